@@ -47,6 +47,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	BackfillDomainReplication(
+		ctx context.Context,
+		Request *admin.BackfillDomainReplicationRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.BackfillDomainReplicationResponse, error)
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
@@ -82,6 +88,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeShardDistributionResponse, error)
 
+	DescribeTaskListConfig(
+		ctx context.Context,
+		Request *admin.DescribeTaskListConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeTaskListConfigResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
@@ -100,36 +112,72 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetDLQReplicationMessagesResponse, error)
 
+	GetDomainAsyncWorkflowConfig(
+		ctx context.Context,
+		Request *admin.GetDomainAsyncWorkflowConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetDomainAsyncWorkflowConfigResponse, error)
+
 	GetDomainReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetDomainReplicationMessagesRequest,
 		opts ...yarpc.CallOption,
 	) (*replicator.GetDomainReplicationMessagesResponse, error)
 
+	GetDomainReplicationWatermark(
+		ctx context.Context,
+		Request *admin.GetDomainReplicationWatermarkRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetDomainReplicationWatermarkResponse, error)
+
 	GetDynamicConfig(
 		ctx context.Context,
 		Request *admin.GetDynamicConfigRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.GetDynamicConfigResponse, error)
 
+	GetReplicationAckStates(
+		ctx context.Context,
+		Request *shared.GetReplicationAckStatesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetReplicationAckStatesResponse, error)
+
 	GetReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetReplicationMessagesRequest,
 		opts ...yarpc.CallOption,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	GetTaskListUsage(
+		ctx context.Context,
+		Request *admin.GetTaskListUsageRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.GetTaskListUsageResponse, error)
+
 	GetWorkflowExecutionRawHistoryV2(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	IssueDomainToken(
+		ctx context.Context,
+		Request *admin.IssueDomainTokenRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.IssueDomainTokenResponse, error)
+
 	ListDynamicConfig(
 		ctx context.Context,
 		Request *admin.ListDynamicConfigRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListTaskListTags(
+		ctx context.Context,
+		Request *admin.ListTaskListTagsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ListTaskListTagsResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -160,6 +208,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ReconcileDomainRetention(
+		ctx context.Context,
+		Request *admin.ReconcileDomainRetentionRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.ReconcileDomainRetentionResponse, error)
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
@@ -196,11 +250,29 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	StealTaskListLease(
+		ctx context.Context,
+		Request *admin.StealTaskListLeaseRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.StealTaskListLeaseResponse, error)
+
+	UpdateDomainAsyncWorkflowConfig(
+		ctx context.Context,
+		Request *admin.UpdateDomainAsyncWorkflowConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.UpdateDomainAsyncWorkflowConfigResponse, error)
+
 	UpdateDynamicConfig(
 		ctx context.Context,
 		Request *admin.UpdateDynamicConfigRequest,
 		opts ...yarpc.CallOption,
 	) error
+
+	UpdateTaskListTags(
+		ctx context.Context,
+		Request *admin.UpdateTaskListTagsRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.UpdateTaskListTagsResponse, error)
 }
 
 // New builds a new client for the AdminService service.
@@ -260,6 +332,34 @@ func (c client) AddSearchAttribute(
 	return
 }
 
+func (c client) BackfillDomainReplication(
+	ctx context.Context,
+	_Request *admin.BackfillDomainReplicationRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.BackfillDomainReplicationResponse, err error) {
+
+	var result admin.AdminService_BackfillDomainReplication_Result
+	args := admin.AdminService_BackfillDomainReplication_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_BackfillDomainReplication_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CloseShard(
 	ctx context.Context,
 	_Request *shared.CloseShardRequest,
@@ -427,6 +527,34 @@ func (c client) DescribeShardDistribution(
 	return
 }
 
+func (c client) DescribeTaskListConfig(
+	ctx context.Context,
+	_Request *admin.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeTaskListConfigResponse, err error) {
+
+	var result admin.AdminService_DescribeTaskListConfig_Result
+	args := admin.AdminService_DescribeTaskListConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_DescribeTaskListConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -511,6 +639,34 @@ func (c client) GetDLQReplicationMessages(
 	return
 }
 
+func (c client) GetDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	_Request *admin.GetDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetDomainAsyncWorkflowConfigResponse, err error) {
+
+	var result admin.AdminService_GetDomainAsyncWorkflowConfig_Result
+	args := admin.AdminService_GetDomainAsyncWorkflowConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_GetDomainAsyncWorkflowConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetDomainReplicationMessages(
 	ctx context.Context,
 	_Request *replicator.GetDomainReplicationMessagesRequest,
@@ -539,6 +695,34 @@ func (c client) GetDomainReplicationMessages(
 	return
 }
 
+func (c client) GetDomainReplicationWatermark(
+	ctx context.Context,
+	_Request *admin.GetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetDomainReplicationWatermarkResponse, err error) {
+
+	var result admin.AdminService_GetDomainReplicationWatermark_Result
+	args := admin.AdminService_GetDomainReplicationWatermark_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_GetDomainReplicationWatermark_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetDynamicConfig(
 	ctx context.Context,
 	_Request *admin.GetDynamicConfigRequest,
@@ -567,6 +751,34 @@ func (c client) GetDynamicConfig(
 	return
 }
 
+func (c client) GetReplicationAckStates(
+	ctx context.Context,
+	_Request *shared.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetReplicationAckStatesResponse, err error) {
+
+	var result admin.AdminService_GetReplicationAckStates_Result
+	args := admin.AdminService_GetReplicationAckStates_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_GetReplicationAckStates_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetReplicationMessages(
 	ctx context.Context,
 	_Request *replicator.GetReplicationMessagesRequest,
@@ -595,6 +807,34 @@ func (c client) GetReplicationMessages(
 	return
 }
 
+func (c client) GetTaskListUsage(
+	ctx context.Context,
+	_Request *admin.GetTaskListUsageRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetTaskListUsageResponse, err error) {
+
+	var result admin.AdminService_GetTaskListUsage_Result
+	args := admin.AdminService_GetTaskListUsage_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_GetTaskListUsage_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetWorkflowExecutionRawHistoryV2(
 	ctx context.Context,
 	_GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
@@ -623,6 +863,34 @@ func (c client) GetWorkflowExecutionRawHistoryV2(
 	return
 }
 
+func (c client) IssueDomainToken(
+	ctx context.Context,
+	_Request *admin.IssueDomainTokenRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.IssueDomainTokenResponse, err error) {
+
+	var result admin.AdminService_IssueDomainToken_Result
+	args := admin.AdminService_IssueDomainToken_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_IssueDomainToken_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ListDynamicConfig(
 	ctx context.Context,
 	_Request *admin.ListDynamicConfigRequest,
@@ -651,6 +919,34 @@ func (c client) ListDynamicConfig(
 	return
 }
 
+func (c client) ListTaskListTags(
+	ctx context.Context,
+	_Request *admin.ListTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListTaskListTagsResponse, err error) {
+
+	var result admin.AdminService_ListTaskListTags_Result
+	args := admin.AdminService_ListTaskListTags_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ListTaskListTags_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) MaintainCorruptWorkflow(
 	ctx context.Context,
 	_Request *admin.AdminMaintainWorkflowRequest,
@@ -791,6 +1087,34 @@ func (c client) ReapplyEvents(
 	return
 }
 
+func (c client) ReconcileDomainRetention(
+	ctx context.Context,
+	_Request *admin.ReconcileDomainRetentionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ReconcileDomainRetentionResponse, err error) {
+
+	var result admin.AdminService_ReconcileDomainRetention_Result
+	args := admin.AdminService_ReconcileDomainRetention_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_ReconcileDomainRetention_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RefreshWorkflowTasks(
 	ctx context.Context,
 	_Request *shared.RefreshWorkflowTasksRequest,
@@ -959,6 +1283,62 @@ func (c client) RestoreDynamicConfig(
 	return
 }
 
+func (c client) StealTaskListLease(
+	ctx context.Context,
+	_Request *admin.StealTaskListLeaseRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.StealTaskListLeaseResponse, err error) {
+
+	var result admin.AdminService_StealTaskListLease_Result
+	args := admin.AdminService_StealTaskListLease_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_StealTaskListLease_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	_Request *admin.UpdateDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateDomainAsyncWorkflowConfigResponse, err error) {
+
+	var result admin.AdminService_UpdateDomainAsyncWorkflowConfig_Result
+	args := admin.AdminService_UpdateDomainAsyncWorkflowConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_UpdateDomainAsyncWorkflowConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDynamicConfig(
 	ctx context.Context,
 	_Request *admin.UpdateDynamicConfigRequest,
@@ -986,3 +1366,31 @@ func (c client) UpdateDynamicConfig(
 	err = admin.AdminService_UpdateDynamicConfig_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateTaskListTags(
+	ctx context.Context,
+	_Request *admin.UpdateTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateTaskListTagsResponse, err error) {
+
+	var result admin.AdminService_UpdateTaskListTags_Result
+	args := admin.AdminService_UpdateTaskListTags_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_UpdateTaskListTags_Helper.UnwrapResponse(&result)
+	return
+}
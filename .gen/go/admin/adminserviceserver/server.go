@@ -46,6 +46,11 @@ type Interface interface {
 		Request *admin.AddSearchAttributeRequest,
 	) error
 
+	BackfillDomainReplication(
+		ctx context.Context,
+		Request *admin.BackfillDomainReplicationRequest,
+	) (*admin.BackfillDomainReplicationResponse, error)
+
 	CloseShard(
 		ctx context.Context,
 		Request *shared.CloseShardRequest,
@@ -75,6 +80,11 @@ type Interface interface {
 		Request *shared.DescribeShardDistributionRequest,
 	) (*shared.DescribeShardDistributionResponse, error)
 
+	DescribeTaskListConfig(
+		ctx context.Context,
+		Request *admin.DescribeTaskListConfigRequest,
+	) (*admin.DescribeTaskListConfigResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
@@ -90,31 +100,61 @@ type Interface interface {
 		Request *replicator.GetDLQReplicationMessagesRequest,
 	) (*replicator.GetDLQReplicationMessagesResponse, error)
 
+	GetDomainAsyncWorkflowConfig(
+		ctx context.Context,
+		Request *admin.GetDomainAsyncWorkflowConfigRequest,
+	) (*admin.GetDomainAsyncWorkflowConfigResponse, error)
+
 	GetDomainReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetDomainReplicationMessagesRequest,
 	) (*replicator.GetDomainReplicationMessagesResponse, error)
 
+	GetDomainReplicationWatermark(
+		ctx context.Context,
+		Request *admin.GetDomainReplicationWatermarkRequest,
+	) (*admin.GetDomainReplicationWatermarkResponse, error)
+
 	GetDynamicConfig(
 		ctx context.Context,
 		Request *admin.GetDynamicConfigRequest,
 	) (*admin.GetDynamicConfigResponse, error)
 
+	GetReplicationAckStates(
+		ctx context.Context,
+		Request *shared.GetReplicationAckStatesRequest,
+	) (*shared.GetReplicationAckStatesResponse, error)
+
 	GetReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetReplicationMessagesRequest,
 	) (*replicator.GetReplicationMessagesResponse, error)
 
+	GetTaskListUsage(
+		ctx context.Context,
+		Request *admin.GetTaskListUsageRequest,
+	) (*admin.GetTaskListUsageResponse, error)
+
 	GetWorkflowExecutionRawHistoryV2(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryV2Request,
 	) (*admin.GetWorkflowExecutionRawHistoryV2Response, error)
 
+	IssueDomainToken(
+		ctx context.Context,
+		Request *admin.IssueDomainTokenRequest,
+	) (*admin.IssueDomainTokenResponse, error)
+
 	ListDynamicConfig(
 		ctx context.Context,
 		Request *admin.ListDynamicConfigRequest,
 	) (*admin.ListDynamicConfigResponse, error)
 
+	ListTaskListTags(
+		ctx context.Context,
+		Request *admin.ListTaskListTagsRequest,
+	) (*admin.ListTaskListTagsResponse, error)
+
 	MaintainCorruptWorkflow(
 		ctx context.Context,
 		Request *admin.AdminMaintainWorkflowRequest,
@@ -140,6 +180,11 @@ type Interface interface {
 		ReapplyEventsRequest *shared.ReapplyEventsRequest,
 	) error
 
+	ReconcileDomainRetention(
+		ctx context.Context,
+		Request *admin.ReconcileDomainRetentionRequest,
+	) (*admin.ReconcileDomainRetentionResponse, error)
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
@@ -170,10 +215,25 @@ type Interface interface {
 		Request *admin.RestoreDynamicConfigRequest,
 	) error
 
+	StealTaskListLease(
+		ctx context.Context,
+		Request *admin.StealTaskListLeaseRequest,
+	) (*admin.StealTaskListLeaseResponse, error)
+
+	UpdateDomainAsyncWorkflowConfig(
+		ctx context.Context,
+		Request *admin.UpdateDomainAsyncWorkflowConfigRequest,
+	) (*admin.UpdateDomainAsyncWorkflowConfigResponse, error)
+
 	UpdateDynamicConfig(
 		ctx context.Context,
 		Request *admin.UpdateDynamicConfigRequest,
 	) error
+
+	UpdateTaskListTags(
+		ctx context.Context,
+		Request *admin.UpdateTaskListTagsRequest,
+	) (*admin.UpdateTaskListTagsResponse, error)
 }
 
 // New prepares an implementation of the AdminService service for
@@ -199,6 +259,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "BackfillDomainReplication",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.BackfillDomainReplication),
+					NoWire: backfilldomainreplication_NoWireHandler{impl},
+				},
+				Signature:    "BackfillDomainReplication(Request *admin.BackfillDomainReplicationRequest) (*admin.BackfillDomainReplicationResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "CloseShard",
 				HandlerSpec: thrift.HandlerSpec{
@@ -271,6 +343,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeTaskListConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.DescribeTaskListConfig),
+					NoWire: describetasklistconfig_NoWireHandler{impl},
+				},
+				Signature:    "DescribeTaskListConfig(Request *admin.DescribeTaskListConfigRequest) (*admin.DescribeTaskListConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -307,6 +391,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetDomainAsyncWorkflowConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetDomainAsyncWorkflowConfig),
+					NoWire: getdomainasyncworkflowconfig_NoWireHandler{impl},
+				},
+				Signature:    "GetDomainAsyncWorkflowConfig(Request *admin.GetDomainAsyncWorkflowConfigRequest) (*admin.GetDomainAsyncWorkflowConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetDomainReplicationMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -319,6 +415,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetDomainReplicationWatermark",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetDomainReplicationWatermark),
+					NoWire: getdomainreplicationwatermark_NoWireHandler{impl},
+				},
+				Signature:    "GetDomainReplicationWatermark(Request *admin.GetDomainReplicationWatermarkRequest) (*admin.GetDomainReplicationWatermarkResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetDynamicConfig",
 				HandlerSpec: thrift.HandlerSpec{
@@ -331,6 +439,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetReplicationAckStates",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetReplicationAckStates),
+					NoWire: getreplicationackstates_NoWireHandler{impl},
+				},
+				Signature:    "GetReplicationAckStates(Request *shared.GetReplicationAckStatesRequest) (*shared.GetReplicationAckStatesResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetReplicationMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -343,6 +463,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetTaskListUsage",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetTaskListUsage),
+					NoWire: gettasklistusage_NoWireHandler{impl},
+				},
+				Signature:    "GetTaskListUsage(Request *admin.GetTaskListUsageRequest) (*admin.GetTaskListUsageResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetWorkflowExecutionRawHistoryV2",
 				HandlerSpec: thrift.HandlerSpec{
@@ -355,6 +487,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "IssueDomainToken",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.IssueDomainToken),
+					NoWire: issuedomaintoken_NoWireHandler{impl},
+				},
+				Signature:    "IssueDomainToken(Request *admin.IssueDomainTokenRequest) (*admin.IssueDomainTokenResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListDynamicConfig",
 				HandlerSpec: thrift.HandlerSpec{
@@ -367,6 +511,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListTaskListTags",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ListTaskListTags),
+					NoWire: listtasklisttags_NoWireHandler{impl},
+				},
+				Signature:    "ListTaskListTags(Request *admin.ListTaskListTagsRequest) (*admin.ListTaskListTagsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "MaintainCorruptWorkflow",
 				HandlerSpec: thrift.HandlerSpec{
@@ -427,6 +583,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReconcileDomainRetention",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReconcileDomainRetention),
+					NoWire: reconciledomainretention_NoWireHandler{impl},
+				},
+				Signature:    "ReconcileDomainRetention(Request *admin.ReconcileDomainRetentionRequest) (*admin.ReconcileDomainRetentionResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RefreshWorkflowTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -499,6 +667,30 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "StealTaskListLease",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.StealTaskListLease),
+					NoWire: stealtasklistlease_NoWireHandler{impl},
+				},
+				Signature:    "StealTaskListLease(Request *admin.StealTaskListLeaseRequest) (*admin.StealTaskListLeaseResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "UpdateDomainAsyncWorkflowConfig",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateDomainAsyncWorkflowConfig),
+					NoWire: updatedomainasyncworkflowconfig_NoWireHandler{impl},
+				},
+				Signature:    "UpdateDomainAsyncWorkflowConfig(Request *admin.UpdateDomainAsyncWorkflowConfigRequest) (*admin.UpdateDomainAsyncWorkflowConfigResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "UpdateDynamicConfig",
 				HandlerSpec: thrift.HandlerSpec{
@@ -510,10 +702,22 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "UpdateDynamicConfig(Request *admin.UpdateDynamicConfigRequest)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "UpdateTaskListTags",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.UpdateTaskListTags),
+					NoWire: updatetasklisttags_NoWireHandler{impl},
+				},
+				Signature:    "UpdateTaskListTags(Request *admin.UpdateTaskListTagsRequest) (*admin.UpdateTaskListTagsResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 27)
+	procedures := make([]transport.Procedure, 0, 39)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -554,6 +758,36 @@ func (h handler) AddSearchAttribute(ctx context.Context, body wire.Value) (thrif
 	return response, err
 }
 
+func (h handler) BackfillDomainReplication(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_BackfillDomainReplication_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'BackfillDomainReplication': %w", err)
+	}
+
+	success, appErr := h.impl.BackfillDomainReplication(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_BackfillDomainReplication_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_CloseShard_Args
 	if err := args.FromWire(body); err != nil {
@@ -734,6 +968,36 @@ func (h handler) DescribeShardDistribution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) DescribeTaskListConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeTaskListConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'DescribeTaskListConfig': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeTaskListConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeTaskListConfig_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -824,17 +1088,17 @@ func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value)
 	return response, err
 }
 
-func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetDomainReplicationMessages_Args
+func (h handler) GetDomainAsyncWorkflowConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDomainAsyncWorkflowConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDomainAsyncWorkflowConfig': %w", err)
 	}
 
-	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.GetDomainAsyncWorkflowConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDomainAsyncWorkflowConfig_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -854,17 +1118,17 @@ func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Val
 	return response, err
 }
 
-func (h handler) GetDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetDynamicConfig_Args
+func (h handler) GetDomainReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDomainReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetDynamicConfig': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.GetDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDynamicConfig_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -884,17 +1148,17 @@ func (h handler) GetDynamicConfig(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetReplicationMessages_Args
+func (h handler) GetDomainReplicationWatermark(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDomainReplicationWatermark_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDomainReplicationWatermark': %w", err)
 	}
 
-	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.GetDomainReplicationWatermark(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDomainReplicationWatermark_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -914,17 +1178,17 @@ func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
-func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
+func (h handler) GetDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetDynamicConfig': %w", err)
 	}
 
-	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
+	success, appErr := h.impl.GetDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDynamicConfig_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -944,17 +1208,17 @@ func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire
 	return response, err
 }
 
-func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ListDynamicConfig_Args
+func (h handler) GetReplicationAckStates(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetReplicationAckStates_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ListDynamicConfig': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetReplicationAckStates': %w", err)
 	}
 
-	success, appErr := h.impl.ListDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.GetReplicationAckStates(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ListDynamicConfig_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetReplicationAckStates_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -974,17 +1238,17 @@ func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift
 	return response, err
 }
 
-func (h handler) MaintainCorruptWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_MaintainCorruptWorkflow_Args
+func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'MaintainCorruptWorkflow': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.MaintainCorruptWorkflow(ctx, args.Request)
+	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_MaintainCorruptWorkflow_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1004,17 +1268,17 @@ func (h handler) MaintainCorruptWorkflow(ctx context.Context, body wire.Value) (
 	return response, err
 }
 
-func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_MergeDLQMessages_Args
+func (h handler) GetTaskListUsage(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetTaskListUsage_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetTaskListUsage': %w", err)
 	}
 
-	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.GetTaskListUsage(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetTaskListUsage_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1034,17 +1298,17 @@ func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_PurgeDLQMessages_Args
+func (h handler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
 	}
 
-	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_PurgeDLQMessages_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1064,17 +1328,17 @@ func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.
 	return response, err
 }
 
-func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ReadDLQMessages_Args
+func (h handler) IssueDomainToken(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_IssueDomainToken_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ReadDLQMessages': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'IssueDomainToken': %w", err)
 	}
 
-	success, appErr := h.impl.ReadDLQMessages(ctx, args.Request)
+	success, appErr := h.impl.IssueDomainToken(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ReadDLQMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_IssueDomainToken_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1094,17 +1358,17 @@ func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
-func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_ReapplyEvents_Args
+func (h handler) ListDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListDynamicConfig_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'ReapplyEvents': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ListDynamicConfig': %w", err)
 	}
 
-	appErr := h.impl.ReapplyEvents(ctx, args.ReapplyEventsRequest)
+	success, appErr := h.impl.ListDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ReapplyEvents_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ListDynamicConfig_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1124,17 +1388,17 @@ func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
-func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RefreshWorkflowTasks_Args
+func (h handler) ListTaskListTags(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListTaskListTags_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RefreshWorkflowTasks': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'ListTaskListTags': %w", err)
 	}
 
-	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+	success, appErr := h.impl.ListTaskListTags(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_ListTaskListTags_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1154,11 +1418,221 @@ func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_RemoveTask_Args
+func (h handler) MaintainCorruptWorkflow(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MaintainCorruptWorkflow_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'RemoveTask': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'MaintainCorruptWorkflow': %w", err)
+	}
+
+	success, appErr := h.impl.MaintainCorruptWorkflow(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MaintainCorruptWorkflow_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) MergeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_MergeDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'MergeDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.MergeDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_MergeDLQMessages_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) PurgeDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_PurgeDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'PurgeDLQMessages': %w", err)
+	}
+
+	appErr := h.impl.PurgeDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_PurgeDLQMessages_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ReadDLQMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReadDLQMessages_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReadDLQMessages': %w", err)
+	}
+
+	success, appErr := h.impl.ReadDLQMessages(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReadDLQMessages_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReapplyEvents_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReapplyEvents': %w", err)
+	}
+
+	appErr := h.impl.ReapplyEvents(ctx, args.ReapplyEventsRequest)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReapplyEvents_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) ReconcileDomainRetention(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReconcileDomainRetention_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ReconcileDomainRetention': %w", err)
+	}
+
+	success, appErr := h.impl.ReconcileDomainRetention(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReconcileDomainRetention_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RefreshWorkflowTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RefreshWorkflowTasks': %w", err)
+	}
+
+	appErr := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) RemoveTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RemoveTask': %w", err)
 	}
 
 	appErr := h.impl.RemoveTask(ctx, args.Request)
@@ -1304,17 +1778,17 @@ func (h handler) RestoreDynamicConfig(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
-func (h handler) UpdateDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
-	var args admin.AdminService_UpdateDynamicConfig_Args
+func (h handler) StealTaskListLease(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_StealTaskListLease_Args
 	if err := args.FromWire(body); err != nil {
 		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode Thrift request for service 'AdminService' procedure 'UpdateDynamicConfig': %w", err)
+			"could not decode Thrift request for service 'AdminService' procedure 'StealTaskListLease': %w", err)
 	}
 
-	appErr := h.impl.UpdateDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.StealTaskListLease(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_UpdateDynamicConfig_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_StealTaskListLease_Helper.WrapResponse(success, appErr)
 
 	var response thrift.Response
 	if err == nil {
@@ -1334,25 +1808,411 @@ func (h handler) UpdateDynamicConfig(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
-type addsearchattribute_NoWireHandler struct{ impl Interface }
+func (h handler) UpdateDomainAsyncWorkflowConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateDomainAsyncWorkflowConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpdateDomainAsyncWorkflowConfig': %w", err)
+	}
 
-func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
-	var (
-		args admin.AdminService_AddSearchAttribute_Args
-		rw   stream.ResponseWriter
-		err  error
-	)
+	success, appErr := h.impl.UpdateDomainAsyncWorkflowConfig(ctx, args.Request)
 
-	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
-	if err != nil {
-		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'AddSearchAttribute': %w", err)
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateDomainAsyncWorkflowConfig_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
 	}
 
-	appErr := h.impl.AddSearchAttribute(ctx, args.Request)
+	return response, err
+}
+
+func (h handler) UpdateDynamicConfig(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateDynamicConfig_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpdateDynamicConfig': %w", err)
+	}
+
+	appErr := h.impl.UpdateDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_AddSearchAttribute_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_UpdateDynamicConfig_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+func (h handler) UpdateTaskListTags(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_UpdateTaskListTags_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'UpdateTaskListTags': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateTaskListTags(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateTaskListTags_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
+type addsearchattribute_NoWireHandler struct{ impl Interface }
+
+func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_AddSearchAttribute_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'AddSearchAttribute': %w", err)
+	}
+
+	appErr := h.impl.AddSearchAttribute(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_AddSearchAttribute_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type backfilldomainreplication_NoWireHandler struct{ impl Interface }
+
+func (h backfilldomainreplication_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_BackfillDomainReplication_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'BackfillDomainReplication': %w", err)
+	}
+
+	success, appErr := h.impl.BackfillDomainReplication(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_BackfillDomainReplication_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type closeshard_NoWireHandler struct{ impl Interface }
+
+func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_CloseShard_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'CloseShard': %w", err)
+	}
+
+	appErr := h.impl.CloseShard(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_CloseShard_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type deleteworkflow_NoWireHandler struct{ impl Interface }
+
+func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DeleteWorkflow_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DeleteWorkflow': %w", err)
+	}
+
+	success, appErr := h.impl.DeleteWorkflow(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DeleteWorkflow_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describecluster_NoWireHandler struct{ impl Interface }
+
+func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeCluster_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeCluster': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeCluster(ctx)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeCluster_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describehistoryhost_NoWireHandler struct{ impl Interface }
+
+func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeHistoryHost_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeHistoryHost': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeHistoryHost(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeHistoryHost_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describequeue_NoWireHandler struct{ impl Interface }
+
+func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeQueue_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeQueue': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeQueue(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeQueue_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describesharddistribution_NoWireHandler struct{ impl Interface }
+
+func (h describesharddistribution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeShardDistribution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeShardDistribution': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeShardDistribution(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeShardDistribution_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type describetasklistconfig_NoWireHandler struct{ impl Interface }
+
+func (h describetasklistconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_DescribeTaskListConfig_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeTaskListConfig': %w", err)
+	}
+
+	success, appErr := h.impl.DescribeTaskListConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_DescribeTaskListConfig_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1371,11 +2231,11 @@ func (h addsearchattribute_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 
 }
 
-type closeshard_NoWireHandler struct{ impl Interface }
+type describeworkflowexecution_NoWireHandler struct{ impl Interface }
 
-func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_CloseShard_Args
+		args admin.AdminService_DescribeWorkflowExecution_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1383,13 +2243,13 @@ func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'CloseShard': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeWorkflowExecution': %w", err)
 	}
 
-	appErr := h.impl.CloseShard(ctx, args.Request)
+	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_CloseShard_Helper.WrapResponse(appErr)
+	result, err := admin.AdminService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1408,11 +2268,11 @@ func (h closeshard_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
-type deleteworkflow_NoWireHandler struct{ impl Interface }
+type getcrossclustertasks_NoWireHandler struct{ impl Interface }
 
-func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DeleteWorkflow_Args
+		args admin.AdminService_GetCrossClusterTasks_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1420,13 +2280,13 @@ func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DeleteWorkflow': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetCrossClusterTasks': %w", err)
 	}
 
-	success, appErr := h.impl.DeleteWorkflow(ctx, args.Request)
+	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DeleteWorkflow_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1445,11 +2305,11 @@ func (h deleteworkflow_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thr
 
 }
 
-type describecluster_NoWireHandler struct{ impl Interface }
+type getdlqreplicationmessages_NoWireHandler struct{ impl Interface }
 
-func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getdlqreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeCluster_Args
+		args admin.AdminService_GetDLQReplicationMessages_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1457,13 +2317,13 @@ func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeCluster': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDLQReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeCluster(ctx)
+	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeCluster_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1482,11 +2342,11 @@ func (h describecluster_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 
 }
 
-type describehistoryhost_NoWireHandler struct{ impl Interface }
+type getdomainasyncworkflowconfig_NoWireHandler struct{ impl Interface }
 
-func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getdomainasyncworkflowconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeHistoryHost_Args
+		args admin.AdminService_GetDomainAsyncWorkflowConfig_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1494,13 +2354,13 @@ func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeHistoryHost': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDomainAsyncWorkflowConfig': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeHistoryHost(ctx, args.Request)
+	success, appErr := h.impl.GetDomainAsyncWorkflowConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeHistoryHost_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDomainAsyncWorkflowConfig_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1519,11 +2379,11 @@ func (h describehistoryhost_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 
 }
 
-type describequeue_NoWireHandler struct{ impl Interface }
+type getdomainreplicationmessages_NoWireHandler struct{ impl Interface }
 
-func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getdomainreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeQueue_Args
+		args admin.AdminService_GetDomainReplicationMessages_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1531,13 +2391,13 @@ func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeQueue': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeQueue(ctx, args.Request)
+	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeQueue_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1556,11 +2416,11 @@ func (h describequeue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
-type describesharddistribution_NoWireHandler struct{ impl Interface }
+type getdomainreplicationwatermark_NoWireHandler struct{ impl Interface }
 
-func (h describesharddistribution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getdomainreplicationwatermark_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeShardDistribution_Args
+		args admin.AdminService_GetDomainReplicationWatermark_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1568,13 +2428,13 @@ func (h describesharddistribution_NoWireHandler) HandleNoWire(ctx context.Contex
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeShardDistribution': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDomainReplicationWatermark': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeShardDistribution(ctx, args.Request)
+	success, appErr := h.impl.GetDomainReplicationWatermark(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeShardDistribution_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDomainReplicationWatermark_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1593,11 +2453,11 @@ func (h describesharddistribution_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
-type describeworkflowexecution_NoWireHandler struct{ impl Interface }
+type getdynamicconfig_NoWireHandler struct{ impl Interface }
 
-func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_DescribeWorkflowExecution_Args
+		args admin.AdminService_GetDynamicConfig_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1605,13 +2465,13 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'DescribeWorkflowExecution': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDynamicConfig': %w", err)
 	}
 
-	success, appErr := h.impl.DescribeWorkflowExecution(ctx, args.Request)
+	success, appErr := h.impl.GetDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_DescribeWorkflowExecution_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetDynamicConfig_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1630,11 +2490,11 @@ func (h describeworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
-type getcrossclustertasks_NoWireHandler struct{ impl Interface }
+type getreplicationackstates_NoWireHandler struct{ impl Interface }
 
-func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getreplicationackstates_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetCrossClusterTasks_Args
+		args admin.AdminService_GetReplicationAckStates_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1642,13 +2502,13 @@ func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nw
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetCrossClusterTasks': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetReplicationAckStates': %w", err)
 	}
 
-	success, appErr := h.impl.GetCrossClusterTasks(ctx, args.Request)
+	success, appErr := h.impl.GetReplicationAckStates(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetCrossClusterTasks_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetReplicationAckStates_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1667,11 +2527,11 @@ func (h getcrossclustertasks_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
-type getdlqreplicationmessages_NoWireHandler struct{ impl Interface }
+type getreplicationmessages_NoWireHandler struct{ impl Interface }
 
-func (h getdlqreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetDLQReplicationMessages_Args
+		args admin.AdminService_GetReplicationMessages_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1679,13 +2539,13 @@ func (h getdlqreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Contex
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDLQReplicationMessages': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
 	}
 
-	success, appErr := h.impl.GetDLQReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDLQReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1704,11 +2564,11 @@ func (h getdlqreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
-type getdomainreplicationmessages_NoWireHandler struct{ impl Interface }
+type gettasklistusage_NoWireHandler struct{ impl Interface }
 
-func (h getdomainreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h gettasklistusage_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetDomainReplicationMessages_Args
+		args admin.AdminService_GetTaskListUsage_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1716,13 +2576,13 @@ func (h getdomainreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Con
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDomainReplicationMessages': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetTaskListUsage': %w", err)
 	}
 
-	success, appErr := h.impl.GetDomainReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.GetTaskListUsage(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDomainReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetTaskListUsage_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1741,11 +2601,11 @@ func (h getdomainreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Con
 
 }
 
-type getdynamicconfig_NoWireHandler struct{ impl Interface }
+type getworkflowexecutionrawhistoryv2_NoWireHandler struct{ impl Interface }
 
-func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetDynamicConfig_Args
+		args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1753,13 +2613,13 @@ func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetDynamicConfig': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
 	}
 
-	success, appErr := h.impl.GetDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetDynamicConfig_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1778,11 +2638,11 @@ func (h getdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *t
 
 }
 
-type getreplicationmessages_NoWireHandler struct{ impl Interface }
+type issuedomaintoken_NoWireHandler struct{ impl Interface }
 
-func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h issuedomaintoken_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetReplicationMessages_Args
+		args admin.AdminService_IssueDomainToken_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1790,13 +2650,13 @@ func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context,
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetReplicationMessages': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'IssueDomainToken': %w", err)
 	}
 
-	success, appErr := h.impl.GetReplicationMessages(ctx, args.Request)
+	success, appErr := h.impl.IssueDomainToken(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetReplicationMessages_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_IssueDomainToken_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1815,11 +2675,11 @@ func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
-type getworkflowexecutionrawhistoryv2_NoWireHandler struct{ impl Interface }
+type listdynamicconfig_NoWireHandler struct{ impl Interface }
 
-func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_GetWorkflowExecutionRawHistoryV2_Args
+		args admin.AdminService_ListDynamicConfig_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1827,13 +2687,13 @@ func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'GetWorkflowExecutionRawHistoryV2': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListDynamicConfig': %w", err)
 	}
 
-	success, appErr := h.impl.GetWorkflowExecutionRawHistoryV2(ctx, args.GetRequest)
+	success, appErr := h.impl.ListDynamicConfig(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_GetWorkflowExecutionRawHistoryV2_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ListDynamicConfig_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -1852,11 +2712,11 @@ func (h getworkflowexecutionrawhistoryv2_NoWireHandler) HandleNoWire(ctx context
 
 }
 
-type listdynamicconfig_NoWireHandler struct{ impl Interface }
+type listtasklisttags_NoWireHandler struct{ impl Interface }
 
-func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+func (h listtasklisttags_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
 	var (
-		args admin.AdminService_ListDynamicConfig_Args
+		args admin.AdminService_ListTaskListTags_Args
 		rw   stream.ResponseWriter
 		err  error
 	)
@@ -1864,13 +2724,13 @@ func (h listdynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *
 	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
 	if err != nil {
 		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
-			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListDynamicConfig': %w", err)
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ListTaskListTags': %w", err)
 	}
 
-	success, appErr := h.impl.ListDynamicConfig(ctx, args.Request)
+	success, appErr := h.impl.ListTaskListTags(ctx, args.Request)
 
 	hadError := appErr != nil
-	result, err := admin.AdminService_ListDynamicConfig_Helper.WrapResponse(success, appErr)
+	result, err := admin.AdminService_ListTaskListTags_Helper.WrapResponse(success, appErr)
 	response := thrift.NoWireResponse{ResponseWriter: rw}
 	if err == nil {
 		response.IsApplicationError = hadError
@@ -2074,6 +2934,43 @@ func (h reapplyevents_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
+type reconciledomainretention_NoWireHandler struct{ impl Interface }
+
+func (h reconciledomainretention_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ReconcileDomainRetention_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ReconcileDomainRetention': %w", err)
+	}
+
+	success, appErr := h.impl.ReconcileDomainRetention(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ReconcileDomainRetention_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type refreshworkflowtasks_NoWireHandler struct{ impl Interface }
 
 func (h refreshworkflowtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2296,6 +3193,80 @@ func (h restoredynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
+type stealtasklistlease_NoWireHandler struct{ impl Interface }
+
+func (h stealtasklistlease_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_StealTaskListLease_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'StealTaskListLease': %w", err)
+	}
+
+	success, appErr := h.impl.StealTaskListLease(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_StealTaskListLease_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
+type updatedomainasyncworkflowconfig_NoWireHandler struct{ impl Interface }
+
+func (h updatedomainasyncworkflowconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_UpdateDomainAsyncWorkflowConfig_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'UpdateDomainAsyncWorkflowConfig': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateDomainAsyncWorkflowConfig(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateDomainAsyncWorkflowConfig_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type updatedynamicconfig_NoWireHandler struct{ impl Interface }
 
 func (h updatedynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2332,3 +3303,40 @@ func (h updatedynamicconfig_NoWireHandler) HandleNoWire(ctx context.Context, nwc
 	return response, err
 
 }
+
+type updatetasklisttags_NoWireHandler struct{ impl Interface }
+
+func (h updatetasklisttags_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_UpdateTaskListTags_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'UpdateTaskListTags': %w", err)
+	}
+
+	success, appErr := h.impl.UpdateTaskListTags(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_UpdateTaskListTags_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
@@ -99,6 +99,39 @@ func (mr *_MockClientRecorder) AddSearchAttribute(
 	return mr.mock.ctrl.RecordCall(mr.mock, "AddSearchAttribute", args...)
 }
 
+// BackfillDomainReplication responds to a BackfillDomainReplication call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().BackfillDomainReplication(gomock.Any(), ...).Return(...)
+//   ... := client.BackfillDomainReplication(...)
+func (m *MockClient) BackfillDomainReplication(
+	ctx context.Context,
+	_Request *admin.BackfillDomainReplicationRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.BackfillDomainReplicationResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "BackfillDomainReplication", args...)
+	success, _ = ret[i].(*admin.BackfillDomainReplicationResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) BackfillDomainReplication(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "BackfillDomainReplication", args...)
+}
+
 // CloseShard responds to a CloseShard call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -293,6 +326,39 @@ func (mr *_MockClientRecorder) DescribeShardDistribution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeShardDistribution", args...)
 }
 
+// DescribeTaskListConfig responds to a DescribeTaskListConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().DescribeTaskListConfig(gomock.Any(), ...).Return(...)
+//   ... := client.DescribeTaskListConfig(...)
+func (m *MockClient) DescribeTaskListConfig(
+	ctx context.Context,
+	_Request *admin.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeTaskListConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", args...)
+	success, _ = ret[i].(*admin.DescribeTaskListConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeTaskListConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskListConfig", args...)
+}
+
 // DescribeWorkflowExecution responds to a DescribeWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -392,6 +458,39 @@ func (mr *_MockClientRecorder) GetDLQReplicationMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetDLQReplicationMessages", args...)
 }
 
+// GetDomainAsyncWorkflowConfig responds to a GetDomainAsyncWorkflowConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().GetDomainAsyncWorkflowConfig(gomock.Any(), ...).Return(...)
+//   ... := client.GetDomainAsyncWorkflowConfig(...)
+func (m *MockClient) GetDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	_Request *admin.GetDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetDomainAsyncWorkflowConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetDomainAsyncWorkflowConfig", args...)
+	success, _ = ret[i].(*admin.GetDomainAsyncWorkflowConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetDomainAsyncWorkflowConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetDomainAsyncWorkflowConfig", args...)
+}
+
 // GetDomainReplicationMessages responds to a GetDomainReplicationMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -425,6 +524,39 @@ func (mr *_MockClientRecorder) GetDomainReplicationMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetDomainReplicationMessages", args...)
 }
 
+// GetDomainReplicationWatermark responds to a GetDomainReplicationWatermark call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().GetDomainReplicationWatermark(gomock.Any(), ...).Return(...)
+//   ... := client.GetDomainReplicationWatermark(...)
+func (m *MockClient) GetDomainReplicationWatermark(
+	ctx context.Context,
+	_Request *admin.GetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetDomainReplicationWatermarkResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetDomainReplicationWatermark", args...)
+	success, _ = ret[i].(*admin.GetDomainReplicationWatermarkResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetDomainReplicationWatermark(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetDomainReplicationWatermark", args...)
+}
+
 // GetDynamicConfig responds to a GetDynamicConfig call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -458,6 +590,39 @@ func (mr *_MockClientRecorder) GetDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetDynamicConfig", args...)
 }
 
+// GetReplicationAckStates responds to a GetReplicationAckStates call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().GetReplicationAckStates(gomock.Any(), ...).Return(...)
+//   ... := client.GetReplicationAckStates(...)
+func (m *MockClient) GetReplicationAckStates(
+	ctx context.Context,
+	_Request *shared.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetReplicationAckStatesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetReplicationAckStates", args...)
+	success, _ = ret[i].(*shared.GetReplicationAckStatesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetReplicationAckStates(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationAckStates", args...)
+}
+
 // GetReplicationMessages responds to a GetReplicationMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -491,6 +656,39 @@ func (mr *_MockClientRecorder) GetReplicationMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationMessages", args...)
 }
 
+// GetTaskListUsage responds to a GetTaskListUsage call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().GetTaskListUsage(gomock.Any(), ...).Return(...)
+//   ... := client.GetTaskListUsage(...)
+func (m *MockClient) GetTaskListUsage(
+	ctx context.Context,
+	_Request *admin.GetTaskListUsageRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.GetTaskListUsageResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetTaskListUsage", args...)
+	success, _ = ret[i].(*admin.GetTaskListUsageResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetTaskListUsage(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetTaskListUsage", args...)
+}
+
 // GetWorkflowExecutionRawHistoryV2 responds to a GetWorkflowExecutionRawHistoryV2 call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -524,6 +722,39 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistoryV2(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistoryV2", args...)
 }
 
+// IssueDomainToken responds to a IssueDomainToken call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().IssueDomainToken(gomock.Any(), ...).Return(...)
+//   ... := client.IssueDomainToken(...)
+func (m *MockClient) IssueDomainToken(
+	ctx context.Context,
+	_Request *admin.IssueDomainTokenRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.IssueDomainTokenResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "IssueDomainToken", args...)
+	success, _ = ret[i].(*admin.IssueDomainTokenResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) IssueDomainToken(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "IssueDomainToken", args...)
+}
+
 // ListDynamicConfig responds to a ListDynamicConfig call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -557,6 +788,39 @@ func (mr *_MockClientRecorder) ListDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ListDynamicConfig", args...)
 }
 
+// ListTaskListTags responds to a ListTaskListTags call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().ListTaskListTags(gomock.Any(), ...).Return(...)
+//   ... := client.ListTaskListTags(...)
+func (m *MockClient) ListTaskListTags(
+	ctx context.Context,
+	_Request *admin.ListTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ListTaskListTagsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListTaskListTags", args...)
+	success, _ = ret[i].(*admin.ListTaskListTagsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListTaskListTags(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListTaskListTags", args...)
+}
+
 // MaintainCorruptWorkflow responds to a MaintainCorruptWorkflow call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -718,6 +982,39 @@ func (mr *_MockClientRecorder) ReapplyEvents(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ReapplyEvents", args...)
 }
 
+// ReconcileDomainRetention responds to a ReconcileDomainRetention call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().ReconcileDomainRetention(gomock.Any(), ...).Return(...)
+//   ... := client.ReconcileDomainRetention(...)
+func (m *MockClient) ReconcileDomainRetention(
+	ctx context.Context,
+	_Request *admin.ReconcileDomainRetentionRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.ReconcileDomainRetentionResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReconcileDomainRetention", args...)
+	success, _ = ret[i].(*admin.ReconcileDomainRetentionResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReconcileDomainRetention(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReconcileDomainRetention", args...)
+}
+
 // RefreshWorkflowTasks responds to a RefreshWorkflowTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -906,6 +1203,72 @@ func (mr *_MockClientRecorder) RestoreDynamicConfig(
 	return mr.mock.ctrl.RecordCall(mr.mock, "RestoreDynamicConfig", args...)
 }
 
+// StealTaskListLease responds to a StealTaskListLease call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().StealTaskListLease(gomock.Any(), ...).Return(...)
+//   ... := client.StealTaskListLease(...)
+func (m *MockClient) StealTaskListLease(
+	ctx context.Context,
+	_Request *admin.StealTaskListLeaseRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.StealTaskListLeaseResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "StealTaskListLease", args...)
+	success, _ = ret[i].(*admin.StealTaskListLeaseResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) StealTaskListLease(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "StealTaskListLease", args...)
+}
+
+// UpdateDomainAsyncWorkflowConfig responds to a UpdateDomainAsyncWorkflowConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().UpdateDomainAsyncWorkflowConfig(gomock.Any(), ...).Return(...)
+//   ... := client.UpdateDomainAsyncWorkflowConfig(...)
+func (m *MockClient) UpdateDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	_Request *admin.UpdateDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateDomainAsyncWorkflowConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateDomainAsyncWorkflowConfig", args...)
+	success, _ = ret[i].(*admin.UpdateDomainAsyncWorkflowConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateDomainAsyncWorkflowConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateDomainAsyncWorkflowConfig", args...)
+}
+
 // UpdateDynamicConfig responds to a UpdateDynamicConfig call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -936,3 +1299,36 @@ func (mr *_MockClientRecorder) UpdateDynamicConfig(
 	args := append([]interface{}{ctx, _Request}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateDynamicConfig", args...)
 }
+
+// UpdateTaskListTags responds to a UpdateTaskListTags call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().UpdateTaskListTags(gomock.Any(), ...).Return(...)
+//   ... := client.UpdateTaskListTags(...)
+func (m *MockClient) UpdateTaskListTags(
+	ctx context.Context,
+	_Request *admin.UpdateTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.UpdateTaskListTagsResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "UpdateTaskListTags", args...)
+	success, _ = ret[i].(*admin.UpdateTaskListTagsResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) UpdateTaskListTags(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "UpdateTaskListTags", args...)
+}
@@ -248,6 +248,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	SignalBatchWorkflowExecution(
+		ctx context.Context,
+		SignalRequest *shared.SignalBatchWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	SignalWithStartWorkflowExecution(
 		ctx context.Context,
 		SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest,
@@ -1286,6 +1292,34 @@ func (c client) ScanWorkflowExecutions(
 	return
 }
 
+func (c client) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	_SignalRequest *shared.SignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result cadence.WorkflowService_SignalBatchWorkflowExecution_Result
+	args := cadence.WorkflowService_SignalBatchWorkflowExecution_Helper.Args(_SignalRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = cadence.WorkflowService_SignalBatchWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	_SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest,
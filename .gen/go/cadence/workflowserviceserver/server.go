@@ -213,6 +213,11 @@ type Interface interface {
 		ListRequest *shared.ListWorkflowExecutionsRequest,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	SignalBatchWorkflowExecution(
+		ctx context.Context,
+		SignalRequest *shared.SignalBatchWorkflowExecutionRequest,
+	) error
+
 	SignalWithStartWorkflowExecution(
 		ctx context.Context,
 		SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest,
@@ -670,6 +675,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "SignalBatchWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.SignalBatchWorkflowExecution),
+					NoWire: signalbatchworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "SignalBatchWorkflowExecution(SignalRequest *shared.SignalBatchWorkflowExecutionRequest)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "SignalWithStartWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -732,7 +749,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 40)
+	procedures := make([]transport.Procedure, 0, 41)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -1793,6 +1810,36 @@ func (h handler) ScanWorkflowExecutions(ctx context.Context, body wire.Value) (t
 	return response, err
 }
 
+func (h handler) SignalBatchWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_SignalBatchWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'WorkflowService' procedure 'SignalBatchWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.SignalBatchWorkflowExecution(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalBatchWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_SignalWithStartWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -3238,6 +3285,43 @@ func (h scanworkflowexecutions_NoWireHandler) HandleNoWire(ctx context.Context,
 
 }
 
+type signalbatchworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h signalbatchworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args cadence.WorkflowService_SignalBatchWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'WorkflowService' procedure 'SignalBatchWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.SignalBatchWorkflowExecution(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := cadence.WorkflowService_SignalBatchWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type signalwithstartworkflowexecution_NoWireHandler struct{ impl Interface }
 
 func (h signalwithstartworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
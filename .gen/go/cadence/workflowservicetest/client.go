@@ -1193,6 +1193,37 @@ func (mr *_MockClientRecorder) ScanWorkflowExecutions(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ScanWorkflowExecutions", args...)
 }
 
+// SignalBatchWorkflowExecution responds to a SignalBatchWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().SignalBatchWorkflowExecution(gomock.Any(), ...).Return(...)
+//   ... := client.SignalBatchWorkflowExecution(...)
+func (m *MockClient) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	_SignalRequest *shared.SignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _SignalRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "SignalBatchWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) SignalBatchWorkflowExecution(
+	ctx interface{},
+	_SignalRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _SignalRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "SignalBatchWorkflowExecution", args...)
+}
+
 // SignalWithStartWorkflowExecution responds to a SignalWithStartWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -83,6 +83,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*replicator.GetDLQReplicationMessagesResponse, error)
 
+	GetDomainReplicationWatermark(
+		ctx context.Context,
+		Request *history.GetDomainReplicationWatermarkRequest,
+		opts ...yarpc.CallOption,
+	) (*history.GetDomainReplicationWatermarkResponse, error)
+
 	GetFailoverInfo(
 		ctx context.Context,
 		Request *history.GetFailoverInfoRequest,
@@ -95,6 +101,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.GetMutableStateResponse, error)
 
+	GetReplicationAckStates(
+		ctx context.Context,
+		Request *shared.GetReplicationAckStatesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.GetReplicationAckStatesResponse, error)
+
 	GetReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetReplicationMessagesRequest,
@@ -143,6 +155,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ReconcileWorkflowRetention(
+		ctx context.Context,
+		Request *history.ReconcileWorkflowRetentionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -257,6 +275,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	SignalBatchWorkflowExecution(
+		ctx context.Context,
+		SignalRequest *history.SignalBatchWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	SignalWithStartWorkflowExecution(
 		ctx context.Context,
 		SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
@@ -519,6 +543,34 @@ func (c client) GetDLQReplicationMessages(
 	return
 }
 
+func (c client) GetDomainReplicationWatermark(
+	ctx context.Context,
+	_Request *history.GetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetDomainReplicationWatermarkResponse, err error) {
+
+	var result history.HistoryService_GetDomainReplicationWatermark_Result
+	args := history.HistoryService_GetDomainReplicationWatermark_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetDomainReplicationWatermark_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetFailoverInfo(
 	ctx context.Context,
 	_Request *history.GetFailoverInfoRequest,
@@ -575,6 +627,34 @@ func (c client) GetMutableState(
 	return
 }
 
+func (c client) GetReplicationAckStates(
+	ctx context.Context,
+	_Request *shared.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetReplicationAckStatesResponse, err error) {
+
+	var result history.HistoryService_GetReplicationAckStates_Result
+	args := history.HistoryService_GetReplicationAckStates_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = history.HistoryService_GetReplicationAckStates_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetReplicationMessages(
 	ctx context.Context,
 	_Request *replicator.GetReplicationMessagesRequest,
@@ -799,6 +879,34 @@ func (c client) ReapplyEvents(
 	return
 }
 
+func (c client) ReconcileWorkflowRetention(
+	ctx context.Context,
+	_Request *history.ReconcileWorkflowRetentionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_ReconcileWorkflowRetention_Result
+	args := history.HistoryService_ReconcileWorkflowRetention_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_ReconcileWorkflowRetention_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -1331,6 +1439,34 @@ func (c client) ScheduleDecisionTask(
 	return
 }
 
+func (c client) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	_SignalRequest *history.SignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result history.HistoryService_SignalBatchWorkflowExecution_Result
+	args := history.HistoryService_SignalBatchWorkflowExecution_Helper.Args(_SignalRequest)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = history.HistoryService_SignalBatchWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	_SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
@@ -76,6 +76,11 @@ type Interface interface {
 		Request *replicator.GetDLQReplicationMessagesRequest,
 	) (*replicator.GetDLQReplicationMessagesResponse, error)
 
+	GetDomainReplicationWatermark(
+		ctx context.Context,
+		Request *history.GetDomainReplicationWatermarkRequest,
+	) (*history.GetDomainReplicationWatermarkResponse, error)
+
 	GetFailoverInfo(
 		ctx context.Context,
 		Request *history.GetFailoverInfoRequest,
@@ -86,6 +91,11 @@ type Interface interface {
 		GetRequest *history.GetMutableStateRequest,
 	) (*history.GetMutableStateResponse, error)
 
+	GetReplicationAckStates(
+		ctx context.Context,
+		Request *shared.GetReplicationAckStatesRequest,
+	) (*shared.GetReplicationAckStatesResponse, error)
+
 	GetReplicationMessages(
 		ctx context.Context,
 		Request *replicator.GetReplicationMessagesRequest,
@@ -126,6 +136,11 @@ type Interface interface {
 		ReapplyEventsRequest *history.ReapplyEventsRequest,
 	) error
 
+	ReconcileWorkflowRetention(
+		ctx context.Context,
+		Request *history.ReconcileWorkflowRetentionRequest,
+	) error
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -221,6 +236,11 @@ type Interface interface {
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
 	) error
 
+	SignalBatchWorkflowExecution(
+		ctx context.Context,
+		SignalRequest *history.SignalBatchWorkflowExecutionRequest,
+	) error
+
 	SignalWithStartWorkflowExecution(
 		ctx context.Context,
 		SignalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
@@ -347,6 +367,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetDomainReplicationWatermark",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetDomainReplicationWatermark),
+					NoWire: getdomainreplicationwatermark_NoWireHandler{impl},
+				},
+				Signature:    "GetDomainReplicationWatermark(Request *history.GetDomainReplicationWatermarkRequest) (*history.GetDomainReplicationWatermarkResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetFailoverInfo",
 				HandlerSpec: thrift.HandlerSpec{
@@ -371,6 +403,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "GetReplicationAckStates",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.GetReplicationAckStates),
+					NoWire: getreplicationackstates_NoWireHandler{impl},
+				},
+				Signature:    "GetReplicationAckStates(Request *shared.GetReplicationAckStatesRequest) (*shared.GetReplicationAckStatesResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetReplicationMessages",
 				HandlerSpec: thrift.HandlerSpec{
@@ -467,6 +511,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ReconcileWorkflowRetention",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ReconcileWorkflowRetention),
+					NoWire: reconcileworkflowretention_NoWireHandler{impl},
+				},
+				Signature:    "ReconcileWorkflowRetention(Request *history.ReconcileWorkflowRetentionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RecordActivityTaskHeartbeat",
 				HandlerSpec: thrift.HandlerSpec{
@@ -695,6 +751,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "SignalBatchWorkflowExecution",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.SignalBatchWorkflowExecution),
+					NoWire: signalbatchworkflowexecution_NoWireHandler{impl},
+				},
+				Signature:    "SignalBatchWorkflowExecution(SignalRequest *history.SignalBatchWorkflowExecutionRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "SignalWithStartWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -769,7 +837,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 42)
+	procedures := make([]transport.Procedure, 0, 46)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -990,6 +1058,36 @@ func (h handler) GetDLQReplicationMessages(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) GetDomainReplicationWatermark(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetDomainReplicationWatermark_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetDomainReplicationWatermark': %w", err)
+	}
+
+	success, appErr := h.impl.GetDomainReplicationWatermark(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_GetDomainReplicationWatermark_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetFailoverInfo(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_GetFailoverInfo_Args
 	if err := args.FromWire(body); err != nil {
@@ -1050,6 +1148,36 @@ func (h handler) GetMutableState(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) GetReplicationAckStates(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_GetReplicationAckStates_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'GetReplicationAckStates': %w", err)
+	}
+
+	success, appErr := h.impl.GetReplicationAckStates(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_GetReplicationAckStates_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) GetReplicationMessages(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_GetReplicationMessages_Args
 	if err := args.FromWire(body); err != nil {
@@ -1290,6 +1418,36 @@ func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) ReconcileWorkflowRetention(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReconcileWorkflowRetention_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'ReconcileWorkflowRetention': %w", err)
+	}
+
+	appErr := h.impl.ReconcileWorkflowRetention(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ReconcileWorkflowRetention_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_RecordActivityTaskHeartbeat_Args
 	if err := args.FromWire(body); err != nil {
@@ -1860,6 +2018,36 @@ func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) SignalBatchWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_SignalBatchWorkflowExecution_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'HistoryService' procedure 'SignalBatchWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.SignalBatchWorkflowExecution(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_SignalBatchWorkflowExecution_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) SignalWithStartWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_SignalWithStartWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -2299,6 +2487,43 @@ func (h getdlqreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Contex
 
 }
 
+type getdomainreplicationwatermark_NoWireHandler struct{ impl Interface }
+
+func (h getdomainreplicationwatermark_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_GetDomainReplicationWatermark_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetDomainReplicationWatermark': %w", err)
+	}
+
+	success, appErr := h.impl.GetDomainReplicationWatermark(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_GetDomainReplicationWatermark_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type getfailoverinfo_NoWireHandler struct{ impl Interface }
 
 func (h getfailoverinfo_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2373,6 +2598,43 @@ func (h getmutablestate_NoWireHandler) HandleNoWire(ctx context.Context, nwc *th
 
 }
 
+type getreplicationackstates_NoWireHandler struct{ impl Interface }
+
+func (h getreplicationackstates_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_GetReplicationAckStates_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'GetReplicationAckStates': %w", err)
+	}
+
+	success, appErr := h.impl.GetReplicationAckStates(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_GetReplicationAckStates_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type getreplicationmessages_NoWireHandler struct{ impl Interface }
 
 func (h getreplicationmessages_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2669,6 +2931,43 @@ func (h reapplyevents_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
+type reconcileworkflowretention_NoWireHandler struct{ impl Interface }
+
+func (h reconcileworkflowretention_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_ReconcileWorkflowRetention_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'ReconcileWorkflowRetention': %w", err)
+	}
+
+	appErr := h.impl.ReconcileWorkflowRetention(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_ReconcileWorkflowRetention_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type recordactivitytaskheartbeat_NoWireHandler struct{ impl Interface }
 
 func (h recordactivitytaskheartbeat_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -3372,6 +3671,43 @@ func (h scheduledecisiontask_NoWireHandler) HandleNoWire(ctx context.Context, nw
 
 }
 
+type signalbatchworkflowexecution_NoWireHandler struct{ impl Interface }
+
+func (h signalbatchworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args history.HistoryService_SignalBatchWorkflowExecution_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'HistoryService' procedure 'SignalBatchWorkflowExecution': %w", err)
+	}
+
+	appErr := h.impl.SignalBatchWorkflowExecution(ctx, args.SignalRequest)
+
+	hadError := appErr != nil
+	result, err := history.HistoryService_SignalBatchWorkflowExecution_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type signalwithstartworkflowexecution_NoWireHandler struct{ impl Interface }
 
 func (h signalwithstartworkflowexecution_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -297,6 +297,39 @@ func (mr *_MockClientRecorder) GetDLQReplicationMessages(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetDLQReplicationMessages", args...)
 }
 
+// GetDomainReplicationWatermark responds to a GetDomainReplicationWatermark call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().GetDomainReplicationWatermark(gomock.Any(), ...).Return(...)
+//   ... := client.GetDomainReplicationWatermark(...)
+func (m *MockClient) GetDomainReplicationWatermark(
+	ctx context.Context,
+	_Request *history.GetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (success *history.GetDomainReplicationWatermarkResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetDomainReplicationWatermark", args...)
+	success, _ = ret[i].(*history.GetDomainReplicationWatermarkResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetDomainReplicationWatermark(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetDomainReplicationWatermark", args...)
+}
+
 // GetFailoverInfo responds to a GetFailoverInfo call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -363,6 +396,39 @@ func (mr *_MockClientRecorder) GetMutableState(
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetMutableState", args...)
 }
 
+// GetReplicationAckStates responds to a GetReplicationAckStates call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().GetReplicationAckStates(gomock.Any(), ...).Return(...)
+//   ... := client.GetReplicationAckStates(...)
+func (m *MockClient) GetReplicationAckStates(
+	ctx context.Context,
+	_Request *shared.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.GetReplicationAckStatesResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "GetReplicationAckStates", args...)
+	success, _ = ret[i].(*shared.GetReplicationAckStatesResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) GetReplicationAckStates(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "GetReplicationAckStates", args...)
+}
+
 // GetReplicationMessages responds to a GetReplicationMessages call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -621,6 +687,37 @@ func (mr *_MockClientRecorder) ReapplyEvents(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ReapplyEvents", args...)
 }
 
+// ReconcileWorkflowRetention responds to a ReconcileWorkflowRetention call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().ReconcileWorkflowRetention(gomock.Any(), ...).Return(...)
+//   ... := client.ReconcileWorkflowRetention(...)
+func (m *MockClient) ReconcileWorkflowRetention(
+	ctx context.Context,
+	_Request *history.ReconcileWorkflowRetentionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReconcileWorkflowRetention", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReconcileWorkflowRetention(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReconcileWorkflowRetention", args...)
+}
+
 // RecordActivityTaskHeartbeat responds to a RecordActivityTaskHeartbeat call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -1224,6 +1321,37 @@ func (mr *_MockClientRecorder) ScheduleDecisionTask(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ScheduleDecisionTask", args...)
 }
 
+// SignalBatchWorkflowExecution responds to a SignalBatchWorkflowExecution call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().SignalBatchWorkflowExecution(gomock.Any(), ...).Return(...)
+//   ... := client.SignalBatchWorkflowExecution(...)
+func (m *MockClient) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	_SignalRequest *history.SignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _SignalRequest}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "SignalBatchWorkflowExecution", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) SignalBatchWorkflowExecution(
+	ctx interface{},
+	_SignalRequest interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _SignalRequest}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "SignalBatchWorkflowExecution", args...)
+}
+
 // SignalWithStartWorkflowExecution responds to a SignalWithStartWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
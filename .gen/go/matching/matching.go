@@ -53,6 +53,11 @@ type AddActivityTaskRequest struct {
 	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	Source                        *TaskSource               `json:"source,omitempty"`
 	ForwardedFrom                 *string                   `json:"forwardedFrom,omitempty"`
+	Ephemeral                     *bool                     `json:"ephemeral,omitempty"`
+	AffinityKey                   *string                   `json:"affinityKey,omitempty"`
+	Attempt                       *int64                    `json:"attempt,omitempty"`
+	ShadowSourceTaskList          *string                   `json:"shadowSourceTaskList,omitempty"`
+	ShadowMetadataOnly            *bool                     `json:"shadowMetadataOnly,omitempty"`
 }
 
 // ToWire translates a AddActivityTaskRequest struct into a Thrift-level intermediate
@@ -72,7 +77,7 @@ type AddActivityTaskRequest struct {
 //   }
 func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [8]wire.Field
+		fields [13]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -142,6 +147,46 @@ func (v *AddActivityTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.Ephemeral != nil {
+		w, err = wire.NewValueBool(*(v.Ephemeral)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.AffinityKey != nil {
+		w, err = wire.NewValueString(*(v.AffinityKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.ShadowSourceTaskList != nil {
+		w, err = wire.NewValueString(*(v.ShadowSourceTaskList)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.ShadowMetadataOnly != nil {
+		w, err = wire.NewValueBool(*(v.ShadowMetadataOnly)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -261,6 +306,56 @@ func (v *AddActivityTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Ephemeral = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AffinityKey = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ShadowSourceTaskList = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ShadowMetadataOnly = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -373,6 +468,66 @@ func (v *AddActivityTaskRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Ephemeral != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Ephemeral)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AffinityKey != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.AffinityKey)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Attempt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Attempt)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ShadowSourceTaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ShadowSourceTaskList)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ShadowMetadataOnly != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.ShadowMetadataOnly)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -472,6 +627,46 @@ func (v *AddActivityTaskRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 80 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Ephemeral = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.AffinityKey = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Attempt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ShadowSourceTaskList = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 120 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.ShadowMetadataOnly = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -501,7 +696,7 @@ func (v *AddActivityTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [8]string
+	var fields [13]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -535,6 +730,26 @@ func (v *AddActivityTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
 		i++
 	}
+	if v.Ephemeral != nil {
+		fields[i] = fmt.Sprintf("Ephemeral: %v", *(v.Ephemeral))
+		i++
+	}
+	if v.AffinityKey != nil {
+		fields[i] = fmt.Sprintf("AffinityKey: %v", *(v.AffinityKey))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ShadowSourceTaskList != nil {
+		fields[i] = fmt.Sprintf("ShadowSourceTaskList: %v", *(v.ShadowSourceTaskList))
+		i++
+	}
+	if v.ShadowMetadataOnly != nil {
+		fields[i] = fmt.Sprintf("ShadowMetadataOnly: %v", *(v.ShadowMetadataOnly))
+		i++
+	}
 
 	return fmt.Sprintf("AddActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -579,6 +794,16 @@ func _TaskSource_EqualsPtr(lhs, rhs *TaskSource) bool {
 	return lhs == nil && rhs == nil
 }
 
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this AddActivityTaskRequest match the
 // provided AddActivityTaskRequest.
 //
@@ -613,6 +838,21 @@ func (v *AddActivityTaskRequest) Equals(rhs *AddActivityTaskRequest) bool {
 	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Ephemeral, rhs.Ephemeral) {
+		return false
+	}
+	if !_String_EqualsPtr(v.AffinityKey, rhs.AffinityKey) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ShadowSourceTaskList, rhs.ShadowSourceTaskList) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ShadowMetadataOnly, rhs.ShadowMetadataOnly) {
+		return false
+	}
 
 	return true
 }
@@ -647,6 +887,21 @@ func (v *AddActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.ForwardedFrom != nil {
 		enc.AddString("forwardedFrom", *v.ForwardedFrom)
 	}
+	if v.Ephemeral != nil {
+		enc.AddBool("ephemeral", *v.Ephemeral)
+	}
+	if v.AffinityKey != nil {
+		enc.AddString("affinityKey", *v.AffinityKey)
+	}
+	if v.Attempt != nil {
+		enc.AddInt64("attempt", *v.Attempt)
+	}
+	if v.ShadowSourceTaskList != nil {
+		enc.AddString("shadowSourceTaskList", *v.ShadowSourceTaskList)
+	}
+	if v.ShadowMetadataOnly != nil {
+		enc.AddBool("shadowMetadataOnly", *v.ShadowMetadataOnly)
+	}
 	return err
 }
 
@@ -770,6 +1025,81 @@ func (v *AddActivityTaskRequest) IsSetForwardedFrom() bool {
 	return v != nil && v.ForwardedFrom != nil
 }
 
+// GetEphemeral returns the value of Ephemeral if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetEphemeral() (o bool) {
+	if v != nil && v.Ephemeral != nil {
+		return *v.Ephemeral
+	}
+
+	return
+}
+
+// IsSetEphemeral returns true if Ephemeral is not nil.
+func (v *AddActivityTaskRequest) IsSetEphemeral() bool {
+	return v != nil && v.Ephemeral != nil
+}
+
+// GetAffinityKey returns the value of AffinityKey if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetAffinityKey() (o string) {
+	if v != nil && v.AffinityKey != nil {
+		return *v.AffinityKey
+	}
+
+	return
+}
+
+// IsSetAffinityKey returns true if AffinityKey is not nil.
+func (v *AddActivityTaskRequest) IsSetAffinityKey() bool {
+	return v != nil && v.AffinityKey != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetAttempt() (o int64) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *AddActivityTaskRequest) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetShadowSourceTaskList returns the value of ShadowSourceTaskList if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetShadowSourceTaskList() (o string) {
+	if v != nil && v.ShadowSourceTaskList != nil {
+		return *v.ShadowSourceTaskList
+	}
+
+	return
+}
+
+// IsSetShadowSourceTaskList returns true if ShadowSourceTaskList is not nil.
+func (v *AddActivityTaskRequest) IsSetShadowSourceTaskList() bool {
+	return v != nil && v.ShadowSourceTaskList != nil
+}
+
+// GetShadowMetadataOnly returns the value of ShadowMetadataOnly if it is set or its
+// zero value if it is unset.
+func (v *AddActivityTaskRequest) GetShadowMetadataOnly() (o bool) {
+	if v != nil && v.ShadowMetadataOnly != nil {
+		return *v.ShadowMetadataOnly
+	}
+
+	return
+}
+
+// IsSetShadowMetadataOnly returns true if ShadowMetadataOnly is not nil.
+func (v *AddActivityTaskRequest) IsSetShadowMetadataOnly() bool {
+	return v != nil && v.ShadowMetadataOnly != nil
+}
+
 type AddDecisionTaskRequest struct {
 	DomainUUID                    *string                   `json:"domainUUID,omitempty"`
 	Execution                     *shared.WorkflowExecution `json:"execution,omitempty"`
@@ -778,6 +1108,11 @@ type AddDecisionTaskRequest struct {
 	ScheduleToStartTimeoutSeconds *int32                    `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	Source                        *TaskSource               `json:"source,omitempty"`
 	ForwardedFrom                 *string                   `json:"forwardedFrom,omitempty"`
+	Ephemeral                     *bool                     `json:"ephemeral,omitempty"`
+	AffinityKey                   *string                   `json:"affinityKey,omitempty"`
+	Attempt                       *int64                    `json:"attempt,omitempty"`
+	ShadowSourceTaskList          *string                   `json:"shadowSourceTaskList,omitempty"`
+	ShadowMetadataOnly            *bool                     `json:"shadowMetadataOnly,omitempty"`
 }
 
 // ToWire translates a AddDecisionTaskRequest struct into a Thrift-level intermediate
@@ -797,7 +1132,7 @@ type AddDecisionTaskRequest struct {
 //   }
 func (v *AddDecisionTaskRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [12]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -859,20 +1194,60 @@ func (v *AddDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a AddDecisionTaskRequest struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a AddDecisionTaskRequest struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
+	if v.Ephemeral != nil {
+		w, err = wire.NewValueBool(*(v.Ephemeral)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.AffinityKey != nil {
+		w, err = wire.NewValueString(*(v.AffinityKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.ShadowSourceTaskList != nil {
+		w, err = wire.NewValueString(*(v.ShadowSourceTaskList)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.ShadowMetadataOnly != nil {
+		w, err = wire.NewValueBool(*(v.ShadowMetadataOnly)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AddDecisionTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AddDecisionTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
 //   }
 //
 //   var v AddDecisionTaskRequest
@@ -950,6 +1325,56 @@ func (v *AddDecisionTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Ephemeral = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AffinityKey = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ShadowSourceTaskList = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.ShadowMetadataOnly = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1050,6 +1475,66 @@ func (v *AddDecisionTaskRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Ephemeral != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Ephemeral)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AffinityKey != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.AffinityKey)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Attempt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Attempt)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ShadowSourceTaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ShadowSourceTaskList)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ShadowMetadataOnly != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.ShadowMetadataOnly)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -1123,6 +1608,46 @@ func (v *AddDecisionTaskRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 70 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Ephemeral = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.AffinityKey = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Attempt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ShadowSourceTaskList = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.ShadowMetadataOnly = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -1152,7 +1677,7 @@ func (v *AddDecisionTaskRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [12]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
@@ -1182,6 +1707,26 @@ func (v *AddDecisionTaskRequest) String() string {
 		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
 		i++
 	}
+	if v.Ephemeral != nil {
+		fields[i] = fmt.Sprintf("Ephemeral: %v", *(v.Ephemeral))
+		i++
+	}
+	if v.AffinityKey != nil {
+		fields[i] = fmt.Sprintf("AffinityKey: %v", *(v.AffinityKey))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.ShadowSourceTaskList != nil {
+		fields[i] = fmt.Sprintf("ShadowSourceTaskList: %v", *(v.ShadowSourceTaskList))
+		i++
+	}
+	if v.ShadowMetadataOnly != nil {
+		fields[i] = fmt.Sprintf("ShadowMetadataOnly: %v", *(v.ShadowMetadataOnly))
+		i++
+	}
 
 	return fmt.Sprintf("AddDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -1217,6 +1762,21 @@ func (v *AddDecisionTaskRequest) Equals(rhs *AddDecisionTaskRequest) bool {
 	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
 		return false
 	}
+	if !_Bool_EqualsPtr(v.Ephemeral, rhs.Ephemeral) {
+		return false
+	}
+	if !_String_EqualsPtr(v.AffinityKey, rhs.AffinityKey) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ShadowSourceTaskList, rhs.ShadowSourceTaskList) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.ShadowMetadataOnly, rhs.ShadowMetadataOnly) {
+		return false
+	}
 
 	return true
 }
@@ -1248,6 +1808,21 @@ func (v *AddDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.ForwardedFrom != nil {
 		enc.AddString("forwardedFrom", *v.ForwardedFrom)
 	}
+	if v.Ephemeral != nil {
+		enc.AddBool("ephemeral", *v.Ephemeral)
+	}
+	if v.AffinityKey != nil {
+		enc.AddString("affinityKey", *v.AffinityKey)
+	}
+	if v.Attempt != nil {
+		enc.AddInt64("attempt", *v.Attempt)
+	}
+	if v.ShadowSourceTaskList != nil {
+		enc.AddString("shadowSourceTaskList", *v.ShadowSourceTaskList)
+	}
+	if v.ShadowMetadataOnly != nil {
+		enc.AddBool("shadowMetadataOnly", *v.ShadowMetadataOnly)
+	}
 	return err
 }
 
@@ -1356,6 +1931,81 @@ func (v *AddDecisionTaskRequest) IsSetForwardedFrom() bool {
 	return v != nil && v.ForwardedFrom != nil
 }
 
+// GetEphemeral returns the value of Ephemeral if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetEphemeral() (o bool) {
+	if v != nil && v.Ephemeral != nil {
+		return *v.Ephemeral
+	}
+
+	return
+}
+
+// IsSetEphemeral returns true if Ephemeral is not nil.
+func (v *AddDecisionTaskRequest) IsSetEphemeral() bool {
+	return v != nil && v.Ephemeral != nil
+}
+
+// GetAffinityKey returns the value of AffinityKey if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetAffinityKey() (o string) {
+	if v != nil && v.AffinityKey != nil {
+		return *v.AffinityKey
+	}
+
+	return
+}
+
+// IsSetAffinityKey returns true if AffinityKey is not nil.
+func (v *AddDecisionTaskRequest) IsSetAffinityKey() bool {
+	return v != nil && v.AffinityKey != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetAttempt() (o int64) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *AddDecisionTaskRequest) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetShadowSourceTaskList returns the value of ShadowSourceTaskList if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetShadowSourceTaskList() (o string) {
+	if v != nil && v.ShadowSourceTaskList != nil {
+		return *v.ShadowSourceTaskList
+	}
+
+	return
+}
+
+// IsSetShadowSourceTaskList returns true if ShadowSourceTaskList is not nil.
+func (v *AddDecisionTaskRequest) IsSetShadowSourceTaskList() bool {
+	return v != nil && v.ShadowSourceTaskList != nil
+}
+
+// GetShadowMetadataOnly returns the value of ShadowMetadataOnly if it is set or its
+// zero value if it is unset.
+func (v *AddDecisionTaskRequest) GetShadowMetadataOnly() (o bool) {
+	if v != nil && v.ShadowMetadataOnly != nil {
+		return *v.ShadowMetadataOnly
+	}
+
+	return
+}
+
+// IsSetShadowMetadataOnly returns true if ShadowMetadataOnly is not nil.
+func (v *AddDecisionTaskRequest) IsSetShadowMetadataOnly() bool {
+	return v != nil && v.ShadowMetadataOnly != nil
+}
+
 type CancelOutstandingPollRequest struct {
 	DomainUUID   *string          `json:"domainUUID,omitempty"`
 	TaskListType *int32           `json:"taskListType,omitempty"`
@@ -1754,12 +2404,13 @@ func (v *CancelOutstandingPollRequest) IsSetPollerID() bool {
 	return v != nil && v.PollerID != nil
 }
 
-type DescribeTaskListRequest struct {
-	DomainUUID  *string                         `json:"domainUUID,omitempty"`
-	DescRequest *shared.DescribeTaskListRequest `json:"descRequest,omitempty"`
+type DescribeTaskListConfigRequest struct {
+	DomainUUID   *string              `json:"domainUUID,omitempty"`
+	TaskList     *shared.TaskList     `json:"taskList,omitempty"`
+	TaskListType *shared.TaskListType `json:"taskListType,omitempty"`
 }
 
-// ToWire translates a DescribeTaskListRequest struct into a Thrift-level intermediate
+// ToWire translates a DescribeTaskListConfigRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1774,9 +2425,9 @@ type DescribeTaskListRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
+func (v *DescribeTaskListConfigRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1790,29 +2441,37 @@ func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DescRequest != nil {
-		w, err = v.DescRequest.ToWire()
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.TaskListType != nil {
+		w, err = v.TaskListType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListRequest_Read(w wire.Value) (*shared.DescribeTaskListRequest, error) {
-	var v shared.DescribeTaskListRequest
+func _TaskListType_Read(w wire.Value) (shared.TaskListType, error) {
+	var v shared.TaskListType
 	err := v.FromWire(w)
-	return &v, err
+	return v, err
 }
 
-// FromWire deserializes a DescribeTaskListRequest struct from its Thrift-level
+// FromWire deserializes a DescribeTaskListConfigRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeTaskListRequest struct
+// An error is returned if we were unable to build a DescribeTaskListConfigRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1820,12 +2479,12 @@ func _DescribeTaskListRequest_Read(w wire.Value) (*shared.DescribeTaskListReques
 //     return nil, err
 //   }
 //
-//   var v DescribeTaskListRequest
+//   var v DescribeTaskListConfigRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
+func (v *DescribeTaskListConfigRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -1842,7 +2501,17 @@ func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.DescRequest, err = _DescribeTaskListRequest_Read(field.Value)
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x shared.TaskListType
+				x, err = _TaskListType_Read(field.Value)
+				v.TaskListType = &x
 				if err != nil {
 					return err
 				}
@@ -1854,11 +2523,11 @@ func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a DescribeTaskListRequest struct directly into bytes, without going
+// Encode serializes a DescribeTaskListConfigRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a DescribeTaskListRequest struct could not be encoded.
-func (v *DescribeTaskListRequest) Encode(sw stream.Writer) error {
+// An error is returned if a DescribeTaskListConfigRequest struct could not be encoded.
+func (v *DescribeTaskListConfigRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -1875,11 +2544,23 @@ func (v *DescribeTaskListRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.DescRequest != nil {
+	if v.TaskList != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.DescRequest.Encode(sw); err != nil {
+		if err := v.TaskList.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskListType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.TaskListType.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -1890,18 +2571,18 @@ func (v *DescribeTaskListRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _DescribeTaskListRequest_Decode(sr stream.Reader) (*shared.DescribeTaskListRequest, error) {
-	var v shared.DescribeTaskListRequest
+func _TaskListType_Decode(sr stream.Reader) (shared.TaskListType, error) {
+	var v shared.TaskListType
 	err := v.Decode(sr)
-	return &v, err
+	return v, err
 }
 
-// Decode deserializes a DescribeTaskListRequest struct directly from its Thrift-level
+// Decode deserializes a DescribeTaskListConfigRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a DescribeTaskListRequest struct could not be generated from the wire
+// An error is returned if a DescribeTaskListConfigRequest struct could not be generated from the wire
 // representation.
-func (v *DescribeTaskListRequest) Decode(sr stream.Reader) error {
+func (v *DescribeTaskListConfigRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -1923,7 +2604,15 @@ func (v *DescribeTaskListRequest) Decode(sr stream.Reader) error {
 			}
 
 		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.DescRequest, err = _DescribeTaskListRequest_Decode(sr)
+			v.TaskList, err = _TaskList_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x shared.TaskListType
+			x, err = _TaskListType_Decode(sr)
+			v.TaskListType = &x
 			if err != nil {
 				return err
 			}
@@ -1950,32 +2639,46 @@ func (v *DescribeTaskListRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeTaskListRequest
+// String returns a readable string representation of a DescribeTaskListConfigRequest
 // struct.
-func (v *DescribeTaskListRequest) String() string {
+func (v *DescribeTaskListConfigRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.DescRequest != nil {
-		fields[i] = fmt.Sprintf("DescRequest: %v", v.DescRequest)
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskListType != nil {
+		fields[i] = fmt.Sprintf("TaskListType: %v", *(v.TaskListType))
 		i++
 	}
 
-	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeTaskListConfigRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this DescribeTaskListRequest match the
-// provided DescribeTaskListRequest.
+func _TaskListType_EqualsPtr(lhs, rhs *shared.TaskListType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this DescribeTaskListConfigRequest match the
+// provided DescribeTaskListConfigRequest.
 //
 // This function performs a deep comparison.
-func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
+func (v *DescribeTaskListConfigRequest) Equals(rhs *DescribeTaskListConfigRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -1984,7 +2687,10 @@ func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !((v.DescRequest == nil && rhs.DescRequest == nil) || (v.DescRequest != nil && rhs.DescRequest != nil && v.DescRequest.Equals(rhs.DescRequest))) {
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_TaskListType_EqualsPtr(v.TaskListType, rhs.TaskListType) {
 		return false
 	}
 
@@ -1992,23 +2698,26 @@ func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeTaskListRequest.
-func (v *DescribeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeTaskListConfigRequest.
+func (v *DescribeTaskListConfigRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.DescRequest != nil {
-		err = multierr.Append(err, enc.AddObject("descRequest", v.DescRequest))
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskListType != nil {
+		err = multierr.Append(err, enc.AddObject("taskListType", *v.TaskListType))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *DescribeTaskListRequest) GetDomainUUID() (o string) {
+func (v *DescribeTaskListConfigRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -2017,31 +2726,62 @@ func (v *DescribeTaskListRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *DescribeTaskListRequest) IsSetDomainUUID() bool {
+func (v *DescribeTaskListConfigRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetDescRequest returns the value of DescRequest if it is set or its
+// GetTaskList returns the value of TaskList if it is set or its
 // zero value if it is unset.
-func (v *DescribeTaskListRequest) GetDescRequest() (o *shared.DescribeTaskListRequest) {
-	if v != nil && v.DescRequest != nil {
-		return v.DescRequest
+func (v *DescribeTaskListConfigRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
 	}
 
 	return
 }
 
-// IsSetDescRequest returns true if DescRequest is not nil.
-func (v *DescribeTaskListRequest) IsSetDescRequest() bool {
-	return v != nil && v.DescRequest != nil
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *DescribeTaskListConfigRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
 }
 
-type ListTaskListPartitionsRequest struct {
-	Domain   *string          `json:"domain,omitempty"`
-	TaskList *shared.TaskList `json:"taskList,omitempty"`
+// GetTaskListType returns the value of TaskListType if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigRequest) GetTaskListType() (o shared.TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+
+	return
 }
 
-// ToWire translates a ListTaskListPartitionsRequest struct into a Thrift-level intermediate
+// IsSetTaskListType returns true if TaskListType is not nil.
+func (v *DescribeTaskListConfigRequest) IsSetTaskListType() bool {
+	return v != nil && v.TaskListType != nil
+}
+
+type DescribeTaskListConfigResponse struct {
+	NumReadPartitions                   *int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions                  *int32 `json:"numWritePartitions,omitempty"`
+	ForwarderMaxOutstandingPolls        *int32 `json:"forwarderMaxOutstandingPolls,omitempty"`
+	ForwarderMaxOutstandingTasks        *int32 `json:"forwarderMaxOutstandingTasks,omitempty"`
+	ForwarderMaxRatePerSecond           *int32 `json:"forwarderMaxRatePerSecond,omitempty"`
+	ForwarderMaxChildrenPerNode         *int32 `json:"forwarderMaxChildrenPerNode,omitempty"`
+	EnableSyncMatch                     *bool  `json:"enableSyncMatch,omitempty"`
+	EnableEphemeralTaskList             *bool  `json:"enableEphemeralTaskList,omitempty"`
+	LongPollExpirationIntervalInSeconds *int64 `json:"longPollExpirationIntervalInSeconds,omitempty"`
+	RangeSize                           *int64 `json:"rangeSize,omitempty"`
+	GetTasksBatchSize                   *int32 `json:"getTasksBatchSize,omitempty"`
+	UpdateAckIntervalInSeconds          *int64 `json:"updateAckIntervalInSeconds,omitempty"`
+	IdleTaskListCheckIntervalInSeconds  *int64 `json:"idleTaskListCheckIntervalInSeconds,omitempty"`
+	MaxTaskListIdleTimeInSeconds        *int64 `json:"maxTaskListIdleTimeInSeconds,omitempty"`
+	MinTaskThrottlingBurstSize          *int32 `json:"minTaskThrottlingBurstSize,omitempty"`
+	MaxTaskDeleteBatchSize              *int32 `json:"maxTaskDeleteBatchSize,omitempty"`
+	OutstandingTaskAppendsThreshold     *int32 `json:"outstandingTaskAppendsThreshold,omitempty"`
+	MaxTaskBatchSize                    *int32 `json:"maxTaskBatchSize,omitempty"`
+}
+
+// ToWire translates a DescribeTaskListConfigResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2056,39 +2796,167 @@ type ListTaskListPartitionsRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ListTaskListPartitionsRequest) ToWire() (wire.Value, error) {
+func (v *DescribeTaskListConfigResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [18]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.NumReadPartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumReadPartitions)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.NumWritePartitions != nil {
+		w, err = wire.NewValueI32(*(v.NumWritePartitions)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.ForwarderMaxOutstandingPolls != nil {
+		w, err = wire.NewValueI32(*(v.ForwarderMaxOutstandingPolls)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ForwarderMaxOutstandingTasks != nil {
+		w, err = wire.NewValueI32(*(v.ForwarderMaxOutstandingTasks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ForwarderMaxRatePerSecond != nil {
+		w, err = wire.NewValueI32(*(v.ForwarderMaxRatePerSecond)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.ForwarderMaxChildrenPerNode != nil {
+		w, err = wire.NewValueI32(*(v.ForwarderMaxChildrenPerNode)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.EnableSyncMatch != nil {
+		w, err = wire.NewValueBool(*(v.EnableSyncMatch)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.EnableEphemeralTaskList != nil {
+		w, err = wire.NewValueBool(*(v.EnableEphemeralTaskList)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.LongPollExpirationIntervalInSeconds != nil {
+		w, err = wire.NewValueI64(*(v.LongPollExpirationIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.RangeSize != nil {
+		w, err = wire.NewValueI64(*(v.RangeSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.GetTasksBatchSize != nil {
+		w, err = wire.NewValueI32(*(v.GetTasksBatchSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.UpdateAckIntervalInSeconds != nil {
+		w, err = wire.NewValueI64(*(v.UpdateAckIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.IdleTaskListCheckIntervalInSeconds != nil {
+		w, err = wire.NewValueI64(*(v.IdleTaskListCheckIntervalInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.MaxTaskListIdleTimeInSeconds != nil {
+		w, err = wire.NewValueI64(*(v.MaxTaskListIdleTimeInSeconds)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.MinTaskThrottlingBurstSize != nil {
+		w, err = wire.NewValueI32(*(v.MinTaskThrottlingBurstSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+	if v.MaxTaskDeleteBatchSize != nil {
+		w, err = wire.NewValueI32(*(v.MaxTaskDeleteBatchSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 160, Value: w}
+		i++
+	}
+	if v.OutstandingTaskAppendsThreshold != nil {
+		w, err = wire.NewValueI32(*(v.OutstandingTaskAppendsThreshold)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 170, Value: w}
+		i++
+	}
+	if v.MaxTaskBatchSize != nil {
+		w, err = wire.NewValueI32(*(v.MaxTaskBatchSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 180, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ListTaskListPartitionsRequest struct from its Thrift-level
+// FromWire deserializes a DescribeTaskListConfigResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ListTaskListPartitionsRequest struct
+// An error is returned if we were unable to build a DescribeTaskListConfigResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2096,54 +2964,216 @@ func (v *ListTaskListPartitionsRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ListTaskListPartitionsRequest
+//   var v DescribeTaskListConfigResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ListTaskListPartitionsRequest) FromWire(w wire.Value) error {
+func (v *DescribeTaskListConfigResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumReadPartitions = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.NumWritePartitions = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
-
-	return nil
-}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ForwarderMaxOutstandingPolls = &x
+				if err != nil {
+					return err
+				}
 
-// Encode serializes a ListTaskListPartitionsRequest struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a ListTaskListPartitionsRequest struct could not be encoded.
-func (v *ListTaskListPartitionsRequest) Encode(sw stream.Writer) error {
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ForwarderMaxOutstandingTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ForwarderMaxRatePerSecond = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ForwarderMaxChildrenPerNode = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.EnableSyncMatch = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.EnableEphemeralTaskList = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LongPollExpirationIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RangeSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.GetTasksBatchSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.UpdateAckIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.IdleTaskListCheckIntervalInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxTaskListIdleTimeInSeconds = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 150:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MinTaskThrottlingBurstSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 160:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaxTaskDeleteBatchSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 170:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.OutstandingTaskAppendsThreshold = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 180:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaxTaskBatchSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a DescribeTaskListConfigResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DescribeTaskListConfigResponse struct could not be encoded.
+func (v *DescribeTaskListConfigResponse) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.NumReadPartitions != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
+		if err := sw.WriteInt32(*(v.NumReadPartitions)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2151,11 +3181,11 @@ func (v *ListTaskListPartitionsRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.TaskList != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.NumWritePartitions != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.TaskList.Encode(sw); err != nil {
+		if err := sw.WriteInt32(*(v.NumWritePartitions)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2163,301 +3193,155 @@ func (v *ListTaskListPartitionsRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
-
-// Decode deserializes a ListTaskListPartitionsRequest struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a ListTaskListPartitionsRequest struct could not be generated from the wire
-// representation.
-func (v *ListTaskListPartitionsRequest) Decode(sr stream.Reader) error {
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
+	if v.ForwarderMaxOutstandingPolls != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ForwarderMaxOutstandingPolls)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
+	if v.ForwarderMaxOutstandingTasks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ForwarderMaxOutstandingTasks)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	for ok {
-		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Domain = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.TaskList, err = _TaskList_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
+	if v.ForwarderMaxRatePerSecond != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
+			return err
 		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
+		if err := sw.WriteInt32(*(v.ForwarderMaxRatePerSecond)); err != nil {
 			return err
 		}
-
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+		if err := sw.WriteFieldEnd(); err != nil {
 			return err
 		}
 	}
 
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
+	if v.ForwarderMaxChildrenPerNode != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ForwarderMaxChildrenPerNode)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return nil
-}
-
-// String returns a readable string representation of a ListTaskListPartitionsRequest
-// struct.
-func (v *ListTaskListPartitionsRequest) String() string {
-	if v == nil {
-		return "<nil>"
+	if v.EnableSyncMatch != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.EnableSyncMatch)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	var fields [2]string
-	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
+	if v.EnableEphemeralTaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.EnableEphemeralTaskList)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
+
+	if v.LongPollExpirationIntervalInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.LongPollExpirationIntervalInSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return fmt.Sprintf("ListTaskListPartitionsRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this ListTaskListPartitionsRequest match the
-// provided ListTaskListPartitionsRequest.
-//
-// This function performs a deep comparison.
-func (v *ListTaskListPartitionsRequest) Equals(rhs *ListTaskListPartitionsRequest) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-
-	return true
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ListTaskListPartitionsRequest.
-func (v *ListTaskListPartitionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
-	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
-	}
-	return err
-}
-
-// GetDomain returns the value of Domain if it is set or its
-// zero value if it is unset.
-func (v *ListTaskListPartitionsRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
-	}
-
-	return
-}
-
-// IsSetDomain returns true if Domain is not nil.
-func (v *ListTaskListPartitionsRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
-}
-
-// GetTaskList returns the value of TaskList if it is set or its
-// zero value if it is unset.
-func (v *ListTaskListPartitionsRequest) GetTaskList() (o *shared.TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+	if v.RangeSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.RangeSize)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return
-}
-
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *ListTaskListPartitionsRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
-}
-
-type PollForActivityTaskRequest struct {
-	DomainUUID    *string                            `json:"domainUUID,omitempty"`
-	PollerID      *string                            `json:"pollerID,omitempty"`
-	PollRequest   *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
-	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
-}
-
-// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
-	var (
-		fields [4]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
-		if err != nil {
-			return w, err
+	if v.GetTasksBatchSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TI32}); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.PollerID != nil {
-		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
-		if err != nil {
-			return w, err
+		if err := sw.WriteInt32(*(v.GetTasksBatchSize)); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 15, Value: w}
-		i++
-	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
-		if err != nil {
-			return w, err
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
 	}
-	if v.ForwardedFrom != nil {
-		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
-		if err != nil {
-			return w, err
+
+	if v.UpdateAckIntervalInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.UpdateAckIntervalInSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
 	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
-	var v shared.PollForActivityTaskRequest
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a PollForActivityTaskRequest struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a PollForActivityTaskRequest struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v PollForActivityTaskRequest
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 15:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.PollerID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ForwardedFrom = &x
-				if err != nil {
-					return err
-				}
-
-			}
+	if v.IdleTaskListCheckIntervalInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.IdleTaskListCheckIntervalInSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
 	}
 
-	return nil
-}
-
-// Encode serializes a PollForActivityTaskRequest struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a PollForActivityTaskRequest struct could not be encoded.
-func (v *PollForActivityTaskRequest) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
+	if v.MaxTaskListIdleTimeInSeconds != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.MaxTaskListIdleTimeInSeconds)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if v.DomainUUID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.MinTaskThrottlingBurstSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.DomainUUID)); err != nil {
+		if err := sw.WriteInt32(*(v.MinTaskThrottlingBurstSize)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2465,11 +3349,11 @@ func (v *PollForActivityTaskRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.PollerID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 15, Type: wire.TBinary}); err != nil {
+	if v.MaxTaskDeleteBatchSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 160, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.PollerID)); err != nil {
+		if err := sw.WriteInt32(*(v.MaxTaskDeleteBatchSize)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2477,11 +3361,11 @@ func (v *PollForActivityTaskRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.PollRequest != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.OutstandingTaskAppendsThreshold != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 170, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := v.PollRequest.Encode(sw); err != nil {
+		if err := sw.WriteInt32(*(v.OutstandingTaskAppendsThreshold)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2489,11 +3373,11 @@ func (v *PollForActivityTaskRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ForwardedFrom != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.MaxTaskBatchSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 180, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ForwardedFrom)); err != nil {
+		if err := sw.WriteInt32(*(v.MaxTaskBatchSize)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2504,18 +3388,12 @@ func (v *PollForActivityTaskRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _PollForActivityTaskRequest_Decode(sr stream.Reader) (*shared.PollForActivityTaskRequest, error) {
-	var v shared.PollForActivityTaskRequest
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a PollForActivityTaskRequest struct directly from its Thrift-level
+// Decode deserializes a DescribeTaskListConfigResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a PollForActivityTaskRequest struct could not be generated from the wire
+// An error is returned if a DescribeTaskListConfigResponse struct could not be generated from the wire
 // representation.
-func (v *PollForActivityTaskRequest) Decode(sr stream.Reader) error {
+func (v *DescribeTaskListConfigResponse) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -2528,32 +3406,146 @@ func (v *PollForActivityTaskRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.DomainUUID = &x
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.NumReadPartitions = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 15 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.PollerID = &x
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.NumWritePartitions = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.PollRequest, err = _PollForActivityTaskRequest_Decode(sr)
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ForwarderMaxOutstandingPolls = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ForwardedFrom = &x
+		case fh.ID == 40 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ForwarderMaxOutstandingTasks = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ForwarderMaxRatePerSecond = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ForwarderMaxChildrenPerNode = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.EnableSyncMatch = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.EnableEphemeralTaskList = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.LongPollExpirationIntervalInSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.RangeSize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.GetTasksBatchSize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 120 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.UpdateAckIntervalInSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 130 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.IdleTaskListCheckIntervalInSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 140 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.MaxTaskListIdleTimeInSeconds = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 150 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MinTaskThrottlingBurstSize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 160 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MaxTaskDeleteBatchSize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 170 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.OutstandingTaskAppendsThreshold = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 180 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.MaxTaskBatchSize = &x
 			if err != nil {
 				return err
 			}
@@ -2580,55 +3572,153 @@ func (v *PollForActivityTaskRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForActivityTaskRequest
+// String returns a readable string representation of a DescribeTaskListConfigResponse
 // struct.
-func (v *PollForActivityTaskRequest) String() string {
+func (v *DescribeTaskListConfigResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [18]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.NumReadPartitions != nil {
+		fields[i] = fmt.Sprintf("NumReadPartitions: %v", *(v.NumReadPartitions))
 		i++
 	}
-	if v.PollerID != nil {
-		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
+	if v.NumWritePartitions != nil {
+		fields[i] = fmt.Sprintf("NumWritePartitions: %v", *(v.NumWritePartitions))
 		i++
 	}
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+	if v.ForwarderMaxOutstandingPolls != nil {
+		fields[i] = fmt.Sprintf("ForwarderMaxOutstandingPolls: %v", *(v.ForwarderMaxOutstandingPolls))
 		i++
 	}
-	if v.ForwardedFrom != nil {
-		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+	if v.ForwarderMaxOutstandingTasks != nil {
+		fields[i] = fmt.Sprintf("ForwarderMaxOutstandingTasks: %v", *(v.ForwarderMaxOutstandingTasks))
+		i++
+	}
+	if v.ForwarderMaxRatePerSecond != nil {
+		fields[i] = fmt.Sprintf("ForwarderMaxRatePerSecond: %v", *(v.ForwarderMaxRatePerSecond))
+		i++
+	}
+	if v.ForwarderMaxChildrenPerNode != nil {
+		fields[i] = fmt.Sprintf("ForwarderMaxChildrenPerNode: %v", *(v.ForwarderMaxChildrenPerNode))
+		i++
+	}
+	if v.EnableSyncMatch != nil {
+		fields[i] = fmt.Sprintf("EnableSyncMatch: %v", *(v.EnableSyncMatch))
+		i++
+	}
+	if v.EnableEphemeralTaskList != nil {
+		fields[i] = fmt.Sprintf("EnableEphemeralTaskList: %v", *(v.EnableEphemeralTaskList))
+		i++
+	}
+	if v.LongPollExpirationIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("LongPollExpirationIntervalInSeconds: %v", *(v.LongPollExpirationIntervalInSeconds))
+		i++
+	}
+	if v.RangeSize != nil {
+		fields[i] = fmt.Sprintf("RangeSize: %v", *(v.RangeSize))
+		i++
+	}
+	if v.GetTasksBatchSize != nil {
+		fields[i] = fmt.Sprintf("GetTasksBatchSize: %v", *(v.GetTasksBatchSize))
+		i++
+	}
+	if v.UpdateAckIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("UpdateAckIntervalInSeconds: %v", *(v.UpdateAckIntervalInSeconds))
+		i++
+	}
+	if v.IdleTaskListCheckIntervalInSeconds != nil {
+		fields[i] = fmt.Sprintf("IdleTaskListCheckIntervalInSeconds: %v", *(v.IdleTaskListCheckIntervalInSeconds))
+		i++
+	}
+	if v.MaxTaskListIdleTimeInSeconds != nil {
+		fields[i] = fmt.Sprintf("MaxTaskListIdleTimeInSeconds: %v", *(v.MaxTaskListIdleTimeInSeconds))
+		i++
+	}
+	if v.MinTaskThrottlingBurstSize != nil {
+		fields[i] = fmt.Sprintf("MinTaskThrottlingBurstSize: %v", *(v.MinTaskThrottlingBurstSize))
+		i++
+	}
+	if v.MaxTaskDeleteBatchSize != nil {
+		fields[i] = fmt.Sprintf("MaxTaskDeleteBatchSize: %v", *(v.MaxTaskDeleteBatchSize))
+		i++
+	}
+	if v.OutstandingTaskAppendsThreshold != nil {
+		fields[i] = fmt.Sprintf("OutstandingTaskAppendsThreshold: %v", *(v.OutstandingTaskAppendsThreshold))
+		i++
+	}
+	if v.MaxTaskBatchSize != nil {
+		fields[i] = fmt.Sprintf("MaxTaskBatchSize: %v", *(v.MaxTaskBatchSize))
 		i++
 	}
 
-	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeTaskListConfigResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForActivityTaskRequest match the
-// provided PollForActivityTaskRequest.
+// Equals returns true if all the fields of this DescribeTaskListConfigResponse match the
+// provided DescribeTaskListConfigResponse.
 //
 // This function performs a deep comparison.
-func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
+func (v *DescribeTaskListConfigResponse) Equals(rhs *DescribeTaskListConfigResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+	if !_I32_EqualsPtr(v.NumReadPartitions, rhs.NumReadPartitions) {
 		return false
 	}
-	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
+	if !_I32_EqualsPtr(v.NumWritePartitions, rhs.NumWritePartitions) {
 		return false
 	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+	if !_I32_EqualsPtr(v.ForwarderMaxOutstandingPolls, rhs.ForwarderMaxOutstandingPolls) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+	if !_I32_EqualsPtr(v.ForwarderMaxOutstandingTasks, rhs.ForwarderMaxOutstandingTasks) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ForwarderMaxRatePerSecond, rhs.ForwarderMaxRatePerSecond) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ForwarderMaxChildrenPerNode, rhs.ForwarderMaxChildrenPerNode) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.EnableSyncMatch, rhs.EnableSyncMatch) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.EnableEphemeralTaskList, rhs.EnableEphemeralTaskList) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LongPollExpirationIntervalInSeconds, rhs.LongPollExpirationIntervalInSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.RangeSize, rhs.RangeSize) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.GetTasksBatchSize, rhs.GetTasksBatchSize) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.UpdateAckIntervalInSeconds, rhs.UpdateAckIntervalInSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.IdleTaskListCheckIntervalInSeconds, rhs.IdleTaskListCheckIntervalInSeconds) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaxTaskListIdleTimeInSeconds, rhs.MaxTaskListIdleTimeInSeconds) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MinTaskThrottlingBurstSize, rhs.MinTaskThrottlingBurstSize) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaxTaskDeleteBatchSize, rhs.MaxTaskDeleteBatchSize) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.OutstandingTaskAppendsThreshold, rhs.OutstandingTaskAppendsThreshold) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaxTaskBatchSize, rhs.MaxTaskBatchSize) {
 		return false
 	}
 
@@ -2636,111 +3726,361 @@ func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) boo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForActivityTaskRequest.
-func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeTaskListConfigResponse.
+func (v *DescribeTaskListConfigResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
+	if v.NumReadPartitions != nil {
+		enc.AddInt32("numReadPartitions", *v.NumReadPartitions)
 	}
-	if v.PollerID != nil {
-		enc.AddString("pollerID", *v.PollerID)
+	if v.NumWritePartitions != nil {
+		enc.AddInt32("numWritePartitions", *v.NumWritePartitions)
 	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	if v.ForwarderMaxOutstandingPolls != nil {
+		enc.AddInt32("forwarderMaxOutstandingPolls", *v.ForwarderMaxOutstandingPolls)
 	}
-	if v.ForwardedFrom != nil {
-		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	if v.ForwarderMaxOutstandingTasks != nil {
+		enc.AddInt32("forwarderMaxOutstandingTasks", *v.ForwarderMaxOutstandingTasks)
+	}
+	if v.ForwarderMaxRatePerSecond != nil {
+		enc.AddInt32("forwarderMaxRatePerSecond", *v.ForwarderMaxRatePerSecond)
+	}
+	if v.ForwarderMaxChildrenPerNode != nil {
+		enc.AddInt32("forwarderMaxChildrenPerNode", *v.ForwarderMaxChildrenPerNode)
+	}
+	if v.EnableSyncMatch != nil {
+		enc.AddBool("enableSyncMatch", *v.EnableSyncMatch)
+	}
+	if v.EnableEphemeralTaskList != nil {
+		enc.AddBool("enableEphemeralTaskList", *v.EnableEphemeralTaskList)
+	}
+	if v.LongPollExpirationIntervalInSeconds != nil {
+		enc.AddInt64("longPollExpirationIntervalInSeconds", *v.LongPollExpirationIntervalInSeconds)
+	}
+	if v.RangeSize != nil {
+		enc.AddInt64("rangeSize", *v.RangeSize)
+	}
+	if v.GetTasksBatchSize != nil {
+		enc.AddInt32("getTasksBatchSize", *v.GetTasksBatchSize)
+	}
+	if v.UpdateAckIntervalInSeconds != nil {
+		enc.AddInt64("updateAckIntervalInSeconds", *v.UpdateAckIntervalInSeconds)
+	}
+	if v.IdleTaskListCheckIntervalInSeconds != nil {
+		enc.AddInt64("idleTaskListCheckIntervalInSeconds", *v.IdleTaskListCheckIntervalInSeconds)
+	}
+	if v.MaxTaskListIdleTimeInSeconds != nil {
+		enc.AddInt64("maxTaskListIdleTimeInSeconds", *v.MaxTaskListIdleTimeInSeconds)
+	}
+	if v.MinTaskThrottlingBurstSize != nil {
+		enc.AddInt32("minTaskThrottlingBurstSize", *v.MinTaskThrottlingBurstSize)
+	}
+	if v.MaxTaskDeleteBatchSize != nil {
+		enc.AddInt32("maxTaskDeleteBatchSize", *v.MaxTaskDeleteBatchSize)
+	}
+	if v.OutstandingTaskAppendsThreshold != nil {
+		enc.AddInt32("outstandingTaskAppendsThreshold", *v.OutstandingTaskAppendsThreshold)
+	}
+	if v.MaxTaskBatchSize != nil {
+		enc.AddInt32("maxTaskBatchSize", *v.MaxTaskBatchSize)
 	}
 	return err
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetNumReadPartitions returns the value of NumReadPartitions if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *DescribeTaskListConfigResponse) GetNumReadPartitions() (o int32) {
+	if v != nil && v.NumReadPartitions != nil {
+		return *v.NumReadPartitions
 	}
 
 	return
 }
 
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *PollForActivityTaskRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
+// IsSetNumReadPartitions returns true if NumReadPartitions is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetNumReadPartitions() bool {
+	return v != nil && v.NumReadPartitions != nil
 }
 
-// GetPollerID returns the value of PollerID if it is set or its
+// GetNumWritePartitions returns the value of NumWritePartitions if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetPollerID() (o string) {
-	if v != nil && v.PollerID != nil {
-		return *v.PollerID
+func (v *DescribeTaskListConfigResponse) GetNumWritePartitions() (o int32) {
+	if v != nil && v.NumWritePartitions != nil {
+		return *v.NumWritePartitions
 	}
 
 	return
 }
 
-// IsSetPollerID returns true if PollerID is not nil.
-func (v *PollForActivityTaskRequest) IsSetPollerID() bool {
-	return v != nil && v.PollerID != nil
+// IsSetNumWritePartitions returns true if NumWritePartitions is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetNumWritePartitions() bool {
+	return v != nil && v.NumWritePartitions != nil
 }
 
-// GetPollRequest returns the value of PollRequest if it is set or its
+// GetForwarderMaxOutstandingPolls returns the value of ForwarderMaxOutstandingPolls if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetPollRequest() (o *shared.PollForActivityTaskRequest) {
-	if v != nil && v.PollRequest != nil {
-		return v.PollRequest
+func (v *DescribeTaskListConfigResponse) GetForwarderMaxOutstandingPolls() (o int32) {
+	if v != nil && v.ForwarderMaxOutstandingPolls != nil {
+		return *v.ForwarderMaxOutstandingPolls
 	}
 
 	return
 }
 
-// IsSetPollRequest returns true if PollRequest is not nil.
-func (v *PollForActivityTaskRequest) IsSetPollRequest() bool {
-	return v != nil && v.PollRequest != nil
+// IsSetForwarderMaxOutstandingPolls returns true if ForwarderMaxOutstandingPolls is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetForwarderMaxOutstandingPolls() bool {
+	return v != nil && v.ForwarderMaxOutstandingPolls != nil
 }
 
-// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// GetForwarderMaxOutstandingTasks returns the value of ForwarderMaxOutstandingTasks if it is set or its
 // zero value if it is unset.
-func (v *PollForActivityTaskRequest) GetForwardedFrom() (o string) {
-	if v != nil && v.ForwardedFrom != nil {
-		return *v.ForwardedFrom
+func (v *DescribeTaskListConfigResponse) GetForwarderMaxOutstandingTasks() (o int32) {
+	if v != nil && v.ForwarderMaxOutstandingTasks != nil {
+		return *v.ForwarderMaxOutstandingTasks
 	}
 
 	return
 }
 
-// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
-func (v *PollForActivityTaskRequest) IsSetForwardedFrom() bool {
-	return v != nil && v.ForwardedFrom != nil
+// IsSetForwarderMaxOutstandingTasks returns true if ForwarderMaxOutstandingTasks is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetForwarderMaxOutstandingTasks() bool {
+	return v != nil && v.ForwarderMaxOutstandingTasks != nil
 }
 
-type PollForDecisionTaskRequest struct {
-	DomainUUID    *string                            `json:"domainUUID,omitempty"`
-	PollerID      *string                            `json:"pollerID,omitempty"`
-	PollRequest   *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
-	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
+// GetForwarderMaxRatePerSecond returns the value of ForwarderMaxRatePerSecond if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetForwarderMaxRatePerSecond() (o int32) {
+	if v != nil && v.ForwarderMaxRatePerSecond != nil {
+		return *v.ForwarderMaxRatePerSecond
+	}
+
+	return
 }
 
-// ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
+// IsSetForwarderMaxRatePerSecond returns true if ForwarderMaxRatePerSecond is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetForwarderMaxRatePerSecond() bool {
+	return v != nil && v.ForwarderMaxRatePerSecond != nil
+}
+
+// GetForwarderMaxChildrenPerNode returns the value of ForwarderMaxChildrenPerNode if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetForwarderMaxChildrenPerNode() (o int32) {
+	if v != nil && v.ForwarderMaxChildrenPerNode != nil {
+		return *v.ForwarderMaxChildrenPerNode
+	}
+
+	return
+}
+
+// IsSetForwarderMaxChildrenPerNode returns true if ForwarderMaxChildrenPerNode is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetForwarderMaxChildrenPerNode() bool {
+	return v != nil && v.ForwarderMaxChildrenPerNode != nil
+}
+
+// GetEnableSyncMatch returns the value of EnableSyncMatch if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetEnableSyncMatch() (o bool) {
+	if v != nil && v.EnableSyncMatch != nil {
+		return *v.EnableSyncMatch
+	}
+
+	return
+}
+
+// IsSetEnableSyncMatch returns true if EnableSyncMatch is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetEnableSyncMatch() bool {
+	return v != nil && v.EnableSyncMatch != nil
+}
+
+// GetEnableEphemeralTaskList returns the value of EnableEphemeralTaskList if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetEnableEphemeralTaskList() (o bool) {
+	if v != nil && v.EnableEphemeralTaskList != nil {
+		return *v.EnableEphemeralTaskList
+	}
+
+	return
+}
+
+// IsSetEnableEphemeralTaskList returns true if EnableEphemeralTaskList is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetEnableEphemeralTaskList() bool {
+	return v != nil && v.EnableEphemeralTaskList != nil
+}
+
+// GetLongPollExpirationIntervalInSeconds returns the value of LongPollExpirationIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetLongPollExpirationIntervalInSeconds() (o int64) {
+	if v != nil && v.LongPollExpirationIntervalInSeconds != nil {
+		return *v.LongPollExpirationIntervalInSeconds
+	}
+
+	return
+}
+
+// IsSetLongPollExpirationIntervalInSeconds returns true if LongPollExpirationIntervalInSeconds is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetLongPollExpirationIntervalInSeconds() bool {
+	return v != nil && v.LongPollExpirationIntervalInSeconds != nil
+}
+
+// GetRangeSize returns the value of RangeSize if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetRangeSize() (o int64) {
+	if v != nil && v.RangeSize != nil {
+		return *v.RangeSize
+	}
+
+	return
+}
+
+// IsSetRangeSize returns true if RangeSize is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetRangeSize() bool {
+	return v != nil && v.RangeSize != nil
+}
+
+// GetGetTasksBatchSize returns the value of GetTasksBatchSize if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetGetTasksBatchSize() (o int32) {
+	if v != nil && v.GetTasksBatchSize != nil {
+		return *v.GetTasksBatchSize
+	}
+
+	return
+}
+
+// IsSetGetTasksBatchSize returns true if GetTasksBatchSize is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetGetTasksBatchSize() bool {
+	return v != nil && v.GetTasksBatchSize != nil
+}
+
+// GetUpdateAckIntervalInSeconds returns the value of UpdateAckIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetUpdateAckIntervalInSeconds() (o int64) {
+	if v != nil && v.UpdateAckIntervalInSeconds != nil {
+		return *v.UpdateAckIntervalInSeconds
+	}
+
+	return
+}
+
+// IsSetUpdateAckIntervalInSeconds returns true if UpdateAckIntervalInSeconds is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetUpdateAckIntervalInSeconds() bool {
+	return v != nil && v.UpdateAckIntervalInSeconds != nil
+}
+
+// GetIdleTaskListCheckIntervalInSeconds returns the value of IdleTaskListCheckIntervalInSeconds if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetIdleTaskListCheckIntervalInSeconds() (o int64) {
+	if v != nil && v.IdleTaskListCheckIntervalInSeconds != nil {
+		return *v.IdleTaskListCheckIntervalInSeconds
+	}
+
+	return
+}
+
+// IsSetIdleTaskListCheckIntervalInSeconds returns true if IdleTaskListCheckIntervalInSeconds is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetIdleTaskListCheckIntervalInSeconds() bool {
+	return v != nil && v.IdleTaskListCheckIntervalInSeconds != nil
+}
+
+// GetMaxTaskListIdleTimeInSeconds returns the value of MaxTaskListIdleTimeInSeconds if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetMaxTaskListIdleTimeInSeconds() (o int64) {
+	if v != nil && v.MaxTaskListIdleTimeInSeconds != nil {
+		return *v.MaxTaskListIdleTimeInSeconds
+	}
+
+	return
+}
+
+// IsSetMaxTaskListIdleTimeInSeconds returns true if MaxTaskListIdleTimeInSeconds is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetMaxTaskListIdleTimeInSeconds() bool {
+	return v != nil && v.MaxTaskListIdleTimeInSeconds != nil
+}
+
+// GetMinTaskThrottlingBurstSize returns the value of MinTaskThrottlingBurstSize if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetMinTaskThrottlingBurstSize() (o int32) {
+	if v != nil && v.MinTaskThrottlingBurstSize != nil {
+		return *v.MinTaskThrottlingBurstSize
+	}
+
+	return
+}
+
+// IsSetMinTaskThrottlingBurstSize returns true if MinTaskThrottlingBurstSize is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetMinTaskThrottlingBurstSize() bool {
+	return v != nil && v.MinTaskThrottlingBurstSize != nil
+}
+
+// GetMaxTaskDeleteBatchSize returns the value of MaxTaskDeleteBatchSize if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetMaxTaskDeleteBatchSize() (o int32) {
+	if v != nil && v.MaxTaskDeleteBatchSize != nil {
+		return *v.MaxTaskDeleteBatchSize
+	}
+
+	return
+}
+
+// IsSetMaxTaskDeleteBatchSize returns true if MaxTaskDeleteBatchSize is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetMaxTaskDeleteBatchSize() bool {
+	return v != nil && v.MaxTaskDeleteBatchSize != nil
+}
+
+// GetOutstandingTaskAppendsThreshold returns the value of OutstandingTaskAppendsThreshold if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetOutstandingTaskAppendsThreshold() (o int32) {
+	if v != nil && v.OutstandingTaskAppendsThreshold != nil {
+		return *v.OutstandingTaskAppendsThreshold
+	}
+
+	return
+}
+
+// IsSetOutstandingTaskAppendsThreshold returns true if OutstandingTaskAppendsThreshold is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetOutstandingTaskAppendsThreshold() bool {
+	return v != nil && v.OutstandingTaskAppendsThreshold != nil
+}
+
+// GetMaxTaskBatchSize returns the value of MaxTaskBatchSize if it is set or its
+// zero value if it is unset.
+func (v *DescribeTaskListConfigResponse) GetMaxTaskBatchSize() (o int32) {
+	if v != nil && v.MaxTaskBatchSize != nil {
+		return *v.MaxTaskBatchSize
+	}
+
+	return
+}
+
+// IsSetMaxTaskBatchSize returns true if MaxTaskBatchSize is not nil.
+func (v *DescribeTaskListConfigResponse) IsSetMaxTaskBatchSize() bool {
+	return v != nil && v.MaxTaskBatchSize != nil
+}
+
+type DescribeTaskListRequest struct {
+	DomainUUID  *string                         `json:"domainUUID,omitempty"`
+	DescRequest *shared.DescribeTaskListRequest `json:"descRequest,omitempty"`
+}
+
+// ToWire translates a DescribeTaskListRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
 //     return err
 //   }
 //
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
+func (v *DescribeTaskListRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -2754,45 +4094,29 @@ func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.PollerID != nil {
-		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 15, Value: w}
-		i++
-	}
-	if v.PollRequest != nil {
-		w, err = v.PollRequest.ToWire()
+	if v.DescRequest != nil {
+		w, err = v.DescRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.ForwardedFrom != nil {
-		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
-	var v shared.PollForDecisionTaskRequest
+func _DescribeTaskListRequest_Read(w wire.Value) (*shared.DescribeTaskListRequest, error) {
+	var v shared.DescribeTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a PollForDecisionTaskRequest struct from its Thrift-level
+// FromWire deserializes a DescribeTaskListRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForDecisionTaskRequest struct
+// An error is returned if we were unable to build a DescribeTaskListRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2800,12 +4124,12 @@ func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTask
 //     return nil, err
 //   }
 //
-//   var v PollForDecisionTaskRequest
+//   var v DescribeTaskListRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
+func (v *DescribeTaskListRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -2819,30 +4143,10 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 15:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.PollerID = &x
-				if err != nil {
-					return err
-				}
-
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ForwardedFrom = &x
+				v.DescRequest, err = _DescribeTaskListRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2854,11 +4158,11 @@ func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a PollForDecisionTaskRequest struct directly into bytes, without going
+// Encode serializes a DescribeTaskListRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a PollForDecisionTaskRequest struct could not be encoded.
-func (v *PollForDecisionTaskRequest) Encode(sw stream.Writer) error {
+// An error is returned if a DescribeTaskListRequest struct could not be encoded.
+func (v *DescribeTaskListRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -2875,35 +4179,11 @@ func (v *PollForDecisionTaskRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.PollerID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 15, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.PollerID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.PollRequest != nil {
+	if v.DescRequest != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.PollRequest.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ForwardedFrom != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.ForwardedFrom)); err != nil {
+		if err := v.DescRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -2914,18 +4194,18 @@ func (v *PollForDecisionTaskRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _PollForDecisionTaskRequest_Decode(sr stream.Reader) (*shared.PollForDecisionTaskRequest, error) {
-	var v shared.PollForDecisionTaskRequest
+func _DescribeTaskListRequest_Decode(sr stream.Reader) (*shared.DescribeTaskListRequest, error) {
+	var v shared.DescribeTaskListRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a PollForDecisionTaskRequest struct directly from its Thrift-level
+// Decode deserializes a DescribeTaskListRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a PollForDecisionTaskRequest struct could not be generated from the wire
+// An error is returned if a DescribeTaskListRequest struct could not be generated from the wire
 // representation.
-func (v *PollForDecisionTaskRequest) Decode(sr stream.Reader) error {
+func (v *DescribeTaskListRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -2946,24 +4226,8 @@ func (v *PollForDecisionTaskRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		case fh.ID == 15 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.PollerID = &x
-			if err != nil {
-				return err
-			}
-
 		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.PollRequest, err = _PollForDecisionTaskRequest_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ForwardedFrom = &x
+			v.DescRequest, err = _DescribeTaskListRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -2990,40 +4254,32 @@ func (v *PollForDecisionTaskRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForDecisionTaskRequest
+// String returns a readable string representation of a DescribeTaskListRequest
 // struct.
-func (v *PollForDecisionTaskRequest) String() string {
+func (v *DescribeTaskListRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [2]string
 	i := 0
 	if v.DomainUUID != nil {
 		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.PollerID != nil {
-		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
-		i++
-	}
-	if v.PollRequest != nil {
-		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
-		i++
-	}
-	if v.ForwardedFrom != nil {
-		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+	if v.DescRequest != nil {
+		fields[i] = fmt.Sprintf("DescRequest: %v", v.DescRequest)
 		i++
 	}
 
-	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DescribeTaskListRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForDecisionTaskRequest match the
-// provided PollForDecisionTaskRequest.
+// Equals returns true if all the fields of this DescribeTaskListRequest match the
+// provided DescribeTaskListRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
+func (v *DescribeTaskListRequest) Equals(rhs *DescribeTaskListRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -3032,13 +4288,7 @@ func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) boo
 	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
-		return false
-	}
-	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+	if !((v.DescRequest == nil && rhs.DescRequest == nil) || (v.DescRequest != nil && rhs.DescRequest != nil && v.DescRequest.Equals(rhs.DescRequest))) {
 		return false
 	}
 
@@ -3046,29 +4296,23 @@ func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) boo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForDecisionTaskRequest.
-func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeTaskListRequest.
+func (v *DescribeTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
 	if v.DomainUUID != nil {
 		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.PollerID != nil {
-		enc.AddString("pollerID", *v.PollerID)
-	}
-	if v.PollRequest != nil {
-		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
-	}
-	if v.ForwardedFrom != nil {
-		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	if v.DescRequest != nil {
+		err = multierr.Append(err, enc.AddObject("descRequest", v.DescRequest))
 	}
 	return err
 }
 
 // GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetDomainUUID() (o string) {
+func (v *DescribeTaskListRequest) GetDomainUUID() (o string) {
 	if v != nil && v.DomainUUID != nil {
 		return *v.DomainUUID
 	}
@@ -3077,114 +4321,31 @@ func (v *PollForDecisionTaskRequest) GetDomainUUID() (o string) {
 }
 
 // IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *PollForDecisionTaskRequest) IsSetDomainUUID() bool {
+func (v *DescribeTaskListRequest) IsSetDomainUUID() bool {
 	return v != nil && v.DomainUUID != nil
 }
 
-// GetPollerID returns the value of PollerID if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetPollerID() (o string) {
-	if v != nil && v.PollerID != nil {
-		return *v.PollerID
-	}
-
-	return
-}
-
-// IsSetPollerID returns true if PollerID is not nil.
-func (v *PollForDecisionTaskRequest) IsSetPollerID() bool {
-	return v != nil && v.PollerID != nil
-}
-
-// GetPollRequest returns the value of PollRequest if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetPollRequest() (o *shared.PollForDecisionTaskRequest) {
-	if v != nil && v.PollRequest != nil {
-		return v.PollRequest
-	}
-
-	return
-}
-
-// IsSetPollRequest returns true if PollRequest is not nil.
-func (v *PollForDecisionTaskRequest) IsSetPollRequest() bool {
-	return v != nil && v.PollRequest != nil
-}
-
-// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// GetDescRequest returns the value of DescRequest if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskRequest) GetForwardedFrom() (o string) {
-	if v != nil && v.ForwardedFrom != nil {
-		return *v.ForwardedFrom
+func (v *DescribeTaskListRequest) GetDescRequest() (o *shared.DescribeTaskListRequest) {
+	if v != nil && v.DescRequest != nil {
+		return v.DescRequest
 	}
 
 	return
 }
 
-// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
-func (v *PollForDecisionTaskRequest) IsSetForwardedFrom() bool {
-	return v != nil && v.ForwardedFrom != nil
-}
-
-type PollForDecisionTaskResponse struct {
-	TaskToken                 []byte                           `json:"taskToken,omitempty"`
-	WorkflowExecution         *shared.WorkflowExecution        `json:"workflowExecution,omitempty"`
-	WorkflowType              *shared.WorkflowType             `json:"workflowType,omitempty"`
-	PreviousStartedEventId    *int64                           `json:"previousStartedEventId,omitempty"`
-	StartedEventId            *int64                           `json:"startedEventId,omitempty"`
-	Attempt                   *int64                           `json:"attempt,omitempty"`
-	NextEventId               *int64                           `json:"nextEventId,omitempty"`
-	BacklogCountHint          *int64                           `json:"backlogCountHint,omitempty"`
-	StickyExecutionEnabled    *bool                            `json:"stickyExecutionEnabled,omitempty"`
-	Query                     *shared.WorkflowQuery            `json:"query,omitempty"`
-	DecisionInfo              *shared.TransientDecisionInfo    `json:"decisionInfo,omitempty"`
-	WorkflowExecutionTaskList *shared.TaskList                 `json:"WorkflowExecutionTaskList,omitempty"`
-	EventStoreVersion         *int32                           `json:"eventStoreVersion,omitempty"`
-	BranchToken               []byte                           `json:"branchToken,omitempty"`
-	ScheduledTimestamp        *int64                           `json:"scheduledTimestamp,omitempty"`
-	StartedTimestamp          *int64                           `json:"startedTimestamp,omitempty"`
-	Queries                   map[string]*shared.WorkflowQuery `json:"queries,omitempty"`
-}
-
-type _Map_String_WorkflowQuery_MapItemList map[string]*shared.WorkflowQuery
-
-func (m _Map_String_WorkflowQuery_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[string]*shared.WorkflowQuery', key [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_WorkflowQuery_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_WorkflowQuery_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
+// IsSetDescRequest returns true if DescRequest is not nil.
+func (v *DescribeTaskListRequest) IsSetDescRequest() bool {
+	return v != nil && v.DescRequest != nil
 }
 
-func (_Map_String_WorkflowQuery_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
+type ListTaskListPartitionsRequest struct {
+	Domain   *string          `json:"domain,omitempty"`
+	TaskList *shared.TaskList `json:"taskList,omitempty"`
 }
 
-func (_Map_String_WorkflowQuery_MapItemList) Close() {}
-
-// ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
+// ToWire translates a ListTaskListPartitionsRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3199,205 +4360,333 @@ func (_Map_String_WorkflowQuery_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+func (v *ListTaskListPartitionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [17]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.TaskToken != nil {
-		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowType != nil {
-		w, err = v.WorkflowType.ToWire()
-		if err != nil {
-			return w, err
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ListTaskListPartitionsRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ListTaskListPartitionsRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ListTaskListPartitionsRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ListTaskListPartitionsRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
 	}
-	if v.PreviousStartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	return nil
+}
+
+// Encode serializes a ListTaskListPartitionsRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ListTaskListPartitionsRequest struct could not be encoded.
+func (v *ListTaskListPartitionsRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
 	}
-	if v.StartedEventId != nil {
-		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
-		if err != nil {
-			return w, err
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.Attempt != nil {
-		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
-		if err != nil {
-			return w, err
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 51, Value: w}
-		i++
-	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
 	}
-	if v.BacklogCountHint != nil {
-		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 65, Value: w}
-		i++
-	}
-	if v.StickyExecutionEnabled != nil {
-		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
-		if err != nil {
-			return w, err
+
+	if v.TaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 70, Value: w}
-		i++
-	}
-	if v.Query != nil {
-		w, err = v.Query.ToWire()
-		if err != nil {
-			return w, err
+		if err := v.TaskList.Encode(sw); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 80, Value: w}
-		i++
-	}
-	if v.DecisionInfo != nil {
-		w, err = v.DecisionInfo.ToWire()
-		if err != nil {
-			return w, err
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		fields[i] = wire.Field{ID: 90, Value: w}
-		i++
 	}
-	if v.WorkflowExecutionTaskList != nil {
-		w, err = v.WorkflowExecutionTaskList.ToWire()
-		if err != nil {
-			return w, err
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ListTaskListPartitionsRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ListTaskListPartitionsRequest struct could not be generated from the wire
+// representation.
+func (v *ListTaskListPartitionsRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.TaskList, err = _TaskList_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
 		}
-		fields[i] = wire.Field{ID: 100, Value: w}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ListTaskListPartitionsRequest
+// struct.
+func (v *ListTaskListPartitionsRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 110, Value: w}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
 		i++
 	}
-	if v.BranchToken != nil {
-		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
+
+	return fmt.Sprintf("ListTaskListPartitionsRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ListTaskListPartitionsRequest match the
+// provided ListTaskListPartitionsRequest.
+//
+// This function performs a deep comparison.
+func (v *ListTaskListPartitionsRequest) Equals(rhs *ListTaskListPartitionsRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListTaskListPartitionsRequest.
+func (v *ListTaskListPartitionsRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ListTaskListPartitionsRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ListTaskListPartitionsRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *ListTaskListPartitionsRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *ListTaskListPartitionsRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+type PollForActivityTaskRequest struct {
+	DomainUUID    *string                            `json:"domainUUID,omitempty"`
+	PollerID      *string                            `json:"pollerID,omitempty"`
+	PollRequest   *shared.PollForActivityTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
+}
+
+// ToWire translates a PollForActivityTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForActivityTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 120, Value: w}
+		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ScheduledTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
+	if v.PollerID != nil {
+		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 130, Value: w}
+		fields[i] = wire.Field{ID: 15, Value: w}
 		i++
 	}
-	if v.StartedTimestamp != nil {
-		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 140, Value: w}
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Queries != nil {
-		w, err = wire.NewValueMap(_Map_String_WorkflowQuery_MapItemList(v.Queries)), error(nil)
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 150, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
-	var v shared.WorkflowType
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _WorkflowQuery_Read(w wire.Value) (*shared.WorkflowQuery, error) {
-	var v shared.WorkflowQuery
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
-	var v shared.TransientDecisionInfo
+func _PollForActivityTaskRequest_Read(w wire.Value) (*shared.PollForActivityTaskRequest, error) {
+	var v shared.PollForActivityTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _Map_String_WorkflowQuery_Read(m wire.MapItemList) (map[string]*shared.WorkflowQuery, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make(map[string]*shared.WorkflowQuery, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := _WorkflowQuery_Read(x.Value)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
-// FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
+// FromWire deserializes a PollForActivityTaskRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a PollForDecisionTaskResponse struct
+// An error is returned if we were unable to build a PollForActivityTaskRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3405,165 +4694,49 @@ func _Map_String_WorkflowQuery_Read(m wire.MapItemList) (map[string]*shared.Work
 //     return nil, err
 //   }
 //
-//   var v PollForDecisionTaskResponse
+//   var v PollForActivityTaskRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+func (v *PollForActivityTaskRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
 			if field.Value.Type() == wire.TBinary {
-				v.TaskToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowType, err = _WorkflowType_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.PreviousStartedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 51:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.Attempt = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 65:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.BacklogCountHint = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 70:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.StickyExecutionEnabled = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 80:
-			if field.Value.Type() == wire.TStruct {
-				v.Query, err = _WorkflowQuery_Read(field.Value)
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 90:
-			if field.Value.Type() == wire.TStruct {
-				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PollerID = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		case 100:
+		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 110:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
+				v.PollRequest, err = _PollForActivityTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 120:
+		case 30:
 			if field.Value.Type() == wire.TBinary {
-				v.BranchToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 130:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ScheduledTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 140:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartedTimestamp = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 150:
-			if field.Value.Type() == wire.TMap {
-				v.Queries, err = _Map_String_WorkflowQuery_Read(field.Value.GetMap())
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ForwardedFrom = &x
 				if err != nil {
 					return err
 				}
@@ -3575,46 +4748,20 @@ func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _Map_String_WorkflowQuery_Encode(val map[string]*shared.WorkflowQuery, sw stream.Writer) error {
-
-	mh := stream.MapHeader{
-		KeyType:   wire.TBinary,
-		ValueType: wire.TStruct,
-		Length:    len(val),
-	}
-	if err := sw.WriteMapBegin(mh); err != nil {
-		return err
-	}
-
-	for k, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[string]*shared.WorkflowQuery', key [%v]: value is nil", k)
-		}
-		if err := sw.WriteString(k); err != nil {
-			return err
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
-		}
-	}
-
-	return sw.WriteMapEnd()
-}
-
-// Encode serializes a PollForDecisionTaskResponse struct directly into bytes, without going
+// Encode serializes a PollForActivityTaskRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a PollForDecisionTaskResponse struct could not be encoded.
-func (v *PollForDecisionTaskResponse) Encode(sw stream.Writer) error {
+// An error is returned if a PollForActivityTaskRequest struct could not be encoded.
+func (v *PollForActivityTaskRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.TaskToken != nil {
+	if v.DomainUUID != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.TaskToken); err != nil {
+		if err := sw.WriteString(*(v.DomainUUID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -3622,11 +4769,11 @@ func (v *PollForDecisionTaskResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.WorkflowExecution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.PollerID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 15, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.WorkflowExecution.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.PollerID)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -3634,11 +4781,11 @@ func (v *PollForDecisionTaskResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.WorkflowType != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+	if v.PollRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.WorkflowType.Encode(sw); err != nil {
+		if err := v.PollRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -3646,11 +4793,11 @@ func (v *PollForDecisionTaskResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.PreviousStartedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+	if v.ForwardedFrom != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.PreviousStartedEventId)); err != nil {
+		if err := sw.WriteString(*(v.ForwardedFrom)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -3658,229 +4805,21 @@ func (v *PollForDecisionTaskResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.StartedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartedEventId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+	return sw.WriteStructEnd()
+}
 
-	if v.Attempt != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 51, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.Attempt)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
+func _PollForActivityTaskRequest_Decode(sr stream.Reader) (*shared.PollForActivityTaskRequest, error) {
+	var v shared.PollForActivityTaskRequest
+	err := v.Decode(sr)
+	return &v, err
+}
 
-	if v.NextEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.NextEventId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.BacklogCountHint != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 65, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.BacklogCountHint)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.StickyExecutionEnabled != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBool}); err != nil {
-			return err
-		}
-		if err := sw.WriteBool(*(v.StickyExecutionEnabled)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Query != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Query.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.DecisionInfo != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.DecisionInfo.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.WorkflowExecutionTaskList != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.WorkflowExecutionTaskList.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.EventStoreVersion != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt32(*(v.EventStoreVersion)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.BranchToken != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.BranchToken); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ScheduledTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.ScheduledTimestamp)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.StartedTimestamp != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.StartedTimestamp)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Queries != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TMap}); err != nil {
-			return err
-		}
-		if err := _Map_String_WorkflowQuery_Encode(v.Queries, sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	return sw.WriteStructEnd()
-}
-
-func _WorkflowType_Decode(sr stream.Reader) (*shared.WorkflowType, error) {
-	var v shared.WorkflowType
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _WorkflowQuery_Decode(sr stream.Reader) (*shared.WorkflowQuery, error) {
-	var v shared.WorkflowQuery
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _TransientDecisionInfo_Decode(sr stream.Reader) (*shared.TransientDecisionInfo, error) {
-	var v shared.TransientDecisionInfo
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _Map_String_WorkflowQuery_Decode(sr stream.Reader) (map[string]*shared.WorkflowQuery, error) {
-	mh, err := sr.ReadMapBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
-		for i := 0; i < mh.Length; i++ {
-			if err := sr.Skip(mh.KeyType); err != nil {
-				return nil, err
-			}
-
-			if err := sr.Skip(mh.ValueType); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadMapEnd()
-	}
-
-	o := make(map[string]*shared.WorkflowQuery, mh.Length)
-	for i := 0; i < mh.Length; i++ {
-		k, err := sr.ReadString()
-		if err != nil {
-			return nil, err
-		}
-
-		v, err := _WorkflowQuery_Decode(sr)
-		if err != nil {
-			return nil, err
-		}
-
-		o[k] = v
-	}
-
-	if err = sr.ReadMapEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
-// Decode deserializes a PollForDecisionTaskResponse struct directly from its Thrift-level
+// Decode deserializes a PollForActivityTaskRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a PollForDecisionTaskResponse struct could not be generated from the wire
+// An error is returned if a PollForActivityTaskRequest struct could not be generated from the wire
 // representation.
-func (v *PollForDecisionTaskResponse) Decode(sr stream.Reader) error {
+func (v *PollForActivityTaskRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -3894,139 +4833,49 @@ func (v *PollForDecisionTaskResponse) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
-			v.TaskToken, err = sr.ReadBinary()
+			var x string
+			x, err = sr.ReadString()
+			v.DomainUUID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+		case fh.ID == 15 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.PollerID = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TStruct:
-			v.WorkflowType, err = _WorkflowType_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.PollRequest, err = _PollForActivityTaskRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.PreviousStartedEventId = &x
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ForwardedFrom = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartedEventId = &x
-			if err != nil {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
 				return err
 			}
+		}
 
-		case fh.ID == 51 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.Attempt = &x
-			if err != nil {
-				return err
-			}
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
 
-		case fh.ID == 60 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.NextEventId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 65 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.BacklogCountHint = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 70 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.StickyExecutionEnabled = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 80 && fh.Type == wire.TStruct:
-			v.Query, err = _WorkflowQuery_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 90 && fh.Type == wire.TStruct:
-			v.DecisionInfo, err = _TransientDecisionInfo_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 100 && fh.Type == wire.TStruct:
-			v.WorkflowExecutionTaskList, err = _TaskList_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 110 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.EventStoreVersion = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 120 && fh.Type == wire.TBinary:
-			v.BranchToken, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 130 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ScheduledTimestamp = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 140 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartedTimestamp = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 150 && fh.Type == wire.TMap:
-			v.Queries, err = _Map_String_WorkflowQuery_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
-
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
-		}
-	}
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
 
 	if err := sr.ReadStructEnd(); err != nil {
 		return err
@@ -4035,513 +4884,2983 @@ func (v *PollForDecisionTaskResponse) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a PollForDecisionTaskResponse
+// String returns a readable string representation of a PollForActivityTaskRequest
 // struct.
-func (v *PollForDecisionTaskResponse) String() string {
+func (v *PollForActivityTaskRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [17]string
+	var fields [4]string
 	i := 0
-	if v.TaskToken != nil {
-		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.WorkflowType != nil {
-		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
-		i++
-	}
-	if v.PreviousStartedEventId != nil {
-		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
-		i++
-	}
-	if v.StartedEventId != nil {
-		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
-		i++
-	}
-	if v.Attempt != nil {
-		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.BacklogCountHint != nil {
-		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
-		i++
-	}
-	if v.StickyExecutionEnabled != nil {
-		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
-		i++
-	}
-	if v.Query != nil {
-		fields[i] = fmt.Sprintf("Query: %v", v.Query)
-		i++
-	}
-	if v.DecisionInfo != nil {
-		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
-		i++
-	}
-	if v.WorkflowExecutionTaskList != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
-		i++
-	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
-		i++
-	}
-	if v.BranchToken != nil {
-		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
 		i++
 	}
-	if v.ScheduledTimestamp != nil {
-		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+	if v.PollerID != nil {
+		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
 		i++
 	}
-	if v.StartedTimestamp != nil {
-		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
 		i++
 	}
-	if v.Queries != nil {
-		fields[i] = fmt.Sprintf("Queries: %v", v.Queries)
+	if v.ForwardedFrom != nil {
+		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
 		i++
 	}
 
-	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _Bool_EqualsPtr(lhs, rhs *bool) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-func _Map_String_WorkflowQuery_Equals(lhs, rhs map[string]*shared.WorkflowQuery) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-	return true
+	return fmt.Sprintf("PollForActivityTaskRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this PollForDecisionTaskResponse match the
-// provided PollForDecisionTaskResponse.
+// Equals returns true if all the fields of this PollForActivityTaskRequest match the
+// provided PollForActivityTaskRequest.
 //
 // This function performs a deep comparison.
-func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
+func (v *PollForActivityTaskRequest) Equals(rhs *PollForActivityTaskRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
-		return false
-	}
-	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
-		return false
-	}
-	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
-		return false
-	}
-	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
-		return false
-	}
-	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
-		return false
-	}
-	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
+	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
 		return false
 	}
-	if !((v.Queries == nil && rhs.Queries == nil) || (v.Queries != nil && rhs.Queries != nil && _Map_String_WorkflowQuery_Equals(v.Queries, rhs.Queries))) {
+	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
 		return false
 	}
 
 	return true
 }
 
-type _Map_String_WorkflowQuery_Zapper map[string]*shared.WorkflowQuery
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_WorkflowQuery_Zapper.
-func (m _Map_String_WorkflowQuery_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of PollForDecisionTaskResponse.
-func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of PollForActivityTaskRequest.
+func (v *PollForActivityTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.TaskToken != nil {
-		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	if v.PollerID != nil {
+		enc.AddString("pollerID", *v.PollerID)
+	}
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	}
+	if v.ForwardedFrom != nil {
+		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *PollForActivityTaskRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetPollerID returns the value of PollerID if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetPollerID() (o string) {
+	if v != nil && v.PollerID != nil {
+		return *v.PollerID
+	}
+
+	return
+}
+
+// IsSetPollerID returns true if PollerID is not nil.
+func (v *PollForActivityTaskRequest) IsSetPollerID() bool {
+	return v != nil && v.PollerID != nil
+}
+
+// GetPollRequest returns the value of PollRequest if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetPollRequest() (o *shared.PollForActivityTaskRequest) {
+	if v != nil && v.PollRequest != nil {
+		return v.PollRequest
+	}
+
+	return
+}
+
+// IsSetPollRequest returns true if PollRequest is not nil.
+func (v *PollForActivityTaskRequest) IsSetPollRequest() bool {
+	return v != nil && v.PollRequest != nil
+}
+
+// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// zero value if it is unset.
+func (v *PollForActivityTaskRequest) GetForwardedFrom() (o string) {
+	if v != nil && v.ForwardedFrom != nil {
+		return *v.ForwardedFrom
+	}
+
+	return
+}
+
+// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
+func (v *PollForActivityTaskRequest) IsSetForwardedFrom() bool {
+	return v != nil && v.ForwardedFrom != nil
+}
+
+type PollForDecisionTaskRequest struct {
+	DomainUUID    *string                            `json:"domainUUID,omitempty"`
+	PollerID      *string                            `json:"pollerID,omitempty"`
+	PollRequest   *shared.PollForDecisionTaskRequest `json:"pollRequest,omitempty"`
+	ForwardedFrom *string                            `json:"forwardedFrom,omitempty"`
+}
+
+// ToWire translates a PollForDecisionTaskRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForDecisionTaskRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.PollerID != nil {
+		w, err = wire.NewValueString(*(v.PollerID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.PollRequest != nil {
+		w, err = v.PollRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _PollForDecisionTaskRequest_Read(w wire.Value) (*shared.PollForDecisionTaskRequest, error) {
+	var v shared.PollForDecisionTaskRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a PollForDecisionTaskRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForDecisionTaskRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForDecisionTaskRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForDecisionTaskRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PollerID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.PollRequest, err = _PollForDecisionTaskRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ForwardedFrom = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a PollForDecisionTaskRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a PollForDecisionTaskRequest struct could not be encoded.
+func (v *PollForDecisionTaskRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.DomainUUID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainUUID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PollerID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 15, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.PollerID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PollRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.PollRequest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ForwardedFrom != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ForwardedFrom)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _PollForDecisionTaskRequest_Decode(sr stream.Reader) (*shared.PollForDecisionTaskRequest, error) {
+	var v shared.PollForDecisionTaskRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a PollForDecisionTaskRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a PollForDecisionTaskRequest struct could not be generated from the wire
+// representation.
+func (v *PollForDecisionTaskRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainUUID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 15 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.PollerID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.PollRequest, err = _PollForDecisionTaskRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ForwardedFrom = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollForDecisionTaskRequest
+// struct.
+func (v *PollForDecisionTaskRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.PollerID != nil {
+		fields[i] = fmt.Sprintf("PollerID: %v", *(v.PollerID))
+		i++
+	}
+	if v.PollRequest != nil {
+		fields[i] = fmt.Sprintf("PollRequest: %v", v.PollRequest)
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+		i++
+	}
+
+	return fmt.Sprintf("PollForDecisionTaskRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PollForDecisionTaskRequest match the
+// provided PollForDecisionTaskRequest.
+//
+// This function performs a deep comparison.
+func (v *PollForDecisionTaskRequest) Equals(rhs *PollForDecisionTaskRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PollerID, rhs.PollerID) {
+		return false
+	}
+	if !((v.PollRequest == nil && rhs.PollRequest == nil) || (v.PollRequest != nil && rhs.PollRequest != nil && v.PollRequest.Equals(rhs.PollRequest))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollForDecisionTaskRequest.
+func (v *PollForDecisionTaskRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.PollerID != nil {
+		enc.AddString("pollerID", *v.PollerID)
+	}
+	if v.PollRequest != nil {
+		err = multierr.Append(err, enc.AddObject("pollRequest", v.PollRequest))
+	}
+	if v.ForwardedFrom != nil {
+		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	}
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
+	}
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *PollForDecisionTaskRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetPollerID returns the value of PollerID if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetPollerID() (o string) {
+	if v != nil && v.PollerID != nil {
+		return *v.PollerID
+	}
+
+	return
+}
+
+// IsSetPollerID returns true if PollerID is not nil.
+func (v *PollForDecisionTaskRequest) IsSetPollerID() bool {
+	return v != nil && v.PollerID != nil
+}
+
+// GetPollRequest returns the value of PollRequest if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetPollRequest() (o *shared.PollForDecisionTaskRequest) {
+	if v != nil && v.PollRequest != nil {
+		return v.PollRequest
+	}
+
+	return
+}
+
+// IsSetPollRequest returns true if PollRequest is not nil.
+func (v *PollForDecisionTaskRequest) IsSetPollRequest() bool {
+	return v != nil && v.PollRequest != nil
+}
+
+// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskRequest) GetForwardedFrom() (o string) {
+	if v != nil && v.ForwardedFrom != nil {
+		return *v.ForwardedFrom
+	}
+
+	return
+}
+
+// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
+func (v *PollForDecisionTaskRequest) IsSetForwardedFrom() bool {
+	return v != nil && v.ForwardedFrom != nil
+}
+
+type PollForDecisionTaskResponse struct {
+	TaskToken                 []byte                           `json:"taskToken,omitempty"`
+	WorkflowExecution         *shared.WorkflowExecution        `json:"workflowExecution,omitempty"`
+	WorkflowType              *shared.WorkflowType             `json:"workflowType,omitempty"`
+	PreviousStartedEventId    *int64                           `json:"previousStartedEventId,omitempty"`
+	StartedEventId            *int64                           `json:"startedEventId,omitempty"`
+	Attempt                   *int64                           `json:"attempt,omitempty"`
+	NextEventId               *int64                           `json:"nextEventId,omitempty"`
+	BacklogCountHint          *int64                           `json:"backlogCountHint,omitempty"`
+	StickyExecutionEnabled    *bool                            `json:"stickyExecutionEnabled,omitempty"`
+	Query                     *shared.WorkflowQuery            `json:"query,omitempty"`
+	DecisionInfo              *shared.TransientDecisionInfo    `json:"decisionInfo,omitempty"`
+	WorkflowExecutionTaskList *shared.TaskList                 `json:"WorkflowExecutionTaskList,omitempty"`
+	EventStoreVersion         *int32                           `json:"eventStoreVersion,omitempty"`
+	BranchToken               []byte                           `json:"branchToken,omitempty"`
+	ScheduledTimestamp        *int64                           `json:"scheduledTimestamp,omitempty"`
+	StartedTimestamp          *int64                           `json:"startedTimestamp,omitempty"`
+	Queries                   map[string]*shared.WorkflowQuery `json:"queries,omitempty"`
+}
+
+type _Map_String_WorkflowQuery_MapItemList map[string]*shared.WorkflowQuery
+
+func (m _Map_String_WorkflowQuery_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*shared.WorkflowQuery', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_WorkflowQuery_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_WorkflowQuery_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_WorkflowQuery_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_WorkflowQuery_MapItemList) Close() {}
+
+// ToWire translates a PollForDecisionTaskResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PollForDecisionTaskResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [17]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.TaskToken != nil {
+		w, err = wire.NewValueBinary(v.TaskToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.WorkflowType != nil {
+		w, err = v.WorkflowType.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.PreviousStartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.StartedEventId != nil {
+		w, err = wire.NewValueI64(*(v.StartedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Attempt != nil {
+		w, err = wire.NewValueI64(*(v.Attempt)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 51, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		w, err = wire.NewValueI64(*(v.BacklogCountHint)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 65, Value: w}
+		i++
+	}
+	if v.StickyExecutionEnabled != nil {
+		w, err = wire.NewValueBool(*(v.StickyExecutionEnabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
+	if v.Query != nil {
+		w, err = v.Query.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
+	if v.DecisionInfo != nil {
+		w, err = v.DecisionInfo.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 90, Value: w}
+		i++
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		w, err = v.WorkflowExecutionTaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 110, Value: w}
+		i++
+	}
+	if v.BranchToken != nil {
+		w, err = wire.NewValueBinary(v.BranchToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.ScheduledTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 130, Value: w}
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		w, err = wire.NewValueI64(*(v.StartedTimestamp)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 140, Value: w}
+		i++
+	}
+	if v.Queries != nil {
+		w, err = wire.NewValueMap(_Map_String_WorkflowQuery_MapItemList(v.Queries)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 150, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _WorkflowType_Read(w wire.Value) (*shared.WorkflowType, error) {
+	var v shared.WorkflowType
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _WorkflowQuery_Read(w wire.Value) (*shared.WorkflowQuery, error) {
+	var v shared.WorkflowQuery
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _TransientDecisionInfo_Read(w wire.Value) (*shared.TransientDecisionInfo, error) {
+	var v shared.TransientDecisionInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_WorkflowQuery_Read(m wire.MapItemList) (map[string]*shared.WorkflowQuery, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.WorkflowQuery, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _WorkflowQuery_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a PollForDecisionTaskResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PollForDecisionTaskResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PollForDecisionTaskResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PollForDecisionTaskResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.TaskToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowType, err = _WorkflowType_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PreviousStartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 51:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Attempt = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 65:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.BacklogCountHint = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 70:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.StickyExecutionEnabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 80:
+			if field.Value.Type() == wire.TStruct {
+				v.Query, err = _WorkflowQuery_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 90:
+			if field.Value.Type() == wire.TStruct {
+				v.DecisionInfo, err = _TransientDecisionInfo_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 100:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecutionTaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 110:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 120:
+			if field.Value.Type() == wire.TBinary {
+				v.BranchToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 130:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ScheduledTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 140:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartedTimestamp = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 150:
+			if field.Value.Type() == wire.TMap {
+				v.Queries, err = _Map_String_WorkflowQuery_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _Map_String_WorkflowQuery_Encode(val map[string]*shared.WorkflowQuery, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TStruct,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string]*shared.WorkflowQuery', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
+// Encode serializes a PollForDecisionTaskResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a PollForDecisionTaskResponse struct could not be encoded.
+func (v *PollForDecisionTaskResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.TaskToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.TaskToken); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowType.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PreviousStartedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.PreviousStartedEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Attempt != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 51, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Attempt)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.NextEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.NextEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BacklogCountHint != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 65, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.BacklogCountHint)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StickyExecutionEnabled != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.StickyExecutionEnabled)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Query != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Query.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DecisionInfo != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 90, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DecisionInfo.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecutionTaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecutionTaskList.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EventStoreVersion != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 110, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.EventStoreVersion)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.BranchToken != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.BranchToken); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ScheduledTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 130, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ScheduledTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.StartedTimestamp != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 140, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartedTimestamp)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Queries != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 150, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_WorkflowQuery_Encode(v.Queries, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _WorkflowType_Decode(sr stream.Reader) (*shared.WorkflowType, error) {
+	var v shared.WorkflowType
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _WorkflowQuery_Decode(sr stream.Reader) (*shared.WorkflowQuery, error) {
+	var v shared.WorkflowQuery
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _TransientDecisionInfo_Decode(sr stream.Reader) (*shared.TransientDecisionInfo, error) {
+	var v shared.TransientDecisionInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _Map_String_WorkflowQuery_Decode(sr stream.Reader) (map[string]*shared.WorkflowQuery, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TStruct {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string]*shared.WorkflowQuery, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := _WorkflowQuery_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a PollForDecisionTaskResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a PollForDecisionTaskResponse struct could not be generated from the wire
+// representation.
+func (v *PollForDecisionTaskResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			v.TaskToken, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.WorkflowType, err = _WorkflowType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.PreviousStartedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 51 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Attempt = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.NextEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 65 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.BacklogCountHint = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 70 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.StickyExecutionEnabled = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 80 && fh.Type == wire.TStruct:
+			v.Query, err = _WorkflowQuery_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 90 && fh.Type == wire.TStruct:
+			v.DecisionInfo, err = _TransientDecisionInfo_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 100 && fh.Type == wire.TStruct:
+			v.WorkflowExecutionTaskList, err = _TaskList_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 110 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.EventStoreVersion = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 120 && fh.Type == wire.TBinary:
+			v.BranchToken, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 130 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ScheduledTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 140 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartedTimestamp = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 150 && fh.Type == wire.TMap:
+			v.Queries, err = _Map_String_WorkflowQuery_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PollForDecisionTaskResponse
+// struct.
+func (v *PollForDecisionTaskResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [17]string
+	i := 0
+	if v.TaskToken != nil {
+		fields[i] = fmt.Sprintf("TaskToken: %v", v.TaskToken)
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.WorkflowType != nil {
+		fields[i] = fmt.Sprintf("WorkflowType: %v", v.WorkflowType)
+		i++
+	}
+	if v.PreviousStartedEventId != nil {
+		fields[i] = fmt.Sprintf("PreviousStartedEventId: %v", *(v.PreviousStartedEventId))
+		i++
+	}
+	if v.StartedEventId != nil {
+		fields[i] = fmt.Sprintf("StartedEventId: %v", *(v.StartedEventId))
+		i++
+	}
+	if v.Attempt != nil {
+		fields[i] = fmt.Sprintf("Attempt: %v", *(v.Attempt))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.BacklogCountHint != nil {
+		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
+		i++
+	}
+	if v.StickyExecutionEnabled != nil {
+		fields[i] = fmt.Sprintf("StickyExecutionEnabled: %v", *(v.StickyExecutionEnabled))
+		i++
+	}
+	if v.Query != nil {
+		fields[i] = fmt.Sprintf("Query: %v", v.Query)
+		i++
+	}
+	if v.DecisionInfo != nil {
+		fields[i] = fmt.Sprintf("DecisionInfo: %v", v.DecisionInfo)
+		i++
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecutionTaskList: %v", v.WorkflowExecutionTaskList)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+	if v.BranchToken != nil {
+		fields[i] = fmt.Sprintf("BranchToken: %v", v.BranchToken)
+		i++
+	}
+	if v.ScheduledTimestamp != nil {
+		fields[i] = fmt.Sprintf("ScheduledTimestamp: %v", *(v.ScheduledTimestamp))
+		i++
+	}
+	if v.StartedTimestamp != nil {
+		fields[i] = fmt.Sprintf("StartedTimestamp: %v", *(v.StartedTimestamp))
+		i++
+	}
+	if v.Queries != nil {
+		fields[i] = fmt.Sprintf("Queries: %v", v.Queries)
+		i++
+	}
+
+	return fmt.Sprintf("PollForDecisionTaskResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_WorkflowQuery_Equals(lhs, rhs map[string]*shared.WorkflowQuery) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this PollForDecisionTaskResponse match the
+// provided PollForDecisionTaskResponse.
+//
+// This function performs a deep comparison.
+func (v *PollForDecisionTaskResponse) Equals(rhs *PollForDecisionTaskResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.TaskToken == nil && rhs.TaskToken == nil) || (v.TaskToken != nil && rhs.TaskToken != nil && bytes.Equal(v.TaskToken, rhs.TaskToken))) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.WorkflowType == nil && rhs.WorkflowType == nil) || (v.WorkflowType != nil && rhs.WorkflowType != nil && v.WorkflowType.Equals(rhs.WorkflowType))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PreviousStartedEventId, rhs.PreviousStartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedEventId, rhs.StartedEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Attempt, rhs.Attempt) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.BacklogCountHint, rhs.BacklogCountHint) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.StickyExecutionEnabled, rhs.StickyExecutionEnabled) {
+		return false
+	}
+	if !((v.Query == nil && rhs.Query == nil) || (v.Query != nil && rhs.Query != nil && v.Query.Equals(rhs.Query))) {
+		return false
+	}
+	if !((v.DecisionInfo == nil && rhs.DecisionInfo == nil) || (v.DecisionInfo != nil && rhs.DecisionInfo != nil && v.DecisionInfo.Equals(rhs.DecisionInfo))) {
+		return false
+	}
+	if !((v.WorkflowExecutionTaskList == nil && rhs.WorkflowExecutionTaskList == nil) || (v.WorkflowExecutionTaskList != nil && rhs.WorkflowExecutionTaskList != nil && v.WorkflowExecutionTaskList.Equals(rhs.WorkflowExecutionTaskList))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+	if !((v.BranchToken == nil && rhs.BranchToken == nil) || (v.BranchToken != nil && rhs.BranchToken != nil && bytes.Equal(v.BranchToken, rhs.BranchToken))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ScheduledTimestamp, rhs.ScheduledTimestamp) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartedTimestamp, rhs.StartedTimestamp) {
+		return false
+	}
+	if !((v.Queries == nil && rhs.Queries == nil) || (v.Queries != nil && rhs.Queries != nil && _Map_String_WorkflowQuery_Equals(v.Queries, rhs.Queries))) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_String_WorkflowQuery_Zapper map[string]*shared.WorkflowQuery
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_WorkflowQuery_Zapper.
+func (m _Map_String_WorkflowQuery_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PollForDecisionTaskResponse.
+func (v *PollForDecisionTaskResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.TaskToken != nil {
+		enc.AddString("taskToken", base64.StdEncoding.EncodeToString(v.TaskToken))
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.WorkflowType != nil {
+		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	}
+	if v.PreviousStartedEventId != nil {
+		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
+	}
+	if v.StartedEventId != nil {
+		enc.AddInt64("startedEventId", *v.StartedEventId)
+	}
+	if v.Attempt != nil {
+		enc.AddInt64("attempt", *v.Attempt)
+	}
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
+	}
+	if v.BacklogCountHint != nil {
+		enc.AddInt64("backlogCountHint", *v.BacklogCountHint)
+	}
+	if v.StickyExecutionEnabled != nil {
+		enc.AddBool("stickyExecutionEnabled", *v.StickyExecutionEnabled)
+	}
+	if v.Query != nil {
+		err = multierr.Append(err, enc.AddObject("query", v.Query))
+	}
+	if v.DecisionInfo != nil {
+		err = multierr.Append(err, enc.AddObject("decisionInfo", v.DecisionInfo))
+	}
+	if v.WorkflowExecutionTaskList != nil {
+		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	if v.BranchToken != nil {
+		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
+	}
+	if v.ScheduledTimestamp != nil {
+		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
+	}
+	if v.StartedTimestamp != nil {
+		enc.AddInt64("startedTimestamp", *v.StartedTimestamp)
+	}
+	if v.Queries != nil {
+		err = multierr.Append(err, enc.AddObject("queries", (_Map_String_WorkflowQuery_Zapper)(v.Queries)))
+	}
+	return err
+}
+
+// GetTaskToken returns the value of TaskToken if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
+	if v != nil && v.TaskToken != nil {
+		return v.TaskToken
+	}
+
+	return
+}
+
+// IsSetTaskToken returns true if TaskToken is not nil.
+func (v *PollForDecisionTaskResponse) IsSetTaskToken() bool {
+	return v != nil && v.TaskToken != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetWorkflowType returns the value of WorkflowType if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowType() (o *shared.WorkflowType) {
+	if v != nil && v.WorkflowType != nil {
+		return v.WorkflowType
+	}
+
+	return
+}
+
+// IsSetWorkflowType returns true if WorkflowType is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
+	return v != nil && v.WorkflowType != nil
+}
+
+// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetPreviousStartedEventId() (o int64) {
+	if v != nil && v.PreviousStartedEventId != nil {
+		return *v.PreviousStartedEventId
+	}
+
+	return
+}
+
+// IsSetPreviousStartedEventId returns true if PreviousStartedEventId is not nil.
+func (v *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
+	return v != nil && v.PreviousStartedEventId != nil
+}
+
+// GetStartedEventId returns the value of StartedEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetStartedEventId() (o int64) {
+	if v != nil && v.StartedEventId != nil {
+		return *v.StartedEventId
+	}
+
+	return
+}
+
+// IsSetStartedEventId returns true if StartedEventId is not nil.
+func (v *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
+	return v != nil && v.StartedEventId != nil
+}
+
+// GetAttempt returns the value of Attempt if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
+	if v != nil && v.Attempt != nil {
+		return *v.Attempt
+	}
+
+	return
+}
+
+// IsSetAttempt returns true if Attempt is not nil.
+func (v *PollForDecisionTaskResponse) IsSetAttempt() bool {
+	return v != nil && v.Attempt != nil
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *PollForDecisionTaskResponse) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
+}
+
+// GetBacklogCountHint returns the value of BacklogCountHint if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetBacklogCountHint() (o int64) {
+	if v != nil && v.BacklogCountHint != nil {
+		return *v.BacklogCountHint
+	}
+
+	return
+}
+
+// IsSetBacklogCountHint returns true if BacklogCountHint is not nil.
+func (v *PollForDecisionTaskResponse) IsSetBacklogCountHint() bool {
+	return v != nil && v.BacklogCountHint != nil
+}
+
+// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetStickyExecutionEnabled() (o bool) {
+	if v != nil && v.StickyExecutionEnabled != nil {
+		return *v.StickyExecutionEnabled
+	}
+
+	return
+}
+
+// IsSetStickyExecutionEnabled returns true if StickyExecutionEnabled is not nil.
+func (v *PollForDecisionTaskResponse) IsSetStickyExecutionEnabled() bool {
+	return v != nil && v.StickyExecutionEnabled != nil
+}
+
+// GetQuery returns the value of Query if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetQuery() (o *shared.WorkflowQuery) {
+	if v != nil && v.Query != nil {
+		return v.Query
+	}
+
+	return
+}
+
+// IsSetQuery returns true if Query is not nil.
+func (v *PollForDecisionTaskResponse) IsSetQuery() bool {
+	return v != nil && v.Query != nil
+}
+
+// GetDecisionInfo returns the value of DecisionInfo if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetDecisionInfo() (o *shared.TransientDecisionInfo) {
+	if v != nil && v.DecisionInfo != nil {
+		return v.DecisionInfo
+	}
+
+	return
+}
+
+// IsSetDecisionInfo returns true if DecisionInfo is not nil.
+func (v *PollForDecisionTaskResponse) IsSetDecisionInfo() bool {
+	return v != nil && v.DecisionInfo != nil
+}
+
+// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetWorkflowExecutionTaskList() (o *shared.TaskList) {
+	if v != nil && v.WorkflowExecutionTaskList != nil {
+		return v.WorkflowExecutionTaskList
+	}
+
+	return
+}
+
+// IsSetWorkflowExecutionTaskList returns true if WorkflowExecutionTaskList is not nil.
+func (v *PollForDecisionTaskResponse) IsSetWorkflowExecutionTaskList() bool {
+	return v != nil && v.WorkflowExecutionTaskList != nil
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetEventStoreVersion() (o int32) {
+	if v != nil && v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
+func (v *PollForDecisionTaskResponse) IsSetEventStoreVersion() bool {
+	return v != nil && v.EventStoreVersion != nil
+}
+
+// GetBranchToken returns the value of BranchToken if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetBranchToken() (o []byte) {
+	if v != nil && v.BranchToken != nil {
+		return v.BranchToken
+	}
+
+	return
+}
+
+// IsSetBranchToken returns true if BranchToken is not nil.
+func (v *PollForDecisionTaskResponse) IsSetBranchToken() bool {
+	return v != nil && v.BranchToken != nil
+}
+
+// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetScheduledTimestamp() (o int64) {
+	if v != nil && v.ScheduledTimestamp != nil {
+		return *v.ScheduledTimestamp
+	}
+
+	return
+}
+
+// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
+func (v *PollForDecisionTaskResponse) IsSetScheduledTimestamp() bool {
+	return v != nil && v.ScheduledTimestamp != nil
+}
+
+// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetStartedTimestamp() (o int64) {
+	if v != nil && v.StartedTimestamp != nil {
+		return *v.StartedTimestamp
+	}
+
+	return
+}
+
+// IsSetStartedTimestamp returns true if StartedTimestamp is not nil.
+func (v *PollForDecisionTaskResponse) IsSetStartedTimestamp() bool {
+	return v != nil && v.StartedTimestamp != nil
+}
+
+// GetQueries returns the value of Queries if it is set or its
+// zero value if it is unset.
+func (v *PollForDecisionTaskResponse) GetQueries() (o map[string]*shared.WorkflowQuery) {
+	if v != nil && v.Queries != nil {
+		return v.Queries
+	}
+
+	return
+}
+
+// IsSetQueries returns true if Queries is not nil.
+func (v *PollForDecisionTaskResponse) IsSetQueries() bool {
+	return v != nil && v.Queries != nil
+}
+
+type QueryWorkflowRequest struct {
+	DomainUUID    *string                      `json:"domainUUID,omitempty"`
+	TaskList      *shared.TaskList             `json:"taskList,omitempty"`
+	QueryRequest  *shared.QueryWorkflowRequest `json:"queryRequest,omitempty"`
+	ForwardedFrom *string                      `json:"forwardedFrom,omitempty"`
+}
+
+// ToWire translates a QueryWorkflowRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.QueryRequest != nil {
+		w, err = v.QueryRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _QueryWorkflowRequest_Read(w wire.Value) (*shared.QueryWorkflowRequest, error) {
+	var v shared.QueryWorkflowRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a QueryWorkflowRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a QueryWorkflowRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v QueryWorkflowRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TStruct {
+				v.QueryRequest, err = _QueryWorkflowRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ForwardedFrom = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a QueryWorkflowRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a QueryWorkflowRequest struct could not be encoded.
+func (v *QueryWorkflowRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.DomainUUID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainUUID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.TaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TaskList.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.QueryRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.QueryRequest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ForwardedFrom != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ForwardedFrom)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _QueryWorkflowRequest_Decode(sr stream.Reader) (*shared.QueryWorkflowRequest, error) {
+	var v shared.QueryWorkflowRequest
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a QueryWorkflowRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a QueryWorkflowRequest struct could not be generated from the wire
+// representation.
+func (v *QueryWorkflowRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainUUID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.TaskList, err = _TaskList_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.QueryRequest, err = _QueryWorkflowRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ForwardedFrom = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a QueryWorkflowRequest
+// struct.
+func (v *QueryWorkflowRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.QueryRequest != nil {
+		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
+		i++
+	}
+	if v.ForwardedFrom != nil {
+		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+		i++
+	}
+
+	return fmt.Sprintf("QueryWorkflowRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this QueryWorkflowRequest match the
+// provided QueryWorkflowRequest.
+//
+// This function performs a deep comparison.
+func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
 	}
-	if v.WorkflowType != nil {
-		err = multierr.Append(err, enc.AddObject("workflowType", v.WorkflowType))
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
 	}
-	if v.PreviousStartedEventId != nil {
-		enc.AddInt64("previousStartedEventId", *v.PreviousStartedEventId)
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
 	}
-	if v.StartedEventId != nil {
-		enc.AddInt64("startedEventId", *v.StartedEventId)
+	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
+		return false
 	}
-	if v.Attempt != nil {
-		enc.AddInt64("attempt", *v.Attempt)
+	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+		return false
 	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of QueryWorkflowRequest.
+func (v *QueryWorkflowRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-	if v.BacklogCountHint != nil {
-		enc.AddInt64("backlogCountHint", *v.BacklogCountHint)
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
 	}
-	if v.StickyExecutionEnabled != nil {
-		enc.AddBool("stickyExecutionEnabled", *v.StickyExecutionEnabled)
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
 	}
-	if v.Query != nil {
-		err = multierr.Append(err, enc.AddObject("query", v.Query))
+	if v.QueryRequest != nil {
+		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
 	}
-	if v.DecisionInfo != nil {
-		err = multierr.Append(err, enc.AddObject("decisionInfo", v.DecisionInfo))
+	if v.ForwardedFrom != nil {
+		enc.AddString("forwardedFrom", *v.ForwardedFrom)
 	}
-	if v.WorkflowExecutionTaskList != nil {
-		err = multierr.Append(err, enc.AddObject("WorkflowExecutionTaskList", v.WorkflowExecutionTaskList))
+	return err
+}
+
+// GetDomainUUID returns the value of DomainUUID if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+
+	return
+}
+
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *QueryWorkflowRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
+}
+
+// GetTaskList returns the value of TaskList if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
 	}
-	if v.BranchToken != nil {
-		enc.AddString("branchToken", base64.StdEncoding.EncodeToString(v.BranchToken))
+
+	return
+}
+
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *QueryWorkflowRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
+}
+
+// GetQueryRequest returns the value of QueryRequest if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetQueryRequest() (o *shared.QueryWorkflowRequest) {
+	if v != nil && v.QueryRequest != nil {
+		return v.QueryRequest
 	}
-	if v.ScheduledTimestamp != nil {
-		enc.AddInt64("scheduledTimestamp", *v.ScheduledTimestamp)
+
+	return
+}
+
+// IsSetQueryRequest returns true if QueryRequest is not nil.
+func (v *QueryWorkflowRequest) IsSetQueryRequest() bool {
+	return v != nil && v.QueryRequest != nil
+}
+
+// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
+// zero value if it is unset.
+func (v *QueryWorkflowRequest) GetForwardedFrom() (o string) {
+	if v != nil && v.ForwardedFrom != nil {
+		return *v.ForwardedFrom
 	}
-	if v.StartedTimestamp != nil {
-		enc.AddInt64("startedTimestamp", *v.StartedTimestamp)
+
+	return
+}
+
+// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
+func (v *QueryWorkflowRequest) IsSetForwardedFrom() bool {
+	return v != nil && v.ForwardedFrom != nil
+}
+
+type RespondQueryTaskCompletedRequest struct {
+	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
+	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
+	TaskID           *string                                  `json:"taskID,omitempty"`
+	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
+}
+
+// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.DomainUUID != nil {
+		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
 	}
-	if v.Queries != nil {
-		err = multierr.Append(err, enc.AddObject("queries", (_Map_String_WorkflowQuery_Zapper)(v.Queries)))
+	if v.TaskList != nil {
+		w, err = v.TaskList.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-	return err
+	if v.TaskID != nil {
+		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.CompletedRequest != nil {
+		w, err = v.CompletedRequest.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
+	var v shared.RespondQueryTaskCompletedRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RespondQueryTaskCompletedRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.DomainUUID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.TaskList, err = _TaskList_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.TaskID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
 }
 
-// GetTaskToken returns the value of TaskToken if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetTaskToken() (o []byte) {
-	if v != nil && v.TaskToken != nil {
-		return v.TaskToken
+// Encode serializes a RespondQueryTaskCompletedRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a RespondQueryTaskCompletedRequest struct could not be encoded.
+func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.DomainUUID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.DomainUUID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return
-}
+	if v.TaskList != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.TaskList.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-// IsSetTaskToken returns true if TaskToken is not nil.
-func (v *PollForDecisionTaskResponse) IsSetTaskToken() bool {
-	return v != nil && v.TaskToken != nil
-}
+	if v.TaskID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.TaskID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowExecution() (o *shared.WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+	if v.CompletedRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.CompletedRequest.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	return
+	return sw.WriteStructEnd()
 }
 
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *PollForDecisionTaskResponse) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
+func _RespondQueryTaskCompletedRequest_Decode(sr stream.Reader) (*shared.RespondQueryTaskCompletedRequest, error) {
+	var v shared.RespondQueryTaskCompletedRequest
+	err := v.Decode(sr)
+	return &v, err
 }
 
-// GetWorkflowType returns the value of WorkflowType if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowType() (o *shared.WorkflowType) {
-	if v != nil && v.WorkflowType != nil {
-		return v.WorkflowType
-	}
-
-	return
-}
+// Decode deserializes a RespondQueryTaskCompletedRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a RespondQueryTaskCompletedRequest struct could not be generated from the wire
+// representation.
+func (v *RespondQueryTaskCompletedRequest) Decode(sr stream.Reader) error {
 
-// IsSetWorkflowType returns true if WorkflowType is not nil.
-func (v *PollForDecisionTaskResponse) IsSetWorkflowType() bool {
-	return v != nil && v.WorkflowType != nil
-}
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
 
-// GetPreviousStartedEventId returns the value of PreviousStartedEventId if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetPreviousStartedEventId() (o int64) {
-	if v != nil && v.PreviousStartedEventId != nil {
-		return *v.PreviousStartedEventId
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
 	}
 
-	return
-}
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.DomainUUID = &x
+			if err != nil {
+				return err
+			}
 
-// IsSetPreviousStartedEventId returns true if PreviousStartedEventId is not nil.
-func (v *PollForDecisionTaskResponse) IsSetPreviousStartedEventId() bool {
-	return v != nil && v.PreviousStartedEventId != nil
-}
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.TaskList, err = _TaskList_Decode(sr)
+			if err != nil {
+				return err
+			}
 
-// GetStartedEventId returns the value of StartedEventId if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetStartedEventId() (o int64) {
-	if v != nil && v.StartedEventId != nil {
-		return *v.StartedEventId
-	}
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.TaskID = &x
+			if err != nil {
+				return err
+			}
 
-	return
-}
+		case fh.ID == 40 && fh.Type == wire.TStruct:
+			v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Decode(sr)
+			if err != nil {
+				return err
+			}
 
-// IsSetStartedEventId returns true if StartedEventId is not nil.
-func (v *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
-	return v != nil && v.StartedEventId != nil
-}
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
 
-// GetAttempt returns the value of Attempt if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetAttempt() (o int64) {
-	if v != nil && v.Attempt != nil {
-		return *v.Attempt
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
 	}
 
-	return
-}
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
 
-// IsSetAttempt returns true if Attempt is not nil.
-func (v *PollForDecisionTaskResponse) IsSetAttempt() bool {
-	return v != nil && v.Attempt != nil
+	return nil
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
+// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// struct.
+func (v *RespondQueryTaskCompletedRequest) String() string {
+	if v == nil {
+		return "<nil>"
 	}
 
-	return
-}
+	var fields [4]string
+	i := 0
+	if v.DomainUUID != nil {
+		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+		i++
+	}
+	if v.TaskList != nil {
+		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+		i++
+	}
+	if v.TaskID != nil {
+		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+		i++
+	}
+	if v.CompletedRequest != nil {
+		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+		i++
+	}
 
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *PollForDecisionTaskResponse) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
+	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// GetBacklogCountHint returns the value of BacklogCountHint if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetBacklogCountHint() (o int64) {
-	if v != nil && v.BacklogCountHint != nil {
-		return *v.BacklogCountHint
+// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
+// provided RespondQueryTaskCompletedRequest.
+//
+// This function performs a deep comparison.
+func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+		return false
+	}
+	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
+		return false
+	}
+	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetBacklogCountHint returns true if BacklogCountHint is not nil.
-func (v *PollForDecisionTaskResponse) IsSetBacklogCountHint() bool {
-	return v != nil && v.BacklogCountHint != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RespondQueryTaskCompletedRequest.
+func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainUUID != nil {
+		enc.AddString("domainUUID", *v.DomainUUID)
+	}
+	if v.TaskList != nil {
+		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	}
+	if v.TaskID != nil {
+		enc.AddString("taskID", *v.TaskID)
+	}
+	if v.CompletedRequest != nil {
+		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
+	}
+	return err
 }
 
-// GetStickyExecutionEnabled returns the value of StickyExecutionEnabled if it is set or its
+// GetDomainUUID returns the value of DomainUUID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetStickyExecutionEnabled() (o bool) {
-	if v != nil && v.StickyExecutionEnabled != nil {
-		return *v.StickyExecutionEnabled
+func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
+	if v != nil && v.DomainUUID != nil {
+		return *v.DomainUUID
 	}
 
 	return
 }
 
-// IsSetStickyExecutionEnabled returns true if StickyExecutionEnabled is not nil.
-func (v *PollForDecisionTaskResponse) IsSetStickyExecutionEnabled() bool {
-	return v != nil && v.StickyExecutionEnabled != nil
+// IsSetDomainUUID returns true if DomainUUID is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
+	return v != nil && v.DomainUUID != nil
 }
 
-// GetQuery returns the value of Query if it is set or its
+// GetTaskList returns the value of TaskList if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetQuery() (o *shared.WorkflowQuery) {
-	if v != nil && v.Query != nil {
-		return v.Query
+func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
 	}
 
 	return
 }
 
-// IsSetQuery returns true if Query is not nil.
-func (v *PollForDecisionTaskResponse) IsSetQuery() bool {
-	return v != nil && v.Query != nil
+// IsSetTaskList returns true if TaskList is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
+	return v != nil && v.TaskList != nil
 }
 
-// GetDecisionInfo returns the value of DecisionInfo if it is set or its
+// GetTaskID returns the value of TaskID if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetDecisionInfo() (o *shared.TransientDecisionInfo) {
-	if v != nil && v.DecisionInfo != nil {
-		return v.DecisionInfo
+func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
+	if v != nil && v.TaskID != nil {
+		return *v.TaskID
 	}
 
 	return
 }
 
-// IsSetDecisionInfo returns true if DecisionInfo is not nil.
-func (v *PollForDecisionTaskResponse) IsSetDecisionInfo() bool {
-	return v != nil && v.DecisionInfo != nil
+// IsSetTaskID returns true if TaskID is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
+	return v != nil && v.TaskID != nil
 }
 
-// GetWorkflowExecutionTaskList returns the value of WorkflowExecutionTaskList if it is set or its
+// GetCompletedRequest returns the value of CompletedRequest if it is set or its
 // zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetWorkflowExecutionTaskList() (o *shared.TaskList) {
-	if v != nil && v.WorkflowExecutionTaskList != nil {
-		return v.WorkflowExecutionTaskList
+func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
+	if v != nil && v.CompletedRequest != nil {
+		return v.CompletedRequest
 	}
 
 	return
 }
 
-// IsSetWorkflowExecutionTaskList returns true if WorkflowExecutionTaskList is not nil.
-func (v *PollForDecisionTaskResponse) IsSetWorkflowExecutionTaskList() bool {
-	return v != nil && v.WorkflowExecutionTaskList != nil
+// IsSetCompletedRequest returns true if CompletedRequest is not nil.
+func (v *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
+	return v != nil && v.CompletedRequest != nil
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetEventStoreVersion() (o int32) {
-	if v != nil && v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+type TaskSource int32
+
+const (
+	TaskSourceHistory   TaskSource = 0
+	TaskSourceDbBacklog TaskSource = 1
+)
+
+// TaskSource_Values returns all recognized values of TaskSource.
+func TaskSource_Values() []TaskSource {
+	return []TaskSource{
+		TaskSourceHistory,
+		TaskSourceDbBacklog,
 	}
+}
 
-	return
+// UnmarshalText tries to decode TaskSource from a byte slice
+// containing its name.
+//
+//   var v TaskSource
+//   err := v.UnmarshalText([]byte("HISTORY"))
+func (v *TaskSource) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "HISTORY":
+		*v = TaskSourceHistory
+		return nil
+	case "DB_BACKLOG":
+		*v = TaskSourceDbBacklog
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskSource", err)
+		}
+		*v = TaskSource(val)
+		return nil
+	}
 }
 
-// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *PollForDecisionTaskResponse) IsSetEventStoreVersion() bool {
-	return v != nil && v.EventStoreVersion != nil
+// MarshalText encodes TaskSource to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v TaskSource) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("HISTORY"), nil
+	case 1:
+		return []byte("DB_BACKLOG"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// GetBranchToken returns the value of BranchToken if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetBranchToken() (o []byte) {
-	if v != nil && v.BranchToken != nil {
-		return v.BranchToken
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskSource.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v TaskSource) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "HISTORY")
+	case 1:
+		enc.AddString("name", "DB_BACKLOG")
 	}
+	return nil
+}
 
-	return
+// Ptr returns a pointer to this enum value.
+func (v TaskSource) Ptr() *TaskSource {
+	return &v
 }
 
-// IsSetBranchToken returns true if BranchToken is not nil.
-func (v *PollForDecisionTaskResponse) IsSetBranchToken() bool {
-	return v != nil && v.BranchToken != nil
+// Encode encodes TaskSource directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v TaskSource
+//   return v.Encode(sWriter)
+func (v TaskSource) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
 }
 
-// GetScheduledTimestamp returns the value of ScheduledTimestamp if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetScheduledTimestamp() (o int64) {
-	if v != nil && v.ScheduledTimestamp != nil {
-		return *v.ScheduledTimestamp
-	}
+// ToWire translates TaskSource into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v TaskSource) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
 
-	return
+// FromWire deserializes TaskSource from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return TaskSource(0), err
+//   }
+//
+//   var v TaskSource
+//   if err := v.FromWire(x); err != nil {
+//     return TaskSource(0), err
+//   }
+//   return v, nil
+func (v *TaskSource) FromWire(w wire.Value) error {
+	*v = (TaskSource)(w.GetI32())
+	return nil
 }
 
-// IsSetScheduledTimestamp returns true if ScheduledTimestamp is not nil.
-func (v *PollForDecisionTaskResponse) IsSetScheduledTimestamp() bool {
-	return v != nil && v.ScheduledTimestamp != nil
+// Decode reads off the encoded TaskSource directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v TaskSource
+//   if err := v.Decode(sReader); err != nil {
+//     return TaskSource(0), err
+//   }
+//   return v, nil
+func (v *TaskSource) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (TaskSource)(i)
+	return nil
 }
 
-// GetStartedTimestamp returns the value of StartedTimestamp if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetStartedTimestamp() (o int64) {
-	if v != nil && v.StartedTimestamp != nil {
-		return *v.StartedTimestamp
+// String returns a readable string representation of TaskSource.
+func (v TaskSource) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "HISTORY"
+	case 1:
+		return "DB_BACKLOG"
 	}
+	return fmt.Sprintf("TaskSource(%d)", w)
+}
 
-	return
+// Equals returns true if this TaskSource value matches the provided
+// value.
+func (v TaskSource) Equals(rhs TaskSource) bool {
+	return v == rhs
 }
 
-// IsSetStartedTimestamp returns true if StartedTimestamp is not nil.
-func (v *PollForDecisionTaskResponse) IsSetStartedTimestamp() bool {
-	return v != nil && v.StartedTimestamp != nil
+// MarshalJSON serializes TaskSource into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v TaskSource) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"HISTORY\""), nil
+	case 1:
+		return ([]byte)("\"DB_BACKLOG\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
 
-// GetQueries returns the value of Queries if it is set or its
-// zero value if it is unset.
-func (v *PollForDecisionTaskResponse) GetQueries() (o map[string]*shared.WorkflowQuery) {
-	if v != nil && v.Queries != nil {
-		return v.Queries
+// UnmarshalJSON attempts to decode TaskSource from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *TaskSource) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskSource")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskSource")
+		}
+		*v = (TaskSource)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskSource")
 	}
-
-	return
 }
 
-// IsSetQueries returns true if Queries is not nil.
-func (v *PollForDecisionTaskResponse) IsSetQueries() bool {
-	return v != nil && v.Queries != nil
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "matching",
+	Package:  "github.com/uber/cadence/.gen/go/matching",
+	FilePath: "matching.thrift",
+	SHA1:     "8f8359f3f161176ad465500e2fcc8b2ad9ee0036",
+	Includes: []*thriftreflect.ThriftModule{
+		shared.ThriftModule,
+	},
+	Raw: rawIDL,
 }
 
-type QueryWorkflowRequest struct {
-	DomainUUID    *string                      `json:"domainUUID,omitempty"`
-	TaskList      *shared.TaskList             `json:"taskList,omitempty"`
-	QueryRequest  *shared.QueryWorkflowRequest `json:"queryRequest,omitempty"`
-	ForwardedFrom *string                      `json:"forwardedFrom,omitempty"`
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence.matching\n\n// TaskSource is the source from which a task was produced\nenum TaskSource {\n    HISTORY,    // Task produced by history service\n    DB_BACKLOG // Task produced from matching db backlog\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForDecisionTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional shared.WorkflowExecution workflowExecution\n  30: optional shared.WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = \"Long\") attempt\n  60: optional i64 (js.type = \"Long\") nextEventId\n  65: optional i64 (js.type = \"Long\") backlogCountHint\n  70: optional bool stickyExecutionEnabled\n  80: optional shared.WorkflowQuery query\n  90: optional shared.TransientDecisionInfo decisionInfo\n  100: optional shared.TaskList WorkflowExecutionTaskList\n  110: optional i32 eventStoreVersion\n  120: optional binary branchToken\n  130: optional i64 (js.type = \"Long\") scheduledTimestamp\n  140: optional i64 (js.type = \"Long\") startedTimestamp\n  150: optional map<string, shared.WorkflowQuery> queries\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForActivityTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct AddDecisionTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional shared.TaskList taskList\n  40: optional i64 (js.type = \"Long\") scheduleId\n  50: optional i32 scheduleToStartTimeoutSeconds\n  59: optional TaskSource source\n  60: optional string forwardedFrom\n  70: optional bool ephemeral\n  80: optional string affinityKey\n  90: optional i64 (js.type = \"Long\") attempt\n  100: optional string shadowSourceTaskList\n  110: optional bool shadowMetadataOnly\n}\n\nstruct AddActivityTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional string sourceDomainUUID\n  40: optional shared.TaskList taskList\n  50: optional i64 (js.type = \"Long\") scheduleId\n  60: optional i32 scheduleToStartTimeoutSeconds\n  69: optional TaskSource source\n  70: optional string forwardedFrom\n  80: optional bool ephemeral\n  90: optional string affinityKey\n  100: optional i64 (js.type = \"Long\") attempt\n  110: optional string shadowSourceTaskList\n  120: optional bool shadowMetadataOnly\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.QueryWorkflowRequest queryRequest\n  40: optional string forwardedFrom\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional string taskID\n  40: optional shared.RespondQueryTaskCompletedRequest completedRequest\n}\n\nstruct CancelOutstandingPollRequest {\n  10: optional string domainUUID\n  20: optional i32 taskListType\n  30: optional shared.TaskList taskList\n  40: optional string pollerID\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.DescribeTaskListRequest descRequest\n}\n\nstruct DescribeTaskListConfigRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.TaskListType taskListType\n}\n\nstruct DescribeTaskListConfigResponse {\n  10: optional i32 numReadPartitions\n  20: optional i32 numWritePartitions\n  30: optional i32 forwarderMaxOutstandingPolls\n  40: optional i32 forwarderMaxOutstandingTasks\n  50: optional i32 forwarderMaxRatePerSecond\n  60: optional i32 forwarderMaxChildrenPerNode\n  70: optional bool enableSyncMatch\n  80: optional bool enableEphemeralTaskList\n  90: optional i64 longPollExpirationIntervalInSeconds\n  100: optional i64 rangeSize\n  110: optional i32 getTasksBatchSize\n  120: optional i64 updateAckIntervalInSeconds\n  130: optional i64 idleTaskListCheckIntervalInSeconds\n  140: optional i64 maxTaskListIdleTimeInSeconds\n  150: optional i32 minTaskThrottlingBurstSize\n  160: optional i32 maxTaskDeleteBatchSize\n  170: optional i32 outstandingTaskAppendsThreshold\n  180: optional i32 maxTaskBatchSize\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional shared.TaskList taskList\n}\n\n/**\n* MatchingService API is exposed to provide support for polling from long running applications.\n* Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.\n**/\nservice MatchingService {\n  /**\n  * PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  **/\n  PollForDecisionTaskResponse PollForDecisionTask(1: PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddDecisionTask(1: AddDecisionTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n    )\n\n  /**\n  * AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddActivityTask(1: AddActivityTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n    )\n\n  /**\n  * QueryWorkflow is called by frontend to query a workflow.\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.QueryFailedError queryFailedError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by frontend to respond query completed.\n  **/\n  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n    * CancelOutstandingPoll is called by frontend to unblock long polls on matching for zombie pollers.\n    * Our rpc stack does not support context propagation, so when a client connection goes away frontend sees\n    * cancellation of context for that handler, but any corresponding calls (long-poll) to matching service does not\n    * see the cancellation propagated so it can unblock corresponding long-polls on its end.  This results is tasks\n    * being dispatched to zombie pollers in this situation.  This API is added so everytime frontend makes a long-poll\n    * api call to matching it passes in a pollerID and then calls this API when it detects client connection is closed\n    * to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.\n    **/\n  void CancelOutstandingPoll(1: CancelOutstandingPollRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: DescribeTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * GetTaskListsByDomain returns the list of all the task lists for a domainName.\n  **/\n  shared.GetTaskListsByDomainResponse GetTaskListsByDomain(1: shared.GetTaskListsByDomainRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * ListTaskListPartitions returns a map of partitionKey and hostAddress for a taskList\n  **/\n  shared.ListTaskListPartitionsResponse ListTaskListPartitions(1: ListTaskListPartitionsRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeTaskListConfig returns the configuration the matching host owning the tasklist applies to it,\n  * after dynamic config filters are applied\n  **/\n  DescribeTaskListConfigResponse DescribeTaskListConfig(1: DescribeTaskListConfigRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n}\n"
+
+// MatchingService_AddActivityTask_Args represents the arguments for the MatchingService.AddActivityTask function.
+//
+// The arguments for AddActivityTask are sent and received over the wire as this struct.
+type MatchingService_AddActivityTask_Args struct {
+	AddRequest *AddActivityTaskRequest `json:"addRequest,omitempty"`
 }
 
-// ToWire translates a QueryWorkflowRequest struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_AddActivityTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4556,61 +7875,37 @@ type QueryWorkflowRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *QueryWorkflowRequest) ToWire() (wire.Value, error) {
+func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 10, Value: w}
-		i++
-	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.QueryRequest != nil {
-		w, err = v.QueryRequest.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.ForwardedFrom != nil {
-		w, err = wire.NewValueString(*(v.ForwardedFrom)), error(nil)
+	if v.AddRequest != nil {
+		w, err = v.AddRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _QueryWorkflowRequest_Read(w wire.Value) (*shared.QueryWorkflowRequest, error) {
-	var v shared.QueryWorkflowRequest
+func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error) {
+	var v AddActivityTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a QueryWorkflowRequest struct from its Thrift-level
+// FromWire deserializes a MatchingService_AddActivityTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a QueryWorkflowRequest struct
+// An error is returned if we were unable to build a MatchingService_AddActivityTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -4618,47 +7913,19 @@ func _QueryWorkflowRequest_Read(w wire.Value) (*shared.QueryWorkflowRequest, err
 //     return nil, err
 //   }
 //
-//   var v QueryWorkflowRequest
+//   var v MatchingService_AddActivityTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
+func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
+		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.QueryRequest, err = _QueryWorkflowRequest_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ForwardedFrom = &x
+				v.AddRequest, err = _AddActivityTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -4670,56 +7937,20 @@ func (v *QueryWorkflowRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a QueryWorkflowRequest struct directly into bytes, without going
+// Encode serializes a MatchingService_AddActivityTask_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a QueryWorkflowRequest struct could not be encoded.
-func (v *QueryWorkflowRequest) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_AddActivityTask_Args struct could not be encoded.
+func (v *MatchingService_AddActivityTask_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.DomainUUID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.DomainUUID)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.TaskList != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.TaskList.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.QueryRequest != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.QueryRequest.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ForwardedFrom != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+	if v.AddRequest != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ForwardedFrom)); err != nil {
+		if err := v.AddRequest.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -4730,18 +7961,18 @@ func (v *QueryWorkflowRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _QueryWorkflowRequest_Decode(sr stream.Reader) (*shared.QueryWorkflowRequest, error) {
-	var v shared.QueryWorkflowRequest
+func _AddActivityTaskRequest_Decode(sr stream.Reader) (*AddActivityTaskRequest, error) {
+	var v AddActivityTaskRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a QueryWorkflowRequest struct directly from its Thrift-level
+// Decode deserializes a MatchingService_AddActivityTask_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a QueryWorkflowRequest struct could not be generated from the wire
+// An error is returned if a MatchingService_AddActivityTask_Args struct could not be generated from the wire
 // representation.
-func (v *QueryWorkflowRequest) Decode(sr stream.Reader) error {
+func (v *MatchingService_AddActivityTask_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -4754,30 +7985,8 @@ func (v *QueryWorkflowRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.DomainUUID = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.TaskList, err = _TaskList_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TStruct:
-			v.QueryRequest, err = _QueryWorkflowRequest_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ForwardedFrom = &x
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.AddRequest, err = _AddActivityTaskRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -4804,150 +8013,239 @@ func (v *QueryWorkflowRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a QueryWorkflowRequest
+// String returns a readable string representation of a MatchingService_AddActivityTask_Args
 // struct.
-func (v *QueryWorkflowRequest) String() string {
+func (v *MatchingService_AddActivityTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [1]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
-		i++
-	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
-		i++
-	}
-	if v.QueryRequest != nil {
-		fields[i] = fmt.Sprintf("QueryRequest: %v", v.QueryRequest)
-		i++
-	}
-	if v.ForwardedFrom != nil {
-		fields[i] = fmt.Sprintf("ForwardedFrom: %v", *(v.ForwardedFrom))
+	if v.AddRequest != nil {
+		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
 		i++
 	}
 
-	return fmt.Sprintf("QueryWorkflowRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_AddActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this QueryWorkflowRequest match the
-// provided QueryWorkflowRequest.
+// Equals returns true if all the fields of this MatchingService_AddActivityTask_Args match the
+// provided MatchingService_AddActivityTask_Args.
 //
 // This function performs a deep comparison.
-func (v *QueryWorkflowRequest) Equals(rhs *QueryWorkflowRequest) bool {
+func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddActivityTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
-		return false
-	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
-		return false
-	}
-	if !((v.QueryRequest == nil && rhs.QueryRequest == nil) || (v.QueryRequest != nil && rhs.QueryRequest != nil && v.QueryRequest.Equals(rhs.QueryRequest))) {
-		return false
-	}
-	if !_String_EqualsPtr(v.ForwardedFrom, rhs.ForwardedFrom) {
+	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
 		return false
 	}
 
 	return true
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of QueryWorkflowRequest.
-func (v *QueryWorkflowRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
-	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
-	}
-	if v.QueryRequest != nil {
-		err = multierr.Append(err, enc.AddObject("queryRequest", v.QueryRequest))
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MatchingService_AddActivityTask_Args.
+func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
 	}
-	if v.ForwardedFrom != nil {
-		enc.AddString("forwardedFrom", *v.ForwardedFrom)
+	if v.AddRequest != nil {
+		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
 	}
 	return err
 }
 
-// GetDomainUUID returns the value of DomainUUID if it is set or its
+// GetAddRequest returns the value of AddRequest if it is set or its
 // zero value if it is unset.
-func (v *QueryWorkflowRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTaskRequest) {
+	if v != nil && v.AddRequest != nil {
+		return v.AddRequest
 	}
 
 	return
 }
 
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *QueryWorkflowRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
+// IsSetAddRequest returns true if AddRequest is not nil.
+func (v *MatchingService_AddActivityTask_Args) IsSetAddRequest() bool {
+	return v != nil && v.AddRequest != nil
 }
 
-// GetTaskList returns the value of TaskList if it is set or its
-// zero value if it is unset.
-func (v *QueryWorkflowRequest) GetTaskList() (o *shared.TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
-	}
-
-	return
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddActivityTask" for this struct.
+func (v *MatchingService_AddActivityTask_Args) MethodName() string {
+	return "AddActivityTask"
 }
 
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *QueryWorkflowRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *MatchingService_AddActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
 }
 
-// GetQueryRequest returns the value of QueryRequest if it is set or its
-// zero value if it is unset.
-func (v *QueryWorkflowRequest) GetQueryRequest() (o *shared.QueryWorkflowRequest) {
-	if v != nil && v.QueryRequest != nil {
-		return v.QueryRequest
-	}
+// MatchingService_AddActivityTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.AddActivityTask
+// function.
+var MatchingService_AddActivityTask_Helper = struct {
+	// Args accepts the parameters of AddActivityTask in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		addRequest *AddActivityTaskRequest,
+	) *MatchingService_AddActivityTask_Args
 
-	return
-}
+	// IsException returns true if the given error can be thrown
+	// by AddActivityTask.
+	//
+	// An error can be thrown by AddActivityTask only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
 
-// IsSetQueryRequest returns true if QueryRequest is not nil.
-func (v *QueryWorkflowRequest) IsSetQueryRequest() bool {
-	return v != nil && v.QueryRequest != nil
-}
+	// WrapResponse returns the result struct for AddActivityTask
+	// given the error returned by it. The provided error may
+	// be nil if AddActivityTask did not fail.
+	//
+	// This allows mapping errors returned by AddActivityTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddActivityTask
+	//
+	//   err := AddActivityTask(args)
+	//   result, err := MatchingService_AddActivityTask_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddActivityTask: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*MatchingService_AddActivityTask_Result, error)
 
-// GetForwardedFrom returns the value of ForwardedFrom if it is set or its
-// zero value if it is unset.
-func (v *QueryWorkflowRequest) GetForwardedFrom() (o string) {
-	if v != nil && v.ForwardedFrom != nil {
-		return *v.ForwardedFrom
+	// UnwrapResponse takes the result struct for AddActivityTask
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddActivityTask threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := MatchingService_AddActivityTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_AddActivityTask_Result) error
+}{}
+
+func init() {
+	MatchingService_AddActivityTask_Helper.Args = func(
+		addRequest *AddActivityTaskRequest,
+	) *MatchingService_AddActivityTask_Args {
+		return &MatchingService_AddActivityTask_Args{
+			AddRequest: addRequest,
+		}
 	}
 
-	return
-}
+	MatchingService_AddActivityTask_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		case *shared.LimitExceededError:
+			return true
+		case *shared.DomainNotActiveError:
+			return true
+		case *shared.RemoteSyncMatchedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	MatchingService_AddActivityTask_Helper.WrapResponse = func(err error) (*MatchingService_AddActivityTask_Result, error) {
+		if err == nil {
+			return &MatchingService_AddActivityTask_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.BadRequestError")
+			}
+			return &MatchingService_AddActivityTask_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.InternalServiceError")
+			}
+			return &MatchingService_AddActivityTask_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.ServiceBusyError")
+			}
+			return &MatchingService_AddActivityTask_Result{ServiceBusyError: e}, nil
+		case *shared.LimitExceededError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.LimitExceededError")
+			}
+			return &MatchingService_AddActivityTask_Result{LimitExceededError: e}, nil
+		case *shared.DomainNotActiveError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.DomainNotActiveError")
+			}
+			return &MatchingService_AddActivityTask_Result{DomainNotActiveError: e}, nil
+		case *shared.RemoteSyncMatchedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.RemoteSyncMatchedError")
+			}
+			return &MatchingService_AddActivityTask_Result{RemoteSyncMatchedError: e}, nil
+		}
+
+		return nil, err
+	}
+	MatchingService_AddActivityTask_Helper.UnwrapResponse = func(result *MatchingService_AddActivityTask_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		if result.LimitExceededError != nil {
+			err = result.LimitExceededError
+			return
+		}
+		if result.DomainNotActiveError != nil {
+			err = result.DomainNotActiveError
+			return
+		}
+		if result.RemoteSyncMatchedError != nil {
+			err = result.RemoteSyncMatchedError
+			return
+		}
+		return
+	}
 
-// IsSetForwardedFrom returns true if ForwardedFrom is not nil.
-func (v *QueryWorkflowRequest) IsSetForwardedFrom() bool {
-	return v != nil && v.ForwardedFrom != nil
 }
 
-type RespondQueryTaskCompletedRequest struct {
-	DomainUUID       *string                                  `json:"domainUUID,omitempty"`
-	TaskList         *shared.TaskList                         `json:"taskList,omitempty"`
-	TaskID           *string                                  `json:"taskID,omitempty"`
-	CompletedRequest *shared.RespondQueryTaskCompletedRequest `json:"completedRequest,omitempty"`
+// MatchingService_AddActivityTask_Result represents the result of a MatchingService.AddActivityTask function call.
+//
+// The result of a AddActivityTask execution is sent and received over the wire as this struct.
+type MatchingService_AddActivityTask_Result struct {
+	BadRequestError        *shared.BadRequestError        `json:"badRequestError,omitempty"`
+	InternalServiceError   *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
+	ServiceBusyError       *shared.ServiceBusyError       `json:"serviceBusyError,omitempty"`
+	LimitExceededError     *shared.LimitExceededError     `json:"limitExceededError,omitempty"`
+	DomainNotActiveError   *shared.DomainNotActiveError   `json:"domainNotActiveError,omitempty"`
+	RemoteSyncMatchedError *shared.RemoteSyncMatchedError `json:"remoteSyncMatchedError,omitempty"`
 }
 
-// ToWire translates a RespondQueryTaskCompletedRequest struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_AddActivityTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -4962,61 +8260,111 @@ type RespondQueryTaskCompletedRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RespondQueryTaskCompletedRequest) ToWire() (wire.Value, error) {
+func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.DomainUUID != nil {
-		w, err = wire.NewValueString(*(v.DomainUUID)), error(nil)
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.TaskList != nil {
-		w, err = v.TaskList.ToWire()
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.TaskID != nil {
-		w, err = wire.NewValueString(*(v.TaskID)), error(nil)
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.LimitExceededError != nil {
+		w, err = v.LimitExceededError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		w, err = v.DomainNotActiveError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
-	if v.CompletedRequest != nil {
-		w, err = v.CompletedRequest.ToWire()
+	if v.RemoteSyncMatchedError != nil {
+		w, err = v.RemoteSyncMatchedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", i)
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryTaskCompletedRequest, error) {
-	var v shared.RespondQueryTaskCompletedRequest
+func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a RespondQueryTaskCompletedRequest struct from its Thrift-level
+func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
+	var v shared.LimitExceededError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _RemoteSyncMatchedError_Read(w wire.Value) (*shared.RemoteSyncMatchedError, error) {
+	var v shared.RemoteSyncMatchedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_AddActivityTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RespondQueryTaskCompletedRequest struct
+// An error is returned if we were unable to build a MatchingService_AddActivityTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5024,47 +8372,59 @@ func _RespondQueryTaskCompletedRequest_Read(w wire.Value) (*shared.RespondQueryT
 //     return nil, err
 //   }
 //
-//   var v RespondQueryTaskCompletedRequest
+//   var v MatchingService_AddActivityTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
+func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.DomainUUID = &x
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
+		case 2:
 			if field.Value.Type() == wire.TStruct {
-				v.TaskList, err = _TaskList_Read(field.Value)
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.TaskID = &x
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
+		case 4:
 			if field.Value.Type() == wire.TStruct {
-				v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Read(field.Value)
+				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TStruct {
+				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5073,23 +8433,46 @@ func (v *RespondQueryTaskCompletedRequest) FromWire(w wire.Value) error {
 		}
 	}
 
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// Encode serializes a RespondQueryTaskCompletedRequest struct directly into bytes, without going
+// Encode serializes a MatchingService_AddActivityTask_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RespondQueryTaskCompletedRequest struct could not be encoded.
-func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_AddActivityTask_Result struct could not be encoded.
+func (v *MatchingService_AddActivityTask_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.DomainUUID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.BadRequestError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.DomainUUID)); err != nil {
+		if err := v.BadRequestError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5097,11 +8480,11 @@ func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.TaskList != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+	if v.InternalServiceError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.TaskList.Encode(sw); err != nil {
+		if err := v.InternalServiceError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5109,11 +8492,11 @@ func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.TaskID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.TaskID)); err != nil {
+		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5121,11 +8504,35 @@ func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.CompletedRequest != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+	if v.LimitExceededError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.CompletedRequest.Encode(sw); err != nil {
+		if err := v.LimitExceededError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DomainNotActiveError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.DomainNotActiveError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RemoteSyncMatchedError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.RemoteSyncMatchedError.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -5133,21 +8540,75 @@ func (v *RespondQueryTaskCompletedRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		count++
+	}
+
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+	}
+
 	return sw.WriteStructEnd()
 }
 
-func _RespondQueryTaskCompletedRequest_Decode(sr stream.Reader) (*shared.RespondQueryTaskCompletedRequest, error) {
-	var v shared.RespondQueryTaskCompletedRequest
+func _BadRequestError_Decode(sr stream.Reader) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a RespondQueryTaskCompletedRequest struct directly from its Thrift-level
+func _InternalServiceError_Decode(sr stream.Reader) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _ServiceBusyError_Decode(sr stream.Reader) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _LimitExceededError_Decode(sr stream.Reader) (*shared.LimitExceededError, error) {
+	var v shared.LimitExceededError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _DomainNotActiveError_Decode(sr stream.Reader) (*shared.DomainNotActiveError, error) {
+	var v shared.DomainNotActiveError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _RemoteSyncMatchedError_Decode(sr stream.Reader) (*shared.RemoteSyncMatchedError, error) {
+	var v shared.RemoteSyncMatchedError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a MatchingService_AddActivityTask_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RespondQueryTaskCompletedRequest struct could not be generated from the wire
+// An error is returned if a MatchingService_AddActivityTask_Result struct could not be generated from the wire
 // representation.
-func (v *RespondQueryTaskCompletedRequest) Decode(sr stream.Reader) error {
+func (v *MatchingService_AddActivityTask_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -5160,30 +8621,38 @@ func (v *RespondQueryTaskCompletedRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.DomainUUID = &x
+		case fh.ID == 1 && fh.Type == wire.TStruct:
+			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.TaskList, err = _TaskList_Decode(sr)
+		case fh.ID == 2 && fh.Type == wire.TStruct:
+			v.InternalServiceError, err = _InternalServiceError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
+			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.TaskID = &x
+		case fh.ID == 5 && fh.Type == wire.TStruct:
+			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TStruct:
-			v.CompletedRequest, err = _RespondQueryTaskCompletedRequest_Decode(sr)
+		case fh.ID == 6 && fh.Type == wire.TStruct:
+			v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -5207,58 +8676,95 @@ func (v *RespondQueryTaskCompletedRequest) Decode(sr stream.Reader) error {
 		return err
 	}
 
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if v.LimitExceededError != nil {
+		count++
+	}
+	if v.DomainNotActiveError != nil {
+		count++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a RespondQueryTaskCompletedRequest
+// String returns a readable string representation of a MatchingService_AddActivityTask_Result
 // struct.
-func (v *RespondQueryTaskCompletedRequest) String() string {
+func (v *MatchingService_AddActivityTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
-	if v.DomainUUID != nil {
-		fields[i] = fmt.Sprintf("DomainUUID: %v", *(v.DomainUUID))
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.TaskList != nil {
-		fields[i] = fmt.Sprintf("TaskList: %v", v.TaskList)
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.TaskID != nil {
-		fields[i] = fmt.Sprintf("TaskID: %v", *(v.TaskID))
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
-	if v.CompletedRequest != nil {
-		fields[i] = fmt.Sprintf("CompletedRequest: %v", v.CompletedRequest)
+	if v.LimitExceededError != nil {
+		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
+		i++
+	}
+	if v.DomainNotActiveError != nil {
+		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
+		i++
+	}
+	if v.RemoteSyncMatchedError != nil {
+		fields[i] = fmt.Sprintf("RemoteSyncMatchedError: %v", v.RemoteSyncMatchedError)
 		i++
 	}
 
-	return fmt.Sprintf("RespondQueryTaskCompletedRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_AddActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RespondQueryTaskCompletedRequest match the
-// provided RespondQueryTaskCompletedRequest.
+// Equals returns true if all the fields of this MatchingService_AddActivityTask_Result match the
+// provided MatchingService_AddActivityTask_Result.
 //
 // This function performs a deep comparison.
-func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompletedRequest) bool {
+func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_AddActivityTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.DomainUUID, rhs.DomainUUID) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.TaskList == nil && rhs.TaskList == nil) || (v.TaskList != nil && rhs.TaskList != nil && v.TaskList.Equals(rhs.TaskList))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.TaskID, rhs.TaskID) {
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
-	if !((v.CompletedRequest == nil && rhs.CompletedRequest == nil) || (v.CompletedRequest != nil && rhs.CompletedRequest != nil && v.CompletedRequest.Equals(rhs.CompletedRequest))) {
+	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
+		return false
+	}
+	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
+		return false
+	}
+	if !((v.RemoteSyncMatchedError == nil && rhs.RemoteSyncMatchedError == nil) || (v.RemoteSyncMatchedError != nil && rhs.RemoteSyncMatchedError != nil && v.RemoteSyncMatchedError.Equals(rhs.RemoteSyncMatchedError))) {
 		return false
 	}
 
@@ -5266,307 +8772,145 @@ func (v *RespondQueryTaskCompletedRequest) Equals(rhs *RespondQueryTaskCompleted
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RespondQueryTaskCompletedRequest.
-func (v *RespondQueryTaskCompletedRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_AddActivityTask_Result.
+func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DomainUUID != nil {
-		enc.AddString("domainUUID", *v.DomainUUID)
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.TaskList != nil {
-		err = multierr.Append(err, enc.AddObject("taskList", v.TaskList))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.TaskID != nil {
-		enc.AddString("taskID", *v.TaskID)
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.CompletedRequest != nil {
-		err = multierr.Append(err, enc.AddObject("completedRequest", v.CompletedRequest))
+	if v.LimitExceededError != nil {
+		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
 	}
-	return err
-}
-
-// GetDomainUUID returns the value of DomainUUID if it is set or its
-// zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetDomainUUID() (o string) {
-	if v != nil && v.DomainUUID != nil {
-		return *v.DomainUUID
+	if v.DomainNotActiveError != nil {
+		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
 	}
-
-	return
-}
-
-// IsSetDomainUUID returns true if DomainUUID is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
-	return v != nil && v.DomainUUID != nil
-}
-
-// GetTaskList returns the value of TaskList if it is set or its
-// zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskList() (o *shared.TaskList) {
-	if v != nil && v.TaskList != nil {
-		return v.TaskList
+	if v.RemoteSyncMatchedError != nil {
+		err = multierr.Append(err, enc.AddObject("remoteSyncMatchedError", v.RemoteSyncMatchedError))
 	}
-
-	return
-}
-
-// IsSetTaskList returns true if TaskList is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
-	return v != nil && v.TaskList != nil
+	return err
 }
 
-// GetTaskID returns the value of TaskID if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetTaskID() (o string) {
-	if v != nil && v.TaskID != nil {
-		return *v.TaskID
+func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetTaskID returns true if TaskID is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
-	return v != nil && v.TaskID != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-// GetCompletedRequest returns the value of CompletedRequest if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *RespondQueryTaskCompletedRequest) GetCompletedRequest() (o *shared.RespondQueryTaskCompletedRequest) {
-	if v != nil && v.CompletedRequest != nil {
-		return v.CompletedRequest
-	}
-
-	return
-}
-
-// IsSetCompletedRequest returns true if CompletedRequest is not nil.
-func (v *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
-	return v != nil && v.CompletedRequest != nil
-}
-
-type TaskSource int32
-
-const (
-	TaskSourceHistory   TaskSource = 0
-	TaskSourceDbBacklog TaskSource = 1
-)
-
-// TaskSource_Values returns all recognized values of TaskSource.
-func TaskSource_Values() []TaskSource {
-	return []TaskSource{
-		TaskSourceHistory,
-		TaskSourceDbBacklog,
-	}
-}
-
-// UnmarshalText tries to decode TaskSource from a byte slice
-// containing its name.
-//
-//   var v TaskSource
-//   err := v.UnmarshalText([]byte("HISTORY"))
-func (v *TaskSource) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "HISTORY":
-		*v = TaskSourceHistory
-		return nil
-	case "DB_BACKLOG":
-		*v = TaskSourceDbBacklog
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskSource", err)
-		}
-		*v = TaskSource(val)
-		return nil
-	}
-}
-
-// MarshalText encodes TaskSource to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v TaskSource) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("HISTORY"), nil
-	case 1:
-		return []byte("DB_BACKLOG"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TaskSource.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v TaskSource) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "HISTORY")
-	case 1:
-		enc.AddString("name", "DB_BACKLOG")
+func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
-	return nil
-}
-
-// Ptr returns a pointer to this enum value.
-func (v TaskSource) Ptr() *TaskSource {
-	return &v
-}
-
-// Encode encodes TaskSource directly to bytes.
-//
-//   sWriter := BinaryStreamer.Writer(writer)
-//
-//   var v TaskSource
-//   return v.Encode(sWriter)
-func (v TaskSource) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
-}
 
-// ToWire translates TaskSource into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v TaskSource) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
+	return
 }
 
-// FromWire deserializes TaskSource from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return TaskSource(0), err
-//   }
-//
-//   var v TaskSource
-//   if err := v.FromWire(x); err != nil {
-//     return TaskSource(0), err
-//   }
-//   return v, nil
-func (v *TaskSource) FromWire(w wire.Value) error {
-	*v = (TaskSource)(w.GetI32())
-	return nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-// Decode reads off the encoded TaskSource directly off of the wire.
-//
-//   sReader := BinaryStreamer.Reader(reader)
-//
-//   var v TaskSource
-//   if err := v.Decode(sReader); err != nil {
-//     return TaskSource(0), err
-//   }
-//   return v, nil
-func (v *TaskSource) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
-		return err
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
 	}
-	*v = (TaskSource)(i)
-	return nil
+
+	return
 }
 
-// String returns a readable string representation of TaskSource.
-func (v TaskSource) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "HISTORY"
-	case 1:
-		return "DB_BACKLOG"
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// GetLimitExceededError returns the value of LimitExceededError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+	if v != nil && v.LimitExceededError != nil {
+		return v.LimitExceededError
 	}
-	return fmt.Sprintf("TaskSource(%d)", w)
+
+	return
 }
 
-// Equals returns true if this TaskSource value matches the provided
-// value.
-func (v TaskSource) Equals(rhs TaskSource) bool {
-	return v == rhs
+// IsSetLimitExceededError returns true if LimitExceededError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetLimitExceededError() bool {
+	return v != nil && v.LimitExceededError != nil
 }
 
-// MarshalJSON serializes TaskSource into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v TaskSource) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"HISTORY\""), nil
-	case 1:
-		return ([]byte)("\"DB_BACKLOG\""), nil
+// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+	if v != nil && v.DomainNotActiveError != nil {
+		return v.DomainNotActiveError
 	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+
+	return
 }
 
-// UnmarshalJSON attempts to decode TaskSource from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *TaskSource) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
+// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetDomainNotActiveError() bool {
+	return v != nil && v.DomainNotActiveError != nil
+}
 
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskSource")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskSource")
-		}
-		*v = (TaskSource)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskSource")
+// GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_AddActivityTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
+	if v != nil && v.RemoteSyncMatchedError != nil {
+		return v.RemoteSyncMatchedError
 	}
+
+	return
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "matching",
-	Package:  "github.com/uber/cadence/.gen/go/matching",
-	FilePath: "matching.thrift",
-	SHA1:     "dfeeaa9142345924cb1a3a7e18106dc72465efdf",
-	Includes: []*thriftreflect.ThriftModule{
-		shared.ThriftModule,
-	},
-	Raw: rawIDL,
+// IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
+func (v *MatchingService_AddActivityTask_Result) IsSetRemoteSyncMatchedError() bool {
+	return v != nil && v.RemoteSyncMatchedError != nil
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\ninclude \"shared.thrift\"\n\nnamespace java com.uber.cadence.matching\n\n// TaskSource is the source from which a task was produced\nenum TaskSource {\n    HISTORY,    // Task produced by history service\n    DB_BACKLOG // Task produced from matching db backlog\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForDecisionTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional shared.WorkflowExecution workflowExecution\n  30: optional shared.WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = \"Long\") attempt\n  60: optional i64 (js.type = \"Long\") nextEventId\n  65: optional i64 (js.type = \"Long\") backlogCountHint\n  70: optional bool stickyExecutionEnabled\n  80: optional shared.WorkflowQuery query\n  90: optional shared.TransientDecisionInfo decisionInfo\n  100: optional shared.TaskList WorkflowExecutionTaskList\n  110: optional i32 eventStoreVersion\n  120: optional binary branchToken\n  130: optional i64 (js.type = \"Long\") scheduledTimestamp\n  140: optional i64 (js.type = \"Long\") startedTimestamp\n  150: optional map<string, shared.WorkflowQuery> queries\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domainUUID\n  15: optional string pollerID\n  20: optional shared.PollForActivityTaskRequest pollRequest\n  30: optional string forwardedFrom\n}\n\nstruct AddDecisionTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional shared.TaskList taskList\n  40: optional i64 (js.type = \"Long\") scheduleId\n  50: optional i32 scheduleToStartTimeoutSeconds\n  59: optional TaskSource source\n  60: optional string forwardedFrom\n}\n\nstruct AddActivityTaskRequest {\n  10: optional string domainUUID\n  20: optional shared.WorkflowExecution execution\n  30: optional string sourceDomainUUID\n  40: optional shared.TaskList taskList\n  50: optional i64 (js.type = \"Long\") scheduleId\n  60: optional i32 scheduleToStartTimeoutSeconds\n  69: optional TaskSource source\n  70: optional string forwardedFrom\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional shared.QueryWorkflowRequest queryRequest\n  40: optional string forwardedFrom\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional string domainUUID\n  20: optional shared.TaskList taskList\n  30: optional string taskID\n  40: optional shared.RespondQueryTaskCompletedRequest completedRequest\n}\n\nstruct CancelOutstandingPollRequest {\n  10: optional string domainUUID\n  20: optional i32 taskListType\n  30: optional shared.TaskList taskList\n  40: optional string pollerID\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domainUUID\n  20: optional shared.DescribeTaskListRequest descRequest\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional shared.TaskList taskList\n}\n\n/**\n* MatchingService API is exposed to provide support for polling from long running applications.\n* Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each\n* DecisionTask, application is expected to process the history of events for that session and respond back with next\n* decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back\n* with completion or failure.\n**/\nservice MatchingService {\n  /**\n  * PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A\n  * DecisionTask is dispatched to callers for active workflow executions, with pending decisions.\n  **/\n  PollForDecisionTaskResponse PollForDecisionTask(1: PollForDecisionTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask\n  * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.\n  **/\n  shared.PollForActivityTaskResponse PollForActivityTask(1: PollForActivityTaskRequest pollRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.LimitExceededError limitExceededError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddDecisionTask(1: AddDecisionTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n    )\n\n  /**\n  * AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched\n  * by the MatchingEngine.\n  **/\n  void AddActivityTask(1: AddActivityTaskRequest addRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.DomainNotActiveError domainNotActiveError,\n      6: shared.RemoteSyncMatchedError remoteSyncMatchedError,\n    )\n\n  /**\n  * QueryWorkflow is called by frontend to query a workflow.\n  **/\n  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.QueryFailedError queryFailedError,\n      5: shared.LimitExceededError limitExceededError,\n      6: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * RespondQueryTaskCompleted is called by frontend to respond query completed.\n  **/\n  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.LimitExceededError limitExceededError,\n      5: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n    * CancelOutstandingPoll is called by frontend to unblock long polls on matching for zombie pollers.\n    * Our rpc stack does not support context propagation, so when a client connection goes away frontend sees\n    * cancellation of context for that handler, but any corresponding calls (long-poll) to matching service does not\n    * see the cancellation propagated so it can unblock corresponding long-polls on its end.  This results is tasks\n    * being dispatched to zombie pollers in this situation.  This API is added so everytime frontend makes a long-poll\n    * api call to matching it passes in a pollerID and then calls this API when it detects client connection is closed\n    * to unblock long polls for this poller and prevent tasks being sent to these zombie pollers.\n    **/\n  void CancelOutstandingPoll(1: CancelOutstandingPollRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * DescribeTaskList returns information about the target tasklist, right now this API returns the\n  * pollers which polled this tasklist in last few minutes.\n  **/\n  shared.DescribeTaskListResponse DescribeTaskList(1: DescribeTaskListRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * GetTaskListsByDomain returns the list of all the task lists for a domainName.\n  **/\n  shared.GetTaskListsByDomainResponse GetTaskListsByDomain(1: shared.GetTaskListsByDomainRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        3: shared.EntityNotExistsError entityNotExistError,\n        4: shared.ServiceBusyError serviceBusyError,\n      )\n\n  /**\n  * ListTaskListPartitions returns a map of partitionKey and hostAddress for a taskList\n  **/\n  shared.ListTaskListPartitionsResponse ListTaskListPartitions(1: ListTaskListPartitionsRequest request)\n    throws (\n        1: shared.BadRequestError badRequestError,\n        2: shared.InternalServiceError internalServiceError,\n        4: shared.ServiceBusyError serviceBusyError,\n    )\n}\n"
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddActivityTask" for this struct.
+func (v *MatchingService_AddActivityTask_Result) MethodName() string {
+	return "AddActivityTask"
+}
 
-// MatchingService_AddActivityTask_Args represents the arguments for the MatchingService.AddActivityTask function.
+// EnvelopeType returns the kind of value inside this struct.
 //
-// The arguments for AddActivityTask are sent and received over the wire as this struct.
-type MatchingService_AddActivityTask_Args struct {
-	AddRequest *AddActivityTaskRequest `json:"addRequest,omitempty"`
+// This will always be Reply for this struct.
+func (v *MatchingService_AddActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
 }
 
-// ToWire translates a MatchingService_AddActivityTask_Args struct into a Thrift-level intermediate
+// MatchingService_AddDecisionTask_Args represents the arguments for the MatchingService.AddDecisionTask function.
+//
+// The arguments for AddDecisionTask are sent and received over the wire as this struct.
+type MatchingService_AddDecisionTask_Args struct {
+	AddRequest *AddDecisionTaskRequest `json:"addRequest,omitempty"`
+}
+
+// ToWire translates a MatchingService_AddDecisionTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5581,7 +8925,7 @@ type MatchingService_AddActivityTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -5601,17 +8945,17 @@ func (v *MatchingService_AddActivityTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error) {
-	var v AddActivityTaskRequest
+func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error) {
+	var v AddDecisionTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddActivityTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_AddDecisionTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddActivityTask_Args struct
+// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -5619,19 +8963,19 @@ func _AddActivityTaskRequest_Read(w wire.Value) (*AddActivityTaskRequest, error)
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddActivityTask_Args
+//   var v MatchingService_AddDecisionTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.AddRequest, err = _AddActivityTaskRequest_Read(field.Value)
+				v.AddRequest, err = _AddDecisionTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -5643,11 +8987,11 @@ func (v *MatchingService_AddActivityTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a MatchingService_AddActivityTask_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_AddDecisionTask_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_AddActivityTask_Args struct could not be encoded.
-func (v *MatchingService_AddActivityTask_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_AddDecisionTask_Args struct could not be encoded.
+func (v *MatchingService_AddDecisionTask_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -5667,18 +9011,18 @@ func (v *MatchingService_AddActivityTask_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _AddActivityTaskRequest_Decode(sr stream.Reader) (*AddActivityTaskRequest, error) {
-	var v AddActivityTaskRequest
+func _AddDecisionTaskRequest_Decode(sr stream.Reader) (*AddDecisionTaskRequest, error) {
+	var v AddDecisionTaskRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_AddActivityTask_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_AddDecisionTask_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_AddActivityTask_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_AddDecisionTask_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_AddActivityTask_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_AddDecisionTask_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -5692,7 +9036,7 @@ func (v *MatchingService_AddActivityTask_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.AddRequest, err = _AddActivityTaskRequest_Decode(sr)
+			v.AddRequest, err = _AddDecisionTaskRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -5719,9 +9063,9 @@ func (v *MatchingService_AddActivityTask_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddActivityTask_Args
+// String returns a readable string representation of a MatchingService_AddDecisionTask_Args
 // struct.
-func (v *MatchingService_AddActivityTask_Args) String() string {
+func (v *MatchingService_AddDecisionTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -5733,14 +9077,14 @@ func (v *MatchingService_AddActivityTask_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddActivityTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_AddDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddActivityTask_Args match the
-// provided MatchingService_AddActivityTask_Args.
+// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Args match the
+// provided MatchingService_AddDecisionTask_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddActivityTask_Args) bool {
+func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDecisionTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -5754,8 +9098,8 @@ func (v *MatchingService_AddActivityTask_Args) Equals(rhs *MatchingService_AddAc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddActivityTask_Args.
-func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_AddDecisionTask_Args.
+func (v *MatchingService_AddDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -5767,7 +9111,7 @@ func (v *MatchingService_AddActivityTask_Args) MarshalLogObject(enc zapcore.Obje
 
 // GetAddRequest returns the value of AddRequest if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTaskRequest) {
+func (v *MatchingService_AddDecisionTask_Args) GetAddRequest() (o *AddDecisionTaskRequest) {
 	if v != nil && v.AddRequest != nil {
 		return v.AddRequest
 	}
@@ -5776,81 +9120,81 @@ func (v *MatchingService_AddActivityTask_Args) GetAddRequest() (o *AddActivityTa
 }
 
 // IsSetAddRequest returns true if AddRequest is not nil.
-func (v *MatchingService_AddActivityTask_Args) IsSetAddRequest() bool {
+func (v *MatchingService_AddDecisionTask_Args) IsSetAddRequest() bool {
 	return v != nil && v.AddRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddActivityTask" for this struct.
-func (v *MatchingService_AddActivityTask_Args) MethodName() string {
-	return "AddActivityTask"
+// This will always be "AddDecisionTask" for this struct.
+func (v *MatchingService_AddDecisionTask_Args) MethodName() string {
+	return "AddDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_AddActivityTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_AddDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_AddActivityTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.AddActivityTask
+// MatchingService_AddDecisionTask_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.AddDecisionTask
 // function.
-var MatchingService_AddActivityTask_Helper = struct {
-	// Args accepts the parameters of AddActivityTask in-order and returns
+var MatchingService_AddDecisionTask_Helper = struct {
+	// Args accepts the parameters of AddDecisionTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		addRequest *AddActivityTaskRequest,
-	) *MatchingService_AddActivityTask_Args
+		addRequest *AddDecisionTaskRequest,
+	) *MatchingService_AddDecisionTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddActivityTask.
+	// by AddDecisionTask.
 	//
-	// An error can be thrown by AddActivityTask only if the
+	// An error can be thrown by AddDecisionTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddActivityTask
+	// WrapResponse returns the result struct for AddDecisionTask
 	// given the error returned by it. The provided error may
-	// be nil if AddActivityTask did not fail.
+	// be nil if AddDecisionTask did not fail.
 	//
-	// This allows mapping errors returned by AddActivityTask into a
+	// This allows mapping errors returned by AddDecisionTask into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// AddActivityTask
+	// AddDecisionTask
 	//
-	//   err := AddActivityTask(args)
-	//   result, err := MatchingService_AddActivityTask_Helper.WrapResponse(err)
+	//   err := AddDecisionTask(args)
+	//   result, err := MatchingService_AddDecisionTask_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddActivityTask: %v", err)
+	//     return fmt.Errorf("unexpected error from AddDecisionTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_AddActivityTask_Result, error)
+	WrapResponse func(error) (*MatchingService_AddDecisionTask_Result, error)
 
-	// UnwrapResponse takes the result struct for AddActivityTask
+	// UnwrapResponse takes the result struct for AddDecisionTask
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if AddActivityTask threw an
+	// The error is non-nil only if AddDecisionTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_AddActivityTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_AddActivityTask_Result) error
+	//   err := MatchingService_AddDecisionTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_AddDecisionTask_Result) error
 }{}
 
 func init() {
-	MatchingService_AddActivityTask_Helper.Args = func(
-		addRequest *AddActivityTaskRequest,
-	) *MatchingService_AddActivityTask_Args {
-		return &MatchingService_AddActivityTask_Args{
+	MatchingService_AddDecisionTask_Helper.Args = func(
+		addRequest *AddDecisionTaskRequest,
+	) *MatchingService_AddDecisionTask_Args {
+		return &MatchingService_AddDecisionTask_Args{
 			AddRequest: addRequest,
 		}
 	}
 
-	MatchingService_AddActivityTask_Helper.IsException = func(err error) bool {
+	MatchingService_AddDecisionTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -5869,47 +9213,47 @@ func init() {
 		}
 	}
 
-	MatchingService_AddActivityTask_Helper.WrapResponse = func(err error) (*MatchingService_AddActivityTask_Result, error) {
+	MatchingService_AddDecisionTask_Helper.WrapResponse = func(err error) (*MatchingService_AddDecisionTask_Result, error) {
 		if err == nil {
-			return &MatchingService_AddActivityTask_Result{}, nil
+			return &MatchingService_AddDecisionTask_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.BadRequestError")
 			}
-			return &MatchingService_AddActivityTask_Result{BadRequestError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.InternalServiceError")
 			}
-			return &MatchingService_AddActivityTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.ServiceBusyError")
 			}
-			return &MatchingService_AddActivityTask_Result{ServiceBusyError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{ServiceBusyError: e}, nil
 		case *shared.LimitExceededError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.LimitExceededError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.LimitExceededError")
 			}
-			return &MatchingService_AddActivityTask_Result{LimitExceededError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{LimitExceededError: e}, nil
 		case *shared.DomainNotActiveError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.DomainNotActiveError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.DomainNotActiveError")
 			}
-			return &MatchingService_AddActivityTask_Result{DomainNotActiveError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{DomainNotActiveError: e}, nil
 		case *shared.RemoteSyncMatchedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddActivityTask_Result.RemoteSyncMatchedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.RemoteSyncMatchedError")
 			}
-			return &MatchingService_AddActivityTask_Result{RemoteSyncMatchedError: e}, nil
+			return &MatchingService_AddDecisionTask_Result{RemoteSyncMatchedError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_AddActivityTask_Helper.UnwrapResponse = func(result *MatchingService_AddActivityTask_Result) (err error) {
+	MatchingService_AddDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_AddDecisionTask_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -5939,10 +9283,10 @@ func init() {
 
 }
 
-// MatchingService_AddActivityTask_Result represents the result of a MatchingService.AddActivityTask function call.
+// MatchingService_AddDecisionTask_Result represents the result of a MatchingService.AddDecisionTask function call.
 //
-// The result of a AddActivityTask execution is sent and received over the wire as this struct.
-type MatchingService_AddActivityTask_Result struct {
+// The result of a AddDecisionTask execution is sent and received over the wire as this struct.
+type MatchingService_AddDecisionTask_Result struct {
 	BadRequestError        *shared.BadRequestError        `json:"badRequestError,omitempty"`
 	InternalServiceError   *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
 	ServiceBusyError       *shared.ServiceBusyError       `json:"serviceBusyError,omitempty"`
@@ -5951,7 +9295,7 @@ type MatchingService_AddActivityTask_Result struct {
 	RemoteSyncMatchedError *shared.RemoteSyncMatchedError `json:"remoteSyncMatchedError,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddActivityTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_AddDecisionTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -5966,7 +9310,7 @@ type MatchingService_AddActivityTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [6]wire.Field
 		i      int = 0
@@ -6024,53 +9368,17 @@ func (v *MatchingService_AddActivityTask_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
-	var v shared.LimitExceededError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _DomainNotActiveError_Read(w wire.Value) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _RemoteSyncMatchedError_Read(w wire.Value) (*shared.RemoteSyncMatchedError, error) {
-	var v shared.RemoteSyncMatchedError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a MatchingService_AddActivityTask_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_AddDecisionTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddActivityTask_Result struct
+// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6078,12 +9386,12 @@ func _RemoteSyncMatchedError_Read(w wire.Value) (*shared.RemoteSyncMatchedError,
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddActivityTask_Result
+//   var v MatchingService_AddDecisionTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -6159,17 +9467,17 @@ func (v *MatchingService_AddActivityTask_Result) FromWire(w wire.Value) error {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_AddActivityTask_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_AddDecisionTask_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_AddActivityTask_Result struct could not be encoded.
-func (v *MatchingService_AddActivityTask_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_AddDecisionTask_Result struct could not be encoded.
+func (v *MatchingService_AddDecisionTask_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -6267,54 +9575,18 @@ func (v *MatchingService_AddActivityTask_Result) Encode(sw stream.Writer) error
 	}
 
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _BadRequestError_Decode(sr stream.Reader) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _InternalServiceError_Decode(sr stream.Reader) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _ServiceBusyError_Decode(sr stream.Reader) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _LimitExceededError_Decode(sr stream.Reader) (*shared.LimitExceededError, error) {
-	var v shared.LimitExceededError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _DomainNotActiveError_Decode(sr stream.Reader) (*shared.DomainNotActiveError, error) {
-	var v shared.DomainNotActiveError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _RemoteSyncMatchedError_Decode(sr stream.Reader) (*shared.RemoteSyncMatchedError, error) {
-	var v shared.RemoteSyncMatchedError
-	err := v.Decode(sr)
-	return &v, err
-}
-
-// Decode deserializes a MatchingService_AddActivityTask_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_AddDecisionTask_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_AddActivityTask_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_AddDecisionTask_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_AddActivityTask_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_AddDecisionTask_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6402,15 +9674,15 @@ func (v *MatchingService_AddActivityTask_Result) Decode(sr stream.Reader) error
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddActivityTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddActivityTask_Result
+// String returns a readable string representation of a MatchingService_AddDecisionTask_Result
 // struct.
-func (v *MatchingService_AddActivityTask_Result) String() string {
+func (v *MatchingService_AddDecisionTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -6442,14 +9714,14 @@ func (v *MatchingService_AddActivityTask_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddActivityTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_AddDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddActivityTask_Result match the
-// provided MatchingService_AddActivityTask_Result.
+// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Result match the
+// provided MatchingService_AddDecisionTask_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_AddActivityTask_Result) bool {
+func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_AddDecisionTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -6478,8 +9750,8 @@ func (v *MatchingService_AddActivityTask_Result) Equals(rhs *MatchingService_Add
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddActivityTask_Result.
-func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_AddDecisionTask_Result.
+func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -6506,7 +9778,7 @@ func (v *MatchingService_AddActivityTask_Result) MarshalLogObject(enc zapcore.Ob
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -6515,13 +9787,13 @@ func (v *MatchingService_AddActivityTask_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -6530,13 +9802,13 @@ func (v *MatchingService_AddActivityTask_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -6545,13 +9817,13 @@ func (v *MatchingService_AddActivityTask_Result) GetServiceBusyError() (o *share
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // GetLimitExceededError returns the value of LimitExceededError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
+func (v *MatchingService_AddDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
 	if v != nil && v.LimitExceededError != nil {
 		return v.LimitExceededError
 	}
@@ -6560,13 +9832,13 @@ func (v *MatchingService_AddActivityTask_Result) GetLimitExceededError() (o *sha
 }
 
 // IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetLimitExceededError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetLimitExceededError() bool {
 	return v != nil && v.LimitExceededError != nil
 }
 
 // GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
+func (v *MatchingService_AddDecisionTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
 	if v != nil && v.DomainNotActiveError != nil {
 		return v.DomainNotActiveError
 	}
@@ -6575,13 +9847,13 @@ func (v *MatchingService_AddActivityTask_Result) GetDomainNotActiveError() (o *s
 }
 
 // IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetDomainNotActiveError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetDomainNotActiveError() bool {
 	return v != nil && v.DomainNotActiveError != nil
 }
 
 // GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddActivityTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
+func (v *MatchingService_AddDecisionTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
 	if v != nil && v.RemoteSyncMatchedError != nil {
 		return v.RemoteSyncMatchedError
 	}
@@ -6590,33 +9862,33 @@ func (v *MatchingService_AddActivityTask_Result) GetRemoteSyncMatchedError() (o
 }
 
 // IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
-func (v *MatchingService_AddActivityTask_Result) IsSetRemoteSyncMatchedError() bool {
+func (v *MatchingService_AddDecisionTask_Result) IsSetRemoteSyncMatchedError() bool {
 	return v != nil && v.RemoteSyncMatchedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddActivityTask" for this struct.
-func (v *MatchingService_AddActivityTask_Result) MethodName() string {
-	return "AddActivityTask"
+// This will always be "AddDecisionTask" for this struct.
+func (v *MatchingService_AddDecisionTask_Result) MethodName() string {
+	return "AddDecisionTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_AddActivityTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_AddDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_AddDecisionTask_Args represents the arguments for the MatchingService.AddDecisionTask function.
+// MatchingService_CancelOutstandingPoll_Args represents the arguments for the MatchingService.CancelOutstandingPoll function.
 //
-// The arguments for AddDecisionTask are sent and received over the wire as this struct.
-type MatchingService_AddDecisionTask_Args struct {
-	AddRequest *AddDecisionTaskRequest `json:"addRequest,omitempty"`
+// The arguments for CancelOutstandingPoll are sent and received over the wire as this struct.
+type MatchingService_CancelOutstandingPoll_Args struct {
+	Request *CancelOutstandingPollRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddDecisionTask_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_CancelOutstandingPoll_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -6631,7 +9903,7 @@ type MatchingService_AddDecisionTask_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -6639,8 +9911,8 @@ func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.AddRequest != nil {
-		w, err = v.AddRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -6651,17 +9923,17 @@ func (v *MatchingService_AddDecisionTask_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error) {
-	var v AddDecisionTaskRequest
+func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollRequest, error) {
+	var v CancelOutstandingPollRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_AddDecisionTask_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_CancelOutstandingPoll_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Args struct
+// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -6669,19 +9941,19 @@ func _AddDecisionTaskRequest_Read(w wire.Value) (*AddDecisionTaskRequest, error)
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddDecisionTask_Args
+//   var v MatchingService_CancelOutstandingPoll_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.AddRequest, err = _AddDecisionTaskRequest_Read(field.Value)
+				v.Request, err = _CancelOutstandingPollRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -6693,20 +9965,20 @@ func (v *MatchingService_AddDecisionTask_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a MatchingService_AddDecisionTask_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_CancelOutstandingPoll_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_AddDecisionTask_Args struct could not be encoded.
-func (v *MatchingService_AddDecisionTask_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_CancelOutstandingPoll_Args struct could not be encoded.
+func (v *MatchingService_CancelOutstandingPoll_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.AddRequest != nil {
+	if v.Request != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := v.AddRequest.Encode(sw); err != nil {
+		if err := v.Request.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -6717,18 +9989,18 @@ func (v *MatchingService_AddDecisionTask_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _AddDecisionTaskRequest_Decode(sr stream.Reader) (*AddDecisionTaskRequest, error) {
-	var v AddDecisionTaskRequest
+func _CancelOutstandingPollRequest_Decode(sr stream.Reader) (*CancelOutstandingPollRequest, error) {
+	var v CancelOutstandingPollRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_AddDecisionTask_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_CancelOutstandingPoll_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_AddDecisionTask_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_CancelOutstandingPoll_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_AddDecisionTask_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_CancelOutstandingPoll_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -6742,7 +10014,7 @@ func (v *MatchingService_AddDecisionTask_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.AddRequest, err = _AddDecisionTaskRequest_Decode(sr)
+			v.Request, err = _CancelOutstandingPollRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -6769,34 +10041,34 @@ func (v *MatchingService_AddDecisionTask_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddDecisionTask_Args
+// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Args
 // struct.
-func (v *MatchingService_AddDecisionTask_Args) String() string {
+func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.AddRequest != nil {
-		fields[i] = fmt.Sprintf("AddRequest: %v", v.AddRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_AddDecisionTask_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Args match the
-// provided MatchingService_AddDecisionTask_Args.
+// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Args match the
+// provided MatchingService_CancelOutstandingPoll_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDecisionTask_Args) bool {
+func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService_CancelOutstandingPoll_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.AddRequest == nil && rhs.AddRequest == nil) || (v.AddRequest != nil && rhs.AddRequest != nil && v.AddRequest.Equals(rhs.AddRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -6804,103 +10076,103 @@ func (v *MatchingService_AddDecisionTask_Args) Equals(rhs *MatchingService_AddDe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddDecisionTask_Args.
-func (v *MatchingService_AddDecisionTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_CancelOutstandingPoll_Args.
+func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.AddRequest != nil {
-		err = multierr.Append(err, enc.AddObject("addRequest", v.AddRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetAddRequest returns the value of AddRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Args) GetAddRequest() (o *AddDecisionTaskRequest) {
-	if v != nil && v.AddRequest != nil {
-		return v.AddRequest
+func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOutstandingPollRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetAddRequest returns true if AddRequest is not nil.
-func (v *MatchingService_AddDecisionTask_Args) IsSetAddRequest() bool {
-	return v != nil && v.AddRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *MatchingService_CancelOutstandingPoll_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddDecisionTask" for this struct.
-func (v *MatchingService_AddDecisionTask_Args) MethodName() string {
-	return "AddDecisionTask"
+// This will always be "CancelOutstandingPoll" for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Args) MethodName() string {
+	return "CancelOutstandingPoll"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_AddDecisionTask_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_CancelOutstandingPoll_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_AddDecisionTask_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.AddDecisionTask
+// MatchingService_CancelOutstandingPoll_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.CancelOutstandingPoll
 // function.
-var MatchingService_AddDecisionTask_Helper = struct {
-	// Args accepts the parameters of AddDecisionTask in-order and returns
+var MatchingService_CancelOutstandingPoll_Helper = struct {
+	// Args accepts the parameters of CancelOutstandingPoll in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		addRequest *AddDecisionTaskRequest,
-	) *MatchingService_AddDecisionTask_Args
+		request *CancelOutstandingPollRequest,
+	) *MatchingService_CancelOutstandingPoll_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddDecisionTask.
+	// by CancelOutstandingPoll.
 	//
-	// An error can be thrown by AddDecisionTask only if the
+	// An error can be thrown by CancelOutstandingPoll only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddDecisionTask
+	// WrapResponse returns the result struct for CancelOutstandingPoll
 	// given the error returned by it. The provided error may
-	// be nil if AddDecisionTask did not fail.
+	// be nil if CancelOutstandingPoll did not fail.
 	//
-	// This allows mapping errors returned by AddDecisionTask into a
+	// This allows mapping errors returned by CancelOutstandingPoll into a
 	// serializable result struct. WrapResponse returns a
 	// non-nil error if the provided error cannot be thrown by
-	// AddDecisionTask
+	// CancelOutstandingPoll
 	//
-	//   err := AddDecisionTask(args)
-	//   result, err := MatchingService_AddDecisionTask_Helper.WrapResponse(err)
+	//   err := CancelOutstandingPoll(args)
+	//   result, err := MatchingService_CancelOutstandingPoll_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddDecisionTask: %v", err)
+	//     return fmt.Errorf("unexpected error from CancelOutstandingPoll: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_AddDecisionTask_Result, error)
+	WrapResponse func(error) (*MatchingService_CancelOutstandingPoll_Result, error)
 
-	// UnwrapResponse takes the result struct for AddDecisionTask
+	// UnwrapResponse takes the result struct for CancelOutstandingPoll
 	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if AddDecisionTask threw an
+	// The error is non-nil only if CancelOutstandingPoll threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_AddDecisionTask_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_AddDecisionTask_Result) error
+	//   err := MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_CancelOutstandingPoll_Result) error
 }{}
 
 func init() {
-	MatchingService_AddDecisionTask_Helper.Args = func(
-		addRequest *AddDecisionTaskRequest,
-	) *MatchingService_AddDecisionTask_Args {
-		return &MatchingService_AddDecisionTask_Args{
-			AddRequest: addRequest,
+	MatchingService_CancelOutstandingPoll_Helper.Args = func(
+		request *CancelOutstandingPollRequest,
+	) *MatchingService_CancelOutstandingPoll_Args {
+		return &MatchingService_CancelOutstandingPoll_Args{
+			Request: request,
 		}
 	}
 
-	MatchingService_AddDecisionTask_Helper.IsException = func(err error) bool {
+	MatchingService_CancelOutstandingPoll_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -6908,58 +10180,37 @@ func init() {
 			return true
 		case *shared.ServiceBusyError:
 			return true
-		case *shared.LimitExceededError:
-			return true
-		case *shared.DomainNotActiveError:
-			return true
-		case *shared.RemoteSyncMatchedError:
-			return true
 		default:
 			return false
 		}
 	}
 
-	MatchingService_AddDecisionTask_Helper.WrapResponse = func(err error) (*MatchingService_AddDecisionTask_Result, error) {
+	MatchingService_CancelOutstandingPoll_Helper.WrapResponse = func(err error) (*MatchingService_CancelOutstandingPoll_Result, error) {
 		if err == nil {
-			return &MatchingService_AddDecisionTask_Result{}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.BadRequestError")
 			}
-			return &MatchingService_AddDecisionTask_Result{BadRequestError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.InternalServiceError")
 			}
-			return &MatchingService_AddDecisionTask_Result{InternalServiceError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{InternalServiceError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.ServiceBusyError")
-			}
-			return &MatchingService_AddDecisionTask_Result{ServiceBusyError: e}, nil
-		case *shared.LimitExceededError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.LimitExceededError")
-			}
-			return &MatchingService_AddDecisionTask_Result{LimitExceededError: e}, nil
-		case *shared.DomainNotActiveError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.DomainNotActiveError")
-			}
-			return &MatchingService_AddDecisionTask_Result{DomainNotActiveError: e}, nil
-		case *shared.RemoteSyncMatchedError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_AddDecisionTask_Result.RemoteSyncMatchedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.ServiceBusyError")
 			}
-			return &MatchingService_AddDecisionTask_Result{RemoteSyncMatchedError: e}, nil
+			return &MatchingService_CancelOutstandingPoll_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_AddDecisionTask_Helper.UnwrapResponse = func(result *MatchingService_AddDecisionTask_Result) (err error) {
+	MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse = func(result *MatchingService_CancelOutstandingPoll_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -6972,36 +10223,21 @@ func init() {
 			err = result.ServiceBusyError
 			return
 		}
-		if result.LimitExceededError != nil {
-			err = result.LimitExceededError
-			return
-		}
-		if result.DomainNotActiveError != nil {
-			err = result.DomainNotActiveError
-			return
-		}
-		if result.RemoteSyncMatchedError != nil {
-			err = result.RemoteSyncMatchedError
-			return
-		}
 		return
 	}
 
 }
 
-// MatchingService_AddDecisionTask_Result represents the result of a MatchingService.AddDecisionTask function call.
+// MatchingService_CancelOutstandingPoll_Result represents the result of a MatchingService.CancelOutstandingPoll function call.
 //
-// The result of a AddDecisionTask execution is sent and received over the wire as this struct.
-type MatchingService_AddDecisionTask_Result struct {
-	BadRequestError        *shared.BadRequestError        `json:"badRequestError,omitempty"`
-	InternalServiceError   *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
-	ServiceBusyError       *shared.ServiceBusyError       `json:"serviceBusyError,omitempty"`
-	LimitExceededError     *shared.LimitExceededError     `json:"limitExceededError,omitempty"`
-	DomainNotActiveError   *shared.DomainNotActiveError   `json:"domainNotActiveError,omitempty"`
-	RemoteSyncMatchedError *shared.RemoteSyncMatchedError `json:"remoteSyncMatchedError,omitempty"`
+// The result of a CancelOutstandingPoll execution is sent and received over the wire as this struct.
+type MatchingService_CancelOutstandingPoll_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_AddDecisionTask_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_CancelOutstandingPoll_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7016,9 +10252,9 @@ type MatchingService_AddDecisionTask_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7048,43 +10284,19 @@ func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.LimitExceededError != nil {
-		w, err = v.LimitExceededError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
-	if v.DomainNotActiveError != nil {
-		w, err = v.DomainNotActiveError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 5, Value: w}
-		i++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		w, err = v.RemoteSyncMatchedError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 6, Value: w}
-		i++
-	}
 
 	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MatchingService_AddDecisionTask_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_CancelOutstandingPoll_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_AddDecisionTask_Result struct
+// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7092,12 +10304,12 @@ func (v *MatchingService_AddDecisionTask_Result) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v MatchingService_AddDecisionTask_Result
+//   var v MatchingService_CancelOutstandingPoll_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -7125,30 +10337,6 @@ func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.LimitExceededError, err = _LimitExceededError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 5:
-			if field.Value.Type() == wire.TStruct {
-				v.DomainNotActiveError, err = _DomainNotActiveError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 6:
-			if field.Value.Type() == wire.TStruct {
-				v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
@@ -7157,33 +10345,24 @@ func (v *MatchingService_AddDecisionTask_Result) FromWire(w wire.Value) error {
 	if v.BadRequestError != nil {
 		count++
 	}
-	if v.InternalServiceError != nil {
-		count++
-	}
-	if v.ServiceBusyError != nil {
-		count++
-	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.DomainNotActiveError != nil {
+	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.RemoteSyncMatchedError != nil {
+	if v.ServiceBusyError != nil {
 		count++
 	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_AddDecisionTask_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_CancelOutstandingPoll_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_AddDecisionTask_Result struct could not be encoded.
-func (v *MatchingService_AddDecisionTask_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_CancelOutstandingPoll_Result struct could not be encoded.
+func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -7224,42 +10403,6 @@ func (v *MatchingService_AddDecisionTask_Result) Encode(sw stream.Writer) error
 		}
 	}
 
-	if v.LimitExceededError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.LimitExceededError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.DomainNotActiveError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 5, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.DomainNotActiveError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.RemoteSyncMatchedError != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 6, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.RemoteSyncMatchedError.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
 	count := 0
 	if v.BadRequestError != nil {
 		count++
@@ -7270,29 +10413,20 @@ func (v *MatchingService_AddDecisionTask_Result) Encode(sw stream.Writer) error
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.DomainNotActiveError != nil {
-		count++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		count++
-	}
 
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a MatchingService_AddDecisionTask_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_CancelOutstandingPoll_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_AddDecisionTask_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_CancelOutstandingPoll_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_AddDecisionTask_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -7323,24 +10457,6 @@ func (v *MatchingService_AddDecisionTask_Result) Decode(sr stream.Reader) error
 				return err
 			}
 
-		case fh.ID == 4 && fh.Type == wire.TStruct:
-			v.LimitExceededError, err = _LimitExceededError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 5 && fh.Type == wire.TStruct:
-			v.DomainNotActiveError, err = _DomainNotActiveError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 6 && fh.Type == wire.TStruct:
-			v.RemoteSyncMatchedError, err = _RemoteSyncMatchedError_Decode(sr)
-			if err != nil {
-				return err
-			}
-
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -7370,30 +10486,21 @@ func (v *MatchingService_AddDecisionTask_Result) Decode(sr stream.Reader) error
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if v.LimitExceededError != nil {
-		count++
-	}
-	if v.DomainNotActiveError != nil {
-		count++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		count++
-	}
 	if count > 1 {
-		return fmt.Errorf("MatchingService_AddDecisionTask_Result should have at most one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_AddDecisionTask_Result
+// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Result
 // struct.
-func (v *MatchingService_AddDecisionTask_Result) String() string {
+func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [3]string
 	i := 0
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
@@ -7407,27 +10514,15 @@ func (v *MatchingService_AddDecisionTask_Result) String() string {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
-	if v.LimitExceededError != nil {
-		fields[i] = fmt.Sprintf("LimitExceededError: %v", v.LimitExceededError)
-		i++
-	}
-	if v.DomainNotActiveError != nil {
-		fields[i] = fmt.Sprintf("DomainNotActiveError: %v", v.DomainNotActiveError)
-		i++
-	}
-	if v.RemoteSyncMatchedError != nil {
-		fields[i] = fmt.Sprintf("RemoteSyncMatchedError: %v", v.RemoteSyncMatchedError)
-		i++
-	}
 
-	return fmt.Sprintf("MatchingService_AddDecisionTask_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_AddDecisionTask_Result match the
-// provided MatchingService_AddDecisionTask_Result.
+// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Result match the
+// provided MatchingService_CancelOutstandingPoll_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_AddDecisionTask_Result) bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingService_CancelOutstandingPoll_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7442,22 +10537,13 @@ func (v *MatchingService_AddDecisionTask_Result) Equals(rhs *MatchingService_Add
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
-	if !((v.LimitExceededError == nil && rhs.LimitExceededError == nil) || (v.LimitExceededError != nil && rhs.LimitExceededError != nil && v.LimitExceededError.Equals(rhs.LimitExceededError))) {
-		return false
-	}
-	if !((v.DomainNotActiveError == nil && rhs.DomainNotActiveError == nil) || (v.DomainNotActiveError != nil && rhs.DomainNotActiveError != nil && v.DomainNotActiveError.Equals(rhs.DomainNotActiveError))) {
-		return false
-	}
-	if !((v.RemoteSyncMatchedError == nil && rhs.RemoteSyncMatchedError == nil) || (v.RemoteSyncMatchedError != nil && rhs.RemoteSyncMatchedError != nil && v.RemoteSyncMatchedError.Equals(rhs.RemoteSyncMatchedError))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_AddDecisionTask_Result.
-func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_CancelOutstandingPoll_Result.
+func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -7470,21 +10556,12 @@ func (v *MatchingService_AddDecisionTask_Result) MarshalLogObject(enc zapcore.Ob
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
-	if v.LimitExceededError != nil {
-		err = multierr.Append(err, enc.AddObject("limitExceededError", v.LimitExceededError))
-	}
-	if v.DomainNotActiveError != nil {
-		err = multierr.Append(err, enc.AddObject("domainNotActiveError", v.DomainNotActiveError))
-	}
-	if v.RemoteSyncMatchedError != nil {
-		err = multierr.Append(err, enc.AddObject("remoteSyncMatchedError", v.RemoteSyncMatchedError))
-	}
 	return err
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -7493,13 +10570,13 @@ func (v *MatchingService_AddDecisionTask_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -7508,13 +10585,13 @@ func (v *MatchingService_AddDecisionTask_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -7523,78 +10600,33 @@ func (v *MatchingService_AddDecisionTask_Result) GetServiceBusyError() (o *share
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_CancelOutstandingPoll_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
-// GetLimitExceededError returns the value of LimitExceededError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetLimitExceededError() (o *shared.LimitExceededError) {
-	if v != nil && v.LimitExceededError != nil {
-		return v.LimitExceededError
-	}
-
-	return
-}
-
-// IsSetLimitExceededError returns true if LimitExceededError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetLimitExceededError() bool {
-	return v != nil && v.LimitExceededError != nil
-}
-
-// GetDomainNotActiveError returns the value of DomainNotActiveError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetDomainNotActiveError() (o *shared.DomainNotActiveError) {
-	if v != nil && v.DomainNotActiveError != nil {
-		return v.DomainNotActiveError
-	}
-
-	return
-}
-
-// IsSetDomainNotActiveError returns true if DomainNotActiveError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetDomainNotActiveError() bool {
-	return v != nil && v.DomainNotActiveError != nil
-}
-
-// GetRemoteSyncMatchedError returns the value of RemoteSyncMatchedError if it is set or its
-// zero value if it is unset.
-func (v *MatchingService_AddDecisionTask_Result) GetRemoteSyncMatchedError() (o *shared.RemoteSyncMatchedError) {
-	if v != nil && v.RemoteSyncMatchedError != nil {
-		return v.RemoteSyncMatchedError
-	}
-
-	return
-}
-
-// IsSetRemoteSyncMatchedError returns true if RemoteSyncMatchedError is not nil.
-func (v *MatchingService_AddDecisionTask_Result) IsSetRemoteSyncMatchedError() bool {
-	return v != nil && v.RemoteSyncMatchedError != nil
-}
-
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddDecisionTask" for this struct.
-func (v *MatchingService_AddDecisionTask_Result) MethodName() string {
-	return "AddDecisionTask"
+// This will always be "CancelOutstandingPoll" for this struct.
+func (v *MatchingService_CancelOutstandingPoll_Result) MethodName() string {
+	return "CancelOutstandingPoll"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_AddDecisionTask_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_CancelOutstandingPoll_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_CancelOutstandingPoll_Args represents the arguments for the MatchingService.CancelOutstandingPoll function.
+// MatchingService_DescribeTaskList_Args represents the arguments for the MatchingService.DescribeTaskList function.
 //
-// The arguments for CancelOutstandingPoll are sent and received over the wire as this struct.
-type MatchingService_CancelOutstandingPoll_Args struct {
-	Request *CancelOutstandingPollRequest `json:"request,omitempty"`
+// The arguments for DescribeTaskList are sent and received over the wire as this struct.
+type MatchingService_DescribeTaskList_Args struct {
+	Request *DescribeTaskListRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_CancelOutstandingPoll_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskList_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7609,7 +10641,7 @@ type MatchingService_CancelOutstandingPoll_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -7629,17 +10661,17 @@ func (v *MatchingService_CancelOutstandingPoll_Args) ToWire() (wire.Value, error
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollRequest, error) {
-	var v CancelOutstandingPollRequest
+func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, error) {
+	var v DescribeTaskListRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_CancelOutstandingPoll_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_DescribeTaskList_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Args struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -7647,19 +10679,19 @@ func _CancelOutstandingPollRequest_Read(w wire.Value) (*CancelOutstandingPollReq
 //     return nil, err
 //   }
 //
-//   var v MatchingService_CancelOutstandingPoll_Args
+//   var v MatchingService_DescribeTaskList_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _CancelOutstandingPollRequest_Read(field.Value)
+				v.Request, err = _DescribeTaskListRequest_1_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -7671,11 +10703,11 @@ func (v *MatchingService_CancelOutstandingPoll_Args) FromWire(w wire.Value) erro
 	return nil
 }
 
-// Encode serializes a MatchingService_CancelOutstandingPoll_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_DescribeTaskList_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_CancelOutstandingPoll_Args struct could not be encoded.
-func (v *MatchingService_CancelOutstandingPoll_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_DescribeTaskList_Args struct could not be encoded.
+func (v *MatchingService_DescribeTaskList_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -7695,18 +10727,18 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Encode(sw stream.Writer) er
 	return sw.WriteStructEnd()
 }
 
-func _CancelOutstandingPollRequest_Decode(sr stream.Reader) (*CancelOutstandingPollRequest, error) {
-	var v CancelOutstandingPollRequest
+func _DescribeTaskListRequest_1_Decode(sr stream.Reader) (*DescribeTaskListRequest, error) {
+	var v DescribeTaskListRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_CancelOutstandingPoll_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_DescribeTaskList_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_CancelOutstandingPoll_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_DescribeTaskList_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_CancelOutstandingPoll_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_DescribeTaskList_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -7720,7 +10752,7 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Decode(sr stream.Reader) er
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _CancelOutstandingPollRequest_Decode(sr)
+			v.Request, err = _DescribeTaskListRequest_1_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -7747,9 +10779,9 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Decode(sr stream.Reader) er
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Args
+// String returns a readable string representation of a MatchingService_DescribeTaskList_Args
 // struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
+func (v *MatchingService_DescribeTaskList_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -7761,14 +10793,14 @@ func (v *MatchingService_CancelOutstandingPoll_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskList_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Args match the
-// provided MatchingService_CancelOutstandingPoll_Args.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Args match the
+// provided MatchingService_DescribeTaskList_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService_CancelOutstandingPoll_Args) bool {
+func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_DescribeTaskList_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -7782,8 +10814,8 @@ func (v *MatchingService_CancelOutstandingPoll_Args) Equals(rhs *MatchingService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_CancelOutstandingPoll_Args.
-func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskList_Args.
+func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -7795,7 +10827,7 @@ func (v *MatchingService_CancelOutstandingPoll_Args) MarshalLogObject(enc zapcor
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOutstandingPollRequest) {
+func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskListRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -7804,86 +10836,87 @@ func (v *MatchingService_CancelOutstandingPoll_Args) GetRequest() (o *CancelOuts
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Args) IsSetRequest() bool {
+func (v *MatchingService_DescribeTaskList_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "CancelOutstandingPoll" for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) MethodName() string {
-	return "CancelOutstandingPoll"
+// This will always be "DescribeTaskList" for this struct.
+func (v *MatchingService_DescribeTaskList_Args) MethodName() string {
+	return "DescribeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskList_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_CancelOutstandingPoll_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.CancelOutstandingPoll
+// MatchingService_DescribeTaskList_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.DescribeTaskList
 // function.
-var MatchingService_CancelOutstandingPoll_Helper = struct {
-	// Args accepts the parameters of CancelOutstandingPoll in-order and returns
+var MatchingService_DescribeTaskList_Helper = struct {
+	// Args accepts the parameters of DescribeTaskList in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *CancelOutstandingPollRequest,
-	) *MatchingService_CancelOutstandingPoll_Args
+		request *DescribeTaskListRequest,
+	) *MatchingService_DescribeTaskList_Args
 
 	// IsException returns true if the given error can be thrown
-	// by CancelOutstandingPoll.
+	// by DescribeTaskList.
 	//
-	// An error can be thrown by CancelOutstandingPoll only if the
+	// An error can be thrown by DescribeTaskList only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for CancelOutstandingPoll
-	// given the error returned by it. The provided error may
-	// be nil if CancelOutstandingPoll did not fail.
+	// WrapResponse returns the result struct for DescribeTaskList
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by CancelOutstandingPoll into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// CancelOutstandingPoll
+	// This allows mapping values and errors returned by
+	// DescribeTaskList into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeTaskList
 	//
-	//   err := CancelOutstandingPoll(args)
-	//   result, err := MatchingService_CancelOutstandingPoll_Helper.WrapResponse(err)
+	//   value, err := DescribeTaskList(args)
+	//   result, err := MatchingService_DescribeTaskList_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from CancelOutstandingPoll: %v", err)
+	//     return fmt.Errorf("unexpected error from DescribeTaskList: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*MatchingService_CancelOutstandingPoll_Result, error)
+	WrapResponse func(*shared.DescribeTaskListResponse, error) (*MatchingService_DescribeTaskList_Result, error)
 
-	// UnwrapResponse takes the result struct for CancelOutstandingPoll
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for DescribeTaskList
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if CancelOutstandingPoll threw an
+	// The error is non-nil only if DescribeTaskList threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_CancelOutstandingPoll_Result) error
+	//   value, err := MatchingService_DescribeTaskList_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_DescribeTaskList_Result) (*shared.DescribeTaskListResponse, error)
 }{}
 
 func init() {
-	MatchingService_CancelOutstandingPoll_Helper.Args = func(
-		request *CancelOutstandingPollRequest,
-	) *MatchingService_CancelOutstandingPoll_Args {
-		return &MatchingService_CancelOutstandingPoll_Args{
+	MatchingService_DescribeTaskList_Helper.Args = func(
+		request *DescribeTaskListRequest,
+	) *MatchingService_DescribeTaskList_Args {
+		return &MatchingService_DescribeTaskList_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_CancelOutstandingPoll_Helper.IsException = func(err error) bool {
+	MatchingService_DescribeTaskList_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -7891,32 +10924,37 @@ func init() {
 		}
 	}
 
-	MatchingService_CancelOutstandingPoll_Helper.WrapResponse = func(err error) (*MatchingService_CancelOutstandingPoll_Result, error) {
+	MatchingService_DescribeTaskList_Helper.WrapResponse = func(success *shared.DescribeTaskListResponse, err error) (*MatchingService_DescribeTaskList_Result, error) {
 		if err == nil {
-			return &MatchingService_CancelOutstandingPoll_Result{}, nil
+			return &MatchingService_DescribeTaskList_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.BadRequestError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{BadRequestError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.InternalServiceError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{InternalServiceError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.EntityNotExistError")
+			}
+			return &MatchingService_DescribeTaskList_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_CancelOutstandingPoll_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.ServiceBusyError")
 			}
-			return &MatchingService_CancelOutstandingPoll_Result{ServiceBusyError: e}, nil
+			return &MatchingService_DescribeTaskList_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_CancelOutstandingPoll_Helper.UnwrapResponse = func(result *MatchingService_CancelOutstandingPoll_Result) (err error) {
+	MatchingService_DescribeTaskList_Helper.UnwrapResponse = func(result *MatchingService_DescribeTaskList_Result) (success *shared.DescribeTaskListResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -7925,25 +10963,41 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// MatchingService_CancelOutstandingPoll_Result represents the result of a MatchingService.CancelOutstandingPoll function call.
+// MatchingService_DescribeTaskList_Result represents the result of a MatchingService.DescribeTaskList function call.
 //
-// The result of a CancelOutstandingPoll execution is sent and received over the wire as this struct.
-type MatchingService_CancelOutstandingPoll_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+// The result of a DescribeTaskList execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type MatchingService_DescribeTaskList_Result struct {
+	// Value returned by DescribeTaskList after a successful execution.
+	Success              *shared.DescribeTaskListResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError          `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError     `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError     `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError         `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_CancelOutstandingPoll_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskList_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -7958,14 +11012,22 @@ type MatchingService_CancelOutstandingPoll_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -7982,27 +11044,47 @@ func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, err
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a MatchingService_CancelOutstandingPoll_Result struct from its Thrift-level
+func _DescribeTaskListResponse_Read(w wire.Value) (*shared.DescribeTaskListResponse, error) {
+	var v shared.DescribeTaskListResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a MatchingService_DescribeTaskList_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_CancelOutstandingPoll_Result struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8010,16 +11092,24 @@ func (v *MatchingService_CancelOutstandingPoll_Result) ToWire() (wire.Value, err
 //     return nil, err
 //   }
 //
-//   var v MatchingService_CancelOutstandingPoll_Result
+//   var v MatchingService_DescribeTaskList_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeTaskListResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -8037,6 +11127,14 @@ func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) er
 
 			}
 		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -8048,31 +11146,49 @@ func (v *MatchingService_CancelOutstandingPoll_Result) FromWire(w wire.Value) er
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_CancelOutstandingPoll_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_DescribeTaskList_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_CancelOutstandingPoll_Result struct could not be encoded.
-func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_DescribeTaskList_Result struct could not be encoded.
+func (v *MatchingService_DescribeTaskList_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
+	if v.Success != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 0, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Success.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	if v.BadRequestError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 1, Type: wire.TStruct}); err != nil {
 			return err
@@ -8097,10 +11213,22 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer)
 		}
 	}
 
-	if v.ServiceBusyError != nil {
+	if v.EntityNotExistError != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 3, Type: wire.TStruct}); err != nil {
 			return err
 		}
+		if err := v.EntityNotExistError.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ServiceBusyError != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 4, Type: wire.TStruct}); err != nil {
+			return err
+		}
 		if err := v.ServiceBusyError.Encode(sw); err != nil {
 			return err
 		}
@@ -8110,29 +11238,47 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Encode(sw stream.Writer)
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
 
-	if count > 1 {
-		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a MatchingService_CancelOutstandingPoll_Result struct directly from its Thrift-level
+func _DescribeTaskListResponse_Decode(sr stream.Reader) (*shared.DescribeTaskListResponse, error) {
+	var v shared.DescribeTaskListResponse
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _EntityNotExistsError_Decode(sr stream.Reader) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.Decode(sr)
+	return &v, err
+}
+
+// Decode deserializes a MatchingService_DescribeTaskList_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_CancelOutstandingPoll_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_DescribeTaskList_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_DescribeTaskList_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -8145,6 +11291,12 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader)
 
 	for ok {
 		switch {
+		case fh.ID == 0 && fh.Type == wire.TStruct:
+			v.Success, err = _DescribeTaskListResponse_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		case fh.ID == 1 && fh.Type == wire.TStruct:
 			v.BadRequestError, err = _BadRequestError_Decode(sr)
 			if err != nil {
@@ -8158,6 +11310,12 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader)
 			}
 
 		case fh.ID == 3 && fh.Type == wire.TStruct:
+			v.EntityNotExistError, err = _EntityNotExistsError_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 4 && fh.Type == wire.TStruct:
 			v.ServiceBusyError, err = _ServiceBusyError_Decode(sr)
 			if err != nil {
 				return err
@@ -8183,31 +11341,41 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Decode(sr stream.Reader)
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("MatchingService_CancelOutstandingPoll_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_CancelOutstandingPoll_Result
+// String returns a readable string representation of a MatchingService_DescribeTaskList_Result
 // struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
+func (v *MatchingService_DescribeTaskList_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -8216,30 +11384,40 @@ func (v *MatchingService_CancelOutstandingPoll_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_CancelOutstandingPoll_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskList_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_CancelOutstandingPoll_Result match the
-// provided MatchingService_CancelOutstandingPoll_Result.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Result match the
+// provided MatchingService_DescribeTaskList_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingService_CancelOutstandingPoll_Result) bool {
+func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_DescribeTaskList_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -8248,26 +11426,47 @@ func (v *MatchingService_CancelOutstandingPoll_Result) Equals(rhs *MatchingServi
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_CancelOutstandingPoll_Result.
-func (v *MatchingService_CancelOutstandingPoll_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskList_Result.
+func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.DescribeTaskListResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -8276,13 +11475,13 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetBadRequestError() (o *
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -8291,13 +11490,28 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetInternalServiceError()
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *MatchingService_DescribeTaskList_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -8306,33 +11520,33 @@ func (v *MatchingService_CancelOutstandingPoll_Result) GetServiceBusyError() (o
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_CancelOutstandingPoll_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_DescribeTaskList_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "CancelOutstandingPoll" for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) MethodName() string {
-	return "CancelOutstandingPoll"
+// This will always be "DescribeTaskList" for this struct.
+func (v *MatchingService_DescribeTaskList_Result) MethodName() string {
+	return "DescribeTaskList"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_CancelOutstandingPoll_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskList_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// MatchingService_DescribeTaskList_Args represents the arguments for the MatchingService.DescribeTaskList function.
+// MatchingService_DescribeTaskListConfig_Args represents the arguments for the MatchingService.DescribeTaskListConfig function.
 //
-// The arguments for DescribeTaskList are sent and received over the wire as this struct.
-type MatchingService_DescribeTaskList_Args struct {
-	Request *DescribeTaskListRequest `json:"request,omitempty"`
+// The arguments for DescribeTaskListConfig are sent and received over the wire as this struct.
+type MatchingService_DescribeTaskListConfig_Args struct {
+	Request *DescribeTaskListConfigRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a MatchingService_DescribeTaskList_Args struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskListConfig_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8347,7 +11561,7 @@ type MatchingService_DescribeTaskList_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskListConfig_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -8367,17 +11581,17 @@ func (v *MatchingService_DescribeTaskList_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, error) {
-	var v DescribeTaskListRequest
+func _DescribeTaskListConfigRequest_Read(w wire.Value) (*DescribeTaskListConfigRequest, error) {
+	var v DescribeTaskListConfigRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_DescribeTaskList_Args struct from its Thrift-level
+// FromWire deserializes a MatchingService_DescribeTaskListConfig_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Args struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskListConfig_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8385,19 +11599,19 @@ func _DescribeTaskListRequest_1_Read(w wire.Value) (*DescribeTaskListRequest, er
 //     return nil, err
 //   }
 //
-//   var v MatchingService_DescribeTaskList_Args
+//   var v MatchingService_DescribeTaskListConfig_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskListConfig_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeTaskListRequest_1_Read(field.Value)
+				v.Request, err = _DescribeTaskListConfigRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8409,11 +11623,11 @@ func (v *MatchingService_DescribeTaskList_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a MatchingService_DescribeTaskList_Args struct directly into bytes, without going
+// Encode serializes a MatchingService_DescribeTaskListConfig_Args struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_DescribeTaskList_Args struct could not be encoded.
-func (v *MatchingService_DescribeTaskList_Args) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_DescribeTaskListConfig_Args struct could not be encoded.
+func (v *MatchingService_DescribeTaskListConfig_Args) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -8433,18 +11647,18 @@ func (v *MatchingService_DescribeTaskList_Args) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _DescribeTaskListRequest_1_Decode(sr stream.Reader) (*DescribeTaskListRequest, error) {
-	var v DescribeTaskListRequest
+func _DescribeTaskListConfigRequest_Decode(sr stream.Reader) (*DescribeTaskListConfigRequest, error) {
+	var v DescribeTaskListConfigRequest
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_DescribeTaskList_Args struct directly from its Thrift-level
+// Decode deserializes a MatchingService_DescribeTaskListConfig_Args struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_DescribeTaskList_Args struct could not be generated from the wire
+// An error is returned if a MatchingService_DescribeTaskListConfig_Args struct could not be generated from the wire
 // representation.
-func (v *MatchingService_DescribeTaskList_Args) Decode(sr stream.Reader) error {
+func (v *MatchingService_DescribeTaskListConfig_Args) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -8458,7 +11672,7 @@ func (v *MatchingService_DescribeTaskList_Args) Decode(sr stream.Reader) error {
 	for ok {
 		switch {
 		case fh.ID == 1 && fh.Type == wire.TStruct:
-			v.Request, err = _DescribeTaskListRequest_1_Decode(sr)
+			v.Request, err = _DescribeTaskListConfigRequest_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -8485,9 +11699,9 @@ func (v *MatchingService_DescribeTaskList_Args) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_DescribeTaskList_Args
+// String returns a readable string representation of a MatchingService_DescribeTaskListConfig_Args
 // struct.
-func (v *MatchingService_DescribeTaskList_Args) String() string {
+func (v *MatchingService_DescribeTaskListConfig_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -8499,14 +11713,14 @@ func (v *MatchingService_DescribeTaskList_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_DescribeTaskList_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskListConfig_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Args match the
-// provided MatchingService_DescribeTaskList_Args.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskListConfig_Args match the
+// provided MatchingService_DescribeTaskListConfig_Args.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_DescribeTaskList_Args) bool {
+func (v *MatchingService_DescribeTaskListConfig_Args) Equals(rhs *MatchingService_DescribeTaskListConfig_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -8520,8 +11734,8 @@ func (v *MatchingService_DescribeTaskList_Args) Equals(rhs *MatchingService_Desc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_DescribeTaskList_Args.
-func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskListConfig_Args.
+func (v *MatchingService_DescribeTaskListConfig_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -8533,7 +11747,7 @@ func (v *MatchingService_DescribeTaskList_Args) MarshalLogObject(enc zapcore.Obj
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskListRequest) {
+func (v *MatchingService_DescribeTaskListConfig_Args) GetRequest() (o *DescribeTaskListConfigRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -8542,80 +11756,80 @@ func (v *MatchingService_DescribeTaskList_Args) GetRequest() (o *DescribeTaskLis
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *MatchingService_DescribeTaskList_Args) IsSetRequest() bool {
+func (v *MatchingService_DescribeTaskListConfig_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeTaskList" for this struct.
-func (v *MatchingService_DescribeTaskList_Args) MethodName() string {
-	return "DescribeTaskList"
+// This will always be "DescribeTaskListConfig" for this struct.
+func (v *MatchingService_DescribeTaskListConfig_Args) MethodName() string {
+	return "DescribeTaskListConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *MatchingService_DescribeTaskList_Args) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskListConfig_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// MatchingService_DescribeTaskList_Helper provides functions that aid in handling the
-// parameters and return values of the MatchingService.DescribeTaskList
+// MatchingService_DescribeTaskListConfig_Helper provides functions that aid in handling the
+// parameters and return values of the MatchingService.DescribeTaskListConfig
 // function.
-var MatchingService_DescribeTaskList_Helper = struct {
-	// Args accepts the parameters of DescribeTaskList in-order and returns
+var MatchingService_DescribeTaskListConfig_Helper = struct {
+	// Args accepts the parameters of DescribeTaskListConfig in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *DescribeTaskListRequest,
-	) *MatchingService_DescribeTaskList_Args
+		request *DescribeTaskListConfigRequest,
+	) *MatchingService_DescribeTaskListConfig_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeTaskList.
+	// by DescribeTaskListConfig.
 	//
-	// An error can be thrown by DescribeTaskList only if the
+	// An error can be thrown by DescribeTaskListConfig only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeTaskList
+	// WrapResponse returns the result struct for DescribeTaskListConfig
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeTaskList into a serializable result struct.
+	// DescribeTaskListConfig into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeTaskList
+	// error cannot be thrown by DescribeTaskListConfig
 	//
-	//   value, err := DescribeTaskList(args)
-	//   result, err := MatchingService_DescribeTaskList_Helper.WrapResponse(value, err)
+	//   value, err := DescribeTaskListConfig(args)
+	//   result, err := MatchingService_DescribeTaskListConfig_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeTaskList: %v", err)
+	//     return fmt.Errorf("unexpected error from DescribeTaskListConfig: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeTaskListResponse, error) (*MatchingService_DescribeTaskList_Result, error)
+	WrapResponse func(*DescribeTaskListConfigResponse, error) (*MatchingService_DescribeTaskListConfig_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeTaskList
+	// UnwrapResponse takes the result struct for DescribeTaskListConfig
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeTaskList threw an
+	// The error is non-nil only if DescribeTaskListConfig threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := MatchingService_DescribeTaskList_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*MatchingService_DescribeTaskList_Result) (*shared.DescribeTaskListResponse, error)
+	//   value, err := MatchingService_DescribeTaskListConfig_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*MatchingService_DescribeTaskListConfig_Result) (*DescribeTaskListConfigResponse, error)
 }{}
 
 func init() {
-	MatchingService_DescribeTaskList_Helper.Args = func(
-		request *DescribeTaskListRequest,
-	) *MatchingService_DescribeTaskList_Args {
-		return &MatchingService_DescribeTaskList_Args{
+	MatchingService_DescribeTaskListConfig_Helper.Args = func(
+		request *DescribeTaskListConfigRequest,
+	) *MatchingService_DescribeTaskListConfig_Args {
+		return &MatchingService_DescribeTaskListConfig_Args{
 			Request: request,
 		}
 	}
 
-	MatchingService_DescribeTaskList_Helper.IsException = func(err error) bool {
+	MatchingService_DescribeTaskListConfig_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -8630,37 +11844,37 @@ func init() {
 		}
 	}
 
-	MatchingService_DescribeTaskList_Helper.WrapResponse = func(success *shared.DescribeTaskListResponse, err error) (*MatchingService_DescribeTaskList_Result, error) {
+	MatchingService_DescribeTaskListConfig_Helper.WrapResponse = func(success *DescribeTaskListConfigResponse, err error) (*MatchingService_DescribeTaskListConfig_Result, error) {
 		if err == nil {
-			return &MatchingService_DescribeTaskList_Result{Success: success}, nil
+			return &MatchingService_DescribeTaskListConfig_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskListConfig_Result.BadRequestError")
 			}
-			return &MatchingService_DescribeTaskList_Result{BadRequestError: e}, nil
+			return &MatchingService_DescribeTaskListConfig_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskListConfig_Result.InternalServiceError")
 			}
-			return &MatchingService_DescribeTaskList_Result{InternalServiceError: e}, nil
+			return &MatchingService_DescribeTaskListConfig_Result{InternalServiceError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskListConfig_Result.EntityNotExistError")
 			}
-			return &MatchingService_DescribeTaskList_Result{EntityNotExistError: e}, nil
+			return &MatchingService_DescribeTaskListConfig_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskList_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for MatchingService_DescribeTaskListConfig_Result.ServiceBusyError")
 			}
-			return &MatchingService_DescribeTaskList_Result{ServiceBusyError: e}, nil
+			return &MatchingService_DescribeTaskListConfig_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	MatchingService_DescribeTaskList_Helper.UnwrapResponse = func(result *MatchingService_DescribeTaskList_Result) (success *shared.DescribeTaskListResponse, err error) {
+	MatchingService_DescribeTaskListConfig_Helper.UnwrapResponse = func(result *MatchingService_DescribeTaskListConfig_Result) (success *DescribeTaskListConfigResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -8689,21 +11903,21 @@ func init() {
 
 }
 
-// MatchingService_DescribeTaskList_Result represents the result of a MatchingService.DescribeTaskList function call.
+// MatchingService_DescribeTaskListConfig_Result represents the result of a MatchingService.DescribeTaskListConfig function call.
 //
-// The result of a DescribeTaskList execution is sent and received over the wire as this struct.
+// The result of a DescribeTaskListConfig execution is sent and received over the wire as this struct.
 //
 // Success is set only if the function did not throw an exception.
-type MatchingService_DescribeTaskList_Result struct {
-	// Value returned by DescribeTaskList after a successful execution.
-	Success              *shared.DescribeTaskListResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError          `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError     `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError     `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError         `json:"serviceBusyError,omitempty"`
+type MatchingService_DescribeTaskListConfig_Result struct {
+	// Value returned by DescribeTaskListConfig after a successful execution.
+	Success              *DescribeTaskListConfigResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError         `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError    `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError    `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError        `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a MatchingService_DescribeTaskList_Result struct into a Thrift-level intermediate
+// ToWire translates a MatchingService_DescribeTaskListConfig_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -8718,7 +11932,7 @@ type MatchingService_DescribeTaskList_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
+func (v *MatchingService_DescribeTaskListConfig_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -8768,29 +11982,23 @@ func (v *MatchingService_DescribeTaskList_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("MatchingService_DescribeTaskListConfig_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeTaskListResponse_Read(w wire.Value) (*shared.DescribeTaskListResponse, error) {
-	var v shared.DescribeTaskListResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
+func _DescribeTaskListConfigResponse_Read(w wire.Value) (*DescribeTaskListConfigResponse, error) {
+	var v DescribeTaskListConfigResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a MatchingService_DescribeTaskList_Result struct from its Thrift-level
+// FromWire deserializes a MatchingService_DescribeTaskListConfig_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a MatchingService_DescribeTaskList_Result struct
+// An error is returned if we were unable to build a MatchingService_DescribeTaskListConfig_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -8798,19 +12006,19 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 //     return nil, err
 //   }
 //
-//   var v MatchingService_DescribeTaskList_Result
+//   var v MatchingService_DescribeTaskListConfig_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
+func (v *MatchingService_DescribeTaskListConfig_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeTaskListResponse_Read(field.Value)
+				v.Success, err = _DescribeTaskListConfigResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -8868,17 +12076,17 @@ func (v *MatchingService_DescribeTaskList_Result) FromWire(w wire.Value) error {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_DescribeTaskListConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// Encode serializes a MatchingService_DescribeTaskList_Result struct directly into bytes, without going
+// Encode serializes a MatchingService_DescribeTaskListConfig_Result struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a MatchingService_DescribeTaskList_Result struct could not be encoded.
-func (v *MatchingService_DescribeTaskList_Result) Encode(sw stream.Writer) error {
+// An error is returned if a MatchingService_DescribeTaskListConfig_Result struct could not be encoded.
+func (v *MatchingService_DescribeTaskListConfig_Result) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
@@ -8961,30 +12169,24 @@ func (v *MatchingService_DescribeTaskList_Result) Encode(sw stream.Writer) error
 	}
 
 	if count != 1 {
-		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_DescribeTaskListConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return sw.WriteStructEnd()
 }
 
-func _DescribeTaskListResponse_Decode(sr stream.Reader) (*shared.DescribeTaskListResponse, error) {
-	var v shared.DescribeTaskListResponse
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _EntityNotExistsError_Decode(sr stream.Reader) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
+func _DescribeTaskListConfigResponse_Decode(sr stream.Reader) (*DescribeTaskListConfigResponse, error) {
+	var v DescribeTaskListConfigResponse
 	err := v.Decode(sr)
 	return &v, err
 }
 
-// Decode deserializes a MatchingService_DescribeTaskList_Result struct directly from its Thrift-level
+// Decode deserializes a MatchingService_DescribeTaskListConfig_Result struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a MatchingService_DescribeTaskList_Result struct could not be generated from the wire
+// An error is returned if a MatchingService_DescribeTaskListConfig_Result struct could not be generated from the wire
 // representation.
-func (v *MatchingService_DescribeTaskList_Result) Decode(sr stream.Reader) error {
+func (v *MatchingService_DescribeTaskListConfig_Result) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -8998,7 +12200,7 @@ func (v *MatchingService_DescribeTaskList_Result) Decode(sr stream.Reader) error
 	for ok {
 		switch {
 		case fh.ID == 0 && fh.Type == wire.TStruct:
-			v.Success, err = _DescribeTaskListResponse_Decode(sr)
+			v.Success, err = _DescribeTaskListConfigResponse_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -9063,15 +12265,15 @@ func (v *MatchingService_DescribeTaskList_Result) Decode(sr stream.Reader) error
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("MatchingService_DescribeTaskList_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("MatchingService_DescribeTaskListConfig_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a MatchingService_DescribeTaskList_Result
+// String returns a readable string representation of a MatchingService_DescribeTaskListConfig_Result
 // struct.
-func (v *MatchingService_DescribeTaskList_Result) String() string {
+func (v *MatchingService_DescribeTaskListConfig_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -9099,14 +12301,14 @@ func (v *MatchingService_DescribeTaskList_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("MatchingService_DescribeTaskList_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("MatchingService_DescribeTaskListConfig_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this MatchingService_DescribeTaskList_Result match the
-// provided MatchingService_DescribeTaskList_Result.
+// Equals returns true if all the fields of this MatchingService_DescribeTaskListConfig_Result match the
+// provided MatchingService_DescribeTaskListConfig_Result.
 //
 // This function performs a deep comparison.
-func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_DescribeTaskList_Result) bool {
+func (v *MatchingService_DescribeTaskListConfig_Result) Equals(rhs *MatchingService_DescribeTaskListConfig_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -9132,8 +12334,8 @@ func (v *MatchingService_DescribeTaskList_Result) Equals(rhs *MatchingService_De
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of MatchingService_DescribeTaskList_Result.
-func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of MatchingService_DescribeTaskListConfig_Result.
+func (v *MatchingService_DescribeTaskListConfig_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -9157,7 +12359,7 @@ func (v *MatchingService_DescribeTaskList_Result) MarshalLogObject(enc zapcore.O
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.DescribeTaskListResponse) {
+func (v *MatchingService_DescribeTaskListConfig_Result) GetSuccess() (o *DescribeTaskListConfigResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -9166,13 +12368,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetSuccess() (o *shared.Descri
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetSuccess() bool {
+func (v *MatchingService_DescribeTaskListConfig_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *MatchingService_DescribeTaskListConfig_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -9181,13 +12383,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetBadRequestError() (o *share
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetBadRequestError() bool {
+func (v *MatchingService_DescribeTaskListConfig_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *MatchingService_DescribeTaskListConfig_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -9196,13 +12398,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetInternalServiceError() (o *
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetInternalServiceError() bool {
+func (v *MatchingService_DescribeTaskListConfig_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *MatchingService_DescribeTaskListConfig_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -9211,13 +12413,13 @@ func (v *MatchingService_DescribeTaskList_Result) GetEntityNotExistError() (o *s
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetEntityNotExistError() bool {
+func (v *MatchingService_DescribeTaskListConfig_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *MatchingService_DescribeTaskListConfig_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -9226,22 +12428,22 @@ func (v *MatchingService_DescribeTaskList_Result) GetServiceBusyError() (o *shar
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *MatchingService_DescribeTaskList_Result) IsSetServiceBusyError() bool {
+func (v *MatchingService_DescribeTaskListConfig_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeTaskList" for this struct.
-func (v *MatchingService_DescribeTaskList_Result) MethodName() string {
-	return "DescribeTaskList"
+// This will always be "DescribeTaskListConfig" for this struct.
+func (v *MatchingService_DescribeTaskListConfig_Result) MethodName() string {
+	return "DescribeTaskListConfig"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *MatchingService_DescribeTaskList_Result) EnvelopeType() wire.EnvelopeType {
+func (v *MatchingService_DescribeTaskListConfig_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
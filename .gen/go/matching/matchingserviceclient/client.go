@@ -64,6 +64,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeTaskListResponse, error)
 
+	DescribeTaskListConfig(
+		ctx context.Context,
+		Request *matching.DescribeTaskListConfigRequest,
+		opts ...yarpc.CallOption,
+	) (*matching.DescribeTaskListConfigResponse, error)
+
 	GetTaskListsByDomain(
 		ctx context.Context,
 		Request *shared.GetTaskListsByDomainRequest,
@@ -242,6 +248,34 @@ func (c client) DescribeTaskList(
 	return
 }
 
+func (c client) DescribeTaskListConfig(
+	ctx context.Context,
+	_Request *matching.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.DescribeTaskListConfigResponse, err error) {
+
+	var result matching.MatchingService_DescribeTaskListConfig_Result
+	args := matching.MatchingService_DescribeTaskListConfig_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = matching.MatchingService_DescribeTaskListConfig_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetTaskListsByDomain(
 	ctx context.Context,
 	_Request *shared.GetTaskListsByDomainRequest,
@@ -193,6 +193,39 @@ func (mr *_MockClientRecorder) DescribeTaskList(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskList", args...)
 }
 
+// DescribeTaskListConfig responds to a DescribeTaskListConfig call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//   client.EXPECT().DescribeTaskListConfig(gomock.Any(), ...).Return(...)
+//   ... := client.DescribeTaskListConfig(...)
+func (m *MockClient) DescribeTaskListConfig(
+	ctx context.Context,
+	_Request *matching.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (success *matching.DescribeTaskListConfigResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", args...)
+	success, _ = ret[i].(*matching.DescribeTaskListConfigResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeTaskListConfig(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeTaskListConfig", args...)
+}
+
 // GetTaskListsByDomain responds to a GetTaskListsByDomain call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -43,6 +43,205 @@ import (
 	shared "github.com/uber/cadence/.gen/go/shared"
 )
 
+type CompressionType int32
+
+const (
+	CompressionTypeSnappy CompressionType = 0
+	CompressionTypeZstd   CompressionType = 1
+)
+
+// CompressionType_Values returns all recognized values of CompressionType.
+func CompressionType_Values() []CompressionType {
+	return []CompressionType{
+		CompressionTypeSnappy,
+		CompressionTypeZstd,
+	}
+}
+
+// UnmarshalText tries to decode CompressionType from a byte slice
+// containing its name.
+//
+//   var v CompressionType
+//   err := v.UnmarshalText([]byte("Snappy"))
+func (v *CompressionType) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "Snappy":
+		*v = CompressionTypeSnappy
+		return nil
+	case "Zstd":
+		*v = CompressionTypeZstd
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "CompressionType", err)
+		}
+		*v = CompressionType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes CompressionType to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v CompressionType) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("Snappy"), nil
+	case 1:
+		return []byte("Zstd"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CompressionType.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v CompressionType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Snappy")
+	case 1:
+		enc.AddString("name", "Zstd")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v CompressionType) Ptr() *CompressionType {
+	return &v
+}
+
+// Encode encodes CompressionType directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v CompressionType
+//   return v.Encode(sWriter)
+func (v CompressionType) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates CompressionType into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v CompressionType) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes CompressionType from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return CompressionType(0), err
+//   }
+//
+//   var v CompressionType
+//   if err := v.FromWire(x); err != nil {
+//     return CompressionType(0), err
+//   }
+//   return v, nil
+func (v *CompressionType) FromWire(w wire.Value) error {
+	*v = (CompressionType)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded CompressionType directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v CompressionType
+//   if err := v.Decode(sReader); err != nil {
+//     return CompressionType(0), err
+//   }
+//   return v, nil
+func (v *CompressionType) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (CompressionType)(i)
+	return nil
+}
+
+// String returns a readable string representation of CompressionType.
+func (v CompressionType) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "Snappy"
+	case 1:
+		return "Zstd"
+	}
+	return fmt.Sprintf("CompressionType(%d)", w)
+}
+
+// Equals returns true if this CompressionType value matches the provided
+// value.
+func (v CompressionType) Equals(rhs CompressionType) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes CompressionType into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v CompressionType) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"Snappy\""), nil
+	case 1:
+		return ([]byte)("\"Zstd\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode CompressionType from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *CompressionType) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "CompressionType")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "CompressionType")
+		}
+		*v = (CompressionType)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "CompressionType")
+	}
+}
+
 type DLQType int32
 
 const (
@@ -1846,7 +2045,8 @@ func (v *FailoverMarkers) IsSetFailoverMarkers() bool {
 }
 
 type GetDLQReplicationMessagesRequest struct {
-	TaskInfos []*ReplicationTaskInfo `json:"taskInfos,omitempty"`
+	TaskInfos             []*ReplicationTaskInfo `json:"taskInfos,omitempty"`
+	SupportedCompressions []CompressionType      `json:"supportedCompressions,omitempty"`
 }
 
 type _List_ReplicationTaskInfo_ValueList []*ReplicationTaskInfo
@@ -1878,6 +2078,32 @@ func (_List_ReplicationTaskInfo_ValueList) ValueType() wire.Type {
 
 func (_List_ReplicationTaskInfo_ValueList) Close() {}
 
+type _List_CompressionType_ValueList []CompressionType
+
+func (v _List_CompressionType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_CompressionType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_CompressionType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_CompressionType_ValueList) Close() {}
+
 // ToWire translates a GetDLQReplicationMessagesRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -1895,7 +2121,7 @@ func (_List_ReplicationTaskInfo_ValueList) Close() {}
 //   }
 func (v *GetDLQReplicationMessagesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -1909,6 +2135,14 @@ func (v *GetDLQReplicationMessagesRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.SupportedCompressions != nil {
+		w, err = wire.NewValueList(_List_CompressionType_ValueList(v.SupportedCompressions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1937,6 +2171,30 @@ func _List_ReplicationTaskInfo_Read(l wire.ValueList) ([]*ReplicationTaskInfo, e
 	return o, err
 }
 
+func _CompressionType_Read(w wire.Value) (CompressionType, error) {
+	var v CompressionType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _List_CompressionType_Read(l wire.ValueList) ([]CompressionType, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]CompressionType, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _CompressionType_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a GetDLQReplicationMessagesRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -1966,6 +2224,14 @@ func (v *GetDLQReplicationMessagesRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.SupportedCompressions, err = _List_CompressionType_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -1994,6 +2260,24 @@ func _List_ReplicationTaskInfo_Encode(val []*ReplicationTaskInfo, sw stream.Writ
 	return sw.WriteListEnd()
 }
 
+func _List_CompressionType_Encode(val []CompressionType, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
 // Encode serializes a GetDLQReplicationMessagesRequest struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -2015,6 +2299,18 @@ func (v *GetDLQReplicationMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.SupportedCompressions != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_CompressionType_Encode(v.SupportedCompressions, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -2054,6 +2350,42 @@ func _List_ReplicationTaskInfo_Decode(sr stream.Reader) ([]*ReplicationTaskInfo,
 	return o, err
 }
 
+func _CompressionType_Decode(sr stream.Reader) (CompressionType, error) {
+	var v CompressionType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_CompressionType_Decode(sr stream.Reader) ([]CompressionType, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]CompressionType, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _CompressionType_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a GetDLQReplicationMessagesRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -2078,6 +2410,12 @@ func (v *GetDLQReplicationMessagesRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.SupportedCompressions, err = _List_CompressionType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -2107,12 +2445,16 @@ func (v *GetDLQReplicationMessagesRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	if v.TaskInfos != nil {
 		fields[i] = fmt.Sprintf("TaskInfos: %v", v.TaskInfos)
 		i++
 	}
+	if v.SupportedCompressions != nil {
+		fields[i] = fmt.Sprintf("SupportedCompressions: %v", v.SupportedCompressions)
+		i++
+	}
 
 	return fmt.Sprintf("GetDLQReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2132,6 +2474,21 @@ func _List_ReplicationTaskInfo_Equals(lhs, rhs []*ReplicationTaskInfo) bool {
 	return true
 }
 
+func _List_CompressionType_Equals(lhs, rhs []CompressionType) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this GetDLQReplicationMessagesRequest match the
 // provided GetDLQReplicationMessagesRequest.
 //
@@ -2145,6 +2502,9 @@ func (v *GetDLQReplicationMessagesRequest) Equals(rhs *GetDLQReplicationMessages
 	if !((v.TaskInfos == nil && rhs.TaskInfos == nil) || (v.TaskInfos != nil && rhs.TaskInfos != nil && _List_ReplicationTaskInfo_Equals(v.TaskInfos, rhs.TaskInfos))) {
 		return false
 	}
+	if !((v.SupportedCompressions == nil && rhs.SupportedCompressions == nil) || (v.SupportedCompressions != nil && rhs.SupportedCompressions != nil && _List_CompressionType_Equals(v.SupportedCompressions, rhs.SupportedCompressions))) {
+		return false
+	}
 
 	return true
 }
@@ -2160,6 +2520,17 @@ func (l _List_ReplicationTaskInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncod
 	return err
 }
 
+type _List_CompressionType_Zapper []CompressionType
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_CompressionType_Zapper.
+func (l _List_CompressionType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of GetDLQReplicationMessagesRequest.
 func (v *GetDLQReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -2169,6 +2540,9 @@ func (v *GetDLQReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEn
 	if v.TaskInfos != nil {
 		err = multierr.Append(err, enc.AddArray("taskInfos", (_List_ReplicationTaskInfo_Zapper)(v.TaskInfos)))
 	}
+	if v.SupportedCompressions != nil {
+		err = multierr.Append(err, enc.AddArray("supportedCompressions", (_List_CompressionType_Zapper)(v.SupportedCompressions)))
+	}
 	return err
 }
 
@@ -2187,6 +2561,21 @@ func (v *GetDLQReplicationMessagesRequest) IsSetTaskInfos() bool {
 	return v != nil && v.TaskInfos != nil
 }
 
+// GetSupportedCompressions returns the value of SupportedCompressions if it is set or its
+// zero value if it is unset.
+func (v *GetDLQReplicationMessagesRequest) GetSupportedCompressions() (o []CompressionType) {
+	if v != nil && v.SupportedCompressions != nil {
+		return v.SupportedCompressions
+	}
+
+	return
+}
+
+// IsSetSupportedCompressions returns true if SupportedCompressions is not nil.
+func (v *GetDLQReplicationMessagesRequest) IsSetSupportedCompressions() bool {
+	return v != nil && v.SupportedCompressions != nil
+}
+
 type GetDLQReplicationMessagesResponse struct {
 	ReplicationTasks []*ReplicationTask `json:"replicationTasks,omitempty"`
 }
@@ -3086,8 +3475,9 @@ func (v *GetDomainReplicationMessagesResponse) IsSetMessages() bool {
 }
 
 type GetReplicationMessagesRequest struct {
-	Tokens      []*ReplicationToken `json:"tokens,omitempty"`
-	ClusterName *string             `json:"clusterName,omitempty"`
+	Tokens                []*ReplicationToken `json:"tokens,omitempty"`
+	ClusterName           *string             `json:"clusterName,omitempty"`
+	SupportedCompressions []CompressionType   `json:"supportedCompressions,omitempty"`
 }
 
 type _List_ReplicationToken_ValueList []*ReplicationToken
@@ -3136,7 +3526,7 @@ func (_List_ReplicationToken_ValueList) Close() {}
 //   }
 func (v *GetReplicationMessagesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3158,6 +3548,14 @@ func (v *GetReplicationMessagesRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.SupportedCompressions != nil {
+		w, err = wire.NewValueList(_List_CompressionType_ValueList(v.SupportedCompressions)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -3225,6 +3623,14 @@ func (v *GetReplicationMessagesRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.SupportedCompressions, err = _List_CompressionType_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -3266,7 +3672,19 @@ func (v *GetReplicationMessagesRequest) Encode(sw stream.Writer) error {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := _List_ReplicationToken_Encode(v.Tokens, sw); err != nil {
+		if err := _List_ReplicationToken_Encode(v.Tokens, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ClusterName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -3274,11 +3692,11 @@ func (v *GetReplicationMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ClusterName != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.SupportedCompressions != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ClusterName)); err != nil {
+		if err := _List_CompressionType_Encode(v.SupportedCompressions, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -3357,6 +3775,12 @@ func (v *GetReplicationMessagesRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 30 && fh.Type == wire.TList:
+			v.SupportedCompressions, err = _List_CompressionType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -3386,7 +3810,7 @@ func (v *GetReplicationMessagesRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Tokens != nil {
 		fields[i] = fmt.Sprintf("Tokens: %v", v.Tokens)
@@ -3396,6 +3820,10 @@ func (v *GetReplicationMessagesRequest) String() string {
 		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
 		i++
 	}
+	if v.SupportedCompressions != nil {
+		fields[i] = fmt.Sprintf("SupportedCompressions: %v", v.SupportedCompressions)
+		i++
+	}
 
 	return fmt.Sprintf("GetReplicationMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -3431,6 +3859,9 @@ func (v *GetReplicationMessagesRequest) Equals(rhs *GetReplicationMessagesReques
 	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
 		return false
 	}
+	if !((v.SupportedCompressions == nil && rhs.SupportedCompressions == nil) || (v.SupportedCompressions != nil && rhs.SupportedCompressions != nil && _List_CompressionType_Equals(v.SupportedCompressions, rhs.SupportedCompressions))) {
+		return false
+	}
 
 	return true
 }
@@ -3458,6 +3889,9 @@ func (v *GetReplicationMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncod
 	if v.ClusterName != nil {
 		enc.AddString("clusterName", *v.ClusterName)
 	}
+	if v.SupportedCompressions != nil {
+		err = multierr.Append(err, enc.AddArray("supportedCompressions", (_List_CompressionType_Zapper)(v.SupportedCompressions)))
+	}
 	return err
 }
 
@@ -3491,6 +3925,21 @@ func (v *GetReplicationMessagesRequest) IsSetClusterName() bool {
 	return v != nil && v.ClusterName != nil
 }
 
+// GetSupportedCompressions returns the value of SupportedCompressions if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationMessagesRequest) GetSupportedCompressions() (o []CompressionType) {
+	if v != nil && v.SupportedCompressions != nil {
+		return v.SupportedCompressions
+	}
+
+	return
+}
+
+// IsSetSupportedCompressions returns true if SupportedCompressions is not nil.
+func (v *GetReplicationMessagesRequest) IsSetSupportedCompressions() bool {
+	return v != nil && v.SupportedCompressions != nil
+}
+
 type GetReplicationMessagesResponse struct {
 	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
 }
@@ -3878,6 +4327,7 @@ type HistoryTaskV2Attributes struct {
 	VersionHistoryItems []*shared.VersionHistoryItem `json:"versionHistoryItems,omitempty"`
 	Events              *shared.DataBlob             `json:"events,omitempty"`
 	NewRunEvents        *shared.DataBlob             `json:"newRunEvents,omitempty"`
+	Compression         *CompressionType             `json:"compression,omitempty"`
 }
 
 type _List_VersionHistoryItem_ValueList []*shared.VersionHistoryItem
@@ -3926,7 +4376,7 @@ func (_List_VersionHistoryItem_ValueList) Close() {}
 //   }
 func (v *HistoryTaskV2Attributes) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3988,6 +4438,14 @@ func (v *HistoryTaskV2Attributes) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.Compression != nil {
+		w, err = v.Compression.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -4107,6 +4565,16 @@ func (v *HistoryTaskV2Attributes) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TI32 {
+				var x CompressionType
+				x, err = _CompressionType_Read(field.Value)
+				v.Compression = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4228,6 +4696,18 @@ func (v *HistoryTaskV2Attributes) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Compression != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Compression.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -4341,6 +4821,14 @@ func (v *HistoryTaskV2Attributes) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 80 && fh.Type == wire.TI32:
+			var x CompressionType
+			x, err = _CompressionType_Decode(sr)
+			v.Compression = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -4370,7 +4858,7 @@ func (v *HistoryTaskV2Attributes) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.TaskId != nil {
 		fields[i] = fmt.Sprintf("TaskId: %v", *(v.TaskId))
@@ -4400,6 +4888,10 @@ func (v *HistoryTaskV2Attributes) String() string {
 		fields[i] = fmt.Sprintf("NewRunEvents: %v", v.NewRunEvents)
 		i++
 	}
+	if v.Compression != nil {
+		fields[i] = fmt.Sprintf("Compression: %v", *(v.Compression))
+		i++
+	}
 
 	return fmt.Sprintf("HistoryTaskV2Attributes{%v}", strings.Join(fields[:i], ", "))
 }
@@ -4419,6 +4911,16 @@ func _List_VersionHistoryItem_Equals(lhs, rhs []*shared.VersionHistoryItem) bool
 	return true
 }
 
+func _CompressionType_EqualsPtr(lhs, rhs *CompressionType) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
 // Equals returns true if all the fields of this HistoryTaskV2Attributes match the
 // provided HistoryTaskV2Attributes.
 //
@@ -4450,6 +4952,9 @@ func (v *HistoryTaskV2Attributes) Equals(rhs *HistoryTaskV2Attributes) bool {
 	if !((v.NewRunEvents == nil && rhs.NewRunEvents == nil) || (v.NewRunEvents != nil && rhs.NewRunEvents != nil && v.NewRunEvents.Equals(rhs.NewRunEvents))) {
 		return false
 	}
+	if !_CompressionType_EqualsPtr(v.Compression, rhs.Compression) {
+		return false
+	}
 
 	return true
 }
@@ -4492,6 +4997,9 @@ func (v *HistoryTaskV2Attributes) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 	if v.NewRunEvents != nil {
 		err = multierr.Append(err, enc.AddObject("newRunEvents", v.NewRunEvents))
 	}
+	if v.Compression != nil {
+		err = multierr.Append(err, enc.AddObject("compression", *v.Compression))
+	}
 	return err
 }
 
@@ -4600,6 +5108,21 @@ func (v *HistoryTaskV2Attributes) IsSetNewRunEvents() bool {
 	return v != nil && v.NewRunEvents != nil
 }
 
+// GetCompression returns the value of Compression if it is set or its
+// zero value if it is unset.
+func (v *HistoryTaskV2Attributes) GetCompression() (o CompressionType) {
+	if v != nil && v.Compression != nil {
+		return *v.Compression
+	}
+
+	return
+}
+
+// IsSetCompression returns true if Compression is not nil.
+func (v *HistoryTaskV2Attributes) IsSetCompression() bool {
+	return v != nil && v.Compression != nil
+}
+
 type MergeDLQMessagesRequest struct {
 	Type                  *DLQType `json:"type,omitempty"`
 	ShardID               *int32   `json:"shardID,omitempty"`
@@ -4607,6 +5130,7 @@ type MergeDLQMessagesRequest struct {
 	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
 	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
 	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+	EndShardID            *int32   `json:"endShardID,omitempty"`
 }
 
 // ToWire translates a MergeDLQMessagesRequest struct into a Thrift-level intermediate
@@ -4626,7 +5150,7 @@ type MergeDLQMessagesRequest struct {
 //   }
 func (v *MergeDLQMessagesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -4680,6 +5204,14 @@ func (v *MergeDLQMessagesRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.EndShardID != nil {
+		w, err = wire.NewValueI32(*(v.EndShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -4769,6 +5301,16 @@ func (v *MergeDLQMessagesRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EndShardID = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4857,6 +5399,18 @@ func (v *MergeDLQMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.EndShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.EndShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -4930,6 +5484,14 @@ func (v *MergeDLQMessagesRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 70 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.EndShardID = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -4959,7 +5521,7 @@ func (v *MergeDLQMessagesRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Type != nil {
 		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
@@ -4985,6 +5547,10 @@ func (v *MergeDLQMessagesRequest) String() string {
 		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
+	if v.EndShardID != nil {
+		fields[i] = fmt.Sprintf("EndShardID: %v", *(v.EndShardID))
+		i++
+	}
 
 	return fmt.Sprintf("MergeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -5037,6 +5603,9 @@ func (v *MergeDLQMessagesRequest) Equals(rhs *MergeDLQMessagesRequest) bool {
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.EndShardID, rhs.EndShardID) {
+		return false
+	}
 
 	return true
 }
@@ -5065,6 +5634,9 @@ func (v *MergeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 	if v.NextPageToken != nil {
 		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
+	if v.EndShardID != nil {
+		enc.AddInt32("endShardID", *v.EndShardID)
+	}
 	return err
 }
 
@@ -5158,6 +5730,21 @@ func (v *MergeDLQMessagesRequest) IsSetNextPageToken() bool {
 	return v != nil && v.NextPageToken != nil
 }
 
+// GetEndShardID returns the value of EndShardID if it is set or its
+// zero value if it is unset.
+func (v *MergeDLQMessagesRequest) GetEndShardID() (o int32) {
+	if v != nil && v.EndShardID != nil {
+		return *v.EndShardID
+	}
+
+	return
+}
+
+// IsSetEndShardID returns true if EndShardID is not nil.
+func (v *MergeDLQMessagesRequest) IsSetEndShardID() bool {
+	return v != nil && v.EndShardID != nil
+}
+
 type MergeDLQMessagesResponse struct {
 	NextPageToken []byte `json:"nextPageToken,omitempty"`
 }
@@ -5369,6 +5956,7 @@ type PurgeDLQMessagesRequest struct {
 	ShardID               *int32   `json:"shardID,omitempty"`
 	SourceCluster         *string  `json:"sourceCluster,omitempty"`
 	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
+	EndShardID            *int32   `json:"endShardID,omitempty"`
 }
 
 // ToWire translates a PurgeDLQMessagesRequest struct into a Thrift-level intermediate
@@ -5388,7 +5976,7 @@ type PurgeDLQMessagesRequest struct {
 //   }
 func (v *PurgeDLQMessagesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5426,6 +6014,14 @@ func (v *PurgeDLQMessagesRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.EndShardID != nil {
+		w, err = wire.NewValueI32(*(v.EndShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -5491,6 +6087,16 @@ func (v *PurgeDLQMessagesRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EndShardID = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -5555,6 +6161,18 @@ func (v *PurgeDLQMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.EndShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.EndShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -5608,6 +6226,14 @@ func (v *PurgeDLQMessagesRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 50 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.EndShardID = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -5637,7 +6263,7 @@ func (v *PurgeDLQMessagesRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Type != nil {
 		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
@@ -5655,6 +6281,10 @@ func (v *PurgeDLQMessagesRequest) String() string {
 		fields[i] = fmt.Sprintf("InclusiveEndMessageID: %v", *(v.InclusiveEndMessageID))
 		i++
 	}
+	if v.EndShardID != nil {
+		fields[i] = fmt.Sprintf("EndShardID: %v", *(v.EndShardID))
+		i++
+	}
 
 	return fmt.Sprintf("PurgeDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -5681,6 +6311,9 @@ func (v *PurgeDLQMessagesRequest) Equals(rhs *PurgeDLQMessagesRequest) bool {
 	if !_I64_EqualsPtr(v.InclusiveEndMessageID, rhs.InclusiveEndMessageID) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.EndShardID, rhs.EndShardID) {
+		return false
+	}
 
 	return true
 }
@@ -5703,6 +6336,9 @@ func (v *PurgeDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (e
 	if v.InclusiveEndMessageID != nil {
 		enc.AddInt64("inclusiveEndMessageID", *v.InclusiveEndMessageID)
 	}
+	if v.EndShardID != nil {
+		enc.AddInt32("endShardID", *v.EndShardID)
+	}
 	return err
 }
 
@@ -5766,6 +6402,21 @@ func (v *PurgeDLQMessagesRequest) IsSetInclusiveEndMessageID() bool {
 	return v != nil && v.InclusiveEndMessageID != nil
 }
 
+// GetEndShardID returns the value of EndShardID if it is set or its
+// zero value if it is unset.
+func (v *PurgeDLQMessagesRequest) GetEndShardID() (o int32) {
+	if v != nil && v.EndShardID != nil {
+		return *v.EndShardID
+	}
+
+	return
+}
+
+// IsSetEndShardID returns true if EndShardID is not nil.
+func (v *PurgeDLQMessagesRequest) IsSetEndShardID() bool {
+	return v != nil && v.EndShardID != nil
+}
+
 type ReadDLQMessagesRequest struct {
 	Type                  *DLQType `json:"type,omitempty"`
 	ShardID               *int32   `json:"shardID,omitempty"`
@@ -5773,6 +6424,7 @@ type ReadDLQMessagesRequest struct {
 	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
 	MaximumPageSize       *int32   `json:"maximumPageSize,omitempty"`
 	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+	EndShardID            *int32   `json:"endShardID,omitempty"`
 }
 
 // ToWire translates a ReadDLQMessagesRequest struct into a Thrift-level intermediate
@@ -5792,7 +6444,7 @@ type ReadDLQMessagesRequest struct {
 //   }
 func (v *ReadDLQMessagesRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -5846,6 +6498,14 @@ func (v *ReadDLQMessagesRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.EndShardID != nil {
+		w, err = wire.NewValueI32(*(v.EndShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -5929,6 +6589,16 @@ func (v *ReadDLQMessagesRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EndShardID = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -6017,6 +6687,18 @@ func (v *ReadDLQMessagesRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.EndShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.EndShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -6084,6 +6766,14 @@ func (v *ReadDLQMessagesRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 70 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.EndShardID = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -6113,7 +6803,7 @@ func (v *ReadDLQMessagesRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Type != nil {
 		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
@@ -6139,6 +6829,10 @@ func (v *ReadDLQMessagesRequest) String() string {
 		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
+	if v.EndShardID != nil {
+		fields[i] = fmt.Sprintf("EndShardID: %v", *(v.EndShardID))
+		i++
+	}
 
 	return fmt.Sprintf("ReadDLQMessagesRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -6171,6 +6865,9 @@ func (v *ReadDLQMessagesRequest) Equals(rhs *ReadDLQMessagesRequest) bool {
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
+	if !_I32_EqualsPtr(v.EndShardID, rhs.EndShardID) {
+		return false
+	}
 
 	return true
 }
@@ -6199,6 +6896,9 @@ func (v *ReadDLQMessagesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.NextPageToken != nil {
 		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
+	if v.EndShardID != nil {
+		enc.AddInt32("endShardID", *v.EndShardID)
+	}
 	return err
 }
 
@@ -6292,6 +6992,21 @@ func (v *ReadDLQMessagesRequest) IsSetNextPageToken() bool {
 	return v != nil && v.NextPageToken != nil
 }
 
+// GetEndShardID returns the value of EndShardID if it is set or its
+// zero value if it is unset.
+func (v *ReadDLQMessagesRequest) GetEndShardID() (o int32) {
+	if v != nil && v.EndShardID != nil {
+		return *v.EndShardID
+	}
+
+	return
+}
+
+// IsSetEndShardID returns true if EndShardID is not nil.
+func (v *ReadDLQMessagesRequest) IsSetEndShardID() bool {
+	return v != nil && v.EndShardID != nil
+}
+
 type ReadDLQMessagesResponse struct {
 	Type                 *DLQType               `json:"type,omitempty"`
 	ReplicationTasks     []*ReplicationTask     `json:"replicationTasks,omitempty"`
@@ -7807,15 +8522,16 @@ func (v *ReplicationTask) IsSetCreationTime() bool {
 }
 
 type ReplicationTaskInfo struct {
-	DomainID     *string `json:"domainID,omitempty"`
-	WorkflowID   *string `json:"workflowID,omitempty"`
-	RunID        *string `json:"runID,omitempty"`
-	TaskType     *int16  `json:"taskType,omitempty"`
-	TaskID       *int64  `json:"taskID,omitempty"`
-	Version      *int64  `json:"version,omitempty"`
-	FirstEventID *int64  `json:"firstEventID,omitempty"`
-	NextEventID  *int64  `json:"nextEventID,omitempty"`
-	ScheduledID  *int64  `json:"scheduledID,omitempty"`
+	DomainID       *string `json:"domainID,omitempty"`
+	WorkflowID     *string `json:"workflowID,omitempty"`
+	RunID          *string `json:"runID,omitempty"`
+	TaskType       *int16  `json:"taskType,omitempty"`
+	TaskID         *int64  `json:"taskID,omitempty"`
+	Version        *int64  `json:"version,omitempty"`
+	FirstEventID   *int64  `json:"firstEventID,omitempty"`
+	NextEventID    *int64  `json:"nextEventID,omitempty"`
+	ScheduledID    *int64  `json:"scheduledID,omitempty"`
+	ExpirationTime *int64  `json:"expirationTime,omitempty"`
 }
 
 // ToWire translates a ReplicationTaskInfo struct into a Thrift-level intermediate
@@ -7835,7 +8551,7 @@ type ReplicationTaskInfo struct {
 //   }
 func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [9]wire.Field
+		fields [10]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7913,6 +8629,14 @@ func (v *ReplicationTaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 90, Value: w}
 		i++
 	}
+	if v.ExpirationTime != nil {
+		w, err = wire.NewValueI64(*(v.ExpirationTime)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 100, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -8028,6 +8752,16 @@ func (v *ReplicationTaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 100:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpirationTime = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -8152,6 +8886,18 @@ func (v *ReplicationTaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.ExpirationTime != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 100, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ExpirationTime)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -8245,6 +8991,14 @@ func (v *ReplicationTaskInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 100 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ExpirationTime = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -8274,7 +9028,7 @@ func (v *ReplicationTaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [9]string
+	var fields [10]string
 	i := 0
 	if v.DomainID != nil {
 		fields[i] = fmt.Sprintf("DomainID: %v", *(v.DomainID))
@@ -8312,6 +9066,10 @@ func (v *ReplicationTaskInfo) String() string {
 		fields[i] = fmt.Sprintf("ScheduledID: %v", *(v.ScheduledID))
 		i++
 	}
+	if v.ExpirationTime != nil {
+		fields[i] = fmt.Sprintf("ExpirationTime: %v", *(v.ExpirationTime))
+		i++
+	}
 
 	return fmt.Sprintf("ReplicationTaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -8363,6 +9121,9 @@ func (v *ReplicationTaskInfo) Equals(rhs *ReplicationTaskInfo) bool {
 	if !_I64_EqualsPtr(v.ScheduledID, rhs.ScheduledID) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.ExpirationTime, rhs.ExpirationTime) {
+		return false
+	}
 
 	return true
 }
@@ -8400,6 +9161,9 @@ func (v *ReplicationTaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.ScheduledID != nil {
 		enc.AddInt64("scheduledID", *v.ScheduledID)
 	}
+	if v.ExpirationTime != nil {
+		enc.AddInt64("expirationTime", *v.ExpirationTime)
+	}
 	return err
 }
 
@@ -8538,6 +9302,21 @@ func (v *ReplicationTaskInfo) IsSetScheduledID() bool {
 	return v != nil && v.ScheduledID != nil
 }
 
+// GetExpirationTime returns the value of ExpirationTime if it is set or its
+// zero value if it is unset.
+func (v *ReplicationTaskInfo) GetExpirationTime() (o int64) {
+	if v != nil && v.ExpirationTime != nil {
+		return *v.ExpirationTime
+	}
+
+	return
+}
+
+// IsSetExpirationTime returns true if ExpirationTime is not nil.
+func (v *ReplicationTaskInfo) IsSetExpirationTime() bool {
+	return v != nil && v.ExpirationTime != nil
+}
+
 type ReplicationTaskType int32
 
 const (
@@ -10799,11 +11578,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "replicator",
 	Package:  "github.com/uber/cadence/.gen/go/replicator",
 	FilePath: "replicator.thrift",
-	SHA1:     "85475c6529845fd2ee1b039bfa9eda1128146139",
+	SHA1:     "076412d3c8a3046f8600a195dc7e504933cd31db",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n  FailoverMarker\n}\n\nenum DomainOperation {\n  Create\n  Update\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n  70: optional i64 (js.type = \"Long\") previousFailoverVersion\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActivityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  150: optional shared.VersionHistory versionHistory\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n}\n\nstruct FailoverMarkerAttributes{\n\t10: optional string domainID\n\t20: optional i64 (js.type = \"Long\") failoverVersion\n\t30: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct FailoverMarkers{\n\t10: optional list<FailoverMarkerAttributes> failoverMarkers\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActivityTaskAttributes syncActivityTaskAttributes\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n  80: optional FailoverMarkerAttributes failoverMarkerAttributes\n  90: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct SyncShardStatus {\n  10: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  30: optional bool hasMore // Hint for flow control\n  40: optional SyncShardStatus syncShardStatus\n}\n\nstruct ReplicationTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional i16 taskType\n  50: optional i64 (js.type = \"Long\") taskID\n  60: optional i64 (js.type = \"Long\") version\n  70: optional i64 (js.type = \"Long\") firstEventID\n  80: optional i64 (js.type = \"Long\") nextEventID\n  90: optional i64 (js.type = \"Long\") scheduledID\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n  20: optional string clusterName\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrievedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}\n\nstruct GetDLQReplicationMessagesRequest {\n  10: optional list<ReplicationTaskInfo> taskInfos\n}\n\nstruct GetDLQReplicationMessagesResponse {\n  10: optional list<ReplicationTask> replicationTasks\n}\n\nenum DLQType {\n  Replication,\n  Domain,\n}\n\nstruct ReadDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct ReadDLQMessagesResponse{\n  10: optional DLQType type\n  20: optional list<ReplicationTask> replicationTasks\n  30: optional binary nextPageToken\n  40: optional list<ReplicationTaskInfo> replicationTasksInfo\n}\n\nstruct PurgeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n}\n\nstruct MergeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct MergeDLQMessagesResponse{\n  10: optional binary nextPageToken\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.replicator\n\ninclude \"shared.thrift\"\n\nenum ReplicationTaskType {\n  Domain\n  History\n  SyncShardStatus\n  SyncActivity\n  HistoryMetadata\n  HistoryV2\n  FailoverMarker\n}\n\n// CompressionType is the compression of the history event blobs of a replication task\nenum CompressionType {\n  Snappy\n  Zstd\n}\n\nenum DomainOperation {\n  Create\n  Update\n}\n\nstruct DomainTaskAttributes {\n  05: optional DomainOperation domainOperation\n  10: optional string id\n  20: optional shared.DomainInfo info\n  30: optional shared.DomainConfiguration config\n  40: optional shared.DomainReplicationConfiguration replicationConfig\n  50: optional i64 (js.type = \"Long\") configVersion\n  60: optional i64 (js.type = \"Long\") failoverVersion\n  70: optional i64 (js.type = \"Long\") previousFailoverVersion\n}\n\nstruct SyncShardStatusTaskAttributes {\n  10: optional string sourceCluster\n  20: optional i64 (js.type = \"Long\") shardId\n  30: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct SyncActivityTaskAttributes {\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional i64 (js.type = \"Long\") version\n  50: optional i64 (js.type = \"Long\") scheduledId\n  60: optional i64 (js.type = \"Long\") scheduledTime\n  70: optional i64 (js.type = \"Long\") startedId\n  80: optional i64 (js.type = \"Long\") startedTime\n  90: optional i64 (js.type = \"Long\") lastHeartbeatTime\n  100: optional binary details\n  110: optional i32 attempt\n  120: optional string lastFailureReason\n  130: optional string lastWorkerIdentity\n  140: optional binary lastFailureDetails\n  150: optional shared.VersionHistory versionHistory\n}\n\nstruct HistoryTaskV2Attributes {\n  05: optional i64 (js.type = \"Long\") taskId\n  10: optional string domainId\n  20: optional string workflowId\n  30: optional string runId\n  40: optional list<shared.VersionHistoryItem> versionHistoryItems\n  50: optional shared.DataBlob events\n  // new run events does not need version history since there is no prior events\n  70: optional shared.DataBlob newRunEvents\n  // compression of the data of events and newRunEvents, not set if they are not compressed\n  80: optional CompressionType compression\n}\n\nstruct FailoverMarkerAttributes{\n\t10: optional string domainID\n\t20: optional i64 (js.type = \"Long\") failoverVersion\n\t30: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct FailoverMarkers{\n\t10: optional list<FailoverMarkerAttributes> failoverMarkers\n}\n\nstruct ReplicationTask {\n  10: optional ReplicationTaskType taskType\n  11: optional i64 (js.type = \"Long\") sourceTaskId\n  20: optional DomainTaskAttributes domainTaskAttributes\n  40: optional SyncShardStatusTaskAttributes syncShardStatusTaskAttributes\n  50: optional SyncActivityTaskAttributes syncActivityTaskAttributes\n  70: optional HistoryTaskV2Attributes historyTaskV2Attributes\n  80: optional FailoverMarkerAttributes failoverMarkerAttributes\n  90: optional i64 (js.type = \"Long\") creationTime\n}\n\nstruct ReplicationToken {\n  10: optional i32 shardID\n  // lastRetrivedMessageId is where the next fetch should begin with\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  30: optional i64 (js.type = \"Long\") lastProcessedMessageId\n}\n\nstruct SyncShardStatus {\n  10: optional i64 (js.type = \"Long\") timestamp\n}\n\nstruct ReplicationMessages {\n  10: optional list<ReplicationTask> replicationTasks\n  // This can be different than the last taskId in the above list, because sender can decide to skip tasks (e.g. for completed workflows).\n  20: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  30: optional bool hasMore // Hint for flow control\n  40: optional SyncShardStatus syncShardStatus\n}\n\nstruct ReplicationTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional i16 taskType\n  50: optional i64 (js.type = \"Long\") taskID\n  60: optional i64 (js.type = \"Long\") version\n  70: optional i64 (js.type = \"Long\") firstEventID\n  80: optional i64 (js.type = \"Long\") nextEventID\n  90: optional i64 (js.type = \"Long\") scheduledID\n  100: optional i64 (js.type = \"Long\") expirationTime\n}\n\nstruct GetReplicationMessagesRequest {\n  10: optional list<ReplicationToken> tokens\n  20: optional string clusterName\n  // compressions the requesting cluster can decode\n  30: optional list<CompressionType> supportedCompressions\n}\n\nstruct GetReplicationMessagesResponse {\n  10: optional map<i32, ReplicationMessages> messagesByShard\n}\n\nstruct GetDomainReplicationMessagesRequest {\n  // lastRetrievedMessageId is where the next fetch should begin with\n  10: optional i64 (js.type = \"Long\") lastRetrievedMessageId\n  // lastProcessedMessageId is the last messageId that is processed on the passive side.\n  // This can be different than lastRetrievedMessageId if passive side supports prefetching messages.\n  20: optional i64 (js.type = \"Long\") lastProcessedMessageId\n  // clusterName is the name of the pulling cluster\n  30: optional string clusterName\n}\n\nstruct GetDomainReplicationMessagesResponse {\n  10: optional ReplicationMessages messages\n}\n\nstruct GetDLQReplicationMessagesRequest {\n  10: optional list<ReplicationTaskInfo> taskInfos\n  // compressions the requesting cluster can decode\n  20: optional list<CompressionType> supportedCompressions\n}\n\nstruct GetDLQReplicationMessagesResponse {\n  10: optional list<ReplicationTask> replicationTasks\n}\n\nenum DLQType {\n  Replication,\n  Domain,\n}\n\nstruct ReadDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n  70: optional i32 endShardID\n}\n\nstruct ReadDLQMessagesResponse{\n  10: optional DLQType type\n  20: optional list<ReplicationTask> replicationTasks\n  30: optional binary nextPageToken\n  40: optional list<ReplicationTaskInfo> replicationTasksInfo\n}\n\nstruct PurgeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 endShardID\n}\n\nstruct MergeDLQMessagesRequest{\n  10: optional DLQType type\n  20: optional i32 shardID\n  30: optional string sourceCluster\n  40: optional i64 (js.type = \"Long\") inclusiveEndMessageID\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n  70: optional i32 endShardID\n}\n\nstruct MergeDLQMessagesResponse{\n  10: optional binary nextPageToken\n}\n"
@@ -6056,6 +6056,408 @@ func (v *ArchivalStatus) UnmarshalJSON(text []byte) error {
 	}
 }
 
+type AsyncWorkflowConfiguration struct {
+	Enabled       *bool   `json:"enabled,omitempty"`
+	QueueProvider *string `json:"queueProvider,omitempty"`
+	QueueTopic    *string `json:"queueTopic,omitempty"`
+	Rps           *int32  `json:"rps,omitempty"`
+}
+
+// ToWire translates a AsyncWorkflowConfiguration struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AsyncWorkflowConfiguration) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Enabled != nil {
+		w, err = wire.NewValueBool(*(v.Enabled)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.QueueProvider != nil {
+		w, err = wire.NewValueString(*(v.QueueProvider)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.QueueTopic != nil {
+		w, err = wire.NewValueString(*(v.QueueTopic)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Rps != nil {
+		w, err = wire.NewValueI32(*(v.Rps)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AsyncWorkflowConfiguration struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AsyncWorkflowConfiguration struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AsyncWorkflowConfiguration
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AsyncWorkflowConfiguration) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Enabled = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.QueueProvider = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.QueueTopic = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Rps = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a AsyncWorkflowConfiguration struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a AsyncWorkflowConfiguration struct could not be encoded.
+func (v *AsyncWorkflowConfiguration) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Enabled != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Enabled)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.QueueProvider != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.QueueProvider)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.QueueTopic != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.QueueTopic)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Rps != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Rps)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a AsyncWorkflowConfiguration struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a AsyncWorkflowConfiguration struct could not be generated from the wire
+// representation.
+func (v *AsyncWorkflowConfiguration) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Enabled = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.QueueProvider = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.QueueTopic = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Rps = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AsyncWorkflowConfiguration
+// struct.
+func (v *AsyncWorkflowConfiguration) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Enabled != nil {
+		fields[i] = fmt.Sprintf("Enabled: %v", *(v.Enabled))
+		i++
+	}
+	if v.QueueProvider != nil {
+		fields[i] = fmt.Sprintf("QueueProvider: %v", *(v.QueueProvider))
+		i++
+	}
+	if v.QueueTopic != nil {
+		fields[i] = fmt.Sprintf("QueueTopic: %v", *(v.QueueTopic))
+		i++
+	}
+	if v.Rps != nil {
+		fields[i] = fmt.Sprintf("Rps: %v", *(v.Rps))
+		i++
+	}
+
+	return fmt.Sprintf("AsyncWorkflowConfiguration{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AsyncWorkflowConfiguration match the
+// provided AsyncWorkflowConfiguration.
+//
+// This function performs a deep comparison.
+func (v *AsyncWorkflowConfiguration) Equals(rhs *AsyncWorkflowConfiguration) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Enabled, rhs.Enabled) {
+		return false
+	}
+	if !_String_EqualsPtr(v.QueueProvider, rhs.QueueProvider) {
+		return false
+	}
+	if !_String_EqualsPtr(v.QueueTopic, rhs.QueueTopic) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Rps, rhs.Rps) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AsyncWorkflowConfiguration.
+func (v *AsyncWorkflowConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Enabled != nil {
+		enc.AddBool("enabled", *v.Enabled)
+	}
+	if v.QueueProvider != nil {
+		enc.AddString("queueProvider", *v.QueueProvider)
+	}
+	if v.QueueTopic != nil {
+		enc.AddString("queueTopic", *v.QueueTopic)
+	}
+	if v.Rps != nil {
+		enc.AddInt32("rps", *v.Rps)
+	}
+	return err
+}
+
+// GetEnabled returns the value of Enabled if it is set or its
+// zero value if it is unset.
+func (v *AsyncWorkflowConfiguration) GetEnabled() (o bool) {
+	if v != nil && v.Enabled != nil {
+		return *v.Enabled
+	}
+
+	return
+}
+
+// IsSetEnabled returns true if Enabled is not nil.
+func (v *AsyncWorkflowConfiguration) IsSetEnabled() bool {
+	return v != nil && v.Enabled != nil
+}
+
+// GetQueueProvider returns the value of QueueProvider if it is set or its
+// zero value if it is unset.
+func (v *AsyncWorkflowConfiguration) GetQueueProvider() (o string) {
+	if v != nil && v.QueueProvider != nil {
+		return *v.QueueProvider
+	}
+
+	return
+}
+
+// IsSetQueueProvider returns true if QueueProvider is not nil.
+func (v *AsyncWorkflowConfiguration) IsSetQueueProvider() bool {
+	return v != nil && v.QueueProvider != nil
+}
+
+// GetQueueTopic returns the value of QueueTopic if it is set or its
+// zero value if it is unset.
+func (v *AsyncWorkflowConfiguration) GetQueueTopic() (o string) {
+	if v != nil && v.QueueTopic != nil {
+		return *v.QueueTopic
+	}
+
+	return
+}
+
+// IsSetQueueTopic returns true if QueueTopic is not nil.
+func (v *AsyncWorkflowConfiguration) IsSetQueueTopic() bool {
+	return v != nil && v.QueueTopic != nil
+}
+
+// GetRps returns the value of Rps if it is set or its
+// zero value if it is unset.
+func (v *AsyncWorkflowConfiguration) GetRps() (o int32) {
+	if v != nil && v.Rps != nil {
+		return *v.Rps
+	}
+
+	return
+}
+
+// IsSetRps returns true if Rps is not nil.
+func (v *AsyncWorkflowConfiguration) IsSetRps() bool {
+	return v != nil && v.Rps != nil
+}
+
 type BadBinaries struct {
 	Binaries map[string]*BadBinaryInfo `json:"binaries,omitempty"`
 }
@@ -13966,9 +14368,284 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) IsSetSearchAttributes
 	return v != nil && v.SearchAttributes != nil
 }
 
+type CountWorkflowExecutionsGroup struct {
+	Value *string `json:"value,omitempty"`
+	Count *int64  `json:"count,omitempty"`
+}
+
+// ToWire translates a CountWorkflowExecutionsGroup struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *CountWorkflowExecutionsGroup) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Value != nil {
+		w, err = wire.NewValueString(*(v.Value)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a CountWorkflowExecutionsGroup struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a CountWorkflowExecutionsGroup struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v CountWorkflowExecutionsGroup
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *CountWorkflowExecutionsGroup) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Value = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a CountWorkflowExecutionsGroup struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a CountWorkflowExecutionsGroup struct could not be encoded.
+func (v *CountWorkflowExecutionsGroup) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Value != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Value)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Count != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Count)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a CountWorkflowExecutionsGroup struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a CountWorkflowExecutionsGroup struct could not be generated from the wire
+// representation.
+func (v *CountWorkflowExecutionsGroup) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Value = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Count = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a CountWorkflowExecutionsGroup
+// struct.
+func (v *CountWorkflowExecutionsGroup) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Value != nil {
+		fields[i] = fmt.Sprintf("Value: %v", *(v.Value))
+		i++
+	}
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+
+	return fmt.Sprintf("CountWorkflowExecutionsGroup{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this CountWorkflowExecutionsGroup match the
+// provided CountWorkflowExecutionsGroup.
+//
+// This function performs a deep comparison.
+func (v *CountWorkflowExecutionsGroup) Equals(rhs *CountWorkflowExecutionsGroup) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Value, rhs.Value) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of CountWorkflowExecutionsGroup.
+func (v *CountWorkflowExecutionsGroup) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Value != nil {
+		enc.AddString("value", *v.Value)
+	}
+	if v.Count != nil {
+		enc.AddInt64("count", *v.Count)
+	}
+	return err
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsGroup) GetValue() (o string) {
+	if v != nil && v.Value != nil {
+		return *v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+func (v *CountWorkflowExecutionsGroup) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsGroup) GetCount() (o int64) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+func (v *CountWorkflowExecutionsGroup) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
 type CountWorkflowExecutionsRequest struct {
-	Domain *string `json:"domain,omitempty"`
-	Query  *string `json:"query,omitempty"`
+	Domain  *string `json:"domain,omitempty"`
+	Query   *string `json:"query,omitempty"`
+	GroupBy *string `json:"groupBy,omitempty"`
 }
 
 // ToWire translates a CountWorkflowExecutionsRequest struct into a Thrift-level intermediate
@@ -13988,7 +14665,7 @@ type CountWorkflowExecutionsRequest struct {
 //   }
 func (v *CountWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -14010,6 +14687,14 @@ func (v *CountWorkflowExecutionsRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.GroupBy != nil {
+		w, err = wire.NewValueString(*(v.GroupBy)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -14055,6 +14740,16 @@ func (v *CountWorkflowExecutionsRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.GroupBy = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -14095,6 +14790,18 @@ func (v *CountWorkflowExecutionsRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.GroupBy != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.GroupBy)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -14132,6 +14839,14 @@ func (v *CountWorkflowExecutionsRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.GroupBy = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -14161,7 +14876,7 @@ func (v *CountWorkflowExecutionsRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -14171,6 +14886,10 @@ func (v *CountWorkflowExecutionsRequest) String() string {
 		fields[i] = fmt.Sprintf("Query: %v", *(v.Query))
 		i++
 	}
+	if v.GroupBy != nil {
+		fields[i] = fmt.Sprintf("GroupBy: %v", *(v.GroupBy))
+		i++
+	}
 
 	return fmt.Sprintf("CountWorkflowExecutionsRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -14191,6 +14910,9 @@ func (v *CountWorkflowExecutionsRequest) Equals(rhs *CountWorkflowExecutionsRequ
 	if !_String_EqualsPtr(v.Query, rhs.Query) {
 		return false
 	}
+	if !_String_EqualsPtr(v.GroupBy, rhs.GroupBy) {
+		return false
+	}
 
 	return true
 }
@@ -14207,6 +14929,9 @@ func (v *CountWorkflowExecutionsRequest) MarshalLogObject(enc zapcore.ObjectEnco
 	if v.Query != nil {
 		enc.AddString("query", *v.Query)
 	}
+	if v.GroupBy != nil {
+		enc.AddString("groupBy", *v.GroupBy)
+	}
 	return err
 }
 
@@ -14240,10 +14965,55 @@ func (v *CountWorkflowExecutionsRequest) IsSetQuery() bool {
 	return v != nil && v.Query != nil
 }
 
+// GetGroupBy returns the value of GroupBy if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsRequest) GetGroupBy() (o string) {
+	if v != nil && v.GroupBy != nil {
+		return *v.GroupBy
+	}
+
+	return
+}
+
+// IsSetGroupBy returns true if GroupBy is not nil.
+func (v *CountWorkflowExecutionsRequest) IsSetGroupBy() bool {
+	return v != nil && v.GroupBy != nil
+}
+
 type CountWorkflowExecutionsResponse struct {
-	Count *int64 `json:"count,omitempty"`
+	Count  *int64                          `json:"count,omitempty"`
+	Groups []*CountWorkflowExecutionsGroup `json:"groups,omitempty"`
+}
+
+type _List_CountWorkflowExecutionsGroup_ValueList []*CountWorkflowExecutionsGroup
+
+func (v _List_CountWorkflowExecutionsGroup_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*CountWorkflowExecutionsGroup', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+func (v _List_CountWorkflowExecutionsGroup_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_CountWorkflowExecutionsGroup_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_CountWorkflowExecutionsGroup_ValueList) Close() {}
+
 // ToWire translates a CountWorkflowExecutionsResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -14261,7 +15031,7 @@ type CountWorkflowExecutionsResponse struct {
 //   }
 func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -14275,10 +15045,42 @@ func (v *CountWorkflowExecutionsResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
+	if v.Groups != nil {
+		w, err = wire.NewValueList(_List_CountWorkflowExecutionsGroup_ValueList(v.Groups)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _CountWorkflowExecutionsGroup_Read(w wire.Value) (*CountWorkflowExecutionsGroup, error) {
+	var v CountWorkflowExecutionsGroup
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_CountWorkflowExecutionsGroup_Read(l wire.ValueList) ([]*CountWorkflowExecutionsGroup, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*CountWorkflowExecutionsGroup, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _CountWorkflowExecutionsGroup_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a CountWorkflowExecutionsResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -14310,6 +15112,14 @@ func (v *CountWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Groups, err = _List_CountWorkflowExecutionsGroup_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -14317,6 +15127,27 @@ func (v *CountWorkflowExecutionsResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _List_CountWorkflowExecutionsGroup_Encode(val []*CountWorkflowExecutionsGroup, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*CountWorkflowExecutionsGroup', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
 // Encode serializes a CountWorkflowExecutionsResponse struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -14338,9 +15169,57 @@ func (v *CountWorkflowExecutionsResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Groups != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_CountWorkflowExecutionsGroup_Encode(v.Groups, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
+func _CountWorkflowExecutionsGroup_Decode(sr stream.Reader) (*CountWorkflowExecutionsGroup, error) {
+	var v CountWorkflowExecutionsGroup
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_CountWorkflowExecutionsGroup_Decode(sr stream.Reader) ([]*CountWorkflowExecutionsGroup, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*CountWorkflowExecutionsGroup, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _CountWorkflowExecutionsGroup_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a CountWorkflowExecutionsResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -14367,6 +15246,12 @@ func (v *CountWorkflowExecutionsResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Groups, err = _List_CountWorkflowExecutionsGroup_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -14396,16 +15281,35 @@ func (v *CountWorkflowExecutionsResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	if v.Count != nil {
 		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
 		i++
 	}
+	if v.Groups != nil {
+		fields[i] = fmt.Sprintf("Groups: %v", v.Groups)
+		i++
+	}
 
 	return fmt.Sprintf("CountWorkflowExecutionsResponse{%v}", strings.Join(fields[:i], ", "))
 }
 
+func _List_CountWorkflowExecutionsGroup_Equals(lhs, rhs []*CountWorkflowExecutionsGroup) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this CountWorkflowExecutionsResponse match the
 // provided CountWorkflowExecutionsResponse.
 //
@@ -14419,10 +15323,24 @@ func (v *CountWorkflowExecutionsResponse) Equals(rhs *CountWorkflowExecutionsRes
 	if !_I64_EqualsPtr(v.Count, rhs.Count) {
 		return false
 	}
+	if !((v.Groups == nil && rhs.Groups == nil) || (v.Groups != nil && rhs.Groups != nil && _List_CountWorkflowExecutionsGroup_Equals(v.Groups, rhs.Groups))) {
+		return false
+	}
 
 	return true
 }
 
+type _List_CountWorkflowExecutionsGroup_Zapper []*CountWorkflowExecutionsGroup
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_CountWorkflowExecutionsGroup_Zapper.
+func (l _List_CountWorkflowExecutionsGroup_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of CountWorkflowExecutionsResponse.
 func (v *CountWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -14432,6 +15350,9 @@ func (v *CountWorkflowExecutionsResponse) MarshalLogObject(enc zapcore.ObjectEnc
 	if v.Count != nil {
 		enc.AddInt64("count", *v.Count)
 	}
+	if v.Groups != nil {
+		err = multierr.Append(err, enc.AddArray("groups", (_List_CountWorkflowExecutionsGroup_Zapper)(v.Groups)))
+	}
 	return err
 }
 
@@ -14450,6 +15371,21 @@ func (v *CountWorkflowExecutionsResponse) IsSetCount() bool {
 	return v != nil && v.Count != nil
 }
 
+// GetGroups returns the value of Groups if it is set or its
+// zero value if it is unset.
+func (v *CountWorkflowExecutionsResponse) GetGroups() (o []*CountWorkflowExecutionsGroup) {
+	if v != nil && v.Groups != nil {
+		return v.Groups
+	}
+
+	return
+}
+
+// IsSetGroups returns true if Groups is not nil.
+func (v *CountWorkflowExecutionsResponse) IsSetGroups() bool {
+	return v != nil && v.Groups != nil
+}
+
 type CrossClusterApplyParentClosePolicyRequestAttributes struct {
 	Children []*ApplyParentClosePolicyRequest `json:"children,omitempty"`
 }
@@ -22646,6 +23582,7 @@ const (
 	DecisionTaskFailedCauseBadBinary                                           DecisionTaskFailedCause = 20
 	DecisionTaskFailedCauseScheduleActivityDuplicateID                         DecisionTaskFailedCause = 21
 	DecisionTaskFailedCauseBadSearchAttributes                                 DecisionTaskFailedCause = 22
+	DecisionTaskFailedCauseBadTaskListPayloadSize                              DecisionTaskFailedCause = 23
 )
 
 // DecisionTaskFailedCause_Values returns all recognized values of DecisionTaskFailedCause.
@@ -22674,6 +23611,7 @@ func DecisionTaskFailedCause_Values() []DecisionTaskFailedCause {
 		DecisionTaskFailedCauseBadBinary,
 		DecisionTaskFailedCauseScheduleActivityDuplicateID,
 		DecisionTaskFailedCauseBadSearchAttributes,
+		DecisionTaskFailedCauseBadTaskListPayloadSize,
 	}
 }
 
@@ -22753,6 +23691,9 @@ func (v *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*v = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "BAD_TASK_LIST_PAYLOAD_SIZE":
+		*v = DecisionTaskFailedCauseBadTaskListPayloadSize
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -22817,6 +23758,8 @@ func (v DecisionTaskFailedCause) MarshalText() ([]byte, error) {
 		return []byte("SCHEDULE_ACTIVITY_DUPLICATE_ID"), nil
 	case 22:
 		return []byte("BAD_SEARCH_ATTRIBUTES"), nil
+	case 23:
+		return []byte("BAD_TASK_LIST_PAYLOAD_SIZE"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -22874,6 +23817,8 @@ func (v DecisionTaskFailedCause) MarshalLogObject(enc zapcore.ObjectEncoder) err
 		enc.AddString("name", "SCHEDULE_ACTIVITY_DUPLICATE_ID")
 	case 22:
 		enc.AddString("name", "BAD_SEARCH_ATTRIBUTES")
+	case 23:
+		enc.AddString("name", "BAD_TASK_LIST_PAYLOAD_SIZE")
 	}
 	return nil
 }
@@ -22988,6 +23933,8 @@ func (v DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_ID"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "BAD_TASK_LIST_PAYLOAD_SIZE"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -23052,6 +23999,8 @@ func (v DecisionTaskFailedCause) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"SCHEDULE_ACTIVITY_DUPLICATE_ID\""), nil
 	case 22:
 		return ([]byte)("\"BAD_SEARCH_ATTRIBUTES\""), nil
+	case 23:
+		return ([]byte)("\"BAD_TASK_LIST_PAYLOAD_SIZE\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -25792,6 +26741,7 @@ func (v *DecisionType) UnmarshalJSON(text []byte) error {
 type DeprecateDomainRequest struct {
 	Name          *string `json:"name,omitempty"`
 	SecurityToken *string `json:"securityToken,omitempty"`
+	UUID          *string `json:"uuid,omitempty"`
 }
 
 // ToWire translates a DeprecateDomainRequest struct into a Thrift-level intermediate
@@ -25811,7 +26761,7 @@ type DeprecateDomainRequest struct {
 //   }
 func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -25833,6 +26783,14 @@ func (v *DeprecateDomainRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.UUID != nil {
+		w, err = wire.NewValueString(*(v.UUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -25878,6 +26836,16 @@ func (v *DeprecateDomainRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UUID = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -25918,6 +26886,18 @@ func (v *DeprecateDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.UUID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.UUID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -25955,6 +26935,14 @@ func (v *DeprecateDomainRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.UUID = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -25984,7 +26972,7 @@ func (v *DeprecateDomainRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -25994,6 +26982,10 @@ func (v *DeprecateDomainRequest) String() string {
 		fields[i] = fmt.Sprintf("SecurityToken: %v", *(v.SecurityToken))
 		i++
 	}
+	if v.UUID != nil {
+		fields[i] = fmt.Sprintf("UUID: %v", *(v.UUID))
+		i++
+	}
 
 	return fmt.Sprintf("DeprecateDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -26014,6 +27006,9 @@ func (v *DeprecateDomainRequest) Equals(rhs *DeprecateDomainRequest) bool {
 	if !_String_EqualsPtr(v.SecurityToken, rhs.SecurityToken) {
 		return false
 	}
+	if !_String_EqualsPtr(v.UUID, rhs.UUID) {
+		return false
+	}
 
 	return true
 }
@@ -26030,6 +27025,9 @@ func (v *DeprecateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (er
 	if v.SecurityToken != nil {
 		enc.AddString("securityToken", *v.SecurityToken)
 	}
+	if v.UUID != nil {
+		enc.AddString("uuid", *v.UUID)
+	}
 	return err
 }
 
@@ -26063,6 +27061,21 @@ func (v *DeprecateDomainRequest) IsSetSecurityToken() bool {
 	return v != nil && v.SecurityToken != nil
 }
 
+// GetUUID returns the value of UUID if it is set or its
+// zero value if it is unset.
+func (v *DeprecateDomainRequest) GetUUID() (o string) {
+	if v != nil && v.UUID != nil {
+		return *v.UUID
+	}
+
+	return
+}
+
+// IsSetUUID returns true if UUID is not nil.
+func (v *DeprecateDomainRequest) IsSetUUID() bool {
+	return v != nil && v.UUID != nil
+}
+
 type DescribeDomainRequest struct {
 	Name *string `json:"name,omitempty"`
 	UUID *string `json:"uuid,omitempty"`
@@ -27234,11 +28247,12 @@ func (v *DescribeHistoryHostRequest) IsSetExecutionForHost() bool {
 }
 
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        *int32           `json:"numberOfShards,omitempty"`
-	ShardIDs              []int32          `json:"shardIDs,omitempty"`
-	DomainCache           *DomainCacheInfo `json:"domainCache,omitempty"`
-	ShardControllerStatus *string          `json:"shardControllerStatus,omitempty"`
-	Address               *string          `json:"address,omitempty"`
+	NumberOfShards        *int32                    `json:"numberOfShards,omitempty"`
+	ShardIDs              []int32                   `json:"shardIDs,omitempty"`
+	DomainCache           *DomainCacheInfo          `json:"domainCache,omitempty"`
+	ShardControllerStatus *string                   `json:"shardControllerStatus,omitempty"`
+	Address               *string                   `json:"address,omitempty"`
+	PayloadSizeStats      []*DomainPayloadSizeStats `json:"payloadSizeStats,omitempty"`
 }
 
 type _List_I32_ValueList []int32
@@ -27267,6 +28281,35 @@ func (_List_I32_ValueList) ValueType() wire.Type {
 
 func (_List_I32_ValueList) Close() {}
 
+type _List_DomainPayloadSizeStats_ValueList []*DomainPayloadSizeStats
+
+func (v _List_DomainPayloadSizeStats_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*DomainPayloadSizeStats', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DomainPayloadSizeStats_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DomainPayloadSizeStats_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DomainPayloadSizeStats_ValueList) Close() {}
+
 // ToWire translates a DescribeHistoryHostResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -27284,7 +28327,7 @@ func (_List_I32_ValueList) Close() {}
 //   }
 func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -27330,6 +28373,14 @@ func (v *DescribeHistoryHostResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.PayloadSizeStats != nil {
+		w, err = wire.NewValueList(_List_DomainPayloadSizeStats_ValueList(v.PayloadSizeStats)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -27358,6 +28409,30 @@ func _DomainCacheInfo_Read(w wire.Value) (*DomainCacheInfo, error) {
 	return &v, err
 }
 
+func _DomainPayloadSizeStats_Read(w wire.Value) (*DomainPayloadSizeStats, error) {
+	var v DomainPayloadSizeStats
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DomainPayloadSizeStats_Read(l wire.ValueList) ([]*DomainPayloadSizeStats, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*DomainPayloadSizeStats, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DomainPayloadSizeStats_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a DescribeHistoryHostResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -27425,6 +28500,14 @@ func (v *DescribeHistoryHostResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TList {
+				v.PayloadSizeStats, err = _List_DomainPayloadSizeStats_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -27450,6 +28533,27 @@ func _List_I32_Encode(val []int32, sw stream.Writer) error {
 	return sw.WriteListEnd()
 }
 
+func _List_DomainPayloadSizeStats_Encode(val []*DomainPayloadSizeStats, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*DomainPayloadSizeStats', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
 // Encode serializes a DescribeHistoryHostResponse struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -27519,6 +28623,18 @@ func (v *DescribeHistoryHostResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.PayloadSizeStats != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_DomainPayloadSizeStats_Encode(v.PayloadSizeStats, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -27558,6 +28674,42 @@ func _DomainCacheInfo_Decode(sr stream.Reader) (*DomainCacheInfo, error) {
 	return &v, err
 }
 
+func _DomainPayloadSizeStats_Decode(sr stream.Reader) (*DomainPayloadSizeStats, error) {
+	var v DomainPayloadSizeStats
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_DomainPayloadSizeStats_Decode(sr stream.Reader) ([]*DomainPayloadSizeStats, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*DomainPayloadSizeStats, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _DomainPayloadSizeStats_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a DescribeHistoryHostResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -27612,6 +28764,12 @@ func (v *DescribeHistoryHostResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 60 && fh.Type == wire.TList:
+			v.PayloadSizeStats, err = _List_DomainPayloadSizeStats_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -27641,7 +28799,7 @@ func (v *DescribeHistoryHostResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.NumberOfShards != nil {
 		fields[i] = fmt.Sprintf("NumberOfShards: %v", *(v.NumberOfShards))
@@ -27663,6 +28821,10 @@ func (v *DescribeHistoryHostResponse) String() string {
 		fields[i] = fmt.Sprintf("Address: %v", *(v.Address))
 		i++
 	}
+	if v.PayloadSizeStats != nil {
+		fields[i] = fmt.Sprintf("PayloadSizeStats: %v", v.PayloadSizeStats)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeHistoryHostResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -27682,6 +28844,21 @@ func _List_I32_Equals(lhs, rhs []int32) bool {
 	return true
 }
 
+func _List_DomainPayloadSizeStats_Equals(lhs, rhs []*DomainPayloadSizeStats) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this DescribeHistoryHostResponse match the
 // provided DescribeHistoryHostResponse.
 //
@@ -27707,6 +28884,9 @@ func (v *DescribeHistoryHostResponse) Equals(rhs *DescribeHistoryHostResponse) b
 	if !_String_EqualsPtr(v.Address, rhs.Address) {
 		return false
 	}
+	if !((v.PayloadSizeStats == nil && rhs.PayloadSizeStats == nil) || (v.PayloadSizeStats != nil && rhs.PayloadSizeStats != nil && _List_DomainPayloadSizeStats_Equals(v.PayloadSizeStats, rhs.PayloadSizeStats))) {
+		return false
+	}
 
 	return true
 }
@@ -27722,6 +28902,17 @@ func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error)
 	return err
 }
 
+type _List_DomainPayloadSizeStats_Zapper []*DomainPayloadSizeStats
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DomainPayloadSizeStats_Zapper.
+func (l _List_DomainPayloadSizeStats_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DescribeHistoryHostResponse.
 func (v *DescribeHistoryHostResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -27743,6 +28934,9 @@ func (v *DescribeHistoryHostResponse) MarshalLogObject(enc zapcore.ObjectEncoder
 	if v.Address != nil {
 		enc.AddString("address", *v.Address)
 	}
+	if v.PayloadSizeStats != nil {
+		err = multierr.Append(err, enc.AddArray("payloadSizeStats", (_List_DomainPayloadSizeStats_Zapper)(v.PayloadSizeStats)))
+	}
 	return err
 }
 
@@ -27821,6 +29015,21 @@ func (v *DescribeHistoryHostResponse) IsSetAddress() bool {
 	return v != nil && v.Address != nil
 }
 
+// GetPayloadSizeStats returns the value of PayloadSizeStats if it is set or its
+// zero value if it is unset.
+func (v *DescribeHistoryHostResponse) GetPayloadSizeStats() (o []*DomainPayloadSizeStats) {
+	if v != nil && v.PayloadSizeStats != nil {
+		return v.PayloadSizeStats
+	}
+
+	return
+}
+
+// IsSetPayloadSizeStats returns true if PayloadSizeStats is not nil.
+func (v *DescribeHistoryHostResponse) IsSetPayloadSizeStats() bool {
+	return v != nil && v.PayloadSizeStats != nil
+}
+
 type DescribeQueueRequest struct {
 	ShardID     *int32  `json:"shardID,omitempty"`
 	ClusterName *string `json:"clusterName,omitempty"`
@@ -30304,6 +31513,7 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
 	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren,omitempty"`
 	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+	HistoryLimitWarning    *HistoryLimitWarning            `json:"historyLimitWarning,omitempty"`
 }
 
 type _List_PendingActivityInfo_ValueList []*PendingActivityInfo
@@ -30381,7 +31591,7 @@ func (_List_PendingChildExecutionInfo_ValueList) Close() {}
 //   }
 func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -30427,6 +31637,14 @@ func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
+	if v.HistoryLimitWarning != nil {
+		w, err = v.HistoryLimitWarning.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -30497,6 +31715,12 @@ func _PendingDecisionInfo_Read(w wire.Value) (*PendingDecisionInfo, error) {
 	return &v, err
 }
 
+func _HistoryLimitWarning_Read(w wire.Value) (*HistoryLimitWarning, error) {
+	var v HistoryLimitWarning
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -30558,6 +31782,14 @@ func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 60:
+			if field.Value.Type() == wire.TStruct {
+				v.HistoryLimitWarning, err = _HistoryLimitWarning_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -30676,6 +31908,18 @@ func (v *DescribeWorkflowExecutionResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.HistoryLimitWarning != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.HistoryLimitWarning.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -30769,6 +32013,12 @@ func _PendingDecisionInfo_Decode(sr stream.Reader) (*PendingDecisionInfo, error)
 	return &v, err
 }
 
+func _HistoryLimitWarning_Decode(sr stream.Reader) (*HistoryLimitWarning, error) {
+	var v HistoryLimitWarning
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a DescribeWorkflowExecutionResponse struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -30817,6 +32067,12 @@ func (v *DescribeWorkflowExecutionResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 60 && fh.Type == wire.TStruct:
+			v.HistoryLimitWarning, err = _HistoryLimitWarning_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -30846,7 +32102,7 @@ func (v *DescribeWorkflowExecutionResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.ExecutionConfiguration != nil {
 		fields[i] = fmt.Sprintf("ExecutionConfiguration: %v", v.ExecutionConfiguration)
@@ -30868,6 +32124,10 @@ func (v *DescribeWorkflowExecutionResponse) String() string {
 		fields[i] = fmt.Sprintf("PendingDecision: %v", v.PendingDecision)
 		i++
 	}
+	if v.HistoryLimitWarning != nil {
+		fields[i] = fmt.Sprintf("HistoryLimitWarning: %v", v.HistoryLimitWarning)
+		i++
+	}
 
 	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -30927,6 +32187,9 @@ func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutio
 	if !((v.PendingDecision == nil && rhs.PendingDecision == nil) || (v.PendingDecision != nil && rhs.PendingDecision != nil && v.PendingDecision.Equals(rhs.PendingDecision))) {
 		return false
 	}
+	if !((v.HistoryLimitWarning == nil && rhs.HistoryLimitWarning == nil) || (v.HistoryLimitWarning != nil && rhs.HistoryLimitWarning != nil && v.HistoryLimitWarning.Equals(rhs.HistoryLimitWarning))) {
+		return false
+	}
 
 	return true
 }
@@ -30974,6 +32237,9 @@ func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectE
 	if v.PendingDecision != nil {
 		err = multierr.Append(err, enc.AddObject("pendingDecision", v.PendingDecision))
 	}
+	if v.HistoryLimitWarning != nil {
+		err = multierr.Append(err, enc.AddObject("historyLimitWarning", v.HistoryLimitWarning))
+	}
 	return err
 }
 
@@ -31052,6 +32318,21 @@ func (v *DescribeWorkflowExecutionResponse) IsSetPendingDecision() bool {
 	return v != nil && v.PendingDecision != nil
 }
 
+// GetHistoryLimitWarning returns the value of HistoryLimitWarning if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetHistoryLimitWarning() (o *HistoryLimitWarning) {
+	if v != nil && v.HistoryLimitWarning != nil {
+		return v.HistoryLimitWarning
+	}
+
+	return
+}
+
+// IsSetHistoryLimitWarning returns true if HistoryLimitWarning is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetHistoryLimitWarning() bool {
+	return v != nil && v.HistoryLimitWarning != nil
+}
+
 type DomainAlreadyExistsError struct {
 	Message string `json:"message,required"`
 }
@@ -31541,13 +32822,14 @@ func (v *DomainCacheInfo) IsSetNumOfItemsInCacheByName() bool {
 }
 
 type DomainConfiguration struct {
-	WorkflowExecutionRetentionPeriodInDays *int32          `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             *bool           `json:"emitMetric,omitempty"`
-	BadBinaries                            *BadBinaries    `json:"badBinaries,omitempty"`
-	HistoryArchivalStatus                  *ArchivalStatus `json:"historyArchivalStatus,omitempty"`
-	HistoryArchivalURI                     *string         `json:"historyArchivalURI,omitempty"`
-	VisibilityArchivalStatus               *ArchivalStatus `json:"visibilityArchivalStatus,omitempty"`
-	VisibilityArchivalURI                  *string         `json:"visibilityArchivalURI,omitempty"`
+	WorkflowExecutionRetentionPeriodInDays *int32                      `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             *bool                       `json:"emitMetric,omitempty"`
+	BadBinaries                            *BadBinaries                `json:"badBinaries,omitempty"`
+	HistoryArchivalStatus                  *ArchivalStatus             `json:"historyArchivalStatus,omitempty"`
+	HistoryArchivalURI                     *string                     `json:"historyArchivalURI,omitempty"`
+	VisibilityArchivalStatus               *ArchivalStatus             `json:"visibilityArchivalStatus,omitempty"`
+	VisibilityArchivalURI                  *string                     `json:"visibilityArchivalURI,omitempty"`
+	AsyncWorkflowConfiguration             *AsyncWorkflowConfiguration `json:"asyncWorkflowConfiguration,omitempty"`
 }
 
 // ToWire translates a DomainConfiguration struct into a Thrift-level intermediate
@@ -31567,7 +32849,7 @@ type DomainConfiguration struct {
 //   }
 func (v *DomainConfiguration) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -31629,6 +32911,14 @@ func (v *DomainConfiguration) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 110, Value: w}
 		i++
 	}
+	if v.AsyncWorkflowConfiguration != nil {
+		w, err = v.AsyncWorkflowConfiguration.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 120, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -31645,6 +32935,12 @@ func _ArchivalStatus_Read(w wire.Value) (ArchivalStatus, error) {
 	return v, err
 }
 
+func _AsyncWorkflowConfiguration_Read(w wire.Value) (*AsyncWorkflowConfiguration, error) {
+	var v AsyncWorkflowConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a DomainConfiguration struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -31734,6 +33030,14 @@ func (v *DomainConfiguration) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 120:
+			if field.Value.Type() == wire.TStruct {
+				v.AsyncWorkflowConfiguration, err = _AsyncWorkflowConfiguration_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -31834,6 +33138,18 @@ func (v *DomainConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.AsyncWorkflowConfiguration != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 120, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.AsyncWorkflowConfiguration.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -31849,6 +33165,12 @@ func _ArchivalStatus_Decode(sr stream.Reader) (ArchivalStatus, error) {
 	return v, err
 }
 
+func _AsyncWorkflowConfiguration_Decode(sr stream.Reader) (*AsyncWorkflowConfiguration, error) {
+	var v AsyncWorkflowConfiguration
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a DomainConfiguration struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -31921,6 +33243,12 @@ func (v *DomainConfiguration) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 120 && fh.Type == wire.TStruct:
+			v.AsyncWorkflowConfiguration, err = _AsyncWorkflowConfiguration_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -31950,7 +33278,7 @@ func (v *DomainConfiguration) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.WorkflowExecutionRetentionPeriodInDays != nil {
 		fields[i] = fmt.Sprintf("WorkflowExecutionRetentionPeriodInDays: %v", *(v.WorkflowExecutionRetentionPeriodInDays))
@@ -31980,6 +33308,10 @@ func (v *DomainConfiguration) String() string {
 		fields[i] = fmt.Sprintf("VisibilityArchivalURI: %v", *(v.VisibilityArchivalURI))
 		i++
 	}
+	if v.AsyncWorkflowConfiguration != nil {
+		fields[i] = fmt.Sprintf("AsyncWorkflowConfiguration: %v", v.AsyncWorkflowConfiguration)
+		i++
+	}
 
 	return fmt.Sprintf("DomainConfiguration{%v}", strings.Join(fields[:i], ", "))
 }
@@ -32025,6 +33357,9 @@ func (v *DomainConfiguration) Equals(rhs *DomainConfiguration) bool {
 	if !_String_EqualsPtr(v.VisibilityArchivalURI, rhs.VisibilityArchivalURI) {
 		return false
 	}
+	if !((v.AsyncWorkflowConfiguration == nil && rhs.AsyncWorkflowConfiguration == nil) || (v.AsyncWorkflowConfiguration != nil && rhs.AsyncWorkflowConfiguration != nil && v.AsyncWorkflowConfiguration.Equals(rhs.AsyncWorkflowConfiguration))) {
+		return false
+	}
 
 	return true
 }
@@ -32056,6 +33391,9 @@ func (v *DomainConfiguration) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.VisibilityArchivalURI != nil {
 		enc.AddString("visibilityArchivalURI", *v.VisibilityArchivalURI)
 	}
+	if v.AsyncWorkflowConfiguration != nil {
+		err = multierr.Append(err, enc.AddObject("asyncWorkflowConfiguration", v.AsyncWorkflowConfiguration))
+	}
 	return err
 }
 
@@ -32164,6 +33502,21 @@ func (v *DomainConfiguration) IsSetVisibilityArchivalURI() bool {
 	return v != nil && v.VisibilityArchivalURI != nil
 }
 
+// GetAsyncWorkflowConfiguration returns the value of AsyncWorkflowConfiguration if it is set or its
+// zero value if it is unset.
+func (v *DomainConfiguration) GetAsyncWorkflowConfiguration() (o *AsyncWorkflowConfiguration) {
+	if v != nil && v.AsyncWorkflowConfiguration != nil {
+		return v.AsyncWorkflowConfiguration
+	}
+
+	return
+}
+
+// IsSetAsyncWorkflowConfiguration returns true if AsyncWorkflowConfiguration is not nil.
+func (v *DomainConfiguration) IsSetAsyncWorkflowConfiguration() bool {
+	return v != nil && v.AsyncWorkflowConfiguration != nil
+}
+
 type DomainInfo struct {
 	Name        *string           `json:"name,omitempty"`
 	Status      *DomainStatus     `json:"status,omitempty"`
@@ -32171,6 +33524,7 @@ type DomainInfo struct {
 	OwnerEmail  *string           `json:"ownerEmail,omitempty"`
 	Data        map[string]string `json:"data,omitempty"`
 	UUID        *string           `json:"uuid,omitempty"`
+	Metadata    map[string][]byte `json:"metadata,omitempty"`
 }
 
 type _Map_String_String_MapItemList map[string]string
@@ -32208,6 +33562,44 @@ func (_Map_String_String_MapItemList) ValueType() wire.Type {
 
 func (_Map_String_String_MapItemList) Close() {}
 
+type _Map_String_Binary_MapItemList map[string][]byte
+
+func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueBinary(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Binary_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) Close() {}
+
 // ToWire translates a DomainInfo struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -32225,7 +33617,7 @@ func (_Map_String_String_MapItemList) Close() {}
 //   }
 func (v *DomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -32279,6 +33671,14 @@ func (v *DomainInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 60, Value: w}
 		i++
 	}
+	if v.Metadata != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Metadata)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 70, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -32317,6 +33717,34 @@ func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
 	return o, err
 }
 
+func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetBinary(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
 // FromWire deserializes a DomainInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -32396,6 +33824,14 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 70:
+			if field.Value.Type() == wire.TMap {
+				v.Metadata, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -32426,6 +33862,32 @@ func _Map_String_String_Encode(val map[string]string, sw stream.Writer) error {
 	return sw.WriteMapEnd()
 }
 
+func _Map_String_Binary_Encode(val map[string][]byte, sw stream.Writer) error {
+
+	mh := stream.MapHeader{
+		KeyType:   wire.TBinary,
+		ValueType: wire.TBinary,
+		Length:    len(val),
+	}
+	if err := sw.WriteMapBegin(mh); err != nil {
+		return err
+	}
+
+	for k, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
+		}
+		if err := sw.WriteString(k); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteMapEnd()
+}
+
 // Encode serializes a DomainInfo struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -32507,6 +33969,18 @@ func (v *DomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Metadata != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 70, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Binary_Encode(v.Metadata, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -32556,6 +34030,46 @@ func _Map_String_String_Decode(sr stream.Reader) (map[string]string, error) {
 	return o, err
 }
 
+func _Map_String_Binary_Decode(sr stream.Reader) (map[string][]byte, error) {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		for i := 0; i < mh.Length; i++ {
+			if err := sr.Skip(mh.KeyType); err != nil {
+				return nil, err
+			}
+
+			if err := sr.Skip(mh.ValueType); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadMapEnd()
+	}
+
+	o := make(map[string][]byte, mh.Length)
+	for i := 0; i < mh.Length; i++ {
+		k, err := sr.ReadString()
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := sr.ReadBinary()
+		if err != nil {
+			return nil, err
+		}
+
+		o[k] = v
+	}
+
+	if err = sr.ReadMapEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a DomainInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -32620,6 +34134,12 @@ func (v *DomainInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 70 && fh.Type == wire.TMap:
+			v.Metadata, err = _Map_String_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -32649,7 +34169,7 @@ func (v *DomainInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [7]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -32675,6 +34195,10 @@ func (v *DomainInfo) String() string {
 		fields[i] = fmt.Sprintf("UUID: %v", *(v.UUID))
 		i++
 	}
+	if v.Metadata != nil {
+		fields[i] = fmt.Sprintf("Metadata: %v", v.Metadata)
+		i++
+	}
 
 	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -32706,6 +34230,23 @@ func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
 	return true
 }
 
+func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
 // Equals returns true if all the fields of this DomainInfo match the
 // provided DomainInfo.
 //
@@ -32734,6 +34275,9 @@ func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
 	if !_String_EqualsPtr(v.UUID, rhs.UUID) {
 		return false
 	}
+	if !((v.Metadata == nil && rhs.Metadata == nil) || (v.Metadata != nil && rhs.Metadata != nil && _Map_String_Binary_Equals(v.Metadata, rhs.Metadata))) {
+		return false
+	}
 
 	return true
 }
@@ -32749,6 +34293,17 @@ func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (
 	return err
 }
 
+type _Map_String_Binary_Zapper map[string][]byte
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Binary_Zapper.
+func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of DomainInfo.
 func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -32773,6 +34328,9 @@ func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.UUID != nil {
 		enc.AddString("uuid", *v.UUID)
 	}
+	if v.Metadata != nil {
+		err = multierr.Append(err, enc.AddObject("metadata", (_Map_String_Binary_Zapper)(v.Metadata)))
+	}
 	return err
 }
 
@@ -32866,6 +34424,21 @@ func (v *DomainInfo) IsSetUUID() bool {
 	return v != nil && v.UUID != nil
 }
 
+// GetMetadata returns the value of Metadata if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetMetadata() (o map[string][]byte) {
+	if v != nil && v.Metadata != nil {
+		return v.Metadata
+	}
+
+	return
+}
+
+// IsSetMetadata returns true if Metadata is not nil.
+func (v *DomainInfo) IsSetMetadata() bool {
+	return v != nil && v.Metadata != nil
+}
+
 type DomainNotActiveError struct {
 	Message        string `json:"message,required"`
 	DomainName     string `json:"domainName,required"`
@@ -33251,41 +34824,16 @@ func (v *DomainNotActiveError) Error() string {
 	return v.String()
 }
 
-type DomainReplicationConfiguration struct {
-	ActiveClusterName *string                            `json:"activeClusterName,omitempty"`
-	Clusters          []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+type DomainPayloadSizeStats struct {
+	DomainName     *string `json:"domainName,omitempty"`
+	PayloadType    *string `json:"payloadType,omitempty"`
+	Count          *int64  `json:"count,omitempty"`
+	AverageSize    *int64  `json:"averageSize,omitempty"`
+	MaxSize        *int64  `json:"maxSize,omitempty"`
+	NearLimitCount *int64  `json:"nearLimitCount,omitempty"`
 }
 
-type _List_ClusterReplicationConfiguration_ValueList []*ClusterReplicationConfiguration
-
-func (v _List_ClusterReplicationConfiguration_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*ClusterReplicationConfiguration', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_ClusterReplicationConfiguration_ValueList) Size() int {
-	return len(v)
-}
-
-func (_List_ClusterReplicationConfiguration_ValueList) ValueType() wire.Type {
-	return wire.TStruct
-}
-
-func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
-
-// ToWire translates a DomainReplicationConfiguration struct into a Thrift-level intermediate
+// ToWire translates a DomainPayloadSizeStats struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -33300,63 +34848,71 @@ func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DomainReplicationConfiguration) ToWire() (wire.Value, error) {
+func (v *DomainPayloadSizeStats) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ActiveClusterName != nil {
-		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
+	if v.DomainName != nil {
+		w, err = wire.NewValueString(*(v.DomainName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Clusters != nil {
-		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
+	if v.PayloadType != nil {
+		w, err = wire.NewValueString(*(v.PayloadType)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _ClusterReplicationConfiguration_Read(w wire.Value) (*ClusterReplicationConfiguration, error) {
-	var v ClusterReplicationConfiguration
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_ClusterReplicationConfiguration_Read(l wire.ValueList) ([]*ClusterReplicationConfiguration, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
+	if v.Count != nil {
+		w, err = wire.NewValueI64(*(v.Count)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
 	}
-
-	o := make([]*ClusterReplicationConfiguration, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ClusterReplicationConfiguration_Read(x)
+	if v.AverageSize != nil {
+		w, err = wire.NewValueI64(*(v.AverageSize)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MaxSize != nil {
+		w, err = wire.NewValueI64(*(v.MaxSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NearLimitCount != nil {
+		w, err = wire.NewValueI64(*(v.NearLimitCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a DomainReplicationConfiguration struct from its Thrift-level
+// FromWire deserializes a DomainPayloadSizeStats struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DomainReplicationConfiguration struct
+// An error is returned if we were unable to build a DomainPayloadSizeStats struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -33364,12 +34920,12 @@ func _List_ClusterReplicationConfiguration_Read(l wire.ValueList) ([]*ClusterRep
 //     return nil, err
 //   }
 //
-//   var v DomainReplicationConfiguration
+//   var v DomainPayloadSizeStats
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
+func (v *DomainPayloadSizeStats) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -33378,61 +34934,82 @@ func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ActiveClusterName = &x
+				v.DomainName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TList {
-				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.PayloadType = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Count = &x
+				if err != nil {
+					return err
+				}
 
-	return nil
-}
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AverageSize = &x
+				if err != nil {
+					return err
+				}
 
-func _List_ClusterReplicationConfiguration_Encode(val []*ClusterReplicationConfiguration, sw stream.Writer) error {
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.MaxSize = &x
+				if err != nil {
+					return err
+				}
 
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NearLimitCount = &x
+				if err != nil {
+					return err
+				}
 
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*ClusterReplicationConfiguration', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
+			}
 		}
 	}
-	return sw.WriteListEnd()
+
+	return nil
 }
 
-// Encode serializes a DomainReplicationConfiguration struct directly into bytes, without going
+// Encode serializes a DomainPayloadSizeStats struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a DomainReplicationConfiguration struct could not be encoded.
-func (v *DomainReplicationConfiguration) Encode(sw stream.Writer) error {
+// An error is returned if a DomainPayloadSizeStats struct could not be encoded.
+func (v *DomainPayloadSizeStats) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ActiveClusterName != nil {
+	if v.DomainName != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ActiveClusterName)); err != nil {
+		if err := sw.WriteString(*(v.DomainName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -33440,11 +35017,11 @@ func (v *DomainReplicationConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Clusters != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+	if v.PayloadType != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := _List_ClusterReplicationConfiguration_Encode(v.Clusters, sw); err != nil {
+		if err := sw.WriteString(*(v.PayloadType)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -33452,51 +35029,63 @@ func (v *DomainReplicationConfiguration) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
-
-func _ClusterReplicationConfiguration_Decode(sr stream.Reader) (*ClusterReplicationConfiguration, error) {
-	var v ClusterReplicationConfiguration
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_ClusterReplicationConfiguration_Decode(sr stream.Reader) ([]*ClusterReplicationConfiguration, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
+	if v.Count != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Count)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
+	if v.AverageSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.AverageSize)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		return nil, sr.ReadListEnd()
 	}
 
-	o := make([]*ClusterReplicationConfiguration, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _ClusterReplicationConfiguration_Decode(sr)
-		if err != nil {
-			return nil, err
+	if v.MaxSize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.MaxSize)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		o = append(o, v)
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
+	if v.NearLimitCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.NearLimitCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
-	return o, err
+
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a DomainReplicationConfiguration struct directly from its Thrift-level
+// Decode deserializes a DomainPayloadSizeStats struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a DomainReplicationConfiguration struct could not be generated from the wire
+// An error is returned if a DomainPayloadSizeStats struct could not be generated from the wire
 // representation.
-func (v *DomainReplicationConfiguration) Decode(sr stream.Reader) error {
+func (v *DomainPayloadSizeStats) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -33512,13 +35101,527 @@ func (v *DomainReplicationConfiguration) Decode(sr stream.Reader) error {
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.ActiveClusterName = &x
+			v.DomainName = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TList:
-			v.Clusters, err = _List_ClusterReplicationConfiguration_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.PayloadType = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Count = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.AverageSize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.MaxSize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.NearLimitCount = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DomainPayloadSizeStats
+// struct.
+func (v *DomainPayloadSizeStats) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.DomainName != nil {
+		fields[i] = fmt.Sprintf("DomainName: %v", *(v.DomainName))
+		i++
+	}
+	if v.PayloadType != nil {
+		fields[i] = fmt.Sprintf("PayloadType: %v", *(v.PayloadType))
+		i++
+	}
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+	if v.AverageSize != nil {
+		fields[i] = fmt.Sprintf("AverageSize: %v", *(v.AverageSize))
+		i++
+	}
+	if v.MaxSize != nil {
+		fields[i] = fmt.Sprintf("MaxSize: %v", *(v.MaxSize))
+		i++
+	}
+	if v.NearLimitCount != nil {
+		fields[i] = fmt.Sprintf("NearLimitCount: %v", *(v.NearLimitCount))
+		i++
+	}
+
+	return fmt.Sprintf("DomainPayloadSizeStats{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DomainPayloadSizeStats match the
+// provided DomainPayloadSizeStats.
+//
+// This function performs a deep comparison.
+func (v *DomainPayloadSizeStats) Equals(rhs *DomainPayloadSizeStats) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.DomainName, rhs.DomainName) {
+		return false
+	}
+	if !_String_EqualsPtr(v.PayloadType, rhs.PayloadType) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.AverageSize, rhs.AverageSize) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.MaxSize, rhs.MaxSize) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NearLimitCount, rhs.NearLimitCount) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DomainPayloadSizeStats.
+func (v *DomainPayloadSizeStats) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.DomainName != nil {
+		enc.AddString("domainName", *v.DomainName)
+	}
+	if v.PayloadType != nil {
+		enc.AddString("payloadType", *v.PayloadType)
+	}
+	if v.Count != nil {
+		enc.AddInt64("count", *v.Count)
+	}
+	if v.AverageSize != nil {
+		enc.AddInt64("averageSize", *v.AverageSize)
+	}
+	if v.MaxSize != nil {
+		enc.AddInt64("maxSize", *v.MaxSize)
+	}
+	if v.NearLimitCount != nil {
+		enc.AddInt64("nearLimitCount", *v.NearLimitCount)
+	}
+	return err
+}
+
+// GetDomainName returns the value of DomainName if it is set or its
+// zero value if it is unset.
+func (v *DomainPayloadSizeStats) GetDomainName() (o string) {
+	if v != nil && v.DomainName != nil {
+		return *v.DomainName
+	}
+
+	return
+}
+
+// IsSetDomainName returns true if DomainName is not nil.
+func (v *DomainPayloadSizeStats) IsSetDomainName() bool {
+	return v != nil && v.DomainName != nil
+}
+
+// GetPayloadType returns the value of PayloadType if it is set or its
+// zero value if it is unset.
+func (v *DomainPayloadSizeStats) GetPayloadType() (o string) {
+	if v != nil && v.PayloadType != nil {
+		return *v.PayloadType
+	}
+
+	return
+}
+
+// IsSetPayloadType returns true if PayloadType is not nil.
+func (v *DomainPayloadSizeStats) IsSetPayloadType() bool {
+	return v != nil && v.PayloadType != nil
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+func (v *DomainPayloadSizeStats) GetCount() (o int64) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+func (v *DomainPayloadSizeStats) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+// GetAverageSize returns the value of AverageSize if it is set or its
+// zero value if it is unset.
+func (v *DomainPayloadSizeStats) GetAverageSize() (o int64) {
+	if v != nil && v.AverageSize != nil {
+		return *v.AverageSize
+	}
+
+	return
+}
+
+// IsSetAverageSize returns true if AverageSize is not nil.
+func (v *DomainPayloadSizeStats) IsSetAverageSize() bool {
+	return v != nil && v.AverageSize != nil
+}
+
+// GetMaxSize returns the value of MaxSize if it is set or its
+// zero value if it is unset.
+func (v *DomainPayloadSizeStats) GetMaxSize() (o int64) {
+	if v != nil && v.MaxSize != nil {
+		return *v.MaxSize
+	}
+
+	return
+}
+
+// IsSetMaxSize returns true if MaxSize is not nil.
+func (v *DomainPayloadSizeStats) IsSetMaxSize() bool {
+	return v != nil && v.MaxSize != nil
+}
+
+// GetNearLimitCount returns the value of NearLimitCount if it is set or its
+// zero value if it is unset.
+func (v *DomainPayloadSizeStats) GetNearLimitCount() (o int64) {
+	if v != nil && v.NearLimitCount != nil {
+		return *v.NearLimitCount
+	}
+
+	return
+}
+
+// IsSetNearLimitCount returns true if NearLimitCount is not nil.
+func (v *DomainPayloadSizeStats) IsSetNearLimitCount() bool {
+	return v != nil && v.NearLimitCount != nil
+}
+
+type DomainReplicationConfiguration struct {
+	ActiveClusterName *string                            `json:"activeClusterName,omitempty"`
+	Clusters          []*ClusterReplicationConfiguration `json:"clusters,omitempty"`
+}
+
+type _List_ClusterReplicationConfiguration_ValueList []*ClusterReplicationConfiguration
+
+func (v _List_ClusterReplicationConfiguration_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ClusterReplicationConfiguration', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ClusterReplicationConfiguration_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ClusterReplicationConfiguration_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ClusterReplicationConfiguration_ValueList) Close() {}
+
+// ToWire translates a DomainReplicationConfiguration struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DomainReplicationConfiguration) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ActiveClusterName != nil {
+		w, err = wire.NewValueString(*(v.ActiveClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Clusters != nil {
+		w, err = wire.NewValueList(_List_ClusterReplicationConfiguration_ValueList(v.Clusters)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ClusterReplicationConfiguration_Read(w wire.Value) (*ClusterReplicationConfiguration, error) {
+	var v ClusterReplicationConfiguration
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ClusterReplicationConfiguration_Read(l wire.ValueList) ([]*ClusterReplicationConfiguration, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ClusterReplicationConfiguration, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ClusterReplicationConfiguration_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a DomainReplicationConfiguration struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DomainReplicationConfiguration struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DomainReplicationConfiguration
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DomainReplicationConfiguration) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActiveClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Clusters, err = _List_ClusterReplicationConfiguration_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_ClusterReplicationConfiguration_Encode(val []*ClusterReplicationConfiguration, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*ClusterReplicationConfiguration', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a DomainReplicationConfiguration struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a DomainReplicationConfiguration struct could not be encoded.
+func (v *DomainReplicationConfiguration) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ActiveClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ActiveClusterName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Clusters != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_ClusterReplicationConfiguration_Encode(v.Clusters, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ClusterReplicationConfiguration_Decode(sr stream.Reader) (*ClusterReplicationConfiguration, error) {
+	var v ClusterReplicationConfiguration
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ClusterReplicationConfiguration_Decode(sr stream.Reader) ([]*ClusterReplicationConfiguration, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*ClusterReplicationConfiguration, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ClusterReplicationConfiguration_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a DomainReplicationConfiguration struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a DomainReplicationConfiguration struct could not be generated from the wire
+// representation.
+func (v *DomainReplicationConfiguration) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ActiveClusterName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TList:
+			v.Clusters, err = _List_ClusterReplicationConfiguration_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -33660,9 +35763,10 @@ func (v *DomainReplicationConfiguration) IsSetClusters() bool {
 type DomainStatus int32
 
 const (
-	DomainStatusRegistered DomainStatus = 0
-	DomainStatusDeprecated DomainStatus = 1
-	DomainStatusDeleted    DomainStatus = 2
+	DomainStatusRegistered   DomainStatus = 0
+	DomainStatusDeprecated   DomainStatus = 1
+	DomainStatusDeleted      DomainStatus = 2
+	DomainStatusProvisioning DomainStatus = 3
 )
 
 // DomainStatus_Values returns all recognized values of DomainStatus.
@@ -33671,6 +35775,7 @@ func DomainStatus_Values() []DomainStatus {
 		DomainStatusRegistered,
 		DomainStatusDeprecated,
 		DomainStatusDeleted,
+		DomainStatusProvisioning,
 	}
 }
 
@@ -33690,6 +35795,9 @@ func (v *DomainStatus) UnmarshalText(value []byte) error {
 	case "DELETED":
 		*v = DomainStatusDeleted
 		return nil
+	case "PROVISIONING":
+		*v = DomainStatusProvisioning
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -33714,6 +35822,8 @@ func (v DomainStatus) MarshalText() ([]byte, error) {
 		return []byte("DEPRECATED"), nil
 	case 2:
 		return []byte("DELETED"), nil
+	case 3:
+		return []byte("PROVISIONING"), nil
 	}
 	return []byte(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -33731,6 +35841,8 @@ func (v DomainStatus) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 		enc.AddString("name", "DEPRECATED")
 	case 2:
 		enc.AddString("name", "DELETED")
+	case 3:
+		enc.AddString("name", "PROVISIONING")
 	}
 	return nil
 }
@@ -33805,6 +35917,8 @@ func (v DomainStatus) String() string {
 		return "DEPRECATED"
 	case 2:
 		return "DELETED"
+	case 3:
+		return "PROVISIONING"
 	}
 	return fmt.Sprintf("DomainStatus(%d)", w)
 }
@@ -33829,6 +35943,8 @@ func (v DomainStatus) MarshalJSON() ([]byte, error) {
 		return ([]byte)("\"DEPRECATED\""), nil
 	case 2:
 		return ([]byte)("\"DELETED\""), nil
+	case 3:
+		return ([]byte)("\"PROVISIONING\""), nil
 	}
 	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
 }
@@ -38037,6 +40153,558 @@ func (v *GetCrossClusterTasksResponse) IsSetFailedCauseByShard() bool {
 	return v != nil && v.FailedCauseByShard != nil
 }
 
+type GetReplicationAckStatesRequest struct {
+	ShardID *int32 `json:"shardID,omitempty"`
+}
+
+// ToWire translates a GetReplicationAckStatesRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationAckStatesRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetReplicationAckStatesRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationAckStatesRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationAckStatesRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationAckStatesRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a GetReplicationAckStatesRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GetReplicationAckStatesRequest struct could not be encoded.
+func (v *GetReplicationAckStatesRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a GetReplicationAckStatesRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GetReplicationAckStatesRequest struct could not be generated from the wire
+// representation.
+func (v *GetReplicationAckStatesRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationAckStatesRequest
+// struct.
+func (v *GetReplicationAckStatesRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationAckStatesRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this GetReplicationAckStatesRequest match the
+// provided GetReplicationAckStatesRequest.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationAckStatesRequest) Equals(rhs *GetReplicationAckStatesRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetReplicationAckStatesRequest.
+func (v *GetReplicationAckStatesRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationAckStatesRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *GetReplicationAckStatesRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+type GetReplicationAckStatesResponse struct {
+	AckStates []*ReplicationAckState `json:"ackStates,omitempty"`
+}
+
+type _List_ReplicationAckState_ValueList []*ReplicationAckState
+
+func (v _List_ReplicationAckState_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ReplicationAckState', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ReplicationAckState_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ReplicationAckState_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ReplicationAckState_ValueList) Close() {}
+
+// ToWire translates a GetReplicationAckStatesResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetReplicationAckStatesResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.AckStates != nil {
+		w, err = wire.NewValueList(_List_ReplicationAckState_ValueList(v.AckStates)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ReplicationAckState_Read(w wire.Value) (*ReplicationAckState, error) {
+	var v ReplicationAckState
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ReplicationAckState_Read(l wire.ValueList) ([]*ReplicationAckState, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ReplicationAckState, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ReplicationAckState_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetReplicationAckStatesResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetReplicationAckStatesResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetReplicationAckStatesResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetReplicationAckStatesResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TList {
+				v.AckStates, err = _List_ReplicationAckState_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_ReplicationAckState_Encode(val []*ReplicationAckState, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*ReplicationAckState', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a GetReplicationAckStatesResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a GetReplicationAckStatesResponse struct could not be encoded.
+func (v *GetReplicationAckStatesResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.AckStates != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_ReplicationAckState_Encode(v.AckStates, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _ReplicationAckState_Decode(sr stream.Reader) (*ReplicationAckState, error) {
+	var v ReplicationAckState
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ReplicationAckState_Decode(sr stream.Reader) ([]*ReplicationAckState, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*ReplicationAckState, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ReplicationAckState_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a GetReplicationAckStatesResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a GetReplicationAckStatesResponse struct could not be generated from the wire
+// representation.
+func (v *GetReplicationAckStatesResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.AckStates, err = _List_ReplicationAckState_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetReplicationAckStatesResponse
+// struct.
+func (v *GetReplicationAckStatesResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.AckStates != nil {
+		fields[i] = fmt.Sprintf("AckStates: %v", v.AckStates)
+		i++
+	}
+
+	return fmt.Sprintf("GetReplicationAckStatesResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ReplicationAckState_Equals(lhs, rhs []*ReplicationAckState) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this GetReplicationAckStatesResponse match the
+// provided GetReplicationAckStatesResponse.
+//
+// This function performs a deep comparison.
+func (v *GetReplicationAckStatesResponse) Equals(rhs *GetReplicationAckStatesResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.AckStates == nil && rhs.AckStates == nil) || (v.AckStates != nil && rhs.AckStates != nil && _List_ReplicationAckState_Equals(v.AckStates, rhs.AckStates))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_ReplicationAckState_Zapper []*ReplicationAckState
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ReplicationAckState_Zapper.
+func (l _List_ReplicationAckState_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetReplicationAckStatesResponse.
+func (v *GetReplicationAckStatesResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.AckStates != nil {
+		err = multierr.Append(err, enc.AddArray("ackStates", (_List_ReplicationAckState_Zapper)(v.AckStates)))
+	}
+	return err
+}
+
+// GetAckStates returns the value of AckStates if it is set or its
+// zero value if it is unset.
+func (v *GetReplicationAckStatesResponse) GetAckStates() (o []*ReplicationAckState) {
+	if v != nil && v.AckStates != nil {
+		return v.AckStates
+	}
+
+	return
+}
+
+// IsSetAckStates returns true if AckStates is not nil.
+func (v *GetReplicationAckStatesResponse) IsSetAckStates() bool {
+	return v != nil && v.AckStates != nil
+}
+
 type GetSearchAttributesResponse struct {
 	Keys map[string]IndexedValueType `json:"keys,omitempty"`
 }
@@ -39290,8 +41958,35 @@ type GetWorkflowExecutionHistoryRequest struct {
 	WaitForNewEvent        *bool                   `json:"waitForNewEvent,omitempty"`
 	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
 	SkipArchival           *bool                   `json:"skipArchival,omitempty"`
+	EventTypes             []EventType             `json:"eventTypes,omitempty"`
 }
 
+type _List_EventType_ValueList []EventType
+
+func (v _List_EventType_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_EventType_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_EventType_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_EventType_ValueList) Close() {}
+
 // ToWire translates a GetWorkflowExecutionHistoryRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -39309,7 +42004,7 @@ type GetWorkflowExecutionHistoryRequest struct {
 //   }
 func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -39371,6 +42066,14 @@ func (v *GetWorkflowExecutionHistoryRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.EventTypes != nil {
+		w, err = wire.NewValueList(_List_EventType_ValueList(v.EventTypes)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -39381,6 +42084,30 @@ func _HistoryEventFilterType_Read(w wire.Value) (HistoryEventFilterType, error)
 	return v, err
 }
 
+func _EventType_Read(w wire.Value) (EventType, error) {
+	var v EventType
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _List_EventType_Read(l wire.ValueList) ([]EventType, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]EventType, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _EventType_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a GetWorkflowExecutionHistoryRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -39468,6 +42195,14 @@ func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TList {
+				v.EventTypes, err = _List_EventType_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -39475,6 +42210,24 @@ func (v *GetWorkflowExecutionHistoryRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
+func _List_EventType_Encode(val []EventType, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TI32,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for _, v := range val {
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
 // Encode serializes a GetWorkflowExecutionHistoryRequest struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -39568,6 +42321,18 @@ func (v *GetWorkflowExecutionHistoryRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.EventTypes != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_EventType_Encode(v.EventTypes, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -39577,6 +42342,42 @@ func _HistoryEventFilterType_Decode(sr stream.Reader) (HistoryEventFilterType, e
 	return v, err
 }
 
+func _EventType_Decode(sr stream.Reader) (EventType, error) {
+	var v EventType
+	err := v.Decode(sr)
+	return v, err
+}
+
+func _List_EventType_Decode(sr stream.Reader) ([]EventType, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TI32 {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]EventType, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _EventType_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
 // Decode deserializes a GetWorkflowExecutionHistoryRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -39647,6 +42448,12 @@ func (v *GetWorkflowExecutionHistoryRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 80 && fh.Type == wire.TList:
+			v.EventTypes, err = _List_EventType_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -39676,7 +42483,7 @@ func (v *GetWorkflowExecutionHistoryRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -39706,6 +42513,10 @@ func (v *GetWorkflowExecutionHistoryRequest) String() string {
 		fields[i] = fmt.Sprintf("SkipArchival: %v", *(v.SkipArchival))
 		i++
 	}
+	if v.EventTypes != nil {
+		fields[i] = fmt.Sprintf("EventTypes: %v", v.EventTypes)
+		i++
+	}
 
 	return fmt.Sprintf("GetWorkflowExecutionHistoryRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -39720,6 +42531,21 @@ func _HistoryEventFilterType_EqualsPtr(lhs, rhs *HistoryEventFilterType) bool {
 	return lhs == nil && rhs == nil
 }
 
+func _List_EventType_Equals(lhs, rhs []EventType) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this GetWorkflowExecutionHistoryRequest match the
 // provided GetWorkflowExecutionHistoryRequest.
 //
@@ -39751,10 +42577,24 @@ func (v *GetWorkflowExecutionHistoryRequest) Equals(rhs *GetWorkflowExecutionHis
 	if !_Bool_EqualsPtr(v.SkipArchival, rhs.SkipArchival) {
 		return false
 	}
+	if !((v.EventTypes == nil && rhs.EventTypes == nil) || (v.EventTypes != nil && rhs.EventTypes != nil && _List_EventType_Equals(v.EventTypes, rhs.EventTypes))) {
+		return false
+	}
 
 	return true
 }
 
+type _List_EventType_Zapper []EventType
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_EventType_Zapper.
+func (l _List_EventType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of GetWorkflowExecutionHistoryRequest.
 func (v *GetWorkflowExecutionHistoryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -39782,6 +42622,9 @@ func (v *GetWorkflowExecutionHistoryRequest) MarshalLogObject(enc zapcore.Object
 	if v.SkipArchival != nil {
 		enc.AddBool("skipArchival", *v.SkipArchival)
 	}
+	if v.EventTypes != nil {
+		err = multierr.Append(err, enc.AddArray("eventTypes", (_List_EventType_Zapper)(v.EventTypes)))
+	}
 	return err
 }
 
@@ -39890,6 +42733,21 @@ func (v *GetWorkflowExecutionHistoryRequest) IsSetSkipArchival() bool {
 	return v != nil && v.SkipArchival != nil
 }
 
+// GetEventTypes returns the value of EventTypes if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionHistoryRequest) GetEventTypes() (o []EventType) {
+	if v != nil && v.EventTypes != nil {
+		return v.EventTypes
+	}
+
+	return
+}
+
+// IsSetEventTypes returns true if EventTypes is not nil.
+func (v *GetWorkflowExecutionHistoryRequest) IsSetEventTypes() bool {
+	return v != nil && v.EventTypes != nil
+}
+
 type GetWorkflowExecutionHistoryResponse struct {
 	History       *History    `json:"history,omitempty"`
 	RawHistory    []*DataBlob `json:"rawHistory,omitempty"`
@@ -40432,44 +43290,6 @@ type Header struct {
 	Fields map[string][]byte `json:"fields,omitempty"`
 }
 
-type _Map_String_Binary_MapItemList map[string][]byte
-
-func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
-		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
-
-		vw, err := wire.NewValueBinary(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (m _Map_String_Binary_MapItemList) Size() int {
-	return len(m)
-}
-
-func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
-	return wire.TBinary
-}
-
-func (_Map_String_Binary_MapItemList) Close() {}
-
 // ToWire translates a Header struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -40505,34 +43325,6 @@ func (v *Header) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TBinary {
-		return nil, nil
-	}
-
-	o := make(map[string][]byte, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := x.Value.GetBinary(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
 // FromWire deserializes a Header struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -40569,32 +43361,6 @@ func (v *Header) FromWire(w wire.Value) error {
 	return nil
 }
 
-func _Map_String_Binary_Encode(val map[string][]byte, sw stream.Writer) error {
-
-	mh := stream.MapHeader{
-		KeyType:   wire.TBinary,
-		ValueType: wire.TBinary,
-		Length:    len(val),
-	}
-	if err := sw.WriteMapBegin(mh); err != nil {
-		return err
-	}
-
-	for k, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid map 'map[string][]byte', key [%v]: value is nil", k)
-		}
-		if err := sw.WriteString(k); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v); err != nil {
-			return err
-		}
-	}
-
-	return sw.WriteMapEnd()
-}
-
 // Encode serializes a Header struct directly into bytes, without going
 // through an intermediary type.
 //
@@ -40619,46 +43385,6 @@ func (v *Header) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _Map_String_Binary_Decode(sr stream.Reader) (map[string][]byte, error) {
-	mh, err := sr.ReadMapBegin()
-	if err != nil {
-		return nil, err
-	}
-
-	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
-		for i := 0; i < mh.Length; i++ {
-			if err := sr.Skip(mh.KeyType); err != nil {
-				return nil, err
-			}
-
-			if err := sr.Skip(mh.ValueType); err != nil {
-				return nil, err
-			}
-		}
-		return nil, sr.ReadMapEnd()
-	}
-
-	o := make(map[string][]byte, mh.Length)
-	for i := 0; i < mh.Length; i++ {
-		k, err := sr.ReadString()
-		if err != nil {
-			return nil, err
-		}
-
-		v, err := sr.ReadBinary()
-		if err != nil {
-			return nil, err
-		}
-
-		o[k] = v
-	}
-
-	if err = sr.ReadMapEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
-}
-
 // Decode deserializes a Header struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -40722,23 +43448,6 @@ func (v *Header) String() string {
 	return fmt.Sprintf("Header{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !bytes.Equal(lv, rv) {
-			return false
-		}
-	}
-	return true
-}
-
 // Equals returns true if all the fields of this Header match the
 // provided Header.
 //
@@ -40756,17 +43465,6 @@ func (v *Header) Equals(rhs *Header) bool {
 	return true
 }
 
-type _Map_String_Binary_Zapper map[string][]byte
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_Binary_Zapper.
-func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of Header.
 func (v *Header) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -42385,12 +45083,6 @@ func (v *HistoryEvent) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _EventType_Read(w wire.Value) (EventType, error) {
-	var v EventType
-	err := v.FromWire(w)
-	return v, err
-}
-
 func _WorkflowExecutionStartedEventAttributes_Read(w wire.Value) (*WorkflowExecutionStartedEventAttributes, error) {
 	var v WorkflowExecutionStartedEventAttributes
 	err := v.FromWire(w)
@@ -43627,12 +46319,6 @@ func (v *HistoryEvent) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-func _EventType_Decode(sr stream.Reader) (EventType, error) {
-	var v EventType
-	err := v.Decode(sr)
-	return v, err
-}
-
 func _WorkflowExecutionStartedEventAttributes_Decode(sr stream.Reader) (*WorkflowExecutionStartedEventAttributes, error) {
 	var v WorkflowExecutionStartedEventAttributes
 	err := v.Decode(sr)
@@ -45631,6 +48317,546 @@ func (v *HistoryEventFilterType) UnmarshalJSON(text []byte) error {
 	}
 }
 
+type HistoryLimitWarning struct {
+	HistoryCount            *int64   `json:"historyCount,omitempty"`
+	HistorySize             *int64   `json:"historySize,omitempty"`
+	HistoryCountLimit       *int64   `json:"historyCountLimit,omitempty"`
+	HistorySizeLimit        *int64   `json:"historySizeLimit,omitempty"`
+	EventsPerHour           *float64 `json:"eventsPerHour,omitempty"`
+	EstimatedSecondsToLimit *int64   `json:"estimatedSecondsToLimit,omitempty"`
+}
+
+// ToWire translates a HistoryLimitWarning struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryLimitWarning) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.HistoryCount != nil {
+		w, err = wire.NewValueI64(*(v.HistoryCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HistorySize != nil {
+		w, err = wire.NewValueI64(*(v.HistorySize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HistoryCountLimit != nil {
+		w, err = wire.NewValueI64(*(v.HistoryCountLimit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.HistorySizeLimit != nil {
+		w, err = wire.NewValueI64(*(v.HistorySizeLimit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.EventsPerHour != nil {
+		w, err = wire.NewValueDouble(*(v.EventsPerHour)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.EstimatedSecondsToLimit != nil {
+		w, err = wire.NewValueI64(*(v.EstimatedSecondsToLimit)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a HistoryLimitWarning struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HistoryLimitWarning struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HistoryLimitWarning
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HistoryLimitWarning) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HistoryCount = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HistorySize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HistoryCountLimit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.HistorySizeLimit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TDouble {
+				var x float64
+				x, err = field.Value.GetDouble(), error(nil)
+				v.EventsPerHour = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EstimatedSecondsToLimit = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a HistoryLimitWarning struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a HistoryLimitWarning struct could not be encoded.
+func (v *HistoryLimitWarning) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.HistoryCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.HistoryCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HistorySize != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.HistorySize)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HistoryCountLimit != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.HistoryCountLimit)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.HistorySizeLimit != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.HistorySizeLimit)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EventsPerHour != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TDouble}); err != nil {
+			return err
+		}
+		if err := sw.WriteDouble(*(v.EventsPerHour)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EstimatedSecondsToLimit != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.EstimatedSecondsToLimit)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a HistoryLimitWarning struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a HistoryLimitWarning struct could not be generated from the wire
+// representation.
+func (v *HistoryLimitWarning) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.HistoryCount = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.HistorySize = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.HistoryCountLimit = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.HistorySizeLimit = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TDouble:
+			var x float64
+			x, err = sr.ReadDouble()
+			v.EventsPerHour = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.EstimatedSecondsToLimit = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HistoryLimitWarning
+// struct.
+func (v *HistoryLimitWarning) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.HistoryCount != nil {
+		fields[i] = fmt.Sprintf("HistoryCount: %v", *(v.HistoryCount))
+		i++
+	}
+	if v.HistorySize != nil {
+		fields[i] = fmt.Sprintf("HistorySize: %v", *(v.HistorySize))
+		i++
+	}
+	if v.HistoryCountLimit != nil {
+		fields[i] = fmt.Sprintf("HistoryCountLimit: %v", *(v.HistoryCountLimit))
+		i++
+	}
+	if v.HistorySizeLimit != nil {
+		fields[i] = fmt.Sprintf("HistorySizeLimit: %v", *(v.HistorySizeLimit))
+		i++
+	}
+	if v.EventsPerHour != nil {
+		fields[i] = fmt.Sprintf("EventsPerHour: %v", *(v.EventsPerHour))
+		i++
+	}
+	if v.EstimatedSecondsToLimit != nil {
+		fields[i] = fmt.Sprintf("EstimatedSecondsToLimit: %v", *(v.EstimatedSecondsToLimit))
+		i++
+	}
+
+	return fmt.Sprintf("HistoryLimitWarning{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this HistoryLimitWarning match the
+// provided HistoryLimitWarning.
+//
+// This function performs a deep comparison.
+func (v *HistoryLimitWarning) Equals(rhs *HistoryLimitWarning) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HistoryCount, rhs.HistoryCount) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HistorySize, rhs.HistorySize) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HistoryCountLimit, rhs.HistoryCountLimit) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.HistorySizeLimit, rhs.HistorySizeLimit) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.EventsPerHour, rhs.EventsPerHour) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EstimatedSecondsToLimit, rhs.EstimatedSecondsToLimit) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryLimitWarning.
+func (v *HistoryLimitWarning) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.HistoryCount != nil {
+		enc.AddInt64("historyCount", *v.HistoryCount)
+	}
+	if v.HistorySize != nil {
+		enc.AddInt64("historySize", *v.HistorySize)
+	}
+	if v.HistoryCountLimit != nil {
+		enc.AddInt64("historyCountLimit", *v.HistoryCountLimit)
+	}
+	if v.HistorySizeLimit != nil {
+		enc.AddInt64("historySizeLimit", *v.HistorySizeLimit)
+	}
+	if v.EventsPerHour != nil {
+		enc.AddFloat64("eventsPerHour", *v.EventsPerHour)
+	}
+	if v.EstimatedSecondsToLimit != nil {
+		enc.AddInt64("estimatedSecondsToLimit", *v.EstimatedSecondsToLimit)
+	}
+	return err
+}
+
+// GetHistoryCount returns the value of HistoryCount if it is set or its
+// zero value if it is unset.
+func (v *HistoryLimitWarning) GetHistoryCount() (o int64) {
+	if v != nil && v.HistoryCount != nil {
+		return *v.HistoryCount
+	}
+
+	return
+}
+
+// IsSetHistoryCount returns true if HistoryCount is not nil.
+func (v *HistoryLimitWarning) IsSetHistoryCount() bool {
+	return v != nil && v.HistoryCount != nil
+}
+
+// GetHistorySize returns the value of HistorySize if it is set or its
+// zero value if it is unset.
+func (v *HistoryLimitWarning) GetHistorySize() (o int64) {
+	if v != nil && v.HistorySize != nil {
+		return *v.HistorySize
+	}
+
+	return
+}
+
+// IsSetHistorySize returns true if HistorySize is not nil.
+func (v *HistoryLimitWarning) IsSetHistorySize() bool {
+	return v != nil && v.HistorySize != nil
+}
+
+// GetHistoryCountLimit returns the value of HistoryCountLimit if it is set or its
+// zero value if it is unset.
+func (v *HistoryLimitWarning) GetHistoryCountLimit() (o int64) {
+	if v != nil && v.HistoryCountLimit != nil {
+		return *v.HistoryCountLimit
+	}
+
+	return
+}
+
+// IsSetHistoryCountLimit returns true if HistoryCountLimit is not nil.
+func (v *HistoryLimitWarning) IsSetHistoryCountLimit() bool {
+	return v != nil && v.HistoryCountLimit != nil
+}
+
+// GetHistorySizeLimit returns the value of HistorySizeLimit if it is set or its
+// zero value if it is unset.
+func (v *HistoryLimitWarning) GetHistorySizeLimit() (o int64) {
+	if v != nil && v.HistorySizeLimit != nil {
+		return *v.HistorySizeLimit
+	}
+
+	return
+}
+
+// IsSetHistorySizeLimit returns true if HistorySizeLimit is not nil.
+func (v *HistoryLimitWarning) IsSetHistorySizeLimit() bool {
+	return v != nil && v.HistorySizeLimit != nil
+}
+
+// GetEventsPerHour returns the value of EventsPerHour if it is set or its
+// zero value if it is unset.
+func (v *HistoryLimitWarning) GetEventsPerHour() (o float64) {
+	if v != nil && v.EventsPerHour != nil {
+		return *v.EventsPerHour
+	}
+
+	return
+}
+
+// IsSetEventsPerHour returns true if EventsPerHour is not nil.
+func (v *HistoryLimitWarning) IsSetEventsPerHour() bool {
+	return v != nil && v.EventsPerHour != nil
+}
+
+// GetEstimatedSecondsToLimit returns the value of EstimatedSecondsToLimit if it is set or its
+// zero value if it is unset.
+func (v *HistoryLimitWarning) GetEstimatedSecondsToLimit() (o int64) {
+	if v != nil && v.EstimatedSecondsToLimit != nil {
+		return *v.EstimatedSecondsToLimit
+	}
+
+	return
+}
+
+// IsSetEstimatedSecondsToLimit returns true if EstimatedSecondsToLimit is not nil.
+func (v *HistoryLimitWarning) IsSetEstimatedSecondsToLimit() bool {
+	return v != nil && v.EstimatedSecondsToLimit != nil
+}
+
 type IndexedValueType int32
 
 const (
@@ -48487,6 +51713,7 @@ func (v *ListDomainsRequest) IsSetNextPageToken() bool {
 type ListDomainsResponse struct {
 	Domains       []*DescribeDomainResponse `json:"domains,omitempty"`
 	NextPageToken []byte                    `json:"nextPageToken,omitempty"`
+	TotalCount    *int64                    `json:"totalCount,omitempty"`
 }
 
 type _List_DescribeDomainResponse_ValueList []*DescribeDomainResponse
@@ -48535,7 +51762,7 @@ func (_List_DescribeDomainResponse_ValueList) Close() {}
 //   }
 func (v *ListDomainsResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -48557,6 +51784,14 @@ func (v *ListDomainsResponse) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.TotalCount != nil {
+		w, err = wire.NewValueI64(*(v.TotalCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -48622,6 +51857,16 @@ func (v *ListDomainsResponse) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.TotalCount = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -48683,6 +51928,18 @@ func (v *ListDomainsResponse) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.TotalCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.TotalCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -48752,6 +52009,14 @@ func (v *ListDomainsResponse) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.TotalCount = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -48781,7 +52046,7 @@ func (v *ListDomainsResponse) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	if v.Domains != nil {
 		fields[i] = fmt.Sprintf("Domains: %v", v.Domains)
@@ -48791,6 +52056,10 @@ func (v *ListDomainsResponse) String() string {
 		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
 		i++
 	}
+	if v.TotalCount != nil {
+		fields[i] = fmt.Sprintf("TotalCount: %v", *(v.TotalCount))
+		i++
+	}
 
 	return fmt.Sprintf("ListDomainsResponse{%v}", strings.Join(fields[:i], ", "))
 }
@@ -48826,6 +52095,9 @@ func (v *ListDomainsResponse) Equals(rhs *ListDomainsResponse) bool {
 	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.TotalCount, rhs.TotalCount) {
+		return false
+	}
 
 	return true
 }
@@ -48853,6 +52125,9 @@ func (v *ListDomainsResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.NextPageToken != nil {
 		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
 	}
+	if v.TotalCount != nil {
+		enc.AddInt64("totalCount", *v.TotalCount)
+	}
 	return err
 }
 
@@ -48886,6 +52161,21 @@ func (v *ListDomainsResponse) IsSetNextPageToken() bool {
 	return v != nil && v.NextPageToken != nil
 }
 
+// GetTotalCount returns the value of TotalCount if it is set or its
+// zero value if it is unset.
+func (v *ListDomainsResponse) GetTotalCount() (o int64) {
+	if v != nil && v.TotalCount != nil {
+		return *v.TotalCount
+	}
+
+	return
+}
+
+// IsSetTotalCount returns true if TotalCount is not nil.
+func (v *ListDomainsResponse) IsSetTotalCount() bool {
+	return v != nil && v.TotalCount != nil
+}
+
 type ListOpenWorkflowExecutionsRequest struct {
 	Domain          *string                  `json:"domain,omitempty"`
 	MaximumPageSize *int32                   `json:"maximumPageSize,omitempty"`
@@ -57675,16 +60965,6 @@ func (v *PollerInfo) String() string {
 	return fmt.Sprintf("PollerInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _Double_EqualsPtr(lhs, rhs *float64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
 // Equals returns true if all the fields of this PollerInfo match the
 // provided PollerInfo.
 //
@@ -63505,11 +66785,15 @@ func (v *RemoveTaskRequest) IsSetClusterName() bool {
 	return v != nil && v.ClusterName != nil
 }
 
-type RequestCancelActivityTaskDecisionAttributes struct {
-	ActivityId *string `json:"activityId,omitempty"`
+type ReplicationAckState struct {
+	ClusterName        *string `json:"clusterName,omitempty"`
+	AckLevel           *int64  `json:"ackLevel,omitempty"`
+	LastAckTime        *int64  `json:"lastAckTime,omitempty"`
+	PendingTasks       *int64  `json:"pendingTasks,omitempty"`
+	PendingTasksCapped *bool   `json:"pendingTasksCapped,omitempty"`
 }
 
-// ToWire translates a RequestCancelActivityTaskDecisionAttributes struct into a Thrift-level intermediate
+// ToWire translates a ReplicationAckState struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -63524,259 +66808,63 @@ type RequestCancelActivityTaskDecisionAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *ReplicationAckState) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ActivityId != nil {
-		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-// FromWire deserializes a RequestCancelActivityTaskDecisionAttributes struct from its Thrift-level
-// representation. The Thrift-level representation may be obtained
-// from a ThriftRW protocol implementation.
-//
-// An error is returned if we were unable to build a RequestCancelActivityTaskDecisionAttributes struct
-// from the provided intermediate representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
-//   if err != nil {
-//     return nil, err
-//   }
-//
-//   var v RequestCancelActivityTaskDecisionAttributes
-//   if err := v.FromWire(x); err != nil {
-//     return nil, err
-//   }
-//   return &v, nil
-func (v *RequestCancelActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
-	var err error
-
-	for _, field := range w.GetStruct().Fields {
-		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ActivityId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		}
-	}
-
-	return nil
-}
-
-// Encode serializes a RequestCancelActivityTaskDecisionAttributes struct directly into bytes, without going
-// through an intermediary type.
-//
-// An error is returned if a RequestCancelActivityTaskDecisionAttributes struct could not be encoded.
-func (v *RequestCancelActivityTaskDecisionAttributes) Encode(sw stream.Writer) error {
-	if err := sw.WriteStructBegin(); err != nil {
-		return err
-	}
-
-	if v.ActivityId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.ActivityId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	return sw.WriteStructEnd()
-}
-
-// Decode deserializes a RequestCancelActivityTaskDecisionAttributes struct directly from its Thrift-level
-// representation, without going through an intemediary type.
-//
-// An error is returned if a RequestCancelActivityTaskDecisionAttributes struct could not be generated from the wire
-// representation.
-func (v *RequestCancelActivityTaskDecisionAttributes) Decode(sr stream.Reader) error {
-
-	if err := sr.ReadStructBegin(); err != nil {
-		return err
-	}
-
-	fh, ok, err := sr.ReadFieldBegin()
-	if err != nil {
-		return err
-	}
-
-	for ok {
-		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.ActivityId = &x
-			if err != nil {
-				return err
-			}
-
-		default:
-			if err := sr.Skip(fh.Type); err != nil {
-				return err
-			}
-		}
-
-		if err := sr.ReadFieldEnd(); err != nil {
-			return err
-		}
-
-		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
-			return err
+	if v.AckLevel != nil {
+		w, err = wire.NewValueI64(*(v.AckLevel)), error(nil)
+		if err != nil {
+			return w, err
 		}
-	}
-
-	if err := sr.ReadStructEnd(); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// String returns a readable string representation of a RequestCancelActivityTaskDecisionAttributes
-// struct.
-func (v *RequestCancelActivityTaskDecisionAttributes) String() string {
-	if v == nil {
-		return "<nil>"
-	}
-
-	var fields [1]string
-	i := 0
-	if v.ActivityId != nil {
-		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-
-	return fmt.Sprintf("RequestCancelActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
-}
-
-// Equals returns true if all the fields of this RequestCancelActivityTaskDecisionAttributes match the
-// provided RequestCancelActivityTaskDecisionAttributes.
-//
-// This function performs a deep comparison.
-func (v *RequestCancelActivityTaskDecisionAttributes) Equals(rhs *RequestCancelActivityTaskDecisionAttributes) bool {
-	if v == nil {
-		return rhs == nil
-	} else if rhs == nil {
-		return false
-	}
-	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
-		return false
-	}
-
-	return true
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelActivityTaskDecisionAttributes.
-func (v *RequestCancelActivityTaskDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	if v == nil {
-		return nil
-	}
-	if v.ActivityId != nil {
-		enc.AddString("activityId", *v.ActivityId)
-	}
-	return err
-}
-
-// GetActivityId returns the value of ActivityId if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelActivityTaskDecisionAttributes) GetActivityId() (o string) {
-	if v != nil && v.ActivityId != nil {
-		return *v.ActivityId
-	}
-
-	return
-}
-
-// IsSetActivityId returns true if ActivityId is not nil.
-func (v *RequestCancelActivityTaskDecisionAttributes) IsSetActivityId() bool {
-	return v != nil && v.ActivityId != nil
-}
-
-type RequestCancelActivityTaskFailedEventAttributes struct {
-	ActivityId                   *string `json:"activityId,omitempty"`
-	Cause                        *string `json:"cause,omitempty"`
-	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
-}
-
-// ToWire translates a RequestCancelActivityTaskFailedEventAttributes struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *RequestCancelActivityTaskFailedEventAttributes) ToWire() (wire.Value, error) {
-	var (
-		fields [3]wire.Field
-		i      int = 0
-		w      wire.Value
-		err    error
-	)
-
-	if v.ActivityId != nil {
-		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
+	if v.LastAckTime != nil {
+		w, err = wire.NewValueI64(*(v.LastAckTime)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.Cause != nil {
-		w, err = wire.NewValueString(*(v.Cause)), error(nil)
+	if v.PendingTasks != nil {
+		w, err = wire.NewValueI64(*(v.PendingTasks)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.PendingTasksCapped != nil {
+		w, err = wire.NewValueBool(*(v.PendingTasksCapped)), error(nil)
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RequestCancelActivityTaskFailedEventAttributes struct from its Thrift-level
+// FromWire deserializes a ReplicationAckState struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelActivityTaskFailedEventAttributes struct
+// An error is returned if we were unable to build a ReplicationAckState struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -63784,12 +66872,12 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) ToWire() (wire.Value, e
 //     return nil, err
 //   }
 //
-//   var v RequestCancelActivityTaskFailedEventAttributes
+//   var v ReplicationAckState
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value) error {
+func (v *ReplicationAckState) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -63798,17 +66886,17 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value)
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ActivityId = &x
+				v.ClusterName = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Cause = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.AckLevel = &x
 				if err != nil {
 					return err
 				}
@@ -63818,7 +66906,27 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value)
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+				v.LastAckTime = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.PendingTasks = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.PendingTasksCapped = &x
 				if err != nil {
 					return err
 				}
@@ -63830,20 +66938,20 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value)
 	return nil
 }
 
-// Encode serializes a RequestCancelActivityTaskFailedEventAttributes struct directly into bytes, without going
+// Encode serializes a ReplicationAckState struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelActivityTaskFailedEventAttributes struct could not be encoded.
-func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a ReplicationAckState struct could not be encoded.
+func (v *ReplicationAckState) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ActivityId != nil {
+	if v.ClusterName != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ActivityId)); err != nil {
+		if err := sw.WriteString(*(v.ClusterName)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -63851,11 +66959,11 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer
 		}
 	}
 
-	if v.Cause != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+	if v.AckLevel != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Cause)); err != nil {
+		if err := sw.WriteInt64(*(v.AckLevel)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -63863,11 +66971,35 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer
 		}
 	}
 
-	if v.DecisionTaskCompletedEventId != nil {
+	if v.LastAckTime != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+		if err := sw.WriteInt64(*(v.LastAckTime)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingTasks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.PendingTasks)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.PendingTasksCapped != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.PendingTasksCapped)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -63878,12 +67010,12 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RequestCancelActivityTaskFailedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a ReplicationAckState struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelActivityTaskFailedEventAttributes struct could not be generated from the wire
+// An error is returned if a ReplicationAckState struct could not be generated from the wire
 // representation.
-func (v *RequestCancelActivityTaskFailedEventAttributes) Decode(sr stream.Reader) error {
+func (v *ReplicationAckState) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -63899,15 +67031,15 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Decode(sr stream.Reader
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.ActivityId = &x
+			v.ClusterName = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Cause = &x
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.AckLevel = &x
 			if err != nil {
 				return err
 			}
@@ -63915,7 +67047,23 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Decode(sr stream.Reader
 		case fh.ID == 30 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
+			v.LastAckTime = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.PendingTasks = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.PendingTasksCapped = &x
 			if err != nil {
 				return err
 			}
@@ -63942,48 +67090,62 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Decode(sr stream.Reader
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelActivityTaskFailedEventAttributes
+// String returns a readable string representation of a ReplicationAckState
 // struct.
-func (v *RequestCancelActivityTaskFailedEventAttributes) String() string {
+func (v *ReplicationAckState) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
-	if v.ActivityId != nil {
-		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
 		i++
 	}
-	if v.Cause != nil {
-		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
+	if v.AckLevel != nil {
+		fields[i] = fmt.Sprintf("AckLevel: %v", *(v.AckLevel))
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+	if v.LastAckTime != nil {
+		fields[i] = fmt.Sprintf("LastAckTime: %v", *(v.LastAckTime))
+		i++
+	}
+	if v.PendingTasks != nil {
+		fields[i] = fmt.Sprintf("PendingTasks: %v", *(v.PendingTasks))
+		i++
+	}
+	if v.PendingTasksCapped != nil {
+		fields[i] = fmt.Sprintf("PendingTasksCapped: %v", *(v.PendingTasksCapped))
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelActivityTaskFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ReplicationAckState{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelActivityTaskFailedEventAttributes match the
-// provided RequestCancelActivityTaskFailedEventAttributes.
+// Equals returns true if all the fields of this ReplicationAckState match the
+// provided ReplicationAckState.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelActivityTaskFailedEventAttributes) Equals(rhs *RequestCancelActivityTaskFailedEventAttributes) bool {
+func (v *ReplicationAckState) Equals(rhs *ReplicationAckState) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Cause, rhs.Cause) {
+	if !_I64_EqualsPtr(v.AckLevel, rhs.AckLevel) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !_I64_EqualsPtr(v.LastAckTime, rhs.LastAckTime) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.PendingTasks, rhs.PendingTasks) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.PendingTasksCapped, rhs.PendingTasksCapped) {
 		return false
 	}
 
@@ -63991,77 +67153,109 @@ func (v *RequestCancelActivityTaskFailedEventAttributes) Equals(rhs *RequestCanc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelActivityTaskFailedEventAttributes.
-func (v *RequestCancelActivityTaskFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ReplicationAckState.
+func (v *ReplicationAckState) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ActivityId != nil {
-		enc.AddString("activityId", *v.ActivityId)
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
 	}
-	if v.Cause != nil {
-		enc.AddString("cause", *v.Cause)
+	if v.AckLevel != nil {
+		enc.AddInt64("ackLevel", *v.AckLevel)
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	if v.LastAckTime != nil {
+		enc.AddInt64("lastAckTime", *v.LastAckTime)
+	}
+	if v.PendingTasks != nil {
+		enc.AddInt64("pendingTasks", *v.PendingTasks)
+	}
+	if v.PendingTasksCapped != nil {
+		enc.AddBool("pendingTasksCapped", *v.PendingTasksCapped)
 	}
 	return err
 }
 
-// GetActivityId returns the value of ActivityId if it is set or its
+// GetClusterName returns the value of ClusterName if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelActivityTaskFailedEventAttributes) GetActivityId() (o string) {
-	if v != nil && v.ActivityId != nil {
-		return *v.ActivityId
+func (v *ReplicationAckState) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
 	}
 
 	return
 }
 
-// IsSetActivityId returns true if ActivityId is not nil.
-func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetActivityId() bool {
-	return v != nil && v.ActivityId != nil
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *ReplicationAckState) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
 }
 
-// GetCause returns the value of Cause if it is set or its
+// GetAckLevel returns the value of AckLevel if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelActivityTaskFailedEventAttributes) GetCause() (o string) {
-	if v != nil && v.Cause != nil {
-		return *v.Cause
+func (v *ReplicationAckState) GetAckLevel() (o int64) {
+	if v != nil && v.AckLevel != nil {
+		return *v.AckLevel
 	}
 
 	return
 }
 
-// IsSetCause returns true if Cause is not nil.
-func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetCause() bool {
-	return v != nil && v.Cause != nil
+// IsSetAckLevel returns true if AckLevel is not nil.
+func (v *ReplicationAckState) IsSetAckLevel() bool {
+	return v != nil && v.AckLevel != nil
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetLastAckTime returns the value of LastAckTime if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelActivityTaskFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *ReplicationAckState) GetLastAckTime() (o int64) {
+	if v != nil && v.LastAckTime != nil {
+		return *v.LastAckTime
 	}
 
 	return
 }
 
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
+// IsSetLastAckTime returns true if LastAckTime is not nil.
+func (v *ReplicationAckState) IsSetLastAckTime() bool {
+	return v != nil && v.LastAckTime != nil
 }
 
-type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
-	Domain            *string `json:"domain,omitempty"`
-	WorkflowId        *string `json:"workflowId,omitempty"`
-	RunId             *string `json:"runId,omitempty"`
-	Control           []byte  `json:"control,omitempty"`
-	ChildWorkflowOnly *bool   `json:"childWorkflowOnly,omitempty"`
+// GetPendingTasks returns the value of PendingTasks if it is set or its
+// zero value if it is unset.
+func (v *ReplicationAckState) GetPendingTasks() (o int64) {
+	if v != nil && v.PendingTasks != nil {
+		return *v.PendingTasks
+	}
+
+	return
 }
 
-// ToWire translates a RequestCancelExternalWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
+// IsSetPendingTasks returns true if PendingTasks is not nil.
+func (v *ReplicationAckState) IsSetPendingTasks() bool {
+	return v != nil && v.PendingTasks != nil
+}
+
+// GetPendingTasksCapped returns the value of PendingTasksCapped if it is set or its
+// zero value if it is unset.
+func (v *ReplicationAckState) GetPendingTasksCapped() (o bool) {
+	if v != nil && v.PendingTasksCapped != nil {
+		return *v.PendingTasksCapped
+	}
+
+	return
+}
+
+// IsSetPendingTasksCapped returns true if PendingTasksCapped is not nil.
+func (v *ReplicationAckState) IsSetPendingTasksCapped() bool {
+	return v != nil && v.PendingTasksCapped != nil
+}
+
+type RequestCancelActivityTaskDecisionAttributes struct {
+	ActivityId *string `json:"activityId,omitempty"`
+}
+
+// ToWire translates a RequestCancelActivityTaskDecisionAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -64076,63 +67270,31 @@ type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
+func (v *RequestCancelActivityTaskDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowId != nil {
-		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.Control != nil {
-		w, err = wire.NewValueBinary(v.Control), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.ChildWorkflowOnly != nil {
-		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct from its Thrift-level
+// FromWire deserializes a RequestCancelActivityTaskDecisionAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionDecisionAttributes struct
+// An error is returned if we were unable to build a RequestCancelActivityTaskDecisionAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -64140,12 +67302,12 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wir
 //     return nil, err
 //   }
 //
-//   var v RequestCancelExternalWorkflowExecutionDecisionAttributes
+//   var v RequestCancelActivityTaskDecisionAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
+func (v *RequestCancelActivityTaskDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -64154,45 +67316,7 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wi
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.WorkflowId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				v.Control, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
-				v.ChildWorkflowOnly = &x
+				v.ActivityId = &x
 				if err != nil {
 					return err
 				}
@@ -64204,68 +67328,20 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wi
 	return nil
 }
 
-// Encode serializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct directly into bytes, without going
+// Encode serializes a RequestCancelActivityTaskDecisionAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionDecisionAttributes struct could not be encoded.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelActivityTaskDecisionAttributes struct could not be encoded.
+func (v *RequestCancelActivityTaskDecisionAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Domain != nil {
+	if v.ActivityId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.WorkflowId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.RunId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.RunId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Control != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteBinary(v.Control); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.ChildWorkflowOnly != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
-			return err
-		}
-		if err := sw.WriteBool(*(v.ChildWorkflowOnly)); err != nil {
+		if err := sw.WriteString(*(v.ActivityId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64276,12 +67352,12 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Encode(sw str
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct directly from its Thrift-level
+// Decode deserializes a RequestCancelActivityTaskDecisionAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionDecisionAttributes struct could not be generated from the wire
+// An error is returned if a RequestCancelActivityTaskDecisionAttributes struct could not be generated from the wire
 // representation.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Decode(sr stream.Reader) error {
+func (v *RequestCancelActivityTaskDecisionAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -64297,37 +67373,7 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Decode(sr str
 		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Domain = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.WorkflowId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RunId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			v.Control, err = sr.ReadBinary()
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 50 && fh.Type == wire.TBool:
-			var x bool
-			x, err = sr.ReadBool()
-			v.ChildWorkflowOnly = &x
+			v.ActivityId = &x
 			if err != nil {
 				return err
 			}
@@ -64354,62 +67400,34 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Decode(sr str
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionDecisionAttributes
+// String returns a readable string representation of a RequestCancelActivityTaskDecisionAttributes
 // struct.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) String() string {
+func (v *RequestCancelActivityTaskDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [1]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.WorkflowId != nil {
-		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
-		i++
-	}
-	if v.Control != nil {
-		fields[i] = fmt.Sprintf("Control: %v", v.Control)
-		i++
-	}
-	if v.ChildWorkflowOnly != nil {
-		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelActivityTaskDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionDecisionAttributes match the
-// provided RequestCancelExternalWorkflowExecutionDecisionAttributes.
+// Equals returns true if all the fields of this RequestCancelActivityTaskDecisionAttributes match the
+// provided RequestCancelActivityTaskDecisionAttributes.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionDecisionAttributes) bool {
+func (v *RequestCancelActivityTaskDecisionAttributes) Equals(rhs *RequestCancelActivityTaskDecisionAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
-		return false
-	}
-	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
-		return false
-	}
-	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
 		return false
 	}
 
@@ -64417,114 +67435,39 @@ func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Equals(rhs *R
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelExternalWorkflowExecutionDecisionAttributes.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelActivityTaskDecisionAttributes.
+func (v *RequestCancelActivityTaskDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
-	}
-	if v.WorkflowId != nil {
-		enc.AddString("workflowId", *v.WorkflowId)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
-	}
-	if v.Control != nil {
-		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
-	}
-	if v.ChildWorkflowOnly != nil {
-		enc.AddBool("childWorkflowOnly", *v.ChildWorkflowOnly)
+	if v.ActivityId != nil {
+		enc.AddString("activityId", *v.ActivityId)
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
-	}
-
-	return
-}
-
-// IsSetDomain returns true if Domain is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
-}
-
-// GetWorkflowId returns the value of WorkflowId if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetWorkflowId() (o string) {
-	if v != nil && v.WorkflowId != nil {
-		return *v.WorkflowId
-	}
-
-	return
-}
-
-// IsSetWorkflowId returns true if WorkflowId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetWorkflowId() bool {
-	return v != nil && v.WorkflowId != nil
-}
-
-// GetRunId returns the value of RunId if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
-	}
-
-	return
-}
-
-// IsSetRunId returns true if RunId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
-}
-
-// GetControl returns the value of Control if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetControl() (o []byte) {
-	if v != nil && v.Control != nil {
-		return v.Control
-	}
-
-	return
-}
-
-// IsSetControl returns true if Control is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetControl() bool {
-	return v != nil && v.Control != nil
-}
-
-// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
+// GetActivityId returns the value of ActivityId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetChildWorkflowOnly() (o bool) {
-	if v != nil && v.ChildWorkflowOnly != nil {
-		return *v.ChildWorkflowOnly
+func (v *RequestCancelActivityTaskDecisionAttributes) GetActivityId() (o string) {
+	if v != nil && v.ActivityId != nil {
+		return *v.ActivityId
 	}
 
 	return
 }
 
-// IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
-func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetChildWorkflowOnly() bool {
-	return v != nil && v.ChildWorkflowOnly != nil
+// IsSetActivityId returns true if ActivityId is not nil.
+func (v *RequestCancelActivityTaskDecisionAttributes) IsSetActivityId() bool {
+	return v != nil && v.ActivityId != nil
 }
 
-type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
-	Cause                        *CancelExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
-	DecisionTaskCompletedEventId *int64                                      `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       *string                                     `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
-	InitiatedEventId             *int64                                      `json:"initiatedEventId,omitempty"`
-	Control                      []byte                                      `json:"control,omitempty"`
+type RequestCancelActivityTaskFailedEventAttributes struct {
+	ActivityId                   *string `json:"activityId,omitempty"`
+	Cause                        *string `json:"cause,omitempty"`
+	DecisionTaskCompletedEventId *int64  `json:"decisionTaskCompletedEventId,omitempty"`
 }
 
-// ToWire translates a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelActivityTaskFailedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -64539,77 +67482,47 @@ type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
+func (v *RequestCancelActivityTaskFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Cause != nil {
-		w, err = v.Cause.ToWire()
+	if v.ActivityId != nil {
+		w, err = wire.NewValueString(*(v.ActivityId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.Cause != nil {
+		w, err = wire.NewValueString(*(v.Cause)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.InitiatedEventId != nil {
-		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.Control != nil {
-		w, err = wire.NewValueBinary(v.Control), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _CancelExternalWorkflowExecutionFailedCause_Read(w wire.Value) (CancelExternalWorkflowExecutionFailedCause, error) {
-	var v CancelExternalWorkflowExecutionFailedCause
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct from its Thrift-level
+// FromWire deserializes a RequestCancelActivityTaskFailedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct
+// An error is returned if we were unable to build a RequestCancelActivityTaskFailedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -64617,67 +67530,41 @@ func _CancelExternalWorkflowExecutionFailedCause_Read(w wire.Value) (CancelExter
 //     return nil, err
 //   }
 //
-//   var v RequestCancelExternalWorkflowExecutionFailedEventAttributes
+//   var v RequestCancelActivityTaskFailedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
+func (v *RequestCancelActivityTaskFailedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x CancelExternalWorkflowExecutionFailedCause
-				x, err = _CancelExternalWorkflowExecutionFailedCause_Read(field.Value)
-				v.Cause = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ActivityId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Cause = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
-				v.InitiatedEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TBinary {
-				v.Control, err = field.Value.GetBinary(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
@@ -64689,56 +67576,20 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w
 	return nil
 }
 
-// Encode serializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct directly into bytes, without going
+// Encode serializes a RequestCancelActivityTaskFailedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct could not be encoded.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelActivityTaskFailedEventAttributes struct could not be encoded.
+func (v *RequestCancelActivityTaskFailedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Cause != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
-			return err
-		}
-		if err := v.Cause.Encode(sw); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
-			return err
-		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.WorkflowExecution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
+	if v.ActivityId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.WorkflowExecution.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.ActivityId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64746,11 +67597,11 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw
 		}
 	}
 
-	if v.InitiatedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+	if v.Cause != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.InitiatedEventId)); err != nil {
+		if err := sw.WriteString(*(v.Cause)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64758,11 +67609,11 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw
 		}
 	}
 
-	if v.Control != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteBinary(v.Control); err != nil {
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -64773,18 +67624,12 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw
 	return sw.WriteStructEnd()
 }
 
-func _CancelExternalWorkflowExecutionFailedCause_Decode(sr stream.Reader) (CancelExternalWorkflowExecutionFailedCause, error) {
-	var v CancelExternalWorkflowExecutionFailedCause
-	err := v.Decode(sr)
-	return v, err
-}
-
-// Decode deserializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a RequestCancelActivityTaskFailedEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct could not be generated from the wire
+// An error is returned if a RequestCancelActivityTaskFailedEventAttributes struct could not be generated from the wire
 // representation.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Decode(sr stream.Reader) error {
+func (v *RequestCancelActivityTaskFailedEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -64797,46 +67642,26 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Decode(sr
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x CancelExternalWorkflowExecutionFailedCause
-			x, err = _CancelExternalWorkflowExecutionFailedCause_Decode(sr)
-			v.Cause = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TBinary:
+		case fh.ID == 10 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Domain = &x
+			v.ActivityId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TStruct:
-			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Cause = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TI64:
+		case fh.ID == 30 && fh.Type == wire.TI64:
 			var x int64
 			x, err = sr.ReadInt64()
-			v.InitiatedEventId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 60 && fh.Type == wire.TBinary:
-			v.Control, err = sr.ReadBinary()
+			v.DecisionTaskCompletedEventId = &x
 			if err != nil {
 				return err
 			}
@@ -64863,15 +67688,19 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Decode(sr
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionFailedEventAttributes
+// String returns a readable string representation of a RequestCancelActivityTaskFailedEventAttributes
 // struct.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() string {
+func (v *RequestCancelActivityTaskFailedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [3]string
 	i := 0
+	if v.ActivityId != nil {
+		fields[i] = fmt.Sprintf("ActivityId: %v", *(v.ActivityId))
+		i++
+	}
 	if v.Cause != nil {
 		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
 		i++
@@ -64880,62 +67709,27 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() s
 		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
-		i++
-	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
-		i++
-	}
-	if v.InitiatedEventId != nil {
-		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
-		i++
-	}
-	if v.Control != nil {
-		fields[i] = fmt.Sprintf("Control: %v", v.Control)
-		i++
-	}
-
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
-}
 
-func _CancelExternalWorkflowExecutionFailedCause_EqualsPtr(lhs, rhs *CancelExternalWorkflowExecutionFailedCause) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("RequestCancelActivityTaskFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionFailedEventAttributes match the
-// provided RequestCancelExternalWorkflowExecutionFailedEventAttributes.
+// Equals returns true if all the fields of this RequestCancelActivityTaskFailedEventAttributes match the
+// provided RequestCancelActivityTaskFailedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionFailedEventAttributes) bool {
+func (v *RequestCancelActivityTaskFailedEventAttributes) Equals(rhs *RequestCancelActivityTaskFailedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_CancelExternalWorkflowExecutionFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_String_EqualsPtr(v.ActivityId, rhs.ActivityId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
+	if !_String_EqualsPtr(v.Cause, rhs.Cause) {
 		return false
 	}
-	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
 
@@ -64943,35 +67737,41 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Equals(rhs
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelExternalWorkflowExecutionFailedEventAttributes.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelActivityTaskFailedEventAttributes.
+func (v *RequestCancelActivityTaskFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.ActivityId != nil {
+		enc.AddString("activityId", *v.ActivityId)
+	}
 	if v.Cause != nil {
-		err = multierr.Append(err, enc.AddObject("cause", *v.Cause))
+		enc.AddString("cause", *v.Cause)
 	}
 	if v.DecisionTaskCompletedEventId != nil {
 		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
-	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
-	}
-	if v.InitiatedEventId != nil {
-		enc.AddInt64("initiatedEventId", *v.InitiatedEventId)
-	}
-	if v.Control != nil {
-		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
-	}
 	return err
 }
 
+// GetActivityId returns the value of ActivityId if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelActivityTaskFailedEventAttributes) GetActivityId() (o string) {
+	if v != nil && v.ActivityId != nil {
+		return *v.ActivityId
+	}
+
+	return
+}
+
+// IsSetActivityId returns true if ActivityId is not nil.
+func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetActivityId() bool {
+	return v != nil && v.ActivityId != nil
+}
+
 // GetCause returns the value of Cause if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetCause() (o CancelExternalWorkflowExecutionFailedCause) {
+func (v *RequestCancelActivityTaskFailedEventAttributes) GetCause() (o string) {
 	if v != nil && v.Cause != nil {
 		return *v.Cause
 	}
@@ -64980,13 +67780,13 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetCause()
 }
 
 // IsSetCause returns true if Cause is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetCause() bool {
+func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetCause() bool {
 	return v != nil && v.Cause != nil
 }
 
 // GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+func (v *RequestCancelActivityTaskFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
 	if v != nil && v.DecisionTaskCompletedEventId != nil {
 		return *v.DecisionTaskCompletedEventId
 	}
@@ -64995,79 +67795,19 @@ func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDecisio
 }
 
 // IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+func (v *RequestCancelActivityTaskFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
 	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
-// GetDomain returns the value of Domain if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
-	}
-
-	return
-}
-
-// IsSetDomain returns true if Domain is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
-}
-
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
-	}
-
-	return
-}
-
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
-}
-
-// GetInitiatedEventId returns the value of InitiatedEventId if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() (o int64) {
-	if v != nil && v.InitiatedEventId != nil {
-		return *v.InitiatedEventId
-	}
-
-	return
-}
-
-// IsSetInitiatedEventId returns true if InitiatedEventId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetInitiatedEventId() bool {
-	return v != nil && v.InitiatedEventId != nil
-}
-
-// GetControl returns the value of Control if it is set or its
-// zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetControl() (o []byte) {
-	if v != nil && v.Control != nil {
-		return v.Control
-	}
-
-	return
-}
-
-// IsSetControl returns true if Control is not nil.
-func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetControl() bool {
-	return v != nil && v.Control != nil
-}
-
-type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
-	DecisionTaskCompletedEventId *int64             `json:"decisionTaskCompletedEventId,omitempty"`
-	Domain                       *string            `json:"domain,omitempty"`
-	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Control                      []byte             `json:"control,omitempty"`
-	ChildWorkflowOnly            *bool              `json:"childWorkflowOnly,omitempty"`
+type RequestCancelExternalWorkflowExecutionDecisionAttributes struct {
+	Domain            *string `json:"domain,omitempty"`
+	WorkflowId        *string `json:"workflowId,omitempty"`
+	RunId             *string `json:"runId,omitempty"`
+	Control           []byte  `json:"control,omitempty"`
+	ChildWorkflowOnly *bool   `json:"childWorkflowOnly,omitempty"`
 }
 
-// ToWire translates a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelExternalWorkflowExecutionDecisionAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -65082,7 +67822,7 @@ type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Value, error) {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) ToWire() (wire.Value, error) {
 	var (
 		fields [5]wire.Field
 		i      int = 0
@@ -65090,24 +67830,24 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire(
 		err    error
 	)
 
-	if v.DecisionTaskCompletedEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.WorkflowId != nil {
+		w, err = wire.NewValueString(*(v.WorkflowId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
@@ -65134,11 +67874,11 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire(
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct from its Thrift-level
+// FromWire deserializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct
+// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionDecisionAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -65146,21 +67886,21 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire(
 //     return nil, err
 //   }
 //
-//   var v RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+//   var v RequestCancelExternalWorkflowExecutionDecisionAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWire(w wire.Value) error {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.DecisionTaskCompletedEventId = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
@@ -65170,15 +67910,17 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWir
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+				v.WorkflowId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RunId = &x
 				if err != nil {
 					return err
 				}
@@ -65208,20 +67950,20 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWir
 	return nil
 }
 
-// Encode serializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct directly into bytes, without going
+// Encode serializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct could not be encoded.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelExternalWorkflowExecutionDecisionAttributes struct could not be encoded.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.DecisionTaskCompletedEventId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -65229,11 +67971,11 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(
 		}
 	}
 
-	if v.Domain != nil {
+	if v.WorkflowId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
+		if err := sw.WriteString(*(v.WorkflowId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -65241,11 +67983,11 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(
 		}
 	}
 
-	if v.WorkflowExecution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
+	if v.RunId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := v.WorkflowExecution.Encode(sw); err != nil {
+		if err := sw.WriteString(*(v.RunId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -65280,12 +68022,12 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct directly from its Thrift-level
+// Decode deserializes a RequestCancelExternalWorkflowExecutionDecisionAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct could not be generated from the wire
+// An error is returned if a RequestCancelExternalWorkflowExecutionDecisionAttributes struct could not be generated from the wire
 // representation.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(sr stream.Reader) error {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -65298,10 +68040,10 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.DecisionTaskCompletedEventId = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
 			if err != nil {
 				return err
 			}
@@ -65309,13 +68051,15 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(
 		case fh.ID == 20 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.Domain = &x
+			v.WorkflowId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TStruct:
-			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RunId = &x
 			if err != nil {
 				return err
 			}
@@ -65356,25 +68100,25 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionDecisionAttributes
 // struct.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String() string {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [5]string
 	i := 0
-	if v.DecisionTaskCompletedEventId != nil {
-		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
-		i++
-	}
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+	if v.WorkflowId != nil {
+		fields[i] = fmt.Sprintf("WorkflowId: %v", *(v.WorkflowId))
+		i++
+	}
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
 	if v.Control != nil {
@@ -65386,26 +68130,26 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String(
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionDecisionAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionInitiatedEventAttributes match the
-// provided RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
+// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionDecisionAttributes match the
+// provided RequestCancelExternalWorkflowExecutionDecisionAttributes.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) bool {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionDecisionAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+	if !_String_EqualsPtr(v.WorkflowId, rhs.WorkflowId) {
 		return false
 	}
-	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
 	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
@@ -65419,19 +68163,19 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelExternalWorkflowExecutionDecisionAttributes.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.DecisionTaskCompletedEventId != nil {
-		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
-	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
-	if v.WorkflowExecution != nil {
-		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	if v.WorkflowId != nil {
+		enc.AddString("workflowId", *v.WorkflowId)
+	}
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
 	}
 	if v.Control != nil {
 		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
@@ -65442,54 +68186,54 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Marshal
 	return err
 }
 
-// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
-	if v != nil && v.DecisionTaskCompletedEventId != nil {
-		return *v.DecisionTaskCompletedEventId
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
-	return v != nil && v.DecisionTaskCompletedEventId != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetDomain returns the value of Domain if it is set or its
+// GetWorkflowId returns the value of WorkflowId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetWorkflowId() (o string) {
+	if v != nil && v.WorkflowId != nil {
+		return *v.WorkflowId
 	}
 
 	return
 }
 
-// IsSetDomain returns true if Domain is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
+// IsSetWorkflowId returns true if WorkflowId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetWorkflowId() bool {
+	return v != nil && v.WorkflowId != nil
 }
 
-// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
-	if v != nil && v.WorkflowExecution != nil {
-		return v.WorkflowExecution
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetWorkflowExecution() bool {
-	return v != nil && v.WorkflowExecution != nil
+// IsSetRunId returns true if RunId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
 }
 
 // GetControl returns the value of Control if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetControl() (o []byte) {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetControl() (o []byte) {
 	if v != nil && v.Control != nil {
 		return v.Control
 	}
@@ -65498,13 +68242,13 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetCont
 }
 
 // IsSetControl returns true if Control is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetControl() bool {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetControl() bool {
 	return v != nil && v.Control != nil
 }
 
 // GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) GetChildWorkflowOnly() (o bool) {
 	if v != nil && v.ChildWorkflowOnly != nil {
 		return *v.ChildWorkflowOnly
 	}
@@ -65513,18 +68257,20 @@ func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChil
 }
 
 // IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
-func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetChildWorkflowOnly() bool {
+func (v *RequestCancelExternalWorkflowExecutionDecisionAttributes) IsSetChildWorkflowOnly() bool {
 	return v != nil && v.ChildWorkflowOnly != nil
 }
 
-type RequestCancelWorkflowExecutionRequest struct {
-	Domain            *string            `json:"domain,omitempty"`
-	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Identity          *string            `json:"identity,omitempty"`
-	RequestId         *string            `json:"requestId,omitempty"`
+type RequestCancelExternalWorkflowExecutionFailedEventAttributes struct {
+	Cause                        *CancelExternalWorkflowExecutionFailedCause `json:"cause,omitempty"`
+	DecisionTaskCompletedEventId *int64                                      `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       *string                                     `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution                          `json:"workflowExecution,omitempty"`
+	InitiatedEventId             *int64                                      `json:"initiatedEventId,omitempty"`
+	Control                      []byte                                      `json:"control,omitempty"`
 }
 
-// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -65539,55 +68285,77 @@ type RequestCancelWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.Cause != nil {
+		w, err = v.Cause.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Identity != nil {
-		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.InitiatedEventId != nil {
+		w, err = wire.NewValueI64(*(v.InitiatedEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
+func _CancelExternalWorkflowExecutionFailedCause_Read(w wire.Value) (CancelExternalWorkflowExecutionFailedCause, error) {
+	var v CancelExternalWorkflowExecutionFailedCause
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -65595,29 +68363,31 @@ func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v RequestCancelWorkflowExecutionRequest
+//   var v RequestCancelExternalWorkflowExecutionFailedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
+			if field.Value.Type() == wire.TI32 {
+				var x CancelExternalWorkflowExecutionFailedCause
+				x, err = _CancelExternalWorkflowExecutionFailedCause_Read(field.Value)
+				v.Cause = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
@@ -65627,17 +68397,33 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.Identity = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.InitiatedEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.RequestId = &x
+				v.Control, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
@@ -65649,17 +68435,41 @@ func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a RequestCancelWorkflowExecutionRequest struct directly into bytes, without going
+// Encode serializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a RequestCancelWorkflowExecutionRequest struct could not be encoded.
-func (v *RequestCancelWorkflowExecutionRequest) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct could not be encoded.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
+	if v.Cause != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := v.Cause.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
 			return err
 		}
 		if err := sw.WriteString(*(v.Domain)); err != nil {
@@ -65671,7 +68481,7 @@ func (v *RequestCancelWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 	}
 
 	if v.WorkflowExecution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TStruct}); err != nil {
 			return err
 		}
 		if err := v.WorkflowExecution.Encode(sw); err != nil {
@@ -65682,11 +68492,11 @@ func (v *RequestCancelWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Identity != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+	if v.InitiatedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Identity)); err != nil {
+		if err := sw.WriteInt64(*(v.InitiatedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -65694,11 +68504,11 @@ func (v *RequestCancelWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RequestId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+	if v.Control != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RequestId)); err != nil {
+		if err := sw.WriteBinary(v.Control); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -65709,12 +68519,18 @@ func (v *RequestCancelWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a RequestCancelWorkflowExecutionRequest struct directly from its Thrift-level
+func _CancelExternalWorkflowExecutionFailedCause_Decode(sr stream.Reader) (CancelExternalWorkflowExecutionFailedCause, error) {
+	var v CancelExternalWorkflowExecutionFailedCause
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a RequestCancelWorkflowExecutionRequest struct could not be generated from the wire
+// An error is returned if a RequestCancelExternalWorkflowExecutionFailedEventAttributes struct could not be generated from the wire
 // representation.
-func (v *RequestCancelWorkflowExecutionRequest) Decode(sr stream.Reader) error {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -65727,7 +68543,23 @@ func (v *RequestCancelWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x CancelExternalWorkflowExecutionFailedCause
+			x, err = _CancelExternalWorkflowExecutionFailedCause_Decode(sr)
+			v.Cause = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionTaskCompletedEventId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
 			v.Domain = &x
@@ -65735,24 +68567,22 @@ func (v *RequestCancelWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TStruct:
+		case fh.ID == 40 && fh.Type == wire.TStruct:
 			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Identity = &x
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.InitiatedEventId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.RequestId = &x
+		case fh.ID == 60 && fh.Type == wire.TBinary:
+			v.Control, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
@@ -65779,15 +68609,23 @@ func (v *RequestCancelWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
+// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionFailedEventAttributes
 // struct.
-func (v *RequestCancelWorkflowExecutionRequest) String() string {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [6]string
 	i := 0
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", *(v.Cause))
+		i++
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
+		i++
+	}
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
@@ -65796,38 +68634,54 @@ func (v *RequestCancelWorkflowExecutionRequest) String() string {
 		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.Identity != nil {
-		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+	if v.InitiatedEventId != nil {
+		fields[i] = fmt.Sprintf("InitiatedEventId: %v", *(v.InitiatedEventId))
 		i++
 	}
-	if v.RequestId != nil {
-		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
 		i++
 	}
 
-	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionFailedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
-// provided RequestCancelWorkflowExecutionRequest.
+func _CancelExternalWorkflowExecutionFailedCause_EqualsPtr(lhs, rhs *CancelExternalWorkflowExecutionFailedCause) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionFailedEventAttributes match the
+// provided RequestCancelExternalWorkflowExecutionFailedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionFailedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !_CancelExternalWorkflowExecutionFailedCause_EqualsPtr(v.Cause, rhs.Cause) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
+		return false
+	}
 	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
 	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+	if !_I64_EqualsPtr(v.InitiatedEventId, rhs.InitiatedEventId) {
 		return false
 	}
-	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
 		return false
 	}
 
@@ -65835,29 +68689,65 @@ func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflo
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of RequestCancelWorkflowExecutionRequest.
-func (v *RequestCancelWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelExternalWorkflowExecutionFailedEventAttributes.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Cause != nil {
+		err = multierr.Append(err, enc.AddObject("cause", *v.Cause))
+	}
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
+	}
 	if v.Domain != nil {
 		enc.AddString("domain", *v.Domain)
 	}
 	if v.WorkflowExecution != nil {
 		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
-	if v.Identity != nil {
-		enc.AddString("identity", *v.Identity)
+	if v.InitiatedEventId != nil {
+		enc.AddInt64("initiatedEventId", *v.InitiatedEventId)
 	}
-	if v.RequestId != nil {
-		enc.AddString("requestId", *v.RequestId)
+	if v.Control != nil {
+		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
 	}
 	return err
 }
 
+// GetCause returns the value of Cause if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetCause() (o CancelExternalWorkflowExecutionFailedCause) {
+	if v != nil && v.Cause != nil {
+		return *v.Cause
+	}
+
+	return
+}
+
+// IsSetCause returns true if Cause is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetCause() bool {
+	return v != nil && v.Cause != nil
+}
+
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
+	}
+
+	return
+}
+
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
+}
+
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -65866,13 +68756,13 @@ func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetDomain() bool {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
 // GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
 	if v != nil && v.WorkflowExecution != nil {
 		return v.WorkflowExecution
 	}
@@ -65881,50 +68771,49 @@ func (v *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() (o *Workf
 }
 
 // IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetWorkflowExecution() bool {
 	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetIdentity returns the value of Identity if it is set or its
+// GetInitiatedEventId returns the value of InitiatedEventId if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetIdentity() (o string) {
-	if v != nil && v.Identity != nil {
-		return *v.Identity
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetInitiatedEventId() (o int64) {
+	if v != nil && v.InitiatedEventId != nil {
+		return *v.InitiatedEventId
 	}
 
 	return
 }
 
-// IsSetIdentity returns true if Identity is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetIdentity() bool {
-	return v != nil && v.Identity != nil
+// IsSetInitiatedEventId returns true if InitiatedEventId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetInitiatedEventId() bool {
+	return v != nil && v.InitiatedEventId != nil
 }
 
-// GetRequestId returns the value of RequestId if it is set or its
+// GetControl returns the value of Control if it is set or its
 // zero value if it is unset.
-func (v *RequestCancelWorkflowExecutionRequest) GetRequestId() (o string) {
-	if v != nil && v.RequestId != nil {
-		return *v.RequestId
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) GetControl() (o []byte) {
+	if v != nil && v.Control != nil {
+		return v.Control
 	}
 
 	return
 }
 
-// IsSetRequestId returns true if RequestId is not nil.
-func (v *RequestCancelWorkflowExecutionRequest) IsSetRequestId() bool {
-	return v != nil && v.RequestId != nil
+// IsSetControl returns true if Control is not nil.
+func (v *RequestCancelExternalWorkflowExecutionFailedEventAttributes) IsSetControl() bool {
+	return v != nil && v.Control != nil
 }
 
-type ResetPointInfo struct {
-	BinaryChecksum           *string `json:"binaryChecksum,omitempty"`
-	RunId                    *string `json:"runId,omitempty"`
-	FirstDecisionCompletedId *int64  `json:"firstDecisionCompletedId,omitempty"`
-	CreatedTimeNano          *int64  `json:"createdTimeNano,omitempty"`
-	ExpiringTimeNano         *int64  `json:"expiringTimeNano,omitempty"`
-	Resettable               *bool   `json:"resettable,omitempty"`
+type RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct {
+	DecisionTaskCompletedEventId *int64             `json:"decisionTaskCompletedEventId,omitempty"`
+	Domain                       *string            `json:"domain,omitempty"`
+	WorkflowExecution            *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Control                      []byte             `json:"control,omitempty"`
+	ChildWorkflowOnly            *bool              `json:"childWorkflowOnly,omitempty"`
 }
 
-// ToWire translates a ResetPointInfo struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -65939,71 +68828,63 @@ type ResetPointInfo struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetPointInfo) ToWire() (wire.Value, error) {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.BinaryChecksum != nil {
-		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
+	if v.DecisionTaskCompletedEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionTaskCompletedEventId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.RunId != nil {
-		w, err = wire.NewValueString(*(v.RunId)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.FirstDecisionCompletedId != nil {
-		w, err = wire.NewValueI64(*(v.FirstDecisionCompletedId)), error(nil)
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
-	if v.CreatedTimeNano != nil {
-		w, err = wire.NewValueI64(*(v.CreatedTimeNano)), error(nil)
+	if v.Control != nil {
+		w, err = wire.NewValueBinary(v.Control), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
-	if v.ExpiringTimeNano != nil {
-		w, err = wire.NewValueI64(*(v.ExpiringTimeNano)), error(nil)
+	if v.ChildWorkflowOnly != nil {
+		w, err = wire.NewValueBool(*(v.ChildWorkflowOnly)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 50, Value: w}
 		i++
 	}
-	if v.Resettable != nil {
-		w, err = wire.NewValueBool(*(v.Resettable)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetPointInfo struct from its Thrift-level
+// FromWire deserializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetPointInfo struct
+// An error is returned if we were unable to build a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -66011,21 +68892,21 @@ func (v *ResetPointInfo) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetPointInfo
+//   var v RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetPointInfo) FromWire(w wire.Value) error {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.BinaryChecksum = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.DecisionTaskCompletedEventId = &x
 				if err != nil {
 					return err
 				}
@@ -66035,47 +68916,33 @@ func (v *ResetPointInfo) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.RunId = &x
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstDecisionCompletedId = &x
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.CreatedTimeNano = &x
+			if field.Value.Type() == wire.TBinary {
+				v.Control, err = field.Value.GetBinary(), error(nil)
 				if err != nil {
 					return err
 				}
 
 			}
 		case 50:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.ExpiringTimeNano = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
 			if field.Value.Type() == wire.TBool {
 				var x bool
 				x, err = field.Value.GetBool(), error(nil)
-				v.Resettable = &x
+				v.ChildWorkflowOnly = &x
 				if err != nil {
 					return err
 				}
@@ -66087,20 +68954,20 @@ func (v *ResetPointInfo) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ResetPointInfo struct directly into bytes, without going
+// Encode serializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResetPointInfo struct could not be encoded.
-func (v *ResetPointInfo) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct could not be encoded.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.BinaryChecksum != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.DecisionTaskCompletedEventId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.BinaryChecksum)); err != nil {
+		if err := sw.WriteInt64(*(v.DecisionTaskCompletedEventId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66108,23 +68975,11 @@ func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.RunId != nil {
+	if v.Domain != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.RunId)); err != nil {
-			return err
-		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
-		}
-	}
-
-	if v.FirstDecisionCompletedId != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
-			return err
-		}
-		if err := sw.WriteInt64(*(v.FirstDecisionCompletedId)); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66132,11 +68987,11 @@ func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.CreatedTimeNano != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TStruct}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.CreatedTimeNano)); err != nil {
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66144,11 +68999,11 @@ func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ExpiringTimeNano != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+	if v.Control != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.ExpiringTimeNano)); err != nil {
+		if err := sw.WriteBinary(v.Control); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66156,11 +69011,11 @@ func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Resettable != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBool}); err != nil {
+	if v.ChildWorkflowOnly != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBool}); err != nil {
 			return err
 		}
-		if err := sw.WriteBool(*(v.Resettable)); err != nil {
+		if err := sw.WriteBool(*(v.ChildWorkflowOnly)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66171,12 +69026,12 @@ func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ResetPointInfo struct directly from its Thrift-level
+// Decode deserializes a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResetPointInfo struct could not be generated from the wire
+// An error is returned if a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes struct could not be generated from the wire
 // representation.
-func (v *ResetPointInfo) Decode(sr stream.Reader) error {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -66189,10 +69044,10 @@ func (v *ResetPointInfo) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.BinaryChecksum = &x
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.DecisionTaskCompletedEventId = &x
 			if err != nil {
 				return err
 			}
@@ -66200,39 +69055,27 @@ func (v *ResetPointInfo) Decode(sr stream.Reader) error {
 		case fh.ID == 20 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.RunId = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 30 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.FirstDecisionCompletedId = &x
+			v.Domain = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 40 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.CreatedTimeNano = &x
+		case fh.ID == 30 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 50 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.ExpiringTimeNano = &x
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			v.Control, err = sr.ReadBinary()
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 60 && fh.Type == wire.TBool:
+		case fh.ID == 50 && fh.Type == wire.TBool:
 			var x bool
 			x, err = sr.ReadBool()
-			v.Resettable = &x
+			v.ChildWorkflowOnly = &x
 			if err != nil {
 				return err
 			}
@@ -66259,69 +69102,62 @@ func (v *ResetPointInfo) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetPointInfo
+// String returns a readable string representation of a RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
 // struct.
-func (v *ResetPointInfo) String() string {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [5]string
 	i := 0
-	if v.BinaryChecksum != nil {
-		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
-		i++
-	}
-	if v.RunId != nil {
-		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
+	if v.DecisionTaskCompletedEventId != nil {
+		fields[i] = fmt.Sprintf("DecisionTaskCompletedEventId: %v", *(v.DecisionTaskCompletedEventId))
 		i++
 	}
-	if v.FirstDecisionCompletedId != nil {
-		fields[i] = fmt.Sprintf("FirstDecisionCompletedId: %v", *(v.FirstDecisionCompletedId))
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-	if v.CreatedTimeNano != nil {
-		fields[i] = fmt.Sprintf("CreatedTimeNano: %v", *(v.CreatedTimeNano))
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
 		i++
 	}
-	if v.ExpiringTimeNano != nil {
-		fields[i] = fmt.Sprintf("ExpiringTimeNano: %v", *(v.ExpiringTimeNano))
+	if v.Control != nil {
+		fields[i] = fmt.Sprintf("Control: %v", v.Control)
 		i++
 	}
-	if v.Resettable != nil {
-		fields[i] = fmt.Sprintf("Resettable: %v", *(v.Resettable))
+	if v.ChildWorkflowOnly != nil {
+		fields[i] = fmt.Sprintf("ChildWorkflowOnly: %v", *(v.ChildWorkflowOnly))
 		i++
 	}
 
-	return fmt.Sprintf("ResetPointInfo{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetPointInfo match the
-// provided ResetPointInfo.
+// Equals returns true if all the fields of this RequestCancelExternalWorkflowExecutionInitiatedEventAttributes match the
+// provided RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
 //
 // This function performs a deep comparison.
-func (v *ResetPointInfo) Equals(rhs *ResetPointInfo) bool {
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) Equals(rhs *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
-		return false
-	}
-	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
+	if !_I64_EqualsPtr(v.DecisionTaskCompletedEventId, rhs.DecisionTaskCompletedEventId) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FirstDecisionCompletedId, rhs.FirstDecisionCompletedId) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.CreatedTimeNano, rhs.CreatedTimeNano) {
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.ExpiringTimeNano, rhs.ExpiringTimeNano) {
+	if !((v.Control == nil && rhs.Control == nil) || (v.Control != nil && rhs.Control != nil && bytes.Equal(v.Control, rhs.Control))) {
 		return false
 	}
-	if !_Bool_EqualsPtr(v.Resettable, rhs.Resettable) {
+	if !_Bool_EqualsPtr(v.ChildWorkflowOnly, rhs.ChildWorkflowOnly) {
 		return false
 	}
 
@@ -66329,156 +69165,112 @@ func (v *ResetPointInfo) Equals(rhs *ResetPointInfo) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetPointInfo.
-func (v *ResetPointInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelExternalWorkflowExecutionInitiatedEventAttributes.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.BinaryChecksum != nil {
-		enc.AddString("binaryChecksum", *v.BinaryChecksum)
-	}
-	if v.RunId != nil {
-		enc.AddString("runId", *v.RunId)
+	if v.DecisionTaskCompletedEventId != nil {
+		enc.AddInt64("decisionTaskCompletedEventId", *v.DecisionTaskCompletedEventId)
 	}
-	if v.FirstDecisionCompletedId != nil {
-		enc.AddInt64("firstDecisionCompletedId", *v.FirstDecisionCompletedId)
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
 	}
-	if v.CreatedTimeNano != nil {
-		enc.AddInt64("createdTimeNano", *v.CreatedTimeNano)
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
 	}
-	if v.ExpiringTimeNano != nil {
-		enc.AddInt64("expiringTimeNano", *v.ExpiringTimeNano)
+	if v.Control != nil {
+		enc.AddString("control", base64.StdEncoding.EncodeToString(v.Control))
 	}
-	if v.Resettable != nil {
-		enc.AddBool("resettable", *v.Resettable)
+	if v.ChildWorkflowOnly != nil {
+		enc.AddBool("childWorkflowOnly", *v.ChildWorkflowOnly)
 	}
 	return err
 }
 
-// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
-// zero value if it is unset.
-func (v *ResetPointInfo) GetBinaryChecksum() (o string) {
-	if v != nil && v.BinaryChecksum != nil {
-		return *v.BinaryChecksum
-	}
-
-	return
-}
-
-// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
-func (v *ResetPointInfo) IsSetBinaryChecksum() bool {
-	return v != nil && v.BinaryChecksum != nil
-}
-
-// GetRunId returns the value of RunId if it is set or its
+// GetDecisionTaskCompletedEventId returns the value of DecisionTaskCompletedEventId if it is set or its
 // zero value if it is unset.
-func (v *ResetPointInfo) GetRunId() (o string) {
-	if v != nil && v.RunId != nil {
-		return *v.RunId
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDecisionTaskCompletedEventId() (o int64) {
+	if v != nil && v.DecisionTaskCompletedEventId != nil {
+		return *v.DecisionTaskCompletedEventId
 	}
 
 	return
 }
 
-// IsSetRunId returns true if RunId is not nil.
-func (v *ResetPointInfo) IsSetRunId() bool {
-	return v != nil && v.RunId != nil
+// IsSetDecisionTaskCompletedEventId returns true if DecisionTaskCompletedEventId is not nil.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetDecisionTaskCompletedEventId() bool {
+	return v != nil && v.DecisionTaskCompletedEventId != nil
 }
 
-// GetFirstDecisionCompletedId returns the value of FirstDecisionCompletedId if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *ResetPointInfo) GetFirstDecisionCompletedId() (o int64) {
-	if v != nil && v.FirstDecisionCompletedId != nil {
-		return *v.FirstDecisionCompletedId
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetFirstDecisionCompletedId returns true if FirstDecisionCompletedId is not nil.
-func (v *ResetPointInfo) IsSetFirstDecisionCompletedId() bool {
-	return v != nil && v.FirstDecisionCompletedId != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-// GetCreatedTimeNano returns the value of CreatedTimeNano if it is set or its
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
 // zero value if it is unset.
-func (v *ResetPointInfo) GetCreatedTimeNano() (o int64) {
-	if v != nil && v.CreatedTimeNano != nil {
-		return *v.CreatedTimeNano
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
 	}
 
 	return
 }
 
-// IsSetCreatedTimeNano returns true if CreatedTimeNano is not nil.
-func (v *ResetPointInfo) IsSetCreatedTimeNano() bool {
-	return v != nil && v.CreatedTimeNano != nil
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
 }
 
-// GetExpiringTimeNano returns the value of ExpiringTimeNano if it is set or its
+// GetControl returns the value of Control if it is set or its
 // zero value if it is unset.
-func (v *ResetPointInfo) GetExpiringTimeNano() (o int64) {
-	if v != nil && v.ExpiringTimeNano != nil {
-		return *v.ExpiringTimeNano
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetControl() (o []byte) {
+	if v != nil && v.Control != nil {
+		return v.Control
 	}
 
 	return
 }
 
-// IsSetExpiringTimeNano returns true if ExpiringTimeNano is not nil.
-func (v *ResetPointInfo) IsSetExpiringTimeNano() bool {
-	return v != nil && v.ExpiringTimeNano != nil
+// IsSetControl returns true if Control is not nil.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetControl() bool {
+	return v != nil && v.Control != nil
 }
 
-// GetResettable returns the value of Resettable if it is set or its
+// GetChildWorkflowOnly returns the value of ChildWorkflowOnly if it is set or its
 // zero value if it is unset.
-func (v *ResetPointInfo) GetResettable() (o bool) {
-	if v != nil && v.Resettable != nil {
-		return *v.Resettable
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) GetChildWorkflowOnly() (o bool) {
+	if v != nil && v.ChildWorkflowOnly != nil {
+		return *v.ChildWorkflowOnly
 	}
 
 	return
 }
 
-// IsSetResettable returns true if Resettable is not nil.
-func (v *ResetPointInfo) IsSetResettable() bool {
-	return v != nil && v.Resettable != nil
-}
-
-type ResetPoints struct {
-	Points []*ResetPointInfo `json:"points,omitempty"`
-}
-
-type _List_ResetPointInfo_ValueList []*ResetPointInfo
-
-func (v _List_ResetPointInfo_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid list '[]*ResetPointInfo', index [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (v _List_ResetPointInfo_ValueList) Size() int {
-	return len(v)
+// IsSetChildWorkflowOnly returns true if ChildWorkflowOnly is not nil.
+func (v *RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) IsSetChildWorkflowOnly() bool {
+	return v != nil && v.ChildWorkflowOnly != nil
 }
 
-func (_List_ResetPointInfo_ValueList) ValueType() wire.Type {
-	return wire.TStruct
+type RequestCancelWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+	RequestId         *string            `json:"requestId,omitempty"`
 }
 
-func (_List_ResetPointInfo_ValueList) Close() {}
-
-// ToWire translates a ResetPoints struct into a Thrift-level intermediate
+// ToWire translates a RequestCancelWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -66493,55 +69285,55 @@ func (_List_ResetPointInfo_ValueList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetPoints) ToWire() (wire.Value, error) {
+func (v *RequestCancelWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Points != nil {
-		w, err = wire.NewValueList(_List_ResetPointInfo_ValueList(v.Points)), error(nil)
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _ResetPointInfo_Read(w wire.Value) (*ResetPointInfo, error) {
-	var v ResetPointInfo
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_ResetPointInfo_Read(l wire.ValueList) ([]*ResetPointInfo, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
 	}
-
-	o := make([]*ResetPointInfo, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _ResetPointInfo_Read(x)
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
 		if err != nil {
-			return err
+			return w, err
 		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetPoints struct from its Thrift-level
+// FromWire deserializes a RequestCancelWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetPoints struct
+// An error is returned if we were unable to build a RequestCancelWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -66549,65 +69341,74 @@ func _List_ResetPointInfo_Read(l wire.ValueList) ([]*ResetPointInfo, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetPoints
+//   var v RequestCancelWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetPoints) FromWire(w wire.Value) error {
+func (v *RequestCancelWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TList {
-				v.Points, err = _List_ResetPointInfo_Read(field.Value.GetList())
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
 				if err != nil {
 					return err
 				}
 
 			}
-		}
-	}
-
-	return nil
-}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
 
-func _List_ResetPointInfo_Encode(val []*ResetPointInfo, sw stream.Writer) error {
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
 
-	lh := stream.ListHeader{
-		Type:   wire.TStruct,
-		Length: len(val),
-	}
-	if err := sw.WriteListBegin(lh); err != nil {
-		return err
-	}
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
 
-	for i, v := range val {
-		if v == nil {
-			return fmt.Errorf("invalid list '[]*ResetPointInfo', index [%v]: value is nil", i)
-		}
-		if err := v.Encode(sw); err != nil {
-			return err
+			}
 		}
 	}
-	return sw.WriteListEnd()
+
+	return nil
 }
 
-// Encode serializes a ResetPoints struct directly into bytes, without going
+// Encode serializes a RequestCancelWorkflowExecutionRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResetPoints struct could not be encoded.
-func (v *ResetPoints) Encode(sw stream.Writer) error {
+// An error is returned if a RequestCancelWorkflowExecutionRequest struct could not be encoded.
+func (v *RequestCancelWorkflowExecutionRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Points != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := _List_ResetPointInfo_Encode(v.Points, sw); err != nil {
+		if err := sw.WriteString(*(v.Domain)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66615,51 +69416,51 @@ func (v *ResetPoints) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
-
-func _ResetPointInfo_Decode(sr stream.Reader) (*ResetPointInfo, error) {
-	var v ResetPointInfo
-	err := v.Decode(sr)
-	return &v, err
-}
-
-func _List_ResetPointInfo_Decode(sr stream.Reader) ([]*ResetPointInfo, error) {
-	lh, err := sr.ReadListBegin()
-	if err != nil {
-		return nil, err
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
 	}
 
-	if lh.Type != wire.TStruct {
-		for i := 0; i < lh.Length; i++ {
-			if err := sr.Skip(lh.Type); err != nil {
-				return nil, err
-			}
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		return nil, sr.ReadListEnd()
 	}
 
-	o := make([]*ResetPointInfo, 0, lh.Length)
-	for i := 0; i < lh.Length; i++ {
-		v, err := _ResetPointInfo_Decode(sr)
-		if err != nil {
-			return nil, err
+	if v.RequestId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RequestId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
 		}
-		o = append(o, v)
 	}
 
-	if err = sr.ReadListEnd(); err != nil {
-		return nil, err
-	}
-	return o, err
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ResetPoints struct directly from its Thrift-level
+// Decode deserializes a RequestCancelWorkflowExecutionRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResetPoints struct could not be generated from the wire
+// An error is returned if a RequestCancelWorkflowExecutionRequest struct could not be generated from the wire
 // representation.
-func (v *ResetPoints) Decode(sr stream.Reader) error {
+func (v *RequestCancelWorkflowExecutionRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -66672,8 +69473,32 @@ func (v *ResetPoints) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TList:
-			v.Points, err = _List_ResetPointInfo_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RequestId = &x
 			if err != nil {
 				return err
 			}
@@ -66700,155 +69525,231 @@ func (v *ResetPoints) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetPoints
+// String returns a readable string representation of a RequestCancelWorkflowExecutionRequest
 // struct.
-func (v *ResetPoints) String() string {
+func (v *RequestCancelWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [4]string
 	i := 0
-	if v.Points != nil {
-		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
 		i++
 	}
-
-	return fmt.Sprintf("ResetPoints{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_ResetPointInfo_Equals(lhs, rhs []*ResetPointInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
 	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
 	}
 
-	return true
+	return fmt.Sprintf("RequestCancelWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetPoints match the
-// provided ResetPoints.
+// Equals returns true if all the fields of this RequestCancelWorkflowExecutionRequest match the
+// provided RequestCancelWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetPoints) Equals(rhs *ResetPoints) bool {
+func (v *RequestCancelWorkflowExecutionRequest) Equals(rhs *RequestCancelWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_ResetPointInfo_Equals(v.Points, rhs.Points))) {
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
 		return false
 	}
 
 	return true
 }
 
-type _List_ResetPointInfo_Zapper []*ResetPointInfo
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_ResetPointInfo_Zapper.
-func (l _List_ResetPointInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
-	}
-	return err
-}
-
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetPoints.
-func (v *ResetPoints) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of RequestCancelWorkflowExecutionRequest.
+func (v *RequestCancelWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Points != nil {
-		err = multierr.Append(err, enc.AddArray("points", (_List_ResetPointInfo_Zapper)(v.Points)))
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
 	}
 	return err
 }
 
-// GetPoints returns the value of Points if it is set or its
+// GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *ResetPoints) GetPoints() (o []*ResetPointInfo) {
-	if v != nil && v.Points != nil {
-		return v.Points
+func (v *RequestCancelWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
 	}
 
 	return
 }
 
-// IsSetPoints returns true if Points is not nil.
-func (v *ResetPoints) IsSetPoints() bool {
-	return v != nil && v.Points != nil
+// IsSetDomain returns true if Domain is not nil.
+func (v *RequestCancelWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
 }
 
-type ResetQueueRequest struct {
-	ShardID     *int32  `json:"shardID,omitempty"`
-	ClusterName *string `json:"clusterName,omitempty"`
-	Type        *int32  `json:"type,omitempty"`
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
 }
 
-// ToWire translates a ResetQueueRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// An error is returned if the struct or any of its fields failed to
-// validate.
-//
-//   x, err := v.ToWire()
-//   if err != nil {
-//     return err
-//   }
-//
-//   if err := binaryProtocol.Encode(x, writer); err != nil {
-//     return err
-//   }
-func (v *ResetQueueRequest) ToWire() (wire.Value, error) {
-	var (
-		fields [3]wire.Field
-		i      int = 0
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *RequestCancelWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *RequestCancelWorkflowExecutionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *RequestCancelWorkflowExecutionRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *RequestCancelWorkflowExecutionRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
+type ResetPointInfo struct {
+	BinaryChecksum           *string `json:"binaryChecksum,omitempty"`
+	RunId                    *string `json:"runId,omitempty"`
+	FirstDecisionCompletedId *int64  `json:"firstDecisionCompletedId,omitempty"`
+	CreatedTimeNano          *int64  `json:"createdTimeNano,omitempty"`
+	ExpiringTimeNano         *int64  `json:"expiringTimeNano,omitempty"`
+	Resettable               *bool   `json:"resettable,omitempty"`
+}
+
+// ToWire translates a ResetPointInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetPointInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ShardID != nil {
-		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+	if v.BinaryChecksum != nil {
+		w, err = wire.NewValueString(*(v.BinaryChecksum)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.ClusterName != nil {
-		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+	if v.RunId != nil {
+		w, err = wire.NewValueString(*(v.RunId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Type != nil {
-		w, err = wire.NewValueI32(*(v.Type)), error(nil)
+	if v.FirstDecisionCompletedId != nil {
+		w, err = wire.NewValueI64(*(v.FirstDecisionCompletedId)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.CreatedTimeNano != nil {
+		w, err = wire.NewValueI64(*(v.CreatedTimeNano)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.ExpiringTimeNano != nil {
+		w, err = wire.NewValueI64(*(v.ExpiringTimeNano)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.Resettable != nil {
+		w, err = wire.NewValueBool(*(v.Resettable)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetQueueRequest struct from its Thrift-level
+// FromWire deserializes a ResetPointInfo struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetQueueRequest struct
+// An error is returned if we were unable to build a ResetPointInfo struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -66856,21 +69757,21 @@ func (v *ResetQueueRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetQueueRequest
+//   var v ResetPointInfo
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetQueueRequest) FromWire(w wire.Value) error {
+func (v *ResetPointInfo) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.ShardID = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.BinaryChecksum = &x
 				if err != nil {
 					return err
 				}
@@ -66880,17 +69781,47 @@ func (v *ResetQueueRequest) FromWire(w wire.Value) error {
 			if field.Value.Type() == wire.TBinary {
 				var x string
 				x, err = field.Value.GetString(), error(nil)
-				v.ClusterName = &x
+				v.RunId = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 30:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.Type = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstDecisionCompletedId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.CreatedTimeNano = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.ExpiringTimeNano = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBool {
+				var x bool
+				x, err = field.Value.GetBool(), error(nil)
+				v.Resettable = &x
 				if err != nil {
 					return err
 				}
@@ -66902,20 +69833,20 @@ func (v *ResetQueueRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ResetQueueRequest struct directly into bytes, without going
+// Encode serializes a ResetPointInfo struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResetQueueRequest struct could not be encoded.
-func (v *ResetQueueRequest) Encode(sw stream.Writer) error {
+// An error is returned if a ResetPointInfo struct could not be encoded.
+func (v *ResetPointInfo) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.ShardID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+	if v.BinaryChecksum != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+		if err := sw.WriteString(*(v.BinaryChecksum)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66923,11 +69854,11 @@ func (v *ResetQueueRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.ClusterName != nil {
+	if v.RunId != nil {
 		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.ClusterName)); err != nil {
+		if err := sw.WriteString(*(v.RunId)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66935,11 +69866,47 @@ func (v *ResetQueueRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Type != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+	if v.FirstDecisionCompletedId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt32(*(v.Type)); err != nil {
+		if err := sw.WriteInt64(*(v.FirstDecisionCompletedId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.CreatedTimeNano != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.CreatedTimeNano)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ExpiringTimeNano != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.ExpiringTimeNano)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Resettable != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TBool}); err != nil {
+			return err
+		}
+		if err := sw.WriteBool(*(v.Resettable)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -66950,12 +69917,12 @@ func (v *ResetQueueRequest) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ResetQueueRequest struct directly from its Thrift-level
+// Decode deserializes a ResetPointInfo struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResetQueueRequest struct could not be generated from the wire
+// An error is returned if a ResetPointInfo struct could not be generated from the wire
 // representation.
-func (v *ResetQueueRequest) Decode(sr stream.Reader) error {
+func (v *ResetPointInfo) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -66968,10 +69935,10 @@ func (v *ResetQueueRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.ShardID = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.BinaryChecksum = &x
 			if err != nil {
 				return err
 			}
@@ -66979,15 +69946,39 @@ func (v *ResetQueueRequest) Decode(sr stream.Reader) error {
 		case fh.ID == 20 && fh.Type == wire.TBinary:
 			var x string
 			x, err = sr.ReadString()
-			v.ClusterName = &x
+			v.RunId = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 30 && fh.Type == wire.TI32:
-			var x int32
-			x, err = sr.ReadInt32()
-			v.Type = &x
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.FirstDecisionCompletedId = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.CreatedTimeNano = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.ExpiringTimeNano = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TBool:
+			var x bool
+			x, err = sr.ReadBool()
+			v.Resettable = &x
 			if err != nil {
 				return err
 			}
@@ -67014,48 +70005,69 @@ func (v *ResetQueueRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetQueueRequest
+// String returns a readable string representation of a ResetPointInfo
 // struct.
-func (v *ResetQueueRequest) String() string {
+func (v *ResetPointInfo) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [6]string
 	i := 0
-	if v.ShardID != nil {
-		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+	if v.BinaryChecksum != nil {
+		fields[i] = fmt.Sprintf("BinaryChecksum: %v", *(v.BinaryChecksum))
 		i++
 	}
-	if v.ClusterName != nil {
-		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+	if v.RunId != nil {
+		fields[i] = fmt.Sprintf("RunId: %v", *(v.RunId))
 		i++
 	}
-	if v.Type != nil {
-		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+	if v.FirstDecisionCompletedId != nil {
+		fields[i] = fmt.Sprintf("FirstDecisionCompletedId: %v", *(v.FirstDecisionCompletedId))
+		i++
+	}
+	if v.CreatedTimeNano != nil {
+		fields[i] = fmt.Sprintf("CreatedTimeNano: %v", *(v.CreatedTimeNano))
+		i++
+	}
+	if v.ExpiringTimeNano != nil {
+		fields[i] = fmt.Sprintf("ExpiringTimeNano: %v", *(v.ExpiringTimeNano))
+		i++
+	}
+	if v.Resettable != nil {
+		fields[i] = fmt.Sprintf("Resettable: %v", *(v.Resettable))
 		i++
 	}
 
-	return fmt.Sprintf("ResetQueueRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetPointInfo{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetQueueRequest match the
-// provided ResetQueueRequest.
+// Equals returns true if all the fields of this ResetPointInfo match the
+// provided ResetPointInfo.
 //
 // This function performs a deep comparison.
-func (v *ResetQueueRequest) Equals(rhs *ResetQueueRequest) bool {
+func (v *ResetPointInfo) Equals(rhs *ResetPointInfo) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+	if !_String_EqualsPtr(v.BinaryChecksum, rhs.BinaryChecksum) {
 		return false
 	}
-	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+	if !_String_EqualsPtr(v.RunId, rhs.RunId) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.Type, rhs.Type) {
+	if !_I64_EqualsPtr(v.FirstDecisionCompletedId, rhs.FirstDecisionCompletedId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.CreatedTimeNano, rhs.CreatedTimeNano) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ExpiringTimeNano, rhs.ExpiringTimeNano) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.Resettable, rhs.Resettable) {
 		return false
 	}
 
@@ -67063,74 +70075,156 @@ func (v *ResetQueueRequest) Equals(rhs *ResetQueueRequest) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetQueueRequest.
-func (v *ResetQueueRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetPointInfo.
+func (v *ResetPointInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ShardID != nil {
-		enc.AddInt32("shardID", *v.ShardID)
+	if v.BinaryChecksum != nil {
+		enc.AddString("binaryChecksum", *v.BinaryChecksum)
 	}
-	if v.ClusterName != nil {
-		enc.AddString("clusterName", *v.ClusterName)
+	if v.RunId != nil {
+		enc.AddString("runId", *v.RunId)
 	}
-	if v.Type != nil {
-		enc.AddInt32("type", *v.Type)
+	if v.FirstDecisionCompletedId != nil {
+		enc.AddInt64("firstDecisionCompletedId", *v.FirstDecisionCompletedId)
+	}
+	if v.CreatedTimeNano != nil {
+		enc.AddInt64("createdTimeNano", *v.CreatedTimeNano)
+	}
+	if v.ExpiringTimeNano != nil {
+		enc.AddInt64("expiringTimeNano", *v.ExpiringTimeNano)
+	}
+	if v.Resettable != nil {
+		enc.AddBool("resettable", *v.Resettable)
 	}
 	return err
 }
 
-// GetShardID returns the value of ShardID if it is set or its
+// GetBinaryChecksum returns the value of BinaryChecksum if it is set or its
 // zero value if it is unset.
-func (v *ResetQueueRequest) GetShardID() (o int32) {
-	if v != nil && v.ShardID != nil {
-		return *v.ShardID
+func (v *ResetPointInfo) GetBinaryChecksum() (o string) {
+	if v != nil && v.BinaryChecksum != nil {
+		return *v.BinaryChecksum
 	}
 
 	return
 }
 
-// IsSetShardID returns true if ShardID is not nil.
-func (v *ResetQueueRequest) IsSetShardID() bool {
-	return v != nil && v.ShardID != nil
+// IsSetBinaryChecksum returns true if BinaryChecksum is not nil.
+func (v *ResetPointInfo) IsSetBinaryChecksum() bool {
+	return v != nil && v.BinaryChecksum != nil
 }
 
-// GetClusterName returns the value of ClusterName if it is set or its
+// GetRunId returns the value of RunId if it is set or its
 // zero value if it is unset.
-func (v *ResetQueueRequest) GetClusterName() (o string) {
-	if v != nil && v.ClusterName != nil {
-		return *v.ClusterName
+func (v *ResetPointInfo) GetRunId() (o string) {
+	if v != nil && v.RunId != nil {
+		return *v.RunId
 	}
 
 	return
 }
 
-// IsSetClusterName returns true if ClusterName is not nil.
-func (v *ResetQueueRequest) IsSetClusterName() bool {
-	return v != nil && v.ClusterName != nil
+// IsSetRunId returns true if RunId is not nil.
+func (v *ResetPointInfo) IsSetRunId() bool {
+	return v != nil && v.RunId != nil
 }
 
-// GetType returns the value of Type if it is set or its
+// GetFirstDecisionCompletedId returns the value of FirstDecisionCompletedId if it is set or its
 // zero value if it is unset.
-func (v *ResetQueueRequest) GetType() (o int32) {
-	if v != nil && v.Type != nil {
-		return *v.Type
+func (v *ResetPointInfo) GetFirstDecisionCompletedId() (o int64) {
+	if v != nil && v.FirstDecisionCompletedId != nil {
+		return *v.FirstDecisionCompletedId
 	}
 
 	return
 }
 
-// IsSetType returns true if Type is not nil.
-func (v *ResetQueueRequest) IsSetType() bool {
-	return v != nil && v.Type != nil
+// IsSetFirstDecisionCompletedId returns true if FirstDecisionCompletedId is not nil.
+func (v *ResetPointInfo) IsSetFirstDecisionCompletedId() bool {
+	return v != nil && v.FirstDecisionCompletedId != nil
 }
 
-type ResetStickyTaskListRequest struct {
-	Domain    *string            `json:"domain,omitempty"`
-	Execution *WorkflowExecution `json:"execution,omitempty"`
+// GetCreatedTimeNano returns the value of CreatedTimeNano if it is set or its
+// zero value if it is unset.
+func (v *ResetPointInfo) GetCreatedTimeNano() (o int64) {
+	if v != nil && v.CreatedTimeNano != nil {
+		return *v.CreatedTimeNano
+	}
+
+	return
 }
 
-// ToWire translates a ResetStickyTaskListRequest struct into a Thrift-level intermediate
+// IsSetCreatedTimeNano returns true if CreatedTimeNano is not nil.
+func (v *ResetPointInfo) IsSetCreatedTimeNano() bool {
+	return v != nil && v.CreatedTimeNano != nil
+}
+
+// GetExpiringTimeNano returns the value of ExpiringTimeNano if it is set or its
+// zero value if it is unset.
+func (v *ResetPointInfo) GetExpiringTimeNano() (o int64) {
+	if v != nil && v.ExpiringTimeNano != nil {
+		return *v.ExpiringTimeNano
+	}
+
+	return
+}
+
+// IsSetExpiringTimeNano returns true if ExpiringTimeNano is not nil.
+func (v *ResetPointInfo) IsSetExpiringTimeNano() bool {
+	return v != nil && v.ExpiringTimeNano != nil
+}
+
+// GetResettable returns the value of Resettable if it is set or its
+// zero value if it is unset.
+func (v *ResetPointInfo) GetResettable() (o bool) {
+	if v != nil && v.Resettable != nil {
+		return *v.Resettable
+	}
+
+	return
+}
+
+// IsSetResettable returns true if Resettable is not nil.
+func (v *ResetPointInfo) IsSetResettable() bool {
+	return v != nil && v.Resettable != nil
+}
+
+type ResetPoints struct {
+	Points []*ResetPointInfo `json:"points,omitempty"`
+}
+
+type _List_ResetPointInfo_ValueList []*ResetPointInfo
+
+func (v _List_ResetPointInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*ResetPointInfo', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_ResetPointInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_ResetPointInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_ResetPointInfo_ValueList) Close() {}
+
+// ToWire translates a ResetPoints struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -67145,39 +70239,55 @@ type ResetStickyTaskListRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
+func (v *ResetPoints) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Domain != nil {
-		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+	if v.Points != nil {
+		w, err = wire.NewValueList(_List_ResetPointInfo_ValueList(v.Points)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Execution != nil {
-		w, err = v.Execution.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 20, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetStickyTaskListRequest struct from its Thrift-level
+func _ResetPointInfo_Read(w wire.Value) (*ResetPointInfo, error) {
+	var v ResetPointInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_ResetPointInfo_Read(l wire.ValueList) ([]*ResetPointInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*ResetPointInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _ResetPointInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a ResetPoints struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetStickyTaskListRequest struct
+// An error is returned if we were unable to build a ResetPoints struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -67185,29 +70295,19 @@ func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetStickyTaskListRequest
+//   var v ResetPoints
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
+func (v *ResetPoints) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Domain = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 20:
-			if field.Value.Type() == wire.TStruct {
-				v.Execution, err = _WorkflowExecution_Read(field.Value)
+			if field.Value.Type() == wire.TList {
+				v.Points, err = _List_ResetPointInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -67219,20 +70319,41 @@ func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a ResetStickyTaskListRequest struct directly into bytes, without going
+func _List_ResetPointInfo_Encode(val []*ResetPointInfo, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*ResetPointInfo', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a ResetPoints struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResetStickyTaskListRequest struct could not be encoded.
-func (v *ResetStickyTaskListRequest) Encode(sw stream.Writer) error {
+// An error is returned if a ResetPoints struct could not be encoded.
+func (v *ResetPoints) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Domain != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.Points != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TList}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Domain)); err != nil {
+		if err := _List_ResetPointInfo_Encode(v.Points, sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -67240,27 +70361,51 @@ func (v *ResetStickyTaskListRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Execution != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
-			return err
-		}
-		if err := v.Execution.Encode(sw); err != nil {
-			return err
+	return sw.WriteStructEnd()
+}
+
+func _ResetPointInfo_Decode(sr stream.Reader) (*ResetPointInfo, error) {
+	var v ResetPointInfo
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_ResetPointInfo_Decode(sr stream.Reader) ([]*ResetPointInfo, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
 		}
-		if err := sw.WriteFieldEnd(); err != nil {
-			return err
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*ResetPointInfo, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _ResetPointInfo_Decode(sr)
+		if err != nil {
+			return nil, err
 		}
+		o = append(o, v)
 	}
 
-	return sw.WriteStructEnd()
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
 }
 
-// Decode deserializes a ResetStickyTaskListRequest struct directly from its Thrift-level
+// Decode deserializes a ResetPoints struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResetStickyTaskListRequest struct could not be generated from the wire
+// An error is returned if a ResetPoints struct could not be generated from the wire
 // representation.
-func (v *ResetStickyTaskListRequest) Decode(sr stream.Reader) error {
+func (v *ResetPoints) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -67273,16 +70418,8 @@ func (v *ResetStickyTaskListRequest) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Domain = &x
-			if err != nil {
-				return err
-			}
-
-		case fh.ID == 20 && fh.Type == wire.TStruct:
-			v.Execution, err = _WorkflowExecution_Decode(sr)
+		case fh.ID == 10 && fh.Type == wire.TList:
+			v.Points, err = _List_ResetPointInfo_Decode(sr)
 			if err != nil {
 				return err
 			}
@@ -67309,96 +70446,100 @@ func (v *ResetStickyTaskListRequest) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetStickyTaskListRequest
+// String returns a readable string representation of a ResetPoints
 // struct.
-func (v *ResetStickyTaskListRequest) String() string {
+func (v *ResetPoints) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [1]string
 	i := 0
-	if v.Domain != nil {
-		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
 		i++
 	}
-	if v.Execution != nil {
-		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
-		i++
+
+	return fmt.Sprintf("ResetPoints{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_ResetPointInfo_Equals(lhs, rhs []*ResetPointInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
 
-	return fmt.Sprintf("ResetStickyTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
 }
 
-// Equals returns true if all the fields of this ResetStickyTaskListRequest match the
-// provided ResetStickyTaskListRequest.
+// Equals returns true if all the fields of this ResetPoints match the
+// provided ResetPoints.
 //
 // This function performs a deep comparison.
-func (v *ResetStickyTaskListRequest) Equals(rhs *ResetStickyTaskListRequest) bool {
+func (v *ResetPoints) Equals(rhs *ResetPoints) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
-		return false
-	}
-	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_ResetPointInfo_Equals(v.Points, rhs.Points))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_ResetPointInfo_Zapper []*ResetPointInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ResetPointInfo_Zapper.
+func (l _List_ResetPointInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetStickyTaskListRequest.
-func (v *ResetStickyTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetPoints.
+func (v *ResetPoints) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Domain != nil {
-		enc.AddString("domain", *v.Domain)
-	}
-	if v.Execution != nil {
-		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	if v.Points != nil {
+		err = multierr.Append(err, enc.AddArray("points", (_List_ResetPointInfo_Zapper)(v.Points)))
 	}
 	return err
 }
 
-// GetDomain returns the value of Domain if it is set or its
-// zero value if it is unset.
-func (v *ResetStickyTaskListRequest) GetDomain() (o string) {
-	if v != nil && v.Domain != nil {
-		return *v.Domain
-	}
-
-	return
-}
-
-// IsSetDomain returns true if Domain is not nil.
-func (v *ResetStickyTaskListRequest) IsSetDomain() bool {
-	return v != nil && v.Domain != nil
-}
-
-// GetExecution returns the value of Execution if it is set or its
+// GetPoints returns the value of Points if it is set or its
 // zero value if it is unset.
-func (v *ResetStickyTaskListRequest) GetExecution() (o *WorkflowExecution) {
-	if v != nil && v.Execution != nil {
-		return v.Execution
+func (v *ResetPoints) GetPoints() (o []*ResetPointInfo) {
+	if v != nil && v.Points != nil {
+		return v.Points
 	}
 
 	return
 }
 
-// IsSetExecution returns true if Execution is not nil.
-func (v *ResetStickyTaskListRequest) IsSetExecution() bool {
-	return v != nil && v.Execution != nil
+// IsSetPoints returns true if Points is not nil.
+func (v *ResetPoints) IsSetPoints() bool {
+	return v != nil && v.Points != nil
 }
 
-type ResetStickyTaskListResponse struct {
+type ResetQueueRequest struct {
+	ShardID     *int32  `json:"shardID,omitempty"`
+	ClusterName *string `json:"clusterName,omitempty"`
+	Type        *int32  `json:"type,omitempty"`
 }
 
-// ToWire translates a ResetStickyTaskListResponse struct into a Thrift-level intermediate
+// ToWire translates a ResetQueueRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -67413,20 +70554,47 @@ type ResetStickyTaskListResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
+func (v *ResetQueueRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [0]wire.Field
+		fields [3]wire.Field
 		i      int = 0
+		w      wire.Value
+		err    error
 	)
 
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.ClusterName != nil {
+		w, err = wire.NewValueString(*(v.ClusterName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Type != nil {
+		w, err = wire.NewValueI32(*(v.Type)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
+// FromWire deserializes a ResetQueueRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
+// An error is returned if we were unable to build a ResetQueueRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -67434,39 +70602,106 @@ func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetStickyTaskListResponse
+//   var v ResetQueueRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
+func (v *ResetQueueRequest) FromWire(w wire.Value) error {
+	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ClusterName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.Type = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
 	return nil
 }
 
-// Encode serializes a ResetStickyTaskListResponse struct directly into bytes, without going
+// Encode serializes a ResetQueueRequest struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a ResetStickyTaskListResponse struct could not be encoded.
-func (v *ResetStickyTaskListResponse) Encode(sw stream.Writer) error {
+// An error is returned if a ResetQueueRequest struct could not be encoded.
+func (v *ResetQueueRequest) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
+	if v.ShardID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.ShardID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.ClusterName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.ClusterName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Type != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI32}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt32(*(v.Type)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a ResetStickyTaskListResponse struct directly from its Thrift-level
+// Decode deserializes a ResetQueueRequest struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a ResetStickyTaskListResponse struct could not be generated from the wire
+// An error is returned if a ResetQueueRequest struct could not be generated from the wire
 // representation.
-func (v *ResetStickyTaskListResponse) Decode(sr stream.Reader) error {
+func (v *ResetQueueRequest) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -67479,6 +70714,30 @@ func (v *ResetStickyTaskListResponse) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
+		case fh.ID == 10 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.ShardID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.ClusterName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI32:
+			var x int32
+			x, err = sr.ReadInt32()
+			v.Type = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -67501,52 +70760,123 @@ func (v *ResetStickyTaskListResponse) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a ResetStickyTaskListResponse
+// String returns a readable string representation of a ResetQueueRequest
 // struct.
-func (v *ResetStickyTaskListResponse) String() string {
+func (v *ResetQueueRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [0]string
+	var fields [3]string
 	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.ClusterName != nil {
+		fields[i] = fmt.Sprintf("ClusterName: %v", *(v.ClusterName))
+		i++
+	}
+	if v.Type != nil {
+		fields[i] = fmt.Sprintf("Type: %v", *(v.Type))
+		i++
+	}
 
-	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("ResetQueueRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
-// provided ResetStickyTaskListResponse.
+// Equals returns true if all the fields of this ResetQueueRequest match the
+// provided ResetQueueRequest.
 //
 // This function performs a deep comparison.
-func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
+func (v *ResetQueueRequest) Equals(rhs *ResetQueueRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.ClusterName, rhs.ClusterName) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Type, rhs.Type) {
+		return false
+	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of ResetStickyTaskListResponse.
-func (v *ResetStickyTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of ResetQueueRequest.
+func (v *ResetQueueRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.ClusterName != nil {
+		enc.AddString("clusterName", *v.ClusterName)
+	}
+	if v.Type != nil {
+		enc.AddInt32("type", *v.Type)
+	}
 	return err
 }
 
-type ResetWorkflowExecutionRequest struct {
-	Domain                *string            `json:"domain,omitempty"`
-	WorkflowExecution     *WorkflowExecution `json:"workflowExecution,omitempty"`
-	Reason                *string            `json:"reason,omitempty"`
-	DecisionFinishEventId *int64             `json:"decisionFinishEventId,omitempty"`
-	RequestId             *string            `json:"requestId,omitempty"`
-	SkipSignalReapply     *bool              `json:"skipSignalReapply,omitempty"`
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *ResetQueueRequest) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
 }
 
-// ToWire translates a ResetWorkflowExecutionRequest struct into a Thrift-level intermediate
+// IsSetShardID returns true if ShardID is not nil.
+func (v *ResetQueueRequest) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetClusterName returns the value of ClusterName if it is set or its
+// zero value if it is unset.
+func (v *ResetQueueRequest) GetClusterName() (o string) {
+	if v != nil && v.ClusterName != nil {
+		return *v.ClusterName
+	}
+
+	return
+}
+
+// IsSetClusterName returns true if ClusterName is not nil.
+func (v *ResetQueueRequest) IsSetClusterName() bool {
+	return v != nil && v.ClusterName != nil
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+func (v *ResetQueueRequest) GetType() (o int32) {
+	if v != nil && v.Type != nil {
+		return *v.Type
+	}
+
+	return
+}
+
+// IsSetType returns true if Type is not nil.
+func (v *ResetQueueRequest) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
+type ResetStickyTaskListRequest struct {
+	Domain    *string            `json:"domain,omitempty"`
+	Execution *WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a ResetStickyTaskListRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -67561,9 +70891,9 @@ type ResetWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *ResetStickyTaskListRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -67577,55 +70907,23 @@ func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.WorkflowExecution != nil {
-		w, err = v.WorkflowExecution.ToWire()
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.Reason != nil {
-		w, err = wire.NewValueString(*(v.Reason)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.DecisionFinishEventId != nil {
-		w, err = wire.NewValueI64(*(v.DecisionFinishEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.RequestId != nil {
-		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.SkipSignalReapply != nil {
-		w, err = wire.NewValueBool(*(v.SkipSignalReapply)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a ResetWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a ResetStickyTaskListRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a ResetWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a ResetStickyTaskListRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -67633,12 +70931,12 @@ func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v ResetWorkflowExecutionRequest
+//   var v ResetStickyTaskListRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *ResetStickyTaskListRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -67655,23 +70953,471 @@ func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
 			}
 		case 20:
 			if field.Value.Type() == wire.TStruct {
-				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Reason = &x
-				if err != nil {
-					return err
-				}
+		}
+	}
 
-			}
-		case 40:
+	return nil
+}
+
+// Encode serializes a ResetStickyTaskListRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ResetStickyTaskListRequest struct could not be encoded.
+func (v *ResetStickyTaskListRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Execution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.Execution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ResetStickyTaskListRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ResetStickyTaskListRequest struct could not be generated from the wire
+// representation.
+func (v *ResetStickyTaskListRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.Execution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResetStickyTaskListRequest
+// struct.
+func (v *ResetStickyTaskListRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("ResetStickyTaskListRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResetStickyTaskListRequest match the
+// provided ResetStickyTaskListRequest.
+//
+// This function performs a deep comparison.
+func (v *ResetStickyTaskListRequest) Equals(rhs *ResetStickyTaskListRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResetStickyTaskListRequest.
+func (v *ResetStickyTaskListRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *ResetStickyTaskListRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *ResetStickyTaskListRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *ResetStickyTaskListRequest) GetExecution() (o *WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *ResetStickyTaskListRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type ResetStickyTaskListResponse struct {
+}
+
+// ToWire translates a ResetStickyTaskListResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetStickyTaskListResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResetStickyTaskListResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetStickyTaskListResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetStickyTaskListResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetStickyTaskListResponse) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a ResetStickyTaskListResponse struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a ResetStickyTaskListResponse struct could not be encoded.
+func (v *ResetStickyTaskListResponse) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a ResetStickyTaskListResponse struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a ResetStickyTaskListResponse struct could not be generated from the wire
+// representation.
+func (v *ResetStickyTaskListResponse) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ResetStickyTaskListResponse
+// struct.
+func (v *ResetStickyTaskListResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("ResetStickyTaskListResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this ResetStickyTaskListResponse match the
+// provided ResetStickyTaskListResponse.
+//
+// This function performs a deep comparison.
+func (v *ResetStickyTaskListResponse) Equals(rhs *ResetStickyTaskListResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ResetStickyTaskListResponse.
+func (v *ResetStickyTaskListResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+type ResetWorkflowExecutionRequest struct {
+	Domain                *string            `json:"domain,omitempty"`
+	WorkflowExecution     *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Reason                *string            `json:"reason,omitempty"`
+	DecisionFinishEventId *int64             `json:"decisionFinishEventId,omitempty"`
+	RequestId             *string            `json:"requestId,omitempty"`
+	SkipSignalReapply     *bool              `json:"skipSignalReapply,omitempty"`
+}
+
+// ToWire translates a ResetWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ResetWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Reason != nil {
+		w, err = wire.NewValueString(*(v.Reason)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.DecisionFinishEventId != nil {
+		w, err = wire.NewValueI64(*(v.DecisionFinishEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.SkipSignalReapply != nil {
+		w, err = wire.NewValueBool(*(v.SkipSignalReapply)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a ResetWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ResetWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ResetWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ResetWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
 			if field.Value.Type() == wire.TI64 {
 				var x int64
 				x, err = field.Value.GetI64(), error(nil)
@@ -76416,7 +80162,8 @@ func (v *SearchAttributes) IsSetIndexedFields() bool {
 }
 
 type ServiceBusyError struct {
-	Message string `json:"message,required"`
+	Message            string `json:"message,required"`
+	RetryAfterInMillis *int64 `json:"retryAfterInMillis,omitempty"`
 }
 
 // ToWire translates a ServiceBusyError struct into a Thrift-level intermediate
@@ -76436,7 +80183,7 @@ type ServiceBusyError struct {
 //   }
 func (v *ServiceBusyError) ToWire() (wire.Value, error) {
 	var (
-		fields [1]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -76448,6 +80195,14 @@ func (v *ServiceBusyError) ToWire() (wire.Value, error) {
 	}
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
+	if v.RetryAfterInMillis != nil {
+		w, err = wire.NewValueI64(*(v.RetryAfterInMillis)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -76484,6 +80239,16 @@ func (v *ServiceBusyError) FromWire(w wire.Value) error {
 				}
 				messageIsSet = true
 			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.RetryAfterInMillis = &x
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
@@ -76513,6 +80278,18 @@ func (v *ServiceBusyError) Encode(sw stream.Writer) error {
 		return err
 	}
 
+	if v.RetryAfterInMillis != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 2, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.RetryAfterInMillis)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -76542,6 +80319,14 @@ func (v *ServiceBusyError) Decode(sr stream.Reader) error {
 				return err
 			}
 			messageIsSet = true
+		case fh.ID == 2 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.RetryAfterInMillis = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -76575,10 +80360,14 @@ func (v *ServiceBusyError) String() string {
 		return "<nil>"
 	}
 
-	var fields [1]string
+	var fields [2]string
 	i := 0
 	fields[i] = fmt.Sprintf("Message: %v", v.Message)
 	i++
+	if v.RetryAfterInMillis != nil {
+		fields[i] = fmt.Sprintf("RetryAfterInMillis: %v", *(v.RetryAfterInMillis))
+		i++
+	}
 
 	return fmt.Sprintf("ServiceBusyError{%v}", strings.Join(fields[:i], ", "))
 }
@@ -76602,6 +80391,9 @@ func (v *ServiceBusyError) Equals(rhs *ServiceBusyError) bool {
 	if !(v.Message == rhs.Message) {
 		return false
 	}
+	if !_I64_EqualsPtr(v.RetryAfterInMillis, rhs.RetryAfterInMillis) {
+		return false
+	}
 
 	return true
 }
@@ -76613,6 +80405,9 @@ func (v *ServiceBusyError) MarshalLogObject(enc zapcore.ObjectEncoder) (err erro
 		return nil
 	}
 	enc.AddString("message", v.Message)
+	if v.RetryAfterInMillis != nil {
+		enc.AddInt64("retryAfterInMillis", *v.RetryAfterInMillis)
+	}
 	return err
 }
 
@@ -76625,10 +80420,889 @@ func (v *ServiceBusyError) GetMessage() (o string) {
 	return
 }
 
+// GetRetryAfterInMillis returns the value of RetryAfterInMillis if it is set or its
+// zero value if it is unset.
+func (v *ServiceBusyError) GetRetryAfterInMillis() (o int64) {
+	if v != nil && v.RetryAfterInMillis != nil {
+		return *v.RetryAfterInMillis
+	}
+
+	return
+}
+
+// IsSetRetryAfterInMillis returns true if RetryAfterInMillis is not nil.
+func (v *ServiceBusyError) IsSetRetryAfterInMillis() bool {
+	return v != nil && v.RetryAfterInMillis != nil
+}
+
 func (v *ServiceBusyError) Error() string {
 	return v.String()
 }
 
+type SignalBatchItem struct {
+	SignalName *string `json:"signalName,omitempty"`
+	Input      []byte  `json:"input,omitempty"`
+}
+
+// ToWire translates a SignalBatchItem struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SignalBatchItem) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.SignalName != nil {
+		w, err = wire.NewValueString(*(v.SignalName)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Input != nil {
+		w, err = wire.NewValueBinary(v.Input), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a SignalBatchItem struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SignalBatchItem struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SignalBatchItem
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SignalBatchItem) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.SignalName = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				v.Input, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a SignalBatchItem struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a SignalBatchItem struct could not be encoded.
+func (v *SignalBatchItem) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.SignalName != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.SignalName)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Input != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.Input); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a SignalBatchItem struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a SignalBatchItem struct could not be generated from the wire
+// representation.
+func (v *SignalBatchItem) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.SignalName = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TBinary:
+			v.Input, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SignalBatchItem
+// struct.
+func (v *SignalBatchItem) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.SignalName != nil {
+		fields[i] = fmt.Sprintf("SignalName: %v", *(v.SignalName))
+		i++
+	}
+	if v.Input != nil {
+		fields[i] = fmt.Sprintf("Input: %v", v.Input)
+		i++
+	}
+
+	return fmt.Sprintf("SignalBatchItem{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this SignalBatchItem match the
+// provided SignalBatchItem.
+//
+// This function performs a deep comparison.
+func (v *SignalBatchItem) Equals(rhs *SignalBatchItem) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.SignalName, rhs.SignalName) {
+		return false
+	}
+	if !((v.Input == nil && rhs.Input == nil) || (v.Input != nil && rhs.Input != nil && bytes.Equal(v.Input, rhs.Input))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SignalBatchItem.
+func (v *SignalBatchItem) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.SignalName != nil {
+		enc.AddString("signalName", *v.SignalName)
+	}
+	if v.Input != nil {
+		enc.AddString("input", base64.StdEncoding.EncodeToString(v.Input))
+	}
+	return err
+}
+
+// GetSignalName returns the value of SignalName if it is set or its
+// zero value if it is unset.
+func (v *SignalBatchItem) GetSignalName() (o string) {
+	if v != nil && v.SignalName != nil {
+		return *v.SignalName
+	}
+
+	return
+}
+
+// IsSetSignalName returns true if SignalName is not nil.
+func (v *SignalBatchItem) IsSetSignalName() bool {
+	return v != nil && v.SignalName != nil
+}
+
+// GetInput returns the value of Input if it is set or its
+// zero value if it is unset.
+func (v *SignalBatchItem) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+
+	return
+}
+
+// IsSetInput returns true if Input is not nil.
+func (v *SignalBatchItem) IsSetInput() bool {
+	return v != nil && v.Input != nil
+}
+
+type SignalBatchWorkflowExecutionRequest struct {
+	Domain            *string            `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Signals           []*SignalBatchItem `json:"signals,omitempty"`
+	Identity          *string            `json:"identity,omitempty"`
+	RequestId         *string            `json:"requestId,omitempty"`
+}
+
+type _List_SignalBatchItem_ValueList []*SignalBatchItem
+
+func (v _List_SignalBatchItem_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid list '[]*SignalBatchItem', index [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_SignalBatchItem_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_SignalBatchItem_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_SignalBatchItem_ValueList) Close() {}
+
+// ToWire translates a SignalBatchWorkflowExecutionRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *SignalBatchWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		w, err = v.WorkflowExecution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.Signals != nil {
+		w, err = wire.NewValueList(_List_SignalBatchItem_ValueList(v.Signals)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Identity != nil {
+		w, err = wire.NewValueString(*(v.Identity)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.RequestId != nil {
+		w, err = wire.NewValueString(*(v.RequestId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _SignalBatchItem_Read(w wire.Value) (*SignalBatchItem, error) {
+	var v SignalBatchItem
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_SignalBatchItem_Read(l wire.ValueList) ([]*SignalBatchItem, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*SignalBatchItem, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _SignalBatchItem_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a SignalBatchWorkflowExecutionRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a SignalBatchWorkflowExecutionRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v SignalBatchWorkflowExecutionRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *SignalBatchWorkflowExecutionRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.WorkflowExecution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TList {
+				v.Signals, err = _List_SignalBatchItem_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Identity = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RequestId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+func _List_SignalBatchItem_Encode(val []*SignalBatchItem, sw stream.Writer) error {
+
+	lh := stream.ListHeader{
+		Type:   wire.TStruct,
+		Length: len(val),
+	}
+	if err := sw.WriteListBegin(lh); err != nil {
+		return err
+	}
+
+	for i, v := range val {
+		if v == nil {
+			return fmt.Errorf("invalid list '[]*SignalBatchItem', index [%v]: value is nil", i)
+		}
+		if err := v.Encode(sw); err != nil {
+			return err
+		}
+	}
+	return sw.WriteListEnd()
+}
+
+// Encode serializes a SignalBatchWorkflowExecutionRequest struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a SignalBatchWorkflowExecutionRequest struct could not be encoded.
+func (v *SignalBatchWorkflowExecutionRequest) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.Domain != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Domain)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.WorkflowExecution != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.WorkflowExecution.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Signals != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TList}); err != nil {
+			return err
+		}
+		if err := _List_SignalBatchItem_Encode(v.Signals, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.Identity != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.Identity)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.RequestId != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.RequestId)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+func _SignalBatchItem_Decode(sr stream.Reader) (*SignalBatchItem, error) {
+	var v SignalBatchItem
+	err := v.Decode(sr)
+	return &v, err
+}
+
+func _List_SignalBatchItem_Decode(sr stream.Reader) ([]*SignalBatchItem, error) {
+	lh, err := sr.ReadListBegin()
+	if err != nil {
+		return nil, err
+	}
+
+	if lh.Type != wire.TStruct {
+		for i := 0; i < lh.Length; i++ {
+			if err := sr.Skip(lh.Type); err != nil {
+				return nil, err
+			}
+		}
+		return nil, sr.ReadListEnd()
+	}
+
+	o := make([]*SignalBatchItem, 0, lh.Length)
+	for i := 0; i < lh.Length; i++ {
+		v, err := _SignalBatchItem_Decode(sr)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, v)
+	}
+
+	if err = sr.ReadListEnd(); err != nil {
+		return nil, err
+	}
+	return o, err
+}
+
+// Decode deserializes a SignalBatchWorkflowExecutionRequest struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a SignalBatchWorkflowExecutionRequest struct could not be generated from the wire
+// representation.
+func (v *SignalBatchWorkflowExecutionRequest) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Domain = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TStruct:
+			v.WorkflowExecution, err = _WorkflowExecution_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TList:
+			v.Signals, err = _List_SignalBatchItem_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Identity = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 50 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.RequestId = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a SignalBatchWorkflowExecutionRequest
+// struct.
+func (v *SignalBatchWorkflowExecutionRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.WorkflowExecution != nil {
+		fields[i] = fmt.Sprintf("WorkflowExecution: %v", v.WorkflowExecution)
+		i++
+	}
+	if v.Signals != nil {
+		fields[i] = fmt.Sprintf("Signals: %v", v.Signals)
+		i++
+	}
+	if v.Identity != nil {
+		fields[i] = fmt.Sprintf("Identity: %v", *(v.Identity))
+		i++
+	}
+	if v.RequestId != nil {
+		fields[i] = fmt.Sprintf("RequestId: %v", *(v.RequestId))
+		i++
+	}
+
+	return fmt.Sprintf("SignalBatchWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_SignalBatchItem_Equals(lhs, rhs []*SignalBatchItem) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this SignalBatchWorkflowExecutionRequest match the
+// provided SignalBatchWorkflowExecutionRequest.
+//
+// This function performs a deep comparison.
+func (v *SignalBatchWorkflowExecutionRequest) Equals(rhs *SignalBatchWorkflowExecutionRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.WorkflowExecution == nil && rhs.WorkflowExecution == nil) || (v.WorkflowExecution != nil && rhs.WorkflowExecution != nil && v.WorkflowExecution.Equals(rhs.WorkflowExecution))) {
+		return false
+	}
+	if !((v.Signals == nil && rhs.Signals == nil) || (v.Signals != nil && rhs.Signals != nil && _List_SignalBatchItem_Equals(v.Signals, rhs.Signals))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Identity, rhs.Identity) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RequestId, rhs.RequestId) {
+		return false
+	}
+
+	return true
+}
+
+type _List_SignalBatchItem_Zapper []*SignalBatchItem
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_SignalBatchItem_Zapper.
+func (l _List_SignalBatchItem_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of SignalBatchWorkflowExecutionRequest.
+func (v *SignalBatchWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.WorkflowExecution != nil {
+		err = multierr.Append(err, enc.AddObject("workflowExecution", v.WorkflowExecution))
+	}
+	if v.Signals != nil {
+		err = multierr.Append(err, enc.AddArray("signals", (_List_SignalBatchItem_Zapper)(v.Signals)))
+	}
+	if v.Identity != nil {
+		enc.AddString("identity", *v.Identity)
+	}
+	if v.RequestId != nil {
+		enc.AddString("requestId", *v.RequestId)
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *SignalBatchWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *SignalBatchWorkflowExecutionRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetWorkflowExecution returns the value of WorkflowExecution if it is set or its
+// zero value if it is unset.
+func (v *SignalBatchWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+
+	return
+}
+
+// IsSetWorkflowExecution returns true if WorkflowExecution is not nil.
+func (v *SignalBatchWorkflowExecutionRequest) IsSetWorkflowExecution() bool {
+	return v != nil && v.WorkflowExecution != nil
+}
+
+// GetSignals returns the value of Signals if it is set or its
+// zero value if it is unset.
+func (v *SignalBatchWorkflowExecutionRequest) GetSignals() (o []*SignalBatchItem) {
+	if v != nil && v.Signals != nil {
+		return v.Signals
+	}
+
+	return
+}
+
+// IsSetSignals returns true if Signals is not nil.
+func (v *SignalBatchWorkflowExecutionRequest) IsSetSignals() bool {
+	return v != nil && v.Signals != nil
+}
+
+// GetIdentity returns the value of Identity if it is set or its
+// zero value if it is unset.
+func (v *SignalBatchWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil && v.Identity != nil {
+		return *v.Identity
+	}
+
+	return
+}
+
+// IsSetIdentity returns true if Identity is not nil.
+func (v *SignalBatchWorkflowExecutionRequest) IsSetIdentity() bool {
+	return v != nil && v.Identity != nil
+}
+
+// GetRequestId returns the value of RequestId if it is set or its
+// zero value if it is unset.
+func (v *SignalBatchWorkflowExecutionRequest) GetRequestId() (o string) {
+	if v != nil && v.RequestId != nil {
+		return *v.RequestId
+	}
+
+	return
+}
+
+// IsSetRequestId returns true if RequestId is not nil.
+func (v *SignalBatchWorkflowExecutionRequest) IsSetRequestId() bool {
+	return v != nil && v.RequestId != nil
+}
+
 type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	Domain            *string            `json:"domain,omitempty"`
 	Execution         *WorkflowExecution `json:"execution,omitempty"`
@@ -85704,25 +90378,299 @@ func (v *SupportedClientVersions) IsSetGoSdk() bool {
 
 // GetJavaSdk returns the value of JavaSdk if it is set or its
 // zero value if it is unset.
-func (v *SupportedClientVersions) GetJavaSdk() (o string) {
-	if v != nil && v.JavaSdk != nil {
-		return *v.JavaSdk
+func (v *SupportedClientVersions) GetJavaSdk() (o string) {
+	if v != nil && v.JavaSdk != nil {
+		return *v.JavaSdk
+	}
+
+	return
+}
+
+// IsSetJavaSdk returns true if JavaSdk is not nil.
+func (v *SupportedClientVersions) IsSetJavaSdk() bool {
+	return v != nil && v.JavaSdk != nil
+}
+
+type TaskIDBlock struct {
+	StartID *int64 `json:"startID,omitempty"`
+	EndID   *int64 `json:"endID,omitempty"`
+}
+
+// ToWire translates a TaskIDBlock struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TaskIDBlock) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.StartID != nil {
+		w, err = wire.NewValueI64(*(v.StartID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.EndID != nil {
+		w, err = wire.NewValueI64(*(v.EndID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a TaskIDBlock struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TaskIDBlock struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TaskIDBlock
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TaskIDBlock) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.StartID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.EndID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// Encode serializes a TaskIDBlock struct directly into bytes, without going
+// through an intermediary type.
+//
+// An error is returned if a TaskIDBlock struct could not be encoded.
+func (v *TaskIDBlock) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+
+	if v.StartID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.StartID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.EndID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.EndID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
+}
+
+// Decode deserializes a TaskIDBlock struct directly from its Thrift-level
+// representation, without going through an intemediary type.
+//
+// An error is returned if a TaskIDBlock struct could not be generated from the wire
+// representation.
+func (v *TaskIDBlock) Decode(sr stream.Reader) error {
+
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+
+	for ok {
+		switch {
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.StartID = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.EndID = &x
+			if err != nil {
+				return err
+			}
+
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	if err := sr.ReadStructEnd(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TaskIDBlock
+// struct.
+func (v *TaskIDBlock) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.StartID != nil {
+		fields[i] = fmt.Sprintf("StartID: %v", *(v.StartID))
+		i++
+	}
+	if v.EndID != nil {
+		fields[i] = fmt.Sprintf("EndID: %v", *(v.EndID))
+		i++
+	}
+
+	return fmt.Sprintf("TaskIDBlock{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this TaskIDBlock match the
+// provided TaskIDBlock.
+//
+// This function performs a deep comparison.
+func (v *TaskIDBlock) Equals(rhs *TaskIDBlock) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I64_EqualsPtr(v.StartID, rhs.StartID) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.EndID, rhs.EndID) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskIDBlock.
+func (v *TaskIDBlock) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.StartID != nil {
+		enc.AddInt64("startID", *v.StartID)
+	}
+	if v.EndID != nil {
+		enc.AddInt64("endID", *v.EndID)
+	}
+	return err
+}
+
+// GetStartID returns the value of StartID if it is set or its
+// zero value if it is unset.
+func (v *TaskIDBlock) GetStartID() (o int64) {
+	if v != nil && v.StartID != nil {
+		return *v.StartID
+	}
+
+	return
+}
+
+// IsSetStartID returns true if StartID is not nil.
+func (v *TaskIDBlock) IsSetStartID() bool {
+	return v != nil && v.StartID != nil
+}
+
+// GetEndID returns the value of EndID if it is set or its
+// zero value if it is unset.
+func (v *TaskIDBlock) GetEndID() (o int64) {
+	if v != nil && v.EndID != nil {
+		return *v.EndID
 	}
 
 	return
 }
 
-// IsSetJavaSdk returns true if JavaSdk is not nil.
-func (v *SupportedClientVersions) IsSetJavaSdk() bool {
-	return v != nil && v.JavaSdk != nil
+// IsSetEndID returns true if EndID is not nil.
+func (v *TaskIDBlock) IsSetEndID() bool {
+	return v != nil && v.EndID != nil
 }
 
-type TaskIDBlock struct {
-	StartID *int64 `json:"startID,omitempty"`
-	EndID   *int64 `json:"endID,omitempty"`
+type TaskList struct {
+	Name *string       `json:"name,omitempty"`
+	Kind *TaskListKind `json:"kind,omitempty"`
 }
 
-// ToWire translates a TaskIDBlock struct into a Thrift-level intermediate
+// ToWire translates a TaskList struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -85737,7 +90685,7 @@ type TaskIDBlock struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TaskIDBlock) ToWire() (wire.Value, error) {
+func (v *TaskList) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -85745,16 +90693,16 @@ func (v *TaskIDBlock) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.StartID != nil {
-		w, err = wire.NewValueI64(*(v.StartID)), error(nil)
+	if v.Name != nil {
+		w, err = wire.NewValueString(*(v.Name)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.EndID != nil {
-		w, err = wire.NewValueI64(*(v.EndID)), error(nil)
+	if v.Kind != nil {
+		w, err = v.Kind.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -85765,11 +90713,17 @@ func (v *TaskIDBlock) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a TaskIDBlock struct from its Thrift-level
+func _TaskListKind_Read(w wire.Value) (TaskListKind, error) {
+	var v TaskListKind
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a TaskList struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TaskIDBlock struct
+// An error is returned if we were unable to build a TaskList struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -85777,31 +90731,31 @@ func (v *TaskIDBlock) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v TaskIDBlock
+//   var v TaskList
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TaskIDBlock) FromWire(w wire.Value) error {
+func (v *TaskList) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.StartID = &x
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Name = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.EndID = &x
+			if field.Value.Type() == wire.TI32 {
+				var x TaskListKind
+				x, err = _TaskListKind_Read(field.Value)
+				v.Kind = &x
 				if err != nil {
 					return err
 				}
@@ -85813,20 +90767,20 @@ func (v *TaskIDBlock) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a TaskIDBlock struct directly into bytes, without going
+// Encode serializes a TaskList struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a TaskIDBlock struct could not be encoded.
-func (v *TaskIDBlock) Encode(sw stream.Writer) error {
+// An error is returned if a TaskList struct could not be encoded.
+func (v *TaskList) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.StartID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
+	if v.Name != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.StartID)); err != nil {
+		if err := sw.WriteString(*(v.Name)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -85834,11 +90788,11 @@ func (v *TaskIDBlock) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.EndID != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
+	if v.Kind != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
 			return err
 		}
-		if err := sw.WriteInt64(*(v.EndID)); err != nil {
+		if err := v.Kind.Encode(sw); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -85849,12 +90803,18 @@ func (v *TaskIDBlock) Encode(sw stream.Writer) error {
 	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a TaskIDBlock struct directly from its Thrift-level
+func _TaskListKind_Decode(sr stream.Reader) (TaskListKind, error) {
+	var v TaskListKind
+	err := v.Decode(sr)
+	return v, err
+}
+
+// Decode deserializes a TaskList struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a TaskIDBlock struct could not be generated from the wire
+// An error is returned if a TaskList struct could not be generated from the wire
 // representation.
-func (v *TaskIDBlock) Decode(sr stream.Reader) error {
+func (v *TaskList) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -85867,18 +90827,18 @@ func (v *TaskIDBlock) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.StartID = &x
+		case fh.ID == 10 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.Name = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI64:
-			var x int64
-			x, err = sr.ReadInt64()
-			v.EndID = &x
+		case fh.ID == 20 && fh.Type == wire.TI32:
+			var x TaskListKind
+			x, err = _TaskListKind_Decode(sr)
+			v.Kind = &x
 			if err != nil {
 				return err
 			}
@@ -85905,41 +90865,51 @@ func (v *TaskIDBlock) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a TaskIDBlock
+// String returns a readable string representation of a TaskList
 // struct.
-func (v *TaskIDBlock) String() string {
+func (v *TaskList) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [2]string
 	i := 0
-	if v.StartID != nil {
-		fields[i] = fmt.Sprintf("StartID: %v", *(v.StartID))
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
 		i++
 	}
-	if v.EndID != nil {
-		fields[i] = fmt.Sprintf("EndID: %v", *(v.EndID))
+	if v.Kind != nil {
+		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
 		i++
 	}
 
-	return fmt.Sprintf("TaskIDBlock{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("TaskList{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TaskIDBlock match the
-// provided TaskIDBlock.
+func _TaskListKind_EqualsPtr(lhs, rhs *TaskListKind) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this TaskList match the
+// provided TaskList.
 //
 // This function performs a deep comparison.
-func (v *TaskIDBlock) Equals(rhs *TaskIDBlock) bool {
+func (v *TaskList) Equals(rhs *TaskList) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_I64_EqualsPtr(v.StartID, rhs.StartID) {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.EndID, rhs.EndID) {
+	if !_TaskListKind_EqualsPtr(v.Kind, rhs.Kind) {
 		return false
 	}
 
@@ -85947,56 +90917,257 @@ func (v *TaskIDBlock) Equals(rhs *TaskIDBlock) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TaskIDBlock.
-func (v *TaskIDBlock) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of TaskList.
+func (v *TaskList) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.StartID != nil {
-		enc.AddInt64("startID", *v.StartID)
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
 	}
-	if v.EndID != nil {
-		enc.AddInt64("endID", *v.EndID)
+	if v.Kind != nil {
+		err = multierr.Append(err, enc.AddObject("kind", *v.Kind))
 	}
 	return err
 }
 
-// GetStartID returns the value of StartID if it is set or its
+// GetName returns the value of Name if it is set or its
 // zero value if it is unset.
-func (v *TaskIDBlock) GetStartID() (o int64) {
-	if v != nil && v.StartID != nil {
-		return *v.StartID
+func (v *TaskList) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
 	}
 
 	return
 }
 
-// IsSetStartID returns true if StartID is not nil.
-func (v *TaskIDBlock) IsSetStartID() bool {
-	return v != nil && v.StartID != nil
+// IsSetName returns true if Name is not nil.
+func (v *TaskList) IsSetName() bool {
+	return v != nil && v.Name != nil
 }
 
-// GetEndID returns the value of EndID if it is set or its
+// GetKind returns the value of Kind if it is set or its
 // zero value if it is unset.
-func (v *TaskIDBlock) GetEndID() (o int64) {
-	if v != nil && v.EndID != nil {
-		return *v.EndID
+func (v *TaskList) GetKind() (o TaskListKind) {
+	if v != nil && v.Kind != nil {
+		return *v.Kind
 	}
 
 	return
 }
 
-// IsSetEndID returns true if EndID is not nil.
-func (v *TaskIDBlock) IsSetEndID() bool {
-	return v != nil && v.EndID != nil
+// IsSetKind returns true if Kind is not nil.
+func (v *TaskList) IsSetKind() bool {
+	return v != nil && v.Kind != nil
 }
 
-type TaskList struct {
-	Name *string       `json:"name,omitempty"`
-	Kind *TaskListKind `json:"kind,omitempty"`
+type TaskListKind int32
+
+const (
+	TaskListKindNormal TaskListKind = 0
+	TaskListKindSticky TaskListKind = 1
+)
+
+// TaskListKind_Values returns all recognized values of TaskListKind.
+func TaskListKind_Values() []TaskListKind {
+	return []TaskListKind{
+		TaskListKindNormal,
+		TaskListKindSticky,
+	}
 }
 
-// ToWire translates a TaskList struct into a Thrift-level intermediate
+// UnmarshalText tries to decode TaskListKind from a byte slice
+// containing its name.
+//
+//   var v TaskListKind
+//   err := v.UnmarshalText([]byte("NORMAL"))
+func (v *TaskListKind) UnmarshalText(value []byte) error {
+	switch s := string(value); s {
+	case "NORMAL":
+		*v = TaskListKindNormal
+		return nil
+	case "STICKY":
+		*v = TaskListKindSticky
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskListKind", err)
+		}
+		*v = TaskListKind(val)
+		return nil
+	}
+}
+
+// MarshalText encodes TaskListKind to text.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements the TextMarshaler interface.
+func (v TaskListKind) MarshalText() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return []byte("NORMAL"), nil
+	case 1:
+		return []byte("STICKY"), nil
+	}
+	return []byte(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TaskListKind.
+// Enums are logged as objects, where the value is logged with key "value", and
+// if this value's name is known, the name is logged with key "name".
+func (v TaskListKind) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "NORMAL")
+	case 1:
+		enc.AddString("name", "STICKY")
+	}
+	return nil
+}
+
+// Ptr returns a pointer to this enum value.
+func (v TaskListKind) Ptr() *TaskListKind {
+	return &v
+}
+
+// Encode encodes TaskListKind directly to bytes.
+//
+//   sWriter := BinaryStreamer.Writer(writer)
+//
+//   var v TaskListKind
+//   return v.Encode(sWriter)
+func (v TaskListKind) Encode(sw stream.Writer) error {
+	return sw.WriteInt32(int32(v))
+}
+
+// ToWire translates TaskListKind into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v TaskListKind) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes TaskListKind from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return TaskListKind(0), err
+//   }
+//
+//   var v TaskListKind
+//   if err := v.FromWire(x); err != nil {
+//     return TaskListKind(0), err
+//   }
+//   return v, nil
+func (v *TaskListKind) FromWire(w wire.Value) error {
+	*v = (TaskListKind)(w.GetI32())
+	return nil
+}
+
+// Decode reads off the encoded TaskListKind directly off of the wire.
+//
+//   sReader := BinaryStreamer.Reader(reader)
+//
+//   var v TaskListKind
+//   if err := v.Decode(sReader); err != nil {
+//     return TaskListKind(0), err
+//   }
+//   return v, nil
+func (v *TaskListKind) Decode(sr stream.Reader) error {
+	i, err := sr.ReadInt32()
+	if err != nil {
+		return err
+	}
+	*v = (TaskListKind)(i)
+	return nil
+}
+
+// String returns a readable string representation of TaskListKind.
+func (v TaskListKind) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "NORMAL"
+	case 1:
+		return "STICKY"
+	}
+	return fmt.Sprintf("TaskListKind(%d)", w)
+}
+
+// Equals returns true if this TaskListKind value matches the provided
+// value.
+func (v TaskListKind) Equals(rhs TaskListKind) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes TaskListKind into JSON.
+//
+// If the enum value is recognized, its name is returned.
+// Otherwise, its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v TaskListKind) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"NORMAL\""), nil
+	case 1:
+		return ([]byte)("\"STICKY\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode TaskListKind from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *TaskListKind) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskListKind")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskListKind")
+		}
+		*v = (TaskListKind)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskListKind")
+	}
+}
+
+type TaskListMatchStats struct {
+	SyncMatched     *int64 `json:"syncMatched,omitempty"`
+	BufferedMatched *int64 `json:"bufferedMatched,omitempty"`
+	Forwarded       *int64 `json:"forwarded,omitempty"`
+	Expired         *int64 `json:"expired,omitempty"`
+}
+
+// ToWire translates a TaskListMatchStats struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -86011,45 +91182,55 @@ type TaskList struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *TaskList) ToWire() (wire.Value, error) {
+func (v *TaskListMatchStats) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
+	if v.SyncMatched != nil {
+		w, err = wire.NewValueI64(*(v.SyncMatched)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.Kind != nil {
-		w, err = v.Kind.ToWire()
+	if v.BufferedMatched != nil {
+		w, err = wire.NewValueI64(*(v.BufferedMatched)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
+	if v.Forwarded != nil {
+		w, err = wire.NewValueI64(*(v.Forwarded)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Expired != nil {
+		w, err = wire.NewValueI64(*(v.Expired)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _TaskListKind_Read(w wire.Value) (TaskListKind, error) {
-	var v TaskListKind
-	err := v.FromWire(w)
-	return v, err
-}
-
-// FromWire deserializes a TaskList struct from its Thrift-level
+// FromWire deserializes a TaskListMatchStats struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a TaskList struct
+// An error is returned if we were unable to build a TaskListMatchStats struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -86057,31 +91238,51 @@ func _TaskListKind_Read(w wire.Value) (TaskListKind, error) {
 //     return nil, err
 //   }
 //
-//   var v TaskList
+//   var v TaskListMatchStats
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *TaskList) FromWire(w wire.Value) error {
+func (v *TaskListMatchStats) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.Name = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.SyncMatched = &x
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TI32 {
-				var x TaskListKind
-				x, err = _TaskListKind_Read(field.Value)
-				v.Kind = &x
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.BufferedMatched = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Forwarded = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.Expired = &x
 				if err != nil {
 					return err
 				}
@@ -86093,20 +91294,20 @@ func (v *TaskList) FromWire(w wire.Value) error {
 	return nil
 }
 
-// Encode serializes a TaskList struct directly into bytes, without going
+// Encode serializes a TaskListMatchStats struct directly into bytes, without going
 // through an intermediary type.
 //
-// An error is returned if a TaskList struct could not be encoded.
-func (v *TaskList) Encode(sw stream.Writer) error {
+// An error is returned if a TaskListMatchStats struct could not be encoded.
+func (v *TaskListMatchStats) Encode(sw stream.Writer) error {
 	if err := sw.WriteStructBegin(); err != nil {
 		return err
 	}
 
-	if v.Name != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TBinary}); err != nil {
+	if v.SyncMatched != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 10, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := sw.WriteString(*(v.Name)); err != nil {
+		if err := sw.WriteInt64(*(v.SyncMatched)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -86114,11 +91315,11 @@ func (v *TaskList) Encode(sw stream.Writer) error {
 		}
 	}
 
-	if v.Kind != nil {
-		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI32}); err != nil {
+	if v.BufferedMatched != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 20, Type: wire.TI64}); err != nil {
 			return err
 		}
-		if err := v.Kind.Encode(sw); err != nil {
+		if err := sw.WriteInt64(*(v.BufferedMatched)); err != nil {
 			return err
 		}
 		if err := sw.WriteFieldEnd(); err != nil {
@@ -86126,21 +91327,39 @@ func (v *TaskList) Encode(sw stream.Writer) error {
 		}
 	}
 
-	return sw.WriteStructEnd()
-}
+	if v.Forwarded != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 30, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Forwarded)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
 
-func _TaskListKind_Decode(sr stream.Reader) (TaskListKind, error) {
-	var v TaskListKind
-	err := v.Decode(sr)
-	return v, err
+	if v.Expired != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.Expired)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	return sw.WriteStructEnd()
 }
 
-// Decode deserializes a TaskList struct directly from its Thrift-level
+// Decode deserializes a TaskListMatchStats struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
-// An error is returned if a TaskList struct could not be generated from the wire
+// An error is returned if a TaskListMatchStats struct could not be generated from the wire
 // representation.
-func (v *TaskList) Decode(sr stream.Reader) error {
+func (v *TaskListMatchStats) Decode(sr stream.Reader) error {
 
 	if err := sr.ReadStructBegin(); err != nil {
 		return err
@@ -86153,18 +91372,34 @@ func (v *TaskList) Decode(sr stream.Reader) error {
 
 	for ok {
 		switch {
-		case fh.ID == 10 && fh.Type == wire.TBinary:
-			var x string
-			x, err = sr.ReadString()
-			v.Name = &x
+		case fh.ID == 10 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.SyncMatched = &x
 			if err != nil {
 				return err
 			}
 
-		case fh.ID == 20 && fh.Type == wire.TI32:
-			var x TaskListKind
-			x, err = _TaskListKind_Decode(sr)
-			v.Kind = &x
+		case fh.ID == 20 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.BufferedMatched = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 30 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Forwarded = &x
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 40 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.Expired = &x
 			if err != nil {
 				return err
 			}
@@ -86191,51 +91426,55 @@ func (v *TaskList) Decode(sr stream.Reader) error {
 	return nil
 }
 
-// String returns a readable string representation of a TaskList
+// String returns a readable string representation of a TaskListMatchStats
 // struct.
-func (v *TaskList) String() string {
+func (v *TaskListMatchStats) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [4]string
 	i := 0
-	if v.Name != nil {
-		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+	if v.SyncMatched != nil {
+		fields[i] = fmt.Sprintf("SyncMatched: %v", *(v.SyncMatched))
 		i++
 	}
-	if v.Kind != nil {
-		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
+	if v.BufferedMatched != nil {
+		fields[i] = fmt.Sprintf("BufferedMatched: %v", *(v.BufferedMatched))
 		i++
 	}
-
-	return fmt.Sprintf("TaskList{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _TaskListKind_EqualsPtr(lhs, rhs *TaskListKind) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return x.Equals(y)
+	if v.Forwarded != nil {
+		fields[i] = fmt.Sprintf("Forwarded: %v", *(v.Forwarded))
+		i++
 	}
-	return lhs == nil && rhs == nil
+	if v.Expired != nil {
+		fields[i] = fmt.Sprintf("Expired: %v", *(v.Expired))
+		i++
+	}
+
+	return fmt.Sprintf("TaskListMatchStats{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this TaskList match the
-// provided TaskList.
+// Equals returns true if all the fields of this TaskListMatchStats match the
+// provided TaskListMatchStats.
 //
 // This function performs a deep comparison.
-func (v *TaskList) Equals(rhs *TaskList) bool {
+func (v *TaskListMatchStats) Equals(rhs *TaskListMatchStats) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.Name, rhs.Name) {
+	if !_I64_EqualsPtr(v.SyncMatched, rhs.SyncMatched) {
 		return false
 	}
-	if !_TaskListKind_EqualsPtr(v.Kind, rhs.Kind) {
+	if !_I64_EqualsPtr(v.BufferedMatched, rhs.BufferedMatched) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Forwarded, rhs.Forwarded) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Expired, rhs.Expired) {
 		return false
 	}
 
@@ -86243,247 +91482,84 @@ func (v *TaskList) Equals(rhs *TaskList) bool {
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TaskList.
-func (v *TaskList) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of TaskListMatchStats.
+func (v *TaskListMatchStats) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Name != nil {
-		enc.AddString("name", *v.Name)
+	if v.SyncMatched != nil {
+		enc.AddInt64("syncMatched", *v.SyncMatched)
 	}
-	if v.Kind != nil {
-		err = multierr.Append(err, enc.AddObject("kind", *v.Kind))
+	if v.BufferedMatched != nil {
+		enc.AddInt64("bufferedMatched", *v.BufferedMatched)
+	}
+	if v.Forwarded != nil {
+		enc.AddInt64("forwarded", *v.Forwarded)
+	}
+	if v.Expired != nil {
+		enc.AddInt64("expired", *v.Expired)
 	}
 	return err
 }
 
-// GetName returns the value of Name if it is set or its
+// GetSyncMatched returns the value of SyncMatched if it is set or its
 // zero value if it is unset.
-func (v *TaskList) GetName() (o string) {
-	if v != nil && v.Name != nil {
-		return *v.Name
+func (v *TaskListMatchStats) GetSyncMatched() (o int64) {
+	if v != nil && v.SyncMatched != nil {
+		return *v.SyncMatched
 	}
 
 	return
 }
 
-// IsSetName returns true if Name is not nil.
-func (v *TaskList) IsSetName() bool {
-	return v != nil && v.Name != nil
+// IsSetSyncMatched returns true if SyncMatched is not nil.
+func (v *TaskListMatchStats) IsSetSyncMatched() bool {
+	return v != nil && v.SyncMatched != nil
 }
 
-// GetKind returns the value of Kind if it is set or its
+// GetBufferedMatched returns the value of BufferedMatched if it is set or its
 // zero value if it is unset.
-func (v *TaskList) GetKind() (o TaskListKind) {
-	if v != nil && v.Kind != nil {
-		return *v.Kind
+func (v *TaskListMatchStats) GetBufferedMatched() (o int64) {
+	if v != nil && v.BufferedMatched != nil {
+		return *v.BufferedMatched
 	}
 
 	return
 }
 
-// IsSetKind returns true if Kind is not nil.
-func (v *TaskList) IsSetKind() bool {
-	return v != nil && v.Kind != nil
+// IsSetBufferedMatched returns true if BufferedMatched is not nil.
+func (v *TaskListMatchStats) IsSetBufferedMatched() bool {
+	return v != nil && v.BufferedMatched != nil
 }
 
-type TaskListKind int32
-
-const (
-	TaskListKindNormal TaskListKind = 0
-	TaskListKindSticky TaskListKind = 1
-)
-
-// TaskListKind_Values returns all recognized values of TaskListKind.
-func TaskListKind_Values() []TaskListKind {
-	return []TaskListKind{
-		TaskListKindNormal,
-		TaskListKindSticky,
-	}
-}
-
-// UnmarshalText tries to decode TaskListKind from a byte slice
-// containing its name.
-//
-//   var v TaskListKind
-//   err := v.UnmarshalText([]byte("NORMAL"))
-func (v *TaskListKind) UnmarshalText(value []byte) error {
-	switch s := string(value); s {
-	case "NORMAL":
-		*v = TaskListKindNormal
-		return nil
-	case "STICKY":
-		*v = TaskListKindSticky
-		return nil
-	default:
-		val, err := strconv.ParseInt(s, 10, 32)
-		if err != nil {
-			return fmt.Errorf("unknown enum value %q for %q: %v", s, "TaskListKind", err)
-		}
-		*v = TaskListKind(val)
-		return nil
-	}
-}
-
-// MarshalText encodes TaskListKind to text.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements the TextMarshaler interface.
-func (v TaskListKind) MarshalText() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return []byte("NORMAL"), nil
-	case 1:
-		return []byte("STICKY"), nil
-	}
-	return []byte(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of TaskListKind.
-// Enums are logged as objects, where the value is logged with key "value", and
-// if this value's name is known, the name is logged with key "name".
-func (v TaskListKind) MarshalLogObject(enc zapcore.ObjectEncoder) error {
-	enc.AddInt32("value", int32(v))
-	switch int32(v) {
-	case 0:
-		enc.AddString("name", "NORMAL")
-	case 1:
-		enc.AddString("name", "STICKY")
+// GetForwarded returns the value of Forwarded if it is set or its
+// zero value if it is unset.
+func (v *TaskListMatchStats) GetForwarded() (o int64) {
+	if v != nil && v.Forwarded != nil {
+		return *v.Forwarded
 	}
-	return nil
-}
 
-// Ptr returns a pointer to this enum value.
-func (v TaskListKind) Ptr() *TaskListKind {
-	return &v
-}
-
-// Encode encodes TaskListKind directly to bytes.
-//
-//   sWriter := BinaryStreamer.Writer(writer)
-//
-//   var v TaskListKind
-//   return v.Encode(sWriter)
-func (v TaskListKind) Encode(sw stream.Writer) error {
-	return sw.WriteInt32(int32(v))
-}
-
-// ToWire translates TaskListKind into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
-//
-// Enums are represented as 32-bit integers over the wire.
-func (v TaskListKind) ToWire() (wire.Value, error) {
-	return wire.NewValueI32(int32(v)), nil
+	return
 }
 
-// FromWire deserializes TaskListKind from its Thrift-level
-// representation.
-//
-//   x, err := binaryProtocol.Decode(reader, wire.TI32)
-//   if err != nil {
-//     return TaskListKind(0), err
-//   }
-//
-//   var v TaskListKind
-//   if err := v.FromWire(x); err != nil {
-//     return TaskListKind(0), err
-//   }
-//   return v, nil
-func (v *TaskListKind) FromWire(w wire.Value) error {
-	*v = (TaskListKind)(w.GetI32())
-	return nil
+// IsSetForwarded returns true if Forwarded is not nil.
+func (v *TaskListMatchStats) IsSetForwarded() bool {
+	return v != nil && v.Forwarded != nil
 }
 
-// Decode reads off the encoded TaskListKind directly off of the wire.
-//
-//   sReader := BinaryStreamer.Reader(reader)
-//
-//   var v TaskListKind
-//   if err := v.Decode(sReader); err != nil {
-//     return TaskListKind(0), err
-//   }
-//   return v, nil
-func (v *TaskListKind) Decode(sr stream.Reader) error {
-	i, err := sr.ReadInt32()
-	if err != nil {
-		return err
-	}
-	*v = (TaskListKind)(i)
-	return nil
-}
-
-// String returns a readable string representation of TaskListKind.
-func (v TaskListKind) String() string {
-	w := int32(v)
-	switch w {
-	case 0:
-		return "NORMAL"
-	case 1:
-		return "STICKY"
+// GetExpired returns the value of Expired if it is set or its
+// zero value if it is unset.
+func (v *TaskListMatchStats) GetExpired() (o int64) {
+	if v != nil && v.Expired != nil {
+		return *v.Expired
 	}
-	return fmt.Sprintf("TaskListKind(%d)", w)
-}
 
-// Equals returns true if this TaskListKind value matches the provided
-// value.
-func (v TaskListKind) Equals(rhs TaskListKind) bool {
-	return v == rhs
+	return
 }
 
-// MarshalJSON serializes TaskListKind into JSON.
-//
-// If the enum value is recognized, its name is returned.
-// Otherwise, its integer value is returned.
-//
-// This implements json.Marshaler.
-func (v TaskListKind) MarshalJSON() ([]byte, error) {
-	switch int32(v) {
-	case 0:
-		return ([]byte)("\"NORMAL\""), nil
-	case 1:
-		return ([]byte)("\"STICKY\""), nil
-	}
-	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
-}
-
-// UnmarshalJSON attempts to decode TaskListKind from its JSON
-// representation.
-//
-// This implementation supports both, numeric and string inputs. If a
-// string is provided, it must be a known enum name.
-//
-// This implements json.Unmarshaler.
-func (v *TaskListKind) UnmarshalJSON(text []byte) error {
-	d := json.NewDecoder(bytes.NewReader(text))
-	d.UseNumber()
-	t, err := d.Token()
-	if err != nil {
-		return err
-	}
-
-	switch w := t.(type) {
-	case json.Number:
-		x, err := w.Int64()
-		if err != nil {
-			return err
-		}
-		if x > math.MaxInt32 {
-			return fmt.Errorf("enum overflow from JSON %q for %q", text, "TaskListKind")
-		}
-		if x < math.MinInt32 {
-			return fmt.Errorf("enum underflow from JSON %q for %q", text, "TaskListKind")
-		}
-		*v = (TaskListKind)(x)
-		return nil
-	case string:
-		return v.UnmarshalText([]byte(w))
-	default:
-		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "TaskListKind")
-	}
+// IsSetExpired returns true if Expired is not nil.
+func (v *TaskListMatchStats) IsSetExpired() bool {
+	return v != nil && v.Expired != nil
 }
 
 type TaskListMetadata struct {
@@ -86971,11 +92047,13 @@ func (v *TaskListPartitionMetadata) IsSetOwnerHostName() bool {
 }
 
 type TaskListStatus struct {
-	BacklogCountHint *int64       `json:"backlogCountHint,omitempty"`
-	ReadLevel        *int64       `json:"readLevel,omitempty"`
-	AckLevel         *int64       `json:"ackLevel,omitempty"`
-	RatePerSecond    *float64     `json:"ratePerSecond,omitempty"`
-	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogCountHint     *int64              `json:"backlogCountHint,omitempty"`
+	ReadLevel            *int64              `json:"readLevel,omitempty"`
+	AckLevel             *int64              `json:"ackLevel,omitempty"`
+	RatePerSecond        *float64            `json:"ratePerSecond,omitempty"`
+	TaskIDBlock          *TaskIDBlock        `json:"taskIDBlock,omitempty"`
+	MatchStats           *TaskListMatchStats `json:"matchStats,omitempty"`
+	OutstandingPollCount *int64              `json:"outstandingPollCount,omitempty"`
 }
 
 // ToWire translates a TaskListStatus struct into a Thrift-level intermediate
@@ -86995,7 +92073,7 @@ type TaskListStatus struct {
 //   }
 func (v *TaskListStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [7]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -87041,6 +92119,22 @@ func (v *TaskListStatus) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 40, Value: w}
 		i++
 	}
+	if v.MatchStats != nil {
+		w, err = v.MatchStats.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.OutstandingPollCount != nil {
+		w, err = wire.NewValueI64(*(v.OutstandingPollCount)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -87051,6 +92145,12 @@ func _TaskIDBlock_Read(w wire.Value) (*TaskIDBlock, error) {
 	return &v, err
 }
 
+func _TaskListMatchStats_Read(w wire.Value) (*TaskListMatchStats, error) {
+	var v TaskListMatchStats
+	err := v.FromWire(w)
+	return &v, err
+}
+
 // FromWire deserializes a TaskListStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -87120,6 +92220,24 @@ func (v *TaskListStatus) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 50:
+			if field.Value.Type() == wire.TStruct {
+				v.MatchStats, err = _TaskListMatchStats_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.OutstandingPollCount = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -87196,6 +92314,30 @@ func (v *TaskListStatus) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.MatchStats != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 50, Type: wire.TStruct}); err != nil {
+			return err
+		}
+		if err := v.MatchStats.Encode(sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.OutstandingPollCount != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 60, Type: wire.TI64}); err != nil {
+			return err
+		}
+		if err := sw.WriteInt64(*(v.OutstandingPollCount)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -87205,6 +92347,12 @@ func _TaskIDBlock_Decode(sr stream.Reader) (*TaskIDBlock, error) {
 	return &v, err
 }
 
+func _TaskListMatchStats_Decode(sr stream.Reader) (*TaskListMatchStats, error) {
+	var v TaskListMatchStats
+	err := v.Decode(sr)
+	return &v, err
+}
+
 // Decode deserializes a TaskListStatus struct directly from its Thrift-level
 // representation, without going through an intemediary type.
 //
@@ -87261,6 +92409,20 @@ func (v *TaskListStatus) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 50 && fh.Type == wire.TStruct:
+			v.MatchStats, err = _TaskListMatchStats_Decode(sr)
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 60 && fh.Type == wire.TI64:
+			var x int64
+			x, err = sr.ReadInt64()
+			v.OutstandingPollCount = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -87290,7 +92452,7 @@ func (v *TaskListStatus) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [7]string
 	i := 0
 	if v.BacklogCountHint != nil {
 		fields[i] = fmt.Sprintf("BacklogCountHint: %v", *(v.BacklogCountHint))
@@ -87312,6 +92474,14 @@ func (v *TaskListStatus) String() string {
 		fields[i] = fmt.Sprintf("TaskIDBlock: %v", v.TaskIDBlock)
 		i++
 	}
+	if v.MatchStats != nil {
+		fields[i] = fmt.Sprintf("MatchStats: %v", v.MatchStats)
+		i++
+	}
+	if v.OutstandingPollCount != nil {
+		fields[i] = fmt.Sprintf("OutstandingPollCount: %v", *(v.OutstandingPollCount))
+		i++
+	}
 
 	return fmt.Sprintf("TaskListStatus{%v}", strings.Join(fields[:i], ", "))
 }
@@ -87341,6 +92511,12 @@ func (v *TaskListStatus) Equals(rhs *TaskListStatus) bool {
 	if !((v.TaskIDBlock == nil && rhs.TaskIDBlock == nil) || (v.TaskIDBlock != nil && rhs.TaskIDBlock != nil && v.TaskIDBlock.Equals(rhs.TaskIDBlock))) {
 		return false
 	}
+	if !((v.MatchStats == nil && rhs.MatchStats == nil) || (v.MatchStats != nil && rhs.MatchStats != nil && v.MatchStats.Equals(rhs.MatchStats))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.OutstandingPollCount, rhs.OutstandingPollCount) {
+		return false
+	}
 
 	return true
 }
@@ -87366,6 +92542,12 @@ func (v *TaskListStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error)
 	if v.TaskIDBlock != nil {
 		err = multierr.Append(err, enc.AddObject("taskIDBlock", v.TaskIDBlock))
 	}
+	if v.MatchStats != nil {
+		err = multierr.Append(err, enc.AddObject("matchStats", v.MatchStats))
+	}
+	if v.OutstandingPollCount != nil {
+		enc.AddInt64("outstandingPollCount", *v.OutstandingPollCount)
+	}
 	return err
 }
 
@@ -87444,6 +92626,36 @@ func (v *TaskListStatus) IsSetTaskIDBlock() bool {
 	return v != nil && v.TaskIDBlock != nil
 }
 
+// GetMatchStats returns the value of MatchStats if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetMatchStats() (o *TaskListMatchStats) {
+	if v != nil && v.MatchStats != nil {
+		return v.MatchStats
+	}
+
+	return
+}
+
+// IsSetMatchStats returns true if MatchStats is not nil.
+func (v *TaskListStatus) IsSetMatchStats() bool {
+	return v != nil && v.MatchStats != nil
+}
+
+// GetOutstandingPollCount returns the value of OutstandingPollCount if it is set or its
+// zero value if it is unset.
+func (v *TaskListStatus) GetOutstandingPollCount() (o int64) {
+	if v != nil && v.OutstandingPollCount != nil {
+		return *v.OutstandingPollCount
+	}
+
+	return
+}
+
+// IsSetOutstandingPollCount returns true if OutstandingPollCount is not nil.
+func (v *TaskListStatus) IsSetOutstandingPollCount() bool {
+	return v != nil && v.OutstandingPollCount != nil
+}
+
 type TaskListType int32
 
 const (
@@ -89610,6 +94822,7 @@ type UpdateDomainInfo struct {
 	Description *string           `json:"description,omitempty"`
 	OwnerEmail  *string           `json:"ownerEmail,omitempty"`
 	Data        map[string]string `json:"data,omitempty"`
+	Metadata    map[string][]byte `json:"metadata,omitempty"`
 }
 
 // ToWire translates a UpdateDomainInfo struct into a Thrift-level intermediate
@@ -89629,7 +94842,7 @@ type UpdateDomainInfo struct {
 //   }
 func (v *UpdateDomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -89659,6 +94872,14 @@ func (v *UpdateDomainInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 30, Value: w}
 		i++
 	}
+	if v.Metadata != nil {
+		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Metadata)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -89712,6 +94933,14 @@ func (v *UpdateDomainInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 40:
+			if field.Value.Type() == wire.TMap {
+				v.Metadata, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -89764,6 +94993,18 @@ func (v *UpdateDomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Metadata != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 40, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_Binary_Encode(v.Metadata, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -89807,6 +95048,12 @@ func (v *UpdateDomainInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 40 && fh.Type == wire.TMap:
+			v.Metadata, err = _Map_String_Binary_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -89836,7 +95083,7 @@ func (v *UpdateDomainInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [4]string
 	i := 0
 	if v.Description != nil {
 		fields[i] = fmt.Sprintf("Description: %v", *(v.Description))
@@ -89850,6 +95097,10 @@ func (v *UpdateDomainInfo) String() string {
 		fields[i] = fmt.Sprintf("Data: %v", v.Data)
 		i++
 	}
+	if v.Metadata != nil {
+		fields[i] = fmt.Sprintf("Metadata: %v", v.Metadata)
+		i++
+	}
 
 	return fmt.Sprintf("UpdateDomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -89873,6 +95124,9 @@ func (v *UpdateDomainInfo) Equals(rhs *UpdateDomainInfo) bool {
 	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && _Map_String_String_Equals(v.Data, rhs.Data))) {
 		return false
 	}
+	if !((v.Metadata == nil && rhs.Metadata == nil) || (v.Metadata != nil && rhs.Metadata != nil && _Map_String_Binary_Equals(v.Metadata, rhs.Metadata))) {
+		return false
+	}
 
 	return true
 }
@@ -89892,6 +95146,9 @@ func (v *UpdateDomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err erro
 	if v.Data != nil {
 		err = multierr.Append(err, enc.AddObject("data", (_Map_String_String_Zapper)(v.Data)))
 	}
+	if v.Metadata != nil {
+		err = multierr.Append(err, enc.AddObject("metadata", (_Map_String_Binary_Zapper)(v.Metadata)))
+	}
 	return err
 }
 
@@ -89940,6 +95197,21 @@ func (v *UpdateDomainInfo) IsSetData() bool {
 	return v != nil && v.Data != nil
 }
 
+// GetMetadata returns the value of Metadata if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainInfo) GetMetadata() (o map[string][]byte) {
+	if v != nil && v.Metadata != nil {
+		return v.Metadata
+	}
+
+	return
+}
+
+// IsSetMetadata returns true if Metadata is not nil.
+func (v *UpdateDomainInfo) IsSetMetadata() bool {
+	return v != nil && v.Metadata != nil
+}
+
 type UpdateDomainRequest struct {
 	Name                     *string                         `json:"name,omitempty"`
 	UpdatedInfo              *UpdateDomainInfo               `json:"updatedInfo,omitempty"`
@@ -89948,6 +95220,7 @@ type UpdateDomainRequest struct {
 	SecurityToken            *string                         `json:"securityToken,omitempty"`
 	DeleteBadBinary          *string                         `json:"deleteBadBinary,omitempty"`
 	FailoverTimeoutInSeconds *int32                          `json:"failoverTimeoutInSeconds,omitempty"`
+	UUID                     *string                         `json:"uuid,omitempty"`
 }
 
 // ToWire translates a UpdateDomainRequest struct into a Thrift-level intermediate
@@ -89967,7 +95240,7 @@ type UpdateDomainRequest struct {
 //   }
 func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [7]wire.Field
+		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -90029,6 +95302,14 @@ func (v *UpdateDomainRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 70, Value: w}
 		i++
 	}
+	if v.UUID != nil {
+		w, err = wire.NewValueString(*(v.UUID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 80, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -90124,6 +95405,16 @@ func (v *UpdateDomainRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 80:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.UUID = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -90224,6 +95515,18 @@ func (v *UpdateDomainRequest) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.UUID != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 80, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.UUID)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -90301,6 +95604,14 @@ func (v *UpdateDomainRequest) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 80 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.UUID = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -90330,7 +95641,7 @@ func (v *UpdateDomainRequest) String() string {
 		return "<nil>"
 	}
 
-	var fields [7]string
+	var fields [8]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -90360,6 +95671,10 @@ func (v *UpdateDomainRequest) String() string {
 		fields[i] = fmt.Sprintf("FailoverTimeoutInSeconds: %v", *(v.FailoverTimeoutInSeconds))
 		i++
 	}
+	if v.UUID != nil {
+		fields[i] = fmt.Sprintf("UUID: %v", *(v.UUID))
+		i++
+	}
 
 	return fmt.Sprintf("UpdateDomainRequest{%v}", strings.Join(fields[:i], ", "))
 }
@@ -90395,6 +95710,9 @@ func (v *UpdateDomainRequest) Equals(rhs *UpdateDomainRequest) bool {
 	if !_I32_EqualsPtr(v.FailoverTimeoutInSeconds, rhs.FailoverTimeoutInSeconds) {
 		return false
 	}
+	if !_String_EqualsPtr(v.UUID, rhs.UUID) {
+		return false
+	}
 
 	return true
 }
@@ -90426,6 +95744,9 @@ func (v *UpdateDomainRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err e
 	if v.FailoverTimeoutInSeconds != nil {
 		enc.AddInt32("failoverTimeoutInSeconds", *v.FailoverTimeoutInSeconds)
 	}
+	if v.UUID != nil {
+		enc.AddString("uuid", *v.UUID)
+	}
 	return err
 }
 
@@ -90534,6 +95855,21 @@ func (v *UpdateDomainRequest) IsSetFailoverTimeoutInSeconds() bool {
 	return v != nil && v.FailoverTimeoutInSeconds != nil
 }
 
+// GetUUID returns the value of UUID if it is set or its
+// zero value if it is unset.
+func (v *UpdateDomainRequest) GetUUID() (o string) {
+	if v != nil && v.UUID != nil {
+		return *v.UUID
+	}
+
+	return
+}
+
+// IsSetUUID returns true if UUID is not nil.
+func (v *UpdateDomainRequest) IsSetUUID() bool {
+	return v != nil && v.UUID != nil
+}
+
 type UpdateDomainResponse struct {
 	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
 	Configuration            *DomainConfiguration            `json:"configuration,omitempty"`
@@ -101747,8 +107083,8 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "shared",
 	Package:  "github.com/uber/cadence/.gen/go/shared",
 	FilePath: "shared.thrift",
-	SHA1:     "572017d80f500ab0ae4c98e70d583661554bfbdd",
+	SHA1:     "c3f033762193c8f4e196331273624d5b9a06258c",
 	Raw:      rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception InternalDataInconsistencyError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n}\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n}\n\nexception ServiceBusyError {\n  1: required string message\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nexception RemoteSyncMatchedError {\n  10: required string message\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  130: optional bool isCron\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n}\n\nstruct FailoverInfo {\n    10: optional i64 (js.type = \"Long\") failoverVersion\n    20: optional i64 (js.type = \"Long\") failoverStartTimestamp\n    30: optional i64 (js.type = \"Long\") failoverExpireTimestamp\n    40: optional i32 completedShardCount\n    50: optional list<i32> pendingShards\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional FailoverInfo failoverInfo\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n}\n\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n}\n\nstruct PendingChildExecutionInfo {\n  1: optional string domain\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional map<string,DescribeTaskListResponse> decisionTaskListMap\n  20: optional map<string,DescribeTaskListResponse> activityTaskListMap\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n  50: optional string                   clusterName\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct FeatureFlags {\n\t10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n  RecordChildWorkflowExecutionComplete\n  ApplyParentClosePolicy\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task\n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID\n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes {\n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional i64 (js.type = \"Long\") initiatedEventID\n  50: optional HistoryEvent completionEvent\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes {\n}\n\nstruct ApplyParentClosePolicyAttributes {\n  10: optional string childDomainID\n  20: optional string childWorkflowID\n  30: optional string childRunID\n  40: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct ApplyParentClosePolicyStatus {\n  10: optional bool completed\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct ApplyParentClosePolicyRequest {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional ApplyParentClosePolicyStatus status\n}\n\nstruct CrossClusterApplyParentClosePolicyRequestAttributes {\n  10: optional list<ApplyParentClosePolicyRequest> children\n}\n\nstruct ApplyParentClosePolicyResult {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct CrossClusterApplyParentClosePolicyResponseAttributes {\n  10: optional list<ApplyParentClosePolicyResult> childrenStatus\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n  50: optional CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes recordChildWorkflowExecutionCompleteAttributes\n  60: optional CrossClusterApplyParentClosePolicyRequestAttributes applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional i16 taskState\n  40: optional CrossClusterTaskFailedCause failedCause\n  50: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  60: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  70: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n  80: optional CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes recordChildWorkflowExecutionCompleteAttributes\n  90: optional CrossClusterApplyParentClosePolicyResponseAttributes applyParentClosePolicyAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence\n\nexception BadRequestError {\n  1: required string message\n}\n\nexception InternalServiceError {\n  1: required string message\n}\n\nexception InternalDataInconsistencyError {\n  1: required string message\n}\n\nexception DomainAlreadyExistsError {\n  1: required string message\n}\n\nexception WorkflowExecutionAlreadyStartedError {\n  10: optional string message\n  20: optional string startRequestId\n  30: optional string runId\n}\n\nexception WorkflowExecutionAlreadyCompletedError {\n  1: required string message\n}\n\nexception EntityNotExistsError {\n  1: required string message\n  2: optional string currentCluster\n  3: optional string activeCluster\n}\n\nexception ServiceBusyError {\n  1: required string message\n  2: optional i64 retryAfterInMillis\n}\n\nexception CancellationAlreadyRequestedError {\n  1: required string message\n}\n\nexception QueryFailedError {\n  1: required string message\n}\n\nexception DomainNotActiveError {\n  1: required string message\n  2: required string domainName\n  3: required string currentCluster\n  4: required string activeCluster\n}\n\nexception LimitExceededError {\n  1: required string message\n}\n\nexception AccessDeniedError {\n  1: required string message\n}\n\nexception RetryTaskV2Error {\n  1: required string message\n  2: optional string domainId\n  3: optional string workflowId\n  4: optional string runId\n  5: optional i64 (js.type = \"Long\") startEventId\n  6: optional i64 (js.type = \"Long\") startEventVersion\n  7: optional i64 (js.type = \"Long\") endEventId\n  8: optional i64 (js.type = \"Long\") endEventVersion\n}\n\nexception ClientVersionNotSupportedError {\n  1: required string featureVersion\n  2: required string clientImpl\n  3: required string supportedVersions\n}\n\nexception FeatureNotEnabledError {\n  1: required string featureFlag\n}\n\nexception CurrentBranchChangedError {\n  10: required string message\n  20: required binary currentBranchToken\n}\n\nexception RemoteSyncMatchedError {\n  10: required string message\n}\n\nenum WorkflowIdReusePolicy {\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running, and the last execution close state is in\n   * [terminated, cancelled, timeouted, failed].\n   */\n  AllowDuplicateFailedOnly,\n  /*\n   * allow start a workflow execution using the same workflow ID,\n   * when workflow not running.\n   */\n  AllowDuplicate,\n  /*\n   * do not allow start a workflow execution using the same workflow ID at all\n   */\n  RejectDuplicate,\n  /*\n   * if a workflow is running using the same workflow ID, terminate it and start a new one\n   */\n  TerminateIfRunning,\n}\n\nenum DomainStatus {\n  REGISTERED,\n  DEPRECATED,\n  DELETED,\n  PROVISIONING,\n}\n\nenum TimeoutType {\n  START_TO_CLOSE,\n  SCHEDULE_TO_START,\n  SCHEDULE_TO_CLOSE,\n  HEARTBEAT,\n}\n\nenum ParentClosePolicy {\n\tABANDON,\n\tREQUEST_CANCEL,\n\tTERMINATE,\n}\n\n\n// whenever this list of decision is changed\n// do change the mutableStateBuilder.go\n// function shouldBufferEvent\n// to make sure wo do the correct event ordering\nenum DecisionType {\n  ScheduleActivityTask,\n  RequestCancelActivityTask,\n  StartTimer,\n  CompleteWorkflowExecution,\n  FailWorkflowExecution,\n  CancelTimer,\n  CancelWorkflowExecution,\n  RequestCancelExternalWorkflowExecution,\n  RecordMarker,\n  ContinueAsNewWorkflowExecution,\n  StartChildWorkflowExecution,\n  SignalExternalWorkflowExecution,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum EventType {\n  WorkflowExecutionStarted,\n  WorkflowExecutionCompleted,\n  WorkflowExecutionFailed,\n  WorkflowExecutionTimedOut,\n  DecisionTaskScheduled,\n  DecisionTaskStarted,\n  DecisionTaskCompleted,\n  DecisionTaskTimedOut\n  DecisionTaskFailed,\n  ActivityTaskScheduled,\n  ActivityTaskStarted,\n  ActivityTaskCompleted,\n  ActivityTaskFailed,\n  ActivityTaskTimedOut,\n  ActivityTaskCancelRequested,\n  RequestCancelActivityTaskFailed,\n  ActivityTaskCanceled,\n  TimerStarted,\n  TimerFired,\n  CancelTimerFailed,\n  TimerCanceled,\n  WorkflowExecutionCancelRequested,\n  WorkflowExecutionCanceled,\n  RequestCancelExternalWorkflowExecutionInitiated,\n  RequestCancelExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionCancelRequested,\n  MarkerRecorded,\n  WorkflowExecutionSignaled,\n  WorkflowExecutionTerminated,\n  WorkflowExecutionContinuedAsNew,\n  StartChildWorkflowExecutionInitiated,\n  StartChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionStarted,\n  ChildWorkflowExecutionCompleted,\n  ChildWorkflowExecutionFailed,\n  ChildWorkflowExecutionCanceled,\n  ChildWorkflowExecutionTimedOut,\n  ChildWorkflowExecutionTerminated,\n  SignalExternalWorkflowExecutionInitiated,\n  SignalExternalWorkflowExecutionFailed,\n  ExternalWorkflowExecutionSignaled,\n  UpsertWorkflowSearchAttributes,\n}\n\nenum DecisionTaskFailedCause {\n  UNHANDLED_DECISION,\n  BAD_SCHEDULE_ACTIVITY_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_ACTIVITY_ATTRIBUTES,\n  BAD_START_TIMER_ATTRIBUTES,\n  BAD_CANCEL_TIMER_ATTRIBUTES,\n  BAD_RECORD_MARKER_ATTRIBUTES,\n  BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_CONTINUE_AS_NEW_ATTRIBUTES,\n  START_TIMER_DUPLICATE_ID,\n  RESET_STICKY_TASKLIST,\n  WORKFLOW_WORKER_UNHANDLED_FAILURE,\n  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,\n  BAD_START_CHILD_EXECUTION_ATTRIBUTES,\n  FORCE_CLOSE_DECISION,\n  FAILOVER_CLOSE_DECISION,\n  BAD_SIGNAL_INPUT_SIZE,\n  RESET_WORKFLOW,\n  BAD_BINARY,\n  SCHEDULE_ACTIVITY_DUPLICATE_ID,\n  BAD_SEARCH_ATTRIBUTES,\n  BAD_TASK_LIST_PAYLOAD_SIZE,\n}\n\nenum DecisionTaskTimedOutCause {\n  TIMEOUT,\n  RESET,\n}\n\nenum CancelExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum SignalExternalWorkflowExecutionFailedCause {\n  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,\n}\n\nenum ChildWorkflowExecutionFailedCause {\n  WORKFLOW_ALREADY_RUNNING,\n}\n\n// TODO: when migrating to gRPC, add a running / none status,\n//  currently, customer is using null / nil as an indication\n//  that workflow is still running\nenum WorkflowExecutionCloseStatus {\n  COMPLETED,\n  FAILED,\n  CANCELED,\n  TERMINATED,\n  CONTINUED_AS_NEW,\n  TIMED_OUT,\n}\n\nenum QueryTaskCompletedType {\n  COMPLETED,\n  FAILED,\n}\n\nenum QueryResultType {\n  ANSWERED,\n  FAILED,\n}\n\nenum PendingActivityState {\n  SCHEDULED,\n  STARTED,\n  CANCEL_REQUESTED,\n}\n\nenum PendingDecisionState {\n  SCHEDULED,\n  STARTED,\n}\n\nenum HistoryEventFilterType {\n  ALL_EVENT,\n  CLOSE_EVENT,\n}\n\nenum TaskListKind {\n  NORMAL,\n  STICKY,\n}\n\nenum ArchivalStatus {\n  DISABLED,\n  ENABLED,\n}\n\nenum IndexedValueType {\n  STRING,\n  KEYWORD,\n  INT,\n  DOUBLE,\n  BOOL,\n  DATETIME,\n}\n\nstruct Header {\n    10: optional map<string, binary> fields\n}\n\nstruct WorkflowType {\n  10: optional string name\n}\n\nstruct ActivityType {\n  10: optional string name\n}\n\nstruct TaskList {\n  10: optional string name\n  20: optional TaskListKind kind\n}\n\nenum EncodingType {\n  ThriftRW,\n  JSON,\n}\n\nenum QueryRejectCondition {\n  // NOT_OPEN indicates that query should be rejected if workflow is not open\n  NOT_OPEN\n  // NOT_COMPLETED_CLEANLY indicates that query should be rejected if workflow did not complete cleanly\n  NOT_COMPLETED_CLEANLY\n}\n\nenum QueryConsistencyLevel {\n  // EVENTUAL indicates that query should be eventually consistent\n  EVENTUAL\n  // STRONG indicates that any events that came before query should be reflected in workflow state before running query\n  STRONG\n}\n\nstruct DataBlob {\n  10: optional EncodingType EncodingType\n  20: optional binary Data\n}\n\nstruct TaskListMetadata {\n  10: optional double maxTasksPerSecond\n}\n\nstruct WorkflowExecution {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct Memo {\n  10: optional map<string,binary> fields\n}\n\nstruct SearchAttributes {\n  10: optional map<string,binary> indexedFields\n}\n\nstruct WorkerVersionInfo {\n  10: optional string impl\n  20: optional string featureVersion\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional WorkflowExecution execution\n  20: optional WorkflowType type\n  30: optional i64 (js.type = \"Long\") startTime\n  40: optional i64 (js.type = \"Long\") closeTime\n  50: optional WorkflowExecutionCloseStatus closeStatus\n  60: optional i64 (js.type = \"Long\") historyLength\n  70: optional string parentDomainId\n  80: optional WorkflowExecution parentExecution\n  90: optional i64 (js.type = \"Long\") executionTime\n  100: optional Memo memo\n  101: optional SearchAttributes searchAttributes\n  110: optional ResetPoints autoResetPoints\n  120: optional string taskList\n  130: optional bool isCron\n}\n\nstruct WorkflowExecutionConfiguration {\n  10: optional TaskList taskList\n  20: optional i32 executionStartToCloseTimeoutSeconds\n  30: optional i32 taskStartToCloseTimeoutSeconds\n//  40: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n}\n\nstruct TransientDecisionInfo {\n  10: optional HistoryEvent scheduledEvent\n  20: optional HistoryEvent startedEvent\n}\n\nstruct ScheduleActivityTaskDecisionAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional Header header\n  90: optional bool requestLocalDispatch\n}\n\nstruct ActivityLocalDispatchInfo{\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  50: optional binary taskToken\n}\n\nstruct RequestCancelActivityTaskDecisionAttributes {\n  10: optional string activityId\n}\n\nstruct StartTimerDecisionAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n}\n\nstruct CompleteWorkflowExecutionDecisionAttributes {\n  10: optional binary result\n}\n\nstruct FailWorkflowExecutionDecisionAttributes {\n  10: optional string reason\n  20: optional binary details\n}\n\nstruct CancelTimerDecisionAttributes {\n  10: optional string timerId\n}\n\nstruct CancelWorkflowExecutionDecisionAttributes {\n  10: optional binary details\n}\n\nstruct RequestCancelExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional string runId\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional string signalName\n  40: optional binary input\n  50: optional binary control\n  60: optional bool childWorkflowOnly\n}\n\nstruct UpsertWorkflowSearchAttributesDecisionAttributes {\n  10: optional SearchAttributes searchAttributes\n}\n\nstruct RecordMarkerDecisionAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional Header header\n}\n\nstruct ContinueAsNewWorkflowExecutionDecisionAttributes {\n  10: optional WorkflowType workflowType\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n  60: optional i32 backoffStartIntervalInSeconds\n  70: optional RetryPolicy retryPolicy\n  80: optional ContinueAsNewInitiator initiator\n  90: optional string failureReason\n  100: optional binary failureDetails\n  110: optional binary lastCompletionResult\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionDecisionAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n//  80: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81: optional ParentClosePolicy parentClosePolicy\n  90: optional binary control\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional RetryPolicy retryPolicy\n  120: optional string cronSchedule\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct Decision {\n  10:  optional DecisionType decisionType\n  20:  optional ScheduleActivityTaskDecisionAttributes scheduleActivityTaskDecisionAttributes\n  25:  optional StartTimerDecisionAttributes startTimerDecisionAttributes\n  30:  optional CompleteWorkflowExecutionDecisionAttributes completeWorkflowExecutionDecisionAttributes\n  35:  optional FailWorkflowExecutionDecisionAttributes failWorkflowExecutionDecisionAttributes\n  40:  optional RequestCancelActivityTaskDecisionAttributes requestCancelActivityTaskDecisionAttributes\n  50:  optional CancelTimerDecisionAttributes cancelTimerDecisionAttributes\n  60:  optional CancelWorkflowExecutionDecisionAttributes cancelWorkflowExecutionDecisionAttributes\n  70:  optional RequestCancelExternalWorkflowExecutionDecisionAttributes requestCancelExternalWorkflowExecutionDecisionAttributes\n  80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes\n  90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes\n  100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes\n  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes\n  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes\n}\n\nstruct WorkflowExecutionStartedEventAttributes {\n  10: optional WorkflowType workflowType\n  12: optional string parentWorkflowDomain\n  14: optional WorkflowExecution parentWorkflowExecution\n  16: optional i64 (js.type = \"Long\") parentInitiatedEventId\n  20: optional TaskList taskList\n  30: optional binary input\n  40: optional i32 executionStartToCloseTimeoutSeconds\n  50: optional i32 taskStartToCloseTimeoutSeconds\n//  52: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  54: optional string continuedExecutionRunId\n  55: optional ContinueAsNewInitiator initiator\n  56: optional string continuedFailureReason\n  57: optional binary continuedFailureDetails\n  58: optional binary lastCompletionResult\n  59: optional string originalExecutionRunId // This is the runID when the WorkflowExecutionStarted event is written\n  60: optional string identity\n  61: optional string firstExecutionRunId // This is the very first runID along the chain of ContinueAsNew and Reset.\n  70: optional RetryPolicy retryPolicy\n  80: optional i32 attempt\n  90: optional i64 (js.type = \"Long\") expirationTimestamp\n  100: optional string cronSchedule\n  110: optional i32 firstDecisionTaskBackoffSeconds\n  120: optional Memo memo\n  121: optional SearchAttributes searchAttributes\n  130: optional ResetPoints prevAutoResetPoints\n  140: optional Header header\n}\n\nstruct ResetPoints{\n  10: optional list<ResetPointInfo> points\n}\n\n struct ResetPointInfo{\n  10: optional string binaryChecksum\n  20: optional string runId\n  30: optional i64 firstDecisionCompletedId\n  40: optional i64 (js.type = \"Long\") createdTimeNano\n  50: optional i64 (js.type = \"Long\") expiringTimeNano //the time that the run is deleted due to retention\n  60: optional bool resettable                         // false if the resset point has pending childWFs/reqCancels/signalExternals.\n}\n\nstruct WorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct WorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n}\n\nenum ContinueAsNewInitiator {\n  Decider,\n  RetryPolicy,\n  CronSchedule,\n}\n\nstruct WorkflowExecutionContinuedAsNewEventAttributes {\n  10: optional string newExecutionRunId\n  20: optional WorkflowType workflowType\n  30: optional TaskList taskList\n  40: optional binary input\n  50: optional i32 executionStartToCloseTimeoutSeconds\n  60: optional i32 taskStartToCloseTimeoutSeconds\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  80: optional i32 backoffStartIntervalInSeconds\n  90: optional ContinueAsNewInitiator initiator\n  100: optional string failureReason\n  110: optional binary failureDetails\n  120: optional binary lastCompletionResult\n  130: optional Header header\n  140: optional Memo memo\n  150: optional SearchAttributes searchAttributes\n}\n\nstruct DecisionTaskScheduledEventAttributes {\n  10: optional TaskList taskList\n  20: optional i32 startToCloseTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") attempt\n}\n\nstruct DecisionTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n}\n\nstruct DecisionTaskCompletedEventAttributes {\n  10: optional binary executionContext\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct DecisionTaskTimedOutEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // for reset workflow\n  40: optional string baseRunId\n  50: optional string newRunId\n  60: optional i64 (js.type = \"Long\") forkEventVersion\n  70: optional string reason\n  80: optional DecisionTaskTimedOutCause cause\n}\n\nstruct DecisionTaskFailedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional DecisionTaskFailedCause cause\n  35: optional binary details\n  40: optional string identity\n  50: optional string reason\n  // for reset workflow\n  60: optional string baseRunId\n  70: optional string newRunId\n  80: optional i64 (js.type = \"Long\") forkEventVersion\n  90: optional string binaryChecksum\n}\n\nstruct ActivityTaskScheduledEventAttributes {\n  10: optional string activityId\n  20: optional ActivityType activityType\n  25: optional string domain\n  30: optional TaskList taskList\n  40: optional binary input\n  45: optional i32 scheduleToCloseTimeoutSeconds\n  50: optional i32 scheduleToStartTimeoutSeconds\n  55: optional i32 startToCloseTimeoutSeconds\n  60: optional i32 heartbeatTimeoutSeconds\n  90: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional RetryPolicy retryPolicy\n  120: optional Header header\n}\n\nstruct ActivityTaskStartedEventAttributes {\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional string identity\n  30: optional string requestId\n  40: optional i32 attempt\n  50: optional string lastFailureReason\n  60: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCompletedEventAttributes {\n  10: optional binary result\n  20: optional i64 (js.type = \"Long\") scheduledEventId\n  30: optional i64 (js.type = \"Long\") startedEventId\n  40: optional string identity\n}\n\nstruct ActivityTaskFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct ActivityTaskTimedOutEventAttributes {\n  05: optional binary details\n  10: optional i64 (js.type = \"Long\") scheduledEventId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional TimeoutType timeoutType\n  // For retry activity, it may have a failure before timeout. It's important to keep those information for debug.\n  // Client can also provide the info for making next decision\n  40: optional string lastFailureReason\n  50: optional binary lastFailureDetails\n}\n\nstruct ActivityTaskCancelRequestedEventAttributes {\n  10: optional string activityId\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct RequestCancelActivityTaskFailedEventAttributes{\n  10: optional string activityId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ActivityTaskCanceledEventAttributes {\n  10: optional binary details\n  20: optional i64 (js.type = \"Long\") latestCancelRequestedEventId\n  30: optional i64 (js.type = \"Long\") scheduledEventId\n  40: optional i64 (js.type = \"Long\") startedEventId\n  50: optional string identity\n}\n\nstruct TimerStartedEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startToFireTimeoutSeconds\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct TimerFiredEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct TimerCanceledEventAttributes {\n  10: optional string timerId\n  20: optional i64 (js.type = \"Long\") startedEventId\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct CancelTimerFailedEventAttributes {\n  10: optional string timerId\n  20: optional string cause\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCancelRequestedEventAttributes {\n  10: optional string cause\n  20: optional i64 (js.type = \"Long\") externalInitiatedEventId\n  30: optional WorkflowExecution externalWorkflowExecution\n  40: optional string identity\n}\n\nstruct WorkflowExecutionCanceledEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional binary details\n}\n\nstruct MarkerRecordedEventAttributes {\n  10: optional string markerName\n  20: optional binary details\n  30: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  40: optional Header header\n}\n\nstruct WorkflowExecutionSignaledEventAttributes {\n  10: optional string signalName\n  20: optional binary input\n  30: optional string identity\n}\n\nstruct WorkflowExecutionTerminatedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n  50: optional bool childWorkflowOnly\n}\n\nstruct RequestCancelExternalWorkflowExecutionFailedEventAttributes {\n  10: optional CancelExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionCancelRequestedEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n}\n\nstruct SignalExternalWorkflowExecutionInitiatedEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional string signalName\n  50: optional binary input\n  60: optional binary control\n  70: optional bool childWorkflowOnly\n}\n\nstruct SignalExternalWorkflowExecutionFailedEventAttributes {\n  10: optional SignalExternalWorkflowExecutionFailedCause cause\n  20: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional binary control\n}\n\nstruct ExternalWorkflowExecutionSignaledEventAttributes {\n  10: optional i64 (js.type = \"Long\") initiatedEventId\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional binary control\n}\n\nstruct UpsertWorkflowSearchAttributesEventAttributes {\n  10: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  20: optional SearchAttributes searchAttributes\n}\n\nstruct StartChildWorkflowExecutionInitiatedEventAttributes {\n  10:  optional string domain\n  20:  optional string workflowId\n  30:  optional WorkflowType workflowType\n  40:  optional TaskList taskList\n  50:  optional binary input\n  60:  optional i32 executionStartToCloseTimeoutSeconds\n  70:  optional i32 taskStartToCloseTimeoutSeconds\n//  80:  optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  81:  optional ParentClosePolicy parentClosePolicy\n  90:  optional binary control\n  100: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n  110: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Header header\n  150: optional Memo memo\n  160: optional SearchAttributes searchAttributes\n  170: optional i32 delayStartSeconds\n}\n\nstruct StartChildWorkflowExecutionFailedEventAttributes {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional ChildWorkflowExecutionFailedCause cause\n  50: optional binary control\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") decisionTaskCompletedEventId\n}\n\nstruct ChildWorkflowExecutionStartedEventAttributes {\n  10: optional string domain\n  20: optional i64 (js.type = \"Long\") initiatedEventId\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional Header header\n}\n\nstruct ChildWorkflowExecutionCompletedEventAttributes {\n  10: optional binary result\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionFailedEventAttributes {\n  10: optional string reason\n  20: optional binary details\n  30: optional string domain\n  40: optional WorkflowExecution workflowExecution\n  50: optional WorkflowType workflowType\n  60: optional i64 (js.type = \"Long\") initiatedEventId\n  70: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionCanceledEventAttributes {\n  10: optional binary details\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTimedOutEventAttributes {\n  10: optional TimeoutType timeoutType\n  20: optional string domain\n  30: optional WorkflowExecution workflowExecution\n  40: optional WorkflowType workflowType\n  50: optional i64 (js.type = \"Long\") initiatedEventId\n  60: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct ChildWorkflowExecutionTerminatedEventAttributes {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") initiatedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n}\n\nstruct HistoryEvent {\n  10:  optional i64 (js.type = \"Long\") eventId\n  20:  optional i64 (js.type = \"Long\") timestamp\n  30:  optional EventType eventType\n  35:  optional i64 (js.type = \"Long\") version\n  36:  optional i64 (js.type = \"Long\") taskId\n  40:  optional WorkflowExecutionStartedEventAttributes workflowExecutionStartedEventAttributes\n  50:  optional WorkflowExecutionCompletedEventAttributes workflowExecutionCompletedEventAttributes\n  60:  optional WorkflowExecutionFailedEventAttributes workflowExecutionFailedEventAttributes\n  70:  optional WorkflowExecutionTimedOutEventAttributes workflowExecutionTimedOutEventAttributes\n  80:  optional DecisionTaskScheduledEventAttributes decisionTaskScheduledEventAttributes\n  90:  optional DecisionTaskStartedEventAttributes decisionTaskStartedEventAttributes\n  100: optional DecisionTaskCompletedEventAttributes decisionTaskCompletedEventAttributes\n  110: optional DecisionTaskTimedOutEventAttributes decisionTaskTimedOutEventAttributes\n  120: optional DecisionTaskFailedEventAttributes decisionTaskFailedEventAttributes\n  130: optional ActivityTaskScheduledEventAttributes activityTaskScheduledEventAttributes\n  140: optional ActivityTaskStartedEventAttributes activityTaskStartedEventAttributes\n  150: optional ActivityTaskCompletedEventAttributes activityTaskCompletedEventAttributes\n  160: optional ActivityTaskFailedEventAttributes activityTaskFailedEventAttributes\n  170: optional ActivityTaskTimedOutEventAttributes activityTaskTimedOutEventAttributes\n  180: optional TimerStartedEventAttributes timerStartedEventAttributes\n  190: optional TimerFiredEventAttributes timerFiredEventAttributes\n  200: optional ActivityTaskCancelRequestedEventAttributes activityTaskCancelRequestedEventAttributes\n  210: optional RequestCancelActivityTaskFailedEventAttributes requestCancelActivityTaskFailedEventAttributes\n  220: optional ActivityTaskCanceledEventAttributes activityTaskCanceledEventAttributes\n  230: optional TimerCanceledEventAttributes timerCanceledEventAttributes\n  240: optional CancelTimerFailedEventAttributes cancelTimerFailedEventAttributes\n  250: optional MarkerRecordedEventAttributes markerRecordedEventAttributes\n  260: optional WorkflowExecutionSignaledEventAttributes workflowExecutionSignaledEventAttributes\n  270: optional WorkflowExecutionTerminatedEventAttributes workflowExecutionTerminatedEventAttributes\n  280: optional WorkflowExecutionCancelRequestedEventAttributes workflowExecutionCancelRequestedEventAttributes\n  290: optional WorkflowExecutionCanceledEventAttributes workflowExecutionCanceledEventAttributes\n  300: optional RequestCancelExternalWorkflowExecutionInitiatedEventAttributes requestCancelExternalWorkflowExecutionInitiatedEventAttributes\n  310: optional RequestCancelExternalWorkflowExecutionFailedEventAttributes requestCancelExternalWorkflowExecutionFailedEventAttributes\n  320: optional ExternalWorkflowExecutionCancelRequestedEventAttributes externalWorkflowExecutionCancelRequestedEventAttributes\n  330: optional WorkflowExecutionContinuedAsNewEventAttributes workflowExecutionContinuedAsNewEventAttributes\n  340: optional StartChildWorkflowExecutionInitiatedEventAttributes startChildWorkflowExecutionInitiatedEventAttributes\n  350: optional StartChildWorkflowExecutionFailedEventAttributes startChildWorkflowExecutionFailedEventAttributes\n  360: optional ChildWorkflowExecutionStartedEventAttributes childWorkflowExecutionStartedEventAttributes\n  370: optional ChildWorkflowExecutionCompletedEventAttributes childWorkflowExecutionCompletedEventAttributes\n  380: optional ChildWorkflowExecutionFailedEventAttributes childWorkflowExecutionFailedEventAttributes\n  390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes\n  400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes\n  410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes\n  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes\n  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes\n  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes\n  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes\n}\n\nstruct History {\n  10: optional list<HistoryEvent> events\n}\n\nstruct WorkflowExecutionFilter {\n  10: optional string workflowId\n  20: optional string runId\n}\n\nstruct WorkflowTypeFilter {\n  10: optional string name\n}\n\nstruct StartTimeFilter {\n  10: optional i64 (js.type = \"Long\") earliestTime\n  20: optional i64 (js.type = \"Long\") latestTime\n}\n\nstruct DomainInfo {\n  10: optional string name\n  20: optional DomainStatus status\n  30: optional string description\n  40: optional string ownerEmail\n  // A key-value map for any customized purpose\n  50: optional map<string,string> data\n  60: optional string uuid\n  // Typed metadata documents keyed by registered metadata key, values are JSON encoded\n  70: optional map<string,binary> metadata\n}\n\nstruct DomainConfiguration {\n  10: optional i32 workflowExecutionRetentionPeriodInDays\n  20: optional bool emitMetric\n  70: optional BadBinaries badBinaries\n  80: optional ArchivalStatus historyArchivalStatus\n  90: optional string historyArchivalURI\n  100: optional ArchivalStatus visibilityArchivalStatus\n  110: optional string visibilityArchivalURI\n  120: optional AsyncWorkflowConfiguration asyncWorkflowConfiguration\n}\n\nstruct AsyncWorkflowConfiguration {\n  10: optional bool enabled\n  20: optional string queueProvider\n  30: optional string queueTopic\n  40: optional i32 rps\n}\n\nstruct FailoverInfo {\n    10: optional i64 (js.type = \"Long\") failoverVersion\n    20: optional i64 (js.type = \"Long\") failoverStartTimestamp\n    30: optional i64 (js.type = \"Long\") failoverExpireTimestamp\n    40: optional i32 completedShardCount\n    50: optional list<i32> pendingShards\n}\n\nstruct BadBinaries{\n  10: optional map<string, BadBinaryInfo> binaries\n}\n\nstruct BadBinaryInfo{\n  10: optional string reason\n  20: optional string operator\n  30: optional i64 (js.type = \"Long\") createdTimeNano\n}\n\nstruct UpdateDomainInfo {\n  10: optional string description\n  20: optional string ownerEmail\n  // A key-value map for any customized purpose\n  30: optional map<string,string> data\n  // Metadata documents to upsert, a JSON null value removes the key\n  40: optional map<string,binary> metadata\n}\n\nstruct ClusterReplicationConfiguration {\n 10: optional string clusterName\n}\n\nstruct DomainReplicationConfiguration {\n 10: optional string activeClusterName\n 20: optional list<ClusterReplicationConfiguration> clusters\n}\n\nstruct RegisterDomainRequest {\n  10: optional string name\n  20: optional string description\n  30: optional string ownerEmail\n  40: optional i32 workflowExecutionRetentionPeriodInDays\n  50: optional bool emitMetric = true\n  60: optional list<ClusterReplicationConfiguration> clusters\n  70: optional string activeClusterName\n  // A key-value map for any customized purpose\n  80: optional map<string,string> data\n  90: optional string securityToken\n  120: optional bool isGlobalDomain\n  130: optional ArchivalStatus historyArchivalStatus\n  140: optional string historyArchivalURI\n  150: optional ArchivalStatus visibilityArchivalStatus\n  160: optional string visibilityArchivalURI\n}\n\nstruct ListDomainsRequest {\n  10: optional i32 pageSize\n  20: optional binary nextPageToken\n}\n\nstruct ListDomainsResponse {\n  10: optional list<DescribeDomainResponse> domains\n  20: optional binary nextPageToken\n  30: optional i64 totalCount\n}\n\nstruct DescribeDomainRequest {\n  10: optional string name\n  20: optional string uuid\n}\n\nstruct DescribeDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n  60: optional FailoverInfo failoverInfo\n}\n\nstruct UpdateDomainRequest {\n 10: optional string name\n 20: optional UpdateDomainInfo updatedInfo\n 30: optional DomainConfiguration configuration\n 40: optional DomainReplicationConfiguration replicationConfiguration\n 50: optional string securityToken\n 60: optional string deleteBadBinary\n 70: optional i32 failoverTimeoutInSeconds\n 80: optional string uuid\n}\n\nstruct UpdateDomainResponse {\n  10: optional DomainInfo domainInfo\n  20: optional DomainConfiguration configuration\n  30: optional DomainReplicationConfiguration replicationConfiguration\n  40: optional i64 (js.type = \"Long\") failoverVersion\n  50: optional bool isGlobalDomain\n}\n\nstruct DeprecateDomainRequest {\n 10: optional string name\n 20: optional string securityToken\n 30: optional string uuid\n}\n\nstruct StartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n//  110: optional ChildPolicy childPolicy -- Removed but reserve the IDL order number\n  120: optional RetryPolicy retryPolicy\n  130: optional string cronSchedule\n  140: optional Memo memo\n  141: optional SearchAttributes searchAttributes\n  150: optional Header header\n  160: optional i32 delayStartSeconds\n}\n\nstruct StartWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct PollForDecisionTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional string binaryChecksum\n}\n\nstruct PollForDecisionTaskResponse {\n  10: optional binary taskToken\n  20: optional WorkflowExecution workflowExecution\n  30: optional WorkflowType workflowType\n  40: optional i64 (js.type = \"Long\") previousStartedEventId\n  50: optional i64 (js.type = \"Long\") startedEventId\n  51: optional i64 (js.type = 'Long') attempt\n  54: optional i64 (js.type = \"Long\") backlogCountHint\n  60: optional History history\n  70: optional binary nextPageToken\n  80: optional WorkflowQuery query\n  90: optional TaskList WorkflowExecutionTaskList\n  100: optional i64 (js.type = \"Long\") scheduledTimestamp\n  110: optional i64 (js.type = \"Long\") startedTimestamp\n  120: optional map<string, WorkflowQuery> queries\n  130: optional i64 (js.type = 'Long') nextEventId\n}\n\nstruct StickyExecutionAttributes {\n  10: optional TaskList workerTaskList\n  20: optional i32 scheduleToStartTimeoutSeconds\n}\n\nstruct RespondDecisionTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional list<Decision> decisions\n  30: optional binary executionContext\n  40: optional string identity\n  50: optional StickyExecutionAttributes stickyAttributes\n  60: optional bool returnNewDecisionTask\n  70: optional bool forceCreateNewDecisionTask\n  80: optional string binaryChecksum\n  90: optional map<string, WorkflowQueryResult> queryResults\n}\n\nstruct RespondDecisionTaskCompletedResponse {\n  10: optional PollForDecisionTaskResponse decisionTask\n  20: optional map<string,ActivityLocalDispatchInfo> activitiesToDispatchLocally\n}\n\nstruct RespondDecisionTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional DecisionTaskFailedCause cause\n  30: optional binary details\n  40: optional string identity\n  50: optional string binaryChecksum\n}\n\nstruct PollForActivityTaskRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional string identity\n  40: optional TaskListMetadata taskListMetadata\n}\n\nstruct PollForActivityTaskResponse {\n  10:  optional binary taskToken\n  20:  optional WorkflowExecution workflowExecution\n  30:  optional string activityId\n  40:  optional ActivityType activityType\n  50:  optional binary input\n  70:  optional i64 (js.type = \"Long\") scheduledTimestamp\n  80:  optional i32 scheduleToCloseTimeoutSeconds\n  90:  optional i64 (js.type = \"Long\") startedTimestamp\n  100: optional i32 startToCloseTimeoutSeconds\n  110: optional i32 heartbeatTimeoutSeconds\n  120: optional i32 attempt\n  130: optional i64 (js.type = \"Long\") scheduledTimestampOfThisAttempt\n  140: optional binary heartbeatDetails\n  150: optional WorkflowType workflowType\n  160: optional string workflowDomain\n  170: optional Header header\n}\n\nstruct RecordActivityTaskHeartbeatRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RecordActivityTaskHeartbeatResponse {\n  10: optional bool cancelRequested\n}\n\nstruct RespondActivityTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional binary result\n  30: optional string identity\n}\n\nstruct RespondActivityTaskFailedRequest {\n  10: optional binary taskToken\n  20: optional string reason\n  30: optional binary details\n  40: optional string identity\n}\n\nstruct RespondActivityTaskCanceledRequest {\n  10: optional binary taskToken\n  20: optional binary details\n  30: optional string identity\n}\n\nstruct RespondActivityTaskCompletedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary result\n  60: optional string identity\n}\n\nstruct RespondActivityTaskFailedByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional string reason\n  60: optional binary details\n  70: optional string identity\n}\n\nstruct RespondActivityTaskCanceledByIDRequest {\n  10: optional string domain\n  20: optional string workflowID\n  30: optional string runID\n  40: optional string activityID\n  50: optional binary details\n  60: optional string identity\n}\n\nstruct RequestCancelWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string identity\n  40: optional string requestId\n}\n\nstruct GetWorkflowExecutionHistoryRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional i32 maximumPageSize\n  40: optional binary nextPageToken\n  50: optional bool waitForNewEvent\n  60: optional HistoryEventFilterType HistoryEventFilterType\n  70: optional bool skipArchival\n  80: optional list<EventType> eventTypes\n}\n\nstruct GetWorkflowExecutionHistoryResponse {\n  10: optional History history\n  11: optional list<DataBlob> rawHistory\n  20: optional binary nextPageToken\n  30: optional bool archived\n}\n\nstruct SignalWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string signalName\n  40: optional binary input\n  50: optional string identity\n  60: optional string requestId\n  70: optional binary control\n}\n\nstruct SignalBatchItem {\n  10: optional string signalName\n  20: optional binary input\n}\n\nstruct SignalBatchWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional list<SignalBatchItem> signals\n  40: optional string identity\n  50: optional string requestId\n}\n\nstruct SignalWithStartWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional string workflowId\n  30: optional WorkflowType workflowType\n  40: optional TaskList taskList\n  50: optional binary input\n  60: optional i32 executionStartToCloseTimeoutSeconds\n  70: optional i32 taskStartToCloseTimeoutSeconds\n  80: optional string identity\n  90: optional string requestId\n  100: optional WorkflowIdReusePolicy workflowIdReusePolicy\n  110: optional string signalName\n  120: optional binary signalInput\n  130: optional binary control\n  140: optional RetryPolicy retryPolicy\n  150: optional string cronSchedule\n  160: optional Memo memo\n  161: optional SearchAttributes searchAttributes\n  170: optional Header header\n  180: optional i32 delayStartSeconds\n}\n\nstruct TerminateWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional binary details\n  50: optional string identity\n}\n\nstruct ResetWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution workflowExecution\n  30: optional string reason\n  40: optional i64 (js.type = \"Long\") decisionFinishEventId\n  50: optional string requestId\n  60: optional bool skipSignalReapply\n}\n\nstruct ResetWorkflowExecutionResponse {\n  10: optional string runId\n}\n\nstruct ListOpenWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n}\n\nstruct ListOpenWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListClosedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 maximumPageSize\n  30: optional binary nextPageToken\n  40: optional StartTimeFilter StartTimeFilter\n  50: optional WorkflowExecutionFilter executionFilter\n  60: optional WorkflowTypeFilter typeFilter\n  70: optional WorkflowExecutionCloseStatus statusFilter\n}\n\nstruct ListClosedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct ListArchivedWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional i32 pageSize\n  30: optional binary nextPageToken\n  40: optional string query\n}\n\nstruct ListArchivedWorkflowExecutionsResponse {\n  10: optional list<WorkflowExecutionInfo> executions\n  20: optional binary nextPageToken\n}\n\nstruct CountWorkflowExecutionsRequest {\n  10: optional string domain\n  20: optional string query\n  // Optional search attribute to count the executions per value of, e.g. CloseStatus, WorkflowType or TaskList\n  30: optional string groupBy\n}\n\nstruct CountWorkflowExecutionsGroup {\n  // Value of the groupBy attribute, empty for executions without it, e.g. open workflows grouped by CloseStatus\n  10: optional string value\n  20: optional i64 count\n}\n\nstruct CountWorkflowExecutionsResponse {\n  10: optional i64 count\n  20: optional list<CountWorkflowExecutionsGroup> groups\n}\n\nstruct GetSearchAttributesResponse {\n  10: optional map<string, IndexedValueType> keys\n}\n\nstruct QueryWorkflowRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n  30: optional WorkflowQuery query\n  // QueryRejectCondition can used to reject the query if workflow state does not satisify condition\n  40: optional QueryRejectCondition queryRejectCondition\n  50: optional QueryConsistencyLevel queryConsistencyLevel\n}\n\nstruct QueryRejected {\n  10: optional WorkflowExecutionCloseStatus closeStatus\n}\n\nstruct QueryWorkflowResponse {\n  10: optional binary queryResult\n  20: optional QueryRejected queryRejected\n}\n\nstruct WorkflowQuery {\n  10: optional string queryType\n  20: optional binary queryArgs\n}\n\nstruct ResetStickyTaskListRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct ResetStickyTaskListResponse {\n    // The reason to keep this response is to allow returning\n    // information in the future.\n}\n\nstruct RespondQueryTaskCompletedRequest {\n  10: optional binary taskToken\n  20: optional QueryTaskCompletedType completedType\n  30: optional binary queryResult\n  40: optional string errorMessage\n  50: optional WorkerVersionInfo workerVersionInfo\n}\n\nstruct WorkflowQueryResult {\n  10: optional QueryResultType resultType\n  20: optional binary answer\n  30: optional string errorMessage\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct PendingActivityInfo {\n  10: optional string activityID\n  20: optional ActivityType activityType\n  30: optional PendingActivityState state\n  40: optional binary heartbeatDetails\n  50: optional i64 (js.type = \"Long\") lastHeartbeatTimestamp\n  60: optional i64 (js.type = \"Long\") lastStartedTimestamp\n  70: optional i32 attempt\n  80: optional i32 maximumAttempts\n  90: optional i64 (js.type = \"Long\") scheduledTimestamp\n  100: optional i64 (js.type = \"Long\") expirationTimestamp\n  110: optional string lastFailureReason\n  120: optional string lastWorkerIdentity\n  130: optional binary lastFailureDetails\n}\n\nstruct PendingDecisionInfo {\n  10: optional PendingDecisionState state\n  20: optional i64 (js.type = \"Long\") scheduledTimestamp\n  30: optional i64 (js.type = \"Long\") startedTimestamp\n  40: optional i64 attempt\n  50: optional i64 (js.type = \"Long\") originalScheduledTimestamp\n}\n\nstruct PendingChildExecutionInfo {\n  1: optional string domain\n  10: optional string workflowID\n  20: optional string runID\n  30: optional string workflowTypName\n  40: optional i64 (js.type = \"Long\") initiatedID\n  50: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional WorkflowExecutionConfiguration executionConfiguration\n  20: optional WorkflowExecutionInfo workflowExecutionInfo\n  30: optional list<PendingActivityInfo> pendingActivities\n  40: optional list<PendingChildExecutionInfo> pendingChildren\n  50: optional PendingDecisionInfo pendingDecision\n  60: optional HistoryLimitWarning historyLimitWarning\n}\n\nstruct HistoryLimitWarning {\n  10: optional i64 historyCount\n  20: optional i64 historySize\n  30: optional i64 historyCountLimit\n  40: optional i64 historySizeLimit\n  50: optional double eventsPerHour\n  60: optional i64 estimatedSecondsToLimit\n}\n\nstruct DescribeTaskListRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n  30: optional TaskListType taskListType\n  40: optional bool includeTaskListStatus\n}\n\nstruct DescribeTaskListResponse {\n  10: optional list<PollerInfo> pollers\n  20: optional TaskListStatus taskListStatus\n}\n\nstruct GetTaskListsByDomainRequest {\n  10: optional string domainName\n}\n\nstruct GetTaskListsByDomainResponse {\n  10: optional map<string,DescribeTaskListResponse> decisionTaskListMap\n  20: optional map<string,DescribeTaskListResponse> activityTaskListMap\n}\n\nstruct ListTaskListPartitionsRequest {\n  10: optional string domain\n  20: optional TaskList taskList\n}\n\nstruct TaskListPartitionMetadata {\n  10: optional string key\n  20: optional string ownerHostName\n}\n\nstruct ListTaskListPartitionsResponse {\n  10: optional list<TaskListPartitionMetadata> activityTaskListPartitions\n  20: optional list<TaskListPartitionMetadata> decisionTaskListPartitions\n}\n\nstruct TaskListStatus {\n  10: optional i64 (js.type = \"Long\") backlogCountHint\n  20: optional i64 (js.type = \"Long\") readLevel\n  30: optional i64 (js.type = \"Long\") ackLevel\n  35: optional double ratePerSecond\n  40: optional TaskIDBlock taskIDBlock\n  50: optional TaskListMatchStats matchStats\n  60: optional i64 (js.type = \"Long\") outstandingPollCount\n}\n\nstruct TaskListMatchStats {\n  10: optional i64 (js.type = \"Long\") syncMatched\n  20: optional i64 (js.type = \"Long\") bufferedMatched\n  30: optional i64 (js.type = \"Long\") forwarded\n  40: optional i64 (js.type = \"Long\") expired\n}\n\nstruct TaskIDBlock {\n  10: optional i64 (js.type = \"Long\")  startID\n  20: optional i64 (js.type = \"Long\")  endID\n}\n\n//At least one of the parameters needs to be provided\nstruct DescribeHistoryHostRequest {\n  10: optional string               hostAddress //ip:port\n  20: optional i32                  shardIdForHost\n  30: optional WorkflowExecution    executionForHost\n}\n\nstruct RemoveTaskRequest {\n  10: optional i32                      shardID\n  20: optional i32                      type\n  30: optional i64 (js.type = \"Long\")   taskID\n  40: optional i64 (js.type = \"Long\")   visibilityTimestamp\n  50: optional string                   clusterName\n}\n\nstruct CloseShardRequest {\n  10: optional i32               shardID\n}\n\nstruct ResetQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueRequest {\n  10: optional i32    shardID\n  20: optional string clusterName\n  30: optional i32    type\n}\n\nstruct DescribeQueueResponse {\n  10: optional list<string> processingQueueStates\n}\n\nstruct GetReplicationAckStatesRequest {\n  10: optional i32 shardID\n}\n\nstruct ReplicationAckState {\n  10: optional string clusterName\n  20: optional i64    ackLevel\n  30: optional i64    lastAckTime\n  40: optional i64    pendingTasks\n  50: optional bool   pendingTasksCapped\n}\n\nstruct GetReplicationAckStatesResponse {\n  10: optional list<ReplicationAckState> ackStates\n}\n\nstruct DescribeShardDistributionRequest {\n  10: optional i32 pageSize\n  20: optional i32 pageID\n}\n\nstruct DescribeShardDistributionResponse {\n  10: optional i32              numberOfShards\n\n  // ShardID to Address (ip:port) map\n  20: optional map<i32, string> shards\n}\n\nstruct DescribeHistoryHostResponse{\n  10: optional i32                  numberOfShards\n  20: optional list<i32>            shardIDs\n  30: optional DomainCacheInfo      domainCache\n  40: optional string               shardControllerStatus\n  50: optional string               address\n  60: optional list<DomainPayloadSizeStats> payloadSizeStats\n}\n\nstruct DomainCacheInfo{\n  10: optional i64 numOfItemsInCacheByID\n  20: optional i64 numOfItemsInCacheByName\n}\n\nstruct DomainPayloadSizeStats{\n  10: optional string domainName\n  20: optional string payloadType\n  30: optional i64 count\n  40: optional i64 averageSize\n  50: optional i64 maxSize\n  60: optional i64 nearLimitCount\n}\n\nenum TaskListType {\n  /*\n   * Decision type of tasklist\n   */\n  Decision,\n  /*\n   * Activity type of tasklist\n   */\n  Activity,\n}\n\nstruct PollerInfo {\n  // Unix Nano\n  10: optional i64 (js.type = \"Long\")  lastAccessTime\n  20: optional string identity\n  30: optional double ratePerSecond\n}\n\nstruct RetryPolicy {\n  // Interval of the first retry. If coefficient is 1.0 then it is used for all retries.\n  10: optional i32 initialIntervalInSeconds\n\n  // Coefficient used to calculate the next retry interval.\n  // The next retry interval is previous interval multiplied by the coefficient.\n  // Must be 1 or larger.\n  20: optional double backoffCoefficient\n\n  // Maximum interval between retries. Exponential backoff leads to interval increase.\n  // This value is the cap of the increase. Default is 100x of initial interval.\n  30: optional i32 maximumIntervalInSeconds\n\n  // Maximum number of attempts. When exceeded the retries stop even if not expired yet.\n  // Must be 1 or bigger. Default is unlimited.\n  40: optional i32 maximumAttempts\n\n  // Non-Retriable errors. Will stop retrying if error matches this list.\n  50: optional list<string> nonRetriableErrorReasons\n\n  // Expiration time for the whole retry process.\n  60: optional i32 expirationIntervalInSeconds\n}\n\n// HistoryBranchRange represents a piece of range for a branch.\nstruct HistoryBranchRange{\n  // branchID of original branch forked from\n  10: optional string branchID\n  // beinning node for the range, inclusive\n  20: optional i64 beginNodeID\n  // ending node for the range, exclusive\n  30: optional i64 endNodeID\n}\n\n// For history persistence to serialize/deserialize branch details\nstruct HistoryBranch{\n  10: optional string treeID\n  20: optional string branchID\n  30: optional list<HistoryBranchRange> ancestors\n}\n\n// VersionHistoryItem contains signal eventID and the corresponding version\nstruct VersionHistoryItem{\n  10: optional i64 (js.type = \"Long\") eventID\n  20: optional i64 (js.type = \"Long\") version\n}\n\n// VersionHistory contains the version history of a branch\nstruct VersionHistory{\n  10: optional binary branchToken\n  20: optional list<VersionHistoryItem> items\n}\n\n// VersionHistories contains all version histories from all branches\nstruct VersionHistories{\n  10: optional i32 currentVersionHistoryIndex\n  20: optional list<VersionHistory> histories\n}\n\n// ReapplyEventsRequest is the request for reapply events API\nstruct ReapplyEventsRequest{\n  10: optional string domainName\n  20: optional WorkflowExecution workflowExecution\n  30: optional DataBlob events\n}\n\n// SupportedClientVersions contains the support versions for client library\nstruct SupportedClientVersions{\n  10: optional string goSdk\n  20: optional string javaSdk\n}\n\n// ClusterInfo contains information about cadence cluster\nstruct ClusterInfo{\n  10: optional SupportedClientVersions supportedClientVersions\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string domain\n  20: optional WorkflowExecution execution\n}\n\nstruct FeatureFlags {\n\t10: optional bool WorkflowExecutionAlreadyCompletedErrorEnabled\n}\n\nenum CrossClusterTaskType {\n  StartChildExecution\n  CancelExecution\n  SignalExecution\n  RecordChildWorkflowExecutionComplete\n  ApplyParentClosePolicy\n}\n\nenum CrossClusterTaskFailedCause {\n  DOMAIN_NOT_ACTIVE\n  DOMAIN_NOT_EXISTS\n  WORKFLOW_ALREADY_RUNNING\n  WORKFLOW_NOT_EXISTS\n  WORKFLOW_ALREADY_COMPLETED\n  UNCATEGORIZED\n}\n\nenum GetTaskFailedCause {\n  SERVICE_BUSY\n  TIMEOUT\n  SHARD_OWNERSHIP_LOST\n  UNCATEGORIZED\n}\n\nstruct CrossClusterTaskInfo {\n  10: optional string domainID\n  20: optional string workflowID\n  30: optional string runID\n  40: optional CrossClusterTaskType taskType\n  50: optional i16 taskState\n  60: optional i64 (js.type = \"Long\") taskID\n  70: optional i64 (js.type = \"Long\") visibilityTimestamp\n}\n\nstruct CrossClusterStartChildExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string requestID\n  30: optional i64 (js.type = \"Long\") initiatedEventID\n  40: optional StartChildWorkflowExecutionInitiatedEventAttributes initiatedEventAttributes\n  // targetRunID is for scheduling first decision task\n  // targetWorkflowID is available in initiatedEventAttributes\n  50: optional string targetRunID\n}\n\nstruct CrossClusterStartChildExecutionResponseAttributes {\n  10: optional string runID\n}\n\nstruct CrossClusterCancelExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n}\n\nstruct CrossClusterCancelExecutionResponseAttributes {\n}\n\nstruct CrossClusterSignalExecutionRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional string requestID\n  50: optional i64 (js.type = \"Long\") initiatedEventID\n  60: optional bool childWorkflowOnly\n  70: optional string signalName\n  80: optional binary signalInput\n  90: optional binary control\n}\n\nstruct CrossClusterSignalExecutionResponseAttributes {\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes {\n  10: optional string targetDomainID\n  20: optional string targetWorkflowID\n  30: optional string targetRunID\n  40: optional i64 (js.type = \"Long\") initiatedEventID\n  50: optional HistoryEvent completionEvent\n}\n\nstruct CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes {\n}\n\nstruct ApplyParentClosePolicyAttributes {\n  10: optional string childDomainID\n  20: optional string childWorkflowID\n  30: optional string childRunID\n  40: optional ParentClosePolicy parentClosePolicy\n}\n\nstruct ApplyParentClosePolicyStatus {\n  10: optional bool completed\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct ApplyParentClosePolicyRequest {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional ApplyParentClosePolicyStatus status\n}\n\nstruct CrossClusterApplyParentClosePolicyRequestAttributes {\n  10: optional list<ApplyParentClosePolicyRequest> children\n}\n\nstruct ApplyParentClosePolicyResult {\n  10: optional ApplyParentClosePolicyAttributes child\n  20: optional CrossClusterTaskFailedCause failedCause\n}\n\nstruct CrossClusterApplyParentClosePolicyResponseAttributes {\n  10: optional list<ApplyParentClosePolicyResult> childrenStatus\n}\n\nstruct CrossClusterTaskRequest {\n  10: optional CrossClusterTaskInfo taskInfo\n  20: optional CrossClusterStartChildExecutionRequestAttributes startChildExecutionAttributes\n  30: optional CrossClusterCancelExecutionRequestAttributes cancelExecutionAttributes\n  40: optional CrossClusterSignalExecutionRequestAttributes signalExecutionAttributes\n  50: optional CrossClusterRecordChildWorkflowExecutionCompleteRequestAttributes recordChildWorkflowExecutionCompleteAttributes\n  60: optional CrossClusterApplyParentClosePolicyRequestAttributes applyParentClosePolicyAttributes\n}\n\nstruct CrossClusterTaskResponse {\n  10: optional i64 (js.type = \"Long\") taskID\n  20: optional CrossClusterTaskType taskType\n  30: optional i16 taskState\n  40: optional CrossClusterTaskFailedCause failedCause\n  50: optional CrossClusterStartChildExecutionResponseAttributes startChildExecutionAttributes\n  60: optional CrossClusterCancelExecutionResponseAttributes cancelExecutionAttributes\n  70: optional CrossClusterSignalExecutionResponseAttributes signalExecutionAttributes\n  80: optional CrossClusterRecordChildWorkflowExecutionCompleteResponseAttributes recordChildWorkflowExecutionCompleteAttributes\n  90: optional CrossClusterApplyParentClosePolicyResponseAttributes applyParentClosePolicyAttributes\n}\n\nstruct GetCrossClusterTasksRequest {\n  10: optional list<i32> shardIDs\n  20: optional string targetCluster\n}\n\nstruct GetCrossClusterTasksResponse {\n  10: optional map<i32, list<CrossClusterTaskRequest>> tasksByShard\n  20: optional map<i32, GetTaskFailedCause> failedCauseByShard\n}\n\nstruct RespondCrossClusterTasksCompletedRequest {\n  10: optional i32 shardID\n  20: optional string targetCluster\n  30: optional list<CrossClusterTaskResponse> taskResponses\n  40: optional bool fetchNewTasks\n}\n\nstruct RespondCrossClusterTasksCompletedResponse {\n  10: optional list<CrossClusterTaskRequest> tasks\n}\n"
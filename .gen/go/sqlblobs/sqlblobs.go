@@ -3377,6 +3377,8 @@ type DomainInfo struct {
 	FailoverEndTime             *int64            `json:"failoverEndTime,omitempty"`
 	PreviousFailoverVersion     *int64            `json:"previousFailoverVersion,omitempty"`
 	LastUpdatedTime             *int64            `json:"lastUpdatedTime,omitempty"`
+	AsyncWorkflowConfig         []byte            `json:"asyncWorkflowConfig,omitempty"`
+	AsyncWorkflowConfigEncoding *string           `json:"asyncWorkflowConfigEncoding,omitempty"`
 }
 
 type _Map_String_String_MapItemList map[string]string
@@ -3431,7 +3433,7 @@ func (_Map_String_String_MapItemList) Close() {}
 //   }
 func (v *DomainInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [24]wire.Field
+		fields [26]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -3629,6 +3631,22 @@ func (v *DomainInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 54, Value: w}
 		i++
 	}
+	if v.AsyncWorkflowConfig != nil {
+		w, err = wire.NewValueBinary(v.AsyncWorkflowConfig), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 56, Value: w}
+		i++
+	}
+	if v.AsyncWorkflowConfigEncoding != nil {
+		w, err = wire.NewValueString(*(v.AsyncWorkflowConfigEncoding)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 58, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -3916,6 +3934,24 @@ func (v *DomainInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 56:
+			if field.Value.Type() == wire.TBinary {
+				v.AsyncWorkflowConfig, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 58:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AsyncWorkflowConfigEncoding = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -4243,6 +4279,30 @@ func (v *DomainInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.AsyncWorkflowConfig != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 56, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteBinary(v.AsyncWorkflowConfig); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
+	if v.AsyncWorkflowConfigEncoding != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 58, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.AsyncWorkflowConfigEncoding)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -4490,6 +4550,20 @@ func (v *DomainInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 56 && fh.Type == wire.TBinary:
+			v.AsyncWorkflowConfig, err = sr.ReadBinary()
+			if err != nil {
+				return err
+			}
+
+		case fh.ID == 58 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.AsyncWorkflowConfigEncoding = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -4519,7 +4593,7 @@ func (v *DomainInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [24]string
+	var fields [26]string
 	i := 0
 	if v.Name != nil {
 		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
@@ -4617,6 +4691,14 @@ func (v *DomainInfo) String() string {
 		fields[i] = fmt.Sprintf("LastUpdatedTime: %v", *(v.LastUpdatedTime))
 		i++
 	}
+	if v.AsyncWorkflowConfig != nil {
+		fields[i] = fmt.Sprintf("AsyncWorkflowConfig: %v", v.AsyncWorkflowConfig)
+		i++
+	}
+	if v.AsyncWorkflowConfigEncoding != nil {
+		fields[i] = fmt.Sprintf("AsyncWorkflowConfigEncoding: %v", *(v.AsyncWorkflowConfigEncoding))
+		i++
+	}
 
 	return fmt.Sprintf("DomainInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -4730,6 +4812,12 @@ func (v *DomainInfo) Equals(rhs *DomainInfo) bool {
 	if !_I64_EqualsPtr(v.LastUpdatedTime, rhs.LastUpdatedTime) {
 		return false
 	}
+	if !((v.AsyncWorkflowConfig == nil && rhs.AsyncWorkflowConfig == nil) || (v.AsyncWorkflowConfig != nil && rhs.AsyncWorkflowConfig != nil && bytes.Equal(v.AsyncWorkflowConfig, rhs.AsyncWorkflowConfig))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.AsyncWorkflowConfigEncoding, rhs.AsyncWorkflowConfigEncoding) {
+		return false
+	}
 
 	return true
 }
@@ -4823,6 +4911,12 @@ func (v *DomainInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.LastUpdatedTime != nil {
 		enc.AddInt64("lastUpdatedTime", *v.LastUpdatedTime)
 	}
+	if v.AsyncWorkflowConfig != nil {
+		enc.AddString("asyncWorkflowConfig", base64.StdEncoding.EncodeToString(v.AsyncWorkflowConfig))
+	}
+	if v.AsyncWorkflowConfigEncoding != nil {
+		enc.AddString("asyncWorkflowConfigEncoding", *v.AsyncWorkflowConfigEncoding)
+	}
 	return err
 }
 
@@ -5186,6 +5280,36 @@ func (v *DomainInfo) IsSetLastUpdatedTime() bool {
 	return v != nil && v.LastUpdatedTime != nil
 }
 
+// GetAsyncWorkflowConfig returns the value of AsyncWorkflowConfig if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetAsyncWorkflowConfig() (o []byte) {
+	if v != nil && v.AsyncWorkflowConfig != nil {
+		return v.AsyncWorkflowConfig
+	}
+
+	return
+}
+
+// IsSetAsyncWorkflowConfig returns true if AsyncWorkflowConfig is not nil.
+func (v *DomainInfo) IsSetAsyncWorkflowConfig() bool {
+	return v != nil && v.AsyncWorkflowConfig != nil
+}
+
+// GetAsyncWorkflowConfigEncoding returns the value of AsyncWorkflowConfigEncoding if it is set or its
+// zero value if it is unset.
+func (v *DomainInfo) GetAsyncWorkflowConfigEncoding() (o string) {
+	if v != nil && v.AsyncWorkflowConfigEncoding != nil {
+		return *v.AsyncWorkflowConfigEncoding
+	}
+
+	return
+}
+
+// IsSetAsyncWorkflowConfigEncoding returns true if AsyncWorkflowConfigEncoding is not nil.
+func (v *DomainInfo) IsSetAsyncWorkflowConfigEncoding() bool {
+	return v != nil && v.AsyncWorkflowConfigEncoding != nil
+}
+
 type HistoryTreeInfo struct {
 	CreatedTimeNanos *int64                       `json:"createdTimeNanos,omitempty"`
 	Ancestors        []*shared.HistoryBranchRange `json:"ancestors,omitempty"`
@@ -6976,6 +7100,7 @@ type ShardInfo struct {
 	TimerProcessingQueueStatesEncoding        *string          `json:"timerProcessingQueueStatesEncoding,omitempty"`
 	CrossClusterProcessingQueueStates         []byte           `json:"crossClusterProcessingQueueStates,omitempty"`
 	CrossClusterProcessingQueueStatesEncoding *string          `json:"crossClusterProcessingQueueStatesEncoding,omitempty"`
+	DomainReplicationWatermarks               map[string]int64 `json:"domainReplicationWatermarks,omitempty"`
 }
 
 type _Map_String_I64_MapItemList map[string]int64
@@ -7030,7 +7155,7 @@ func (_Map_String_I64_MapItemList) Close() {}
 //   }
 func (v *ShardInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [19]wire.Field
+		fields [20]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -7188,6 +7313,14 @@ func (v *ShardInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 61, Value: w}
 		i++
 	}
+	if v.DomainReplicationWatermarks != nil {
+		w, err = wire.NewValueMap(_Map_String_I64_MapItemList(v.DomainReplicationWatermarks)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 62, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -7415,6 +7548,14 @@ func (v *ShardInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 62:
+			if field.Value.Type() == wire.TMap {
+				v.DomainReplicationWatermarks, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -7682,6 +7823,18 @@ func (v *ShardInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.DomainReplicationWatermarks != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 62, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_I64_Encode(v.DomainReplicationWatermarks, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -7879,6 +8032,12 @@ func (v *ShardInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 62 && fh.Type == wire.TMap:
+			v.DomainReplicationWatermarks, err = _Map_String_I64_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -7908,7 +8067,7 @@ func (v *ShardInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [19]string
+	var fields [20]string
 	i := 0
 	if v.StolenSinceRenew != nil {
 		fields[i] = fmt.Sprintf("StolenSinceRenew: %v", *(v.StolenSinceRenew))
@@ -7986,6 +8145,10 @@ func (v *ShardInfo) String() string {
 		fields[i] = fmt.Sprintf("CrossClusterProcessingQueueStatesEncoding: %v", *(v.CrossClusterProcessingQueueStatesEncoding))
 		i++
 	}
+	if v.DomainReplicationWatermarks != nil {
+		fields[i] = fmt.Sprintf("DomainReplicationWatermarks: %v", v.DomainReplicationWatermarks)
+		i++
+	}
 
 	return fmt.Sprintf("ShardInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -8074,6 +8237,9 @@ func (v *ShardInfo) Equals(rhs *ShardInfo) bool {
 	if !_String_EqualsPtr(v.CrossClusterProcessingQueueStatesEncoding, rhs.CrossClusterProcessingQueueStatesEncoding) {
 		return false
 	}
+	if !((v.DomainReplicationWatermarks == nil && rhs.DomainReplicationWatermarks == nil) || (v.DomainReplicationWatermarks != nil && rhs.DomainReplicationWatermarks != nil && _Map_String_I64_Equals(v.DomainReplicationWatermarks, rhs.DomainReplicationWatermarks))) {
+		return false
+	}
 
 	return true
 }
@@ -8152,6 +8318,9 @@ func (v *ShardInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.CrossClusterProcessingQueueStatesEncoding != nil {
 		enc.AddString("crossClusterProcessingQueueStatesEncoding", *v.CrossClusterProcessingQueueStatesEncoding)
 	}
+	if v.DomainReplicationWatermarks != nil {
+		err = multierr.Append(err, enc.AddObject("domainReplicationWatermarks", (_Map_String_I64_Zapper)(v.DomainReplicationWatermarks)))
+	}
 	return err
 }
 
@@ -8440,6 +8609,21 @@ func (v *ShardInfo) IsSetCrossClusterProcessingQueueStatesEncoding() bool {
 	return v != nil && v.CrossClusterProcessingQueueStatesEncoding != nil
 }
 
+// GetDomainReplicationWatermarks returns the value of DomainReplicationWatermarks if it is set or its
+// zero value if it is unset.
+func (v *ShardInfo) GetDomainReplicationWatermarks() (o map[string]int64) {
+	if v != nil && v.DomainReplicationWatermarks != nil {
+		return v.DomainReplicationWatermarks
+	}
+
+	return
+}
+
+// IsSetDomainReplicationWatermarks returns true if DomainReplicationWatermarks is not nil.
+func (v *ShardInfo) IsSetDomainReplicationWatermarks() bool {
+	return v != nil && v.DomainReplicationWatermarks != nil
+}
+
 type SignalInfo struct {
 	Version               *int64  `json:"version,omitempty"`
 	InitiatedEventBatchID *int64  `json:"initiatedEventBatchID,omitempty"`
@@ -8968,6 +9152,7 @@ type TaskInfo struct {
 	ScheduleID       *int64  `json:"scheduleID,omitempty"`
 	ExpiryTimeNanos  *int64  `json:"expiryTimeNanos,omitempty"`
 	CreatedTimeNanos *int64  `json:"createdTimeNanos,omitempty"`
+	AffinityKey      *string `json:"affinityKey,omitempty"`
 }
 
 // ToWire translates a TaskInfo struct into a Thrift-level intermediate
@@ -8987,7 +9172,7 @@ type TaskInfo struct {
 //   }
 func (v *TaskInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -9033,6 +9218,14 @@ func (v *TaskInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 15, Value: w}
 		i++
 	}
+	if v.AffinityKey != nil {
+		w, err = wire.NewValueString(*(v.AffinityKey)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -9106,6 +9299,16 @@ func (v *TaskInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 16:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.AffinityKey = &x
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -9182,6 +9385,18 @@ func (v *TaskInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.AffinityKey != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 16, Type: wire.TBinary}); err != nil {
+			return err
+		}
+		if err := sw.WriteString(*(v.AffinityKey)); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -9241,6 +9456,14 @@ func (v *TaskInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 16 && fh.Type == wire.TBinary:
+			var x string
+			x, err = sr.ReadString()
+			v.AffinityKey = &x
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -9270,7 +9493,7 @@ func (v *TaskInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	if v.WorkflowID != nil {
 		fields[i] = fmt.Sprintf("WorkflowID: %v", *(v.WorkflowID))
@@ -9292,6 +9515,10 @@ func (v *TaskInfo) String() string {
 		fields[i] = fmt.Sprintf("CreatedTimeNanos: %v", *(v.CreatedTimeNanos))
 		i++
 	}
+	if v.AffinityKey != nil {
+		fields[i] = fmt.Sprintf("AffinityKey: %v", *(v.AffinityKey))
+		i++
+	}
 
 	return fmt.Sprintf("TaskInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -9321,6 +9548,9 @@ func (v *TaskInfo) Equals(rhs *TaskInfo) bool {
 	if !_I64_EqualsPtr(v.CreatedTimeNanos, rhs.CreatedTimeNanos) {
 		return false
 	}
+	if !_String_EqualsPtr(v.AffinityKey, rhs.AffinityKey) {
+		return false
+	}
 
 	return true
 }
@@ -9346,6 +9576,9 @@ func (v *TaskInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.CreatedTimeNanos != nil {
 		enc.AddInt64("createdTimeNanos", *v.CreatedTimeNanos)
 	}
+	if v.AffinityKey != nil {
+		enc.AddString("affinityKey", *v.AffinityKey)
+	}
 	return err
 }
 
@@ -9424,11 +9657,27 @@ func (v *TaskInfo) IsSetCreatedTimeNanos() bool {
 	return v != nil && v.CreatedTimeNanos != nil
 }
 
+// GetAffinityKey returns the value of AffinityKey if it is set or its
+// zero value if it is unset.
+func (v *TaskInfo) GetAffinityKey() (o string) {
+	if v != nil && v.AffinityKey != nil {
+		return *v.AffinityKey
+	}
+
+	return
+}
+
+// IsSetAffinityKey returns true if AffinityKey is not nil.
+func (v *TaskInfo) IsSetAffinityKey() bool {
+	return v != nil && v.AffinityKey != nil
+}
+
 type TaskListInfo struct {
-	Kind             *int16 `json:"kind,omitempty"`
-	AckLevel         *int64 `json:"ackLevel,omitempty"`
-	ExpiryTimeNanos  *int64 `json:"expiryTimeNanos,omitempty"`
-	LastUpdatedNanos *int64 `json:"lastUpdatedNanos,omitempty"`
+	Kind             *int16            `json:"kind,omitempty"`
+	AckLevel         *int64            `json:"ackLevel,omitempty"`
+	ExpiryTimeNanos  *int64            `json:"expiryTimeNanos,omitempty"`
+	LastUpdatedNanos *int64            `json:"lastUpdatedNanos,omitempty"`
+	Tags             map[string]string `json:"tags,omitempty"`
 }
 
 // ToWire translates a TaskListInfo struct into a Thrift-level intermediate
@@ -9448,7 +9697,7 @@ type TaskListInfo struct {
 //   }
 func (v *TaskListInfo) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -9486,6 +9735,14 @@ func (v *TaskListInfo) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 16, Value: w}
 		i++
 	}
+	if v.Tags != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Tags)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 18, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -9551,6 +9808,14 @@ func (v *TaskListInfo) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 18:
+			if field.Value.Type() == wire.TMap {
+				v.Tags, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -9615,6 +9880,18 @@ func (v *TaskListInfo) Encode(sw stream.Writer) error {
 		}
 	}
 
+	if v.Tags != nil {
+		if err := sw.WriteFieldBegin(stream.FieldHeader{ID: 18, Type: wire.TMap}); err != nil {
+			return err
+		}
+		if err := _Map_String_String_Encode(v.Tags, sw); err != nil {
+			return err
+		}
+		if err := sw.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+
 	return sw.WriteStructEnd()
 }
 
@@ -9668,6 +9945,12 @@ func (v *TaskListInfo) Decode(sr stream.Reader) error {
 				return err
 			}
 
+		case fh.ID == 18 && fh.Type == wire.TMap:
+			v.Tags, err = _Map_String_String_Decode(sr)
+			if err != nil {
+				return err
+			}
+
 		default:
 			if err := sr.Skip(fh.Type); err != nil {
 				return err
@@ -9697,7 +9980,7 @@ func (v *TaskListInfo) String() string {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
 	if v.Kind != nil {
 		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
@@ -9715,6 +9998,10 @@ func (v *TaskListInfo) String() string {
 		fields[i] = fmt.Sprintf("LastUpdatedNanos: %v", *(v.LastUpdatedNanos))
 		i++
 	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
 
 	return fmt.Sprintf("TaskListInfo{%v}", strings.Join(fields[:i], ", "))
 }
@@ -9741,6 +10028,9 @@ func (v *TaskListInfo) Equals(rhs *TaskListInfo) bool {
 	if !_I64_EqualsPtr(v.LastUpdatedNanos, rhs.LastUpdatedNanos) {
 		return false
 	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Map_String_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
 
 	return true
 }
@@ -9763,6 +10053,9 @@ func (v *TaskListInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.LastUpdatedNanos != nil {
 		enc.AddInt64("lastUpdatedNanos", *v.LastUpdatedNanos)
 	}
+	if v.Tags != nil {
+		err = multierr.Append(err, enc.AddObject("tags", (_Map_String_String_Zapper)(v.Tags)))
+	}
 	return err
 }
 
@@ -9826,6 +10119,21 @@ func (v *TaskListInfo) IsSetLastUpdatedNanos() bool {
 	return v != nil && v.LastUpdatedNanos != nil
 }
 
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+func (v *TaskListInfo) GetTags() (o map[string]string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+func (v *TaskListInfo) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
 type TimerInfo struct {
 	Version         *int64 `json:"version,omitempty"`
 	StartedID       *int64 `json:"startedID,omitempty"`
@@ -15953,11 +16261,11 @@ var ThriftModule = &thriftreflect.ThriftModule{
 	Name:     "sqlblobs",
 	Package:  "github.com/uber/cadence/.gen/go/sqlblobs",
 	FilePath: "sqlblobs.thrift",
-	SHA1:     "215db027f8e11111bbb3bc537dcd7dc0e4a09213",
+	SHA1:     "955e68f423f702613e37f66b53c1a1fe13038c1f",
 	Includes: []*thriftreflect.ThriftModule{
 		shared.ThriftModule,
 	},
 	Raw: rawIDL,
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}"
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.sqlblobs\n\ninclude \"shared.thrift\"\n\nstruct ShardInfo {\n  10: optional i32 stolenSinceRenew\n  12: optional i64 (js.type = \"Long\") updatedAtNanos\n  14: optional i64 (js.type = \"Long\") replicationAckLevel\n  16: optional i64 (js.type = \"Long\") transferAckLevel\n  18: optional i64 (js.type = \"Long\") timerAckLevelNanos\n  24: optional i64 (js.type = \"Long\") domainNotificationVersion\n  34: optional map<string, i64> clusterTransferAckLevel\n  36: optional map<string, i64> clusterTimerAckLevel\n  38: optional string owner\n  40: optional map<string, i64> clusterReplicationLevel\n  42: optional binary pendingFailoverMarkers\n  44: optional string pendingFailoverMarkersEncoding\n  46: optional map<string, i64> replicationDlqAckLevel\n  50: optional binary transferProcessingQueueStates\n  51: optional string transferProcessingQueueStatesEncoding\n  55: optional binary timerProcessingQueueStates\n  56: optional string timerProcessingQueueStatesEncoding\n  60: optional binary crossClusterProcessingQueueStates\n  61: optional string crossClusterProcessingQueueStatesEncoding\n  62: optional map<string, i64> domainReplicationWatermarks\n}\n\nstruct DomainInfo {\n  10: optional string name\n  12: optional string description\n  14: optional string owner\n  16: optional i32 status\n  18: optional i16 retentionDays\n  20: optional bool emitMetric\n  22: optional string archivalBucket\n  24: optional i16 archivalStatus\n  26: optional i64 (js.type = \"Long\") configVersion\n  28: optional i64 (js.type = \"Long\") notificationVersion\n  30: optional i64 (js.type = \"Long\") failoverNotificationVersion\n  32: optional i64 (js.type = \"Long\") failoverVersion\n  34: optional string activeClusterName\n  36: optional list<string> clusters\n  38: optional map<string, string> data\n  39: optional binary badBinaries\n  40: optional string badBinariesEncoding\n  42: optional i16 historyArchivalStatus\n  44: optional string historyArchivalURI\n  46: optional i16 visibilityArchivalStatus\n  48: optional string visibilityArchivalURI\n  50: optional i64 (js.type = \"Long\") failoverEndTime\n  52: optional i64 (js.type = \"Long\") previousFailoverVersion\n  54: optional i64 (js.type = \"Long\") lastUpdatedTime\n  56: optional binary asyncWorkflowConfig\n  58: optional string asyncWorkflowConfigEncoding\n}\n\nstruct HistoryTreeInfo {\n  10: optional i64 (js.type = \"Long\") createdTimeNanos // For fork operation to prevent race condition of leaking event data when forking branches fail. Also can be used for clean up leaked data\n  12: optional list<shared.HistoryBranchRange> ancestors\n  14: optional string info // For lookup back to workflow during debugging, also background cleanup when fork operation cannot finish self cleanup due to crash.\n}\n\nstruct WorkflowExecutionInfo {\n  10: optional binary parentDomainID\n  12: optional string parentWorkflowID\n  14: optional binary parentRunID\n  16: optional i64 (js.type = \"Long\") initiatedID\n  18: optional i64 (js.type = \"Long\") completionEventBatchID\n  20: optional binary completionEvent\n  22: optional string completionEventEncoding\n  24: optional string taskList\n  26: optional string workflowTypeName\n  28: optional i32 workflowTimeoutSeconds\n  30: optional i32 decisionTaskTimeoutSeconds\n  32: optional binary executionContext\n  34: optional i32 state\n  36: optional i32 closeStatus\n  38: optional i64 (js.type = \"Long\") startVersion\n  44: optional i64 (js.type = \"Long\") lastWriteEventID\n  48: optional i64 (js.type = \"Long\") lastEventTaskID\n  50: optional i64 (js.type = \"Long\") lastFirstEventID\n  52: optional i64 (js.type = \"Long\") lastProcessedEvent\n  54: optional i64 (js.type = \"Long\") startTimeNanos\n  56: optional i64 (js.type = \"Long\") lastUpdatedTimeNanos\n  58: optional i64 (js.type = \"Long\") decisionVersion\n  60: optional i64 (js.type = \"Long\") decisionScheduleID\n  62: optional i64 (js.type = \"Long\") decisionStartedID\n  64: optional i32 decisionTimeout\n  66: optional i64 (js.type = \"Long\") decisionAttempt\n  68: optional i64 (js.type = \"Long\") decisionStartedTimestampNanos\n  69: optional i64 (js.type = \"Long\") decisionScheduledTimestampNanos\n  70: optional bool cancelRequested\n  71: optional i64 (js.type = \"Long\") decisionOriginalScheduledTimestampNanos\n  72: optional string createRequestID\n  74: optional string decisionRequestID\n  76: optional string cancelRequestID\n  78: optional string stickyTaskList\n  80: optional i64 (js.type = \"Long\") stickyScheduleToStartTimeout\n  82: optional i64 (js.type = \"Long\") retryAttempt\n  84: optional i32 retryInitialIntervalSeconds\n  86: optional i32 retryMaximumIntervalSeconds\n  88: optional i32 retryMaximumAttempts\n  90: optional i32 retryExpirationSeconds\n  92: optional double retryBackoffCoefficient\n  94: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  96: optional list<string> retryNonRetryableErrors\n  98: optional bool hasRetryPolicy\n  100: optional string cronSchedule\n  102: optional i32 eventStoreVersion\n  104: optional binary eventBranchToken\n  106: optional i64 (js.type = \"Long\") signalCount\n  108: optional i64 (js.type = \"Long\") historySize\n  110: optional string clientLibraryVersion\n  112: optional string clientFeatureVersion\n  114: optional string clientImpl\n  115: optional binary autoResetPoints\n  116: optional string autoResetPointsEncoding\n  118: optional map<string, binary> searchAttributes\n  120: optional map<string, binary> memo\n  122: optional binary versionHistories\n  124: optional string versionHistoriesEncoding\n}\n\nstruct ActivityInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") scheduledEventBatchID\n  14: optional binary scheduledEvent\n  16: optional string scheduledEventEncoding\n  18: optional i64 (js.type = \"Long\") scheduledTimeNanos\n  20: optional i64 (js.type = \"Long\") startedID\n  22: optional binary startedEvent\n  24: optional string startedEventEncoding\n  26: optional i64 (js.type = \"Long\") startedTimeNanos\n  28: optional string activityID\n  30: optional string requestID\n  32: optional i32 scheduleToStartTimeoutSeconds\n  34: optional i32 scheduleToCloseTimeoutSeconds\n  36: optional i32 startToCloseTimeoutSeconds\n  38: optional i32 heartbeatTimeoutSeconds\n  40: optional bool cancelRequested\n  42: optional i64 (js.type = \"Long\") cancelRequestID\n  44: optional i32 timerTaskStatus\n  46: optional i32 attempt\n  48: optional string taskList\n  50: optional string startedIdentity\n  52: optional bool hasRetryPolicy\n  54: optional i32 retryInitialIntervalSeconds\n  56: optional i32 retryMaximumIntervalSeconds\n  58: optional i32 retryMaximumAttempts\n  60: optional i64 (js.type = \"Long\") retryExpirationTimeNanos\n  62: optional double retryBackoffCoefficient\n  64: optional list<string> retryNonRetryableErrors\n  66: optional string retryLastFailureReason\n  68: optional string retryLastWorkerIdentity\n  70: optional binary retryLastFailureDetails\n}\n\nstruct ChildExecutionInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  14: optional i64 (js.type = \"Long\") startedID\n  16: optional binary initiatedEvent\n  18: optional string initiatedEventEncoding\n  20: optional string startedWorkflowID\n  22: optional binary startedRunID\n  24: optional binary startedEvent\n  26: optional string startedEventEncoding\n  28: optional string createRequestID\n  29: optional string domainID\n  30: optional string domainName // deprecated\n  32: optional string workflowTypeName\n  35: optional i32 parentClosePolicy\n}\n\nstruct SignalInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string requestID\n  14: optional string name\n  16: optional binary input\n  18: optional binary control\n}\n\nstruct RequestCancelInfo {\n  10: optional i64 (js.type = \"Long\") version\n  11: optional i64 (js.type = \"Long\") initiatedEventBatchID\n  12: optional string cancelRequestID\n}\n\nstruct TimerInfo {\n  10: optional i64 (js.type = \"Long\") version\n  12: optional i64 (js.type = \"Long\") startedID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  // TaskID is a misleading variable, it actually serves\n  // the purpose of indicating whether a timer task is\n  // generated for this timer info\n  16: optional i64 (js.type = \"Long\") taskID\n}\n\nstruct TaskInfo {\n  10: optional string workflowID\n  12: optional binary runID\n  13: optional i64 (js.type = \"Long\") scheduleID\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  15: optional i64 (js.type = \"Long\") createdTimeNanos\n  16: optional string affinityKey\n}\n\nstruct TaskListInfo {\n  10: optional i16 kind // {Normal, Sticky}\n  12: optional i64 (js.type = \"Long\") ackLevel\n  14: optional i64 (js.type = \"Long\") expiryTimeNanos\n  16: optional i64 (js.type = \"Long\") lastUpdatedNanos\n  18: optional map<string, string> tags\n}\n\nstruct TransferTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional binary targetDomainID\n  20: optional string targetWorkflowID\n  22: optional binary targetRunID\n  24: optional string taskList\n  26: optional bool targetChildWorkflowOnly\n  28: optional i64 (js.type = \"Long\") scheduleID\n  30: optional i64 (js.type = \"Long\") version\n  32: optional i64 (js.type = \"Long\") visibilityTimestampNanos\n  34: optional set<binary> targetDomainIDs\n}\n\nstruct TimerTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i16 timeoutType\n  20: optional i64 (js.type = \"Long\") version\n  22: optional i64 (js.type = \"Long\") scheduleAttempt\n  24: optional i64 (js.type = \"Long\") eventID\n}\n\nstruct ReplicationTaskInfo {\n  10: optional binary domainID\n  12: optional string workflowID\n  14: optional binary runID\n  16: optional i16 taskType\n  18: optional i64 (js.type = \"Long\") version\n  20: optional i64 (js.type = \"Long\") firstEventID\n  22: optional i64 (js.type = \"Long\") nextEventID\n  24: optional i64 (js.type = \"Long\") scheduledID\n  26: optional i32 eventStoreVersion\n  28: optional i32 newRunEventStoreVersion\n  30: optional binary branch_token\n  34: optional binary newRunBranchToken\n  38: optional i64 (js.type = \"Long\") creationTime\n}"
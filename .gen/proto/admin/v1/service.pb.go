@@ -387,14 +387,15 @@ func (m *DescribeShardDistributionResponse) GetShards() map[int32]string {
 }
 
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        int32                `protobuf:"varint,1,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
-	ShardIds              []int32              `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
-	DomainCache           *v11.DomainCacheInfo `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
-	ShardControllerStatus string               `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
-	Address               string               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
-	XXX_unrecognized      []byte               `json:"-"`
-	XXX_sizecache         int32                `json:"-"`
+	NumberOfShards        int32                         `protobuf:"varint,1,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
+	ShardIds              []int32                       `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	DomainCache           *v11.DomainCacheInfo          `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
+	ShardControllerStatus string                        `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
+	Address               string                        `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	PayloadSizeStats      []*v11.DomainPayloadSizeStats `protobuf:"bytes,6,rep,name=payload_size_stats,json=payloadSizeStats,proto3" json:"payload_size_stats,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                      `json:"-"`
+	XXX_unrecognized      []byte                        `json:"-"`
+	XXX_sizecache         int32                         `json:"-"`
 }
 
 func (m *DescribeHistoryHostResponse) Reset()         { *m = DescribeHistoryHostResponse{} }
@@ -465,6 +466,13 @@ func (m *DescribeHistoryHostResponse) GetAddress() string {
 	return ""
 }
 
+func (m *DescribeHistoryHostResponse) GetPayloadSizeStats() []*v11.DomainPayloadSizeStats {
+	if m != nil {
+		return m.PayloadSizeStats
+	}
+	return nil
+}
+
 type CloseShardRequest struct {
 	ShardId              int32    `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -881,6 +889,100 @@ func (m *DescribeQueueResponse) GetProcessingQueueStates() []string {
 	return nil
 }
 
+type GetReplicationAckStatesRequest struct {
+	ShardId              int32    `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReplicationAckStatesRequest) Reset()         { *m = GetReplicationAckStatesRequest{} }
+func (m *GetReplicationAckStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationAckStatesRequest) ProtoMessage()    {}
+func (*GetReplicationAckStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{14}
+}
+func (m *GetReplicationAckStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationAckStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationAckStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationAckStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationAckStatesRequest.Merge(m, src)
+}
+func (m *GetReplicationAckStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationAckStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationAckStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationAckStatesRequest proto.InternalMessageInfo
+
+func (m *GetReplicationAckStatesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+type GetReplicationAckStatesResponse struct {
+	AckStates            []*v11.ReplicationAckState `protobuf:"bytes,1,rep,name=ack_states,json=ackStates,proto3" json:"ack_states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetReplicationAckStatesResponse) Reset()         { *m = GetReplicationAckStatesResponse{} }
+func (m *GetReplicationAckStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationAckStatesResponse) ProtoMessage()    {}
+func (*GetReplicationAckStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{15}
+}
+func (m *GetReplicationAckStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationAckStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationAckStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationAckStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationAckStatesResponse.Merge(m, src)
+}
+func (m *GetReplicationAckStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationAckStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationAckStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationAckStatesResponse proto.InternalMessageInfo
+
+func (m *GetReplicationAckStatesResponse) GetAckStates() []*v11.ReplicationAckState {
+	if m != nil {
+		return m.AckStates
+	}
+	return nil
+}
+
 type GetWorkflowExecutionRawHistoryV2Request struct {
 	Domain               string                  `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	WorkflowExecution    *v1.WorkflowExecution   `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
@@ -899,7 +1001,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Reset() {
 func (m *GetWorkflowExecutionRawHistoryV2Request) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowExecutionRawHistoryV2Request) ProtoMessage()    {}
 func (*GetWorkflowExecutionRawHistoryV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{14}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{16}
 }
 func (m *GetWorkflowExecutionRawHistoryV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1087,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Reset() {
 func (m *GetWorkflowExecutionRawHistoryV2Response) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowExecutionRawHistoryV2Response) ProtoMessage()    {}
 func (*GetWorkflowExecutionRawHistoryV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{15}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{17}
 }
 func (m *GetWorkflowExecutionRawHistoryV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,18 +1138,20 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) GetVersionHistory() *v11.Vers
 }
 
 type GetReplicationMessagesRequest struct {
-	Tokens               []*v11.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	ClusterName          string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Tokens      []*v11.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	ClusterName string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Compressions the requesting cluster can decode.
+	SupportedCompressions []v11.CompressionType `protobuf:"varint,3,rep,packed,name=supported_compressions,json=supportedCompressions,proto3,enum=uber.cadence.shared.v1.CompressionType" json:"supported_compressions,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
 }
 
 func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMessagesRequest{} }
 func (m *GetReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{16}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{18}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1090,6 +1194,13 @@ func (m *GetReplicationMessagesRequest) GetClusterName() string {
 	return ""
 }
 
+func (m *GetReplicationMessagesRequest) GetSupportedCompressions() []v11.CompressionType {
+	if m != nil {
+		return m.SupportedCompressions
+	}
+	return nil
+}
+
 type GetReplicationMessagesResponse struct {
 	ShardMessages        map[int32]*v11.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
@@ -1101,7 +1212,7 @@ func (m *GetReplicationMessagesResponse) Reset()         { *m = GetReplicationMe
 func (m *GetReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{17}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{19}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,17 +1249,19 @@ func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v11.Repli
 }
 
 type GetDLQReplicationMessagesRequest struct {
-	TaskInfos            []*v11.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	TaskInfos []*v11.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
+	// Compressions the requesting cluster can decode.
+	SupportedCompressions []v11.CompressionType `protobuf:"varint,2,rep,packed,name=supported_compressions,json=supportedCompressions,proto3,enum=uber.cadence.shared.v1.CompressionType" json:"supported_compressions,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
 }
 
 func (m *GetDLQReplicationMessagesRequest) Reset()         { *m = GetDLQReplicationMessagesRequest{} }
 func (m *GetDLQReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{18}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{20}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1184,6 +1297,13 @@ func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v11.ReplicationTask
 	return nil
 }
 
+func (m *GetDLQReplicationMessagesRequest) GetSupportedCompressions() []v11.CompressionType {
+	if m != nil {
+		return m.SupportedCompressions
+	}
+	return nil
+}
+
 type GetDLQReplicationMessagesResponse struct {
 	ReplicationTasks     []*v11.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -1195,7 +1315,7 @@ func (m *GetDLQReplicationMessagesResponse) Reset()         { *m = GetDLQReplica
 func (m *GetDLQReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{19}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{21}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1368,7 @@ func (m *GetDomainReplicationMessagesRequest) Reset()         { *m = GetDomainRe
 func (m *GetDomainReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDomainReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetDomainReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{20}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{22}
 }
 func (m *GetDomainReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1309,7 +1429,7 @@ func (m *GetDomainReplicationMessagesResponse) Reset()         { *m = GetDomainR
 func (m *GetDomainReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDomainReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetDomainReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{21}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{23}
 }
 func (m *GetDomainReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1359,7 +1479,7 @@ func (m *ReapplyEventsRequest) Reset()         { *m = ReapplyEventsRequest{} }
 func (m *ReapplyEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsRequest) ProtoMessage()    {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{22}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{24}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1419,7 +1539,7 @@ func (m *ReapplyEventsResponse) Reset()         { *m = ReapplyEventsResponse{} }
 func (m *ReapplyEventsResponse) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsResponse) ProtoMessage()    {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{23}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{25}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1460,7 +1580,7 @@ func (m *AddSearchAttributeRequest) Reset()         { *m = AddSearchAttributeReq
 func (m *AddSearchAttributeRequest) String() string { return proto.CompactTextString(m) }
 func (*AddSearchAttributeRequest) ProtoMessage()    {}
 func (*AddSearchAttributeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{24}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{26}
 }
 func (m *AddSearchAttributeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1513,7 +1633,7 @@ func (m *AddSearchAttributeResponse) Reset()         { *m = AddSearchAttributeRe
 func (m *AddSearchAttributeResponse) String() string { return proto.CompactTextString(m) }
 func (*AddSearchAttributeResponse) ProtoMessage()    {}
 func (*AddSearchAttributeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{25}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{27}
 }
 func (m *AddSearchAttributeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1552,7 +1672,7 @@ func (m *DescribeClusterRequest) Reset()         { *m = DescribeClusterRequest{}
 func (m *DescribeClusterRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeClusterRequest) ProtoMessage()    {}
 func (*DescribeClusterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{26}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{28}
 }
 func (m *DescribeClusterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1594,7 +1714,7 @@ func (m *DescribeClusterResponse) Reset()         { *m = DescribeClusterResponse
 func (m *DescribeClusterResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeClusterResponse) ProtoMessage()    {}
 func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{27}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{29}
 }
 func (m *DescribeClusterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1651,16 +1771,18 @@ type ReadDLQMessagesRequest struct {
 	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
 	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
-	XXX_unrecognized      []byte            `json:"-"`
-	XXX_sizecache         int32             `json:"-"`
+	// Inclusive upper bound of the shard range starting at shard_id. Ignored when not greater than shard_id.
+	EndShardId           int32    `protobuf:"varint,7,opt,name=end_shard_id,json=endShardId,proto3" json:"end_shard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{} }
 func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesRequest) ProtoMessage()    {}
 func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{28}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{30}
 }
 func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,6 +1853,13 @@ func (m *ReadDLQMessagesRequest) GetNextPageToken() []byte {
 	return nil
 }
 
+func (m *ReadDLQMessagesRequest) GetEndShardId() int32 {
+	if m != nil {
+		return m.EndShardId
+	}
+	return 0
+}
+
 type ReadDLQMessagesResponse struct {
 	Type                 v11.DLQType                `protobuf:"varint,1,opt,name=type,proto3,enum=uber.cadence.shared.v1.DLQType" json:"type,omitempty"`
 	ReplicationTasks     []*v11.ReplicationTask     `protobuf:"bytes,2,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
@@ -1745,7 +1874,7 @@ func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse
 func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesResponse) ProtoMessage()    {}
 func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{29}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{31}
 }
 func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1807,16 +1936,18 @@ type PurgeDLQMessagesRequest struct {
 	ShardId               int32             `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	SourceCluster         string            `protobuf:"bytes,3,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
-	XXX_unrecognized      []byte            `json:"-"`
-	XXX_sizecache         int32             `json:"-"`
+	// Inclusive upper bound of the shard range starting at shard_id. Ignored when not greater than shard_id.
+	EndShardId           int32    `protobuf:"varint,5,opt,name=end_shard_id,json=endShardId,proto3" json:"end_shard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest{} }
 func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{30}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{32}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1873,6 +2004,13 @@ func (m *PurgeDLQMessagesRequest) GetInclusiveEndMessageId() *types.Int64Value {
 	return nil
 }
 
+func (m *PurgeDLQMessagesRequest) GetEndShardId() int32 {
+	if m != nil {
+		return m.EndShardId
+	}
+	return 0
+}
+
 type PurgeDLQMessagesResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1883,7 +2021,7 @@ func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesRespon
 func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{31}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{33}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1919,16 +2057,18 @@ type MergeDLQMessagesRequest struct {
 	InclusiveEndMessageId *types.Int64Value `protobuf:"bytes,4,opt,name=inclusive_end_message_id,json=inclusiveEndMessageId,proto3" json:"inclusive_end_message_id,omitempty"`
 	PageSize              int32             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	NextPageToken         []byte            `protobuf:"bytes,6,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}          `json:"-"`
-	XXX_unrecognized      []byte            `json:"-"`
-	XXX_sizecache         int32             `json:"-"`
+	// Inclusive upper bound of the shard range starting at shard_id. Ignored when not greater than shard_id.
+	EndShardId           int32    `protobuf:"varint,7,opt,name=end_shard_id,json=endShardId,proto3" json:"end_shard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest{} }
 func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesRequest) ProtoMessage()    {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{32}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{34}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,6 +2139,13 @@ func (m *MergeDLQMessagesRequest) GetNextPageToken() []byte {
 	return nil
 }
 
+func (m *MergeDLQMessagesRequest) GetEndShardId() int32 {
+	if m != nil {
+		return m.EndShardId
+	}
+	return 0
+}
+
 type MergeDLQMessagesResponse struct {
 	NextPageToken        []byte   `protobuf:"bytes,1,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2010,7 +2157,7 @@ func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesRespon
 func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesResponse) ProtoMessage()    {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{33}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{35}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2058,7 +2205,7 @@ func (m *RefreshWorkflowTasksRequest) Reset()         { *m = RefreshWorkflowTask
 func (m *RefreshWorkflowTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksRequest) ProtoMessage()    {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{34}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{36}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2258,7 @@ func (m *RefreshWorkflowTasksResponse) Reset()         { *m = RefreshWorkflowTas
 func (m *RefreshWorkflowTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksResponse) ProtoMessage()    {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{35}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{37}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2155,7 +2302,7 @@ func (m *ResendReplicationTasksRequest) Reset()         { *m = ResendReplication
 func (m *ResendReplicationTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*ResendReplicationTasksRequest) ProtoMessage()    {}
 func (*ResendReplicationTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{36}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{38}
 }
 func (m *ResendReplicationTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2229,7 +2376,7 @@ func (m *ResendReplicationTasksResponse) Reset()         { *m = ResendReplicatio
 func (m *ResendReplicationTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*ResendReplicationTasksResponse) ProtoMessage()    {}
 func (*ResendReplicationTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{37}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{39}
 }
 func (m *ResendReplicationTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2270,7 +2417,7 @@ func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTask
 func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
 func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{38}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{40}
 }
 func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2325,7 +2472,7 @@ func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTas
 func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
 func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{39}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{41}
 }
 func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2384,7 +2531,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
 func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
 func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{40}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{42}
 }
 func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2456,7 +2603,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) String() string {
 }
 func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
 func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{41}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{43}
 }
 func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2655,7 @@ func (m *GetDynamicConfigRequest) Reset()         { *m = GetDynamicConfigRequest
 func (m *GetDynamicConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDynamicConfigRequest) ProtoMessage()    {}
 func (*GetDynamicConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{42}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{44}
 }
 func (m *GetDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2562,7 +2709,7 @@ func (m *GetDynamicConfigResponse) Reset()         { *m = GetDynamicConfigRespon
 func (m *GetDynamicConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDynamicConfigResponse) ProtoMessage()    {}
 func (*GetDynamicConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{43}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{45}
 }
 func (m *GetDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2612,7 +2759,7 @@ func (m *UpdateDynamicConfigRequest) Reset()         { *m = UpdateDynamicConfigR
 func (m *UpdateDynamicConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDynamicConfigRequest) ProtoMessage()    {}
 func (*UpdateDynamicConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{44}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{46}
 }
 func (m *UpdateDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2665,7 +2812,7 @@ func (m *UpdateDynamicConfigResponse) Reset()         { *m = UpdateDynamicConfig
 func (m *UpdateDynamicConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*UpdateDynamicConfigResponse) ProtoMessage()    {}
 func (*UpdateDynamicConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{45}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{47}
 }
 func (m *UpdateDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2707,7 +2854,7 @@ func (m *RestoreDynamicConfigRequest) Reset()         { *m = RestoreDynamicConfi
 func (m *RestoreDynamicConfigRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreDynamicConfigRequest) ProtoMessage()    {}
 func (*RestoreDynamicConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{46}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{48}
 }
 func (m *RestoreDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2907,7 @@ func (m *RestoreDynamicConfigResponse) Reset()         { *m = RestoreDynamicConf
 func (m *RestoreDynamicConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*RestoreDynamicConfigResponse) ProtoMessage()    {}
 func (*RestoreDynamicConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{47}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{49}
 }
 func (m *RestoreDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2801,7 +2948,7 @@ func (m *AdminDeleteWorkflowRequest) Reset()         { *m = AdminDeleteWorkflowR
 func (m *AdminDeleteWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*AdminDeleteWorkflowRequest) ProtoMessage()    {}
 func (*AdminDeleteWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{48}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{50}
 }
 func (m *AdminDeleteWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2857,7 +3004,7 @@ func (m *AdminDeleteWorkflowResponse) Reset()         { *m = AdminDeleteWorkflow
 func (m *AdminDeleteWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*AdminDeleteWorkflowResponse) ProtoMessage()    {}
 func (*AdminDeleteWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{49}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{51}
 }
 func (m *AdminDeleteWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2919,7 +3066,7 @@ func (m *AdminMaintainWorkflowRequest) Reset()         { *m = AdminMaintainWorkf
 func (m *AdminMaintainWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*AdminMaintainWorkflowRequest) ProtoMessage()    {}
 func (*AdminMaintainWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{50}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{52}
 }
 func (m *AdminMaintainWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2975,7 +3122,7 @@ func (m *AdminMaintainWorkflowResponse) Reset()         { *m = AdminMaintainWork
 func (m *AdminMaintainWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*AdminMaintainWorkflowResponse) ProtoMessage()    {}
 func (*AdminMaintainWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{51}
+	return fileDescriptor_c6fc96d64a8b67fd, []int{53}
 }
 func (m *AdminMaintainWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3025,25 +3172,25 @@ func (m *AdminMaintainWorkflowResponse) GetVisibilityDeleted() bool {
 	return false
 }
 
-type ListDynamicConfigRequest struct {
-	ConfigName           string   `protobuf:"bytes,1,opt,name=config_name,json=configName,proto3" json:"config_name,omitempty"`
+type GetDomainReplicationWatermarkRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListDynamicConfigRequest) Reset()         { *m = ListDynamicConfigRequest{} }
-func (m *ListDynamicConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*ListDynamicConfigRequest) ProtoMessage()    {}
-func (*ListDynamicConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{52}
+func (m *GetDomainReplicationWatermarkRequest) Reset()         { *m = GetDomainReplicationWatermarkRequest{} }
+func (m *GetDomainReplicationWatermarkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDomainReplicationWatermarkRequest) ProtoMessage()    {}
+func (*GetDomainReplicationWatermarkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{54}
 }
-func (m *ListDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetDomainReplicationWatermarkRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDomainReplicationWatermarkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListDynamicConfigRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDomainReplicationWatermarkRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3053,44 +3200,45 @@ func (m *ListDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ListDynamicConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListDynamicConfigRequest.Merge(m, src)
+func (m *GetDomainReplicationWatermarkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDomainReplicationWatermarkRequest.Merge(m, src)
 }
-func (m *ListDynamicConfigRequest) XXX_Size() int {
+func (m *GetDomainReplicationWatermarkRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListDynamicConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListDynamicConfigRequest.DiscardUnknown(m)
+func (m *GetDomainReplicationWatermarkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDomainReplicationWatermarkRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListDynamicConfigRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetDomainReplicationWatermarkRequest proto.InternalMessageInfo
 
-func (m *ListDynamicConfigRequest) GetConfigName() string {
+func (m *GetDomainReplicationWatermarkRequest) GetDomain() string {
 	if m != nil {
-		return m.ConfigName
+		return m.Domain
 	}
 	return ""
 }
 
-type ListDynamicConfigResponse struct {
-	Entries              []*DynamicConfigEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type GetDomainReplicationWatermarkResponse struct {
+	Cluster                 string           `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	LastReplicatedEventTime *types.Timestamp `protobuf:"bytes,2,opt,name=last_replicated_event_time,json=lastReplicatedEventTime,proto3" json:"last_replicated_event_time,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}         `json:"-"`
+	XXX_unrecognized        []byte           `json:"-"`
+	XXX_sizecache           int32            `json:"-"`
 }
 
-func (m *ListDynamicConfigResponse) Reset()         { *m = ListDynamicConfigResponse{} }
-func (m *ListDynamicConfigResponse) String() string { return proto.CompactTextString(m) }
-func (*ListDynamicConfigResponse) ProtoMessage()    {}
-func (*ListDynamicConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{53}
+func (m *GetDomainReplicationWatermarkResponse) Reset()         { *m = GetDomainReplicationWatermarkResponse{} }
+func (m *GetDomainReplicationWatermarkResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDomainReplicationWatermarkResponse) ProtoMessage()    {}
+func (*GetDomainReplicationWatermarkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{55}
 }
-func (m *ListDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetDomainReplicationWatermarkResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDomainReplicationWatermarkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListDynamicConfigResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDomainReplicationWatermarkResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3100,45 +3248,54 @@ func (m *ListDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ListDynamicConfigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListDynamicConfigResponse.Merge(m, src)
+func (m *GetDomainReplicationWatermarkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDomainReplicationWatermarkResponse.Merge(m, src)
 }
-func (m *ListDynamicConfigResponse) XXX_Size() int {
+func (m *GetDomainReplicationWatermarkResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListDynamicConfigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListDynamicConfigResponse.DiscardUnknown(m)
+func (m *GetDomainReplicationWatermarkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDomainReplicationWatermarkResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListDynamicConfigResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetDomainReplicationWatermarkResponse proto.InternalMessageInfo
 
-func (m *ListDynamicConfigResponse) GetEntries() []*DynamicConfigEntry {
+func (m *GetDomainReplicationWatermarkResponse) GetCluster() string {
 	if m != nil {
-		return m.Entries
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *GetDomainReplicationWatermarkResponse) GetLastReplicatedEventTime() *types.Timestamp {
+	if m != nil {
+		return m.LastReplicatedEventTime
 	}
 	return nil
 }
 
-type DynamicConfigEntry struct {
-	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Values               []*DynamicConfigValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type IssueDomainTokenRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Apis                 []string `protobuf:"bytes,2,rep,name=apis,proto3" json:"apis,omitempty"`
+	TtlSeconds           int64    `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Subject              string   `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DynamicConfigEntry) Reset()         { *m = DynamicConfigEntry{} }
-func (m *DynamicConfigEntry) String() string { return proto.CompactTextString(m) }
-func (*DynamicConfigEntry) ProtoMessage()    {}
-func (*DynamicConfigEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{54}
+func (m *IssueDomainTokenRequest) Reset()         { *m = IssueDomainTokenRequest{} }
+func (m *IssueDomainTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*IssueDomainTokenRequest) ProtoMessage()    {}
+func (*IssueDomainTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{56}
 }
-func (m *DynamicConfigEntry) XXX_Unmarshal(b []byte) error {
+func (m *IssueDomainTokenRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DynamicConfigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IssueDomainTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DynamicConfigEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IssueDomainTokenRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3148,52 +3305,65 @@ func (m *DynamicConfigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DynamicConfigEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DynamicConfigEntry.Merge(m, src)
+func (m *IssueDomainTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueDomainTokenRequest.Merge(m, src)
 }
-func (m *DynamicConfigEntry) XXX_Size() int {
+func (m *IssueDomainTokenRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DynamicConfigEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_DynamicConfigEntry.DiscardUnknown(m)
+func (m *IssueDomainTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueDomainTokenRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DynamicConfigEntry proto.InternalMessageInfo
+var xxx_messageInfo_IssueDomainTokenRequest proto.InternalMessageInfo
 
-func (m *DynamicConfigEntry) GetName() string {
+func (m *IssueDomainTokenRequest) GetDomain() string {
 	if m != nil {
-		return m.Name
+		return m.Domain
 	}
 	return ""
 }
 
-func (m *DynamicConfigEntry) GetValues() []*DynamicConfigValue {
+func (m *IssueDomainTokenRequest) GetApis() []string {
 	if m != nil {
-		return m.Values
+		return m.Apis
 	}
 	return nil
 }
 
-type DynamicConfigValue struct {
-	Value                *v1.DataBlob           `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
-	Filters              []*DynamicConfigFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+func (m *IssueDomainTokenRequest) GetTtlSeconds() int64 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
 }
 
-func (m *DynamicConfigValue) Reset()         { *m = DynamicConfigValue{} }
-func (m *DynamicConfigValue) String() string { return proto.CompactTextString(m) }
-func (*DynamicConfigValue) ProtoMessage()    {}
-func (*DynamicConfigValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{55}
+func (m *IssueDomainTokenRequest) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
 }
-func (m *DynamicConfigValue) XXX_Unmarshal(b []byte) error {
+
+type IssueDomainTokenResponse struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssueDomainTokenResponse) Reset()         { *m = IssueDomainTokenResponse{} }
+func (m *IssueDomainTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*IssueDomainTokenResponse) ProtoMessage()    {}
+func (*IssueDomainTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{57}
+}
+func (m *IssueDomainTokenResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DynamicConfigValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IssueDomainTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DynamicConfigValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IssueDomainTokenResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3203,52 +3373,46 @@ func (m *DynamicConfigValue) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DynamicConfigValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DynamicConfigValue.Merge(m, src)
+func (m *IssueDomainTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueDomainTokenResponse.Merge(m, src)
 }
-func (m *DynamicConfigValue) XXX_Size() int {
+func (m *IssueDomainTokenResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DynamicConfigValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_DynamicConfigValue.DiscardUnknown(m)
+func (m *IssueDomainTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueDomainTokenResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DynamicConfigValue proto.InternalMessageInfo
-
-func (m *DynamicConfigValue) GetValue() *v1.DataBlob {
-	if m != nil {
-		return m.Value
-	}
-	return nil
-}
+var xxx_messageInfo_IssueDomainTokenResponse proto.InternalMessageInfo
 
-func (m *DynamicConfigValue) GetFilters() []*DynamicConfigFilter {
+func (m *IssueDomainTokenResponse) GetToken() string {
 	if m != nil {
-		return m.Filters
+		return m.Token
 	}
-	return nil
+	return ""
 }
 
-type DynamicConfigFilter struct {
-	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value                *v1.DataBlob `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type StealTaskListLeaseRequest struct {
+	Domain               string          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *DynamicConfigFilter) Reset()         { *m = DynamicConfigFilter{} }
-func (m *DynamicConfigFilter) String() string { return proto.CompactTextString(m) }
-func (*DynamicConfigFilter) ProtoMessage()    {}
-func (*DynamicConfigFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6fc96d64a8b67fd, []int{56}
+func (m *StealTaskListLeaseRequest) Reset()         { *m = StealTaskListLeaseRequest{} }
+func (m *StealTaskListLeaseRequest) String() string { return proto.CompactTextString(m) }
+func (*StealTaskListLeaseRequest) ProtoMessage()    {}
+func (*StealTaskListLeaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{58}
 }
-func (m *DynamicConfigFilter) XXX_Unmarshal(b []byte) error {
+func (m *StealTaskListLeaseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DynamicConfigFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StealTaskListLeaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DynamicConfigFilter.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StealTaskListLeaseRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3258,1591 +3422,1810 @@ func (m *DynamicConfigFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *DynamicConfigFilter) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DynamicConfigFilter.Merge(m, src)
+func (m *StealTaskListLeaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StealTaskListLeaseRequest.Merge(m, src)
 }
-func (m *DynamicConfigFilter) XXX_Size() int {
+func (m *StealTaskListLeaseRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *DynamicConfigFilter) XXX_DiscardUnknown() {
-	xxx_messageInfo_DynamicConfigFilter.DiscardUnknown(m)
+func (m *StealTaskListLeaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StealTaskListLeaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DynamicConfigFilter proto.InternalMessageInfo
+var xxx_messageInfo_StealTaskListLeaseRequest proto.InternalMessageInfo
 
-func (m *DynamicConfigFilter) GetName() string {
+func (m *StealTaskListLeaseRequest) GetDomain() string {
 	if m != nil {
-		return m.Name
+		return m.Domain
 	}
 	return ""
 }
 
-func (m *DynamicConfigFilter) GetValue() *v1.DataBlob {
+func (m *StealTaskListLeaseRequest) GetTaskList() *v1.TaskList {
 	if m != nil {
-		return m.Value
+		return m.TaskList
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.admin.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.admin.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "uber.cadence.admin.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeShardDistributionRequest)(nil), "uber.cadence.admin.v1.DescribeShardDistributionRequest")
-	proto.RegisterType((*DescribeShardDistributionResponse)(nil), "uber.cadence.admin.v1.DescribeShardDistributionResponse")
-	proto.RegisterMapType((map[int32]string)(nil), "uber.cadence.admin.v1.DescribeShardDistributionResponse.ShardsEntry")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "uber.cadence.admin.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "uber.cadence.admin.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "uber.cadence.admin.v1.CloseShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "uber.cadence.admin.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "uber.cadence.admin.v1.RemoveTaskResponse")
-	proto.RegisterType((*ResetQueueRequest)(nil), "uber.cadence.admin.v1.ResetQueueRequest")
-	proto.RegisterType((*ResetQueueResponse)(nil), "uber.cadence.admin.v1.ResetQueueResponse")
-	proto.RegisterType((*DescribeQueueRequest)(nil), "uber.cadence.admin.v1.DescribeQueueRequest")
-	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.admin.v1.DescribeQueueResponse")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "uber.cadence.admin.v1.GetWorkflowExecutionRawHistoryV2Request")
-	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "uber.cadence.admin.v1.GetWorkflowExecutionRawHistoryV2Response")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.admin.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.admin.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v11.ReplicationMessages)(nil), "uber.cadence.admin.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "uber.cadence.admin.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "uber.cadence.admin.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*GetDomainReplicationMessagesRequest)(nil), "uber.cadence.admin.v1.GetDomainReplicationMessagesRequest")
-	proto.RegisterType((*GetDomainReplicationMessagesResponse)(nil), "uber.cadence.admin.v1.GetDomainReplicationMessagesResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "uber.cadence.admin.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.admin.v1.ReapplyEventsResponse")
-	proto.RegisterType((*AddSearchAttributeRequest)(nil), "uber.cadence.admin.v1.AddSearchAttributeRequest")
-	proto.RegisterMapType((map[string]v1.IndexedValueType)(nil), "uber.cadence.admin.v1.AddSearchAttributeRequest.SearchAttributeEntry")
-	proto.RegisterType((*AddSearchAttributeResponse)(nil), "uber.cadence.admin.v1.AddSearchAttributeResponse")
-	proto.RegisterType((*DescribeClusterRequest)(nil), "uber.cadence.admin.v1.DescribeClusterRequest")
-	proto.RegisterType((*DescribeClusterResponse)(nil), "uber.cadence.admin.v1.DescribeClusterResponse")
-	proto.RegisterMapType((map[string]*v11.PersistenceInfo)(nil), "uber.cadence.admin.v1.DescribeClusterResponse.PersistenceInfoEntry")
-	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.admin.v1.ReadDLQMessagesRequest")
-	proto.RegisterType((*ReadDLQMessagesResponse)(nil), "uber.cadence.admin.v1.ReadDLQMessagesResponse")
-	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "uber.cadence.admin.v1.PurgeDLQMessagesRequest")
-	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "uber.cadence.admin.v1.PurgeDLQMessagesResponse")
-	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "uber.cadence.admin.v1.MergeDLQMessagesRequest")
-	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "uber.cadence.admin.v1.MergeDLQMessagesResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.admin.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.admin.v1.RefreshWorkflowTasksResponse")
-	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "uber.cadence.admin.v1.ResendReplicationTasksRequest")
-	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "uber.cadence.admin.v1.ResendReplicationTasksResponse")
-	proto.RegisterType((*GetCrossClusterTasksRequest)(nil), "uber.cadence.admin.v1.GetCrossClusterTasksRequest")
-	proto.RegisterType((*GetCrossClusterTasksResponse)(nil), "uber.cadence.admin.v1.GetCrossClusterTasksResponse")
-	proto.RegisterMapType((map[int32]v11.GetTaskFailedCause)(nil), "uber.cadence.admin.v1.GetCrossClusterTasksResponse.FailedCauseByShardEntry")
-	proto.RegisterMapType((map[int32]*v11.CrossClusterTaskRequests)(nil), "uber.cadence.admin.v1.GetCrossClusterTasksResponse.TasksByShardEntry")
-	proto.RegisterType((*RespondCrossClusterTasksCompletedRequest)(nil), "uber.cadence.admin.v1.RespondCrossClusterTasksCompletedRequest")
-	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.admin.v1.RespondCrossClusterTasksCompletedResponse")
-	proto.RegisterType((*GetDynamicConfigRequest)(nil), "uber.cadence.admin.v1.GetDynamicConfigRequest")
-	proto.RegisterType((*GetDynamicConfigResponse)(nil), "uber.cadence.admin.v1.GetDynamicConfigResponse")
-	proto.RegisterType((*UpdateDynamicConfigRequest)(nil), "uber.cadence.admin.v1.UpdateDynamicConfigRequest")
-	proto.RegisterType((*UpdateDynamicConfigResponse)(nil), "uber.cadence.admin.v1.UpdateDynamicConfigResponse")
-	proto.RegisterType((*RestoreDynamicConfigRequest)(nil), "uber.cadence.admin.v1.RestoreDynamicConfigRequest")
-	proto.RegisterType((*RestoreDynamicConfigResponse)(nil), "uber.cadence.admin.v1.RestoreDynamicConfigResponse")
-	proto.RegisterType((*AdminDeleteWorkflowRequest)(nil), "uber.cadence.admin.v1.AdminDeleteWorkflowRequest")
-	proto.RegisterType((*AdminDeleteWorkflowResponse)(nil), "uber.cadence.admin.v1.AdminDeleteWorkflowResponse")
-	proto.RegisterType((*AdminMaintainWorkflowRequest)(nil), "uber.cadence.admin.v1.AdminMaintainWorkflowRequest")
-	proto.RegisterType((*AdminMaintainWorkflowResponse)(nil), "uber.cadence.admin.v1.AdminMaintainWorkflowResponse")
-	proto.RegisterType((*ListDynamicConfigRequest)(nil), "uber.cadence.admin.v1.ListDynamicConfigRequest")
-	proto.RegisterType((*ListDynamicConfigResponse)(nil), "uber.cadence.admin.v1.ListDynamicConfigResponse")
-	proto.RegisterType((*DynamicConfigEntry)(nil), "uber.cadence.admin.v1.DynamicConfigEntry")
-	proto.RegisterType((*DynamicConfigValue)(nil), "uber.cadence.admin.v1.DynamicConfigValue")
-	proto.RegisterType((*DynamicConfigFilter)(nil), "uber.cadence.admin.v1.DynamicConfigFilter")
+func (m *StealTaskListLeaseRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
 }
 
-func init() {
-	proto.RegisterFile("uber/cadence/admin/v1/service.proto", fileDescriptor_c6fc96d64a8b67fd)
+type StealTaskListLeaseResponse struct {
+	RangeId              int64    `protobuf:"varint,1,opt,name=range_id,json=rangeId,proto3" json:"range_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-var fileDescriptor_c6fc96d64a8b67fd = []byte{
-	// 2864 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xc9, 0x6f, 0x1c, 0xc7,
-	0xd5, 0x77, 0xcf, 0x90, 0x14, 0xf9, 0x46, 0xa4, 0xc4, 0x12, 0xc5, 0xa5, 0x29, 0xd1, 0x54, 0xdb,
-	0xb2, 0xe8, 0x6d, 0x68, 0x0d, 0x6d, 0x7f, 0xb6, 0x05, 0x7f, 0x36, 0x35, 0x94, 0x28, 0xda, 0x92,
-	0x25, 0xb5, 0x64, 0x39, 0x08, 0x82, 0x74, 0x7a, 0xa6, 0x8b, 0x64, 0x87, 0x33, 0xdd, 0xad, 0xae,
-	0x9a, 0x91, 0x69, 0x18, 0x89, 0x11, 0x38, 0xa7, 0xec, 0xc8, 0x21, 0xc7, 0x1c, 0x12, 0xf8, 0x90,
-	0x1c, 0x82, 0xdc, 0x73, 0x0e, 0x72, 0x74, 0xfe, 0x83, 0x40, 0x07, 0x5f, 0x1c, 0x04, 0x08, 0x72,
-	0xc9, 0x31, 0xa8, 0xa5, 0xa7, 0xd7, 0x9a, 0x85, 0x51, 0x20, 0xc3, 0x37, 0x76, 0xd5, 0xdb, 0xea,
-	0x57, 0xaf, 0xde, 0x7b, 0xf5, 0x6a, 0x08, 0x4f, 0x75, 0x1a, 0x38, 0x5c, 0x6f, 0xda, 0x0e, 0xf6,
-	0x9a, 0x78, 0xdd, 0x76, 0xda, 0xae, 0xb7, 0xde, 0xbd, 0xb8, 0x4e, 0x70, 0xd8, 0x75, 0x9b, 0xb8,
-	0x1a, 0x84, 0x3e, 0xf5, 0xd1, 0x69, 0x46, 0x54, 0x95, 0x44, 0x55, 0x4e, 0x54, 0xed, 0x5e, 0xd4,
-	0x9f, 0xdc, 0xf3, 0xfd, 0xbd, 0x16, 0x5e, 0xe7, 0x44, 0x8d, 0xce, 0xee, 0x3a, 0x75, 0xdb, 0x98,
-	0x50, 0xbb, 0x1d, 0x08, 0x3e, 0x7d, 0x25, 0x4b, 0xf0, 0x20, 0xb4, 0x83, 0x00, 0x87, 0x44, 0xce,
-	0xaf, 0xa6, 0x95, 0x07, 0x2e, 0x53, 0xdd, 0xf4, 0xdb, 0x6d, 0xdf, 0x93, 0x14, 0x4f, 0x17, 0x51,
-	0x74, 0x5d, 0xe2, 0x36, 0xdc, 0x96, 0x4b, 0x0f, 0x0b, 0xa9, 0xc8, 0xbe, 0x1d, 0x62, 0x87, 0x8b,
-	0x6a, 0x75, 0x08, 0xc5, 0xe1, 0x00, 0xaa, 0x7d, 0x97, 0x50, 0x3f, 0x8c, 0x64, 0x19, 0x0a, 0xaa,
-	0xfb, 0x1d, 0xdc, 0x91, 0x78, 0xe8, 0x6b, 0x0a, 0x9a, 0x10, 0x07, 0x2d, 0xb7, 0x69, 0x53, 0x37,
-	0xb2, 0xdf, 0xf8, 0x85, 0x06, 0xab, 0x5b, 0x98, 0x34, 0x43, 0xb7, 0x81, 0x3f, 0xf0, 0xc3, 0x83,
-	0xdd, 0x96, 0xff, 0xe0, 0xca, 0x87, 0xb8, 0xd9, 0x61, 0x34, 0x26, 0xbe, 0xdf, 0xc1, 0x84, 0xa2,
-	0x79, 0x98, 0x70, 0xfc, 0xb6, 0xed, 0x7a, 0x8b, 0xda, 0xaa, 0xb6, 0x36, 0x65, 0xca, 0x2f, 0xf4,
-	0x3e, 0xa0, 0x07, 0x92, 0xc7, 0xc2, 0x11, 0xd3, 0x62, 0x69, 0x55, 0x5b, 0xab, 0xd4, 0x9e, 0xa9,
-	0xa6, 0xf7, 0x24, 0x70, 0xab, 0xdd, 0x8b, 0xd5, 0xbc, 0x8a, 0xd9, 0x07, 0xd9, 0x21, 0xe3, 0xaf,
-	0x1a, 0x9c, 0xeb, 0x63, 0x13, 0x09, 0x7c, 0x8f, 0x60, 0xb4, 0x04, 0x93, 0x6c, 0x61, 0x8e, 0xe5,
-	0x3a, 0xdc, 0xac, 0x71, 0xf3, 0x18, 0xff, 0xde, 0x71, 0xd0, 0x39, 0x38, 0x2e, 0x31, 0xb3, 0x6c,
-	0xc7, 0x09, 0xb9, 0x45, 0x53, 0x66, 0x45, 0x8e, 0x6d, 0x3a, 0x4e, 0x88, 0x36, 0x60, 0xbe, 0xdd,
-	0xa1, 0x76, 0xa3, 0x85, 0x2d, 0x42, 0x6d, 0x8a, 0x2d, 0xd7, 0xb3, 0x9a, 0x76, 0x73, 0x1f, 0x2f,
-	0x96, 0x39, 0xf1, 0x29, 0x39, 0x7b, 0x87, 0x4d, 0xee, 0x78, 0x75, 0x36, 0x85, 0x5e, 0x87, 0xa5,
-	0x1c, 0x93, 0x63, 0x53, 0xbb, 0x61, 0x13, 0xbc, 0x38, 0xc6, 0xf9, 0xe6, 0xd3, 0x7c, 0x5b, 0x72,
-	0xd6, 0xf8, 0xb3, 0x06, 0x7a, 0xb4, 0xa6, 0x6b, 0xc2, 0x8e, 0x6b, 0x3e, 0xa1, 0x11, 0xc2, 0x4f,
-	0xc1, 0xf1, 0x7d, 0x9f, 0x50, 0x6e, 0x2e, 0x26, 0x44, 0xe0, 0x7c, 0xed, 0x09, 0xb3, 0xc2, 0x46,
-	0x37, 0xc5, 0x20, 0x5a, 0x4e, 0xac, 0x98, 0x2d, 0x69, 0xfc, 0xda, 0x13, 0xf1, 0x9a, 0x3f, 0x28,
-	0xdc, 0x8b, 0xf2, 0x28, 0x7b, 0x71, 0xed, 0x89, 0x82, 0xdd, 0xb8, 0x3c, 0x0d, 0x15, 0x47, 0x1a,
-	0x6e, 0x35, 0x0e, 0x8d, 0x6f, 0xc4, 0xfe, 0x72, 0x87, 0xa9, 0xde, 0x72, 0x09, 0x0d, 0xdd, 0x46,
-	0xca, 0x5f, 0x96, 0x61, 0x2a, 0xb0, 0xf7, 0xb0, 0x45, 0xdc, 0x8f, 0xb0, 0xdc, 0x9b, 0x49, 0x36,
-	0x70, 0xc7, 0xfd, 0x08, 0xa3, 0x05, 0x38, 0xc6, 0x27, 0xa3, 0x45, 0x98, 0x13, 0xec, 0x73, 0xc7,
-	0x31, 0xbe, 0x48, 0x6c, 0x7b, 0x81, 0x68, 0xb9, 0xed, 0x6b, 0x70, 0xd2, 0xeb, 0xb4, 0x1b, 0x38,
-	0xb4, 0xfc, 0x5d, 0x8b, 0x2f, 0x9e, 0x48, 0x15, 0x33, 0x62, 0xfc, 0xe6, 0x2e, 0x67, 0x26, 0xe8,
-	0x5b, 0x30, 0x21, 0xe7, 0x4b, 0xab, 0xe5, 0xb5, 0x4a, 0x6d, 0xab, 0x5a, 0x18, 0x25, 0xaa, 0x03,
-	0x75, 0x56, 0x85, 0xc0, 0x2b, 0x1e, 0x0d, 0x0f, 0x4d, 0x29, 0x53, 0x7f, 0x1d, 0x2a, 0x89, 0x61,
-	0x74, 0x12, 0xca, 0x07, 0xf8, 0x50, 0x5a, 0xc2, 0xfe, 0x44, 0x73, 0x30, 0xde, 0xb5, 0x5b, 0x1d,
-	0x2c, 0xbd, 0x4f, 0x7c, 0xbc, 0x51, 0x7a, 0x4d, 0x33, 0x7e, 0x50, 0x82, 0xe5, 0x42, 0x5f, 0x18,
-	0x79, 0x89, 0xcb, 0x30, 0x15, 0x79, 0x84, 0x58, 0xe5, 0xb8, 0x39, 0x29, 0x1d, 0x82, 0xa0, 0x77,
-	0xe0, 0xb8, 0x38, 0xa7, 0x09, 0xc7, 0xae, 0xd4, 0x2e, 0xa4, 0x51, 0x10, 0xb1, 0x81, 0xc3, 0xc0,
-	0x69, 0xb9, 0xa3, 0xef, 0x78, 0xbb, 0xbe, 0x59, 0x71, 0xe2, 0x01, 0xf4, 0x2a, 0x2c, 0x08, 0x45,
-	0x4d, 0xdf, 0xa3, 0xa1, 0xdf, 0x6a, 0xe1, 0x90, 0x1f, 0x81, 0x0e, 0x91, 0x7e, 0x7f, 0x9a, 0x4f,
-	0xd7, 0x7b, 0xb3, 0x77, 0xf8, 0x24, 0x5a, 0x84, 0x63, 0x91, 0x4b, 0x8f, 0x73, 0xba, 0xe8, 0xd3,
-	0xa8, 0xc2, 0x6c, 0xbd, 0xe5, 0x13, 0x81, 0x7a, 0xe4, 0x38, 0xea, 0x33, 0x6d, 0xcc, 0x01, 0x4a,
-	0xd2, 0x0b, 0xa8, 0x8c, 0x7f, 0x68, 0x30, 0x6b, 0xe2, 0xb6, 0xdf, 0xc5, 0x77, 0x6d, 0x72, 0x30,
-	0x58, 0x0c, 0x7a, 0x13, 0xa6, 0xa8, 0x4d, 0x0e, 0x2c, 0x7a, 0x18, 0x88, 0x9d, 0x99, 0xa9, 0xad,
-	0xaa, 0x10, 0x61, 0x22, 0xef, 0x1e, 0x06, 0xd8, 0x9c, 0xa4, 0xf2, 0x2f, 0xe6, 0xbc, 0x9c, 0xdd,
-	0x75, 0x38, 0x9c, 0x65, 0x73, 0x82, 0x7d, 0xee, 0x38, 0xa8, 0x0e, 0x27, 0xe2, 0xa8, 0x6f, 0xb1,
-	0x3c, 0xc3, 0x81, 0xa9, 0xd4, 0xf4, 0xaa, 0xc8, 0x31, 0xd5, 0x28, 0xc7, 0x54, 0xef, 0x46, 0x49,
-	0xc8, 0x9c, 0x89, 0x59, 0xd8, 0x20, 0x8b, 0x5b, 0x32, 0x23, 0x58, 0x9e, 0xdd, 0xc6, 0x12, 0xb2,
-	0x8a, 0x1c, 0x7b, 0xcf, 0x6e, 0x63, 0x06, 0x43, 0x72, 0xbd, 0x12, 0x86, 0x9f, 0x73, 0x18, 0x08,
-	0xa6, 0xb7, 0x59, 0x12, 0x18, 0x02, 0x86, 0xac, 0xa6, 0x52, 0x4e, 0x53, 0x1a, 0xa9, 0xf2, 0xa8,
-	0x48, 0x09, 0x43, 0x63, 0x8b, 0xa4, 0xa1, 0xbf, 0xd4, 0x60, 0x2e, 0x72, 0xfd, 0xaf, 0x8e, 0xad,
-	0x37, 0xe1, 0x74, 0xc6, 0x28, 0x79, 0x12, 0x5f, 0x85, 0x85, 0x20, 0xf4, 0x9b, 0x98, 0x10, 0xd7,
-	0xdb, 0xb3, 0x78, 0x86, 0x15, 0x91, 0x9f, 0x1d, 0xc8, 0x32, 0x73, 0xfb, 0x78, 0x9a, 0x73, 0xf2,
-	0xb0, 0x4f, 0x8c, 0x7f, 0x95, 0xe0, 0xc2, 0x36, 0xa6, 0xf9, 0xe4, 0x65, 0x3f, 0x90, 0x07, 0xfe,
-	0x5e, 0xed, 0xf1, 0x24, 0x57, 0xf4, 0x2e, 0x54, 0x08, 0xb5, 0x43, 0x6a, 0xe1, 0x2e, 0xf6, 0xa8,
-	0x0c, 0x0a, 0xcf, 0xa9, 0xc0, 0xba, 0x87, 0x43, 0xc2, 0x32, 0x83, 0x30, 0x7a, 0x87, 0xe2, 0xb6,
-	0x09, 0x9c, 0xfd, 0x0a, 0xe3, 0x46, 0xdb, 0x30, 0x85, 0x3d, 0x47, 0x8a, 0x1a, 0x1b, 0x59, 0xd4,
-	0x24, 0xf6, 0x1c, 0x21, 0x28, 0x95, 0x31, 0xc6, 0x33, 0x19, 0xe3, 0x19, 0x38, 0xe1, 0xe1, 0x0f,
-	0xa9, 0xc5, 0x29, 0xa8, 0x7f, 0x80, 0xbd, 0xc5, 0x89, 0x55, 0x6d, 0xed, 0xb8, 0x39, 0xcd, 0x86,
-	0x6f, 0xd9, 0x7b, 0xf8, 0x2e, 0x1b, 0x34, 0xbe, 0xd4, 0x60, 0x6d, 0x30, 0xea, 0x72, 0x6b, 0x0b,
-	0x84, 0x6a, 0x05, 0x42, 0xd1, 0x55, 0x38, 0x11, 0xd5, 0x12, 0x0d, 0x9b, 0x36, 0xf7, 0x71, 0x94,
-	0x4e, 0xce, 0x16, 0xee, 0x01, 0x4b, 0xf8, 0x97, 0x5b, 0x7e, 0xc3, 0x9c, 0x91, 0x5c, 0x97, 0x05,
-	0x13, 0xba, 0x09, 0x27, 0xba, 0x02, 0x01, 0x4b, 0xce, 0x14, 0x27, 0x67, 0x15, 0x60, 0xe6, 0x4c,
-	0x37, 0xf5, 0x6d, 0x7c, 0xaa, 0xc1, 0xd9, 0x6d, 0x4c, 0xcd, 0xb8, 0xa4, 0xbb, 0x81, 0x09, 0xb1,
-	0xf7, 0x30, 0x89, 0x3c, 0xeb, 0x6d, 0x98, 0xe0, 0x0b, 0x13, 0xce, 0x5a, 0xa9, 0xad, 0xa9, 0x34,
-	0x25, 0x64, 0xf0, 0x45, 0x9b, 0x92, 0x6f, 0x88, 0xa3, 0x67, 0x7c, 0x52, 0x82, 0x15, 0x95, 0x19,
-	0x12, 0x6a, 0x1f, 0x66, 0xc4, 0xd9, 0x6e, 0xcb, 0x19, 0x69, 0xcf, 0x35, 0x45, 0x42, 0xee, 0x2f,
-	0x4e, 0x64, 0xe3, 0x68, 0x54, 0x24, 0xe5, 0x69, 0x92, 0x1c, 0xd3, 0xdb, 0x80, 0xf2, 0x44, 0x05,
-	0x29, 0x7a, 0x33, 0x99, 0xa2, 0x2b, 0xb5, 0xe7, 0x87, 0xc0, 0xa7, 0x67, 0x4d, 0x22, 0x9f, 0x7b,
-	0xb0, 0xba, 0x8d, 0xe9, 0xd6, 0xf5, 0xdb, 0x7d, 0xf6, 0xe2, 0x1d, 0x00, 0x91, 0x38, 0xbc, 0x5d,
-	0x3f, 0x5a, 0xff, 0x30, 0xfa, 0x58, 0xb4, 0xe2, 0xe9, 0x98, 0x07, 0x38, 0xf6, 0x17, 0x31, 0x0e,
-	0xe1, 0x5c, 0x1f, 0x7d, 0x12, 0xf4, 0xbb, 0x30, 0x9b, 0xa8, 0xf6, 0x2d, 0xc6, 0x1d, 0xe9, 0xbd,
-	0x30, 0xa4, 0x5e, 0xf3, 0x64, 0x98, 0x1e, 0x20, 0xc6, 0xbf, 0x35, 0x78, 0x8a, 0xe9, 0xe6, 0x21,
-	0xaa, 0xcf, 0x72, 0xef, 0xc1, 0x52, 0xcb, 0x26, 0xd4, 0x0a, 0x31, 0x0d, 0x5d, 0xdc, 0xc5, 0xbd,
-	0xbd, 0x8f, 0xe2, 0x7b, 0xa5, 0xb6, 0x9c, 0x4b, 0x8c, 0x3b, 0x1e, 0x7d, 0xf5, 0xe5, 0x7b, 0x0c,
-	0x56, 0x73, 0x9e, 0x71, 0x9b, 0x11, 0xb3, 0x94, 0xbe, 0xe3, 0xf4, 0xe4, 0xca, 0xb0, 0x9b, 0x96,
-	0x5b, 0x1a, 0x52, 0xee, 0xad, 0x88, 0x39, 0x96, 0x9b, 0x75, 0xf4, 0x72, 0xde, 0xd1, 0x7d, 0x78,
-	0xba, 0xff, 0xca, 0x25, 0xf0, 0xdb, 0x30, 0x99, 0xf0, 0xf3, 0x91, 0xfd, 0xaa, 0xc7, 0x6c, 0xfc,
-	0x49, 0x83, 0x39, 0x13, 0xdb, 0x41, 0xd0, 0x3a, 0xe4, 0x41, 0x92, 0x3c, 0xa6, 0x8c, 0xf1, 0x0a,
-	0x4c, 0xf0, 0x00, 0x4f, 0x64, 0xc0, 0x1a, 0x10, 0xf8, 0x24, 0xb1, 0xb1, 0x00, 0xa7, 0x33, 0xd6,
-	0xcb, 0x1a, 0xe0, 0xd7, 0x25, 0x58, 0xda, 0x74, 0x9c, 0x3b, 0xd8, 0x0e, 0x9b, 0xfb, 0x9b, 0x54,
-	0x94, 0xdb, 0xbd, 0x42, 0x20, 0x80, 0x93, 0x84, 0xcf, 0x58, 0x76, 0x34, 0x25, 0xdd, 0xf6, 0x8a,
-	0x22, 0x5c, 0x28, 0x65, 0x55, 0x33, 0xc3, 0x22, 0x56, 0x9c, 0x20, 0xe9, 0x51, 0x74, 0x1e, 0x66,
-	0x08, 0x6e, 0x76, 0x42, 0x5e, 0xb8, 0xf1, 0x44, 0x20, 0xc2, 0xdc, 0x74, 0x34, 0xca, 0x63, 0xa2,
-	0xee, 0xc2, 0x5c, 0x91, 0xbc, 0x64, 0x58, 0x99, 0x12, 0x61, 0xe5, 0x52, 0x32, 0xac, 0xcc, 0xd4,
-	0xce, 0x17, 0xe2, 0xb5, 0xe3, 0x39, 0xf8, 0x43, 0xec, 0x70, 0xb7, 0xe4, 0xe5, 0x48, 0x22, 0xa0,
-	0x9c, 0x01, 0xbd, 0x68, 0x51, 0x12, 0xbf, 0x45, 0x98, 0x8f, 0xaa, 0x95, 0xba, 0xf0, 0x4f, 0xb9,
-	0x5e, 0xe3, 0x8f, 0x65, 0x58, 0xc8, 0x4d, 0x49, 0xb7, 0xdc, 0x87, 0x25, 0xd2, 0x09, 0x02, 0x3f,
-	0xa4, 0xd8, 0xb1, 0x9a, 0x2d, 0x17, 0x7b, 0xd4, 0x92, 0x19, 0x25, 0xf2, 0xd3, 0x17, 0x0a, 0x0d,
-	0xbd, 0x13, 0x71, 0xd5, 0x39, 0x93, 0xcc, 0x4a, 0xc4, 0x5c, 0x20, 0xc5, 0x13, 0x2c, 0xd3, 0xb5,
-	0x31, 0xbb, 0xa6, 0x90, 0x7d, 0x37, 0xe0, 0x01, 0xaf, 0xd8, 0x07, 0xe3, 0x73, 0x70, 0xa3, 0x47,
-	0xce, 0x43, 0xdd, 0x4c, 0x3b, 0xf5, 0x8d, 0x3c, 0x38, 0x19, 0x30, 0xe1, 0x84, 0x32, 0x3e, 0x21,
-	0xb1, 0xcc, 0x5d, 0xa2, 0x3e, 0xe0, 0x4a, 0x97, 0x01, 0xa1, 0x7a, 0x2b, 0x16, 0xc3, 0x24, 0x4b,
-	0x87, 0x08, 0xd2, 0xa3, 0xfa, 0x01, 0xcc, 0x15, 0x11, 0x16, 0xec, 0xf4, 0x9b, 0xe9, 0x04, 0xa2,
-	0x0c, 0xac, 0x19, 0x71, 0xc9, 0xbd, 0xfe, 0x5d, 0x09, 0xe6, 0x4d, 0x6c, 0x3b, 0x5b, 0xd7, 0x6f,
-	0x67, 0x83, 0xe8, 0x06, 0x8c, 0xf1, 0x82, 0x56, 0xe3, 0x6e, 0xf4, 0xa4, 0xf2, 0xe2, 0x76, 0xfd,
-	0x36, 0x77, 0x20, 0x4e, 0x9c, 0x2a, 0xa4, 0x4b, 0xe9, 0x42, 0x9a, 0x39, 0xba, 0xdf, 0x09, 0x9b,
-	0xd8, 0x92, 0x71, 0x4d, 0x86, 0xb9, 0x69, 0x31, 0x2a, 0xc1, 0x42, 0x77, 0x61, 0xd1, 0xf5, 0x18,
-	0x85, 0xdb, 0xc5, 0x16, 0x2b, 0xef, 0x12, 0x21, 0x76, 0x6c, 0x70, 0x88, 0x3d, 0xdd, 0x63, 0xbe,
-	0xe2, 0x25, 0x22, 0xec, 0x23, 0xa9, 0xf0, 0xfe, 0x50, 0x82, 0x85, 0x1c, 0x58, 0xd2, 0xc1, 0x8f,
-	0x84, 0x56, 0x61, 0x96, 0x2c, 0xfd, 0x97, 0x59, 0x12, 0xd9, 0x30, 0x9f, 0x93, 0x9a, 0x74, 0xdb,
-	0x91, 0x12, 0xff, 0x5c, 0x56, 0x3c, 0x3f, 0x13, 0x05, 0x88, 0x8d, 0x15, 0x21, 0xf6, 0x85, 0x06,
-	0x0b, 0xb7, 0x3a, 0xe1, 0x1e, 0xfe, 0x9a, 0xfb, 0x97, 0xa1, 0xc3, 0x62, 0x7e, 0x9d, 0x32, 0x62,
-	0xfe, 0xbe, 0x04, 0x0b, 0x37, 0xf0, 0xd7, 0x1f, 0x84, 0x47, 0x73, 0xc8, 0x2e, 0xc3, 0x62, 0x1e,
-	0xac, 0xd1, 0x6e, 0x4d, 0xc6, 0x8f, 0x35, 0x58, 0x36, 0xf1, 0x6e, 0x88, 0xc9, 0x7e, 0x54, 0x63,
-	0x70, 0xdf, 0x7d, 0x4c, 0x1d, 0xe5, 0x15, 0x38, 0x53, 0x6c, 0x8d, 0x74, 0x90, 0xcf, 0x4b, 0x70,
-	0xd6, 0xc4, 0x04, 0x7b, 0x4e, 0xe6, 0x04, 0x92, 0x44, 0x4b, 0x53, 0x36, 0xd3, 0x64, 0x01, 0x3b,
-	0x65, 0x4e, 0x8a, 0x81, 0x1d, 0xe7, 0x7f, 0x55, 0x78, 0x9d, 0x87, 0x99, 0x10, 0xb7, 0x7d, 0x9a,
-	0x73, 0x25, 0x31, 0x1a, 0xb9, 0x52, 0xe6, 0x46, 0x3f, 0xf6, 0xe8, 0x6e, 0xf4, 0xe3, 0x47, 0xbf,
-	0xd1, 0x1b, 0xab, 0xb0, 0xa2, 0x42, 0x54, 0x82, 0x6e, 0xc3, 0xf2, 0x36, 0xa6, 0xf5, 0xd0, 0x27,
-	0x44, 0x2e, 0x25, 0x8b, 0x78, 0xdc, 0xdb, 0xd4, 0x32, 0xbd, 0xcd, 0xf3, 0x30, 0x43, 0xed, 0x70,
-	0x0f, 0xd3, 0x1e, 0x34, 0xb2, 0x66, 0x13, 0xa3, 0x52, 0x9e, 0xf1, 0xcf, 0x32, 0x9c, 0x29, 0xd6,
-	0x21, 0xfd, 0xf9, 0x80, 0xc9, 0x61, 0xd1, 0xb9, 0x71, 0x28, 0x3a, 0xad, 0x03, 0x6a, 0xcd, 0x7e,
-	0xc2, 0x78, 0x67, 0x89, 0x5c, 0x3e, 0xe4, 0x57, 0x4f, 0x51, 0x5a, 0x1c, 0xa7, 0x89, 0x21, 0xf4,
-	0x3d, 0x38, 0xbd, 0x6b, 0xbb, 0x2d, 0x56, 0x7f, 0xd9, 0x1d, 0x82, 0x63, 0x9d, 0x22, 0xe1, 0xbc,
-	0x7b, 0x14, 0x9d, 0x57, 0xb9, 0xc0, 0x3a, 0x93, 0x97, 0xd2, 0x8c, 0x76, 0x73, 0x13, 0xfa, 0x7d,
-	0x98, 0xcd, 0x99, 0x58, 0x70, 0x2b, 0xbe, 0x9a, 0x2e, 0x6a, 0x5e, 0x52, 0x6d, 0x7f, 0xd6, 0x28,
-	0xb9, 0x71, 0xc9, 0xab, 0xb1, 0x7e, 0x1f, 0x16, 0x14, 0x16, 0x16, 0x28, 0x7e, 0x3b, 0x5d, 0x37,
-	0x2b, 0xfd, 0x6e, 0x1b, 0x53, 0xa6, 0x2f, 0x21, 0x38, 0x59, 0x50, 0x7d, 0xa9, 0xc1, 0x9a, 0x80,
-	0xc7, 0xc9, 0xc1, 0x56, 0xf7, 0xdb, 0x41, 0x0b, 0x53, 0x3c, 0x44, 0xc3, 0x79, 0x48, 0x17, 0x43,
-	0x1f, 0x08, 0x0f, 0xb2, 0x42, 0xb9, 0x23, 0x44, 0xe6, 0xf8, 0x11, 0x60, 0x13, 0x8c, 0x4c, 0x70,
-	0xfc, 0x45, 0xd0, 0xd3, 0x30, 0xbd, 0x8b, 0x69, 0x73, 0xff, 0x3d, 0x2c, 0x82, 0x15, 0x3f, 0xd8,
-	0x93, 0x66, 0x7a, 0xd0, 0x20, 0xf0, 0xec, 0x10, 0x8b, 0x95, 0xde, 0x7e, 0x15, 0xc6, 0xa3, 0x3e,
-	0xc0, 0x11, 0x77, 0x96, 0xb3, 0x1b, 0x9f, 0x68, 0xb0, 0xc0, 0xee, 0xc2, 0x87, 0x9e, 0xdd, 0x76,
-	0x9b, 0x75, 0xdf, 0xdb, 0x75, 0xf7, 0x22, 0x44, 0x9f, 0x84, 0x4a, 0x93, 0x0f, 0x88, 0x8b, 0xb4,
-	0x08, 0x95, 0x20, 0x86, 0x78, 0xaf, 0x76, 0x0b, 0x8e, 0xed, 0xba, 0x2d, 0x8a, 0xc3, 0xa8, 0xd0,
-	0x7a, 0x4e, 0x55, 0xc4, 0x27, 0xc5, 0x5f, 0xe5, 0x2c, 0x66, 0xc4, 0x6a, 0xdc, 0x84, 0xc5, 0xbc,
-	0x05, 0xbd, 0x4a, 0x50, 0xfa, 0x91, 0x36, 0xcc, 0x7d, 0x55, 0xd0, 0x1a, 0x3f, 0xd1, 0x40, 0x7f,
-	0x3f, 0x70, 0x6c, 0x8a, 0x8f, 0xb6, 0xac, 0xf7, 0x60, 0x5a, 0x12, 0x70, 0x79, 0xd1, 0xe2, 0x9e,
-	0x1d, 0x66, 0x71, 0x22, 0xa7, 0x1f, 0x6f, 0xc6, 0x1f, 0xc4, 0x38, 0x0b, 0xcb, 0x85, 0xe6, 0xc8,
-	0xe0, 0xf9, 0x29, 0x4f, 0xb0, 0x2c, 0xf0, 0xe2, 0xc7, 0xb9, 0x0d, 0x3c, 0xb1, 0x16, 0x59, 0x21,
-	0xcd, 0xfc, 0x91, 0xc6, 0xae, 0xb2, 0x6d, 0xd7, 0xdb, 0xc2, 0xcc, 0x15, 0xa3, 0xb4, 0xf7, 0x98,
-	0xca, 0x80, 0xdf, 0x6a, 0xb0, 0x5c, 0x68, 0x8d, 0x74, 0x9c, 0x0b, 0x71, 0xaf, 0xd7, 0xe1, 0x14,
-	0x22, 0x28, 0x4c, 0xf6, 0x9a, 0xb9, 0x82, 0xcf, 0x41, 0x2f, 0x02, 0xea, 0x99, 0x45, 0x7a, 0xb4,
-	0x25, 0x4e, 0x3b, 0x1b, 0xcf, 0x24, 0xc8, 0x13, 0x8f, 0x43, 0x11, 0x79, 0x59, 0x90, 0xc7, 0x33,
-	0x92, 0x9c, 0xb9, 0xe2, 0x19, 0x6e, 0xe6, 0x0d, 0xdb, 0xf5, 0xa8, 0xed, 0x7a, 0x8f, 0x19, 0xb6,
-	0xcf, 0x34, 0x38, 0xab, 0xb0, 0xe7, 0xab, 0x05, 0xdc, 0x25, 0x58, 0xbc, 0xee, 0x92, 0xa3, 0xc5,
-	0x25, 0xe3, 0x3b, 0xb0, 0x54, 0xc0, 0x2c, 0x17, 0x58, 0x87, 0x63, 0xd8, 0xa3, 0xa1, 0xdb, 0xeb,
-	0x5d, 0x0f, 0x75, 0xae, 0x45, 0x2a, 0x8e, 0x38, 0x8d, 0x03, 0x40, 0xf9, 0x69, 0x84, 0x60, 0x2c,
-	0x61, 0x11, 0xff, 0x1b, 0x6d, 0xc2, 0x84, 0x8c, 0x22, 0xe5, 0x51, 0xa3, 0x88, 0x64, 0x34, 0x7e,
-	0xa6, 0x65, 0xb4, 0xf1, 0xe9, 0x23, 0xc5, 0xc6, 0x47, 0x14, 0x2b, 0xbe, 0x0d, 0xa7, 0x0a, 0xe6,
-	0x0b, 0xd7, 0xbf, 0x91, 0x2e, 0x41, 0x86, 0xb2, 0xb2, 0xf6, 0x77, 0x1d, 0x26, 0xb9, 0x9b, 0x6e,
-	0xde, 0xda, 0x41, 0x3f, 0xd5, 0x60, 0x49, 0xf9, 0x1b, 0x12, 0xf4, 0x7f, 0x03, 0xfa, 0x46, 0xaa,
-	0x5f, 0xc2, 0xe8, 0xaf, 0x8d, 0xce, 0x28, 0x3d, 0xe8, 0x63, 0x38, 0x55, 0xf0, 0xe6, 0x8f, 0x2e,
-	0x0e, 0x10, 0x98, 0xff, 0xad, 0x88, 0x5e, 0x1b, 0x85, 0x45, 0x6a, 0x4f, 0xc2, 0x91, 0xfb, 0x9d,
-	0xc3, 0x40, 0x38, 0x54, 0x3f, 0xf4, 0x18, 0x08, 0x87, 0xfa, 0x67, 0x1c, 0x36, 0x40, 0xfc, 0x9c,
-	0x8f, 0xd6, 0x14, 0x72, 0x72, 0xbf, 0x10, 0xd0, 0x9f, 0x1d, 0x82, 0x32, 0x56, 0x11, 0x3f, 0x95,
-	0x2b, 0x55, 0xe4, 0x7e, 0x3d, 0xa0, 0x54, 0x91, 0x7f, 0x77, 0x17, 0x2a, 0xa2, 0x47, 0xee, 0x3e,
-	0x2a, 0x32, 0x2f, 0xf3, 0x7d, 0x54, 0x64, 0x5f, 0xcc, 0xd1, 0x77, 0x61, 0x3a, 0xf5, 0x36, 0x8d,
-	0x9e, 0x1f, 0x80, 0x79, 0x4a, 0xd1, 0x0b, 0xc3, 0x11, 0x4b, 0x5d, 0xbf, 0xd1, 0xf8, 0x4b, 0x56,
-	0xdf, 0x07, 0x54, 0xf4, 0xff, 0xea, 0x6b, 0xca, 0x30, 0xef, 0xdd, 0xfa, 0x5b, 0x47, 0xe6, 0x97,
-	0x56, 0xfe, 0x50, 0x83, 0xf9, 0xe2, 0x27, 0x42, 0xf4, 0xf2, 0x88, 0x2f, 0x8a, 0xc2, 0xa2, 0x57,
-	0x8e, 0xf4, 0x0e, 0xc9, 0xcf, 0x94, 0xf2, 0x1d, 0x4e, 0x79, 0xa6, 0x06, 0xbd, 0x14, 0x2a, 0xcf,
-	0xd4, 0xe0, 0x27, 0xbf, 0x5f, 0x69, 0xfc, 0xb6, 0xab, 0x7c, 0xa2, 0x42, 0x6f, 0xf4, 0x11, 0x3d,
-	0xe0, 0x45, 0x4f, 0xbf, 0x74, 0x24, 0xde, 0xd8, 0x89, 0x53, 0x6f, 0x41, 0x4a, 0x27, 0x2e, 0x7a,
-	0xef, 0x52, 0x3a, 0x71, 0xe1, 0xf3, 0x12, 0x3a, 0x04, 0x94, 0x7f, 0x3c, 0x41, 0x2f, 0x8d, 0xfa,
-	0x78, 0xa4, 0x5f, 0x1c, 0x81, 0x43, 0xaa, 0x0e, 0xe0, 0x44, 0xe6, 0xe5, 0x01, 0xbd, 0x38, 0xec,
-	0x0b, 0x85, 0x50, 0x5a, 0x1d, 0xed, 0x41, 0x83, 0x69, 0xcc, 0xf4, 0xc3, 0x95, 0x1a, 0x8b, 0x1f,
-	0x19, 0x94, 0x1a, 0x55, 0x6d, 0x76, 0x02, 0x27, 0xb3, 0x7d, 0x56, 0xa4, 0x92, 0xa1, 0x68, 0x3c,
-	0xeb, 0xeb, 0x43, 0xd3, 0xc7, 0x4a, 0xb3, 0x2d, 0x49, 0xa5, 0x52, 0x45, 0xa3, 0x57, 0xa9, 0x54,
-	0xd9, 0xeb, 0xfc, 0x3e, 0xcc, 0x15, 0x35, 0x0d, 0x51, 0x4d, 0x89, 0x98, 0xb2, 0xdf, 0xa9, 0x6f,
-	0x8c, 0xc4, 0x93, 0x08, 0x74, 0xc5, 0x3d, 0x34, 0x65, 0xa0, 0xeb, 0xdb, 0xc4, 0x54, 0x06, 0xba,
-	0xfe, 0x8d, 0x3a, 0x06, 0x44, 0x51, 0x0f, 0x4a, 0x09, 0x44, 0x9f, 0xae, 0x9e, 0x12, 0x88, 0xbe,
-	0x5d, 0xba, 0xcf, 0x34, 0x38, 0x37, 0xb0, 0xcb, 0x81, 0xde, 0x52, 0xaf, 0x6e, 0xa8, 0x66, 0x90,
-	0xfe, 0xf6, 0xd1, 0x05, 0xc4, 0x7e, 0x9a, 0xed, 0x4a, 0x28, 0xfd, 0x54, 0xd1, 0x40, 0x51, 0xfa,
-	0xa9, 0xb2, 0xdd, 0xf1, 0x31, 0x9c, 0x2a, 0xe8, 0x14, 0x28, 0x2b, 0x4b, 0x75, 0x93, 0x43, 0x59,
-	0x59, 0xf6, 0x69, 0x44, 0x88, 0x53, 0x92, 0xef, 0x00, 0xf4, 0x39, 0x25, 0xca, 0xa6, 0x45, 0x9f,
-	0x53, 0xa2, 0x6e, 0x31, 0xa0, 0x2e, 0xcc, 0xe6, 0xee, 0x6d, 0x48, 0x05, 0xa2, 0xea, 0x7a, 0xa8,
-	0xbf, 0x34, 0x3c, 0x83, 0xd4, 0xfb, 0x00, 0x66, 0xd2, 0x6d, 0x04, 0xa4, 0xce, 0x18, 0xaa, 0x06,
-	0x88, 0x12, 0xf1, 0x7e, 0x5d, 0x8a, 0x4f, 0x35, 0x58, 0x88, 0x6e, 0xe2, 0x75, 0x3f, 0x0c, 0x3b,
-	0x41, 0xaf, 0x70, 0x42, 0x1b, 0xfd, 0xe4, 0x29, 0xda, 0x09, 0xfa, 0xcb, 0xa3, 0x31, 0x09, 0x33,
-	0x2e, 0x6f, 0xfe, 0xe5, 0xe1, 0x8a, 0xf6, 0xf9, 0xc3, 0x15, 0xed, 0x6f, 0x0f, 0x57, 0xb4, 0x6f,
-	0x6e, 0xec, 0xb9, 0x74, 0xbf, 0xd3, 0xa8, 0x36, 0xfd, 0xf6, 0x7a, 0xea, 0x9f, 0x0f, 0xaa, 0x7b,
-	0xd8, 0x13, 0xff, 0x5f, 0xd1, 0xfb, 0xe7, 0x8d, 0x4b, 0xfc, 0x8f, 0xee, 0xc5, 0xc6, 0x04, 0x1f,
-	0xdf, 0xf8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf6, 0x40, 0x3d, 0xe4, 0x31, 0x00, 0x00,
+func (m *StealTaskListLeaseResponse) Reset()         { *m = StealTaskListLeaseResponse{} }
+func (m *StealTaskListLeaseResponse) String() string { return proto.CompactTextString(m) }
+func (*StealTaskListLeaseResponse) ProtoMessage()    {}
+func (*StealTaskListLeaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{59}
+}
+func (m *StealTaskListLeaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StealTaskListLeaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StealTaskListLeaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StealTaskListLeaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StealTaskListLeaseResponse.Merge(m, src)
+}
+func (m *StealTaskListLeaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StealTaskListLeaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StealTaskListLeaseResponse.DiscardUnknown(m)
 }
 
-func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StealTaskListLeaseResponse proto.InternalMessageInfo
+
+func (m *StealTaskListLeaseResponse) GetRangeId() int64 {
+	if m != nil {
+		return m.RangeId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DescribeTaskListConfigRequest struct {
+	Domain               string          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *DescribeTaskListConfigRequest) Reset()         { *m = DescribeTaskListConfigRequest{} }
+func (m *DescribeTaskListConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeTaskListConfigRequest) ProtoMessage()    {}
+func (*DescribeTaskListConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{60}
+}
+func (m *DescribeTaskListConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskListConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskListConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DescribeTaskListConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskListConfigRequest.Merge(m, src)
+}
+func (m *DescribeTaskListConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskListConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskListConfigRequest.DiscardUnknown(m)
 }
 
-func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DescribeTaskListConfigRequest proto.InternalMessageInfo
+
+func (m *DescribeTaskListConfigRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeTaskListConfigRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
 }
 
-func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DescribeTaskListConfigRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
+	}
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+type DescribeTaskListConfigResponse struct {
+	NumReadPartitions                 int32    `protobuf:"varint,1,opt,name=num_read_partitions,json=numReadPartitions,proto3" json:"num_read_partitions,omitempty"`
+	NumWritePartitions                int32    `protobuf:"varint,2,opt,name=num_write_partitions,json=numWritePartitions,proto3" json:"num_write_partitions,omitempty"`
+	ForwarderMaxOutstandingPolls      int32    `protobuf:"varint,3,opt,name=forwarder_max_outstanding_polls,json=forwarderMaxOutstandingPolls,proto3" json:"forwarder_max_outstanding_polls,omitempty"`
+	ForwarderMaxOutstandingTasks      int32    `protobuf:"varint,4,opt,name=forwarder_max_outstanding_tasks,json=forwarderMaxOutstandingTasks,proto3" json:"forwarder_max_outstanding_tasks,omitempty"`
+	ForwarderMaxRatePerSecond         int32    `protobuf:"varint,5,opt,name=forwarder_max_rate_per_second,json=forwarderMaxRatePerSecond,proto3" json:"forwarder_max_rate_per_second,omitempty"`
+	ForwarderMaxChildrenPerNode       int32    `protobuf:"varint,6,opt,name=forwarder_max_children_per_node,json=forwarderMaxChildrenPerNode,proto3" json:"forwarder_max_children_per_node,omitempty"`
+	EnableSyncMatch                   bool     `protobuf:"varint,7,opt,name=enable_sync_match,json=enableSyncMatch,proto3" json:"enable_sync_match,omitempty"`
+	EnableEphemeralTaskList           bool     `protobuf:"varint,8,opt,name=enable_ephemeral_task_list,json=enableEphemeralTaskList,proto3" json:"enable_ephemeral_task_list,omitempty"`
+	LongPollExpirationIntervalSeconds int64    `protobuf:"varint,9,opt,name=long_poll_expiration_interval_seconds,json=longPollExpirationIntervalSeconds,proto3" json:"long_poll_expiration_interval_seconds,omitempty"`
+	RangeSize                         int64    `protobuf:"varint,10,opt,name=range_size,json=rangeSize,proto3" json:"range_size,omitempty"`
+	GetTasksBatchSize                 int32    `protobuf:"varint,11,opt,name=get_tasks_batch_size,json=getTasksBatchSize,proto3" json:"get_tasks_batch_size,omitempty"`
+	UpdateAckIntervalSeconds          int64    `protobuf:"varint,12,opt,name=update_ack_interval_seconds,json=updateAckIntervalSeconds,proto3" json:"update_ack_interval_seconds,omitempty"`
+	IdleTaskListCheckIntervalSeconds  int64    `protobuf:"varint,13,opt,name=idle_task_list_check_interval_seconds,json=idleTaskListCheckIntervalSeconds,proto3" json:"idle_task_list_check_interval_seconds,omitempty"`
+	MaxTaskListIdleTimeSeconds        int64    `protobuf:"varint,14,opt,name=max_task_list_idle_time_seconds,json=maxTaskListIdleTimeSeconds,proto3" json:"max_task_list_idle_time_seconds,omitempty"`
+	MinTaskThrottlingBurstSize        int32    `protobuf:"varint,15,opt,name=min_task_throttling_burst_size,json=minTaskThrottlingBurstSize,proto3" json:"min_task_throttling_burst_size,omitempty"`
+	MaxTaskDeleteBatchSize            int32    `protobuf:"varint,16,opt,name=max_task_delete_batch_size,json=maxTaskDeleteBatchSize,proto3" json:"max_task_delete_batch_size,omitempty"`
+	OutstandingTaskAppendsThreshold   int32    `protobuf:"varint,17,opt,name=outstanding_task_appends_threshold,json=outstandingTaskAppendsThreshold,proto3" json:"outstanding_task_appends_threshold,omitempty"`
+	MaxTaskBatchSize                  int32    `protobuf:"varint,18,opt,name=max_task_batch_size,json=maxTaskBatchSize,proto3" json:"max_task_batch_size,omitempty"`
+	XXX_NoUnkeyedLiteral              struct{} `json:"-"`
+	XXX_unrecognized                  []byte   `json:"-"`
+	XXX_sizecache                     int32    `json:"-"`
+}
+
+func (m *DescribeTaskListConfigResponse) Reset()         { *m = DescribeTaskListConfigResponse{} }
+func (m *DescribeTaskListConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeTaskListConfigResponse) ProtoMessage()    {}
+func (*DescribeTaskListConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{61}
+}
+func (m *DescribeTaskListConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskListConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskListConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MutableStateInDatabase) > 0 {
-		i -= len(m.MutableStateInDatabase)
-		copy(dAtA[i:], m.MutableStateInDatabase)
-		i = encodeVarintService(dAtA, i, uint64(len(m.MutableStateInDatabase)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MutableStateInCache) > 0 {
-		i -= len(m.MutableStateInCache)
-		copy(dAtA[i:], m.MutableStateInCache)
-		i = encodeVarintService(dAtA, i, uint64(len(m.MutableStateInCache)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.HistoryAddr) > 0 {
-		i -= len(m.HistoryAddr)
-		copy(dAtA[i:], m.HistoryAddr)
-		i = encodeVarintService(dAtA, i, uint64(len(m.HistoryAddr)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *DescribeTaskListConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskListConfigResponse.Merge(m, src)
+}
+func (m *DescribeTaskListConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskListConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskListConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeTaskListConfigResponse proto.InternalMessageInfo
+
+func (m *DescribeTaskListConfigResponse) GetNumReadPartitions() int32 {
+	if m != nil {
+		return m.NumReadPartitions
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetNumWritePartitions() int32 {
+	if m != nil {
+		return m.NumWritePartitions
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DescribeTaskListConfigResponse) GetForwarderMaxOutstandingPolls() int32 {
+	if m != nil {
+		return m.ForwarderMaxOutstandingPolls
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeTaskListConfigResponse) GetForwarderMaxOutstandingTasks() int32 {
+	if m != nil {
+		return m.ForwarderMaxOutstandingTasks
+	}
+	return 0
 }
 
-func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DescribeTaskListConfigResponse) GetForwarderMaxRatePerSecond() int32 {
+	if m != nil {
+		return m.ForwarderMaxRatePerSecond
 	}
-	if m.DescribeBy != nil {
-		{
-			size := m.DescribeBy.Size()
-			i -= size
-			if _, err := m.DescribeBy.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetForwarderMaxChildrenPerNode() int32 {
+	if m != nil {
+		return m.ForwarderMaxChildrenPerNode
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DescribeHistoryHostRequest_HostAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeTaskListConfigResponse) GetEnableSyncMatch() bool {
+	if m != nil {
+		return m.EnableSyncMatch
+	}
+	return false
 }
 
-func (m *DescribeHistoryHostRequest_HostAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.HostAddress)
-	copy(dAtA[i:], m.HostAddress)
-	i = encodeVarintService(dAtA, i, uint64(len(m.HostAddress)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *DescribeTaskListConfigResponse) GetEnableEphemeralTaskList() bool {
+	if m != nil {
+		return m.EnableEphemeralTaskList
+	}
+	return false
 }
-func (m *DescribeHistoryHostRequest_ShardId) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *DescribeTaskListConfigResponse) GetLongPollExpirationIntervalSeconds() int64 {
+	if m != nil {
+		return m.LongPollExpirationIntervalSeconds
+	}
+	return 0
 }
 
-func (m *DescribeHistoryHostRequest_ShardId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-	i--
-	dAtA[i] = 0x10
-	return len(dAtA) - i, nil
+func (m *DescribeTaskListConfigResponse) GetRangeSize() int64 {
+	if m != nil {
+		return m.RangeSize
+	}
+	return 0
 }
-func (m *DescribeHistoryHostRequest_WorkflowExecution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *DescribeTaskListConfigResponse) GetGetTasksBatchSize() int32 {
+	if m != nil {
+		return m.GetTasksBatchSize
+	}
+	return 0
 }
 
-func (m *DescribeHistoryHostRequest_WorkflowExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+func (m *DescribeTaskListConfigResponse) GetUpdateAckIntervalSeconds() int64 {
+	if m != nil {
+		return m.UpdateAckIntervalSeconds
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *DescribeShardDistributionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *DescribeTaskListConfigResponse) GetIdleTaskListCheckIntervalSeconds() int64 {
+	if m != nil {
+		return m.IdleTaskListCheckIntervalSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DescribeShardDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeTaskListConfigResponse) GetMaxTaskListIdleTimeSeconds() int64 {
+	if m != nil {
+		return m.MaxTaskListIdleTimeSeconds
+	}
+	return 0
 }
 
-func (m *DescribeShardDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *DescribeTaskListConfigResponse) GetMinTaskThrottlingBurstSize() int32 {
+	if m != nil {
+		return m.MinTaskThrottlingBurstSize
 	}
-	if m.PageId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageId))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetMaxTaskDeleteBatchSize() int32 {
+	if m != nil {
+		return m.MaxTaskDeleteBatchSize
 	}
-	if m.PageSize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetOutstandingTaskAppendsThreshold() int32 {
+	if m != nil {
+		return m.OutstandingTaskAppendsThreshold
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DescribeShardDistributionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DescribeTaskListConfigResponse) GetMaxTaskBatchSize() int32 {
+	if m != nil {
+		return m.MaxTaskBatchSize
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DescribeShardDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetTaskListUsageRequest struct {
+	Domain               string          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *DescribeShardDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Shards) > 0 {
-		for k := range m.Shards {
-			v := m.Shards[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintService(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+func (m *GetTaskListUsageRequest) Reset()         { *m = GetTaskListUsageRequest{} }
+func (m *GetTaskListUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTaskListUsageRequest) ProtoMessage()    {}
+func (*GetTaskListUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{62}
+}
+func (m *GetTaskListUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskListUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskListUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.NumberOfShards != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NumberOfShards))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *GetTaskListUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskListUsageRequest.Merge(m, src)
+}
+func (m *GetTaskListUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskListUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskListUsageRequest.DiscardUnknown(m)
 }
 
-func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTaskListUsageRequest proto.InternalMessageInfo
+
+func (m *GetTaskListUsageRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetTaskListUsageRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
 }
 
-func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GetTaskListUsageRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ShardControllerStatus) > 0 {
-		i -= len(m.ShardControllerStatus)
-		copy(dAtA[i:], m.ShardControllerStatus)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ShardControllerStatus)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.DomainCache != nil {
-		{
-			size, err := m.DomainCache.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+type GetTaskListUsageResponse struct {
+	TaskCount            int64    `protobuf:"varint,1,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	SizeBytes            int64    `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Estimated            bool     `protobuf:"varint,3,opt,name=estimated,proto3" json:"estimated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTaskListUsageResponse) Reset()         { *m = GetTaskListUsageResponse{} }
+func (m *GetTaskListUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTaskListUsageResponse) ProtoMessage()    {}
+func (*GetTaskListUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{63}
+}
+func (m *GetTaskListUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskListUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskListUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA5 := make([]byte, len(m.ShardIds)*10)
-		var j4 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintService(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *GetTaskListUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskListUsageResponse.Merge(m, src)
+}
+func (m *GetTaskListUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskListUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskListUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskListUsageResponse proto.InternalMessageInfo
+
+func (m *GetTaskListUsageResponse) GetTaskCount() int64 {
+	if m != nil {
+		return m.TaskCount
 	}
-	if m.NumberOfShards != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.NumberOfShards))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *GetTaskListUsageResponse) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetTaskListUsageResponse) GetEstimated() bool {
+	if m != nil {
+		return m.Estimated
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdateTaskListTagsRequest struct {
+	Domain               string            `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TaskList             *v1.TaskList      `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType   `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+func (m *UpdateTaskListTagsRequest) Reset()         { *m = UpdateTaskListTagsRequest{} }
+func (m *UpdateTaskListTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskListTagsRequest) ProtoMessage()    {}
+func (*UpdateTaskListTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{64}
+}
+func (m *UpdateTaskListTagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTaskListTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTaskListTagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateTaskListTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskListTagsRequest.Merge(m, src)
+}
+func (m *UpdateTaskListTagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTaskListTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskListTagsRequest.DiscardUnknown(m)
 }
 
-func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateTaskListTagsRequest proto.InternalMessageInfo
+
+func (m *UpdateTaskListTagsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UpdateTaskListTagsRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
+	}
+	return nil
 }
 
-func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *UpdateTaskListTagsRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
 	}
-	return len(dAtA) - i, nil
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
 }
 
-func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateTaskListTagsRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdateTaskListTagsResponse struct {
+	Tags                 map[string]string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.VisibilityTime != nil {
-		{
-			size, err := m.VisibilityTime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *UpdateTaskListTagsResponse) Reset()         { *m = UpdateTaskListTagsResponse{} }
+func (m *UpdateTaskListTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTaskListTagsResponse) ProtoMessage()    {}
+func (*UpdateTaskListTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{65}
+}
+func (m *UpdateTaskListTagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateTaskListTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateTaskListTagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.TaskType != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UpdateTaskListTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTaskListTagsResponse.Merge(m, src)
+}
+func (m *UpdateTaskListTagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateTaskListTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTaskListTagsResponse.DiscardUnknown(m)
 }
 
-func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateTaskListTagsResponse proto.InternalMessageInfo
+
+func (m *UpdateTaskListTagsResponse) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListTaskListTagsRequest struct {
+	Domain               string            `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ListTaskListTagsRequest) Reset()         { *m = ListTaskListTagsRequest{} }
+func (m *ListTaskListTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTaskListTagsRequest) ProtoMessage()    {}
+func (*ListTaskListTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{66}
+}
+func (m *ListTaskListTagsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTaskListTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTaskListTagsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListTaskListTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTaskListTagsRequest.Merge(m, src)
+}
+func (m *ListTaskListTagsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTaskListTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTaskListTagsRequest.DiscardUnknown(m)
 }
 
-func (m *ResetQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListTaskListTagsRequest proto.InternalMessageInfo
+
+func (m *ListTaskListTagsRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ResetQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListTaskListTagsRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
 }
 
-func (m *ResetQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.TaskType != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
-		i--
-		dAtA[i] = 0x18
+type TaskListTags struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TaskListType         v1.TaskListType   `protobuf:"varint,2,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TaskListTags) Reset()         { *m = TaskListTags{} }
+func (m *TaskListTags) String() string { return proto.CompactTextString(m) }
+func (*TaskListTags) ProtoMessage()    {}
+func (*TaskListTags) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{67}
+}
+func (m *TaskListTags) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListTags) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListTags.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *TaskListTags) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListTags.Merge(m, src)
+}
+func (m *TaskListTags) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListTags) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListTags.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListTags proto.InternalMessageInfo
+
+func (m *TaskListTags) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *TaskListTags) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
 	}
-	return len(dAtA) - i, nil
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
 }
 
-func (m *ResetQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskListTags) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ResetQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListTaskListTagsResponse struct {
+	TaskLists            []*TaskListTags `protobuf:"bytes,1,rep,name=task_lists,json=taskLists,proto3" json:"task_lists,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ResetQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ListTaskListTagsResponse) Reset()         { *m = ListTaskListTagsResponse{} }
+func (m *ListTaskListTagsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTaskListTagsResponse) ProtoMessage()    {}
+func (*ListTaskListTagsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{68}
+}
+func (m *ListTaskListTagsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListTaskListTagsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListTaskListTagsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListTaskListTagsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTaskListTagsResponse.Merge(m, src)
+}
+func (m *ListTaskListTagsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListTaskListTagsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTaskListTagsResponse.DiscardUnknown(m)
 }
 
-func (m *DescribeQueueRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListTaskListTagsResponse proto.InternalMessageInfo
+
+func (m *ListTaskListTagsResponse) GetTaskLists() []*TaskListTags {
+	if m != nil {
+		return m.TaskLists
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DescribeQueueRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BackfillDomainReplicationRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	TargetCluster        string   `protobuf:"bytes,2,opt,name=target_cluster,json=targetCluster,proto3" json:"target_cluster,omitempty"`
+	ClosedWithinSeconds  int64    `protobuf:"varint,3,opt,name=closed_within_seconds,json=closedWithinSeconds,proto3" json:"closed_within_seconds,omitempty"`
+	Rps                  int32    `protobuf:"varint,4,opt,name=rps,proto3" json:"rps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *BackfillDomainReplicationRequest) Reset()         { *m = BackfillDomainReplicationRequest{} }
+func (m *BackfillDomainReplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*BackfillDomainReplicationRequest) ProtoMessage()    {}
+func (*BackfillDomainReplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{69}
+}
+func (m *BackfillDomainReplicationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillDomainReplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillDomainReplicationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.TaskType != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *BackfillDomainReplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillDomainReplicationRequest.Merge(m, src)
+}
+func (m *BackfillDomainReplicationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillDomainReplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillDomainReplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillDomainReplicationRequest proto.InternalMessageInfo
+
+func (m *BackfillDomainReplicationRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *BackfillDomainReplicationRequest) GetTargetCluster() string {
+	if m != nil {
+		return m.TargetCluster
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *BackfillDomainReplicationRequest) GetClosedWithinSeconds() int64 {
+	if m != nil {
+		return m.ClosedWithinSeconds
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *DescribeQueueResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BackfillDomainReplicationRequest) GetRps() int32 {
+	if m != nil {
+		return m.Rps
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *DescribeQueueResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BackfillDomainReplicationResponse struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ProcessingQueueStates) > 0 {
-		for iNdEx := len(m.ProcessingQueueStates) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ProcessingQueueStates[iNdEx])
-			copy(dAtA[i:], m.ProcessingQueueStates[iNdEx])
-			i = encodeVarintService(dAtA, i, uint64(len(m.ProcessingQueueStates[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *BackfillDomainReplicationResponse) Reset()         { *m = BackfillDomainReplicationResponse{} }
+func (m *BackfillDomainReplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*BackfillDomainReplicationResponse) ProtoMessage()    {}
+func (*BackfillDomainReplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{70}
+}
+func (m *BackfillDomainReplicationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillDomainReplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillDomainReplicationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BackfillDomainReplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillDomainReplicationResponse.Merge(m, src)
+}
+func (m *BackfillDomainReplicationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillDomainReplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillDomainReplicationResponse.DiscardUnknown(m)
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BackfillDomainReplicationResponse proto.InternalMessageInfo
+
+func (m *BackfillDomainReplicationResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdateDomainAsyncWorkflowConfigRequest struct {
+	Domain               string                          `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Configuration        *v11.AsyncWorkflowConfiguration `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x28
+func (m *UpdateDomainAsyncWorkflowConfigRequest) Reset() {
+	*m = UpdateDomainAsyncWorkflowConfigRequest{}
+}
+func (m *UpdateDomainAsyncWorkflowConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDomainAsyncWorkflowConfigRequest) ProtoMessage()    {}
+func (*UpdateDomainAsyncWorkflowConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{71}
+}
+func (m *UpdateDomainAsyncWorkflowConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDomainAsyncWorkflowConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDomainAsyncWorkflowConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.EndEvent != nil {
-		{
-			size, err := m.EndEvent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.StartEvent != nil {
-		{
-			size, err := m.StartEvent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *UpdateDomainAsyncWorkflowConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDomainAsyncWorkflowConfigRequest.Merge(m, src)
+}
+func (m *UpdateDomainAsyncWorkflowConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDomainAsyncWorkflowConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDomainAsyncWorkflowConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDomainAsyncWorkflowConfigRequest proto.InternalMessageInfo
+
+func (m *UpdateDomainAsyncWorkflowConfigRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UpdateDomainAsyncWorkflowConfigRequest) GetConfiguration() *v11.AsyncWorkflowConfiguration {
+	if m != nil {
+		return m.Configuration
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UpdateDomainAsyncWorkflowConfigResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.VersionHistory != nil {
-		{
-			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.HistoryBatches) > 0 {
-		for iNdEx := len(m.HistoryBatches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HistoryBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *UpdateDomainAsyncWorkflowConfigResponse) Reset() {
+	*m = UpdateDomainAsyncWorkflowConfigResponse{}
+}
+func (m *UpdateDomainAsyncWorkflowConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDomainAsyncWorkflowConfigResponse) ProtoMessage()    {}
+func (*UpdateDomainAsyncWorkflowConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{72}
+}
+func (m *UpdateDomainAsyncWorkflowConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateDomainAsyncWorkflowConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateDomainAsyncWorkflowConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *UpdateDomainAsyncWorkflowConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDomainAsyncWorkflowConfigResponse.Merge(m, src)
+}
+func (m *UpdateDomainAsyncWorkflowConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateDomainAsyncWorkflowConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDomainAsyncWorkflowConfigResponse.DiscardUnknown(m)
 }
 
-func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_UpdateDomainAsyncWorkflowConfigResponse proto.InternalMessageInfo
+
+type GetDomainAsyncWorkflowConfigRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *GetDomainAsyncWorkflowConfigRequest) Reset()         { *m = GetDomainAsyncWorkflowConfigRequest{} }
+func (m *GetDomainAsyncWorkflowConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDomainAsyncWorkflowConfigRequest) ProtoMessage()    {}
+func (*GetDomainAsyncWorkflowConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{73}
+}
+func (m *GetDomainAsyncWorkflowConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDomainAsyncWorkflowConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDomainAsyncWorkflowConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetDomainAsyncWorkflowConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDomainAsyncWorkflowConfigRequest.Merge(m, src)
+}
+func (m *GetDomainAsyncWorkflowConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDomainAsyncWorkflowConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDomainAsyncWorkflowConfigRequest.DiscardUnknown(m)
 }
 
-func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetDomainAsyncWorkflowConfigRequest proto.InternalMessageInfo
+
+func (m *GetDomainAsyncWorkflowConfigRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetDomainAsyncWorkflowConfigResponse struct {
+	Configuration        *v11.AsyncWorkflowConfiguration `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ShardMessages) > 0 {
-		for k := range m.ShardMessages {
-			v := m.ShardMessages[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *GetDomainAsyncWorkflowConfigResponse) Reset()         { *m = GetDomainAsyncWorkflowConfigResponse{} }
+func (m *GetDomainAsyncWorkflowConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDomainAsyncWorkflowConfigResponse) ProtoMessage()    {}
+func (*GetDomainAsyncWorkflowConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{74}
+}
+func (m *GetDomainAsyncWorkflowConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDomainAsyncWorkflowConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDomainAsyncWorkflowConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetDomainAsyncWorkflowConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDomainAsyncWorkflowConfigResponse.Merge(m, src)
+}
+func (m *GetDomainAsyncWorkflowConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDomainAsyncWorkflowConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDomainAsyncWorkflowConfigResponse.DiscardUnknown(m)
 }
 
-func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetDomainAsyncWorkflowConfigResponse proto.InternalMessageInfo
+
+func (m *GetDomainAsyncWorkflowConfigResponse) GetConfiguration() *v11.AsyncWorkflowConfiguration {
+	if m != nil {
+		return m.Configuration
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ReconcileDomainRetentionRequest struct {
+	Domain               string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Rps                  int32    `protobuf:"varint,2,opt,name=rps,proto3" json:"rps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.TaskInfos) > 0 {
-		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ReconcileDomainRetentionRequest) Reset()         { *m = ReconcileDomainRetentionRequest{} }
+func (m *ReconcileDomainRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileDomainRetentionRequest) ProtoMessage()    {}
+func (*ReconcileDomainRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{75}
+}
+func (m *ReconcileDomainRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileDomainRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileDomainRetentionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ReconcileDomainRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileDomainRetentionRequest.Merge(m, src)
 }
-
-func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReconcileDomainRetentionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileDomainRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileDomainRetentionRequest.DiscardUnknown(m)
 }
 
-func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_ReconcileDomainRetentionRequest proto.InternalMessageInfo
+
+func (m *ReconcileDomainRetentionRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetDomainReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ReconcileDomainRetentionRequest) GetRps() int32 {
+	if m != nil {
+		return m.Rps
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetDomainReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ReconcileDomainRetentionResponse struct {
+	JobId                string   `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetDomainReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.LastProcessedMessageId != nil {
-		{
-			size, err := m.LastProcessedMessageId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.LastRetrievedMessageId != nil {
-		{
-			size, err := m.LastRetrievedMessageId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *ReconcileDomainRetentionResponse) Reset()         { *m = ReconcileDomainRetentionResponse{} }
+func (m *ReconcileDomainRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconcileDomainRetentionResponse) ProtoMessage()    {}
+func (*ReconcileDomainRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{76}
+}
+func (m *ReconcileDomainRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileDomainRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileDomainRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ReconcileDomainRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileDomainRetentionResponse.Merge(m, src)
+}
+func (m *ReconcileDomainRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileDomainRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileDomainRetentionResponse.DiscardUnknown(m)
 }
 
-func (m *GetDomainReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReconcileDomainRetentionResponse proto.InternalMessageInfo
+
+func (m *ReconcileDomainRetentionResponse) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetDomainReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListDynamicConfigRequest struct {
+	ConfigName           string   `protobuf:"bytes,1,opt,name=config_name,json=configName,proto3" json:"config_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetDomainReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Messages != nil {
-		{
-			size, err := m.Messages.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *ListDynamicConfigRequest) Reset()         { *m = ListDynamicConfigRequest{} }
+func (m *ListDynamicConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDynamicConfigRequest) ProtoMessage()    {}
+func (*ListDynamicConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{77}
+}
+func (m *ListDynamicConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDynamicConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDynamicConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ListDynamicConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDynamicConfigRequest.Merge(m, src)
+}
+func (m *ListDynamicConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListDynamicConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDynamicConfigRequest.DiscardUnknown(m)
 }
 
-func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListDynamicConfigRequest proto.InternalMessageInfo
+
+func (m *ListDynamicConfigRequest) GetConfigName() string {
+	if m != nil {
+		return m.ConfigName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListDynamicConfigResponse struct {
+	Entries              []*DynamicConfigEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Events != nil {
-		{
-			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+func (m *ListDynamicConfigResponse) Reset()         { *m = ListDynamicConfigResponse{} }
+func (m *ListDynamicConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDynamicConfigResponse) ProtoMessage()    {}
+func (*ListDynamicConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{78}
+}
+func (m *ListDynamicConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListDynamicConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListDynamicConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ListDynamicConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDynamicConfigResponse.Merge(m, src)
 }
-
-func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListDynamicConfigResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+func (m *ListDynamicConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDynamicConfigResponse.DiscardUnknown(m)
 }
 
-func (m *AddSearchAttributeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListDynamicConfigResponse proto.InternalMessageInfo
+
+func (m *ListDynamicConfigResponse) GetEntries() []*DynamicConfigEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AddSearchAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DynamicConfigEntry struct {
+	Name                 string                `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values               []*DynamicConfigValue `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *AddSearchAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.SecurityToken) > 0 {
-		i -= len(m.SecurityToken)
-		copy(dAtA[i:], m.SecurityToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SecurityToken)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SearchAttribute) > 0 {
-		for k := range m.SearchAttribute {
-			v := m.SearchAttribute[k]
-			baseI := i
-			i = encodeVarintService(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *DynamicConfigEntry) Reset()         { *m = DynamicConfigEntry{} }
+func (m *DynamicConfigEntry) String() string { return proto.CompactTextString(m) }
+func (*DynamicConfigEntry) ProtoMessage()    {}
+func (*DynamicConfigEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{79}
+}
+func (m *DynamicConfigEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DynamicConfigEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigEntry.Merge(m, src)
+}
+func (m *DynamicConfigEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigEntry.DiscardUnknown(m)
 }
 
-func (m *AddSearchAttributeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DynamicConfigEntry proto.InternalMessageInfo
+
+func (m *DynamicConfigEntry) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AddSearchAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DynamicConfigEntry) GetValues() []*DynamicConfigValue {
+	if m != nil {
+		return m.Values
+	}
+	return nil
 }
 
-func (m *AddSearchAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return len(dAtA) - i, nil
+type DynamicConfigValue struct {
+	Value                *v1.DataBlob           `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Filters              []*DynamicConfigFilter `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *DescribeClusterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DynamicConfigValue) Reset()         { *m = DynamicConfigValue{} }
+func (m *DynamicConfigValue) String() string { return proto.CompactTextString(m) }
+func (*DynamicConfigValue) ProtoMessage()    {}
+func (*DynamicConfigValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{80}
+}
+func (m *DynamicConfigValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *DescribeClusterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DynamicConfigValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigValue.Merge(m, src)
+}
+func (m *DynamicConfigValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigValue.DiscardUnknown(m)
 }
 
-func (m *DescribeClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+var xxx_messageInfo_DynamicConfigValue proto.InternalMessageInfo
+
+func (m *DynamicConfigValue) GetValue() *v1.DataBlob {
+	if m != nil {
+		return m.Value
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *DescribeClusterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DynamicConfigValue) GetFilters() []*DynamicConfigFilter {
+	if m != nil {
+		return m.Filters
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *DescribeClusterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type DynamicConfigFilter struct {
+	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                *v1.DataBlob `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *DescribeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
+func (m *DynamicConfigFilter) Reset()         { *m = DynamicConfigFilter{} }
+func (m *DynamicConfigFilter) String() string { return proto.CompactTextString(m) }
+func (*DynamicConfigFilter) ProtoMessage()    {}
+func (*DynamicConfigFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6fc96d64a8b67fd, []int{81}
+}
+func (m *DynamicConfigFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicConfigFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicConfigFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicConfigFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicConfigFilter.Merge(m, src)
+}
+func (m *DynamicConfigFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicConfigFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicConfigFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicConfigFilter proto.InternalMessageInfo
+
+func (m *DynamicConfigFilter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DynamicConfigFilter) GetValue() *v1.DataBlob {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.admin.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.admin.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "uber.cadence.admin.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeShardDistributionRequest)(nil), "uber.cadence.admin.v1.DescribeShardDistributionRequest")
+	proto.RegisterType((*DescribeShardDistributionResponse)(nil), "uber.cadence.admin.v1.DescribeShardDistributionResponse")
+	proto.RegisterMapType((map[int32]string)(nil), "uber.cadence.admin.v1.DescribeShardDistributionResponse.ShardsEntry")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "uber.cadence.admin.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "uber.cadence.admin.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "uber.cadence.admin.v1.CloseShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "uber.cadence.admin.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "uber.cadence.admin.v1.RemoveTaskResponse")
+	proto.RegisterType((*ResetQueueRequest)(nil), "uber.cadence.admin.v1.ResetQueueRequest")
+	proto.RegisterType((*ResetQueueResponse)(nil), "uber.cadence.admin.v1.ResetQueueResponse")
+	proto.RegisterType((*DescribeQueueRequest)(nil), "uber.cadence.admin.v1.DescribeQueueRequest")
+	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.admin.v1.DescribeQueueResponse")
+	proto.RegisterType((*GetReplicationAckStatesRequest)(nil), "uber.cadence.admin.v1.GetReplicationAckStatesRequest")
+	proto.RegisterType((*GetReplicationAckStatesResponse)(nil), "uber.cadence.admin.v1.GetReplicationAckStatesResponse")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Request)(nil), "uber.cadence.admin.v1.GetWorkflowExecutionRawHistoryV2Request")
+	proto.RegisterType((*GetWorkflowExecutionRawHistoryV2Response)(nil), "uber.cadence.admin.v1.GetWorkflowExecutionRawHistoryV2Response")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.admin.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.admin.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v11.ReplicationMessages)(nil), "uber.cadence.admin.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "uber.cadence.admin.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "uber.cadence.admin.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*GetDomainReplicationMessagesRequest)(nil), "uber.cadence.admin.v1.GetDomainReplicationMessagesRequest")
+	proto.RegisterType((*GetDomainReplicationMessagesResponse)(nil), "uber.cadence.admin.v1.GetDomainReplicationMessagesResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "uber.cadence.admin.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.admin.v1.ReapplyEventsResponse")
+	proto.RegisterType((*AddSearchAttributeRequest)(nil), "uber.cadence.admin.v1.AddSearchAttributeRequest")
+	proto.RegisterMapType((map[string]v1.IndexedValueType)(nil), "uber.cadence.admin.v1.AddSearchAttributeRequest.SearchAttributeEntry")
+	proto.RegisterType((*AddSearchAttributeResponse)(nil), "uber.cadence.admin.v1.AddSearchAttributeResponse")
+	proto.RegisterType((*DescribeClusterRequest)(nil), "uber.cadence.admin.v1.DescribeClusterRequest")
+	proto.RegisterType((*DescribeClusterResponse)(nil), "uber.cadence.admin.v1.DescribeClusterResponse")
+	proto.RegisterMapType((map[string]*v11.PersistenceInfo)(nil), "uber.cadence.admin.v1.DescribeClusterResponse.PersistenceInfoEntry")
+	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.admin.v1.ReadDLQMessagesRequest")
+	proto.RegisterType((*ReadDLQMessagesResponse)(nil), "uber.cadence.admin.v1.ReadDLQMessagesResponse")
+	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "uber.cadence.admin.v1.PurgeDLQMessagesRequest")
+	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "uber.cadence.admin.v1.PurgeDLQMessagesResponse")
+	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "uber.cadence.admin.v1.MergeDLQMessagesRequest")
+	proto.RegisterType((*MergeDLQMessagesResponse)(nil), "uber.cadence.admin.v1.MergeDLQMessagesResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.admin.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.admin.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*ResendReplicationTasksRequest)(nil), "uber.cadence.admin.v1.ResendReplicationTasksRequest")
+	proto.RegisterType((*ResendReplicationTasksResponse)(nil), "uber.cadence.admin.v1.ResendReplicationTasksResponse")
+	proto.RegisterType((*GetCrossClusterTasksRequest)(nil), "uber.cadence.admin.v1.GetCrossClusterTasksRequest")
+	proto.RegisterType((*GetCrossClusterTasksResponse)(nil), "uber.cadence.admin.v1.GetCrossClusterTasksResponse")
+	proto.RegisterMapType((map[int32]v11.GetTaskFailedCause)(nil), "uber.cadence.admin.v1.GetCrossClusterTasksResponse.FailedCauseByShardEntry")
+	proto.RegisterMapType((map[int32]*v11.CrossClusterTaskRequests)(nil), "uber.cadence.admin.v1.GetCrossClusterTasksResponse.TasksByShardEntry")
+	proto.RegisterType((*RespondCrossClusterTasksCompletedRequest)(nil), "uber.cadence.admin.v1.RespondCrossClusterTasksCompletedRequest")
+	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.admin.v1.RespondCrossClusterTasksCompletedResponse")
+	proto.RegisterType((*GetDynamicConfigRequest)(nil), "uber.cadence.admin.v1.GetDynamicConfigRequest")
+	proto.RegisterType((*GetDynamicConfigResponse)(nil), "uber.cadence.admin.v1.GetDynamicConfigResponse")
+	proto.RegisterType((*UpdateDynamicConfigRequest)(nil), "uber.cadence.admin.v1.UpdateDynamicConfigRequest")
+	proto.RegisterType((*UpdateDynamicConfigResponse)(nil), "uber.cadence.admin.v1.UpdateDynamicConfigResponse")
+	proto.RegisterType((*RestoreDynamicConfigRequest)(nil), "uber.cadence.admin.v1.RestoreDynamicConfigRequest")
+	proto.RegisterType((*RestoreDynamicConfigResponse)(nil), "uber.cadence.admin.v1.RestoreDynamicConfigResponse")
+	proto.RegisterType((*AdminDeleteWorkflowRequest)(nil), "uber.cadence.admin.v1.AdminDeleteWorkflowRequest")
+	proto.RegisterType((*AdminDeleteWorkflowResponse)(nil), "uber.cadence.admin.v1.AdminDeleteWorkflowResponse")
+	proto.RegisterType((*AdminMaintainWorkflowRequest)(nil), "uber.cadence.admin.v1.AdminMaintainWorkflowRequest")
+	proto.RegisterType((*AdminMaintainWorkflowResponse)(nil), "uber.cadence.admin.v1.AdminMaintainWorkflowResponse")
+	proto.RegisterType((*GetDomainReplicationWatermarkRequest)(nil), "uber.cadence.admin.v1.GetDomainReplicationWatermarkRequest")
+	proto.RegisterType((*GetDomainReplicationWatermarkResponse)(nil), "uber.cadence.admin.v1.GetDomainReplicationWatermarkResponse")
+	proto.RegisterType((*IssueDomainTokenRequest)(nil), "uber.cadence.admin.v1.IssueDomainTokenRequest")
+	proto.RegisterType((*IssueDomainTokenResponse)(nil), "uber.cadence.admin.v1.IssueDomainTokenResponse")
+	proto.RegisterType((*StealTaskListLeaseRequest)(nil), "uber.cadence.admin.v1.StealTaskListLeaseRequest")
+	proto.RegisterType((*StealTaskListLeaseResponse)(nil), "uber.cadence.admin.v1.StealTaskListLeaseResponse")
+	proto.RegisterType((*DescribeTaskListConfigRequest)(nil), "uber.cadence.admin.v1.DescribeTaskListConfigRequest")
+	proto.RegisterType((*DescribeTaskListConfigResponse)(nil), "uber.cadence.admin.v1.DescribeTaskListConfigResponse")
+	proto.RegisterType((*GetTaskListUsageRequest)(nil), "uber.cadence.admin.v1.GetTaskListUsageRequest")
+	proto.RegisterType((*GetTaskListUsageResponse)(nil), "uber.cadence.admin.v1.GetTaskListUsageResponse")
+	proto.RegisterType((*UpdateTaskListTagsRequest)(nil), "uber.cadence.admin.v1.UpdateTaskListTagsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.admin.v1.UpdateTaskListTagsRequest.TagsEntry")
+	proto.RegisterType((*UpdateTaskListTagsResponse)(nil), "uber.cadence.admin.v1.UpdateTaskListTagsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.admin.v1.UpdateTaskListTagsResponse.TagsEntry")
+	proto.RegisterType((*ListTaskListTagsRequest)(nil), "uber.cadence.admin.v1.ListTaskListTagsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.admin.v1.ListTaskListTagsRequest.TagsEntry")
+	proto.RegisterType((*TaskListTags)(nil), "uber.cadence.admin.v1.TaskListTags")
+	proto.RegisterMapType((map[string]string)(nil), "uber.cadence.admin.v1.TaskListTags.TagsEntry")
+	proto.RegisterType((*ListTaskListTagsResponse)(nil), "uber.cadence.admin.v1.ListTaskListTagsResponse")
+	proto.RegisterType((*BackfillDomainReplicationRequest)(nil), "uber.cadence.admin.v1.BackfillDomainReplicationRequest")
+	proto.RegisterType((*BackfillDomainReplicationResponse)(nil), "uber.cadence.admin.v1.BackfillDomainReplicationResponse")
+	proto.RegisterType((*UpdateDomainAsyncWorkflowConfigRequest)(nil), "uber.cadence.admin.v1.UpdateDomainAsyncWorkflowConfigRequest")
+	proto.RegisterType((*UpdateDomainAsyncWorkflowConfigResponse)(nil), "uber.cadence.admin.v1.UpdateDomainAsyncWorkflowConfigResponse")
+	proto.RegisterType((*GetDomainAsyncWorkflowConfigRequest)(nil), "uber.cadence.admin.v1.GetDomainAsyncWorkflowConfigRequest")
+	proto.RegisterType((*GetDomainAsyncWorkflowConfigResponse)(nil), "uber.cadence.admin.v1.GetDomainAsyncWorkflowConfigResponse")
+	proto.RegisterType((*ReconcileDomainRetentionRequest)(nil), "uber.cadence.admin.v1.ReconcileDomainRetentionRequest")
+	proto.RegisterType((*ReconcileDomainRetentionResponse)(nil), "uber.cadence.admin.v1.ReconcileDomainRetentionResponse")
+	proto.RegisterType((*ListDynamicConfigRequest)(nil), "uber.cadence.admin.v1.ListDynamicConfigRequest")
+	proto.RegisterType((*ListDynamicConfigResponse)(nil), "uber.cadence.admin.v1.ListDynamicConfigResponse")
+	proto.RegisterType((*DynamicConfigEntry)(nil), "uber.cadence.admin.v1.DynamicConfigEntry")
+	proto.RegisterType((*DynamicConfigValue)(nil), "uber.cadence.admin.v1.DynamicConfigValue")
+	proto.RegisterType((*DynamicConfigFilter)(nil), "uber.cadence.admin.v1.DynamicConfigFilter")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/admin/v1/service.proto", fileDescriptor_c6fc96d64a8b67fd)
+}
+
+var fileDescriptor_c6fc96d64a8b67fd = []byte{
+	// 4283 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
+	0x76, 0xee, 0x19, 0x91, 0x22, 0x1f, 0x3f, 0x44, 0x96, 0xf8, 0x31, 0x6c, 0x8a, 0x1f, 0x6a, 0x5b,
+	0x16, 0xe5, 0xb5, 0x87, 0x12, 0x69, 0xc9, 0xb2, 0x14, 0x79, 0xcd, 0x0f, 0x7d, 0xd0, 0x16, 0x25,
+	0xba, 0x29, 0x5b, 0x8b, 0x60, 0xe1, 0x4e, 0xcf, 0x74, 0x91, 0xd3, 0x66, 0x4f, 0xf7, 0xb8, 0xab,
+	0x86, 0x14, 0x17, 0x41, 0xb2, 0x87, 0x0d, 0xb0, 0xc0, 0xe6, 0x13, 0x39, 0x04, 0xd8, 0x4b, 0x02,
+	0x24, 0x70, 0x8e, 0x41, 0x2e, 0xc1, 0x1e, 0x72, 0xc9, 0x25, 0xc8, 0x21, 0x1b, 0x6c, 0xfe, 0xc1,
+	0xc2, 0x08, 0x92, 0x43, 0x80, 0x00, 0x41, 0x72, 0xc8, 0x31, 0xa8, 0x8f, 0xfe, 0x98, 0x99, 0xae,
+	0x99, 0x1e, 0x46, 0x89, 0xbc, 0xd9, 0x1b, 0xa7, 0xea, 0xbd, 0x57, 0xef, 0xbd, 0x7a, 0xf5, 0xde,
+	0xab, 0x57, 0xaf, 0x09, 0xaf, 0x37, 0x2b, 0x38, 0x5c, 0xad, 0xda, 0x0e, 0xf6, 0xab, 0x78, 0xd5,
+	0x76, 0xea, 0xae, 0xbf, 0x7a, 0x7c, 0x63, 0x95, 0xe0, 0xf0, 0xd8, 0xad, 0xe2, 0x72, 0x23, 0x0c,
+	0x68, 0x80, 0xa6, 0x19, 0x50, 0x59, 0x02, 0x95, 0x39, 0x50, 0xf9, 0xf8, 0x86, 0xbe, 0x74, 0x18,
+	0x04, 0x87, 0x1e, 0x5e, 0xe5, 0x40, 0x95, 0xe6, 0xc1, 0x2a, 0x75, 0xeb, 0x98, 0x50, 0xbb, 0xde,
+	0x10, 0x78, 0xfa, 0x62, 0x3b, 0xc0, 0x49, 0x68, 0x37, 0x1a, 0x38, 0x24, 0x72, 0x7e, 0xb9, 0x75,
+	0xf1, 0x86, 0xcb, 0x96, 0xae, 0x06, 0xf5, 0x7a, 0xe0, 0x4b, 0x08, 0x23, 0x0b, 0x82, 0xda, 0xe4,
+	0xc8, 0x73, 0x09, 0x95, 0x30, 0x6f, 0x64, 0xc1, 0x1c, 0xbb, 0xc4, 0xad, 0xb8, 0x9e, 0x4b, 0x4f,
+	0x33, 0xa1, 0x48, 0xcd, 0x0e, 0xb1, 0xc3, 0x97, 0xf3, 0x9a, 0x84, 0xe2, 0x50, 0x42, 0xbd, 0xae,
+	0x80, 0x72, 0x82, 0xba, 0xed, 0xfa, 0x3d, 0x48, 0xd5, 0x5c, 0x42, 0x83, 0xf0, 0x34, 0x93, 0xf5,
+	0x04, 0xea, 0xcb, 0x26, 0x6e, 0x4a, 0xc5, 0xea, 0x2b, 0x0a, 0x98, 0x10, 0x37, 0x3c, 0xb7, 0x6a,
+	0x53, 0x37, 0x52, 0x84, 0xf1, 0x07, 0x1a, 0x2c, 0x6f, 0x63, 0x52, 0x0d, 0xdd, 0x0a, 0x7e, 0x1e,
+	0x84, 0x47, 0x07, 0x5e, 0x70, 0x72, 0xff, 0x05, 0xae, 0x36, 0x19, 0x8c, 0x89, 0xbf, 0x6c, 0x62,
+	0x42, 0xd1, 0x0c, 0x0c, 0x0a, 0x46, 0x4b, 0xda, 0xb2, 0xb6, 0x32, 0x6c, 0xca, 0x5f, 0xe8, 0x53,
+	0x40, 0x27, 0x12, 0xc7, 0xc2, 0x11, 0x52, 0xa9, 0xb0, 0xac, 0xad, 0x8c, 0xac, 0xbd, 0x59, 0x6e,
+	0xdd, 0xdc, 0x86, 0x5b, 0x3e, 0xbe, 0x51, 0xee, 0x5c, 0x62, 0xf2, 0xa4, 0x7d, 0xc8, 0xf8, 0x47,
+	0x0d, 0x2e, 0x77, 0xe1, 0x89, 0x34, 0x02, 0x9f, 0x60, 0x34, 0x07, 0x43, 0x4c, 0x30, 0xc7, 0x72,
+	0x1d, 0xce, 0xd6, 0x80, 0x79, 0x9e, 0xff, 0xde, 0x71, 0xd0, 0x65, 0x18, 0x95, 0x3a, 0xb3, 0x6c,
+	0xc7, 0x09, 0x39, 0x47, 0xc3, 0xe6, 0x88, 0x1c, 0xdb, 0x70, 0x9c, 0x10, 0xad, 0xc3, 0x4c, 0xbd,
+	0x49, 0xed, 0x8a, 0x87, 0x2d, 0x42, 0x6d, 0x8a, 0x2d, 0xd7, 0xb7, 0xaa, 0x76, 0xb5, 0x86, 0x4b,
+	0x45, 0x0e, 0x7c, 0x51, 0xce, 0xee, 0xb3, 0xc9, 0x1d, 0x7f, 0x8b, 0x4d, 0xa1, 0xf7, 0x61, 0xae,
+	0x03, 0xc9, 0xb1, 0xa9, 0x5d, 0xb1, 0x09, 0x2e, 0x9d, 0xe3, 0x78, 0x33, 0xad, 0x78, 0xdb, 0x72,
+	0xd6, 0xf8, 0x5b, 0x0d, 0xf4, 0x48, 0xa6, 0x47, 0x82, 0x8f, 0x47, 0x01, 0xa1, 0x91, 0x86, 0x5f,
+	0x87, 0xd1, 0x5a, 0x40, 0x28, 0x67, 0x17, 0x13, 0x22, 0xf4, 0xfc, 0xe8, 0x35, 0x73, 0x84, 0x8d,
+	0x6e, 0x88, 0x41, 0x34, 0x9f, 0x92, 0x98, 0x89, 0x34, 0xf0, 0xe8, 0xb5, 0x44, 0xe6, 0xe7, 0x99,
+	0x7b, 0x51, 0xec, 0x67, 0x2f, 0x1e, 0xbd, 0x96, 0xb1, 0x1b, 0x9b, 0x63, 0x30, 0xe2, 0x48, 0xc6,
+	0xad, 0xca, 0xa9, 0xf1, 0x9d, 0xc4, 0x5e, 0xf6, 0xd9, 0xd2, 0xdb, 0x2e, 0xa1, 0xa1, 0x5b, 0x69,
+	0xb1, 0x97, 0x79, 0x18, 0x6e, 0xd8, 0x87, 0xd8, 0x22, 0xee, 0xf7, 0xb0, 0xdc, 0x9b, 0x21, 0x36,
+	0xb0, 0xef, 0x7e, 0x0f, 0xa3, 0x59, 0x38, 0xcf, 0x27, 0x23, 0x21, 0xcc, 0x41, 0xf6, 0x73, 0xc7,
+	0x31, 0xfe, 0x39, 0xb5, 0xed, 0x19, 0xa4, 0xe5, 0xb6, 0xaf, 0xc0, 0x84, 0xdf, 0xac, 0x57, 0x70,
+	0x68, 0x05, 0x07, 0x16, 0x17, 0x9e, 0xc8, 0x25, 0xc6, 0xc5, 0xf8, 0xd3, 0x03, 0x8e, 0x4c, 0xd0,
+	0x77, 0x61, 0x50, 0xce, 0x17, 0x96, 0x8b, 0x2b, 0x23, 0x6b, 0xdb, 0xe5, 0x4c, 0x77, 0x53, 0xee,
+	0xb9, 0x66, 0x59, 0x10, 0xbc, 0xef, 0xd3, 0xf0, 0xd4, 0x94, 0x34, 0xf5, 0xf7, 0x61, 0x24, 0x35,
+	0x8c, 0x26, 0xa0, 0x78, 0x84, 0x4f, 0x25, 0x27, 0xec, 0x4f, 0x34, 0x05, 0x03, 0xc7, 0xb6, 0xd7,
+	0xc4, 0xd2, 0xfa, 0xc4, 0x8f, 0x3b, 0x85, 0xdb, 0x9a, 0xf1, 0xf3, 0x02, 0xcc, 0x67, 0xda, 0x42,
+	0xdf, 0x22, 0xce, 0xc3, 0x70, 0x64, 0x11, 0x42, 0xca, 0x01, 0x73, 0x48, 0x1a, 0x04, 0x41, 0x1f,
+	0xc1, 0xa8, 0x38, 0xa7, 0x29, 0xc3, 0x1e, 0x59, 0xbb, 0xda, 0xaa, 0x05, 0xe1, 0x1b, 0xb8, 0x1a,
+	0x38, 0x2c, 0x37, 0xf4, 0x1d, 0xff, 0x20, 0x30, 0x47, 0x9c, 0x64, 0x00, 0xdd, 0x82, 0x59, 0xb1,
+	0x50, 0x35, 0xf0, 0x69, 0x18, 0x78, 0x1e, 0x0e, 0xf9, 0x11, 0x68, 0x12, 0x69, 0xf7, 0xd3, 0x7c,
+	0x7a, 0x2b, 0x9e, 0xdd, 0xe7, 0x93, 0xa8, 0x04, 0xe7, 0x23, 0x93, 0x1e, 0xe0, 0x70, 0xd1, 0x4f,
+	0xf4, 0x5d, 0x40, 0x0d, 0xfb, 0xd4, 0x0b, 0x6c, 0x87, 0x9b, 0x09, 0xa7, 0x46, 0x4a, 0x83, 0x7c,
+	0xa7, 0xca, 0xdd, 0x79, 0xdc, 0x13, 0x78, 0xcc, 0x9a, 0xd8, 0x32, 0xc4, 0x9c, 0x68, 0xb4, 0x8d,
+	0x18, 0x65, 0x98, 0xdc, 0xf2, 0x02, 0x22, 0xf6, 0x34, 0x32, 0x4b, 0xb5, 0xc7, 0x30, 0xa6, 0x00,
+	0xa5, 0xe1, 0xc5, 0x46, 0x18, 0xff, 0xa6, 0xc1, 0xa4, 0x89, 0xeb, 0xc1, 0x31, 0x7e, 0x66, 0x93,
+	0xa3, 0xde, 0x64, 0xd0, 0x3d, 0x18, 0x66, 0x41, 0xc4, 0xa2, 0xa7, 0x0d, 0xb1, 0xef, 0xe3, 0x6b,
+	0xcb, 0x2a, 0x59, 0x18, 0xc9, 0x67, 0xa7, 0x0d, 0x6c, 0x0e, 0x51, 0xf9, 0x17, 0x3b, 0x1a, 0x1c,
+	0xdd, 0x75, 0xf8, 0x66, 0x15, 0xcd, 0x41, 0xf6, 0x73, 0xc7, 0x41, 0x5b, 0x70, 0x21, 0x09, 0x3c,
+	0x16, 0x0b, 0x87, 0x5c, 0xed, 0x23, 0x6b, 0x7a, 0x59, 0x84, 0xc2, 0x72, 0x14, 0x0a, 0xcb, 0xcf,
+	0xa2, 0x58, 0x69, 0x8e, 0x27, 0x28, 0x6c, 0x90, 0x79, 0x45, 0x19, 0x94, 0x2c, 0xdf, 0xae, 0x63,
+	0xb9, 0x21, 0x23, 0x72, 0xec, 0x89, 0x5d, 0xc7, 0x4c, 0x0d, 0x69, 0x79, 0xa5, 0x1a, 0x7e, 0x9f,
+	0xab, 0x81, 0x60, 0xfa, 0x49, 0x13, 0x37, 0x71, 0x0e, 0x35, 0xb4, 0xaf, 0x54, 0xe8, 0x58, 0xa9,
+	0x55, 0x53, 0xc5, 0x7e, 0x35, 0x25, 0x18, 0x4d, 0x38, 0x92, 0x8c, 0xfe, 0xa1, 0x06, 0x53, 0xd1,
+	0xc1, 0xfa, 0xe6, 0xf0, 0xfa, 0x14, 0xa6, 0xdb, 0x98, 0x92, 0xe7, 0xfc, 0x16, 0xcc, 0x36, 0xc2,
+	0xa0, 0x8a, 0x09, 0x71, 0xfd, 0x43, 0x8b, 0xc7, 0x6f, 0x11, 0x57, 0xd8, 0x71, 0x2f, 0xb2, 0x43,
+	0x95, 0x4c, 0x73, 0x4c, 0x1e, 0x54, 0x88, 0x71, 0x17, 0x16, 0x1f, 0x62, 0x6a, 0x26, 0xb1, 0x7c,
+	0xa3, 0x7a, 0x24, 0xa6, 0x72, 0x58, 0x7a, 0x1d, 0x96, 0x94, 0xc8, 0x92, 0xaf, 0x8f, 0x00, 0xec,
+	0xea, 0x51, 0x9a, 0x95, 0x91, 0xb5, 0x6f, 0xa9, 0x04, 0xce, 0xa0, 0x64, 0x0e, 0xdb, 0x11, 0x4d,
+	0xe3, 0x3f, 0x0a, 0x70, 0xf5, 0x21, 0xa6, 0x9d, 0x61, 0xdc, 0x3e, 0x91, 0xae, 0xef, 0xb3, 0xb5,
+	0x57, 0x93, 0x66, 0xa0, 0x8f, 0x61, 0x84, 0x50, 0x3b, 0xa4, 0x16, 0x3e, 0xc6, 0x3e, 0x95, 0xee,
+	0xf1, 0x2d, 0x95, 0x9c, 0x9f, 0xe1, 0x90, 0xb0, 0x18, 0x29, 0x98, 0xde, 0xa1, 0xb8, 0x6e, 0x02,
+	0x47, 0xbf, 0xcf, 0xb0, 0xd1, 0x43, 0x18, 0xc6, 0xbe, 0x23, 0x49, 0x9d, 0xeb, 0x9b, 0xd4, 0x10,
+	0xf6, 0x1d, 0x41, 0xa8, 0x25, 0x76, 0x0e, 0xb4, 0xc5, 0xce, 0x37, 0xe1, 0x82, 0x8f, 0x5f, 0x50,
+	0x8b, 0x43, 0xd0, 0xe0, 0x08, 0xfb, 0xa5, 0xc1, 0x65, 0x6d, 0x65, 0xd4, 0x1c, 0x63, 0xc3, 0x7b,
+	0xf6, 0x21, 0x7e, 0xc6, 0x06, 0x8d, 0x7f, 0xd5, 0x60, 0xa5, 0xb7, 0xd6, 0xe5, 0x76, 0x67, 0x10,
+	0xd5, 0x32, 0x88, 0xa2, 0x07, 0x70, 0x21, 0xca, 0xaa, 0x2a, 0x36, 0xad, 0xd6, 0x70, 0x14, 0x58,
+	0x17, 0x32, 0xf7, 0x80, 0xa5, 0x3e, 0x9b, 0x5e, 0x50, 0x31, 0xc7, 0x25, 0xd6, 0xa6, 0x40, 0x42,
+	0x4f, 0xe1, 0xc2, 0xb1, 0xd0, 0x80, 0x25, 0x67, 0xb2, 0xd3, 0x14, 0x95, 0xc2, 0xcc, 0xf1, 0xe3,
+	0x96, 0xdf, 0xc6, 0x3f, 0x69, 0xb0, 0xd0, 0x6a, 0xd3, 0xbb, 0x98, 0x10, 0xfb, 0x30, 0x39, 0x0f,
+	0x1f, 0xc2, 0x20, 0x17, 0x2c, 0xb2, 0xe6, 0x95, 0x1c, 0xd6, 0xcc, 0x85, 0x36, 0x25, 0x5e, 0x1e,
+	0x37, 0xf1, 0x39, 0xcc, 0x90, 0x66, 0xa3, 0x11, 0x84, 0x14, 0xb3, 0x38, 0x59, 0x6f, 0x84, 0xec,
+	0xe8, 0x06, 0x3e, 0x29, 0x15, 0x97, 0x8b, 0x2b, 0xe3, 0xea, 0xc8, 0xbb, 0x95, 0xc0, 0x72, 0xd7,
+	0x31, 0x1d, 0x93, 0x49, 0xcd, 0x10, 0xe3, 0xfb, 0x05, 0x58, 0x54, 0x89, 0x29, 0xb7, 0x32, 0x80,
+	0x71, 0x71, 0xee, 0xeb, 0x72, 0x46, 0xca, 0xfb, 0x48, 0x91, 0xfa, 0x74, 0x27, 0x27, 0xf2, 0x9e,
+	0x68, 0x54, 0xa4, 0x3f, 0x63, 0x24, 0x3d, 0xa6, 0xd7, 0x01, 0x75, 0x02, 0x65, 0x24, 0x43, 0x1b,
+	0xe9, 0x64, 0x28, 0x9f, 0x37, 0x89, 0xb9, 0x49, 0x65, 0x4e, 0xff, 0xa0, 0xc1, 0xf2, 0x43, 0x4c,
+	0xb7, 0x1f, 0x7f, 0xd2, 0x65, 0xb3, 0x3f, 0x02, 0x10, 0x51, 0xd4, 0x3f, 0x08, 0xfa, 0x71, 0x5f,
+	0xcc, 0x75, 0xf3, 0xcc, 0x67, 0x98, 0xca, 0xbf, 0x48, 0x97, 0x3d, 0x2d, 0xbc, 0x94, 0x3d, 0x3d,
+	0x85, 0xcb, 0x5d, 0xe4, 0x91, 0xbb, 0xfa, 0x0c, 0x26, 0x53, 0x17, 0x37, 0x8b, 0x71, 0x17, 0xc9,
+	0x75, 0x35, 0xa7, 0x5c, 0xe6, 0x44, 0xd8, 0x3a, 0x40, 0x8c, 0xff, 0xd2, 0xe0, 0x75, 0xb6, 0x36,
+	0xf7, 0xb1, 0x5d, 0xd4, 0xf9, 0x19, 0xcc, 0x79, 0x36, 0xa1, 0x56, 0x88, 0x69, 0xe8, 0xe2, 0x63,
+	0x1c, 0x1b, 0x57, 0x14, 0x5c, 0x46, 0xd6, 0xe6, 0x3b, 0xb2, 0x90, 0x1d, 0x9f, 0xde, 0x7a, 0xf7,
+	0x33, 0xb6, 0x6f, 0xe6, 0x0c, 0xc3, 0x36, 0x23, 0x64, 0x49, 0x7d, 0xc7, 0x89, 0xe9, 0xca, 0x18,
+	0xd7, 0x4a, 0xb7, 0x90, 0x93, 0xee, 0x5e, 0x84, 0x9c, 0xd0, 0x6d, 0x3f, 0xa9, 0xc5, 0xce, 0x34,
+	0x27, 0x80, 0x37, 0xba, 0x4b, 0x2e, 0x15, 0xff, 0x10, 0x86, 0x52, 0x07, 0xa9, 0x6f, 0xc3, 0x8d,
+	0x91, 0x8d, 0xbf, 0xd6, 0x60, 0xca, 0xc4, 0x76, 0xa3, 0xe1, 0x9d, 0x72, 0x2f, 0x4f, 0x5e, 0x51,
+	0xc8, 0xbb, 0x09, 0x83, 0x3c, 0x42, 0x11, 0xe9, 0x71, 0x7b, 0x78, 0x6e, 0x09, 0x6c, 0xcc, 0xc2,
+	0x74, 0x1b, 0xf7, 0x32, 0xe1, 0xfa, 0xe3, 0x02, 0xcc, 0x6d, 0x38, 0xce, 0x3e, 0xb6, 0xc3, 0x6a,
+	0x6d, 0x83, 0x8a, 0x9b, 0x53, 0x9c, 0x75, 0x35, 0x60, 0x82, 0xf0, 0x19, 0xcb, 0x8e, 0xa6, 0xa4,
+	0xd9, 0xde, 0x57, 0xf8, 0x23, 0x25, 0xad, 0x72, 0xdb, 0xb0, 0x70, 0x46, 0x17, 0x48, 0xeb, 0x28,
+	0xba, 0x02, 0xe3, 0x04, 0x57, 0x9b, 0x21, 0xcf, 0x92, 0x79, 0x24, 0x13, 0x7e, 0x7a, 0x2c, 0x1a,
+	0xe5, 0x4e, 0x5d, 0x77, 0x61, 0x2a, 0x8b, 0x5e, 0xda, 0x6f, 0x0d, 0x0b, 0xbf, 0x75, 0x37, 0xed,
+	0xb7, 0xc6, 0xd7, 0xae, 0x64, 0xea, 0x6b, 0xc7, 0x77, 0xf0, 0x0b, 0xec, 0x70, 0xb3, 0xe4, 0x87,
+	0x3d, 0xe5, 0xb1, 0x2e, 0x81, 0x9e, 0x25, 0x94, 0xd4, 0x5f, 0x09, 0x66, 0xa2, 0xd4, 0x70, 0x4b,
+	0xd8, 0xa7, 0x94, 0xd7, 0xf8, 0xcb, 0x22, 0xcc, 0x76, 0x4c, 0x49, 0xb3, 0xac, 0xc1, 0x5c, 0xca,
+	0x29, 0x79, 0x2e, 0xf6, 0xa9, 0x25, 0x43, 0x62, 0x64, 0xa7, 0x6f, 0x67, 0x32, 0xba, 0x1f, 0xfb,
+	0x20, 0x8e, 0x24, 0xc3, 0x2a, 0x31, 0x67, 0x49, 0xf6, 0x04, 0x0b, 0xd5, 0x75, 0xcc, 0x6e, 0x9c,
+	0xa4, 0xe6, 0x36, 0xb8, 0x43, 0xcd, 0xb6, 0xc1, 0xe4, 0x1c, 0xec, 0xc6, 0xe0, 0xdc, 0x95, 0x8e,
+	0xd7, 0x5b, 0x7e, 0x23, 0x1f, 0x26, 0x1a, 0x8c, 0x38, 0xa1, 0x0c, 0x4f, 0x50, 0x2c, 0x72, 0x93,
+	0xd8, 0xea, 0x71, 0x3b, 0x6f, 0x53, 0x42, 0x79, 0x2f, 0x21, 0xc3, 0x28, 0x4b, 0x83, 0x68, 0xb4,
+	0x8e, 0xea, 0x47, 0x30, 0x95, 0x05, 0x98, 0xb1, 0xd3, 0xf7, 0x5a, 0x23, 0x94, 0xd2, 0xb1, 0xb6,
+	0x91, 0x4b, 0xef, 0xf5, 0xdf, 0x17, 0x60, 0xc6, 0xc4, 0xb6, 0xb3, 0xfd, 0xf8, 0x93, 0x76, 0x27,
+	0xba, 0x0e, 0xe7, 0xf8, 0xed, 0x41, 0xe3, 0x66, 0xb4, 0xa4, 0xbc, 0xdf, 0x3e, 0xfe, 0x84, 0x1b,
+	0x10, 0x07, 0x6e, 0xc9, 0xe2, 0x0b, 0xad, 0xb7, 0x16, 0x66, 0xe8, 0x41, 0x33, 0xac, 0x62, 0x4b,
+	0xfa, 0x35, 0xe9, 0xe6, 0xc6, 0xc4, 0xa8, 0x54, 0x16, 0x7a, 0x06, 0x25, 0xd7, 0x67, 0x10, 0xee,
+	0x31, 0xb6, 0x58, 0x7e, 0x9a, 0x72, 0xb1, 0xe7, 0x7a, 0xbb, 0xd8, 0xe9, 0x18, 0xf9, 0xbe, 0x9f,
+	0xf2, 0xb0, 0x2f, 0x23, 0x45, 0x45, 0xcb, 0x30, 0xca, 0x18, 0x8a, 0x05, 0x3c, 0xcf, 0xe9, 0x00,
+	0xf6, 0x9d, 0x7d, 0x79, 0x53, 0xf9, 0x8b, 0x02, 0xcc, 0x76, 0xa8, 0x53, 0x1e, 0x81, 0x33, 0xe9,
+	0x33, 0x33, 0x8e, 0x16, 0xfe, 0x87, 0x71, 0x14, 0xd9, 0x30, 0xd3, 0x41, 0x35, 0x6d, 0xd8, 0x7d,
+	0xa5, 0x1e, 0x53, 0xed, 0xe4, 0xf9, 0xa9, 0xc9, 0xd0, 0xe9, 0xb9, 0xac, 0xb4, 0xff, 0x87, 0x05,
+	0x98, 0xdd, 0x6b, 0x86, 0x87, 0xf8, 0xff, 0xbb, 0x05, 0xb6, 0x1b, 0xcf, 0x40, 0x87, 0xf1, 0xe8,
+	0x50, 0xea, 0xd4, 0x84, 0xf4, 0xba, 0x3f, 0x2d, 0xc0, 0xec, 0x2e, 0xfe, 0x25, 0x50, 0xd3, 0xff,
+	0xd1, 0x41, 0xdd, 0x84, 0xd2, 0x2e, 0xce, 0xd6, 0x75, 0xde, 0xcb, 0xa5, 0xf1, 0xdb, 0x1a, 0xcc,
+	0x9b, 0xf8, 0x20, 0xc4, 0xa4, 0x16, 0x65, 0x32, 0xdc, 0xfe, 0x5f, 0xd1, 0x13, 0xc4, 0x22, 0x5c,
+	0xca, 0xe6, 0x46, 0x9a, 0xd0, 0xcf, 0x0a, 0xb0, 0x60, 0x62, 0x82, 0x7d, 0xa7, 0xed, 0x14, 0x93,
+	0x54, 0x0d, 0x5c, 0x56, 0x5f, 0x65, 0x9a, 0x3c, 0x6c, 0x0e, 0x89, 0x81, 0x1d, 0xe7, 0x7f, 0x2b,
+	0xbd, 0xbb, 0x02, 0xe3, 0x21, 0xae, 0x07, 0xb4, 0xc3, 0xd8, 0xc4, 0x68, 0x64, 0x6c, 0x6d, 0x85,
+	0x8f, 0x73, 0x2f, 0xaf, 0xf0, 0x31, 0x70, 0xf6, 0xc2, 0x87, 0xb1, 0x0c, 0x8b, 0x2a, 0x8d, 0x4a,
+	0xa5, 0xdb, 0x30, 0xff, 0x10, 0xd3, 0xad, 0x30, 0x20, 0x44, 0x8a, 0xd2, 0xae, 0xf1, 0xa4, 0x18,
+	0xae, 0xb5, 0x15, 0xc3, 0xaf, 0xc0, 0x38, 0xb5, 0xc3, 0x43, 0x4c, 0x63, 0xd5, 0xc8, 0xcc, 0x50,
+	0x8c, 0x4a, 0x7a, 0xc6, 0xbf, 0x17, 0xe1, 0x52, 0xf6, 0x1a, 0xd2, 0x9e, 0x8f, 0x60, 0x5c, 0x78,
+	0xf8, 0xca, 0xa9, 0x38, 0x12, 0x3d, 0x32, 0xda, 0x6e, 0xc4, 0x78, 0xb1, 0x90, 0x6c, 0x9e, 0xf2,
+	0x73, 0x24, 0x12, 0x98, 0x51, 0x9a, 0x1a, 0x42, 0xbf, 0x01, 0xd3, 0x07, 0xb6, 0xeb, 0xb1, 0x2c,
+	0xcf, 0x6e, 0x12, 0x9c, 0xac, 0x29, 0x82, 0xd6, 0xc7, 0x67, 0x59, 0xf3, 0x01, 0x27, 0xb8, 0xc5,
+	0xe8, 0xb5, 0xac, 0x8c, 0x0e, 0x3a, 0x26, 0xf4, 0x2f, 0x61, 0xb2, 0x83, 0xc5, 0x8c, 0xcb, 0xfd,
+	0x83, 0xd6, 0xd4, 0xe9, 0xba, 0xf2, 0x4e, 0xdc, 0xc6, 0x94, 0xdc, 0xb8, 0xf4, 0x0d, 0x5f, 0xff,
+	0x12, 0x66, 0x15, 0x1c, 0x66, 0x2c, 0xfc, 0x61, 0x6b, 0x76, 0xae, 0xb4, 0xbb, 0x87, 0x98, 0xb2,
+	0xf5, 0x52, 0x84, 0xd3, 0x69, 0x1b, 0x2b, 0x96, 0x09, 0xf5, 0x38, 0x1d, 0x6a, 0x63, 0x97, 0x75,
+	0x0f, 0x53, 0x9c, 0xe3, 0x0d, 0x21, 0xa7, 0x89, 0xa1, 0xe7, 0xc2, 0x82, 0xac, 0x50, 0xee, 0x08,
+	0x91, 0x79, 0x42, 0x1f, 0x6a, 0x13, 0x88, 0x8c, 0x70, 0xf2, 0x8b, 0xa0, 0x37, 0x60, 0xec, 0x00,
+	0xd3, 0x6a, 0xed, 0x09, 0x16, 0xce, 0x8a, 0x1f, 0xec, 0x21, 0xb3, 0x75, 0xd0, 0x20, 0x70, 0x2d,
+	0x87, 0xb0, 0xd2, 0xda, 0x1f, 0xc0, 0x40, 0x54, 0x6d, 0x38, 0xe3, 0xce, 0x72, 0x74, 0xe3, 0xfb,
+	0x1a, 0xcc, 0xb2, 0x1b, 0xf7, 0xa9, 0x6f, 0xd7, 0xdd, 0xea, 0x56, 0xe0, 0x1f, 0xb8, 0x87, 0x91,
+	0x46, 0x97, 0x60, 0xa4, 0xca, 0x07, 0xc4, 0x75, 0x5d, 0xb8, 0x4a, 0x10, 0x43, 0xbc, 0xae, 0xb6,
+	0x0d, 0xe7, 0x0f, 0x5c, 0x8f, 0xe2, 0x30, 0x4a, 0xd6, 0xde, 0x52, 0x5d, 0x15, 0xd2, 0xe4, 0x1f,
+	0x70, 0x14, 0x33, 0x42, 0x35, 0x9e, 0x42, 0xa9, 0x93, 0x83, 0x38, 0x9b, 0x94, 0x76, 0xa4, 0xe5,
+	0xb9, 0x15, 0x0b, 0x58, 0xe3, 0x77, 0x34, 0xd0, 0x3f, 0x6d, 0x38, 0x36, 0xc5, 0x67, 0x13, 0xeb,
+	0x09, 0x8c, 0x49, 0x00, 0x4e, 0x2f, 0x12, 0xee, 0x5a, 0x1e, 0xe1, 0x44, 0xd4, 0x1f, 0xad, 0x26,
+	0x3f, 0x88, 0xb1, 0x00, 0xf3, 0x99, 0xec, 0x48, 0xe7, 0xf9, 0x03, 0x1e, 0x60, 0x99, 0xe3, 0xc5,
+	0xaf, 0x72, 0x1b, 0x78, 0x60, 0xcd, 0xe2, 0x42, 0xb2, 0xf9, 0x23, 0x8d, 0x5d, 0x98, 0xeb, 0xae,
+	0xbf, 0x8d, 0x99, 0x29, 0x46, 0x61, 0xef, 0x15, 0xa5, 0x01, 0x7f, 0xa6, 0xc1, 0x7c, 0x26, 0x37,
+	0xd2, 0x70, 0xae, 0x26, 0x25, 0x71, 0x87, 0x43, 0x08, 0xa7, 0x30, 0x14, 0xd7, 0xbc, 0x05, 0x9e,
+	0x83, 0xde, 0x01, 0x14, 0xb3, 0x45, 0x62, 0xd8, 0x02, 0x87, 0x9d, 0x4c, 0x66, 0x52, 0xe0, 0xa9,
+	0xf7, 0xbe, 0x08, 0xbc, 0x28, 0xc0, 0x93, 0x19, 0x09, 0xce, 0x4c, 0xf1, 0x12, 0x67, 0x73, 0xd7,
+	0x76, 0x7d, 0x6a, 0xbb, 0xfe, 0x2b, 0x56, 0xdb, 0x57, 0x1a, 0x2c, 0x28, 0xf8, 0xf9, 0x66, 0x29,
+	0xee, 0x83, 0xec, 0x42, 0xe0, 0x73, 0x9b, 0xe2, 0xb0, 0x6e, 0x87, 0x47, 0x3d, 0xf4, 0x67, 0xfc,
+	0x58, 0x83, 0x2b, 0x3d, 0x08, 0x48, 0x81, 0x4b, 0x70, 0x3e, 0x8a, 0x0a, 0x82, 0x44, 0xf4, 0x13,
+	0x3d, 0x07, 0x5d, 0xd6, 0x57, 0x05, 0x3a, 0x96, 0xc9, 0x94, 0x78, 0xe6, 0x2d, 0xf4, 0x7c, 0xe6,
+	0x9d, 0x15, 0xf5, 0xd5, 0x08, 0x99, 0x27, 0x53, 0x6c, 0x96, 0x3b, 0xdd, 0x1d, 0x42, 0x9a, 0x58,
+	0xb0, 0x27, 0xde, 0x33, 0x7a, 0x18, 0x04, 0x82, 0x73, 0x76, 0xc3, 0x15, 0x27, 0x7c, 0xd8, 0xe4,
+	0x7f, 0x33, 0xcf, 0x40, 0xa9, 0x67, 0x11, 0x5c, 0x0d, 0x7c, 0x87, 0xc8, 0x97, 0x69, 0xa0, 0xd4,
+	0xdb, 0x17, 0x23, 0x4c, 0x36, 0xd2, 0xac, 0x7c, 0x81, 0xab, 0x54, 0x36, 0x03, 0x44, 0x3f, 0x8d,
+	0xeb, 0x50, 0xea, 0xe4, 0x40, 0x6a, 0x64, 0x0a, 0x06, 0x92, 0xfb, 0xc0, 0xb0, 0x29, 0x7e, 0x18,
+	0x3f, 0xd1, 0x60, 0x6e, 0x9f, 0x62, 0xdb, 0x63, 0x61, 0xe4, 0xb1, 0x4b, 0xe8, 0x63, 0x6c, 0x13,
+	0xdc, 0x8b, 0xed, 0x3b, 0xf2, 0x85, 0xd6, 0x73, 0x09, 0x2d, 0x15, 0xba, 0x38, 0xf1, 0x88, 0xaa,
+	0x78, 0x9e, 0x65, 0x7f, 0xa1, 0x87, 0x30, 0x1e, 0xe3, 0xa6, 0x9f, 0x78, 0x2f, 0x77, 0x25, 0xc0,
+	0x6f, 0x7f, 0xa3, 0x34, 0xf5, 0xcb, 0x78, 0x0f, 0xf4, 0x2c, 0xce, 0x93, 0x76, 0xa5, 0xd0, 0xf6,
+	0x93, 0xaa, 0x79, 0xd1, 0x3c, 0xcf, 0x7f, 0xef, 0x38, 0xac, 0x3a, 0xbc, 0x10, 0x95, 0xb9, 0x22,
+	0xe4, 0x56, 0xe7, 0xfc, 0x8d, 0x96, 0xfb, 0x3f, 0x87, 0x60, 0x51, 0xc5, 0xbe, 0x14, 0xbe, 0x0c,
+	0x17, 0xfd, 0x66, 0xdd, 0x0a, 0xb1, 0xed, 0x58, 0x0d, 0x3b, 0xa4, 0x2e, 0x8d, 0x6b, 0x95, 0x03,
+	0xe6, 0xa4, 0xdf, 0xac, 0x9b, 0xd8, 0x76, 0xf6, 0xe2, 0x09, 0x74, 0x1d, 0xa6, 0x18, 0xfc, 0x49,
+	0xe8, 0x52, 0x9c, 0x46, 0x10, 0x97, 0x6b, 0xe4, 0x37, 0xeb, 0xcf, 0xd9, 0x54, 0x0a, 0xe3, 0x3e,
+	0x2c, 0x1d, 0x04, 0xe1, 0x89, 0x1d, 0x3a, 0x38, 0xb4, 0xea, 0xf6, 0x0b, 0x2b, 0x68, 0x52, 0x42,
+	0x6d, 0xdf, 0x61, 0x4f, 0xeb, 0x8d, 0xc0, 0xf3, 0x84, 0xe1, 0x0e, 0x98, 0x97, 0x62, 0xb0, 0x5d,
+	0xfb, 0xc5, 0xd3, 0x04, 0x68, 0x8f, 0xc1, 0x74, 0x27, 0x43, 0xe3, 0xac, 0x4a, 0x4d, 0x46, 0xd4,
+	0x84, 0x3e, 0x84, 0x85, 0x56, 0x32, 0xa1, 0xcd, 0x04, 0xc1, 0xa1, 0x3c, 0x45, 0xf2, 0x32, 0x3e,
+	0x97, 0x26, 0x62, 0xda, 0x14, 0xef, 0xe1, 0x50, 0x1c, 0x2a, 0xb4, 0xdd, 0xce, 0x48, 0xb5, 0xe6,
+	0x7a, 0x4e, 0x88, 0x7d, 0x4e, 0xc5, 0x0f, 0x1c, 0xcc, 0x6f, 0xeb, 0x03, 0xe6, 0x7c, 0x9a, 0xc6,
+	0x96, 0x04, 0xda, 0xc3, 0xe1, 0x93, 0xc0, 0xc1, 0xe8, 0x2d, 0x98, 0xc4, 0xbe, 0xe8, 0x57, 0x3b,
+	0xf5, 0xab, 0x56, 0x9d, 0xbd, 0xc0, 0xf2, 0x0b, 0xfc, 0x90, 0x79, 0x41, 0x4c, 0xec, 0x9f, 0xfa,
+	0xd5, 0x5d, 0x36, 0x8c, 0xee, 0x82, 0x2e, 0x61, 0x71, 0xa3, 0x86, 0xeb, 0x38, 0xb4, 0x3d, 0x2b,
+	0x31, 0xae, 0x21, 0x8e, 0x34, 0x2b, 0x20, 0xee, 0x47, 0x00, 0xd1, 0x76, 0xa3, 0x3d, 0xb8, 0xe2,
+	0x05, 0x52, 0xd3, 0x16, 0x7e, 0xd1, 0x70, 0x43, 0x51, 0x0d, 0x73, 0x7d, 0x8a, 0xc3, 0x63, 0x3b,
+	0xf1, 0x1e, 0xc3, 0xdc, 0xf4, 0x2f, 0x7b, 0x81, 0xd0, 0xf8, 0xfd, 0x18, 0x74, 0x47, 0x42, 0x46,
+	0x4e, 0x65, 0x01, 0x40, 0x9c, 0x17, 0x5e, 0xbc, 0x00, 0x8e, 0x36, 0xcc, 0x47, 0x78, 0xf5, 0x62,
+	0x15, 0xa6, 0x58, 0xa6, 0x2d, 0xef, 0x62, 0x4c, 0x00, 0x01, 0x38, 0x22, 0x4c, 0xea, 0x50, 0x64,
+	0xfc, 0x84, 0xbf, 0x39, 0x73, 0x84, 0x7b, 0x30, 0xdf, 0xe4, 0xe9, 0x91, 0xc5, 0x7a, 0x1b, 0x3a,
+	0xf8, 0x1a, 0xe5, 0x0b, 0x94, 0x04, 0xc8, 0x46, 0xf5, 0xa8, 0x9d, 0x9d, 0xa7, 0x70, 0xc5, 0x75,
+	0x3c, 0x9c, 0x68, 0xc4, 0xaa, 0xd6, 0x70, 0x16, 0xa1, 0x31, 0x4e, 0x68, 0x99, 0x01, 0xc7, 0x87,
+	0xa1, 0x86, 0x3b, 0x09, 0x6e, 0xc1, 0x12, 0xdb, 0xd6, 0x84, 0x9e, 0x20, 0xef, 0xd6, 0x71, 0x4c,
+	0x6a, 0x9c, 0x93, 0xd2, 0xeb, 0xf6, 0x8b, 0x88, 0xd2, 0x0e, 0xa3, 0xea, 0xd6, 0x71, 0x44, 0x64,
+	0x13, 0x16, 0xeb, 0xae, 0xa8, 0x39, 0x5a, 0xb4, 0x16, 0x06, 0x94, 0x7a, 0xcc, 0x50, 0x2b, 0xcd,
+	0x90, 0x50, 0xa1, 0x8f, 0x0b, 0x5c, 0x1f, 0x7a, 0xdd, 0xe5, 0x77, 0xe9, 0x67, 0x31, 0xcc, 0x26,
+	0x03, 0xe1, 0x8a, 0xb9, 0x03, 0x7a, 0xcc, 0x88, 0x08, 0x98, 0x69, 0x7d, 0x4e, 0x70, 0xfc, 0x19,
+	0xc9, 0x83, 0x88, 0x9b, 0x89, 0x52, 0x3f, 0x06, 0xa3, 0xfd, 0x80, 0x58, 0x76, 0xa3, 0x81, 0x7d,
+	0x87, 0x30, 0x7e, 0x30, 0xa9, 0x05, 0x9e, 0x53, 0x9a, 0xe4, 0x34, 0x96, 0x82, 0xd6, 0x53, 0xb2,
+	0x21, 0xe0, 0x9e, 0x45, 0x60, 0xe8, 0x1d, 0xb8, 0x18, 0x33, 0x92, 0xe2, 0x00, 0x71, 0xec, 0x09,
+	0xc9, 0x41, 0xbc, 0xb6, 0xf1, 0x57, 0xe2, 0x4e, 0x11, 0xa9, 0xe6, 0x53, 0x62, 0x1f, 0xfe, 0x62,
+	0xc4, 0x89, 0x63, 0x7e, 0x13, 0x69, 0xe3, 0x5b, 0x3a, 0xca, 0x05, 0xf9, 0x76, 0x5d, 0x0d, 0x9a,
+	0x3e, 0x95, 0x71, 0x82, 0xb3, 0xbc, 0xc5, 0x06, 0xd8, 0x34, 0x6f, 0x96, 0xab, 0x9c, 0x52, 0x2c,
+	0xbc, 0x61, 0xd1, 0x1c, 0x66, 0x23, 0x9b, 0x6c, 0x00, 0x5d, 0x82, 0x61, 0x4c, 0xa8, 0x5b, 0xb7,
+	0x93, 0xa4, 0x27, 0x19, 0x30, 0xfe, 0xa6, 0x00, 0x73, 0xe2, 0x86, 0x10, 0x33, 0x67, 0x1f, 0x92,
+	0x5f, 0x04, 0x95, 0xa1, 0x27, 0x70, 0x8e, 0xda, 0x87, 0xcc, 0xf7, 0xb2, 0x8b, 0xc7, 0x1d, 0xc5,
+	0xc5, 0x43, 0x29, 0x5c, 0x99, 0xfd, 0x2d, 0xca, 0x1c, 0x9c, 0x8e, 0xfe, 0x1e, 0x0c, 0xc7, 0x43,
+	0x19, 0x6f, 0x41, 0xea, 0xd6, 0xcd, 0xaf, 0xe2, 0x4b, 0x5f, 0xeb, 0x32, 0x72, 0xfb, 0x9e, 0x4a,
+	0x3e, 0x45, 0x4d, 0xe8, 0x6e, 0x1f, 0x7c, 0xc6, 0x15, 0xa1, 0x97, 0xc4, 0xe8, 0x4f, 0x34, 0x98,
+	0x15, 0xd4, 0xf3, 0x6f, 0xf5, 0x63, 0xc9, 0xbd, 0xb8, 0xde, 0xdd, 0x56, 0x70, 0xaf, 0xa0, 0xfa,
+	0xf2, 0x58, 0xff, 0x17, 0x0d, 0x46, 0xd3, 0x0b, 0xb0, 0xa4, 0x34, 0x75, 0x27, 0xe5, 0x7f, 0x67,
+	0x98, 0x56, 0xe1, 0x6c, 0xa6, 0xb5, 0x21, 0x85, 0x16, 0x45, 0x98, 0x77, 0x14, 0x42, 0xa7, 0xf9,
+	0x79, 0x79, 0x92, 0x7e, 0x0e, 0xa5, 0x4e, 0x6d, 0x4a, 0x53, 0xda, 0x04, 0x88, 0x05, 0x8c, 0x0c,
+	0xea, 0xf5, 0x1c, 0xdc, 0x09, 0x77, 0xc1, 0x7e, 0x11, 0xe3, 0xcf, 0x35, 0x58, 0xde, 0xb4, 0xab,
+	0x47, 0x07, 0xae, 0xe7, 0x75, 0xdc, 0x51, 0x7a, 0x59, 0x43, 0xce, 0x72, 0xd6, 0x1a, 0x4c, 0x57,
+	0xbd, 0x80, 0x60, 0xc7, 0x3a, 0x71, 0x69, 0xcd, 0xf5, 0xdb, 0xee, 0x09, 0x17, 0xc5, 0xe4, 0x73,
+	0x3e, 0x17, 0x85, 0xad, 0x09, 0x28, 0x86, 0x8d, 0x28, 0x93, 0x62, 0x7f, 0x1a, 0x77, 0xe0, 0x72,
+	0x17, 0x46, 0xa5, 0x4a, 0xa6, 0x61, 0xf0, 0x8b, 0xa0, 0x92, 0xd4, 0xd3, 0x07, 0xbe, 0x08, 0x2a,
+	0x3b, 0x0e, 0xbb, 0x84, 0xbd, 0x29, 0x2b, 0x1f, 0x1c, 0x75, 0x83, 0xe5, 0x3a, 0xd1, 0x8d, 0x33,
+	0x5f, 0x1e, 0xfd, 0x9d, 0xa8, 0x16, 0xd3, 0x14, 0xc9, 0x88, 0x74, 0x74, 0x6b, 0xaa, 0x7a, 0x57,
+	0xc6, 0x12, 0x12, 0xd3, 0x6c, 0x25, 0x64, 0x5c, 0x83, 0xab, 0x3d, 0x79, 0x93, 0xa5, 0x8f, 0x7b,
+	0xa9, 0x7e, 0x9c, 0xfe, 0x65, 0x60, 0xd7, 0xbd, 0x37, 0xba, 0xe3, 0x4b, 0x35, 0x76, 0x08, 0xab,
+	0xbd, 0x2c, 0x61, 0x3f, 0x86, 0x25, 0x93, 0x6d, 0x71, 0xd5, 0xf5, 0x70, 0xb4, 0x8d, 0x14, 0xfb,
+	0x79, 0xac, 0x4d, 0x9a, 0x44, 0x21, 0x31, 0x89, 0xf7, 0x61, 0x59, 0x4d, 0xac, 0xbb, 0x45, 0xdc,
+	0x15, 0xe7, 0xea, 0x4c, 0x75, 0x2e, 0xe3, 0xd7, 0x60, 0x2e, 0x03, 0x59, 0x2e, 0xb8, 0x05, 0xe7,
+	0xb1, 0x4f, 0x43, 0x37, 0xee, 0xac, 0xcb, 0x55, 0xae, 0x13, 0xce, 0x22, 0xc2, 0x34, 0x8e, 0x00,
+	0x75, 0x4e, 0x67, 0x7a, 0xb9, 0x0d, 0x18, 0x94, 0xc5, 0xc1, 0x62, 0xbf, 0xc5, 0x41, 0x89, 0x68,
+	0xfc, 0x9e, 0x06, 0xa8, 0x73, 0xfa, 0x4c, 0x25, 0xcf, 0x97, 0x54, 0x02, 0xfc, 0x1c, 0x2e, 0x66,
+	0xcc, 0x67, 0xca, 0xbf, 0xde, 0xfa, 0xb2, 0x90, 0x8b, 0xcb, 0xb5, 0x9f, 0x5e, 0x85, 0x21, 0x5e,
+	0x7d, 0xda, 0xd8, 0xdb, 0x41, 0xbf, 0xab, 0xc1, 0x9c, 0xf2, 0x5b, 0x22, 0xf4, 0x5e, 0x8f, 0xa6,
+	0x13, 0xd5, 0x17, 0x51, 0xfa, 0xed, 0xfe, 0x11, 0xa5, 0x05, 0xfd, 0x3a, 0x5c, 0xcc, 0xf8, 0xf6,
+	0x03, 0xdd, 0xe8, 0x41, 0xb0, 0xf3, 0x9b, 0x21, 0x7d, 0xad, 0x1f, 0x14, 0xb9, 0x7a, 0x5a, 0x1d,
+	0x1d, 0xdf, 0xbb, 0xf4, 0x54, 0x87, 0xea, 0x83, 0x1f, 0xfd, 0x76, 0xff, 0x88, 0x92, 0x21, 0x1b,
+	0x20, 0xf9, 0xf0, 0x02, 0xad, 0x28, 0xe8, 0x74, 0x7c, 0xcb, 0xa1, 0x5f, 0xcb, 0x01, 0x99, 0x2c,
+	0x91, 0x7c, 0xd4, 0xa0, 0x5c, 0xa2, 0xe3, 0x3b, 0x0f, 0xfd, 0x5a, 0x0e, 0xc8, 0xf4, 0x12, 0xd1,
+	0xe7, 0x08, 0x5d, 0x96, 0x68, 0xfb, 0x86, 0x42, 0xbf, 0x96, 0x03, 0x52, 0x2e, 0xf1, 0x05, 0x8c,
+	0xb5, 0x7c, 0x45, 0x80, 0xbe, 0xd5, 0x43, 0xe7, 0x2d, 0x0b, 0xbd, 0x9d, 0x0f, 0x58, 0xae, 0xf5,
+	0x43, 0x71, 0xb5, 0xca, 0xfa, 0x48, 0x00, 0xdd, 0xcc, 0xd5, 0x4a, 0xdc, 0xfe, 0x45, 0x82, 0x7e,
+	0xab, 0x5f, 0x34, 0xc9, 0xca, 0x9f, 0x8a, 0x8e, 0xdf, 0xae, 0x9d, 0xec, 0xe8, 0x03, 0x35, 0xf1,
+	0x3c, 0x1f, 0x1e, 0xe8, 0xdf, 0x3e, 0x33, 0xbe, 0xe4, 0xf2, 0xb7, 0x34, 0x98, 0xc9, 0xee, 0xa5,
+	0x46, 0xef, 0xf6, 0xd9, 0x7a, 0x2d, 0x38, 0xba, 0x79, 0xa6, 0x86, 0x6d, 0x7e, 0xbc, 0x95, 0xfd,
+	0xc4, 0xca, 0xe3, 0xdd, 0xab, 0xa3, 0x5a, 0xbf, 0xdd, 0x3f, 0xa2, 0x64, 0xe8, 0x8f, 0x34, 0xfe,
+	0x9e, 0xae, 0x6c, 0xb5, 0x45, 0x77, 0xba, 0x90, 0xee, 0xd1, 0x99, 0xac, 0xdf, 0x3d, 0x13, 0x6e,
+	0x72, 0x9e, 0x5a, 0x7a, 0x5a, 0x95, 0xe7, 0x29, 0xab, 0x6f, 0x57, 0x7f, 0x3b, 0x1f, 0xb0, 0x5c,
+	0xeb, 0x14, 0x50, 0x67, 0x13, 0x28, 0xba, 0xde, 0x6f, 0x13, 0xac, 0x7e, 0xa3, 0x0f, 0x0c, 0xb9,
+	0x74, 0x03, 0x2e, 0xb4, 0x75, 0x50, 0xa2, 0x77, 0xf2, 0x76, 0x5a, 0x8a, 0x45, 0xcb, 0xfd, 0x35,
+	0x66, 0xb2, 0x15, 0xdb, 0xba, 0xf6, 0x94, 0x2b, 0x66, 0x37, 0x4b, 0xea, 0xe5, 0xbc, 0xe0, 0x72,
+	0x45, 0x02, 0x13, 0xed, 0xbd, 0x5e, 0x48, 0x45, 0x43, 0xd1, 0x1e, 0xa7, 0xaf, 0xe6, 0x86, 0x4f,
+	0x16, 0xdd, 0xc5, 0x39, 0x17, 0xdd, 0xc5, 0xfd, 0x2d, 0xaa, 0xec, 0xa6, 0xfa, 0x4d, 0x98, 0xca,
+	0x6a, 0x4b, 0x42, 0x6b, 0x4a, 0x8d, 0x29, 0x3b, 0xaa, 0xf4, 0xf5, 0xbe, 0x70, 0x52, 0x8e, 0x2e,
+	0xbb, 0x4b, 0x47, 0xe9, 0xe8, 0xba, 0xb6, 0x49, 0xe9, 0x37, 0xfb, 0xc4, 0x4a, 0x14, 0x91, 0xd5,
+	0xe5, 0xa2, 0x54, 0x44, 0x97, 0xbe, 0x21, 0x7d, 0xbd, 0x2f, 0x1c, 0xc9, 0xc0, 0x57, 0x1a, 0x5c,
+	0xee, 0xd9, 0x47, 0x81, 0xbe, 0xad, 0x96, 0x2e, 0x57, 0xbb, 0x89, 0xfe, 0xe1, 0xd9, 0x09, 0x24,
+	0x76, 0xda, 0xde, 0xf7, 0xa0, 0xb4, 0x53, 0x45, 0x8b, 0x86, 0xbe, 0x9a, 0x1b, 0x3e, 0x49, 0x72,
+	0x33, 0x7a, 0x11, 0x94, 0x49, 0xae, 0xba, 0x8d, 0x42, 0x5f, 0xeb, 0x07, 0x25, 0x7d, 0x4a, 0x3a,
+	0x7b, 0x0c, 0xba, 0x9c, 0x12, 0x65, 0x5b, 0x84, 0xbe, 0xde, 0x17, 0x8e, 0x64, 0xe0, 0x18, 0x26,
+	0x3b, 0xae, 0x90, 0x68, 0xb5, 0x4b, 0x3d, 0x2d, 0x73, 0xe9, 0xeb, 0xf9, 0x11, 0xe4, 0xba, 0x27,
+	0x30, 0xde, 0xda, 0xa8, 0x80, 0xd4, 0x11, 0x43, 0xd5, 0x62, 0xa1, 0xaf, 0xf5, 0x83, 0x22, 0x17,
+	0xfe, 0x81, 0x06, 0xb3, 0xd1, 0x5b, 0xff, 0x56, 0x10, 0x86, 0xcd, 0x46, 0x9c, 0x38, 0xa1, 0xf5,
+	0x6e, 0xf4, 0x14, 0x0d, 0x0b, 0xfa, 0xbb, 0xfd, 0x21, 0x49, 0x36, 0x7e, 0x2c, 0x3e, 0x04, 0x54,
+	0x3f, 0xc7, 0xa3, 0x7e, 0x52, 0x86, 0xf6, 0x2e, 0x00, 0xfd, 0x57, 0xce, 0x86, 0x9c, 0x1c, 0xc4,
+	0xf6, 0xb7, 0x70, 0xe5, 0x41, 0x54, 0x3c, 0xdb, 0xeb, 0xab, 0xb9, 0xe1, 0x93, 0xcc, 0xa3, 0xf3,
+	0x4d, 0x5a, 0x99, 0x79, 0x28, 0x1f, 0xde, 0xf5, 0x1b, 0x7d, 0x60, 0xa4, 0x42, 0x45, 0xf6, 0xb3,
+	0xb0, 0x32, 0x54, 0x74, 0x7d, 0x04, 0xd7, 0x6f, 0xf6, 0x89, 0xd5, 0xe2, 0x00, 0x5b, 0x9e, 0x5b,
+	0xba, 0x39, 0xc0, 0xac, 0xf7, 0x24, 0x7d, 0x35, 0x37, 0x7c, 0xa2, 0xf7, 0xce, 0x2a, 0xbf, 0x52,
+	0xef, 0xca, 0x87, 0x0b, 0xfd, 0x46, 0xdf, 0x4f, 0x08, 0x4c, 0xde, 0xf6, 0xa2, 0xb2, 0x52, 0x5e,
+	0x45, 0x2d, 0x5f, 0x5f, 0xcd, 0x0d, 0x9f, 0xba, 0x78, 0x28, 0x0b, 0xb8, 0xca, 0x8b, 0x47, 0xaf,
+	0xda, 0xb4, 0x7e, 0xbb, 0x7f, 0x44, 0xc9, 0xd0, 0x9f, 0x68, 0xb0, 0xd4, 0xa3, 0xf0, 0x8a, 0xee,
+	0x75, 0x8f, 0x2d, 0x3d, 0x0a, 0xb1, 0xfa, 0x07, 0x67, 0x45, 0xcf, 0xba, 0x1b, 0x65, 0xf1, 0xd7,
+	0xf3, 0x6e, 0xd4, 0x85, 0xb9, 0xbb, 0x67, 0xc2, 0x95, 0x9c, 0xfd, 0x48, 0x83, 0x92, 0xaa, 0xf6,
+	0x8a, 0x6e, 0x29, 0x23, 0x62, 0xd7, 0xca, 0xaf, 0xfe, 0x5e, 0xdf, 0x78, 0x82, 0x9b, 0xcd, 0x8d,
+	0xbf, 0xfb, 0x7a, 0x51, 0xfb, 0xd9, 0xd7, 0x8b, 0xda, 0xcf, 0xbf, 0x5e, 0xd4, 0x7e, 0x75, 0xfd,
+	0xd0, 0xa5, 0xb5, 0x66, 0xa5, 0x5c, 0x0d, 0xea, 0xab, 0x2d, 0xff, 0xe5, 0xa8, 0x7c, 0x88, 0x7d,
+	0xf1, 0x1f, 0xa1, 0xe2, 0x7f, 0x37, 0x75, 0x97, 0xff, 0x71, 0x7c, 0xa3, 0x32, 0xc8, 0xc7, 0xd7,
+	0xff, 0x7b, 0x00, 0x5d, 0x4c, 0xc2, 0xf4, 0x96, 0x4a, 0x00, 0x00,
+}
+
+func (m *DescribeWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PersistenceInfo) > 0 {
-		for k := range m.PersistenceInfo {
-			v := m.PersistenceInfo[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintService(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.MembershipInfo != nil {
+	if m.WorkflowExecution != nil {
 		{
-			size, err := m.MembershipInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4852,22 +5235,17 @@ func (m *DescribeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.SupportedClientVersions != nil {
-		{
-			size, err := m.SupportedClientVersions.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4877,12 +5255,12 @@ func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReadDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4891,51 +5269,36 @@ func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.PageSize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.InclusiveEndMessageId != nil {
-		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.MutableStateInDatabase) > 0 {
+		i -= len(m.MutableStateInDatabase)
+		copy(dAtA[i:], m.MutableStateInDatabase)
+		i = encodeVarintService(dAtA, i, uint64(len(m.MutableStateInDatabase)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+	if len(m.MutableStateInCache) > 0 {
+		i -= len(m.MutableStateInCache)
+		copy(dAtA[i:], m.MutableStateInCache)
+		i = encodeVarintService(dAtA, i, uint64(len(m.MutableStateInCache)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if len(m.HistoryAddr) > 0 {
+		i -= len(m.HistoryAddr)
+		copy(dAtA[i:], m.HistoryAddr)
+		i = encodeVarintService(dAtA, i, uint64(len(m.HistoryAddr)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeHistoryHostRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4945,12 +5308,12 @@ func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReadDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReadDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4959,50 +5322,66 @@ func (m *ReadDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
-		i--
-		dAtA[i] = 0x22
+	if m.DescribeBy != nil {
+		{
+			size := m.DescribeBy.Size()
+			i -= size
+			if _, err := m.DescribeBy.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if len(m.ReplicationTasksInfo) > 0 {
-		for iNdEx := len(m.ReplicationTasksInfo) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasksInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.ReplicationTasks) > 0 {
-		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeHistoryHostRequest_HostAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryHostRequest_HostAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.HostAddress)
+	copy(dAtA[i:], m.HostAddress)
+	i = encodeVarintService(dAtA, i, uint64(len(m.HostAddress)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *DescribeHistoryHostRequest_ShardId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryHostRequest_ShardId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *DescribeHistoryHostRequest_WorkflowExecution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeHistoryHostRequest_WorkflowExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
-	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeShardDistributionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5012,12 +5391,12 @@ func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeShardDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeShardDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5026,39 +5405,20 @@ func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.InclusiveEndMessageId != nil {
-		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if m.PageId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageId))
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeShardDistributionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5068,12 +5428,12 @@ func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeShardDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeShardDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5082,10 +5442,32 @@ func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Shards) > 0 {
+		for k := range m.Shards {
+			v := m.Shards[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NumberOfShards != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumberOfShards))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeHistoryHostResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5095,12 +5477,12 @@ func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5109,21 +5491,37 @@ func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+	if len(m.PayloadSizeStats) > 0 {
+		for iNdEx := len(m.PayloadSizeStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayloadSizeStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x2a
 	}
-	if m.PageSize != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+	if len(m.ShardControllerStatus) > 0 {
+		i -= len(m.ShardControllerStatus)
+		copy(dAtA[i:], m.ShardControllerStatus)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ShardControllerStatus)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x22
 	}
-	if m.InclusiveEndMessageId != nil {
+	if m.DomainCache != nil {
 		{
-			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.DomainCache.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5131,29 +5529,36 @@ func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.SourceCluster) > 0 {
-		i -= len(m.SourceCluster)
-		copy(dAtA[i:], m.SourceCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ShardId != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+	if len(m.ShardIds) > 0 {
+		dAtA5 := make([]byte, len(m.ShardIds)*10)
+		var j4 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintService(dAtA, i, uint64(j4))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Type != 0 {
-		i = encodeVarintService(dAtA, i, uint64(m.Type))
+	if m.NumberOfShards != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumberOfShards))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *CloseShardRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5163,12 +5568,12 @@ func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *CloseShardRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CloseShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5177,17 +5582,15 @@ func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.NextPageToken) > 0 {
-		i -= len(m.NextPageToken)
-		copy(dAtA[i:], m.NextPageToken)
-		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
+func (m *CloseShardResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5197,12 +5600,12 @@ func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CloseShardResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CloseShardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5211,29 +5614,10 @@ func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+func (m *RemoveTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5243,12 +5627,12 @@ func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveTaskRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5257,48 +5641,16 @@ func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ResendReplicationTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ResendReplicationTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.EndEvent != nil {
-		{
-			size, err := m.EndEvent.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.StartEvent != nil {
+	if m.VisibilityTime != nil {
 		{
-			size, err := m.StartEvent.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.VisibilityTime.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5308,36 +5660,25 @@ func (m *ResendReplicationTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.RemoteCluster) > 0 {
-		i -= len(m.RemoteCluster)
-		copy(dAtA[i:], m.RemoteCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RemoteCluster)))
+	if m.TaskId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
+	if m.TaskType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
+func (m *RemoveTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5347,12 +5688,12 @@ func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResendReplicationTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *RemoveTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResendReplicationTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RemoveTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5364,7 +5705,7 @@ func (m *ResendReplicationTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *GetCrossClusterTasksRequest) Marshal() (dAtA []byte, err error) {
+func (m *ResetQueueRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5374,12 +5715,12 @@ func (m *GetCrossClusterTasksRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetCrossClusterTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetQueueRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5388,36 +5729,27 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TargetCluster) > 0 {
-		i -= len(m.TargetCluster)
-		copy(dAtA[i:], m.TargetCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+	if m.TaskType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ShardIds) > 0 {
-		dAtA28 := make([]byte, len(m.ShardIds)*10)
-		var j27 int
-		for _, num1 := range m.ShardIds {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j27++
-			}
-			dAtA28[j27] = uint8(num)
-			j27++
-		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintService(dAtA, i, uint64(j27))
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetCrossClusterTasksResponse) Marshal() (dAtA []byte, err error) {
+func (m *ResetQueueResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5427,12 +5759,12 @@ func (m *GetCrossClusterTasksResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetCrossClusterTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResetQueueResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResetQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5441,49 +5773,10 @@ func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.FailedCauseByShard) > 0 {
-		for k := range m.FailedCauseByShard {
-			v := m.FailedCauseByShard[k]
-			baseI := i
-			i = encodeVarintService(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.TasksByShard) > 0 {
-		for k := range m.TasksByShard {
-			v := m.TasksByShard[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
-					i = encodeVarintService(dAtA, i, uint64(size))
-				}
-				i--
-				dAtA[i] = 0x12
-			}
-			i = encodeVarintService(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintService(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
-		}
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err error) {
+func (m *DescribeQueueRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5493,12 +5786,12 @@ func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeQueueRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5507,34 +5800,15 @@ func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []b
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.FetchNewTasks {
-		i--
-		if m.FetchNewTasks {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.TaskType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskType))
 		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.TaskResponses) > 0 {
-		for iNdEx := len(m.TaskResponses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+		dAtA[i] = 0x18
 	}
-	if len(m.TargetCluster) > 0 {
-		i -= len(m.TargetCluster)
-		copy(dAtA[i:], m.TargetCluster)
-		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -5546,7 +5820,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err error) {
+func (m *DescribeQueueResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5556,12 +5830,12 @@ func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeQueueResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5570,22 +5844,19 @@ func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Tasks != nil {
-		{
-			size, err := m.Tasks.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	if len(m.ProcessingQueueStates) > 0 {
+		for iNdEx := len(m.ProcessingQueueStates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProcessingQueueStates[iNdEx])
+			copy(dAtA[i:], m.ProcessingQueueStates[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.ProcessingQueueStates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationAckStatesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5595,12 +5866,12 @@ func (m *GetDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationAckStatesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationAckStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5609,31 +5880,15 @@ func (m *GetDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Filters) > 0 {
-		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ConfigName) > 0 {
-		i -= len(m.ConfigName)
-		copy(dAtA[i:], m.ConfigName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ConfigName)))
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationAckStatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5643,12 +5898,12 @@ func (m *GetDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationAckStatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationAckStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5657,22 +5912,24 @@ func (m *GetDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.AckStates) > 0 {
+		for iNdEx := len(m.AckStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetWorkflowExecutionRawHistoryV2Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5682,12 +5939,12 @@ func (m *UpdateDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetWorkflowExecutionRawHistoryV2Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5696,31 +5953,65 @@ func (m *UpdateDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ConfigValues) > 0 {
-		for iNdEx := len(m.ConfigValues) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ConfigValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EndEvent != nil {
+		{
+			size, err := m.EndEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x12
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartEvent != nil {
+		{
+			size, err := m.StartEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.ConfigName) > 0 {
-		i -= len(m.ConfigName)
-		copy(dAtA[i:], m.ConfigName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ConfigName)))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetWorkflowExecutionRawHistoryV2Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5730,12 +6021,12 @@ func (m *UpdateDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetWorkflowExecutionRawHistoryV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5744,10 +6035,43 @@ func (m *UpdateDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.VersionHistory != nil {
+		{
+			size, err := m.VersionHistory.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HistoryBatches) > 0 {
+		for iNdEx := len(m.HistoryBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HistoryBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestoreDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5757,12 +6081,12 @@ func (m *RestoreDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestoreDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestoreDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5771,10 +6095,35 @@ func (m *RestoreDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Filters) > 0 {
-		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SupportedCompressions) > 0 {
+		dAtA12 := make([]byte, len(m.SupportedCompressions)*10)
+		var j11 int
+		for _, num := range m.SupportedCompressions {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintService(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5782,20 +6131,13 @@ func (m *RestoreDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 				i = encodeVarintService(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.ConfigName) > 0 {
-		i -= len(m.ConfigName)
-		copy(dAtA[i:], m.ConfigName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ConfigName)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RestoreDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5805,12 +6147,12 @@ func (m *RestoreDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RestoreDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RestoreDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5819,10 +6161,34 @@ func (m *RestoreDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ShardMessages) > 0 {
+		for k := range m.ShardMessages {
+			v := m.ShardMessages[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AdminDeleteWorkflowRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetDLQReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5832,12 +6198,12 @@ func (m *AdminDeleteWorkflowRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminDeleteWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetDLQReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AdminDeleteWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5846,29 +6212,42 @@ func (m *AdminDeleteWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.SupportedCompressions) > 0 {
+		dAtA15 := make([]byte, len(m.SupportedCompressions)*10)
+		var j14 int
+		for _, num := range m.SupportedCompressions {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
 			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintService(dAtA, i, uint64(j14))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.TaskInfos) > 0 {
+		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AdminDeleteWorkflowResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetDLQReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5878,12 +6257,12 @@ func (m *AdminDeleteWorkflowResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminDeleteWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetDLQReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AdminDeleteWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetDLQReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5892,40 +6271,24 @@ func (m *AdminDeleteWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.VisibilityDeleted {
-		i--
-		if m.VisibilityDeleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ExecutionsDeleted {
-		i--
-		if m.ExecutionsDeleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.HistoryDeleted {
-		i--
-		if m.HistoryDeleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AdminMaintainWorkflowRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetDomainReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5935,12 +6298,12 @@ func (m *AdminMaintainWorkflowRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminMaintainWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetDomainReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AdminMaintainWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetDomainReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5949,9 +6312,16 @@ func (m *AdminMaintainWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LastProcessedMessageId != nil {
 		{
-			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.LastProcessedMessageId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5961,17 +6331,22 @@ func (m *AdminMaintainWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Domain) > 0 {
-		i -= len(m.Domain)
-		copy(dAtA[i:], m.Domain)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+	if m.LastRetrievedMessageId != nil {
+		{
+			size, err := m.LastRetrievedMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AdminMaintainWorkflowResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetDomainReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5981,12 +6356,12 @@ func (m *AdminMaintainWorkflowResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AdminMaintainWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetDomainReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AdminMaintainWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetDomainReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5995,40 +6370,80 @@ func (m *AdminMaintainWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.VisibilityDeleted {
-		i--
-		if m.VisibilityDeleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Messages != nil {
+		{
+			size, err := m.Messages.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0xa
 	}
-	if m.ExecutionsDeleted {
-		i--
-		if m.ExecutionsDeleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+
+func (m *ReapplyEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReapplyEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReapplyEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Events != nil {
+		{
+			size, err := m.Events.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if m.HistoryDeleted {
-		i--
-		if m.HistoryDeleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReapplyEventsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6038,12 +6453,12 @@ func (m *ListDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReapplyEventsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReapplyEventsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6052,17 +6467,10 @@ func (m *ListDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ConfigName) > 0 {
-		i -= len(m.ConfigName)
-		copy(dAtA[i:], m.ConfigName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ConfigName)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ListDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *AddSearchAttributeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6072,12 +6480,12 @@ func (m *ListDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddSearchAttributeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ListDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddSearchAttributeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6086,16 +6494,26 @@ func (m *ListDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
+	if len(m.SecurityToken) > 0 {
+		i -= len(m.SecurityToken)
+		copy(dAtA[i:], m.SecurityToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SecurityToken)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SearchAttribute) > 0 {
+		for k := range m.SearchAttribute {
+			v := m.SearchAttribute[k]
+			baseI := i
+			i = encodeVarintService(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6103,7 +6521,7 @@ func (m *ListDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *DynamicConfigEntry) Marshal() (dAtA []byte, err error) {
+func (m *AddSearchAttributeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6113,12 +6531,12 @@ func (m *DynamicConfigEntry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DynamicConfigEntry) MarshalTo(dAtA []byte) (int, error) {
+func (m *AddSearchAttributeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DynamicConfigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AddSearchAttributeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6127,31 +6545,10 @@ func (m *DynamicConfigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DynamicConfigValue) Marshal() (dAtA []byte, err error) {
+func (m *DescribeClusterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6161,12 +6558,12 @@ func (m *DynamicConfigValue) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DynamicConfigValue) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeClusterRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DynamicConfigValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeClusterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6175,36 +6572,10 @@ func (m *DynamicConfigValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Filters) > 0 {
-		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Value != nil {
-		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DynamicConfigFilter) Marshal() (dAtA []byte, err error) {
+func (m *DescribeClusterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6214,12 +6585,12 @@ func (m *DynamicConfigFilter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DynamicConfigFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *DescribeClusterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DynamicConfigFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DescribeClusterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6228,9 +6599,35 @@ func (m *DynamicConfigFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Value != nil {
+	if len(m.PersistenceInfo) > 0 {
+		for k := range m.PersistenceInfo {
+			v := m.PersistenceInfo[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MembershipInfo != nil {
 		{
-			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MembershipInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6240,1258 +6637,7932 @@ func (m *DynamicConfigFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+	if m.SupportedClientVersions != nil {
+		{
+			size, err := m.SupportedClientVersions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintService(dAtA []byte, offset int, v uint64) int {
-	offset -= sovService(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ReadDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func (m *ReadDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ReadDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.HistoryAddr)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.EndShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EndShardId))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.MutableStateInCache)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.MutableStateInDatabase)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *DescribeHistoryHostRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.DescribeBy != nil {
-		n += m.DescribeBy.Size()
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeHistoryHostRequest_HostAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.HostAddress)
-	n += 1 + l + sovService(uint64(l))
-	return n
-}
-func (m *DescribeHistoryHostRequest_ShardId) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ReadDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 1 + sovService(uint64(m.ShardId))
-	return n
+	return dAtA[:n], nil
 }
-func (m *DescribeHistoryHostRequest_WorkflowExecution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	return n
+
+func (m *ReadDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *DescribeShardDistributionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ReadDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PageSize != 0 {
-		n += 1 + sovService(uint64(m.PageSize))
-	}
-	if m.PageId != 0 {
-		n += 1 + sovService(uint64(m.PageId))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *DescribeShardDistributionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if m.NumberOfShards != 0 {
-		n += 1 + sovService(uint64(m.NumberOfShards))
+	if len(m.ReplicationTasksInfo) > 0 {
+		for iNdEx := len(m.ReplicationTasksInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasksInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Shards) > 0 {
-		for k, v := range m.Shards {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovService(uint64(k)) + 1 + len(v) + sovService(uint64(len(v)))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+	if len(m.ReplicationTasks) > 0 {
+		for iNdEx := len(m.ReplicationTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReplicationTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeHistoryHostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PurgeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NumberOfShards != 0 {
-		n += 1 + sovService(uint64(m.NumberOfShards))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovService(uint64(e))
-		}
-		n += 1 + sovService(uint64(l)) + l
+	if m.EndShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EndShardId))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.DomainCache != nil {
-		l = m.DomainCache.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.ShardControllerStatus)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CloseShardRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PurgeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PurgeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CloseShardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MergeDLQMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MergeDLQMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MergeDLQMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *RemoveTaskRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.EndShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.EndShardId))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.TaskType != 0 {
-		n += 1 + sovService(uint64(m.TaskType))
+	if m.PageSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovService(uint64(m.TaskId))
+	if m.InclusiveEndMessageId != nil {
+		{
+			size, err := m.InclusiveEndMessageId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.VisibilityTime != nil {
-		l = m.VisibilityTime.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.SourceCluster) > 0 {
+		i -= len(m.SourceCluster)
+		copy(dAtA[i:], m.SourceCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SourceCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Type != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RemoveTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *MergeDLQMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResetQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.TaskType != 0 {
-		n += 1 + sovService(uint64(m.TaskType))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+func (m *MergeDLQMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MergeDLQMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintService(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeQueueRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.TaskType != 0 {
-		n += 1 + sovService(uint64(m.TaskType))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RefreshWorkflowTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeQueueResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RefreshWorkflowTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ProcessingQueueStates) > 0 {
-		for _, s := range m.ProcessingQueueStates {
-			l = len(s)
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.StartEvent != nil {
-		l = m.StartEvent.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.EndEvent != nil {
-		l = m.EndEvent.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.PageSize != 0 {
-		n += 1 + sovService(uint64(m.PageSize))
-	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RefreshWorkflowTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RefreshWorkflowTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefreshWorkflowTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.HistoryBatches) > 0 {
-		for _, e := range m.HistoryBatches {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
-	if m.VersionHistory != nil {
-		l = m.VersionHistory.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResendReplicationTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResendReplicationTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResendReplicationTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.EndEvent != nil {
+		{
+			size, err := m.EndEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.StartEvent != nil {
+		{
+			size, err := m.StartEvent.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-
-func (m *GetReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.RemoteCluster) > 0 {
+		i -= len(m.RemoteCluster)
+		copy(dAtA[i:], m.RemoteCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RemoteCluster)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if len(m.ShardMessages) > 0 {
-		for k, v := range m.ShardMessages {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			mapEntrySize := 1 + sovService(uint64(k)) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ResendReplicationTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ResendReplicationTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResendReplicationTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.TaskInfos) > 0 {
-		for _, e := range m.TaskInfos {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetCrossClusterTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCrossClusterTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *GetDomainReplicationMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LastRetrievedMessageId != nil {
-		l = m.LastRetrievedMessageId.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.LastProcessedMessageId != nil {
-		l = m.LastProcessedMessageId.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.ClusterName)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ShardIds) > 0 {
+		dAtA32 := make([]byte, len(m.ShardIds)*10)
+		var j31 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		i -= j31
+		copy(dAtA[i:], dAtA32[:j31])
+		i = encodeVarintService(dAtA, i, uint64(j31))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDomainReplicationMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Messages != nil {
-		l = m.Messages.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *GetCrossClusterTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReapplyEventsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetCrossClusterTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCrossClusterTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Events != nil {
-		l = m.Events.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.FailedCauseByShard) > 0 {
+		for k := range m.FailedCauseByShard {
+			v := m.FailedCauseByShard[k]
+			baseI := i
+			i = encodeVarintService(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.TasksByShard) > 0 {
+		for k := range m.TasksByShard {
+			v := m.TasksByShard[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintService(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintService(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReapplyEventsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RespondCrossClusterTasksCompletedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *AddSearchAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.FetchNewTasks {
+		i--
+		if m.FetchNewTasks {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if len(m.SearchAttribute) > 0 {
-		for k, v := range m.SearchAttribute {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + sovService(uint64(v))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+	if len(m.TaskResponses) > 0 {
+		for iNdEx := len(m.TaskResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.SecurityToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *AddSearchAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *RespondCrossClusterTasksCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DescribeClusterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RespondCrossClusterTasksCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Tasks != nil {
+		{
+			size, err := m.Tasks.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DescribeClusterResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SupportedClientVersions != nil {
-		l = m.SupportedClientVersions.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.MembershipInfo != nil {
-		l = m.MembershipInfo.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.PersistenceInfo) > 0 {
-		for k, v := range m.PersistenceInfo {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReadDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.InclusiveEndMessageId != nil {
-		l = m.InclusiveEndMessageId.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.PageSize != 0 {
-		n += 1 + sovService(uint64(m.PageSize))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ConfigName) > 0 {
+		i -= len(m.ConfigName)
+		copy(dAtA[i:], m.ConfigName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ConfigName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReadDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
-	}
-	if len(m.ReplicationTasks) > 0 {
-		for _, e := range m.ReplicationTasks {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
-		}
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ReplicationTasksInfo) > 0 {
-		for _, e := range m.ReplicationTasksInfo {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.InclusiveEndMessageId != nil {
-		l = m.InclusiveEndMessageId.Size()
-		n += 1 + l + sovService(uint64(l))
+	if len(m.ConfigValues) > 0 {
+		for iNdEx := len(m.ConfigValues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConfigValues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ConfigName) > 0 {
+		i -= len(m.ConfigName)
+		copy(dAtA[i:], m.ConfigName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ConfigName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PurgeDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RestoreDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovService(uint64(m.Type))
-	}
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.SourceCluster)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.InclusiveEndMessageId != nil {
-		l = m.InclusiveEndMessageId.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovService(uint64(m.PageSize))
-	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.ConfigName) > 0 {
+		i -= len(m.ConfigName)
+		copy(dAtA[i:], m.ConfigName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ConfigName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MergeDLQMessagesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RestoreDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AdminDeleteWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AdminDeleteWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminDeleteWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RefreshWorkflowTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AdminDeleteWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ResendReplicationTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AdminDeleteWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminDeleteWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DomainId)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	l = len(m.RemoteCluster)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.StartEvent != nil {
-		l = m.StartEvent.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.VisibilityDeleted {
+		i--
+		if m.VisibilityDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.EndEvent != nil {
-		l = m.EndEvent.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.ExecutionsDeleted {
+		i--
+		if m.ExecutionsDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.HistoryDeleted {
+		i--
+		if m.HistoryDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ResendReplicationTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AdminMaintainWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetCrossClusterTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AdminMaintainWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminMaintainWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ShardIds) > 0 {
-		l = 0
-		for _, e := range m.ShardIds {
-			l += sovService(uint64(e))
-		}
-		n += 1 + sovService(uint64(l)) + l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.TargetCluster)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetCrossClusterTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AdminMaintainWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TasksByShard) > 0 {
-		for k, v := range m.TasksByShard {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovService(uint64(l))
-			}
-			mapEntrySize := 1 + sovService(uint64(k)) + l
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
-	}
-	if len(m.FailedCauseByShard) > 0 {
-		for k, v := range m.FailedCauseByShard {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovService(uint64(k)) + 1 + sovService(uint64(v))
-			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *RespondCrossClusterTasksCompletedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AdminMaintainWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdminMaintainWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ShardId != 0 {
-		n += 1 + sovService(uint64(m.ShardId))
-	}
-	l = len(m.TargetCluster)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.TaskResponses) > 0 {
-		for _, e := range m.TaskResponses {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+	if m.VisibilityDeleted {
+		i--
+		if m.VisibilityDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.FetchNewTasks {
-		n += 2
+	if m.ExecutionsDeleted {
+		i--
+		if m.ExecutionsDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.HistoryDeleted {
+		i--
+		if m.HistoryDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RespondCrossClusterTasksCompletedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetDomainReplicationWatermarkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDomainReplicationWatermarkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDomainReplicationWatermarkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Tasks != nil {
-		l = m.Tasks.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDynamicConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetDomainReplicationWatermarkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDomainReplicationWatermarkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDomainReplicationWatermarkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConfigName)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Filters) > 0 {
-		for _, e := range m.Filters {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+	if m.LastReplicatedEventTime != nil {
+		{
+			size, err := m.LastReplicatedEventTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDynamicConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IssueDomainTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueDomainTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueDomainTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
-}
-
-func (m *UpdateDynamicConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Subject) > 0 {
+		i -= len(m.Subject)
+		copy(dAtA[i:], m.Subject)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Subject)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	l = len(m.ConfigName)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.TtlSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TtlSeconds))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.ConfigValues) > 0 {
-		for _, e := range m.ConfigValues {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+	if len(m.Apis) > 0 {
+		for iNdEx := len(m.Apis) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Apis[iNdEx])
+			copy(dAtA[i:], m.Apis[iNdEx])
+			i = encodeVarintService(dAtA, i, uint64(len(m.Apis[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *UpdateDynamicConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IssueDomainTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IssueDomainTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IssueDomainTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *RestoreDynamicConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StealTaskListLeaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StealTaskListLeaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StealTaskListLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ConfigName)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Filters) > 0 {
-		for _, e := range m.Filters {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *RestoreDynamicConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StealTaskListLeaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StealTaskListLeaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StealTaskListLeaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.RangeId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.RangeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AdminDeleteWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeTaskListConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AdminDeleteWorkflowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DescribeTaskListConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HistoryDeleted {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutionsDeleted {
-		n += 2
+	if m.MaxTaskBatchSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxTaskBatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.VisibilityDeleted {
-		n += 2
+	if m.OutstandingTaskAppendsThreshold != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.OutstandingTaskAppendsThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.MaxTaskDeleteBatchSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxTaskDeleteBatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	return n
+	if m.MinTaskThrottlingBurstSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MinTaskThrottlingBurstSize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxTaskListIdleTimeSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxTaskListIdleTimeSeconds))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.IdleTaskListCheckIntervalSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.IdleTaskListCheckIntervalSeconds))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.UpdateAckIntervalSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.UpdateAckIntervalSeconds))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GetTasksBatchSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GetTasksBatchSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RangeSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.RangeSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LongPollExpirationIntervalSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.LongPollExpirationIntervalSeconds))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EnableEphemeralTaskList {
+		i--
+		if m.EnableEphemeralTaskList {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EnableSyncMatch {
+		i--
+		if m.EnableSyncMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ForwarderMaxChildrenPerNode != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ForwarderMaxChildrenPerNode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ForwarderMaxRatePerSecond != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ForwarderMaxRatePerSecond))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ForwarderMaxOutstandingTasks != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ForwarderMaxOutstandingTasks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ForwarderMaxOutstandingPolls != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ForwarderMaxOutstandingPolls))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumWritePartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumWritePartitions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumReadPartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumReadPartitions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AdminMaintainWorkflowRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTaskListUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskListUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Domain)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.WorkflowExecution != nil {
-		l = m.WorkflowExecution.Size()
-		n += 1 + l + sovService(uint64(l))
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AdminMaintainWorkflowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetTaskListUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskListUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskListUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HistoryDeleted {
-		n += 2
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.ExecutionsDeleted {
-		n += 2
+	if m.Estimated {
+		i--
+		if m.Estimated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.VisibilityDeleted {
-		n += 2
+	if m.SizeBytes != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TaskCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTaskListTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTaskListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskListTagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateTaskListTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateTaskListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateTaskListTagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTaskListTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTaskListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTaskListTagsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskListTags) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListTags) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListTags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Tags) > 0 {
+		for k := range m.Tags {
+			v := m.Tags[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintService(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintService(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintService(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTaskListTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListTaskListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListTaskListTagsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskLists) > 0 {
+		for iNdEx := len(m.TaskLists) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskLists[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackfillDomainReplicationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillDomainReplicationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillDomainReplicationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rps != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Rps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ClosedWithinSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ClosedWithinSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TargetCluster) > 0 {
+		i -= len(m.TargetCluster)
+		copy(dAtA[i:], m.TargetCluster)
+		i = encodeVarintService(dAtA, i, uint64(len(m.TargetCluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackfillDomainReplicationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillDomainReplicationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillDomainReplicationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDomainAsyncWorkflowConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDomainAsyncWorkflowConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDomainAsyncWorkflowConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Configuration != nil {
+		{
+			size, err := m.Configuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateDomainAsyncWorkflowConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateDomainAsyncWorkflowConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateDomainAsyncWorkflowConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDomainAsyncWorkflowConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDomainAsyncWorkflowConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDomainAsyncWorkflowConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDomainAsyncWorkflowConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDomainAsyncWorkflowConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDomainAsyncWorkflowConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Configuration != nil {
+		{
+			size, err := m.Configuration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileDomainRetentionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileDomainRetentionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileDomainRetentionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rps != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Rps))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileDomainRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileDomainRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileDomainRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDynamicConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDynamicConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDynamicConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ConfigName) > 0 {
+		i -= len(m.ConfigName)
+		copy(dAtA[i:], m.ConfigName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ConfigName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListDynamicConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListDynamicConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListDynamicConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicConfigFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicConfigFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicConfigFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintService(dAtA []byte, offset int, v uint64) int {
+	offset -= sovService(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DescribeWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.HistoryAddr)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.MutableStateInCache)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.MutableStateInDatabase)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DescribeBy != nil {
+		n += m.DescribeBy.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostRequest_HostAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddress)
+	n += 1 + l + sovService(uint64(l))
+	return n
+}
+func (m *DescribeHistoryHostRequest_ShardId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovService(uint64(m.ShardId))
+	return n
+}
+func (m *DescribeHistoryHostRequest_WorkflowExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	return n
+}
+func (m *DescribeShardDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	if m.PageId != 0 {
+		n += 1 + sovService(uint64(m.PageId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeShardDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumberOfShards != 0 {
+		n += 1 + sovService(uint64(m.NumberOfShards))
+	}
+	if len(m.Shards) > 0 {
+		for k, v := range m.Shards {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovService(uint64(k)) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeHistoryHostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumberOfShards != 0 {
+		n += 1 + sovService(uint64(m.NumberOfShards))
+	}
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.DomainCache != nil {
+		l = m.DomainCache.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ShardControllerStatus)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PayloadSizeStats) > 0 {
+		for _, e := range m.PayloadSizeStats {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CloseShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CloseShardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovService(uint64(m.TaskType))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovService(uint64(m.TaskId))
+	}
+	if m.VisibilityTime != nil {
+		l = m.VisibilityTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovService(uint64(m.TaskType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResetQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskType != 0 {
+		n += 1 + sovService(uint64(m.TaskType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProcessingQueueStates) > 0 {
+		for _, s := range m.ProcessingQueueStates {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationAckStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationAckStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AckStates) > 0 {
+		for _, e := range m.AckStates {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartEvent != nil {
+		l = m.StartEvent.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EndEvent != nil {
+		l = m.EndEvent.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkflowExecutionRawHistoryV2Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.HistoryBatches) > 0 {
+		for _, e := range m.HistoryBatches {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.VersionHistory != nil {
+		l = m.VersionHistory.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.SupportedCompressions) > 0 {
+		l = 0
+		for _, e := range m.SupportedCompressions {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardMessages) > 0 {
+		for k, v := range m.ShardMessages {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + sovService(uint64(k)) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskInfos) > 0 {
+		for _, e := range m.TaskInfos {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.SupportedCompressions) > 0 {
+		l = 0
+		for _, e := range m.SupportedCompressions {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDLQReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDomainReplicationMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastRetrievedMessageId != nil {
+		l = m.LastRetrievedMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.LastProcessedMessageId != nil {
+		l = m.LastProcessedMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDomainReplicationMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Messages != nil {
+		l = m.Messages.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReapplyEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Events != nil {
+		l = m.Events.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReapplyEventsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddSearchAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SearchAttribute) > 0 {
+		for k, v := range m.SearchAttribute {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + sovService(uint64(v))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.SecurityToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AddSearchAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeClusterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeClusterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SupportedClientVersions != nil {
+		l = m.SupportedClientVersions.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.MembershipInfo != nil {
+		l = m.MembershipInfo.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.PersistenceInfo) > 0 {
+		for k, v := range m.PersistenceInfo {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EndShardId != 0 {
+		n += 1 + sovService(uint64(m.EndShardId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReadDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if len(m.ReplicationTasks) > 0 {
+		for _, e := range m.ReplicationTasks {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if len(m.ReplicationTasksInfo) > 0 {
+		for _, e := range m.ReplicationTasksInfo {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EndShardId != 0 {
+		n += 1 + sovService(uint64(m.EndShardId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PurgeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeDLQMessagesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovService(uint64(m.Type))
+	}
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.SourceCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.InclusiveEndMessageId != nil {
+		l = m.InclusiveEndMessageId.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovService(uint64(m.PageSize))
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EndShardId != 0 {
+		n += 1 + sovService(uint64(m.EndShardId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MergeDLQMessagesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RefreshWorkflowTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResendReplicationTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.RemoteCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.StartEvent != nil {
+		l = m.StartEvent.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.EndEvent != nil {
+		l = m.EndEvent.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ResendReplicationTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCrossClusterTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	l = len(m.TargetCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetCrossClusterTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TasksByShard) > 0 {
+		for k, v := range m.TasksByShard {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovService(uint64(l))
+			}
+			mapEntrySize := 1 + sovService(uint64(k)) + l
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if len(m.FailedCauseByShard) > 0 {
+		for k, v := range m.FailedCauseByShard {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovService(uint64(k)) + 1 + sovService(uint64(v))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondCrossClusterTasksCompletedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	l = len(m.TargetCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.TaskResponses) > 0 {
+		for _, e := range m.TaskResponses {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.FetchNewTasks {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RespondCrossClusterTasksCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tasks != nil {
+		l = m.Tasks.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.ConfigValues) > 0 {
+		for _, e := range m.ConfigValues {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminDeleteWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminDeleteWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistoryDeleted {
+		n += 2
+	}
+	if m.ExecutionsDeleted {
+		n += 2
+	}
+	if m.VisibilityDeleted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminMaintainWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AdminMaintainWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistoryDeleted {
+		n += 2
+	}
+	if m.ExecutionsDeleted {
+		n += 2
+	}
+	if m.VisibilityDeleted {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDomainReplicationWatermarkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDomainReplicationWatermarkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.LastReplicatedEventTime != nil {
+		l = m.LastReplicatedEventTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IssueDomainTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Apis) > 0 {
+		for _, s := range m.Apis {
+			l = len(s)
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.TtlSeconds != 0 {
+		n += 1 + sovService(uint64(m.TtlSeconds))
+	}
+	l = len(m.Subject)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IssueDomainTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StealTaskListLeaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StealTaskListLeaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RangeId != 0 {
+		n += 1 + sovService(uint64(m.RangeId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeTaskListConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DescribeTaskListConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumReadPartitions != 0 {
+		n += 1 + sovService(uint64(m.NumReadPartitions))
+	}
+	if m.NumWritePartitions != 0 {
+		n += 1 + sovService(uint64(m.NumWritePartitions))
+	}
+	if m.ForwarderMaxOutstandingPolls != 0 {
+		n += 1 + sovService(uint64(m.ForwarderMaxOutstandingPolls))
+	}
+	if m.ForwarderMaxOutstandingTasks != 0 {
+		n += 1 + sovService(uint64(m.ForwarderMaxOutstandingTasks))
+	}
+	if m.ForwarderMaxRatePerSecond != 0 {
+		n += 1 + sovService(uint64(m.ForwarderMaxRatePerSecond))
+	}
+	if m.ForwarderMaxChildrenPerNode != 0 {
+		n += 1 + sovService(uint64(m.ForwarderMaxChildrenPerNode))
+	}
+	if m.EnableSyncMatch {
+		n += 2
+	}
+	if m.EnableEphemeralTaskList {
+		n += 2
+	}
+	if m.LongPollExpirationIntervalSeconds != 0 {
+		n += 1 + sovService(uint64(m.LongPollExpirationIntervalSeconds))
+	}
+	if m.RangeSize != 0 {
+		n += 1 + sovService(uint64(m.RangeSize))
+	}
+	if m.GetTasksBatchSize != 0 {
+		n += 1 + sovService(uint64(m.GetTasksBatchSize))
+	}
+	if m.UpdateAckIntervalSeconds != 0 {
+		n += 1 + sovService(uint64(m.UpdateAckIntervalSeconds))
+	}
+	if m.IdleTaskListCheckIntervalSeconds != 0 {
+		n += 1 + sovService(uint64(m.IdleTaskListCheckIntervalSeconds))
+	}
+	if m.MaxTaskListIdleTimeSeconds != 0 {
+		n += 1 + sovService(uint64(m.MaxTaskListIdleTimeSeconds))
+	}
+	if m.MinTaskThrottlingBurstSize != 0 {
+		n += 1 + sovService(uint64(m.MinTaskThrottlingBurstSize))
+	}
+	if m.MaxTaskDeleteBatchSize != 0 {
+		n += 2 + sovService(uint64(m.MaxTaskDeleteBatchSize))
+	}
+	if m.OutstandingTaskAppendsThreshold != 0 {
+		n += 2 + sovService(uint64(m.OutstandingTaskAppendsThreshold))
+	}
+	if m.MaxTaskBatchSize != 0 {
+		n += 2 + sovService(uint64(m.MaxTaskBatchSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskListUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskCount != 0 {
+		n += 1 + sovService(uint64(m.TaskCount))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovService(uint64(m.SizeBytes))
+	}
+	if m.Estimated {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateTaskListTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateTaskListTagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTaskListTagsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskListTags) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if len(m.Tags) > 0 {
+		for k, v := range m.Tags {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovService(uint64(len(k))) + 1 + len(v) + sovService(uint64(len(v)))
+			n += mapEntrySize + 1 + sovService(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListTaskListTagsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskLists) > 0 {
+		for _, e := range m.TaskLists {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackfillDomainReplicationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.TargetCluster)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ClosedWithinSeconds != 0 {
+		n += 1 + sovService(uint64(m.ClosedWithinSeconds))
+	}
+	if m.Rps != 0 {
+		n += 1 + sovService(uint64(m.Rps))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackfillDomainReplicationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDomainAsyncWorkflowConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Configuration != nil {
+		l = m.Configuration.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateDomainAsyncWorkflowConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDomainAsyncWorkflowConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDomainAsyncWorkflowConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Configuration != nil {
+		l = m.Configuration.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReconcileDomainRetentionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Rps != 0 {
+		n += 1 + sovService(uint64(m.Rps))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReconcileDomainRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDynamicConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConfigName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListDynamicConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DynamicConfigEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DynamicConfigValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DynamicConfigFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateInCache", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MutableStateInCache = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateInDatabase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MutableStateInDatabase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DescribeBy = &DescribeHistoryHostRequest_HostAddress{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DescribeBy = &DescribeHistoryHostRequest_ShardId{v}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &v1.WorkflowExecution{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.DescribeBy = &DescribeHistoryHostRequest_WorkflowExecution{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeShardDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeShardDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeShardDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageId", wireType)
+			}
+			m.PageId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeShardDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeShardDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeShardDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfShards", wireType)
+			}
+			m.NumberOfShards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfShards |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Shards == nil {
+				m.Shards = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Shards[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfShards", wireType)
+			}
+			m.NumberOfShards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfShards |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainCache", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DomainCache == nil {
+				m.DomainCache = &v11.DomainCacheInfo{}
+			}
+			if err := m.DomainCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSizeStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadSizeStats = append(m.PayloadSizeStats, &v11.DomainPayloadSizeStats{})
+			if err := m.PayloadSizeStats[len(m.PayloadSizeStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VisibilityTime == nil {
+				m.VisibilityTime = &types.Timestamp{}
+			}
+			if err := m.VisibilityTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+			}
+			m.TaskType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingQueueStates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessingQueueStates = append(m.ProcessingQueueStates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationAckStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationAckStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationAckStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationAckStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationAckStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationAckStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckStates = append(m.AckStates, &v11.ReplicationAckState{})
+			if err := m.AckStates[len(m.AckStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartEvent == nil {
+				m.StartEvent = &v11.VersionHistoryItem{}
+			}
+			if err := m.StartEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndEvent == nil {
+				m.EndEvent = &v11.VersionHistoryItem{}
+			}
+			if err := m.EndEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HistoryBatches = append(m.HistoryBatches, &v1.DataBlob{})
+			if err := m.HistoryBatches[len(m.HistoryBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VersionHistory == nil {
+				m.VersionHistory = &v11.VersionHistory{}
+			}
+			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &v11.ReplicationToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v v11.CompressionType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= v11.CompressionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SupportedCompressions = append(m.SupportedCompressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.SupportedCompressions) == 0 {
+					m.SupportedCompressions = make([]v11.CompressionType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v v11.CompressionType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= v11.CompressionType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SupportedCompressions = append(m.SupportedCompressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedCompressions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ShardMessages == nil {
+				m.ShardMessages = make(map[int32]*v11.ReplicationMessages)
+			}
+			var mapkey int32
+			var mapvalue *v11.ReplicationMessages
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.ReplicationMessages{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ShardMessages[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskInfos = append(m.TaskInfos, &v11.ReplicationTaskInfo{})
+			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v v11.CompressionType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= v11.CompressionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SupportedCompressions = append(m.SupportedCompressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.SupportedCompressions) == 0 {
+					m.SupportedCompressions = make([]v11.CompressionType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v v11.CompressionType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= v11.CompressionType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SupportedCompressions = append(m.SupportedCompressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedCompressions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDomainReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDomainReplicationMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDomainReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastRetrievedMessageId == nil {
+				m.LastRetrievedMessageId = &types.Int64Value{}
+			}
+			if err := m.LastRetrievedMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastProcessedMessageId == nil {
+				m.LastProcessedMessageId = &types.Int64Value{}
+			}
+			if err := m.LastProcessedMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDomainReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDomainReplicationMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDomainReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Messages == nil {
+				m.Messages = &v11.ReplicationMessages{}
+			}
+			if err := m.Messages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Events == nil {
+				m.Events = &v1.DataBlob{}
+			}
+			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddSearchAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddSearchAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttribute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SearchAttribute == nil {
+				m.SearchAttribute = make(map[string]v1.IndexedValueType)
+			}
+			var mapkey string
+			var mapvalue v1.IndexedValueType
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v1.IndexedValueType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SearchAttribute[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *ListDynamicConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConfigName)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *ListDynamicConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+func (m *AddSearchAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *DynamicConfigEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if len(m.Values) > 0 {
-		for _, e := range m.Values {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddSearchAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddSearchAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *DynamicConfigValue) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovService(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Filters) > 0 {
-		for _, e := range m.Filters {
-			l = e.Size()
-			n += 1 + l + sovService(uint64(l))
+	return nil
+}
+func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *DynamicConfigFilter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.Value != nil {
-		l = m.Value.Size()
-		n += 1 + l + sovService(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7514,17 +14585,17 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClientVersions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7534,27 +14605,67 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SupportedClientVersions == nil {
+				m.SupportedClientVersions = &v1.SupportedClientVersions{}
+			}
+			if err := m.SupportedClientVersions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
+			if m.MembershipInfo == nil {
+				m.MembershipInfo = &v11.MembershipInfo{}
+			}
+			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7581,12 +14692,105 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.PersistenceInfo == nil {
+				m.PersistenceInfo = make(map[string]*v11.PersistenceInfo)
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue *v11.PersistenceInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.PersistenceInfo{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.PersistenceInfo[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7610,7 +14814,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7633,13 +14837,32 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v11.DLQType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
@@ -7658,9 +14881,9 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7688,13 +14911,13 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistoryAddr = string(dAtA[iNdEx:postIndex])
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateInCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7704,29 +14927,52 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MutableStateInCache = string(dAtA[iNdEx:postIndex])
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
+			}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MutableStateInDatabase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7736,24 +14982,45 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MutableStateInDatabase = string(dAtA[iNdEx:postIndex])
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShardId", wireType)
+			}
+			m.EndShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -7776,7 +15043,7 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7799,17 +15066,36 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReadDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReadDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v11.DLQType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7819,29 +15105,31 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DescribeBy = &DescribeHistoryHostRequest_HostAddress{string(dAtA[iNdEx:postIndex])}
+			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasksInfo", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7851,17 +15139,31 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DescribeBy = &DescribeHistoryHostRequest_ShardId{v}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v11.ReplicationTaskInfo{})
+			if err := m.ReplicationTasksInfo[len(m.ReplicationTasksInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7871,26 +15173,25 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v1.WorkflowExecution{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
 			}
-			m.DescribeBy = &DescribeHistoryHostRequest_WorkflowExecution{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7914,7 +15215,7 @@ func (m *DescribeHistoryHostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeShardDistributionRequest) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7937,17 +15238,17 @@ func (m *DescribeShardDistributionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeShardDistributionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeShardDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.PageSize = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7957,16 +15258,16 @@ func (m *DescribeShardDistributionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				m.Type |= v11.DLQType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			m.PageId = 0
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7976,67 +15277,16 @@ func (m *DescribeShardDistributionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageId |= int32(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DescribeShardDistributionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeShardDistributionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeShardDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfShards", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			m.NumberOfShards = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8046,14 +15296,27 @@ func (m *DescribeShardDistributionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumberOfShards |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8080,90 +15343,32 @@ func (m *DescribeShardDistributionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Shards == nil {
-				m.Shards = make(map[int32]string)
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
 			}
-			var mapkey int32
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShardId", wireType)
+			}
+			m.EndShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Shards[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8186,7 +15391,58 @@ func (m *DescribeShardDistributionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8209,17 +15465,17 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeHistoryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfShards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.NumberOfShards = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8229,92 +15485,35 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumberOfShards |= int32(b&0x7F) << shift
+				m.Type |= v11.DLQType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthService
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthService
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainCache", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8324,33 +15523,29 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DomainCache == nil {
-				m.DomainCache = &v11.DomainCacheInfo{}
-			}
-			if err := m.DomainCache.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardControllerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8360,29 +15555,33 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ShardControllerStatus = string(dAtA[iNdEx:postIndex])
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
+			}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var stringLen uint64
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8392,80 +15591,50 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PageSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndShardId", wireType)
 			}
-			m.ShardId = 0
+			m.EndShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8475,7 +15644,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.EndShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8502,7 +15671,7 @@ func (m *CloseShardRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8525,12 +15694,46 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CloseShardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CloseShardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8553,7 +15756,7 @@ func (m *CloseShardResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8576,74 +15779,17 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
-			}
-			m.TaskType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskType |= v11.TaskType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8653,33 +15799,29 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VisibilityTime == nil {
-				m.VisibilityTime = &types.Timestamp{}
-			}
-			if err := m.VisibilityTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8689,23 +15831,27 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8729,7 +15875,7 @@ func (m *RemoveTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8752,10 +15898,10 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8780,7 +15926,7 @@ func (m *RemoveTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8803,17 +15949,17 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			m.ShardId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8823,16 +15969,97 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEvent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8842,29 +16069,33 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			if m.StartEvent == nil {
+				m.StartEvent = &v11.VersionHistoryItem{}
+			}
+			if err := m.StartEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndEvent", wireType)
 			}
-			m.TaskType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -8874,11 +16105,28 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskType |= v11.TaskType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndEvent == nil {
+				m.EndEvent = &v11.VersionHistoryItem{}
+			}
+			if err := m.EndEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -8901,7 +16149,7 @@ func (m *ResetQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8924,10 +16172,10 @@ func (m *ResetQueueResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetQueueResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8952,7 +16200,7 @@ func (m *ResetQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
+func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8975,34 +16223,91 @@ func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeQueueRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCrossClusterTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCrossClusterTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9030,27 +16335,8 @@ func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.TargetCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
-			}
-			m.TaskType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskType |= v11.TaskType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -9073,7 +16359,7 @@ func (m *DescribeQueueRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
+func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9092,21 +16378,136 @@ func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeQueueResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCrossClusterTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCrossClusterTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksByShard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TasksByShard == nil {
+				m.TasksByShard = make(map[int32]*v11.CrossClusterTaskRequests)
+			}
+			var mapkey int32
+			var mapvalue *v11.CrossClusterTaskRequests
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.CrossClusterTaskRequests{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TasksByShard[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessingQueueStates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCauseByShard", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9116,23 +16517,90 @@ func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessingQueueStates = append(m.ProcessingQueueStates, string(dAtA[iNdEx:postIndex]))
+			if m.FailedCauseByShard == nil {
+				m.FailedCauseByShard = make(map[int32]v11.GetTaskFailedCause)
+			}
+			var mapkey int32
+			var mapvalue v11.GetTaskFailedCause
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v11.GetTaskFailedCause(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FailedCauseByShard[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9156,7 +16624,7 @@ func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
+func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9179,17 +16647,17 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var stringLen uint64
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9199,29 +16667,16 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9231,31 +16686,27 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TargetCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskResponses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9282,73 +16733,16 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartEvent == nil {
-				m.StartEvent = &v11.VersionHistoryItem{}
-			}
-			if err := m.StartEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskResponses = append(m.TaskResponses, &v11.CrossClusterTaskResponse{})
+			if err := m.TaskResponses[len(m.TaskResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEvent", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EndEvent == nil {
-				m.EndEvent = &v11.VersionHistoryItem{}
-			}
-			if err := m.EndEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FetchNewTasks", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9358,26 +16752,12 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.FetchNewTasks = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -9400,7 +16780,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error {
+func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9423,83 +16803,15 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWorkflowExecutionRawHistoryV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryBatches", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HistoryBatches = append(m.HistoryBatches, &v1.DataBlob{})
-			if err := m.HistoryBatches[len(m.HistoryBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9526,10 +16838,10 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistory == nil {
-				m.VersionHistory = &v11.VersionHistory{}
+			if m.Tasks == nil {
+				m.Tasks = &v11.CrossClusterTaskRequests{}
 			}
-			if err := m.VersionHistory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tasks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9555,7 +16867,7 @@ func (m *GetWorkflowExecutionRawHistoryV2Response) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9578,17 +16890,17 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDynamicConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9598,31 +16910,29 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, &v11.ReplicationToken{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfigName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -9632,23 +16942,25 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			m.Filters = append(m.Filters, &DynamicConfigFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9672,7 +16984,7 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9695,15 +17007,15 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDynamicConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9730,91 +17042,12 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ShardMessages == nil {
-				m.ShardMessages = make(map[int32]*v11.ReplicationMessages)
+			if m.Value == nil {
+				m.Value = &v1.DataBlob{}
 			}
-			var mapkey int32
-			var mapvalue *v11.ReplicationMessages
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v11.ReplicationMessages{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ShardMessages[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9838,7 +17071,7 @@ func (m *GetReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9861,15 +17094,47 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateDynamicConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskInfos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigValues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9896,8 +17161,8 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskInfos = append(m.TaskInfos, &v11.ReplicationTaskInfo{})
-			if err := m.TaskInfos[len(m.TaskInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConfigValues = append(m.ConfigValues, &DynamicConfigValue{})
+			if err := m.ConfigValues[len(m.ConfigValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9923,7 +17188,7 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9946,46 +17211,12 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateDynamicConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDLQReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10008,7 +17239,7 @@ func (m *GetDLQReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDomainReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *RestoreDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10031,17 +17262,17 @@ func (m *GetDomainReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDomainReplicationMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreDynamicConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDomainReplicationMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRetrievedMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10051,31 +17282,27 @@ func (m *GetDomainReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastRetrievedMessageId == nil {
-				m.LastRetrievedMessageId = &types.Int64Value{}
-			}
-			if err := m.LastRetrievedMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConfigName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastProcessedMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10102,45 +17329,11 @@ func (m *GetDomainReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastProcessedMessageId == nil {
-				m.LastProcessedMessageId = &types.Int64Value{}
-			}
-			if err := m.LastProcessedMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Filters = append(m.Filters, &DynamicConfigFilter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10163,7 +17356,7 @@ func (m *GetDomainReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDomainReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *RestoreDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10186,48 +17379,12 @@ func (m *GetDomainReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDomainReplicationMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestoreDynamicConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDomainReplicationMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestoreDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Messages == nil {
-				m.Messages = &v11.ReplicationMessages{}
-			}
-			if err := m.Messages.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10250,7 +17407,7 @@ func (m *GetDomainReplicationMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
+func (m *AdminDeleteWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10273,10 +17430,10 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminDeleteWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminDeleteWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10347,42 +17504,6 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Events == nil {
-				m.Events = &v1.DataBlob{}
-			}
-			if err := m.Events.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10405,7 +17526,7 @@ func (m *ReapplyEventsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
+func (m *AdminDeleteWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10415,25 +17536,85 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowService
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AdminDeleteWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AdminDeleteWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistoryDeleted = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionsDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExecutionsDeleted = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityDeleted", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReapplyEventsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.VisibilityDeleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10456,7 +17637,7 @@ func (m *ReapplyEventsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *AdminMaintainWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10479,17 +17660,17 @@ func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddSearchAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminMaintainWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddSearchAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminMaintainWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SearchAttribute", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10499,110 +17680,29 @@ func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SearchAttribute == nil {
-				m.SearchAttribute = make(map[string]v1.IndexedValueType)
-			}
-			var mapkey string
-			var mapvalue v1.IndexedValueType
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v1.IndexedValueType(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.SearchAttribute[mapkey] = mapvalue
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10612,23 +17712,27 @@ func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityToken = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10652,7 +17756,7 @@ func (m *AddSearchAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddSearchAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *AdminMaintainWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10675,12 +17779,72 @@ func (m *AddSearchAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddSearchAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdminMaintainWorkflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddSearchAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdminMaintainWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HistoryDeleted = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionsDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExecutionsDeleted = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VisibilityDeleted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10703,7 +17867,7 @@ func (m *AddSearchAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDomainReplicationWatermarkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10713,25 +17877,57 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowService
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDomainReplicationWatermarkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDomainReplicationWatermarkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -10754,7 +17950,7 @@ func (m *DescribeClusterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDomainReplicationWatermarkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10777,17 +17973,17 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeClusterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDomainReplicationWatermarkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeClusterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDomainReplicationWatermarkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SupportedClientVersions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -10797,31 +17993,27 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SupportedClientVersions == nil {
-				m.SupportedClientVersions = &v1.SupportedClientVersions{}
-			}
-			if err := m.SupportedClientVersions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MembershipInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplicatedEventTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10848,142 +18040,13 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MembershipInfo == nil {
-				m.MembershipInfo = &v11.MembershipInfo{}
+			if m.LastReplicatedEventTime == nil {
+				m.LastReplicatedEventTime = &types.Timestamp{}
 			}
-			if err := m.MembershipInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastReplicatedEventTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PersistenceInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PersistenceInfo == nil {
-				m.PersistenceInfo = make(map[string]*v11.PersistenceInfo)
-			}
-			var mapkey string
-			var mapvalue *v11.PersistenceInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v11.PersistenceInfo{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.PersistenceInfo[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -11006,7 +18069,7 @@ func (m *DescribeClusterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *IssueDomainTokenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11029,53 +18092,15 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssueDomainTokenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssueDomainTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11103,13 +18128,13 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apis", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11119,33 +18144,29 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InclusiveEndMessageId == nil {
-				m.InclusiveEndMessageId = &types.Int64Value{}
-			}
-			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Apis = append(m.Apis, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TtlSeconds", wireType)
 			}
-			m.PageSize = 0
+			m.TtlSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11155,16 +18176,16 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				m.TtlSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11174,25 +18195,23 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11216,7 +18235,7 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *IssueDomainTokenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11239,17 +18258,17 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: IssueDomainTokenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IssueDomainTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11259,16 +18278,80 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StealTaskListLeaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StealTaskListLeaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StealTaskListLeaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11278,29 +18361,27 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasksInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11327,16 +18408,18 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v11.ReplicationTaskInfo{})
-			if err := m.ReplicationTasksInfo[len(m.ReplicationTasksInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
 			}
-			var byteLen int
+			m.TaskListType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11346,26 +18429,11 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -11388,7 +18456,7 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *StealTaskListLeaseResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11411,17 +18479,17 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StealTaskListLeaseResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StealTaskListLeaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeId", wireType)
 			}
-			m.Type = 0
+			m.RangeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11431,33 +18499,65 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				m.RangeId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11485,11 +18585,11 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11503,77 +18603,45 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				iNdEx++
 				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.InclusiveEndMessageId == nil {
-				m.InclusiveEndMessageId = &types.Int64Value{}
-			}
-			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -11596,7 +18664,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeTaskListConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11619,17 +18687,17 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeTaskListConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeTaskListConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReadPartitions", wireType)
 			}
-			m.Type = 0
+			m.NumReadPartitions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11639,16 +18707,16 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
+				m.NumReadPartitions |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWritePartitions", wireType)
 			}
-			m.ShardId = 0
+			m.NumWritePartitions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11658,16 +18726,16 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.NumWritePartitions |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderMaxOutstandingPolls", wireType)
 			}
-			var stringLen uint64
+			m.ForwarderMaxOutstandingPolls = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11677,29 +18745,16 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ForwarderMaxOutstandingPolls |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderMaxOutstandingTasks", wireType)
 			}
-			var msglen int
+			m.ForwarderMaxOutstandingTasks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11709,33 +18764,54 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ForwarderMaxOutstandingTasks |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderMaxRatePerSecond", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ForwarderMaxRatePerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwarderMaxRatePerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.InclusiveEndMessageId == nil {
-				m.InclusiveEndMessageId = &types.Int64Value{}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderMaxChildrenPerNode", wireType)
 			}
-			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ForwarderMaxChildrenPerNode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwarderMaxChildrenPerNode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSyncMatch", wireType)
 			}
-			m.PageSize = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11745,16 +18821,17 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			m.EnableSyncMatch = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEphemeralTaskList", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11764,82 +18841,112 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
+			m.EnableEphemeralTaskList = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongPollExpirationIntervalSeconds", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			m.LongPollExpirationIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LongPollExpirationIntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeSize", wireType)
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			m.RangeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetTasksBatchSize", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+			m.GetTasksBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GetTasksBatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAckIntervalSeconds", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			m.UpdateAckIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateAckIntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTaskListCheckIntervalSeconds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.IdleTaskListCheckIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTaskListCheckIntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskListIdleTimeSeconds", wireType)
 			}
-			var byteLen int
+			m.MaxTaskListIdleTimeSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11849,82 +18956,16 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.MaxTaskListIdleTimeSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskThrottlingBurstSize", wireType)
 			}
-			var stringLen uint64
+			m.MinTaskThrottlingBurstSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11934,29 +18975,16 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MinTaskThrottlingBurstSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskDeleteBatchSize", wireType)
 			}
-			var msglen int
+			m.MaxTaskDeleteBatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -11966,79 +18994,49 @@ func (m *RefreshWorkflowTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxTaskDeleteBatchSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingTaskAppendsThreshold", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			m.OutstandingTaskAppendsThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutstandingTaskAppendsThreshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskBatchSize", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MaxTaskBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskBatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RefreshWorkflowTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -12061,7 +19059,7 @@ func (m *RefreshWorkflowTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *GetTaskListUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12084,83 +19082,15 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskListUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskListUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12188,11 +19118,11 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteCluster = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12219,18 +19149,18 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartEvent == nil {
-				m.StartEvent = &v11.VersionHistoryItem{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.StartEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndEvent", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
 			}
-			var msglen int
+			m.TaskListType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -12240,28 +19170,11 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EndEvent == nil {
-				m.EndEvent = &v11.VersionHistoryItem{}
-			}
-			if err := m.EndEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -12284,7 +19197,7 @@ func (m *ResendReplicationTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *GetTaskListUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12307,12 +19220,70 @@ func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskListUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResendReplicationTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskListUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskCount", wireType)
+			}
+			m.TaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Estimated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -12335,7 +19306,7 @@ func (m *ResendReplicationTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateTaskListTagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12358,91 +19329,15 @@ func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCrossClusterTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateTaskListTagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCrossClusterTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateTaskListTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthService
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthService
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12470,62 +19365,66 @@ func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetCluster = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetCrossClusterTasksResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCrossClusterTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TasksByShard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12552,11 +19451,11 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TasksByShard == nil {
-				m.TasksByShard = make(map[int32]*v11.CrossClusterTaskRequests)
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
 			}
-			var mapkey int32
-			var mapvalue *v11.CrossClusterTaskRequests
+			var mapkey string
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -12576,6 +19475,7 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowService
@@ -12585,13 +19485,26 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowService
@@ -12601,26 +19514,24 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthService
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthService
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &v11.CrossClusterTaskRequests{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipService(dAtA[iNdEx:])
@@ -12636,11 +19547,62 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.TasksByShard[mapkey] = mapvalue
+			m.Tags[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateTaskListTagsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateTaskListTagsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateTaskListTagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedCauseByShard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12667,11 +19629,11 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailedCauseByShard == nil {
-				m.FailedCauseByShard = make(map[int32]v11.GetTaskFailedCause)
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
 			}
-			var mapkey int32
-			var mapvalue v11.GetTaskFailedCause
+			var mapkey string
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -12691,6 +19653,7 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowService
@@ -12700,12 +19663,26 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowService
@@ -12715,11 +19692,24 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= v11.GetTaskFailedCause(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipService(dAtA[iNdEx:])
@@ -12735,7 +19725,7 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.FailedCauseByShard[mapkey] = mapvalue
+			m.Tags[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12759,7 +19749,7 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTaskListTagsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12782,34 +19772,15 @@ func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTaskListTagsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTaskListTagsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12837,11 +19808,11 @@ func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetCluster = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12868,31 +19839,104 @@ func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskResponses = append(m.TaskResponses, &v11.CrossClusterTaskResponse{})
-			if err := m.TaskResponses[len(m.TaskResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FetchNewTasks", wireType)
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			m.FetchNewTasks = bool(v != 0)
+			m.Tags[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -12915,7 +19959,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *TaskListTags) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12938,17 +19982,17 @@ func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskListTags: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskListTags: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -12958,84 +20002,29 @@ func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tasks == nil {
-				m.Tasks = &v11.CrossClusterTaskRequests{}
-			}
-			if err := m.Tasks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDynamicConfigRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDynamicConfigRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigName", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
 			}
-			var stringLen uint64
+			m.TaskListType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13045,27 +20034,14 @@ func (m *GetDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConfigName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13092,10 +20068,103 @@ func (m *GetDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, &DynamicConfigFilter{})
-			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Tags == nil {
+				m.Tags = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Tags[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13119,7 +20188,7 @@ func (m *GetDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetDynamicConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTaskListTagsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13142,15 +20211,15 @@ func (m *GetDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetDynamicConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTaskListTagsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTaskListTagsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskLists", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13177,10 +20246,8 @@ func (m *GetDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &v1.DataBlob{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskLists = append(m.TaskLists, &TaskListTags{})
+			if err := m.TaskLists[len(m.TaskLists)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13206,7 +20273,7 @@ func (m *GetDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateDynamicConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *BackfillDomainReplicationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13229,15 +20296,15 @@ func (m *UpdateDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateDynamicConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackfillDomainReplicationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackfillDomainReplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13265,13 +20332,45 @@ func (m *UpdateDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigName = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedWithinSeconds", wireType)
 			}
-			var msglen int
+			m.ClosedWithinSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13281,26 +20380,30 @@ func (m *UpdateDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ClosedWithinSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rps", wireType)
 			}
-			m.ConfigValues = append(m.ConfigValues, &DynamicConfigValue{})
-			if err := m.ConfigValues[len(m.ConfigValues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Rps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rps |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13323,7 +20426,7 @@ func (m *UpdateDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateDynamicConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *BackfillDomainReplicationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13346,12 +20449,44 @@ func (m *UpdateDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateDynamicConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackfillDomainReplicationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackfillDomainReplicationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13374,7 +20509,7 @@ func (m *UpdateDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreDynamicConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *UpdateDomainAsyncWorkflowConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13397,15 +20532,15 @@ func (m *RestoreDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreDynamicConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateDomainAsyncWorkflowConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreDynamicConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateDomainAsyncWorkflowConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13433,11 +20568,11 @@ func (m *RestoreDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigName = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13464,8 +20599,10 @@ func (m *RestoreDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filters = append(m.Filters, &DynamicConfigFilter{})
-			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Configuration == nil {
+				m.Configuration = &v11.AsyncWorkflowConfiguration{}
+			}
+			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13491,7 +20628,7 @@ func (m *RestoreDynamicConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreDynamicConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *UpdateDomainAsyncWorkflowConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13514,10 +20651,10 @@ func (m *RestoreDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreDynamicConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateDomainAsyncWorkflowConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreDynamicConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateDomainAsyncWorkflowConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13542,7 +20679,7 @@ func (m *RestoreDynamicConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminDeleteWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *GetDomainAsyncWorkflowConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13565,10 +20702,10 @@ func (m *AdminDeleteWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminDeleteWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDomainAsyncWorkflowConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminDeleteWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDomainAsyncWorkflowConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13603,42 +20740,6 @@ func (m *AdminDeleteWorkflowRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13661,7 +20762,7 @@ func (m *AdminDeleteWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminDeleteWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDomainAsyncWorkflowConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13684,17 +20785,17 @@ func (m *AdminDeleteWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminDeleteWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDomainAsyncWorkflowConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminDeleteWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDomainAsyncWorkflowConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryDeleted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configuration", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13704,52 +20805,28 @@ func (m *AdminDeleteWorkflowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HistoryDeleted = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionsDeleted", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			m.ExecutionsDeleted = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityDeleted", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.Configuration == nil {
+				m.Configuration = &v11.AsyncWorkflowConfiguration{}
 			}
-			m.VisibilityDeleted = bool(v != 0)
+			if err := m.Configuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13772,7 +20849,7 @@ func (m *AdminDeleteWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminMaintainWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *ReconcileDomainRetentionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13795,10 +20872,10 @@ func (m *AdminMaintainWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminMaintainWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReconcileDomainRetentionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminMaintainWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReconcileDomainRetentionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13834,10 +20911,10 @@ func (m *AdminMaintainWorkflowRequest) Unmarshal(dAtA []byte) error {
 			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rps", wireType)
 			}
-			var msglen int
+			m.Rps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13847,28 +20924,11 @@ func (m *AdminMaintainWorkflowRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Rps |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
-			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13891,7 +20951,7 @@ func (m *AdminMaintainWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AdminMaintainWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *ReconcileDomainRetentionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13914,17 +20974,17 @@ func (m *AdminMaintainWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AdminMaintainWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReconcileDomainRetentionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AdminMaintainWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReconcileDomainRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoryDeleted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13934,52 +20994,24 @@ func (m *AdminMaintainWorkflowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HistoryDeleted = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionsDeleted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.ExecutionsDeleted = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VisibilityDeleted", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.VisibilityDeleted = bool(v != 0)
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -51,6 +51,7 @@ type AdminAPIYARPCClient interface {
 	RemoveTask(context.Context, *RemoveTaskRequest, ...yarpc.CallOption) (*RemoveTaskResponse, error)
 	ResetQueue(context.Context, *ResetQueueRequest, ...yarpc.CallOption) (*ResetQueueResponse, error)
 	DescribeQueue(context.Context, *DescribeQueueRequest, ...yarpc.CallOption) (*DescribeQueueResponse, error)
+	GetReplicationAckStates(context.Context, *GetReplicationAckStatesRequest, ...yarpc.CallOption) (*GetReplicationAckStatesResponse, error)
 	GetWorkflowExecutionRawHistoryV2(context.Context, *GetWorkflowExecutionRawHistoryV2Request, ...yarpc.CallOption) (*GetWorkflowExecutionRawHistoryV2Response, error)
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest, ...yarpc.CallOption) (*GetReplicationMessagesResponse, error)
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
@@ -71,6 +72,17 @@ type AdminAPIYARPCClient interface {
 	ListDynamicConfig(context.Context, *ListDynamicConfigRequest, ...yarpc.CallOption) (*ListDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *AdminDeleteWorkflowRequest, ...yarpc.CallOption) (*AdminDeleteWorkflowResponse, error)
 	MaintainCorruptWorkflow(context.Context, *AdminMaintainWorkflowRequest, ...yarpc.CallOption) (*AdminMaintainWorkflowResponse, error)
+	GetDomainReplicationWatermark(context.Context, *GetDomainReplicationWatermarkRequest, ...yarpc.CallOption) (*GetDomainReplicationWatermarkResponse, error)
+	IssueDomainToken(context.Context, *IssueDomainTokenRequest, ...yarpc.CallOption) (*IssueDomainTokenResponse, error)
+	StealTaskListLease(context.Context, *StealTaskListLeaseRequest, ...yarpc.CallOption) (*StealTaskListLeaseResponse, error)
+	DescribeTaskListConfig(context.Context, *DescribeTaskListConfigRequest, ...yarpc.CallOption) (*DescribeTaskListConfigResponse, error)
+	GetTaskListUsage(context.Context, *GetTaskListUsageRequest, ...yarpc.CallOption) (*GetTaskListUsageResponse, error)
+	UpdateTaskListTags(context.Context, *UpdateTaskListTagsRequest, ...yarpc.CallOption) (*UpdateTaskListTagsResponse, error)
+	ListTaskListTags(context.Context, *ListTaskListTagsRequest, ...yarpc.CallOption) (*ListTaskListTagsResponse, error)
+	BackfillDomainReplication(context.Context, *BackfillDomainReplicationRequest, ...yarpc.CallOption) (*BackfillDomainReplicationResponse, error)
+	UpdateDomainAsyncWorkflowConfig(context.Context, *UpdateDomainAsyncWorkflowConfigRequest, ...yarpc.CallOption) (*UpdateDomainAsyncWorkflowConfigResponse, error)
+	GetDomainAsyncWorkflowConfig(context.Context, *GetDomainAsyncWorkflowConfigRequest, ...yarpc.CallOption) (*GetDomainAsyncWorkflowConfigResponse, error)
+	ReconcileDomainRetention(context.Context, *ReconcileDomainRetentionRequest, ...yarpc.CallOption) (*ReconcileDomainRetentionResponse, error)
 }
 
 func newAdminAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) AdminAPIYARPCClient {
@@ -98,6 +110,7 @@ type AdminAPIYARPCServer interface {
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	ResetQueue(context.Context, *ResetQueueRequest) (*ResetQueueResponse, error)
 	DescribeQueue(context.Context, *DescribeQueueRequest) (*DescribeQueueResponse, error)
+	GetReplicationAckStates(context.Context, *GetReplicationAckStatesRequest) (*GetReplicationAckStatesResponse, error)
 	GetWorkflowExecutionRawHistoryV2(context.Context, *GetWorkflowExecutionRawHistoryV2Request) (*GetWorkflowExecutionRawHistoryV2Response, error)
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error)
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
@@ -118,6 +131,17 @@ type AdminAPIYARPCServer interface {
 	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
 	DeleteWorkflow(context.Context, *AdminDeleteWorkflowRequest) (*AdminDeleteWorkflowResponse, error)
 	MaintainCorruptWorkflow(context.Context, *AdminMaintainWorkflowRequest) (*AdminMaintainWorkflowResponse, error)
+	GetDomainReplicationWatermark(context.Context, *GetDomainReplicationWatermarkRequest) (*GetDomainReplicationWatermarkResponse, error)
+	IssueDomainToken(context.Context, *IssueDomainTokenRequest) (*IssueDomainTokenResponse, error)
+	StealTaskListLease(context.Context, *StealTaskListLeaseRequest) (*StealTaskListLeaseResponse, error)
+	DescribeTaskListConfig(context.Context, *DescribeTaskListConfigRequest) (*DescribeTaskListConfigResponse, error)
+	GetTaskListUsage(context.Context, *GetTaskListUsageRequest) (*GetTaskListUsageResponse, error)
+	UpdateTaskListTags(context.Context, *UpdateTaskListTagsRequest) (*UpdateTaskListTagsResponse, error)
+	ListTaskListTags(context.Context, *ListTaskListTagsRequest) (*ListTaskListTagsResponse, error)
+	BackfillDomainReplication(context.Context, *BackfillDomainReplicationRequest) (*BackfillDomainReplicationResponse, error)
+	UpdateDomainAsyncWorkflowConfig(context.Context, *UpdateDomainAsyncWorkflowConfigRequest) (*UpdateDomainAsyncWorkflowConfigResponse, error)
+	GetDomainAsyncWorkflowConfig(context.Context, *GetDomainAsyncWorkflowConfigRequest) (*GetDomainAsyncWorkflowConfigResponse, error)
+	ReconcileDomainRetention(context.Context, *ReconcileDomainRetentionRequest) (*ReconcileDomainRetentionResponse, error)
 }
 
 type buildAdminAPIYARPCProceduresParams struct {
@@ -201,6 +225,16 @@ func buildAdminAPIYARPCProcedures(params buildAdminAPIYARPCProceduresParams) []t
 						},
 					),
 				},
+				{
+					MethodName: "GetReplicationAckStates",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetReplicationAckStates,
+							NewRequest:  newAdminAPIServiceGetReplicationAckStatesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "GetWorkflowExecutionRawHistoryV2",
 					Handler: protobuf.NewUnaryHandler(
@@ -401,6 +435,116 @@ func buildAdminAPIYARPCProcedures(params buildAdminAPIYARPCProceduresParams) []t
 						},
 					),
 				},
+				{
+					MethodName: "GetDomainReplicationWatermark",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDomainReplicationWatermark,
+							NewRequest:  newAdminAPIServiceGetDomainReplicationWatermarkYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "IssueDomainToken",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.IssueDomainToken,
+							NewRequest:  newAdminAPIServiceIssueDomainTokenYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "StealTaskListLease",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.StealTaskListLease,
+							NewRequest:  newAdminAPIServiceStealTaskListLeaseYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeTaskListConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeTaskListConfig,
+							NewRequest:  newAdminAPIServiceDescribeTaskListConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetTaskListUsage",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetTaskListUsage,
+							NewRequest:  newAdminAPIServiceGetTaskListUsageYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateTaskListTags",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateTaskListTags,
+							NewRequest:  newAdminAPIServiceUpdateTaskListTagsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ListTaskListTags",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ListTaskListTags,
+							NewRequest:  newAdminAPIServiceListTaskListTagsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "BackfillDomainReplication",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.BackfillDomainReplication,
+							NewRequest:  newAdminAPIServiceBackfillDomainReplicationYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomainAsyncWorkflowConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.UpdateDomainAsyncWorkflowConfig,
+							NewRequest:  newAdminAPIServiceUpdateDomainAsyncWorkflowConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "GetDomainAsyncWorkflowConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDomainAsyncWorkflowConfig,
+							NewRequest:  newAdminAPIServiceGetDomainAsyncWorkflowConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ReconcileDomainRetention",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ReconcileDomainRetention,
+							NewRequest:  newAdminAPIServiceReconcileDomainRetentionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -597,6 +741,18 @@ func (c *_AdminAPIYARPCCaller) DescribeQueue(ctx context.Context, request *Descr
 	return response, err
 }
 
+func (c *_AdminAPIYARPCCaller) GetReplicationAckStates(ctx context.Context, request *GetReplicationAckStatesRequest, options ...yarpc.CallOption) (*GetReplicationAckStatesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetReplicationAckStates", request, newAdminAPIServiceGetReplicationAckStatesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetReplicationAckStatesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetReplicationAckStatesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_AdminAPIYARPCCaller) GetWorkflowExecutionRawHistoryV2(ctx context.Context, request *GetWorkflowExecutionRawHistoryV2Request, options ...yarpc.CallOption) (*GetWorkflowExecutionRawHistoryV2Response, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "GetWorkflowExecutionRawHistoryV2", request, newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse, options...)
 	if responseMessage == nil {
@@ -837,6 +993,138 @@ func (c *_AdminAPIYARPCCaller) MaintainCorruptWorkflow(ctx context.Context, requ
 	return response, err
 }
 
+func (c *_AdminAPIYARPCCaller) GetDomainReplicationWatermark(ctx context.Context, request *GetDomainReplicationWatermarkRequest, options ...yarpc.CallOption) (*GetDomainReplicationWatermarkResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDomainReplicationWatermark", request, newAdminAPIServiceGetDomainReplicationWatermarkYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDomainReplicationWatermarkResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainReplicationWatermarkYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) IssueDomainToken(ctx context.Context, request *IssueDomainTokenRequest, options ...yarpc.CallOption) (*IssueDomainTokenResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "IssueDomainToken", request, newAdminAPIServiceIssueDomainTokenYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*IssueDomainTokenResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceIssueDomainTokenYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) StealTaskListLease(ctx context.Context, request *StealTaskListLeaseRequest, options ...yarpc.CallOption) (*StealTaskListLeaseResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "StealTaskListLease", request, newAdminAPIServiceStealTaskListLeaseYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StealTaskListLeaseResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceStealTaskListLeaseYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) DescribeTaskListConfig(ctx context.Context, request *DescribeTaskListConfigRequest, options ...yarpc.CallOption) (*DescribeTaskListConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskListConfig", request, newAdminAPIServiceDescribeTaskListConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeTaskListConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceDescribeTaskListConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetTaskListUsage(ctx context.Context, request *GetTaskListUsageRequest, options ...yarpc.CallOption) (*GetTaskListUsageResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetTaskListUsage", request, newAdminAPIServiceGetTaskListUsageYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetTaskListUsageResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetTaskListUsageYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) UpdateTaskListTags(ctx context.Context, request *UpdateTaskListTagsRequest, options ...yarpc.CallOption) (*UpdateTaskListTagsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateTaskListTags", request, newAdminAPIServiceUpdateTaskListTagsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateTaskListTagsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceUpdateTaskListTagsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ListTaskListTags(ctx context.Context, request *ListTaskListTagsRequest, options ...yarpc.CallOption) (*ListTaskListTagsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListTaskListTags", request, newAdminAPIServiceListTaskListTagsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListTaskListTagsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceListTaskListTagsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) BackfillDomainReplication(ctx context.Context, request *BackfillDomainReplicationRequest, options ...yarpc.CallOption) (*BackfillDomainReplicationResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "BackfillDomainReplication", request, newAdminAPIServiceBackfillDomainReplicationYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*BackfillDomainReplicationResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceBackfillDomainReplicationYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) UpdateDomainAsyncWorkflowConfig(ctx context.Context, request *UpdateDomainAsyncWorkflowConfigRequest, options ...yarpc.CallOption) (*UpdateDomainAsyncWorkflowConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomainAsyncWorkflowConfig", request, newAdminAPIServiceUpdateDomainAsyncWorkflowConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainAsyncWorkflowConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDomainAsyncWorkflowConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) GetDomainAsyncWorkflowConfig(ctx context.Context, request *GetDomainAsyncWorkflowConfigRequest, options ...yarpc.CallOption) (*GetDomainAsyncWorkflowConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDomainAsyncWorkflowConfig", request, newAdminAPIServiceGetDomainAsyncWorkflowConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDomainAsyncWorkflowConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainAsyncWorkflowConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_AdminAPIYARPCCaller) ReconcileDomainRetention(ctx context.Context, request *ReconcileDomainRetentionRequest, options ...yarpc.CallOption) (*ReconcileDomainRetentionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ReconcileDomainRetention", request, newAdminAPIServiceReconcileDomainRetentionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ReconcileDomainRetentionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyAdminAPIServiceReconcileDomainRetentionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _AdminAPIYARPCHandler struct {
 	server AdminAPIYARPCServer
 }
@@ -953,6 +1241,22 @@ func (h *_AdminAPIYARPCHandler) DescribeQueue(ctx context.Context, requestMessag
 	return response, err
 }
 
+func (h *_AdminAPIYARPCHandler) GetReplicationAckStates(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetReplicationAckStatesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetReplicationAckStatesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetReplicationAckStatesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetReplicationAckStates(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_AdminAPIYARPCHandler) GetWorkflowExecutionRawHistoryV2(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *GetWorkflowExecutionRawHistoryV2Request
 	var ok bool
@@ -1273,6 +1577,182 @@ func (h *_AdminAPIYARPCHandler) MaintainCorruptWorkflow(ctx context.Context, req
 	return response, err
 }
 
+func (h *_AdminAPIYARPCHandler) GetDomainReplicationWatermark(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDomainReplicationWatermarkRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDomainReplicationWatermarkRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainReplicationWatermarkYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDomainReplicationWatermark(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) IssueDomainToken(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *IssueDomainTokenRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*IssueDomainTokenRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceIssueDomainTokenYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.IssueDomainToken(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) StealTaskListLease(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *StealTaskListLeaseRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*StealTaskListLeaseRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceStealTaskListLeaseYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.StealTaskListLease(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) DescribeTaskListConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeTaskListConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeTaskListConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceDescribeTaskListConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeTaskListConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetTaskListUsage(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetTaskListUsageRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetTaskListUsageRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetTaskListUsageYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetTaskListUsage(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) UpdateTaskListTags(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateTaskListTagsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateTaskListTagsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceUpdateTaskListTagsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateTaskListTags(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ListTaskListTags(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListTaskListTagsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListTaskListTagsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceListTaskListTagsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListTaskListTags(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) BackfillDomainReplication(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *BackfillDomainReplicationRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*BackfillDomainReplicationRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceBackfillDomainReplicationYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.BackfillDomainReplication(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) UpdateDomainAsyncWorkflowConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainAsyncWorkflowConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainAsyncWorkflowConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceUpdateDomainAsyncWorkflowConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomainAsyncWorkflowConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) GetDomainAsyncWorkflowConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDomainAsyncWorkflowConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDomainAsyncWorkflowConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceGetDomainAsyncWorkflowConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDomainAsyncWorkflowConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_AdminAPIYARPCHandler) ReconcileDomainRetention(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ReconcileDomainRetentionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ReconcileDomainRetentionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyAdminAPIServiceReconcileDomainRetentionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ReconcileDomainRetention(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newAdminAPIServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
 	return &DescribeWorkflowExecutionRequest{}
 }
@@ -1329,6 +1809,14 @@ func newAdminAPIServiceDescribeQueueYARPCResponse() proto.Message {
 	return &DescribeQueueResponse{}
 }
 
+func newAdminAPIServiceGetReplicationAckStatesYARPCRequest() proto.Message {
+	return &GetReplicationAckStatesRequest{}
+}
+
+func newAdminAPIServiceGetReplicationAckStatesYARPCResponse() proto.Message {
+	return &GetReplicationAckStatesResponse{}
+}
+
 func newAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest() proto.Message {
 	return &GetWorkflowExecutionRawHistoryV2Request{}
 }
@@ -1489,6 +1977,94 @@ func newAdminAPIServiceMaintainCorruptWorkflowYARPCResponse() proto.Message {
 	return &AdminMaintainWorkflowResponse{}
 }
 
+func newAdminAPIServiceGetDomainReplicationWatermarkYARPCRequest() proto.Message {
+	return &GetDomainReplicationWatermarkRequest{}
+}
+
+func newAdminAPIServiceGetDomainReplicationWatermarkYARPCResponse() proto.Message {
+	return &GetDomainReplicationWatermarkResponse{}
+}
+
+func newAdminAPIServiceIssueDomainTokenYARPCRequest() proto.Message {
+	return &IssueDomainTokenRequest{}
+}
+
+func newAdminAPIServiceIssueDomainTokenYARPCResponse() proto.Message {
+	return &IssueDomainTokenResponse{}
+}
+
+func newAdminAPIServiceStealTaskListLeaseYARPCRequest() proto.Message {
+	return &StealTaskListLeaseRequest{}
+}
+
+func newAdminAPIServiceStealTaskListLeaseYARPCResponse() proto.Message {
+	return &StealTaskListLeaseResponse{}
+}
+
+func newAdminAPIServiceDescribeTaskListConfigYARPCRequest() proto.Message {
+	return &DescribeTaskListConfigRequest{}
+}
+
+func newAdminAPIServiceDescribeTaskListConfigYARPCResponse() proto.Message {
+	return &DescribeTaskListConfigResponse{}
+}
+
+func newAdminAPIServiceGetTaskListUsageYARPCRequest() proto.Message {
+	return &GetTaskListUsageRequest{}
+}
+
+func newAdminAPIServiceGetTaskListUsageYARPCResponse() proto.Message {
+	return &GetTaskListUsageResponse{}
+}
+
+func newAdminAPIServiceUpdateTaskListTagsYARPCRequest() proto.Message {
+	return &UpdateTaskListTagsRequest{}
+}
+
+func newAdminAPIServiceUpdateTaskListTagsYARPCResponse() proto.Message {
+	return &UpdateTaskListTagsResponse{}
+}
+
+func newAdminAPIServiceListTaskListTagsYARPCRequest() proto.Message {
+	return &ListTaskListTagsRequest{}
+}
+
+func newAdminAPIServiceListTaskListTagsYARPCResponse() proto.Message {
+	return &ListTaskListTagsResponse{}
+}
+
+func newAdminAPIServiceBackfillDomainReplicationYARPCRequest() proto.Message {
+	return &BackfillDomainReplicationRequest{}
+}
+
+func newAdminAPIServiceBackfillDomainReplicationYARPCResponse() proto.Message {
+	return &BackfillDomainReplicationResponse{}
+}
+
+func newAdminAPIServiceUpdateDomainAsyncWorkflowConfigYARPCRequest() proto.Message {
+	return &UpdateDomainAsyncWorkflowConfigRequest{}
+}
+
+func newAdminAPIServiceUpdateDomainAsyncWorkflowConfigYARPCResponse() proto.Message {
+	return &UpdateDomainAsyncWorkflowConfigResponse{}
+}
+
+func newAdminAPIServiceGetDomainAsyncWorkflowConfigYARPCRequest() proto.Message {
+	return &GetDomainAsyncWorkflowConfigRequest{}
+}
+
+func newAdminAPIServiceGetDomainAsyncWorkflowConfigYARPCResponse() proto.Message {
+	return &GetDomainAsyncWorkflowConfigResponse{}
+}
+
+func newAdminAPIServiceReconcileDomainRetentionYARPCRequest() proto.Message {
+	return &ReconcileDomainRetentionRequest{}
+}
+
+func newAdminAPIServiceReconcileDomainRetentionYARPCResponse() proto.Message {
+	return &ReconcileDomainRetentionResponse{}
+}
+
 var (
 	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCRequest          = &DescribeWorkflowExecutionRequest{}
 	emptyAdminAPIServiceDescribeWorkflowExecutionYARPCResponse         = &DescribeWorkflowExecutionResponse{}
@@ -1504,6 +2080,8 @@ var (
 	emptyAdminAPIServiceResetQueueYARPCResponse                        = &ResetQueueResponse{}
 	emptyAdminAPIServiceDescribeQueueYARPCRequest                      = &DescribeQueueRequest{}
 	emptyAdminAPIServiceDescribeQueueYARPCResponse                     = &DescribeQueueResponse{}
+	emptyAdminAPIServiceGetReplicationAckStatesYARPCRequest            = &GetReplicationAckStatesRequest{}
+	emptyAdminAPIServiceGetReplicationAckStatesYARPCResponse           = &GetReplicationAckStatesResponse{}
 	emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCRequest   = &GetWorkflowExecutionRawHistoryV2Request{}
 	emptyAdminAPIServiceGetWorkflowExecutionRawHistoryV2YARPCResponse  = &GetWorkflowExecutionRawHistoryV2Response{}
 	emptyAdminAPIServiceGetReplicationMessagesYARPCRequest             = &GetReplicationMessagesRequest{}
@@ -1544,189 +2122,300 @@ var (
 	emptyAdminAPIServiceDeleteWorkflowYARPCResponse                    = &AdminDeleteWorkflowResponse{}
 	emptyAdminAPIServiceMaintainCorruptWorkflowYARPCRequest            = &AdminMaintainWorkflowRequest{}
 	emptyAdminAPIServiceMaintainCorruptWorkflowYARPCResponse           = &AdminMaintainWorkflowResponse{}
+	emptyAdminAPIServiceGetDomainReplicationWatermarkYARPCRequest      = &GetDomainReplicationWatermarkRequest{}
+	emptyAdminAPIServiceGetDomainReplicationWatermarkYARPCResponse     = &GetDomainReplicationWatermarkResponse{}
+	emptyAdminAPIServiceIssueDomainTokenYARPCRequest                   = &IssueDomainTokenRequest{}
+	emptyAdminAPIServiceIssueDomainTokenYARPCResponse                  = &IssueDomainTokenResponse{}
+	emptyAdminAPIServiceStealTaskListLeaseYARPCRequest                 = &StealTaskListLeaseRequest{}
+	emptyAdminAPIServiceStealTaskListLeaseYARPCResponse                = &StealTaskListLeaseResponse{}
+	emptyAdminAPIServiceDescribeTaskListConfigYARPCRequest             = &DescribeTaskListConfigRequest{}
+	emptyAdminAPIServiceDescribeTaskListConfigYARPCResponse            = &DescribeTaskListConfigResponse{}
+	emptyAdminAPIServiceGetTaskListUsageYARPCRequest                   = &GetTaskListUsageRequest{}
+	emptyAdminAPIServiceGetTaskListUsageYARPCResponse                  = &GetTaskListUsageResponse{}
+	emptyAdminAPIServiceUpdateTaskListTagsYARPCRequest                 = &UpdateTaskListTagsRequest{}
+	emptyAdminAPIServiceUpdateTaskListTagsYARPCResponse                = &UpdateTaskListTagsResponse{}
+	emptyAdminAPIServiceListTaskListTagsYARPCRequest                   = &ListTaskListTagsRequest{}
+	emptyAdminAPIServiceListTaskListTagsYARPCResponse                  = &ListTaskListTagsResponse{}
+	emptyAdminAPIServiceBackfillDomainReplicationYARPCRequest          = &BackfillDomainReplicationRequest{}
+	emptyAdminAPIServiceBackfillDomainReplicationYARPCResponse         = &BackfillDomainReplicationResponse{}
+	emptyAdminAPIServiceUpdateDomainAsyncWorkflowConfigYARPCRequest    = &UpdateDomainAsyncWorkflowConfigRequest{}
+	emptyAdminAPIServiceUpdateDomainAsyncWorkflowConfigYARPCResponse   = &UpdateDomainAsyncWorkflowConfigResponse{}
+	emptyAdminAPIServiceGetDomainAsyncWorkflowConfigYARPCRequest       = &GetDomainAsyncWorkflowConfigRequest{}
+	emptyAdminAPIServiceGetDomainAsyncWorkflowConfigYARPCResponse      = &GetDomainAsyncWorkflowConfigResponse{}
+	emptyAdminAPIServiceReconcileDomainRetentionYARPCRequest           = &ReconcileDomainRetentionRequest{}
+	emptyAdminAPIServiceReconcileDomainRetentionYARPCResponse          = &ReconcileDomainRetentionResponse{}
 )
 
 var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 	// uber/cadence/admin/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5b, 0xcb, 0x73, 0x1c, 0x47,
-		0x19, 0xcf, 0xec, 0x4a, 0xb2, 0xf4, 0xad, 0x25, 0x5b, 0x6d, 0x59, 0x8f, 0x91, 0xed, 0xc8, 0x93,
-		0x38, 0x56, 0x5e, 0xab, 0x78, 0x95, 0x84, 0x24, 0xae, 0x90, 0xc8, 0x2b, 0x5b, 0x56, 0x62, 0xc7,
-		0xf6, 0xd8, 0x71, 0x28, 0x8a, 0x62, 0x98, 0xdd, 0x69, 0x49, 0x83, 0x76, 0x67, 0xc6, 0xd3, 0xbd,
-		0xeb, 0x6c, 0x2a, 0x05, 0x29, 0xca, 0x9c, 0x78, 0x17, 0x07, 0x8e, 0x1c, 0xa0, 0x72, 0x80, 0x03,
-		0xc5, 0x9d, 0x33, 0x67, 0xf8, 0x27, 0x72, 0x09, 0x45, 0x15, 0xc5, 0x85, 0x23, 0xd5, 0x8f, 0xd9,
-		0x79, 0xf6, 0x3e, 0x84, 0x29, 0xa7, 0x72, 0xd3, 0x74, 0x7f, 0xaf, 0xfe, 0xf5, 0xd7, 0xdf, 0xf7,
-		0xf5, 0xd7, 0x2b, 0x78, 0xa6, 0xd3, 0xc0, 0xe1, 0x46, 0xd3, 0x76, 0xb0, 0xd7, 0xc4, 0x1b, 0xb6,
-		0xd3, 0x76, 0xbd, 0x8d, 0xee, 0xa5, 0x0d, 0x82, 0xc3, 0xae, 0xdb, 0xc4, 0xd5, 0x20, 0xf4, 0xa9,
-		0x8f, 0x4e, 0x33, 0xa2, 0xaa, 0x24, 0xaa, 0x72, 0xa2, 0x6a, 0xf7, 0x92, 0xfe, 0xf4, 0xbe, 0xef,
-		0xef, 0xb7, 0xf0, 0x06, 0x27, 0x6a, 0x74, 0xf6, 0x36, 0xa8, 0xdb, 0xc6, 0x84, 0xda, 0xed, 0x40,
-		0xf0, 0xe9, 0xe7, 0xb2, 0x04, 0x0f, 0x43, 0x3b, 0x08, 0x70, 0x48, 0xe4, 0xfc, 0x5a, 0x5a, 0x79,
-		0xe0, 0x32, 0xd5, 0x4d, 0xbf, 0xdd, 0xf6, 0x3d, 0x49, 0xf1, 0x6c, 0x11, 0x45, 0xd7, 0x25, 0x6e,
-		0xc3, 0x6d, 0xb9, 0xb4, 0x57, 0x48, 0x45, 0x0e, 0xec, 0x10, 0x3b, 0x5c, 0x54, 0xab, 0x43, 0x28,
-		0x0e, 0x87, 0x50, 0x1d, 0xb8, 0x84, 0xfa, 0x61, 0x24, 0xcb, 0x50, 0x50, 0x3d, 0xe8, 0xe0, 0x8e,
-		0xc4, 0x43, 0x5f, 0x57, 0xd0, 0x84, 0x38, 0x68, 0xb9, 0x4d, 0x9b, 0xba, 0x91, 0xfd, 0xc6, 0xaf,
-		0x34, 0x58, 0xdb, 0xc6, 0xa4, 0x19, 0xba, 0x0d, 0xfc, 0x91, 0x1f, 0x1e, 0xee, 0xb5, 0xfc, 0x87,
-		0x57, 0x3f, 0xc6, 0xcd, 0x0e, 0xa3, 0x31, 0xf1, 0x83, 0x0e, 0x26, 0x14, 0x2d, 0xc2, 0x94, 0xe3,
-		0xb7, 0x6d, 0xd7, 0x5b, 0xd6, 0xd6, 0xb4, 0xf5, 0x19, 0x53, 0x7e, 0xa1, 0x0f, 0x01, 0x3d, 0x94,
-		0x3c, 0x16, 0x8e, 0x98, 0x96, 0x4b, 0x6b, 0xda, 0x7a, 0xa5, 0xf6, 0x5c, 0x35, 0xbd, 0x27, 0x81,
-		0x5b, 0xed, 0x5e, 0xaa, 0xe6, 0x55, 0xcc, 0x3f, 0xcc, 0x0e, 0x19, 0x7f, 0xd7, 0xe0, 0xfc, 0x00,
-		0x9b, 0x48, 0xe0, 0x7b, 0x04, 0xa3, 0x15, 0x98, 0x66, 0x0b, 0x73, 0x2c, 0xd7, 0xe1, 0x66, 0x4d,
-		0x9a, 0xc7, 0xf8, 0xf7, 0xae, 0x83, 0xce, 0xc3, 0x71, 0x89, 0x99, 0x65, 0x3b, 0x4e, 0xc8, 0x2d,
-		0x9a, 0x31, 0x2b, 0x72, 0x6c, 0xcb, 0x71, 0x42, 0xb4, 0x09, 0x8b, 0xed, 0x0e, 0xb5, 0x1b, 0x2d,
-		0x6c, 0x11, 0x6a, 0x53, 0x6c, 0xb9, 0x9e, 0xd5, 0xb4, 0x9b, 0x07, 0x78, 0xb9, 0xcc, 0x89, 0x4f,
-		0xc9, 0xd9, 0xbb, 0x6c, 0x72, 0xd7, 0xab, 0xb3, 0x29, 0xf4, 0x26, 0xac, 0xe4, 0x98, 0x1c, 0x9b,
-		0xda, 0x0d, 0x9b, 0xe0, 0xe5, 0x09, 0xce, 0xb7, 0x98, 0xe6, 0xdb, 0x96, 0xb3, 0xc6, 0x5f, 0x35,
-		0xd0, 0xa3, 0x35, 0x5d, 0x17, 0x76, 0x5c, 0xf7, 0x09, 0x8d, 0x10, 0x7e, 0x06, 0x8e, 0x1f, 0xf8,
-		0x84, 0x72, 0x73, 0x31, 0x21, 0x02, 0xe7, 0xeb, 0x4f, 0x99, 0x15, 0x36, 0xba, 0x25, 0x06, 0xd1,
-		0x6a, 0x62, 0xc5, 0x6c, 0x49, 0x93, 0xd7, 0x9f, 0x8a, 0xd7, 0xfc, 0x51, 0xe1, 0x5e, 0x94, 0xc7,
-		0xd9, 0x8b, 0xeb, 0x4f, 0x15, 0xec, 0xc6, 0x95, 0x59, 0xa8, 0x38, 0xd2, 0x70, 0xab, 0xd1, 0x33,
-		0xbe, 0x15, 0xfb, 0xcb, 0x5d, 0xa6, 0x7a, 0xdb, 0x25, 0x34, 0x74, 0x1b, 0x29, 0x7f, 0x59, 0x85,
-		0x99, 0xc0, 0xde, 0xc7, 0x16, 0x71, 0x3f, 0xc1, 0x72, 0x6f, 0xa6, 0xd9, 0xc0, 0x5d, 0xf7, 0x13,
-		0x8c, 0x96, 0xe0, 0x18, 0x9f, 0x8c, 0x16, 0x61, 0x4e, 0xb1, 0xcf, 0x5d, 0xc7, 0xf8, 0x22, 0xb1,
-		0xed, 0x05, 0xa2, 0xe5, 0xb6, 0xaf, 0xc3, 0x49, 0xaf, 0xd3, 0x6e, 0xe0, 0xd0, 0xf2, 0xf7, 0x2c,
-		0xbe, 0x78, 0x22, 0x55, 0xcc, 0x89, 0xf1, 0x5b, 0x7b, 0x9c, 0x99, 0xa0, 0xef, 0xc0, 0x94, 0x9c,
-		0x2f, 0xad, 0x95, 0xd7, 0x2b, 0xb5, 0xed, 0x6a, 0x61, 0x94, 0xa8, 0x0e, 0xd5, 0x59, 0x15, 0x02,
-		0xaf, 0x7a, 0x34, 0xec, 0x99, 0x52, 0xa6, 0xfe, 0x26, 0x54, 0x12, 0xc3, 0xe8, 0x24, 0x94, 0x0f,
-		0x71, 0x4f, 0x5a, 0xc2, 0xfe, 0x44, 0x0b, 0x30, 0xd9, 0xb5, 0x5b, 0x1d, 0x2c, 0xbd, 0x4f, 0x7c,
-		0xbc, 0x55, 0x7a, 0x43, 0x33, 0x7e, 0x54, 0x82, 0xd5, 0x42, 0x5f, 0x18, 0x7b, 0x89, 0xab, 0x30,
-		0x13, 0x79, 0x84, 0x58, 0xe5, 0xa4, 0x39, 0x2d, 0x1d, 0x82, 0xa0, 0xf7, 0xe0, 0xb8, 0x38, 0xa7,
-		0x09, 0xc7, 0xae, 0xd4, 0x2e, 0xa6, 0x51, 0x10, 0xb1, 0x81, 0xc3, 0xc0, 0x69, 0xb9, 0xa3, 0xef,
-		0x7a, 0x7b, 0xbe, 0x59, 0x71, 0xe2, 0x01, 0xf4, 0x3a, 0x2c, 0x09, 0x45, 0x4d, 0xdf, 0xa3, 0xa1,
-		0xdf, 0x6a, 0xe1, 0x90, 0x1f, 0x81, 0x0e, 0x91, 0x7e, 0x7f, 0x9a, 0x4f, 0xd7, 0xfb, 0xb3, 0x77,
-		0xf9, 0x24, 0x5a, 0x86, 0x63, 0x91, 0x4b, 0x4f, 0x72, 0xba, 0xe8, 0xd3, 0xa8, 0xc2, 0x7c, 0xbd,
-		0xe5, 0x13, 0x81, 0x7a, 0xe4, 0x38, 0xea, 0x33, 0x6d, 0x2c, 0x00, 0x4a, 0xd2, 0x0b, 0xa8, 0x8c,
-		0x7f, 0x6a, 0x30, 0x6f, 0xe2, 0xb6, 0xdf, 0xc5, 0xf7, 0x6c, 0x72, 0x38, 0x5c, 0x0c, 0x7a, 0x1b,
-		0x66, 0xa8, 0x4d, 0x0e, 0x2d, 0xda, 0x0b, 0xc4, 0xce, 0xcc, 0xd5, 0xd6, 0x54, 0x88, 0x30, 0x91,
-		0xf7, 0x7a, 0x01, 0x36, 0xa7, 0xa9, 0xfc, 0x8b, 0x39, 0x2f, 0x67, 0x77, 0x1d, 0x0e, 0x67, 0xd9,
-		0x9c, 0x62, 0x9f, 0xbb, 0x0e, 0xaa, 0xc3, 0x89, 0x38, 0xea, 0x5b, 0x2c, 0xcf, 0x70, 0x60, 0x2a,
-		0x35, 0xbd, 0x2a, 0x72, 0x4c, 0x35, 0xca, 0x31, 0xd5, 0x7b, 0x51, 0x12, 0x32, 0xe7, 0x62, 0x16,
-		0x36, 0xc8, 0xe2, 0x96, 0xcc, 0x08, 0x96, 0x67, 0xb7, 0xb1, 0x84, 0xac, 0x22, 0xc7, 0x3e, 0xb0,
-		0xdb, 0x98, 0xc1, 0x90, 0x5c, 0xaf, 0x84, 0xe1, 0x97, 0x1c, 0x06, 0x82, 0xe9, 0x1d, 0x96, 0x04,
-		0x46, 0x80, 0x21, 0xab, 0xa9, 0x94, 0xd3, 0x94, 0x46, 0xaa, 0x3c, 0x2e, 0x52, 0xc2, 0xd0, 0xd8,
-		0x22, 0x69, 0xe8, 0xaf, 0x35, 0x58, 0x88, 0x5c, 0xff, 0xab, 0x63, 0xeb, 0x2d, 0x38, 0x9d, 0x31,
-		0x4a, 0x9e, 0xc4, 0xd7, 0x61, 0x29, 0x08, 0xfd, 0x26, 0x26, 0xc4, 0xf5, 0xf6, 0x2d, 0x9e, 0x61,
-		0x45, 0xe4, 0x67, 0x07, 0xb2, 0xcc, 0xdc, 0x3e, 0x9e, 0xe6, 0x9c, 0x3c, 0xec, 0x13, 0xe3, 0xdf,
-		0x25, 0xb8, 0xb8, 0x83, 0x69, 0x3e, 0x79, 0xd9, 0x0f, 0xe5, 0x81, 0xbf, 0x5f, 0x7b, 0x32, 0xc9,
-		0x15, 0xbd, 0x0f, 0x15, 0x42, 0xed, 0x90, 0x5a, 0xb8, 0x8b, 0x3d, 0x2a, 0x83, 0xc2, 0x0b, 0x2a,
-		0xb0, 0xee, 0xe3, 0x90, 0xb0, 0xcc, 0x20, 0x8c, 0xde, 0xa5, 0xb8, 0x6d, 0x02, 0x67, 0xbf, 0xca,
-		0xb8, 0xd1, 0x0e, 0xcc, 0x60, 0xcf, 0x91, 0xa2, 0x26, 0xc6, 0x16, 0x35, 0x8d, 0x3d, 0x47, 0x08,
-		0x4a, 0x65, 0x8c, 0xc9, 0x4c, 0xc6, 0x78, 0x0e, 0x4e, 0x78, 0xf8, 0x63, 0x6a, 0x71, 0x0a, 0xea,
-		0x1f, 0x62, 0x6f, 0x79, 0x6a, 0x4d, 0x5b, 0x3f, 0x6e, 0xce, 0xb2, 0xe1, 0xdb, 0xf6, 0x3e, 0xbe,
-		0xc7, 0x06, 0x8d, 0x2f, 0x35, 0x58, 0x1f, 0x8e, 0xba, 0xdc, 0xda, 0x02, 0xa1, 0x5a, 0x81, 0x50,
-		0x74, 0x0d, 0x4e, 0x44, 0xb5, 0x44, 0xc3, 0xa6, 0xcd, 0x03, 0x1c, 0xa5, 0x93, 0xb3, 0x85, 0x7b,
-		0xc0, 0x12, 0xfe, 0x95, 0x96, 0xdf, 0x30, 0xe7, 0x24, 0xd7, 0x15, 0xc1, 0x84, 0x6e, 0xc1, 0x89,
-		0xae, 0x40, 0xc0, 0x92, 0x33, 0xc5, 0xc9, 0x59, 0x05, 0x98, 0x39, 0xd7, 0x4d, 0x7d, 0x1b, 0x8f,
-		0x34, 0x38, 0xbb, 0x83, 0xa9, 0x19, 0x97, 0x74, 0x37, 0x31, 0x21, 0xf6, 0x3e, 0x26, 0x91, 0x67,
-		0xbd, 0x0b, 0x53, 0x7c, 0x61, 0xc2, 0x59, 0x2b, 0xb5, 0x75, 0x95, 0xa6, 0x84, 0x0c, 0xbe, 0x68,
-		0x53, 0xf2, 0x8d, 0x70, 0xf4, 0x8c, 0xcf, 0x4a, 0x70, 0x4e, 0x65, 0x86, 0x84, 0xda, 0x87, 0x39,
-		0x71, 0xb6, 0xdb, 0x72, 0x46, 0xda, 0x73, 0x5d, 0x91, 0x90, 0x07, 0x8b, 0x13, 0xd9, 0x38, 0x1a,
-		0x15, 0x49, 0x79, 0x96, 0x24, 0xc7, 0xf4, 0x36, 0xa0, 0x3c, 0x51, 0x41, 0x8a, 0xde, 0x4a, 0xa6,
-		0xe8, 0x4a, 0xed, 0xc5, 0x11, 0xf0, 0xe9, 0x5b, 0x93, 0xc8, 0xe7, 0x1e, 0xac, 0xed, 0x60, 0xba,
-		0x7d, 0xe3, 0xce, 0x80, 0xbd, 0x78, 0x0f, 0x40, 0x24, 0x0e, 0x6f, 0xcf, 0x8f, 0xd6, 0x3f, 0x8a,
-		0x3e, 0x16, 0xad, 0x78, 0x3a, 0xe6, 0x01, 0x8e, 0xfd, 0x45, 0x8c, 0x1e, 0x9c, 0x1f, 0xa0, 0x4f,
-		0x82, 0x7e, 0x0f, 0xe6, 0x13, 0xd5, 0xbe, 0xc5, 0xb8, 0x23, 0xbd, 0x17, 0x47, 0xd4, 0x6b, 0x9e,
-		0x0c, 0xd3, 0x03, 0xc4, 0xf8, 0x8f, 0x06, 0xcf, 0x30, 0xdd, 0x3c, 0x44, 0x0d, 0x58, 0xee, 0x7d,
-		0x58, 0x69, 0xd9, 0x84, 0x5a, 0x21, 0xa6, 0xa1, 0x8b, 0xbb, 0xb8, 0xbf, 0xf7, 0x51, 0x7c, 0xaf,
-		0xd4, 0x56, 0x73, 0x89, 0x71, 0xd7, 0xa3, 0xaf, 0xbf, 0x7a, 0x9f, 0xc1, 0x6a, 0x2e, 0x32, 0x6e,
-		0x33, 0x62, 0x96, 0xd2, 0x77, 0x9d, 0xbe, 0x5c, 0x19, 0x76, 0xd3, 0x72, 0x4b, 0x23, 0xca, 0xbd,
-		0x1d, 0x31, 0xc7, 0x72, 0xb3, 0x8e, 0x5e, 0xce, 0x3b, 0xba, 0x0f, 0xcf, 0x0e, 0x5e, 0xb9, 0x04,
-		0x7e, 0x07, 0xa6, 0x13, 0x7e, 0x3e, 0xb6, 0x5f, 0xf5, 0x99, 0x8d, 0xbf, 0x68, 0xb0, 0x60, 0x62,
-		0x3b, 0x08, 0x5a, 0x3d, 0x1e, 0x24, 0xc9, 0x13, 0xca, 0x18, 0xaf, 0xc1, 0x14, 0x0f, 0xf0, 0x44,
-		0x06, 0xac, 0x21, 0x81, 0x4f, 0x12, 0x1b, 0x4b, 0x70, 0x3a, 0x63, 0xbd, 0xac, 0x01, 0x7e, 0x5b,
-		0x82, 0x95, 0x2d, 0xc7, 0xb9, 0x8b, 0xed, 0xb0, 0x79, 0xb0, 0x45, 0x45, 0xb9, 0xdd, 0x2f, 0x04,
-		0x02, 0x38, 0x49, 0xf8, 0x8c, 0x65, 0x47, 0x53, 0xd2, 0x6d, 0xaf, 0x2a, 0xc2, 0x85, 0x52, 0x56,
-		0x35, 0x33, 0x2c, 0x62, 0xc5, 0x09, 0x92, 0x1e, 0x45, 0x17, 0x60, 0x8e, 0xe0, 0x66, 0x27, 0xe4,
-		0x85, 0x1b, 0x4f, 0x04, 0x22, 0xcc, 0xcd, 0x46, 0xa3, 0x3c, 0x26, 0xea, 0x2e, 0x2c, 0x14, 0xc9,
-		0x4b, 0x86, 0x95, 0x19, 0x11, 0x56, 0x2e, 0x27, 0xc3, 0xca, 0x5c, 0xed, 0x42, 0x21, 0x5e, 0xbb,
-		0x9e, 0x83, 0x3f, 0xc6, 0x0e, 0x77, 0x4b, 0x5e, 0x8e, 0x24, 0x02, 0xca, 0x19, 0xd0, 0x8b, 0x16,
-		0x25, 0xf1, 0x5b, 0x86, 0xc5, 0xa8, 0x5a, 0xa9, 0x0b, 0xff, 0x94, 0xeb, 0x35, 0xfe, 0x5c, 0x86,
-		0xa5, 0xdc, 0x94, 0x74, 0xcb, 0x03, 0x58, 0x21, 0x9d, 0x20, 0xf0, 0x43, 0x8a, 0x1d, 0xab, 0xd9,
-		0x72, 0xb1, 0x47, 0x2d, 0x99, 0x51, 0x22, 0x3f, 0x7d, 0xa9, 0xd0, 0xd0, 0xbb, 0x11, 0x57, 0x9d,
-		0x33, 0xc9, 0xac, 0x44, 0xcc, 0x25, 0x52, 0x3c, 0xc1, 0x32, 0x5d, 0x1b, 0xb3, 0x6b, 0x0a, 0x39,
-		0x70, 0x03, 0x1e, 0xf0, 0x8a, 0x7d, 0x30, 0x3e, 0x07, 0x37, 0xfb, 0xe4, 0x3c, 0xd4, 0xcd, 0xb5,
-		0x53, 0xdf, 0xc8, 0x83, 0x93, 0x01, 0x13, 0x4e, 0x28, 0xe3, 0x13, 0x12, 0xcb, 0xdc, 0x25, 0xea,
-		0x43, 0xae, 0x74, 0x19, 0x10, 0xaa, 0xb7, 0x63, 0x31, 0x4c, 0xb2, 0x74, 0x88, 0x20, 0x3d, 0xaa,
-		0x1f, 0xc2, 0x42, 0x11, 0x61, 0xc1, 0x4e, 0xbf, 0x9d, 0x4e, 0x20, 0xca, 0xc0, 0x9a, 0x11, 0x97,
-		0xdc, 0xeb, 0x3f, 0x94, 0x60, 0xd1, 0xc4, 0xb6, 0xb3, 0x7d, 0xe3, 0x4e, 0x36, 0x88, 0x6e, 0xc2,
-		0x04, 0x2f, 0x68, 0x35, 0xee, 0x46, 0x4f, 0x2b, 0x2f, 0x6e, 0x37, 0xee, 0x70, 0x07, 0xe2, 0xc4,
-		0xa9, 0x42, 0xba, 0x94, 0x2e, 0xa4, 0x99, 0xa3, 0xfb, 0x9d, 0xb0, 0x89, 0x2d, 0x19, 0xd7, 0x64,
-		0x98, 0x9b, 0x15, 0xa3, 0x12, 0x2c, 0x74, 0x0f, 0x96, 0x5d, 0x8f, 0x51, 0xb8, 0x5d, 0x6c, 0xb1,
-		0xf2, 0x2e, 0x11, 0x62, 0x27, 0x86, 0x87, 0xd8, 0xd3, 0x7d, 0xe6, 0xab, 0x5e, 0x22, 0xc2, 0x3e,
-		0x96, 0x0a, 0xef, 0x4f, 0x25, 0x58, 0xca, 0x81, 0x25, 0x1d, 0xfc, 0x48, 0x68, 0x15, 0x66, 0xc9,
-		0xd2, 0xff, 0x98, 0x25, 0x91, 0x0d, 0x8b, 0x39, 0xa9, 0x49, 0xb7, 0x1d, 0x2b, 0xf1, 0x2f, 0x64,
-		0xc5, 0xf3, 0x33, 0x51, 0x80, 0xd8, 0x44, 0x11, 0x62, 0x5f, 0x68, 0xb0, 0x74, 0xbb, 0x13, 0xee,
-		0xe3, 0xaf, 0xb9, 0x7f, 0x19, 0x3a, 0x2c, 0xe7, 0xd7, 0x29, 0x23, 0xe6, 0x1f, 0x4b, 0xb0, 0x74,
-		0x13, 0x7f, 0xfd, 0x41, 0x78, 0x3c, 0x87, 0xec, 0x0a, 0x2c, 0xe7, 0xc1, 0x1a, 0xef, 0xd6, 0x64,
-		0xfc, 0x54, 0x83, 0x55, 0x13, 0xef, 0x85, 0x98, 0x1c, 0x44, 0x35, 0x06, 0xf7, 0xdd, 0x27, 0xd4,
-		0x51, 0x3e, 0x07, 0x67, 0x8a, 0xad, 0x91, 0x0e, 0xf2, 0xb7, 0x12, 0x9c, 0x35, 0x31, 0xc1, 0x9e,
-		0x93, 0x39, 0x81, 0x24, 0xd1, 0xd2, 0x94, 0xcd, 0x34, 0x59, 0xc0, 0xce, 0x98, 0xd3, 0x62, 0x60,
-		0xd7, 0xf9, 0x7f, 0x15, 0x5e, 0x17, 0x60, 0x2e, 0xc4, 0x6d, 0x9f, 0xe6, 0x5c, 0x49, 0x8c, 0x46,
-		0xae, 0x94, 0xb9, 0xd1, 0x4f, 0x3c, 0xbe, 0x1b, 0xfd, 0xe4, 0xd1, 0x6f, 0xf4, 0xc6, 0x1a, 0x9c,
-		0x53, 0x21, 0x2a, 0x41, 0xb7, 0x61, 0x75, 0x07, 0xd3, 0x7a, 0xe8, 0x13, 0x22, 0x97, 0x92, 0x45,
-		0x3c, 0xee, 0x6d, 0x6a, 0x99, 0xde, 0xe6, 0x05, 0x98, 0xa3, 0x76, 0xb8, 0x8f, 0x69, 0x1f, 0x1a,
-		0x59, 0xb3, 0x89, 0x51, 0x29, 0xcf, 0xf8, 0x57, 0x19, 0xce, 0x14, 0xeb, 0x90, 0xfe, 0x7c, 0xc8,
-		0xe4, 0xb0, 0xe8, 0xdc, 0xe8, 0x89, 0x4e, 0xeb, 0x90, 0x5a, 0x73, 0x90, 0x30, 0xde, 0x59, 0x22,
-		0x57, 0x7a, 0xfc, 0xea, 0x29, 0x4a, 0x8b, 0xe3, 0x34, 0x31, 0x84, 0x7e, 0x00, 0xa7, 0xf7, 0x6c,
-		0xb7, 0xc5, 0xea, 0x2f, 0xbb, 0x43, 0x70, 0xac, 0x53, 0x24, 0x9c, 0xf7, 0x8f, 0xa2, 0xf3, 0x1a,
-		0x17, 0x58, 0x67, 0xf2, 0x52, 0x9a, 0xd1, 0x5e, 0x6e, 0x42, 0x7f, 0x00, 0xf3, 0x39, 0x13, 0x0b,
-		0x6e, 0xc5, 0xd7, 0xd2, 0x45, 0xcd, 0x2b, 0xaa, 0xed, 0xcf, 0x1a, 0x25, 0x37, 0x2e, 0x79, 0x35,
-		0xd6, 0x1f, 0xc0, 0x92, 0xc2, 0xc2, 0x02, 0xc5, 0xef, 0xa6, 0xeb, 0x66, 0xa5, 0xdf, 0xed, 0x60,
-		0xca, 0xf4, 0x25, 0x04, 0x27, 0x0b, 0xaa, 0x2f, 0x35, 0x58, 0x17, 0xf0, 0x38, 0x39, 0xd8, 0xea,
-		0x7e, 0x3b, 0x68, 0x61, 0x8a, 0x47, 0x68, 0x38, 0x8f, 0xe8, 0x62, 0xe8, 0x23, 0xe1, 0x41, 0x56,
-		0x28, 0x77, 0x84, 0xc8, 0x1c, 0x3f, 0x06, 0x6c, 0x82, 0x91, 0x09, 0x8e, 0xbf, 0x08, 0x7a, 0x16,
-		0x66, 0xf7, 0x30, 0x6d, 0x1e, 0x7c, 0x80, 0x45, 0xb0, 0xe2, 0x07, 0x7b, 0xda, 0x4c, 0x0f, 0x1a,
-		0x04, 0x9e, 0x1f, 0x61, 0xb1, 0xd2, 0xdb, 0xaf, 0xc1, 0x64, 0xd4, 0x07, 0x38, 0xe2, 0xce, 0x72,
-		0x76, 0xe3, 0x33, 0x0d, 0x96, 0xd8, 0x5d, 0xb8, 0xe7, 0xd9, 0x6d, 0xb7, 0x59, 0xf7, 0xbd, 0x3d,
-		0x77, 0x3f, 0x42, 0xf4, 0x69, 0xa8, 0x34, 0xf9, 0x80, 0xb8, 0x48, 0x8b, 0x50, 0x09, 0x62, 0x88,
-		0xf7, 0x6a, 0xb7, 0xe1, 0xd8, 0x9e, 0xdb, 0xa2, 0x38, 0x8c, 0x0a, 0xad, 0x17, 0x54, 0x45, 0x7c,
-		0x52, 0xfc, 0x35, 0xce, 0x62, 0x46, 0xac, 0xc6, 0x2d, 0x58, 0xce, 0x5b, 0xd0, 0xaf, 0x04, 0xa5,
-		0x1f, 0x69, 0xa3, 0xdc, 0x57, 0x05, 0xad, 0xf1, 0x33, 0x0d, 0xf4, 0x0f, 0x03, 0xc7, 0xa6, 0xf8,
-		0x68, 0xcb, 0xfa, 0x00, 0x66, 0x25, 0x01, 0x97, 0x17, 0x2d, 0xee, 0xf9, 0x51, 0x16, 0x27, 0x72,
-		0xfa, 0xf1, 0x66, 0xfc, 0x41, 0x8c, 0xb3, 0xb0, 0x5a, 0x68, 0x8e, 0x0c, 0x9e, 0x8f, 0x78, 0x82,
-		0x65, 0x81, 0x17, 0x3f, 0xc9, 0x6d, 0xe0, 0x89, 0xb5, 0xc8, 0x0a, 0x69, 0xe6, 0x4f, 0x34, 0x76,
-		0x95, 0x6d, 0xbb, 0xde, 0x36, 0x66, 0xae, 0x18, 0xa5, 0xbd, 0x27, 0x54, 0x06, 0xfc, 0x5e, 0x83,
-		0xd5, 0x42, 0x6b, 0xa4, 0xe3, 0x5c, 0x8c, 0x7b, 0xbd, 0x0e, 0xa7, 0x10, 0x41, 0x61, 0xba, 0xdf,
-		0xcc, 0x15, 0x7c, 0x0e, 0x7a, 0x19, 0x50, 0xdf, 0x2c, 0xd2, 0xa7, 0x2d, 0x71, 0xda, 0xf9, 0x78,
-		0x26, 0x41, 0x9e, 0x78, 0x1c, 0x8a, 0xc8, 0xcb, 0x82, 0x3c, 0x9e, 0x91, 0xe4, 0xcc, 0x15, 0xcf,
-		0x70, 0x33, 0x6f, 0xda, 0xae, 0x47, 0x6d, 0xd7, 0x7b, 0xc2, 0xb0, 0x7d, 0xae, 0xc1, 0x59, 0x85,
-		0x3d, 0x5f, 0x2d, 0xe0, 0x2e, 0xc3, 0xf2, 0x0d, 0x97, 0x1c, 0x2d, 0x2e, 0x19, 0xdf, 0x83, 0x95,
-		0x02, 0x66, 0xb9, 0xc0, 0x3a, 0x1c, 0xc3, 0x1e, 0x0d, 0xdd, 0x7e, 0xef, 0x7a, 0xa4, 0x73, 0x2d,
-		0x52, 0x71, 0xc4, 0x69, 0x1c, 0x02, 0xca, 0x4f, 0x23, 0x04, 0x13, 0x09, 0x8b, 0xf8, 0xdf, 0x68,
-		0x0b, 0xa6, 0x64, 0x14, 0x29, 0x8f, 0x1b, 0x45, 0x24, 0xa3, 0xf1, 0x0b, 0x2d, 0xa3, 0x8d, 0x4f,
-		0x1f, 0x29, 0x36, 0x3e, 0xa6, 0x58, 0xf1, 0x5d, 0x38, 0x55, 0x30, 0x5f, 0xb8, 0xfe, 0xcd, 0x74,
-		0x09, 0x32, 0x92, 0x95, 0xb5, 0x7f, 0xe8, 0x30, 0xcd, 0xdd, 0x74, 0xeb, 0xf6, 0x2e, 0xfa, 0xb9,
-		0x06, 0x2b, 0xca, 0xdf, 0x90, 0xa0, 0x6f, 0x0c, 0xe9, 0x1b, 0xa9, 0x7e, 0x09, 0xa3, 0xbf, 0x31,
-		0x3e, 0xa3, 0xf4, 0xa0, 0x4f, 0xe1, 0x54, 0xc1, 0x9b, 0x3f, 0xba, 0x34, 0x44, 0x60, 0xfe, 0xb7,
-		0x22, 0x7a, 0x6d, 0x1c, 0x16, 0xa9, 0x3d, 0x09, 0x47, 0xee, 0x77, 0x0e, 0x43, 0xe1, 0x50, 0xfd,
-		0xd0, 0x63, 0x28, 0x1c, 0xea, 0x9f, 0x71, 0xd8, 0x00, 0xf1, 0x73, 0x3e, 0x5a, 0x57, 0xc8, 0xc9,
-		0xfd, 0x42, 0x40, 0x7f, 0x7e, 0x04, 0xca, 0x58, 0x45, 0xfc, 0x54, 0xae, 0x54, 0x91, 0xfb, 0xf5,
-		0x80, 0x52, 0x45, 0xfe, 0xdd, 0x5d, 0xa8, 0x88, 0x1e, 0xb9, 0x07, 0xa8, 0xc8, 0xbc, 0xcc, 0x0f,
-		0x50, 0x91, 0x7d, 0x31, 0x47, 0xdf, 0x87, 0xd9, 0xd4, 0xdb, 0x34, 0x7a, 0x71, 0x08, 0xe6, 0x29,
-		0x45, 0x2f, 0x8d, 0x46, 0x2c, 0x75, 0xfd, 0x4e, 0xe3, 0x2f, 0x59, 0x03, 0x1f, 0x50, 0xd1, 0x37,
-		0xd5, 0xd7, 0x94, 0x51, 0xde, 0xbb, 0xf5, 0x77, 0x8e, 0xcc, 0x2f, 0xad, 0xfc, 0xb1, 0x06, 0x8b,
-		0xc5, 0x4f, 0x84, 0xe8, 0xd5, 0x31, 0x5f, 0x14, 0x85, 0x45, 0xaf, 0x1d, 0xe9, 0x1d, 0x92, 0x9f,
-		0x29, 0xe5, 0x3b, 0x9c, 0xf2, 0x4c, 0x0d, 0x7b, 0x29, 0x54, 0x9e, 0xa9, 0xe1, 0x4f, 0x7e, 0xbf,
-		0xd1, 0xf8, 0x6d, 0x57, 0xf9, 0x44, 0x85, 0xde, 0x1a, 0x20, 0x7a, 0xc8, 0x8b, 0x9e, 0x7e, 0xf9,
-		0x48, 0xbc, 0xb1, 0x13, 0xa7, 0xde, 0x82, 0x94, 0x4e, 0x5c, 0xf4, 0xde, 0xa5, 0x74, 0xe2, 0xc2,
-		0xe7, 0x25, 0xd4, 0x03, 0x94, 0x7f, 0x3c, 0x41, 0xaf, 0x8c, 0xfb, 0x78, 0xa4, 0x5f, 0x1a, 0x83,
-		0x43, 0xaa, 0x0e, 0xe0, 0x44, 0xe6, 0xe5, 0x01, 0xbd, 0x3c, 0xea, 0x0b, 0x85, 0x50, 0x5a, 0x1d,
-		0xef, 0x41, 0x83, 0x69, 0xcc, 0xf4, 0xc3, 0x95, 0x1a, 0x8b, 0x1f, 0x19, 0x94, 0x1a, 0x55, 0x6d,
-		0x76, 0x02, 0x27, 0xb3, 0x7d, 0x56, 0xa4, 0x92, 0xa1, 0x68, 0x3c, 0xeb, 0x1b, 0x23, 0xd3, 0xc7,
-		0x4a, 0xb3, 0x2d, 0x49, 0xa5, 0x52, 0x45, 0xa3, 0x57, 0xa9, 0x54, 0xd9, 0xeb, 0xfc, 0x21, 0x2c,
-		0x14, 0x35, 0x0d, 0x51, 0x4d, 0x89, 0x98, 0xb2, 0xdf, 0xa9, 0x6f, 0x8e, 0xc5, 0x93, 0x08, 0x74,
-		0xc5, 0x3d, 0x34, 0x65, 0xa0, 0x1b, 0xd8, 0xc4, 0x54, 0x06, 0xba, 0xc1, 0x8d, 0x3a, 0x06, 0x44,
-		0x51, 0x0f, 0x4a, 0x09, 0xc4, 0x80, 0xae, 0x9e, 0x12, 0x88, 0x81, 0x5d, 0xba, 0xcf, 0x35, 0x38,
-		0x3f, 0xb4, 0xcb, 0x81, 0xde, 0x51, 0xaf, 0x6e, 0xa4, 0x66, 0x90, 0xfe, 0xee, 0xd1, 0x05, 0xc4,
-		0x7e, 0x9a, 0xed, 0x4a, 0x28, 0xfd, 0x54, 0xd1, 0x40, 0x51, 0xfa, 0xa9, 0xb2, 0xdd, 0xf1, 0x29,
-		0x9c, 0x2a, 0xe8, 0x14, 0x28, 0x2b, 0x4b, 0x75, 0x93, 0x43, 0x59, 0x59, 0x0e, 0x68, 0x44, 0x88,
-		0x53, 0x92, 0xef, 0x00, 0x0c, 0x38, 0x25, 0xca, 0xa6, 0xc5, 0x80, 0x53, 0xa2, 0x6e, 0x31, 0xa0,
-		0x2e, 0xcc, 0xe7, 0xee, 0x6d, 0x48, 0x05, 0xa2, 0xea, 0x7a, 0xa8, 0xbf, 0x32, 0x3a, 0x83, 0xd4,
-		0xfb, 0x10, 0xe6, 0xd2, 0x6d, 0x04, 0xa4, 0xce, 0x18, 0xaa, 0x06, 0x88, 0x12, 0xf1, 0x41, 0x5d,
-		0x8a, 0x47, 0x1a, 0x2c, 0x45, 0x37, 0xf1, 0xba, 0x1f, 0x86, 0x9d, 0xa0, 0x5f, 0x38, 0xa1, 0xcd,
-		0x41, 0xf2, 0x14, 0xed, 0x04, 0xfd, 0xd5, 0xf1, 0x98, 0x84, 0x19, 0x57, 0x5e, 0xfb, 0xf6, 0xe6,
-		0xbe, 0x4b, 0x0f, 0x3a, 0x8d, 0x6a, 0xd3, 0x6f, 0x6f, 0xa4, 0xfe, 0xe1, 0xa0, 0xba, 0x8f, 0x3d,
-		0xf1, 0x3f, 0x15, 0xfd, 0x7f, 0xd8, 0xb8, 0xcc, 0xff, 0xe8, 0x5e, 0x6a, 0x4c, 0xf1, 0xf1, 0xcd,
-		0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x58, 0xdd, 0x9d, 0x67, 0xd8, 0x31, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4d, 0x6f, 0x1c, 0x47,
+		0x76, 0xee, 0x19, 0x91, 0x22, 0x1f, 0x29, 0x8a, 0x2c, 0x51, 0xe4, 0xb0, 0x29, 0x8a, 0x54, 0xdb,
+		0xb2, 0x28, 0xaf, 0x3d, 0x94, 0x48, 0x4b, 0x96, 0xa5, 0xc8, 0x6b, 0x7e, 0xe8, 0x83, 0xb6, 0x28,
+		0xd1, 0x4d, 0xd9, 0x5a, 0x04, 0x0b, 0x77, 0x7a, 0xa6, 0x8b, 0x9c, 0x36, 0xfb, 0x63, 0xdc, 0x55,
+		0x43, 0x8a, 0x8b, 0x20, 0xd9, 0xc3, 0x06, 0x58, 0x60, 0xf3, 0x89, 0x1c, 0x02, 0xec, 0x25, 0x01,
+		0x12, 0x38, 0xc7, 0x20, 0x97, 0x60, 0x0f, 0xb9, 0xe4, 0x92, 0x53, 0x36, 0x48, 0xfe, 0x41, 0x0e,
+		0x41, 0x72, 0x08, 0x10, 0x20, 0x48, 0x0e, 0x39, 0x06, 0xf5, 0xd1, 0x1f, 0x33, 0xd3, 0x35, 0xd3,
+		0xc3, 0x28, 0x91, 0x37, 0xb9, 0x71, 0xaa, 0xde, 0x7b, 0xf5, 0xde, 0xab, 0x57, 0xef, 0xbd, 0x7a,
+		0xf5, 0x9a, 0xf0, 0x66, 0xab, 0x86, 0xa3, 0x95, 0xba, 0xed, 0xe0, 0xa0, 0x8e, 0x57, 0x6c, 0xc7,
+		0x77, 0x83, 0x95, 0xa3, 0x9b, 0x2b, 0x04, 0x47, 0x47, 0x6e, 0x1d, 0x57, 0x9b, 0x51, 0x48, 0x43,
+		0x74, 0x91, 0x01, 0x55, 0x25, 0x50, 0x95, 0x03, 0x55, 0x8f, 0x6e, 0xea, 0x8b, 0x07, 0x61, 0x78,
+		0xe0, 0xe1, 0x15, 0x0e, 0x54, 0x6b, 0xed, 0xaf, 0x50, 0xd7, 0xc7, 0x84, 0xda, 0x7e, 0x53, 0xe0,
+		0xe9, 0x97, 0x3b, 0x01, 0x8e, 0x23, 0xbb, 0xd9, 0xc4, 0x11, 0x91, 0xf3, 0x4b, 0xed, 0x8b, 0x37,
+		0x5d, 0xb6, 0x74, 0x3d, 0xf4, 0xfd, 0x30, 0x90, 0x10, 0x46, 0x1e, 0x04, 0xb5, 0xc9, 0xa1, 0xe7,
+		0x12, 0x2a, 0x61, 0xde, 0xca, 0x83, 0x39, 0x72, 0x89, 0x5b, 0x73, 0x3d, 0x97, 0x9e, 0xe4, 0x42,
+		0x91, 0x86, 0x1d, 0x61, 0x87, 0x2f, 0xe7, 0xb5, 0x08, 0xc5, 0x91, 0x84, 0x7a, 0x53, 0x01, 0xe5,
+		0x84, 0xbe, 0xed, 0x06, 0x7d, 0x48, 0x35, 0x5c, 0x42, 0xc3, 0xe8, 0x24, 0x97, 0xf5, 0x14, 0xea,
+		0xeb, 0x16, 0x6e, 0x49, 0xc5, 0xea, 0xcb, 0x0a, 0x98, 0x08, 0x37, 0x3d, 0xb7, 0x6e, 0x53, 0x37,
+		0x56, 0x84, 0xf1, 0x7b, 0x1a, 0x2c, 0x6d, 0x61, 0x52, 0x8f, 0xdc, 0x1a, 0x7e, 0x11, 0x46, 0x87,
+		0xfb, 0x5e, 0x78, 0xfc, 0xe0, 0x25, 0xae, 0xb7, 0x18, 0x8c, 0x89, 0xbf, 0x6e, 0x61, 0x42, 0xd1,
+		0x0c, 0x0c, 0x0b, 0x46, 0x2b, 0xda, 0x92, 0xb6, 0x3c, 0x6a, 0xca, 0x5f, 0xe8, 0x73, 0x40, 0xc7,
+		0x12, 0xc7, 0xc2, 0x31, 0x52, 0xa5, 0xb4, 0xa4, 0x2d, 0x8f, 0xad, 0xbe, 0x5d, 0x6d, 0xdf, 0xdc,
+		0xa6, 0x5b, 0x3d, 0xba, 0x59, 0xed, 0x5e, 0x62, 0xea, 0xb8, 0x73, 0xc8, 0xf8, 0x7b, 0x0d, 0xae,
+		0xf4, 0xe0, 0x89, 0x34, 0xc3, 0x80, 0x60, 0x34, 0x07, 0x23, 0x4c, 0x30, 0xc7, 0x72, 0x1d, 0xce,
+		0xd6, 0x90, 0x79, 0x96, 0xff, 0xde, 0x76, 0xd0, 0x15, 0x18, 0x97, 0x3a, 0xb3, 0x6c, 0xc7, 0x89,
+		0x38, 0x47, 0xa3, 0xe6, 0x98, 0x1c, 0x5b, 0x77, 0x9c, 0x08, 0xad, 0xc1, 0x8c, 0xdf, 0xa2, 0x76,
+		0xcd, 0xc3, 0x16, 0xa1, 0x36, 0xc5, 0x96, 0x1b, 0x58, 0x75, 0xbb, 0xde, 0xc0, 0x95, 0x32, 0x07,
+		0xbe, 0x20, 0x67, 0xf7, 0xd8, 0xe4, 0x76, 0xb0, 0xc9, 0xa6, 0xd0, 0x87, 0x30, 0xd7, 0x85, 0xe4,
+		0xd8, 0xd4, 0xae, 0xd9, 0x04, 0x57, 0xce, 0x70, 0xbc, 0x99, 0x76, 0xbc, 0x2d, 0x39, 0x6b, 0xfc,
+		0xb5, 0x06, 0x7a, 0x2c, 0xd3, 0x63, 0xc1, 0xc7, 0xe3, 0x90, 0xd0, 0x58, 0xc3, 0x6f, 0xc2, 0x78,
+		0x23, 0x24, 0x94, 0xb3, 0x8b, 0x09, 0x11, 0x7a, 0x7e, 0xfc, 0x86, 0x39, 0xc6, 0x46, 0xd7, 0xc5,
+		0x20, 0x9a, 0xcf, 0x48, 0xcc, 0x44, 0x1a, 0x7a, 0xfc, 0x46, 0x2a, 0xf3, 0x8b, 0xdc, 0xbd, 0x28,
+		0x0f, 0xb2, 0x17, 0x8f, 0xdf, 0xc8, 0xd9, 0x8d, 0x8d, 0x73, 0x30, 0xe6, 0x48, 0xc6, 0xad, 0xda,
+		0x89, 0xf1, 0xbd, 0xd4, 0x5e, 0xf6, 0xd8, 0xd2, 0x5b, 0x2e, 0xa1, 0x91, 0x5b, 0x6b, 0xb3, 0x97,
+		0x79, 0x18, 0x6d, 0xda, 0x07, 0xd8, 0x22, 0xee, 0x0f, 0xb0, 0xdc, 0x9b, 0x11, 0x36, 0xb0, 0xe7,
+		0xfe, 0x00, 0xa3, 0x59, 0x38, 0xcb, 0x27, 0x63, 0x21, 0xcc, 0x61, 0xf6, 0x73, 0xdb, 0x31, 0xfe,
+		0x29, 0xb3, 0xed, 0x39, 0xa4, 0xe5, 0xb6, 0x2f, 0xc3, 0x64, 0xd0, 0xf2, 0x6b, 0x38, 0xb2, 0xc2,
+		0x7d, 0x8b, 0x0b, 0x4f, 0xe4, 0x12, 0x13, 0x62, 0xfc, 0xd9, 0x3e, 0x47, 0x26, 0xe8, 0xfb, 0x30,
+		0x2c, 0xe7, 0x4b, 0x4b, 0xe5, 0xe5, 0xb1, 0xd5, 0xad, 0x6a, 0xae, 0xbb, 0xa9, 0xf6, 0x5d, 0xb3,
+		0x2a, 0x08, 0x3e, 0x08, 0x68, 0x74, 0x62, 0x4a, 0x9a, 0xfa, 0x87, 0x30, 0x96, 0x19, 0x46, 0x93,
+		0x50, 0x3e, 0xc4, 0x27, 0x92, 0x13, 0xf6, 0x27, 0x9a, 0x86, 0xa1, 0x23, 0xdb, 0x6b, 0x61, 0x69,
+		0x7d, 0xe2, 0xc7, 0xdd, 0xd2, 0x1d, 0xcd, 0xf8, 0x87, 0x12, 0xcc, 0xe7, 0xda, 0xc2, 0xc0, 0x22,
+		0xce, 0xc3, 0x68, 0x6c, 0x11, 0x42, 0xca, 0x21, 0x73, 0x44, 0x1a, 0x04, 0x41, 0x9f, 0xc0, 0xb8,
+		0x38, 0xa7, 0x19, 0xc3, 0x1e, 0x5b, 0xbd, 0xd6, 0xae, 0x05, 0xe1, 0x1b, 0xb8, 0x1a, 0x38, 0x2c,
+		0x37, 0xf4, 0xed, 0x60, 0x3f, 0x34, 0xc7, 0x9c, 0x74, 0x00, 0xdd, 0x86, 0x59, 0xb1, 0x50, 0x3d,
+		0x0c, 0x68, 0x14, 0x7a, 0x1e, 0x8e, 0xf8, 0x11, 0x68, 0x11, 0x69, 0xf7, 0x17, 0xf9, 0xf4, 0x66,
+		0x32, 0xbb, 0xc7, 0x27, 0x51, 0x05, 0xce, 0xc6, 0x26, 0x3d, 0xc4, 0xe1, 0xe2, 0x9f, 0xe8, 0xfb,
+		0x80, 0x9a, 0xf6, 0x89, 0x17, 0xda, 0x0e, 0x37, 0x13, 0x4e, 0x8d, 0x54, 0x86, 0xf9, 0x4e, 0x55,
+		0x7b, 0xf3, 0xb8, 0x2b, 0xf0, 0x98, 0x35, 0xb1, 0x65, 0x88, 0x39, 0xd9, 0xec, 0x18, 0x31, 0xaa,
+		0x30, 0xb5, 0xe9, 0x85, 0x44, 0xec, 0x69, 0x6c, 0x96, 0x6a, 0x8f, 0x61, 0x4c, 0x03, 0xca, 0xc2,
+		0x8b, 0x8d, 0x30, 0xfe, 0x55, 0x83, 0x29, 0x13, 0xfb, 0xe1, 0x11, 0x7e, 0x6e, 0x93, 0xc3, 0xfe,
+		0x64, 0xd0, 0x7d, 0x18, 0x65, 0x41, 0xc4, 0xa2, 0x27, 0x4d, 0xb1, 0xef, 0x13, 0xab, 0x4b, 0x2a,
+		0x59, 0x18, 0xc9, 0xe7, 0x27, 0x4d, 0x6c, 0x8e, 0x50, 0xf9, 0x17, 0x3b, 0x1a, 0x1c, 0xdd, 0x75,
+		0xf8, 0x66, 0x95, 0xcd, 0x61, 0xf6, 0x73, 0xdb, 0x41, 0x9b, 0x70, 0x3e, 0x0d, 0x3c, 0x16, 0x0b,
+		0x87, 0x5c, 0xed, 0x63, 0xab, 0x7a, 0x55, 0x84, 0xc2, 0x6a, 0x1c, 0x0a, 0xab, 0xcf, 0xe3, 0x58,
+		0x69, 0x4e, 0xa4, 0x28, 0x6c, 0x90, 0x79, 0x45, 0x19, 0x94, 0xac, 0xc0, 0xf6, 0xb1, 0xdc, 0x90,
+		0x31, 0x39, 0xf6, 0xd4, 0xf6, 0x31, 0x53, 0x43, 0x56, 0x5e, 0xa9, 0x86, 0xdf, 0xe5, 0x6a, 0x20,
+		0x98, 0x7e, 0xd6, 0xc2, 0x2d, 0x5c, 0x40, 0x0d, 0x9d, 0x2b, 0x95, 0xba, 0x56, 0x6a, 0xd7, 0x54,
+		0x79, 0x50, 0x4d, 0x09, 0x46, 0x53, 0x8e, 0x24, 0xa3, 0xbf, 0xaf, 0xc1, 0x74, 0x7c, 0xb0, 0xbe,
+		0x3d, 0xbc, 0x3e, 0x83, 0x8b, 0x1d, 0x4c, 0xc9, 0x73, 0x7e, 0x1b, 0x66, 0x9b, 0x51, 0x58, 0xc7,
+		0x84, 0xb8, 0xc1, 0x81, 0xc5, 0xe3, 0xb7, 0x88, 0x2b, 0xec, 0xb8, 0x97, 0xd9, 0xa1, 0x4a, 0xa7,
+		0x39, 0x26, 0x0f, 0x2a, 0xc4, 0xb8, 0x07, 0x97, 0x1f, 0x61, 0x6a, 0xa6, 0xb1, 0x7c, 0xbd, 0x7e,
+		0x28, 0xa6, 0x0a, 0x58, 0xba, 0x0f, 0x8b, 0x4a, 0x64, 0xc9, 0xd7, 0x27, 0x00, 0x76, 0xfd, 0x30,
+		0xcb, 0xca, 0xd8, 0xea, 0x77, 0x54, 0x02, 0xe7, 0x50, 0x32, 0x47, 0xed, 0x98, 0xa6, 0xf1, 0xef,
+		0x25, 0xb8, 0xf6, 0x08, 0xd3, 0xee, 0x30, 0x6e, 0x1f, 0x4b, 0xd7, 0xf7, 0xc5, 0xea, 0xeb, 0x49,
+		0x33, 0xd0, 0xa7, 0x30, 0x46, 0xa8, 0x1d, 0x51, 0x0b, 0x1f, 0xe1, 0x80, 0x4a, 0xf7, 0xf8, 0x8e,
+		0x4a, 0xce, 0x2f, 0x70, 0x44, 0x58, 0x8c, 0x14, 0x4c, 0x6f, 0x53, 0xec, 0x9b, 0xc0, 0xd1, 0x1f,
+		0x30, 0x6c, 0xf4, 0x08, 0x46, 0x71, 0xe0, 0x48, 0x52, 0x67, 0x06, 0x26, 0x35, 0x82, 0x03, 0x47,
+		0x10, 0x6a, 0x8b, 0x9d, 0x43, 0x1d, 0xb1, 0xf3, 0x6d, 0x38, 0x1f, 0xe0, 0x97, 0xd4, 0xe2, 0x10,
+		0x34, 0x3c, 0xc4, 0x41, 0x65, 0x78, 0x49, 0x5b, 0x1e, 0x37, 0xcf, 0xb1, 0xe1, 0x5d, 0xfb, 0x00,
+		0x3f, 0x67, 0x83, 0xc6, 0xbf, 0x68, 0xb0, 0xdc, 0x5f, 0xeb, 0x72, 0xbb, 0x73, 0x88, 0x6a, 0x39,
+		0x44, 0xd1, 0x43, 0x38, 0x1f, 0x67, 0x55, 0x35, 0x9b, 0xd6, 0x1b, 0x38, 0x0e, 0xac, 0x0b, 0xb9,
+		0x7b, 0xc0, 0x52, 0x9f, 0x0d, 0x2f, 0xac, 0x99, 0x13, 0x12, 0x6b, 0x43, 0x20, 0xa1, 0x67, 0x70,
+		0xfe, 0x48, 0x68, 0xc0, 0x92, 0x33, 0xf9, 0x69, 0x8a, 0x4a, 0x61, 0xe6, 0xc4, 0x51, 0xdb, 0x6f,
+		0xe3, 0x1f, 0x35, 0x58, 0x68, 0xb7, 0xe9, 0x1d, 0x4c, 0x88, 0x7d, 0x90, 0x9e, 0x87, 0x8f, 0x61,
+		0x98, 0x0b, 0x16, 0x5b, 0xf3, 0x72, 0x01, 0x6b, 0xe6, 0x42, 0x9b, 0x12, 0xaf, 0x88, 0x9b, 0xf8,
+		0x12, 0x66, 0x48, 0xab, 0xd9, 0x0c, 0x23, 0x8a, 0x59, 0x9c, 0xf4, 0x9b, 0x11, 0x3b, 0xba, 0x61,
+		0x40, 0x2a, 0xe5, 0xa5, 0xf2, 0xf2, 0x84, 0x3a, 0xf2, 0x6e, 0xa6, 0xb0, 0xdc, 0x75, 0x5c, 0x4c,
+		0xc8, 0x64, 0x66, 0x88, 0xf1, 0xc3, 0x12, 0x5c, 0x56, 0x89, 0x29, 0xb7, 0x32, 0x84, 0x09, 0x71,
+		0xee, 0x7d, 0x39, 0x23, 0xe5, 0x7d, 0xac, 0x48, 0x7d, 0x7a, 0x93, 0x13, 0x79, 0x4f, 0x3c, 0x2a,
+		0xd2, 0x9f, 0x73, 0x24, 0x3b, 0xa6, 0xfb, 0x80, 0xba, 0x81, 0x72, 0x92, 0xa1, 0xf5, 0x6c, 0x32,
+		0x54, 0xcc, 0x9b, 0x24, 0xdc, 0x64, 0x32, 0xa7, 0xbf, 0xd5, 0x60, 0xe9, 0x11, 0xa6, 0x5b, 0x4f,
+		0x3e, 0xeb, 0xb1, 0xd9, 0x9f, 0x00, 0x88, 0x28, 0x1a, 0xec, 0x87, 0x83, 0xb8, 0x2f, 0xe6, 0xba,
+		0x79, 0xe6, 0x33, 0x4a, 0xe5, 0x5f, 0xa4, 0xc7, 0x9e, 0x96, 0x5e, 0xc9, 0x9e, 0x9e, 0xc0, 0x95,
+		0x1e, 0xf2, 0xc8, 0x5d, 0x7d, 0x0e, 0x53, 0x99, 0x8b, 0x9b, 0xc5, 0xb8, 0x8b, 0xe5, 0xba, 0x56,
+		0x50, 0x2e, 0x73, 0x32, 0x6a, 0x1f, 0x20, 0xc6, 0x7f, 0x6a, 0xf0, 0x26, 0x5b, 0x9b, 0xfb, 0xd8,
+		0x1e, 0xea, 0xfc, 0x02, 0xe6, 0x3c, 0x9b, 0x50, 0x2b, 0xc2, 0x34, 0x72, 0xf1, 0x11, 0x4e, 0x8c,
+		0x2b, 0x0e, 0x2e, 0x63, 0xab, 0xf3, 0x5d, 0x59, 0xc8, 0x76, 0x40, 0x6f, 0xbf, 0xff, 0x05, 0xdb,
+		0x37, 0x73, 0x86, 0x61, 0x9b, 0x31, 0xb2, 0xa4, 0xbe, 0xed, 0x24, 0x74, 0x65, 0x8c, 0x6b, 0xa7,
+		0x5b, 0x2a, 0x48, 0x77, 0x37, 0x46, 0x4e, 0xe9, 0x76, 0x9e, 0xd4, 0x72, 0x77, 0x9a, 0x13, 0xc2,
+		0x5b, 0xbd, 0x25, 0x97, 0x8a, 0x7f, 0x04, 0x23, 0x99, 0x83, 0x34, 0xb0, 0xe1, 0x26, 0xc8, 0xc6,
+		0x5f, 0x6a, 0x30, 0x6d, 0x62, 0xbb, 0xd9, 0xf4, 0x4e, 0xb8, 0x97, 0x27, 0xaf, 0x29, 0xe4, 0xdd,
+		0x82, 0x61, 0x1e, 0xa1, 0x88, 0xf4, 0xb8, 0x7d, 0x3c, 0xb7, 0x04, 0x36, 0x66, 0xe1, 0x62, 0x07,
+		0xf7, 0x32, 0xe1, 0xfa, 0xc3, 0x12, 0xcc, 0xad, 0x3b, 0xce, 0x1e, 0xb6, 0xa3, 0x7a, 0x63, 0x9d,
+		0x8a, 0x9b, 0x53, 0x92, 0x75, 0x35, 0x61, 0x92, 0xf0, 0x19, 0xcb, 0x8e, 0xa7, 0xa4, 0xd9, 0x3e,
+		0x50, 0xf8, 0x23, 0x25, 0xad, 0x6a, 0xc7, 0xb0, 0x70, 0x46, 0xe7, 0x49, 0xfb, 0x28, 0xba, 0x0a,
+		0x13, 0x04, 0xd7, 0x5b, 0x11, 0xcf, 0x92, 0x79, 0x24, 0x13, 0x7e, 0xfa, 0x5c, 0x3c, 0xca, 0x9d,
+		0xba, 0xee, 0xc2, 0x74, 0x1e, 0xbd, 0xac, 0xdf, 0x1a, 0x15, 0x7e, 0xeb, 0x5e, 0xd6, 0x6f, 0x4d,
+		0xac, 0x5e, 0xcd, 0xd5, 0xd7, 0x76, 0xe0, 0xe0, 0x97, 0xd8, 0xe1, 0x66, 0xc9, 0x0f, 0x7b, 0xc6,
+		0x63, 0x5d, 0x02, 0x3d, 0x4f, 0x28, 0xa9, 0xbf, 0x0a, 0xcc, 0xc4, 0xa9, 0xe1, 0xa6, 0xb0, 0x4f,
+		0x29, 0xaf, 0xf1, 0xe7, 0x65, 0x98, 0xed, 0x9a, 0x92, 0x66, 0xd9, 0x80, 0xb9, 0x8c, 0x53, 0xf2,
+		0x5c, 0x1c, 0x50, 0x4b, 0x86, 0xc4, 0xd8, 0x4e, 0xdf, 0xcd, 0x65, 0x74, 0x2f, 0xf1, 0x41, 0x1c,
+		0x49, 0x86, 0x55, 0x62, 0xce, 0x92, 0xfc, 0x09, 0x16, 0xaa, 0x7d, 0xcc, 0x6e, 0x9c, 0xa4, 0xe1,
+		0x36, 0xb9, 0x43, 0xcd, 0xb7, 0xc1, 0xf4, 0x1c, 0xec, 0x24, 0xe0, 0xdc, 0x95, 0x4e, 0xf8, 0x6d,
+		0xbf, 0x51, 0x00, 0x93, 0x4d, 0x46, 0x9c, 0x50, 0x86, 0x27, 0x28, 0x96, 0xb9, 0x49, 0x6c, 0xf6,
+		0xb9, 0x9d, 0x77, 0x28, 0xa1, 0xba, 0x9b, 0x92, 0x61, 0x94, 0xa5, 0x41, 0x34, 0xdb, 0x47, 0xf5,
+		0x43, 0x98, 0xce, 0x03, 0xcc, 0xd9, 0xe9, 0xfb, 0xed, 0x11, 0x4a, 0xe9, 0x58, 0x3b, 0xc8, 0x65,
+		0xf7, 0xfa, 0x6f, 0x4a, 0x30, 0x63, 0x62, 0xdb, 0xd9, 0x7a, 0xf2, 0x59, 0xa7, 0x13, 0x5d, 0x83,
+		0x33, 0xfc, 0xf6, 0xa0, 0x71, 0x33, 0x5a, 0x54, 0xde, 0x6f, 0x9f, 0x7c, 0xc6, 0x0d, 0x88, 0x03,
+		0xb7, 0x65, 0xf1, 0xa5, 0xf6, 0x5b, 0x0b, 0x33, 0xf4, 0xb0, 0x15, 0xd5, 0xb1, 0x25, 0xfd, 0x9a,
+		0x74, 0x73, 0xe7, 0xc4, 0xa8, 0x54, 0x16, 0x7a, 0x0e, 0x15, 0x37, 0x60, 0x10, 0xee, 0x11, 0xb6,
+		0x58, 0x7e, 0x9a, 0x71, 0xb1, 0x67, 0xfa, 0xbb, 0xd8, 0x8b, 0x09, 0xf2, 0x83, 0x20, 0xe3, 0x61,
+		0x5f, 0x45, 0x8a, 0x8a, 0x96, 0x60, 0x9c, 0x31, 0x94, 0x08, 0x78, 0x96, 0xd3, 0x01, 0x1c, 0x38,
+		0x7b, 0xf2, 0xa6, 0xf2, 0x67, 0x25, 0x98, 0xed, 0x52, 0xa7, 0x3c, 0x02, 0xa7, 0xd2, 0x67, 0x6e,
+		0x1c, 0x2d, 0xfd, 0x37, 0xe3, 0x28, 0xb2, 0x61, 0xa6, 0x8b, 0x6a, 0xd6, 0xb0, 0x07, 0x4a, 0x3d,
+		0xa6, 0x3b, 0xc9, 0xf3, 0x53, 0x93, 0xa3, 0xd3, 0x33, 0x79, 0x69, 0xff, 0x8f, 0x4b, 0x30, 0xbb,
+		0xdb, 0x8a, 0x0e, 0xf0, 0xff, 0x75, 0x0b, 0xec, 0x34, 0x9e, 0xa1, 0x2e, 0xe3, 0xd1, 0xa1, 0xd2,
+		0xad, 0x09, 0xe9, 0x75, 0x7f, 0x5e, 0x82, 0xd9, 0x1d, 0xfc, 0xff, 0x40, 0x4d, 0xff, 0x4b, 0x07,
+		0x75, 0x03, 0x2a, 0x3b, 0x38, 0x5f, 0xd7, 0x45, 0x2f, 0x97, 0xc6, 0x6f, 0x6a, 0x30, 0x6f, 0xe2,
+		0xfd, 0x08, 0x93, 0x46, 0x9c, 0xc9, 0x70, 0xfb, 0x7f, 0x4d, 0x4f, 0x10, 0x97, 0xe1, 0x52, 0x3e,
+		0x37, 0xd2, 0x84, 0xfe, 0xae, 0x04, 0x0b, 0x26, 0x26, 0x38, 0x70, 0x3a, 0x4e, 0x31, 0xc9, 0xd4,
+		0xc0, 0x65, 0xf5, 0x55, 0xa6, 0xc9, 0xa3, 0xe6, 0x88, 0x18, 0xd8, 0x76, 0xfe, 0xa7, 0xd2, 0xbb,
+		0xab, 0x30, 0x11, 0x61, 0x3f, 0xa4, 0x5d, 0xc6, 0x26, 0x46, 0x63, 0x63, 0xeb, 0x28, 0x7c, 0x9c,
+		0x79, 0x75, 0x85, 0x8f, 0xa1, 0xd3, 0x17, 0x3e, 0x8c, 0x25, 0xb8, 0xac, 0xd2, 0xa8, 0x54, 0xba,
+		0x0d, 0xf3, 0x8f, 0x30, 0xdd, 0x8c, 0x42, 0x42, 0xa4, 0x28, 0x9d, 0x1a, 0x4f, 0x8b, 0xe1, 0x5a,
+		0x47, 0x31, 0xfc, 0x2a, 0x4c, 0x50, 0x3b, 0x3a, 0xc0, 0x34, 0x51, 0x8d, 0xcc, 0x0c, 0xc5, 0xa8,
+		0xa4, 0x67, 0xfc, 0x5b, 0x19, 0x2e, 0xe5, 0xaf, 0x21, 0xed, 0xf9, 0x10, 0x26, 0x84, 0x87, 0xaf,
+		0x9d, 0x88, 0x23, 0xd1, 0x27, 0xa3, 0xed, 0x45, 0x8c, 0x17, 0x0b, 0xc9, 0xc6, 0x09, 0x3f, 0x47,
+		0x22, 0x81, 0x19, 0xa7, 0x99, 0x21, 0xf4, 0x6b, 0x70, 0x71, 0xdf, 0x76, 0x3d, 0x96, 0xe5, 0xd9,
+		0x2d, 0x82, 0xd3, 0x35, 0x45, 0xd0, 0xfa, 0xf4, 0x34, 0x6b, 0x3e, 0xe4, 0x04, 0x37, 0x19, 0xbd,
+		0xb6, 0x95, 0xd1, 0x7e, 0xd7, 0x84, 0xfe, 0x35, 0x4c, 0x75, 0xb1, 0x98, 0x73, 0xb9, 0x7f, 0xd8,
+		0x9e, 0x3a, 0xdd, 0x50, 0xde, 0x89, 0x3b, 0x98, 0x92, 0x1b, 0x97, 0xbd, 0xe1, 0xeb, 0x5f, 0xc3,
+		0xac, 0x82, 0xc3, 0x9c, 0x85, 0x3f, 0x6e, 0xcf, 0xce, 0x95, 0x76, 0xf7, 0x08, 0x53, 0xb6, 0x5e,
+		0x86, 0x70, 0x36, 0x6d, 0x63, 0xc5, 0x32, 0xa1, 0x1e, 0xa7, 0x4b, 0x6d, 0xec, 0xb2, 0xee, 0x61,
+		0x8a, 0x0b, 0xbc, 0x21, 0x14, 0x34, 0x31, 0xf4, 0x42, 0x58, 0x90, 0x15, 0xc9, 0x1d, 0x21, 0x32,
+		0x4f, 0x18, 0x40, 0x6d, 0x02, 0x91, 0x11, 0x4e, 0x7f, 0x11, 0xf4, 0x16, 0x9c, 0xdb, 0xc7, 0xb4,
+		0xde, 0x78, 0x8a, 0x85, 0xb3, 0xe2, 0x07, 0x7b, 0xc4, 0x6c, 0x1f, 0x34, 0x08, 0x5c, 0x2f, 0x20,
+		0xac, 0xb4, 0xf6, 0x87, 0x30, 0x14, 0x57, 0x1b, 0x4e, 0xb9, 0xb3, 0x1c, 0xdd, 0xf8, 0xa1, 0x06,
+		0xb3, 0xec, 0xc6, 0x7d, 0x12, 0xd8, 0xbe, 0x5b, 0xdf, 0x0c, 0x83, 0x7d, 0xf7, 0x20, 0xd6, 0xe8,
+		0x22, 0x8c, 0xd5, 0xf9, 0x80, 0xb8, 0xae, 0x0b, 0x57, 0x09, 0x62, 0x88, 0xd7, 0xd5, 0xb6, 0xe0,
+		0xec, 0xbe, 0xeb, 0x51, 0x1c, 0xc5, 0xc9, 0xda, 0x3b, 0xaa, 0xab, 0x42, 0x96, 0xfc, 0x43, 0x8e,
+		0x62, 0xc6, 0xa8, 0xc6, 0x33, 0xa8, 0x74, 0x73, 0x90, 0x64, 0x93, 0xd2, 0x8e, 0xb4, 0x22, 0xb7,
+		0x62, 0x01, 0x6b, 0xfc, 0x96, 0x06, 0xfa, 0xe7, 0x4d, 0xc7, 0xa6, 0xf8, 0x74, 0x62, 0x3d, 0x85,
+		0x73, 0x12, 0x80, 0xd3, 0x8b, 0x85, 0xbb, 0x5e, 0x44, 0x38, 0x11, 0xf5, 0xc7, 0xeb, 0xe9, 0x0f,
+		0x62, 0x2c, 0xc0, 0x7c, 0x2e, 0x3b, 0xd2, 0x79, 0xfe, 0x88, 0x07, 0x58, 0xe6, 0x78, 0xf1, 0xeb,
+		0xdc, 0x06, 0x1e, 0x58, 0xf3, 0xb8, 0x90, 0x6c, 0xfe, 0x44, 0x63, 0x17, 0x66, 0xdf, 0x0d, 0xb6,
+		0x30, 0x33, 0xc5, 0x38, 0xec, 0xbd, 0xa6, 0x34, 0xe0, 0x4f, 0x34, 0x98, 0xcf, 0xe5, 0x46, 0x1a,
+		0xce, 0xb5, 0xb4, 0x24, 0xee, 0x70, 0x08, 0xe1, 0x14, 0x46, 0x92, 0x9a, 0xb7, 0xc0, 0x73, 0xd0,
+		0x7b, 0x80, 0x12, 0xb6, 0x48, 0x02, 0x5b, 0xe2, 0xb0, 0x53, 0xe9, 0x4c, 0x06, 0x3c, 0xf3, 0xde,
+		0x17, 0x83, 0x97, 0x05, 0x78, 0x3a, 0x23, 0xc1, 0x99, 0x29, 0x5e, 0xe2, 0x6c, 0xee, 0xd8, 0x6e,
+		0x40, 0x6d, 0x37, 0x78, 0xcd, 0x6a, 0xfb, 0x46, 0x83, 0x05, 0x05, 0x3f, 0xdf, 0x2e, 0xc5, 0x7d,
+		0x94, 0x5f, 0x08, 0x7c, 0x61, 0x53, 0x1c, 0xf9, 0x76, 0x74, 0xd8, 0x47, 0x7f, 0xc6, 0x4f, 0x35,
+		0xb8, 0xda, 0x87, 0x80, 0x14, 0xb8, 0x02, 0x67, 0xe3, 0xa8, 0x20, 0x48, 0xc4, 0x3f, 0xd1, 0x0b,
+		0xd0, 0x65, 0x7d, 0x55, 0xa0, 0x63, 0x99, 0x4c, 0x89, 0x67, 0xde, 0x52, 0xdf, 0x67, 0xde, 0x59,
+		0x51, 0x5f, 0x8d, 0x91, 0x79, 0x32, 0xc5, 0x66, 0xb9, 0xd3, 0xdd, 0x26, 0xa4, 0x85, 0x05, 0x7b,
+		0xe2, 0x3d, 0xa3, 0x8f, 0x41, 0x20, 0x38, 0x63, 0x37, 0x5d, 0x71, 0xc2, 0x47, 0x4d, 0xfe, 0x37,
+		0xf3, 0x0c, 0x94, 0x7a, 0x16, 0xc1, 0xf5, 0x30, 0x70, 0x88, 0x7c, 0x99, 0x06, 0x4a, 0xbd, 0x3d,
+		0x31, 0xc2, 0x64, 0x23, 0xad, 0xda, 0x57, 0xb8, 0x4e, 0x65, 0x33, 0x40, 0xfc, 0xd3, 0xb8, 0x01,
+		0x95, 0x6e, 0x0e, 0xa4, 0x46, 0xa6, 0x61, 0x28, 0xbd, 0x0f, 0x8c, 0x9a, 0xe2, 0x87, 0xf1, 0x33,
+		0x0d, 0xe6, 0xf6, 0x28, 0xb6, 0x3d, 0x16, 0x46, 0x9e, 0xb8, 0x84, 0x3e, 0xc1, 0x36, 0xc1, 0xfd,
+		0xd8, 0xbe, 0x2b, 0x5f, 0x68, 0x3d, 0x97, 0xd0, 0x4a, 0xa9, 0x87, 0x13, 0x8f, 0xa9, 0x8a, 0xe7,
+		0x59, 0xf6, 0x17, 0x7a, 0x04, 0x13, 0x09, 0x6e, 0xf6, 0x89, 0xf7, 0x4a, 0x4f, 0x02, 0xfc, 0xf6,
+		0x37, 0x4e, 0x33, 0xbf, 0x8c, 0x0f, 0x40, 0xcf, 0xe3, 0x3c, 0x6d, 0x57, 0x8a, 0xec, 0x20, 0xad,
+		0x9a, 0x97, 0xcd, 0xb3, 0xfc, 0xf7, 0xb6, 0xc3, 0xaa, 0xc3, 0x0b, 0x71, 0x99, 0x2b, 0x46, 0x6e,
+		0x77, 0xce, 0xdf, 0x6a, 0xb9, 0xff, 0x63, 0x04, 0x2e, 0xab, 0xd8, 0x97, 0xc2, 0x57, 0xe1, 0x42,
+		0xd0, 0xf2, 0xad, 0x08, 0xdb, 0x8e, 0xd5, 0xb4, 0x23, 0xea, 0xd2, 0xa4, 0x56, 0x39, 0x64, 0x4e,
+		0x05, 0x2d, 0xdf, 0xc4, 0xb6, 0xb3, 0x9b, 0x4c, 0xa0, 0x1b, 0x30, 0xcd, 0xe0, 0x8f, 0x23, 0x97,
+		0xe2, 0x2c, 0x82, 0xb8, 0x5c, 0xa3, 0xa0, 0xe5, 0xbf, 0x60, 0x53, 0x19, 0x8c, 0x07, 0xb0, 0xb8,
+		0x1f, 0x46, 0xc7, 0x76, 0xe4, 0xe0, 0xc8, 0xf2, 0xed, 0x97, 0x56, 0xd8, 0xa2, 0x84, 0xda, 0x81,
+		0xc3, 0x9e, 0xd6, 0x9b, 0xa1, 0xe7, 0x09, 0xc3, 0x1d, 0x32, 0x2f, 0x25, 0x60, 0x3b, 0xf6, 0xcb,
+		0x67, 0x29, 0xd0, 0x2e, 0x83, 0xe9, 0x4d, 0x86, 0x26, 0x59, 0x95, 0x9a, 0x8c, 0xa8, 0x09, 0x7d,
+		0x0c, 0x0b, 0xed, 0x64, 0x22, 0x9b, 0x09, 0x82, 0x23, 0x79, 0x8a, 0xe4, 0x65, 0x7c, 0x2e, 0x4b,
+		0xc4, 0xb4, 0x29, 0xde, 0xc5, 0x91, 0x38, 0x54, 0x68, 0xab, 0x93, 0x91, 0x7a, 0xc3, 0xf5, 0x9c,
+		0x08, 0x07, 0x9c, 0x4a, 0x10, 0x3a, 0x98, 0xdf, 0xd6, 0x87, 0xcc, 0xf9, 0x2c, 0x8d, 0x4d, 0x09,
+		0xb4, 0x8b, 0xa3, 0xa7, 0xa1, 0x83, 0xd1, 0x3b, 0x30, 0x85, 0x03, 0xd1, 0xaf, 0x76, 0x12, 0xd4,
+		0x2d, 0x9f, 0xbd, 0xc0, 0xf2, 0x0b, 0xfc, 0x88, 0x79, 0x5e, 0x4c, 0xec, 0x9d, 0x04, 0xf5, 0x1d,
+		0x36, 0x8c, 0xee, 0x81, 0x2e, 0x61, 0x71, 0xb3, 0x81, 0x7d, 0x1c, 0xd9, 0x9e, 0x95, 0x1a, 0xd7,
+		0x08, 0x47, 0x9a, 0x15, 0x10, 0x0f, 0x62, 0x80, 0x78, 0xbb, 0xd1, 0x2e, 0x5c, 0xf5, 0x42, 0xa9,
+		0x69, 0x0b, 0xbf, 0x6c, 0xba, 0x91, 0xa8, 0x86, 0xb9, 0x01, 0xc5, 0xd1, 0x91, 0x9d, 0x7a, 0x8f,
+		0x51, 0x6e, 0xfa, 0x57, 0xbc, 0x50, 0x68, 0xfc, 0x41, 0x02, 0xba, 0x2d, 0x21, 0x63, 0xa7, 0xb2,
+		0x00, 0x20, 0xce, 0x0b, 0x2f, 0x5e, 0x00, 0x47, 0x1b, 0xe5, 0x23, 0xbc, 0x7a, 0xb1, 0x02, 0xd3,
+		0x2c, 0xd3, 0x96, 0x77, 0x31, 0x26, 0x80, 0x00, 0x1c, 0x13, 0x26, 0x75, 0x20, 0x32, 0x7e, 0xc2,
+		0xdf, 0x9c, 0x39, 0xc2, 0x7d, 0x98, 0x6f, 0xf1, 0xf4, 0xc8, 0x62, 0xbd, 0x0d, 0x5d, 0x7c, 0x8d,
+		0xf3, 0x05, 0x2a, 0x02, 0x64, 0xbd, 0x7e, 0xd8, 0xc9, 0xce, 0x33, 0xb8, 0xea, 0x3a, 0x1e, 0x4e,
+		0x35, 0x62, 0xd5, 0x1b, 0x38, 0x8f, 0xd0, 0x39, 0x4e, 0x68, 0x89, 0x01, 0x27, 0x87, 0xa1, 0x81,
+		0xbb, 0x09, 0x6e, 0xc2, 0x22, 0xdb, 0xd6, 0x94, 0x9e, 0x20, 0xef, 0xfa, 0x38, 0x21, 0x35, 0xc1,
+		0x49, 0xe9, 0xbe, 0xfd, 0x32, 0xa6, 0xb4, 0xcd, 0xa8, 0xba, 0x3e, 0x8e, 0x89, 0x6c, 0xc0, 0x65,
+		0xdf, 0x15, 0x35, 0x47, 0x8b, 0x36, 0xa2, 0x90, 0x52, 0x8f, 0x19, 0x6a, 0xad, 0x15, 0x11, 0x2a,
+		0xf4, 0x71, 0x9e, 0xeb, 0x43, 0xf7, 0x5d, 0x7e, 0x97, 0x7e, 0x9e, 0xc0, 0x6c, 0x30, 0x10, 0xae,
+		0x98, 0xbb, 0xa0, 0x27, 0x8c, 0x88, 0x80, 0x99, 0xd5, 0xe7, 0x24, 0xc7, 0x9f, 0x91, 0x3c, 0x88,
+		0xb8, 0x99, 0x2a, 0xf5, 0x53, 0x30, 0x3a, 0x0f, 0x88, 0x65, 0x37, 0x9b, 0x38, 0x70, 0x08, 0xe3,
+		0x07, 0x93, 0x46, 0xe8, 0x39, 0x95, 0x29, 0x4e, 0x63, 0x31, 0x6c, 0x3f, 0x25, 0xeb, 0x02, 0xee,
+		0x79, 0x0c, 0x86, 0xde, 0x83, 0x0b, 0x09, 0x23, 0x19, 0x0e, 0x10, 0xc7, 0x9e, 0x94, 0x1c, 0x24,
+		0x6b, 0x1b, 0x7f, 0x21, 0xee, 0x14, 0xb1, 0x6a, 0x3e, 0x27, 0xf6, 0xc1, 0x2f, 0x46, 0x9c, 0x38,
+		0xe2, 0x37, 0x91, 0x0e, 0xbe, 0xa5, 0xa3, 0x5c, 0x90, 0x6f, 0xd7, 0xf5, 0xb0, 0x15, 0x50, 0x19,
+		0x27, 0x38, 0xcb, 0x9b, 0x6c, 0x80, 0x4d, 0xf3, 0x66, 0xb9, 0xda, 0x09, 0xc5, 0xc2, 0x1b, 0x96,
+		0xcd, 0x51, 0x36, 0xb2, 0xc1, 0x06, 0xd0, 0x25, 0x18, 0xc5, 0x84, 0xba, 0xbe, 0x9d, 0x26, 0x3d,
+		0xe9, 0x80, 0xf1, 0x57, 0x25, 0x98, 0x13, 0x37, 0x84, 0x84, 0x39, 0xfb, 0x80, 0xfc, 0x22, 0xa8,
+		0x0c, 0x3d, 0x85, 0x33, 0xd4, 0x3e, 0x60, 0xbe, 0x97, 0x5d, 0x3c, 0xee, 0x2a, 0x2e, 0x1e, 0x4a,
+		0xe1, 0xaa, 0xec, 0x6f, 0x51, 0xe6, 0xe0, 0x74, 0xf4, 0x0f, 0x60, 0x34, 0x19, 0xca, 0x79, 0x0b,
+		0x52, 0xb7, 0x6e, 0x7e, 0x93, 0x5c, 0xfa, 0xda, 0x97, 0x91, 0xdb, 0xf7, 0x4c, 0xf2, 0x29, 0x6a,
+		0x42, 0xf7, 0x06, 0xe0, 0x33, 0xa9, 0x08, 0xbd, 0x22, 0x46, 0x7f, 0xa6, 0xc1, 0xac, 0xa0, 0x5e,
+		0x7c, 0xab, 0x9f, 0x48, 0xee, 0xc5, 0xf5, 0xee, 0x8e, 0x82, 0x7b, 0x05, 0xd5, 0x57, 0xc7, 0xfa,
+		0x3f, 0x6b, 0x30, 0x9e, 0x5d, 0x80, 0x25, 0xa5, 0x99, 0x3b, 0x29, 0xff, 0x3b, 0xc7, 0xb4, 0x4a,
+		0xa7, 0x33, 0xad, 0x75, 0x29, 0xb4, 0x28, 0xc2, 0xbc, 0xa7, 0x10, 0x3a, 0xcb, 0xcf, 0xab, 0x93,
+		0xf4, 0x4b, 0xa8, 0x74, 0x6b, 0x53, 0x9a, 0xd2, 0x06, 0x40, 0x22, 0x60, 0x6c, 0x50, 0x6f, 0x16,
+		0xe0, 0x4e, 0xb8, 0x0b, 0xf6, 0x8b, 0x18, 0x7f, 0xaa, 0xc1, 0xd2, 0x86, 0x5d, 0x3f, 0xdc, 0x77,
+		0x3d, 0xaf, 0xeb, 0x8e, 0xd2, 0xcf, 0x1a, 0x0a, 0x96, 0xb3, 0x56, 0xe1, 0x62, 0xdd, 0x0b, 0x09,
+		0x76, 0xac, 0x63, 0x97, 0x36, 0xdc, 0xa0, 0xe3, 0x9e, 0x70, 0x41, 0x4c, 0xbe, 0xe0, 0x73, 0x71,
+		0xd8, 0x9a, 0x84, 0x72, 0xd4, 0x8c, 0x33, 0x29, 0xf6, 0xa7, 0x71, 0x17, 0xae, 0xf4, 0x60, 0x54,
+		0xaa, 0xe4, 0x22, 0x0c, 0x7f, 0x15, 0xd6, 0xd2, 0x7a, 0xfa, 0xd0, 0x57, 0x61, 0x6d, 0xdb, 0x61,
+		0x97, 0xb0, 0xb7, 0x65, 0xe5, 0x83, 0xa3, 0xae, 0xb3, 0x5c, 0x27, 0xbe, 0x71, 0x16, 0xcb, 0xa3,
+		0xbf, 0x17, 0xd7, 0x62, 0x5a, 0x22, 0x19, 0x91, 0x8e, 0x6e, 0x55, 0x55, 0xef, 0xca, 0x59, 0x42,
+		0x62, 0x9a, 0xed, 0x84, 0x8c, 0xeb, 0x70, 0xad, 0x2f, 0x6f, 0xb2, 0xf4, 0x71, 0x3f, 0xd3, 0x8f,
+		0x33, 0xb8, 0x0c, 0xec, 0xba, 0xf7, 0x56, 0x6f, 0x7c, 0xa9, 0xc6, 0x2e, 0x61, 0xb5, 0x57, 0x25,
+		0xec, 0xa7, 0xb0, 0x68, 0xb2, 0x2d, 0xae, 0xbb, 0x1e, 0x8e, 0xb7, 0x91, 0xe2, 0xa0, 0x88, 0xb5,
+		0x49, 0x93, 0x28, 0xa5, 0x26, 0xf1, 0x21, 0x2c, 0xa9, 0x89, 0xf5, 0xb6, 0x88, 0x7b, 0xe2, 0x5c,
+		0x9d, 0xaa, 0xce, 0x65, 0xfc, 0x0a, 0xcc, 0xe5, 0x20, 0xcb, 0x05, 0x37, 0xe1, 0x2c, 0x0e, 0x68,
+		0xe4, 0x26, 0x9d, 0x75, 0x85, 0xca, 0x75, 0xc2, 0x59, 0xc4, 0x98, 0xc6, 0x21, 0xa0, 0xee, 0xe9,
+		0x5c, 0x2f, 0xb7, 0x0e, 0xc3, 0xb2, 0x38, 0x58, 0x1e, 0xb4, 0x38, 0x28, 0x11, 0x8d, 0xdf, 0xd1,
+		0x00, 0x75, 0x4f, 0x9f, 0xaa, 0xe4, 0xf9, 0x8a, 0x4a, 0x80, 0x5f, 0xc2, 0x85, 0x9c, 0xf9, 0x5c,
+		0xf9, 0xd7, 0xda, 0x5f, 0x16, 0x0a, 0x71, 0xb9, 0xfa, 0xf3, 0x6b, 0x30, 0xc2, 0xab, 0x4f, 0xeb,
+		0xbb, 0xdb, 0xe8, 0xb7, 0x35, 0x98, 0x53, 0x7e, 0x4b, 0x84, 0x3e, 0xe8, 0xd3, 0x74, 0xa2, 0xfa,
+		0x22, 0x4a, 0xbf, 0x33, 0x38, 0xa2, 0xb4, 0xa0, 0x5f, 0x85, 0x0b, 0x39, 0xdf, 0x7e, 0xa0, 0x9b,
+		0x7d, 0x08, 0x76, 0x7f, 0x33, 0xa4, 0xaf, 0x0e, 0x82, 0x22, 0x57, 0xcf, 0xaa, 0xa3, 0xeb, 0x7b,
+		0x97, 0xbe, 0xea, 0x50, 0x7d, 0xf0, 0xa3, 0xdf, 0x19, 0x1c, 0x51, 0x32, 0x64, 0x03, 0xa4, 0x1f,
+		0x5e, 0xa0, 0x65, 0x05, 0x9d, 0xae, 0x6f, 0x39, 0xf4, 0xeb, 0x05, 0x20, 0xd3, 0x25, 0xd2, 0x8f,
+		0x1a, 0x94, 0x4b, 0x74, 0x7d, 0xe7, 0xa1, 0x5f, 0x2f, 0x00, 0x99, 0x5d, 0x22, 0xfe, 0x1c, 0xa1,
+		0xc7, 0x12, 0x1d, 0xdf, 0x50, 0xe8, 0xd7, 0x0b, 0x40, 0xca, 0x25, 0xbe, 0x82, 0x73, 0x6d, 0x5f,
+		0x11, 0xa0, 0xef, 0xf4, 0xd1, 0x79, 0xdb, 0x42, 0xef, 0x16, 0x03, 0x96, 0x6b, 0xfd, 0x58, 0x5c,
+		0xad, 0xf2, 0x3e, 0x12, 0x40, 0xb7, 0x0a, 0xb5, 0x12, 0x77, 0x7e, 0x91, 0xa0, 0xdf, 0x1e, 0x14,
+		0x4d, 0xb2, 0xf2, 0xc7, 0xa2, 0xe3, 0xb7, 0x67, 0x27, 0x3b, 0xfa, 0x48, 0x4d, 0xbc, 0xc8, 0x87,
+		0x07, 0xfa, 0x77, 0x4f, 0x8d, 0x2f, 0xb9, 0xfc, 0x0d, 0x0d, 0x66, 0xf2, 0x7b, 0xa9, 0xd1, 0xfb,
+		0x03, 0xb6, 0x5e, 0x0b, 0x8e, 0x6e, 0x9d, 0xaa, 0x61, 0x9b, 0x1f, 0x6f, 0x65, 0x3f, 0xb1, 0xf2,
+		0x78, 0xf7, 0xeb, 0xa8, 0xd6, 0xef, 0x0c, 0x8e, 0x28, 0x19, 0xfa, 0x03, 0x8d, 0xbf, 0xa7, 0x2b,
+		0x5b, 0x6d, 0xd1, 0xdd, 0x1e, 0xa4, 0xfb, 0x74, 0x26, 0xeb, 0xf7, 0x4e, 0x85, 0x9b, 0x9e, 0xa7,
+		0xb6, 0x9e, 0x56, 0xe5, 0x79, 0xca, 0xeb, 0xdb, 0xd5, 0xdf, 0x2d, 0x06, 0x2c, 0xd7, 0x3a, 0x01,
+		0xd4, 0xdd, 0x04, 0x8a, 0x6e, 0x0c, 0xda, 0x04, 0xab, 0xdf, 0x1c, 0x00, 0x43, 0x2e, 0xdd, 0x84,
+		0xf3, 0x1d, 0x1d, 0x94, 0xe8, 0xbd, 0xa2, 0x9d, 0x96, 0x62, 0xd1, 0xea, 0x60, 0x8d, 0x99, 0x6c,
+		0xc5, 0x8e, 0xae, 0x3d, 0xe5, 0x8a, 0xf9, 0xcd, 0x92, 0x7a, 0xb5, 0x28, 0xb8, 0x5c, 0x91, 0xc0,
+		0x64, 0x67, 0xaf, 0x17, 0x52, 0xd1, 0x50, 0xb4, 0xc7, 0xe9, 0x2b, 0x85, 0xe1, 0xd3, 0x45, 0x77,
+		0x70, 0xc1, 0x45, 0x77, 0xf0, 0x60, 0x8b, 0x2a, 0xbb, 0xa9, 0x7e, 0x1d, 0xa6, 0xf3, 0xda, 0x92,
+		0xd0, 0xaa, 0x52, 0x63, 0xca, 0x8e, 0x2a, 0x7d, 0x6d, 0x20, 0x9c, 0x8c, 0xa3, 0xcb, 0xef, 0xd2,
+		0x51, 0x3a, 0xba, 0x9e, 0x6d, 0x52, 0xfa, 0xad, 0x01, 0xb1, 0x52, 0x45, 0xe4, 0x75, 0xb9, 0x28,
+		0x15, 0xd1, 0xa3, 0x6f, 0x48, 0x5f, 0x1b, 0x08, 0x47, 0x32, 0xf0, 0x8d, 0x06, 0x57, 0xfa, 0xf6,
+		0x51, 0xa0, 0xef, 0xaa, 0xa5, 0x2b, 0xd4, 0x6e, 0xa2, 0x7f, 0x7c, 0x7a, 0x02, 0xa9, 0x9d, 0x76,
+		0xf6, 0x3d, 0x28, 0xed, 0x54, 0xd1, 0xa2, 0xa1, 0xaf, 0x14, 0x86, 0x4f, 0x93, 0xdc, 0x9c, 0x5e,
+		0x04, 0x65, 0x92, 0xab, 0x6e, 0xa3, 0xd0, 0x57, 0x07, 0x41, 0xc9, 0x9e, 0x92, 0xee, 0x1e, 0x83,
+		0x1e, 0xa7, 0x44, 0xd9, 0x16, 0xa1, 0xaf, 0x0d, 0x84, 0x23, 0x19, 0x38, 0x82, 0xa9, 0xae, 0x2b,
+		0x24, 0x5a, 0xe9, 0x51, 0x4f, 0xcb, 0x5d, 0xfa, 0x46, 0x71, 0x04, 0xb9, 0xee, 0x31, 0x4c, 0xb4,
+		0x37, 0x2a, 0x20, 0x75, 0xc4, 0x50, 0xb5, 0x58, 0xe8, 0xab, 0x83, 0xa0, 0xc8, 0x85, 0x7f, 0xa4,
+		0xc1, 0x6c, 0xfc, 0xd6, 0xbf, 0x19, 0x46, 0x51, 0xab, 0x99, 0x24, 0x4e, 0x68, 0xad, 0x17, 0x3d,
+		0x45, 0xc3, 0x82, 0xfe, 0xfe, 0x60, 0x48, 0x92, 0x8d, 0x9f, 0x8a, 0x0f, 0x01, 0xd5, 0xcf, 0xf1,
+		0x68, 0x90, 0x94, 0xa1, 0xb3, 0x0b, 0x40, 0xff, 0xa5, 0xd3, 0x21, 0xa7, 0x07, 0xb1, 0xf3, 0x2d,
+		0x5c, 0x79, 0x10, 0x15, 0xcf, 0xf6, 0xfa, 0x4a, 0x61, 0xf8, 0x34, 0xf3, 0xe8, 0x7e, 0x93, 0x56,
+		0x66, 0x1e, 0xca, 0x87, 0x77, 0xfd, 0xe6, 0x00, 0x18, 0x99, 0x50, 0x91, 0xff, 0x2c, 0xac, 0x0c,
+		0x15, 0x3d, 0x1f, 0xc1, 0xf5, 0x5b, 0x03, 0x62, 0xb5, 0x39, 0xc0, 0xb6, 0xe7, 0x96, 0x5e, 0x0e,
+		0x30, 0xef, 0x3d, 0x49, 0x5f, 0x29, 0x0c, 0x9f, 0xea, 0xbd, 0xbb, 0xca, 0xaf, 0xd4, 0xbb, 0xf2,
+		0xe1, 0x42, 0xbf, 0x39, 0xf0, 0x13, 0x02, 0x93, 0xb7, 0xb3, 0xa8, 0xac, 0x94, 0x57, 0x51, 0xcb,
+		0xd7, 0x57, 0x0a, 0xc3, 0x67, 0x2e, 0x1e, 0xca, 0x02, 0xae, 0xf2, 0xe2, 0xd1, 0xaf, 0x36, 0xad,
+		0xdf, 0x19, 0x1c, 0x51, 0x32, 0xf4, 0x47, 0x1a, 0x2c, 0xf6, 0x29, 0xbc, 0xa2, 0xfb, 0xbd, 0x63,
+		0x4b, 0x9f, 0x42, 0xac, 0xfe, 0xd1, 0x69, 0xd1, 0xf3, 0xee, 0x46, 0x79, 0xfc, 0xf5, 0xbd, 0x1b,
+		0xf5, 0x60, 0xee, 0xde, 0xa9, 0x70, 0x25, 0x67, 0x3f, 0xd1, 0xa0, 0xa2, 0xaa, 0xbd, 0xa2, 0xdb,
+		0xca, 0x88, 0xd8, 0xb3, 0xf2, 0xab, 0x7f, 0x30, 0x30, 0x9e, 0xe0, 0x66, 0xe3, 0xd6, 0x2f, 0xaf,
+		0x1d, 0xb8, 0xb4, 0xd1, 0xaa, 0x55, 0xeb, 0xa1, 0xbf, 0xd2, 0xf6, 0x9f, 0x8d, 0xaa, 0x07, 0x38,
+		0x10, 0xff, 0x05, 0x2a, 0xf9, 0x17, 0x53, 0xf7, 0xf8, 0x1f, 0x47, 0x37, 0x6b, 0xc3, 0x7c, 0x7c,
+		0xed, 0xbf, 0x06, 0x00, 0xf7, 0x7c, 0x17, 0xe0, 0x8a, 0x4a, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -1830,186 +2519,7 @@ var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
-	},
-	// uber/cadence/api/v1/visibility.proto
-	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-		0x14, 0x85, 0x71, 0x69, 0x23, 0xb8, 0x29, 0x60, 0x0d, 0x82, 0x80, 0x2b, 0x08, 0xb2, 0x58, 0x54,
-		0x48, 0x8c, 0xe5, 0xb2, 0xec, 0x02, 0x25, 0xd8, 0xa0, 0x11, 0x21, 0x09, 0x8e, 0x9b, 0x36, 0x6c,
-		0xac, 0xb1, 0x3d, 0x0d, 0x23, 0xc6, 0x1e, 0xcb, 0x1e, 0xa7, 0xed, 0x53, 0xf0, 0x9e, 0x3c, 0x05,
-		0xf2, 0x1f, 0x42, 0xc2, 0x15, 0x3b, 0xfb, 0xdc, 0x73, 0x3e, 0xcd, 0xfd, 0x81, 0xd7, 0x65, 0xc8,
-		0x72, 0x2b, 0xa2, 0x31, 0x4b, 0x23, 0x66, 0xd1, 0x8c, 0x5b, 0x3b, 0xdb, 0xda, 0xf1, 0x82, 0x87,
-		0x5c, 0x70, 0x75, 0x83, 0xb3, 0x5c, 0x2a, 0x89, 0x1e, 0x57, 0x2e, 0xdc, 0xba, 0x30, 0xcd, 0x38,
-		0xde, 0xd9, 0xc6, 0x78, 0x2b, 0xe5, 0x56, 0x30, 0xab, 0xb6, 0x84, 0xe5, 0xa5, 0xa5, 0x78, 0xc2,
-		0x0a, 0x45, 0x93, 0xac, 0x49, 0x19, 0x66, 0x1f, 0xfb, 0x4a, 0xe6, 0x3f, 0x2e, 0x85, 0xbc, 0x6a,
-		0x3c, 0xe6, 0x57, 0x18, 0x9d, 0xb7, 0x8a, 0x7b, 0xcd, 0xa2, 0x52, 0x71, 0x99, 0x7e, 0xe4, 0x42,
-		0xb1, 0x1c, 0x8d, 0x61, 0xd8, 0x99, 0x03, 0x1e, 0x3f, 0xd3, 0x5e, 0x69, 0xc7, 0xf7, 0x3d, 0xe8,
-		0x24, 0x12, 0xa3, 0x27, 0x30, 0xc8, 0xcb, 0xb4, 0xaa, 0xed, 0xd5, 0xb5, 0x83, 0xbc, 0x4c, 0x49,
-		0x6c, 0x1e, 0x03, 0xea, 0x90, 0xfe, 0x4d, 0xc6, 0x5a, 0x1a, 0x82, 0xfd, 0x94, 0x26, 0xac, 0xc5,
-		0xd4, 0xdf, 0xe6, 0x4f, 0x0d, 0x1e, 0xad, 0x14, 0xcd, 0x95, 0xcf, 0x93, 0xce, 0xf7, 0x1e, 0x1e,
-		0x30, 0x9a, 0x0b, 0xce, 0x0a, 0x15, 0x54, 0x0d, 0xd5, 0x81, 0xe1, 0x89, 0x81, 0x9b, 0x6e, 0x71,
-		0xd7, 0x2d, 0xf6, 0xbb, 0x6e, 0xbd, 0xc3, 0x2e, 0x50, 0x49, 0xe8, 0x14, 0x86, 0x82, 0xaa, 0x3f,
-		0xf1, 0xbd, 0xff, 0xc6, 0xa1, 0xb1, 0x57, 0x82, 0xb9, 0x81, 0xc3, 0x95, 0xa2, 0xaa, 0x2c, 0xda,
-		0xd7, 0x10, 0x18, 0x14, 0xf5, 0x7f, 0xfd, 0x8c, 0x87, 0x27, 0x36, 0xee, 0xd9, 0x04, 0xfe, 0x67,
-		0x82, 0x1f, 0x84, 0x2c, 0x58, 0x03, 0xf2, 0x5a, 0xc0, 0x9b, 0x5f, 0x1a, 0xe8, 0x24, 0x8d, 0xd9,
-		0x35, 0x8b, 0xd7, 0x54, 0x94, 0xac, 0x9a, 0x0d, 0x7a, 0x09, 0x06, 0x99, 0x3b, 0xee, 0x85, 0xeb,
-		0x04, 0xeb, 0xc9, 0xec, 0xcc, 0x0d, 0xfc, 0xcd, 0xd2, 0x0d, 0xc8, 0x7c, 0x3d, 0x99, 0x11, 0x47,
-		0xbf, 0x83, 0x5e, 0xc0, 0xf3, 0x9e, 0xfa, 0xca, 0xf7, 0xc8, 0xfc, 0x93, 0xae, 0xdd, 0x12, 0xff,
-		0xec, 0x6e, 0xce, 0x17, 0x9e, 0xa3, 0xef, 0x21, 0x03, 0x9e, 0xf6, 0xe2, 0x7d, 0xfd, 0xee, 0x2d,
-		0x68, 0x67, 0x71, 0x36, 0x9d, 0xb9, 0xfa, 0x3e, 0x3a, 0x82, 0x51, 0x4f, 0x79, 0xba, 0x58, 0xcc,
-		0xf4, 0x03, 0x34, 0x86, 0xa3, 0xbe, 0xec, 0xc4, 0x77, 0x7d, 0xf2, 0xc5, 0xd5, 0x07, 0xd3, 0x0b,
-		0x18, 0x45, 0x32, 0xe9, 0x1b, 0xd6, 0xf4, 0xde, 0x24, 0xe3, 0xcb, 0x6a, 0x0b, 0x4b, 0xed, 0x9b,
-		0xbd, 0xe5, 0xea, 0x7b, 0x19, 0xe2, 0x48, 0x26, 0xd6, 0xdf, 0xc7, 0xfa, 0x96, 0xc7, 0xc2, 0xda,
-		0xca, 0xe6, 0xb4, 0xdb, 0xcb, 0x3d, 0xa5, 0x19, 0xdf, 0xd9, 0xe1, 0xa0, 0xd6, 0xde, 0xfd, 0x0e,
-		0x00, 0x00, 0xff, 0xff, 0xa6, 0x32, 0xc1, 0x36, 0x39, 0x03, 0x00, 0x00,
-	},
-	// uber/cadence/api/v1/workflow.proto
-	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-		0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x83, 0x1e, 0xc7, 0xb1, 0x92, 0xec, 0x26, 0x8e, 0x76,
-		0x93, 0x75, 0xd4, 0xb5, 0xbd, 0x4e, 0x36, 0x9b, 0x66, 0xd3, 0x34, 0xa5, 0x49, 0x3a, 0x66, 0x22,
-		0x53, 0xea, 0x88, 0x8a, 0xe3, 0x45, 0x51, 0x82, 0x96, 0xc6, 0xf6, 0x20, 0x12, 0x29, 0x90, 0x54,
-		0x12, 0xdf, 0x0b, 0xf4, 0xdc, 0x5b, 0xd1, 0x53, 0xff, 0x80, 0x02, 0x45, 0xd1, 0x73, 0xd1, 0xa2,
-		0x87, 0xde, 0x7a, 0xed, 0xb1, 0xf7, 0xfe, 0x17, 0xc5, 0x0c, 0x87, 0x12, 0xf5, 0x93, 0x4a, 0x0b,
-		0x6c, 0x6f, 0xe6, 0xe3, 0xf7, 0x7d, 0x7c, 0xf3, 0xe6, 0xbd, 0x8f, 0x43, 0x0b, 0x0a, 0x9d, 0x53,
-		0xe2, 0xef, 0xd6, 0x9d, 0x06, 0x71, 0xeb, 0x64, 0xd7, 0x69, 0xd3, 0xdd, 0x77, 0x7b, 0xbb, 0xef,
-		0x3d, 0xff, 0xed, 0x59, 0xd3, 0x7b, 0xbf, 0xd3, 0xf6, 0xbd, 0xd0, 0x43, 0x6b, 0x0c, 0xb3, 0x23,
-		0x30, 0x3b, 0x4e, 0x9b, 0xee, 0xbc, 0xdb, 0xbb, 0x71, 0xeb, 0xdc, 0xf3, 0xce, 0x9b, 0x64, 0x97,
-		0x43, 0x4e, 0x3b, 0x67, 0xbb, 0x8d, 0x8e, 0xef, 0x84, 0xd4, 0x73, 0x23, 0xd2, 0x8d, 0xdb, 0x83,
-		0xf7, 0x43, 0xda, 0x22, 0x41, 0xe8, 0xb4, 0xda, 0x02, 0xb0, 0x39, 0xea, 0xc9, 0x75, 0xaf, 0xd5,
-		0xea, 0x4a, 0x8c, 0xcc, 0x2d, 0x74, 0x82, 0xb7, 0x4d, 0x1a, 0x84, 0x11, 0xa6, 0xf0, 0xd7, 0x39,
-		0x58, 0x3f, 0x16, 0xe9, 0xea, 0x1f, 0x48, 0xbd, 0xc3, 0x52, 0x30, 0xdc, 0x33, 0x0f, 0xd5, 0x00,
-		0xc5, 0xeb, 0xb0, 0x49, 0x7c, 0x27, 0x2f, 0x6d, 0x4a, 0x5b, 0xb9, 0x07, 0xf7, 0x76, 0x46, 0x2c,
-		0x69, 0x67, 0x48, 0x07, 0xaf, 0xbe, 0x1f, 0x0c, 0xa1, 0x47, 0x30, 0x13, 0x5e, 0xb6, 0x49, 0x3e,
-		0xc3, 0x85, 0xee, 0x4c, 0x14, 0xb2, 0x2e, 0xdb, 0x04, 0x73, 0x38, 0x7a, 0x02, 0x10, 0x84, 0x8e,
-		0x1f, 0xda, 0xac, 0x0c, 0xf9, 0x2c, 0x27, 0xdf, 0xd8, 0x89, 0x6a, 0xb4, 0x13, 0xd7, 0x68, 0xc7,
-		0x8a, 0x6b, 0x84, 0x17, 0x38, 0x9a, 0x5d, 0x33, 0x6a, 0xbd, 0xe9, 0x05, 0x24, 0xa2, 0xce, 0xa4,
-		0x53, 0x39, 0x9a, 0x53, 0x2d, 0x58, 0x8c, 0xa8, 0x41, 0xe8, 0x84, 0x9d, 0x20, 0x3f, 0xbb, 0x29,
-		0x6d, 0x2d, 0x3f, 0xd8, 0x9b, 0x6e, 0xf5, 0x2a, 0x63, 0x56, 0x39, 0x11, 0xe7, 0xea, 0xbd, 0x0b,
-		0x74, 0x17, 0x96, 0x2f, 0x68, 0x10, 0x7a, 0xfe, 0xa5, 0xdd, 0x24, 0xee, 0x79, 0x78, 0x91, 0x9f,
-		0xdb, 0x94, 0xb6, 0xb2, 0x78, 0x49, 0x44, 0x4b, 0x3c, 0x88, 0x7e, 0x0e, 0xeb, 0x6d, 0xc7, 0x27,
-		0x6e, 0xd8, 0x2b, 0xbf, 0x4d, 0xdd, 0x33, 0x2f, 0x7f, 0x85, 0x2f, 0x61, 0x6b, 0x64, 0x16, 0x15,
-		0xce, 0xe8, 0xdb, 0x49, 0xbc, 0xd6, 0x1e, 0x0e, 0x22, 0x05, 0x96, 0x7b, 0xb2, 0xbc, 0x32, 0xf3,
-		0xa9, 0x95, 0x59, 0xea, 0x32, 0x78, 0x75, 0xb6, 0x61, 0xa6, 0x45, 0x5a, 0x5e, 0x7e, 0x81, 0x13,
-		0xaf, 0x8f, 0xcc, 0xe7, 0x88, 0xb4, 0x3c, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0x40, 0x1c, 0xbf, 0x7e,
-		0x61, 0x3b, 0x61, 0xe8, 0xd3, 0xd3, 0x4e, 0x48, 0x82, 0x3c, 0x70, 0xee, 0xdd, 0x91, 0xdc, 0x2a,
-		0x47, 0x2b, 0x5d, 0x30, 0x96, 0x83, 0x81, 0x08, 0x2a, 0xc1, 0xaa, 0xd3, 0x09, 0x3d, 0xdb, 0x27,
-		0x01, 0x09, 0xed, 0xb6, 0x47, 0xdd, 0x30, 0xc8, 0xe7, 0xb8, 0xe6, 0xe6, 0x48, 0x4d, 0xcc, 0x80,
-		0x15, 0x8e, 0xc3, 0x2b, 0x8c, 0x9a, 0x08, 0xa0, 0x9b, 0xb0, 0xc0, 0xc6, 0xc3, 0x66, 0xf3, 0x91,
-		0x5f, 0xdc, 0x94, 0xb6, 0x16, 0xf0, 0x3c, 0x0b, 0x94, 0x68, 0x10, 0xa2, 0x0d, 0xb8, 0x42, 0x03,
-		0xbb, 0xee, 0x7b, 0x6e, 0x7e, 0x69, 0x53, 0xda, 0x9a, 0xc7, 0x73, 0x34, 0x50, 0x7d, 0xcf, 0x2d,
-		0xfc, 0x26, 0x03, 0xb7, 0x86, 0x37, 0xdf, 0x73, 0xcf, 0xe8, 0xb9, 0x18, 0x69, 0xf4, 0x6d, 0x52,
-		0x38, 0x1a, 0xa1, 0x4f, 0x47, 0xa6, 0x67, 0x89, 0xa7, 0x25, 0x9e, 0xeb, 0xc0, 0x66, 0x6f, 0xa3,
-		0xc4, 0x0c, 0x78, 0x76, 0xaf, 0xa3, 0xbd, 0x4e, 0x28, 0x86, 0xe9, 0xfa, 0xd0, 0xd6, 0x69, 0x22,
-		0x01, 0xfc, 0x49, 0x57, 0xa2, 0xca, 0xe7, 0xc2, 0x53, 0xe3, 0x1e, 0xf7, 0x3a, 0x21, 0x3a, 0x86,
-		0x9b, 0x3c, 0xbd, 0x31, 0xea, 0xd9, 0x34, 0xf5, 0x0d, 0xc6, 0x1e, 0x21, 0x5c, 0xf8, 0x87, 0x04,
-		0x6b, 0x23, 0x3a, 0x92, 0x15, 0xba, 0xe1, 0xb5, 0x1c, 0xea, 0xda, 0xb4, 0xc1, 0xeb, 0xb1, 0x80,
-		0xe7, 0xa3, 0x80, 0xd1, 0x40, 0xb7, 0x21, 0x27, 0x6e, 0xba, 0x4e, 0x2b, 0x32, 0x8a, 0x05, 0x0c,
-		0x51, 0xc8, 0x74, 0x5a, 0x64, 0x8c, 0x33, 0x65, 0xff, 0x57, 0x67, 0xba, 0x03, 0x8b, 0xd4, 0xa5,
-		0x21, 0x75, 0x42, 0xd2, 0x60, 0x79, 0xcd, 0xf0, 0xa1, 0xcc, 0x75, 0x63, 0x46, 0xa3, 0xf0, 0x6b,
-		0x09, 0xd6, 0xf5, 0x0f, 0x21, 0xf1, 0x5d, 0xa7, 0xf9, 0xbd, 0xb8, 0xe5, 0x60, 0x4e, 0x99, 0xe1,
-		0x9c, 0xfe, 0x35, 0x0b, 0x6b, 0x15, 0xe2, 0x36, 0xa8, 0x7b, 0xae, 0xd4, 0x43, 0xfa, 0x8e, 0x86,
-		0x97, 0x3c, 0xa3, 0xdb, 0x90, 0x73, 0xc4, 0x75, 0xaf, 0xca, 0x10, 0x87, 0x8c, 0x06, 0x3a, 0x80,
-		0xa5, 0x2e, 0x20, 0xd5, 0x92, 0x63, 0x69, 0x6e, 0xc9, 0x8b, 0x4e, 0xe2, 0x0a, 0x3d, 0x87, 0x59,
-		0x66, 0x8f, 0x91, 0x2b, 0x2f, 0x3f, 0xb8, 0x3f, 0xda, 0x97, 0xfa, 0x33, 0x64, 0x4e, 0x48, 0x70,
-		0xc4, 0x43, 0x06, 0xac, 0x5e, 0x10, 0xc7, 0x0f, 0x4f, 0x89, 0x13, 0xda, 0x0d, 0x12, 0x3a, 0xb4,
-		0x19, 0x08, 0x9f, 0xfe, 0x64, 0x8c, 0xc9, 0x5d, 0x36, 0x3d, 0xa7, 0x81, 0xe5, 0x2e, 0x4d, 0x8b,
-		0x58, 0xe8, 0x25, 0xac, 0x35, 0x9d, 0x20, 0xb4, 0x7b, 0x7a, 0xdc, 0xda, 0x66, 0x53, 0xad, 0x6d,
-		0x95, 0xd1, 0x0e, 0x63, 0x16, 0xb7, 0xb7, 0x03, 0xe0, 0xc1, 0x68, 0x2a, 0x48, 0x23, 0x52, 0x9a,
-		0x4b, 0x55, 0x5a, 0x61, 0xa4, 0x6a, 0xc4, 0xe1, 0x3a, 0x79, 0xb8, 0xe2, 0x84, 0x21, 0x69, 0xb5,
-		0x43, 0xee, 0xdc, 0xb3, 0x38, 0xbe, 0x44, 0xf7, 0x41, 0x6e, 0x39, 0x1f, 0x68, 0xab, 0xd3, 0xb2,
-		0x45, 0x28, 0xe0, 0x2e, 0x3c, 0x8b, 0x57, 0x44, 0x5c, 0x11, 0x61, 0x66, 0xd7, 0x41, 0xfd, 0x82,
-		0x34, 0x3a, 0xcd, 0x38, 0x93, 0x85, 0x74, 0xbb, 0xee, 0x32, 0x78, 0x1e, 0x2a, 0xac, 0x90, 0x0f,
-		0x6d, 0x1a, 0xcd, 0x6c, 0xa4, 0x01, 0xa9, 0x1a, 0xcb, 0x3d, 0x0a, 0x17, 0x79, 0x0e, 0x8b, 0xbc,
-		0x28, 0x67, 0x0e, 0x6d, 0x76, 0x7c, 0x22, 0xbc, 0x76, 0xf4, 0x36, 0x1d, 0x44, 0x18, 0x9c, 0x63,
-		0x0c, 0x71, 0x81, 0xbe, 0x82, 0xab, 0x5c, 0x80, 0xf5, 0x3a, 0xf1, 0x6d, 0xda, 0x20, 0x6e, 0x48,
-		0xc3, 0x4b, 0x61, 0xb7, 0x88, 0xdd, 0x3b, 0xe6, 0xb7, 0x0c, 0x71, 0xa7, 0xf0, 0xa7, 0x0c, 0x5c,
-		0x17, 0xed, 0xa3, 0x5e, 0xd0, 0x66, 0xe3, 0x7b, 0x19, 0xbc, 0x2f, 0x13, 0xb2, 0x6c, 0x38, 0x92,
-		0x5e, 0x24, 0xbf, 0x4f, 0x9c, 0x4f, 0xb8, 0x23, 0x0d, 0x8e, 0x69, 0x76, 0x68, 0x4c, 0xd1, 0x6b,
-		0x10, 0xaf, 0x61, 0x61, 0xae, 0x6d, 0xaf, 0x49, 0xeb, 0x97, 0xbc, 0xcd, 0x97, 0xc7, 0x24, 0x1a,
-		0x39, 0x27, 0x37, 0xd4, 0x0a, 0x47, 0xe3, 0xd5, 0xf6, 0x60, 0x08, 0x5d, 0x83, 0xb9, 0xc8, 0x1a,
-		0x79, 0x93, 0x2f, 0x60, 0x71, 0x55, 0xf8, 0x7b, 0xa6, 0x6b, 0x0b, 0x1a, 0xa9, 0xd3, 0x20, 0xae,
-		0x57, 0x77, 0x5a, 0xa5, 0xf4, 0x69, 0x8d, 0x89, 0x7d, 0xd3, 0x3a, 0xdc, 0x89, 0x99, 0x8f, 0xed,
-		0xc4, 0x67, 0xb0, 0xd8, 0x37, 0x54, 0xe9, 0xc7, 0xb9, 0x5c, 0x30, 0x7a, 0xa0, 0x66, 0xfa, 0x07,
-		0x0a, 0xc3, 0x86, 0xe7, 0xd3, 0x73, 0xea, 0x3a, 0x4d, 0x7b, 0x20, 0xc9, 0x74, 0x0b, 0x58, 0x8f,
-		0xa9, 0xd5, 0x64, 0xb2, 0x85, 0x3f, 0x67, 0xe0, 0x7a, 0x6c, 0x5b, 0x25, 0xaf, 0xee, 0x34, 0x35,
-		0x1a, 0xb4, 0x9d, 0xb0, 0x7e, 0x31, 0x9d, 0xcb, 0xfe, 0xff, 0xcb, 0xf5, 0x0b, 0xb8, 0xd5, 0x9f,
-		0x81, 0xed, 0x9d, 0xd9, 0xe1, 0x05, 0x0d, 0xec, 0x64, 0x15, 0x27, 0x0b, 0xde, 0xe8, 0xcb, 0xa8,
-		0x7c, 0x66, 0x5d, 0xd0, 0x40, 0x78, 0x13, 0xfa, 0x14, 0x80, 0x9f, 0x1e, 0x42, 0xef, 0x2d, 0x89,
-		0xba, 0x70, 0x11, 0xf3, 0xe3, 0x8e, 0xc5, 0x02, 0x85, 0x97, 0x90, 0x4b, 0x9e, 0xb1, 0x9e, 0xc2,
-		0x9c, 0x38, 0xa6, 0x49, 0x9b, 0xd9, 0xad, 0xdc, 0x83, 0xcf, 0x52, 0x8e, 0x69, 0xfc, 0x04, 0x2b,
-		0x28, 0x85, 0x3f, 0x64, 0x60, 0xb9, 0xff, 0x16, 0xfa, 0x02, 0x56, 0x4e, 0xa9, 0xeb, 0xf8, 0x97,
-		0x76, 0xfd, 0x82, 0xd4, 0xdf, 0x06, 0x9d, 0x96, 0xd8, 0x84, 0xe5, 0x28, 0xac, 0x8a, 0x28, 0x5a,
-		0x87, 0x39, 0xbf, 0xe3, 0xc6, 0x2f, 0xd1, 0x05, 0x3c, 0xeb, 0x77, 0xd8, 0x69, 0xe3, 0x19, 0xdc,
-		0x3c, 0xa3, 0x7e, 0xc0, 0x5e, 0x3c, 0x51, 0xb3, 0xdb, 0x75, 0xaf, 0xd5, 0x6e, 0x92, 0xbe, 0x49,
-		0xce, 0x73, 0x48, 0x3c, 0x0e, 0x6a, 0x0c, 0xe0, 0xf4, 0xc5, 0xba, 0x4f, 0x9c, 0xee, 0xde, 0xa4,
-		0x97, 0x32, 0x27, 0xf0, 0xc2, 0x4e, 0x97, 0xb8, 0xc1, 0x52, 0xf7, 0x7c, 0xda, 0x36, 0x5d, 0x8c,
-		0x09, 0x5c, 0xe0, 0x16, 0x00, 0x3f, 0xfb, 0x86, 0xce, 0x69, 0x33, 0x7a, 0x3b, 0xcd, 0xe3, 0x44,
-		0xa4, 0xf8, 0x47, 0x09, 0xae, 0x8e, 0x7a, 0xf7, 0xa2, 0x02, 0xdc, 0xaa, 0xe8, 0xa6, 0x66, 0x98,
-		0x2f, 0x6c, 0x45, 0xb5, 0x8c, 0xd7, 0x86, 0x75, 0x62, 0x57, 0x2d, 0xc5, 0xd2, 0x6d, 0xc3, 0x7c,
-		0xad, 0x94, 0x0c, 0x4d, 0xfe, 0x01, 0xfa, 0x1c, 0x36, 0xc7, 0x60, 0xaa, 0xea, 0xa1, 0xae, 0xd5,
-		0x4a, 0xba, 0x26, 0x4b, 0x13, 0x94, 0xaa, 0x96, 0x82, 0x2d, 0x5d, 0x93, 0x33, 0xe8, 0x87, 0xf0,
-		0xc5, 0x18, 0x8c, 0xaa, 0x98, 0xaa, 0x5e, 0xb2, 0xb1, 0xfe, 0xb3, 0x9a, 0x5e, 0x65, 0xe0, 0x6c,
-		0xf1, 0x97, 0xbd, 0x9c, 0xfb, 0x1c, 0x28, 0xf9, 0x24, 0x4d, 0x57, 0x8d, 0xaa, 0x51, 0x36, 0x27,
-		0xe5, 0x3c, 0x80, 0x19, 0x93, 0xf3, 0x20, 0x2a, 0xce, 0xb9, 0xf8, 0xab, 0x4c, 0xef, 0xd3, 0xd8,
-		0x68, 0x60, 0xd2, 0xe9, 0x7a, 0xee, 0xe7, 0xb0, 0x79, 0x5c, 0xc6, 0xaf, 0x0e, 0x4a, 0xe5, 0x63,
-		0xdb, 0xd0, 0x6c, 0xac, 0xd7, 0xaa, 0xba, 0x5d, 0x29, 0x97, 0x0c, 0xf5, 0x24, 0x91, 0xc9, 0x8f,
-		0xe0, 0xeb, 0xb1, 0x28, 0xa5, 0xc4, 0xa2, 0x5a, 0xad, 0x52, 0x32, 0x54, 0xf6, 0xd4, 0x03, 0xc5,
-		0x28, 0xe9, 0x9a, 0x5d, 0x36, 0x4b, 0x27, 0xb2, 0x84, 0xbe, 0x84, 0xad, 0x69, 0x99, 0x72, 0x06,
-		0x6d, 0xc3, 0xfd, 0xb1, 0x68, 0xac, 0xbf, 0xd4, 0x55, 0x2b, 0x01, 0xcf, 0xa2, 0x3d, 0xd8, 0x1e,
-		0x0b, 0xb7, 0x74, 0x7c, 0x64, 0x98, 0xbc, 0xa0, 0x07, 0x36, 0xae, 0x99, 0xa6, 0x61, 0xbe, 0x90,
-		0x67, 0x8a, 0xbf, 0x93, 0x60, 0x75, 0xe8, 0x65, 0x84, 0x6e, 0xc3, 0xcd, 0x8a, 0x82, 0x75, 0xd3,
-		0xb2, 0xd5, 0x52, 0x79, 0x54, 0x01, 0xc6, 0x00, 0x94, 0x7d, 0xc5, 0xd4, 0xca, 0xa6, 0x2c, 0xa1,
-		0x7b, 0x50, 0x18, 0x05, 0x10, 0xbd, 0x20, 0x5a, 0x43, 0xce, 0xa0, 0x3b, 0xf0, 0xe9, 0x28, 0x5c,
-		0x37, 0x5b, 0x39, 0x5b, 0xfc, 0x77, 0x06, 0x3e, 0x99, 0xf4, 0x05, 0xce, 0x3a, 0xb0, 0xbb, 0x6c,
-		0xfd, 0x8d, 0xae, 0xd6, 0x2c, 0xb6, 0xe7, 0x91, 0x1e, 0xdb, 0xf9, 0x5a, 0x35, 0x91, 0x79, 0xb2,
-		0xa4, 0x63, 0xc0, 0x6a, 0xf9, 0xa8, 0x52, 0xd2, 0x2d, 0xde, 0x4d, 0x45, 0xb8, 0x97, 0x06, 0x8f,
-		0x36, 0x58, 0xce, 0xf4, 0xed, 0xed, 0x38, 0x69, 0xbe, 0x6e, 0x36, 0x0a, 0x68, 0x07, 0x8a, 0x69,
-		0xe8, 0x6e, 0x15, 0x34, 0x79, 0x06, 0x7d, 0x0d, 0x5f, 0xa5, 0x27, 0x6e, 0x5a, 0x86, 0x59, 0xd3,
-		0x35, 0x5b, 0xa9, 0xda, 0xa6, 0x7e, 0x2c, 0xcf, 0x4e, 0xb3, 0x5c, 0xcb, 0x38, 0x62, 0xfd, 0x59,
-		0xb3, 0xe4, 0xb9, 0xe2, 0x5f, 0x24, 0xb8, 0xa6, 0x7a, 0x6e, 0x48, 0xdd, 0x0e, 0x51, 0x02, 0x93,
-		0xbc, 0x37, 0xa2, 0x73, 0x8e, 0xe7, 0xa3, 0xbb, 0x70, 0x27, 0xd6, 0x17, 0xf2, 0xb6, 0x61, 0x1a,
-		0x96, 0xa1, 0x58, 0x65, 0x9c, 0xa8, 0xef, 0x44, 0x18, 0x1b, 0x48, 0x4d, 0xc7, 0x51, 0x5d, 0xc7,
-		0xc3, 0xb0, 0x6e, 0xe1, 0x13, 0xd1, 0x0a, 0x91, 0xc3, 0x8c, 0xc7, 0xaa, 0x98, 0xcd, 0xb7, 0x98,
-		0x7f, 0x39, 0x5b, 0xfc, 0xbd, 0x04, 0x39, 0xf1, 0x8d, 0xca, 0x3f, 0x61, 0xf2, 0x70, 0x95, 0x2d,
-		0xb0, 0x5c, 0xb3, 0x6c, 0xeb, 0xa4, 0xa2, 0xf7, 0xf7, 0x70, 0xdf, 0x1d, 0x6e, 0x0f, 0xb6, 0x55,
-		0x8e, 0xaa, 0x13, 0x39, 0x49, 0x3f, 0x40, 0x3c, 0x85, 0x61, 0x38, 0x58, 0xce, 0x4c, 0xc4, 0x44,
-		0x3a, 0x59, 0x74, 0x03, 0xae, 0xf5, 0x61, 0x0e, 0x75, 0x05, 0x5b, 0xfb, 0xba, 0x62, 0xc9, 0x33,
-		0xc5, 0xdf, 0x4a, 0x70, 0x3d, 0x76, 0x42, 0x8b, 0xbd, 0x58, 0x69, 0x8b, 0x34, 0xca, 0x9d, 0x50,
-		0x75, 0x3a, 0x01, 0x41, 0xf7, 0xe1, 0x6e, 0xd7, 0xc3, 0x2c, 0xa5, 0xfa, 0xaa, 0xb7, 0x57, 0xb6,
-		0xaa, 0xb0, 0xe1, 0xee, 0xad, 0x26, 0x15, 0x2a, 0x52, 0x90, 0x25, 0xf4, 0x05, 0x7c, 0x36, 0x19,
-		0x8a, 0xf5, 0xaa, 0x6e, 0xc9, 0x99, 0xe2, 0x3f, 0x73, 0xb0, 0x91, 0x4c, 0x8e, 0x1d, 0xf4, 0x49,
-		0x23, 0x4a, 0xed, 0x1e, 0x14, 0xfa, 0x45, 0x84, 0xcf, 0x0d, 0xe6, 0xb5, 0x07, 0xdb, 0x13, 0x70,
-		0x35, 0xf3, 0x50, 0x31, 0x35, 0x76, 0x1d, 0x83, 0x64, 0x09, 0x3d, 0x87, 0xa7, 0x13, 0x28, 0xfb,
-		0x8a, 0xd6, 0xab, 0x72, 0xf7, 0x8d, 0xa3, 0x58, 0x16, 0x36, 0xf6, 0x6b, 0x96, 0x5e, 0x95, 0x33,
-		0x48, 0x07, 0x25, 0x45, 0xa0, 0xdf, 0x87, 0x46, 0xca, 0x64, 0xd1, 0x13, 0x78, 0x94, 0x96, 0x47,
-		0xd4, 0x32, 0xc6, 0x91, 0x8e, 0x93, 0xd4, 0x19, 0xf4, 0x2d, 0x7c, 0x93, 0x42, 0x15, 0x4f, 0x1e,
-		0xe2, 0xce, 0xa2, 0xa7, 0xf0, 0x38, 0x35, 0x7b, 0xb5, 0x8c, 0x35, 0xfb, 0x48, 0xc1, 0xaf, 0xfa,
-		0xc9, 0x73, 0xc8, 0x00, 0x3d, 0xed, 0xc1, 0xc2, 0xdd, 0xec, 0x11, 0xbe, 0x90, 0x90, 0xba, 0x32,
-		0x45, 0x15, 0x59, 0x20, 0x45, 0x66, 0x1e, 0xbd, 0x00, 0x75, 0xba, 0x52, 0x4c, 0x16, 0x5a, 0x40,
-		0x6f, 0xc0, 0xfa, 0xb8, 0x5d, 0xd5, 0xdf, 0x58, 0x3a, 0x36, 0x95, 0x34, 0x65, 0x40, 0xcf, 0xe0,
-		0x49, 0x6a, 0xd1, 0xfa, 0xfd, 0x27, 0x41, 0xcf, 0xa1, 0xc7, 0xf0, 0x70, 0x02, 0x3d, 0xd9, 0x23,
-		0xbd, 0x53, 0x81, 0xa1, 0xc9, 0x8b, 0xe8, 0x11, 0xec, 0x4d, 0x20, 0xf2, 0x29, 0xb4, 0xab, 0x96,
-		0xa1, 0xbe, 0x3a, 0x89, 0x6e, 0x97, 0x8c, 0xaa, 0x25, 0x2f, 0xa1, 0x9f, 0xc2, 0x8f, 0x27, 0xd0,
-		0xba, 0x8b, 0x65, 0x7f, 0xe8, 0x38, 0x31, 0x62, 0x0c, 0x56, 0xc3, 0xba, 0xbc, 0x3c, 0xc5, 0x9e,
-		0x54, 0x8d, 0x17, 0xe9, 0x95, 0x5b, 0x41, 0x2a, 0x3c, 0x9f, 0x6a, 0x44, 0xd4, 0x43, 0xa3, 0xa4,
-		0x8d, 0x16, 0x91, 0xd1, 0x43, 0xd8, 0x9d, 0x20, 0x72, 0x50, 0xc6, 0xaa, 0x2e, 0xde, 0x58, 0x5d,
-		0x93, 0x58, 0x45, 0xdf, 0xc0, 0x83, 0x49, 0x24, 0xc5, 0x28, 0x95, 0x5f, 0xeb, 0x78, 0x90, 0x87,
-		0xd8, 0x6b, 0x74, 0xba, 0xa5, 0x1b, 0x66, 0xa5, 0x66, 0xd9, 0x55, 0xe3, 0x3b, 0x5d, 0x5e, 0x63,
-		0xaf, 0xd1, 0xd4, 0x9d, 0x8a, 0x6b, 0x25, 0x5f, 0x1d, 0x36, 0xe3, 0xa1, 0x87, 0xec, 0x1b, 0xa6,
-		0x82, 0x4f, 0xe4, 0xf5, 0x94, 0xde, 0x1b, 0x36, 0xba, 0xbe, 0x16, 0xba, 0x36, 0xcd, 0x72, 0x74,
-		0x05, 0xab, 0x87, 0xc9, 0x8a, 0x6f, 0xb0, 0xb7, 0xce, 0x1d, 0xfe, 0x0f, 0x97, 0xa1, 0x73, 0x55,
-		0xd2, 0xe2, 0xf7, 0x60, 0x3b, 0xda, 0xb7, 0x11, 0x5d, 0x30, 0xc6, 0xed, 0xf7, 0xe1, 0x27, 0xd3,
-		0x51, 0xba, 0xf7, 0x95, 0x12, 0xd6, 0x15, 0xed, 0xa4, 0x7b, 0x24, 0x95, 0x8a, 0x7f, 0x93, 0xa0,
-		0xa8, 0x3a, 0x6e, 0x9d, 0x34, 0xe3, 0xff, 0xc7, 0x4e, 0xcc, 0xf2, 0x29, 0x3c, 0x9e, 0x62, 0xde,
-		0xc7, 0xe4, 0x7b, 0x0c, 0xd5, 0x8f, 0x25, 0xd7, 0xcc, 0x57, 0x66, 0xf9, 0xd8, 0x9c, 0x44, 0x10,
-		0x8b, 0xa8, 0xd2, 0x73, 0xfe, 0xcf, 0xe4, 0xe9, 0x16, 0x21, 0xda, 0xee, 0xbf, 0x5b, 0xc4, 0xc7,
-		0x92, 0xa7, 0x5a, 0xc4, 0xfe, 0x1b, 0xd8, 0xa8, 0x7b, 0xad, 0x51, 0x5f, 0xf1, 0xfb, 0xf3, 0x4a,
-		0x9b, 0x56, 0xd8, 0x17, 0x6c, 0x45, 0xfa, 0x6e, 0xef, 0x9c, 0x86, 0x17, 0x9d, 0xd3, 0x9d, 0xba,
-		0xd7, 0xda, 0x4d, 0xfe, 0x2e, 0xb9, 0x4d, 0x1b, 0xcd, 0xdd, 0x73, 0x2f, 0xfa, 0x9d, 0x53, 0xfc,
-		0x48, 0xf9, 0xd4, 0x69, 0xd3, 0x77, 0x7b, 0xa7, 0x73, 0x3c, 0xf6, 0xf0, 0x3f, 0x01, 0x00, 0x00,
-		0xff, 0xff, 0x4a, 0xf8, 0xd6, 0xfd, 0x64, 0x1d, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
@@ -2059,40 +2569,243 @@ var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 		0xcb, 0x84, 0xe7, 0x87, 0x14, 0xbd, 0x6c, 0x8f, 0x4a, 0xb6, 0xd0, 0x82, 0x2c, 0xac, 0xdf, 0x86,
 		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xbf, 0xc9, 0xef, 0x19, 0xc9, 0xbc, 0x25,
 		0xaf, 0x7f, 0xae, 0xe6, 0xcf, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
-		0x07, 0x00, 0x00, 0xff, 0xff, 0x99, 0x3b, 0x06, 0xfc, 0x57, 0x05, 0x00, 0x00,
+		0x0f, 0x00, 0x99, 0x3b, 0x06, 0xfc, 0x57, 0x05, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/visibility.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
+		0x14, 0x85, 0x71, 0x69, 0x23, 0xb8, 0x29, 0x60, 0x0d, 0x82, 0x80, 0x2b, 0x08, 0xb2, 0x58, 0x54,
+		0x48, 0x8c, 0xe5, 0xb2, 0xec, 0x02, 0x25, 0xd8, 0xa0, 0x11, 0x21, 0x09, 0x8e, 0x9b, 0x36, 0x6c,
+		0xac, 0xb1, 0x3d, 0x0d, 0x23, 0xc6, 0x1e, 0xcb, 0x1e, 0xa7, 0xed, 0x53, 0xf0, 0x9e, 0x3c, 0x05,
+		0xf2, 0x1f, 0x42, 0xc2, 0x15, 0x3b, 0xfb, 0xdc, 0x73, 0x3e, 0xcd, 0xfd, 0x81, 0xd7, 0x65, 0xc8,
+		0x72, 0x2b, 0xa2, 0x31, 0x4b, 0x23, 0x66, 0xd1, 0x8c, 0x5b, 0x3b, 0xdb, 0xda, 0xf1, 0x82, 0x87,
+		0x5c, 0x70, 0x75, 0x83, 0xb3, 0x5c, 0x2a, 0x89, 0x1e, 0x57, 0x2e, 0xdc, 0xba, 0x30, 0xcd, 0x38,
+		0xde, 0xd9, 0xc6, 0x78, 0x2b, 0xe5, 0x56, 0x30, 0xab, 0xb6, 0x84, 0xe5, 0xa5, 0xa5, 0x78, 0xc2,
+		0x0a, 0x45, 0x93, 0xac, 0x49, 0x19, 0x66, 0x1f, 0xfb, 0x4a, 0xe6, 0x3f, 0x2e, 0x85, 0xbc, 0x6a,
+		0x3c, 0xe6, 0x57, 0x18, 0x9d, 0xb7, 0x8a, 0x7b, 0xcd, 0xa2, 0x52, 0x71, 0x99, 0x7e, 0xe4, 0x42,
+		0xb1, 0x1c, 0x8d, 0x61, 0xd8, 0x99, 0x03, 0x1e, 0x3f, 0xd3, 0x5e, 0x69, 0xc7, 0xf7, 0x3d, 0xe8,
+		0x24, 0x12, 0xa3, 0x27, 0x30, 0xc8, 0xcb, 0xb4, 0xaa, 0xed, 0xd5, 0xb5, 0x83, 0xbc, 0x4c, 0x49,
+		0x6c, 0x1e, 0x03, 0xea, 0x90, 0xfe, 0x4d, 0xc6, 0x5a, 0x1a, 0x82, 0xfd, 0x94, 0x26, 0xac, 0xc5,
+		0xd4, 0xdf, 0xe6, 0x4f, 0x0d, 0x1e, 0xad, 0x14, 0xcd, 0x95, 0xcf, 0x93, 0xce, 0xf7, 0x1e, 0x1e,
+		0x30, 0x9a, 0x0b, 0xce, 0x0a, 0x15, 0x28, 0xde, 0x06, 0x86, 0x27, 0x06, 0x6e, 0xba, 0xc5, 0x5d,
+		0xb7, 0xd8, 0xef, 0xba, 0xf5, 0x0e, 0xbb, 0x40, 0x25, 0xa1, 0x53, 0x18, 0x0a, 0xaa, 0xfe, 0xc4,
+		0xf7, 0xfe, 0x1b, 0x87, 0xc6, 0x5e, 0x09, 0xe6, 0x06, 0x0e, 0x57, 0x8a, 0xaa, 0xb2, 0x68, 0x5f,
+		0x43, 0x60, 0x50, 0xd4, 0xff, 0xf5, 0x33, 0x1e, 0x9e, 0xd8, 0xb8, 0x67, 0x13, 0xf8, 0x9f, 0x09,
+		0x7e, 0x10, 0xb2, 0x60, 0x0d, 0xc8, 0x6b, 0x01, 0x6f, 0x7e, 0x69, 0xa0, 0x93, 0x34, 0x66, 0xd7,
+		0x2c, 0x5e, 0x53, 0x51, 0xb2, 0x6a, 0x36, 0xe8, 0x25, 0x18, 0x64, 0xee, 0xb8, 0x17, 0xae, 0x13,
+		0xac, 0x27, 0xb3, 0x33, 0x37, 0xf0, 0x37, 0x4b, 0x37, 0x20, 0xf3, 0xf5, 0x64, 0x46, 0x1c, 0xfd,
+		0x0e, 0x7a, 0x01, 0xcf, 0x7b, 0xea, 0x2b, 0xdf, 0x23, 0xf3, 0x4f, 0xba, 0x76, 0x4b, 0xfc, 0xb3,
+		0xbb, 0x39, 0x5f, 0x78, 0x8e, 0xbe, 0x87, 0x0c, 0x78, 0xda, 0x8b, 0xf7, 0xf5, 0xbb, 0xb7, 0xa0,
+		0x9d, 0xc5, 0xd9, 0x74, 0xe6, 0xea, 0xfb, 0xe8, 0x08, 0x46, 0x3d, 0xe5, 0xe9, 0x62, 0x31, 0xd3,
+		0x0f, 0xd0, 0x18, 0x8e, 0xfa, 0xb2, 0x13, 0xdf, 0xf5, 0xc9, 0x17, 0x57, 0x1f, 0x4c, 0x2f, 0x60,
+		0x14, 0xc9, 0xa4, 0x6f, 0x58, 0xd3, 0x7b, 0x93, 0x8c, 0x2f, 0xab, 0x2d, 0x2c, 0xb5, 0x6f, 0xf6,
+		0x96, 0xab, 0xef, 0x65, 0x88, 0x23, 0x99, 0x58, 0x7f, 0x1f, 0xeb, 0x5b, 0x1e, 0x0b, 0x6b, 0x2b,
+		0x9b, 0xd3, 0x6e, 0x2f, 0xf7, 0x94, 0x66, 0x7c, 0x67, 0x87, 0x83, 0x5a, 0x7b, 0xf7, 0x7b, 0x00,
+		0xa6, 0x32, 0xc1, 0x36, 0x39, 0x03, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/workflow.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
+		0x15, 0x2e, 0x25, 0xdb, 0xb1, 0x9f, 0xfc, 0x83, 0x1e, 0xc7, 0xb1, 0x92, 0xec, 0x26, 0x8e, 0x76,
+		0x93, 0x75, 0xd4, 0xb5, 0xbd, 0x4e, 0x36, 0x9b, 0x66, 0xd3, 0x34, 0xa5, 0x49, 0x3a, 0x66, 0x22,
+		0x53, 0xea, 0x90, 0x8a, 0xe3, 0x45, 0x51, 0x82, 0x96, 0x68, 0x7b, 0x10, 0x89, 0x14, 0xc8, 0x51,
+		0x12, 0xdf, 0x0b, 0xf4, 0xdc, 0x5b, 0xd1, 0x53, 0xff, 0x80, 0x02, 0x45, 0xd1, 0x73, 0xd1, 0xa2,
+		0x87, 0xde, 0x7a, 0xed, 0xb1, 0xf7, 0xfe, 0x17, 0xc5, 0x0c, 0x7f, 0x88, 0xfa, 0x49, 0xa5, 0x05,
+		0xb6, 0x37, 0xf3, 0xf1, 0xfb, 0x3e, 0xbe, 0x79, 0xf3, 0xde, 0xc7, 0xa1, 0x05, 0xa5, 0xee, 0xa9,
+		0xe3, 0xef, 0x36, 0xec, 0xa6, 0xe3, 0x36, 0x9c, 0x5d, 0xbb, 0x43, 0x76, 0xdf, 0xed, 0xed, 0xbe,
+		0xf7, 0xfc, 0xb7, 0x67, 0x2d, 0xef, 0xfd, 0x4e, 0xc7, 0xf7, 0xa8, 0x87, 0xd6, 0x18, 0x66, 0x27,
+		0xc2, 0xec, 0xd8, 0x1d, 0xb2, 0xf3, 0x6e, 0xef, 0xc6, 0xad, 0x73, 0xcf, 0x3b, 0x6f, 0x39, 0xbb,
+		0x1c, 0x72, 0xda, 0x3d, 0xdb, 0x6d, 0x76, 0x7d, 0x9b, 0x12, 0xcf, 0x0d, 0x49, 0x37, 0x6e, 0x0f,
+		0xde, 0xa7, 0xa4, 0xed, 0x04, 0xd4, 0x6e, 0x77, 0x22, 0xc0, 0xe6, 0xa8, 0x27, 0x37, 0xbc, 0x76,
+		0x3b, 0x91, 0x18, 0x99, 0x1b, 0xb5, 0x83, 0xb7, 0x2d, 0x12, 0xd0, 0x10, 0x53, 0xfa, 0xeb, 0x1c,
+		0xac, 0x1f, 0x47, 0xe9, 0xaa, 0x1f, 0x9c, 0x46, 0x97, 0xa5, 0xa0, 0xb9, 0x67, 0x1e, 0xaa, 0x03,
+		0x8a, 0xd7, 0x61, 0x39, 0xf1, 0x9d, 0xa2, 0xb0, 0x29, 0x6c, 0x15, 0x1e, 0xdc, 0xdb, 0x19, 0xb1,
+		0xa4, 0x9d, 0x21, 0x1d, 0xbc, 0xfa, 0x7e, 0x30, 0x84, 0x1e, 0xc1, 0x0c, 0xbd, 0xec, 0x38, 0xc5,
+		0x1c, 0x17, 0xba, 0x33, 0x51, 0xc8, 0xbc, 0xec, 0x38, 0x98, 0xc3, 0xd1, 0x13, 0x80, 0x80, 0xda,
+		0x3e, 0xb5, 0x58, 0x19, 0x8a, 0x79, 0x4e, 0xbe, 0xb1, 0x13, 0xd6, 0x68, 0x27, 0xae, 0xd1, 0x8e,
+		0x19, 0xd7, 0x08, 0x2f, 0x70, 0x34, 0xbb, 0x66, 0xd4, 0x46, 0xcb, 0x0b, 0x9c, 0x90, 0x3a, 0x93,
+		0x4d, 0xe5, 0x68, 0x4e, 0x35, 0x61, 0x31, 0xa4, 0x06, 0xd4, 0xa6, 0xdd, 0xa0, 0x38, 0xbb, 0x29,
+		0x6c, 0x2d, 0x3f, 0xd8, 0x9b, 0x6e, 0xf5, 0x32, 0x63, 0x1a, 0x9c, 0x88, 0x0b, 0x8d, 0xde, 0x05,
+		0xba, 0x0b, 0xcb, 0x17, 0x24, 0xa0, 0x9e, 0x7f, 0x69, 0xb5, 0x1c, 0xf7, 0x9c, 0x5e, 0x14, 0xe7,
+		0x36, 0x85, 0xad, 0x3c, 0x5e, 0x8a, 0xa2, 0x15, 0x1e, 0x44, 0x3f, 0x87, 0xf5, 0x8e, 0xed, 0x3b,
+		0x2e, 0xed, 0x95, 0xdf, 0x22, 0xee, 0x99, 0x57, 0xbc, 0xc2, 0x97, 0xb0, 0x35, 0x32, 0x8b, 0x1a,
+		0x67, 0xf4, 0xed, 0x24, 0x5e, 0xeb, 0x0c, 0x07, 0x91, 0x04, 0xcb, 0x3d, 0x59, 0x5e, 0x99, 0xf9,
+		0xcc, 0xca, 0x2c, 0x25, 0x0c, 0x5e, 0x9d, 0x6d, 0x98, 0x69, 0x3b, 0x6d, 0xaf, 0xb8, 0xc0, 0x89,
+		0xd7, 0x47, 0xe6, 0x73, 0xe4, 0xb4, 0x3d, 0xcc, 0x61, 0x08, 0xc3, 0x6a, 0xe0, 0xd8, 0x7e, 0xe3,
+		0xc2, 0xb2, 0x29, 0xf5, 0xc9, 0x69, 0x97, 0x3a, 0x41, 0x11, 0x38, 0xf7, 0xee, 0x48, 0xae, 0xc1,
+		0xd1, 0x52, 0x02, 0xc6, 0x62, 0x30, 0x10, 0x41, 0x15, 0x58, 0xb5, 0xbb, 0xd4, 0xb3, 0x7c, 0x27,
+		0x70, 0xa8, 0xd5, 0xf1, 0x88, 0x4b, 0x83, 0x62, 0x81, 0x6b, 0x6e, 0x8e, 0xd4, 0xc4, 0x0c, 0x58,
+		0xe3, 0x38, 0xbc, 0xc2, 0xa8, 0xa9, 0x00, 0xba, 0x09, 0x0b, 0x6c, 0x3c, 0x2c, 0x36, 0x1f, 0xc5,
+		0xc5, 0x4d, 0x61, 0x6b, 0x01, 0xcf, 0xb3, 0x40, 0x85, 0x04, 0x14, 0x6d, 0xc0, 0x15, 0x12, 0x58,
+		0x0d, 0xdf, 0x73, 0x8b, 0x4b, 0x9b, 0xc2, 0xd6, 0x3c, 0x9e, 0x23, 0x81, 0xec, 0x7b, 0x6e, 0xe9,
+		0x37, 0x39, 0xb8, 0x35, 0xbc, 0xf9, 0x9e, 0x7b, 0x46, 0xce, 0xa3, 0x91, 0x46, 0xdf, 0xa6, 0x85,
+		0xc3, 0x11, 0xfa, 0x74, 0x64, 0x7a, 0x66, 0xf4, 0xb4, 0xd4, 0x73, 0x6d, 0xd8, 0xec, 0x6d, 0x54,
+		0x34, 0x03, 0x9e, 0xd5, 0xeb, 0x68, 0xaf, 0x4b, 0xa3, 0x61, 0xba, 0x3e, 0xb4, 0x75, 0x4a, 0x94,
+		0x00, 0xfe, 0x24, 0x91, 0x30, 0xf8, 0x5c, 0x78, 0x72, 0xdc, 0xe3, 0x5e, 0x97, 0xa2, 0x63, 0xb8,
+		0xc9, 0xd3, 0x1b, 0xa3, 0x9e, 0xcf, 0x52, 0xdf, 0x60, 0xec, 0x11, 0xc2, 0xa5, 0x7f, 0x08, 0xb0,
+		0x36, 0xa2, 0x23, 0x59, 0xa1, 0x9b, 0x5e, 0xdb, 0x26, 0xae, 0x45, 0x9a, 0xbc, 0x1e, 0x0b, 0x78,
+		0x3e, 0x0c, 0x68, 0x4d, 0x74, 0x1b, 0x0a, 0xd1, 0x4d, 0xd7, 0x6e, 0x87, 0x46, 0xb1, 0x80, 0x21,
+		0x0c, 0xe9, 0x76, 0xdb, 0x19, 0xe3, 0x4c, 0xf9, 0xff, 0xd5, 0x99, 0xee, 0xc0, 0x22, 0x71, 0x09,
+		0x25, 0x36, 0x75, 0x9a, 0x2c, 0xaf, 0x19, 0x3e, 0x94, 0x85, 0x24, 0xa6, 0x35, 0x4b, 0xbf, 0x16,
+		0x60, 0x5d, 0xfd, 0x40, 0x1d, 0xdf, 0xb5, 0x5b, 0xdf, 0x8b, 0x5b, 0x0e, 0xe6, 0x94, 0x1b, 0xce,
+		0xe9, 0x5f, 0xb3, 0xb0, 0x56, 0x73, 0xdc, 0x26, 0x71, 0xcf, 0xa5, 0x06, 0x25, 0xef, 0x08, 0xbd,
+		0xe4, 0x19, 0xdd, 0x86, 0x82, 0x1d, 0x5d, 0xf7, 0xaa, 0x0c, 0x71, 0x48, 0x6b, 0xa2, 0x03, 0x58,
+		0x4a, 0x00, 0x99, 0x96, 0x1c, 0x4b, 0x73, 0x4b, 0x5e, 0xb4, 0x53, 0x57, 0xe8, 0x39, 0xcc, 0x32,
+		0x7b, 0x0c, 0x5d, 0x79, 0xf9, 0xc1, 0xfd, 0xd1, 0xbe, 0xd4, 0x9f, 0x21, 0x73, 0x42, 0x07, 0x87,
+		0x3c, 0xa4, 0xc1, 0xea, 0x85, 0x63, 0xfb, 0xf4, 0xd4, 0xb1, 0xa9, 0xd5, 0x74, 0xa8, 0x4d, 0x5a,
+		0x41, 0xe4, 0xd3, 0x9f, 0x8c, 0x31, 0xb9, 0xcb, 0x96, 0x67, 0x37, 0xb1, 0x98, 0xd0, 0x94, 0x90,
+		0x85, 0x5e, 0xc2, 0x5a, 0xcb, 0x0e, 0xa8, 0xd5, 0xd3, 0xe3, 0xd6, 0x36, 0x9b, 0x69, 0x6d, 0xab,
+		0x8c, 0x76, 0x18, 0xb3, 0x58, 0x1c, 0x1d, 0x00, 0x0f, 0x86, 0x53, 0xe1, 0x34, 0x43, 0xa5, 0xb9,
+		0x4c, 0xa5, 0x15, 0x46, 0x32, 0x42, 0x0e, 0xd7, 0x29, 0xc2, 0x15, 0x9b, 0x52, 0xa7, 0xdd, 0xa1,
+		0xdc, 0xb9, 0x67, 0x71, 0x7c, 0x89, 0xee, 0x83, 0xd8, 0xb6, 0x3f, 0x90, 0x76, 0xb7, 0x6d, 0x45,
+		0xa1, 0x80, 0xbb, 0xf0, 0x2c, 0x5e, 0x89, 0xe2, 0x52, 0x14, 0x66, 0x76, 0x1d, 0x34, 0x2e, 0x9c,
+		0x66, 0xb7, 0x15, 0x67, 0xb2, 0x90, 0x6d, 0xd7, 0x09, 0x83, 0xe7, 0x21, 0xc3, 0x8a, 0xf3, 0xa1,
+		0x43, 0xc2, 0x99, 0x0d, 0x35, 0x20, 0x53, 0x63, 0xb9, 0x47, 0xe1, 0x22, 0xcf, 0x61, 0x91, 0x17,
+		0xe5, 0xcc, 0x26, 0xad, 0xae, 0xef, 0x14, 0x0b, 0x13, 0xb6, 0xe9, 0x20, 0xc4, 0xe0, 0x02, 0x63,
+		0x44, 0x17, 0xe8, 0x2b, 0xb8, 0xca, 0x05, 0x58, 0xaf, 0x3b, 0xbe, 0x45, 0x9a, 0x8e, 0x4b, 0x09,
+		0xbd, 0x8c, 0xec, 0x16, 0xb1, 0x7b, 0xc7, 0xfc, 0x96, 0x16, 0xdd, 0x29, 0xfd, 0x29, 0x07, 0xd7,
+		0xa3, 0xf6, 0x91, 0x2f, 0x48, 0xab, 0xf9, 0xbd, 0x0c, 0xde, 0x97, 0x29, 0x59, 0x36, 0x1c, 0x69,
+		0x2f, 0x12, 0xdf, 0xa7, 0xce, 0x27, 0xdc, 0x91, 0x06, 0xc7, 0x34, 0x3f, 0x34, 0xa6, 0xe8, 0x35,
+		0x44, 0xaf, 0xe1, 0xc8, 0x5c, 0x3b, 0x5e, 0x8b, 0x34, 0x2e, 0x79, 0x9b, 0x2f, 0x8f, 0x49, 0x34,
+		0x74, 0x4e, 0x6e, 0xa8, 0x35, 0x8e, 0xc6, 0xab, 0x9d, 0xc1, 0x10, 0xba, 0x06, 0x73, 0xa1, 0x35,
+		0xf2, 0x26, 0x5f, 0xc0, 0xd1, 0x55, 0xe9, 0xef, 0xb9, 0xc4, 0x16, 0x14, 0xa7, 0x41, 0x82, 0xb8,
+		0x5e, 0xc9, 0xb4, 0x0a, 0xd9, 0xd3, 0x1a, 0x13, 0xfb, 0xa6, 0x75, 0xb8, 0x13, 0x73, 0x1f, 0xdb,
+		0x89, 0xcf, 0x60, 0xb1, 0x6f, 0xa8, 0xb2, 0x8f, 0x73, 0x85, 0x60, 0xf4, 0x40, 0xcd, 0xf4, 0x0f,
+		0x14, 0x86, 0x0d, 0xcf, 0x27, 0xe7, 0xc4, 0xb5, 0x5b, 0xd6, 0x40, 0x92, 0xd9, 0x16, 0xb0, 0x1e,
+		0x53, 0x8d, 0x74, 0xb2, 0xa5, 0x3f, 0xe7, 0xe0, 0x7a, 0x6c, 0x5b, 0x15, 0xaf, 0x61, 0xb7, 0x14,
+		0x12, 0x74, 0x6c, 0xda, 0xb8, 0x98, 0xce, 0x65, 0xff, 0xff, 0xe5, 0xfa, 0x05, 0xdc, 0xea, 0xcf,
+		0xc0, 0xf2, 0xce, 0x2c, 0x7a, 0x41, 0x02, 0x2b, 0x5d, 0xc5, 0xc9, 0x82, 0x37, 0xfa, 0x32, 0xaa,
+		0x9e, 0x99, 0x17, 0x24, 0x88, 0xbc, 0x09, 0x7d, 0x0a, 0xc0, 0x4f, 0x0f, 0xd4, 0x7b, 0xeb, 0x84,
+		0x5d, 0xb8, 0x88, 0xf9, 0x71, 0xc7, 0x64, 0x81, 0xd2, 0x4b, 0x28, 0xa4, 0xcf, 0x58, 0x4f, 0x61,
+		0x2e, 0x3a, 0xa6, 0x09, 0x9b, 0xf9, 0xad, 0xc2, 0x83, 0xcf, 0x32, 0x8e, 0x69, 0xfc, 0x04, 0x1b,
+		0x51, 0x4a, 0x7f, 0xc8, 0xc1, 0x72, 0xff, 0x2d, 0xf4, 0x05, 0xac, 0x9c, 0x12, 0xd7, 0xf6, 0x2f,
+		0xad, 0xc6, 0x85, 0xd3, 0x78, 0x1b, 0x74, 0xdb, 0xd1, 0x26, 0x2c, 0x87, 0x61, 0x39, 0x8a, 0xa2,
+		0x75, 0x98, 0xf3, 0xbb, 0x6e, 0xfc, 0x12, 0x5d, 0xc0, 0xb3, 0x7e, 0x97, 0x9d, 0x36, 0x9e, 0xc1,
+		0xcd, 0x33, 0xe2, 0x07, 0xec, 0xc5, 0x13, 0x36, 0xbb, 0xd5, 0xf0, 0xda, 0x9d, 0x96, 0xd3, 0x37,
+		0xc9, 0x45, 0x0e, 0x89, 0xc7, 0x41, 0x8e, 0x01, 0x9c, 0xbe, 0xd8, 0xf0, 0x1d, 0x3b, 0xd9, 0x9b,
+		0xec, 0x52, 0x16, 0x22, 0x7c, 0x64, 0xa7, 0x4b, 0xdc, 0x60, 0x89, 0x7b, 0x3e, 0x6d, 0x9b, 0x2e,
+		0xc6, 0x04, 0x2e, 0x70, 0x0b, 0x80, 0x9f, 0x7d, 0xa9, 0x7d, 0xda, 0x0a, 0xdf, 0x4e, 0xf3, 0x38,
+		0x15, 0x29, 0xff, 0x51, 0x80, 0xab, 0xa3, 0xde, 0xbd, 0xa8, 0x04, 0xb7, 0x6a, 0xaa, 0xae, 0x68,
+		0xfa, 0x0b, 0x4b, 0x92, 0x4d, 0xed, 0xb5, 0x66, 0x9e, 0x58, 0x86, 0x29, 0x99, 0xaa, 0xa5, 0xe9,
+		0xaf, 0xa5, 0x8a, 0xa6, 0x88, 0x3f, 0x40, 0x9f, 0xc3, 0xe6, 0x18, 0x8c, 0x21, 0x1f, 0xaa, 0x4a,
+		0xbd, 0xa2, 0x2a, 0xa2, 0x30, 0x41, 0xc9, 0x30, 0x25, 0x6c, 0xaa, 0x8a, 0x98, 0x43, 0x3f, 0x84,
+		0x2f, 0xc6, 0x60, 0x64, 0x49, 0x97, 0xd5, 0x8a, 0x85, 0xd5, 0x9f, 0xd5, 0x55, 0x83, 0x81, 0xf3,
+		0xe5, 0x5f, 0xf6, 0x72, 0xee, 0x73, 0xa0, 0xf4, 0x93, 0x14, 0x55, 0xd6, 0x0c, 0xad, 0xaa, 0x4f,
+		0xca, 0x79, 0x00, 0x33, 0x26, 0xe7, 0x41, 0x54, 0x9c, 0x73, 0xf9, 0x57, 0xb9, 0xde, 0xa7, 0xb1,
+		0xd6, 0xc4, 0x4e, 0x37, 0xf1, 0xdc, 0xcf, 0x61, 0xf3, 0xb8, 0x8a, 0x5f, 0x1d, 0x54, 0xaa, 0xc7,
+		0x96, 0xa6, 0x58, 0x58, 0xad, 0x1b, 0xaa, 0x55, 0xab, 0x56, 0x34, 0xf9, 0x24, 0x95, 0xc9, 0x8f,
+		0xe0, 0xeb, 0xb1, 0x28, 0xa9, 0xc2, 0xa2, 0x4a, 0xbd, 0x56, 0xd1, 0x64, 0xf6, 0xd4, 0x03, 0x49,
+		0xab, 0xa8, 0x8a, 0x55, 0xd5, 0x2b, 0x27, 0xa2, 0x80, 0xbe, 0x84, 0xad, 0x69, 0x99, 0x62, 0x0e,
+		0x6d, 0xc3, 0xfd, 0xb1, 0x68, 0xac, 0xbe, 0x54, 0x65, 0x33, 0x05, 0xcf, 0xa3, 0x3d, 0xd8, 0x1e,
+		0x0b, 0x37, 0x55, 0x7c, 0xa4, 0xe9, 0xbc, 0xa0, 0x07, 0x16, 0xae, 0xeb, 0xba, 0xa6, 0xbf, 0x10,
+		0x67, 0xca, 0xbf, 0x13, 0x60, 0x75, 0xe8, 0x65, 0x84, 0x6e, 0xc3, 0xcd, 0x9a, 0x84, 0x55, 0xdd,
+		0xb4, 0xe4, 0x4a, 0x75, 0x54, 0x01, 0xc6, 0x00, 0xa4, 0x7d, 0x49, 0x57, 0xaa, 0xba, 0x28, 0xa0,
+		0x7b, 0x50, 0x1a, 0x05, 0x88, 0x7a, 0x21, 0x6a, 0x0d, 0x31, 0x87, 0xee, 0xc0, 0xa7, 0xa3, 0x70,
+		0x49, 0xb6, 0x62, 0xbe, 0xfc, 0xef, 0x1c, 0x7c, 0x32, 0xe9, 0x0b, 0x9c, 0x75, 0x60, 0xb2, 0x6c,
+		0xf5, 0x8d, 0x2a, 0xd7, 0x4d, 0xb6, 0xe7, 0xa1, 0x1e, 0xdb, 0xf9, 0xba, 0x91, 0xca, 0x3c, 0x5d,
+		0xd2, 0x31, 0x60, 0xb9, 0x7a, 0x54, 0xab, 0xa8, 0x26, 0xef, 0xa6, 0x32, 0xdc, 0xcb, 0x82, 0x87,
+		0x1b, 0x2c, 0xe6, 0xfa, 0xf6, 0x76, 0x9c, 0x34, 0x5f, 0x37, 0x1b, 0x05, 0xb4, 0x03, 0xe5, 0x2c,
+		0x74, 0x52, 0x05, 0x45, 0x9c, 0x41, 0x5f, 0xc3, 0x57, 0xd9, 0x89, 0xeb, 0xa6, 0xa6, 0xd7, 0x55,
+		0xc5, 0x92, 0x0c, 0x4b, 0x57, 0x8f, 0xc5, 0xd9, 0x69, 0x96, 0x6b, 0x6a, 0x47, 0xac, 0x3f, 0xeb,
+		0xa6, 0x38, 0x57, 0xfe, 0x8b, 0x00, 0xd7, 0x64, 0xcf, 0xa5, 0xc4, 0xed, 0x3a, 0x52, 0xa0, 0x3b,
+		0xef, 0xb5, 0xf0, 0x9c, 0xe3, 0xf9, 0xe8, 0x2e, 0xdc, 0x89, 0xf5, 0x23, 0x79, 0x4b, 0xd3, 0x35,
+		0x53, 0x93, 0xcc, 0x2a, 0x4e, 0xd5, 0x77, 0x22, 0x8c, 0x0d, 0xa4, 0xa2, 0xe2, 0xb0, 0xae, 0xe3,
+		0x61, 0x58, 0x35, 0xf1, 0x49, 0xd4, 0x0a, 0xa1, 0xc3, 0x8c, 0xc7, 0xca, 0xb8, 0xaa, 0x27, 0xf3,
+		0x2f, 0xe6, 0xcb, 0xbf, 0x17, 0xa0, 0x10, 0x7d, 0xa3, 0xf2, 0x4f, 0x98, 0x22, 0x5c, 0x65, 0x0b,
+		0xac, 0xd6, 0x4d, 0xcb, 0x3c, 0xa9, 0xa9, 0xfd, 0x3d, 0xdc, 0x77, 0x87, 0xdb, 0x83, 0x65, 0x56,
+		0xc3, 0xea, 0x84, 0x4e, 0xd2, 0x0f, 0x88, 0x9e, 0xc2, 0x30, 0x1c, 0x2c, 0xe6, 0x26, 0x62, 0x42,
+		0x9d, 0x3c, 0xba, 0x01, 0xd7, 0xfa, 0x30, 0x87, 0xaa, 0x84, 0xcd, 0x7d, 0x55, 0x32, 0xc5, 0x99,
+		0xf2, 0x6f, 0x05, 0xb8, 0x1e, 0x3b, 0x21, 0xfb, 0x0f, 0x01, 0x4b, 0xbd, 0x59, 0xed, 0x52, 0xd9,
+		0xee, 0x06, 0x0e, 0xba, 0x0f, 0x77, 0x13, 0x0f, 0x33, 0x25, 0xe3, 0x55, 0x6f, 0xaf, 0x2c, 0x59,
+		0xaa, 0x1b, 0xe9, 0xd5, 0x64, 0x42, 0xa3, 0x14, 0x44, 0x01, 0x7d, 0x01, 0x9f, 0x4d, 0x86, 0x62,
+		0xd5, 0x50, 0x4d, 0x31, 0x57, 0xfe, 0x67, 0x01, 0x36, 0xd2, 0xc9, 0xb1, 0x83, 0xbe, 0xd3, 0x0c,
+		0x53, 0xbb, 0x07, 0xa5, 0x7e, 0x91, 0xc8, 0xe7, 0x06, 0xf3, 0xda, 0x83, 0xed, 0x09, 0xb8, 0xba,
+		0x7e, 0x28, 0xe9, 0x0a, 0xbb, 0x8e, 0x41, 0xa2, 0x80, 0x9e, 0xc3, 0xd3, 0x09, 0x94, 0x7d, 0x49,
+		0xe9, 0x55, 0x39, 0x79, 0xe3, 0x48, 0xa6, 0x89, 0xb5, 0xfd, 0xba, 0xa9, 0x1a, 0x62, 0x0e, 0xa9,
+		0x20, 0x65, 0x08, 0xf4, 0xfb, 0xd0, 0x48, 0x99, 0x3c, 0x7a, 0x02, 0x8f, 0xb2, 0xf2, 0x08, 0x5b,
+		0x46, 0x3b, 0x52, 0x71, 0x9a, 0x3a, 0x83, 0xbe, 0x85, 0x6f, 0x32, 0xa8, 0xd1, 0x93, 0x87, 0xb8,
+		0xb3, 0xe8, 0x29, 0x3c, 0xce, 0xcc, 0x5e, 0xae, 0x62, 0xc5, 0x3a, 0x92, 0xf0, 0xab, 0x7e, 0xf2,
+		0x1c, 0xd2, 0x40, 0xcd, 0x7a, 0x70, 0xe4, 0x6e, 0xd6, 0x08, 0x5f, 0x48, 0x49, 0x5d, 0x99, 0xa2,
+		0x8a, 0x2c, 0x90, 0x21, 0x33, 0x8f, 0x5e, 0x80, 0x3c, 0x5d, 0x29, 0x26, 0x0b, 0x2d, 0xa0, 0x37,
+		0x60, 0x7e, 0xdc, 0xae, 0xaa, 0x6f, 0x4c, 0x15, 0xeb, 0x52, 0x96, 0x32, 0xa0, 0x67, 0xf0, 0x24,
+		0xb3, 0x68, 0xfd, 0xfe, 0x93, 0xa2, 0x17, 0xd0, 0x63, 0x78, 0x38, 0x81, 0x9e, 0xee, 0x91, 0xde,
+		0xa9, 0x40, 0x53, 0xc4, 0x45, 0xf4, 0x08, 0xf6, 0x26, 0x10, 0xf9, 0x14, 0x5a, 0x86, 0xa9, 0xc9,
+		0xaf, 0x4e, 0xc2, 0xdb, 0x15, 0xcd, 0x30, 0xc5, 0x25, 0xf4, 0x53, 0xf8, 0xf1, 0x04, 0x5a, 0xb2,
+		0x58, 0xf6, 0x87, 0x8a, 0x53, 0x23, 0xc6, 0x60, 0x75, 0xac, 0x8a, 0xcb, 0x53, 0xec, 0x89, 0xa1,
+		0xbd, 0xc8, 0xae, 0xdc, 0x0a, 0x92, 0xe1, 0xf9, 0x54, 0x23, 0x22, 0x1f, 0x6a, 0x15, 0x65, 0xb4,
+		0x88, 0x88, 0x1e, 0xc2, 0xee, 0x04, 0x91, 0x83, 0x2a, 0x96, 0xd5, 0xe8, 0x8d, 0x95, 0x98, 0xc4,
+		0x2a, 0xfa, 0x06, 0x1e, 0x4c, 0x22, 0x49, 0x5a, 0xa5, 0xfa, 0x5a, 0xc5, 0x83, 0x3c, 0xc4, 0x5e,
+		0xa3, 0xd3, 0x2d, 0x5d, 0xd3, 0x6b, 0x75, 0xd3, 0x32, 0xb4, 0xef, 0x54, 0x71, 0x8d, 0xbd, 0x46,
+		0x33, 0x77, 0x2a, 0xae, 0x95, 0x78, 0x75, 0xd8, 0x8c, 0x87, 0x1e, 0xb2, 0xaf, 0xe9, 0x12, 0x3e,
+		0x11, 0xd7, 0x33, 0x7a, 0x6f, 0xd8, 0xe8, 0xfa, 0x5a, 0xe8, 0xda, 0x34, 0xcb, 0x51, 0x25, 0x2c,
+		0x1f, 0xa6, 0x2b, 0xbe, 0xc1, 0xde, 0x3a, 0x77, 0xf8, 0x3f, 0x5c, 0x86, 0xce, 0x55, 0x69, 0x8b,
+		0xdf, 0x83, 0xed, 0x70, 0xdf, 0x46, 0x74, 0xc1, 0x18, 0xb7, 0xdf, 0x87, 0x9f, 0x4c, 0x47, 0x49,
+		0xee, 0x4b, 0x15, 0xac, 0x4a, 0xca, 0x49, 0x72, 0x24, 0x15, 0xca, 0x7f, 0x13, 0xa0, 0x2c, 0xdb,
+		0x6e, 0xc3, 0x69, 0xc5, 0xff, 0x8f, 0x9d, 0x98, 0xe5, 0x53, 0x78, 0x3c, 0xc5, 0xbc, 0x8f, 0xc9,
+		0xf7, 0x18, 0x8c, 0x8f, 0x25, 0xd7, 0xf5, 0x57, 0x7a, 0xf5, 0x58, 0x9f, 0x44, 0x88, 0x16, 0x61,
+		0x90, 0x73, 0xd7, 0x9e, 0x7a, 0x11, 0x51, 0xdb, 0xfd, 0x77, 0x8b, 0xf8, 0x58, 0xf2, 0x54, 0x8b,
+		0xd8, 0x7f, 0x03, 0x1b, 0x0d, 0xaf, 0x3d, 0xea, 0x2b, 0x7e, 0x7f, 0x5e, 0xea, 0x90, 0x1a, 0xfb,
+		0x82, 0xad, 0x09, 0xdf, 0xed, 0x9d, 0x13, 0x7a, 0xd1, 0x3d, 0xdd, 0x69, 0x78, 0xed, 0xdd, 0xf4,
+		0xef, 0x92, 0xdb, 0xa4, 0xd9, 0xda, 0x3d, 0xf7, 0xc2, 0xdf, 0x39, 0xa3, 0x1f, 0x29, 0x9f, 0xda,
+		0x1d, 0xf2, 0x6e, 0xef, 0x74, 0x8e, 0xc7, 0x1e, 0xfe, 0x67, 0x00, 0x4a, 0xf8, 0xd6, 0xfd, 0x64,
+		0x1d, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/cluster.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdf, 0x8b, 0xd3, 0x40,
-		0x10, 0x26, 0x17, 0x6b, 0xdb, 0xe9, 0xe1, 0x9d, 0x8b, 0x3f, 0x82, 0x22, 0xc4, 0x20, 0x52, 0x14,
-		0x12, 0x7a, 0xa2, 0xc2, 0x29, 0x22, 0x57, 0x39, 0xec, 0x83, 0x3f, 0x58, 0xdf, 0x7c, 0x09, 0x9b,
-		0xcd, 0x34, 0x59, 0xae, 0xd9, 0x3d, 0x36, 0x9b, 0x42, 0x1f, 0xfc, 0x13, 0xfc, 0x87, 0xf4, 0x9f,
-		0x93, 0xec, 0x26, 0xd5, 0xc3, 0x1e, 0x77, 0x6f, 0x33, 0x3b, 0xdf, 0xf7, 0xed, 0x37, 0x33, 0x0c,
-		0x3c, 0x69, 0x32, 0xd4, 0x09, 0x67, 0x39, 0x4a, 0x8e, 0x49, 0x5d, 0x32, 0x8d, 0x79, 0xb2, 0x9e,
-		0x25, 0x7c, 0xd5, 0xd4, 0x06, 0x75, 0x7c, 0xae, 0x95, 0x51, 0xe4, 0x5e, 0x8b, 0x8a, 0x3b, 0x54,
-		0xec, 0x50, 0xf1, 0x7a, 0x16, 0x3d, 0x85, 0xd1, 0x47, 0x55, 0x9b, 0x85, 0x5c, 0x2a, 0xf2, 0x00,
-		0x46, 0x22, 0x47, 0x69, 0x84, 0xd9, 0x04, 0x5e, 0xe8, 0x4d, 0xc7, 0x74, 0x9b, 0x47, 0x3f, 0x60,
-		0x44, 0x85, 0x2c, 0x2c, 0x8e, 0xc0, 0x0d, 0xad, 0x56, 0xd8, 0x61, 0x6c, 0x4c, 0x1e, 0xc3, 0x7e,
-		0x85, 0x55, 0x86, 0x3a, 0xe5, 0xaa, 0x91, 0x26, 0xd8, 0x0b, 0xbd, 0xe9, 0x80, 0x4e, 0xdc, 0xdb,
-		0xbc, 0x7d, 0x22, 0xc7, 0x30, 0x74, 0x69, 0x1d, 0xf8, 0xa1, 0x3f, 0x9d, 0x1c, 0x85, 0xf1, 0x6e,
-		0x53, 0x71, 0xef, 0x88, 0xf6, 0x84, 0xe8, 0x97, 0x07, 0xb7, 0x3e, 0xb9, 0xb8, 0x14, 0xe7, 0xd6,
-		0xc5, 0x1c, 0xf6, 0x79, 0xa3, 0x35, 0x4a, 0x93, 0x96, 0xaa, 0x36, 0xd6, 0xcd, 0x75, 0x34, 0x27,
-		0x1d, 0xab, 0x7d, 0x20, 0xcf, 0xe1, 0xb6, 0x46, 0xc6, 0x4b, 0x96, 0xad, 0x30, 0xed, 0xdd, 0xed,
-		0x85, 0xfe, 0x74, 0x4c, 0x0f, 0xb7, 0x85, 0xee, 0x63, 0xf2, 0x0a, 0x06, 0x5a, 0xc8, 0xe2, 0x4a,
-		0xfb, 0xfd, 0xa0, 0xa8, 0x83, 0x47, 0x3f, 0x3d, 0x38, 0xf8, 0xa0, 0x2a, 0x26, 0xe4, 0x9c, 0xf1,
-		0x12, 0xad, 0xfb, 0x63, 0x78, 0x28, 0x9b, 0x2a, 0x55, 0xcb, 0x54, 0x18, 0xac, 0xea, 0x54, 0xc8,
-		0x94, 0xb7, 0xc5, 0x34, 0xdb, 0xa4, 0x22, 0xb7, 0xcd, 0xf8, 0xf4, 0xae, 0x6c, 0xaa, 0x2f, 0xcb,
-		0x45, 0x0b, 0x58, 0x38, 0xee, 0xc9, 0x66, 0x91, 0x93, 0x77, 0xf0, 0xe8, 0x52, 0xae, 0x64, 0x15,
-		0xda, 0xe1, 0xfb, 0xf4, 0xfe, 0x0e, 0xf6, 0x67, 0x56, 0x61, 0xf4, 0x16, 0xc8, 0x57, 0xd4, 0xb5,
-		0xa8, 0x4d, 0x6b, 0xfc, 0x1b, 0x1a, 0x23, 0x64, 0x41, 0x0e, 0xc1, 0x3f, 0xc3, 0x7e, 0xf1, 0x6d,
-		0x48, 0xee, 0xc0, 0x60, 0xcd, 0x56, 0x8d, 0xd3, 0x1b, 0x53, 0x97, 0x44, 0xef, 0x2f, 0xb0, 0x4f,
-		0x91, 0x99, 0x46, 0xe3, 0x0e, 0x76, 0x00, 0x43, 0x94, 0xed, 0xf8, 0x72, 0xcb, 0x1f, 0xd1, 0x3e,
-		0x8d, 0x7e, 0x7b, 0x70, 0xf0, 0x8f, 0x84, 0x9d, 0x47, 0x00, 0xc3, 0x8c, 0xf1, 0x33, 0x94, 0x79,
-		0xa7, 0xd1, 0xa7, 0xe4, 0x14, 0x46, 0xb5, 0xb3, 0xe8, 0x36, 0x33, 0x39, 0x7a, 0x76, 0xd9, 0xe0,
-		0xff, 0xef, 0x8a, 0x6e, 0xb9, 0xad, 0xce, 0xd2, 0x99, 0xed, 0x17, 0x78, 0x1d, 0x9d, 0xae, 0x3f,
-		0xba, 0xe5, 0x9e, 0xbc, 0xfe, 0xfe, 0xb2, 0x10, 0xa6, 0x6c, 0xb2, 0x98, 0xab, 0x2a, 0xb9, 0x70,
-		0x7c, 0x71, 0x81, 0x32, 0xb1, 0xf7, 0xf6, 0xf7, 0x0e, 0xdf, 0xb8, 0x68, 0x3d, 0xcb, 0x6e, 0xda,
-		0xca, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0xef, 0x39, 0x7d, 0xb1, 0x03, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xed, 0x6a, 0x13, 0x41,
+		0x14, 0x65, 0xbb, 0x4d, 0x93, 0xde, 0x2d, 0x6d, 0x1d, 0xb4, 0x46, 0x45, 0x8c, 0x8b, 0x48, 0x50,
+		0xd8, 0xd0, 0x8a, 0x0a, 0x55, 0x44, 0x5a, 0x29, 0x06, 0xfc, 0x28, 0x53, 0x7f, 0xf9, 0x67, 0x98,
+		0xdd, 0xbd, 0x49, 0x86, 0x66, 0x66, 0xc3, 0xec, 0x6c, 0x68, 0x0a, 0x3e, 0x82, 0x2f, 0xa4, 0x6f,
+		0xe2, 0xd3, 0xc8, 0xcc, 0xec, 0x46, 0x8b, 0x2d, 0xf6, 0xdf, 0xdc, 0x7b, 0xce, 0xb9, 0x7b, 0x66,
+		0xef, 0x61, 0xe0, 0x51, 0x95, 0xa2, 0x1e, 0x64, 0x3c, 0x47, 0x95, 0xe1, 0xa0, 0x9c, 0x70, 0x8d,
+		0xf9, 0x60, 0xbe, 0x3b, 0xc8, 0xa6, 0x55, 0x69, 0x50, 0x27, 0x33, 0x5d, 0x98, 0x82, 0xec, 0x58,
+		0x56, 0x52, 0xb3, 0x12, 0xcf, 0x4a, 0xe6, 0xbb, 0xf1, 0x63, 0xe8, 0xbc, 0x2f, 0x4a, 0x33, 0x54,
+		0xa3, 0x82, 0xdc, 0x85, 0x8e, 0xc8, 0x51, 0x19, 0x61, 0x16, 0xdd, 0xa0, 0x17, 0xf4, 0xd7, 0xe9,
+		0xb2, 0x8e, 0xbf, 0x41, 0x87, 0x0a, 0x35, 0x76, 0x3c, 0x02, 0xab, 0xba, 0x98, 0x62, 0xcd, 0x71,
+		0x67, 0xf2, 0x10, 0x36, 0x24, 0xca, 0x14, 0x35, 0xcb, 0x8a, 0x4a, 0x99, 0xee, 0x4a, 0x2f, 0xe8,
+		0xb7, 0x68, 0xe4, 0x7b, 0x87, 0xb6, 0x45, 0xf6, 0xa1, 0xed, 0xcb, 0xb2, 0x1b, 0xf6, 0xc2, 0x7e,
+		0xb4, 0xd7, 0x4b, 0x2e, 0x37, 0x95, 0x34, 0x8e, 0x68, 0x23, 0x88, 0x7f, 0x04, 0xb0, 0xf9, 0xd1,
+		0x9f, 0x27, 0x62, 0xe6, 0x5c, 0x1c, 0xc2, 0x46, 0x56, 0x69, 0x8d, 0xca, 0xb0, 0x49, 0x51, 0x1a,
+		0xe7, 0xe6, 0x3a, 0x33, 0xa3, 0x5a, 0x65, 0x1b, 0xe4, 0x29, 0xdc, 0xd0, 0xc8, 0xb3, 0x09, 0x4f,
+		0xa7, 0xc8, 0x1a, 0x77, 0x2b, 0xbd, 0xb0, 0xbf, 0x4e, 0xb7, 0x97, 0x40, 0xfd, 0x61, 0xf2, 0x02,
+		0x5a, 0x5a, 0xa8, 0xf1, 0x7f, 0xed, 0x37, 0x3f, 0x8a, 0x7a, 0x7a, 0xfc, 0x3d, 0x80, 0xad, 0x77,
+		0x85, 0xe4, 0x42, 0x1d, 0xf2, 0x6c, 0x82, 0xce, 0xfd, 0x3e, 0xdc, 0x53, 0x95, 0x64, 0xc5, 0x88,
+		0x09, 0x83, 0xb2, 0x64, 0x42, 0xb1, 0xcc, 0x82, 0x2c, 0x5d, 0x30, 0x91, 0xbb, 0xcb, 0x84, 0xf4,
+		0x96, 0xaa, 0xe4, 0xe7, 0xd1, 0xd0, 0x12, 0x86, 0x5e, 0x7b, 0xb0, 0x18, 0xe6, 0xe4, 0x0d, 0xdc,
+		0xbf, 0x52, 0xab, 0xb8, 0x44, 0xf7, 0xf3, 0x43, 0x7a, 0xfb, 0x12, 0xf5, 0x27, 0x2e, 0x31, 0xfe,
+		0x15, 0xc0, 0x8e, 0xf7, 0x73, 0xcc, 0x17, 0xd3, 0x82, 0xe7, 0x27, 0xe2, 0x1c, 0x4f, 0x0c, 0x37,
+		0x25, 0x79, 0x00, 0x51, 0xee, 0x10, 0x3f, 0xc8, 0x6f, 0x18, 0x7c, 0xcb, 0x6a, 0xed, 0x9e, 0x67,
+		0x5e, 0xc4, 0xcc, 0x62, 0xe6, 0x3f, 0xb5, 0x4e, 0xa3, 0xba, 0xf7, 0x65, 0x31, 0x43, 0x72, 0x13,
+		0x5a, 0x3e, 0x03, 0xa1, 0xb3, 0xe1, 0x0b, 0x2b, 0xe4, 0x73, 0xd4, 0x7c, 0x8c, 0xac, 0x14, 0xe7,
+		0xd8, 0x5d, 0x75, 0x60, 0x54, 0xf7, 0xac, 0x03, 0x72, 0x07, 0x3a, 0x92, 0x9f, 0x79, 0xb8, 0xe5,
+		0xe0, 0xb6, 0xe4, 0x67, 0x0e, 0xea, 0xc3, 0xb6, 0x42, 0xae, 0xd9, 0x54, 0x48, 0x61, 0xea, 0x88,
+		0xad, 0x39, 0xca, 0xa6, 0xed, 0x7f, 0xb0, 0x6d, 0x97, 0xb2, 0xf8, 0x35, 0x90, 0x63, 0xd4, 0xa5,
+		0x28, 0x8d, 0xdd, 0xca, 0x09, 0x1a, 0x23, 0xd4, 0x98, 0x6c, 0x43, 0x78, 0x8a, 0x4d, 0xaa, 0xed,
+		0xd1, 0xba, 0x9c, 0xf3, 0x69, 0xd5, 0xdc, 0xc0, 0x17, 0xf1, 0xdb, 0x0b, 0xea, 0x23, 0xe4, 0xa6,
+		0xd2, 0x78, 0x89, 0xba, 0x0b, 0x6d, 0x54, 0x36, 0x1b, 0xb9, 0xd3, 0x77, 0x68, 0x53, 0xc6, 0x3f,
+		0x03, 0xd8, 0xfa, 0x6b, 0x84, 0x5b, 0x76, 0x17, 0xda, 0x29, 0xcf, 0x4e, 0x51, 0xe5, 0xf5, 0x8c,
+		0xa6, 0x24, 0x47, 0xd0, 0x29, 0xbd, 0x45, 0x1f, 0xbb, 0x68, 0xef, 0xc9, 0x55, 0xa9, 0xfa, 0xf7,
+		0x56, 0x74, 0xa9, 0xb5, 0x73, 0x46, 0xde, 0x6c, 0x93, 0xce, 0xeb, 0xcc, 0xa9, 0xef, 0x47, 0x97,
+		0xda, 0x83, 0x97, 0x5f, 0x9f, 0x8f, 0x85, 0x99, 0x54, 0x69, 0x92, 0x15, 0x72, 0x70, 0xe1, 0x65,
+		0x49, 0xc6, 0xa8, 0x06, 0xee, 0x31, 0xf9, 0xf3, 0xc8, 0xbc, 0xf2, 0xa7, 0xf9, 0x6e, 0xba, 0xe6,
+		0x90, 0x67, 0xbf, 0x07, 0x00, 0xb2, 0xef, 0x7d, 0x0b, 0x8e, 0x04, 0x00, 0x00,
+	},
+	// uber/cadence/shared/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xcf, 0x4a, 0xc4, 0x30,
+		0x10, 0x87, 0x89, 0xeb, 0xdf, 0x88, 0x22, 0x39, 0x48, 0xf0, 0x62, 0x51, 0x84, 0x9e, 0x12, 0x8a,
+		0x88, 0x07, 0x4f, 0xea, 0x0b, 0x48, 0x11, 0x04, 0x2f, 0x92, 0x26, 0xb3, 0xdd, 0xe0, 0x6e, 0xa6,
+		0x4e, 0x9b, 0x88, 0x6f, 0xe1, 0x23, 0x4b, 0xd3, 0x8a, 0x78, 0xfb, 0xcd, 0x37, 0xdf, 0xe5, 0xe3,
+		0x97, 0xb1, 0x01, 0xd2, 0xd6, 0x38, 0x08, 0x16, 0x74, 0xbf, 0x32, 0x04, 0x4e, 0xa7, 0x4a, 0x3b,
+		0xdc, 0x18, 0x1f, 0x54, 0x47, 0x38, 0xa0, 0x38, 0x1d, 0x25, 0x35, 0x4b, 0x6a, 0x92, 0x54, 0xaa,
+		0x2e, 0xbe, 0x19, 0x3f, 0xbb, 0xef, 0xbf, 0x82, 0x7d, 0x41, 0x7a, 0x5f, 0xae, 0xf1, 0xf3, 0x11,
+		0xc3, 0xd2, 0xb7, 0x91, 0xcc, 0xe0, 0x31, 0x08, 0xc9, 0xf7, 0x20, 0x98, 0x66, 0x0d, 0x4e, 0xb2,
+		0x82, 0x95, 0xfb, 0xf5, 0xef, 0x29, 0xae, 0xf8, 0xf1, 0x47, 0x84, 0x08, 0x6f, 0x1d, 0x61, 0xf2,
+		0x0e, 0x48, 0x6e, 0x15, 0xac, 0x3c, 0xa8, 0x8f, 0x32, 0x7d, 0x9a, 0xa1, 0x38, 0xe7, 0x87, 0x93,
+		0x36, 0x60, 0xe7, 0xad, 0x5c, 0x64, 0x87, 0x67, 0xf4, 0x3c, 0x12, 0x71, 0xc2, 0x17, 0xd4, 0xf5,
+		0x72, 0xbb, 0x60, 0xe5, 0x4e, 0x3d, 0xce, 0x87, 0xdb, 0xd7, 0x9b, 0xd6, 0x0f, 0xab, 0xd8, 0x28,
+		0x8b, 0x1b, 0xfd, 0x2f, 0x4e, 0xb5, 0x10, 0x74, 0x0e, 0xfa, 0xeb, 0xbc, 0x9b, 0x56, 0xaa, 0x9a,
+		0xdd, 0xfc, 0xb9, 0xfe, 0x19, 0x00, 0x42, 0xb7, 0x3c, 0x1a, 0x11, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -2117,507 +2830,519 @@ var yarpcFileDescriptorClosurec6fc96d64a8b67fd = [][]byte{
 		0xbd, 0xc8, 0xfe, 0xa5, 0x88, 0x71, 0x0a, 0xef, 0xa6, 0x4a, 0x2b, 0x1c, 0x58, 0xd3, 0x0f, 0x7a,
 		0xe9, 0xc0, 0x53, 0xf4, 0x2f, 0x5b, 0xf1, 0x6c, 0xbb, 0xd3, 0xdd, 0xda, 0x85, 0x9b, 0xe0, 0xed,
 		0xe5, 0xf3, 0xc5, 0x9c, 0xe9, 0x2c, 0x4f, 0x48, 0x2a, 0x16, 0x5e, 0x6d, 0x7b, 0xc8, 0x1c, 0xb8,
-		0x67, 0x76, 0x66, 0xb3, 0x86, 0x57, 0xd5, 0x53, 0xe1, 0x27, 0x7f, 0xcc, 0xcd, 0xf9, 0x77, 0x00,
-		0x00, 0x00, 0xff, 0xff, 0x84, 0xb4, 0x3e, 0x6c, 0xb0, 0x02, 0x00, 0x00,
+		0x67, 0x76, 0x66, 0xb3, 0x86, 0x57, 0xd5, 0x53, 0xe1, 0x27, 0x7f, 0xcc, 0xcd, 0xf9, 0xf7, 0x00,
+		0x84, 0xb4, 0x3e, 0x6c, 0xb0, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x57,
-		0xd5, 0xef, 0xec, 0xda, 0xbb, 0xde, 0xb3, 0x8e, 0x63, 0xdf, 0x24, 0x8e, 0x9d, 0xbf, 0xce, 0x24,
-		0x4d, 0x5c, 0xc7, 0x59, 0x27, 0x4e, 0x9a, 0x7c, 0x69, 0xbe, 0xb6, 0x9f, 0xe3, 0xd8, 0xea, 0x4a,
-		0xfe, 0x92, 0x68, 0xe2, 0xa4, 0x05, 0x21, 0x2d, 0xe3, 0x99, 0xeb, 0x78, 0xe4, 0xdd, 0x9d, 0xed,
-		0xcc, 0xac, 0x37, 0x46, 0xe2, 0x89, 0x07, 0x24, 0xd4, 0x0a, 0xaa, 0x0a, 0x89, 0x0a, 0x10, 0x08,
-		0x09, 0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x90, 0x40, 0x85, 0x27, 0x5e,
-		0x78, 0x80, 0x07, 0x1e, 0xe8, 0x1b, 0x0f, 0x94, 0x37, 0x24, 0x34, 0x77, 0xee, 0xec, 0xce, 0x9f,
-		0x7b, 0x67, 0xee, 0xac, 0x37, 0x2d, 0xa8, 0x79, 0xf3, 0xdc, 0x39, 0xf7, 0xcc, 0xef, 0x9e, 0x7b,
-		0xce, 0xb9, 0xe7, 0x9e, 0x73, 0xd6, 0x70, 0xaa, 0xbd, 0x81, 0xad, 0x05, 0x4d, 0xd5, 0x71, 0x53,
-		0xc3, 0x0b, 0x6a, 0xcb, 0x58, 0xd8, 0xb9, 0xb4, 0xb0, 0x65, 0xd8, 0x8e, 0x69, 0xed, 0x56, 0x5a,
-		0x96, 0xe9, 0x98, 0xe8, 0x80, 0x4b, 0x52, 0xa1, 0x24, 0x15, 0xb5, 0x65, 0x54, 0x76, 0x2e, 0x1d,
-		0x39, 0xf1, 0xd0, 0x34, 0x1f, 0xd6, 0xf1, 0x02, 0x21, 0xd9, 0x68, 0x6f, 0x2e, 0xe8, 0x6d, 0x4b,
-		0x75, 0x0c, 0xb3, 0xe9, 0x4d, 0x3a, 0x72, 0x32, 0xfa, 0xde, 0x31, 0x1a, 0xd8, 0x76, 0xd4, 0x46,
-		0x8b, 0x12, 0xcc, 0xb0, 0x3e, 0xac, 0x99, 0x8d, 0x46, 0x97, 0x85, 0xcc, 0xa2, 0x70, 0x54, 0x7b,
-		0xbb, 0x6e, 0xd8, 0x4e, 0x12, 0x4d, 0xc7, 0xb4, 0xb6, 0x37, 0xeb, 0x66, 0xc7, 0xa3, 0x91, 0x6f,
-		0x41, 0xf1, 0x25, 0x6f, 0x41, 0xe8, 0x3a, 0x14, 0xf0, 0x0e, 0x6e, 0x3a, 0xf6, 0x94, 0x34, 0x93,
-		0x9f, 0x2d, 0x2f, 0x9e, 0xaa, 0x30, 0xd6, 0x56, 0xa1, 0xd4, 0x2b, 0x2e, 0xa5, 0x42, 0x27, 0xc8,
-		0xef, 0x5f, 0x83, 0xd1, 0xe0, 0x0b, 0x34, 0x0d, 0x23, 0xe4, 0x55, 0xcd, 0xd0, 0xa7, 0xa4, 0x19,
-		0x69, 0x36, 0xaf, 0x14, 0xc9, 0x73, 0x55, 0x47, 0xd7, 0x01, 0xbc, 0x57, 0xee, 0xa2, 0xa7, 0x72,
-		0x33, 0xd2, 0x6c, 0x79, 0xf1, 0x48, 0xc5, 0x93, 0x48, 0xc5, 0x97, 0x48, 0x65, 0xdd, 0x97, 0x88,
-		0x52, 0x22, 0xd4, 0xee, 0x33, 0x9a, 0x82, 0xe2, 0x0e, 0xb6, 0x6c, 0xc3, 0x6c, 0x4e, 0xe5, 0x3d,
-		0xa6, 0xf4, 0x11, 0x1d, 0x86, 0xa2, 0xbb, 0x78, 0xf7, 0x73, 0x43, 0xe4, 0x4d, 0xc1, 0x7d, 0xac,
-		0xea, 0xe8, 0x6b, 0x12, 0x9c, 0xf7, 0x97, 0x5c, 0xc3, 0x8f, 0xb0, 0xd6, 0x76, 0xf7, 0xa1, 0x66,
-		0x3b, 0xaa, 0xe5, 0x60, 0xbd, 0xe6, 0x21, 0x51, 0x1d, 0xc7, 0x32, 0x36, 0xda, 0x0e, 0xb6, 0xa7,
-		0x86, 0x09, 0x9e, 0xff, 0x65, 0x2e, 0xfd, 0x65, 0xca, 0x67, 0xc5, 0x67, 0x73, 0xcf, 0xe3, 0x42,
-		0x96, 0xbc, 0xd4, 0xe5, 0xf1, 0xd2, 0x53, 0xca, 0xb9, 0x8e, 0x18, 0x29, 0xfa, 0x96, 0x04, 0x17,
-		0x18, 0xf0, 0x34, 0xb3, 0xd1, 0xaa, 0x63, 0x26, 0xc0, 0x02, 0x01, 0xf8, 0x82, 0x18, 0xc0, 0x65,
-		0x9f, 0x4f, 0x1c, 0xe2, 0x33, 0x1d, 0x51, 0x62, 0xf4, 0x96, 0x04, 0x73, 0x0c, 0x90, 0x9b, 0xaa,
-		0x51, 0x67, 0x21, 0x2c, 0x12, 0x84, 0x37, 0xc4, 0x10, 0xae, 0x12, 0x26, 0x71, 0x78, 0x67, 0x3b,
-		0x42, 0x94, 0xe8, 0x9b, 0x6c, 0x01, 0xba, 0xba, 0xa5, 0xd7, 0xcc, 0xb6, 0x13, 0x87, 0x37, 0x42,
-		0xe0, 0x3d, 0x2f, 0x06, 0xcf, 0x55, 0x3b, 0xfd, 0x4e, 0xdb, 0x89, 0x03, 0x9c, 0xed, 0x08, 0xd2,
-		0xa2, 0x37, 0x25, 0x98, 0xd5, 0xb1, 0x66, 0xd8, 0x04, 0x98, 0xab, 0xa5, 0xb6, 0xb6, 0x85, 0xf5,
-		0x36, 0x53, 0x78, 0x25, 0x82, 0xee, 0x3a, 0x13, 0xdd, 0x2d, 0xca, 0x64, 0x5d, 0xb5, 0xb7, 0xef,
-		0xf9, 0x2c, 0xe2, 0xc8, 0xce, 0xe8, 0x02, 0x74, 0xe8, 0x75, 0x09, 0xce, 0x46, 0x50, 0xf1, 0x6c,
-		0x02, 0x08, 0xa6, 0x6b, 0xe9, 0x98, 0x78, 0xe6, 0x20, 0xeb, 0xa9, 0x54, 0x0c, 0x29, 0x25, 0x18,
-		0x41, 0x59, 0x50, 0x4a, 0x09, 0xfa, 0x1f, 0x92, 0x12, 0x57, 0xf5, 0xdf, 0x88, 0xa1, 0x4a, 0xd0,
-		0xac, 0x51, 0x82, 0xea, 0x7f, 0x52, 0x51, 0xf1, 0x95, 0xea, 0xb4, 0x9e, 0x4e, 0x86, 0xbe, 0x20,
-		0xc1, 0xd3, 0x61, 0x4c, 0x3c, 0x4b, 0xdc, 0x47, 0x00, 0x5d, 0x4d, 0x05, 0xc4, 0x33, 0xc2, 0x53,
-		0x7a, 0x1a, 0x11, 0xd9, 0x36, 0x55, 0x73, 0x8c, 0x1d, 0xc3, 0xd9, 0x4d, 0x55, 0xee, 0xb1, 0x84,
-		0x6d, 0x5b, 0xa2, 0x4c, 0xd2, 0x94, 0x5b, 0x15, 0xa0, 0x23, 0xca, 0x1d, 0x41, 0xc5, 0x53, 0xee,
-		0xfd, 0x09, 0xca, 0x1d, 0xc2, 0xc4, 0x55, 0x6e, 0x35, 0x95, 0x8a, 0x21, 0xa5, 0x04, 0xe5, 0x1e,
-		0x17, 0x94, 0x52, 0x92, 0x72, 0xab, 0x02, 0x74, 0x44, 0x91, 0xc2, 0xa8, 0x78, 0x8a, 0x34, 0x91,
-		0xa0, 0x48, 0x41, 0x48, 0x5c, 0x45, 0x52, 0xd3, 0x88, 0x88, 0xa5, 0x85, 0xc1, 0x24, 0x58, 0x1a,
-		0x4a, 0xb0, 0xb4, 0x20, 0x9e, 0x04, 0x4b, 0x53, 0xd3, 0xc9, 0x50, 0x07, 0x4e, 0xb8, 0x20, 0x2c,
-		0xbe, 0xf6, 0x1c, 0x20, 0x40, 0x2e, 0x32, 0x81, 0xb8, 0x5c, 0x2d, 0xae, 0xda, 0x1c, 0x75, 0xf8,
-		0xaf, 0xd1, 0xab, 0x70, 0xcc, 0xfb, 0xf0, 0xa6, 0x61, 0xb1, 0x3e, 0x7b, 0x90, 0x7c, 0xb6, 0xc2,
-		0xff, 0xec, 0xaa, 0x3b, 0x2f, 0xfe, 0xd1, 0x69, 0x87, 0xf7, 0x12, 0x7d, 0x47, 0x82, 0x85, 0x88,
-		0x8a, 0xaa, 0x4d, 0x0d, 0xd7, 0x6b, 0x16, 0x7e, 0xb5, 0x8d, 0x6d, 0xe6, 0xea, 0x0f, 0x11, 0x18,
-		0x2f, 0xa6, 0x6b, 0x2a, 0xe1, 0xa4, 0xf8, 0x8c, 0xe2, 0xb8, 0xe6, 0x54, 0x61, 0x6a, 0xf4, 0x43,
-		0x09, 0xae, 0x50, 0x4c, 0x3e, 0x44, 0x31, 0x25, 0x9e, 0x24, 0x68, 0x97, 0x99, 0x68, 0xe9, 0xd7,
-		0xbc, 0x4f, 0x8b, 0x68, 0x74, 0xc5, 0xca, 0x34, 0x03, 0x7d, 0x49, 0x82, 0x73, 0x2c, 0xf1, 0xb2,
-		0x80, 0x1e, 0x16, 0xd4, 0xee, 0x65, 0xca, 0x21, 0x45, 0xbb, 0x39, 0x64, 0xe8, 0x33, 0x70, 0xd2,
-		0x53, 0x32, 0x3e, 0x92, 0x29, 0x82, 0xe4, 0x12, 0x5f, 0xcf, 0xf8, 0x10, 0x3c, 0x05, 0xe6, 0x7d,
-		0xfb, 0xf3, 0x12, 0x9c, 0xa1, 0x9b, 0x47, 0x15, 0x9d, 0xb3, 0x69, 0xd3, 0x04, 0xc1, 0xb3, 0x4c,
-		0x04, 0x1e, 0x73, 0x4f, 0xdf, 0x39, 0xdb, 0x34, 0xa3, 0xa5, 0xd0, 0xa0, 0xcf, 0xc2, 0x4c, 0x43,
-		0xb5, 0xb6, 0xb1, 0x55, 0xb3, 0xb0, 0x66, 0x5a, 0x3a, 0x0b, 0xc4, 0x11, 0x02, 0x62, 0x91, 0x09,
-		0xe2, 0xff, 0xc9, 0x64, 0x85, 0xce, 0x8d, 0x23, 0x38, 0xde, 0x48, 0x22, 0x40, 0xdf, 0x90, 0x60,
-		0x9e, 0x75, 0x3f, 0x31, 0x1e, 0x36, 0x55, 0xa6, 0x40, 0x8e, 0x66, 0x09, 0x5f, 0xef, 0x51, 0x36,
-		0x22, 0xe1, 0x2b, 0x87, 0x16, 0x7d, 0x5b, 0x82, 0x0a, 0x2b, 0xc2, 0xc6, 0x56, 0xc3, 0x68, 0xaa,
-		0x4c, 0xbf, 0x70, 0x2c, 0xc1, 0x2f, 0xc4, 0x43, 0xec, 0x2e, 0x23, 0x86, 0x5f, 0xe8, 0x08, 0x53,
-		0xa3, 0x1f, 0x49, 0x70, 0x85, 0x75, 0x95, 0x4a, 0xf5, 0x62, 0xc7, 0x09, 0xda, 0x5b, 0x82, 0x37,
-		0xaa, 0x34, 0x57, 0xb6, 0xd0, 0xc9, 0x36, 0x85, 0xa7, 0x01, 0x7c, 0xa3, 0x3c, 0x91, 0x45, 0x03,
-		0xf8, 0x06, 0x3a, 0xdb, 0x11, 0xa4, 0x45, 0x7f, 0x95, 0x60, 0x25, 0xe2, 0x71, 0xf1, 0x23, 0x07,
-		0x5b, 0x4d, 0xb5, 0x5e, 0x63, 0x20, 0x37, 0x9a, 0x86, 0x63, 0xb0, 0x15, 0xe3, 0x24, 0x81, 0x7e,
-		0x2f, 0xdd, 0x05, 0xaf, 0x50, 0xfe, 0xb1, 0xf5, 0x54, 0x7d, 0xe6, 0xf1, 0x05, 0xbd, 0x60, 0xed,
-		0x89, 0x03, 0xfa, 0x93, 0x04, 0x37, 0x33, 0x2c, 0x93, 0xe7, 0xb1, 0x66, 0xc8, 0x1a, 0xef, 0xee,
-		0x61, 0x8d, 0x3c, 0x67, 0x76, 0xc3, 0xea, 0x7f, 0x3a, 0x7a, 0x4f, 0x82, 0xe7, 0x93, 0x96, 0x93,
-		0x6e, 0x27, 0xa7, 0xc8, 0xc2, 0xd6, 0x98, 0x0b, 0xe3, 0x82, 0x49, 0xb5, 0x97, 0x6b, 0xb8, 0xbf,
-		0xa9, 0x24, 0x0e, 0x60, 0xa6, 0x4e, 0x9a, 0x8e, 0xd1, 0x6c, 0x63, 0xbd, 0xa6, 0xda, 0xb5, 0x26,
-		0xee, 0xc4, 0xd7, 0x21, 0x27, 0xc4, 0x01, 0x8c, 0x0c, 0x0a, 0x65, 0xb7, 0x64, 0xdf, 0xc6, 0x1d,
-		0x46, 0x1c, 0xd0, 0xc9, 0x34, 0x03, 0xfd, 0x52, 0x82, 0xeb, 0x24, 0x9a, 0xac, 0x69, 0x5b, 0x46,
-		0x5d, 0xcf, 0x68, 0x3f, 0xa7, 0x09, 0xf4, 0x97, 0x98, 0xd0, 0x49, 0x28, 0xb9, 0xec, 0x32, 0xcd,
-		0x62, 0x34, 0x97, 0xed, 0xec, 0xd3, 0xd0, 0x4f, 0x25, 0xb8, 0x9a, 0xb2, 0x08, 0x9e, 0x75, 0x9c,
-		0x21, 0x2b, 0x58, 0xc9, 0xba, 0x02, 0x9e, 0x49, 0x5c, 0xb4, 0x33, 0xce, 0x41, 0xdf, 0x93, 0xe0,
-		0x12, 0x17, 0x35, 0x37, 0xce, 0x7f, 0x9a, 0xc0, 0x5e, 0x62, 0x87, 0x21, 0xcc, 0xaf, 0x73, 0x03,
-		0xff, 0x79, 0x2d, 0x03, 0x3d, 0xfa, 0x81, 0x04, 0x97, 0xb9, 0x70, 0x13, 0x2e, 0x91, 0x67, 0x13,
-		0x94, 0x9c, 0x0d, 0x38, 0xe1, 0x3a, 0x59, 0xd1, 0x32, 0xcd, 0x40, 0xef, 0x48, 0x70, 0x31, 0xb3,
-		0x66, 0x9c, 0x23, 0x88, 0xff, 0x2f, 0x03, 0x62, 0x9e, 0x52, 0x9c, 0xd7, 0x32, 0xe8, 0xc3, 0xbb,
-		0x12, 0x2c, 0xf2, 0x05, 0xcc, 0x3d, 0x84, 0x67, 0x09, 0xda, 0x9b, 0x59, 0xe4, 0xcb, 0x3d, 0x89,
-		0x2f, 0x68, 0x59, 0x26, 0xa0, 0xef, 0x27, 0xa9, 0x44, 0xc2, 0xa5, 0xf9, 0x99, 0xcc, 0x90, 0xf9,
-		0xd7, 0x67, 0x0e, 0x64, 0xde, 0x45, 0xda, 0x8d, 0xcd, 0xf8, 0x90, 0x13, 0x22, 0xc9, 0xb9, 0x84,
-		0xd8, 0x8c, 0x83, 0x39, 0x21, 0x9c, 0x5c, 0xd0, 0xb2, 0x4d, 0x21, 0x87, 0xa6, 0x17, 0x8a, 0xf7,
-		0x1b, 0xf1, 0x9c, 0x4f, 0x38, 0x34, 0xbd, 0x88, 0xbb, 0x9f, 0x50, 0xe7, 0x9a, 0xdd, 0xdf, 0x54,
-		0xf4, 0x2b, 0x09, 0x9e, 0x13, 0x58, 0x10, 0xcf, 0x46, 0xe7, 0xc9, 0x6a, 0xaa, 0xfd, 0xac, 0x86,
-		0x67, 0xac, 0x57, 0xec, 0x3e, 0xe6, 0xa1, 0x9f, 0x48, 0xf0, 0x6c, 0xd2, 0x02, 0xf8, 0xf7, 0xa7,
-		0x0b, 0x09, 0x07, 0x10, 0x17, 0x04, 0xff, 0x1e, 0x75, 0x11, 0x67, 0x9c, 0x43, 0x1c, 0x4e, 0xbb,
-		0x65, 0x63, 0xcb, 0xe9, 0x01, 0xb7, 0xb1, 0x6a, 0x69, 0x5b, 0x01, 0x98, 0x71, 0xdc, 0x95, 0x04,
-		0xeb, 0xbd, 0x4f, 0xd8, 0xf9, 0x08, 0xee, 0x11, 0x66, 0xbd, 0x2f, 0x32, 0xac, 0xb7, 0x9d, 0x65,
-		0xc2, 0xcd, 0x51, 0x80, 0x1e, 0x10, 0xf9, 0x2f, 0x65, 0x38, 0x27, 0x7a, 0x7a, 0xad, 0xc2, 0xbe,
-		0xee, 0x1a, 0x9d, 0xdd, 0x16, 0x26, 0xb5, 0x40, 0x5e, 0x65, 0xd1, 0x67, 0xba, 0xbe, 0xdb, 0xc2,
-		0xca, 0x68, 0x27, 0xf0, 0x84, 0x3e, 0x05, 0x87, 0x5a, 0xaa, 0xe5, 0x4a, 0x24, 0x68, 0x74, 0x9b,
-		0x26, 0x2d, 0x1f, 0xce, 0x32, 0xf9, 0xdd, 0x25, 0x33, 0x02, 0x36, 0xb1, 0x69, 0x2a, 0x07, 0x5a,
-		0xf1, 0x41, 0xf4, 0x1c, 0x94, 0x48, 0x46, 0xa6, 0x6e, 0xd8, 0x0e, 0x29, 0x2c, 0x96, 0x17, 0x8f,
-		0xb3, 0x53, 0x1e, 0xaa, 0xbd, 0xbd, 0x66, 0xd8, 0x8e, 0x32, 0xe2, 0xd0, 0xbf, 0xd0, 0x22, 0x0c,
-		0x1b, 0xcd, 0x56, 0xdb, 0x21, 0x65, 0xc7, 0xf2, 0xe2, 0x31, 0x0e, 0x92, 0xdd, 0xba, 0xa9, 0xea,
-		0x8a, 0x47, 0x8a, 0x54, 0x98, 0x89, 0x84, 0x1c, 0x35, 0xc7, 0xac, 0x69, 0x75, 0xd3, 0xc6, 0xc4,
-		0x7f, 0x9b, 0x6d, 0x87, 0xd6, 0x21, 0xa7, 0x63, 0x75, 0xd1, 0x5b, 0xb4, 0x92, 0xac, 0x1c, 0xc3,
-		0x21, 0xd9, 0xaf, 0x9b, 0xcb, 0xee, 0xfc, 0x75, 0x6f, 0x3a, 0x7a, 0x19, 0x8e, 0xf6, 0xd2, 0xde,
-		0x71, 0xee, 0x85, 0x34, 0xee, 0x87, 0x1d, 0x3f, 0x99, 0x1d, 0x61, 0x7c, 0x03, 0x8e, 0xf4, 0x22,
-		0xec, 0xde, 0x2a, 0xac, 0x76, 0xb3, 0x66, 0xe8, 0xa4, 0xf4, 0x57, 0x52, 0x0e, 0x77, 0x29, 0xba,
-		0x72, 0x56, 0xda, 0xcd, 0xaa, 0x8e, 0xaa, 0x50, 0xa2, 0xae, 0xd2, 0xb4, 0x48, 0x1d, 0x6e, 0x6c,
-		0xf1, 0x3c, 0xdb, 0xb5, 0x53, 0x06, 0x24, 0x84, 0xae, 0xfa, 0x53, 0x94, 0xde, 0x6c, 0x54, 0x85,
-		0x89, 0x1e, 0x0e, 0xd7, 0x5d, 0xb5, 0x2d, 0x4c, 0x8b, 0x67, 0xec, 0x3d, 0x58, 0xf5, 0x68, 0x94,
-		0xf1, 0xee, 0x34, 0x3a, 0x82, 0x14, 0x98, 0xac, 0xab, 0xee, 0x9d, 0xcf, 0x0b, 0x67, 0xc8, 0x72,
-		0xb0, 0xdd, 0xae, 0x3b, 0xb4, 0xf0, 0x95, 0xbc, 0xa7, 0x07, 0xdd, 0xb9, 0xcb, 0xdd, 0xa9, 0x0a,
-		0x99, 0x89, 0xae, 0xc3, 0xb4, 0x69, 0x19, 0x0f, 0x0d, 0xcf, 0xd1, 0x46, 0xa4, 0x54, 0x26, 0x52,
-		0x9a, 0xf4, 0x09, 0x22, 0x42, 0x3a, 0x02, 0x23, 0x86, 0x8e, 0x9b, 0x8e, 0xe1, 0xec, 0x92, 0x8a,
-		0x52, 0x49, 0xe9, 0x3e, 0xa3, 0xcb, 0x30, 0xb9, 0x69, 0x58, 0xb6, 0x13, 0xe7, 0xb9, 0x8f, 0x50,
-		0x1e, 0x20, 0x6f, 0x23, 0x0c, 0x97, 0x61, 0xd4, 0xc2, 0x8e, 0xb5, 0x5b, 0x6b, 0x99, 0x75, 0x43,
-		0xdb, 0xa5, 0x55, 0x98, 0x19, 0xce, 0x05, 0xd5, 0xb1, 0x76, 0xef, 0x12, 0x3a, 0xa5, 0x6c, 0xf5,
-		0x1e, 0xd0, 0x14, 0x14, 0x55, 0xc7, 0xc1, 0x8d, 0x96, 0x43, 0x2a, 0x26, 0xc3, 0x8a, 0xff, 0x88,
-		0x96, 0x61, 0x3f, 0x7e, 0xd4, 0x32, 0x3c, 0xc5, 0xf1, 0x8a, 0xfa, 0xe3, 0xa9, 0x45, 0xfd, 0xb1,
-		0xde, 0x14, 0x52, 0xd9, 0x3f, 0x0d, 0xfb, 0x34, 0xcb, 0xb5, 0x06, 0x5a, 0xd1, 0x21, 0x15, 0x87,
-		0x92, 0x32, 0xea, 0x0e, 0xfa, 0x55, 0x1e, 0xf4, 0x0a, 0x1c, 0xf5, 0x56, 0x1f, 0xae, 0x7e, 0x6d,
-		0xa8, 0xda, 0xb6, 0xb9, 0xb9, 0x49, 0x8b, 0x02, 0x09, 0x4a, 0x3d, 0x45, 0x66, 0x07, 0x0b, 0x5f,
-		0x37, 0xbd, 0xa9, 0xe8, 0x02, 0x0c, 0x35, 0x70, 0xc3, 0xa4, 0xe9, 0xfc, 0x69, 0x76, 0xa2, 0x0f,
-		0x37, 0x4c, 0x85, 0x90, 0x21, 0x05, 0x26, 0x62, 0x1e, 0x9b, 0xe6, 0xe4, 0x9f, 0x66, 0x9f, 0x8d,
-		0x11, 0x0f, 0xab, 0x8c, 0xdb, 0x91, 0x11, 0x74, 0x1f, 0x26, 0x5b, 0x16, 0xde, 0xa9, 0xa9, 0x6d,
-		0xc7, 0x74, 0xf5, 0x0f, 0x3b, 0xb5, 0x96, 0x69, 0x34, 0x1d, 0x3f, 0xcb, 0xce, 0xdb, 0x2f, 0x1b,
-		0x3b, 0x77, 0x09, 0x9d, 0x72, 0xc0, 0x9d, 0xbf, 0xd4, 0x76, 0xcc, 0xc0, 0x20, 0xba, 0x0c, 0x85,
-		0x2d, 0xac, 0xea, 0xd8, 0xa2, 0xe9, 0xef, 0xa3, 0xec, 0xa6, 0x0e, 0x42, 0xa2, 0x50, 0x52, 0xf9,
-		0x1d, 0x09, 0x9e, 0x11, 0x8f, 0xf6, 0xaf, 0x40, 0x81, 0xda, 0x8b, 0x24, 0x60, 0x2f, 0x94, 0x16,
-		0xad, 0xc2, 0x4c, 0x72, 0xb9, 0xd7, 0xd0, 0x89, 0x77, 0xcf, 0x2b, 0xc7, 0xf8, 0x95, 0xda, 0xaa,
-		0x2e, 0xbf, 0x2d, 0xc1, 0x59, 0xc1, 0xa0, 0xe1, 0x2a, 0x14, 0x7d, 0x4f, 0x21, 0x09, 0x78, 0x0a,
-		0x9f, 0x78, 0x60, 0x50, 0x4d, 0x98, 0x15, 0x8e, 0x98, 0x97, 0x61, 0x94, 0x3a, 0xeb, 0xde, 0xc1,
-		0x39, 0xc6, 0x51, 0x02, 0xea, 0x9b, 0xc9, 0xb9, 0x59, 0x76, 0x7a, 0x0f, 0xf2, 0xef, 0x24, 0x38,
-		0x23, 0xd2, 0x34, 0x10, 0x3e, 0x01, 0xa5, 0x6c, 0x27, 0xe0, 0x6d, 0x98, 0xe4, 0x9c, 0x32, 0xb9,
-		0x34, 0x83, 0x3c, 0x60, 0x33, 0x4e, 0x98, 0x80, 0xa7, 0xc9, 0x87, 0x3c, 0x8d, 0xfc, 0xba, 0x04,
-		0x72, 0x7a, 0xbf, 0x01, 0x9a, 0x07, 0x14, 0xad, 0x41, 0x77, 0xbb, 0x90, 0xc6, 0xed, 0x90, 0x08,
-		0x22, 0xee, 0x36, 0x17, 0x71, 0xb7, 0xc7, 0x01, 0xfc, 0x84, 0xa0, 0xa1, 0x13, 0x34, 0x25, 0xa5,
-		0x44, 0x47, 0xaa, 0xba, 0xfc, 0xf7, 0x88, 0x78, 0xb9, 0x16, 0x92, 0x0d, 0xd1, 0x2c, 0x8c, 0x87,
-		0xf3, 0x10, 0x5d, 0xf5, 0x1a, 0xb3, 0x03, 0x2b, 0x8e, 0x60, 0xcf, 0x47, 0xb0, 0x9f, 0x83, 0xfd,
-		0x1b, 0x46, 0x53, 0xb5, 0x76, 0x6b, 0xda, 0x16, 0xd6, 0xb6, 0xed, 0x76, 0x83, 0x84, 0x28, 0x25,
-		0x65, 0xcc, 0x1b, 0x5e, 0xa6, 0xa3, 0xe8, 0x3c, 0x4c, 0x84, 0xb3, 0x67, 0xf8, 0x91, 0x17, 0x7e,
-		0x8c, 0x2a, 0xe3, 0x38, 0x98, 0xd4, 0xc2, 0x8f, 0x1c, 0xf9, 0xb5, 0x3c, 0x9c, 0x16, 0x68, 0x65,
-		0x78, 0x6c, 0x2b, 0x8e, 0x9a, 0x45, 0xbe, 0x0f, 0xb3, 0x40, 0x27, 0xa0, 0xbc, 0xa1, 0xda, 0xd8,
-		0x3f, 0x3a, 0x3d, 0xb1, 0x94, 0xdc, 0x21, 0xef, 0xc0, 0x3c, 0x06, 0xd0, 0xc4, 0x1d, 0xff, 0xf5,
-		0xb0, 0x27, 0xd8, 0x26, 0xee, 0x78, 0x6f, 0xe7, 0x01, 0x6d, 0x9a, 0xd6, 0x36, 0x45, 0xea, 0xf7,
-		0xa3, 0x15, 0xbc, 0xa5, 0xb9, 0x6f, 0x08, 0xd6, 0x07, 0xb4, 0x31, 0x6d, 0xd2, 0x75, 0x8e, 0xaa,
-		0x6d, 0x36, 0x69, 0x6c, 0x44, 0x9f, 0xd0, 0x2d, 0x18, 0xd6, 0xd4, 0xb6, 0x8d, 0x69, 0x18, 0x54,
-		0x11, 0x6e, 0x1a, 0x59, 0x76, 0x67, 0x29, 0xde, 0x64, 0xf9, 0xed, 0x3c, 0x9c, 0x4a, 0x6d, 0xe4,
-		0x78, 0x6c, 0x9b, 0x71, 0xd3, 0x5f, 0x83, 0xb7, 0x0b, 0xf3, 0x82, 0x7d, 0x26, 0xc1, 0x15, 0x04,
-		0x7d, 0xf2, 0x50, 0x16, 0x9f, 0x1c, 0x54, 0xfd, 0xe1, 0x88, 0xea, 0x47, 0xf6, 0xb7, 0x90, 0xbc,
-		0xbf, 0x45, 0xa1, 0xfd, 0x1d, 0xe1, 0xec, 0x2f, 0xc3, 0xcc, 0x4a, 0x2c, 0x33, 0x93, 0xbf, 0x5e,
-		0x80, 0x33, 0x22, 0x3d, 0x2e, 0xe8, 0x24, 0x94, 0xbb, 0x85, 0x62, 0xba, 0x4d, 0x25, 0x05, 0xfc,
-		0xa1, 0xaa, 0xee, 0x5e, 0xaa, 0x7a, 0x95, 0x64, 0xd7, 0x08, 0x72, 0x09, 0x97, 0xaa, 0xee, 0x27,
-		0xc9, 0xa5, 0x4a, 0x0d, 0x3c, 0xb9, 0xaa, 0xa9, 0x9b, 0x0d, 0xd5, 0x68, 0x52, 0xdf, 0x41, 0x9f,
-		0xc2, 0x87, 0xc1, 0x50, 0x9f, 0xd7, 0xa1, 0x82, 0xf8, 0x75, 0x68, 0x1d, 0xa6, 0x7d, 0x25, 0x8c,
-		0x9f, 0x21, 0xc5, 0xb4, 0x33, 0x64, 0xd2, 0x9f, 0x1b, 0x39, 0x46, 0x22, 0x5c, 0xe9, 0x11, 0x45,
-		0xb9, 0x8e, 0x64, 0xe0, 0xea, 0xdd, 0x82, 0x28, 0x57, 0xfe, 0x61, 0x57, 0xea, 0xeb, 0xb0, 0x5b,
-		0x85, 0x89, 0x2d, 0xac, 0x5a, 0xce, 0x06, 0x56, 0x7b, 0xe8, 0x20, 0x8d, 0xd5, 0x78, 0x77, 0x4e,
-		0x8f, 0x4f, 0x7a, 0x88, 0x52, 0x4e, 0x0f, 0x51, 0x62, 0x77, 0x85, 0xd1, 0x7e, 0xee, 0x0a, 0xbd,
-		0x98, 0x73, 0x9f, 0x78, 0xcc, 0xf9, 0x37, 0x09, 0xe4, 0xf4, 0x7e, 0xab, 0x0f, 0xed, 0x70, 0x0f,
-		0x86, 0x21, 0x43, 0xe1, 0x0b, 0xcf, 0x8b, 0x30, 0x4a, 0xee, 0x8b, 0xbe, 0xdf, 0x1a, 0x16, 0xf0,
-		0x5b, 0x65, 0x77, 0x06, 0x7d, 0x90, 0xff, 0x20, 0x85, 0x5d, 0xc1, 0x80, 0x23, 0x6b, 0xb6, 0x88,
-		0x72, 0x19, 0xdc, 0x7d, 0x3e, 0x35, 0xda, 0x18, 0x0a, 0x0b, 0x53, 0xfe, 0xbd, 0x04, 0xa7, 0xd2,
-		0x9b, 0x60, 0xfa, 0x0d, 0xc0, 0x3f, 0x8a, 0x15, 0xfd, 0x2c, 0x07, 0xa7, 0x05, 0x5a, 0xc9, 0xdc,
-		0x35, 0xe9, 0xd8, 0x51, 0x8d, 0xba, 0x2d, 0xb4, 0x49, 0x3e, 0xf1, 0x63, 0x5b, 0x53, 0x34, 0x42,
-		0x1a, 0xea, 0x27, 0x42, 0xda, 0xb3, 0x8a, 0x7f, 0x59, 0x82, 0x39, 0xf1, 0x0e, 0x30, 0x91, 0x33,
-		0x6f, 0x30, 0x57, 0xb0, 0x77, 0x25, 0xc8, 0xd8, 0xeb, 0x95, 0x8e, 0xed, 0xa0, 0x1f, 0x06, 0x79,
-		0x1e, 0x86, 0x06, 0x36, 0x22, 0x88, 0xf3, 0x02, 0x88, 0xdf, 0x8a, 0xe8, 0x21, 0xaf, 0x2a, 0xd4,
-		0xaf, 0x1e, 0xae, 0xc2, 0x4c, 0x5d, 0x75, 0x02, 0x3d, 0x0f, 0xd1, 0x0e, 0x80, 0x9e, 0x64, 0x3d,
-		0x3a, 0xd6, 0x56, 0x7a, 0x61, 0x13, 0x43, 0x9f, 0xf3, 0x19, 0xf4, 0x79, 0x28, 0xd5, 0x46, 0x23,
-		0x81, 0x9e, 0xfc, 0x9e, 0x04, 0x47, 0x13, 0xba, 0x2c, 0xd1, 0x34, 0x8c, 0x78, 0xdd, 0x65, 0xdd,
-		0x7d, 0x2b, 0x92, 0xe7, 0xaa, 0x8e, 0xd6, 0xe0, 0x50, 0xf7, 0x20, 0xdf, 0x34, 0xac, 0x0c, 0x97,
-		0x56, 0x44, 0xcf, 0xf1, 0x55, 0xc3, 0xc2, 0x59, 0x8e, 0x5f, 0x91, 0xcd, 0xfe, 0x34, 0x4c, 0x73,
-		0xdb, 0x37, 0x93, 0x56, 0x23, 0x1c, 0xb3, 0xcb, 0xbf, 0x96, 0xe0, 0x58, 0x52, 0xe7, 0xde, 0x40,
-		0xbe, 0x32, 0x28, 0x79, 0x24, 0x3a, 0xe8, 0x1f, 0x4b, 0x30, 0x93, 0xd6, 0x01, 0x98, 0xb4, 0x9a,
-		0xc7, 0x6a, 0xb6, 0x89, 0xc8, 0xff, 0x55, 0x84, 0x8c, 0x8d, 0x26, 0x68, 0x01, 0x0e, 0x92, 0x5e,
-		0x96, 0x68, 0xda, 0xd7, 0x5b, 0xd3, 0x44, 0x13, 0x77, 0x22, 0x49, 0xdf, 0x58, 0xe5, 0x25, 0xd7,
-		0x5f, 0xe5, 0xe5, 0x49, 0x6d, 0x44, 0xbc, 0x36, 0x22, 0xa2, 0x3b, 0x45, 0x01, 0xdd, 0xb9, 0x03,
-		0x93, 0x34, 0xa7, 0x4d, 0x31, 0x1a, 0x4d, 0x07, 0x5b, 0x3b, 0x6a, 0x3d, 0xfd, 0xde, 0x72, 0x90,
-		0x4e, 0x24, 0xf0, 0xaa, 0x74, 0x5a, 0xb8, 0xee, 0x52, 0xda, 0x53, 0xdd, 0x25, 0x10, 0xc2, 0x41,
-		0x96, 0x10, 0x8e, 0x5f, 0x64, 0x29, 0xf7, 0x5d, 0x64, 0xe9, 0xdd, 0x33, 0x46, 0x85, 0xef, 0x19,
-		0xdd, 0x54, 0xff, 0xbe, 0x3d, 0xa4, 0xfa, 0xc7, 0xf6, 0x94, 0xea, 0x77, 0x7d, 0xf0, 0x42, 0xd6,
-		0x6e, 0xb7, 0xae, 0xb7, 0x92, 0x82, 0xde, 0x2a, 0xe9, 0x7e, 0xb3, 0x01, 0x87, 0xbb, 0x15, 0xf2,
-		0x48, 0xd5, 0xd4, 0xb3, 0xe3, 0xb9, 0xc4, 0x1a, 0x78, 0xb8, 0x6e, 0x7a, 0x08, 0xb3, 0x86, 0xe5,
-		0xef, 0x4a, 0x8c, 0x94, 0x36, 0xef, 0x64, 0x11, 0x31, 0x0f, 0x49, 0xc0, 0x3c, 0x02, 0x91, 0x4e,
-		0x2e, 0x43, 0xa4, 0x23, 0x7f, 0x20, 0xc1, 0xf1, 0xc4, 0x6e, 0x6d, 0x37, 0xd4, 0xa3, 0xbd, 0xe0,
-		0x4d, 0xb5, 0xe1, 0x8b, 0x1a, 0xbc, 0xa1, 0xdb, 0x6a, 0x03, 0xf7, 0xfb, 0xe9, 0x81, 0x9d, 0x2a,
-		0x3d, 0x8d, 0x1f, 0x12, 0xbf, 0x59, 0x7f, 0x95, 0xb5, 0x49, 0xbc, 0xee, 0x84, 0x93, 0x50, 0xa6,
-		0xfd, 0x21, 0x41, 0x11, 0x78, 0x43, 0x44, 0x04, 0x5d, 0xa7, 0x9e, 0x13, 0x77, 0xea, 0x09, 0x79,
-		0x6a, 0xf9, 0x2b, 0x12, 0xcc, 0x65, 0xe8, 0xc8, 0xe9, 0xe5, 0x53, 0xa5, 0x50, 0x3e, 0xb5, 0xdf,
-		0x9d, 0x49, 0x82, 0xf6, 0x8b, 0x1c, 0xbc, 0xb0, 0xb7, 0xae, 0xe4, 0x81, 0xe9, 0x7c, 0x2f, 0x57,
-		0x97, 0x0b, 0xe5, 0xea, 0xee, 0x03, 0x8a, 0x77, 0xbf, 0x50, 0xfb, 0x3e, 0x2b, 0xd6, 0xe1, 0xaa,
-		0x4c, 0xc4, 0x5a, 0x58, 0xd1, 0x14, 0x14, 0x35, 0xb3, 0xe9, 0x58, 0x66, 0x9d, 0x28, 0xda, 0xa8,
-		0xe2, 0x3f, 0xa2, 0x0a, 0x1c, 0x88, 0x34, 0x72, 0x99, 0xcd, 0xba, 0x17, 0x99, 0x8f, 0x28, 0x13,
-		0xa1, 0xfe, 0xaa, 0x3b, 0xcd, 0xfa, 0xae, 0xfc, 0x66, 0x1e, 0x6e, 0xec, 0xa1, 0xeb, 0x19, 0xdd,
-		0x0f, 0xfa, 0xbd, 0x31, 0xce, 0x6f, 0x0a, 0x84, 0x38, 0x87, 0xd2, 0xce, 0x03, 0xba, 0x4f, 0x72,
-		0x73, 0xa8, 0xec, 0x7d, 0x19, 0xda, 0xeb, 0xbe, 0xcc, 0x03, 0x8a, 0xf6, 0x9a, 0xd1, 0x0a, 0x45,
-		0x5e, 0x19, 0x37, 0x42, 0x4a, 0xe8, 0xa5, 0xb0, 0xfc, 0x5d, 0x2c, 0x84, 0x76, 0x51, 0xfe, 0xa3,
-		0x04, 0xd7, 0xfa, 0x6c, 0xd9, 0xe6, 0x60, 0x90, 0x38, 0x18, 0x3e, 0x5c, 0xc5, 0x95, 0xbf, 0x98,
-		0x87, 0x6b, 0x7d, 0xb6, 0xd5, 0xfd, 0xb7, 0xda, 0x6a, 0xc4, 0x63, 0x0f, 0xf1, 0x3d, 0xf6, 0xb0,
-		0xb8, 0xc7, 0xe6, 0xaa, 0x0e, 0xcf, 0x01, 0x14, 0x79, 0x0e, 0xe0, 0xb5, 0x3c, 0x5c, 0xe9, 0xa7,
-		0x35, 0x50, 0xcc, 0xf2, 0x85, 0x38, 0x3f, 0xb1, 0xfc, 0x9e, 0xe5, 0xbf, 0x2f, 0xc1, 0xc5, 0xac,
-		0x6d, 0x8e, 0xff, 0xd1, 0x26, 0xcf, 0x3f, 0xab, 0xe4, 0xdf, 0x4a, 0x70, 0x21, 0x53, 0x6b, 0xe4,
-		0xc0, 0x5c, 0x00, 0xf3, 0xd6, 0x90, 0xdb, 0xdb, 0xad, 0xe1, 0xcf, 0x23, 0x70, 0xb9, 0x8f, 0xdf,
-		0x78, 0x04, 0xb6, 0x43, 0x0a, 0x6d, 0xc7, 0x49, 0x28, 0x77, 0xb7, 0x83, 0xea, 0x7c, 0x49, 0x01,
-		0x7f, 0x88, 0x95, 0x42, 0xc8, 0x0f, 0x20, 0x85, 0xd0, 0x6f, 0x3d, 0x71, 0x78, 0xb0, 0x29, 0x84,
-		0xc2, 0x63, 0x4d, 0x21, 0x14, 0xfb, 0x4e, 0x21, 0x3c, 0x00, 0xda, 0xa1, 0x4a, 0x39, 0xd2, 0x32,
-		0x9c, 0xd7, 0x24, 0x70, 0x36, 0xa1, 0xcd, 0x95, 0x70, 0xa1, 0xc5, 0xb8, 0x89, 0x56, 0x74, 0x28,
-		0x68, 0x24, 0xa5, 0xb0, 0x3f, 0x17, 0x51, 0x79, 0x10, 0x50, 0x79, 0x0d, 0xa6, 0x02, 0xea, 0x54,
-		0xb3, 0x70, 0xbb, 0x07, 0xbf, 0x4c, 0xe0, 0xcf, 0x25, 0x2a, 0x4e, 0x55, 0x57, 0xdc, 0x29, 0x74,
-		0x09, 0x87, 0x3a, 0xac, 0xe1, 0x58, 0x79, 0x72, 0x5f, 0x3f, 0xe5, 0xc9, 0x58, 0xaf, 0xe1, 0x18,
-		0xa3, 0xd7, 0xb0, 0x77, 0xd3, 0xda, 0x9f, 0x3d, 0xb7, 0x30, 0xbe, 0x87, 0xdc, 0xc2, 0xc4, 0xde,
-		0xda, 0x08, 0x9f, 0x83, 0xb2, 0x8e, 0xeb, 0xea, 0xae, 0xa7, 0x9a, 0xe9, 0x3d, 0x91, 0x40, 0xa8,
-		0x89, 0x2a, 0xca, 0x6f, 0xe4, 0xe1, 0x62, 0xd6, 0xdf, 0x60, 0x7d, 0xf4, 0xee, 0x65, 0xcd, 0x8f,
-		0x13, 0xbc, 0x4a, 0xd7, 0xd5, 0xcc, 0x3f, 0x20, 0x0a, 0x85, 0x07, 0x01, 0x43, 0x19, 0x0e, 0x1b,
-		0x0a, 0xfb, 0x10, 0x2c, 0x70, 0x0e, 0xc1, 0x01, 0xe5, 0x02, 0xe5, 0xdf, 0xe4, 0x60, 0x3e, 0xcb,
-		0x0f, 0xcc, 0xb8, 0xfb, 0xc1, 0x3e, 0x7d, 0x73, 0x7b, 0x3d, 0x7d, 0x07, 0xb5, 0x8b, 0x6c, 0xe9,
-		0x0e, 0x71, 0xa4, 0xdb, 0xb3, 0xce, 0x61, 0xf1, 0x3c, 0xc8, 0x07, 0x39, 0xc8, 0xf8, 0xd3, 0xb7,
-		0x8f, 0x87, 0x30, 0x59, 0x65, 0x9d, 0x61, 0x66, 0x59, 0xa7, 0xd7, 0x8f, 0x50, 0x10, 0xef, 0x47,
-		0x90, 0xff, 0x91, 0x83, 0xf3, 0x83, 0xf0, 0x28, 0x1f, 0x53, 0xa1, 0x07, 0x32, 0xee, 0x85, 0x0c,
-		0x19, 0x77, 0xf9, 0x9f, 0x39, 0xb8, 0x90, 0xe9, 0x97, 0x88, 0x4f, 0x04, 0x1f, 0x13, 0xbc, 0x9f,
-		0x52, 0x2c, 0x64, 0xc9, 0x33, 0x7f, 0x2e, 0xcf, 0x13, 0x3c, 0xaf, 0x87, 0xe4, 0x89, 0xe0, 0x13,
-		0x5b, 0x58, 0x0a, 0xfd, 0xf4, 0xbe, 0xff, 0x3c, 0x07, 0x0b, 0x19, 0x7f, 0x21, 0xfa, 0x64, 0x1f,
-		0x42, 0xfb, 0x30, 0xe7, 0xc0, 0x7e, 0xf2, 0xe7, 0xaa, 0x51, 0x77, 0xb0, 0x45, 0x3e, 0x75, 0x1c,
-		0xa6, 0x57, 0x1e, 0xac, 0xdc, 0x5e, 0xaf, 0xad, 0x56, 0xd7, 0xd6, 0x57, 0x94, 0xda, 0xfa, 0x27,
-		0xee, 0xae, 0xd4, 0xaa, 0xb7, 0x1f, 0x2c, 0xad, 0x55, 0x6f, 0x8d, 0x3f, 0x85, 0x4e, 0xc2, 0xd1,
-		0xf8, 0xeb, 0xa5, 0xb5, 0xb5, 0x1a, 0x19, 0x1d, 0x97, 0xd0, 0x29, 0x38, 0x1e, 0x27, 0x58, 0x5e,
-		0xbb, 0x73, 0x6f, 0x85, 0x92, 0xe4, 0x6e, 0xbe, 0x02, 0x87, 0x35, 0xb3, 0xc1, 0x92, 0xc1, 0xcd,
-		0x91, 0xa5, 0x96, 0x71, 0xd7, 0x0d, 0x61, 0xef, 0x4a, 0x9f, 0xbc, 0xf4, 0xd0, 0x70, 0xb6, 0xda,
-		0x1b, 0x15, 0xcd, 0x6c, 0x2c, 0x04, 0xff, 0xcf, 0xe9, 0x05, 0x43, 0xaf, 0x2f, 0x3c, 0x34, 0xbd,
-		0xff, 0xad, 0x4a, 0xff, 0xe9, 0xe9, 0x0d, 0xb5, 0x65, 0xec, 0x5c, 0xda, 0x28, 0x90, 0xb1, 0xcb,
-		0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x63, 0xa5, 0x41, 0x73, 0xd7, 0x55, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x6c, 0x1c, 0x47,
+		0x19, 0xef, 0xde, 0xd9, 0x67, 0xdf, 0x77, 0x8e, 0x63, 0x4f, 0x12, 0xc7, 0x4e, 0x9c, 0xc4, 0xd9,
+		0xa4, 0x89, 0xeb, 0xd8, 0xe7, 0xc4, 0x49, 0x13, 0xd2, 0xd0, 0x16, 0xc7, 0xb1, 0x95, 0x93, 0x4c,
+		0x62, 0x6d, 0x9c, 0xb4, 0x20, 0xa4, 0x63, 0xbd, 0x3b, 0x8e, 0x57, 0xbe, 0xbb, 0xbd, 0xee, 0xce,
+		0xf9, 0x62, 0x24, 0x9e, 0x78, 0x40, 0x42, 0xad, 0xa0, 0xaa, 0x90, 0xa8, 0x00, 0x81, 0x90, 0x40,
+		0x2d, 0x42, 0x2a, 0x02, 0x21, 0x40, 0xbc, 0x00, 0x12, 0x02, 0x09, 0x54, 0x78, 0xe2, 0x85, 0x07,
+		0x78, 0xe0, 0x81, 0xbe, 0xf1, 0x40, 0x79, 0x43, 0x42, 0x3b, 0x3b, 0x7b, 0x7f, 0x76, 0x67, 0x76,
+		0x67, 0xcf, 0x4e, 0x0b, 0x6a, 0xde, 0xbc, 0xb3, 0xdf, 0x7c, 0xfb, 0xfb, 0x66, 0xbe, 0xef, 0x9b,
+		0x6f, 0xbe, 0xef, 0x3b, 0xc3, 0xe9, 0xc6, 0x06, 0x76, 0xe6, 0x0d, 0xdd, 0xc4, 0x35, 0x03, 0xcf,
+		0xeb, 0x75, 0x6b, 0x7e, 0xe7, 0xd2, 0xfc, 0x96, 0xe5, 0x12, 0xdb, 0xd9, 0x2d, 0xd6, 0x1d, 0x9b,
+		0xd8, 0xe8, 0x90, 0x47, 0x52, 0x64, 0x24, 0x45, 0xbd, 0x6e, 0x15, 0x77, 0x2e, 0x1d, 0x3b, 0xf9,
+		0xd0, 0xb6, 0x1f, 0x56, 0xf0, 0x3c, 0x25, 0xd9, 0x68, 0x6c, 0xce, 0x9b, 0x0d, 0x47, 0x27, 0x96,
+		0x5d, 0xf3, 0x27, 0x1d, 0x3b, 0x15, 0x7e, 0x4f, 0xac, 0x2a, 0x76, 0x89, 0x5e, 0xad, 0x33, 0x82,
+		0x29, 0xde, 0x87, 0x0d, 0xbb, 0x5a, 0x6d, 0xb1, 0x50, 0x79, 0x14, 0x44, 0x77, 0xb7, 0x2b, 0x96,
+		0x4b, 0xe2, 0x68, 0x9a, 0xb6, 0xb3, 0xbd, 0x59, 0xb1, 0x9b, 0x3e, 0x8d, 0x7a, 0x0b, 0x06, 0x6e,
+		0xfb, 0x02, 0xa1, 0xeb, 0x90, 0xc3, 0x3b, 0xb8, 0x46, 0xdc, 0x71, 0x65, 0x2a, 0x3b, 0x5d, 0x58,
+		0x38, 0x5d, 0xe4, 0xc8, 0x56, 0x64, 0xd4, 0xcb, 0x1e, 0xa5, 0xc6, 0x26, 0xa8, 0xef, 0x5d, 0x83,
+		0xa1, 0xce, 0x17, 0x68, 0x02, 0x06, 0xe9, 0xab, 0xb2, 0x65, 0x8e, 0x2b, 0x53, 0xca, 0x74, 0x56,
+		0x1b, 0xa0, 0xcf, 0x25, 0x13, 0x5d, 0x07, 0xf0, 0x5f, 0x79, 0x42, 0x8f, 0x67, 0xa6, 0x94, 0xe9,
+		0xc2, 0xc2, 0xb1, 0xa2, 0xbf, 0x22, 0xc5, 0x60, 0x45, 0x8a, 0xeb, 0xc1, 0x8a, 0x68, 0x79, 0x4a,
+		0xed, 0x3d, 0xa3, 0x71, 0x18, 0xd8, 0xc1, 0x8e, 0x6b, 0xd9, 0xb5, 0xf1, 0xac, 0xcf, 0x94, 0x3d,
+		0xa2, 0xa3, 0x30, 0xe0, 0x09, 0xef, 0x7d, 0xae, 0x8f, 0xbe, 0xc9, 0x79, 0x8f, 0x25, 0x13, 0x7d,
+		0x43, 0x81, 0x0b, 0x81, 0xc8, 0x65, 0xfc, 0x08, 0x1b, 0x0d, 0x6f, 0x1f, 0xca, 0x2e, 0xd1, 0x1d,
+		0x82, 0xcd, 0xb2, 0x8f, 0x44, 0x27, 0xc4, 0xb1, 0x36, 0x1a, 0x04, 0xbb, 0xe3, 0xfd, 0x14, 0xcf,
+		0xc7, 0xb9, 0xa2, 0xbf, 0xc4, 0xf8, 0x2c, 0x07, 0x6c, 0xee, 0xf9, 0x5c, 0xa8, 0xc8, 0x8b, 0x2d,
+		0x1e, 0xb7, 0x9f, 0xd2, 0xce, 0x37, 0xe5, 0x48, 0xd1, 0x77, 0x14, 0x98, 0xe3, 0xc0, 0x33, 0xec,
+		0x6a, 0xbd, 0x82, 0xb9, 0x00, 0x73, 0x14, 0xe0, 0x0b, 0x72, 0x00, 0x97, 0x02, 0x3e, 0x51, 0x88,
+		0xcf, 0x34, 0x65, 0x89, 0xd1, 0x9b, 0x0a, 0xcc, 0x70, 0x40, 0x6e, 0xea, 0x56, 0x85, 0x87, 0x70,
+		0x80, 0x22, 0xbc, 0x21, 0x87, 0x70, 0x85, 0x32, 0x89, 0xc2, 0x3b, 0xd7, 0x94, 0xa2, 0x44, 0xdf,
+		0xe6, 0x2f, 0xa0, 0xa7, 0x5b, 0x66, 0xd9, 0x6e, 0x90, 0x28, 0xbc, 0x41, 0x0a, 0xef, 0x79, 0x39,
+		0x78, 0x9e, 0xda, 0x99, 0x77, 0x1b, 0x24, 0x0a, 0x70, 0xba, 0x29, 0x49, 0x8b, 0xde, 0x50, 0x60,
+		0xda, 0xc4, 0x86, 0xe5, 0x52, 0x60, 0x9e, 0x96, 0xba, 0xc6, 0x16, 0x36, 0x1b, 0xdc, 0xc5, 0xcb,
+		0x53, 0x74, 0xd7, 0xb9, 0xe8, 0x6e, 0x31, 0x26, 0xeb, 0xba, 0xbb, 0x7d, 0x2f, 0x60, 0x11, 0x45,
+		0x76, 0xd6, 0x94, 0xa0, 0x43, 0xaf, 0x29, 0x70, 0x2e, 0x84, 0x4a, 0x64, 0x13, 0x40, 0x31, 0x5d,
+		0x4b, 0xc6, 0x24, 0x32, 0x07, 0xd5, 0x4c, 0xa4, 0xe2, 0xac, 0x52, 0x8c, 0x11, 0x14, 0x24, 0x57,
+		0x29, 0x46, 0xff, 0xcf, 0x9a, 0x12, 0x74, 0xe8, 0xf5, 0x08, 0xaa, 0x18, 0xcd, 0x1a, 0xa2, 0xa8,
+		0x3e, 0x96, 0x88, 0x4a, 0xac, 0x54, 0x67, 0xcc, 0x64, 0x32, 0xf4, 0x25, 0x05, 0x9e, 0xee, 0xc6,
+		0x24, 0xb2, 0xc4, 0x03, 0x14, 0xd0, 0xd5, 0x44, 0x40, 0x22, 0x23, 0x3c, 0x6d, 0x26, 0x11, 0xd1,
+		0x6d, 0xd3, 0x0d, 0x62, 0xed, 0x58, 0x64, 0x37, 0x51, 0xb9, 0x87, 0x63, 0xb6, 0x6d, 0x91, 0x31,
+		0x49, 0x52, 0x6e, 0x5d, 0x82, 0x8e, 0x2a, 0x77, 0x08, 0x95, 0x48, 0xb9, 0x0f, 0xc6, 0x28, 0x77,
+		0x17, 0x26, 0xa1, 0x72, 0xeb, 0x89, 0x54, 0x9c, 0x55, 0x8a, 0x51, 0xee, 0x11, 0xc9, 0x55, 0x8a,
+		0x53, 0x6e, 0x5d, 0x82, 0x8e, 0x2a, 0x52, 0x37, 0x2a, 0x91, 0x22, 0x8d, 0xc6, 0x28, 0x52, 0x27,
+		0x24, 0xa1, 0x22, 0xe9, 0x49, 0x44, 0xd4, 0xd2, 0xba, 0xc1, 0xc4, 0x58, 0x1a, 0x8a, 0xb1, 0xb4,
+		0x4e, 0x3c, 0x31, 0x96, 0xa6, 0x27, 0x93, 0xa1, 0x26, 0x9c, 0xf4, 0x40, 0x38, 0x62, 0xed, 0x39,
+		0x44, 0x81, 0x5c, 0xe4, 0x02, 0xf1, 0xb8, 0x3a, 0x42, 0xb5, 0x39, 0x4e, 0xc4, 0xaf, 0xd1, 0x2b,
+		0x30, 0xe9, 0x7f, 0x78, 0xd3, 0x72, 0x78, 0x9f, 0x3d, 0x4c, 0x3f, 0x5b, 0x14, 0x7f, 0x76, 0xc5,
+		0x72, 0x22, 0x5c, 0x6f, 0x3f, 0xa5, 0x4d, 0x10, 0xd1, 0x4b, 0xf4, 0x3d, 0x05, 0xe6, 0x43, 0x2a,
+		0xaa, 0xd7, 0x0c, 0x5c, 0x29, 0x3b, 0xf8, 0x95, 0x06, 0x76, 0xb9, 0xd2, 0x1f, 0xa1, 0x30, 0x5e,
+		0x4c, 0xd6, 0x54, 0xca, 0x49, 0x0b, 0x18, 0x45, 0x71, 0xcd, 0xe8, 0xd2, 0xd4, 0xe8, 0xc7, 0x0a,
+		0x5c, 0x61, 0x98, 0x02, 0x88, 0x72, 0x4a, 0x3c, 0x46, 0xd1, 0x2e, 0x71, 0xd1, 0xb2, 0xaf, 0xf9,
+		0x9f, 0x96, 0xd1, 0xe8, 0xa2, 0x93, 0x6a, 0x06, 0xfa, 0x8a, 0x02, 0xe7, 0x79, 0xcb, 0xcb, 0x03,
+		0x7a, 0x54, 0x52, 0xbb, 0x97, 0x18, 0x87, 0x04, 0xed, 0x16, 0x90, 0xa1, 0xcf, 0xc1, 0x29, 0x5f,
+		0xc9, 0xc4, 0x48, 0xc6, 0x29, 0x92, 0x4b, 0x62, 0x3d, 0x13, 0x43, 0x98, 0x24, 0x31, 0xef, 0xd1,
+		0x17, 0x15, 0x38, 0xcb, 0x36, 0x8f, 0x29, 0xba, 0x60, 0xd3, 0x26, 0x28, 0x82, 0x67, 0xb9, 0x08,
+		0x7c, 0xe6, 0xbe, 0xbe, 0x0b, 0xb6, 0x69, 0xca, 0x48, 0xa0, 0x41, 0x9f, 0x87, 0xa9, 0xaa, 0xee,
+		0x6c, 0x63, 0xa7, 0xec, 0x60, 0xc3, 0x76, 0x4c, 0x1e, 0x88, 0x63, 0x14, 0xc4, 0x02, 0x17, 0xc4,
+		0x27, 0xe9, 0x64, 0x8d, 0xcd, 0x8d, 0x22, 0x38, 0x51, 0x8d, 0x23, 0x40, 0xdf, 0x52, 0x60, 0x96,
+		0x77, 0x3f, 0xb1, 0x1e, 0xd6, 0x74, 0xee, 0x82, 0x1c, 0x4f, 0x13, 0xbe, 0xde, 0x63, 0x6c, 0x64,
+		0xc2, 0x57, 0x01, 0x2d, 0xfa, 0xae, 0x02, 0x45, 0x0e, 0x42, 0x82, 0x9d, 0xaa, 0x55, 0xd3, 0xb9,
+		0x7e, 0x61, 0x32, 0xc6, 0x2f, 0x44, 0x43, 0xec, 0x16, 0x23, 0x8e, 0x5f, 0x68, 0x4a, 0x53, 0xa3,
+		0x9f, 0x28, 0x70, 0x85, 0x77, 0x95, 0x4a, 0xf4, 0x62, 0x27, 0x28, 0xda, 0x5b, 0x92, 0x37, 0xaa,
+		0x24, 0x57, 0x36, 0xdf, 0x4c, 0x37, 0x45, 0xa4, 0x01, 0x62, 0xa3, 0x3c, 0x99, 0x46, 0x03, 0xc4,
+		0x06, 0x3a, 0xdd, 0x94, 0xa4, 0x45, 0x7f, 0x57, 0x60, 0x39, 0xe4, 0x71, 0xf1, 0x23, 0x82, 0x9d,
+		0x9a, 0x5e, 0x29, 0x73, 0x90, 0x5b, 0x35, 0x8b, 0x58, 0x7c, 0xc5, 0x38, 0x45, 0xa1, 0xdf, 0x4b,
+		0x76, 0xc1, 0xcb, 0x8c, 0x7f, 0x44, 0x9e, 0x52, 0xc0, 0x3c, 0x2a, 0xd0, 0x0b, 0xce, 0x9e, 0x38,
+		0xa0, 0xbf, 0x28, 0x70, 0x33, 0x85, 0x98, 0x22, 0x8f, 0x35, 0x45, 0x65, 0x5c, 0xdb, 0x83, 0x8c,
+		0x22, 0x67, 0x76, 0xc3, 0xe9, 0x7d, 0x3a, 0x7a, 0x57, 0x81, 0xe7, 0xe3, 0xc4, 0x49, 0xb6, 0x93,
+		0xd3, 0x54, 0xb0, 0x55, 0xae, 0x60, 0x42, 0x30, 0x89, 0xf6, 0x72, 0x0d, 0xf7, 0x36, 0x95, 0xc6,
+		0x01, 0x3c, 0x39, 0xec, 0x1a, 0xb1, 0x6a, 0x0d, 0x6c, 0x96, 0x75, 0xb7, 0x5c, 0xc3, 0xcd, 0xa8,
+		0x1c, 0x6a, 0x4c, 0x1c, 0x10, 0x05, 0x11, 0xb0, 0x5b, 0x74, 0xef, 0xe0, 0x66, 0x14, 0x7e, 0xb1,
+		0x99, 0x6a, 0x06, 0xfa, 0xb5, 0x02, 0xd7, 0x69, 0x34, 0x59, 0x36, 0xb6, 0xac, 0x8a, 0x99, 0xd2,
+		0x7e, 0xce, 0x50, 0xe8, 0xb7, 0xb9, 0xd0, 0x69, 0x28, 0xb9, 0xe4, 0x31, 0x4d, 0x63, 0x34, 0x97,
+		0xdd, 0xf4, 0xd3, 0xd0, 0xcf, 0x15, 0xb8, 0x9a, 0x20, 0x84, 0xc8, 0x3a, 0xce, 0x52, 0x09, 0x96,
+		0xd3, 0x4a, 0x20, 0x32, 0x89, 0x8b, 0x6e, 0xca, 0x39, 0xe8, 0x07, 0x0a, 0x5c, 0x12, 0xa2, 0x16,
+		0xc6, 0xf9, 0x4f, 0x53, 0xd8, 0x8b, 0xfc, 0x30, 0x84, 0xfb, 0x75, 0x61, 0xe0, 0x3f, 0x6b, 0xa4,
+		0xa0, 0x47, 0x3f, 0x52, 0xe0, 0xb2, 0x10, 0x6e, 0xcc, 0x25, 0xf2, 0x5c, 0x8c, 0x92, 0xf3, 0x01,
+		0xc7, 0x5c, 0x27, 0x8b, 0x46, 0xaa, 0x19, 0xe8, 0x6d, 0x05, 0x2e, 0xa6, 0xd6, 0x8c, 0xf3, 0x14,
+		0xf1, 0x27, 0x52, 0x20, 0x16, 0x29, 0xc5, 0x05, 0x23, 0x85, 0x3e, 0xbc, 0xa3, 0xc0, 0x82, 0x78,
+		0x81, 0x85, 0x87, 0xf0, 0x34, 0x45, 0x7b, 0x33, 0xcd, 0xfa, 0x0a, 0x4f, 0xe2, 0x39, 0x23, 0xcd,
+		0x04, 0xf4, 0xc3, 0x38, 0x95, 0x88, 0xb9, 0x34, 0x3f, 0x93, 0x1a, 0xb2, 0xf8, 0xfa, 0x3c, 0x67,
+		0xa4, 0x99, 0x40, 0x63, 0x33, 0x31, 0xe4, 0x98, 0x48, 0x72, 0x26, 0x26, 0x36, 0x13, 0x60, 0x8e,
+		0x09, 0x27, 0xe7, 0x8d, 0x74, 0x53, 0xe8, 0xa1, 0xe9, 0x87, 0xe2, 0xbd, 0x46, 0x3c, 0x17, 0x62,
+		0x0e, 0x4d, 0x3f, 0xe2, 0xee, 0x25, 0xd4, 0xb9, 0xe6, 0xf6, 0x36, 0x15, 0xfd, 0x46, 0x81, 0xe7,
+		0x24, 0x04, 0x12, 0xd9, 0xe8, 0x2c, 0x95, 0xa6, 0xd4, 0x8b, 0x34, 0x22, 0x63, 0xbd, 0xe2, 0xf6,
+		0x30, 0x0f, 0xfd, 0x4c, 0x81, 0x67, 0xe3, 0x04, 0x10, 0xdf, 0x9f, 0xe6, 0x62, 0x0e, 0x20, 0x21,
+		0x08, 0xf1, 0x3d, 0xea, 0x22, 0x4e, 0x39, 0x87, 0x3a, 0x9c, 0x46, 0xdd, 0xc5, 0x0e, 0x69, 0x03,
+		0x77, 0xb1, 0xee, 0x18, 0x5b, 0x1d, 0x30, 0xa3, 0xb8, 0x8b, 0x31, 0xd6, 0x7b, 0x9f, 0xb2, 0x0b,
+		0x10, 0xdc, 0xa3, 0xcc, 0xda, 0x5f, 0xe4, 0x58, 0x6f, 0x23, 0xcd, 0x84, 0x9b, 0x43, 0x00, 0x6d,
+		0x20, 0xea, 0xdf, 0x0a, 0x70, 0x5e, 0xf6, 0xf4, 0x5a, 0x81, 0x03, 0x2d, 0x19, 0xc9, 0x6e, 0x1d,
+		0xd3, 0x5a, 0xa0, 0xa8, 0xb2, 0x18, 0x30, 0x5d, 0xdf, 0xad, 0x63, 0x6d, 0xa8, 0xd9, 0xf1, 0x84,
+		0x3e, 0x03, 0x47, 0xea, 0xba, 0xe3, 0xad, 0x48, 0xa7, 0xd1, 0x6d, 0xda, 0xac, 0x7c, 0x38, 0xcd,
+		0xe5, 0xb7, 0x46, 0x67, 0x74, 0xd8, 0xc4, 0xa6, 0xad, 0x1d, 0xaa, 0x47, 0x07, 0xd1, 0x73, 0x90,
+		0xa7, 0x19, 0x99, 0x8a, 0xe5, 0x12, 0x5a, 0x58, 0x2c, 0x2c, 0x9c, 0xe0, 0xa7, 0x3c, 0x74, 0x77,
+		0x7b, 0xd5, 0x72, 0x89, 0x36, 0x48, 0xd8, 0x5f, 0x68, 0x01, 0xfa, 0xad, 0x5a, 0xbd, 0x41, 0x68,
+		0xd9, 0xb1, 0xb0, 0x30, 0x29, 0x40, 0xb2, 0x5b, 0xb1, 0x75, 0x53, 0xf3, 0x49, 0x91, 0x0e, 0x53,
+		0xa1, 0x90, 0xa3, 0x4c, 0xec, 0xb2, 0x51, 0xb1, 0x5d, 0x4c, 0xfd, 0xb7, 0xdd, 0x20, 0xac, 0x0e,
+		0x39, 0x11, 0xa9, 0x8b, 0xde, 0x62, 0x95, 0x64, 0x6d, 0x12, 0x77, 0xad, 0xfd, 0xba, 0xbd, 0xe4,
+		0xcd, 0x5f, 0xf7, 0xa7, 0xa3, 0x97, 0xe0, 0x78, 0x3b, 0xed, 0x1d, 0xe5, 0x9e, 0x4b, 0xe2, 0x7e,
+		0x94, 0x04, 0xc9, 0xec, 0x10, 0xe3, 0x1b, 0x70, 0xac, 0x1d, 0x61, 0xb7, 0xa5, 0x70, 0x1a, 0x35,
+		0xaf, 0xf6, 0xea, 0x95, 0xfe, 0xf2, 0xda, 0xd1, 0x16, 0x45, 0x6b, 0x9d, 0xb5, 0x46, 0xad, 0x64,
+		0xa2, 0x12, 0xe4, 0x99, 0xab, 0xb4, 0x1d, 0x5a, 0x87, 0x1b, 0x5e, 0xb8, 0xc0, 0x77, 0xed, 0x8c,
+		0x01, 0x0d, 0xa1, 0x4b, 0xc1, 0x14, 0xad, 0x3d, 0x1b, 0x95, 0x60, 0xb4, 0x8d, 0xc3, 0x73, 0x57,
+		0x0d, 0x07, 0x8f, 0xe7, 0x63, 0xf6, 0x60, 0xc5, 0xa7, 0xd1, 0x46, 0x5a, 0xd3, 0xd8, 0x08, 0xd2,
+		0x60, 0xac, 0xa2, 0x7b, 0x77, 0x3e, 0x3f, 0x9c, 0xa1, 0xe2, 0x60, 0xb7, 0x51, 0x21, 0xe3, 0x10,
+		0xc3, 0x2f, 0xd8, 0xd3, 0xc3, 0xde, 0xdc, 0xa5, 0xd6, 0x54, 0x8d, 0xce, 0x44, 0xd7, 0x61, 0xc2,
+		0x76, 0xac, 0x87, 0x96, 0xef, 0x68, 0x43, 0xab, 0x54, 0xa0, 0xab, 0x34, 0x16, 0x10, 0x84, 0x16,
+		0xe9, 0x18, 0x0c, 0x5a, 0x26, 0xae, 0x11, 0x8b, 0xec, 0xd2, 0x8a, 0x52, 0x5e, 0x6b, 0x3d, 0xa3,
+		0xcb, 0x30, 0xb6, 0x69, 0x39, 0x2e, 0x89, 0xf2, 0x3c, 0x40, 0x29, 0x0f, 0xd1, 0xb7, 0x21, 0x86,
+		0x4b, 0x30, 0xe4, 0x60, 0xe2, 0xec, 0x96, 0xeb, 0x76, 0xc5, 0x32, 0x76, 0x59, 0x15, 0x66, 0x4a,
+		0x70, 0x41, 0x25, 0xce, 0xee, 0x1a, 0xa5, 0xd3, 0x0a, 0x4e, 0xfb, 0xc1, 0x2b, 0xbd, 0xeb, 0x84,
+		0xe0, 0x6a, 0x9d, 0xd0, 0x8a, 0x49, 0xbf, 0x16, 0x3c, 0xa2, 0x25, 0x38, 0x88, 0x1f, 0xd5, 0x2d,
+		0x5f, 0x71, 0xfc, 0xa2, 0xfe, 0x48, 0x62, 0x51, 0x7f, 0xb8, 0x3d, 0xc5, 0x1b, 0x44, 0x67, 0xe0,
+		0x80, 0xe1, 0x78, 0xd6, 0xc0, 0x2a, 0x3a, 0xb4, 0xe2, 0x90, 0xd7, 0x86, 0xbc, 0xc1, 0xa0, 0xca,
+		0x83, 0x5e, 0x86, 0xe3, 0xbe, 0xf4, 0xdd, 0xd5, 0xaf, 0x0d, 0xdd, 0xd8, 0xb6, 0x37, 0x37, 0xc7,
+		0x51, 0x92, 0x52, 0x8f, 0xd3, 0xd9, 0x9d, 0x85, 0xaf, 0x9b, 0xfe, 0x54, 0x34, 0x07, 0x7d, 0x55,
+		0x5c, 0xb5, 0x59, 0x3a, 0x7f, 0x82, 0x9f, 0xe8, 0xc3, 0x55, 0x5b, 0xa3, 0x64, 0x48, 0x83, 0xd1,
+		0x88, 0xc7, 0x66, 0x39, 0xf9, 0xa7, 0xf9, 0x67, 0x63, 0xc8, 0xc3, 0x6a, 0x23, 0x6e, 0x68, 0x04,
+		0xdd, 0x87, 0xb1, 0xba, 0x83, 0x77, 0xca, 0x7a, 0x83, 0xd8, 0x9e, 0xfe, 0x61, 0x52, 0xae, 0xdb,
+		0x56, 0x8d, 0x04, 0x59, 0x76, 0xd1, 0x7e, 0xb9, 0x98, 0xac, 0x51, 0x3a, 0xed, 0x90, 0x37, 0x7f,
+		0xb1, 0x41, 0xec, 0x8e, 0x41, 0x74, 0x19, 0x72, 0x5b, 0x58, 0x37, 0xb1, 0xc3, 0xd2, 0xdf, 0xc7,
+		0xf9, 0x4d, 0x1d, 0x94, 0x44, 0x63, 0xa4, 0xea, 0xdb, 0x0a, 0x3c, 0x23, 0x1f, 0xed, 0x5f, 0x81,
+		0x1c, 0xb3, 0x17, 0x45, 0xc2, 0x5e, 0x18, 0x2d, 0x5a, 0x81, 0xa9, 0xf8, 0x72, 0xaf, 0x65, 0x52,
+		0xef, 0x9e, 0xd5, 0x26, 0xc5, 0x95, 0xda, 0x92, 0xa9, 0xbe, 0xa5, 0xc0, 0x39, 0xc9, 0xa0, 0xe1,
+		0x2a, 0x0c, 0x04, 0x9e, 0x42, 0x91, 0xf0, 0x14, 0x01, 0xf1, 0xbe, 0x41, 0xb5, 0x61, 0x5a, 0x3a,
+		0x62, 0x5e, 0x82, 0x21, 0xe6, 0xac, 0xdb, 0x07, 0xe7, 0xb0, 0x40, 0x09, 0x98, 0x6f, 0xa6, 0xe7,
+		0x66, 0x81, 0xb4, 0x1f, 0xd4, 0x3f, 0x28, 0x70, 0x56, 0xa6, 0x69, 0xa0, 0xfb, 0x04, 0x54, 0xd2,
+		0x9d, 0x80, 0x77, 0x60, 0x4c, 0x70, 0xca, 0x64, 0x92, 0x0c, 0xf2, 0x90, 0xcb, 0x39, 0x61, 0x3a,
+		0x3c, 0x4d, 0xb6, 0xcb, 0xd3, 0xa8, 0xaf, 0x29, 0xa0, 0x26, 0xf7, 0x1b, 0xa0, 0x59, 0x40, 0xe1,
+		0x1a, 0x74, 0xab, 0x0b, 0x69, 0xc4, 0xed, 0x5a, 0x82, 0x90, 0xbb, 0xcd, 0x84, 0xdc, 0xed, 0x09,
+		0x80, 0x20, 0x21, 0x68, 0x99, 0x14, 0x4d, 0x5e, 0xcb, 0xb3, 0x91, 0x92, 0xa9, 0xfe, 0x33, 0xb4,
+		0xbc, 0x42, 0x0b, 0x49, 0x87, 0x68, 0x1a, 0x46, 0xba, 0xf3, 0x10, 0x2d, 0xf5, 0x1a, 0x76, 0x3b,
+		0x24, 0x0e, 0x61, 0xcf, 0x86, 0xb0, 0x9f, 0x87, 0x83, 0x1b, 0x56, 0x4d, 0x77, 0x76, 0xcb, 0xc6,
+		0x16, 0x36, 0xb6, 0xdd, 0x46, 0x95, 0x86, 0x28, 0x79, 0x6d, 0xd8, 0x1f, 0x5e, 0x62, 0xa3, 0xe8,
+		0x02, 0x8c, 0x76, 0x67, 0xcf, 0xf0, 0x23, 0x3f, 0xfc, 0x18, 0xd2, 0x46, 0x70, 0x67, 0x52, 0x0b,
+		0x3f, 0x22, 0xea, 0xab, 0x59, 0x38, 0x23, 0xd1, 0xca, 0xf0, 0xd8, 0x24, 0x0e, 0x9b, 0x45, 0xb6,
+		0x07, 0xb3, 0x40, 0x27, 0xa1, 0xb0, 0xa1, 0xbb, 0x38, 0x38, 0x3a, 0xfd, 0x65, 0xc9, 0x7b, 0x43,
+		0xfe, 0x81, 0x39, 0x09, 0xe0, 0x25, 0x0e, 0xd9, 0xeb, 0x7e, 0x7f, 0x61, 0x6b, 0xb8, 0xe9, 0xbf,
+		0x9d, 0x05, 0xb4, 0x69, 0x3b, 0xdb, 0x0c, 0x69, 0xd0, 0x8f, 0x96, 0xf3, 0x45, 0xf3, 0xde, 0x50,
+		0xac, 0x0f, 0xfc, 0x71, 0x34, 0xe6, 0x39, 0x47, 0xdd, 0xb5, 0x6b, 0x2c, 0x36, 0x62, 0x4f, 0xe8,
+		0x16, 0xf4, 0x1b, 0x7a, 0xc3, 0xc5, 0x2c, 0x0c, 0x2a, 0x4a, 0x37, 0x8d, 0x2c, 0x79, 0xb3, 0x34,
+		0x7f, 0xb2, 0xfa, 0x56, 0x16, 0x4e, 0x27, 0x36, 0x72, 0x3c, 0xb6, 0xcd, 0xb8, 0x19, 0xc8, 0xe0,
+		0xef, 0xc2, 0xac, 0x64, 0x9f, 0x49, 0xa7, 0x04, 0x9d, 0x3e, 0xb9, 0x2f, 0x8d, 0x4f, 0xee, 0x54,
+		0xfd, 0xfe, 0x90, 0xea, 0x87, 0xf6, 0x37, 0x17, 0xbf, 0xbf, 0x03, 0x52, 0xfb, 0x3b, 0x28, 0xd8,
+		0x5f, 0x8e, 0x99, 0xe5, 0x79, 0x66, 0xa6, 0x7e, 0x33, 0x07, 0x67, 0x65, 0x7a, 0x5c, 0xd0, 0x29,
+		0x28, 0xb4, 0x0a, 0xc5, 0x6c, 0x9b, 0xf2, 0x1a, 0x04, 0x43, 0x25, 0xd3, 0xbb, 0x54, 0xb5, 0x08,
+		0xa8, 0x11, 0x64, 0x62, 0x2e, 0x55, 0xad, 0x4f, 0xd2, 0x4b, 0x95, 0xde, 0xf1, 0xe4, 0xa9, 0xa6,
+		0x69, 0x57, 0x75, 0xab, 0xc6, 0x7c, 0x07, 0x7b, 0xea, 0x3e, 0x0c, 0xfa, 0x7a, 0xbc, 0x0e, 0xe5,
+		0xe4, 0xaf, 0x43, 0xeb, 0x30, 0x11, 0x28, 0x61, 0xf4, 0x0c, 0x19, 0x48, 0x3a, 0x43, 0xc6, 0x82,
+		0xb9, 0xa1, 0x63, 0x24, 0xc4, 0x95, 0x1d, 0x51, 0x8c, 0xeb, 0x60, 0x0a, 0xae, 0xfe, 0x2d, 0x88,
+		0x71, 0x15, 0x1f, 0x76, 0xf9, 0x9e, 0x0e, 0xbb, 0x15, 0x18, 0xdd, 0xc2, 0xba, 0x43, 0x36, 0xb0,
+		0xde, 0x46, 0x07, 0x49, 0xac, 0x46, 0x5a, 0x73, 0xda, 0x7c, 0x92, 0x43, 0x94, 0x42, 0x72, 0x88,
+		0x12, 0xb9, 0x2b, 0x0c, 0xf5, 0x72, 0x57, 0x68, 0xc7, 0x9c, 0x07, 0xe4, 0x63, 0xce, 0x7f, 0x28,
+		0xa0, 0x26, 0xf7, 0x5b, 0x7d, 0x60, 0x87, 0x7b, 0x67, 0x18, 0xd2, 0xd7, 0x7d, 0xe1, 0x79, 0x11,
+		0x86, 0xe8, 0x7d, 0x31, 0xf0, 0x5b, 0xfd, 0x12, 0x7e, 0xab, 0xe0, 0xcd, 0x60, 0x0f, 0xea, 0x9f,
+		0x94, 0x6e, 0x57, 0xb0, 0xcf, 0x91, 0x35, 0x7f, 0x89, 0x32, 0x29, 0xdc, 0x7d, 0x36, 0x31, 0xda,
+		0xe8, 0xeb, 0x5e, 0x4c, 0xf5, 0x8f, 0x0a, 0x9c, 0x4e, 0x6e, 0x82, 0xe9, 0x35, 0x00, 0xff, 0x30,
+		0x24, 0xfa, 0x45, 0x06, 0xce, 0x48, 0xb4, 0x92, 0x79, 0x32, 0x99, 0x98, 0xe8, 0x56, 0xc5, 0x95,
+		0xda, 0xa4, 0x80, 0xf8, 0xb1, 0xc9, 0x14, 0x8e, 0x90, 0xfa, 0x7a, 0x89, 0x90, 0xf6, 0xac, 0xe2,
+		0x5f, 0x55, 0x60, 0x46, 0xbe, 0x03, 0x4c, 0xe6, 0xcc, 0xdb, 0x9f, 0x2b, 0xd8, 0x3b, 0x0a, 0xa4,
+		0xec, 0xf5, 0x4a, 0xc6, 0x76, 0x38, 0x08, 0x83, 0x7c, 0x0f, 0xe3, 0x3f, 0x48, 0x21, 0xce, 0x4a,
+		0x20, 0x7e, 0x33, 0xa4, 0x87, 0xa2, 0xaa, 0x50, 0xaf, 0x7a, 0xb8, 0x02, 0x53, 0x15, 0x9d, 0x74,
+		0xf4, 0x3c, 0x84, 0x3b, 0x00, 0xda, 0x2b, 0xeb, 0xd3, 0xf1, 0xb6, 0xd2, 0x0f, 0x9b, 0x38, 0xfa,
+		0x9c, 0x4d, 0xa1, 0xcf, 0x7d, 0x89, 0x36, 0x1a, 0x0a, 0xf4, 0xd4, 0x77, 0x15, 0x38, 0x1e, 0xd3,
+		0x65, 0xe9, 0xfd, 0x0a, 0xc5, 0xef, 0x2e, 0x6b, 0xed, 0xdb, 0x00, 0x7d, 0x2e, 0x99, 0x68, 0x15,
+		0x8e, 0xb4, 0x0e, 0xf2, 0x4d, 0xcb, 0x49, 0x71, 0x69, 0x45, 0xec, 0x1c, 0xf7, 0xba, 0x28, 0xd3,
+		0x1c, 0xbf, 0x32, 0x9b, 0xfd, 0x59, 0x98, 0x10, 0xb6, 0x6f, 0xc6, 0x49, 0x23, 0x1d, 0xb3, 0xab,
+		0xbf, 0x55, 0x60, 0x32, 0xae, 0x73, 0x6f, 0x5f, 0xbe, 0xb2, 0x5f, 0xeb, 0x11, 0xeb, 0xa0, 0x7f,
+		0xaa, 0xc0, 0x54, 0x52, 0x07, 0x60, 0x9c, 0x34, 0x8f, 0xd5, 0x6c, 0x63, 0x91, 0xff, 0x67, 0x00,
+		0x52, 0x36, 0x9a, 0xa0, 0x79, 0x38, 0x4c, 0x7b, 0x59, 0xc2, 0x69, 0x5f, 0x5f, 0xa6, 0xd1, 0x1a,
+		0x6e, 0x86, 0x92, 0xbe, 0x91, 0xca, 0x4b, 0xa6, 0xb7, 0xca, 0xcb, 0x93, 0xda, 0x88, 0x7c, 0x6d,
+		0x44, 0x46, 0x77, 0x06, 0x24, 0x74, 0xe7, 0x2e, 0x8c, 0xb1, 0x9c, 0x36, 0xc3, 0x68, 0xd5, 0x08,
+		0x76, 0x76, 0xf4, 0x4a, 0xf2, 0xbd, 0xe5, 0x30, 0x9b, 0x48, 0xe1, 0x95, 0xd8, 0xb4, 0xee, 0xba,
+		0x4b, 0x7e, 0x4f, 0x75, 0x97, 0x8e, 0x10, 0x0e, 0xd2, 0x84, 0x70, 0xe2, 0x22, 0x4b, 0xa1, 0xe7,
+		0x22, 0x4b, 0xfb, 0x9e, 0x31, 0x24, 0x7d, 0xcf, 0x68, 0xa5, 0xfa, 0x0f, 0xec, 0x21, 0xd5, 0x3f,
+		0xbc, 0xa7, 0x54, 0xbf, 0xe7, 0x83, 0xe7, 0xd3, 0x76, 0xbb, 0xb5, 0xbc, 0x95, 0xd2, 0xe9, 0xad,
+		0xe2, 0xee, 0x37, 0x1b, 0x70, 0xb4, 0x55, 0x21, 0x0f, 0x55, 0x4d, 0x7d, 0x3b, 0x9e, 0x89, 0xad,
+		0x81, 0x77, 0xd7, 0x4d, 0x8f, 0x60, 0xde, 0xb0, 0xfa, 0x7d, 0x05, 0xa6, 0x05, 0x92, 0xf0, 0x8a,
+		0xc1, 0xc9, 0xe6, 0xa1, 0x48, 0x98, 0x47, 0x47, 0xa4, 0x93, 0x49, 0x11, 0xe9, 0xa8, 0xef, 0x2b,
+		0x70, 0x22, 0xb6, 0x5b, 0xdb, 0x0b, 0xf5, 0x58, 0x2f, 0x78, 0x4d, 0xaf, 0x06, 0x4b, 0x0d, 0xfe,
+		0xd0, 0x1d, 0xbd, 0x8a, 0x7b, 0xfd, 0xf4, 0xbe, 0x9d, 0x2a, 0x6d, 0x8d, 0xef, 0x93, 0xbf, 0x59,
+		0x7f, 0x9d, 0xb7, 0x49, 0xa2, 0xee, 0x84, 0x53, 0x50, 0x60, 0xfd, 0x21, 0x9d, 0x4b, 0xe0, 0x0f,
+		0xd1, 0x25, 0x68, 0x39, 0xf5, 0x8c, 0xbc, 0x53, 0x8f, 0xc9, 0x53, 0xab, 0x5f, 0x53, 0x60, 0x26,
+		0x45, 0x47, 0x4e, 0x3b, 0x9f, 0xaa, 0x74, 0xe5, 0x53, 0x7b, 0xdd, 0x99, 0x38, 0x68, 0xbf, 0xca,
+		0xc0, 0x0b, 0x7b, 0xeb, 0x4a, 0xde, 0x37, 0x9d, 0x6f, 0xe7, 0xea, 0x32, 0x5d, 0xb9, 0xba, 0xfb,
+		0x80, 0xa2, 0xdd, 0x2f, 0xcc, 0xbe, 0xcf, 0xc9, 0x75, 0xb8, 0x6a, 0xa3, 0x91, 0x16, 0x56, 0x2f,
+		0xf9, 0x61, 0xd8, 0x35, 0xe2, 0xd8, 0x15, 0xaa, 0x68, 0x43, 0x5a, 0xf0, 0x88, 0x8a, 0x70, 0x28,
+		0xd4, 0xc8, 0x65, 0xd7, 0x2a, 0x7e, 0x64, 0x3e, 0xa8, 0x8d, 0x76, 0xf5, 0x57, 0xdd, 0xad, 0x55,
+		0x76, 0xd5, 0x37, 0xb2, 0x70, 0x63, 0x0f, 0x5d, 0xcf, 0xe8, 0x7e, 0xa7, 0xdf, 0x1b, 0x16, 0xfc,
+		0xa6, 0x40, 0x8a, 0x73, 0x57, 0xda, 0x79, 0x9f, 0xee, 0x93, 0xc2, 0x1c, 0x2a, 0x7f, 0x5f, 0xfa,
+		0xf6, 0xba, 0x2f, 0xb3, 0x80, 0xc2, 0xbd, 0x66, 0xac, 0x42, 0x91, 0xd5, 0x46, 0xac, 0x2e, 0x25,
+		0xf4, 0x53, 0x58, 0xc1, 0x2e, 0xe6, 0xba, 0x76, 0x51, 0xfd, 0xb3, 0x02, 0xd7, 0x7a, 0x6c, 0xd9,
+		0x16, 0x60, 0x50, 0x04, 0x18, 0x3e, 0x58, 0xc5, 0x55, 0xbf, 0x9c, 0x85, 0x6b, 0x3d, 0xb6, 0xd5,
+		0xfd, 0xbf, 0xda, 0x6a, 0xc8, 0x63, 0xf7, 0x89, 0x3d, 0x76, 0xbf, 0xbc, 0xc7, 0x16, 0xaa, 0x8e,
+		0xc8, 0x01, 0x0c, 0x88, 0x1c, 0xc0, 0xab, 0x59, 0xb8, 0xd2, 0x4b, 0x6b, 0xa0, 0x9c, 0xe5, 0x4b,
+		0x71, 0x7e, 0x62, 0xf9, 0x6d, 0xcb, 0x7f, 0x4f, 0x81, 0x8b, 0x69, 0xdb, 0x1c, 0xff, 0xa7, 0x4d,
+		0x5e, 0x7c, 0x56, 0xa9, 0xbf, 0x57, 0x60, 0x2e, 0x55, 0x6b, 0xe4, 0xbe, 0xb9, 0x00, 0xee, 0xad,
+		0x21, 0xb3, 0xb7, 0x5b, 0xc3, 0x5f, 0x07, 0xe1, 0x72, 0x0f, 0xbf, 0xf1, 0xe8, 0xd8, 0x0e, 0xa5,
+		0x6b, 0x3b, 0x4e, 0x41, 0xa1, 0xb5, 0x1d, 0x4c, 0xe7, 0xf3, 0x1a, 0x04, 0x43, 0xbc, 0x14, 0x42,
+		0x76, 0x1f, 0x52, 0x08, 0xbd, 0xd6, 0x13, 0xfb, 0xf7, 0x37, 0x85, 0x90, 0x7b, 0xac, 0x29, 0x84,
+		0x81, 0x9e, 0x53, 0x08, 0x0f, 0x80, 0x75, 0xa8, 0x32, 0x8e, 0xac, 0x0c, 0xe7, 0x37, 0x09, 0x9c,
+		0x8b, 0x69, 0x73, 0xa5, 0x5c, 0x58, 0x31, 0x6e, 0xb4, 0x1e, 0x1e, 0xea, 0x34, 0x92, 0x7c, 0xb7,
+		0x3f, 0x97, 0x51, 0x79, 0x90, 0x50, 0x79, 0x03, 0xc6, 0x3b, 0xd4, 0xa9, 0xec, 0xe0, 0x46, 0x1b,
+		0x7e, 0x81, 0xc2, 0x9f, 0x89, 0x55, 0x9c, 0x92, 0xa9, 0xe1, 0x46, 0x80, 0x57, 0x3b, 0xd2, 0xe4,
+		0x0d, 0x47, 0xca, 0x93, 0x07, 0x7a, 0x29, 0x4f, 0x46, 0x7a, 0x0d, 0x87, 0x39, 0xbd, 0x86, 0xed,
+		0x9b, 0xd6, 0xc1, 0xf4, 0xb9, 0x85, 0x91, 0x3d, 0xe4, 0x16, 0x46, 0xf7, 0xd6, 0x46, 0xf8, 0x1c,
+		0x14, 0x4c, 0x5c, 0xd1, 0x77, 0x7d, 0xd5, 0x4c, 0xee, 0x89, 0x04, 0x4a, 0x4d, 0x55, 0x51, 0x7d,
+		0x3d, 0x0b, 0x17, 0xd3, 0xfe, 0x06, 0xeb, 0xc3, 0x77, 0x2f, 0xab, 0x41, 0x9c, 0xe0, 0x57, 0xba,
+		0xae, 0xa6, 0xfe, 0x01, 0x51, 0x57, 0x78, 0xd0, 0x61, 0x28, 0xfd, 0xdd, 0x86, 0xc2, 0x3f, 0x04,
+		0x73, 0x82, 0x43, 0x70, 0x9f, 0x72, 0x81, 0xea, 0xef, 0x32, 0x30, 0x9b, 0xe6, 0x07, 0x66, 0xc2,
+		0xfd, 0xe0, 0x9f, 0xbe, 0x99, 0xbd, 0x9e, 0xbe, 0xfb, 0xb5, 0x8b, 0xfc, 0xd5, 0xed, 0x13, 0xac,
+		0x6e, 0xdb, 0x3a, 0xfb, 0xe5, 0xf3, 0x20, 0xef, 0x67, 0x20, 0xe5, 0x4f, 0xdf, 0x3e, 0x1a, 0x8b,
+		0xc9, 0x2b, 0xeb, 0xf4, 0x73, 0xcb, 0x3a, 0xed, 0x7e, 0x84, 0x9c, 0x7c, 0x3f, 0x82, 0xfa, 0xaf,
+		0x0c, 0x5c, 0xd8, 0x0f, 0x8f, 0xf2, 0x11, 0x5d, 0xf4, 0x8e, 0x8c, 0x7b, 0x2e, 0x45, 0xc6, 0x5d,
+		0xfd, 0x77, 0x06, 0xe6, 0x52, 0xfd, 0x12, 0xf1, 0xc9, 0xc2, 0x47, 0x16, 0x3e, 0x48, 0x29, 0xe6,
+		0xd2, 0xe4, 0x99, 0xbf, 0x90, 0x15, 0x2d, 0xbc, 0xa8, 0x87, 0xe4, 0xc9, 0xc2, 0xc7, 0xb6, 0xb0,
+		0xe4, 0x7a, 0xe9, 0x7d, 0xff, 0x65, 0x06, 0xe6, 0x53, 0xfe, 0x42, 0xf4, 0xc9, 0x3e, 0x74, 0xed,
+		0xc3, 0x0c, 0x81, 0x83, 0xf4, 0xcf, 0x15, 0xab, 0x42, 0xb0, 0x43, 0x3f, 0x75, 0x02, 0x26, 0x96,
+		0x1f, 0x2c, 0xdf, 0x59, 0x2f, 0xaf, 0x94, 0x56, 0xd7, 0x97, 0xb5, 0xf2, 0xfa, 0xa7, 0xd6, 0x96,
+		0xcb, 0xa5, 0x3b, 0x0f, 0x16, 0x57, 0x4b, 0xb7, 0x46, 0x9e, 0x42, 0xa7, 0xe0, 0x78, 0xf4, 0xf5,
+		0xe2, 0xea, 0x6a, 0x99, 0x8e, 0x8e, 0x28, 0xe8, 0x34, 0x9c, 0x88, 0x12, 0x2c, 0xad, 0xde, 0xbd,
+		0xb7, 0xcc, 0x48, 0x32, 0x37, 0x5f, 0x86, 0xa3, 0x86, 0x5d, 0xe5, 0xad, 0xc1, 0xcd, 0xc1, 0xc5,
+		0xba, 0xb5, 0xe6, 0xd8, 0xc4, 0x5e, 0x53, 0x3e, 0x7d, 0xe9, 0xa1, 0x45, 0xb6, 0x1a, 0x1b, 0x45,
+		0xc3, 0xae, 0xce, 0x77, 0xfe, 0x9f, 0xd3, 0x39, 0xcb, 0xac, 0xcc, 0x3f, 0xb4, 0xfd, 0xff, 0xad,
+		0xca, 0xfe, 0xe9, 0xe9, 0x0d, 0xbd, 0x6e, 0xed, 0x5c, 0xda, 0xc8, 0xd1, 0xb1, 0xcb, 0xff, 0x1d,
+		0x00, 0x63, 0xa5, 0x41, 0x73, 0xd7, 0x55, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/queue.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
-		0x15, 0x37, 0x25, 0xff, 0x91, 0x9f, 0x1d, 0x87, 0x9e, 0x34, 0x1b, 0xe5, 0x8f, 0x11, 0x85, 0x0b,
-		0x24, 0x82, 0x9b, 0xa5, 0x6a, 0x27, 0xc1, 0x2e, 0xda, 0xa2, 0x2d, 0x43, 0x31, 0x16, 0x1b, 0x46,
-		0x52, 0x87, 0x94, 0xb3, 0x0e, 0x50, 0x10, 0xb4, 0x38, 0xb6, 0x89, 0x50, 0xa4, 0x96, 0xa4, 0x9c,
-		0xea, 0xde, 0x5d, 0xf4, 0xd8, 0x05, 0xda, 0x2f, 0xd0, 0x4b, 0xd1, 0xde, 0x7b, 0xe8, 0xa1, 0xd7,
-		0xf6, 0xd4, 0x43, 0x7b, 0xe8, 0x65, 0xbf, 0x44, 0x3f, 0x42, 0xc1, 0x21, 0x65, 0x51, 0x14, 0x25,
-		0xd1, 0x5e, 0x17, 0xe8, 0xa1, 0x37, 0xeb, 0xf1, 0xf7, 0xde, 0xfc, 0xde, 0x7b, 0x33, 0x3f, 0xbe,
-		0x31, 0x81, 0x1b, 0x1c, 0x13, 0xaf, 0xd6, 0x35, 0x4c, 0xe2, 0x74, 0x49, 0xcd, 0x3f, 0x33, 0x3c,
-		0x62, 0xd6, 0xce, 0xf7, 0x6a, 0x5f, 0x0c, 0xc8, 0x80, 0xf0, 0x7d, 0xcf, 0x0d, 0x5c, 0xf4, 0x51,
-		0x88, 0xe1, 0x63, 0x0c, 0x1f, 0x61, 0xf8, 0xf3, 0xbd, 0x7b, 0x0f, 0x4f, 0x5d, 0xf7, 0xd4, 0x26,
-		0x35, 0x8a, 0x3a, 0x1e, 0x9c, 0xd4, 0x02, 0xab, 0x47, 0xfc, 0xc0, 0xe8, 0xf5, 0x23, 0xc7, 0x7b,
-		0x95, 0x89, 0xe0, 0x46, 0xdf, 0x0a, 0x23, 0x77, 0xdd, 0x5e, 0xcf, 0x75, 0x62, 0xc4, 0xa3, 0x2c,
-		0xc4, 0x99, 0xe5, 0x07, 0xae, 0x37, 0x8c, 0x21, 0x5c, 0x16, 0xe4, 0x83, 0xeb, 0xbd, 0x3f, 0xb1,
-		0xdd, 0x0f, 0x11, 0x86, 0xfb, 0xa6, 0x00, 0xdf, 0x11, 0x3d, 0xd7, 0xf7, 0x45, 0x7b, 0xe0, 0x07,
-		0xc4, 0xd3, 0x0c, 0xff, 0xbd, 0xec, 0x9c, 0xb8, 0xe8, 0x3e, 0xac, 0x9b, 0x6e, 0xcf, 0xb0, 0x1c,
-		0xdd, 0x32, 0xcb, 0x4c, 0x85, 0xa9, 0xae, 0xe3, 0x52, 0x64, 0x90, 0x4d, 0xd4, 0x01, 0x34, 0x8a,
-		0xa3, 0x93, 0x5f, 0x90, 0xee, 0x20, 0xb0, 0x5c, 0xa7, 0x5c, 0xa8, 0x30, 0xd5, 0x8d, 0xfd, 0xc7,
-		0xfc, 0x44, 0xd2, 0x46, 0xdf, 0xe2, 0xcf, 0xf7, 0xf8, 0xb7, 0x31, 0x5c, 0x1a, 0xa1, 0xf1, 0xf6,
-		0x87, 0xb4, 0x09, 0xc9, 0xb0, 0x1e, 0x18, 0xfe, 0x7b, 0x3d, 0x18, 0xf6, 0x49, 0xb9, 0x58, 0x61,
-		0xaa, 0x5b, 0xfb, 0x4f, 0xf9, 0xec, 0x12, 0xf2, 0x69, 0xd2, 0xda, 0xb0, 0x4f, 0x70, 0x29, 0x88,
-		0xff, 0x42, 0x3b, 0x00, 0x34, 0x94, 0x1f, 0x18, 0x01, 0x29, 0x2f, 0x57, 0x98, 0xea, 0x0a, 0xa6,
-		0xc1, 0xd5, 0xd0, 0x80, 0xee, 0xc0, 0x1a, 0x7d, 0x6c, 0x99, 0xe5, 0x95, 0x0a, 0x53, 0x2d, 0xe2,
-		0xd5, 0xf0, 0xa7, 0x6c, 0x22, 0x05, 0x6e, 0x9d, 0x5b, 0xbe, 0x75, 0x6c, 0xd9, 0x56, 0x30, 0xd4,
-		0x46, 0x5d, 0x29, 0xaf, 0xd2, 0xd4, 0xee, 0xf1, 0x51, 0xdf, 0xf8, 0x51, 0xdf, 0xf8, 0x0b, 0x04,
-		0xce, 0x72, 0xe3, 0xfe, 0x55, 0x80, 0xef, 0x25, 0x89, 0xaa, 0x81, 0xe1, 0x05, 0xe2, 0x99, 0x65,
-		0x9b, 0xe3, 0x3a, 0x90, 0x2f, 0x06, 0xc4, 0x0f, 0x84, 0x20, 0xf0, 0xac, 0xe3, 0x41, 0x40, 0x7c,
-		0x54, 0x05, 0x36, 0x30, 0xbc, 0x53, 0x12, 0xe8, 0xe9, 0x06, 0x6c, 0x45, 0xf6, 0xfa, 0xa8, 0x0d,
-		0x3b, 0x00, 0x5e, 0xe4, 0x1e, 0x62, 0x0a, 0x14, 0xb3, 0x1e, 0x5b, 0x64, 0x13, 0x3d, 0x05, 0x64,
-		0x39, 0x56, 0x60, 0x19, 0x01, 0x31, 0x75, 0x72, 0x4e, 0x1c, 0x0a, 0x2b, 0xd2, 0x7c, 0xd9, 0x8b,
-		0x27, 0x52, 0xf8, 0x40, 0x36, 0xd1, 0x57, 0x0c, 0xdc, 0x4b, 0xc3, 0x8d, 0x0b, 0x56, 0xb4, 0x84,
-		0x1b, 0xfb, 0x8d, 0xcc, 0xe6, 0x8e, 0xd3, 0x9a, 0x6a, 0xb3, 0x3c, 0xb1, 0xcc, 0x38, 0x4b, 0x5c,
-		0xb6, 0x66, 0x3c, 0x41, 0x1c, 0xdc, 0x88, 0xf3, 0xf7, 0x06, 0xce, 0xa8, 0x43, 0xeb, 0x78, 0x23,
-		0x32, 0xe2, 0x81, 0x23, 0x9b, 0xdc, 0x4f, 0x61, 0x6f, 0x61, 0x5d, 0xfd, 0xbe, 0xeb, 0xf8, 0x24,
-		0x11, 0xf8, 0x36, 0xac, 0xc6, 0x11, 0xa3, 0x72, 0xae, 0x78, 0x34, 0xd6, 0x9f, 0x0b, 0xf0, 0x34,
-		0x19, 0x4c, 0x34, 0x9c, 0x2e, 0xb1, 0xaf, 0xa5, 0x41, 0xc7, 0x70, 0x37, 0x46, 0x7e, 0xeb, 0xe3,
-		0x72, 0x27, 0x0a, 0x34, 0xf5, 0x20, 0xb5, 0x09, 0x8a, 0xf9, 0x36, 0xc1, 0xf2, 0x8c, 0x4d, 0xc0,
-		0xc3, 0xad, 0x6e, 0x58, 0xc6, 0x31, 0x5f, 0xd7, 0xb1, 0x87, 0xb4, 0x03, 0x25, 0xbc, 0xdd, 0x4d,
-		0xb6, 0xb8, 0xe5, 0xd8, 0x43, 0xae, 0x06, 0x9f, 0xcc, 0x2d, 0x5d, 0xba, 0x07, 0xdc, 0x9f, 0x8a,
-		0x93, 0xc5, 0x56, 0xad, 0x53, 0xc7, 0xf8, 0x7f, 0xb1, 0xf3, 0x14, 0x1b, 0x3d, 0x84, 0x0d, 0x9f,
-		0x96, 0x4b, 0x77, 0x8c, 0x1e, 0xa1, 0x9a, 0xb4, 0x8e, 0x21, 0x32, 0x35, 0x8d, 0x1e, 0x41, 0x3f,
-		0x86, 0xcd, 0x18, 0x60, 0x39, 0xfd, 0x41, 0x50, 0x5e, 0xa3, 0x49, 0x3f, 0xc8, 0x4c, 0xba, 0x6d,
-		0x0c, 0x6d, 0xd7, 0x30, 0x71, 0x1c, 0x52, 0x0e, 0x1d, 0x50, 0x19, 0xd6, 0xba, 0xae, 0x13, 0x78,
-		0xae, 0x5d, 0x2e, 0x55, 0x98, 0xea, 0x26, 0x1e, 0xfd, 0x4c, 0x37, 0x7a, 0xaa, 0x6d, 0x53, 0x8d,
-		0xfe, 0x7b, 0x01, 0x84, 0xa4, 0x07, 0x26, 0x5d, 0xd7, 0x33, 0xb3, 0x45, 0x42, 0x74, 0x7b, 0x7d,
-		0x9b, 0x04, 0xe4, 0x7f, 0xbd, 0xfb, 0x97, 0x13, 0x54, 0x05, 0xd8, 0x6e, 0x94, 0x98, 0xe5, 0x3a,
-		0x11, 0x3c, 0x56, 0xd1, 0x47, 0x99, 0x44, 0x1a, 0xd1, 0xcb, 0x9b, 0xba, 0xe3, 0x9b, 0x63, 0x57,
-		0x6a, 0xe0, 0xea, 0xf0, 0xf2, 0xf2, 0xe5, 0x9c, 0xea, 0xca, 0xbf, 0x19, 0xa8, 0x08, 0xfd, 0xbe,
-		0x3d, 0x6c, 0x1b, 0x1e, 0x71, 0x02, 0xd1, 0x76, 0x7d, 0xd2, 0x76, 0x6d, 0xab, 0x3b, 0x4c, 0x14,
-		0xfd, 0x31, 0xdc, 0x8c, 0xf6, 0x65, 0xba, 0xe6, 0x37, 0xa8, 0xf9, 0xa2, 0xe4, 0xbb, 0xb0, 0x9d,
-		0xda, 0xbf, 0x17, 0x6f, 0xa1, 0x9b, 0x13, 0xbb, 0x57, 0x36, 0x51, 0x05, 0x36, 0x23, 0x6c, 0xac,
-		0xc0, 0xd1, 0xd1, 0x01, 0x6a, 0xa3, 0x92, 0x8e, 0x0e, 0xe1, 0x56, 0x9f, 0x92, 0xd2, 0xbb, 0x21,
-		0x2b, 0xbd, 0x4f, 0x69, 0xd1, 0x8a, 0x6d, 0xcd, 0x68, 0xdd, 0x54, 0x12, 0x78, 0xbb, 0x9f, 0x36,
-		0x71, 0xbf, 0x61, 0xe0, 0x41, 0x76, 0xca, 0xe1, 0x28, 0x30, 0xf0, 0xd1, 0x03, 0x58, 0x8f, 0x8b,
-		0x4d, 0xa2, 0x44, 0x4b, 0x78, 0x6c, 0x40, 0x87, 0xb0, 0x79, 0x62, 0x58, 0x36, 0x31, 0xf5, 0xae,
-		0x31, 0xf0, 0x09, 0xcd, 0x6f, 0x6b, 0xff, 0x59, 0xde, 0xb1, 0xe4, 0x15, 0xf5, 0x15, 0x43, 0x57,
-		0xbc, 0x71, 0x32, 0xfe, 0xc1, 0xfd, 0x85, 0x81, 0x9d, 0x6c, 0x5a, 0xf1, 0x29, 0x40, 0x4d, 0x58,
-		0xa1, 0xe5, 0xa1, 0x9c, 0x36, 0xf6, 0x3f, 0x9b, 0xb5, 0xe4, 0xa2, 0x7e, 0xe2, 0x28, 0x0c, 0x52,
-		0x60, 0xd5, 0xa7, 0x19, 0xc7, 0xc7, 0xe1, 0xf9, 0xe5, 0x02, 0x46, 0xd5, 0xc2, 0x71, 0x0c, 0xee,
-		0x57, 0x0c, 0x3c, 0x4b, 0x26, 0x3b, 0x37, 0x97, 0xc4, 0xe6, 0xfa, 0x19, 0x94, 0x28, 0x1d, 0x8f,
-		0x38, 0x65, 0xa6, 0x52, 0xac, 0x6e, 0xec, 0xbf, 0xb8, 0x1c, 0x8f, 0x38, 0x24, 0xbe, 0x08, 0xc3,
-		0xfd, 0x75, 0x66, 0x87, 0x31, 0xf1, 0x07, 0xf6, 0xf5, 0x57, 0xf2, 0xbf, 0xb5, 0x27, 0x7e, 0xcb,
-		0xc0, 0xf3, 0x3c, 0x35, 0x9d, 0x9a, 0x6c, 0x7e, 0x1e, 0x9f, 0x58, 0x8f, 0x38, 0x7a, 0xdc, 0xe3,
-		0xa8, 0xb6, 0xcf, 0x2f, 0x5b, 0xdb, 0xb0, 0x5e, 0x78, 0x6b, 0x14, 0x2c, 0xea, 0x39, 0xf7, 0xf5,
-		0x1a, 0xdc, 0x49, 0x27, 0x31, 0xda, 0xa5, 0xa3, 0x99, 0xdd, 0x72, 0x4e, 0xdc, 0xb8, 0xbe, 0xb9,
-		0x67, 0xf6, 0xf0, 0xa2, 0x11, 0xcd, 0xec, 0xf4, 0xca, 0xf1, 0x6b, 0x06, 0x76, 0xfc, 0xe9, 0x49,
-		0x6e, 0x9c, 0x67, 0xbc, 0x71, 0x1b, 0x79, 0xe2, 0xe7, 0x19, 0xb5, 0x1b, 0x4b, 0x78, 0xfe, 0x82,
-		0xe8, 0x97, 0x0c, 0xdc, 0xed, 0x4e, 0x0e, 0x35, 0x09, 0x3a, 0x45, 0x4a, 0xa7, 0x9e, 0x87, 0xce,
-		0xa2, 0xa1, 0xb2, 0xb1, 0x84, 0x67, 0x2f, 0x44, 0x69, 0xf8, 0x93, 0xaf, 0x5c, 0x21, 0x3d, 0x9a,
-		0xe7, 0xa2, 0xb1, 0x68, 0xdc, 0x0a, 0x69, 0xcc, 0x5c, 0x08, 0xfd, 0x83, 0x81, 0x17, 0xde, 0x55,
-		0xde, 0xe3, 0x74, 0xa6, 0xd9, 0xd8, 0x3f, 0xca, 0x43, 0xf1, 0x4a, 0x83, 0x42, 0x63, 0x09, 0x5f,
-		0x8d, 0x19, 0xfa, 0x3d, 0x03, 0x4f, 0x8c, 0x7c, 0xda, 0x15, 0xdf, 0x02, 0x5f, 0xe7, 0xc9, 0x22,
-		0xa7, 0x1c, 0x36, 0x96, 0x70, 0xde, 0xd5, 0x5f, 0x6e, 0x02, 0x8c, 0xef, 0x63, 0xdc, 0x1f, 0x4a,
-		0x50, 0x9e, 0x3e, 0x93, 0x91, 0x32, 0x24, 0xaf, 0xb7, 0xcc, 0xc4, 0xf5, 0x76, 0xe2, 0x86, 0x5d,
-		0xb8, 0xc6, 0x1b, 0x76, 0x31, 0x7d, 0xc3, 0x4e, 0x6b, 0xe4, 0xf2, 0xf5, 0x68, 0x24, 0xfa, 0x7a,
-		0xa1, 0x48, 0x44, 0x7b, 0x4d, 0xbe, 0xb2, 0x48, 0xa4, 0xd5, 0x75, 0xb1, 0x4a, 0x7c, 0x39, 0x57,
-		0x25, 0xa2, 0x5d, 0x23, 0x5d, 0x49, 0x25, 0x32, 0xb8, 0xcc, 0x91, 0x89, 0x2f, 0xe7, 0xca, 0xc4,
-		0x5a, 0x7e, 0x1e, 0x0b, 0xc7, 0xfb, 0xf9, 0x3a, 0xf1, 0xcf, 0x2b, 0xeb, 0x44, 0x89, 0x72, 0x7c,
-		0x77, 0x7d, 0x3a, 0x91, 0x41, 0xfc, 0x8a, 0x42, 0xf1, 0x47, 0x06, 0xaa, 0x46, 0xce, 0x17, 0x72,
-		0x79, 0x9d, 0xe6, 0xa1, 0x7c, 0x1b, 0xa5, 0xc8, 0x60, 0x9e, 0x7b, 0xfd, 0x94, 0x56, 0xf4, 0xb3,
-		0xa4, 0x82, 0xe6, 0xe7, 0x23, 0x0d, 0x6e, 0xd0, 0x63, 0x1c, 0x5f, 0x62, 0x47, 0x83, 0x43, 0x2d,
-		0xef, 0x41, 0x1d, 0x8d, 0x63, 0x9b, 0x41, 0x22, 0xea, 0xee, 0x57, 0x0c, 0x94, 0x46, 0x9a, 0x81,
-		0x6e, 0xc3, 0xb6, 0x26, 0xa8, 0xaf, 0x75, 0xed, 0xa8, 0x2d, 0xe9, 0x72, 0xf3, 0x50, 0x50, 0xe4,
-		0x3a, 0xbb, 0x84, 0x3e, 0x02, 0x34, 0x36, 0x6b, 0x58, 0x68, 0xaa, 0xaf, 0x24, 0xcc, 0x32, 0xe8,
-		0x16, 0xdc, 0x4c, 0xd8, 0xe5, 0x37, 0x12, 0x66, 0x0b, 0xe8, 0x2e, 0xdc, 0x1e, 0x1b, 0xb1, 0xd4,
-		0x56, 0x64, 0x51, 0xd0, 0xe4, 0x56, 0x93, 0x2d, 0xa2, 0xfb, 0x70, 0x67, 0xfc, 0x48, 0xc4, 0x2d,
-		0x55, 0xd5, 0x45, 0xa5, 0xa3, 0x6a, 0x12, 0x66, 0x97, 0x77, 0xff, 0x96, 0xf1, 0xff, 0x4d, 0x4a,
-		0xea, 0x63, 0x78, 0x38, 0x81, 0xd5, 0xb3, 0x28, 0xee, 0xc1, 0x27, 0xb3, 0x40, 0xaa, 0x26, 0x60,
-		0x4d, 0x17, 0x1b, 0xb2, 0x52, 0xd7, 0xa5, 0xcf, 0x25, 0xb1, 0x43, 0xd9, 0x30, 0xe8, 0x29, 0x54,
-		0x67, 0xb9, 0x88, 0x42, 0x53, 0x94, 0x94, 0x04, 0xba, 0x30, 0x0f, 0xad, 0xca, 0x07, 0x4d, 0x21,
-		0x89, 0x2e, 0xa2, 0x3a, 0xfc, 0x64, 0x16, 0x1a, 0x4b, 0x62, 0x0b, 0xd7, 0x63, 0x3e, 0x6f, 0x5b,
-		0xf8, 0xf5, 0x6b, 0xa5, 0xf5, 0x76, 0xec, 0xac, 0x8b, 0xad, 0x37, 0x6d, 0x45, 0xd2, 0x24, 0x76,
-		0x19, 0xbd, 0x80, 0xbd, 0x59, 0x51, 0x84, 0x76, 0x5b, 0x39, 0xd2, 0xdb, 0x02, 0x96, 0x9a, 0x9a,
-		0x2e, 0x2a, 0x2d, 0x55, 0xd2, 0xdb, 0x2d, 0x45, 0x16, 0x8f, 0xd8, 0x95, 0xdd, 0xdf, 0x15, 0xe1,
-		0xfe, 0x1c, 0x95, 0x46, 0xdf, 0x85, 0x27, 0x19, 0x61, 0x5f, 0x09, 0xb2, 0x22, 0xd5, 0x75, 0x51,
-		0xe8, 0xa8, 0xc9, 0xc2, 0x66, 0x73, 0x98, 0x00, 0xd7, 0x5b, 0x6f, 0x04, 0xb9, 0xa9, 0x37, 0x5b,
-		0x9a, 0x2e, 0x88, 0x9a, 0x7c, 0x28, 0xb1, 0xcc, 0x25, 0xdd, 0xa4, 0xcf, 0x65, 0x55, 0x53, 0xd9,
-		0x02, 0xfa, 0x21, 0x7c, 0xb6, 0xc8, 0x2d, 0x2c, 0xd9, 0xab, 0xb0, 0x64, 0x82, 0x82, 0x25, 0xa1,
-		0x7e, 0xa4, 0xe3, 0x4e, 0xb3, 0x29, 0x37, 0x0f, 0xd8, 0x22, 0xfa, 0x14, 0x9e, 0xe5, 0xf6, 0x4e,
-		0x2c, 0xbb, 0x8c, 0x7e, 0x04, 0xdf, 0xbf, 0xf4, 0xb2, 0xa3, 0x3e, 0xd5, 0xd9, 0x95, 0x19, 0xbb,
-		0x6f, 0xc2, 0xbf, 0xd3, 0x14, 0x05, 0x4d, 0x3a, 0x68, 0x61, 0xf9, 0x9d, 0x54, 0x67, 0x57, 0x77,
-		0xbf, 0x61, 0x00, 0x1d, 0x90, 0x20, 0xdd, 0x9b, 0x47, 0xb0, 0x73, 0x20, 0x69, 0x73, 0x3b, 0xf2,
-		0x18, 0xb8, 0x6c, 0x88, 0x2a, 0xe1, 0x43, 0x59, 0x94, 0xf4, 0x97, 0x1d, 0xf5, 0x88, 0x65, 0x66,
-		0x87, 0x0a, 0x4f, 0x6a, 0xab, 0xa3, 0xb1, 0x05, 0xc4, 0xc3, 0xee, 0x8c, 0x50, 0x0d, 0x01, 0xd7,
-		0xf5, 0xd6, 0xdb, 0xa6, 0x84, 0xd5, 0x86, 0xdc, 0xd6, 0x95, 0x96, 0xaa, 0xb1, 0x45, 0xf4, 0x04,
-		0x3e, 0xce, 0xc6, 0x4f, 0x66, 0xb7, 0xfc, 0xf2, 0xd3, 0x77, 0x2f, 0x4e, 0xad, 0xe0, 0x6c, 0x70,
-		0xcc, 0x77, 0xdd, 0x5e, 0x6d, 0xe2, 0xeb, 0x06, 0x7f, 0x4a, 0x9c, 0xe8, 0x73, 0xca, 0xf8, 0x53,
-		0xcc, 0x0f, 0xa2, 0xbf, 0xce, 0xf7, 0x8e, 0x57, 0xe9, 0x93, 0x67, 0xff, 0x09, 0x00, 0x00, 0xff,
-		0xff, 0xd8, 0x06, 0x2c, 0x43, 0xb4, 0x19, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6e, 0xdb, 0xd8,
+		0x15, 0x36, 0x25, 0xff, 0xc8, 0xc7, 0x1e, 0x87, 0xbe, 0x69, 0x26, 0xca, 0x8f, 0x11, 0x85, 0x03,
+		0x24, 0x82, 0x9b, 0xa1, 0x6a, 0x27, 0xc1, 0x0c, 0xda, 0xa2, 0x2d, 0x43, 0x31, 0x16, 0x1b, 0x46,
+		0x52, 0x2f, 0x69, 0x67, 0x1c, 0xa0, 0x20, 0x68, 0xf1, 0xda, 0x21, 0x4c, 0x91, 0x1a, 0xfe, 0x38,
+		0xd5, 0xbe, 0x33, 0xe8, 0xb2, 0x03, 0xb4, 0x2f, 0xd0, 0x4d, 0xd1, 0xee, 0xbb, 0xe8, 0xa2, 0xdb,
+		0x76, 0xd5, 0x45, 0xbb, 0xe8, 0x66, 0x5e, 0xa2, 0x8f, 0x50, 0xf0, 0x92, 0xb2, 0x28, 0x8a, 0x92,
+		0x69, 0xc7, 0x05, 0xba, 0xe8, 0x4e, 0x3a, 0xfc, 0xce, 0xb9, 0xdf, 0x39, 0xe7, 0xde, 0x8f, 0xe7,
+		0x4a, 0xc0, 0x85, 0x47, 0xc4, 0x6b, 0xf4, 0x0c, 0x93, 0x38, 0x3d, 0xd2, 0xf0, 0xdf, 0x19, 0x1e,
+		0x31, 0x1b, 0x67, 0x3b, 0x8d, 0x2f, 0x43, 0x12, 0x12, 0x7e, 0xe0, 0xb9, 0x81, 0x8b, 0x3e, 0x8e,
+		0x30, 0x7c, 0x82, 0xe1, 0x63, 0x0c, 0x7f, 0xb6, 0x73, 0xf7, 0xc1, 0x89, 0xeb, 0x9e, 0xd8, 0xa4,
+		0x41, 0x51, 0x47, 0xe1, 0x71, 0x23, 0xb0, 0xfa, 0xc4, 0x0f, 0x8c, 0xfe, 0x20, 0x76, 0xbc, 0x5b,
+		0x9b, 0x08, 0x6e, 0x0c, 0xac, 0x28, 0x72, 0xcf, 0xed, 0xf7, 0x5d, 0x27, 0x41, 0x3c, 0xcc, 0x43,
+		0xbc, 0xb3, 0xfc, 0xc0, 0xf5, 0x86, 0x09, 0x84, 0xcb, 0x83, 0xbc, 0x77, 0xbd, 0xd3, 0x63, 0xdb,
+		0x7d, 0x1f, 0x63, 0xb8, 0x6f, 0x4b, 0xf0, 0x1d, 0xd1, 0x73, 0x7d, 0x5f, 0xb4, 0x43, 0x3f, 0x20,
+		0x9e, 0x66, 0xf8, 0xa7, 0xb2, 0x73, 0xec, 0xa2, 0x7b, 0xb0, 0x6a, 0xba, 0x7d, 0xc3, 0x72, 0x74,
+		0xcb, 0xac, 0x32, 0x35, 0xa6, 0xbe, 0x8a, 0x2b, 0xb1, 0x41, 0x36, 0xd1, 0x3e, 0xa0, 0x51, 0x1c,
+		0x9d, 0xfc, 0x82, 0xf4, 0xc2, 0xc0, 0x72, 0x9d, 0x6a, 0xa9, 0xc6, 0xd4, 0xd7, 0x76, 0x1f, 0xf1,
+		0x13, 0x49, 0x1b, 0x03, 0x8b, 0x3f, 0xdb, 0xe1, 0xdf, 0x24, 0x70, 0x69, 0x84, 0xc6, 0x9b, 0xef,
+		0xb3, 0x26, 0x24, 0xc3, 0x6a, 0x60, 0xf8, 0xa7, 0x7a, 0x30, 0x1c, 0x90, 0x6a, 0xb9, 0xc6, 0xd4,
+		0x37, 0x76, 0x9f, 0xf0, 0xf9, 0x25, 0xe4, 0xb3, 0xa4, 0xb5, 0xe1, 0x80, 0xe0, 0x4a, 0x90, 0x7c,
+		0x42, 0x5b, 0x00, 0x34, 0x94, 0x1f, 0x18, 0x01, 0xa9, 0x2e, 0xd6, 0x98, 0xfa, 0x12, 0xa6, 0xc1,
+		0xd5, 0xc8, 0x80, 0x6e, 0xc3, 0x0a, 0x7d, 0x6c, 0x99, 0xd5, 0xa5, 0x1a, 0x53, 0x2f, 0xe3, 0xe5,
+		0xe8, 0xab, 0x6c, 0x22, 0x05, 0x6e, 0x9e, 0x59, 0xbe, 0x75, 0x64, 0xd9, 0x56, 0x30, 0xd4, 0x46,
+		0x5d, 0xa9, 0x2e, 0xd3, 0xd4, 0xee, 0xf2, 0x71, 0xdf, 0xf8, 0x51, 0xdf, 0xf8, 0x73, 0x04, 0xce,
+		0x73, 0xe3, 0xfe, 0x55, 0x82, 0xef, 0xa5, 0x89, 0xaa, 0x81, 0xe1, 0x05, 0xe2, 0x3b, 0xcb, 0x36,
+		0xc7, 0x75, 0x20, 0x5f, 0x86, 0xc4, 0x0f, 0x84, 0x20, 0xf0, 0xac, 0xa3, 0x30, 0x20, 0x3e, 0xaa,
+		0x03, 0x1b, 0x18, 0xde, 0x09, 0x09, 0xf4, 0x6c, 0x03, 0x36, 0x62, 0x7b, 0x73, 0xd4, 0x86, 0x2d,
+		0x00, 0x2f, 0x76, 0x8f, 0x30, 0x25, 0x8a, 0x59, 0x4d, 0x2c, 0xb2, 0x89, 0x9e, 0x00, 0xb2, 0x1c,
+		0x2b, 0xb0, 0x8c, 0x80, 0x98, 0x3a, 0x39, 0x23, 0x0e, 0x85, 0x95, 0x69, 0xbe, 0xec, 0xf9, 0x13,
+		0x29, 0x7a, 0x20, 0x9b, 0xe8, 0x6b, 0x06, 0xee, 0x66, 0xe1, 0xc6, 0x39, 0x2b, 0x5a, 0xc2, 0xb5,
+		0xdd, 0x56, 0x6e, 0x73, 0xc7, 0x69, 0x4d, 0xb5, 0x59, 0x9e, 0x58, 0x66, 0x9c, 0x25, 0xae, 0x5a,
+		0x33, 0x9e, 0x20, 0x0e, 0x3e, 0x4a, 0xf2, 0xf7, 0x42, 0x67, 0xd4, 0xa1, 0x55, 0xbc, 0x16, 0x1b,
+		0x71, 0xe8, 0xc8, 0x26, 0xf7, 0x53, 0xd8, 0xb9, 0xb0, 0xae, 0xfe, 0xc0, 0x75, 0x7c, 0x92, 0x0a,
+		0x7c, 0x0b, 0x96, 0xbd, 0x30, 0x55, 0xce, 0x25, 0x8f, 0xc6, 0xfa, 0x73, 0x09, 0x9e, 0xa4, 0x83,
+		0x89, 0x86, 0xd3, 0x23, 0xf6, 0xb5, 0x34, 0xe8, 0x08, 0xee, 0x24, 0xc8, 0x0f, 0x3e, 0x2e, 0xb7,
+		0xe3, 0x40, 0x53, 0x0f, 0x32, 0x9b, 0xa0, 0x5c, 0x6c, 0x13, 0x2c, 0xce, 0xd8, 0x04, 0x3c, 0xdc,
+		0xec, 0x45, 0x65, 0x1c, 0xf3, 0x75, 0x1d, 0x7b, 0x48, 0x3b, 0x50, 0xc1, 0x9b, 0xbd, 0x74, 0x8b,
+		0x3b, 0x8e, 0x3d, 0xe4, 0x1a, 0xf0, 0xe9, 0xdc, 0xd2, 0x65, 0x7b, 0xc0, 0xfd, 0xa9, 0x3c, 0x59,
+		0x6c, 0xd5, 0x3a, 0x71, 0x8c, 0xff, 0x17, 0xbb, 0x48, 0xb1, 0xd1, 0x03, 0x58, 0xf3, 0x69, 0xb9,
+		0x74, 0xc7, 0xe8, 0x13, 0xaa, 0x49, 0xab, 0x18, 0x62, 0x53, 0xdb, 0xe8, 0x13, 0xf4, 0x63, 0x58,
+		0x4f, 0x00, 0x96, 0x33, 0x08, 0x83, 0xea, 0x0a, 0x4d, 0xfa, 0x7e, 0x6e, 0xd2, 0x5d, 0x63, 0x68,
+		0xbb, 0x86, 0x89, 0x93, 0x90, 0x72, 0xe4, 0x80, 0xaa, 0xb0, 0xd2, 0x73, 0x9d, 0xc0, 0x73, 0xed,
+		0x6a, 0xa5, 0xc6, 0xd4, 0xd7, 0xf1, 0xe8, 0x6b, 0xb6, 0xd1, 0x53, 0x6d, 0x9b, 0x6a, 0xf4, 0xdf,
+		0x4b, 0x20, 0xa4, 0x3d, 0x30, 0xe9, 0xb9, 0x9e, 0x99, 0x2f, 0x12, 0xa2, 0xdb, 0x1f, 0xd8, 0x24,
+		0x20, 0xff, 0xeb, 0xdd, 0xbf, 0x9c, 0xa0, 0x2a, 0xc0, 0xf6, 0xe2, 0xc4, 0x2c, 0xd7, 0x89, 0xe1,
+		0x89, 0x8a, 0x3e, 0xcc, 0x25, 0xd2, 0x8a, 0x5f, 0xde, 0xd4, 0x1d, 0xdf, 0x18, 0xbb, 0x52, 0x03,
+		0xd7, 0x84, 0x17, 0x97, 0x2f, 0xe7, 0x54, 0x57, 0xfe, 0xcd, 0x40, 0x4d, 0x18, 0x0c, 0xec, 0x61,
+		0xd7, 0xf0, 0x88, 0x13, 0x88, 0xb6, 0xeb, 0x93, 0xae, 0x6b, 0x5b, 0xbd, 0x61, 0xaa, 0xe8, 0x8f,
+		0xe0, 0x46, 0xbc, 0x2f, 0xb3, 0x35, 0xff, 0x88, 0x9a, 0xcf, 0x4b, 0xbe, 0x0d, 0x9b, 0x99, 0xfd,
+		0x7b, 0xfe, 0x16, 0xba, 0x31, 0xb1, 0x7b, 0x65, 0x13, 0xd5, 0x60, 0x3d, 0xc6, 0x26, 0x0a, 0x1c,
+		0x1f, 0x1d, 0xa0, 0x36, 0x2a, 0xe9, 0xe8, 0x00, 0x6e, 0x0e, 0x28, 0x29, 0xbd, 0x17, 0xb1, 0xd2,
+		0x07, 0x94, 0x16, 0xad, 0xd8, 0xc6, 0x8c, 0xd6, 0x4d, 0x25, 0x81, 0x37, 0x07, 0x59, 0x13, 0xf7,
+		0x1b, 0x06, 0xee, 0xe7, 0xa7, 0x1c, 0x8d, 0x02, 0xa1, 0x8f, 0xee, 0xc3, 0x6a, 0x52, 0x6c, 0x12,
+		0x27, 0x5a, 0xc1, 0x63, 0x03, 0x3a, 0x80, 0xf5, 0x63, 0xc3, 0xb2, 0x89, 0xa9, 0xf7, 0x8c, 0xd0,
+		0x27, 0x34, 0xbf, 0x8d, 0xdd, 0xa7, 0x45, 0xc7, 0x92, 0x97, 0xd4, 0x57, 0x8c, 0x5c, 0xf1, 0xda,
+		0xf1, 0xf8, 0x0b, 0xf7, 0x17, 0x06, 0xb6, 0xf2, 0x69, 0x25, 0xa7, 0x00, 0xb5, 0x61, 0x89, 0x96,
+		0x87, 0x72, 0x5a, 0xdb, 0xfd, 0x7c, 0xd6, 0x92, 0x17, 0xf5, 0x13, 0xc7, 0x61, 0x90, 0x02, 0xcb,
+		0x3e, 0xcd, 0x38, 0x39, 0x0e, 0xcf, 0x2e, 0x17, 0x30, 0xae, 0x16, 0x4e, 0x62, 0x70, 0xbf, 0x62,
+		0xe0, 0x69, 0x3a, 0xd9, 0xb9, 0xb9, 0xa4, 0x36, 0xd7, 0xcf, 0xa0, 0x42, 0xe9, 0x78, 0xc4, 0xa9,
+		0x32, 0xb5, 0x72, 0x7d, 0x6d, 0xf7, 0xf9, 0xe5, 0x78, 0x24, 0x21, 0xf1, 0x79, 0x18, 0xee, 0xaf,
+		0x33, 0x3b, 0x8c, 0x89, 0x1f, 0xda, 0xd7, 0x5f, 0xc9, 0xff, 0xd6, 0x9e, 0xf8, 0x2d, 0x03, 0xcf,
+		0x8a, 0xd4, 0x74, 0x6a, 0xb2, 0xf9, 0x79, 0x72, 0x62, 0x3d, 0xe2, 0xe8, 0x49, 0x8f, 0xe3, 0xda,
+		0x3e, 0xbb, 0x6c, 0x6d, 0xa3, 0x7a, 0xe1, 0x8d, 0x51, 0xb0, 0xb8, 0xe7, 0xdc, 0x37, 0x2b, 0x70,
+		0x3b, 0x9b, 0xc4, 0x68, 0x97, 0x8e, 0x66, 0x76, 0xcb, 0x39, 0x76, 0x93, 0xfa, 0x16, 0x9e, 0xd9,
+		0xa3, 0x8b, 0x46, 0x3c, 0xb3, 0x47, 0x9f, 0xd0, 0xaf, 0x19, 0xd8, 0xf2, 0xa7, 0x27, 0xb9, 0x71,
+		0x9e, 0xd5, 0x52, 0xde, 0x10, 0x9a, 0x1f, 0xbf, 0xc8, 0xa8, 0xdd, 0x5a, 0xc0, 0xf3, 0x17, 0x44,
+		0xbf, 0x64, 0xe0, 0x4e, 0x6f, 0x72, 0xa8, 0x49, 0xd1, 0x29, 0x53, 0x3a, 0xcd, 0x22, 0x74, 0x2e,
+		0x1a, 0x2a, 0x5b, 0x0b, 0x78, 0xf6, 0x42, 0x94, 0x86, 0x3f, 0xf9, 0xca, 0x15, 0xb2, 0xa3, 0x79,
+		0x21, 0x1a, 0x17, 0x8d, 0x5b, 0x11, 0x8d, 0x99, 0x0b, 0xa1, 0x7f, 0x30, 0xf0, 0xdc, 0xbb, 0xca,
+		0x7b, 0x9c, 0xce, 0x34, 0x6b, 0xbb, 0x87, 0x45, 0x28, 0x5e, 0x69, 0x50, 0x68, 0x2d, 0xe0, 0xab,
+		0x31, 0x43, 0xbf, 0x67, 0xe0, 0xb1, 0x51, 0x4c, 0xbb, 0x92, 0x5b, 0xe0, 0xab, 0x22, 0x59, 0x14,
+		0x94, 0xc3, 0xd6, 0x02, 0x2e, 0xba, 0xfa, 0x8b, 0x75, 0x80, 0xf1, 0x7d, 0x8c, 0xfb, 0x43, 0x05,
+		0xaa, 0xd3, 0x67, 0x32, 0x56, 0x86, 0xf4, 0xf5, 0x96, 0x99, 0xb8, 0xde, 0x4e, 0xdc, 0xb0, 0x4b,
+		0xd7, 0x78, 0xc3, 0x2e, 0x67, 0x6f, 0xd8, 0x59, 0x8d, 0x5c, 0xbc, 0x1e, 0x8d, 0x44, 0xdf, 0x5c,
+		0x28, 0x12, 0xf1, 0x5e, 0x93, 0xaf, 0x2c, 0x12, 0x59, 0x75, 0xbd, 0x58, 0x25, 0xbe, 0x9a, 0xab,
+		0x12, 0xf1, 0xae, 0x91, 0xae, 0xa4, 0x12, 0x39, 0x5c, 0xe6, 0xc8, 0xc4, 0x57, 0x73, 0x65, 0x62,
+		0xa5, 0x38, 0x8f, 0x0b, 0xc7, 0xfb, 0xf9, 0x3a, 0xf1, 0xcf, 0x2b, 0xeb, 0x44, 0x85, 0x72, 0x7c,
+		0x7b, 0x7d, 0x3a, 0x91, 0x43, 0xfc, 0x8a, 0x42, 0xf1, 0x47, 0x06, 0xea, 0x46, 0xc1, 0x17, 0x72,
+		0x75, 0x95, 0xe6, 0xa1, 0x7c, 0x88, 0x52, 0xe4, 0x30, 0x2f, 0xbc, 0x7e, 0x46, 0x2b, 0x06, 0x79,
+		0x52, 0x41, 0xf3, 0xf3, 0x91, 0x16, 0xfd, 0xda, 0xe2, 0x9f, 0xea, 0xc9, 0x25, 0x76, 0x34, 0x38,
+		0x34, 0x8a, 0x1e, 0xd4, 0x24, 0x10, 0x5e, 0x0f, 0xc6, 0x5f, 0xfc, 0xed, 0xaf, 0x19, 0xa8, 0x8c,
+		0x34, 0x03, 0xdd, 0x82, 0x4d, 0x4d, 0x50, 0x5f, 0xe9, 0xda, 0x61, 0x57, 0xd2, 0xe5, 0xf6, 0x81,
+		0xa0, 0xc8, 0x4d, 0x76, 0x01, 0x7d, 0x0c, 0x68, 0x6c, 0xd6, 0xb0, 0xd0, 0x56, 0x5f, 0x4a, 0x98,
+		0x65, 0xd0, 0x4d, 0xb8, 0x91, 0xb2, 0xcb, 0xaf, 0x25, 0xcc, 0x96, 0xd0, 0x1d, 0xb8, 0x35, 0x36,
+		0x62, 0xa9, 0xab, 0xc8, 0xa2, 0xa0, 0xc9, 0x9d, 0x36, 0x5b, 0x46, 0xf7, 0xe0, 0xf6, 0xf8, 0x91,
+		0x88, 0x3b, 0xaa, 0xaa, 0x8b, 0xca, 0xbe, 0xaa, 0x49, 0x98, 0x5d, 0xdc, 0xfe, 0x5b, 0xce, 0xef,
+		0x9b, 0x94, 0xd4, 0x27, 0xf0, 0x60, 0x02, 0xab, 0xe7, 0x51, 0xdc, 0x81, 0x4f, 0x67, 0x81, 0x54,
+		0x4d, 0xc0, 0x9a, 0x2e, 0xb6, 0x64, 0xa5, 0xa9, 0x4b, 0x5f, 0x48, 0xe2, 0x3e, 0x65, 0xc3, 0xa0,
+		0x27, 0x50, 0x9f, 0xe5, 0x22, 0x0a, 0x6d, 0x51, 0x52, 0x52, 0xe8, 0xd2, 0x3c, 0xb4, 0x2a, 0xef,
+		0xb5, 0x85, 0x34, 0xba, 0x8c, 0x9a, 0xf0, 0x93, 0x59, 0x68, 0x2c, 0x89, 0x1d, 0xdc, 0x4c, 0xf8,
+		0xbc, 0xe9, 0xe0, 0x57, 0xaf, 0x94, 0xce, 0x9b, 0xb1, 0xb3, 0x2e, 0x76, 0x5e, 0x77, 0x15, 0x49,
+		0x93, 0xd8, 0x45, 0xf4, 0x1c, 0x76, 0x66, 0x45, 0x11, 0xba, 0x5d, 0xe5, 0x50, 0xef, 0x0a, 0x58,
+		0x6a, 0x6b, 0xba, 0xa8, 0x74, 0x54, 0x49, 0xef, 0x76, 0x14, 0x59, 0x3c, 0x64, 0x97, 0xb6, 0x7f,
+		0x57, 0x86, 0x7b, 0x73, 0x54, 0x1a, 0x7d, 0x17, 0x1e, 0xe7, 0x84, 0x7d, 0x29, 0xc8, 0x8a, 0xd4,
+		0xd4, 0x45, 0x61, 0x5f, 0x4d, 0x17, 0x36, 0x9f, 0xc3, 0x04, 0xb8, 0xd9, 0x79, 0x2d, 0xc8, 0x6d,
+		0xbd, 0xdd, 0xd1, 0x74, 0x41, 0xd4, 0xe4, 0x03, 0x89, 0x65, 0x2e, 0xe9, 0x26, 0x7d, 0x21, 0xab,
+		0x9a, 0xca, 0x96, 0xd0, 0x0f, 0xe1, 0xf3, 0x8b, 0xdc, 0xa2, 0x92, 0xbd, 0x8c, 0x4a, 0x26, 0x28,
+		0x58, 0x12, 0x9a, 0x87, 0x3a, 0xde, 0x6f, 0xb7, 0xe5, 0xf6, 0x1e, 0x5b, 0x46, 0x9f, 0xc1, 0xd3,
+		0xc2, 0xde, 0xa9, 0x65, 0x17, 0xd1, 0x8f, 0xe0, 0xfb, 0x97, 0x5e, 0x76, 0xd4, 0xa7, 0x26, 0xbb,
+		0x34, 0x63, 0xf7, 0x4d, 0xf8, 0xef, 0xb7, 0x45, 0x41, 0x93, 0xf6, 0x3a, 0x58, 0x7e, 0x2b, 0x35,
+		0xd9, 0xe5, 0xed, 0x6f, 0x19, 0x40, 0x7b, 0x24, 0xc8, 0xf6, 0xe6, 0x21, 0x6c, 0xed, 0x49, 0xda,
+		0xdc, 0x8e, 0x3c, 0x02, 0x2e, 0x1f, 0xa2, 0x4a, 0xf8, 0x40, 0x16, 0x25, 0xfd, 0xc5, 0xbe, 0x7a,
+		0xc8, 0x32, 0xb3, 0x43, 0x45, 0x27, 0xb5, 0xb3, 0xaf, 0xb1, 0x25, 0xc4, 0xc3, 0xf6, 0x8c, 0x50,
+		0x2d, 0x01, 0x37, 0xf5, 0xce, 0x9b, 0xb6, 0x84, 0xd5, 0x96, 0xdc, 0xd5, 0x95, 0x8e, 0xaa, 0xb1,
+		0x65, 0xf4, 0x18, 0x3e, 0xc9, 0xc7, 0x4f, 0x66, 0xb7, 0xf8, 0xe2, 0xb3, 0xb7, 0xcf, 0x4f, 0xac,
+		0xe0, 0x5d, 0x78, 0xc4, 0xf7, 0xdc, 0x7e, 0x63, 0xe2, 0xdf, 0x0d, 0xfe, 0x84, 0x38, 0xf1, 0xdf,
+		0x29, 0xe3, 0xbf, 0x62, 0x7e, 0x10, 0x7f, 0x3a, 0xdb, 0x39, 0x5a, 0xa6, 0x4f, 0x9e, 0xfe, 0x67,
+		0x00, 0xd8, 0x06, 0x2c, 0x43, 0xb4, 0x19, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/replication.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x73, 0x1a, 0x47,
-		0x13, 0xf6, 0x82, 0xf8, 0x50, 0x0b, 0x01, 0x1a, 0xe9, 0xb5, 0xb0, 0x64, 0xd5, 0x8b, 0x89, 0x6c,
-		0xc9, 0x72, 0x0a, 0x6c, 0xb9, 0x9c, 0xcf, 0x4a, 0xb9, 0xd6, 0x02, 0x95, 0x36, 0xd6, 0x97, 0x87,
-		0xb5, 0x5c, 0xca, 0x21, 0x5b, 0x2b, 0x76, 0x24, 0xb6, 0x04, 0xbb, 0xd4, 0xce, 0x80, 0xcc, 0x31,
-		0xb9, 0xe7, 0x98, 0x5c, 0x72, 0xcc, 0xef, 0xc8, 0x35, 0x67, 0xff, 0xa4, 0xd4, 0xce, 0xcc, 0x02,
-		0x0b, 0x0b, 0x96, 0xcb, 0x87, 0xdc, 0xb4, 0xdd, 0xcf, 0xd3, 0xdd, 0xd3, 0xdd, 0xd3, 0x3d, 0x02,
-		0xb6, 0xbb, 0x17, 0xc4, 0xab, 0x34, 0x4c, 0x8b, 0x38, 0x0d, 0x52, 0xa1, 0x4d, 0xd3, 0x23, 0x56,
-		0xa5, 0xf7, 0xac, 0xe2, 0x91, 0x4e, 0xcb, 0x6e, 0x98, 0xcc, 0x76, 0x9d, 0x72, 0xc7, 0x73, 0x99,
-		0x8b, 0xee, 0xfa, 0xc8, 0xb2, 0x44, 0x96, 0x05, 0xb2, 0xdc, 0x7b, 0xb6, 0xf6, 0xff, 0x2b, 0xd7,
-		0xbd, 0x6a, 0x91, 0x0a, 0x47, 0x5d, 0x74, 0x2f, 0x2b, 0xcc, 0x6e, 0x13, 0xca, 0xcc, 0x76, 0x47,
-		0x10, 0xd7, 0x8a, 0x21, 0x17, 0x66, 0xc7, 0xf6, 0xed, 0x37, 0xdc, 0x76, 0x3b, 0x30, 0x1d, 0x8d,
-		0xb0, 0xdc, 0xb6, 0x69, 0x07, 0x88, 0xcd, 0x29, 0x61, 0x36, 0x6d, 0xca, 0x5c, 0xaf, 0x2f, 0x50,
-		0xa5, 0x3f, 0x62, 0xb0, 0x8c, 0x87, 0x81, 0x1f, 0x11, 0x4a, 0xcd, 0x2b, 0x42, 0x91, 0x0e, 0x4b,
-		0x23, 0xe7, 0x31, 0x98, 0x49, 0xaf, 0x69, 0x41, 0x29, 0xc6, 0xb7, 0x17, 0x76, 0xb7, 0xca, 0xd1,
-		0xc7, 0x2a, 0x8f, 0xd8, 0xd1, 0x4d, 0x7a, 0x8d, 0xf3, 0x5e, 0x58, 0x40, 0xd1, 0xb7, 0x70, 0xaf,
-		0x65, 0x52, 0x66, 0x78, 0x84, 0x79, 0x36, 0xe9, 0x11, 0xcb, 0x68, 0x0b, 0x87, 0x86, 0x6d, 0x15,
-		0x62, 0x45, 0x65, 0x3b, 0x8e, 0xef, 0xfa, 0x00, 0x1c, 0xe8, 0x65, 0x3c, 0x9a, 0x85, 0xee, 0x41,
-		0xba, 0x69, 0x52, 0xa3, 0xed, 0x7a, 0xa4, 0x10, 0x2f, 0x2a, 0xdb, 0x69, 0x9c, 0x6a, 0x9a, 0xf4,
-		0xc8, 0xf5, 0x08, 0xaa, 0xc3, 0x12, 0xed, 0x3b, 0x0d, 0xc3, 0x8f, 0xc4, 0x32, 0x28, 0x33, 0x59,
-		0x97, 0x16, 0xe6, 0x8a, 0xca, 0xac, 0x58, 0xeb, 0x7d, 0xa7, 0x51, 0xf7, 0xf1, 0x75, 0x0e, 0xc7,
-		0x39, 0x1a, 0x16, 0x94, 0x7e, 0x4f, 0x42, 0x6e, 0xec, 0x40, 0xe8, 0x00, 0xe6, 0xfd, 0x44, 0x18,
-		0xac, 0xdf, 0x21, 0x05, 0xa5, 0xa8, 0x6c, 0x67, 0x77, 0x9f, 0xdc, 0x32, 0x19, 0x7a, 0xbf, 0x43,
-		0x70, 0x9a, 0xc9, 0xbf, 0xd0, 0x26, 0x64, 0xa9, 0xdb, 0xf5, 0x1a, 0x84, 0x67, 0x76, 0x78, 0xfa,
-		0x8c, 0x90, 0xfa, 0x0c, 0xcd, 0x42, 0x2f, 0x61, 0xb1, 0xe1, 0x11, 0x59, 0x01, 0xbb, 0x2d, 0x0e,
-		0xbe, 0xb0, 0xbb, 0x56, 0x16, 0xfd, 0x53, 0x0e, 0xfa, 0xa7, 0xac, 0x07, 0xfd, 0x83, 0x33, 0x01,
-		0xc1, 0x17, 0x21, 0x0b, 0xee, 0x8a, 0x9e, 0x10, 0x6e, 0x4c, 0xc6, 0x3c, 0xfb, 0xa2, 0xcb, 0x48,
-		0x90, 0x9e, 0x2f, 0xa7, 0x45, 0x5f, 0xe5, 0x2c, 0x3f, 0x0c, 0x75, 0xc0, 0x39, 0xb8, 0x83, 0x57,
-		0xac, 0x08, 0x39, 0xfa, 0x45, 0x81, 0x07, 0x13, 0x05, 0x98, 0xf0, 0x98, 0xe0, 0x1e, 0x5f, 0xdc,
-		0xb2, 0x20, 0x13, 0xae, 0x37, 0xe8, 0x2c, 0x00, 0xba, 0x01, 0x0e, 0x30, 0xcc, 0x06, 0xb3, 0x7b,
-		0x36, 0xeb, 0x4f, 0xb8, 0x4f, 0x72, 0xf7, 0xbb, 0xb3, 0xdc, 0xab, 0x92, 0x3b, 0xe1, 0x7b, 0x8d,
-		0x4e, 0xd5, 0x22, 0x07, 0xd6, 0xe4, 0x8d, 0x12, 0x2e, 0x7b, 0xbb, 0xa3, 0x5e, 0x53, 0xdc, 0x6b,
-		0x65, 0x9a, 0xd7, 0x03, 0xc1, 0xf4, 0x4d, 0x9e, 0xed, 0x86, 0x5c, 0xae, 0x36, 0xa3, 0x55, 0xa8,
-		0x03, 0x6b, 0x97, 0xa6, 0xdd, 0x72, 0x7b, 0xc4, 0x33, 0xda, 0xa6, 0x77, 0x4d, 0xbc, 0x51, 0x7f,
-		0x69, 0xee, 0xef, 0xe9, 0x34, 0x7f, 0xfb, 0x92, 0x79, 0xc4, 0x89, 0x21, 0x87, 0x85, 0xcb, 0x29,
-		0xba, 0x57, 0x19, 0x80, 0xa1, 0x87, 0xd2, 0xdf, 0x31, 0x58, 0x89, 0xea, 0x0e, 0x84, 0x21, 0x2f,
-		0x7b, 0xcd, 0xed, 0x10, 0x8f, 0xf7, 0xa0, 0xbc, 0x23, 0x5b, 0xb3, 0xbb, 0xec, 0x24, 0x80, 0xe3,
-		0x9c, 0x15, 0x16, 0xa0, 0x2c, 0xc4, 0xe4, 0xd5, 0x98, 0xc7, 0x31, 0xdb, 0x42, 0xcf, 0x21, 0x29,
-		0x20, 0xf2, 0x26, 0xac, 0x87, 0x2d, 0x9b, 0x1d, 0x7b, 0x68, 0x16, 0x4b, 0x28, 0x7a, 0x08, 0xd9,
-		0x86, 0xeb, 0x5c, 0xda, 0x57, 0x46, 0x8f, 0x78, 0xd4, 0x0f, 0x6b, 0x8e, 0xdf, 0xb5, 0x45, 0x21,
-		0x3d, 0x13, 0x42, 0xf4, 0x18, 0xf2, 0x83, 0xc4, 0x06, 0xc0, 0x04, 0x07, 0xe6, 0x02, 0x79, 0x00,
-		0xfd, 0x0e, 0xee, 0x75, 0x3c, 0xd2, 0xb3, 0xdd, 0x2e, 0x35, 0x26, 0x38, 0x49, 0xce, 0x59, 0x0d,
-		0x00, 0xfb, 0x61, 0x6e, 0xe9, 0x4f, 0x05, 0x36, 0x66, 0xf6, 0xba, 0x1f, 0xaf, 0x9c, 0x0d, 0x8d,
-		0x56, 0x97, 0x32, 0xe2, 0xf1, 0x34, 0xce, 0xe3, 0x45, 0x21, 0xdd, 0x13, 0x42, 0x7f, 0x20, 0x8a,
-		0xfb, 0x26, 0x33, 0x94, 0xc0, 0x29, 0xfe, 0xad, 0x59, 0xe8, 0x1b, 0x98, 0x1f, 0x6c, 0x94, 0x5b,
-		0xcc, 0x8c, 0x21, 0xb8, 0xf4, 0x21, 0x01, 0x6b, 0xd3, 0xaf, 0x02, 0x5a, 0x87, 0x79, 0x59, 0x63,
-		0xdb, 0x92, 0x51, 0xa5, 0x85, 0x40, 0xb3, 0xd0, 0x5b, 0x40, 0x37, 0xae, 0x77, 0x7d, 0xd9, 0x72,
-		0x6f, 0x0c, 0xf2, 0x9e, 0x34, 0xba, 0xbc, 0x05, 0x62, 0xdc, 0xfd, 0xa3, 0xc8, 0x42, 0xbd, 0x93,
-		0xf0, 0x5a, 0x80, 0xc6, 0x4b, 0x37, 0xe3, 0x22, 0x54, 0x80, 0x54, 0x90, 0xda, 0x38, 0x4f, 0x6d,
-		0xf0, 0x89, 0x1e, 0x40, 0x86, 0x36, 0x9a, 0xc4, 0xea, 0xb6, 0x08, 0xcf, 0x82, 0x28, 0xeb, 0xc2,
-		0x40, 0xa6, 0x59, 0x48, 0x85, 0xec, 0x10, 0xc2, 0x47, 0x68, 0xe2, 0xa3, 0xe9, 0x58, 0x1c, 0x30,
-		0xf8, 0x0c, 0xdd, 0x00, 0xa0, 0xcc, 0xf4, 0x98, 0xf0, 0x21, 0xaa, 0x3b, 0x2f, 0x25, 0x9a, 0x85,
-		0x7e, 0x80, 0x4c, 0xa0, 0xe6, 0xf6, 0x53, 0x1f, 0xb5, 0xbf, 0x20, 0xf1, 0xdc, 0xfa, 0x8f, 0xb0,
-		0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26, 0x13, 0x56, 0xd2, 0x1f, 0xb5, 0xb2, 0xe4,
-		0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x15, 0xa4, 0x2c, 0xc2, 0x4c, 0xbb, 0x45, 0x0b, 0xf3, 0x9c,
-		0x7f, 0x3f, 0x32, 0xeb, 0xa7, 0x66, 0xbf, 0xe5, 0x9a, 0x16, 0x0e, 0xc0, 0x7e, 0x86, 0x4d, 0xc6,
-		0x48, 0xbb, 0xc3, 0x0a, 0x20, 0x1a, 0x49, 0x7e, 0xa2, 0x97, 0x90, 0xe1, 0xd1, 0xf9, 0x4d, 0xde,
-		0xf5, 0x48, 0x61, 0x61, 0x86, 0xd9, 0x7d, 0x81, 0xc1, 0x0b, 0x3e, 0x43, 0x7e, 0xa0, 0xa7, 0xb0,
-		0xc2, 0x0d, 0xf8, 0x65, 0x25, 0x9e, 0x61, 0x5b, 0xc4, 0x61, 0x36, 0xeb, 0x17, 0x32, 0xbc, 0x77,
-		0x90, 0xaf, 0x7b, 0xc7, 0x55, 0x9a, 0xd4, 0xa0, 0x13, 0xc8, 0xc9, 0xfa, 0x1a, 0x72, 0x04, 0x16,
-		0x16, 0xa3, 0x5a, 0x68, 0x38, 0x45, 0xe4, 0xcd, 0x92, 0xb3, 0x14, 0x67, 0x7b, 0xa1, 0xef, 0xd2,
-		0xaf, 0x71, 0x58, 0x9d, 0x32, 0x67, 0xd1, 0x2a, 0xa4, 0x82, 0xfd, 0xab, 0xf0, 0xc2, 0x26, 0x99,
-		0xd8, 0xbc, 0xa1, 0x46, 0x8f, 0xdd, 0xaa, 0xd1, 0xe3, 0x9f, 0xdb, 0xe8, 0x3f, 0xc3, 0xff, 0xc6,
-		0x4e, 0x6e, 0xd8, 0x8c, 0xb4, 0xfd, 0x5d, 0xed, 0x3f, 0xbb, 0x76, 0x6e, 0x77, 0x7e, 0x8d, 0x91,
-		0x36, 0x5e, 0xee, 0x4d, 0xc8, 0x28, 0x7a, 0x01, 0x49, 0xd2, 0x23, 0x0e, 0x0b, 0x56, 0xf1, 0x46,
-		0xf4, 0xf0, 0x34, 0x99, 0xf9, 0xaa, 0xe5, 0x5e, 0x60, 0x09, 0x46, 0x7b, 0x90, 0x75, 0xc8, 0x8d,
-		0xe1, 0x75, 0x1d, 0x43, 0xd2, 0x93, 0xb7, 0xa1, 0x67, 0x1c, 0x72, 0x83, 0xbb, 0x4e, 0x8d, 0x53,
-		0x4a, 0x7f, 0x29, 0x50, 0x98, 0xb6, 0x7c, 0x66, 0x4f, 0x95, 0xa8, 0xb1, 0x1c, 0x8b, 0x1e, 0xcb,
-		0x9f, 0xfb, 0x5c, 0x2a, 0xfd, 0xa6, 0xc0, 0x72, 0x38, 0x4a, 0xdd, 0xbd, 0x26, 0x8e, 0x1f, 0x60,
-		0x30, 0x6a, 0xc5, 0x23, 0x38, 0x81, 0xd3, 0x72, 0xd6, 0x52, 0x74, 0x0e, 0xb9, 0xb1, 0x85, 0x2c,
-		0x67, 0xde, 0x27, 0x6f, 0x61, 0x9c, 0x0d, 0xef, 0xe0, 0xd2, 0x3f, 0xe1, 0xc7, 0x39, 0x7f, 0x15,
-		0x3a, 0x97, 0xee, 0x7f, 0x32, 0x86, 0xd7, 0x47, 0xdf, 0xbe, 0x71, 0x3e, 0x26, 0x86, 0xcf, 0xd9,
-		0x91, 0x7b, 0x34, 0x17, 0xba, 0x47, 0x23, 0xc3, 0x3b, 0x11, 0x1e, 0xde, 0x9b, 0x90, 0xbd, 0xb4,
-		0x3d, 0xca, 0x44, 0x53, 0x0d, 0x47, 0x6b, 0x86, 0x4b, 0x79, 0xdb, 0x68, 0x16, 0x2a, 0xc1, 0xa2,
-		0x43, 0xde, 0x8f, 0x80, 0x52, 0x62, 0xc6, 0xfb, 0xc2, 0x00, 0x33, 0xbe, 0x06, 0xd2, 0x13, 0x6b,
-		0xc0, 0x6f, 0xbf, 0xfc, 0x68, 0x22, 0x79, 0x55, 0x47, 0x17, 0xa8, 0x12, 0x5e, 0xa0, 0x9f, 0xf1,
-		0x7f, 0x4a, 0x40, 0xed, 0x78, 0x6e, 0x83, 0x50, 0x1a, 0xa6, 0xc6, 0x87, 0xd4, 0xd3, 0x40, 0x3f,
-		0xa0, 0x96, 0x5e, 0x43, 0x6e, 0xec, 0x65, 0x10, 0xde, 0xe4, 0xca, 0x27, 0x6c, 0xf2, 0x9d, 0x0f,
-		0x93, 0xbd, 0xc3, 0x4b, 0xf5, 0x00, 0x36, 0x70, 0xed, 0xf4, 0x50, 0xdb, 0x53, 0x75, 0xed, 0xe4,
-		0xd8, 0xd0, 0xd5, 0xfa, 0x6b, 0x43, 0x3f, 0x3f, 0xad, 0x19, 0xda, 0xf1, 0x99, 0x7a, 0xa8, 0x55,
-		0xf3, 0x77, 0x50, 0x11, 0xee, 0x47, 0x43, 0xaa, 0x27, 0x47, 0xaa, 0x76, 0x9c, 0x57, 0xa6, 0x1b,
-		0x39, 0xd0, 0xea, 0xfa, 0x09, 0x3e, 0xcf, 0xc7, 0xd0, 0x13, 0xd8, 0x8a, 0x86, 0xd4, 0xcf, 0x8f,
-		0xf7, 0x8c, 0xfa, 0x81, 0x8a, 0xab, 0x46, 0x5d, 0x57, 0xf5, 0xb7, 0xf5, 0x7c, 0x1c, 0x6d, 0xc1,
-		0x17, 0x33, 0xc0, 0xea, 0x9e, 0xae, 0x9d, 0x69, 0xfa, 0x79, 0x7e, 0x0e, 0xed, 0xc0, 0xa3, 0x99,
-		0x8e, 0x8d, 0xa3, 0x9a, 0xae, 0x56, 0x55, 0x5d, 0xcd, 0x27, 0xd0, 0x26, 0x14, 0x67, 0x63, 0xcf,
-		0x76, 0xf3, 0x49, 0xf4, 0x18, 0x1e, 0x46, 0xa3, 0xf6, 0x55, 0xed, 0xf0, 0xe4, 0xac, 0x86, 0x8d,
-		0x23, 0x15, 0xbf, 0xae, 0xe1, 0x7c, 0x6a, 0xc7, 0x86, 0xdc, 0xd8, 0x8b, 0x15, 0xdd, 0x87, 0x82,
-		0x48, 0x8a, 0x71, 0x72, 0x5a, 0xc3, 0xc2, 0xc4, 0x30, 0x91, 0xeb, 0xb0, 0x3a, 0xa1, 0xdd, 0xc3,
-		0x35, 0x55, 0xaf, 0xe5, 0x95, 0x48, 0xe5, 0xdb, 0xd3, 0xaa, 0xaf, 0x8c, 0xed, 0x1c, 0x43, 0xaa,
-		0x7a, 0xf8, 0x86, 0x17, 0x6c, 0x05, 0xf2, 0xd5, 0xc3, 0x37, 0xe3, 0x35, 0x2a, 0xc0, 0xca, 0x40,
-		0x3a, 0x12, 0x7f, 0x5e, 0x41, 0xcb, 0x90, 0x1b, 0x68, 0x64, 0xc1, 0x62, 0xaf, 0xbe, 0xfe, 0xe9,
-		0xc5, 0x95, 0xcd, 0x9a, 0xdd, 0x8b, 0x72, 0xc3, 0x6d, 0x57, 0x42, 0xbf, 0x0c, 0x94, 0xaf, 0x88,
-		0x23, 0x7e, 0x89, 0x18, 0xfe, 0x48, 0xf0, 0xbd, 0xf8, 0xab, 0xf7, 0xec, 0x22, 0xc9, 0x35, 0xcf,
-		0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x50, 0xe1, 0x45, 0xf5, 0x10, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0xdb, 0xc8,
+		0x11, 0x5e, 0x90, 0x16, 0x49, 0xb5, 0x28, 0x12, 0x1e, 0xc9, 0x16, 0x2c, 0x5b, 0x15, 0x99, 0xf6,
+		0xae, 0xb5, 0xda, 0x14, 0x65, 0x6b, 0xcb, 0xf9, 0xad, 0x64, 0x0b, 0x26, 0xa9, 0x12, 0x62, 0xfd,
+		0x70, 0x87, 0xb0, 0xb6, 0xb4, 0x87, 0xa0, 0x46, 0xc0, 0x48, 0x42, 0x91, 0x04, 0x58, 0xc0, 0x90,
+		0x5a, 0x1e, 0xf3, 0x00, 0x39, 0x26, 0x97, 0x1c, 0x53, 0x79, 0x95, 0xdc, 0x52, 0xe5, 0x07, 0xc9,
+		0x43, 0xa4, 0xe6, 0x07, 0x24, 0xc1, 0x3f, 0x2b, 0xf1, 0x21, 0x37, 0x4e, 0xf7, 0xd7, 0xdd, 0x33,
+		0x3d, 0x5f, 0x4f, 0x37, 0x08, 0x7b, 0xfd, 0x2b, 0x1a, 0x1d, 0xb8, 0xc4, 0xa3, 0x81, 0x4b, 0x0f,
+		0xe2, 0x5b, 0x12, 0x51, 0xef, 0x60, 0xf0, 0xe6, 0x20, 0xa2, 0xbd, 0x8e, 0xef, 0x12, 0xe6, 0x87,
+		0x41, 0xb5, 0x17, 0x85, 0x2c, 0x44, 0x8f, 0x39, 0xb2, 0xaa, 0x90, 0x55, 0x89, 0xac, 0x0e, 0xde,
+		0x6c, 0xff, 0xec, 0x26, 0x0c, 0x6f, 0x3a, 0xf4, 0x40, 0xa0, 0xae, 0xfa, 0xd7, 0x07, 0xcc, 0xef,
+		0xd2, 0x98, 0x91, 0x6e, 0x4f, 0x1a, 0x6e, 0xef, 0xa6, 0x42, 0x90, 0x9e, 0xcf, 0xfd, 0xbb, 0x61,
+		0xb7, 0x1b, 0x06, 0xcb, 0x10, 0x5e, 0xd8, 0x25, 0x7e, 0x82, 0x78, 0xb1, 0x60, 0x9b, 0x29, 0xd0,
+		0xcb, 0x05, 0xa0, 0x5b, 0x3f, 0x66, 0x61, 0x34, 0x94, 0xa8, 0xca, 0x5f, 0x33, 0xb0, 0x81, 0xc7,
+		0xa7, 0x3b, 0xa5, 0x71, 0x4c, 0x6e, 0x68, 0x8c, 0x6c, 0x78, 0x38, 0x71, 0x68, 0x87, 0x91, 0xb8,
+		0x1d, 0x1b, 0xda, 0x6e, 0x76, 0x6f, 0xed, 0xf0, 0x55, 0x75, 0xfe, 0xd9, 0xab, 0x13, 0x7e, 0x6c,
+		0x12, 0xb7, 0xb1, 0x1e, 0xa5, 0x05, 0x31, 0xfa, 0x35, 0x3c, 0xe9, 0x90, 0x98, 0x39, 0x11, 0x65,
+		0x91, 0x4f, 0x07, 0xd4, 0x73, 0xba, 0x32, 0xa0, 0xe3, 0x7b, 0x46, 0x66, 0x57, 0xdb, 0xcb, 0xe2,
+		0xc7, 0x1c, 0x80, 0x13, 0xbd, 0xda, 0x8f, 0xe5, 0xa1, 0x27, 0x50, 0xb8, 0x25, 0xb1, 0xd3, 0x0d,
+		0x23, 0x6a, 0x64, 0x77, 0xb5, 0xbd, 0x02, 0xce, 0xdf, 0x92, 0xf8, 0x34, 0x8c, 0x28, 0x6a, 0xc1,
+		0xc3, 0x78, 0x18, 0xb8, 0x0e, 0xdf, 0x89, 0xe7, 0xc4, 0x8c, 0xb0, 0x7e, 0x6c, 0x3c, 0xd8, 0xd5,
+		0x96, 0xed, 0xb5, 0x35, 0x0c, 0xdc, 0x16, 0xc7, 0xb7, 0x04, 0x1c, 0x97, 0xe3, 0xb4, 0xa0, 0xf2,
+		0x97, 0x1c, 0x94, 0xa7, 0x0e, 0x84, 0x8e, 0x61, 0x95, 0x27, 0xc2, 0x61, 0xc3, 0x1e, 0x35, 0xb4,
+		0x5d, 0x6d, 0xaf, 0x74, 0xf8, 0xcd, 0x3d, 0x93, 0x61, 0x0f, 0x7b, 0x14, 0x17, 0x98, 0xfa, 0x85,
+		0x5e, 0x42, 0x29, 0x0e, 0xfb, 0x91, 0x4b, 0x45, 0x66, 0xc7, 0xa7, 0x2f, 0x4a, 0x29, 0xb7, 0xb0,
+		0x3c, 0xf4, 0x1d, 0xac, 0xbb, 0x11, 0x55, 0x37, 0xe0, 0x77, 0xe5, 0xc1, 0xd7, 0x0e, 0xb7, 0xab,
+		0x92, 0x64, 0xd5, 0x84, 0x64, 0x55, 0x3b, 0x21, 0x19, 0x2e, 0x26, 0x06, 0x5c, 0x84, 0x3c, 0x78,
+		0x2c, 0x39, 0x21, 0xc3, 0x10, 0xc6, 0x22, 0xff, 0xaa, 0xcf, 0x68, 0x92, 0x9e, 0x9f, 0x2f, 0xda,
+		0x7d, 0x5d, 0x58, 0xf1, 0x6d, 0x98, 0x23, 0x9b, 0xe3, 0x2f, 0xf0, 0xa6, 0x37, 0x47, 0x8e, 0xfe,
+		0xa4, 0xc1, 0xf3, 0x99, 0x0b, 0x98, 0x89, 0xb8, 0x22, 0x22, 0xbe, 0xbd, 0xe7, 0x85, 0xcc, 0x84,
+		0xde, 0x89, 0x97, 0x01, 0xd0, 0x1d, 0x08, 0x80, 0x43, 0x5c, 0xe6, 0x0f, 0x7c, 0x36, 0x9c, 0x09,
+		0x9f, 0x13, 0xe1, 0x0f, 0x97, 0x85, 0x37, 0x95, 0xed, 0x4c, 0xec, 0xed, 0x78, 0xa1, 0x16, 0x05,
+		0xb0, 0xad, 0x2a, 0x4a, 0x86, 0x1c, 0x1c, 0x4e, 0x46, 0xcd, 0x8b, 0xa8, 0x07, 0x8b, 0xa2, 0x1e,
+		0x4b, 0x4b, 0xee, 0xf2, 0xe2, 0x30, 0x15, 0x72, 0xeb, 0x76, 0xbe, 0x0a, 0xf5, 0x60, 0xfb, 0x9a,
+		0xf8, 0x9d, 0x70, 0x40, 0x23, 0xa7, 0x4b, 0xa2, 0x36, 0x8d, 0x26, 0xe3, 0x15, 0x44, 0xbc, 0xd7,
+		0x8b, 0xe2, 0x1d, 0x29, 0xcb, 0x53, 0x61, 0x98, 0x0a, 0x68, 0x5c, 0x2f, 0xd0, 0xbd, 0x2b, 0x02,
+		0x8c, 0x23, 0x54, 0xfe, 0x91, 0x85, 0xcd, 0x79, 0xec, 0x40, 0x18, 0x74, 0xc5, 0xb5, 0xb0, 0x47,
+		0x23, 0xc1, 0x41, 0x55, 0x23, 0xaf, 0x96, 0xb3, 0xec, 0x3c, 0x81, 0xe3, 0xb2, 0x97, 0x16, 0xa0,
+		0x12, 0x64, 0x54, 0x69, 0xac, 0xe2, 0x8c, 0xef, 0xa1, 0x6f, 0x21, 0x27, 0x21, 0xaa, 0x12, 0x9e,
+		0xa6, 0x3d, 0x93, 0x9e, 0x3f, 0x76, 0x8b, 0x15, 0x14, 0x7d, 0x09, 0x25, 0x37, 0x0c, 0xae, 0xfd,
+		0x1b, 0x67, 0x40, 0xa3, 0x98, 0x6f, 0xeb, 0x81, 0xa8, 0xb5, 0x75, 0x29, 0xbd, 0x90, 0x42, 0xf4,
+		0x35, 0xe8, 0xa3, 0xc4, 0x26, 0xc0, 0x15, 0x01, 0x2c, 0x27, 0xf2, 0x04, 0xfa, 0x1b, 0x78, 0xd2,
+		0x8b, 0xe8, 0xc0, 0x0f, 0xfb, 0xb1, 0x33, 0x63, 0x93, 0x13, 0x36, 0x5b, 0x09, 0xe0, 0x68, 0xca,
+		0xf6, 0x1a, 0x1e, 0x11, 0xc1, 0xd4, 0xbb, 0x30, 0x6a, 0x5f, 0x77, 0xc2, 0x3b, 0x47, 0x6e, 0xc3,
+		0xc8, 0x2f, 0x27, 0xa8, 0xc9, 0x8d, 0x7e, 0x50, 0x36, 0x35, 0x61, 0xd2, 0x57, 0x69, 0xdb, 0x20,
+		0xb3, 0xba, 0xca, 0xdf, 0x34, 0xd8, 0x59, 0x5a, 0x53, 0x3c, 0x2f, 0xea, 0x0d, 0x72, 0x3b, 0xfd,
+		0x98, 0xd1, 0x48, 0x5c, 0xd7, 0x2a, 0x5e, 0x97, 0xd2, 0x9a, 0x14, 0xf2, 0x87, 0x57, 0xd6, 0xb5,
+		0xba, 0x89, 0x15, 0x9c, 0x17, 0x6b, 0xcb, 0x43, 0xbf, 0x82, 0xd5, 0x51, 0x7b, 0xbb, 0xc7, 0xdb,
+		0x34, 0x06, 0x57, 0x3e, 0xae, 0xc0, 0xf6, 0xe2, 0x92, 0x43, 0x4f, 0x61, 0x55, 0x71, 0xc9, 0xf7,
+		0xd4, 0xae, 0x0a, 0x52, 0x60, 0x79, 0xe8, 0x03, 0xa0, 0x51, 0xee, 0xe8, 0x4f, 0xd4, 0xed, 0x0b,
+		0xaa, 0x65, 0x44, 0xf8, 0xaf, 0xe6, 0x12, 0x22, 0x49, 0x4d, 0x23, 0x41, 0xe3, 0x87, 0x77, 0xd3,
+		0x22, 0x64, 0x40, 0x3e, 0xb9, 0xc2, 0xac, 0xb8, 0xc2, 0x64, 0x89, 0x9e, 0x43, 0x31, 0x76, 0x6f,
+		0xa9, 0xd7, 0xef, 0x50, 0x91, 0x05, 0x49, 0x9f, 0xb5, 0x91, 0xcc, 0xf2, 0x90, 0x09, 0xa5, 0x31,
+		0x44, 0x3c, 0xd5, 0x2b, 0x9f, 0x4c, 0xc7, 0xfa, 0xc8, 0x82, 0xcb, 0xd0, 0x0e, 0x40, 0xcc, 0x48,
+		0xc4, 0x64, 0x0c, 0xc9, 0xa2, 0x55, 0x25, 0xb1, 0x3c, 0xf4, 0x3b, 0x28, 0x26, 0x6a, 0xe1, 0x3f,
+		0xff, 0x49, 0xff, 0x6b, 0x0a, 0x2f, 0xbc, 0xff, 0x01, 0x36, 0x44, 0xe7, 0xbd, 0xa5, 0x24, 0x62,
+		0x57, 0x94, 0x30, 0xe9, 0xa5, 0xf0, 0x49, 0x2f, 0x0f, 0xb9, 0xd9, 0x71, 0x62, 0x25, 0x7c, 0xfd,
+		0x02, 0xf2, 0x1e, 0x65, 0xc4, 0xef, 0xc4, 0xc6, 0xaa, 0xb0, 0x7f, 0x36, 0x37, 0xeb, 0x4d, 0x32,
+		0xec, 0x84, 0xc4, 0xc3, 0x09, 0x98, 0x67, 0x98, 0x30, 0x46, 0xbb, 0x3d, 0x66, 0x80, 0x24, 0x92,
+		0x5a, 0xa2, 0xef, 0xa0, 0x28, 0x76, 0xc7, 0x8b, 0xa9, 0x1f, 0x51, 0x63, 0x6d, 0x89, 0xdb, 0x23,
+		0x89, 0xc1, 0x6b, 0xdc, 0x42, 0x2d, 0xd0, 0x6b, 0xd8, 0x14, 0x0e, 0xf8, 0xb5, 0xd2, 0xc8, 0xf1,
+		0x3d, 0x1a, 0x30, 0x9f, 0x0d, 0x8d, 0xa2, 0xe0, 0x0e, 0xe2, 0xba, 0x1f, 0x84, 0xca, 0x52, 0x1a,
+		0x74, 0x0e, 0x65, 0x75, 0xbf, 0x8e, 0x7a, 0x6a, 0x8d, 0xf5, 0x79, 0x14, 0x1a, 0x57, 0xa0, 0xaa,
+		0x60, 0xf5, 0x66, 0xe3, 0xd2, 0x20, 0xb5, 0xae, 0xfc, 0x2b, 0x0b, 0x5b, 0x0b, 0xde, 0x73, 0xb4,
+		0x05, 0xf9, 0xa4, 0xcf, 0x6b, 0xe2, 0x62, 0x73, 0x4c, 0x76, 0xf8, 0x14, 0xd1, 0x33, 0xf7, 0x22,
+		0x7a, 0xf6, 0x73, 0x89, 0xfe, 0x47, 0x78, 0x34, 0x75, 0x72, 0xc7, 0x67, 0xb4, 0xcb, 0x67, 0x02,
+		0x3e, 0xde, 0xed, 0xdf, 0xef, 0xfc, 0x16, 0xa3, 0x5d, 0xbc, 0x31, 0x98, 0x91, 0xc5, 0xe8, 0x2d,
+		0xe4, 0xe8, 0x80, 0x06, 0x2c, 0x69, 0xf9, 0x3b, 0xf3, 0x1f, 0x69, 0xc2, 0xc8, 0xbb, 0x4e, 0x78,
+		0x85, 0x15, 0x18, 0xd5, 0xa0, 0x14, 0xd0, 0x3b, 0x27, 0xea, 0x07, 0x8e, 0x32, 0xcf, 0xdd, 0xc7,
+		0xbc, 0x18, 0xd0, 0x3b, 0xdc, 0x0f, 0x1a, 0xd2, 0x89, 0x05, 0x6b, 0x6e, 0xd8, 0xed, 0x45, 0x34,
+		0x16, 0x85, 0x9c, 0x5f, 0xde, 0x7f, 0x6a, 0x63, 0xa8, 0x98, 0xcf, 0x26, 0x6d, 0x2b, 0x7f, 0xd7,
+		0xc0, 0x58, 0xd4, 0x2f, 0x97, 0x3f, 0x50, 0xf3, 0x3a, 0x49, 0x66, 0x7e, 0x27, 0xf9, 0xdc, 0x09,
+		0xaf, 0xf2, 0x67, 0x0d, 0x36, 0xd2, 0xbb, 0xb4, 0xc3, 0x36, 0x0d, 0xf8, 0x06, 0x93, 0x57, 0x5b,
+		0xce, 0xed, 0x2b, 0xb8, 0xa0, 0x9e, 0xed, 0x18, 0x5d, 0x42, 0x79, 0x6a, 0x86, 0x30, 0x32, 0xff,
+		0xdb, 0xe0, 0x80, 0x4b, 0xe9, 0xb1, 0xa1, 0xf2, 0xcf, 0xf4, 0xf7, 0x84, 0x18, 0x64, 0x83, 0xeb,
+		0xf0, 0xff, 0xf2, 0xa2, 0x3f, 0x9d, 0x1c, 0xd7, 0xb3, 0xe2, 0xc5, 0x19, 0x4f, 0xe0, 0x13, 0x25,
+		0xf9, 0x20, 0x55, 0x92, 0x13, 0x7d, 0x60, 0x25, 0xdd, 0x07, 0x5e, 0x42, 0xe9, 0xda, 0x8f, 0x62,
+		0x26, 0xf9, 0x39, 0x7e, 0xa5, 0x8b, 0x42, 0x2a, 0x18, 0x68, 0x79, 0xa8, 0x02, 0xeb, 0x01, 0xfd,
+		0x69, 0x02, 0x94, 0x97, 0xed, 0x82, 0x0b, 0x13, 0xcc, 0x74, 0x47, 0x29, 0xcc, 0x74, 0x14, 0x4e,
+		0x3f, 0x7d, 0x32, 0x91, 0xe2, 0x56, 0x27, 0x7b, 0xb1, 0x96, 0xee, 0xc5, 0x9f, 0xf1, 0x69, 0x95,
+		0x98, 0xf6, 0xa2, 0xd0, 0xa5, 0x71, 0x9c, 0x36, 0xcd, 0x8e, 0x4d, 0x9b, 0x89, 0x7e, 0x64, 0x5a,
+		0x79, 0x0f, 0xe5, 0xa9, 0x21, 0x23, 0x3d, 0x14, 0x68, 0xff, 0xcd, 0x50, 0xf0, 0x6f, 0x2d, 0xc5,
+		0x1d, 0xd3, 0x6d, 0x73, 0x97, 0x94, 0x67, 0x4b, 0x4d, 0x28, 0x4e, 0x40, 0xba, 0x54, 0xd1, 0x67,
+		0x4d, 0xc9, 0xce, 0x48, 0x97, 0xf2, 0xab, 0x26, 0x6e, 0xdb, 0xe9, 0xd0, 0x01, 0xed, 0xa8, 0xd3,
+		0x16, 0x88, 0xdb, 0x3e, 0xe1, 0x6b, 0xf4, 0x7b, 0x58, 0x17, 0xe7, 0xe3, 0x88, 0x7b, 0x16, 0x99,
+		0x68, 0x2e, 0xa6, 0xdb, 0xe6, 0x12, 0xf4, 0x02, 0xd6, 0x7b, 0x34, 0xf0, 0xfc, 0xe0, 0x46, 0x7d,
+		0x07, 0x4b, 0xc2, 0x14, 0x95, 0x50, 0x7e, 0xda, 0xbe, 0x86, 0xcd, 0x14, 0xc8, 0x71, 0x49, 0xaf,
+		0x47, 0x3d, 0xc1, 0xa1, 0x02, 0x46, 0x93, 0xd8, 0x9a, 0xd0, 0xec, 0x7f, 0x9c, 0x2d, 0x15, 0xc1,
+		0xcc, 0xe7, 0xb0, 0x83, 0x1b, 0xcd, 0x13, 0xab, 0x66, 0xda, 0xd6, 0xf9, 0x99, 0x63, 0x9b, 0xad,
+		0xf7, 0x8e, 0x7d, 0xd9, 0x6c, 0x38, 0xd6, 0xd9, 0x85, 0x79, 0x62, 0xd5, 0xf5, 0x2f, 0xd0, 0x2e,
+		0x3c, 0x9b, 0x0f, 0xa9, 0x9f, 0x9f, 0x9a, 0xd6, 0x99, 0xae, 0x2d, 0x76, 0x72, 0x6c, 0xb5, 0xec,
+		0x73, 0x7c, 0xa9, 0x67, 0xd0, 0x37, 0xf0, 0x6a, 0x3e, 0xa4, 0x75, 0x79, 0x56, 0x73, 0x5a, 0xc7,
+		0x26, 0xae, 0x3b, 0x2d, 0xdb, 0xb4, 0x3f, 0xb4, 0xf4, 0x2c, 0x7a, 0x05, 0x2f, 0x96, 0x80, 0xcd,
+		0x9a, 0x6d, 0x5d, 0x58, 0xf6, 0xa5, 0xfe, 0x00, 0xed, 0xc3, 0x57, 0x4b, 0x03, 0x3b, 0xa7, 0x0d,
+		0xdb, 0xac, 0x9b, 0xb6, 0xa9, 0xaf, 0xa0, 0x97, 0xb0, 0xbb, 0x1c, 0x7b, 0x71, 0xa8, 0xe7, 0xd0,
+		0xd7, 0xf0, 0xe5, 0x7c, 0xd4, 0x91, 0x69, 0x9d, 0x9c, 0x5f, 0x34, 0xb0, 0x73, 0x6a, 0xe2, 0xf7,
+		0x0d, 0xac, 0xe7, 0xf7, 0x6f, 0xa0, 0x3c, 0xf5, 0xa6, 0xa3, 0x67, 0x60, 0xd4, 0xce, 0x4f, 0x9b,
+		0xb8, 0xd1, 0x6a, 0x09, 0xeb, 0x74, 0x22, 0x9f, 0xc2, 0xd6, 0x8c, 0xb6, 0x75, 0x66, 0x36, 0x9b,
+		0x97, 0xba, 0x86, 0x9e, 0xc0, 0xa3, 0x19, 0xe5, 0x8f, 0x2d, 0xbb, 0xae, 0x67, 0xf6, 0x7d, 0x28,
+		0x4f, 0x7d, 0xbc, 0xf0, 0x40, 0x32, 0xfb, 0xce, 0x79, 0xb3, 0x81, 0xe5, 0x5e, 0x53, 0x81, 0x66,
+		0xb4, 0x35, 0xdc, 0x30, 0xed, 0x86, 0xae, 0xcd, 0x55, 0x7e, 0x68, 0xd6, 0xb9, 0x32, 0xb3, 0x7f,
+		0x06, 0xf9, 0xfa, 0xc9, 0xf7, 0xe2, 0x2c, 0x9b, 0xa0, 0xd7, 0x4f, 0xbe, 0x9f, 0x3e, 0x83, 0x01,
+		0x9b, 0x23, 0xe9, 0x44, 0xa2, 0x74, 0x0d, 0x6d, 0x40, 0x79, 0xa4, 0x51, 0xcc, 0xc8, 0xbc, 0xfb,
+		0xe5, 0x8f, 0x6f, 0x6f, 0x7c, 0x76, 0xdb, 0xbf, 0xaa, 0xba, 0x61, 0xf7, 0x20, 0xf5, 0x27, 0x51,
+		0xf5, 0x86, 0x06, 0xf2, 0x9f, 0xab, 0xf1, 0xff, 0x45, 0xbf, 0x95, 0xbf, 0x06, 0x6f, 0xae, 0x72,
+		0x42, 0xf3, 0xed, 0x7f, 0x06, 0x00, 0xde, 0xc6, 0xe2, 0x4a, 0x25, 0x13, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/domain.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xd1, 0x6e, 0xdb, 0x36,
-		0x14, 0x9d, 0xec, 0x24, 0x73, 0xae, 0x1c, 0xd7, 0x65, 0x9a, 0x46, 0xf1, 0x86, 0x45, 0x4d, 0x51,
-		0xc0, 0x2b, 0x30, 0x79, 0xf1, 0x86, 0xad, 0xdd, 0xb0, 0x07, 0xc7, 0x52, 0x3b, 0x0f, 0x59, 0x16,
-		0xc8, 0x6e, 0x30, 0x6c, 0x0f, 0x02, 0x2d, 0xd1, 0x36, 0x51, 0x59, 0x14, 0x28, 0xda, 0x69, 0xde,
-		0x86, 0x7d, 0xc4, 0x3e, 0x66, 0x8f, 0xfb, 0xb2, 0x41, 0x14, 0xa5, 0x38, 0xb6, 0x90, 0xf4, 0x8d,
-		0xbc, 0xf7, 0x9e, 0xc3, 0xa3, 0xa3, 0x7b, 0x29, 0x81, 0xb9, 0x18, 0x13, 0xde, 0xf1, 0x71, 0x40,
-		0x22, 0x9f, 0x74, 0x70, 0x4c, 0x3b, 0xcb, 0xd3, 0x4e, 0xc0, 0xe6, 0x98, 0x46, 0x56, 0xcc, 0x99,
-		0x60, 0x68, 0x3f, 0xad, 0xb0, 0x54, 0x85, 0x85, 0x63, 0x6a, 0x2d, 0x4f, 0x5b, 0x5f, 0x4c, 0x19,
-		0x9b, 0x86, 0xa4, 0x23, 0x4b, 0xc6, 0x8b, 0x49, 0x27, 0x58, 0x70, 0x2c, 0x28, 0x53, 0xa0, 0xd6,
-		0xf1, 0x7a, 0x5e, 0xd0, 0x39, 0x49, 0x04, 0x9e, 0xc7, 0x59, 0xc1, 0xc9, 0x3f, 0x35, 0xd8, 0xb1,
-		0xe5, 0x31, 0xa8, 0x01, 0x15, 0x1a, 0x18, 0x9a, 0xa9, 0xb5, 0x77, 0xdd, 0x0a, 0x0d, 0x10, 0x82,
-		0xad, 0x08, 0xcf, 0x89, 0x51, 0x91, 0x11, 0xb9, 0x46, 0xaf, 0x61, 0x27, 0x11, 0x58, 0x2c, 0x12,
-		0xa3, 0x6a, 0x6a, 0xed, 0x46, 0xf7, 0x99, 0x55, 0xa2, 0xca, 0xca, 0x08, 0x87, 0xb2, 0xd0, 0x55,
-		0x00, 0x64, 0x82, 0x1e, 0x90, 0xc4, 0xe7, 0x34, 0x4e, 0xf5, 0x19, 0x5b, 0x92, 0x75, 0x35, 0x84,
-		0x8e, 0x41, 0x67, 0xd7, 0x11, 0xe1, 0x1e, 0x99, 0x63, 0x1a, 0x1a, 0xdb, 0xb2, 0x02, 0x64, 0xc8,
-		0x49, 0x23, 0xe8, 0x35, 0x6c, 0x05, 0x58, 0x60, 0x63, 0xc7, 0xac, 0xb6, 0xf5, 0xee, 0x8b, 0x7b,
-		0xce, 0xb6, 0x6c, 0x2c, 0xb0, 0x13, 0x09, 0x7e, 0xe3, 0x4a, 0x08, 0x9a, 0xc1, 0xf3, 0x6b, 0xc6,
-		0xdf, 0x4f, 0x42, 0x76, 0xed, 0x91, 0x0f, 0xc4, 0x5f, 0xa4, 0x27, 0x7a, 0x9c, 0x08, 0x12, 0xc9,
-		0x55, 0x4c, 0x38, 0x65, 0x81, 0xf1, 0xa9, 0xa9, 0xb5, 0xf5, 0xee, 0x91, 0x95, 0xd9, 0x66, 0xe5,
-		0xb6, 0x59, 0xb6, 0xb2, 0xd5, 0x35, 0x73, 0x16, 0x27, 0x27, 0x71, 0x73, 0x8e, 0x4b, 0x49, 0x81,
-		0xfa, 0x50, 0x1f, 0xe3, 0xc0, 0x1b, 0xd3, 0x08, 0x73, 0x4a, 0x12, 0xa3, 0x26, 0x29, 0xcd, 0x52,
-		0xb1, 0x67, 0x38, 0x38, 0x53, 0x75, 0xae, 0x3e, 0xbe, 0xdd, 0xa0, 0x3f, 0xe1, 0x70, 0x46, 0x13,
-		0xc1, 0xf8, 0x8d, 0x87, 0xb9, 0x3f, 0xa3, 0x4b, 0x1c, 0x7a, 0xca, 0xf8, 0x5d, 0x69, 0xfc, 0xf3,
-		0x52, 0xbe, 0x9e, 0xaa, 0x55, 0xd6, 0x1f, 0x28, 0x8e, 0xbb, 0x61, 0xf4, 0x35, 0x3c, 0xd9, 0x20,
-		0x5f, 0x70, 0x6a, 0x80, 0x34, 0x1c, 0xad, 0x81, 0xde, 0x71, 0x8a, 0x30, 0xb4, 0x96, 0x34, 0xa1,
-		0x63, 0x1a, 0x52, 0xb1, 0xa9, 0x48, 0xff, 0x78, 0x45, 0xc6, 0x2d, 0xcd, 0x9a, 0xa8, 0xef, 0xe0,
-		0xb0, 0xec, 0x88, 0x54, 0x57, 0x5d, 0xea, 0x3a, 0xd8, 0x84, 0xa6, 0xd2, 0x2c, 0xd8, 0xc7, 0xbe,
-		0xa0, 0x4b, 0xe2, 0xf9, 0xe1, 0x22, 0x11, 0x84, 0x7b, 0xb2, 0x69, 0xf7, 0x24, 0xe6, 0x71, 0x96,
-		0xea, 0x67, 0x99, 0x8b, 0xb4, 0x83, 0x2f, 0xa1, 0xa6, 0x0a, 0x13, 0xa3, 0x21, 0xfb, 0xe8, 0xdb,
-		0x52, 0xe1, 0x0a, 0xe3, 0x92, 0x38, 0xa4, 0xbe, 0x7c, 0xf7, 0x7d, 0x16, 0x4d, 0xe8, 0x34, 0x6f,
-		0x84, 0x82, 0x05, 0x7d, 0x09, 0xcd, 0x09, 0xa6, 0x21, 0x5b, 0x12, 0xee, 0x2d, 0x09, 0x4f, 0xd2,
-		0xee, 0x7e, 0x64, 0x6a, 0xed, 0xaa, 0xfb, 0x28, 0x8f, 0x5f, 0x65, 0x61, 0xd4, 0x86, 0x26, 0x4d,
-		0xbc, 0x69, 0xc8, 0xc6, 0x38, 0xf4, 0xb2, 0xe9, 0x36, 0x9a, 0xa6, 0xd6, 0xae, 0xb9, 0x0d, 0x9a,
-		0xbc, 0x95, 0x61, 0x35, 0x8c, 0x6f, 0x60, 0xaf, 0x20, 0xa5, 0xd1, 0x84, 0x19, 0x8f, 0x65, 0x1b,
-		0x95, 0xcf, 0xdb, 0x1b, 0x55, 0x39, 0x88, 0x26, 0xcc, 0xad, 0x4f, 0x56, 0x76, 0xad, 0xef, 0x61,
-		0xb7, 0x18, 0x05, 0xd4, 0x84, 0xea, 0x7b, 0x72, 0xa3, 0x46, 0x3c, 0x5d, 0xa2, 0x27, 0xb0, 0xbd,
-		0xc4, 0xe1, 0x22, 0x1f, 0xf2, 0x6c, 0xf3, 0x43, 0xe5, 0x95, 0x76, 0x62, 0xc3, 0xf1, 0x03, 0x16,
-		0xa0, 0x67, 0x50, 0xbf, 0xe3, 0x79, 0xc6, 0xab, 0xfb, 0xb7, 0x6e, 0x9f, 0xfc, 0xab, 0x81, 0xbe,
-		0xd2, 0xe4, 0xe8, 0x17, 0xa8, 0x15, 0x83, 0xa1, 0x49, 0xf7, 0xad, 0x87, 0x06, 0xc3, 0xca, 0x17,
-		0xd9, 0x38, 0x17, 0xf8, 0x96, 0x07, 0x7b, 0x77, 0x52, 0x25, 0x8f, 0xf7, 0x6a, 0xf5, 0xf1, 0xf4,
-		0xee, 0xc9, 0xbd, 0x67, 0xdd, 0x48, 0xfb, 0x56, 0x2c, 0xf8, 0x5b, 0x83, 0xbd, 0x3b, 0x49, 0xf4,
-		0x14, 0x76, 0x38, 0xc1, 0x09, 0x8b, 0xd4, 0x21, 0x6a, 0x87, 0x5a, 0x50, 0x63, 0x31, 0xe1, 0x58,
-		0x30, 0xae, 0x9c, 0x2c, 0xf6, 0xe8, 0x27, 0xa8, 0xfb, 0x9c, 0x60, 0x41, 0x02, 0x2f, 0xbd, 0x7c,
-		0xe5, 0xc5, 0xa9, 0x77, 0x5b, 0x1b, 0x57, 0xcc, 0x28, 0xbf, 0x99, 0x5d, 0x5d, 0xd5, 0xa7, 0x91,
-		0x93, 0xff, 0x2a, 0x50, 0x5f, 0x7d, 0xbf, 0xa5, 0xed, 0xa6, 0x95, 0xb7, 0xdb, 0x08, 0x8c, 0xa2,
-		0x34, 0x11, 0x98, 0x0b, 0xaf, 0xb8, 0xfe, 0x95, 0x23, 0xf7, 0xc9, 0x78, 0x9a, 0x63, 0x87, 0x29,
-		0xb4, 0x88, 0xa3, 0x2b, 0x38, 0x2a, 0x58, 0xc9, 0x87, 0x98, 0x72, 0xb2, 0x42, 0xfb, 0xf0, 0xd3,
-		0x1d, 0xe6, 0x60, 0x47, 0x62, 0x6f, 0x79, 0xbb, 0x70, 0xe0, 0xb3, 0x79, 0x1c, 0x92, 0xd4, 0xaa,
-		0x64, 0x86, 0x79, 0xe0, 0xf9, 0x6c, 0x11, 0x09, 0xf9, 0xa9, 0xd8, 0x76, 0xf7, 0x8b, 0xe4, 0x30,
-		0xcd, 0xf5, 0xd3, 0x14, 0x7a, 0x01, 0x8d, 0x98, 0x44, 0x01, 0x8d, 0xa6, 0x19, 0x22, 0x31, 0xb6,
-		0xcd, 0x6a, 0x7b, 0xdb, 0xdd, 0x53, 0x51, 0x59, 0x9a, 0xbc, 0xfc, 0x4b, 0x83, 0xfa, 0xea, 0x47,
-		0x09, 0x1d, 0xc1, 0x81, 0xfd, 0xdb, 0xaf, 0xbd, 0xc1, 0x85, 0x37, 0x1c, 0xf5, 0x46, 0xef, 0x86,
-		0xde, 0xe0, 0xe2, 0xaa, 0x77, 0x3e, 0xb0, 0x9b, 0x9f, 0xa0, 0xcf, 0xc1, 0xb8, 0x9b, 0x72, 0x9d,
-		0xb7, 0x83, 0xe1, 0xc8, 0x71, 0x1d, 0xbb, 0xa9, 0x6d, 0x66, 0x6d, 0xe7, 0xd2, 0x75, 0xfa, 0xbd,
-		0x91, 0x63, 0x37, 0x2b, 0x9b, 0xb4, 0xb6, 0x73, 0xee, 0xa4, 0xa9, 0xea, 0xcb, 0x19, 0x34, 0xd6,
-		0x6e, 0xbc, 0xcf, 0xe0, 0xb0, 0xe7, 0xf6, 0x7f, 0x1e, 0x5c, 0xf5, 0xce, 0x4b, 0x55, 0xac, 0x27,
-		0xed, 0xc1, 0xb0, 0x77, 0x76, 0x2e, 0x55, 0x94, 0x40, 0x9d, 0x8b, 0x2c, 0x59, 0x39, 0xfb, 0x1d,
-		0x0e, 0x7d, 0x36, 0x2f, 0x6b, 0xf5, 0xb3, 0x5a, 0x2f, 0xa6, 0x97, 0xe9, 0x2b, 0xb9, 0xd4, 0xfe,
-		0x38, 0x9d, 0x52, 0x31, 0x5b, 0x8c, 0x2d, 0x9f, 0xcd, 0x3b, 0xab, 0x3f, 0x1f, 0x5f, 0xd1, 0x20,
-		0xec, 0x4c, 0x59, 0xf6, 0xcb, 0xa0, 0xfe, 0x44, 0x7e, 0xc4, 0x31, 0x5d, 0x9e, 0x8e, 0x77, 0x64,
-		0xec, 0x9b, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x98, 0xc3, 0x6b, 0x64, 0xad, 0x08, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x6f, 0xdb, 0x36,
+		0x17, 0xfd, 0x64, 0x27, 0xf9, 0x9c, 0x2b, 0xc7, 0x75, 0x99, 0xa6, 0x51, 0xbc, 0x61, 0x51, 0x53,
+		0x14, 0xf0, 0x0a, 0x4c, 0x5e, 0xbc, 0x61, 0x6b, 0x37, 0xec, 0xc1, 0xb1, 0xd4, 0xce, 0x43, 0x96,
+		0x05, 0xb2, 0x1b, 0x0c, 0xdb, 0x83, 0x40, 0x4b, 0xb4, 0x4d, 0x54, 0x16, 0x05, 0x8a, 0x72, 0x9a,
+		0xb7, 0x61, 0x3f, 0x62, 0x3f, 0x66, 0x8f, 0xfb, 0x65, 0x83, 0x28, 0x4a, 0x71, 0x62, 0xa1, 0xd9,
+		0x1b, 0x79, 0xef, 0x3d, 0x87, 0x47, 0x47, 0xf7, 0x52, 0x02, 0x33, 0x9d, 0x12, 0xde, 0xf3, 0x71,
+		0x40, 0x22, 0x9f, 0xf4, 0x70, 0x4c, 0x7b, 0xab, 0xd3, 0x5e, 0xc0, 0x96, 0x98, 0x46, 0x56, 0xcc,
+		0x99, 0x60, 0x68, 0x3f, 0xab, 0xb0, 0x54, 0x85, 0x85, 0x63, 0x6a, 0xad, 0x4e, 0x3b, 0x9f, 0xcd,
+		0x19, 0x9b, 0x87, 0xa4, 0x27, 0x4b, 0xa6, 0xe9, 0xac, 0x17, 0xa4, 0x1c, 0x0b, 0xca, 0x14, 0xa8,
+		0x73, 0x7c, 0x3f, 0x2f, 0xe8, 0x92, 0x24, 0x02, 0x2f, 0xe3, 0xbc, 0xe0, 0xe4, 0xaf, 0x06, 0xec,
+		0xd8, 0xf2, 0x18, 0xd4, 0x82, 0x1a, 0x0d, 0x0c, 0xcd, 0xd4, 0xba, 0xbb, 0x6e, 0x8d, 0x06, 0x08,
+		0xc1, 0x56, 0x84, 0x97, 0xc4, 0xa8, 0xc9, 0x88, 0x5c, 0xa3, 0xd7, 0xb0, 0x93, 0x08, 0x2c, 0xd2,
+		0xc4, 0xa8, 0x9b, 0x5a, 0xb7, 0xd5, 0x7f, 0x66, 0x55, 0xa8, 0xb2, 0x72, 0xc2, 0xb1, 0x2c, 0x74,
+		0x15, 0x00, 0x99, 0xa0, 0x07, 0x24, 0xf1, 0x39, 0x8d, 0x33, 0x7d, 0xc6, 0x96, 0x64, 0x5d, 0x0f,
+		0xa1, 0x63, 0xd0, 0xd9, 0x75, 0x44, 0xb8, 0x47, 0x96, 0x98, 0x86, 0xc6, 0xb6, 0xac, 0x00, 0x19,
+		0x72, 0xb2, 0x08, 0x7a, 0x0d, 0x5b, 0x01, 0x16, 0xd8, 0xd8, 0x31, 0xeb, 0x5d, 0xbd, 0xff, 0xe2,
+		0x23, 0x67, 0x5b, 0x36, 0x16, 0xd8, 0x89, 0x04, 0xbf, 0x71, 0x25, 0x04, 0x2d, 0xe0, 0xf9, 0x35,
+		0xe3, 0xef, 0x67, 0x21, 0xbb, 0xf6, 0xc8, 0x07, 0xe2, 0xa7, 0xd9, 0x89, 0x1e, 0x27, 0x82, 0x44,
+		0x72, 0x15, 0x13, 0x4e, 0x59, 0x60, 0xfc, 0xdf, 0xd4, 0xba, 0x7a, 0xff, 0xc8, 0xca, 0x6d, 0xb3,
+		0x0a, 0xdb, 0x2c, 0x5b, 0xd9, 0xea, 0x9a, 0x05, 0x8b, 0x53, 0x90, 0xb8, 0x05, 0xc7, 0xa5, 0xa4,
+		0x40, 0x43, 0x68, 0x4e, 0x71, 0xe0, 0x4d, 0x69, 0x84, 0x39, 0x25, 0x89, 0xd1, 0x90, 0x94, 0x66,
+		0xa5, 0xd8, 0x33, 0x1c, 0x9c, 0xa9, 0x3a, 0x57, 0x9f, 0xde, 0x6e, 0xd0, 0xef, 0x70, 0xb8, 0xa0,
+		0x89, 0x60, 0xfc, 0xc6, 0xc3, 0xdc, 0x5f, 0xd0, 0x15, 0x0e, 0x3d, 0x65, 0xfc, 0xae, 0x34, 0xfe,
+		0x79, 0x25, 0xdf, 0x40, 0xd5, 0x2a, 0xeb, 0x0f, 0x14, 0xc7, 0xdd, 0x30, 0xfa, 0x12, 0x9e, 0x6c,
+		0x90, 0xa7, 0x9c, 0x1a, 0x20, 0x0d, 0x47, 0xf7, 0x40, 0xef, 0x38, 0x45, 0x18, 0x3a, 0x2b, 0x9a,
+		0xd0, 0x29, 0x0d, 0xa9, 0xd8, 0x54, 0xa4, 0xff, 0x77, 0x45, 0xc6, 0x2d, 0xcd, 0x3d, 0x51, 0xdf,
+		0xc0, 0x61, 0xd5, 0x11, 0x99, 0xae, 0xa6, 0xd4, 0x75, 0xb0, 0x09, 0xcd, 0xa4, 0x59, 0xb0, 0x8f,
+		0x7d, 0x41, 0x57, 0xc4, 0xf3, 0xc3, 0x34, 0x11, 0x84, 0x7b, 0xb2, 0x69, 0xf7, 0x24, 0xe6, 0x71,
+		0x9e, 0x1a, 0xe6, 0x99, 0x8b, 0xac, 0x83, 0x2f, 0xa1, 0xa1, 0x0a, 0x13, 0xa3, 0x25, 0xfb, 0xe8,
+		0xeb, 0x4a, 0xe1, 0x0a, 0xe3, 0x92, 0x38, 0xa4, 0xbe, 0x7c, 0xf7, 0x43, 0x16, 0xcd, 0xe8, 0xbc,
+		0x68, 0x84, 0x92, 0x05, 0x7d, 0x0e, 0xed, 0x19, 0xa6, 0x21, 0x5b, 0x11, 0xee, 0xad, 0x08, 0x4f,
+		0xb2, 0xee, 0x7e, 0x64, 0x6a, 0xdd, 0xba, 0xfb, 0xa8, 0x88, 0x5f, 0xe5, 0x61, 0xd4, 0x85, 0x36,
+		0x4d, 0xbc, 0x79, 0xc8, 0xa6, 0x38, 0xf4, 0xf2, 0xe9, 0x36, 0xda, 0xa6, 0xd6, 0x6d, 0xb8, 0x2d,
+		0x9a, 0xbc, 0x95, 0x61, 0x35, 0x8c, 0x6f, 0x60, 0xaf, 0x24, 0xa5, 0xd1, 0x8c, 0x19, 0x8f, 0x65,
+		0x1b, 0x55, 0xcf, 0xdb, 0x1b, 0x55, 0x39, 0x8a, 0x66, 0xcc, 0x6d, 0xce, 0xd6, 0x76, 0x9d, 0x6f,
+		0x61, 0xb7, 0x1c, 0x05, 0xd4, 0x86, 0xfa, 0x7b, 0x72, 0xa3, 0x46, 0x3c, 0x5b, 0xa2, 0x27, 0xb0,
+		0xbd, 0xc2, 0x61, 0x5a, 0x0c, 0x79, 0xbe, 0xf9, 0xae, 0xf6, 0x4a, 0x3b, 0xb1, 0xe1, 0xf8, 0x01,
+		0x0b, 0xd0, 0x33, 0x68, 0xde, 0xf1, 0x3c, 0xe7, 0xd5, 0xfd, 0x5b, 0xb7, 0x4f, 0xfe, 0xd6, 0x40,
+		0x5f, 0x6b, 0x72, 0xf4, 0x13, 0x34, 0xca, 0xc1, 0xd0, 0xa4, 0xfb, 0xd6, 0x43, 0x83, 0x61, 0x15,
+		0x8b, 0x7c, 0x9c, 0x4b, 0x7c, 0xc7, 0x83, 0xbd, 0x3b, 0xa9, 0x8a, 0xc7, 0x7b, 0xb5, 0xfe, 0x78,
+		0x7a, 0xff, 0xe4, 0xa3, 0x67, 0xdd, 0x48, 0xfb, 0xd6, 0x2c, 0xf8, 0x53, 0x83, 0xbd, 0x3b, 0x49,
+		0xf4, 0x14, 0x76, 0x38, 0xc1, 0x09, 0x8b, 0xd4, 0x21, 0x6a, 0x87, 0x3a, 0xd0, 0x60, 0x31, 0xe1,
+		0x58, 0x30, 0xae, 0x9c, 0x2c, 0xf7, 0xe8, 0x07, 0x68, 0xfa, 0x9c, 0x60, 0x41, 0x02, 0x2f, 0xbb,
+		0x7c, 0xe5, 0xc5, 0xa9, 0xf7, 0x3b, 0x1b, 0x57, 0xcc, 0xa4, 0xb8, 0x99, 0x5d, 0x5d, 0xd5, 0x67,
+		0x91, 0x93, 0x7f, 0x6a, 0xd0, 0x5c, 0x7f, 0xbf, 0x95, 0xed, 0xa6, 0x55, 0xb7, 0xdb, 0x04, 0x8c,
+		0xb2, 0x34, 0x11, 0x98, 0x0b, 0xaf, 0xbc, 0xfe, 0x8d, 0xda, 0x83, 0x32, 0x9e, 0x16, 0xd8, 0x71,
+		0x06, 0x2d, 0xe3, 0xe8, 0x0a, 0x8e, 0x4a, 0x56, 0xf2, 0x21, 0xa6, 0x9c, 0xac, 0xd1, 0x3e, 0xfc,
+		0x74, 0x87, 0x05, 0xd8, 0x91, 0xd8, 0x5b, 0xde, 0x3e, 0x1c, 0xf8, 0x6c, 0x19, 0x87, 0x24, 0xb3,
+		0x2a, 0x59, 0x60, 0x1e, 0x78, 0x3e, 0x4b, 0x23, 0x21, 0x3f, 0x15, 0xdb, 0xee, 0x7e, 0x99, 0x1c,
+		0x67, 0xb9, 0x61, 0x96, 0x42, 0x2f, 0xa0, 0x15, 0x93, 0x28, 0xa0, 0xd1, 0x3c, 0x47, 0x24, 0xc6,
+		0xb6, 0x59, 0xef, 0x6e, 0xbb, 0x7b, 0x2a, 0x2a, 0x4b, 0x93, 0x97, 0x7f, 0x68, 0xd0, 0x5c, 0xff,
+		0x28, 0xa1, 0x23, 0x38, 0xb0, 0x7f, 0xf9, 0x79, 0x30, 0xba, 0xf0, 0xc6, 0x93, 0xc1, 0xe4, 0xdd,
+		0xd8, 0x1b, 0x5d, 0x5c, 0x0d, 0xce, 0x47, 0x76, 0xfb, 0x7f, 0xe8, 0x53, 0x30, 0xee, 0xa6, 0x5c,
+		0xe7, 0xed, 0x68, 0x3c, 0x71, 0x5c, 0xc7, 0x6e, 0x6b, 0x9b, 0x59, 0xdb, 0xb9, 0x74, 0x9d, 0xe1,
+		0x60, 0xe2, 0xd8, 0xed, 0xda, 0x26, 0xad, 0xed, 0x9c, 0x3b, 0x59, 0xaa, 0xfe, 0x72, 0x01, 0xad,
+		0x7b, 0x37, 0xde, 0x27, 0x70, 0x38, 0x70, 0x87, 0x3f, 0x8e, 0xae, 0x06, 0xe7, 0x95, 0x2a, 0xee,
+		0x27, 0xed, 0xd1, 0x78, 0x70, 0x76, 0x2e, 0x55, 0x54, 0x40, 0x9d, 0x8b, 0x3c, 0x59, 0x3b, 0xfb,
+		0x15, 0x0e, 0x7d, 0xb6, 0xac, 0x6a, 0xf5, 0xb3, 0xc6, 0x20, 0xa6, 0x97, 0xd9, 0x2b, 0xb9, 0xd4,
+		0x7e, 0x3b, 0x9d, 0x53, 0xb1, 0x48, 0xa7, 0x96, 0xcf, 0x96, 0xbd, 0xf5, 0x9f, 0x8f, 0x2f, 0x68,
+		0x10, 0xf6, 0xe6, 0x2c, 0xff, 0x65, 0x50, 0x7f, 0x22, 0xdf, 0xe3, 0x98, 0xae, 0x4e, 0xa7, 0x3b,
+		0x32, 0xf6, 0xd5, 0xbf, 0x03, 0x00, 0x98, 0xc3, 0x6b, 0x64, 0xad, 0x08, 0x00, 0x00,
 	},
 }
 
@@ -26,6 +26,7 @@
 package historyv1
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -320,6 +321,236 @@ func (m *SignalWorkflowExecutionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SignalWorkflowExecutionResponse proto.InternalMessageInfo
 
+type SignalBatchWorkflowExecutionRequest struct {
+	Request              *SignalBatchRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	DomainId             string              `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *SignalBatchWorkflowExecutionRequest) Reset()         { *m = SignalBatchWorkflowExecutionRequest{} }
+func (m *SignalBatchWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*SignalBatchWorkflowExecutionRequest) ProtoMessage()    {}
+func (*SignalBatchWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{4}
+}
+func (m *SignalBatchWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalBatchWorkflowExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalBatchWorkflowExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalBatchWorkflowExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalBatchWorkflowExecutionRequest.Merge(m, src)
+}
+func (m *SignalBatchWorkflowExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalBatchWorkflowExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalBatchWorkflowExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalBatchWorkflowExecutionRequest proto.InternalMessageInfo
+
+func (m *SignalBatchWorkflowExecutionRequest) GetRequest() *SignalBatchRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *SignalBatchWorkflowExecutionRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+// SignalBatchRequest is the frontend signal batch request,
+// it is defined here as the public API does not carry signal batches.
+type SignalBatchRequest struct {
+	Domain               string                `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	Signals              []*SignalBatchItem    `protobuf:"bytes,3,rep,name=signals,proto3" json:"signals,omitempty"`
+	Identity             string                `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	RequestId            string                `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *SignalBatchRequest) Reset()         { *m = SignalBatchRequest{} }
+func (m *SignalBatchRequest) String() string { return proto.CompactTextString(m) }
+func (*SignalBatchRequest) ProtoMessage()    {}
+func (*SignalBatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{5}
+}
+func (m *SignalBatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalBatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalBatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalBatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalBatchRequest.Merge(m, src)
+}
+func (m *SignalBatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalBatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalBatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalBatchRequest proto.InternalMessageInfo
+
+func (m *SignalBatchRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *SignalBatchRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+func (m *SignalBatchRequest) GetSignals() []*SignalBatchItem {
+	if m != nil {
+		return m.Signals
+	}
+	return nil
+}
+
+func (m *SignalBatchRequest) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *SignalBatchRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type SignalBatchItem struct {
+	SignalName           string      `protobuf:"bytes,1,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	Input                *v1.Payload `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SignalBatchItem) Reset()         { *m = SignalBatchItem{} }
+func (m *SignalBatchItem) String() string { return proto.CompactTextString(m) }
+func (*SignalBatchItem) ProtoMessage()    {}
+func (*SignalBatchItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{6}
+}
+func (m *SignalBatchItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalBatchItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalBatchItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalBatchItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalBatchItem.Merge(m, src)
+}
+func (m *SignalBatchItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalBatchItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalBatchItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalBatchItem proto.InternalMessageInfo
+
+func (m *SignalBatchItem) GetSignalName() string {
+	if m != nil {
+		return m.SignalName
+	}
+	return ""
+}
+
+func (m *SignalBatchItem) GetInput() *v1.Payload {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+type SignalBatchWorkflowExecutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SignalBatchWorkflowExecutionResponse) Reset()         { *m = SignalBatchWorkflowExecutionResponse{} }
+func (m *SignalBatchWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*SignalBatchWorkflowExecutionResponse) ProtoMessage()    {}
+func (*SignalBatchWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{7}
+}
+func (m *SignalBatchWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalBatchWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalBatchWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalBatchWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalBatchWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *SignalBatchWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalBatchWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalBatchWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalBatchWorkflowExecutionResponse proto.InternalMessageInfo
+
 type SignalWithStartWorkflowExecutionRequest struct {
 	Request              *v1.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                                      `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -334,7 +565,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Reset() {
 func (m *SignalWithStartWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*SignalWithStartWorkflowExecutionRequest) ProtoMessage()    {}
 func (*SignalWithStartWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{4}
+	return fileDescriptor_fee8ff76963a38ed, []int{8}
 }
 func (m *SignalWithStartWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -390,7 +621,7 @@ func (m *SignalWithStartWorkflowExecutionResponse) Reset() {
 func (m *SignalWithStartWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*SignalWithStartWorkflowExecutionResponse) ProtoMessage()    {}
 func (*SignalWithStartWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{5}
+	return fileDescriptor_fee8ff76963a38ed, []int{9}
 }
 func (m *SignalWithStartWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +669,7 @@ func (m *ResetWorkflowExecutionRequest) Reset()         { *m = ResetWorkflowExec
 func (m *ResetWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetWorkflowExecutionRequest) ProtoMessage()    {}
 func (*ResetWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{6}
+	return fileDescriptor_fee8ff76963a38ed, []int{10}
 }
 func (m *ResetWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +723,7 @@ func (m *ResetWorkflowExecutionResponse) Reset()         { *m = ResetWorkflowExe
 func (m *ResetWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetWorkflowExecutionResponse) ProtoMessage()    {}
 func (*ResetWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{7}
+	return fileDescriptor_fee8ff76963a38ed, []int{11}
 }
 func (m *ResetWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -545,7 +776,7 @@ func (m *TerminateWorkflowExecutionRequest) Reset()         { *m = TerminateWork
 func (m *TerminateWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*TerminateWorkflowExecutionRequest) ProtoMessage()    {}
 func (*TerminateWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{8}
+	return fileDescriptor_fee8ff76963a38ed, []int{12}
 }
 func (m *TerminateWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -612,7 +843,7 @@ func (m *TerminateWorkflowExecutionResponse) Reset()         { *m = TerminateWor
 func (m *TerminateWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*TerminateWorkflowExecutionResponse) ProtoMessage()    {}
 func (*TerminateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{9}
+	return fileDescriptor_fee8ff76963a38ed, []int{13}
 }
 func (m *TerminateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -653,7 +884,7 @@ func (m *DescribeWorkflowExecutionRequest) Reset()         { *m = DescribeWorkfl
 func (m *DescribeWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionRequest) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{10}
+	return fileDescriptor_fee8ff76963a38ed, []int{14}
 }
 func (m *DescribeWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -702,16 +933,19 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*v1.PendingActivityInfo          `protobuf:"bytes,3,rep,name=pending_activities,json=pendingActivities,proto3" json:"pending_activities,omitempty"`
 	PendingChildren        []*v1.PendingChildExecutionInfo    `protobuf:"bytes,4,rep,name=pending_children,json=pendingChildren,proto3" json:"pending_children,omitempty"`
 	PendingDecision        *v1.PendingDecisionInfo            `protobuf:"bytes,5,opt,name=pending_decision,json=pendingDecision,proto3" json:"pending_decision,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{}                           `json:"-"`
-	XXX_unrecognized       []byte                             `json:"-"`
-	XXX_sizecache          int32                              `json:"-"`
+	// history_limit_warning extends the public response,
+	// api.v1.DescribeWorkflowExecutionResponse does not carry it yet
+	HistoryLimitWarning  *HistoryLimitWarning `protobuf:"bytes,6,opt,name=history_limit_warning,json=historyLimitWarning,proto3" json:"history_limit_warning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *DescribeWorkflowExecutionResponse) Reset()         { *m = DescribeWorkflowExecutionResponse{} }
 func (m *DescribeWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeWorkflowExecutionResponse) ProtoMessage()    {}
 func (*DescribeWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{11}
+	return fileDescriptor_fee8ff76963a38ed, []int{15}
 }
 func (m *DescribeWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,6 +1009,100 @@ func (m *DescribeWorkflowExecutionResponse) GetPendingDecision() *v1.PendingDeci
 	return nil
 }
 
+func (m *DescribeWorkflowExecutionResponse) GetHistoryLimitWarning() *HistoryLimitWarning {
+	if m != nil {
+		return m.HistoryLimitWarning
+	}
+	return nil
+}
+
+type HistoryLimitWarning struct {
+	HistoryCount            int64             `protobuf:"varint,1,opt,name=history_count,json=historyCount,proto3" json:"history_count,omitempty"`
+	HistorySize             int64             `protobuf:"varint,2,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	HistoryCountLimit       int64             `protobuf:"varint,3,opt,name=history_count_limit,json=historyCountLimit,proto3" json:"history_count_limit,omitempty"`
+	HistorySizeLimit        int64             `protobuf:"varint,4,opt,name=history_size_limit,json=historySizeLimit,proto3" json:"history_size_limit,omitempty"`
+	EventsPerHour           float64           `protobuf:"fixed64,5,opt,name=events_per_hour,json=eventsPerHour,proto3" json:"events_per_hour,omitempty"`
+	EstimatedSecondsToLimit *types.Int64Value `protobuf:"bytes,6,opt,name=estimated_seconds_to_limit,json=estimatedSecondsToLimit,proto3" json:"estimated_seconds_to_limit,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}          `json:"-"`
+	XXX_unrecognized        []byte            `json:"-"`
+	XXX_sizecache           int32             `json:"-"`
+}
+
+func (m *HistoryLimitWarning) Reset()         { *m = HistoryLimitWarning{} }
+func (m *HistoryLimitWarning) String() string { return proto.CompactTextString(m) }
+func (*HistoryLimitWarning) ProtoMessage()    {}
+func (*HistoryLimitWarning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{16}
+}
+func (m *HistoryLimitWarning) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryLimitWarning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryLimitWarning.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryLimitWarning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryLimitWarning.Merge(m, src)
+}
+func (m *HistoryLimitWarning) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryLimitWarning) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryLimitWarning.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryLimitWarning proto.InternalMessageInfo
+
+func (m *HistoryLimitWarning) GetHistoryCount() int64 {
+	if m != nil {
+		return m.HistoryCount
+	}
+	return 0
+}
+
+func (m *HistoryLimitWarning) GetHistorySize() int64 {
+	if m != nil {
+		return m.HistorySize
+	}
+	return 0
+}
+
+func (m *HistoryLimitWarning) GetHistoryCountLimit() int64 {
+	if m != nil {
+		return m.HistoryCountLimit
+	}
+	return 0
+}
+
+func (m *HistoryLimitWarning) GetHistorySizeLimit() int64 {
+	if m != nil {
+		return m.HistorySizeLimit
+	}
+	return 0
+}
+
+func (m *HistoryLimitWarning) GetEventsPerHour() float64 {
+	if m != nil {
+		return m.EventsPerHour
+	}
+	return 0
+}
+
+func (m *HistoryLimitWarning) GetEstimatedSecondsToLimit() *types.Int64Value {
+	if m != nil {
+		return m.EstimatedSecondsToLimit
+	}
+	return nil
+}
+
 type QueryWorkflowRequest struct {
 	Request              *v1.QueryWorkflowRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
 	DomainId             string                   `protobuf:"bytes,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
@@ -787,7 +1115,7 @@ func (m *QueryWorkflowRequest) Reset()         { *m = QueryWorkflowRequest{} }
 func (m *QueryWorkflowRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowRequest) ProtoMessage()    {}
 func (*QueryWorkflowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{12}
+	return fileDescriptor_fee8ff76963a38ed, []int{17}
 }
 func (m *QueryWorkflowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -842,7 +1170,7 @@ func (m *QueryWorkflowResponse) Reset()         { *m = QueryWorkflowResponse{} }
 func (m *QueryWorkflowResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWorkflowResponse) ProtoMessage()    {}
 func (*QueryWorkflowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{13}
+	return fileDescriptor_fee8ff76963a38ed, []int{18}
 }
 func (m *QueryWorkflowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -897,7 +1225,7 @@ func (m *ResetStickyTaskListRequest) Reset()         { *m = ResetStickyTaskListR
 func (m *ResetStickyTaskListRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListRequest) ProtoMessage()    {}
 func (*ResetStickyTaskListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{14}
+	return fileDescriptor_fee8ff76963a38ed, []int{19}
 }
 func (m *ResetStickyTaskListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -950,7 +1278,7 @@ func (m *ResetStickyTaskListResponse) Reset()         { *m = ResetStickyTaskList
 func (m *ResetStickyTaskListResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetStickyTaskListResponse) ProtoMessage()    {}
 func (*ResetStickyTaskListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{15}
+	return fileDescriptor_fee8ff76963a38ed, []int{20}
 }
 func (m *ResetStickyTaskListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -993,7 +1321,7 @@ func (m *GetMutableStateRequest) Reset()         { *m = GetMutableStateRequest{}
 func (m *GetMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateRequest) ProtoMessage()    {}
 func (*GetMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{16}
+	return fileDescriptor_fee8ff76963a38ed, []int{21}
 }
 func (m *GetMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1077,7 +1405,7 @@ func (m *GetMutableStateResponse) Reset()         { *m = GetMutableStateResponse
 func (m *GetMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*GetMutableStateResponse) ProtoMessage()    {}
 func (*GetMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{17}
+	return fileDescriptor_fee8ff76963a38ed, []int{22}
 }
 func (m *GetMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1239,7 +1567,7 @@ func (m *PollMutableStateRequest) Reset()         { *m = PollMutableStateRequest
 func (m *PollMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateRequest) ProtoMessage()    {}
 func (*PollMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{18}
+	return fileDescriptor_fee8ff76963a38ed, []int{23}
 }
 func (m *PollMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1649,7 @@ func (m *PollMutableStateResponse) Reset()         { *m = PollMutableStateRespon
 func (m *PollMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*PollMutableStateResponse) ProtoMessage()    {}
 func (*PollMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{19}
+	return fileDescriptor_fee8ff76963a38ed, []int{24}
 }
 func (m *PollMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1472,7 +1800,7 @@ func (m *RecordDecisionTaskStartedRequest) Reset()         { *m = RecordDecision
 func (m *RecordDecisionTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedRequest) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{20}
+	return fileDescriptor_fee8ff76963a38ed, []int{25}
 }
 func (m *RecordDecisionTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1567,7 +1895,7 @@ func (m *RecordDecisionTaskStartedResponse) Reset()         { *m = RecordDecisio
 func (m *RecordDecisionTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordDecisionTaskStartedResponse) ProtoMessage()    {}
 func (*RecordDecisionTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{21}
+	return fileDescriptor_fee8ff76963a38ed, []int{26}
 }
 func (m *RecordDecisionTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1711,7 +2039,7 @@ func (m *RecordActivityTaskStartedRequest) Reset()         { *m = RecordActivity
 func (m *RecordActivityTaskStartedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedRequest) ProtoMessage()    {}
 func (*RecordActivityTaskStartedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{22}
+	return fileDescriptor_fee8ff76963a38ed, []int{27}
 }
 func (m *RecordActivityTaskStartedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1799,7 +2127,7 @@ func (m *RecordActivityTaskStartedResponse) Reset()         { *m = RecordActivit
 func (m *RecordActivityTaskStartedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskStartedResponse) ProtoMessage()    {}
 func (*RecordActivityTaskStartedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{23}
+	return fileDescriptor_fee8ff76963a38ed, []int{28}
 }
 func (m *RecordActivityTaskStartedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1889,7 +2217,7 @@ func (m *RespondDecisionTaskCompletedRequest) Reset()         { *m = RespondDeci
 func (m *RespondDecisionTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{24}
+	return fileDescriptor_fee8ff76963a38ed, []int{29}
 }
 func (m *RespondDecisionTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1944,7 +2272,7 @@ func (m *RespondDecisionTaskCompletedResponse) Reset()         { *m = RespondDec
 func (m *RespondDecisionTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{25}
+	return fileDescriptor_fee8ff76963a38ed, []int{30}
 }
 func (m *RespondDecisionTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2327,7 @@ func (m *RespondDecisionTaskFailedRequest) Reset()         { *m = RespondDecisio
 func (m *RespondDecisionTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedRequest) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{26}
+	return fileDescriptor_fee8ff76963a38ed, []int{31}
 }
 func (m *RespondDecisionTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2052,7 +2380,7 @@ func (m *RespondDecisionTaskFailedResponse) Reset()         { *m = RespondDecisi
 func (m *RespondDecisionTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondDecisionTaskFailedResponse) ProtoMessage()    {}
 func (*RespondDecisionTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{27}
+	return fileDescriptor_fee8ff76963a38ed, []int{32}
 }
 func (m *RespondDecisionTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2093,7 +2421,7 @@ func (m *RecordActivityTaskHeartbeatRequest) Reset()         { *m = RecordActivi
 func (m *RecordActivityTaskHeartbeatRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatRequest) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{28}
+	return fileDescriptor_fee8ff76963a38ed, []int{33}
 }
 func (m *RecordActivityTaskHeartbeatRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2147,7 +2475,7 @@ func (m *RecordActivityTaskHeartbeatResponse) Reset()         { *m = RecordActiv
 func (m *RecordActivityTaskHeartbeatResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordActivityTaskHeartbeatResponse) ProtoMessage()    {}
 func (*RecordActivityTaskHeartbeatResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{29}
+	return fileDescriptor_fee8ff76963a38ed, []int{34}
 }
 func (m *RecordActivityTaskHeartbeatResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2523,7 @@ func (m *RespondActivityTaskCompletedRequest) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{30}
+	return fileDescriptor_fee8ff76963a38ed, []int{35}
 }
 func (m *RespondActivityTaskCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2248,7 +2576,7 @@ func (m *RespondActivityTaskCompletedResponse) Reset()         { *m = RespondAct
 func (m *RespondActivityTaskCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCompletedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{31}
+	return fileDescriptor_fee8ff76963a38ed, []int{36}
 }
 func (m *RespondActivityTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2289,7 +2617,7 @@ func (m *RespondActivityTaskFailedRequest) Reset()         { *m = RespondActivit
 func (m *RespondActivityTaskFailedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedRequest) ProtoMessage()    {}
 func (*RespondActivityTaskFailedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{32}
+	return fileDescriptor_fee8ff76963a38ed, []int{37}
 }
 func (m *RespondActivityTaskFailedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2342,7 +2670,7 @@ func (m *RespondActivityTaskFailedResponse) Reset()         { *m = RespondActivi
 func (m *RespondActivityTaskFailedResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskFailedResponse) ProtoMessage()    {}
 func (*RespondActivityTaskFailedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{33}
+	return fileDescriptor_fee8ff76963a38ed, []int{38}
 }
 func (m *RespondActivityTaskFailedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2383,7 +2711,7 @@ func (m *RespondActivityTaskCanceledRequest) Reset()         { *m = RespondActiv
 func (m *RespondActivityTaskCanceledRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledRequest) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{34}
+	return fileDescriptor_fee8ff76963a38ed, []int{39}
 }
 func (m *RespondActivityTaskCanceledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2436,7 +2764,7 @@ func (m *RespondActivityTaskCanceledResponse) Reset()         { *m = RespondActi
 func (m *RespondActivityTaskCanceledResponse) String() string { return proto.CompactTextString(m) }
 func (*RespondActivityTaskCanceledResponse) ProtoMessage()    {}
 func (*RespondActivityTaskCanceledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{35}
+	return fileDescriptor_fee8ff76963a38ed, []int{40}
 }
 func (m *RespondActivityTaskCanceledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2478,7 +2806,7 @@ func (m *RemoveSignalMutableStateRequest) Reset()         { *m = RemoveSignalMut
 func (m *RemoveSignalMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateRequest) ProtoMessage()    {}
 func (*RemoveSignalMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{36}
+	return fileDescriptor_fee8ff76963a38ed, []int{41}
 }
 func (m *RemoveSignalMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2538,7 +2866,7 @@ func (m *RemoveSignalMutableStateResponse) Reset()         { *m = RemoveSignalMu
 func (m *RemoveSignalMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveSignalMutableStateResponse) ProtoMessage()    {}
 func (*RemoveSignalMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{37}
+	return fileDescriptor_fee8ff76963a38ed, []int{42}
 }
 func (m *RemoveSignalMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2584,7 +2912,7 @@ func (m *RequestCancelWorkflowExecutionRequest) Reset()         { *m = RequestCa
 func (m *RequestCancelWorkflowExecutionRequest) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionRequest) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{38}
+	return fileDescriptor_fee8ff76963a38ed, []int{43}
 }
 func (m *RequestCancelWorkflowExecutionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2653,7 +2981,7 @@ func (m *RequestCancelWorkflowExecutionResponse) Reset() {
 func (m *RequestCancelWorkflowExecutionResponse) String() string { return proto.CompactTextString(m) }
 func (*RequestCancelWorkflowExecutionResponse) ProtoMessage()    {}
 func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{39}
+	return fileDescriptor_fee8ff76963a38ed, []int{44}
 }
 func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2695,7 +3023,7 @@ func (m *ScheduleDecisionTaskRequest) Reset()         { *m = ScheduleDecisionTas
 func (m *ScheduleDecisionTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskRequest) ProtoMessage()    {}
 func (*ScheduleDecisionTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{40}
+	return fileDescriptor_fee8ff76963a38ed, []int{45}
 }
 func (m *ScheduleDecisionTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2755,7 +3083,7 @@ func (m *ScheduleDecisionTaskResponse) Reset()         { *m = ScheduleDecisionTa
 func (m *ScheduleDecisionTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*ScheduleDecisionTaskResponse) ProtoMessage()    {}
 func (*ScheduleDecisionTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{41}
+	return fileDescriptor_fee8ff76963a38ed, []int{46}
 }
 func (m *ScheduleDecisionTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2804,7 +3132,7 @@ func (m *RecordChildExecutionCompletedRequest) Reset()         { *m = RecordChil
 func (m *RecordChildExecutionCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedRequest) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{42}
+	return fileDescriptor_fee8ff76963a38ed, []int{47}
 }
 func (m *RecordChildExecutionCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2878,7 +3206,7 @@ func (m *RecordChildExecutionCompletedResponse) Reset()         { *m = RecordChi
 func (m *RecordChildExecutionCompletedResponse) String() string { return proto.CompactTextString(m) }
 func (*RecordChildExecutionCompletedResponse) ProtoMessage()    {}
 func (*RecordChildExecutionCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{43}
+	return fileDescriptor_fee8ff76963a38ed, []int{48}
 }
 func (m *RecordChildExecutionCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2923,7 +3251,7 @@ func (m *ReplicateEventsV2Request) Reset()         { *m = ReplicateEventsV2Reque
 func (m *ReplicateEventsV2Request) String() string { return proto.CompactTextString(m) }
 func (*ReplicateEventsV2Request) ProtoMessage()    {}
 func (*ReplicateEventsV2Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{44}
+	return fileDescriptor_fee8ff76963a38ed, []int{49}
 }
 func (m *ReplicateEventsV2Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2997,7 +3325,7 @@ func (m *ReplicateEventsV2Response) Reset()         { *m = ReplicateEventsV2Resp
 func (m *ReplicateEventsV2Response) String() string { return proto.CompactTextString(m) }
 func (*ReplicateEventsV2Response) ProtoMessage()    {}
 func (*ReplicateEventsV2Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{45}
+	return fileDescriptor_fee8ff76963a38ed, []int{50}
 }
 func (m *ReplicateEventsV2Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3039,7 +3367,7 @@ func (m *SyncShardStatusRequest) Reset()         { *m = SyncShardStatusRequest{}
 func (m *SyncShardStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusRequest) ProtoMessage()    {}
 func (*SyncShardStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{46}
+	return fileDescriptor_fee8ff76963a38ed, []int{51}
 }
 func (m *SyncShardStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3099,7 +3427,7 @@ func (m *SyncShardStatusResponse) Reset()         { *m = SyncShardStatusResponse
 func (m *SyncShardStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncShardStatusResponse) ProtoMessage()    {}
 func (*SyncShardStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{47}
+	return fileDescriptor_fee8ff76963a38ed, []int{52}
 }
 func (m *SyncShardStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3151,7 +3479,7 @@ func (m *SyncActivityRequest) Reset()         { *m = SyncActivityRequest{} }
 func (m *SyncActivityRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityRequest) ProtoMessage()    {}
 func (*SyncActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{48}
+	return fileDescriptor_fee8ff76963a38ed, []int{53}
 }
 func (m *SyncActivityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3281,7 +3609,7 @@ func (m *SyncActivityResponse) Reset()         { *m = SyncActivityResponse{} }
 func (m *SyncActivityResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncActivityResponse) ProtoMessage()    {}
 func (*SyncActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{49}
+	return fileDescriptor_fee8ff76963a38ed, []int{54}
 }
 func (m *SyncActivityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3322,7 +3650,7 @@ func (m *DescribeMutableStateRequest) Reset()         { *m = DescribeMutableStat
 func (m *DescribeMutableStateRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateRequest) ProtoMessage()    {}
 func (*DescribeMutableStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{50}
+	return fileDescriptor_fee8ff76963a38ed, []int{55}
 }
 func (m *DescribeMutableStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3377,7 +3705,7 @@ func (m *DescribeMutableStateResponse) Reset()         { *m = DescribeMutableSta
 func (m *DescribeMutableStateResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeMutableStateResponse) ProtoMessage()    {}
 func (*DescribeMutableStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{51}
+	return fileDescriptor_fee8ff76963a38ed, []int{56}
 }
 func (m *DescribeMutableStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3430,7 +3758,7 @@ func (m *DescribeHistoryHostRequest) Reset()         { *m = DescribeHistoryHostR
 func (m *DescribeHistoryHostRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostRequest) ProtoMessage()    {}
 func (*DescribeHistoryHostRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{52}
+	return fileDescriptor_fee8ff76963a38ed, []int{57}
 }
 func (m *DescribeHistoryHostRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,21 +3788,22 @@ func (m *DescribeHistoryHostRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_DescribeHistoryHostRequest proto.InternalMessageInfo
 
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        int32                `protobuf:"varint,1,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
-	ShardIds              []int32              `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
-	DomainCache           *v11.DomainCacheInfo `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
-	ShardControllerStatus string               `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
-	Address               string               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}             `json:"-"`
-	XXX_unrecognized      []byte               `json:"-"`
-	XXX_sizecache         int32                `json:"-"`
+	NumberOfShards        int32                         `protobuf:"varint,1,opt,name=number_of_shards,json=numberOfShards,proto3" json:"number_of_shards,omitempty"`
+	ShardIds              []int32                       `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	DomainCache           *v11.DomainCacheInfo          `protobuf:"bytes,3,opt,name=domain_cache,json=domainCache,proto3" json:"domain_cache,omitempty"`
+	ShardControllerStatus string                        `protobuf:"bytes,4,opt,name=shard_controller_status,json=shardControllerStatus,proto3" json:"shard_controller_status,omitempty"`
+	Address               string                        `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	PayloadSizeStats      []*v11.DomainPayloadSizeStats `protobuf:"bytes,6,rep,name=payload_size_stats,json=payloadSizeStats,proto3" json:"payload_size_stats,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}                      `json:"-"`
+	XXX_unrecognized      []byte                        `json:"-"`
+	XXX_sizecache         int32                         `json:"-"`
 }
 
 func (m *DescribeHistoryHostResponse) Reset()         { *m = DescribeHistoryHostResponse{} }
 func (m *DescribeHistoryHostResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeHistoryHostResponse) ProtoMessage()    {}
 func (*DescribeHistoryHostResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{53}
+	return fileDescriptor_fee8ff76963a38ed, []int{58}
 }
 func (m *DescribeHistoryHostResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3538,6 +3867,13 @@ func (m *DescribeHistoryHostResponse) GetAddress() string {
 	return ""
 }
 
+func (m *DescribeHistoryHostResponse) GetPayloadSizeStats() []*v11.DomainPayloadSizeStats {
+	if m != nil {
+		return m.PayloadSizeStats
+	}
+	return nil
+}
+
 type CloseShardRequest struct {
 	ShardId              int32    `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -3549,7 +3885,7 @@ func (m *CloseShardRequest) Reset()         { *m = CloseShardRequest{} }
 func (m *CloseShardRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseShardRequest) ProtoMessage()    {}
 func (*CloseShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{54}
+	return fileDescriptor_fee8ff76963a38ed, []int{59}
 }
 func (m *CloseShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3595,7 +3931,7 @@ func (m *CloseShardResponse) Reset()         { *m = CloseShardResponse{} }
 func (m *CloseShardResponse) String() string { return proto.CompactTextString(m) }
 func (*CloseShardResponse) ProtoMessage()    {}
 func (*CloseShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{55}
+	return fileDescriptor_fee8ff76963a38ed, []int{60}
 }
 func (m *CloseShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3639,7 +3975,7 @@ func (m *RemoveTaskRequest) Reset()         { *m = RemoveTaskRequest{} }
 func (m *RemoveTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskRequest) ProtoMessage()    {}
 func (*RemoveTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{56}
+	return fileDescriptor_fee8ff76963a38ed, []int{61}
 }
 func (m *RemoveTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3713,7 +4049,7 @@ func (m *RemoveTaskResponse) Reset()         { *m = RemoveTaskResponse{} }
 func (m *RemoveTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveTaskResponse) ProtoMessage()    {}
 func (*RemoveTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{57}
+	return fileDescriptor_fee8ff76963a38ed, []int{62}
 }
 func (m *RemoveTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3755,7 +4091,7 @@ func (m *ResetQueueRequest) Reset()         { *m = ResetQueueRequest{} }
 func (m *ResetQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueRequest) ProtoMessage()    {}
 func (*ResetQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{58}
+	return fileDescriptor_fee8ff76963a38ed, []int{63}
 }
 func (m *ResetQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3815,7 +4151,7 @@ func (m *ResetQueueResponse) Reset()         { *m = ResetQueueResponse{} }
 func (m *ResetQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetQueueResponse) ProtoMessage()    {}
 func (*ResetQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{59}
+	return fileDescriptor_fee8ff76963a38ed, []int{64}
 }
 func (m *ResetQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3857,7 +4193,7 @@ func (m *DescribeQueueRequest) Reset()         { *m = DescribeQueueRequest{} }
 func (m *DescribeQueueRequest) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueRequest) ProtoMessage()    {}
 func (*DescribeQueueRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{60}
+	return fileDescriptor_fee8ff76963a38ed, []int{65}
 }
 func (m *DescribeQueueRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3918,7 +4254,7 @@ func (m *DescribeQueueResponse) Reset()         { *m = DescribeQueueResponse{} }
 func (m *DescribeQueueResponse) String() string { return proto.CompactTextString(m) }
 func (*DescribeQueueResponse) ProtoMessage()    {}
 func (*DescribeQueueResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{61}
+	return fileDescriptor_fee8ff76963a38ed, []int{66}
 }
 func (m *DescribeQueueResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3954,19 +4290,115 @@ func (m *DescribeQueueResponse) GetProcessingQueueStates() []string {
 	return nil
 }
 
+type GetReplicationAckStatesRequest struct {
+	ShardId              int32    `protobuf:"varint,1,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetReplicationAckStatesRequest) Reset()         { *m = GetReplicationAckStatesRequest{} }
+func (m *GetReplicationAckStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationAckStatesRequest) ProtoMessage()    {}
+func (*GetReplicationAckStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{67}
+}
+func (m *GetReplicationAckStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationAckStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationAckStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationAckStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationAckStatesRequest.Merge(m, src)
+}
+func (m *GetReplicationAckStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationAckStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationAckStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationAckStatesRequest proto.InternalMessageInfo
+
+func (m *GetReplicationAckStatesRequest) GetShardId() int32 {
+	if m != nil {
+		return m.ShardId
+	}
+	return 0
+}
+
+type GetReplicationAckStatesResponse struct {
+	AckStates            []*v11.ReplicationAckState `protobuf:"bytes,1,rep,name=ack_states,json=ackStates,proto3" json:"ack_states,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *GetReplicationAckStatesResponse) Reset()         { *m = GetReplicationAckStatesResponse{} }
+func (m *GetReplicationAckStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetReplicationAckStatesResponse) ProtoMessage()    {}
+func (*GetReplicationAckStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{68}
+}
+func (m *GetReplicationAckStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetReplicationAckStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetReplicationAckStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetReplicationAckStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetReplicationAckStatesResponse.Merge(m, src)
+}
+func (m *GetReplicationAckStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetReplicationAckStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetReplicationAckStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetReplicationAckStatesResponse proto.InternalMessageInfo
+
+func (m *GetReplicationAckStatesResponse) GetAckStates() []*v11.ReplicationAckState {
+	if m != nil {
+		return m.AckStates
+	}
+	return nil
+}
+
 type GetReplicationMessagesRequest struct {
-	Tokens               []*v11.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	ClusterName          string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	Tokens      []*v11.ReplicationToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	ClusterName string                  `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Compressions the requesting cluster can decode.
+	SupportedCompressions []v11.CompressionType `protobuf:"varint,3,rep,packed,name=supported_compressions,json=supportedCompressions,proto3,enum=uber.cadence.shared.v1.CompressionType" json:"supported_compressions,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
 }
 
 func (m *GetReplicationMessagesRequest) Reset()         { *m = GetReplicationMessagesRequest{} }
 func (m *GetReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{62}
+	return fileDescriptor_fee8ff76963a38ed, []int{69}
 }
 func (m *GetReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4009,6 +4441,13 @@ func (m *GetReplicationMessagesRequest) GetClusterName() string {
 	return ""
 }
 
+func (m *GetReplicationMessagesRequest) GetSupportedCompressions() []v11.CompressionType {
+	if m != nil {
+		return m.SupportedCompressions
+	}
+	return nil
+}
+
 type GetReplicationMessagesResponse struct {
 	ShardMessages        map[int32]*v11.ReplicationMessages `protobuf:"bytes,1,rep,name=shard_messages,json=shardMessages,proto3" json:"shard_messages,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
@@ -4020,7 +4459,7 @@ func (m *GetReplicationMessagesResponse) Reset()         { *m = GetReplicationMe
 func (m *GetReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{63}
+	return fileDescriptor_fee8ff76963a38ed, []int{70}
 }
 func (m *GetReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4057,17 +4496,19 @@ func (m *GetReplicationMessagesResponse) GetShardMessages() map[int32]*v11.Repli
 }
 
 type GetDLQReplicationMessagesRequest struct {
-	TaskInfos            []*v11.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+	TaskInfos []*v11.ReplicationTaskInfo `protobuf:"bytes,1,rep,name=task_infos,json=taskInfos,proto3" json:"task_infos,omitempty"`
+	// Compressions the requesting cluster can decode.
+	SupportedCompressions []v11.CompressionType `protobuf:"varint,2,rep,packed,name=supported_compressions,json=supportedCompressions,proto3,enum=uber.cadence.shared.v1.CompressionType" json:"supported_compressions,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}              `json:"-"`
+	XXX_unrecognized      []byte                `json:"-"`
+	XXX_sizecache         int32                 `json:"-"`
 }
 
 func (m *GetDLQReplicationMessagesRequest) Reset()         { *m = GetDLQReplicationMessagesRequest{} }
 func (m *GetDLQReplicationMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesRequest) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{64}
+	return fileDescriptor_fee8ff76963a38ed, []int{71}
 }
 func (m *GetDLQReplicationMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4103,6 +4544,13 @@ func (m *GetDLQReplicationMessagesRequest) GetTaskInfos() []*v11.ReplicationTask
 	return nil
 }
 
+func (m *GetDLQReplicationMessagesRequest) GetSupportedCompressions() []v11.CompressionType {
+	if m != nil {
+		return m.SupportedCompressions
+	}
+	return nil
+}
+
 type GetDLQReplicationMessagesResponse struct {
 	ReplicationTasks     []*v11.ReplicationTask `protobuf:"bytes,1,rep,name=replication_tasks,json=replicationTasks,proto3" json:"replication_tasks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -4114,7 +4562,7 @@ func (m *GetDLQReplicationMessagesResponse) Reset()         { *m = GetDLQReplica
 func (m *GetDLQReplicationMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDLQReplicationMessagesResponse) ProtoMessage()    {}
 func (*GetDLQReplicationMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{65}
+	return fileDescriptor_fee8ff76963a38ed, []int{72}
 }
 func (m *GetDLQReplicationMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4164,7 +4612,7 @@ func (m *ReapplyEventsRequest) Reset()         { *m = ReapplyEventsRequest{} }
 func (m *ReapplyEventsRequest) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsRequest) ProtoMessage()    {}
 func (*ReapplyEventsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{66}
+	return fileDescriptor_fee8ff76963a38ed, []int{73}
 }
 func (m *ReapplyEventsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4231,7 +4679,7 @@ func (m *ReapplyEventsResponse) Reset()         { *m = ReapplyEventsResponse{} }
 func (m *ReapplyEventsResponse) String() string { return proto.CompactTextString(m) }
 func (*ReapplyEventsResponse) ProtoMessage()    {}
 func (*ReapplyEventsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{67}
+	return fileDescriptor_fee8ff76963a38ed, []int{74}
 }
 func (m *ReapplyEventsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4273,7 +4721,7 @@ func (m *RefreshWorkflowTasksRequest) Reset()         { *m = RefreshWorkflowTask
 func (m *RefreshWorkflowTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksRequest) ProtoMessage()    {}
 func (*RefreshWorkflowTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{68}
+	return fileDescriptor_fee8ff76963a38ed, []int{75}
 }
 func (m *RefreshWorkflowTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4333,7 +4781,7 @@ func (m *RefreshWorkflowTasksResponse) Reset()         { *m = RefreshWorkflowTas
 func (m *RefreshWorkflowTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*RefreshWorkflowTasksResponse) ProtoMessage()    {}
 func (*RefreshWorkflowTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{69}
+	return fileDescriptor_fee8ff76963a38ed, []int{76}
 }
 func (m *RefreshWorkflowTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4378,7 +4826,7 @@ func (m *ReadDLQMessagesRequest) Reset()         { *m = ReadDLQMessagesRequest{}
 func (m *ReadDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesRequest) ProtoMessage()    {}
 func (*ReadDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{70}
+	return fileDescriptor_fee8ff76963a38ed, []int{77}
 }
 func (m *ReadDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4463,7 +4911,7 @@ func (m *ReadDLQMessagesResponse) Reset()         { *m = ReadDLQMessagesResponse
 func (m *ReadDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*ReadDLQMessagesResponse) ProtoMessage()    {}
 func (*ReadDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{71}
+	return fileDescriptor_fee8ff76963a38ed, []int{78}
 }
 func (m *ReadDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4534,7 +4982,7 @@ func (m *PurgeDLQMessagesRequest) Reset()         { *m = PurgeDLQMessagesRequest
 func (m *PurgeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesRequest) ProtoMessage()    {}
 func (*PurgeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{72}
+	return fileDescriptor_fee8ff76963a38ed, []int{79}
 }
 func (m *PurgeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4601,7 +5049,7 @@ func (m *PurgeDLQMessagesResponse) Reset()         { *m = PurgeDLQMessagesRespon
 func (m *PurgeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*PurgeDLQMessagesResponse) ProtoMessage()    {}
 func (*PurgeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{73}
+	return fileDescriptor_fee8ff76963a38ed, []int{80}
 }
 func (m *PurgeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4646,7 +5094,7 @@ func (m *MergeDLQMessagesRequest) Reset()         { *m = MergeDLQMessagesRequest
 func (m *MergeDLQMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesRequest) ProtoMessage()    {}
 func (*MergeDLQMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{74}
+	return fileDescriptor_fee8ff76963a38ed, []int{81}
 }
 func (m *MergeDLQMessagesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4728,7 +5176,7 @@ func (m *MergeDLQMessagesResponse) Reset()         { *m = MergeDLQMessagesRespon
 func (m *MergeDLQMessagesResponse) String() string { return proto.CompactTextString(m) }
 func (*MergeDLQMessagesResponse) ProtoMessage()    {}
 func (*MergeDLQMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{75}
+	return fileDescriptor_fee8ff76963a38ed, []int{82}
 }
 func (m *MergeDLQMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4775,7 +5223,7 @@ func (m *NotifyFailoverMarkersRequest) Reset()         { *m = NotifyFailoverMark
 func (m *NotifyFailoverMarkersRequest) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersRequest) ProtoMessage()    {}
 func (*NotifyFailoverMarkersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{76}
+	return fileDescriptor_fee8ff76963a38ed, []int{83}
 }
 func (m *NotifyFailoverMarkersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4821,7 +5269,7 @@ func (m *NotifyFailoverMarkersResponse) Reset()         { *m = NotifyFailoverMar
 func (m *NotifyFailoverMarkersResponse) String() string { return proto.CompactTextString(m) }
 func (*NotifyFailoverMarkersResponse) ProtoMessage()    {}
 func (*NotifyFailoverMarkersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{77}
+	return fileDescriptor_fee8ff76963a38ed, []int{84}
 }
 func (m *NotifyFailoverMarkersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4862,7 +5310,7 @@ func (m *GetCrossClusterTasksRequest) Reset()         { *m = GetCrossClusterTask
 func (m *GetCrossClusterTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksRequest) ProtoMessage()    {}
 func (*GetCrossClusterTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{78}
+	return fileDescriptor_fee8ff76963a38ed, []int{85}
 }
 func (m *GetCrossClusterTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4917,7 +5365,7 @@ func (m *GetCrossClusterTasksResponse) Reset()         { *m = GetCrossClusterTas
 func (m *GetCrossClusterTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCrossClusterTasksResponse) ProtoMessage()    {}
 func (*GetCrossClusterTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{79}
+	return fileDescriptor_fee8ff76963a38ed, []int{86}
 }
 func (m *GetCrossClusterTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4976,7 +5424,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Reset() {
 func (m *RespondCrossClusterTasksCompletedRequest) String() string { return proto.CompactTextString(m) }
 func (*RespondCrossClusterTasksCompletedRequest) ProtoMessage()    {}
 func (*RespondCrossClusterTasksCompletedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{80}
+	return fileDescriptor_fee8ff76963a38ed, []int{87}
 }
 func (m *RespondCrossClusterTasksCompletedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5048,7 +5496,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) String() string {
 }
 func (*RespondCrossClusterTasksCompletedResponse) ProtoMessage() {}
 func (*RespondCrossClusterTasksCompletedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{81}
+	return fileDescriptor_fee8ff76963a38ed, []int{88}
 }
 func (m *RespondCrossClusterTasksCompletedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5095,7 +5543,7 @@ func (m *GetFailoverInfoRequest) Reset()         { *m = GetFailoverInfoRequest{}
 func (m *GetFailoverInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoRequest) ProtoMessage()    {}
 func (*GetFailoverInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{82}
+	return fileDescriptor_fee8ff76963a38ed, []int{89}
 }
 func (m *GetFailoverInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5143,7 +5591,7 @@ func (m *GetFailoverInfoResponse) Reset()         { *m = GetFailoverInfoResponse
 func (m *GetFailoverInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetFailoverInfoResponse) ProtoMessage()    {}
 func (*GetFailoverInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fee8ff76963a38ed, []int{83}
+	return fileDescriptor_fee8ff76963a38ed, []int{90}
 }
 func (m *GetFailoverInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5186,82 +5634,285 @@ func (m *GetFailoverInfoResponse) GetPendingShards() []int32 {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
-	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.StartWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionResponse")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest")
-	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionResponse")
-	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionRequest")
-	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
-	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
-	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
-	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
-	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.history.v1.QueryWorkflowResponse")
-	proto.RegisterType((*ResetStickyTaskListRequest)(nil), "uber.cadence.history.v1.ResetStickyTaskListRequest")
-	proto.RegisterType((*ResetStickyTaskListResponse)(nil), "uber.cadence.history.v1.ResetStickyTaskListResponse")
-	proto.RegisterType((*GetMutableStateRequest)(nil), "uber.cadence.history.v1.GetMutableStateRequest")
-	proto.RegisterType((*GetMutableStateResponse)(nil), "uber.cadence.history.v1.GetMutableStateResponse")
-	proto.RegisterType((*PollMutableStateRequest)(nil), "uber.cadence.history.v1.PollMutableStateRequest")
-	proto.RegisterType((*PollMutableStateResponse)(nil), "uber.cadence.history.v1.PollMutableStateResponse")
-	proto.RegisterType((*RecordDecisionTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedRequest")
-	proto.RegisterType((*RecordDecisionTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse")
-	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse.QueriesEntry")
-	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedRequest")
-	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedResponse")
-	proto.RegisterType((*RespondDecisionTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedRequest")
-	proto.RegisterType((*RespondDecisionTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse")
-	proto.RegisterMapType((map[string]*v1.ActivityLocalDispatchInfo)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse.ActivitiesToDispatchLocallyEntry")
-	proto.RegisterType((*RespondDecisionTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedRequest")
-	proto.RegisterType((*RespondDecisionTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedResponse")
-	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatRequest")
-	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatResponse")
-	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedRequest")
-	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedResponse")
-	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedRequest")
-	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedResponse")
-	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledRequest")
-	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledResponse")
-	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateRequest")
-	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateResponse")
-	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionRequest")
-	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionResponse")
-	proto.RegisterType((*ScheduleDecisionTaskRequest)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskRequest")
-	proto.RegisterType((*ScheduleDecisionTaskResponse)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskResponse")
-	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedRequest")
-	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedResponse")
-	proto.RegisterType((*ReplicateEventsV2Request)(nil), "uber.cadence.history.v1.ReplicateEventsV2Request")
-	proto.RegisterType((*ReplicateEventsV2Response)(nil), "uber.cadence.history.v1.ReplicateEventsV2Response")
-	proto.RegisterType((*SyncShardStatusRequest)(nil), "uber.cadence.history.v1.SyncShardStatusRequest")
-	proto.RegisterType((*SyncShardStatusResponse)(nil), "uber.cadence.history.v1.SyncShardStatusResponse")
-	proto.RegisterType((*SyncActivityRequest)(nil), "uber.cadence.history.v1.SyncActivityRequest")
-	proto.RegisterType((*SyncActivityResponse)(nil), "uber.cadence.history.v1.SyncActivityResponse")
-	proto.RegisterType((*DescribeMutableStateRequest)(nil), "uber.cadence.history.v1.DescribeMutableStateRequest")
-	proto.RegisterType((*DescribeMutableStateResponse)(nil), "uber.cadence.history.v1.DescribeMutableStateResponse")
-	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "uber.cadence.history.v1.DescribeHistoryHostRequest")
-	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "uber.cadence.history.v1.DescribeHistoryHostResponse")
-	proto.RegisterType((*CloseShardRequest)(nil), "uber.cadence.history.v1.CloseShardRequest")
-	proto.RegisterType((*CloseShardResponse)(nil), "uber.cadence.history.v1.CloseShardResponse")
-	proto.RegisterType((*RemoveTaskRequest)(nil), "uber.cadence.history.v1.RemoveTaskRequest")
-	proto.RegisterType((*RemoveTaskResponse)(nil), "uber.cadence.history.v1.RemoveTaskResponse")
-	proto.RegisterType((*ResetQueueRequest)(nil), "uber.cadence.history.v1.ResetQueueRequest")
-	proto.RegisterType((*ResetQueueResponse)(nil), "uber.cadence.history.v1.ResetQueueResponse")
-	proto.RegisterType((*DescribeQueueRequest)(nil), "uber.cadence.history.v1.DescribeQueueRequest")
-	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.history.v1.DescribeQueueResponse")
-	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetReplicationMessagesRequest")
-	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse")
-	proto.RegisterMapType((map[int32]*v11.ReplicationMessages)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
-	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesRequest")
-	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesResponse")
-	proto.RegisterType((*ReapplyEventsRequest)(nil), "uber.cadence.history.v1.ReapplyEventsRequest")
-	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.history.v1.ReapplyEventsResponse")
-	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksRequest")
-	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksResponse")
-	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.history.v1.ReadDLQMessagesRequest")
-	proto.RegisterType((*ReadDLQMessagesResponse)(nil), "uber.cadence.history.v1.ReadDLQMessagesResponse")
+type GetDomainReplicationWatermarkRequest struct {
+	DomainId             string   `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	ShardIds             []int32  `protobuf:"varint,2,rep,packed,name=shard_ids,json=shardIds,proto3" json:"shard_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDomainReplicationWatermarkRequest) Reset()         { *m = GetDomainReplicationWatermarkRequest{} }
+func (m *GetDomainReplicationWatermarkRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDomainReplicationWatermarkRequest) ProtoMessage()    {}
+func (*GetDomainReplicationWatermarkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{91}
+}
+func (m *GetDomainReplicationWatermarkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDomainReplicationWatermarkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDomainReplicationWatermarkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetDomainReplicationWatermarkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDomainReplicationWatermarkRequest.Merge(m, src)
+}
+func (m *GetDomainReplicationWatermarkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDomainReplicationWatermarkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDomainReplicationWatermarkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDomainReplicationWatermarkRequest proto.InternalMessageInfo
+
+func (m *GetDomainReplicationWatermarkRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *GetDomainReplicationWatermarkRequest) GetShardIds() []int32 {
+	if m != nil {
+		return m.ShardIds
+	}
+	return nil
+}
+
+type GetDomainReplicationWatermarkResponse struct {
+	LastReplicatedEventTime *types.Timestamp `protobuf:"bytes,1,opt,name=last_replicated_event_time,json=lastReplicatedEventTime,proto3" json:"last_replicated_event_time,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{}         `json:"-"`
+	XXX_unrecognized        []byte           `json:"-"`
+	XXX_sizecache           int32            `json:"-"`
+}
+
+func (m *GetDomainReplicationWatermarkResponse) Reset()         { *m = GetDomainReplicationWatermarkResponse{} }
+func (m *GetDomainReplicationWatermarkResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDomainReplicationWatermarkResponse) ProtoMessage()    {}
+func (*GetDomainReplicationWatermarkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{92}
+}
+func (m *GetDomainReplicationWatermarkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDomainReplicationWatermarkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDomainReplicationWatermarkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetDomainReplicationWatermarkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDomainReplicationWatermarkResponse.Merge(m, src)
+}
+func (m *GetDomainReplicationWatermarkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDomainReplicationWatermarkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDomainReplicationWatermarkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDomainReplicationWatermarkResponse proto.InternalMessageInfo
+
+func (m *GetDomainReplicationWatermarkResponse) GetLastReplicatedEventTime() *types.Timestamp {
+	if m != nil {
+		return m.LastReplicatedEventTime
+	}
+	return nil
+}
+
+type ReconcileWorkflowRetentionRequest struct {
+	DomainId             string                `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	WorkflowExecution    *v1.WorkflowExecution `protobuf:"bytes,2,opt,name=workflow_execution,json=workflowExecution,proto3" json:"workflow_execution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReconcileWorkflowRetentionRequest) Reset()         { *m = ReconcileWorkflowRetentionRequest{} }
+func (m *ReconcileWorkflowRetentionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileWorkflowRetentionRequest) ProtoMessage()    {}
+func (*ReconcileWorkflowRetentionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{93}
+}
+func (m *ReconcileWorkflowRetentionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileWorkflowRetentionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileWorkflowRetentionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconcileWorkflowRetentionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileWorkflowRetentionRequest.Merge(m, src)
+}
+func (m *ReconcileWorkflowRetentionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileWorkflowRetentionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileWorkflowRetentionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileWorkflowRetentionRequest proto.InternalMessageInfo
+
+func (m *ReconcileWorkflowRetentionRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
+	}
+	return ""
+}
+
+func (m *ReconcileWorkflowRetentionRequest) GetWorkflowExecution() *v1.WorkflowExecution {
+	if m != nil {
+		return m.WorkflowExecution
+	}
+	return nil
+}
+
+type ReconcileWorkflowRetentionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconcileWorkflowRetentionResponse) Reset()         { *m = ReconcileWorkflowRetentionResponse{} }
+func (m *ReconcileWorkflowRetentionResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconcileWorkflowRetentionResponse) ProtoMessage()    {}
+func (*ReconcileWorkflowRetentionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fee8ff76963a38ed, []int{94}
+}
+func (m *ReconcileWorkflowRetentionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReconcileWorkflowRetentionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReconcileWorkflowRetentionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReconcileWorkflowRetentionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileWorkflowRetentionResponse.Merge(m, src)
+}
+func (m *ReconcileWorkflowRetentionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReconcileWorkflowRetentionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileWorkflowRetentionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileWorkflowRetentionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*StartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.StartWorkflowExecutionRequest")
+	proto.RegisterType((*StartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.StartWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*SignalBatchWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalBatchWorkflowExecutionRequest")
+	proto.RegisterType((*SignalBatchRequest)(nil), "uber.cadence.history.v1.SignalBatchRequest")
+	proto.RegisterType((*SignalBatchItem)(nil), "uber.cadence.history.v1.SignalBatchItem")
+	proto.RegisterType((*SignalBatchWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalBatchWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionRequest")
+	proto.RegisterType((*SignalWithStartWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.SignalWithStartWorkflowExecutionResponse")
+	proto.RegisterType((*ResetWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionRequest")
+	proto.RegisterType((*ResetWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.ResetWorkflowExecutionResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionRequest")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*DescribeWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionRequest")
+	proto.RegisterType((*DescribeWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.DescribeWorkflowExecutionResponse")
+	proto.RegisterType((*HistoryLimitWarning)(nil), "uber.cadence.history.v1.HistoryLimitWarning")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.history.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.history.v1.QueryWorkflowResponse")
+	proto.RegisterType((*ResetStickyTaskListRequest)(nil), "uber.cadence.history.v1.ResetStickyTaskListRequest")
+	proto.RegisterType((*ResetStickyTaskListResponse)(nil), "uber.cadence.history.v1.ResetStickyTaskListResponse")
+	proto.RegisterType((*GetMutableStateRequest)(nil), "uber.cadence.history.v1.GetMutableStateRequest")
+	proto.RegisterType((*GetMutableStateResponse)(nil), "uber.cadence.history.v1.GetMutableStateResponse")
+	proto.RegisterType((*PollMutableStateRequest)(nil), "uber.cadence.history.v1.PollMutableStateRequest")
+	proto.RegisterType((*PollMutableStateResponse)(nil), "uber.cadence.history.v1.PollMutableStateResponse")
+	proto.RegisterType((*RecordDecisionTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedRequest")
+	proto.RegisterType((*RecordDecisionTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse")
+	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.history.v1.RecordDecisionTaskStartedResponse.QueriesEntry")
+	proto.RegisterType((*RecordActivityTaskStartedRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedRequest")
+	proto.RegisterType((*RecordActivityTaskStartedResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskStartedResponse")
+	proto.RegisterType((*RespondDecisionTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedRequest")
+	proto.RegisterType((*RespondDecisionTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse")
+	proto.RegisterMapType((map[string]*v1.ActivityLocalDispatchInfo)(nil), "uber.cadence.history.v1.RespondDecisionTaskCompletedResponse.ActivitiesToDispatchLocallyEntry")
+	proto.RegisterType((*RespondDecisionTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedRequest")
+	proto.RegisterType((*RespondDecisionTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondDecisionTaskFailedResponse")
+	proto.RegisterType((*RecordActivityTaskHeartbeatRequest)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatRequest")
+	proto.RegisterType((*RecordActivityTaskHeartbeatResponse)(nil), "uber.cadence.history.v1.RecordActivityTaskHeartbeatResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedRequest")
+	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCompletedResponse")
+	proto.RegisterType((*RespondActivityTaskFailedRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedRequest")
+	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledRequest)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledRequest")
+	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "uber.cadence.history.v1.RespondActivityTaskCanceledResponse")
+	proto.RegisterType((*RemoveSignalMutableStateRequest)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateRequest")
+	proto.RegisterType((*RemoveSignalMutableStateResponse)(nil), "uber.cadence.history.v1.RemoveSignalMutableStateResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionRequest)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionRequest")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.history.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*ScheduleDecisionTaskRequest)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskRequest")
+	proto.RegisterType((*ScheduleDecisionTaskResponse)(nil), "uber.cadence.history.v1.ScheduleDecisionTaskResponse")
+	proto.RegisterType((*RecordChildExecutionCompletedRequest)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedRequest")
+	proto.RegisterType((*RecordChildExecutionCompletedResponse)(nil), "uber.cadence.history.v1.RecordChildExecutionCompletedResponse")
+	proto.RegisterType((*ReplicateEventsV2Request)(nil), "uber.cadence.history.v1.ReplicateEventsV2Request")
+	proto.RegisterType((*ReplicateEventsV2Response)(nil), "uber.cadence.history.v1.ReplicateEventsV2Response")
+	proto.RegisterType((*SyncShardStatusRequest)(nil), "uber.cadence.history.v1.SyncShardStatusRequest")
+	proto.RegisterType((*SyncShardStatusResponse)(nil), "uber.cadence.history.v1.SyncShardStatusResponse")
+	proto.RegisterType((*SyncActivityRequest)(nil), "uber.cadence.history.v1.SyncActivityRequest")
+	proto.RegisterType((*SyncActivityResponse)(nil), "uber.cadence.history.v1.SyncActivityResponse")
+	proto.RegisterType((*DescribeMutableStateRequest)(nil), "uber.cadence.history.v1.DescribeMutableStateRequest")
+	proto.RegisterType((*DescribeMutableStateResponse)(nil), "uber.cadence.history.v1.DescribeMutableStateResponse")
+	proto.RegisterType((*DescribeHistoryHostRequest)(nil), "uber.cadence.history.v1.DescribeHistoryHostRequest")
+	proto.RegisterType((*DescribeHistoryHostResponse)(nil), "uber.cadence.history.v1.DescribeHistoryHostResponse")
+	proto.RegisterType((*CloseShardRequest)(nil), "uber.cadence.history.v1.CloseShardRequest")
+	proto.RegisterType((*CloseShardResponse)(nil), "uber.cadence.history.v1.CloseShardResponse")
+	proto.RegisterType((*RemoveTaskRequest)(nil), "uber.cadence.history.v1.RemoveTaskRequest")
+	proto.RegisterType((*RemoveTaskResponse)(nil), "uber.cadence.history.v1.RemoveTaskResponse")
+	proto.RegisterType((*ResetQueueRequest)(nil), "uber.cadence.history.v1.ResetQueueRequest")
+	proto.RegisterType((*ResetQueueResponse)(nil), "uber.cadence.history.v1.ResetQueueResponse")
+	proto.RegisterType((*DescribeQueueRequest)(nil), "uber.cadence.history.v1.DescribeQueueRequest")
+	proto.RegisterType((*DescribeQueueResponse)(nil), "uber.cadence.history.v1.DescribeQueueResponse")
+	proto.RegisterType((*GetReplicationAckStatesRequest)(nil), "uber.cadence.history.v1.GetReplicationAckStatesRequest")
+	proto.RegisterType((*GetReplicationAckStatesResponse)(nil), "uber.cadence.history.v1.GetReplicationAckStatesResponse")
+	proto.RegisterType((*GetReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetReplicationMessagesRequest")
+	proto.RegisterType((*GetReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse")
+	proto.RegisterMapType((map[int32]*v11.ReplicationMessages)(nil), "uber.cadence.history.v1.GetReplicationMessagesResponse.ShardMessagesEntry")
+	proto.RegisterType((*GetDLQReplicationMessagesRequest)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesRequest")
+	proto.RegisterType((*GetDLQReplicationMessagesResponse)(nil), "uber.cadence.history.v1.GetDLQReplicationMessagesResponse")
+	proto.RegisterType((*ReapplyEventsRequest)(nil), "uber.cadence.history.v1.ReapplyEventsRequest")
+	proto.RegisterType((*ReapplyEventsResponse)(nil), "uber.cadence.history.v1.ReapplyEventsResponse")
+	proto.RegisterType((*RefreshWorkflowTasksRequest)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksRequest")
+	proto.RegisterType((*RefreshWorkflowTasksResponse)(nil), "uber.cadence.history.v1.RefreshWorkflowTasksResponse")
+	proto.RegisterType((*ReadDLQMessagesRequest)(nil), "uber.cadence.history.v1.ReadDLQMessagesRequest")
+	proto.RegisterType((*ReadDLQMessagesResponse)(nil), "uber.cadence.history.v1.ReadDLQMessagesResponse")
 	proto.RegisterType((*PurgeDLQMessagesRequest)(nil), "uber.cadence.history.v1.PurgeDLQMessagesRequest")
 	proto.RegisterType((*PurgeDLQMessagesResponse)(nil), "uber.cadence.history.v1.PurgeDLQMessagesResponse")
 	proto.RegisterType((*MergeDLQMessagesRequest)(nil), "uber.cadence.history.v1.MergeDLQMessagesRequest")
@@ -5276,6 +5927,10 @@ func init() {
 	proto.RegisterType((*RespondCrossClusterTasksCompletedResponse)(nil), "uber.cadence.history.v1.RespondCrossClusterTasksCompletedResponse")
 	proto.RegisterType((*GetFailoverInfoRequest)(nil), "uber.cadence.history.v1.GetFailoverInfoRequest")
 	proto.RegisterType((*GetFailoverInfoResponse)(nil), "uber.cadence.history.v1.GetFailoverInfoResponse")
+	proto.RegisterType((*GetDomainReplicationWatermarkRequest)(nil), "uber.cadence.history.v1.GetDomainReplicationWatermarkRequest")
+	proto.RegisterType((*GetDomainReplicationWatermarkResponse)(nil), "uber.cadence.history.v1.GetDomainReplicationWatermarkResponse")
+	proto.RegisterType((*ReconcileWorkflowRetentionRequest)(nil), "uber.cadence.history.v1.ReconcileWorkflowRetentionRequest")
+	proto.RegisterType((*ReconcileWorkflowRetentionResponse)(nil), "uber.cadence.history.v1.ReconcileWorkflowRetentionResponse")
 }
 
 func init() {
@@ -5283,303 +5938,334 @@ func init() {
 }
 
 var fileDescriptor_fee8ff76963a38ed = []byte{
-	// 4726 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0xc9,
-	0x56, 0x56, 0x7b, 0xe2, 0xbf, 0x63, 0x7b, 0x6c, 0x57, 0xfc, 0x33, 0x1e, 0x27, 0x8e, 0xdd, 0x77,
-	0x93, 0x78, 0xb3, 0xbb, 0x93, 0x8d, 0xb3, 0x9b, 0x64, 0xb3, 0xbb, 0x77, 0x37, 0xb1, 0x93, 0xec,
-	0x44, 0xf9, 0x6d, 0x9b, 0x04, 0x10, 0x4a, 0xab, 0x3d, 0x5d, 0x63, 0x37, 0x9e, 0xe9, 0x9e, 0x74,
-	0xf7, 0xd8, 0x99, 0x7d, 0x40, 0x17, 0xb8, 0x42, 0xba, 0x57, 0x08, 0x2e, 0x70, 0x41, 0x48, 0x48,
-	0x57, 0x42, 0x17, 0xe9, 0xc2, 0xf2, 0xc0, 0x03, 0xbc, 0x21, 0x9e, 0x78, 0x81, 0x07, 0x24, 0x5e,
-	0x79, 0x02, 0xad, 0xae, 0x78, 0x00, 0x89, 0x17, 0x10, 0x8f, 0x08, 0xd5, 0x4f, 0xff, 0x4d, 0x57,
-	0x57, 0xf7, 0xd8, 0x48, 0xd9, 0xbb, 0xec, 0x9b, 0xa7, 0xaa, 0xce, 0xa9, 0x53, 0xa7, 0x4e, 0x7d,
-	0x75, 0xea, 0x9c, 0xd3, 0x86, 0xf3, 0xdd, 0x5d, 0xec, 0x5e, 0x6e, 0x18, 0x26, 0xb6, 0x1b, 0xf8,
-	0xf2, 0xbe, 0xe5, 0xf9, 0x8e, 0xdb, 0xbb, 0x7c, 0x78, 0xe5, 0xb2, 0x87, 0xdd, 0x43, 0xab, 0x81,
-	0x6b, 0x1d, 0xd7, 0xf1, 0x1d, 0xb4, 0x48, 0x86, 0xd5, 0xf8, 0xb0, 0x1a, 0x1f, 0x56, 0x3b, 0xbc,
-	0x52, 0x5d, 0xd9, 0x73, 0x9c, 0xbd, 0x16, 0xbe, 0x4c, 0x87, 0xed, 0x76, 0x9b, 0x97, 0xcd, 0xae,
-	0x6b, 0xf8, 0x96, 0x63, 0x33, 0xc2, 0xea, 0xb9, 0xfe, 0x7e, 0xdf, 0x6a, 0x63, 0xcf, 0x37, 0xda,
-	0x1d, 0x3e, 0x20, 0xc5, 0xe0, 0xc8, 0x35, 0x3a, 0x1d, 0xec, 0x7a, 0xbc, 0x7f, 0x35, 0x21, 0xa0,
-	0xd1, 0xb1, 0x88, 0x70, 0x0d, 0xa7, 0xdd, 0x0e, 0xa7, 0x58, 0x13, 0x8d, 0x08, 0x44, 0xe4, 0x52,
-	0x88, 0x86, 0xbc, 0xec, 0xe2, 0x70, 0x80, 0x2a, 0x1a, 0xe0, 0x1b, 0xde, 0x41, 0xcb, 0xf2, 0x7c,
-	0xd9, 0x98, 0x23, 0xc7, 0x3d, 0x68, 0xb6, 0x9c, 0x23, 0x3e, 0xe6, 0x92, 0x68, 0x0c, 0x57, 0xa5,
-	0xde, 0x37, 0x76, 0x3d, 0x6f, 0x2c, 0x76, 0xf9, 0xc8, 0x37, 0x12, 0x23, 0xbd, 0x7d, 0xc3, 0xc5,
-	0x26, 0x55, 0x43, 0xab, 0xeb, 0xf9, 0xb9, 0xa3, 0x92, 0xaa, 0x50, 0x33, 0x46, 0xbd, 0xec, 0xe2,
-	0x2e, 0x16, 0x4a, 0x16, 0x8d, 0x71, 0x71, 0xa7, 0x65, 0x35, 0xe2, 0xdb, 0x7b, 0x3e, 0x63, 0x64,
-	0x72, 0xa9, 0xea, 0x9f, 0x0e, 0xc3, 0xd9, 0x6d, 0xdf, 0x70, 0xfd, 0xe7, 0xbc, 0xfd, 0xce, 0x2b,
-	0xdc, 0xe8, 0x12, 0x3e, 0x1a, 0x7e, 0xd9, 0xc5, 0x9e, 0x8f, 0x1e, 0xc0, 0xa8, 0xcb, 0xfe, 0xac,
-	0x28, 0xab, 0xca, 0xfa, 0xc4, 0xc6, 0x46, 0x2d, 0x61, 0x72, 0x46, 0xc7, 0xaa, 0x1d, 0x5e, 0xa9,
-	0x49, 0x99, 0x68, 0x01, 0x0b, 0xb4, 0x0c, 0xe3, 0xa6, 0xd3, 0x36, 0x2c, 0x5b, 0xb7, 0xcc, 0xca,
-	0xd0, 0xaa, 0xb2, 0x3e, 0xae, 0x8d, 0xb1, 0x86, 0xba, 0x89, 0x7e, 0x09, 0xe6, 0x3b, 0x86, 0x8b,
-	0x6d, 0x5f, 0xc7, 0x01, 0x03, 0xdd, 0xb2, 0x9b, 0x4e, 0xa5, 0x44, 0x27, 0x5e, 0x17, 0x4e, 0xfc,
-	0x84, 0x52, 0x84, 0x33, 0xd6, 0xed, 0xa6, 0xa3, 0x9d, 0xee, 0xa4, 0x1b, 0x51, 0x05, 0x46, 0x0d,
-	0xdf, 0xc7, 0xed, 0x8e, 0x5f, 0x39, 0xb5, 0xaa, 0xac, 0x0f, 0x6b, 0xc1, 0x4f, 0xb4, 0x09, 0xd3,
-	0xf8, 0x55, 0xc7, 0x62, 0xc7, 0x43, 0x27, 0xe7, 0xa0, 0x32, 0x4c, 0x67, 0xac, 0xd6, 0xd8, 0x19,
-	0xa8, 0x05, 0x67, 0xa0, 0xb6, 0x13, 0x1c, 0x12, 0xad, 0x1c, 0x91, 0x90, 0x46, 0xd4, 0x84, 0xa5,
-	0x86, 0x63, 0xfb, 0x96, 0xdd, 0xc5, 0xba, 0xe1, 0xe9, 0x36, 0x3e, 0xd2, 0x2d, 0xdb, 0xf2, 0x2d,
-	0xc3, 0x77, 0xdc, 0xca, 0xc8, 0xaa, 0xb2, 0x5e, 0xde, 0x78, 0x4b, 0xb8, 0x80, 0x4d, 0x4e, 0x75,
-	0xcb, 0x7b, 0x84, 0x8f, 0xea, 0x01, 0x89, 0xb6, 0xd0, 0x10, 0xb6, 0xa3, 0x3a, 0xcc, 0x06, 0x3d,
-	0xa6, 0xde, 0x34, 0xac, 0x56, 0xd7, 0xc5, 0x95, 0x51, 0x2a, 0xee, 0x19, 0x21, 0xff, 0xbb, 0x6c,
-	0x8c, 0x36, 0x13, 0x92, 0xf1, 0x16, 0xa4, 0xc1, 0x42, 0xcb, 0xf0, 0x7c, 0xbd, 0xe1, 0xb4, 0x3b,
-	0x2d, 0x4c, 0x17, 0xef, 0x62, 0xaf, 0xdb, 0xf2, 0x2b, 0x63, 0x12, 0x7e, 0x4f, 0x8c, 0x5e, 0xcb,
-	0x31, 0x4c, 0x6d, 0x8e, 0xd0, 0x6e, 0x86, 0xa4, 0x1a, 0xa5, 0x44, 0x3f, 0x0f, 0xcb, 0x4d, 0xcb,
-	0xf5, 0x7c, 0xdd, 0xc4, 0x0d, 0xcb, 0xa3, 0xfa, 0x34, 0xbc, 0x03, 0x7d, 0xd7, 0x68, 0x1c, 0x38,
-	0xcd, 0x66, 0x65, 0x9c, 0x32, 0x5e, 0x4a, 0xe9, 0x75, 0x8b, 0x83, 0x93, 0x56, 0xa1, 0xd4, 0x5b,
-	0x9c, 0x78, 0xc7, 0xf0, 0x0e, 0x6e, 0x33, 0x52, 0xf5, 0x3a, 0xac, 0x64, 0x19, 0x99, 0xd7, 0x71,
-	0x6c, 0x0f, 0xa3, 0x79, 0x18, 0x71, 0xbb, 0xd4, 0xb2, 0x14, 0x6a, 0x59, 0xc3, 0x6e, 0xd7, 0xae,
-	0x9b, 0xea, 0x9f, 0x0c, 0xc1, 0xca, 0xb6, 0xb5, 0x67, 0x1b, 0xad, 0x4c, 0x23, 0x7f, 0xd8, 0x6f,
-	0xe4, 0x57, 0xc5, 0x46, 0x2e, 0xe5, 0x52, 0xd0, 0xca, 0x9b, 0xb0, 0x8c, 0x5f, 0xf9, 0xd8, 0xb5,
-	0x8d, 0x56, 0x08, 0x3c, 0x91, 0xc1, 0x73, 0x5b, 0xbf, 0x20, 0x9c, 0x3f, 0x3d, 0xf3, 0x52, 0xc0,
-	0x2a, 0xd5, 0x85, 0x6a, 0x70, 0xba, 0xb1, 0x6f, 0xb5, 0xcc, 0x68, 0x12, 0xc7, 0x6e, 0xf5, 0xa8,
-	0xed, 0x8f, 0x69, 0xb3, 0xb4, 0x2b, 0x20, 0x7a, 0x6c, 0xb7, 0x7a, 0xea, 0x1a, 0x9c, 0xcb, 0x5c,
-	0x1f, 0x53, 0xb0, 0xfa, 0x23, 0x05, 0x2e, 0xf2, 0x31, 0x96, 0xbf, 0x2f, 0xc7, 0x8d, 0x67, 0xfd,
-	0x2a, 0xfd, 0x48, 0xa6, 0xd2, 0x3c, 0x76, 0xc5, 0x74, 0xab, 0xde, 0x82, 0xf5, 0x7c, 0x86, 0x72,
-	0x6b, 0xf9, 0xbe, 0x02, 0x67, 0x35, 0xec, 0xe1, 0x13, 0x23, 0xa2, 0x94, 0x49, 0xc1, 0xf5, 0x5c,
-	0x87, 0x95, 0x2c, 0x36, 0xf2, 0x55, 0x7c, 0x31, 0x04, 0x6b, 0x3b, 0xd8, 0x6d, 0x5b, 0xb6, 0xe1,
-	0xe3, 0xcc, 0x95, 0x3c, 0xe9, 0x5f, 0xc9, 0x35, 0xe1, 0x4a, 0x72, 0x19, 0xfd, 0x8c, 0x5b, 0xfe,
-	0x1b, 0xa0, 0xca, 0x96, 0xc8, 0x8d, 0xff, 0x07, 0x0a, 0xac, 0x6e, 0x61, 0xaf, 0xe1, 0x5a, 0xbb,
-	0xd9, 0x1a, 0x7d, 0xdc, 0xaf, 0xd1, 0xf7, 0x85, 0xcb, 0xc9, 0xe3, 0x53, 0xd0, 0x3c, 0xfe, 0xa7,
-	0x04, 0x6b, 0x12, 0x56, 0xdc, 0x44, 0x5a, 0xb0, 0x18, 0xdd, 0xa7, 0x0d, 0xc7, 0x6e, 0x5a, 0x7b,
-	0x1c, 0x6d, 0xa5, 0x60, 0x97, 0x62, 0xb8, 0x19, 0x27, 0xd5, 0x16, 0xb0, 0xb0, 0x1d, 0xed, 0xc2,
-	0x62, 0x7a, 0x6f, 0xd9, 0x35, 0x3e, 0x44, 0x67, 0xbb, 0x54, 0x6c, 0x36, 0x7a, 0x91, 0xcf, 0x1f,
-	0x89, 0x9a, 0xd1, 0x73, 0x40, 0x1d, 0x6c, 0x9b, 0x96, 0xbd, 0xa7, 0x1b, 0x0d, 0xdf, 0x3a, 0xb4,
-	0x7c, 0x0b, 0x7b, 0x95, 0xd2, 0x6a, 0x29, 0xdb, 0x4b, 0x60, 0xc3, 0x6f, 0xb1, 0xd1, 0x3d, 0xca,
-	0x7c, 0xb6, 0x93, 0x68, 0xb4, 0xb0, 0x87, 0x7e, 0x01, 0x66, 0x02, 0xc6, 0xd4, 0x4c, 0x5c, 0x6c,
-	0x57, 0x4e, 0x51, 0xb6, 0x35, 0x19, 0xdb, 0x4d, 0x32, 0x36, 0x29, 0xf9, 0x74, 0x27, 0xd6, 0xe5,
-	0x62, 0x1b, 0x6d, 0x47, 0xac, 0x83, 0xab, 0x91, 0x7b, 0x19, 0x52, 0x89, 0x83, 0x9b, 0x30, 0xc1,
-	0x34, 0x68, 0x54, 0x5f, 0xc1, 0xdc, 0x53, 0xe2, 0x2c, 0x07, 0xda, 0x0b, 0xcc, 0x70, 0xb3, 0xdf,
-	0x0c, 0xdf, 0x14, 0xce, 0x21, 0xa2, 0x2d, 0x68, 0x7a, 0x3f, 0x56, 0x60, 0xbe, 0x8f, 0x9c, 0x9b,
-	0xdb, 0x27, 0x30, 0x49, 0x1d, 0xf8, 0xc0, 0x97, 0x50, 0x0a, 0xf8, 0x12, 0x13, 0x94, 0x82, 0xbb,
-	0x10, 0x75, 0x28, 0x07, 0x0c, 0x7e, 0x19, 0x37, 0x7c, 0x6c, 0x72, 0xc3, 0x51, 0xb3, 0xd7, 0xa0,
-	0xf1, 0x91, 0xda, 0xd4, 0xcb, 0xf8, 0x4f, 0xf5, 0xbb, 0x0a, 0x54, 0x29, 0x80, 0x6e, 0xfb, 0x56,
-	0xe3, 0xa0, 0x47, 0xdc, 0x89, 0x07, 0x96, 0xe7, 0x07, 0x6a, 0xaa, 0xf7, 0xab, 0xe9, 0x72, 0x36,
-	0x92, 0x0b, 0x39, 0x14, 0x54, 0xd6, 0x59, 0x58, 0x16, 0xf2, 0xe0, 0xc8, 0xf2, 0x9f, 0x0a, 0x2c,
-	0xdc, 0xc3, 0xfe, 0xc3, 0xae, 0x6f, 0xec, 0xb6, 0xf0, 0xb6, 0x6f, 0xf8, 0x58, 0x13, 0xb1, 0x55,
-	0xfa, 0xf0, 0xf4, 0xe7, 0x00, 0x09, 0x60, 0x74, 0x68, 0x20, 0x18, 0x9d, 0x4d, 0x9d, 0x30, 0x74,
-	0x15, 0x16, 0xf0, 0xab, 0x0e, 0x55, 0xa0, 0x6e, 0xe3, 0x57, 0xbe, 0x8e, 0x0f, 0x89, 0x4f, 0x6e,
-	0x99, 0x14, 0xa1, 0x4b, 0xda, 0xe9, 0xa0, 0xf7, 0x11, 0x7e, 0xe5, 0xdf, 0x21, 0x7d, 0x75, 0x13,
-	0xbd, 0x0b, 0x73, 0x8d, 0xae, 0x4b, 0x9d, 0xf7, 0x5d, 0xd7, 0xb0, 0x1b, 0xfb, 0xba, 0xef, 0x1c,
-	0xd0, 0xd3, 0xa3, 0xac, 0x4f, 0x6a, 0x88, 0xf7, 0xdd, 0xa6, 0x5d, 0x3b, 0xa4, 0x47, 0xfd, 0xe1,
-	0x38, 0x2c, 0xa6, 0x56, 0xcd, 0x6d, 0x48, 0xbc, 0x32, 0xe5, 0xa4, 0x2b, 0xbb, 0x0b, 0x53, 0x21,
-	0x5b, 0xbf, 0xd7, 0xc1, 0x5c, 0x57, 0x6b, 0x52, 0x8e, 0x3b, 0xbd, 0x0e, 0xd6, 0x26, 0x8f, 0x62,
-	0xbf, 0x90, 0x0a, 0x53, 0x22, 0xc5, 0x4c, 0xd8, 0x31, 0x85, 0x3c, 0x83, 0xa5, 0x8e, 0x8b, 0x0f,
-	0x2d, 0xa7, 0xeb, 0xe9, 0x1e, 0xf1, 0x44, 0xb0, 0x19, 0x8d, 0x3f, 0x45, 0xe7, 0x5d, 0x4e, 0xb9,
-	0xc1, 0x75, 0xdb, 0xbf, 0xf6, 0xde, 0x33, 0xa3, 0xd5, 0xc5, 0xda, 0x42, 0x40, 0xbd, 0xcd, 0x88,
-	0x03, 0xbe, 0xef, 0xc0, 0x69, 0xea, 0xb4, 0x33, 0x2f, 0x3b, 0xe4, 0x38, 0x4c, 0x25, 0x98, 0x21,
-	0x5d, 0x77, 0x49, 0x4f, 0x30, 0xfc, 0x26, 0x8c, 0x53, 0x07, 0x9c, 0x3c, 0x97, 0xe9, 0x33, 0x64,
-	0x62, 0xe3, 0xac, 0xf8, 0x92, 0x0f, 0xac, 0x72, 0xcc, 0xe7, 0x7f, 0xa1, 0x7b, 0x30, 0xe3, 0x51,
-	0x8b, 0xd5, 0x23, 0x16, 0xa3, 0x45, 0x58, 0x94, 0xbd, 0x84, 0xa1, 0xa3, 0xf7, 0x60, 0xa1, 0xd1,
-	0xb2, 0x88, 0xa4, 0x2d, 0x6b, 0xd7, 0x35, 0xdc, 0x9e, 0x7e, 0x88, 0x5d, 0x8a, 0x80, 0x63, 0xd4,
-	0xa4, 0xe7, 0x58, 0xef, 0x03, 0xd6, 0xf9, 0x8c, 0xf5, 0xc5, 0xa8, 0x9a, 0xd8, 0xf0, 0xbb, 0x2e,
-	0x0e, 0xa9, 0xc6, 0xe3, 0x54, 0x77, 0x59, 0x67, 0x40, 0x75, 0x0e, 0x26, 0x38, 0x95, 0xd5, 0xee,
-	0xb4, 0x2a, 0x40, 0x87, 0x02, 0x6b, 0xaa, 0xb7, 0x3b, 0x2d, 0xe4, 0xc1, 0xa5, 0xfe, 0x55, 0xe9,
-	0x5e, 0x63, 0x1f, 0x9b, 0xdd, 0x16, 0xd6, 0x7d, 0x87, 0x6d, 0x16, 0x7d, 0x05, 0x3a, 0x5d, 0xbf,
-	0x32, 0x91, 0xf7, 0x60, 0x79, 0x23, 0xb9, 0xd6, 0x6d, 0xce, 0x69, 0xc7, 0xa1, 0xfb, 0xb6, 0xc3,
-	0xd8, 0x10, 0x97, 0x84, 0x6d, 0x15, 0x79, 0xf1, 0x47, 0x0b, 0x99, 0xa4, 0x0f, 0xd1, 0x59, 0xda,
-	0xb5, 0x4d, 0x7a, 0x82, 0x55, 0x64, 0x1d, 0xa7, 0xa9, 0xac, 0xe3, 0x84, 0x1e, 0x40, 0x39, 0xb4,
-	0x6d, 0x8f, 0x1c, 0xa6, 0x4a, 0x99, 0x3e, 0x3a, 0xcf, 0x27, 0xb7, 0x8a, 0x45, 0x02, 0xe2, 0xf6,
-	0xcd, 0x4e, 0x5e, 0x78, 0x30, 0xe8, 0x4f, 0xd4, 0x80, 0xb9, 0x90, 0x5b, 0xa3, 0xe5, 0x78, 0x98,
-	0xf3, 0x9c, 0xa6, 0x3c, 0xaf, 0x14, 0x74, 0x18, 0x08, 0x21, 0xe1, 0xd7, 0xf5, 0xb4, 0xf0, 0x3c,
-	0x87, 0x8d, 0xe4, 0x94, 0xcf, 0x72, 0x45, 0xe8, 0x2c, 0x14, 0x42, 0x6e, 0xf1, 0x19, 0xd1, 0x9d,
-	0x18, 0x49, 0xcd, 0x15, 0xf4, 0x59, 0x30, 0x5e, 0x9b, 0x39, 0xec, 0x6b, 0x41, 0x1f, 0xc1, 0xb2,
-	0x45, 0xce, 0x5c, 0xdf, 0x1e, 0x63, 0x9b, 0xe0, 0x8c, 0x59, 0x99, 0xa5, 0x6e, 0xe0, 0xa2, 0xe5,
-	0x25, 0xd1, 0xf8, 0x0e, 0xeb, 0x56, 0xff, 0x4b, 0x81, 0xc5, 0x27, 0x4e, 0xab, 0xf5, 0xff, 0x0c,
-	0x8d, 0x7f, 0x32, 0x06, 0x95, 0xf4, 0xb2, 0xbf, 0x81, 0xe3, 0x6f, 0xe0, 0xf8, 0xeb, 0x08, 0xc7,
-	0x59, 0xe7, 0x63, 0x32, 0x13, 0x5e, 0x85, 0x58, 0x35, 0x75, 0x62, 0xac, 0xfa, 0xd9, 0x43, 0x6d,
-	0xf5, 0x6f, 0x87, 0x60, 0x55, 0xc3, 0x0d, 0xc7, 0x35, 0xe3, 0x51, 0x3a, 0x7e, 0x2c, 0x5e, 0x27,
-	0x52, 0x9e, 0x83, 0x89, 0xd0, 0x70, 0x42, 0x10, 0x80, 0xa0, 0xa9, 0x6e, 0xa2, 0x45, 0x18, 0xa5,
-	0x36, 0xc6, 0x4f, 0x7c, 0x49, 0x1b, 0x21, 0x3f, 0xeb, 0x26, 0x3a, 0x0b, 0xc0, 0xfd, 0xf8, 0xe0,
-	0xec, 0x8e, 0x6b, 0xe3, 0xbc, 0xa5, 0x6e, 0x22, 0x0d, 0x26, 0x3b, 0x4e, 0xab, 0xa5, 0x07, 0x6f,
-	0x85, 0x11, 0xc9, 0x5b, 0x81, 0x60, 0xe8, 0x5d, 0xc7, 0x8d, 0xab, 0x26, 0x78, 0x2b, 0x4c, 0x10,
-	0x26, 0xfc, 0x87, 0xfa, 0xcf, 0xa3, 0xb0, 0x26, 0xd1, 0x22, 0x07, 0xde, 0x14, 0x42, 0x2a, 0xc7,
-	0x43, 0x48, 0x29, 0xfa, 0x0d, 0x1d, 0x1f, 0xfd, 0xde, 0x06, 0x14, 0xe8, 0xd7, 0xec, 0x87, 0xdf,
-	0x99, 0xb0, 0x27, 0x18, 0xbd, 0x4e, 0x00, 0x4c, 0x00, 0xbd, 0x25, 0x82, 0x50, 0x09, 0xbe, 0x29,
-	0x44, 0x1f, 0x4e, 0x23, 0x7a, 0x2c, 0x9e, 0x3f, 0x92, 0x8c, 0xe7, 0xdf, 0x80, 0x0a, 0x87, 0x94,
-	0x28, 0x00, 0x11, 0xdc, 0xfe, 0xa3, 0xf4, 0xf6, 0x5f, 0x60, 0xfd, 0xa1, 0xed, 0xf0, 0xcb, 0x1f,
-	0x69, 0x30, 0x15, 0xc6, 0xad, 0x69, 0xc8, 0x82, 0x05, 0xc2, 0xdf, 0xc9, 0x3a, 0x8d, 0x3b, 0xae,
-	0x61, 0x7b, 0x04, 0xca, 0x12, 0xcf, 0xf4, 0x49, 0x33, 0xf6, 0x0b, 0xbd, 0x80, 0x33, 0x82, 0x80,
-	0x48, 0x04, 0xe1, 0xe3, 0x45, 0x20, 0x7c, 0x29, 0x65, 0xee, 0x21, 0x9a, 0x67, 0xb8, 0x96, 0x90,
-	0xe5, 0x5a, 0xae, 0xc1, 0x64, 0x02, 0xf3, 0x26, 0x28, 0xe6, 0x4d, 0xec, 0xc6, 0xc0, 0xee, 0x16,
-	0x94, 0xa3, 0x6d, 0xa5, 0xf9, 0x90, 0xc9, 0xdc, 0x7c, 0xc8, 0x54, 0x48, 0x41, 0xd3, 0x21, 0x1f,
-	0xc3, 0x64, 0xb0, 0xd7, 0x94, 0xc1, 0x54, 0x2e, 0x83, 0x09, 0x3e, 0x9e, 0x92, 0x1b, 0x30, 0x4a,
-	0x5e, 0xf2, 0x04, 0x64, 0xcb, 0x34, 0xfe, 0x72, 0xaf, 0x96, 0x91, 0xe8, 0xac, 0xe5, 0x9e, 0x22,
-	0x1a, 0x22, 0xb0, 0xb0, 0x77, 0xc7, 0xf6, 0xdd, 0x9e, 0x16, 0xf0, 0xad, 0xbe, 0x80, 0xc9, 0x78,
-	0x07, 0x9a, 0x81, 0xd2, 0x01, 0xee, 0x71, 0xb0, 0x22, 0x7f, 0xa2, 0x1b, 0x30, 0x7c, 0x48, 0xcc,
-	0x5f, 0x1a, 0x7f, 0x08, 0x4e, 0x1d, 0x8b, 0x43, 0x30, 0x82, 0x9b, 0x43, 0x37, 0x94, 0x18, 0x4e,
-	0x06, 0x51, 0xa7, 0x6f, 0x70, 0x32, 0x85, 0x93, 0x71, 0xd5, 0x08, 0x71, 0xf2, 0xa7, 0xa5, 0x00,
-	0x27, 0x85, 0x5a, 0xe4, 0x38, 0x79, 0x1f, 0xa6, 0xfb, 0x70, 0x48, 0x8a, 0x94, 0xec, 0xfe, 0xed,
-	0x51, 0x24, 0xd1, 0xca, 0x49, 0x9c, 0x4a, 0x59, 0xee, 0xd0, 0x60, 0x96, 0x1b, 0x83, 0xa5, 0x52,
-	0x12, 0x96, 0x5e, 0xc0, 0x4a, 0xf2, 0x54, 0xe9, 0x4e, 0x53, 0xf7, 0xf7, 0x2d, 0x4f, 0x8f, 0xe7,
-	0x25, 0xe5, 0x53, 0x55, 0x13, 0xa7, 0xec, 0x71, 0x73, 0x67, 0xdf, 0xf2, 0x6e, 0x71, 0xfe, 0x75,
-	0x98, 0xdd, 0xc7, 0x86, 0xeb, 0xef, 0x62, 0xc3, 0xd7, 0x4d, 0xec, 0x1b, 0x56, 0xcb, 0xe3, 0x21,
-	0x46, 0x79, 0xf4, 0x6d, 0x26, 0x24, 0xdb, 0x62, 0x54, 0xe9, 0x7b, 0x67, 0xe4, 0x78, 0xf7, 0xce,
-	0x45, 0x98, 0x0e, 0xf9, 0x30, 0xb3, 0xa6, 0x00, 0x3c, 0xae, 0x85, 0x5e, 0xcf, 0x16, 0x6d, 0x55,
-	0xff, 0x40, 0x81, 0x6f, 0xb1, 0xdd, 0x4c, 0x9c, 0x64, 0x9e, 0x5e, 0x8c, 0xce, 0x8b, 0xd6, 0x1f,
-	0xb1, 0xbb, 0x91, 0x15, 0xb1, 0xcb, 0x63, 0x55, 0x30, 0x74, 0xf7, 0x97, 0x25, 0x78, 0x43, 0xce,
-	0x8d, 0x9b, 0x20, 0x8e, 0x2e, 0x37, 0x97, 0xb7, 0x71, 0x11, 0x6f, 0x1e, 0x1f, 0xba, 0xb4, 0x69,
-	0xaf, 0xcf, 0xd2, 0x7f, 0xac, 0xc0, 0x4a, 0x14, 0xf3, 0x26, 0x0e, 0xb2, 0x69, 0x79, 0x1d, 0xc3,
-	0x6f, 0xec, 0xeb, 0x2d, 0xa7, 0x61, 0xb4, 0x5a, 0xbd, 0xca, 0x10, 0x05, 0xcc, 0x17, 0x92, 0x59,
-	0xf3, 0x97, 0x53, 0x8b, 0x82, 0xe2, 0x3b, 0xce, 0x16, 0x9f, 0xe1, 0x01, 0x9b, 0x80, 0xe1, 0xe8,
-	0xb2, 0x91, 0x3d, 0xa2, 0xfa, 0x2b, 0xb0, 0x9a, 0xc7, 0x40, 0x80, 0xb7, 0x5b, 0x49, 0xbc, 0x15,
-	0x87, 0xdc, 0x03, 0x18, 0xa0, 0xbc, 0x02, 0xc6, 0xf4, 0xda, 0x8d, 0x61, 0xef, 0x0f, 0x14, 0x82,
-	0xbd, 0xa9, 0x65, 0xde, 0x35, 0xac, 0x56, 0x64, 0x4b, 0x05, 0x73, 0x35, 0x79, 0x7c, 0x0a, 0x1a,
-	0xd2, 0xb7, 0x08, 0x8e, 0x65, 0x72, 0xe2, 0x91, 0xe0, 0x1f, 0x2a, 0xa0, 0xa6, 0xd1, 0xee, 0xb3,
-	0xe0, 0x78, 0x06, 0x92, 0x3f, 0xed, 0x97, 0xfc, 0x7a, 0x86, 0xe4, 0x79, 0x9c, 0x0a, 0xca, 0xfe,
-	0x84, 0x1c, 0x4e, 0x09, 0x2f, 0x6e, 0x9b, 0x6f, 0xc2, 0x4c, 0xc3, 0xb0, 0x1b, 0x38, 0xbc, 0x01,
-	0x30, 0xbb, 0xd3, 0xc6, 0xb4, 0x69, 0xd6, 0xae, 0x05, 0xcd, 0xf1, 0xf3, 0x1e, 0xe7, 0x79, 0xc2,
-	0xf3, 0x2e, 0x63, 0x55, 0x70, 0xa9, 0x17, 0xc2, 0xe3, 0x9e, 0xc1, 0x2c, 0x96, 0x0d, 0x14, 0x0c,
-	0x3c, 0x89, 0x85, 0x65, 0xf2, 0x19, 0xd8, 0xc2, 0x44, 0x9c, 0x12, 0x16, 0x96, 0x5e, 0x20, 0xdd,
-	0x9f, 0x48, 0xf2, 0xc2, 0x16, 0x96, 0xc7, 0xa9, 0xa0, 0xec, 0xe7, 0xc5, 0xe6, 0x10, 0xf2, 0xe2,
-	0xd2, 0xff, 0x95, 0x02, 0xe7, 0x34, 0xdc, 0x76, 0x0e, 0x31, 0x4b, 0xf3, 0x7f, 0x55, 0x82, 0x74,
-	0x49, 0xc7, 0xa8, 0xd4, 0xe7, 0x18, 0xa9, 0x2a, 0xb1, 0x95, 0x2c, 0xa9, 0xf9, 0xd2, 0xfe, 0x7a,
-	0x08, 0xce, 0xf3, 0x25, 0xb0, 0x65, 0x67, 0xe6, 0x98, 0xa5, 0x0b, 0x34, 0xa0, 0x9c, 0x3c, 0x83,
-	0x7c, 0x71, 0x37, 0x33, 0xf6, 0xaf, 0xc0, 0x84, 0xda, 0x54, 0xe2, 0xf4, 0xa2, 0x5d, 0x58, 0x0c,
-	0xd3, 0xf8, 0xc2, 0x42, 0x2d, 0x71, 0x86, 0xf7, 0x0e, 0xa7, 0xe9, 0xcb, 0xf0, 0x62, 0x51, 0xf3,
-	0xc0, 0x29, 0xfc, 0x75, 0xb8, 0x90, 0xb7, 0x16, 0xae, 0xe7, 0xbf, 0x51, 0x60, 0x39, 0x88, 0x0a,
-	0x09, 0x5e, 0xe9, 0xaf, 0xc5, 0x7c, 0x2e, 0xc1, 0xac, 0xe5, 0xe9, 0xc9, 0xba, 0x29, 0xaa, 0xcb,
-	0x31, 0x6d, 0xda, 0xf2, 0xee, 0xc6, 0x2b, 0xa2, 0xd4, 0x15, 0x38, 0x23, 0x16, 0x9f, 0xaf, 0xef,
-	0xa7, 0x43, 0x04, 0xc1, 0x08, 0x58, 0x27, 0xb3, 0xd2, 0x29, 0x68, 0x7d, 0x1d, 0x0b, 0x5d, 0x83,
-	0x49, 0x5e, 0x14, 0x87, 0xcd, 0x58, 0xa0, 0x36, 0x6c, 0xab, 0x9b, 0xe8, 0x39, 0x9c, 0x6e, 0x04,
-	0xa2, 0xc6, 0xa6, 0x3e, 0x35, 0xd0, 0xd4, 0x28, 0x64, 0x11, 0xcd, 0xfd, 0x00, 0x66, 0x62, 0x85,
-	0x6e, 0xec, 0x91, 0x30, 0x5c, 0xf4, 0x91, 0x30, 0x1d, 0x91, 0xd2, 0x06, 0xf5, 0x22, 0x39, 0xad,
-	0x52, 0x2d, 0xf3, 0xfd, 0xf8, 0xb7, 0x21, 0xa8, 0x68, 0xbc, 0x3c, 0x13, 0x53, 0x5a, 0xef, 0xd9,
-	0xc6, 0xeb, 0xdc, 0x83, 0x17, 0x30, 0x9f, 0x8c, 0x64, 0xf6, 0x74, 0xcb, 0xc7, 0xed, 0xa0, 0x7e,
-	0xe2, 0x52, 0xa1, 0x68, 0x66, 0xaf, 0xee, 0xe3, 0xb6, 0x76, 0xfa, 0x30, 0xd5, 0xe6, 0xa1, 0xf7,
-	0x61, 0x84, 0x2a, 0xd7, 0xe3, 0x7b, 0x26, 0x8e, 0x6c, 0x6c, 0x19, 0xbe, 0x71, 0xbb, 0xe5, 0xec,
-	0x6a, 0x7c, 0x30, 0xda, 0x84, 0xb2, 0x8d, 0x8f, 0x74, 0xb7, 0xcb, 0xf7, 0x26, 0x78, 0xba, 0xe4,
-	0x90, 0x4f, 0xda, 0xf8, 0x48, 0xeb, 0xb2, 0x4d, 0xf1, 0xd4, 0x65, 0x58, 0x12, 0xe8, 0x9a, 0xef,
-	0xc4, 0xf7, 0x15, 0x58, 0xd8, 0xee, 0xd9, 0x8d, 0xed, 0x7d, 0xc3, 0x35, 0x79, 0x80, 0x93, 0xef,
-	0xc3, 0x79, 0x28, 0x7b, 0x4e, 0xd7, 0x6d, 0x60, 0x9d, 0x57, 0xee, 0xf2, 0xcd, 0x98, 0x62, 0xad,
-	0x9b, 0xac, 0x11, 0x2d, 0xc1, 0x18, 0xd1, 0x87, 0x19, 0xdc, 0x60, 0xc3, 0xda, 0x28, 0xfd, 0x5d,
-	0x37, 0x51, 0x0d, 0x4e, 0xd1, 0xd7, 0x62, 0x29, 0xf7, 0x09, 0x47, 0xc7, 0xa9, 0x4b, 0xb0, 0x98,
-	0x92, 0x85, 0xcb, 0xf9, 0xf7, 0xc3, 0x70, 0x9a, 0xf4, 0x05, 0x37, 0xe1, 0xeb, 0x34, 0x96, 0x0a,
-	0x8c, 0x06, 0x01, 0x25, 0x76, 0x56, 0x83, 0x9f, 0xe4, 0x28, 0x47, 0xaf, 0xd9, 0x30, 0x52, 0x10,
-	0x46, 0x16, 0x88, 0x4e, 0xd2, 0x61, 0xa4, 0xe1, 0x41, 0xc3, 0x48, 0x67, 0x01, 0x82, 0x57, 0x95,
-	0x65, 0xd2, 0x57, 0x68, 0x49, 0x1b, 0xe7, 0x2d, 0x75, 0x33, 0xf5, 0x56, 0x1f, 0x1d, 0xec, 0xad,
-	0x7e, 0x9f, 0x27, 0x6f, 0xa2, 0x67, 0x33, 0xe5, 0x32, 0x96, 0xcb, 0x65, 0x96, 0x90, 0x85, 0x0e,
-	0x30, 0xe5, 0x75, 0x0d, 0x46, 0x83, 0x37, 0xf7, 0x78, 0x81, 0x37, 0x77, 0x30, 0x38, 0x1e, 0x2f,
-	0x80, 0x64, 0xbc, 0xe0, 0x13, 0x98, 0x64, 0xa9, 0x25, 0x5e, 0xe4, 0x3b, 0x51, 0xa0, 0xc8, 0x77,
-	0x82, 0x66, 0x9c, 0x78, 0x7d, 0xef, 0xbb, 0x40, 0x6b, 0x74, 0x79, 0xc9, 0xba, 0x6e, 0x99, 0xd8,
-	0xf6, 0x2d, 0xbf, 0x47, 0x83, 0x79, 0xe3, 0x1a, 0x22, 0x7d, 0xcf, 0x69, 0x57, 0x9d, 0xf7, 0xa0,
-	0xc7, 0x30, 0xdd, 0x87, 0x0d, 0x3c, 0x70, 0x77, 0xa1, 0x18, 0x2a, 0x68, 0xe5, 0x24, 0x22, 0xa8,
-	0x0b, 0x30, 0x97, 0x34, 0x65, 0x6e, 0xe3, 0xbf, 0xa3, 0xc0, 0x72, 0x50, 0xb9, 0xf6, 0x15, 0x71,
-	0xe2, 0xd4, 0xdf, 0x52, 0xe0, 0x8c, 0x58, 0x26, 0xfe, 0xbe, 0xb9, 0x0a, 0x0b, 0x6d, 0xd6, 0xce,
-	0xf2, 0x2a, 0xba, 0x65, 0xeb, 0x0d, 0xa3, 0xb1, 0x8f, 0xb9, 0x84, 0xa7, 0xdb, 0x31, 0xaa, 0xba,
-	0xbd, 0x49, 0xba, 0xd0, 0x07, 0xb0, 0x94, 0x22, 0x32, 0x0d, 0xdf, 0xd8, 0x35, 0x3c, 0xcc, 0xdd,
-	0xe0, 0x85, 0x24, 0xdd, 0x16, 0xef, 0x55, 0xcf, 0x40, 0x35, 0x90, 0x87, 0xeb, 0xf3, 0x33, 0x27,
-	0x2c, 0x3d, 0x52, 0x7f, 0x6d, 0x28, 0x52, 0x61, 0xa2, 0x9b, 0x4b, 0xbb, 0x0e, 0x33, 0x76, 0xb7,
-	0xbd, 0x8b, 0x5d, 0xdd, 0x69, 0xea, 0x14, 0xa6, 0x3c, 0x2a, 0xe7, 0xb0, 0x56, 0x66, 0xed, 0x8f,
-	0x9b, 0x14, 0x7d, 0x3c, 0xa2, 0xec, 0x00, 0xd6, 0x3c, 0x1a, 0x3d, 0x18, 0xd6, 0xc6, 0x38, 0xae,
-	0x79, 0xe8, 0x3e, 0x4c, 0xf2, 0x9d, 0x60, 0x4b, 0x65, 0x00, 0x77, 0x31, 0xcb, 0x1e, 0x58, 0x3c,
-	0x87, 0x2e, 0x9d, 0xfa, 0x77, 0x13, 0x66, 0xd4, 0x80, 0xae, 0xc1, 0x22, 0x9b, 0xa8, 0xe1, 0xd8,
-	0xbe, 0xeb, 0xb4, 0x5a, 0xd8, 0xa5, 0x4a, 0xe9, 0xb2, 0xbb, 0x62, 0x5c, 0x9b, 0xa7, 0xdd, 0x9b,
-	0x61, 0x2f, 0x43, 0x46, 0x7a, 0x46, 0x4c, 0xd3, 0xc5, 0x9e, 0xc7, 0x83, 0x8e, 0xc1, 0x4f, 0xb5,
-	0x06, 0xb3, 0x2c, 0x35, 0x45, 0xe8, 0x02, 0xe3, 0x89, 0xc3, 0xb4, 0x92, 0x80, 0x69, 0x75, 0x0e,
-	0x50, 0x7c, 0x3c, 0xb7, 0xc6, 0xff, 0x50, 0x60, 0x96, 0x39, 0xe8, 0x71, 0x4f, 0x30, 0x9b, 0x0d,
-	0xfa, 0x98, 0xa7, 0x71, 0xc3, 0xac, 0x75, 0x79, 0x63, 0x35, 0x33, 0x47, 0x60, 0x78, 0x07, 0x34,
-	0x34, 0x46, 0x33, 0xb9, 0x34, 0x2c, 0x16, 0x0b, 0xb0, 0x96, 0x12, 0x01, 0xd6, 0x4d, 0x98, 0x3e,
-	0xb4, 0x3c, 0x6b, 0xd7, 0x6a, 0x59, 0x7e, 0x8f, 0x81, 0x51, 0x7e, 0x4c, 0xb0, 0x1c, 0x91, 0x50,
-	0x24, 0x5a, 0x83, 0x49, 0x7e, 0x8b, 0xe9, 0xb6, 0xc1, 0x41, 0x77, 0x5c, 0x9b, 0xe0, 0x6d, 0x8f,
-	0x8c, 0x36, 0x26, 0x6a, 0x88, 0xaf, 0x37, 0x7a, 0xd3, 0xce, 0xd2, 0x3a, 0xb5, 0xa7, 0x5d, 0xdc,
-	0xc5, 0x05, 0xd4, 0xd0, 0x3f, 0xd3, 0x50, 0x6a, 0xa6, 0xa4, 0xa6, 0x4a, 0x83, 0x6a, 0x8a, 0x09,
-	0x1a, 0x49, 0xc4, 0x05, 0xfd, 0x3d, 0x05, 0xe6, 0x02, 0xd3, 0xff, 0xea, 0xc8, 0xfa, 0x18, 0xe6,
-	0xfb, 0x84, 0xe2, 0x27, 0xf1, 0x1a, 0x2c, 0x76, 0x5c, 0xa7, 0x81, 0x3d, 0xcf, 0xb2, 0xf7, 0x74,
-	0xfa, 0x3d, 0x0f, 0xc3, 0x02, 0x72, 0x20, 0x4b, 0xc4, 0xec, 0xa3, 0x6e, 0x4a, 0x49, 0x81, 0xc0,
-	0x53, 0xbf, 0xab, 0xc0, 0xd9, 0x7b, 0xd8, 0xd7, 0xa2, 0x8f, 0x7b, 0x1e, 0x62, 0xcf, 0x33, 0xf6,
-	0x70, 0xe8, 0xb7, 0x7c, 0x0a, 0x23, 0x34, 0x89, 0xc3, 0x18, 0x49, 0x52, 0xd1, 0x31, 0x1e, 0x34,
-	0xc5, 0xa3, 0x71, 0xba, 0x02, 0x6a, 0x21, 0x40, 0xb3, 0x92, 0x25, 0x06, 0x5f, 0xe1, 0x4b, 0x28,
-	0x33, 0xbd, 0xb7, 0x79, 0x0f, 0x97, 0xe7, 0x7e, 0x66, 0x10, 0x52, 0xce, 0xb0, 0x46, 0xcf, 0x67,
-	0xd0, 0xca, 0x02, 0x8e, 0x53, 0x5e, 0xbc, 0xad, 0xda, 0x06, 0x94, 0x1e, 0x14, 0x0f, 0x2a, 0x0e,
-	0xb3, 0xa0, 0xe2, 0xad, 0x64, 0x50, 0xf1, 0xad, 0x02, 0x1a, 0x0a, 0xa5, 0x89, 0x45, 0x14, 0x6d,
-	0x58, 0xbd, 0x87, 0xfd, 0xad, 0x07, 0x4f, 0x25, 0xbb, 0x71, 0x1f, 0x80, 0x1d, 0x6b, 0xbb, 0xe9,
-	0x04, 0x1a, 0x28, 0x32, 0x1f, 0xb1, 0x25, 0x0a, 0x96, 0xd4, 0xfc, 0xc8, 0x5f, 0x9e, 0xda, 0x83,
-	0x35, 0xc9, 0x7c, 0x5c, 0xed, 0x3b, 0x30, 0x1b, 0xfb, 0xf2, 0x8b, 0xe6, 0x14, 0x83, 0x79, 0x2f,
-	0x16, 0x9c, 0x57, 0x9b, 0x71, 0x93, 0x0d, 0x9e, 0xfa, 0x4f, 0x0a, 0xcc, 0x69, 0xd8, 0xe8, 0x74,
-	0x5a, 0xec, 0xf1, 0x13, 0xae, 0x6f, 0x01, 0x46, 0x78, 0x10, 0x9f, 0xdd, 0x77, 0xfc, 0x97, 0xbc,
-	0xe8, 0x5f, 0x7c, 0x59, 0x97, 0x4e, 0xea, 0x98, 0x1e, 0xef, 0x95, 0xa1, 0x2e, 0xc2, 0x7c, 0xdf,
-	0xd2, 0x38, 0xa4, 0xfc, 0x99, 0x02, 0xcb, 0x1a, 0x6e, 0xba, 0xd8, 0xdb, 0x0f, 0xf3, 0x19, 0x44,
-	0x1b, 0x5f, 0xc1, 0xb5, 0xab, 0x2b, 0x70, 0x46, 0x2c, 0x2a, 0x5f, 0xcb, 0x17, 0x43, 0xb0, 0xa0,
-	0x61, 0xc3, 0xdc, 0x7a, 0xf0, 0xb4, 0xdf, 0x44, 0xaf, 0xc2, 0xa9, 0xb0, 0x8e, 0xa0, 0xbc, 0x71,
-	0x2e, 0xf3, 0x16, 0x7f, 0xf0, 0x94, 0x82, 0x1b, 0x1d, 0x2c, 0x7b, 0xf6, 0xa4, 0x1f, 0x4e, 0x25,
-	0xd1, 0xc3, 0x69, 0x07, 0x2a, 0x96, 0x4d, 0x46, 0x58, 0x87, 0x58, 0xc7, 0x76, 0x88, 0x13, 0x05,
-	0x8b, 0xaf, 0xe6, 0x43, 0xe2, 0x3b, 0x76, 0x70, 0xe0, 0xeb, 0x26, 0xd1, 0x7d, 0x87, 0x30, 0xf1,
-	0xac, 0xcf, 0xd9, 0x2d, 0x37, 0xac, 0x8d, 0x91, 0x86, 0x6d, 0xeb, 0x73, 0x8c, 0x2e, 0xc0, 0x34,
-	0x2d, 0x21, 0xa0, 0x23, 0x58, 0xa6, 0x7b, 0x84, 0x66, 0xba, 0x69, 0x65, 0xc1, 0x13, 0x63, 0x0f,
-	0xb3, 0xc2, 0xb7, 0xbf, 0x18, 0x82, 0xc5, 0x94, 0xb2, 0x42, 0x87, 0xef, 0x18, 0xda, 0x12, 0x1e,
-	0xca, 0xa1, 0x13, 0x1e, 0x4a, 0x64, 0xc0, 0x42, 0x8a, 0x6b, 0x10, 0x73, 0x1b, 0x18, 0x67, 0xe6,
-	0xfa, 0xd9, 0xd3, 0x98, 0x9b, 0x40, 0x63, 0xa7, 0x44, 0x1a, 0xfb, 0x57, 0x05, 0x16, 0x9f, 0x74,
-	0xdd, 0x3d, 0xfc, 0x35, 0xb7, 0x2f, 0xb5, 0x0a, 0x95, 0xf4, 0x3a, 0xf9, 0x19, 0xfb, 0xf3, 0x21,
-	0x58, 0x7c, 0x88, 0xbf, 0xfe, 0x4a, 0xf8, 0xbf, 0x39, 0x64, 0xb7, 0xa1, 0x92, 0x56, 0x16, 0x3f,
-	0x64, 0x02, 0x1e, 0x8a, 0x88, 0xc7, 0xaf, 0x2a, 0x70, 0xe6, 0x91, 0xe3, 0x5b, 0xcd, 0x1e, 0x79,
-	0xdf, 0x3a, 0x87, 0xd8, 0x7d, 0x68, 0x90, 0xc7, 0x6b, 0xa8, 0x76, 0x03, 0x16, 0x9a, 0xbc, 0x47,
-	0x6f, 0xd3, 0x2e, 0x3d, 0xe1, 0x1c, 0x65, 0x1e, 0x91, 0x24, 0x3f, 0xe6, 0x1f, 0xcd, 0x35, 0xd3,
-	0x8d, 0x9e, 0x7a, 0x0e, 0xce, 0x66, 0x88, 0xc0, 0xcd, 0xc2, 0x80, 0xe5, 0x7b, 0xd8, 0xdf, 0x74,
-	0x1d, 0xcf, 0xe3, 0xdb, 0x92, 0xb8, 0x45, 0x12, 0x2f, 0x2d, 0xa5, 0xef, 0xa5, 0x75, 0x1e, 0xca,
-	0xbe, 0xe1, 0xee, 0x61, 0x3f, 0xdc, 0x66, 0x76, 0x9f, 0x4c, 0xb1, 0x56, 0xce, 0x4f, 0xfd, 0xef,
-	0x12, 0x9c, 0x11, 0xcf, 0xc1, 0x15, 0xda, 0x26, 0x7c, 0x08, 0x3c, 0xec, 0xf6, 0xd8, 0xbb, 0x8f,
-	0xaf, 0xff, 0x9e, 0xcc, 0x19, 0xcb, 0x64, 0x47, 0x3d, 0x5d, 0xef, 0x76, 0x8f, 0x3a, 0x5b, 0xcc,
-	0x13, 0x9b, 0xf4, 0x63, 0x4d, 0xe8, 0x3b, 0x0a, 0xcc, 0x37, 0x69, 0x92, 0x49, 0x6f, 0x18, 0x5d,
-	0x0f, 0x47, 0xd3, 0x32, 0xd0, 0x7b, 0x78, 0xbc, 0x69, 0x59, 0xde, 0x6a, 0x93, 0x70, 0x4c, 0x4c,
-	0x8e, 0x9a, 0xa9, 0x8e, 0xea, 0x4b, 0x98, 0x4d, 0x49, 0x29, 0x70, 0x05, 0xef, 0x26, 0x5d, 0xc1,
-	0x77, 0xb3, 0xec, 0xa1, 0x5f, 0x28, 0xbe, 0x7b, 0x71, 0x7f, 0xb0, 0xfa, 0x12, 0x16, 0x33, 0x24,
-	0x14, 0x4c, 0xfc, 0x69, 0x7c, 0xe2, 0x72, 0x76, 0x88, 0xf5, 0x1e, 0xf6, 0xa3, 0x94, 0x1d, 0x65,
-	0x1c, 0x77, 0x41, 0xff, 0x5d, 0x81, 0x75, 0x9e, 0x24, 0x4b, 0xa9, 0x2d, 0x15, 0xdd, 0x97, 0xbc,
-	0x84, 0x8a, 0xd9, 0x19, 0x7a, 0xce, 0xcc, 0x28, 0xac, 0x66, 0x08, 0x02, 0xc4, 0x03, 0xa8, 0x8d,
-	0x17, 0x31, 0x4c, 0xf9, 0xb1, 0x5f, 0x1e, 0x7a, 0x03, 0xa6, 0x9a, 0xd8, 0x6f, 0xec, 0x3f, 0xc2,
-	0xcc, 0x6f, 0xe1, 0x59, 0x9d, 0x64, 0xa3, 0xea, 0xc1, 0x9b, 0x05, 0x16, 0x1b, 0xd6, 0x49, 0x0e,
-	0x07, 0xce, 0xef, 0x31, 0x77, 0x96, 0x92, 0xab, 0xef, 0xd3, 0x0f, 0xb1, 0x82, 0xc3, 0x4d, 0xef,
-	0xca, 0x02, 0x01, 0x29, 0xd5, 0xa7, 0x5f, 0x32, 0x25, 0xc9, 0xb8, 0x64, 0x1b, 0x30, 0x1f, 0xa5,
-	0x33, 0x82, 0xe0, 0x47, 0x97, 0xd7, 0x27, 0x0d, 0x6b, 0x51, 0xae, 0x63, 0x9b, 0x45, 0x3e, 0xba,
-	0x36, 0x8d, 0x46, 0x07, 0x9f, 0x0a, 0xf2, 0xb8, 0x0d, 0x0b, 0xca, 0x4c, 0xf1, 0x56, 0x16, 0xb6,
-	0xd9, 0xf8, 0x87, 0xb7, 0x01, 0x78, 0xe0, 0xe7, 0xd6, 0x93, 0x3a, 0xfa, 0x9e, 0x02, 0x0b, 0xe2,
-	0x4f, 0x9e, 0xd1, 0xb5, 0xcc, 0x23, 0x28, 0xfd, 0xe8, 0xba, 0x7a, 0x7d, 0x60, 0x3a, 0xbe, 0xea,
-	0xdf, 0x54, 0x60, 0x31, 0xe3, 0x63, 0x72, 0x24, 0x61, 0x2a, 0xfd, 0xbc, 0xbe, 0x7a, 0x63, 0x70,
-	0x42, 0x2e, 0xce, 0x4f, 0x14, 0x58, 0xcd, 0xfb, 0x2e, 0x1c, 0x7d, 0x9a, 0xc7, 0x3e, 0xef, 0x1b,
-	0xf5, 0xea, 0xad, 0x13, 0x70, 0xe0, 0x92, 0x92, 0x4d, 0x14, 0x7f, 0xf1, 0x2d, 0xd9, 0x44, 0xe9,
-	0x97, 0xe6, 0x92, 0x4d, 0xcc, 0xf9, 0xb4, 0xfc, 0xf7, 0x15, 0xa8, 0x66, 0x7f, 0x17, 0x8d, 0xb2,
-	0xcb, 0x9a, 0x72, 0xbf, 0x17, 0xaf, 0x7e, 0x78, 0x2c, 0x5a, 0x2e, 0xd7, 0xef, 0x2a, 0xb0, 0x94,
-	0xf9, 0xd5, 0x33, 0xfa, 0x20, 0x93, 0x75, 0xde, 0x47, 0xd7, 0xd5, 0x9b, 0xc7, 0x21, 0xe5, 0x42,
-	0xd9, 0x30, 0x95, 0xf8, 0x1c, 0x16, 0xbd, 0x93, 0xc9, 0x4c, 0xf4, 0xd5, 0x6d, 0xb5, 0x56, 0x74,
-	0x38, 0x9f, 0xef, 0x3b, 0x0a, 0x9c, 0x16, 0x7c, 0x53, 0x8a, 0xae, 0xca, 0x77, 0x5b, 0xf8, 0x15,
-	0x6b, 0xf5, 0xbd, 0xc1, 0x88, 0xb8, 0x08, 0x3e, 0x4c, 0xf7, 0x7d, 0xbf, 0x89, 0x2e, 0xcb, 0xee,
-	0x7a, 0x41, 0x9c, 0xbf, 0xfa, 0x6e, 0x71, 0x02, 0x3e, 0xeb, 0x11, 0xcc, 0xf4, 0x7f, 0xa7, 0x84,
-	0xb2, 0xb9, 0x64, 0x7c, 0xc9, 0x55, 0xbd, 0x32, 0x00, 0x45, 0xcc, 0xec, 0x32, 0x0b, 0xf6, 0x24,
-	0x66, 0x97, 0xf7, 0xad, 0x44, 0xf5, 0x04, 0xf5, 0x81, 0xe8, 0x8f, 0x14, 0x38, 0x23, 0xab, 0xe7,
-	0x43, 0x1f, 0x1d, 0xb3, 0x0c, 0x90, 0x89, 0xf6, 0xf1, 0x89, 0x8a, 0x08, 0xb9, 0xca, 0x32, 0x8a,
-	0xde, 0xa4, 0x2a, 0x93, 0x97, 0xdc, 0x49, 0x55, 0x96, 0x53, 0x63, 0x17, 0xdb, 0x47, 0x41, 0x45,
-	0x71, 0xee, 0x3e, 0x66, 0xd7, 0x72, 0xe7, 0xee, 0xa3, 0xac, 0x80, 0x39, 0xb6, 0x8f, 0xc2, 0xba,
-	0xb3, 0xfc, 0x7d, 0x94, 0xd5, 0xbe, 0xe5, 0xef, 0xa3, 0xb4, 0xd8, 0x2d, 0xbe, 0x8f, 0xe9, 0xd2,
-	0xb2, 0xfc, 0x7d, 0xcc, 0x2c, 0x6c, 0xcb, 0xdf, 0xc7, 0xec, 0x4a, 0x36, 0xf4, 0x87, 0x34, 0x62,
-	0x97, 0x59, 0x33, 0x86, 0x3e, 0x1c, 0x68, 0xcd, 0xc9, 0xaa, 0xb5, 0xea, 0x47, 0xc7, 0x23, 0x4e,
-	0x88, 0x96, 0x59, 0x30, 0x29, 0x15, 0x2d, 0xaf, 0x64, 0x53, 0x2a, 0x5a, 0x7e, 0x8d, 0xe6, 0x1f,
-	0x2b, 0xb0, 0x22, 0xaf, 0x94, 0x42, 0xdf, 0x96, 0x4c, 0x50, 0xa0, 0x5c, 0xac, 0xfa, 0xc9, 0xb1,
-	0xe9, 0xb9, 0x8c, 0xbf, 0xad, 0x40, 0x25, 0xab, 0x5e, 0x0e, 0xdd, 0x90, 0x70, 0x97, 0x16, 0x06,
-	0x56, 0x3f, 0x38, 0x06, 0x25, 0x97, 0xe8, 0xd7, 0x15, 0x98, 0x13, 0x55, 0x5d, 0xa1, 0xec, 0x9b,
-	0x53, 0x52, 0x63, 0x56, 0x7d, 0x7f, 0x40, 0x2a, 0x2e, 0xc5, 0x8f, 0xe8, 0xbf, 0x26, 0x92, 0x14,
-	0x1d, 0xa1, 0x8f, 0x73, 0x6c, 0x43, 0x5e, 0x12, 0x56, 0xfd, 0xf6, 0x71, 0xc9, 0xb9, 0x80, 0x9f,
-	0xc3, 0x6c, 0xaa, 0xfc, 0x06, 0x5d, 0x91, 0x30, 0x15, 0x97, 0x45, 0x55, 0x37, 0x06, 0x21, 0x89,
-	0xbc, 0x91, 0xbe, 0x82, 0x1a, 0x89, 0x37, 0x22, 0x2e, 0x03, 0x92, 0x78, 0x23, 0x19, 0xb5, 0x3a,
-	0xe8, 0x00, 0x26, 0xe3, 0xf5, 0x0d, 0xe8, 0x6d, 0x29, 0x87, 0xbe, 0x8a, 0x9e, 0xea, 0x3b, 0x05,
-	0x47, 0xc7, 0xac, 0x50, 0x54, 0xa0, 0x20, 0xb1, 0x42, 0x49, 0x8d, 0x85, 0xc4, 0x0a, 0xa5, 0x55,
-	0x10, 0xc4, 0xf3, 0x14, 0xd4, 0x1d, 0x48, 0x3c, 0xcf, 0xec, 0x22, 0x86, 0xea, 0x7b, 0x83, 0x11,
-	0x85, 0xdf, 0x5a, 0x40, 0x94, 0xc5, 0x47, 0x97, 0x32, 0x79, 0xa4, 0x4a, 0x03, 0xaa, 0x6f, 0x15,
-	0x1a, 0x1b, 0x4d, 0x13, 0x65, 0xc9, 0x25, 0xd3, 0xa4, 0x4a, 0x07, 0x24, 0xd3, 0xa4, 0xd3, 0xee,
-	0x6c, 0x9a, 0x20, 0xc7, 0x2d, 0x9d, 0xa6, 0x2f, 0x35, 0x2f, 0x9d, 0xa6, 0x3f, 0x69, 0x4e, 0x5e,
-	0x28, 0x89, 0xf4, 0xb4, 0xe4, 0x85, 0x22, 0xca, 0xad, 0x4b, 0x5e, 0x28, 0xe2, 0xac, 0xf7, 0xf7,
-	0xd8, 0x7f, 0xb5, 0x11, 0xe4, 0x2f, 0x25, 0x4f, 0x59, 0x69, 0xba, 0x5b, 0xf2, 0x94, 0xcd, 0xc9,
-	0x4f, 0x13, 0x07, 0x26, 0x33, 0x9d, 0x2a, 0x71, 0x60, 0xf2, 0x52, 0xbe, 0x12, 0x07, 0x26, 0x3f,
-	0x7b, 0x6b, 0xc3, 0x54, 0x22, 0x17, 0x29, 0xd9, 0x10, 0x51, 0x3a, 0x56, 0xb2, 0x21, 0xc2, 0x14,
-	0x27, 0x85, 0x0f, 0x51, 0xde, 0x10, 0xc9, 0x9e, 0x7f, 0x99, 0x19, 0x51, 0x09, 0x7c, 0xc8, 0x92,
-	0x93, 0x04, 0xa7, 0xfb, 0xd2, 0x6d, 0x12, 0x9c, 0x16, 0x67, 0x31, 0x25, 0x38, 0x9d, 0x95, 0xc9,
-	0x23, 0xaf, 0xc6, 0xbe, 0x54, 0x8e, 0xec, 0xd5, 0x28, 0xce, 0x6e, 0xc9, 0x5e, 0x8d, 0x19, 0x79,
-	0x22, 0x32, 0x71, 0x7f, 0xe6, 0x43, 0x32, 0x71, 0x46, 0x46, 0x49, 0x32, 0x71, 0x66, 0x5a, 0xe5,
-	0x37, 0x14, 0x98, 0x17, 0xe6, 0x2a, 0x50, 0xf6, 0xc6, 0xc9, 0xd2, 0x2b, 0xd5, 0x6b, 0x83, 0x92,
-	0xc5, 0xcc, 0x4e, 0x14, 0xe9, 0x97, 0x98, 0x9d, 0x24, 0x85, 0x22, 0x31, 0x3b, 0x69, 0x52, 0xe4,
-	0x0b, 0x25, 0xfc, 0x3a, 0x26, 0x3b, 0x9e, 0x8c, 0x6e, 0xe5, 0xb9, 0xfd, 0xb9, 0x81, 0xf7, 0xea,
-	0xed, 0x93, 0xb0, 0x48, 0x44, 0x56, 0xe2, 0xf1, 0x64, 0x79, 0x64, 0x45, 0x10, 0xb0, 0x96, 0x47,
-	0x56, 0x44, 0xa1, 0xea, 0xdb, 0x77, 0xfe, 0xee, 0xcb, 0x15, 0xe5, 0x1f, 0xbf, 0x5c, 0x51, 0xfe,
-	0xe5, 0xcb, 0x15, 0xe5, 0x17, 0xaf, 0xef, 0x59, 0xfe, 0x7e, 0x77, 0xb7, 0xd6, 0x70, 0xda, 0x97,
-	0x13, 0xff, 0x4b, 0xb8, 0xb6, 0x87, 0x6d, 0xf6, 0x4f, 0xa1, 0x63, 0xff, 0x95, 0xfa, 0x43, 0xfe,
-	0xe7, 0xe1, 0x95, 0xdd, 0x11, 0xda, 0x77, 0xf5, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4c, 0xed,
-	0x19, 0x44, 0xc1, 0x5a, 0x00, 0x00,
+	// 5222 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x4b, 0x6c, 0x1c, 0x47,
+	0x76, 0xe8, 0xa1, 0xf8, 0x7b, 0x24, 0x87, 0x64, 0x89, 0x9f, 0x51, 0x53, 0xa2, 0xc8, 0xd6, 0xc7,
+	0x5c, 0xd9, 0x1e, 0x49, 0x94, 0xf5, 0xb1, 0x6c, 0xd9, 0x96, 0x48, 0x49, 0x1e, 0x43, 0xb2, 0xa4,
+	0x26, 0x63, 0x6d, 0x82, 0x85, 0x7a, 0x9b, 0xd3, 0x35, 0x64, 0x87, 0x33, 0xdd, 0xa3, 0xae, 0x1e,
+	0x52, 0xf4, 0x21, 0x70, 0x3e, 0x08, 0xb0, 0x8b, 0x20, 0xdf, 0xcd, 0x07, 0x08, 0xb0, 0x40, 0xb0,
+	0x01, 0x36, 0x71, 0x0e, 0x39, 0x6c, 0x6e, 0xc1, 0x9e, 0x72, 0x49, 0x2e, 0x41, 0xae, 0x39, 0xc5,
+	0x30, 0x8c, 0x1c, 0x12, 0x20, 0x97, 0x04, 0x39, 0x07, 0xf5, 0xe9, 0xdf, 0x74, 0x77, 0x4d, 0xcf,
+	0x68, 0x17, 0xf6, 0x3a, 0xbe, 0x71, 0xaa, 0xde, 0x7b, 0xf5, 0xea, 0xd5, 0xab, 0x57, 0xaf, 0xde,
+	0x7b, 0xd5, 0x84, 0x73, 0x9d, 0x1d, 0xec, 0x5d, 0xac, 0x9b, 0x16, 0x76, 0xea, 0xf8, 0xe2, 0x9e,
+	0x4d, 0x7c, 0xd7, 0x3b, 0xba, 0x78, 0x70, 0xf9, 0x22, 0xc1, 0xde, 0x81, 0x5d, 0xc7, 0xd5, 0xb6,
+	0xe7, 0xfa, 0x2e, 0x5a, 0xa4, 0x60, 0x55, 0x01, 0x56, 0x15, 0x60, 0xd5, 0x83, 0xcb, 0xea, 0xf2,
+	0xae, 0xeb, 0xee, 0x36, 0xf1, 0x45, 0x06, 0xb6, 0xd3, 0x69, 0x5c, 0xb4, 0x3a, 0x9e, 0xe9, 0xdb,
+	0xae, 0xc3, 0x11, 0xd5, 0xd3, 0xdd, 0xfd, 0xbe, 0xdd, 0xc2, 0xc4, 0x37, 0x5b, 0x6d, 0x01, 0x90,
+	0x22, 0x70, 0xe8, 0x99, 0xed, 0x36, 0xf6, 0x88, 0xe8, 0x5f, 0x49, 0x30, 0x68, 0xb6, 0x6d, 0xca,
+	0x5c, 0xdd, 0x6d, 0xb5, 0xc2, 0x21, 0x56, 0xb3, 0x20, 0x02, 0x16, 0x05, 0x17, 0x59, 0x20, 0xcf,
+	0x3b, 0x38, 0x04, 0xd0, 0xb2, 0x00, 0x7c, 0x93, 0xec, 0x37, 0x6d, 0xe2, 0xcb, 0x60, 0x0e, 0x5d,
+	0x6f, 0xbf, 0xd1, 0x74, 0x0f, 0x05, 0xcc, 0x85, 0x2c, 0x18, 0x21, 0x4a, 0xa3, 0x0b, 0x76, 0xad,
+	0x17, 0x2c, 0xf6, 0x04, 0xe4, 0xd9, 0x04, 0x24, 0xd9, 0x33, 0x3d, 0x6c, 0x31, 0x31, 0x34, 0x3b,
+	0xc4, 0xef, 0x09, 0x95, 0x14, 0x85, 0x96, 0x03, 0xf5, 0xbc, 0x83, 0x3b, 0x38, 0x93, 0xb3, 0x08,
+	0xc6, 0xc3, 0xed, 0xa6, 0x5d, 0x8f, 0x2f, 0xef, 0xb9, 0x1c, 0xc8, 0xe4, 0x54, 0xb5, 0xbf, 0x1a,
+	0x86, 0x53, 0x5b, 0xbe, 0xe9, 0xf9, 0x4f, 0x45, 0xfb, 0xdd, 0x17, 0xb8, 0xde, 0xa1, 0x74, 0x74,
+	0xfc, 0xbc, 0x83, 0x89, 0x8f, 0x1e, 0xc0, 0xa8, 0xc7, 0xff, 0xac, 0x28, 0x2b, 0xca, 0xda, 0xc4,
+	0xfa, 0x7a, 0x35, 0xa1, 0x72, 0x66, 0xdb, 0xae, 0x1e, 0x5c, 0xae, 0x4a, 0x89, 0xe8, 0x01, 0x09,
+	0xb4, 0x04, 0xe3, 0x96, 0xdb, 0x32, 0x6d, 0xc7, 0xb0, 0xad, 0x4a, 0x69, 0x45, 0x59, 0x1b, 0xd7,
+	0xc7, 0x78, 0x43, 0xcd, 0x42, 0xdf, 0x81, 0xf9, 0xb6, 0xe9, 0x61, 0xc7, 0x37, 0x70, 0x40, 0xc0,
+	0xb0, 0x9d, 0x86, 0x5b, 0x19, 0x62, 0x03, 0xaf, 0x65, 0x0e, 0xfc, 0x98, 0x61, 0x84, 0x23, 0xd6,
+	0x9c, 0x86, 0xab, 0x1f, 0x6f, 0xa7, 0x1b, 0x51, 0x05, 0x46, 0x4d, 0xdf, 0xc7, 0xad, 0xb6, 0x5f,
+	0x39, 0xb6, 0xa2, 0xac, 0x0d, 0xeb, 0xc1, 0x4f, 0xb4, 0x01, 0xd3, 0xf8, 0x45, 0xdb, 0xe6, 0xdb,
+	0xc3, 0xa0, 0xfb, 0xa0, 0x32, 0xcc, 0x46, 0x54, 0xab, 0x7c, 0x0f, 0x54, 0x83, 0x3d, 0x50, 0xdd,
+	0x0e, 0x36, 0x89, 0x5e, 0x8e, 0x50, 0x68, 0x23, 0x6a, 0xc0, 0x89, 0xba, 0xeb, 0xf8, 0xb6, 0xd3,
+	0xc1, 0x86, 0x49, 0x0c, 0x07, 0x1f, 0x1a, 0xb6, 0x63, 0xfb, 0xb6, 0xe9, 0xbb, 0x5e, 0x65, 0x64,
+	0x45, 0x59, 0x2b, 0xaf, 0xbf, 0x9a, 0x39, 0x81, 0x0d, 0x81, 0x75, 0x9b, 0x7c, 0x88, 0x0f, 0x6b,
+	0x01, 0x8a, 0xbe, 0x50, 0xcf, 0x6c, 0x47, 0x35, 0x98, 0x0d, 0x7a, 0x2c, 0xa3, 0x61, 0xda, 0xcd,
+	0x8e, 0x87, 0x2b, 0xa3, 0x8c, 0xdd, 0x93, 0x99, 0xf4, 0xef, 0x71, 0x18, 0x7d, 0x26, 0x44, 0x13,
+	0x2d, 0x48, 0x87, 0x85, 0xa6, 0x49, 0x7c, 0xa3, 0xee, 0xb6, 0xda, 0x4d, 0xcc, 0x26, 0xef, 0x61,
+	0xd2, 0x69, 0xfa, 0x95, 0x31, 0x09, 0xbd, 0xc7, 0xe6, 0x51, 0xd3, 0x35, 0x2d, 0x7d, 0x8e, 0xe2,
+	0x6e, 0x84, 0xa8, 0x3a, 0xc3, 0x44, 0xdf, 0x86, 0xa5, 0x86, 0xed, 0x11, 0xdf, 0xb0, 0x70, 0xdd,
+	0x26, 0x4c, 0x9e, 0x26, 0xd9, 0x37, 0x76, 0xcc, 0xfa, 0xbe, 0xdb, 0x68, 0x54, 0xc6, 0x19, 0xe1,
+	0x13, 0x29, 0xb9, 0x6e, 0x0a, 0xe3, 0xa4, 0x57, 0x18, 0xf6, 0xa6, 0x40, 0xde, 0x36, 0xc9, 0xfe,
+	0x1d, 0x8e, 0xaa, 0x5d, 0x87, 0xe5, 0x3c, 0x25, 0x23, 0x6d, 0xd7, 0x21, 0x18, 0xcd, 0xc3, 0x88,
+	0xd7, 0x61, 0x9a, 0xa5, 0x30, 0xcd, 0x1a, 0xf6, 0x3a, 0x4e, 0xcd, 0xd2, 0xfe, 0xb2, 0x04, 0xcb,
+	0x5b, 0xf6, 0xae, 0x63, 0x36, 0x73, 0x95, 0xfc, 0x61, 0xb7, 0x92, 0x5f, 0xc9, 0x56, 0x72, 0x29,
+	0x95, 0x82, 0x5a, 0xde, 0x80, 0x25, 0xfc, 0xc2, 0xc7, 0x9e, 0x63, 0x36, 0x43, 0xc3, 0x13, 0x29,
+	0xbc, 0xd0, 0xf5, 0xf3, 0x99, 0xe3, 0xa7, 0x47, 0x3e, 0x11, 0x90, 0x4a, 0x75, 0xa1, 0x2a, 0x1c,
+	0xaf, 0xef, 0xd9, 0x4d, 0x2b, 0x1a, 0xc4, 0x75, 0x9a, 0x47, 0x4c, 0xf7, 0xc7, 0xf4, 0x59, 0xd6,
+	0x15, 0x20, 0x3d, 0x72, 0x9a, 0x47, 0xda, 0x2a, 0x9c, 0xce, 0x9d, 0x1f, 0x17, 0xb0, 0xf6, 0x3d,
+	0x05, 0xce, 0x70, 0x98, 0x3b, 0xa6, 0x5f, 0xdf, 0xcb, 0x15, 0xe7, 0xdd, 0x6e, 0x71, 0x76, 0x69,
+	0x7e, 0x74, 0x4c, 0x55, 0x63, 0xe4, 0xfa, 0x12, 0xa3, 0xf6, 0x1b, 0x25, 0x40, 0x69, 0x64, 0xb4,
+	0x00, 0x23, 0x1c, 0x44, 0xe8, 0x80, 0xf8, 0x85, 0x7e, 0x09, 0x50, 0x86, 0xb0, 0x4b, 0x7d, 0x09,
+	0x7b, 0xf6, 0x30, 0x25, 0xe4, 0x3b, 0x30, 0x4a, 0x18, 0x13, 0xa4, 0x32, 0xb4, 0x32, 0x94, 0x36,
+	0x52, 0xd9, 0x33, 0xad, 0xf9, 0xb8, 0xa5, 0x07, 0x88, 0x48, 0x85, 0x31, 0xdb, 0xc2, 0x8e, 0x6f,
+	0xfb, 0x7c, 0x75, 0xc6, 0xf5, 0xf0, 0x37, 0x3a, 0x05, 0x20, 0xa4, 0x41, 0x65, 0x30, 0xcc, 0x7a,
+	0xc7, 0x45, 0x4b, 0xcd, 0xd2, 0x1a, 0x30, 0xdd, 0x45, 0x16, 0x9d, 0x86, 0x09, 0x4e, 0xd8, 0x70,
+	0xcc, 0x16, 0x16, 0x52, 0x00, 0xde, 0xf4, 0xa1, 0xd9, 0xc2, 0x68, 0x1d, 0x86, 0x6d, 0xa7, 0xdd,
+	0xf1, 0x2b, 0xa5, 0x02, 0x9b, 0x9c, 0x83, 0x6a, 0xe7, 0xe1, 0xac, 0x7c, 0xdd, 0x85, 0x82, 0xfc,
+	0x50, 0x81, 0x57, 0x84, 0x12, 0xd9, 0xfe, 0x9e, 0xfc, 0x60, 0xf9, 0xa8, 0x5b, 0x49, 0xde, 0x96,
+	0xed, 0xb9, 0x5e, 0xe4, 0x0a, 0x6a, 0xcd, 0x6d, 0x58, 0xeb, 0x4d, 0x50, 0x6e, 0x4e, 0xbe, 0xaf,
+	0xc0, 0x29, 0x1d, 0x13, 0xfc, 0xd2, 0x47, 0xa6, 0x94, 0x48, 0xc1, 0xf9, 0x5c, 0x87, 0xe5, 0x3c,
+	0x32, 0xf2, 0x59, 0x7c, 0x5a, 0x82, 0xd5, 0x6d, 0xec, 0xb5, 0x6c, 0xc7, 0xf4, 0x71, 0xee, 0x4c,
+	0x1e, 0x77, 0xcf, 0xe4, 0x5a, 0xe6, 0x4c, 0x7a, 0x12, 0xfa, 0x05, 0x37, 0x8d, 0x67, 0x41, 0x93,
+	0x4d, 0x51, 0x28, 0xff, 0xef, 0x2b, 0xb0, 0xb2, 0x89, 0x49, 0xdd, 0xb3, 0x77, 0xf2, 0x25, 0xfa,
+	0xa8, 0x5b, 0xa2, 0x57, 0x33, 0xa7, 0xd3, 0x8b, 0x4e, 0x41, 0xf5, 0xf8, 0xec, 0x18, 0xac, 0x4a,
+	0x48, 0x09, 0x15, 0x69, 0xc2, 0x62, 0xe4, 0x70, 0xd5, 0x5d, 0xa7, 0x61, 0xef, 0x8a, 0xe3, 0x58,
+	0x7a, 0x1a, 0xa6, 0x08, 0x6e, 0xc4, 0x51, 0xf5, 0x05, 0x9c, 0xd9, 0x8e, 0x76, 0x60, 0x31, 0xbd,
+	0xb6, 0xdc, 0xcf, 0xe3, 0x16, 0xe9, 0x42, 0xb1, 0xd1, 0x98, 0xa7, 0x37, 0x7f, 0x98, 0xd5, 0x8c,
+	0x9e, 0x02, 0x6a, 0x63, 0xc7, 0xb2, 0x9d, 0x5d, 0xc3, 0xac, 0xfb, 0xf6, 0x81, 0xed, 0xdb, 0x38,
+	0xc7, 0x42, 0x07, 0x06, 0x8f, 0x83, 0xdf, 0xe6, 0xd0, 0x47, 0x8c, 0xf8, 0x6c, 0x3b, 0xd1, 0x68,
+	0x63, 0x82, 0x7e, 0x19, 0x66, 0x02, 0xc2, 0x4c, 0x4d, 0x3c, 0xec, 0x54, 0x8e, 0x31, 0xb2, 0x55,
+	0x19, 0xd9, 0x0d, 0x0a, 0x9b, 0xe4, 0x7c, 0xba, 0x1d, 0xeb, 0xf2, 0xb0, 0x83, 0xb6, 0x22, 0xd2,
+	0x81, 0xef, 0x24, 0xdc, 0x50, 0x29, 0xc7, 0x81, 0xab, 0x94, 0x20, 0x1a, 0x34, 0xa2, 0xef, 0xc2,
+	0xbc, 0x38, 0x82, 0x8c, 0xa6, 0xdd, 0xb2, 0x7d, 0xe3, 0xd0, 0xf4, 0x1c, 0xdb, 0xd9, 0x65, 0x1e,
+	0xe9, 0xc4, 0xfa, 0x6b, 0xb9, 0xa7, 0xd5, 0xfb, 0xfc, 0xcf, 0x07, 0x14, 0xe9, 0x29, 0xc7, 0xd1,
+	0x8f, 0xef, 0xa5, 0x1b, 0xb5, 0x9f, 0x96, 0xe0, 0x78, 0x06, 0x30, 0x3a, 0x03, 0x53, 0xc1, 0xc8,
+	0x75, 0xb7, 0xe3, 0x70, 0x75, 0x1f, 0xd2, 0x27, 0x45, 0xe3, 0x06, 0x6d, 0x43, 0xab, 0x10, 0xfc,
+	0x36, 0x88, 0xfd, 0x31, 0x66, 0x0a, 0x30, 0xa4, 0x4f, 0x88, 0xb6, 0x2d, 0xfb, 0x63, 0x4c, 0xf7,
+	0x6a, 0x82, 0x0e, 0x9f, 0x07, 0xb3, 0x05, 0x43, 0xfa, 0x6c, 0x9c, 0x1a, 0x1b, 0x1e, 0xbd, 0x06,
+	0x28, 0x4e, 0x52, 0x80, 0x1f, 0x63, 0xe0, 0x33, 0x31, 0xc2, 0x1c, 0xfa, 0x3c, 0x4c, 0xe3, 0x03,
+	0xec, 0xf8, 0xc4, 0x68, 0x63, 0xcf, 0xd8, 0x73, 0x3b, 0x1e, 0x93, 0xb9, 0xa2, 0x4f, 0xf1, 0xe6,
+	0xc7, 0xd8, 0x7b, 0xdf, 0xed, 0x78, 0xe8, 0xdb, 0xa0, 0x62, 0xe2, 0xdb, 0x2d, 0xd3, 0xc7, 0x96,
+	0x41, 0x70, 0xdd, 0x75, 0x2c, 0x62, 0xf8, 0xae, 0xa0, 0xce, 0x85, 0xb9, 0x94, 0xf2, 0x6a, 0x6b,
+	0x8e, 0x7f, 0xed, 0x8d, 0x8f, 0xcc, 0x66, 0x07, 0xeb, 0x8b, 0x21, 0xfa, 0x16, 0xc7, 0xde, 0x76,
+	0x19, 0x07, 0xda, 0x0b, 0x98, 0x7b, 0x42, 0xef, 0xbb, 0x81, 0x7e, 0x07, 0x86, 0x62, 0xa3, 0xdb,
+	0x50, 0x7c, 0x2b, 0x53, 0x0b, 0xb2, 0x70, 0x0b, 0x1a, 0x87, 0x1f, 0x29, 0x30, 0xdf, 0x85, 0x2e,
+	0x0c, 0xc2, 0xbb, 0x30, 0xc9, 0xee, 0xe0, 0xc1, 0x75, 0x40, 0x29, 0xe0, 0x29, 0x4c, 0x30, 0x0c,
+	0x71, 0x0b, 0xa8, 0x41, 0x39, 0x20, 0xf0, 0xab, 0xb8, 0xee, 0x63, 0x4b, 0x6c, 0x6d, 0x2d, 0x7f,
+	0x0e, 0xba, 0x80, 0xd4, 0xa7, 0x9e, 0xc7, 0x7f, 0x6a, 0xbf, 0xa5, 0x80, 0xca, 0x8e, 0xb8, 0x2d,
+	0xdf, 0xae, 0xef, 0x1f, 0xd1, 0x1b, 0xc1, 0x03, 0x9b, 0xf8, 0x81, 0x98, 0x6a, 0xdd, 0x62, 0xba,
+	0x98, 0x7f, 0xd6, 0x66, 0x52, 0x28, 0x28, 0xac, 0x53, 0xb0, 0x94, 0x49, 0x43, 0xd8, 0xfe, 0xff,
+	0x56, 0x60, 0xe1, 0x3e, 0xf6, 0x1f, 0x76, 0x7c, 0x73, 0xa7, 0x89, 0xb7, 0x7c, 0xd3, 0xc7, 0x7a,
+	0x16, 0x59, 0xa5, 0xeb, 0xc4, 0xfb, 0x39, 0xb9, 0xa5, 0x57, 0x60, 0x01, 0xbf, 0x68, 0x33, 0x01,
+	0x1a, 0x0e, 0x7e, 0xe1, 0x1b, 0x4c, 0x9b, 0x29, 0x03, 0x7c, 0xdf, 0x1c, 0x0f, 0x7a, 0x3f, 0xc4,
+	0x2f, 0xfc, 0xbb, 0xb4, 0xaf, 0x66, 0xa1, 0x4b, 0x30, 0x57, 0xef, 0x78, 0xec, 0xfe, 0xbd, 0xe3,
+	0x99, 0x4e, 0x7d, 0xcf, 0xf0, 0xdd, 0x7d, 0x66, 0xdf, 0x94, 0xb5, 0x49, 0x1d, 0x89, 0xbe, 0x3b,
+	0xac, 0x6b, 0x9b, 0xf6, 0x68, 0x3f, 0x18, 0x87, 0xc5, 0xd4, 0xac, 0x85, 0x0e, 0x65, 0xcf, 0x4c,
+	0x79, 0xd9, 0x99, 0xdd, 0x83, 0xa9, 0x90, 0xac, 0x7f, 0xd4, 0xc6, 0x42, 0x56, 0xab, 0x52, 0x8a,
+	0xdb, 0x47, 0x6d, 0xac, 0x4f, 0x1e, 0xc6, 0x7e, 0x21, 0x0d, 0xa6, 0xb2, 0x04, 0x33, 0xe1, 0xc4,
+	0x04, 0xf2, 0x11, 0x9c, 0x68, 0x7b, 0xf8, 0xc0, 0x76, 0x3b, 0xc4, 0x20, 0xd4, 0x57, 0xc4, 0x56,
+	0x04, 0x7f, 0xac, 0xf7, 0x9e, 0x5f, 0x08, 0xb0, 0xb7, 0x38, 0x72, 0x40, 0xf7, 0x75, 0x38, 0xce,
+	0xee, 0xdd, 0xfc, 0xa2, 0x1c, 0x52, 0x1c, 0xe6, 0x36, 0x8a, 0x76, 0xdd, 0xa3, 0x3d, 0x01, 0xf8,
+	0x4d, 0x18, 0x67, 0x77, 0xe8, 0xa6, 0x4d, 0x02, 0x53, 0x73, 0x2a, 0xdb, 0x0d, 0x0b, 0xb4, 0x72,
+	0xcc, 0x17, 0x7f, 0xa1, 0xfb, 0x30, 0x43, 0x98, 0xc6, 0x1a, 0x11, 0x89, 0xd1, 0x22, 0x24, 0xca,
+	0x24, 0xa1, 0xe8, 0xe8, 0x0d, 0x58, 0xa8, 0x37, 0x6d, 0xcc, 0xec, 0xef, 0x8e, 0x67, 0x7a, 0x47,
+	0xc6, 0x01, 0xf6, 0xd8, 0x19, 0x35, 0xc6, 0x54, 0x7a, 0x8e, 0xf7, 0x3e, 0xe0, 0x9d, 0x1f, 0xf1,
+	0xbe, 0x18, 0x56, 0x03, 0x9b, 0x7e, 0xc7, 0xc3, 0x21, 0xd6, 0x78, 0x1c, 0xeb, 0x1e, 0xef, 0x0c,
+	0xb0, 0x4e, 0xc3, 0x84, 0xc0, 0xb2, 0x5b, 0xed, 0x66, 0x05, 0x18, 0x28, 0xf0, 0xa6, 0x5a, 0xab,
+	0xdd, 0x44, 0x04, 0x2e, 0x74, 0xcf, 0xca, 0x20, 0xf5, 0x3d, 0x6c, 0x75, 0x9a, 0x98, 0x5a, 0x65,
+	0xb6, 0x58, 0x2c, 0x90, 0xe3, 0x76, 0xfc, 0xca, 0x44, 0xaf, 0x98, 0xc3, 0xd9, 0xe4, 0x5c, 0xb7,
+	0x04, 0xa5, 0x6d, 0x97, 0xad, 0xdb, 0x36, 0x27, 0x43, 0x0f, 0x22, 0xbe, 0x54, 0xf4, 0x08, 0x89,
+	0x26, 0x32, 0xc9, 0x62, 0x49, 0xb3, 0xac, 0x6b, 0xcb, 0x77, 0xa3, 0x59, 0xe4, 0x6d, 0xa7, 0xa9,
+	0xbc, 0xed, 0x84, 0x1e, 0x40, 0x39, 0xd4, 0x6d, 0x42, 0x37, 0x53, 0xa5, 0xcc, 0xe2, 0x46, 0xe7,
+	0x92, 0x4b, 0xc5, 0x83, 0x79, 0x71, 0xfd, 0xe6, 0x3b, 0x6f, 0xea, 0x30, 0xfe, 0x13, 0xd5, 0x61,
+	0x2e, 0xa4, 0x56, 0x6f, 0xba, 0x04, 0x0b, 0x9a, 0xd3, 0x8c, 0xe6, 0xe5, 0x82, 0x2e, 0x1d, 0x45,
+	0xa4, 0xf4, 0x3a, 0x44, 0x0f, 0xf7, 0x73, 0xd8, 0x48, 0x77, 0xf9, 0xac, 0x10, 0x84, 0xc1, 0xcf,
+	0x56, 0xea, 0x67, 0xcd, 0x64, 0x79, 0x2d, 0x11, 0xd7, 0x42, 0x40, 0xef, 0x07, 0xf0, 0xfa, 0xcc,
+	0x41, 0x57, 0x0b, 0x7a, 0x1b, 0x96, 0x6c, 0x62, 0xf0, 0x65, 0x89, 0xad, 0x31, 0x76, 0xa8, 0x9d,
+	0xb1, 0x2a, 0xb3, 0xcc, 0x51, 0x5f, 0xb4, 0x49, 0xd2, 0x1a, 0xdf, 0xe5, 0xdd, 0xda, 0xff, 0x28,
+	0xb0, 0xf8, 0xd8, 0x6d, 0x36, 0xff, 0x9f, 0x59, 0xe3, 0x1f, 0x8f, 0x41, 0x25, 0x3d, 0xed, 0x6f,
+	0xcc, 0xf1, 0x37, 0xe6, 0xf8, 0xeb, 0x68, 0x8e, 0xf3, 0xf6, 0xc7, 0x64, 0xae, 0x79, 0xcd, 0xb4,
+	0x55, 0x53, 0x2f, 0x6d, 0xab, 0x7e, 0xf1, 0xac, 0xb6, 0xf6, 0x0f, 0x25, 0x58, 0xd1, 0x71, 0xdd,
+	0xf5, 0xac, 0x78, 0xa0, 0x5d, 0x6c, 0x8b, 0x2f, 0xd3, 0x52, 0xd2, 0xe0, 0x65, 0xa0, 0x38, 0xa1,
+	0x11, 0x80, 0xa0, 0xa9, 0x66, 0xa1, 0x45, 0x18, 0x65, 0x3a, 0x26, 0x76, 0xfc, 0x90, 0x3e, 0x42,
+	0x7f, 0xd6, 0xac, 0x1e, 0x81, 0x52, 0xa4, 0xc3, 0x64, 0xdb, 0x6d, 0x36, 0x0d, 0xd1, 0x52, 0x19,
+	0x91, 0xdc, 0x15, 0xa8, 0x0d, 0xbd, 0xe7, 0x7a, 0x71, 0xd1, 0x04, 0x77, 0x85, 0x09, 0x4a, 0x44,
+	0xfc, 0xd0, 0xfe, 0x6d, 0x14, 0x56, 0x25, 0x52, 0x14, 0x86, 0x37, 0x65, 0x21, 0x95, 0xc1, 0x2c,
+	0xa4, 0xd4, 0xfa, 0x95, 0x06, 0xb7, 0x7e, 0xaf, 0x01, 0x0a, 0xe4, 0x6b, 0x75, 0x9b, 0xdf, 0x99,
+	0xb0, 0x27, 0x80, 0x5e, 0xa3, 0x06, 0x2c, 0xc3, 0xf4, 0x0e, 0xe9, 0x65, 0xd1, 0x1e, 0x40, 0xa6,
+	0x2c, 0xfa, 0x70, 0xda, 0xa2, 0xc7, 0x52, 0x72, 0x23, 0xc9, 0x94, 0xdc, 0x0d, 0xa8, 0x08, 0x93,
+	0x12, 0x85, 0x88, 0x82, 0xd3, 0x7f, 0x94, 0x9d, 0xfe, 0x0b, 0xbc, 0x3f, 0xd4, 0x1d, 0x71, 0xf8,
+	0x23, 0x1d, 0xa6, 0xc2, 0xd4, 0x13, 0x0b, 0x2a, 0xf1, 0x5c, 0xd6, 0xeb, 0x79, 0xbb, 0x71, 0xdb,
+	0x33, 0x1d, 0x42, 0x4d, 0x59, 0x22, 0x90, 0x32, 0x69, 0xc5, 0x7e, 0xa1, 0x67, 0x70, 0x32, 0x23,
+	0x64, 0x15, 0x99, 0xf0, 0xf1, 0x22, 0x26, 0xfc, 0x44, 0x4a, 0xdd, 0x83, 0xae, 0x3c, 0xd7, 0x12,
+	0xf2, 0x5c, 0xcb, 0x55, 0x98, 0x4c, 0xd8, 0xbc, 0x09, 0x66, 0xf3, 0x26, 0x76, 0x62, 0xc6, 0xee,
+	0x36, 0x94, 0xa3, 0x65, 0x65, 0x29, 0xcd, 0xc9, 0x9e, 0x29, 0xcd, 0xa9, 0x10, 0x83, 0xb6, 0xa1,
+	0x5b, 0x30, 0x19, 0xac, 0x35, 0x23, 0x30, 0xd5, 0x93, 0xc0, 0x84, 0x80, 0x67, 0xe8, 0x26, 0x8c,
+	0xd2, 0x9b, 0x3c, 0x35, 0xb2, 0x65, 0x16, 0x21, 0xbb, 0x9f, 0x1b, 0x6c, 0xea, 0xb9, 0x8b, 0x58,
+	0x88, 0xc0, 0xc6, 0xe4, 0xae, 0xe3, 0x7b, 0x47, 0x7a, 0x40, 0x57, 0x7d, 0x06, 0x93, 0xf1, 0x0e,
+	0x34, 0x03, 0x43, 0xfb, 0xf8, 0x48, 0x18, 0x2b, 0xfa, 0x27, 0xba, 0x01, 0xc3, 0x07, 0x54, 0xfd,
+	0xa5, 0xf1, 0x87, 0x60, 0xd7, 0xf1, 0x38, 0x04, 0x47, 0xb8, 0x59, 0xba, 0xa1, 0xc4, 0xec, 0x64,
+	0x10, 0x17, 0xfc, 0xc6, 0x4e, 0xa6, 0xec, 0x64, 0x5c, 0x34, 0x99, 0x76, 0xf2, 0x8b, 0xa1, 0xc0,
+	0x4e, 0x66, 0x4a, 0x51, 0xd8, 0xc9, 0x0f, 0x60, 0xba, 0xcb, 0x0e, 0x49, 0x2d, 0xa5, 0x08, 0x39,
+	0x32, 0x4b, 0xa2, 0x97, 0x93, 0x76, 0x2a, 0xa5, 0xb9, 0xa5, 0xfe, 0x34, 0x37, 0x66, 0x96, 0x86,
+	0x92, 0x66, 0xe9, 0x19, 0x2c, 0x27, 0x77, 0x95, 0xe1, 0x36, 0x0c, 0x7f, 0xcf, 0x26, 0x46, 0xbc,
+	0xb4, 0x40, 0x3e, 0x94, 0x9a, 0xd8, 0x65, 0x8f, 0x1a, 0xdb, 0x7b, 0x36, 0xb9, 0x2d, 0xe8, 0xd7,
+	0x60, 0x76, 0x0f, 0x9b, 0x9e, 0xbf, 0x83, 0x4d, 0xdf, 0xb0, 0xb0, 0x6f, 0xda, 0x4d, 0x52, 0x19,
+	0x2e, 0x10, 0x7d, 0x9b, 0x09, 0xd1, 0x36, 0x39, 0x56, 0xfa, 0xdc, 0x19, 0x19, 0xec, 0xdc, 0x79,
+	0x05, 0xa6, 0x43, 0x3a, 0x22, 0xb3, 0x3a, 0xca, 0xb4, 0x26, 0xf4, 0x7a, 0x36, 0x59, 0xab, 0xf6,
+	0x27, 0x0a, 0x9c, 0xe1, 0xab, 0x99, 0xd8, 0xc9, 0xa2, 0x42, 0x20, 0xda, 0x2f, 0x7a, 0x77, 0xc4,
+	0xee, 0x46, 0x5e, 0xc4, 0xae, 0x17, 0xa9, 0x82, 0xa1, 0xbb, 0x9f, 0x0c, 0xc1, 0x59, 0x39, 0x35,
+	0xa1, 0x82, 0x38, 0x3a, 0xdc, 0x3c, 0xd1, 0x26, 0x58, 0xbc, 0x39, 0xb8, 0xe9, 0xd2, 0xa7, 0x49,
+	0x97, 0xa6, 0xff, 0x48, 0x81, 0xe5, 0x28, 0x2b, 0x41, 0x1d, 0x64, 0xcb, 0x26, 0x6d, 0x9a, 0x58,
+	0x35, 0x9a, 0x6e, 0xdd, 0x6c, 0x36, 0x8f, 0x2a, 0x25, 0x66, 0x30, 0x9f, 0x49, 0x46, 0xed, 0x3d,
+	0x9d, 0x6a, 0x94, 0xb6, 0xd8, 0x76, 0x37, 0xc5, 0x08, 0x0f, 0xf8, 0x00, 0xdc, 0x8e, 0x2e, 0x99,
+	0xf9, 0x10, 0xea, 0xaf, 0xc1, 0x4a, 0x2f, 0x02, 0x19, 0xf6, 0x76, 0x33, 0x69, 0x6f, 0xb3, 0x93,
+	0x22, 0x81, 0x19, 0x60, 0xb4, 0x02, 0xc2, 0xec, 0xd8, 0x8d, 0xd9, 0x5e, 0x9a, 0x4d, 0xcb, 0x98,
+	0x26, 0xad, 0x5d, 0xc1, 0x56, 0x9f, 0xd9, 0xb4, 0x5e, 0x74, 0x0a, 0x2a, 0xd2, 0x19, 0x58, 0x95,
+	0x50, 0x12, 0x91, 0xe0, 0x1f, 0x28, 0xa0, 0xa5, 0xad, 0xdd, 0xfb, 0xc1, 0xf6, 0x0c, 0x38, 0x7f,
+	0xd2, 0xcd, 0xf9, 0xf5, 0x1c, 0xce, 0x7b, 0x51, 0x2a, 0xc8, 0xfb, 0x63, 0x38, 0x23, 0xa5, 0x25,
+	0x74, 0xf3, 0x5b, 0x30, 0x53, 0x37, 0x9d, 0x3a, 0x0e, 0x4f, 0x00, 0xcc, 0xcf, 0xb4, 0x31, 0x7d,
+	0x9a, 0xb7, 0xeb, 0x41, 0x73, 0x7c, 0xbf, 0xc7, 0x69, 0xbe, 0xe4, 0x7e, 0x97, 0x91, 0x2a, 0x38,
+	0xd5, 0xf3, 0x70, 0x56, 0x4e, 0x2c, 0x96, 0xaf, 0xcd, 0x00, 0x7c, 0x19, 0x0d, 0xcb, 0xa5, 0xd3,
+	0xb7, 0x86, 0x65, 0x51, 0x4a, 0x68, 0x58, 0x7a, 0x82, 0x6c, 0x7d, 0xb0, 0xd5, 0xb7, 0x86, 0xf5,
+	0xa2, 0x54, 0x90, 0xf7, 0x73, 0x70, 0x46, 0x4a, 0x4b, 0x70, 0xff, 0x77, 0x0a, 0x9c, 0xd6, 0x71,
+	0xcb, 0x3d, 0xc0, 0xbc, 0x10, 0xe3, 0xab, 0x12, 0xa4, 0x4b, 0x3a, 0x46, 0x43, 0xdd, 0x95, 0x36,
+	0x1a, 0xac, 0xe4, 0x73, 0x2d, 0xa6, 0xf6, 0xf7, 0x25, 0x38, 0x27, 0xa6, 0xc0, 0xa7, 0x9d, 0x5b,
+	0x05, 0x20, 0x9d, 0xa0, 0x09, 0xe5, 0xe4, 0x1e, 0xac, 0x94, 0xb2, 0x0e, 0xa1, 0x70, 0xfd, 0x0a,
+	0x0c, 0xa8, 0x4f, 0x25, 0x76, 0x2f, 0xcd, 0xc1, 0x87, 0x85, 0x16, 0x99, 0xb5, 0x96, 0xd9, 0x39,
+	0xf8, 0xbb, 0x02, 0xa7, 0x2b, 0x07, 0x8f, 0xb3, 0x9a, 0xfb, 0x2e, 0xb2, 0x58, 0x83, 0xf3, 0xbd,
+	0xe6, 0x22, 0xe4, 0xfc, 0x53, 0x05, 0x96, 0x82, 0xa8, 0x50, 0xc6, 0x2d, 0xfd, 0x4b, 0x51, 0x9f,
+	0x0b, 0x30, 0x6b, 0x13, 0x23, 0x59, 0xfa, 0xc8, 0x64, 0x39, 0xa6, 0x4f, 0xdb, 0xe4, 0x5e, 0xbc,
+	0xa8, 0x51, 0x5b, 0x86, 0x93, 0xd9, 0xec, 0x8b, 0xf9, 0x7d, 0x51, 0x82, 0xb3, 0xdc, 0x58, 0x27,
+	0xeb, 0x06, 0x52, 0xa6, 0xf5, 0xcb, 0x98, 0xe8, 0x2a, 0x4c, 0x8a, 0xba, 0x56, 0x6c, 0xc5, 0x02,
+	0xb5, 0x61, 0x5b, 0xcd, 0x42, 0x4f, 0xe1, 0x78, 0x3d, 0x60, 0x35, 0x36, 0xf4, 0xb1, 0xbe, 0x86,
+	0x46, 0x21, 0x89, 0x68, 0xec, 0x07, 0x30, 0x13, 0xab, 0x55, 0xe5, 0x97, 0x84, 0xe1, 0xa2, 0x97,
+	0x84, 0xe9, 0x08, 0x95, 0x35, 0x68, 0xaf, 0xc0, 0xb9, 0x1e, 0x52, 0x16, 0xeb, 0xf1, 0x1f, 0x25,
+	0xa8, 0xe8, 0xa2, 0xc2, 0x1a, 0x33, 0x5c, 0xf2, 0xd1, 0xfa, 0x97, 0xb9, 0x06, 0xcf, 0x60, 0x3e,
+	0x19, 0xc9, 0x3c, 0x32, 0x6c, 0x1f, 0xb7, 0x82, 0x0a, 0x97, 0x0b, 0x85, 0xa2, 0x99, 0x47, 0xac,
+	0x0a, 0xf1, 0xf8, 0x41, 0xaa, 0x8d, 0xa0, 0xab, 0x30, 0xc2, 0x84, 0x4b, 0x2a, 0xc7, 0x24, 0x91,
+	0x8d, 0x4d, 0xd3, 0x37, 0xef, 0x34, 0xdd, 0x1d, 0x5d, 0x00, 0xa3, 0x0d, 0x28, 0xd3, 0xb2, 0x67,
+	0x5a, 0x6e, 0x26, 0xd0, 0x87, 0x8b, 0xa0, 0x4f, 0x3a, 0xf8, 0x50, 0xef, 0xf0, 0x45, 0x21, 0xda,
+	0x12, 0x9c, 0xc8, 0x90, 0xb5, 0x58, 0x89, 0xef, 0x2b, 0xb0, 0xb0, 0x75, 0xe4, 0xd4, 0xb7, 0xf6,
+	0x4c, 0xcf, 0x12, 0x01, 0x4e, 0xb1, 0x0e, 0xe7, 0xa0, 0x4c, 0xdc, 0x8e, 0x57, 0xc7, 0x86, 0x28,
+	0xbe, 0x17, 0x8b, 0x31, 0xc5, 0x5b, 0x37, 0x78, 0x23, 0x3a, 0x01, 0x63, 0x54, 0x1e, 0x56, 0x70,
+	0x82, 0x0d, 0xeb, 0xa3, 0xec, 0x77, 0xcd, 0x42, 0x55, 0x38, 0xc6, 0x6e, 0x8b, 0x43, 0x3d, 0xaf,
+	0x70, 0x0c, 0x4e, 0x3b, 0x01, 0x8b, 0x29, 0x5e, 0x04, 0x9f, 0xff, 0x34, 0x0c, 0xc7, 0x69, 0x5f,
+	0x70, 0x12, 0x7e, 0x99, 0xca, 0x52, 0x81, 0xd1, 0x20, 0xa0, 0xc4, 0xf7, 0x6a, 0xf0, 0x93, 0x6e,
+	0xe5, 0xe8, 0x36, 0x1b, 0x46, 0x0a, 0xc2, 0xc8, 0x02, 0x95, 0x49, 0x3a, 0x8c, 0x34, 0xdc, 0x6f,
+	0x18, 0xe9, 0x14, 0x40, 0x70, 0xab, 0xb2, 0x2d, 0x76, 0x0b, 0x1d, 0xd2, 0xc7, 0x45, 0x4b, 0xcd,
+	0x4a, 0xdd, 0xd5, 0x47, 0xfb, 0xbb, 0xab, 0x7f, 0x20, 0x92, 0x37, 0xd1, 0xb5, 0x99, 0x51, 0x19,
+	0xeb, 0x49, 0x65, 0x96, 0xa2, 0x85, 0x0e, 0x30, 0xa3, 0x75, 0x0d, 0x46, 0x83, 0x3b, 0xf7, 0x78,
+	0x81, 0x3b, 0x77, 0x00, 0x1c, 0x8f, 0x17, 0x40, 0x32, 0x5e, 0xf0, 0x2e, 0x4c, 0xf2, 0xd4, 0x92,
+	0xa8, 0xd3, 0x9f, 0x28, 0x50, 0xa7, 0x3f, 0xc1, 0x32, 0x4e, 0xfc, 0x07, 0xcd, 0x72, 0x30, 0x02,
+	0xfc, 0xd5, 0x89, 0x11, 0xd6, 0x09, 0x4f, 0x32, 0xdd, 0x41, 0xb4, 0xef, 0x29, 0xeb, 0xaa, 0x89,
+	0x1e, 0xf4, 0x08, 0xa6, 0xbb, 0x6c, 0x43, 0x65, 0x2a, 0x4b, 0x85, 0xf2, 0xac, 0x82, 0x5e, 0x4e,
+	0x5a, 0x04, 0x6d, 0x01, 0xe6, 0x92, 0xaa, 0x2c, 0x74, 0xfc, 0x0f, 0x14, 0x58, 0x0a, 0x6a, 0x0b,
+	0xbf, 0x22, 0x4e, 0x9c, 0xf6, 0xbb, 0x0a, 0x9c, 0xcc, 0xe6, 0x49, 0xdc, 0x6f, 0xae, 0xc0, 0x42,
+	0x8b, 0xb7, 0xf3, 0xbc, 0x8a, 0x61, 0x3b, 0x46, 0xdd, 0xac, 0xef, 0x05, 0x85, 0xd2, 0xc7, 0x5b,
+	0x31, 0xac, 0x9a, 0xb3, 0x41, 0xbb, 0xd0, 0x9b, 0x70, 0x22, 0x85, 0x64, 0x99, 0xbe, 0xb9, 0x63,
+	0x12, 0x2c, 0xdc, 0xe0, 0x85, 0x24, 0xde, 0xa6, 0xe8, 0xd5, 0x4e, 0x82, 0x1a, 0xf0, 0x23, 0xe4,
+	0xf9, 0xbe, 0x1b, 0x96, 0x1e, 0x69, 0x9f, 0x95, 0x60, 0x29, 0xb3, 0x5b, 0x70, 0xbb, 0x06, 0x33,
+	0x4e, 0xa7, 0xb5, 0x83, 0x3d, 0x1a, 0x66, 0x62, 0x66, 0x8a, 0x30, 0x3e, 0x87, 0xf5, 0x32, 0x6f,
+	0x7f, 0xd4, 0x60, 0xd6, 0x87, 0x50, 0x61, 0x07, 0x66, 0x8d, 0xb0, 0xe8, 0xc1, 0xb0, 0x3e, 0x26,
+	0xec, 0x1a, 0x41, 0x1f, 0xc0, 0xa4, 0x58, 0x09, 0x3e, 0x55, 0x6e, 0xe0, 0x5e, 0xc9, 0xd3, 0x07,
+	0x1e, 0xcf, 0x61, 0x53, 0x67, 0xfe, 0xdd, 0x84, 0x15, 0x35, 0xa0, 0x6b, 0xb0, 0xc8, 0x07, 0xaa,
+	0xbb, 0x8e, 0xef, 0xb9, 0xcd, 0x26, 0xf6, 0x98, 0x50, 0x3a, 0x44, 0xd4, 0xae, 0xcf, 0xb3, 0xee,
+	0x8d, 0xb0, 0x97, 0x5b, 0x46, 0xb6, 0x47, 0x2c, 0xcb, 0xc3, 0x84, 0x88, 0xa0, 0x63, 0xf0, 0x13,
+	0x7d, 0x07, 0x50, 0x9b, 0xef, 0x28, 0x5e, 0xb0, 0x47, 0xa9, 0x91, 0xca, 0x48, 0x56, 0x51, 0x65,
+	0x37, 0x8f, 0x62, 0x27, 0xd2, 0x72, 0x3e, 0x3a, 0x0c, 0xd1, 0x67, 0xda, 0x5d, 0x2d, 0x5a, 0x15,
+	0x66, 0x79, 0xe2, 0x8b, 0x72, 0x15, 0xa8, 0x66, 0xfc, 0x10, 0x50, 0x12, 0x87, 0x80, 0x36, 0x07,
+	0x28, 0x0e, 0x2f, 0x74, 0xfd, 0xbf, 0x14, 0x98, 0xe5, 0xee, 0x7f, 0xdc, 0xcf, 0xcc, 0x27, 0x83,
+	0x6e, 0x89, 0x24, 0x71, 0x98, 0x13, 0x2f, 0xaf, 0xaf, 0xe4, 0x66, 0x20, 0x4c, 0xb2, 0xcf, 0x02,
+	0x6f, 0x63, 0xbe, 0xf8, 0x2b, 0x1e, 0xbe, 0x1d, 0x4a, 0x84, 0x6f, 0x37, 0x60, 0xfa, 0xc0, 0x26,
+	0xf6, 0x8e, 0xdd, 0xb4, 0xfd, 0x23, 0x6e, 0xea, 0x7a, 0x47, 0x1c, 0xcb, 0x11, 0x0a, 0x6d, 0xa4,
+	0x76, 0x5f, 0x9c, 0x91, 0xfc, 0x8d, 0x00, 0x5f, 0x90, 0x09, 0xd1, 0x46, 0x1f, 0x09, 0x50, 0x31,
+	0xc4, 0xe7, 0x1b, 0xdd, 0x98, 0x67, 0x75, 0x4c, 0xb0, 0xff, 0xa4, 0x83, 0x3b, 0xb8, 0x80, 0x18,
+	0xba, 0x47, 0x2a, 0xa5, 0x46, 0x4a, 0x4a, 0x6a, 0xa8, 0x5f, 0x49, 0x71, 0x46, 0x23, 0x8e, 0x04,
+	0xa3, 0x7f, 0xa4, 0xc0, 0x5c, 0xb0, 0xb1, 0xbe, 0x3a, 0xbc, 0x3e, 0x82, 0xf9, 0x2e, 0xa6, 0xc4,
+	0x3e, 0xbf, 0x06, 0x8b, 0x6d, 0xcf, 0xad, 0x63, 0x42, 0x68, 0xf5, 0x2f, 0x7b, 0xf0, 0xc7, 0x2d,
+	0x0d, 0xdd, 0xee, 0x43, 0x74, 0x53, 0x45, 0xdd, 0x0c, 0x93, 0x99, 0x19, 0xa2, 0xbd, 0x05, 0xcb,
+	0xf7, 0xb1, 0xaf, 0x47, 0x8f, 0xff, 0x6e, 0xd7, 0xf7, 0x79, 0x57, 0x01, 0x4d, 0x6f, 0xc1, 0xe9,
+	0x5c, 0xe4, 0x30, 0x26, 0x0f, 0x66, 0x7d, 0x3f, 0xce, 0x4a, 0xea, 0x29, 0x4f, 0x34, 0xe1, 0x0c,
+	0x4a, 0xfa, 0xb8, 0x19, 0xd0, 0xd4, 0xbe, 0x50, 0xe0, 0x54, 0x72, 0xbc, 0x87, 0x98, 0x10, 0x73,
+	0x37, 0xe2, 0xf5, 0x3d, 0x18, 0x61, 0xe9, 0xac, 0x60, 0xa4, 0xb5, 0x02, 0x23, 0xb1, 0x64, 0x97,
+	0x2e, 0xf0, 0x8a, 0x2c, 0xe1, 0x33, 0x58, 0x20, 0x9d, 0x76, 0xdb, 0x65, 0x0e, 0x07, 0xbd, 0x11,
+	0x78, 0x54, 0xac, 0xae, 0xc3, 0x7d, 0xe7, 0x72, 0xbe, 0x55, 0xdc, 0x88, 0x60, 0xd9, 0xb2, 0xce,
+	0x87, 0x64, 0x62, 0x3d, 0x84, 0x3e, 0x4b, 0x5a, 0xce, 0x9b, 0xa6, 0x90, 0xea, 0x73, 0x28, 0xf3,
+	0x35, 0x69, 0x89, 0x1e, 0x31, 0xdf, 0x0f, 0x72, 0xc3, 0xbd, 0x72, 0x82, 0x55, 0x66, 0xab, 0x82,
+	0x56, 0x1e, 0xda, 0x9d, 0x22, 0xf1, 0x36, 0xb5, 0x05, 0x28, 0x0d, 0x14, 0x0f, 0xdf, 0x0e, 0xf3,
+	0xf0, 0xed, 0xed, 0x64, 0xf8, 0xb6, 0xc8, 0x5a, 0x87, 0xdc, 0xc4, 0x62, 0xb7, 0xff, 0xac, 0xc0,
+	0xca, 0x7d, 0xec, 0x6f, 0x3e, 0x78, 0x22, 0x59, 0xee, 0x0f, 0x00, 0xb8, 0x8d, 0x73, 0x1a, 0x6e,
+	0x3f, 0xca, 0x45, 0x37, 0x16, 0x3b, 0x97, 0xc6, 0x7d, 0xf1, 0x17, 0x91, 0xac, 0x6a, 0xe9, 0x67,
+	0xb2, 0xaa, 0x47, 0xb0, 0x2a, 0x99, 0x8f, 0x58, 0xd7, 0x6d, 0x98, 0x8d, 0xbd, 0xc3, 0x65, 0xe9,
+	0xe1, 0x60, 0x5e, 0xaf, 0x14, 0x9c, 0x97, 0x3e, 0xe3, 0x25, 0x1b, 0x88, 0xf6, 0xaf, 0x0a, 0xcc,
+	0xe9, 0xd8, 0x6c, 0xb7, 0x9b, 0xfc, 0x1e, 0x4b, 0x7a, 0xbd, 0x74, 0x93, 0xbe, 0xb0, 0xc9, 0xf6,
+	0xbb, 0x86, 0x5e, 0xf6, 0x8e, 0x31, 0xd8, 0x85, 0x51, 0x5b, 0x84, 0xf9, 0xae, 0xa9, 0x09, 0xfb,
+	0xfd, 0xd7, 0x0a, 0x2d, 0xb7, 0x6e, 0x78, 0x98, 0x84, 0x8f, 0xcd, 0x98, 0x34, 0xbe, 0x82, 0x73,
+	0xa7, 0xd1, 0x9c, 0x6c, 0x56, 0xc5, 0x5c, 0x3e, 0x2d, 0xc1, 0x82, 0x8e, 0x4d, 0x6b, 0xf3, 0xc1,
+	0x93, 0xee, 0x2d, 0x70, 0x05, 0x8e, 0x85, 0x25, 0x21, 0xe5, 0xf5, 0xd3, 0xb9, 0xce, 0xce, 0x83,
+	0x27, 0x4c, 0x39, 0x19, 0xb0, 0xec, 0x06, 0x9b, 0xbe, 0x03, 0x0f, 0x65, 0xdd, 0x81, 0xb7, 0xa1,
+	0x62, 0x3b, 0x14, 0xc2, 0x3e, 0xc0, 0x06, 0x76, 0x42, 0x43, 0x54, 0xb0, 0x8e, 0x6e, 0x3e, 0x44,
+	0xbe, 0xeb, 0x04, 0x16, 0xa5, 0x66, 0x51, 0xd9, 0xb7, 0x29, 0x11, 0xf6, 0x90, 0x63, 0x98, 0x31,
+	0x36, 0x46, 0x1b, 0xd8, 0x2b, 0x8e, 0xf3, 0x30, 0xcd, 0xaa, 0x41, 0x18, 0x04, 0x2f, 0x5a, 0x18,
+	0x61, 0x45, 0x0b, 0xac, 0x48, 0xe4, 0xb1, 0xb9, 0x8b, 0x79, 0x0d, 0xe3, 0xdf, 0x96, 0x60, 0x31,
+	0x25, 0xac, 0xd0, 0x77, 0x1f, 0x40, 0x5a, 0x99, 0x9b, 0xb2, 0xf4, 0x92, 0x9b, 0x12, 0x99, 0xb0,
+	0x90, 0xa2, 0x1a, 0x84, 0x4f, 0xfb, 0xb6, 0x63, 0x73, 0xdd, 0xe4, 0x69, 0x6b, 0x96, 0xc4, 0x8e,
+	0x65, 0x49, 0xec, 0xdf, 0x69, 0xb1, 0x6b, 0xc7, 0xdb, 0xc5, 0x5f, 0x73, 0xfd, 0xd2, 0x54, 0xa8,
+	0xa4, 0xe7, 0x29, 0xf6, 0xd8, 0xdf, 0x94, 0x60, 0xf1, 0x21, 0xfe, 0xfa, 0x0b, 0xe1, 0x67, 0xb3,
+	0xc9, 0xee, 0x40, 0xe5, 0x21, 0xce, 0x96, 0x64, 0x16, 0x0d, 0x25, 0x8b, 0xc6, 0xaf, 0x2b, 0x70,
+	0xf2, 0x43, 0xd7, 0xb7, 0x1b, 0x47, 0x34, 0x54, 0xe1, 0x1e, 0x60, 0xef, 0xa1, 0x49, 0xe3, 0x10,
+	0xa1, 0xd8, 0x4d, 0x58, 0x68, 0x88, 0x1e, 0xa3, 0xc5, 0xba, 0x8c, 0x84, 0x77, 0x97, 0xbb, 0x45,
+	0x92, 0xf4, 0xd8, 0x68, 0xfa, 0x5c, 0x23, 0xdd, 0x48, 0xb4, 0xd3, 0x70, 0x2a, 0x87, 0x05, 0xa1,
+	0x16, 0x26, 0x2c, 0xdd, 0xc7, 0xfe, 0x86, 0xe7, 0x12, 0x22, 0x96, 0x25, 0x71, 0x8a, 0x24, 0x2e,
+	0xcd, 0x4a, 0xd7, 0xa5, 0xf9, 0x1c, 0x94, 0x7d, 0xd3, 0xdb, 0xc5, 0x7e, 0xb8, 0xcc, 0xfc, 0x3c,
+	0x99, 0xe2, 0xad, 0x82, 0x9e, 0xf6, 0xbf, 0x43, 0x70, 0x32, 0x7b, 0x0c, 0x21, 0xd0, 0x16, 0x94,
+	0xb9, 0x79, 0xd8, 0x39, 0xe2, 0x57, 0xf8, 0x8a, 0xd2, 0xa3, 0x1a, 0x4a, 0x46, 0x8e, 0x5d, 0x2b,
+	0xc8, 0x9d, 0x23, 0xe6, 0xcd, 0x71, 0x57, 0x6f, 0xd2, 0x8f, 0x35, 0xa1, 0x4f, 0x14, 0x98, 0x6f,
+	0xb0, 0x7c, 0xa1, 0x51, 0x37, 0x3b, 0x04, 0x47, 0xc3, 0x72, 0xa3, 0xf7, 0x70, 0xb0, 0x61, 0x79,
+	0x0a, 0x72, 0x83, 0x52, 0x4c, 0x0c, 0x8e, 0x1a, 0xa9, 0x0e, 0xf5, 0x39, 0xcc, 0xa6, 0xb8, 0xcc,
+	0xf0, 0x35, 0xef, 0x25, 0x7d, 0xcd, 0x4b, 0xb9, 0x2e, 0x5a, 0x17, 0x53, 0x62, 0xf5, 0xe2, 0x0e,
+	0xa7, 0xfa, 0x1c, 0x16, 0x73, 0x38, 0xcc, 0x18, 0xf8, 0xbd, 0xf8, 0xc0, 0xe5, 0xfc, 0x68, 0xf9,
+	0x7d, 0xec, 0x47, 0xd9, 0x57, 0x46, 0x38, 0xee, 0xe3, 0xfe, 0xa7, 0x02, 0x6b, 0x22, 0xdf, 0x99,
+	0x12, 0x5b, 0x2a, 0x51, 0x23, 0xb9, 0x76, 0x16, 0xd3, 0x33, 0xf4, 0x94, 0xab, 0x51, 0x58, 0x98,
+	0x12, 0xc4, 0xfa, 0xfb, 0x10, 0x1b, 0x47, 0xa4, 0x84, 0xa3, 0x5f, 0x04, 0x9d, 0x85, 0xa9, 0x06,
+	0xf6, 0xeb, 0x7b, 0x1f, 0x62, 0xee, 0xb7, 0x88, 0x04, 0x5d, 0xb2, 0x51, 0x23, 0xf0, 0xad, 0x02,
+	0x93, 0x0d, 0x4b, 0x5e, 0x87, 0x03, 0xe7, 0x77, 0xc0, 0x95, 0x65, 0xe8, 0xda, 0x55, 0xf6, 0xa6,
+	0x2e, 0xd8, 0xdc, 0xec, 0xac, 0x2c, 0x10, 0x5b, 0xd4, 0x7c, 0x58, 0x4c, 0xa1, 0x09, 0xce, 0xd6,
+	0x61, 0x3e, 0xca, 0x4c, 0x05, 0x71, 0xac, 0xe0, 0x71, 0xea, 0xb0, 0x1e, 0xa5, 0xad, 0xb6, 0x78,
+	0x10, 0x8b, 0xbe, 0x51, 0x3d, 0x07, 0xe5, 0xe0, 0x5d, 0xae, 0x08, 0xc1, 0xf1, 0xf8, 0xda, 0x94,
+	0x68, 0x65, 0xa0, 0x44, 0xfb, 0x2e, 0x9c, 0xa5, 0x57, 0x04, 0xc6, 0x44, 0xec, 0x94, 0x7f, 0x6a,
+	0xfa, 0xd8, 0xa3, 0xf6, 0xaf, 0x50, 0x58, 0x54, 0x16, 0xc6, 0xd3, 0x3e, 0x51, 0xe0, 0x5c, 0x8f,
+	0x21, 0xc4, 0x34, 0x9f, 0x82, 0xca, 0xa2, 0xc6, 0x81, 0x63, 0x11, 0x56, 0xeb, 0xb2, 0x80, 0x91,
+	0xd2, 0x33, 0x60, 0xb4, 0x48, 0xb1, 0xf5, 0x10, 0x99, 0x39, 0xe7, 0xb4, 0x57, 0xfb, 0x53, 0x85,
+	0x97, 0xf2, 0x39, 0x75, 0xbb, 0x89, 0xa3, 0x67, 0xa3, 0x3e, 0x76, 0x0a, 0x67, 0xb7, 0x7f, 0x4e,
+	0x91, 0xdf, 0xb3, 0xa0, 0xc9, 0x18, 0xe3, 0x82, 0x59, 0xff, 0xc9, 0x65, 0x00, 0x11, 0x68, 0xbd,
+	0xfd, 0xb8, 0x86, 0xbe, 0x47, 0xd3, 0x49, 0x99, 0x1f, 0x81, 0x40, 0xd7, 0xf2, 0xbf, 0xe3, 0x21,
+	0xfb, 0x0c, 0x85, 0x7a, 0xbd, 0x6f, 0x3c, 0xb1, 0x66, 0xbf, 0xa3, 0xc0, 0x62, 0xce, 0xf7, 0x57,
+	0xd0, 0xf5, 0x1e, 0x1f, 0x15, 0xc9, 0xe5, 0xe6, 0x46, 0xff, 0x88, 0x82, 0x9d, 0x3f, 0x57, 0xe0,
+	0xa4, 0xec, 0x93, 0x1f, 0xe8, 0xed, 0x22, 0x1f, 0x3a, 0xc9, 0x65, 0xec, 0xd6, 0x80, 0xd8, 0x82,
+	0xbb, 0x1f, 0x2b, 0xb0, 0xd2, 0xeb, 0x3b, 0x1e, 0xe8, 0xbd, 0x5e, 0x93, 0xef, 0xf5, 0x4d, 0x11,
+	0xf5, 0xf6, 0x4b, 0x50, 0x10, 0x9c, 0x52, 0x15, 0xcb, 0xfe, 0x42, 0x87, 0x44, 0xc5, 0xa4, 0x5f,
+	0x06, 0x51, 0xaf, 0xf7, 0x8d, 0x27, 0x78, 0xf9, 0x63, 0x05, 0xd4, 0xfc, 0xef, 0x58, 0xa0, 0xfc,
+	0x22, 0xc7, 0x9e, 0xdf, 0xf7, 0x50, 0xdf, 0x1a, 0x08, 0x57, 0xf0, 0xf5, 0x87, 0x0a, 0x9c, 0xc8,
+	0xfd, 0x4a, 0x05, 0x7a, 0x33, 0x97, 0x74, 0xaf, 0x8f, 0x64, 0xa8, 0x37, 0x07, 0x41, 0x15, 0x4c,
+	0x39, 0x30, 0x95, 0x78, 0x1c, 0x8f, 0x5e, 0xcf, 0x25, 0x96, 0xf5, 0x06, 0x5f, 0xad, 0x16, 0x05,
+	0x17, 0xe3, 0x7d, 0xa2, 0xc0, 0xf1, 0x8c, 0x17, 0xe6, 0xe8, 0x8a, 0x7c, 0xb5, 0x33, 0xdf, 0xb4,
+	0xab, 0x6f, 0xf4, 0x87, 0x24, 0x58, 0xf0, 0x61, 0xba, 0xeb, 0x35, 0x37, 0xba, 0x28, 0x73, 0x17,
+	0x33, 0xb2, 0x7e, 0xea, 0xa5, 0xe2, 0x08, 0x62, 0xd4, 0x43, 0x98, 0xe9, 0x7e, 0xb5, 0x88, 0xf2,
+	0xa9, 0xe4, 0xbc, 0xeb, 0x54, 0x2f, 0xf7, 0x81, 0x11, 0x53, 0xbb, 0xdc, 0xf2, 0x5d, 0x89, 0xda,
+	0xf5, 0x7a, 0x39, 0xa5, 0xbe, 0x44, 0xb5, 0x30, 0xb3, 0xbb, 0xb2, 0xea, 0x5e, 0x89, 0xdd, 0x2d,
+	0x50, 0x31, 0xad, 0xde, 0x1a, 0x10, 0x3b, 0x21, 0xb2, 0x9c, 0x12, 0x58, 0xa9, 0xc8, 0xe4, 0x05,
+	0xb8, 0xea, 0xcd, 0x41, 0x50, 0x53, 0xeb, 0x98, 0xf1, 0xbe, 0xa0, 0xe7, 0x3a, 0xe6, 0xbf, 0xec,
+	0x50, 0x6f, 0x0e, 0x82, 0x9a, 0x5e, 0xc7, 0xcc, 0x2a, 0xd4, 0xde, 0xeb, 0x28, 0xab, 0x84, 0x55,
+	0x6f, 0x0d, 0x88, 0x9d, 0x5e, 0xc7, 0x74, 0xa1, 0x69, 0xef, 0x75, 0xcc, 0x2d, 0x73, 0x55, 0x6f,
+	0x0e, 0x82, 0x2a, 0x98, 0xfa, 0x33, 0x16, 0xf4, 0xcd, 0xad, 0x20, 0x45, 0x6f, 0xf5, 0x35, 0xe7,
+	0x64, 0x0d, 0xab, 0xfa, 0xf6, 0x60, 0xc8, 0x09, 0xd6, 0x72, 0xcb, 0xa7, 0xa5, 0xac, 0xf5, 0x2a,
+	0xe0, 0x56, 0xdf, 0x1e, 0x0c, 0x59, 0xb0, 0xf6, 0x17, 0x0a, 0x2c, 0x0b, 0x4a, 0x39, 0x75, 0x93,
+	0xe8, 0x1d, 0xc9, 0x00, 0x05, 0x8a, 0x47, 0xd5, 0x77, 0x07, 0xc6, 0x17, 0x3c, 0xfe, 0x9e, 0x02,
+	0x15, 0x9e, 0x4e, 0x4e, 0x57, 0xcf, 0xa2, 0x1b, 0x12, 0xea, 0xd2, 0x32, 0x61, 0xf5, 0xcd, 0x01,
+	0x30, 0x05, 0x47, 0xbf, 0xa9, 0xc0, 0x5c, 0x56, 0x0d, 0x26, 0xca, 0x3f, 0x39, 0x25, 0x15, 0xa7,
+	0xea, 0xd5, 0x3e, 0xb1, 0x04, 0x17, 0x3f, 0x64, 0x9f, 0x92, 0x93, 0x94, 0x20, 0xa2, 0x5b, 0x3d,
+	0x74, 0x43, 0x5e, 0x20, 0xaa, 0xbe, 0x33, 0x28, 0xba, 0x60, 0xf0, 0x63, 0x98, 0x0d, 0xaf, 0x81,
+	0x41, 0x31, 0x1e, 0xba, 0x2c, 0x21, 0x9a, 0x5d, 0x24, 0xa9, 0xae, 0xf7, 0x83, 0x12, 0x79, 0x23,
+	0x5d, 0xe5, 0x75, 0x12, 0x6f, 0x24, 0xbb, 0x28, 0x50, 0xbd, 0x54, 0x1c, 0x41, 0x8c, 0xba, 0x0f,
+	0x93, 0xf1, 0x6a, 0x27, 0xf4, 0x9a, 0x94, 0x42, 0x57, 0x7d, 0x9f, 0xfa, 0x7a, 0x41, 0xe8, 0x98,
+	0x16, 0x66, 0x95, 0x2b, 0x49, 0xb4, 0x50, 0x52, 0x71, 0xa5, 0x5e, 0xed, 0x13, 0x2b, 0xe6, 0x79,
+	0x66, 0x54, 0x21, 0x49, 0x3c, 0xcf, 0xfc, 0x92, 0x26, 0xf5, 0x8d, 0xfe, 0x90, 0xc2, 0x97, 0x57,
+	0x10, 0x55, 0xdd, 0xa0, 0x0b, 0xb9, 0x34, 0x52, 0xa5, 0x3c, 0xea, 0xab, 0x85, 0x60, 0xa3, 0x61,
+	0xa2, 0xaa, 0x16, 0xc9, 0x30, 0xa9, 0x52, 0x1f, 0xf5, 0xd5, 0x42, 0xb0, 0xf1, 0x61, 0x82, 0x9a,
+	0x14, 0xe9, 0x30, 0x5d, 0xa5, 0x34, 0xea, 0xab, 0x85, 0x60, 0xa3, 0x1b, 0x4a, 0xa2, 0x9c, 0x44,
+	0x72, 0x43, 0xc9, 0xaa, 0x85, 0x51, 0xab, 0x45, 0xc1, 0x63, 0x11, 0x8a, 0x9c, 0x8a, 0x11, 0x49,
+	0x84, 0x42, 0x5e, 0xa0, 0xa2, 0xde, 0xe8, 0x1f, 0x31, 0x76, 0xb3, 0xce, 0x2e, 0x8c, 0x90, 0xdc,
+	0xac, 0xa5, 0x15, 0x28, 0xea, 0xf5, 0x01, 0x2b, 0x30, 0x98, 0x3f, 0x95, 0x5b, 0x20, 0x20, 0xf1,
+	0xa7, 0x7a, 0x15, 0x49, 0xa8, 0x37, 0x07, 0x41, 0x8d, 0xf4, 0x23, 0x91, 0x5d, 0x97, 0xe8, 0x47,
+	0x56, 0x81, 0x81, 0x5a, 0x2d, 0x0a, 0x1e, 0xb3, 0x66, 0x59, 0x99, 0x70, 0x24, 0xbb, 0x8d, 0xe6,
+	0xe6, 0xf8, 0xd5, 0xab, 0x7d, 0x62, 0x45, 0xc7, 0x46, 0x57, 0x02, 0x59, 0x72, 0x6c, 0x64, 0xe7,
+	0xe5, 0xd5, 0x4b, 0xc5, 0x11, 0x62, 0x97, 0xd8, 0xae, 0xe4, 0xa4, 0xec, 0x12, 0x9b, 0x9d, 0xaf,
+	0x55, 0x2f, 0xf7, 0x81, 0x11, 0x0d, 0xfc, 0x10, 0x17, 0x1e, 0xf8, 0x21, 0xee, 0x77, 0xe0, 0xdc,
+	0x44, 0xe1, 0x6f, 0x2b, 0x30, 0x9f, 0x99, 0x7d, 0x43, 0xf9, 0x0b, 0x27, 0x4b, 0x18, 0xaa, 0xd7,
+	0xfa, 0x45, 0x8b, 0xa9, 0x5d, 0x56, 0xee, 0x4a, 0xa2, 0x76, 0x92, 0xa4, 0xa0, 0x7a, 0xb5, 0x4f,
+	0x2c, 0xc1, 0xc5, 0xa7, 0x4a, 0xf8, 0x74, 0x2f, 0x3f, 0x43, 0x82, 0x6e, 0xf7, 0xba, 0x85, 0xf4,
+	0x4c, 0x25, 0xa9, 0x77, 0x5e, 0x86, 0x44, 0x22, 0xd0, 0x13, 0xcf, 0x90, 0xc8, 0x03, 0x3d, 0x19,
+	0x29, 0x18, 0xf5, 0x52, 0x71, 0x84, 0x98, 0xb7, 0x2b, 0xcd, 0x5f, 0x48, 0xbc, 0xdd, 0x22, 0xa9,
+	0x15, 0xf5, 0x9d, 0x41, 0xd1, 0x63, 0xf1, 0xd1, 0xfc, 0x24, 0x02, 0x92, 0x87, 0x03, 0xa4, 0x29,
+	0x11, 0xf5, 0xad, 0x81, 0x70, 0x39, 0x5f, 0x77, 0xee, 0xfe, 0xe3, 0xe7, 0xcb, 0xca, 0xbf, 0x7c,
+	0xbe, 0xac, 0x7c, 0xf6, 0xf9, 0xb2, 0xf2, 0x2b, 0xd7, 0x77, 0x6d, 0x7f, 0xaf, 0xb3, 0x53, 0xad,
+	0xbb, 0xad, 0x8b, 0x89, 0x7f, 0xf2, 0x50, 0xdd, 0xc5, 0x0e, 0xff, 0x6f, 0x1d, 0xb1, 0x7f, 0x17,
+	0xf2, 0x96, 0xf8, 0xf3, 0xe0, 0xf2, 0xce, 0x08, 0xeb, 0xbb, 0xf2, 0x7f, 0x03, 0x00, 0x9d, 0x2e,
+	0xf4, 0x4c, 0x5a, 0x64, 0x00, 0x00,
 }
 
 func (m *StartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
@@ -5827,7 +6513,7 @@ func (m *SignalWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignalBatchWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5837,12 +6523,12 @@ func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalBatchWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalBatchWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5873,7 +6559,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+func (m *SignalBatchRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5883,12 +6569,12 @@ func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalBatchRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5897,17 +6583,57 @@ func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []b
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signals) > 0 {
+		for iNdEx := len(m.Signals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintService(dAtA, i, uint64(len(m.Domain)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+func (m *SignalBatchItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5917,12 +6643,12 @@ func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SignalBatchItem) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SignalBatchItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5931,11 +6657,164 @@ func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
+	if m.Input != nil {
+		{
+			size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SignalName) > 0 {
+		i -= len(m.SignalName)
+		copy(dAtA[i:], m.SignalName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.SignalName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalBatchWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalBatchWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalBatchWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SignalWithStartWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignalWithStartWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetWorkflowExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetWorkflowExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetWorkflowExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if m.Request != nil {
@@ -6152,6 +7031,18 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.HistoryLimitWarning != nil {
+		{
+			size, err := m.HistoryLimitWarning.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.PendingDecision != nil {
 		{
 			size, err := m.PendingDecision.MarshalToSizedBuffer(dAtA[:i])
@@ -6219,6 +7110,71 @@ func (m *DescribeWorkflowExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *HistoryLimitWarning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryLimitWarning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryLimitWarning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EstimatedSecondsToLimit != nil {
+		{
+			size, err := m.EstimatedSecondsToLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EventsPerHour != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EventsPerHour))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.HistorySizeLimit != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistorySizeLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HistoryCountLimit != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistoryCountLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HistorySize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HistoryCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.HistoryCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryWorkflowRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8603,6 +9559,20 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.PayloadSizeStats) > 0 {
+		for iNdEx := len(m.PayloadSizeStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayloadSizeStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -8630,21 +9600,21 @@ func (m *DescribeHistoryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA82 := make([]byte, len(m.ShardIds)*10)
-		var j81 int
+		dAtA87 := make([]byte, len(m.ShardIds)*10)
+		var j86 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA82[j81] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA87[j86] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j81++
+				j86++
 			}
-			dAtA82[j81] = uint8(num)
-			j81++
+			dAtA87[j86] = uint8(num)
+			j86++
 		}
-		i -= j81
-		copy(dAtA[i:], dAtA82[:j81])
-		i = encodeVarintService(dAtA, i, uint64(j81))
+		i -= j86
+		copy(dAtA[i:], dAtA87[:j86])
+		i = encodeVarintService(dAtA, i, uint64(j86))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -8954,7 +9924,7 @@ func (m *DescribeQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationAckStatesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8964,12 +9934,12 @@ func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationAckStatesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationAckStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8978,31 +9948,15 @@ func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ClusterName) > 0 {
-		i -= len(m.ClusterName)
-		copy(dAtA[i:], m.ClusterName)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+	if m.ShardId != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ShardId))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintService(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+func (m *GetReplicationAckStatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9012,12 +9966,12 @@ func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetReplicationAckStatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetReplicationAckStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9026,17 +9980,124 @@ func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ShardMessages) > 0 {
-		for k := range m.ShardMessages {
-			v := m.ShardMessages[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
-						return 0, err
-					}
-					i -= size
+	if len(m.AckStates) > 0 {
+		for iNdEx := len(m.AckStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AckStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationMessagesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SupportedCompressions) > 0 {
+		dAtA90 := make([]byte, len(m.SupportedCompressions)*10)
+		var j89 int
+		for _, num := range m.SupportedCompressions {
+			for num >= 1<<7 {
+				dAtA90[j89] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j89++
+			}
+			dAtA90[j89] = uint8(num)
+			j89++
+		}
+		i -= j89
+		copy(dAtA[i:], dAtA90[:j89])
+		i = encodeVarintService(dAtA, i, uint64(j89))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintService(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetReplicationMessagesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetReplicationMessagesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetReplicationMessagesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ShardMessages) > 0 {
+		for k := range m.ShardMessages {
+			v := m.ShardMessages[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
 					i = encodeVarintService(dAtA, i, uint64(size))
 				}
 				i--
@@ -9077,6 +10138,24 @@ func (m *GetDLQReplicationMessagesRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SupportedCompressions) > 0 {
+		dAtA93 := make([]byte, len(m.SupportedCompressions)*10)
+		var j92 int
+		for _, num := range m.SupportedCompressions {
+			for num >= 1<<7 {
+				dAtA93[j92] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j92++
+			}
+			dAtA93[j92] = uint8(num)
+			j92++
+		}
+		i -= j92
+		copy(dAtA[i:], dAtA93[:j92])
+		i = encodeVarintService(dAtA, i, uint64(j92))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.TaskInfos) > 0 {
 		for iNdEx := len(m.TaskInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -9727,21 +10806,21 @@ func (m *GetCrossClusterTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA92 := make([]byte, len(m.ShardIds)*10)
-		var j91 int
+		dAtA101 := make([]byte, len(m.ShardIds)*10)
+		var j100 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA92[j91] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA101[j100] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j91++
+				j100++
 			}
-			dAtA92[j91] = uint8(num)
-			j91++
+			dAtA101[j100] = uint8(num)
+			j100++
 		}
-		i -= j91
-		copy(dAtA[i:], dAtA92[:j91])
-		i = encodeVarintService(dAtA, i, uint64(j91))
+		i -= j100
+		copy(dAtA[i:], dAtA101[:j100])
+		i = encodeVarintService(dAtA, i, uint64(j100))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -9975,21 +11054,21 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.PendingShards) > 0 {
-		dAtA96 := make([]byte, len(m.PendingShards)*10)
-		var j95 int
+		dAtA105 := make([]byte, len(m.PendingShards)*10)
+		var j104 int
 		for _, num1 := range m.PendingShards {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA96[j95] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA105[j104] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j95++
+				j104++
 			}
-			dAtA96[j95] = uint8(num)
-			j95++
+			dAtA105[j104] = uint8(num)
+			j104++
 		}
-		i -= j95
-		copy(dAtA[i:], dAtA96[:j95])
-		i = encodeVarintService(dAtA, i, uint64(j95))
+		i -= j104
+		copy(dAtA[i:], dAtA105[:j104])
+		i = encodeVarintService(dAtA, i, uint64(j104))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -10001,6 +11080,171 @@ func (m *GetFailoverInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GetDomainReplicationWatermarkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDomainReplicationWatermarkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDomainReplicationWatermarkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ShardIds) > 0 {
+		dAtA107 := make([]byte, len(m.ShardIds)*10)
+		var j106 int
+		for _, num1 := range m.ShardIds {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA107[j106] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j106++
+			}
+			dAtA107[j106] = uint8(num)
+			j106++
+		}
+		i -= j106
+		copy(dAtA[i:], dAtA107[:j106])
+		i = encodeVarintService(dAtA, i, uint64(j106))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDomainReplicationWatermarkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDomainReplicationWatermarkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDomainReplicationWatermarkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.LastReplicatedEventTime != nil {
+		{
+			size, err := m.LastReplicatedEventTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileWorkflowRetentionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileWorkflowRetentionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileWorkflowRetentionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.WorkflowExecution != nil {
+		{
+			size, err := m.WorkflowExecution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReconcileWorkflowRetentionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReconcileWorkflowRetentionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReconcileWorkflowRetentionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -10113,7 +11357,7 @@ func (m *SignalWorkflowExecutionResponse) Size() (n int) {
 	return n
 }
 
-func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
+func (m *SignalBatchWorkflowExecutionRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10133,13 +11377,99 @@ func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
 	return n
 }
 
-func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
+func (m *SignalBatchRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RunId)
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.Signals) > 0 {
+		for _, e := range m.Signals {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalBatchItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SignalName)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Input != nil {
+		l = m.Input.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalBatchWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalWithStartWorkflowExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SignalWithStartWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RunId)
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
@@ -10274,6 +11604,41 @@ func (m *DescribeWorkflowExecutionResponse) Size() (n int) {
 		l = m.PendingDecision.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.HistoryLimitWarning != nil {
+		l = m.HistoryLimitWarning.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistoryLimitWarning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HistoryCount != 0 {
+		n += 1 + sovService(uint64(m.HistoryCount))
+	}
+	if m.HistorySize != 0 {
+		n += 1 + sovService(uint64(m.HistorySize))
+	}
+	if m.HistoryCountLimit != 0 {
+		n += 1 + sovService(uint64(m.HistoryCountLimit))
+	}
+	if m.HistorySizeLimit != 0 {
+		n += 1 + sovService(uint64(m.HistorySizeLimit))
+	}
+	if m.EventsPerHour != 0 {
+		n += 9
+	}
+	if m.EstimatedSecondsToLimit != nil {
+		l = m.EstimatedSecondsToLimit.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11324,6 +12689,12 @@ func (m *DescribeHistoryHostResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.PayloadSizeStats) > 0 {
+		for _, e := range m.PayloadSizeStats {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11472,6 +12843,39 @@ func (m *DescribeQueueResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetReplicationAckStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardId != 0 {
+		n += 1 + sovService(uint64(m.ShardId))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetReplicationAckStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AckStates) > 0 {
+		for _, e := range m.AckStates {
+			l = e.Size()
+			n += 1 + l + sovService(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *GetReplicationMessagesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -11488,6 +12892,13 @@ func (m *GetReplicationMessagesRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if len(m.SupportedCompressions) > 0 {
+		l = 0
+		for _, e := range m.SupportedCompressions {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11531,6 +12942,13 @@ func (m *GetDLQReplicationMessagesRequest) Size() (n int) {
 			n += 1 + l + sovService(uint64(l))
 		}
 	}
+	if len(m.SupportedCompressions) > 0 {
+		l = 0
+		for _, e := range m.SupportedCompressions {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -11950,6 +13368,77 @@ func (m *GetFailoverInfoResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetDomainReplicationWatermarkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if len(m.ShardIds) > 0 {
+		l = 0
+		for _, e := range m.ShardIds {
+			l += sovService(uint64(e))
+		}
+		n += 1 + sovService(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetDomainReplicationWatermarkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastReplicatedEventTime != nil {
+		l = m.LastReplicatedEventTime.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReconcileWorkflowRetentionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.WorkflowExecution != nil {
+		l = m.WorkflowExecution.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ReconcileWorkflowRetentionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovService(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -12602,7 +14091,7 @@ func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SignalBatchWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12625,10 +14114,10 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalBatchWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalBatchWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12661,7 +14150,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.SignalWithStartWorkflowExecutionRequest{}
+				m.Request = &SignalBatchRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -12721,7 +14210,7 @@ func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *SignalBatchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12744,15 +14233,15 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalBatchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12780,62 +14269,47 @@ func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12862,16 +14336,14 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.ResetWorkflowExecutionRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Signals = append(m.Signals, &SignalBatchItem{})
+			if err := m.Signals[len(m.Signals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12899,62 +14371,11 @@ func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12982,7 +14403,7 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RunId = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13006,7 +14427,7 @@ func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SignalBatchItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13029,51 +14450,15 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalBatchItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalBatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Request == nil {
-				m.Request = &v1.TerminateWorkflowExecutionRequest{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13101,11 +14486,11 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.SignalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13132,33 +14517,13 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExternalWorkflowExecution == nil {
-				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
+			if m.Input == nil {
+				m.Input = &v1.Payload{}
 			}
-			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13181,7 +14546,7 @@ func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *SignalBatchWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13204,10 +14569,10 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalBatchWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalBatchWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13232,7 +14597,7 @@ func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
+func (m *SignalWithStartWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13255,10 +14620,10 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13291,7 +14656,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.DescribeWorkflowExecutionRequest{}
+				m.Request = &v1.SignalWithStartWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13351,7 +14716,7 @@ func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+func (m *SignalWithStartWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13374,17 +14739,17 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SignalWithStartWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13394,178 +14759,34 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExecutionConfiguration == nil {
-				m.ExecutionConfiguration = &v1.WorkflowExecutionConfiguration{}
-			}
-			if err := m.ExecutionConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecutionInfo == nil {
-				m.WorkflowExecutionInfo = &v1.WorkflowExecutionInfo{}
-			}
-			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingActivities = append(m.PendingActivities, &v1.PendingActivityInfo{})
-			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PendingChildren = append(m.PendingChildren, &v1.PendingChildExecutionInfo{})
-			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingDecision", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PendingDecision == nil {
-				m.PendingDecision = &v1.PendingDecisionInfo{}
-			}
-			if err := m.PendingDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
@@ -13578,7 +14799,7 @@ func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13601,10 +14822,10 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13637,7 +14858,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.QueryWorkflowRequest{}
+				m.Request = &v1.ResetWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13697,7 +14918,7 @@ func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
+func (m *ResetWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13720,53 +14941,17 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResetWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.QueryResult == nil {
-				m.QueryResult = &v1.Payload{}
-			}
-			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -13776,27 +14961,23 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.QueryRejected == nil {
-				m.QueryRejected = &v1.QueryRejected{}
-			}
-			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13820,7 +15001,7 @@ func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13843,10 +15024,10 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13879,7 +15060,7 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Request == nil {
-				m.Request = &v1.ResetStickyTaskListRequest{}
+				m.Request = &v1.TerminateWorkflowExecutionRequest{}
 			}
 			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -13917,6 +15098,62 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalWorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExternalWorkflowExecution == nil {
+				m.ExternalWorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.ExternalWorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildWorkflowOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ChildWorkflowOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -13939,7 +15176,7 @@ func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
+func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13962,10 +15199,10 @@ func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResetStickyTaskListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResetStickyTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13990,7 +15227,7 @@ func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14013,17 +15250,17 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14033,44 +15270,12 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
 			postIndex := iNdEx + msglen
@@ -14080,37 +15285,18 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Request == nil {
+				m.Request = &v1.DescribeWorkflowExecutionRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
-			}
-			m.ExpectedNextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14120,25 +15306,23 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14162,7 +15346,7 @@ func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *DescribeWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14185,15 +15369,15 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DescribeWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionConfiguration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14220,16 +15404,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.ExecutionConfiguration == nil {
+				m.ExecutionConfiguration = &v1.WorkflowExecutionConfiguration{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExecutionConfiguration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14256,18 +15440,18 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
+			if m.WorkflowExecutionInfo == nil {
+				m.WorkflowExecutionInfo = &v1.WorkflowExecutionInfo{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecutionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActivities", wireType)
 			}
-			m.NextEventId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14277,14 +15461,29 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActivities = append(m.PendingActivities, &v1.PendingActivityInfo{})
+			if err := m.PendingActivities[len(m.PendingActivities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChildren", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14311,35 +15510,14 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
-			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingChildren = append(m.PendingChildren, &v1.PendingChildExecutionInfo{})
+			if err := m.PendingChildren[len(m.PendingChildren)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
-			}
-			m.LastFirstEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingDecision", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14366,16 +15544,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+			if m.PendingDecision == nil {
+				m.PendingDecision = &v1.PendingDecisionInfo{}
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingDecision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLimitWarning", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14402,18 +15580,69 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskList == nil {
-				m.StickyTaskList = &v1.TaskList{}
+			if m.HistoryLimitWarning == nil {
+				m.HistoryLimitWarning = &HistoryLimitWarning{}
 			}
-			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.HistoryLimitWarning.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoryLimitWarning) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryLimitWarning: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryLimitWarning: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryCount", wireType)
+			}
+			m.HistoryCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14423,29 +15652,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HistoryCount |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
 			}
-			var stringLen uint64
+			m.HistorySize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14455,29 +15671,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HistorySize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryCountLimit", wireType)
 			}
-			var stringLen uint64
+			m.HistoryCountLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14487,84 +15690,16 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.HistoryCountLimit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClientImpl = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StickyTaskListScheduleToStartTimeout == nil {
-				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
-			}
-			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
-			}
-			m.EventStoreVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySizeLimit", wireType)
 			}
-			var byteLen int
+			m.HistorySizeLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14574,67 +15709,25 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.HistorySizeLimit |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsPerHour", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
-			}
-			m.WorkflowState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowState |= v11.WorkflowState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
-			}
-			m.WorkflowCloseState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 16:
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EventsPerHour = float64(math.Float64frombits(v))
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedSecondsToLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14661,33 +15754,13 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v11.VersionHistories{}
+			if m.EstimatedSecondsToLimit == nil {
+				m.EstimatedSecondsToLimit = &types.Int64Value{}
 			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EstimatedSecondsToLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskListEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsStickyTaskListEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -14710,7 +15783,7 @@ func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14733,47 +15806,15 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14800,37 +15841,18 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.Request == nil {
+				m.Request = &v1.QueryWorkflowRequest{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
-			}
-			m.ExpectedNextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExpectedNextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -14840,25 +15862,23 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.CurrentBranchToken == nil {
-				m.CurrentBranchToken = []byte{}
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14882,7 +15902,7 @@ func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWorkflowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14905,15 +15925,15 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWorkflowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryResult", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14940,16 +15960,16 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.QueryResult == nil {
+				m.QueryResult = &v1.Payload{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryRejected", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14976,35 +15996,67 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
+			if m.QueryRejected == nil {
+				m.QueryRejected = &v1.QueryRejected{}
 			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QueryRejected.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.NextEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetStickyTaskListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetStickyTaskListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetStickyTaskListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15031,37 +16083,18 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
+			if m.Request == nil {
+				m.Request = &v1.ResetStickyTaskListRequest{}
 			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
-			}
-			m.LastFirstEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LastFirstEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15071,67 +16104,129 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
-			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetStickyTaskListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetStickyTaskListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetStickyTaskListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StickyTaskList == nil {
-				m.StickyTaskList = &v1.TaskList{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15159,13 +16254,13 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15175,61 +16270,33 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ClientImpl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
 			}
-			var msglen int
+			m.ExpectedNextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15239,29 +16306,12 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StickyTaskListScheduleToStartTimeout == nil {
-				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
-			}
-			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
@@ -15295,80 +16345,6 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 				m.CurrentBranchToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.VersionHistories == nil {
-				m.VersionHistories = &v11.VersionHistories{}
-			}
-			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
-			}
-			m.WorkflowState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowState |= v11.WorkflowState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
-			}
-			m.WorkflowCloseState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -15391,7 +16367,7 @@ func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *GetMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15414,17 +16390,17 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15434,27 +16410,31 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15481,18 +16461,18 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			m.ScheduleId = 0
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15502,16 +16482,16 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
 			}
-			m.TaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15521,16 +16501,52 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
+			}
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
+			}
+			m.LastFirstEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastFirstEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15540,27 +16556,31 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15587,69 +16607,18 @@ func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollForDecisionTaskRequest{}
+			if m.StickyTaskList == nil {
+				m.StickyTaskList = &v1.TaskList{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
-			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15659,33 +16628,29 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15695,33 +16660,29 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PreviousStartedEventId == nil {
-				m.PreviousStartedEventId = &types.Int64Value{}
-			}
-			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
 			}
-			m.ScheduledEventId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15731,35 +16692,29 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledEventId |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.StartedEventId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartedEventId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.NextEventId = 0
+			m.ClientImpl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15769,35 +16724,33 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextEventId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.Attempt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StickyTaskListScheduleToStartTimeout == nil {
+				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
+			}
+			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
 			}
-			var v int
+			m.EventStoreVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15807,17 +16760,16 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.EventStoreVersion |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StickyExecutionEnabled = bool(v != 0)
-		case 8:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15827,33 +16779,31 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DecisionInfo == nil {
-				m.DecisionInfo = &v11.TransientDecisionInfo{}
-			}
-			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
 			}
-			var msglen int
+			m.WorkflowState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15863,33 +16813,16 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WorkflowState |= v11.WorkflowState(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkflowExecutionTaskList == nil {
-				m.WorkflowExecutionTaskList = &v1.TaskList{}
-			}
-			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
 			}
-			m.EventStoreVersion = 0
+			m.WorkflowCloseState = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15899,16 +16832,16 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventStoreVersion |= int32(b&0x7F) << shift
+				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15918,31 +16851,33 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.BranchToken == nil {
-				m.BranchToken = []byte{}
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v11.VersionHistories{}
+			}
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStickyTaskListEnabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -15952,31 +16887,98 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
+			m.IsStickyTaskListEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTime == nil {
-				m.ScheduledTime = &types.Timestamp{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PollMutableStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PollMutableStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PollMutableStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16003,18 +17005,37 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedNextEventId", wireType)
+			}
+			m.ExpectedNextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedNextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16024,120 +17045,25 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Queries == nil {
-				m.Queries = make(map[string]*v1.WorkflowQuery)
-			}
-			var mapkey string
-			var mapvalue *v1.WorkflowQuery
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v1.WorkflowQuery{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
 			}
-			m.Queries[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16161,7 +17087,7 @@ func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
+func (m *PollMutableStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16184,17 +17110,17 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PollMutableStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PollMutableStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16204,27 +17130,31 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16251,18 +17181,18 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowExecution == nil {
-				m.WorkflowExecution = &v1.WorkflowExecution{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
 			}
-			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
 			}
-			m.ScheduleId = 0
+			m.NextEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16272,16 +17202,16 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduleId |= int64(b&0x7F) << shift
+				m.NextEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
 			}
-			m.TaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16291,16 +17221,33 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
+			}
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastFirstEventId", wireType)
 			}
-			var stringLen uint64
+			m.LastFirstEventId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16310,27 +17257,14 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastFirstEventId |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16357,67 +17291,16 @@ func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PollRequest == nil {
-				m.PollRequest = &v1.PollForActivityTaskRequest{}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
 			}
-			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16444,18 +17327,18 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledEvent == nil {
-				m.ScheduledEvent = &v1.HistoryEvent{}
+			if m.StickyTaskList == nil {
+				m.StickyTaskList = &v1.TaskList{}
 			}
-			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StickyTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLibraryVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16465,33 +17348,29 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedTime == nil {
-				m.StartedTime = &types.Timestamp{}
-			}
-			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientLibraryVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientFeatureVersion", wireType)
 			}
-			m.Attempt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16501,16 +17380,29 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Attempt |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientFeatureVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientImpl", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16520,31 +17412,27 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ScheduledTimeOfThisAttempt == nil {
-				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
-			}
-			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientImpl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyTaskListScheduleToStartTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16571,18 +17459,18 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HeartbeatDetails == nil {
-				m.HeartbeatDetails = &v1.Payload{}
+			if m.StickyTaskListScheduleToStartTimeout == nil {
+				m.StickyTaskListScheduleToStartTimeout = &types.Duration{}
 			}
-			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StickyTaskListScheduleToStartTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentBranchToken", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16592,33 +17480,31 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowType == nil {
-				m.WorkflowType = &v1.WorkflowType{}
-			}
-			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.CurrentBranchToken = append(m.CurrentBranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentBranchToken == nil {
+				m.CurrentBranchToken = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionHistories", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -16628,47 +17514,89 @@ func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if m.VersionHistories == nil {
+				m.VersionHistories = &v11.VersionHistories{}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+			if err := m.VersionHistories.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowState", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+			m.WorkflowState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowState |= v11.WorkflowState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowCloseState", wireType)
+			}
+			m.WorkflowCloseState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkflowCloseState |= v1.WorkflowExecutionCloseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *RecordDecisionTaskStartedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16691,15 +17619,47 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordDecisionTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16726,16 +17686,54 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &v1.RespondDecisionTaskCompletedRequest{}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
 			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16763,7 +17761,43 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DomainId = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollForDecisionTaskRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16787,7 +17821,7 @@ func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *RecordDecisionTaskStartedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16810,15 +17844,15 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecordDecisionTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16845,16 +17879,16 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedResponse == nil {
-				m.StartedResponse = &RecordDecisionTaskStartedResponse{}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
 			}
-			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivitiesToDispatchLocally", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartedEventId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16881,53 +17915,1224 @@ func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ActivitiesToDispatchLocally == nil {
-				m.ActivitiesToDispatchLocally = make(map[string]*v1.ActivityLocalDispatchInfo)
+			if m.PreviousStartedEventId == nil {
+				m.PreviousStartedEventId = &types.Int64Value{}
 			}
-			var mapkey string
-			var mapvalue *v1.ActivityLocalDispatchInfo
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.PreviousStartedEventId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEventId", wireType)
+			}
+			m.ScheduledEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthService
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthService
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedEventId", wireType)
+			}
+			m.StartedEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextEventId", wireType)
+			}
+			m.NextEventId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextEventId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StickyExecutionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StickyExecutionEnabled = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecisionInfo == nil {
+				m.DecisionInfo = &v11.TransientDecisionInfo{}
+			}
+			if err := m.DecisionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecutionTaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecutionTaskList == nil {
+				m.WorkflowExecutionTaskList = &v1.TaskList{}
+			}
+			if err := m.WorkflowExecutionTaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventStoreVersion", wireType)
+			}
+			m.EventStoreVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventStoreVersion |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BranchToken = append(m.BranchToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.BranchToken == nil {
+				m.BranchToken = []byte{}
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTime == nil {
+				m.ScheduledTime = &types.Timestamp{}
+			}
+			if err := m.ScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queries == nil {
+				m.Queries = make(map[string]*v1.WorkflowQuery)
+			}
+			var mapkey string
+			var mapvalue *v1.WorkflowQuery
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.WorkflowQuery{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Queries[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskStartedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleId", wireType)
+			}
+			m.ScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduleId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollRequest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PollRequest == nil {
+				m.PollRequest = &v1.PollForActivityTaskRequest{}
+			}
+			if err := m.PollRequest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordActivityTaskStartedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordActivityTaskStartedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledEvent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledEvent == nil {
+				m.ScheduledEvent = &v1.HistoryEvent{}
+			}
+			if err := m.ScheduledEvent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedTime == nil {
+				m.StartedTime = &types.Timestamp{}
+			}
+			if err := m.StartedTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimeOfThisAttempt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTimeOfThisAttempt == nil {
+				m.ScheduledTimeOfThisAttempt = &types.Timestamp{}
+			}
+			if err := m.ScheduledTimeOfThisAttempt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatDetails", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HeartbeatDetails == nil {
+				m.HeartbeatDetails = &v1.Payload{}
+			}
+			if err := m.HeartbeatDetails.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowType == nil {
+				m.WorkflowType = &v1.WorkflowType{}
+			}
+			if err := m.WorkflowType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskCompletedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &v1.RespondDecisionTaskCompletedRequest{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartedResponse == nil {
+				m.StartedResponse = &RecordDecisionTaskStartedResponse{}
+			}
+			if err := m.StartedResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivitiesToDispatchLocally", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivitiesToDispatchLocally == nil {
+				m.ActivitiesToDispatchLocally = make(map[string]*v1.ActivityLocalDispatchInfo)
+			}
+			var mapkey string
+			var mapvalue *v1.ActivityLocalDispatchInfo
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthService
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthService
+					}
 					if postStringIndexmapkey > l {
 						return io.ErrUnexpectedEOF
 					}
@@ -20220,6 +22425,40 @@ func (m *DescribeHistoryHostResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSizeStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadSizeStats = append(m.PayloadSizeStats, &v11.DomainPayloadSizeStats{})
+			if err := m.PayloadSizeStats[len(m.PayloadSizeStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -20966,6 +23205,161 @@ func (m *DescribeQueueResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetReplicationAckStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationAckStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationAckStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetReplicationAckStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetReplicationAckStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetReplicationAckStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckStates = append(m.AckStates, &v11.ReplicationAckState{})
+			if err := m.AckStates[len(m.AckStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -21059,8 +23453,77 @@ func (m *GetReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v v11.CompressionType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= v11.CompressionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SupportedCompressions = append(m.SupportedCompressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.SupportedCompressions) == 0 {
+					m.SupportedCompressions = make([]v11.CompressionType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v v11.CompressionType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= v11.CompressionType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SupportedCompressions = append(m.SupportedCompressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedCompressions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -21312,6 +23775,75 @@ func (m *GetDLQReplicationMessagesRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v v11.CompressionType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= v11.CompressionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SupportedCompressions = append(m.SupportedCompressions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.SupportedCompressions) == 0 {
+					m.SupportedCompressions = make([]v11.CompressionType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v v11.CompressionType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= v11.CompressionType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SupportedCompressions = append(m.SupportedCompressions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupportedCompressions", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -21921,16 +24453,207 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
+			}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReadDLQMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReadDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= v11.DLQType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -21940,27 +24663,29 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
+			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
+			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasksInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21987,33 +24712,12 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InclusiveEndMessageId == nil {
-				m.InclusiveEndMessageId = &types.Int64Value{}
-			}
-			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v11.ReplicationTaskInfo{})
+			if err := m.ReplicationTasksInfo[len(m.ReplicationTasksInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
@@ -22069,7 +24773,7 @@ func (m *ReadDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22092,10 +24796,10 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReadDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReadDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22118,10 +24822,10 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
 			}
-			var msglen int
+			m.ShardId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22131,31 +24835,16 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ShardId |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReplicationTasks = append(m.ReplicationTasks, &v11.ReplicationTask{})
-			if err := m.ReplicationTasks[len(m.ReplicationTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationTasksInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22165,31 +24854,29 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReplicationTasksInfo = append(m.ReplicationTasksInfo, &v11.ReplicationTaskInfo{})
-			if err := m.ReplicationTasksInfo[len(m.ReplicationTasksInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22199,24 +24886,26 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			if m.InclusiveEndMessageId == nil {
+				m.InclusiveEndMessageId = &types.Int64Value{}
+			}
+			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -22241,7 +24930,7 @@ func (m *ReadDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22264,10 +24953,61 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22376,6 +25116,59 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22398,7 +25191,7 @@ func (m *PurgeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22421,12 +25214,46 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurgeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageToken == nil {
+				m.NextPageToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22449,7 +25276,7 @@ func (m *PurgeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
+func (m *NotifyFailoverMarkersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22472,85 +25299,15 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NotifyFailoverMarkersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NotifyFailoverMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= v11.DLQType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
-			}
-			m.ShardId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceCluster", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SourceCluster = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InclusiveEndMessageId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailoverMarkerTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22577,66 +25334,62 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InclusiveEndMessageId == nil {
-				m.InclusiveEndMessageId = &types.Int64Value{}
-			}
-			if err := m.InclusiveEndMessageId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailoverMarkerTokens = append(m.FailoverMarkerTokens, &v11.FailoverMarkerToken{})
+			if err := m.FailoverMarkerTokens[len(m.FailoverMarkerTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotifyFailoverMarkersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotifyFailoverMarkersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotifyFailoverMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22659,7 +25412,7 @@ func (m *MergeDLQMessagesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22682,17 +25435,93 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCrossClusterTasksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MergeDLQMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCrossClusterTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -22702,25 +25531,23 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = append(m.NextPageToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.NextPageToken == nil {
-				m.NextPageToken = []byte{}
-			}
+			m.TargetCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22744,7 +25571,7 @@ func (m *MergeDLQMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotifyFailoverMarkersRequest) Unmarshal(dAtA []byte) error {
+func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22767,15 +25594,15 @@ func (m *NotifyFailoverMarkersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NotifyFailoverMarkersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetCrossClusterTasksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NotifyFailoverMarkersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetCrossClusterTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailoverMarkerTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TasksByShard", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22802,62 +25629,191 @@ func (m *NotifyFailoverMarkersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FailoverMarkerTokens = append(m.FailoverMarkerTokens, &v11.FailoverMarkerToken{})
-			if err := m.FailoverMarkerTokens[len(m.FailoverMarkerTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.TasksByShard == nil {
+				m.TasksByShard = make(map[int32]*v11.CrossClusterTaskRequests)
+			}
+			var mapkey int32
+			var mapvalue *v11.CrossClusterTaskRequests
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthService
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthService
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.CrossClusterTaskRequests{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.TasksByShard[mapkey] = mapvalue
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCauseByShard", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthService
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotifyFailoverMarkersResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.FailedCauseByShard == nil {
+				m.FailedCauseByShard = make(map[int32]v11.GetTaskFailedCause)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NotifyFailoverMarkersResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NotifyFailoverMarkersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			var mapkey int32
+			var mapvalue v11.GetTaskFailedCause
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= v11.GetTaskFailedCause(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipService(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthService
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FailedCauseByShard[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -22880,7 +25836,7 @@ func (m *NotifyFailoverMarkersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
+func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22903,87 +25859,30 @@ func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCrossClusterTasksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCrossClusterTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ShardIds = append(m.ShardIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthService
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthService
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+			}
+			m.ShardId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ShardIds) == 0 {
-					m.ShardIds = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ShardIds = append(m.ShardIds, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardId |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
 		case 2:
 			if wireType != 2 {
@@ -23017,6 +25916,60 @@ func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.TargetCluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskResponses = append(m.TaskResponses, &v11.CrossClusterTaskResponse{})
+			if err := m.TaskResponses[len(m.TaskResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FetchNewTasks", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FetchNewTasks = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -23039,7 +25992,7 @@ func (m *GetCrossClusterTasksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23062,15 +26015,15 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCrossClusterTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCrossClusterTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TasksByShard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23097,97 +26050,69 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TasksByShard == nil {
-				m.TasksByShard = make(map[int32]*v11.CrossClusterTaskRequests)
+			if m.Tasks == nil {
+				m.Tasks = &v11.CrossClusterTaskRequests{}
 			}
-			var mapkey int32
-			var mapvalue *v11.CrossClusterTaskRequests
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthService
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthService
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &v11.CrossClusterTaskRequests{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.Tasks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFailoverInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
 			}
-			m.TasksByShard[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFailoverInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFailoverInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedCauseByShard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -23197,90 +26122,23 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FailedCauseByShard == nil {
-				m.FailedCauseByShard = make(map[int32]v11.GetTaskFailedCause)
-			}
-			var mapkey int32
-			var mapvalue v11.GetTaskFailedCause
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= v11.GetTaskFailedCause(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipService(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthService
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.FailedCauseByShard[mapkey] = mapvalue
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23304,7 +26162,7 @@ func (m *GetCrossClusterTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error {
+func (m *GetFailoverInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23327,17 +26185,17 @@ func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFailoverInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFailoverInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShardId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedShardCount", wireType)
 			}
-			m.ShardId = 0
+			m.CompletedShardCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -23347,48 +26205,143 @@ func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ShardId |= int32(b&0x7F) << shift
+				m.CompletedShardCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetCluster", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.PendingShards = append(m.PendingShards, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingShards) == 0 {
+					m.PendingShards = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingShards = append(m.PendingShards, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingShards", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthService
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetCluster = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDomainReplicationWatermarkRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDomainReplicationWatermarkRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDomainReplicationWatermarkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskResponses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -23398,46 +26351,100 @@ func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskResponses = append(m.TaskResponses, &v11.CrossClusterTaskResponse{})
-			if err := m.TaskResponses[len(m.TaskResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FetchNewTasks", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
+		case 2:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.ShardIds = append(m.ShardIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthService
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ShardIds) == 0 {
+					m.ShardIds = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ShardIds = append(m.ShardIds, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardIds", wireType)
 			}
-			m.FetchNewTasks = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -23460,7 +26467,7 @@ func (m *RespondCrossClusterTasksCompletedRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDomainReplicationWatermarkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23483,15 +26490,15 @@ func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDomainReplicationWatermarkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RespondCrossClusterTasksCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDomainReplicationWatermarkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplicatedEventTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23518,10 +26525,10 @@ func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tasks == nil {
-				m.Tasks = &v11.CrossClusterTaskRequests{}
+			if m.LastReplicatedEventTime == nil {
+				m.LastReplicatedEventTime = &types.Timestamp{}
 			}
-			if err := m.Tasks.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastReplicatedEventTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23547,7 +26554,7 @@ func (m *RespondCrossClusterTasksCompletedResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *GetFailoverInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *ReconcileWorkflowRetentionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23570,10 +26577,10 @@ func (m *GetFailoverInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFailoverInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReconcileWorkflowRetentionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFailoverInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReconcileWorkflowRetentionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23608,6 +26615,42 @@ func (m *GetFailoverInfoRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.DomainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowExecution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowExecution == nil {
+				m.WorkflowExecution = &v1.WorkflowExecution{}
+			}
+			if err := m.WorkflowExecution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -23630,7 +26673,7 @@ func (m *GetFailoverInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFailoverInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *ReconcileWorkflowRetentionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23653,107 +26696,12 @@ func (m *GetFailoverInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFailoverInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReconcileWorkflowRetentionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFailoverInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReconcileWorkflowRetentionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletedShardCount", wireType)
-			}
-			m.CompletedShardCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowService
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompletedShardCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PendingShards = append(m.PendingShards, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowService
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthService
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthService
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PendingShards) == 0 {
-					m.PendingShards = make([]int32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowService
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PendingShards = append(m.PendingShards, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingShards", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
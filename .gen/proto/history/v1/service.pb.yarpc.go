@@ -46,6 +46,7 @@ var _ = ioutil.NopCloser
 type HistoryAPIYARPCClient interface {
 	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error)
+	SignalBatchWorkflowExecution(context.Context, *SignalBatchWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalBatchWorkflowExecutionResponse, error)
 	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error)
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
@@ -75,6 +76,7 @@ type HistoryAPIYARPCClient interface {
 	RemoveTask(context.Context, *RemoveTaskRequest, ...yarpc.CallOption) (*RemoveTaskResponse, error)
 	ResetQueue(context.Context, *ResetQueueRequest, ...yarpc.CallOption) (*ResetQueueResponse, error)
 	DescribeQueue(context.Context, *DescribeQueueRequest, ...yarpc.CallOption) (*DescribeQueueResponse, error)
+	GetReplicationAckStates(context.Context, *GetReplicationAckStatesRequest, ...yarpc.CallOption) (*GetReplicationAckStatesResponse, error)
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest, ...yarpc.CallOption) (*GetReplicationMessagesResponse, error)
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest, ...yarpc.CallOption) (*ReapplyEventsResponse, error)
@@ -86,6 +88,8 @@ type HistoryAPIYARPCClient interface {
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest, ...yarpc.CallOption) (*GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest, ...yarpc.CallOption) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest, ...yarpc.CallOption) (*GetFailoverInfoResponse, error)
+	GetDomainReplicationWatermark(context.Context, *GetDomainReplicationWatermarkRequest, ...yarpc.CallOption) (*GetDomainReplicationWatermarkResponse, error)
+	ReconcileWorkflowRetention(context.Context, *ReconcileWorkflowRetentionRequest, ...yarpc.CallOption) (*ReconcileWorkflowRetentionResponse, error)
 }
 
 func newHistoryAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) HistoryAPIYARPCClient {
@@ -108,6 +112,7 @@ func NewHistoryAPIYARPCClient(clientConfig transport.ClientConfig, options ...pr
 type HistoryAPIYARPCServer interface {
 	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest) (*SignalWorkflowExecutionResponse, error)
+	SignalBatchWorkflowExecution(context.Context, *SignalBatchWorkflowExecutionRequest) (*SignalBatchWorkflowExecutionResponse, error)
 	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*SignalWithStartWorkflowExecutionResponse, error)
 	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
@@ -137,6 +142,7 @@ type HistoryAPIYARPCServer interface {
 	RemoveTask(context.Context, *RemoveTaskRequest) (*RemoveTaskResponse, error)
 	ResetQueue(context.Context, *ResetQueueRequest) (*ResetQueueResponse, error)
 	DescribeQueue(context.Context, *DescribeQueueRequest) (*DescribeQueueResponse, error)
+	GetReplicationAckStates(context.Context, *GetReplicationAckStatesRequest) (*GetReplicationAckStatesResponse, error)
 	GetReplicationMessages(context.Context, *GetReplicationMessagesRequest) (*GetReplicationMessagesResponse, error)
 	GetDLQReplicationMessages(context.Context, *GetDLQReplicationMessagesRequest) (*GetDLQReplicationMessagesResponse, error)
 	ReapplyEvents(context.Context, *ReapplyEventsRequest) (*ReapplyEventsResponse, error)
@@ -148,6 +154,8 @@ type HistoryAPIYARPCServer interface {
 	GetCrossClusterTasks(context.Context, *GetCrossClusterTasksRequest) (*GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *RespondCrossClusterTasksCompletedRequest) (*RespondCrossClusterTasksCompletedResponse, error)
 	GetFailoverInfo(context.Context, *GetFailoverInfoRequest) (*GetFailoverInfoResponse, error)
+	GetDomainReplicationWatermark(context.Context, *GetDomainReplicationWatermarkRequest) (*GetDomainReplicationWatermarkResponse, error)
+	ReconcileWorkflowRetention(context.Context, *ReconcileWorkflowRetentionRequest) (*ReconcileWorkflowRetentionResponse, error)
 }
 
 type buildHistoryAPIYARPCProceduresParams struct {
@@ -181,6 +189,16 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "SignalBatchWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.SignalBatchWorkflowExecution,
+							NewRequest:  newHistoryAPIServiceSignalBatchWorkflowExecutionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "SignalWithStartWorkflowExecution",
 					Handler: protobuf.NewUnaryHandler(
@@ -471,6 +489,16 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "GetReplicationAckStates",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetReplicationAckStates,
+							NewRequest:  newHistoryAPIServiceGetReplicationAckStatesYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "GetReplicationMessages",
 					Handler: protobuf.NewUnaryHandler(
@@ -581,6 +609,26 @@ func buildHistoryAPIYARPCProcedures(params buildHistoryAPIYARPCProceduresParams)
 						},
 					),
 				},
+				{
+					MethodName: "GetDomainReplicationWatermark",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetDomainReplicationWatermark,
+							NewRequest:  newHistoryAPIServiceGetDomainReplicationWatermarkYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ReconcileWorkflowRetention",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ReconcileWorkflowRetention,
+							NewRequest:  newHistoryAPIServiceReconcileWorkflowRetentionYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -717,6 +765,18 @@ func (c *_HistoryAPIYARPCCaller) SignalWorkflowExecution(ctx context.Context, re
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) SignalBatchWorkflowExecution(ctx context.Context, request *SignalBatchWorkflowExecutionRequest, options ...yarpc.CallOption) (*SignalBatchWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SignalBatchWorkflowExecution", request, newHistoryAPIServiceSignalBatchWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*SignalBatchWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceSignalBatchWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) SignalWithStartWorkflowExecution(ctx context.Context, request *SignalWithStartWorkflowExecutionRequest, options ...yarpc.CallOption) (*SignalWithStartWorkflowExecutionResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "SignalWithStartWorkflowExecution", request, newHistoryAPIServiceSignalWithStartWorkflowExecutionYARPCResponse, options...)
 	if responseMessage == nil {
@@ -1065,6 +1125,18 @@ func (c *_HistoryAPIYARPCCaller) DescribeQueue(ctx context.Context, request *Des
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) GetReplicationAckStates(ctx context.Context, request *GetReplicationAckStatesRequest, options ...yarpc.CallOption) (*GetReplicationAckStatesResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetReplicationAckStates", request, newHistoryAPIServiceGetReplicationAckStatesYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetReplicationAckStatesResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceGetReplicationAckStatesYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_HistoryAPIYARPCCaller) GetReplicationMessages(ctx context.Context, request *GetReplicationMessagesRequest, options ...yarpc.CallOption) (*GetReplicationMessagesResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "GetReplicationMessages", request, newHistoryAPIServiceGetReplicationMessagesYARPCResponse, options...)
 	if responseMessage == nil {
@@ -1197,6 +1269,30 @@ func (c *_HistoryAPIYARPCCaller) GetFailoverInfo(ctx context.Context, request *G
 	return response, err
 }
 
+func (c *_HistoryAPIYARPCCaller) GetDomainReplicationWatermark(ctx context.Context, request *GetDomainReplicationWatermarkRequest, options ...yarpc.CallOption) (*GetDomainReplicationWatermarkResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetDomainReplicationWatermark", request, newHistoryAPIServiceGetDomainReplicationWatermarkYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetDomainReplicationWatermarkResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceGetDomainReplicationWatermarkYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_HistoryAPIYARPCCaller) ReconcileWorkflowRetention(ctx context.Context, request *ReconcileWorkflowRetentionRequest, options ...yarpc.CallOption) (*ReconcileWorkflowRetentionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ReconcileWorkflowRetention", request, newHistoryAPIServiceReconcileWorkflowRetentionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ReconcileWorkflowRetentionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyHistoryAPIServiceReconcileWorkflowRetentionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _HistoryAPIYARPCHandler struct {
 	server HistoryAPIYARPCServer
 }
@@ -1233,6 +1329,22 @@ func (h *_HistoryAPIYARPCHandler) SignalWorkflowExecution(ctx context.Context, r
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) SignalBatchWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalBatchWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalBatchWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceSignalBatchWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalBatchWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) SignalWithStartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *SignalWithStartWorkflowExecutionRequest
 	var ok bool
@@ -1697,6 +1809,22 @@ func (h *_HistoryAPIYARPCHandler) DescribeQueue(ctx context.Context, requestMess
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) GetReplicationAckStates(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetReplicationAckStatesRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetReplicationAckStatesRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceGetReplicationAckStatesYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetReplicationAckStates(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_HistoryAPIYARPCHandler) GetReplicationMessages(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *GetReplicationMessagesRequest
 	var ok bool
@@ -1873,6 +2001,38 @@ func (h *_HistoryAPIYARPCHandler) GetFailoverInfo(ctx context.Context, requestMe
 	return response, err
 }
 
+func (h *_HistoryAPIYARPCHandler) GetDomainReplicationWatermark(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetDomainReplicationWatermarkRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetDomainReplicationWatermarkRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceGetDomainReplicationWatermarkYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetDomainReplicationWatermark(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_HistoryAPIYARPCHandler) ReconcileWorkflowRetention(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ReconcileWorkflowRetentionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ReconcileWorkflowRetentionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyHistoryAPIServiceReconcileWorkflowRetentionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ReconcileWorkflowRetention(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newHistoryAPIServiceStartWorkflowExecutionYARPCRequest() proto.Message {
 	return &StartWorkflowExecutionRequest{}
 }
@@ -1889,6 +2049,14 @@ func newHistoryAPIServiceSignalWorkflowExecutionYARPCResponse() proto.Message {
 	return &SignalWorkflowExecutionResponse{}
 }
 
+func newHistoryAPIServiceSignalBatchWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalBatchWorkflowExecutionRequest{}
+}
+
+func newHistoryAPIServiceSignalBatchWorkflowExecutionYARPCResponse() proto.Message {
+	return &SignalBatchWorkflowExecutionResponse{}
+}
+
 func newHistoryAPIServiceSignalWithStartWorkflowExecutionYARPCRequest() proto.Message {
 	return &SignalWithStartWorkflowExecutionRequest{}
 }
@@ -2121,6 +2289,14 @@ func newHistoryAPIServiceDescribeQueueYARPCResponse() proto.Message {
 	return &DescribeQueueResponse{}
 }
 
+func newHistoryAPIServiceGetReplicationAckStatesYARPCRequest() proto.Message {
+	return &GetReplicationAckStatesRequest{}
+}
+
+func newHistoryAPIServiceGetReplicationAckStatesYARPCResponse() proto.Message {
+	return &GetReplicationAckStatesResponse{}
+}
+
 func newHistoryAPIServiceGetReplicationMessagesYARPCRequest() proto.Message {
 	return &GetReplicationMessagesRequest{}
 }
@@ -2209,11 +2385,29 @@ func newHistoryAPIServiceGetFailoverInfoYARPCResponse() proto.Message {
 	return &GetFailoverInfoResponse{}
 }
 
+func newHistoryAPIServiceGetDomainReplicationWatermarkYARPCRequest() proto.Message {
+	return &GetDomainReplicationWatermarkRequest{}
+}
+
+func newHistoryAPIServiceGetDomainReplicationWatermarkYARPCResponse() proto.Message {
+	return &GetDomainReplicationWatermarkResponse{}
+}
+
+func newHistoryAPIServiceReconcileWorkflowRetentionYARPCRequest() proto.Message {
+	return &ReconcileWorkflowRetentionRequest{}
+}
+
+func newHistoryAPIServiceReconcileWorkflowRetentionYARPCResponse() proto.Message {
+	return &ReconcileWorkflowRetentionResponse{}
+}
+
 var (
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCRequest             = &StartWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceStartWorkflowExecutionYARPCResponse            = &StartWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceSignalWorkflowExecutionYARPCRequest            = &SignalWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceSignalWorkflowExecutionYARPCResponse           = &SignalWorkflowExecutionResponse{}
+	emptyHistoryAPIServiceSignalBatchWorkflowExecutionYARPCRequest       = &SignalBatchWorkflowExecutionRequest{}
+	emptyHistoryAPIServiceSignalBatchWorkflowExecutionYARPCResponse      = &SignalBatchWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceSignalWithStartWorkflowExecutionYARPCRequest   = &SignalWithStartWorkflowExecutionRequest{}
 	emptyHistoryAPIServiceSignalWithStartWorkflowExecutionYARPCResponse  = &SignalWithStartWorkflowExecutionResponse{}
 	emptyHistoryAPIServiceResetWorkflowExecutionYARPCRequest             = &ResetWorkflowExecutionRequest{}
@@ -2272,6 +2466,8 @@ var (
 	emptyHistoryAPIServiceResetQueueYARPCResponse                        = &ResetQueueResponse{}
 	emptyHistoryAPIServiceDescribeQueueYARPCRequest                      = &DescribeQueueRequest{}
 	emptyHistoryAPIServiceDescribeQueueYARPCResponse                     = &DescribeQueueResponse{}
+	emptyHistoryAPIServiceGetReplicationAckStatesYARPCRequest            = &GetReplicationAckStatesRequest{}
+	emptyHistoryAPIServiceGetReplicationAckStatesYARPCResponse           = &GetReplicationAckStatesResponse{}
 	emptyHistoryAPIServiceGetReplicationMessagesYARPCRequest             = &GetReplicationMessagesRequest{}
 	emptyHistoryAPIServiceGetReplicationMessagesYARPCResponse            = &GetReplicationMessagesResponse{}
 	emptyHistoryAPIServiceGetDLQReplicationMessagesYARPCRequest          = &GetDLQReplicationMessagesRequest{}
@@ -2294,306 +2490,341 @@ var (
 	emptyHistoryAPIServiceRespondCrossClusterTasksCompletedYARPCResponse = &RespondCrossClusterTasksCompletedResponse{}
 	emptyHistoryAPIServiceGetFailoverInfoYARPCRequest                    = &GetFailoverInfoRequest{}
 	emptyHistoryAPIServiceGetFailoverInfoYARPCResponse                   = &GetFailoverInfoResponse{}
+	emptyHistoryAPIServiceGetDomainReplicationWatermarkYARPCRequest      = &GetDomainReplicationWatermarkRequest{}
+	emptyHistoryAPIServiceGetDomainReplicationWatermarkYARPCResponse     = &GetDomainReplicationWatermarkResponse{}
+	emptyHistoryAPIServiceReconcileWorkflowRetentionYARPCRequest         = &ReconcileWorkflowRetentionRequest{}
+	emptyHistoryAPIServiceReconcileWorkflowRetentionYARPCResponse        = &ReconcileWorkflowRetentionResponse{}
 )
 
 var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	// uber/cadence/history/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0xdd, 0x6f, 0x1c, 0xc9,
-		0x56, 0x57, 0x7b, 0xe2, 0xaf, 0x63, 0x7b, 0x6c, 0x57, 0xfc, 0x31, 0x1e, 0x27, 0x8e, 0xdd, 0x37,
-		0x1f, 0xde, 0xec, 0xee, 0x64, 0xe3, 0xec, 0x26, 0xd9, 0xec, 0xee, 0xdd, 0x4d, 0xec, 0x24, 0x3b,
-		0x51, 0x3e, 0xdb, 0x26, 0x01, 0x84, 0xd2, 0x6a, 0x4f, 0xd7, 0xd8, 0x8d, 0x67, 0xba, 0x27, 0xdd,
-		0x3d, 0x76, 0x66, 0x1f, 0xd0, 0x05, 0xae, 0x90, 0xee, 0x15, 0x82, 0x0b, 0x5c, 0x10, 0x12, 0xd2,
-		0x95, 0xd0, 0x45, 0xba, 0xb0, 0x3c, 0xf0, 0x00, 0x6f, 0x88, 0x27, 0x9e, 0x78, 0xe0, 0x0f, 0xe0,
-		0x89, 0x97, 0x2b, 0x1e, 0x40, 0xe2, 0x05, 0xc4, 0x23, 0x42, 0xf5, 0xd1, 0x5f, 0xd3, 0xd5, 0xd5,
-		0x3d, 0x36, 0x52, 0x76, 0xf7, 0xee, 0x9b, 0xa7, 0xaa, 0xce, 0xa9, 0x53, 0xa7, 0x4e, 0xfd, 0xea,
-		0xd4, 0x39, 0xa7, 0x0d, 0x17, 0xba, 0xbb, 0xd8, 0xbd, 0xd2, 0x30, 0x4c, 0x6c, 0x37, 0xf0, 0x95,
-		0x7d, 0xcb, 0xf3, 0x1d, 0xb7, 0x77, 0xe5, 0xf0, 0xea, 0x15, 0x0f, 0xbb, 0x87, 0x56, 0x03, 0xd7,
-		0x3a, 0xae, 0xe3, 0x3b, 0x68, 0x91, 0x0c, 0xab, 0xf1, 0x61, 0x35, 0x3e, 0xac, 0x76, 0x78, 0xb5,
-		0xba, 0xb2, 0xe7, 0x38, 0x7b, 0x2d, 0x7c, 0x85, 0x0e, 0xdb, 0xed, 0x36, 0xaf, 0x98, 0x5d, 0xd7,
-		0xf0, 0x2d, 0xc7, 0x66, 0x84, 0xd5, 0x73, 0xfd, 0xfd, 0xbe, 0xd5, 0xc6, 0x9e, 0x6f, 0xb4, 0x3b,
-		0x7c, 0x40, 0x8a, 0xc1, 0x91, 0x6b, 0x74, 0x3a, 0xd8, 0xf5, 0x78, 0xff, 0x6a, 0x42, 0x40, 0xa3,
-		0x63, 0x11, 0xe1, 0x1a, 0x4e, 0xbb, 0x1d, 0x4e, 0xb1, 0x26, 0x1a, 0x11, 0x88, 0xc8, 0xa5, 0x10,
-		0x0d, 0x79, 0xd5, 0xc5, 0xe1, 0x00, 0x55, 0x34, 0xc0, 0x37, 0xbc, 0x83, 0x96, 0xe5, 0xf9, 0xb2,
-		0x31, 0x47, 0x8e, 0x7b, 0xd0, 0x6c, 0x39, 0x47, 0x7c, 0xcc, 0x65, 0xd1, 0x18, 0xae, 0x4a, 0xbd,
-		0x6f, 0xec, 0x7a, 0xde, 0x58, 0xec, 0xf2, 0x91, 0xe7, 0x13, 0x23, 0xbd, 0x7d, 0xc3, 0xc5, 0x26,
-		0x55, 0x43, 0xab, 0xeb, 0xf9, 0xb9, 0xa3, 0x92, 0xaa, 0x50, 0x33, 0x46, 0xbd, 0xea, 0xe2, 0x2e,
-		0x16, 0x4a, 0x16, 0x8d, 0x71, 0x71, 0xa7, 0x65, 0x35, 0xe2, 0xdb, 0x7b, 0x21, 0x63, 0x64, 0x72,
-		0xa9, 0xea, 0x5f, 0x0e, 0xc3, 0xd9, 0x6d, 0xdf, 0x70, 0xfd, 0x17, 0xbc, 0xfd, 0xee, 0x6b, 0xdc,
-		0xe8, 0x12, 0x3e, 0x1a, 0x7e, 0xd5, 0xc5, 0x9e, 0x8f, 0x1e, 0xc2, 0xa8, 0xcb, 0xfe, 0xac, 0x28,
-		0xab, 0xca, 0xfa, 0xc4, 0xc6, 0x46, 0x2d, 0x61, 0x72, 0x46, 0xc7, 0xaa, 0x1d, 0x5e, 0xad, 0x49,
-		0x99, 0x68, 0x01, 0x0b, 0xb4, 0x0c, 0xe3, 0xa6, 0xd3, 0x36, 0x2c, 0x5b, 0xb7, 0xcc, 0xca, 0xd0,
-		0xaa, 0xb2, 0x3e, 0xae, 0x8d, 0xb1, 0x86, 0xba, 0x89, 0x7e, 0x0d, 0xe6, 0x3b, 0x86, 0x8b, 0x6d,
-		0x5f, 0xc7, 0x01, 0x03, 0xdd, 0xb2, 0x9b, 0x4e, 0xa5, 0x44, 0x27, 0x5e, 0x17, 0x4e, 0xfc, 0x94,
-		0x52, 0x84, 0x33, 0xd6, 0xed, 0xa6, 0xa3, 0x9d, 0xee, 0xa4, 0x1b, 0x51, 0x05, 0x46, 0x0d, 0xdf,
-		0xc7, 0xed, 0x8e, 0x5f, 0x39, 0xb5, 0xaa, 0xac, 0x0f, 0x6b, 0xc1, 0x4f, 0xb4, 0x09, 0xd3, 0xf8,
-		0x75, 0xc7, 0x62, 0xc7, 0x43, 0x27, 0xe7, 0xa0, 0x32, 0x4c, 0x67, 0xac, 0xd6, 0xd8, 0x19, 0xa8,
-		0x05, 0x67, 0xa0, 0xb6, 0x13, 0x1c, 0x12, 0xad, 0x1c, 0x91, 0x90, 0x46, 0xd4, 0x84, 0xa5, 0x86,
-		0x63, 0xfb, 0x96, 0xdd, 0xc5, 0xba, 0xe1, 0xe9, 0x36, 0x3e, 0xd2, 0x2d, 0xdb, 0xf2, 0x2d, 0xc3,
-		0x77, 0xdc, 0xca, 0xc8, 0xaa, 0xb2, 0x5e, 0xde, 0x78, 0x5b, 0xb8, 0x80, 0x4d, 0x4e, 0x75, 0xdb,
-		0x7b, 0x8c, 0x8f, 0xea, 0x01, 0x89, 0xb6, 0xd0, 0x10, 0xb6, 0xa3, 0x3a, 0xcc, 0x06, 0x3d, 0xa6,
-		0xde, 0x34, 0xac, 0x56, 0xd7, 0xc5, 0x95, 0x51, 0x2a, 0xee, 0x19, 0x21, 0xff, 0x7b, 0x6c, 0x8c,
-		0x36, 0x13, 0x92, 0xf1, 0x16, 0xa4, 0xc1, 0x42, 0xcb, 0xf0, 0x7c, 0xbd, 0xe1, 0xb4, 0x3b, 0x2d,
-		0x4c, 0x17, 0xef, 0x62, 0xaf, 0xdb, 0xf2, 0x2b, 0x63, 0x12, 0x7e, 0x4f, 0x8d, 0x5e, 0xcb, 0x31,
-		0x4c, 0x6d, 0x8e, 0xd0, 0x6e, 0x86, 0xa4, 0x1a, 0xa5, 0x44, 0xbf, 0x0c, 0xcb, 0x4d, 0xcb, 0xf5,
-		0x7c, 0xdd, 0xc4, 0x0d, 0xcb, 0xa3, 0xfa, 0x34, 0xbc, 0x03, 0x7d, 0xd7, 0x68, 0x1c, 0x38, 0xcd,
-		0x66, 0x65, 0x9c, 0x32, 0x5e, 0x4a, 0xe9, 0x75, 0x8b, 0x83, 0x93, 0x56, 0xa1, 0xd4, 0x5b, 0x9c,
-		0x78, 0xc7, 0xf0, 0x0e, 0xee, 0x30, 0x52, 0xf5, 0x06, 0xac, 0x64, 0x19, 0x99, 0xd7, 0x71, 0x6c,
-		0x0f, 0xa3, 0x79, 0x18, 0x71, 0xbb, 0xd4, 0xb2, 0x14, 0x6a, 0x59, 0xc3, 0x6e, 0xd7, 0xae, 0x9b,
-		0xea, 0x5f, 0x0c, 0xc1, 0xca, 0xb6, 0xb5, 0x67, 0x1b, 0xad, 0x4c, 0x23, 0x7f, 0xd4, 0x6f, 0xe4,
-		0xd7, 0xc4, 0x46, 0x2e, 0xe5, 0x52, 0xd0, 0xca, 0x9b, 0xb0, 0x8c, 0x5f, 0xfb, 0xd8, 0xb5, 0x8d,
-		0x56, 0x08, 0x3c, 0x91, 0xc1, 0x73, 0x5b, 0xbf, 0x28, 0x9c, 0x3f, 0x3d, 0xf3, 0x52, 0xc0, 0x2a,
-		0xd5, 0x85, 0x6a, 0x70, 0xba, 0xb1, 0x6f, 0xb5, 0xcc, 0x68, 0x12, 0xc7, 0x6e, 0xf5, 0xa8, 0xed,
-		0x8f, 0x69, 0xb3, 0xb4, 0x2b, 0x20, 0x7a, 0x62, 0xb7, 0x7a, 0xea, 0x1a, 0x9c, 0xcb, 0x5c, 0x1f,
-		0x53, 0xb0, 0xfa, 0x13, 0x05, 0x2e, 0xf1, 0x31, 0x96, 0xbf, 0x2f, 0xc7, 0x8d, 0xe7, 0xfd, 0x2a,
-		0xfd, 0x58, 0xa6, 0xd2, 0x3c, 0x76, 0xc5, 0x74, 0xab, 0xde, 0x86, 0xf5, 0x7c, 0x86, 0x72, 0x6b,
-		0xf9, 0xa1, 0x02, 0x67, 0x35, 0xec, 0xe1, 0x13, 0x23, 0xa2, 0x94, 0x49, 0xc1, 0xf5, 0xdc, 0x80,
-		0x95, 0x2c, 0x36, 0xf2, 0x55, 0x7c, 0x39, 0x04, 0x6b, 0x3b, 0xd8, 0x6d, 0x5b, 0xb6, 0xe1, 0xe3,
-		0xcc, 0x95, 0x3c, 0xed, 0x5f, 0xc9, 0x75, 0xe1, 0x4a, 0x72, 0x19, 0x7d, 0xcd, 0x2d, 0xff, 0x3c,
-		0xa8, 0xb2, 0x25, 0x72, 0xe3, 0xff, 0x91, 0x02, 0xab, 0x5b, 0xd8, 0x6b, 0xb8, 0xd6, 0x6e, 0xb6,
-		0x46, 0x9f, 0xf4, 0x6b, 0xf4, 0x03, 0xe1, 0x72, 0xf2, 0xf8, 0x14, 0x34, 0x8f, 0xff, 0x2d, 0xc1,
-		0x9a, 0x84, 0x15, 0x37, 0x91, 0x16, 0x2c, 0x46, 0xf7, 0x69, 0xc3, 0xb1, 0x9b, 0xd6, 0x1e, 0x47,
-		0x5b, 0x29, 0xd8, 0xa5, 0x18, 0x6e, 0xc6, 0x49, 0xb5, 0x05, 0x2c, 0x6c, 0x47, 0xbb, 0xb0, 0x98,
-		0xde, 0x5b, 0x76, 0x8d, 0x0f, 0xd1, 0xd9, 0x2e, 0x17, 0x9b, 0x8d, 0x5e, 0xe4, 0xf3, 0x47, 0xa2,
-		0x66, 0xf4, 0x02, 0x50, 0x07, 0xdb, 0xa6, 0x65, 0xef, 0xe9, 0x46, 0xc3, 0xb7, 0x0e, 0x2d, 0xdf,
-		0xc2, 0x5e, 0xa5, 0xb4, 0x5a, 0xca, 0xf6, 0x12, 0xd8, 0xf0, 0xdb, 0x6c, 0x74, 0x8f, 0x32, 0x9f,
-		0xed, 0x24, 0x1a, 0x2d, 0xec, 0xa1, 0x5f, 0x81, 0x99, 0x80, 0x31, 0x35, 0x13, 0x17, 0xdb, 0x95,
-		0x53, 0x94, 0x6d, 0x4d, 0xc6, 0x76, 0x93, 0x8c, 0x4d, 0x4a, 0x3e, 0xdd, 0x89, 0x75, 0xb9, 0xd8,
-		0x46, 0xdb, 0x11, 0xeb, 0xe0, 0x6a, 0xe4, 0x5e, 0x86, 0x54, 0xe2, 0xe0, 0x26, 0x4c, 0x30, 0x0d,
-		0x1a, 0xd5, 0xd7, 0x30, 0xf7, 0x8c, 0x38, 0xcb, 0x81, 0xf6, 0x02, 0x33, 0xdc, 0xec, 0x37, 0xc3,
-		0xb7, 0x84, 0x73, 0x88, 0x68, 0x0b, 0x9a, 0xde, 0x4f, 0x15, 0x98, 0xef, 0x23, 0xe7, 0xe6, 0xf6,
-		0x29, 0x4c, 0x52, 0x07, 0x3e, 0xf0, 0x25, 0x94, 0x02, 0xbe, 0xc4, 0x04, 0xa5, 0xe0, 0x2e, 0x44,
-		0x1d, 0xca, 0x01, 0x83, 0x5f, 0xc7, 0x0d, 0x1f, 0x9b, 0xdc, 0x70, 0xd4, 0xec, 0x35, 0x68, 0x7c,
-		0xa4, 0x36, 0xf5, 0x2a, 0xfe, 0x53, 0xfd, 0xbe, 0x02, 0x55, 0x0a, 0xa0, 0xdb, 0xbe, 0xd5, 0x38,
-		0xe8, 0x11, 0x77, 0xe2, 0xa1, 0xe5, 0xf9, 0x81, 0x9a, 0xea, 0xfd, 0x6a, 0xba, 0x92, 0x8d, 0xe4,
-		0x42, 0x0e, 0x05, 0x95, 0x75, 0x16, 0x96, 0x85, 0x3c, 0x38, 0xb2, 0xfc, 0x97, 0x02, 0x0b, 0xf7,
-		0xb1, 0xff, 0xa8, 0xeb, 0x1b, 0xbb, 0x2d, 0xbc, 0xed, 0x1b, 0x3e, 0xd6, 0x44, 0x6c, 0x95, 0x3e,
-		0x3c, 0xfd, 0x25, 0x40, 0x02, 0x18, 0x1d, 0x1a, 0x08, 0x46, 0x67, 0x53, 0x27, 0x0c, 0x5d, 0x83,
-		0x05, 0xfc, 0xba, 0x43, 0x15, 0xa8, 0xdb, 0xf8, 0xb5, 0xaf, 0xe3, 0x43, 0xe2, 0x93, 0x5b, 0x26,
-		0x45, 0xe8, 0x92, 0x76, 0x3a, 0xe8, 0x7d, 0x8c, 0x5f, 0xfb, 0x77, 0x49, 0x5f, 0xdd, 0x44, 0xef,
-		0xc1, 0x5c, 0xa3, 0xeb, 0x52, 0xe7, 0x7d, 0xd7, 0x35, 0xec, 0xc6, 0xbe, 0xee, 0x3b, 0x07, 0xf4,
-		0xf4, 0x28, 0xeb, 0x93, 0x1a, 0xe2, 0x7d, 0x77, 0x68, 0xd7, 0x0e, 0xe9, 0x51, 0x7f, 0x3c, 0x0e,
-		0x8b, 0xa9, 0x55, 0x73, 0x1b, 0x12, 0xaf, 0x4c, 0x39, 0xe9, 0xca, 0xee, 0xc1, 0x54, 0xc8, 0xd6,
-		0xef, 0x75, 0x30, 0xd7, 0xd5, 0x9a, 0x94, 0xe3, 0x4e, 0xaf, 0x83, 0xb5, 0xc9, 0xa3, 0xd8, 0x2f,
-		0xa4, 0xc2, 0x94, 0x48, 0x31, 0x13, 0x76, 0x4c, 0x21, 0xcf, 0x61, 0xa9, 0xe3, 0xe2, 0x43, 0xcb,
-		0xe9, 0x7a, 0xba, 0x47, 0x3c, 0x11, 0x6c, 0x46, 0xe3, 0x4f, 0xd1, 0x79, 0x97, 0x53, 0x6e, 0x70,
-		0xdd, 0xf6, 0xaf, 0xbf, 0xff, 0xdc, 0x68, 0x75, 0xb1, 0xb6, 0x10, 0x50, 0x6f, 0x33, 0xe2, 0x80,
-		0xef, 0xbb, 0x70, 0x9a, 0x3a, 0xed, 0xcc, 0xcb, 0x0e, 0x39, 0x0e, 0x53, 0x09, 0x66, 0x48, 0xd7,
-		0x3d, 0xd2, 0x13, 0x0c, 0xbf, 0x05, 0xe3, 0xd4, 0x01, 0x27, 0xcf, 0x65, 0xfa, 0x0c, 0x99, 0xd8,
-		0x38, 0x2b, 0xbe, 0xe4, 0x03, 0xab, 0x1c, 0xf3, 0xf9, 0x5f, 0xe8, 0x3e, 0xcc, 0x78, 0xd4, 0x62,
-		0xf5, 0x88, 0xc5, 0x68, 0x11, 0x16, 0x65, 0x2f, 0x61, 0xe8, 0xe8, 0x7d, 0x58, 0x68, 0xb4, 0x2c,
-		0x22, 0x69, 0xcb, 0xda, 0x75, 0x0d, 0xb7, 0xa7, 0x1f, 0x62, 0x97, 0x22, 0xe0, 0x18, 0x35, 0xe9,
-		0x39, 0xd6, 0xfb, 0x90, 0x75, 0x3e, 0x67, 0x7d, 0x31, 0xaa, 0x26, 0x36, 0xfc, 0xae, 0x8b, 0x43,
-		0xaa, 0xf1, 0x38, 0xd5, 0x3d, 0xd6, 0x19, 0x50, 0x9d, 0x83, 0x09, 0x4e, 0x65, 0xb5, 0x3b, 0xad,
-		0x0a, 0xd0, 0xa1, 0xc0, 0x9a, 0xea, 0xed, 0x4e, 0x0b, 0x79, 0x70, 0xb9, 0x7f, 0x55, 0xba, 0xd7,
-		0xd8, 0xc7, 0x66, 0xb7, 0x85, 0x75, 0xdf, 0x61, 0x9b, 0x45, 0x5f, 0x81, 0x4e, 0xd7, 0xaf, 0x4c,
-		0xe4, 0x3d, 0x58, 0xce, 0x27, 0xd7, 0xba, 0xcd, 0x39, 0xed, 0x38, 0x74, 0xdf, 0x76, 0x18, 0x1b,
-		0xe2, 0x92, 0xb0, 0xad, 0x22, 0x2f, 0xfe, 0x68, 0x21, 0x93, 0xf4, 0x21, 0x3a, 0x4b, 0xbb, 0xb6,
-		0x49, 0x4f, 0xb0, 0x8a, 0xac, 0xe3, 0x34, 0x95, 0x75, 0x9c, 0xd0, 0x43, 0x28, 0x87, 0xb6, 0xed,
-		0x91, 0xc3, 0x54, 0x29, 0xd3, 0x47, 0xe7, 0x85, 0xe4, 0x56, 0xb1, 0x48, 0x40, 0xdc, 0xbe, 0xd9,
-		0xc9, 0x0b, 0x0f, 0x06, 0xfd, 0x89, 0x1a, 0x30, 0x17, 0x72, 0x6b, 0xb4, 0x1c, 0x0f, 0x73, 0x9e,
-		0xd3, 0x94, 0xe7, 0xd5, 0x82, 0x0e, 0x03, 0x21, 0x24, 0xfc, 0xba, 0x9e, 0x16, 0x9e, 0xe7, 0xb0,
-		0x91, 0x9c, 0xf2, 0x59, 0xae, 0x08, 0x9d, 0x85, 0x42, 0xc8, 0x2d, 0x3e, 0x23, 0xba, 0x13, 0x23,
-		0xa9, 0xb9, 0x82, 0x3e, 0x0f, 0xc6, 0x6b, 0x33, 0x87, 0x7d, 0x2d, 0xe8, 0x63, 0x58, 0xb6, 0xc8,
-		0x99, 0xeb, 0xdb, 0x63, 0x6c, 0x13, 0x9c, 0x31, 0x2b, 0xb3, 0xd4, 0x0d, 0x5c, 0xb4, 0xbc, 0x24,
-		0x1a, 0xdf, 0x65, 0xdd, 0xea, 0x7f, 0x2b, 0xb0, 0xf8, 0xd4, 0x69, 0xb5, 0x7e, 0xc1, 0xd0, 0xf8,
-		0x67, 0x63, 0x50, 0x49, 0x2f, 0xfb, 0x5b, 0x38, 0xfe, 0x16, 0x8e, 0xbf, 0x89, 0x70, 0x9c, 0x75,
-		0x3e, 0x26, 0x33, 0xe1, 0x55, 0x88, 0x55, 0x53, 0x27, 0xc6, 0xaa, 0xaf, 0x1f, 0x6a, 0xab, 0xff,
-		0x38, 0x04, 0xab, 0x1a, 0x6e, 0x38, 0xae, 0x19, 0x8f, 0xd2, 0xf1, 0x63, 0xf1, 0x26, 0x91, 0xf2,
-		0x1c, 0x4c, 0x84, 0x86, 0x13, 0x82, 0x00, 0x04, 0x4d, 0x75, 0x13, 0x2d, 0xc2, 0x28, 0xb5, 0x31,
-		0x7e, 0xe2, 0x4b, 0xda, 0x08, 0xf9, 0x59, 0x37, 0xd1, 0x59, 0x00, 0xee, 0xc7, 0x07, 0x67, 0x77,
-		0x5c, 0x1b, 0xe7, 0x2d, 0x75, 0x13, 0x69, 0x30, 0xd9, 0x71, 0x5a, 0x2d, 0x3d, 0x78, 0x2b, 0x8c,
-		0x48, 0xde, 0x0a, 0x04, 0x43, 0xef, 0x39, 0x6e, 0x5c, 0x35, 0xc1, 0x5b, 0x61, 0x82, 0x30, 0xe1,
-		0x3f, 0xd4, 0x7f, 0x1d, 0x85, 0x35, 0x89, 0x16, 0x39, 0xf0, 0xa6, 0x10, 0x52, 0x39, 0x1e, 0x42,
-		0x4a, 0xd1, 0x6f, 0xe8, 0xf8, 0xe8, 0xf7, 0x0e, 0xa0, 0x40, 0xbf, 0x66, 0x3f, 0xfc, 0xce, 0x84,
-		0x3d, 0xc1, 0xe8, 0x75, 0x02, 0x60, 0x02, 0xe8, 0x2d, 0x11, 0x84, 0x4a, 0xf0, 0x4d, 0x21, 0xfa,
-		0x70, 0x1a, 0xd1, 0x63, 0xf1, 0xfc, 0x91, 0x64, 0x3c, 0xff, 0x26, 0x54, 0x38, 0xa4, 0x44, 0x01,
-		0x88, 0xe0, 0xf6, 0x1f, 0xa5, 0xb7, 0xff, 0x02, 0xeb, 0x0f, 0x6d, 0x87, 0x5f, 0xfe, 0x48, 0x83,
-		0xa9, 0x30, 0x6e, 0x4d, 0x43, 0x16, 0x2c, 0x10, 0xfe, 0x6e, 0xd6, 0x69, 0xdc, 0x71, 0x0d, 0xdb,
-		0x23, 0x50, 0x96, 0x78, 0xa6, 0x4f, 0x9a, 0xb1, 0x5f, 0xe8, 0x25, 0x9c, 0x11, 0x04, 0x44, 0x22,
-		0x08, 0x1f, 0x2f, 0x02, 0xe1, 0x4b, 0x29, 0x73, 0x0f, 0xd1, 0x3c, 0xc3, 0xb5, 0x84, 0x2c, 0xd7,
-		0x72, 0x0d, 0x26, 0x13, 0x98, 0x37, 0x41, 0x31, 0x6f, 0x62, 0x37, 0x06, 0x76, 0xb7, 0xa1, 0x1c,
-		0x6d, 0x2b, 0xcd, 0x87, 0x4c, 0xe6, 0xe6, 0x43, 0xa6, 0x42, 0x0a, 0x9a, 0x0e, 0xf9, 0x04, 0x26,
-		0x83, 0xbd, 0xa6, 0x0c, 0xa6, 0x72, 0x19, 0x4c, 0xf0, 0xf1, 0x94, 0xdc, 0x80, 0x51, 0xf2, 0x92,
-		0x27, 0x20, 0x5b, 0xa6, 0xf1, 0x97, 0xfb, 0xb5, 0x8c, 0x44, 0x67, 0x2d, 0xf7, 0x14, 0xd1, 0x10,
-		0x81, 0x85, 0xbd, 0xbb, 0xb6, 0xef, 0xf6, 0xb4, 0x80, 0x6f, 0xf5, 0x25, 0x4c, 0xc6, 0x3b, 0xd0,
-		0x0c, 0x94, 0x0e, 0x70, 0x8f, 0x83, 0x15, 0xf9, 0x13, 0xdd, 0x84, 0xe1, 0x43, 0x62, 0xfe, 0xd2,
-		0xf8, 0x43, 0x70, 0xea, 0x58, 0x1c, 0x82, 0x11, 0xdc, 0x1a, 0xba, 0xa9, 0xc4, 0x70, 0x32, 0x88,
-		0x3a, 0x7d, 0x8b, 0x93, 0x29, 0x9c, 0x8c, 0xab, 0x46, 0x88, 0x93, 0x3f, 0x2f, 0x05, 0x38, 0x29,
-		0xd4, 0x22, 0xc7, 0xc9, 0x07, 0x30, 0xdd, 0x87, 0x43, 0x52, 0xa4, 0x64, 0xf7, 0x6f, 0x8f, 0x22,
-		0x89, 0x56, 0x4e, 0xe2, 0x54, 0xca, 0x72, 0x87, 0x06, 0xb3, 0xdc, 0x18, 0x2c, 0x95, 0x92, 0xb0,
-		0xf4, 0x12, 0x56, 0x92, 0xa7, 0x4a, 0x77, 0x9a, 0xba, 0xbf, 0x6f, 0x79, 0x7a, 0x3c, 0x2f, 0x29,
-		0x9f, 0xaa, 0x9a, 0x38, 0x65, 0x4f, 0x9a, 0x3b, 0xfb, 0x96, 0x77, 0x9b, 0xf3, 0xaf, 0xc3, 0xec,
-		0x3e, 0x36, 0x5c, 0x7f, 0x17, 0x1b, 0xbe, 0x6e, 0x62, 0xdf, 0xb0, 0x5a, 0x1e, 0x0f, 0x31, 0xca,
-		0xa3, 0x6f, 0x33, 0x21, 0xd9, 0x16, 0xa3, 0x4a, 0xdf, 0x3b, 0x23, 0xc7, 0xbb, 0x77, 0x2e, 0xc1,
-		0x74, 0xc8, 0x87, 0x99, 0x35, 0x05, 0xe0, 0x71, 0x2d, 0xf4, 0x7a, 0xb6, 0x68, 0xab, 0xfa, 0x27,
-		0x0a, 0x7c, 0x87, 0xed, 0x66, 0xe2, 0x24, 0xf3, 0xf4, 0x62, 0x74, 0x5e, 0xb4, 0xfe, 0x88, 0xdd,
-		0xcd, 0xac, 0x88, 0x5d, 0x1e, 0xab, 0x82, 0xa1, 0xbb, 0xbf, 0x2d, 0xc1, 0x79, 0x39, 0x37, 0x6e,
-		0x82, 0x38, 0xba, 0xdc, 0x5c, 0xde, 0xc6, 0x45, 0xbc, 0x75, 0x7c, 0xe8, 0xd2, 0xa6, 0xbd, 0x3e,
-		0x4b, 0xff, 0xa9, 0x02, 0x2b, 0x51, 0xcc, 0x9b, 0x38, 0xc8, 0xa6, 0xe5, 0x75, 0x0c, 0xbf, 0xb1,
-		0xaf, 0xb7, 0x9c, 0x86, 0xd1, 0x6a, 0xf5, 0x2a, 0x43, 0x14, 0x30, 0x5f, 0x4a, 0x66, 0xcd, 0x5f,
-		0x4e, 0x2d, 0x0a, 0x8a, 0xef, 0x38, 0x5b, 0x7c, 0x86, 0x87, 0x6c, 0x02, 0x86, 0xa3, 0xcb, 0x46,
-		0xf6, 0x88, 0xea, 0x6f, 0xc0, 0x6a, 0x1e, 0x03, 0x01, 0xde, 0x6e, 0x25, 0xf1, 0x56, 0x1c, 0x72,
-		0x0f, 0x60, 0x80, 0xf2, 0x0a, 0x18, 0xd3, 0x6b, 0x37, 0x86, 0xbd, 0x3f, 0x52, 0x08, 0xf6, 0xa6,
-		0x96, 0x79, 0xcf, 0xb0, 0x5a, 0x91, 0x2d, 0x15, 0xcc, 0xd5, 0xe4, 0xf1, 0x29, 0x68, 0x48, 0xdf,
-		0x21, 0x38, 0x96, 0xc9, 0x89, 0x47, 0x82, 0x7f, 0xac, 0x80, 0x9a, 0x46, 0xbb, 0xcf, 0x83, 0xe3,
-		0x19, 0x48, 0xfe, 0xac, 0x5f, 0xf2, 0x1b, 0x19, 0x92, 0xe7, 0x71, 0x2a, 0x28, 0xfb, 0x53, 0x72,
-		0x38, 0x25, 0xbc, 0xb8, 0x6d, 0xbe, 0x05, 0x33, 0x0d, 0xc3, 0x6e, 0xe0, 0xf0, 0x06, 0xc0, 0xec,
-		0x4e, 0x1b, 0xd3, 0xa6, 0x59, 0xbb, 0x16, 0x34, 0xc7, 0xcf, 0x7b, 0x9c, 0xe7, 0x09, 0xcf, 0xbb,
-		0x8c, 0x55, 0xc1, 0xa5, 0x5e, 0x0c, 0x8f, 0x7b, 0x06, 0xb3, 0x58, 0x36, 0x50, 0x30, 0xf0, 0x24,
-		0x16, 0x96, 0xc9, 0x67, 0x60, 0x0b, 0x13, 0x71, 0x4a, 0x58, 0x58, 0x7a, 0x81, 0x74, 0x7f, 0x22,
-		0xc9, 0x0b, 0x5b, 0x58, 0x1e, 0xa7, 0x82, 0xb2, 0x5f, 0x10, 0x9b, 0x43, 0xc8, 0x8b, 0x4b, 0xff,
-		0x77, 0x0a, 0x9c, 0xd3, 0x70, 0xdb, 0x39, 0xc4, 0x2c, 0xcd, 0xff, 0x55, 0x09, 0xd2, 0x25, 0x1d,
-		0xa3, 0x52, 0x9f, 0x63, 0xa4, 0xaa, 0xc4, 0x56, 0xb2, 0xa4, 0xe6, 0x4b, 0xfb, 0xfb, 0x21, 0xb8,
-		0xc0, 0x97, 0xc0, 0x96, 0x9d, 0x99, 0x63, 0x96, 0x2e, 0xd0, 0x80, 0x72, 0xf2, 0x0c, 0xf2, 0xc5,
-		0xdd, 0xca, 0xd8, 0xbf, 0x02, 0x13, 0x6a, 0x53, 0x89, 0xd3, 0x8b, 0x76, 0x61, 0x31, 0x4c, 0xe3,
-		0x0b, 0x0b, 0xb5, 0xc4, 0x19, 0xde, 0xbb, 0x9c, 0xa6, 0x2f, 0xc3, 0x8b, 0x45, 0xcd, 0x03, 0xa7,
-		0xf0, 0xd7, 0xe1, 0x62, 0xde, 0x5a, 0xb8, 0x9e, 0xff, 0x41, 0x81, 0xe5, 0x20, 0x2a, 0x24, 0x78,
-		0xa5, 0xbf, 0x11, 0xf3, 0xb9, 0x0c, 0xb3, 0x96, 0xa7, 0x27, 0xeb, 0xa6, 0xa8, 0x2e, 0xc7, 0xb4,
-		0x69, 0xcb, 0xbb, 0x17, 0xaf, 0x88, 0x52, 0x57, 0xe0, 0x8c, 0x58, 0x7c, 0xbe, 0xbe, 0x9f, 0x0f,
-		0x11, 0x04, 0x23, 0x60, 0x9d, 0xcc, 0x4a, 0xa7, 0xa0, 0xf5, 0x4d, 0x2c, 0x74, 0x0d, 0x26, 0x79,
-		0x51, 0x1c, 0x36, 0x63, 0x81, 0xda, 0xb0, 0xad, 0x6e, 0xa2, 0x17, 0x70, 0xba, 0x11, 0x88, 0x1a,
-		0x9b, 0xfa, 0xd4, 0x40, 0x53, 0xa3, 0x90, 0x45, 0x34, 0xf7, 0x43, 0x98, 0x89, 0x15, 0xba, 0xb1,
-		0x47, 0xc2, 0x70, 0xd1, 0x47, 0xc2, 0x74, 0x44, 0x4a, 0x1b, 0xd4, 0x4b, 0xe4, 0xb4, 0x4a, 0xb5,
-		0xcc, 0xf7, 0xe3, 0xdf, 0x87, 0xa0, 0xa2, 0xf1, 0xf2, 0x4c, 0x4c, 0x69, 0xbd, 0xe7, 0x1b, 0x6f,
-		0x72, 0x0f, 0x5e, 0xc2, 0x7c, 0x32, 0x92, 0xd9, 0xd3, 0x2d, 0x1f, 0xb7, 0x83, 0xfa, 0x89, 0xcb,
-		0x85, 0xa2, 0x99, 0xbd, 0xba, 0x8f, 0xdb, 0xda, 0xe9, 0xc3, 0x54, 0x9b, 0x87, 0x3e, 0x80, 0x11,
-		0xaa, 0x5c, 0x8f, 0xef, 0x99, 0x38, 0xb2, 0xb1, 0x65, 0xf8, 0xc6, 0x9d, 0x96, 0xb3, 0xab, 0xf1,
-		0xc1, 0x68, 0x13, 0xca, 0x36, 0x3e, 0xd2, 0xdd, 0x2e, 0xdf, 0x9b, 0xe0, 0xe9, 0x92, 0x43, 0x3e,
-		0x69, 0xe3, 0x23, 0xad, 0xcb, 0x36, 0xc5, 0x53, 0x97, 0x61, 0x49, 0xa0, 0x6b, 0xbe, 0x13, 0x3f,
-		0x54, 0x60, 0x61, 0xbb, 0x67, 0x37, 0xb6, 0xf7, 0x0d, 0xd7, 0xe4, 0x01, 0x4e, 0xbe, 0x0f, 0x17,
-		0xa0, 0xec, 0x39, 0x5d, 0xb7, 0x81, 0x75, 0x5e, 0xb9, 0xcb, 0x37, 0x63, 0x8a, 0xb5, 0x6e, 0xb2,
-		0x46, 0xb4, 0x04, 0x63, 0x44, 0x1f, 0x66, 0x70, 0x83, 0x0d, 0x6b, 0xa3, 0xf4, 0x77, 0xdd, 0x44,
-		0x35, 0x38, 0x45, 0x5f, 0x8b, 0xa5, 0xdc, 0x27, 0x1c, 0x1d, 0xa7, 0x2e, 0xc1, 0x62, 0x4a, 0x16,
-		0x2e, 0xe7, 0x3f, 0x0d, 0xc3, 0x69, 0xd2, 0x17, 0xdc, 0x84, 0x6f, 0xd2, 0x58, 0x2a, 0x30, 0x1a,
-		0x04, 0x94, 0xd8, 0x59, 0x0d, 0x7e, 0x92, 0xa3, 0x1c, 0xbd, 0x66, 0xc3, 0x48, 0x41, 0x18, 0x59,
-		0x20, 0x3a, 0x49, 0x87, 0x91, 0x86, 0x07, 0x0d, 0x23, 0x9d, 0x05, 0x08, 0x5e, 0x55, 0x96, 0x49,
-		0x5f, 0xa1, 0x25, 0x6d, 0x9c, 0xb7, 0xd4, 0xcd, 0xd4, 0x5b, 0x7d, 0x74, 0xb0, 0xb7, 0xfa, 0x03,
-		0x9e, 0xbc, 0x89, 0x9e, 0xcd, 0x94, 0xcb, 0x58, 0x2e, 0x97, 0x59, 0x42, 0x16, 0x3a, 0xc0, 0x94,
-		0xd7, 0x75, 0x18, 0x0d, 0xde, 0xdc, 0xe3, 0x05, 0xde, 0xdc, 0xc1, 0xe0, 0x78, 0xbc, 0x00, 0x92,
-		0xf1, 0x82, 0x4f, 0x61, 0x92, 0xa5, 0x96, 0x78, 0x91, 0xef, 0x44, 0x81, 0x22, 0xdf, 0x09, 0x9a,
-		0x71, 0xe2, 0xf5, 0xbd, 0xef, 0x01, 0xad, 0xd1, 0xe5, 0x25, 0xeb, 0xba, 0x65, 0x62, 0xdb, 0xb7,
-		0xfc, 0x1e, 0x0d, 0xe6, 0x8d, 0x6b, 0x88, 0xf4, 0xbd, 0xa0, 0x5d, 0x75, 0xde, 0x83, 0x9e, 0xc0,
-		0x74, 0x1f, 0x36, 0xf0, 0xc0, 0xdd, 0xc5, 0x62, 0xa8, 0xa0, 0x95, 0x93, 0x88, 0xa0, 0x2e, 0xc0,
-		0x5c, 0xd2, 0x94, 0xb9, 0x8d, 0xff, 0x81, 0x02, 0xcb, 0x41, 0xe5, 0xda, 0x57, 0xc4, 0x89, 0x53,
-		0x7f, 0x4f, 0x81, 0x33, 0x62, 0x99, 0xf8, 0xfb, 0xe6, 0x1a, 0x2c, 0xb4, 0x59, 0x3b, 0xcb, 0xab,
-		0xe8, 0x96, 0xad, 0x37, 0x8c, 0xc6, 0x3e, 0xe6, 0x12, 0x9e, 0x6e, 0xc7, 0xa8, 0xea, 0xf6, 0x26,
-		0xe9, 0x42, 0x1f, 0xc2, 0x52, 0x8a, 0xc8, 0x34, 0x7c, 0x63, 0xd7, 0xf0, 0x30, 0x77, 0x83, 0x17,
-		0x92, 0x74, 0x5b, 0xbc, 0x57, 0x3d, 0x03, 0xd5, 0x40, 0x1e, 0xae, 0xcf, 0xcf, 0x9d, 0xb0, 0xf4,
-		0x48, 0xfd, 0xad, 0xa1, 0x48, 0x85, 0x89, 0x6e, 0x2e, 0xed, 0x3a, 0xcc, 0xd8, 0xdd, 0xf6, 0x2e,
-		0x76, 0x75, 0xa7, 0xa9, 0x53, 0x98, 0xf2, 0xa8, 0x9c, 0xc3, 0x5a, 0x99, 0xb5, 0x3f, 0x69, 0x52,
-		0xf4, 0xf1, 0x88, 0xb2, 0x03, 0x58, 0xf3, 0x68, 0xf4, 0x60, 0x58, 0x1b, 0xe3, 0xb8, 0xe6, 0xa1,
-		0x07, 0x30, 0xc9, 0x77, 0x82, 0x2d, 0x95, 0x01, 0xdc, 0xa5, 0x2c, 0x7b, 0x60, 0xf1, 0x1c, 0xba,
-		0x74, 0xea, 0xdf, 0x4d, 0x98, 0x51, 0x03, 0xba, 0x0e, 0x8b, 0x6c, 0xa2, 0x86, 0x63, 0xfb, 0xae,
-		0xd3, 0x6a, 0x61, 0x97, 0x2a, 0xa5, 0xcb, 0xee, 0x8a, 0x71, 0x6d, 0x9e, 0x76, 0x6f, 0x86, 0xbd,
-		0x0c, 0x19, 0xe9, 0x19, 0x31, 0x4d, 0x17, 0x7b, 0x1e, 0x0f, 0x3a, 0x06, 0x3f, 0xd5, 0x1a, 0xcc,
-		0xb2, 0xd4, 0x14, 0xa1, 0x0b, 0x8c, 0x27, 0x0e, 0xd3, 0x4a, 0x02, 0xa6, 0xd5, 0x39, 0x40, 0xf1,
-		0xf1, 0xdc, 0x1a, 0xff, 0x53, 0x81, 0x59, 0xe6, 0xa0, 0xc7, 0x3d, 0xc1, 0x6c, 0x36, 0xe8, 0x13,
-		0x9e, 0xc6, 0x0d, 0xb3, 0xd6, 0xe5, 0x8d, 0xd5, 0xcc, 0x1c, 0x81, 0xe1, 0x1d, 0xd0, 0xd0, 0x18,
-		0xcd, 0xe4, 0xd2, 0xb0, 0x58, 0x2c, 0xc0, 0x5a, 0x4a, 0x04, 0x58, 0x37, 0x61, 0xfa, 0xd0, 0xf2,
-		0xac, 0x5d, 0xab, 0x65, 0xf9, 0x3d, 0x06, 0x46, 0xf9, 0x31, 0xc1, 0x72, 0x44, 0x42, 0x91, 0x68,
-		0x0d, 0x26, 0xf9, 0x2d, 0xa6, 0xdb, 0x06, 0x07, 0xdd, 0x71, 0x6d, 0x82, 0xb7, 0x3d, 0x36, 0xda,
-		0x98, 0xa8, 0x21, 0xbe, 0xde, 0xe8, 0x4d, 0x3b, 0x4b, 0xeb, 0xd4, 0x9e, 0x75, 0x71, 0x17, 0x17,
-		0x50, 0x43, 0xff, 0x4c, 0x43, 0xa9, 0x99, 0x92, 0x9a, 0x2a, 0x0d, 0xaa, 0x29, 0x26, 0x68, 0x24,
-		0x11, 0x17, 0xf4, 0x8f, 0x14, 0x98, 0x0b, 0x4c, 0xff, 0xab, 0x23, 0xeb, 0x13, 0x98, 0xef, 0x13,
-		0x8a, 0x9f, 0xc4, 0xeb, 0xb0, 0xd8, 0x71, 0x9d, 0x06, 0xf6, 0x3c, 0xcb, 0xde, 0xd3, 0xe9, 0xf7,
-		0x3c, 0x0c, 0x0b, 0xc8, 0x81, 0x2c, 0x11, 0xb3, 0x8f, 0xba, 0x29, 0x25, 0x05, 0x02, 0x4f, 0xfd,
-		0xbe, 0x02, 0x67, 0xef, 0x63, 0x5f, 0x8b, 0x3e, 0xee, 0x79, 0x84, 0x3d, 0xcf, 0xd8, 0xc3, 0xa1,
-		0xdf, 0xf2, 0x19, 0x8c, 0xd0, 0x24, 0x0e, 0x63, 0x24, 0x49, 0x45, 0xc7, 0x78, 0xd0, 0x14, 0x8f,
-		0xc6, 0xe9, 0x0a, 0xa8, 0x85, 0x00, 0xcd, 0x4a, 0x96, 0x18, 0x7c, 0x85, 0xaf, 0xa0, 0xcc, 0xf4,
-		0xde, 0xe6, 0x3d, 0x5c, 0x9e, 0x07, 0x99, 0x41, 0x48, 0x39, 0xc3, 0x1a, 0x3d, 0x9f, 0x41, 0x2b,
-		0x0b, 0x38, 0x4e, 0x79, 0xf1, 0xb6, 0x6a, 0x1b, 0x50, 0x7a, 0x50, 0x3c, 0xa8, 0x38, 0xcc, 0x82,
-		0x8a, 0xb7, 0x93, 0x41, 0xc5, 0xb7, 0x0b, 0x68, 0x28, 0x94, 0x26, 0x16, 0x51, 0xb4, 0x61, 0xf5,
-		0x3e, 0xf6, 0xb7, 0x1e, 0x3e, 0x93, 0xec, 0xc6, 0x03, 0x00, 0x76, 0xac, 0xed, 0xa6, 0x13, 0x68,
-		0xa0, 0xc8, 0x7c, 0xc4, 0x96, 0x28, 0x58, 0x52, 0xf3, 0x23, 0x7f, 0x79, 0x6a, 0x0f, 0xd6, 0x24,
-		0xf3, 0x71, 0xb5, 0xef, 0xc0, 0x6c, 0xec, 0xcb, 0x2f, 0x9a, 0x53, 0x0c, 0xe6, 0xbd, 0x54, 0x70,
-		0x5e, 0x6d, 0xc6, 0x4d, 0x36, 0x78, 0xea, 0xbf, 0x28, 0x30, 0xa7, 0x61, 0xa3, 0xd3, 0x69, 0xb1,
-		0xc7, 0x4f, 0xb8, 0xbe, 0x05, 0x18, 0xe1, 0x41, 0x7c, 0x76, 0xdf, 0xf1, 0x5f, 0xf2, 0xa2, 0x7f,
-		0xf1, 0x65, 0x5d, 0x3a, 0xa9, 0x63, 0x7a, 0xbc, 0x57, 0x86, 0xba, 0x08, 0xf3, 0x7d, 0x4b, 0xe3,
-		0x90, 0xf2, 0x57, 0x0a, 0x2c, 0x6b, 0xb8, 0xe9, 0x62, 0x6f, 0x3f, 0xcc, 0x67, 0x10, 0x6d, 0x7c,
-		0x05, 0xd7, 0xae, 0xae, 0xc0, 0x19, 0xb1, 0xa8, 0x7c, 0x2d, 0x5f, 0x0e, 0xc1, 0x82, 0x86, 0x0d,
-		0x73, 0xeb, 0xe1, 0xb3, 0x7e, 0x13, 0xbd, 0x06, 0xa7, 0xc2, 0x3a, 0x82, 0xf2, 0xc6, 0xb9, 0xcc,
-		0x5b, 0xfc, 0xe1, 0x33, 0x0a, 0x6e, 0x74, 0xb0, 0xec, 0xd9, 0x93, 0x7e, 0x38, 0x95, 0x44, 0x0f,
-		0xa7, 0x1d, 0xa8, 0x58, 0x36, 0x19, 0x61, 0x1d, 0x62, 0x1d, 0xdb, 0x21, 0x4e, 0x14, 0x2c, 0xbe,
-		0x9a, 0x0f, 0x89, 0xef, 0xda, 0xc1, 0x81, 0xaf, 0x9b, 0x44, 0xf7, 0x1d, 0xc2, 0xc4, 0xb3, 0xbe,
-		0x60, 0xb7, 0xdc, 0xb0, 0x36, 0x46, 0x1a, 0xb6, 0xad, 0x2f, 0x30, 0xba, 0x08, 0xd3, 0xb4, 0x84,
-		0x80, 0x8e, 0x60, 0x99, 0xee, 0x11, 0x9a, 0xe9, 0xa6, 0x95, 0x05, 0x4f, 0x8d, 0x3d, 0xcc, 0x0a,
-		0xdf, 0xfe, 0x66, 0x08, 0x16, 0x53, 0xca, 0x0a, 0x1d, 0xbe, 0x63, 0x68, 0x4b, 0x78, 0x28, 0x87,
-		0x4e, 0x78, 0x28, 0x91, 0x01, 0x0b, 0x29, 0xae, 0x41, 0xcc, 0x6d, 0x60, 0x9c, 0x99, 0xeb, 0x67,
-		0x4f, 0x63, 0x6e, 0x02, 0x8d, 0x9d, 0x12, 0x69, 0xec, 0xdf, 0x14, 0x58, 0x7c, 0xda, 0x75, 0xf7,
-		0xf0, 0x37, 0xdc, 0xbe, 0xd4, 0x2a, 0x54, 0xd2, 0xeb, 0xe4, 0x67, 0xec, 0xaf, 0x87, 0x60, 0xf1,
-		0x11, 0xfe, 0xe6, 0x2b, 0xe1, 0xff, 0xe7, 0x90, 0xdd, 0x81, 0x4a, 0x5a, 0x59, 0xfc, 0x90, 0x09,
-		0x78, 0x28, 0x22, 0x1e, 0xbf, 0xa9, 0xc0, 0x99, 0xc7, 0x8e, 0x6f, 0x35, 0x7b, 0xe4, 0x7d, 0xeb,
-		0x1c, 0x62, 0xf7, 0x91, 0x41, 0x1e, 0xaf, 0xa1, 0xda, 0x0d, 0x58, 0x68, 0xf2, 0x1e, 0xbd, 0x4d,
-		0xbb, 0xf4, 0x84, 0x73, 0x94, 0x79, 0x44, 0x92, 0xfc, 0x98, 0x7f, 0x34, 0xd7, 0x4c, 0x37, 0x7a,
-		0xea, 0x39, 0x38, 0x9b, 0x21, 0x02, 0x37, 0x0b, 0x03, 0x96, 0xef, 0x63, 0x7f, 0xd3, 0x75, 0x3c,
-		0x8f, 0x6f, 0x4b, 0xe2, 0x16, 0x49, 0xbc, 0xb4, 0x94, 0xbe, 0x97, 0xd6, 0x05, 0x28, 0xfb, 0x86,
-		0xbb, 0x87, 0xfd, 0x70, 0x9b, 0xd9, 0x7d, 0x32, 0xc5, 0x5a, 0x39, 0x3f, 0xf5, 0x7f, 0x4a, 0x70,
-		0x46, 0x3c, 0x07, 0x57, 0x68, 0x9b, 0xf0, 0x21, 0xf0, 0xb0, 0xdb, 0x63, 0xef, 0x3e, 0xbe, 0xfe,
-		0xfb, 0x32, 0x67, 0x2c, 0x93, 0x1d, 0xf5, 0x74, 0xbd, 0x3b, 0x3d, 0xea, 0x6c, 0x31, 0x4f, 0x6c,
-		0xd2, 0x8f, 0x35, 0xa1, 0xef, 0x29, 0x30, 0xdf, 0xa4, 0x49, 0x26, 0xbd, 0x61, 0x74, 0x3d, 0x1c,
-		0x4d, 0xcb, 0x40, 0xef, 0xd1, 0xf1, 0xa6, 0x65, 0x79, 0xab, 0x4d, 0xc2, 0x31, 0x31, 0x39, 0x6a,
-		0xa6, 0x3a, 0xaa, 0xaf, 0x60, 0x36, 0x25, 0xa5, 0xc0, 0x15, 0xbc, 0x97, 0x74, 0x05, 0xdf, 0xcb,
-		0xb2, 0x87, 0x7e, 0xa1, 0xf8, 0xee, 0xc5, 0xfd, 0xc1, 0xea, 0x2b, 0x58, 0xcc, 0x90, 0x50, 0x30,
-		0xf1, 0x67, 0xf1, 0x89, 0xcb, 0xd9, 0x21, 0xd6, 0xfb, 0xd8, 0x8f, 0x52, 0x76, 0x94, 0x71, 0xdc,
-		0x05, 0xfd, 0x0f, 0x05, 0xd6, 0x79, 0x92, 0x2c, 0xa5, 0xb6, 0x54, 0x74, 0x5f, 0xf2, 0x12, 0x2a,
-		0x66, 0x67, 0xe8, 0x05, 0x33, 0xa3, 0xb0, 0x9a, 0x21, 0x08, 0x10, 0x0f, 0xa0, 0x36, 0x5e, 0xc4,
-		0x30, 0xe5, 0xc7, 0x7e, 0x79, 0xe8, 0x3c, 0x4c, 0x35, 0xb1, 0xdf, 0xd8, 0x7f, 0x8c, 0x99, 0xdf,
-		0xc2, 0xb3, 0x3a, 0xc9, 0x46, 0xd5, 0x83, 0xb7, 0x0a, 0x2c, 0x36, 0xac, 0x93, 0x1c, 0x0e, 0x9c,
-		0xdf, 0x63, 0xee, 0x2c, 0x25, 0x57, 0x3f, 0xa0, 0x1f, 0x62, 0x05, 0x87, 0x9b, 0xde, 0x95, 0x05,
-		0x02, 0x52, 0xaa, 0x4f, 0xbf, 0x64, 0x4a, 0x92, 0x71, 0xc9, 0x36, 0x60, 0x3e, 0x4a, 0x67, 0x04,
-		0xc1, 0x8f, 0x2e, 0xaf, 0x4f, 0x1a, 0xd6, 0xa2, 0x5c, 0xc7, 0x36, 0x8b, 0x7c, 0x74, 0x6d, 0x1a,
-		0x8d, 0x0e, 0x3e, 0x15, 0xe4, 0x71, 0x1b, 0x16, 0x94, 0x99, 0xe2, 0xad, 0x2c, 0x6c, 0xb3, 0xf1,
-		0xcf, 0xef, 0x00, 0xf0, 0xc0, 0xcf, 0xed, 0xa7, 0x75, 0xf4, 0x03, 0x05, 0x16, 0xc4, 0x9f, 0x3c,
-		0xa3, 0xeb, 0x99, 0x47, 0x50, 0xfa, 0xd1, 0x75, 0xf5, 0xc6, 0xc0, 0x74, 0x7c, 0xd5, 0xbf, 0xab,
-		0xc0, 0x62, 0xc6, 0xc7, 0xe4, 0x48, 0xc2, 0x54, 0xfa, 0x79, 0x7d, 0xf5, 0xe6, 0xe0, 0x84, 0x5c,
-		0x9c, 0x9f, 0x29, 0xb0, 0x9a, 0xf7, 0x5d, 0x38, 0xfa, 0x2c, 0x8f, 0x7d, 0xde, 0x37, 0xea, 0xd5,
-		0xdb, 0x27, 0xe0, 0xc0, 0x25, 0x25, 0x9b, 0x28, 0xfe, 0xe2, 0x5b, 0xb2, 0x89, 0xd2, 0x2f, 0xcd,
-		0x25, 0x9b, 0x98, 0xf3, 0x69, 0xf9, 0x1f, 0x2b, 0x50, 0xcd, 0xfe, 0x2e, 0x1a, 0x65, 0x97, 0x35,
-		0xe5, 0x7e, 0x2f, 0x5e, 0xfd, 0xe8, 0x58, 0xb4, 0x5c, 0xae, 0x3f, 0x54, 0x60, 0x29, 0xf3, 0xab,
-		0x67, 0xf4, 0x61, 0x26, 0xeb, 0xbc, 0x8f, 0xae, 0xab, 0xb7, 0x8e, 0x43, 0xca, 0x85, 0xb2, 0x61,
-		0x2a, 0xf1, 0x39, 0x2c, 0x7a, 0x37, 0x93, 0x99, 0xe8, 0xab, 0xdb, 0x6a, 0xad, 0xe8, 0x70, 0x3e,
-		0xdf, 0xf7, 0x14, 0x38, 0x2d, 0xf8, 0xa6, 0x14, 0x5d, 0x93, 0xef, 0xb6, 0xf0, 0x2b, 0xd6, 0xea,
-		0xfb, 0x83, 0x11, 0x71, 0x11, 0x7c, 0x98, 0xee, 0xfb, 0x7e, 0x13, 0x5d, 0x91, 0xdd, 0xf5, 0x82,
-		0x38, 0x7f, 0xf5, 0xbd, 0xe2, 0x04, 0x7c, 0xd6, 0x23, 0x98, 0xe9, 0xff, 0x4e, 0x09, 0x65, 0x73,
-		0xc9, 0xf8, 0x92, 0xab, 0x7a, 0x75, 0x00, 0x8a, 0x98, 0xd9, 0x65, 0x16, 0xec, 0x49, 0xcc, 0x2e,
-		0xef, 0x5b, 0x89, 0xea, 0x09, 0xea, 0x03, 0xd1, 0x9f, 0x29, 0x70, 0x46, 0x56, 0xcf, 0x87, 0x3e,
-		0x3e, 0x66, 0x19, 0x20, 0x13, 0xed, 0x93, 0x13, 0x15, 0x11, 0x72, 0x95, 0x65, 0x14, 0xbd, 0x49,
-		0x55, 0x26, 0x2f, 0xb9, 0x93, 0xaa, 0x2c, 0xa7, 0xc6, 0x2e, 0xb6, 0x8f, 0x82, 0x8a, 0xe2, 0xdc,
-		0x7d, 0xcc, 0xae, 0xe5, 0xce, 0xdd, 0x47, 0x59, 0x01, 0x73, 0x6c, 0x1f, 0x85, 0x75, 0x67, 0xf9,
-		0xfb, 0x28, 0xab, 0x7d, 0xcb, 0xdf, 0x47, 0x69, 0xb1, 0x5b, 0x7c, 0x1f, 0xd3, 0xa5, 0x65, 0xf9,
-		0xfb, 0x98, 0x59, 0xd8, 0x96, 0xbf, 0x8f, 0xd9, 0x95, 0x6c, 0xe8, 0x4f, 0x69, 0xc4, 0x2e, 0xb3,
-		0x66, 0x0c, 0x7d, 0x34, 0xd0, 0x9a, 0x93, 0x55, 0x6b, 0xd5, 0x8f, 0x8f, 0x47, 0x9c, 0x10, 0x2d,
-		0xb3, 0x60, 0x52, 0x2a, 0x5a, 0x5e, 0xc9, 0xa6, 0x54, 0xb4, 0xfc, 0x1a, 0xcd, 0x3f, 0x57, 0x60,
-		0x45, 0x5e, 0x29, 0x85, 0xbe, 0x2b, 0x99, 0xa0, 0x40, 0xb9, 0x58, 0xf5, 0xd3, 0x63, 0xd3, 0x73,
-		0x19, 0x7f, 0x5f, 0x81, 0x4a, 0x56, 0xbd, 0x1c, 0xba, 0x29, 0xe1, 0x2e, 0x2d, 0x0c, 0xac, 0x7e,
-		0x78, 0x0c, 0x4a, 0x2e, 0xd1, 0x6f, 0x2b, 0x30, 0x27, 0xaa, 0xba, 0x42, 0xd9, 0x37, 0xa7, 0xa4,
-		0xc6, 0xac, 0xfa, 0xc1, 0x80, 0x54, 0x5c, 0x8a, 0x9f, 0xd0, 0x7f, 0x4d, 0x24, 0x29, 0x3a, 0x42,
-		0x9f, 0xe4, 0xd8, 0x86, 0xbc, 0x24, 0xac, 0xfa, 0xdd, 0xe3, 0x92, 0x73, 0x01, 0xbf, 0x80, 0xd9,
-		0x54, 0xf9, 0x0d, 0xba, 0x2a, 0x61, 0x2a, 0x2e, 0x8b, 0xaa, 0x6e, 0x0c, 0x42, 0x12, 0x79, 0x23,
-		0x7d, 0x05, 0x35, 0x12, 0x6f, 0x44, 0x5c, 0x06, 0x24, 0xf1, 0x46, 0x32, 0x6a, 0x75, 0xd0, 0x01,
-		0x4c, 0xc6, 0xeb, 0x1b, 0xd0, 0x3b, 0x52, 0x0e, 0x7d, 0x15, 0x3d, 0xd5, 0x77, 0x0b, 0x8e, 0x8e,
-		0x59, 0xa1, 0xa8, 0x40, 0x41, 0x62, 0x85, 0x92, 0x1a, 0x0b, 0x89, 0x15, 0x4a, 0xab, 0x20, 0x88,
-		0xe7, 0x29, 0xa8, 0x3b, 0x90, 0x78, 0x9e, 0xd9, 0x45, 0x0c, 0xd5, 0xf7, 0x07, 0x23, 0x0a, 0xbf,
-		0xb5, 0x80, 0x28, 0x8b, 0x8f, 0x2e, 0x67, 0xf2, 0x48, 0x95, 0x06, 0x54, 0xdf, 0x2e, 0x34, 0x36,
-		0x9a, 0x26, 0xca, 0x92, 0x4b, 0xa6, 0x49, 0x95, 0x0e, 0x48, 0xa6, 0x49, 0xa7, 0xdd, 0xd9, 0x34,
-		0x41, 0x8e, 0x5b, 0x3a, 0x4d, 0x5f, 0x6a, 0x5e, 0x3a, 0x4d, 0x7f, 0xd2, 0x9c, 0xbc, 0x50, 0x12,
-		0xe9, 0x69, 0xc9, 0x0b, 0x45, 0x94, 0x5b, 0x97, 0xbc, 0x50, 0xc4, 0x59, 0xef, 0x1f, 0xb0, 0xff,
-		0x6a, 0x23, 0xc8, 0x5f, 0x4a, 0x9e, 0xb2, 0xd2, 0x74, 0xb7, 0xe4, 0x29, 0x9b, 0x93, 0x9f, 0x26,
-		0x0e, 0x4c, 0x66, 0x3a, 0x55, 0xe2, 0xc0, 0xe4, 0xa5, 0x7c, 0x25, 0x0e, 0x4c, 0x7e, 0xf6, 0xd6,
-		0x86, 0xa9, 0x44, 0x2e, 0x52, 0xb2, 0x21, 0xa2, 0x74, 0xac, 0x64, 0x43, 0x84, 0x29, 0x4e, 0x0a,
-		0x1f, 0xa2, 0xbc, 0x21, 0x92, 0x3d, 0xff, 0x32, 0x33, 0xa2, 0x12, 0xf8, 0x90, 0x25, 0x27, 0x09,
-		0x4e, 0xf7, 0xa5, 0xdb, 0x24, 0x38, 0x2d, 0xce, 0x62, 0x4a, 0x70, 0x3a, 0x2b, 0x93, 0x47, 0x5e,
-		0x8d, 0x7d, 0xa9, 0x1c, 0xd9, 0xab, 0x51, 0x9c, 0xdd, 0x92, 0xbd, 0x1a, 0x33, 0xf2, 0x44, 0x64,
-		0xe2, 0xfe, 0xcc, 0x87, 0x64, 0xe2, 0x8c, 0x8c, 0x92, 0x64, 0xe2, 0xcc, 0xb4, 0xca, 0xef, 0x28,
-		0x30, 0x2f, 0xcc, 0x55, 0xa0, 0xec, 0x8d, 0x93, 0xa5, 0x57, 0xaa, 0xd7, 0x07, 0x25, 0x8b, 0x99,
-		0x9d, 0x28, 0xd2, 0x2f, 0x31, 0x3b, 0x49, 0x0a, 0x45, 0x62, 0x76, 0xd2, 0xa4, 0xc8, 0x97, 0x4a,
-		0xf8, 0x75, 0x4c, 0x76, 0x3c, 0x19, 0xdd, 0xce, 0x73, 0xfb, 0x73, 0x03, 0xef, 0xd5, 0x3b, 0x27,
-		0x61, 0x91, 0x88, 0xac, 0xc4, 0xe3, 0xc9, 0xf2, 0xc8, 0x8a, 0x20, 0x60, 0x2d, 0x8f, 0xac, 0x88,
-		0x42, 0xd5, 0x77, 0x3e, 0xfc, 0xd5, 0x1b, 0x7b, 0x96, 0xbf, 0xdf, 0xdd, 0xad, 0x35, 0x9c, 0xf6,
-		0x95, 0xc4, 0xff, 0x0f, 0xae, 0xed, 0x61, 0x9b, 0xfd, 0x23, 0xe8, 0xd8, 0x7f, 0xa2, 0xfe, 0x88,
-		0xff, 0x79, 0x78, 0x75, 0x77, 0x84, 0xf6, 0x5d, 0xfb, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a,
-		0xa2, 0x18, 0x8f, 0xb5, 0x5a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3d, 0x4b, 0x6c, 0x1c, 0x47,
+		0x76, 0xe8, 0xa1, 0xf8, 0x7b, 0x24, 0x87, 0x64, 0x89, 0x9f, 0x51, 0x53, 0x1f, 0xaa, 0xf5, 0x31,
+		0x57, 0xb6, 0x47, 0x3f, 0xeb, 0x63, 0xd9, 0xb2, 0x2d, 0x91, 0x92, 0x3c, 0x86, 0x64, 0x49, 0x4d,
+		0xc6, 0xda, 0x04, 0x0b, 0xf5, 0x36, 0xbb, 0x6b, 0xc8, 0x0e, 0x67, 0xba, 0x47, 0x5d, 0x3d, 0xa4,
+		0xe8, 0x43, 0xe0, 0x7c, 0x10, 0x60, 0x17, 0x41, 0xbe, 0x9b, 0x0f, 0x10, 0x60, 0x81, 0x60, 0x03,
+		0x6c, 0xe2, 0x1c, 0x72, 0xd8, 0xdc, 0x82, 0x3d, 0xe5, 0x94, 0x4b, 0xee, 0x39, 0x65, 0x0f, 0x46,
+		0x0e, 0x09, 0x90, 0x4b, 0x82, 0x9c, 0x83, 0xfa, 0xf4, 0x6f, 0xba, 0xbb, 0xa6, 0x67, 0xb4, 0x0b,
+		0x7b, 0x1d, 0xdf, 0x38, 0x55, 0xef, 0xbd, 0x7a, 0xf5, 0xea, 0xd5, 0xab, 0x57, 0xef, 0xbd, 0x6a,
+		0xc2, 0xb9, 0xee, 0x36, 0xf6, 0x2f, 0x5a, 0xa6, 0x8d, 0x5d, 0x0b, 0x5f, 0xdc, 0x75, 0x48, 0xe0,
+		0xf9, 0x87, 0x17, 0xf7, 0x2f, 0x5f, 0x24, 0xd8, 0xdf, 0x77, 0x2c, 0x5c, 0xef, 0xf8, 0x5e, 0xe0,
+		0xa1, 0x65, 0x0a, 0x56, 0x17, 0x60, 0x75, 0x01, 0x56, 0xdf, 0xbf, 0xac, 0x9e, 0xdc, 0xf1, 0xbc,
+		0x9d, 0x16, 0xbe, 0xc8, 0xc0, 0xb6, 0xbb, 0xcd, 0x8b, 0x76, 0xd7, 0x37, 0x03, 0xc7, 0x73, 0x39,
+		0xa2, 0x7a, 0xaa, 0xb7, 0x3f, 0x70, 0xda, 0x98, 0x04, 0x66, 0xbb, 0x23, 0x00, 0x32, 0x04, 0x0e,
+		0x7c, 0xb3, 0xd3, 0xc1, 0x3e, 0x11, 0xfd, 0xab, 0x29, 0x06, 0xcd, 0x8e, 0x43, 0x99, 0xb3, 0xbc,
+		0x76, 0x3b, 0x1a, 0xe2, 0x74, 0x1e, 0x44, 0xc8, 0xa2, 0xe0, 0x22, 0x0f, 0xe4, 0x45, 0x17, 0x47,
+		0x00, 0x5a, 0x1e, 0x40, 0x60, 0x92, 0xbd, 0x96, 0x43, 0x02, 0x19, 0xcc, 0x81, 0xe7, 0xef, 0x35,
+		0x5b, 0xde, 0x81, 0x80, 0xb9, 0x90, 0x07, 0x23, 0x44, 0x69, 0xf4, 0xc0, 0xae, 0xf5, 0x83, 0xc5,
+		0xbe, 0x80, 0x3c, 0x9b, 0x82, 0x24, 0xbb, 0xa6, 0x8f, 0x6d, 0x26, 0x86, 0x56, 0x97, 0x04, 0x7d,
+		0xa1, 0xd2, 0xa2, 0xd0, 0x0a, 0xa0, 0x5e, 0x74, 0x71, 0x17, 0xe7, 0x72, 0x16, 0xc3, 0xf8, 0xb8,
+		0xd3, 0x72, 0xac, 0xe4, 0xf2, 0x9e, 0x2b, 0x80, 0x4c, 0x4f, 0x55, 0xfb, 0x9b, 0x51, 0x38, 0xb1,
+		0x19, 0x98, 0x7e, 0xf0, 0x4c, 0xb4, 0xdf, 0x7b, 0x89, 0xad, 0x2e, 0xa5, 0xa3, 0xe3, 0x17, 0x5d,
+		0x4c, 0x02, 0xf4, 0x10, 0xc6, 0x7d, 0xfe, 0x67, 0x4d, 0x59, 0x55, 0xd6, 0xa6, 0xae, 0x5c, 0xa9,
+		0xa7, 0x54, 0xce, 0xec, 0x38, 0xf5, 0xfd, 0xcb, 0x75, 0x29, 0x11, 0x3d, 0x24, 0x81, 0x56, 0x60,
+		0xd2, 0xf6, 0xda, 0xa6, 0xe3, 0x1a, 0x8e, 0x5d, 0xab, 0xac, 0x2a, 0x6b, 0x93, 0xfa, 0x04, 0x6f,
+		0x68, 0xd8, 0xe8, 0x3b, 0xb0, 0xd8, 0x31, 0x7d, 0xec, 0x06, 0x06, 0x0e, 0x09, 0x18, 0x8e, 0xdb,
+		0xf4, 0x6a, 0x23, 0x6c, 0xe0, 0xb5, 0xdc, 0x81, 0x9f, 0x30, 0x8c, 0x68, 0xc4, 0x86, 0xdb, 0xf4,
+		0xf4, 0xa3, 0x9d, 0x6c, 0x23, 0xaa, 0xc1, 0xb8, 0x19, 0x04, 0xb8, 0xdd, 0x09, 0x6a, 0x47, 0x56,
+		0x95, 0xb5, 0x51, 0x3d, 0xfc, 0x89, 0xd6, 0x61, 0x16, 0xbf, 0xec, 0x38, 0x7c, 0x7b, 0x18, 0x74,
+		0x1f, 0xd4, 0x46, 0xd9, 0x88, 0x6a, 0x9d, 0xef, 0x81, 0x7a, 0xb8, 0x07, 0xea, 0x5b, 0xe1, 0x26,
+		0xd1, 0xab, 0x31, 0x0a, 0x6d, 0x44, 0x4d, 0x38, 0x66, 0x79, 0x6e, 0xe0, 0xb8, 0x5d, 0x6c, 0x98,
+		0xc4, 0x70, 0xf1, 0x81, 0xe1, 0xb8, 0x4e, 0xe0, 0x98, 0x81, 0xe7, 0xd7, 0xc6, 0x56, 0x95, 0xb5,
+		0xea, 0x95, 0xd7, 0x73, 0x27, 0xb0, 0x2e, 0xb0, 0xee, 0x90, 0x8f, 0xf1, 0x41, 0x23, 0x44, 0xd1,
+		0x97, 0xac, 0xdc, 0x76, 0xd4, 0x80, 0xf9, 0xb0, 0xc7, 0x36, 0x9a, 0xa6, 0xd3, 0xea, 0xfa, 0xb8,
+		0x36, 0xce, 0xd8, 0x3d, 0x9e, 0x4b, 0xff, 0x3e, 0x87, 0xd1, 0xe7, 0x22, 0x34, 0xd1, 0x82, 0x74,
+		0x58, 0x6a, 0x99, 0x24, 0x30, 0x2c, 0xaf, 0xdd, 0x69, 0x61, 0x36, 0x79, 0x1f, 0x93, 0x6e, 0x2b,
+		0xa8, 0x4d, 0x48, 0xe8, 0x3d, 0x31, 0x0f, 0x5b, 0x9e, 0x69, 0xeb, 0x0b, 0x14, 0x77, 0x3d, 0x42,
+		0xd5, 0x19, 0x26, 0xfa, 0x36, 0xac, 0x34, 0x1d, 0x9f, 0x04, 0x86, 0x8d, 0x2d, 0x87, 0x30, 0x79,
+		0x9a, 0x64, 0xcf, 0xd8, 0x36, 0xad, 0x3d, 0xaf, 0xd9, 0xac, 0x4d, 0x32, 0xc2, 0xc7, 0x32, 0x72,
+		0xdd, 0x10, 0xc6, 0x49, 0xaf, 0x31, 0xec, 0x0d, 0x81, 0xbc, 0x65, 0x92, 0xbd, 0xbb, 0x1c, 0x55,
+		0xbb, 0x01, 0x27, 0x8b, 0x94, 0x8c, 0x74, 0x3c, 0x97, 0x60, 0xb4, 0x08, 0x63, 0x7e, 0x97, 0x69,
+		0x96, 0xc2, 0x34, 0x6b, 0xd4, 0xef, 0xba, 0x0d, 0x5b, 0xfb, 0xeb, 0x0a, 0x9c, 0xdc, 0x74, 0x76,
+		0x5c, 0xb3, 0x55, 0xa8, 0xe4, 0x8f, 0x7a, 0x95, 0xfc, 0x6a, 0xbe, 0x92, 0x4b, 0xa9, 0x94, 0xd4,
+		0xf2, 0x26, 0xac, 0xe0, 0x97, 0x01, 0xf6, 0x5d, 0xb3, 0x15, 0x19, 0x9e, 0x58, 0xe1, 0x85, 0xae,
+		0x9f, 0xcf, 0x1d, 0x3f, 0x3b, 0xf2, 0xb1, 0x90, 0x54, 0xa6, 0x0b, 0xd5, 0xe1, 0xa8, 0xb5, 0xeb,
+		0xb4, 0xec, 0x78, 0x10, 0xcf, 0x6d, 0x1d, 0x32, 0xdd, 0x9f, 0xd0, 0xe7, 0x59, 0x57, 0x88, 0xf4,
+		0xd8, 0x6d, 0x1d, 0x6a, 0xa7, 0xe1, 0x54, 0xe1, 0xfc, 0xb8, 0x80, 0xb5, 0xef, 0x29, 0x70, 0x86,
+		0xc3, 0xdc, 0x35, 0x03, 0x6b, 0xb7, 0x50, 0x9c, 0xf7, 0x7a, 0xc5, 0xd9, 0xa3, 0xf9, 0xf1, 0x31,
+		0x55, 0x4f, 0x90, 0x1b, 0x48, 0x8c, 0xda, 0x6f, 0x55, 0x00, 0x65, 0x91, 0xd1, 0x12, 0x8c, 0x71,
+		0x10, 0xa1, 0x03, 0xe2, 0x17, 0xfa, 0x15, 0x40, 0x39, 0xc2, 0xae, 0x0c, 0x24, 0xec, 0xf9, 0x83,
+		0x8c, 0x90, 0xef, 0xc2, 0x38, 0x61, 0x4c, 0x90, 0xda, 0xc8, 0xea, 0x48, 0xd6, 0x48, 0xe5, 0xcf,
+		0xb4, 0x11, 0xe0, 0xb6, 0x1e, 0x22, 0x22, 0x15, 0x26, 0x1c, 0x1b, 0xbb, 0x81, 0x13, 0xf0, 0xd5,
+		0x99, 0xd4, 0xa3, 0xdf, 0xe8, 0x04, 0x80, 0x90, 0x06, 0x95, 0xc1, 0x28, 0xeb, 0x9d, 0x14, 0x2d,
+		0x0d, 0x5b, 0x6b, 0xc2, 0x6c, 0x0f, 0x59, 0x74, 0x0a, 0xa6, 0x38, 0x61, 0xc3, 0x35, 0xdb, 0x58,
+		0x48, 0x01, 0x78, 0xd3, 0xc7, 0x66, 0x1b, 0xa3, 0x2b, 0x30, 0xea, 0xb8, 0x9d, 0x6e, 0x50, 0xab,
+		0x94, 0xd8, 0xe4, 0x1c, 0x54, 0x3b, 0x0f, 0x67, 0xe5, 0xeb, 0x2e, 0x14, 0xe4, 0x87, 0x0a, 0xbc,
+		0x26, 0x94, 0xc8, 0x09, 0x76, 0xe5, 0x07, 0xcb, 0x27, 0xbd, 0x4a, 0xf2, 0xae, 0x6c, 0xcf, 0xf5,
+		0x23, 0x57, 0x52, 0x6b, 0xee, 0xc0, 0x5a, 0x7f, 0x82, 0x72, 0x73, 0xf2, 0x7d, 0x05, 0x4e, 0xe8,
+		0x98, 0xe0, 0x57, 0x3e, 0x32, 0xa5, 0x44, 0x4a, 0xce, 0xe7, 0x06, 0x9c, 0x2c, 0x22, 0x23, 0x9f,
+		0xc5, 0xe7, 0x15, 0x38, 0xbd, 0x85, 0xfd, 0xb6, 0xe3, 0x9a, 0x01, 0x2e, 0x9c, 0xc9, 0x93, 0xde,
+		0x99, 0x5c, 0xcf, 0x9d, 0x49, 0x5f, 0x42, 0xbf, 0xe4, 0xa6, 0xf1, 0x2c, 0x68, 0xb2, 0x29, 0x0a,
+		0xe5, 0xff, 0x43, 0x05, 0x56, 0x37, 0x30, 0xb1, 0x7c, 0x67, 0xbb, 0x58, 0xa2, 0x8f, 0x7b, 0x25,
+		0x7a, 0x2d, 0x77, 0x3a, 0xfd, 0xe8, 0x94, 0x54, 0x8f, 0x9f, 0x1d, 0x81, 0xd3, 0x12, 0x52, 0x42,
+		0x45, 0x5a, 0xb0, 0x1c, 0x3b, 0x5c, 0x96, 0xe7, 0x36, 0x9d, 0x1d, 0x71, 0x1c, 0x4b, 0x4f, 0xc3,
+		0x0c, 0xc1, 0xf5, 0x24, 0xaa, 0xbe, 0x84, 0x73, 0xdb, 0xd1, 0x36, 0x2c, 0x67, 0xd7, 0x96, 0xfb,
+		0x79, 0xdc, 0x22, 0x5d, 0x28, 0x37, 0x1a, 0xf3, 0xf4, 0x16, 0x0f, 0xf2, 0x9a, 0xd1, 0x33, 0x40,
+		0x1d, 0xec, 0xda, 0x8e, 0xbb, 0x63, 0x98, 0x56, 0xe0, 0xec, 0x3b, 0x81, 0x83, 0x0b, 0x2c, 0x74,
+		0x68, 0xf0, 0x38, 0xf8, 0x1d, 0x0e, 0x7d, 0xc8, 0x88, 0xcf, 0x77, 0x52, 0x8d, 0x0e, 0x26, 0xe8,
+		0x57, 0x61, 0x2e, 0x24, 0xcc, 0xd4, 0xc4, 0xc7, 0x6e, 0xed, 0x08, 0x23, 0x5b, 0x97, 0x91, 0x5d,
+		0xa7, 0xb0, 0x69, 0xce, 0x67, 0x3b, 0x89, 0x2e, 0x1f, 0xbb, 0x68, 0x33, 0x26, 0x1d, 0xfa, 0x4e,
+		0xc2, 0x0d, 0x95, 0x72, 0x1c, 0xba, 0x4a, 0x29, 0xa2, 0x61, 0x23, 0xfa, 0x2e, 0x2c, 0x8a, 0x23,
+		0xc8, 0x68, 0x39, 0x6d, 0x27, 0x30, 0x0e, 0x4c, 0xdf, 0x75, 0xdc, 0x1d, 0xe6, 0x91, 0x4e, 0x5d,
+		0x79, 0xa3, 0xf0, 0xb4, 0xfa, 0x90, 0xff, 0xf9, 0x90, 0x22, 0x3d, 0xe3, 0x38, 0xfa, 0xd1, 0xdd,
+		0x6c, 0xa3, 0xf6, 0xd3, 0x0a, 0x1c, 0xcd, 0x01, 0x46, 0x67, 0x60, 0x26, 0x1c, 0xd9, 0xf2, 0xba,
+		0x2e, 0x57, 0xf7, 0x11, 0x7d, 0x5a, 0x34, 0xae, 0xd3, 0x36, 0x74, 0x1a, 0xc2, 0xdf, 0x06, 0x71,
+		0x3e, 0xc5, 0x4c, 0x01, 0x46, 0xf4, 0x29, 0xd1, 0xb6, 0xe9, 0x7c, 0x8a, 0xe9, 0x5e, 0x4d, 0xd1,
+		0xe1, 0xf3, 0x60, 0xb6, 0x60, 0x44, 0x9f, 0x4f, 0x52, 0x63, 0xc3, 0xa3, 0x37, 0x00, 0x25, 0x49,
+		0x0a, 0xf0, 0x23, 0x0c, 0x7c, 0x2e, 0x41, 0x98, 0x43, 0x9f, 0x87, 0x59, 0xbc, 0x8f, 0xdd, 0x80,
+		0x18, 0x1d, 0xec, 0x1b, 0xbb, 0x5e, 0xd7, 0x67, 0x32, 0x57, 0xf4, 0x19, 0xde, 0xfc, 0x04, 0xfb,
+		0x1f, 0x7a, 0x5d, 0x1f, 0x7d, 0x1b, 0x54, 0x4c, 0x02, 0xa7, 0x6d, 0x06, 0xd8, 0x36, 0x08, 0xb6,
+		0x3c, 0xd7, 0x26, 0x46, 0xe0, 0x09, 0xea, 0x5c, 0x98, 0x2b, 0x19, 0xaf, 0xb6, 0xe1, 0x06, 0xd7,
+		0xdf, 0xfa, 0xc4, 0x6c, 0x75, 0xb1, 0xbe, 0x1c, 0xa1, 0x6f, 0x72, 0xec, 0x2d, 0x8f, 0x71, 0xa0,
+		0xbd, 0x84, 0x85, 0xa7, 0xf4, 0xbe, 0x1b, 0xea, 0x77, 0x68, 0x28, 0xd6, 0x7b, 0x0d, 0xc5, 0xb7,
+		0x72, 0xb5, 0x20, 0x0f, 0xb7, 0xa4, 0x71, 0xf8, 0x91, 0x02, 0x8b, 0x3d, 0xe8, 0xc2, 0x20, 0xbc,
+		0x0f, 0xd3, 0xec, 0x0e, 0x1e, 0x5e, 0x07, 0x94, 0x12, 0x9e, 0xc2, 0x14, 0xc3, 0x10, 0xb7, 0x80,
+		0x06, 0x54, 0x43, 0x02, 0xbf, 0x8e, 0xad, 0x00, 0xdb, 0x62, 0x6b, 0x6b, 0xc5, 0x73, 0xd0, 0x05,
+		0xa4, 0x3e, 0xf3, 0x22, 0xf9, 0x53, 0xfb, 0x1d, 0x05, 0x54, 0x76, 0xc4, 0x6d, 0x06, 0x8e, 0xb5,
+		0x77, 0x48, 0x6f, 0x04, 0x0f, 0x1d, 0x12, 0x84, 0x62, 0x6a, 0xf4, 0x8a, 0xe9, 0x62, 0xf1, 0x59,
+		0x9b, 0x4b, 0xa1, 0xa4, 0xb0, 0x4e, 0xc0, 0x4a, 0x2e, 0x0d, 0x61, 0xfb, 0xff, 0x5b, 0x81, 0xa5,
+		0x07, 0x38, 0x78, 0xd4, 0x0d, 0xcc, 0xed, 0x16, 0xde, 0x0c, 0xcc, 0x00, 0xeb, 0x79, 0x64, 0x95,
+		0x9e, 0x13, 0xef, 0x17, 0xe4, 0x96, 0x5e, 0x85, 0x25, 0xfc, 0xb2, 0xc3, 0x04, 0x68, 0xb8, 0xf8,
+		0x65, 0x60, 0x30, 0x6d, 0xa6, 0x0c, 0xf0, 0x7d, 0x73, 0x34, 0xec, 0xfd, 0x18, 0xbf, 0x0c, 0xee,
+		0xd1, 0xbe, 0x86, 0x8d, 0x2e, 0xc1, 0x82, 0xd5, 0xf5, 0xd9, 0xfd, 0x7b, 0xdb, 0x37, 0x5d, 0x6b,
+		0xd7, 0x08, 0xbc, 0x3d, 0x66, 0xdf, 0x94, 0xb5, 0x69, 0x1d, 0x89, 0xbe, 0xbb, 0xac, 0x6b, 0x8b,
+		0xf6, 0x68, 0x3f, 0x98, 0x84, 0xe5, 0xcc, 0xac, 0x85, 0x0e, 0xe5, 0xcf, 0x4c, 0x79, 0xd5, 0x99,
+		0xdd, 0x87, 0x99, 0x88, 0x6c, 0x70, 0xd8, 0xc1, 0x42, 0x56, 0xa7, 0xa5, 0x14, 0xb7, 0x0e, 0x3b,
+		0x58, 0x9f, 0x3e, 0x48, 0xfc, 0x42, 0x1a, 0xcc, 0xe4, 0x09, 0x66, 0xca, 0x4d, 0x08, 0xe4, 0x13,
+		0x38, 0xd6, 0xf1, 0xf1, 0xbe, 0xe3, 0x75, 0x89, 0x41, 0xa8, 0xaf, 0x88, 0xed, 0x18, 0xfe, 0x48,
+		0xff, 0x3d, 0xbf, 0x14, 0x62, 0x6f, 0x72, 0xe4, 0x90, 0xee, 0x9b, 0x70, 0x94, 0xdd, 0xbb, 0xf9,
+		0x45, 0x39, 0xa2, 0x38, 0xca, 0x6d, 0x14, 0xed, 0xba, 0x4f, 0x7b, 0x42, 0xf0, 0x5b, 0x30, 0xc9,
+		0xee, 0xd0, 0x2d, 0x87, 0x84, 0xa6, 0xe6, 0x44, 0xbe, 0x1b, 0x16, 0x6a, 0xe5, 0x44, 0x20, 0xfe,
+		0x42, 0x0f, 0x60, 0x8e, 0x30, 0x8d, 0x35, 0x62, 0x12, 0xe3, 0x65, 0x48, 0x54, 0x49, 0x4a, 0xd1,
+		0xd1, 0x5b, 0xb0, 0x64, 0xb5, 0x1c, 0xcc, 0xec, 0xef, 0xb6, 0x6f, 0xfa, 0x87, 0xc6, 0x3e, 0xf6,
+		0xd9, 0x19, 0x35, 0xc1, 0x54, 0x7a, 0x81, 0xf7, 0x3e, 0xe4, 0x9d, 0x9f, 0xf0, 0xbe, 0x04, 0x56,
+		0x13, 0x9b, 0x41, 0xd7, 0xc7, 0x11, 0xd6, 0x64, 0x12, 0xeb, 0x3e, 0xef, 0x0c, 0xb1, 0x4e, 0xc1,
+		0x94, 0xc0, 0x72, 0xda, 0x9d, 0x56, 0x0d, 0x18, 0x28, 0xf0, 0xa6, 0x46, 0xbb, 0xd3, 0x42, 0x04,
+		0x2e, 0xf4, 0xce, 0xca, 0x20, 0xd6, 0x2e, 0xb6, 0xbb, 0x2d, 0x4c, 0xad, 0x32, 0x5b, 0x2c, 0x16,
+		0xc8, 0xf1, 0xba, 0x41, 0x6d, 0xaa, 0x5f, 0xcc, 0xe1, 0x6c, 0x7a, 0xae, 0x9b, 0x82, 0xd2, 0x96,
+		0xc7, 0xd6, 0x6d, 0x8b, 0x93, 0xa1, 0x07, 0x11, 0x5f, 0x2a, 0x7a, 0x84, 0xc4, 0x13, 0x99, 0x66,
+		0xb1, 0xa4, 0x79, 0xd6, 0xb5, 0x19, 0x78, 0xf1, 0x2c, 0x8a, 0xb6, 0xd3, 0x4c, 0xd1, 0x76, 0x42,
+		0x0f, 0xa1, 0x1a, 0xe9, 0x36, 0xa1, 0x9b, 0xa9, 0x56, 0x65, 0x71, 0xa3, 0x73, 0xe9, 0xa5, 0xe2,
+		0xc1, 0xbc, 0xa4, 0x7e, 0xf3, 0x9d, 0x37, 0x73, 0x90, 0xfc, 0x89, 0x2c, 0x58, 0x88, 0xa8, 0x59,
+		0x2d, 0x8f, 0x60, 0x41, 0x73, 0x96, 0xd1, 0xbc, 0x5c, 0xd2, 0xa5, 0xa3, 0x88, 0x94, 0x5e, 0x97,
+		0xe8, 0xd1, 0x7e, 0x8e, 0x1a, 0xe9, 0x2e, 0x9f, 0x17, 0x82, 0x30, 0xf8, 0xd9, 0x4a, 0xfd, 0xac,
+		0xb9, 0x3c, 0xaf, 0x25, 0xe6, 0x5a, 0x08, 0xe8, 0xc3, 0x10, 0x5e, 0x9f, 0xdb, 0xef, 0x69, 0x41,
+		0xef, 0xc2, 0x8a, 0x43, 0x0c, 0xbe, 0x2c, 0x89, 0x35, 0xc6, 0x2e, 0xb5, 0x33, 0x76, 0x6d, 0x9e,
+		0x39, 0xea, 0xcb, 0x0e, 0x49, 0x5b, 0xe3, 0x7b, 0xbc, 0x5b, 0xfb, 0x1f, 0x05, 0x96, 0x9f, 0x78,
+		0xad, 0xd6, 0xff, 0x33, 0x6b, 0xfc, 0xe3, 0x09, 0xa8, 0x65, 0xa7, 0xfd, 0x8d, 0x39, 0xfe, 0xc6,
+		0x1c, 0x7f, 0x1d, 0xcd, 0x71, 0xd1, 0xfe, 0x98, 0x2e, 0x34, 0xaf, 0xb9, 0xb6, 0x6a, 0xe6, 0x95,
+		0x6d, 0xd5, 0x2f, 0x9f, 0xd5, 0xd6, 0xfe, 0xa9, 0x02, 0xab, 0x3a, 0xb6, 0x3c, 0xdf, 0x4e, 0x06,
+		0xda, 0xc5, 0xb6, 0xf8, 0x32, 0x2d, 0x25, 0x0d, 0x5e, 0x86, 0x8a, 0x13, 0x19, 0x01, 0x08, 0x9b,
+		0x1a, 0x36, 0x5a, 0x86, 0x71, 0xa6, 0x63, 0x62, 0xc7, 0x8f, 0xe8, 0x63, 0xf4, 0x67, 0xc3, 0xee,
+		0x13, 0x28, 0x45, 0x3a, 0x4c, 0x77, 0xbc, 0x56, 0xcb, 0x10, 0x2d, 0xb5, 0x31, 0xc9, 0x5d, 0x81,
+		0xda, 0xd0, 0xfb, 0x9e, 0x9f, 0x14, 0x4d, 0x78, 0x57, 0x98, 0xa2, 0x44, 0xc4, 0x0f, 0xed, 0xdf,
+		0xc6, 0xe1, 0xb4, 0x44, 0x8a, 0xc2, 0xf0, 0x66, 0x2c, 0xa4, 0x32, 0x9c, 0x85, 0x94, 0x5a, 0xbf,
+		0xca, 0xf0, 0xd6, 0xef, 0x0d, 0x40, 0xa1, 0x7c, 0xed, 0x5e, 0xf3, 0x3b, 0x17, 0xf5, 0x84, 0xd0,
+		0x6b, 0xd4, 0x80, 0xe5, 0x98, 0xde, 0x11, 0xbd, 0x2a, 0xda, 0x43, 0xc8, 0x8c, 0x45, 0x1f, 0xcd,
+		0x5a, 0xf4, 0x44, 0x4a, 0x6e, 0x2c, 0x9d, 0x92, 0xbb, 0x09, 0x35, 0x61, 0x52, 0xe2, 0x10, 0x51,
+		0x78, 0xfa, 0x8f, 0xb3, 0xd3, 0x7f, 0x89, 0xf7, 0x47, 0xba, 0x23, 0x0e, 0x7f, 0xa4, 0xc3, 0x4c,
+		0x94, 0x7a, 0x62, 0x41, 0x25, 0x9e, 0xcb, 0x7a, 0xb3, 0x68, 0x37, 0x6e, 0xf9, 0xa6, 0x4b, 0xa8,
+		0x29, 0x4b, 0x05, 0x52, 0xa6, 0xed, 0xc4, 0x2f, 0xf4, 0x1c, 0x8e, 0xe7, 0x84, 0xac, 0x62, 0x13,
+		0x3e, 0x59, 0xc6, 0x84, 0x1f, 0xcb, 0xa8, 0x7b, 0xd8, 0x55, 0xe4, 0x5a, 0x42, 0x91, 0x6b, 0x79,
+		0x1a, 0xa6, 0x53, 0x36, 0x6f, 0x8a, 0xd9, 0xbc, 0xa9, 0xed, 0x84, 0xb1, 0xbb, 0x03, 0xd5, 0x78,
+		0x59, 0x59, 0x4a, 0x73, 0xba, 0x6f, 0x4a, 0x73, 0x26, 0xc2, 0xa0, 0x6d, 0xe8, 0x36, 0x4c, 0x87,
+		0x6b, 0xcd, 0x08, 0xcc, 0xf4, 0x25, 0x30, 0x25, 0xe0, 0x19, 0xba, 0x09, 0xe3, 0xf4, 0x26, 0x4f,
+		0x8d, 0x6c, 0x95, 0x45, 0xc8, 0x1e, 0x14, 0x06, 0x9b, 0xfa, 0xee, 0x22, 0x16, 0x22, 0x70, 0x30,
+		0xb9, 0xe7, 0x06, 0xfe, 0xa1, 0x1e, 0xd2, 0x55, 0x9f, 0xc3, 0x74, 0xb2, 0x03, 0xcd, 0xc1, 0xc8,
+		0x1e, 0x3e, 0x14, 0xc6, 0x8a, 0xfe, 0x89, 0x6e, 0xc2, 0xe8, 0x3e, 0x55, 0x7f, 0x69, 0xfc, 0x21,
+		0xdc, 0x75, 0x3c, 0x0e, 0xc1, 0x11, 0x6e, 0x55, 0x6e, 0x2a, 0x09, 0x3b, 0x19, 0xc6, 0x05, 0xbf,
+		0xb1, 0x93, 0x19, 0x3b, 0x99, 0x14, 0x4d, 0xae, 0x9d, 0xfc, 0x62, 0x24, 0xb4, 0x93, 0xb9, 0x52,
+		0x14, 0x76, 0xf2, 0x23, 0x98, 0xed, 0xb1, 0x43, 0x52, 0x4b, 0x29, 0x42, 0x8e, 0xcc, 0x92, 0xe8,
+		0xd5, 0xb4, 0x9d, 0xca, 0x68, 0x6e, 0x65, 0x30, 0xcd, 0x4d, 0x98, 0xa5, 0x91, 0xb4, 0x59, 0x7a,
+		0x0e, 0x27, 0xd3, 0xbb, 0xca, 0xf0, 0x9a, 0x46, 0xb0, 0xeb, 0x10, 0x23, 0x59, 0x5a, 0x20, 0x1f,
+		0x4a, 0x4d, 0xed, 0xb2, 0xc7, 0xcd, 0xad, 0x5d, 0x87, 0xdc, 0x11, 0xf4, 0x1b, 0x30, 0xbf, 0x8b,
+		0x4d, 0x3f, 0xd8, 0xc6, 0x66, 0x60, 0xd8, 0x38, 0x30, 0x9d, 0x16, 0xa9, 0x8d, 0x96, 0x88, 0xbe,
+		0xcd, 0x45, 0x68, 0x1b, 0x1c, 0x2b, 0x7b, 0xee, 0x8c, 0x0d, 0x77, 0xee, 0xbc, 0x06, 0xb3, 0x11,
+		0x1d, 0x91, 0x59, 0x1d, 0x67, 0x5a, 0x13, 0x79, 0x3d, 0x1b, 0xac, 0x55, 0xfb, 0x33, 0x05, 0xce,
+		0xf0, 0xd5, 0x4c, 0xed, 0x64, 0x51, 0x21, 0x10, 0xef, 0x17, 0xbd, 0x37, 0x62, 0x77, 0xb3, 0x28,
+		0x62, 0xd7, 0x8f, 0x54, 0xc9, 0xd0, 0xdd, 0x4f, 0x46, 0xe0, 0xac, 0x9c, 0x9a, 0x50, 0x41, 0x1c,
+		0x1f, 0x6e, 0xbe, 0x68, 0x13, 0x2c, 0xde, 0x1a, 0xde, 0x74, 0xe9, 0xb3, 0xa4, 0x47, 0xd3, 0x7f,
+		0xa4, 0xc0, 0xc9, 0x38, 0x2b, 0x41, 0x1d, 0x64, 0xdb, 0x21, 0x1d, 0x9a, 0x58, 0x35, 0x5a, 0x9e,
+		0x65, 0xb6, 0x5a, 0x87, 0xb5, 0x0a, 0x33, 0x98, 0xcf, 0x25, 0xa3, 0xf6, 0x9f, 0x4e, 0x3d, 0x4e,
+		0x5b, 0x6c, 0x79, 0x1b, 0x62, 0x84, 0x87, 0x7c, 0x00, 0x6e, 0x47, 0x57, 0xcc, 0x62, 0x08, 0xf5,
+		0x37, 0x60, 0xb5, 0x1f, 0x81, 0x1c, 0x7b, 0xbb, 0x91, 0xb6, 0xb7, 0xf9, 0x49, 0x91, 0xd0, 0x0c,
+		0x30, 0x5a, 0x21, 0x61, 0x76, 0xec, 0x26, 0x6c, 0x2f, 0xcd, 0xa6, 0xe5, 0x4c, 0x93, 0xd6, 0xae,
+		0x60, 0x7b, 0xc0, 0x6c, 0x5a, 0x3f, 0x3a, 0x25, 0x15, 0xe9, 0x0c, 0x9c, 0x96, 0x50, 0x12, 0x91,
+		0xe0, 0x1f, 0x28, 0xa0, 0x65, 0xad, 0xdd, 0x87, 0xe1, 0xf6, 0x0c, 0x39, 0x7f, 0xda, 0xcb, 0xf9,
+		0x8d, 0x02, 0xce, 0xfb, 0x51, 0x2a, 0xc9, 0xfb, 0x13, 0x38, 0x23, 0xa5, 0x25, 0x74, 0xf3, 0x5b,
+		0x30, 0x67, 0x99, 0xae, 0x85, 0xa3, 0x13, 0x00, 0xf3, 0x33, 0x6d, 0x42, 0x9f, 0xe5, 0xed, 0x7a,
+		0xd8, 0x9c, 0xdc, 0xef, 0x49, 0x9a, 0xaf, 0xb8, 0xdf, 0x65, 0xa4, 0x4a, 0x4e, 0xf5, 0x3c, 0x9c,
+		0x95, 0x13, 0x4b, 0xe4, 0x6b, 0x73, 0x00, 0x5f, 0x45, 0xc3, 0x0a, 0xe9, 0x0c, 0xac, 0x61, 0x79,
+		0x94, 0x52, 0x1a, 0x96, 0x9d, 0x20, 0x5b, 0x1f, 0x6c, 0x0f, 0xac, 0x61, 0xfd, 0x28, 0x95, 0xe4,
+		0xfd, 0x1c, 0x9c, 0x91, 0xd2, 0x12, 0xdc, 0xff, 0x83, 0x02, 0xa7, 0x74, 0xdc, 0xf6, 0xf6, 0x31,
+		0x2f, 0xc4, 0xf8, 0xaa, 0x04, 0xe9, 0xd2, 0x8e, 0xd1, 0x48, 0x6f, 0xa5, 0x8d, 0x06, 0xab, 0xc5,
+		0x5c, 0x8b, 0xa9, 0xfd, 0x63, 0x05, 0xce, 0x89, 0x29, 0xf0, 0x69, 0x17, 0x56, 0x01, 0x48, 0x27,
+		0x68, 0x42, 0x35, 0xbd, 0x07, 0x6b, 0x95, 0xbc, 0x43, 0x28, 0x5a, 0xbf, 0x12, 0x03, 0xea, 0x33,
+		0xa9, 0xdd, 0x4b, 0x73, 0xf0, 0x51, 0xa1, 0x45, 0x6e, 0xad, 0x65, 0x7e, 0x0e, 0xfe, 0x9e, 0xc0,
+		0xe9, 0xc9, 0xc1, 0xe3, 0xbc, 0xe6, 0x81, 0x8b, 0x2c, 0xd6, 0xe0, 0x7c, 0xbf, 0xb9, 0x08, 0x39,
+		0xff, 0x54, 0x81, 0x95, 0x30, 0x2a, 0x94, 0x73, 0x4b, 0xff, 0x52, 0xd4, 0xe7, 0x02, 0xcc, 0x3b,
+		0xc4, 0x48, 0x97, 0x3e, 0x32, 0x59, 0x4e, 0xe8, 0xb3, 0x0e, 0xb9, 0x9f, 0x2c, 0x6a, 0xd4, 0x4e,
+		0xc2, 0xf1, 0x7c, 0xf6, 0xc5, 0xfc, 0xbe, 0xa8, 0xc0, 0x59, 0x6e, 0xac, 0xd3, 0x75, 0x03, 0x19,
+		0xd3, 0xfa, 0x65, 0x4c, 0xf4, 0x34, 0x4c, 0x8b, 0xba, 0x56, 0x6c, 0x27, 0x02, 0xb5, 0x51, 0x5b,
+		0xc3, 0x46, 0xcf, 0xe0, 0xa8, 0x15, 0xb2, 0x9a, 0x18, 0xfa, 0xc8, 0x40, 0x43, 0xa3, 0x88, 0x44,
+		0x3c, 0xf6, 0x43, 0x98, 0x4b, 0xd4, 0xaa, 0xf2, 0x4b, 0xc2, 0x68, 0xd9, 0x4b, 0xc2, 0x6c, 0x8c,
+		0xca, 0x1a, 0xb4, 0xd7, 0xe0, 0x5c, 0x1f, 0x29, 0x8b, 0xf5, 0xf8, 0x8f, 0x0a, 0xd4, 0x74, 0x51,
+		0x61, 0x8d, 0x19, 0x2e, 0xf9, 0xe4, 0xca, 0x97, 0xb9, 0x06, 0xcf, 0x61, 0x31, 0x1d, 0xc9, 0x3c,
+		0x34, 0x9c, 0x00, 0xb7, 0xc3, 0x0a, 0x97, 0x0b, 0xa5, 0xa2, 0x99, 0x87, 0xac, 0x0a, 0xf1, 0xe8,
+		0x7e, 0xa6, 0x8d, 0xa0, 0x6b, 0x30, 0xc6, 0x84, 0x4b, 0x6a, 0x47, 0x24, 0x91, 0x8d, 0x0d, 0x33,
+		0x30, 0xef, 0xb6, 0xbc, 0x6d, 0x5d, 0x00, 0xa3, 0x75, 0xa8, 0xd2, 0xb2, 0x67, 0x5a, 0x6e, 0x26,
+		0xd0, 0x47, 0xcb, 0xa0, 0x4f, 0xbb, 0xf8, 0x40, 0xef, 0xf2, 0x45, 0x21, 0xda, 0x0a, 0x1c, 0xcb,
+		0x91, 0xb5, 0x58, 0x89, 0xef, 0x2b, 0xb0, 0xb4, 0x79, 0xe8, 0x5a, 0x9b, 0xbb, 0xa6, 0x6f, 0x8b,
+		0x00, 0xa7, 0x58, 0x87, 0x73, 0x50, 0x25, 0x5e, 0xd7, 0xb7, 0xb0, 0x21, 0x8a, 0xef, 0xc5, 0x62,
+		0xcc, 0xf0, 0xd6, 0x75, 0xde, 0x88, 0x8e, 0xc1, 0x04, 0x95, 0x87, 0x1d, 0x9e, 0x60, 0xa3, 0xfa,
+		0x38, 0xfb, 0xdd, 0xb0, 0x51, 0x1d, 0x8e, 0xb0, 0xdb, 0xe2, 0x48, 0xdf, 0x2b, 0x1c, 0x83, 0xd3,
+		0x8e, 0xc1, 0x72, 0x86, 0x17, 0xc1, 0xe7, 0x3f, 0x8f, 0xc2, 0x51, 0xda, 0x17, 0x9e, 0x84, 0x5f,
+		0xa6, 0xb2, 0xd4, 0x60, 0x3c, 0x0c, 0x28, 0xf1, 0xbd, 0x1a, 0xfe, 0xa4, 0x5b, 0x39, 0xbe, 0xcd,
+		0x46, 0x91, 0x82, 0x28, 0xb2, 0x40, 0x65, 0x92, 0x0d, 0x23, 0x8d, 0x0e, 0x1a, 0x46, 0x3a, 0x01,
+		0x10, 0xde, 0xaa, 0x1c, 0x9b, 0xdd, 0x42, 0x47, 0xf4, 0x49, 0xd1, 0xd2, 0xb0, 0x33, 0x77, 0xf5,
+		0xf1, 0xc1, 0xee, 0xea, 0x1f, 0x89, 0xe4, 0x4d, 0x7c, 0x6d, 0x66, 0x54, 0x26, 0xfa, 0x52, 0x99,
+		0xa7, 0x68, 0x91, 0x03, 0xcc, 0x68, 0x5d, 0x87, 0xf1, 0xf0, 0xce, 0x3d, 0x59, 0xe2, 0xce, 0x1d,
+		0x02, 0x27, 0xe3, 0x05, 0x90, 0x8e, 0x17, 0xbc, 0x0f, 0xd3, 0x3c, 0xb5, 0x24, 0xea, 0xf4, 0xa7,
+		0x4a, 0xd4, 0xe9, 0x4f, 0xb1, 0x8c, 0x13, 0xff, 0x41, 0xb3, 0x1c, 0x8c, 0x00, 0x7f, 0x75, 0x62,
+		0x44, 0x75, 0xc2, 0xd3, 0x4c, 0x77, 0x10, 0xed, 0x7b, 0xc6, 0xba, 0x1a, 0xa2, 0x07, 0x3d, 0x86,
+		0xd9, 0x1e, 0xdb, 0x50, 0x9b, 0xc9, 0x53, 0xa1, 0x22, 0xab, 0xa0, 0x57, 0xd3, 0x16, 0x41, 0x5b,
+		0x82, 0x85, 0xb4, 0x2a, 0x0b, 0x1d, 0xff, 0x23, 0x05, 0x56, 0xc2, 0xda, 0xc2, 0xaf, 0x88, 0x13,
+		0xa7, 0xfd, 0xbe, 0x02, 0xc7, 0xf3, 0x79, 0x12, 0xf7, 0x9b, 0xab, 0xb0, 0xd4, 0xe6, 0xed, 0x3c,
+		0xaf, 0x62, 0x38, 0xae, 0x61, 0x99, 0xd6, 0x6e, 0x58, 0x28, 0x7d, 0xb4, 0x9d, 0xc0, 0x6a, 0xb8,
+		0xeb, 0xb4, 0x0b, 0xbd, 0x0d, 0xc7, 0x32, 0x48, 0xb6, 0x19, 0x98, 0xdb, 0x26, 0xc1, 0xc2, 0x0d,
+		0x5e, 0x4a, 0xe3, 0x6d, 0x88, 0x5e, 0xed, 0x38, 0xa8, 0x21, 0x3f, 0x42, 0x9e, 0x1f, 0x7a, 0x51,
+		0xe9, 0x91, 0xf6, 0xb3, 0x0a, 0xac, 0xe4, 0x76, 0x0b, 0x6e, 0xd7, 0x60, 0xce, 0xed, 0xb6, 0xb7,
+		0xb1, 0x4f, 0xc3, 0x4c, 0xcc, 0x4c, 0x11, 0xc6, 0xe7, 0xa8, 0x5e, 0xe5, 0xed, 0x8f, 0x9b, 0xcc,
+		0xfa, 0x10, 0x2a, 0xec, 0xd0, 0xac, 0x11, 0x16, 0x3d, 0x18, 0xd5, 0x27, 0x84, 0x5d, 0x23, 0xe8,
+		0x23, 0x98, 0x16, 0x2b, 0xc1, 0xa7, 0xca, 0x0d, 0xdc, 0x6b, 0x45, 0xfa, 0xc0, 0xe3, 0x39, 0x6c,
+		0xea, 0xcc, 0xbf, 0x9b, 0xb2, 0xe3, 0x06, 0x74, 0x1d, 0x96, 0xf9, 0x40, 0x96, 0xe7, 0x06, 0xbe,
+		0xd7, 0x6a, 0x61, 0x9f, 0x09, 0xa5, 0x4b, 0x44, 0xed, 0xfa, 0x22, 0xeb, 0x5e, 0x8f, 0x7a, 0xb9,
+		0x65, 0x64, 0x7b, 0xc4, 0xb6, 0x7d, 0x4c, 0x88, 0x08, 0x3a, 0x86, 0x3f, 0xd1, 0x77, 0x00, 0x75,
+		0xf8, 0x8e, 0xe2, 0x05, 0x7b, 0x94, 0x1a, 0xa9, 0x8d, 0xe5, 0x15, 0x55, 0xf6, 0xf2, 0x28, 0x76,
+		0x22, 0x2d, 0xe7, 0xa3, 0xc3, 0x10, 0x7d, 0xae, 0xd3, 0xd3, 0xa2, 0xd5, 0x61, 0x9e, 0x27, 0xbe,
+		0x28, 0x57, 0xa1, 0x6a, 0x26, 0x0f, 0x01, 0x25, 0x75, 0x08, 0x68, 0x0b, 0x80, 0x92, 0xf0, 0x42,
+		0xd7, 0xff, 0x4b, 0x81, 0x79, 0xee, 0xfe, 0x27, 0xfd, 0xcc, 0x62, 0x32, 0xe8, 0xb6, 0x48, 0x12,
+		0x47, 0x39, 0xf1, 0xea, 0x95, 0xd5, 0xc2, 0x0c, 0x84, 0x49, 0xf6, 0x58, 0xe0, 0x6d, 0x22, 0x10,
+		0x7f, 0x25, 0xc3, 0xb7, 0x23, 0xa9, 0xf0, 0xed, 0x3a, 0xcc, 0xee, 0x3b, 0xc4, 0xd9, 0x76, 0x5a,
+		0x4e, 0x70, 0xc8, 0x4d, 0x5d, 0xff, 0x88, 0x63, 0x35, 0x46, 0xa1, 0x8d, 0xd4, 0xee, 0x8b, 0x33,
+		0x92, 0xbf, 0x11, 0xe0, 0x0b, 0x32, 0x25, 0xda, 0xe8, 0x23, 0x01, 0x2a, 0x86, 0xe4, 0x7c, 0xe3,
+		0x1b, 0xf3, 0xbc, 0x8e, 0x09, 0x0e, 0x9e, 0x76, 0x71, 0x17, 0x97, 0x10, 0x43, 0xef, 0x48, 0x95,
+		0xcc, 0x48, 0x69, 0x49, 0x8d, 0x0c, 0x2a, 0x29, 0xce, 0x68, 0xcc, 0x91, 0x60, 0xf4, 0x4f, 0x14,
+		0x58, 0x08, 0x37, 0xd6, 0x57, 0x87, 0xd7, 0xc7, 0xb0, 0xd8, 0xc3, 0x94, 0xd8, 0xe7, 0xd7, 0x61,
+		0xb9, 0xe3, 0x7b, 0x16, 0x26, 0x84, 0x56, 0xff, 0xb2, 0x07, 0x7f, 0xdc, 0xd2, 0xd0, 0xed, 0x3e,
+		0x42, 0x37, 0x55, 0xdc, 0xcd, 0x30, 0x99, 0x99, 0x21, 0xda, 0x3b, 0x70, 0xf2, 0x01, 0x0e, 0xf4,
+		0xf8, 0xf1, 0xdf, 0x1d, 0x6b, 0x8f, 0x77, 0x95, 0xd0, 0xf4, 0x36, 0x9c, 0x2a, 0x44, 0x8e, 0x62,
+		0xf2, 0x60, 0x5a, 0x7b, 0x49, 0x56, 0x32, 0x4f, 0x79, 0xe2, 0x09, 0xe7, 0x50, 0xd2, 0x27, 0xcd,
+		0x90, 0xa6, 0xf6, 0x85, 0x02, 0x27, 0xd2, 0xe3, 0x3d, 0xc2, 0x84, 0x98, 0x3b, 0x31, 0xaf, 0x1f,
+		0xc0, 0x18, 0x4b, 0x67, 0x85, 0x23, 0xad, 0x95, 0x18, 0x89, 0x25, 0xbb, 0x74, 0x81, 0x57, 0x66,
+		0x09, 0x9f, 0xc3, 0x12, 0xe9, 0x76, 0x3a, 0x1e, 0x73, 0x38, 0xe8, 0x8d, 0xc0, 0xa7, 0x62, 0xf5,
+		0x5c, 0xee, 0x3b, 0x57, 0x8b, 0xad, 0xe2, 0x7a, 0x0c, 0xcb, 0x96, 0x75, 0x31, 0x22, 0x93, 0xe8,
+		0x21, 0xf4, 0x59, 0xd2, 0xc9, 0xa2, 0x69, 0x0a, 0xa9, 0xbe, 0x80, 0x2a, 0x5f, 0x93, 0xb6, 0xe8,
+		0x11, 0xf3, 0xfd, 0xa8, 0x30, 0xdc, 0x2b, 0x27, 0x58, 0x67, 0xb6, 0x2a, 0x6c, 0xe5, 0xa1, 0xdd,
+		0x19, 0x92, 0x6c, 0x53, 0xdb, 0x80, 0xb2, 0x40, 0xc9, 0xf0, 0xed, 0x28, 0x0f, 0xdf, 0xde, 0x49,
+		0x87, 0x6f, 0xcb, 0xac, 0x75, 0xc4, 0x4d, 0x22, 0x76, 0xfb, 0x2f, 0x0a, 0xac, 0x3e, 0xc0, 0xc1,
+		0xc6, 0xc3, 0xa7, 0x92, 0xe5, 0xfe, 0x08, 0x80, 0xdb, 0x38, 0xb7, 0xe9, 0x0d, 0xa2, 0x5c, 0x74,
+		0x63, 0xb1, 0x73, 0x69, 0x32, 0x10, 0x7f, 0x11, 0xc9, 0xaa, 0x56, 0x7e, 0x2e, 0xab, 0x7a, 0x08,
+		0xa7, 0x25, 0xf3, 0x11, 0xeb, 0xba, 0x05, 0xf3, 0x89, 0x77, 0xb8, 0x2c, 0x3d, 0x1c, 0xce, 0xeb,
+		0xb5, 0x92, 0xf3, 0xd2, 0xe7, 0xfc, 0x74, 0x03, 0xd1, 0xfe, 0x55, 0x81, 0x05, 0x1d, 0x9b, 0x9d,
+		0x4e, 0x8b, 0xdf, 0x63, 0x49, 0xbf, 0x97, 0x6e, 0xd2, 0x17, 0x36, 0xf9, 0x7e, 0xd7, 0xc8, 0xab,
+		0xde, 0x31, 0x86, 0xbb, 0x30, 0x6a, 0xcb, 0xb0, 0xd8, 0x33, 0x35, 0x61, 0xbf, 0xff, 0x56, 0xa1,
+		0xe5, 0xd6, 0x4d, 0x1f, 0x93, 0xe8, 0xb1, 0x19, 0x93, 0xc6, 0x57, 0x70, 0xee, 0x34, 0x9a, 0x93,
+		0xcf, 0xaa, 0x98, 0xcb, 0xe7, 0x15, 0x58, 0xd2, 0xb1, 0x69, 0x6f, 0x3c, 0x7c, 0xda, 0xbb, 0x05,
+		0xae, 0xc2, 0x91, 0xa8, 0x24, 0xa4, 0x7a, 0xe5, 0x54, 0xa1, 0xb3, 0xf3, 0xf0, 0x29, 0x53, 0x4e,
+		0x06, 0x2c, 0xbb, 0xc1, 0x66, 0xef, 0xc0, 0x23, 0x79, 0x77, 0xe0, 0x2d, 0xa8, 0x39, 0x2e, 0x85,
+		0x70, 0xf6, 0xb1, 0x81, 0xdd, 0xc8, 0x10, 0x95, 0xac, 0xa3, 0x5b, 0x8c, 0x90, 0xef, 0xb9, 0xa1,
+		0x45, 0x69, 0xd8, 0x54, 0xf6, 0x1d, 0x4a, 0x84, 0x3d, 0xe4, 0x18, 0x65, 0x8c, 0x4d, 0xd0, 0x06,
+		0xf6, 0x8a, 0xe3, 0x3c, 0xcc, 0xb2, 0x6a, 0x10, 0x06, 0xc1, 0x8b, 0x16, 0xc6, 0x58, 0xd1, 0x02,
+		0x2b, 0x12, 0x79, 0x62, 0xee, 0x60, 0x5e, 0xc3, 0xf8, 0xf7, 0x15, 0x58, 0xce, 0x08, 0x2b, 0xf2,
+		0xdd, 0x87, 0x90, 0x56, 0xee, 0xa6, 0xac, 0xbc, 0xe2, 0xa6, 0x44, 0x26, 0x2c, 0x65, 0xa8, 0x86,
+		0xe1, 0xd3, 0x81, 0xed, 0xd8, 0x42, 0x2f, 0x79, 0xda, 0x9a, 0x27, 0xb1, 0x23, 0x79, 0x12, 0xfb,
+		0x77, 0x5a, 0xec, 0xda, 0xf5, 0x77, 0xf0, 0xd7, 0x5c, 0xbf, 0x34, 0x15, 0x6a, 0xd9, 0x79, 0x8a,
+		0x3d, 0xf6, 0x77, 0x15, 0x58, 0x7e, 0x84, 0xbf, 0xfe, 0x42, 0xf8, 0xf9, 0x6c, 0xb2, 0xbb, 0x50,
+		0x7b, 0x84, 0xf3, 0x25, 0x99, 0x47, 0x43, 0xc9, 0xa3, 0xf1, 0x9b, 0x0a, 0x1c, 0xff, 0xd8, 0x0b,
+		0x9c, 0xe6, 0x21, 0x0d, 0x55, 0x78, 0xfb, 0xd8, 0x7f, 0x64, 0xd2, 0x38, 0x44, 0x24, 0x76, 0x13,
+		0x96, 0x9a, 0xa2, 0xc7, 0x68, 0xb3, 0x2e, 0x23, 0xe5, 0xdd, 0x15, 0x6e, 0x91, 0x34, 0x3d, 0x36,
+		0x9a, 0xbe, 0xd0, 0xcc, 0x36, 0x12, 0xed, 0x14, 0x9c, 0x28, 0x60, 0x41, 0xa8, 0x85, 0x09, 0x2b,
+		0x0f, 0x70, 0xb0, 0xee, 0x7b, 0x84, 0x88, 0x65, 0x49, 0x9d, 0x22, 0xa9, 0x4b, 0xb3, 0xd2, 0x73,
+		0x69, 0x3e, 0x07, 0xd5, 0xc0, 0xf4, 0x77, 0x70, 0x10, 0x2d, 0x33, 0x3f, 0x4f, 0x66, 0x78, 0xab,
+		0xa0, 0xa7, 0xfd, 0xef, 0x08, 0x1c, 0xcf, 0x1f, 0x43, 0x08, 0xb4, 0x0d, 0x55, 0x6e, 0x1e, 0xb6,
+		0x0f, 0xf9, 0x15, 0xbe, 0xa6, 0xf4, 0xa9, 0x86, 0x92, 0x91, 0x63, 0xd7, 0x0a, 0x72, 0xf7, 0x90,
+		0x79, 0x73, 0xdc, 0xd5, 0x9b, 0x0e, 0x12, 0x4d, 0xe8, 0x33, 0x05, 0x16, 0x9b, 0x2c, 0x5f, 0x68,
+		0x58, 0x66, 0x97, 0xe0, 0x78, 0x58, 0x6e, 0xf4, 0x1e, 0x0d, 0x37, 0x2c, 0x4f, 0x41, 0xae, 0x53,
+		0x8a, 0xa9, 0xc1, 0x51, 0x33, 0xd3, 0xa1, 0xbe, 0x80, 0xf9, 0x0c, 0x97, 0x39, 0xbe, 0xe6, 0xfd,
+		0xb4, 0xaf, 0x79, 0xa9, 0xd0, 0x45, 0xeb, 0x61, 0x4a, 0xac, 0x5e, 0xd2, 0xe1, 0x54, 0x5f, 0xc0,
+		0x72, 0x01, 0x87, 0x39, 0x03, 0x7f, 0x90, 0x1c, 0xb8, 0x5a, 0x1c, 0x2d, 0x7f, 0x80, 0x83, 0x38,
+		0xfb, 0xca, 0x08, 0x27, 0x7d, 0xdc, 0xff, 0x54, 0x60, 0x4d, 0xe4, 0x3b, 0x33, 0x62, 0xcb, 0x24,
+		0x6a, 0x24, 0xd7, 0xce, 0x72, 0x7a, 0x86, 0x9e, 0x71, 0x35, 0x8a, 0x0a, 0x53, 0xc2, 0x58, 0xff,
+		0x00, 0x62, 0xe3, 0x88, 0x94, 0x70, 0xfc, 0x8b, 0xa0, 0xb3, 0x30, 0xd3, 0xc4, 0x81, 0xb5, 0xfb,
+		0x31, 0xe6, 0x7e, 0x8b, 0x48, 0xd0, 0xa5, 0x1b, 0x35, 0x02, 0xdf, 0x2a, 0x31, 0xd9, 0xa8, 0xe4,
+		0x75, 0x34, 0x74, 0x7e, 0x87, 0x5c, 0x59, 0x86, 0xae, 0x5d, 0x63, 0x6f, 0xea, 0xc2, 0xcd, 0xcd,
+		0xce, 0xca, 0x12, 0xb1, 0x45, 0x2d, 0x80, 0xe5, 0x0c, 0x9a, 0xe0, 0xec, 0x0a, 0x2c, 0xc6, 0x99,
+		0xa9, 0x30, 0x8e, 0x15, 0x3e, 0x4e, 0x1d, 0xd5, 0xe3, 0xb4, 0xd5, 0x26, 0x0f, 0x62, 0xd1, 0x37,
+		0xaa, 0xe7, 0xa0, 0x1a, 0xbe, 0xcb, 0x15, 0x21, 0x38, 0x1e, 0x5f, 0x9b, 0x11, 0xad, 0x0c, 0x94,
+		0x68, 0xdf, 0x85, 0xb3, 0xf4, 0x8a, 0xc0, 0x98, 0x48, 0x9c, 0xf2, 0xcf, 0xcc, 0x00, 0xfb, 0xd4,
+		0xfe, 0x95, 0x0a, 0x8b, 0xca, 0xc2, 0x78, 0xda, 0x67, 0x0a, 0x9c, 0xeb, 0x33, 0x84, 0x98, 0xe6,
+		0x33, 0x50, 0x59, 0xd4, 0x38, 0x74, 0x2c, 0xa2, 0x6a, 0x5d, 0x16, 0x30, 0x52, 0xfa, 0x06, 0x8c,
+		0x96, 0x29, 0xb6, 0x1e, 0x21, 0x33, 0xe7, 0x9c, 0xf6, 0x6a, 0x7f, 0xae, 0xf0, 0x52, 0x3e, 0xd7,
+		0x72, 0x5a, 0x38, 0x7e, 0x36, 0x1a, 0x60, 0xb7, 0x74, 0x76, 0xfb, 0x17, 0x14, 0xf9, 0x3d, 0x0b,
+		0x9a, 0x8c, 0x31, 0x2e, 0x98, 0x2b, 0x3f, 0xb9, 0x0c, 0x20, 0x02, 0xad, 0x77, 0x9e, 0x34, 0xd0,
+		0xf7, 0x68, 0x3a, 0x29, 0xf7, 0x23, 0x10, 0xe8, 0x7a, 0xf1, 0x77, 0x3c, 0x64, 0x9f, 0xa1, 0x50,
+		0x6f, 0x0c, 0x8c, 0x27, 0xd6, 0xec, 0xf7, 0x14, 0x58, 0x2e, 0xf8, 0xfe, 0x0a, 0xba, 0xd1, 0xe7,
+		0xa3, 0x22, 0x85, 0xdc, 0xdc, 0x1c, 0x1c, 0x51, 0xb0, 0xf3, 0x97, 0x0a, 0x1c, 0x97, 0x7d, 0xf2,
+		0x03, 0xbd, 0x5b, 0xe6, 0x43, 0x27, 0x85, 0x8c, 0xdd, 0x1e, 0x12, 0x5b, 0x70, 0xf7, 0x63, 0x05,
+		0x56, 0xfb, 0x7d, 0xc7, 0x03, 0x7d, 0xd0, 0x6f, 0xf2, 0xfd, 0xbe, 0x29, 0xa2, 0xde, 0x79, 0x05,
+		0x0a, 0x82, 0x53, 0xaa, 0x62, 0xf9, 0x5f, 0xe8, 0x90, 0xa8, 0x98, 0xf4, 0xcb, 0x20, 0xea, 0x8d,
+		0x81, 0xf1, 0x04, 0x2f, 0x7f, 0xaa, 0x80, 0x5a, 0xfc, 0x1d, 0x0b, 0x54, 0x5c, 0xe4, 0xd8, 0xf7,
+		0xfb, 0x1e, 0xea, 0x3b, 0x43, 0xe1, 0x0a, 0xbe, 0xfe, 0x58, 0x81, 0x63, 0x85, 0x5f, 0xa9, 0x40,
+		0x6f, 0x17, 0x92, 0xee, 0xf7, 0x91, 0x0c, 0xf5, 0xd6, 0x30, 0xa8, 0x82, 0x29, 0x17, 0x66, 0x52,
+		0x8f, 0xe3, 0xd1, 0x9b, 0x85, 0xc4, 0xf2, 0xde, 0xe0, 0xab, 0xf5, 0xb2, 0xe0, 0x62, 0xbc, 0xcf,
+		0x14, 0x38, 0x9a, 0xf3, 0xc2, 0x1c, 0x5d, 0x95, 0xaf, 0x76, 0xee, 0x9b, 0x76, 0xf5, 0xad, 0xc1,
+		0x90, 0x04, 0x0b, 0x01, 0xcc, 0xf6, 0xbc, 0xe6, 0x46, 0x17, 0x65, 0xee, 0x62, 0x4e, 0xd6, 0x4f,
+		0xbd, 0x54, 0x1e, 0x41, 0x8c, 0x7a, 0x00, 0x73, 0xbd, 0xaf, 0x16, 0x51, 0x31, 0x95, 0x82, 0x77,
+		0x9d, 0xea, 0xe5, 0x01, 0x30, 0x12, 0x6a, 0x57, 0x58, 0xbe, 0x2b, 0x51, 0xbb, 0x7e, 0x2f, 0xa7,
+		0xd4, 0x57, 0xa8, 0x16, 0x66, 0x76, 0x57, 0x56, 0xdd, 0x2b, 0xb1, 0xbb, 0x25, 0x2a, 0xa6, 0xd5,
+		0xdb, 0x43, 0x62, 0xa7, 0x44, 0x56, 0x50, 0x02, 0x2b, 0x15, 0x99, 0xbc, 0x00, 0x57, 0xbd, 0x35,
+		0x0c, 0x6a, 0x66, 0x1d, 0x73, 0xde, 0x17, 0xf4, 0x5d, 0xc7, 0xe2, 0x97, 0x1d, 0xea, 0xad, 0x61,
+		0x50, 0xb3, 0xeb, 0x98, 0x5b, 0x85, 0xda, 0x7f, 0x1d, 0x65, 0x95, 0xb0, 0xea, 0xed, 0x21, 0xb1,
+		0xb3, 0xeb, 0x98, 0x2d, 0x34, 0xed, 0xbf, 0x8e, 0x85, 0x65, 0xae, 0xea, 0xad, 0x61, 0x50, 0x05,
+		0x53, 0x7f, 0xc1, 0x82, 0xbe, 0x85, 0x15, 0xa4, 0xe8, 0x9d, 0x81, 0xe6, 0x9c, 0xae, 0x61, 0x55,
+		0xdf, 0x1d, 0x0e, 0x39, 0xc5, 0x5a, 0x61, 0xf9, 0xb4, 0x94, 0xb5, 0x7e, 0x05, 0xdc, 0xea, 0xbb,
+		0xc3, 0x21, 0x0b, 0xd6, 0xfe, 0x4a, 0x81, 0x93, 0x82, 0x52, 0x41, 0xdd, 0x24, 0x7a, 0x4f, 0x32,
+		0x40, 0x89, 0xe2, 0x51, 0xf5, 0xfd, 0xa1, 0xf1, 0x05, 0x8f, 0x7f, 0xa0, 0x40, 0x8d, 0xa7, 0x93,
+		0xb3, 0xd5, 0xb3, 0xe8, 0xa6, 0x84, 0xba, 0xb4, 0x4c, 0x58, 0x7d, 0x7b, 0x08, 0x4c, 0xc1, 0xd1,
+		0x6f, 0x2b, 0xb0, 0x90, 0x57, 0x83, 0x89, 0x8a, 0x4f, 0x4e, 0x49, 0xc5, 0xa9, 0x7a, 0x6d, 0x40,
+		0x2c, 0xc1, 0xc5, 0x0f, 0xd9, 0xa7, 0xe4, 0x24, 0x25, 0x88, 0xe8, 0x76, 0x1f, 0xdd, 0x90, 0x17,
+		0x88, 0xaa, 0xef, 0x0d, 0x8b, 0x2e, 0x18, 0xfc, 0x14, 0xe6, 0xa3, 0x6b, 0x60, 0x58, 0x8c, 0x87,
+		0x2e, 0x4b, 0x88, 0xe6, 0x17, 0x49, 0xaa, 0x57, 0x06, 0x41, 0x89, 0xbd, 0x91, 0x9e, 0xf2, 0x3a,
+		0x89, 0x37, 0x92, 0x5f, 0x14, 0xa8, 0x5e, 0x2a, 0x8f, 0x20, 0x46, 0xdd, 0x83, 0xe9, 0x64, 0xb5,
+		0x13, 0x7a, 0x43, 0x4a, 0xa1, 0xa7, 0xbe, 0x4f, 0x7d, 0xb3, 0x24, 0x74, 0x42, 0x0b, 0xf3, 0xca,
+		0x95, 0x24, 0x5a, 0x28, 0xa9, 0xb8, 0x52, 0xaf, 0x0d, 0x88, 0x95, 0xf0, 0x3c, 0x73, 0xaa, 0x90,
+		0x24, 0x9e, 0x67, 0x71, 0x49, 0x93, 0xfa, 0xd6, 0x60, 0x48, 0xd1, 0xcb, 0x2b, 0x88, 0xab, 0x6e,
+		0xd0, 0x85, 0x42, 0x1a, 0x99, 0x52, 0x1e, 0xf5, 0xf5, 0x52, 0xb0, 0xf1, 0x30, 0x71, 0x55, 0x8b,
+		0x64, 0x98, 0x4c, 0xa9, 0x8f, 0xfa, 0x7a, 0x29, 0xd8, 0xe4, 0x30, 0x61, 0x4d, 0x8a, 0x74, 0x98,
+		0x9e, 0x52, 0x1a, 0xf5, 0xf5, 0x52, 0xb0, 0xf1, 0x0d, 0x25, 0x55, 0x4e, 0x22, 0xb9, 0xa1, 0xe4,
+		0xd5, 0xc2, 0xa8, 0xf5, 0xb2, 0xe0, 0x89, 0x08, 0x45, 0x41, 0xc5, 0x88, 0x24, 0x42, 0x21, 0x2f,
+		0x50, 0x51, 0x6f, 0x0e, 0x8e, 0x98, 0xb8, 0x59, 0xe7, 0x17, 0x46, 0x48, 0x6e, 0xd6, 0xd2, 0x0a,
+		0x14, 0xf5, 0xc6, 0x90, 0x15, 0x18, 0xcc, 0x9f, 0x2a, 0x2c, 0x10, 0x90, 0xf8, 0x53, 0xfd, 0x8a,
+		0x24, 0xd4, 0x5b, 0xc3, 0xa0, 0xc6, 0xfa, 0x91, 0xca, 0xae, 0x4b, 0xf4, 0x23, 0xaf, 0xc0, 0x40,
+		0xad, 0x97, 0x05, 0x4f, 0x58, 0xb3, 0xbc, 0x4c, 0x38, 0x92, 0xdd, 0x46, 0x0b, 0x73, 0xfc, 0xea,
+		0xb5, 0x01, 0xb1, 0xe2, 0x63, 0xa3, 0x27, 0x81, 0x2c, 0x39, 0x36, 0xf2, 0xf3, 0xf2, 0xea, 0xa5,
+		0xf2, 0x08, 0x89, 0x4b, 0x6c, 0x4f, 0x72, 0x52, 0x76, 0x89, 0xcd, 0xcf, 0xd7, 0xaa, 0x97, 0x07,
+		0xc0, 0x88, 0x07, 0x7e, 0x84, 0x4b, 0x0f, 0xfc, 0x08, 0x0f, 0x3a, 0x70, 0x61, 0xa2, 0xf0, 0x77,
+		0x15, 0x58, 0xcc, 0xcd, 0xbe, 0xa1, 0xe2, 0x85, 0x93, 0x25, 0x0c, 0xd5, 0xeb, 0x83, 0xa2, 0x25,
+		0xd4, 0x2e, 0x2f, 0x77, 0x25, 0x51, 0x3b, 0x49, 0x52, 0x50, 0xbd, 0x36, 0x20, 0x96, 0xe0, 0xe2,
+		0x73, 0x25, 0x7a, 0xba, 0x57, 0x9c, 0x21, 0x41, 0x77, 0xfa, 0xdd, 0x42, 0xfa, 0xa6, 0x92, 0xd4,
+		0xbb, 0xaf, 0x42, 0x22, 0x15, 0xe8, 0x49, 0x66, 0x48, 0xe4, 0x81, 0x9e, 0x9c, 0x14, 0x8c, 0x7a,
+		0xa9, 0x3c, 0x42, 0xc2, 0xdb, 0x95, 0xe6, 0x2f, 0x24, 0xde, 0x6e, 0x99, 0xd4, 0x8a, 0xfa, 0xde,
+		0xb0, 0xe8, 0x89, 0xf8, 0x68, 0x71, 0x12, 0x01, 0xc9, 0xc3, 0x01, 0xd2, 0x94, 0x88, 0xfa, 0xce,
+		0x50, 0xb8, 0x9c, 0xaf, 0xbb, 0x6f, 0xff, 0xda, 0x8d, 0x1d, 0x27, 0xd8, 0xed, 0x6e, 0xd7, 0x2d,
+		0xaf, 0x7d, 0x31, 0xf5, 0x8f, 0x1d, 0xea, 0x3b, 0xd8, 0xe5, 0xff, 0xa1, 0x23, 0xf1, 0x2f, 0x42,
+		0xde, 0x11, 0x7f, 0xee, 0x5f, 0xde, 0x1e, 0x63, 0x7d, 0x57, 0xff, 0x6f, 0x00, 0x49, 0x84, 0x3d,
+		0xad, 0x4e, 0x64, 0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -3529,36 +3760,43 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 	},
 	// uber/cadence/shared/v1/cluster.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdf, 0x8b, 0xd3, 0x40,
-		0x10, 0x26, 0x17, 0x6b, 0xdb, 0xe9, 0xe1, 0x9d, 0x8b, 0x3f, 0x82, 0x22, 0xc4, 0x20, 0x52, 0x14,
-		0x12, 0x7a, 0xa2, 0xc2, 0x29, 0x22, 0x57, 0x39, 0xec, 0x83, 0x3f, 0x58, 0xdf, 0x7c, 0x09, 0x9b,
-		0xcd, 0x34, 0x59, 0xae, 0xd9, 0x3d, 0x36, 0x9b, 0x42, 0x1f, 0xfc, 0x13, 0xfc, 0x87, 0xf4, 0x9f,
-		0x93, 0xec, 0x26, 0xd5, 0xc3, 0x1e, 0x77, 0x6f, 0x33, 0x3b, 0xdf, 0xf7, 0xed, 0x37, 0x33, 0x0c,
-		0x3c, 0x69, 0x32, 0xd4, 0x09, 0x67, 0x39, 0x4a, 0x8e, 0x49, 0x5d, 0x32, 0x8d, 0x79, 0xb2, 0x9e,
-		0x25, 0x7c, 0xd5, 0xd4, 0x06, 0x75, 0x7c, 0xae, 0x95, 0x51, 0xe4, 0x5e, 0x8b, 0x8a, 0x3b, 0x54,
-		0xec, 0x50, 0xf1, 0x7a, 0x16, 0x3d, 0x85, 0xd1, 0x47, 0x55, 0x9b, 0x85, 0x5c, 0x2a, 0xf2, 0x00,
-		0x46, 0x22, 0x47, 0x69, 0x84, 0xd9, 0x04, 0x5e, 0xe8, 0x4d, 0xc7, 0x74, 0x9b, 0x47, 0x3f, 0x60,
-		0x44, 0x85, 0x2c, 0x2c, 0x8e, 0xc0, 0x0d, 0xad, 0x56, 0xd8, 0x61, 0x6c, 0x4c, 0x1e, 0xc3, 0x7e,
-		0x85, 0x55, 0x86, 0x3a, 0xe5, 0xaa, 0x91, 0x26, 0xd8, 0x0b, 0xbd, 0xe9, 0x80, 0x4e, 0xdc, 0xdb,
-		0xbc, 0x7d, 0x22, 0xc7, 0x30, 0x74, 0x69, 0x1d, 0xf8, 0xa1, 0x3f, 0x9d, 0x1c, 0x85, 0xf1, 0x6e,
-		0x53, 0x71, 0xef, 0x88, 0xf6, 0x84, 0xe8, 0x97, 0x07, 0xb7, 0x3e, 0xb9, 0xb8, 0x14, 0xe7, 0xd6,
-		0xc5, 0x1c, 0xf6, 0x79, 0xa3, 0x35, 0x4a, 0x93, 0x96, 0xaa, 0x36, 0xd6, 0xcd, 0x75, 0x34, 0x27,
-		0x1d, 0xab, 0x7d, 0x20, 0xcf, 0xe1, 0xb6, 0x46, 0xc6, 0x4b, 0x96, 0xad, 0x30, 0xed, 0xdd, 0xed,
-		0x85, 0xfe, 0x74, 0x4c, 0x0f, 0xb7, 0x85, 0xee, 0x63, 0xf2, 0x0a, 0x06, 0x5a, 0xc8, 0xe2, 0x4a,
-		0xfb, 0xfd, 0xa0, 0xa8, 0x83, 0x47, 0x3f, 0x3d, 0x38, 0xf8, 0xa0, 0x2a, 0x26, 0xe4, 0x9c, 0xf1,
-		0x12, 0xad, 0xfb, 0x63, 0x78, 0x28, 0x9b, 0x2a, 0x55, 0xcb, 0x54, 0x18, 0xac, 0xea, 0x54, 0xc8,
-		0x94, 0xb7, 0xc5, 0x34, 0xdb, 0xa4, 0x22, 0xb7, 0xcd, 0xf8, 0xf4, 0xae, 0x6c, 0xaa, 0x2f, 0xcb,
-		0x45, 0x0b, 0x58, 0x38, 0xee, 0xc9, 0x66, 0x91, 0x93, 0x77, 0xf0, 0xe8, 0x52, 0xae, 0x64, 0x15,
-		0xda, 0xe1, 0xfb, 0xf4, 0xfe, 0x0e, 0xf6, 0x67, 0x56, 0x61, 0xf4, 0x16, 0xc8, 0x57, 0xd4, 0xb5,
-		0xa8, 0x4d, 0x6b, 0xfc, 0x1b, 0x1a, 0x23, 0x64, 0x41, 0x0e, 0xc1, 0x3f, 0xc3, 0x7e, 0xf1, 0x6d,
-		0x48, 0xee, 0xc0, 0x60, 0xcd, 0x56, 0x8d, 0xd3, 0x1b, 0x53, 0x97, 0x44, 0xef, 0x2f, 0xb0, 0x4f,
-		0x91, 0x99, 0x46, 0xe3, 0x0e, 0x76, 0x00, 0x43, 0x94, 0xed, 0xf8, 0x72, 0xcb, 0x1f, 0xd1, 0x3e,
-		0x8d, 0x7e, 0x7b, 0x70, 0xf0, 0x8f, 0x84, 0x9d, 0x47, 0x00, 0xc3, 0x8c, 0xf1, 0x33, 0x94, 0x79,
-		0xa7, 0xd1, 0xa7, 0xe4, 0x14, 0x46, 0xb5, 0xb3, 0xe8, 0x36, 0x33, 0x39, 0x7a, 0x76, 0xd9, 0xe0,
-		0xff, 0xef, 0x8a, 0x6e, 0xb9, 0xad, 0xce, 0xd2, 0x99, 0xed, 0x17, 0x78, 0x1d, 0x9d, 0xae, 0x3f,
-		0xba, 0xe5, 0x9e, 0xbc, 0xfe, 0xfe, 0xb2, 0x10, 0xa6, 0x6c, 0xb2, 0x98, 0xab, 0x2a, 0xb9, 0x70,
-		0x7c, 0x71, 0x81, 0x32, 0xb1, 0xf7, 0xf6, 0xf7, 0x0e, 0xdf, 0xb8, 0x68, 0x3d, 0xcb, 0x6e, 0xda,
-		0xca, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0xef, 0x39, 0x7d, 0xb1, 0x03, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xed, 0x6a, 0x13, 0x41,
+		0x14, 0x65, 0xbb, 0x4d, 0x93, 0xde, 0x2d, 0x6d, 0x1d, 0xb4, 0x46, 0x45, 0x8c, 0x8b, 0x48, 0x50,
+		0xd8, 0xd0, 0x8a, 0x0a, 0x55, 0x44, 0x5a, 0x29, 0x06, 0xfc, 0x28, 0x53, 0x7f, 0xf9, 0x67, 0x98,
+		0xdd, 0xbd, 0x49, 0x86, 0x66, 0x66, 0xc3, 0xec, 0x6c, 0x68, 0x0a, 0x3e, 0x82, 0x2f, 0xa4, 0x6f,
+		0xe2, 0xd3, 0xc8, 0xcc, 0xec, 0x46, 0x8b, 0x2d, 0xf6, 0xdf, 0xdc, 0x7b, 0xce, 0xb9, 0x7b, 0x66,
+		0xef, 0x61, 0xe0, 0x51, 0x95, 0xa2, 0x1e, 0x64, 0x3c, 0x47, 0x95, 0xe1, 0xa0, 0x9c, 0x70, 0x8d,
+		0xf9, 0x60, 0xbe, 0x3b, 0xc8, 0xa6, 0x55, 0x69, 0x50, 0x27, 0x33, 0x5d, 0x98, 0x82, 0xec, 0x58,
+		0x56, 0x52, 0xb3, 0x12, 0xcf, 0x4a, 0xe6, 0xbb, 0xf1, 0x63, 0xe8, 0xbc, 0x2f, 0x4a, 0x33, 0x54,
+		0xa3, 0x82, 0xdc, 0x85, 0x8e, 0xc8, 0x51, 0x19, 0x61, 0x16, 0xdd, 0xa0, 0x17, 0xf4, 0xd7, 0xe9,
+		0xb2, 0x8e, 0xbf, 0x41, 0x87, 0x0a, 0x35, 0x76, 0x3c, 0x02, 0xab, 0xba, 0x98, 0x62, 0xcd, 0x71,
+		0x67, 0xf2, 0x10, 0x36, 0x24, 0xca, 0x14, 0x35, 0xcb, 0x8a, 0x4a, 0x99, 0xee, 0x4a, 0x2f, 0xe8,
+		0xb7, 0x68, 0xe4, 0x7b, 0x87, 0xb6, 0x45, 0xf6, 0xa1, 0xed, 0xcb, 0xb2, 0x1b, 0xf6, 0xc2, 0x7e,
+		0xb4, 0xd7, 0x4b, 0x2e, 0x37, 0x95, 0x34, 0x8e, 0x68, 0x23, 0x88, 0x7f, 0x04, 0xb0, 0xf9, 0xd1,
+		0x9f, 0x27, 0x62, 0xe6, 0x5c, 0x1c, 0xc2, 0x46, 0x56, 0x69, 0x8d, 0xca, 0xb0, 0x49, 0x51, 0x1a,
+		0xe7, 0xe6, 0x3a, 0x33, 0xa3, 0x5a, 0x65, 0x1b, 0xe4, 0x29, 0xdc, 0xd0, 0xc8, 0xb3, 0x09, 0x4f,
+		0xa7, 0xc8, 0x1a, 0x77, 0x2b, 0xbd, 0xb0, 0xbf, 0x4e, 0xb7, 0x97, 0x40, 0xfd, 0x61, 0xf2, 0x02,
+		0x5a, 0x5a, 0xa8, 0xf1, 0x7f, 0xed, 0x37, 0x3f, 0x8a, 0x7a, 0x7a, 0xfc, 0x3d, 0x80, 0xad, 0x77,
+		0x85, 0xe4, 0x42, 0x1d, 0xf2, 0x6c, 0x82, 0xce, 0xfd, 0x3e, 0xdc, 0x53, 0x95, 0x64, 0xc5, 0x88,
+		0x09, 0x83, 0xb2, 0x64, 0x42, 0xb1, 0xcc, 0x82, 0x2c, 0x5d, 0x30, 0x91, 0xbb, 0xcb, 0x84, 0xf4,
+		0x96, 0xaa, 0xe4, 0xe7, 0xd1, 0xd0, 0x12, 0x86, 0x5e, 0x7b, 0xb0, 0x18, 0xe6, 0xe4, 0x0d, 0xdc,
+		0xbf, 0x52, 0xab, 0xb8, 0x44, 0xf7, 0xf3, 0x43, 0x7a, 0xfb, 0x12, 0xf5, 0x27, 0x2e, 0x31, 0xfe,
+		0x15, 0xc0, 0x8e, 0xf7, 0x73, 0xcc, 0x17, 0xd3, 0x82, 0xe7, 0x27, 0xe2, 0x1c, 0x4f, 0x0c, 0x37,
+		0x25, 0x79, 0x00, 0x51, 0xee, 0x10, 0x3f, 0xc8, 0x6f, 0x18, 0x7c, 0xcb, 0x6a, 0xed, 0x9e, 0x67,
+		0x5e, 0xc4, 0xcc, 0x62, 0xe6, 0x3f, 0xb5, 0x4e, 0xa3, 0xba, 0xf7, 0x65, 0x31, 0x43, 0x72, 0x13,
+		0x5a, 0x3e, 0x03, 0xa1, 0xb3, 0xe1, 0x0b, 0x2b, 0xe4, 0x73, 0xd4, 0x7c, 0x8c, 0xac, 0x14, 0xe7,
+		0xd8, 0x5d, 0x75, 0x60, 0x54, 0xf7, 0xac, 0x03, 0x72, 0x07, 0x3a, 0x92, 0x9f, 0x79, 0xb8, 0xe5,
+		0xe0, 0xb6, 0xe4, 0x67, 0x0e, 0xea, 0xc3, 0xb6, 0x42, 0xae, 0xd9, 0x54, 0x48, 0x61, 0xea, 0x88,
+		0xad, 0x39, 0xca, 0xa6, 0xed, 0x7f, 0xb0, 0x6d, 0x97, 0xb2, 0xf8, 0x35, 0x90, 0x63, 0xd4, 0xa5,
+		0x28, 0x8d, 0xdd, 0xca, 0x09, 0x1a, 0x23, 0xd4, 0x98, 0x6c, 0x43, 0x78, 0x8a, 0x4d, 0xaa, 0xed,
+		0xd1, 0xba, 0x9c, 0xf3, 0x69, 0xd5, 0xdc, 0xc0, 0x17, 0xf1, 0xdb, 0x0b, 0xea, 0x23, 0xe4, 0xa6,
+		0xd2, 0x78, 0x89, 0xba, 0x0b, 0x6d, 0x54, 0x36, 0x1b, 0xb9, 0xd3, 0x77, 0x68, 0x53, 0xc6, 0x3f,
+		0x03, 0xd8, 0xfa, 0x6b, 0x84, 0x5b, 0x76, 0x17, 0xda, 0x29, 0xcf, 0x4e, 0x51, 0xe5, 0xf5, 0x8c,
+		0xa6, 0x24, 0x47, 0xd0, 0x29, 0xbd, 0x45, 0x1f, 0xbb, 0x68, 0xef, 0xc9, 0x55, 0xa9, 0xfa, 0xf7,
+		0x56, 0x74, 0xa9, 0xb5, 0x73, 0x46, 0xde, 0x6c, 0x93, 0xce, 0xeb, 0xcc, 0xa9, 0xef, 0x47, 0x97,
+		0xda, 0x83, 0x97, 0x5f, 0x9f, 0x8f, 0x85, 0x99, 0x54, 0x69, 0x92, 0x15, 0x72, 0x70, 0xe1, 0x65,
+		0x49, 0xc6, 0xa8, 0x06, 0xee, 0x31, 0xf9, 0xf3, 0xc8, 0xbc, 0xf2, 0xa7, 0xf9, 0x6e, 0xba, 0xe6,
+		0x90, 0x67, 0xbf, 0x07, 0x00, 0xb2, 0xef, 0x7d, 0x0b, 0x8e, 0x04, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -3583,279 +3821,308 @@ var yarpcFileDescriptorClosurefee8ff76963a38ed = [][]byte{
 		0xbd, 0xc8, 0xfe, 0xa5, 0x88, 0x71, 0x0a, 0xef, 0xa6, 0x4a, 0x2b, 0x1c, 0x58, 0xd3, 0x0f, 0x7a,
 		0xe9, 0xc0, 0x53, 0xf4, 0x2f, 0x5b, 0xf1, 0x6c, 0xbb, 0xd3, 0xdd, 0xda, 0x85, 0x9b, 0xe0, 0xed,
 		0xe5, 0xf3, 0xc5, 0x9c, 0xe9, 0x2c, 0x4f, 0x48, 0x2a, 0x16, 0x5e, 0x6d, 0x7b, 0xc8, 0x1c, 0xb8,
-		0x67, 0x76, 0x66, 0xb3, 0x86, 0x57, 0xd5, 0x53, 0xe1, 0x27, 0x7f, 0xcc, 0xcd, 0xf9, 0x77, 0x00,
-		0x00, 0x00, 0xff, 0xff, 0x84, 0xb4, 0x3e, 0x6c, 0xb0, 0x02, 0x00, 0x00,
+		0x67, 0x76, 0x66, 0xb3, 0x86, 0x57, 0xd5, 0x53, 0xe1, 0x27, 0x7f, 0xcc, 0xcd, 0xf9, 0xf7, 0x00,
+		0x84, 0xb4, 0x3e, 0x6c, 0xb0, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/queue.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6f, 0xdb, 0xc8,
-		0x15, 0x37, 0x25, 0xff, 0x91, 0x9f, 0x1d, 0x87, 0x9e, 0x34, 0x1b, 0xe5, 0x8f, 0x11, 0x85, 0x0b,
-		0x24, 0x82, 0x9b, 0xa5, 0x6a, 0x27, 0xc1, 0x2e, 0xda, 0xa2, 0x2d, 0x43, 0x31, 0x16, 0x1b, 0x46,
-		0x52, 0x87, 0x94, 0xb3, 0x0e, 0x50, 0x10, 0xb4, 0x38, 0xb6, 0x89, 0x50, 0xa4, 0x96, 0xa4, 0x9c,
-		0xea, 0xde, 0x5d, 0xf4, 0xd8, 0x05, 0xda, 0x2f, 0xd0, 0x4b, 0xd1, 0xde, 0x7b, 0xe8, 0xa1, 0xd7,
-		0xf6, 0xd4, 0x43, 0x7b, 0xe8, 0x65, 0xbf, 0x44, 0x3f, 0x42, 0xc1, 0x21, 0x65, 0x51, 0x14, 0x25,
-		0xd1, 0x5e, 0x17, 0xe8, 0xa1, 0x37, 0xeb, 0xf1, 0xf7, 0xde, 0xfc, 0xde, 0x7b, 0x33, 0x3f, 0xbe,
-		0x31, 0x81, 0x1b, 0x1c, 0x13, 0xaf, 0xd6, 0x35, 0x4c, 0xe2, 0x74, 0x49, 0xcd, 0x3f, 0x33, 0x3c,
-		0x62, 0xd6, 0xce, 0xf7, 0x6a, 0x5f, 0x0c, 0xc8, 0x80, 0xf0, 0x7d, 0xcf, 0x0d, 0x5c, 0xf4, 0x51,
-		0x88, 0xe1, 0x63, 0x0c, 0x1f, 0x61, 0xf8, 0xf3, 0xbd, 0x7b, 0x0f, 0x4f, 0x5d, 0xf7, 0xd4, 0x26,
-		0x35, 0x8a, 0x3a, 0x1e, 0x9c, 0xd4, 0x02, 0xab, 0x47, 0xfc, 0xc0, 0xe8, 0xf5, 0x23, 0xc7, 0x7b,
-		0x95, 0x89, 0xe0, 0x46, 0xdf, 0x0a, 0x23, 0x77, 0xdd, 0x5e, 0xcf, 0x75, 0x62, 0xc4, 0xa3, 0x2c,
-		0xc4, 0x99, 0xe5, 0x07, 0xae, 0x37, 0x8c, 0x21, 0x5c, 0x16, 0xe4, 0x83, 0xeb, 0xbd, 0x3f, 0xb1,
-		0xdd, 0x0f, 0x11, 0x86, 0xfb, 0xa6, 0x00, 0xdf, 0x11, 0x3d, 0xd7, 0xf7, 0x45, 0x7b, 0xe0, 0x07,
-		0xc4, 0xd3, 0x0c, 0xff, 0xbd, 0xec, 0x9c, 0xb8, 0xe8, 0x3e, 0xac, 0x9b, 0x6e, 0xcf, 0xb0, 0x1c,
-		0xdd, 0x32, 0xcb, 0x4c, 0x85, 0xa9, 0xae, 0xe3, 0x52, 0x64, 0x90, 0x4d, 0xd4, 0x01, 0x34, 0x8a,
-		0xa3, 0x93, 0x5f, 0x90, 0xee, 0x20, 0xb0, 0x5c, 0xa7, 0x5c, 0xa8, 0x30, 0xd5, 0x8d, 0xfd, 0xc7,
-		0xfc, 0x44, 0xd2, 0x46, 0xdf, 0xe2, 0xcf, 0xf7, 0xf8, 0xb7, 0x31, 0x5c, 0x1a, 0xa1, 0xf1, 0xf6,
-		0x87, 0xb4, 0x09, 0xc9, 0xb0, 0x1e, 0x18, 0xfe, 0x7b, 0x3d, 0x18, 0xf6, 0x49, 0xb9, 0x58, 0x61,
-		0xaa, 0x5b, 0xfb, 0x4f, 0xf9, 0xec, 0x12, 0xf2, 0x69, 0xd2, 0xda, 0xb0, 0x4f, 0x70, 0x29, 0x88,
-		0xff, 0x42, 0x3b, 0x00, 0x34, 0x94, 0x1f, 0x18, 0x01, 0x29, 0x2f, 0x57, 0x98, 0xea, 0x0a, 0xa6,
-		0xc1, 0xd5, 0xd0, 0x80, 0xee, 0xc0, 0x1a, 0x7d, 0x6c, 0x99, 0xe5, 0x95, 0x0a, 0x53, 0x2d, 0xe2,
-		0xd5, 0xf0, 0xa7, 0x6c, 0x22, 0x05, 0x6e, 0x9d, 0x5b, 0xbe, 0x75, 0x6c, 0xd9, 0x56, 0x30, 0xd4,
-		0x46, 0x5d, 0x29, 0xaf, 0xd2, 0xd4, 0xee, 0xf1, 0x51, 0xdf, 0xf8, 0x51, 0xdf, 0xf8, 0x0b, 0x04,
-		0xce, 0x72, 0xe3, 0xfe, 0x55, 0x80, 0xef, 0x25, 0x89, 0xaa, 0x81, 0xe1, 0x05, 0xe2, 0x99, 0x65,
-		0x9b, 0xe3, 0x3a, 0x90, 0x2f, 0x06, 0xc4, 0x0f, 0x84, 0x20, 0xf0, 0xac, 0xe3, 0x41, 0x40, 0x7c,
-		0x54, 0x05, 0x36, 0x30, 0xbc, 0x53, 0x12, 0xe8, 0xe9, 0x06, 0x6c, 0x45, 0xf6, 0xfa, 0xa8, 0x0d,
-		0x3b, 0x00, 0x5e, 0xe4, 0x1e, 0x62, 0x0a, 0x14, 0xb3, 0x1e, 0x5b, 0x64, 0x13, 0x3d, 0x05, 0x64,
-		0x39, 0x56, 0x60, 0x19, 0x01, 0x31, 0x75, 0x72, 0x4e, 0x1c, 0x0a, 0x2b, 0xd2, 0x7c, 0xd9, 0x8b,
-		0x27, 0x52, 0xf8, 0x40, 0x36, 0xd1, 0x57, 0x0c, 0xdc, 0x4b, 0xc3, 0x8d, 0x0b, 0x56, 0xb4, 0x84,
-		0x1b, 0xfb, 0x8d, 0xcc, 0xe6, 0x8e, 0xd3, 0x9a, 0x6a, 0xb3, 0x3c, 0xb1, 0xcc, 0x38, 0x4b, 0x5c,
-		0xb6, 0x66, 0x3c, 0x41, 0x1c, 0xdc, 0x88, 0xf3, 0xf7, 0x06, 0xce, 0xa8, 0x43, 0xeb, 0x78, 0x23,
-		0x32, 0xe2, 0x81, 0x23, 0x9b, 0xdc, 0x4f, 0x61, 0x6f, 0x61, 0x5d, 0xfd, 0xbe, 0xeb, 0xf8, 0x24,
-		0x11, 0xf8, 0x36, 0xac, 0xc6, 0x11, 0xa3, 0x72, 0xae, 0x78, 0x34, 0xd6, 0x9f, 0x0b, 0xf0, 0x34,
-		0x19, 0x4c, 0x34, 0x9c, 0x2e, 0xb1, 0xaf, 0xa5, 0x41, 0xc7, 0x70, 0x37, 0x46, 0x7e, 0xeb, 0xe3,
-		0x72, 0x27, 0x0a, 0x34, 0xf5, 0x20, 0xb5, 0x09, 0x8a, 0xf9, 0x36, 0xc1, 0xf2, 0x8c, 0x4d, 0xc0,
-		0xc3, 0xad, 0x6e, 0x58, 0xc6, 0x31, 0x5f, 0xd7, 0xb1, 0x87, 0xb4, 0x03, 0x25, 0xbc, 0xdd, 0x4d,
-		0xb6, 0xb8, 0xe5, 0xd8, 0x43, 0xae, 0x06, 0x9f, 0xcc, 0x2d, 0x5d, 0xba, 0x07, 0xdc, 0x9f, 0x8a,
-		0x93, 0xc5, 0x56, 0xad, 0x53, 0xc7, 0xf8, 0x7f, 0xb1, 0xf3, 0x14, 0x1b, 0x3d, 0x84, 0x0d, 0x9f,
-		0x96, 0x4b, 0x77, 0x8c, 0x1e, 0xa1, 0x9a, 0xb4, 0x8e, 0x21, 0x32, 0x35, 0x8d, 0x1e, 0x41, 0x3f,
-		0x86, 0xcd, 0x18, 0x60, 0x39, 0xfd, 0x41, 0x50, 0x5e, 0xa3, 0x49, 0x3f, 0xc8, 0x4c, 0xba, 0x6d,
-		0x0c, 0x6d, 0xd7, 0x30, 0x71, 0x1c, 0x52, 0x0e, 0x1d, 0x50, 0x19, 0xd6, 0xba, 0xae, 0x13, 0x78,
-		0xae, 0x5d, 0x2e, 0x55, 0x98, 0xea, 0x26, 0x1e, 0xfd, 0x4c, 0x37, 0x7a, 0xaa, 0x6d, 0x53, 0x8d,
-		0xfe, 0x7b, 0x01, 0x84, 0xa4, 0x07, 0x26, 0x5d, 0xd7, 0x33, 0xb3, 0x45, 0x42, 0x74, 0x7b, 0x7d,
-		0x9b, 0x04, 0xe4, 0x7f, 0xbd, 0xfb, 0x97, 0x13, 0x54, 0x05, 0xd8, 0x6e, 0x94, 0x98, 0xe5, 0x3a,
-		0x11, 0x3c, 0x56, 0xd1, 0x47, 0x99, 0x44, 0x1a, 0xd1, 0xcb, 0x9b, 0xba, 0xe3, 0x9b, 0x63, 0x57,
-		0x6a, 0xe0, 0xea, 0xf0, 0xf2, 0xf2, 0xe5, 0x9c, 0xea, 0xca, 0xbf, 0x19, 0xa8, 0x08, 0xfd, 0xbe,
-		0x3d, 0x6c, 0x1b, 0x1e, 0x71, 0x02, 0xd1, 0x76, 0x7d, 0xd2, 0x76, 0x6d, 0xab, 0x3b, 0x4c, 0x14,
-		0xfd, 0x31, 0xdc, 0x8c, 0xf6, 0x65, 0xba, 0xe6, 0x37, 0xa8, 0xf9, 0xa2, 0xe4, 0xbb, 0xb0, 0x9d,
-		0xda, 0xbf, 0x17, 0x6f, 0xa1, 0x9b, 0x13, 0xbb, 0x57, 0x36, 0x51, 0x05, 0x36, 0x23, 0x6c, 0xac,
-		0xc0, 0xd1, 0xd1, 0x01, 0x6a, 0xa3, 0x92, 0x8e, 0x0e, 0xe1, 0x56, 0x9f, 0x92, 0xd2, 0xbb, 0x21,
-		0x2b, 0xbd, 0x4f, 0x69, 0xd1, 0x8a, 0x6d, 0xcd, 0x68, 0xdd, 0x54, 0x12, 0x78, 0xbb, 0x9f, 0x36,
-		0x71, 0xbf, 0x61, 0xe0, 0x41, 0x76, 0xca, 0xe1, 0x28, 0x30, 0xf0, 0xd1, 0x03, 0x58, 0x8f, 0x8b,
-		0x4d, 0xa2, 0x44, 0x4b, 0x78, 0x6c, 0x40, 0x87, 0xb0, 0x79, 0x62, 0x58, 0x36, 0x31, 0xf5, 0xae,
-		0x31, 0xf0, 0x09, 0xcd, 0x6f, 0x6b, 0xff, 0x59, 0xde, 0xb1, 0xe4, 0x15, 0xf5, 0x15, 0x43, 0x57,
-		0xbc, 0x71, 0x32, 0xfe, 0xc1, 0xfd, 0x85, 0x81, 0x9d, 0x6c, 0x5a, 0xf1, 0x29, 0x40, 0x4d, 0x58,
-		0xa1, 0xe5, 0xa1, 0x9c, 0x36, 0xf6, 0x3f, 0x9b, 0xb5, 0xe4, 0xa2, 0x7e, 0xe2, 0x28, 0x0c, 0x52,
-		0x60, 0xd5, 0xa7, 0x19, 0xc7, 0xc7, 0xe1, 0xf9, 0xe5, 0x02, 0x46, 0xd5, 0xc2, 0x71, 0x0c, 0xee,
-		0x57, 0x0c, 0x3c, 0x4b, 0x26, 0x3b, 0x37, 0x97, 0xc4, 0xe6, 0xfa, 0x19, 0x94, 0x28, 0x1d, 0x8f,
-		0x38, 0x65, 0xa6, 0x52, 0xac, 0x6e, 0xec, 0xbf, 0xb8, 0x1c, 0x8f, 0x38, 0x24, 0xbe, 0x08, 0xc3,
-		0xfd, 0x75, 0x66, 0x87, 0x31, 0xf1, 0x07, 0xf6, 0xf5, 0x57, 0xf2, 0xbf, 0xb5, 0x27, 0x7e, 0xcb,
-		0xc0, 0xf3, 0x3c, 0x35, 0x9d, 0x9a, 0x6c, 0x7e, 0x1e, 0x9f, 0x58, 0x8f, 0x38, 0x7a, 0xdc, 0xe3,
-		0xa8, 0xb6, 0xcf, 0x2f, 0x5b, 0xdb, 0xb0, 0x5e, 0x78, 0x6b, 0x14, 0x2c, 0xea, 0x39, 0xf7, 0xf5,
-		0x1a, 0xdc, 0x49, 0x27, 0x31, 0xda, 0xa5, 0xa3, 0x99, 0xdd, 0x72, 0x4e, 0xdc, 0xb8, 0xbe, 0xb9,
-		0x67, 0xf6, 0xf0, 0xa2, 0x11, 0xcd, 0xec, 0xf4, 0xca, 0xf1, 0x6b, 0x06, 0x76, 0xfc, 0xe9, 0x49,
-		0x6e, 0x9c, 0x67, 0xbc, 0x71, 0x1b, 0x79, 0xe2, 0xe7, 0x19, 0xb5, 0x1b, 0x4b, 0x78, 0xfe, 0x82,
-		0xe8, 0x97, 0x0c, 0xdc, 0xed, 0x4e, 0x0e, 0x35, 0x09, 0x3a, 0x45, 0x4a, 0xa7, 0x9e, 0x87, 0xce,
-		0xa2, 0xa1, 0xb2, 0xb1, 0x84, 0x67, 0x2f, 0x44, 0x69, 0xf8, 0x93, 0xaf, 0x5c, 0x21, 0x3d, 0x9a,
-		0xe7, 0xa2, 0xb1, 0x68, 0xdc, 0x0a, 0x69, 0xcc, 0x5c, 0x08, 0xfd, 0x83, 0x81, 0x17, 0xde, 0x55,
-		0xde, 0xe3, 0x74, 0xa6, 0xd9, 0xd8, 0x3f, 0xca, 0x43, 0xf1, 0x4a, 0x83, 0x42, 0x63, 0x09, 0x5f,
-		0x8d, 0x19, 0xfa, 0x3d, 0x03, 0x4f, 0x8c, 0x7c, 0xda, 0x15, 0xdf, 0x02, 0x5f, 0xe7, 0xc9, 0x22,
-		0xa7, 0x1c, 0x36, 0x96, 0x70, 0xde, 0xd5, 0x5f, 0x6e, 0x02, 0x8c, 0xef, 0x63, 0xdc, 0x1f, 0x4a,
-		0x50, 0x9e, 0x3e, 0x93, 0x91, 0x32, 0x24, 0xaf, 0xb7, 0xcc, 0xc4, 0xf5, 0x76, 0xe2, 0x86, 0x5d,
-		0xb8, 0xc6, 0x1b, 0x76, 0x31, 0x7d, 0xc3, 0x4e, 0x6b, 0xe4, 0xf2, 0xf5, 0x68, 0x24, 0xfa, 0x7a,
-		0xa1, 0x48, 0x44, 0x7b, 0x4d, 0xbe, 0xb2, 0x48, 0xa4, 0xd5, 0x75, 0xb1, 0x4a, 0x7c, 0x39, 0x57,
-		0x25, 0xa2, 0x5d, 0x23, 0x5d, 0x49, 0x25, 0x32, 0xb8, 0xcc, 0x91, 0x89, 0x2f, 0xe7, 0xca, 0xc4,
-		0x5a, 0x7e, 0x1e, 0x0b, 0xc7, 0xfb, 0xf9, 0x3a, 0xf1, 0xcf, 0x2b, 0xeb, 0x44, 0x89, 0x72, 0x7c,
-		0x77, 0x7d, 0x3a, 0x91, 0x41, 0xfc, 0x8a, 0x42, 0xf1, 0x47, 0x06, 0xaa, 0x46, 0xce, 0x17, 0x72,
-		0x79, 0x9d, 0xe6, 0xa1, 0x7c, 0x1b, 0xa5, 0xc8, 0x60, 0x9e, 0x7b, 0xfd, 0x94, 0x56, 0xf4, 0xb3,
-		0xa4, 0x82, 0xe6, 0xe7, 0x23, 0x0d, 0x6e, 0xd0, 0x63, 0x1c, 0x5f, 0x62, 0x47, 0x83, 0x43, 0x2d,
-		0xef, 0x41, 0x1d, 0x8d, 0x63, 0x9b, 0x41, 0x22, 0xea, 0xee, 0x57, 0x0c, 0x94, 0x46, 0x9a, 0x81,
-		0x6e, 0xc3, 0xb6, 0x26, 0xa8, 0xaf, 0x75, 0xed, 0xa8, 0x2d, 0xe9, 0x72, 0xf3, 0x50, 0x50, 0xe4,
-		0x3a, 0xbb, 0x84, 0x3e, 0x02, 0x34, 0x36, 0x6b, 0x58, 0x68, 0xaa, 0xaf, 0x24, 0xcc, 0x32, 0xe8,
-		0x16, 0xdc, 0x4c, 0xd8, 0xe5, 0x37, 0x12, 0x66, 0x0b, 0xe8, 0x2e, 0xdc, 0x1e, 0x1b, 0xb1, 0xd4,
-		0x56, 0x64, 0x51, 0xd0, 0xe4, 0x56, 0x93, 0x2d, 0xa2, 0xfb, 0x70, 0x67, 0xfc, 0x48, 0xc4, 0x2d,
-		0x55, 0xd5, 0x45, 0xa5, 0xa3, 0x6a, 0x12, 0x66, 0x97, 0x77, 0xff, 0x96, 0xf1, 0xff, 0x4d, 0x4a,
-		0xea, 0x63, 0x78, 0x38, 0x81, 0xd5, 0xb3, 0x28, 0xee, 0xc1, 0x27, 0xb3, 0x40, 0xaa, 0x26, 0x60,
-		0x4d, 0x17, 0x1b, 0xb2, 0x52, 0xd7, 0xa5, 0xcf, 0x25, 0xb1, 0x43, 0xd9, 0x30, 0xe8, 0x29, 0x54,
-		0x67, 0xb9, 0x88, 0x42, 0x53, 0x94, 0x94, 0x04, 0xba, 0x30, 0x0f, 0xad, 0xca, 0x07, 0x4d, 0x21,
-		0x89, 0x2e, 0xa2, 0x3a, 0xfc, 0x64, 0x16, 0x1a, 0x4b, 0x62, 0x0b, 0xd7, 0x63, 0x3e, 0x6f, 0x5b,
-		0xf8, 0xf5, 0x6b, 0xa5, 0xf5, 0x76, 0xec, 0xac, 0x8b, 0xad, 0x37, 0x6d, 0x45, 0xd2, 0x24, 0x76,
-		0x19, 0xbd, 0x80, 0xbd, 0x59, 0x51, 0x84, 0x76, 0x5b, 0x39, 0xd2, 0xdb, 0x02, 0x96, 0x9a, 0x9a,
-		0x2e, 0x2a, 0x2d, 0x55, 0xd2, 0xdb, 0x2d, 0x45, 0x16, 0x8f, 0xd8, 0x95, 0xdd, 0xdf, 0x15, 0xe1,
-		0xfe, 0x1c, 0x95, 0x46, 0xdf, 0x85, 0x27, 0x19, 0x61, 0x5f, 0x09, 0xb2, 0x22, 0xd5, 0x75, 0x51,
-		0xe8, 0xa8, 0xc9, 0xc2, 0x66, 0x73, 0x98, 0x00, 0xd7, 0x5b, 0x6f, 0x04, 0xb9, 0xa9, 0x37, 0x5b,
-		0x9a, 0x2e, 0x88, 0x9a, 0x7c, 0x28, 0xb1, 0xcc, 0x25, 0xdd, 0xa4, 0xcf, 0x65, 0x55, 0x53, 0xd9,
-		0x02, 0xfa, 0x21, 0x7c, 0xb6, 0xc8, 0x2d, 0x2c, 0xd9, 0xab, 0xb0, 0x64, 0x82, 0x82, 0x25, 0xa1,
-		0x7e, 0xa4, 0xe3, 0x4e, 0xb3, 0x29, 0x37, 0x0f, 0xd8, 0x22, 0xfa, 0x14, 0x9e, 0xe5, 0xf6, 0x4e,
-		0x2c, 0xbb, 0x8c, 0x7e, 0x04, 0xdf, 0xbf, 0xf4, 0xb2, 0xa3, 0x3e, 0xd5, 0xd9, 0x95, 0x19, 0xbb,
-		0x6f, 0xc2, 0xbf, 0xd3, 0x14, 0x05, 0x4d, 0x3a, 0x68, 0x61, 0xf9, 0x9d, 0x54, 0x67, 0x57, 0x77,
-		0xbf, 0x61, 0x00, 0x1d, 0x90, 0x20, 0xdd, 0x9b, 0x47, 0xb0, 0x73, 0x20, 0x69, 0x73, 0x3b, 0xf2,
-		0x18, 0xb8, 0x6c, 0x88, 0x2a, 0xe1, 0x43, 0x59, 0x94, 0xf4, 0x97, 0x1d, 0xf5, 0x88, 0x65, 0x66,
-		0x87, 0x0a, 0x4f, 0x6a, 0xab, 0xa3, 0xb1, 0x05, 0xc4, 0xc3, 0xee, 0x8c, 0x50, 0x0d, 0x01, 0xd7,
-		0xf5, 0xd6, 0xdb, 0xa6, 0x84, 0xd5, 0x86, 0xdc, 0xd6, 0x95, 0x96, 0xaa, 0xb1, 0x45, 0xf4, 0x04,
-		0x3e, 0xce, 0xc6, 0x4f, 0x66, 0xb7, 0xfc, 0xf2, 0xd3, 0x77, 0x2f, 0x4e, 0xad, 0xe0, 0x6c, 0x70,
-		0xcc, 0x77, 0xdd, 0x5e, 0x6d, 0xe2, 0xeb, 0x06, 0x7f, 0x4a, 0x9c, 0xe8, 0x73, 0xca, 0xf8, 0x53,
-		0xcc, 0x0f, 0xa2, 0xbf, 0xce, 0xf7, 0x8e, 0x57, 0xe9, 0x93, 0x67, 0xff, 0x09, 0x00, 0x00, 0xff,
-		0xff, 0xd8, 0x06, 0x2c, 0x43, 0xb4, 0x19, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6e, 0xdb, 0xd8,
+		0x15, 0x36, 0x25, 0xff, 0xc8, 0xc7, 0x1e, 0x87, 0xbe, 0x69, 0x26, 0xca, 0x8f, 0x11, 0x85, 0x03,
+		0x24, 0x82, 0x9b, 0xa1, 0x6a, 0x27, 0xc1, 0x0c, 0xda, 0xa2, 0x2d, 0x43, 0x31, 0x16, 0x1b, 0x46,
+		0x52, 0x2f, 0x69, 0x67, 0x1c, 0xa0, 0x20, 0x68, 0xf1, 0xda, 0x21, 0x4c, 0x91, 0x1a, 0xfe, 0x38,
+		0xd5, 0xbe, 0x33, 0xe8, 0xb2, 0x03, 0xb4, 0x2f, 0xd0, 0x4d, 0xd1, 0xee, 0xbb, 0xe8, 0xa2, 0xdb,
+		0x76, 0xd5, 0x45, 0xbb, 0xe8, 0x66, 0x5e, 0xa2, 0x8f, 0x50, 0xf0, 0x92, 0xb2, 0x28, 0x8a, 0x92,
+		0x69, 0xc7, 0x05, 0xba, 0xe8, 0x4e, 0x3a, 0xfc, 0xce, 0xb9, 0xdf, 0x39, 0xe7, 0xde, 0x8f, 0xe7,
+		0x4a, 0xc0, 0x85, 0x47, 0xc4, 0x6b, 0xf4, 0x0c, 0x93, 0x38, 0x3d, 0xd2, 0xf0, 0xdf, 0x19, 0x1e,
+		0x31, 0x1b, 0x67, 0x3b, 0x8d, 0x2f, 0x43, 0x12, 0x12, 0x7e, 0xe0, 0xb9, 0x81, 0x8b, 0x3e, 0x8e,
+		0x30, 0x7c, 0x82, 0xe1, 0x63, 0x0c, 0x7f, 0xb6, 0x73, 0xf7, 0xc1, 0x89, 0xeb, 0x9e, 0xd8, 0xa4,
+		0x41, 0x51, 0x47, 0xe1, 0x71, 0x23, 0xb0, 0xfa, 0xc4, 0x0f, 0x8c, 0xfe, 0x20, 0x76, 0xbc, 0x5b,
+		0x9b, 0x08, 0x6e, 0x0c, 0xac, 0x28, 0x72, 0xcf, 0xed, 0xf7, 0x5d, 0x27, 0x41, 0x3c, 0xcc, 0x43,
+		0xbc, 0xb3, 0xfc, 0xc0, 0xf5, 0x86, 0x09, 0x84, 0xcb, 0x83, 0xbc, 0x77, 0xbd, 0xd3, 0x63, 0xdb,
+		0x7d, 0x1f, 0x63, 0xb8, 0x6f, 0x4b, 0xf0, 0x1d, 0xd1, 0x73, 0x7d, 0x5f, 0xb4, 0x43, 0x3f, 0x20,
+		0x9e, 0x66, 0xf8, 0xa7, 0xb2, 0x73, 0xec, 0xa2, 0x7b, 0xb0, 0x6a, 0xba, 0x7d, 0xc3, 0x72, 0x74,
+		0xcb, 0xac, 0x32, 0x35, 0xa6, 0xbe, 0x8a, 0x2b, 0xb1, 0x41, 0x36, 0xd1, 0x3e, 0xa0, 0x51, 0x1c,
+		0x9d, 0xfc, 0x82, 0xf4, 0xc2, 0xc0, 0x72, 0x9d, 0x6a, 0xa9, 0xc6, 0xd4, 0xd7, 0x76, 0x1f, 0xf1,
+		0x13, 0x49, 0x1b, 0x03, 0x8b, 0x3f, 0xdb, 0xe1, 0xdf, 0x24, 0x70, 0x69, 0x84, 0xc6, 0x9b, 0xef,
+		0xb3, 0x26, 0x24, 0xc3, 0x6a, 0x60, 0xf8, 0xa7, 0x7a, 0x30, 0x1c, 0x90, 0x6a, 0xb9, 0xc6, 0xd4,
+		0x37, 0x76, 0x9f, 0xf0, 0xf9, 0x25, 0xe4, 0xb3, 0xa4, 0xb5, 0xe1, 0x80, 0xe0, 0x4a, 0x90, 0x7c,
+		0x42, 0x5b, 0x00, 0x34, 0x94, 0x1f, 0x18, 0x01, 0xa9, 0x2e, 0xd6, 0x98, 0xfa, 0x12, 0xa6, 0xc1,
+		0xd5, 0xc8, 0x80, 0x6e, 0xc3, 0x0a, 0x7d, 0x6c, 0x99, 0xd5, 0xa5, 0x1a, 0x53, 0x2f, 0xe3, 0xe5,
+		0xe8, 0xab, 0x6c, 0x22, 0x05, 0x6e, 0x9e, 0x59, 0xbe, 0x75, 0x64, 0xd9, 0x56, 0x30, 0xd4, 0x46,
+		0x5d, 0xa9, 0x2e, 0xd3, 0xd4, 0xee, 0xf2, 0x71, 0xdf, 0xf8, 0x51, 0xdf, 0xf8, 0x73, 0x04, 0xce,
+		0x73, 0xe3, 0xfe, 0x55, 0x82, 0xef, 0xa5, 0x89, 0xaa, 0x81, 0xe1, 0x05, 0xe2, 0x3b, 0xcb, 0x36,
+		0xc7, 0x75, 0x20, 0x5f, 0x86, 0xc4, 0x0f, 0x84, 0x20, 0xf0, 0xac, 0xa3, 0x30, 0x20, 0x3e, 0xaa,
+		0x03, 0x1b, 0x18, 0xde, 0x09, 0x09, 0xf4, 0x6c, 0x03, 0x36, 0x62, 0x7b, 0x73, 0xd4, 0x86, 0x2d,
+		0x00, 0x2f, 0x76, 0x8f, 0x30, 0x25, 0x8a, 0x59, 0x4d, 0x2c, 0xb2, 0x89, 0x9e, 0x00, 0xb2, 0x1c,
+		0x2b, 0xb0, 0x8c, 0x80, 0x98, 0x3a, 0x39, 0x23, 0x0e, 0x85, 0x95, 0x69, 0xbe, 0xec, 0xf9, 0x13,
+		0x29, 0x7a, 0x20, 0x9b, 0xe8, 0x6b, 0x06, 0xee, 0x66, 0xe1, 0xc6, 0x39, 0x2b, 0x5a, 0xc2, 0xb5,
+		0xdd, 0x56, 0x6e, 0x73, 0xc7, 0x69, 0x4d, 0xb5, 0x59, 0x9e, 0x58, 0x66, 0x9c, 0x25, 0xae, 0x5a,
+		0x33, 0x9e, 0x20, 0x0e, 0x3e, 0x4a, 0xf2, 0xf7, 0x42, 0x67, 0xd4, 0xa1, 0x55, 0xbc, 0x16, 0x1b,
+		0x71, 0xe8, 0xc8, 0x26, 0xf7, 0x53, 0xd8, 0xb9, 0xb0, 0xae, 0xfe, 0xc0, 0x75, 0x7c, 0x92, 0x0a,
+		0x7c, 0x0b, 0x96, 0xbd, 0x30, 0x55, 0xce, 0x25, 0x8f, 0xc6, 0xfa, 0x73, 0x09, 0x9e, 0xa4, 0x83,
+		0x89, 0x86, 0xd3, 0x23, 0xf6, 0xb5, 0x34, 0xe8, 0x08, 0xee, 0x24, 0xc8, 0x0f, 0x3e, 0x2e, 0xb7,
+		0xe3, 0x40, 0x53, 0x0f, 0x32, 0x9b, 0xa0, 0x5c, 0x6c, 0x13, 0x2c, 0xce, 0xd8, 0x04, 0x3c, 0xdc,
+		0xec, 0x45, 0x65, 0x1c, 0xf3, 0x75, 0x1d, 0x7b, 0x48, 0x3b, 0x50, 0xc1, 0x9b, 0xbd, 0x74, 0x8b,
+		0x3b, 0x8e, 0x3d, 0xe4, 0x1a, 0xf0, 0xe9, 0xdc, 0xd2, 0x65, 0x7b, 0xc0, 0xfd, 0xa9, 0x3c, 0x59,
+		0x6c, 0xd5, 0x3a, 0x71, 0x8c, 0xff, 0x17, 0xbb, 0x48, 0xb1, 0xd1, 0x03, 0x58, 0xf3, 0x69, 0xb9,
+		0x74, 0xc7, 0xe8, 0x13, 0xaa, 0x49, 0xab, 0x18, 0x62, 0x53, 0xdb, 0xe8, 0x13, 0xf4, 0x63, 0x58,
+		0x4f, 0x00, 0x96, 0x33, 0x08, 0x83, 0xea, 0x0a, 0x4d, 0xfa, 0x7e, 0x6e, 0xd2, 0x5d, 0x63, 0x68,
+		0xbb, 0x86, 0x89, 0x93, 0x90, 0x72, 0xe4, 0x80, 0xaa, 0xb0, 0xd2, 0x73, 0x9d, 0xc0, 0x73, 0xed,
+		0x6a, 0xa5, 0xc6, 0xd4, 0xd7, 0xf1, 0xe8, 0x6b, 0xb6, 0xd1, 0x53, 0x6d, 0x9b, 0x6a, 0xf4, 0xdf,
+		0x4b, 0x20, 0xa4, 0x3d, 0x30, 0xe9, 0xb9, 0x9e, 0x99, 0x2f, 0x12, 0xa2, 0xdb, 0x1f, 0xd8, 0x24,
+		0x20, 0xff, 0xeb, 0xdd, 0xbf, 0x9c, 0xa0, 0x2a, 0xc0, 0xf6, 0xe2, 0xc4, 0x2c, 0xd7, 0x89, 0xe1,
+		0x89, 0x8a, 0x3e, 0xcc, 0x25, 0xd2, 0x8a, 0x5f, 0xde, 0xd4, 0x1d, 0xdf, 0x18, 0xbb, 0x52, 0x03,
+		0xd7, 0x84, 0x17, 0x97, 0x2f, 0xe7, 0x54, 0x57, 0xfe, 0xcd, 0x40, 0x4d, 0x18, 0x0c, 0xec, 0x61,
+		0xd7, 0xf0, 0x88, 0x13, 0x88, 0xb6, 0xeb, 0x93, 0xae, 0x6b, 0x5b, 0xbd, 0x61, 0xaa, 0xe8, 0x8f,
+		0xe0, 0x46, 0xbc, 0x2f, 0xb3, 0x35, 0xff, 0x88, 0x9a, 0xcf, 0x4b, 0xbe, 0x0d, 0x9b, 0x99, 0xfd,
+		0x7b, 0xfe, 0x16, 0xba, 0x31, 0xb1, 0x7b, 0x65, 0x13, 0xd5, 0x60, 0x3d, 0xc6, 0x26, 0x0a, 0x1c,
+		0x1f, 0x1d, 0xa0, 0x36, 0x2a, 0xe9, 0xe8, 0x00, 0x6e, 0x0e, 0x28, 0x29, 0xbd, 0x17, 0xb1, 0xd2,
+		0x07, 0x94, 0x16, 0xad, 0xd8, 0xc6, 0x8c, 0xd6, 0x4d, 0x25, 0x81, 0x37, 0x07, 0x59, 0x13, 0xf7,
+		0x1b, 0x06, 0xee, 0xe7, 0xa7, 0x1c, 0x8d, 0x02, 0xa1, 0x8f, 0xee, 0xc3, 0x6a, 0x52, 0x6c, 0x12,
+		0x27, 0x5a, 0xc1, 0x63, 0x03, 0x3a, 0x80, 0xf5, 0x63, 0xc3, 0xb2, 0x89, 0xa9, 0xf7, 0x8c, 0xd0,
+		0x27, 0x34, 0xbf, 0x8d, 0xdd, 0xa7, 0x45, 0xc7, 0x92, 0x97, 0xd4, 0x57, 0x8c, 0x5c, 0xf1, 0xda,
+		0xf1, 0xf8, 0x0b, 0xf7, 0x17, 0x06, 0xb6, 0xf2, 0x69, 0x25, 0xa7, 0x00, 0xb5, 0x61, 0x89, 0x96,
+		0x87, 0x72, 0x5a, 0xdb, 0xfd, 0x7c, 0xd6, 0x92, 0x17, 0xf5, 0x13, 0xc7, 0x61, 0x90, 0x02, 0xcb,
+		0x3e, 0xcd, 0x38, 0x39, 0x0e, 0xcf, 0x2e, 0x17, 0x30, 0xae, 0x16, 0x4e, 0x62, 0x70, 0xbf, 0x62,
+		0xe0, 0x69, 0x3a, 0xd9, 0xb9, 0xb9, 0xa4, 0x36, 0xd7, 0xcf, 0xa0, 0x42, 0xe9, 0x78, 0xc4, 0xa9,
+		0x32, 0xb5, 0x72, 0x7d, 0x6d, 0xf7, 0xf9, 0xe5, 0x78, 0x24, 0x21, 0xf1, 0x79, 0x18, 0xee, 0xaf,
+		0x33, 0x3b, 0x8c, 0x89, 0x1f, 0xda, 0xd7, 0x5f, 0xc9, 0xff, 0xd6, 0x9e, 0xf8, 0x2d, 0x03, 0xcf,
+		0x8a, 0xd4, 0x74, 0x6a, 0xb2, 0xf9, 0x79, 0x72, 0x62, 0x3d, 0xe2, 0xe8, 0x49, 0x8f, 0xe3, 0xda,
+		0x3e, 0xbb, 0x6c, 0x6d, 0xa3, 0x7a, 0xe1, 0x8d, 0x51, 0xb0, 0xb8, 0xe7, 0xdc, 0x37, 0x2b, 0x70,
+		0x3b, 0x9b, 0xc4, 0x68, 0x97, 0x8e, 0x66, 0x76, 0xcb, 0x39, 0x76, 0x93, 0xfa, 0x16, 0x9e, 0xd9,
+		0xa3, 0x8b, 0x46, 0x3c, 0xb3, 0x47, 0x9f, 0xd0, 0xaf, 0x19, 0xd8, 0xf2, 0xa7, 0x27, 0xb9, 0x71,
+		0x9e, 0xd5, 0x52, 0xde, 0x10, 0x9a, 0x1f, 0xbf, 0xc8, 0xa8, 0xdd, 0x5a, 0xc0, 0xf3, 0x17, 0x44,
+		0xbf, 0x64, 0xe0, 0x4e, 0x6f, 0x72, 0xa8, 0x49, 0xd1, 0x29, 0x53, 0x3a, 0xcd, 0x22, 0x74, 0x2e,
+		0x1a, 0x2a, 0x5b, 0x0b, 0x78, 0xf6, 0x42, 0x94, 0x86, 0x3f, 0xf9, 0xca, 0x15, 0xb2, 0xa3, 0x79,
+		0x21, 0x1a, 0x17, 0x8d, 0x5b, 0x11, 0x8d, 0x99, 0x0b, 0xa1, 0x7f, 0x30, 0xf0, 0xdc, 0xbb, 0xca,
+		0x7b, 0x9c, 0xce, 0x34, 0x6b, 0xbb, 0x87, 0x45, 0x28, 0x5e, 0x69, 0x50, 0x68, 0x2d, 0xe0, 0xab,
+		0x31, 0x43, 0xbf, 0x67, 0xe0, 0xb1, 0x51, 0x4c, 0xbb, 0x92, 0x5b, 0xe0, 0xab, 0x22, 0x59, 0x14,
+		0x94, 0xc3, 0xd6, 0x02, 0x2e, 0xba, 0xfa, 0x8b, 0x75, 0x80, 0xf1, 0x7d, 0x8c, 0xfb, 0x43, 0x05,
+		0xaa, 0xd3, 0x67, 0x32, 0x56, 0x86, 0xf4, 0xf5, 0x96, 0x99, 0xb8, 0xde, 0x4e, 0xdc, 0xb0, 0x4b,
+		0xd7, 0x78, 0xc3, 0x2e, 0x67, 0x6f, 0xd8, 0x59, 0x8d, 0x5c, 0xbc, 0x1e, 0x8d, 0x44, 0xdf, 0x5c,
+		0x28, 0x12, 0xf1, 0x5e, 0x93, 0xaf, 0x2c, 0x12, 0x59, 0x75, 0xbd, 0x58, 0x25, 0xbe, 0x9a, 0xab,
+		0x12, 0xf1, 0xae, 0x91, 0xae, 0xa4, 0x12, 0x39, 0x5c, 0xe6, 0xc8, 0xc4, 0x57, 0x73, 0x65, 0x62,
+		0xa5, 0x38, 0x8f, 0x0b, 0xc7, 0xfb, 0xf9, 0x3a, 0xf1, 0xcf, 0x2b, 0xeb, 0x44, 0x85, 0x72, 0x7c,
+		0x7b, 0x7d, 0x3a, 0x91, 0x43, 0xfc, 0x8a, 0x42, 0xf1, 0x47, 0x06, 0xea, 0x46, 0xc1, 0x17, 0x72,
+		0x75, 0x95, 0xe6, 0xa1, 0x7c, 0x88, 0x52, 0xe4, 0x30, 0x2f, 0xbc, 0x7e, 0x46, 0x2b, 0x06, 0x79,
+		0x52, 0x41, 0xf3, 0xf3, 0x91, 0x16, 0xfd, 0xda, 0xe2, 0x9f, 0xea, 0xc9, 0x25, 0x76, 0x34, 0x38,
+		0x34, 0x8a, 0x1e, 0xd4, 0x24, 0x10, 0x5e, 0x0f, 0xc6, 0x5f, 0xfc, 0xed, 0xaf, 0x19, 0xa8, 0x8c,
+		0x34, 0x03, 0xdd, 0x82, 0x4d, 0x4d, 0x50, 0x5f, 0xe9, 0xda, 0x61, 0x57, 0xd2, 0xe5, 0xf6, 0x81,
+		0xa0, 0xc8, 0x4d, 0x76, 0x01, 0x7d, 0x0c, 0x68, 0x6c, 0xd6, 0xb0, 0xd0, 0x56, 0x5f, 0x4a, 0x98,
+		0x65, 0xd0, 0x4d, 0xb8, 0x91, 0xb2, 0xcb, 0xaf, 0x25, 0xcc, 0x96, 0xd0, 0x1d, 0xb8, 0x35, 0x36,
+		0x62, 0xa9, 0xab, 0xc8, 0xa2, 0xa0, 0xc9, 0x9d, 0x36, 0x5b, 0x46, 0xf7, 0xe0, 0xf6, 0xf8, 0x91,
+		0x88, 0x3b, 0xaa, 0xaa, 0x8b, 0xca, 0xbe, 0xaa, 0x49, 0x98, 0x5d, 0xdc, 0xfe, 0x5b, 0xce, 0xef,
+		0x9b, 0x94, 0xd4, 0x27, 0xf0, 0x60, 0x02, 0xab, 0xe7, 0x51, 0xdc, 0x81, 0x4f, 0x67, 0x81, 0x54,
+		0x4d, 0xc0, 0x9a, 0x2e, 0xb6, 0x64, 0xa5, 0xa9, 0x4b, 0x5f, 0x48, 0xe2, 0x3e, 0x65, 0xc3, 0xa0,
+		0x27, 0x50, 0x9f, 0xe5, 0x22, 0x0a, 0x6d, 0x51, 0x52, 0x52, 0xe8, 0xd2, 0x3c, 0xb4, 0x2a, 0xef,
+		0xb5, 0x85, 0x34, 0xba, 0x8c, 0x9a, 0xf0, 0x93, 0x59, 0x68, 0x2c, 0x89, 0x1d, 0xdc, 0x4c, 0xf8,
+		0xbc, 0xe9, 0xe0, 0x57, 0xaf, 0x94, 0xce, 0x9b, 0xb1, 0xb3, 0x2e, 0x76, 0x5e, 0x77, 0x15, 0x49,
+		0x93, 0xd8, 0x45, 0xf4, 0x1c, 0x76, 0x66, 0x45, 0x11, 0xba, 0x5d, 0xe5, 0x50, 0xef, 0x0a, 0x58,
+		0x6a, 0x6b, 0xba, 0xa8, 0x74, 0x54, 0x49, 0xef, 0x76, 0x14, 0x59, 0x3c, 0x64, 0x97, 0xb6, 0x7f,
+		0x57, 0x86, 0x7b, 0x73, 0x54, 0x1a, 0x7d, 0x17, 0x1e, 0xe7, 0x84, 0x7d, 0x29, 0xc8, 0x8a, 0xd4,
+		0xd4, 0x45, 0x61, 0x5f, 0x4d, 0x17, 0x36, 0x9f, 0xc3, 0x04, 0xb8, 0xd9, 0x79, 0x2d, 0xc8, 0x6d,
+		0xbd, 0xdd, 0xd1, 0x74, 0x41, 0xd4, 0xe4, 0x03, 0x89, 0x65, 0x2e, 0xe9, 0x26, 0x7d, 0x21, 0xab,
+		0x9a, 0xca, 0x96, 0xd0, 0x0f, 0xe1, 0xf3, 0x8b, 0xdc, 0xa2, 0x92, 0xbd, 0x8c, 0x4a, 0x26, 0x28,
+		0x58, 0x12, 0x9a, 0x87, 0x3a, 0xde, 0x6f, 0xb7, 0xe5, 0xf6, 0x1e, 0x5b, 0x46, 0x9f, 0xc1, 0xd3,
+		0xc2, 0xde, 0xa9, 0x65, 0x17, 0xd1, 0x8f, 0xe0, 0xfb, 0x97, 0x5e, 0x76, 0xd4, 0xa7, 0x26, 0xbb,
+		0x34, 0x63, 0xf7, 0x4d, 0xf8, 0xef, 0xb7, 0x45, 0x41, 0x93, 0xf6, 0x3a, 0x58, 0x7e, 0x2b, 0x35,
+		0xd9, 0xe5, 0xed, 0x6f, 0x19, 0x40, 0x7b, 0x24, 0xc8, 0xf6, 0xe6, 0x21, 0x6c, 0xed, 0x49, 0xda,
+		0xdc, 0x8e, 0x3c, 0x02, 0x2e, 0x1f, 0xa2, 0x4a, 0xf8, 0x40, 0x16, 0x25, 0xfd, 0xc5, 0xbe, 0x7a,
+		0xc8, 0x32, 0xb3, 0x43, 0x45, 0x27, 0xb5, 0xb3, 0xaf, 0xb1, 0x25, 0xc4, 0xc3, 0xf6, 0x8c, 0x50,
+		0x2d, 0x01, 0x37, 0xf5, 0xce, 0x9b, 0xb6, 0x84, 0xd5, 0x96, 0xdc, 0xd5, 0x95, 0x8e, 0xaa, 0xb1,
+		0x65, 0xf4, 0x18, 0x3e, 0xc9, 0xc7, 0x4f, 0x66, 0xb7, 0xf8, 0xe2, 0xb3, 0xb7, 0xcf, 0x4f, 0xac,
+		0xe0, 0x5d, 0x78, 0xc4, 0xf7, 0xdc, 0x7e, 0x63, 0xe2, 0xdf, 0x0d, 0xfe, 0x84, 0x38, 0xf1, 0xdf,
+		0x29, 0xe3, 0xbf, 0x62, 0x7e, 0x10, 0x7f, 0x3a, 0xdb, 0x39, 0x5a, 0xa6, 0x4f, 0x9e, 0xfe, 0x67,
+		0x00, 0xd8, 0x06, 0x2c, 0x43, 0xb4, 0x19, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/replication.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x73, 0x1a, 0x47,
-		0x13, 0xf6, 0x82, 0xf8, 0x50, 0x0b, 0x01, 0x1a, 0xe9, 0xb5, 0xb0, 0x64, 0xd5, 0x8b, 0x89, 0x6c,
-		0xc9, 0x72, 0x0a, 0x6c, 0xb9, 0x9c, 0xcf, 0x4a, 0xb9, 0xd6, 0x02, 0x95, 0x36, 0xd6, 0x97, 0x87,
-		0xb5, 0x5c, 0xca, 0x21, 0x5b, 0x2b, 0x76, 0x24, 0xb6, 0x04, 0xbb, 0xd4, 0xce, 0x80, 0xcc, 0x31,
-		0xb9, 0xe7, 0x98, 0x5c, 0x72, 0xcc, 0xef, 0xc8, 0x35, 0x67, 0xff, 0xa4, 0xd4, 0xce, 0xcc, 0x02,
-		0x0b, 0x0b, 0x96, 0xcb, 0x87, 0xdc, 0xb4, 0xdd, 0xcf, 0xd3, 0xdd, 0xd3, 0xdd, 0xd3, 0x3d, 0x02,
-		0xb6, 0xbb, 0x17, 0xc4, 0xab, 0x34, 0x4c, 0x8b, 0x38, 0x0d, 0x52, 0xa1, 0x4d, 0xd3, 0x23, 0x56,
-		0xa5, 0xf7, 0xac, 0xe2, 0x91, 0x4e, 0xcb, 0x6e, 0x98, 0xcc, 0x76, 0x9d, 0x72, 0xc7, 0x73, 0x99,
-		0x8b, 0xee, 0xfa, 0xc8, 0xb2, 0x44, 0x96, 0x05, 0xb2, 0xdc, 0x7b, 0xb6, 0xf6, 0xff, 0x2b, 0xd7,
-		0xbd, 0x6a, 0x91, 0x0a, 0x47, 0x5d, 0x74, 0x2f, 0x2b, 0xcc, 0x6e, 0x13, 0xca, 0xcc, 0x76, 0x47,
-		0x10, 0xd7, 0x8a, 0x21, 0x17, 0x66, 0xc7, 0xf6, 0xed, 0x37, 0xdc, 0x76, 0x3b, 0x30, 0x1d, 0x8d,
-		0xb0, 0xdc, 0xb6, 0x69, 0x07, 0x88, 0xcd, 0x29, 0x61, 0x36, 0x6d, 0xca, 0x5c, 0xaf, 0x2f, 0x50,
-		0xa5, 0x3f, 0x62, 0xb0, 0x8c, 0x87, 0x81, 0x1f, 0x11, 0x4a, 0xcd, 0x2b, 0x42, 0x91, 0x0e, 0x4b,
-		0x23, 0xe7, 0x31, 0x98, 0x49, 0xaf, 0x69, 0x41, 0x29, 0xc6, 0xb7, 0x17, 0x76, 0xb7, 0xca, 0xd1,
-		0xc7, 0x2a, 0x8f, 0xd8, 0xd1, 0x4d, 0x7a, 0x8d, 0xf3, 0x5e, 0x58, 0x40, 0xd1, 0xb7, 0x70, 0xaf,
-		0x65, 0x52, 0x66, 0x78, 0x84, 0x79, 0x36, 0xe9, 0x11, 0xcb, 0x68, 0x0b, 0x87, 0x86, 0x6d, 0x15,
-		0x62, 0x45, 0x65, 0x3b, 0x8e, 0xef, 0xfa, 0x00, 0x1c, 0xe8, 0x65, 0x3c, 0x9a, 0x85, 0xee, 0x41,
-		0xba, 0x69, 0x52, 0xa3, 0xed, 0x7a, 0xa4, 0x10, 0x2f, 0x2a, 0xdb, 0x69, 0x9c, 0x6a, 0x9a, 0xf4,
-		0xc8, 0xf5, 0x08, 0xaa, 0xc3, 0x12, 0xed, 0x3b, 0x0d, 0xc3, 0x8f, 0xc4, 0x32, 0x28, 0x33, 0x59,
-		0x97, 0x16, 0xe6, 0x8a, 0xca, 0xac, 0x58, 0xeb, 0x7d, 0xa7, 0x51, 0xf7, 0xf1, 0x75, 0x0e, 0xc7,
-		0x39, 0x1a, 0x16, 0x94, 0x7e, 0x4f, 0x42, 0x6e, 0xec, 0x40, 0xe8, 0x00, 0xe6, 0xfd, 0x44, 0x18,
-		0xac, 0xdf, 0x21, 0x05, 0xa5, 0xa8, 0x6c, 0x67, 0x77, 0x9f, 0xdc, 0x32, 0x19, 0x7a, 0xbf, 0x43,
-		0x70, 0x9a, 0xc9, 0xbf, 0xd0, 0x26, 0x64, 0xa9, 0xdb, 0xf5, 0x1a, 0x84, 0x67, 0x76, 0x78, 0xfa,
-		0x8c, 0x90, 0xfa, 0x0c, 0xcd, 0x42, 0x2f, 0x61, 0xb1, 0xe1, 0x11, 0x59, 0x01, 0xbb, 0x2d, 0x0e,
-		0xbe, 0xb0, 0xbb, 0x56, 0x16, 0xfd, 0x53, 0x0e, 0xfa, 0xa7, 0xac, 0x07, 0xfd, 0x83, 0x33, 0x01,
-		0xc1, 0x17, 0x21, 0x0b, 0xee, 0x8a, 0x9e, 0x10, 0x6e, 0x4c, 0xc6, 0x3c, 0xfb, 0xa2, 0xcb, 0x48,
-		0x90, 0x9e, 0x2f, 0xa7, 0x45, 0x5f, 0xe5, 0x2c, 0x3f, 0x0c, 0x75, 0xc0, 0x39, 0xb8, 0x83, 0x57,
-		0xac, 0x08, 0x39, 0xfa, 0x45, 0x81, 0x07, 0x13, 0x05, 0x98, 0xf0, 0x98, 0xe0, 0x1e, 0x5f, 0xdc,
-		0xb2, 0x20, 0x13, 0xae, 0x37, 0xe8, 0x2c, 0x00, 0xba, 0x01, 0x0e, 0x30, 0xcc, 0x06, 0xb3, 0x7b,
-		0x36, 0xeb, 0x4f, 0xb8, 0x4f, 0x72, 0xf7, 0xbb, 0xb3, 0xdc, 0xab, 0x92, 0x3b, 0xe1, 0x7b, 0x8d,
-		0x4e, 0xd5, 0x22, 0x07, 0xd6, 0xe4, 0x8d, 0x12, 0x2e, 0x7b, 0xbb, 0xa3, 0x5e, 0x53, 0xdc, 0x6b,
-		0x65, 0x9a, 0xd7, 0x03, 0xc1, 0xf4, 0x4d, 0x9e, 0xed, 0x86, 0x5c, 0xae, 0x36, 0xa3, 0x55, 0xa8,
-		0x03, 0x6b, 0x97, 0xa6, 0xdd, 0x72, 0x7b, 0xc4, 0x33, 0xda, 0xa6, 0x77, 0x4d, 0xbc, 0x51, 0x7f,
-		0x69, 0xee, 0xef, 0xe9, 0x34, 0x7f, 0xfb, 0x92, 0x79, 0xc4, 0x89, 0x21, 0x87, 0x85, 0xcb, 0x29,
-		0xba, 0x57, 0x19, 0x80, 0xa1, 0x87, 0xd2, 0xdf, 0x31, 0x58, 0x89, 0xea, 0x0e, 0x84, 0x21, 0x2f,
-		0x7b, 0xcd, 0xed, 0x10, 0x8f, 0xf7, 0xa0, 0xbc, 0x23, 0x5b, 0xb3, 0xbb, 0xec, 0x24, 0x80, 0xe3,
-		0x9c, 0x15, 0x16, 0xa0, 0x2c, 0xc4, 0xe4, 0xd5, 0x98, 0xc7, 0x31, 0xdb, 0x42, 0xcf, 0x21, 0x29,
-		0x20, 0xf2, 0x26, 0xac, 0x87, 0x2d, 0x9b, 0x1d, 0x7b, 0x68, 0x16, 0x4b, 0x28, 0x7a, 0x08, 0xd9,
-		0x86, 0xeb, 0x5c, 0xda, 0x57, 0x46, 0x8f, 0x78, 0xd4, 0x0f, 0x6b, 0x8e, 0xdf, 0xb5, 0x45, 0x21,
-		0x3d, 0x13, 0x42, 0xf4, 0x18, 0xf2, 0x83, 0xc4, 0x06, 0xc0, 0x04, 0x07, 0xe6, 0x02, 0x79, 0x00,
-		0xfd, 0x0e, 0xee, 0x75, 0x3c, 0xd2, 0xb3, 0xdd, 0x2e, 0x35, 0x26, 0x38, 0x49, 0xce, 0x59, 0x0d,
-		0x00, 0xfb, 0x61, 0x6e, 0xe9, 0x4f, 0x05, 0x36, 0x66, 0xf6, 0xba, 0x1f, 0xaf, 0x9c, 0x0d, 0x8d,
-		0x56, 0x97, 0x32, 0xe2, 0xf1, 0x34, 0xce, 0xe3, 0x45, 0x21, 0xdd, 0x13, 0x42, 0x7f, 0x20, 0x8a,
-		0xfb, 0x26, 0x33, 0x94, 0xc0, 0x29, 0xfe, 0xad, 0x59, 0xe8, 0x1b, 0x98, 0x1f, 0x6c, 0x94, 0x5b,
-		0xcc, 0x8c, 0x21, 0xb8, 0xf4, 0x21, 0x01, 0x6b, 0xd3, 0xaf, 0x02, 0x5a, 0x87, 0x79, 0x59, 0x63,
-		0xdb, 0x92, 0x51, 0xa5, 0x85, 0x40, 0xb3, 0xd0, 0x5b, 0x40, 0x37, 0xae, 0x77, 0x7d, 0xd9, 0x72,
-		0x6f, 0x0c, 0xf2, 0x9e, 0x34, 0xba, 0xbc, 0x05, 0x62, 0xdc, 0xfd, 0xa3, 0xc8, 0x42, 0xbd, 0x93,
-		0xf0, 0x5a, 0x80, 0xc6, 0x4b, 0x37, 0xe3, 0x22, 0x54, 0x80, 0x54, 0x90, 0xda, 0x38, 0x4f, 0x6d,
-		0xf0, 0x89, 0x1e, 0x40, 0x86, 0x36, 0x9a, 0xc4, 0xea, 0xb6, 0x08, 0xcf, 0x82, 0x28, 0xeb, 0xc2,
-		0x40, 0xa6, 0x59, 0x48, 0x85, 0xec, 0x10, 0xc2, 0x47, 0x68, 0xe2, 0xa3, 0xe9, 0x58, 0x1c, 0x30,
-		0xf8, 0x0c, 0xdd, 0x00, 0xa0, 0xcc, 0xf4, 0x98, 0xf0, 0x21, 0xaa, 0x3b, 0x2f, 0x25, 0x9a, 0x85,
-		0x7e, 0x80, 0x4c, 0xa0, 0xe6, 0xf6, 0x53, 0x1f, 0xb5, 0xbf, 0x20, 0xf1, 0xdc, 0xfa, 0x8f, 0xb0,
-		0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26, 0x13, 0x56, 0xd2, 0x1f, 0xb5, 0xb2, 0xe4,
-		0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x15, 0xa4, 0x2c, 0xc2, 0x4c, 0xbb, 0x45, 0x0b, 0xf3, 0x9c,
-		0x7f, 0x3f, 0x32, 0xeb, 0xa7, 0x66, 0xbf, 0xe5, 0x9a, 0x16, 0x0e, 0xc0, 0x7e, 0x86, 0x4d, 0xc6,
-		0x48, 0xbb, 0xc3, 0x0a, 0x20, 0x1a, 0x49, 0x7e, 0xa2, 0x97, 0x90, 0xe1, 0xd1, 0xf9, 0x4d, 0xde,
-		0xf5, 0x48, 0x61, 0x61, 0x86, 0xd9, 0x7d, 0x81, 0xc1, 0x0b, 0x3e, 0x43, 0x7e, 0xa0, 0xa7, 0xb0,
-		0xc2, 0x0d, 0xf8, 0x65, 0x25, 0x9e, 0x61, 0x5b, 0xc4, 0x61, 0x36, 0xeb, 0x17, 0x32, 0xbc, 0x77,
-		0x90, 0xaf, 0x7b, 0xc7, 0x55, 0x9a, 0xd4, 0xa0, 0x13, 0xc8, 0xc9, 0xfa, 0x1a, 0x72, 0x04, 0x16,
-		0x16, 0xa3, 0x5a, 0x68, 0x38, 0x45, 0xe4, 0xcd, 0x92, 0xb3, 0x14, 0x67, 0x7b, 0xa1, 0xef, 0xd2,
-		0xaf, 0x71, 0x58, 0x9d, 0x32, 0x67, 0xd1, 0x2a, 0xa4, 0x82, 0xfd, 0xab, 0xf0, 0xc2, 0x26, 0x99,
-		0xd8, 0xbc, 0xa1, 0x46, 0x8f, 0xdd, 0xaa, 0xd1, 0xe3, 0x9f, 0xdb, 0xe8, 0x3f, 0xc3, 0xff, 0xc6,
-		0x4e, 0x6e, 0xd8, 0x8c, 0xb4, 0xfd, 0x5d, 0xed, 0x3f, 0xbb, 0x76, 0x6e, 0x77, 0x7e, 0x8d, 0x91,
-		0x36, 0x5e, 0xee, 0x4d, 0xc8, 0x28, 0x7a, 0x01, 0x49, 0xd2, 0x23, 0x0e, 0x0b, 0x56, 0xf1, 0x46,
-		0xf4, 0xf0, 0x34, 0x99, 0xf9, 0xaa, 0xe5, 0x5e, 0x60, 0x09, 0x46, 0x7b, 0x90, 0x75, 0xc8, 0x8d,
-		0xe1, 0x75, 0x1d, 0x43, 0xd2, 0x93, 0xb7, 0xa1, 0x67, 0x1c, 0x72, 0x83, 0xbb, 0x4e, 0x8d, 0x53,
-		0x4a, 0x7f, 0x29, 0x50, 0x98, 0xb6, 0x7c, 0x66, 0x4f, 0x95, 0xa8, 0xb1, 0x1c, 0x8b, 0x1e, 0xcb,
-		0x9f, 0xfb, 0x5c, 0x2a, 0xfd, 0xa6, 0xc0, 0x72, 0x38, 0x4a, 0xdd, 0xbd, 0x26, 0x8e, 0x1f, 0x60,
-		0x30, 0x6a, 0xc5, 0x23, 0x38, 0x81, 0xd3, 0x72, 0xd6, 0x52, 0x74, 0x0e, 0xb9, 0xb1, 0x85, 0x2c,
-		0x67, 0xde, 0x27, 0x6f, 0x61, 0x9c, 0x0d, 0xef, 0xe0, 0xd2, 0x3f, 0xe1, 0xc7, 0x39, 0x7f, 0x15,
-		0x3a, 0x97, 0xee, 0x7f, 0x32, 0x86, 0xd7, 0x47, 0xdf, 0xbe, 0x71, 0x3e, 0x26, 0x86, 0xcf, 0xd9,
-		0x91, 0x7b, 0x34, 0x17, 0xba, 0x47, 0x23, 0xc3, 0x3b, 0x11, 0x1e, 0xde, 0x9b, 0x90, 0xbd, 0xb4,
-		0x3d, 0xca, 0x44, 0x53, 0x0d, 0x47, 0x6b, 0x86, 0x4b, 0x79, 0xdb, 0x68, 0x16, 0x2a, 0xc1, 0xa2,
-		0x43, 0xde, 0x8f, 0x80, 0x52, 0x62, 0xc6, 0xfb, 0xc2, 0x00, 0x33, 0xbe, 0x06, 0xd2, 0x13, 0x6b,
-		0xc0, 0x6f, 0xbf, 0xfc, 0x68, 0x22, 0x79, 0x55, 0x47, 0x17, 0xa8, 0x12, 0x5e, 0xa0, 0x9f, 0xf1,
-		0x7f, 0x4a, 0x40, 0xed, 0x78, 0x6e, 0x83, 0x50, 0x1a, 0xa6, 0xc6, 0x87, 0xd4, 0xd3, 0x40, 0x3f,
-		0xa0, 0x96, 0x5e, 0x43, 0x6e, 0xec, 0x65, 0x10, 0xde, 0xe4, 0xca, 0x27, 0x6c, 0xf2, 0x9d, 0x0f,
-		0x93, 0xbd, 0xc3, 0x4b, 0xf5, 0x00, 0x36, 0x70, 0xed, 0xf4, 0x50, 0xdb, 0x53, 0x75, 0xed, 0xe4,
-		0xd8, 0xd0, 0xd5, 0xfa, 0x6b, 0x43, 0x3f, 0x3f, 0xad, 0x19, 0xda, 0xf1, 0x99, 0x7a, 0xa8, 0x55,
-		0xf3, 0x77, 0x50, 0x11, 0xee, 0x47, 0x43, 0xaa, 0x27, 0x47, 0xaa, 0x76, 0x9c, 0x57, 0xa6, 0x1b,
-		0x39, 0xd0, 0xea, 0xfa, 0x09, 0x3e, 0xcf, 0xc7, 0xd0, 0x13, 0xd8, 0x8a, 0x86, 0xd4, 0xcf, 0x8f,
-		0xf7, 0x8c, 0xfa, 0x81, 0x8a, 0xab, 0x46, 0x5d, 0x57, 0xf5, 0xb7, 0xf5, 0x7c, 0x1c, 0x6d, 0xc1,
-		0x17, 0x33, 0xc0, 0xea, 0x9e, 0xae, 0x9d, 0x69, 0xfa, 0x79, 0x7e, 0x0e, 0xed, 0xc0, 0xa3, 0x99,
-		0x8e, 0x8d, 0xa3, 0x9a, 0xae, 0x56, 0x55, 0x5d, 0xcd, 0x27, 0xd0, 0x26, 0x14, 0x67, 0x63, 0xcf,
-		0x76, 0xf3, 0x49, 0xf4, 0x18, 0x1e, 0x46, 0xa3, 0xf6, 0x55, 0xed, 0xf0, 0xe4, 0xac, 0x86, 0x8d,
-		0x23, 0x15, 0xbf, 0xae, 0xe1, 0x7c, 0x6a, 0xc7, 0x86, 0xdc, 0xd8, 0x8b, 0x15, 0xdd, 0x87, 0x82,
-		0x48, 0x8a, 0x71, 0x72, 0x5a, 0xc3, 0xc2, 0xc4, 0x30, 0x91, 0xeb, 0xb0, 0x3a, 0xa1, 0xdd, 0xc3,
-		0x35, 0x55, 0xaf, 0xe5, 0x95, 0x48, 0xe5, 0xdb, 0xd3, 0xaa, 0xaf, 0x8c, 0xed, 0x1c, 0x43, 0xaa,
-		0x7a, 0xf8, 0x86, 0x17, 0x6c, 0x05, 0xf2, 0xd5, 0xc3, 0x37, 0xe3, 0x35, 0x2a, 0xc0, 0xca, 0x40,
-		0x3a, 0x12, 0x7f, 0x5e, 0x41, 0xcb, 0x90, 0x1b, 0x68, 0x64, 0xc1, 0x62, 0xaf, 0xbe, 0xfe, 0xe9,
-		0xc5, 0x95, 0xcd, 0x9a, 0xdd, 0x8b, 0x72, 0xc3, 0x6d, 0x57, 0x42, 0xbf, 0x0c, 0x94, 0xaf, 0x88,
-		0x23, 0x7e, 0x89, 0x18, 0xfe, 0x48, 0xf0, 0xbd, 0xf8, 0xab, 0xf7, 0xec, 0x22, 0xc9, 0x35, 0xcf,
-		0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x50, 0xe1, 0x45, 0xf5, 0x10, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0xdb, 0xc8,
+		0x11, 0x5e, 0x90, 0x16, 0x49, 0xb5, 0x28, 0x12, 0x1e, 0xc9, 0x16, 0x2c, 0x5b, 0x15, 0x99, 0xf6,
+		0xae, 0xb5, 0xda, 0x14, 0x65, 0x6b, 0xcb, 0xf9, 0xad, 0x64, 0x0b, 0x26, 0xa9, 0x12, 0x62, 0xfd,
+		0x70, 0x87, 0xb0, 0xb6, 0xb4, 0x87, 0xa0, 0x46, 0xc0, 0x48, 0x42, 0x91, 0x04, 0x58, 0xc0, 0x90,
+		0x5a, 0x1e, 0xf3, 0x00, 0x39, 0x26, 0x97, 0x1c, 0x53, 0x79, 0x95, 0xdc, 0x52, 0xe5, 0x07, 0xc9,
+		0x43, 0xa4, 0xe6, 0x07, 0x24, 0xc1, 0x3f, 0x2b, 0xf1, 0x21, 0x37, 0x4e, 0xf7, 0xd7, 0xdd, 0x33,
+		0x3d, 0x5f, 0x4f, 0x37, 0x08, 0x7b, 0xfd, 0x2b, 0x1a, 0x1d, 0xb8, 0xc4, 0xa3, 0x81, 0x4b, 0x0f,
+		0xe2, 0x5b, 0x12, 0x51, 0xef, 0x60, 0xf0, 0xe6, 0x20, 0xa2, 0xbd, 0x8e, 0xef, 0x12, 0xe6, 0x87,
+		0x41, 0xb5, 0x17, 0x85, 0x2c, 0x44, 0x8f, 0x39, 0xb2, 0xaa, 0x90, 0x55, 0x89, 0xac, 0x0e, 0xde,
+		0x6c, 0xff, 0xec, 0x26, 0x0c, 0x6f, 0x3a, 0xf4, 0x40, 0xa0, 0xae, 0xfa, 0xd7, 0x07, 0xcc, 0xef,
+		0xd2, 0x98, 0x91, 0x6e, 0x4f, 0x1a, 0x6e, 0xef, 0xa6, 0x42, 0x90, 0x9e, 0xcf, 0xfd, 0xbb, 0x61,
+		0xb7, 0x1b, 0x06, 0xcb, 0x10, 0x5e, 0xd8, 0x25, 0x7e, 0x82, 0x78, 0xb1, 0x60, 0x9b, 0x29, 0xd0,
+		0xcb, 0x05, 0xa0, 0x5b, 0x3f, 0x66, 0x61, 0x34, 0x94, 0xa8, 0xca, 0x5f, 0x33, 0xb0, 0x81, 0xc7,
+		0xa7, 0x3b, 0xa5, 0x71, 0x4c, 0x6e, 0x68, 0x8c, 0x6c, 0x78, 0x38, 0x71, 0x68, 0x87, 0x91, 0xb8,
+		0x1d, 0x1b, 0xda, 0x6e, 0x76, 0x6f, 0xed, 0xf0, 0x55, 0x75, 0xfe, 0xd9, 0xab, 0x13, 0x7e, 0x6c,
+		0x12, 0xb7, 0xb1, 0x1e, 0xa5, 0x05, 0x31, 0xfa, 0x35, 0x3c, 0xe9, 0x90, 0x98, 0x39, 0x11, 0x65,
+		0x91, 0x4f, 0x07, 0xd4, 0x73, 0xba, 0x32, 0xa0, 0xe3, 0x7b, 0x46, 0x66, 0x57, 0xdb, 0xcb, 0xe2,
+		0xc7, 0x1c, 0x80, 0x13, 0xbd, 0xda, 0x8f, 0xe5, 0xa1, 0x27, 0x50, 0xb8, 0x25, 0xb1, 0xd3, 0x0d,
+		0x23, 0x6a, 0x64, 0x77, 0xb5, 0xbd, 0x02, 0xce, 0xdf, 0x92, 0xf8, 0x34, 0x8c, 0x28, 0x6a, 0xc1,
+		0xc3, 0x78, 0x18, 0xb8, 0x0e, 0xdf, 0x89, 0xe7, 0xc4, 0x8c, 0xb0, 0x7e, 0x6c, 0x3c, 0xd8, 0xd5,
+		0x96, 0xed, 0xb5, 0x35, 0x0c, 0xdc, 0x16, 0xc7, 0xb7, 0x04, 0x1c, 0x97, 0xe3, 0xb4, 0xa0, 0xf2,
+		0x97, 0x1c, 0x94, 0xa7, 0x0e, 0x84, 0x8e, 0x61, 0x95, 0x27, 0xc2, 0x61, 0xc3, 0x1e, 0x35, 0xb4,
+		0x5d, 0x6d, 0xaf, 0x74, 0xf8, 0xcd, 0x3d, 0x93, 0x61, 0x0f, 0x7b, 0x14, 0x17, 0x98, 0xfa, 0x85,
+		0x5e, 0x42, 0x29, 0x0e, 0xfb, 0x91, 0x4b, 0x45, 0x66, 0xc7, 0xa7, 0x2f, 0x4a, 0x29, 0xb7, 0xb0,
+		0x3c, 0xf4, 0x1d, 0xac, 0xbb, 0x11, 0x55, 0x37, 0xe0, 0x77, 0xe5, 0xc1, 0xd7, 0x0e, 0xb7, 0xab,
+		0x92, 0x64, 0xd5, 0x84, 0x64, 0x55, 0x3b, 0x21, 0x19, 0x2e, 0x26, 0x06, 0x5c, 0x84, 0x3c, 0x78,
+		0x2c, 0x39, 0x21, 0xc3, 0x10, 0xc6, 0x22, 0xff, 0xaa, 0xcf, 0x68, 0x92, 0x9e, 0x9f, 0x2f, 0xda,
+		0x7d, 0x5d, 0x58, 0xf1, 0x6d, 0x98, 0x23, 0x9b, 0xe3, 0x2f, 0xf0, 0xa6, 0x37, 0x47, 0x8e, 0xfe,
+		0xa4, 0xc1, 0xf3, 0x99, 0x0b, 0x98, 0x89, 0xb8, 0x22, 0x22, 0xbe, 0xbd, 0xe7, 0x85, 0xcc, 0x84,
+		0xde, 0x89, 0x97, 0x01, 0xd0, 0x1d, 0x08, 0x80, 0x43, 0x5c, 0xe6, 0x0f, 0x7c, 0x36, 0x9c, 0x09,
+		0x9f, 0x13, 0xe1, 0x0f, 0x97, 0x85, 0x37, 0x95, 0xed, 0x4c, 0xec, 0xed, 0x78, 0xa1, 0x16, 0x05,
+		0xb0, 0xad, 0x2a, 0x4a, 0x86, 0x1c, 0x1c, 0x4e, 0x46, 0xcd, 0x8b, 0xa8, 0x07, 0x8b, 0xa2, 0x1e,
+		0x4b, 0x4b, 0xee, 0xf2, 0xe2, 0x30, 0x15, 0x72, 0xeb, 0x76, 0xbe, 0x0a, 0xf5, 0x60, 0xfb, 0x9a,
+		0xf8, 0x9d, 0x70, 0x40, 0x23, 0xa7, 0x4b, 0xa2, 0x36, 0x8d, 0x26, 0xe3, 0x15, 0x44, 0xbc, 0xd7,
+		0x8b, 0xe2, 0x1d, 0x29, 0xcb, 0x53, 0x61, 0x98, 0x0a, 0x68, 0x5c, 0x2f, 0xd0, 0xbd, 0x2b, 0x02,
+		0x8c, 0x23, 0x54, 0xfe, 0x91, 0x85, 0xcd, 0x79, 0xec, 0x40, 0x18, 0x74, 0xc5, 0xb5, 0xb0, 0x47,
+		0x23, 0xc1, 0x41, 0x55, 0x23, 0xaf, 0x96, 0xb3, 0xec, 0x3c, 0x81, 0xe3, 0xb2, 0x97, 0x16, 0xa0,
+		0x12, 0x64, 0x54, 0x69, 0xac, 0xe2, 0x8c, 0xef, 0xa1, 0x6f, 0x21, 0x27, 0x21, 0xaa, 0x12, 0x9e,
+		0xa6, 0x3d, 0x93, 0x9e, 0x3f, 0x76, 0x8b, 0x15, 0x14, 0x7d, 0x09, 0x25, 0x37, 0x0c, 0xae, 0xfd,
+		0x1b, 0x67, 0x40, 0xa3, 0x98, 0x6f, 0xeb, 0x81, 0xa8, 0xb5, 0x75, 0x29, 0xbd, 0x90, 0x42, 0xf4,
+		0x35, 0xe8, 0xa3, 0xc4, 0x26, 0xc0, 0x15, 0x01, 0x2c, 0x27, 0xf2, 0x04, 0xfa, 0x1b, 0x78, 0xd2,
+		0x8b, 0xe8, 0xc0, 0x0f, 0xfb, 0xb1, 0x33, 0x63, 0x93, 0x13, 0x36, 0x5b, 0x09, 0xe0, 0x68, 0xca,
+		0xf6, 0x1a, 0x1e, 0x11, 0xc1, 0xd4, 0xbb, 0x30, 0x6a, 0x5f, 0x77, 0xc2, 0x3b, 0x47, 0x6e, 0xc3,
+		0xc8, 0x2f, 0x27, 0xa8, 0xc9, 0x8d, 0x7e, 0x50, 0x36, 0x35, 0x61, 0xd2, 0x57, 0x69, 0xdb, 0x20,
+		0xb3, 0xba, 0xca, 0xdf, 0x34, 0xd8, 0x59, 0x5a, 0x53, 0x3c, 0x2f, 0xea, 0x0d, 0x72, 0x3b, 0xfd,
+		0x98, 0xd1, 0x48, 0x5c, 0xd7, 0x2a, 0x5e, 0x97, 0xd2, 0x9a, 0x14, 0xf2, 0x87, 0x57, 0xd6, 0xb5,
+		0xba, 0x89, 0x15, 0x9c, 0x17, 0x6b, 0xcb, 0x43, 0xbf, 0x82, 0xd5, 0x51, 0x7b, 0xbb, 0xc7, 0xdb,
+		0x34, 0x06, 0x57, 0x3e, 0xae, 0xc0, 0xf6, 0xe2, 0x92, 0x43, 0x4f, 0x61, 0x55, 0x71, 0xc9, 0xf7,
+		0xd4, 0xae, 0x0a, 0x52, 0x60, 0x79, 0xe8, 0x03, 0xa0, 0x51, 0xee, 0xe8, 0x4f, 0xd4, 0xed, 0x0b,
+		0xaa, 0x65, 0x44, 0xf8, 0xaf, 0xe6, 0x12, 0x22, 0x49, 0x4d, 0x23, 0x41, 0xe3, 0x87, 0x77, 0xd3,
+		0x22, 0x64, 0x40, 0x3e, 0xb9, 0xc2, 0xac, 0xb8, 0xc2, 0x64, 0x89, 0x9e, 0x43, 0x31, 0x76, 0x6f,
+		0xa9, 0xd7, 0xef, 0x50, 0x91, 0x05, 0x49, 0x9f, 0xb5, 0x91, 0xcc, 0xf2, 0x90, 0x09, 0xa5, 0x31,
+		0x44, 0x3c, 0xd5, 0x2b, 0x9f, 0x4c, 0xc7, 0xfa, 0xc8, 0x82, 0xcb, 0xd0, 0x0e, 0x40, 0xcc, 0x48,
+		0xc4, 0x64, 0x0c, 0xc9, 0xa2, 0x55, 0x25, 0xb1, 0x3c, 0xf4, 0x3b, 0x28, 0x26, 0x6a, 0xe1, 0x3f,
+		0xff, 0x49, 0xff, 0x6b, 0x0a, 0x2f, 0xbc, 0xff, 0x01, 0x36, 0x44, 0xe7, 0xbd, 0xa5, 0x24, 0x62,
+		0x57, 0x94, 0x30, 0xe9, 0xa5, 0xf0, 0x49, 0x2f, 0x0f, 0xb9, 0xd9, 0x71, 0x62, 0x25, 0x7c, 0xfd,
+		0x02, 0xf2, 0x1e, 0x65, 0xc4, 0xef, 0xc4, 0xc6, 0xaa, 0xb0, 0x7f, 0x36, 0x37, 0xeb, 0x4d, 0x32,
+		0xec, 0x84, 0xc4, 0xc3, 0x09, 0x98, 0x67, 0x98, 0x30, 0x46, 0xbb, 0x3d, 0x66, 0x80, 0x24, 0x92,
+		0x5a, 0xa2, 0xef, 0xa0, 0x28, 0x76, 0xc7, 0x8b, 0xa9, 0x1f, 0x51, 0x63, 0x6d, 0x89, 0xdb, 0x23,
+		0x89, 0xc1, 0x6b, 0xdc, 0x42, 0x2d, 0xd0, 0x6b, 0xd8, 0x14, 0x0e, 0xf8, 0xb5, 0xd2, 0xc8, 0xf1,
+		0x3d, 0x1a, 0x30, 0x9f, 0x0d, 0x8d, 0xa2, 0xe0, 0x0e, 0xe2, 0xba, 0x1f, 0x84, 0xca, 0x52, 0x1a,
+		0x74, 0x0e, 0x65, 0x75, 0xbf, 0x8e, 0x7a, 0x6a, 0x8d, 0xf5, 0x79, 0x14, 0x1a, 0x57, 0xa0, 0xaa,
+		0x60, 0xf5, 0x66, 0xe3, 0xd2, 0x20, 0xb5, 0xae, 0xfc, 0x2b, 0x0b, 0x5b, 0x0b, 0xde, 0x73, 0xb4,
+		0x05, 0xf9, 0xa4, 0xcf, 0x6b, 0xe2, 0x62, 0x73, 0x4c, 0x76, 0xf8, 0x14, 0xd1, 0x33, 0xf7, 0x22,
+		0x7a, 0xf6, 0x73, 0x89, 0xfe, 0x47, 0x78, 0x34, 0x75, 0x72, 0xc7, 0x67, 0xb4, 0xcb, 0x67, 0x02,
+		0x3e, 0xde, 0xed, 0xdf, 0xef, 0xfc, 0x16, 0xa3, 0x5d, 0xbc, 0x31, 0x98, 0x91, 0xc5, 0xe8, 0x2d,
+		0xe4, 0xe8, 0x80, 0x06, 0x2c, 0x69, 0xf9, 0x3b, 0xf3, 0x1f, 0x69, 0xc2, 0xc8, 0xbb, 0x4e, 0x78,
+		0x85, 0x15, 0x18, 0xd5, 0xa0, 0x14, 0xd0, 0x3b, 0x27, 0xea, 0x07, 0x8e, 0x32, 0xcf, 0xdd, 0xc7,
+		0xbc, 0x18, 0xd0, 0x3b, 0xdc, 0x0f, 0x1a, 0xd2, 0x89, 0x05, 0x6b, 0x6e, 0xd8, 0xed, 0x45, 0x34,
+		0x16, 0x85, 0x9c, 0x5f, 0xde, 0x7f, 0x6a, 0x63, 0xa8, 0x98, 0xcf, 0x26, 0x6d, 0x2b, 0x7f, 0xd7,
+		0xc0, 0x58, 0xd4, 0x2f, 0x97, 0x3f, 0x50, 0xf3, 0x3a, 0x49, 0x66, 0x7e, 0x27, 0xf9, 0xdc, 0x09,
+		0xaf, 0xf2, 0x67, 0x0d, 0x36, 0xd2, 0xbb, 0xb4, 0xc3, 0x36, 0x0d, 0xf8, 0x06, 0x93, 0x57, 0x5b,
+		0xce, 0xed, 0x2b, 0xb8, 0xa0, 0x9e, 0xed, 0x18, 0x5d, 0x42, 0x79, 0x6a, 0x86, 0x30, 0x32, 0xff,
+		0xdb, 0xe0, 0x80, 0x4b, 0xe9, 0xb1, 0xa1, 0xf2, 0xcf, 0xf4, 0xf7, 0x84, 0x18, 0x64, 0x83, 0xeb,
+		0xf0, 0xff, 0xf2, 0xa2, 0x3f, 0x9d, 0x1c, 0xd7, 0xb3, 0xe2, 0xc5, 0x19, 0x4f, 0xe0, 0x13, 0x25,
+		0xf9, 0x20, 0x55, 0x92, 0x13, 0x7d, 0x60, 0x25, 0xdd, 0x07, 0x5e, 0x42, 0xe9, 0xda, 0x8f, 0x62,
+		0x26, 0xf9, 0x39, 0x7e, 0xa5, 0x8b, 0x42, 0x2a, 0x18, 0x68, 0x79, 0xa8, 0x02, 0xeb, 0x01, 0xfd,
+		0x69, 0x02, 0x94, 0x97, 0xed, 0x82, 0x0b, 0x13, 0xcc, 0x74, 0x47, 0x29, 0xcc, 0x74, 0x14, 0x4e,
+		0x3f, 0x7d, 0x32, 0x91, 0xe2, 0x56, 0x27, 0x7b, 0xb1, 0x96, 0xee, 0xc5, 0x9f, 0xf1, 0x69, 0x95,
+		0x98, 0xf6, 0xa2, 0xd0, 0xa5, 0x71, 0x9c, 0x36, 0xcd, 0x8e, 0x4d, 0x9b, 0x89, 0x7e, 0x64, 0x5a,
+		0x79, 0x0f, 0xe5, 0xa9, 0x21, 0x23, 0x3d, 0x14, 0x68, 0xff, 0xcd, 0x50, 0xf0, 0x6f, 0x2d, 0xc5,
+		0x1d, 0xd3, 0x6d, 0x73, 0x97, 0x94, 0x67, 0x4b, 0x4d, 0x28, 0x4e, 0x40, 0xba, 0x54, 0xd1, 0x67,
+		0x4d, 0xc9, 0xce, 0x48, 0x97, 0xf2, 0xab, 0x26, 0x6e, 0xdb, 0xe9, 0xd0, 0x01, 0xed, 0xa8, 0xd3,
+		0x16, 0x88, 0xdb, 0x3e, 0xe1, 0x6b, 0xf4, 0x7b, 0x58, 0x17, 0xe7, 0xe3, 0x88, 0x7b, 0x16, 0x99,
+		0x68, 0x2e, 0xa6, 0xdb, 0xe6, 0x12, 0xf4, 0x02, 0xd6, 0x7b, 0x34, 0xf0, 0xfc, 0xe0, 0x46, 0x7d,
+		0x07, 0x4b, 0xc2, 0x14, 0x95, 0x50, 0x7e, 0xda, 0xbe, 0x86, 0xcd, 0x14, 0xc8, 0x71, 0x49, 0xaf,
+		0x47, 0x3d, 0xc1, 0xa1, 0x02, 0x46, 0x93, 0xd8, 0x9a, 0xd0, 0xec, 0x7f, 0x9c, 0x2d, 0x15, 0xc1,
+		0xcc, 0xe7, 0xb0, 0x83, 0x1b, 0xcd, 0x13, 0xab, 0x66, 0xda, 0xd6, 0xf9, 0x99, 0x63, 0x9b, 0xad,
+		0xf7, 0x8e, 0x7d, 0xd9, 0x6c, 0x38, 0xd6, 0xd9, 0x85, 0x79, 0x62, 0xd5, 0xf5, 0x2f, 0xd0, 0x2e,
+		0x3c, 0x9b, 0x0f, 0xa9, 0x9f, 0x9f, 0x9a, 0xd6, 0x99, 0xae, 0x2d, 0x76, 0x72, 0x6c, 0xb5, 0xec,
+		0x73, 0x7c, 0xa9, 0x67, 0xd0, 0x37, 0xf0, 0x6a, 0x3e, 0xa4, 0x75, 0x79, 0x56, 0x73, 0x5a, 0xc7,
+		0x26, 0xae, 0x3b, 0x2d, 0xdb, 0xb4, 0x3f, 0xb4, 0xf4, 0x2c, 0x7a, 0x05, 0x2f, 0x96, 0x80, 0xcd,
+		0x9a, 0x6d, 0x5d, 0x58, 0xf6, 0xa5, 0xfe, 0x00, 0xed, 0xc3, 0x57, 0x4b, 0x03, 0x3b, 0xa7, 0x0d,
+		0xdb, 0xac, 0x9b, 0xb6, 0xa9, 0xaf, 0xa0, 0x97, 0xb0, 0xbb, 0x1c, 0x7b, 0x71, 0xa8, 0xe7, 0xd0,
+		0xd7, 0xf0, 0xe5, 0x7c, 0xd4, 0x91, 0x69, 0x9d, 0x9c, 0x5f, 0x34, 0xb0, 0x73, 0x6a, 0xe2, 0xf7,
+		0x0d, 0xac, 0xe7, 0xf7, 0x6f, 0xa0, 0x3c, 0xf5, 0xa6, 0xa3, 0x67, 0x60, 0xd4, 0xce, 0x4f, 0x9b,
+		0xb8, 0xd1, 0x6a, 0x09, 0xeb, 0x74, 0x22, 0x9f, 0xc2, 0xd6, 0x8c, 0xb6, 0x75, 0x66, 0x36, 0x9b,
+		0x97, 0xba, 0x86, 0x9e, 0xc0, 0xa3, 0x19, 0xe5, 0x8f, 0x2d, 0xbb, 0xae, 0x67, 0xf6, 0x7d, 0x28,
+		0x4f, 0x7d, 0xbc, 0xf0, 0x40, 0x32, 0xfb, 0xce, 0x79, 0xb3, 0x81, 0xe5, 0x5e, 0x53, 0x81, 0x66,
+		0xb4, 0x35, 0xdc, 0x30, 0xed, 0x86, 0xae, 0xcd, 0x55, 0x7e, 0x68, 0xd6, 0xb9, 0x32, 0xb3, 0x7f,
+		0x06, 0xf9, 0xfa, 0xc9, 0xf7, 0xe2, 0x2c, 0x9b, 0xa0, 0xd7, 0x4f, 0xbe, 0x9f, 0x3e, 0x83, 0x01,
+		0x9b, 0x23, 0xe9, 0x44, 0xa2, 0x74, 0x0d, 0x6d, 0x40, 0x79, 0xa4, 0x51, 0xcc, 0xc8, 0xbc, 0xfb,
+		0xe5, 0x8f, 0x6f, 0x6f, 0x7c, 0x76, 0xdb, 0xbf, 0xaa, 0xba, 0x61, 0xf7, 0x20, 0xf5, 0x27, 0x51,
+		0xf5, 0x86, 0x06, 0xf2, 0x9f, 0xab, 0xf1, 0xff, 0x45, 0xbf, 0x95, 0xbf, 0x06, 0x6f, 0xae, 0x72,
+		0x42, 0xf3, 0xed, 0x7f, 0x06, 0x00, 0xde, 0xc6, 0xe2, 0x4a, 0x25, 0x13, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/domain.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xd1, 0x6e, 0xdb, 0x36,
-		0x14, 0x9d, 0xec, 0x24, 0x73, 0xae, 0x1c, 0xd7, 0x65, 0x9a, 0x46, 0xf1, 0x86, 0x45, 0x4d, 0x51,
-		0xc0, 0x2b, 0x30, 0x79, 0xf1, 0x86, 0xad, 0xdd, 0xb0, 0x07, 0xc7, 0x52, 0x3b, 0x0f, 0x59, 0x16,
-		0xc8, 0x6e, 0x30, 0x6c, 0x0f, 0x02, 0x2d, 0xd1, 0x36, 0x51, 0x59, 0x14, 0x28, 0xda, 0x69, 0xde,
-		0x86, 0x7d, 0xc4, 0x3e, 0x66, 0x8f, 0xfb, 0xb2, 0x41, 0x14, 0xa5, 0x38, 0xb6, 0x90, 0xf4, 0x8d,
-		0xbc, 0xf7, 0x9e, 0xc3, 0xa3, 0xa3, 0x7b, 0x29, 0x81, 0xb9, 0x18, 0x13, 0xde, 0xf1, 0x71, 0x40,
-		0x22, 0x9f, 0x74, 0x70, 0x4c, 0x3b, 0xcb, 0xd3, 0x4e, 0xc0, 0xe6, 0x98, 0x46, 0x56, 0xcc, 0x99,
-		0x60, 0x68, 0x3f, 0xad, 0xb0, 0x54, 0x85, 0x85, 0x63, 0x6a, 0x2d, 0x4f, 0x5b, 0x5f, 0x4c, 0x19,
-		0x9b, 0x86, 0xa4, 0x23, 0x4b, 0xc6, 0x8b, 0x49, 0x27, 0x58, 0x70, 0x2c, 0x28, 0x53, 0xa0, 0xd6,
-		0xf1, 0x7a, 0x5e, 0xd0, 0x39, 0x49, 0x04, 0x9e, 0xc7, 0x59, 0xc1, 0xc9, 0x3f, 0x35, 0xd8, 0xb1,
-		0xe5, 0x31, 0xa8, 0x01, 0x15, 0x1a, 0x18, 0x9a, 0xa9, 0xb5, 0x77, 0xdd, 0x0a, 0x0d, 0x10, 0x82,
-		0xad, 0x08, 0xcf, 0x89, 0x51, 0x91, 0x11, 0xb9, 0x46, 0xaf, 0x61, 0x27, 0x11, 0x58, 0x2c, 0x12,
-		0xa3, 0x6a, 0x6a, 0xed, 0x46, 0xf7, 0x99, 0x55, 0xa2, 0xca, 0xca, 0x08, 0x87, 0xb2, 0xd0, 0x55,
-		0x00, 0x64, 0x82, 0x1e, 0x90, 0xc4, 0xe7, 0x34, 0x4e, 0xf5, 0x19, 0x5b, 0x92, 0x75, 0x35, 0x84,
-		0x8e, 0x41, 0x67, 0xd7, 0x11, 0xe1, 0x1e, 0x99, 0x63, 0x1a, 0x1a, 0xdb, 0xb2, 0x02, 0x64, 0xc8,
-		0x49, 0x23, 0xe8, 0x35, 0x6c, 0x05, 0x58, 0x60, 0x63, 0xc7, 0xac, 0xb6, 0xf5, 0xee, 0x8b, 0x7b,
-		0xce, 0xb6, 0x6c, 0x2c, 0xb0, 0x13, 0x09, 0x7e, 0xe3, 0x4a, 0x08, 0x9a, 0xc1, 0xf3, 0x6b, 0xc6,
-		0xdf, 0x4f, 0x42, 0x76, 0xed, 0x91, 0x0f, 0xc4, 0x5f, 0xa4, 0x27, 0x7a, 0x9c, 0x08, 0x12, 0xc9,
-		0x55, 0x4c, 0x38, 0x65, 0x81, 0xf1, 0xa9, 0xa9, 0xb5, 0xf5, 0xee, 0x91, 0x95, 0xd9, 0x66, 0xe5,
-		0xb6, 0x59, 0xb6, 0xb2, 0xd5, 0x35, 0x73, 0x16, 0x27, 0x27, 0x71, 0x73, 0x8e, 0x4b, 0x49, 0x81,
-		0xfa, 0x50, 0x1f, 0xe3, 0xc0, 0x1b, 0xd3, 0x08, 0x73, 0x4a, 0x12, 0xa3, 0x26, 0x29, 0xcd, 0x52,
-		0xb1, 0x67, 0x38, 0x38, 0x53, 0x75, 0xae, 0x3e, 0xbe, 0xdd, 0xa0, 0x3f, 0xe1, 0x70, 0x46, 0x13,
-		0xc1, 0xf8, 0x8d, 0x87, 0xb9, 0x3f, 0xa3, 0x4b, 0x1c, 0x7a, 0xca, 0xf8, 0x5d, 0x69, 0xfc, 0xf3,
-		0x52, 0xbe, 0x9e, 0xaa, 0x55, 0xd6, 0x1f, 0x28, 0x8e, 0xbb, 0x61, 0xf4, 0x35, 0x3c, 0xd9, 0x20,
-		0x5f, 0x70, 0x6a, 0x80, 0x34, 0x1c, 0xad, 0x81, 0xde, 0x71, 0x8a, 0x30, 0xb4, 0x96, 0x34, 0xa1,
-		0x63, 0x1a, 0x52, 0xb1, 0xa9, 0x48, 0xff, 0x78, 0x45, 0xc6, 0x2d, 0xcd, 0x9a, 0xa8, 0xef, 0xe0,
-		0xb0, 0xec, 0x88, 0x54, 0x57, 0x5d, 0xea, 0x3a, 0xd8, 0x84, 0xa6, 0xd2, 0x2c, 0xd8, 0xc7, 0xbe,
-		0xa0, 0x4b, 0xe2, 0xf9, 0xe1, 0x22, 0x11, 0x84, 0x7b, 0xb2, 0x69, 0xf7, 0x24, 0xe6, 0x71, 0x96,
-		0xea, 0x67, 0x99, 0x8b, 0xb4, 0x83, 0x2f, 0xa1, 0xa6, 0x0a, 0x13, 0xa3, 0x21, 0xfb, 0xe8, 0xdb,
-		0x52, 0xe1, 0x0a, 0xe3, 0x92, 0x38, 0xa4, 0xbe, 0x7c, 0xf7, 0x7d, 0x16, 0x4d, 0xe8, 0x34, 0x6f,
-		0x84, 0x82, 0x05, 0x7d, 0x09, 0xcd, 0x09, 0xa6, 0x21, 0x5b, 0x12, 0xee, 0x2d, 0x09, 0x4f, 0xd2,
-		0xee, 0x7e, 0x64, 0x6a, 0xed, 0xaa, 0xfb, 0x28, 0x8f, 0x5f, 0x65, 0x61, 0xd4, 0x86, 0x26, 0x4d,
-		0xbc, 0x69, 0xc8, 0xc6, 0x38, 0xf4, 0xb2, 0xe9, 0x36, 0x9a, 0xa6, 0xd6, 0xae, 0xb9, 0x0d, 0x9a,
-		0xbc, 0x95, 0x61, 0x35, 0x8c, 0x6f, 0x60, 0xaf, 0x20, 0xa5, 0xd1, 0x84, 0x19, 0x8f, 0x65, 0x1b,
-		0x95, 0xcf, 0xdb, 0x1b, 0x55, 0x39, 0x88, 0x26, 0xcc, 0xad, 0x4f, 0x56, 0x76, 0xad, 0xef, 0x61,
-		0xb7, 0x18, 0x05, 0xd4, 0x84, 0xea, 0x7b, 0x72, 0xa3, 0x46, 0x3c, 0x5d, 0xa2, 0x27, 0xb0, 0xbd,
-		0xc4, 0xe1, 0x22, 0x1f, 0xf2, 0x6c, 0xf3, 0x43, 0xe5, 0x95, 0x76, 0x62, 0xc3, 0xf1, 0x03, 0x16,
-		0xa0, 0x67, 0x50, 0xbf, 0xe3, 0x79, 0xc6, 0xab, 0xfb, 0xb7, 0x6e, 0x9f, 0xfc, 0xab, 0x81, 0xbe,
-		0xd2, 0xe4, 0xe8, 0x17, 0xa8, 0x15, 0x83, 0xa1, 0x49, 0xf7, 0xad, 0x87, 0x06, 0xc3, 0xca, 0x17,
-		0xd9, 0x38, 0x17, 0xf8, 0x96, 0x07, 0x7b, 0x77, 0x52, 0x25, 0x8f, 0xf7, 0x6a, 0xf5, 0xf1, 0xf4,
-		0xee, 0xc9, 0xbd, 0x67, 0xdd, 0x48, 0xfb, 0x56, 0x2c, 0xf8, 0x5b, 0x83, 0xbd, 0x3b, 0x49, 0xf4,
-		0x14, 0x76, 0x38, 0xc1, 0x09, 0x8b, 0xd4, 0x21, 0x6a, 0x87, 0x5a, 0x50, 0x63, 0x31, 0xe1, 0x58,
-		0x30, 0xae, 0x9c, 0x2c, 0xf6, 0xe8, 0x27, 0xa8, 0xfb, 0x9c, 0x60, 0x41, 0x02, 0x2f, 0xbd, 0x7c,
-		0xe5, 0xc5, 0xa9, 0x77, 0x5b, 0x1b, 0x57, 0xcc, 0x28, 0xbf, 0x99, 0x5d, 0x5d, 0xd5, 0xa7, 0x91,
-		0x93, 0xff, 0x2a, 0x50, 0x5f, 0x7d, 0xbf, 0xa5, 0xed, 0xa6, 0x95, 0xb7, 0xdb, 0x08, 0x8c, 0xa2,
-		0x34, 0x11, 0x98, 0x0b, 0xaf, 0xb8, 0xfe, 0x95, 0x23, 0xf7, 0xc9, 0x78, 0x9a, 0x63, 0x87, 0x29,
-		0xb4, 0x88, 0xa3, 0x2b, 0x38, 0x2a, 0x58, 0xc9, 0x87, 0x98, 0x72, 0xb2, 0x42, 0xfb, 0xf0, 0xd3,
-		0x1d, 0xe6, 0x60, 0x47, 0x62, 0x6f, 0x79, 0xbb, 0x70, 0xe0, 0xb3, 0x79, 0x1c, 0x92, 0xd4, 0xaa,
-		0x64, 0x86, 0x79, 0xe0, 0xf9, 0x6c, 0x11, 0x09, 0xf9, 0xa9, 0xd8, 0x76, 0xf7, 0x8b, 0xe4, 0x30,
-		0xcd, 0xf5, 0xd3, 0x14, 0x7a, 0x01, 0x8d, 0x98, 0x44, 0x01, 0x8d, 0xa6, 0x19, 0x22, 0x31, 0xb6,
-		0xcd, 0x6a, 0x7b, 0xdb, 0xdd, 0x53, 0x51, 0x59, 0x9a, 0xbc, 0xfc, 0x4b, 0x83, 0xfa, 0xea, 0x47,
-		0x09, 0x1d, 0xc1, 0x81, 0xfd, 0xdb, 0xaf, 0xbd, 0xc1, 0x85, 0x37, 0x1c, 0xf5, 0x46, 0xef, 0x86,
-		0xde, 0xe0, 0xe2, 0xaa, 0x77, 0x3e, 0xb0, 0x9b, 0x9f, 0xa0, 0xcf, 0xc1, 0xb8, 0x9b, 0x72, 0x9d,
-		0xb7, 0x83, 0xe1, 0xc8, 0x71, 0x1d, 0xbb, 0xa9, 0x6d, 0x66, 0x6d, 0xe7, 0xd2, 0x75, 0xfa, 0xbd,
-		0x91, 0x63, 0x37, 0x2b, 0x9b, 0xb4, 0xb6, 0x73, 0xee, 0xa4, 0xa9, 0xea, 0xcb, 0x19, 0x34, 0xd6,
-		0x6e, 0xbc, 0xcf, 0xe0, 0xb0, 0xe7, 0xf6, 0x7f, 0x1e, 0x5c, 0xf5, 0xce, 0x4b, 0x55, 0xac, 0x27,
-		0xed, 0xc1, 0xb0, 0x77, 0x76, 0x2e, 0x55, 0x94, 0x40, 0x9d, 0x8b, 0x2c, 0x59, 0x39, 0xfb, 0x1d,
-		0x0e, 0x7d, 0x36, 0x2f, 0x6b, 0xf5, 0xb3, 0x5a, 0x2f, 0xa6, 0x97, 0xe9, 0x2b, 0xb9, 0xd4, 0xfe,
-		0x38, 0x9d, 0x52, 0x31, 0x5b, 0x8c, 0x2d, 0x9f, 0xcd, 0x3b, 0xab, 0x3f, 0x1f, 0x5f, 0xd1, 0x20,
-		0xec, 0x4c, 0x59, 0xf6, 0xcb, 0xa0, 0xfe, 0x44, 0x7e, 0xc4, 0x31, 0x5d, 0x9e, 0x8e, 0x77, 0x64,
-		0xec, 0x9b, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x98, 0xc3, 0x6b, 0x64, 0xad, 0x08, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x6f, 0xdb, 0x36,
+		0x17, 0xfd, 0x64, 0x27, 0xf9, 0x9c, 0x2b, 0xc7, 0x75, 0x99, 0xa6, 0x51, 0xbc, 0x61, 0x51, 0x53,
+		0x14, 0xf0, 0x0a, 0x4c, 0x5e, 0xbc, 0x61, 0x6b, 0x37, 0xec, 0xc1, 0xb1, 0xd4, 0xce, 0x43, 0x96,
+		0x05, 0xb2, 0x1b, 0x0c, 0xdb, 0x83, 0x40, 0x4b, 0xb4, 0x4d, 0x54, 0x16, 0x05, 0x8a, 0x72, 0x9a,
+		0xb7, 0x61, 0x3f, 0x62, 0x3f, 0x66, 0x8f, 0xfb, 0x65, 0x83, 0x28, 0x4a, 0x71, 0x62, 0xa1, 0xd9,
+		0x1b, 0x79, 0xef, 0x3d, 0x87, 0x47, 0x47, 0xf7, 0x52, 0x02, 0x33, 0x9d, 0x12, 0xde, 0xf3, 0x71,
+		0x40, 0x22, 0x9f, 0xf4, 0x70, 0x4c, 0x7b, 0xab, 0xd3, 0x5e, 0xc0, 0x96, 0x98, 0x46, 0x56, 0xcc,
+		0x99, 0x60, 0x68, 0x3f, 0xab, 0xb0, 0x54, 0x85, 0x85, 0x63, 0x6a, 0xad, 0x4e, 0x3b, 0x9f, 0xcd,
+		0x19, 0x9b, 0x87, 0xa4, 0x27, 0x4b, 0xa6, 0xe9, 0xac, 0x17, 0xa4, 0x1c, 0x0b, 0xca, 0x14, 0xa8,
+		0x73, 0x7c, 0x3f, 0x2f, 0xe8, 0x92, 0x24, 0x02, 0x2f, 0xe3, 0xbc, 0xe0, 0xe4, 0xaf, 0x06, 0xec,
+		0xd8, 0xf2, 0x18, 0xd4, 0x82, 0x1a, 0x0d, 0x0c, 0xcd, 0xd4, 0xba, 0xbb, 0x6e, 0x8d, 0x06, 0x08,
+		0xc1, 0x56, 0x84, 0x97, 0xc4, 0xa8, 0xc9, 0x88, 0x5c, 0xa3, 0xd7, 0xb0, 0x93, 0x08, 0x2c, 0xd2,
+		0xc4, 0xa8, 0x9b, 0x5a, 0xb7, 0xd5, 0x7f, 0x66, 0x55, 0xa8, 0xb2, 0x72, 0xc2, 0xb1, 0x2c, 0x74,
+		0x15, 0x00, 0x99, 0xa0, 0x07, 0x24, 0xf1, 0x39, 0x8d, 0x33, 0x7d, 0xc6, 0x96, 0x64, 0x5d, 0x0f,
+		0xa1, 0x63, 0xd0, 0xd9, 0x75, 0x44, 0xb8, 0x47, 0x96, 0x98, 0x86, 0xc6, 0xb6, 0xac, 0x00, 0x19,
+		0x72, 0xb2, 0x08, 0x7a, 0x0d, 0x5b, 0x01, 0x16, 0xd8, 0xd8, 0x31, 0xeb, 0x5d, 0xbd, 0xff, 0xe2,
+		0x23, 0x67, 0x5b, 0x36, 0x16, 0xd8, 0x89, 0x04, 0xbf, 0x71, 0x25, 0x04, 0x2d, 0xe0, 0xf9, 0x35,
+		0xe3, 0xef, 0x67, 0x21, 0xbb, 0xf6, 0xc8, 0x07, 0xe2, 0xa7, 0xd9, 0x89, 0x1e, 0x27, 0x82, 0x44,
+		0x72, 0x15, 0x13, 0x4e, 0x59, 0x60, 0xfc, 0xdf, 0xd4, 0xba, 0x7a, 0xff, 0xc8, 0xca, 0x6d, 0xb3,
+		0x0a, 0xdb, 0x2c, 0x5b, 0xd9, 0xea, 0x9a, 0x05, 0x8b, 0x53, 0x90, 0xb8, 0x05, 0xc7, 0xa5, 0xa4,
+		0x40, 0x43, 0x68, 0x4e, 0x71, 0xe0, 0x4d, 0x69, 0x84, 0x39, 0x25, 0x89, 0xd1, 0x90, 0x94, 0x66,
+		0xa5, 0xd8, 0x33, 0x1c, 0x9c, 0xa9, 0x3a, 0x57, 0x9f, 0xde, 0x6e, 0xd0, 0xef, 0x70, 0xb8, 0xa0,
+		0x89, 0x60, 0xfc, 0xc6, 0xc3, 0xdc, 0x5f, 0xd0, 0x15, 0x0e, 0x3d, 0x65, 0xfc, 0xae, 0x34, 0xfe,
+		0x79, 0x25, 0xdf, 0x40, 0xd5, 0x2a, 0xeb, 0x0f, 0x14, 0xc7, 0xdd, 0x30, 0xfa, 0x12, 0x9e, 0x6c,
+		0x90, 0xa7, 0x9c, 0x1a, 0x20, 0x0d, 0x47, 0xf7, 0x40, 0xef, 0x38, 0x45, 0x18, 0x3a, 0x2b, 0x9a,
+		0xd0, 0x29, 0x0d, 0xa9, 0xd8, 0x54, 0xa4, 0xff, 0x77, 0x45, 0xc6, 0x2d, 0xcd, 0x3d, 0x51, 0xdf,
+		0xc0, 0x61, 0xd5, 0x11, 0x99, 0xae, 0xa6, 0xd4, 0x75, 0xb0, 0x09, 0xcd, 0xa4, 0x59, 0xb0, 0x8f,
+		0x7d, 0x41, 0x57, 0xc4, 0xf3, 0xc3, 0x34, 0x11, 0x84, 0x7b, 0xb2, 0x69, 0xf7, 0x24, 0xe6, 0x71,
+		0x9e, 0x1a, 0xe6, 0x99, 0x8b, 0xac, 0x83, 0x2f, 0xa1, 0xa1, 0x0a, 0x13, 0xa3, 0x25, 0xfb, 0xe8,
+		0xeb, 0x4a, 0xe1, 0x0a, 0xe3, 0x92, 0x38, 0xa4, 0xbe, 0x7c, 0xf7, 0x43, 0x16, 0xcd, 0xe8, 0xbc,
+		0x68, 0x84, 0x92, 0x05, 0x7d, 0x0e, 0xed, 0x19, 0xa6, 0x21, 0x5b, 0x11, 0xee, 0xad, 0x08, 0x4f,
+		0xb2, 0xee, 0x7e, 0x64, 0x6a, 0xdd, 0xba, 0xfb, 0xa8, 0x88, 0x5f, 0xe5, 0x61, 0xd4, 0x85, 0x36,
+		0x4d, 0xbc, 0x79, 0xc8, 0xa6, 0x38, 0xf4, 0xf2, 0xe9, 0x36, 0xda, 0xa6, 0xd6, 0x6d, 0xb8, 0x2d,
+		0x9a, 0xbc, 0x95, 0x61, 0x35, 0x8c, 0x6f, 0x60, 0xaf, 0x24, 0xa5, 0xd1, 0x8c, 0x19, 0x8f, 0x65,
+		0x1b, 0x55, 0xcf, 0xdb, 0x1b, 0x55, 0x39, 0x8a, 0x66, 0xcc, 0x6d, 0xce, 0xd6, 0x76, 0x9d, 0x6f,
+		0x61, 0xb7, 0x1c, 0x05, 0xd4, 0x86, 0xfa, 0x7b, 0x72, 0xa3, 0x46, 0x3c, 0x5b, 0xa2, 0x27, 0xb0,
+		0xbd, 0xc2, 0x61, 0x5a, 0x0c, 0x79, 0xbe, 0xf9, 0xae, 0xf6, 0x4a, 0x3b, 0xb1, 0xe1, 0xf8, 0x01,
+		0x0b, 0xd0, 0x33, 0x68, 0xde, 0xf1, 0x3c, 0xe7, 0xd5, 0xfd, 0x5b, 0xb7, 0x4f, 0xfe, 0xd6, 0x40,
+		0x5f, 0x6b, 0x72, 0xf4, 0x13, 0x34, 0xca, 0xc1, 0xd0, 0xa4, 0xfb, 0xd6, 0x43, 0x83, 0x61, 0x15,
+		0x8b, 0x7c, 0x9c, 0x4b, 0x7c, 0xc7, 0x83, 0xbd, 0x3b, 0xa9, 0x8a, 0xc7, 0x7b, 0xb5, 0xfe, 0x78,
+		0x7a, 0xff, 0xe4, 0xa3, 0x67, 0xdd, 0x48, 0xfb, 0xd6, 0x2c, 0xf8, 0x53, 0x83, 0xbd, 0x3b, 0x49,
+		0xf4, 0x14, 0x76, 0x38, 0xc1, 0x09, 0x8b, 0xd4, 0x21, 0x6a, 0x87, 0x3a, 0xd0, 0x60, 0x31, 0xe1,
+		0x58, 0x30, 0xae, 0x9c, 0x2c, 0xf7, 0xe8, 0x07, 0x68, 0xfa, 0x9c, 0x60, 0x41, 0x02, 0x2f, 0xbb,
+		0x7c, 0xe5, 0xc5, 0xa9, 0xf7, 0x3b, 0x1b, 0x57, 0xcc, 0xa4, 0xb8, 0x99, 0x5d, 0x5d, 0xd5, 0x67,
+		0x91, 0x93, 0x7f, 0x6a, 0xd0, 0x5c, 0x7f, 0xbf, 0x95, 0xed, 0xa6, 0x55, 0xb7, 0xdb, 0x04, 0x8c,
+		0xb2, 0x34, 0x11, 0x98, 0x0b, 0xaf, 0xbc, 0xfe, 0x8d, 0xda, 0x83, 0x32, 0x9e, 0x16, 0xd8, 0x71,
+		0x06, 0x2d, 0xe3, 0xe8, 0x0a, 0x8e, 0x4a, 0x56, 0xf2, 0x21, 0xa6, 0x9c, 0xac, 0xd1, 0x3e, 0xfc,
+		0x74, 0x87, 0x05, 0xd8, 0x91, 0xd8, 0x5b, 0xde, 0x3e, 0x1c, 0xf8, 0x6c, 0x19, 0x87, 0x24, 0xb3,
+		0x2a, 0x59, 0x60, 0x1e, 0x78, 0x3e, 0x4b, 0x23, 0x21, 0x3f, 0x15, 0xdb, 0xee, 0x7e, 0x99, 0x1c,
+		0x67, 0xb9, 0x61, 0x96, 0x42, 0x2f, 0xa0, 0x15, 0x93, 0x28, 0xa0, 0xd1, 0x3c, 0x47, 0x24, 0xc6,
+		0xb6, 0x59, 0xef, 0x6e, 0xbb, 0x7b, 0x2a, 0x2a, 0x4b, 0x93, 0x97, 0x7f, 0x68, 0xd0, 0x5c, 0xff,
+		0x28, 0xa1, 0x23, 0x38, 0xb0, 0x7f, 0xf9, 0x79, 0x30, 0xba, 0xf0, 0xc6, 0x93, 0xc1, 0xe4, 0xdd,
+		0xd8, 0x1b, 0x5d, 0x5c, 0x0d, 0xce, 0x47, 0x76, 0xfb, 0x7f, 0xe8, 0x53, 0x30, 0xee, 0xa6, 0x5c,
+		0xe7, 0xed, 0x68, 0x3c, 0x71, 0x5c, 0xc7, 0x6e, 0x6b, 0x9b, 0x59, 0xdb, 0xb9, 0x74, 0x9d, 0xe1,
+		0x60, 0xe2, 0xd8, 0xed, 0xda, 0x26, 0xad, 0xed, 0x9c, 0x3b, 0x59, 0xaa, 0xfe, 0x72, 0x01, 0xad,
+		0x7b, 0x37, 0xde, 0x27, 0x70, 0x38, 0x70, 0x87, 0x3f, 0x8e, 0xae, 0x06, 0xe7, 0x95, 0x2a, 0xee,
+		0x27, 0xed, 0xd1, 0x78, 0x70, 0x76, 0x2e, 0x55, 0x54, 0x40, 0x9d, 0x8b, 0x3c, 0x59, 0x3b, 0xfb,
+		0x15, 0x0e, 0x7d, 0xb6, 0xac, 0x6a, 0xf5, 0xb3, 0xc6, 0x20, 0xa6, 0x97, 0xd9, 0x2b, 0xb9, 0xd4,
+		0x7e, 0x3b, 0x9d, 0x53, 0xb1, 0x48, 0xa7, 0x96, 0xcf, 0x96, 0xbd, 0xf5, 0x9f, 0x8f, 0x2f, 0x68,
+		0x10, 0xf6, 0xe6, 0x2c, 0xff, 0x65, 0x50, 0x7f, 0x22, 0xdf, 0xe3, 0x98, 0xae, 0x4e, 0xa7, 0x3b,
+		0x32, 0xf6, 0xd5, 0xbf, 0x03, 0x00, 0x98, 0xc3, 0x6b, 0x64, 0xad, 0x08, 0x00, 0x00,
+	},
+	// uber/cadence/shared/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xcf, 0x4a, 0xc4, 0x30,
+		0x10, 0x87, 0x89, 0xeb, 0xdf, 0x88, 0x22, 0x39, 0x48, 0xf0, 0x62, 0x51, 0x84, 0x9e, 0x12, 0x8a,
+		0x88, 0x07, 0x4f, 0xea, 0x0b, 0x48, 0x11, 0x04, 0x2f, 0x92, 0x26, 0xb3, 0xdd, 0xe0, 0x6e, 0xa6,
+		0x4e, 0x9b, 0x88, 0x6f, 0xe1, 0x23, 0x4b, 0xd3, 0x8a, 0x78, 0xfb, 0xcd, 0x37, 0xdf, 0xe5, 0xe3,
+		0x97, 0xb1, 0x01, 0xd2, 0xd6, 0x38, 0x08, 0x16, 0x74, 0xbf, 0x32, 0x04, 0x4e, 0xa7, 0x4a, 0x3b,
+		0xdc, 0x18, 0x1f, 0x54, 0x47, 0x38, 0xa0, 0x38, 0x1d, 0x25, 0x35, 0x4b, 0x6a, 0x92, 0x54, 0xaa,
+		0x2e, 0xbe, 0x19, 0x3f, 0xbb, 0xef, 0xbf, 0x82, 0x7d, 0x41, 0x7a, 0x5f, 0xae, 0xf1, 0xf3, 0x11,
+		0xc3, 0xd2, 0xb7, 0x91, 0xcc, 0xe0, 0x31, 0x08, 0xc9, 0xf7, 0x20, 0x98, 0x66, 0x0d, 0x4e, 0xb2,
+		0x82, 0x95, 0xfb, 0xf5, 0xef, 0x29, 0xae, 0xf8, 0xf1, 0x47, 0x84, 0x08, 0x6f, 0x1d, 0x61, 0xf2,
+		0x0e, 0x48, 0x6e, 0x15, 0xac, 0x3c, 0xa8, 0x8f, 0x32, 0x7d, 0x9a, 0xa1, 0x38, 0xe7, 0x87, 0x93,
+		0x36, 0x60, 0xe7, 0xad, 0x5c, 0x64, 0x87, 0x67, 0xf4, 0x3c, 0x12, 0x71, 0xc2, 0x17, 0xd4, 0xf5,
+		0x72, 0xbb, 0x60, 0xe5, 0x4e, 0x3d, 0xce, 0x87, 0xdb, 0xd7, 0x9b, 0xd6, 0x0f, 0xab, 0xd8, 0x28,
+		0x8b, 0x1b, 0xfd, 0x2f, 0x4e, 0xb5, 0x10, 0x74, 0x0e, 0xfa, 0xeb, 0xbc, 0x9b, 0x56, 0xaa, 0x9a,
+		0xdd, 0xfc, 0xb9, 0xfe, 0x19, 0x00, 0x42, 0xb7, 0x3c, 0x1a, 0x11, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/workflow.proto
 	[]byte{
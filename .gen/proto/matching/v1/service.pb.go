@@ -541,6 +541,11 @@ type AddDecisionTaskRequest struct {
 	ScheduleToStartTimeout *types.Duration       `protobuf:"bytes,5,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
 	Source                 v11.TaskSource        `protobuf:"varint,6,opt,name=source,proto3,enum=uber.cadence.shared.v1.TaskSource" json:"source,omitempty"`
 	ForwardedFrom          string                `protobuf:"bytes,7,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	Ephemeral              bool                  `protobuf:"varint,8,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	AffinityKey            string                `protobuf:"bytes,9,opt,name=affinity_key,json=affinityKey,proto3" json:"affinity_key,omitempty"`
+	Attempt                int64                 `protobuf:"varint,10,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ShadowSourceTaskList   string                `protobuf:"bytes,11,opt,name=shadow_source_task_list,json=shadowSourceTaskList,proto3" json:"shadow_source_task_list,omitempty"`
+	ShadowMetadataOnly     bool                  `protobuf:"varint,12,opt,name=shadow_metadata_only,json=shadowMetadataOnly,proto3" json:"shadow_metadata_only,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
 	XXX_unrecognized       []byte                `json:"-"`
 	XXX_sizecache          int32                 `json:"-"`
@@ -628,6 +633,41 @@ func (m *AddDecisionTaskRequest) GetForwardedFrom() string {
 	return ""
 }
 
+func (m *AddDecisionTaskRequest) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
+func (m *AddDecisionTaskRequest) GetAffinityKey() string {
+	if m != nil {
+		return m.AffinityKey
+	}
+	return ""
+}
+
+func (m *AddDecisionTaskRequest) GetAttempt() int64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *AddDecisionTaskRequest) GetShadowSourceTaskList() string {
+	if m != nil {
+		return m.ShadowSourceTaskList
+	}
+	return ""
+}
+
+func (m *AddDecisionTaskRequest) GetShadowMetadataOnly() bool {
+	if m != nil {
+		return m.ShadowMetadataOnly
+	}
+	return false
+}
+
 type AddDecisionTaskResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -676,6 +716,11 @@ type AddActivityTaskRequest struct {
 	ScheduleToStartTimeout *types.Duration       `protobuf:"bytes,6,opt,name=schedule_to_start_timeout,json=scheduleToStartTimeout,proto3" json:"schedule_to_start_timeout,omitempty"`
 	Source                 v11.TaskSource        `protobuf:"varint,7,opt,name=source,proto3,enum=uber.cadence.shared.v1.TaskSource" json:"source,omitempty"`
 	ForwardedFrom          string                `protobuf:"bytes,8,opt,name=forwarded_from,json=forwardedFrom,proto3" json:"forwarded_from,omitempty"`
+	Ephemeral              bool                  `protobuf:"varint,9,opt,name=ephemeral,proto3" json:"ephemeral,omitempty"`
+	AffinityKey            string                `protobuf:"bytes,10,opt,name=affinity_key,json=affinityKey,proto3" json:"affinity_key,omitempty"`
+	Attempt                int64                 `protobuf:"varint,11,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ShadowSourceTaskList   string                `protobuf:"bytes,12,opt,name=shadow_source_task_list,json=shadowSourceTaskList,proto3" json:"shadow_source_task_list,omitempty"`
+	ShadowMetadataOnly     bool                  `protobuf:"varint,13,opt,name=shadow_metadata_only,json=shadowMetadataOnly,proto3" json:"shadow_metadata_only,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{}              `json:"-"`
 	XXX_unrecognized       []byte                `json:"-"`
 	XXX_sizecache          int32                 `json:"-"`
@@ -770,6 +815,41 @@ func (m *AddActivityTaskRequest) GetForwardedFrom() string {
 	return ""
 }
 
+func (m *AddActivityTaskRequest) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
+func (m *AddActivityTaskRequest) GetAffinityKey() string {
+	if m != nil {
+		return m.AffinityKey
+	}
+	return ""
+}
+
+func (m *AddActivityTaskRequest) GetAttempt() int64 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *AddActivityTaskRequest) GetShadowSourceTaskList() string {
+	if m != nil {
+		return m.ShadowSourceTaskList
+	}
+	return ""
+}
+
+func (m *AddActivityTaskRequest) GetShadowMetadataOnly() bool {
+	if m != nil {
+		return m.ShadowMetadataOnly
+	}
+	return false
+}
+
 type AddActivityTaskResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1211,11 +1291,15 @@ func (m *DescribeTaskListRequest) GetDomainId() string {
 }
 
 type DescribeTaskListResponse struct {
-	Pollers              []*v1.PollerInfo   `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
-	TaskListStatus       *v1.TaskListStatus `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Pollers        []*v1.PollerInfo   `protobuf:"bytes,1,rep,name=pollers,proto3" json:"pollers,omitempty"`
+	TaskListStatus *v1.TaskListStatus `protobuf:"bytes,2,opt,name=task_list_status,json=taskListStatus,proto3" json:"task_list_status,omitempty"`
+	// match_stats and outstanding_poll_count extend task_list_status,
+	// they are carried here until api.v1.TaskListStatus has them
+	MatchStats           *TaskListMatchStats `protobuf:"bytes,3,opt,name=match_stats,json=matchStats,proto3" json:"match_stats,omitempty"`
+	OutstandingPollCount int64               `protobuf:"varint,4,opt,name=outstanding_poll_count,json=outstandingPollCount,proto3" json:"outstanding_poll_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *DescribeTaskListResponse) Reset()         { *m = DescribeTaskListResponse{} }
@@ -1265,6 +1349,91 @@ func (m *DescribeTaskListResponse) GetTaskListStatus() *v1.TaskListStatus {
 	return nil
 }
 
+func (m *DescribeTaskListResponse) GetMatchStats() *TaskListMatchStats {
+	if m != nil {
+		return m.MatchStats
+	}
+	return nil
+}
+
+func (m *DescribeTaskListResponse) GetOutstandingPollCount() int64 {
+	if m != nil {
+		return m.OutstandingPollCount
+	}
+	return 0
+}
+
+type TaskListMatchStats struct {
+	SyncMatched          int64    `protobuf:"varint,1,opt,name=sync_matched,json=syncMatched,proto3" json:"sync_matched,omitempty"`
+	BufferedMatched      int64    `protobuf:"varint,2,opt,name=buffered_matched,json=bufferedMatched,proto3" json:"buffered_matched,omitempty"`
+	Forwarded            int64    `protobuf:"varint,3,opt,name=forwarded,proto3" json:"forwarded,omitempty"`
+	Expired              int64    `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskListMatchStats) Reset()         { *m = TaskListMatchStats{} }
+func (m *TaskListMatchStats) String() string { return proto.CompactTextString(m) }
+func (*TaskListMatchStats) ProtoMessage()    {}
+func (*TaskListMatchStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{16}
+}
+func (m *TaskListMatchStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskListMatchStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskListMatchStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskListMatchStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskListMatchStats.Merge(m, src)
+}
+func (m *TaskListMatchStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskListMatchStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskListMatchStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskListMatchStats proto.InternalMessageInfo
+
+func (m *TaskListMatchStats) GetSyncMatched() int64 {
+	if m != nil {
+		return m.SyncMatched
+	}
+	return 0
+}
+
+func (m *TaskListMatchStats) GetBufferedMatched() int64 {
+	if m != nil {
+		return m.BufferedMatched
+	}
+	return 0
+}
+
+func (m *TaskListMatchStats) GetForwarded() int64 {
+	if m != nil {
+		return m.Forwarded
+	}
+	return 0
+}
+
+func (m *TaskListMatchStats) GetExpired() int64 {
+	if m != nil {
+		return m.Expired
+	}
+	return 0
+}
+
 type ListTaskListPartitionsRequest struct {
 	Domain               string       `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
 	TaskList             *v1.TaskList `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
@@ -1277,7 +1446,7 @@ func (m *ListTaskListPartitionsRequest) Reset()         { *m = ListTaskListParti
 func (m *ListTaskListPartitionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsRequest) ProtoMessage()    {}
 func (*ListTaskListPartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{16}
+	return fileDescriptor_826e827d3aabf7fc, []int{17}
 }
 func (m *ListTaskListPartitionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1332,7 +1501,7 @@ func (m *ListTaskListPartitionsResponse) Reset()         { *m = ListTaskListPart
 func (m *ListTaskListPartitionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListTaskListPartitionsResponse) ProtoMessage()    {}
 func (*ListTaskListPartitionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{17}
+	return fileDescriptor_826e827d3aabf7fc, []int{18}
 }
 func (m *ListTaskListPartitionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1386,7 +1555,7 @@ func (m *GetTaskListsByDomainRequest) Reset()         { *m = GetTaskListsByDomai
 func (m *GetTaskListsByDomainRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainRequest) ProtoMessage()    {}
 func (*GetTaskListsByDomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{18}
+	return fileDescriptor_826e827d3aabf7fc, []int{19}
 }
 func (m *GetTaskListsByDomainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1434,7 +1603,7 @@ func (m *GetTaskListsByDomainResponse) Reset()         { *m = GetTaskListsByDoma
 func (m *GetTaskListsByDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTaskListsByDomainResponse) ProtoMessage()    {}
 func (*GetTaskListsByDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_826e827d3aabf7fc, []int{19}
+	return fileDescriptor_826e827d3aabf7fc, []int{20}
 }
 func (m *GetTaskListsByDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1477,237 +1646,530 @@ func (m *GetTaskListsByDomainResponse) GetActivityTaskListMap() map[string]*Desc
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*PollForDecisionTaskRequest)(nil), "uber.cadence.matching.v1.PollForDecisionTaskRequest")
-	proto.RegisterType((*PollForDecisionTaskResponse)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse")
-	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse.QueriesEntry")
-	proto.RegisterType((*PollForActivityTaskRequest)(nil), "uber.cadence.matching.v1.PollForActivityTaskRequest")
-	proto.RegisterType((*PollForActivityTaskResponse)(nil), "uber.cadence.matching.v1.PollForActivityTaskResponse")
-	proto.RegisterType((*AddDecisionTaskRequest)(nil), "uber.cadence.matching.v1.AddDecisionTaskRequest")
-	proto.RegisterType((*AddDecisionTaskResponse)(nil), "uber.cadence.matching.v1.AddDecisionTaskResponse")
-	proto.RegisterType((*AddActivityTaskRequest)(nil), "uber.cadence.matching.v1.AddActivityTaskRequest")
-	proto.RegisterType((*AddActivityTaskResponse)(nil), "uber.cadence.matching.v1.AddActivityTaskResponse")
-	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.matching.v1.QueryWorkflowRequest")
-	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.matching.v1.QueryWorkflowResponse")
-	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedRequest")
-	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedResponse")
-	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "uber.cadence.matching.v1.CancelOutstandingPollRequest")
-	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "uber.cadence.matching.v1.CancelOutstandingPollResponse")
-	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.matching.v1.DescribeTaskListRequest")
-	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse")
-	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsRequest")
-	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsResponse")
-	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainRequest")
-	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse")
-	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.ActivityTaskListMapEntry")
-	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.DecisionTaskListMapEntry")
+type DescribeTaskListConfigRequest struct {
+	DomainId             string          `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	TaskList             *v1.TaskList    `protobuf:"bytes,2,opt,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	TaskListType         v1.TaskListType `protobuf:"varint,3,opt,name=task_list_type,json=taskListType,proto3,enum=uber.cadence.api.v1.TaskListType" json:"task_list_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("uber/cadence/matching/v1/service.proto", fileDescriptor_826e827d3aabf7fc)
+func (m *DescribeTaskListConfigRequest) Reset()         { *m = DescribeTaskListConfigRequest{} }
+func (m *DescribeTaskListConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeTaskListConfigRequest) ProtoMessage()    {}
+func (*DescribeTaskListConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{21}
 }
-
-var fileDescriptor_826e827d3aabf7fc = []byte{
-	// 1909 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xdd, 0x6f, 0xdb, 0xd6,
-	0x15, 0x07, 0xfd, 0xad, 0x23, 0x59, 0x71, 0x6e, 0x5a, 0x47, 0x96, 0xe3, 0x8f, 0xa8, 0x6b, 0xe7,
-	0x0d, 0x1d, 0x35, 0xab, 0x4d, 0x96, 0xa6, 0x18, 0x06, 0xc7, 0x8e, 0x13, 0x01, 0xcb, 0x92, 0x32,
-	0x5a, 0x06, 0x0c, 0x43, 0x88, 0x2b, 0xf2, 0xda, 0xe2, 0x2c, 0x91, 0x0c, 0xef, 0x95, 0x5c, 0xed,
-	0x61, 0x0f, 0x43, 0x37, 0x0c, 0xe8, 0xeb, 0xfe, 0x82, 0xad, 0x8f, 0xfb, 0x43, 0xfa, 0xb0, 0x87,
-	0xbd, 0x0f, 0x03, 0x86, 0x00, 0xfb, 0x3f, 0x86, 0xfb, 0x41, 0x4a, 0x94, 0x2e, 0xf5, 0x61, 0xaf,
-	0xed, 0x9b, 0x78, 0xcf, 0x39, 0xbf, 0xf3, 0x7d, 0xee, 0x21, 0x05, 0x1f, 0x74, 0x9b, 0x24, 0xaa,
-	0x3a, 0xd8, 0x25, 0xbe, 0x43, 0xaa, 0x1d, 0xcc, 0x9c, 0x96, 0xe7, 0x9f, 0x57, 0x7b, 0x87, 0x55,
-	0x4a, 0xa2, 0x9e, 0xe7, 0x10, 0x33, 0x8c, 0x02, 0x16, 0xa0, 0x12, 0xe7, 0x33, 0x15, 0x9f, 0x19,
-	0xf3, 0x99, 0xbd, 0xc3, 0xf2, 0xee, 0x79, 0x10, 0x9c, 0xb7, 0x49, 0x55, 0xf0, 0x35, 0xbb, 0x67,
-	0x55, 0xb7, 0x1b, 0x61, 0xe6, 0x05, 0xbe, 0x94, 0x2c, 0xef, 0x8d, 0xd2, 0x99, 0xd7, 0x21, 0x94,
-	0xe1, 0x4e, 0xa8, 0x18, 0xc6, 0x00, 0x2e, 0x23, 0x1c, 0x86, 0x24, 0xa2, 0x8a, 0xbe, 0x9f, 0x32,
-	0x11, 0x87, 0x1e, 0xb7, 0xce, 0x09, 0x3a, 0x9d, 0x81, 0x0a, 0x1d, 0xc7, 0x9b, 0x2e, 0x89, 0xfa,
-	0x8a, 0xa1, 0xa2, 0x63, 0x60, 0x98, 0x5e, 0xb4, 0x3d, 0xca, 0x14, 0xcf, 0x81, 0x8e, 0x47, 0x05,
-	0xc1, 0xbe, 0x0c, 0xa2, 0x0b, 0x12, 0x29, 0xce, 0x1f, 0x4e, 0xe3, 0x3c, 0x6b, 0x07, 0x97, 0x8a,
-	0xf7, 0x7b, 0x29, 0x5e, 0xda, 0xc2, 0x11, 0x71, 0x39, 0x7b, 0xcb, 0xa3, 0x2c, 0x48, 0xec, 0x7b,
-	0x3f, 0x83, 0x2b, 0x6d, 0x62, 0xe5, 0x6b, 0x03, 0xca, 0x2f, 0x82, 0x76, 0xfb, 0x34, 0x88, 0x4e,
-	0x88, 0xe3, 0x51, 0x2f, 0xf0, 0x1b, 0x98, 0x5e, 0x58, 0xe4, 0x4d, 0x97, 0x50, 0x86, 0xea, 0xb0,
-	0x1a, 0xc9, 0x9f, 0x25, 0x63, 0xdf, 0x38, 0xc8, 0xd7, 0xaa, 0x66, 0x2a, 0x6b, 0x38, 0xf4, 0xcc,
-	0xde, 0xa1, 0x99, 0x8d, 0x60, 0xc5, 0xf2, 0x68, 0x1b, 0x72, 0x6e, 0xd0, 0xc1, 0x9e, 0x6f, 0x7b,
-	0x6e, 0x69, 0x61, 0xdf, 0x38, 0xc8, 0x59, 0x6b, 0xf2, 0xa0, 0xee, 0x72, 0x62, 0x18, 0xb4, 0xdb,
-	0x24, 0xe2, 0xc4, 0x45, 0x49, 0x94, 0x07, 0x75, 0x17, 0xbd, 0x0f, 0xc5, 0xb3, 0x20, 0xba, 0xc4,
-	0x91, 0x4b, 0x5c, 0xfb, 0x2c, 0x0a, 0x3a, 0xa5, 0x25, 0xc1, 0xb1, 0x9e, 0x9c, 0x9e, 0x46, 0x41,
-	0xa7, 0xf2, 0x45, 0x0e, 0xb6, 0xb5, 0x86, 0xd0, 0x30, 0xf0, 0x29, 0x41, 0x3b, 0x00, 0xdc, 0x79,
-	0x9b, 0x05, 0x17, 0xc4, 0x17, 0xee, 0x14, 0xac, 0x1c, 0x3f, 0x69, 0xf0, 0x03, 0xf4, 0x4b, 0x40,
-	0x71, 0xa0, 0x6d, 0xf2, 0x39, 0x71, 0xba, 0xbc, 0xe0, 0x84, 0xa1, 0xf9, 0xda, 0x07, 0x5a, 0xaf,
-	0x7f, 0xa5, 0xd8, 0x1f, 0xc7, 0xdc, 0xd6, 0xcd, 0xcb, 0xd1, 0x23, 0x74, 0x0a, 0xeb, 0x09, 0x2c,
-	0xeb, 0x87, 0x44, 0x78, 0x97, 0xaf, 0xdd, 0x9d, 0x88, 0xd8, 0xe8, 0x87, 0xc4, 0x2a, 0x5c, 0x0e,
-	0x3d, 0xa1, 0x57, 0xb0, 0x15, 0x46, 0xa4, 0xe7, 0x05, 0x5d, 0x6a, 0x53, 0x86, 0x23, 0x46, 0x5c,
-	0x9b, 0xf4, 0x88, 0xcf, 0x78, 0xc4, 0x96, 0x04, 0xe6, 0xb6, 0x29, 0xcb, 0xde, 0x8c, 0xcb, 0xde,
-	0xac, 0xfb, 0xec, 0xfe, 0xc7, 0xaf, 0x70, 0xbb, 0x4b, 0xac, 0xcd, 0x58, 0xfa, 0xa5, 0x14, 0x7e,
-	0xcc, 0x65, 0xeb, 0x2e, 0x3a, 0x80, 0x8d, 0x31, 0xb8, 0xe5, 0x7d, 0xe3, 0x60, 0xd1, 0x2a, 0xd2,
-	0x34, 0x67, 0x09, 0x56, 0x31, 0x63, 0xa4, 0x13, 0xb2, 0xd2, 0xca, 0xbe, 0x71, 0xb0, 0x6c, 0xc5,
-	0x8f, 0xa8, 0x02, 0xeb, 0x3e, 0xf9, 0x9c, 0x0d, 0x00, 0x56, 0x05, 0x40, 0x9e, 0x1f, 0xc6, 0xd2,
-	0x1f, 0x02, 0x6a, 0x62, 0xe7, 0xa2, 0x1d, 0x9c, 0xdb, 0x4e, 0xd0, 0xf5, 0x99, 0xdd, 0xf2, 0x7c,
-	0x56, 0x5a, 0x13, 0x8c, 0x1b, 0x8a, 0x72, 0xcc, 0x09, 0x4f, 0x3d, 0x9f, 0xa1, 0x07, 0x50, 0xa2,
-	0xcc, 0x73, 0x2e, 0xfa, 0x83, 0x54, 0xd8, 0xc4, 0xc7, 0xcd, 0x36, 0x71, 0x4b, 0xb9, 0x7d, 0xe3,
-	0x60, 0xcd, 0xda, 0x94, 0xf4, 0x24, 0xd0, 0x8f, 0x25, 0x15, 0x3d, 0x80, 0x65, 0xd1, 0xa6, 0x25,
-	0x10, 0x31, 0xa9, 0x4c, 0x8c, 0xf3, 0x67, 0x9c, 0xd3, 0x92, 0x02, 0xc8, 0x82, 0x75, 0x57, 0xd5,
-	0x8d, 0xed, 0xf9, 0x67, 0x41, 0x29, 0x2f, 0x10, 0x7e, 0x94, 0x46, 0x90, 0x9d, 0xc4, 0x41, 0x1a,
-	0x11, 0xf6, 0xa9, 0x47, 0x7c, 0x16, 0x57, 0x5b, 0xdd, 0x3f, 0x0b, 0xac, 0x82, 0x3b, 0xf4, 0x84,
-	0x5e, 0xc3, 0x9d, 0xf1, 0xa2, 0xb2, 0x45, 0x19, 0xf2, 0x26, 0x2c, 0x15, 0x84, 0x8a, 0x1d, 0xad,
-	0x91, 0xbc, 0x78, 0x7f, 0xee, 0x51, 0x66, 0x6d, 0x8d, 0x55, 0x55, 0x4c, 0x42, 0x26, 0xdc, 0x92,
-	0x41, 0xe7, 0xad, 0x4f, 0xec, 0x1e, 0x89, 0xb8, 0xea, 0xd2, 0xba, 0xc8, 0xcf, 0x4d, 0x41, 0x7a,
-	0xc9, 0x29, 0xaf, 0x24, 0x01, 0xdd, 0x85, 0x42, 0x33, 0xc2, 0xbe, 0xd3, 0x52, 0x5d, 0x50, 0x14,
-	0x5d, 0x90, 0x97, 0x67, 0xb2, 0x0f, 0x8e, 0xa0, 0x48, 0x9d, 0x16, 0x71, 0xbb, 0x6d, 0xe2, 0xda,
-	0x7c, 0xb0, 0x96, 0x6e, 0x08, 0x23, 0xcb, 0x63, 0xd5, 0xd5, 0x88, 0xa7, 0xae, 0xb5, 0x9e, 0x48,
-	0xf0, 0x33, 0xf4, 0x53, 0x28, 0xc4, 0x35, 0x25, 0x00, 0x36, 0xa6, 0x02, 0xe4, 0x15, 0xbf, 0x10,
-	0xff, 0x0d, 0xac, 0xf2, 0x8c, 0x78, 0x84, 0x96, 0x6e, 0xee, 0x2f, 0x1e, 0xe4, 0x6b, 0x8f, 0xcc,
-	0xac, 0xab, 0xc2, 0x9c, 0xd0, 0xf0, 0xe6, 0x67, 0x12, 0xe4, 0xb1, 0xcf, 0xa2, 0xbe, 0x15, 0x43,
-	0x96, 0x5f, 0x43, 0x61, 0x98, 0x80, 0x36, 0x60, 0xf1, 0x82, 0xf4, 0xc5, 0x3c, 0xc8, 0x59, 0xfc,
-	0x27, 0x2f, 0xa1, 0x1e, 0xef, 0x19, 0xd5, 0xfc, 0x33, 0x95, 0x90, 0x10, 0x78, 0xb8, 0xf0, 0xc0,
-	0x18, 0x9e, 0xa8, 0x47, 0x0e, 0xf3, 0x7a, 0x1e, 0xeb, 0x5f, 0x7d, 0xa2, 0x6a, 0x10, 0xbe, 0xc5,
-	0x89, 0xfa, 0xe5, 0x5a, 0x32, 0x51, 0xd3, 0x86, 0x7c, 0xa7, 0x13, 0x75, 0x0f, 0xf2, 0x58, 0x59,
-	0x33, 0xf0, 0x0d, 0xe2, 0xa3, 0xba, 0xcb, 0x47, 0x6e, 0xc2, 0x20, 0x46, 0xee, 0xd2, 0x84, 0x91,
-	0x9b, 0x38, 0x26, 0x46, 0x2e, 0x1e, 0x7a, 0x42, 0x35, 0x58, 0xf6, 0xfc, 0xb0, 0xcb, 0xc4, 0x3c,
-	0xcc, 0xd7, 0xee, 0xe8, 0x13, 0x85, 0xfb, 0xed, 0x00, 0xbb, 0x96, 0x64, 0xd5, 0x74, 0xcf, 0xca,
-	0x75, 0xbb, 0x67, 0x75, 0xbe, 0xee, 0x69, 0xc0, 0x56, 0x8c, 0x67, 0xb3, 0xc0, 0x76, 0xda, 0x01,
-	0x25, 0x02, 0x28, 0xe8, 0xca, 0x79, 0x9b, 0xaf, 0x6d, 0x8d, 0x61, 0x9d, 0xa8, 0x05, 0xcb, 0xda,
-	0x8c, 0x65, 0x1b, 0xc1, 0x31, 0x97, 0x6c, 0x48, 0x41, 0xf4, 0x0b, 0xd8, 0x14, 0x4a, 0xc6, 0x21,
-	0x73, 0xd3, 0x20, 0x6f, 0x09, 0xc1, 0x11, 0xbc, 0x53, 0xb8, 0xd9, 0x22, 0x38, 0x62, 0x4d, 0x82,
-	0x59, 0x02, 0x05, 0xd3, 0xa0, 0x36, 0x12, 0x99, 0x18, 0x67, 0xe8, 0x52, 0xca, 0xa7, 0x2f, 0xa5,
-	0xd7, 0xb0, 0x9b, 0xce, 0x84, 0x1d, 0x9c, 0xd9, 0xac, 0xe5, 0x51, 0x3b, 0x16, 0x28, 0x4c, 0x0d,
-	0x6c, 0x39, 0x95, 0x99, 0xe7, 0x67, 0x8d, 0x96, 0x47, 0x8f, 0x14, 0x7e, 0x7d, 0xd8, 0x03, 0x97,
-	0x30, 0xec, 0xb5, 0xa9, 0x18, 0xbc, 0xd3, 0x2a, 0x65, 0xe0, 0xc4, 0x89, 0x94, 0x1a, 0xdf, 0x11,
-	0x8a, 0x57, 0xdb, 0x11, 0xbe, 0x0f, 0x37, 0x12, 0x1c, 0x39, 0x08, 0xc4, 0xec, 0xce, 0x59, 0xc5,
-	0xf8, 0xf8, 0x44, 0x9c, 0xa2, 0x8f, 0x60, 0xa5, 0x45, 0xb0, 0x4b, 0x22, 0x35, 0x9a, 0xb7, 0xb5,
-	0x9a, 0x9e, 0x0a, 0x16, 0x4b, 0xb1, 0x56, 0xfe, 0xba, 0x08, 0x9b, 0x47, 0xae, 0xab, 0x5b, 0x13,
-	0x53, 0x93, 0xc8, 0x18, 0x99, 0x44, 0xdf, 0xd0, 0x18, 0x78, 0x08, 0xb9, 0xc1, 0x3d, 0xba, 0x38,
-	0xcb, 0x3d, 0xba, 0xc6, 0xe2, 0x6b, 0x73, 0x0f, 0xf2, 0x49, 0x8f, 0xa8, 0xf5, 0x69, 0xd1, 0x82,
-	0xf8, 0xa8, 0xee, 0x8e, 0x36, 0x91, 0x2a, 0x7d, 0x55, 0xa6, 0xcb, 0x73, 0x34, 0x91, 0xd8, 0xb6,
-	0xe2, 0x62, 0x7d, 0x08, 0x2b, 0x34, 0xe8, 0x46, 0x8e, 0x1c, 0x0a, 0xc5, 0xd1, 0x9b, 0x65, 0x68,
-	0xb5, 0xc0, 0xf4, 0xe2, 0xa5, 0xe0, 0xb4, 0x94, 0x84, 0x66, 0x64, 0xaf, 0xea, 0x46, 0xf6, 0x16,
-	0xdc, 0x1e, 0xcb, 0x91, 0x9c, 0xd6, 0x95, 0x7f, 0xc8, 0xfc, 0xe9, 0x2e, 0xa5, 0xef, 0x22, 0x7f,
-	0x7c, 0xf1, 0x14, 0xae, 0xd9, 0x03, 0xd5, 0x72, 0x96, 0x17, 0xe5, 0xf9, 0x49, 0x6c, 0x40, 0x2a,
-	0xd3, 0x4b, 0xd7, 0xca, 0xf4, 0xf2, 0x7c, 0x99, 0x5e, 0xb9, 0x7e, 0xa6, 0x57, 0xff, 0x0f, 0x99,
-	0x5e, 0xcb, 0xce, 0xb4, 0xee, 0x5e, 0xae, 0xfc, 0xcb, 0x80, 0x77, 0xc4, 0x5e, 0x12, 0x27, 0x22,
-	0xce, 0xf3, 0xf1, 0xe8, 0xf2, 0xf1, 0x03, 0x6d, 0x1c, 0x75, 0xb2, 0x33, 0xae, 0x1d, 0xd7, 0xe9,
-	0xca, 0x19, 0xb7, 0x92, 0xbf, 0x19, 0xf0, 0xee, 0x88, 0x85, 0x6a, 0x1f, 0xf9, 0x19, 0x14, 0xc4,
-	0x2a, 0x6f, 0x47, 0x84, 0x76, 0xdb, 0xb1, 0x8f, 0x93, 0xa7, 0x71, 0x5e, 0x48, 0x58, 0x42, 0x00,
-	0xd5, 0xa1, 0x18, 0x03, 0xfc, 0x96, 0x38, 0x8c, 0xb8, 0x13, 0x57, 0x40, 0xb9, 0xfa, 0x29, 0x4e,
-	0x6b, 0xfd, 0xcd, 0xf0, 0x63, 0xe5, 0xbf, 0x06, 0xec, 0x4b, 0xc3, 0x5c, 0xc1, 0xc7, 0xfd, 0x3d,
-	0x0e, 0x3a, 0x61, 0x9b, 0x70, 0x66, 0x15, 0xca, 0xe7, 0xa3, 0xf9, 0xb8, 0xa7, 0x55, 0x34, 0x0d,
-	0xe7, 0x5b, 0xc8, 0xcd, 0x6d, 0x58, 0x15, 0xb2, 0x6a, 0x5a, 0xe6, 0xac, 0x15, 0xfe, 0x58, 0x77,
-	0x2b, 0xef, 0xc1, 0xdd, 0x09, 0xe6, 0xa9, 0x82, 0xfc, 0xb7, 0x01, 0x77, 0x8e, 0xb1, 0xef, 0x90,
-	0xf6, 0xf3, 0x2e, 0xa3, 0x0c, 0xfb, 0xae, 0xe7, 0x9f, 0xf3, 0xcd, 0x72, 0xa6, 0x01, 0x94, 0x5a,
-	0x65, 0x17, 0x46, 0x56, 0xd9, 0x27, 0x50, 0x4c, 0x9c, 0x1a, 0xbc, 0x60, 0x17, 0x33, 0x2e, 0xcf,
-	0xd8, 0x33, 0x79, 0x79, 0xb2, 0xa1, 0xa7, 0xeb, 0x4c, 0x99, 0xca, 0x1e, 0xec, 0x64, 0xb8, 0xa7,
-	0x02, 0xf0, 0x7b, 0xb8, 0x7d, 0x42, 0xa8, 0x13, 0x79, 0x4d, 0x92, 0x88, 0x2b, 0xd7, 0x4f, 0x47,
-	0x6b, 0xe0, 0x43, 0xad, 0xd6, 0x0c, 0xf1, 0xd9, 0x52, 0x5f, 0xf9, 0xca, 0x80, 0xd2, 0x38, 0x82,
-	0x6a, 0x9b, 0x4f, 0x60, 0x55, 0x86, 0x93, 0x96, 0x0c, 0xf1, 0xbe, 0xb5, 0x97, 0xf9, 0x4a, 0x42,
-	0x22, 0xf1, 0x92, 0x1b, 0xf3, 0xa3, 0x67, 0xb0, 0x31, 0x88, 0x3e, 0x65, 0x98, 0x75, 0xa9, 0x6a,
-	0x99, 0xf7, 0x26, 0xc6, 0xee, 0xa5, 0x60, 0xb5, 0x8a, 0x2c, 0xf5, 0x5c, 0xa1, 0xb0, 0x23, 0xf2,
-	0xa1, 0x4e, 0x5f, 0xe0, 0x88, 0x79, 0x7c, 0xce, 0xd2, 0x38, 0x58, 0x9b, 0xb0, 0xa2, 0x16, 0x1b,
-	0x59, 0x24, 0xea, 0x29, 0x9d, 0xbc, 0x85, 0xf9, 0x92, 0xf7, 0xa7, 0x05, 0xd8, 0xcd, 0xd2, 0xaa,
-	0x22, 0xf4, 0x06, 0x76, 0x06, 0x6f, 0x14, 0x89, 0xbf, 0x61, 0xc2, 0xa8, 0xe2, 0x66, 0x4e, 0x54,
-	0x99, 0xe0, 0x3e, 0x23, 0x0c, 0xbb, 0x98, 0x61, 0xab, 0x8c, 0x87, 0xa6, 0x77, 0x5a, 0x35, 0x57,
-	0x99, 0x7c, 0x8d, 0xd0, 0xaa, 0x5c, 0xb8, 0x9a, 0x4a, 0x77, 0x68, 0x35, 0x48, 0xab, 0xac, 0xdc,
-	0x83, 0xed, 0x27, 0x24, 0x09, 0x03, 0x7d, 0xd4, 0x97, 0x37, 0xf0, 0x94, 0xd8, 0x57, 0xbe, 0x5a,
-	0x82, 0x3b, 0x7a, 0x39, 0x15, 0xbd, 0x2f, 0x0c, 0xd8, 0xd4, 0xf8, 0xd2, 0xc1, 0xa1, 0x8a, 0xdb,
-	0xf3, 0xec, 0xf7, 0xfb, 0x49, 0xc0, 0xe6, 0xc9, 0x88, 0x2f, 0xcf, 0x70, 0x28, 0x5f, 0xf6, 0x6f,
-	0xb9, 0xe3, 0x14, 0x61, 0x86, 0x26, 0x8b, 0xdc, 0x8c, 0x85, 0x6b, 0x99, 0x71, 0x34, 0x92, 0xc5,
-	0x81, 0x19, 0x78, 0x9c, 0x52, 0xfe, 0x1d, 0xef, 0x44, 0xbd, 0xdd, 0x9a, 0x6f, 0x11, 0x4f, 0xd3,
-	0xdf, 0x22, 0x6a, 0xd9, 0x26, 0x66, 0xb5, 0xf7, 0xd0, 0xb7, 0x09, 0xae, 0x3b, 0xcb, 0xd8, 0x6f,
-	0x5a, 0x77, 0xed, 0xef, 0x00, 0xf9, 0x67, 0x4a, 0xe6, 0xe8, 0x45, 0x1d, 0xfd, 0xc1, 0x80, 0x5b,
-	0x9a, 0xaf, 0x37, 0xe8, 0xe3, 0x39, 0x3f, 0xf6, 0x88, 0xe2, 0x2c, 0xdf, 0xbb, 0xd2, 0x27, 0xa2,
-	0x61, 0x23, 0x86, 0x03, 0x33, 0x83, 0x11, 0x9a, 0x35, 0x7a, 0x06, 0x23, 0xb4, 0x9f, 0x51, 0x7a,
-	0x70, 0x63, 0x64, 0x67, 0x47, 0x3f, 0xce, 0x46, 0xd2, 0xbf, 0x82, 0x95, 0x0f, 0xe7, 0x90, 0x48,
-	0xe9, 0x4d, 0xf9, 0x3d, 0x59, 0xaf, 0xce, 0xe7, 0xc3, 0x39, 0x24, 0x94, 0xde, 0x10, 0xd6, 0x53,
-	0xfb, 0x1b, 0x32, 0xb3, 0x31, 0x74, 0xab, 0x68, 0xb9, 0x3a, 0x33, 0xbf, 0xd2, 0xf8, 0x17, 0x03,
-	0xb6, 0x32, 0xb7, 0x14, 0xf4, 0x30, 0x1b, 0x6e, 0xda, 0xe6, 0x55, 0xfe, 0xf4, 0x4a, 0xb2, 0xca,
-	0xac, 0x3f, 0x1b, 0xf0, 0xae, 0x76, 0x6f, 0x40, 0xf7, 0xb3, 0x61, 0x27, 0xed, 0x51, 0xe5, 0x9f,
-	0xcc, 0x2d, 0xa7, 0x4c, 0xe9, 0xc3, 0xc6, 0x68, 0x13, 0xa3, 0xc3, 0x79, 0x1a, 0x5e, 0xea, 0xbf,
-	0xc2, 0x8c, 0x40, 0x5f, 0x1a, 0xb0, 0xa9, 0xbf, 0x7f, 0xd1, 0x04, 0x77, 0x26, 0xee, 0x09, 0xe5,
-	0x07, 0xf3, 0x0b, 0x2a, 0x6b, 0xfe, 0x68, 0xc0, 0x3b, 0xba, 0x69, 0x8f, 0xee, 0xcd, 0x7b, 0x3b,
-	0x48, 0x4b, 0xee, 0x5f, 0xed, 0x52, 0x79, 0xf4, 0xe4, 0xeb, 0xb7, 0xbb, 0xc6, 0x3f, 0xdf, 0xee,
-	0x1a, 0xff, 0x79, 0xbb, 0x6b, 0xfc, 0xfa, 0x93, 0x73, 0x8f, 0xb5, 0xba, 0x4d, 0xd3, 0x09, 0x3a,
-	0xd5, 0xd4, 0x1f, 0x7b, 0xe6, 0x39, 0xf1, 0xe5, 0xdf, 0x9c, 0xc3, 0xff, 0xb4, 0x7e, 0x1a, 0xff,
-	0xee, 0x1d, 0x36, 0x57, 0x04, 0xf5, 0xa3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x4f, 0x1f,
-	0x16, 0x97, 0x1d, 0x00, 0x00,
+func (m *DescribeTaskListConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DescribeTaskListConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskListConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *PollForDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeTaskListConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskListConfigRequest.Merge(m, src)
+}
+func (m *DescribeTaskListConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskListConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskListConfigRequest.DiscardUnknown(m)
 }
 
-func (m *PollForDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ForwardedFrom) > 0 {
-		i -= len(m.ForwardedFrom)
-		copy(dAtA[i:], m.ForwardedFrom)
-		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_DescribeTaskListConfigRequest proto.InternalMessageInfo
+
+func (m *DescribeTaskListConfigRequest) GetDomainId() string {
+	if m != nil {
+		return m.DomainId
 	}
-	if len(m.PollerId) > 0 {
-		i -= len(m.PollerId)
-		copy(dAtA[i:], m.PollerId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.PollerId)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *DescribeTaskListConfigRequest) GetTaskList() *v1.TaskList {
+	if m != nil {
+		return m.TaskList
 	}
-	if len(m.DomainId) > 0 {
-		i -= len(m.DomainId)
-		copy(dAtA[i:], m.DomainId)
-		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *DescribeTaskListConfigRequest) GetTaskListType() v1.TaskListType {
+	if m != nil {
+		return m.TaskListType
 	}
-	if m.Request != nil {
-		{
-			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintService(dAtA, i, uint64(size))
+	return v1.TaskListType_TASK_LIST_TYPE_INVALID
+}
+
+type DescribeTaskListConfigResponse struct {
+	NumReadPartitions                 int32    `protobuf:"varint,1,opt,name=num_read_partitions,json=numReadPartitions,proto3" json:"num_read_partitions,omitempty"`
+	NumWritePartitions                int32    `protobuf:"varint,2,opt,name=num_write_partitions,json=numWritePartitions,proto3" json:"num_write_partitions,omitempty"`
+	ForwarderMaxOutstandingPolls      int32    `protobuf:"varint,3,opt,name=forwarder_max_outstanding_polls,json=forwarderMaxOutstandingPolls,proto3" json:"forwarder_max_outstanding_polls,omitempty"`
+	ForwarderMaxOutstandingTasks      int32    `protobuf:"varint,4,opt,name=forwarder_max_outstanding_tasks,json=forwarderMaxOutstandingTasks,proto3" json:"forwarder_max_outstanding_tasks,omitempty"`
+	ForwarderMaxRatePerSecond         int32    `protobuf:"varint,5,opt,name=forwarder_max_rate_per_second,json=forwarderMaxRatePerSecond,proto3" json:"forwarder_max_rate_per_second,omitempty"`
+	ForwarderMaxChildrenPerNode       int32    `protobuf:"varint,6,opt,name=forwarder_max_children_per_node,json=forwarderMaxChildrenPerNode,proto3" json:"forwarder_max_children_per_node,omitempty"`
+	EnableSyncMatch                   bool     `protobuf:"varint,7,opt,name=enable_sync_match,json=enableSyncMatch,proto3" json:"enable_sync_match,omitempty"`
+	EnableEphemeralTaskList           bool     `protobuf:"varint,8,opt,name=enable_ephemeral_task_list,json=enableEphemeralTaskList,proto3" json:"enable_ephemeral_task_list,omitempty"`
+	LongPollExpirationIntervalSeconds int64    `protobuf:"varint,9,opt,name=long_poll_expiration_interval_seconds,json=longPollExpirationIntervalSeconds,proto3" json:"long_poll_expiration_interval_seconds,omitempty"`
+	RangeSize                         int64    `protobuf:"varint,10,opt,name=range_size,json=rangeSize,proto3" json:"range_size,omitempty"`
+	GetTasksBatchSize                 int32    `protobuf:"varint,11,opt,name=get_tasks_batch_size,json=getTasksBatchSize,proto3" json:"get_tasks_batch_size,omitempty"`
+	UpdateAckIntervalSeconds          int64    `protobuf:"varint,12,opt,name=update_ack_interval_seconds,json=updateAckIntervalSeconds,proto3" json:"update_ack_interval_seconds,omitempty"`
+	IdleTaskListCheckIntervalSeconds  int64    `protobuf:"varint,13,opt,name=idle_task_list_check_interval_seconds,json=idleTaskListCheckIntervalSeconds,proto3" json:"idle_task_list_check_interval_seconds,omitempty"`
+	MaxTaskListIdleTimeSeconds        int64    `protobuf:"varint,14,opt,name=max_task_list_idle_time_seconds,json=maxTaskListIdleTimeSeconds,proto3" json:"max_task_list_idle_time_seconds,omitempty"`
+	MinTaskThrottlingBurstSize        int32    `protobuf:"varint,15,opt,name=min_task_throttling_burst_size,json=minTaskThrottlingBurstSize,proto3" json:"min_task_throttling_burst_size,omitempty"`
+	MaxTaskDeleteBatchSize            int32    `protobuf:"varint,16,opt,name=max_task_delete_batch_size,json=maxTaskDeleteBatchSize,proto3" json:"max_task_delete_batch_size,omitempty"`
+	OutstandingTaskAppendsThreshold   int32    `protobuf:"varint,17,opt,name=outstanding_task_appends_threshold,json=outstandingTaskAppendsThreshold,proto3" json:"outstanding_task_appends_threshold,omitempty"`
+	MaxTaskBatchSize                  int32    `protobuf:"varint,18,opt,name=max_task_batch_size,json=maxTaskBatchSize,proto3" json:"max_task_batch_size,omitempty"`
+	XXX_NoUnkeyedLiteral              struct{} `json:"-"`
+	XXX_unrecognized                  []byte   `json:"-"`
+	XXX_sizecache                     int32    `json:"-"`
+}
+
+func (m *DescribeTaskListConfigResponse) Reset()         { *m = DescribeTaskListConfigResponse{} }
+func (m *DescribeTaskListConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeTaskListConfigResponse) ProtoMessage()    {}
+func (*DescribeTaskListConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_826e827d3aabf7fc, []int{22}
+}
+func (m *DescribeTaskListConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DescribeTaskListConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DescribeTaskListConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DescribeTaskListConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeTaskListConfigResponse.Merge(m, src)
+}
+func (m *DescribeTaskListConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DescribeTaskListConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeTaskListConfigResponse.DiscardUnknown(m)
 }
 
-func (m *PollForDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DescribeTaskListConfigResponse proto.InternalMessageInfo
+
+func (m *DescribeTaskListConfigResponse) GetNumReadPartitions() int32 {
+	if m != nil {
+		return m.NumReadPartitions
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PollForDecisionTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DescribeTaskListConfigResponse) GetNumWritePartitions() int32 {
+	if m != nil {
+		return m.NumWritePartitions
+	}
+	return 0
 }
 
-func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+func (m *DescribeTaskListConfigResponse) GetForwarderMaxOutstandingPolls() int32 {
+	if m != nil {
+		return m.ForwarderMaxOutstandingPolls
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetForwarderMaxOutstandingTasks() int32 {
+	if m != nil {
+		return m.ForwarderMaxOutstandingTasks
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetForwarderMaxRatePerSecond() int32 {
+	if m != nil {
+		return m.ForwarderMaxRatePerSecond
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetForwarderMaxChildrenPerNode() int32 {
+	if m != nil {
+		return m.ForwarderMaxChildrenPerNode
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetEnableSyncMatch() bool {
+	if m != nil {
+		return m.EnableSyncMatch
+	}
+	return false
+}
+
+func (m *DescribeTaskListConfigResponse) GetEnableEphemeralTaskList() bool {
+	if m != nil {
+		return m.EnableEphemeralTaskList
+	}
+	return false
+}
+
+func (m *DescribeTaskListConfigResponse) GetLongPollExpirationIntervalSeconds() int64 {
+	if m != nil {
+		return m.LongPollExpirationIntervalSeconds
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetRangeSize() int64 {
+	if m != nil {
+		return m.RangeSize
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetGetTasksBatchSize() int32 {
+	if m != nil {
+		return m.GetTasksBatchSize
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetUpdateAckIntervalSeconds() int64 {
+	if m != nil {
+		return m.UpdateAckIntervalSeconds
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetIdleTaskListCheckIntervalSeconds() int64 {
+	if m != nil {
+		return m.IdleTaskListCheckIntervalSeconds
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetMaxTaskListIdleTimeSeconds() int64 {
+	if m != nil {
+		return m.MaxTaskListIdleTimeSeconds
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetMinTaskThrottlingBurstSize() int32 {
+	if m != nil {
+		return m.MinTaskThrottlingBurstSize
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetMaxTaskDeleteBatchSize() int32 {
+	if m != nil {
+		return m.MaxTaskDeleteBatchSize
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetOutstandingTaskAppendsThreshold() int32 {
+	if m != nil {
+		return m.OutstandingTaskAppendsThreshold
+	}
+	return 0
+}
+
+func (m *DescribeTaskListConfigResponse) GetMaxTaskBatchSize() int32 {
+	if m != nil {
+		return m.MaxTaskBatchSize
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PollForDecisionTaskRequest)(nil), "uber.cadence.matching.v1.PollForDecisionTaskRequest")
+	proto.RegisterType((*PollForDecisionTaskResponse)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse")
+	proto.RegisterMapType((map[string]*v1.WorkflowQuery)(nil), "uber.cadence.matching.v1.PollForDecisionTaskResponse.QueriesEntry")
+	proto.RegisterType((*PollForActivityTaskRequest)(nil), "uber.cadence.matching.v1.PollForActivityTaskRequest")
+	proto.RegisterType((*PollForActivityTaskResponse)(nil), "uber.cadence.matching.v1.PollForActivityTaskResponse")
+	proto.RegisterType((*AddDecisionTaskRequest)(nil), "uber.cadence.matching.v1.AddDecisionTaskRequest")
+	proto.RegisterType((*AddDecisionTaskResponse)(nil), "uber.cadence.matching.v1.AddDecisionTaskResponse")
+	proto.RegisterType((*AddActivityTaskRequest)(nil), "uber.cadence.matching.v1.AddActivityTaskRequest")
+	proto.RegisterType((*AddActivityTaskResponse)(nil), "uber.cadence.matching.v1.AddActivityTaskResponse")
+	proto.RegisterType((*QueryWorkflowRequest)(nil), "uber.cadence.matching.v1.QueryWorkflowRequest")
+	proto.RegisterType((*QueryWorkflowResponse)(nil), "uber.cadence.matching.v1.QueryWorkflowResponse")
+	proto.RegisterType((*RespondQueryTaskCompletedRequest)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedRequest")
+	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "uber.cadence.matching.v1.RespondQueryTaskCompletedResponse")
+	proto.RegisterType((*CancelOutstandingPollRequest)(nil), "uber.cadence.matching.v1.CancelOutstandingPollRequest")
+	proto.RegisterType((*CancelOutstandingPollResponse)(nil), "uber.cadence.matching.v1.CancelOutstandingPollResponse")
+	proto.RegisterType((*DescribeTaskListRequest)(nil), "uber.cadence.matching.v1.DescribeTaskListRequest")
+	proto.RegisterType((*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.DescribeTaskListResponse")
+	proto.RegisterType((*TaskListMatchStats)(nil), "uber.cadence.matching.v1.TaskListMatchStats")
+	proto.RegisterType((*ListTaskListPartitionsRequest)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsRequest")
+	proto.RegisterType((*ListTaskListPartitionsResponse)(nil), "uber.cadence.matching.v1.ListTaskListPartitionsResponse")
+	proto.RegisterType((*GetTaskListsByDomainRequest)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainRequest")
+	proto.RegisterType((*GetTaskListsByDomainResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse")
+	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.ActivityTaskListMapEntry")
+	proto.RegisterMapType((map[string]*DescribeTaskListResponse)(nil), "uber.cadence.matching.v1.GetTaskListsByDomainResponse.DecisionTaskListMapEntry")
+	proto.RegisterType((*DescribeTaskListConfigRequest)(nil), "uber.cadence.matching.v1.DescribeTaskListConfigRequest")
+	proto.RegisterType((*DescribeTaskListConfigResponse)(nil), "uber.cadence.matching.v1.DescribeTaskListConfigResponse")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/matching/v1/service.proto", fileDescriptor_826e827d3aabf7fc)
+}
+
+var fileDescriptor_826e827d3aabf7fc = []byte{
+	// 2611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0xcb, 0x6e, 0x23, 0xc7,
+	0xd5, 0x46, 0xeb, 0xce, 0x43, 0x89, 0x23, 0xd5, 0xc8, 0x1a, 0x8a, 0xba, 0x0e, 0xfd, 0x8f, 0x7f,
+	0xd9, 0xb0, 0x29, 0x4b, 0xb6, 0x9c, 0xf1, 0x18, 0x46, 0xa2, 0xdb, 0xd8, 0x84, 0x23, 0x4b, 0x6e,
+	0x29, 0x36, 0x10, 0x04, 0x6e, 0x14, 0xbb, 0x8b, 0x62, 0x47, 0xcd, 0x6e, 0x4e, 0x57, 0x91, 0x12,
+	0xbd, 0xc8, 0x22, 0x70, 0x82, 0x00, 0xde, 0x64, 0x91, 0x55, 0xb6, 0xc9, 0x26, 0xaf, 0x90, 0x5d,
+	0x76, 0x5e, 0x66, 0x1f, 0x04, 0x08, 0x0c, 0x24, 0x4f, 0x90, 0x07, 0x08, 0xea, 0xd6, 0xec, 0x26,
+	0x9b, 0xa4, 0x28, 0xf9, 0xb2, 0x53, 0x57, 0x7d, 0xe7, 0xab, 0x53, 0xa7, 0xce, 0xad, 0x8a, 0x82,
+	0x57, 0x9a, 0x15, 0x12, 0x6e, 0xdb, 0xd8, 0x21, 0xbe, 0x4d, 0xb6, 0xeb, 0x98, 0xd9, 0x35, 0xd7,
+	0xbf, 0xdc, 0x6e, 0xed, 0x6c, 0x53, 0x12, 0xb6, 0x5c, 0x9b, 0x94, 0x1a, 0x61, 0xc0, 0x02, 0x94,
+	0xe7, 0xb8, 0x92, 0xc2, 0x95, 0x34, 0xae, 0xd4, 0xda, 0x29, 0xac, 0x5f, 0x06, 0xc1, 0xa5, 0x47,
+	0xb6, 0x05, 0xae, 0xd2, 0xac, 0x6e, 0x3b, 0xcd, 0x10, 0x33, 0x37, 0xf0, 0xa5, 0x64, 0x61, 0xa3,
+	0x7b, 0x9e, 0xb9, 0x75, 0x42, 0x19, 0xae, 0x37, 0x14, 0xa0, 0x87, 0xe0, 0x3a, 0xc4, 0x8d, 0x06,
+	0x09, 0xa9, 0x9a, 0xdf, 0x4c, 0xa8, 0x88, 0x1b, 0x2e, 0xd7, 0xce, 0x0e, 0xea, 0xf5, 0xce, 0x12,
+	0x69, 0x88, 0x17, 0x4d, 0x12, 0xb6, 0x15, 0xa0, 0x98, 0x06, 0x60, 0x98, 0x5e, 0x79, 0x2e, 0x65,
+	0x0a, 0xb3, 0x95, 0x86, 0x51, 0x46, 0xb0, 0xae, 0x83, 0xf0, 0x8a, 0x84, 0x0a, 0xf9, 0xda, 0x30,
+	0x64, 0xd5, 0x0b, 0xae, 0x15, 0xf6, 0xff, 0x12, 0x58, 0x5a, 0xc3, 0x21, 0x71, 0x38, 0xbc, 0xe6,
+	0x52, 0x16, 0x44, 0xfa, 0x3d, 0xe9, 0x83, 0x4a, 0xaa, 0x58, 0xfc, 0xda, 0x80, 0xc2, 0x59, 0xe0,
+	0x79, 0xcf, 0x83, 0xf0, 0x88, 0xd8, 0x2e, 0x75, 0x03, 0xff, 0x02, 0xd3, 0x2b, 0x93, 0xbc, 0x68,
+	0x12, 0xca, 0x50, 0x19, 0xa6, 0x43, 0xf9, 0x67, 0xde, 0xd8, 0x34, 0xb6, 0xb2, 0xbb, 0xdb, 0xa5,
+	0xc4, 0xa9, 0xe1, 0x86, 0x5b, 0x6a, 0xed, 0x94, 0xfa, 0x33, 0x98, 0x5a, 0x1e, 0xad, 0x40, 0xc6,
+	0x09, 0xea, 0xd8, 0xf5, 0x2d, 0xd7, 0xc9, 0x8f, 0x6d, 0x1a, 0x5b, 0x19, 0x73, 0x46, 0x0e, 0x94,
+	0x1d, 0x3e, 0xd9, 0x08, 0x3c, 0x8f, 0x84, 0x7c, 0x72, 0x5c, 0x4e, 0xca, 0x81, 0xb2, 0x83, 0x9e,
+	0x40, 0xae, 0x1a, 0x84, 0xd7, 0x38, 0x74, 0x88, 0x63, 0x55, 0xc3, 0xa0, 0x9e, 0x9f, 0x10, 0x88,
+	0xb9, 0x68, 0xf4, 0x79, 0x18, 0xd4, 0x8b, 0x5f, 0x66, 0x60, 0x25, 0x55, 0x11, 0xda, 0x08, 0x7c,
+	0x4a, 0xd0, 0x1a, 0x00, 0xdf, 0xbc, 0xc5, 0x82, 0x2b, 0xe2, 0x8b, 0xed, 0xcc, 0x9a, 0x19, 0x3e,
+	0x72, 0xc1, 0x07, 0xd0, 0xcf, 0x00, 0x69, 0x43, 0x5b, 0xe4, 0x86, 0xd8, 0x4d, 0xee, 0x70, 0x42,
+	0xd1, 0xec, 0xee, 0x2b, 0xa9, 0xbb, 0xfe, 0x4c, 0xc1, 0x8f, 0x35, 0xda, 0x5c, 0xb8, 0xee, 0x1e,
+	0x42, 0xcf, 0x61, 0x2e, 0xa2, 0x65, 0xed, 0x06, 0x11, 0xbb, 0xcb, 0xee, 0x3e, 0x1e, 0xc8, 0x78,
+	0xd1, 0x6e, 0x10, 0x73, 0xf6, 0x3a, 0xf6, 0x85, 0x3e, 0x85, 0xe5, 0x46, 0x48, 0x5a, 0x6e, 0xd0,
+	0xa4, 0x16, 0x65, 0x38, 0x64, 0xc4, 0xb1, 0x48, 0x8b, 0xf8, 0x8c, 0x5b, 0x6c, 0x42, 0x70, 0xae,
+	0x94, 0xa4, 0xdb, 0x97, 0xb4, 0xdb, 0x97, 0xca, 0x3e, 0x7b, 0xe7, 0xed, 0x4f, 0xb1, 0xd7, 0x24,
+	0xe6, 0x92, 0x96, 0x3e, 0x97, 0xc2, 0xc7, 0x5c, 0xb6, 0xec, 0xa0, 0x2d, 0x98, 0xef, 0xa1, 0x9b,
+	0xdc, 0x34, 0xb6, 0xc6, 0xcd, 0x1c, 0x4d, 0x22, 0xf3, 0x30, 0x8d, 0x19, 0x23, 0xf5, 0x06, 0xcb,
+	0x4f, 0x6d, 0x1a, 0x5b, 0x93, 0xa6, 0xfe, 0x44, 0x45, 0x98, 0xf3, 0xc9, 0x0d, 0xeb, 0x10, 0x4c,
+	0x0b, 0x82, 0x2c, 0x1f, 0xd4, 0xd2, 0xaf, 0x03, 0xaa, 0x60, 0xfb, 0xca, 0x0b, 0x2e, 0x2d, 0x3b,
+	0x68, 0xfa, 0xcc, 0xaa, 0xb9, 0x3e, 0xcb, 0xcf, 0x08, 0xe0, 0xbc, 0x9a, 0x39, 0xe4, 0x13, 0x1f,
+	0xba, 0x3e, 0x43, 0x4f, 0x21, 0x4f, 0x99, 0x6b, 0x5f, 0xb5, 0x3b, 0x47, 0x61, 0x11, 0x1f, 0x57,
+	0x3c, 0xe2, 0xe4, 0x33, 0x9b, 0xc6, 0xd6, 0x8c, 0xb9, 0x24, 0xe7, 0x23, 0x43, 0x1f, 0xcb, 0x59,
+	0xf4, 0x14, 0x26, 0x45, 0x98, 0xe6, 0x41, 0xd8, 0xa4, 0x38, 0xd0, 0xce, 0x9f, 0x70, 0xa4, 0x29,
+	0x05, 0x90, 0x09, 0x73, 0x8e, 0xf2, 0x1b, 0xcb, 0xf5, 0xab, 0x41, 0x3e, 0x2b, 0x18, 0xde, 0x48,
+	0x32, 0xc8, 0x48, 0xe2, 0x24, 0x17, 0x21, 0xf6, 0xa9, 0x4b, 0x7c, 0xa6, 0xbd, 0xad, 0xec, 0x57,
+	0x03, 0x73, 0xd6, 0x89, 0x7d, 0xa1, 0xcf, 0x61, 0xb5, 0xd7, 0xa9, 0x2c, 0xe1, 0x86, 0x3c, 0x08,
+	0xf3, 0xb3, 0x62, 0x89, 0xb5, 0x54, 0x25, 0xb9, 0xf3, 0xfe, 0xd4, 0xa5, 0xcc, 0x5c, 0xee, 0xf1,
+	0x2a, 0x3d, 0x85, 0x4a, 0xf0, 0x50, 0x1a, 0x9d, 0x87, 0x3e, 0xb1, 0x5a, 0x24, 0xe4, 0x4b, 0xe7,
+	0xe7, 0xc4, 0xf9, 0x2c, 0x88, 0xa9, 0x73, 0x3e, 0xf3, 0xa9, 0x9c, 0x40, 0x8f, 0x61, 0xb6, 0x12,
+	0x62, 0xdf, 0xae, 0xa9, 0x28, 0xc8, 0x89, 0x28, 0xc8, 0xca, 0x31, 0x19, 0x07, 0xfb, 0x90, 0xa3,
+	0x76, 0x8d, 0x38, 0x4d, 0x8f, 0x38, 0x16, 0x4f, 0xac, 0xf9, 0x07, 0x42, 0xc9, 0x42, 0x8f, 0x77,
+	0x5d, 0xe8, 0xac, 0x6b, 0xce, 0x45, 0x12, 0x7c, 0x0c, 0xbd, 0x0f, 0xb3, 0xda, 0xa7, 0x04, 0xc1,
+	0xfc, 0x50, 0x82, 0xac, 0xc2, 0x0b, 0xf1, 0x5f, 0xc0, 0x34, 0x3f, 0x11, 0x97, 0xd0, 0xfc, 0xc2,
+	0xe6, 0xf8, 0x56, 0x76, 0xf7, 0xa0, 0xd4, 0xaf, 0x54, 0x94, 0x06, 0x04, 0x7c, 0xe9, 0x13, 0x49,
+	0x72, 0xec, 0xb3, 0xb0, 0x6d, 0x6a, 0xca, 0xc2, 0xe7, 0x30, 0x1b, 0x9f, 0x40, 0xf3, 0x30, 0x7e,
+	0x45, 0xda, 0x22, 0x1f, 0x64, 0x4c, 0xfe, 0x27, 0x77, 0xa1, 0x16, 0x8f, 0x99, 0xfc, 0xd8, 0xed,
+	0x5d, 0x48, 0x08, 0x3c, 0x1b, 0x7b, 0x6a, 0xc4, 0x33, 0xea, 0xbe, 0xcd, 0xdc, 0x96, 0xcb, 0xda,
+	0x77, 0xcf, 0xa8, 0x29, 0x0c, 0xdf, 0x63, 0x46, 0xfd, 0x6a, 0x06, 0x56, 0x52, 0x15, 0xf9, 0x41,
+	0x33, 0xea, 0x06, 0x64, 0xb1, 0xd2, 0xa6, 0xb3, 0x37, 0xd0, 0x43, 0x65, 0x87, 0xa7, 0xdc, 0x08,
+	0x20, 0x52, 0xee, 0xc4, 0x80, 0x94, 0x1b, 0x6d, 0x4c, 0xa4, 0x5c, 0x1c, 0xfb, 0x42, 0xbb, 0x30,
+	0xe9, 0xfa, 0x8d, 0x26, 0x13, 0xf9, 0x30, 0xbb, 0xbb, 0x9a, 0x7e, 0x50, 0xb8, 0xed, 0x05, 0xd8,
+	0x31, 0x25, 0x34, 0x25, 0x7a, 0xa6, 0xee, 0x1b, 0x3d, 0xd3, 0xa3, 0x45, 0xcf, 0x05, 0x2c, 0x6b,
+	0x3e, 0x8b, 0x05, 0x96, 0xed, 0x05, 0x94, 0x08, 0xa2, 0xa0, 0x29, 0xf3, 0x6d, 0x76, 0x77, 0xb9,
+	0x87, 0xeb, 0x48, 0x35, 0x58, 0xe6, 0x92, 0x96, 0xbd, 0x08, 0x0e, 0xb9, 0xe4, 0x85, 0x14, 0x44,
+	0x1f, 0xc3, 0x92, 0x58, 0xa4, 0x97, 0x32, 0x33, 0x8c, 0xf2, 0xa1, 0x10, 0xec, 0xe2, 0x7b, 0x0e,
+	0x0b, 0x35, 0x82, 0x43, 0x56, 0x21, 0x98, 0x45, 0x54, 0x30, 0x8c, 0x6a, 0x3e, 0x92, 0xd1, 0x3c,
+	0xb1, 0xa2, 0x94, 0x4d, 0x16, 0xa5, 0xcf, 0x61, 0x3d, 0x79, 0x12, 0x56, 0x50, 0xb5, 0x58, 0xcd,
+	0xa5, 0x96, 0x16, 0x98, 0x1d, 0x6a, 0xd8, 0x42, 0xe2, 0x64, 0x4e, 0xab, 0x17, 0x35, 0x97, 0xee,
+	0x2b, 0xfe, 0x72, 0x7c, 0x07, 0x0e, 0x61, 0xd8, 0xf5, 0x68, 0x7e, 0xee, 0x16, 0x9e, 0xd2, 0xd9,
+	0xc4, 0x91, 0x94, 0xea, 0xed, 0x11, 0x72, 0x77, 0xeb, 0x11, 0xfe, 0x1f, 0x1e, 0x44, 0x3c, 0x32,
+	0x11, 0x88, 0xdc, 0x9d, 0x31, 0x73, 0x7a, 0xf8, 0x48, 0x8c, 0xa2, 0xb7, 0x60, 0xaa, 0x46, 0xb0,
+	0x43, 0x42, 0x95, 0x9a, 0x57, 0x52, 0x57, 0xfa, 0x50, 0x40, 0x4c, 0x05, 0x2d, 0xfe, 0x67, 0x02,
+	0x96, 0xf6, 0x1d, 0x27, 0xad, 0x4d, 0x4c, 0x64, 0x22, 0xa3, 0x2b, 0x13, 0x7d, 0x47, 0x69, 0xe0,
+	0x19, 0x64, 0x3a, 0x75, 0x74, 0xfc, 0x36, 0x75, 0x74, 0x86, 0xa9, 0xbf, 0x78, 0x0a, 0x89, 0x62,
+	0x44, 0xb5, 0x4f, 0xe3, 0x26, 0xe8, 0xa1, 0xb2, 0xd3, 0x1d, 0x44, 0xca, 0xf5, 0x95, 0x9b, 0x4e,
+	0x8e, 0x10, 0x44, 0xa2, 0xdb, 0xd2, 0xce, 0xfa, 0x0c, 0xa6, 0x68, 0xd0, 0x0c, 0x6d, 0x99, 0x14,
+	0x72, 0xbb, 0xc5, 0xbe, 0xad, 0x05, 0xa6, 0x57, 0xe7, 0x02, 0x69, 0x2a, 0x89, 0x94, 0x94, 0x3d,
+	0x9d, 0x92, 0xb2, 0xd1, 0x2a, 0x64, 0x48, 0xa3, 0x46, 0xea, 0x24, 0xc4, 0x9e, 0x88, 0xf6, 0x19,
+	0xb3, 0x33, 0xc0, 0xcb, 0x3f, 0xae, 0x56, 0x5d, 0x9f, 0x67, 0x46, 0x5e, 0xf4, 0x32, 0x82, 0x22,
+	0xab, 0xc7, 0x3e, 0x22, 0xed, 0x78, 0x40, 0x81, 0x30, 0x8b, 0xfe, 0x44, 0x7b, 0xf0, 0x88, 0xd6,
+	0xb0, 0x13, 0x5c, 0x5b, 0x52, 0xa5, 0x58, 0x1b, 0x93, 0x15, 0x3c, 0x8b, 0x72, 0x5a, 0x2a, 0x1e,
+	0xb5, 0x28, 0x6f, 0x82, 0x1a, 0xb7, 0xea, 0x84, 0x61, 0x07, 0x33, 0x6c, 0x05, 0xbe, 0xd7, 0x16,
+	0xd1, 0x37, 0x63, 0x22, 0x39, 0x77, 0xa2, 0xa6, 0x4e, 0x7d, 0xaf, 0x5d, 0x5c, 0x86, 0x47, 0x3d,
+	0x7e, 0x26, 0x2b, 0x4e, 0xf1, 0xf7, 0x93, 0xc2, 0x07, 0xd3, 0x0a, 0xeb, 0x0f, 0xe1, 0x83, 0xbc,
+	0x79, 0x96, 0xb6, 0xe8, 0x2c, 0x2d, 0xeb, 0x51, 0x4e, 0x8e, 0x1f, 0x69, 0x05, 0x12, 0xde, 0x3a,
+	0x71, 0x2f, 0x6f, 0x9d, 0x1c, 0xcd, 0x5b, 0xa7, 0xee, 0xef, 0xad, 0xd3, 0xdf, 0x82, 0xb7, 0xce,
+	0x0c, 0xf5, 0xd6, 0xcc, 0x30, 0x6f, 0x85, 0x81, 0xde, 0x9a, 0xbd, 0xb5, 0xb7, 0xce, 0xde, 0xc1,
+	0x5b, 0xe7, 0x86, 0x78, 0x6b, 0x5a, 0x7f, 0x54, 0xfc, 0x87, 0x01, 0x8b, 0xa2, 0x3f, 0xd4, 0xce,
+	0xa4, 0x7d, 0xf5, 0xb0, 0xbb, 0x09, 0x7c, 0x35, 0xd5, 0x17, 0xd2, 0x64, 0x6f, 0xd9, 0xfe, 0xdd,
+	0x27, 0x3b, 0xde, 0xb2, 0x3b, 0xfc, 0x93, 0x01, 0x2f, 0x75, 0x69, 0xa8, 0xfa, 0xc2, 0x1f, 0xc3,
+	0xac, 0xb8, 0x52, 0x59, 0x21, 0xa1, 0x4d, 0x4f, 0xef, 0x71, 0x70, 0x55, 0xcc, 0x0a, 0x09, 0x53,
+	0x08, 0xa0, 0x32, 0xe4, 0x34, 0xc1, 0x2f, 0x89, 0xcd, 0x88, 0x33, 0xb0, 0x15, 0x97, 0x2d, 0xb8,
+	0x42, 0x9a, 0x73, 0x2f, 0xe2, 0x9f, 0xc5, 0x7f, 0x1b, 0xb0, 0x29, 0x15, 0x73, 0x04, 0x8e, 0xef,
+	0xf7, 0x30, 0xa8, 0x37, 0x3c, 0xc2, 0xc1, 0xca, 0x94, 0xa7, 0xdd, 0xe7, 0xb1, 0x97, 0xba, 0xd0,
+	0x30, 0x9e, 0xef, 0xe1, 0x6c, 0x1e, 0xc1, 0xb4, 0x90, 0x55, 0x55, 0x2b, 0x63, 0x4e, 0xf1, 0xcf,
+	0xb2, 0x53, 0x7c, 0x19, 0x1e, 0x0f, 0x50, 0x4f, 0x39, 0xe4, 0x3f, 0x0d, 0x58, 0x3d, 0xc4, 0xbe,
+	0x4d, 0xbc, 0xd3, 0x26, 0xa3, 0x0c, 0xfb, 0x8e, 0xeb, 0x5f, 0xf2, 0x0e, 0xff, 0x56, 0x49, 0x34,
+	0x71, 0xa5, 0x18, 0xeb, 0xba, 0x52, 0x7c, 0x00, 0xb9, 0x68, 0x53, 0x9d, 0x87, 0x8e, 0x5c, 0x9f,
+	0x26, 0x46, 0xef, 0x4c, 0x36, 0x31, 0x2c, 0xf6, 0x75, 0x9f, 0x4c, 0x59, 0xdc, 0x80, 0xb5, 0x3e,
+	0xdb, 0x53, 0x06, 0xf8, 0x15, 0x3c, 0x3a, 0x22, 0xd4, 0x0e, 0xdd, 0x4a, 0x14, 0xf2, 0x7a, 0xeb,
+	0xcf, 0xbb, 0x7d, 0xe0, 0xf5, 0xd4, 0x55, 0xfb, 0x88, 0xdf, 0xee, 0xe8, 0x8b, 0x7f, 0x19, 0x83,
+	0x7c, 0x2f, 0x83, 0x0a, 0x9b, 0x77, 0x61, 0x5a, 0x9a, 0x93, 0xe6, 0x0d, 0x71, 0xef, 0xdd, 0xe8,
+	0x7b, 0x35, 0x24, 0xa1, 0x78, 0x6c, 0xd0, 0x78, 0x74, 0x02, 0xf3, 0x1d, 0xeb, 0x53, 0x86, 0x59,
+	0x93, 0xaa, 0x90, 0x79, 0x79, 0xa0, 0xed, 0xce, 0x05, 0xd4, 0xcc, 0xb1, 0xc4, 0x37, 0x3a, 0x81,
+	0xac, 0xb8, 0x64, 0x0b, 0x2a, 0x9a, 0x1f, 0x4f, 0xb3, 0x47, 0xfc, 0x16, 0xae, 0xe9, 0x4e, 0xf8,
+	0x18, 0xe7, 0xa0, 0x26, 0xd4, 0xa3, 0xbf, 0xd1, 0xdb, 0xb0, 0x14, 0x74, 0x0e, 0xc4, 0xe2, 0x4a,
+	0xcb, 0x47, 0x20, 0xd5, 0x79, 0x2d, 0x06, 0xc9, 0xe3, 0x12, 0xef, 0x40, 0xc5, 0x3f, 0x1a, 0x80,
+	0x7a, 0x89, 0x79, 0x55, 0xa0, 0x6d, 0xdf, 0xb6, 0x04, 0x3f, 0x91, 0x5e, 0x3a, 0x6e, 0x66, 0xf9,
+	0xd8, 0x89, 0x1c, 0x42, 0xaf, 0xc2, 0x7c, 0xa5, 0x59, 0xad, 0x92, 0x90, 0x38, 0x11, 0x6c, 0x4c,
+	0xc0, 0x1e, 0xe8, 0x71, 0x0d, 0x5d, 0x85, 0x4c, 0x94, 0xd5, 0xc4, 0x3e, 0xc7, 0xcd, 0xce, 0x00,
+	0x2f, 0x2f, 0xe4, 0xa6, 0xe1, 0x86, 0x44, 0xf7, 0x88, 0xfa, 0xb3, 0x48, 0x61, 0x4d, 0x78, 0xac,
+	0xd2, 0xef, 0x0c, 0x87, 0xcc, 0xe5, 0xd5, 0x94, 0x6a, 0x77, 0x5a, 0x82, 0x29, 0xd5, 0x82, 0xcb,
+	0x30, 0x52, 0x5f, 0x49, 0xf7, 0x1e, 0x1b, 0xcd, 0xbd, 0x7f, 0x3b, 0x06, 0xeb, 0xfd, 0x56, 0x55,
+	0x3e, 0xf4, 0x02, 0xd6, 0x3a, 0x77, 0xdf, 0xc8, 0x23, 0x1a, 0x11, 0x50, 0x79, 0x56, 0x69, 0xe0,
+	0x92, 0x11, 0xaf, 0x2e, 0x74, 0x66, 0x01, 0xc7, 0xea, 0x5b, 0x72, 0x69, 0xbe, 0x64, 0xf4, 0x6e,
+	0x96, 0xba, 0xe4, 0xd8, 0xdd, 0x96, 0x74, 0x62, 0x0d, 0x60, 0x72, 0xc9, 0xe2, 0x1e, 0xac, 0x7c,
+	0x40, 0x22, 0x33, 0xd0, 0x83, 0xb6, 0xec, 0xb3, 0x86, 0xd8, 0xbe, 0xf8, 0xe7, 0x09, 0x58, 0x4d,
+	0x97, 0x53, 0xd6, 0xfb, 0xd2, 0x80, 0xa5, 0x94, 0xbd, 0xd4, 0x71, 0x43, 0xd9, 0xed, 0xb4, 0x7f,
+	0x0c, 0x0c, 0x22, 0x2e, 0x1d, 0x75, 0xed, 0xe5, 0x04, 0x37, 0xe4, 0xb3, 0xd4, 0x43, 0xa7, 0x77,
+	0x46, 0xa8, 0x91, 0x72, 0x8a, 0x5c, 0x8d, 0xb1, 0x7b, 0xa9, 0xb1, 0xdf, 0x75, 0x8a, 0x1d, 0x35,
+	0x70, 0xef, 0x4c, 0xe1, 0x0b, 0x9e, 0xab, 0xd2, 0xf5, 0x4e, 0x79, 0x35, 0xfb, 0x30, 0xf9, 0x6a,
+	0xb6, 0xdb, 0x5f, 0xc5, 0x7e, 0x09, 0x30, 0xf6, 0x8a, 0xc6, 0xd7, 0xee, 0xa7, 0xec, 0x77, 0xbd,
+	0x76, 0xf1, 0x6f, 0x06, 0xac, 0x75, 0xe3, 0x0e, 0x03, 0xbf, 0xea, 0x5e, 0xde, 0xaa, 0x4c, 0xde,
+	0x23, 0xc2, 0xbf, 0xb5, 0x2a, 0x5a, 0xfc, 0xef, 0x0c, 0xac, 0xf7, 0xdb, 0x83, 0x72, 0xf6, 0x12,
+	0x3c, 0xf4, 0x9b, 0x75, 0x2b, 0x24, 0xd8, 0x49, 0x26, 0x08, 0xf1, 0x76, 0xec, 0x37, 0xeb, 0x26,
+	0xc1, 0x4e, 0x2c, 0xce, 0xdf, 0x84, 0x45, 0x8e, 0xbf, 0x0e, 0x5d, 0x46, 0x92, 0xe1, 0xcd, 0x05,
+	0x90, 0xdf, 0xac, 0x7f, 0xc6, 0xa7, 0x62, 0x12, 0xc7, 0xb0, 0xa1, 0x73, 0x69, 0x68, 0xd5, 0xf1,
+	0x8d, 0xd5, 0x5d, 0x05, 0x64, 0x69, 0x99, 0x34, 0x57, 0x23, 0xd8, 0x09, 0xbe, 0xe9, 0xaa, 0xdd,
+	0x43, 0x68, 0xf8, 0xae, 0x69, 0x7e, 0x62, 0x20, 0x0d, 0x37, 0x00, 0x45, 0x3f, 0x81, 0xb5, 0x24,
+	0x4d, 0x88, 0xf9, 0x46, 0x48, 0x68, 0x51, 0x62, 0x07, 0xbe, 0xbc, 0x58, 0x4d, 0x9a, 0xcb, 0x71,
+	0x12, 0x13, 0x33, 0x72, 0x46, 0xc2, 0x73, 0x01, 0x40, 0x47, 0xdd, 0x8a, 0xd8, 0x35, 0xd7, 0x73,
+	0x42, 0xe2, 0x0b, 0x16, 0x3f, 0x70, 0x88, 0xfa, 0x65, 0x64, 0x25, 0xce, 0x71, 0xa8, 0x40, 0x67,
+	0x24, 0xfc, 0x38, 0x70, 0x08, 0x7a, 0x0d, 0x16, 0xe4, 0x4f, 0x19, 0x56, 0xa7, 0x8e, 0x89, 0x2b,
+	0xd6, 0x8c, 0xf9, 0x40, 0x4e, 0x9c, 0xeb, 0x5a, 0x86, 0xde, 0x83, 0x82, 0xc2, 0x46, 0xd7, 0xa2,
+	0xd8, 0x45, 0x46, 0xde, 0xef, 0x1f, 0x49, 0xc4, 0xb1, 0x06, 0x44, 0x77, 0x99, 0x33, 0x78, 0xe2,
+	0x05, 0xba, 0xde, 0x8a, 0xc2, 0x25, 0x2e, 0x7c, 0x96, 0xeb, 0x33, 0x12, 0xb6, 0xb0, 0xa7, 0xf6,
+	0x4d, 0xc5, 0xcd, 0x6b, 0xdc, 0x7c, 0xec, 0x05, 0xd2, 0xe2, 0xc7, 0x11, 0xb4, 0xac, 0x90, 0x72,
+	0xff, 0x94, 0x3f, 0xf8, 0x86, 0xd8, 0xbf, 0x24, 0x16, 0x75, 0xbf, 0x20, 0xea, 0x7d, 0x20, 0x23,
+	0x46, 0xce, 0xdd, 0x2f, 0x08, 0xda, 0x86, 0xc5, 0x4b, 0xc2, 0xe4, 0x91, 0x58, 0x15, 0xd9, 0x40,
+	0x70, 0xa0, 0x7c, 0x99, 0x5b, 0xb8, 0x94, 0xa9, 0x89, 0x1e, 0x88, 0x62, 0xce, 0x05, 0xde, 0x87,
+	0x95, 0x66, 0xc3, 0xe1, 0xc7, 0x80, 0xed, 0xab, 0x5e, 0xbd, 0x66, 0xc5, 0x02, 0x79, 0x09, 0xd9,
+	0xb7, 0xaf, 0xba, 0xd5, 0x39, 0x85, 0x27, 0xae, 0xe3, 0xc5, 0xae, 0x76, 0x96, 0x5d, 0x23, 0x69,
+	0x44, 0x73, 0x82, 0x68, 0x93, 0x83, 0xa3, 0x60, 0xa8, 0x91, 0x5e, 0xc2, 0x43, 0xd8, 0xe0, 0xc7,
+	0xda, 0xe1, 0x93, 0xf4, 0xfc, 0xf1, 0x50, 0x53, 0xe5, 0x04, 0x55, 0xa1, 0x8e, 0x6f, 0x34, 0x53,
+	0x99, 0xb3, 0xba, 0x75, 0xa2, 0x49, 0x0e, 0x60, 0xbd, 0xee, 0xaa, 0xf2, 0xc1, 0x6a, 0x61, 0xc0,
+	0x98, 0xc7, 0x1d, 0xb5, 0xd2, 0x0c, 0x29, 0x93, 0xf6, 0x78, 0x20, 0xec, 0x51, 0xa8, 0xbb, 0x22,
+	0xaf, 0x5e, 0x44, 0x98, 0x03, 0x0e, 0x11, 0x86, 0x79, 0x06, 0x85, 0x48, 0x11, 0x87, 0xf0, 0x26,
+	0x3e, 0x6e, 0xcf, 0x79, 0x21, 0xbf, 0xa4, 0x74, 0x38, 0x12, 0xf3, 0x1d, 0xa3, 0x7e, 0x04, 0xc5,
+	0xee, 0x00, 0xb1, 0xf8, 0xaf, 0xdf, 0xbe, 0x43, 0xb9, 0x3e, 0x84, 0xd6, 0x02, 0xcf, 0xc9, 0x2f,
+	0x08, 0x8e, 0x8d, 0x20, 0x19, 0x25, 0xfb, 0x12, 0x77, 0xa1, 0x61, 0xe8, 0x0d, 0x78, 0x18, 0x29,
+	0x12, 0xd3, 0x00, 0x09, 0xe9, 0x79, 0xa5, 0x41, 0xb4, 0xf6, 0xee, 0x5f, 0xb3, 0x90, 0x3d, 0x51,
+	0xe9, 0x76, 0xff, 0xac, 0x8c, 0x7e, 0x6d, 0xc0, 0xc3, 0x94, 0x9f, 0x68, 0xd0, 0xdb, 0x23, 0xfe,
+	0xa2, 0x23, 0xd2, 0x6e, 0x61, 0xef, 0x4e, 0xbf, 0x03, 0xc5, 0x95, 0x88, 0xd7, 0x94, 0x5b, 0x28,
+	0x91, 0xf2, 0xce, 0x54, 0xd8, 0x1b, 0x51, 0x4a, 0x29, 0xd1, 0x82, 0x07, 0x5d, 0x8f, 0x5a, 0xe8,
+	0xcd, 0xfe, 0x4c, 0xe9, 0xef, 0xac, 0x85, 0x9d, 0x11, 0x24, 0x12, 0xeb, 0x26, 0xf6, 0x3d, 0x78,
+	0xdd, 0xb4, 0x3d, 0xef, 0x8c, 0x20, 0xa1, 0xd6, 0x6d, 0xc0, 0x5c, 0xe2, 0x71, 0x00, 0x95, 0xfa,
+	0x73, 0xa4, 0xbd, 0x73, 0x14, 0xb6, 0x6f, 0x8d, 0x57, 0x2b, 0xfe, 0xc1, 0x80, 0xe5, 0xbe, 0x57,
+	0x60, 0xf4, 0xac, 0x3f, 0xdd, 0xb0, 0x6b, 0x7d, 0xe1, 0xbd, 0x3b, 0xc9, 0x2a, 0xb5, 0x7e, 0x67,
+	0xc0, 0x4b, 0xa9, 0x97, 0x52, 0xf4, 0x4e, 0x7f, 0xda, 0x41, 0x97, 0xf4, 0xc2, 0x8f, 0x46, 0x96,
+	0x53, 0xaa, 0xb4, 0x61, 0xbe, 0xbb, 0x27, 0x40, 0x3b, 0xa3, 0xf4, 0x4a, 0x72, 0xfd, 0x3b, 0xb4,
+	0x57, 0xe8, 0x2b, 0x03, 0x96, 0xd2, 0xaf, 0x2e, 0x68, 0xc0, 0x76, 0x06, 0x5e, 0xb1, 0x0a, 0x4f,
+	0x47, 0x17, 0x54, 0xda, 0xfc, 0xc6, 0x80, 0xc5, 0xb4, 0x46, 0x19, 0xed, 0x8d, 0xda, 0x58, 0x4b,
+	0x4d, 0xde, 0xb9, 0x5b, 0x3f, 0x2e, 0xac, 0x92, 0xde, 0xa5, 0x0d, 0xb2, 0xca, 0xc0, 0xde, 0xb4,
+	0xf0, 0x74, 0x74, 0x41, 0xa9, 0xcd, 0xc1, 0x07, 0x5f, 0x7f, 0xb3, 0x6e, 0xfc, 0xfd, 0x9b, 0x75,
+	0xe3, 0x5f, 0xdf, 0xac, 0x1b, 0x3f, 0x7f, 0xf7, 0xd2, 0x65, 0xb5, 0x66, 0xa5, 0x64, 0x07, 0xf5,
+	0xed, 0xc4, 0xff, 0x12, 0x95, 0x2e, 0x89, 0x2f, 0xff, 0xb3, 0x2a, 0xfe, 0xcf, 0x5d, 0xef, 0xe9,
+	0xbf, 0x5b, 0x3b, 0x95, 0x29, 0x31, 0xfb, 0xd6, 0xff, 0x06, 0x00, 0xf1, 0x09, 0x3d, 0xaf, 0x0a,
+	0x26, 0x00, 0x00,
+}
+
+func (m *PollForDecisionTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollForDecisionTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollForDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ForwardedFrom) > 0 {
+		i -= len(m.ForwardedFrom)
+		copy(dAtA[i:], m.ForwardedFrom)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ForwardedFrom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PollerId) > 0 {
+		i -= len(m.PollerId)
+		copy(dAtA[i:], m.PollerId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.PollerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PollForDecisionTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PollForDecisionTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PollForDecisionTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
@@ -2176,6 +2638,45 @@ func (m *AddDecisionTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ShadowMetadataOnly {
+		i--
+		if m.ShadowMetadataOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ShadowSourceTaskList) > 0 {
+		i -= len(m.ShadowSourceTaskList)
+		copy(dAtA[i:], m.ShadowSourceTaskList)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ShadowSourceTaskList)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.AffinityKey) > 0 {
+		i -= len(m.AffinityKey)
+		copy(dAtA[i:], m.AffinityKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.AffinityKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Ephemeral {
+		i--
+		if m.Ephemeral {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ForwardedFrom) > 0 {
 		i -= len(m.ForwardedFrom)
 		copy(dAtA[i:], m.ForwardedFrom)
@@ -2290,6 +2791,45 @@ func (m *AddActivityTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ShadowMetadataOnly {
+		i--
+		if m.ShadowMetadataOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ShadowSourceTaskList) > 0 {
+		i -= len(m.ShadowSourceTaskList)
+		copy(dAtA[i:], m.ShadowSourceTaskList)
+		i = encodeVarintService(dAtA, i, uint64(len(m.ShadowSourceTaskList)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AffinityKey) > 0 {
+		i -= len(m.AffinityKey)
+		copy(dAtA[i:], m.AffinityKey)
+		i = encodeVarintService(dAtA, i, uint64(len(m.AffinityKey)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Ephemeral {
+		i--
+		if m.Ephemeral {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.ForwardedFrom) > 0 {
 		i -= len(m.ForwardedFrom)
 		copy(dAtA[i:], m.ForwardedFrom)
@@ -2750,6 +3290,23 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.OutstandingPollCount != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.OutstandingPollCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MatchStats != nil {
+		{
+			size, err := m.MatchStats.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TaskListStatus != nil {
 		{
 			size, err := m.TaskListStatus.MarshalToSizedBuffer(dAtA[:i])
@@ -2779,7 +3336,54 @@ func (m *DescribeTaskListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *ListTaskListPartitionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *TaskListMatchStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskListMatchStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskListMatchStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Expired != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Expired))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Forwarded != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.Forwarded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BufferedMatched != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.BufferedMatched))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SyncMatched != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.SyncMatched))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListTaskListPartitionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2993,6 +3597,190 @@ func (m *GetTaskListsByDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *DescribeTaskListConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.TaskListType != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.TaskListType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TaskList != nil {
+		{
+			size, err := m.TaskList.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintService(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainId) > 0 {
+		i -= len(m.DomainId)
+		copy(dAtA[i:], m.DomainId)
+		i = encodeVarintService(dAtA, i, uint64(len(m.DomainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DescribeTaskListConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DescribeTaskListConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DescribeTaskListConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxTaskBatchSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxTaskBatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.OutstandingTaskAppendsThreshold != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.OutstandingTaskAppendsThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.MaxTaskDeleteBatchSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxTaskDeleteBatchSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MinTaskThrottlingBurstSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MinTaskThrottlingBurstSize))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxTaskListIdleTimeSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.MaxTaskListIdleTimeSeconds))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.IdleTaskListCheckIntervalSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.IdleTaskListCheckIntervalSeconds))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.UpdateAckIntervalSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.UpdateAckIntervalSeconds))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GetTasksBatchSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.GetTasksBatchSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RangeSize != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.RangeSize))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LongPollExpirationIntervalSeconds != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.LongPollExpirationIntervalSeconds))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EnableEphemeralTaskList {
+		i--
+		if m.EnableEphemeralTaskList {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EnableSyncMatch {
+		i--
+		if m.EnableSyncMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ForwarderMaxChildrenPerNode != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ForwarderMaxChildrenPerNode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ForwarderMaxRatePerSecond != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ForwarderMaxRatePerSecond))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ForwarderMaxOutstandingTasks != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ForwarderMaxOutstandingTasks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ForwarderMaxOutstandingPolls != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.ForwarderMaxOutstandingPolls))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumWritePartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumWritePartitions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumReadPartitions != 0 {
+		i = encodeVarintService(dAtA, i, uint64(m.NumReadPartitions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintService(dAtA []byte, offset int, v uint64) int {
 	offset -= sovService(v)
 	base := offset
@@ -3250,6 +4038,23 @@ func (m *AddDecisionTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.Ephemeral {
+		n += 2
+	}
+	l = len(m.AffinityKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	l = len(m.ShadowSourceTaskList)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ShadowMetadataOnly {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3304,6 +4109,23 @@ func (m *AddActivityTaskRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.Ephemeral {
+		n += 2
+	}
+	l = len(m.AffinityKey)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovService(uint64(m.Attempt))
+	}
+	l = len(m.ShadowSourceTaskList)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.ShadowMetadataOnly {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3485,6 +4307,37 @@ func (m *DescribeTaskListResponse) Size() (n int) {
 		l = m.TaskListStatus.Size()
 		n += 1 + l + sovService(uint64(l))
 	}
+	if m.MatchStats != nil {
+		l = m.MatchStats.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.OutstandingPollCount != 0 {
+		n += 1 + sovService(uint64(m.OutstandingPollCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskListMatchStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SyncMatched != 0 {
+		n += 1 + sovService(uint64(m.SyncMatched))
+	}
+	if m.BufferedMatched != 0 {
+		n += 1 + sovService(uint64(m.BufferedMatched))
+	}
+	if m.Forwarded != 0 {
+		n += 1 + sovService(uint64(m.Forwarded))
+	}
+	if m.Expired != 0 {
+		n += 1 + sovService(uint64(m.Expired))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3589,31 +4442,120 @@ func (m *GetTaskListsByDomainResponse) Size() (n int) {
 	return n
 }
 
-func sovService(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozService(x uint64) (n int) {
-	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *DescribeTaskListConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainId)
+	if l > 0 {
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskList != nil {
+		l = m.TaskList.Size()
+		n += 1 + l + sovService(uint64(l))
+	}
+	if m.TaskListType != 0 {
+		n += 1 + sovService(uint64(m.TaskListType))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
+
+func (m *DescribeTaskListConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumReadPartitions != 0 {
+		n += 1 + sovService(uint64(m.NumReadPartitions))
+	}
+	if m.NumWritePartitions != 0 {
+		n += 1 + sovService(uint64(m.NumWritePartitions))
+	}
+	if m.ForwarderMaxOutstandingPolls != 0 {
+		n += 1 + sovService(uint64(m.ForwarderMaxOutstandingPolls))
+	}
+	if m.ForwarderMaxOutstandingTasks != 0 {
+		n += 1 + sovService(uint64(m.ForwarderMaxOutstandingTasks))
+	}
+	if m.ForwarderMaxRatePerSecond != 0 {
+		n += 1 + sovService(uint64(m.ForwarderMaxRatePerSecond))
+	}
+	if m.ForwarderMaxChildrenPerNode != 0 {
+		n += 1 + sovService(uint64(m.ForwarderMaxChildrenPerNode))
+	}
+	if m.EnableSyncMatch {
+		n += 2
+	}
+	if m.EnableEphemeralTaskList {
+		n += 2
+	}
+	if m.LongPollExpirationIntervalSeconds != 0 {
+		n += 1 + sovService(uint64(m.LongPollExpirationIntervalSeconds))
+	}
+	if m.RangeSize != 0 {
+		n += 1 + sovService(uint64(m.RangeSize))
+	}
+	if m.GetTasksBatchSize != 0 {
+		n += 1 + sovService(uint64(m.GetTasksBatchSize))
+	}
+	if m.UpdateAckIntervalSeconds != 0 {
+		n += 1 + sovService(uint64(m.UpdateAckIntervalSeconds))
+	}
+	if m.IdleTaskListCheckIntervalSeconds != 0 {
+		n += 1 + sovService(uint64(m.IdleTaskListCheckIntervalSeconds))
+	}
+	if m.MaxTaskListIdleTimeSeconds != 0 {
+		n += 1 + sovService(uint64(m.MaxTaskListIdleTimeSeconds))
+	}
+	if m.MinTaskThrottlingBurstSize != 0 {
+		n += 1 + sovService(uint64(m.MinTaskThrottlingBurstSize))
+	}
+	if m.MaxTaskDeleteBatchSize != 0 {
+		n += 2 + sovService(uint64(m.MaxTaskDeleteBatchSize))
+	}
+	if m.OutstandingTaskAppendsThreshold != 0 {
+		n += 2 + sovService(uint64(m.OutstandingTaskAppendsThreshold))
+	}
+	if m.MaxTaskBatchSize != 0 {
+		n += 2 + sovService(uint64(m.MaxTaskBatchSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovService(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozService(x uint64) (n int) {
+	return sovService(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PollForDecisionTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
@@ -5451,6 +6393,129 @@ func (m *AddDecisionTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ephemeral", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ephemeral = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffinityKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AffinityKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShadowSourceTaskList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShadowSourceTaskList = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShadowMetadataOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShadowMetadataOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -5795,53 +6860,176 @@ func (m *AddActivityTaskRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ForwardedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipService(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ephemeral", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthService
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.Ephemeral = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AffinityKey", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowService
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.AffinityKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
-		}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShadowSourceTaskList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShadowSourceTaskList = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShadowMetadataOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ShadowMetadataOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddActivityTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddActivityTaskResponse: wiretype end group for non-group")
+		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: AddActivityTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
@@ -6855,6 +8043,61 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MatchStats == nil {
+				m.MatchStats = &TaskListMatchStats{}
+			}
+			if err := m.MatchStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingPollCount", wireType)
+			}
+			m.OutstandingPollCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutstandingPollCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -6877,7 +8120,7 @@ func (m *DescribeTaskListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
+func (m *TaskListMatchStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6900,17 +8143,17 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskListPartitionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskListMatchStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskListPartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskListMatchStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncMatched", wireType)
 			}
-			var stringLen uint64
+			m.SyncMatched = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6920,29 +8163,16 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SyncMatched |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Domain = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferedMatched", wireType)
 			}
-			var msglen int
+			m.BufferedMatched = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -6952,28 +8182,49 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BufferedMatched |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthService
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthService
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Forwarded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Forwarded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.TaskList == nil {
-				m.TaskList = &v1.TaskList{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expired", wireType)
 			}
-			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Expired = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expired |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipService(dAtA[iNdEx:])
@@ -6996,7 +8247,7 @@ func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
+func (m *ListTaskListPartitionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7019,17 +8270,17 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListTaskListPartitionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListTaskListPartitionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListTaskListPartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListTaskListPartitionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListPartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowService
@@ -7039,29 +8290,27 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthService
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthService
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivityTaskListPartitions = append(m.ActivityTaskListPartitions, &v1.TaskListPartitionMetadata{})
-			if err := m.ActivityTaskListPartitions[len(m.ActivityTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskListPartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7088,8 +8337,10 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DecisionTaskListPartitions = append(m.DecisionTaskListPartitions, &v1.TaskListPartitionMetadata{})
-			if err := m.DecisionTaskListPartitions[len(m.DecisionTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7115,7 +8366,126 @@ func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
+func (m *ListTaskListPartitionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListTaskListPartitionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListTaskListPartitionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivityTaskListPartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivityTaskListPartitions = append(m.ActivityTaskListPartitions, &v1.TaskListPartitionMetadata{})
+			if err := m.ActivityTaskListPartitions[len(m.ActivityTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTaskListPartitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DecisionTaskListPartitions = append(m.DecisionTaskListPartitions, &v1.TaskListPartitionMetadata{})
+			if err := m.DecisionTaskListPartitions[len(m.DecisionTaskListPartitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskListsByDomainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7507,6 +8877,539 @@ func (m *GetTaskListsByDomainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DescribeTaskListConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthService
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthService
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskList == nil {
+				m.TaskList = &v1.TaskList{}
+			}
+			if err := m.TaskList.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskListType", wireType)
+			}
+			m.TaskListType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskListType |= v1.TaskListType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DescribeTaskListConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DescribeTaskListConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DescribeTaskListConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumReadPartitions", wireType)
+			}
+			m.NumReadPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumReadPartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumWritePartitions", wireType)
+			}
+			m.NumWritePartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumWritePartitions |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderMaxOutstandingPolls", wireType)
+			}
+			m.ForwarderMaxOutstandingPolls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwarderMaxOutstandingPolls |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderMaxOutstandingTasks", wireType)
+			}
+			m.ForwarderMaxOutstandingTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwarderMaxOutstandingTasks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderMaxRatePerSecond", wireType)
+			}
+			m.ForwarderMaxRatePerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwarderMaxRatePerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwarderMaxChildrenPerNode", wireType)
+			}
+			m.ForwarderMaxChildrenPerNode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwarderMaxChildrenPerNode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableSyncMatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableSyncMatch = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEphemeralTaskList", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableEphemeralTaskList = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LongPollExpirationIntervalSeconds", wireType)
+			}
+			m.LongPollExpirationIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LongPollExpirationIntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangeSize", wireType)
+			}
+			m.RangeSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangeSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetTasksBatchSize", wireType)
+			}
+			m.GetTasksBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GetTasksBatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAckIntervalSeconds", wireType)
+			}
+			m.UpdateAckIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateAckIntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleTaskListCheckIntervalSeconds", wireType)
+			}
+			m.IdleTaskListCheckIntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IdleTaskListCheckIntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskListIdleTimeSeconds", wireType)
+			}
+			m.MaxTaskListIdleTimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskListIdleTimeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskThrottlingBurstSize", wireType)
+			}
+			m.MinTaskThrottlingBurstSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTaskThrottlingBurstSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskDeleteBatchSize", wireType)
+			}
+			m.MaxTaskDeleteBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskDeleteBatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingTaskAppendsThreshold", wireType)
+			}
+			m.OutstandingTaskAppendsThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OutstandingTaskAppendsThreshold |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTaskBatchSize", wireType)
+			}
+			m.MaxTaskBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowService
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTaskBatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
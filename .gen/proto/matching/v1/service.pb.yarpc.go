@@ -54,6 +54,7 @@ type MatchingAPIYARPCClient interface {
 	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest, ...yarpc.CallOption) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest, ...yarpc.CallOption) (*GetTaskListsByDomainResponse, error)
+	DescribeTaskListConfig(context.Context, *DescribeTaskListConfigRequest, ...yarpc.CallOption) (*DescribeTaskListConfigResponse, error)
 }
 
 func newMatchingAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) MatchingAPIYARPCClient {
@@ -84,6 +85,7 @@ type MatchingAPIYARPCServer interface {
 	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest) (*GetTaskListsByDomainResponse, error)
+	DescribeTaskListConfig(context.Context, *DescribeTaskListConfigRequest) (*DescribeTaskListConfigResponse, error)
 }
 
 type buildMatchingAPIYARPCProceduresParams struct {
@@ -197,6 +199,16 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "DescribeTaskListConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribeTaskListConfig,
+							NewRequest:  newMatchingAPIServiceDescribeTaskListConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -429,6 +441,18 @@ func (c *_MatchingAPIYARPCCaller) GetTaskListsByDomain(ctx context.Context, requ
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) DescribeTaskListConfig(ctx context.Context, request *DescribeTaskListConfigRequest, options ...yarpc.CallOption) (*DescribeTaskListConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskListConfig", request, newMatchingAPIServiceDescribeTaskListConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeTaskListConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceDescribeTaskListConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _MatchingAPIYARPCHandler struct {
 	server MatchingAPIYARPCServer
 }
@@ -593,6 +617,22 @@ func (h *_MatchingAPIYARPCHandler) GetTaskListsByDomain(ctx context.Context, req
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) DescribeTaskListConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeTaskListConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeTaskListConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceDescribeTaskListConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeTaskListConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newMatchingAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
 	return &PollForDecisionTaskRequest{}
 }
@@ -673,6 +713,14 @@ func newMatchingAPIServiceGetTaskListsByDomainYARPCResponse() proto.Message {
 	return &GetTaskListsByDomainResponse{}
 }
 
+func newMatchingAPIServiceDescribeTaskListConfigYARPCRequest() proto.Message {
+	return &DescribeTaskListConfigRequest{}
+}
+
+func newMatchingAPIServiceDescribeTaskListConfigYARPCResponse() proto.Message {
+	return &DescribeTaskListConfigResponse{}
+}
+
 var (
 	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest        = &PollForDecisionTaskRequest{}
 	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse       = &PollForDecisionTaskResponse{}
@@ -694,130 +742,176 @@ var (
 	emptyMatchingAPIServiceListTaskListPartitionsYARPCResponse    = &ListTaskListPartitionsResponse{}
 	emptyMatchingAPIServiceGetTaskListsByDomainYARPCRequest       = &GetTaskListsByDomainRequest{}
 	emptyMatchingAPIServiceGetTaskListsByDomainYARPCResponse      = &GetTaskListsByDomainResponse{}
+	emptyMatchingAPIServiceDescribeTaskListConfigYARPCRequest     = &DescribeTaskListConfigRequest{}
+	emptyMatchingAPIServiceDescribeTaskListConfigYARPCResponse    = &DescribeTaskListConfigResponse{}
 )
 
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5f, 0x6f, 0xdb, 0xd6,
-		0x15, 0x07, 0xfd, 0x4f, 0xd6, 0x91, 0xac, 0x38, 0x37, 0xad, 0x23, 0xcb, 0x71, 0xec, 0xa8, 0x6b,
-		0xe7, 0x0d, 0x1d, 0x35, 0xab, 0x4d, 0x96, 0x26, 0x18, 0x06, 0xc7, 0x8e, 0x1b, 0x01, 0xcb, 0x92,
-		0x32, 0x5a, 0x06, 0x0c, 0x43, 0x88, 0x2b, 0xf2, 0xda, 0xe2, 0x2c, 0x91, 0x0c, 0xef, 0x95, 0x5c,
-		0xed, 0x61, 0x0f, 0x43, 0x37, 0x0c, 0xe8, 0xeb, 0x3e, 0xc1, 0xd6, 0xc7, 0x7d, 0x90, 0x3d, 0xec,
-		0x1b, 0x0c, 0x7b, 0xdc, 0xf7, 0x18, 0xee, 0x1f, 0x52, 0xa2, 0x74, 0xa9, 0x3f, 0x76, 0xdb, 0xbc,
-		0x89, 0xf7, 0x9c, 0xf3, 0x3b, 0xff, 0xcf, 0x3d, 0xa4, 0xe0, 0xa3, 0x5e, 0x8b, 0x44, 0x35, 0x07,
-		0xbb, 0xc4, 0x77, 0x48, 0xad, 0x8b, 0x99, 0xd3, 0xf6, 0xfc, 0xf3, 0x5a, 0xff, 0xb0, 0x46, 0x49,
-		0xd4, 0xf7, 0x1c, 0x62, 0x86, 0x51, 0xc0, 0x02, 0x54, 0xe6, 0x7c, 0xa6, 0xe2, 0x33, 0x63, 0x3e,
-		0xb3, 0x7f, 0x58, 0xb9, 0x7b, 0x1e, 0x04, 0xe7, 0x1d, 0x52, 0x13, 0x7c, 0xad, 0xde, 0x59, 0xcd,
-		0xed, 0x45, 0x98, 0x79, 0x81, 0x2f, 0x25, 0x2b, 0x7b, 0xe3, 0x74, 0xe6, 0x75, 0x09, 0x65, 0xb8,
-		0x1b, 0x2a, 0x86, 0x09, 0x80, 0xcb, 0x08, 0x87, 0x21, 0x89, 0xa8, 0xa2, 0xef, 0xa7, 0x4c, 0xc4,
-		0xa1, 0xc7, 0xad, 0x73, 0x82, 0x6e, 0x77, 0xa8, 0x42, 0xc7, 0xf1, 0xb6, 0x47, 0xa2, 0x81, 0x62,
-		0xa8, 0xea, 0x18, 0x18, 0xa6, 0x17, 0x1d, 0x8f, 0x32, 0xc5, 0x73, 0xa0, 0xe3, 0x51, 0x41, 0xb0,
-		0x2f, 0x83, 0xe8, 0x82, 0x44, 0x8a, 0xf3, 0xc7, 0xb3, 0x38, 0xcf, 0x3a, 0xc1, 0xa5, 0xe2, 0xfd,
-		0x41, 0x8a, 0x97, 0xb6, 0x71, 0x44, 0x5c, 0xce, 0xde, 0xf6, 0x28, 0x0b, 0x12, 0xfb, 0x3e, 0xcc,
-		0xe0, 0x4a, 0x9b, 0x58, 0xfd, 0x97, 0x01, 0x95, 0x97, 0x41, 0xa7, 0x73, 0x1a, 0x44, 0x27, 0xc4,
-		0xf1, 0xa8, 0x17, 0xf8, 0x4d, 0x4c, 0x2f, 0x2c, 0xf2, 0xb6, 0x47, 0x28, 0x43, 0x0d, 0xc8, 0x45,
-		0xf2, 0x67, 0xd9, 0xd8, 0x37, 0x0e, 0x0a, 0xf5, 0x9a, 0x99, 0xca, 0x1a, 0x0e, 0x3d, 0xb3, 0x7f,
-		0x68, 0x66, 0x23, 0x58, 0xb1, 0x3c, 0xda, 0x81, 0xbc, 0x1b, 0x74, 0xb1, 0xe7, 0xdb, 0x9e, 0x5b,
-		0x5e, 0xda, 0x37, 0x0e, 0xf2, 0xd6, 0xba, 0x3c, 0x68, 0xb8, 0x9c, 0x18, 0x06, 0x9d, 0x0e, 0x89,
-		0x38, 0x71, 0x59, 0x12, 0xe5, 0x41, 0xc3, 0x45, 0x1f, 0x42, 0xe9, 0x2c, 0x88, 0x2e, 0x71, 0xe4,
-		0x12, 0xd7, 0x3e, 0x8b, 0x82, 0x6e, 0x79, 0x45, 0x70, 0x6c, 0x24, 0xa7, 0xa7, 0x51, 0xd0, 0xad,
-		0x7e, 0x95, 0x87, 0x1d, 0xad, 0x21, 0x34, 0x0c, 0x7c, 0x4a, 0xd0, 0x2e, 0x00, 0x77, 0xde, 0x66,
-		0xc1, 0x05, 0xf1, 0x85, 0x3b, 0x45, 0x2b, 0xcf, 0x4f, 0x9a, 0xfc, 0x00, 0xfd, 0x1a, 0x50, 0x1c,
-		0x68, 0x9b, 0x7c, 0x49, 0x9c, 0x1e, 0x2f, 0x38, 0x61, 0x68, 0xa1, 0xfe, 0x91, 0xd6, 0xeb, 0xdf,
-		0x28, 0xf6, 0xa7, 0x31, 0xb7, 0x75, 0xf3, 0x72, 0xfc, 0x08, 0x9d, 0xc2, 0x46, 0x02, 0xcb, 0x06,
-		0x21, 0x11, 0xde, 0x15, 0xea, 0xf7, 0xa6, 0x22, 0x36, 0x07, 0x21, 0xb1, 0x8a, 0x97, 0x23, 0x4f,
-		0xe8, 0x35, 0x6c, 0x87, 0x11, 0xe9, 0x7b, 0x41, 0x8f, 0xda, 0x94, 0xe1, 0x88, 0x11, 0xd7, 0x26,
-		0x7d, 0xe2, 0x33, 0x1e, 0xb1, 0x15, 0x81, 0xb9, 0x63, 0xca, 0xb2, 0x37, 0xe3, 0xb2, 0x37, 0x1b,
-		0x3e, 0x7b, 0xf0, 0xe9, 0x6b, 0xdc, 0xe9, 0x11, 0x6b, 0x2b, 0x96, 0x7e, 0x25, 0x85, 0x9f, 0x72,
-		0xd9, 0x86, 0x8b, 0x0e, 0x60, 0x73, 0x02, 0x6e, 0x75, 0xdf, 0x38, 0x58, 0xb6, 0x4a, 0x34, 0xcd,
-		0x59, 0x86, 0x1c, 0x66, 0x8c, 0x74, 0x43, 0x56, 0x5e, 0xdb, 0x37, 0x0e, 0x56, 0xad, 0xf8, 0x11,
-		0x55, 0x61, 0xc3, 0x27, 0x5f, 0xb2, 0x21, 0x40, 0x4e, 0x00, 0x14, 0xf8, 0x61, 0x2c, 0xfd, 0x31,
-		0xa0, 0x16, 0x76, 0x2e, 0x3a, 0xc1, 0xb9, 0xed, 0x04, 0x3d, 0x9f, 0xd9, 0x6d, 0xcf, 0x67, 0xe5,
-		0x75, 0xc1, 0xb8, 0xa9, 0x28, 0xc7, 0x9c, 0xf0, 0xcc, 0xf3, 0x19, 0x7a, 0x08, 0x65, 0xca, 0x3c,
-		0xe7, 0x62, 0x30, 0x4c, 0x85, 0x4d, 0x7c, 0xdc, 0xea, 0x10, 0xb7, 0x9c, 0xdf, 0x37, 0x0e, 0xd6,
-		0xad, 0x2d, 0x49, 0x4f, 0x02, 0xfd, 0x54, 0x52, 0xd1, 0x43, 0x58, 0x15, 0x6d, 0x5a, 0x06, 0x11,
-		0x93, 0xea, 0xd4, 0x38, 0x7f, 0xc1, 0x39, 0x2d, 0x29, 0x80, 0x2c, 0xd8, 0x70, 0x55, 0xdd, 0xd8,
-		0x9e, 0x7f, 0x16, 0x94, 0x0b, 0x02, 0xe1, 0x27, 0x69, 0x04, 0xd9, 0x49, 0x1c, 0xa4, 0x19, 0x61,
-		0x9f, 0x7a, 0xc4, 0x67, 0x71, 0xb5, 0x35, 0xfc, 0xb3, 0xc0, 0x2a, 0xba, 0x23, 0x4f, 0xe8, 0x0d,
-		0xdc, 0x99, 0x2c, 0x2a, 0x5b, 0x94, 0x21, 0x6f, 0xc2, 0x72, 0x51, 0xa8, 0xd8, 0xd5, 0x1a, 0xc9,
-		0x8b, 0xf7, 0x97, 0x1e, 0x65, 0xd6, 0xf6, 0x44, 0x55, 0xc5, 0x24, 0x64, 0xc2, 0x2d, 0x19, 0x74,
-		0xde, 0xfa, 0xc4, 0xee, 0x93, 0x88, 0xab, 0x2e, 0x6f, 0x88, 0xfc, 0xdc, 0x14, 0xa4, 0x57, 0x9c,
-		0xf2, 0x5a, 0x12, 0xd0, 0x3d, 0x28, 0xb6, 0x22, 0xec, 0x3b, 0x6d, 0xd5, 0x05, 0x25, 0xd1, 0x05,
-		0x05, 0x79, 0x26, 0xfb, 0xe0, 0x08, 0x4a, 0xd4, 0x69, 0x13, 0xb7, 0xd7, 0x21, 0xae, 0xcd, 0x07,
-		0x6b, 0xf9, 0x86, 0x30, 0xb2, 0x32, 0x51, 0x5d, 0xcd, 0x78, 0xea, 0x5a, 0x1b, 0x89, 0x04, 0x3f,
-		0x43, 0x3f, 0x87, 0x62, 0x5c, 0x53, 0x02, 0x60, 0x73, 0x26, 0x40, 0x41, 0xf1, 0x0b, 0xf1, 0xdf,
-		0x41, 0x8e, 0x67, 0xc4, 0x23, 0xb4, 0x7c, 0x73, 0x7f, 0xf9, 0xa0, 0x50, 0x7f, 0x62, 0x66, 0x5d,
-		0x15, 0xe6, 0x94, 0x86, 0x37, 0xbf, 0x90, 0x20, 0x4f, 0x7d, 0x16, 0x0d, 0xac, 0x18, 0xb2, 0xf2,
-		0x06, 0x8a, 0xa3, 0x04, 0xb4, 0x09, 0xcb, 0x17, 0x64, 0x20, 0xe6, 0x41, 0xde, 0xe2, 0x3f, 0x79,
-		0x09, 0xf5, 0x79, 0xcf, 0xa8, 0xe6, 0x9f, 0xab, 0x84, 0x84, 0xc0, 0xa3, 0xa5, 0x87, 0xc6, 0xe8,
-		0x44, 0x3d, 0x72, 0x98, 0xd7, 0xf7, 0xd8, 0xe0, 0xea, 0x13, 0x55, 0x83, 0xf0, 0x3d, 0x4e, 0xd4,
-		0xaf, 0xd7, 0x93, 0x89, 0x9a, 0x36, 0xe4, 0x9d, 0x4e, 0xd4, 0x3d, 0x28, 0x60, 0x65, 0xcd, 0xd0,
-		0x37, 0x88, 0x8f, 0x1a, 0x2e, 0x1f, 0xb9, 0x09, 0x83, 0x18, 0xb9, 0x2b, 0x53, 0x46, 0x6e, 0xe2,
-		0x98, 0x18, 0xb9, 0x78, 0xe4, 0x09, 0xd5, 0x61, 0xd5, 0xf3, 0xc3, 0x1e, 0x13, 0xf3, 0xb0, 0x50,
-		0xbf, 0xa3, 0x4f, 0x14, 0x1e, 0x74, 0x02, 0xec, 0x5a, 0x92, 0x55, 0xd3, 0x3d, 0x6b, 0xd7, 0xed,
-		0x9e, 0xdc, 0x62, 0xdd, 0xd3, 0x84, 0xed, 0x18, 0xcf, 0x66, 0x81, 0xed, 0x74, 0x02, 0x4a, 0x04,
-		0x50, 0xd0, 0x93, 0xf3, 0xb6, 0x50, 0xdf, 0x9e, 0xc0, 0x3a, 0x51, 0x0b, 0x96, 0xb5, 0x15, 0xcb,
-		0x36, 0x83, 0x63, 0x2e, 0xd9, 0x94, 0x82, 0xe8, 0x57, 0xb0, 0x25, 0x94, 0x4c, 0x42, 0xe6, 0x67,
-		0x41, 0xde, 0x12, 0x82, 0x63, 0x78, 0xa7, 0x70, 0xb3, 0x4d, 0x70, 0xc4, 0x5a, 0x04, 0xb3, 0x04,
-		0x0a, 0x66, 0x41, 0x6d, 0x26, 0x32, 0x31, 0xce, 0xc8, 0xa5, 0x54, 0x48, 0x5f, 0x4a, 0x6f, 0xe0,
-		0x6e, 0x3a, 0x13, 0x76, 0x70, 0x66, 0xb3, 0xb6, 0x47, 0xed, 0x58, 0xa0, 0x38, 0x33, 0xb0, 0x95,
-		0x54, 0x66, 0x5e, 0x9c, 0x35, 0xdb, 0x1e, 0x3d, 0x52, 0xf8, 0x8d, 0x51, 0x0f, 0x5c, 0xc2, 0xb0,
-		0xd7, 0xa1, 0x62, 0xf0, 0xce, 0xaa, 0x94, 0xa1, 0x13, 0x27, 0x52, 0x6a, 0x72, 0x47, 0x28, 0x5d,
-		0x6d, 0x47, 0xf8, 0x21, 0xdc, 0x48, 0x70, 0xe4, 0x20, 0x10, 0xb3, 0x3b, 0x6f, 0x95, 0xe2, 0xe3,
-		0x13, 0x71, 0x8a, 0x3e, 0x81, 0xb5, 0x36, 0xc1, 0x2e, 0x89, 0xd4, 0x68, 0xde, 0xd1, 0x6a, 0x7a,
-		0x26, 0x58, 0x2c, 0xc5, 0x5a, 0xfd, 0xfb, 0x32, 0x6c, 0x1d, 0xb9, 0xae, 0x6e, 0x4d, 0x4c, 0x4d,
-		0x22, 0x63, 0x6c, 0x12, 0x7d, 0x47, 0x63, 0xe0, 0x11, 0xe4, 0x87, 0xf7, 0xe8, 0xf2, 0x3c, 0xf7,
-		0xe8, 0x3a, 0x8b, 0xaf, 0xcd, 0x3d, 0x28, 0x24, 0x3d, 0xa2, 0xd6, 0xa7, 0x65, 0x0b, 0xe2, 0xa3,
-		0x86, 0x3b, 0xde, 0x44, 0xaa, 0xf4, 0x55, 0x99, 0xae, 0x2e, 0xd0, 0x44, 0x62, 0xdb, 0x8a, 0x8b,
-		0xf5, 0x11, 0xac, 0xd1, 0xa0, 0x17, 0x39, 0x72, 0x28, 0x94, 0xc6, 0x6f, 0x96, 0x91, 0xd5, 0x02,
-		0xd3, 0x8b, 0x57, 0x82, 0xd3, 0x52, 0x12, 0x9a, 0x91, 0x9d, 0xd3, 0x8d, 0xec, 0x6d, 0xb8, 0x3d,
-		0x91, 0x23, 0x39, 0xad, 0xab, 0xff, 0x96, 0xf9, 0xd3, 0x5d, 0x4a, 0xef, 0x22, 0x7f, 0x7c, 0xf1,
-		0x14, 0xae, 0xd9, 0x43, 0xd5, 0x72, 0x96, 0x97, 0xe4, 0xf9, 0x49, 0x6c, 0x40, 0x2a, 0xd3, 0x2b,
-		0xd7, 0xca, 0xf4, 0xea, 0x62, 0x99, 0x5e, 0xbb, 0x7e, 0xa6, 0x73, 0xdf, 0x42, 0xa6, 0xd7, 0xb3,
-		0x33, 0xad, 0xbb, 0x97, 0xab, 0xff, 0x31, 0xe0, 0x3d, 0xb1, 0x97, 0xc4, 0x89, 0x88, 0xf3, 0x7c,
-		0x3c, 0xbe, 0x7c, 0xfc, 0x48, 0x1b, 0x47, 0x9d, 0xec, 0x9c, 0x6b, 0xc7, 0x75, 0xba, 0x72, 0xce,
-		0xad, 0xe4, 0x1f, 0x06, 0xbc, 0x3f, 0x66, 0xa1, 0xda, 0x47, 0x7e, 0x01, 0x45, 0xb1, 0xca, 0xdb,
-		0x11, 0xa1, 0xbd, 0x4e, 0xec, 0xe3, 0xf4, 0x69, 0x5c, 0x10, 0x12, 0x96, 0x10, 0x40, 0x0d, 0x28,
-		0xc5, 0x00, 0xbf, 0x27, 0x0e, 0x23, 0xee, 0xd4, 0x15, 0x50, 0xae, 0x7e, 0x8a, 0xd3, 0xda, 0x78,
-		0x3b, 0xfa, 0x58, 0xfd, 0x9f, 0x01, 0xfb, 0xd2, 0x30, 0x57, 0xf0, 0x71, 0x7f, 0x8f, 0x83, 0x6e,
-		0xd8, 0x21, 0x9c, 0x59, 0x85, 0xf2, 0xc5, 0x78, 0x3e, 0xee, 0x6b, 0x15, 0xcd, 0xc2, 0xf9, 0x1e,
-		0x72, 0x73, 0x1b, 0x72, 0x42, 0x56, 0x4d, 0xcb, 0xbc, 0xb5, 0xc6, 0x1f, 0x1b, 0x6e, 0xf5, 0x03,
-		0xb8, 0x37, 0xc5, 0x3c, 0x55, 0x90, 0xff, 0x35, 0xe0, 0xce, 0x31, 0xf6, 0x1d, 0xd2, 0x79, 0xd1,
-		0x63, 0x94, 0x61, 0xdf, 0xf5, 0xfc, 0x73, 0xbe, 0x59, 0xce, 0x35, 0x80, 0x52, 0xab, 0xec, 0xd2,
-		0xd8, 0x2a, 0xfb, 0x39, 0x94, 0x12, 0xa7, 0x86, 0x2f, 0xd8, 0xa5, 0x8c, 0xcb, 0x33, 0xf6, 0x4c,
-		0x5e, 0x9e, 0x6c, 0xe4, 0xe9, 0x3a, 0x53, 0xa6, 0xba, 0x07, 0xbb, 0x19, 0xee, 0xa9, 0x00, 0xfc,
-		0x11, 0x6e, 0x9f, 0x10, 0xea, 0x44, 0x5e, 0x8b, 0x24, 0xe2, 0xca, 0xf5, 0xd3, 0xf1, 0x1a, 0xf8,
-		0x58, 0xab, 0x35, 0x43, 0x7c, 0xbe, 0xd4, 0x57, 0xbf, 0x31, 0xa0, 0x3c, 0x89, 0xa0, 0xda, 0xe6,
-		0x33, 0xc8, 0xc9, 0x70, 0xd2, 0xb2, 0x21, 0xde, 0xb7, 0xf6, 0x32, 0x5f, 0x49, 0x48, 0x24, 0x5e,
-		0x72, 0x63, 0x7e, 0xf4, 0x1c, 0x36, 0x87, 0xd1, 0xa7, 0x0c, 0xb3, 0x1e, 0x55, 0x2d, 0xf3, 0xc1,
-		0xd4, 0xd8, 0xbd, 0x12, 0xac, 0x56, 0x89, 0xa5, 0x9e, 0xab, 0x14, 0x76, 0x45, 0x3e, 0xd4, 0xe9,
-		0x4b, 0x1c, 0x31, 0x8f, 0xcf, 0x59, 0x1a, 0x07, 0x6b, 0x0b, 0xd6, 0xd4, 0x62, 0x23, 0x8b, 0x44,
-		0x3d, 0xa5, 0x93, 0xb7, 0xb4, 0x58, 0xf2, 0xfe, 0xb2, 0x04, 0x77, 0xb3, 0xb4, 0xaa, 0x08, 0xbd,
-		0x85, 0xdd, 0xe1, 0x1b, 0x45, 0xe2, 0x6f, 0x98, 0x30, 0xaa, 0xb8, 0x99, 0x53, 0x55, 0x26, 0xb8,
-		0xcf, 0x09, 0xc3, 0x2e, 0x66, 0xd8, 0xaa, 0xe0, 0x91, 0xe9, 0x9d, 0x56, 0xcd, 0x55, 0x26, 0x5f,
-		0x23, 0xb4, 0x2a, 0x97, 0xae, 0xa6, 0xd2, 0x1d, 0x59, 0x0d, 0xd2, 0x2a, 0xab, 0xf7, 0x61, 0xe7,
-		0x73, 0x92, 0x84, 0x81, 0x3e, 0x19, 0xc8, 0x1b, 0x78, 0x46, 0xec, 0xab, 0xdf, 0xac, 0xc0, 0x1d,
-		0xbd, 0x9c, 0x8a, 0xde, 0x57, 0x06, 0x6c, 0x69, 0x7c, 0xe9, 0xe2, 0x50, 0xc5, 0xed, 0x45, 0xf6,
-		0xfb, 0xfd, 0x34, 0x60, 0xf3, 0x64, 0xcc, 0x97, 0xe7, 0x38, 0x94, 0x2f, 0xfb, 0xb7, 0xdc, 0x49,
-		0x8a, 0x30, 0x43, 0x93, 0x45, 0x6e, 0xc6, 0xd2, 0xb5, 0xcc, 0x38, 0x1a, 0xcb, 0xe2, 0xd0, 0x0c,
-		0x3c, 0x49, 0xa9, 0xfc, 0x81, 0x77, 0xa2, 0xde, 0x6e, 0xcd, 0xb7, 0x88, 0x67, 0xe9, 0x6f, 0x11,
-		0xf5, 0x6c, 0x13, 0xb3, 0xda, 0x7b, 0xe4, 0xdb, 0x04, 0xd7, 0x9d, 0x65, 0xec, 0x77, 0xad, 0xbb,
-		0xfe, 0x4f, 0x80, 0xc2, 0x73, 0x25, 0x73, 0xf4, 0xb2, 0x81, 0xfe, 0x64, 0xc0, 0x2d, 0xcd, 0xd7,
-		0x1b, 0xf4, 0xe9, 0x82, 0x1f, 0x7b, 0x44, 0x71, 0x56, 0xee, 0x5f, 0xe9, 0x13, 0xd1, 0xa8, 0x11,
-		0xa3, 0x81, 0x99, 0xc3, 0x08, 0xcd, 0x1a, 0x3d, 0x87, 0x11, 0xda, 0xcf, 0x28, 0x7d, 0xb8, 0x31,
-		0xb6, 0xb3, 0xa3, 0x9f, 0x66, 0x23, 0xe9, 0x5f, 0xc1, 0x2a, 0x87, 0x0b, 0x48, 0xa4, 0xf4, 0xa6,
-		0xfc, 0x9e, 0xae, 0x57, 0xe7, 0xf3, 0xe1, 0x02, 0x12, 0x4a, 0x6f, 0x08, 0x1b, 0xa9, 0xfd, 0x0d,
-		0x99, 0xd9, 0x18, 0xba, 0x55, 0xb4, 0x52, 0x9b, 0x9b, 0x5f, 0x69, 0xfc, 0x9b, 0x01, 0xdb, 0x99,
-		0x5b, 0x0a, 0x7a, 0x94, 0x0d, 0x37, 0x6b, 0xf3, 0xaa, 0x3c, 0xbe, 0x92, 0xac, 0x32, 0xeb, 0xaf,
-		0x06, 0xbc, 0xaf, 0xdd, 0x1b, 0xd0, 0x83, 0x6c, 0xd8, 0x69, 0x7b, 0x54, 0xe5, 0x67, 0x0b, 0xcb,
-		0x29, 0x53, 0x06, 0xb0, 0x39, 0xde, 0xc4, 0xe8, 0x70, 0x91, 0x86, 0x97, 0xfa, 0xaf, 0x30, 0x23,
-		0xd0, 0xd7, 0x06, 0x6c, 0xe9, 0xef, 0x5f, 0x34, 0xc5, 0x9d, 0xa9, 0x7b, 0x42, 0xe5, 0xe1, 0xe2,
-		0x82, 0xca, 0x9a, 0x3f, 0x1b, 0xf0, 0x9e, 0x6e, 0xda, 0xa3, 0xfb, 0x8b, 0xde, 0x0e, 0xd2, 0x92,
-		0x07, 0x57, 0xbb, 0x54, 0x9e, 0x3c, 0xfe, 0xed, 0x67, 0xe7, 0x1e, 0x6b, 0xf7, 0x5a, 0xa6, 0x13,
-		0x74, 0x6b, 0xa9, 0x3f, 0xf3, 0xcc, 0x73, 0xe2, 0xcb, 0xbf, 0x36, 0x47, 0xff, 0x5d, 0x7d, 0x1c,
-		0xff, 0xee, 0x1f, 0xb6, 0xd6, 0x04, 0xf5, 0x93, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x3a, 0x72,
-		0x53, 0x2b, 0x8b, 0x1d, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4d, 0x53, 0x23, 0xc7,
+		0x19, 0x2e, 0xf1, 0xad, 0x57, 0x20, 0xa0, 0xc1, 0xac, 0x10, 0xb0, 0xb0, 0x72, 0xd6, 0xc1, 0x2e,
+		0x5b, 0x2c, 0x78, 0xd9, 0xac, 0x77, 0xcb, 0x95, 0xf0, 0xb5, 0x5e, 0x95, 0x83, 0xc1, 0x03, 0x59,
+		0x57, 0xa5, 0x52, 0x3b, 0xd5, 0x9a, 0x69, 0xa1, 0x09, 0xa3, 0x19, 0xed, 0x74, 0x4b, 0xa0, 0x3d,
+		0xe4, 0x90, 0x72, 0x52, 0xa9, 0xf2, 0x25, 0x87, 0x9c, 0x72, 0x4d, 0x2e, 0xf9, 0x0b, 0xb9, 0xe5,
+		0x96, 0xff, 0x90, 0xca, 0x31, 0xf9, 0x05, 0xf9, 0x01, 0xa9, 0xfe, 0x1a, 0xcd, 0x48, 0x23, 0x09,
+		0x81, 0x3f, 0x6e, 0x4c, 0xf7, 0xf3, 0x3e, 0xfd, 0xf6, 0xdb, 0xef, 0x57, 0xb7, 0x80, 0xf7, 0x1a,
+		0x65, 0x12, 0x6c, 0x59, 0xd8, 0x26, 0x9e, 0x45, 0xb6, 0x6a, 0x98, 0x59, 0x55, 0xc7, 0xbb, 0xd8,
+		0x6a, 0x6e, 0x6f, 0x51, 0x12, 0x34, 0x1d, 0x8b, 0x14, 0xeb, 0x81, 0xcf, 0x7c, 0x94, 0xe3, 0xb8,
+		0xa2, 0xc2, 0x15, 0x35, 0xae, 0xd8, 0xdc, 0xce, 0xdf, 0xbf, 0xf0, 0xfd, 0x0b, 0x97, 0x6c, 0x09,
+		0x5c, 0xb9, 0x51, 0xd9, 0xb2, 0x1b, 0x01, 0x66, 0x8e, 0xef, 0x49, 0xc9, 0xfc, 0x7a, 0xe7, 0x3c,
+		0x73, 0x6a, 0x84, 0x32, 0x5c, 0xab, 0x2b, 0x40, 0x17, 0xc1, 0x55, 0x80, 0xeb, 0x75, 0x12, 0x50,
+		0x35, 0xbf, 0x11, 0x53, 0x11, 0xd7, 0x1d, 0xae, 0x9d, 0xe5, 0xd7, 0x6a, 0xed, 0x25, 0x92, 0x10,
+		0x6f, 0x1a, 0x24, 0x68, 0x29, 0x40, 0x21, 0x09, 0xc0, 0x30, 0xbd, 0x74, 0x1d, 0xca, 0x14, 0x66,
+		0x33, 0x09, 0xa3, 0x8c, 0x60, 0x5e, 0xf9, 0xc1, 0x25, 0x09, 0x14, 0xf2, 0x83, 0x41, 0xc8, 0x8a,
+		0xeb, 0x5f, 0x29, 0xec, 0x8f, 0x62, 0x58, 0x5a, 0xc5, 0x01, 0xb1, 0x39, 0xbc, 0xea, 0x50, 0xe6,
+		0x87, 0xfa, 0x3d, 0xec, 0x81, 0x8a, 0xab, 0x58, 0xf8, 0x67, 0x0a, 0xf2, 0xa7, 0xbe, 0xeb, 0xbe,
+		0xf0, 0x83, 0x43, 0x62, 0x39, 0xd4, 0xf1, 0xbd, 0x73, 0x4c, 0x2f, 0x0d, 0xf2, 0xa6, 0x41, 0x28,
+		0x43, 0x25, 0x98, 0x0c, 0xe4, 0x9f, 0xb9, 0xd4, 0x46, 0x6a, 0x33, 0xb3, 0xb3, 0x55, 0x8c, 0x9d,
+		0x1a, 0xae, 0x3b, 0xc5, 0xe6, 0x76, 0xb1, 0x37, 0x83, 0xa1, 0xe5, 0xd1, 0x0a, 0xa4, 0x6d, 0xbf,
+		0x86, 0x1d, 0xcf, 0x74, 0xec, 0xdc, 0xc8, 0x46, 0x6a, 0x33, 0x6d, 0x4c, 0xc9, 0x81, 0x92, 0xcd,
+		0x27, 0xeb, 0xbe, 0xeb, 0x92, 0x80, 0x4f, 0x8e, 0xca, 0x49, 0x39, 0x50, 0xb2, 0xd1, 0x43, 0xc8,
+		0x56, 0xfc, 0xe0, 0x0a, 0x07, 0x36, 0xb1, 0xcd, 0x4a, 0xe0, 0xd7, 0x72, 0x63, 0x02, 0x31, 0x13,
+		0x8e, 0xbe, 0x08, 0xfc, 0x5a, 0xe1, 0xeb, 0x34, 0xac, 0x24, 0x2a, 0x42, 0xeb, 0xbe, 0x47, 0x09,
+		0x5a, 0x03, 0xe0, 0x9b, 0x37, 0x99, 0x7f, 0x49, 0x3c, 0xb1, 0x9d, 0x69, 0x23, 0xcd, 0x47, 0xce,
+		0xf9, 0x00, 0xfa, 0x05, 0x20, 0x6d, 0x68, 0x93, 0x5c, 0x13, 0xab, 0xc1, 0x1d, 0x4e, 0x28, 0x9a,
+		0xd9, 0x79, 0x2f, 0x71, 0xd7, 0x5f, 0x29, 0xf8, 0x91, 0x46, 0x1b, 0xf3, 0x57, 0x9d, 0x43, 0xe8,
+		0x05, 0xcc, 0x84, 0xb4, 0xac, 0x55, 0x27, 0x62, 0x77, 0x99, 0x9d, 0x07, 0x7d, 0x19, 0xcf, 0x5b,
+		0x75, 0x62, 0x4c, 0x5f, 0x45, 0xbe, 0xd0, 0x2b, 0x58, 0xae, 0x07, 0xa4, 0xe9, 0xf8, 0x0d, 0x6a,
+		0x52, 0x86, 0x03, 0x46, 0x6c, 0x93, 0x34, 0x89, 0xc7, 0xb8, 0xc5, 0xc6, 0x04, 0xe7, 0x4a, 0x51,
+		0xba, 0x7d, 0x51, 0xbb, 0x7d, 0xb1, 0xe4, 0xb1, 0x27, 0x8f, 0x5f, 0x61, 0xb7, 0x41, 0x8c, 0x25,
+		0x2d, 0x7d, 0x26, 0x85, 0x8f, 0xb8, 0x6c, 0xc9, 0x46, 0x9b, 0x30, 0xd7, 0x45, 0x37, 0xbe, 0x91,
+		0xda, 0x1c, 0x35, 0xb2, 0x34, 0x8e, 0xcc, 0xc1, 0x24, 0x66, 0x8c, 0xd4, 0xea, 0x2c, 0x37, 0xb1,
+		0x91, 0xda, 0x1c, 0x37, 0xf4, 0x27, 0x2a, 0xc0, 0x8c, 0x47, 0xae, 0x59, 0x9b, 0x60, 0x52, 0x10,
+		0x64, 0xf8, 0xa0, 0x96, 0xfe, 0x10, 0x50, 0x19, 0x5b, 0x97, 0xae, 0x7f, 0x61, 0x5a, 0x7e, 0xc3,
+		0x63, 0x66, 0xd5, 0xf1, 0x58, 0x6e, 0x4a, 0x00, 0xe7, 0xd4, 0xcc, 0x01, 0x9f, 0x78, 0xe9, 0x78,
+		0x0c, 0x3d, 0x85, 0x1c, 0x65, 0x8e, 0x75, 0xd9, 0x6a, 0x1f, 0x85, 0x49, 0x3c, 0x5c, 0x76, 0x89,
+		0x9d, 0x4b, 0x6f, 0xa4, 0x36, 0xa7, 0x8c, 0x25, 0x39, 0x1f, 0x1a, 0xfa, 0x48, 0xce, 0xa2, 0xa7,
+		0x30, 0x2e, 0xc2, 0x34, 0x07, 0xc2, 0x26, 0x85, 0xbe, 0x76, 0xfe, 0x92, 0x23, 0x0d, 0x29, 0x80,
+		0x0c, 0x98, 0xb1, 0x95, 0xdf, 0x98, 0x8e, 0x57, 0xf1, 0x73, 0x19, 0xc1, 0xf0, 0x51, 0x9c, 0x41,
+		0x46, 0x12, 0x27, 0x39, 0x0f, 0xb0, 0x47, 0x1d, 0xe2, 0x31, 0xed, 0x6d, 0x25, 0xaf, 0xe2, 0x1b,
+		0xd3, 0x76, 0xe4, 0x0b, 0xbd, 0x86, 0xd5, 0x6e, 0xa7, 0x32, 0x85, 0x1b, 0xf2, 0x20, 0xcc, 0x4d,
+		0x8b, 0x25, 0xd6, 0x12, 0x95, 0xe4, 0xce, 0xfb, 0x73, 0x87, 0x32, 0x63, 0xb9, 0xcb, 0xab, 0xf4,
+		0x14, 0x2a, 0xc2, 0x82, 0x34, 0x3a, 0x0f, 0x7d, 0x62, 0x36, 0x49, 0xc0, 0x97, 0xce, 0xcd, 0x88,
+		0xf3, 0x99, 0x17, 0x53, 0x67, 0x7c, 0xe6, 0x95, 0x9c, 0x40, 0x0f, 0x60, 0xba, 0x1c, 0x60, 0xcf,
+		0xaa, 0xaa, 0x28, 0xc8, 0x8a, 0x28, 0xc8, 0xc8, 0x31, 0x19, 0x07, 0x7b, 0x90, 0xa5, 0x56, 0x95,
+		0xd8, 0x0d, 0x97, 0xd8, 0x26, 0x4f, 0xac, 0xb9, 0x59, 0xa1, 0x64, 0xbe, 0xcb, 0xbb, 0xce, 0x75,
+		0xd6, 0x35, 0x66, 0x42, 0x09, 0x3e, 0x86, 0x3e, 0x85, 0x69, 0xed, 0x53, 0x82, 0x60, 0x6e, 0x20,
+		0x41, 0x46, 0xe1, 0x85, 0xf8, 0xaf, 0x60, 0x92, 0x9f, 0x88, 0x43, 0x68, 0x6e, 0x7e, 0x63, 0x74,
+		0x33, 0xb3, 0xb3, 0x5f, 0xec, 0x55, 0x2a, 0x8a, 0x7d, 0x02, 0xbe, 0xf8, 0xa5, 0x24, 0x39, 0xf2,
+		0x58, 0xd0, 0x32, 0x34, 0x65, 0xfe, 0x35, 0x4c, 0x47, 0x27, 0xd0, 0x1c, 0x8c, 0x5e, 0x92, 0x96,
+		0xc8, 0x07, 0x69, 0x83, 0xff, 0xc9, 0x5d, 0xa8, 0xc9, 0x63, 0x26, 0x37, 0x72, 0x73, 0x17, 0x12,
+		0x02, 0xcf, 0x46, 0x9e, 0xa6, 0xa2, 0x19, 0x75, 0xcf, 0x62, 0x4e, 0xd3, 0x61, 0xad, 0xdb, 0x67,
+		0xd4, 0x04, 0x86, 0xef, 0x31, 0xa3, 0x7e, 0x33, 0x05, 0x2b, 0x89, 0x8a, 0xfc, 0xa0, 0x19, 0x75,
+		0x1d, 0x32, 0x58, 0x69, 0xd3, 0xde, 0x1b, 0xe8, 0xa1, 0x92, 0xcd, 0x53, 0x6e, 0x08, 0x10, 0x29,
+		0x77, 0xac, 0x4f, 0xca, 0x0d, 0x37, 0x26, 0x52, 0x2e, 0x8e, 0x7c, 0xa1, 0x1d, 0x18, 0x77, 0xbc,
+		0x7a, 0x83, 0x89, 0x7c, 0x98, 0xd9, 0x59, 0x4d, 0x3e, 0x28, 0xdc, 0x72, 0x7d, 0x6c, 0x1b, 0x12,
+		0x9a, 0x10, 0x3d, 0x13, 0x77, 0x8d, 0x9e, 0xc9, 0xe1, 0xa2, 0xe7, 0x1c, 0x96, 0x35, 0x9f, 0xc9,
+		0x7c, 0xd3, 0x72, 0x7d, 0x4a, 0x04, 0x91, 0xdf, 0x90, 0xf9, 0x36, 0xb3, 0xb3, 0xdc, 0xc5, 0x75,
+		0xa8, 0x1a, 0x2c, 0x63, 0x49, 0xcb, 0x9e, 0xfb, 0x07, 0x5c, 0xf2, 0x5c, 0x0a, 0xa2, 0x2f, 0x60,
+		0x49, 0x2c, 0xd2, 0x4d, 0x99, 0x1e, 0x44, 0xb9, 0x20, 0x04, 0x3b, 0xf8, 0x5e, 0xc0, 0x7c, 0x95,
+		0xe0, 0x80, 0x95, 0x09, 0x66, 0x21, 0x15, 0x0c, 0xa2, 0x9a, 0x0b, 0x65, 0x34, 0x4f, 0xa4, 0x28,
+		0x65, 0xe2, 0x45, 0xe9, 0x35, 0xdc, 0x8f, 0x9f, 0x84, 0xe9, 0x57, 0x4c, 0x56, 0x75, 0xa8, 0xa9,
+		0x05, 0xa6, 0x07, 0x1a, 0x36, 0x1f, 0x3b, 0x99, 0x93, 0xca, 0x79, 0xd5, 0xa1, 0x7b, 0x8a, 0xbf,
+		0x14, 0xdd, 0x81, 0x4d, 0x18, 0x76, 0x5c, 0x9a, 0x9b, 0xb9, 0x81, 0xa7, 0xb4, 0x37, 0x71, 0x28,
+		0xa5, 0xba, 0x7b, 0x84, 0xec, 0xed, 0x7a, 0x84, 0x1f, 0xc3, 0x6c, 0xc8, 0x23, 0x13, 0x81, 0xc8,
+		0xdd, 0x69, 0x23, 0xab, 0x87, 0x0f, 0xc5, 0x28, 0xfa, 0x18, 0x26, 0xaa, 0x04, 0xdb, 0x24, 0x50,
+		0xa9, 0x79, 0x25, 0x71, 0xa5, 0x97, 0x02, 0x62, 0x28, 0x68, 0xe1, 0xbf, 0x63, 0xb0, 0xb4, 0x67,
+		0xdb, 0x49, 0x6d, 0x62, 0x2c, 0x13, 0xa5, 0x3a, 0x32, 0xd1, 0x77, 0x94, 0x06, 0x9e, 0x41, 0xba,
+		0x5d, 0x47, 0x47, 0x6f, 0x52, 0x47, 0xa7, 0x98, 0xfa, 0x8b, 0xa7, 0x90, 0x30, 0x46, 0x54, 0xfb,
+		0x34, 0x6a, 0x80, 0x1e, 0x2a, 0xd9, 0x9d, 0x41, 0xa4, 0x5c, 0x5f, 0xb9, 0xe9, 0xf8, 0x10, 0x41,
+		0x24, 0xba, 0x2d, 0xed, 0xac, 0xcf, 0x60, 0x82, 0xfa, 0x8d, 0xc0, 0x92, 0x49, 0x21, 0xbb, 0x53,
+		0xe8, 0xd9, 0x5a, 0x60, 0x7a, 0x79, 0x26, 0x90, 0x86, 0x92, 0x48, 0x48, 0xd9, 0x93, 0x09, 0x29,
+		0x1b, 0xad, 0x42, 0x9a, 0xd4, 0xab, 0xa4, 0x46, 0x02, 0xec, 0x8a, 0x68, 0x9f, 0x32, 0xda, 0x03,
+		0xbc, 0xfc, 0xe3, 0x4a, 0xc5, 0xf1, 0x78, 0x66, 0xe4, 0x45, 0x2f, 0x2d, 0x28, 0x32, 0x7a, 0xec,
+		0x73, 0xd2, 0x8a, 0x06, 0x14, 0x08, 0xb3, 0xe8, 0x4f, 0xb4, 0x0b, 0xf7, 0x68, 0x15, 0xdb, 0xfe,
+		0x95, 0x29, 0x55, 0x8a, 0xb4, 0x31, 0x19, 0xc1, 0xb3, 0x28, 0xa7, 0xa5, 0xe2, 0x61, 0x8b, 0xf2,
+		0x08, 0xd4, 0xb8, 0x59, 0x23, 0x0c, 0xdb, 0x98, 0x61, 0xd3, 0xf7, 0xdc, 0x96, 0x88, 0xbe, 0x29,
+		0x03, 0xc9, 0xb9, 0x63, 0x35, 0x75, 0xe2, 0xb9, 0xad, 0xc2, 0x32, 0xdc, 0xeb, 0xf2, 0x33, 0x59,
+		0x71, 0x0a, 0x7f, 0x1c, 0x17, 0x3e, 0x98, 0x54, 0x58, 0x7f, 0x08, 0x1f, 0xe4, 0xcd, 0xb3, 0xb4,
+		0x45, 0x7b, 0x69, 0x59, 0x8f, 0xb2, 0x72, 0xfc, 0x50, 0x2b, 0x10, 0xf3, 0xd6, 0xb1, 0x3b, 0x79,
+		0xeb, 0xf8, 0x70, 0xde, 0x3a, 0x71, 0x77, 0x6f, 0x9d, 0xfc, 0x16, 0xbc, 0x75, 0x6a, 0xa0, 0xb7,
+		0xa6, 0x07, 0x79, 0x2b, 0xf4, 0xf5, 0xd6, 0xcc, 0x8d, 0xbd, 0x75, 0xfa, 0x16, 0xde, 0x3a, 0x33,
+		0xc0, 0x5b, 0x93, 0xfa, 0xa3, 0xc2, 0xbf, 0x52, 0xb0, 0x28, 0xfa, 0x43, 0xed, 0x4c, 0xda, 0x57,
+		0x0f, 0x3a, 0x9b, 0xc0, 0xf7, 0x13, 0x7d, 0x21, 0x49, 0xf6, 0x86, 0xed, 0xdf, 0x5d, 0xb2, 0xe3,
+		0x0d, 0xbb, 0xc3, 0xbf, 0xa4, 0xe0, 0x9d, 0x0e, 0x0d, 0x55, 0x5f, 0xf8, 0x53, 0x98, 0x16, 0x57,
+		0x2a, 0x33, 0x20, 0xb4, 0xe1, 0xea, 0x3d, 0xf6, 0xaf, 0x8a, 0x19, 0x21, 0x61, 0x08, 0x01, 0x54,
+		0x82, 0xac, 0x26, 0xf8, 0x35, 0xb1, 0x18, 0xb1, 0xfb, 0xb6, 0xe2, 0xb2, 0x05, 0x57, 0x48, 0x63,
+		0xe6, 0x4d, 0xf4, 0xb3, 0xf0, 0x9f, 0x14, 0x6c, 0x48, 0xc5, 0x6c, 0x81, 0xe3, 0xfb, 0x3d, 0xf0,
+		0x6b, 0x75, 0x97, 0x70, 0xb0, 0x32, 0xe5, 0x49, 0xe7, 0x79, 0xec, 0x26, 0x2e, 0x34, 0x88, 0xe7,
+		0x7b, 0x38, 0x9b, 0x7b, 0x30, 0x29, 0x64, 0x55, 0xd5, 0x4a, 0x1b, 0x13, 0xfc, 0xb3, 0x64, 0x17,
+		0xde, 0x85, 0x07, 0x7d, 0xd4, 0x53, 0x0e, 0xf9, 0xef, 0x14, 0xac, 0x1e, 0x60, 0xcf, 0x22, 0xee,
+		0x49, 0x83, 0x51, 0x86, 0x3d, 0xdb, 0xf1, 0x2e, 0x78, 0x87, 0x7f, 0xa3, 0x24, 0x1a, 0xbb, 0x52,
+		0x8c, 0x74, 0x5c, 0x29, 0x3e, 0x83, 0x6c, 0xb8, 0xa9, 0xf6, 0x43, 0x47, 0xb6, 0x47, 0x13, 0xa3,
+		0x77, 0x26, 0x9b, 0x18, 0x16, 0xf9, 0xba, 0x4b, 0xa6, 0x2c, 0xac, 0xc3, 0x5a, 0x8f, 0xed, 0x29,
+		0x03, 0xfc, 0x06, 0xee, 0x1d, 0x12, 0x6a, 0x05, 0x4e, 0x39, 0x0c, 0x79, 0xbd, 0xf5, 0x17, 0x9d,
+		0x3e, 0xf0, 0x61, 0xe2, 0xaa, 0x3d, 0xc4, 0x6f, 0x76, 0xf4, 0x85, 0xbf, 0x8d, 0x40, 0xae, 0x9b,
+		0x41, 0x85, 0xcd, 0x27, 0x30, 0x29, 0xcd, 0x49, 0x73, 0x29, 0x71, 0xef, 0x5d, 0xef, 0x79, 0x35,
+		0x24, 0x81, 0x78, 0x6c, 0xd0, 0x78, 0x74, 0x0c, 0x73, 0x6d, 0xeb, 0x53, 0x86, 0x59, 0x83, 0xaa,
+		0x90, 0x79, 0xb7, 0xaf, 0xed, 0xce, 0x04, 0xd4, 0xc8, 0xb2, 0xd8, 0x37, 0x3a, 0x86, 0x8c, 0xb8,
+		0x64, 0x0b, 0x2a, 0x9a, 0x1b, 0x4d, 0xb2, 0x47, 0xf4, 0x16, 0xae, 0xe9, 0x8e, 0xf9, 0x18, 0xe7,
+		0xa0, 0x06, 0xd4, 0xc2, 0xbf, 0xd1, 0x63, 0x58, 0xf2, 0xdb, 0x07, 0x62, 0x72, 0xa5, 0xe5, 0x23,
+		0x90, 0xea, 0xbc, 0x16, 0xfd, 0xf8, 0x71, 0x89, 0x77, 0xa0, 0xc2, 0x9f, 0x53, 0x80, 0xba, 0x89,
+		0x79, 0x55, 0xa0, 0x2d, 0xcf, 0x32, 0x05, 0x3f, 0x91, 0x5e, 0x3a, 0x6a, 0x64, 0xf8, 0xd8, 0xb1,
+		0x1c, 0x42, 0xef, 0xc3, 0x5c, 0xb9, 0x51, 0xa9, 0x90, 0x80, 0xd8, 0x21, 0x6c, 0x44, 0xc0, 0x66,
+		0xf5, 0xb8, 0x86, 0xae, 0x42, 0x3a, 0xcc, 0x6a, 0x62, 0x9f, 0xa3, 0x46, 0x7b, 0x80, 0x97, 0x17,
+		0x72, 0x5d, 0x77, 0x02, 0xa2, 0x7b, 0x44, 0xfd, 0x59, 0xa0, 0xb0, 0x26, 0x3c, 0x56, 0xe9, 0x77,
+		0x8a, 0x03, 0xe6, 0xf0, 0x6a, 0x4a, 0xb5, 0x3b, 0x2d, 0xc1, 0x84, 0x6a, 0xc1, 0x65, 0x18, 0xa9,
+		0xaf, 0xb8, 0x7b, 0x8f, 0x0c, 0xe7, 0xde, 0xbf, 0x1f, 0x81, 0xfb, 0xbd, 0x56, 0x55, 0x3e, 0xf4,
+		0x06, 0xd6, 0xda, 0x77, 0xdf, 0xd0, 0x23, 0xea, 0x21, 0x50, 0x79, 0x56, 0xb1, 0xef, 0x92, 0x21,
+		0xaf, 0x2e, 0x74, 0x46, 0x1e, 0x47, 0xea, 0x5b, 0x7c, 0x69, 0xbe, 0x64, 0xf8, 0x6e, 0x96, 0xb8,
+		0xe4, 0xc8, 0xed, 0x96, 0xb4, 0x23, 0x0d, 0x60, 0x7c, 0xc9, 0xc2, 0x2e, 0xac, 0x7c, 0x46, 0x42,
+		0x33, 0xd0, 0xfd, 0x96, 0xec, 0xb3, 0x06, 0xd8, 0xbe, 0xf0, 0xd7, 0x31, 0x58, 0x4d, 0x96, 0x53,
+		0xd6, 0xfb, 0x3a, 0x05, 0x4b, 0x09, 0x7b, 0xa9, 0xe1, 0xba, 0xb2, 0xdb, 0x49, 0xef, 0x18, 0xe8,
+		0x47, 0x5c, 0x3c, 0xec, 0xd8, 0xcb, 0x31, 0xae, 0xcb, 0x67, 0xa9, 0x05, 0xbb, 0x7b, 0x46, 0xa8,
+		0x91, 0x70, 0x8a, 0x5c, 0x8d, 0x91, 0x3b, 0xa9, 0xb1, 0xd7, 0x71, 0x8a, 0x6d, 0x35, 0x70, 0xf7,
+		0x4c, 0xfe, 0x2d, 0xcf, 0x55, 0xc9, 0x7a, 0x27, 0xbc, 0x9a, 0xbd, 0x8c, 0xbf, 0x9a, 0xed, 0xf4,
+		0x56, 0xb1, 0x57, 0x02, 0x8c, 0xbc, 0xa2, 0xf1, 0xb5, 0x7b, 0x29, 0xfb, 0x5d, 0xaf, 0x5d, 0xf8,
+		0x47, 0x0a, 0xd6, 0x3a, 0x71, 0x07, 0xbe, 0x57, 0x71, 0x2e, 0x6e, 0x54, 0x26, 0xef, 0x10, 0xe1,
+		0xdf, 0x5a, 0x15, 0x2d, 0xfc, 0x6f, 0x0a, 0xee, 0xf7, 0xda, 0x83, 0x72, 0xf6, 0x22, 0x2c, 0x78,
+		0x8d, 0x9a, 0x19, 0x10, 0x6c, 0xc7, 0x13, 0x84, 0x78, 0x3b, 0xf6, 0x1a, 0x35, 0x83, 0x60, 0x3b,
+		0x12, 0xe7, 0x8f, 0x60, 0x91, 0xe3, 0xaf, 0x02, 0x87, 0x91, 0x78, 0x78, 0x73, 0x01, 0xe4, 0x35,
+		0x6a, 0x5f, 0xf1, 0xa9, 0x88, 0xc4, 0x11, 0xac, 0xeb, 0x5c, 0x1a, 0x98, 0x35, 0x7c, 0x6d, 0x76,
+		0x56, 0x01, 0x59, 0x5a, 0xc6, 0x8d, 0xd5, 0x10, 0x76, 0x8c, 0xaf, 0x3b, 0x6a, 0xf7, 0x00, 0x1a,
+		0xbe, 0x6b, 0x9a, 0x1b, 0xeb, 0x4b, 0xc3, 0x0d, 0x40, 0xd1, 0xcf, 0x60, 0x2d, 0x4e, 0x13, 0x60,
+		0xbe, 0x11, 0x12, 0x98, 0x94, 0x58, 0xbe, 0x27, 0x2f, 0x56, 0xe3, 0xc6, 0x72, 0x94, 0xc4, 0xc0,
+		0x8c, 0x9c, 0x92, 0xe0, 0x4c, 0x00, 0xd0, 0x61, 0xa7, 0x22, 0x56, 0xd5, 0x71, 0xed, 0x80, 0x78,
+		0x82, 0xc5, 0xf3, 0x6d, 0xa2, 0x7e, 0x19, 0x59, 0x89, 0x72, 0x1c, 0x28, 0xd0, 0x29, 0x09, 0xbe,
+		0xf0, 0x6d, 0x82, 0x3e, 0x80, 0x79, 0xf9, 0x53, 0x86, 0xd9, 0xae, 0x63, 0xe2, 0x8a, 0x35, 0x65,
+		0xcc, 0xca, 0x89, 0x33, 0x5d, 0xcb, 0xd0, 0x73, 0xc8, 0x2b, 0x6c, 0x78, 0x2d, 0x8a, 0x5c, 0x64,
+		0xe4, 0xfd, 0xfe, 0x9e, 0x44, 0x1c, 0x69, 0x40, 0x78, 0x97, 0x39, 0x85, 0x87, 0xae, 0xaf, 0xeb,
+		0xad, 0x28, 0x5c, 0xe2, 0xc2, 0x67, 0x3a, 0x1e, 0x23, 0x41, 0x13, 0xbb, 0x6a, 0xdf, 0x54, 0xdc,
+		0xbc, 0x46, 0x8d, 0x07, 0xae, 0x2f, 0x2d, 0x7e, 0x14, 0x42, 0x4b, 0x0a, 0x29, 0xf7, 0x4f, 0xf9,
+		0x83, 0x6f, 0x80, 0xbd, 0x0b, 0x62, 0x52, 0xe7, 0x2d, 0x51, 0xef, 0x03, 0x69, 0x31, 0x72, 0xe6,
+		0xbc, 0x25, 0x68, 0x0b, 0x16, 0x2f, 0x08, 0x93, 0x47, 0x62, 0x96, 0x65, 0x03, 0xc1, 0x81, 0xf2,
+		0x65, 0x6e, 0xfe, 0x42, 0xa6, 0x26, 0xba, 0x2f, 0x8a, 0x39, 0x17, 0xf8, 0x14, 0x56, 0x1a, 0x75,
+		0x9b, 0x1f, 0x03, 0xb6, 0x2e, 0xbb, 0xf5, 0x9a, 0x16, 0x0b, 0xe4, 0x24, 0x64, 0xcf, 0xba, 0xec,
+		0x54, 0xe7, 0x04, 0x1e, 0x3a, 0xb6, 0x1b, 0xb9, 0xda, 0x99, 0x56, 0x95, 0x24, 0x11, 0xcd, 0x08,
+		0xa2, 0x0d, 0x0e, 0x0e, 0x83, 0xa1, 0x4a, 0xba, 0x09, 0x0f, 0x60, 0x9d, 0x1f, 0x6b, 0x9b, 0x4f,
+		0xd2, 0xf3, 0xc7, 0x43, 0x4d, 0x95, 0x15, 0x54, 0xf9, 0x1a, 0xbe, 0xd6, 0x4c, 0x25, 0xce, 0xea,
+		0xd4, 0x88, 0x26, 0xd9, 0x87, 0xfb, 0x35, 0x47, 0x95, 0x0f, 0x56, 0x0d, 0x7c, 0xc6, 0x5c, 0xee,
+		0xa8, 0xe5, 0x46, 0x40, 0x99, 0xb4, 0xc7, 0xac, 0xb0, 0x47, 0xbe, 0xe6, 0x88, 0xbc, 0x7a, 0x1e,
+		0x62, 0xf6, 0x39, 0x44, 0x18, 0xe6, 0x19, 0xe4, 0x43, 0x45, 0x6c, 0xc2, 0x9b, 0xf8, 0xa8, 0x3d,
+		0xe7, 0x84, 0xfc, 0x92, 0xd2, 0xe1, 0x50, 0xcc, 0xb7, 0x8d, 0xfa, 0x39, 0x14, 0x3a, 0x03, 0xc4,
+		0xe4, 0xbf, 0x7e, 0x7b, 0x36, 0xe5, 0xfa, 0x10, 0x5a, 0xf5, 0x5d, 0x3b, 0x37, 0x2f, 0x38, 0xd6,
+		0xfd, 0x78, 0x94, 0xec, 0x49, 0xdc, 0xb9, 0x86, 0xa1, 0x8f, 0x60, 0x21, 0x54, 0x24, 0xa2, 0x01,
+		0x12, 0xd2, 0x73, 0x4a, 0x83, 0x70, 0xed, 0x9d, 0xbf, 0x67, 0x20, 0x73, 0xac, 0xd2, 0xed, 0xde,
+		0x69, 0x09, 0xfd, 0x36, 0x05, 0x0b, 0x09, 0x3f, 0xd1, 0xa0, 0xc7, 0x43, 0xfe, 0xa2, 0x23, 0xd2,
+		0x6e, 0x7e, 0xf7, 0x56, 0xbf, 0x03, 0x45, 0x95, 0x88, 0xd6, 0x94, 0x1b, 0x28, 0x91, 0xf0, 0xce,
+		0x94, 0xdf, 0x1d, 0x52, 0x4a, 0x29, 0xd1, 0x84, 0xd9, 0x8e, 0x47, 0x2d, 0xf4, 0xa8, 0x37, 0x53,
+		0xf2, 0x3b, 0x6b, 0x7e, 0x7b, 0x08, 0x89, 0xd8, 0xba, 0xb1, 0x7d, 0xf7, 0x5f, 0x37, 0x69, 0xcf,
+		0xdb, 0x43, 0x48, 0xa8, 0x75, 0xeb, 0x30, 0x13, 0x7b, 0x1c, 0x40, 0xc5, 0xde, 0x1c, 0x49, 0xef,
+		0x1c, 0xf9, 0xad, 0x1b, 0xe3, 0xd5, 0x8a, 0x7f, 0x4a, 0xc1, 0x72, 0xcf, 0x2b, 0x30, 0x7a, 0xd6,
+		0x9b, 0x6e, 0xd0, 0xb5, 0x3e, 0xff, 0xfc, 0x56, 0xb2, 0x4a, 0xad, 0x3f, 0xa4, 0xe0, 0x9d, 0xc4,
+		0x4b, 0x29, 0x7a, 0xd2, 0x9b, 0xb6, 0xdf, 0x25, 0x3d, 0xff, 0x93, 0xa1, 0xe5, 0x94, 0x2a, 0x2d,
+		0x98, 0xeb, 0xec, 0x09, 0xd0, 0xf6, 0x30, 0xbd, 0x92, 0x5c, 0xff, 0x16, 0xed, 0x15, 0xfa, 0x26,
+		0x05, 0x4b, 0xc9, 0x57, 0x17, 0xd4, 0x67, 0x3b, 0x7d, 0xaf, 0x58, 0xf9, 0xa7, 0xc3, 0x0b, 0x2a,
+		0x6d, 0x7e, 0x97, 0x82, 0xc5, 0xa4, 0x46, 0x19, 0xed, 0x0e, 0xdb, 0x58, 0x4b, 0x4d, 0x9e, 0xdc,
+		0xae, 0x1f, 0x17, 0x56, 0x49, 0xee, 0xd2, 0xfa, 0x59, 0xa5, 0x6f, 0x6f, 0x9a, 0x7f, 0x3a, 0xbc,
+		0xa0, 0xd4, 0x66, 0xff, 0xf9, 0x2f, 0x3f, 0xb9, 0x70, 0x58, 0xb5, 0x51, 0x2e, 0x5a, 0x7e, 0x6d,
+		0x2b, 0xf6, 0xff, 0x43, 0xc5, 0x0b, 0xe2, 0xc9, 0xff, 0xa6, 0x8a, 0xfe, 0x43, 0xd7, 0x73, 0xfd,
+		0x77, 0x73, 0xbb, 0x3c, 0x21, 0x66, 0x3f, 0xfe, 0xff, 0x00, 0x33, 0xaf, 0x29, 0x64, 0xfe, 0x25,
+		0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
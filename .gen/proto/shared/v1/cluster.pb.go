@@ -273,6 +273,93 @@ func (m *DomainCacheInfo) GetNumOfItemsInCacheByName() int64 {
 	return 0
 }
 
+type DomainPayloadSizeStats struct {
+	DomainName           string   `protobuf:"bytes,1,opt,name=domain_name,json=domainName,proto3" json:"domain_name,omitempty"`
+	PayloadType          string   `protobuf:"bytes,2,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	AverageSize          int64    `protobuf:"varint,4,opt,name=average_size,json=averageSize,proto3" json:"average_size,omitempty"`
+	MaxSize              int64    `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	NearLimitCount       int64    `protobuf:"varint,6,opt,name=near_limit_count,json=nearLimitCount,proto3" json:"near_limit_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DomainPayloadSizeStats) Reset()         { *m = DomainPayloadSizeStats{} }
+func (m *DomainPayloadSizeStats) String() string { return proto.CompactTextString(m) }
+func (*DomainPayloadSizeStats) ProtoMessage()    {}
+func (*DomainPayloadSizeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31e4c5c03631024e, []int{4}
+}
+func (m *DomainPayloadSizeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainPayloadSizeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainPayloadSizeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainPayloadSizeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainPayloadSizeStats.Merge(m, src)
+}
+func (m *DomainPayloadSizeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainPayloadSizeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainPayloadSizeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainPayloadSizeStats proto.InternalMessageInfo
+
+func (m *DomainPayloadSizeStats) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *DomainPayloadSizeStats) GetPayloadType() string {
+	if m != nil {
+		return m.PayloadType
+	}
+	return ""
+}
+
+func (m *DomainPayloadSizeStats) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *DomainPayloadSizeStats) GetAverageSize() int64 {
+	if m != nil {
+		return m.AverageSize
+	}
+	return 0
+}
+
+func (m *DomainPayloadSizeStats) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *DomainPayloadSizeStats) GetNearLimitCount() int64 {
+	if m != nil {
+		return m.NearLimitCount
+	}
+	return 0
+}
+
 type PersistenceSetting struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -285,7 +372,7 @@ func (m *PersistenceSetting) Reset()         { *m = PersistenceSetting{} }
 func (m *PersistenceSetting) String() string { return proto.CompactTextString(m) }
 func (*PersistenceSetting) ProtoMessage()    {}
 func (*PersistenceSetting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31e4c5c03631024e, []int{4}
+	return fileDescriptor_31e4c5c03631024e, []int{5}
 }
 func (m *PersistenceSetting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,7 +427,7 @@ func (m *PersistenceFeature) Reset()         { *m = PersistenceFeature{} }
 func (m *PersistenceFeature) String() string { return proto.CompactTextString(m) }
 func (*PersistenceFeature) ProtoMessage()    {}
 func (*PersistenceFeature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31e4c5c03631024e, []int{5}
+	return fileDescriptor_31e4c5c03631024e, []int{6}
 }
 func (m *PersistenceFeature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -396,7 +483,7 @@ func (m *PersistenceInfo) Reset()         { *m = PersistenceInfo{} }
 func (m *PersistenceInfo) String() string { return proto.CompactTextString(m) }
 func (*PersistenceInfo) ProtoMessage()    {}
 func (*PersistenceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31e4c5c03631024e, []int{6}
+	return fileDescriptor_31e4c5c03631024e, []int{7}
 }
 func (m *PersistenceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,6 +538,7 @@ func init() {
 	proto.RegisterType((*RingInfo)(nil), "uber.cadence.shared.v1.RingInfo")
 	proto.RegisterType((*MembershipInfo)(nil), "uber.cadence.shared.v1.MembershipInfo")
 	proto.RegisterType((*DomainCacheInfo)(nil), "uber.cadence.shared.v1.DomainCacheInfo")
+	proto.RegisterType((*DomainPayloadSizeStats)(nil), "uber.cadence.shared.v1.DomainPayloadSizeStats")
 	proto.RegisterType((*PersistenceSetting)(nil), "uber.cadence.shared.v1.PersistenceSetting")
 	proto.RegisterType((*PersistenceFeature)(nil), "uber.cadence.shared.v1.PersistenceFeature")
 	proto.RegisterType((*PersistenceInfo)(nil), "uber.cadence.shared.v1.PersistenceInfo")
@@ -461,39 +549,45 @@ func init() {
 }
 
 var fileDescriptor_31e4c5c03631024e = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdd, 0x8a, 0x13, 0x4d,
-	0x10, 0x65, 0x76, 0xbe, 0x7c, 0x49, 0x2a, 0x8b, 0xbb, 0x36, 0xfe, 0x04, 0xc5, 0x10, 0x07, 0x91,
-	0xa0, 0x30, 0x43, 0x56, 0xf4, 0x62, 0x15, 0x91, 0x8d, 0x2c, 0xe6, 0xc2, 0x1f, 0xda, 0x3b, 0x6f,
-	0x86, 0x9e, 0x9e, 0xca, 0x4c, 0xb3, 0x99, 0xee, 0xa5, 0xa7, 0x27, 0x90, 0x0b, 0x1f, 0xc1, 0x17,
-	0xd2, 0x17, 0xf0, 0xd2, 0x47, 0x90, 0x3c, 0x89, 0x4c, 0xf7, 0x4c, 0x74, 0x31, 0x8b, 0x7b, 0x57,
-	0xd5, 0x75, 0xce, 0xe9, 0x53, 0x55, 0x14, 0x3c, 0xa8, 0x12, 0xd4, 0x11, 0x67, 0x29, 0x4a, 0x8e,
-	0x51, 0x99, 0x33, 0x8d, 0x69, 0xb4, 0x9a, 0x46, 0x7c, 0x59, 0x95, 0x06, 0x75, 0x78, 0xae, 0x95,
-	0x51, 0xe4, 0x56, 0x8d, 0x0a, 0x1b, 0x54, 0xe8, 0x50, 0xe1, 0x6a, 0x1a, 0x3c, 0x84, 0xde, 0x1b,
-	0x55, 0x9a, 0xb9, 0x5c, 0x28, 0x72, 0x07, 0x7a, 0x22, 0x45, 0x69, 0x84, 0x59, 0x0f, 0xbd, 0xb1,
-	0x37, 0xe9, 0xd3, 0x6d, 0x1e, 0x7c, 0x86, 0x1e, 0x15, 0x32, 0xb3, 0x38, 0x02, 0xff, 0x69, 0xb5,
-	0xc4, 0x06, 0x63, 0x63, 0x72, 0x1f, 0xf6, 0x0b, 0x2c, 0x12, 0xd4, 0x31, 0x57, 0x95, 0x34, 0xc3,
-	0xbd, 0xb1, 0x37, 0xe9, 0xd0, 0x81, 0x7b, 0x9b, 0xd5, 0x4f, 0xe4, 0x18, 0xba, 0x2e, 0x2d, 0x87,
-	0xfe, 0xd8, 0x9f, 0x0c, 0x8e, 0xc6, 0xe1, 0x6e, 0x53, 0x61, 0xeb, 0x88, 0xb6, 0x84, 0xe0, 0xab,
-	0x07, 0xd7, 0xde, 0xba, 0x38, 0x17, 0xe7, 0xd6, 0xc5, 0x0c, 0xf6, 0x79, 0xa5, 0x35, 0x4a, 0x13,
-	0xe7, 0xaa, 0x34, 0xd6, 0xcd, 0x55, 0x34, 0x07, 0x0d, 0xab, 0x7e, 0x20, 0x8f, 0xe1, 0xba, 0x46,
-	0xc6, 0x73, 0x96, 0x2c, 0x31, 0x6e, 0xdd, 0xed, 0x8d, 0xfd, 0x49, 0x9f, 0x1e, 0x6e, 0x0b, 0xcd,
-	0xc7, 0xe4, 0x19, 0x74, 0xb4, 0x90, 0xd9, 0x3f, 0xed, 0xb7, 0x83, 0xa2, 0x0e, 0x1e, 0x7c, 0xf1,
-	0xe0, 0xe0, 0xb5, 0x2a, 0x98, 0x90, 0x33, 0xc6, 0x73, 0xb4, 0xee, 0x8f, 0xe1, 0xae, 0xac, 0x8a,
-	0x58, 0x2d, 0x62, 0x61, 0xb0, 0x28, 0x63, 0x21, 0x63, 0x5e, 0x17, 0xe3, 0x64, 0x1d, 0x8b, 0xd4,
-	0x36, 0xe3, 0xd3, 0x9b, 0xb2, 0x2a, 0xde, 0x2f, 0xe6, 0x35, 0x60, 0xee, 0xb8, 0x27, 0xeb, 0x79,
-	0x4a, 0x5e, 0xc2, 0xbd, 0x4b, 0xb9, 0x92, 0x15, 0x68, 0x87, 0xef, 0xd3, 0xdb, 0x3b, 0xd8, 0xef,
-	0x58, 0x81, 0xc1, 0x0b, 0x20, 0x1f, 0x50, 0x97, 0xa2, 0x34, 0xb5, 0xf1, 0x8f, 0x68, 0x8c, 0x90,
-	0x19, 0x39, 0x04, 0xff, 0x0c, 0xdb, 0xc5, 0xd7, 0x21, 0xb9, 0x01, 0x9d, 0x15, 0x5b, 0x56, 0x4e,
-	0xaf, 0x4f, 0x5d, 0x12, 0xbc, 0xba, 0xc0, 0x3e, 0x45, 0x66, 0x2a, 0x8d, 0x3b, 0xd8, 0x43, 0xe8,
-	0xa2, 0xac, 0xc7, 0x97, 0x5a, 0x7e, 0x8f, 0xb6, 0x69, 0xf0, 0xcd, 0x83, 0x83, 0x3f, 0x24, 0xec,
-	0x3c, 0x86, 0xd0, 0x4d, 0x18, 0x3f, 0x43, 0x99, 0x36, 0x1a, 0x6d, 0x4a, 0x4e, 0xa1, 0x57, 0x3a,
-	0x8b, 0x6e, 0x33, 0x83, 0xa3, 0x47, 0x97, 0x0d, 0xfe, 0xef, 0xae, 0xe8, 0x96, 0x5b, 0xeb, 0x2c,
-	0x9c, 0xd9, 0x76, 0x81, 0x57, 0xd1, 0x69, 0xfa, 0xa3, 0x5b, 0xee, 0xc9, 0xec, 0xfb, 0x66, 0xe4,
-	0xfd, 0xd8, 0x8c, 0xbc, 0x9f, 0x9b, 0x91, 0xf7, 0xe9, 0x69, 0x26, 0x4c, 0x5e, 0x25, 0x21, 0x57,
-	0x45, 0x74, 0xe1, 0x10, 0xc3, 0x0c, 0x65, 0x64, 0x6f, 0xef, 0xf7, 0x4d, 0x3e, 0x77, 0xd1, 0x6a,
-	0x9a, 0xfc, 0x6f, 0x2b, 0x4f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x93, 0x7f, 0x12, 0xb0, 0xbd,
-	0x03, 0x00, 0x00,
+	// 606 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x6b, 0x13, 0x41,
+	0x14, 0x65, 0xbb, 0x4d, 0x93, 0xde, 0x2d, 0x6d, 0x1d, 0xb4, 0x46, 0xc5, 0x1a, 0x17, 0x91, 0xa0,
+	0xb0, 0xa1, 0x15, 0x7d, 0xa8, 0x22, 0xd2, 0x48, 0x31, 0xe0, 0x47, 0xd9, 0xfa, 0xe4, 0xcb, 0x30,
+	0xbb, 0x7b, 0x93, 0x0c, 0xcd, 0xcc, 0x86, 0xd9, 0xd9, 0xd0, 0x14, 0xfc, 0x09, 0xfe, 0x21, 0xfd,
+	0x03, 0x3e, 0xfa, 0xec, 0x93, 0xf4, 0x97, 0xc8, 0xcc, 0xec, 0x46, 0x8b, 0x2d, 0xf6, 0x6d, 0xee,
+	0x3d, 0xe7, 0xdc, 0x3d, 0xb3, 0xf7, 0x30, 0xf0, 0xa0, 0x4c, 0x50, 0xf5, 0x52, 0x96, 0xa1, 0x4c,
+	0xb1, 0x57, 0x8c, 0x99, 0xc2, 0xac, 0x37, 0xdb, 0xe9, 0xa5, 0x93, 0xb2, 0xd0, 0xa8, 0xa2, 0xa9,
+	0xca, 0x75, 0x4e, 0xb6, 0x0c, 0x2b, 0xaa, 0x58, 0x91, 0x63, 0x45, 0xb3, 0x9d, 0xf0, 0x21, 0xb4,
+	0xde, 0xe4, 0x85, 0x1e, 0xc8, 0x61, 0x4e, 0x6e, 0x43, 0x8b, 0x67, 0x28, 0x35, 0xd7, 0xf3, 0xb6,
+	0xd7, 0xf1, 0xba, 0xab, 0xf1, 0xa2, 0x0e, 0x3f, 0x43, 0x2b, 0xe6, 0x72, 0x64, 0x79, 0x04, 0x96,
+	0x55, 0x3e, 0xc1, 0x8a, 0x63, 0xcf, 0xe4, 0x3e, 0xac, 0x09, 0x14, 0x09, 0x2a, 0x9a, 0xe6, 0xa5,
+	0xd4, 0xed, 0xa5, 0x8e, 0xd7, 0x6d, 0xc4, 0x81, 0xeb, 0xf5, 0x4d, 0x8b, 0xec, 0x41, 0xd3, 0x95,
+	0x45, 0xdb, 0xef, 0xf8, 0xdd, 0x60, 0xb7, 0x13, 0x5d, 0x6c, 0x2a, 0xaa, 0x1d, 0xc5, 0xb5, 0x20,
+	0xfc, 0xea, 0xc1, 0xfa, 0x3b, 0x77, 0x1e, 0xf3, 0xa9, 0x75, 0xd1, 0x87, 0xb5, 0xb4, 0x54, 0x0a,
+	0xa5, 0xa6, 0xe3, 0xbc, 0xd0, 0xd6, 0xcd, 0x55, 0x66, 0x06, 0x95, 0xca, 0x34, 0xc8, 0x63, 0xb8,
+	0xa6, 0x90, 0xa5, 0x63, 0x96, 0x4c, 0x90, 0xd6, 0xee, 0x96, 0x3a, 0x7e, 0x77, 0x35, 0xde, 0x5c,
+	0x00, 0xd5, 0x87, 0xc9, 0x33, 0x68, 0x28, 0x2e, 0x47, 0xff, 0xb5, 0x5f, 0xff, 0xa8, 0xd8, 0xd1,
+	0xc3, 0x2f, 0x1e, 0x6c, 0xbc, 0xce, 0x05, 0xe3, 0xb2, 0xcf, 0xd2, 0x31, 0x5a, 0xf7, 0x7b, 0x70,
+	0x47, 0x96, 0x82, 0xe6, 0x43, 0xca, 0x35, 0x8a, 0x82, 0x72, 0x49, 0x53, 0x03, 0xd2, 0x64, 0x4e,
+	0x79, 0x66, 0x2f, 0xe3, 0xc7, 0x37, 0x64, 0x29, 0x3e, 0x0c, 0x07, 0x86, 0x30, 0x70, 0xda, 0xfd,
+	0xf9, 0x20, 0x23, 0x2f, 0xe1, 0xee, 0xa5, 0x5a, 0xc9, 0x04, 0xda, 0x9f, 0xef, 0xc7, 0x37, 0x2f,
+	0x50, 0xbf, 0x67, 0x02, 0xc3, 0x9f, 0x1e, 0x6c, 0x39, 0x3f, 0x87, 0x6c, 0x3e, 0xc9, 0x59, 0x76,
+	0xc4, 0x4f, 0xf1, 0x48, 0x33, 0x5d, 0x90, 0x7b, 0x10, 0x64, 0x16, 0x71, 0x83, 0xdc, 0x86, 0xc1,
+	0xb5, 0x8c, 0xd6, 0xec, 0x79, 0xea, 0x44, 0x54, 0xcf, 0xa7, 0xee, 0x53, 0xab, 0x71, 0x50, 0xf5,
+	0x3e, 0xce, 0xa7, 0x48, 0xae, 0x43, 0xc3, 0x65, 0xc0, 0xb7, 0x36, 0x5c, 0x61, 0x84, 0x6c, 0x86,
+	0x8a, 0x8d, 0x90, 0x16, 0xfc, 0x14, 0xdb, 0xcb, 0x16, 0x0c, 0xaa, 0x9e, 0x71, 0x40, 0x6e, 0x41,
+	0x4b, 0xb0, 0x13, 0x07, 0x37, 0x2c, 0xdc, 0x14, 0xec, 0xc4, 0x42, 0x5d, 0xd8, 0x94, 0xc8, 0x14,
+	0x9d, 0x70, 0xc1, 0x75, 0x15, 0xb1, 0x15, 0x4b, 0x59, 0x37, 0xfd, 0xb7, 0xa6, 0x6d, 0x53, 0x16,
+	0xbe, 0x00, 0x72, 0x88, 0xaa, 0xe0, 0x85, 0x36, 0x5b, 0x39, 0x42, 0xad, 0xb9, 0x1c, 0x91, 0x4d,
+	0xf0, 0x8f, 0xb1, 0x4e, 0xb5, 0x39, 0x1a, 0x97, 0x33, 0x36, 0x29, 0xeb, 0x1b, 0xb8, 0x22, 0x7c,
+	0x75, 0x4e, 0x7d, 0x80, 0x4c, 0x97, 0x0a, 0x2f, 0x50, 0xb7, 0xa1, 0x89, 0xd2, 0x64, 0x23, 0xb3,
+	0xfa, 0x56, 0x5c, 0x97, 0xe1, 0x37, 0x0f, 0x36, 0xfe, 0x1a, 0x61, 0x97, 0xdd, 0x86, 0x66, 0xc2,
+	0xd2, 0x63, 0x94, 0x59, 0x35, 0xa3, 0x2e, 0xc9, 0x01, 0xb4, 0x0a, 0x67, 0xd1, 0xc5, 0x2e, 0xd8,
+	0x7d, 0x74, 0x59, 0xaa, 0xfe, 0xbd, 0x55, 0xbc, 0xd0, 0x9a, 0x39, 0x43, 0x67, 0xb6, 0x4e, 0xe7,
+	0x55, 0xe6, 0x54, 0xf7, 0x8b, 0x17, 0xda, 0xfd, 0xfe, 0xf7, 0xb3, 0x6d, 0xef, 0xc7, 0xd9, 0xb6,
+	0xf7, 0xeb, 0x6c, 0xdb, 0xfb, 0xf4, 0x74, 0xc4, 0xf5, 0xb8, 0x4c, 0xa2, 0x34, 0x17, 0xbd, 0x73,
+	0xaf, 0x4c, 0x34, 0x42, 0xd9, 0xb3, 0x0f, 0xcb, 0x9f, 0x07, 0xe7, 0xb9, 0x3b, 0xcd, 0x76, 0x92,
+	0x15, 0x8b, 0x3c, 0xf9, 0x3d, 0x00, 0x71, 0x27, 0xf0, 0x50, 0x9a, 0x04, 0x00, 0x00,
 }
 
 func (m *HostInfo) Marshal() (dAtA []byte, err error) {
@@ -682,6 +776,67 @@ func (m *DomainCacheInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DomainPayloadSizeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainPayloadSizeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainPayloadSizeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.NearLimitCount != 0 {
+		i = encodeVarintCluster(dAtA, i, uint64(m.NearLimitCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxSize != 0 {
+		i = encodeVarintCluster(dAtA, i, uint64(m.MaxSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AverageSize != 0 {
+		i = encodeVarintCluster(dAtA, i, uint64(m.AverageSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Count != 0 {
+		i = encodeVarintCluster(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PayloadType) > 0 {
+		i -= len(m.PayloadType)
+		copy(dAtA[i:], m.PayloadType)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.PayloadType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DomainName) > 0 {
+		i -= len(m.DomainName)
+		copy(dAtA[i:], m.DomainName)
+		i = encodeVarintCluster(dAtA, i, uint64(len(m.DomainName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PersistenceSetting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -927,6 +1082,38 @@ func (m *DomainCacheInfo) Size() (n int) {
 	return n
 }
 
+func (m *DomainPayloadSizeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DomainName)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	l = len(m.PayloadType)
+	if l > 0 {
+		n += 1 + l + sovCluster(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovCluster(uint64(m.Count))
+	}
+	if m.AverageSize != 0 {
+		n += 1 + sovCluster(uint64(m.AverageSize))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovCluster(uint64(m.MaxSize))
+	}
+	if m.NearLimitCount != 0 {
+		n += 1 + sovCluster(uint64(m.NearLimitCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *PersistenceSetting) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1461,6 +1648,197 @@ func (m *DomainCacheInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DomainPayloadSizeStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCluster
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainPayloadSizeStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainPayloadSizeStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCluster
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageSize", wireType)
+			}
+			m.AverageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NearLimitCount", wireType)
+			}
+			m.NearLimitCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCluster
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NearLimitCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCluster(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCluster
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PersistenceSetting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28,35 +28,42 @@ package sharedv1
 var yarpcFileDescriptorClosure31e4c5c03631024e = [][]byte{
 	// uber/cadence/shared/v1/cluster.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xdf, 0x8b, 0xd3, 0x40,
-		0x10, 0x26, 0x17, 0x6b, 0xdb, 0xe9, 0xe1, 0x9d, 0x8b, 0x3f, 0x82, 0x22, 0xc4, 0x20, 0x52, 0x14,
-		0x12, 0x7a, 0xa2, 0xc2, 0x29, 0x22, 0x57, 0x39, 0xec, 0x83, 0x3f, 0x58, 0xdf, 0x7c, 0x09, 0x9b,
-		0xcd, 0x34, 0x59, 0xae, 0xd9, 0x3d, 0x36, 0x9b, 0x42, 0x1f, 0xfc, 0x13, 0xfc, 0x87, 0xf4, 0x9f,
-		0x93, 0xec, 0x26, 0xd5, 0xc3, 0x1e, 0x77, 0x6f, 0x33, 0x3b, 0xdf, 0xf7, 0xed, 0x37, 0x33, 0x0c,
-		0x3c, 0x69, 0x32, 0xd4, 0x09, 0x67, 0x39, 0x4a, 0x8e, 0x49, 0x5d, 0x32, 0x8d, 0x79, 0xb2, 0x9e,
-		0x25, 0x7c, 0xd5, 0xd4, 0x06, 0x75, 0x7c, 0xae, 0x95, 0x51, 0xe4, 0x5e, 0x8b, 0x8a, 0x3b, 0x54,
-		0xec, 0x50, 0xf1, 0x7a, 0x16, 0x3d, 0x85, 0xd1, 0x47, 0x55, 0x9b, 0x85, 0x5c, 0x2a, 0xf2, 0x00,
-		0x46, 0x22, 0x47, 0x69, 0x84, 0xd9, 0x04, 0x5e, 0xe8, 0x4d, 0xc7, 0x74, 0x9b, 0x47, 0x3f, 0x60,
-		0x44, 0x85, 0x2c, 0x2c, 0x8e, 0xc0, 0x0d, 0xad, 0x56, 0xd8, 0x61, 0x6c, 0x4c, 0x1e, 0xc3, 0x7e,
-		0x85, 0x55, 0x86, 0x3a, 0xe5, 0xaa, 0x91, 0x26, 0xd8, 0x0b, 0xbd, 0xe9, 0x80, 0x4e, 0xdc, 0xdb,
-		0xbc, 0x7d, 0x22, 0xc7, 0x30, 0x74, 0x69, 0x1d, 0xf8, 0xa1, 0x3f, 0x9d, 0x1c, 0x85, 0xf1, 0x6e,
-		0x53, 0x71, 0xef, 0x88, 0xf6, 0x84, 0xe8, 0x97, 0x07, 0xb7, 0x3e, 0xb9, 0xb8, 0x14, 0xe7, 0xd6,
-		0xc5, 0x1c, 0xf6, 0x79, 0xa3, 0x35, 0x4a, 0x93, 0x96, 0xaa, 0x36, 0xd6, 0xcd, 0x75, 0x34, 0x27,
-		0x1d, 0xab, 0x7d, 0x20, 0xcf, 0xe1, 0xb6, 0x46, 0xc6, 0x4b, 0x96, 0xad, 0x30, 0xed, 0xdd, 0xed,
-		0x85, 0xfe, 0x74, 0x4c, 0x0f, 0xb7, 0x85, 0xee, 0x63, 0xf2, 0x0a, 0x06, 0x5a, 0xc8, 0xe2, 0x4a,
-		0xfb, 0xfd, 0xa0, 0xa8, 0x83, 0x47, 0x3f, 0x3d, 0x38, 0xf8, 0xa0, 0x2a, 0x26, 0xe4, 0x9c, 0xf1,
-		0x12, 0xad, 0xfb, 0x63, 0x78, 0x28, 0x9b, 0x2a, 0x55, 0xcb, 0x54, 0x18, 0xac, 0xea, 0x54, 0xc8,
-		0x94, 0xb7, 0xc5, 0x34, 0xdb, 0xa4, 0x22, 0xb7, 0xcd, 0xf8, 0xf4, 0xae, 0x6c, 0xaa, 0x2f, 0xcb,
-		0x45, 0x0b, 0x58, 0x38, 0xee, 0xc9, 0x66, 0x91, 0x93, 0x77, 0xf0, 0xe8, 0x52, 0xae, 0x64, 0x15,
-		0xda, 0xe1, 0xfb, 0xf4, 0xfe, 0x0e, 0xf6, 0x67, 0x56, 0x61, 0xf4, 0x16, 0xc8, 0x57, 0xd4, 0xb5,
-		0xa8, 0x4d, 0x6b, 0xfc, 0x1b, 0x1a, 0x23, 0x64, 0x41, 0x0e, 0xc1, 0x3f, 0xc3, 0x7e, 0xf1, 0x6d,
-		0x48, 0xee, 0xc0, 0x60, 0xcd, 0x56, 0x8d, 0xd3, 0x1b, 0x53, 0x97, 0x44, 0xef, 0x2f, 0xb0, 0x4f,
-		0x91, 0x99, 0x46, 0xe3, 0x0e, 0x76, 0x00, 0x43, 0x94, 0xed, 0xf8, 0x72, 0xcb, 0x1f, 0xd1, 0x3e,
-		0x8d, 0x7e, 0x7b, 0x70, 0xf0, 0x8f, 0x84, 0x9d, 0x47, 0x00, 0xc3, 0x8c, 0xf1, 0x33, 0x94, 0x79,
-		0xa7, 0xd1, 0xa7, 0xe4, 0x14, 0x46, 0xb5, 0xb3, 0xe8, 0x36, 0x33, 0x39, 0x7a, 0x76, 0xd9, 0xe0,
-		0xff, 0xef, 0x8a, 0x6e, 0xb9, 0xad, 0xce, 0xd2, 0x99, 0xed, 0x17, 0x78, 0x1d, 0x9d, 0xae, 0x3f,
-		0xba, 0xe5, 0x9e, 0xbc, 0xfe, 0xfe, 0xb2, 0x10, 0xa6, 0x6c, 0xb2, 0x98, 0xab, 0x2a, 0xb9, 0x70,
-		0x7c, 0x71, 0x81, 0x32, 0xb1, 0xf7, 0xf6, 0xf7, 0x0e, 0xdf, 0xb8, 0x68, 0x3d, 0xcb, 0x6e, 0xda,
-		0xca, 0x8b, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x90, 0xef, 0x39, 0x7d, 0xb1, 0x03, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xed, 0x6a, 0x13, 0x41,
+		0x14, 0x65, 0xbb, 0x4d, 0x93, 0xde, 0x2d, 0x6d, 0x1d, 0xb4, 0x46, 0x45, 0x8c, 0x8b, 0x48, 0x50,
+		0xd8, 0xd0, 0x8a, 0x0a, 0x55, 0x44, 0x5a, 0x29, 0x06, 0xfc, 0x28, 0x53, 0x7f, 0xf9, 0x67, 0x98,
+		0xdd, 0xbd, 0x49, 0x86, 0x66, 0x66, 0xc3, 0xec, 0x6c, 0x68, 0x0a, 0x3e, 0x82, 0x2f, 0xa4, 0x6f,
+		0xe2, 0xd3, 0xc8, 0xcc, 0xec, 0x46, 0x8b, 0x2d, 0xf6, 0xdf, 0xdc, 0x7b, 0xce, 0xb9, 0x7b, 0x66,
+		0xef, 0x61, 0xe0, 0x51, 0x95, 0xa2, 0x1e, 0x64, 0x3c, 0x47, 0x95, 0xe1, 0xa0, 0x9c, 0x70, 0x8d,
+		0xf9, 0x60, 0xbe, 0x3b, 0xc8, 0xa6, 0x55, 0x69, 0x50, 0x27, 0x33, 0x5d, 0x98, 0x82, 0xec, 0x58,
+		0x56, 0x52, 0xb3, 0x12, 0xcf, 0x4a, 0xe6, 0xbb, 0xf1, 0x63, 0xe8, 0xbc, 0x2f, 0x4a, 0x33, 0x54,
+		0xa3, 0x82, 0xdc, 0x85, 0x8e, 0xc8, 0x51, 0x19, 0x61, 0x16, 0xdd, 0xa0, 0x17, 0xf4, 0xd7, 0xe9,
+		0xb2, 0x8e, 0xbf, 0x41, 0x87, 0x0a, 0x35, 0x76, 0x3c, 0x02, 0xab, 0xba, 0x98, 0x62, 0xcd, 0x71,
+		0x67, 0xf2, 0x10, 0x36, 0x24, 0xca, 0x14, 0x35, 0xcb, 0x8a, 0x4a, 0x99, 0xee, 0x4a, 0x2f, 0xe8,
+		0xb7, 0x68, 0xe4, 0x7b, 0x87, 0xb6, 0x45, 0xf6, 0xa1, 0xed, 0xcb, 0xb2, 0x1b, 0xf6, 0xc2, 0x7e,
+		0xb4, 0xd7, 0x4b, 0x2e, 0x37, 0x95, 0x34, 0x8e, 0x68, 0x23, 0x88, 0x7f, 0x04, 0xb0, 0xf9, 0xd1,
+		0x9f, 0x27, 0x62, 0xe6, 0x5c, 0x1c, 0xc2, 0x46, 0x56, 0x69, 0x8d, 0xca, 0xb0, 0x49, 0x51, 0x1a,
+		0xe7, 0xe6, 0x3a, 0x33, 0xa3, 0x5a, 0x65, 0x1b, 0xe4, 0x29, 0xdc, 0xd0, 0xc8, 0xb3, 0x09, 0x4f,
+		0xa7, 0xc8, 0x1a, 0x77, 0x2b, 0xbd, 0xb0, 0xbf, 0x4e, 0xb7, 0x97, 0x40, 0xfd, 0x61, 0xf2, 0x02,
+		0x5a, 0x5a, 0xa8, 0xf1, 0x7f, 0xed, 0x37, 0x3f, 0x8a, 0x7a, 0x7a, 0xfc, 0x3d, 0x80, 0xad, 0x77,
+		0x85, 0xe4, 0x42, 0x1d, 0xf2, 0x6c, 0x82, 0xce, 0xfd, 0x3e, 0xdc, 0x53, 0x95, 0x64, 0xc5, 0x88,
+		0x09, 0x83, 0xb2, 0x64, 0x42, 0xb1, 0xcc, 0x82, 0x2c, 0x5d, 0x30, 0x91, 0xbb, 0xcb, 0x84, 0xf4,
+		0x96, 0xaa, 0xe4, 0xe7, 0xd1, 0xd0, 0x12, 0x86, 0x5e, 0x7b, 0xb0, 0x18, 0xe6, 0xe4, 0x0d, 0xdc,
+		0xbf, 0x52, 0xab, 0xb8, 0x44, 0xf7, 0xf3, 0x43, 0x7a, 0xfb, 0x12, 0xf5, 0x27, 0x2e, 0x31, 0xfe,
+		0x15, 0xc0, 0x8e, 0xf7, 0x73, 0xcc, 0x17, 0xd3, 0x82, 0xe7, 0x27, 0xe2, 0x1c, 0x4f, 0x0c, 0x37,
+		0x25, 0x79, 0x00, 0x51, 0xee, 0x10, 0x3f, 0xc8, 0x6f, 0x18, 0x7c, 0xcb, 0x6a, 0xed, 0x9e, 0x67,
+		0x5e, 0xc4, 0xcc, 0x62, 0xe6, 0x3f, 0xb5, 0x4e, 0xa3, 0xba, 0xf7, 0x65, 0x31, 0x43, 0x72, 0x13,
+		0x5a, 0x3e, 0x03, 0xa1, 0xb3, 0xe1, 0x0b, 0x2b, 0xe4, 0x73, 0xd4, 0x7c, 0x8c, 0xac, 0x14, 0xe7,
+		0xd8, 0x5d, 0x75, 0x60, 0x54, 0xf7, 0xac, 0x03, 0x72, 0x07, 0x3a, 0x92, 0x9f, 0x79, 0xb8, 0xe5,
+		0xe0, 0xb6, 0xe4, 0x67, 0x0e, 0xea, 0xc3, 0xb6, 0x42, 0xae, 0xd9, 0x54, 0x48, 0x61, 0xea, 0x88,
+		0xad, 0x39, 0xca, 0xa6, 0xed, 0x7f, 0xb0, 0x6d, 0x97, 0xb2, 0xf8, 0x35, 0x90, 0x63, 0xd4, 0xa5,
+		0x28, 0x8d, 0xdd, 0xca, 0x09, 0x1a, 0x23, 0xd4, 0x98, 0x6c, 0x43, 0x78, 0x8a, 0x4d, 0xaa, 0xed,
+		0xd1, 0xba, 0x9c, 0xf3, 0x69, 0xd5, 0xdc, 0xc0, 0x17, 0xf1, 0xdb, 0x0b, 0xea, 0x23, 0xe4, 0xa6,
+		0xd2, 0x78, 0x89, 0xba, 0x0b, 0x6d, 0x54, 0x36, 0x1b, 0xb9, 0xd3, 0x77, 0x68, 0x53, 0xc6, 0x3f,
+		0x03, 0xd8, 0xfa, 0x6b, 0x84, 0x5b, 0x76, 0x17, 0xda, 0x29, 0xcf, 0x4e, 0x51, 0xe5, 0xf5, 0x8c,
+		0xa6, 0x24, 0x47, 0xd0, 0x29, 0xbd, 0x45, 0x1f, 0xbb, 0x68, 0xef, 0xc9, 0x55, 0xa9, 0xfa, 0xf7,
+		0x56, 0x74, 0xa9, 0xb5, 0x73, 0x46, 0xde, 0x6c, 0x93, 0xce, 0xeb, 0xcc, 0xa9, 0xef, 0x47, 0x97,
+		0xda, 0x83, 0x97, 0x5f, 0x9f, 0x8f, 0x85, 0x99, 0x54, 0x69, 0x92, 0x15, 0x72, 0x70, 0xe1, 0x65,
+		0x49, 0xc6, 0xa8, 0x06, 0xee, 0x31, 0xf9, 0xf3, 0xc8, 0xbc, 0xf2, 0xa7, 0xf9, 0x6e, 0xba, 0xe6,
+		0x90, 0x67, 0xbf, 0x07, 0x00, 0xb2, 0xef, 0x7d, 0x0b, 0x8e, 0x04, 0x00, 0x00,
 	},
 }
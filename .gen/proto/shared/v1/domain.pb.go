@@ -0,0 +1,483 @@
+// The MIT License (MIT)
+
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/shared/v1/domain.proto
+
+package sharedv1
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AsyncWorkflowConfiguration is the queue which the asynchronous start and signal requests of a domain are written to.
+type AsyncWorkflowConfiguration struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	QueueProvider        string   `protobuf:"bytes,2,opt,name=queue_provider,json=queueProvider,proto3" json:"queue_provider,omitempty"`
+	QueueTopic           string   `protobuf:"bytes,3,opt,name=queue_topic,json=queueTopic,proto3" json:"queue_topic,omitempty"`
+	Rps                  int32    `protobuf:"varint,4,opt,name=rps,proto3" json:"rps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AsyncWorkflowConfiguration) Reset()         { *m = AsyncWorkflowConfiguration{} }
+func (m *AsyncWorkflowConfiguration) String() string { return proto.CompactTextString(m) }
+func (*AsyncWorkflowConfiguration) ProtoMessage()    {}
+func (*AsyncWorkflowConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5d8e8bf84e8d53f4, []int{0}
+}
+func (m *AsyncWorkflowConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AsyncWorkflowConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AsyncWorkflowConfiguration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AsyncWorkflowConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AsyncWorkflowConfiguration.Merge(m, src)
+}
+func (m *AsyncWorkflowConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *AsyncWorkflowConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_AsyncWorkflowConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AsyncWorkflowConfiguration proto.InternalMessageInfo
+
+func (m *AsyncWorkflowConfiguration) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *AsyncWorkflowConfiguration) GetQueueProvider() string {
+	if m != nil {
+		return m.QueueProvider
+	}
+	return ""
+}
+
+func (m *AsyncWorkflowConfiguration) GetQueueTopic() string {
+	if m != nil {
+		return m.QueueTopic
+	}
+	return ""
+}
+
+func (m *AsyncWorkflowConfiguration) GetRps() int32 {
+	if m != nil {
+		return m.Rps
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*AsyncWorkflowConfiguration)(nil), "uber.cadence.shared.v1.AsyncWorkflowConfiguration")
+}
+
+func init() {
+	proto.RegisterFile("uber/cadence/shared/v1/domain.proto", fileDescriptor_5d8e8bf84e8d53f4)
+}
+
+var fileDescriptor_5d8e8bf84e8d53f4 = []byte{
+	// 238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0x4f, 0x4a, 0xc4, 0x30,
+	0x14, 0x87, 0x89, 0xe3, 0xdf, 0x88, 0x22, 0x59, 0x48, 0x70, 0x51, 0x8b, 0x22, 0x74, 0x95, 0x50,
+	0xc4, 0x95, 0x2b, 0x9d, 0x0b, 0x48, 0x11, 0x04, 0x37, 0x92, 0x26, 0x6f, 0x3a, 0xc1, 0x99, 0xbc,
+	0x9a, 0x36, 0x15, 0x6f, 0xe1, 0xb1, 0x5c, 0x7a, 0x04, 0xe9, 0x49, 0xa4, 0x69, 0x45, 0x66, 0xf7,
+	0x7b, 0xdf, 0xfb, 0x36, 0x1f, 0xbd, 0x0c, 0x25, 0x78, 0xa9, 0x95, 0x01, 0xa7, 0x41, 0x36, 0x4b,
+	0xe5, 0xc1, 0xc8, 0x2e, 0x97, 0x06, 0xd7, 0xca, 0x3a, 0x51, 0x7b, 0x6c, 0x91, 0x9d, 0x0e, 0x92,
+	0x98, 0x24, 0x31, 0x4a, 0xa2, 0xcb, 0x2f, 0x3e, 0x09, 0x3d, 0xbb, 0x6b, 0x3e, 0x9c, 0x7e, 0x42,
+	0xff, 0xba, 0x58, 0xe1, 0xfb, 0x1c, 0xdd, 0xc2, 0x56, 0xc1, 0xab, 0xd6, 0xa2, 0x63, 0x9c, 0xee,
+	0x81, 0x53, 0xe5, 0x0a, 0x0c, 0x27, 0x29, 0xc9, 0xf6, 0x8b, 0xbf, 0x93, 0x5d, 0xd1, 0xe3, 0xb7,
+	0x00, 0x01, 0x5e, 0x6a, 0x8f, 0x9d, 0x35, 0xe0, 0xf9, 0x56, 0x4a, 0xb2, 0x83, 0xe2, 0x28, 0xd2,
+	0x87, 0x09, 0xb2, 0x73, 0x7a, 0x38, 0x6a, 0x2d, 0xd6, 0x56, 0xf3, 0x59, 0x74, 0x68, 0x44, 0x8f,
+	0x03, 0x61, 0x27, 0x74, 0xe6, 0xeb, 0x86, 0x6f, 0xa7, 0x24, 0xdb, 0x29, 0x86, 0x79, 0x3f, 0xff,
+	0xea, 0x13, 0xf2, 0xdd, 0x27, 0xe4, 0xa7, 0x4f, 0xc8, 0xf3, 0x4d, 0x65, 0xdb, 0x65, 0x28, 0x85,
+	0xc6, 0xb5, 0xdc, 0x08, 0x15, 0x15, 0x38, 0x19, 0xe3, 0xfe, 0x9b, 0x6f, 0xc7, 0xd5, 0xe5, 0xe5,
+	0x6e, 0xfc, 0x5c, 0xff, 0x0e, 0x00, 0xa8, 0xcf, 0xc3, 0x1c, 0x1d, 0x01, 0x00, 0x00,
+}
+
+func (m *AsyncWorkflowConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AsyncWorkflowConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AsyncWorkflowConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Rps != 0 {
+		i = encodeVarintDomain(dAtA, i, uint64(m.Rps))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.QueueTopic) > 0 {
+		i -= len(m.QueueTopic)
+		copy(dAtA[i:], m.QueueTopic)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.QueueTopic)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QueueProvider) > 0 {
+		i -= len(m.QueueProvider)
+		copy(dAtA[i:], m.QueueProvider)
+		i = encodeVarintDomain(dAtA, i, uint64(len(m.QueueProvider)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDomain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDomain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AsyncWorkflowConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.QueueProvider)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	l = len(m.QueueTopic)
+	if l > 0 {
+		n += 1 + l + sovDomain(uint64(l))
+	}
+	if m.Rps != 0 {
+		n += 1 + sovDomain(uint64(m.Rps))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovDomain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDomain(x uint64) (n int) {
+	return sovDomain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AsyncWorkflowConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AsyncWorkflowConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AsyncWorkflowConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueTopic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueueTopic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rps", wireType)
+			}
+			m.Rps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Rps |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDomain(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDomain
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDomain
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDomain
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDomain        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDomain          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDomain = fmt.Errorf("proto: unexpected end of group")
+)
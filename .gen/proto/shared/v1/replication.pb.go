@@ -91,6 +91,34 @@ func (ReplicationTaskType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_00df2ec6c2eaefe5, []int{0}
 }
 
+type CompressionType int32
+
+const (
+	CompressionType_COMPRESSION_TYPE_INVALID CompressionType = 0
+	CompressionType_COMPRESSION_TYPE_SNAPPY  CompressionType = 1
+	CompressionType_COMPRESSION_TYPE_ZSTD    CompressionType = 2
+)
+
+var CompressionType_name = map[int32]string{
+	0: "COMPRESSION_TYPE_INVALID",
+	1: "COMPRESSION_TYPE_SNAPPY",
+	2: "COMPRESSION_TYPE_ZSTD",
+}
+
+var CompressionType_value = map[string]int32{
+	"COMPRESSION_TYPE_INVALID": 0,
+	"COMPRESSION_TYPE_SNAPPY":  1,
+	"COMPRESSION_TYPE_ZSTD":    2,
+}
+
+func (x CompressionType) String() string {
+	return proto.EnumName(CompressionType_name, int32(x))
+}
+
+func (CompressionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{1}
+}
+
 type DomainOperation int32
 
 const (
@@ -116,7 +144,7 @@ func (x DomainOperation) String() string {
 }
 
 func (DomainOperation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00df2ec6c2eaefe5, []int{1}
+	return fileDescriptor_00df2ec6c2eaefe5, []int{2}
 }
 
 type DLQType int32
@@ -144,7 +172,7 @@ func (x DLQType) String() string {
 }
 
 func (DLQType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_00df2ec6c2eaefe5, []int{2}
+	return fileDescriptor_00df2ec6c2eaefe5, []int{3}
 }
 
 type ReplicationMessages struct {
@@ -379,9 +407,11 @@ type DomainTaskAttributes struct {
 	ConfigVersion           int64           `protobuf:"varint,4,opt,name=config_version,json=configVersion,proto3" json:"config_version,omitempty"`
 	FailoverVersion         int64           `protobuf:"varint,5,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
 	PreviousFailoverVersion int64           `protobuf:"varint,6,opt,name=previous_failover_version,json=previousFailoverVersion,proto3" json:"previous_failover_version,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{}        `json:"-"`
-	XXX_unrecognized        []byte          `json:"-"`
-	XXX_sizecache           int32           `json:"-"`
+	// async_workflow_config is replicated next to the domain as the public API has no field for it
+	AsyncWorkflowConfig  *AsyncWorkflowConfiguration `protobuf:"bytes,7,opt,name=async_workflow_config,json=asyncWorkflowConfig,proto3" json:"async_workflow_config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
 func (m *DomainTaskAttributes) Reset()         { *m = DomainTaskAttributes{} }
@@ -459,6 +489,13 @@ func (m *DomainTaskAttributes) GetPreviousFailoverVersion() int64 {
 	return 0
 }
 
+func (m *DomainTaskAttributes) GetAsyncWorkflowConfig() *AsyncWorkflowConfiguration {
+	if m != nil {
+		return m.AsyncWorkflowConfig
+	}
+	return nil
+}
+
 type SyncShardStatusTaskAttributes struct {
 	SourceCluster        string           `protobuf:"bytes,1,opt,name=source_cluster,json=sourceCluster,proto3" json:"source_cluster,omitempty"`
 	ShardId              int32            `protobuf:"varint,2,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
@@ -672,10 +709,12 @@ type HistoryTaskV2Attributes struct {
 	VersionHistoryItems []*VersionHistoryItem `protobuf:"bytes,4,rep,name=version_history_items,json=versionHistoryItems,proto3" json:"version_history_items,omitempty"`
 	Events              *v1.DataBlob          `protobuf:"bytes,5,opt,name=events,proto3" json:"events,omitempty"`
 	// New run events does not need version history since there is no prior events.
-	NewRunEvents         *v1.DataBlob `protobuf:"bytes,6,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	NewRunEvents *v1.DataBlob `protobuf:"bytes,6,opt,name=new_run_events,json=newRunEvents,proto3" json:"new_run_events,omitempty"`
+	// Compression of the data of events and new_run_events, not set if they are not compressed.
+	Compression          CompressionType `protobuf:"varint,7,opt,name=compression,proto3,enum=uber.cadence.shared.v1.CompressionType" json:"compression,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *HistoryTaskV2Attributes) Reset()         { *m = HistoryTaskV2Attributes{} }
@@ -753,6 +792,13 @@ func (m *HistoryTaskV2Attributes) GetNewRunEvents() *v1.DataBlob {
 	return nil
 }
 
+func (m *HistoryTaskV2Attributes) GetCompression() CompressionType {
+	if m != nil {
+		return m.Compression
+	}
+	return CompressionType_COMPRESSION_TYPE_INVALID
+}
+
 type FailoverMarkerAttributes struct {
 	DomainId             string           `protobuf:"bytes,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
 	FailoverVersion      int64            `protobuf:"varint,2,opt,name=failover_version,json=failoverVersion,proto3" json:"failover_version,omitempty"`
@@ -1087,8 +1133,89 @@ func (m *SyncShardStatus) GetTimestamp() *types.Timestamp {
 	return nil
 }
 
+type ReplicationAckState struct {
+	ClusterName string `protobuf:"bytes,1,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	AckLevel    int64  `protobuf:"varint,2,opt,name=ack_level,json=ackLevel,proto3" json:"ack_level,omitempty"`
+	// last_ack_time is not set when the cluster did not poll since the shard was loaded.
+	LastAckTime          *types.Timestamp `protobuf:"bytes,3,opt,name=last_ack_time,json=lastAckTime,proto3" json:"last_ack_time,omitempty"`
+	PendingTasks         int64            `protobuf:"varint,4,opt,name=pending_tasks,json=pendingTasks,proto3" json:"pending_tasks,omitempty"`
+	PendingTasksCapped   bool             `protobuf:"varint,5,opt,name=pending_tasks_capped,json=pendingTasksCapped,proto3" json:"pending_tasks_capped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReplicationAckState) Reset()         { *m = ReplicationAckState{} }
+func (m *ReplicationAckState) String() string { return proto.CompactTextString(m) }
+func (*ReplicationAckState) ProtoMessage()    {}
+func (*ReplicationAckState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00df2ec6c2eaefe5, []int{11}
+}
+func (m *ReplicationAckState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplicationAckState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplicationAckState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplicationAckState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationAckState.Merge(m, src)
+}
+func (m *ReplicationAckState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplicationAckState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationAckState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationAckState proto.InternalMessageInfo
+
+func (m *ReplicationAckState) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *ReplicationAckState) GetAckLevel() int64 {
+	if m != nil {
+		return m.AckLevel
+	}
+	return 0
+}
+
+func (m *ReplicationAckState) GetLastAckTime() *types.Timestamp {
+	if m != nil {
+		return m.LastAckTime
+	}
+	return nil
+}
+
+func (m *ReplicationAckState) GetPendingTasks() int64 {
+	if m != nil {
+		return m.PendingTasks
+	}
+	return 0
+}
+
+func (m *ReplicationAckState) GetPendingTasksCapped() bool {
+	if m != nil {
+		return m.PendingTasksCapped
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("uber.cadence.shared.v1.ReplicationTaskType", ReplicationTaskType_name, ReplicationTaskType_value)
+	proto.RegisterEnum("uber.cadence.shared.v1.CompressionType", CompressionType_name, CompressionType_value)
 	proto.RegisterEnum("uber.cadence.shared.v1.DomainOperation", DomainOperation_name, DomainOperation_value)
 	proto.RegisterEnum("uber.cadence.shared.v1.DLQType", DLQType_name, DLQType_value)
 	proto.RegisterType((*ReplicationMessages)(nil), "uber.cadence.shared.v1.ReplicationMessages")
@@ -1102,6 +1229,7 @@ func init() {
 	proto.RegisterType((*ReplicationTaskInfo)(nil), "uber.cadence.shared.v1.ReplicationTaskInfo")
 	proto.RegisterType((*ReplicationToken)(nil), "uber.cadence.shared.v1.ReplicationToken")
 	proto.RegisterType((*SyncShardStatus)(nil), "uber.cadence.shared.v1.SyncShardStatus")
+	proto.RegisterType((*ReplicationAckState)(nil), "uber.cadence.shared.v1.ReplicationAckState")
 }
 
 func init() {
@@ -1109,102 +1237,113 @@ func init() {
 }
 
 var fileDescriptor_00df2ec6c2eaefe5 = []byte{
-	// 1506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0x1a, 0x49,
-	0x12, 0x76, 0x83, 0xf8, 0x51, 0x0a, 0x01, 0x2a, 0x69, 0x2d, 0x2c, 0x59, 0x5e, 0xcc, 0xca, 0x96,
-	0x2c, 0x6f, 0x80, 0x2d, 0x87, 0x37, 0xf6, 0x27, 0x36, 0x1c, 0x6d, 0x81, 0x42, 0xbd, 0xd6, 0x9f,
-	0x8b, 0xb6, 0x1c, 0xda, 0xc3, 0x74, 0xb4, 0xe8, 0x92, 0xe8, 0x10, 0x74, 0x13, 0x5d, 0x05, 0x32,
-	0xc7, 0x99, 0xfb, 0x1c, 0x67, 0x2e, 0x73, 0x9c, 0xe7, 0x98, 0xeb, 0xc4, 0x1c, 0xfd, 0x08, 0x13,
-	0x7e, 0x92, 0x89, 0xae, 0xaa, 0x06, 0x1a, 0x1a, 0x2c, 0x87, 0x0f, 0x73, 0x53, 0x67, 0x7e, 0x5f,
-	0x66, 0x56, 0x66, 0x56, 0x66, 0x09, 0xd8, 0xee, 0x5e, 0x10, 0xaf, 0xd2, 0x30, 0x2d, 0xe2, 0x34,
-	0x48, 0x85, 0x36, 0x4d, 0x8f, 0x58, 0x95, 0xde, 0xf3, 0x8a, 0x47, 0x3a, 0x2d, 0xbb, 0x61, 0x32,
-	0xdb, 0x75, 0xca, 0x1d, 0xcf, 0x65, 0x2e, 0xba, 0xeb, 0x23, 0xcb, 0x12, 0x59, 0x16, 0xc8, 0x72,
-	0xef, 0xf9, 0xda, 0x5f, 0xaf, 0x5c, 0xf7, 0xaa, 0x45, 0x2a, 0x1c, 0x75, 0xd1, 0xbd, 0xac, 0x30,
-	0xbb, 0x4d, 0x28, 0x33, 0xdb, 0x1d, 0x41, 0x5c, 0x2b, 0x86, 0x5c, 0x98, 0x1d, 0xdb, 0xb7, 0xdf,
-	0x70, 0xdb, 0xed, 0xc0, 0x74, 0x34, 0xc2, 0x72, 0xdb, 0xa6, 0x1d, 0x20, 0x36, 0xa7, 0x84, 0xd9,
-	0xb4, 0x29, 0x73, 0xbd, 0xbe, 0x40, 0x95, 0x7e, 0x8c, 0xc1, 0x32, 0x1e, 0x06, 0x7e, 0x44, 0x28,
-	0x35, 0xaf, 0x08, 0x45, 0x3a, 0x2c, 0x8d, 0x9c, 0xc7, 0x60, 0x26, 0xbd, 0xa6, 0x05, 0xa5, 0x18,
-	0xdf, 0x5e, 0xd8, 0xdd, 0x2a, 0x47, 0x1f, 0xab, 0x3c, 0x62, 0x47, 0x37, 0xe9, 0x35, 0xce, 0x7b,
-	0x61, 0x01, 0x45, 0xff, 0x82, 0x7b, 0x2d, 0x93, 0x32, 0xc3, 0x23, 0xcc, 0xb3, 0x49, 0x8f, 0x58,
-	0x46, 0x5b, 0x38, 0x34, 0x6c, 0xab, 0x10, 0x2b, 0x2a, 0xdb, 0x71, 0x7c, 0xd7, 0x07, 0xe0, 0x40,
-	0x2f, 0xe3, 0xd1, 0x2c, 0x74, 0x0f, 0xd2, 0x4d, 0x93, 0x1a, 0x6d, 0xd7, 0x23, 0x85, 0x78, 0x51,
-	0xd9, 0x4e, 0xe3, 0x54, 0xd3, 0xa4, 0x47, 0xae, 0x47, 0x50, 0x1d, 0x96, 0x68, 0xdf, 0x69, 0x18,
-	0x7e, 0x24, 0x96, 0x41, 0x99, 0xc9, 0xba, 0xb4, 0x30, 0x57, 0x54, 0x66, 0xc5, 0x5a, 0xef, 0x3b,
-	0x8d, 0xba, 0x8f, 0xaf, 0x73, 0x38, 0xce, 0xd1, 0xb0, 0xa0, 0xf4, 0x43, 0x12, 0x72, 0x63, 0x07,
-	0x42, 0x07, 0x30, 0xef, 0x27, 0xc2, 0x60, 0xfd, 0x0e, 0x29, 0x28, 0x45, 0x65, 0x3b, 0xbb, 0xfb,
-	0xf4, 0x96, 0xc9, 0xd0, 0xfb, 0x1d, 0x82, 0xd3, 0x4c, 0xfe, 0x85, 0x36, 0x21, 0x4b, 0xdd, 0xae,
-	0xd7, 0x20, 0x3c, 0xb3, 0xc3, 0xd3, 0x67, 0x84, 0xd4, 0x67, 0x68, 0x16, 0x7a, 0x05, 0x8b, 0x0d,
-	0x8f, 0xc8, 0x0a, 0xd8, 0x6d, 0x71, 0xf0, 0x85, 0xdd, 0xb5, 0xb2, 0xe8, 0x9f, 0x72, 0xd0, 0x3f,
-	0x65, 0x3d, 0xe8, 0x1f, 0x9c, 0x09, 0x08, 0xbe, 0x08, 0x59, 0x70, 0x57, 0xf4, 0x84, 0x70, 0x63,
-	0x32, 0xe6, 0xd9, 0x17, 0x5d, 0x46, 0x82, 0xf4, 0xfc, 0x7d, 0x5a, 0xf4, 0x55, 0xce, 0xf2, 0xc3,
-	0x50, 0x07, 0x9c, 0x83, 0x3b, 0x78, 0xc5, 0x8a, 0x90, 0xa3, 0x6f, 0x15, 0x78, 0x38, 0x51, 0x80,
-	0x09, 0x8f, 0x09, 0xee, 0xf1, 0xe5, 0x2d, 0x0b, 0x32, 0xe1, 0x7a, 0x83, 0xce, 0x02, 0xa0, 0x1b,
-	0xe0, 0x00, 0xc3, 0x6c, 0x30, 0xbb, 0x67, 0xb3, 0xfe, 0x84, 0xfb, 0x24, 0x77, 0xbf, 0x3b, 0xcb,
-	0xbd, 0x2a, 0xb9, 0x13, 0xbe, 0xd7, 0xe8, 0x54, 0x2d, 0x72, 0x60, 0x4d, 0xde, 0x28, 0xe1, 0xb2,
-	0xb7, 0x3b, 0xea, 0x35, 0xc5, 0xbd, 0x56, 0xa6, 0x79, 0x3d, 0x10, 0x4c, 0xdf, 0xe4, 0xd9, 0x6e,
-	0xc8, 0xe5, 0x6a, 0x33, 0x5a, 0x85, 0x3a, 0xb0, 0x76, 0x69, 0xda, 0x2d, 0xb7, 0x47, 0x3c, 0xa3,
-	0x6d, 0x7a, 0xd7, 0xc4, 0x1b, 0xf5, 0x97, 0xe6, 0xfe, 0x9e, 0x4d, 0xf3, 0xb7, 0x2f, 0x99, 0x47,
-	0x9c, 0x18, 0x72, 0x58, 0xb8, 0x9c, 0xa2, 0x7b, 0x9d, 0x01, 0x18, 0x7a, 0x28, 0xfd, 0x12, 0x83,
-	0x95, 0xa8, 0xee, 0x40, 0x18, 0xf2, 0xb2, 0xd7, 0xdc, 0x0e, 0xf1, 0x78, 0x0f, 0xca, 0x3b, 0xb2,
-	0x35, 0xbb, 0xcb, 0x4e, 0x02, 0x38, 0xce, 0x59, 0x61, 0x01, 0xca, 0x42, 0x4c, 0x5e, 0x8d, 0x79,
-	0x1c, 0xb3, 0x2d, 0xf4, 0x02, 0x92, 0x02, 0x22, 0x6f, 0xc2, 0x7a, 0xd8, 0xb2, 0xd9, 0xb1, 0x87,
-	0x66, 0xb1, 0x84, 0xa2, 0x47, 0x90, 0x6d, 0xb8, 0xce, 0xa5, 0x7d, 0x65, 0xf4, 0x88, 0x47, 0xfd,
-	0xb0, 0xe6, 0xf8, 0x5d, 0x5b, 0x14, 0xd2, 0x33, 0x21, 0x44, 0x4f, 0x20, 0x3f, 0x48, 0x6c, 0x00,
-	0x4c, 0x70, 0x60, 0x2e, 0x90, 0x07, 0xd0, 0x7f, 0xc3, 0xbd, 0x8e, 0x47, 0x7a, 0xb6, 0xdb, 0xa5,
-	0xc6, 0x04, 0x27, 0xc9, 0x39, 0xab, 0x01, 0x60, 0x3f, 0xcc, 0x2d, 0xfd, 0xa4, 0xc0, 0xc6, 0xcc,
-	0x5e, 0xf7, 0xe3, 0x95, 0xb3, 0xa1, 0xd1, 0xea, 0x52, 0x46, 0x3c, 0x9e, 0xc6, 0x79, 0xbc, 0x28,
-	0xa4, 0x7b, 0x42, 0xe8, 0x0f, 0x44, 0x71, 0xdf, 0x64, 0x86, 0x12, 0x38, 0xc5, 0xbf, 0x35, 0x0b,
-	0xfd, 0x13, 0xe6, 0x07, 0x1b, 0xe5, 0x16, 0x33, 0x63, 0x08, 0x2e, 0x7d, 0x4c, 0xc0, 0xda, 0xf4,
-	0xab, 0x80, 0xd6, 0x61, 0x5e, 0xd6, 0xd8, 0xb6, 0x64, 0x54, 0x69, 0x21, 0xd0, 0x2c, 0xf4, 0x0e,
-	0xd0, 0x8d, 0xeb, 0x5d, 0x5f, 0xb6, 0xdc, 0x1b, 0x83, 0x7c, 0x20, 0x8d, 0x2e, 0x6f, 0x81, 0x18,
-	0x77, 0xff, 0x38, 0xb2, 0x50, 0xef, 0x25, 0xbc, 0x16, 0xa0, 0xf1, 0xd2, 0xcd, 0xb8, 0x08, 0x15,
-	0x20, 0x15, 0xa4, 0x36, 0xce, 0x53, 0x1b, 0x7c, 0xa2, 0x87, 0x90, 0xa1, 0x8d, 0x26, 0xb1, 0xba,
-	0x2d, 0xc2, 0xb3, 0x20, 0xca, 0xba, 0x30, 0x90, 0x69, 0x16, 0x52, 0x21, 0x3b, 0x84, 0xf0, 0x11,
-	0x9a, 0xf8, 0x6c, 0x3a, 0x16, 0x07, 0x0c, 0x3e, 0x43, 0x37, 0x00, 0x28, 0x33, 0x3d, 0x26, 0x7c,
-	0x88, 0xea, 0xce, 0x4b, 0x89, 0x66, 0xa1, 0xff, 0x42, 0x26, 0x50, 0x73, 0xfb, 0xa9, 0xcf, 0xda,
-	0x5f, 0x90, 0x78, 0x6e, 0xfd, 0x7f, 0xb0, 0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26,
-	0x13, 0x56, 0xd2, 0x9f, 0xb5, 0xb2, 0xe4, 0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x0f, 0x48, 0x59,
-	0x84, 0x99, 0x76, 0x8b, 0x16, 0xe6, 0x39, 0xff, 0x7e, 0x64, 0xd6, 0x4f, 0xcd, 0x7e, 0xcb, 0x35,
-	0x2d, 0x1c, 0x80, 0xfd, 0x0c, 0x9b, 0x8c, 0x91, 0x76, 0x87, 0x15, 0x40, 0x34, 0x92, 0xfc, 0x44,
-	0xaf, 0x20, 0xc3, 0xa3, 0xf3, 0x9b, 0xbc, 0xeb, 0x91, 0xc2, 0xc2, 0x0c, 0xb3, 0xfb, 0x02, 0x83,
-	0x17, 0x7c, 0x86, 0xfc, 0x40, 0xcf, 0x60, 0x85, 0x1b, 0xf0, 0xcb, 0x4a, 0x3c, 0xc3, 0xb6, 0x88,
-	0xc3, 0x6c, 0xd6, 0x2f, 0x64, 0x78, 0xef, 0x20, 0x5f, 0xf7, 0x9e, 0xab, 0x34, 0xa9, 0x41, 0x27,
-	0x90, 0x93, 0xf5, 0x35, 0xe4, 0x08, 0x2c, 0x2c, 0x46, 0xb5, 0xd0, 0x70, 0x8a, 0xc8, 0x9b, 0x25,
-	0x67, 0x29, 0xce, 0xf6, 0x42, 0xdf, 0xa5, 0xef, 0xe2, 0xb0, 0x3a, 0x65, 0xce, 0xa2, 0x55, 0x48,
-	0x05, 0xfb, 0x57, 0xe1, 0x85, 0x4d, 0x32, 0xb1, 0x79, 0x43, 0x8d, 0x1e, 0xbb, 0x55, 0xa3, 0xc7,
-	0xbf, 0xb6, 0xd1, 0xbf, 0x81, 0xbf, 0x8c, 0x9d, 0xdc, 0xb0, 0x19, 0x69, 0xfb, 0xbb, 0xda, 0x7f,
-	0x76, 0xed, 0xdc, 0xee, 0xfc, 0x1a, 0x23, 0x6d, 0xbc, 0xdc, 0x9b, 0x90, 0x51, 0xf4, 0x12, 0x92,
-	0xa4, 0x47, 0x1c, 0x16, 0xac, 0xe2, 0x8d, 0xe8, 0xe1, 0x69, 0x32, 0xf3, 0x75, 0xcb, 0xbd, 0xc0,
-	0x12, 0x8c, 0xf6, 0x20, 0xeb, 0x90, 0x1b, 0xc3, 0xeb, 0x3a, 0x86, 0xa4, 0x27, 0x6f, 0x43, 0xcf,
-	0x38, 0xe4, 0x06, 0x77, 0x9d, 0x1a, 0xa7, 0x94, 0x7e, 0x56, 0xa0, 0x30, 0x6d, 0xf9, 0xcc, 0x9e,
-	0x2a, 0x51, 0x63, 0x39, 0x16, 0x3d, 0x96, 0xbf, 0xf6, 0xb9, 0x54, 0xfa, 0x5e, 0x81, 0xe5, 0x70,
-	0x94, 0xba, 0x7b, 0x4d, 0x1c, 0x3f, 0xc0, 0x60, 0xd4, 0x8a, 0x47, 0x70, 0x02, 0xa7, 0xe5, 0xac,
-	0xa5, 0xe8, 0x1c, 0x72, 0x63, 0x0b, 0x59, 0xce, 0xbc, 0x2f, 0xde, 0xc2, 0x38, 0x1b, 0xde, 0xc1,
-	0xa5, 0x5f, 0xc3, 0x8f, 0x73, 0xfe, 0x2a, 0x74, 0x2e, 0xdd, 0x3f, 0x65, 0x0c, 0xaf, 0x8f, 0xbe,
-	0x7d, 0xe3, 0x7c, 0x4c, 0x0c, 0x9f, 0xb3, 0x23, 0xf7, 0x68, 0x2e, 0x74, 0x8f, 0x46, 0x86, 0x77,
-	0x22, 0x3c, 0xbc, 0x37, 0x21, 0x7b, 0x69, 0x7b, 0x94, 0x89, 0xa6, 0x1a, 0x8e, 0xd6, 0x0c, 0x97,
-	0xf2, 0xb6, 0xd1, 0x2c, 0x54, 0x82, 0x45, 0x87, 0x7c, 0x18, 0x01, 0xa5, 0xc4, 0x8c, 0xf7, 0x85,
-	0x01, 0x66, 0x7c, 0x0d, 0xa4, 0x27, 0xd6, 0x80, 0xdf, 0x7e, 0xf9, 0xd1, 0x44, 0xf2, 0xaa, 0x8e,
-	0x2e, 0x50, 0x25, 0xbc, 0x40, 0xbf, 0xe2, 0xff, 0x94, 0x80, 0xda, 0xf1, 0xdc, 0x06, 0xa1, 0x34,
-	0x4c, 0x8d, 0x0f, 0xa9, 0xa7, 0x81, 0x7e, 0x40, 0x2d, 0xbd, 0x81, 0xdc, 0xd8, 0xcb, 0x20, 0xbc,
-	0xc9, 0x95, 0x2f, 0xd8, 0xe4, 0x3b, 0x1f, 0x27, 0x7b, 0x87, 0x97, 0xea, 0x21, 0x6c, 0xe0, 0xda,
-	0xe9, 0xa1, 0xb6, 0xa7, 0xea, 0xda, 0xc9, 0xb1, 0xa1, 0xab, 0xf5, 0x37, 0x86, 0x7e, 0x7e, 0x5a,
-	0x33, 0xb4, 0xe3, 0x33, 0xf5, 0x50, 0xab, 0xe6, 0xef, 0xa0, 0x22, 0xdc, 0x8f, 0x86, 0x54, 0x4f,
-	0x8e, 0x54, 0xed, 0x38, 0xaf, 0x4c, 0x37, 0x72, 0xa0, 0xd5, 0xf5, 0x13, 0x7c, 0x9e, 0x8f, 0xa1,
-	0xa7, 0xb0, 0x15, 0x0d, 0xa9, 0x9f, 0x1f, 0xef, 0x19, 0xf5, 0x03, 0x15, 0x57, 0x8d, 0xba, 0xae,
-	0xea, 0xef, 0xea, 0xf9, 0x38, 0xda, 0x82, 0xbf, 0xcd, 0x00, 0xab, 0x7b, 0xba, 0x76, 0xa6, 0xe9,
-	0xe7, 0xf9, 0x39, 0xb4, 0x03, 0x8f, 0x67, 0x3a, 0x36, 0x8e, 0x6a, 0xba, 0x5a, 0x55, 0x75, 0x35,
-	0x9f, 0x40, 0x9b, 0x50, 0x9c, 0x8d, 0x3d, 0xdb, 0xcd, 0x27, 0xd1, 0x13, 0x78, 0x14, 0x8d, 0xda,
-	0x57, 0xb5, 0xc3, 0x93, 0xb3, 0x1a, 0x36, 0x8e, 0x54, 0xfc, 0xa6, 0x86, 0xf3, 0xa9, 0x1d, 0x1b,
-	0x72, 0x63, 0x2f, 0x56, 0x74, 0x1f, 0x0a, 0x22, 0x29, 0xc6, 0xc9, 0x69, 0x0d, 0x0b, 0x13, 0xc3,
-	0x44, 0xae, 0xc3, 0xea, 0x84, 0x76, 0x0f, 0xd7, 0x54, 0xbd, 0x96, 0x57, 0x22, 0x95, 0xef, 0x4e,
-	0xab, 0xbe, 0x32, 0xb6, 0x73, 0x0c, 0xa9, 0xea, 0xe1, 0x5b, 0x5e, 0xb0, 0x15, 0xc8, 0x57, 0x0f,
-	0xdf, 0x8e, 0xd7, 0xa8, 0x00, 0x2b, 0x03, 0xe9, 0x48, 0xfc, 0x79, 0x05, 0x2d, 0x43, 0x6e, 0xa0,
-	0x91, 0x05, 0x8b, 0xbd, 0xde, 0xfb, 0xed, 0xd3, 0x03, 0xe5, 0xe3, 0xa7, 0x07, 0xca, 0xef, 0x9f,
-	0x1e, 0x28, 0xff, 0x7f, 0x79, 0x65, 0xb3, 0x66, 0xf7, 0xa2, 0xdc, 0x70, 0xdb, 0x95, 0xd0, 0xaf,
-	0x04, 0xe5, 0x2b, 0xe2, 0x88, 0x5f, 0x25, 0x86, 0x3f, 0x18, 0xfc, 0x47, 0xfc, 0xd5, 0x7b, 0x7e,
-	0x91, 0xe4, 0x9a, 0x17, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc8, 0x16, 0xd1, 0xe8, 0x01, 0x11,
-	0x00, 0x00,
+	// 1695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0xdb, 0xc8,
+	0x11, 0x5e, 0x90, 0x16, 0x49, 0xb5, 0x28, 0x12, 0x1e, 0xc9, 0x16, 0x2c, 0x5b, 0x8e, 0x4c, 0x7b,
+	0xd7, 0x5a, 0x6d, 0x8a, 0xb2, 0xb5, 0xe5, 0x54, 0x7e, 0x2a, 0xd9, 0x82, 0x49, 0xba, 0x84, 0x58,
+	0x3f, 0xdc, 0x21, 0xac, 0x2d, 0xed, 0x21, 0xa8, 0x11, 0x30, 0x92, 0x50, 0x24, 0x01, 0x16, 0x30,
+	0xa4, 0xac, 0x63, 0x1e, 0x20, 0xc7, 0xe4, 0x92, 0x63, 0x2a, 0xaf, 0x92, 0xca, 0x25, 0x55, 0x7e,
+	0x84, 0x94, 0xcf, 0x79, 0x88, 0xd4, 0xfc, 0x80, 0x24, 0xf8, 0x67, 0x25, 0x3e, 0xec, 0x8d, 0xd3,
+	0xfd, 0x75, 0xf7, 0x4c, 0xcf, 0xd7, 0xd3, 0x0d, 0xc2, 0x4e, 0xff, 0x9c, 0x46, 0x7b, 0x2e, 0xf1,
+	0x68, 0xe0, 0xd2, 0xbd, 0xf8, 0x8a, 0x44, 0xd4, 0xdb, 0x1b, 0xbc, 0xdc, 0x8b, 0x68, 0xaf, 0xe3,
+	0xbb, 0x84, 0xf9, 0x61, 0x50, 0xed, 0x45, 0x21, 0x0b, 0xd1, 0x7d, 0x8e, 0xac, 0x2a, 0x64, 0x55,
+	0x22, 0xab, 0x83, 0x97, 0x9b, 0x3f, 0xbb, 0x0c, 0xc3, 0xcb, 0x0e, 0xdd, 0x13, 0xa8, 0xf3, 0xfe,
+	0xc5, 0x1e, 0xf3, 0xbb, 0x34, 0x66, 0xa4, 0xdb, 0x93, 0x86, 0x9b, 0xdb, 0xa9, 0x10, 0xa4, 0xe7,
+	0x73, 0xff, 0x6e, 0xd8, 0xed, 0x86, 0xc1, 0x22, 0x84, 0x17, 0x76, 0x89, 0x9f, 0x20, 0x9e, 0xce,
+	0xd9, 0x66, 0x0a, 0xf4, 0x6c, 0x0e, 0xe8, 0xca, 0x8f, 0x59, 0x18, 0xdd, 0x48, 0x54, 0xe5, 0x2f,
+	0x19, 0x58, 0xc3, 0xa3, 0xd3, 0x1d, 0xd1, 0x38, 0x26, 0x97, 0x34, 0x46, 0x36, 0xdc, 0x1d, 0x3b,
+	0xb4, 0xc3, 0x48, 0xdc, 0x8e, 0x0d, 0x6d, 0x3b, 0xbb, 0xb3, 0xb2, 0xff, 0xbc, 0x3a, 0xfb, 0xec,
+	0xd5, 0x31, 0x3f, 0x36, 0x89, 0xdb, 0x58, 0x8f, 0xd2, 0x82, 0x18, 0xfd, 0x0a, 0x1e, 0x74, 0x48,
+	0xcc, 0x9c, 0x88, 0xb2, 0xc8, 0xa7, 0x03, 0xea, 0x39, 0x5d, 0x19, 0xd0, 0xf1, 0x3d, 0x23, 0xb3,
+	0xad, 0xed, 0x64, 0xf1, 0x7d, 0x0e, 0xc0, 0x89, 0x5e, 0xed, 0xc7, 0xf2, 0xd0, 0x03, 0x28, 0x5c,
+	0x91, 0xd8, 0xe9, 0x86, 0x11, 0x35, 0xb2, 0xdb, 0xda, 0x4e, 0x01, 0xe7, 0xaf, 0x48, 0x7c, 0x14,
+	0x46, 0x14, 0xb5, 0xe0, 0x6e, 0x7c, 0x13, 0xb8, 0x0e, 0xdf, 0x89, 0xe7, 0xc4, 0x8c, 0xb0, 0x7e,
+	0x6c, 0xdc, 0xd9, 0xd6, 0x16, 0xed, 0xb5, 0x75, 0x13, 0xb8, 0x2d, 0x8e, 0x6f, 0x09, 0x38, 0x2e,
+	0xc7, 0x69, 0x41, 0xe5, 0xcf, 0x39, 0x28, 0x4f, 0x1c, 0x08, 0x1d, 0xc0, 0x32, 0x4f, 0x84, 0xc3,
+	0x6e, 0x7a, 0xd4, 0xd0, 0xb6, 0xb5, 0x9d, 0xd2, 0xfe, 0x37, 0xb7, 0x4c, 0x86, 0x7d, 0xd3, 0xa3,
+	0xb8, 0xc0, 0xd4, 0x2f, 0xf4, 0x0c, 0x4a, 0x71, 0xd8, 0x8f, 0x5c, 0x2a, 0x32, 0x3b, 0x3a, 0x7d,
+	0x51, 0x4a, 0xb9, 0x85, 0xe5, 0xa1, 0xef, 0x60, 0xd5, 0x8d, 0xa8, 0xba, 0x01, 0xbf, 0x2b, 0x0f,
+	0xbe, 0xb2, 0xbf, 0x59, 0x95, 0x24, 0xab, 0x26, 0x24, 0xab, 0xda, 0x09, 0xc9, 0x70, 0x31, 0x31,
+	0xe0, 0x22, 0xe4, 0xc1, 0x7d, 0xc9, 0x09, 0x19, 0x86, 0x30, 0x16, 0xf9, 0xe7, 0x7d, 0x46, 0x93,
+	0xf4, 0xfc, 0x7c, 0xde, 0xee, 0xeb, 0xc2, 0x8a, 0x6f, 0xc3, 0x1c, 0xda, 0x1c, 0x7c, 0x81, 0xd7,
+	0xbd, 0x19, 0x72, 0xf4, 0x47, 0x0d, 0x9e, 0x4c, 0x5d, 0xc0, 0x54, 0xc4, 0x25, 0x11, 0xf1, 0xd5,
+	0x2d, 0x2f, 0x64, 0x2a, 0xf4, 0x56, 0xbc, 0x08, 0x80, 0xae, 0x41, 0x00, 0x1c, 0xe2, 0x32, 0x7f,
+	0xe0, 0xb3, 0x9b, 0xa9, 0xf0, 0x39, 0x11, 0x7e, 0x7f, 0x51, 0x78, 0x53, 0xd9, 0x4e, 0xc5, 0xde,
+	0x8c, 0xe7, 0x6a, 0x51, 0x00, 0x9b, 0xaa, 0xa2, 0x64, 0xc8, 0xc1, 0xfe, 0x78, 0xd4, 0xbc, 0x88,
+	0xba, 0x37, 0x2f, 0xea, 0x81, 0xb4, 0xe4, 0x2e, 0x4f, 0xf7, 0x53, 0x21, 0x37, 0xae, 0x66, 0xab,
+	0x50, 0x0f, 0x36, 0x2f, 0x88, 0xdf, 0x09, 0x07, 0x34, 0x72, 0xba, 0x24, 0x6a, 0xd3, 0x68, 0x3c,
+	0x5e, 0x41, 0xc4, 0x7b, 0x31, 0x2f, 0xde, 0x1b, 0x65, 0x79, 0x24, 0x0c, 0x53, 0x01, 0x8d, 0x8b,
+	0x39, 0xba, 0xd7, 0x45, 0x80, 0x51, 0x84, 0xca, 0xdf, 0xb3, 0xb0, 0x3e, 0x8b, 0x1d, 0x08, 0x83,
+	0xae, 0xb8, 0x16, 0xf6, 0x68, 0x24, 0x38, 0xa8, 0x6a, 0xe4, 0xf9, 0x62, 0x96, 0x9d, 0x24, 0x70,
+	0x5c, 0xf6, 0xd2, 0x02, 0x54, 0x82, 0x8c, 0x2a, 0x8d, 0x65, 0x9c, 0xf1, 0x3d, 0xf4, 0x2d, 0xe4,
+	0x24, 0x44, 0x55, 0xc2, 0xc3, 0xb4, 0x67, 0xd2, 0xf3, 0x47, 0x6e, 0xb1, 0x82, 0xa2, 0x2f, 0xa1,
+	0xe4, 0x86, 0xc1, 0x85, 0x7f, 0xe9, 0x0c, 0x68, 0x14, 0xf3, 0x6d, 0xdd, 0x11, 0xb5, 0xb6, 0x2a,
+	0xa5, 0xa7, 0x52, 0x88, 0xbe, 0x06, 0x7d, 0x98, 0xd8, 0x04, 0xb8, 0x24, 0x80, 0xe5, 0x44, 0x9e,
+	0x40, 0x7f, 0x0d, 0x0f, 0x7a, 0x11, 0x1d, 0xf8, 0x61, 0x3f, 0x76, 0xa6, 0x6c, 0x72, 0xc2, 0x66,
+	0x23, 0x01, 0xbc, 0x99, 0xb0, 0xbd, 0x80, 0x7b, 0x44, 0x30, 0xf5, 0x3a, 0x8c, 0xda, 0x17, 0x9d,
+	0xf0, 0xda, 0x91, 0xdb, 0x30, 0xf2, 0x8b, 0x09, 0x6a, 0x72, 0xa3, 0x1f, 0x94, 0x4d, 0x4d, 0x98,
+	0xf4, 0x55, 0xda, 0xd6, 0xc8, 0xb4, 0xae, 0xf2, 0x57, 0x0d, 0xb6, 0x16, 0xd6, 0x14, 0xcf, 0x8b,
+	0x7a, 0x83, 0xdc, 0x4e, 0x3f, 0x66, 0x34, 0x12, 0xd7, 0xb5, 0x8c, 0x57, 0xa5, 0xb4, 0x26, 0x85,
+	0xfc, 0xe1, 0x95, 0x75, 0xad, 0x6e, 0x62, 0x09, 0xe7, 0xc5, 0xda, 0xf2, 0xd0, 0x2f, 0x61, 0x79,
+	0xd8, 0xde, 0x6e, 0xf1, 0x36, 0x8d, 0xc0, 0x95, 0x0f, 0x4b, 0xb0, 0x39, 0xbf, 0xe4, 0xd0, 0x43,
+	0x58, 0x56, 0x5c, 0xf2, 0x3d, 0xb5, 0xab, 0x82, 0x14, 0x58, 0x1e, 0x7a, 0x07, 0x68, 0x98, 0x3b,
+	0xfa, 0x9e, 0xba, 0x7d, 0x41, 0xb5, 0x8c, 0x08, 0xff, 0xd5, 0x4c, 0x42, 0x24, 0xa9, 0x69, 0x24,
+	0x68, 0x7c, 0xf7, 0x7a, 0x52, 0x84, 0x0c, 0xc8, 0x27, 0x57, 0x98, 0x15, 0x57, 0x98, 0x2c, 0xd1,
+	0x13, 0x28, 0xc6, 0xee, 0x15, 0xf5, 0xfa, 0x1d, 0x2a, 0xb2, 0x20, 0xe9, 0xb3, 0x32, 0x94, 0x59,
+	0x1e, 0x32, 0xa1, 0x34, 0x82, 0x88, 0xa7, 0x7a, 0xe9, 0x93, 0xe9, 0x58, 0x1d, 0x5a, 0x70, 0x19,
+	0xda, 0x02, 0x88, 0x19, 0x89, 0x98, 0x8c, 0x21, 0x59, 0xb4, 0xac, 0x24, 0x96, 0x87, 0x7e, 0x0b,
+	0xc5, 0x44, 0x2d, 0xfc, 0xe7, 0x3f, 0xe9, 0x7f, 0x45, 0xe1, 0x85, 0xf7, 0xdf, 0xc3, 0x9a, 0xe8,
+	0xbc, 0x57, 0x94, 0x44, 0xec, 0x9c, 0x12, 0x26, 0xbd, 0x14, 0x3e, 0xe9, 0xe5, 0x2e, 0x37, 0x3b,
+	0x48, 0xac, 0x84, 0xaf, 0x5f, 0x40, 0xde, 0xa3, 0x8c, 0xf8, 0x9d, 0xd8, 0x58, 0x16, 0xf6, 0x8f,
+	0x66, 0x66, 0xbd, 0x49, 0x6e, 0x3a, 0x21, 0xf1, 0x70, 0x02, 0xe6, 0x19, 0x26, 0x8c, 0xd1, 0x6e,
+	0x8f, 0x19, 0x20, 0x89, 0xa4, 0x96, 0xe8, 0x3b, 0x28, 0x8a, 0xdd, 0xf1, 0x62, 0xea, 0x47, 0xd4,
+	0x58, 0x59, 0xe0, 0xf6, 0x8d, 0xc4, 0xe0, 0x15, 0x6e, 0xa1, 0x16, 0xe8, 0x05, 0xac, 0x0b, 0x07,
+	0xfc, 0x5a, 0x69, 0xe4, 0xf8, 0x1e, 0x0d, 0x98, 0xcf, 0x6e, 0x8c, 0xa2, 0xe0, 0x0e, 0xe2, 0xba,
+	0x1f, 0x84, 0xca, 0x52, 0x1a, 0x74, 0x02, 0x65, 0x75, 0xbf, 0x8e, 0x7a, 0x6a, 0x8d, 0xd5, 0x59,
+	0x14, 0x1a, 0x55, 0xa0, 0xaa, 0x60, 0xf5, 0x66, 0xe3, 0xd2, 0x20, 0xb5, 0xae, 0xfc, 0x2b, 0x0b,
+	0x1b, 0x73, 0xde, 0x73, 0xb4, 0x01, 0xf9, 0xa4, 0xcf, 0x6b, 0xe2, 0x62, 0x73, 0x4c, 0x76, 0xf8,
+	0x14, 0xd1, 0x33, 0xb7, 0x22, 0x7a, 0xf6, 0x73, 0x89, 0xfe, 0x07, 0xb8, 0x37, 0x71, 0x72, 0xc7,
+	0x67, 0xb4, 0xcb, 0x67, 0x02, 0x3e, 0xde, 0xed, 0xde, 0xee, 0xfc, 0x16, 0xa3, 0x5d, 0xbc, 0x36,
+	0x98, 0x92, 0xc5, 0xe8, 0x15, 0xe4, 0xe8, 0x80, 0x06, 0x2c, 0x69, 0xf9, 0x5b, 0xb3, 0x1f, 0x69,
+	0xc2, 0xc8, 0xeb, 0x4e, 0x78, 0x8e, 0x15, 0x18, 0xd5, 0xa0, 0x14, 0xd0, 0x6b, 0x27, 0xea, 0x07,
+	0x8e, 0x32, 0xcf, 0xdd, 0xc6, 0xbc, 0x18, 0xd0, 0x6b, 0xdc, 0x0f, 0x1a, 0xd2, 0x89, 0x05, 0x2b,
+	0x6e, 0xd8, 0xed, 0x45, 0x34, 0x16, 0x85, 0x9c, 0x5f, 0xdc, 0x7f, 0x6a, 0x23, 0xa8, 0x98, 0xcf,
+	0xc6, 0x6d, 0x2b, 0x7f, 0xd3, 0xc0, 0x98, 0xd7, 0x2f, 0x17, 0x3f, 0x50, 0xb3, 0x3a, 0x49, 0x66,
+	0x76, 0x27, 0xf9, 0xdc, 0x09, 0xaf, 0xf2, 0x27, 0x0d, 0xd6, 0xd2, 0xbb, 0xb4, 0xc3, 0x36, 0x0d,
+	0xf8, 0x06, 0x93, 0x57, 0x5b, 0xce, 0xed, 0x4b, 0xb8, 0xa0, 0x9e, 0xed, 0x18, 0x9d, 0x41, 0x79,
+	0x62, 0x86, 0x30, 0x32, 0xff, 0xdf, 0xe0, 0x80, 0x4b, 0xe9, 0xb1, 0xa1, 0xf2, 0x8f, 0xf4, 0xf7,
+	0x84, 0x18, 0x64, 0x83, 0x8b, 0xf0, 0x27, 0x79, 0xd1, 0x1f, 0x8e, 0x8f, 0xeb, 0x59, 0xf1, 0xe2,
+	0x8c, 0x26, 0xf0, 0xb1, 0x92, 0xbc, 0x93, 0x2a, 0xc9, 0xb1, 0x3e, 0xb0, 0x94, 0xee, 0x03, 0xcf,
+	0xa0, 0x74, 0xe1, 0x47, 0x31, 0x93, 0xfc, 0x1c, 0xbd, 0xd2, 0x45, 0x21, 0x15, 0x0c, 0xb4, 0x3c,
+	0x54, 0x81, 0xd5, 0x80, 0xbe, 0x1f, 0x03, 0xe5, 0x65, 0xbb, 0xe0, 0xc2, 0x04, 0x33, 0xd9, 0x51,
+	0x0a, 0x53, 0x1d, 0x85, 0xd3, 0x4f, 0x1f, 0x4f, 0xa4, 0xb8, 0xd5, 0xf1, 0x5e, 0xac, 0xa5, 0x7b,
+	0xf1, 0x67, 0x7c, 0x5a, 0x25, 0xa6, 0xbd, 0x28, 0x74, 0x69, 0x1c, 0xa7, 0x4d, 0xb3, 0x23, 0xd3,
+	0x66, 0xa2, 0x1f, 0x9a, 0x56, 0xde, 0x42, 0x79, 0x62, 0xc8, 0x48, 0x0f, 0x05, 0xda, 0xff, 0x32,
+	0x14, 0xfc, 0x47, 0x4b, 0x71, 0xc7, 0x74, 0xdb, 0xdc, 0x25, 0xe5, 0xd9, 0x52, 0x13, 0x8a, 0x13,
+	0x90, 0x2e, 0x55, 0xf4, 0x59, 0x51, 0xb2, 0x63, 0xd2, 0xa5, 0xfc, 0xaa, 0x89, 0xdb, 0x76, 0x3a,
+	0x74, 0x40, 0x3b, 0xea, 0xb4, 0x05, 0xe2, 0xb6, 0x0f, 0xf9, 0x1a, 0xfd, 0x0e, 0x56, 0xc5, 0xf9,
+	0x38, 0xe2, 0x96, 0x45, 0x26, 0x9a, 0x8b, 0xe9, 0xb6, 0xb9, 0x04, 0x3d, 0x85, 0xd5, 0x1e, 0x0d,
+	0x3c, 0x3f, 0xb8, 0x54, 0xdf, 0xc1, 0x92, 0x30, 0x45, 0x25, 0x94, 0x9f, 0xb6, 0x2f, 0x60, 0x3d,
+	0x05, 0x72, 0x5c, 0xd2, 0xeb, 0x51, 0x4f, 0x70, 0xa8, 0x80, 0xd1, 0x38, 0xb6, 0x26, 0x34, 0xbb,
+	0x1f, 0xa6, 0x4b, 0x45, 0x30, 0xf3, 0x09, 0x6c, 0xe1, 0x46, 0xf3, 0xd0, 0xaa, 0x99, 0xb6, 0x75,
+	0x72, 0xec, 0xd8, 0x66, 0xeb, 0xad, 0x63, 0x9f, 0x35, 0x1b, 0x8e, 0x75, 0x7c, 0x6a, 0x1e, 0x5a,
+	0x75, 0xfd, 0x0b, 0xb4, 0x0d, 0x8f, 0x66, 0x43, 0xea, 0x27, 0x47, 0xa6, 0x75, 0xac, 0x6b, 0xf3,
+	0x9d, 0x1c, 0x58, 0x2d, 0xfb, 0x04, 0x9f, 0xe9, 0x19, 0xf4, 0x0d, 0x3c, 0x9f, 0x0d, 0x69, 0x9d,
+	0x1d, 0xd7, 0x9c, 0xd6, 0x81, 0x89, 0xeb, 0x4e, 0xcb, 0x36, 0xed, 0x77, 0x2d, 0x3d, 0x8b, 0x9e,
+	0xc3, 0xd3, 0x05, 0x60, 0xb3, 0x66, 0x5b, 0xa7, 0x96, 0x7d, 0xa6, 0xdf, 0x41, 0xbb, 0xf0, 0xd5,
+	0xc2, 0xc0, 0xce, 0x51, 0xc3, 0x36, 0xeb, 0xa6, 0x6d, 0xea, 0x4b, 0xe8, 0x19, 0x6c, 0x2f, 0xc6,
+	0x9e, 0xee, 0xeb, 0x39, 0xf4, 0x35, 0x7c, 0x39, 0x1b, 0xf5, 0xc6, 0xb4, 0x0e, 0x4f, 0x4e, 0x1b,
+	0xd8, 0x39, 0x32, 0xf1, 0xdb, 0x06, 0xd6, 0xf3, 0xbb, 0x97, 0x50, 0x9e, 0x78, 0xd3, 0xd1, 0x23,
+	0x30, 0x6a, 0x27, 0x47, 0x4d, 0xdc, 0x68, 0xb5, 0x84, 0x75, 0x3a, 0x91, 0x0f, 0x61, 0x63, 0x4a,
+	0xdb, 0x3a, 0x36, 0x9b, 0xcd, 0x33, 0x5d, 0x43, 0x0f, 0xe0, 0xde, 0x94, 0xf2, 0xc7, 0x96, 0x5d,
+	0xd7, 0x33, 0xbb, 0x3e, 0x94, 0x27, 0x3e, 0x5e, 0x78, 0x20, 0x99, 0x7d, 0xe7, 0xa4, 0xd9, 0xc0,
+	0x72, 0xaf, 0xa9, 0x40, 0x53, 0xda, 0x1a, 0x6e, 0x98, 0x76, 0x43, 0xd7, 0x66, 0x2a, 0xdf, 0x35,
+	0xeb, 0x5c, 0x99, 0xd9, 0x3d, 0x86, 0x7c, 0xfd, 0xf0, 0x7b, 0x71, 0x96, 0x75, 0xd0, 0xeb, 0x87,
+	0xdf, 0x4f, 0x9e, 0xc1, 0x80, 0xf5, 0xa1, 0x74, 0x2c, 0x51, 0xba, 0x86, 0xd6, 0xa0, 0x3c, 0xd4,
+	0x28, 0x66, 0x64, 0x5e, 0xd7, 0xfe, 0xf9, 0xf1, 0xb1, 0xf6, 0xe1, 0xe3, 0x63, 0xed, 0xdf, 0x1f,
+	0x1f, 0x6b, 0x3f, 0xbe, 0xba, 0xf4, 0xd9, 0x55, 0xff, 0xbc, 0xea, 0x86, 0xdd, 0xbd, 0xd4, 0x1f,
+	0x46, 0xd5, 0x4b, 0x1a, 0xc8, 0x7f, 0xb1, 0x46, 0xff, 0x1d, 0xfd, 0x46, 0xfe, 0x1a, 0xbc, 0x3c,
+	0xcf, 0x09, 0xcd, 0xb7, 0xff, 0x1d, 0x00, 0x8a, 0x73, 0x30, 0x79, 0x31, 0x13, 0x00, 0x00,
 }
 
 func (m *ReplicationMessages) Marshal() (dAtA []byte, err error) {
@@ -1462,6 +1601,18 @@ func (m *DomainTaskAttributes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.AsyncWorkflowConfig != nil {
+		{
+			size, err := m.AsyncWorkflowConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.PreviousFailoverVersion != 0 {
 		i = encodeVarintReplication(dAtA, i, uint64(m.PreviousFailoverVersion))
 		i--
@@ -1724,6 +1875,11 @@ func (m *HistoryTaskV2Attributes) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Compression != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.Compression))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.NewRunEvents != nil {
 		{
 			size, err := m.NewRunEvents.MarshalToSizedBuffer(dAtA[:i])
@@ -1877,21 +2033,21 @@ func (m *FailoverMarkerToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 	}
 	if len(m.ShardIds) > 0 {
-		dAtA23 := make([]byte, len(m.ShardIds)*10)
-		var j22 int
+		dAtA24 := make([]byte, len(m.ShardIds)*10)
+		var j23 int
 		for _, num1 := range m.ShardIds {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA24[j23] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j22++
+				j23++
 			}
-			dAtA23[j22] = uint8(num)
-			j22++
+			dAtA24[j23] = uint8(num)
+			j23++
 		}
-		i -= j22
-		copy(dAtA[i:], dAtA23[:j22])
-		i = encodeVarintReplication(dAtA, i, uint64(j22))
+		i -= j23
+		copy(dAtA[i:], dAtA24[:j23])
+		i = encodeVarintReplication(dAtA, i, uint64(j23))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2055,6 +2211,72 @@ func (m *SyncShardStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReplicationAckState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplicationAckState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplicationAckState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PendingTasksCapped {
+		i--
+		if m.PendingTasksCapped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PendingTasks != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.PendingTasks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastAckTime != nil {
+		{
+			size, err := m.LastAckTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintReplication(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AckLevel != 0 {
+		i = encodeVarintReplication(dAtA, i, uint64(m.AckLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClusterName) > 0 {
+		i -= len(m.ClusterName)
+		copy(dAtA[i:], m.ClusterName)
+		i = encodeVarintReplication(dAtA, i, uint64(len(m.ClusterName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintReplication(dAtA []byte, offset int, v uint64) int {
 	offset -= sovReplication(v)
 	base := offset
@@ -2205,6 +2427,10 @@ func (m *DomainTaskAttributes) Size() (n int) {
 	if m.PreviousFailoverVersion != 0 {
 		n += 1 + sovReplication(uint64(m.PreviousFailoverVersion))
 	}
+	if m.AsyncWorkflowConfig != nil {
+		l = m.AsyncWorkflowConfig.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2325,6 +2551,9 @@ func (m *HistoryTaskV2Attributes) Size() (n int) {
 		l = m.NewRunEvents.Size()
 		n += 1 + l + sovReplication(uint64(l))
 	}
+	if m.Compression != 0 {
+		n += 1 + sovReplication(uint64(m.Compression))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2452,6 +2681,35 @@ func (m *SyncShardStatus) Size() (n int) {
 	return n
 }
 
+func (m *ReplicationAckState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterName)
+	if l > 0 {
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.AckLevel != 0 {
+		n += 1 + sovReplication(uint64(m.AckLevel))
+	}
+	if m.LastAckTime != nil {
+		l = m.LastAckTime.Size()
+		n += 1 + l + sovReplication(uint64(l))
+	}
+	if m.PendingTasks != 0 {
+		n += 1 + sovReplication(uint64(m.PendingTasks))
+	}
+	if m.PendingTasksCapped {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovReplication(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3091,6 +3349,42 @@ func (m *DomainTaskAttributes) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AsyncWorkflowConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AsyncWorkflowConfig == nil {
+				m.AsyncWorkflowConfig = &AsyncWorkflowConfiguration{}
+			}
+			if err := m.AsyncWorkflowConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
@@ -3916,6 +4210,25 @@ func (m *HistoryTaskV2Attributes) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= CompressionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipReplication(dAtA[iNdEx:])
@@ -4667,6 +4980,183 @@ func (m *SyncShardStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ReplicationAckState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplicationAckState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplicationAckState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckLevel", wireType)
+			}
+			m.AckLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AckLevel |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAckTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReplication
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAckTime == nil {
+				m.LastAckTime = &types.Timestamp{}
+			}
+			if err := m.LastAckTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTasks", wireType)
+			}
+			m.PendingTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingTasks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingTasksCapped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PendingTasksCapped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipReplication(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
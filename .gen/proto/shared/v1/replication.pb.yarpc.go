@@ -28,99 +28,111 @@ package sharedv1
 var yarpcFileDescriptorClosure00df2ec6c2eaefe5 = [][]byte{
 	// uber/cadence/shared/v1/replication.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x73, 0x1a, 0x47,
-		0x13, 0xf6, 0x82, 0xf8, 0x50, 0x0b, 0x01, 0x1a, 0xe9, 0xb5, 0xb0, 0x64, 0xd5, 0x8b, 0x89, 0x6c,
-		0xc9, 0x72, 0x0a, 0x6c, 0xb9, 0x9c, 0xcf, 0x4a, 0xb9, 0xd6, 0x02, 0x95, 0x36, 0xd6, 0x97, 0x87,
-		0xb5, 0x5c, 0xca, 0x21, 0x5b, 0x2b, 0x76, 0x24, 0xb6, 0x04, 0xbb, 0xd4, 0xce, 0x80, 0xcc, 0x31,
-		0xb9, 0xe7, 0x98, 0x5c, 0x72, 0xcc, 0xef, 0xc8, 0x35, 0x67, 0xff, 0xa4, 0xd4, 0xce, 0xcc, 0x02,
-		0x0b, 0x0b, 0x96, 0xcb, 0x87, 0xdc, 0xb4, 0xdd, 0xcf, 0xd3, 0xdd, 0xd3, 0xdd, 0xd3, 0x3d, 0x02,
-		0xb6, 0xbb, 0x17, 0xc4, 0xab, 0x34, 0x4c, 0x8b, 0x38, 0x0d, 0x52, 0xa1, 0x4d, 0xd3, 0x23, 0x56,
-		0xa5, 0xf7, 0xac, 0xe2, 0x91, 0x4e, 0xcb, 0x6e, 0x98, 0xcc, 0x76, 0x9d, 0x72, 0xc7, 0x73, 0x99,
-		0x8b, 0xee, 0xfa, 0xc8, 0xb2, 0x44, 0x96, 0x05, 0xb2, 0xdc, 0x7b, 0xb6, 0xf6, 0xff, 0x2b, 0xd7,
-		0xbd, 0x6a, 0x91, 0x0a, 0x47, 0x5d, 0x74, 0x2f, 0x2b, 0xcc, 0x6e, 0x13, 0xca, 0xcc, 0x76, 0x47,
-		0x10, 0xd7, 0x8a, 0x21, 0x17, 0x66, 0xc7, 0xf6, 0xed, 0x37, 0xdc, 0x76, 0x3b, 0x30, 0x1d, 0x8d,
-		0xb0, 0xdc, 0xb6, 0x69, 0x07, 0x88, 0xcd, 0x29, 0x61, 0x36, 0x6d, 0xca, 0x5c, 0xaf, 0x2f, 0x50,
-		0xa5, 0x3f, 0x62, 0xb0, 0x8c, 0x87, 0x81, 0x1f, 0x11, 0x4a, 0xcd, 0x2b, 0x42, 0x91, 0x0e, 0x4b,
-		0x23, 0xe7, 0x31, 0x98, 0x49, 0xaf, 0x69, 0x41, 0x29, 0xc6, 0xb7, 0x17, 0x76, 0xb7, 0xca, 0xd1,
-		0xc7, 0x2a, 0x8f, 0xd8, 0xd1, 0x4d, 0x7a, 0x8d, 0xf3, 0x5e, 0x58, 0x40, 0xd1, 0xb7, 0x70, 0xaf,
-		0x65, 0x52, 0x66, 0x78, 0x84, 0x79, 0x36, 0xe9, 0x11, 0xcb, 0x68, 0x0b, 0x87, 0x86, 0x6d, 0x15,
-		0x62, 0x45, 0x65, 0x3b, 0x8e, 0xef, 0xfa, 0x00, 0x1c, 0xe8, 0x65, 0x3c, 0x9a, 0x85, 0xee, 0x41,
-		0xba, 0x69, 0x52, 0xa3, 0xed, 0x7a, 0xa4, 0x10, 0x2f, 0x2a, 0xdb, 0x69, 0x9c, 0x6a, 0x9a, 0xf4,
-		0xc8, 0xf5, 0x08, 0xaa, 0xc3, 0x12, 0xed, 0x3b, 0x0d, 0xc3, 0x8f, 0xc4, 0x32, 0x28, 0x33, 0x59,
-		0x97, 0x16, 0xe6, 0x8a, 0xca, 0xac, 0x58, 0xeb, 0x7d, 0xa7, 0x51, 0xf7, 0xf1, 0x75, 0x0e, 0xc7,
-		0x39, 0x1a, 0x16, 0x94, 0x7e, 0x4f, 0x42, 0x6e, 0xec, 0x40, 0xe8, 0x00, 0xe6, 0xfd, 0x44, 0x18,
-		0xac, 0xdf, 0x21, 0x05, 0xa5, 0xa8, 0x6c, 0x67, 0x77, 0x9f, 0xdc, 0x32, 0x19, 0x7a, 0xbf, 0x43,
-		0x70, 0x9a, 0xc9, 0xbf, 0xd0, 0x26, 0x64, 0xa9, 0xdb, 0xf5, 0x1a, 0x84, 0x67, 0x76, 0x78, 0xfa,
-		0x8c, 0x90, 0xfa, 0x0c, 0xcd, 0x42, 0x2f, 0x61, 0xb1, 0xe1, 0x11, 0x59, 0x01, 0xbb, 0x2d, 0x0e,
-		0xbe, 0xb0, 0xbb, 0x56, 0x16, 0xfd, 0x53, 0x0e, 0xfa, 0xa7, 0xac, 0x07, 0xfd, 0x83, 0x33, 0x01,
-		0xc1, 0x17, 0x21, 0x0b, 0xee, 0x8a, 0x9e, 0x10, 0x6e, 0x4c, 0xc6, 0x3c, 0xfb, 0xa2, 0xcb, 0x48,
-		0x90, 0x9e, 0x2f, 0xa7, 0x45, 0x5f, 0xe5, 0x2c, 0x3f, 0x0c, 0x75, 0xc0, 0x39, 0xb8, 0x83, 0x57,
-		0xac, 0x08, 0x39, 0xfa, 0x45, 0x81, 0x07, 0x13, 0x05, 0x98, 0xf0, 0x98, 0xe0, 0x1e, 0x5f, 0xdc,
-		0xb2, 0x20, 0x13, 0xae, 0x37, 0xe8, 0x2c, 0x00, 0xba, 0x01, 0x0e, 0x30, 0xcc, 0x06, 0xb3, 0x7b,
-		0x36, 0xeb, 0x4f, 0xb8, 0x4f, 0x72, 0xf7, 0xbb, 0xb3, 0xdc, 0xab, 0x92, 0x3b, 0xe1, 0x7b, 0x8d,
-		0x4e, 0xd5, 0x22, 0x07, 0xd6, 0xe4, 0x8d, 0x12, 0x2e, 0x7b, 0xbb, 0xa3, 0x5e, 0x53, 0xdc, 0x6b,
-		0x65, 0x9a, 0xd7, 0x03, 0xc1, 0xf4, 0x4d, 0x9e, 0xed, 0x86, 0x5c, 0xae, 0x36, 0xa3, 0x55, 0xa8,
-		0x03, 0x6b, 0x97, 0xa6, 0xdd, 0x72, 0x7b, 0xc4, 0x33, 0xda, 0xa6, 0x77, 0x4d, 0xbc, 0x51, 0x7f,
-		0x69, 0xee, 0xef, 0xe9, 0x34, 0x7f, 0xfb, 0x92, 0x79, 0xc4, 0x89, 0x21, 0x87, 0x85, 0xcb, 0x29,
-		0xba, 0x57, 0x19, 0x80, 0xa1, 0x87, 0xd2, 0xdf, 0x31, 0x58, 0x89, 0xea, 0x0e, 0x84, 0x21, 0x2f,
-		0x7b, 0xcd, 0xed, 0x10, 0x8f, 0xf7, 0xa0, 0xbc, 0x23, 0x5b, 0xb3, 0xbb, 0xec, 0x24, 0x80, 0xe3,
-		0x9c, 0x15, 0x16, 0xa0, 0x2c, 0xc4, 0xe4, 0xd5, 0x98, 0xc7, 0x31, 0xdb, 0x42, 0xcf, 0x21, 0x29,
-		0x20, 0xf2, 0x26, 0xac, 0x87, 0x2d, 0x9b, 0x1d, 0x7b, 0x68, 0x16, 0x4b, 0x28, 0x7a, 0x08, 0xd9,
-		0x86, 0xeb, 0x5c, 0xda, 0x57, 0x46, 0x8f, 0x78, 0xd4, 0x0f, 0x6b, 0x8e, 0xdf, 0xb5, 0x45, 0x21,
-		0x3d, 0x13, 0x42, 0xf4, 0x18, 0xf2, 0x83, 0xc4, 0x06, 0xc0, 0x04, 0x07, 0xe6, 0x02, 0x79, 0x00,
-		0xfd, 0x0e, 0xee, 0x75, 0x3c, 0xd2, 0xb3, 0xdd, 0x2e, 0x35, 0x26, 0x38, 0x49, 0xce, 0x59, 0x0d,
-		0x00, 0xfb, 0x61, 0x6e, 0xe9, 0x4f, 0x05, 0x36, 0x66, 0xf6, 0xba, 0x1f, 0xaf, 0x9c, 0x0d, 0x8d,
-		0x56, 0x97, 0x32, 0xe2, 0xf1, 0x34, 0xce, 0xe3, 0x45, 0x21, 0xdd, 0x13, 0x42, 0x7f, 0x20, 0x8a,
-		0xfb, 0x26, 0x33, 0x94, 0xc0, 0x29, 0xfe, 0xad, 0x59, 0xe8, 0x1b, 0x98, 0x1f, 0x6c, 0x94, 0x5b,
-		0xcc, 0x8c, 0x21, 0xb8, 0xf4, 0x21, 0x01, 0x6b, 0xd3, 0xaf, 0x02, 0x5a, 0x87, 0x79, 0x59, 0x63,
-		0xdb, 0x92, 0x51, 0xa5, 0x85, 0x40, 0xb3, 0xd0, 0x5b, 0x40, 0x37, 0xae, 0x77, 0x7d, 0xd9, 0x72,
-		0x6f, 0x0c, 0xf2, 0x9e, 0x34, 0xba, 0xbc, 0x05, 0x62, 0xdc, 0xfd, 0xa3, 0xc8, 0x42, 0xbd, 0x93,
-		0xf0, 0x5a, 0x80, 0xc6, 0x4b, 0x37, 0xe3, 0x22, 0x54, 0x80, 0x54, 0x90, 0xda, 0x38, 0x4f, 0x6d,
-		0xf0, 0x89, 0x1e, 0x40, 0x86, 0x36, 0x9a, 0xc4, 0xea, 0xb6, 0x08, 0xcf, 0x82, 0x28, 0xeb, 0xc2,
-		0x40, 0xa6, 0x59, 0x48, 0x85, 0xec, 0x10, 0xc2, 0x47, 0x68, 0xe2, 0xa3, 0xe9, 0x58, 0x1c, 0x30,
-		0xf8, 0x0c, 0xdd, 0x00, 0xa0, 0xcc, 0xf4, 0x98, 0xf0, 0x21, 0xaa, 0x3b, 0x2f, 0x25, 0x9a, 0x85,
-		0x7e, 0x80, 0x4c, 0xa0, 0xe6, 0xf6, 0x53, 0x1f, 0xb5, 0xbf, 0x20, 0xf1, 0xdc, 0xfa, 0x8f, 0xb0,
-		0xcc, 0x37, 0x62, 0x93, 0x98, 0x1e, 0xbb, 0x20, 0x26, 0x13, 0x56, 0xd2, 0x1f, 0xb5, 0xb2, 0xe4,
-		0xd3, 0x0e, 0x02, 0x16, 0xb7, 0xf5, 0x15, 0xa4, 0x2c, 0xc2, 0x4c, 0xbb, 0x45, 0x0b, 0xf3, 0x9c,
-		0x7f, 0x3f, 0x32, 0xeb, 0xa7, 0x66, 0xbf, 0xe5, 0x9a, 0x16, 0x0e, 0xc0, 0x7e, 0x86, 0x4d, 0xc6,
-		0x48, 0xbb, 0xc3, 0x0a, 0x20, 0x1a, 0x49, 0x7e, 0xa2, 0x97, 0x90, 0xe1, 0xd1, 0xf9, 0x4d, 0xde,
-		0xf5, 0x48, 0x61, 0x61, 0x86, 0xd9, 0x7d, 0x81, 0xc1, 0x0b, 0x3e, 0x43, 0x7e, 0xa0, 0xa7, 0xb0,
-		0xc2, 0x0d, 0xf8, 0x65, 0x25, 0x9e, 0x61, 0x5b, 0xc4, 0x61, 0x36, 0xeb, 0x17, 0x32, 0xbc, 0x77,
-		0x90, 0xaf, 0x7b, 0xc7, 0x55, 0x9a, 0xd4, 0xa0, 0x13, 0xc8, 0xc9, 0xfa, 0x1a, 0x72, 0x04, 0x16,
-		0x16, 0xa3, 0x5a, 0x68, 0x38, 0x45, 0xe4, 0xcd, 0x92, 0xb3, 0x14, 0x67, 0x7b, 0xa1, 0xef, 0xd2,
-		0xaf, 0x71, 0x58, 0x9d, 0x32, 0x67, 0xd1, 0x2a, 0xa4, 0x82, 0xfd, 0xab, 0xf0, 0xc2, 0x26, 0x99,
-		0xd8, 0xbc, 0xa1, 0x46, 0x8f, 0xdd, 0xaa, 0xd1, 0xe3, 0x9f, 0xdb, 0xe8, 0x3f, 0xc3, 0xff, 0xc6,
-		0x4e, 0x6e, 0xd8, 0x8c, 0xb4, 0xfd, 0x5d, 0xed, 0x3f, 0xbb, 0x76, 0x6e, 0x77, 0x7e, 0x8d, 0x91,
-		0x36, 0x5e, 0xee, 0x4d, 0xc8, 0x28, 0x7a, 0x01, 0x49, 0xd2, 0x23, 0x0e, 0x0b, 0x56, 0xf1, 0x46,
-		0xf4, 0xf0, 0x34, 0x99, 0xf9, 0xaa, 0xe5, 0x5e, 0x60, 0x09, 0x46, 0x7b, 0x90, 0x75, 0xc8, 0x8d,
-		0xe1, 0x75, 0x1d, 0x43, 0xd2, 0x93, 0xb7, 0xa1, 0x67, 0x1c, 0x72, 0x83, 0xbb, 0x4e, 0x8d, 0x53,
-		0x4a, 0x7f, 0x29, 0x50, 0x98, 0xb6, 0x7c, 0x66, 0x4f, 0x95, 0xa8, 0xb1, 0x1c, 0x8b, 0x1e, 0xcb,
-		0x9f, 0xfb, 0x5c, 0x2a, 0xfd, 0xa6, 0xc0, 0x72, 0x38, 0x4a, 0xdd, 0xbd, 0x26, 0x8e, 0x1f, 0x60,
-		0x30, 0x6a, 0xc5, 0x23, 0x38, 0x81, 0xd3, 0x72, 0xd6, 0x52, 0x74, 0x0e, 0xb9, 0xb1, 0x85, 0x2c,
-		0x67, 0xde, 0x27, 0x6f, 0x61, 0x9c, 0x0d, 0xef, 0xe0, 0xd2, 0x3f, 0xe1, 0xc7, 0x39, 0x7f, 0x15,
-		0x3a, 0x97, 0xee, 0x7f, 0x32, 0x86, 0xd7, 0x47, 0xdf, 0xbe, 0x71, 0x3e, 0x26, 0x86, 0xcf, 0xd9,
-		0x91, 0x7b, 0x34, 0x17, 0xba, 0x47, 0x23, 0xc3, 0x3b, 0x11, 0x1e, 0xde, 0x9b, 0x90, 0xbd, 0xb4,
-		0x3d, 0xca, 0x44, 0x53, 0x0d, 0x47, 0x6b, 0x86, 0x4b, 0x79, 0xdb, 0x68, 0x16, 0x2a, 0xc1, 0xa2,
-		0x43, 0xde, 0x8f, 0x80, 0x52, 0x62, 0xc6, 0xfb, 0xc2, 0x00, 0x33, 0xbe, 0x06, 0xd2, 0x13, 0x6b,
-		0xc0, 0x6f, 0xbf, 0xfc, 0x68, 0x22, 0x79, 0x55, 0x47, 0x17, 0xa8, 0x12, 0x5e, 0xa0, 0x9f, 0xf1,
-		0x7f, 0x4a, 0x40, 0xed, 0x78, 0x6e, 0x83, 0x50, 0x1a, 0xa6, 0xc6, 0x87, 0xd4, 0xd3, 0x40, 0x3f,
-		0xa0, 0x96, 0x5e, 0x43, 0x6e, 0xec, 0x65, 0x10, 0xde, 0xe4, 0xca, 0x27, 0x6c, 0xf2, 0x9d, 0x0f,
-		0x93, 0xbd, 0xc3, 0x4b, 0xf5, 0x00, 0x36, 0x70, 0xed, 0xf4, 0x50, 0xdb, 0x53, 0x75, 0xed, 0xe4,
-		0xd8, 0xd0, 0xd5, 0xfa, 0x6b, 0x43, 0x3f, 0x3f, 0xad, 0x19, 0xda, 0xf1, 0x99, 0x7a, 0xa8, 0x55,
-		0xf3, 0x77, 0x50, 0x11, 0xee, 0x47, 0x43, 0xaa, 0x27, 0x47, 0xaa, 0x76, 0x9c, 0x57, 0xa6, 0x1b,
-		0x39, 0xd0, 0xea, 0xfa, 0x09, 0x3e, 0xcf, 0xc7, 0xd0, 0x13, 0xd8, 0x8a, 0x86, 0xd4, 0xcf, 0x8f,
-		0xf7, 0x8c, 0xfa, 0x81, 0x8a, 0xab, 0x46, 0x5d, 0x57, 0xf5, 0xb7, 0xf5, 0x7c, 0x1c, 0x6d, 0xc1,
-		0x17, 0x33, 0xc0, 0xea, 0x9e, 0xae, 0x9d, 0x69, 0xfa, 0x79, 0x7e, 0x0e, 0xed, 0xc0, 0xa3, 0x99,
-		0x8e, 0x8d, 0xa3, 0x9a, 0xae, 0x56, 0x55, 0x5d, 0xcd, 0x27, 0xd0, 0x26, 0x14, 0x67, 0x63, 0xcf,
-		0x76, 0xf3, 0x49, 0xf4, 0x18, 0x1e, 0x46, 0xa3, 0xf6, 0x55, 0xed, 0xf0, 0xe4, 0xac, 0x86, 0x8d,
-		0x23, 0x15, 0xbf, 0xae, 0xe1, 0x7c, 0x6a, 0xc7, 0x86, 0xdc, 0xd8, 0x8b, 0x15, 0xdd, 0x87, 0x82,
-		0x48, 0x8a, 0x71, 0x72, 0x5a, 0xc3, 0xc2, 0xc4, 0x30, 0x91, 0xeb, 0xb0, 0x3a, 0xa1, 0xdd, 0xc3,
-		0x35, 0x55, 0xaf, 0xe5, 0x95, 0x48, 0xe5, 0xdb, 0xd3, 0xaa, 0xaf, 0x8c, 0xed, 0x1c, 0x43, 0xaa,
-		0x7a, 0xf8, 0x86, 0x17, 0x6c, 0x05, 0xf2, 0xd5, 0xc3, 0x37, 0xe3, 0x35, 0x2a, 0xc0, 0xca, 0x40,
-		0x3a, 0x12, 0x7f, 0x5e, 0x41, 0xcb, 0x90, 0x1b, 0x68, 0x64, 0xc1, 0x62, 0xaf, 0xbe, 0xfe, 0xe9,
-		0xc5, 0x95, 0xcd, 0x9a, 0xdd, 0x8b, 0x72, 0xc3, 0x6d, 0x57, 0x42, 0xbf, 0x0c, 0x94, 0xaf, 0x88,
-		0x23, 0x7e, 0x89, 0x18, 0xfe, 0x48, 0xf0, 0xbd, 0xf8, 0xab, 0xf7, 0xec, 0x22, 0xc9, 0x35, 0xcf,
-		0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x50, 0xe1, 0x45, 0xf5, 0x10, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x72, 0xdb, 0xc8,
+		0x11, 0x5e, 0x90, 0x16, 0x49, 0xb5, 0x28, 0x12, 0x1e, 0xc9, 0x16, 0x2c, 0x5b, 0x15, 0x99, 0xf6,
+		0xae, 0xb5, 0xda, 0x14, 0x65, 0x6b, 0xcb, 0xf9, 0xad, 0x64, 0x0b, 0x26, 0xa9, 0x12, 0x62, 0xfd,
+		0x70, 0x87, 0xb0, 0xb6, 0xb4, 0x87, 0xa0, 0x46, 0xc0, 0x48, 0x42, 0x91, 0x04, 0x58, 0xc0, 0x90,
+		0x5a, 0x1e, 0xf3, 0x00, 0x39, 0x26, 0x97, 0x1c, 0x53, 0x79, 0x95, 0xdc, 0x52, 0xe5, 0x07, 0xc9,
+		0x43, 0xa4, 0xe6, 0x07, 0x24, 0xc1, 0x3f, 0x2b, 0xf1, 0x21, 0x37, 0x4e, 0xf7, 0xd7, 0xdd, 0x33,
+		0x3d, 0x5f, 0x4f, 0x37, 0x08, 0x7b, 0xfd, 0x2b, 0x1a, 0x1d, 0xb8, 0xc4, 0xa3, 0x81, 0x4b, 0x0f,
+		0xe2, 0x5b, 0x12, 0x51, 0xef, 0x60, 0xf0, 0xe6, 0x20, 0xa2, 0xbd, 0x8e, 0xef, 0x12, 0xe6, 0x87,
+		0x41, 0xb5, 0x17, 0x85, 0x2c, 0x44, 0x8f, 0x39, 0xb2, 0xaa, 0x90, 0x55, 0x89, 0xac, 0x0e, 0xde,
+		0x6c, 0xff, 0xec, 0x26, 0x0c, 0x6f, 0x3a, 0xf4, 0x40, 0xa0, 0xae, 0xfa, 0xd7, 0x07, 0xcc, 0xef,
+		0xd2, 0x98, 0x91, 0x6e, 0x4f, 0x1a, 0x6e, 0xef, 0xa6, 0x42, 0x90, 0x9e, 0xcf, 0xfd, 0xbb, 0x61,
+		0xb7, 0x1b, 0x06, 0xcb, 0x10, 0x5e, 0xd8, 0x25, 0x7e, 0x82, 0x78, 0xb1, 0x60, 0x9b, 0x29, 0xd0,
+		0xcb, 0x05, 0xa0, 0x5b, 0x3f, 0x66, 0x61, 0x34, 0x94, 0xa8, 0xca, 0x5f, 0x33, 0xb0, 0x81, 0xc7,
+		0xa7, 0x3b, 0xa5, 0x71, 0x4c, 0x6e, 0x68, 0x8c, 0x6c, 0x78, 0x38, 0x71, 0x68, 0x87, 0x91, 0xb8,
+		0x1d, 0x1b, 0xda, 0x6e, 0x76, 0x6f, 0xed, 0xf0, 0x55, 0x75, 0xfe, 0xd9, 0xab, 0x13, 0x7e, 0x6c,
+		0x12, 0xb7, 0xb1, 0x1e, 0xa5, 0x05, 0x31, 0xfa, 0x35, 0x3c, 0xe9, 0x90, 0x98, 0x39, 0x11, 0x65,
+		0x91, 0x4f, 0x07, 0xd4, 0x73, 0xba, 0x32, 0xa0, 0xe3, 0x7b, 0x46, 0x66, 0x57, 0xdb, 0xcb, 0xe2,
+		0xc7, 0x1c, 0x80, 0x13, 0xbd, 0xda, 0x8f, 0xe5, 0xa1, 0x27, 0x50, 0xb8, 0x25, 0xb1, 0xd3, 0x0d,
+		0x23, 0x6a, 0x64, 0x77, 0xb5, 0xbd, 0x02, 0xce, 0xdf, 0x92, 0xf8, 0x34, 0x8c, 0x28, 0x6a, 0xc1,
+		0xc3, 0x78, 0x18, 0xb8, 0x0e, 0xdf, 0x89, 0xe7, 0xc4, 0x8c, 0xb0, 0x7e, 0x6c, 0x3c, 0xd8, 0xd5,
+		0x96, 0xed, 0xb5, 0x35, 0x0c, 0xdc, 0x16, 0xc7, 0xb7, 0x04, 0x1c, 0x97, 0xe3, 0xb4, 0xa0, 0xf2,
+		0x97, 0x1c, 0x94, 0xa7, 0x0e, 0x84, 0x8e, 0x61, 0x95, 0x27, 0xc2, 0x61, 0xc3, 0x1e, 0x35, 0xb4,
+		0x5d, 0x6d, 0xaf, 0x74, 0xf8, 0xcd, 0x3d, 0x93, 0x61, 0x0f, 0x7b, 0x14, 0x17, 0x98, 0xfa, 0x85,
+		0x5e, 0x42, 0x29, 0x0e, 0xfb, 0x91, 0x4b, 0x45, 0x66, 0xc7, 0xa7, 0x2f, 0x4a, 0x29, 0xb7, 0xb0,
+		0x3c, 0xf4, 0x1d, 0xac, 0xbb, 0x11, 0x55, 0x37, 0xe0, 0x77, 0xe5, 0xc1, 0xd7, 0x0e, 0xb7, 0xab,
+		0x92, 0x64, 0xd5, 0x84, 0x64, 0x55, 0x3b, 0x21, 0x19, 0x2e, 0x26, 0x06, 0x5c, 0x84, 0x3c, 0x78,
+		0x2c, 0x39, 0x21, 0xc3, 0x10, 0xc6, 0x22, 0xff, 0xaa, 0xcf, 0x68, 0x92, 0x9e, 0x9f, 0x2f, 0xda,
+		0x7d, 0x5d, 0x58, 0xf1, 0x6d, 0x98, 0x23, 0x9b, 0xe3, 0x2f, 0xf0, 0xa6, 0x37, 0x47, 0x8e, 0xfe,
+		0xa4, 0xc1, 0xf3, 0x99, 0x0b, 0x98, 0x89, 0xb8, 0x22, 0x22, 0xbe, 0xbd, 0xe7, 0x85, 0xcc, 0x84,
+		0xde, 0x89, 0x97, 0x01, 0xd0, 0x1d, 0x08, 0x80, 0x43, 0x5c, 0xe6, 0x0f, 0x7c, 0x36, 0x9c, 0x09,
+		0x9f, 0x13, 0xe1, 0x0f, 0x97, 0x85, 0x37, 0x95, 0xed, 0x4c, 0xec, 0xed, 0x78, 0xa1, 0x16, 0x05,
+		0xb0, 0xad, 0x2a, 0x4a, 0x86, 0x1c, 0x1c, 0x4e, 0x46, 0xcd, 0x8b, 0xa8, 0x07, 0x8b, 0xa2, 0x1e,
+		0x4b, 0x4b, 0xee, 0xf2, 0xe2, 0x30, 0x15, 0x72, 0xeb, 0x76, 0xbe, 0x0a, 0xf5, 0x60, 0xfb, 0x9a,
+		0xf8, 0x9d, 0x70, 0x40, 0x23, 0xa7, 0x4b, 0xa2, 0x36, 0x8d, 0x26, 0xe3, 0x15, 0x44, 0xbc, 0xd7,
+		0x8b, 0xe2, 0x1d, 0x29, 0xcb, 0x53, 0x61, 0x98, 0x0a, 0x68, 0x5c, 0x2f, 0xd0, 0xbd, 0x2b, 0x02,
+		0x8c, 0x23, 0x54, 0xfe, 0x91, 0x85, 0xcd, 0x79, 0xec, 0x40, 0x18, 0x74, 0xc5, 0xb5, 0xb0, 0x47,
+		0x23, 0xc1, 0x41, 0x55, 0x23, 0xaf, 0x96, 0xb3, 0xec, 0x3c, 0x81, 0xe3, 0xb2, 0x97, 0x16, 0xa0,
+		0x12, 0x64, 0x54, 0x69, 0xac, 0xe2, 0x8c, 0xef, 0xa1, 0x6f, 0x21, 0x27, 0x21, 0xaa, 0x12, 0x9e,
+		0xa6, 0x3d, 0x93, 0x9e, 0x3f, 0x76, 0x8b, 0x15, 0x14, 0x7d, 0x09, 0x25, 0x37, 0x0c, 0xae, 0xfd,
+		0x1b, 0x67, 0x40, 0xa3, 0x98, 0x6f, 0xeb, 0x81, 0xa8, 0xb5, 0x75, 0x29, 0xbd, 0x90, 0x42, 0xf4,
+		0x35, 0xe8, 0xa3, 0xc4, 0x26, 0xc0, 0x15, 0x01, 0x2c, 0x27, 0xf2, 0x04, 0xfa, 0x1b, 0x78, 0xd2,
+		0x8b, 0xe8, 0xc0, 0x0f, 0xfb, 0xb1, 0x33, 0x63, 0x93, 0x13, 0x36, 0x5b, 0x09, 0xe0, 0x68, 0xca,
+		0xf6, 0x1a, 0x1e, 0x11, 0xc1, 0xd4, 0xbb, 0x30, 0x6a, 0x5f, 0x77, 0xc2, 0x3b, 0x47, 0x6e, 0xc3,
+		0xc8, 0x2f, 0x27, 0xa8, 0xc9, 0x8d, 0x7e, 0x50, 0x36, 0x35, 0x61, 0xd2, 0x57, 0x69, 0xdb, 0x20,
+		0xb3, 0xba, 0xca, 0xdf, 0x34, 0xd8, 0x59, 0x5a, 0x53, 0x3c, 0x2f, 0xea, 0x0d, 0x72, 0x3b, 0xfd,
+		0x98, 0xd1, 0x48, 0x5c, 0xd7, 0x2a, 0x5e, 0x97, 0xd2, 0x9a, 0x14, 0xf2, 0x87, 0x57, 0xd6, 0xb5,
+		0xba, 0x89, 0x15, 0x9c, 0x17, 0x6b, 0xcb, 0x43, 0xbf, 0x82, 0xd5, 0x51, 0x7b, 0xbb, 0xc7, 0xdb,
+		0x34, 0x06, 0x57, 0x3e, 0xae, 0xc0, 0xf6, 0xe2, 0x92, 0x43, 0x4f, 0x61, 0x55, 0x71, 0xc9, 0xf7,
+		0xd4, 0xae, 0x0a, 0x52, 0x60, 0x79, 0xe8, 0x03, 0xa0, 0x51, 0xee, 0xe8, 0x4f, 0xd4, 0xed, 0x0b,
+		0xaa, 0x65, 0x44, 0xf8, 0xaf, 0xe6, 0x12, 0x22, 0x49, 0x4d, 0x23, 0x41, 0xe3, 0x87, 0x77, 0xd3,
+		0x22, 0x64, 0x40, 0x3e, 0xb9, 0xc2, 0xac, 0xb8, 0xc2, 0x64, 0x89, 0x9e, 0x43, 0x31, 0x76, 0x6f,
+		0xa9, 0xd7, 0xef, 0x50, 0x91, 0x05, 0x49, 0x9f, 0xb5, 0x91, 0xcc, 0xf2, 0x90, 0x09, 0xa5, 0x31,
+		0x44, 0x3c, 0xd5, 0x2b, 0x9f, 0x4c, 0xc7, 0xfa, 0xc8, 0x82, 0xcb, 0xd0, 0x0e, 0x40, 0xcc, 0x48,
+		0xc4, 0x64, 0x0c, 0xc9, 0xa2, 0x55, 0x25, 0xb1, 0x3c, 0xf4, 0x3b, 0x28, 0x26, 0x6a, 0xe1, 0x3f,
+		0xff, 0x49, 0xff, 0x6b, 0x0a, 0x2f, 0xbc, 0xff, 0x01, 0x36, 0x44, 0xe7, 0xbd, 0xa5, 0x24, 0x62,
+		0x57, 0x94, 0x30, 0xe9, 0xa5, 0xf0, 0x49, 0x2f, 0x0f, 0xb9, 0xd9, 0x71, 0x62, 0x25, 0x7c, 0xfd,
+		0x02, 0xf2, 0x1e, 0x65, 0xc4, 0xef, 0xc4, 0xc6, 0xaa, 0xb0, 0x7f, 0x36, 0x37, 0xeb, 0x4d, 0x32,
+		0xec, 0x84, 0xc4, 0xc3, 0x09, 0x98, 0x67, 0x98, 0x30, 0x46, 0xbb, 0x3d, 0x66, 0x80, 0x24, 0x92,
+		0x5a, 0xa2, 0xef, 0xa0, 0x28, 0x76, 0xc7, 0x8b, 0xa9, 0x1f, 0x51, 0x63, 0x6d, 0x89, 0xdb, 0x23,
+		0x89, 0xc1, 0x6b, 0xdc, 0x42, 0x2d, 0xd0, 0x6b, 0xd8, 0x14, 0x0e, 0xf8, 0xb5, 0xd2, 0xc8, 0xf1,
+		0x3d, 0x1a, 0x30, 0x9f, 0x0d, 0x8d, 0xa2, 0xe0, 0x0e, 0xe2, 0xba, 0x1f, 0x84, 0xca, 0x52, 0x1a,
+		0x74, 0x0e, 0x65, 0x75, 0xbf, 0x8e, 0x7a, 0x6a, 0x8d, 0xf5, 0x79, 0x14, 0x1a, 0x57, 0xa0, 0xaa,
+		0x60, 0xf5, 0x66, 0xe3, 0xd2, 0x20, 0xb5, 0xae, 0xfc, 0x2b, 0x0b, 0x5b, 0x0b, 0xde, 0x73, 0xb4,
+		0x05, 0xf9, 0xa4, 0xcf, 0x6b, 0xe2, 0x62, 0x73, 0x4c, 0x76, 0xf8, 0x14, 0xd1, 0x33, 0xf7, 0x22,
+		0x7a, 0xf6, 0x73, 0x89, 0xfe, 0x47, 0x78, 0x34, 0x75, 0x72, 0xc7, 0x67, 0xb4, 0xcb, 0x67, 0x02,
+		0x3e, 0xde, 0xed, 0xdf, 0xef, 0xfc, 0x16, 0xa3, 0x5d, 0xbc, 0x31, 0x98, 0x91, 0xc5, 0xe8, 0x2d,
+		0xe4, 0xe8, 0x80, 0x06, 0x2c, 0x69, 0xf9, 0x3b, 0xf3, 0x1f, 0x69, 0xc2, 0xc8, 0xbb, 0x4e, 0x78,
+		0x85, 0x15, 0x18, 0xd5, 0xa0, 0x14, 0xd0, 0x3b, 0x27, 0xea, 0x07, 0x8e, 0x32, 0xcf, 0xdd, 0xc7,
+		0xbc, 0x18, 0xd0, 0x3b, 0xdc, 0x0f, 0x1a, 0xd2, 0x89, 0x05, 0x6b, 0x6e, 0xd8, 0xed, 0x45, 0x34,
+		0x16, 0x85, 0x9c, 0x5f, 0xde, 0x7f, 0x6a, 0x63, 0xa8, 0x98, 0xcf, 0x26, 0x6d, 0x2b, 0x7f, 0xd7,
+		0xc0, 0x58, 0xd4, 0x2f, 0x97, 0x3f, 0x50, 0xf3, 0x3a, 0x49, 0x66, 0x7e, 0x27, 0xf9, 0xdc, 0x09,
+		0xaf, 0xf2, 0x67, 0x0d, 0x36, 0xd2, 0xbb, 0xb4, 0xc3, 0x36, 0x0d, 0xf8, 0x06, 0x93, 0x57, 0x5b,
+		0xce, 0xed, 0x2b, 0xb8, 0xa0, 0x9e, 0xed, 0x18, 0x5d, 0x42, 0x79, 0x6a, 0x86, 0x30, 0x32, 0xff,
+		0xdb, 0xe0, 0x80, 0x4b, 0xe9, 0xb1, 0xa1, 0xf2, 0xcf, 0xf4, 0xf7, 0x84, 0x18, 0x64, 0x83, 0xeb,
+		0xf0, 0xff, 0xf2, 0xa2, 0x3f, 0x9d, 0x1c, 0xd7, 0xb3, 0xe2, 0xc5, 0x19, 0x4f, 0xe0, 0x13, 0x25,
+		0xf9, 0x20, 0x55, 0x92, 0x13, 0x7d, 0x60, 0x25, 0xdd, 0x07, 0x5e, 0x42, 0xe9, 0xda, 0x8f, 0x62,
+		0x26, 0xf9, 0x39, 0x7e, 0xa5, 0x8b, 0x42, 0x2a, 0x18, 0x68, 0x79, 0xa8, 0x02, 0xeb, 0x01, 0xfd,
+		0x69, 0x02, 0x94, 0x97, 0xed, 0x82, 0x0b, 0x13, 0xcc, 0x74, 0x47, 0x29, 0xcc, 0x74, 0x14, 0x4e,
+		0x3f, 0x7d, 0x32, 0x91, 0xe2, 0x56, 0x27, 0x7b, 0xb1, 0x96, 0xee, 0xc5, 0x9f, 0xf1, 0x69, 0x95,
+		0x98, 0xf6, 0xa2, 0xd0, 0xa5, 0x71, 0x9c, 0x36, 0xcd, 0x8e, 0x4d, 0x9b, 0x89, 0x7e, 0x64, 0x5a,
+		0x79, 0x0f, 0xe5, 0xa9, 0x21, 0x23, 0x3d, 0x14, 0x68, 0xff, 0xcd, 0x50, 0xf0, 0x6f, 0x2d, 0xc5,
+		0x1d, 0xd3, 0x6d, 0x73, 0x97, 0x94, 0x67, 0x4b, 0x4d, 0x28, 0x4e, 0x40, 0xba, 0x54, 0xd1, 0x67,
+		0x4d, 0xc9, 0xce, 0x48, 0x97, 0xf2, 0xab, 0x26, 0x6e, 0xdb, 0xe9, 0xd0, 0x01, 0xed, 0xa8, 0xd3,
+		0x16, 0x88, 0xdb, 0x3e, 0xe1, 0x6b, 0xf4, 0x7b, 0x58, 0x17, 0xe7, 0xe3, 0x88, 0x7b, 0x16, 0x99,
+		0x68, 0x2e, 0xa6, 0xdb, 0xe6, 0x12, 0xf4, 0x02, 0xd6, 0x7b, 0x34, 0xf0, 0xfc, 0xe0, 0x46, 0x7d,
+		0x07, 0x4b, 0xc2, 0x14, 0x95, 0x50, 0x7e, 0xda, 0xbe, 0x86, 0xcd, 0x14, 0xc8, 0x71, 0x49, 0xaf,
+		0x47, 0x3d, 0xc1, 0xa1, 0x02, 0x46, 0x93, 0xd8, 0x9a, 0xd0, 0xec, 0x7f, 0x9c, 0x2d, 0x15, 0xc1,
+		0xcc, 0xe7, 0xb0, 0x83, 0x1b, 0xcd, 0x13, 0xab, 0x66, 0xda, 0xd6, 0xf9, 0x99, 0x63, 0x9b, 0xad,
+		0xf7, 0x8e, 0x7d, 0xd9, 0x6c, 0x38, 0xd6, 0xd9, 0x85, 0x79, 0x62, 0xd5, 0xf5, 0x2f, 0xd0, 0x2e,
+		0x3c, 0x9b, 0x0f, 0xa9, 0x9f, 0x9f, 0x9a, 0xd6, 0x99, 0xae, 0x2d, 0x76, 0x72, 0x6c, 0xb5, 0xec,
+		0x73, 0x7c, 0xa9, 0x67, 0xd0, 0x37, 0xf0, 0x6a, 0x3e, 0xa4, 0x75, 0x79, 0x56, 0x73, 0x5a, 0xc7,
+		0x26, 0xae, 0x3b, 0x2d, 0xdb, 0xb4, 0x3f, 0xb4, 0xf4, 0x2c, 0x7a, 0x05, 0x2f, 0x96, 0x80, 0xcd,
+		0x9a, 0x6d, 0x5d, 0x58, 0xf6, 0xa5, 0xfe, 0x00, 0xed, 0xc3, 0x57, 0x4b, 0x03, 0x3b, 0xa7, 0x0d,
+		0xdb, 0xac, 0x9b, 0xb6, 0xa9, 0xaf, 0xa0, 0x97, 0xb0, 0xbb, 0x1c, 0x7b, 0x71, 0xa8, 0xe7, 0xd0,
+		0xd7, 0xf0, 0xe5, 0x7c, 0xd4, 0x91, 0x69, 0x9d, 0x9c, 0x5f, 0x34, 0xb0, 0x73, 0x6a, 0xe2, 0xf7,
+		0x0d, 0xac, 0xe7, 0xf7, 0x6f, 0xa0, 0x3c, 0xf5, 0xa6, 0xa3, 0x67, 0x60, 0xd4, 0xce, 0x4f, 0x9b,
+		0xb8, 0xd1, 0x6a, 0x09, 0xeb, 0x74, 0x22, 0x9f, 0xc2, 0xd6, 0x8c, 0xb6, 0x75, 0x66, 0x36, 0x9b,
+		0x97, 0xba, 0x86, 0x9e, 0xc0, 0xa3, 0x19, 0xe5, 0x8f, 0x2d, 0xbb, 0xae, 0x67, 0xf6, 0x7d, 0x28,
+		0x4f, 0x7d, 0xbc, 0xf0, 0x40, 0x32, 0xfb, 0xce, 0x79, 0xb3, 0x81, 0xe5, 0x5e, 0x53, 0x81, 0x66,
+		0xb4, 0x35, 0xdc, 0x30, 0xed, 0x86, 0xae, 0xcd, 0x55, 0x7e, 0x68, 0xd6, 0xb9, 0x32, 0xb3, 0x7f,
+		0x06, 0xf9, 0xfa, 0xc9, 0xf7, 0xe2, 0x2c, 0x9b, 0xa0, 0xd7, 0x4f, 0xbe, 0x9f, 0x3e, 0x83, 0x01,
+		0x9b, 0x23, 0xe9, 0x44, 0xa2, 0x74, 0x0d, 0x6d, 0x40, 0x79, 0xa4, 0x51, 0xcc, 0xc8, 0xbc, 0xfb,
+		0xe5, 0x8f, 0x6f, 0x6f, 0x7c, 0x76, 0xdb, 0xbf, 0xaa, 0xba, 0x61, 0xf7, 0x20, 0xf5, 0x27, 0x51,
+		0xf5, 0x86, 0x06, 0xf2, 0x9f, 0xab, 0xf1, 0xff, 0x45, 0xbf, 0x95, 0xbf, 0x06, 0x6f, 0xae, 0x72,
+		0x42, 0xf3, 0xed, 0x7f, 0x06, 0x00, 0xde, 0xc6, 0xe2, 0x4a, 0x25, 0x13, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -204,71 +216,88 @@ var yarpcFileDescriptorClosure00df2ec6c2eaefe5 = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/domain.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xd1, 0x6e, 0xdb, 0x36,
-		0x14, 0x9d, 0xec, 0x24, 0x73, 0xae, 0x1c, 0xd7, 0x65, 0x9a, 0x46, 0xf1, 0x86, 0x45, 0x4d, 0x51,
-		0xc0, 0x2b, 0x30, 0x79, 0xf1, 0x86, 0xad, 0xdd, 0xb0, 0x07, 0xc7, 0x52, 0x3b, 0x0f, 0x59, 0x16,
-		0xc8, 0x6e, 0x30, 0x6c, 0x0f, 0x02, 0x2d, 0xd1, 0x36, 0x51, 0x59, 0x14, 0x28, 0xda, 0x69, 0xde,
-		0x86, 0x7d, 0xc4, 0x3e, 0x66, 0x8f, 0xfb, 0xb2, 0x41, 0x14, 0xa5, 0x38, 0xb6, 0x90, 0xf4, 0x8d,
-		0xbc, 0xf7, 0x9e, 0xc3, 0xa3, 0xa3, 0x7b, 0x29, 0x81, 0xb9, 0x18, 0x13, 0xde, 0xf1, 0x71, 0x40,
-		0x22, 0x9f, 0x74, 0x70, 0x4c, 0x3b, 0xcb, 0xd3, 0x4e, 0xc0, 0xe6, 0x98, 0x46, 0x56, 0xcc, 0x99,
-		0x60, 0x68, 0x3f, 0xad, 0xb0, 0x54, 0x85, 0x85, 0x63, 0x6a, 0x2d, 0x4f, 0x5b, 0x5f, 0x4c, 0x19,
-		0x9b, 0x86, 0xa4, 0x23, 0x4b, 0xc6, 0x8b, 0x49, 0x27, 0x58, 0x70, 0x2c, 0x28, 0x53, 0xa0, 0xd6,
-		0xf1, 0x7a, 0x5e, 0xd0, 0x39, 0x49, 0x04, 0x9e, 0xc7, 0x59, 0xc1, 0xc9, 0x3f, 0x35, 0xd8, 0xb1,
-		0xe5, 0x31, 0xa8, 0x01, 0x15, 0x1a, 0x18, 0x9a, 0xa9, 0xb5, 0x77, 0xdd, 0x0a, 0x0d, 0x10, 0x82,
-		0xad, 0x08, 0xcf, 0x89, 0x51, 0x91, 0x11, 0xb9, 0x46, 0xaf, 0x61, 0x27, 0x11, 0x58, 0x2c, 0x12,
-		0xa3, 0x6a, 0x6a, 0xed, 0x46, 0xf7, 0x99, 0x55, 0xa2, 0xca, 0xca, 0x08, 0x87, 0xb2, 0xd0, 0x55,
-		0x00, 0x64, 0x82, 0x1e, 0x90, 0xc4, 0xe7, 0x34, 0x4e, 0xf5, 0x19, 0x5b, 0x92, 0x75, 0x35, 0x84,
-		0x8e, 0x41, 0x67, 0xd7, 0x11, 0xe1, 0x1e, 0x99, 0x63, 0x1a, 0x1a, 0xdb, 0xb2, 0x02, 0x64, 0xc8,
-		0x49, 0x23, 0xe8, 0x35, 0x6c, 0x05, 0x58, 0x60, 0x63, 0xc7, 0xac, 0xb6, 0xf5, 0xee, 0x8b, 0x7b,
-		0xce, 0xb6, 0x6c, 0x2c, 0xb0, 0x13, 0x09, 0x7e, 0xe3, 0x4a, 0x08, 0x9a, 0xc1, 0xf3, 0x6b, 0xc6,
-		0xdf, 0x4f, 0x42, 0x76, 0xed, 0x91, 0x0f, 0xc4, 0x5f, 0xa4, 0x27, 0x7a, 0x9c, 0x08, 0x12, 0xc9,
-		0x55, 0x4c, 0x38, 0x65, 0x81, 0xf1, 0xa9, 0xa9, 0xb5, 0xf5, 0xee, 0x91, 0x95, 0xd9, 0x66, 0xe5,
-		0xb6, 0x59, 0xb6, 0xb2, 0xd5, 0x35, 0x73, 0x16, 0x27, 0x27, 0x71, 0x73, 0x8e, 0x4b, 0x49, 0x81,
-		0xfa, 0x50, 0x1f, 0xe3, 0xc0, 0x1b, 0xd3, 0x08, 0x73, 0x4a, 0x12, 0xa3, 0x26, 0x29, 0xcd, 0x52,
-		0xb1, 0x67, 0x38, 0x38, 0x53, 0x75, 0xae, 0x3e, 0xbe, 0xdd, 0xa0, 0x3f, 0xe1, 0x70, 0x46, 0x13,
-		0xc1, 0xf8, 0x8d, 0x87, 0xb9, 0x3f, 0xa3, 0x4b, 0x1c, 0x7a, 0xca, 0xf8, 0x5d, 0x69, 0xfc, 0xf3,
-		0x52, 0xbe, 0x9e, 0xaa, 0x55, 0xd6, 0x1f, 0x28, 0x8e, 0xbb, 0x61, 0xf4, 0x35, 0x3c, 0xd9, 0x20,
-		0x5f, 0x70, 0x6a, 0x80, 0x34, 0x1c, 0xad, 0x81, 0xde, 0x71, 0x8a, 0x30, 0xb4, 0x96, 0x34, 0xa1,
-		0x63, 0x1a, 0x52, 0xb1, 0xa9, 0x48, 0xff, 0x78, 0x45, 0xc6, 0x2d, 0xcd, 0x9a, 0xa8, 0xef, 0xe0,
-		0xb0, 0xec, 0x88, 0x54, 0x57, 0x5d, 0xea, 0x3a, 0xd8, 0x84, 0xa6, 0xd2, 0x2c, 0xd8, 0xc7, 0xbe,
-		0xa0, 0x4b, 0xe2, 0xf9, 0xe1, 0x22, 0x11, 0x84, 0x7b, 0xb2, 0x69, 0xf7, 0x24, 0xe6, 0x71, 0x96,
-		0xea, 0x67, 0x99, 0x8b, 0xb4, 0x83, 0x2f, 0xa1, 0xa6, 0x0a, 0x13, 0xa3, 0x21, 0xfb, 0xe8, 0xdb,
-		0x52, 0xe1, 0x0a, 0xe3, 0x92, 0x38, 0xa4, 0xbe, 0x7c, 0xf7, 0x7d, 0x16, 0x4d, 0xe8, 0x34, 0x6f,
-		0x84, 0x82, 0x05, 0x7d, 0x09, 0xcd, 0x09, 0xa6, 0x21, 0x5b, 0x12, 0xee, 0x2d, 0x09, 0x4f, 0xd2,
-		0xee, 0x7e, 0x64, 0x6a, 0xed, 0xaa, 0xfb, 0x28, 0x8f, 0x5f, 0x65, 0x61, 0xd4, 0x86, 0x26, 0x4d,
-		0xbc, 0x69, 0xc8, 0xc6, 0x38, 0xf4, 0xb2, 0xe9, 0x36, 0x9a, 0xa6, 0xd6, 0xae, 0xb9, 0x0d, 0x9a,
-		0xbc, 0x95, 0x61, 0x35, 0x8c, 0x6f, 0x60, 0xaf, 0x20, 0xa5, 0xd1, 0x84, 0x19, 0x8f, 0x65, 0x1b,
-		0x95, 0xcf, 0xdb, 0x1b, 0x55, 0x39, 0x88, 0x26, 0xcc, 0xad, 0x4f, 0x56, 0x76, 0xad, 0xef, 0x61,
-		0xb7, 0x18, 0x05, 0xd4, 0x84, 0xea, 0x7b, 0x72, 0xa3, 0x46, 0x3c, 0x5d, 0xa2, 0x27, 0xb0, 0xbd,
-		0xc4, 0xe1, 0x22, 0x1f, 0xf2, 0x6c, 0xf3, 0x43, 0xe5, 0x95, 0x76, 0x62, 0xc3, 0xf1, 0x03, 0x16,
-		0xa0, 0x67, 0x50, 0xbf, 0xe3, 0x79, 0xc6, 0xab, 0xfb, 0xb7, 0x6e, 0x9f, 0xfc, 0xab, 0x81, 0xbe,
-		0xd2, 0xe4, 0xe8, 0x17, 0xa8, 0x15, 0x83, 0xa1, 0x49, 0xf7, 0xad, 0x87, 0x06, 0xc3, 0xca, 0x17,
-		0xd9, 0x38, 0x17, 0xf8, 0x96, 0x07, 0x7b, 0x77, 0x52, 0x25, 0x8f, 0xf7, 0x6a, 0xf5, 0xf1, 0xf4,
-		0xee, 0xc9, 0xbd, 0x67, 0xdd, 0x48, 0xfb, 0x56, 0x2c, 0xf8, 0x5b, 0x83, 0xbd, 0x3b, 0x49, 0xf4,
-		0x14, 0x76, 0x38, 0xc1, 0x09, 0x8b, 0xd4, 0x21, 0x6a, 0x87, 0x5a, 0x50, 0x63, 0x31, 0xe1, 0x58,
-		0x30, 0xae, 0x9c, 0x2c, 0xf6, 0xe8, 0x27, 0xa8, 0xfb, 0x9c, 0x60, 0x41, 0x02, 0x2f, 0xbd, 0x7c,
-		0xe5, 0xc5, 0xa9, 0x77, 0x5b, 0x1b, 0x57, 0xcc, 0x28, 0xbf, 0x99, 0x5d, 0x5d, 0xd5, 0xa7, 0x91,
-		0x93, 0xff, 0x2a, 0x50, 0x5f, 0x7d, 0xbf, 0xa5, 0xed, 0xa6, 0x95, 0xb7, 0xdb, 0x08, 0x8c, 0xa2,
-		0x34, 0x11, 0x98, 0x0b, 0xaf, 0xb8, 0xfe, 0x95, 0x23, 0xf7, 0xc9, 0x78, 0x9a, 0x63, 0x87, 0x29,
-		0xb4, 0x88, 0xa3, 0x2b, 0x38, 0x2a, 0x58, 0xc9, 0x87, 0x98, 0x72, 0xb2, 0x42, 0xfb, 0xf0, 0xd3,
-		0x1d, 0xe6, 0x60, 0x47, 0x62, 0x6f, 0x79, 0xbb, 0x70, 0xe0, 0xb3, 0x79, 0x1c, 0x92, 0xd4, 0xaa,
-		0x64, 0x86, 0x79, 0xe0, 0xf9, 0x6c, 0x11, 0x09, 0xf9, 0xa9, 0xd8, 0x76, 0xf7, 0x8b, 0xe4, 0x30,
-		0xcd, 0xf5, 0xd3, 0x14, 0x7a, 0x01, 0x8d, 0x98, 0x44, 0x01, 0x8d, 0xa6, 0x19, 0x22, 0x31, 0xb6,
-		0xcd, 0x6a, 0x7b, 0xdb, 0xdd, 0x53, 0x51, 0x59, 0x9a, 0xbc, 0xfc, 0x4b, 0x83, 0xfa, 0xea, 0x47,
-		0x09, 0x1d, 0xc1, 0x81, 0xfd, 0xdb, 0xaf, 0xbd, 0xc1, 0x85, 0x37, 0x1c, 0xf5, 0x46, 0xef, 0x86,
-		0xde, 0xe0, 0xe2, 0xaa, 0x77, 0x3e, 0xb0, 0x9b, 0x9f, 0xa0, 0xcf, 0xc1, 0xb8, 0x9b, 0x72, 0x9d,
-		0xb7, 0x83, 0xe1, 0xc8, 0x71, 0x1d, 0xbb, 0xa9, 0x6d, 0x66, 0x6d, 0xe7, 0xd2, 0x75, 0xfa, 0xbd,
-		0x91, 0x63, 0x37, 0x2b, 0x9b, 0xb4, 0xb6, 0x73, 0xee, 0xa4, 0xa9, 0xea, 0xcb, 0x19, 0x34, 0xd6,
-		0x6e, 0xbc, 0xcf, 0xe0, 0xb0, 0xe7, 0xf6, 0x7f, 0x1e, 0x5c, 0xf5, 0xce, 0x4b, 0x55, 0xac, 0x27,
-		0xed, 0xc1, 0xb0, 0x77, 0x76, 0x2e, 0x55, 0x94, 0x40, 0x9d, 0x8b, 0x2c, 0x59, 0x39, 0xfb, 0x1d,
-		0x0e, 0x7d, 0x36, 0x2f, 0x6b, 0xf5, 0xb3, 0x5a, 0x2f, 0xa6, 0x97, 0xe9, 0x2b, 0xb9, 0xd4, 0xfe,
-		0x38, 0x9d, 0x52, 0x31, 0x5b, 0x8c, 0x2d, 0x9f, 0xcd, 0x3b, 0xab, 0x3f, 0x1f, 0x5f, 0xd1, 0x20,
-		0xec, 0x4c, 0x59, 0xf6, 0xcb, 0xa0, 0xfe, 0x44, 0x7e, 0xc4, 0x31, 0x5d, 0x9e, 0x8e, 0x77, 0x64,
-		0xec, 0x9b, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x98, 0xc3, 0x6b, 0x64, 0xad, 0x08, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0x51, 0x6f, 0xdb, 0x36,
+		0x17, 0xfd, 0x64, 0x27, 0xf9, 0x9c, 0x2b, 0xc7, 0x75, 0x99, 0xa6, 0x51, 0xbc, 0x61, 0x51, 0x53,
+		0x14, 0xf0, 0x0a, 0x4c, 0x5e, 0xbc, 0x61, 0x6b, 0x37, 0xec, 0xc1, 0xb1, 0xd4, 0xce, 0x43, 0x96,
+		0x05, 0xb2, 0x1b, 0x0c, 0xdb, 0x83, 0x40, 0x4b, 0xb4, 0x4d, 0x54, 0x16, 0x05, 0x8a, 0x72, 0x9a,
+		0xb7, 0x61, 0x3f, 0x62, 0x3f, 0x66, 0x8f, 0xfb, 0x65, 0x83, 0x28, 0x4a, 0x71, 0x62, 0xa1, 0xd9,
+		0x1b, 0x79, 0xef, 0x3d, 0x87, 0x47, 0x47, 0xf7, 0x52, 0x02, 0x33, 0x9d, 0x12, 0xde, 0xf3, 0x71,
+		0x40, 0x22, 0x9f, 0xf4, 0x70, 0x4c, 0x7b, 0xab, 0xd3, 0x5e, 0xc0, 0x96, 0x98, 0x46, 0x56, 0xcc,
+		0x99, 0x60, 0x68, 0x3f, 0xab, 0xb0, 0x54, 0x85, 0x85, 0x63, 0x6a, 0xad, 0x4e, 0x3b, 0x9f, 0xcd,
+		0x19, 0x9b, 0x87, 0xa4, 0x27, 0x4b, 0xa6, 0xe9, 0xac, 0x17, 0xa4, 0x1c, 0x0b, 0xca, 0x14, 0xa8,
+		0x73, 0x7c, 0x3f, 0x2f, 0xe8, 0x92, 0x24, 0x02, 0x2f, 0xe3, 0xbc, 0xe0, 0xe4, 0xaf, 0x06, 0xec,
+		0xd8, 0xf2, 0x18, 0xd4, 0x82, 0x1a, 0x0d, 0x0c, 0xcd, 0xd4, 0xba, 0xbb, 0x6e, 0x8d, 0x06, 0x08,
+		0xc1, 0x56, 0x84, 0x97, 0xc4, 0xa8, 0xc9, 0x88, 0x5c, 0xa3, 0xd7, 0xb0, 0x93, 0x08, 0x2c, 0xd2,
+		0xc4, 0xa8, 0x9b, 0x5a, 0xb7, 0xd5, 0x7f, 0x66, 0x55, 0xa8, 0xb2, 0x72, 0xc2, 0xb1, 0x2c, 0x74,
+		0x15, 0x00, 0x99, 0xa0, 0x07, 0x24, 0xf1, 0x39, 0x8d, 0x33, 0x7d, 0xc6, 0x96, 0x64, 0x5d, 0x0f,
+		0xa1, 0x63, 0xd0, 0xd9, 0x75, 0x44, 0xb8, 0x47, 0x96, 0x98, 0x86, 0xc6, 0xb6, 0xac, 0x00, 0x19,
+		0x72, 0xb2, 0x08, 0x7a, 0x0d, 0x5b, 0x01, 0x16, 0xd8, 0xd8, 0x31, 0xeb, 0x5d, 0xbd, 0xff, 0xe2,
+		0x23, 0x67, 0x5b, 0x36, 0x16, 0xd8, 0x89, 0x04, 0xbf, 0x71, 0x25, 0x04, 0x2d, 0xe0, 0xf9, 0x35,
+		0xe3, 0xef, 0x67, 0x21, 0xbb, 0xf6, 0xc8, 0x07, 0xe2, 0xa7, 0xd9, 0x89, 0x1e, 0x27, 0x82, 0x44,
+		0x72, 0x15, 0x13, 0x4e, 0x59, 0x60, 0xfc, 0xdf, 0xd4, 0xba, 0x7a, 0xff, 0xc8, 0xca, 0x6d, 0xb3,
+		0x0a, 0xdb, 0x2c, 0x5b, 0xd9, 0xea, 0x9a, 0x05, 0x8b, 0x53, 0x90, 0xb8, 0x05, 0xc7, 0xa5, 0xa4,
+		0x40, 0x43, 0x68, 0x4e, 0x71, 0xe0, 0x4d, 0x69, 0x84, 0x39, 0x25, 0x89, 0xd1, 0x90, 0x94, 0x66,
+		0xa5, 0xd8, 0x33, 0x1c, 0x9c, 0xa9, 0x3a, 0x57, 0x9f, 0xde, 0x6e, 0xd0, 0xef, 0x70, 0xb8, 0xa0,
+		0x89, 0x60, 0xfc, 0xc6, 0xc3, 0xdc, 0x5f, 0xd0, 0x15, 0x0e, 0x3d, 0x65, 0xfc, 0xae, 0x34, 0xfe,
+		0x79, 0x25, 0xdf, 0x40, 0xd5, 0x2a, 0xeb, 0x0f, 0x14, 0xc7, 0xdd, 0x30, 0xfa, 0x12, 0x9e, 0x6c,
+		0x90, 0xa7, 0x9c, 0x1a, 0x20, 0x0d, 0x47, 0xf7, 0x40, 0xef, 0x38, 0x45, 0x18, 0x3a, 0x2b, 0x9a,
+		0xd0, 0x29, 0x0d, 0xa9, 0xd8, 0x54, 0xa4, 0xff, 0x77, 0x45, 0xc6, 0x2d, 0xcd, 0x3d, 0x51, 0xdf,
+		0xc0, 0x61, 0xd5, 0x11, 0x99, 0xae, 0xa6, 0xd4, 0x75, 0xb0, 0x09, 0xcd, 0xa4, 0x59, 0xb0, 0x8f,
+		0x7d, 0x41, 0x57, 0xc4, 0xf3, 0xc3, 0x34, 0x11, 0x84, 0x7b, 0xb2, 0x69, 0xf7, 0x24, 0xe6, 0x71,
+		0x9e, 0x1a, 0xe6, 0x99, 0x8b, 0xac, 0x83, 0x2f, 0xa1, 0xa1, 0x0a, 0x13, 0xa3, 0x25, 0xfb, 0xe8,
+		0xeb, 0x4a, 0xe1, 0x0a, 0xe3, 0x92, 0x38, 0xa4, 0xbe, 0x7c, 0xf7, 0x43, 0x16, 0xcd, 0xe8, 0xbc,
+		0x68, 0x84, 0x92, 0x05, 0x7d, 0x0e, 0xed, 0x19, 0xa6, 0x21, 0x5b, 0x11, 0xee, 0xad, 0x08, 0x4f,
+		0xb2, 0xee, 0x7e, 0x64, 0x6a, 0xdd, 0xba, 0xfb, 0xa8, 0x88, 0x5f, 0xe5, 0x61, 0xd4, 0x85, 0x36,
+		0x4d, 0xbc, 0x79, 0xc8, 0xa6, 0x38, 0xf4, 0xf2, 0xe9, 0x36, 0xda, 0xa6, 0xd6, 0x6d, 0xb8, 0x2d,
+		0x9a, 0xbc, 0x95, 0x61, 0x35, 0x8c, 0x6f, 0x60, 0xaf, 0x24, 0xa5, 0xd1, 0x8c, 0x19, 0x8f, 0x65,
+		0x1b, 0x55, 0xcf, 0xdb, 0x1b, 0x55, 0x39, 0x8a, 0x66, 0xcc, 0x6d, 0xce, 0xd6, 0x76, 0x9d, 0x6f,
+		0x61, 0xb7, 0x1c, 0x05, 0xd4, 0x86, 0xfa, 0x7b, 0x72, 0xa3, 0x46, 0x3c, 0x5b, 0xa2, 0x27, 0xb0,
+		0xbd, 0xc2, 0x61, 0x5a, 0x0c, 0x79, 0xbe, 0xf9, 0xae, 0xf6, 0x4a, 0x3b, 0xb1, 0xe1, 0xf8, 0x01,
+		0x0b, 0xd0, 0x33, 0x68, 0xde, 0xf1, 0x3c, 0xe7, 0xd5, 0xfd, 0x5b, 0xb7, 0x4f, 0xfe, 0xd6, 0x40,
+		0x5f, 0x6b, 0x72, 0xf4, 0x13, 0x34, 0xca, 0xc1, 0xd0, 0xa4, 0xfb, 0xd6, 0x43, 0x83, 0x61, 0x15,
+		0x8b, 0x7c, 0x9c, 0x4b, 0x7c, 0xc7, 0x83, 0xbd, 0x3b, 0xa9, 0x8a, 0xc7, 0x7b, 0xb5, 0xfe, 0x78,
+		0x7a, 0xff, 0xe4, 0xa3, 0x67, 0xdd, 0x48, 0xfb, 0xd6, 0x2c, 0xf8, 0x53, 0x83, 0xbd, 0x3b, 0x49,
+		0xf4, 0x14, 0x76, 0x38, 0xc1, 0x09, 0x8b, 0xd4, 0x21, 0x6a, 0x87, 0x3a, 0xd0, 0x60, 0x31, 0xe1,
+		0x58, 0x30, 0xae, 0x9c, 0x2c, 0xf7, 0xe8, 0x07, 0x68, 0xfa, 0x9c, 0x60, 0x41, 0x02, 0x2f, 0xbb,
+		0x7c, 0xe5, 0xc5, 0xa9, 0xf7, 0x3b, 0x1b, 0x57, 0xcc, 0xa4, 0xb8, 0x99, 0x5d, 0x5d, 0xd5, 0x67,
+		0x91, 0x93, 0x7f, 0x6a, 0xd0, 0x5c, 0x7f, 0xbf, 0x95, 0xed, 0xa6, 0x55, 0xb7, 0xdb, 0x04, 0x8c,
+		0xb2, 0x34, 0x11, 0x98, 0x0b, 0xaf, 0xbc, 0xfe, 0x8d, 0xda, 0x83, 0x32, 0x9e, 0x16, 0xd8, 0x71,
+		0x06, 0x2d, 0xe3, 0xe8, 0x0a, 0x8e, 0x4a, 0x56, 0xf2, 0x21, 0xa6, 0x9c, 0xac, 0xd1, 0x3e, 0xfc,
+		0x74, 0x87, 0x05, 0xd8, 0x91, 0xd8, 0x5b, 0xde, 0x3e, 0x1c, 0xf8, 0x6c, 0x19, 0x87, 0x24, 0xb3,
+		0x2a, 0x59, 0x60, 0x1e, 0x78, 0x3e, 0x4b, 0x23, 0x21, 0x3f, 0x15, 0xdb, 0xee, 0x7e, 0x99, 0x1c,
+		0x67, 0xb9, 0x61, 0x96, 0x42, 0x2f, 0xa0, 0x15, 0x93, 0x28, 0xa0, 0xd1, 0x3c, 0x47, 0x24, 0xc6,
+		0xb6, 0x59, 0xef, 0x6e, 0xbb, 0x7b, 0x2a, 0x2a, 0x4b, 0x93, 0x97, 0x7f, 0x68, 0xd0, 0x5c, 0xff,
+		0x28, 0xa1, 0x23, 0x38, 0xb0, 0x7f, 0xf9, 0x79, 0x30, 0xba, 0xf0, 0xc6, 0x93, 0xc1, 0xe4, 0xdd,
+		0xd8, 0x1b, 0x5d, 0x5c, 0x0d, 0xce, 0x47, 0x76, 0xfb, 0x7f, 0xe8, 0x53, 0x30, 0xee, 0xa6, 0x5c,
+		0xe7, 0xed, 0x68, 0x3c, 0x71, 0x5c, 0xc7, 0x6e, 0x6b, 0x9b, 0x59, 0xdb, 0xb9, 0x74, 0x9d, 0xe1,
+		0x60, 0xe2, 0xd8, 0xed, 0xda, 0x26, 0xad, 0xed, 0x9c, 0x3b, 0x59, 0xaa, 0xfe, 0x72, 0x01, 0xad,
+		0x7b, 0x37, 0xde, 0x27, 0x70, 0x38, 0x70, 0x87, 0x3f, 0x8e, 0xae, 0x06, 0xe7, 0x95, 0x2a, 0xee,
+		0x27, 0xed, 0xd1, 0x78, 0x70, 0x76, 0x2e, 0x55, 0x54, 0x40, 0x9d, 0x8b, 0x3c, 0x59, 0x3b, 0xfb,
+		0x15, 0x0e, 0x7d, 0xb6, 0xac, 0x6a, 0xf5, 0xb3, 0xc6, 0x20, 0xa6, 0x97, 0xd9, 0x2b, 0xb9, 0xd4,
+		0x7e, 0x3b, 0x9d, 0x53, 0xb1, 0x48, 0xa7, 0x96, 0xcf, 0x96, 0xbd, 0xf5, 0x9f, 0x8f, 0x2f, 0x68,
+		0x10, 0xf6, 0xe6, 0x2c, 0xff, 0x65, 0x50, 0x7f, 0x22, 0xdf, 0xe3, 0x98, 0xae, 0x4e, 0xa7, 0x3b,
+		0x32, 0xf6, 0xd5, 0xbf, 0x03, 0x00, 0x98, 0xc3, 0x6b, 0x64, 0xad, 0x08, 0x00, 0x00,
+	},
+	// uber/cadence/shared/v1/domain.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xcf, 0x4a, 0xc4, 0x30,
+		0x10, 0x87, 0x89, 0xeb, 0xdf, 0x88, 0x22, 0x39, 0x48, 0xf0, 0x62, 0x51, 0x84, 0x9e, 0x12, 0x8a,
+		0x88, 0x07, 0x4f, 0xea, 0x0b, 0x48, 0x11, 0x04, 0x2f, 0x92, 0x26, 0xb3, 0xdd, 0xe0, 0x6e, 0xa6,
+		0x4e, 0x9b, 0x88, 0x6f, 0xe1, 0x23, 0x4b, 0xd3, 0x8a, 0x78, 0xfb, 0xcd, 0x37, 0xdf, 0xe5, 0xe3,
+		0x97, 0xb1, 0x01, 0xd2, 0xd6, 0x38, 0x08, 0x16, 0x74, 0xbf, 0x32, 0x04, 0x4e, 0xa7, 0x4a, 0x3b,
+		0xdc, 0x18, 0x1f, 0x54, 0x47, 0x38, 0xa0, 0x38, 0x1d, 0x25, 0x35, 0x4b, 0x6a, 0x92, 0x54, 0xaa,
+		0x2e, 0xbe, 0x19, 0x3f, 0xbb, 0xef, 0xbf, 0x82, 0x7d, 0x41, 0x7a, 0x5f, 0xae, 0xf1, 0xf3, 0x11,
+		0xc3, 0xd2, 0xb7, 0x91, 0xcc, 0xe0, 0x31, 0x08, 0xc9, 0xf7, 0x20, 0x98, 0x66, 0x0d, 0x4e, 0xb2,
+		0x82, 0x95, 0xfb, 0xf5, 0xef, 0x29, 0xae, 0xf8, 0xf1, 0x47, 0x84, 0x08, 0x6f, 0x1d, 0x61, 0xf2,
+		0x0e, 0x48, 0x6e, 0x15, 0xac, 0x3c, 0xa8, 0x8f, 0x32, 0x7d, 0x9a, 0xa1, 0x38, 0xe7, 0x87, 0x93,
+		0x36, 0x60, 0xe7, 0xad, 0x5c, 0x64, 0x87, 0x67, 0xf4, 0x3c, 0x12, 0x71, 0xc2, 0x17, 0xd4, 0xf5,
+		0x72, 0xbb, 0x60, 0xe5, 0x4e, 0x3d, 0xce, 0x87, 0xdb, 0xd7, 0x9b, 0xd6, 0x0f, 0xab, 0xd8, 0x28,
+		0x8b, 0x1b, 0xfd, 0x2f, 0x4e, 0xb5, 0x10, 0x74, 0x0e, 0xfa, 0xeb, 0xbc, 0x9b, 0x56, 0xaa, 0x9a,
+		0xdd, 0xfc, 0xb9, 0xfe, 0x19, 0x00, 0x42, 0xb7, 0x3c, 0x1a, 0x11, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -134,6 +134,17 @@ func (c *clientImpl) DescribeQueue(
 	return c.client.DescribeQueue(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetReplicationAckStates(
+	ctx context.Context,
+	request *types.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationAckStatesResponse, error) {
+
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetReplicationAckStates(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.AdminDescribeWorkflowExecutionRequest,
@@ -292,6 +303,106 @@ func (c *clientImpl) GetDynamicConfig(
 	return c.client.GetDynamicConfig(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.GetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDomainReplicationWatermarkResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDomainReplicationWatermark(ctx, request, opts...)
+}
+
+func (c *clientImpl) IssueDomainToken(
+	ctx context.Context,
+	request *types.IssueDomainTokenRequest,
+	opts ...yarpc.CallOption,
+) (*types.IssueDomainTokenResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.IssueDomainToken(ctx, request, opts...)
+}
+
+func (c *clientImpl) StealTaskListLease(
+	ctx context.Context,
+	request *types.StealTaskListLeaseRequest,
+	opts ...yarpc.CallOption,
+) (*types.StealTaskListLeaseResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.StealTaskListLease(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskListConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetTaskListUsage(
+	ctx context.Context,
+	request *types.GetTaskListUsageRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListUsageResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetTaskListUsage(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskListTags(
+	ctx context.Context,
+	request *types.UpdateTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListTagsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateTaskListTags(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListTaskListTags(
+	ctx context.Context,
+	request *types.ListTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListTaskListTagsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListTaskListTags(ctx, request, opts...)
+}
+
+func (c *clientImpl) BackfillDomainReplication(
+	ctx context.Context,
+	request *types.BackfillDomainReplicationRequest,
+	opts ...yarpc.CallOption,
+) (*types.BackfillDomainReplicationResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.BackfillDomainReplication(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.UpdateDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateDomainAsyncWorkflowConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) GetDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.GetDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDomainAsyncWorkflowConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDomainAsyncWorkflowConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) UpdateDynamicConfig(
 	ctx context.Context,
 	request *types.UpdateDynamicConfigRequest,
@@ -355,3 +466,13 @@ func (c *clientImpl) createContextWithLargeTimeout(parent context.Context) (cont
 	}
 	return context.WithTimeout(parent, c.largeTimeout)
 }
+
+func (c *clientImpl) ReconcileDomainRetention(
+	ctx context.Context,
+	request *types.ReconcileDomainRetentionRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReconcileDomainRetentionResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ReconcileDomainRetention(ctx, request, opts...)
+}
@@ -234,6 +234,32 @@ func (c *errorInjectionClient) DescribeQueue(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) GetReplicationAckStates(
+	ctx context.Context,
+	request *types.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationAckStatesResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.GetReplicationAckStatesResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.GetReplicationAckStates(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationGetReplicationAckStates,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
 func (c *errorInjectionClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.AdminDescribeWorkflowExecutionRequest,
@@ -619,6 +645,266 @@ func (c *errorInjectionClient) GetDynamicConfig(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.GetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDomainReplicationWatermarkResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.GetDomainReplicationWatermarkResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.GetDomainReplicationWatermark(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationGetDomainReplicationWatermark,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) IssueDomainToken(
+	ctx context.Context,
+	request *types.IssueDomainTokenRequest,
+	opts ...yarpc.CallOption,
+) (*types.IssueDomainTokenResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.IssueDomainTokenResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.IssueDomainToken(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationIssueDomainToken,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) StealTaskListLease(
+	ctx context.Context,
+	request *types.StealTaskListLeaseRequest,
+	opts ...yarpc.CallOption,
+) (*types.StealTaskListLeaseResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.StealTaskListLeaseResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.StealTaskListLease(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationStealTaskListLease,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.DescribeTaskListConfigResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.DescribeTaskListConfig(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationDescribeTaskListConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) GetTaskListUsage(
+	ctx context.Context,
+	request *types.GetTaskListUsageRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListUsageResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.GetTaskListUsageResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.GetTaskListUsage(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationGetTaskListUsage,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) UpdateTaskListTags(
+	ctx context.Context,
+	request *types.UpdateTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListTagsResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.UpdateTaskListTagsResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.UpdateTaskListTags(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationUpdateTaskListTags,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) ListTaskListTags(
+	ctx context.Context,
+	request *types.ListTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListTaskListTagsResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.ListTaskListTagsResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.ListTaskListTags(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationListTaskListTags,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) BackfillDomainReplication(
+	ctx context.Context,
+	request *types.BackfillDomainReplicationRequest,
+	opts ...yarpc.CallOption,
+) (*types.BackfillDomainReplicationResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.BackfillDomainReplicationResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.BackfillDomainReplication(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationBackfillDomainReplication,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) UpdateDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.UpdateDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.UpdateDomainAsyncWorkflowConfigResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.UpdateDomainAsyncWorkflowConfig(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationUpdateDomainAsyncWorkflowConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) GetDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.GetDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDomainAsyncWorkflowConfigResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.GetDomainAsyncWorkflowConfigResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.GetDomainAsyncWorkflowConfig(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationGetDomainAsyncWorkflowConfig,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
 func (c *errorInjectionClient) UpdateDynamicConfig(
 	ctx context.Context,
 	request *types.UpdateDynamicConfigRequest,
@@ -746,3 +1032,29 @@ func (c *errorInjectionClient) ListDynamicConfig(
 	}
 	return resp, clientErr
 }
+
+func (c *errorInjectionClient) ReconcileDomainRetention(
+	ctx context.Context,
+	request *types.ReconcileDomainRetentionRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReconcileDomainRetentionResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.ReconcileDomainRetentionResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.ReconcileDomainRetention(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.AdminClientOperationReconcileDomainRetention,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
@@ -68,6 +68,11 @@ func (g grpcClient) DescribeQueue(ctx context.Context, request *types.DescribeQu
 	return proto.ToAdminDescribeQueueResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) GetReplicationAckStates(ctx context.Context, request *types.GetReplicationAckStatesRequest, opts ...yarpc.CallOption) (*types.GetReplicationAckStatesResponse, error) {
+	response, err := g.c.GetReplicationAckStates(ctx, proto.FromAdminGetReplicationAckStatesRequest(request), opts...)
+	return proto.ToAdminGetReplicationAckStatesResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) DescribeWorkflowExecution(ctx context.Context, request *types.AdminDescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	response, err := g.c.DescribeWorkflowExecution(ctx, proto.FromAdminDescribeWorkflowExecutionRequest(request), opts...)
 	return proto.ToAdminDescribeWorkflowExecutionResponse(response), proto.ToError(err)
@@ -148,6 +153,56 @@ func (g grpcClient) GetDynamicConfig(ctx context.Context, request *types.GetDyna
 	return proto.ToGetDynamicConfigResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) GetDomainReplicationWatermark(ctx context.Context, request *types.GetDomainReplicationWatermarkRequest, opts ...yarpc.CallOption) (*types.GetDomainReplicationWatermarkResponse, error) {
+	response, err := g.c.GetDomainReplicationWatermark(ctx, proto.FromAdminGetDomainReplicationWatermarkRequest(request), opts...)
+	return proto.ToAdminGetDomainReplicationWatermarkResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) IssueDomainToken(ctx context.Context, request *types.IssueDomainTokenRequest, opts ...yarpc.CallOption) (*types.IssueDomainTokenResponse, error) {
+	response, err := g.c.IssueDomainToken(ctx, proto.FromAdminIssueDomainTokenRequest(request), opts...)
+	return proto.ToAdminIssueDomainTokenResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) StealTaskListLease(ctx context.Context, request *types.StealTaskListLeaseRequest, opts ...yarpc.CallOption) (*types.StealTaskListLeaseResponse, error) {
+	response, err := g.c.StealTaskListLease(ctx, proto.FromAdminStealTaskListLeaseRequest(request), opts...)
+	return proto.ToAdminStealTaskListLeaseResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) DescribeTaskListConfig(ctx context.Context, request *types.DescribeTaskListConfigRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	response, err := g.c.DescribeTaskListConfig(ctx, proto.FromAdminDescribeTaskListConfigRequest(request), opts...)
+	return proto.ToAdminDescribeTaskListConfigResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) GetTaskListUsage(ctx context.Context, request *types.GetTaskListUsageRequest, opts ...yarpc.CallOption) (*types.GetTaskListUsageResponse, error) {
+	response, err := g.c.GetTaskListUsage(ctx, proto.FromAdminGetTaskListUsageRequest(request), opts...)
+	return proto.ToAdminGetTaskListUsageResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) UpdateTaskListTags(ctx context.Context, request *types.UpdateTaskListTagsRequest, opts ...yarpc.CallOption) (*types.UpdateTaskListTagsResponse, error) {
+	response, err := g.c.UpdateTaskListTags(ctx, proto.FromAdminUpdateTaskListTagsRequest(request), opts...)
+	return proto.ToAdminUpdateTaskListTagsResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) ListTaskListTags(ctx context.Context, request *types.ListTaskListTagsRequest, opts ...yarpc.CallOption) (*types.ListTaskListTagsResponse, error) {
+	response, err := g.c.ListTaskListTags(ctx, proto.FromAdminListTaskListTagsRequest(request), opts...)
+	return proto.ToAdminListTaskListTagsResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) BackfillDomainReplication(ctx context.Context, request *types.BackfillDomainReplicationRequest, opts ...yarpc.CallOption) (*types.BackfillDomainReplicationResponse, error) {
+	response, err := g.c.BackfillDomainReplication(ctx, proto.FromAdminBackfillDomainReplicationRequest(request), opts...)
+	return proto.ToAdminBackfillDomainReplicationResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) UpdateDomainAsyncWorkflowConfig(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfigRequest, opts ...yarpc.CallOption) (*types.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	response, err := g.c.UpdateDomainAsyncWorkflowConfig(ctx, proto.FromAdminUpdateDomainAsyncWorkflowConfigRequest(request), opts...)
+	return proto.ToAdminUpdateDomainAsyncWorkflowConfigResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) GetDomainAsyncWorkflowConfig(ctx context.Context, request *types.GetDomainAsyncWorkflowConfigRequest, opts ...yarpc.CallOption) (*types.GetDomainAsyncWorkflowConfigResponse, error) {
+	response, err := g.c.GetDomainAsyncWorkflowConfig(ctx, proto.FromAdminGetDomainAsyncWorkflowConfigRequest(request), opts...)
+	return proto.ToAdminGetDomainAsyncWorkflowConfigResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) UpdateDynamicConfig(ctx context.Context, request *types.UpdateDynamicConfigRequest, opts ...yarpc.CallOption) error {
 	_, err := g.c.UpdateDynamicConfig(ctx, proto.FromUpdateDynamicConfigRequest(request), opts...)
 	return proto.ToError(err)
@@ -172,3 +227,8 @@ func (g grpcClient) ListDynamicConfig(ctx context.Context, request *types.ListDy
 	response, err := g.c.ListDynamicConfig(ctx, proto.FromListDynamicConfigRequest(request), opts...)
 	return proto.ToListDynamicConfigResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) ReconcileDomainRetention(ctx context.Context, request *types.ReconcileDomainRetentionRequest, opts ...yarpc.CallOption) (*types.ReconcileDomainRetentionResponse, error) {
+	response, err := g.c.ReconcileDomainRetention(ctx, proto.FromAdminReconcileDomainRetentionRequest(request), opts...)
+	return proto.ToAdminReconcileDomainRetentionResponse(response), proto.ToError(err)
+}
@@ -38,6 +38,7 @@ type Client interface {
 	DescribeShardDistribution(context.Context, *types.DescribeShardDistributionRequest, ...yarpc.CallOption) (*types.DescribeShardDistributionResponse, error)
 	DescribeHistoryHost(context.Context, *types.DescribeHistoryHostRequest, ...yarpc.CallOption) (*types.DescribeHistoryHostResponse, error)
 	DescribeQueue(context.Context, *types.DescribeQueueRequest, ...yarpc.CallOption) (*types.DescribeQueueResponse, error)
+	GetReplicationAckStates(context.Context, *types.GetReplicationAckStatesRequest, ...yarpc.CallOption) (*types.GetReplicationAckStatesResponse, error)
 	DescribeWorkflowExecution(context.Context, *types.AdminDescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error)
 	GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDLQReplicationMessagesResponse, error)
 	GetDomainReplicationMessages(context.Context, *types.GetDomainReplicationMessagesRequest, ...yarpc.CallOption) (*types.GetDomainReplicationMessagesResponse, error)
@@ -54,6 +55,17 @@ type Client interface {
 	GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest, ...yarpc.CallOption) (*types.GetCrossClusterTasksResponse, error)
 	RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest, ...yarpc.CallOption) (*types.RespondCrossClusterTasksCompletedResponse, error)
 	GetDynamicConfig(context.Context, *types.GetDynamicConfigRequest, ...yarpc.CallOption) (*types.GetDynamicConfigResponse, error)
+	GetDomainReplicationWatermark(context.Context, *types.GetDomainReplicationWatermarkRequest, ...yarpc.CallOption) (*types.GetDomainReplicationWatermarkResponse, error)
+	IssueDomainToken(context.Context, *types.IssueDomainTokenRequest, ...yarpc.CallOption) (*types.IssueDomainTokenResponse, error)
+	StealTaskListLease(context.Context, *types.StealTaskListLeaseRequest, ...yarpc.CallOption) (*types.StealTaskListLeaseResponse, error)
+	DescribeTaskListConfig(context.Context, *types.DescribeTaskListConfigRequest, ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error)
+	GetTaskListUsage(context.Context, *types.GetTaskListUsageRequest, ...yarpc.CallOption) (*types.GetTaskListUsageResponse, error)
+	UpdateTaskListTags(context.Context, *types.UpdateTaskListTagsRequest, ...yarpc.CallOption) (*types.UpdateTaskListTagsResponse, error)
+	ListTaskListTags(context.Context, *types.ListTaskListTagsRequest, ...yarpc.CallOption) (*types.ListTaskListTagsResponse, error)
+	BackfillDomainReplication(context.Context, *types.BackfillDomainReplicationRequest, ...yarpc.CallOption) (*types.BackfillDomainReplicationResponse, error)
+	ReconcileDomainRetention(context.Context, *types.ReconcileDomainRetentionRequest, ...yarpc.CallOption) (*types.ReconcileDomainRetentionResponse, error)
+	UpdateDomainAsyncWorkflowConfig(context.Context, *types.UpdateDomainAsyncWorkflowConfigRequest, ...yarpc.CallOption) (*types.UpdateDomainAsyncWorkflowConfigResponse, error)
+	GetDomainAsyncWorkflowConfig(context.Context, *types.GetDomainAsyncWorkflowConfigRequest, ...yarpc.CallOption) (*types.GetDomainAsyncWorkflowConfigResponse, error)
 	UpdateDynamicConfig(context.Context, *types.UpdateDynamicConfigRequest, ...yarpc.CallOption) error
 	RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest, ...yarpc.CallOption) error
 	ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest, ...yarpc.CallOption) (*types.ListDynamicConfigResponse, error)
@@ -177,6 +177,26 @@ func (mr *MockClientMockRecorder) DescribeQueue(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockClient)(nil).DescribeQueue), varargs...)
 }
 
+// GetReplicationAckStates mocks base method
+func (m *MockClient) GetReplicationAckStates(arg0 context.Context, arg1 *types.GetReplicationAckStatesRequest, arg2 ...yarpc.CallOption) (*types.GetReplicationAckStatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationAckStates", varargs...)
+	ret0, _ := ret[0].(*types.GetReplicationAckStatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationAckStates indicates an expected call of GetReplicationAckStates
+func (mr *MockClientMockRecorder) GetReplicationAckStates(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationAckStates", reflect.TypeOf((*MockClient)(nil).GetReplicationAckStates), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method
 func (m *MockClient) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.AdminDescribeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -491,6 +511,206 @@ func (mr *MockClientMockRecorder) GetDynamicConfig(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockClient)(nil).GetDynamicConfig), varargs...)
 }
 
+// GetDomainReplicationWatermark mocks base method
+func (m *MockClient) GetDomainReplicationWatermark(arg0 context.Context, arg1 *types.GetDomainReplicationWatermarkRequest, arg2 ...yarpc.CallOption) (*types.GetDomainReplicationWatermarkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainReplicationWatermark", varargs...)
+	ret0, _ := ret[0].(*types.GetDomainReplicationWatermarkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainReplicationWatermark indicates an expected call of GetDomainReplicationWatermark
+func (mr *MockClientMockRecorder) GetDomainReplicationWatermark(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationWatermark", reflect.TypeOf((*MockClient)(nil).GetDomainReplicationWatermark), varargs...)
+}
+
+// IssueDomainToken mocks base method
+func (m *MockClient) IssueDomainToken(arg0 context.Context, arg1 *types.IssueDomainTokenRequest, arg2 ...yarpc.CallOption) (*types.IssueDomainTokenResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "IssueDomainToken", varargs...)
+	ret0, _ := ret[0].(*types.IssueDomainTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueDomainToken indicates an expected call of IssueDomainToken
+func (mr *MockClientMockRecorder) IssueDomainToken(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueDomainToken", reflect.TypeOf((*MockClient)(nil).IssueDomainToken), varargs...)
+}
+
+// StealTaskListLease mocks base method
+func (m *MockClient) StealTaskListLease(arg0 context.Context, arg1 *types.StealTaskListLeaseRequest, arg2 ...yarpc.CallOption) (*types.StealTaskListLeaseResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StealTaskListLease", varargs...)
+	ret0, _ := ret[0].(*types.StealTaskListLeaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StealTaskListLease indicates an expected call of StealTaskListLease
+func (mr *MockClientMockRecorder) StealTaskListLease(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StealTaskListLease", reflect.TypeOf((*MockClient)(nil).StealTaskListLease), varargs...)
+}
+
+// DescribeTaskListConfig mocks base method
+func (m *MockClient) DescribeTaskListConfig(arg0 context.Context, arg1 *types.DescribeTaskListConfigRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", varargs...)
+	ret0, _ := ret[0].(*types.DescribeTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListConfig indicates an expected call of DescribeTaskListConfig
+func (mr *MockClientMockRecorder) DescribeTaskListConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListConfig", reflect.TypeOf((*MockClient)(nil).DescribeTaskListConfig), varargs...)
+}
+
+// GetTaskListUsage mocks base method
+func (m *MockClient) GetTaskListUsage(arg0 context.Context, arg1 *types.GetTaskListUsageRequest, arg2 ...yarpc.CallOption) (*types.GetTaskListUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTaskListUsage", varargs...)
+	ret0, _ := ret[0].(*types.GetTaskListUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListUsage indicates an expected call of GetTaskListUsage
+func (mr *MockClientMockRecorder) GetTaskListUsage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListUsage", reflect.TypeOf((*MockClient)(nil).GetTaskListUsage), varargs...)
+}
+
+// UpdateTaskListTags mocks base method
+func (m *MockClient) UpdateTaskListTags(arg0 context.Context, arg1 *types.UpdateTaskListTagsRequest, arg2 ...yarpc.CallOption) (*types.UpdateTaskListTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateTaskListTags", varargs...)
+	ret0, _ := ret[0].(*types.UpdateTaskListTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListTags indicates an expected call of UpdateTaskListTags
+func (mr *MockClientMockRecorder) UpdateTaskListTags(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListTags", reflect.TypeOf((*MockClient)(nil).UpdateTaskListTags), varargs...)
+}
+
+// ListTaskListTags mocks base method
+func (m *MockClient) ListTaskListTags(arg0 context.Context, arg1 *types.ListTaskListTagsRequest, arg2 ...yarpc.CallOption) (*types.ListTaskListTagsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTaskListTags", varargs...)
+	ret0, _ := ret[0].(*types.ListTaskListTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskListTags indicates an expected call of ListTaskListTags
+func (mr *MockClientMockRecorder) ListTaskListTags(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListTags", reflect.TypeOf((*MockClient)(nil).ListTaskListTags), varargs...)
+}
+
+// BackfillDomainReplication mocks base method
+func (m *MockClient) BackfillDomainReplication(arg0 context.Context, arg1 *types.BackfillDomainReplicationRequest, arg2 ...yarpc.CallOption) (*types.BackfillDomainReplicationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BackfillDomainReplication", varargs...)
+	ret0, _ := ret[0].(*types.BackfillDomainReplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDomainAsyncWorkflowConfig mocks base method
+func (m *MockClient) UpdateDomainAsyncWorkflowConfig(arg0 context.Context, arg1 *types.UpdateDomainAsyncWorkflowConfigRequest, arg2 ...yarpc.CallOption) (*types.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateDomainAsyncWorkflowConfig", varargs...)
+	ret0, _ := ret[0].(*types.UpdateDomainAsyncWorkflowConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainAsyncWorkflowConfig mocks base method
+func (m *MockClient) GetDomainAsyncWorkflowConfig(arg0 context.Context, arg1 *types.GetDomainAsyncWorkflowConfigRequest, arg2 ...yarpc.CallOption) (*types.GetDomainAsyncWorkflowConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainAsyncWorkflowConfig", varargs...)
+	ret0, _ := ret[0].(*types.GetDomainAsyncWorkflowConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillDomainReplication indicates an expected call of BackfillDomainReplication
+func (mr *MockClientMockRecorder) BackfillDomainReplication(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillDomainReplication", reflect.TypeOf((*MockClient)(nil).BackfillDomainReplication), varargs...)
+}
+
+// UpdateDomainAsyncWorkflowConfig indicates an expected call of UpdateDomainAsyncWorkflowConfig
+func (mr *MockClientMockRecorder) UpdateDomainAsyncWorkflowConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainAsyncWorkflowConfig", reflect.TypeOf((*MockClient)(nil).UpdateDomainAsyncWorkflowConfig), varargs...)
+}
+
+// GetDomainAsyncWorkflowConfig indicates an expected call of GetDomainAsyncWorkflowConfig
+func (mr *MockClientMockRecorder) GetDomainAsyncWorkflowConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainAsyncWorkflowConfig", reflect.TypeOf((*MockClient)(nil).GetDomainAsyncWorkflowConfig), varargs...)
+}
+
 // UpdateDynamicConfig mocks base method
 func (m *MockClient) UpdateDynamicConfig(arg0 context.Context, arg1 *types.UpdateDynamicConfigRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -588,3 +808,23 @@ func (mr *MockClientMockRecorder) ListDynamicConfig(arg0, arg1 interface{}, arg2
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockClient)(nil).ListDynamicConfig), varargs...)
 }
+
+// ReconcileDomainRetention mocks base method
+func (m *MockClient) ReconcileDomainRetention(arg0 context.Context, arg1 *types.ReconcileDomainRetentionRequest, arg2 ...yarpc.CallOption) (*types.ReconcileDomainRetentionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReconcileDomainRetention", varargs...)
+	ret0, _ := ret[0].(*types.ReconcileDomainRetentionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileDomainRetention indicates an expected call of ReconcileDomainRetention
+func (mr *MockClientMockRecorder) ReconcileDomainRetention(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileDomainRetention", reflect.TypeOf((*MockClient)(nil).ReconcileDomainRetention), varargs...)
+}
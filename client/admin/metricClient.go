@@ -170,6 +170,24 @@ func (c *metricClient) DescribeQueue(
 	return resp, err
 }
 
+func (c *metricClient) GetReplicationAckStates(
+	ctx context.Context,
+	request *types.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationAckStatesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientGetReplicationAckStatesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetReplicationAckStatesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetReplicationAckStates(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetReplicationAckStatesScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.AdminDescribeWorkflowExecutionRequest,
@@ -428,6 +446,176 @@ func (c *metricClient) GetDynamicConfig(
 	return resp, err
 }
 
+func (c *metricClient) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.GetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDomainReplicationWatermarkResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientGetDomainReplicationWatermarkScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetDomainReplicationWatermarkScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetDomainReplicationWatermark(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetDomainReplicationWatermarkScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) IssueDomainToken(
+	ctx context.Context,
+	request *types.IssueDomainTokenRequest,
+	opts ...yarpc.CallOption,
+) (*types.IssueDomainTokenResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientIssueDomainTokenScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientIssueDomainTokenScope, metrics.CadenceClientLatency)
+	resp, err := c.client.IssueDomainToken(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientIssueDomainTokenScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StealTaskListLease(
+	ctx context.Context,
+	request *types.StealTaskListLeaseRequest,
+	opts ...yarpc.CallOption,
+) (*types.StealTaskListLeaseResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientStealTaskListLeaseScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStealTaskListLeaseScope, metrics.CadenceClientLatency)
+	resp, err := c.client.StealTaskListLease(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStealTaskListLeaseScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTaskListConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeTaskListConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetTaskListUsage(
+	ctx context.Context,
+	request *types.GetTaskListUsageRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListUsageResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientGetTaskListUsageScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetTaskListUsageScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetTaskListUsage(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetTaskListUsageScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateTaskListTags(
+	ctx context.Context,
+	request *types.UpdateTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListTagsResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateTaskListTagsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateTaskListTagsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateTaskListTags(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateTaskListTagsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListTaskListTags(
+	ctx context.Context,
+	request *types.ListTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListTaskListTagsResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientListTaskListTagsScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListTaskListTagsScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListTaskListTags(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListTaskListTagsScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) BackfillDomainReplication(
+	ctx context.Context,
+	request *types.BackfillDomainReplicationRequest,
+	opts ...yarpc.CallOption,
+) (*types.BackfillDomainReplicationResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientBackfillDomainReplicationScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientBackfillDomainReplicationScope, metrics.CadenceClientLatency)
+	resp, err := c.client.BackfillDomainReplication(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientBackfillDomainReplicationScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.UpdateDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateDomainAsyncWorkflowConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateDomainAsyncWorkflowConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateDomainAsyncWorkflowConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateDomainAsyncWorkflowConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) GetDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.GetDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDomainAsyncWorkflowConfigResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientGetDomainAsyncWorkflowConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientGetDomainAsyncWorkflowConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetDomainAsyncWorkflowConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientGetDomainAsyncWorkflowConfigScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) UpdateDynamicConfig(
 	ctx context.Context,
 	request *types.UpdateDynamicConfigRequest,
@@ -512,3 +700,20 @@ func (c *metricClient) ListDynamicConfig(
 	}
 	return resp, err
 }
+
+func (c *metricClient) ReconcileDomainRetention(
+	ctx context.Context,
+	request *types.ReconcileDomainRetentionRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReconcileDomainRetentionResponse, error) {
+	c.metricsClient.IncCounter(metrics.AdminClientReconcileDomainRetentionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReconcileDomainRetentionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ReconcileDomainRetention(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReconcileDomainRetentionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
@@ -143,6 +143,22 @@ func (c *retryableClient) DescribeQueue(
 	return resp, err
 }
 
+func (c *retryableClient) GetReplicationAckStates(
+	ctx context.Context,
+	request *types.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationAckStatesResponse, error) {
+
+	var resp *types.GetReplicationAckStatesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationAckStates(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeWorkflowExecution(
 	ctx context.Context,
 	request *types.AdminDescribeWorkflowExecutionRequest,
@@ -361,6 +377,156 @@ func (c *retryableClient) GetDynamicConfig(
 	return resp, err
 }
 
+func (c *retryableClient) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.GetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDomainReplicationWatermarkResponse, error) {
+	var resp *types.GetDomainReplicationWatermarkResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDomainReplicationWatermark(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) IssueDomainToken(
+	ctx context.Context,
+	request *types.IssueDomainTokenRequest,
+	opts ...yarpc.CallOption,
+) (*types.IssueDomainTokenResponse, error) {
+	var resp *types.IssueDomainTokenResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.IssueDomainToken(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) StealTaskListLease(
+	ctx context.Context,
+	request *types.StealTaskListLeaseRequest,
+	opts ...yarpc.CallOption,
+) (*types.StealTaskListLeaseResponse, error) {
+	var resp *types.StealTaskListLeaseResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StealTaskListLease(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.DescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	var resp *types.DescribeTaskListConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskListConfig(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) GetTaskListUsage(
+	ctx context.Context,
+	request *types.GetTaskListUsageRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetTaskListUsageResponse, error) {
+	var resp *types.GetTaskListUsageResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetTaskListUsage(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskListTags(
+	ctx context.Context,
+	request *types.UpdateTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateTaskListTagsResponse, error) {
+	var resp *types.UpdateTaskListTagsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateTaskListTags(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) ListTaskListTags(
+	ctx context.Context,
+	request *types.ListTaskListTagsRequest,
+	opts ...yarpc.CallOption,
+) (*types.ListTaskListTagsResponse, error) {
+	var resp *types.ListTaskListTagsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListTaskListTags(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) BackfillDomainReplication(
+	ctx context.Context,
+	request *types.BackfillDomainReplicationRequest,
+	opts ...yarpc.CallOption,
+) (*types.BackfillDomainReplicationResponse, error) {
+	var resp *types.BackfillDomainReplicationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.BackfillDomainReplication(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.UpdateDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	var resp *types.UpdateDomainAsyncWorkflowConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateDomainAsyncWorkflowConfig(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) GetDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.GetDomainAsyncWorkflowConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetDomainAsyncWorkflowConfigResponse, error) {
+	var resp *types.GetDomainAsyncWorkflowConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDomainAsyncWorkflowConfig(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *retryableClient) UpdateDynamicConfig(
 	ctx context.Context,
 	request *types.UpdateDynamicConfigRequest,
@@ -427,3 +593,18 @@ func (c *retryableClient) ListDynamicConfig(
 	err := c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *retryableClient) ReconcileDomainRetention(
+	ctx context.Context,
+	request *types.ReconcileDomainRetentionRequest,
+	opts ...yarpc.CallOption,
+) (*types.ReconcileDomainRetentionResponse, error) {
+	var resp *types.ReconcileDomainRetentionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ReconcileDomainRetention(ctx, request, opts...)
+		return err
+	}
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
@@ -69,6 +69,11 @@ func (t thriftClient) DescribeQueue(ctx context.Context, request *types.Describe
 	return thrift.ToDescribeQueueResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) GetReplicationAckStates(ctx context.Context, request *types.GetReplicationAckStatesRequest, opts ...yarpc.CallOption) (*types.GetReplicationAckStatesResponse, error) {
+	response, err := t.c.GetReplicationAckStates(ctx, thrift.FromGetReplicationAckStatesRequest(request), opts...)
+	return thrift.ToGetReplicationAckStatesResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) DescribeWorkflowExecution(ctx context.Context, request *types.AdminDescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	response, err := t.c.DescribeWorkflowExecution(ctx, thrift.FromAdminDescribeWorkflowExecutionRequest(request), opts...)
 	return thrift.ToAdminDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
@@ -149,6 +154,56 @@ func (t thriftClient) GetDynamicConfig(ctx context.Context, request *types.GetDy
 	return thrift.ToGetDynamicConfigResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) GetDomainReplicationWatermark(ctx context.Context, request *types.GetDomainReplicationWatermarkRequest, opts ...yarpc.CallOption) (*types.GetDomainReplicationWatermarkResponse, error) {
+	response, err := t.c.GetDomainReplicationWatermark(ctx, thrift.FromGetDomainReplicationWatermarkRequest(request), opts...)
+	return thrift.ToGetDomainReplicationWatermarkResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) IssueDomainToken(ctx context.Context, request *types.IssueDomainTokenRequest, opts ...yarpc.CallOption) (*types.IssueDomainTokenResponse, error) {
+	response, err := t.c.IssueDomainToken(ctx, thrift.FromIssueDomainTokenRequest(request), opts...)
+	return thrift.ToIssueDomainTokenResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) StealTaskListLease(ctx context.Context, request *types.StealTaskListLeaseRequest, opts ...yarpc.CallOption) (*types.StealTaskListLeaseResponse, error) {
+	response, err := t.c.StealTaskListLease(ctx, thrift.FromStealTaskListLeaseRequest(request), opts...)
+	return thrift.ToStealTaskListLeaseResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) DescribeTaskListConfig(ctx context.Context, request *types.DescribeTaskListConfigRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	response, err := t.c.DescribeTaskListConfig(ctx, thrift.FromDescribeTaskListConfigRequest(request), opts...)
+	return thrift.ToDescribeTaskListConfigResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) GetTaskListUsage(ctx context.Context, request *types.GetTaskListUsageRequest, opts ...yarpc.CallOption) (*types.GetTaskListUsageResponse, error) {
+	response, err := t.c.GetTaskListUsage(ctx, thrift.FromGetTaskListUsageRequest(request), opts...)
+	return thrift.ToGetTaskListUsageResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateTaskListTags(ctx context.Context, request *types.UpdateTaskListTagsRequest, opts ...yarpc.CallOption) (*types.UpdateTaskListTagsResponse, error) {
+	response, err := t.c.UpdateTaskListTags(ctx, thrift.FromUpdateTaskListTagsRequest(request), opts...)
+	return thrift.ToUpdateTaskListTagsResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ListTaskListTags(ctx context.Context, request *types.ListTaskListTagsRequest, opts ...yarpc.CallOption) (*types.ListTaskListTagsResponse, error) {
+	response, err := t.c.ListTaskListTags(ctx, thrift.FromListTaskListTagsRequest(request), opts...)
+	return thrift.ToListTaskListTagsResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) BackfillDomainReplication(ctx context.Context, request *types.BackfillDomainReplicationRequest, opts ...yarpc.CallOption) (*types.BackfillDomainReplicationResponse, error) {
+	response, err := t.c.BackfillDomainReplication(ctx, thrift.FromBackfillDomainReplicationRequest(request), opts...)
+	return thrift.ToBackfillDomainReplicationResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) UpdateDomainAsyncWorkflowConfig(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfigRequest, opts ...yarpc.CallOption) (*types.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	response, err := t.c.UpdateDomainAsyncWorkflowConfig(ctx, thrift.FromUpdateDomainAsyncWorkflowConfigRequest(request), opts...)
+	return thrift.ToUpdateDomainAsyncWorkflowConfigResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) GetDomainAsyncWorkflowConfig(ctx context.Context, request *types.GetDomainAsyncWorkflowConfigRequest, opts ...yarpc.CallOption) (*types.GetDomainAsyncWorkflowConfigResponse, error) {
+	response, err := t.c.GetDomainAsyncWorkflowConfig(ctx, thrift.FromGetDomainAsyncWorkflowConfigRequest(request), opts...)
+	return thrift.ToGetDomainAsyncWorkflowConfigResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) UpdateDynamicConfig(ctx context.Context, request *types.UpdateDynamicConfigRequest, opts ...yarpc.CallOption) error {
 	err := t.c.UpdateDynamicConfig(ctx, thrift.FromUpdateDynamicConfigRequest(request), opts...)
 	return thrift.ToError(err)
@@ -173,3 +228,8 @@ func (t thriftClient) ListDynamicConfig(ctx context.Context, request *types.List
 	response, err := t.c.ListDynamicConfig(ctx, thrift.FromListDynamicConfigRequest(request), opts...)
 	return thrift.ToListDynamicConfigResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) ReconcileDomainRetention(ctx context.Context, request *types.ReconcileDomainRetentionRequest, opts ...yarpc.CallOption) (*types.ReconcileDomainRetentionResponse, error) {
+	response, err := t.c.ReconcileDomainRetention(ctx, thrift.FromReconcileDomainRetentionRequest(request), opts...)
+	return thrift.ToReconcileDomainRetentionResponse(response), thrift.ToError(err)
+}
@@ -430,6 +430,17 @@ func (c *clientImpl) SignalWorkflowExecution(
 	return c.client.SignalWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	request *types.SignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SignalBatchWorkflowExecution(ctx, request, opts...)
+}
+
 func (c *clientImpl) StartWorkflowExecution(
 	ctx context.Context,
 	request *types.StartWorkflowExecutionRequest,
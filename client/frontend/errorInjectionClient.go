@@ -926,6 +926,31 @@ func (c *errorInjectionClient) SignalWorkflowExecution(
 	return clientErr
 }
 
+func (c *errorInjectionClient) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	request *types.SignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.SignalBatchWorkflowExecution(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.FrontendClientOperationSignalBatchWorkflowExecution,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
 func (c *errorInjectionClient) StartWorkflowExecution(
 	ctx context.Context,
 	request *types.StartWorkflowExecutionRequest,
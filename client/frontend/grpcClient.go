@@ -231,6 +231,11 @@ func (g grpcClient) SignalWorkflowExecution(ctx context.Context, request *types.
 	return proto.ToError(err)
 }
 
+func (g grpcClient) SignalBatchWorkflowExecution(ctx context.Context, request *types.SignalBatchWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	// the public gRPC API does not define signal batches, they are only served over thrift
+	return &types.BadRequestError{Message: "SignalBatchWorkflowExecution is not supported over gRPC."}
+}
+
 func (g grpcClient) StartWorkflowExecution(ctx context.Context, request *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
 	response, err := g.workflow.StartWorkflowExecution(ctx, proto.FromStartWorkflowExecutionRequest(request), opts...)
 	return proto.ToStartWorkflowExecutionResponse(response), proto.ToError(err)
@@ -747,6 +747,25 @@ func (mr *MockClientMockRecorder) ScanWorkflowExecutions(arg0, arg1 interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanWorkflowExecutions", reflect.TypeOf((*MockClient)(nil).ScanWorkflowExecutions), varargs...)
 }
 
+// SignalBatchWorkflowExecution mocks base method
+func (m *MockClient) SignalBatchWorkflowExecution(arg0 context.Context, arg1 *types.SignalBatchWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignalBatchWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalBatchWorkflowExecution indicates an expected call of SignalBatchWorkflowExecution
+func (mr *MockClientMockRecorder) SignalBatchWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalBatchWorkflowExecution", reflect.TypeOf((*MockClient)(nil).SignalBatchWorkflowExecution), varargs...)
+}
+
 // SignalWithStartWorkflowExecution mocks base method
 func (m *MockClient) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *types.SignalWithStartWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
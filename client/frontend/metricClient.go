@@ -658,6 +658,24 @@ func (c *metricClient) SignalWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	request *types.SignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientSignalBatchWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientSignalBatchWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.SignalBatchWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientSignalBatchWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) StartWorkflowExecution(
 	ctx context.Context,
 	request *types.StartWorkflowExecutionRequest,
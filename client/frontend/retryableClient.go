@@ -545,6 +545,18 @@ func (c *retryableClient) SignalWorkflowExecution(
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *retryableClient) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	request *types.SignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.SignalBatchWorkflowExecution(ctx, request, opts...)
+	}
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) StartWorkflowExecution(
 	ctx context.Context,
 	request *types.StartWorkflowExecutionRequest,
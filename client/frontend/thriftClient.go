@@ -224,6 +224,11 @@ func (t thriftClient) SignalWorkflowExecution(ctx context.Context, request *type
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) SignalBatchWorkflowExecution(ctx context.Context, request *types.SignalBatchWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.SignalBatchWorkflowExecution(ctx, thrift.FromSignalBatchWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) StartWorkflowExecution(ctx context.Context, request *types.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
 	response, err := t.c.StartWorkflowExecution(ctx, thrift.FromStartWorkflowExecutionRequest(request), opts...)
 	return thrift.ToStartWorkflowExecutionResponse(response), thrift.ToError(err)
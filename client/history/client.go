@@ -282,6 +282,31 @@ func (c *clientImpl) DescribeQueue(
 	return response, nil
 }
 
+func (c *clientImpl) GetReplicationAckStates(
+	ctx context.Context,
+	request *types.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationAckStatesResponse, error) {
+	peer, err := c.peerResolver.FromShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+	var response *types.GetReplicationAckStatesResponse
+	op := func(ctx context.Context, peer string) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = c.client.GetReplicationAckStates(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+		return err
+	}
+
+	err = c.executeWithRedirect(ctx, peer, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *types.DescribeMutableStateRequest,
@@ -578,6 +603,25 @@ func (c *clientImpl) SignalWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	request *types.HistorySignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.SignalRequest.WorkflowExecution.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.SignalBatchWorkflowExecution(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+
+	return err
+}
+
 func (c *clientImpl) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *types.HistorySignalWithStartWorkflowExecutionRequest,
@@ -796,7 +840,8 @@ func (c *clientImpl) GetReplicationMessages(
 
 		if _, ok := requestsByPeer[peer]; !ok {
 			requestsByPeer[peer] = &types.GetReplicationMessagesRequest{
-				ClusterName: request.ClusterName,
+				ClusterName:           request.ClusterName,
+				SupportedCompressions: request.SupportedCompressions,
 			}
 		}
 
@@ -1116,6 +1161,51 @@ func (c *clientImpl) GetFailoverInfo(
 	return c.client.GetFailoverInfo(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
 }
 
+func (c *clientImpl) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.HistoryGetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryGetDomainReplicationWatermarkResponse, error) {
+	requestByPeer := make(map[string]*types.HistoryGetDomainReplicationWatermarkRequest)
+	for _, shardID := range request.GetShardIDs() {
+		peer, err := c.peerResolver.FromShardID(int(shardID))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := requestByPeer[peer]; !ok {
+			requestByPeer[peer] = &types.HistoryGetDomainReplicationWatermarkRequest{
+				DomainID: request.DomainID,
+			}
+		}
+		requestByPeer[peer].ShardIDs = append(requestByPeer[peer].ShardIDs, shardID)
+	}
+
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+
+	futures := make([]future.Future, 0, len(requestByPeer))
+	for peer, req := range requestByPeer {
+		future, settable := future.NewFuture()
+		go func(peer string, req *types.HistoryGetDomainReplicationWatermarkRequest) {
+			settable.Set(c.client.GetDomainReplicationWatermark(ctx, req, append(opts, yarpc.WithShardKey(peer))...))
+		}(peer, req)
+		futures = append(futures, future)
+	}
+
+	response := &types.HistoryGetDomainReplicationWatermarkResponse{}
+	for _, future := range futures {
+		var resp *types.HistoryGetDomainReplicationWatermarkResponse
+		if err := future.Get(ctx, &resp); err != nil {
+			return nil, err
+		}
+		if resp.GetLastReplicatedEventTime() > response.GetLastReplicatedEventTime() {
+			response.LastReplicatedEventTime = resp.LastReplicatedEventTime
+		}
+	}
+	return response, nil
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	if parent == nil {
 		return context.WithTimeout(context.Background(), c.timeout)
@@ -1153,3 +1243,21 @@ redirectLoop:
 	}
 	return err
 }
+
+func (c *clientImpl) ReconcileWorkflowRetention(
+	ctx context.Context,
+	request *types.ReconcileWorkflowRetentionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromWorkflowID(request.GetWorkflowExecution().GetWorkflowID())
+	if err != nil {
+		return err
+	}
+	op := func(ctx context.Context, peer string) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return c.client.ReconcileWorkflowRetention(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+	}
+	err = c.executeWithRedirect(ctx, peer, op)
+	return err
+}
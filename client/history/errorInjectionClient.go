@@ -184,6 +184,32 @@ func (c *errorInjectionClient) DescribeQueue(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) GetReplicationAckStates(
+	ctx context.Context,
+	request *types.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationAckStatesResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.GetReplicationAckStatesResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.GetReplicationAckStates(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.HistoryClientOperationGetReplicationAckStates,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
 func (c *errorInjectionClient) RemoveTask(
 	ctx context.Context,
 	request *types.RemoveTaskRequest,
@@ -594,6 +620,31 @@ func (c *errorInjectionClient) SignalWorkflowExecution(
 	return clientErr
 }
 
+func (c *errorInjectionClient) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	request *types.HistorySignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.SignalBatchWorkflowExecution(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.HistoryClientOperationSignalBatchWorkflowExecution,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
 func (c *errorInjectionClient) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *types.HistorySignalWithStartWorkflowExecutionRequest,
@@ -1128,3 +1179,54 @@ func (c *errorInjectionClient) GetFailoverInfo(
 	}
 	return resp, clientErr
 }
+
+func (c *errorInjectionClient) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.HistoryGetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryGetDomainReplicationWatermarkResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.HistoryGetDomainReplicationWatermarkResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.GetDomainReplicationWatermark(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.HistoryClientOperationGetDomainReplicationWatermark,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) ReconcileWorkflowRetention(
+	ctx context.Context,
+	request *types.ReconcileWorkflowRetentionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.ReconcileWorkflowRetention(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.HistoryClientOperationReconcileWorkflowRetention,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
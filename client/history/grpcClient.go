@@ -58,6 +58,11 @@ func (g grpcClient) DescribeQueue(ctx context.Context, request *types.DescribeQu
 	return proto.ToHistoryDescribeQueueResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) GetReplicationAckStates(ctx context.Context, request *types.GetReplicationAckStatesRequest, opts ...yarpc.CallOption) (*types.GetReplicationAckStatesResponse, error) {
+	response, err := g.c.GetReplicationAckStates(ctx, proto.FromHistoryGetReplicationAckStatesRequest(request), opts...)
+	return proto.ToHistoryGetReplicationAckStatesResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) DescribeWorkflowExecution(ctx context.Context, request *types.HistoryDescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.c.DescribeWorkflowExecution(ctx, proto.FromHistoryDescribeWorkflowExecutionRequest(request), opts...)
 	return proto.ToHistoryDescribeWorkflowExecutionResponse(response), proto.ToError(err)
@@ -223,6 +228,11 @@ func (g grpcClient) SignalWorkflowExecution(ctx context.Context, request *types.
 	return proto.ToError(err)
 }
 
+func (g grpcClient) SignalBatchWorkflowExecution(ctx context.Context, request *types.HistorySignalBatchWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.SignalBatchWorkflowExecution(ctx, proto.FromHistorySignalBatchWorkflowExecutionRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) StartWorkflowExecution(ctx context.Context, request *types.HistoryStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
 	response, err := g.c.StartWorkflowExecution(ctx, proto.FromHistoryStartWorkflowExecutionRequest(request), opts...)
 	return proto.ToHistoryStartWorkflowExecutionResponse(response), proto.ToError(err)
@@ -247,3 +257,13 @@ func (g grpcClient) GetFailoverInfo(ctx context.Context, request *types.GetFailo
 	response, err := g.c.GetFailoverInfo(ctx, proto.FromHistoryGetFailoverInfoRequest(request), opts...)
 	return proto.ToHistoryGetFailoverInfoResponse(response), proto.ToError(err)
 }
+
+func (g grpcClient) GetDomainReplicationWatermark(ctx context.Context, request *types.HistoryGetDomainReplicationWatermarkRequest, opts ...yarpc.CallOption) (*types.HistoryGetDomainReplicationWatermarkResponse, error) {
+	response, err := g.c.GetDomainReplicationWatermark(ctx, proto.FromHistoryGetDomainReplicationWatermarkRequest(request), opts...)
+	return proto.ToHistoryGetDomainReplicationWatermarkResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) ReconcileWorkflowRetention(ctx context.Context, request *types.ReconcileWorkflowRetentionRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ReconcileWorkflowRetention(ctx, proto.FromHistoryReconcileWorkflowRetentionRequest(request), opts...)
+	return proto.ToError(err)
+}
@@ -138,6 +138,26 @@ func (mr *MockClientMockRecorder) DescribeQueue(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockClient)(nil).DescribeQueue), varargs...)
 }
 
+// GetReplicationAckStates mocks base method
+func (m *MockClient) GetReplicationAckStates(arg0 context.Context, arg1 *types.GetReplicationAckStatesRequest, arg2 ...yarpc.CallOption) (*types.GetReplicationAckStatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetReplicationAckStates", varargs...)
+	ret0, _ := ret[0].(*types.GetReplicationAckStatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationAckStates indicates an expected call of GetReplicationAckStates
+func (mr *MockClientMockRecorder) GetReplicationAckStates(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationAckStates", reflect.TypeOf((*MockClient)(nil).GetReplicationAckStates), varargs...)
+}
+
 // DescribeWorkflowExecution mocks base method
 func (m *MockClient) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.HistoryDescribeWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -743,6 +763,25 @@ func (mr *MockClientMockRecorder) ScheduleDecisionTask(arg0, arg1 interface{}, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleDecisionTask", reflect.TypeOf((*MockClient)(nil).ScheduleDecisionTask), varargs...)
 }
 
+// SignalBatchWorkflowExecution mocks base method
+func (m *MockClient) SignalBatchWorkflowExecution(arg0 context.Context, arg1 *types.HistorySignalBatchWorkflowExecutionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SignalBatchWorkflowExecution", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalBatchWorkflowExecution indicates an expected call of SignalBatchWorkflowExecution
+func (mr *MockClientMockRecorder) SignalBatchWorkflowExecution(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalBatchWorkflowExecution", reflect.TypeOf((*MockClient)(nil).SignalBatchWorkflowExecution), varargs...)
+}
+
 // SignalWithStartWorkflowExecution mocks base method
 func (m *MockClient) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *types.HistorySignalWithStartWorkflowExecutionRequest, arg2 ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -878,3 +917,42 @@ func (mr *MockClientMockRecorder) GetFailoverInfo(arg0, arg1 interface{}, arg2 .
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFailoverInfo", reflect.TypeOf((*MockClient)(nil).GetFailoverInfo), varargs...)
 }
+
+// GetDomainReplicationWatermark mocks base method
+func (m *MockClient) GetDomainReplicationWatermark(arg0 context.Context, arg1 *types.HistoryGetDomainReplicationWatermarkRequest, arg2 ...yarpc.CallOption) (*types.HistoryGetDomainReplicationWatermarkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainReplicationWatermark", varargs...)
+	ret0, _ := ret[0].(*types.HistoryGetDomainReplicationWatermarkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainReplicationWatermark indicates an expected call of GetDomainReplicationWatermark
+func (mr *MockClientMockRecorder) GetDomainReplicationWatermark(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationWatermark", reflect.TypeOf((*MockClient)(nil).GetDomainReplicationWatermark), varargs...)
+}
+
+// ReconcileWorkflowRetention mocks base method
+func (m *MockClient) ReconcileWorkflowRetention(arg0 context.Context, arg1 *types.ReconcileWorkflowRetentionRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReconcileWorkflowRetention", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileWorkflowRetention indicates an expected call of ReconcileWorkflowRetention
+func (mr *MockClientMockRecorder) ReconcileWorkflowRetention(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileWorkflowRetention", reflect.TypeOf((*MockClient)(nil).ReconcileWorkflowRetention), varargs...)
+}
@@ -152,6 +152,24 @@ func (c *metricClient) DescribeQueue(
 	return resp, err
 }
 
+func (c *metricClient) GetReplicationAckStates(
+	context context.Context,
+	request *types.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationAckStatesResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationAckStatesScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetReplicationAckStatesScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetReplicationAckStates(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetReplicationAckStatesScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) DescribeMutableState(
 	context context.Context,
 	request *types.DescribeMutableStateRequest,
@@ -422,6 +440,24 @@ func (c *metricClient) SignalWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) SignalBatchWorkflowExecution(
+	context context.Context,
+	request *types.HistorySignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientSignalBatchWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientSignalBatchWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.SignalBatchWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientSignalBatchWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) SignalWithStartWorkflowExecution(
 	context context.Context,
 	request *types.HistorySignalWithStartWorkflowExecutionRequest,
@@ -793,3 +829,38 @@ func (c *metricClient) GetFailoverInfo(
 
 	return resp, err
 }
+
+func (c *metricClient) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.HistoryGetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryGetDomainReplicationWatermarkResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientGetDomainReplicationWatermarkScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientGetDomainReplicationWatermarkScope, metrics.CadenceClientLatency)
+	resp, err := c.client.GetDomainReplicationWatermark(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientGetDomainReplicationWatermarkScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) ReconcileWorkflowRetention(
+	ctx context.Context,
+	request *types.ReconcileWorkflowRetentionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.HistoryClientReconcileWorkflowRetentionScope, metrics.CadenceClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReconcileWorkflowRetentionScope, metrics.CadenceClientLatency)
+	err := c.client.ReconcileWorkflowRetention(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReconcileWorkflowRetentionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
@@ -132,6 +132,23 @@ func (c *retryableClient) DescribeQueue(
 	return resp, err
 }
 
+func (c *retryableClient) GetReplicationAckStates(
+	ctx context.Context,
+	request *types.GetReplicationAckStatesRequest,
+	opts ...yarpc.CallOption,
+) (*types.GetReplicationAckStatesResponse, error) {
+
+	var resp *types.GetReplicationAckStatesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetReplicationAckStates(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *retryableClient) RemoveTask(
 	ctx context.Context,
 	request *types.RemoveTaskRequest,
@@ -378,6 +395,19 @@ func (c *retryableClient) SignalWorkflowExecution(
 	return c.throttleRetry.Do(ctx, op)
 }
 
+func (c *retryableClient) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	request *types.HistorySignalBatchWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.SignalBatchWorkflowExecution(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *types.HistorySignalWithStartWorkflowExecutionRequest,
@@ -684,3 +714,32 @@ func (c *retryableClient) GetFailoverInfo(
 	err := c.throttleRetry.Do(ctx, op)
 	return resp, err
 }
+
+func (c *retryableClient) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.HistoryGetDomainReplicationWatermarkRequest,
+	opts ...yarpc.CallOption,
+) (*types.HistoryGetDomainReplicationWatermarkResponse, error) {
+	var resp *types.HistoryGetDomainReplicationWatermarkResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.GetDomainReplicationWatermark(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) ReconcileWorkflowRetention(
+	ctx context.Context,
+	request *types.ReconcileWorkflowRetentionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReconcileWorkflowRetention(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
@@ -59,6 +59,11 @@ func (t thriftClient) DescribeQueue(ctx context.Context, request *types.Describe
 	return thrift.ToDescribeQueueResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) GetReplicationAckStates(ctx context.Context, request *types.GetReplicationAckStatesRequest, opts ...yarpc.CallOption) (*types.GetReplicationAckStatesResponse, error) {
+	response, err := t.c.GetReplicationAckStates(ctx, thrift.FromGetReplicationAckStatesRequest(request), opts...)
+	return thrift.ToGetReplicationAckStatesResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) DescribeWorkflowExecution(ctx context.Context, request *types.HistoryDescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
 	response, err := t.c.DescribeWorkflowExecution(ctx, thrift.FromHistoryDescribeWorkflowExecutionRequest(request), opts...)
 	return thrift.ToDescribeWorkflowExecutionResponse(response), thrift.ToError(err)
@@ -224,6 +229,11 @@ func (t thriftClient) SignalWorkflowExecution(ctx context.Context, request *type
 	return thrift.ToError(err)
 }
 
+func (t thriftClient) SignalBatchWorkflowExecution(ctx context.Context, request *types.HistorySignalBatchWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.SignalBatchWorkflowExecution(ctx, thrift.FromHistorySignalBatchWorkflowExecutionRequest(request), opts...)
+	return thrift.ToError(err)
+}
+
 func (t thriftClient) StartWorkflowExecution(ctx context.Context, request *types.HistoryStartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.StartWorkflowExecutionResponse, error) {
 	response, err := t.c.StartWorkflowExecution(ctx, thrift.FromHistoryStartWorkflowExecutionRequest(request), opts...)
 	return thrift.ToStartWorkflowExecutionResponse(response), thrift.ToError(err)
@@ -248,3 +258,13 @@ func (t thriftClient) GetFailoverInfo(ctx context.Context, request *types.GetFai
 	response, err := t.c.GetFailoverInfo(ctx, thrift.FromGetFailoverInfoRequest(request), opts...)
 	return thrift.ToGetFailoverInfoResponse(response), thrift.ToError(err)
 }
+
+func (t thriftClient) GetDomainReplicationWatermark(ctx context.Context, request *types.HistoryGetDomainReplicationWatermarkRequest, opts ...yarpc.CallOption) (*types.HistoryGetDomainReplicationWatermarkResponse, error) {
+	response, err := t.c.GetDomainReplicationWatermark(ctx, thrift.FromHistoryGetDomainReplicationWatermarkRequest(request), opts...)
+	return thrift.ToHistoryGetDomainReplicationWatermarkResponse(response), thrift.ToError(err)
+}
+
+func (t thriftClient) ReconcileWorkflowRetention(ctx context.Context, request *types.ReconcileWorkflowRetentionRequest, opts ...yarpc.CallOption) error {
+	err := t.c.ReconcileWorkflowRetention(ctx, thrift.FromReconcileWorkflowRetentionRequest(request), opts...)
+	return thrift.ToError(err)
+}
@@ -212,6 +212,20 @@ func (c *clientImpl) DescribeTaskList(
 	return c.client.DescribeTaskList(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
 }
 
+func (c *clientImpl) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.TaskList.GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeTaskListConfig(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
 func (c *clientImpl) ListTaskListPartitions(
 	ctx context.Context,
 	request *types.MatchingListTaskListPartitionsRequest,
@@ -58,6 +58,11 @@ func (g grpcClient) DescribeTaskList(ctx context.Context, request *types.Matchin
 	return proto.ToMatchingDescribeTaskListResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) DescribeTaskListConfig(ctx context.Context, request *types.MatchingDescribeTaskListConfigRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	response, err := g.c.DescribeTaskListConfig(ctx, proto.FromMatchingDescribeTaskListConfigRequest(request), opts...)
+	return proto.ToMatchingDescribeTaskListConfigResponse(response), proto.ToError(err)
+}
+
 func (g grpcClient) ListTaskListPartitions(ctx context.Context, request *types.MatchingListTaskListPartitionsRequest, opts ...yarpc.CallOption) (*types.ListTaskListPartitionsResponse, error) {
 	response, err := g.c.ListTaskListPartitions(ctx, proto.FromMatchingListTaskListPartitionsRequest(request), opts...)
 	return proto.ToMatchingListTaskListPartitionsResponse(response), proto.ToError(err)
@@ -136,6 +136,26 @@ func (mr *MockClientMockRecorder) DescribeTaskList(arg0, arg1 interface{}, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockClient)(nil).DescribeTaskList), varargs...)
 }
 
+// DescribeTaskListConfig mocks base method
+func (m *MockClient) DescribeTaskListConfig(arg0 context.Context, arg1 *types.MatchingDescribeTaskListConfigRequest, arg2 ...yarpc.CallOption) (*types.DescribeTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", varargs...)
+	ret0, _ := ret[0].(*types.DescribeTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListConfig indicates an expected call of DescribeTaskListConfig
+func (mr *MockClientMockRecorder) DescribeTaskListConfig(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListConfig", reflect.TypeOf((*MockClient)(nil).DescribeTaskListConfig), varargs...)
+}
+
 // ListTaskListPartitions mocks base method
 func (m *MockClient) ListTaskListPartitions(arg0 context.Context, arg1 *types.MatchingListTaskListPartitionsRequest, arg2 ...yarpc.CallOption) (*types.ListTaskListPartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -224,6 +224,24 @@ func (c *metricClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *metricClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListConfigScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientDescribeTaskListConfigScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeTaskListConfig(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListConfigScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) ListTaskListPartitions(
 	ctx context.Context,
 	request *types.MatchingListTaskListPartitionsRequest,
@@ -170,6 +170,23 @@ func (c *retryableClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+
+	var resp *types.DescribeTaskListConfigResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskListConfig(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
 func (c *retryableClient) ListTaskListPartitions(
 	ctx context.Context,
 	request *types.MatchingListTaskListPartitionsRequest,
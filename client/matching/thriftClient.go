@@ -75,6 +75,15 @@ func (t thriftClient) DescribeTaskList(
 	return thrift.ToDescribeTaskListResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+	opts ...yarpc.CallOption,
+) (*types.DescribeTaskListConfigResponse, error) {
+	response, err := t.c.DescribeTaskListConfig(ctx, thrift.FromMatchingDescribeTaskListConfigRequest(request), opts...)
+	return thrift.ToMatchingDescribeTaskListConfigResponse(response), thrift.ToError(err)
+}
+
 func (t thriftClient) ListTaskListPartitions(
 	ctx context.Context,
 	request *types.MatchingListTaskListPartitionsRequest,
@@ -185,6 +185,11 @@ func (s *server) startService() common.Daemon {
 	}
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
 
+	params.TracerProvider, err = s.cfg.Tracing.NewTracerProvider(params.Name)
+	if err != nil {
+		log.Fatalf("error creating tracer provider: %v", err)
+	}
+
 	params.ClusterRedirectionPolicy = s.cfg.ClusterGroupMetadata.ClusterRedirectionPolicy
 
 	params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
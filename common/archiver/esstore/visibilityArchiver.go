@@ -0,0 +1,394 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package esstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/config"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+)
+
+const (
+	// URIScheme is the scheme for the ElasticSearch implementation
+	URIScheme = "es"
+
+	// ExpireTime is the field holding the time after which an archived record is no longer returned
+	ExpireTime = "ExpireTime"
+
+	retentionQueryKey       = "retention"
+	defaultMaxResultWindow  = 10000
+	errEncodeVisibilityMemo = "failed to encode visibility memo"
+	errIndexVisibilityDoc   = "failed to index visibility record"
+)
+
+var (
+	errNoIndexSpecified = errors.New("ElasticSearch index is not specified in URI")
+	errInvalidRetention = errors.New("retention in URI must be a positive number of days")
+)
+
+type (
+	visibilityArchiver struct {
+		container   *archiver.VisibilityBootstrapContainer
+		esClient    es.GenericClient
+		serializer  persistence.PayloadSerializer
+		queryParser QueryParser
+	}
+
+	// visibilityRecord uses the same field names as the hot visibility index,
+	// so the cold index can be created from the same template
+	visibilityRecord struct {
+		es.VisibilityRecord
+		ExpireTime int64
+	}
+)
+
+// NewVisibilityArchiver creates a new archiver.VisibilityArchiver based on ElasticSearch.
+// Each domain selects its own cold index and retention through its archival URI,
+// e.g. es://cadence-archive-samples?retention=365
+func NewVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	config *config.ESArchiver,
+) (archiver.VisibilityArchiver, error) {
+	if config.ElasticSearch == nil {
+		return nil, errors.New("ElasticSearch connection config is missing for esstore visibility archiver")
+	}
+	esClient, err := es.NewGenericClient(config.ElasticSearch, container.Logger)
+	if err != nil {
+		return nil, err
+	}
+	return newVisibilityArchiver(container, esClient), nil
+}
+
+func newVisibilityArchiver(
+	container *archiver.VisibilityBootstrapContainer,
+	esClient es.GenericClient,
+) *visibilityArchiver {
+	return &visibilityArchiver{
+		container:   container,
+		esClient:    esClient,
+		serializer:  persistence.NewPayloadSerializer(),
+		queryParser: NewQueryParser(),
+	}
+}
+
+func (v *visibilityArchiver) Archive(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.ArchiveVisibilityRequest,
+	opts ...archiver.ArchiveOption,
+) (err error) {
+	scope := v.container.MetricsClient.Scope(metrics.VisibilityArchiverScope, metrics.DomainTag(request.DomainName))
+	featureCatalog := archiver.GetFeatureCatalog(opts...)
+	sw := scope.StartTimer(metrics.CadenceLatency)
+	logger := archiver.TagLoggerWithArchiveVisibilityRequestAndURI(v.container.Logger, request, URI.String())
+	archiveFailReason := ""
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if archiveFailReason == errIndexVisibilityDoc {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveTransientErrorCount)
+				logger.Error(archiver.ArchiveTransientErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+			} else {
+				scope.IncCounter(metrics.VisibilityArchiverArchiveNonRetryableErrorCount)
+				logger.Error(archiver.ArchiveNonRetriableErrorMsg, tag.ArchivalArchiveFailReason(archiveFailReason), tag.Error(err))
+				if featureCatalog.NonRetriableError != nil {
+					err = featureCatalog.NonRetriableError()
+				}
+			}
+		}
+	}()
+
+	retention, err := validateURI(URI)
+	if err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidURI
+		return err
+	}
+
+	if err := archiver.ValidateVisibilityArchivalRequest(request); err != nil {
+		archiveFailReason = archiver.ErrReasonInvalidArchiveRequest
+		return err
+	}
+
+	record, err := v.newVisibilityRecord(request, retention)
+	if err != nil {
+		archiveFailReason = errEncodeVisibilityMemo
+		return err
+	}
+
+	docID := es.GenerateDocID(request.WorkflowID, request.RunID)
+	if err := v.esClient.PutDocument(ctx, URI.Hostname(), docID, record); err != nil {
+		archiveFailReason = errIndexVisibilityDoc
+		return err
+	}
+	scope.IncCounter(metrics.VisibilityArchiveSuccessCount)
+	return nil
+}
+
+func (v *visibilityArchiver) Query(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+) (*archiver.QueryVisibilityResponse, error) {
+	if _, err := validateURI(URI); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidURI.Error()}
+	}
+
+	if err := archiver.ValidateQueryRequest(request); err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrInvalidQueryVisibilityRequest.Error()}
+	}
+
+	parsedQuery, err := v.queryParser.Parse(request.Query)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: err.Error()}
+	}
+	if parsedQuery.emptyResult {
+		return &archiver.QueryVisibilityResponse{}, nil
+	}
+
+	token, err := es.GetNextPageToken(request.NextPageToken)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: archiver.ErrNextPageTokenCorrupted.Error()}
+	}
+
+	dsl, err := buildQueryDSL(request.DomainID, parsedQuery, request.PageSize, token, time.Now())
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	resp, err := v.esClient.SearchByQuery(ctx, &es.SearchByQueryRequest{
+		Index:           URI.Hostname(),
+		Query:           dsl,
+		NextPageToken:   request.NextPageToken,
+		PageSize:        request.PageSize,
+		MaxResultWindow: defaultMaxResultWindow,
+	})
+	if err != nil {
+		return nil, &types.InternalServiceError{Message: err.Error()}
+	}
+
+	response := &archiver.QueryVisibilityResponse{
+		NextPageToken: resp.NextPageToken,
+	}
+	for _, execution := range resp.Executions {
+		if execution == nil {
+			continue
+		}
+		response.Executions = append(response.Executions, v.convertToExecutionInfo(execution))
+	}
+	return response, nil
+}
+
+func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
+	_, err := validateURI(URI)
+	return err
+}
+
+func (v *visibilityArchiver) newVisibilityRecord(
+	request *archiver.ArchiveVisibilityRequest,
+	retention time.Duration,
+) (*visibilityRecord, error) {
+	record := &visibilityRecord{
+		VisibilityRecord: es.VisibilityRecord{
+			WorkflowID:    request.WorkflowID,
+			RunID:         request.RunID,
+			WorkflowType:  request.WorkflowTypeName,
+			DomainID:      request.DomainID,
+			StartTime:     request.StartTimestamp,
+			ExecutionTime: request.ExecutionTimestamp,
+			CloseTime:     request.CloseTimestamp,
+			CloseStatus:   *thrift.FromWorkflowExecutionCloseStatus(request.CloseStatus.Ptr()),
+			HistoryLength: request.HistoryLength,
+		},
+	}
+	if request.Memo != nil {
+		memo, err := v.serializer.SerializeVisibilityMemo(request.Memo, common.EncodingTypeThriftRW)
+		if err != nil {
+			return nil, err
+		}
+		record.Memo = memo.Data
+		record.Encoding = string(memo.GetEncoding())
+	}
+	if len(request.SearchAttributes) != 0 {
+		record.Attr = make(map[string]interface{}, len(request.SearchAttributes))
+		for key, value := range request.SearchAttributes {
+			record.Attr[key] = value
+		}
+	}
+	if retention > 0 {
+		record.ExpireTime = request.CloseTimestamp + retention.Nanoseconds()
+	}
+	return record, nil
+}
+
+func (v *visibilityArchiver) convertToExecutionInfo(
+	execution *persistence.InternalVisibilityWorkflowExecutionInfo,
+) *types.WorkflowExecutionInfo {
+	info := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+		},
+		Type: &types.WorkflowType{
+			Name: execution.TypeName,
+		},
+		StartTime:     common.Int64Ptr(execution.StartTime.UnixNano()),
+		ExecutionTime: common.Int64Ptr(execution.ExecutionTime.UnixNano()),
+		CloseTime:     common.Int64Ptr(execution.CloseTime.UnixNano()),
+		CloseStatus:   execution.Status,
+		HistoryLength: execution.HistoryLength,
+	}
+	if execution.Memo != nil && len(execution.Memo.Data) != 0 {
+		memo, err := v.serializer.DeserializeVisibilityMemo(execution.Memo)
+		if err != nil {
+			v.container.Logger.Error("failed to deserialize archived memo",
+				tag.WorkflowID(execution.WorkflowID),
+				tag.WorkflowRunID(execution.RunID),
+				tag.Error(err))
+		}
+		info.Memo = memo
+	}
+	if len(execution.SearchAttributes) != 0 {
+		searchAttributes := make(map[string]string, len(execution.SearchAttributes))
+		for key, value := range execution.SearchAttributes {
+			searchAttributes[key] = fmt.Sprint(value)
+		}
+		info.SearchAttributes = &types.SearchAttributes{
+			IndexedFields: archiver.ConvertSearchAttrToBytes(searchAttributes),
+		}
+	}
+	return info
+}
+
+// buildQueryDSL translates the parsed query into ElasticSearch DSL. Records are always
+// scoped to the domain and records past their retention are filtered out, since physical
+// deletion from the cold index is left to index lifecycle management.
+func buildQueryDSL(
+	domainID string,
+	parsedQuery *parsedQuery,
+	pageSize int,
+	token *es.ElasticVisibilityPageToken,
+	now time.Time,
+) (string, error) {
+	must := []interface{}{
+		termQuery(es.DomainID, domainID),
+		map[string]interface{}{
+			"range": map[string]interface{}{
+				es.CloseTime: map[string]interface{}{
+					"gte": parsedQuery.earliestCloseTime,
+					"lte": parsedQuery.latestCloseTime,
+				},
+			},
+		},
+		map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should": []interface{}{
+					map[string]interface{}{
+						"range": map[string]interface{}{
+							ExpireTime: map[string]interface{}{"gt": now.UnixNano()},
+						},
+					},
+					map[string]interface{}{
+						"bool": map[string]interface{}{
+							"must_not": map[string]interface{}{
+								"exists": map[string]interface{}{"field": ExpireTime},
+							},
+						},
+					},
+					termQuery(ExpireTime, 0),
+				},
+			},
+		},
+	}
+	if parsedQuery.workflowID != nil {
+		must = append(must, termQuery(es.WorkflowID, *parsedQuery.workflowID))
+	}
+	if parsedQuery.runID != nil {
+		must = append(must, termQuery(es.RunID, *parsedQuery.runID))
+	}
+	if parsedQuery.workflowTypeName != nil {
+		must = append(must, termQuery(es.WorkflowType, *parsedQuery.workflowTypeName))
+	}
+	if parsedQuery.closeStatus != nil {
+		must = append(must, termQuery(es.CloseStatus, int32(*thrift.FromWorkflowExecutionCloseStatus(parsedQuery.closeStatus))))
+	}
+
+	dsl := map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": must,
+			},
+		},
+		"size": pageSize,
+		"sort": []interface{}{
+			map[string]interface{}{es.CloseTime: "desc"},
+			map[string]interface{}{es.RunID: "desc"},
+		},
+	}
+	if es.ShouldSearchAfter(token) {
+		dsl["search_after"] = []interface{}{token.SortValue, token.TieBreaker}
+	} else {
+		dsl["from"] = token.From
+	}
+
+	data, err := json.Marshal(dsl)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func termQuery(field string, value interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"term": map[string]interface{}{field: value},
+	}
+}
+
+// validateURI checks the scheme and index of the URI and returns the optional retention
+func validateURI(URI archiver.URI) (time.Duration, error) {
+	if URI.Scheme() != URIScheme {
+		return 0, archiver.ErrURISchemeMismatch
+	}
+	if len(URI.Hostname()) == 0 {
+		return 0, errNoIndexSpecified
+	}
+	values, ok := URI.Query()[retentionQueryKey]
+	if !ok || len(values) == 0 {
+		return 0, nil
+	}
+	days, err := strconv.Atoi(values[0])
+	if err != nil || days <= 0 {
+		return 0, errInvalidRetention
+	}
+	return time.Duration(days) * 24 * time.Hour, nil
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package esstore
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
+	es "github.com/uber/cadence/common/elasticsearch"
+	esMocks "github.com/uber/cadence/common/elasticsearch/mocks"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	testDomainID         = "test-domain-id"
+	testDomainName       = "test-domain-name"
+	testWorkflowID       = "test-workflow-id"
+	testRunID            = "test-run-id"
+	testWorkflowTypeName = "test-workflow-type"
+	testIndex            = "cadence-archive-test"
+	testArchivalURI      = "es://" + testIndex + "?retention=30"
+)
+
+type visibilityArchiverSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	esClient  *esMocks.GenericClient
+	container *archiver.VisibilityBootstrapContainer
+	URI       archiver.URI
+}
+
+func TestVisibilityArchiverSuite(t *testing.T) {
+	suite.Run(t, new(visibilityArchiverSuite))
+}
+
+func (s *visibilityArchiverSuite) SetupTest() {
+	var err error
+	s.Assertions = require.New(s.T())
+	s.esClient = &esMocks.GenericClient{}
+	s.container = &archiver.VisibilityBootstrapContainer{
+		Logger:        loggerimpl.NewLogger(zap.NewNop()),
+		MetricsClient: metrics.NewClient(tally.NewTestScope("test", nil), metrics.VisibilityArchiverScope),
+	}
+	s.URI, err = archiver.NewURI(testArchivalURI)
+	s.NoError(err)
+}
+
+func (s *visibilityArchiverSuite) TearDownTest() {
+	s.esClient.AssertExpectations(s.T())
+}
+
+func (s *visibilityArchiverSuite) TestValidateURI() {
+	testCases := []struct {
+		URI         string
+		expectedErr error
+	}{
+		{
+			URI:         "wrongscheme://index",
+			expectedErr: archiver.ErrURISchemeMismatch,
+		},
+		{
+			URI:         "es:///index",
+			expectedErr: errNoIndexSpecified,
+		},
+		{
+			URI:         "es://index?retention=abc",
+			expectedErr: errInvalidRetention,
+		},
+		{
+			URI:         "es://index?retention=0",
+			expectedErr: errInvalidRetention,
+		},
+		{
+			URI:         "es://index",
+			expectedErr: nil,
+		},
+		{
+			URI:         testArchivalURI,
+			expectedErr: nil,
+		},
+	}
+
+	visibilityArchiver := newVisibilityArchiver(s.container, s.esClient)
+	for _, tc := range testCases {
+		URI, err := archiver.NewURI(tc.URI)
+		s.NoError(err)
+		s.Equal(tc.expectedErr, visibilityArchiver.ValidateURI(URI))
+	}
+}
+
+func (s *visibilityArchiverSuite) TestArchive() {
+	closeTime := time.Now().UnixNano()
+	request := &archiver.ArchiveVisibilityRequest{
+		DomainID:         testDomainID,
+		DomainName:       testDomainName,
+		WorkflowID:       testWorkflowID,
+		RunID:            testRunID,
+		WorkflowTypeName: testWorkflowTypeName,
+		StartTimestamp:   closeTime - int64(time.Hour),
+		CloseTimestamp:   closeTime,
+		CloseStatus:      types.WorkflowExecutionCloseStatusFailed,
+		HistoryLength:    10,
+		SearchAttributes: map[string]string{"CustomKeywordField": "keyword"},
+	}
+
+	var indexed *visibilityRecord
+	s.esClient.On("PutDocument", mock.Anything, testIndex, es.GenerateDocID(testWorkflowID, testRunID), mock.Anything).
+		Run(func(args mock.Arguments) {
+			indexed = args.Get(3).(*visibilityRecord)
+		}).Return(nil).Once()
+
+	visibilityArchiver := newVisibilityArchiver(s.container, s.esClient)
+	s.NoError(visibilityArchiver.Archive(context.Background(), s.URI, request))
+	s.NotNil(indexed)
+	s.Equal(testDomainID, indexed.DomainID)
+	s.Equal(testWorkflowTypeName, indexed.WorkflowType)
+	s.Equal(closeTime+int64(30*24*time.Hour), indexed.ExpireTime)
+	s.Equal("keyword", indexed.Attr["CustomKeywordField"])
+}
+
+func (s *visibilityArchiverSuite) TestArchive_Fail_InvalidRequest() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.esClient)
+	err := visibilityArchiver.Archive(context.Background(), s.URI, &archiver.ArchiveVisibilityRequest{
+		DomainID: testDomainID,
+	})
+	s.Error(err)
+}
+
+func (s *visibilityArchiverSuite) TestQuery_Fail_InvalidQuery() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.esClient)
+	response, err := visibilityArchiver.Query(context.Background(), s.URI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "StartTime > 0",
+	})
+	s.Error(err)
+	s.IsType(&types.BadRequestError{}, err)
+	s.Nil(response)
+}
+
+func (s *visibilityArchiverSuite) TestQuery() {
+	now := time.Now()
+	s.esClient.On("SearchByQuery", mock.Anything, mock.MatchedBy(func(request *es.SearchByQueryRequest) bool {
+		return request.Index == testIndex && request.PageSize == 10
+	})).Return(&es.SearchResponse{
+		Executions: []*persistence.InternalVisibilityWorkflowExecutionInfo{
+			{
+				DomainID:         testDomainID,
+				WorkflowID:       testWorkflowID,
+				RunID:            testRunID,
+				TypeName:         testWorkflowTypeName,
+				StartTime:        now.Add(-time.Hour),
+				CloseTime:        now,
+				Status:           types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+				HistoryLength:    5,
+				SearchAttributes: map[string]interface{}{"CustomKeywordField": "keyword"},
+			},
+		},
+		NextPageToken: []byte("next"),
+	}, nil).Once()
+
+	visibilityArchiver := newVisibilityArchiver(s.container, s.esClient)
+	response, err := visibilityArchiver.Query(context.Background(), s.URI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "WorkflowType = 'test-workflow-type' and CloseStatus = 'completed'",
+	})
+	s.NoError(err)
+	s.Equal([]byte("next"), response.NextPageToken)
+	s.Len(response.Executions, 1)
+	s.Equal(testRunID, response.Executions[0].Execution.GetRunID())
+	s.Equal(now.UnixNano(), response.Executions[0].GetCloseTime())
+	s.Equal(types.WorkflowExecutionCloseStatusCompleted, response.Executions[0].GetCloseStatus())
+	s.Equal([]byte("keyword"), response.Executions[0].SearchAttributes.IndexedFields["CustomKeywordField"])
+}
+
+func (s *visibilityArchiverSuite) TestQuery_EmptyResult() {
+	visibilityArchiver := newVisibilityArchiver(s.container, s.esClient)
+	response, err := visibilityArchiver.Query(context.Background(), s.URI, &archiver.QueryVisibilityRequest{
+		DomainID: testDomainID,
+		PageSize: 10,
+		Query:    "WorkflowID = 'a' and WorkflowID = 'b'",
+	})
+	s.NoError(err)
+	s.Empty(response.Executions)
+}
+
+func (s *visibilityArchiverSuite) TestBuildQueryDSL() {
+	now := time.Unix(0, 1000)
+	parsedQuery := &parsedQuery{
+		earliestCloseTime: 10,
+		latestCloseTime:   20,
+		workflowID:        common.StringPtr(testWorkflowID),
+		closeStatus:       types.WorkflowExecutionCloseStatusFailed.Ptr(),
+	}
+
+	dsl, err := buildQueryDSL(testDomainID, parsedQuery, 10, &es.ElasticVisibilityPageToken{From: 20}, now)
+	s.NoError(err)
+	var decoded map[string]interface{}
+	s.NoError(json.Unmarshal([]byte(dsl), &decoded))
+	s.EqualValues(20, decoded["from"])
+	s.EqualValues(10, decoded["size"])
+	s.Nil(decoded["search_after"])
+	s.Contains(dsl, `{"term":{"DomainID":"test-domain-id"}}`)
+	s.Contains(dsl, `{"term":{"WorkflowID":"test-workflow-id"}}`)
+	s.Contains(dsl, `{"term":{"CloseStatus":1}}`)
+	s.Contains(dsl, `{"range":{"CloseTime":{"gte":10,"lte":20}}}`)
+	s.Contains(dsl, `{"range":{"ExpireTime":{"gt":1000}}}`)
+
+	dsl, err = buildQueryDSL(testDomainID, parsedQuery, 10, &es.ElasticVisibilityPageToken{SortValue: json.Number("15"), TieBreaker: testRunID}, now)
+	s.NoError(err)
+	s.Contains(dsl, `"search_after":[15,"test-run-id"]`)
+	s.NotContains(dsl, `"from"`)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common/archiver/gcloud"
 
 	"github.com/uber/cadence/common/archiver"
+	"github.com/uber/cadence/common/archiver/esstore"
 	"github.com/uber/cadence/common/archiver/filestore"
 	"github.com/uber/cadence/common/archiver/s3store"
 	"github.com/uber/cadence/common/config"
@@ -43,19 +44,22 @@ var (
 	ErrArchiverConfigNotFound = errors.New("unable to find archiver config for the given scheme")
 	// ErrBootstrapContainerAlreadyRegistered is the error for registering multiple containers for the same serviceName
 	ErrBootstrapContainerAlreadyRegistered = errors.New("bootstrap container has already been registered")
+	// ErrUnknownProvider is the error for unknown custom archiver provider name
+	ErrUnknownProvider = errors.New("unknown custom archiver provider")
 )
 
 type (
-	// ArchiverProvider returns history or visibility archiver based on the scheme and serviceName.
-	// The archiver for each combination of scheme and serviceName will be created only once and cached.
+	// ArchiverProvider returns history or visibility archiver based on the scheme, provider name and serviceName.
+	// An empty provider name selects the default provider configs, otherwise the custom provider config with that name.
+	// The archiver for each combination of scheme, provider name and serviceName will be created only once and cached.
 	ArchiverProvider interface {
 		RegisterBootstrapContainer(
 			serviceName string,
 			historyContainer *archiver.HistoryBootstrapContainer,
 			visibilityContainter *archiver.VisibilityBootstrapContainer,
 		) error
-		GetHistoryArchiver(scheme, serviceName string) (archiver.HistoryArchiver, error)
-		GetVisibilityArchiver(scheme, serviceName string) (archiver.VisibilityArchiver, error)
+		GetHistoryArchiver(scheme, providerName, serviceName string) (archiver.HistoryArchiver, error)
+		GetVisibilityArchiver(scheme, providerName, serviceName string) (archiver.VisibilityArchiver, error)
 	}
 
 	archiverProvider struct {
@@ -118,8 +122,8 @@ func (p *archiverProvider) RegisterBootstrapContainer(
 	return nil
 }
 
-func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (historyArchiver archiver.HistoryArchiver, err error) {
-	archiverKey := p.getArchiverKey(scheme, serviceName)
+func (p *archiverProvider) GetHistoryArchiver(scheme, providerName, serviceName string) (historyArchiver archiver.HistoryArchiver, err error) {
+	archiverKey := p.getArchiverKey(scheme, providerName, serviceName)
 	p.RLock()
 	if historyArchiver, ok := p.historyArchivers[archiverKey]; ok {
 		p.RUnlock()
@@ -132,25 +136,32 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 		return nil, ErrBootstrapContainerNotFound
 	}
 
+	configs := p.historyArchiverConfigs
+	if providerName != "" {
+		if configs, ok = p.historyArchiverConfigs.Custom[providerName]; !ok || configs == nil {
+			return nil, ErrUnknownProvider
+		}
+	}
+
 	switch scheme {
 	case filestore.URIScheme:
-		if p.historyArchiverConfigs.Filestore == nil {
+		if configs.Filestore == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		historyArchiver, err = filestore.NewHistoryArchiver(container, p.historyArchiverConfigs.Filestore)
+		historyArchiver, err = filestore.NewHistoryArchiver(container, configs.Filestore)
 
 	case gcloud.URIScheme:
-		if p.historyArchiverConfigs.Gstorage == nil {
+		if configs.Gstorage == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
 
-		historyArchiver, err = gcloud.NewHistoryArchiver(container, p.historyArchiverConfigs.Gstorage)
+		historyArchiver, err = gcloud.NewHistoryArchiver(container, configs.Gstorage)
 
 	case s3store.URIScheme:
-		if p.historyArchiverConfigs.S3store == nil {
+		if configs.S3store == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		historyArchiver, err = s3store.NewHistoryArchiver(container, p.historyArchiverConfigs.S3store)
+		historyArchiver, err = s3store.NewHistoryArchiver(container, configs.S3store)
 	default:
 		return nil, ErrUnknownScheme
 	}
@@ -168,8 +179,8 @@ func (p *archiverProvider) GetHistoryArchiver(scheme, serviceName string) (histo
 	return historyArchiver, nil
 }
 
-func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (archiver.VisibilityArchiver, error) {
-	archiverKey := p.getArchiverKey(scheme, serviceName)
+func (p *archiverProvider) GetVisibilityArchiver(scheme, providerName, serviceName string) (archiver.VisibilityArchiver, error) {
+	archiverKey := p.getArchiverKey(scheme, providerName, serviceName)
 	p.RLock()
 	if visibilityArchiver, ok := p.visibilityArchivers[archiverKey]; ok {
 		p.RUnlock()
@@ -182,25 +193,37 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 		return nil, ErrBootstrapContainerNotFound
 	}
 
+	configs := p.visibilityArchiverConfigs
+	if providerName != "" {
+		if configs, ok = p.visibilityArchiverConfigs.Custom[providerName]; !ok || configs == nil {
+			return nil, ErrUnknownProvider
+		}
+	}
+
 	var visibilityArchiver archiver.VisibilityArchiver
 	var err error
 
 	switch scheme {
 	case filestore.URIScheme:
-		if p.visibilityArchiverConfigs.Filestore == nil {
+		if configs.Filestore == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		visibilityArchiver, err = filestore.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Filestore)
+		visibilityArchiver, err = filestore.NewVisibilityArchiver(container, configs.Filestore)
 	case s3store.URIScheme:
-		if p.visibilityArchiverConfigs.S3store == nil {
+		if configs.S3store == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		visibilityArchiver, err = s3store.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.S3store)
+		visibilityArchiver, err = s3store.NewVisibilityArchiver(container, configs.S3store)
 	case gcloud.URIScheme:
-		if p.visibilityArchiverConfigs.Gstorage == nil {
+		if configs.Gstorage == nil {
+			return nil, ErrArchiverConfigNotFound
+		}
+		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, configs.Gstorage)
+	case esstore.URIScheme:
+		if configs.ESstore == nil {
 			return nil, ErrArchiverConfigNotFound
 		}
-		visibilityArchiver, err = gcloud.NewVisibilityArchiver(container, p.visibilityArchiverConfigs.Gstorage)
+		visibilityArchiver, err = esstore.NewVisibilityArchiver(container, configs.ESstore)
 
 	default:
 		return nil, ErrUnknownScheme
@@ -219,6 +242,6 @@ func (p *archiverProvider) GetVisibilityArchiver(scheme, serviceName string) (ar
 
 }
 
-func (p *archiverProvider) getArchiverKey(scheme, serviceName string) string {
-	return scheme + ":" + serviceName
+func (p *archiverProvider) getArchiverKey(scheme, providerName, serviceName string) string {
+	return scheme + ":" + providerName + ":" + serviceName
 }
@@ -33,13 +33,13 @@ type MockArchiverProvider struct {
 	mock.Mock
 }
 
-// GetHistoryArchiver provides a mock function with given fields: scheme, serviceName
-func (_m *MockArchiverProvider) GetHistoryArchiver(scheme string, serviceName string) (archiver.HistoryArchiver, error) {
-	ret := _m.Called(scheme, serviceName)
+// GetHistoryArchiver provides a mock function with given fields: scheme, providerName, serviceName
+func (_m *MockArchiverProvider) GetHistoryArchiver(scheme string, providerName string, serviceName string) (archiver.HistoryArchiver, error) {
+	ret := _m.Called(scheme, providerName, serviceName)
 
 	var r0 archiver.HistoryArchiver
-	if rf, ok := ret.Get(0).(func(string, string) archiver.HistoryArchiver); ok {
-		r0 = rf(scheme, serviceName)
+	if rf, ok := ret.Get(0).(func(string, string, string) archiver.HistoryArchiver); ok {
+		r0 = rf(scheme, providerName, serviceName)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(archiver.HistoryArchiver)
@@ -47,8 +47,8 @@ func (_m *MockArchiverProvider) GetHistoryArchiver(scheme string, serviceName st
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(scheme, serviceName)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(scheme, providerName, serviceName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -56,13 +56,13 @@ func (_m *MockArchiverProvider) GetHistoryArchiver(scheme string, serviceName st
 	return r0, r1
 }
 
-// GetVisibilityArchiver provides a mock function with given fields: scheme, serviceName
-func (_m *MockArchiverProvider) GetVisibilityArchiver(scheme string, serviceName string) (archiver.VisibilityArchiver, error) {
-	ret := _m.Called(scheme, serviceName)
+// GetVisibilityArchiver provides a mock function with given fields: scheme, providerName, serviceName
+func (_m *MockArchiverProvider) GetVisibilityArchiver(scheme string, providerName string, serviceName string) (archiver.VisibilityArchiver, error) {
+	ret := _m.Called(scheme, providerName, serviceName)
 
 	var r0 archiver.VisibilityArchiver
-	if rf, ok := ret.Get(0).(func(string, string) archiver.VisibilityArchiver); ok {
-		r0 = rf(scheme, serviceName)
+	if rf, ok := ret.Get(0).(func(string, string, string) archiver.VisibilityArchiver); ok {
+		r0 = rf(scheme, providerName, serviceName)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(archiver.VisibilityArchiver)
@@ -70,8 +70,8 @@ func (_m *MockArchiverProvider) GetVisibilityArchiver(scheme string, serviceName
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(scheme, serviceName)
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(scheme, providerName, serviceName)
 	} else {
 		r1 = ret.Error(1)
 	}
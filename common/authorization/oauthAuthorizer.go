@@ -52,6 +52,9 @@ type JWTClaims struct {
 	Admin  bool
 	Iat    int64
 	TTL    int64
+	// Domain and APIs are only set on domain scoped tokens
+	Domain string   `json:",omitempty"`
+	APIs   []string `json:",omitempty"`
 }
 
 const groupSeparator = " "
@@ -99,6 +102,13 @@ func (a *oauthAuthority) Authorize(
 		a.log.Debug("request is not authorized", tag.Error(err))
 		return Result{Decision: DecisionDeny}, nil
 	}
+	if claims.Domain != "" {
+		if err := validateScope(claims, attributes); err != nil {
+			a.log.Debug("request is not authorized", tag.Error(err))
+			return Result{Decision: DecisionDeny}, nil
+		}
+		return Result{Decision: DecisionAllow}, nil
+	}
 	if claims.Admin {
 		return Result{Decision: DecisionAllow}, nil
 	}
@@ -0,0 +1,94 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cristalhq/jwt/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+func TestDomainScopedToken(t *testing.T) {
+	privateKey, err := common.LoadRSAPrivateKey("../../config/credentials/keytest")
+	require.NoError(t, err)
+	authorizer, err := NewOAuthAuthorizer(config.OAuthAuthorizer{
+		Enable: true,
+		JwtCredentials: config.JwtCredentials{
+			Algorithm: jwt.RS256.String(),
+			PublicKey: "../../config/credentials/keytest.pub",
+		},
+		MaxJwtTTL: 3600,
+	}, loggerimpl.NewNopLogger(), nil)
+	require.NoError(t, err)
+
+	_, err = IssueDomainScopedToken(privateKey, &DomainScopedToken{Domain: "test-domain", TTL: time.Hour}, time.Now())
+	assert.Error(t, err)
+
+	token, err := IssueDomainScopedToken(privateKey, &DomainScopedToken{
+		Subject: "test-team",
+		Domain:  "test-domain",
+		APIs:    []string{"StartWorkflowExecution", "SignalWorkflowExecution"},
+		TTL:     time.Hour,
+	}, time.Now())
+	require.NoError(t, err)
+	expiredToken, err := IssueDomainScopedToken(privateKey, &DomainScopedToken{
+		Domain: "test-domain",
+		APIs:   []string{"StartWorkflowExecution"},
+		TTL:    time.Hour,
+	}, time.Now().Add(-2*time.Hour))
+	require.NoError(t, err)
+
+	authorize := func(token string, attributes *Attributes) Decision {
+		ctx, call := encoding.NewInboundCall(context.Background())
+		require.NoError(t, call.ReadFromRequest(&transport.Request{
+			Headers: transport.NewHeaders().With(common.AuthorizationTokenHeaderName, token),
+		}))
+		result, err := authorizer.Authorize(ctx, attributes)
+		require.NoError(t, err)
+		return result.Decision
+	}
+
+	assert.Equal(t, DecisionAllow, authorize(token, &Attributes{
+		APIName: "SignalWorkflowExecution", DomainName: "test-domain", Permission: PermissionWrite,
+	}))
+	assert.Equal(t, DecisionDeny, authorize(token, &Attributes{
+		APIName: "TerminateWorkflowExecution", DomainName: "test-domain", Permission: PermissionWrite,
+	}))
+	assert.Equal(t, DecisionDeny, authorize(token, &Attributes{
+		APIName: "SignalWorkflowExecution", DomainName: "other-domain", Permission: PermissionWrite,
+	}))
+	assert.Equal(t, DecisionDeny, authorize(token, &Attributes{
+		APIName: "StartWorkflowExecution", DomainName: "test-domain", Permission: PermissionAdmin,
+	}))
+	assert.Equal(t, DecisionDeny, authorize(expiredToken, &Attributes{
+		APIName: "StartWorkflowExecution", DomainName: "test-domain", Permission: PermissionWrite,
+	}))
+}
@@ -22,6 +22,7 @@ package backoff
 
 import (
 	"context"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -193,6 +194,10 @@ func (tr *ThrottleRetry) Do(ctx context.Context, op Operation) error {
 		// check if the error is a throttle error
 		if tr.isThrottle(err) {
 			throttleBackOff := t.NextBackOff()
+			if retryAfter := getRetryAfter(err); retryAfter > 0 {
+				// the server knows when the request can be allowed, use its hint instead of guessing
+				throttleBackOff = retryAfter
+			}
 			if throttleBackOff != done && throttleBackOff > next {
 				next = throttleBackOff
 			}
@@ -210,6 +215,17 @@ func (tr *ThrottleRetry) Do(ctx context.Context, op Operation) error {
 	}
 }
 
+// getRetryAfter returns the retry after hint of a throttle error, spread by up to 20% so that the throttled
+// clients don't all retry at the same time
+func getRetryAfter(err error) time.Duration {
+	busyErr, ok := err.(*types.ServiceBusyError)
+	if !ok || busyErr.RetryAfterInMillis <= 0 {
+		return 0
+	}
+	retryAfter := time.Duration(busyErr.RetryAfterInMillis) * time.Millisecond
+	return retryAfter + time.Duration(rand.Int63n(int64(retryAfter)/5+1))
+}
+
 // IgnoreErrors can be used as IsRetryable handler for Retry function to exclude certain errors from the retry list
 func IgnoreErrors(errorsToExclude []error) func(error) bool {
 	return func(err error) bool {
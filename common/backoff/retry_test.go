@@ -28,6 +28,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -270,6 +272,36 @@ func (s *RetrySuite) TestRetryThrottled() {
 	s.Equal(1, i) // Because retry expires before next retry
 }
 
+func (s *RetrySuite) TestRetryThrottledWithRetryAfter() {
+	i := 0
+	throttleErr := &types.ServiceBusyError{Message: "throttled", RetryAfterInMillis: 100}
+	op := func() error {
+		i++
+		if i == 1 {
+			return throttleErr
+		}
+		return nil
+	}
+
+	policy := NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetExpirationInterval(NoInterval)
+	throttlePolicy := NewExponentialRetryPolicy(time.Second)
+	throttleRetry := NewThrottleRetry(
+		WithRetryPolicy(policy),
+		WithRetryableError(func(_ error) bool { return true }),
+		WithThrottlePolicy(throttlePolicy),
+	)
+
+	// the hint of the server replaces the throttle policy, which would not retry before the context expires
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := throttleRetry.Do(ctx, op)
+	s.NoError(err)
+	s.Equal(2, i)
+	s.True(time.Since(start) >= 100*time.Millisecond)
+}
+
 func (s *RetrySuite) TestConcurrentRetrier() {
 	policy := NewExponentialRetryPolicy(1 * time.Millisecond)
 	policy.SetMaximumInterval(10 * time.Millisecond)
@@ -72,6 +72,8 @@ type (
 		Blobstore Blobstore `yaml:"blobstore"`
 		// Authorization is the config for setting up authorization
 		Authorization Authorization `yaml:"authorization"`
+		// Tracing is the config for exporting OpenTelemetry spans
+		Tracing Tracing `yaml:"tracing"`
 	}
 
 	Authorization struct {
@@ -102,6 +104,8 @@ type (
 		Algorithm string `yaml:"algorithm"`
 		// Public Key Path for verifying JWT token passed in from external clients
 		PublicKey string `yaml:"publicKey"`
+		// Private Key Path for signing domain scoped tokens issued by the admin API, optional
+		PrivateKey string `yaml:"privateKey"`
 	}
 
 	// Service contains the service specific config items
@@ -120,6 +124,16 @@ type (
 		Port int `yaml:"port"`
 	}
 
+	// Tracing contains the config items for exporting OpenTelemetry spans
+	Tracing struct {
+		// Enable turns on exporting spans, a no-op tracer is used otherwise
+		Enable bool `yaml:"enable"`
+		// Endpoint is the host:port of the OTLP gRPC collector spans are exported to
+		Endpoint string `yaml:"endpoint"`
+		// Insecure disables TLS when connecting to the collector
+		Insecure bool `yaml:"insecure"`
+	}
+
 	// RPC contains the rpc config items
 	RPC struct {
 		// Port is the port  on which the Thrift TChannel will bind to
@@ -228,6 +242,8 @@ type (
 		// Use it ONLY when a configure is too specific to a particular NoSQL database that should not be in the common struct
 		// Otherwise please add new fields to the struct for better documentation
 		// If being used in any database, update this comment here to make it clear
+		// Cassandra: "replicationDLQMode" selects where replication DLQ tasks are stored, one of
+		// "legacy" (default, executions table), "migrate" or "dedicated" (replication_dlq_tasks table)
 		ConnectAttributes map[string]string `yaml:"connectAttributes"`
 	}
 
@@ -424,6 +440,9 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
 		S3store   *S3Archiver        `yaml:"s3store"`
+		// Custom contains named provider configs that a domain can select instead of the ones above,
+		// e.g. to archive into a different S3 account or GCS project
+		Custom map[string]*HistoryArchiverProvider `yaml:"custom"`
 	}
 
 	// VisibilityArchival contains the config for visibility archival
@@ -441,6 +460,9 @@ type (
 		Filestore *FilestoreArchiver `yaml:"filestore"`
 		S3store   *S3Archiver        `yaml:"s3store"`
 		Gstorage  *GstorageArchiver  `yaml:"gstorage"`
+		ESstore   *ESArchiver        `yaml:"esstore"`
+		// Custom contains named provider configs that a domain can select instead of the ones above
+		Custom map[string]*VisibilityArchiverProvider `yaml:"custom"`
 	}
 
 	// FilestoreArchiver contain the config for filestore archiver
@@ -461,6 +483,12 @@ type (
 		S3ForcePathStyle bool    `yaml:"s3ForcePathStyle"`
 	}
 
+	// ESArchiver contains the config for the ElasticSearch visibility archiver,
+	// which writes closed workflow records to a per-domain cold index
+	ESArchiver struct {
+		ElasticSearch *ElasticSearchConfig `yaml:"elasticsearch"`
+	}
+
 	// PublicClient is config for connecting to cadence frontend
 	PublicClient struct {
 		// HostPort is the host port to connect on. Host can be DNS name
@@ -158,10 +158,24 @@ const (
 	DomainDataKeyForManagedFailover = "IsManagedByCadence"
 	// DomainDataKeyForPreferredCluster is the key of DomainData for domain rebalance
 	DomainDataKeyForPreferredCluster = "PreferredCluster"
+	// DomainDataKeyForFailoverDrill is the key of DomainData for scheduled failover drill
+	DomainDataKeyForFailoverDrill = "IsFailoverDrillEnabled"
 	// DomainDataKeyForReadGroups stores which groups have read permission of the domain API
 	DomainDataKeyForReadGroups = "READ_GROUPS"
 	// DomainDataKeyForWriteGroups stores which groups have write permission of the domain API
 	DomainDataKeyForWriteGroups = "WRITE_GROUPS"
+	// DomainDataKeyForHistoryArchivalProvider is the key of DomainData for the name of the custom history archiver provider config
+	DomainDataKeyForHistoryArchivalProvider = "HistoryArchivalProvider"
+	// DomainDataKeyForVisibilityArchivalProvider is the key of DomainData for the name of the custom visibility archiver provider config
+	DomainDataKeyForVisibilityArchivalProvider = "VisibilityArchivalProvider"
+	// DomainDataKeyForChangeHistory is the key of DomainData for the change trail recorded by the CLI
+	DomainDataKeyForChangeHistory = "ChangeHistory"
+	// DomainDataKeyForWorkflowIDPattern is the key of DomainData for the pattern workflow IDs started in the domain must match
+	DomainDataKeyForWorkflowIDPattern = "WorkflowIDPattern"
+	// DomainDataKeyForQuarantine is the key of DomainData for putting a domain in quarantine, see domain.IsQuarantined
+	DomainDataKeyForQuarantine = "IsQuarantined"
+	// DomainDataKeyPrefixForMetadata is the prefix of the DomainData keys holding the typed domain metadata documents
+	DomainDataKeyPrefixForMetadata = "cadence.metadata."
 )
 
 type (
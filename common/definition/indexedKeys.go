@@ -47,6 +47,9 @@ const (
 	CustomDoubleField    = "CustomDoubleField"
 	CustomDatetimeField  = "CustomDatetimeField"
 	CadenceChangeVersion = "CadenceChangeVersion"
+	// HistoryLimitReachTime is set by history on executions whose history is projected
+	// to reach the size or count limit, it is the estimated time the limit is reached
+	HistoryLimitReachTime = "HistoryLimitReachTime"
 )
 
 // valid non-indexed fields on ES
@@ -62,14 +65,15 @@ var defaultIndexedKeys = createDefaultIndexedKeys()
 
 func createDefaultIndexedKeys() map[string]interface{} {
 	defaultIndexedKeys := map[string]interface{}{
-		CustomStringField:    shared.IndexedValueTypeString,
-		CustomKeywordField:   shared.IndexedValueTypeKeyword,
-		CustomIntField:       shared.IndexedValueTypeInt,
-		CustomBoolField:      shared.IndexedValueTypeBool,
-		CustomDoubleField:    shared.IndexedValueTypeDouble,
-		CustomDatetimeField:  shared.IndexedValueTypeDatetime,
-		CadenceChangeVersion: shared.IndexedValueTypeKeyword,
-		BinaryChecksums:      shared.IndexedValueTypeKeyword,
+		CustomStringField:     shared.IndexedValueTypeString,
+		CustomKeywordField:    shared.IndexedValueTypeKeyword,
+		CustomIntField:        shared.IndexedValueTypeInt,
+		CustomBoolField:       shared.IndexedValueTypeBool,
+		CustomDoubleField:     shared.IndexedValueTypeDouble,
+		CustomDatetimeField:   shared.IndexedValueTypeDatetime,
+		CadenceChangeVersion:  shared.IndexedValueTypeKeyword,
+		BinaryChecksums:       shared.IndexedValueTypeKeyword,
+		HistoryLimitReachTime: shared.IndexedValueTypeDatetime,
 	}
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
@@ -22,7 +22,10 @@ package domain
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -61,6 +64,20 @@ func (d *AttrValidatorImpl) validateDomainConfig(config *persistence.DomainConfi
 	return nil
 }
 
+func (d *AttrValidatorImpl) validateDomainData(data map[string]string) error {
+	if pattern := data[common.DomainDataKeyForWorkflowIDPattern]; pattern != "" {
+		if _, err := compileWorkflowIDPattern(pattern); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Invalid workflow ID pattern %q: %v.", pattern, err)}
+		}
+	}
+	if value, ok := data[common.DomainDataKeyForQuarantine]; ok {
+		if _, err := strconv.ParseBool(strings.TrimSpace(value)); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Invalid quarantine value %q, it must be true or false.", value)}
+		}
+	}
+	return nil
+}
+
 func (d *AttrValidatorImpl) validateDomainReplicationConfigForLocalDomain(
 	replicationConfig *persistence.DomainReplicationConfig,
 ) error {
@@ -34,4 +34,10 @@ const (
 
 	// FailoverCoolDown is the duration between two failovers
 	FailoverCoolDown = 1 * time.Minute
+
+	// DefaultFailoverWebhookTimeout is the timeout of a single domain failover webhook call
+	DefaultFailoverWebhookTimeout = 5 * time.Second
+
+	// DefaultMetadataSizeLimit is the maximal total size in bytes of the metadata documents of a domain
+	DefaultMetadataSizeLimit = 8 * 1024
 )
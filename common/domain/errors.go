@@ -37,4 +37,9 @@ var (
 
 	errInvalidRetentionPeriod = &types.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig  = &types.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
+
+	errInvalidAsyncWorkflowQueue = &types.BadRequestError{Message: "Invalid to enable async workflows without specifying a queue provider and topic."}
+	errInvalidAsyncWorkflowRPS   = &types.BadRequestError{Message: "Async workflow RPS cannot be negative."}
+
+	errDomainNameUUIDMismatch = &types.BadRequestError{Message: "Domain name does not match the domain UUID."}
 )
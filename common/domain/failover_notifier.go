@@ -0,0 +1,153 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	// FailoverNotifier tells downstream systems (traffic routers, dashboards, pagers)
+	// that the active cluster of a domain has changed
+	FailoverNotifier interface {
+		NotifyFailover(ctx context.Context, event *FailoverEvent) error
+	}
+
+	// FailoverEvent is the payload delivered to failover webhooks
+	FailoverEvent struct {
+		DomainID        string `json:"domainID"`
+		DomainName      string `json:"domainName"`
+		FromCluster     string `json:"fromCluster"`
+		ToCluster       string `json:"toCluster"`
+		FailoverVersion int64  `json:"failoverVersion"`
+		IsGraceful      bool   `json:"isGraceful"`
+		// Initiator is the cluster that handled the failover request and,
+		// when known, the calling service, e.g. "cluster0/cadence-cli"
+		Initiator string    `json:"initiator"`
+		Timestamp time.Time `json:"timestamp"`
+	}
+
+	webhookFailoverNotifier struct {
+		urls    dynamicconfig.StringPropertyFnWithDomainFilter
+		timeout dynamicconfig.DurationPropertyFn
+		client  *http.Client
+		logger  log.Logger
+	}
+)
+
+var _ FailoverNotifier = (*webhookFailoverNotifier)(nil)
+
+// NewWebhookFailoverNotifier creates a FailoverNotifier which POSTs the failover event as JSON
+// to every webhook URL configured for the domain. URLs are read per domain from dynamic config,
+// so a cluster wide default can be combined with domain specific overrides.
+func NewWebhookFailoverNotifier(
+	urls dynamicconfig.StringPropertyFnWithDomainFilter,
+	timeout dynamicconfig.DurationPropertyFn,
+	logger log.Logger,
+) FailoverNotifier {
+	return &webhookFailoverNotifier{
+		urls:    urls,
+		timeout: timeout,
+		client:  &http.Client{},
+		logger:  logger,
+	}
+}
+
+// NotifyFailover calls all webhooks of the domain, a failing webhook does not prevent
+// the remaining ones from being called
+func (n *webhookFailoverNotifier) NotifyFailover(
+	ctx context.Context,
+	event *FailoverEvent,
+) error {
+
+	urls := parseWebhookURLs(n.urls(event.DomainName))
+	if len(urls) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	var failed []string
+	for _, url := range urls {
+		if err := n.post(ctx, url, payload); err != nil {
+			n.logger.Warn("Failed to call domain failover webhook",
+				tag.WorkflowDomainName(event.DomainName),
+				tag.Address(url),
+				tag.Error(err),
+			)
+			failed = append(failed, url)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to call %d of %d domain failover webhooks: %v", len(failed), len(urls), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func (n *webhookFailoverNotifier) post(
+	ctx context.Context,
+	url string,
+	payload []byte,
+) error {
+
+	ctx, cancel := context.WithTimeout(ctx, n.timeout())
+	defer cancel()
+
+	request, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := n.client.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+	return nil
+}
+
+func parseWebhookURLs(value string) []string {
+	var urls []string
+	for _, url := range strings.Split(value, ",") {
+		if url = strings.TrimSpace(url); url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
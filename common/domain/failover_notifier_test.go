@@ -0,0 +1,86 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+func TestWebhookFailoverNotifier(t *testing.T) {
+	var received []*FailoverEvent
+	okServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		event := &FailoverEvent{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(event))
+		received = append(received, event)
+	}))
+	defer okServer.Close()
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingServer.Close()
+
+	urls := map[string]string{
+		"domain-ok":      okServer.URL + " , " + okServer.URL,
+		"domain-failing": failingServer.URL + "," + okServer.URL,
+	}
+	notifier := NewWebhookFailoverNotifier(
+		func(domain string) string { return urls[domain] },
+		dynamicconfig.GetDurationPropertyFn(time.Second),
+		loggerimpl.NewNopLogger(),
+	)
+
+	event := &FailoverEvent{
+		DomainID:        "domain-id",
+		DomainName:      "domain-ok",
+		FromCluster:     "cluster0",
+		ToCluster:       "cluster1",
+		FailoverVersion: 11,
+		IsGraceful:      true,
+		Initiator:       "cluster1/cadence-cli",
+		Timestamp:       time.Unix(1600000000, 0).UTC(),
+	}
+	require.NoError(t, notifier.NotifyFailover(context.Background(), event))
+	require.Len(t, received, 2)
+	assert.Equal(t, event, received[0])
+
+	received = nil
+	event.DomainName = "domain-failing"
+	assert.Error(t, notifier.NotifyFailover(context.Background(), event))
+	assert.Len(t, received, 1)
+
+	received = nil
+	event.DomainName = "domain-without-webhook"
+	assert.NoError(t, notifier.NotifyFailover(context.Background(), event))
+	assert.Empty(t, received)
+}
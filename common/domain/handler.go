@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/archiver"
@@ -69,6 +70,10 @@ type (
 			ctx context.Context,
 			updateRequest *types.UpdateDomainRequest,
 		) (*types.UpdateDomainResponse, error)
+		UpdateAsyncWorkflowConfiguration(
+			ctx context.Context,
+			updateRequest types.UpdateDomainAsyncWorkflowConfigRequest,
+		) error
 	}
 
 	// handlerImpl is the domain operation handler implementation
@@ -79,6 +84,8 @@ type (
 		domainAttrValidator *AttrValidatorImpl
 		archivalMetadata    archiver.ArchivalMetadata
 		archiverProvider    provider.ArchiverProvider
+		provisioner         Provisioner
+		failoverNotifier    FailoverNotifier
 		timeSource          clock.TimeSource
 		config              Config
 		logger              log.Logger
@@ -91,6 +98,17 @@ type (
 		RequiredDomainDataKeys dynamicconfig.MapPropertyFn
 		MaxBadBinaryCount      dynamicconfig.IntPropertyFnWithDomainFilter
 		FailoverCoolDown       dynamicconfig.DurationPropertyFnWithDomainFilter
+		// EnableAsyncRegistration registers local domains in PROVISIONING status and
+		// hands them over to the Provisioner, which marks them REGISTERED once done
+		EnableAsyncRegistration dynamicconfig.BoolPropertyFn
+		// FailoverWebhookURLs are called with the failover details whenever the
+		// active cluster of a domain is changed through this handler
+		FailoverWebhookURLs    dynamicconfig.StringPropertyFnWithDomainFilter
+		FailoverWebhookTimeout dynamicconfig.DurationPropertyFn
+		// ValidMetadataKeys maps the metadata keys that can be set on a domain to their type,
+		// MetadataSizeLimit bounds the total size of the metadata of a domain
+		ValidMetadataKeys dynamicconfig.MapPropertyFn
+		MetadataSizeLimit dynamicconfig.IntPropertyFn
 	}
 )
 
@@ -105,8 +123,17 @@ func NewHandler(
 	domainReplicator Replicator,
 	archivalMetadata archiver.ArchivalMetadata,
 	archiverProvider provider.ArchiverProvider,
+	provisioner Provisioner,
 	timeSource clock.TimeSource,
 ) Handler {
+	var failoverNotifier FailoverNotifier
+	if config.FailoverWebhookURLs != nil {
+		webhookTimeout := config.FailoverWebhookTimeout
+		if webhookTimeout == nil {
+			webhookTimeout = dynamicconfig.GetDurationPropertyFn(DefaultFailoverWebhookTimeout)
+		}
+		failoverNotifier = NewWebhookFailoverNotifier(config.FailoverWebhookURLs, webhookTimeout, logger)
+	}
 	return &handlerImpl{
 		logger:              logger,
 		domainManager:       domainManager,
@@ -115,6 +142,8 @@ func NewHandler(
 		domainAttrValidator: newAttrValidator(clusterMetadata, int32(config.MinRetentionDays())),
 		archivalMetadata:    archivalMetadata,
 		archiverProvider:    archiverProvider,
+		provisioner:         provisioner,
+		failoverNotifier:    failoverNotifier,
 		timeSource:          timeSource,
 		config:              config,
 	}
@@ -139,9 +168,14 @@ func (d *handlerImpl) RegisterDomain(
 	}
 
 	// first check if the name is already registered as the local domain
-	_, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: registerRequest.GetName()})
+	existingDomain, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: registerRequest.GetName()})
 	switch err.(type) {
 	case nil:
+		if existingDomain.Info.Status == persistence.DomainStatusProvisioning && d.provisioner != nil {
+			// a previous async registration did not manage to hand the domain over
+			// to the provisioning workflow, retry that instead of failing
+			return d.provisioner.StartDomainProvisioning(ctx, existingDomain.Info.ID, existingDomain.Info.Name)
+		}
 		// domain already exists, cannot proceed
 		return &types.DomainAlreadyExistsError{Message: "Domain already exists."}
 	case *types.EntityNotExistsError:
@@ -177,7 +211,10 @@ func (d *handlerImpl) RegisterDomain(
 			return err
 		}
 
-		nextHistoryArchivalState, _, err = currentHistoryArchivalState.getNextState(archivalEvent, d.validateHistoryArchivalURI)
+		nextHistoryArchivalState, _, err = currentHistoryArchivalState.getNextState(
+			archivalEvent,
+			d.historyArchivalURIValidator(registerRequest.Data[common.DomainDataKeyForHistoryArchivalProvider]),
+		)
 		if err != nil {
 			return err
 		}
@@ -197,7 +234,10 @@ func (d *handlerImpl) RegisterDomain(
 			return err
 		}
 
-		nextVisibilityArchivalState, _, err = currentVisibilityArchivalState.getNextState(archivalEvent, d.validateVisibilityArchivalURI)
+		nextVisibilityArchivalState, _, err = currentVisibilityArchivalState.getNextState(
+			archivalEvent,
+			d.visibilityArchivalURIValidator(registerRequest.Data[common.DomainDataKeyForVisibilityArchivalProvider]),
+		)
 		if err != nil {
 			return err
 		}
@@ -225,10 +265,20 @@ func (d *handlerImpl) RegisterDomain(
 		Clusters:          clusters,
 	}
 	isGlobalDomain := registerRequest.GetIsGlobalDomain()
+	asyncRegistration := d.isAsyncRegistrationEnabled(isGlobalDomain)
+	if asyncRegistration {
+		info.Status = persistence.DomainStatusProvisioning
+	}
 
 	if err := d.domainAttrValidator.validateDomainConfig(config); err != nil {
 		return err
 	}
+	if err := validateDomainDataKeys(registerRequest.Data); err != nil {
+		return err
+	}
+	if err := d.domainAttrValidator.validateDomainData(info.Data); err != nil {
+		return err
+	}
 	if isGlobalDomain {
 		if err := d.domainAttrValidator.validateDomainReplicationConfigForGlobalDomain(
 			replicationConfig,
@@ -280,6 +330,17 @@ func (d *handlerImpl) RegisterDomain(
 		}
 	}
 
+	if asyncRegistration {
+		if err := d.provisioner.StartDomainProvisioning(ctx, domainResponse.ID, registerRequest.GetName()); err != nil {
+			return err
+		}
+		d.logger.Info("Register domain accepted, domain is provisioning",
+			tag.WorkflowDomainName(registerRequest.GetName()),
+			tag.WorkflowDomainID(domainResponse.ID),
+		)
+		return nil
+	}
+
 	d.logger.Info("Register domain succeeded",
 		tag.WorkflowDomainName(registerRequest.GetName()),
 		tag.WorkflowDomainID(domainResponse.ID),
@@ -288,6 +349,29 @@ func (d *handlerImpl) RegisterDomain(
 	return nil
 }
 
+// isAsyncRegistrationEnabled returns whether the domain should be registered in PROVISIONING status.
+// Global domains are always registered synchronously, as the status flip is not replicated.
+func (d *handlerImpl) isAsyncRegistrationEnabled(isGlobalDomain bool) bool {
+	return !isGlobalDomain &&
+		d.provisioner != nil &&
+		d.config.EnableAsyncRegistration != nil &&
+		d.config.EnableAsyncRegistration()
+}
+
+func (d *handlerImpl) validMetadataKeys() map[string]interface{} {
+	if d.config.ValidMetadataKeys == nil {
+		return nil
+	}
+	return d.config.ValidMetadataKeys()
+}
+
+func (d *handlerImpl) metadataSizeLimit() int {
+	if d.config.MetadataSizeLimit == nil {
+		return DefaultMetadataSizeLimit
+	}
+	return d.config.MetadataSizeLimit()
+}
+
 // ListDomains list all domains
 func (d *handlerImpl) ListDomains(
 	ctx context.Context,
@@ -321,6 +405,7 @@ func (d *handlerImpl) ListDomains(
 	response := &types.ListDomainsResponse{
 		Domains:       domains,
 		NextPageToken: resp.NextPageToken,
+		TotalCount:    resp.TotalCount,
 	}
 
 	return response, nil
@@ -373,7 +458,7 @@ func (d *handlerImpl) UpdateDomain(
 		return nil, err
 	}
 	notificationVersion := metadata.NotificationVersion
-	getResponse, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: updateRequest.GetName()})
+	getResponse, err := d.getDomainByNameOrID(ctx, updateRequest.GetName(), updateRequest.GetUUID())
 	if err != nil {
 		return nil, err
 	}
@@ -403,6 +488,7 @@ func (d *handlerImpl) UpdateDomain(
 	historyArchivalState, historyArchivalConfigChanged, err := d.getHistoryArchivalState(
 		config,
 		updateRequest,
+		getArchivalProviderName(common.DomainDataKeyForHistoryArchivalProvider, info.Data, updateRequest.Data),
 	)
 	if err != nil {
 		return nil, err
@@ -416,6 +502,7 @@ func (d *handlerImpl) UpdateDomain(
 	visibilityArchivalState, visibilityArchivalConfigChanged, err := d.getVisibilityArchivalState(
 		config,
 		updateRequest,
+		getArchivalProviderName(common.DomainDataKeyForVisibilityArchivalProvider, info.Data, updateRequest.Data),
 	)
 	if err != nil {
 		return nil, err
@@ -425,6 +512,13 @@ func (d *handlerImpl) UpdateDomain(
 		config.VisibilityArchivalURI = visibilityArchivalState.URI
 	}
 
+	if err := validateDomainDataKeys(updateRequest.Data); err != nil {
+		return nil, err
+	}
+	if err := validateDomainMetadata(updateRequest.Metadata, d.validMetadataKeys()); err != nil {
+		return nil, err
+	}
+
 	// Update domain info
 	info, domainInfoChanged := d.updateDomainInfo(
 		updateRequest,
@@ -485,6 +579,14 @@ func (d *handlerImpl) UpdateDomain(
 	if err := d.domainAttrValidator.validateDomainConfig(config); err != nil {
 		return nil, err
 	}
+	if err := d.domainAttrValidator.validateDomainData(info.Data); err != nil {
+		return nil, err
+	}
+	if updateRequest.Metadata != nil {
+		if err := validateDomainMetadataSize(info.Data, d.metadataSizeLimit()); err != nil {
+			return nil, err
+		}
+	}
 	if isGlobalDomain {
 		if err := d.domainAttrValidator.validateDomainReplicationConfigForGlobalDomain(
 			replicationConfig,
@@ -566,6 +668,18 @@ func (d *handlerImpl) UpdateDomain(
 		}
 	}
 
+	if activeClusterChanged && isGlobalDomain {
+		d.notifyFailover(ctx, &FailoverEvent{
+			DomainID:        info.ID,
+			DomainName:      info.Name,
+			FromCluster:     currentActiveCluster,
+			ToCluster:       replicationConfig.ActiveClusterName,
+			FailoverVersion: failoverVersion,
+			IsGraceful:      updateRequest.FailoverTimeoutInSeconds != nil,
+			Timestamp:       lastUpdatedTime,
+		})
+	}
+
 	response := &types.UpdateDomainResponse{
 		IsGlobalDomain:  isGlobalDomain,
 		FailoverVersion: failoverVersion,
@@ -594,7 +708,7 @@ func (d *handlerImpl) DeprecateDomain(
 		return err
 	}
 	notificationVersion := metadata.NotificationVersion
-	getResponse, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: deprecateRequest.GetName()})
+	getResponse, err := d.getDomainByNameOrID(ctx, deprecateRequest.GetName(), deprecateRequest.GetUUID())
 	if err != nil {
 		return err
 	}
@@ -646,19 +760,96 @@ func (d *handlerImpl) DeprecateDomain(
 	return nil
 }
 
+// UpdateAsyncWorkflowConfiguration replaces the async workflow configuration of the domain,
+// a nil configuration removes it
+func (d *handlerImpl) UpdateAsyncWorkflowConfiguration(
+	ctx context.Context,
+	updateRequest types.UpdateDomainAsyncWorkflowConfigRequest,
+) error {
+	asyncWorkflowConfig := types.AsyncWorkflowConfiguration{}
+	if updateRequest.Configuration != nil {
+		asyncWorkflowConfig = *updateRequest.Configuration
+	}
+	if err := validateAsyncWorkflowConfiguration(asyncWorkflowConfig); err != nil {
+		return err
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 domain table
+	// and since we do not know which table will return the domain afterwards
+	// this call has to be made
+	metadata, err := d.domainManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.getDomainByNameOrID(ctx, updateRequest.GetDomain(), "")
+	if err != nil {
+		return err
+	}
+
+	isGlobalDomain := getResponse.IsGlobalDomain
+	if isGlobalDomain && !d.clusterMetadata.IsPrimaryCluster() {
+		return errNotPrimaryCluster
+	}
+	getResponse.ConfigVersion = getResponse.ConfigVersion + 1
+	getResponse.Config.AsyncWorkflowConfig = asyncWorkflowConfig
+
+	updateReq := &persistence.UpdateDomainRequest{
+		Info:                        getResponse.Info,
+		Config:                      getResponse.Config,
+		ReplicationConfig:           getResponse.ReplicationConfig,
+		ConfigVersion:               getResponse.ConfigVersion,
+		FailoverVersion:             getResponse.FailoverVersion,
+		FailoverNotificationVersion: getResponse.FailoverNotificationVersion,
+		FailoverEndTime:             getResponse.FailoverEndTime,
+		PreviousFailoverVersion:     getResponse.PreviousFailoverVersion,
+		LastUpdatedTime:             d.timeSource.Now().UnixNano(),
+		NotificationVersion:         notificationVersion,
+	}
+	err = d.domainManager.UpdateDomain(ctx, updateReq)
+	if err != nil {
+		return err
+	}
+
+	if isGlobalDomain {
+		if err := d.domainReplicator.HandleTransmissionTask(
+			ctx,
+			types.DomainOperationUpdate,
+			getResponse.Info,
+			getResponse.Config,
+			getResponse.ReplicationConfig,
+			getResponse.ConfigVersion,
+			getResponse.FailoverVersion,
+			getResponse.PreviousFailoverVersion,
+			isGlobalDomain,
+		); err != nil {
+			return err
+		}
+	}
+
+	d.logger.Info("UpdateAsyncWorkflowConfiguration domain succeeded",
+		tag.WorkflowDomainName(getResponse.Info.Name),
+		tag.WorkflowDomainID(getResponse.Info.ID),
+	)
+	return nil
+}
+
 func (d *handlerImpl) createResponse(
 	info *persistence.DomainInfo,
 	config *persistence.DomainConfig,
 	replicationConfig *persistence.DomainReplicationConfig,
 ) (*types.DomainInfo, *types.DomainConfiguration, *types.DomainReplicationConfiguration) {
 
+	data, metadata := splitDomainMetadata(info.Data)
 	infoResult := &types.DomainInfo{
 		Name:        info.Name,
 		Status:      getDomainStatus(info),
 		Description: info.Description,
 		OwnerEmail:  info.OwnerEmail,
-		Data:        info.Data,
+		Data:        data,
 		UUID:        info.ID,
+		Metadata:    metadata,
 	}
 
 	configResult := &types.DomainConfiguration{
@@ -669,6 +860,7 @@ func (d *handlerImpl) createResponse(
 		VisibilityArchivalStatus:               config.VisibilityArchivalStatus.Ptr(),
 		VisibilityArchivalURI:                  config.VisibilityArchivalURI,
 		BadBinaries:                            &config.BadBinaries,
+		AsyncWorkflowConfig:                    &config.AsyncWorkflowConfig,
 	}
 
 	clusters := []*types.ClusterReplicationConfiguration{}
@@ -756,37 +948,58 @@ func (d *handlerImpl) toArchivalUpdateEvent(
 	return event, nil
 }
 
-func (d *handlerImpl) validateHistoryArchivalURI(URIString string) error {
-	URI, err := archiver.NewURI(URIString)
-	if err != nil {
-		return err
-	}
+// historyArchivalURIValidator validates the URI against the archiver of the given provider,
+// which also rejects provider names that are not in the archiver provider config
+func (d *handlerImpl) historyArchivalURIValidator(providerName string) func(string) error {
+	return func(URIString string) error {
+		URI, err := archiver.NewURI(URIString)
+		if err != nil {
+			return err
+		}
 
-	archiver, err := d.archiverProvider.GetHistoryArchiver(URI.Scheme(), service.Frontend)
-	if err != nil {
-		return err
-	}
+		archiver, err := d.archiverProvider.GetHistoryArchiver(URI.Scheme(), providerName, service.Frontend)
+		if err != nil {
+			return err
+		}
 
-	return archiver.ValidateURI(URI)
+		return archiver.ValidateURI(URI)
+	}
 }
 
-func (d *handlerImpl) validateVisibilityArchivalURI(URIString string) error {
-	URI, err := archiver.NewURI(URIString)
-	if err != nil {
-		return err
-	}
+// visibilityArchivalURIValidator validates the URI against the archiver of the given provider
+func (d *handlerImpl) visibilityArchivalURIValidator(providerName string) func(string) error {
+	return func(URIString string) error {
+		URI, err := archiver.NewURI(URIString)
+		if err != nil {
+			return err
+		}
 
-	archiver, err := d.archiverProvider.GetVisibilityArchiver(URI.Scheme(), service.Frontend)
-	if err != nil {
-		return err
+		archiver, err := d.archiverProvider.GetVisibilityArchiver(URI.Scheme(), providerName, service.Frontend)
+		if err != nil {
+			return err
+		}
+
+		return archiver.ValidateURI(URI)
 	}
+}
 
-	return archiver.ValidateURI(URI)
+// getArchivalProviderName returns the custom archiver provider name stored under the domain data key,
+// the value in the update request takes precedence over the current one
+func getArchivalProviderName(
+	key string,
+	currentData map[string]string,
+	updateData map[string]string,
+) string {
+	if providerName, ok := updateData[key]; ok {
+		return providerName
+	}
+	return currentData[key]
 }
 
 func (d *handlerImpl) getHistoryArchivalState(
 	config *persistence.DomainConfig,
 	updateRequest *types.UpdateDomainRequest,
+	providerName string,
 ) (*ArchivalState, bool, error) {
 
 	currentHistoryArchivalState := &ArchivalState{
@@ -804,7 +1017,7 @@ func (d *handlerImpl) getHistoryArchivalState(
 		if err != nil {
 			return currentHistoryArchivalState, false, err
 		}
-		return currentHistoryArchivalState.getNextState(archivalEvent, d.validateHistoryArchivalURI)
+		return currentHistoryArchivalState.getNextState(archivalEvent, d.historyArchivalURIValidator(providerName))
 	}
 	return currentHistoryArchivalState, false, nil
 }
@@ -812,6 +1025,7 @@ func (d *handlerImpl) getHistoryArchivalState(
 func (d *handlerImpl) getVisibilityArchivalState(
 	config *persistence.DomainConfig,
 	updateRequest *types.UpdateDomainRequest,
+	providerName string,
 ) (*ArchivalState, bool, error) {
 	currentVisibilityArchivalState := &ArchivalState{
 		Status: config.VisibilityArchivalStatus,
@@ -827,7 +1041,7 @@ func (d *handlerImpl) getVisibilityArchivalState(
 		if err != nil {
 			return currentVisibilityArchivalState, false, err
 		}
-		return currentVisibilityArchivalState.getNextState(archivalEvent, d.validateVisibilityArchivalURI)
+		return currentVisibilityArchivalState.getNextState(archivalEvent, d.visibilityArchivalURIValidator(providerName))
 	}
 	return currentVisibilityArchivalState, false, nil
 }
@@ -851,6 +1065,10 @@ func (d *handlerImpl) updateDomainInfo(
 		// only do merging
 		currentDomainInfo.Data = d.mergeDomainData(currentDomainInfo.Data, updateRequest.Data)
 	}
+	if updateRequest.Metadata != nil {
+		isDomainUpdated = true
+		currentDomainInfo.Data = mergeDomainMetadata(currentDomainInfo.Data, updateRequest.Metadata)
+	}
 	return currentDomainInfo, isDomainUpdated
 }
 
@@ -932,6 +1150,51 @@ func (d *handlerImpl) updateReplicationConfig(
 	return config, clusterUpdated, activeClusterUpdated, nil
 }
 
+// getDomainByNameOrID loads the domain by UUID when one is given, which disambiguates
+// legacy metadata with duplicated or renamed domains, and by name otherwise
+func (d *handlerImpl) getDomainByNameOrID(
+	ctx context.Context,
+	domainName string,
+	domainID string,
+) (*persistence.GetDomainResponse, error) {
+	if domainID == "" {
+		return d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: domainName})
+	}
+
+	resp, err := d.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{ID: domainID})
+	if err != nil {
+		return nil, err
+	}
+	if domainName != "" && resp.Info.Name != domainName {
+		return nil, errDomainNameUUIDMismatch
+	}
+	return resp, nil
+}
+
+// notifyFailover delivers the failover event to the configured webhooks in the background,
+// the failover itself is already persisted and must not be slowed down or failed by them
+func (d *handlerImpl) notifyFailover(
+	ctx context.Context,
+	event *FailoverEvent,
+) {
+	if d.failoverNotifier == nil {
+		return
+	}
+
+	event.Initiator = d.clusterMetadata.GetCurrentClusterName()
+	if caller := yarpc.CallFromContext(ctx).Caller(); caller != "" {
+		event.Initiator += "/" + caller
+	}
+	go func() {
+		if err := d.failoverNotifier.NotifyFailover(context.Background(), event); err != nil {
+			d.logger.Warn("Failed to notify domain failover",
+				tag.WorkflowDomainName(event.DomainName),
+				tag.Error(err),
+			)
+		}
+	}()
+}
+
 func getDomainStatus(info *persistence.DomainInfo) *types.DomainStatus {
 	switch info.Status {
 	case persistence.DomainStatusRegistered:
@@ -943,7 +1206,20 @@ func getDomainStatus(info *persistence.DomainInfo) *types.DomainStatus {
 	case persistence.DomainStatusDeleted:
 		v := types.DomainStatusDeleted
 		return &v
+	case persistence.DomainStatusProvisioning:
+		v := types.DomainStatusProvisioning
+		return &v
 	}
 
 	return nil
 }
+
+func validateAsyncWorkflowConfiguration(config types.AsyncWorkflowConfiguration) error {
+	if config.Enabled && (config.QueueProvider == "" || config.QueueTopic == "") {
+		return errInvalidAsyncWorkflowQueue
+	}
+	if config.RPS < 0 {
+		return errInvalidAsyncWorkflowRPS
+	}
+	return nil
+}
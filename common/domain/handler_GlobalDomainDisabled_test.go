@@ -112,6 +112,7 @@ func (s *domainHandlerGlobalDomainDisabledSuite) SetupTest() {
 		s.mockDomainReplicator,
 		s.archivalMetadata,
 		s.mockArchiverProvider,
+		nil,
 		clock.NewRealTimeSource(),
 	).(*handlerImpl)
 }
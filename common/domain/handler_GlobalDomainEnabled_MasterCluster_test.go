@@ -113,6 +113,7 @@ func (s *domainHandlerGlobalDomainEnabledPrimaryClusterSuite) SetupTest() {
 		s.mockDomainReplicator,
 		s.archivalMetadata,
 		s.mockArchiverProvider,
+		nil,
 		clock.NewRealTimeSource(),
 	).(*handlerImpl)
 }
@@ -887,6 +888,7 @@ func (s *domainHandlerGlobalDomainEnabledPrimaryClusterSuite) TestUpdateDomain_C
 		s.mockDomainReplicator,
 		s.archivalMetadata,
 		s.mockArchiverProvider,
+		nil,
 		clock.NewRealTimeSource(),
 	).(*handlerImpl)
 
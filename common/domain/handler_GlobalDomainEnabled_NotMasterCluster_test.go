@@ -113,6 +113,7 @@ func (s *domainHandlerGlobalDomainEnabledNotPrimaryClusterSuite) SetupTest() {
 		s.mockDomainReplicator,
 		s.archivalMetadata,
 		s.mockArchiverProvider,
+		nil,
 		clock.NewRealTimeSource(),
 	).(*handlerImpl)
 }
@@ -130,3 +130,17 @@ func (mr *MockHandlerMockRecorder) UpdateDomain(ctx, updateRequest interface{})
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockHandler)(nil).UpdateDomain), ctx, updateRequest)
 }
+
+// UpdateAsyncWorkflowConfiguration mocks base method
+func (m *MockHandler) UpdateAsyncWorkflowConfiguration(ctx context.Context, updateRequest types.UpdateDomainAsyncWorkflowConfigRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAsyncWorkflowConfiguration", ctx, updateRequest)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAsyncWorkflowConfiguration indicates an expected call of UpdateAsyncWorkflowConfiguration
+func (mr *MockHandlerMockRecorder) UpdateAsyncWorkflowConfiguration(ctx, updateRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAsyncWorkflowConfiguration", reflect.TypeOf((*MockHandler)(nil).UpdateAsyncWorkflowConfiguration), ctx, updateRequest)
+}
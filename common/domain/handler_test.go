@@ -27,6 +27,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -116,6 +117,7 @@ func (s *domainHandlerCommonSuite) SetupTest() {
 		s.mockDomainReplicator,
 		s.archivalMetadata,
 		s.mockArchiverProvider,
+		nil,
 		clock.NewRealTimeSource(),
 	).(*handlerImpl)
 }
@@ -607,6 +609,176 @@ func (s *domainHandlerCommonSuite) TestUpdateDomain_ForceFailover_SameActiveClus
 	s.NoError(err)
 }
 
+func (s *domainHandlerCommonSuite) TestRegisterDomain_AsyncRegistration() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	provisioner := NewMockProvisioner(controller)
+	s.handler.provisioner = provisioner
+	s.handler.config.EnableAsyncRegistration = dc.GetBoolPropertyFn(true)
+
+	domainName := s.getRandomDomainName()
+	registerRequest := &types.RegisterDomainRequest{
+		Name:                                   domainName,
+		WorkflowExecutionRetentionPeriodInDays: int32(1),
+		IsGlobalDomain:                         false,
+	}
+	provisioner.EXPECT().StartDomainProvisioning(gomock.Any(), gomock.Any(), domainName).Return(&types.ServiceBusyError{}).Times(1)
+	err := s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.IsType(&types.ServiceBusyError{}, err)
+
+	resp, err := s.handler.DescribeDomain(context.Background(), &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	s.NoError(err)
+	s.Equal(types.DomainStatusProvisioning, resp.GetDomainInfo().GetStatus())
+
+	// registering again hands the provisioning domain over instead of failing
+	provisioner.EXPECT().StartDomainProvisioning(gomock.Any(), resp.GetDomainInfo().GetUUID(), domainName).Return(nil).Times(1)
+	err = s.handler.RegisterDomain(context.Background(), registerRequest)
+	s.NoError(err)
+}
+
+func (s *domainHandlerCommonSuite) TestRegisterDomain_AsyncRegistration_GlobalDomain() {
+	controller := gomock.NewController(s.T())
+	defer controller.Finish()
+	s.handler.provisioner = NewMockProvisioner(controller)
+	s.handler.config.EnableAsyncRegistration = dc.GetBoolPropertyFn(true)
+
+	s.False(s.handler.isAsyncRegistrationEnabled(true))
+	s.True(s.handler.isAsyncRegistrationEnabled(false))
+}
+
+func (s *domainHandlerCommonSuite) TestUpdateDomain_ByUUID() {
+	domainName := s.getRandomDomainName()
+	err := s.handler.RegisterDomain(context.Background(), &types.RegisterDomainRequest{
+		Name:                                   domainName,
+		WorkflowExecutionRetentionPeriodInDays: int32(1),
+		IsGlobalDomain:                         false,
+	})
+	s.NoError(err)
+	describeResp, err := s.handler.DescribeDomain(context.Background(), &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	s.NoError(err)
+	domainID := describeResp.GetDomainInfo().GetUUID()
+
+	resp, err := s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:        domainName,
+		UUID:        domainID,
+		Description: common.StringPtr("updated by uuid"),
+	})
+	s.NoError(err)
+	s.Equal("updated by uuid", resp.GetDomainInfo().GetDescription())
+
+	_, err = s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:        s.getRandomDomainName(),
+		UUID:        domainID,
+		Description: common.StringPtr("mismatch"),
+	})
+	s.Equal(errDomainNameUUIDMismatch, err)
+}
+
+func (s *domainHandlerCommonSuite) TestUpdateDomain_Metadata() {
+	s.handler.config.ValidMetadataKeys = dc.GetMapPropertyFn(map[string]interface{}{
+		"tier":  MetadataTypeString,
+		"owner": MetadataTypeObject,
+	})
+	domainName := s.getRandomDomainName()
+	err := s.handler.RegisterDomain(context.Background(), &types.RegisterDomainRequest{
+		Name:                                   domainName,
+		WorkflowExecutionRetentionPeriodInDays: int32(1),
+		IsGlobalDomain:                         false,
+		Data:                                   map[string]string{"k0": "v0"},
+	})
+	s.NoError(err)
+
+	resp, err := s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name: domainName,
+		Metadata: map[string][]byte{
+			"tier":  []byte(`"gold"`),
+			"owner": []byte(`{ "team": "platform" }`),
+		},
+	})
+	s.NoError(err)
+	s.Equal(map[string]string{"k0": "v0"}, resp.GetDomainInfo().GetData())
+	s.Equal(map[string][]byte{
+		"tier":  []byte(`"gold"`),
+		"owner": []byte(`{"team":"platform"}`),
+	}, resp.GetDomainInfo().GetMetadata())
+
+	_, err = s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:     domainName,
+		Metadata: map[string][]byte{"tier": []byte("null")},
+	})
+	s.NoError(err)
+	describeResp, err := s.handler.DescribeDomain(context.Background(), &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	s.NoError(err)
+	s.Equal(map[string][]byte{"owner": []byte(`{"team":"platform"}`)}, describeResp.GetDomainInfo().GetMetadata())
+
+	_, err = s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:     domainName,
+		Metadata: map[string][]byte{"runbook": []byte(`"https://runbook"`)},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	_, err = s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name: domainName,
+		Data: map[string]string{common.DomainDataKeyPrefixForMetadata + "tier": `"gold"`},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+
+	s.handler.config.MetadataSizeLimit = dc.GetIntPropertyFn(10)
+	_, err = s.handler.UpdateDomain(context.Background(), &types.UpdateDomainRequest{
+		Name:     domainName,
+		Metadata: map[string][]byte{"tier": []byte(`"platinum"`)},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *domainHandlerCommonSuite) TestUpdateAsyncWorkflowConfiguration() {
+	domainName := s.getRandomDomainName()
+	err := s.handler.RegisterDomain(context.Background(), &types.RegisterDomainRequest{
+		Name:                                   domainName,
+		WorkflowExecutionRetentionPeriodInDays: int32(1),
+		IsGlobalDomain:                         false,
+	})
+	s.NoError(err)
+	describeResp, err := s.handler.DescribeDomain(context.Background(), &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	s.NoError(err)
+	s.Equal(&types.AsyncWorkflowConfiguration{}, describeResp.GetConfiguration().GetAsyncWorkflowConfig())
+
+	config := &types.AsyncWorkflowConfiguration{
+		Enabled:       true,
+		QueueProvider: "kafka",
+		QueueTopic:    "async-requests",
+		RPS:           100,
+	}
+	err = s.handler.UpdateAsyncWorkflowConfiguration(context.Background(), types.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain:        domainName,
+		Configuration: config,
+	})
+	s.NoError(err)
+	describeResp, err = s.handler.DescribeDomain(context.Background(), &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	s.NoError(err)
+	s.Equal(config, describeResp.GetConfiguration().GetAsyncWorkflowConfig())
+
+	err = s.handler.UpdateAsyncWorkflowConfiguration(context.Background(), types.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain: domainName,
+	})
+	s.NoError(err)
+	describeResp, err = s.handler.DescribeDomain(context.Background(), &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	s.NoError(err)
+	s.Equal(&types.AsyncWorkflowConfiguration{}, describeResp.GetConfiguration().GetAsyncWorkflowConfig())
+
+	for _, invalid := range []*types.AsyncWorkflowConfiguration{
+		{Enabled: true, QueueTopic: "async-requests"},
+		{Enabled: true, QueueProvider: "kafka"},
+		{QueueProvider: "kafka", QueueTopic: "async-requests", RPS: -1},
+	} {
+		err = s.handler.UpdateAsyncWorkflowConfiguration(context.Background(), types.UpdateDomainAsyncWorkflowConfigRequest{
+			Domain:        domainName,
+			Configuration: invalid,
+		})
+		s.IsType(&types.BadRequestError{}, err)
+	}
+}
+
 func (s *domainHandlerCommonSuite) getRandomDomainName() string {
 	return "domain" + uuid.New()
 }
@@ -0,0 +1,187 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// Types a metadata key can be registered with in the frontend.validDomainMetadataKeys dynamic config
+const (
+	MetadataTypeString   = "String"
+	MetadataTypeInt      = "Int"
+	MetadataTypeDouble   = "Double"
+	MetadataTypeBool     = "Bool"
+	MetadataTypeDatetime = "Datetime"
+	MetadataTypeObject   = "Object"
+)
+
+// Domain metadata are small JSON documents stored in the domain data under DomainDataKeyPrefixForMetadata,
+// so they are persisted and replicated together with the rest of the domain info.
+// They are split out of the data again when the domain is described.
+
+// validateDomainDataKeys rejects user provided domain data squatting on the keys reserved for metadata
+func validateDomainDataKeys(data map[string]string) error {
+	for key := range data {
+		if strings.HasPrefix(key, common.DomainDataKeyPrefixForMetadata) {
+			return &types.BadRequestError{Message: fmt.Sprintf(
+				"Domain data key %q is reserved for domain metadata, use the metadata field instead.", key,
+			)}
+		}
+	}
+	return nil
+}
+
+// validateDomainMetadata checks the metadata to upsert against the registered metadata keys.
+// A JSON null value removes the key and only needs the key to be registered
+func validateDomainMetadata(metadata map[string][]byte, validKeys map[string]interface{}) error {
+	for key, value := range metadata {
+		typ, ok := validKeys[key]
+		if !ok {
+			return &types.BadRequestError{Message: fmt.Sprintf("Domain metadata key %q is not registered.", key)}
+		}
+		if isNullMetadata(value) {
+			continue
+		}
+		if err := validateMetadataValue(fmt.Sprint(typ), value); err != nil {
+			return &types.BadRequestError{Message: fmt.Sprintf("Invalid value for domain metadata key %q: %v.", key, err)}
+		}
+	}
+	return nil
+}
+
+func validateMetadataValue(typ string, value []byte) error {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return fmt.Errorf("value is not valid JSON")
+	}
+	if decoder.More() {
+		return fmt.Errorf("value is not a single JSON document")
+	}
+
+	switch typ {
+	case MetadataTypeString:
+		if _, ok := decoded.(string); ok {
+			return nil
+		}
+	case MetadataTypeInt:
+		if n, ok := decoded.(json.Number); ok {
+			if _, err := n.Int64(); err == nil {
+				return nil
+			}
+		}
+	case MetadataTypeDouble:
+		if n, ok := decoded.(json.Number); ok {
+			if _, err := n.Float64(); err == nil {
+				return nil
+			}
+		}
+	case MetadataTypeBool:
+		if _, ok := decoded.(bool); ok {
+			return nil
+		}
+	case MetadataTypeDatetime:
+		if s, ok := decoded.(string); ok {
+			if _, err := time.Parse(time.RFC3339Nano, s); err == nil {
+				return nil
+			}
+		}
+	case MetadataTypeObject:
+		if _, ok := decoded.(map[string]interface{}); ok {
+			return nil
+		}
+	default:
+		return fmt.Errorf("key is registered with unknown type %q", typ)
+	}
+	return fmt.Errorf("value is not of type %v", typ)
+}
+
+// validateDomainMetadataSize checks the total size of the metadata kept in the domain data
+func validateDomainMetadataSize(data map[string]string, sizeLimit int) error {
+	size := 0
+	for key, value := range data {
+		if strings.HasPrefix(key, common.DomainDataKeyPrefixForMetadata) {
+			size += len(key) - len(common.DomainDataKeyPrefixForMetadata) + len(value)
+		}
+	}
+	if size > sizeLimit {
+		return &types.BadRequestError{Message: fmt.Sprintf(
+			"Domain metadata size %v bytes exceeds the limit of %v bytes.", size, sizeLimit,
+		)}
+	}
+	return nil
+}
+
+// mergeDomainMetadata upserts the metadata into the domain data, the values must have been validated
+func mergeDomainMetadata(data map[string]string, metadata map[string][]byte) map[string]string {
+	if data == nil {
+		data = map[string]string{}
+	}
+	for key, value := range metadata {
+		if isNullMetadata(value) {
+			delete(data, common.DomainDataKeyPrefixForMetadata+key)
+			continue
+		}
+		compacted := &bytes.Buffer{}
+		if err := json.Compact(compacted, value); err != nil {
+			compacted = bytes.NewBuffer(value)
+		}
+		data[common.DomainDataKeyPrefixForMetadata+key] = compacted.String()
+	}
+	return data
+}
+
+// splitDomainMetadata separates the metadata documents from the rest of the domain data
+func splitDomainMetadata(data map[string]string) (map[string]string, map[string][]byte) {
+	var metadata map[string][]byte
+	for key := range data {
+		if strings.HasPrefix(key, common.DomainDataKeyPrefixForMetadata) {
+			metadata = map[string][]byte{}
+			break
+		}
+	}
+	if metadata == nil {
+		return data, nil
+	}
+
+	userData := make(map[string]string, len(data))
+	for key, value := range data {
+		if strings.HasPrefix(key, common.DomainDataKeyPrefixForMetadata) {
+			metadata[strings.TrimPrefix(key, common.DomainDataKeyPrefixForMetadata)] = []byte(value)
+		} else {
+			userData[key] = value
+		}
+	}
+	return userData, metadata
+}
+
+func isNullMetadata(value []byte) bool {
+	return string(bytes.TrimSpace(value)) == "null"
+}
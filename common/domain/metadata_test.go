@@ -0,0 +1,106 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidateDomainMetadata(t *testing.T) {
+	validKeys := map[string]interface{}{
+		"tier":     MetadataTypeString,
+		"priority": MetadataTypeInt,
+		"weight":   MetadataTypeDouble,
+		"critical": MetadataTypeBool,
+		"since":    MetadataTypeDatetime,
+		"owner":    MetadataTypeObject,
+		"broken":   "Keyword",
+	}
+	valid := map[string][]byte{
+		"tier":     []byte(`"gold"`),
+		"priority": []byte(`3`),
+		"weight":   []byte(`0.5`),
+		"critical": []byte(`true`),
+		"since":    []byte(`"2021-06-01T10:00:00Z"`),
+		"owner":    []byte(`{"team": "platform", "oncall": ["a", "b"]}`),
+		"broken":   []byte(`null`),
+	}
+	assert.NoError(t, validateDomainMetadata(nil, validKeys))
+	assert.NoError(t, validateDomainMetadata(valid, validKeys))
+
+	for key, value := range map[string]string{
+		"unknown":  `"value"`,
+		"tier":     `3`,
+		"priority": `3.5`,
+		"weight":   `"0.5"`,
+		"critical": `"true"`,
+		"since":    `"yesterday"`,
+		"owner":    `["platform"]`,
+		"broken":   `"value"`,
+	} {
+		err := validateDomainMetadata(map[string][]byte{key: []byte(value)}, validKeys)
+		assert.IsType(t, &types.BadRequestError{}, err, key)
+	}
+	assert.Error(t, validateDomainMetadata(map[string][]byte{"tier": []byte(`"gold`)}, validKeys))
+	assert.Error(t, validateDomainMetadata(map[string][]byte{"tier": []byte(`"gold" "silver"`)}, validKeys))
+}
+
+func TestValidateDomainDataKeys(t *testing.T) {
+	assert.NoError(t, validateDomainDataKeys(nil))
+	assert.NoError(t, validateDomainDataKeys(map[string]string{"tier": "gold"}))
+	err := validateDomainDataKeys(map[string]string{common.DomainDataKeyPrefixForMetadata + "tier": "gold"})
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
+
+func TestDomainMetadata_MergeAndSplit(t *testing.T) {
+	data := mergeDomainMetadata(nil, map[string][]byte{
+		"tier":  []byte(` "gold" `),
+		"owner": []byte(`{ "team": "platform" }`),
+	})
+	assert.Equal(t, map[string]string{
+		common.DomainDataKeyPrefixForMetadata + "tier":  `"gold"`,
+		common.DomainDataKeyPrefixForMetadata + "owner": `{"team":"platform"}`,
+	}, data)
+
+	data["k0"] = "v0"
+	data = mergeDomainMetadata(data, map[string][]byte{"tier": []byte("null")})
+	userData, metadata := splitDomainMetadata(data)
+	assert.Equal(t, map[string]string{"k0": "v0"}, userData)
+	assert.Equal(t, map[string][]byte{"owner": []byte(`{"team":"platform"}`)}, metadata)
+
+	userData, metadata = splitDomainMetadata(map[string]string{"k0": "v0"})
+	assert.Equal(t, map[string]string{"k0": "v0"}, userData)
+	assert.Nil(t, metadata)
+}
+
+func TestValidateDomainMetadataSize(t *testing.T) {
+	data := map[string]string{
+		"k0": "a long value that is not domain metadata",
+		common.DomainDataKeyPrefixForMetadata + "tier": `"gold"`,
+	}
+	assert.NoError(t, validateDomainMetadataSize(data, 10))
+	assert.IsType(t, &types.BadRequestError{}, validateDomainMetadataSize(data, 9))
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination provisioner_mock.go
+
+package domain
+
+import (
+	"context"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// Provisioner hands an asynchronously registered domain over to the
+	// domain provisioning system workflow
+	Provisioner interface {
+		StartDomainProvisioning(ctx context.Context, domainID string, domainName string) error
+	}
+
+	// ProvisioningStep is a cluster specific side effect (e.g. ES index templates,
+	// archival buckets, quotas) that must succeed before a PROVISIONING domain is
+	// marked REGISTERED. Provision must be idempotent as it is retried on failure.
+	ProvisioningStep interface {
+		Name() string
+		Provision(ctx context.Context, domain *persistence.GetDomainResponse) error
+	}
+)
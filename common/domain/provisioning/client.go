@@ -0,0 +1,91 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package provisioning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain"
+)
+
+type (
+	// Request is the input of the domain provisioning workflow
+	Request struct {
+		DomainID   string
+		DomainName string
+	}
+
+	clientImpl struct {
+		cadenceClient cclient.Client
+	}
+)
+
+const (
+	// TaskListName is the tasklist of the domain provisioning workflow
+	TaskListName = "cadence-sys-domain-provisioning-tasklist"
+	// WorkflowTypeName is the workflow type of the domain provisioning workflow
+	WorkflowTypeName = "cadence-sys-domain-provisioning-workflow"
+	// WorkflowTimeout is the execution timeout of the domain provisioning workflow
+	WorkflowTimeout = 24 * time.Hour
+
+	workflowIDPrefix = "cadence-sys-domain-provisioning"
+)
+
+var _ domain.Provisioner = (*clientImpl)(nil)
+
+// NewClient creates a domain.Provisioner which starts the domain provisioning workflow
+func NewClient(publicClient workflowserviceclient.Interface) domain.Provisioner {
+	return &clientImpl{
+		cadenceClient: cclient.NewClient(publicClient, common.SystemLocalDomainName, &cclient.Options{}),
+	}
+}
+
+func (c *clientImpl) StartDomainProvisioning(
+	ctx context.Context,
+	domainID string,
+	domainName string,
+) error {
+	workflowOptions := cclient.StartWorkflowOptions{
+		ID:                              fmt.Sprintf("%v-%v", workflowIDPrefix, domainName),
+		TaskList:                        TaskListName,
+		ExecutionStartToCloseTimeout:    WorkflowTimeout,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+		WorkflowIDReusePolicy:           cclient.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	_, err := c.cadenceClient.StartWorkflow(ctx, workflowOptions, WorkflowTypeName, Request{
+		DomainID:   domainID,
+		DomainName: domainName,
+	})
+	// an already started workflow means provisioning of this domain is in progress
+	switch err.(type) {
+	case *shared.WorkflowExecutionAlreadyStartedError, nil:
+		return nil
+	default:
+		return err
+	}
+}
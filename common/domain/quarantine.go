@@ -0,0 +1,42 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/uber/cadence/common"
+)
+
+// IsQuarantined returns whether the domain data puts the domain in quarantine.
+// A quarantined domain rejects new workflows and signals, while the workflows already running in it
+// keep making progress and can still be described, queried and terminated. Unlike deprecation it is
+// meant to be lifted again once the traffic of the domain stops hurting the cluster.
+// Continue as new, cron and retries are part of running workflows and are not affected.
+func IsQuarantined(data map[string]string) bool {
+	value, ok := data[common.DomainDataKeyForQuarantine]
+	if !ok {
+		return false
+	}
+	quarantined, err := strconv.ParseBool(strings.TrimSpace(value))
+	return err == nil && quarantined
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestIsQuarantined(t *testing.T) {
+	assert.False(t, IsQuarantined(nil))
+	assert.False(t, IsQuarantined(map[string]string{common.DomainDataKeyForQuarantine: "false"}))
+	assert.False(t, IsQuarantined(map[string]string{common.DomainDataKeyForQuarantine: "not-a-bool"}))
+	assert.True(t, IsQuarantined(map[string]string{common.DomainDataKeyForQuarantine: "true"}))
+	assert.True(t, IsQuarantined(map[string]string{common.DomainDataKeyForQuarantine: " TRUE "}))
+}
+
+func TestValidateDomainData_Quarantine(t *testing.T) {
+	validator := newAttrValidator(nil, 0)
+	assert.NoError(t, validator.validateDomainData(map[string]string{common.DomainDataKeyForQuarantine: "true"}))
+	assert.NoError(t, validator.validateDomainData(map[string]string{common.DomainDataKeyForQuarantine: "false"}))
+	err := validator.validateDomainData(map[string]string{common.DomainDataKeyForQuarantine: "yes please"})
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
@@ -250,6 +250,9 @@ func (h *domainReplicationTaskExecutorImpl) handleDomainUpdateReplicationTask(ct
 		if task.Config.GetBadBinaries() != nil {
 			request.Config.BadBinaries = *task.Config.GetBadBinaries()
 		}
+		if task.Config.GetAsyncWorkflowConfig() != nil {
+			request.Config.AsyncWorkflowConfig = *task.Config.GetAsyncWorkflowConfig()
+		}
 		request.ReplicationConfig.Clusters = h.convertClusterReplicationConfigFromThrift(task.ReplicationConfig.Clusters)
 		request.ConfigVersion = task.GetConfigVersion()
 	}
@@ -0,0 +1,125 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination client_mock.go
+
+package replicationbackfill
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// Client starts replication backfill workflows
+	Client interface {
+		// StartBackfill starts the backfill of the request and returns its job ID. Only one backfill
+		// runs per domain and target cluster, the ID of the running one is returned when it exists.
+		StartBackfill(ctx context.Context, request *Request) (string, error)
+	}
+
+	// Request is the input of the replication backfill workflow, it carries the progress across continue-as-new
+	Request struct {
+		DomainID      string
+		DomainName    string
+		SourceCluster string
+		TargetCluster string
+		// EarliestCloseTime and LatestTime (unix nanos) bound the executions to backfill, workflows started
+		// after LatestTime are replicated to the target cluster by the regular replication
+		EarliestCloseTime int64
+		LatestTime        int64
+		// RPS caps the number of replicated workflows per second, zero means no limit
+		RPS      int
+		Progress Progress
+	}
+
+	// Progress of a replication backfill, it is also the result of the workflow
+	Progress struct {
+		Phase string
+		// NextPageToken is the visibility page token of the current phase
+		NextPageToken []byte
+		Replicated    int64
+		Failed        int64
+	}
+
+	clientImpl struct {
+		cadenceClient cclient.Client
+	}
+)
+
+const (
+	// TaskListName is the tasklist of the replication backfill workflow
+	TaskListName = "cadence-sys-replication-backfill-tasklist"
+	// WorkflowTypeName is the workflow type of the replication backfill workflow
+	WorkflowTypeName = "cadence-sys-replication-backfill-workflow"
+	// WorkflowIDPrefix is the prefix of replication backfill workflow IDs, see GetWorkflowID
+	WorkflowIDPrefix = "cadence-sys-replication-backfill"
+	// WorkflowTimeout is the execution timeout of the replication backfill workflow
+	WorkflowTimeout = 30 * 24 * time.Hour
+	// QueryType returns the Request of a running backfill, including its progress
+	QueryType = "progress"
+
+	// PhaseOpen replicates the open workflows of the domain
+	PhaseOpen = "open workflows"
+	// PhaseClosed replicates the recently closed workflows of the domain
+	PhaseClosed = "closed workflows"
+	// PhaseCompleted means all workflows are processed
+	PhaseCompleted = "completed"
+)
+
+// NewClient creates a Client which starts replication backfill workflows through the given frontend
+func NewClient(publicClient workflowserviceclient.Interface) Client {
+	return &clientImpl{
+		cadenceClient: cclient.NewClient(publicClient, common.SystemLocalDomainName, &cclient.Options{}),
+	}
+}
+
+// GetWorkflowID returns the backfill workflow ID of a domain and target cluster
+func GetWorkflowID(domainName string, targetCluster string) string {
+	return fmt.Sprintf("%v-%v-%v", WorkflowIDPrefix, domainName, targetCluster)
+}
+
+func (c *clientImpl) StartBackfill(
+	ctx context.Context,
+	request *Request,
+) (string, error) {
+	workflowID := GetWorkflowID(request.DomainName, request.TargetCluster)
+	workflowOptions := cclient.StartWorkflowOptions{
+		ID:                              workflowID,
+		TaskList:                        TaskListName,
+		ExecutionStartToCloseTimeout:    WorkflowTimeout,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+		WorkflowIDReusePolicy:           cclient.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	_, err := c.cadenceClient.StartWorkflow(ctx, workflowOptions, WorkflowTypeName, request)
+	switch err.(type) {
+	case *shared.WorkflowExecutionAlreadyStartedError, nil:
+		return workflowID, nil
+	default:
+		return "", err
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+//go:generate mockgen -package $GOPACKAGE -source $GOFILE -destination client_mock.go
+
+package retentionreconciler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	cclient "go.uber.org/cadence/client"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// Client starts retention reconcile workflows
+	Client interface {
+		// StartReconcile starts the reconciliation of the request and returns its job ID. Only one
+		// reconciliation runs per domain, the ID of the running one is returned when it exists.
+		StartReconcile(ctx context.Context, request *Request) (string, error)
+	}
+
+	// Request is the input of the retention reconcile workflow, it carries the progress across continue-as-new
+	Request struct {
+		DomainID   string
+		DomainName string
+		// LatestTime (unix nanos) bounds the close time of the reconciled executions, workflows closed
+		// after it already scheduled their deletion with the new retention
+		LatestTime int64
+		// RPS caps the number of reconciled workflows per second, zero means no limit
+		RPS      int
+		Progress Progress
+	}
+
+	// Progress of a retention reconciliation, it is also the result of the workflow
+	Progress struct {
+		// NextPageToken is the visibility page token of the closed workflows
+		NextPageToken []byte
+		Reconciled    int64
+		Failed        int64
+		Completed     bool
+	}
+
+	clientImpl struct {
+		cadenceClient cclient.Client
+	}
+)
+
+const (
+	// TaskListName is the tasklist of the retention reconcile workflow
+	TaskListName = "cadence-sys-retention-reconcile-tasklist"
+	// WorkflowTypeName is the workflow type of the retention reconcile workflow
+	WorkflowTypeName = "cadence-sys-retention-reconcile-workflow"
+	// WorkflowIDPrefix is the prefix of retention reconcile workflow IDs, see GetWorkflowID
+	WorkflowIDPrefix = "cadence-sys-retention-reconcile"
+	// WorkflowTimeout is the execution timeout of the retention reconcile workflow
+	WorkflowTimeout = 30 * 24 * time.Hour
+	// QueryType returns the Request of a running reconciliation, including its progress
+	QueryType = "progress"
+)
+
+// NewClient creates a Client which starts retention reconcile workflows through the given frontend
+func NewClient(publicClient workflowserviceclient.Interface) Client {
+	return &clientImpl{
+		cadenceClient: cclient.NewClient(publicClient, common.SystemLocalDomainName, &cclient.Options{}),
+	}
+}
+
+// GetWorkflowID returns the retention reconcile workflow ID of a domain
+func GetWorkflowID(domainName string) string {
+	return fmt.Sprintf("%v-%v", WorkflowIDPrefix, domainName)
+}
+
+func (c *clientImpl) StartReconcile(
+	ctx context.Context,
+	request *Request,
+) (string, error) {
+	workflowID := GetWorkflowID(request.DomainName)
+	workflowOptions := cclient.StartWorkflowOptions{
+		ID:                              workflowID,
+		TaskList:                        TaskListName,
+		ExecutionStartToCloseTimeout:    WorkflowTimeout,
+		DecisionTaskStartToCloseTimeout: time.Minute,
+		WorkflowIDReusePolicy:           cclient.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	_, err := c.cadenceClient.StartWorkflow(ctx, workflowOptions, WorkflowTypeName, request)
+	switch err.(type) {
+	case *shared.WorkflowExecutionAlreadyStartedError, nil:
+		return workflowID, nil
+	default:
+		return "", err
+	}
+}
@@ -104,6 +104,7 @@ func (domainReplicator *domainReplicatorImpl) HandleTransmissionTask(
 			VisibilityArchivalStatus:               config.VisibilityArchivalStatus.Ptr(),
 			VisibilityArchivalURI:                  config.VisibilityArchivalURI,
 			BadBinaries:                            &config.BadBinaries,
+			AsyncWorkflowConfig:                    &config.AsyncWorkflowConfig,
 		},
 		ReplicationConfig: &types.DomainReplicationConfiguration{
 			ActiveClusterName: replicationConfig.ActiveClusterName,
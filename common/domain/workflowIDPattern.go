@@ -0,0 +1,70 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+// compiled workflow ID patterns, keyed by pattern. There are at most as many patterns as domains
+var workflowIDPatterns sync.Map
+
+// ValidateWorkflowID checks the workflow ID against the pattern configured in the domain data, if any.
+// The pattern is a regular expression anchored at the start of the workflow ID, so a plain
+// prefix like "payments-" or an alternation like "(payments|billing)-" reserves workflow ID prefixes.
+// It is checked whenever a workflow ID enters the domain: on start, signal with start and child workflow start.
+// Continue as new keeps the workflow ID of the run it continues and is exempt, so setting a pattern
+// does not fail the next run of workflows that are already running
+func ValidateWorkflowID(domainName string, data map[string]string, workflowID string) error {
+	pattern := data[common.DomainDataKeyForWorkflowIDPattern]
+	if pattern == "" {
+		return nil
+	}
+	re, err := compileWorkflowIDPattern(pattern)
+	if err != nil {
+		// patterns are validated when they are set, so this is a domain updated by other means
+		return nil
+	}
+	if !re.MatchString(workflowID) {
+		return &types.BadRequestError{Message: fmt.Sprintf(
+			"WorkflowID %q does not match the pattern %q required by domain %v, the workflow may be started in the wrong domain.",
+			workflowID, pattern, domainName,
+		)}
+	}
+	return nil
+}
+
+func compileWorkflowIDPattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := workflowIDPatterns.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile("^(?:" + pattern + ")")
+	if err != nil {
+		return nil, err
+	}
+	workflowIDPatterns.Store(pattern, re)
+	return re, nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestValidateWorkflowID(t *testing.T) {
+	assert.NoError(t, ValidateWorkflowID("domain", nil, "any-id"))
+	assert.NoError(t, ValidateWorkflowID("domain", map[string]string{common.DomainDataKeyForWorkflowIDPattern: ""}, "any-id"))
+
+	data := map[string]string{common.DomainDataKeyForWorkflowIDPattern: "(payments|billing)-"}
+	assert.NoError(t, ValidateWorkflowID("domain", data, "payments-123"))
+	assert.NoError(t, ValidateWorkflowID("domain", data, "billing-123"))
+	err := ValidateWorkflowID("domain", data, "orders-payments-123")
+	assert.IsType(t, &types.BadRequestError{}, err)
+
+	data[common.DomainDataKeyForWorkflowIDPattern] = `payments-\d+$`
+	assert.NoError(t, ValidateWorkflowID("domain", data, "payments-123"))
+	assert.Error(t, ValidateWorkflowID("domain", data, "payments-123-retry"))
+}
+
+func TestValidateDomainData_WorkflowIDPattern(t *testing.T) {
+	validator := newAttrValidator(nil, 0)
+	assert.NoError(t, validator.validateDomainData(map[string]string{common.DomainDataKeyForWorkflowIDPattern: "payments-"}))
+	err := validator.validateDomainData(map[string]string{common.DomainDataKeyForWorkflowIDPattern: "payments-("})
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
@@ -125,6 +125,9 @@ type FloatPropertyFn func(opts ...FilterOption) float64
 // FloatPropertyFnWithShardIDFilter is a wrapper to get float property from dynamic config with shardID as filter
 type FloatPropertyFnWithShardIDFilter func(shardID int) float64
 
+// FloatPropertyFnWithTaskListInfoFilters is a wrapper to get float property from dynamic config with three filters: domain, taskList, taskType
+type FloatPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) float64
+
 // DurationPropertyFn is a wrapper to get duration property from dynamic config
 type DurationPropertyFn func(opts ...FilterOption) time.Duration
 
@@ -152,6 +155,9 @@ type MapPropertyFn func(opts ...FilterOption) map[string]interface{}
 // StringPropertyFnWithDomainFilter is a wrapper to get string property from dynamic config
 type StringPropertyFnWithDomainFilter func(domain string) string
 
+// StringPropertyFnWithTaskListInfoFilters is a wrapper to get string property from dynamic config with three filters: domain, taskList, taskType
+type StringPropertyFnWithTaskListInfoFilters func(domain string, taskList string, taskType int) string
+
 // BoolPropertyFnWithDomainFilter is a wrapper to get bool property from dynamic config with domain as filter
 type BoolPropertyFnWithDomainFilter func(domain string) bool
 
@@ -328,6 +334,27 @@ func (c *Collection) GetFloat64PropertyFilteredByShardID(key Key, defaultValue f
 	}
 }
 
+// GetFloat64PropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's a float64
+func (c *Collection) GetFloat64PropertyFilteredByTaskListInfo(key Key, defaultValue float64) FloatPropertyFnWithTaskListInfoFilters {
+	return func(domain string, taskList string, taskType int) float64 {
+		filters := c.toFilterMap(
+			DomainFilter(domain),
+			TaskListFilter(taskList),
+			TaskTypeFilter(taskType),
+		)
+		val, err := c.client.GetFloatValue(
+			key,
+			filters,
+			defaultValue,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		c.logValue(key, filters, val, defaultValue, float64CompareEquals)
+		return val
+	}
+}
+
 // GetDurationProperty gets property and asserts that it's a duration
 func (c *Collection) GetDurationProperty(key Key, defaultValue time.Duration) DurationPropertyFn {
 	return func(opts ...FilterOption) time.Duration {
@@ -485,6 +512,27 @@ func (c *Collection) GetStringPropertyFilteredByDomain(key Key, defaultValue str
 	}
 }
 
+// GetStringPropertyFilteredByTaskListInfo gets property with taskListInfo as filters and asserts that it's a string
+func (c *Collection) GetStringPropertyFilteredByTaskListInfo(key Key, defaultValue string) StringPropertyFnWithTaskListInfoFilters {
+	return func(domain string, taskList string, taskType int) string {
+		filters := c.toFilterMap(
+			DomainFilter(domain),
+			TaskListFilter(taskList),
+			TaskTypeFilter(taskType),
+		)
+		val, err := c.client.GetStringValue(
+			key,
+			filters,
+			defaultValue,
+		)
+		if err != nil {
+			c.logError(key, filters, err)
+		}
+		c.logValue(key, filters, val, defaultValue, stringCompareEquals)
+		return val
+	}
+}
+
 // GetBoolPropertyFilteredByDomain gets property with domain filter and asserts that it's a bool
 func (c *Collection) GetBoolPropertyFilteredByDomain(key Key, defaultValue bool) BoolPropertyFnWithDomainFilter {
 	return func(domain string) bool {
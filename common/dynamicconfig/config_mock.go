@@ -59,6 +59,11 @@ func GetFloatPropertyFn(value float64) func(opts ...FilterOption) float64 {
 	return func(...FilterOption) float64 { return value }
 }
 
+// GetFloatPropertyFnFilteredByTaskListInfo returns value as FloatPropertyFnWithTaskListInfoFilters
+func GetFloatPropertyFnFilteredByTaskListInfo(value float64) func(domain string, taskList string, taskType int) float64 {
+	return func(domain string, taskList string, taskType int) float64 { return value }
+}
+
 // GetBoolPropertyFn returns value as BoolPropertyFn
 func GetBoolPropertyFn(value bool) func(opts ...FilterOption) bool {
 	return func(...FilterOption) bool { return value }
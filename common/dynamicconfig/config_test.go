@@ -81,6 +81,17 @@ func (s *configSuite) TestGetStringPropertyFnWithDomainFilter() {
 	s.Equal("efg", value(domain))
 }
 
+func (s *configSuite) TestGetStringPropertyFilteredByTaskListInfo() {
+	key := TestGetStringPropertyKey
+	domain := "testDomain"
+	taskList := "testTaskList"
+	taskType := 0
+	value := s.cln.GetStringPropertyFilteredByTaskListInfo(key, "abc")
+	s.Equal("abc", value(domain, taskList, taskType))
+	s.client.SetValue(key, "efg")
+	s.Equal("efg", value(domain, taskList, taskType))
+}
+
 func (s *configSuite) TestGetIntPropertyFilteredByTaskListInfo() {
 	key := TestGetIntPropertyFilteredByTaskListInfoKey
 	domain := "testDomain"
@@ -100,6 +111,17 @@ func (s *configSuite) TestGetFloat64Property() {
 	s.Equal(0.01, value())
 }
 
+func (s *configSuite) TestGetFloat64PropertyFilteredByTaskListInfo() {
+	key := TestGetFloat64PropertyFilteredByTaskListInfoKey
+	domain := "testDomain"
+	taskList := "testTaskList"
+	taskType := 0
+	value := s.cln.GetFloat64PropertyFilteredByTaskListInfo(key, 0.1)
+	s.Equal(0.1, value(domain, taskList, taskType))
+	s.client.SetValue(key, 0.01)
+	s.Equal(0.01, value(domain, taskList, taskType))
+}
+
 func (s *configSuite) TestGetBoolProperty() {
 	key := TestGetBoolPropertyKey
 	value := s.cln.GetBoolProperty(key, true)
@@ -64,6 +64,7 @@ const (
 	TestGetDurationPropertyFilteredByTaskListInfoKey
 	TestGetBoolPropertyFilteredByDomainIDKey
 	TestGetBoolPropertyFilteredByTaskListInfoKey
+	TestGetFloat64PropertyFilteredByTaskListInfoKey
 
 	// key for common & admin
 
@@ -243,6 +244,42 @@ const (
 	// Default value: 51200 (50*1024)
 	// Allowed filters: DomainName
 	HistoryCountLimitWarn
+	// HistoryLimitAtRiskWindow is the look-ahead window for flagging a workflow execution whose history
+	// is growing fast enough to reach the history size or count error limit
+	// KeyName: limit.history.atRiskWindow
+	// Value type: Duration
+	// Default value: 24h (24*time.Hour)
+	// Allowed filters: DomainName
+	HistoryLimitAtRiskWindow
+	// TaskListPayloadSizeLimitError is the per tasklist limit on the payload of a task scheduled onto it, i.e. the input of
+	// an activity, a child workflow or a continue as new run. Scheduling a bigger task fails the decision with cause
+	// BAD_TASK_LIST_PAYLOAD_SIZE. It is an override on top of limit.blobSize.error for tasklists whose
+	// oversized tasks should not reach matching, 0 means no override
+	// KeyName: limit.taskListPayloadSize.error
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName,TaskListName,TaskType
+	TaskListPayloadSizeLimitError
+	// TaskListPayloadSizeLimitWarn is the per tasklist task payload size above which the task is reported as near the limit,
+	// 0 means no warning
+	// KeyName: limit.taskListPayloadSize.warn
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName,TaskListName,TaskType
+	TaskListPayloadSizeLimitWarn
+	// PayloadSizeNearLimitPercentage is the percentage of limit.blobSize.error above which a decision or activity
+	// payload counts as near the limit in the per domain payload size stats of history hosts
+	// KeyName: limit.payloadSize.nearLimitPercentage
+	// Value type: Int
+	// Default value: 50
+	// Allowed filters: DomainName
+	PayloadSizeNearLimitPercentage
+	// PayloadSizeStatsWindow is the window of the per domain payload size stats of history hosts
+	// KeyName: limit.payloadSize.statsWindow
+	// Value type: Duration
+	// Default value: 1h (1*time.Hour)
+	// Allowed filters: N/A
+	PayloadSizeStatsWindow
 	// DomainNameMaxLength is the length limit for domain name
 	// KeyName: limit.domainNameLength
 	// Value type: Int
@@ -366,6 +403,12 @@ const (
 	// Default value: 1000 (see common.GetHistoryMaxPageSize)
 	// Allowed filters: DomainName
 	FrontendHistoryMaxPageSize
+	// FrontendSignalBatchMaxSize is the max number of signals allowed in one SignalBatchWorkflowExecution request
+	// KeyName: frontend.signalBatchMaxSize
+	// Value type: Int
+	// Default value: 100
+	// Allowed filters: DomainName
+	FrontendSignalBatchMaxSize
 	// FrontendUserRPS is workflow rate limit per second
 	// KeyName: frontend.rps
 	// Value type: Int
@@ -444,6 +487,39 @@ const (
 	// Default value: 1m (one minute, see domain.FailoverCoolDown)
 	// Allowed filters: DomainName
 	FrontendFailoverCoolDown
+	// FrontendEnableAsyncDomainRegistration is whether local domains are registered in PROVISIONING status
+	// and only become REGISTERED once the domain provisioning system workflow completes
+	// KeyName: frontend.enableAsyncDomainRegistration
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	FrontendEnableAsyncDomainRegistration
+	// FrontendDomainFailoverWebhookURLs is a comma separated list of webhook URLs that are called
+	// when the active cluster of a domain is changed through this cluster
+	// KeyName: frontend.domainFailoverWebhookURLs
+	// Value type: String
+	// Default value: "" (no webhook)
+	// Allowed filters: DomainName
+	FrontendDomainFailoverWebhookURLs
+	// FrontendDomainFailoverWebhookTimeout is the timeout of a single domain failover webhook call
+	// KeyName: frontend.domainFailoverWebhookTimeout
+	// Value type: Duration
+	// Default value: 5s (see domain.DefaultFailoverWebhookTimeout)
+	// Allowed filters: N/A
+	FrontendDomainFailoverWebhookTimeout
+	// FrontendValidDomainMetadataKeys is the registry of the metadata keys that can be set on domains, mapped to
+	// their type: String, Int, Double, Bool, Datetime (RFC3339) or Object (a JSON object)
+	// KeyName: frontend.validDomainMetadataKeys
+	// Value type: Map
+	// Default value: nil (no metadata key can be set)
+	// Allowed filters: N/A
+	FrontendValidDomainMetadataKeys
+	// FrontendDomainMetadataSizeLimit is the maximal total size in bytes of the metadata of a domain
+	// KeyName: frontend.domainMetadataSizeLimit
+	// Value type: Int
+	// Default value: 8192 (see domain.DefaultMetadataSizeLimit)
+	// Allowed filters: N/A
+	FrontendDomainMetadataSizeLimit
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs. When overriding, ensure to include the existing default attributes of the current release
 	// KeyName: frontend.validSearchAttributes
 	// Value type: Map
@@ -573,6 +649,14 @@ const (
 	// Default value: 1m (1*time.Minute)
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingUpdateAckInterval
+	// MatchingMaxIdleTaskReadInterval is the max interval between periodic task reads from persistence of a
+	// tasklist without backlog and without task adds. The interval starts at updateAckInterval, doubles on every
+	// idle read up to this value and is reset by the next task add. Values not above updateAckInterval disable backoff
+	// KeyName: matching.maxIdleTaskReadInterval
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingMaxIdleTaskReadInterval
 	// MatchingIdleTasklistCheckInterval is the IdleTasklistCheckInterval
 	// KeyName: matching.idleTasklistCheckInterval
 	// Value type: Duration
@@ -591,12 +675,28 @@ const (
 	// Default value: 250
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingOutstandingTaskAppendsThreshold
+	// MatchingOverloadShedPolicy is what a tasklist does with a task add once outstanding task appends reach
+	// matching.outstandingTaskAppendsThreshold. "reject-newest" fails the add with a service busy error,
+	// "reject-oldest-buffered" fails the oldest add waiting to be written instead so that fresh tasks get in,
+	// "block-until-deadline" never sheds: the add waits for room in the writer until its deadline and fails with it
+	// KeyName: matching.overloadShedPolicy
+	// Value type: String
+	// Default value: reject-newest
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingOverloadShedPolicy
 	// MatchingMaxTaskBatchSize is max batch size for task writer
 	// KeyName: matching.maxTaskBatchSize
 	// Value type: Int
 	// Default value: 100
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingMaxTaskBatchSize
+	// MatchingTaskBatchFlushInterval is the max time task writer holds a batch open to group concurrent appends,
+	// it only applies when the previous batch had more than one task, so idle tasklists are never delayed
+	// KeyName: matching.taskBatchFlushInterval
+	// Value type: Duration
+	// Default value: 5ms (5*time.Millisecond)
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskBatchFlushInterval
 	// MatchingMaxTaskDeleteBatchSize is the max batch size for range deletion of tasks
 	// KeyName: matching.maxTaskDeleteBatchSize
 	// Value type: Int
@@ -645,12 +745,40 @@ const (
 	// Default value: 20
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingForwarderPartitionHintInterval is the interval at which a task list partition refreshes the number of
+	// pollers waiting on its ancestor partitions, so that forwarding can skip idle parents, 0 disables the hints
+	// KeyName: matching.forwarderPartitionHintInterval
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingForwarderPartitionHintInterval
+	// MatchingPollerZonePattern is a regular expression matched against poller identities to infer the isolation zone
+	// of the pollers, the first capture group, or the whole match without groups, is the zone. Empty disables it
+	// KeyName: matching.pollerZonePattern
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPollerZonePattern
+	// MatchingDisableCrossZoneForwarding prevents forwarding tasks to an ancestor partition whose pollers are all in
+	// other isolation zones than the pollers of the partition, as inferred with MatchingPollerZonePattern
+	// KeyName: matching.disableCrossZoneForwarding
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingDisableCrossZoneForwarding
 	// MatchingShutdownDrainDuration is the duration of traffic drain during shutdown
 	// KeyName: matching.shutdownDrainDuration
 	// Value type: Duration
 	// Default value: 0
 	// Allowed filters: N/A
 	MatchingShutdownDrainDuration
+	// MatchingHealthMaxPersistenceErrorRate is the persistence error rate of the last minute above which the
+	// matching host reports itself as not ok in its health check, 0 disables the check
+	// KeyName: matching.healthMaxPersistenceErrorRate
+	// Value type: Float64
+	// Default value: 0.5
+	// Allowed filters: N/A
+	MatchingHealthMaxPersistenceErrorRate
 	// MatchingErrorInjectionRate is rate for injecting random error in matching client
 	// KeyName: matching.errorInjectionRate
 	// Value type: Float64
@@ -663,6 +791,114 @@ const (
 	// Default value: false
 	// Allowed filters: DomainID
 	MatchingEnableTaskInfoLogByDomainID
+	// MatchingDomainDispatchWeight is the weight of a domain when sharing the dispatch rate of a tasklist with the
+	// tasklists of the same name in other domains, which are assumed to be polled by the same workers. The rate is
+	// only shared with the domains whose tasklist has a backlog or was recently added tasks. 0 means the domain
+	// does not participate in weighted fair dispatch, negative weights are ignored the same way
+	// KeyName: matching.domainDispatchWeight
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	MatchingDomainDispatchWeight
+	// MatchingMaxTaskListsPerDomain is the max number of distinct tasklists of a domain a matching host loads,
+	// loading more fails adding tasks to and polling the new tasklists. Sticky tasklists are not counted.
+	// Tasklists are spread across matching hosts, so this caps the tasklists of the domain to about this
+	// limit times the number of matching hosts. 0 means no limit
+	// KeyName: matching.maxTaskListsPerDomain
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	MatchingMaxTaskListsPerDomain
+	// MatchingEnableEphemeralTaskList makes a tasklist only sync match tasks and never persist them,
+	// adding a task fails when no poller picks it up within MatchingEphemeralSyncMatchTimeout
+	// KeyName: matching.enableEphemeralTaskList
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableEphemeralTaskList
+	// MatchingEphemeralSyncMatchTimeout is the max time a task added to an ephemeral tasklist waits for a poller
+	// KeyName: matching.ephemeralSyncMatchTimeout
+	// Value type: Duration
+	// Default value: 200ms
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEphemeralSyncMatchTimeout
+	// MatchingTaskAffinityTTL is how long tasks added with an affinity key keep being routed to the poller identity that
+	// handled the previous task with that key, as long as that identity keeps polling. Zero disables affinity routing
+	// KeyName: matching.taskAffinityTTL
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskAffinityTTL
+	// MatchingTaskDedupeWindow is how long an added task is remembered to drop duplicate adds of the same task attempt,
+	// e.g. when history retries an add whose response was lost. Activity retries share the schedule ID but carry a new
+	// attempt, so only enable it once all history hosts send the attempt. Zero disables dedupe
+	// KeyName: matching.taskDedupeWindow
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskDedupeWindow
+	// MatchingTaskDedupeMaxSize is the max number of recently added tasks remembered per tasklist for dedupe
+	// KeyName: matching.taskDedupeMaxSize
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingTaskDedupeMaxSize
+	// MatchingEnableDispatchTracing records a span per task from add to dispatch with the configured tracer
+	// KeyName: matching.enableDispatchTracing
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainID
+	MatchingEnableDispatchTracing
+	// MatchingEnableDeadlineOrderedDispatch dispatches the tasks loaded from persistence by earliest schedule to start
+	// deadline instead of in the order they were added, so tasks with a short deadline are not starved by a backlog
+	// KeyName: matching.enableDeadlineOrderedDispatch
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableDeadlineOrderedDispatch
+	// MatchingEnablePartitionDrain moves the backlog of task list partitions left over after the number of partitions
+	// is reduced to the active partitions, so the partition count can be scaled down without stranding tasks
+	// KeyName: matching.enablePartitionDrain
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnablePartitionDrain
+	// MatchingPartitionDrainCheckInterval is the interval at which the root partition of a task list looks for
+	// leftover partitions with backlog to drain
+	// KeyName: matching.partitionDrainCheckInterval
+	// Value type: Duration
+	// Default value: 5m (5*time.Minute)
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingPartitionDrainCheckInterval
+	// MatchingShadowTaskList is the name of the task list that tasks added to this task list are mirrored to, so a new
+	// worker fleet polling the shadow task list can be validated before traffic is cut over. Empty disables mirroring
+	// KeyName: matching.shadowTaskList
+	// Value type: String
+	// Default value: "" (empty string)
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingShadowTaskList
+	// MatchingShadowTaskListSampleRatio is the ratio of the tasks added to this task list that are mirrored to
+	// matching.shadowTaskList, between 0 and 1
+	// KeyName: matching.shadowTaskListSampleRatio
+	// Value type: Float64
+	// Default value: 0
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingShadowTaskListSampleRatio
+	// MatchingShadowTaskListMode is what the mirrored copy of a task carries. "metadata" hands pollers of the shadow
+	// task list the workflow execution and schedule ID only, so the task is never started from there. "full" hands
+	// them a startable task, and whichever task list starts the task first processes it
+	// KeyName: matching.shadowTaskListMode
+	// Value type: String
+	// Default value: metadata
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingShadowTaskListMode
+	// MatchingEnableReadOnlyDescribeTaskList answers DescribeTaskList for a task list that is not loaded from its
+	// persisted metadata and the stats cached when it was last unloaded, instead of leasing and starting the task list
+	// KeyName: matching.enableReadOnlyDescribeTaskList
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingEnableReadOnlyDescribeTaskList
 
 	// key for history
 
@@ -672,6 +908,30 @@ const (
 	// Default value: 3000
 	// Allowed filters: N/A
 	HistoryRPS
+	// HistoryWorkflowIDSignalRPS is the max rate of signals per second a single workflow ID can receive, 0 means no limit
+	// KeyName: history.workflowIDSignalRPS
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	HistoryWorkflowIDSignalRPS
+	// HistoryWorkflowIDQueryRPS is the max rate of queries per second a single workflow ID can receive, 0 means no limit
+	// KeyName: history.workflowIDQueryRPS
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	HistoryWorkflowIDQueryRPS
+	// HistoryWorkflowIDChildStartRPS is the max rate of child workflow starts per second a single parent workflow ID can issue, 0 means no limit
+	// KeyName: history.workflowIDChildStartRPS
+	// Value type: Int
+	// Default value: 0
+	// Allowed filters: DomainName
+	HistoryWorkflowIDChildStartRPS
+	// HistoryWorkflowIDRateLimiterCacheSize is the max number of workflow ID rate limiters kept by each history host for signals and queries, and by each shard for child workflow starts
+	// KeyName: history.workflowIDRateLimiterCacheSize
+	// Value type: Int
+	// Default value: 10000
+	// Allowed filters: N/A
+	HistoryWorkflowIDRateLimiterCacheSize
 	// HistoryPersistenceMaxQPS is the max qps history host can query DB
 	// KeyName: history.persistenceMaxQPS
 	// Value type: Int
@@ -1390,6 +1650,12 @@ const (
 	// Default value: 40s (40 * time.Second)
 	// Allowed filters: N/A
 	ReplicatorUpperLatency
+	// ReplicatorQueueStatsRetention is how long the per shard replication queue depth samples are kept in memory
+	// KeyName: history.replicatorQueueStatsRetention
+	// Value type: Duration
+	// Default value: 30m (30 * time.Minute)
+	// Allowed filters: N/A
+	ReplicatorQueueStatsRetention
 
 	// ExecutionMgrNumConns is persistence connections number for ExecutionManager
 	// KeyName: history.executionMgrNumConns
@@ -1761,6 +2027,27 @@ const (
 	// Default value: true
 	// Allowed filters: DomainID, WorkflowID
 	EnableReplicationTaskGeneration
+	// ReplicationTaskCompression is the compression of the history events of replication tasks sent to clusters
+	// supporting it, one of snappy and zstd, empty to send them uncompressed
+	// KeyName: history.replicationTaskCompression
+	// Value type: String
+	// Default value: ""
+	// Allowed filters: N/A
+	ReplicationTaskCompression
+	// ReplicationDLQMessageMaxAge is how long the persistence store keeps replication DLQ messages before they
+	// expire, it should match the TTL of the store. Zero disables the expiry warnings
+	// KeyName: history.replicationDLQMessageMaxAge
+	// Value type: Duration
+	// Default value: 0
+	// Allowed filters: N/A
+	ReplicationDLQMessageMaxAge
+	// ReplicationDLQMessageExpiryWarning is how long before reaching ReplicationDLQMessageMaxAge a replication DLQ
+	// message is reported as about to expire
+	// KeyName: history.replicationDLQMessageExpiryWarning
+	// Value type: Duration
+	// Default value: 72h (72*time.Hour)
+	// Allowed filters: N/A
+	ReplicationDLQMessageExpiryWarning
 
 	// key for worker
 
@@ -1981,6 +2268,12 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableParentClosePolicyWorker
+	// EnableDomainProvisioningWorker decides whether or not enable system workers for provisioning asynchronously registered domains
+	// KeyName: system.enableDomainProvisioningWorker
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableDomainProvisioningWorker
 	// EnableESAnalyzer decides whether to enable system workers for processing ElasticSearch Analyzer
 	// KeyName: system.enableESAnalyzer
 	// Value type: Bool
@@ -2005,6 +2298,24 @@ const (
 	// Default value: true
 	// Allowed filters: N/A
 	EnableFailoverManager
+	// EnableDLQMerger indicates if the worker for scheduled DLQ merges is enabled
+	// KeyName: system.enableDLQMerger
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableDLQMerger
+	// EnableReplicationBackfill indicates if the worker for replication backfills of newly added clusters is enabled
+	// KeyName: system.enableReplicationBackfill
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableReplicationBackfill
+	// EnableRetentionReconciler indicates if the worker for retention reconciliations of domains is enabled
+	// KeyName: system.enableRetentionReconciler
+	// Value type: Bool
+	// Default value: true
+	// Allowed filters: N/A
+	EnableRetentionReconciler
 	// EnableWorkflowShadower indicates if workflow shadower is enabled
 	// KeyName: system.enableWorkflowShadower
 	// Value type: Bool
@@ -2210,6 +2521,7 @@ var Keys = map[Key]string{
 	TestGetDurationPropertyFilteredByTaskListInfoKey: "testGetDurationPropertyFilteredByTaskListInfoKey",
 	TestGetBoolPropertyFilteredByDomainIDKey:         "testGetBoolPropertyFilteredByDomainIDKey",
 	TestGetBoolPropertyFilteredByTaskListInfoKey:     "testGetBoolPropertyFilteredByTaskListInfoKey",
+	TestGetFloat64PropertyFilteredByTaskListInfoKey:  "testGetFloat64PropertyFilteredByTaskListInfoKey",
 
 	// system settings
 	EnableGlobalDomain:                  "system.enableGlobalDomain",
@@ -2231,8 +2543,12 @@ var Keys = map[Key]string{
 	DisallowQuery:                       "system.disallowQuery",
 	EnableBatcher:                       "worker.enableBatcher",
 	EnableParentClosePolicyWorker:       "system.enableParentClosePolicyWorker",
+	EnableDomainProvisioningWorker:      "system.enableDomainProvisioningWorker",
 	EnableESAnalyzer:                    "system.enableESAnalyzer",
 	EnableFailoverManager:               "system.enableFailoverManager",
+	EnableDLQMerger:                     "system.enableDLQMerger",
+	EnableReplicationBackfill:           "system.enableReplicationBackfill",
+	EnableRetentionReconciler:           "system.enableRetentionReconciler",
 	EnableWorkflowShadower:              "system.enableWorkflowShadower",
 	EnableStickyQuery:                   "system.enableStickyQuery",
 	EnableDebugMode:                     "system.enableDebugMode",
@@ -2242,12 +2558,19 @@ var Keys = map[Key]string{
 	EnableWatchDog:                      "system.EnableWatchDog",
 
 	// size limit
-	BlobSizeLimitError:     "limit.blobSize.error",
-	BlobSizeLimitWarn:      "limit.blobSize.warn",
-	HistorySizeLimitError:  "limit.historySize.error",
-	HistorySizeLimitWarn:   "limit.historySize.warn",
-	HistoryCountLimitError: "limit.historyCount.error",
-	HistoryCountLimitWarn:  "limit.historyCount.warn",
+	BlobSizeLimitError:       "limit.blobSize.error",
+	BlobSizeLimitWarn:        "limit.blobSize.warn",
+	HistorySizeLimitError:    "limit.historySize.error",
+	HistorySizeLimitWarn:     "limit.historySize.warn",
+	HistoryCountLimitError:   "limit.historyCount.error",
+	HistoryCountLimitWarn:    "limit.historyCount.warn",
+	HistoryLimitAtRiskWindow: "limit.history.atRiskWindow",
+
+	TaskListPayloadSizeLimitError: "limit.taskListPayloadSize.error",
+	TaskListPayloadSizeLimitWarn:  "limit.taskListPayloadSize.warn",
+
+	PayloadSizeNearLimitPercentage: "limit.payloadSize.nearLimitPercentage",
+	PayloadSizeStatsWindow:         "limit.payloadSize.statsWindow",
 
 	// id length limits
 	MaxIDLengthWarnLimit:  "limit.maxIDWarnLength",
@@ -2274,8 +2597,14 @@ var Keys = map[Key]string{
 	FrontendESVisibilityListMaxQPS:              "frontend.esVisibilityListMaxQPS",
 	FrontendMaxBadBinaries:                      "frontend.maxBadBinaries",
 	FrontendFailoverCoolDown:                    "frontend.failoverCoolDown",
+	FrontendEnableAsyncDomainRegistration:       "frontend.enableAsyncDomainRegistration",
+	FrontendDomainFailoverWebhookURLs:           "frontend.domainFailoverWebhookURLs",
+	FrontendDomainFailoverWebhookTimeout:        "frontend.domainFailoverWebhookTimeout",
+	FrontendValidDomainMetadataKeys:             "frontend.validDomainMetadataKeys",
+	FrontendDomainMetadataSizeLimit:             "frontend.domainMetadataSizeLimit",
 	FrontendESIndexMaxResultWindow:              "frontend.esIndexMaxResultWindow",
 	FrontendHistoryMaxPageSize:                  "frontend.historyMaxPageSize",
+	FrontendSignalBatchMaxSize:                  "frontend.signalBatchMaxSize",
 	FrontendUserRPS:                             "frontend.rps",
 	FrontendWorkerRPS:                           "frontend.workerrps",
 	FrontendMaxDomainUserRPSPerInstance:         "frontend.domainrps",
@@ -2310,10 +2639,13 @@ var Keys = map[Key]string{
 	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
 	MatchingEnableSyncMatch:                 "matching.enableSyncMatch",
 	MatchingUpdateAckInterval:               "matching.updateAckInterval",
+	MatchingMaxIdleTaskReadInterval:         "matching.maxIdleTaskReadInterval",
 	MatchingIdleTasklistCheckInterval:       "matching.idleTasklistCheckInterval",
 	MaxTasklistIdleTime:                     "matching.maxTasklistIdleTime",
 	MatchingOutstandingTaskAppendsThreshold: "matching.outstandingTaskAppendsThreshold",
+	MatchingOverloadShedPolicy:              "matching.overloadShedPolicy",
 	MatchingMaxTaskBatchSize:                "matching.maxTaskBatchSize",
+	MatchingTaskBatchFlushInterval:          "matching.taskBatchFlushInterval",
 	MatchingMaxTaskDeleteBatchSize:          "matching.maxTaskDeleteBatchSize",
 	MatchingThrottledLogRPS:                 "matching.throttledLogRPS",
 	MatchingNumTasklistWritePartitions:      "matching.numTasklistWritePartitions",
@@ -2322,12 +2654,35 @@ var Keys = map[Key]string{
 	MatchingForwarderMaxOutstandingTasks:    "matching.forwarderMaxOutstandingTasks",
 	MatchingForwarderMaxRatePerSecond:       "matching.forwarderMaxRatePerSecond",
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
+	MatchingForwarderPartitionHintInterval:  "matching.forwarderPartitionHintInterval",
+	MatchingPollerZonePattern:               "matching.pollerZonePattern",
+	MatchingDisableCrossZoneForwarding:      "matching.disableCrossZoneForwarding",
 	MatchingShutdownDrainDuration:           "matching.shutdownDrainDuration",
+	MatchingHealthMaxPersistenceErrorRate:   "matching.healthMaxPersistenceErrorRate",
 	MatchingErrorInjectionRate:              "matching.errorInjectionRate",
 	MatchingEnableTaskInfoLogByDomainID:     "matching.enableTaskInfoLogByDomainID",
+	MatchingDomainDispatchWeight:            "matching.domainDispatchWeight",
+	MatchingMaxTaskListsPerDomain:           "matching.maxTaskListsPerDomain",
+	MatchingEnableEphemeralTaskList:         "matching.enableEphemeralTaskList",
+	MatchingEphemeralSyncMatchTimeout:       "matching.ephemeralSyncMatchTimeout",
+	MatchingTaskAffinityTTL:                 "matching.taskAffinityTTL",
+	MatchingTaskDedupeWindow:                "matching.taskDedupeWindow",
+	MatchingTaskDedupeMaxSize:               "matching.taskDedupeMaxSize",
+	MatchingEnableDispatchTracing:           "matching.enableDispatchTracing",
+	MatchingEnableDeadlineOrderedDispatch:   "matching.enableDeadlineOrderedDispatch",
+	MatchingEnablePartitionDrain:            "matching.enablePartitionDrain",
+	MatchingPartitionDrainCheckInterval:     "matching.partitionDrainCheckInterval",
+	MatchingShadowTaskList:                  "matching.shadowTaskList",
+	MatchingShadowTaskListSampleRatio:       "matching.shadowTaskListSampleRatio",
+	MatchingShadowTaskListMode:              "matching.shadowTaskListMode",
+	MatchingEnableReadOnlyDescribeTaskList:  "matching.enableReadOnlyDescribeTaskList",
 
 	// history settings
 	HistoryRPS:                                         "history.rps",
+	HistoryWorkflowIDSignalRPS:                         "history.workflowIDSignalRPS",
+	HistoryWorkflowIDQueryRPS:                          "history.workflowIDQueryRPS",
+	HistoryWorkflowIDChildStartRPS:                     "history.workflowIDChildStartRPS",
+	HistoryWorkflowIDRateLimiterCacheSize:              "history.workflowIDRateLimiterCacheSize",
 	HistoryPersistenceMaxQPS:                           "history.persistenceMaxQPS",
 	HistoryPersistenceGlobalMaxQPS:                     "history.persistenceGlobalMaxQPS",
 	HistoryVisibilityOpenMaxQPS:                        "history.historyVisibilityOpenMaxQPS",
@@ -2451,6 +2806,7 @@ var Keys = map[Key]string{
 	ReplicatorProcessorMaxRedispatchQueueSize:             "history.replicatorProcessorMaxRedispatchQueueSize",
 	ReplicatorProcessorEnablePriorityTaskProcessor:        "history.replicatorProcessorEnablePriorityTaskProcessor",
 	ReplicatorUpperLatency:                                "history.replicatorUpperLatency",
+	ReplicatorQueueStatsRetention:                         "history.replicatorQueueStatsRetention",
 
 	ExecutionMgrNumConns:                               "history.executionMgrNumConns",
 	HistoryMgrNumConns:                                 "history.historyMgrNumConns",
@@ -2498,6 +2854,9 @@ var Keys = map[Key]string{
 	ReplicationTaskProcessorShardQPS:                   "history.ReplicationTaskProcessorShardQPS",
 	EnableReplicationTaskGeneration:                    "history.enableReplicationTaskGeneration",
 	ReplicationTaskGenerationQPS:                       "history.ReplicationTaskGenerationQPS",
+	ReplicationTaskCompression:                         "history.replicationTaskCompression",
+	ReplicationDLQMessageMaxAge:                        "history.replicationDLQMessageMaxAge",
+	ReplicationDLQMessageExpiryWarning:                 "history.replicationDLQMessageExpiryWarning",
 	EnableConsistentQuery:                              "history.EnableConsistentQuery",
 	EnableConsistentQueryByDomain:                      "history.EnableConsistentQueryByDomain",
 	EnableCrossClusterOperations:                       "history.enableCrossClusterOperations",
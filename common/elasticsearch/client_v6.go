@@ -171,6 +171,11 @@ func (c *elasticV6) CreateIndex(ctx context.Context, index string) error {
 	return err
 }
 
+func (c *elasticV6) PutDocument(ctx context.Context, index, id string, body interface{}) error {
+	_, err := c.client.Index().Index(index).Type(esDocType).Id(id).BodyJson(body).Do(ctx)
+	return err
+}
+
 func (c *elasticV6) CountByQuery(ctx context.Context, index, query string) (int64, error) {
 	return c.client.Count(index).BodyString(query).Do(ctx)
 }
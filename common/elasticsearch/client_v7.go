@@ -170,6 +170,11 @@ func (c *elasticV7) CreateIndex(ctx context.Context, index string) error {
 	return err
 }
 
+func (c *elasticV7) PutDocument(ctx context.Context, index, id string, body interface{}) error {
+	_, err := c.client.Index().Index(index).Id(id).BodyJson(body).Do(ctx)
+	return err
+}
+
 func (c *elasticV7) CountByQuery(ctx context.Context, index, query string) (int64, error) {
 	return c.client.Count(index).BodyString(query).Do(ctx)
 }
@@ -76,6 +76,8 @@ type (
 		PutMapping(ctx context.Context, index, root, key, valueType string) error
 		// CreateIndex creates a new index
 		CreateIndex(ctx context.Context, index string) error
+		// PutDocument indexes a single document with the given ID, overwriting any existing one
+		PutDocument(ctx context.Context, index, id string, body interface{}) error
 
 		IsNotFoundError(err error) bool
 	}
@@ -72,6 +72,20 @@ func (_m *GenericClient) CreateIndex(ctx context.Context, index string) error {
 	return r0
 }
 
+// PutDocument provides a mock function with given fields: ctx, index, id, body
+func (_m *GenericClient) PutDocument(ctx context.Context, index string, id string, body interface{}) error {
+	ret := _m.Called(ctx, index, id, body)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, interface{}) error); ok {
+		r0 = rf(ctx, index, id, body)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // IsNotFoundError provides a mock function with given fields: err
 func (_m *GenericClient) IsNotFoundError(err error) bool {
 	ret := _m.Called(err)
@@ -153,6 +153,11 @@ func BlobSizeViolationOperation(operation string) Tag {
 	return newStringTag("blob-size-violation-operation", operation)
 }
 
+// PayloadType returns tag for PayloadType
+func PayloadType(payloadType string) Tag {
+	return newStringTag("payload-type", payloadType)
+}
+
 // domain related
 
 // WorkflowDomainID returns tag for WorkflowDomainID
@@ -131,6 +131,10 @@ var (
 	ComponentCrossClusterTaskFetcher    = component("cross-cluster-task-fetcher")
 	ComponentShardScanner               = component("shardscanner-scanner")
 	ComponentShardFixer                 = component("shardscanner-fixer")
+	ComponentDomainProvisioner          = component("domain-provisioner")
+	ComponentDLQMerger                  = component("dlq-merger")
+	ComponentReplicationBackfill        = component("replication-backfill")
+	ComponentRetentionReconciler        = component("retention-reconciler")
 )
 
 // Pre-defined values for TagSysLifecycle
@@ -221,6 +225,9 @@ var (
 	StoreOperationListTaskList          = storeOperation("list-task-list")
 	StoreOperationDeleteTaskList        = storeOperation("delete-task-list")
 	StoreOperationStopTaskList          = storeOperation("stop-task-list")
+	StoreOperationGetTaskListUsage      = storeOperation("get-task-list-usage")
+	StoreOperationGetTaskList           = storeOperation("get-task-list")
+	StoreOperationUpdateTaskListTags    = storeOperation("update-task-list-tags")
 
 	StoreOperationCreateDomain       = storeOperation("create-domain")
 	StoreOperationGetDomain          = storeOperation("get-domain")
@@ -281,6 +288,7 @@ var (
 	AdminClientOperationCloseShard                        = clientOperation("admin-close-shard")
 	AdminClientOperationResetQueue                        = clientOperation("admin-reset-queue")
 	AdminClientOperationDescribeQueue                     = clientOperation("admin-describe-queue")
+	AdminClientOperationGetReplicationAckStates           = clientOperation("admin-get-replication-ack-states")
 	AdminClientOperationDescribeWorkflowExecution         = clientOperation("admin-describe-wf-execution")
 	AdminClientOperationGetWorkflowExecutionRawHistoryV2  = clientOperation("admin-get-wf-execution-raw-history-v2")
 	AdminClientOperationDescribeCluster                   = clientOperation("admin-describe-cluster")
@@ -296,6 +304,17 @@ var (
 	AdminClientOperationGetCrossClusterTasks              = clientOperation("admin-get-cross-cluster-tasks")
 	AdminClientOperationRespondCrossClusterTasksCompleted = clientOperation("admin-respond-cross-cluster-tasks-completed")
 	AdminClientOperationGetDynamicConfig                  = clientOperation("admin-get-dynamic-config")
+	AdminClientOperationGetDomainReplicationWatermark     = clientOperation("admin-get-domain-replication-watermark")
+	AdminClientOperationIssueDomainToken                  = clientOperation("admin-issue-domain-token")
+	AdminClientOperationStealTaskListLease                = clientOperation("admin-steal-task-list-lease")
+	AdminClientOperationDescribeTaskListConfig            = clientOperation("admin-describe-task-list-config")
+	AdminClientOperationGetTaskListUsage                  = clientOperation("admin-get-task-list-usage")
+	AdminClientOperationUpdateTaskListTags                = clientOperation("admin-update-task-list-tags")
+	AdminClientOperationListTaskListTags                  = clientOperation("admin-list-task-list-tags")
+	AdminClientOperationBackfillDomainReplication         = clientOperation("admin-backfill-domain-replication")
+	AdminClientOperationReconcileDomainRetention          = clientOperation("admin-reconcile-domain-retention")
+	AdminClientOperationUpdateDomainAsyncWorkflowConfig   = clientOperation("admin-update-domain-async-workflow-config")
+	AdminClientOperationGetDomainAsyncWorkflowConfig      = clientOperation("admin-get-domain-async-workflow-config")
 	AdminClientOperationUpdateDynamicConfig               = clientOperation("admin-update-dynamic-config")
 	AdminClientOperationRestoreDynamicConfig              = clientOperation("admin-restore-dynamic-config")
 	AdminClientOperationListDynamicConfig                 = clientOperation("admin-list-dynamic-config")
@@ -336,6 +355,7 @@ var (
 	FrontendClientOperationRespondQueryTaskCompleted        = clientOperation("frontend-respond-query-task-completed")
 	FrontendClientOperationSignalWithStartWorkflowExecution = clientOperation("frontend-signal-with-start-wf-execution")
 	FrontendClientOperationSignalWorkflowExecution          = clientOperation("frontend-signal-wf-execution")
+	FrontendClientOperationSignalBatchWorkflowExecution     = clientOperation("frontend-signal-batch-wf-execution")
 	FrontendClientOperationStartWorkflowExecution           = clientOperation("frontend-start-wf-execution")
 	FrontendClientOperationTerminateWorkflowExecution       = clientOperation("frontend-terminate-wf-execution")
 	FrontendClientOperationUpdateDomain                     = clientOperation("frontend-update-domain")
@@ -348,6 +368,7 @@ var (
 	HistoryClientOperationCloseShard                        = clientOperation("history-close-shard")
 	HistoryClientOperationResetQueue                        = clientOperation("history-reset-queue")
 	HistoryClientOperationDescribeQueue                     = clientOperation("history-describe-queue")
+	HistoryClientOperationGetReplicationAckStates           = clientOperation("history-get-replication-ack-states")
 	HistoryClientOperationRemoveTask                        = clientOperation("history-remove-task")
 	HistoryClientOperationDescribeMutableState              = clientOperation("history-describe-mutable-state")
 	HistoryClientOperationGetMutableState                   = clientOperation("history-get-mutable-state")
@@ -364,6 +385,7 @@ var (
 	HistoryClientOperationRecordActivityTaskHeartbeat       = clientOperation("history-record-activity-task-heartbeat")
 	HistoryClientOperationRequestCancelWorkflowExecution    = clientOperation("history-request-cancel-wf-execution")
 	HistoryClientOperationSignalWorkflowExecution           = clientOperation("history-signal-wf-execution")
+	HistoryClientOperationSignalBatchWorkflowExecution      = clientOperation("history-signal-batch-wf-execution")
 	HistoryClientOperationSignalWithStartWorkflowExecution  = clientOperation("history-signal-with-start-wf-execution")
 	HistoryClientOperationRemoveSignalMutableState          = clientOperation("history-remove-signal-mutable-state")
 	HistoryClientOperationTerminateWorkflowExecution        = clientOperation("history-terminate-wf-execution")
@@ -385,6 +407,8 @@ var (
 	HistoryClientOperationGetCrossClusterTasks              = clientOperation("history-get-cross-cluster-tasks")
 	HistoryClientOperationRespondCrossClusterTasksCompleted = clientOperation("history-respond-cross-cluster-tasks-completed")
 	HistoryClientOperationGetFailoverInfo                   = clientOperation("history-get-failover-info")
+	HistoryClientOperationGetDomainReplicationWatermark     = clientOperation("history-get-domain-replication-watermark")
+	HistoryClientOperationReconcileWorkflowRetention        = clientOperation("history-reconcile-workflow-retention")
 
 	MatchingClientOperationAddActivityTask        = clientOperation("matching-add-activity-task")
 	MatchingClientOperationAddDecisionTask        = clientOperation("matching-add-decision-task")
@@ -396,6 +420,7 @@ var (
 	MatchingClientOperationDescribeTaskList       = clientOperation("matching-describe-task-list")
 	MatchingClientOperationListTaskListPartitions = clientOperation("matching-list-task-list-partitions")
 	MatchingClientOperationGetTaskListsByDomain   = clientOperation("get-task-list-for-domain")
+	MatchingClientOperationDescribeTaskListConfig = clientOperation("matching-describe-task-list-config")
 )
 
 // Pre-defined values for TagIDType
@@ -211,6 +211,12 @@ const (
 	PersistenceCompleteTasksLessThanScope
 	// PersistenceGetOrphanTasksScope is the metric scope for persistence.TaskManager.GetOrphanTasks API
 	PersistenceGetOrphanTasksScope
+	// PersistenceGetTaskListUsageScope is the metric scope for persistence.TaskManager.GetTaskListUsage API
+	PersistenceGetTaskListUsageScope
+	// PersistenceGetTaskListScope is the metric scope for persistence.TaskManager.GetTaskList API
+	PersistenceGetTaskListScope
+	// PersistenceUpdateTaskListTagsScope is the metric scope for persistence.TaskManager.UpdateTaskListTags API
+	PersistenceUpdateTaskListTagsScope
 	// PersistenceLeaseTaskListScope tracks LeaseTaskList calls made by service to persistence layer
 	PersistenceLeaseTaskListScope
 	// PersistenceUpdateTaskListScope tracks PersistenceUpdateTaskListScope calls made by service to persistence layer
@@ -309,6 +315,8 @@ const (
 	HistoryClientResetQueueScope
 	// HistoryClientDescribeQueueScope tracks RPC calls to history service
 	HistoryClientDescribeQueueScope
+	// HistoryClientGetReplicationAckStatesScope tracks RPC calls to history service
+	HistoryClientGetReplicationAckStatesScope
 	// HistoryClientRecordActivityTaskHeartbeatScope tracks RPC calls to history service
 	HistoryClientRecordActivityTaskHeartbeatScope
 	// HistoryClientRespondDecisionTaskCompletedScope tracks RPC calls to history service
@@ -339,6 +347,8 @@ const (
 	HistoryClientRequestCancelWorkflowExecutionScope
 	// HistoryClientSignalWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWorkflowExecutionScope
+	// HistoryClientSignalBatchWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientSignalBatchWorkflowExecutionScope
 	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWithStartWorkflowExecutionScope
 	// HistoryClientRemoveSignalMutableStateScope tracks RPC calls to history service
@@ -381,6 +391,10 @@ const (
 	HistoryClientRespondCrossClusterTasksCompletedScope
 	// HistoryClientGetFailoverInfoScope tracks RPC calls to history service
 	HistoryClientGetFailoverInfoScope
+	// HistoryClientGetDomainReplicationWatermarkScope tracks RPC calls to history service
+	HistoryClientGetDomainReplicationWatermarkScope
+	// HistoryClientReconcileWorkflowRetentionScope tracks RPC calls to history service
+	HistoryClientReconcileWorkflowRetentionScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -401,6 +415,8 @@ const (
 	MatchingClientListTaskListPartitionsScope
 	// MatchingClientGetTaskListsByDomainScope tracks RPC calls to matching service
 	MatchingClientGetTaskListsByDomainScope
+	// MatchingClientDescribeTaskListConfigScope tracks RPC calls to matching service
+	MatchingClientDescribeTaskListConfigScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
 	FrontendClientDeprecateDomainScope
 	// FrontendClientDescribeDomainScope tracks RPC calls to frontend service
@@ -465,6 +481,8 @@ const (
 	FrontendClientSignalWithStartWorkflowExecutionScope
 	// FrontendClientSignalWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientSignalWorkflowExecutionScope
+	// FrontendClientSignalBatchWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientSignalBatchWorkflowExecutionScope
 	// FrontendClientStartWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
@@ -503,6 +521,8 @@ const (
 	AdminClientResetQueueScope
 	// AdminClientDescribeQueueScope tracks RPC calls to admin service
 	AdminClientDescribeQueueScope
+	// AdminClientGetReplicationAckStatesScope tracks RPC calls to admin service
+	AdminClientGetReplicationAckStatesScope
 	// AdminClientDescribeHistoryHostScope tracks RPC calls to admin service
 	AdminClientDescribeHistoryHostScope
 	// AdminClientDescribeShardDistributionScope tracks RPC calls to admin service
@@ -529,6 +549,28 @@ const (
 	AdminClientRespondCrossClusterTasksCompletedScope
 	// AdminClientGetDynamicConfigScope tracks RPC calls to admin service
 	AdminClientGetDynamicConfigScope
+	// AdminClientGetDomainReplicationWatermarkScope tracks RPC calls to admin service
+	AdminClientGetDomainReplicationWatermarkScope
+	// AdminClientIssueDomainTokenScope tracks RPC calls to admin service
+	AdminClientIssueDomainTokenScope
+	// AdminClientStealTaskListLeaseScope tracks RPC calls to admin service
+	AdminClientStealTaskListLeaseScope
+	// AdminClientDescribeTaskListConfigScope tracks RPC calls to admin service
+	AdminClientDescribeTaskListConfigScope
+	// AdminClientGetTaskListUsageScope tracks RPC calls to admin service
+	AdminClientGetTaskListUsageScope
+	// AdminClientUpdateTaskListTagsScope tracks RPC calls to admin service
+	AdminClientUpdateTaskListTagsScope
+	// AdminClientListTaskListTagsScope tracks RPC calls to admin service
+	AdminClientListTaskListTagsScope
+	// AdminClientBackfillDomainReplicationScope tracks RPC calls to admin service
+	AdminClientBackfillDomainReplicationScope
+	// AdminClientReconcileDomainRetentionScope tracks RPC calls to admin service
+	AdminClientReconcileDomainRetentionScope
+	// AdminClientUpdateDomainAsyncWorkflowConfigScope tracks RPC calls to admin service
+	AdminClientUpdateDomainAsyncWorkflowConfigScope
+	// AdminClientGetDomainAsyncWorkflowConfigScope tracks RPC calls to admin service
+	AdminClientGetDomainAsyncWorkflowConfigScope
 	// AdminClientUpdateDynamicConfigScope tracks RPC calls to admin service
 	AdminClientUpdateDynamicConfigScope
 	// AdminClientRestoreDynamicConfigScope tracks RPC calls to admin service
@@ -605,6 +647,8 @@ const (
 	DCRedirectionSignalWithStartWorkflowExecutionScope
 	// DCRedirectionSignalWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionSignalWorkflowExecutionScope
+	// DCRedirectionSignalBatchWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionSignalBatchWorkflowExecutionScope
 	// DCRedirectionStartWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionStartWorkflowExecutionScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
@@ -755,6 +799,8 @@ const (
 	AdminResetQueueScope
 	// AdminDescribeQueueScope is the metrics scope for admin.AdminDescribeQueueScope
 	AdminDescribeQueueScope
+	// AdminGetReplicationAckStatesScope is the metrics scope for admin.AdminGetReplicationAckStatesScope
+	AdminGetReplicationAckStatesScope
 	// AdminReadDLQMessagesScope is the metric scope for admin.AdminReadDLQMessagesScope
 	AdminReadDLQMessagesScope
 	// AdminPurgeDLQMessagesScope is the metric scope for admin.AdminPurgeDLQMessagesScope
@@ -769,6 +815,28 @@ const (
 	AdminRespondCrossClusterTasksCompletedScope
 	// AdminGetDynamicConfigScope is the metric scope for admin.GetDynamicConfig
 	AdminGetDynamicConfigScope
+	// AdminGetDomainReplicationWatermarkScope is the metric scope for admin.GetDomainReplicationWatermark
+	AdminGetDomainReplicationWatermarkScope
+	// AdminGetTaskListUsageScope is the metric scope for admin.GetTaskListUsage
+	AdminGetTaskListUsageScope
+	// AdminIssueDomainTokenScope is the metric scope for admin.IssueDomainToken
+	AdminIssueDomainTokenScope
+	// AdminStealTaskListLeaseScope is the metric scope for admin.StealTaskListLease
+	AdminStealTaskListLeaseScope
+	// AdminDescribeTaskListConfigScope is the metric scope for admin.DescribeTaskListConfig
+	AdminDescribeTaskListConfigScope
+	// AdminUpdateTaskListTagsScope is the metric scope for admin.UpdateTaskListTags
+	AdminUpdateTaskListTagsScope
+	// AdminListTaskListTagsScope is the metric scope for admin.ListTaskListTags
+	AdminListTaskListTagsScope
+	// AdminBackfillDomainReplicationScope is the metric scope for admin.BackfillDomainReplication
+	AdminBackfillDomainReplicationScope
+	// AdminReconcileDomainRetentionScope is the metric scope for admin.ReconcileDomainRetention
+	AdminReconcileDomainRetentionScope
+	// AdminUpdateDomainAsyncWorkflowConfigScope is the metric scope for admin.UpdateDomainAsyncWorkflowConfig
+	AdminUpdateDomainAsyncWorkflowConfigScope
+	// AdminGetDomainAsyncWorkflowConfigScope is the metric scope for admin.GetDomainAsyncWorkflowConfig
+	AdminGetDomainAsyncWorkflowConfigScope
 	// AdminUpdateDynamicConfigScope is the metric scope for admin.UpdateDynamicConfig
 	AdminUpdateDynamicConfigScope
 	// AdminRestoreDynamicConfigScope is the metric scope for admin.RestoreDynamicConfig
@@ -821,6 +889,8 @@ const (
 	FrontendPollForWorklfowExecutionRawHistoryScope
 	// FrontendSignalWorkflowExecutionScope is the metric scope for frontend.SignalWorkflowExecution
 	FrontendSignalWorkflowExecutionScope
+	// FrontendSignalBatchWorkflowExecutionScope is the metric scope for frontend.SignalBatchWorkflowExecution
+	FrontendSignalBatchWorkflowExecutionScope
 	// FrontendSignalWithStartWorkflowExecutionScope is the metric scope for frontend.SignalWithStartWorkflowExecution
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
@@ -891,6 +961,8 @@ const (
 	HistoryResetQueueScope
 	// HistoryDescribeQueueScope tracks DescribeQueue API calls received by service
 	HistoryDescribeQueueScope
+	// HistoryGetReplicationAckStatesScope tracks GetReplicationAckStates API calls received by service
+	HistoryGetReplicationAckStatesScope
 	// HistoryDescribeMutabelStateScope tracks DescribeMutableState API calls received by service
 	HistoryDescribeMutabelStateScope
 	// HistoryGetMutableStateScope tracks GetMutableState API calls received by service
@@ -907,6 +979,8 @@ const (
 	HistoryRecordActivityTaskStartedScope
 	// HistorySignalWorkflowExecutionScope tracks SignalWorkflowExecution API calls received by service
 	HistorySignalWorkflowExecutionScope
+	// HistorySignalBatchWorkflowExecutionScope tracks SignalBatchWorkflowExecution API calls received by service
+	HistorySignalBatchWorkflowExecutionScope
 	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
 	HistorySignalWithStartWorkflowExecutionScope
 	// HistoryRemoveSignalMutableStateScope tracks RemoveSignalMutableState API calls received by service
@@ -955,6 +1029,10 @@ const (
 	HistoryRespondCrossClusterTasksCompletedScope
 	// HistoryGetFailoverInfoScope tracks HistoryGetFailoverInfo API calls received by service
 	HistoryGetFailoverInfoScope
+	// HistoryGetDomainReplicationWatermarkScope tracks HistoryGetDomainReplicationWatermark API calls received by service
+	HistoryGetDomainReplicationWatermarkScope
+	// HistoryReconcileWorkflowRetentionScope tracks ReconcileWorkflowRetention API calls received by service
+	HistoryReconcileWorkflowRetentionScope
 	// TaskPriorityAssignerScope is the scope used by all metric emitted by task priority assigner
 	TaskPriorityAssignerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -1157,6 +1235,8 @@ const (
 	MatchingListTaskListPartitionsScope
 	// MatchingGetTaskListsByDomainScope tracks GetTaskListsByDomain API calls received by service
 	MatchingGetTaskListsByDomainScope
+	// MatchingDescribeTaskListConfigScope tracks DescribeTaskListConfig API calls received by service
+	MatchingDescribeTaskListConfigScope
 
 	NumMatchingScopes
 )
@@ -1248,6 +1328,9 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCompleteTaskScope:                             {operation: "CompleteTask"},
 		PersistenceCompleteTasksLessThanScope:                    {operation: "CompleteTasksLessThan"},
 		PersistenceGetOrphanTasksScope:                           {operation: "GetOrphanTasks"},
+		PersistenceGetTaskListUsageScope:                         {operation: "GetTaskListUsage"},
+		PersistenceGetTaskListScope:                              {operation: "GetTaskList"},
+		PersistenceUpdateTaskListTagsScope:                       {operation: "UpdateTaskListTags"},
 		PersistenceLeaseTaskListScope:                            {operation: "LeaseTaskList"},
 		PersistenceUpdateTaskListScope:                           {operation: "UpdateTaskList"},
 		PersistenceListTaskListScope:                             {operation: "ListTaskList"},
@@ -1307,6 +1390,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientCloseShardScope:                          {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientResetQueueScope:                          {operation: "HistoryClientResetQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientDescribeQueueScope:                       {operation: "HistoryClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetReplicationAckStatesScope:             {operation: "HistoryClientGetReplicationAckStates", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRecordActivityTaskHeartbeatScope:         {operation: "HistoryClientRecordActivityTaskHeartbeat", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondDecisionTaskCompletedScope:        {operation: "HistoryClientRespondDecisionTaskCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondDecisionTaskFailedScope:           {operation: "HistoryClientRespondDecisionTaskFailed", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1322,6 +1406,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientRecordActivityTaskStartedScope:           {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRequestCancelWorkflowExecutionScope:      {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientSignalWorkflowExecutionScope:             {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientSignalBatchWorkflowExecutionScope:        {operation: "HistoryClientSignalBatchWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientSignalWithStartWorkflowExecutionScope:    {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:            {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:          {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
@@ -1343,6 +1428,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientGetCrossClusterTasksScope:                {operation: "HistoryClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientRespondCrossClusterTasksCompletedScope:   {operation: "HistoryClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		HistoryClientGetFailoverInfoScope:                     {operation: "HistoryClientGetFailoverInfo", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientGetDomainReplicationWatermarkScope:       {operation: "HistoryClientGetDomainReplicationWatermark", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
+		HistoryClientReconcileWorkflowRetentionScope:          {operation: "HistoryClientReconcileWorkflowRetention", tags: map[string]string{CadenceRoleTagName: HistoryClientRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:                {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:                {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
@@ -1353,6 +1440,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientListTaskListPartitionsScope:             {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientGetTaskListsByDomainScope:               {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientDescribeTaskListConfigScope:             {operation: "MatchingClientDescribeTaskListConfig", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                    {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeDomainScope:                     {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                   {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1385,6 +1473,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientRespondQueryTaskCompletedScope:          {operation: "FrontendClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientSignalWithStartWorkflowExecutionScope:   {operation: "FrontendClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientSignalWorkflowExecutionScope:            {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
+		FrontendClientSignalBatchWorkflowExecutionScope:       {operation: "FrontendClientSignalBatchWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:             {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:         {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientUpdateDomainScope:                       {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1411,12 +1500,24 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientRemoveTaskScope:                            {operation: "AdminClientRemoveTask", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientResetQueueScope:                            {operation: "AdminClientResetQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientDescribeQueueScope:                         {operation: "AdminClientDescribeQueue", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetReplicationAckStatesScope:               {operation: "AdminClientGetReplicationAckStates", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientReadDLQMessagesScope:                       {operation: "AdminClientReadDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientMergeDLQMessagesScope:                      {operation: "AdminClientMergeDLQMessages", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetCrossClusterTasksScope:                  {operation: "AdminClientGetCrossClusterTasks", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRespondCrossClusterTasksCompletedScope:     {operation: "AdminClientRespondCrossClusterTasksCompleted", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientGetDynamicConfigScope:                      {operation: "AdminClientGetDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetDomainReplicationWatermarkScope:         {operation: "AdminClientGetDomainReplicationWatermark", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientIssueDomainTokenScope:                      {operation: "AdminClientIssueDomainToken", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientStealTaskListLeaseScope:                    {operation: "AdminClientStealTaskListLease", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientDescribeTaskListConfigScope:                {operation: "AdminClientDescribeTaskListConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetTaskListUsageScope:                      {operation: "AdminClientGetTaskListUsage", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUpdateTaskListTagsScope:                    {operation: "AdminClientUpdateTaskListTags", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientListTaskListTagsScope:                      {operation: "AdminClientListTaskListTags", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientBackfillDomainReplicationScope:             {operation: "AdminClientBackfillDomainReplication", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientReconcileDomainRetentionScope:              {operation: "AdminClientReconcileDomainRetention", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientUpdateDomainAsyncWorkflowConfigScope:       {operation: "AdminClientUpdateDomainAsyncWorkflowConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
+		AdminClientGetDomainAsyncWorkflowConfigScope:          {operation: "AdminClientGetDomainAsyncWorkflowConfig", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientUpdateDynamicConfigScope:                   {operation: "AdminClientUpdateDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientRestoreDynamicConfigScope:                  {operation: "AdminClientRestoreDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
 		AdminClientListDynamicConfigScope:                     {operation: "AdminClientListDynamicConfigScope", tags: map[string]string{CadenceRoleTagName: AdminClientRoleTagValue}},
@@ -1455,6 +1556,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionRespondQueryTaskCompletedScope:           {operation: "DCRedirectionRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionSignalWithStartWorkflowExecutionScope:    {operation: "DCRedirectionSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionSignalWorkflowExecutionScope:             {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionSignalBatchWorkflowExecutionScope:        {operation: "DCRedirectionSignalBatchWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionScope:              {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:          {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                        {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -1512,6 +1614,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminCloseShardScope:                        {operation: "AdminCloseShard"},
 		AdminResetQueueScope:                        {operation: "AdminResetQueue"},
 		AdminDescribeQueueScope:                     {operation: "AdminDescribeQueue"},
+		AdminGetReplicationAckStatesScope:           {operation: "AdminGetReplicationAckStates"},
 		AdminReadDLQMessagesScope:                   {operation: "AdminReadDLQMessages"},
 		AdminPurgeDLQMessagesScope:                  {operation: "AdminPurgeDLQMessages"},
 		AdminMergeDLQMessagesScope:                  {operation: "AdminMergeDLQMessages"},
@@ -1530,6 +1633,17 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminGetCrossClusterTasksScope:              {operation: "AdminGetCrossClusterTasks"},
 		AdminRespondCrossClusterTasksCompletedScope: {operation: "AdminRespondCrossClusterTasksCompleted"},
 		AdminGetDynamicConfigScope:                  {operation: "AdminGetDynamicConfig"},
+		AdminGetDomainReplicationWatermarkScope:     {operation: "AdminGetDomainReplicationWatermark"},
+		AdminGetTaskListUsageScope:                  {operation: "AdminGetTaskListUsage"},
+		AdminIssueDomainTokenScope:                  {operation: "AdminIssueDomainToken"},
+		AdminStealTaskListLeaseScope:                {operation: "AdminStealTaskListLease"},
+		AdminDescribeTaskListConfigScope:            {operation: "AdminDescribeTaskListConfig"},
+		AdminUpdateTaskListTagsScope:                {operation: "AdminUpdateTaskListTags"},
+		AdminListTaskListTagsScope:                  {operation: "AdminListTaskListTags"},
+		AdminBackfillDomainReplicationScope:         {operation: "AdminBackfillDomainReplication"},
+		AdminReconcileDomainRetentionScope:          {operation: "AdminReconcileDomainRetention"},
+		AdminUpdateDomainAsyncWorkflowConfigScope:   {operation: "AdminUpdateDomainAsyncWorkflowConfig"},
+		AdminGetDomainAsyncWorkflowConfigScope:      {operation: "AdminGetDomainAsyncWorkflowConfig"},
 		AdminUpdateDynamicConfigScope:               {operation: "AdminUpdateDynamicConfig"},
 		AdminRestoreDynamicConfigScope:              {operation: "AdminRestoreDynamicConfig"},
 		AdminListDynamicConfigScope:                 {operation: "AdminListDynamicConfig"},
@@ -1554,6 +1668,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendGetWorkflowExecutionRawHistoryScope:     {operation: "GetWorkflowExecutionRawHistory"},
 		FrontendPollForWorklfowExecutionRawHistoryScope: {operation: "PollForWorklfowExecutionRawHistory"},
 		FrontendSignalWorkflowExecutionScope:            {operation: "SignalWorkflowExecution"},
+		FrontendSignalBatchWorkflowExecutionScope:       {operation: "SignalBatchWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope:   {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:         {operation: "TerminateWorkflowExecution"},
 		FrontendResetWorkflowExecutionScope:             {operation: "ResetWorkflowExecution"},
@@ -1589,6 +1704,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRespondActivityTaskCanceledScope:                         {operation: "RespondActivityTaskCanceled"},
 		HistoryResetQueueScope:                                          {operation: "ResetQueue"},
 		HistoryDescribeQueueScope:                                       {operation: "DescribeQueue"},
+		HistoryGetReplicationAckStatesScope:                             {operation: "GetReplicationAckStates"},
 		HistoryDescribeMutabelStateScope:                                {operation: "DescribeMutableState"},
 		HistoryGetMutableStateScope:                                     {operation: "GetMutableState"},
 		HistoryPollMutableStateScope:                                    {operation: "PollMutableState"},
@@ -1597,6 +1713,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRecordDecisionTaskStartedScope:                           {operation: "RecordDecisionTaskStarted"},
 		HistoryRecordActivityTaskStartedScope:                           {operation: "RecordActivityTaskStarted"},
 		HistorySignalWorkflowExecutionScope:                             {operation: "SignalWorkflowExecution"},
+		HistorySignalBatchWorkflowExecutionScope:                        {operation: "SignalBatchWorkflowExecution"},
 		HistorySignalWithStartWorkflowExecutionScope:                    {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                            {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                          {operation: "TerminateWorkflowExecution"},
@@ -1624,6 +1741,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryGetCrossClusterTasksScope:                                {operation: "GetCrossClusterTasks"},
 		HistoryRespondCrossClusterTasksCompletedScope:                   {operation: "RespondCrossClusterTasksCompleted"},
 		HistoryGetFailoverInfoScope:                                     {operation: "GetFailoverInfo"},
+		HistoryGetDomainReplicationWatermarkScope:                       {operation: "GetDomainReplicationWatermark"},
+		HistoryReconcileWorkflowRetentionScope:                          {operation: "ReconcileWorkflowRetention"},
 		TaskPriorityAssignerScope:                                       {operation: "TaskPriorityAssigner"},
 		TransferQueueProcessorScope:                                     {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                               {operation: "TransferActiveQueueProcessor"},
@@ -1722,6 +1841,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
 		MatchingListTaskListPartitionsScope:    {operation: "ListTaskListPartitions"},
 		MatchingGetTaskListsByDomainScope:      {operation: "GetTaskListsByDomain"},
+		MatchingDescribeTaskListConfigScope:    {operation: "DescribeTaskListConfig"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -2120,6 +2240,11 @@ const (
 	ReplicationDLQProbeFailed
 	ReplicationDLQSize
 	ReplicationDLQValidationFailed
+	ReplicationDLQRerouted
+	ReplicationDLQOldestMessageAge
+	ReplicationDLQMessageNearExpiry
+	ReplicationDLQMergeFailed
+	WorkflowIDRateLimitedCounter
 	GetReplicationMessagesForShardLatency
 	GetDLQReplicationMessagesLatency
 	EventReapplySkippedCount
@@ -2150,6 +2275,11 @@ const (
 	FailoverMarkerUpdateShardFailure
 	FailoverMarkerCallbackCount
 	HistoryFailoverCallbackCount
+	WorkflowHistoryAtRiskCount
+	TaskPayloadSizeNearLimitCount
+	TaskPayloadSizeExceedsLimitCount
+	PayloadSize
+	PayloadSizeNearLimitCount
 
 	NumHistoryMetrics
 )
@@ -2158,16 +2288,37 @@ const (
 const (
 	PollSuccessPerTaskListCounter = iota + NumCommonMetrics
 	PollTimeoutPerTaskListCounter
+	PollDrainedPerTaskListCounter
 	PollSuccessWithSyncPerTaskListCounter
 	LeaseRequestPerTaskListCounter
 	LeaseFailurePerTaskListCounter
+	LeaseConflictPerTaskListCounter
 	ConditionFailedErrorPerTaskListCounter
 	RespondQueryTaskFailedPerTaskListCounter
 	SyncThrottlePerTaskListCounter
+	EphemeralNotMatchedPerTaskListCounter
+	TaskMatchedPerTaskListCounter
+	TaskAffinityMatchedPerTaskListCounter
+	TaskDedupedPerTaskListCounter
 	BufferThrottlePerTaskListCounter
 	SyncMatchLatencyPerTaskList
 	AsyncMatchLatencyPerTaskList
+	TaskAppendLatencyPerTaskList
+	TaskAppendBatchSizePerTaskList
+	OverloadShedNewestPerTaskListCounter
+	OverloadShedOldestPerTaskListCounter
+	OverloadBlockWaitPerTaskList
 	ExpiredTasksPerTaskListCounter
+	BufferDispatchedPerTaskListCounter
+	BufferDeadlineMissedPerTaskListCounter
+	DrainedTasksPerTaskListCounter
+	DrainTaskErrorsPerTaskListCounter
+	PartitionsDrainedPerTaskListCounter
+	ShadowTaskMirroredPerTaskListCounter
+	ShadowTaskNotMatchedPerTaskListCounter
+	ShadowTaskErrorsPerTaskListCounter
+	ShadowTaskDispatchLatencyPerTaskList
+	ShadowSourceDispatchLatencyPerTaskList
 	ForwardedPerTaskListCounter
 	ForwardTaskCallsPerTaskList
 	ForwardTaskErrorsPerTaskList
@@ -2184,6 +2335,7 @@ const (
 	RemoteToRemoteMatchPerTaskListCounter
 	PollerPerTaskListCounter
 	TaskListManagersGauge
+	TaskListsPerDomainLimitExceededCounter
 	TaskLagPerTaskListGauge
 	TaskBacklogPerTaskListGauge
 
@@ -2658,6 +2810,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ReplicationDLQProbeFailed:                           {metricName: "replication_dlq_probe_failed", metricType: Counter},
 		ReplicationDLQSize:                                  {metricName: "replication_dlq_size", metricType: Gauge},
 		ReplicationDLQValidationFailed:                      {metricName: "replication_dlq_validation_failed", metricType: Counter},
+		ReplicationDLQRerouted:                              {metricName: "replication_dlq_rerouted", metricType: Counter},
+		ReplicationDLQOldestMessageAge:                      {metricName: "replication_dlq_oldest_message_age", metricType: Timer},
+		ReplicationDLQMessageNearExpiry:                     {metricName: "replication_dlq_message_near_expiry", metricType: Counter},
+		ReplicationDLQMergeFailed:                           {metricName: "replication_dlq_merge_failed", metricType: Counter},
+		WorkflowIDRateLimitedCounter:                        {metricName: "workflow_id_rate_limited", metricType: Counter},
 		GetReplicationMessagesForShardLatency:               {metricName: "get_replication_messages_for_shard", metricType: Timer},
 		GetDLQReplicationMessagesLatency:                    {metricName: "get_dlq_replication_messages", metricType: Timer},
 		EventReapplySkippedCount:                            {metricName: "event_reapply_skipped_count", metricType: Counter},
@@ -2688,6 +2845,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		FailoverMarkerUpdateShardFailure:                    {metricName: "failover_marker_update_shard_failures", metricType: Counter},
 		FailoverMarkerCallbackCount:                         {metricName: "failover_marker_callback_count", metricType: Counter},
 		HistoryFailoverCallbackCount:                        {metricName: "failover_callback_handler_count", metricType: Counter},
+		WorkflowHistoryAtRiskCount:                          {metricName: "workflow_history_at_risk", metricType: Counter},
+		TaskPayloadSizeNearLimitCount:                       {metricName: "task_payload_size_near_limit", metricType: Counter},
+		TaskPayloadSizeExceedsLimitCount:                    {metricName: "task_payload_size_exceeds_limit", metricType: Counter},
+		PayloadSize:                                         {metricName: "payload_size", metricType: Histogram, buckets: PayloadSizeBuckets},
+		PayloadSizeNearLimitCount:                           {metricName: "payload_size_near_limit", metricType: Counter},
 		TransferTasksCount:                                  {metricName: "transfer_tasks_count", metricType: Timer},
 		TimerTasksCount:                                     {metricName: "timer_tasks_count", metricType: Timer},
 		CrossClusterTasksCount:                              {metricName: "cross_cluster_tasks_count", metricType: Timer},
@@ -2696,14 +2858,30 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 	Matching: {
 		PollSuccessPerTaskListCounter:            {metricName: "poll_success_per_tl", metricRollupName: "poll_success"},
 		PollTimeoutPerTaskListCounter:            {metricName: "poll_timeouts_per_tl", metricRollupName: "poll_timeouts"},
+		PollDrainedPerTaskListCounter:            {metricName: "poll_drained_per_tl", metricRollupName: "poll_drained"},
 		PollSuccessWithSyncPerTaskListCounter:    {metricName: "poll_success_sync_per_tl", metricRollupName: "poll_success_sync"},
 		LeaseRequestPerTaskListCounter:           {metricName: "lease_requests_per_tl", metricRollupName: "lease_requests"},
 		LeaseFailurePerTaskListCounter:           {metricName: "lease_failures_per_tl", metricRollupName: "lease_failures"},
+		LeaseConflictPerTaskListCounter:          {metricName: "lease_conflicts_per_tl", metricRollupName: "lease_conflicts"},
 		ConditionFailedErrorPerTaskListCounter:   {metricName: "condition_failed_errors_per_tl", metricRollupName: "condition_failed_errors"},
 		RespondQueryTaskFailedPerTaskListCounter: {metricName: "respond_query_failed_per_tl", metricRollupName: "respond_query_failed"},
 		SyncThrottlePerTaskListCounter:           {metricName: "sync_throttle_count_per_tl", metricRollupName: "sync_throttle_count"},
+		EphemeralNotMatchedPerTaskListCounter:    {metricName: "ephemeral_task_not_matched_per_tl", metricRollupName: "ephemeral_task_not_matched"},
+		TaskMatchedPerTaskListCounter:            {metricName: "task_matched_per_tl", metricRollupName: "task_matched"},
+		TaskAffinityMatchedPerTaskListCounter:    {metricName: "task_affinity_matched_per_tl", metricRollupName: "task_affinity_matched"},
+		TaskDedupedPerTaskListCounter:            {metricName: "task_deduped_per_tl", metricRollupName: "task_deduped"},
 		BufferThrottlePerTaskListCounter:         {metricName: "buffer_throttle_count_per_tl", metricRollupName: "buffer_throttle_count"},
 		ExpiredTasksPerTaskListCounter:           {metricName: "tasks_expired_per_tl", metricRollupName: "tasks_expired"},
+		BufferDispatchedPerTaskListCounter:       {metricName: "buffer_dispatched_per_tl", metricRollupName: "buffer_dispatched"},
+		BufferDeadlineMissedPerTaskListCounter:   {metricName: "buffer_deadline_missed_per_tl", metricRollupName: "buffer_deadline_missed"},
+		DrainedTasksPerTaskListCounter:           {metricName: "tasks_drained_per_tl", metricRollupName: "tasks_drained"},
+		DrainTaskErrorsPerTaskListCounter:        {metricName: "drain_task_errors_per_tl", metricRollupName: "drain_task_errors"},
+		PartitionsDrainedPerTaskListCounter:      {metricName: "partitions_drained_per_tl", metricRollupName: "partitions_drained"},
+		ShadowTaskMirroredPerTaskListCounter:     {metricName: "shadow_task_mirrored_per_tl", metricRollupName: "shadow_task_mirrored"},
+		ShadowTaskNotMatchedPerTaskListCounter:   {metricName: "shadow_task_not_matched_per_tl", metricRollupName: "shadow_task_not_matched"},
+		ShadowTaskErrorsPerTaskListCounter:       {metricName: "shadow_task_errors_per_tl", metricRollupName: "shadow_task_errors"},
+		ShadowTaskDispatchLatencyPerTaskList:     {metricName: "shadow_task_dispatch_latency_per_tl", metricRollupName: "shadow_task_dispatch_latency", metricType: Timer},
+		ShadowSourceDispatchLatencyPerTaskList:   {metricName: "shadow_source_dispatch_latency_per_tl", metricRollupName: "shadow_source_dispatch_latency", metricType: Timer},
 		ForwardedPerTaskListCounter:              {metricName: "forwarded_per_tl", metricRollupName: "forwarded"},
 		ForwardTaskCallsPerTaskList:              {metricName: "forward_task_calls_per_tl", metricRollupName: "forward_task_calls"},
 		ForwardTaskErrorsPerTaskList:             {metricName: "forward_task_errors_per_tl", metricRollupName: "forward_task_errors"},
@@ -2713,6 +2891,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ForwardPollErrorsPerTaskList:             {metricName: "forward_poll_errors_per_tl", metricRollupName: "forward_poll_errors"},
 		SyncMatchLatencyPerTaskList:              {metricName: "syncmatch_latency_per_tl", metricRollupName: "syncmatch_latency", metricType: Timer},
 		AsyncMatchLatencyPerTaskList:             {metricName: "asyncmatch_latency_per_tl", metricRollupName: "asyncmatch_latency", metricType: Timer},
+		TaskAppendLatencyPerTaskList:             {metricName: "task_append_latency_per_tl", metricRollupName: "task_append_latency", metricType: Timer},
+		TaskAppendBatchSizePerTaskList:           {metricName: "task_append_batch_size_per_tl", metricRollupName: "task_append_batch_size", metricType: Timer},
+		OverloadShedNewestPerTaskListCounter:     {metricName: "overload_shed_newest_per_tl", metricRollupName: "overload_shed_newest"},
+		OverloadShedOldestPerTaskListCounter:     {metricName: "overload_shed_oldest_per_tl", metricRollupName: "overload_shed_oldest"},
+		OverloadBlockWaitPerTaskList:             {metricName: "overload_block_wait_per_tl", metricRollupName: "overload_block_wait", metricType: Timer},
 		ForwardTaskLatencyPerTaskList:            {metricName: "forward_task_latency_per_tl", metricRollupName: "forward_task_latency"},
 		ForwardQueryLatencyPerTaskList:           {metricName: "forward_query_latency_per_tl", metricRollupName: "forward_query_latency"},
 		ForwardPollLatencyPerTaskList:            {metricName: "forward_poll_latency_per_tl", metricRollupName: "forward_poll_latency"},
@@ -2722,6 +2905,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		RemoteToRemoteMatchPerTaskListCounter:    {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
 		PollerPerTaskListCounter:                 {metricName: "poller_count_per_tl", metricRollupName: "poller_count"},
 		TaskListManagersGauge:                    {metricName: "tasklist_managers", metricType: Gauge},
+		TaskListsPerDomainLimitExceededCounter:   {metricName: "tasklists_per_domain_limit_exceeded", metricType: Counter},
 		TaskLagPerTaskListGauge:                  {metricName: "task_lag_per_tl", metricType: Gauge},
 		TaskBacklogPerTaskListGauge:              {metricName: "task_backlog_per_tl", metricType: Gauge},
 	},
@@ -2808,6 +2992,21 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 	},
 }
 
+// PayloadSizeBuckets contains byte buckets for measuring decision and activity payload sizes
+var PayloadSizeBuckets = tally.ValueBuckets{
+	1024,
+	4 * 1024,
+	16 * 1024,
+	64 * 1024,
+	128 * 1024,
+	256 * 1024,
+	512 * 1024,
+	1024 * 1024,
+	1536 * 1024,
+	2 * 1024 * 1024,
+	4 * 1024 * 1024,
+}
+
 // PersistenceLatencyBuckets contains duration buckets for measuring persistence latency
 var PersistenceLatencyBuckets = tally.DurationBuckets([]time.Duration{
 	1 * time.Millisecond,
@@ -47,6 +47,9 @@ const (
 	transport              = "transport"
 	caller                 = "caller"
 	signalName             = "signalName"
+	matchType              = "matchType"
+	dispatchOrder          = "dispatchOrder"
+	payloadType            = "payloadType"
 
 	allValue     = "all"
 	unknownValue = "_unknown_"
@@ -170,6 +173,21 @@ func SignalNameTag(value string) Tag {
 	return metricWithUnknown(signalName, value)
 }
 
+// MatchTypeTag returns a new MatchType tag, describing how a task was matched with a poller
+func MatchTypeTag(value string) Tag {
+	return simpleMetric{key: matchType, value: value}
+}
+
+// DispatchOrderTag returns a new DispatchOrder tag, describing the order buffered tasks are dispatched in
+func DispatchOrderTag(value string) Tag {
+	return simpleMetric{key: dispatchOrder, value: value}
+}
+
+// PayloadTypeTag returns a new PayloadType tag, describing the kind of payload whose size is measured
+func PayloadTypeTag(value string) Tag {
+	return simpleMetric{key: payloadType, value: value}
+}
+
 // SignalNameAllTag returns a new SignalName tag with all value
 func SignalNameAllTag() Tag {
 	return metricWithUnknown(signalName, allValue)
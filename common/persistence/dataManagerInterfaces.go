@@ -43,6 +43,7 @@ const (
 	DomainStatusRegistered = iota
 	DomainStatusDeprecated
 	DomainStatusDeleted
+	DomainStatusProvisioning
 )
 
 const (
@@ -290,6 +291,7 @@ type (
 		ClusterReplicationLevel           map[string]int64                  `json:"cluster_replication_level"`
 		DomainNotificationVersion         int64                             `json:"domain_notification_version"`
 		PendingFailoverMarkers            []*types.FailoverMarkerAttributes `json:"pending_failover_markers"`
+		DomainReplicationWatermarks       map[string]time.Time              `json:"domain_replication_watermarks"`
 	}
 
 	// WorkflowExecutionInfo describes a workflow execution
@@ -443,6 +445,9 @@ type (
 		Kind        int
 		Expiry      time.Time
 		LastUpdated time.Time
+		// Tags are set by operators to group task lists, e.g. by team or service.
+		// They are only updated through UpdateTaskListTags and kept by UpdateTaskList
+		Tags map[string]string
 	}
 
 	// TaskInfo describes either activity or decision task
@@ -455,6 +460,7 @@ type (
 		ScheduleToStartTimeout int32
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		AffinityKey            string
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -1217,6 +1223,26 @@ type (
 		TaskListInfo *TaskListInfo
 	}
 
+	// GetTaskListRequest is used to read a task list without leasing it
+	GetTaskListRequest struct {
+		DomainID string
+		TaskList string
+		TaskType int
+	}
+
+	// GetTaskListResponse is response to GetTaskListRequest
+	GetTaskListResponse struct {
+		TaskListInfo *TaskListInfo
+	}
+
+	// UpdateTaskListTagsRequest is used to replace the tags of an existing task list
+	UpdateTaskListTagsRequest struct {
+		DomainID string
+		TaskList string
+		TaskType int
+		Tags     map[string]string
+	}
+
 	// UpdateTaskListRequest is used to update task list implementation information
 	UpdateTaskListRequest struct {
 		TaskListInfo *TaskListInfo
@@ -1308,6 +1334,21 @@ type (
 		Tasks []*TaskKey
 	}
 
+	// GetTaskListUsageRequest contains the request params needed to invoke the GetTaskListUsage API
+	GetTaskListUsageRequest struct {
+		DomainID     string
+		TaskListName string
+		TaskListType int
+	}
+
+	// GetTaskListUsageResponse is the response to GetTaskListUsageRequest
+	GetTaskListUsageResponse struct {
+		TaskCount int64
+		SizeBytes int64
+		// Estimated is set when the store extrapolated the usage from a sample of the tasks
+		Estimated bool
+	}
+
 	// GetTimerIndexTasksRequest is the request for GetTimerIndexTasks
 	// TODO: replace this with an iterator that can configure min and max index.
 	GetTimerIndexTasksRequest struct {
@@ -1343,6 +1384,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              types.BadBinaries
+		AsyncWorkflowConfig      types.AsyncWorkflowConfiguration
 	}
 
 	// DomainReplicationConfig describes the cross DC domain replication configuration
@@ -1428,6 +1470,7 @@ type (
 	ListDomainsResponse struct {
 		Domains       []*GetDomainResponse
 		NextPageToken []byte
+		TotalCount    int64
 	}
 
 	// GetMetadataResponse is the response for GetMetadata
@@ -1758,6 +1801,9 @@ type (
 		CompleteTask(ctx context.Context, request *CompleteTaskRequest) error
 		CompleteTasksLessThan(ctx context.Context, request *CompleteTasksLessThanRequest) (*CompleteTasksLessThanResponse, error)
 		GetOrphanTasks(ctx context.Context, request *GetOrphanTasksRequest) (*GetOrphanTasksResponse, error)
+		GetTaskListUsage(ctx context.Context, request *GetTaskListUsageRequest) (*GetTaskListUsageResponse, error)
+		GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error)
+		UpdateTaskListTags(ctx context.Context, request *UpdateTaskListTagsRequest) error
 	}
 
 	// HistoryManager is used to manager workflow history events
@@ -2809,6 +2855,10 @@ func (s *ShardInfo) Copy() *ShardInfo {
 	for k, v := range s.ReplicationDLQAckLevel {
 		replicationDLQAckLevel[k] = v
 	}
+	domainReplicationWatermarks := make(map[string]time.Time)
+	for k, v := range s.DomainReplicationWatermarks {
+		domainReplicationWatermarks[k] = v
+	}
 	return &ShardInfo{
 		ShardID:                           s.ShardID,
 		Owner:                             s.Owner,
@@ -2826,6 +2876,7 @@ func (s *ShardInfo) Copy() *ShardInfo {
 		ClusterReplicationLevel:           clusterReplicationLevel,
 		ReplicationDLQAckLevel:            replicationDLQAckLevel,
 		PendingFailoverMarkers:            s.PendingFailoverMarkers,
+		DomainReplicationWatermarks:       domainReplicationWatermarks,
 		UpdatedAt:                         s.UpdatedAt,
 	}
 }
@@ -982,6 +982,50 @@ func (mr *MockTaskManagerMockRecorder) GetOrphanTasks(ctx, request interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrphanTasks", reflect.TypeOf((*MockTaskManager)(nil).GetOrphanTasks), ctx, request)
 }
 
+// GetTaskListUsage mocks base method
+func (m *MockTaskManager) GetTaskListUsage(ctx context.Context, request *GetTaskListUsageRequest) (*GetTaskListUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListUsage", ctx, request)
+	ret0, _ := ret[0].(*GetTaskListUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListUsage indicates an expected call of GetTaskListUsage
+func (mr *MockTaskManagerMockRecorder) GetTaskListUsage(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListUsage", reflect.TypeOf((*MockTaskManager)(nil).GetTaskListUsage), ctx, request)
+}
+
+// GetTaskList mocks base method
+func (m *MockTaskManager) GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskList", ctx, request)
+	ret0, _ := ret[0].(*GetTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskList indicates an expected call of GetTaskList
+func (mr *MockTaskManagerMockRecorder) GetTaskList(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskList", reflect.TypeOf((*MockTaskManager)(nil).GetTaskList), ctx, request)
+}
+
+// UpdateTaskListTags mocks base method
+func (m *MockTaskManager) UpdateTaskListTags(ctx context.Context, request *UpdateTaskListTagsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListTags", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskListTags indicates an expected call of UpdateTaskListTags
+func (mr *MockTaskManagerMockRecorder) UpdateTaskListTags(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListTags", reflect.TypeOf((*MockTaskManager)(nil).UpdateTaskListTags), ctx, request)
+}
+
 // MockHistoryManager is a mock of HistoryManager interface
 type MockHistoryManager struct {
 	ctrl     *gomock.Controller
@@ -74,6 +74,14 @@ type (
 		// _do not_ exist in the database. They are therefore unreachable and no longer represent valid items
 		// that can be legitimately acted upon.
 		GetOrphanTasks(ctx context.Context, request *GetOrphanTasksRequest) (*GetOrphanTasksResponse, error)
+		// GetTaskListUsage returns the number of tasks and bytes stored for a task list, including tasks
+		// that are acked but not yet deleted. Stores that cannot count cheaply may return an estimate.
+		GetTaskListUsage(ctx context.Context, request *GetTaskListUsageRequest) (*GetTaskListUsageResponse, error)
+		// GetTaskList returns the task list without taking its lease, or EntityNotExistsError if the task list does not exist
+		GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error)
+		// UpdateTaskListTags replaces the tags of the task list without taking its lease, or returns
+		// EntityNotExistsError if the task list does not exist
+		UpdateTaskListTags(ctx context.Context, request *UpdateTaskListTagsRequest) error
 	}
 
 	// DomainStore is a lower level of DomainManager
@@ -764,6 +772,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *DataBlob
+		AsyncWorkflowConfig      *DataBlob
 	}
 
 	// InternalCreateDomainRequest is used to create the domain
@@ -810,6 +819,7 @@ type (
 	InternalListDomainsResponse struct {
 		Domains       []*InternalGetDomainResponse
 		NextPageToken []byte
+		TotalCount    int64
 	}
 
 	// InternalShardInfo describes a shard
@@ -831,6 +841,7 @@ type (
 		ClusterReplicationLevel           map[string]int64     `json:"cluster_replication_level"`
 		DomainNotificationVersion         int64                `json:"domain_notification_version"`
 		PendingFailoverMarkers            *DataBlob            `json:"pending_failover_markers"`
+		DomainReplicationWatermarks       map[string]time.Time `json:"domain_replication_watermarks"`
 	}
 
 	// InternalCreateShardRequest is request to CreateShard
@@ -864,6 +875,7 @@ type (
 		ScheduleToStartTimeout time.Duration
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		AffinityKey            string
 	}
 
 	// InternalCreateTasksInfo describes a task to be created in InternalCreateTasksRequest
@@ -129,11 +129,15 @@ type (
 		DomainUUID string
 		Domain     string // domain name is not persisted, but used as config filter key
 		Query      string
+		// optional search attribute to also count the executions per value of
+		GroupBy string
 	}
 
 	// CountWorkflowExecutionsResponse is response to CountWorkflowExecutions
 	CountWorkflowExecutionsResponse struct {
 		Count int64
+		// set when the request is grouped, ordered by descending count
+		Groups []*types.CountWorkflowExecutionsGroup
 	}
 
 	// ListWorkflowExecutionsByTypeRequest is used to list executions of
@@ -177,6 +177,7 @@ func (m *domainManagerImpl) ListDomains(
 	return &ListDomainsResponse{
 		Domains:       domains,
 		NextPageToken: resp.NextPageToken,
+		TotalCount:    resp.TotalCount,
 	}, nil
 }
 
@@ -191,6 +192,10 @@ func (m *domainManagerImpl) toInternalDomainConfig(c *DomainConfig) (InternalDom
 	if err != nil {
 		return InternalDomainConfig{}, err
 	}
+	asyncWorkflowConfig, err := m.serializer.SerializeAsyncWorkflowsConfig(&c.AsyncWorkflowConfig, common.EncodingTypeThriftRW)
+	if err != nil {
+		return InternalDomainConfig{}, err
+	}
 	return InternalDomainConfig{
 		Retention:                common.DaysToDuration(c.Retention),
 		EmitMetric:               c.EmitMetric,
@@ -199,6 +204,7 @@ func (m *domainManagerImpl) toInternalDomainConfig(c *DomainConfig) (InternalDom
 		VisibilityArchivalStatus: c.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    c.VisibilityArchivalURI,
 		BadBinaries:              badBinaries,
+		AsyncWorkflowConfig:      asyncWorkflowConfig,
 	}, nil
 }
 
@@ -213,6 +219,10 @@ func (m *domainManagerImpl) fromInternalDomainConfig(ic *InternalDomainConfig) (
 	if badBinaries.Binaries == nil {
 		badBinaries.Binaries = map[string]*types.BadBinaryInfo{}
 	}
+	asyncWorkflowConfig, err := m.serializer.DeserializeAsyncWorkflowsConfig(ic.AsyncWorkflowConfig)
+	if err != nil {
+		return DomainConfig{}, err
+	}
 	return DomainConfig{
 		Retention:                common.DurationToDays(ic.Retention),
 		EmitMetric:               ic.EmitMetric,
@@ -221,6 +231,7 @@ func (m *domainManagerImpl) fromInternalDomainConfig(ic *InternalDomainConfig) (
 		VisibilityArchivalStatus: ic.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    ic.VisibilityArchivalURI,
 		BadBinaries:              *badBinaries,
+		AsyncWorkflowConfig:      *asyncWorkflowConfig,
 	}, nil
 }
 
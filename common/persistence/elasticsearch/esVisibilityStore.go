@@ -442,6 +442,10 @@ func (v *esVisibilityStore) CountWorkflowExecutions(
 ) (
 	*p.CountWorkflowExecutionsResponse, error) {
 
+	if request.GroupBy != "" {
+		return v.countWorkflowExecutionsByGroup(ctx, request)
+	}
+
 	queryDSL, err := getESQueryDSLForCount(request)
 	if err != nil {
 		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
@@ -458,6 +462,87 @@ func (v *esVisibilityStore) CountWorkflowExecutions(
 	return response, nil
 }
 
+func (v *esVisibilityStore) countWorkflowExecutionsByGroup(
+	ctx context.Context,
+	request *p.CountWorkflowExecutionsRequest,
+) (*p.CountWorkflowExecutionsResponse, error) {
+
+	if !countGroupByKeys[request.GroupBy] {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf(
+			"Cannot group by %v, only %v, %v and %v are supported.", request.GroupBy, es.CloseStatus, es.WorkflowType, es.TaskList,
+		)}
+	}
+	queryDSL, err := getESQueryDSLForCountByGroup(request)
+	if err != nil {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Error when parse query: %v", err)}
+	}
+
+	resp, err := v.esClient.SearchRaw(ctx, v.index, queryDSL)
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("CountWorkflowExecutions failed. Error: %v", err),
+		}
+	}
+	groups, count, err := parseCountGroups(request.GroupBy, resp.Aggregations[countGroupByAggName])
+	if err != nil {
+		return nil, &types.InternalServiceError{
+			Message: fmt.Sprintf("CountWorkflowExecutions failed to parse groups. Error: %v", err),
+		}
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count, Groups: groups}, nil
+}
+
+// getESQueryDSLForCountByGroup returns the count query with a terms aggregation on the group by key.
+// Executions without the key, e.g. open workflows when grouping by CloseStatus, are counted in their own bucket
+func getESQueryDSLForCountByGroup(request *p.CountWorkflowExecutionsRequest) (string, error) {
+	queryDSL, err := getESQueryDSLForCount(request)
+	if err != nil {
+		return "", err
+	}
+	dsl := fastjson.MustParse(queryDSL)
+	missing := `""`
+	if request.GroupBy == es.CloseStatus {
+		missing = countGroupMissingCloseStatus
+	}
+	dsl.Set(dslFieldSize, fastjson.MustParse("0"))
+	dsl.Set("aggs", fastjson.MustParse(fmt.Sprintf(
+		`{"%v":{"terms":{"field":"%v","size":%v,"missing":%v}}}`, countGroupByAggName, request.GroupBy, countGroupByMaxGroups, missing,
+	)))
+	return dsl.String(), nil
+}
+
+func parseCountGroups(groupBy string, aggregation json.RawMessage) ([]*types.CountWorkflowExecutionsGroup, int64, error) {
+	var terms struct {
+		Buckets []struct {
+			Key      interface{} `json:"key"`
+			DocCount int64       `json:"doc_count"`
+		} `json:"buckets"`
+		SumOtherDocCount int64 `json:"sum_other_doc_count"`
+	}
+	if len(aggregation) == 0 {
+		return nil, 0, errors.New("aggregation not found in the response")
+	}
+	if err := json.Unmarshal(aggregation, &terms); err != nil {
+		return nil, 0, err
+	}
+
+	count := terms.SumOtherDocCount
+	groups := make([]*types.CountWorkflowExecutionsGroup, 0, len(terms.Buckets))
+	for _, bucket := range terms.Buckets {
+		count += bucket.DocCount
+		value := fmt.Sprint(bucket.Key)
+		if groupBy == es.CloseStatus {
+			if value == countGroupMissingCloseStatus {
+				value = ""
+			} else if status, err := strconv.Atoi(value); err == nil {
+				value = types.WorkflowExecutionCloseStatus(status).String()
+			}
+		}
+		groups = append(groups, &types.CountWorkflowExecutionsGroup{Value: value, Count: bucket.DocCount})
+	}
+	return groups, count, nil
+}
+
 const (
 	jsonMissingCloseTime     = `{"missing":{"field":"CloseTime"}}`
 	jsonRangeOnExecutionTime = `{"range":{"ExecutionTime":`
@@ -470,9 +555,20 @@ const (
 	dslFieldSize        = "size"
 
 	defaultDateTimeFormat = time.RFC3339 // used for converting UnixNano to string like 2018-02-15T16:16:36-08:00
+
+	countGroupByAggName = "groupby"
+	// the number of groups returned when counting by group, executions in the other groups are only part of the total
+	countGroupByMaxGroups = 1000
+	// CloseStatus of the executions without one, the close status values start at 0
+	countGroupMissingCloseStatus = "-1"
 )
 
 var (
+	countGroupByKeys = map[string]bool{
+		es.CloseStatus:  true,
+		es.WorkflowType: true,
+		es.TaskList:     true,
+	}
 	timeKeys = map[string]bool{
 		es.StartTime:     true,
 		es.CloseTime:     true,
@@ -826,6 +826,58 @@ func (s *ESVisibilitySuite) TestCountWorkflowExecutions() {
 	s.True(strings.Contains(err.Error(), "Error when parse query"))
 }
 
+func (s *ESVisibilitySuite) TestCountWorkflowExecutions_GroupBy() {
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, `"size":0`) &&
+			strings.Contains(input, `"aggs":{"groupby":{"terms":{"field":"CloseStatus","size":1000,"missing":-1}}}`)
+	})).Return(&es.RawResponse{
+		Aggregations: map[string]json.RawMessage{
+			"groupby": json.RawMessage(`{"sum_other_doc_count":1,"buckets":[{"key":0,"doc_count":5},{"key":-1,"doc_count":3}]}`),
+		},
+	}, nil).Once()
+
+	request := &p.CountWorkflowExecutionsRequest{
+		DomainUUID: testDomainID,
+		Domain:     testDomain,
+		Query:      `WorkflowType = 'wtype'`,
+		GroupBy:    es.CloseStatus,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	resp, err := s.visibilityStore.CountWorkflowExecutions(ctx, request)
+	s.NoError(err)
+	s.Equal(int64(9), resp.Count)
+	s.Equal([]*types.CountWorkflowExecutionsGroup{
+		{Value: types.WorkflowExecutionCloseStatusCompleted.String(), Count: 5},
+		{Value: "", Count: 3},
+	}, resp.Groups)
+
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.MatchedBy(func(input string) bool {
+		return strings.Contains(input, `"aggs":{"groupby":{"terms":{"field":"WorkflowType","size":1000,"missing":""}}}`)
+	})).Return(&es.RawResponse{
+		Aggregations: map[string]json.RawMessage{
+			"groupby": json.RawMessage(`{"sum_other_doc_count":0,"buckets":[{"key":"wtype","doc_count":2}]}`),
+		},
+	}, nil).Once()
+	request.GroupBy = es.WorkflowType
+	resp, err = s.visibilityStore.CountWorkflowExecutions(ctx, request)
+	s.NoError(err)
+	s.Equal(int64(2), resp.Count)
+	s.Equal([]*types.CountWorkflowExecutionsGroup{{Value: "wtype", Count: 2}}, resp.Groups)
+
+	// test internal error
+	s.mockESClient.On("SearchRaw", mock.Anything, testIndex, mock.Anything).Return(nil, errTestESSearch).Once()
+	_, err = s.visibilityStore.CountWorkflowExecutions(ctx, request)
+	s.IsType(&types.InternalServiceError{}, err)
+
+	// test bad request
+	request.GroupBy = es.RunID
+	_, err = s.visibilityStore.CountWorkflowExecutions(ctx, request)
+	s.IsType(&types.BadRequestError{}, err)
+}
+
 func (s *ESVisibilitySuite) TestTimeProcessFunc() {
 	cases := []struct {
 		key   string
@@ -204,6 +204,10 @@ func (m *nosqlDomainStore) ListDomains(
 	if err != nil {
 		return nil, convertCommonErrors(m.db, "ListDomains", err)
 	}
+	totalCount, err := m.db.CountAllDomains(ctx)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "ListDomains", err)
+	}
 	var domains []*p.InternalGetDomainResponse
 	for _, row := range rows {
 		if row.Info.Data == nil {
@@ -237,6 +241,7 @@ func (m *nosqlDomainStore) ListDomains(
 	return &p.InternalListDomainsResponse{
 		Domains:       domains,
 		NextPageToken: nextPageToken,
+		TotalCount:    totalCount,
 	}, nil
 }
 
@@ -285,6 +290,7 @@ func (m *nosqlDomainStore) toNoSQLInternalDomainConfig(
 		VisibilityArchivalStatus: domainConfig.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    domainConfig.VisibilityArchivalURI,
 		BadBinaries:              domainConfig.BadBinaries,
+		AsyncWorkflowConfig:      domainConfig.AsyncWorkflowConfig,
 	}, nil
 }
 
@@ -301,5 +307,6 @@ func (m *nosqlDomainStore) fromNoSQLInternalDomainConfig(
 		VisibilityArchivalStatus: domainConfig.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    domainConfig.VisibilityArchivalURI,
 		BadBinaries:              domainConfig.BadBinaries,
+		AsyncWorkflowConfig:      domainConfig.AsyncWorkflowConfig,
 	}, nil
 }
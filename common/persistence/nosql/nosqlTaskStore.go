@@ -45,6 +45,10 @@ const (
 	initialRangeID    = 1 // Id of the first range of a new task list
 	initialAckLevel   = 0
 	stickyTaskListTTL = int64(24 * time.Hour / time.Second) // if sticky task_list stopped being updated, remove it in one day
+
+	taskListUsageBatchSize = 1000
+	taskListUsageMaxScan   = 100 * taskListUsageBatchSize // tasks read before GetTaskListUsage stops counting
+	taskRowFixedSize       = 4 * 8                        // task_id, task_type, schedule_id and created_time columns
 )
 
 var _ p.TaskStore = (*nosqlTaskStore)(nil)
@@ -74,6 +78,38 @@ func (t *nosqlTaskStore) GetOrphanTasks(ctx context.Context, request *p.GetOrpha
 	}
 }
 
+// GetTaskListUsage pages through the tasks of a task list to count them. NoSQL stores do not expose the
+// stored size of a row, so the bytes are estimated from the task fields and the result is always marked
+// as estimated. Counting stops after taskListUsageMaxScan tasks, in which case the count is a lower bound.
+func (t *nosqlTaskStore) GetTaskListUsage(ctx context.Context, request *p.GetTaskListUsageRequest) (*p.GetTaskListUsageResponse, error) {
+	response := &p.GetTaskListUsageResponse{Estimated: true}
+	minTaskID := int64(math.MinInt64)
+	for response.TaskCount < taskListUsageMaxScan {
+		rows, err := t.db.SelectTasks(ctx, &nosqlplugin.TasksFilter{
+			TaskListFilter: nosqlplugin.TaskListFilter{
+				DomainID:     request.DomainID,
+				TaskListName: request.TaskListName,
+				TaskListType: request.TaskListType,
+			},
+			BatchSize: taskListUsageBatchSize,
+			MinTaskID: minTaskID,
+			MaxTaskID: math.MaxInt64,
+		})
+		if err != nil {
+			return nil, convertCommonErrors(t.db, "GetTaskListUsage", err)
+		}
+		for _, row := range rows {
+			response.TaskCount++
+			response.SizeBytes += int64(len(row.DomainID)+len(row.TaskListName)+len(row.WorkflowID)+len(row.RunID)) + taskRowFixedSize
+		}
+		if len(rows) < taskListUsageBatchSize {
+			break
+		}
+		minTaskID = rows[len(rows)-1].TaskID
+	}
+	return response, nil
+}
+
 func (t *nosqlTaskStore) LeaseTaskList(
 	ctx context.Context,
 	request *p.LeaseTaskListRequest,
@@ -149,10 +185,62 @@ func (t *nosqlTaskStore) LeaseTaskList(
 		AckLevel:    currTL.AckLevel,
 		Kind:        request.TaskListKind,
 		LastUpdated: now,
+		Tags:        currTL.Tags,
 	}
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
 
+func (t *nosqlTaskStore) GetTaskList(
+	ctx context.Context,
+	request *p.GetTaskListRequest,
+) (*p.GetTaskListResponse, error) {
+	row, err := t.db.SelectTaskList(ctx, &nosqlplugin.TaskListFilter{
+		DomainID:     request.DomainID,
+		TaskListName: request.TaskList,
+		TaskListType: request.TaskType,
+	})
+	if err != nil {
+		if t.db.IsNotFoundError(err) {
+			return nil, &types.EntityNotExistsError{
+				Message: fmt.Sprintf("task list %v of type %v does not exist", request.TaskList, request.TaskType),
+			}
+		}
+		return nil, convertCommonErrors(t.db, "GetTaskList", err)
+	}
+	return &p.GetTaskListResponse{
+		TaskListInfo: &p.TaskListInfo{
+			DomainID:    request.DomainID,
+			Name:        request.TaskList,
+			TaskType:    request.TaskType,
+			RangeID:     row.RangeID,
+			AckLevel:    row.AckLevel,
+			Kind:        row.TaskListKind,
+			LastUpdated: row.LastUpdatedTime,
+			Tags:        row.Tags,
+		},
+	}, nil
+}
+
+func (t *nosqlTaskStore) UpdateTaskListTags(
+	ctx context.Context,
+	request *p.UpdateTaskListTagsRequest,
+) error {
+	err := t.db.UpdateTaskListTags(ctx, &nosqlplugin.TaskListFilter{
+		DomainID:     request.DomainID,
+		TaskListName: request.TaskList,
+		TaskListType: request.TaskType,
+	}, request.Tags)
+	if err != nil {
+		if _, ok := err.(*nosqlplugin.ConditionFailure); ok {
+			return &types.EntityNotExistsError{
+				Message: fmt.Sprintf("task list %v of type %v does not exist", request.TaskList, request.TaskType),
+			}
+		}
+		return convertCommonErrors(t.db, "UpdateTaskListTags", err)
+	}
+	return nil
+}
+
 func (t *nosqlTaskStore) UpdateTaskList(
 	ctx context.Context,
 	request *p.UpdateTaskListRequest,
@@ -238,6 +326,7 @@ func (t *nosqlTaskStore) CreateTasks(
 			RunID:        t.Execution.GetRunID(),
 			ScheduledID:  t.Data.ScheduleID,
 			CreatedTime:  now,
+			AffinityKey:  t.Data.AffinityKey,
 		}
 		ttl := int(t.Data.ScheduleToStartTimeout.Seconds())
 		tasks = append(tasks, &nosqlplugin.TaskRowForInsert{
@@ -318,6 +407,7 @@ func toTaskInfo(t *nosqlplugin.TaskRow) *p.InternalTaskInfo {
 		TaskID:      t.TaskID,
 		ScheduleID:  t.ScheduledID,
 		CreatedTime: t.CreatedTime,
+		AffinityKey: t.AffinityKey,
 	}
 }
 
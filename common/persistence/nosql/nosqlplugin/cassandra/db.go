@@ -33,17 +33,19 @@ type cdb struct {
 	client  gocql.Client
 	session gocql.Session
 	cfg     *config.NoSQL
+	dlqMode replicationDLQMode
 }
 
 var _ nosqlplugin.DB = (*cdb)(nil)
 
 // newCassandraDBFromSession returns a DB from a session
-func newCassandraDBFromSession(cfg *config.NoSQL, session gocql.Session, logger log.Logger) *cdb {
+func newCassandraDBFromSession(cfg *config.NoSQL, session gocql.Session, logger log.Logger, dlqMode replicationDLQMode) *cdb {
 	return &cdb{
 		client:  gocql.GetRegisteredClient(),
 		session: session,
 		logger:  logger,
 		cfg:     cfg,
+		dlqMode: dlqMode,
 	}
 }
 
@@ -59,7 +59,9 @@ const (
 		`visibility_archival_status: ?, ` +
 		`visibility_archival_uri: ?, ` +
 		`bad_binaries: ?,` +
-		`bad_binaries_encoding: ?` +
+		`bad_binaries_encoding: ?,` +
+		`async_workflow_config: ?,` +
+		`async_workflow_config_encoding: ?` +
 		`}`
 
 	templateDomainReplicationConfigType = `{` +
@@ -88,6 +90,7 @@ const (
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
 		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.async_workflow_config, config.async_workflow_config_encoding, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -136,6 +139,7 @@ const (
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
 		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.async_workflow_config, config.async_workflow_config_encoding, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -145,6 +149,12 @@ const (
 		`failover_end_time, ` +
 		`last_updated_time, ` +
 		`notification_version ` +
+		`FROM domains_by_name_v2 ` +
+		`WHERE domains_partition = ? ` +
+		`and name > ? ` +
+		`LIMIT ?`
+
+	templateListDomainNamesQueryV2 = `SELECT name ` +
 		`FROM domains_by_name_v2 ` +
 		`WHERE domains_partition = ? `
 )
@@ -194,6 +204,8 @@ func (db *cdb) InsertDomain(
 		row.Config.VisibilityArchivalURI,
 		row.Config.BadBinaries.Data,
 		string(row.Config.BadBinaries.Encoding),
+		row.Config.AsyncWorkflowConfig.Data,
+		string(row.Config.AsyncWorkflowConfig.Encoding),
 		row.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
 		row.IsGlobalDomain,
@@ -292,6 +304,8 @@ func (db *cdb) UpdateDomain(
 		row.Config.VisibilityArchivalURI,
 		row.Config.BadBinaries.Data,
 		string(row.Config.BadBinaries.Encoding),
+		row.Config.AsyncWorkflowConfig.Data,
+		string(row.Config.AsyncWorkflowConfig.Encoding),
 		row.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(row.ReplicationConfig.Clusters),
 		row.ConfigVersion,
@@ -352,6 +366,8 @@ func (db *cdb) SelectDomain(
 	// because of encoding/types, we can't directly read from config struct
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
+	var asyncWorkflowConfigData []byte
+	var asyncWorkflowConfigEncoding string
 	var replicationClusters []map[string]interface{}
 
 	var failoverNotificationVersion int64
@@ -382,6 +398,8 @@ func (db *cdb) SelectDomain(
 		&config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&asyncWorkflowConfigData,
+		&asyncWorkflowConfigEncoding,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&isGlobalDomain,
@@ -399,6 +417,7 @@ func (db *cdb) SelectDomain(
 	}
 
 	config.BadBinaries = p.NewDataBlob(badBinariesData, common.EncodingType(badBinariesDataEncoding))
+	config.AsyncWorkflowConfig = p.NewDataBlob(asyncWorkflowConfigData, common.EncodingType(asyncWorkflowConfigEncoding))
 	config.Retention = common.DaysToDuration(retentionDays)
 	replicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
 
@@ -427,8 +446,10 @@ func (db *cdb) SelectAllDomains(
 	pageSize int,
 	pageToken []byte,
 ) ([]*nosqlplugin.DomainRow, []byte, error) {
-	query := db.session.Query(templateListDomainQueryV2, constDomainPartition).WithContext(ctx)
-	iter := query.PageSize(pageSize).PageState(pageToken).Iter()
+	// page by the immutable domain name instead of the driver paging state,
+	// so the token stays valid while domains are registered and deleted
+	query := db.session.Query(templateListDomainQueryV2, constDomainPartition, string(pageToken), pageSize).WithContext(ctx)
+	iter := query.Iter()
 	if iter == nil {
 		return nil, nil, &types.InternalServiceError{
 			Message: "SelectAllDomains operation failed.  Not able to create query iterator.",
@@ -444,10 +465,14 @@ func (db *cdb) SelectAllDomains(
 	var replicationClusters []map[string]interface{}
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
+	var asyncWorkflowConfigData []byte
+	var asyncWorkflowConfigEncoding string
 	var retentionDays int32
 	var failoverEndTime int64
 	var lastUpdateTime int64
 	var rows []*nosqlplugin.DomainRow
+	var lastName string
+	scanned := 0
 	for iter.Scan(
 		&name,
 		&domain.Info.ID,
@@ -466,6 +491,8 @@ func (db *cdb) SelectAllDomains(
 		&domain.Config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&asyncWorkflowConfigData,
+		&asyncWorkflowConfigEncoding,
 		&domain.ReplicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&domain.IsGlobalDomain,
@@ -477,9 +504,12 @@ func (db *cdb) SelectAllDomains(
 		&lastUpdateTime,
 		&domain.NotificationVersion,
 	) {
+		lastName = name
+		scanned++
 		if name != domainMetadataRecordName {
 			// do not include the metadata record
 			domain.Config.BadBinaries = p.NewDataBlob(badBinariesData, common.EncodingType(badBinariesDataEncoding))
+			domain.Config.AsyncWorkflowConfig = p.NewDataBlob(asyncWorkflowConfigData, common.EncodingType(asyncWorkflowConfigEncoding))
 			domain.ReplicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
 			domain.Config.Retention = common.DaysToDuration(retentionDays)
 			domain.LastUpdatedTime = time.Unix(0, lastUpdateTime)
@@ -491,6 +521,8 @@ func (db *cdb) SelectAllDomains(
 		replicationClusters = []map[string]interface{}{}
 		badBinariesData = []byte("")
 		badBinariesDataEncoding = ""
+		asyncWorkflowConfigData = []byte("")
+		asyncWorkflowConfigEncoding = ""
 		failoverEndTime = 0
 		lastUpdateTime = 0
 		retentionDays = 0
@@ -501,13 +533,40 @@ func (db *cdb) SelectAllDomains(
 		}
 	}
 
-	nextPageToken := iter.PageState()
 	if err := iter.Close(); err != nil {
 		return nil, nil, err
 	}
+	var nextPageToken []byte
+	if scanned >= pageSize {
+		nextPageToken = []byte(lastName)
+	}
 	return rows, nextPageToken, nil
 }
 
+// Count all domains
+func (db *cdb) CountAllDomains(
+	ctx context.Context,
+) (int64, error) {
+	iter := db.session.Query(templateListDomainNamesQueryV2, constDomainPartition).WithContext(ctx).Iter()
+	if iter == nil {
+		return 0, &types.InternalServiceError{
+			Message: "CountAllDomains operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	var name string
+	var count int64
+	for iter.Scan(&name) {
+		if name != domainMetadataRecordName {
+			count++
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
 //  Delete a domain, either by domainID or domainName
 func (db *cdb) DeleteDomain(
 	ctx context.Context,
@@ -64,11 +64,15 @@ func (p *plugin) CreateAdminDB(cfg *config.NoSQL, logger log.Logger) (nosqlplugi
 }
 
 func (p *plugin) doCreateDB(cfg *config.NoSQL, logger log.Logger) (*cdb, error) {
+	dlqMode, err := parseReplicationDLQMode(cfg.ConnectAttributes)
+	if err != nil {
+		return nil, err
+	}
 	session, err := gocql.GetRegisteredClient().CreateSession(toGoCqlConfig(cfg))
 	if err != nil {
 		return nil, err
 	}
-	db := newCassandraDBFromSession(cfg, session, logger)
+	db := newCassandraDBFromSession(cfg, session, logger, dlqMode)
 	return db, nil
 }
 
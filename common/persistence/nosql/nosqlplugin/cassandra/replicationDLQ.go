@@ -0,0 +1,333 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"fmt"
+
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
+)
+
+// replicationDLQModeAttribute is the NoSQL connect attribute used to select where replication DLQ tasks are stored
+const replicationDLQModeAttribute = "replicationDLQMode"
+
+type replicationDLQMode string
+
+const (
+	// replicationDLQModeLegacy stores DLQ tasks in the executions table, next to the replication tasks
+	replicationDLQModeLegacy replicationDLQMode = "legacy"
+	// replicationDLQModeMigrate writes DLQ tasks to the replication_dlq_tasks table,
+	// while reads and deletes also cover tasks still left in the executions table
+	replicationDLQModeMigrate replicationDLQMode = "migrate"
+	// replicationDLQModeDedicated only uses the replication_dlq_tasks table
+	replicationDLQModeDedicated replicationDLQMode = "dedicated"
+)
+
+const (
+	// page token prefixes used in migrate mode to tell which table the next page should come from
+	dlqPageTokenLegacy    byte = 0
+	dlqPageTokenDedicated byte = 1
+)
+
+const (
+	templateCreateReplicationDLQTaskQuery = `INSERT INTO replication_dlq_tasks (` +
+		`shard_id, source_cluster, task_id, replication) ` +
+		`VALUES(?, ?, ?, ` + templateReplicationTaskType + `)`
+
+	templateGetReplicationDLQTasksQuery = `SELECT replication ` +
+		`FROM replication_dlq_tasks ` +
+		`WHERE shard_id = ? ` +
+		`and source_cluster = ? ` +
+		`and task_id > ? ` +
+		`and task_id <= ?`
+
+	templateGetReplicationDLQSizeQuery = `SELECT count(1) as count ` +
+		`FROM replication_dlq_tasks ` +
+		`WHERE shard_id = ? ` +
+		`and source_cluster = ?`
+
+	templateDeleteReplicationDLQTaskQuery = `DELETE FROM replication_dlq_tasks ` +
+		`WHERE shard_id = ? ` +
+		`and source_cluster = ? ` +
+		`and task_id = ?`
+
+	templateRangeDeleteReplicationDLQTasksQuery = `DELETE FROM replication_dlq_tasks ` +
+		`WHERE shard_id = ? ` +
+		`and source_cluster = ? ` +
+		`and task_id > ? ` +
+		`and task_id <= ?`
+)
+
+func parseReplicationDLQMode(attributes map[string]string) (replicationDLQMode, error) {
+	switch mode := replicationDLQMode(attributes[replicationDLQModeAttribute]); mode {
+	case "":
+		return replicationDLQModeLegacy, nil
+	case replicationDLQModeLegacy, replicationDLQModeMigrate, replicationDLQModeDedicated:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown %v: %q, supported values are %q, %q and %q",
+			replicationDLQModeAttribute, mode, replicationDLQModeLegacy, replicationDLQModeMigrate, replicationDLQModeDedicated)
+	}
+}
+
+func (db *cdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
+	if db.dlqMode == replicationDLQModeLegacy {
+		return db.insertLegacyReplicationDLQTask(ctx, shardID, sourceCluster, task)
+	}
+
+	query := db.session.Query(templateCreateReplicationDLQTaskQuery,
+		shardID,
+		sourceCluster,
+		task.TaskID,
+		task.DomainID,
+		task.WorkflowID,
+		task.RunID,
+		task.TaskID,
+		task.TaskType,
+		task.FirstEventID,
+		task.NextEventID,
+		task.Version,
+		task.ScheduledID,
+		p.EventStoreVersion,
+		task.BranchToken,
+		p.EventStoreVersion,
+		task.NewRunBranchToken,
+		task.CreationTime.UnixNano(),
+	).WithContext(ctx)
+
+	return query.Exec()
+}
+
+func (db *cdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	switch db.dlqMode {
+	case replicationDLQModeLegacy:
+		return db.selectLegacyReplicationDLQTasks(ctx, shardID, sourceCluster, pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	case replicationDLQModeDedicated:
+		return db.selectDedicatedReplicationDLQTasks(ctx, shardID, sourceCluster, pageSize, pageToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+	}
+
+	// In migrate mode the legacy rows are drained first. They were all written before the switch,
+	// so they have smaller task IDs than anything in the dedicated table and ordering is preserved.
+	if len(pageToken) == 0 || pageToken[0] == dlqPageTokenLegacy {
+		var legacyToken []byte
+		if len(pageToken) > 0 {
+			legacyToken = pageToken[1:]
+		}
+		tasks, nextToken, err := db.selectLegacyReplicationDLQTasks(ctx, shardID, sourceCluster, pageSize, legacyToken, exclusiveMinTaskID, inclusiveMaxTaskID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(nextToken) > 0 {
+			return tasks, append([]byte{dlqPageTokenLegacy}, nextToken...), nil
+		}
+		if len(tasks) > 0 {
+			return tasks, []byte{dlqPageTokenDedicated}, nil
+		}
+		pageToken = []byte{dlqPageTokenDedicated}
+	}
+
+	tasks, nextToken, err := db.selectDedicatedReplicationDLQTasks(ctx, shardID, sourceCluster, pageSize, pageToken[1:], exclusiveMinTaskID, inclusiveMaxTaskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(nextToken) > 0 {
+		nextToken = append([]byte{dlqPageTokenDedicated}, nextToken...)
+	}
+	return tasks, nextToken, nil
+}
+
+func (db *cdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
+	switch db.dlqMode {
+	case replicationDLQModeLegacy:
+		return db.selectLegacyReplicationDLQTasksCount(ctx, shardID, sourceCluster)
+	case replicationDLQModeDedicated:
+		return db.selectDedicatedReplicationDLQTasksCount(ctx, shardID, sourceCluster)
+	}
+
+	legacyCount, err := db.selectLegacyReplicationDLQTasksCount(ctx, shardID, sourceCluster)
+	if err != nil {
+		return -1, err
+	}
+	dedicatedCount, err := db.selectDedicatedReplicationDLQTasksCount(ctx, shardID, sourceCluster)
+	if err != nil {
+		return -1, err
+	}
+	return legacyCount + dedicatedCount, nil
+}
+
+func (db *cdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
+	if db.dlqMode != replicationDLQModeDedicated {
+		if err := db.deleteLegacyReplicationDLQTask(ctx, shardID, sourceCluster, taskID); err != nil {
+			return err
+		}
+	}
+	if db.dlqMode == replicationDLQModeLegacy {
+		return nil
+	}
+
+	query := db.session.Query(templateDeleteReplicationDLQTaskQuery,
+		shardID,
+		sourceCluster,
+		taskID,
+	).WithContext(ctx)
+
+	return query.Exec()
+}
+
+func (db *cdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	if db.dlqMode != replicationDLQModeDedicated {
+		if err := db.rangeDeleteLegacyReplicationDLQTasks(ctx, shardID, sourceCluster, exclusiveBeginTaskID, inclusiveEndTaskID); err != nil {
+			return err
+		}
+	}
+	if db.dlqMode == replicationDLQModeLegacy {
+		return nil
+	}
+
+	query := db.session.Query(templateRangeDeleteReplicationDLQTasksQuery,
+		shardID,
+		sourceCluster,
+		exclusiveBeginTaskID,
+		inclusiveEndTaskID,
+	).WithContext(ctx)
+
+	return query.Exec()
+}
+
+func (db *cdb) selectDedicatedReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	query := db.session.Query(templateGetReplicationDLQTasksQuery,
+		shardID,
+		sourceCluster,
+		exclusiveMinTaskID,
+		inclusiveMaxTaskID,
+	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+
+	return populateGetReplicationTasks(query)
+}
+
+func (db *cdb) selectDedicatedReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
+	query := db.session.Query(templateGetReplicationDLQSizeQuery,
+		shardID,
+		sourceCluster,
+	).WithContext(ctx)
+
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		return -1, err
+	}
+
+	return result["count"].(int64), nil
+}
+
+func (db *cdb) insertLegacyReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
+	// Use source cluster name as the workflow id for replication dlq
+	query := db.session.Query(templateCreateReplicationTaskQuery,
+		shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		sourceCluster,
+		rowTypeDLQRunID,
+		task.DomainID,
+		task.WorkflowID,
+		task.RunID,
+		task.TaskID,
+		task.TaskType,
+		task.FirstEventID,
+		task.NextEventID,
+		task.Version,
+		task.ScheduledID,
+		p.EventStoreVersion,
+		task.BranchToken,
+		p.EventStoreVersion,
+		task.NewRunBranchToken,
+		task.CreationTime.UnixNano(),
+		defaultVisibilityTimestamp,
+		task.TaskID,
+	).WithContext(ctx)
+
+	return query.Exec()
+}
+
+func (db *cdb) selectLegacyReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
+	// Reading replication tasks need to be quorum level consistent, otherwise we could loose task
+	query := db.session.Query(templateGetReplicationTasksQuery,
+		shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		sourceCluster,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		exclusiveMinTaskID,
+		inclusiveMaxTaskID,
+	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
+
+	return populateGetReplicationTasks(query)
+}
+
+func (db *cdb) selectLegacyReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
+	// Reading replication tasks need to be quorum level consistent, otherwise we could loose task
+	query := db.session.Query(templateGetDLQSizeQuery,
+		shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		sourceCluster,
+		rowTypeDLQRunID,
+	).WithContext(ctx)
+
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		return -1, err
+	}
+
+	queueSize := result["count"].(int64)
+	return queueSize, nil
+}
+
+func (db *cdb) deleteLegacyReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
+	query := db.session.Query(templateCompleteReplicationTaskQuery,
+		shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		sourceCluster,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		taskID,
+	).WithContext(ctx)
+
+	return query.Exec()
+}
+
+func (db *cdb) rangeDeleteLegacyReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
+	query := db.session.Query(templateRangeCompleteReplicationTaskQuery,
+		shardID,
+		rowTypeDLQ,
+		rowTypeDLQDomainID,
+		sourceCluster,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		exclusiveBeginTaskID,
+		inclusiveEndTaskID,
+	).WithContext(ctx)
+
+	return query.Exec()
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cassandra
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+)
+
+func TestParseReplicationDLQMode(t *testing.T) {
+	mode, err := parseReplicationDLQMode(nil)
+	require.NoError(t, err)
+	assert.Equal(t, replicationDLQModeLegacy, mode)
+
+	for _, expected := range []replicationDLQMode{replicationDLQModeLegacy, replicationDLQModeMigrate, replicationDLQModeDedicated} {
+		mode, err = parseReplicationDLQMode(map[string]string{replicationDLQModeAttribute: string(expected)})
+		require.NoError(t, err)
+		assert.Equal(t, expected, mode)
+	}
+
+	_, err = parseReplicationDLQMode(map[string]string{replicationDLQModeAttribute: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestSelectReplicationDLQTasks_MigrateMode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := gocql.NewMockSession(ctrl)
+	db := &cdb{session: session, dlqMode: replicationDLQModeMigrate}
+
+	expectPage := func(template string, pageState []byte, nextPageState []byte) {
+		query := gocql.NewMockQuery(ctrl)
+		iter := gocql.NewMockIter(ctrl)
+		session.EXPECT().Query(template, gomock.Any()).Return(query)
+		query.EXPECT().PageSize(10).Return(query)
+		query.EXPECT().PageState(pageState).Return(query)
+		query.EXPECT().WithContext(gomock.Any()).Return(query)
+		query.EXPECT().Iter().Return(iter)
+		iter.EXPECT().MapScan(gomock.Any()).Return(false)
+		iter.EXPECT().PageState().Return(nextPageState)
+		iter.EXPECT().Close().Return(nil)
+	}
+
+	// legacy rows are paged first and the token remembers that
+	expectPage(templateGetReplicationTasksQuery, nil, []byte("legacy"))
+	_, token, err := db.SelectReplicationDLQTasksOrderByTaskID(context.Background(), 1, "standby", 10, nil, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte{dlqPageTokenLegacy}, "legacy"...), token)
+
+	// once legacy rows are drained, the same call moves on to the dedicated table
+	expectPage(templateGetReplicationTasksQuery, []byte("legacy"), []byte{})
+	expectPage(templateGetReplicationDLQTasksQuery, []byte{}, []byte("dedicated"))
+	_, token, err = db.SelectReplicationDLQTasksOrderByTaskID(context.Background(), 1, "standby", 10, token, 0, 100)
+	require.NoError(t, err)
+	assert.Equal(t, append([]byte{dlqPageTokenDedicated}, "dedicated"...), token)
+
+	// dedicated pages never go back to the legacy rows
+	expectPage(templateGetReplicationDLQTasksQuery, []byte("dedicated"), []byte{})
+	_, token, err = db.SelectReplicationDLQTasksOrderByTaskID(context.Background(), 1, "standby", 10, token, 0, 100)
+	require.NoError(t, err)
+	assert.Empty(t, token)
+}
@@ -53,7 +53,8 @@ const (
 		`cluster_replication_level: ?, ` +
 		`replication_dlq_ack_level: ?, ` +
 		`pending_failover_markers: ?, ` +
-		`pending_failover_markers_encoding: ? ` +
+		`pending_failover_markers_encoding: ?, ` +
+		`domain_replication_watermarks: ? ` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -130,6 +131,7 @@ func (db *cdb) InsertShard(ctx context.Context, row *nosqlplugin.ShardRow) error
 		row.ReplicationDLQAckLevel,
 		markerData,
 		markerEncoding,
+		row.DomainReplicationWatermarks,
 		row.RangeID,
 	).WithContext(ctx)
 
@@ -239,6 +241,8 @@ func convertToShardInfo(
 			pendingFailoverMarkersRawData = v.([]byte)
 		case "pending_failover_markers_encoding":
 			pendingFailoverMarkersEncoding = v.(string)
+		case "domain_replication_watermarks":
+			info.DomainReplicationWatermarks = v.(map[string]time.Time)
 		}
 	}
 
@@ -258,6 +262,9 @@ func convertToShardInfo(
 	if info.ReplicationDLQAckLevel == nil {
 		info.ReplicationDLQAckLevel = make(map[string]int64)
 	}
+	if info.DomainReplicationWatermarks == nil {
+		info.DomainReplicationWatermarks = make(map[string]time.Time)
+	}
 	info.PendingFailoverMarkers = persistence.NewDataBlob(
 		pendingFailoverMarkersRawData,
 		common.EncodingType(pendingFailoverMarkersEncoding),
@@ -337,6 +344,7 @@ func (db *cdb) UpdateShard(ctx context.Context, row *nosqlplugin.ShardRow, previ
 		row.ReplicationDLQAckLevel,
 		markerData,
 		markerEncoding,
+		row.DomainReplicationWatermarks,
 		row.RangeID,
 		row.ShardID,
 		rowTypeShard,
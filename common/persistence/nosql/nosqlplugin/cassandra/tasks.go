@@ -59,7 +59,8 @@ const (
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
-		`created_time: ? ` +
+		`created_time: ?, ` +
+		`affinity_key: ? ` +
 		`}`
 
 	templateCreateTaskQuery = `INSERT INTO tasks (` +
@@ -89,7 +90,8 @@ const (
 
 	templateGetTaskList = `SELECT ` +
 		`range_id, ` +
-		`task_list ` +
+		`task_list, ` +
+		`tags ` +
 		`FROM tasks ` +
 		`WHERE domain_id = ? ` +
 		`and task_list_name = ? ` +
@@ -135,6 +137,15 @@ const (
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
+	templateUpdateTaskListTagsQuery = `UPDATE tasks SET ` +
+		`tags = ? ` +
+		`WHERE domain_id = ? ` +
+		`and task_list_name = ? ` +
+		`and task_list_type = ? ` +
+		`and type = ? ` +
+		`and task_id = ? ` +
+		`IF EXISTS`
+
 	templateDeleteTaskListQuery = `DELETE FROM tasks ` +
 		`WHERE domain_id = ? ` +
 		`AND task_list_name = ? ` +
@@ -156,7 +167,8 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 	).WithContext(ctx)
 	var rangeID int64
 	var tlDB map[string]interface{}
-	err := query.Scan(&rangeID, &tlDB)
+	var tags map[string]string
+	err := query.Scan(&rangeID, &tlDB, &tags)
 	if err != nil {
 		return nil, err
 	}
@@ -173,6 +185,7 @@ func (db *cdb) SelectTaskList(ctx context.Context, filter *nosqlplugin.TaskListF
 		LastUpdatedTime: lastUpdatedTime,
 		AckLevel:        ackLevel,
 		RangeID:         rangeID,
+		Tags:            tags,
 	}, nil
 }
 
@@ -296,6 +309,29 @@ func (db *cdb) UpdateTaskListWithTTL(
 	return handleTaskListAppliedError(applied, previous)
 }
 
+// UpdateTaskListTags replaces the tags of a single tasklist row without changing the rest of it.
+// Tags are stored in their own column, so that UpdateTaskList keeps them
+// Return ConditionFailure if the row doesn't exist
+func (db *cdb) UpdateTaskListTags(ctx context.Context, filter *nosqlplugin.TaskListFilter, tags map[string]string) error {
+	query := db.session.Query(templateUpdateTaskListTagsQuery,
+		tags,
+		filter.DomainID,
+		filter.TaskListName,
+		filter.TaskListType,
+		rowTypeTaskList,
+		taskListTaskID,
+	).WithContext(ctx)
+
+	applied, err := query.MapScanCAS(make(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	if !applied {
+		return nosqlplugin.NewConditionFailure("tasklist")
+	}
+	return nil
+}
+
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *cdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
@@ -351,7 +387,8 @@ func (db *cdb) InsertTasks(
 				task.WorkflowID,
 				task.RunID,
 				scheduleID,
-				task.CreatedTime)
+				task.CreatedTime,
+				task.AffinityKey)
 		} else {
 			if ttl > maxCassandraTTL {
 				ttl = maxCassandraTTL
@@ -367,6 +404,7 @@ func (db *cdb) InsertTasks(
 				task.RunID,
 				scheduleID,
 				task.CreatedTime,
+				task.AffinityKey,
 				ttl)
 		}
 	}
@@ -454,6 +492,8 @@ func createTaskInfo(
 			info.ScheduledID = v.(int64)
 		case "created_time":
 			info.CreatedTime = v.(time.Time)
+		case "affinity_key":
+			info.AffinityKey = v.(string)
 		}
 	}
 
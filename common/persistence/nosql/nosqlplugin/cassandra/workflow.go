@@ -650,99 +650,6 @@ func (db *cdb) RangeDeleteCrossClusterTasks(ctx context.Context, shardID int, ta
 	return query.Exec()
 }
 
-func (db *cdb) InsertReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, task nosqlplugin.ReplicationTask) error {
-	// Use source cluster name as the workflow id for replication dlq
-	query := db.session.Query(templateCreateReplicationTaskQuery,
-		shardID,
-		rowTypeDLQ,
-		rowTypeDLQDomainID,
-		sourceCluster,
-		rowTypeDLQRunID,
-		task.DomainID,
-		task.WorkflowID,
-		task.RunID,
-		task.TaskID,
-		task.TaskType,
-		task.FirstEventID,
-		task.NextEventID,
-		task.Version,
-		task.ScheduledID,
-		p.EventStoreVersion,
-		task.BranchToken,
-		p.EventStoreVersion,
-		task.NewRunBranchToken,
-		defaultVisibilityTimestamp,
-		defaultVisibilityTimestamp,
-		task.TaskID,
-	).WithContext(ctx)
-
-	return query.Exec()
-}
-
-func (db *cdb) SelectReplicationDLQTasksOrderByTaskID(ctx context.Context, shardID int, sourceCluster string, pageSize int, pageToken []byte, exclusiveMinTaskID, inclusiveMaxTaskID int64) ([]*nosqlplugin.ReplicationTask, []byte, error) {
-	// Reading replication tasks need to be quorum level consistent, otherwise we could loose task
-	query := db.session.Query(templateGetReplicationTasksQuery,
-		shardID,
-		rowTypeDLQ,
-		rowTypeDLQDomainID,
-		sourceCluster,
-		rowTypeDLQRunID,
-		defaultVisibilityTimestamp,
-		exclusiveMinTaskID,
-		inclusiveMaxTaskID,
-	).PageSize(pageSize).PageState(pageToken).WithContext(ctx)
-
-	return populateGetReplicationTasks(query)
-}
-
-func (db *cdb) SelectReplicationDLQTasksCount(ctx context.Context, shardID int, sourceCluster string) (int64, error) {
-	// Reading replication tasks need to be quorum level consistent, otherwise we could loose task
-	query := db.session.Query(templateGetDLQSizeQuery,
-		shardID,
-		rowTypeDLQ,
-		rowTypeDLQDomainID,
-		sourceCluster,
-		rowTypeDLQRunID,
-	).WithContext(ctx)
-
-	result := make(map[string]interface{})
-	if err := query.MapScan(result); err != nil {
-		return -1, err
-	}
-
-	queueSize := result["count"].(int64)
-	return queueSize, nil
-}
-
-func (db *cdb) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
-	query := db.session.Query(templateCompleteReplicationTaskQuery,
-		shardID,
-		rowTypeDLQ,
-		rowTypeDLQDomainID,
-		sourceCluster,
-		rowTypeDLQRunID,
-		defaultVisibilityTimestamp,
-		taskID,
-	).WithContext(ctx)
-
-	return query.Exec()
-}
-
-func (db *cdb) RangeDeleteReplicationDLQTasks(ctx context.Context, shardID int, sourceCluster string, exclusiveBeginTaskID, inclusiveEndTaskID int64) error {
-	query := db.session.Query(templateRangeCompleteReplicationTaskQuery,
-		shardID,
-		rowTypeDLQ,
-		rowTypeDLQDomainID,
-		sourceCluster,
-		rowTypeDLQRunID,
-		defaultVisibilityTimestamp,
-		exclusiveBeginTaskID,
-		inclusiveEndTaskID,
-	).WithContext(ctx)
-
-	return query.Exec()
-}
-
 func (db *cdb) InsertReplicationTask(ctx context.Context, tasks []*nosqlplugin.ReplicationTask, shardCondition nosqlplugin.ShardCondition) error {
 	if len(tasks) == 0 {
 		return nil
@@ -61,6 +61,13 @@ func (db *ddb) SelectAllDomains(
 	panic("TODO")
 }
 
+// Count all domains
+func (db *ddb) CountAllDomains(
+	ctx context.Context,
+) (int64, error) {
+	panic("TODO")
+}
+
 //  Delete a domain, either by domainID or domainName
 func (db *ddb) DeleteDomain(
 	ctx context.Context,
@@ -177,8 +177,11 @@ type (
 		UpdateDomain(ctx context.Context, row *DomainRow) error
 		// Get one domain data, either by domainID or domainName
 		SelectDomain(ctx context.Context, domainID *string, domainName *string) (*DomainRow, error)
-		// Get all domain data
+		// Get all domain data, ordered by an immutable key of the domain. The page token embeds the last key
+		// returned, so domains created or deleted while paging do not shift the other domains between pages
 		SelectAllDomains(ctx context.Context, pageSize int, pageToken []byte) ([]*DomainRow, []byte, error)
+		// Count all domains
+		CountAllDomains(ctx context.Context) (int64, error)
 		//  Delete a domain, either by domainID or domainName
 		DeleteDomain(ctx context.Context, domainID *string, domainName *string) error
 		// right now domain metadata is just an integer as notification version
@@ -327,6 +330,10 @@ type (
 		// Ignore TTL if it's not supported, which becomes exactly the same as UpdateTaskList, but ListTaskList must be
 		// implemented for TaskListScavenger
 		UpdateTaskListWithTTL(ctx context.Context, ttlSeconds int64, row *TaskListRow, previousRangeID int64) error
+		// UpdateTaskListTags replaces the tags of a single tasklist row without changing the rest of it.
+		// Tags must be kept by UpdateTaskList and UpdateTaskListWithTTL
+		// Return ConditionFailure if the row doesn't exist
+		UpdateTaskListTags(ctx context.Context, filter *TaskListFilter, tags map[string]string) error
 		// ListTaskList returns all tasklists.
 		// Noop if TTL is already implemented in other methods
 		ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*ListTaskListResult, error)
@@ -175,6 +175,21 @@ func (mr *MockDBMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockDB)(nil).Close))
 }
 
+// CountAllDomains mocks base method.
+func (m *MockDB) CountAllDomains(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAllDomains", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAllDomains indicates an expected call of CountAllDomains.
+func (mr *MockDBMockRecorder) CountAllDomains(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllDomains", reflect.TypeOf((*MockDB)(nil).CountAllDomains), ctx)
+}
+
 // DeleteCrossClusterTask mocks base method.
 func (m *MockDB) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
 	m.ctrl.T.Helper()
@@ -1196,6 +1211,20 @@ func (mr *MockDBMockRecorder) UpdateTaskList(ctx, row, previousRangeID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskList", reflect.TypeOf((*MockDB)(nil).UpdateTaskList), ctx, row, previousRangeID)
 }
 
+// UpdateTaskListTags mocks base method.
+func (m *MockDB) UpdateTaskListTags(ctx context.Context, filter *TaskListFilter, tags map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListTags", ctx, filter, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskListTags indicates an expected call of UpdateTaskListTags.
+func (mr *MockDBMockRecorder) UpdateTaskListTags(ctx, filter, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListTags", reflect.TypeOf((*MockDB)(nil).UpdateTaskListTags), ctx, filter, tags)
+}
+
 // UpdateTaskListWithTTL mocks base method.
 func (m *MockDB) UpdateTaskListWithTTL(ctx context.Context, ttlSeconds int64, row *TaskListRow, previousRangeID int64) error {
 	m.ctrl.T.Helper()
@@ -1261,6 +1290,21 @@ func (m *MocktableCRUD) EXPECT() *MocktableCRUDMockRecorder {
 	return m.recorder
 }
 
+// CountAllDomains mocks base method.
+func (m *MocktableCRUD) CountAllDomains(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAllDomains", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAllDomains indicates an expected call of CountAllDomains.
+func (mr *MocktableCRUDMockRecorder) CountAllDomains(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllDomains", reflect.TypeOf((*MocktableCRUD)(nil).CountAllDomains), ctx)
+}
+
 // DeleteCrossClusterTask mocks base method.
 func (m *MocktableCRUD) DeleteCrossClusterTask(ctx context.Context, shardID int, targetCluster string, taskID int64) error {
 	m.ctrl.T.Helper()
@@ -2226,6 +2270,20 @@ func (mr *MocktableCRUDMockRecorder) UpdateTaskList(ctx, row, previousRangeID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskList", reflect.TypeOf((*MocktableCRUD)(nil).UpdateTaskList), ctx, row, previousRangeID)
 }
 
+// UpdateTaskListTags mocks base method.
+func (m *MocktableCRUD) UpdateTaskListTags(ctx context.Context, filter *TaskListFilter, tags map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListTags", ctx, filter, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskListTags indicates an expected call of UpdateTaskListTags.
+func (mr *MocktableCRUDMockRecorder) UpdateTaskListTags(ctx, filter, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListTags", reflect.TypeOf((*MocktableCRUD)(nil).UpdateTaskListTags), ctx, filter, tags)
+}
+
 // UpdateTaskListWithTTL mocks base method.
 func (m *MocktableCRUD) UpdateTaskListWithTTL(ctx context.Context, ttlSeconds int64, row *TaskListRow, previousRangeID int64) error {
 	m.ctrl.T.Helper()
@@ -2636,6 +2694,21 @@ func (m *MockDomainCRUD) EXPECT() *MockDomainCRUDMockRecorder {
 	return m.recorder
 }
 
+// CountAllDomains mocks base method.
+func (m *MockDomainCRUD) CountAllDomains(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAllDomains", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAllDomains indicates an expected call of CountAllDomains.
+func (mr *MockDomainCRUDMockRecorder) CountAllDomains(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAllDomains", reflect.TypeOf((*MockDomainCRUD)(nil).CountAllDomains), ctx)
+}
+
 // DeleteDomain mocks base method.
 func (m *MockDomainCRUD) DeleteDomain(ctx context.Context, domainID, domainName *string) error {
 	m.ctrl.T.Helper()
@@ -3039,6 +3112,20 @@ func (mr *MockTaskCRUDMockRecorder) UpdateTaskList(ctx, row, previousRangeID int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskList", reflect.TypeOf((*MockTaskCRUD)(nil).UpdateTaskList), ctx, row, previousRangeID)
 }
 
+// UpdateTaskListTags mocks base method.
+func (m *MockTaskCRUD) UpdateTaskListTags(ctx context.Context, filter *TaskListFilter, tags map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListTags", ctx, filter, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTaskListTags indicates an expected call of UpdateTaskListTags.
+func (mr *MockTaskCRUDMockRecorder) UpdateTaskListTags(ctx, filter, tags interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListTags", reflect.TypeOf((*MockTaskCRUD)(nil).UpdateTaskListTags), ctx, filter, tags)
+}
+
 // UpdateTaskListWithTTL mocks base method.
 func (m *MockTaskCRUD) UpdateTaskListWithTTL(ctx context.Context, ttlSeconds int64, row *TaskListRow, previousRangeID int64) error {
 	m.ctrl.T.Helper()
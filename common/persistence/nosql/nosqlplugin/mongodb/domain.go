@@ -61,6 +61,13 @@ func (db *mdb) SelectAllDomains(
 	panic("TODO")
 }
 
+// Count all domains
+func (db *mdb) CountAllDomains(
+	ctx context.Context,
+) (int64, error) {
+	panic("TODO")
+}
+
 //  Delete a domain, either by domainID or domainName
 func (db *mdb) DeleteDomain(
 	ctx context.Context,
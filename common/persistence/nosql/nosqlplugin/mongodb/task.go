@@ -61,6 +61,12 @@ func (db *mdb) UpdateTaskListWithTTL(
 	panic("TODO")
 }
 
+// UpdateTaskListTags replaces the tags of a single tasklist row without changing the rest of it
+// Return ConditionFailure if the row doesn't exist
+func (db *mdb) UpdateTaskListTags(ctx context.Context, filter *nosqlplugin.TaskListFilter, tags map[string]string) error {
+	panic("TODO")
+}
+
 // ListTaskList returns all tasklists.
 // Noop if TTL is already implemented in other methods
 func (db *mdb) ListTaskList(ctx context.Context, pageSize int, nextPageToken []byte) (*nosqlplugin.ListTaskListResult, error) {
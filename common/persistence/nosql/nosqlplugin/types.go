@@ -151,6 +151,7 @@ type (
 		RunID       string
 		ScheduledID int64
 		CreatedTime time.Time
+		AffinityKey string
 	}
 
 	// TaskListFilter is for filtering tasklist
@@ -170,6 +171,7 @@ type (
 		TaskListKind    int
 		AckLevel        int64
 		LastUpdatedTime time.Time
+		Tags            map[string]string
 	}
 
 	// ListTaskListResult is the result of list tasklists
@@ -217,6 +219,7 @@ type (
 		VisibilityArchivalStatus types.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *persistence.DataBlob
+		AsyncWorkflowConfig      *persistence.DataBlob
 	}
 
 	// SelectMessagesBetweenRequest is a request struct for SelectMessagesBetween
@@ -134,6 +134,7 @@ func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	s.NotNil(tasks1Response.Tasks, "expected valid list of tasks.")
 	s.Equal(1, len(tasks1Response.Tasks), "Expected 1 decision task.")
 	s.Equal(int64(5), tasks1Response.Tasks[0].ScheduleID)
+	s.Equal(workflowExecution.WorkflowID, tasks1Response.Tasks[0].AffinityKey)
 }
 
 // TestGetTasksWithNoMaxReadLevel test
@@ -624,3 +625,131 @@ func (s *MatchingPersistenceSuite) TestGetOrphanTasks() {
 	}
 	s.True(found)
 }
+
+func (s *MatchingPersistenceSuite) TestGetTaskListUsage() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := uuid.New()
+	taskList := "test-task-list-usage"
+	usage, err := s.TaskMgr.GetTaskListUsage(ctx, &p.GetTaskListUsageRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskListType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	s.Equal(int64(0), usage.TaskCount)
+	s.Equal(int64(0), usage.SizeBytes)
+
+	workflowExecution := types.WorkflowExecution{WorkflowID: "task-list-usage-test", RunID: uuid.New()}
+	for i := int64(1); i <= 3; i++ {
+		_, err := s.CreateDecisionTask(ctx, domainID, workflowExecution, taskList, i)
+		s.NoError(err)
+	}
+
+	usage, err = s.TaskMgr.GetTaskListUsage(ctx, &p.GetTaskListUsageRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskListType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	s.Equal(int64(3), usage.TaskCount)
+	s.True(usage.SizeBytes > 0)
+
+	// the other task type of the same task list has its own tasks
+	usage, err = s.TaskMgr.GetTaskListUsage(ctx, &p.GetTaskListUsageRequest{
+		DomainID:     domainID,
+		TaskListName: taskList,
+		TaskListType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Equal(int64(0), usage.TaskCount)
+}
+
+func (s *MatchingPersistenceSuite) TestGetTaskList() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := uuid.New()
+	taskList := "test-get-task-list"
+	_, err := s.TaskMgr.GetTaskList(ctx, &p.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	_, err = s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+
+	// reading the task list does not take its lease
+	for i := 0; i < 2; i++ {
+		response, err := s.TaskMgr.GetTaskList(ctx, &p.GetTaskListRequest{
+			DomainID: domainID,
+			TaskList: taskList,
+			TaskType: p.TaskListTypeActivity,
+		})
+		s.NoError(err)
+		s.EqualValues(1, response.TaskListInfo.RangeID)
+		s.Equal(taskList, response.TaskListInfo.Name)
+	}
+}
+
+func (s *MatchingPersistenceSuite) TestUpdateTaskListTags() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := uuid.New()
+	taskList := "test-update-task-list-tags"
+	tags := map[string]string{"team": "payments", "tier": "1"}
+	err := s.TaskMgr.UpdateTaskListTags(ctx, &p.UpdateTaskListTagsRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+		Tags:     tags,
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	leaseResp, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Empty(leaseResp.TaskListInfo.Tags)
+
+	err = s.TaskMgr.UpdateTaskListTags(ctx, &p.UpdateTaskListTagsRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+		Tags:     tags,
+	})
+	s.NoError(err)
+
+	// the owner of the lease keeps the tags when it updates the task list
+	tli := leaseResp.TaskListInfo
+	tli.AckLevel = 10
+	_, err = s.TaskMgr.UpdateTaskList(ctx, &p.UpdateTaskListRequest{TaskListInfo: tli})
+	s.NoError(err)
+
+	response, err := s.TaskMgr.GetTaskList(ctx, &p.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Equal(tags, response.TaskListInfo.Tags)
+	s.EqualValues(10, response.TaskListInfo.AckLevel)
+
+	leaseResp, err = s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeActivity,
+	})
+	s.NoError(err)
+	s.Equal(tags, leaseResp.TaskListInfo.Tags)
+}
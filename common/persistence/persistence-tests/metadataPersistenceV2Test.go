@@ -159,6 +159,7 @@ func (m *MetadataPersistenceSuiteV2) TestCreateDomain() {
 	m.Equal(visibilityArchivalStatus, resp1.Config.VisibilityArchivalStatus)
 	m.Equal(visibilityArchivalURI, resp1.Config.VisibilityArchivalURI)
 	m.Equal(badBinaries, resp1.Config.BadBinaries)
+	m.Equal(types.AsyncWorkflowConfiguration{}, resp1.Config.AsyncWorkflowConfig)
 	m.Equal(cluster.TestCurrentClusterName, resp1.ReplicationConfig.ActiveClusterName)
 	m.Equal(1, len(resp1.ReplicationConfig.Clusters))
 	m.Equal(isGlobalDomain, resp1.IsGlobalDomain)
@@ -783,6 +784,12 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			},
 		},
 	}
+	testAsyncWorkflowConfig := types.AsyncWorkflowConfiguration{
+		Enabled:       true,
+		QueueProvider: "kafka",
+		QueueTopic:    "test-async-requests",
+		RPS:           100,
+	}
 
 	err3 := m.UpdateDomain(
 		ctx,
@@ -802,6 +809,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			VisibilityArchivalStatus: updatedVisibilityArchivalStatus,
 			VisibilityArchivalURI:    updatedVisibilityArchivalURI,
 			BadBinaries:              testBinaries,
+			AsyncWorkflowConfig:      testAsyncWorkflowConfig,
 		},
 		&p.DomainReplicationConfig{
 			ActiveClusterName: updateClusterActive,
@@ -834,6 +842,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 	m.Equal(updatedVisibilityArchivalStatus, resp4.Config.VisibilityArchivalStatus)
 	m.Equal(updatedVisibilityArchivalURI, resp4.Config.VisibilityArchivalURI)
 	m.Equal(testBinaries, resp4.Config.BadBinaries)
+	m.Equal(testAsyncWorkflowConfig, resp4.Config.AsyncWorkflowConfig)
 	m.Equal(updateClusterActive, resp4.ReplicationConfig.ActiveClusterName)
 	m.Equal(len(updateClusters), len(resp4.ReplicationConfig.Clusters))
 	for index := range clusters {
@@ -896,6 +905,7 @@ func (m *MetadataPersistenceSuiteV2) TestUpdateDomain() {
 			VisibilityArchivalStatus: updatedVisibilityArchivalStatus,
 			VisibilityArchivalURI:    updatedVisibilityArchivalURI,
 			BadBinaries:              testBinaries,
+			AsyncWorkflowConfig:      testAsyncWorkflowConfig,
 		},
 		&p.DomainReplicationConfig{
 			ActiveClusterName: updateClusterActive,
@@ -1189,8 +1199,10 @@ ListLoop:
 	for {
 		resp, err := m.ListDomains(ctx, pageSize, token)
 		m.NoError(err)
+		m.Equal(int64(len(inputDomains)), resp.TotalCount)
 		token = resp.NextPageToken
 		for _, domain := range resp.Domains {
+			m.NotContains(outputDomains, domain.Info.ID)
 			outputDomains[domain.Info.ID] = domain
 			// global notification version is already tested, so here we make it 0
 			// so we can test == easily
@@ -1639,11 +1639,12 @@ func (s *TestBase) CreateDecisionTask(ctx context.Context, domainID string, work
 			TaskID:    taskID,
 			Execution: workflowExecution,
 			Data: &persistence.TaskInfo{
-				DomainID:   domainID,
-				WorkflowID: workflowExecution.WorkflowID,
-				RunID:      workflowExecution.RunID,
-				TaskID:     taskID,
-				ScheduleID: decisionScheduleID,
+				DomainID:    domainID,
+				WorkflowID:  workflowExecution.WorkflowID,
+				RunID:       workflowExecution.RunID,
+				TaskID:      taskID,
+				ScheduleID:  decisionScheduleID,
+				AffinityKey: workflowExecution.WorkflowID,
 			},
 		},
 	}
@@ -131,6 +131,7 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	updatedReplicationAckLevel := int64(2000)
 	updatedAlternativeClusterDLQAckLevel := int64(100)
 	updatedStolenSinceRenew := 10
+	updatedDomainReplicationWatermark := updatedCurrentClusterTimerAckLevel.Add(-time.Minute)
 
 	updatedInfo := shardInfo.Copy()
 	updatedInfo.Owner = updatedOwner
@@ -161,6 +162,9 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	}
 	updatedInfo.ReplicationAckLevel = updatedReplicationAckLevel
 	updatedInfo.StolenSinceRenew = updatedStolenSinceRenew
+	updatedInfo.DomainReplicationWatermarks = map[string]time.Time{
+		"some-domain-id": updatedDomainReplicationWatermark,
+	}
 
 	err2 := s.UpdateShard(ctx, updatedInfo, shardInfo.RangeID)
 	s.Nil(err2)
@@ -181,6 +185,7 @@ func (s *ShardPersistenceSuite) TestUpdateShard() {
 	s.Equal(updatedReplicationAckLevel, info1.ReplicationAckLevel)
 	s.Equal(updatedInfo.ReplicationDLQAckLevel, info1.ReplicationDLQAckLevel)
 	s.Equal(updatedStolenSinceRenew, info1.StolenSinceRenew)
+	s.EqualTimes(updatedDomainReplicationWatermark, info1.DomainReplicationWatermarks["some-domain-id"])
 
 	failedUpdateInfo := shardInfo.Copy()
 	failedUpdateInfo.Owner = "failed_owner"
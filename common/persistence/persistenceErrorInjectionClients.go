@@ -1107,6 +1107,80 @@ func (p *taskErrorInjectionPersistenceClient) GetOrphanTasks(
 	return response, persistenceErr
 }
 
+func (p *taskErrorInjectionPersistenceClient) GetTaskListUsage(
+	ctx context.Context,
+	request *GetTaskListUsageRequest,
+) (*GetTaskListUsageResponse, error) {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var response *GetTaskListUsageResponse
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		response, persistenceErr = p.persistence.GetTaskListUsage(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationGetTaskListUsage,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return nil, fakeErr
+	}
+	return response, persistenceErr
+}
+
+func (p *taskErrorInjectionPersistenceClient) GetTaskList(
+	ctx context.Context,
+	request *GetTaskListRequest,
+) (*GetTaskListResponse, error) {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var response *GetTaskListResponse
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		response, persistenceErr = p.persistence.GetTaskList(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationGetTaskList,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return nil, fakeErr
+	}
+	return response, persistenceErr
+}
+
+func (p *taskErrorInjectionPersistenceClient) UpdateTaskListTags(
+	ctx context.Context,
+	request *UpdateTaskListTagsRequest,
+) error {
+	fakeErr := generateFakeError(p.errorRate)
+
+	var persistenceErr error
+	var forwardCall bool
+	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+		persistenceErr = p.persistence.UpdateTaskListTags(ctx, request)
+	}
+
+	if fakeErr != nil {
+		p.logger.Error(msgInjectedFakeErr,
+			tag.StoreOperationUpdateTaskListTags,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.StoreError(persistenceErr),
+		)
+		return fakeErr
+	}
+	return persistenceErr
+}
+
 func (p *taskErrorInjectionPersistenceClient) LeaseTaskList(
 	ctx context.Context,
 	request *LeaseTaskListRequest,
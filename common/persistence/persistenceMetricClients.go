@@ -819,6 +819,41 @@ func (p *taskPersistenceClient) GetOrphanTasks(ctx context.Context, request *Get
 	return resp, nil
 }
 
+func (p *taskPersistenceClient) GetTaskListUsage(ctx context.Context, request *GetTaskListUsageRequest) (*GetTaskListUsageResponse, error) {
+	var resp *GetTaskListUsageResponse
+	op := func() error {
+		var err error
+		resp, err = p.persistence.GetTaskListUsage(ctx, request)
+		return err
+	}
+	err := p.call(metrics.PersistenceGetTaskListUsageScope, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (p *taskPersistenceClient) GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	var resp *GetTaskListResponse
+	op := func() error {
+		var err error
+		resp, err = p.persistence.GetTaskList(ctx, request)
+		return err
+	}
+	err := p.call(metrics.PersistenceGetTaskListScope, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (p *taskPersistenceClient) UpdateTaskListTags(ctx context.Context, request *UpdateTaskListTagsRequest) error {
+	op := func() error {
+		return p.persistence.UpdateTaskListTags(ctx, request)
+	}
+	return p.call(metrics.PersistenceUpdateTaskListTagsScope, op)
+}
+
 func (p *taskPersistenceClient) LeaseTaskList(
 	ctx context.Context,
 	request *LeaseTaskListRequest,
@@ -636,6 +636,27 @@ func (p *taskRateLimitedPersistenceClient) GetOrphanTasks(ctx context.Context, r
 	return p.persistence.GetOrphanTasks(ctx, request)
 }
 
+func (p *taskRateLimitedPersistenceClient) GetTaskListUsage(ctx context.Context, request *GetTaskListUsageRequest) (*GetTaskListUsageResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.GetTaskListUsage(ctx, request)
+}
+
+func (p *taskRateLimitedPersistenceClient) GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return nil, ErrPersistenceLimitExceeded
+	}
+	return p.persistence.GetTaskList(ctx, request)
+}
+
+func (p *taskRateLimitedPersistenceClient) UpdateTaskListTags(ctx context.Context, request *UpdateTaskListTagsRequest) error {
+	if ok := p.rateLimiter.Allow(); !ok {
+		return ErrPersistenceLimitExceeded
+	}
+	return p.persistence.UpdateTaskListTags(ctx, request)
+}
+
 func (p *taskRateLimitedPersistenceClient) LeaseTaskList(
 	ctx context.Context,
 	request *LeaseTaskListRequest,
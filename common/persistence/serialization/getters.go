@@ -180,6 +180,14 @@ func (s *ShardInfo) GetTimerProcessingQueueStatesEncoding() (o string) {
 	return
 }
 
+// GetDomainReplicationWatermarks internal sql blob getter
+func (s *ShardInfo) GetDomainReplicationWatermarks() (o map[string]time.Time) {
+	if s != nil {
+		return s.DomainReplicationWatermarks
+	}
+	return
+}
+
 // GetName internal sql blob getter
 func (d *DomainInfo) GetName() (o string) {
 	if d != nil {
@@ -316,6 +324,22 @@ func (d *DomainInfo) GetBadBinariesEncoding() (o string) {
 	return
 }
 
+// GetAsyncWorkflowConfig internal sql blob getter
+func (d *DomainInfo) GetAsyncWorkflowConfig() (o []byte) {
+	if d != nil {
+		return d.AsyncWorkflowConfig
+	}
+	return
+}
+
+// GetAsyncWorkflowConfigEncoding internal sql blob getter
+func (d *DomainInfo) GetAsyncWorkflowConfigEncoding() (o string) {
+	if d != nil {
+		return d.AsyncWorkflowConfigEncoding
+	}
+	return
+}
+
 // GetHistoryArchivalStatus internal sql blob getter
 func (d *DomainInfo) GetHistoryArchivalStatus() (o int16) {
 	if d != nil {
@@ -1372,6 +1396,14 @@ func (t *TaskInfo) GetCreatedTimestamp() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetAffinityKey internal sql blob getter
+func (t *TaskInfo) GetAffinityKey() (o string) {
+	if t != nil {
+		return t.AffinityKey
+	}
+	return
+}
+
 // GetKind internal sql blob getter
 func (t *TaskListInfo) GetKind() (o int16) {
 	if t != nil {
@@ -1404,6 +1436,14 @@ func (t *TaskListInfo) GetLastUpdated() time.Time {
 	return time.Unix(0, 0)
 }
 
+// GetTags internal sql blob getter
+func (t *TaskListInfo) GetTags() (o map[string]string) {
+	if t != nil {
+		return t.Tags
+	}
+	return
+}
+
 // GetDomainID internal sql blob getter
 func (t *TransferTaskInfo) GetDomainID() (o []byte) {
 	if t != nil {
@@ -56,6 +56,7 @@ type (
 		CrossClusterProcessingQueueStatesEncoding string
 		TimerProcessingQueueStates                []byte
 		TimerProcessingQueueStatesEncoding        string
+		DomainReplicationWatermarks               map[string]time.Time
 	}
 
 	// DomainInfo blob in a serialization agnostic format
@@ -77,6 +78,8 @@ type (
 		Data                        map[string]string
 		BadBinaries                 []byte
 		BadBinariesEncoding         string
+		AsyncWorkflowConfig         []byte
+		AsyncWorkflowConfigEncoding string
 		HistoryArchivalStatus       int16
 		HistoryArchivalURI          string
 		VisibilityArchivalStatus    int16
@@ -248,6 +251,7 @@ type (
 		ScheduleID       int64
 		ExpiryTimestamp  time.Time
 		CreatedTimestamp time.Time
+		AffinityKey      string
 	}
 
 	// TaskListInfo blob in a serialization agnostic format
@@ -256,6 +260,7 @@ type (
 		AckLevel        int64
 		ExpiryTimestamp time.Time
 		LastUpdated     time.Time
+		Tags            map[string]string
 	}
 
 	// TransferTaskInfo blob in a serialization agnostic format
@@ -60,6 +60,12 @@ func shardInfoToThrift(info *ShardInfo) *sqlblobs.ShardInfo {
 			result.ClusterTimerAckLevel[k] = v.UnixNano()
 		}
 	}
+	if info.DomainReplicationWatermarks != nil {
+		result.DomainReplicationWatermarks = make(map[string]int64, len(info.DomainReplicationWatermarks))
+		for k, v := range info.DomainReplicationWatermarks {
+			result.DomainReplicationWatermarks[k] = v.UnixNano()
+		}
+	}
 	return result
 }
 
@@ -94,6 +100,12 @@ func shardInfoFromThrift(info *sqlblobs.ShardInfo) *ShardInfo {
 			result.ClusterTimerAckLevel[k] = time.Unix(0, v)
 		}
 	}
+	if info.DomainReplicationWatermarks != nil {
+		result.DomainReplicationWatermarks = make(map[string]time.Time, len(info.DomainReplicationWatermarks))
+		for k, v := range info.DomainReplicationWatermarks {
+			result.DomainReplicationWatermarks[k] = time.Unix(0, v)
+		}
+	}
 	return result
 }
 
@@ -118,6 +130,8 @@ func domainInfoToThrift(info *DomainInfo) *sqlblobs.DomainInfo {
 		Data:                        info.Data,
 		BadBinaries:                 info.BadBinaries,
 		BadBinariesEncoding:         &info.BadBinariesEncoding,
+		AsyncWorkflowConfig:         info.AsyncWorkflowConfig,
+		AsyncWorkflowConfigEncoding: &info.AsyncWorkflowConfigEncoding,
 		HistoryArchivalStatus:       &info.HistoryArchivalStatus,
 		HistoryArchivalURI:          &info.HistoryArchivalURI,
 		VisibilityArchivalStatus:    &info.VisibilityArchivalStatus,
@@ -150,6 +164,8 @@ func domainInfoFromThrift(info *sqlblobs.DomainInfo) *DomainInfo {
 		Data:                        info.Data,
 		BadBinaries:                 info.BadBinaries,
 		BadBinariesEncoding:         info.GetBadBinariesEncoding(),
+		AsyncWorkflowConfig:         info.AsyncWorkflowConfig,
+		AsyncWorkflowConfigEncoding: info.GetAsyncWorkflowConfigEncoding(),
 		HistoryArchivalStatus:       info.GetHistoryArchivalStatus(),
 		HistoryArchivalURI:          info.GetHistoryArchivalURI(),
 		VisibilityArchivalStatus:    info.GetVisibilityArchivalStatus(),
@@ -521,6 +537,7 @@ func taskInfoToThrift(info *TaskInfo) *sqlblobs.TaskInfo {
 		ScheduleID:       &info.ScheduleID,
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		CreatedTimeNanos: timeToUnixNanoPtr(info.CreatedTimestamp),
+		AffinityKey:      &info.AffinityKey,
 	}
 }
 
@@ -534,6 +551,7 @@ func taskInfoFromThrift(info *sqlblobs.TaskInfo) *TaskInfo {
 		ScheduleID:       info.GetScheduleID(),
 		ExpiryTimestamp:  timeFromUnixNano(info.GetExpiryTimeNanos()),
 		CreatedTimestamp: timeFromUnixNano(info.GetCreatedTimeNanos()),
+		AffinityKey:      info.GetAffinityKey(),
 	}
 }
 
@@ -546,6 +564,7 @@ func taskListInfoToThrift(info *TaskListInfo) *sqlblobs.TaskListInfo {
 		AckLevel:         &info.AckLevel,
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		LastUpdatedNanos: timeToUnixNanoPtr(info.LastUpdated),
+		Tags:             info.Tags,
 	}
 }
 
@@ -558,6 +577,7 @@ func taskListInfoFromThrift(info *sqlblobs.TaskListInfo) *TaskListInfo {
 		AckLevel:        info.GetAckLevel(),
 		ExpiryTimestamp: timeFromUnixNano(info.GetExpiryTimeNanos()),
 		LastUpdated:     timeFromUnixNano(info.GetLastUpdatedNanos()),
+		Tags:            info.Tags,
 	}
 }
 
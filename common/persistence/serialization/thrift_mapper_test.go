@@ -97,6 +97,8 @@ func TestDomainInfo(t *testing.T) {
 		Data:                        map[string]string{"key_1": "value_1", "key_2": "value_2"},
 		BadBinaries:                 []byte("BadBinaries"),
 		BadBinariesEncoding:         "BadBinariesEncoding",
+		AsyncWorkflowConfig:         []byte("AsyncWorkflowConfig"),
+		AsyncWorkflowConfigEncoding: "AsyncWorkflowConfigEncoding",
 		HistoryArchivalStatus:       int16(rand.Intn(1000)),
 		HistoryArchivalURI:          "HistoryArchivalURI",
 		VisibilityArchivalStatus:    int16(rand.Intn(1000)),
@@ -122,6 +124,8 @@ func TestDomainInfo(t *testing.T) {
 	assert.Equal(t, expected.Data, actual.Data)
 	assert.Equal(t, expected.BadBinaries, actual.BadBinaries)
 	assert.Equal(t, expected.BadBinariesEncoding, actual.BadBinariesEncoding)
+	assert.Equal(t, expected.AsyncWorkflowConfig, actual.AsyncWorkflowConfig)
+	assert.Equal(t, expected.AsyncWorkflowConfigEncoding, actual.AsyncWorkflowConfigEncoding)
 	assert.Equal(t, expected.HistoryArchivalStatus, actual.HistoryArchivalStatus)
 	assert.Equal(t, expected.HistoryArchivalURI, actual.HistoryArchivalURI)
 	assert.Equal(t, expected.VisibilityArchivalStatus, actual.VisibilityArchivalStatus)
@@ -423,10 +427,12 @@ func TestTaskListInfo(t *testing.T) {
 		AckLevel:        int64(rand.Intn(1000)),
 		ExpiryTimestamp: time.Now(),
 		LastUpdated:     time.Now(),
+		Tags:            map[string]string{"team": "payments"},
 	}
 	actual := taskListInfoFromThrift(taskListInfoToThrift(expected))
 	assert.Equal(t, expected.Kind, actual.Kind)
 	assert.Equal(t, expected.AckLevel, actual.AckLevel)
+	assert.Equal(t, expected.Tags, actual.Tags)
 	assert.Equal(t, expected.LastUpdated.Sub(actual.LastUpdated), time.Duration(0))
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 }
@@ -58,6 +58,10 @@ type (
 		SerializeBadBinaries(event *types.BadBinaries, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeBadBinaries(data *DataBlob) (*types.BadBinaries, error)
 
+		// serialize/deserialize async workflow configuration
+		SerializeAsyncWorkflowsConfig(config *types.AsyncWorkflowConfiguration, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeAsyncWorkflowsConfig(data *DataBlob) (*types.AsyncWorkflowConfiguration, error)
+
 		// serialize/deserialize version histories
 		SerializeVersionHistories(histories *types.VersionHistories, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeVersionHistories(data *DataBlob) (*types.VersionHistories, error)
@@ -160,6 +164,19 @@ func (t *serializerImpl) DeserializeBadBinaries(data *DataBlob) (*types.BadBinar
 	return &bb, err
 }
 
+func (t *serializerImpl) SerializeAsyncWorkflowsConfig(config *types.AsyncWorkflowConfiguration, encodingType common.EncodingType) (*DataBlob, error) {
+	if config == nil {
+		config = &types.AsyncWorkflowConfiguration{}
+	}
+	return t.serialize(config, encodingType)
+}
+
+func (t *serializerImpl) DeserializeAsyncWorkflowsConfig(data *DataBlob) (*types.AsyncWorkflowConfiguration, error) {
+	var config types.AsyncWorkflowConfiguration
+	err := t.deserialize(data, &config)
+	return &config, err
+}
+
 func (t *serializerImpl) SerializeVisibilityMemo(memo *types.Memo, encodingType common.EncodingType) (*DataBlob, error) {
 	if memo == nil {
 		// Return nil here to be consistent with Event
@@ -296,6 +313,8 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(thrift.FromResetPoints(input))
 	case *types.BadBinaries:
 		return t.thriftrwEncoder.Encode(thrift.FromBadBinaries(input))
+	case *types.AsyncWorkflowConfiguration:
+		return t.thriftrwEncoder.Encode(thrift.FromAsyncWorkflowConfiguration(input))
 	case *types.VersionHistories:
 		return t.thriftrwEncoder.Encode(thrift.FromVersionHistories(input))
 	case []*types.FailoverMarkerAttributes:
@@ -370,6 +389,13 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = *thrift.ToBadBinaries(&thriftTarget)
 		return nil
+	case *types.AsyncWorkflowConfiguration:
+		thriftTarget := workflow.AsyncWorkflowConfiguration{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = *thrift.ToAsyncWorkflowConfiguration(&thriftTarget)
+		return nil
 	case *types.VersionHistories:
 		thriftTarget := workflow.VersionHistories{}
 		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
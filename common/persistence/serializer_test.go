@@ -528,3 +528,34 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *cadenceSerializerSuite) TestSerializeAsyncWorkflowsConfig() {
+	serializer := NewPayloadSerializer()
+	config := &types.AsyncWorkflowConfiguration{
+		Enabled:       true,
+		QueueProvider: "kafka",
+		QueueTopic:    "async-requests",
+		RPS:           100,
+	}
+
+	dNilConfig, err := serializer.DeserializeAsyncWorkflowsConfig(nil)
+	s.Nil(err)
+	s.Equal(&types.AsyncWorkflowConfiguration{}, dNilConfig)
+
+	nilConfig, err := serializer.SerializeAsyncWorkflowsConfig(nil, common.EncodingTypeThriftRW)
+	s.Nil(err)
+	s.NotNil(nilConfig)
+	dNilConfig, err = serializer.DeserializeAsyncWorkflowsConfig(nilConfig)
+	s.Nil(err)
+	s.Equal(&types.AsyncWorkflowConfiguration{}, dNilConfig)
+
+	for _, encodingType := range []common.EncodingType{common.EncodingTypeJSON, common.EncodingTypeThriftRW} {
+		blob, err := serializer.SerializeAsyncWorkflowsConfig(config, encodingType)
+		s.Nil(err)
+		s.Equal(encodingType, blob.GetEncoding())
+
+		deserialized, err := serializer.DeserializeAsyncWorkflowsConfig(blob)
+		s.Nil(err)
+		s.Equal(config, deserialized)
+	}
+}
@@ -135,6 +135,7 @@ func (m *shardManager) toInternalShardInfo(shardInfo *ShardInfo) (*InternalShard
 		ClusterReplicationLevel:           shardInfo.ClusterReplicationLevel,
 		DomainNotificationVersion:         shardInfo.DomainNotificationVersion,
 		PendingFailoverMarkers:            pendingFailoverMarker,
+		DomainReplicationWatermarks:       shardInfo.DomainReplicationWatermarks,
 	}, nil
 }
 
@@ -177,5 +178,6 @@ func (m *shardManager) fromInternalShardInfo(internalShardInfo *InternalShardInf
 		ClusterReplicationLevel:           internalShardInfo.ClusterReplicationLevel,
 		DomainNotificationVersion:         internalShardInfo.DomainNotificationVersion,
 		PendingFailoverMarkers:            pendingFailoverMarker,
+		DomainReplicationWatermarks:       internalShardInfo.DomainReplicationWatermarks,
 	}, nil
 }
@@ -105,6 +105,13 @@ func (m *sqlDomainStore) CreateDomain(
 		badBinariesEncoding = string(request.Config.BadBinaries.GetEncoding())
 	}
 
+	var asyncWorkflowConfig []byte
+	asyncWorkflowConfigEncoding := string(common.EncodingTypeEmpty)
+	if request.Config.AsyncWorkflowConfig != nil {
+		asyncWorkflowConfig = request.Config.AsyncWorkflowConfig.Data
+		asyncWorkflowConfigEncoding = string(request.Config.AsyncWorkflowConfig.GetEncoding())
+	}
+
 	domainInfo := &serialization.DomainInfo{
 		Name:                        request.Info.Name,
 		Status:                      int32(request.Info.Status),
@@ -129,6 +136,8 @@ func (m *sqlDomainStore) CreateDomain(
 		LastUpdatedTimestamp:        request.LastUpdatedTime,
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		AsyncWorkflowConfig:         asyncWorkflowConfig,
+		AsyncWorkflowConfigEncoding: asyncWorkflowConfigEncoding,
 	}
 
 	blob, err := m.parser.DomainInfoToBlob(domainInfo)
@@ -226,6 +235,11 @@ func (m *sqlDomainStore) domainRowToGetDomainResponse(row *sqlplugin.DomainRow)
 		badBinaries = persistence.NewDataBlob(domainInfo.BadBinaries, common.EncodingType(domainInfo.GetBadBinariesEncoding()))
 	}
 
+	var asyncWorkflowConfig *persistence.DataBlob
+	if domainInfo.AsyncWorkflowConfig != nil {
+		asyncWorkflowConfig = persistence.NewDataBlob(domainInfo.AsyncWorkflowConfig, common.EncodingType(domainInfo.GetAsyncWorkflowConfigEncoding()))
+	}
+
 	return &persistence.InternalGetDomainResponse{
 		Info: &persistence.DomainInfo{
 			ID:          row.ID.String(),
@@ -245,6 +259,7 @@ func (m *sqlDomainStore) domainRowToGetDomainResponse(row *sqlplugin.DomainRow)
 			VisibilityArchivalStatus: types.ArchivalStatus(domainInfo.GetVisibilityArchivalStatus()),
 			VisibilityArchivalURI:    domainInfo.GetVisibilityArchivalURI(),
 			BadBinaries:              badBinaries,
+			AsyncWorkflowConfig:      asyncWorkflowConfig,
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: cluster.GetOrUseDefaultActiveCluster(m.activeClusterName, domainInfo.GetActiveClusterName()),
@@ -278,6 +293,13 @@ func (m *sqlDomainStore) UpdateDomain(
 		badBinariesEncoding = string(request.Config.BadBinaries.GetEncoding())
 	}
 
+	var asyncWorkflowConfig []byte
+	asyncWorkflowConfigEncoding := string(common.EncodingTypeEmpty)
+	if request.Config.AsyncWorkflowConfig != nil {
+		asyncWorkflowConfig = request.Config.AsyncWorkflowConfig.Data
+		asyncWorkflowConfigEncoding = string(request.Config.AsyncWorkflowConfig.GetEncoding())
+	}
+
 	domainInfo := &serialization.DomainInfo{
 		Status:                      int32(request.Info.Status),
 		Description:                 request.Info.Description,
@@ -302,6 +324,8 @@ func (m *sqlDomainStore) UpdateDomain(
 		LastUpdatedTimestamp:        request.LastUpdatedTime,
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		AsyncWorkflowConfig:         asyncWorkflowConfig,
+		AsyncWorkflowConfigEncoding: asyncWorkflowConfigEncoding,
 	}
 
 	blob, err := m.parser.DomainInfoToBlob(domainInfo)
@@ -383,6 +407,11 @@ func (m *sqlDomainStore) ListDomains(
 		return nil, convertCommonErrors(m.db, "ListDomains", "Failed to get domain rows.", err)
 	}
 
+	totalCount, err := m.db.CountFromDomain(ctx)
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "ListDomains", "Failed to count domain rows.", err)
+	}
+
 	var domains []*persistence.InternalGetDomainResponse
 	for _, row := range rows {
 		resp, err := m.domainRowToGetDomainResponse(&row)
@@ -392,7 +421,7 @@ func (m *sqlDomainStore) ListDomains(
 		domains = append(domains, resp)
 	}
 
-	resp := &persistence.InternalListDomainsResponse{Domains: domains}
+	resp := &persistence.InternalListDomainsResponse{Domains: domains, TotalCount: totalCount}
 	if len(rows) >= request.PageSize {
 		resp.NextPageToken = rows[len(rows)-1].ID
 	}
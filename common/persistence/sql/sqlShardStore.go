@@ -120,6 +120,9 @@ func (m *sqlShardStore) GetShard(
 	if shardInfo.ReplicationDlqAckLevel == nil {
 		shardInfo.ReplicationDlqAckLevel = make(map[string]int64)
 	}
+	if shardInfo.DomainReplicationWatermarks == nil {
+		shardInfo.DomainReplicationWatermarks = make(map[string]time.Time)
+	}
 
 	var transferPQS *persistence.DataBlob
 	if shardInfo.GetTransferProcessingQueueStates() != nil {
@@ -162,6 +165,7 @@ func (m *sqlShardStore) GetShard(
 		DomainNotificationVersion:         shardInfo.GetDomainNotificationVersion(),
 		ClusterReplicationLevel:           shardInfo.ClusterReplicationLevel,
 		ReplicationDLQAckLevel:            shardInfo.ReplicationDlqAckLevel,
+		DomainReplicationWatermarks:       shardInfo.DomainReplicationWatermarks,
 	}}
 
 	return resp, nil
@@ -289,6 +293,7 @@ func shardInfoToShardsRow(s persistence.InternalShardInfo, parser serialization.
 		ReplicationDlqAckLevel:                    s.ReplicationDLQAckLevel,
 		PendingFailoverMarkers:                    markerData,
 		PendingFailoverMarkersEncoding:            markerEncoding,
+		DomainReplicationWatermarks:               s.DomainReplicationWatermarks,
 	}
 
 	blob, err := parser.ShardInfoToBlob(shardInfo)
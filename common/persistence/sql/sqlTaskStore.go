@@ -181,12 +181,112 @@ func (m *sqlTaskStore) LeaseTaskList(
 			AckLevel:    ackLevel,
 			Kind:        request.TaskListKind,
 			LastUpdated: now,
+			Tags:        tlInfo.GetTags(),
 		}}
 		return nil
 	})
 	return resp, err
 }
 
+func (m *sqlTaskStore) GetTaskList(
+	ctx context.Context,
+	request *persistence.GetTaskListRequest,
+) (*persistence.GetTaskListResponse, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainIDAndTasklist(request.DomainID, request.TaskList, m.db.GetTotalNumDBShards())
+	domainID := serialization.MustParseUUID(request.DomainID)
+	rows, err := m.db.SelectFromTaskLists(ctx, &sqlplugin.TaskListsFilter{
+		ShardID:  dbShardID,
+		DomainID: &domainID,
+		Name:     &request.TaskList,
+		TaskType: common.Int64Ptr(int64(request.TaskType))})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &types.EntityNotExistsError{
+				Message: fmt.Sprintf("task list %v of type %v does not exist", request.TaskList, request.TaskType),
+			}
+		}
+		return nil, convertCommonErrors(m.db, "GetTaskList", "", err)
+	}
+
+	row := rows[0]
+	tlInfo, err := m.parser.TaskListInfoFromBlob(row.Data, row.DataEncoding)
+	if err != nil {
+		return nil, err
+	}
+	return &persistence.GetTaskListResponse{
+		TaskListInfo: &persistence.TaskListInfo{
+			DomainID:    request.DomainID,
+			Name:        request.TaskList,
+			TaskType:    request.TaskType,
+			RangeID:     row.RangeID,
+			AckLevel:    tlInfo.GetAckLevel(),
+			Kind:        int(tlInfo.GetKind()),
+			Expiry:      tlInfo.GetExpiryTimestamp(),
+			LastUpdated: tlInfo.GetLastUpdated(),
+			Tags:        tlInfo.GetTags(),
+		},
+	}, nil
+}
+
+func (m *sqlTaskStore) UpdateTaskListTags(
+	ctx context.Context,
+	request *persistence.UpdateTaskListTagsRequest,
+) error {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainIDAndTasklist(request.DomainID, request.TaskList, m.db.GetTotalNumDBShards())
+	domainID := serialization.MustParseUUID(request.DomainID)
+	return m.txExecute(ctx, dbShardID, "UpdateTaskListTags", func(tx sqlplugin.Tx) error {
+		rows, err := tx.SelectFromTaskLists(ctx, &sqlplugin.TaskListsFilter{
+			ShardID:  dbShardID,
+			DomainID: &domainID,
+			Name:     &request.TaskList,
+			TaskType: common.Int64Ptr(int64(request.TaskType))})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &types.EntityNotExistsError{
+					Message: fmt.Sprintf("task list %v of type %v does not exist", request.TaskList, request.TaskType),
+				}
+			}
+			return err
+		}
+		row := rows[0]
+		if err := lockTaskList(ctx, tx, dbShardID, domainID, request.TaskList, request.TaskType, row.RangeID); err != nil {
+			return err
+		}
+		tlInfo, err := m.parser.TaskListInfoFromBlob(row.Data, row.DataEncoding)
+		if err != nil {
+			return err
+		}
+		tlInfo.Tags = request.Tags
+		blob, err := m.parser.TaskListInfoToBlob(tlInfo)
+		if err != nil {
+			return err
+		}
+		row.ShardID = dbShardID
+		row.Data = blob.Data
+		row.DataEncoding = string(blob.Encoding)
+		var result sql.Result
+		if m.db.SupportsTTL() && tlInfo.GetKind() == persistence.TaskListKindSticky {
+			result, err = tx.UpdateTaskListsWithTTL(ctx, &sqlplugin.TaskListsRowWithTTL{
+				TaskListsRow: row,
+				TTL:          stickyTasksListsTTL,
+			})
+		} else {
+			result, err = tx.UpdateTaskLists(ctx, &row)
+		}
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected != 1 {
+			return fmt.Errorf("%v rows were affected instead of 1", rowsAffected)
+		}
+		return nil
+	})
+}
+
 func (m *sqlTaskStore) UpdateTaskList(
 	ctx context.Context,
 	request *persistence.UpdateTaskListRequest,
@@ -204,16 +304,30 @@ func (m *sqlTaskStore) UpdateTaskList(
 	}
 
 	var resp *persistence.UpdateTaskListResponse
-	blob, err := m.parser.TaskListInfoToBlob(tlInfo)
-	if err != nil {
-		return nil, err
-	}
-	err = m.txExecute(ctx, dbShardID, "UpdateTaskList", func(tx sqlplugin.Tx) error {
+	err := m.txExecute(ctx, dbShardID, "UpdateTaskList", func(tx sqlplugin.Tx) error {
 		err1 := lockTaskList(
 			ctx, tx, dbShardID, domainID, request.TaskListInfo.Name, request.TaskListInfo.TaskType, request.TaskListInfo.RangeID)
 		if err1 != nil {
 			return err1
 		}
+		// tags are only set through UpdateTaskListTags, keep the stored ones
+		rows, err1 := tx.SelectFromTaskLists(ctx, &sqlplugin.TaskListsFilter{
+			ShardID:  dbShardID,
+			DomainID: &domainID,
+			Name:     &request.TaskListInfo.Name,
+			TaskType: common.Int64Ptr(int64(request.TaskListInfo.TaskType))})
+		if err1 != nil {
+			return err1
+		}
+		storedInfo, err1 := m.parser.TaskListInfoFromBlob(rows[0].Data, rows[0].DataEncoding)
+		if err1 != nil {
+			return err1
+		}
+		tlInfo.Tags = storedInfo.GetTags()
+		blob, err1 := m.parser.TaskListInfoToBlob(tlInfo)
+		if err1 != nil {
+			return err1
+		}
 		var result sql.Result
 		row := &sqlplugin.TaskListsRow{
 			ShardID:      dbShardID,
@@ -392,6 +506,7 @@ func (m *sqlTaskStore) CreateTasks(
 			ScheduleID:       v.Data.ScheduleID,
 			ExpiryTimestamp:  expiryTime,
 			CreatedTimestamp: time.Now(),
+			AffinityKey:      v.Data.AffinityKey,
 		})
 		if err != nil {
 			return nil, err
@@ -478,6 +593,7 @@ func (m *sqlTaskStore) GetTasks(
 			ScheduleID:  info.GetScheduleID(),
 			Expiry:      info.GetExpiryTimestamp(),
 			CreatedTime: info.GetCreatedTimestamp(),
+			AffinityKey: info.GetAffinityKey(),
 		}
 	}
 
@@ -552,6 +668,23 @@ func (m *sqlTaskStore) GetOrphanTasks(ctx context.Context, request *persistence.
 	return &persistence.GetOrphanTasksResponse{Tasks: tasks}, nil
 }
 
+// GetTaskListUsage returns the exact number of tasks and data bytes stored for a task list
+func (m *sqlTaskStore) GetTaskListUsage(ctx context.Context, request *persistence.GetTaskListUsageRequest) (*persistence.GetTaskListUsageResponse, error) {
+	row, err := m.db.GetTasksUsage(ctx, &sqlplugin.TasksFilter{
+		ShardID:      sqlplugin.GetDBShardIDFromDomainIDAndTasklist(request.DomainID, request.TaskListName, m.db.GetTotalNumDBShards()),
+		DomainID:     serialization.MustParseUUID(request.DomainID),
+		TaskListName: request.TaskListName,
+		TaskType:     int64(request.TaskListType),
+	})
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetTaskListUsage", "", err)
+	}
+	return &persistence.GetTaskListUsageResponse{
+		TaskCount: row.TaskCount,
+		SizeBytes: row.SizeBytes,
+	}, nil
+}
+
 func lockTaskList(ctx context.Context, tx sqlplugin.Tx, shardID int, domainID serialization.UUID, name string, taskListType int, oldRangeID int64) error {
 	rangeID, err := tx.LockTaskLists(ctx, &sqlplugin.TaskListsFilter{
 		ShardID: shardID, DomainID: &domainID, Name: &name, TaskType: common.Int64Ptr(int64(taskListType))})
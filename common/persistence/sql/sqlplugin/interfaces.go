@@ -208,6 +208,12 @@ type (
 		TaskID       int64
 	}
 
+	// TasksUsageRow represents the number of rows and bytes of data stored for a task list in tasks table
+	TasksUsageRow struct {
+		TaskCount int64
+		SizeBytes int64
+	}
+
 	// TasksRowWithTTL represents a row in tasks table with a ttl
 	TasksRowWithTTL struct {
 		TasksRow TasksRow
@@ -575,6 +581,8 @@ type (
 		// Name can be specified to filter results. If both are not specified, all rows
 		// will be returned
 		SelectFromDomain(ctx context.Context, filter *DomainFilter) ([]DomainRow, error)
+		// CountFromDomain returns the number of rows in domains table
+		CountFromDomain(ctx context.Context) (int64, error)
 		// DeleteDomain deletes a single row. One of ID or Name MUST be specified
 		DeleteFromDomain(ctx context.Context, filter *DomainFilter) (sql.Result, error)
 
@@ -602,6 +610,9 @@ type (
 		//    - this will delete up to limit number of tasks less than or equal to the given task id
 		DeleteFromTasks(ctx context.Context, filter *TasksFilter) (sql.Result, error)
 		GetOrphanTasks(ctx context.Context, filter *OrphanTasksFilter) ([]TaskKeyRow, error)
+		// GetTasksUsage counts the rows and data bytes stored in tasks table for a task list
+		// Required filter params - {domainID, tasklistName, taskType}
+		GetTasksUsage(ctx context.Context, filter *TasksFilter) (*TasksUsageRow, error)
 
 		InsertIntoTaskLists(ctx context.Context, row *TaskListsRow) (sql.Result, error)
 		InsertIntoTaskListsWithTTL(ctx context.Context, row *TaskListsRowWithTTL) (sql.Result, error)
@@ -44,6 +44,7 @@ const (
 
 	listDomainsQuery      = getDomainPart + ` WHERE shard_id=? ORDER BY id LIMIT ?`
 	listDomainsRangeQuery = getDomainPart + ` WHERE shard_id=? AND id > ? ORDER BY id LIMIT ?`
+	countDomainsQuery     = `SELECT COUNT(*) FROM domains WHERE shard_id=?`
 
 	deleteDomainByIDQuery   = `DELETE FROM domains WHERE shard_id=? AND id = ?`
 	deleteDomainByNameQuery = `DELETE FROM domains WHERE shard_id=? AND name = ?`
@@ -108,6 +109,13 @@ func (mdb *db) selectAllFromDomain(ctx context.Context, filter *sqlplugin.Domain
 	return rows, err
 }
 
+// CountFromDomain returns the number of rows in domains table
+func (mdb *db) CountFromDomain(ctx context.Context) (int64, error) {
+	var count int64
+	err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &count, countDomainsQuery, shardID)
+	return count, err
+}
+
 // DeleteFromDomain deletes a single row in domains table
 func (mdb *db) DeleteFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) (sql.Result, error) {
 	var err error
@@ -98,6 +98,9 @@ task_type = :task_type
 		`	SELECT domain_id, name, task_type FROM task_lists AS tl ` +
 		`	WHERE t.domain_id=tl.domain_id and t.task_list_name=tl.name and t.task_type=tl.task_type ` +
 		`) LIMIT ?;`
+
+	getTasksUsageQry = `SELECT COUNT(1) AS task_count, COALESCE(SUM(LENGTH(data)), 0) AS size_bytes FROM tasks ` +
+		`WHERE domain_id = ? AND task_list_name = ? AND task_type = ?`
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
@@ -151,6 +154,16 @@ func (mdb *db) GetOrphanTasks(ctx context.Context, filter *sqlplugin.OrphanTasks
 	return rows, nil
 }
 
+// GetTasksUsage counts the rows and data bytes stored in tasks table for a task list
+func (mdb *db) GetTasksUsage(ctx context.Context, filter *sqlplugin.TasksFilter) (*sqlplugin.TasksUsageRow, error) {
+	var row sqlplugin.TasksUsageRow
+	err := mdb.driver.GetContext(ctx, filter.ShardID, &row, getTasksUsageQry, filter.DomainID, filter.TaskListName, filter.TaskType)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
 // InsertIntoTaskLists inserts one or more rows into task_lists table
 func (mdb *db) InsertIntoTaskLists(ctx context.Context, row *sqlplugin.TaskListsRow) (sql.Result, error) {
 	return mdb.driver.NamedExecContext(ctx, row.ShardID, createTaskListQry, row)
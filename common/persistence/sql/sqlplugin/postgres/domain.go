@@ -44,6 +44,7 @@ const (
 
 	listDomainsQuery      = getDomainPart + ` WHERE shard_id=$1 ORDER BY id LIMIT $2`
 	listDomainsRangeQuery = getDomainPart + ` WHERE shard_id=$1 AND id > $2 ORDER BY id LIMIT $3`
+	countDomainsQuery     = `SELECT COUNT(*) FROM domains WHERE shard_id=$1`
 
 	deleteDomainByIDQuery   = `DELETE FROM domains WHERE shard_id=$1 AND id = $2`
 	deleteDomainByNameQuery = `DELETE FROM domains WHERE shard_id=$1 AND name = $2`
@@ -108,6 +109,13 @@ func (pdb *db) selectAllFromDomain(ctx context.Context, filter *sqlplugin.Domain
 	return rows, err
 }
 
+// CountFromDomain returns the number of rows in domains table
+func (pdb *db) CountFromDomain(ctx context.Context) (int64, error) {
+	var count int64
+	err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &count, countDomainsQuery, shardID)
+	return count, err
+}
+
 // DeleteFromDomain deletes a single row in domains table
 func (pdb *db) DeleteFromDomain(ctx context.Context, filter *sqlplugin.DomainFilter) (sql.Result, error) {
 	var err error
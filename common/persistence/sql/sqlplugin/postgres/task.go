@@ -99,6 +99,9 @@ task_type = :task_type
 		`	SELECT domain_id, name, task_type FROM task_lists AS tl ` +
 		`	WHERE t.domain_id=tl.domain_id and t.task_list_name=tl.name and t.task_type=tl.task_type ` +
 		`) LIMIT $1;`
+
+	getTasksUsageQry = `SELECT COUNT(1) AS task_count, COALESCE(SUM(OCTET_LENGTH(data)), 0) AS size_bytes FROM tasks ` +
+		`WHERE domain_id = $1 AND task_list_name = $2 AND task_type = $3`
 )
 
 // InsertIntoTasks inserts one or more rows into tasks table
@@ -151,6 +154,16 @@ func (pdb *db) GetOrphanTasks(ctx context.Context, filter *sqlplugin.OrphanTasks
 	return rows, nil
 }
 
+// GetTasksUsage counts the rows and data bytes stored in tasks table for a task list
+func (pdb *db) GetTasksUsage(ctx context.Context, filter *sqlplugin.TasksFilter) (*sqlplugin.TasksUsageRow, error) {
+	var row sqlplugin.TasksUsageRow
+	err := pdb.driver.GetContext(ctx, filter.ShardID, &row, getTasksUsageQry, filter.DomainID, filter.TaskListName, filter.TaskType)
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
 // InsertIntoTaskLists inserts one or more rows into task_lists table
 func (pdb *db) InsertIntoTaskLists(ctx context.Context, row *sqlplugin.TaskListsRow) (sql.Result, error) {
 	return pdb.driver.NamedExecContext(ctx, row.ShardID, createTaskListQry, row)
@@ -109,6 +109,18 @@ func (t *taskManager) GetOrphanTasks(ctx context.Context, request *GetOrphanTask
 	return t.persistence.GetOrphanTasks(ctx, request)
 }
 
+func (t *taskManager) GetTaskListUsage(ctx context.Context, request *GetTaskListUsageRequest) (*GetTaskListUsageResponse, error) {
+	return t.persistence.GetTaskListUsage(ctx, request)
+}
+
+func (t *taskManager) GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	return t.persistence.GetTaskList(ctx, request)
+}
+
+func (t *taskManager) UpdateTaskListTags(ctx context.Context, request *UpdateTaskListTagsRequest) error {
+	return t.persistence.UpdateTaskListTags(ctx, request)
+}
+
 func (t *taskManager) toInternalCreateTaskInfo(createTaskInfo *CreateTaskInfo) *InternalCreateTasksInfo {
 	if createTaskInfo == nil {
 		return nil
@@ -133,6 +145,7 @@ func (t *taskManager) toInternalTaskInfo(taskInfo *TaskInfo) *InternalTaskInfo {
 		ScheduleToStartTimeout: common.SecondsToDuration(int64(taskInfo.ScheduleToStartTimeout)),
 		Expiry:                 taskInfo.Expiry,
 		CreatedTime:            taskInfo.CreatedTime,
+		AffinityKey:            taskInfo.AffinityKey,
 	}
 }
 func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *TaskInfo {
@@ -148,5 +161,6 @@ func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *
 		ScheduleToStartTimeout: int32(internalTaskInfo.ScheduleToStartTimeout.Seconds()),
 		Expiry:                 internalTaskInfo.Expiry,
 		CreatedTime:            internalTaskInfo.CreatedTime,
+		AffinityKey:            internalTaskInfo.AffinityKey,
 	}
 }
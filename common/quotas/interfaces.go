@@ -22,6 +22,7 @@ package quotas
 
 import (
 	"context"
+	"time"
 
 	"golang.org/x/time/rate"
 )
@@ -59,4 +60,8 @@ type Policy interface {
 	// immediately with a true or false indicating if the request can make
 	// progress
 	Allow(info Info) bool
+
+	// RetryAfter returns how long a request rejected by Allow is expected to
+	// wait until it can make progress, or zero if this is not known
+	RetryAfter(info Info) time.Duration
 }
@@ -73,6 +73,21 @@ func TestMultiStageRateLimiterBlockedByGlobalRps(t *testing.T) {
 	check(" after refill")
 }
 
+func TestMultiStageRateLimiterRetryAfter(t *testing.T) {
+	t.Parallel()
+	policy := newFixedRpsMultiStageRateLimiter(4, 1)
+	assert.True(t, policy.Allow(Info{Domain: defaultDomain}))
+	assert.False(t, policy.Allow(Info{Domain: defaultDomain}))
+
+	// the domain limiter refills slower than the global one
+	retryAfter := policy.RetryAfter(Info{Domain: defaultDomain})
+	assert.True(t, retryAfter > 500*time.Millisecond && retryAfter <= time.Second, retryAfter)
+	assert.True(t, policy.RetryAfter(Info{}) <= 250*time.Millisecond)
+
+	// asking for the delay does not consume a token
+	assert.InDelta(t, retryAfter.Seconds(), policy.RetryAfter(Info{Domain: defaultDomain}).Seconds(), 0.1)
+}
+
 func TestMultiStageRateLimitingMultipleDomains(t *testing.T) {
 	t.Parallel()
 	policy := newFixedRpsMultiStageRateLimiter(2, 1) // should allow 1/s per domain, 2/s total
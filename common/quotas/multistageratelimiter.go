@@ -20,6 +20,10 @@
 
 package quotas
 
+import (
+	"time"
+)
+
 // MultiStageRateLimiter indicates a domain specific rate limit policy
 type MultiStageRateLimiter struct {
 	domainLimiters *Collection
@@ -65,3 +69,25 @@ func (d *MultiStageRateLimiter) Allow(info Info) bool {
 	}
 	return true
 }
+
+// RetryAfter returns how long a request rejected by Allow is expected to wait
+// until both the domain and the global limiter have a token available
+func (d *MultiStageRateLimiter) RetryAfter(info Info) time.Duration {
+	retryAfter := reservationDelay(d.globalLimiter)
+	if len(info.Domain) > 0 {
+		if domainDelay := reservationDelay(d.domainLimiters.For(info.Domain)); domainDelay > retryAfter {
+			retryAfter = domainDelay
+		}
+	}
+	return retryAfter
+}
+
+// reservationDelay returns the delay of a reservation on the limiter without consuming a token
+func reservationDelay(limiter Limiter) time.Duration {
+	rsv := limiter.Reserve()
+	if !rsv.OK() {
+		return 0
+	}
+	defer rsv.Cancel()
+	return rsv.Delay()
+}
@@ -22,6 +22,7 @@ package resource
 
 import (
 	"github.com/uber-go/tally"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 
 	"github.com/uber/cadence/common"
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
 	es "github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
@@ -64,7 +66,9 @@ type (
 		PublicClient             workflowserviceclient.Interface
 		ArchivalMetadata         archiver.ArchivalMetadata
 		ArchiverProvider         provider.ArchiverProvider
+		DomainProvisioningSteps  []domain.ProvisioningStep
 		Authorizer               authorization.Authorizer // NOTE: this can be nil. If nil, AccessControlledHandlerImpl will initiate one with config.Authorization
 		AuthorizationConfig      config.Authorization     // NOTE: empty(default) struct will get a authorization.NoopAuthorizer
+		TracerProvider           trace.TracerProvider     // NOTE: this can be nil, tracing is then disabled
 	}
 )
@@ -42,6 +42,10 @@ func loadRSAKey(path string, keyType KeyType) (interface{}, error) {
 	if err != nil {
 		return nil, fmt.Errorf("invalid %s path %s", keyType, path)
 	}
+	return parseRSAKey(keyString, keyType)
+}
+
+func parseRSAKey(keyString []byte, keyType KeyType) (interface{}, error) {
 	block, _ := pem.Decode(keyString)
 	if block == nil || strings.ToLower(block.Type) != strings.ToLower(string(keyType)) {
 		return nil, fmt.Errorf("failed to parse PEM block containing the %s", keyType)
@@ -80,3 +84,12 @@ func LoadRSAPrivateKey(path string) (*rsa.PrivateKey, error) {
 	}
 	return key.(*rsa.PrivateKey), err
 }
+
+// ParseRSAPrivateKey parses a PEM encoded PKCS8 RSA private key
+func ParseRSAPrivateKey(key []byte) (*rsa.PrivateKey, error) {
+	parsed, err := parseRSAKey(key, KeyTypePrivate)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.(*rsa.PrivateKey), err
+}
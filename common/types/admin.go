@@ -553,3 +553,533 @@ func (v *ListDynamicConfigResponse) GetEntries() (o []*DynamicConfigEntry) {
 	}
 	return
 }
+
+// DescribeTaskListConfigRequest is an internal type (TBD...)
+type DescribeTaskListConfigRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *DescribeTaskListConfigRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *DescribeTaskListConfigRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *DescribeTaskListConfigRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// DescribeTaskListConfigResponse is an internal type (TBD...)
+type DescribeTaskListConfigResponse struct {
+	NumReadPartitions                   int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions                  int32 `json:"numWritePartitions,omitempty"`
+	ForwarderMaxOutstandingPolls        int32 `json:"forwarderMaxOutstandingPolls,omitempty"`
+	ForwarderMaxOutstandingTasks        int32 `json:"forwarderMaxOutstandingTasks,omitempty"`
+	ForwarderMaxRatePerSecond           int32 `json:"forwarderMaxRatePerSecond,omitempty"`
+	ForwarderMaxChildrenPerNode         int32 `json:"forwarderMaxChildrenPerNode,omitempty"`
+	EnableSyncMatch                     bool  `json:"enableSyncMatch,omitempty"`
+	EnableEphemeralTaskList             bool  `json:"enableEphemeralTaskList,omitempty"`
+	LongPollExpirationIntervalInSeconds int64 `json:"longPollExpirationIntervalInSeconds,omitempty"`
+	RangeSize                           int64 `json:"rangeSize,omitempty"`
+	GetTasksBatchSize                   int32 `json:"getTasksBatchSize,omitempty"`
+	UpdateAckIntervalInSeconds          int64 `json:"updateAckIntervalInSeconds,omitempty"`
+	IdleTaskListCheckIntervalInSeconds  int64 `json:"idleTaskListCheckIntervalInSeconds,omitempty"`
+	MaxTaskListIdleTimeInSeconds        int64 `json:"maxTaskListIdleTimeInSeconds,omitempty"`
+	MinTaskThrottlingBurstSize          int32 `json:"minTaskThrottlingBurstSize,omitempty"`
+	MaxTaskDeleteBatchSize              int32 `json:"maxTaskDeleteBatchSize,omitempty"`
+	OutstandingTaskAppendsThreshold     int32 `json:"outstandingTaskAppendsThreshold,omitempty"`
+	MaxTaskBatchSize                    int32 `json:"maxTaskBatchSize,omitempty"`
+}
+
+// GetNumReadPartitions is an internal getter (TBD...)
+func (v *DescribeTaskListConfigResponse) GetNumReadPartitions() (o int32) {
+	if v != nil {
+		return v.NumReadPartitions
+	}
+	return
+}
+
+// GetNumWritePartitions is an internal getter (TBD...)
+func (v *DescribeTaskListConfigResponse) GetNumWritePartitions() (o int32) {
+	if v != nil {
+		return v.NumWritePartitions
+	}
+	return
+}
+
+// GetEnableSyncMatch is an internal getter (TBD...)
+func (v *DescribeTaskListConfigResponse) GetEnableSyncMatch() (o bool) {
+	if v != nil {
+		return v.EnableSyncMatch
+	}
+	return
+}
+
+// GetTaskListUsageRequest is an internal type (TBD...)
+type GetTaskListUsageRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetTaskListUsageRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *GetTaskListUsageRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *GetTaskListUsageRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetTaskListUsageResponse is an internal type (TBD...)
+type GetTaskListUsageResponse struct {
+	TaskCount int64 `json:"taskCount,omitempty"`
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
+	Estimated bool  `json:"estimated,omitempty"`
+}
+
+// GetTaskCount is an internal getter (TBD...)
+func (v *GetTaskListUsageResponse) GetTaskCount() (o int64) {
+	if v != nil {
+		return v.TaskCount
+	}
+	return
+}
+
+// GetSizeBytes is an internal getter (TBD...)
+func (v *GetTaskListUsageResponse) GetSizeBytes() (o int64) {
+	if v != nil {
+		return v.SizeBytes
+	}
+	return
+}
+
+// GetEstimated is an internal getter (TBD...)
+func (v *GetTaskListUsageResponse) GetEstimated() (o bool) {
+	if v != nil {
+		return v.Estimated
+	}
+	return
+}
+
+// IssueDomainTokenRequest is an internal type (TBD...)
+type IssueDomainTokenRequest struct {
+	Domain     string   `json:"domain,omitempty"`
+	APIs       []string `json:"apis,omitempty"`
+	TTLSeconds int64    `json:"ttlSeconds,omitempty"`
+	Subject    string   `json:"subject,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *IssueDomainTokenRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetAPIs is an internal getter (TBD...)
+func (v *IssueDomainTokenRequest) GetAPIs() (o []string) {
+	if v != nil && v.APIs != nil {
+		return v.APIs
+	}
+	return
+}
+
+// GetTTLSeconds is an internal getter (TBD...)
+func (v *IssueDomainTokenRequest) GetTTLSeconds() (o int64) {
+	if v != nil {
+		return v.TTLSeconds
+	}
+	return
+}
+
+// GetSubject is an internal getter (TBD...)
+func (v *IssueDomainTokenRequest) GetSubject() (o string) {
+	if v != nil {
+		return v.Subject
+	}
+	return
+}
+
+// IssueDomainTokenResponse is an internal type (TBD...)
+type IssueDomainTokenResponse struct {
+	Token string `json:"token,omitempty"`
+}
+
+// GetToken is an internal getter (TBD...)
+func (v *IssueDomainTokenResponse) GetToken() (o string) {
+	if v != nil {
+		return v.Token
+	}
+	return
+}
+
+// StealTaskListLeaseRequest is an internal type (TBD...)
+type StealTaskListLeaseRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *StealTaskListLeaseRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *StealTaskListLeaseRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *StealTaskListLeaseRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// StealTaskListLeaseResponse is an internal type (TBD...)
+type StealTaskListLeaseResponse struct {
+	RangeID int64 `json:"rangeID,omitempty"`
+}
+
+// GetRangeID is an internal getter (TBD...)
+func (v *StealTaskListLeaseResponse) GetRangeID() (o int64) {
+	if v != nil {
+		return v.RangeID
+	}
+	return
+}
+
+// UpdateTaskListTagsRequest is an internal type (TBD...)
+type UpdateTaskListTagsRequest struct {
+	Domain       string            `json:"domain,omitempty"`
+	TaskList     *TaskList         `json:"taskList,omitempty"`
+	TaskListType *TaskListType     `json:"taskListType,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateTaskListTagsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *UpdateTaskListTagsRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *UpdateTaskListTagsRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetTags is an internal getter (TBD...)
+func (v *UpdateTaskListTagsRequest) GetTags() (o map[string]string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+	return
+}
+
+// UpdateTaskListTagsResponse is an internal type (TBD...)
+type UpdateTaskListTagsResponse struct {
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// GetTags is an internal getter (TBD...)
+func (v *UpdateTaskListTagsResponse) GetTags() (o map[string]string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+	return
+}
+
+// ListTaskListTagsRequest is an internal type (TBD...)
+type ListTaskListTagsRequest struct {
+	Domain string            `json:"domain,omitempty"`
+	Tags   map[string]string `json:"tags,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ListTaskListTagsRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTags is an internal getter (TBD...)
+func (v *ListTaskListTagsRequest) GetTags() (o map[string]string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+	return
+}
+
+// TaskListTags is an internal type (TBD...)
+type TaskListTags struct {
+	Name         string            `json:"name,omitempty"`
+	TaskListType *TaskListType     `json:"taskListType,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *TaskListTags) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *TaskListTags) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetTags is an internal getter (TBD...)
+func (v *TaskListTags) GetTags() (o map[string]string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+	return
+}
+
+// ListTaskListTagsResponse is an internal type (TBD...)
+type ListTaskListTagsResponse struct {
+	TaskLists []*TaskListTags `json:"taskLists,omitempty"`
+}
+
+// GetTaskLists is an internal getter (TBD...)
+func (v *ListTaskListTagsResponse) GetTaskLists() (o []*TaskListTags) {
+	if v != nil && v.TaskLists != nil {
+		return v.TaskLists
+	}
+	return
+}
+
+// BackfillDomainReplicationRequest is an internal type (TBD...)
+type BackfillDomainReplicationRequest struct {
+	Domain              string `json:"domain,omitempty"`
+	TargetCluster       string `json:"targetCluster,omitempty"`
+	ClosedWithinSeconds int64  `json:"closedWithinSeconds,omitempty"`
+	RPS                 int32  `json:"rps,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *BackfillDomainReplicationRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTargetCluster is an internal getter (TBD...)
+func (v *BackfillDomainReplicationRequest) GetTargetCluster() (o string) {
+	if v != nil {
+		return v.TargetCluster
+	}
+	return
+}
+
+// GetClosedWithinSeconds is an internal getter (TBD...)
+func (v *BackfillDomainReplicationRequest) GetClosedWithinSeconds() (o int64) {
+	if v != nil {
+		return v.ClosedWithinSeconds
+	}
+	return
+}
+
+// GetRPS is an internal getter (TBD...)
+func (v *BackfillDomainReplicationRequest) GetRPS() (o int32) {
+	if v != nil {
+		return v.RPS
+	}
+	return
+}
+
+// BackfillDomainReplicationResponse is an internal type (TBD...)
+type BackfillDomainReplicationResponse struct {
+	JobID string `json:"jobID,omitempty"`
+}
+
+// GetJobID is an internal getter (TBD...)
+func (v *BackfillDomainReplicationResponse) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
+
+// UpdateDomainAsyncWorkflowConfigRequest is an internal type (TBD...)
+type UpdateDomainAsyncWorkflowConfigRequest struct {
+	Domain        string                      `json:"domain,omitempty"`
+	Configuration *AsyncWorkflowConfiguration `json:"configuration,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *UpdateDomainAsyncWorkflowConfigRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetConfiguration is an internal getter (TBD...)
+func (v *UpdateDomainAsyncWorkflowConfigRequest) GetConfiguration() (o *AsyncWorkflowConfiguration) {
+	if v != nil {
+		return v.Configuration
+	}
+	return
+}
+
+// UpdateDomainAsyncWorkflowConfigResponse is an internal type (TBD...)
+type UpdateDomainAsyncWorkflowConfigResponse struct {
+}
+
+// GetDomainAsyncWorkflowConfigRequest is an internal type (TBD...)
+type GetDomainAsyncWorkflowConfigRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetDomainAsyncWorkflowConfigRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetDomainAsyncWorkflowConfigResponse is an internal type (TBD...)
+type GetDomainAsyncWorkflowConfigResponse struct {
+	Configuration *AsyncWorkflowConfiguration `json:"configuration,omitempty"`
+}
+
+// GetConfiguration is an internal getter (TBD...)
+func (v *GetDomainAsyncWorkflowConfigResponse) GetConfiguration() (o *AsyncWorkflowConfiguration) {
+	if v != nil {
+		return v.Configuration
+	}
+	return
+}
+
+// GetDomainReplicationWatermarkRequest is an internal type (TBD...)
+type GetDomainReplicationWatermarkRequest struct {
+	Domain string `json:"domain,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *GetDomainReplicationWatermarkRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetDomainReplicationWatermarkResponse is an internal type (TBD...)
+type GetDomainReplicationWatermarkResponse struct {
+	Cluster                 string `json:"cluster,omitempty"`
+	LastReplicatedEventTime *int64 `json:"lastReplicatedEventTime,omitempty"`
+}
+
+// GetCluster is an internal getter (TBD...)
+func (v *GetDomainReplicationWatermarkResponse) GetCluster() (o string) {
+	if v != nil {
+		return v.Cluster
+	}
+	return
+}
+
+// GetLastReplicatedEventTime is an internal getter (TBD...)
+func (v *GetDomainReplicationWatermarkResponse) GetLastReplicatedEventTime() (o int64) {
+	if v != nil && v.LastReplicatedEventTime != nil {
+		return *v.LastReplicatedEventTime
+	}
+	return
+}
+
+// ReconcileDomainRetentionRequest is an internal type (TBD...)
+type ReconcileDomainRetentionRequest struct {
+	Domain string `json:"domain,omitempty"`
+	RPS    int32  `json:"rps,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ReconcileDomainRetentionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetRPS is an internal getter (TBD...)
+func (v *ReconcileDomainRetentionRequest) GetRPS() (o int32) {
+	if v != nil {
+		return v.RPS
+	}
+	return
+}
+
+// ReconcileDomainRetentionResponse is an internal type (TBD...)
+type ReconcileDomainRetentionResponse struct {
+	JobID string `json:"jobID,omitempty"`
+}
+
+// GetJobID is an internal getter (TBD...)
+func (v *ReconcileDomainRetentionResponse) GetJobID() (o string) {
+	if v != nil {
+		return v.JobID
+	}
+	return
+}
@@ -129,7 +129,12 @@ func (err RetryTaskV2Error) Error() string {
 }
 
 func (err ServiceBusyError) Error() string {
-	return fmt.Sprintf("ServiceBusyError{Message: %v}", err.Message)
+	sb := &strings.Builder{}
+	printField(sb, "Message", err.Message)
+	if err.RetryAfterInMillis != 0 {
+		printField(sb, "RetryAfterInMillis", err.RetryAfterInMillis)
+	}
+	return fmt.Sprintf("ServiceBusyError{%s}", sb.String())
 }
 
 func (err WorkflowExecutionAlreadyStartedError) Error() string {
@@ -41,3 +41,83 @@ func (v *HealthStatus) GetMsg() (o string) {
 	}
 	return
 }
+
+// MatchingHostHealth is an internal type (TBD...)
+type MatchingHostHealth struct {
+	Ready                bool                      `json:"ready"`
+	LoadedTaskLists      int                       `json:"loadedTaskLists"`
+	LeaseFailures        int64                     `json:"leaseFailures"`
+	PersistenceRequests  int64                     `json:"persistenceRequests"`
+	PersistenceErrors    int64                     `json:"persistenceErrors"`
+	PersistenceErrorRate float64                   `json:"persistenceErrorRate"`
+	Draining             bool                      `json:"draining"`
+	NotReadyTaskLists    []*MatchingTaskListHealth `json:"notReadyTaskLists,omitempty"`
+	ContestedTaskLists   []*MatchingTaskListHealth `json:"contestedTaskLists,omitempty"`
+}
+
+// GetReady is an internal getter (TBD...)
+func (v *MatchingHostHealth) GetReady() (o bool) {
+	if v != nil {
+		return v.Ready
+	}
+	return
+}
+
+// GetLoadedTaskLists is an internal getter (TBD...)
+func (v *MatchingHostHealth) GetLoadedTaskLists() (o int) {
+	if v != nil {
+		return v.LoadedTaskLists
+	}
+	return
+}
+
+// GetLeaseFailures is an internal getter (TBD...)
+func (v *MatchingHostHealth) GetLeaseFailures() (o int64) {
+	if v != nil {
+		return v.LeaseFailures
+	}
+	return
+}
+
+// GetPersistenceErrorRate is an internal getter (TBD...)
+func (v *MatchingHostHealth) GetPersistenceErrorRate() (o float64) {
+	if v != nil {
+		return v.PersistenceErrorRate
+	}
+	return
+}
+
+// GetDraining is an internal getter (TBD...)
+func (v *MatchingHostHealth) GetDraining() (o bool) {
+	if v != nil {
+		return v.Draining
+	}
+	return
+}
+
+// GetNotReadyTaskLists is an internal getter (TBD...)
+func (v *MatchingHostHealth) GetNotReadyTaskLists() (o []*MatchingTaskListHealth) {
+	if v != nil {
+		return v.NotReadyTaskLists
+	}
+	return
+}
+
+// GetContestedTaskLists is an internal getter (TBD...)
+func (v *MatchingHostHealth) GetContestedTaskLists() (o []*MatchingTaskListHealth) {
+	if v != nil {
+		return v.ContestedTaskLists
+	}
+	return
+}
+
+// MatchingTaskListHealth is an internal type (TBD...)
+type MatchingTaskListHealth struct {
+	DomainID       string       `json:"domainID"`
+	Name           string       `json:"name"`
+	TaskListType   TaskListType `json:"taskListType"`
+	LastError      string       `json:"lastError,omitempty"`
+	RangeID        int64        `json:"rangeID"`
+	LeaseRenewals  int64        `json:"leaseRenewals"`
+	LeaseConflicts int64        `json:"leaseConflicts"`
+}
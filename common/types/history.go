@@ -1521,6 +1521,28 @@ func (v *HistorySignalWorkflowExecutionRequest) GetChildWorkflowOnly() (o bool)
 	return
 }
 
+// HistorySignalBatchWorkflowExecutionRequest is an internal type (TBD...)
+type HistorySignalBatchWorkflowExecutionRequest struct {
+	DomainUUID    string                               `json:"domainUUID,omitempty"`
+	SignalRequest *SignalBatchWorkflowExecutionRequest `json:"signalRequest,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *HistorySignalBatchWorkflowExecutionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetSignalRequest is an internal getter (TBD...)
+func (v *HistorySignalBatchWorkflowExecutionRequest) GetSignalRequest() (o *SignalBatchWorkflowExecutionRequest) {
+	if v != nil && v.SignalRequest != nil {
+		return v.SignalRequest
+	}
+	return
+}
+
 // HistoryStartWorkflowExecutionRequest is an internal type (TBD...)
 type HistoryStartWorkflowExecutionRequest struct {
 	DomainUUID                      string                         `json:"domainUUID,omitempty"`
@@ -1859,3 +1881,60 @@ func (v *GetFailoverInfoResponse) GetPendingShards() (o []int32) {
 	}
 	return
 }
+
+// HistoryGetDomainReplicationWatermarkRequest is an internal type (TBD...)
+type HistoryGetDomainReplicationWatermarkRequest struct {
+	DomainID string  `json:"domainID,omitempty"`
+	ShardIDs []int32 `json:"shardIDs,omitempty"`
+}
+
+// GetDomainID is an internal getter (TBD...)
+func (v *HistoryGetDomainReplicationWatermarkRequest) GetDomainID() (o string) {
+	if v != nil {
+		return v.DomainID
+	}
+	return
+}
+
+// GetShardIDs is an internal getter (TBD...)
+func (v *HistoryGetDomainReplicationWatermarkRequest) GetShardIDs() (o []int32) {
+	if v != nil && v.ShardIDs != nil {
+		return v.ShardIDs
+	}
+	return
+}
+
+// HistoryGetDomainReplicationWatermarkResponse is an internal type (TBD...)
+type HistoryGetDomainReplicationWatermarkResponse struct {
+	LastReplicatedEventTime *int64 `json:"lastReplicatedEventTime,omitempty"`
+}
+
+// GetLastReplicatedEventTime is an internal getter (TBD...)
+func (v *HistoryGetDomainReplicationWatermarkResponse) GetLastReplicatedEventTime() (o int64) {
+	if v != nil && v.LastReplicatedEventTime != nil {
+		return *v.LastReplicatedEventTime
+	}
+	return
+}
+
+// ReconcileWorkflowRetentionRequest is an internal type (TBD...)
+type ReconcileWorkflowRetentionRequest struct {
+	DomainUUID        string             `json:"domainUUID,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *ReconcileWorkflowRetentionRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *ReconcileWorkflowRetentionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package json
+
+import (
+	encodingjson "encoding/json"
+	"sort"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// Domain is the JSON representation of types.DescribeDomainResponse,
+	// domain info, configuration and replication configuration are flattened into one object
+	Domain struct {
+		Name                     string                             `json:"name"`
+		UUID                     string                             `json:"uuid"`
+		Status                   string                             `json:"status,omitempty"`
+		Description              string                             `json:"description,omitempty"`
+		OwnerEmail               string                             `json:"ownerEmail,omitempty"`
+		Data                     map[string]string                  `json:"data,omitempty"`
+		Metadata                 map[string]encodingjson.RawMessage `json:"metadata,omitempty"`
+		RetentionDays            int32                              `json:"retentionDays"`
+		EmitMetric               bool                               `json:"emitMetric"`
+		BadBinaries              map[string]*BadBinary              `json:"badBinaries,omitempty"`
+		HistoryArchivalStatus    string                             `json:"historyArchivalStatus,omitempty"`
+		HistoryArchivalURI       string                             `json:"historyArchivalUri,omitempty"`
+		VisibilityArchivalStatus string                             `json:"visibilityArchivalStatus,omitempty"`
+		VisibilityArchivalURI    string                             `json:"visibilityArchivalUri,omitempty"`
+		IsGlobalDomain           bool                               `json:"isGlobalDomain"`
+		ActiveClusterName        string                             `json:"activeClusterName,omitempty"`
+		Clusters                 []string                           `json:"clusters,omitempty"`
+		FailoverVersion          int64                              `json:"failoverVersion"`
+		FailoverInfo             *FailoverInfo                      `json:"failoverInfo,omitempty"`
+	}
+
+	// BadBinary is the JSON representation of types.BadBinaryInfo
+	BadBinary struct {
+		Reason      string `json:"reason,omitempty"`
+		Operator    string `json:"operator,omitempty"`
+		CreatedTime string `json:"createdTime,omitempty"`
+	}
+
+	// FailoverInfo is the JSON representation of types.FailoverInfo
+	FailoverInfo struct {
+		FailoverVersion     int64   `json:"failoverVersion"`
+		StartTime           string  `json:"startTime,omitempty"`
+		ExpireTime          string  `json:"expireTime,omitempty"`
+		CompletedShardCount int32   `json:"completedShardCount"`
+		PendingShards       []int32 `json:"pendingShards,omitempty"`
+	}
+)
+
+// FromDescribeDomainResponse converts internal DescribeDomainResponse type to JSON.
+// Clusters and pending shards are sorted, so the output only changes when the domain does.
+func FromDescribeDomainResponse(t *types.DescribeDomainResponse) *Domain {
+	if t == nil {
+		return nil
+	}
+	info := t.GetDomainInfo()
+	config := t.GetConfiguration()
+	domain := &Domain{
+		Name:                  info.GetName(),
+		UUID:                  info.GetUUID(),
+		Description:           info.GetDescription(),
+		OwnerEmail:            info.GetOwnerEmail(),
+		Data:                  info.GetData(),
+		Metadata:              fromDomainMetadata(info.GetMetadata()),
+		RetentionDays:         config.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:            config.GetEmitMetric(),
+		BadBinaries:           fromBadBinaries(config.GetBadBinaries()),
+		HistoryArchivalURI:    config.GetHistoryArchivalURI(),
+		VisibilityArchivalURI: config.GetVisibilityArchivalURI(),
+		IsGlobalDomain:        t.GetIsGlobalDomain(),
+		ActiveClusterName:     t.GetReplicationConfiguration().GetActiveClusterName(),
+		Clusters:              fromClusterReplicationConfigurationArray(t.GetReplicationConfiguration().GetClusters()),
+		FailoverVersion:       t.GetFailoverVersion(),
+		FailoverInfo:          FromFailoverInfo(t.GetFailoverInfo()),
+	}
+	if info != nil && info.Status != nil {
+		domain.Status = info.Status.String()
+	}
+	if config != nil && config.HistoryArchivalStatus != nil {
+		domain.HistoryArchivalStatus = config.HistoryArchivalStatus.String()
+	}
+	if config != nil && config.VisibilityArchivalStatus != nil {
+		domain.VisibilityArchivalStatus = config.VisibilityArchivalStatus.String()
+	}
+	return domain
+}
+
+// FromDescribeDomainResponseArray converts internal DescribeDomainResponse type array to JSON
+func FromDescribeDomainResponseArray(t []*types.DescribeDomainResponse) []*Domain {
+	if t == nil {
+		return nil
+	}
+	v := make([]*Domain, len(t))
+	for i := range t {
+		v[i] = FromDescribeDomainResponse(t[i])
+	}
+	return v
+}
+
+// FromFailoverInfo converts internal FailoverInfo type to JSON
+func FromFailoverInfo(t *types.FailoverInfo) *FailoverInfo {
+	if t == nil {
+		return nil
+	}
+	var pendingShards []int32
+	if len(t.PendingShards) > 0 {
+		pendingShards = make([]int32, len(t.PendingShards))
+		copy(pendingShards, t.PendingShards)
+		sort.Slice(pendingShards, func(i, j int) bool { return pendingShards[i] < pendingShards[j] })
+	}
+	return &FailoverInfo{
+		FailoverVersion:     t.FailoverVersion,
+		StartTime:           fromUnixNano(&t.FailoverStartTimestamp),
+		ExpireTime:          fromUnixNano(&t.FailoverExpireTimestamp),
+		CompletedShardCount: t.CompletedShardCount,
+		PendingShards:       pendingShards,
+	}
+}
+
+func fromBadBinaries(t *types.BadBinaries) map[string]*BadBinary {
+	if t == nil || len(t.Binaries) == 0 {
+		return nil
+	}
+	v := make(map[string]*BadBinary, len(t.Binaries))
+	for checksum, info := range t.Binaries {
+		v[checksum] = &BadBinary{
+			Reason:      info.GetReason(),
+			Operator:    info.GetOperator(),
+			CreatedTime: fromUnixNano(info.CreatedTimeNano),
+		}
+	}
+	return v
+}
+
+// fromDomainMetadata embeds the metadata documents as they are, the frontend only stores valid JSON
+func fromDomainMetadata(t map[string][]byte) map[string]encodingjson.RawMessage {
+	if len(t) == 0 {
+		return nil
+	}
+	v := make(map[string]encodingjson.RawMessage, len(t))
+	for key, value := range t {
+		v[key] = value
+	}
+	return v
+}
+
+func fromClusterReplicationConfigurationArray(t []*types.ClusterReplicationConfiguration) []string {
+	if len(t) == 0 {
+		return nil
+	}
+	v := make([]string, len(t))
+	for i := range t {
+		v[i] = t[i].GetClusterName()
+	}
+	sort.Strings(v)
+	return v
+}
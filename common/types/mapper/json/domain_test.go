@@ -0,0 +1,129 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package json
+
+import (
+	encodingjson "encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestDomain(t *testing.T) {
+	assert.Nil(t, FromDescribeDomainResponse(nil))
+	assert.Nil(t, FromDescribeDomainResponseArray(nil))
+	assert.Nil(t, FromFailoverInfo(nil))
+
+	domain := FromDescribeDomainResponse(&testdata.DescribeDomainResponse)
+	assert.Equal(t, testdata.DomainName, domain.Name)
+	assert.Equal(t, testdata.DomainID, domain.UUID)
+	assert.Equal(t, testdata.DomainStatus.String(), domain.Status)
+	assert.Equal(t, testdata.DomainData, domain.Data)
+	assert.Equal(t, int32(testdata.DomainRetention), domain.RetentionDays)
+	assert.Equal(t, testdata.ArchivalStatus.String(), domain.HistoryArchivalStatus)
+	assert.Equal(t, testdata.HistoryArchivalURI, domain.HistoryArchivalURI)
+	assert.Equal(t, testdata.BadBinaryReason, domain.BadBinaries["BadBinary1"].Reason)
+	assert.Equal(t, []string{testdata.ClusterName1}, domain.Clusters)
+	assert.True(t, domain.IsGlobalDomain)
+
+	assert.Equal(t, []*Domain{domain}, FromDescribeDomainResponseArray([]*types.DescribeDomainResponse{&testdata.DescribeDomainResponse}))
+}
+
+func TestDomain_JSON(t *testing.T) {
+	marshal := func(response *types.DescribeDomainResponse) string {
+		data, err := encodingjson.Marshal(FromDescribeDomainResponse(response))
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	response := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{
+			Name:   "domain",
+			UUID:   "uuid",
+			Status: types.DomainStatusRegistered.Ptr(),
+		},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "c2",
+			Clusters: []*types.ClusterReplicationConfiguration{
+				{ClusterName: "c2"},
+				{ClusterName: "c1"},
+			},
+		},
+		FailoverInfo: &types.FailoverInfo{
+			FailoverVersion: 2,
+			PendingShards:   []int32{3, 1, 2},
+		},
+		IsGlobalDomain: true,
+	}
+	expected := `{
+		"name": "domain",
+		"uuid": "uuid",
+		"status": "REGISTERED",
+		"retentionDays": 0,
+		"emitMetric": false,
+		"isGlobalDomain": true,
+		"activeClusterName": "c2",
+		"clusters": ["c1", "c2"],
+		"failoverVersion": 0,
+		"failoverInfo": {
+			"failoverVersion": 2,
+			"startTime": "1970-01-01T00:00:00Z",
+			"expireTime": "1970-01-01T00:00:00Z",
+			"completedShardCount": 0,
+			"pendingShards": [1, 2, 3]
+		}
+	}`
+	assert.JSONEq(t, expected, marshal(response))
+
+	// empty collections are omitted the same way as missing ones
+	response.DomainInfo.Data = map[string]string{}
+	response.DomainInfo.Metadata = map[string][]byte{}
+	response.Configuration = &types.DomainConfiguration{BadBinaries: &types.BadBinaries{Binaries: map[string]*types.BadBinaryInfo{}}}
+	assert.JSONEq(t, expected, marshal(response))
+}
+
+func TestDomain_Metadata(t *testing.T) {
+	response := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{
+			Name: "domain",
+			UUID: "uuid",
+			Metadata: map[string][]byte{
+				"tier":  []byte(`"gold"`),
+				"owner": []byte(`{"team":"platform"}`),
+			},
+		},
+	}
+	data, err := encodingjson.Marshal(FromDescribeDomainResponse(response))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "domain",
+		"uuid": "uuid",
+		"metadata": {"tier": "gold", "owner": {"team": "platform"}},
+		"retentionDays": 0,
+		"emitMetric": false,
+		"isGlobalDomain": false,
+		"failoverVersion": 0
+	}`, string(data))
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+// Package json maps common/types into stable JSON representations meant for external tooling.
+// Enums are rendered by name, timestamps as RFC3339 strings and JSON payloads are inlined.
+// Fields are only ever added to these types, never renamed or removed.
+package json
+
+import (
+	encodingjson "encoding/json"
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// WorkflowExecutionInfo is the JSON representation of types.WorkflowExecutionInfo
+	WorkflowExecutionInfo struct {
+		WorkflowID        string                 `json:"workflowId"`
+		RunID             string                 `json:"runId"`
+		WorkflowType      string                 `json:"workflowType,omitempty"`
+		TaskList          string                 `json:"taskList,omitempty"`
+		StartTime         string                 `json:"startTime,omitempty"`
+		ExecutionTime     string                 `json:"executionTime,omitempty"`
+		CloseTime         string                 `json:"closeTime,omitempty"`
+		CloseStatus       string                 `json:"closeStatus,omitempty"`
+		HistoryLength     int64                  `json:"historyLength"`
+		ParentDomainID    string                 `json:"parentDomainId,omitempty"`
+		ParentDomain      string                 `json:"parentDomain,omitempty"`
+		ParentWorkflowID  string                 `json:"parentWorkflowId,omitempty"`
+		ParentRunID       string                 `json:"parentRunId,omitempty"`
+		ParentInitiatedID *int64                 `json:"parentInitiatedId,omitempty"`
+		Memo              map[string]interface{} `json:"memo,omitempty"`
+		SearchAttributes  map[string]interface{} `json:"searchAttributes,omitempty"`
+		AutoResetPoints   []*ResetPointInfo      `json:"autoResetPoints,omitempty"`
+		IsCron            bool                   `json:"isCron"`
+	}
+
+	// ResetPointInfo is the JSON representation of types.ResetPointInfo
+	ResetPointInfo struct {
+		BinaryChecksum           string `json:"binaryChecksum"`
+		RunID                    string `json:"runId"`
+		FirstDecisionCompletedID int64  `json:"firstDecisionCompletedId"`
+		CreatedTime              string `json:"createdTime,omitempty"`
+		ExpiringTime             string `json:"expiringTime,omitempty"`
+		Resettable               bool   `json:"resettable"`
+	}
+
+	// HistoryEvent is the JSON representation of types.HistoryEvent,
+	// the attributes of the event type are put under a single attributes field
+	HistoryEvent struct {
+		EventID    int64       `json:"eventId"`
+		EventType  string      `json:"eventType"`
+		Timestamp  string      `json:"timestamp,omitempty"`
+		Version    int64       `json:"version"`
+		TaskID     int64       `json:"taskId"`
+		Attributes interface{} `json:"attributes,omitempty"`
+	}
+)
+
+// FromWorkflowExecutionInfo converts internal WorkflowExecutionInfo type to JSON
+func FromWorkflowExecutionInfo(t *types.WorkflowExecutionInfo) *WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	info := &WorkflowExecutionInfo{
+		WorkflowID:        t.GetExecution().GetWorkflowID(),
+		RunID:             t.GetExecution().GetRunID(),
+		WorkflowType:      t.GetType().GetName(),
+		TaskList:          t.TaskList,
+		StartTime:         fromUnixNano(t.StartTime),
+		ExecutionTime:     fromUnixNano(t.ExecutionTime),
+		CloseTime:         fromUnixNano(t.CloseTime),
+		HistoryLength:     t.HistoryLength,
+		ParentDomainID:    t.GetParentDomainID(),
+		ParentWorkflowID:  t.GetParentExecution().GetWorkflowID(),
+		ParentRunID:       t.GetParentExecution().GetRunID(),
+		ParentInitiatedID: t.ParentInitiatedID,
+		Memo:              fromPayloadMap(t.GetMemo().GetFields()),
+		SearchAttributes:  fromPayloadMap(t.GetSearchAttributes().GetIndexedFields()),
+		AutoResetPoints:   FromResetPointInfoArray(t.GetAutoResetPoints().GetPoints()),
+		IsCron:            t.IsCron,
+	}
+	if t.ParentDomain != nil {
+		info.ParentDomain = *t.ParentDomain
+	}
+	if t.CloseStatus != nil {
+		info.CloseStatus = t.CloseStatus.String()
+	}
+	return info
+}
+
+// FromWorkflowExecutionInfoArray converts internal WorkflowExecutionInfo type array to JSON
+func FromWorkflowExecutionInfoArray(t []*types.WorkflowExecutionInfo) []*WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*WorkflowExecutionInfo, len(t))
+	for i := range t {
+		v[i] = FromWorkflowExecutionInfo(t[i])
+	}
+	return v
+}
+
+// FromResetPointInfo converts internal ResetPointInfo type to JSON
+func FromResetPointInfo(t *types.ResetPointInfo) *ResetPointInfo {
+	if t == nil {
+		return nil
+	}
+	return &ResetPointInfo{
+		BinaryChecksum:           t.BinaryChecksum,
+		RunID:                    t.RunID,
+		FirstDecisionCompletedID: t.FirstDecisionCompletedID,
+		CreatedTime:              fromUnixNano(t.CreatedTimeNano),
+		ExpiringTime:             fromUnixNano(t.ExpiringTimeNano),
+		Resettable:               t.Resettable,
+	}
+}
+
+// FromResetPointInfoArray converts internal ResetPointInfo type array to JSON
+func FromResetPointInfoArray(t []*types.ResetPointInfo) []*ResetPointInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*ResetPointInfo, len(t))
+	for i := range t {
+		v[i] = FromResetPointInfo(t[i])
+	}
+	return v
+}
+
+// FromHistoryEvent converts internal HistoryEvent type to JSON
+func FromHistoryEvent(t *types.HistoryEvent) *HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	return &HistoryEvent{
+		EventID:    t.ID,
+		EventType:  t.GetEventType().String(),
+		Timestamp:  fromUnixNano(t.Timestamp),
+		Version:    t.Version,
+		TaskID:     t.TaskID,
+		Attributes: EventAttributes(t),
+	}
+}
+
+// FromHistoryEventArray converts internal HistoryEvent type array to JSON
+func FromHistoryEventArray(t []*types.HistoryEvent) []*HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	v := make([]*HistoryEvent, len(t))
+	for i := range t {
+		v[i] = FromHistoryEvent(t[i])
+	}
+	return v
+}
+
+// EventAttributes returns the attributes matching the type of the event, or nil for unknown event types
+func EventAttributes(e *types.HistoryEvent) interface{} {
+	switch e.GetEventType() {
+	case types.EventTypeWorkflowExecutionStarted:
+		return e.WorkflowExecutionStartedEventAttributes
+	case types.EventTypeWorkflowExecutionCompleted:
+		return e.WorkflowExecutionCompletedEventAttributes
+	case types.EventTypeWorkflowExecutionFailed:
+		return e.WorkflowExecutionFailedEventAttributes
+	case types.EventTypeWorkflowExecutionTimedOut:
+		return e.WorkflowExecutionTimedOutEventAttributes
+	case types.EventTypeDecisionTaskScheduled:
+		return e.DecisionTaskScheduledEventAttributes
+	case types.EventTypeDecisionTaskStarted:
+		return e.DecisionTaskStartedEventAttributes
+	case types.EventTypeDecisionTaskCompleted:
+		return e.DecisionTaskCompletedEventAttributes
+	case types.EventTypeDecisionTaskTimedOut:
+		return e.DecisionTaskTimedOutEventAttributes
+	case types.EventTypeDecisionTaskFailed:
+		return e.DecisionTaskFailedEventAttributes
+	case types.EventTypeActivityTaskScheduled:
+		return e.ActivityTaskScheduledEventAttributes
+	case types.EventTypeActivityTaskStarted:
+		return e.ActivityTaskStartedEventAttributes
+	case types.EventTypeActivityTaskCompleted:
+		return e.ActivityTaskCompletedEventAttributes
+	case types.EventTypeActivityTaskFailed:
+		return e.ActivityTaskFailedEventAttributes
+	case types.EventTypeActivityTaskTimedOut:
+		return e.ActivityTaskTimedOutEventAttributes
+	case types.EventTypeActivityTaskCancelRequested:
+		return e.ActivityTaskCancelRequestedEventAttributes
+	case types.EventTypeRequestCancelActivityTaskFailed:
+		return e.RequestCancelActivityTaskFailedEventAttributes
+	case types.EventTypeActivityTaskCanceled:
+		return e.ActivityTaskCanceledEventAttributes
+	case types.EventTypeTimerStarted:
+		return e.TimerStartedEventAttributes
+	case types.EventTypeTimerFired:
+		return e.TimerFiredEventAttributes
+	case types.EventTypeCancelTimerFailed:
+		return e.CancelTimerFailedEventAttributes
+	case types.EventTypeTimerCanceled:
+		return e.TimerCanceledEventAttributes
+	case types.EventTypeWorkflowExecutionCancelRequested:
+		return e.WorkflowExecutionCancelRequestedEventAttributes
+	case types.EventTypeWorkflowExecutionCanceled:
+		return e.WorkflowExecutionCanceledEventAttributes
+	case types.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
+		return e.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+	case types.EventTypeRequestCancelExternalWorkflowExecutionFailed:
+		return e.RequestCancelExternalWorkflowExecutionFailedEventAttributes
+	case types.EventTypeExternalWorkflowExecutionCancelRequested:
+		return e.ExternalWorkflowExecutionCancelRequestedEventAttributes
+	case types.EventTypeMarkerRecorded:
+		return e.MarkerRecordedEventAttributes
+	case types.EventTypeWorkflowExecutionSignaled:
+		return e.WorkflowExecutionSignaledEventAttributes
+	case types.EventTypeWorkflowExecutionTerminated:
+		return e.WorkflowExecutionTerminatedEventAttributes
+	case types.EventTypeWorkflowExecutionContinuedAsNew:
+		return e.WorkflowExecutionContinuedAsNewEventAttributes
+	case types.EventTypeStartChildWorkflowExecutionInitiated:
+		return e.StartChildWorkflowExecutionInitiatedEventAttributes
+	case types.EventTypeStartChildWorkflowExecutionFailed:
+		return e.StartChildWorkflowExecutionFailedEventAttributes
+	case types.EventTypeChildWorkflowExecutionStarted:
+		return e.ChildWorkflowExecutionStartedEventAttributes
+	case types.EventTypeChildWorkflowExecutionCompleted:
+		return e.ChildWorkflowExecutionCompletedEventAttributes
+	case types.EventTypeChildWorkflowExecutionFailed:
+		return e.ChildWorkflowExecutionFailedEventAttributes
+	case types.EventTypeChildWorkflowExecutionCanceled:
+		return e.ChildWorkflowExecutionCanceledEventAttributes
+	case types.EventTypeChildWorkflowExecutionTimedOut:
+		return e.ChildWorkflowExecutionTimedOutEventAttributes
+	case types.EventTypeChildWorkflowExecutionTerminated:
+		return e.ChildWorkflowExecutionTerminatedEventAttributes
+	case types.EventTypeSignalExternalWorkflowExecutionInitiated:
+		return e.SignalExternalWorkflowExecutionInitiatedEventAttributes
+	case types.EventTypeSignalExternalWorkflowExecutionFailed:
+		return e.SignalExternalWorkflowExecutionFailedEventAttributes
+	case types.EventTypeExternalWorkflowExecutionSignaled:
+		return e.ExternalWorkflowExecutionSignaledEventAttributes
+	case types.EventTypeUpsertWorkflowSearchAttributes:
+		return e.UpsertWorkflowSearchAttributesEventAttributes
+	}
+	return nil
+}
+
+func fromUnixNano(t *int64) string {
+	if t == nil {
+		return ""
+	}
+	return time.Unix(0, *t).UTC().Format(time.RFC3339Nano)
+}
+
+// fromPayloadMap inlines JSON encoded payloads, other payloads are kept as strings
+func fromPayloadMap(t map[string][]byte) map[string]interface{} {
+	if t == nil {
+		return nil
+	}
+	v := make(map[string]interface{}, len(t))
+	for key, payload := range t {
+		var decoded interface{}
+		if err := encodingjson.Unmarshal(payload, &decoded); err == nil {
+			v[key] = decoded
+		} else {
+			v[key] = string(payload)
+		}
+	}
+	return v
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package json
+
+import (
+	encodingjson "encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestWorkflowExecutionInfo(t *testing.T) {
+	assert.Nil(t, FromWorkflowExecutionInfo(nil))
+	assert.Nil(t, FromWorkflowExecutionInfoArray(nil))
+
+	info := FromWorkflowExecutionInfo(&testdata.WorkflowExecutionInfo)
+	assert.Equal(t, testdata.WorkflowID, info.WorkflowID)
+	assert.Equal(t, testdata.RunID, info.RunID)
+	assert.Equal(t, testdata.WorkflowTypeName, info.WorkflowType)
+	assert.Equal(t, testdata.WorkflowExecutionCloseStatus.String(), info.CloseStatus)
+	assert.Equal(t, time.Unix(0, testdata.Timestamp1).UTC().Format(time.RFC3339Nano), info.StartTime)
+	assert.Equal(t, testdata.DomainName, info.ParentDomain)
+	assert.Len(t, info.AutoResetPoints, len(testdata.ResetPointInfoArray))
+	assert.Len(t, info.Memo, len(testdata.Memo.Fields))
+
+	assert.Equal(t, []*WorkflowExecutionInfo{info}, FromWorkflowExecutionInfoArray(testdata.WorkflowExecutionInfoArray))
+}
+
+func TestWorkflowExecutionInfo_JSON(t *testing.T) {
+	startTime := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	data, err := encodingjson.Marshal(FromWorkflowExecutionInfo(&types.WorkflowExecutionInfo{
+		Execution:   &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+		StartTime:   common.Int64Ptr(startTime.UnixNano()),
+		CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		SearchAttributes: &types.SearchAttributes{
+			IndexedFields: map[string][]byte{
+				"CustomKeywordField": []byte(`"keyword"`),
+				"Binary":             {1, 2},
+			},
+		},
+	}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"workflowId": "wid",
+		"runId": "rid",
+		"startTime": "2021-03-04T05:06:07Z",
+		"closeStatus": "COMPLETED",
+		"historyLength": 0,
+		"searchAttributes": {"CustomKeywordField": "keyword", "Binary": "\u0001\u0002"},
+		"isCron": false
+	}`, string(data))
+}
+
+func TestHistoryEvent(t *testing.T) {
+	assert.Nil(t, FromHistoryEvent(nil))
+	assert.Nil(t, FromHistoryEventArray(nil))
+
+	for _, item := range testdata.History.Events {
+		event := FromHistoryEvent(item)
+		assert.Equal(t, item.ID, event.EventID)
+		assert.Equal(t, item.GetEventType().String(), event.EventType)
+		assert.Equal(t, time.Unix(0, item.GetTimestamp()).UTC().Format(time.RFC3339Nano), event.Timestamp)
+		assert.NotNil(t, event.Attributes, item.GetEventType().String())
+	}
+	assert.Len(t, FromHistoryEventArray(testdata.History.Events), len(testdata.History.Events))
+}
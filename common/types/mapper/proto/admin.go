@@ -162,6 +162,7 @@ func FromAdminDescribeHistoryHostResponse(t *types.DescribeHistoryHostResponse)
 		DomainCache:           FromDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.ShardControllerStatus,
 		Address:               t.Address,
+		PayloadSizeStats:      FromDomainPayloadSizeStatsArray(t.PayloadSizeStats),
 	}
 }
 
@@ -185,6 +186,7 @@ func ToAdminDescribeHistoryHostResponse(t *adminv1.DescribeHistoryHostResponse)
 		DomainCache:           ToDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.ShardControllerStatus,
 		Address:               t.Address,
+		PayloadSizeStats:      ToDomainPayloadSizeStatsArray(t.PayloadSizeStats),
 	}
 }
 
@@ -228,6 +230,42 @@ func ToAdminDescribeQueueResponse(t *adminv1.DescribeQueueResponse) *types.Descr
 	}
 }
 
+func FromAdminGetReplicationAckStatesRequest(t *types.GetReplicationAckStatesRequest) *adminv1.GetReplicationAckStatesRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetReplicationAckStatesRequest{
+		ShardId: t.ShardID,
+	}
+}
+
+func ToAdminGetReplicationAckStatesRequest(t *adminv1.GetReplicationAckStatesRequest) *types.GetReplicationAckStatesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationAckStatesRequest{
+		ShardID: t.ShardId,
+	}
+}
+
+func FromAdminGetReplicationAckStatesResponse(t *types.GetReplicationAckStatesResponse) *adminv1.GetReplicationAckStatesResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetReplicationAckStatesResponse{
+		AckStates: FromReplicationAckStateArray(t.AckStates),
+	}
+}
+
+func ToAdminGetReplicationAckStatesResponse(t *adminv1.GetReplicationAckStatesResponse) *types.GetReplicationAckStatesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationAckStatesResponse{
+		AckStates: ToReplicationAckStateArray(t.AckStates),
+	}
+}
+
 func FromAdminDescribeWorkflowExecutionRequest(t *types.AdminDescribeWorkflowExecutionRequest) *adminv1.DescribeWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -277,7 +315,8 @@ func FromAdminGetDLQReplicationMessagesRequest(t *types.GetDLQReplicationMessage
 		return nil
 	}
 	return &adminv1.GetDLQReplicationMessagesRequest{
-		TaskInfos: FromReplicationTaskInfoArray(t.TaskInfos),
+		TaskInfos:             FromReplicationTaskInfoArray(t.TaskInfos),
+		SupportedCompressions: FromCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -286,7 +325,8 @@ func ToAdminGetDLQReplicationMessagesRequest(t *adminv1.GetDLQReplicationMessage
 		return nil
 	}
 	return &types.GetDLQReplicationMessagesRequest{
-		TaskInfos: ToReplicationTaskInfoArray(t.TaskInfos),
+		TaskInfos:             ToReplicationTaskInfoArray(t.TaskInfos),
+		SupportedCompressions: ToCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -353,8 +393,9 @@ func FromAdminGetReplicationMessagesRequest(t *types.GetReplicationMessagesReque
 		return nil
 	}
 	return &adminv1.GetReplicationMessagesRequest{
-		Tokens:      FromReplicationTokenArray(t.Tokens),
-		ClusterName: t.ClusterName,
+		Tokens:                FromReplicationTokenArray(t.Tokens),
+		ClusterName:           t.ClusterName,
+		SupportedCompressions: FromCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -363,8 +404,9 @@ func ToAdminGetReplicationMessagesRequest(t *adminv1.GetReplicationMessagesReque
 		return nil
 	}
 	return &types.GetReplicationMessagesRequest{
-		Tokens:      ToReplicationTokenArray(t.Tokens),
-		ClusterName: t.ClusterName,
+		Tokens:                ToReplicationTokenArray(t.Tokens),
+		ClusterName:           t.ClusterName,
+		SupportedCompressions: ToCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -449,6 +491,7 @@ func FromAdminMergeDLQMessagesRequest(t *types.MergeDLQMessagesRequest) *adminv1
 		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
 		PageSize:              t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		EndShardId:            t.EndShardID,
 	}
 }
 
@@ -463,6 +506,7 @@ func ToAdminMergeDLQMessagesRequest(t *adminv1.MergeDLQMessagesRequest) *types.M
 		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
 		MaximumPageSize:       t.PageSize,
 		NextPageToken:         t.NextPageToken,
+		EndShardID:            t.EndShardId,
 	}
 }
 
@@ -493,6 +537,7 @@ func FromAdminPurgeDLQMessagesRequest(t *types.PurgeDLQMessagesRequest) *adminv1
 		ShardId:               t.ShardID,
 		SourceCluster:         t.SourceCluster,
 		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
+		EndShardId:            t.EndShardID,
 	}
 }
 
@@ -505,6 +550,7 @@ func ToAdminPurgeDLQMessagesRequest(t *adminv1.PurgeDLQMessagesRequest) *types.P
 		ShardID:               t.ShardId,
 		SourceCluster:         t.SourceCluster,
 		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
+		EndShardID:            t.EndShardId,
 	}
 }
 
@@ -519,6 +565,7 @@ func FromAdminReadDLQMessagesRequest(t *types.ReadDLQMessagesRequest) *adminv1.R
 		InclusiveEndMessageId: fromInt64Value(t.InclusiveEndMessageID),
 		PageSize:              t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		EndShardId:            t.EndShardID,
 	}
 }
 
@@ -533,6 +580,7 @@ func ToAdminReadDLQMessagesRequest(t *adminv1.ReadDLQMessagesRequest) *types.Rea
 		InclusiveEndMessageID: toInt64Value(t.InclusiveEndMessageId),
 		MaximumPageSize:       t.PageSize,
 		NextPageToken:         t.NextPageToken,
+		EndShardID:            t.EndShardId,
 	}
 }
 
@@ -1124,3 +1172,563 @@ func ToDynamicConfigFilter(t *adminv1.DynamicConfigFilter) *types.DynamicConfigF
 		Value: ToDataBlob(t.Value),
 	}
 }
+
+// FromAdminGetDomainReplicationWatermarkRequest converts internal GetDomainReplicationWatermarkRequest type to proto
+func FromAdminGetDomainReplicationWatermarkRequest(t *types.GetDomainReplicationWatermarkRequest) *adminv1.GetDomainReplicationWatermarkRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetDomainReplicationWatermarkRequest{
+		Domain: t.Domain,
+	}
+}
+
+// ToAdminGetDomainReplicationWatermarkRequest converts proto GetDomainReplicationWatermarkRequest type to internal
+func ToAdminGetDomainReplicationWatermarkRequest(t *adminv1.GetDomainReplicationWatermarkRequest) *types.GetDomainReplicationWatermarkRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainReplicationWatermarkRequest{
+		Domain: t.Domain,
+	}
+}
+
+// FromAdminGetDomainReplicationWatermarkResponse converts internal GetDomainReplicationWatermarkResponse type to proto
+func FromAdminGetDomainReplicationWatermarkResponse(t *types.GetDomainReplicationWatermarkResponse) *adminv1.GetDomainReplicationWatermarkResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetDomainReplicationWatermarkResponse{
+		Cluster:                 t.Cluster,
+		LastReplicatedEventTime: unixNanoToTime(t.LastReplicatedEventTime),
+	}
+}
+
+// ToAdminGetDomainReplicationWatermarkResponse converts proto GetDomainReplicationWatermarkResponse type to internal
+func ToAdminGetDomainReplicationWatermarkResponse(t *adminv1.GetDomainReplicationWatermarkResponse) *types.GetDomainReplicationWatermarkResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainReplicationWatermarkResponse{
+		Cluster:                 t.Cluster,
+		LastReplicatedEventTime: timeToUnixNano(t.LastReplicatedEventTime),
+	}
+}
+
+// FromAdminIssueDomainTokenRequest converts internal IssueDomainTokenRequest type to proto
+func FromAdminIssueDomainTokenRequest(t *types.IssueDomainTokenRequest) *adminv1.IssueDomainTokenRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.IssueDomainTokenRequest{
+		Domain:     t.Domain,
+		Apis:       t.APIs,
+		TtlSeconds: t.TTLSeconds,
+		Subject:    t.Subject,
+	}
+}
+
+// ToAdminIssueDomainTokenRequest converts proto IssueDomainTokenRequest type to internal
+func ToAdminIssueDomainTokenRequest(t *adminv1.IssueDomainTokenRequest) *types.IssueDomainTokenRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.IssueDomainTokenRequest{
+		Domain:     t.Domain,
+		APIs:       t.Apis,
+		TTLSeconds: t.TtlSeconds,
+		Subject:    t.Subject,
+	}
+}
+
+// FromAdminIssueDomainTokenResponse converts internal IssueDomainTokenResponse type to proto
+func FromAdminIssueDomainTokenResponse(t *types.IssueDomainTokenResponse) *adminv1.IssueDomainTokenResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.IssueDomainTokenResponse{
+		Token: t.Token,
+	}
+}
+
+// ToAdminIssueDomainTokenResponse converts proto IssueDomainTokenResponse type to internal
+func ToAdminIssueDomainTokenResponse(t *adminv1.IssueDomainTokenResponse) *types.IssueDomainTokenResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.IssueDomainTokenResponse{
+		Token: t.Token,
+	}
+}
+
+// FromAdminStealTaskListLeaseRequest converts internal StealTaskListLeaseRequest type to proto
+func FromAdminStealTaskListLeaseRequest(t *types.StealTaskListLeaseRequest) *adminv1.StealTaskListLeaseRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.StealTaskListLeaseRequest{
+		Domain:       t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToAdminStealTaskListLeaseRequest converts proto StealTaskListLeaseRequest type to internal
+func ToAdminStealTaskListLeaseRequest(t *adminv1.StealTaskListLeaseRequest) *types.StealTaskListLeaseRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.StealTaskListLeaseRequest{
+		Domain:       t.Domain,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromAdminStealTaskListLeaseResponse converts internal StealTaskListLeaseResponse type to proto
+func FromAdminStealTaskListLeaseResponse(t *types.StealTaskListLeaseResponse) *adminv1.StealTaskListLeaseResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.StealTaskListLeaseResponse{
+		RangeId: t.RangeID,
+	}
+}
+
+// ToAdminStealTaskListLeaseResponse converts proto StealTaskListLeaseResponse type to internal
+func ToAdminStealTaskListLeaseResponse(t *adminv1.StealTaskListLeaseResponse) *types.StealTaskListLeaseResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.StealTaskListLeaseResponse{
+		RangeID: t.RangeId,
+	}
+}
+
+// FromAdminUpdateTaskListTagsRequest converts internal UpdateTaskListTagsRequest type to proto
+func FromAdminUpdateTaskListTagsRequest(t *types.UpdateTaskListTagsRequest) *adminv1.UpdateTaskListTagsRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.UpdateTaskListTagsRequest{
+		Domain:       t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Tags:         t.Tags,
+	}
+}
+
+// ToAdminUpdateTaskListTagsRequest converts proto UpdateTaskListTagsRequest type to internal
+func ToAdminUpdateTaskListTagsRequest(t *adminv1.UpdateTaskListTagsRequest) *types.UpdateTaskListTagsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListTagsRequest{
+		Domain:       t.Domain,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Tags:         t.Tags,
+	}
+}
+
+// FromAdminUpdateTaskListTagsResponse converts internal UpdateTaskListTagsResponse type to proto
+func FromAdminUpdateTaskListTagsResponse(t *types.UpdateTaskListTagsResponse) *adminv1.UpdateTaskListTagsResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.UpdateTaskListTagsResponse{
+		Tags: t.Tags,
+	}
+}
+
+// ToAdminUpdateTaskListTagsResponse converts proto UpdateTaskListTagsResponse type to internal
+func ToAdminUpdateTaskListTagsResponse(t *adminv1.UpdateTaskListTagsResponse) *types.UpdateTaskListTagsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListTagsResponse{
+		Tags: t.Tags,
+	}
+}
+
+// FromAdminListTaskListTagsRequest converts internal ListTaskListTagsRequest type to proto
+func FromAdminListTaskListTagsRequest(t *types.ListTaskListTagsRequest) *adminv1.ListTaskListTagsRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListTaskListTagsRequest{
+		Domain: t.Domain,
+		Tags:   t.Tags,
+	}
+}
+
+// ToAdminListTaskListTagsRequest converts proto ListTaskListTagsRequest type to internal
+func ToAdminListTaskListTagsRequest(t *adminv1.ListTaskListTagsRequest) *types.ListTaskListTagsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListTaskListTagsRequest{
+		Domain: t.Domain,
+		Tags:   t.Tags,
+	}
+}
+
+// FromAdminListTaskListTagsResponse converts internal ListTaskListTagsResponse type to proto
+func FromAdminListTaskListTagsResponse(t *types.ListTaskListTagsResponse) *adminv1.ListTaskListTagsResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ListTaskListTagsResponse{
+		TaskLists: FromTaskListTagsArray(t.TaskLists),
+	}
+}
+
+// ToAdminListTaskListTagsResponse converts proto ListTaskListTagsResponse type to internal
+func ToAdminListTaskListTagsResponse(t *adminv1.ListTaskListTagsResponse) *types.ListTaskListTagsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListTaskListTagsResponse{
+		TaskLists: ToTaskListTagsArray(t.TaskLists),
+	}
+}
+
+// FromTaskListTags converts internal TaskListTags type to proto
+func FromTaskListTags(t *types.TaskListTags) *adminv1.TaskListTags {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.TaskListTags{
+		Name:         t.Name,
+		TaskListType: FromTaskListType(t.TaskListType),
+		Tags:         t.Tags,
+	}
+}
+
+// ToTaskListTags converts proto TaskListTags type to internal
+func ToTaskListTags(t *adminv1.TaskListTags) *types.TaskListTags {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListTags{
+		Name:         t.Name,
+		TaskListType: ToTaskListType(t.TaskListType),
+		Tags:         t.Tags,
+	}
+}
+
+// FromTaskListTagsArray converts internal TaskListTags array type to proto
+func FromTaskListTagsArray(t []*types.TaskListTags) []*adminv1.TaskListTags {
+	if t == nil {
+		return nil
+	}
+	v := make([]*adminv1.TaskListTags, len(t))
+	for i := range t {
+		v[i] = FromTaskListTags(t[i])
+	}
+	return v
+}
+
+// ToTaskListTagsArray converts proto TaskListTags array type to internal
+func ToTaskListTagsArray(t []*adminv1.TaskListTags) []*types.TaskListTags {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.TaskListTags, len(t))
+	for i := range t {
+		v[i] = ToTaskListTags(t[i])
+	}
+	return v
+}
+
+// FromAdminBackfillDomainReplicationRequest converts internal BackfillDomainReplicationRequest type to proto
+func FromAdminBackfillDomainReplicationRequest(t *types.BackfillDomainReplicationRequest) *adminv1.BackfillDomainReplicationRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.BackfillDomainReplicationRequest{
+		Domain:              t.Domain,
+		TargetCluster:       t.TargetCluster,
+		ClosedWithinSeconds: t.ClosedWithinSeconds,
+		Rps:                 t.RPS,
+	}
+}
+
+// ToAdminBackfillDomainReplicationRequest converts proto BackfillDomainReplicationRequest type to internal
+func ToAdminBackfillDomainReplicationRequest(t *adminv1.BackfillDomainReplicationRequest) *types.BackfillDomainReplicationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillDomainReplicationRequest{
+		Domain:              t.Domain,
+		TargetCluster:       t.TargetCluster,
+		ClosedWithinSeconds: t.ClosedWithinSeconds,
+		RPS:                 t.Rps,
+	}
+}
+
+// FromAdminBackfillDomainReplicationResponse converts internal BackfillDomainReplicationResponse type to proto
+func FromAdminBackfillDomainReplicationResponse(t *types.BackfillDomainReplicationResponse) *adminv1.BackfillDomainReplicationResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.BackfillDomainReplicationResponse{
+		JobId: t.JobID,
+	}
+}
+
+// ToAdminBackfillDomainReplicationResponse converts proto BackfillDomainReplicationResponse type to internal
+func ToAdminBackfillDomainReplicationResponse(t *adminv1.BackfillDomainReplicationResponse) *types.BackfillDomainReplicationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillDomainReplicationResponse{
+		JobID: t.JobId,
+	}
+}
+
+// FromAdminUpdateDomainAsyncWorkflowConfigRequest converts internal UpdateDomainAsyncWorkflowConfigRequest type to proto
+func FromAdminUpdateDomainAsyncWorkflowConfigRequest(t *types.UpdateDomainAsyncWorkflowConfigRequest) *adminv1.UpdateDomainAsyncWorkflowConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain:        t.Domain,
+		Configuration: FromAsyncWorkflowConfiguration(t.Configuration),
+	}
+}
+
+// ToAdminUpdateDomainAsyncWorkflowConfigRequest converts proto UpdateDomainAsyncWorkflowConfigRequest type to internal
+func ToAdminUpdateDomainAsyncWorkflowConfigRequest(t *adminv1.UpdateDomainAsyncWorkflowConfigRequest) *types.UpdateDomainAsyncWorkflowConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain:        t.Domain,
+		Configuration: ToAsyncWorkflowConfiguration(t.Configuration),
+	}
+}
+
+// FromAdminUpdateDomainAsyncWorkflowConfigResponse converts internal UpdateDomainAsyncWorkflowConfigResponse type to proto
+func FromAdminUpdateDomainAsyncWorkflowConfigResponse(t *types.UpdateDomainAsyncWorkflowConfigResponse) *adminv1.UpdateDomainAsyncWorkflowConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.UpdateDomainAsyncWorkflowConfigResponse{}
+}
+
+// ToAdminUpdateDomainAsyncWorkflowConfigResponse converts proto UpdateDomainAsyncWorkflowConfigResponse type to internal
+func ToAdminUpdateDomainAsyncWorkflowConfigResponse(t *adminv1.UpdateDomainAsyncWorkflowConfigResponse) *types.UpdateDomainAsyncWorkflowConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateDomainAsyncWorkflowConfigResponse{}
+}
+
+// FromAdminGetDomainAsyncWorkflowConfigRequest converts internal GetDomainAsyncWorkflowConfigRequest type to proto
+func FromAdminGetDomainAsyncWorkflowConfigRequest(t *types.GetDomainAsyncWorkflowConfigRequest) *adminv1.GetDomainAsyncWorkflowConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetDomainAsyncWorkflowConfigRequest{
+		Domain: t.Domain,
+	}
+}
+
+// ToAdminGetDomainAsyncWorkflowConfigRequest converts proto GetDomainAsyncWorkflowConfigRequest type to internal
+func ToAdminGetDomainAsyncWorkflowConfigRequest(t *adminv1.GetDomainAsyncWorkflowConfigRequest) *types.GetDomainAsyncWorkflowConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainAsyncWorkflowConfigRequest{
+		Domain: t.Domain,
+	}
+}
+
+// FromAdminGetDomainAsyncWorkflowConfigResponse converts internal GetDomainAsyncWorkflowConfigResponse type to proto
+func FromAdminGetDomainAsyncWorkflowConfigResponse(t *types.GetDomainAsyncWorkflowConfigResponse) *adminv1.GetDomainAsyncWorkflowConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetDomainAsyncWorkflowConfigResponse{
+		Configuration: FromAsyncWorkflowConfiguration(t.Configuration),
+	}
+}
+
+// ToAdminGetDomainAsyncWorkflowConfigResponse converts proto GetDomainAsyncWorkflowConfigResponse type to internal
+func ToAdminGetDomainAsyncWorkflowConfigResponse(t *adminv1.GetDomainAsyncWorkflowConfigResponse) *types.GetDomainAsyncWorkflowConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainAsyncWorkflowConfigResponse{
+		Configuration: ToAsyncWorkflowConfiguration(t.Configuration),
+	}
+}
+
+// FromAdminReconcileDomainRetentionRequest converts internal ReconcileDomainRetentionRequest type to proto
+func FromAdminReconcileDomainRetentionRequest(t *types.ReconcileDomainRetentionRequest) *adminv1.ReconcileDomainRetentionRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ReconcileDomainRetentionRequest{
+		Domain: t.Domain,
+		Rps:    t.RPS,
+	}
+}
+
+// ToAdminReconcileDomainRetentionRequest converts proto ReconcileDomainRetentionRequest type to internal
+func ToAdminReconcileDomainRetentionRequest(t *adminv1.ReconcileDomainRetentionRequest) *types.ReconcileDomainRetentionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReconcileDomainRetentionRequest{
+		Domain: t.Domain,
+		RPS:    t.Rps,
+	}
+}
+
+// FromAdminReconcileDomainRetentionResponse converts internal ReconcileDomainRetentionResponse type to proto
+func FromAdminReconcileDomainRetentionResponse(t *types.ReconcileDomainRetentionResponse) *adminv1.ReconcileDomainRetentionResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.ReconcileDomainRetentionResponse{
+		JobId: t.JobID,
+	}
+}
+
+// ToAdminReconcileDomainRetentionResponse converts proto ReconcileDomainRetentionResponse type to internal
+func ToAdminReconcileDomainRetentionResponse(t *adminv1.ReconcileDomainRetentionResponse) *types.ReconcileDomainRetentionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReconcileDomainRetentionResponse{
+		JobID: t.JobId,
+	}
+}
+
+// FromAdminDescribeTaskListConfigRequest converts internal DescribeTaskListConfigRequest type to proto
+func FromAdminDescribeTaskListConfigRequest(t *types.DescribeTaskListConfigRequest) *adminv1.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DescribeTaskListConfigRequest{
+		Domain:       t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToAdminDescribeTaskListConfigRequest converts proto DescribeTaskListConfigRequest type to internal
+func ToAdminDescribeTaskListConfigRequest(t *adminv1.DescribeTaskListConfigRequest) *types.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListConfigRequest{
+		Domain:       t.Domain,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromAdminDescribeTaskListConfigResponse converts internal DescribeTaskListConfigResponse type to proto
+func FromAdminDescribeTaskListConfigResponse(t *types.DescribeTaskListConfigResponse) *adminv1.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.DescribeTaskListConfigResponse{
+		NumReadPartitions:                 t.NumReadPartitions,
+		NumWritePartitions:                t.NumWritePartitions,
+		ForwarderMaxOutstandingPolls:      t.ForwarderMaxOutstandingPolls,
+		ForwarderMaxOutstandingTasks:      t.ForwarderMaxOutstandingTasks,
+		ForwarderMaxRatePerSecond:         t.ForwarderMaxRatePerSecond,
+		ForwarderMaxChildrenPerNode:       t.ForwarderMaxChildrenPerNode,
+		EnableSyncMatch:                   t.EnableSyncMatch,
+		EnableEphemeralTaskList:           t.EnableEphemeralTaskList,
+		LongPollExpirationIntervalSeconds: t.LongPollExpirationIntervalInSeconds,
+		RangeSize:                         t.RangeSize,
+		GetTasksBatchSize:                 t.GetTasksBatchSize,
+		UpdateAckIntervalSeconds:          t.UpdateAckIntervalInSeconds,
+		IdleTaskListCheckIntervalSeconds:  t.IdleTaskListCheckIntervalInSeconds,
+		MaxTaskListIdleTimeSeconds:        t.MaxTaskListIdleTimeInSeconds,
+		MinTaskThrottlingBurstSize:        t.MinTaskThrottlingBurstSize,
+		MaxTaskDeleteBatchSize:            t.MaxTaskDeleteBatchSize,
+		OutstandingTaskAppendsThreshold:   t.OutstandingTaskAppendsThreshold,
+		MaxTaskBatchSize:                  t.MaxTaskBatchSize,
+	}
+}
+
+// ToAdminDescribeTaskListConfigResponse converts proto DescribeTaskListConfigResponse type to internal
+func ToAdminDescribeTaskListConfigResponse(t *adminv1.DescribeTaskListConfigResponse) *types.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListConfigResponse{
+		NumReadPartitions:                   t.NumReadPartitions,
+		NumWritePartitions:                  t.NumWritePartitions,
+		ForwarderMaxOutstandingPolls:        t.ForwarderMaxOutstandingPolls,
+		ForwarderMaxOutstandingTasks:        t.ForwarderMaxOutstandingTasks,
+		ForwarderMaxRatePerSecond:           t.ForwarderMaxRatePerSecond,
+		ForwarderMaxChildrenPerNode:         t.ForwarderMaxChildrenPerNode,
+		EnableSyncMatch:                     t.EnableSyncMatch,
+		EnableEphemeralTaskList:             t.EnableEphemeralTaskList,
+		LongPollExpirationIntervalInSeconds: t.LongPollExpirationIntervalSeconds,
+		RangeSize:                           t.RangeSize,
+		GetTasksBatchSize:                   t.GetTasksBatchSize,
+		UpdateAckIntervalInSeconds:          t.UpdateAckIntervalSeconds,
+		IdleTaskListCheckIntervalInSeconds:  t.IdleTaskListCheckIntervalSeconds,
+		MaxTaskListIdleTimeInSeconds:        t.MaxTaskListIdleTimeSeconds,
+		MinTaskThrottlingBurstSize:          t.MinTaskThrottlingBurstSize,
+		MaxTaskDeleteBatchSize:              t.MaxTaskDeleteBatchSize,
+		OutstandingTaskAppendsThreshold:     t.OutstandingTaskAppendsThreshold,
+		MaxTaskBatchSize:                    t.MaxTaskBatchSize,
+	}
+}
+
+// FromAdminGetTaskListUsageRequest converts internal GetTaskListUsageRequest type to proto
+func FromAdminGetTaskListUsageRequest(t *types.GetTaskListUsageRequest) *adminv1.GetTaskListUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetTaskListUsageRequest{
+		Domain:       t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToAdminGetTaskListUsageRequest converts proto GetTaskListUsageRequest type to internal
+func ToAdminGetTaskListUsageRequest(t *adminv1.GetTaskListUsageRequest) *types.GetTaskListUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListUsageRequest{
+		Domain:       t.Domain,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromAdminGetTaskListUsageResponse converts internal GetTaskListUsageResponse type to proto
+func FromAdminGetTaskListUsageResponse(t *types.GetTaskListUsageResponse) *adminv1.GetTaskListUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &adminv1.GetTaskListUsageResponse{
+		TaskCount: t.TaskCount,
+		SizeBytes: t.SizeBytes,
+		Estimated: t.Estimated,
+	}
+}
+
+// ToAdminGetTaskListUsageResponse converts proto GetTaskListUsageResponse type to internal
+func ToAdminGetTaskListUsageResponse(t *adminv1.GetTaskListUsageResponse) *types.GetTaskListUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListUsageResponse{
+		TaskCount: t.TaskCount,
+		SizeBytes: t.SizeBytes,
+		Estimated: t.Estimated,
+	}
+}
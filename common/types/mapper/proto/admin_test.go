@@ -72,6 +72,16 @@ func TestAdminDescribeQueueResponse(t *testing.T) {
 		assert.Equal(t, item, ToAdminDescribeQueueResponse(FromAdminDescribeQueueResponse(item)))
 	}
 }
+func TestAdminGetReplicationAckStatesRequest(t *testing.T) {
+	for _, item := range []*types.GetReplicationAckStatesRequest{nil, {}, &testdata.AdminGetReplicationAckStatesRequest} {
+		assert.Equal(t, item, ToAdminGetReplicationAckStatesRequest(FromAdminGetReplicationAckStatesRequest(item)))
+	}
+}
+func TestAdminGetReplicationAckStatesResponse(t *testing.T) {
+	for _, item := range []*types.GetReplicationAckStatesResponse{nil, {}, &testdata.AdminGetReplicationAckStatesResponse} {
+		assert.Equal(t, item, ToAdminGetReplicationAckStatesResponse(FromAdminGetReplicationAckStatesResponse(item)))
+	}
+}
 func TestAdminDescribeWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.AdminDescribeWorkflowExecutionRequest{nil, {}, &testdata.AdminDescribeWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToAdminDescribeWorkflowExecutionRequest(FromAdminDescribeWorkflowExecutionRequest(item)))
@@ -196,3 +206,135 @@ func TestAdminRespondCrossClusterTasksCompletedResponse(t *testing.T) {
 		assert.Equal(t, item, ToAdminRespondCrossClusterTasksCompletedResponse(FromAdminRespondCrossClusterTasksCompletedResponse(item)))
 	}
 }
+
+func TestAdminGetDomainReplicationWatermarkRequest(t *testing.T) {
+	for _, item := range []*types.GetDomainReplicationWatermarkRequest{nil, {}, &testdata.AdminGetDomainReplicationWatermarkRequest} {
+		assert.Equal(t, item, ToAdminGetDomainReplicationWatermarkRequest(FromAdminGetDomainReplicationWatermarkRequest(item)))
+	}
+}
+
+func TestAdminGetDomainReplicationWatermarkResponse(t *testing.T) {
+	for _, item := range []*types.GetDomainReplicationWatermarkResponse{nil, {}, &testdata.AdminGetDomainReplicationWatermarkResponse} {
+		assert.Equal(t, item, ToAdminGetDomainReplicationWatermarkResponse(FromAdminGetDomainReplicationWatermarkResponse(item)))
+	}
+}
+
+func TestAdminIssueDomainTokenRequest(t *testing.T) {
+	for _, item := range []*types.IssueDomainTokenRequest{nil, {}, &testdata.AdminIssueDomainTokenRequest} {
+		assert.Equal(t, item, ToAdminIssueDomainTokenRequest(FromAdminIssueDomainTokenRequest(item)))
+	}
+}
+
+func TestAdminIssueDomainTokenResponse(t *testing.T) {
+	for _, item := range []*types.IssueDomainTokenResponse{nil, {}, &testdata.AdminIssueDomainTokenResponse} {
+		assert.Equal(t, item, ToAdminIssueDomainTokenResponse(FromAdminIssueDomainTokenResponse(item)))
+	}
+}
+
+func TestAdminStealTaskListLeaseRequest(t *testing.T) {
+	for _, item := range []*types.StealTaskListLeaseRequest{nil, {}, &testdata.AdminStealTaskListLeaseRequest} {
+		assert.Equal(t, item, ToAdminStealTaskListLeaseRequest(FromAdminStealTaskListLeaseRequest(item)))
+	}
+}
+
+func TestAdminStealTaskListLeaseResponse(t *testing.T) {
+	for _, item := range []*types.StealTaskListLeaseResponse{nil, {}, &testdata.AdminStealTaskListLeaseResponse} {
+		assert.Equal(t, item, ToAdminStealTaskListLeaseResponse(FromAdminStealTaskListLeaseResponse(item)))
+	}
+}
+
+func TestAdminDescribeTaskListConfigRequest(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListConfigRequest{nil, {}, &testdata.AdminDescribeTaskListConfigRequest} {
+		assert.Equal(t, item, ToAdminDescribeTaskListConfigRequest(FromAdminDescribeTaskListConfigRequest(item)))
+	}
+}
+
+func TestAdminDescribeTaskListConfigResponse(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListConfigResponse{nil, {}, &testdata.AdminDescribeTaskListConfigResponse} {
+		assert.Equal(t, item, ToAdminDescribeTaskListConfigResponse(FromAdminDescribeTaskListConfigResponse(item)))
+	}
+}
+
+func TestAdminGetTaskListUsageRequest(t *testing.T) {
+	for _, item := range []*types.GetTaskListUsageRequest{nil, {}, &testdata.AdminGetTaskListUsageRequest} {
+		assert.Equal(t, item, ToAdminGetTaskListUsageRequest(FromAdminGetTaskListUsageRequest(item)))
+	}
+}
+
+func TestAdminGetTaskListUsageResponse(t *testing.T) {
+	for _, item := range []*types.GetTaskListUsageResponse{nil, {}, &testdata.AdminGetTaskListUsageResponse} {
+		assert.Equal(t, item, ToAdminGetTaskListUsageResponse(FromAdminGetTaskListUsageResponse(item)))
+	}
+}
+
+func TestAdminUpdateTaskListTagsRequest(t *testing.T) {
+	for _, item := range []*types.UpdateTaskListTagsRequest{nil, {}, &testdata.AdminUpdateTaskListTagsRequest} {
+		assert.Equal(t, item, ToAdminUpdateTaskListTagsRequest(FromAdminUpdateTaskListTagsRequest(item)))
+	}
+}
+
+func TestAdminUpdateTaskListTagsResponse(t *testing.T) {
+	for _, item := range []*types.UpdateTaskListTagsResponse{nil, {}, &testdata.AdminUpdateTaskListTagsResponse} {
+		assert.Equal(t, item, ToAdminUpdateTaskListTagsResponse(FromAdminUpdateTaskListTagsResponse(item)))
+	}
+}
+
+func TestAdminListTaskListTagsRequest(t *testing.T) {
+	for _, item := range []*types.ListTaskListTagsRequest{nil, {}, &testdata.AdminListTaskListTagsRequest} {
+		assert.Equal(t, item, ToAdminListTaskListTagsRequest(FromAdminListTaskListTagsRequest(item)))
+	}
+}
+
+func TestAdminListTaskListTagsResponse(t *testing.T) {
+	for _, item := range []*types.ListTaskListTagsResponse{nil, {}, &testdata.AdminListTaskListTagsResponse} {
+		assert.Equal(t, item, ToAdminListTaskListTagsResponse(FromAdminListTaskListTagsResponse(item)))
+	}
+}
+
+func TestAdminBackfillDomainReplicationRequest(t *testing.T) {
+	for _, item := range []*types.BackfillDomainReplicationRequest{nil, {}, &testdata.AdminBackfillDomainReplicationRequest} {
+		assert.Equal(t, item, ToAdminBackfillDomainReplicationRequest(FromAdminBackfillDomainReplicationRequest(item)))
+	}
+}
+
+func TestAdminBackfillDomainReplicationResponse(t *testing.T) {
+	for _, item := range []*types.BackfillDomainReplicationResponse{nil, {}, &testdata.AdminBackfillDomainReplicationResponse} {
+		assert.Equal(t, item, ToAdminBackfillDomainReplicationResponse(FromAdminBackfillDomainReplicationResponse(item)))
+	}
+}
+
+func TestAdminReconcileDomainRetentionRequest(t *testing.T) {
+	for _, item := range []*types.ReconcileDomainRetentionRequest{nil, {}, &testdata.AdminReconcileDomainRetentionRequest} {
+		assert.Equal(t, item, ToAdminReconcileDomainRetentionRequest(FromAdminReconcileDomainRetentionRequest(item)))
+	}
+}
+
+func TestAdminReconcileDomainRetentionResponse(t *testing.T) {
+	for _, item := range []*types.ReconcileDomainRetentionResponse{nil, {}, &testdata.AdminReconcileDomainRetentionResponse} {
+		assert.Equal(t, item, ToAdminReconcileDomainRetentionResponse(FromAdminReconcileDomainRetentionResponse(item)))
+	}
+}
+
+func TestAdminUpdateDomainAsyncWorkflowConfigRequest(t *testing.T) {
+	for _, item := range []*types.UpdateDomainAsyncWorkflowConfigRequest{nil, {}, &testdata.AdminUpdateDomainAsyncWorkflowConfigRequest} {
+		assert.Equal(t, item, ToAdminUpdateDomainAsyncWorkflowConfigRequest(FromAdminUpdateDomainAsyncWorkflowConfigRequest(item)))
+	}
+}
+
+func TestAdminUpdateDomainAsyncWorkflowConfigResponse(t *testing.T) {
+	for _, item := range []*types.UpdateDomainAsyncWorkflowConfigResponse{nil, {}} {
+		assert.Equal(t, item, ToAdminUpdateDomainAsyncWorkflowConfigResponse(FromAdminUpdateDomainAsyncWorkflowConfigResponse(item)))
+	}
+}
+
+func TestAdminGetDomainAsyncWorkflowConfigRequest(t *testing.T) {
+	for _, item := range []*types.GetDomainAsyncWorkflowConfigRequest{nil, {}, &testdata.AdminGetDomainAsyncWorkflowConfigRequest} {
+		assert.Equal(t, item, ToAdminGetDomainAsyncWorkflowConfigRequest(FromAdminGetDomainAsyncWorkflowConfigRequest(item)))
+	}
+}
+
+func TestAdminGetDomainAsyncWorkflowConfigResponse(t *testing.T) {
+	for _, item := range []*types.GetDomainAsyncWorkflowConfigResponse{nil, {}, &testdata.AdminGetDomainAsyncWorkflowConfigResponse} {
+		assert.Equal(t, item, ToAdminGetDomainAsyncWorkflowConfigResponse(FromAdminGetDomainAsyncWorkflowConfigResponse(item)))
+	}
+}
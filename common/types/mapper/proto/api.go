@@ -836,6 +836,9 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) apiv1.Decisio
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_SCHEDULE_ACTIVITY_DUPLICATE_ID
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_BAD_SEARCH_ATTRIBUTES
+	case types.DecisionTaskFailedCauseBadTaskListPayloadSize:
+		// not part of the public API yet, the failure details still describe the violated limit
+		return apiv1.DecisionTaskFailedCause_DECISION_TASK_FAILED_CAUSE_INVALID
 	}
 	panic("unexpected enum value")
 }
@@ -1250,6 +1253,9 @@ func ToDescribeWorkflowExecutionResponse(t *apiv1.DescribeWorkflowExecutionRespo
 	}
 }
 
+// domainStatusProvisioning is not part of the public proto API yet, it is carried as its raw enum value
+const domainStatusProvisioning = apiv1.DomainStatus(4)
+
 func FromDomainStatus(t *types.DomainStatus) apiv1.DomainStatus {
 	if t == nil {
 		return apiv1.DomainStatus_DOMAIN_STATUS_INVALID
@@ -1261,6 +1267,8 @@ func FromDomainStatus(t *types.DomainStatus) apiv1.DomainStatus {
 		return apiv1.DomainStatus_DOMAIN_STATUS_DEPRECATED
 	case types.DomainStatusDeleted:
 		return apiv1.DomainStatus_DOMAIN_STATUS_DELETED
+	case types.DomainStatusProvisioning:
+		return domainStatusProvisioning
 	}
 	panic("unexpected enum value")
 }
@@ -1275,6 +1283,8 @@ func ToDomainStatus(t apiv1.DomainStatus) *types.DomainStatus {
 		return types.DomainStatusDeprecated.Ptr()
 	case apiv1.DomainStatus_DOMAIN_STATUS_DELETED:
 		return types.DomainStatusDeleted.Ptr()
+	case domainStatusProvisioning:
+		return types.DomainStatusProvisioning.Ptr()
 	}
 	panic("unexpected enum value")
 }
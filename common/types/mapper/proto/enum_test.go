@@ -45,6 +45,17 @@ func TestTaskSource(t *testing.T) {
 	assert.Panics(t, func() { ToTaskSource(sharedv1.TaskSource(UnknownValue)) })
 	assert.Panics(t, func() { FromTaskSource(types.TaskSource(UnknownValue).Ptr()) })
 }
+func TestCompressionType(t *testing.T) {
+	for _, item := range []*types.CompressionType{
+		nil,
+		types.CompressionTypeSnappy.Ptr(),
+		types.CompressionTypeZstd.Ptr(),
+	} {
+		assert.Equal(t, item, ToCompressionType(FromCompressionType(item)))
+	}
+	assert.Panics(t, func() { ToCompressionType(sharedv1.CompressionType(UnknownValue)) })
+	assert.Panics(t, func() { FromCompressionType(types.CompressionType(UnknownValue).Ptr()) })
+}
 func TestDLQType(t *testing.T) {
 	for _, item := range []*types.DLQType{
 		nil,
@@ -161,6 +172,7 @@ func TestDecisionTaskFailedCause(t *testing.T) {
 	} {
 		assert.Equal(t, item, ToDecisionTaskFailedCause(FromDecisionTaskFailedCause(item)))
 	}
+	assert.Nil(t, ToDecisionTaskFailedCause(FromDecisionTaskFailedCause(types.DecisionTaskFailedCauseBadTaskListPayloadSize.Ptr())))
 	assert.Panics(t, func() { ToDecisionTaskFailedCause(apiv1.DecisionTaskFailedCause(UnknownValue)) })
 	assert.Panics(t, func() { FromDecisionTaskFailedCause(types.DecisionTaskFailedCause(UnknownValue).Ptr()) })
 }
@@ -170,6 +182,7 @@ func TestDomainStatus(t *testing.T) {
 		types.DomainStatusRegistered.Ptr(),
 		types.DomainStatusDeprecated.Ptr(),
 		types.DomainStatusDeleted.Ptr(),
+		types.DomainStatusProvisioning.Ptr(),
 	} {
 		assert.Equal(t, item, ToDomainStatus(FromDomainStatus(item)))
 	}
@@ -22,7 +22,9 @@ package proto
 
 import (
 	"errors"
+	"time"
 
+	gogo "github.com/gogo/protobuf/types"
 	"go.uber.org/yarpc/encoding/protobuf"
 	"go.uber.org/yarpc/yarpcerrors"
 
@@ -99,7 +101,7 @@ func FromError(err error) error {
 	case *types.LimitExceededError:
 		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.LimitExceededError{}))
 	case *types.ServiceBusyError:
-		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.ServiceBusyError{}))
+		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.ServiceBusyError{}), withRetryAfter(e.RetryAfterInMillis))
 	case *types.RemoteSyncMatchedError:
 		return protobuf.NewError(yarpcerrors.CodeUnavailable, e.Message, protobuf.WithErrorDetails(&sharedv1.RemoteSyncMatchedError{}))
 	}
@@ -223,7 +225,8 @@ func ToError(err error) error {
 			}
 		case *apiv1.ServiceBusyError:
 			return &types.ServiceBusyError{
-				Message: status.Message(),
+				Message:            status.Message(),
+				RetryAfterInMillis: getRetryAfterInMillis(err),
 			}
 		}
 	case yarpcerrors.CodeUnavailable:
@@ -242,6 +245,26 @@ func ToError(err error) error {
 	return status
 }
 
+// withRetryAfter attaches the retry after hint of a throttling error as a google.protobuf.Duration detail,
+// as the error messages of the public API have no field for it
+func withRetryAfter(retryAfterInMillis int64) protobuf.ErrorOption {
+	if retryAfterInMillis <= 0 {
+		return protobuf.WithErrorDetails()
+	}
+	return protobuf.WithErrorDetails(gogo.DurationProto(time.Duration(retryAfterInMillis) * time.Millisecond))
+}
+
+func getRetryAfterInMillis(err error) int64 {
+	for _, detail := range protobuf.GetErrorDetails(err) {
+		if d, ok := detail.(*gogo.Duration); ok {
+			if retryAfter, err := gogo.DurationFromProto(d); err == nil {
+				return retryAfter.Milliseconds()
+			}
+		}
+	}
+	return 0
+}
+
 func getErrorDetails(err error) interface{} {
 	details := protobuf.GetErrorDetails(err)
 	if len(details) > 0 {
@@ -71,6 +71,7 @@ func FromHistoryDescribeHistoryHostResponse(t *types.DescribeHistoryHostResponse
 		DomainCache:           FromDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.ShardControllerStatus,
 		Address:               t.Address,
+		PayloadSizeStats:      FromDomainPayloadSizeStatsArray(t.PayloadSizeStats),
 	}
 }
 
@@ -84,6 +85,7 @@ func ToHistoryDescribeHistoryHostResponse(t *historyv1.DescribeHistoryHostRespon
 		DomainCache:           ToDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.ShardControllerStatus,
 		Address:               t.Address,
+		PayloadSizeStats:      ToDomainPayloadSizeStatsArray(t.PayloadSizeStats),
 	}
 }
 
@@ -167,6 +169,42 @@ func ToHistoryDescribeQueueResponse(t *historyv1.DescribeQueueResponse) *types.D
 	}
 }
 
+func FromHistoryGetReplicationAckStatesRequest(t *types.GetReplicationAckStatesRequest) *historyv1.GetReplicationAckStatesRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationAckStatesRequest{
+		ShardId: t.ShardID,
+	}
+}
+
+func ToHistoryGetReplicationAckStatesRequest(t *historyv1.GetReplicationAckStatesRequest) *types.GetReplicationAckStatesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationAckStatesRequest{
+		ShardID: t.ShardId,
+	}
+}
+
+func FromHistoryGetReplicationAckStatesResponse(t *types.GetReplicationAckStatesResponse) *historyv1.GetReplicationAckStatesResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetReplicationAckStatesResponse{
+		AckStates: FromReplicationAckStateArray(t.AckStates),
+	}
+}
+
+func ToHistoryGetReplicationAckStatesResponse(t *historyv1.GetReplicationAckStatesResponse) *types.GetReplicationAckStatesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationAckStatesResponse{
+		AckStates: ToReplicationAckStateArray(t.AckStates),
+	}
+}
+
 func FromHistoryDescribeWorkflowExecutionRequest(t *types.HistoryDescribeWorkflowExecutionRequest) *historyv1.DescribeWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -197,6 +235,7 @@ func FromHistoryDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecu
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		HistoryLimitWarning:    FromHistoryLimitWarning(t.HistoryLimitWarning),
 	}
 }
 
@@ -210,6 +249,35 @@ func ToHistoryDescribeWorkflowExecutionResponse(t *historyv1.DescribeWorkflowExe
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		HistoryLimitWarning:    ToHistoryLimitWarning(t.HistoryLimitWarning),
+	}
+}
+
+func FromHistoryLimitWarning(t *types.HistoryLimitWarning) *historyv1.HistoryLimitWarning {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.HistoryLimitWarning{
+		HistoryCount:            t.HistoryCount,
+		HistorySize:             t.HistorySize,
+		HistoryCountLimit:       t.HistoryCountLimit,
+		HistorySizeLimit:        t.HistorySizeLimit,
+		EventsPerHour:           t.EventsPerHour,
+		EstimatedSecondsToLimit: fromInt64Value(t.EstimatedSecondsToLimit),
+	}
+}
+
+func ToHistoryLimitWarning(t *historyv1.HistoryLimitWarning) *types.HistoryLimitWarning {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryLimitWarning{
+		HistoryCount:            t.HistoryCount,
+		HistorySize:             t.HistorySize,
+		HistoryCountLimit:       t.HistoryCountLimit,
+		HistorySizeLimit:        t.HistorySizeLimit,
+		EventsPerHour:           t.EventsPerHour,
+		EstimatedSecondsToLimit: toInt64Value(t.EstimatedSecondsToLimit),
 	}
 }
 
@@ -218,7 +286,8 @@ func FromHistoryGetDLQReplicationMessagesRequest(t *types.GetDLQReplicationMessa
 		return nil
 	}
 	return &historyv1.GetDLQReplicationMessagesRequest{
-		TaskInfos: FromReplicationTaskInfoArray(t.TaskInfos),
+		TaskInfos:             FromReplicationTaskInfoArray(t.TaskInfos),
+		SupportedCompressions: FromCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -227,7 +296,8 @@ func ToHistoryGetDLQReplicationMessagesRequest(t *historyv1.GetDLQReplicationMes
 		return nil
 	}
 	return &types.GetDLQReplicationMessagesRequest{
-		TaskInfos: ToReplicationTaskInfoArray(t.TaskInfos),
+		TaskInfos:             ToReplicationTaskInfoArray(t.TaskInfos),
+		SupportedCompressions: ToCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -287,6 +357,44 @@ func ToHistoryGetFailoverInfoResponse(t *historyv1.GetFailoverInfoResponse) *typ
 	}
 }
 
+func FromHistoryGetDomainReplicationWatermarkRequest(t *types.HistoryGetDomainReplicationWatermarkRequest) *historyv1.GetDomainReplicationWatermarkRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetDomainReplicationWatermarkRequest{
+		DomainId: t.DomainID,
+		ShardIds: t.ShardIDs,
+	}
+}
+
+func ToHistoryGetDomainReplicationWatermarkRequest(t *historyv1.GetDomainReplicationWatermarkRequest) *types.HistoryGetDomainReplicationWatermarkRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetDomainReplicationWatermarkRequest{
+		DomainID: t.DomainId,
+		ShardIDs: t.ShardIds,
+	}
+}
+
+func FromHistoryGetDomainReplicationWatermarkResponse(t *types.HistoryGetDomainReplicationWatermarkResponse) *historyv1.GetDomainReplicationWatermarkResponse {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.GetDomainReplicationWatermarkResponse{
+		LastReplicatedEventTime: unixNanoToTime(t.LastReplicatedEventTime),
+	}
+}
+
+func ToHistoryGetDomainReplicationWatermarkResponse(t *historyv1.GetDomainReplicationWatermarkResponse) *types.HistoryGetDomainReplicationWatermarkResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetDomainReplicationWatermarkResponse{
+		LastReplicatedEventTime: timeToUnixNano(t.LastReplicatedEventTime),
+	}
+}
+
 func FromHistoryGetMutableStateRequest(t *types.GetMutableStateRequest) *historyv1.GetMutableStateRequest {
 	if t == nil {
 		return nil
@@ -371,8 +479,9 @@ func FromHistoryGetReplicationMessagesRequest(t *types.GetReplicationMessagesReq
 		return nil
 	}
 	return &historyv1.GetReplicationMessagesRequest{
-		Tokens:      FromReplicationTokenArray(t.Tokens),
-		ClusterName: t.ClusterName,
+		Tokens:                FromReplicationTokenArray(t.Tokens),
+		ClusterName:           t.ClusterName,
+		SupportedCompressions: FromCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -381,8 +490,9 @@ func ToHistoryGetReplicationMessagesRequest(t *historyv1.GetReplicationMessagesR
 		return nil
 	}
 	return &types.GetReplicationMessagesRequest{
-		Tokens:      ToReplicationTokenArray(t.Tokens),
-		ClusterName: t.ClusterName,
+		Tokens:                ToReplicationTokenArray(t.Tokens),
+		ClusterName:           t.ClusterName,
+		SupportedCompressions: ToCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -1292,6 +1402,94 @@ func ToHistorySignalWorkflowExecutionRequest(t *historyv1.SignalWorkflowExecutio
 	}
 }
 
+func FromHistorySignalBatchWorkflowExecutionRequest(t *types.HistorySignalBatchWorkflowExecutionRequest) *historyv1.SignalBatchWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.SignalBatchWorkflowExecutionRequest{
+		Request:  FromSignalBatchRequest(t.SignalRequest),
+		DomainId: t.DomainUUID,
+	}
+}
+
+func ToHistorySignalBatchWorkflowExecutionRequest(t *historyv1.SignalBatchWorkflowExecutionRequest) *types.HistorySignalBatchWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistorySignalBatchWorkflowExecutionRequest{
+		SignalRequest: ToSignalBatchRequest(t.Request),
+		DomainUUID:    t.DomainId,
+	}
+}
+
+func FromSignalBatchRequest(t *types.SignalBatchWorkflowExecutionRequest) *historyv1.SignalBatchRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.SignalBatchRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Signals:           FromSignalBatchItemArray(t.Signals),
+		Identity:          t.Identity,
+		RequestId:         t.RequestID,
+	}
+}
+
+func ToSignalBatchRequest(t *historyv1.SignalBatchRequest) *types.SignalBatchWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalBatchWorkflowExecutionRequest{
+		Domain:            t.Domain,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Signals:           ToSignalBatchItemArray(t.Signals),
+		Identity:          t.Identity,
+		RequestID:         t.RequestId,
+	}
+}
+
+func FromSignalBatchItem(t *types.SignalBatchItem) *historyv1.SignalBatchItem {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.SignalBatchItem{
+		SignalName: t.SignalName,
+		Input:      FromPayload(t.Input),
+	}
+}
+
+func ToSignalBatchItem(t *historyv1.SignalBatchItem) *types.SignalBatchItem {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalBatchItem{
+		SignalName: t.SignalName,
+		Input:      ToPayload(t.Input),
+	}
+}
+
+func FromSignalBatchItemArray(t []*types.SignalBatchItem) []*historyv1.SignalBatchItem {
+	if t == nil {
+		return nil
+	}
+	v := make([]*historyv1.SignalBatchItem, len(t))
+	for i := range t {
+		v[i] = FromSignalBatchItem(t[i])
+	}
+	return v
+}
+
+func ToSignalBatchItemArray(t []*historyv1.SignalBatchItem) []*types.SignalBatchItem {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.SignalBatchItem, len(t))
+	for i := range t {
+		v[i] = ToSignalBatchItem(t[i])
+	}
+	return v
+}
+
 func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecutionRequest) *historyv1.StartWorkflowExecutionRequest {
 	if t == nil {
 		return nil
@@ -1524,3 +1722,23 @@ func ToHistoryRespondCrossClusterTasksCompletedResponse(t *historyv1.RespondCros
 		Tasks: ToCrossClusterTaskRequestArray(t.Tasks),
 	}
 }
+
+func FromHistoryReconcileWorkflowRetentionRequest(t *types.ReconcileWorkflowRetentionRequest) *historyv1.ReconcileWorkflowRetentionRequest {
+	if t == nil {
+		return nil
+	}
+	return &historyv1.ReconcileWorkflowRetentionRequest{
+		DomainId:          t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+func ToHistoryReconcileWorkflowRetentionRequest(t *historyv1.ReconcileWorkflowRetentionRequest) *types.ReconcileWorkflowRetentionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReconcileWorkflowRetentionRequest{
+		DomainUUID:        t.DomainId,
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
@@ -64,11 +64,27 @@ func TestHistoryDescribeQueueResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryDescribeQueueResponse(FromHistoryDescribeQueueResponse(item)))
 	}
 }
+func TestHistoryGetReplicationAckStatesRequest(t *testing.T) {
+	for _, item := range []*types.GetReplicationAckStatesRequest{nil, {}, &testdata.HistoryGetReplicationAckStatesRequest} {
+		assert.Equal(t, item, ToHistoryGetReplicationAckStatesRequest(FromHistoryGetReplicationAckStatesRequest(item)))
+	}
+}
+func TestHistoryGetReplicationAckStatesResponse(t *testing.T) {
+	for _, item := range []*types.GetReplicationAckStatesResponse{nil, {}, &testdata.HistoryGetReplicationAckStatesResponse} {
+		assert.Equal(t, item, ToHistoryGetReplicationAckStatesResponse(FromHistoryGetReplicationAckStatesResponse(item)))
+	}
+}
 func TestHistoryDescribeWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistoryDescribeWorkflowExecutionRequest{nil, {}, &testdata.HistoryDescribeWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistoryDescribeWorkflowExecutionRequest(FromHistoryDescribeWorkflowExecutionRequest(item)))
 	}
 }
+func TestHistoryLimitWarning(t *testing.T) {
+	for _, item := range []*types.HistoryLimitWarning{nil, {}, &testdata.HistoryLimitWarning} {
+		assert.Equal(t, item, ToHistoryLimitWarning(FromHistoryLimitWarning(item)))
+	}
+}
+
 func TestHistoryDescribeWorkflowExecutionResponse(t *testing.T) {
 	for _, item := range []*types.DescribeWorkflowExecutionResponse{nil, {}, &testdata.HistoryDescribeWorkflowExecutionResponse} {
 		assert.Equal(t, item, ToHistoryDescribeWorkflowExecutionResponse(FromHistoryDescribeWorkflowExecutionResponse(item)))
@@ -207,6 +223,12 @@ func TestHistoryRemoveSignalMutableStateRequest(t *testing.T) {
 		assert.Equal(t, item, ToHistoryRemoveSignalMutableStateRequest(FromHistoryRemoveSignalMutableStateRequest(item)))
 	}
 }
+
+func TestHistoryReconcileWorkflowRetentionRequest(t *testing.T) {
+	for _, item := range []*types.ReconcileWorkflowRetentionRequest{nil, {}, &testdata.HistoryReconcileWorkflowRetentionRequest} {
+		assert.Equal(t, item, ToHistoryReconcileWorkflowRetentionRequest(FromHistoryReconcileWorkflowRetentionRequest(item)))
+	}
+}
 func TestHistoryRemoveTaskRequest(t *testing.T) {
 	for _, item := range []*types.RemoveTaskRequest{nil, {}, &testdata.HistoryRemoveTaskRequest} {
 		assert.Equal(t, item, ToHistoryRemoveTaskRequest(FromHistoryRemoveTaskRequest(item)))
@@ -287,6 +309,12 @@ func TestHistorySignalWithStartWorkflowExecutionResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistorySignalWithStartWorkflowExecutionResponse(FromHistorySignalWithStartWorkflowExecutionResponse(item)))
 	}
 }
+func TestHistorySignalBatchWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistorySignalBatchWorkflowExecutionRequest{nil, {}, &testdata.HistorySignalBatchWorkflowExecutionRequest} {
+		assert.Equal(t, item, ToHistorySignalBatchWorkflowExecutionRequest(FromHistorySignalBatchWorkflowExecutionRequest(item)))
+	}
+}
+
 func TestHistorySignalWorkflowExecutionRequest(t *testing.T) {
 	for _, item := range []*types.HistorySignalWorkflowExecutionRequest{nil, {}, &testdata.HistorySignalWorkflowExecutionRequest} {
 		assert.Equal(t, item, ToHistorySignalWorkflowExecutionRequest(FromHistorySignalWorkflowExecutionRequest(item)))
@@ -353,3 +381,15 @@ func TestHistoryGetTaskInfoResponse(t *testing.T) {
 		assert.Equal(t, item, ToHistoryGetFailoverInfoResponse(FromHistoryGetFailoverInfoResponse(item)))
 	}
 }
+
+func TestHistoryGetDomainReplicationWatermarkRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetDomainReplicationWatermarkRequest{nil, {}, &testdata.HistoryGetDomainReplicationWatermarkRequest} {
+		assert.Equal(t, item, ToHistoryGetDomainReplicationWatermarkRequest(FromHistoryGetDomainReplicationWatermarkRequest(item)))
+	}
+}
+
+func TestHistoryGetDomainReplicationWatermarkResponse(t *testing.T) {
+	for _, item := range []*types.HistoryGetDomainReplicationWatermarkResponse{nil, {}, &testdata.HistoryGetDomainReplicationWatermarkResponse} {
+		assert.Equal(t, item, ToHistoryGetDomainReplicationWatermarkResponse(FromHistoryGetDomainReplicationWatermarkResponse(item)))
+	}
+}
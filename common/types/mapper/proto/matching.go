@@ -39,6 +39,11 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
+		Ephemeral:              t.Ephemeral,
+		AffinityKey:            t.AffinityKey,
+		Attempt:                t.Attempt,
+		ShadowSourceTaskList:   t.ShadowSourceTaskList,
+		ShadowMetadataOnly:     t.ShadowMetadataOnly,
 	}
 }
 
@@ -55,6 +60,11 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
+		Ephemeral:                     t.Ephemeral,
+		AffinityKey:                   t.AffinityKey,
+		Attempt:                       t.Attempt,
+		ShadowSourceTaskList:          t.ShadowSourceTaskList,
+		ShadowMetadataOnly:            t.ShadowMetadataOnly,
 	}
 }
 
@@ -70,6 +80,11 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		ScheduleToStartTimeout: secondsToDuration(t.ScheduleToStartTimeoutSeconds),
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
+		Ephemeral:              t.Ephemeral,
+		AffinityKey:            t.AffinityKey,
+		Attempt:                t.Attempt,
+		ShadowSourceTaskList:   t.ShadowSourceTaskList,
+		ShadowMetadataOnly:     t.ShadowMetadataOnly,
 	}
 }
 
@@ -85,6 +100,11 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		ScheduleToStartTimeoutSeconds: durationToSeconds(t.ScheduleToStartTimeout),
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
+		Ephemeral:                     t.Ephemeral,
+		AffinityKey:                   t.AffinityKey,
+		Attempt:                       t.Attempt,
+		ShadowSourceTaskList:          t.ShadowSourceTaskList,
+		ShadowMetadataOnly:            t.ShadowMetadataOnly,
 	}
 }
 
@@ -145,8 +165,10 @@ func FromMatchingDescribeTaskListResponse(t *types.DescribeTaskListResponse) *ma
 		return nil
 	}
 	return &matchingv1.DescribeTaskListResponse{
-		Pollers:        FromPollerInfoArray(t.Pollers),
-		TaskListStatus: FromTaskListStatus(t.TaskListStatus),
+		Pollers:              FromPollerInfoArray(t.Pollers),
+		TaskListStatus:       FromTaskListStatus(t.TaskListStatus),
+		MatchStats:           FromMatchingTaskListMatchStats(t.TaskListStatus.GetMatchStats()),
+		OutstandingPollCount: t.TaskListStatus.GetOutstandingPollCount(),
 	}
 }
 
@@ -154,9 +176,38 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 	if t == nil {
 		return nil
 	}
+	taskListStatus := ToTaskListStatus(t.TaskListStatus)
+	if taskListStatus != nil {
+		taskListStatus.MatchStats = ToMatchingTaskListMatchStats(t.MatchStats)
+		taskListStatus.OutstandingPollCount = t.OutstandingPollCount
+	}
 	return &types.DescribeTaskListResponse{
 		Pollers:        ToPollerInfoArray(t.Pollers),
-		TaskListStatus: ToTaskListStatus(t.TaskListStatus),
+		TaskListStatus: taskListStatus,
+	}
+}
+
+func FromMatchingTaskListMatchStats(t *types.TaskListMatchStats) *matchingv1.TaskListMatchStats {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.TaskListMatchStats{
+		SyncMatched:     t.SyncMatched,
+		BufferedMatched: t.BufferedMatched,
+		Forwarded:       t.Forwarded,
+		Expired:         t.Expired,
+	}
+}
+
+func ToMatchingTaskListMatchStats(t *matchingv1.TaskListMatchStats) *types.TaskListMatchStats {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListMatchStats{
+		SyncMatched:     t.SyncMatched,
+		BufferedMatched: t.BufferedMatched,
+		Forwarded:       t.Forwarded,
+		Expired:         t.Expired,
 	}
 }
 
@@ -475,3 +526,77 @@ func ToMatchingRespondQueryTaskCompletedRequest(t *matchingv1.RespondQueryTaskCo
 		TaskID:           t.TaskId,
 	}
 }
+
+func FromMatchingDescribeTaskListConfigRequest(t *types.MatchingDescribeTaskListConfigRequest) *matchingv1.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribeTaskListConfigRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+func ToMatchingDescribeTaskListConfigRequest(t *matchingv1.DescribeTaskListConfigRequest) *types.MatchingDescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+func FromMatchingDescribeTaskListConfigResponse(t *types.DescribeTaskListConfigResponse) *matchingv1.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribeTaskListConfigResponse{
+		NumReadPartitions:                 t.NumReadPartitions,
+		NumWritePartitions:                t.NumWritePartitions,
+		ForwarderMaxOutstandingPolls:      t.ForwarderMaxOutstandingPolls,
+		ForwarderMaxOutstandingTasks:      t.ForwarderMaxOutstandingTasks,
+		ForwarderMaxRatePerSecond:         t.ForwarderMaxRatePerSecond,
+		ForwarderMaxChildrenPerNode:       t.ForwarderMaxChildrenPerNode,
+		EnableSyncMatch:                   t.EnableSyncMatch,
+		EnableEphemeralTaskList:           t.EnableEphemeralTaskList,
+		LongPollExpirationIntervalSeconds: t.LongPollExpirationIntervalInSeconds,
+		RangeSize:                         t.RangeSize,
+		GetTasksBatchSize:                 t.GetTasksBatchSize,
+		UpdateAckIntervalSeconds:          t.UpdateAckIntervalInSeconds,
+		IdleTaskListCheckIntervalSeconds:  t.IdleTaskListCheckIntervalInSeconds,
+		MaxTaskListIdleTimeSeconds:        t.MaxTaskListIdleTimeInSeconds,
+		MinTaskThrottlingBurstSize:        t.MinTaskThrottlingBurstSize,
+		MaxTaskDeleteBatchSize:            t.MaxTaskDeleteBatchSize,
+		OutstandingTaskAppendsThreshold:   t.OutstandingTaskAppendsThreshold,
+		MaxTaskBatchSize:                  t.MaxTaskBatchSize,
+	}
+}
+
+func ToMatchingDescribeTaskListConfigResponse(t *matchingv1.DescribeTaskListConfigResponse) *types.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListConfigResponse{
+		NumReadPartitions:                   t.NumReadPartitions,
+		NumWritePartitions:                  t.NumWritePartitions,
+		ForwarderMaxOutstandingPolls:        t.ForwarderMaxOutstandingPolls,
+		ForwarderMaxOutstandingTasks:        t.ForwarderMaxOutstandingTasks,
+		ForwarderMaxRatePerSecond:           t.ForwarderMaxRatePerSecond,
+		ForwarderMaxChildrenPerNode:         t.ForwarderMaxChildrenPerNode,
+		EnableSyncMatch:                     t.EnableSyncMatch,
+		EnableEphemeralTaskList:             t.EnableEphemeralTaskList,
+		LongPollExpirationIntervalInSeconds: t.LongPollExpirationIntervalSeconds,
+		RangeSize:                           t.RangeSize,
+		GetTasksBatchSize:                   t.GetTasksBatchSize,
+		UpdateAckIntervalInSeconds:          t.UpdateAckIntervalSeconds,
+		IdleTaskListCheckIntervalInSeconds:  t.IdleTaskListCheckIntervalSeconds,
+		MaxTaskListIdleTimeInSeconds:        t.MaxTaskListIdleTimeSeconds,
+		MinTaskThrottlingBurstSize:          t.MinTaskThrottlingBurstSize,
+		MaxTaskDeleteBatchSize:              t.MaxTaskDeleteBatchSize,
+		OutstandingTaskAppendsThreshold:     t.OutstandingTaskAppendsThreshold,
+		MaxTaskBatchSize:                    t.MaxTaskBatchSize,
+	}
+}
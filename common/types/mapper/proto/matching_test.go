@@ -59,6 +59,12 @@ func TestMatchingDescribeTaskListResponse(t *testing.T) {
 	}
 }
 
+func TestMatchingTaskListMatchStats(t *testing.T) {
+	for _, item := range []*types.TaskListMatchStats{nil, {}, &testdata.TaskListMatchStats} {
+		assert.Equal(t, item, ToMatchingTaskListMatchStats(FromMatchingTaskListMatchStats(item)))
+	}
+}
+
 func TestMatchingDescribeTaskListResponseMap(t *testing.T) {
 	for _, item := range []map[string]*types.DescribeTaskListResponse{nil, {}, testdata.DescribeTaskListResponseMap} {
 		assert.Equal(t, item, ToMatchingDescribeTaskListResponseMap(FromMatchingDescribeTaskListResponseMap(item)))
@@ -130,3 +136,15 @@ func TestMatchingGetTaskListsByDomainResponse(t *testing.T) {
 		assert.Equal(t, item, ToMatchingGetTaskListsByDomainResponse(FromMatchingGetTaskListsByDomainResponse(item)))
 	}
 }
+
+func TestMatchingDescribeTaskListConfigRequest(t *testing.T) {
+	for _, item := range []*types.MatchingDescribeTaskListConfigRequest{nil, {}, &testdata.MatchingDescribeTaskListConfigRequest} {
+		assert.Equal(t, item, ToMatchingDescribeTaskListConfigRequest(FromMatchingDescribeTaskListConfigRequest(item)))
+	}
+}
+
+func TestMatchingDescribeTaskListConfigResponse(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListConfigResponse{nil, {}, &testdata.MatchingDescribeTaskListConfigResponse} {
+		assert.Equal(t, item, ToMatchingDescribeTaskListConfigResponse(FromMatchingDescribeTaskListConfigResponse(item)))
+	}
+}
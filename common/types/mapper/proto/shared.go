@@ -219,6 +219,56 @@ func ToDomainCacheInfo(t *sharedv1.DomainCacheInfo) *types.DomainCacheInfo {
 	}
 }
 
+func FromDomainPayloadSizeStats(t *types.DomainPayloadSizeStats) *sharedv1.DomainPayloadSizeStats {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.DomainPayloadSizeStats{
+		DomainName:     t.DomainName,
+		PayloadType:    t.PayloadType,
+		Count:          t.Count,
+		AverageSize:    t.AverageSize,
+		MaxSize:        t.MaxSize,
+		NearLimitCount: t.NearLimitCount,
+	}
+}
+
+func ToDomainPayloadSizeStats(t *sharedv1.DomainPayloadSizeStats) *types.DomainPayloadSizeStats {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainPayloadSizeStats{
+		DomainName:     t.DomainName,
+		PayloadType:    t.PayloadType,
+		Count:          t.Count,
+		AverageSize:    t.AverageSize,
+		MaxSize:        t.MaxSize,
+		NearLimitCount: t.NearLimitCount,
+	}
+}
+
+func FromDomainPayloadSizeStatsArray(t []*types.DomainPayloadSizeStats) []*sharedv1.DomainPayloadSizeStats {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.DomainPayloadSizeStats, len(t))
+	for i := range t {
+		v[i] = FromDomainPayloadSizeStats(t[i])
+	}
+	return v
+}
+
+func ToDomainPayloadSizeStatsArray(t []*sharedv1.DomainPayloadSizeStats) []*types.DomainPayloadSizeStats {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DomainPayloadSizeStats, len(t))
+	for i := range t {
+		v[i] = ToDomainPayloadSizeStats(t[i])
+	}
+	return v
+}
+
 func FromRingInfo(t *types.RingInfo) *sharedv1.RingInfo {
 	if t == nil {
 		return nil
@@ -459,6 +509,55 @@ func ToRingInfoArray(t []*sharedv1.RingInfo) []*types.RingInfo {
 	return v
 }
 
+func FromCompressionType(t *types.CompressionType) sharedv1.CompressionType {
+	if t == nil {
+		return sharedv1.CompressionType_COMPRESSION_TYPE_INVALID
+	}
+	switch *t {
+	case types.CompressionTypeSnappy:
+		return sharedv1.CompressionType_COMPRESSION_TYPE_SNAPPY
+	case types.CompressionTypeZstd:
+		return sharedv1.CompressionType_COMPRESSION_TYPE_ZSTD
+	}
+	panic("unexpected enum value")
+}
+
+func ToCompressionType(t sharedv1.CompressionType) *types.CompressionType {
+	switch t {
+	case sharedv1.CompressionType_COMPRESSION_TYPE_INVALID:
+		return nil
+	case sharedv1.CompressionType_COMPRESSION_TYPE_SNAPPY:
+		return types.CompressionTypeSnappy.Ptr()
+	case sharedv1.CompressionType_COMPRESSION_TYPE_ZSTD:
+		return types.CompressionTypeZstd.Ptr()
+	}
+	panic("unexpected enum value")
+}
+
+func FromCompressionTypeArray(t []types.CompressionType) []sharedv1.CompressionType {
+	if t == nil {
+		return nil
+	}
+	v := make([]sharedv1.CompressionType, len(t))
+	for i := range t {
+		v[i] = FromCompressionType(&t[i])
+	}
+	return v
+}
+
+func ToCompressionTypeArray(t []sharedv1.CompressionType) []types.CompressionType {
+	if t == nil {
+		return nil
+	}
+	v := make([]types.CompressionType, 0, len(t))
+	for i := range t {
+		if compression := ToCompressionType(t[i]); compression != nil {
+			v = append(v, *compression)
+		}
+	}
+	return v
+}
+
 func FromDLQType(t *types.DLQType) sharedv1.DLQType {
 	if t == nil {
 		return sharedv1.DLQType_DLQ_TYPE_INVALID
@@ -524,6 +623,7 @@ func FromDomainTaskAttributes(t *types.DomainTaskAttributes) *sharedv1.DomainTas
 		ConfigVersion:           t.ConfigVersion,
 		FailoverVersion:         t.FailoverVersion,
 		PreviousFailoverVersion: t.PreviousFailoverVersion,
+		AsyncWorkflowConfig:     FromAsyncWorkflowConfiguration(t.Config.GetAsyncWorkflowConfig()),
 	}
 }
 
@@ -532,6 +632,9 @@ func ToDomainTaskAttributes(t *sharedv1.DomainTaskAttributes) *types.DomainTaskA
 		return nil
 	}
 	domain := ToDescribeDomainResponseDomain(t.Domain)
+	if domain.Configuration != nil {
+		domain.Configuration.AsyncWorkflowConfig = ToAsyncWorkflowConfiguration(t.AsyncWorkflowConfig)
+	}
 	return &types.DomainTaskAttributes{
 		DomainOperation:         ToDomainOperation(t.DomainOperation),
 		ID:                      t.Id,
@@ -544,6 +647,30 @@ func ToDomainTaskAttributes(t *sharedv1.DomainTaskAttributes) *types.DomainTaskA
 	}
 }
 
+func FromAsyncWorkflowConfiguration(t *types.AsyncWorkflowConfiguration) *sharedv1.AsyncWorkflowConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.AsyncWorkflowConfiguration{
+		Enabled:       t.Enabled,
+		QueueProvider: t.QueueProvider,
+		QueueTopic:    t.QueueTopic,
+		Rps:           t.RPS,
+	}
+}
+
+func ToAsyncWorkflowConfiguration(t *sharedv1.AsyncWorkflowConfiguration) *types.AsyncWorkflowConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &types.AsyncWorkflowConfiguration{
+		Enabled:       t.Enabled,
+		QueueProvider: t.QueueProvider,
+		QueueTopic:    t.QueueTopic,
+		RPS:           t.Rps,
+	}
+}
+
 func FromFailoverMarkerAttributes(t *types.FailoverMarkerAttributes) *sharedv1.FailoverMarkerAttributes {
 	if t == nil {
 		return nil
@@ -597,6 +724,7 @@ func FromHistoryTaskV2Attributes(t *types.HistoryTaskV2Attributes) *sharedv1.His
 		VersionHistoryItems: FromVersionHistoryItemArray(t.VersionHistoryItems),
 		Events:              FromDataBlob(t.Events),
 		NewRunEvents:        FromDataBlob(t.NewRunEvents),
+		Compression:         FromCompressionType(t.Compression),
 	}
 }
 
@@ -612,6 +740,7 @@ func ToHistoryTaskV2Attributes(t *sharedv1.HistoryTaskV2Attributes) *types.Histo
 		VersionHistoryItems: ToVersionHistoryItemArray(t.VersionHistoryItems),
 		Events:              ToDataBlob(t.Events),
 		NewRunEvents:        ToDataBlob(t.NewRunEvents),
+		Compression:         ToCompressionType(t.Compression),
 	}
 }
 
@@ -801,6 +930,54 @@ func ToSyncShardStatus(t *sharedv1.SyncShardStatus) *types.SyncShardStatus {
 	}
 }
 
+func FromReplicationAckState(t *types.ReplicationAckState) *sharedv1.ReplicationAckState {
+	if t == nil {
+		return nil
+	}
+	return &sharedv1.ReplicationAckState{
+		ClusterName:        t.ClusterName,
+		AckLevel:           t.AckLevel,
+		LastAckTime:        unixNanoToTime(t.LastAckTime),
+		PendingTasks:       t.PendingTasks,
+		PendingTasksCapped: t.PendingTasksCapped,
+	}
+}
+
+func ToReplicationAckState(t *sharedv1.ReplicationAckState) *types.ReplicationAckState {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationAckState{
+		ClusterName:        t.ClusterName,
+		AckLevel:           t.AckLevel,
+		LastAckTime:        timeToUnixNano(t.LastAckTime),
+		PendingTasks:       t.PendingTasks,
+		PendingTasksCapped: t.PendingTasksCapped,
+	}
+}
+
+func FromReplicationAckStateArray(t []*types.ReplicationAckState) []*sharedv1.ReplicationAckState {
+	if t == nil {
+		return nil
+	}
+	v := make([]*sharedv1.ReplicationAckState, len(t))
+	for i := range t {
+		v[i] = FromReplicationAckState(t[i])
+	}
+	return v
+}
+
+func ToReplicationAckStateArray(t []*sharedv1.ReplicationAckState) []*types.ReplicationAckState {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationAckState, len(t))
+	for i := range t {
+		v[i] = ToReplicationAckState(t[i])
+	}
+	return v
+}
+
 func FromSyncShardStatusTaskAttributes(t *types.SyncShardStatusTaskAttributes) *sharedv1.SyncShardStatusTaskAttributes {
 	if t == nil {
 		return nil
@@ -45,6 +45,11 @@ func TestDomainCacheInfo(t *testing.T) {
 		assert.Equal(t, item, ToDomainCacheInfo(FromDomainCacheInfo(item)))
 	}
 }
+func TestDomainPayloadSizeStats(t *testing.T) {
+	for _, item := range []*types.DomainPayloadSizeStats{nil, {}, &testdata.DomainPayloadSizeStats} {
+		assert.Equal(t, item, ToDomainPayloadSizeStats(FromDomainPayloadSizeStats(item)))
+	}
+}
 func TestRingInfo(t *testing.T) {
 	for _, item := range []*types.RingInfo{nil, {}, &testdata.RingInfo} {
 		assert.Equal(t, item, ToRingInfo(FromRingInfo(item)))
@@ -86,7 +91,12 @@ func TestRingInfoArray(t *testing.T) {
 	}
 }
 func TestDomainTaskAttributes(t *testing.T) {
-	for _, item := range []*types.DomainTaskAttributes{nil, &testdata.DomainTaskAttributes} {
+	// the async workflow configuration is replicated next to the domain, the public proto has no field for it
+	config := testdata.DomainConfiguration
+	config.AsyncWorkflowConfig = &testdata.AsyncWorkflowConfiguration
+	withAsyncWorkflowConfig := testdata.DomainTaskAttributes
+	withAsyncWorkflowConfig.Config = &config
+	for _, item := range []*types.DomainTaskAttributes{nil, &testdata.DomainTaskAttributes, &withAsyncWorkflowConfig} {
 		assert.Equal(t, item, ToDomainTaskAttributes(FromDomainTaskAttributes(item)))
 	}
 }
@@ -685,3 +685,563 @@ func ToListDynamicConfigResponse(t *admin.ListDynamicConfigResponse) *types.List
 		Entries: ToDynamicConfigEntryArray(t.Entries),
 	}
 }
+
+// FromGetDomainReplicationWatermarkRequest converts internal GetDomainReplicationWatermarkRequest type to thrift
+func FromGetDomainReplicationWatermarkRequest(t *types.GetDomainReplicationWatermarkRequest) *admin.GetDomainReplicationWatermarkRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetDomainReplicationWatermarkRequest{
+		Domain: &t.Domain,
+	}
+}
+
+// ToGetDomainReplicationWatermarkRequest converts thrift GetDomainReplicationWatermarkRequest type to internal
+func ToGetDomainReplicationWatermarkRequest(t *admin.GetDomainReplicationWatermarkRequest) *types.GetDomainReplicationWatermarkRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainReplicationWatermarkRequest{
+		Domain: t.GetDomain(),
+	}
+}
+
+// FromGetDomainReplicationWatermarkResponse converts internal GetDomainReplicationWatermarkResponse type to thrift
+func FromGetDomainReplicationWatermarkResponse(t *types.GetDomainReplicationWatermarkResponse) *admin.GetDomainReplicationWatermarkResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetDomainReplicationWatermarkResponse{
+		Cluster:                 &t.Cluster,
+		LastReplicatedEventTime: t.LastReplicatedEventTime,
+	}
+}
+
+// ToGetDomainReplicationWatermarkResponse converts thrift GetDomainReplicationWatermarkResponse type to internal
+func ToGetDomainReplicationWatermarkResponse(t *admin.GetDomainReplicationWatermarkResponse) *types.GetDomainReplicationWatermarkResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainReplicationWatermarkResponse{
+		Cluster:                 t.GetCluster(),
+		LastReplicatedEventTime: t.LastReplicatedEventTime,
+	}
+}
+
+// FromIssueDomainTokenRequest converts internal IssueDomainTokenRequest type to thrift
+func FromIssueDomainTokenRequest(t *types.IssueDomainTokenRequest) *admin.IssueDomainTokenRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.IssueDomainTokenRequest{
+		Domain:     &t.Domain,
+		Apis:       t.APIs,
+		TtlSeconds: &t.TTLSeconds,
+		Subject:    &t.Subject,
+	}
+}
+
+// ToIssueDomainTokenRequest converts thrift IssueDomainTokenRequest type to internal
+func ToIssueDomainTokenRequest(t *admin.IssueDomainTokenRequest) *types.IssueDomainTokenRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.IssueDomainTokenRequest{
+		Domain:     t.GetDomain(),
+		APIs:       t.Apis,
+		TTLSeconds: t.GetTtlSeconds(),
+		Subject:    t.GetSubject(),
+	}
+}
+
+// FromIssueDomainTokenResponse converts internal IssueDomainTokenResponse type to thrift
+func FromIssueDomainTokenResponse(t *types.IssueDomainTokenResponse) *admin.IssueDomainTokenResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.IssueDomainTokenResponse{
+		Token: &t.Token,
+	}
+}
+
+// ToIssueDomainTokenResponse converts thrift IssueDomainTokenResponse type to internal
+func ToIssueDomainTokenResponse(t *admin.IssueDomainTokenResponse) *types.IssueDomainTokenResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.IssueDomainTokenResponse{
+		Token: t.GetToken(),
+	}
+}
+
+// FromStealTaskListLeaseRequest converts internal StealTaskListLeaseRequest type to thrift
+func FromStealTaskListLeaseRequest(t *types.StealTaskListLeaseRequest) *admin.StealTaskListLeaseRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.StealTaskListLeaseRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToStealTaskListLeaseRequest converts thrift StealTaskListLeaseRequest type to internal
+func ToStealTaskListLeaseRequest(t *admin.StealTaskListLeaseRequest) *types.StealTaskListLeaseRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.StealTaskListLeaseRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromStealTaskListLeaseResponse converts internal StealTaskListLeaseResponse type to thrift
+func FromStealTaskListLeaseResponse(t *types.StealTaskListLeaseResponse) *admin.StealTaskListLeaseResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.StealTaskListLeaseResponse{
+		RangeID: &t.RangeID,
+	}
+}
+
+// ToStealTaskListLeaseResponse converts thrift StealTaskListLeaseResponse type to internal
+func ToStealTaskListLeaseResponse(t *admin.StealTaskListLeaseResponse) *types.StealTaskListLeaseResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.StealTaskListLeaseResponse{
+		RangeID: t.GetRangeID(),
+	}
+}
+
+// FromUpdateTaskListTagsRequest converts internal UpdateTaskListTagsRequest type to thrift
+func FromUpdateTaskListTagsRequest(t *types.UpdateTaskListTagsRequest) *admin.UpdateTaskListTagsRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.UpdateTaskListTagsRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Tags:         t.Tags,
+	}
+}
+
+// ToUpdateTaskListTagsRequest converts thrift UpdateTaskListTagsRequest type to internal
+func ToUpdateTaskListTagsRequest(t *admin.UpdateTaskListTagsRequest) *types.UpdateTaskListTagsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListTagsRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Tags:         t.Tags,
+	}
+}
+
+// FromUpdateTaskListTagsResponse converts internal UpdateTaskListTagsResponse type to thrift
+func FromUpdateTaskListTagsResponse(t *types.UpdateTaskListTagsResponse) *admin.UpdateTaskListTagsResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.UpdateTaskListTagsResponse{
+		Tags: t.Tags,
+	}
+}
+
+// ToUpdateTaskListTagsResponse converts thrift UpdateTaskListTagsResponse type to internal
+func ToUpdateTaskListTagsResponse(t *admin.UpdateTaskListTagsResponse) *types.UpdateTaskListTagsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateTaskListTagsResponse{
+		Tags: t.Tags,
+	}
+}
+
+// FromListTaskListTagsRequest converts internal ListTaskListTagsRequest type to thrift
+func FromListTaskListTagsRequest(t *types.ListTaskListTagsRequest) *admin.ListTaskListTagsRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListTaskListTagsRequest{
+		Domain: &t.Domain,
+		Tags:   t.Tags,
+	}
+}
+
+// ToListTaskListTagsRequest converts thrift ListTaskListTagsRequest type to internal
+func ToListTaskListTagsRequest(t *admin.ListTaskListTagsRequest) *types.ListTaskListTagsRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ListTaskListTagsRequest{
+		Domain: t.GetDomain(),
+		Tags:   t.Tags,
+	}
+}
+
+// FromListTaskListTagsResponse converts internal ListTaskListTagsResponse type to thrift
+func FromListTaskListTagsResponse(t *types.ListTaskListTagsResponse) *admin.ListTaskListTagsResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ListTaskListTagsResponse{
+		TaskLists: FromTaskListTagsArray(t.TaskLists),
+	}
+}
+
+// ToListTaskListTagsResponse converts thrift ListTaskListTagsResponse type to internal
+func ToListTaskListTagsResponse(t *admin.ListTaskListTagsResponse) *types.ListTaskListTagsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ListTaskListTagsResponse{
+		TaskLists: ToTaskListTagsArray(t.TaskLists),
+	}
+}
+
+// FromTaskListTags converts internal TaskListTags type to thrift
+func FromTaskListTags(t *types.TaskListTags) *admin.TaskListTags {
+	if t == nil {
+		return nil
+	}
+	return &admin.TaskListTags{
+		Name:         &t.Name,
+		TaskListType: FromTaskListType(t.TaskListType),
+		Tags:         t.Tags,
+	}
+}
+
+// ToTaskListTags converts thrift TaskListTags type to internal
+func ToTaskListTags(t *admin.TaskListTags) *types.TaskListTags {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListTags{
+		Name:         t.GetName(),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Tags:         t.Tags,
+	}
+}
+
+// FromTaskListTagsArray converts internal TaskListTags array type to thrift
+func FromTaskListTagsArray(t []*types.TaskListTags) []*admin.TaskListTags {
+	if t == nil {
+		return nil
+	}
+	v := make([]*admin.TaskListTags, len(t))
+	for i := range t {
+		v[i] = FromTaskListTags(t[i])
+	}
+	return v
+}
+
+// ToTaskListTagsArray converts thrift TaskListTags array type to internal
+func ToTaskListTagsArray(t []*admin.TaskListTags) []*types.TaskListTags {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.TaskListTags, len(t))
+	for i := range t {
+		v[i] = ToTaskListTags(t[i])
+	}
+	return v
+}
+
+// FromBackfillDomainReplicationRequest converts internal BackfillDomainReplicationRequest type to thrift
+func FromBackfillDomainReplicationRequest(t *types.BackfillDomainReplicationRequest) *admin.BackfillDomainReplicationRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.BackfillDomainReplicationRequest{
+		Domain:              &t.Domain,
+		TargetCluster:       &t.TargetCluster,
+		ClosedWithinSeconds: &t.ClosedWithinSeconds,
+		Rps:                 &t.RPS,
+	}
+}
+
+// ToBackfillDomainReplicationRequest converts thrift BackfillDomainReplicationRequest type to internal
+func ToBackfillDomainReplicationRequest(t *admin.BackfillDomainReplicationRequest) *types.BackfillDomainReplicationRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillDomainReplicationRequest{
+		Domain:              t.GetDomain(),
+		TargetCluster:       t.GetTargetCluster(),
+		ClosedWithinSeconds: t.GetClosedWithinSeconds(),
+		RPS:                 t.GetRps(),
+	}
+}
+
+// FromBackfillDomainReplicationResponse converts internal BackfillDomainReplicationResponse type to thrift
+func FromBackfillDomainReplicationResponse(t *types.BackfillDomainReplicationResponse) *admin.BackfillDomainReplicationResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.BackfillDomainReplicationResponse{
+		JobID: &t.JobID,
+	}
+}
+
+// ToBackfillDomainReplicationResponse converts thrift BackfillDomainReplicationResponse type to internal
+func ToBackfillDomainReplicationResponse(t *admin.BackfillDomainReplicationResponse) *types.BackfillDomainReplicationResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.BackfillDomainReplicationResponse{
+		JobID: t.GetJobID(),
+	}
+}
+
+// FromUpdateDomainAsyncWorkflowConfigRequest converts internal UpdateDomainAsyncWorkflowConfigRequest type to thrift
+func FromUpdateDomainAsyncWorkflowConfigRequest(t *types.UpdateDomainAsyncWorkflowConfigRequest) *admin.UpdateDomainAsyncWorkflowConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain:        &t.Domain,
+		Configuration: FromAsyncWorkflowConfiguration(t.Configuration),
+	}
+}
+
+// ToUpdateDomainAsyncWorkflowConfigRequest converts thrift UpdateDomainAsyncWorkflowConfigRequest type to internal
+func ToUpdateDomainAsyncWorkflowConfigRequest(t *admin.UpdateDomainAsyncWorkflowConfigRequest) *types.UpdateDomainAsyncWorkflowConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain:        t.GetDomain(),
+		Configuration: ToAsyncWorkflowConfiguration(t.Configuration),
+	}
+}
+
+// FromUpdateDomainAsyncWorkflowConfigResponse converts internal UpdateDomainAsyncWorkflowConfigResponse type to thrift
+func FromUpdateDomainAsyncWorkflowConfigResponse(t *types.UpdateDomainAsyncWorkflowConfigResponse) *admin.UpdateDomainAsyncWorkflowConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.UpdateDomainAsyncWorkflowConfigResponse{}
+}
+
+// ToUpdateDomainAsyncWorkflowConfigResponse converts thrift UpdateDomainAsyncWorkflowConfigResponse type to internal
+func ToUpdateDomainAsyncWorkflowConfigResponse(t *admin.UpdateDomainAsyncWorkflowConfigResponse) *types.UpdateDomainAsyncWorkflowConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.UpdateDomainAsyncWorkflowConfigResponse{}
+}
+
+// FromGetDomainAsyncWorkflowConfigRequest converts internal GetDomainAsyncWorkflowConfigRequest type to thrift
+func FromGetDomainAsyncWorkflowConfigRequest(t *types.GetDomainAsyncWorkflowConfigRequest) *admin.GetDomainAsyncWorkflowConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetDomainAsyncWorkflowConfigRequest{
+		Domain: &t.Domain,
+	}
+}
+
+// ToGetDomainAsyncWorkflowConfigRequest converts thrift GetDomainAsyncWorkflowConfigRequest type to internal
+func ToGetDomainAsyncWorkflowConfigRequest(t *admin.GetDomainAsyncWorkflowConfigRequest) *types.GetDomainAsyncWorkflowConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainAsyncWorkflowConfigRequest{
+		Domain: t.GetDomain(),
+	}
+}
+
+// FromGetDomainAsyncWorkflowConfigResponse converts internal GetDomainAsyncWorkflowConfigResponse type to thrift
+func FromGetDomainAsyncWorkflowConfigResponse(t *types.GetDomainAsyncWorkflowConfigResponse) *admin.GetDomainAsyncWorkflowConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetDomainAsyncWorkflowConfigResponse{
+		Configuration: FromAsyncWorkflowConfiguration(t.Configuration),
+	}
+}
+
+// ToGetDomainAsyncWorkflowConfigResponse converts thrift GetDomainAsyncWorkflowConfigResponse type to internal
+func ToGetDomainAsyncWorkflowConfigResponse(t *admin.GetDomainAsyncWorkflowConfigResponse) *types.GetDomainAsyncWorkflowConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetDomainAsyncWorkflowConfigResponse{
+		Configuration: ToAsyncWorkflowConfiguration(t.Configuration),
+	}
+}
+
+// FromReconcileDomainRetentionRequest converts internal ReconcileDomainRetentionRequest type to thrift
+func FromReconcileDomainRetentionRequest(t *types.ReconcileDomainRetentionRequest) *admin.ReconcileDomainRetentionRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ReconcileDomainRetentionRequest{
+		Domain: &t.Domain,
+		Rps:    &t.RPS,
+	}
+}
+
+// ToReconcileDomainRetentionRequest converts thrift ReconcileDomainRetentionRequest type to internal
+func ToReconcileDomainRetentionRequest(t *admin.ReconcileDomainRetentionRequest) *types.ReconcileDomainRetentionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReconcileDomainRetentionRequest{
+		Domain: t.GetDomain(),
+		RPS:    t.GetRps(),
+	}
+}
+
+// FromReconcileDomainRetentionResponse converts internal ReconcileDomainRetentionResponse type to thrift
+func FromReconcileDomainRetentionResponse(t *types.ReconcileDomainRetentionResponse) *admin.ReconcileDomainRetentionResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.ReconcileDomainRetentionResponse{
+		JobID: &t.JobID,
+	}
+}
+
+// ToReconcileDomainRetentionResponse converts thrift ReconcileDomainRetentionResponse type to internal
+func ToReconcileDomainRetentionResponse(t *admin.ReconcileDomainRetentionResponse) *types.ReconcileDomainRetentionResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.ReconcileDomainRetentionResponse{
+		JobID: t.GetJobID(),
+	}
+}
+
+// FromDescribeTaskListConfigRequest converts internal DescribeTaskListConfigRequest type to thrift
+func FromDescribeTaskListConfigRequest(t *types.DescribeTaskListConfigRequest) *admin.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeTaskListConfigRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToDescribeTaskListConfigRequest converts thrift DescribeTaskListConfigRequest type to internal
+func ToDescribeTaskListConfigRequest(t *admin.DescribeTaskListConfigRequest) *types.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListConfigRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromDescribeTaskListConfigResponse converts internal DescribeTaskListConfigResponse type to thrift
+func FromDescribeTaskListConfigResponse(t *types.DescribeTaskListConfigResponse) *admin.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.DescribeTaskListConfigResponse{
+		NumReadPartitions:                   &t.NumReadPartitions,
+		NumWritePartitions:                  &t.NumWritePartitions,
+		ForwarderMaxOutstandingPolls:        &t.ForwarderMaxOutstandingPolls,
+		ForwarderMaxOutstandingTasks:        &t.ForwarderMaxOutstandingTasks,
+		ForwarderMaxRatePerSecond:           &t.ForwarderMaxRatePerSecond,
+		ForwarderMaxChildrenPerNode:         &t.ForwarderMaxChildrenPerNode,
+		EnableSyncMatch:                     &t.EnableSyncMatch,
+		EnableEphemeralTaskList:             &t.EnableEphemeralTaskList,
+		LongPollExpirationIntervalInSeconds: &t.LongPollExpirationIntervalInSeconds,
+		RangeSize:                           &t.RangeSize,
+		GetTasksBatchSize:                   &t.GetTasksBatchSize,
+		UpdateAckIntervalInSeconds:          &t.UpdateAckIntervalInSeconds,
+		IdleTaskListCheckIntervalInSeconds:  &t.IdleTaskListCheckIntervalInSeconds,
+		MaxTaskListIdleTimeInSeconds:        &t.MaxTaskListIdleTimeInSeconds,
+		MinTaskThrottlingBurstSize:          &t.MinTaskThrottlingBurstSize,
+		MaxTaskDeleteBatchSize:              &t.MaxTaskDeleteBatchSize,
+		OutstandingTaskAppendsThreshold:     &t.OutstandingTaskAppendsThreshold,
+		MaxTaskBatchSize:                    &t.MaxTaskBatchSize,
+	}
+}
+
+// ToDescribeTaskListConfigResponse converts thrift DescribeTaskListConfigResponse type to internal
+func ToDescribeTaskListConfigResponse(t *admin.DescribeTaskListConfigResponse) *types.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListConfigResponse{
+		NumReadPartitions:                   t.GetNumReadPartitions(),
+		NumWritePartitions:                  t.GetNumWritePartitions(),
+		ForwarderMaxOutstandingPolls:        t.GetForwarderMaxOutstandingPolls(),
+		ForwarderMaxOutstandingTasks:        t.GetForwarderMaxOutstandingTasks(),
+		ForwarderMaxRatePerSecond:           t.GetForwarderMaxRatePerSecond(),
+		ForwarderMaxChildrenPerNode:         t.GetForwarderMaxChildrenPerNode(),
+		EnableSyncMatch:                     t.GetEnableSyncMatch(),
+		EnableEphemeralTaskList:             t.GetEnableEphemeralTaskList(),
+		LongPollExpirationIntervalInSeconds: t.GetLongPollExpirationIntervalInSeconds(),
+		RangeSize:                           t.GetRangeSize(),
+		GetTasksBatchSize:                   t.GetGetTasksBatchSize(),
+		UpdateAckIntervalInSeconds:          t.GetUpdateAckIntervalInSeconds(),
+		IdleTaskListCheckIntervalInSeconds:  t.GetIdleTaskListCheckIntervalInSeconds(),
+		MaxTaskListIdleTimeInSeconds:        t.GetMaxTaskListIdleTimeInSeconds(),
+		MinTaskThrottlingBurstSize:          t.GetMinTaskThrottlingBurstSize(),
+		MaxTaskDeleteBatchSize:              t.GetMaxTaskDeleteBatchSize(),
+		OutstandingTaskAppendsThreshold:     t.GetOutstandingTaskAppendsThreshold(),
+		MaxTaskBatchSize:                    t.GetMaxTaskBatchSize(),
+	}
+}
+
+// FromGetTaskListUsageRequest converts internal GetTaskListUsageRequest type to thrift
+func FromGetTaskListUsageRequest(t *types.GetTaskListUsageRequest) *admin.GetTaskListUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetTaskListUsageRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToGetTaskListUsageRequest converts thrift GetTaskListUsageRequest type to internal
+func ToGetTaskListUsageRequest(t *admin.GetTaskListUsageRequest) *types.GetTaskListUsageRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListUsageRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromGetTaskListUsageResponse converts internal GetTaskListUsageResponse type to thrift
+func FromGetTaskListUsageResponse(t *types.GetTaskListUsageResponse) *admin.GetTaskListUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.GetTaskListUsageResponse{
+		TaskCount: &t.TaskCount,
+		SizeBytes: &t.SizeBytes,
+		Estimated: &t.Estimated,
+	}
+}
+
+// ToGetTaskListUsageResponse converts thrift GetTaskListUsageResponse type to internal
+func ToGetTaskListUsageResponse(t *admin.GetTaskListUsageResponse) *types.GetTaskListUsageResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListUsageResponse{
+		TaskCount: t.GetTaskCount(),
+		SizeBytes: t.GetSizeBytes(),
+		Estimated: t.GetEstimated(),
+	}
+}
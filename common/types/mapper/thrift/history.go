@@ -1042,6 +1042,28 @@ func ToHistorySignalWorkflowExecutionRequest(t *history.SignalWorkflowExecutionR
 	}
 }
 
+// FromHistorySignalBatchWorkflowExecutionRequest converts internal SignalBatchWorkflowExecutionRequest type to thrift
+func FromHistorySignalBatchWorkflowExecutionRequest(t *types.HistorySignalBatchWorkflowExecutionRequest) *history.SignalBatchWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.SignalBatchWorkflowExecutionRequest{
+		DomainUUID:    &t.DomainUUID,
+		SignalRequest: FromSignalBatchWorkflowExecutionRequest(t.SignalRequest),
+	}
+}
+
+// ToHistorySignalBatchWorkflowExecutionRequest converts thrift SignalBatchWorkflowExecutionRequest type to internal
+func ToHistorySignalBatchWorkflowExecutionRequest(t *history.SignalBatchWorkflowExecutionRequest) *types.HistorySignalBatchWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistorySignalBatchWorkflowExecutionRequest{
+		DomainUUID:    t.GetDomainUUID(),
+		SignalRequest: ToSignalBatchWorkflowExecutionRequest(t.SignalRequest),
+	}
+}
+
 // FromHistoryStartWorkflowExecutionRequest converts internal StartWorkflowExecutionRequest type to thrift
 func FromHistoryStartWorkflowExecutionRequest(t *types.HistoryStartWorkflowExecutionRequest) *history.StartWorkflowExecutionRequest {
 	if t == nil {
@@ -1291,3 +1313,67 @@ func ToGetFailoverInfoResponse(t *history.GetFailoverInfoResponse) *types.GetFai
 		PendingShards:       t.GetPendingShards(),
 	}
 }
+
+// FromHistoryGetDomainReplicationWatermarkRequest converts internal HistoryGetDomainReplicationWatermarkRequest type to thrift
+func FromHistoryGetDomainReplicationWatermarkRequest(t *types.HistoryGetDomainReplicationWatermarkRequest) *history.GetDomainReplicationWatermarkRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.GetDomainReplicationWatermarkRequest{
+		DomainID: &t.DomainID,
+		ShardIDs: t.ShardIDs,
+	}
+}
+
+// ToHistoryGetDomainReplicationWatermarkRequest converts thrift GetDomainReplicationWatermarkRequest type to internal
+func ToHistoryGetDomainReplicationWatermarkRequest(t *history.GetDomainReplicationWatermarkRequest) *types.HistoryGetDomainReplicationWatermarkRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetDomainReplicationWatermarkRequest{
+		DomainID: t.GetDomainID(),
+		ShardIDs: t.ShardIDs,
+	}
+}
+
+// FromHistoryGetDomainReplicationWatermarkResponse converts internal HistoryGetDomainReplicationWatermarkResponse type to thrift
+func FromHistoryGetDomainReplicationWatermarkResponse(t *types.HistoryGetDomainReplicationWatermarkResponse) *history.GetDomainReplicationWatermarkResponse {
+	if t == nil {
+		return nil
+	}
+	return &history.GetDomainReplicationWatermarkResponse{
+		LastReplicatedEventTime: t.LastReplicatedEventTime,
+	}
+}
+
+// ToHistoryGetDomainReplicationWatermarkResponse converts thrift GetDomainReplicationWatermarkResponse type to internal
+func ToHistoryGetDomainReplicationWatermarkResponse(t *history.GetDomainReplicationWatermarkResponse) *types.HistoryGetDomainReplicationWatermarkResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryGetDomainReplicationWatermarkResponse{
+		LastReplicatedEventTime: t.LastReplicatedEventTime,
+	}
+}
+
+// FromReconcileWorkflowRetentionRequest converts internal ReconcileWorkflowRetentionRequest type to thrift
+func FromReconcileWorkflowRetentionRequest(t *types.ReconcileWorkflowRetentionRequest) *history.ReconcileWorkflowRetentionRequest {
+	if t == nil {
+		return nil
+	}
+	return &history.ReconcileWorkflowRetentionRequest{
+		DomainUUID:        &t.DomainUUID,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// ToReconcileWorkflowRetentionRequest converts thrift ReconcileWorkflowRetentionRequest type to internal
+func ToReconcileWorkflowRetentionRequest(t *history.ReconcileWorkflowRetentionRequest) *types.ReconcileWorkflowRetentionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ReconcileWorkflowRetentionRequest{
+		DomainUUID:        t.GetDomainUUID(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+	}
+}
@@ -40,6 +40,11 @@ func FromAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matching.AddAc
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
+		Ephemeral:                     &t.Ephemeral,
+		AffinityKey:                   &t.AffinityKey,
+		Attempt:                       &t.Attempt,
+		ShadowSourceTaskList:          &t.ShadowSourceTaskList,
+		ShadowMetadataOnly:            &t.ShadowMetadataOnly,
 	}
 }
 
@@ -57,6 +62,11 @@ func ToAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types.AddActi
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
+		Ephemeral:                     t.GetEphemeral(),
+		AffinityKey:                   t.GetAffinityKey(),
+		Attempt:                       t.GetAttempt(),
+		ShadowSourceTaskList:          t.GetShadowSourceTaskList(),
+		ShadowMetadataOnly:            t.GetShadowMetadataOnly(),
 	}
 }
 
@@ -73,6 +83,11 @@ func FromAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matching.AddDe
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
+		Ephemeral:                     &t.Ephemeral,
+		AffinityKey:                   &t.AffinityKey,
+		Attempt:                       &t.Attempt,
+		ShadowSourceTaskList:          &t.ShadowSourceTaskList,
+		ShadowMetadataOnly:            &t.ShadowMetadataOnly,
 	}
 }
 
@@ -89,6 +104,11 @@ func ToAddDecisionTaskRequest(t *matching.AddDecisionTaskRequest) *types.AddDeci
 		ScheduleToStartTimeoutSeconds: t.ScheduleToStartTimeoutSeconds,
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
+		Ephemeral:                     t.GetEphemeral(),
+		AffinityKey:                   t.GetAffinityKey(),
+		Attempt:                       t.GetAttempt(),
+		ShadowSourceTaskList:          t.GetShadowSourceTaskList(),
+		ShadowMetadataOnly:            t.GetShadowMetadataOnly(),
 	}
 }
 
@@ -349,3 +369,81 @@ func ToTaskSource(t *matching.TaskSource) *types.TaskSource {
 	}
 	panic("unexpected enum value")
 }
+
+// FromMatchingDescribeTaskListConfigRequest converts internal DescribeTaskListConfigRequest type to thrift
+func FromMatchingDescribeTaskListConfigRequest(t *types.MatchingDescribeTaskListConfigRequest) *matching.DescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &matching.DescribeTaskListConfigRequest{
+		DomainUUID:   &t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToMatchingDescribeTaskListConfigRequest converts thrift DescribeTaskListConfigRequest type to internal
+func ToMatchingDescribeTaskListConfigRequest(t *matching.DescribeTaskListConfigRequest) *types.MatchingDescribeTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID:   t.GetDomainUUID(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromMatchingDescribeTaskListConfigResponse converts internal DescribeTaskListConfigResponse type to thrift
+func FromMatchingDescribeTaskListConfigResponse(t *types.DescribeTaskListConfigResponse) *matching.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &matching.DescribeTaskListConfigResponse{
+		NumReadPartitions:                   &t.NumReadPartitions,
+		NumWritePartitions:                  &t.NumWritePartitions,
+		ForwarderMaxOutstandingPolls:        &t.ForwarderMaxOutstandingPolls,
+		ForwarderMaxOutstandingTasks:        &t.ForwarderMaxOutstandingTasks,
+		ForwarderMaxRatePerSecond:           &t.ForwarderMaxRatePerSecond,
+		ForwarderMaxChildrenPerNode:         &t.ForwarderMaxChildrenPerNode,
+		EnableSyncMatch:                     &t.EnableSyncMatch,
+		EnableEphemeralTaskList:             &t.EnableEphemeralTaskList,
+		LongPollExpirationIntervalInSeconds: &t.LongPollExpirationIntervalInSeconds,
+		RangeSize:                           &t.RangeSize,
+		GetTasksBatchSize:                   &t.GetTasksBatchSize,
+		UpdateAckIntervalInSeconds:          &t.UpdateAckIntervalInSeconds,
+		IdleTaskListCheckIntervalInSeconds:  &t.IdleTaskListCheckIntervalInSeconds,
+		MaxTaskListIdleTimeInSeconds:        &t.MaxTaskListIdleTimeInSeconds,
+		MinTaskThrottlingBurstSize:          &t.MinTaskThrottlingBurstSize,
+		MaxTaskDeleteBatchSize:              &t.MaxTaskDeleteBatchSize,
+		OutstandingTaskAppendsThreshold:     &t.OutstandingTaskAppendsThreshold,
+		MaxTaskBatchSize:                    &t.MaxTaskBatchSize,
+	}
+}
+
+// ToMatchingDescribeTaskListConfigResponse converts thrift DescribeTaskListConfigResponse type to internal
+func ToMatchingDescribeTaskListConfigResponse(t *matching.DescribeTaskListConfigResponse) *types.DescribeTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.DescribeTaskListConfigResponse{
+		NumReadPartitions:                   t.GetNumReadPartitions(),
+		NumWritePartitions:                  t.GetNumWritePartitions(),
+		ForwarderMaxOutstandingPolls:        t.GetForwarderMaxOutstandingPolls(),
+		ForwarderMaxOutstandingTasks:        t.GetForwarderMaxOutstandingTasks(),
+		ForwarderMaxRatePerSecond:           t.GetForwarderMaxRatePerSecond(),
+		ForwarderMaxChildrenPerNode:         t.GetForwarderMaxChildrenPerNode(),
+		EnableSyncMatch:                     t.GetEnableSyncMatch(),
+		EnableEphemeralTaskList:             t.GetEnableEphemeralTaskList(),
+		LongPollExpirationIntervalInSeconds: t.GetLongPollExpirationIntervalInSeconds(),
+		RangeSize:                           t.GetRangeSize(),
+		GetTasksBatchSize:                   t.GetGetTasksBatchSize(),
+		UpdateAckIntervalInSeconds:          t.GetUpdateAckIntervalInSeconds(),
+		IdleTaskListCheckIntervalInSeconds:  t.GetIdleTaskListCheckIntervalInSeconds(),
+		MaxTaskListIdleTimeInSeconds:        t.GetMaxTaskListIdleTimeInSeconds(),
+		MinTaskThrottlingBurstSize:          t.GetMinTaskThrottlingBurstSize(),
+		MaxTaskDeleteBatchSize:              t.GetMaxTaskDeleteBatchSize(),
+		OutstandingTaskAppendsThreshold:     t.GetOutstandingTaskAppendsThreshold(),
+		MaxTaskBatchSize:                    t.GetMaxTaskBatchSize(),
+	}
+}
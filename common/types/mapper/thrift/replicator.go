@@ -26,6 +26,38 @@ import (
 	"github.com/uber/cadence/.gen/go/replicator"
 )
 
+// FromCompressionType converts internal CompressionType type to thrift
+func FromCompressionType(t *types.CompressionType) *replicator.CompressionType {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case types.CompressionTypeSnappy:
+		v := replicator.CompressionTypeSnappy
+		return &v
+	case types.CompressionTypeZstd:
+		v := replicator.CompressionTypeZstd
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
+// ToCompressionType converts thrift CompressionType type to internal
+func ToCompressionType(t *replicator.CompressionType) *types.CompressionType {
+	if t == nil {
+		return nil
+	}
+	switch *t {
+	case replicator.CompressionTypeSnappy:
+		v := types.CompressionTypeSnappy
+		return &v
+	case replicator.CompressionTypeZstd:
+		v := types.CompressionTypeZstd
+		return &v
+	}
+	panic("unexpected enum value")
+}
+
 // FromDLQType converts internal DLQType type to thrift
 func FromDLQType(t *types.DLQType) *replicator.DLQType {
 	if t == nil {
@@ -174,7 +206,8 @@ func FromGetDLQReplicationMessagesRequest(t *types.GetDLQReplicationMessagesRequ
 		return nil
 	}
 	return &replicator.GetDLQReplicationMessagesRequest{
-		TaskInfos: FromReplicationTaskInfoArray(t.TaskInfos),
+		TaskInfos:             FromReplicationTaskInfoArray(t.TaskInfos),
+		SupportedCompressions: FromCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -184,7 +217,8 @@ func ToGetDLQReplicationMessagesRequest(t *replicator.GetDLQReplicationMessagesR
 		return nil
 	}
 	return &types.GetDLQReplicationMessagesRequest{
-		TaskInfos: ToReplicationTaskInfoArray(t.TaskInfos),
+		TaskInfos:             ToReplicationTaskInfoArray(t.TaskInfos),
+		SupportedCompressions: ToCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -258,8 +292,9 @@ func FromGetReplicationMessagesRequest(t *types.GetReplicationMessagesRequest) *
 		return nil
 	}
 	return &replicator.GetReplicationMessagesRequest{
-		Tokens:      FromReplicationTokenArray(t.Tokens),
-		ClusterName: &t.ClusterName,
+		Tokens:                FromReplicationTokenArray(t.Tokens),
+		ClusterName:           &t.ClusterName,
+		SupportedCompressions: FromCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -269,8 +304,9 @@ func ToGetReplicationMessagesRequest(t *replicator.GetReplicationMessagesRequest
 		return nil
 	}
 	return &types.GetReplicationMessagesRequest{
-		Tokens:      ToReplicationTokenArray(t.Tokens),
-		ClusterName: t.GetClusterName(),
+		Tokens:                ToReplicationTokenArray(t.Tokens),
+		ClusterName:           t.GetClusterName(),
+		SupportedCompressions: ToCompressionTypeArray(t.SupportedCompressions),
 	}
 }
 
@@ -307,6 +343,7 @@ func FromHistoryTaskV2Attributes(t *types.HistoryTaskV2Attributes) *replicator.H
 		VersionHistoryItems: FromVersionHistoryItemArray(t.VersionHistoryItems),
 		Events:              FromDataBlob(t.Events),
 		NewRunEvents:        FromDataBlob(t.NewRunEvents),
+		Compression:         FromCompressionType(t.Compression),
 	}
 }
 
@@ -323,6 +360,7 @@ func ToHistoryTaskV2Attributes(t *replicator.HistoryTaskV2Attributes) *types.His
 		VersionHistoryItems: ToVersionHistoryItemArray(t.VersionHistoryItems),
 		Events:              ToDataBlob(t.Events),
 		NewRunEvents:        ToDataBlob(t.NewRunEvents),
+		Compression:         ToCompressionType(t.Compression),
 	}
 }
 
@@ -338,6 +376,7 @@ func FromMergeDLQMessagesRequest(t *types.MergeDLQMessagesRequest) *replicator.M
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
 		MaximumPageSize:       &t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		EndShardID:            &t.EndShardID,
 	}
 }
 
@@ -353,6 +392,7 @@ func ToMergeDLQMessagesRequest(t *replicator.MergeDLQMessagesRequest) *types.Mer
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
 		MaximumPageSize:       t.GetMaximumPageSize(),
 		NextPageToken:         t.NextPageToken,
+		EndShardID:            t.GetEndShardID(),
 	}
 }
 
@@ -386,6 +426,7 @@ func FromPurgeDLQMessagesRequest(t *types.PurgeDLQMessagesRequest) *replicator.P
 		ShardID:               &t.ShardID,
 		SourceCluster:         &t.SourceCluster,
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
+		EndShardID:            &t.EndShardID,
 	}
 }
 
@@ -399,6 +440,7 @@ func ToPurgeDLQMessagesRequest(t *replicator.PurgeDLQMessagesRequest) *types.Pur
 		ShardID:               t.GetShardID(),
 		SourceCluster:         t.GetSourceCluster(),
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
+		EndShardID:            t.GetEndShardID(),
 	}
 }
 
@@ -414,6 +456,7 @@ func FromReadDLQMessagesRequest(t *types.ReadDLQMessagesRequest) *replicator.Rea
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
 		MaximumPageSize:       &t.MaximumPageSize,
 		NextPageToken:         t.NextPageToken,
+		EndShardID:            &t.EndShardID,
 	}
 }
 
@@ -429,6 +472,7 @@ func ToReadDLQMessagesRequest(t *replicator.ReadDLQMessagesRequest) *types.ReadD
 		InclusiveEndMessageID: t.InclusiveEndMessageID,
 		MaximumPageSize:       t.GetMaximumPageSize(),
 		NextPageToken:         t.NextPageToken,
+		EndShardID:            t.GetEndShardID(),
 	}
 }
 
@@ -524,15 +568,16 @@ func FromReplicationTaskInfo(t *types.ReplicationTaskInfo) *replicator.Replicati
 		return nil
 	}
 	return &replicator.ReplicationTaskInfo{
-		DomainID:     &t.DomainID,
-		WorkflowID:   &t.WorkflowID,
-		RunID:        &t.RunID,
-		TaskType:     &t.TaskType,
-		TaskID:       &t.TaskID,
-		Version:      &t.Version,
-		FirstEventID: &t.FirstEventID,
-		NextEventID:  &t.NextEventID,
-		ScheduledID:  &t.ScheduledID,
+		DomainID:       &t.DomainID,
+		WorkflowID:     &t.WorkflowID,
+		RunID:          &t.RunID,
+		TaskType:       &t.TaskType,
+		TaskID:         &t.TaskID,
+		Version:        &t.Version,
+		FirstEventID:   &t.FirstEventID,
+		NextEventID:    &t.NextEventID,
+		ScheduledID:    &t.ScheduledID,
+		ExpirationTime: t.ExpirationTime,
 	}
 }
 
@@ -542,15 +587,16 @@ func ToReplicationTaskInfo(t *replicator.ReplicationTaskInfo) *types.Replication
 		return nil
 	}
 	return &types.ReplicationTaskInfo{
-		DomainID:     t.GetDomainID(),
-		WorkflowID:   t.GetWorkflowID(),
-		RunID:        t.GetRunID(),
-		TaskType:     t.GetTaskType(),
-		TaskID:       t.GetTaskID(),
-		Version:      t.GetVersion(),
-		FirstEventID: t.GetFirstEventID(),
-		NextEventID:  t.GetNextEventID(),
-		ScheduledID:  t.GetScheduledID(),
+		DomainID:       t.GetDomainID(),
+		WorkflowID:     t.GetWorkflowID(),
+		RunID:          t.GetRunID(),
+		TaskType:       t.GetTaskType(),
+		TaskID:         t.GetTaskID(),
+		Version:        t.GetVersion(),
+		FirstEventID:   t.GetFirstEventID(),
+		NextEventID:    t.GetNextEventID(),
+		ScheduledID:    t.GetScheduledID(),
+		ExpirationTime: t.ExpirationTime,
 	}
 }
 
@@ -804,6 +850,30 @@ func ToReplicationTaskArray(t []*replicator.ReplicationTask) []*types.Replicatio
 	return v
 }
 
+// FromCompressionTypeArray converts internal CompressionType type array to thrift
+func FromCompressionTypeArray(t []types.CompressionType) []replicator.CompressionType {
+	if t == nil {
+		return nil
+	}
+	v := make([]replicator.CompressionType, len(t))
+	for i := range t {
+		v[i] = *FromCompressionType(&t[i])
+	}
+	return v
+}
+
+// ToCompressionTypeArray converts thrift CompressionType type array to internal
+func ToCompressionTypeArray(t []replicator.CompressionType) []types.CompressionType {
+	if t == nil {
+		return nil
+	}
+	v := make([]types.CompressionType, len(t))
+	for i := range t {
+		v[i] = *ToCompressionType(&t[i])
+	}
+	return v
+}
+
 // FromReplicationTokenArray converts internal ReplicationToken type array to thrift
 func FromReplicationTokenArray(t []*types.ReplicationToken) []*replicator.ReplicationToken {
 	if t == nil {
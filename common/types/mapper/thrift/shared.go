@@ -928,8 +928,9 @@ func FromCountWorkflowExecutionsRequest(t *types.CountWorkflowExecutionsRequest)
 		return nil
 	}
 	return &shared.CountWorkflowExecutionsRequest{
-		Domain: &t.Domain,
-		Query:  &t.Query,
+		Domain:  &t.Domain,
+		Query:   &t.Query,
+		GroupBy: &t.GroupBy,
 	}
 }
 
@@ -939,8 +940,9 @@ func ToCountWorkflowExecutionsRequest(t *shared.CountWorkflowExecutionsRequest)
 		return nil
 	}
 	return &types.CountWorkflowExecutionsRequest{
-		Domain: t.GetDomain(),
-		Query:  t.GetQuery(),
+		Domain:  t.GetDomain(),
+		Query:   t.GetQuery(),
+		GroupBy: t.GetGroupBy(),
 	}
 }
 
@@ -950,7 +952,8 @@ func FromCountWorkflowExecutionsResponse(t *types.CountWorkflowExecutionsRespons
 		return nil
 	}
 	return &shared.CountWorkflowExecutionsResponse{
-		Count: &t.Count,
+		Count:  &t.Count,
+		Groups: FromCountWorkflowExecutionsGroupArray(t.Groups),
 	}
 }
 
@@ -960,6 +963,29 @@ func ToCountWorkflowExecutionsResponse(t *shared.CountWorkflowExecutionsResponse
 		return nil
 	}
 	return &types.CountWorkflowExecutionsResponse{
+		Count:  t.GetCount(),
+		Groups: ToCountWorkflowExecutionsGroupArray(t.Groups),
+	}
+}
+
+// FromCountWorkflowExecutionsGroup converts internal CountWorkflowExecutionsGroup type to thrift
+func FromCountWorkflowExecutionsGroup(t *types.CountWorkflowExecutionsGroup) *shared.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &shared.CountWorkflowExecutionsGroup{
+		Value: &t.Value,
+		Count: &t.Count,
+	}
+}
+
+// ToCountWorkflowExecutionsGroup converts thrift CountWorkflowExecutionsGroup type to internal
+func ToCountWorkflowExecutionsGroup(t *shared.CountWorkflowExecutionsGroup) *types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	return &types.CountWorkflowExecutionsGroup{
+		Value: t.GetValue(),
 		Count: t.GetCount(),
 	}
 }
@@ -1157,6 +1183,9 @@ func FromDecisionTaskFailedCause(t *types.DecisionTaskFailedCause) *shared.Decis
 	case types.DecisionTaskFailedCauseBadSearchAttributes:
 		v := shared.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case types.DecisionTaskFailedCauseBadTaskListPayloadSize:
+		v := shared.DecisionTaskFailedCauseBadTaskListPayloadSize
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1236,6 +1265,9 @@ func ToDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) *types.Decisio
 	case shared.DecisionTaskFailedCauseBadSearchAttributes:
 		v := types.DecisionTaskFailedCauseBadSearchAttributes
 		return &v
+	case shared.DecisionTaskFailedCauseBadTaskListPayloadSize:
+		v := types.DecisionTaskFailedCauseBadTaskListPayloadSize
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -1498,6 +1530,7 @@ func FromDeprecateDomainRequest(t *types.DeprecateDomainRequest) *shared.Depreca
 	return &shared.DeprecateDomainRequest{
 		Name:          &t.Name,
 		SecurityToken: &t.SecurityToken,
+		UUID:          &t.UUID,
 	}
 }
 
@@ -1509,6 +1542,7 @@ func ToDeprecateDomainRequest(t *shared.DeprecateDomainRequest) *types.Deprecate
 	return &types.DeprecateDomainRequest{
 		Name:          t.GetName(),
 		SecurityToken: t.GetSecurityToken(),
+		UUID:          t.GetUUID(),
 	}
 }
 
@@ -1621,6 +1655,7 @@ func FromDescribeHistoryHostResponse(t *types.DescribeHistoryHostResponse) *shar
 		DomainCache:           FromDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: &t.ShardControllerStatus,
 		Address:               &t.Address,
+		PayloadSizeStats:      FromDomainPayloadSizeStatsArray(t.PayloadSizeStats),
 	}
 }
 
@@ -1646,6 +1681,7 @@ func ToDescribeHistoryHostResponse(t *shared.DescribeHistoryHostResponse) *types
 		DomainCache:           ToDomainCacheInfo(t.DomainCache),
 		ShardControllerStatus: t.GetShardControllerStatus(),
 		Address:               t.GetAddress(),
+		PayloadSizeStats:      ToDomainPayloadSizeStatsArray(t.PayloadSizeStats),
 	}
 }
 
@@ -1704,6 +1740,98 @@ func ToDescribeQueueResponse(t *shared.DescribeQueueResponse) *types.DescribeQue
 	}
 }
 
+// FromGetReplicationAckStatesRequest converts internal GetReplicationAckStatesRequest type to thrift
+func FromGetReplicationAckStatesRequest(t *types.GetReplicationAckStatesRequest) *shared.GetReplicationAckStatesRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetReplicationAckStatesRequest{
+		ShardID: &t.ShardID,
+	}
+}
+
+// ToGetReplicationAckStatesRequest converts thrift GetReplicationAckStatesRequest type to internal
+func ToGetReplicationAckStatesRequest(t *shared.GetReplicationAckStatesRequest) *types.GetReplicationAckStatesRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationAckStatesRequest{
+		ShardID: t.GetShardID(),
+	}
+}
+
+// FromGetReplicationAckStatesResponse converts internal GetReplicationAckStatesResponse type to thrift
+func FromGetReplicationAckStatesResponse(t *types.GetReplicationAckStatesResponse) *shared.GetReplicationAckStatesResponse {
+	if t == nil {
+		return nil
+	}
+	return &shared.GetReplicationAckStatesResponse{
+		AckStates: FromReplicationAckStateArray(t.AckStates),
+	}
+}
+
+// ToGetReplicationAckStatesResponse converts thrift GetReplicationAckStatesResponse type to internal
+func ToGetReplicationAckStatesResponse(t *shared.GetReplicationAckStatesResponse) *types.GetReplicationAckStatesResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetReplicationAckStatesResponse{
+		AckStates: ToReplicationAckStateArray(t.AckStates),
+	}
+}
+
+// FromReplicationAckState converts internal ReplicationAckState type to thrift
+func FromReplicationAckState(t *types.ReplicationAckState) *shared.ReplicationAckState {
+	if t == nil {
+		return nil
+	}
+	return &shared.ReplicationAckState{
+		ClusterName:        &t.ClusterName,
+		AckLevel:           &t.AckLevel,
+		LastAckTime:        t.LastAckTime,
+		PendingTasks:       &t.PendingTasks,
+		PendingTasksCapped: &t.PendingTasksCapped,
+	}
+}
+
+// ToReplicationAckState converts thrift ReplicationAckState type to internal
+func ToReplicationAckState(t *shared.ReplicationAckState) *types.ReplicationAckState {
+	if t == nil {
+		return nil
+	}
+	return &types.ReplicationAckState{
+		ClusterName:        t.GetClusterName(),
+		AckLevel:           t.GetAckLevel(),
+		LastAckTime:        t.LastAckTime,
+		PendingTasks:       t.GetPendingTasks(),
+		PendingTasksCapped: t.GetPendingTasksCapped(),
+	}
+}
+
+// FromReplicationAckStateArray converts internal ReplicationAckState type array to thrift
+func FromReplicationAckStateArray(t []*types.ReplicationAckState) []*shared.ReplicationAckState {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.ReplicationAckState, len(t))
+	for i := range t {
+		v[i] = FromReplicationAckState(t[i])
+	}
+	return v
+}
+
+// ToReplicationAckStateArray converts thrift ReplicationAckState type array to internal
+func ToReplicationAckStateArray(t []*shared.ReplicationAckState) []*types.ReplicationAckState {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.ReplicationAckState, len(t))
+	for i := range t {
+		v[i] = ToReplicationAckState(t[i])
+	}
+	return v
+}
+
 // FromDescribeTaskListRequest converts internal DescribeTaskListRequest type to thrift
 func FromDescribeTaskListRequest(t *types.DescribeTaskListRequest) *shared.DescribeTaskListRequest {
 	if t == nil {
@@ -1785,6 +1913,7 @@ func FromDescribeWorkflowExecutionResponse(t *types.DescribeWorkflowExecutionRes
 		PendingActivities:      FromPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        FromPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        FromPendingDecisionInfo(t.PendingDecision),
+		HistoryLimitWarning:    FromHistoryLimitWarning(t.HistoryLimitWarning),
 	}
 }
 
@@ -1799,6 +1928,7 @@ func ToDescribeWorkflowExecutionResponse(t *shared.DescribeWorkflowExecutionResp
 		PendingActivities:      ToPendingActivityInfoArray(t.PendingActivities),
 		PendingChildren:        ToPendingChildExecutionInfoArray(t.PendingChildren),
 		PendingDecision:        ToPendingDecisionInfo(t.PendingDecision),
+		HistoryLimitWarning:    ToHistoryLimitWarning(t.HistoryLimitWarning),
 	}
 }
 
@@ -1844,6 +1974,60 @@ func ToDomainCacheInfo(t *shared.DomainCacheInfo) *types.DomainCacheInfo {
 	}
 }
 
+// FromDomainPayloadSizeStats converts internal DomainPayloadSizeStats type to thrift
+func FromDomainPayloadSizeStats(t *types.DomainPayloadSizeStats) *shared.DomainPayloadSizeStats {
+	if t == nil {
+		return nil
+	}
+	return &shared.DomainPayloadSizeStats{
+		DomainName:     &t.DomainName,
+		PayloadType:    &t.PayloadType,
+		Count:          &t.Count,
+		AverageSize:    &t.AverageSize,
+		MaxSize:        &t.MaxSize,
+		NearLimitCount: &t.NearLimitCount,
+	}
+}
+
+// ToDomainPayloadSizeStats converts thrift DomainPayloadSizeStats type to internal
+func ToDomainPayloadSizeStats(t *shared.DomainPayloadSizeStats) *types.DomainPayloadSizeStats {
+	if t == nil {
+		return nil
+	}
+	return &types.DomainPayloadSizeStats{
+		DomainName:     t.GetDomainName(),
+		PayloadType:    t.GetPayloadType(),
+		Count:          t.GetCount(),
+		AverageSize:    t.GetAverageSize(),
+		MaxSize:        t.GetMaxSize(),
+		NearLimitCount: t.GetNearLimitCount(),
+	}
+}
+
+// FromDomainPayloadSizeStatsArray converts internal DomainPayloadSizeStats type array to thrift
+func FromDomainPayloadSizeStatsArray(t []*types.DomainPayloadSizeStats) []*shared.DomainPayloadSizeStats {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.DomainPayloadSizeStats, len(t))
+	for i := range t {
+		v[i] = FromDomainPayloadSizeStats(t[i])
+	}
+	return v
+}
+
+// ToDomainPayloadSizeStatsArray converts thrift DomainPayloadSizeStats type array to internal
+func ToDomainPayloadSizeStatsArray(t []*shared.DomainPayloadSizeStats) []*types.DomainPayloadSizeStats {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.DomainPayloadSizeStats, len(t))
+	for i := range t {
+		v[i] = ToDomainPayloadSizeStats(t[i])
+	}
+	return v
+}
+
 // FromDomainConfiguration converts internal DomainConfiguration type to thrift
 func FromDomainConfiguration(t *types.DomainConfiguration) *shared.DomainConfiguration {
 	if t == nil {
@@ -1857,6 +2041,7 @@ func FromDomainConfiguration(t *types.DomainConfiguration) *shared.DomainConfigu
 		HistoryArchivalURI:                     &t.HistoryArchivalURI,
 		VisibilityArchivalStatus:               FromArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  &t.VisibilityArchivalURI,
+		AsyncWorkflowConfiguration:             FromAsyncWorkflowConfiguration(t.AsyncWorkflowConfig),
 	}
 }
 
@@ -1873,6 +2058,33 @@ func ToDomainConfiguration(t *shared.DomainConfiguration) *types.DomainConfigura
 		HistoryArchivalURI:                     t.GetHistoryArchivalURI(),
 		VisibilityArchivalStatus:               ToArchivalStatus(t.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  t.GetVisibilityArchivalURI(),
+		AsyncWorkflowConfig:                    ToAsyncWorkflowConfiguration(t.AsyncWorkflowConfiguration),
+	}
+}
+
+// FromAsyncWorkflowConfiguration converts internal AsyncWorkflowConfiguration type to thrift
+func FromAsyncWorkflowConfiguration(t *types.AsyncWorkflowConfiguration) *shared.AsyncWorkflowConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &shared.AsyncWorkflowConfiguration{
+		Enabled:       &t.Enabled,
+		QueueProvider: &t.QueueProvider,
+		QueueTopic:    &t.QueueTopic,
+		Rps:           &t.RPS,
+	}
+}
+
+// ToAsyncWorkflowConfiguration converts thrift AsyncWorkflowConfiguration type to internal
+func ToAsyncWorkflowConfiguration(t *shared.AsyncWorkflowConfiguration) *types.AsyncWorkflowConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &types.AsyncWorkflowConfiguration{
+		Enabled:       t.GetEnabled(),
+		QueueProvider: t.GetQueueProvider(),
+		QueueTopic:    t.GetQueueTopic(),
+		RPS:           t.GetRps(),
 	}
 }
 
@@ -1888,6 +2100,7 @@ func FromDomainInfo(t *types.DomainInfo) *shared.DomainInfo {
 		OwnerEmail:  &t.OwnerEmail,
 		Data:        t.Data,
 		UUID:        &t.UUID,
+		Metadata:    t.Metadata,
 	}
 }
 
@@ -1903,6 +2116,7 @@ func ToDomainInfo(t *shared.DomainInfo) *types.DomainInfo {
 		OwnerEmail:  t.GetOwnerEmail(),
 		Data:        t.Data,
 		UUID:        t.GetUUID(),
+		Metadata:    t.Metadata,
 	}
 }
 
@@ -1997,6 +2211,9 @@ func FromDomainStatus(t *types.DomainStatus) *shared.DomainStatus {
 	case types.DomainStatusDeleted:
 		v := shared.DomainStatusDeleted
 		return &v
+	case types.DomainStatusProvisioning:
+		v := shared.DomainStatusProvisioning
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2016,6 +2233,9 @@ func ToDomainStatus(t *shared.DomainStatus) *types.DomainStatus {
 	case shared.DomainStatusDeleted:
 		v := types.DomainStatusDeleted
 		return &v
+	case shared.DomainStatusProvisioning:
+		v := types.DomainStatusProvisioning
+		return &v
 	}
 	panic("unexpected enum value")
 }
@@ -2473,6 +2693,7 @@ func FromGetWorkflowExecutionHistoryRequest(t *types.GetWorkflowExecutionHistory
 		WaitForNewEvent:        &t.WaitForNewEvent,
 		HistoryEventFilterType: FromHistoryEventFilterType(t.HistoryEventFilterType),
 		SkipArchival:           &t.SkipArchival,
+		EventTypes:             FromEventTypeArray(t.EventTypes),
 	}
 }
 
@@ -2489,6 +2710,7 @@ func ToGetWorkflowExecutionHistoryRequest(t *shared.GetWorkflowExecutionHistoryR
 		WaitForNewEvent:        t.GetWaitForNewEvent(),
 		HistoryEventFilterType: ToHistoryEventFilterType(t.HistoryEventFilterType),
 		SkipArchival:           t.GetSkipArchival(),
+		EventTypes:             ToEventTypeArray(t.EventTypes),
 	}
 }
 
@@ -2750,6 +2972,36 @@ func ToHistoryEventFilterType(t *shared.HistoryEventFilterType) *types.HistoryEv
 	panic("unexpected enum value")
 }
 
+// FromHistoryLimitWarning converts internal HistoryLimitWarning type to thrift
+func FromHistoryLimitWarning(t *types.HistoryLimitWarning) *shared.HistoryLimitWarning {
+	if t == nil {
+		return nil
+	}
+	return &shared.HistoryLimitWarning{
+		HistoryCount:            &t.HistoryCount,
+		HistorySize:             &t.HistorySize,
+		HistoryCountLimit:       &t.HistoryCountLimit,
+		HistorySizeLimit:        &t.HistorySizeLimit,
+		EventsPerHour:           &t.EventsPerHour,
+		EstimatedSecondsToLimit: t.EstimatedSecondsToLimit,
+	}
+}
+
+// ToHistoryLimitWarning converts thrift HistoryLimitWarning type to internal
+func ToHistoryLimitWarning(t *shared.HistoryLimitWarning) *types.HistoryLimitWarning {
+	if t == nil {
+		return nil
+	}
+	return &types.HistoryLimitWarning{
+		HistoryCount:            t.GetHistoryCount(),
+		HistorySize:             t.GetHistorySize(),
+		HistoryCountLimit:       t.GetHistoryCountLimit(),
+		HistorySizeLimit:        t.GetHistorySizeLimit(),
+		EventsPerHour:           t.GetEventsPerHour(),
+		EstimatedSecondsToLimit: t.EstimatedSecondsToLimit,
+	}
+}
+
 // FromIndexedValueType converts internal IndexedValueType type to thrift
 func FromIndexedValueType(t types.IndexedValueType) shared.IndexedValueType {
 
@@ -2982,6 +3234,7 @@ func FromListDomainsResponse(t *types.ListDomainsResponse) *shared.ListDomainsRe
 	return &shared.ListDomainsResponse{
 		Domains:       FromDescribeDomainResponseArray(t.Domains),
 		NextPageToken: t.NextPageToken,
+		TotalCount:    &t.TotalCount,
 	}
 }
 
@@ -2993,6 +3246,7 @@ func ToListDomainsResponse(t *shared.ListDomainsResponse) *types.ListDomainsResp
 	return &types.ListDomainsResponse{
 		Domains:       ToDescribeDomainResponseArray(t.Domains),
 		NextPageToken: t.NextPageToken,
+		TotalCount:    t.GetTotalCount(),
 	}
 }
 
@@ -4824,7 +5078,8 @@ func FromServiceBusyError(t *types.ServiceBusyError) *shared.ServiceBusyError {
 		return nil
 	}
 	return &shared.ServiceBusyError{
-		Message: t.Message,
+		Message:            t.Message,
+		RetryAfterInMillis: &t.RetryAfterInMillis,
 	}
 }
 
@@ -4834,7 +5089,8 @@ func ToServiceBusyError(t *shared.ServiceBusyError) *types.ServiceBusyError {
 		return nil
 	}
 	return &types.ServiceBusyError{
-		Message: t.Message,
+		Message:            t.Message,
+		RetryAfterInMillis: t.GetRetryAfterInMillis(),
 	}
 }
 
@@ -5042,6 +5298,56 @@ func ToSignalWorkflowExecutionRequest(t *shared.SignalWorkflowExecutionRequest)
 	}
 }
 
+// FromSignalBatchWorkflowExecutionRequest converts internal SignalBatchWorkflowExecutionRequest type to thrift
+func FromSignalBatchWorkflowExecutionRequest(t *types.SignalBatchWorkflowExecutionRequest) *shared.SignalBatchWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalBatchWorkflowExecutionRequest{
+		Domain:            &t.Domain,
+		WorkflowExecution: FromWorkflowExecution(t.WorkflowExecution),
+		Signals:           FromSignalBatchItemArray(t.Signals),
+		Identity:          &t.Identity,
+		RequestId:         &t.RequestID,
+	}
+}
+
+// ToSignalBatchWorkflowExecutionRequest converts thrift SignalBatchWorkflowExecutionRequest type to internal
+func ToSignalBatchWorkflowExecutionRequest(t *shared.SignalBatchWorkflowExecutionRequest) *types.SignalBatchWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalBatchWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToWorkflowExecution(t.WorkflowExecution),
+		Signals:           ToSignalBatchItemArray(t.Signals),
+		Identity:          t.GetIdentity(),
+		RequestID:         t.GetRequestId(),
+	}
+}
+
+// FromSignalBatchItem converts internal SignalBatchItem type to thrift
+func FromSignalBatchItem(t *types.SignalBatchItem) *shared.SignalBatchItem {
+	if t == nil {
+		return nil
+	}
+	return &shared.SignalBatchItem{
+		SignalName: &t.SignalName,
+		Input:      t.Input,
+	}
+}
+
+// ToSignalBatchItem converts thrift SignalBatchItem type to internal
+func ToSignalBatchItem(t *shared.SignalBatchItem) *types.SignalBatchItem {
+	if t == nil {
+		return nil
+	}
+	return &types.SignalBatchItem{
+		SignalName: t.GetSignalName(),
+		Input:      t.Input,
+	}
+}
+
 // FromStartChildWorkflowExecutionDecisionAttributes converts internal StartChildWorkflowExecutionDecisionAttributes type to thrift
 func FromStartChildWorkflowExecutionDecisionAttributes(t *types.StartChildWorkflowExecutionDecisionAttributes) *shared.StartChildWorkflowExecutionDecisionAttributes {
 	if t == nil {
@@ -5454,11 +5760,26 @@ func FromTaskListStatus(t *types.TaskListStatus) *shared.TaskListStatus {
 		return nil
 	}
 	return &shared.TaskListStatus{
-		BacklogCountHint: &t.BacklogCountHint,
-		ReadLevel:        &t.ReadLevel,
-		AckLevel:         &t.AckLevel,
-		RatePerSecond:    &t.RatePerSecond,
-		TaskIDBlock:      FromTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:     &t.BacklogCountHint,
+		ReadLevel:            &t.ReadLevel,
+		AckLevel:             &t.AckLevel,
+		RatePerSecond:        &t.RatePerSecond,
+		TaskIDBlock:          FromTaskIDBlock(t.TaskIDBlock),
+		MatchStats:           FromTaskListMatchStats(t.MatchStats),
+		OutstandingPollCount: &t.OutstandingPollCount,
+	}
+}
+
+// FromTaskListMatchStats converts internal TaskListMatchStats type to thrift
+func FromTaskListMatchStats(t *types.TaskListMatchStats) *shared.TaskListMatchStats {
+	if t == nil {
+		return nil
+	}
+	return &shared.TaskListMatchStats{
+		SyncMatched:     &t.SyncMatched,
+		BufferedMatched: &t.BufferedMatched,
+		Forwarded:       &t.Forwarded,
+		Expired:         &t.Expired,
 	}
 }
 
@@ -5468,11 +5789,26 @@ func ToTaskListStatus(t *shared.TaskListStatus) *types.TaskListStatus {
 		return nil
 	}
 	return &types.TaskListStatus{
-		BacklogCountHint: t.GetBacklogCountHint(),
-		ReadLevel:        t.GetReadLevel(),
-		AckLevel:         t.GetAckLevel(),
-		RatePerSecond:    t.GetRatePerSecond(),
-		TaskIDBlock:      ToTaskIDBlock(t.TaskIDBlock),
+		BacklogCountHint:     t.GetBacklogCountHint(),
+		ReadLevel:            t.GetReadLevel(),
+		AckLevel:             t.GetAckLevel(),
+		RatePerSecond:        t.GetRatePerSecond(),
+		TaskIDBlock:          ToTaskIDBlock(t.TaskIDBlock),
+		MatchStats:           ToTaskListMatchStats(t.MatchStats),
+		OutstandingPollCount: t.GetOutstandingPollCount(),
+	}
+}
+
+// ToTaskListMatchStats converts thrift TaskListMatchStats type to internal
+func ToTaskListMatchStats(t *shared.TaskListMatchStats) *types.TaskListMatchStats {
+	if t == nil {
+		return nil
+	}
+	return &types.TaskListMatchStats{
+		SyncMatched:     t.GetSyncMatched(),
+		BufferedMatched: t.GetBufferedMatched(),
+		Forwarded:       t.GetForwarded(),
+		Expired:         t.GetExpired(),
 	}
 }
 
@@ -5684,12 +6020,14 @@ func FromUpdateDomainRequest(t *types.UpdateDomainRequest) *shared.UpdateDomainR
 		SecurityToken:            &t.SecurityToken,
 		DeleteBadBinary:          t.DeleteBadBinary,
 		FailoverTimeoutInSeconds: t.FailoverTimeoutInSeconds,
+		UUID:                     &t.UUID,
 	}
-	if t.Description != nil || t.OwnerEmail != nil || t.Data != nil {
+	if t.Description != nil || t.OwnerEmail != nil || t.Data != nil || t.Metadata != nil {
 		request.UpdatedInfo = &shared.UpdateDomainInfo{
 			Description: t.Description,
 			OwnerEmail:  t.OwnerEmail,
 			Data:        t.Data,
+			Metadata:    t.Metadata,
 		}
 	}
 	if t.WorkflowExecutionRetentionPeriodInDays != nil ||
@@ -5728,11 +6066,13 @@ func ToUpdateDomainRequest(t *shared.UpdateDomainRequest) *types.UpdateDomainReq
 		SecurityToken:            t.GetSecurityToken(),
 		DeleteBadBinary:          t.DeleteBadBinary,
 		FailoverTimeoutInSeconds: t.FailoverTimeoutInSeconds,
+		UUID:                     t.GetUUID(),
 	}
 	if t.UpdatedInfo != nil {
 		request.Description = t.UpdatedInfo.Description
 		request.OwnerEmail = t.UpdatedInfo.OwnerEmail
 		request.Data = t.UpdatedInfo.Data
+		request.Metadata = t.UpdatedInfo.Metadata
 	}
 	if t.Configuration != nil {
 		request.WorkflowExecutionRetentionPeriodInDays = t.Configuration.WorkflowExecutionRetentionPeriodInDays
@@ -6654,6 +6994,78 @@ func ToDataBlobArray(t []*shared.DataBlob) []*types.DataBlob {
 	return v
 }
 
+// FromEventTypeArray converts internal EventType type array to thrift
+func FromEventTypeArray(t []types.EventType) []shared.EventType {
+	if t == nil {
+		return nil
+	}
+	v := make([]shared.EventType, len(t))
+	for i := range t {
+		v[i] = *FromEventType(&t[i])
+	}
+	return v
+}
+
+// ToEventTypeArray converts thrift EventType type array to internal
+func ToEventTypeArray(t []shared.EventType) []types.EventType {
+	if t == nil {
+		return nil
+	}
+	v := make([]types.EventType, len(t))
+	for i := range t {
+		v[i] = *ToEventType(&t[i])
+	}
+	return v
+}
+
+// FromCountWorkflowExecutionsGroupArray converts internal CountWorkflowExecutionsGroup type array to thrift
+func FromCountWorkflowExecutionsGroupArray(t []*types.CountWorkflowExecutionsGroup) []*shared.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = FromCountWorkflowExecutionsGroup(t[i])
+	}
+	return v
+}
+
+// ToCountWorkflowExecutionsGroupArray converts thrift CountWorkflowExecutionsGroup type array to internal
+func ToCountWorkflowExecutionsGroupArray(t []*shared.CountWorkflowExecutionsGroup) []*types.CountWorkflowExecutionsGroup {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.CountWorkflowExecutionsGroup, len(t))
+	for i := range t {
+		v[i] = ToCountWorkflowExecutionsGroup(t[i])
+	}
+	return v
+}
+
+// FromSignalBatchItemArray converts internal SignalBatchItem type array to thrift
+func FromSignalBatchItemArray(t []*types.SignalBatchItem) []*shared.SignalBatchItem {
+	if t == nil {
+		return nil
+	}
+	v := make([]*shared.SignalBatchItem, len(t))
+	for i := range t {
+		v[i] = FromSignalBatchItem(t[i])
+	}
+	return v
+}
+
+// ToSignalBatchItemArray converts thrift SignalBatchItem type array to internal
+func ToSignalBatchItemArray(t []*shared.SignalBatchItem) []*types.SignalBatchItem {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.SignalBatchItem, len(t))
+	for i := range t {
+		v[i] = ToSignalBatchItem(t[i])
+	}
+	return v
+}
+
 // FromDescribeDomainResponseArray converts internal DescribeDomainResponse type array to thrift
 func FromDescribeDomainResponseArray(t []*types.DescribeDomainResponse) []*shared.DescribeDomainResponse {
 	if t == nil {
@@ -0,0 +1,173 @@
+// Copyright (c) 2022 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package thrifttests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestGetDomainReplicationWatermarkRequest(t *testing.T) {
+	for _, item := range []*types.GetDomainReplicationWatermarkRequest{nil, {}, &testdata.AdminGetDomainReplicationWatermarkRequest} {
+		assert.Equal(t, item, thrift.ToGetDomainReplicationWatermarkRequest(thrift.FromGetDomainReplicationWatermarkRequest(item)))
+	}
+}
+
+func TestGetDomainReplicationWatermarkResponse(t *testing.T) {
+	for _, item := range []*types.GetDomainReplicationWatermarkResponse{nil, {}, &testdata.AdminGetDomainReplicationWatermarkResponse} {
+		assert.Equal(t, item, thrift.ToGetDomainReplicationWatermarkResponse(thrift.FromGetDomainReplicationWatermarkResponse(item)))
+	}
+}
+
+func TestIssueDomainTokenRequest(t *testing.T) {
+	for _, item := range []*types.IssueDomainTokenRequest{nil, {}, &testdata.AdminIssueDomainTokenRequest} {
+		assert.Equal(t, item, thrift.ToIssueDomainTokenRequest(thrift.FromIssueDomainTokenRequest(item)))
+	}
+}
+
+func TestIssueDomainTokenResponse(t *testing.T) {
+	for _, item := range []*types.IssueDomainTokenResponse{nil, {}, &testdata.AdminIssueDomainTokenResponse} {
+		assert.Equal(t, item, thrift.ToIssueDomainTokenResponse(thrift.FromIssueDomainTokenResponse(item)))
+	}
+}
+
+func TestStealTaskListLeaseRequest(t *testing.T) {
+	for _, item := range []*types.StealTaskListLeaseRequest{nil, {}, &testdata.AdminStealTaskListLeaseRequest} {
+		assert.Equal(t, item, thrift.ToStealTaskListLeaseRequest(thrift.FromStealTaskListLeaseRequest(item)))
+	}
+}
+
+func TestStealTaskListLeaseResponse(t *testing.T) {
+	for _, item := range []*types.StealTaskListLeaseResponse{nil, {}, &testdata.AdminStealTaskListLeaseResponse} {
+		assert.Equal(t, item, thrift.ToStealTaskListLeaseResponse(thrift.FromStealTaskListLeaseResponse(item)))
+	}
+}
+
+func TestDescribeTaskListConfigRequest(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListConfigRequest{nil, {}, &testdata.AdminDescribeTaskListConfigRequest} {
+		assert.Equal(t, item, thrift.ToDescribeTaskListConfigRequest(thrift.FromDescribeTaskListConfigRequest(item)))
+	}
+}
+
+func TestDescribeTaskListConfigResponse(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListConfigResponse{nil, {}, &testdata.AdminDescribeTaskListConfigResponse} {
+		assert.Equal(t, item, thrift.ToDescribeTaskListConfigResponse(thrift.FromDescribeTaskListConfigResponse(item)))
+	}
+}
+
+func TestGetTaskListUsageRequest(t *testing.T) {
+	for _, item := range []*types.GetTaskListUsageRequest{nil, {}, &testdata.AdminGetTaskListUsageRequest} {
+		assert.Equal(t, item, thrift.ToGetTaskListUsageRequest(thrift.FromGetTaskListUsageRequest(item)))
+	}
+}
+
+func TestGetTaskListUsageResponse(t *testing.T) {
+	for _, item := range []*types.GetTaskListUsageResponse{nil, {}, &testdata.AdminGetTaskListUsageResponse} {
+		assert.Equal(t, item, thrift.ToGetTaskListUsageResponse(thrift.FromGetTaskListUsageResponse(item)))
+	}
+}
+
+func TestUpdateTaskListTagsRequest(t *testing.T) {
+	for _, item := range []*types.UpdateTaskListTagsRequest{nil, {}, &testdata.AdminUpdateTaskListTagsRequest} {
+		assert.Equal(t, item, thrift.ToUpdateTaskListTagsRequest(thrift.FromUpdateTaskListTagsRequest(item)))
+	}
+}
+
+func TestUpdateTaskListTagsResponse(t *testing.T) {
+	for _, item := range []*types.UpdateTaskListTagsResponse{nil, {}, &testdata.AdminUpdateTaskListTagsResponse} {
+		assert.Equal(t, item, thrift.ToUpdateTaskListTagsResponse(thrift.FromUpdateTaskListTagsResponse(item)))
+	}
+}
+
+func TestListTaskListTagsRequest(t *testing.T) {
+	for _, item := range []*types.ListTaskListTagsRequest{nil, {}, &testdata.AdminListTaskListTagsRequest} {
+		assert.Equal(t, item, thrift.ToListTaskListTagsRequest(thrift.FromListTaskListTagsRequest(item)))
+	}
+}
+
+func TestListTaskListTagsResponse(t *testing.T) {
+	for _, item := range []*types.ListTaskListTagsResponse{nil, {}, &testdata.AdminListTaskListTagsResponse} {
+		assert.Equal(t, item, thrift.ToListTaskListTagsResponse(thrift.FromListTaskListTagsResponse(item)))
+	}
+}
+
+func TestBackfillDomainReplicationRequest(t *testing.T) {
+	for _, item := range []*types.BackfillDomainReplicationRequest{nil, {}, &testdata.AdminBackfillDomainReplicationRequest} {
+		assert.Equal(t, item, thrift.ToBackfillDomainReplicationRequest(thrift.FromBackfillDomainReplicationRequest(item)))
+	}
+}
+
+func TestBackfillDomainReplicationResponse(t *testing.T) {
+	for _, item := range []*types.BackfillDomainReplicationResponse{nil, {}, &testdata.AdminBackfillDomainReplicationResponse} {
+		assert.Equal(t, item, thrift.ToBackfillDomainReplicationResponse(thrift.FromBackfillDomainReplicationResponse(item)))
+	}
+}
+
+func TestReconcileDomainRetentionRequest(t *testing.T) {
+	for _, item := range []*types.ReconcileDomainRetentionRequest{nil, {}, &testdata.AdminReconcileDomainRetentionRequest} {
+		assert.Equal(t, item, thrift.ToReconcileDomainRetentionRequest(thrift.FromReconcileDomainRetentionRequest(item)))
+	}
+}
+
+func TestReconcileDomainRetentionResponse(t *testing.T) {
+	for _, item := range []*types.ReconcileDomainRetentionResponse{nil, {}, &testdata.AdminReconcileDomainRetentionResponse} {
+		assert.Equal(t, item, thrift.ToReconcileDomainRetentionResponse(thrift.FromReconcileDomainRetentionResponse(item)))
+	}
+}
+
+func TestUpdateDomainAsyncWorkflowConfigRequest(t *testing.T) {
+	for _, item := range []*types.UpdateDomainAsyncWorkflowConfigRequest{nil, {}, &testdata.AdminUpdateDomainAsyncWorkflowConfigRequest} {
+		assert.Equal(t, item, thrift.ToUpdateDomainAsyncWorkflowConfigRequest(thrift.FromUpdateDomainAsyncWorkflowConfigRequest(item)))
+	}
+}
+
+func TestUpdateDomainAsyncWorkflowConfigResponse(t *testing.T) {
+	for _, item := range []*types.UpdateDomainAsyncWorkflowConfigResponse{nil, {}} {
+		assert.Equal(t, item, thrift.ToUpdateDomainAsyncWorkflowConfigResponse(thrift.FromUpdateDomainAsyncWorkflowConfigResponse(item)))
+	}
+}
+
+func TestGetDomainAsyncWorkflowConfigRequest(t *testing.T) {
+	for _, item := range []*types.GetDomainAsyncWorkflowConfigRequest{nil, {}, &testdata.AdminGetDomainAsyncWorkflowConfigRequest} {
+		assert.Equal(t, item, thrift.ToGetDomainAsyncWorkflowConfigRequest(thrift.FromGetDomainAsyncWorkflowConfigRequest(item)))
+	}
+}
+
+func TestGetDomainAsyncWorkflowConfigResponse(t *testing.T) {
+	for _, item := range []*types.GetDomainAsyncWorkflowConfigResponse{nil, {}, &testdata.AdminGetDomainAsyncWorkflowConfigResponse} {
+		assert.Equal(t, item, thrift.ToGetDomainAsyncWorkflowConfigResponse(thrift.FromGetDomainAsyncWorkflowConfigResponse(item)))
+	}
+}
+
+func TestReplicationTaskInfo(t *testing.T) {
+	// the expiration time is not part of the public proto yet, so it is not in the shared testdata
+	expiring := testdata.ReplicationTaskInfo
+	expiring.ExpirationTime = common.Int64Ptr(testdata.Timestamp)
+	for _, item := range []*types.ReplicationTaskInfo{nil, {}, &testdata.ReplicationTaskInfo, &expiring} {
+		assert.Equal(t, item, thrift.ToReplicationTaskInfo(thrift.FromReplicationTaskInfo(item)))
+	}
+}
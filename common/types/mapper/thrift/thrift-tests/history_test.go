@@ -35,3 +35,27 @@ func TestHistoryTerminateWorkflowExecutionRequest(t *testing.T) {
 		assert.Equal(t, item, thrift.ToHistoryTerminateWorkflowExecutionRequest(thrift.FromHistoryTerminateWorkflowExecutionRequest(item)))
 	}
 }
+
+func TestHistoryGetDomainReplicationWatermarkRequest(t *testing.T) {
+	for _, item := range []*types.HistoryGetDomainReplicationWatermarkRequest{nil, {}, &testdata.HistoryGetDomainReplicationWatermarkRequest} {
+		assert.Equal(t, item, thrift.ToHistoryGetDomainReplicationWatermarkRequest(thrift.FromHistoryGetDomainReplicationWatermarkRequest(item)))
+	}
+}
+
+func TestHistoryGetDomainReplicationWatermarkResponse(t *testing.T) {
+	for _, item := range []*types.HistoryGetDomainReplicationWatermarkResponse{nil, {}, &testdata.HistoryGetDomainReplicationWatermarkResponse} {
+		assert.Equal(t, item, thrift.ToHistoryGetDomainReplicationWatermarkResponse(thrift.FromHistoryGetDomainReplicationWatermarkResponse(item)))
+	}
+}
+
+func TestReconcileWorkflowRetentionRequest(t *testing.T) {
+	for _, item := range []*types.ReconcileWorkflowRetentionRequest{nil, {}, &testdata.HistoryReconcileWorkflowRetentionRequest} {
+		assert.Equal(t, item, thrift.ToReconcileWorkflowRetentionRequest(thrift.FromReconcileWorkflowRetentionRequest(item)))
+	}
+}
+
+func TestHistorySignalBatchWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.HistorySignalBatchWorkflowExecutionRequest{nil, {}, &testdata.HistorySignalBatchWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToHistorySignalBatchWorkflowExecutionRequest(thrift.FromHistorySignalBatchWorkflowExecutionRequest(item)))
+	}
+}
@@ -48,6 +48,24 @@ func TestRemoveTaskRequest(t *testing.T) {
 	}
 }
 
+func TestGetReplicationAckStatesRequest(t *testing.T) {
+	for _, item := range []*types.GetReplicationAckStatesRequest{nil, {}, &testdata.AdminGetReplicationAckStatesRequest} {
+		assert.Equal(t, item, thrift.ToGetReplicationAckStatesRequest(thrift.FromGetReplicationAckStatesRequest(item)))
+	}
+}
+
+func TestGetReplicationAckStatesResponse(t *testing.T) {
+	for _, item := range []*types.GetReplicationAckStatesResponse{nil, {}, &testdata.AdminGetReplicationAckStatesResponse} {
+		assert.Equal(t, item, thrift.ToGetReplicationAckStatesResponse(thrift.FromGetReplicationAckStatesResponse(item)))
+	}
+}
+
+func TestDescribeHistoryHostResponse(t *testing.T) {
+	for _, item := range []*types.DescribeHistoryHostResponse{nil, {}, &testdata.AdminDescribeHistoryHostResponse} {
+		assert.Equal(t, item, thrift.ToDescribeHistoryHostResponse(thrift.FromDescribeHistoryHostResponse(item)))
+	}
+}
+
 func TestCrossClusterTaskInfo(t *testing.T) {
 	for _, item := range []*types.CrossClusterTaskInfo{nil, {}, &testdata.CrossClusterTaskInfo} {
 		assert.Equal(t, item, thrift.ToCrossClusterTaskInfo(thrift.FromCrossClusterTaskInfo(item)))
@@ -139,6 +157,70 @@ func TestGetTaskListsByDomainResponse(t *testing.T) {
 	}
 }
 
+func TestDescribeWorkflowExecutionResponse(t *testing.T) {
+	for _, item := range []*types.DescribeWorkflowExecutionResponse{nil, {}, &testdata.DescribeWorkflowExecutionResponse, &testdata.HistoryDescribeWorkflowExecutionResponse} {
+		assert.Equal(t, withoutParentDomainAndInitiatedID(item), thrift.ToDescribeWorkflowExecutionResponse(thrift.FromDescribeWorkflowExecutionResponse(item)))
+	}
+}
+
+// withoutParentDomainAndInitiatedID returns a copy of the response as read from thrift,
+// whose WorkflowExecutionInfo has no parent domain name and initiated ID
+func withoutParentDomainAndInitiatedID(response *types.DescribeWorkflowExecutionResponse) *types.DescribeWorkflowExecutionResponse {
+	if response == nil || response.WorkflowExecutionInfo == nil {
+		return response
+	}
+	r, info := *response, *response.WorkflowExecutionInfo
+	info.ParentDomain = nil
+	info.ParentInitiatedID = nil
+	r.WorkflowExecutionInfo = &info
+	return &r
+}
+
+func TestHistoryLimitWarning(t *testing.T) {
+	for _, item := range []*types.HistoryLimitWarning{nil, {}, &testdata.HistoryLimitWarning} {
+		assert.Equal(t, item, thrift.ToHistoryLimitWarning(thrift.FromHistoryLimitWarning(item)))
+	}
+}
+
+func TestGetWorkflowExecutionHistoryRequest(t *testing.T) {
+	// event types are not part of the public proto yet, so they are not in the shared testdata
+	filtered := testdata.GetWorkflowExecutionHistoryRequest
+	filtered.EventTypes = []types.EventType{types.EventTypeActivityTaskCompleted, types.EventTypeWorkflowExecutionSignaled}
+	for _, item := range []*types.GetWorkflowExecutionHistoryRequest{nil, {}, &testdata.GetWorkflowExecutionHistoryRequest, &filtered} {
+		assert.Equal(t, item, thrift.ToGetWorkflowExecutionHistoryRequest(thrift.FromGetWorkflowExecutionHistoryRequest(item)))
+	}
+}
+
+func TestListDomainsResponse(t *testing.T) {
+	// the total count is not part of the public proto yet, so it is not in the shared testdata
+	counted := testdata.ListDomainsResponse
+	counted.TotalCount = 42
+	for _, item := range []*types.ListDomainsResponse{nil, {}, &testdata.ListDomainsResponse, &counted} {
+		assert.Equal(t, item, thrift.ToListDomainsResponse(thrift.FromListDomainsResponse(item)))
+	}
+}
+
+func TestDomainConfiguration(t *testing.T) {
+	// the async workflow configuration is not part of the public proto yet, so it is not in the shared testdata
+	async := testdata.DomainConfiguration
+	async.AsyncWorkflowConfig = &testdata.AsyncWorkflowConfiguration
+	for _, item := range []*types.DomainConfiguration{nil, {}, &testdata.DomainConfiguration, &async} {
+		assert.Equal(t, item, thrift.ToDomainConfiguration(thrift.FromDomainConfiguration(item)))
+	}
+}
+
+func TestEventTypeArray(t *testing.T) {
+	for _, item := range [][]types.EventType{nil, {}, {types.EventTypeDecisionTaskCompleted, types.EventTypeTimerFired}} {
+		assert.Equal(t, item, thrift.ToEventTypeArray(thrift.FromEventTypeArray(item)))
+	}
+}
+
+func TestDescribeTaskListResponse(t *testing.T) {
+	for _, item := range []*types.DescribeTaskListResponse{nil, {}, &testdata.DescribeTaskListResponse, &testdata.MatchingDescribeTaskListResponse} {
+		assert.Equal(t, item, thrift.ToDescribeTaskListResponse(thrift.FromDescribeTaskListResponse(item)))
+	}
+}
+
 func TestDescribeTaskListResponseMap(t *testing.T) {
 	for _, item := range []map[string]*types.DescribeTaskListResponse{nil, {}, testdata.DescribeTaskListResponseMap} {
 		i := thrift.FromDescribeTaskListResponseMap(item)
@@ -207,3 +289,79 @@ func TestCrossClusterApplyParentClosePolicyResponse(t *testing.T) {
 		),
 	)
 }
+
+func TestDomainStatus(t *testing.T) {
+	for _, item := range []*types.DomainStatus{
+		nil,
+		types.DomainStatusRegistered.Ptr(),
+		types.DomainStatusDeprecated.Ptr(),
+		types.DomainStatusDeleted.Ptr(),
+		types.DomainStatusProvisioning.Ptr(),
+	} {
+		assert.Equal(t, item, thrift.ToDomainStatus(thrift.FromDomainStatus(item)))
+	}
+}
+
+func TestTaskListStatus(t *testing.T) {
+	for _, item := range []*types.TaskListStatus{nil, {}, &testdata.TaskListStatus, &testdata.MatchingTaskListStatus} {
+		assert.Equal(t, item, thrift.ToTaskListStatus(thrift.FromTaskListStatus(item)))
+	}
+}
+
+func TestTaskListMatchStats(t *testing.T) {
+	for _, item := range []*types.TaskListMatchStats{nil, {}, &testdata.TaskListMatchStats} {
+		assert.Equal(t, item, thrift.ToTaskListMatchStats(thrift.FromTaskListMatchStats(item)))
+	}
+}
+
+func TestUpdateDomainRequest(t *testing.T) {
+	withUUID := testdata.UpdateDomainRequest
+	withUUID.UUID = testdata.DomainID
+	// metadata is not part of the public proto yet, so it is not in the shared testdata
+	withMetadata := testdata.UpdateDomainRequest
+	withMetadata.Metadata = map[string][]byte{"tier": []byte(`"gold"`), "runbook": []byte("null")}
+	for _, item := range []*types.UpdateDomainRequest{nil, {}, &testdata.UpdateDomainRequest, &withUUID, &withMetadata} {
+		assert.Equal(t, item, thrift.ToUpdateDomainRequest(thrift.FromUpdateDomainRequest(item)))
+	}
+}
+
+func TestCountWorkflowExecutionsRequest(t *testing.T) {
+	// group by is not part of the public proto yet, so it is not in the shared testdata
+	grouped := testdata.CountWorkflowExecutionsRequest
+	grouped.GroupBy = "CloseStatus"
+	for _, item := range []*types.CountWorkflowExecutionsRequest{nil, {}, &testdata.CountWorkflowExecutionsRequest, &grouped} {
+		assert.Equal(t, item, thrift.ToCountWorkflowExecutionsRequest(thrift.FromCountWorkflowExecutionsRequest(item)))
+	}
+}
+
+func TestCountWorkflowExecutionsResponse(t *testing.T) {
+	// groups are not part of the public proto yet, so they are not in the shared testdata
+	grouped := testdata.CountWorkflowExecutionsResponse
+	grouped.Groups = []*types.CountWorkflowExecutionsGroup{{Value: "COMPLETED", Count: 3}, {Value: "", Count: 1}}
+	for _, item := range []*types.CountWorkflowExecutionsResponse{nil, {}, &testdata.CountWorkflowExecutionsResponse, &grouped} {
+		assert.Equal(t, item, thrift.ToCountWorkflowExecutionsResponse(thrift.FromCountWorkflowExecutionsResponse(item)))
+	}
+}
+
+func TestDomainInfo(t *testing.T) {
+	// metadata is not part of the public proto yet, so it is not in the shared testdata
+	withMetadata := testdata.DomainInfo
+	withMetadata.Metadata = map[string][]byte{"tier": []byte(`"gold"`), "owner": []byte(`{"team":"platform"}`)}
+	for _, item := range []*types.DomainInfo{nil, {}, &testdata.DomainInfo, &withMetadata} {
+		assert.Equal(t, item, thrift.ToDomainInfo(thrift.FromDomainInfo(item)))
+	}
+}
+
+func TestDeprecateDomainRequest(t *testing.T) {
+	withUUID := testdata.DeprecateDomainRequest
+	withUUID.UUID = testdata.DomainID
+	for _, item := range []*types.DeprecateDomainRequest{nil, {}, &testdata.DeprecateDomainRequest, &withUUID} {
+		assert.Equal(t, item, thrift.ToDeprecateDomainRequest(thrift.FromDeprecateDomainRequest(item)))
+	}
+}
+
+func TestSignalBatchWorkflowExecutionRequest(t *testing.T) {
+	for _, item := range []*types.SignalBatchWorkflowExecutionRequest{nil, {}, &testdata.SignalBatchWorkflowExecutionRequest} {
+		assert.Equal(t, item, thrift.ToSignalBatchWorkflowExecutionRequest(thrift.FromSignalBatchWorkflowExecutionRequest(item)))
+	}
+}
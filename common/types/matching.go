@@ -36,6 +36,11 @@ type AddActivityTaskRequest struct {
 	ScheduleToStartTimeoutSeconds *int32             `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
+	Ephemeral                     bool               `json:"ephemeral,omitempty"`
+	AffinityKey                   string             `json:"affinityKey,omitempty"`
+	Attempt                       int64              `json:"attempt,omitempty"`
+	ShadowSourceTaskList          string             `json:"shadowSourceTaskList,omitempty"`
+	ShadowMetadataOnly            bool               `json:"shadowMetadataOnly,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -102,6 +107,46 @@ func (v *AddActivityTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetEphemeral is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetEphemeral() (o bool) {
+	if v != nil {
+		return v.Ephemeral
+	}
+	return
+}
+
+// GetAffinityKey is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetAffinityKey() (o string) {
+	if v != nil {
+		return v.AffinityKey
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetAttempt() (o int64) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetShadowSourceTaskList is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetShadowSourceTaskList() (o string) {
+	if v != nil {
+		return v.ShadowSourceTaskList
+	}
+	return
+}
+
+// GetShadowMetadataOnly is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetShadowMetadataOnly() (o bool) {
+	if v != nil {
+		return v.ShadowMetadataOnly
+	}
+	return
+}
+
 // AddDecisionTaskRequest is an internal type (TBD...)
 type AddDecisionTaskRequest struct {
 	DomainUUID                    string             `json:"domainUUID,omitempty"`
@@ -111,6 +156,11 @@ type AddDecisionTaskRequest struct {
 	ScheduleToStartTimeoutSeconds *int32             `json:"scheduleToStartTimeoutSeconds,omitempty"`
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
+	Ephemeral                     bool               `json:"ephemeral,omitempty"`
+	AffinityKey                   string             `json:"affinityKey,omitempty"`
+	Attempt                       int64              `json:"attempt,omitempty"`
+	ShadowSourceTaskList          string             `json:"shadowSourceTaskList,omitempty"`
+	ShadowMetadataOnly            bool               `json:"shadowMetadataOnly,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -169,6 +219,46 @@ func (v *AddDecisionTaskRequest) GetForwardedFrom() (o string) {
 	return
 }
 
+// GetEphemeral is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetEphemeral() (o bool) {
+	if v != nil {
+		return v.Ephemeral
+	}
+	return
+}
+
+// GetAffinityKey is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetAffinityKey() (o string) {
+	if v != nil {
+		return v.AffinityKey
+	}
+	return
+}
+
+// GetAttempt is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetAttempt() (o int64) {
+	if v != nil {
+		return v.Attempt
+	}
+	return
+}
+
+// GetShadowSourceTaskList is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetShadowSourceTaskList() (o string) {
+	if v != nil {
+		return v.ShadowSourceTaskList
+	}
+	return
+}
+
+// GetShadowMetadataOnly is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetShadowMetadataOnly() (o bool) {
+	if v != nil {
+		return v.ShadowMetadataOnly
+	}
+	return
+}
+
 // CancelOutstandingPollRequest is an internal type (TBD...)
 type CancelOutstandingPollRequest struct {
 	DomainUUID   string    `json:"domainUUID,omitempty"`
@@ -231,6 +321,37 @@ func (v *MatchingDescribeTaskListRequest) GetDescRequest() (o *DescribeTaskListR
 	return
 }
 
+// MatchingDescribeTaskListConfigRequest is an internal type (TBD...)
+type MatchingDescribeTaskListConfigRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingDescribeTaskListConfigRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingDescribeTaskListConfigRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingDescribeTaskListConfigRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
 // MatchingListTaskListPartitionsRequest is an internal type (TBD...)
 type MatchingListTaskListPartitionsRequest struct {
 	Domain   string    `json:"domain,omitempty"`
@@ -26,6 +26,57 @@ import (
 	"strings"
 )
 
+// CompressionType is an internal type (TBD...)
+type CompressionType int32
+
+// Ptr is a helper function for getting pointer value
+func (e CompressionType) Ptr() *CompressionType {
+	return &e
+}
+
+// String returns a readable string representation of CompressionType.
+func (e CompressionType) String() string {
+	w := int32(e)
+	switch w {
+	case 0:
+		return "Snappy"
+	case 1:
+		return "Zstd"
+	}
+	return fmt.Sprintf("CompressionType(%d)", w)
+}
+
+// UnmarshalText parses enum value from string representation
+func (e *CompressionType) UnmarshalText(value []byte) error {
+	switch s := strings.ToUpper(string(value)); s {
+	case "SNAPPY":
+		*e = CompressionTypeSnappy
+		return nil
+	case "ZSTD":
+		*e = CompressionTypeZstd
+		return nil
+	default:
+		val, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unknown enum value %q for %q: %v", s, "CompressionType", err)
+		}
+		*e = CompressionType(val)
+		return nil
+	}
+}
+
+// MarshalText encodes CompressionType to text.
+func (e CompressionType) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+const (
+	// CompressionTypeSnappy is an option for CompressionType
+	CompressionTypeSnappy CompressionType = iota
+	// CompressionTypeZstd is an option for CompressionType
+	CompressionTypeZstd
+)
+
 // DLQType is an internal type (TBD...)
 type DLQType int32
 
@@ -250,7 +301,8 @@ func (v *FailoverMarkers) GetFailoverMarkers() (o []*FailoverMarkerAttributes) {
 
 // GetDLQReplicationMessagesRequest is an internal type (TBD...)
 type GetDLQReplicationMessagesRequest struct {
-	TaskInfos []*ReplicationTaskInfo `json:"taskInfos,omitempty"`
+	TaskInfos             []*ReplicationTaskInfo `json:"taskInfos,omitempty"`
+	SupportedCompressions []CompressionType      `json:"supportedCompressions,omitempty"`
 }
 
 // GetTaskInfos is an internal getter (TBD...)
@@ -261,6 +313,14 @@ func (v *GetDLQReplicationMessagesRequest) GetTaskInfos() (o []*ReplicationTaskI
 	return
 }
 
+// GetSupportedCompressions is an internal getter (TBD...)
+func (v *GetDLQReplicationMessagesRequest) GetSupportedCompressions() (o []CompressionType) {
+	if v != nil && v.SupportedCompressions != nil {
+		return v.SupportedCompressions
+	}
+	return
+}
+
 // GetDLQReplicationMessagesResponse is an internal type (TBD...)
 type GetDLQReplicationMessagesResponse struct {
 	ReplicationTasks []*ReplicationTask `json:"replicationTasks,omitempty"`
@@ -320,8 +380,9 @@ func (v *GetDomainReplicationMessagesResponse) GetMessages() (o *ReplicationMess
 
 // GetReplicationMessagesRequest is an internal type (TBD...)
 type GetReplicationMessagesRequest struct {
-	Tokens      []*ReplicationToken `json:"tokens,omitempty"`
-	ClusterName string              `json:"clusterName,omitempty"`
+	Tokens                []*ReplicationToken `json:"tokens,omitempty"`
+	ClusterName           string              `json:"clusterName,omitempty"`
+	SupportedCompressions []CompressionType   `json:"supportedCompressions,omitempty"`
 }
 
 // GetTokens is an internal getter (TBD...)
@@ -340,6 +401,14 @@ func (v *GetReplicationMessagesRequest) GetClusterName() (o string) {
 	return
 }
 
+// GetSupportedCompressions is an internal getter (TBD...)
+func (v *GetReplicationMessagesRequest) GetSupportedCompressions() (o []CompressionType) {
+	if v != nil && v.SupportedCompressions != nil {
+		return v.SupportedCompressions
+	}
+	return
+}
+
 // GetReplicationMessagesResponse is an internal type (TBD...)
 type GetReplicationMessagesResponse struct {
 	MessagesByShard map[int32]*ReplicationMessages `json:"messagesByShard,omitempty"`
@@ -362,6 +431,7 @@ type HistoryTaskV2Attributes struct {
 	VersionHistoryItems []*VersionHistoryItem `json:"versionHistoryItems,omitempty"`
 	Events              *DataBlob             `json:"events,omitempty"`
 	NewRunEvents        *DataBlob             `json:"newRunEvents,omitempty"`
+	Compression         *CompressionType      `json:"compression,omitempty"`
 }
 
 // GetTaskID is an internal getter (TBD...)
@@ -420,6 +490,14 @@ func (v *HistoryTaskV2Attributes) GetNewRunEvents() (o *DataBlob) {
 	return
 }
 
+// GetCompression is an internal getter (TBD...)
+func (v *HistoryTaskV2Attributes) GetCompression() (o CompressionType) {
+	if v != nil && v.Compression != nil {
+		return *v.Compression
+	}
+	return
+}
+
 // MergeDLQMessagesRequest is an internal type (TBD...)
 type MergeDLQMessagesRequest struct {
 	Type                  *DLQType `json:"type,omitempty"`
@@ -428,6 +506,7 @@ type MergeDLQMessagesRequest struct {
 	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
 	MaximumPageSize       int32    `json:"maximumPageSize,omitempty"`
 	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+	EndShardID            int32    `json:"endShardID,omitempty"`
 }
 
 // GetType is an internal getter (TBD...)
@@ -446,6 +525,14 @@ func (v *MergeDLQMessagesRequest) GetShardID() (o int32) {
 	return
 }
 
+// GetEndShardID is an internal getter (TBD...)
+func (v *MergeDLQMessagesRequest) GetEndShardID() (o int32) {
+	if v != nil {
+		return v.EndShardID
+	}
+	return
+}
+
 // GetSourceCluster is an internal getter (TBD...)
 func (v *MergeDLQMessagesRequest) GetSourceCluster() (o string) {
 	if v != nil {
@@ -497,6 +584,7 @@ type PurgeDLQMessagesRequest struct {
 	ShardID               int32    `json:"shardID,omitempty"`
 	SourceCluster         string   `json:"sourceCluster,omitempty"`
 	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
+	EndShardID            int32    `json:"endShardID,omitempty"`
 }
 
 // GetType is an internal getter (TBD...)
@@ -515,6 +603,14 @@ func (v *PurgeDLQMessagesRequest) GetShardID() (o int32) {
 	return
 }
 
+// GetEndShardID is an internal getter (TBD...)
+func (v *PurgeDLQMessagesRequest) GetEndShardID() (o int32) {
+	if v != nil {
+		return v.EndShardID
+	}
+	return
+}
+
 // GetSourceCluster is an internal getter (TBD...)
 func (v *PurgeDLQMessagesRequest) GetSourceCluster() (o string) {
 	if v != nil {
@@ -539,6 +635,7 @@ type ReadDLQMessagesRequest struct {
 	InclusiveEndMessageID *int64   `json:"inclusiveEndMessageID,omitempty"`
 	MaximumPageSize       int32    `json:"maximumPageSize,omitempty"`
 	NextPageToken         []byte   `json:"nextPageToken,omitempty"`
+	EndShardID            int32    `json:"endShardID,omitempty"`
 }
 
 // GetType is an internal getter (TBD...)
@@ -557,6 +654,14 @@ func (v *ReadDLQMessagesRequest) GetShardID() (o int32) {
 	return
 }
 
+// GetEndShardID is an internal getter (TBD...)
+func (v *ReadDLQMessagesRequest) GetEndShardID() (o int32) {
+	if v != nil {
+		return v.EndShardID
+	}
+	return
+}
+
 // GetSourceCluster is an internal getter (TBD...)
 func (v *ReadDLQMessagesRequest) GetSourceCluster() (o string) {
 	if v != nil {
@@ -756,6 +861,9 @@ type ReplicationTaskInfo struct {
 	FirstEventID int64  `json:"firstEventID,omitempty"`
 	NextEventID  int64  `json:"nextEventID,omitempty"`
 	ScheduledID  int64  `json:"scheduledID,omitempty"`
+	// ExpirationTime is when a DLQ message reaches the configured DLQ max age, in unix nanos.
+	// It is only set on messages read from the replication DLQ.
+	ExpirationTime *int64 `json:"expirationTime,omitempty"`
 }
 
 // GetDomainID is an internal getter (TBD...)
@@ -830,6 +938,14 @@ func (v *ReplicationTaskInfo) GetScheduledID() (o int64) {
 	return
 }
 
+// GetExpirationTime is an internal getter (TBD...)
+func (v *ReplicationTaskInfo) GetExpirationTime() (o int64) {
+	if v != nil && v.ExpirationTime != nil {
+		return *v.ExpirationTime
+	}
+	return
+}
+
 // ReplicationTaskType is an internal type (TBD...)
 type ReplicationTaskType int32
 
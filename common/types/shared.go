@@ -1398,8 +1398,9 @@ func (v *ContinueAsNewWorkflowExecutionDecisionAttributes) GetSearchAttributes()
 
 // CountWorkflowExecutionsRequest is an internal type (TBD...)
 type CountWorkflowExecutionsRequest struct {
-	Domain string `json:"domain,omitempty"`
-	Query  string `json:"query,omitempty"`
+	Domain  string `json:"domain,omitempty"`
+	Query   string `json:"query,omitempty"`
+	GroupBy string `json:"groupBy,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -1418,9 +1419,40 @@ func (v *CountWorkflowExecutionsRequest) GetQuery() (o string) {
 	return
 }
 
+// GetGroupBy is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsRequest) GetGroupBy() (o string) {
+	if v != nil {
+		return v.GroupBy
+	}
+	return
+}
+
+// CountWorkflowExecutionsGroup is an internal type (TBD...)
+type CountWorkflowExecutionsGroup struct {
+	Value string `json:"value,omitempty"`
+	Count int64  `json:"count,omitempty"`
+}
+
+// GetValue is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsGroup) GetValue() (o string) {
+	if v != nil {
+		return v.Value
+	}
+	return
+}
+
+// GetCount is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsGroup) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
+
 // CountWorkflowExecutionsResponse is an internal type (TBD...)
 type CountWorkflowExecutionsResponse struct {
-	Count int64 `json:"count,omitempty"`
+	Count  int64                           `json:"count,omitempty"`
+	Groups []*CountWorkflowExecutionsGroup `json:"groups,omitempty"`
 }
 
 // GetCount is an internal getter (TBD...)
@@ -1431,6 +1463,14 @@ func (v *CountWorkflowExecutionsResponse) GetCount() (o int64) {
 	return
 }
 
+// GetGroups is an internal getter (TBD...)
+func (v *CountWorkflowExecutionsResponse) GetGroups() (o []*CountWorkflowExecutionsGroup) {
+	if v != nil && v.Groups != nil {
+		return v.Groups
+	}
+	return
+}
+
 // CurrentBranchChangedError is an internal type (TBD...)
 type CurrentBranchChangedError struct {
 	Message            string `json:"message,required"`
@@ -1712,6 +1752,8 @@ func (e DecisionTaskFailedCause) String() string {
 		return "SCHEDULE_ACTIVITY_DUPLICATE_I_D"
 	case 22:
 		return "BAD_SEARCH_ATTRIBUTES"
+	case 23:
+		return "BAD_TASK_LIST_PAYLOAD_SIZE"
 	}
 	return fmt.Sprintf("DecisionTaskFailedCause(%d)", w)
 }
@@ -1788,6 +1830,9 @@ func (e *DecisionTaskFailedCause) UnmarshalText(value []byte) error {
 	case "BAD_SEARCH_ATTRIBUTES":
 		*e = DecisionTaskFailedCauseBadSearchAttributes
 		return nil
+	case "BAD_TASK_LIST_PAYLOAD_SIZE":
+		*e = DecisionTaskFailedCauseBadTaskListPayloadSize
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -1850,6 +1895,8 @@ const (
 	DecisionTaskFailedCauseScheduleActivityDuplicateID
 	// DecisionTaskFailedCauseBadSearchAttributes is an option for DecisionTaskFailedCause
 	DecisionTaskFailedCauseBadSearchAttributes
+	// DecisionTaskFailedCauseBadTaskListPayloadSize is an option for DecisionTaskFailedCause
+	DecisionTaskFailedCauseBadTaskListPayloadSize
 )
 
 // DecisionTaskFailedEventAttributes is an internal type (TBD...)
@@ -2267,6 +2314,7 @@ const (
 type DeprecateDomainRequest struct {
 	Name          string `json:"name,omitempty"`
 	SecurityToken string `json:"securityToken,omitempty"`
+	UUID          string `json:"uuid,omitempty"`
 }
 
 // GetName is an internal getter (TBD...)
@@ -2277,6 +2325,14 @@ func (v *DeprecateDomainRequest) GetName() (o string) {
 	return
 }
 
+// GetUUID is an internal getter (TBD...)
+func (v *DeprecateDomainRequest) GetUUID() (o string) {
+	if v != nil {
+		return v.UUID
+	}
+	return
+}
+
 // GetSecurityToken is an internal getter (TBD...)
 func (v *DeprecateDomainRequest) GetSecurityToken() (o string) {
 	if v != nil {
@@ -2426,11 +2482,12 @@ func (v *DescribeShardDistributionResponse) GetShards() (o map[int32]string) {
 
 // DescribeHistoryHostResponse is an internal type (TBD...)
 type DescribeHistoryHostResponse struct {
-	NumberOfShards        int32            `json:"numberOfShards,omitempty"`
-	ShardIDs              []int32          `json:"shardIDs,omitempty"`
-	DomainCache           *DomainCacheInfo `json:"domainCache,omitempty"`
-	ShardControllerStatus string           `json:"shardControllerStatus,omitempty"`
-	Address               string           `json:"address,omitempty"`
+	NumberOfShards        int32                     `json:"numberOfShards,omitempty"`
+	ShardIDs              []int32                   `json:"shardIDs,omitempty"`
+	DomainCache           *DomainCacheInfo          `json:"domainCache,omitempty"`
+	ShardControllerStatus string                    `json:"shardControllerStatus,omitempty"`
+	Address               string                    `json:"address,omitempty"`
+	PayloadSizeStats      []*DomainPayloadSizeStats `json:"payloadSizeStats,omitempty"`
 }
 
 // GetNumberOfShards is an internal getter (TBD...)
@@ -2473,6 +2530,14 @@ func (v *DescribeHistoryHostResponse) GetAddress() (o string) {
 	return
 }
 
+// GetPayloadSizeStats is an internal getter (TBD...)
+func (v *DescribeHistoryHostResponse) GetPayloadSizeStats() (o []*DomainPayloadSizeStats) {
+	if v != nil && v.PayloadSizeStats != nil {
+		return v.PayloadSizeStats
+	}
+	return
+}
+
 // DescribeQueueRequest is an internal type (TBD...)
 type DescribeQueueRequest struct {
 	ShardID     int32  `json:"shardID,omitempty"`
@@ -2517,6 +2582,82 @@ func (v *DescribeQueueResponse) GetProcessingQueueStates() (o []string) {
 	return
 }
 
+// GetReplicationAckStatesRequest is an internal type (TBD...)
+type GetReplicationAckStatesRequest struct {
+	ShardID int32 `json:"shardID,omitempty"`
+}
+
+// GetShardID is an internal getter (TBD...)
+func (v *GetReplicationAckStatesRequest) GetShardID() (o int32) {
+	if v != nil {
+		return v.ShardID
+	}
+	return
+}
+
+// GetReplicationAckStatesResponse is an internal type (TBD...)
+type GetReplicationAckStatesResponse struct {
+	AckStates []*ReplicationAckState `json:"ackStates,omitempty"`
+}
+
+// GetAckStates is an internal getter (TBD...)
+func (v *GetReplicationAckStatesResponse) GetAckStates() (o []*ReplicationAckState) {
+	if v != nil && v.AckStates != nil {
+		return v.AckStates
+	}
+	return
+}
+
+// ReplicationAckState is the replication progress of a remote cluster on a shard
+type ReplicationAckState struct {
+	ClusterName string `json:"clusterName,omitempty"`
+	AckLevel    int64  `json:"ackLevel,omitempty"`
+	// LastAckTime is nil when the cluster did not poll since the shard was loaded
+	LastAckTime        *int64 `json:"lastAckTime,omitempty"`
+	PendingTasks       int64  `json:"pendingTasks,omitempty"`
+	PendingTasksCapped bool   `json:"pendingTasksCapped,omitempty"`
+}
+
+// GetClusterName is an internal getter (TBD...)
+func (v *ReplicationAckState) GetClusterName() (o string) {
+	if v != nil {
+		return v.ClusterName
+	}
+	return
+}
+
+// GetAckLevel is an internal getter (TBD...)
+func (v *ReplicationAckState) GetAckLevel() (o int64) {
+	if v != nil {
+		return v.AckLevel
+	}
+	return
+}
+
+// GetLastAckTime is an internal getter (TBD...)
+func (v *ReplicationAckState) GetLastAckTime() (o int64) {
+	if v != nil && v.LastAckTime != nil {
+		return *v.LastAckTime
+	}
+	return
+}
+
+// GetPendingTasks is an internal getter (TBD...)
+func (v *ReplicationAckState) GetPendingTasks() (o int64) {
+	if v != nil {
+		return v.PendingTasks
+	}
+	return
+}
+
+// GetPendingTasksCapped is an internal getter (TBD...)
+func (v *ReplicationAckState) GetPendingTasksCapped() (o bool) {
+	if v != nil {
+		return v.PendingTasksCapped
+	}
+	return
+}
+
 // DescribeTaskListRequest is an internal type (TBD...)
 type DescribeTaskListRequest struct {
 	Domain                string        `json:"domain,omitempty"`
@@ -2608,6 +2749,7 @@ type DescribeWorkflowExecutionResponse struct {
 	PendingActivities      []*PendingActivityInfo          `json:"pendingActivities,omitempty"`
 	PendingChildren        []*PendingChildExecutionInfo    `json:"pendingChildren,omitempty"`
 	PendingDecision        *PendingDecisionInfo            `json:"pendingDecision,omitempty"`
+	HistoryLimitWarning    *HistoryLimitWarning            `json:"historyLimitWarning,omitempty"`
 }
 
 // GetExecutionConfiguration is an internal getter (TBD...)
@@ -2650,6 +2792,14 @@ func (v *DescribeWorkflowExecutionResponse) GetPendingDecision() (o *PendingDeci
 	return
 }
 
+// GetHistoryLimitWarning is an internal getter (TBD...)
+func (v *DescribeWorkflowExecutionResponse) GetHistoryLimitWarning() (o *HistoryLimitWarning) {
+	if v != nil && v.HistoryLimitWarning != nil {
+		return v.HistoryLimitWarning
+	}
+	return
+}
+
 // DomainAlreadyExistsError is an internal type (TBD...)
 type DomainAlreadyExistsError struct {
 	Message string `json:"message,required"`
@@ -2685,15 +2835,74 @@ func (v *DomainCacheInfo) GetNumOfItemsInCacheByName() (o int64) {
 	return
 }
 
+// DomainPayloadSizeStats is an internal type (TBD...)
+type DomainPayloadSizeStats struct {
+	DomainName     string `json:"domainName,omitempty"`
+	PayloadType    string `json:"payloadType,omitempty"`
+	Count          int64  `json:"count,omitempty"`
+	AverageSize    int64  `json:"averageSize,omitempty"`
+	MaxSize        int64  `json:"maxSize,omitempty"`
+	NearLimitCount int64  `json:"nearLimitCount,omitempty"`
+}
+
+// GetDomainName is an internal getter (TBD...)
+func (v *DomainPayloadSizeStats) GetDomainName() (o string) {
+	if v != nil {
+		return v.DomainName
+	}
+	return
+}
+
+// GetPayloadType is an internal getter (TBD...)
+func (v *DomainPayloadSizeStats) GetPayloadType() (o string) {
+	if v != nil {
+		return v.PayloadType
+	}
+	return
+}
+
+// GetCount is an internal getter (TBD...)
+func (v *DomainPayloadSizeStats) GetCount() (o int64) {
+	if v != nil {
+		return v.Count
+	}
+	return
+}
+
+// GetAverageSize is an internal getter (TBD...)
+func (v *DomainPayloadSizeStats) GetAverageSize() (o int64) {
+	if v != nil {
+		return v.AverageSize
+	}
+	return
+}
+
+// GetMaxSize is an internal getter (TBD...)
+func (v *DomainPayloadSizeStats) GetMaxSize() (o int64) {
+	if v != nil {
+		return v.MaxSize
+	}
+	return
+}
+
+// GetNearLimitCount is an internal getter (TBD...)
+func (v *DomainPayloadSizeStats) GetNearLimitCount() (o int64) {
+	if v != nil {
+		return v.NearLimitCount
+	}
+	return
+}
+
 // DomainConfiguration is an internal type (TBD...)
 type DomainConfiguration struct {
-	WorkflowExecutionRetentionPeriodInDays int32           `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
-	EmitMetric                             bool            `json:"emitMetric,omitempty"`
-	BadBinaries                            *BadBinaries    `json:"badBinaries,omitempty"`
-	HistoryArchivalStatus                  *ArchivalStatus `json:"historyArchivalStatus,omitempty"`
-	HistoryArchivalURI                     string          `json:"historyArchivalURI,omitempty"`
-	VisibilityArchivalStatus               *ArchivalStatus `json:"visibilityArchivalStatus,omitempty"`
-	VisibilityArchivalURI                  string          `json:"visibilityArchivalURI,omitempty"`
+	WorkflowExecutionRetentionPeriodInDays int32                       `json:"workflowExecutionRetentionPeriodInDays,omitempty"`
+	EmitMetric                             bool                        `json:"emitMetric,omitempty"`
+	BadBinaries                            *BadBinaries                `json:"badBinaries,omitempty"`
+	HistoryArchivalStatus                  *ArchivalStatus             `json:"historyArchivalStatus,omitempty"`
+	HistoryArchivalURI                     string                      `json:"historyArchivalURI,omitempty"`
+	VisibilityArchivalStatus               *ArchivalStatus             `json:"visibilityArchivalStatus,omitempty"`
+	VisibilityArchivalURI                  string                      `json:"visibilityArchivalURI,omitempty"`
+	AsyncWorkflowConfig                    *AsyncWorkflowConfiguration `json:"asyncWorkflowConfig,omitempty"`
 }
 
 // GetWorkflowExecutionRetentionPeriodInDays is an internal getter (TBD...)
@@ -2752,6 +2961,54 @@ func (v *DomainConfiguration) GetVisibilityArchivalURI() (o string) {
 	return
 }
 
+// GetAsyncWorkflowConfig is an internal getter (TBD...)
+func (v *DomainConfiguration) GetAsyncWorkflowConfig() (o *AsyncWorkflowConfiguration) {
+	if v != nil {
+		return v.AsyncWorkflowConfig
+	}
+	return
+}
+
+// AsyncWorkflowConfiguration is the queue which the asynchronous start and signal requests of a domain are written to
+type AsyncWorkflowConfiguration struct {
+	Enabled       bool   `json:"enabled,omitempty"`
+	QueueProvider string `json:"queueProvider,omitempty"`
+	QueueTopic    string `json:"queueTopic,omitempty"`
+	RPS           int32  `json:"rps,omitempty"`
+}
+
+// GetEnabled is an internal getter (TBD...)
+func (v *AsyncWorkflowConfiguration) GetEnabled() (o bool) {
+	if v != nil {
+		return v.Enabled
+	}
+	return
+}
+
+// GetQueueProvider is an internal getter (TBD...)
+func (v *AsyncWorkflowConfiguration) GetQueueProvider() (o string) {
+	if v != nil {
+		return v.QueueProvider
+	}
+	return
+}
+
+// GetQueueTopic is an internal getter (TBD...)
+func (v *AsyncWorkflowConfiguration) GetQueueTopic() (o string) {
+	if v != nil {
+		return v.QueueTopic
+	}
+	return
+}
+
+// GetRPS is an internal getter (TBD...)
+func (v *AsyncWorkflowConfiguration) GetRPS() (o int32) {
+	if v != nil {
+		return v.RPS
+	}
+	return
+}
+
 // DomainInfo is an internal type (TBD...)
 type DomainInfo struct {
 	Name        string            `json:"name,omitempty"`
@@ -2760,6 +3017,7 @@ type DomainInfo struct {
 	OwnerEmail  string            `json:"ownerEmail,omitempty"`
 	Data        map[string]string `json:"data,omitempty"`
 	UUID        string            `json:"uuid,omitempty"`
+	Metadata    map[string][]byte `json:"metadata,omitempty"`
 }
 
 // GetName is an internal getter (TBD...)
@@ -2810,6 +3068,14 @@ func (v *DomainInfo) GetUUID() (o string) {
 	return
 }
 
+// GetMetadata is an internal getter (TBD...)
+func (v *DomainInfo) GetMetadata() (o map[string][]byte) {
+	if v != nil && v.Metadata != nil {
+		return v.Metadata
+	}
+	return
+}
+
 // DomainNotActiveError is an internal type (TBD...)
 type DomainNotActiveError struct {
 	Message        string `json:"message,required"`
@@ -2890,6 +3156,8 @@ func (e DomainStatus) String() string {
 		return "DEPRECATED"
 	case 2:
 		return "DELETED"
+	case 3:
+		return "PROVISIONING"
 	}
 	return fmt.Sprintf("DomainStatus(%d)", w)
 }
@@ -2906,6 +3174,9 @@ func (e *DomainStatus) UnmarshalText(value []byte) error {
 	case "DELETED":
 		*e = DomainStatusDeleted
 		return nil
+	case "PROVISIONING":
+		*e = DomainStatusProvisioning
+		return nil
 	default:
 		val, err := strconv.ParseInt(s, 10, 32)
 		if err != nil {
@@ -2928,6 +3199,8 @@ const (
 	DomainStatusDeprecated
 	// DomainStatusDeleted is an option for DomainStatus
 	DomainStatusDeleted
+	// DomainStatusProvisioning is an option for DomainStatus
+	DomainStatusProvisioning
 )
 
 // EncodingType is an internal type (TBD...)
@@ -3471,6 +3744,7 @@ type GetWorkflowExecutionHistoryRequest struct {
 	WaitForNewEvent        bool                    `json:"waitForNewEvent,omitempty"`
 	HistoryEventFilterType *HistoryEventFilterType `json:"HistoryEventFilterType,omitempty"`
 	SkipArchival           bool                    `json:"skipArchival,omitempty"`
+	EventTypes             []EventType             `json:"eventTypes,omitempty"`
 }
 
 // GetDomain is an internal getter (TBD...)
@@ -3529,6 +3803,14 @@ func (v *GetWorkflowExecutionHistoryRequest) GetSkipArchival() (o bool) {
 	return
 }
 
+// GetEventTypes is an internal getter (TBD...)
+func (v *GetWorkflowExecutionHistoryRequest) GetEventTypes() (o []EventType) {
+	if v != nil && v.EventTypes != nil {
+		return v.EventTypes
+	}
+	return
+}
+
 // GetWorkflowExecutionHistoryResponse is an internal type (TBD...)
 type GetWorkflowExecutionHistoryResponse struct {
 	History       *History    `json:"history,omitempty"`
@@ -4112,6 +4394,64 @@ const (
 	HistoryEventFilterTypeCloseEvent
 )
 
+// HistoryLimitWarning is an internal type (TBD...)
+type HistoryLimitWarning struct {
+	HistoryCount            int64   `json:"historyCount,omitempty"`
+	HistorySize             int64   `json:"historySize,omitempty"`
+	HistoryCountLimit       int64   `json:"historyCountLimit,omitempty"`
+	HistorySizeLimit        int64   `json:"historySizeLimit,omitempty"`
+	EventsPerHour           float64 `json:"eventsPerHour,omitempty"`
+	EstimatedSecondsToLimit *int64  `json:"estimatedSecondsToLimit,omitempty"`
+}
+
+// GetHistoryCount is an internal getter (TBD...)
+func (v *HistoryLimitWarning) GetHistoryCount() (o int64) {
+	if v != nil {
+		return v.HistoryCount
+	}
+	return
+}
+
+// GetHistorySize is an internal getter (TBD...)
+func (v *HistoryLimitWarning) GetHistorySize() (o int64) {
+	if v != nil {
+		return v.HistorySize
+	}
+	return
+}
+
+// GetHistoryCountLimit is an internal getter (TBD...)
+func (v *HistoryLimitWarning) GetHistoryCountLimit() (o int64) {
+	if v != nil {
+		return v.HistoryCountLimit
+	}
+	return
+}
+
+// GetHistorySizeLimit is an internal getter (TBD...)
+func (v *HistoryLimitWarning) GetHistorySizeLimit() (o int64) {
+	if v != nil {
+		return v.HistorySizeLimit
+	}
+	return
+}
+
+// GetEventsPerHour is an internal getter (TBD...)
+func (v *HistoryLimitWarning) GetEventsPerHour() (o float64) {
+	if v != nil {
+		return v.EventsPerHour
+	}
+	return
+}
+
+// GetEstimatedSecondsToLimit is an internal getter (TBD...)
+func (v *HistoryLimitWarning) GetEstimatedSecondsToLimit() (o int64) {
+	if v != nil && v.EstimatedSecondsToLimit != nil {
+		return *v.EstimatedSecondsToLimit
+	}
+	return
+}
+
 // IndexedValueType is an internal type (TBD...)
 type IndexedValueType int32
 
@@ -4407,6 +4747,7 @@ func (v *ListDomainsRequest) GetNextPageToken() (o []byte) {
 type ListDomainsResponse struct {
 	Domains       []*DescribeDomainResponse `json:"domains,omitempty"`
 	NextPageToken []byte                    `json:"nextPageToken,omitempty"`
+	TotalCount    int64                     `json:"totalCount,omitempty"`
 }
 
 // GetDomains is an internal getter (TBD...)
@@ -4425,6 +4766,14 @@ func (v *ListDomainsResponse) GetNextPageToken() (o []byte) {
 	return
 }
 
+// GetTotalCount is an internal getter (TBD...)
+func (v *ListDomainsResponse) GetTotalCount() (o int64) {
+	if v != nil {
+		return v.TotalCount
+	}
+	return
+}
+
 // ListOpenWorkflowExecutionsRequest is an internal type (TBD...)
 type ListOpenWorkflowExecutionsRequest struct {
 	Domain          string                   `json:"domain,omitempty"`
@@ -7362,7 +7711,8 @@ func (v *SearchAttributes) GetIndexedFields() (o map[string][]byte) {
 
 // ServiceBusyError is an internal type (TBD...)
 type ServiceBusyError struct {
-	Message string `json:"message,required"`
+	Message            string `json:"message,required"`
+	RetryAfterInMillis int64  `json:"retryAfterInMillis,omitempty"`
 }
 
 // GetMessage is an internal getter (TBD...)
@@ -7373,6 +7723,14 @@ func (v *ServiceBusyError) GetMessage() (o string) {
 	return
 }
 
+// GetRetryAfterInMillis is an internal getter (TBD...)
+func (v *ServiceBusyError) GetRetryAfterInMillis() (o int64) {
+	if v != nil {
+		return v.RetryAfterInMillis
+	}
+	return
+}
+
 // SignalExternalWorkflowExecutionDecisionAttributes is an internal type (TBD...)
 type SignalExternalWorkflowExecutionDecisionAttributes struct {
 	Domain            string             `json:"domain,omitempty"`
@@ -7842,6 +8200,77 @@ func (v *SignalWorkflowExecutionRequest) GetControl() (o []byte) {
 	return
 }
 
+// SignalBatchWorkflowExecutionRequest is an internal type (TBD...)
+type SignalBatchWorkflowExecutionRequest struct {
+	Domain            string             `json:"domain,omitempty"`
+	WorkflowExecution *WorkflowExecution `json:"workflowExecution,omitempty"`
+	Signals           []*SignalBatchItem `json:"signals,omitempty"`
+	Identity          string             `json:"identity,omitempty"`
+	RequestID         string             `json:"requestId,omitempty"`
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *SignalBatchWorkflowExecutionRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetWorkflowExecution is an internal getter (TBD...)
+func (v *SignalBatchWorkflowExecutionRequest) GetWorkflowExecution() (o *WorkflowExecution) {
+	if v != nil && v.WorkflowExecution != nil {
+		return v.WorkflowExecution
+	}
+	return
+}
+
+// GetSignals is an internal getter (TBD...)
+func (v *SignalBatchWorkflowExecutionRequest) GetSignals() (o []*SignalBatchItem) {
+	if v != nil && v.Signals != nil {
+		return v.Signals
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *SignalBatchWorkflowExecutionRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// GetRequestID is an internal getter (TBD...)
+func (v *SignalBatchWorkflowExecutionRequest) GetRequestID() (o string) {
+	if v != nil {
+		return v.RequestID
+	}
+	return
+}
+
+// SignalBatchItem is an internal type (TBD...)
+type SignalBatchItem struct {
+	SignalName string `json:"signalName,omitempty"`
+	Input      []byte `json:"input,omitempty"`
+}
+
+// GetSignalName is an internal getter (TBD...)
+func (v *SignalBatchItem) GetSignalName() (o string) {
+	if v != nil {
+		return v.SignalName
+	}
+	return
+}
+
+// GetInput is an internal getter (TBD...)
+func (v *SignalBatchItem) GetInput() (o []byte) {
+	if v != nil && v.Input != nil {
+		return v.Input
+	}
+	return
+}
+
 // StartChildWorkflowExecutionDecisionAttributes is an internal type (TBD...)
 type StartChildWorkflowExecutionDecisionAttributes struct {
 	Domain                              string                 `json:"domain,omitempty"`
@@ -8549,6 +8978,46 @@ const (
 	TaskListKindSticky
 )
 
+// TaskListMatchStats is an internal type (TBD...)
+type TaskListMatchStats struct {
+	SyncMatched     int64 `json:"syncMatched,omitempty"`
+	BufferedMatched int64 `json:"bufferedMatched,omitempty"`
+	Forwarded       int64 `json:"forwarded,omitempty"`
+	Expired         int64 `json:"expired,omitempty"`
+}
+
+// GetSyncMatched is an internal getter (TBD...)
+func (v *TaskListMatchStats) GetSyncMatched() (o int64) {
+	if v != nil {
+		return v.SyncMatched
+	}
+	return
+}
+
+// GetBufferedMatched is an internal getter (TBD...)
+func (v *TaskListMatchStats) GetBufferedMatched() (o int64) {
+	if v != nil {
+		return v.BufferedMatched
+	}
+	return
+}
+
+// GetForwarded is an internal getter (TBD...)
+func (v *TaskListMatchStats) GetForwarded() (o int64) {
+	if v != nil {
+		return v.Forwarded
+	}
+	return
+}
+
+// GetExpired is an internal getter (TBD...)
+func (v *TaskListMatchStats) GetExpired() (o int64) {
+	if v != nil {
+		return v.Expired
+	}
+	return
+}
+
 // TaskListMetadata is an internal type (TBD...)
 type TaskListMetadata struct {
 	MaxTasksPerSecond *float64 `json:"maxTasksPerSecond,omitempty"`
@@ -8586,11 +9055,13 @@ func (v *TaskListPartitionMetadata) GetOwnerHostName() (o string) {
 
 // TaskListStatus is an internal type (TBD...)
 type TaskListStatus struct {
-	BacklogCountHint int64        `json:"backlogCountHint,omitempty"`
-	ReadLevel        int64        `json:"readLevel,omitempty"`
-	AckLevel         int64        `json:"ackLevel,omitempty"`
-	RatePerSecond    float64      `json:"ratePerSecond,omitempty"`
-	TaskIDBlock      *TaskIDBlock `json:"taskIDBlock,omitempty"`
+	BacklogCountHint     int64               `json:"backlogCountHint,omitempty"`
+	ReadLevel            int64               `json:"readLevel,omitempty"`
+	AckLevel             int64               `json:"ackLevel,omitempty"`
+	RatePerSecond        float64             `json:"ratePerSecond,omitempty"`
+	TaskIDBlock          *TaskIDBlock        `json:"taskIDBlock,omitempty"`
+	MatchStats           *TaskListMatchStats `json:"matchStats,omitempty"`
+	OutstandingPollCount int64               `json:"outstandingPollCount,omitempty"`
 }
 
 // GetBacklogCountHint is an internal getter (TBD...)
@@ -8633,6 +9104,22 @@ func (v *TaskListStatus) GetTaskIDBlock() (o *TaskIDBlock) {
 	return
 }
 
+// GetMatchStats is an internal getter (TBD...)
+func (v *TaskListStatus) GetMatchStats() (o *TaskListMatchStats) {
+	if v != nil && v.MatchStats != nil {
+		return v.MatchStats
+	}
+	return
+}
+
+// GetOutstandingPollCount is an internal getter (TBD...)
+func (v *TaskListStatus) GetOutstandingPollCount() (o int64) {
+	if v != nil {
+		return v.OutstandingPollCount
+	}
+	return
+}
+
 // TaskListType is an internal type (TBD...)
 type TaskListType int32
 
@@ -8931,6 +9418,8 @@ type UpdateDomainRequest struct {
 	SecurityToken                          string                             `json:"securityToken,omitempty"`
 	DeleteBadBinary                        *string                            `json:"deleteBadBinary,omitempty"`
 	FailoverTimeoutInSeconds               *int32                             `json:"failoverTimeoutInSeconds,omitempty"`
+	UUID                                   string                             `json:"uuid,omitempty"`
+	Metadata                               map[string][]byte                  `json:"metadata,omitempty"`
 }
 
 // GetName is an internal getter (TBD...)
@@ -8941,6 +9430,14 @@ func (v *UpdateDomainRequest) GetName() (o string) {
 	return
 }
 
+// GetUUID is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetUUID() (o string) {
+	if v != nil {
+		return v.UUID
+	}
+	return
+}
+
 // GetSecurityToken is an internal getter (TBD...)
 func (v *UpdateDomainRequest) GetSecurityToken() (o string) {
 	if v != nil {
@@ -8981,6 +9478,14 @@ func (v *UpdateDomainRequest) GetVisibilityArchivalURI() (o string) {
 	return
 }
 
+// GetMetadata is an internal getter (TBD...)
+func (v *UpdateDomainRequest) GetMetadata() (o map[string][]byte) {
+	if v != nil && v.Metadata != nil {
+		return v.Metadata
+	}
+	return
+}
+
 // UpdateDomainResponse is an internal type (TBD...)
 type UpdateDomainResponse struct {
 	DomainInfo               *DomainInfo                     `json:"domainInfo,omitempty"`
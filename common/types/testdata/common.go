@@ -66,6 +66,8 @@ const (
 	RatePerSecond     = 3.14
 	TaskID            = 444
 	ShardID           = 12345
+	EndShardID        = 12350
+	RangeID           = 13
 	MessageID1        = 50001
 	MessageID2        = 50002
 	EventStoreVersion = 333
@@ -141,6 +143,10 @@ var (
 		Name: TaskListName,
 		Kind: types.TaskListKindNormal.Ptr(),
 	}
+	TaskListTags = map[string]string{
+		"team": "payments",
+		"tier": "1",
+	}
 	RetryPolicy = types.RetryPolicy{
 		InitialIntervalInSeconds:    1,
 		BackoffCoefficient:          1.1,
@@ -228,6 +234,29 @@ var (
 		RatePerSecond:    RatePerSecond,
 		TaskIDBlock:      &TaskIDBlock,
 	}
+	MatchingTaskListStatus = types.TaskListStatus{
+		BacklogCountHint:     BacklogCountHint,
+		ReadLevel:            ReadLevel,
+		AckLevel:             AckLevel,
+		RatePerSecond:        RatePerSecond,
+		TaskIDBlock:          &TaskIDBlock,
+		MatchStats:           &TaskListMatchStats,
+		OutstandingPollCount: 3,
+	}
+	HistoryLimitWarning = types.HistoryLimitWarning{
+		HistoryCount:            1000,
+		HistorySize:             2000,
+		HistoryCountLimit:       3000,
+		HistorySizeLimit:        4000,
+		EventsPerHour:           50.5,
+		EstimatedSecondsToLimit: common.Int64Ptr(6000),
+	}
+	TaskListMatchStats = types.TaskListMatchStats{
+		SyncMatched:     10,
+		BufferedMatched: 20,
+		Forwarded:       30,
+		Expired:         40,
+	}
 	TaskIDBlock = types.TaskIDBlock{
 		StartID: 551,
 		EndID:   559,
@@ -278,6 +307,17 @@ var (
 		NumOfItemsInCacheByID:   int64(2000),
 		NumOfItemsInCacheByName: int64(3000),
 	}
+	DomainPayloadSizeStats = types.DomainPayloadSizeStats{
+		DomainName:     DomainName,
+		PayloadType:    "activity_input",
+		Count:          int64(100),
+		AverageSize:    int64(1024),
+		MaxSize:        int64(1536 * 1024),
+		NearLimitCount: int64(3),
+	}
+	DomainPayloadSizeStatsArray = []*types.DomainPayloadSizeStats{
+		&DomainPayloadSizeStats,
+	}
 	VersionHistoryItem = types.VersionHistoryItem{
 		EventID: EventID1,
 		Version: Version1,
@@ -45,6 +45,10 @@ const (
 
 	DeleteBadBinary = "DeleteBadBinary"
 
+	AsyncWorkflowQueueProvider = "AsyncWorkflowQueueProvider"
+	AsyncWorkflowQueueTopic    = "AsyncWorkflowQueueTopic"
+	AsyncWorkflowRPS           = 100
+
 	FailoverVersion1 = 301
 	FailoverVersion2 = 302
 )
@@ -79,6 +83,12 @@ var (
 		VisibilityArchivalStatus:               &ArchivalStatus,
 		VisibilityArchivalURI:                  VisibilityArchivalURI,
 	}
+	AsyncWorkflowConfiguration = types.AsyncWorkflowConfiguration{
+		Enabled:       true,
+		QueueProvider: AsyncWorkflowQueueProvider,
+		QueueTopic:    AsyncWorkflowQueueTopic,
+		RPS:           AsyncWorkflowRPS,
+	}
 	DomainReplicationConfiguration = types.DomainReplicationConfiguration{
 		ActiveClusterName: ClusterName1,
 		Clusters:          ClusterReplicationConfigurationArray,
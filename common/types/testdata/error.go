@@ -94,7 +94,8 @@ var (
 		EndEventVersion:   common.Int64Ptr(Version2),
 	}
 	ServiceBusyError = types.ServiceBusyError{
-		Message: ErrorMessage,
+		Message:            ErrorMessage,
+		RetryAfterInMillis: int64(Duration1),
 	}
 	ShardOwnershipLostError = types.ShardOwnershipLostError{
 		Message: ErrorMessage,
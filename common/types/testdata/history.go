@@ -576,6 +576,13 @@ var (
 		CompletedShardCount: 0,
 		PendingShards:       []int32{1, 2, 3},
 	}
+	HistoryGetDomainReplicationWatermarkRequest = types.HistoryGetDomainReplicationWatermarkRequest{
+		DomainID: DomainID,
+		ShardIDs: []int32{1, 2, 3},
+	}
+	HistoryGetDomainReplicationWatermarkResponse = types.HistoryGetDomainReplicationWatermarkResponse{
+		LastReplicatedEventTime: &Timestamp1,
+	}
 )
 
 func generateEvent(modifier func(e *types.HistoryEvent)) types.HistoryEvent {
@@ -136,6 +136,7 @@ var (
 		VersionHistoryItems: VersionHistoryItemArray,
 		Events:              &DataBlob,
 		NewRunEvents:        &DataBlob,
+		Compression:         types.CompressionTypeZstd.Ptr(),
 	}
 	FailoverMarkerAttributes = types.FailoverMarkerAttributes{
 		DomainID:        DomainID,
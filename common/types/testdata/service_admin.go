@@ -56,6 +56,7 @@ var (
 		DomainCache:           &DomainCacheInfo,
 		ShardControllerStatus: "ShardControllerStatus",
 		Address:               HostName,
+		PayloadSizeStats:      DomainPayloadSizeStatsArray,
 	}
 	AdminDescribeQueueRequest = types.DescribeQueueRequest{
 		ShardID:     ShardID,
@@ -65,6 +66,26 @@ var (
 	AdminDescribeQueueResponse = types.DescribeQueueResponse{
 		ProcessingQueueStates: []string{"state1", "state2"},
 	}
+	AdminGetReplicationAckStatesRequest = types.GetReplicationAckStatesRequest{
+		ShardID: ShardID,
+	}
+	AdminGetReplicationAckStatesResponse = types.GetReplicationAckStatesResponse{
+		AckStates: []*types.ReplicationAckState{
+			{
+				ClusterName:        ClusterName1,
+				AckLevel:           TaskID,
+				LastAckTime:        &Timestamp1,
+				PendingTasks:       10,
+				PendingTasksCapped: false,
+			},
+			{
+				ClusterName:        ClusterName2,
+				AckLevel:           TaskID,
+				PendingTasks:       10000,
+				PendingTasksCapped: true,
+			},
+		},
+	}
 	AdminDescribeWorkflowExecutionRequest = types.AdminDescribeWorkflowExecutionRequest{
 		Domain:    DomainName,
 		Execution: &WorkflowExecution,
@@ -76,7 +97,8 @@ var (
 		MutableStateInDatabase: "MutableStateInDatabase",
 	}
 	AdminGetDLQReplicationMessagesRequest = types.GetDLQReplicationMessagesRequest{
-		TaskInfos: ReplicationTaskInfoArray,
+		TaskInfos:             ReplicationTaskInfoArray,
+		SupportedCompressions: []types.CompressionType{types.CompressionTypeSnappy, types.CompressionTypeZstd},
 	}
 	AdminGetDLQReplicationMessagesResponse = types.GetDLQReplicationMessagesResponse{
 		ReplicationTasks: ReplicationTaskArray,
@@ -90,8 +112,9 @@ var (
 		Messages: &ReplicationMessages,
 	}
 	AdminGetReplicationMessagesRequest = types.GetReplicationMessagesRequest{
-		Tokens:      ReplicationTokenArray,
-		ClusterName: ClusterName1,
+		Tokens:                ReplicationTokenArray,
+		ClusterName:           ClusterName1,
+		SupportedCompressions: []types.CompressionType{types.CompressionTypeSnappy, types.CompressionTypeZstd},
 	}
 	AdminGetReplicationMessagesResponse = types.GetReplicationMessagesResponse{
 		MessagesByShard: ReplicationMessagesMap,
@@ -118,6 +141,7 @@ var (
 		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
 		MaximumPageSize:       PageSize,
 		NextPageToken:         NextPageToken,
+		EndShardID:            EndShardID,
 	}
 	AdminMergeDLQMessagesResponse = types.MergeDLQMessagesResponse{
 		NextPageToken: NextPageToken,
@@ -127,6 +151,7 @@ var (
 		ShardID:               ShardID,
 		SourceCluster:         ClusterName1,
 		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
+		EndShardID:            EndShardID,
 	}
 	AdminReadDLQMessagesRequest = types.ReadDLQMessagesRequest{
 		Type:                  types.DLQTypeDomain.Ptr(),
@@ -135,6 +160,7 @@ var (
 		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
 		MaximumPageSize:       PageSize,
 		NextPageToken:         NextPageToken,
+		EndShardID:            EndShardID,
 	}
 	AdminReadDLQMessagesResponse = types.ReadDLQMessagesResponse{
 		Type:                 types.DLQTypeDomain.Ptr(),
@@ -177,4 +203,92 @@ var (
 	AdminGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	AdminRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
 	AdminRespondCrossClusterTasksCompletedResponse = RespondCrossClusterTasksCompletedResponse
+	AdminGetDomainReplicationWatermarkRequest      = types.GetDomainReplicationWatermarkRequest{
+		Domain: DomainName,
+	}
+	AdminGetDomainReplicationWatermarkResponse = types.GetDomainReplicationWatermarkResponse{
+		Cluster:                 ClusterName1,
+		LastReplicatedEventTime: &Timestamp1,
+	}
+	AdminIssueDomainTokenRequest = types.IssueDomainTokenRequest{
+		Domain:     DomainName,
+		APIs:       []string{"StartWorkflowExecution", "SignalWorkflowExecution"},
+		TTLSeconds: 3600,
+		Subject:    "Subject",
+	}
+	AdminIssueDomainTokenResponse = types.IssueDomainTokenResponse{
+		Token: "Token",
+	}
+	AdminStealTaskListLeaseRequest = types.StealTaskListLeaseRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}
+	AdminStealTaskListLeaseResponse = types.StealTaskListLeaseResponse{
+		RangeID: RangeID,
+	}
+	AdminDescribeTaskListConfigRequest = types.DescribeTaskListConfigRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}
+	AdminGetTaskListUsageRequest = types.GetTaskListUsageRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}
+	AdminGetTaskListUsageResponse = types.GetTaskListUsageResponse{
+		TaskCount: 10,
+		SizeBytes: 1024,
+		Estimated: true,
+	}
+	AdminUpdateTaskListTagsRequest = types.UpdateTaskListTagsRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		Tags:         TaskListTags,
+	}
+	AdminUpdateTaskListTagsResponse = types.UpdateTaskListTagsResponse{
+		Tags: TaskListTags,
+	}
+	AdminListTaskListTagsRequest = types.ListTaskListTagsRequest{
+		Domain: DomainName,
+		Tags:   TaskListTags,
+	}
+	AdminListTaskListTagsResponse = types.ListTaskListTagsResponse{
+		TaskLists: []*types.TaskListTags{
+			{
+				Name:         TaskListName,
+				TaskListType: types.TaskListTypeActivity.Ptr(),
+				Tags:         TaskListTags,
+			},
+		},
+	}
+	AdminBackfillDomainReplicationRequest = types.BackfillDomainReplicationRequest{
+		Domain:              DomainName,
+		TargetCluster:       ClusterName2,
+		ClosedWithinSeconds: int64(Duration1),
+		RPS:                 Duration2,
+	}
+	AdminBackfillDomainReplicationResponse = types.BackfillDomainReplicationResponse{
+		JobID: WorkflowID,
+	}
+	AdminReconcileDomainRetentionRequest = types.ReconcileDomainRetentionRequest{
+		Domain: DomainName,
+		RPS:    Duration2,
+	}
+	AdminReconcileDomainRetentionResponse = types.ReconcileDomainRetentionResponse{
+		JobID: WorkflowID,
+	}
+	AdminUpdateDomainAsyncWorkflowConfigRequest = types.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain:        DomainName,
+		Configuration: &AsyncWorkflowConfiguration,
+	}
+	AdminGetDomainAsyncWorkflowConfigRequest = types.GetDomainAsyncWorkflowConfigRequest{
+		Domain: DomainName,
+	}
+	AdminGetDomainAsyncWorkflowConfigResponse = types.GetDomainAsyncWorkflowConfigResponse{
+		Configuration: &AsyncWorkflowConfiguration,
+	}
+	AdminDescribeTaskListConfigResponse = MatchingDescribeTaskListConfigResponse
 )
@@ -332,6 +332,16 @@ var (
 		RequestID:         RequestID,
 		Control:           Control,
 	}
+	SignalBatchWorkflowExecutionRequest = types.SignalBatchWorkflowExecutionRequest{
+		Domain:            DomainName,
+		WorkflowExecution: &WorkflowExecution,
+		Signals: []*types.SignalBatchItem{
+			{SignalName: SignalName, Input: Payload1},
+			{SignalName: SignalName, Input: Payload2},
+		},
+		Identity:  Identity,
+		RequestID: RequestID,
+	}
 	SignalWithStartWorkflowExecutionRequest = types.SignalWithStartWorkflowExecutionRequest{
 		Domain:                              DomainName,
 		WorkflowID:                          WorkflowID,
@@ -40,11 +40,20 @@ var (
 	}
 	HistoryDescribeQueueRequest             = AdminDescribeQueueRequest
 	HistoryDescribeQueueResponse            = AdminDescribeQueueResponse
+	HistoryGetReplicationAckStatesRequest   = AdminGetReplicationAckStatesRequest
+	HistoryGetReplicationAckStatesResponse  = AdminGetReplicationAckStatesResponse
 	HistoryDescribeWorkflowExecutionRequest = types.HistoryDescribeWorkflowExecutionRequest{
 		DomainUUID: DomainID,
 		Request:    &DescribeWorkflowExecutionRequest,
 	}
-	HistoryDescribeWorkflowExecutionResponse = DescribeWorkflowExecutionResponse
+	HistoryDescribeWorkflowExecutionResponse = types.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: &WorkflowExecutionConfiguration,
+		WorkflowExecutionInfo:  &WorkflowExecutionInfo,
+		PendingActivities:      PendingActivityInfoArray,
+		PendingChildren:        PendingChildExecutionInfoArray,
+		PendingDecision:        &PendingDecisionInfo,
+		HistoryLimitWarning:    &HistoryLimitWarning,
+	}
 	HistoryGetDLQReplicationMessagesRequest  = AdminGetDLQReplicationMessagesRequest
 	HistoryGetDLQReplicationMessagesResponse = AdminGetDLQReplicationMessagesResponse
 	HistoryGetMutableStateRequest            = types.GetMutableStateRequest{
@@ -75,9 +84,16 @@ var (
 	}
 	HistoryGetReplicationMessagesRequest  = AdminGetReplicationMessagesRequest
 	HistoryGetReplicationMessagesResponse = AdminGetReplicationMessagesResponse
-	HistoryMergeDLQMessagesRequest        = AdminMergeDLQMessagesRequest
-	HistoryMergeDLQMessagesResponse       = AdminMergeDLQMessagesResponse
-	HistoryNotifyFailoverMarkersRequest   = types.NotifyFailoverMarkersRequest{
+	HistoryMergeDLQMessagesRequest        = types.MergeDLQMessagesRequest{
+		Type:                  types.DLQTypeDomain.Ptr(),
+		ShardID:               ShardID,
+		SourceCluster:         ClusterName1,
+		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
+		MaximumPageSize:       PageSize,
+		NextPageToken:         NextPageToken,
+	}
+	HistoryMergeDLQMessagesResponse     = AdminMergeDLQMessagesResponse
+	HistoryNotifyFailoverMarkersRequest = types.NotifyFailoverMarkersRequest{
 		FailoverMarkerTokens: FailoverMarkerTokenArray,
 	}
 	HistoryPollMutableStateRequest = types.PollMutableStateRequest{
@@ -103,15 +119,27 @@ var (
 		WorkflowState:                        common.Int32Ptr(persistence.WorkflowStateCorrupted),
 		WorkflowCloseState:                   common.Int32Ptr(persistence.WorkflowCloseStatusTimedOut),
 	}
-	HistoryPurgeDLQMessagesRequest = AdminPurgeDLQMessagesRequest
-	HistoryQueryWorkflowRequest    = types.HistoryQueryWorkflowRequest{
+	HistoryPurgeDLQMessagesRequest = types.PurgeDLQMessagesRequest{
+		Type:                  types.DLQTypeDomain.Ptr(),
+		ShardID:               ShardID,
+		SourceCluster:         ClusterName1,
+		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
+	}
+	HistoryQueryWorkflowRequest = types.HistoryQueryWorkflowRequest{
 		DomainUUID: DomainID,
 		Request:    &QueryWorkflowRequest,
 	}
 	HistoryQueryWorkflowResponse = types.HistoryQueryWorkflowResponse{
 		Response: &QueryWorkflowResponse,
 	}
-	HistoryReadDLQMessagesRequest  = AdminReadDLQMessagesRequest
+	HistoryReadDLQMessagesRequest = types.ReadDLQMessagesRequest{
+		Type:                  types.DLQTypeDomain.Ptr(),
+		ShardID:               ShardID,
+		SourceCluster:         ClusterName1,
+		InclusiveEndMessageID: common.Int64Ptr(MessageID1),
+		MaximumPageSize:       PageSize,
+		NextPageToken:         NextPageToken,
+	}
 	HistoryReadDLQMessagesResponse = AdminReadDLQMessagesResponse
 	HistoryReapplyEventsRequest    = types.HistoryReapplyEventsRequest{
 		DomainUUID: DomainID,
@@ -179,6 +207,10 @@ var (
 		WorkflowExecution: &WorkflowExecution,
 		RequestID:         RequestID,
 	}
+	HistoryReconcileWorkflowRetentionRequest = types.ReconcileWorkflowRetentionRequest{
+		DomainUUID:        DomainID,
+		WorkflowExecution: &WorkflowExecution,
+	}
 	HistoryRemoveTaskRequest        = AdminRemoveTaskRequest
 	HistoryReplicateEventsV2Request = types.ReplicateEventsV2Request{
 		DomainUUID:          DomainID,
@@ -248,6 +280,10 @@ var (
 		ExternalWorkflowExecution: &WorkflowExecution,
 		ChildWorkflowOnly:         true,
 	}
+	HistorySignalBatchWorkflowExecutionRequest = types.HistorySignalBatchWorkflowExecutionRequest{
+		DomainUUID:    DomainID,
+		SignalRequest: &SignalBatchWorkflowExecutionRequest,
+	}
 	HistoryStartWorkflowExecutionRequest = types.HistoryStartWorkflowExecutionRequest{
 		DomainUUID:                      DomainID,
 		StartRequest:                    &StartWorkflowExecutionRequest,
@@ -28,6 +28,7 @@ import (
 const (
 	ForwardedFrom = "ForwardedFrom"
 	PollerID      = "PollerID"
+	AffinityKey   = "AffinityKey"
 )
 
 var (
@@ -40,6 +41,11 @@ var (
 		ScheduleToStartTimeoutSeconds: &Duration1,
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
+		Ephemeral:                     true,
+		AffinityKey:                   AffinityKey,
+		Attempt:                       Attempt,
+		ShadowSourceTaskList:          TaskListName,
+		ShadowMetadataOnly:            true,
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -49,6 +55,11 @@ var (
 		ScheduleToStartTimeoutSeconds: &Duration1,
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
+		Ephemeral:                     true,
+		AffinityKey:                   AffinityKey,
+		Attempt:                       Attempt,
+		ShadowSourceTaskList:          TaskListName,
+		ShadowMetadataOnly:            true,
 	}
 	MatchingCancelOutstandingPollRequest = types.CancelOutstandingPollRequest{
 		DomainUUID:   DomainID,
@@ -62,7 +73,32 @@ var (
 	}
 	MatchingDescribeTaskListResponse = types.DescribeTaskListResponse{
 		Pollers:        PollerInfoArray,
-		TaskListStatus: &TaskListStatus,
+		TaskListStatus: &MatchingTaskListStatus,
+	}
+	MatchingDescribeTaskListConfigRequest = types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}
+	MatchingDescribeTaskListConfigResponse = types.DescribeTaskListConfigResponse{
+		NumReadPartitions:                   2,
+		NumWritePartitions:                  2,
+		ForwarderMaxOutstandingPolls:        1,
+		ForwarderMaxOutstandingTasks:        1,
+		ForwarderMaxRatePerSecond:           10,
+		ForwarderMaxChildrenPerNode:         20,
+		EnableSyncMatch:                     true,
+		EnableEphemeralTaskList:             true,
+		LongPollExpirationIntervalInSeconds: 60,
+		RangeSize:                           100000,
+		GetTasksBatchSize:                   1000,
+		UpdateAckIntervalInSeconds:          60,
+		IdleTaskListCheckIntervalInSeconds:  300,
+		MaxTaskListIdleTimeInSeconds:        300,
+		MinTaskThrottlingBurstSize:          1,
+		MaxTaskDeleteBatchSize:              100,
+		OutstandingTaskAppendsThreshold:     250,
+		MaxTaskBatchSize:                    100,
 	}
 	MatchingListTaskListPartitionsRequest = types.MatchingListTaskListPartitionsRequest{
 		Domain:   DomainName,
@@ -146,5 +182,5 @@ var (
 		ActivityTaskListMap: DescribeTaskListResponseMap,
 	}
 
-	DescribeTaskListResponseMap = map[string]*types.DescribeTaskListResponse{DomainName: &MatchingDescribeTaskListResponse}
+	DescribeTaskListResponseMap = map[string]*types.DescribeTaskListResponse{DomainName: &DescribeTaskListResponse}
 )
@@ -291,6 +291,15 @@ func IsEntityNotExistsError(err error) bool {
 	return ok
 }
 
+// NewServiceBusyError returns the error of a throttled request, with a hint on when it can be retried.
+// The hint is rounded up to milliseconds, so that clients don't retry before the request can be allowed.
+func NewServiceBusyError(message string, retryAfter time.Duration) *types.ServiceBusyError {
+	return &types.ServiceBusyError{
+		Message:            message,
+		RetryAfterInMillis: int64((retryAfter + time.Millisecond - 1) / time.Millisecond),
+	}
+}
+
 // IsServiceBusyError checks if the error is a service busy error.
 func IsServiceBusyError(err error) bool {
 	switch err.(type) {
@@ -150,6 +150,22 @@ func (a *AccessControlledWorkflowAdminHandler) DescribeQueue(ctx context.Context
 	return a.AdminHandler.DescribeQueue(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) GetReplicationAckStates(ctx context.Context, request *types.GetReplicationAckStatesRequest) (*types.GetReplicationAckStatesResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "GetReplicationAckStates",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.GetReplicationAckStates(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) DescribeWorkflowExecution(ctx context.Context, request *types.AdminDescribeWorkflowExecutionRequest) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	attr := &authorization.Attributes{
 		APIName:    "DescribeWorkflowExecution",
@@ -390,6 +406,22 @@ func (a *AccessControlledWorkflowAdminHandler) GetDynamicConfig(ctx context.Cont
 	return a.AdminHandler.GetDynamicConfig(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) GetDomainReplicationWatermark(ctx context.Context, request *types.GetDomainReplicationWatermarkRequest) (*types.GetDomainReplicationWatermarkResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "GetDomainReplicationWatermark",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.GetDomainReplicationWatermark(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) UpdateDynamicConfig(ctx context.Context, request *types.UpdateDynamicConfigRequest) error {
 	attr := &authorization.Attributes{
 		APIName:    "UpdateDynamicConfig",
@@ -470,6 +502,166 @@ func (a *AccessControlledWorkflowAdminHandler) ListDynamicConfig(ctx context.Con
 	return a.AdminHandler.ListDynamicConfig(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) DescribeTaskListConfig(ctx context.Context, request *types.DescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "DescribeTaskListConfig",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.DescribeTaskListConfig(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) GetTaskListUsage(ctx context.Context, request *types.GetTaskListUsageRequest) (*types.GetTaskListUsageResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "GetTaskListUsage",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.GetTaskListUsage(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) IssueDomainToken(ctx context.Context, request *types.IssueDomainTokenRequest) (*types.IssueDomainTokenResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "IssueDomainToken",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.IssueDomainToken(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) StealTaskListLease(ctx context.Context, request *types.StealTaskListLeaseRequest) (*types.StealTaskListLeaseResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "StealTaskListLease",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.StealTaskListLease(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) UpdateTaskListTags(ctx context.Context, request *types.UpdateTaskListTagsRequest) (*types.UpdateTaskListTagsResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "UpdateTaskListTags",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.UpdateTaskListTags(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ListTaskListTags(ctx context.Context, request *types.ListTaskListTagsRequest) (*types.ListTaskListTagsResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "ListTaskListTags",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ListTaskListTags(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) BackfillDomainReplication(ctx context.Context, request *types.BackfillDomainReplicationRequest) (*types.BackfillDomainReplicationResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "BackfillDomainReplication",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.BackfillDomainReplication(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ReconcileDomainRetention(ctx context.Context, request *types.ReconcileDomainRetentionRequest) (*types.ReconcileDomainRetentionResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "ReconcileDomainRetention",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.ReconcileDomainRetention(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) UpdateDomainAsyncWorkflowConfig(ctx context.Context, request *types.UpdateDomainAsyncWorkflowConfigRequest) (*types.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "UpdateDomainAsyncWorkflowConfig",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.UpdateDomainAsyncWorkflowConfig(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) GetDomainAsyncWorkflowConfig(ctx context.Context, request *types.GetDomainAsyncWorkflowConfigRequest) (*types.GetDomainAsyncWorkflowConfigResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:    "GetDomainAsyncWorkflowConfig",
+		Permission: authorization.PermissionAdmin,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.GetDomainAsyncWorkflowConfig(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
@@ -672,6 +672,30 @@ func (a *AccessControlledWorkflowHandler) SignalWorkflowExecution(
 	return a.frontendHandler.SignalWorkflowExecution(ctx, request)
 }
 
+// SignalBatchWorkflowExecution API call
+func (a *AccessControlledWorkflowHandler) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	request *types.SignalBatchWorkflowExecutionRequest,
+) error {
+
+	scope := a.getMetricsScopeWithDomain(metrics.FrontendSignalBatchWorkflowExecutionScope, request)
+
+	attr := &authorization.Attributes{
+		APIName:    "SignalBatchWorkflowExecution",
+		DomainName: request.GetDomain(),
+		Permission: authorization.PermissionWrite,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.frontendHandler.SignalBatchWorkflowExecution(ctx, request)
+}
+
 // StartWorkflowExecution API call
 func (a *AccessControlledWorkflowHandler) StartWorkflowExecution(
 	ctx context.Context,
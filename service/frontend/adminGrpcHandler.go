@@ -71,6 +71,11 @@ func (g adminGRPCHandler) DescribeQueue(ctx context.Context, request *adminv1.De
 	return proto.FromAdminDescribeQueueResponse(response), proto.FromError(err)
 }
 
+func (g adminGRPCHandler) GetReplicationAckStates(ctx context.Context, request *adminv1.GetReplicationAckStatesRequest) (*adminv1.GetReplicationAckStatesResponse, error) {
+	response, err := g.h.GetReplicationAckStates(ctx, proto.ToAdminGetReplicationAckStatesRequest(request))
+	return proto.FromAdminGetReplicationAckStatesResponse(response), proto.FromError(err)
+}
+
 func (g adminGRPCHandler) DescribeWorkflowExecution(ctx context.Context, request *adminv1.DescribeWorkflowExecutionRequest) (*adminv1.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.h.DescribeWorkflowExecution(ctx, proto.ToAdminDescribeWorkflowExecutionRequest(request))
 	return proto.FromAdminDescribeWorkflowExecutionResponse(response), proto.FromError(err)
@@ -151,6 +156,61 @@ func (g adminGRPCHandler) GetDynamicConfig(ctx context.Context, request *adminv1
 	return proto.FromGetDynamicConfigResponse(response), proto.FromError(err)
 }
 
+func (g adminGRPCHandler) GetDomainReplicationWatermark(ctx context.Context, request *adminv1.GetDomainReplicationWatermarkRequest) (*adminv1.GetDomainReplicationWatermarkResponse, error) {
+	response, err := g.h.GetDomainReplicationWatermark(ctx, proto.ToAdminGetDomainReplicationWatermarkRequest(request))
+	return proto.FromAdminGetDomainReplicationWatermarkResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) IssueDomainToken(ctx context.Context, request *adminv1.IssueDomainTokenRequest) (*adminv1.IssueDomainTokenResponse, error) {
+	response, err := g.h.IssueDomainToken(ctx, proto.ToAdminIssueDomainTokenRequest(request))
+	return proto.FromAdminIssueDomainTokenResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) StealTaskListLease(ctx context.Context, request *adminv1.StealTaskListLeaseRequest) (*adminv1.StealTaskListLeaseResponse, error) {
+	response, err := g.h.StealTaskListLease(ctx, proto.ToAdminStealTaskListLeaseRequest(request))
+	return proto.FromAdminStealTaskListLeaseResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) DescribeTaskListConfig(ctx context.Context, request *adminv1.DescribeTaskListConfigRequest) (*adminv1.DescribeTaskListConfigResponse, error) {
+	response, err := g.h.DescribeTaskListConfig(ctx, proto.ToAdminDescribeTaskListConfigRequest(request))
+	return proto.FromAdminDescribeTaskListConfigResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) GetTaskListUsage(ctx context.Context, request *adminv1.GetTaskListUsageRequest) (*adminv1.GetTaskListUsageResponse, error) {
+	response, err := g.h.GetTaskListUsage(ctx, proto.ToAdminGetTaskListUsageRequest(request))
+	return proto.FromAdminGetTaskListUsageResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) UpdateTaskListTags(ctx context.Context, request *adminv1.UpdateTaskListTagsRequest) (*adminv1.UpdateTaskListTagsResponse, error) {
+	response, err := g.h.UpdateTaskListTags(ctx, proto.ToAdminUpdateTaskListTagsRequest(request))
+	return proto.FromAdminUpdateTaskListTagsResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ListTaskListTags(ctx context.Context, request *adminv1.ListTaskListTagsRequest) (*adminv1.ListTaskListTagsResponse, error) {
+	response, err := g.h.ListTaskListTags(ctx, proto.ToAdminListTaskListTagsRequest(request))
+	return proto.FromAdminListTaskListTagsResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) BackfillDomainReplication(ctx context.Context, request *adminv1.BackfillDomainReplicationRequest) (*adminv1.BackfillDomainReplicationResponse, error) {
+	response, err := g.h.BackfillDomainReplication(ctx, proto.ToAdminBackfillDomainReplicationRequest(request))
+	return proto.FromAdminBackfillDomainReplicationResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) ReconcileDomainRetention(ctx context.Context, request *adminv1.ReconcileDomainRetentionRequest) (*adminv1.ReconcileDomainRetentionResponse, error) {
+	response, err := g.h.ReconcileDomainRetention(ctx, proto.ToAdminReconcileDomainRetentionRequest(request))
+	return proto.FromAdminReconcileDomainRetentionResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) UpdateDomainAsyncWorkflowConfig(ctx context.Context, request *adminv1.UpdateDomainAsyncWorkflowConfigRequest) (*adminv1.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	response, err := g.h.UpdateDomainAsyncWorkflowConfig(ctx, proto.ToAdminUpdateDomainAsyncWorkflowConfigRequest(request))
+	return proto.FromAdminUpdateDomainAsyncWorkflowConfigResponse(response), proto.FromError(err)
+}
+
+func (g adminGRPCHandler) GetDomainAsyncWorkflowConfig(ctx context.Context, request *adminv1.GetDomainAsyncWorkflowConfigRequest) (*adminv1.GetDomainAsyncWorkflowConfigResponse, error) {
+	response, err := g.h.GetDomainAsyncWorkflowConfig(ctx, proto.ToAdminGetDomainAsyncWorkflowConfigRequest(request))
+	return proto.FromAdminGetDomainAsyncWorkflowConfigResponse(response), proto.FromError(err)
+}
+
 func (g adminGRPCHandler) UpdateDynamicConfig(ctx context.Context, request *adminv1.UpdateDynamicConfigRequest) (*adminv1.UpdateDynamicConfigResponse, error) {
 	err := g.h.UpdateDynamicConfig(ctx, proto.ToUpdateDynamicConfigRequest(request))
 	return &adminv1.UpdateDynamicConfigResponse{}, proto.FromError(err)
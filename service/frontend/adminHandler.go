@@ -28,22 +28,29 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pborman/uuid"
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/domain/provisioning"
+	"github.com/uber/cadence/common/domain/replicationbackfill"
+	"github.com/uber/cadence/common/domain/retentionreconciler"
 	dc "github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
 	"github.com/uber/cadence/common/persistence"
@@ -60,7 +67,17 @@ const (
 )
 
 var (
-	errInvalidFilters = &types.BadRequestError{Message: "Request Filters are invalid, unable to parse."}
+	errInvalidFilters             = &types.BadRequestError{Message: "Request Filters are invalid, unable to parse."}
+	errTokenAPIsNotSet            = &types.BadRequestError{Message: "APIs allowed by the token are not set on request."}
+	errTokenIssuanceNotConfigured = &types.BadRequestError{Message: "Token issuance requires the OAuth authorizer with a private key to be configured."}
+	errInvalidDLQShardRange       = &types.BadRequestError{Message: "End shard ID must not be smaller than the shard ID and must be a valid shard."}
+	errInvalidDLQPageToken        = &types.BadRequestError{Message: "Next page token of the DLQ shard range is invalid."}
+	errTaskListTagsNotSet         = &types.BadRequestError{Message: "Tags are not set on request."}
+	errEmptyTaskListTagKey        = &types.BadRequestError{Message: "Tag keys must not be empty."}
+	errStickyTaskListTags         = &types.BadRequestError{Message: "Sticky tasklists cannot be tagged."}
+	errInvalidBackfillRPS         = &types.BadRequestError{Message: "RPS must not be negative."}
+	errInvalidBackfillWindow      = &types.BadRequestError{Message: "Closed within seconds must not be negative."}
+	errInvalidReconcileRPS        = &types.BadRequestError{Message: "RPS must not be negative."}
 )
 
 type (
@@ -77,6 +94,7 @@ type (
 		DescribeWorkflowExecution(context.Context, *types.AdminDescribeWorkflowExecutionRequest) (*types.AdminDescribeWorkflowExecutionResponse, error)
 		GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 		GetDomainReplicationMessages(context.Context, *types.GetDomainReplicationMessagesRequest) (*types.GetDomainReplicationMessagesResponse, error)
+		GetReplicationAckStates(context.Context, *types.GetReplicationAckStatesRequest) (*types.GetReplicationAckStatesResponse, error)
 		GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
 		GetWorkflowExecutionRawHistoryV2(context.Context, *types.GetWorkflowExecutionRawHistoryV2Request) (*types.GetWorkflowExecutionRawHistoryV2Response, error)
 		MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
@@ -90,9 +108,20 @@ type (
 		GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 		RespondCrossClusterTasksCompleted(context.Context, *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error)
 		GetDynamicConfig(context.Context, *types.GetDynamicConfigRequest) (*types.GetDynamicConfigResponse, error)
+		GetDomainReplicationWatermark(context.Context, *types.GetDomainReplicationWatermarkRequest) (*types.GetDomainReplicationWatermarkResponse, error)
 		UpdateDynamicConfig(context.Context, *types.UpdateDynamicConfigRequest) error
 		RestoreDynamicConfig(context.Context, *types.RestoreDynamicConfigRequest) error
 		ListDynamicConfig(context.Context, *types.ListDynamicConfigRequest) (*types.ListDynamicConfigResponse, error)
+		DescribeTaskListConfig(context.Context, *types.DescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error)
+		GetTaskListUsage(context.Context, *types.GetTaskListUsageRequest) (*types.GetTaskListUsageResponse, error)
+		IssueDomainToken(context.Context, *types.IssueDomainTokenRequest) (*types.IssueDomainTokenResponse, error)
+		StealTaskListLease(context.Context, *types.StealTaskListLeaseRequest) (*types.StealTaskListLeaseResponse, error)
+		UpdateTaskListTags(context.Context, *types.UpdateTaskListTagsRequest) (*types.UpdateTaskListTagsResponse, error)
+		ListTaskListTags(context.Context, *types.ListTaskListTagsRequest) (*types.ListTaskListTagsResponse, error)
+		BackfillDomainReplication(context.Context, *types.BackfillDomainReplicationRequest) (*types.BackfillDomainReplicationResponse, error)
+		ReconcileDomainRetention(context.Context, *types.ReconcileDomainRetentionRequest) (*types.ReconcileDomainRetentionResponse, error)
+		UpdateDomainAsyncWorkflowConfig(context.Context, *types.UpdateDomainAsyncWorkflowConfigRequest) (*types.UpdateDomainAsyncWorkflowConfigResponse, error)
+		GetDomainAsyncWorkflowConfig(context.Context, *types.GetDomainAsyncWorkflowConfigRequest) (*types.GetDomainAsyncWorkflowConfigResponse, error)
 		DeleteWorkflow(context.Context, *types.AdminDeleteWorkflowRequest) (*types.AdminDeleteWorkflowResponse, error)
 		MaintainCorruptWorkflow(context.Context, *types.AdminMaintainWorkflowRequest) (*types.AdminMaintainWorkflowResponse, error)
 	}
@@ -106,9 +135,12 @@ type (
 		config                *Config
 		domainDLQHandler      domain.DLQMessageHandler
 		domainFailoverWatcher domain.FailoverWatcher
+		domainHandler         domain.Handler
 		eventSerializer       persistence.PayloadSerializer
 		esClient              elasticsearch.GenericClient
 		throttleRetry         *backoff.ThrottleRetry
+		replicationBackfill   replicationbackfill.Client
+		retentionReconciler   retentionreconciler.Client
 	}
 
 	workflowQueryTemplate struct {
@@ -127,6 +159,12 @@ type (
 		PersistenceToken  []byte
 		VersionHistories  *types.VersionHistories
 	}
+
+	// dlqShardRangeToken tracks the progress of a paginated DLQ request over a shard range
+	dlqShardRangeToken struct {
+		ShardID       int32
+		NextPageToken []byte
+	}
 )
 
 var (
@@ -144,6 +182,7 @@ func NewAdminHandler(
 	resource resource.Resource,
 	params *resource.Params,
 	config *Config,
+	replicationMessageSink messaging.Producer,
 ) AdminHandler {
 
 	domainReplicationTaskExecutor := domain.NewReplicationTaskExecutor(
@@ -170,12 +209,25 @@ func NewAdminHandler(
 			resource.GetMetricsClient(),
 			resource.GetLogger(),
 		),
+		domainHandler: domain.NewHandler(
+			config.domainConfig,
+			resource.GetLogger(),
+			resource.GetDomainManager(),
+			resource.GetClusterMetadata(),
+			domain.NewDomainReplicator(replicationMessageSink, resource.GetLogger()),
+			resource.GetArchivalMetadata(),
+			resource.GetArchiverProvider(),
+			provisioning.NewClient(resource.GetSDKClient()),
+			resource.GetTimeSource(),
+		),
 		eventSerializer: persistence.NewPayloadSerializer(),
 		esClient:        params.ESClient,
 		throttleRetry: backoff.NewThrottleRetry(
 			backoff.WithRetryPolicy(adminServiceRetryPolicy),
 			backoff.WithRetryableError(common.IsServiceTransientError),
 		),
+		replicationBackfill: replicationbackfill.NewClient(resource.GetSDKClient()),
+		retentionReconciler: retentionreconciler.NewClient(resource.GetSDKClient()),
 	}
 }
 
@@ -672,6 +724,27 @@ func (adh *adminHandlerImpl) DescribeQueue(
 	return adh.GetHistoryClient().DescribeQueue(ctx, request)
 }
 
+// GetReplicationAckStates returns the replication ack state of every remote cluster on a shard
+func (adh *adminHandlerImpl) GetReplicationAckStates(
+	ctx context.Context,
+	request *types.GetReplicationAckStatesRequest,
+) (resp *types.GetReplicationAckStatesResponse, retError error) {
+
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetReplicationAckStatesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().GetReplicationAckStates(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 // DescribeShardDistribution returns information about history shard distribution
 func (adh *adminHandlerImpl) DescribeShardDistribution(
 	ctx context.Context,
@@ -1106,6 +1179,16 @@ func (adh *adminHandlerImpl) ReadDLQMessages(
 	var op func() error
 	switch request.GetType() {
 	case types.DLQTypeReplication:
+		if err := adh.validateDLQShardRange(request.GetShardID(), request.GetEndShardID()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		if request.GetEndShardID() > request.GetShardID() {
+			resp, err := adh.readReplicationDLQMessagesInShardRange(ctx, request)
+			if err != nil {
+				return nil, adh.error(err, scope)
+			}
+			return resp, nil
+		}
 		return adh.GetHistoryClient().ReadDLQMessages(ctx, request)
 	case types.DLQTypeDomain:
 		op = func() error {
@@ -1161,6 +1244,20 @@ func (adh *adminHandlerImpl) PurgeDLQMessages(
 	var op func() error
 	switch request.GetType() {
 	case types.DLQTypeReplication:
+		if err := adh.validateDLQShardRange(request.GetShardID(), request.GetEndShardID()); err != nil {
+			return adh.error(err, scope)
+		}
+		if request.GetEndShardID() > request.GetShardID() {
+			for shardID := request.GetShardID(); shardID <= request.GetEndShardID(); shardID++ {
+				shardRequest := *request
+				shardRequest.ShardID = shardID
+				shardRequest.EndShardID = 0
+				if err := adh.GetHistoryClient().PurgeDLQMessages(ctx, &shardRequest); err != nil {
+					return adh.error(err, scope)
+				}
+			}
+			return nil
+		}
 		return adh.GetHistoryClient().PurgeDLQMessages(ctx, request)
 	case types.DLQTypeDomain:
 		op = func() error {
@@ -1211,6 +1308,16 @@ func (adh *adminHandlerImpl) MergeDLQMessages(
 	var op func() error
 	switch request.GetType() {
 	case types.DLQTypeReplication:
+		if err := adh.validateDLQShardRange(request.GetShardID(), request.GetEndShardID()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		if request.GetEndShardID() > request.GetShardID() {
+			resp, err := adh.mergeReplicationDLQMessagesInShardRange(ctx, request)
+			if err != nil {
+				return nil, adh.error(err, scope)
+			}
+			return resp, nil
+		}
 		return adh.GetHistoryClient().MergeDLQMessages(ctx, request)
 	case types.DLQTypeDomain:
 
@@ -1242,6 +1349,104 @@ func (adh *adminHandlerImpl) MergeDLQMessages(
 	}, nil
 }
 
+func (adh *adminHandlerImpl) validateDLQShardRange(shardID int32, endShardID int32) error {
+	if endShardID == 0 {
+		return nil
+	}
+	if endShardID < shardID || int(endShardID) >= adh.numberOfHistoryShards {
+		return errInvalidDLQShardRange
+	}
+	return nil
+}
+
+// readReplicationDLQMessagesInShardRange reads the shards of the range one after the other
+// until the page is full, the page token records where to resume
+func (adh *adminHandlerImpl) readReplicationDLQMessagesInShardRange(
+	ctx context.Context,
+	request *types.ReadDLQMessagesRequest,
+) (*types.ReadDLQMessagesResponse, error) {
+
+	token, err := deserializeDLQShardRangeToken(request.GetShardID(), request.GetNextPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.ReadDLQMessagesResponse{
+		Type: request.Type,
+	}
+	for token.ShardID <= request.GetEndShardID() && int32(len(resp.ReplicationTasks)) < request.GetMaximumPageSize() {
+		shardRequest := *request
+		shardRequest.ShardID = token.ShardID
+		shardRequest.EndShardID = 0
+		shardRequest.MaximumPageSize = request.GetMaximumPageSize() - int32(len(resp.ReplicationTasks))
+		shardRequest.NextPageToken = token.NextPageToken
+		shardResp, err := adh.GetHistoryClient().ReadDLQMessages(ctx, &shardRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.ReplicationTasks = append(resp.ReplicationTasks, shardResp.GetReplicationTasks()...)
+		resp.ReplicationTasksInfo = append(resp.ReplicationTasksInfo, shardResp.GetReplicationTasksInfo()...)
+		token = nextDLQShardRangeToken(token.ShardID, shardResp.GetNextPageToken())
+	}
+
+	if token.ShardID <= request.GetEndShardID() {
+		if resp.NextPageToken, err = json.Marshal(token); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// mergeReplicationDLQMessagesInShardRange merges a single page of the current shard of the range,
+// the page token records where to resume
+func (adh *adminHandlerImpl) mergeReplicationDLQMessagesInShardRange(
+	ctx context.Context,
+	request *types.MergeDLQMessagesRequest,
+) (*types.MergeDLQMessagesResponse, error) {
+
+	token, err := deserializeDLQShardRangeToken(request.GetShardID(), request.GetNextPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	shardRequest := *request
+	shardRequest.ShardID = token.ShardID
+	shardRequest.EndShardID = 0
+	shardRequest.NextPageToken = token.NextPageToken
+	shardResp, err := adh.GetHistoryClient().MergeDLQMessages(ctx, &shardRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &types.MergeDLQMessagesResponse{}
+	token = nextDLQShardRangeToken(token.ShardID, shardResp.GetNextPageToken())
+	if token.ShardID <= request.GetEndShardID() {
+		if resp.NextPageToken, err = json.Marshal(token); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func nextDLQShardRangeToken(shardID int32, nextPageToken []byte) *dlqShardRangeToken {
+	if len(nextPageToken) == 0 {
+		return &dlqShardRangeToken{ShardID: shardID + 1}
+	}
+	return &dlqShardRangeToken{ShardID: shardID, NextPageToken: nextPageToken}
+}
+
+func deserializeDLQShardRangeToken(shardID int32, bytes []byte) (*dlqShardRangeToken, error) {
+	if len(bytes) == 0 {
+		return &dlqShardRangeToken{ShardID: shardID}, nil
+	}
+	token := &dlqShardRangeToken{}
+	if err := json.Unmarshal(bytes, token); err != nil || token.ShardID < shardID {
+		return nil, errInvalidDLQPageToken
+	}
+	return token, nil
+}
+
 // RefreshWorkflowTasks re-generates the workflow tasks
 func (adh *adminHandlerImpl) RefreshWorkflowTasks(
 	ctx context.Context,
@@ -1690,6 +1895,536 @@ func (adh *adminHandlerImpl) ListDynamicConfig(ctx context.Context, request *typ
 	}, nil
 }
 
+// DescribeTaskListConfig returns the effective matching configuration of a tasklist after dynamic config filters are applied
+func (adh *adminHandlerImpl) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.DescribeTaskListConfigRequest,
+) (_ *types.DescribeTaskListConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminDescribeTaskListConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(errTaskListTypeNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// the matching host owning the tasklist reports the config it actually runs with
+	resp, err := adh.GetMatchingClient().DescribeTaskListConfig(ctx, &types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID:   domainID,
+		TaskList:     request.GetTaskList(),
+		TaskListType: request.TaskListType,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// GetTaskListUsage returns the number of tasks and bytes stored for a tasklist, the values are estimated for NoSQL stores
+func (adh *adminHandlerImpl) GetTaskListUsage(
+	ctx context.Context,
+	request *types.GetTaskListUsageRequest,
+) (_ *types.GetTaskListUsageResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetTaskListUsageScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(errTaskListTypeNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	usage, err := adh.GetTaskManager().GetTaskListUsage(ctx, &persistence.GetTaskListUsageRequest{
+		DomainID:     domainID,
+		TaskListName: request.GetTaskList().GetName(),
+		TaskListType: int(request.GetTaskListType()),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.GetTaskListUsageResponse{
+		TaskCount: usage.TaskCount,
+		SizeBytes: usage.SizeBytes,
+		Estimated: usage.Estimated,
+	}, nil
+}
+
+// IssueDomainToken signs a token that only grants access to the given APIs of a domain until it expires,
+// it requires the private key of the OAuth authorizer to be configured
+func (adh *adminHandlerImpl) IssueDomainToken(
+	ctx context.Context,
+	request *types.IssueDomainTokenRequest,
+) (_ *types.IssueDomainTokenResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminIssueDomainTokenScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if len(request.GetAPIs()) == 0 {
+		return nil, adh.error(errTokenAPIsNotSet, scope)
+	}
+	oauthConfig := adh.params.AuthorizationConfig.OAuthAuthorizer
+	if !oauthConfig.Enable || oauthConfig.JwtCredentials.PrivateKey == "" {
+		return nil, adh.error(errTokenIssuanceNotConfigured, scope)
+	}
+	if request.GetTTLSeconds() <= 0 || request.GetTTLSeconds() > oauthConfig.MaxJwtTTL {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("TTL of token must be between 1 and %v seconds.", oauthConfig.MaxJwtTTL),
+		}, scope)
+	}
+	if _, err := adh.GetDomainCache().GetDomain(request.GetDomain()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	privateKey, err := common.LoadRSAPrivateKey(oauthConfig.JwtCredentials.PrivateKey)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	token, err := authorization.IssueDomainScopedToken(privateKey, &authorization.DomainScopedToken{
+		Subject: request.GetSubject(),
+		Domain:  request.GetDomain(),
+		APIs:    request.GetAPIs(),
+		TTL:     time.Duration(request.GetTTLSeconds()) * time.Second,
+	}, adh.GetTimeSource().Now())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.GetLogger().Info("Issued domain scoped token.",
+		tag.WorkflowDomainName(request.GetDomain()),
+		tag.Name(request.GetSubject()),
+		tag.Value(request.GetAPIs()))
+	return &types.IssueDomainTokenResponse{Token: token}, nil
+}
+
+// StealTaskListLease takes over the lease of an existing tasklist by bumping its rangeID. The matching host holding
+// the lease fails its next write and unloads the tasklist, which is then loaded by the host owning it
+func (adh *adminHandlerImpl) StealTaskListLease(
+	ctx context.Context,
+	request *types.StealTaskListLeaseRequest,
+) (_ *types.StealTaskListLeaseResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminStealTaskListLeaseScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(errTaskListTypeNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// leasing creates missing tasklists, make sure a mistyped name does not leave an empty tasklist behind
+	if _, err := adh.GetTaskManager().GetTaskList(ctx, &persistence.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: request.GetTaskList().GetName(),
+		TaskType: int(request.GetTaskListType()),
+	}); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// a zero rangeID steals the lease from its current owner
+	resp, err := adh.GetTaskManager().LeaseTaskList(ctx, &persistence.LeaseTaskListRequest{
+		DomainID:     domainID,
+		TaskList:     request.GetTaskList().GetName(),
+		TaskType:     int(request.GetTaskListType()),
+		TaskListKind: persistence.TaskListKindNormal,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.GetLogger().Info("Stole tasklist lease.",
+		tag.WorkflowDomainName(request.GetDomain()),
+		tag.WorkflowTaskListName(request.GetTaskList().GetName()),
+		tag.WorkflowTaskListType(int(request.GetTaskListType())),
+		tag.ShardRangeID(resp.TaskListInfo.RangeID))
+	return &types.StealTaskListLeaseResponse{RangeID: resp.TaskListInfo.RangeID}, nil
+}
+
+// UpdateTaskListTags merges the given tags into the tags of an existing tasklist, tags with an empty value are removed.
+// Tags are kept next to the lease of the tasklist, so they are set without taking the lease from its matching host
+func (adh *adminHandlerImpl) UpdateTaskListTags(
+	ctx context.Context,
+	request *types.UpdateTaskListTagsRequest,
+) (_ *types.UpdateTaskListTagsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUpdateTaskListTagsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if request.TaskListType == nil {
+		return nil, adh.error(errTaskListTypeNotSet, scope)
+	}
+	if len(request.GetTags()) == 0 {
+		return nil, adh.error(errTaskListTagsNotSet, scope)
+	}
+	if _, ok := request.GetTags()[""]; ok {
+		return nil, adh.error(errEmptyTaskListTagKey, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetTaskManager().GetTaskList(ctx, &persistence.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: request.GetTaskList().GetName(),
+		TaskType: int(request.GetTaskListType()),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if resp.TaskListInfo.Kind == persistence.TaskListKindSticky {
+		return nil, adh.error(errStickyTaskListTags, scope)
+	}
+
+	tags := make(map[string]string, len(resp.TaskListInfo.Tags)+len(request.GetTags()))
+	for key, value := range resp.TaskListInfo.Tags {
+		tags[key] = value
+	}
+	for key, value := range request.GetTags() {
+		if value == "" {
+			delete(tags, key)
+		} else {
+			tags[key] = value
+		}
+	}
+	if err := adh.GetTaskManager().UpdateTaskListTags(ctx, &persistence.UpdateTaskListTagsRequest{
+		DomainID: domainID,
+		TaskList: request.GetTaskList().GetName(),
+		TaskType: int(request.GetTaskListType()),
+		Tags:     tags,
+	}); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.GetLogger().Info("Updated tasklist tags.",
+		tag.WorkflowDomainName(request.GetDomain()),
+		tag.WorkflowTaskListName(request.GetTaskList().GetName()),
+		tag.WorkflowTaskListType(int(request.GetTaskListType())),
+		tag.Value(tags))
+	return &types.UpdateTaskListTagsResponse{Tags: tags}, nil
+}
+
+// ListTaskListTags returns the tags of the tasklists of the domain loaded by matching hosts. When tags are given,
+// only the tasklists having all of them are returned
+func (adh *adminHandlerImpl) ListTaskListTags(
+	ctx context.Context,
+	request *types.ListTaskListTagsRequest,
+) (_ *types.ListTaskListTagsResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminListTaskListTagsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// the store cannot list the tasklists of a domain, so only the tasklists known to matching are listed
+	loaded, err := adh.GetMatchingClient().GetTaskListsByDomain(ctx, &types.GetTaskListsByDomainRequest{
+		Domain: request.GetDomain(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	taskLists := []*types.TaskListTags{}
+	for _, taskListType := range []types.TaskListType{types.TaskListTypeDecision, types.TaskListTypeActivity} {
+		taskListMap := loaded.GetDecisionTaskListMap()
+		if taskListType == types.TaskListTypeActivity {
+			taskListMap = loaded.GetActivityTaskListMap()
+		}
+		names := make([]string, 0, len(taskListMap))
+		for name := range taskListMap {
+			if !strings.HasPrefix(name, common.ReservedTaskListPrefix) {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			resp, err := adh.GetTaskManager().GetTaskList(ctx, &persistence.GetTaskListRequest{
+				DomainID: domainID,
+				TaskList: name,
+				TaskType: int(taskListType),
+			})
+			if err != nil {
+				if _, ok := err.(*types.EntityNotExistsError); ok {
+					continue
+				}
+				return nil, adh.error(err, scope)
+			}
+			if resp.TaskListInfo.Kind == persistence.TaskListKindSticky || !hasTaskListTags(resp.TaskListInfo.Tags, request.GetTags()) {
+				continue
+			}
+			taskLists = append(taskLists, &types.TaskListTags{
+				Name:         name,
+				TaskListType: taskListType.Ptr(),
+				Tags:         resp.TaskListInfo.Tags,
+			})
+		}
+	}
+	return &types.ListTaskListTagsResponse{TaskLists: taskLists}, nil
+}
+
+// BackfillDomainReplication starts the replication backfill of a global domain to a cluster newly added to it.
+// The open workflows and the workflows closed within the requested time, or the domain retention by default,
+// are replicated from the current cluster.
+func (adh *adminHandlerImpl) BackfillDomainReplication(
+	ctx context.Context,
+	request *types.BackfillDomainReplicationRequest,
+) (_ *types.BackfillDomainReplicationResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminBackfillDomainReplicationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTargetCluster() == "" {
+		return nil, adh.error(errClusterNameNotSet, scope)
+	}
+	if request.GetRPS() < 0 {
+		return nil, adh.error(errInvalidBackfillRPS, scope)
+	}
+	if request.GetClosedWithinSeconds() < 0 {
+		return nil, adh.error(errInvalidBackfillWindow, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if !domainEntry.IsGlobalDomain() {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Domain %v is not a global domain.", request.GetDomain()),
+		}, scope)
+	}
+	currentCluster := adh.GetClusterMetadata().GetCurrentClusterName()
+	if request.GetTargetCluster() == currentCluster {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Cannot backfill the current cluster %v, send the request to another cluster of the domain.", currentCluster),
+		}, scope)
+	}
+	if !domainEntry.HasReplicationCluster(request.GetTargetCluster()) {
+		return nil, adh.error(&types.BadRequestError{
+			Message: fmt.Sprintf("Cluster %v is not in the replication config of domain %v.", request.GetTargetCluster(), request.GetDomain()),
+		}, scope)
+	}
+
+	closedWithin := time.Duration(request.GetClosedWithinSeconds()) * time.Second
+	if closedWithin == 0 {
+		closedWithin = time.Duration(domainEntry.GetConfig().Retention) * 24 * time.Hour
+	}
+	now := adh.GetTimeSource().Now()
+	jobID, err := adh.replicationBackfill.StartBackfill(ctx, &replicationbackfill.Request{
+		DomainID:          domainEntry.GetInfo().ID,
+		DomainName:        request.GetDomain(),
+		SourceCluster:     currentCluster,
+		TargetCluster:     request.GetTargetCluster(),
+		EarliestCloseTime: now.Add(-closedWithin).UnixNano(),
+		LatestTime:        now.UnixNano(),
+		RPS:               int(request.GetRPS()),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.GetLogger().Info("Started replication backfill.",
+		tag.WorkflowDomainName(request.GetDomain()),
+		tag.ClusterName(request.GetTargetCluster()),
+		tag.WorkflowID(jobID))
+	return &types.BackfillDomainReplicationResponse{JobID: jobID}, nil
+}
+
+// ReconcileDomainRetention starts the reconciliation of the history deletion timers of the closed workflows
+// of a domain, so that they are deleted according to the current retention of the domain instead of the
+// retention the domain had when they closed.
+func (adh *adminHandlerImpl) ReconcileDomainRetention(
+	ctx context.Context,
+	request *types.ReconcileDomainRetentionRequest,
+) (_ *types.ReconcileDomainRetentionResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminReconcileDomainRetentionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetRPS() < 0 {
+		return nil, adh.error(errInvalidReconcileRPS, scope)
+	}
+	domainEntry, err := adh.GetDomainCache().GetDomain(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	jobID, err := adh.retentionReconciler.StartReconcile(ctx, &retentionreconciler.Request{
+		DomainID:   domainEntry.GetInfo().ID,
+		DomainName: request.GetDomain(),
+		LatestTime: adh.GetTimeSource().Now().UnixNano(),
+		RPS:        int(request.GetRPS()),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	adh.GetLogger().Info("Started retention reconciliation.",
+		tag.WorkflowDomainName(request.GetDomain()),
+		tag.WorkflowID(jobID))
+	return &types.ReconcileDomainRetentionResponse{JobID: jobID}, nil
+}
+
+// UpdateDomainAsyncWorkflowConfig replaces the async workflow configuration of the domain, which selects
+// the queue that asynchronous start and signal requests of the domain are sent to and the rate they are consumed at.
+// The configuration of a global domain is replicated to its other clusters.
+func (adh *adminHandlerImpl) UpdateDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.UpdateDomainAsyncWorkflowConfigRequest,
+) (_ *types.UpdateDomainAsyncWorkflowConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminUpdateDomainAsyncWorkflowConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if err := adh.domainHandler.UpdateAsyncWorkflowConfiguration(ctx, *request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.UpdateDomainAsyncWorkflowConfigResponse{}, nil
+}
+
+// GetDomainAsyncWorkflowConfig returns the async workflow configuration of the domain
+func (adh *adminHandlerImpl) GetDomainAsyncWorkflowConfig(
+	ctx context.Context,
+	request *types.GetDomainAsyncWorkflowConfigRequest,
+) (_ *types.GetDomainAsyncWorkflowConfigResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetDomainAsyncWorkflowConfigScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	resp, err := adh.domainHandler.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(request.GetDomain())})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.GetDomainAsyncWorkflowConfigResponse{
+		Configuration: resp.GetConfiguration().GetAsyncWorkflowConfig(),
+	}, nil
+}
+
+func hasTaskListTags(tags map[string]string, filter map[string]string) bool {
+	for key, value := range filter {
+		if tags[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// GetDomainReplicationWatermark returns the event time of the latest history event of the domain
+// replicated to the current cluster
+func (adh *adminHandlerImpl) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.GetDomainReplicationWatermarkRequest,
+) (_ *types.GetDomainReplicationWatermarkResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminGetDomainReplicationWatermarkScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	shardIDs := make([]int32, 0, adh.numberOfHistoryShards)
+	for shardID := 0; shardID < adh.numberOfHistoryShards; shardID++ {
+		shardIDs = append(shardIDs, int32(shardID))
+	}
+	resp, err := adh.GetHistoryClient().GetDomainReplicationWatermark(ctx, &types.HistoryGetDomainReplicationWatermarkRequest{
+		DomainID: domainID,
+		ShardIDs: shardIDs,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.GetDomainReplicationWatermarkResponse{
+		Cluster:                 adh.GetClusterMetadata().GetCurrentClusterName(),
+		LastReplicatedEventTime: resp.LastReplicatedEventTime,
+	}, nil
+}
+
 func checkValidKey(keyName string) (dc.Key, error) {
 	keyVal, ok := dc.KeyNames[keyName]
 	if !ok || keyVal == dc.UnknownKey {
@@ -170,6 +170,21 @@ func (mr *MockAdminHandlerMockRecorder) DescribeQueue(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockAdminHandler)(nil).DescribeQueue), arg0, arg1)
 }
 
+// GetReplicationAckStates mocks base method
+func (m *MockAdminHandler) GetReplicationAckStates(arg0 context.Context, arg1 *types.GetReplicationAckStatesRequest) (*types.GetReplicationAckStatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationAckStates", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetReplicationAckStatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationAckStates indicates an expected call of GetReplicationAckStates
+func (mr *MockAdminHandlerMockRecorder) GetReplicationAckStates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationAckStates", reflect.TypeOf((*MockAdminHandler)(nil).GetReplicationAckStates), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method
 func (m *MockAdminHandler) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.AdminDescribeWorkflowExecutionRequest) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -422,6 +437,171 @@ func (mr *MockAdminHandlerMockRecorder) GetDynamicConfig(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminHandler)(nil).GetDynamicConfig), arg0, arg1)
 }
 
+// GetDomainReplicationWatermark mocks base method
+func (m *MockAdminHandler) GetDomainReplicationWatermark(arg0 context.Context, arg1 *types.GetDomainReplicationWatermarkRequest) (*types.GetDomainReplicationWatermarkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainReplicationWatermark", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetDomainReplicationWatermarkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainReplicationWatermark indicates an expected call of GetDomainReplicationWatermark
+func (mr *MockAdminHandlerMockRecorder) GetDomainReplicationWatermark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationWatermark", reflect.TypeOf((*MockAdminHandler)(nil).GetDomainReplicationWatermark), arg0, arg1)
+}
+
+// DescribeTaskListConfig mocks base method
+func (m *MockAdminHandler) DescribeTaskListConfig(arg0 context.Context, arg1 *types.DescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListConfig indicates an expected call of DescribeTaskListConfig
+func (mr *MockAdminHandlerMockRecorder) DescribeTaskListConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListConfig", reflect.TypeOf((*MockAdminHandler)(nil).DescribeTaskListConfig), arg0, arg1)
+}
+
+// IssueDomainToken mocks base method
+func (m *MockAdminHandler) IssueDomainToken(arg0 context.Context, arg1 *types.IssueDomainTokenRequest) (*types.IssueDomainTokenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueDomainToken", arg0, arg1)
+	ret0, _ := ret[0].(*types.IssueDomainTokenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueDomainToken indicates an expected call of IssueDomainToken
+func (mr *MockAdminHandlerMockRecorder) IssueDomainToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueDomainToken", reflect.TypeOf((*MockAdminHandler)(nil).IssueDomainToken), arg0, arg1)
+}
+
+// StealTaskListLease mocks base method
+func (m *MockAdminHandler) StealTaskListLease(arg0 context.Context, arg1 *types.StealTaskListLeaseRequest) (*types.StealTaskListLeaseResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StealTaskListLease", arg0, arg1)
+	ret0, _ := ret[0].(*types.StealTaskListLeaseResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StealTaskListLease indicates an expected call of StealTaskListLease
+func (mr *MockAdminHandlerMockRecorder) StealTaskListLease(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StealTaskListLease", reflect.TypeOf((*MockAdminHandler)(nil).StealTaskListLease), arg0, arg1)
+}
+
+// UpdateTaskListTags mocks base method
+func (m *MockAdminHandler) UpdateTaskListTags(arg0 context.Context, arg1 *types.UpdateTaskListTagsRequest) (*types.UpdateTaskListTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTaskListTags", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateTaskListTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTaskListTags indicates an expected call of UpdateTaskListTags
+func (mr *MockAdminHandlerMockRecorder) UpdateTaskListTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTaskListTags", reflect.TypeOf((*MockAdminHandler)(nil).UpdateTaskListTags), arg0, arg1)
+}
+
+// ListTaskListTags mocks base method
+func (m *MockAdminHandler) ListTaskListTags(arg0 context.Context, arg1 *types.ListTaskListTagsRequest) (*types.ListTaskListTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTaskListTags", arg0, arg1)
+	ret0, _ := ret[0].(*types.ListTaskListTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTaskListTags indicates an expected call of ListTaskListTags
+func (mr *MockAdminHandlerMockRecorder) ListTaskListTags(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListTags", reflect.TypeOf((*MockAdminHandler)(nil).ListTaskListTags), arg0, arg1)
+}
+
+// BackfillDomainReplication mocks base method
+func (m *MockAdminHandler) BackfillDomainReplication(arg0 context.Context, arg1 *types.BackfillDomainReplicationRequest) (*types.BackfillDomainReplicationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BackfillDomainReplication", arg0, arg1)
+	ret0, _ := ret[0].(*types.BackfillDomainReplicationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDomainAsyncWorkflowConfig mocks base method
+func (m *MockAdminHandler) UpdateDomainAsyncWorkflowConfig(arg0 context.Context, arg1 *types.UpdateDomainAsyncWorkflowConfigRequest) (*types.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDomainAsyncWorkflowConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.UpdateDomainAsyncWorkflowConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainAsyncWorkflowConfig mocks base method
+func (m *MockAdminHandler) GetDomainAsyncWorkflowConfig(arg0 context.Context, arg1 *types.GetDomainAsyncWorkflowConfigRequest) (*types.GetDomainAsyncWorkflowConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainAsyncWorkflowConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetDomainAsyncWorkflowConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BackfillDomainReplication indicates an expected call of BackfillDomainReplication
+func (mr *MockAdminHandlerMockRecorder) BackfillDomainReplication(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackfillDomainReplication", reflect.TypeOf((*MockAdminHandler)(nil).BackfillDomainReplication), arg0, arg1)
+}
+
+// ReconcileDomainRetention mocks base method
+func (m *MockAdminHandler) ReconcileDomainRetention(arg0 context.Context, arg1 *types.ReconcileDomainRetentionRequest) (*types.ReconcileDomainRetentionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileDomainRetention", arg0, arg1)
+	ret0, _ := ret[0].(*types.ReconcileDomainRetentionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconcileDomainRetention indicates an expected call of ReconcileDomainRetention
+func (mr *MockAdminHandlerMockRecorder) ReconcileDomainRetention(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileDomainRetention", reflect.TypeOf((*MockAdminHandler)(nil).ReconcileDomainRetention), arg0, arg1)
+}
+
+// UpdateDomainAsyncWorkflowConfig indicates an expected call of UpdateDomainAsyncWorkflowConfig
+func (mr *MockAdminHandlerMockRecorder) UpdateDomainAsyncWorkflowConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainAsyncWorkflowConfig", reflect.TypeOf((*MockAdminHandler)(nil).UpdateDomainAsyncWorkflowConfig), arg0, arg1)
+}
+
+// GetDomainAsyncWorkflowConfig indicates an expected call of GetDomainAsyncWorkflowConfig
+func (mr *MockAdminHandlerMockRecorder) GetDomainAsyncWorkflowConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainAsyncWorkflowConfig", reflect.TypeOf((*MockAdminHandler)(nil).GetDomainAsyncWorkflowConfig), arg0, arg1)
+}
+
+// GetTaskListUsage mocks base method
+func (m *MockAdminHandler) GetTaskListUsage(arg0 context.Context, arg1 *types.GetTaskListUsageRequest) (*types.GetTaskListUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListUsage", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetTaskListUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListUsage indicates an expected call of GetTaskListUsage
+func (mr *MockAdminHandlerMockRecorder) GetTaskListUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListUsage", reflect.TypeOf((*MockAdminHandler)(nil).GetTaskListUsage), arg0, arg1)
+}
+
 // UpdateDynamicConfig mocks base method
 func (m *MockAdminHandler) UpdateDynamicConfig(arg0 context.Context, arg1 *types.UpdateDynamicConfigRequest) error {
 	m.ctrl.T.Helper()
@@ -26,22 +26,29 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/domain/replicationbackfill"
+	"github.com/uber/cadence/common/domain/retentionreconciler"
 	"github.com/uber/cadence/common/dynamicconfig"
 	esmock "github.com/uber/cadence/common/elasticsearch/mocks"
 	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
@@ -97,8 +104,11 @@ func (s *adminHandlerSuite) SetupTest() {
 	config := &Config{
 		EnableAdminProtection:  dynamicconfig.GetBoolPropertyFn(false),
 		EnableGracefulFailover: dynamicconfig.GetBoolPropertyFn(false),
+		domainConfig: domain.Config{
+			MinRetentionDays: dynamicconfig.GetIntPropertyFn(1),
+		},
 	}
-	s.handler = NewAdminHandler(s.mockResource, params, config).(*adminHandlerImpl)
+	s.handler = NewAdminHandler(s.mockResource, params, config, messaging.NewNoopProducer()).(*adminHandlerImpl)
 	s.handler.Start()
 }
 
@@ -760,3 +770,445 @@ func (s *adminHandlerSuite) Test_GetDynamicConfig_FilterMatch() {
 	s.NoError(err)
 	s.Equal(resp.Value.Data, encTrue)
 }
+
+func (s *adminHandlerSuite) Test_DescribeTaskListConfig() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskListConfig(gomock.Any(), &types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID:   s.domainID,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}).Return(&types.DescribeTaskListConfigResponse{NumReadPartitions: 4, NumWritePartitions: 4}, nil).Times(1)
+
+	resp, err := s.handler.DescribeTaskListConfig(ctx, &types.DescribeTaskListConfigRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.NoError(err)
+	s.Equal(&types.DescribeTaskListConfigResponse{NumReadPartitions: 4, NumWritePartitions: 4}, resp)
+}
+
+func (s *adminHandlerSuite) Test_DescribeTaskListConfig_TaskListTypeNotSet() {
+	_, err := s.handler.DescribeTaskListConfig(context.Background(), &types.DescribeTaskListConfigRequest{
+		Domain:   s.domainName,
+		TaskList: &types.TaskList{Name: "tl"},
+	})
+	s.Equal(errTaskListTypeNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_GetTaskListUsage() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.TaskMgr.On("GetTaskListUsage", mock.Anything, &persistence.GetTaskListUsageRequest{
+		DomainID:     s.domainID,
+		TaskListName: "tl",
+		TaskListType: persistence.TaskListTypeActivity,
+	}).Return(&persistence.GetTaskListUsageResponse{TaskCount: 10, SizeBytes: 1024}, nil).Once()
+
+	resp, err := s.handler.GetTaskListUsage(ctx, &types.GetTaskListUsageRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.NoError(err)
+	s.Equal(&types.GetTaskListUsageResponse{TaskCount: 10, SizeBytes: 1024}, resp)
+}
+
+func (s *adminHandlerSuite) Test_GetTaskListUsage_TaskListNotSet() {
+	_, err := s.handler.GetTaskListUsage(context.Background(), &types.GetTaskListUsageRequest{
+		Domain:       s.domainName,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.Equal(errTaskListNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_ReadDLQMessages_ShardRange() {
+	ctx := context.Background()
+	s.handler.numberOfHistoryShards = 4
+	s.mockHistoryClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.ReadDLQMessagesRequest, _ ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error) {
+			s.Equal(int32(0), request.EndShardID)
+			switch request.ShardID {
+			case 1:
+				s.Equal(int32(2), request.MaximumPageSize)
+				return &types.ReadDLQMessagesResponse{ReplicationTasks: []*types.ReplicationTask{{SourceTaskID: 1}}}, nil
+			case 2:
+				s.Equal(int32(1), request.MaximumPageSize)
+				return &types.ReadDLQMessagesResponse{
+					ReplicationTasks: []*types.ReplicationTask{{SourceTaskID: 2}},
+					NextPageToken:    []byte("shard-2-token"),
+				}, nil
+			}
+			s.FailNow("unexpected shard", request.ShardID)
+			return nil, nil
+		}).Times(2)
+
+	resp, err := s.handler.ReadDLQMessages(ctx, &types.ReadDLQMessagesRequest{
+		Type:            types.DLQTypeReplication.Ptr(),
+		ShardID:         1,
+		EndShardID:      3,
+		MaximumPageSize: 2,
+	})
+	s.NoError(err)
+	s.Len(resp.ReplicationTasks, 2)
+
+	token, err := deserializeDLQShardRangeToken(1, resp.NextPageToken)
+	s.NoError(err)
+	s.Equal(&dlqShardRangeToken{ShardID: 2, NextPageToken: []byte("shard-2-token")}, token)
+}
+
+func (s *adminHandlerSuite) Test_PurgeDLQMessages_ShardRange() {
+	ctx := context.Background()
+	s.handler.numberOfHistoryShards = 4
+	var shardIDs []int32
+	s.mockHistoryClient.EXPECT().PurgeDLQMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.PurgeDLQMessagesRequest, _ ...yarpc.CallOption) error {
+			shardIDs = append(shardIDs, request.ShardID)
+			return nil
+		}).Times(3)
+
+	err := s.handler.PurgeDLQMessages(ctx, &types.PurgeDLQMessagesRequest{
+		Type:       types.DLQTypeReplication.Ptr(),
+		ShardID:    1,
+		EndShardID: 3,
+	})
+	s.NoError(err)
+	s.Equal([]int32{1, 2, 3}, shardIDs)
+}
+
+func (s *adminHandlerSuite) Test_MergeDLQMessages_ShardRange() {
+	ctx := context.Background()
+	s.handler.numberOfHistoryShards = 4
+	s.mockHistoryClient.EXPECT().MergeDLQMessages(gomock.Any(), &types.MergeDLQMessagesRequest{
+		Type:                  types.DLQTypeReplication.Ptr(),
+		ShardID:               1,
+		InclusiveEndMessageID: common.Int64Ptr(endMessageID),
+	}).Return(&types.MergeDLQMessagesResponse{}, nil).Times(1)
+
+	resp, err := s.handler.MergeDLQMessages(ctx, &types.MergeDLQMessagesRequest{
+		Type:       types.DLQTypeReplication.Ptr(),
+		ShardID:    1,
+		EndShardID: 3,
+	})
+	s.NoError(err)
+
+	token, err := deserializeDLQShardRangeToken(1, resp.NextPageToken)
+	s.NoError(err)
+	s.Equal(&dlqShardRangeToken{ShardID: 2}, token)
+}
+
+func (s *adminHandlerSuite) Test_PurgeDLQMessages_InvalidShardRange() {
+	s.handler.numberOfHistoryShards = 4
+	err := s.handler.PurgeDLQMessages(context.Background(), &types.PurgeDLQMessagesRequest{
+		Type:       types.DLQTypeReplication.Ptr(),
+		ShardID:    2,
+		EndShardID: 1,
+	})
+	s.Equal(errInvalidDLQShardRange, err)
+
+	err = s.handler.PurgeDLQMessages(context.Background(), &types.PurgeDLQMessagesRequest{
+		Type:       types.DLQTypeReplication.Ptr(),
+		ShardID:    2,
+		EndShardID: 4,
+	})
+	s.Equal(errInvalidDLQShardRange, err)
+}
+
+func (s *adminHandlerSuite) Test_StealTaskListLease() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.TaskMgr.On("GetTaskList", mock.Anything, &persistence.GetTaskListRequest{
+		DomainID: s.domainID,
+		TaskList: "tl",
+		TaskType: persistence.TaskListTypeActivity,
+	}).Return(&persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{RangeID: 3}}, nil).Once()
+	s.mockResource.TaskMgr.On("LeaseTaskList", mock.Anything, &persistence.LeaseTaskListRequest{
+		DomainID:     s.domainID,
+		TaskList:     "tl",
+		TaskType:     persistence.TaskListTypeActivity,
+		TaskListKind: persistence.TaskListKindNormal,
+	}).Return(&persistence.LeaseTaskListResponse{TaskListInfo: &persistence.TaskListInfo{RangeID: 4}}, nil).Once()
+
+	resp, err := s.handler.StealTaskListLease(ctx, &types.StealTaskListLeaseRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.NoError(err)
+	s.Equal(&types.StealTaskListLeaseResponse{RangeID: 4}, resp)
+}
+
+func (s *adminHandlerSuite) Test_StealTaskListLease_TaskListNotExists() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.TaskMgr.On("GetTaskList", mock.Anything, mock.Anything).
+		Return(nil, &types.EntityNotExistsError{Message: "task list tl of type 1 does not exist"}).Once()
+
+	_, err := s.handler.StealTaskListLease(ctx, &types.StealTaskListLeaseRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+	s.mockResource.TaskMgr.AssertNotCalled(s.T(), "LeaseTaskList", mock.Anything, mock.Anything)
+}
+
+func (s *adminHandlerSuite) Test_UpdateTaskListTags() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.TaskMgr.On("GetTaskList", mock.Anything, &persistence.GetTaskListRequest{
+		DomainID: s.domainID,
+		TaskList: "tl",
+		TaskType: persistence.TaskListTypeActivity,
+	}).Return(&persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
+		Tags: map[string]string{"team": "orders", "tier": "2"},
+	}}, nil).Once()
+	s.mockResource.TaskMgr.On("UpdateTaskListTags", mock.Anything, &persistence.UpdateTaskListTagsRequest{
+		DomainID: s.domainID,
+		TaskList: "tl",
+		TaskType: persistence.TaskListTypeActivity,
+		Tags:     map[string]string{"team": "payments", "service": "checkout"},
+	}).Return(nil).Once()
+
+	resp, err := s.handler.UpdateTaskListTags(ctx, &types.UpdateTaskListTagsRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		Tags:         map[string]string{"team": "payments", "service": "checkout", "tier": ""},
+	})
+	s.NoError(err)
+	s.Equal(map[string]string{"team": "payments", "service": "checkout"}, resp.Tags)
+}
+
+func (s *adminHandlerSuite) Test_UpdateTaskListTags_InvalidRequest() {
+	ctx := context.Background()
+	for name, tags := range map[string]map[string]string{
+		"no tags":   nil,
+		"empty key": {"": "payments"},
+	} {
+		_, err := s.handler.UpdateTaskListTags(ctx, &types.UpdateTaskListTagsRequest{
+			Domain:       s.domainName,
+			TaskList:     &types.TaskList{Name: "tl"},
+			TaskListType: types.TaskListTypeActivity.Ptr(),
+			Tags:         tags,
+		})
+		s.IsType(&types.BadRequestError{}, err, name)
+	}
+}
+
+func (s *adminHandlerSuite) Test_UpdateTaskListTags_StickyTaskList() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.TaskMgr.On("GetTaskList", mock.Anything, mock.Anything).
+		Return(&persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{Kind: persistence.TaskListKindSticky}}, nil).Once()
+
+	_, err := s.handler.UpdateTaskListTags(ctx, &types.UpdateTaskListTagsRequest{
+		Domain:       s.domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+		Tags:         map[string]string{"team": "payments"},
+	})
+	s.IsType(&types.BadRequestError{}, err)
+	s.mockResource.TaskMgr.AssertNotCalled(s.T(), "UpdateTaskListTags", mock.Anything, mock.Anything)
+}
+
+func (s *adminHandlerSuite) Test_ListTaskListTags() {
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().GetTaskListsByDomain(gomock.Any(), &types.GetTaskListsByDomainRequest{
+		Domain: s.domainName,
+	}).Return(&types.GetTaskListsByDomainResponse{
+		DecisionTaskListMap: map[string]*types.DescribeTaskListResponse{
+			"payments-tl":                  {},
+			"orders-tl":                    {},
+			"/__cadence_sys/payments-tl/1": {},
+		},
+		ActivityTaskListMap: map[string]*types.DescribeTaskListResponse{
+			"payments-tl": {},
+			"deleted-tl":  {},
+		},
+	}, nil).Times(1)
+	getTaskList := func(name string, taskType int, tags map[string]string) {
+		s.mockResource.TaskMgr.On("GetTaskList", mock.Anything, &persistence.GetTaskListRequest{
+			DomainID: s.domainID,
+			TaskList: name,
+			TaskType: taskType,
+		}).Return(&persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{Tags: tags}}, nil).Once()
+	}
+	getTaskList("payments-tl", persistence.TaskListTypeDecision, map[string]string{"team": "payments", "tier": "1"})
+	getTaskList("orders-tl", persistence.TaskListTypeDecision, map[string]string{"team": "orders"})
+	getTaskList("payments-tl", persistence.TaskListTypeActivity, map[string]string{"team": "payments"})
+	s.mockResource.TaskMgr.On("GetTaskList", mock.Anything, &persistence.GetTaskListRequest{
+		DomainID: s.domainID,
+		TaskList: "deleted-tl",
+		TaskType: persistence.TaskListTypeActivity,
+	}).Return(nil, &types.EntityNotExistsError{}).Once()
+
+	resp, err := s.handler.ListTaskListTags(ctx, &types.ListTaskListTagsRequest{
+		Domain: s.domainName,
+		Tags:   map[string]string{"team": "payments"},
+	})
+	s.NoError(err)
+	s.Equal([]*types.TaskListTags{
+		{Name: "payments-tl", TaskListType: types.TaskListTypeDecision.Ptr(), Tags: map[string]string{"team": "payments", "tier": "1"}},
+		{Name: "payments-tl", TaskListType: types.TaskListTypeActivity.Ptr(), Tags: map[string]string{"team": "payments"}},
+	}, resp.TaskLists)
+}
+
+func (s *adminHandlerSuite) newGlobalDomainEntry() *cache.DomainCacheEntry {
+	return cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 3},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1,
+		nil,
+	)
+}
+
+func (s *adminHandlerSuite) Test_BackfillDomainReplication() {
+	ctx := context.Background()
+	mockBackfill := replicationbackfill.NewMockClient(s.controller)
+	s.handler.replicationBackfill = mockBackfill
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(s.newGlobalDomainEntry(), nil).Times(1)
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	mockBackfill.EXPECT().StartBackfill(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *replicationbackfill.Request) (string, error) {
+			s.Equal(s.domainID, request.DomainID)
+			s.Equal(s.domainName, request.DomainName)
+			s.Equal(cluster.TestCurrentClusterName, request.SourceCluster)
+			s.Equal(cluster.TestAlternativeClusterName, request.TargetCluster)
+			s.Equal(10, request.RPS)
+			// the domain retention is used when no time is given
+			s.Equal((3 * 24 * time.Hour).Nanoseconds(), request.LatestTime-request.EarliestCloseTime)
+			return "jobID", nil
+		}).Times(1)
+
+	resp, err := s.handler.BackfillDomainReplication(ctx, &types.BackfillDomainReplicationRequest{
+		Domain:        s.domainName,
+		TargetCluster: cluster.TestAlternativeClusterName,
+		RPS:           10,
+	})
+	s.NoError(err)
+	s.Equal("jobID", resp.JobID)
+}
+
+func (s *adminHandlerSuite) Test_BackfillDomainReplication_InvalidRequest() {
+	ctx := context.Background()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	localDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: s.domainID, Name: s.domainName},
+		&persistence.DomainConfig{Retention: 3},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+
+	for name, tc := range map[string]struct {
+		domainEntry   *cache.DomainCacheEntry
+		targetCluster string
+	}{
+		"local domain":      {domainEntry: localDomainEntry, targetCluster: cluster.TestAlternativeClusterName},
+		"current cluster":   {domainEntry: s.newGlobalDomainEntry(), targetCluster: cluster.TestCurrentClusterName},
+		"not in the domain": {domainEntry: s.newGlobalDomainEntry(), targetCluster: "unknown"},
+	} {
+		s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(tc.domainEntry, nil).Times(1)
+		_, err := s.handler.BackfillDomainReplication(ctx, &types.BackfillDomainReplicationRequest{
+			Domain:        s.domainName,
+			TargetCluster: tc.targetCluster,
+		})
+		s.IsType(&types.BadRequestError{}, err, name)
+	}
+
+	_, err := s.handler.BackfillDomainReplication(ctx, &types.BackfillDomainReplicationRequest{
+		Domain:        s.domainName,
+		TargetCluster: cluster.TestAlternativeClusterName,
+		RPS:           -1,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) Test_ReconcileDomainRetention() {
+	ctx := context.Background()
+	mockReconciler := retentionreconciler.NewMockClient(s.controller)
+	s.handler.retentionReconciler = mockReconciler
+	s.mockDomainCache.EXPECT().GetDomain(s.domainName).Return(s.newGlobalDomainEntry(), nil).Times(1)
+	mockReconciler.EXPECT().StartReconcile(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *retentionreconciler.Request) (string, error) {
+			s.Equal(s.domainID, request.DomainID)
+			s.Equal(s.domainName, request.DomainName)
+			s.Equal(10, request.RPS)
+			s.NotZero(request.LatestTime)
+			return "jobID", nil
+		}).Times(1)
+
+	resp, err := s.handler.ReconcileDomainRetention(ctx, &types.ReconcileDomainRetentionRequest{
+		Domain: s.domainName,
+		RPS:    10,
+	})
+	s.NoError(err)
+	s.Equal("jobID", resp.JobID)
+}
+
+func (s *adminHandlerSuite) Test_ReconcileDomainRetention_InvalidRequest() {
+	ctx := context.Background()
+
+	_, err := s.handler.ReconcileDomainRetention(ctx, &types.ReconcileDomainRetentionRequest{})
+	s.IsType(&types.BadRequestError{}, err)
+
+	_, err = s.handler.ReconcileDomainRetention(ctx, &types.ReconcileDomainRetentionRequest{
+		Domain: s.domainName,
+		RPS:    -1,
+	})
+	s.IsType(&types.BadRequestError{}, err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateDomainAsyncWorkflowConfig() {
+	ctx := context.Background()
+	mockDomainHandler := domain.NewMockHandler(s.controller)
+	s.handler.domainHandler = mockDomainHandler
+	request := &types.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain: s.domainName,
+		Configuration: &types.AsyncWorkflowConfiguration{
+			Enabled:       true,
+			QueueProvider: "kafka",
+			QueueTopic:    "async-requests",
+			RPS:           100,
+		},
+	}
+	mockDomainHandler.EXPECT().UpdateAsyncWorkflowConfiguration(ctx, *request).Return(nil).Times(1)
+
+	resp, err := s.handler.UpdateDomainAsyncWorkflowConfig(ctx, request)
+	s.NoError(err)
+	s.Equal(&types.UpdateDomainAsyncWorkflowConfigResponse{}, resp)
+
+	_, err = s.handler.UpdateDomainAsyncWorkflowConfig(ctx, &types.UpdateDomainAsyncWorkflowConfigRequest{})
+	s.Equal(errDomainNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_GetDomainAsyncWorkflowConfig() {
+	ctx := context.Background()
+	mockDomainHandler := domain.NewMockHandler(s.controller)
+	s.handler.domainHandler = mockDomainHandler
+	config := &types.AsyncWorkflowConfiguration{
+		Enabled:       true,
+		QueueProvider: "kafka",
+		QueueTopic:    "async-requests",
+		RPS:           100,
+	}
+	mockDomainHandler.EXPECT().DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(s.domainName)}).Return(&types.DescribeDomainResponse{
+		Configuration: &types.DomainConfiguration{AsyncWorkflowConfig: config},
+	}, nil).Times(1)
+
+	resp, err := s.handler.GetDomainAsyncWorkflowConfig(ctx, &types.GetDomainAsyncWorkflowConfigRequest{Domain: s.domainName})
+	s.NoError(err)
+	s.Equal(config, resp.Configuration)
+
+	_, err = s.handler.GetDomainAsyncWorkflowConfig(ctx, &types.GetDomainAsyncWorkflowConfigRequest{})
+	s.Equal(errDomainNotSet, err)
+}
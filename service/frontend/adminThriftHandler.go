@@ -82,6 +82,12 @@ func (t AdminThriftHandler) DescribeQueue(ctx context.Context, request *shared.D
 	return thrift.FromDescribeQueueResponse(response), thrift.FromError(err)
 }
 
+// GetReplicationAckStates forwards request to the underlying handler
+func (t AdminThriftHandler) GetReplicationAckStates(ctx context.Context, request *shared.GetReplicationAckStatesRequest) (*shared.GetReplicationAckStatesResponse, error) {
+	response, err := t.h.GetReplicationAckStates(ctx, thrift.ToGetReplicationAckStatesRequest(request))
+	return thrift.FromGetReplicationAckStatesResponse(response), thrift.FromError(err)
+}
+
 // DescribeWorkflowExecution forwards request to the underlying handler
 func (t AdminThriftHandler) DescribeWorkflowExecution(ctx context.Context, request *admin.DescribeWorkflowExecutionRequest) (*admin.DescribeWorkflowExecutionResponse, error) {
 	response, err := t.h.DescribeWorkflowExecution(ctx, thrift.ToAdminDescribeWorkflowExecutionRequest(request))
@@ -178,6 +184,72 @@ func (t AdminThriftHandler) GetDynamicConfig(ctx context.Context, request *admin
 	return thrift.FromGetDynamicConfigResponse(response), thrift.FromError(err)
 }
 
+// GetDomainReplicationWatermark returns the event time of the latest replicated event of a domain
+func (t AdminThriftHandler) GetDomainReplicationWatermark(ctx context.Context, request *admin.GetDomainReplicationWatermarkRequest) (*admin.GetDomainReplicationWatermarkResponse, error) {
+	response, err := t.h.GetDomainReplicationWatermark(ctx, thrift.ToGetDomainReplicationWatermarkRequest(request))
+	return thrift.FromGetDomainReplicationWatermarkResponse(response), thrift.FromError(err)
+}
+
+// IssueDomainToken forwards request to the underlying handler
+func (t AdminThriftHandler) IssueDomainToken(ctx context.Context, request *admin.IssueDomainTokenRequest) (*admin.IssueDomainTokenResponse, error) {
+	response, err := t.h.IssueDomainToken(ctx, thrift.ToIssueDomainTokenRequest(request))
+	return thrift.FromIssueDomainTokenResponse(response), thrift.FromError(err)
+}
+
+// StealTaskListLease forwards request to the underlying handler
+func (t AdminThriftHandler) StealTaskListLease(ctx context.Context, request *admin.StealTaskListLeaseRequest) (*admin.StealTaskListLeaseResponse, error) {
+	response, err := t.h.StealTaskListLease(ctx, thrift.ToStealTaskListLeaseRequest(request))
+	return thrift.FromStealTaskListLeaseResponse(response), thrift.FromError(err)
+}
+
+// DescribeTaskListConfig forwards request to the underlying handler
+func (t AdminThriftHandler) DescribeTaskListConfig(ctx context.Context, request *admin.DescribeTaskListConfigRequest) (*admin.DescribeTaskListConfigResponse, error) {
+	response, err := t.h.DescribeTaskListConfig(ctx, thrift.ToDescribeTaskListConfigRequest(request))
+	return thrift.FromDescribeTaskListConfigResponse(response), thrift.FromError(err)
+}
+
+// GetTaskListUsage forwards request to the underlying handler
+func (t AdminThriftHandler) GetTaskListUsage(ctx context.Context, request *admin.GetTaskListUsageRequest) (*admin.GetTaskListUsageResponse, error) {
+	response, err := t.h.GetTaskListUsage(ctx, thrift.ToGetTaskListUsageRequest(request))
+	return thrift.FromGetTaskListUsageResponse(response), thrift.FromError(err)
+}
+
+// UpdateTaskListTags forwards request to the underlying handler
+func (t AdminThriftHandler) UpdateTaskListTags(ctx context.Context, request *admin.UpdateTaskListTagsRequest) (*admin.UpdateTaskListTagsResponse, error) {
+	response, err := t.h.UpdateTaskListTags(ctx, thrift.ToUpdateTaskListTagsRequest(request))
+	return thrift.FromUpdateTaskListTagsResponse(response), thrift.FromError(err)
+}
+
+// ListTaskListTags forwards request to the underlying handler
+func (t AdminThriftHandler) ListTaskListTags(ctx context.Context, request *admin.ListTaskListTagsRequest) (*admin.ListTaskListTagsResponse, error) {
+	response, err := t.h.ListTaskListTags(ctx, thrift.ToListTaskListTagsRequest(request))
+	return thrift.FromListTaskListTagsResponse(response), thrift.FromError(err)
+}
+
+// BackfillDomainReplication forwards request to the underlying handler
+func (t AdminThriftHandler) BackfillDomainReplication(ctx context.Context, request *admin.BackfillDomainReplicationRequest) (*admin.BackfillDomainReplicationResponse, error) {
+	response, err := t.h.BackfillDomainReplication(ctx, thrift.ToBackfillDomainReplicationRequest(request))
+	return thrift.FromBackfillDomainReplicationResponse(response), thrift.FromError(err)
+}
+
+// ReconcileDomainRetention forwards request to the underlying handler
+func (t AdminThriftHandler) ReconcileDomainRetention(ctx context.Context, request *admin.ReconcileDomainRetentionRequest) (*admin.ReconcileDomainRetentionResponse, error) {
+	response, err := t.h.ReconcileDomainRetention(ctx, thrift.ToReconcileDomainRetentionRequest(request))
+	return thrift.FromReconcileDomainRetentionResponse(response), thrift.FromError(err)
+}
+
+// UpdateDomainAsyncWorkflowConfig forwards request to the underlying handler
+func (t AdminThriftHandler) UpdateDomainAsyncWorkflowConfig(ctx context.Context, request *admin.UpdateDomainAsyncWorkflowConfigRequest) (*admin.UpdateDomainAsyncWorkflowConfigResponse, error) {
+	response, err := t.h.UpdateDomainAsyncWorkflowConfig(ctx, thrift.ToUpdateDomainAsyncWorkflowConfigRequest(request))
+	return thrift.FromUpdateDomainAsyncWorkflowConfigResponse(response), thrift.FromError(err)
+}
+
+// GetDomainAsyncWorkflowConfig forwards request to the underlying handler
+func (t AdminThriftHandler) GetDomainAsyncWorkflowConfig(ctx context.Context, request *admin.GetDomainAsyncWorkflowConfigRequest) (*admin.GetDomainAsyncWorkflowConfigResponse, error) {
+	response, err := t.h.GetDomainAsyncWorkflowConfig(ctx, thrift.ToGetDomainAsyncWorkflowConfigRequest(request))
+	return thrift.FromGetDomainAsyncWorkflowConfigResponse(response), thrift.FromError(err)
+}
+
 // UpdateDynamicConfig updates dynamic config value
 func (t AdminThriftHandler) UpdateDynamicConfig(ctx context.Context, request *admin.UpdateDynamicConfigRequest) error {
 	err := t.h.UpdateDynamicConfig(ctx, thrift.ToUpdateDynamicConfigRequest(request))
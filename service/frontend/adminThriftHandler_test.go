@@ -72,12 +72,86 @@ func TestAdminThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.DescribeQueueResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("GetReplicationAckStates", func(t *testing.T) {
+		h.EXPECT().GetReplicationAckStates(ctx, &types.GetReplicationAckStatesRequest{}).Return(&types.GetReplicationAckStatesResponse{}, internalErr).Times(1)
+		resp, err := th.GetReplicationAckStates(ctx, &shared.GetReplicationAckStatesRequest{})
+		assert.Equal(t, shared.GetReplicationAckStatesResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DescribeWorkflowExecution", func(t *testing.T) {
 		h.EXPECT().DescribeWorkflowExecution(ctx, &types.AdminDescribeWorkflowExecutionRequest{}).Return(&types.AdminDescribeWorkflowExecutionResponse{}, internalErr).Times(1)
 		resp, err := th.DescribeWorkflowExecution(ctx, &admin.DescribeWorkflowExecutionRequest{})
 		assert.Equal(t, admin.DescribeWorkflowExecutionResponse{ShardId: common.StringPtr(""), HistoryAddr: common.StringPtr(""), MutableStateInCache: common.StringPtr(""), MutableStateInDatabase: common.StringPtr("")}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("GetDomainReplicationWatermark", func(t *testing.T) {
+		h.EXPECT().GetDomainReplicationWatermark(ctx, &types.GetDomainReplicationWatermarkRequest{}).Return(&types.GetDomainReplicationWatermarkResponse{}, internalErr).Times(1)
+		resp, err := th.GetDomainReplicationWatermark(ctx, &admin.GetDomainReplicationWatermarkRequest{})
+		assert.Equal(t, admin.GetDomainReplicationWatermarkResponse{Cluster: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("IssueDomainToken", func(t *testing.T) {
+		h.EXPECT().IssueDomainToken(ctx, &types.IssueDomainTokenRequest{}).Return(&types.IssueDomainTokenResponse{}, internalErr).Times(1)
+		resp, err := th.IssueDomainToken(ctx, &admin.IssueDomainTokenRequest{})
+		assert.Equal(t, admin.IssueDomainTokenResponse{Token: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("StealTaskListLease", func(t *testing.T) {
+		h.EXPECT().StealTaskListLease(ctx, &types.StealTaskListLeaseRequest{}).Return(&types.StealTaskListLeaseResponse{}, internalErr).Times(1)
+		resp, err := th.StealTaskListLease(ctx, &admin.StealTaskListLeaseRequest{})
+		assert.Equal(t, admin.StealTaskListLeaseResponse{RangeID: common.Int64Ptr(0)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("DescribeTaskListConfig", func(t *testing.T) {
+		h.EXPECT().DescribeTaskListConfig(ctx, &types.DescribeTaskListConfigRequest{}).Return(&types.DescribeTaskListConfigResponse{NumReadPartitions: 2, EnableSyncMatch: true}, internalErr).Times(1)
+		resp, err := th.DescribeTaskListConfig(ctx, &admin.DescribeTaskListConfigRequest{})
+		assert.Equal(t, common.Int32Ptr(2), resp.NumReadPartitions)
+		assert.Equal(t, common.BoolPtr(true), resp.EnableSyncMatch)
+		assert.Equal(t, common.Int64Ptr(0), resp.RangeSize)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("GetTaskListUsage", func(t *testing.T) {
+		h.EXPECT().GetTaskListUsage(ctx, &types.GetTaskListUsageRequest{}).Return(&types.GetTaskListUsageResponse{}, internalErr).Times(1)
+		resp, err := th.GetTaskListUsage(ctx, &admin.GetTaskListUsageRequest{})
+		assert.Equal(t, admin.GetTaskListUsageResponse{TaskCount: common.Int64Ptr(0), SizeBytes: common.Int64Ptr(0), Estimated: common.BoolPtr(false)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UpdateTaskListTags", func(t *testing.T) {
+		h.EXPECT().UpdateTaskListTags(ctx, &types.UpdateTaskListTagsRequest{}).Return(&types.UpdateTaskListTagsResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateTaskListTags(ctx, &admin.UpdateTaskListTagsRequest{})
+		assert.Equal(t, admin.UpdateTaskListTagsResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ListTaskListTags", func(t *testing.T) {
+		h.EXPECT().ListTaskListTags(ctx, &types.ListTaskListTagsRequest{}).Return(&types.ListTaskListTagsResponse{}, internalErr).Times(1)
+		resp, err := th.ListTaskListTags(ctx, &admin.ListTaskListTagsRequest{})
+		assert.Equal(t, admin.ListTaskListTagsResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("BackfillDomainReplication", func(t *testing.T) {
+		h.EXPECT().BackfillDomainReplication(ctx, &types.BackfillDomainReplicationRequest{}).Return(&types.BackfillDomainReplicationResponse{}, internalErr).Times(1)
+		resp, err := th.BackfillDomainReplication(ctx, &admin.BackfillDomainReplicationRequest{})
+		assert.Equal(t, admin.BackfillDomainReplicationResponse{JobID: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("ReconcileDomainRetention", func(t *testing.T) {
+		h.EXPECT().ReconcileDomainRetention(ctx, &types.ReconcileDomainRetentionRequest{}).Return(&types.ReconcileDomainRetentionResponse{}, internalErr).Times(1)
+		resp, err := th.ReconcileDomainRetention(ctx, &admin.ReconcileDomainRetentionRequest{})
+		assert.Equal(t, admin.ReconcileDomainRetentionResponse{JobID: common.StringPtr("")}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("UpdateDomainAsyncWorkflowConfig", func(t *testing.T) {
+		h.EXPECT().UpdateDomainAsyncWorkflowConfig(ctx, &types.UpdateDomainAsyncWorkflowConfigRequest{}).Return(&types.UpdateDomainAsyncWorkflowConfigResponse{}, internalErr).Times(1)
+		resp, err := th.UpdateDomainAsyncWorkflowConfig(ctx, &admin.UpdateDomainAsyncWorkflowConfigRequest{})
+		assert.Equal(t, admin.UpdateDomainAsyncWorkflowConfigResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("GetDomainAsyncWorkflowConfig", func(t *testing.T) {
+		h.EXPECT().GetDomainAsyncWorkflowConfig(ctx, &types.GetDomainAsyncWorkflowConfigRequest{}).Return(&types.GetDomainAsyncWorkflowConfigResponse{}, internalErr).Times(1)
+		resp, err := th.GetDomainAsyncWorkflowConfig(ctx, &admin.GetDomainAsyncWorkflowConfigRequest{})
+		assert.Equal(t, admin.GetDomainAsyncWorkflowConfigResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("GetDLQReplicationMessages", func(t *testing.T) {
 		h.EXPECT().GetDLQReplicationMessages(ctx, &types.GetDLQReplicationMessagesRequest{}).Return(&types.GetDLQReplicationMessagesResponse{}, internalErr).Times(1)
 		resp, err := th.GetDLQReplicationMessages(ctx, &replicator.GetDLQReplicationMessagesRequest{})
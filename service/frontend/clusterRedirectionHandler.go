@@ -1052,6 +1052,35 @@ func (handler *ClusterRedirectionHandlerImpl) SignalWorkflowExecution(
 	return err
 }
 
+// SignalBatchWorkflowExecution API call
+func (handler *ClusterRedirectionHandlerImpl) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	request *types.SignalBatchWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "SignalBatchWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionSignalBatchWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(ctx, request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.SignalBatchWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.GetRemoteFrontendClient(targetDC)
+			err = remoteClient.SignalBatchWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
 // StartWorkflowExecution API call
 func (handler *ClusterRedirectionHandlerImpl) StartWorkflowExecution(
 	ctx context.Context,
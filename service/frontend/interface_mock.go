@@ -586,6 +586,20 @@ func (mr *MockHandlerMockRecorder) ScanWorkflowExecutions(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScanWorkflowExecutions", reflect.TypeOf((*MockHandler)(nil).ScanWorkflowExecutions), arg0, arg1)
 }
 
+// SignalBatchWorkflowExecution mocks base method
+func (m *MockHandler) SignalBatchWorkflowExecution(arg0 context.Context, arg1 *types.SignalBatchWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalBatchWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalBatchWorkflowExecution indicates an expected call of SignalBatchWorkflowExecution
+func (mr *MockHandlerMockRecorder) SignalBatchWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalBatchWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).SignalBatchWorkflowExecution), arg0, arg1)
+}
+
 // SignalWithStartWorkflowExecution mocks base method
 func (m *MockHandler) SignalWithStartWorkflowExecution(arg0 context.Context, arg1 *types.SignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -50,6 +50,7 @@ type Config struct {
 	ESVisibilityListMaxQPS          dynamicconfig.IntPropertyFnWithDomainFilter
 	ESIndexMaxResultWindow          dynamicconfig.IntPropertyFn
 	HistoryMaxPageSize              dynamicconfig.IntPropertyFnWithDomainFilter
+	SignalBatchMaxSize              dynamicconfig.IntPropertyFnWithDomainFilter
 	UserRPS                         dynamicconfig.IntPropertyFn
 	WorkerRPS                       dynamicconfig.IntPropertyFn
 	MaxDomainUserRPSPerInstance     dynamicconfig.IntPropertyFnWithDomainFilter
@@ -124,6 +125,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		EnableReadVisibilityFromES:                  dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableReadVisibilityFromES, enableReadFromES),
 		ESIndexMaxResultWindow:                      dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
 		HistoryMaxPageSize:                          dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
+		SignalBatchMaxSize:                          dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendSignalBatchMaxSize, 100),
 		UserRPS:                                     dc.GetIntProperty(dynamicconfig.FrontendUserRPS, 1200),
 		WorkerRPS:                                   dc.GetIntProperty(dynamicconfig.FrontendWorkerRPS, dynamicconfig.UnlimitedRPS),
 		MaxDomainUserRPSPerInstance:                 dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxDomainUserRPSPerInstance, 1200),
@@ -161,11 +163,16 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		DecisionResultCountLimit:                    dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDecisionResultCountLimit, 0),
 		EmitSignalNameMetricsTag:                    dc.GetBoolPropertyFilteredByDomain(dynamicconfig.FrontendEmitSignalNameMetricsTag, false),
 		domainConfig: domain.Config{
-			MaxBadBinaryCount:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxBadBinaries, domain.MaxBadBinaries),
-			MinRetentionDays:       dc.GetIntProperty(dynamicconfig.MinRetentionDays, domain.DefaultMinWorkflowRetentionInDays),
-			MaxRetentionDays:       dc.GetIntProperty(dynamicconfig.MaxRetentionDays, domain.DefaultMaxWorkflowRetentionInDays),
-			FailoverCoolDown:       dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendFailoverCoolDown, domain.FailoverCoolDown),
-			RequiredDomainDataKeys: dc.GetMapProperty(dynamicconfig.RequiredDomainDataKeys, nil),
+			MaxBadBinaryCount:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendMaxBadBinaries, domain.MaxBadBinaries),
+			MinRetentionDays:        dc.GetIntProperty(dynamicconfig.MinRetentionDays, domain.DefaultMinWorkflowRetentionInDays),
+			MaxRetentionDays:        dc.GetIntProperty(dynamicconfig.MaxRetentionDays, domain.DefaultMaxWorkflowRetentionInDays),
+			FailoverCoolDown:        dc.GetDurationPropertyFilteredByDomain(dynamicconfig.FrontendFailoverCoolDown, domain.FailoverCoolDown),
+			RequiredDomainDataKeys:  dc.GetMapProperty(dynamicconfig.RequiredDomainDataKeys, nil),
+			EnableAsyncRegistration: dc.GetBoolProperty(dynamicconfig.FrontendEnableAsyncDomainRegistration, false),
+			FailoverWebhookURLs:     dc.GetStringPropertyFilteredByDomain(dynamicconfig.FrontendDomainFailoverWebhookURLs, ""),
+			FailoverWebhookTimeout:  dc.GetDurationProperty(dynamicconfig.FrontendDomainFailoverWebhookTimeout, domain.DefaultFailoverWebhookTimeout),
+			ValidMetadataKeys:       dc.GetMapProperty(dynamicconfig.FrontendValidDomainMetadataKeys, nil),
+			MetadataSizeLimit:       dc.GetIntProperty(dynamicconfig.FrontendDomainMetadataSizeLimit, domain.DefaultMetadataSizeLimit),
 		},
 	}
 }
@@ -281,7 +288,7 @@ func (s *Service) Start() {
 	grpcHandler := newGrpcHandler(handler)
 	grpcHandler.register(s.GetDispatcher())
 
-	s.adminHandler = NewAdminHandler(s, s.params, s.config)
+	s.adminHandler = NewAdminHandler(s, s.params, s.config, replicationMessageSink)
 	s.adminHandler = NewAccessControlledAdminHandlerImpl(s.adminHandler, s, s.params.Authorizer, s.params.AuthorizationConfig)
 
 	adminThriftHandler := NewAdminThriftHandler(s.adminHandler)
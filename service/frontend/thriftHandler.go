@@ -277,6 +277,12 @@ func (t ThriftHandler) SignalWorkflowExecution(ctx context.Context, request *sha
 	return thrift.FromError(err)
 }
 
+// SignalBatchWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) SignalBatchWorkflowExecution(ctx context.Context, request *shared.SignalBatchWorkflowExecutionRequest) error {
+	err := t.h.SignalBatchWorkflowExecution(ctx, thrift.ToSignalBatchWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
 // StartWorkflowExecution forwards request to the underlying handler
 func (t ThriftHandler) StartWorkflowExecution(ctx context.Context, request *shared.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
 	response, err := t.h.StartWorkflowExecution(ctx, thrift.ToStartWorkflowExecutionRequest(request))
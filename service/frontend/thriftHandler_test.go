@@ -113,7 +113,7 @@ func TestThriftHandler(t *testing.T) {
 	t.Run("ListDomains", func(t *testing.T) {
 		h.EXPECT().ListDomains(ctx, &types.ListDomainsRequest{}).Return(&types.ListDomainsResponse{}, internalErr).Times(1)
 		resp, err := th.ListDomains(ctx, &shared.ListDomainsRequest{})
-		assert.Equal(t, shared.ListDomainsResponse{}, *resp)
+		assert.Equal(t, shared.ListDomainsResponse{TotalCount: common.Int64Ptr(0)}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
 	t.Run("ListOpenWorkflowExecutions", func(t *testing.T) {
@@ -249,6 +249,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("SignalBatchWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().SignalBatchWorkflowExecution(ctx, &types.SignalBatchWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.SignalBatchWorkflowExecution(ctx, &shared.SignalBatchWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("StartWorkflowExecution", func(t *testing.T) {
 		h.EXPECT().StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{}).Return(&types.StartWorkflowExecutionResponse{}, internalErr).Times(1)
 		resp, err := th.StartWorkflowExecution(ctx, &shared.StartWorkflowExecutionRequest{})
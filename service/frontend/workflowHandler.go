@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/domain/provisioning"
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -116,6 +117,8 @@ var (
 	errWorkflowIDNotSet                           = &types.BadRequestError{Message: "WorkflowId is not set on request."}
 	errActivityIDNotSet                           = &types.BadRequestError{Message: "ActivityID is not set on request."}
 	errSignalNameNotSet                           = &types.BadRequestError{Message: "SignalName is not set on request."}
+	errSignalBatchNotSet                          = &types.BadRequestError{Message: "Signals are not set on request."}
+	errSignalBatchSizeTooLarge                    = &types.BadRequestError{Message: "Total input size of signals exceeds limit."}
 	errInvalidRunID                               = &types.BadRequestError{Message: "Invalid RunId."}
 	errInvalidNextPageToken                       = &types.BadRequestError{Message: "Invalid NextPageToken."}
 	errNextPageTokenRunIDMismatch                 = &types.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
@@ -133,6 +136,7 @@ var (
 	errClusterNameNotSet                          = &types.BadRequestError{Message: "Cluster name is not set."}
 	errEmptyReplicationInfo                       = &types.BadRequestError{Message: "Replication task info is not set."}
 	errEmptyQueueType                             = &types.BadRequestError{Message: "Queue type is not set."}
+	errEventTypesWithCloseEventFilter             = &types.BadRequestError{Message: "EventTypes cannot be combined with the close event filter type."}
 	errShuttingDown                               = &types.InternalServiceError{Message: "Shutting down"}
 
 	// err for archival
@@ -143,6 +147,7 @@ var (
 	errWorkflowTypeTooLong = &types.BadRequestError{Message: "WorkflowType length exceeds limit."}
 	errWorkflowIDTooLong   = &types.BadRequestError{Message: "WorkflowID length exceeds limit."}
 	errSignalNameTooLong   = &types.BadRequestError{Message: "SignalName length exceeds limit."}
+	errSignalBatchTooLarge = &types.BadRequestError{Message: "Number of signals exceeds limit."}
 	errTaskListTooLong     = &types.BadRequestError{Message: "TaskList length exceeds limit."}
 	errRequestIDTooLong    = &types.BadRequestError{Message: "RequestID length exceeds limit."}
 	errIdentityTooLong     = &types.BadRequestError{Message: "Identity length exceeds limit."}
@@ -193,6 +198,7 @@ func NewWorkflowHandler(
 			domain.NewDomainReplicator(replicationMessageSink, resource.GetLogger()),
 			resource.GetArchivalMetadata(),
 			resource.GetArchiverProvider(),
+			provisioning.NewClient(resource.GetSDKClient()),
 			resource.GetTimeSource(),
 		),
 		visibilityQueryValidator: validator.NewQueryValidator(config.ValidSearchAttributes),
@@ -538,7 +544,7 @@ func (wh *WorkflowHandler) PollForActivityTask(
 
 	if ok := wh.allow(false, pollRequest); !ok {
 		// pollers exponentially back off up to 10s
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+		return nil, wh.error(wh.createServiceBusyError(false, pollRequest), scope, tags...)
 	}
 
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
@@ -658,7 +664,7 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 
 	if ok := wh.allow(false, pollRequest); !ok {
 		// pollers exponentially back off up to 10s
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+		return nil, wh.error(wh.createServiceBusyError(false, pollRequest), scope, tags...)
 	}
 
 	pollerID := uuid.New()
@@ -1969,7 +1975,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	}
 
 	if ok := wh.allow(true, startRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+		return nil, wh.error(wh.createServiceBusyError(true, startRequest), scope, tags...)
 	}
 
 	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
@@ -2066,10 +2072,14 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	}
 
 	wh.GetLogger().Debug("Start workflow execution request domain", tag.WorkflowDomainName(domainName))
-	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	domainEntry, err := wh.GetDomainCache().GetDomain(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
+	domainID := domainEntry.GetInfo().ID
+	if err := domain.ValidateWorkflowID(domainName, domainEntry.GetInfo().Data, startRequest.GetWorkflowID()); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
@@ -2133,13 +2143,18 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	}
 
 	if ok := wh.allow(true, getRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+		return nil, wh.error(wh.createServiceBusyError(true, getRequest), scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
 
+	eventTypes := getRequest.GetEventTypes()
+	if len(eventTypes) > 0 && getRequest.GetHistoryEventFilterType() == types.HistoryEventFilterTypeCloseEvent {
+		return nil, wh.error(errEventTypesWithCloseEventFilter, scope, tags...)
+	}
+
 	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope, tags...)
@@ -2162,7 +2177,11 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		enableArchivalRead := wh.GetArchivalMetadata().GetHistoryConfig().ReadEnabled()
 		historyArchived := wh.historyArchived(ctx, getRequest, domainID)
 		if enableArchivalRead && historyArchived {
-			return wh.getArchivedHistory(ctx, getRequest, domainID, scope, tags...)
+			resp, err := wh.getArchivedHistory(ctx, getRequest, domainID, scope, tags...)
+			if err == nil && len(eventTypes) > 0 {
+				resp.History.Events = filterHistoryEvents(resp.History.Events, eventTypes)
+			}
+			return resp, err
 		}
 	}
 
@@ -2269,7 +2288,9 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 	clientFeatureVersion := call.Header(common.FeatureVersionHeaderName)
 	clientImpl := call.Header(common.ClientImplHeaderName)
 	supportsRawHistoryQuery := wh.versionChecker.SupportsRawHistoryQuery(clientImpl, clientFeatureVersion) == nil
-	isRawHistoryEnabled := wh.config.SendRawWorkflowHistory(domainName) && supportsRawHistoryQuery
+	// raw history blobs cannot be filtered without deserializing them, so fall back to
+	// returning decoded events when the caller asks for specific event types
+	isRawHistoryEnabled := wh.config.SendRawWorkflowHistory(domainName) && supportsRawHistoryQuery && len(eventTypes) == 0
 
 	history := &types.History{}
 	history.Events = []*types.HistoryEvent{}
@@ -2341,6 +2362,17 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 			if err := getHistory(token.FirstEventID, token.NextEventID, token.PersistenceToken); err != nil {
 				return nil, wh.error(err, scope, tags...)
 			}
+			if len(eventTypes) > 0 {
+				history.Events = filterHistoryEvents(history.Events, eventTypes)
+				// keep reading stored pages until one has a matching event, rather than
+				// handing the caller a series of empty pages to page through
+				for len(history.Events) == 0 && len(token.PersistenceToken) != 0 {
+					if err := getHistory(token.FirstEventID, token.NextEventID, token.PersistenceToken); err != nil {
+						return nil, wh.error(err, scope, tags...)
+					}
+					history.Events = filterHistoryEvents(history.Events, eventTypes)
+				}
+			}
 			// here, for long pull on history events, we need to intercept the paging token from cassandra
 			// and do something clever
 			if len(token.PersistenceToken) == 0 && (!token.IsWorkflowRunning || !isLongPoll) {
@@ -2406,7 +2438,7 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 	}
 
 	if ok := wh.allow(true, signalRequest); !ok {
-		return wh.error(createServiceBusyError(), scope, tags...)
+		return wh.error(wh.createServiceBusyError(true, signalRequest), scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -2486,6 +2518,136 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 	return nil
 }
 
+// SignalBatchWorkflowExecution is used to send multiple signals to a running workflow execution at once.
+// All signals are recorded as WorkflowExecutionSignaled events in a single history transaction and only one
+// decision task is created for them.
+func (wh *WorkflowHandler) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	signalRequest *types.SignalBatchWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(ctx, metrics.FrontendSignalBatchWorkflowExecutionScope, signalRequest)
+	defer sw.Stop()
+
+	if wh.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	if err := wh.versionChecker.ClientSupported(ctx, wh.config.EnableClientVersionCheck()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if signalRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	domainName := signalRequest.GetDomain()
+	wfExecution := signalRequest.GetWorkflowExecution()
+	tags := getDomainWfIDRunIDTags(domainName, wfExecution)
+
+	if domainName == "" {
+		return wh.error(errDomainNotSet, scope, tags...)
+	}
+
+	if ok := wh.allow(true, signalRequest); !ok {
+		return wh.error(wh.createServiceBusyError(true, signalRequest), scope, tags...)
+	}
+
+	if err := validateExecution(wfExecution); err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	idLengthWarnLimit := wh.config.MaxIDLengthWarnLimit()
+	if !common.ValidIDLength(
+		domainName,
+		scope,
+		idLengthWarnLimit,
+		wh.config.DomainNameMaxLength(domainName),
+		metrics.CadenceErrDomainNameExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeDomainName) {
+		return wh.error(errDomainTooLong, scope, tags...)
+	}
+
+	if len(signalRequest.GetSignals()) == 0 {
+		return wh.error(errSignalBatchNotSet, scope, tags...)
+	}
+
+	if len(signalRequest.GetSignals()) > wh.config.SignalBatchMaxSize(domainName) {
+		return wh.error(errSignalBatchTooLarge, scope, tags...)
+	}
+
+	if !common.ValidIDLength(
+		signalRequest.GetRequestID(),
+		scope,
+		idLengthWarnLimit,
+		wh.config.RequestIDMaxLength(domainName),
+		metrics.CadenceErrRequestIDExceededWarnLimit,
+		domainName,
+		wh.GetLogger(),
+		tag.IDTypeRequestID) {
+		return wh.error(errRequestIDTooLong, scope, tags...)
+	}
+
+	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	if err != nil {
+		return wh.error(err, scope, tags...)
+	}
+
+	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
+	totalSize := 0
+	for _, signal := range signalRequest.GetSignals() {
+		if signal.GetSignalName() == "" {
+			return wh.error(errSignalNameNotSet, scope, tags...)
+		}
+
+		if !common.ValidIDLength(
+			signal.GetSignalName(),
+			scope,
+			idLengthWarnLimit,
+			wh.config.SignalNameMaxLength(domainName),
+			metrics.CadenceErrSignalNameExceededWarnLimit,
+			domainName,
+			wh.GetLogger(),
+			tag.IDTypeSignalName) {
+			return wh.error(errSignalNameTooLong, scope, tags...)
+		}
+
+		if err := common.CheckEventBlobSizeLimit(
+			len(signal.GetInput()),
+			sizeLimitWarn,
+			sizeLimitError,
+			domainID,
+			wfExecution.GetWorkflowID(),
+			wfExecution.GetRunID(),
+			scope,
+			wh.GetThrottledLogger(),
+			tag.BlobSizeViolationOperation("SignalBatchWorkflowExecution"),
+		); err != nil {
+			return wh.error(err, scope, tags...)
+		}
+		totalSize += len(signal.GetInput())
+	}
+
+	// all signals are written in one history transaction, so the batch as a whole is held to the blob size limit
+	if totalSize > sizeLimitError {
+		return wh.error(errSignalBatchSizeTooLarge, scope, tags...)
+	}
+
+	err = wh.GetHistoryClient().SignalBatchWorkflowExecution(ctx, &types.HistorySignalBatchWorkflowExecutionRequest{
+		DomainUUID:    domainID,
+		SignalRequest: signalRequest,
+	})
+	if err != nil {
+		return wh.normalizeVersionedErrors(ctx, wh.error(err, scope, tags...))
+	}
+
+	return nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -2523,7 +2685,7 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 	}
 
 	if ok := wh.allow(true, signalWithStartRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+		return nil, wh.error(wh.createServiceBusyError(true, signalWithStartRequest), scope, tags...)
 	}
 
 	if signalWithStartRequest.GetWorkflowID() == "" {
@@ -2623,10 +2785,14 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(err, scope, tags...)
 	}
 
-	domainID, err := wh.GetDomainCache().GetDomainID(domainName)
+	domainEntry, err := wh.GetDomainCache().GetDomain(domainName)
 	if err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
+	domainID := domainEntry.GetInfo().ID
+	if err := domain.ValidateWorkflowID(domainName, domainEntry.GetInfo().Data, signalWithStartRequest.GetWorkflowID()); err != nil {
+		return nil, wh.error(err, scope, tags...)
+	}
 
 	sizeLimitError := wh.config.BlobSizeLimitError(domainName)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainName)
@@ -2701,7 +2867,7 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	}
 
 	if ok := wh.allow(true, terminateRequest); !ok {
-		return wh.error(createServiceBusyError(), scope, tags...)
+		return wh.error(wh.createServiceBusyError(true, terminateRequest), scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -2756,7 +2922,7 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(
 	}
 
 	if ok := wh.allow(true, resetRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+		return nil, wh.error(wh.createServiceBusyError(true, resetRequest), scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -2810,7 +2976,7 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	}
 
 	if ok := wh.allow(true, cancelRequest); !ok {
-		return wh.error(createServiceBusyError(), scope, tags...)
+		return wh.error(wh.createServiceBusyError(true, cancelRequest), scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -2860,7 +3026,7 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(
 	}
 
 	if ok := wh.allow(true, listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, listRequest), scope)
 	}
 
 	if listRequest.StartTimeFilter == nil {
@@ -2977,7 +3143,7 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
 	}
 
 	if ok := wh.allow(true, listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, listRequest), scope)
 	}
 
 	if listRequest.GetPageSize() <= 0 {
@@ -3012,7 +3178,11 @@ func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(
 		return nil, wh.error(err, scope)
 	}
 
-	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(URI.Scheme(), service.Frontend)
+	visibilityArchiver, err := wh.GetArchiverProvider().GetVisibilityArchiver(
+		URI.Scheme(),
+		entry.GetInfo().Data[common.DomainDataKeyForVisibilityArchivalProvider],
+		service.Frontend,
+	)
 	if err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -3069,7 +3239,7 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(
 	}
 
 	if ok := wh.allow(true, listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, listRequest), scope)
 	}
 
 	if listRequest.StartTimeFilter == nil {
@@ -3209,7 +3379,7 @@ func (wh *WorkflowHandler) ListWorkflowExecutions(
 	}
 
 	if ok := wh.allow(true, listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, listRequest), scope)
 	}
 
 	if listRequest.GetPageSize() <= 0 {
@@ -3277,7 +3447,7 @@ func (wh *WorkflowHandler) ScanWorkflowExecutions(
 	}
 
 	if ok := wh.allow(true, listRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, listRequest), scope)
 	}
 
 	if listRequest.GetPageSize() <= 0 {
@@ -3345,7 +3515,7 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 	}
 
 	if ok := wh.allow(true, countRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, countRequest), scope)
 	}
 
 	validatedQuery, err := wh.visibilityQueryValidator.ValidateQuery(countRequest.GetQuery())
@@ -3363,6 +3533,7 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 		DomainUUID: domainID,
 		Domain:     domain,
 		Query:      validatedQuery,
+		GroupBy:    countRequest.GetGroupBy(),
 	}
 	persistenceResp, err := wh.GetVisibilityManager().CountWorkflowExecutions(ctx, req)
 	if err != nil {
@@ -3370,7 +3541,8 @@ func (wh *WorkflowHandler) CountWorkflowExecutions(
 	}
 
 	resp = &types.CountWorkflowExecutionsResponse{
-		Count: persistenceResp.Count,
+		Count:  persistenceResp.Count,
+		Groups: persistenceResp.Groups,
 	}
 	return resp, nil
 }
@@ -3481,7 +3653,7 @@ func (wh *WorkflowHandler) QueryWorkflow(
 	}
 
 	if ok := wh.allow(true, queryRequest); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+		return nil, wh.error(wh.createServiceBusyError(true, queryRequest), scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -3563,7 +3735,7 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(
 	}
 
 	if ok := wh.allow(true, request); !ok {
-		return nil, wh.error(createServiceBusyError(), scope, tags...)
+		return nil, wh.error(wh.createServiceBusyError(true, request), scope, tags...)
 	}
 
 	if err := validateExecution(wfExecution); err != nil {
@@ -3616,7 +3788,7 @@ func (wh *WorkflowHandler) DescribeTaskList(
 	}
 
 	if ok := wh.allow(true, request); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, request), scope)
 	}
 
 	domainID, err := wh.GetDomainCache().GetDomainID(request.GetDomain())
@@ -3666,7 +3838,7 @@ func (wh *WorkflowHandler) ListTaskListPartitions(
 	}
 
 	if ok := wh.allow(true, request); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, request), scope)
 	}
 
 	if err := wh.validateTaskList(request.TaskList, scope, request.GetDomain()); err != nil {
@@ -3703,7 +3875,7 @@ func (wh *WorkflowHandler) GetTaskListsByDomain(
 	}
 
 	if ok := wh.allow(true, request); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, request), scope)
 	}
 
 	resp, err := wh.GetMatchingClient().GetTaskListsByDomain(ctx, &types.GetTaskListsByDomainRequest{
@@ -4018,8 +4190,9 @@ func (wh *WorkflowHandler) createPollForDecisionTaskResponse(
 	branchToken []byte,
 ) (*types.PollForDecisionTaskResponse, error) {
 
-	if matchingResp.WorkflowExecution == nil {
-		// this will happen if there is no decision task to be send to worker / caller
+	if matchingResp.WorkflowExecution == nil || len(matchingResp.TaskToken) == 0 {
+		// this will happen if there is no decision task to be send to worker / caller,
+		// or the task is a copy mirrored to a shadow tasklist that must not be started
 		return &types.PollForDecisionTaskResponse{}, nil
 	}
 
@@ -4169,6 +4342,20 @@ func verifyHistoryIsComplete(
 	}
 }
 
+// filterHistoryEvents returns the events whose type is one of eventTypes
+func filterHistoryEvents(events []*types.HistoryEvent, eventTypes []types.EventType) []*types.HistoryEvent {
+	filtered := []*types.HistoryEvent{}
+	for _, event := range events {
+		for _, eventType := range eventTypes {
+			if event.GetEventType() == eventType {
+				filtered = append(filtered, event)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
 func deserializeHistoryToken(bytes []byte) (*getHistoryContinuationToken, error) {
 	token := &getHistoryContinuationToken{}
 	err := json.Unmarshal(bytes, token)
@@ -4184,10 +4371,12 @@ func serializeHistoryToken(token *getHistoryContinuationToken) ([]byte, error) {
 	return bytes, err
 }
 
-func createServiceBusyError() *types.ServiceBusyError {
-	err := &types.ServiceBusyError{}
-	err.Message = "Too many outstanding requests to the cadence service"
-	return err
+// createServiceBusyError returns the error of a throttled request, with a hint on when the request can be retried
+func (wh *WorkflowHandler) createServiceBusyError(isUserEndpoint bool, d domainGetter) *types.ServiceBusyError {
+	return common.NewServiceBusyError(
+		"Too many outstanding requests to the cadence service",
+		wh.rateLimiter(isUserEndpoint).RetryAfter(quotas.Info{Domain: getDomain(d)}),
+	)
 }
 
 func isFailoverRequest(updateRequest *types.UpdateDomainRequest) bool {
@@ -4301,7 +4490,11 @@ func (wh *WorkflowHandler) getArchivedHistory(
 		return nil, wh.error(err, scope, tags...)
 	}
 
-	historyArchiver, err := wh.GetArchiverProvider().GetHistoryArchiver(URI.Scheme(), service.Frontend)
+	historyArchiver, err := wh.GetArchiverProvider().GetHistoryArchiver(
+		URI.Scheme(),
+		entry.GetInfo().Data[common.DomainDataKeyForHistoryArchivalProvider],
+		service.Frontend,
+	)
 	if err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
@@ -4342,14 +4535,21 @@ func (wh *WorkflowHandler) isListRequestPageSizeTooLarge(pageSize int32, domain
 }
 
 func (wh *WorkflowHandler) allow(isUserEndpoint bool, d domainGetter) bool {
-	domain := ""
-	if d != nil {
-		domain = d.GetDomain()
-	}
+	return wh.rateLimiter(isUserEndpoint).Allow(quotas.Info{Domain: getDomain(d)})
+}
+
+func (wh *WorkflowHandler) rateLimiter(isUserEndpoint bool) quotas.Policy {
 	if isUserEndpoint {
-		return wh.userRateLimiter.Allow(quotas.Info{Domain: domain})
+		return wh.userRateLimiter
+	}
+	return wh.workerRateLimiter
+}
+
+func getDomain(d domainGetter) string {
+	if d == nil {
+		return ""
 	}
-	return wh.workerRateLimiter.Allow(quotas.Info{Domain: domain})
+	return d.GetDomain()
 }
 
 // GetClusterInfo return information about cadence deployment
@@ -4360,7 +4560,7 @@ func (wh *WorkflowHandler) GetClusterInfo(
 
 	scope := wh.getDefaultScope(ctx, metrics.FrontendClientGetClusterInfoScope)
 	if ok := wh.allow(true, nil); !ok {
-		return nil, wh.error(createServiceBusyError(), scope)
+		return nil, wh.error(wh.createServiceBusyError(true, nil), scope)
 	}
 
 	return &types.ClusterInfo{
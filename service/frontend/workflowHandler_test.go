@@ -511,8 +511,8 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Failure_InvalidArchivalURI() {
 	s.mockMetadataMgr.On("GetDomain", mock.Anything, mock.Anything).Return(nil, &types.EntityNotExistsError{})
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(errors.New("invalid URI"))
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
-	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -538,8 +538,8 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Success_EnabledWithNoArchivalU
 	}, nil)
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
-	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -560,8 +560,8 @@ func (s *workflowHandlerSuite) TestRegisterDomain_Success_EnabledWithArchivalURI
 	}, nil)
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
-	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -674,7 +674,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_UpdateExistingArchivalUR
 	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
 	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -699,7 +699,7 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Failure_InvalidArchivalURI() {
 	s.mockMetadataMgr.On("GetDomain", mock.Anything, mock.Anything).Return(getDomainResp, nil)
 	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(errors.New("invalid URI"))
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -729,8 +729,8 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalEnabledToArchiva
 	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
-	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -793,8 +793,8 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalEnabledToArchiva
 	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
-	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -829,8 +829,8 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalEnabledToEnabled
 	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
-	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -866,8 +866,8 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalNeverEnabledToEn
 	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "some random URI"))
 	s.mockHistoryArchiver.On("ValidateURI", mock.Anything).Return(nil)
 	s.mockVisibilityArchiver.On("ValidateURI", mock.Anything).Return(nil)
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
-	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -1010,7 +1010,7 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_GetFirstPage() {
 		NextPageToken:  nextPageToken,
 		HistoryBatches: []*types.History{historyBatch1, historyBatch2},
 	}, nil)
-	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
+	s.mockArchiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockHistoryArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -1139,7 +1139,7 @@ func (s *workflowHandlerSuite) TestListArchivedVisibility_Success() {
 	), nil).AnyTimes()
 	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("enabled", dc.GetStringPropertyFn("enabled"), dc.GetBoolPropertyFn(true), "disabled", "random URI"))
 	s.mockVisibilityArchiver.On("Query", mock.Anything, mock.Anything, mock.Anything).Return(&archiver.QueryVisibilityResponse{}, nil)
-	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
+	s.mockArchiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.mockVisibilityArchiver, nil)
 
 	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
 
@@ -1221,6 +1221,73 @@ func (s *workflowHandlerSuite) getWorkflowExecutionHistory(nextEventID int64, tr
 	}
 }
 
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionHistory__Success__EventTypesFilter() {
+	// raw history is enabled but must be bypassed so the events can be filtered
+	wh := s.getWorkflowHandler(
+		NewConfig(
+			dc.NewCollection(
+				dc.NewNopClient(),
+				s.mockResource.GetLogger()),
+			numHistoryShards,
+			false,
+			true,
+		),
+	)
+	ctx := context.Background()
+	s.mockDomainCache.EXPECT().GetDomainID(gomock.Any()).Return(s.testDomainID, nil).AnyTimes()
+	s.mockVersionChecker.EXPECT().SupportsRawHistoryQuery(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+			{ID: 2, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+		},
+		NextPageToken: []byte{1},
+	}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything, mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: []*types.HistoryEvent{
+			{ID: 3, EventType: types.EventTypeWorkflowExecutionCompleted.Ptr()},
+		},
+		NextPageToken: []byte{},
+	}, nil).Once()
+	token, _ := json.Marshal(&getHistoryContinuationToken{
+		FirstEventID: 1,
+		NextEventID:  4,
+		RunID:        testRunID,
+		BranchToken:  []byte{1},
+	})
+	resp, err := wh.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain: s.testDomain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+		},
+		MaximumPageSize: 2,
+		SkipArchival:    true,
+		NextPageToken:   token,
+		EventTypes:      []types.EventType{types.EventTypeWorkflowExecutionCompleted},
+	})
+	s.NoError(err)
+	s.Nil(resp.RawHistory)
+	s.Nil(resp.NextPageToken)
+	s.Len(resp.History.Events, 1)
+	s.Equal(int64(3), resp.History.Events[0].ID)
+}
+
+func (s *workflowHandlerSuite) TestGetWorkflowExecutionHistory__Failure__EventTypesWithCloseEventFilter() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	_, err := wh.GetWorkflowExecutionHistory(context.Background(), &types.GetWorkflowExecutionHistoryRequest{
+		Domain: s.testDomain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: testWorkflowID,
+			RunID:      testRunID,
+		},
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+		EventTypes:             []types.EventType{types.EventTypeWorkflowExecutionCompleted},
+	})
+	s.Equal(errEventTypesWithCloseEventFilter, err)
+}
+
 func deserializeBlobDataToHistoryEvents(wh *WorkflowHandler, dataBlobs []*types.DataBlob) []*types.HistoryEvent {
 	var historyEvents []*types.HistoryEvent
 	for _, batch := range dataBlobs {
@@ -1459,6 +1526,58 @@ func (s *workflowHandlerSuite) TestSignalMetricHasSignalName() {
 	s.True(expectedMetrics["test.cadence_errors_bad_request"])
 }
 
+func (s *workflowHandlerSuite) TestSignalBatchWorkflowExecution_InvalidRequest() {
+	config := s.newConfig(dc.NewInMemoryClient())
+	config.SignalBatchMaxSize = dc.GetIntPropertyFilteredByDomain(1)
+	wh := s.getWorkflowHandler(config)
+
+	execution := &types.WorkflowExecution{WorkflowID: testWorkflowID}
+	err := wh.SignalBatchWorkflowExecution(context.Background(), &types.SignalBatchWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: execution,
+	})
+	s.Equal(errSignalBatchNotSet, err)
+
+	err = wh.SignalBatchWorkflowExecution(context.Background(), &types.SignalBatchWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: execution,
+		Signals:           []*types.SignalBatchItem{{SignalName: "first"}, {SignalName: "second"}},
+	})
+	s.Equal(errSignalBatchTooLarge, err)
+
+	config.SignalBatchMaxSize = dc.GetIntPropertyFilteredByDomain(2)
+	config.BlobSizeLimitError = dc.GetIntPropertyFilteredByDomain(10)
+	config.BlobSizeLimitWarn = dc.GetIntPropertyFilteredByDomain(10)
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	err = wh.SignalBatchWorkflowExecution(context.Background(), &types.SignalBatchWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: execution,
+		Signals: []*types.SignalBatchItem{
+			{SignalName: "first", Input: make([]byte, 6)},
+			{SignalName: "second", Input: make([]byte, 6)},
+		},
+	})
+	s.Equal(errSignalBatchSizeTooLarge, err)
+}
+
+func (s *workflowHandlerSuite) TestSignalBatchWorkflowExecution() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+
+	request := &types.SignalBatchWorkflowExecutionRequest{
+		Domain:            s.testDomain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: testWorkflowID},
+		Signals:           []*types.SignalBatchItem{{SignalName: "first"}, {SignalName: "second"}},
+	}
+	s.mockDomainCache.EXPECT().GetDomainID(s.testDomain).Return(s.testDomainID, nil).Times(1)
+	s.mockHistoryClient.EXPECT().SignalBatchWorkflowExecution(gomock.Any(), &types.HistorySignalBatchWorkflowExecutionRequest{
+		DomainUUID:    s.testDomainID,
+		SignalRequest: request,
+	}).Return(nil).Times(1)
+
+	err := wh.SignalBatchWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+}
+
 func (s *workflowHandlerSuite) newConfig(dynamicClient dc.Client) *Config {
 	config := NewConfig(
 		dc.NewCollection(
@@ -34,6 +34,10 @@ import (
 type Config struct {
 	NumberOfShards                  int
 	RPS                             dynamicconfig.IntPropertyFn
+	WorkflowIDSignalRPS             dynamicconfig.IntPropertyFnWithDomainFilter
+	WorkflowIDQueryRPS              dynamicconfig.IntPropertyFnWithDomainFilter
+	WorkflowIDChildStartRPS         dynamicconfig.IntPropertyFnWithDomainFilter
+	WorkflowIDRateLimiterCacheSize  dynamicconfig.IntPropertyFn
 	MaxIDLengthWarnLimit            dynamicconfig.IntPropertyFn
 	DomainNameMaxLength             dynamicconfig.IntPropertyFnWithDomainFilter
 	IdentityMaxLength               dynamicconfig.IntPropertyFnWithDomainFilter
@@ -196,6 +200,7 @@ type Config struct {
 	ReplicatorProcessorEnablePriorityTaskProcessor        dynamicconfig.BoolPropertyFn
 	ReplicatorProcessorFetchTasksBatchSize                dynamicconfig.IntPropertyFnWithShardIDFilter
 	ReplicatorUpperLatency                                dynamicconfig.DurationPropertyFn
+	ReplicatorQueueStatsRetention                         dynamicconfig.DurationPropertyFn
 
 	// Persistence settings
 	ExecutionMgrNumConns dynamicconfig.IntPropertyFn
@@ -245,6 +250,14 @@ type Config struct {
 	HistorySizeLimitWarn   dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
+	// HistoryLimitAtRiskWindow is how far ahead to project history growth when flagging executions at risk
+	HistoryLimitAtRiskWindow dynamicconfig.DurationPropertyFnWithDomainFilter
+	// TaskListPayloadSizeLimitError and TaskListPayloadSizeLimitWarn limit the payload of tasks per tasklist
+	TaskListPayloadSizeLimitError dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	TaskListPayloadSizeLimitWarn  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+	// PayloadSizeNearLimitPercentage and PayloadSizeStatsWindow drive the per domain payload size stats
+	PayloadSizeNearLimitPercentage dynamicconfig.IntPropertyFnWithDomainFilter
+	PayloadSizeStatsWindow         dynamicconfig.DurationPropertyFn
 
 	// ValidSearchAttributes is legal indexed keys that can be used in list APIs
 	ValidSearchAttributes             dynamicconfig.MapPropertyFn
@@ -287,6 +300,9 @@ type Config struct {
 	ReplicationTaskProcessorShardQPS                   dynamicconfig.FloatPropertyFn
 	ReplicationTaskGenerationQPS                       dynamicconfig.FloatPropertyFn
 	EnableReplicationTaskGeneration                    dynamicconfig.BoolPropertyFnWithDomainIDAndWorkflowIDFilter
+	ReplicationTaskCompression                         dynamicconfig.StringPropertyFn
+	ReplicationDLQMessageMaxAge                        dynamicconfig.DurationPropertyFn
+	ReplicationDLQMessageExpiryWarning                 dynamicconfig.DurationPropertyFn
 
 	// The following are used by consistent query
 	EnableConsistentQuery         dynamicconfig.BoolPropertyFn
@@ -349,6 +365,10 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 	cfg := &Config{
 		NumberOfShards:                       numberOfShards,
 		RPS:                                  dc.GetIntProperty(dynamicconfig.HistoryRPS, 3000),
+		WorkflowIDSignalRPS:                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryWorkflowIDSignalRPS, 0),
+		WorkflowIDQueryRPS:                   dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryWorkflowIDQueryRPS, 0),
+		WorkflowIDChildStartRPS:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryWorkflowIDChildStartRPS, 0),
+		WorkflowIDRateLimiterCacheSize:       dc.GetIntProperty(dynamicconfig.HistoryWorkflowIDRateLimiterCacheSize, 10000),
 		MaxIDLengthWarnLimit:                 dc.GetIntProperty(dynamicconfig.MaxIDLengthWarnLimit, common.DefaultIDLengthWarnLimit),
 		DomainNameMaxLength:                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.DomainNameMaxLength, common.DefaultIDLengthErrorLimit),
 		IdentityMaxLength:                    dc.GetIntPropertyFilteredByDomain(dynamicconfig.IdentityMaxLength, common.DefaultIDLengthErrorLimit),
@@ -492,6 +512,7 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ReplicatorProcessorEnablePriorityTaskProcessor:        dc.GetBoolProperty(dynamicconfig.ReplicatorProcessorEnablePriorityTaskProcessor, false),
 		ReplicatorProcessorFetchTasksBatchSize:                dc.GetIntPropertyFilteredByShardID(dynamicconfig.ReplicatorTaskBatchSize, 25),
 		ReplicatorUpperLatency:                                dc.GetDurationProperty(dynamicconfig.ReplicatorUpperLatency, 40*time.Second),
+		ReplicatorQueueStatsRetention:                         dc.GetDurationProperty(dynamicconfig.ReplicatorQueueStatsRetention, 30*time.Minute),
 
 		ExecutionMgrNumConns:       dc.GetIntProperty(dynamicconfig.ExecutionMgrNumConns, 50),
 		HistoryMgrNumConns:         dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
@@ -518,12 +539,19 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ArchiveInlineVisibilityGlobalRPS: dc.GetIntProperty(dynamicconfig.ArchiveInlineVisibilityGlobalRPS, 10000),
 		AllowArchivingIncompleteHistory:  dc.GetBoolProperty(dynamicconfig.AllowArchivingIncompleteHistory, false),
 
-		BlobSizeLimitError:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
-		BlobSizeLimitWarn:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 512*1024),
-		HistorySizeLimitError:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitError, 200*1024*1024),
-		HistorySizeLimitWarn:   dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitWarn, 50*1024*1024),
-		HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
-		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
+		BlobSizeLimitError:       dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitError, 2*1024*1024),
+		BlobSizeLimitWarn:        dc.GetIntPropertyFilteredByDomain(dynamicconfig.BlobSizeLimitWarn, 512*1024),
+		HistorySizeLimitError:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitError, 200*1024*1024),
+		HistorySizeLimitWarn:     dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistorySizeLimitWarn, 50*1024*1024),
+		HistoryCountLimitError:   dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
+		HistoryCountLimitWarn:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
+		HistoryLimitAtRiskWindow: dc.GetDurationPropertyFilteredByDomain(dynamicconfig.HistoryLimitAtRiskWindow, 24*time.Hour),
+
+		TaskListPayloadSizeLimitError: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.TaskListPayloadSizeLimitError, 0),
+		TaskListPayloadSizeLimitWarn:  dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.TaskListPayloadSizeLimitWarn, 0),
+
+		PayloadSizeNearLimitPercentage: dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadSizeNearLimitPercentage, 50),
+		PayloadSizeStatsWindow:         dc.GetDurationProperty(dynamicconfig.PayloadSizeStatsWindow, time.Hour),
 
 		ThrottledLogRPS:   dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 4),
 		EnableStickyQuery: dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableStickyQuery, true),
@@ -559,6 +587,9 @@ func New(dc *dynamicconfig.Collection, numberOfShards int, storeType string, isA
 		ReplicationTaskProcessorShardQPS:                   dc.GetFloat64Property(dynamicconfig.ReplicationTaskProcessorShardQPS, 5),
 		ReplicationTaskGenerationQPS:                       dc.GetFloat64Property(dynamicconfig.ReplicationTaskGenerationQPS, 100),
 		EnableReplicationTaskGeneration:                    dc.GetBoolPropertyFilteredByDomainIDAndWorkflowID(dynamicconfig.EnableReplicationTaskGeneration, true),
+		ReplicationTaskCompression:                         dc.GetStringProperty(dynamicconfig.ReplicationTaskCompression, ""),
+		ReplicationDLQMessageMaxAge:                        dc.GetDurationProperty(dynamicconfig.ReplicationDLQMessageMaxAge, 0),
+		ReplicationDLQMessageExpiryWarning:                 dc.GetDurationProperty(dynamicconfig.ReplicationDLQMessageExpiryWarning, 72*time.Hour),
 
 		EnableConsistentQuery:                 dc.GetBoolProperty(dynamicconfig.EnableConsistentQuery, true),
 		EnableConsistentQueryByDomain:         dc.GetBoolPropertyFilteredByDomain(dynamicconfig.EnableConsistentQueryByDomain, false),
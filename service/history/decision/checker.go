@@ -23,12 +23,15 @@ package decision
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/pborman/uuid"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -58,11 +61,14 @@ type (
 		historyCountLimitWarn  int
 		historyCountLimitError int
 
+		historyLimitAtRiskWindow time.Duration
+
 		completedID    int64
 		mutableState   execution.MutableState
 		executionStats *persistence.ExecutionStats
 		metricsScope   metrics.Scope
 		logger         log.Logger
+		timeSource     clock.TimeSource
 	}
 )
 
@@ -94,24 +100,28 @@ func newWorkflowSizeChecker(
 	historySizeLimitError int,
 	historyCountLimitWarn int,
 	historyCountLimitError int,
+	historyLimitAtRiskWindow time.Duration,
 	completedID int64,
 	mutableState execution.MutableState,
 	executionStats *persistence.ExecutionStats,
 	metricsScope metrics.Scope,
 	logger log.Logger,
+	timeSource clock.TimeSource,
 ) *workflowSizeChecker {
 	return &workflowSizeChecker{
-		blobSizeLimitWarn:      blobSizeLimitWarn,
-		blobSizeLimitError:     blobSizeLimitError,
-		historySizeLimitWarn:   historySizeLimitWarn,
-		historySizeLimitError:  historySizeLimitError,
-		historyCountLimitWarn:  historyCountLimitWarn,
-		historyCountLimitError: historyCountLimitError,
-		completedID:            completedID,
-		mutableState:           mutableState,
-		executionStats:         executionStats,
-		metricsScope:           metricsScope,
-		logger:                 logger,
+		blobSizeLimitWarn:        blobSizeLimitWarn,
+		blobSizeLimitError:       blobSizeLimitError,
+		historySizeLimitWarn:     historySizeLimitWarn,
+		historySizeLimitError:    historySizeLimitError,
+		historyCountLimitWarn:    historyCountLimitWarn,
+		historyCountLimitError:   historyCountLimitError,
+		historyLimitAtRiskWindow: historyLimitAtRiskWindow,
+		completedID:              completedID,
+		mutableState:             mutableState,
+		executionStats:           executionStats,
+		metricsScope:             metricsScope,
+		logger:                   logger,
+		timeSource:               timeSource,
 	}
 }
 
@@ -173,8 +183,34 @@ func (c *workflowSizeChecker) failWorkflowSizeExceedsLimit() (bool, error) {
 		return true, nil
 	}
 
+	executionInfo := c.mutableState.GetExecutionInfo()
+	warning := execution.CheckHistoryLimit(
+		execution.HistoryLimits{
+			CountLimitWarn:  c.historyCountLimitWarn,
+			CountLimitError: c.historyCountLimitError,
+			SizeLimitWarn:   c.historySizeLimitWarn,
+			SizeLimitError:  c.historySizeLimitError,
+			AtRiskWindow:    c.historyLimitAtRiskWindow,
+		},
+		executionInfo.StartTimestamp,
+		c.timeSource.Now(),
+		int64(historyCount),
+		int64(historySize),
+	)
+	if warning == nil {
+		return false, nil
+	}
+	c.metricsScope.IncCounter(metrics.WorkflowHistoryAtRiskCount)
+
+	reachTime := c.timeSource.Now()
+	if warning.EstimatedSecondsToLimit != nil {
+		reachTime = reachTime.Add(time.Duration(*warning.EstimatedSecondsToLimit) * time.Second)
+	}
+	if err := c.mutableState.UpdateHistoryLimitReachTime(reachTime); err != nil {
+		return false, err
+	}
+
 	if historySize > c.historySizeLimitWarn || historyCount > c.historyCountLimitWarn {
-		executionInfo := c.mutableState.GetExecutionInfo()
 		c.logger.Warn("history size exceeds warn limit.",
 			tag.WorkflowDomainID(executionInfo.DomainID),
 			tag.WorkflowID(executionInfo.WorkflowID),
@@ -184,6 +220,12 @@ func (c *workflowSizeChecker) failWorkflowSizeExceedsLimit() (bool, error) {
 		return false, nil
 	}
 
+	c.logger.Warn("history is growing toward error limit.",
+		tag.WorkflowDomainID(executionInfo.DomainID),
+		tag.WorkflowID(executionInfo.WorkflowID),
+		tag.WorkflowRunID(executionInfo.RunID),
+		tag.WorkflowHistorySize(historySize),
+		tag.WorkflowEventCount(historyCount))
 	return false, nil
 }
 
@@ -750,6 +792,19 @@ func (v *attrValidator) validateStartChildExecutionAttributes(
 		return &types.BadRequestError{Message: "WorkflowType exceeds length limit."}
 	}
 
+	// child workflows are held to the workflow ID pattern of the domain they are started in
+	targetDomainEntry, err := v.domainCache.GetDomainByID(targetDomainID)
+	if err != nil {
+		return err
+	}
+	if err := domain.ValidateWorkflowID(
+		targetDomainEntry.GetInfo().Name,
+		targetDomainEntry.GetInfo().Data,
+		attributes.GetWorkflowID(),
+	); err != nil {
+		return err
+	}
+
 	if err := common.ValidateRetryPolicy(attributes.RetryPolicy); err != nil {
 		return err
 	}
@@ -821,6 +876,39 @@ func (v *attrValidator) validatedTaskList(
 	return taskList, nil
 }
 
+// validateTaskListPayloadSize enforces the per tasklist payload size limit, which keeps oversized tasks of one
+// tasklist from reaching matching even when they are within the domain wide blob size limit
+func (v *attrValidator) validateTaskListPayloadSize(
+	domainName string,
+	taskList string,
+	taskType int,
+	payload []byte,
+	metricsScope int,
+) error {
+
+	limitError := v.config.TaskListPayloadSizeLimitError(domainName, taskList, taskType)
+	limitWarn := v.config.TaskListPayloadSizeLimitWarn(domainName, taskList, taskType)
+	if (limitError <= 0 || len(payload) <= limitError) && (limitWarn <= 0 || len(payload) <= limitWarn) {
+		return nil
+	}
+
+	scope := v.metricsClient.Scope(metricsScope).Tagged(metrics.DomainTag(domainName), metrics.TaskListTag(taskList))
+	if limitError > 0 && len(payload) > limitError {
+		scope.IncCounter(metrics.TaskPayloadSizeExceedsLimitCount)
+		return &types.BadRequestError{
+			Message: fmt.Sprintf("Task payload size %v exceeds the limit %v of tasklist %v.", len(payload), limitError, taskList),
+		}
+	}
+
+	scope.IncCounter(metrics.TaskPayloadSizeNearLimitCount)
+	v.logger.Warn("Task payload size is near the tasklist limit.",
+		tag.WorkflowDomainName(domainName),
+		tag.WorkflowTaskListName(taskList),
+		tag.WorkflowSize(int64(len(payload))),
+	)
+	return nil
+}
+
 func (v *attrValidator) validateCrossDomainCall(
 	sourceDomainID string,
 	targetDomainID string,
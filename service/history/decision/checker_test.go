@@ -156,6 +156,39 @@ func (s *attrValidatorSuite) TestValidateSignalExternalWorkflowExecutionAttribut
 	s.NoError(err)
 }
 
+func (s *attrValidatorSuite) TestValidateStartChildExecutionAttributes_WorkflowIDPattern() {
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{Name: s.testDomainID},
+		nil,
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	targetDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{
+			Name: s.testTargetDomainID,
+			Data: map[string]string{common.DomainDataKeyForWorkflowIDPattern: "payments-"},
+		},
+		nil,
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testDomainID).Return(domainEntry, nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(s.testTargetDomainID).Return(targetDomainEntry, nil).AnyTimes()
+
+	parentInfo := &persistence.WorkflowExecutionInfo{TaskList: "parent-task-list"}
+	attributes := &types.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:       s.testTargetDomainID,
+		WorkflowID:   "billing-child",
+		WorkflowType: &types.WorkflowType{Name: "child-type"},
+	}
+	err := s.validator.validateStartChildExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, parentInfo, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.IsType(&types.BadRequestError{}, err)
+
+	attributes.WorkflowID = "payments-child"
+	err = s.validator.validateStartChildExecutionAttributes(s.testDomainID, s.testTargetDomainID, attributes, parentInfo, metrics.HistoryRespondDecisionTaskCompletedScope)
+	s.NoError(err)
+}
+
 func (s *attrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
 	domainName := "testDomain"
 	var attributes *types.UpsertWorkflowSearchAttributesDecisionAttributes
@@ -176,6 +209,42 @@ func (s *attrValidatorSuite) TestValidateUpsertWorkflowSearchAttributes() {
 	s.Nil(err)
 }
 
+func (s *attrValidatorSuite) TestValidateTaskListPayloadSize() {
+	s.validator.config.TaskListPayloadSizeLimitError = func(domain string, taskList string, taskType int) int {
+		if taskList == "limited-tasklist" {
+			return 10
+		}
+		return 0
+	}
+	s.validator.config.TaskListPayloadSizeLimitWarn = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(5)
+
+	testCases := []struct {
+		taskList    string
+		payloadSize int
+		expectErr   bool
+	}{
+		{taskList: "limited-tasklist", payloadSize: 3, expectErr: false},
+		{taskList: "limited-tasklist", payloadSize: 8, expectErr: false},
+		{taskList: "limited-tasklist", payloadSize: 11, expectErr: true},
+		{taskList: "other-tasklist", payloadSize: 11, expectErr: false},
+	}
+
+	for _, tc := range testCases {
+		err := s.validator.validateTaskListPayloadSize(
+			s.testDomainID,
+			tc.taskList,
+			persistence.TaskListTypeActivity,
+			make([]byte, tc.payloadSize),
+			metrics.HistoryRespondDecisionTaskCompletedScope,
+		)
+		if tc.expectErr {
+			s.IsType(&types.BadRequestError{}, err)
+		} else {
+			s.NoError(err)
+		}
+	}
+}
+
 func (s *attrValidatorSuite) TestValidateCrossDomainCall_LocalToLocal() {
 	domainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&persistence.DomainInfo{Name: s.testDomainID},
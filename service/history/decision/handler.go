@@ -428,11 +428,13 @@ Update_History_Loop:
 				handler.config.HistorySizeLimitError(domainName),
 				handler.config.HistoryCountLimitWarn(domainName),
 				handler.config.HistoryCountLimitError(domainName),
+				handler.config.HistoryLimitAtRiskWindow(domainName),
 				completedEvent.ID,
 				msBuilder,
 				executionStats,
 				handler.metricsClient.Scope(metrics.HistoryRespondDecisionTaskCompletedScope, metrics.DomainTag(domainName)),
 				handler.throttledLogger,
+				handler.timeSource,
 			)
 
 			decisionTaskHandler := newDecisionTaskHandler(
@@ -32,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/config"
 	"github.com/uber/cadence/service/history/execution"
@@ -254,6 +255,15 @@ func (handler *taskHandlerImpl) handleDecisionScheduleActivity(
 		return nil, err
 	}
 
+	if err := handler.validateTaskListPayloadSize(
+		attr.GetDomain(),
+		attr.TaskList,
+		persistence.TaskListTypeActivity,
+		attr.Input,
+	); err != nil || handler.stopProcessing {
+		return nil, err
+	}
+
 	event, ai, activityDispatchInfo, err := handler.mutableState.AddActivityTaskScheduledEvent(handler.decisionTaskCompletedID, attr)
 	switch err.(type) {
 	case nil:
@@ -764,6 +774,15 @@ func (handler *taskHandlerImpl) handleDecisionContinueAsNewWorkflow(
 		return err
 	}
 
+	if err := handler.validateTaskListPayloadSize(
+		"",
+		attr.TaskList,
+		persistence.TaskListTypeDecision,
+		attr.Input,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	// If the decision has more than one completion event than just pick the first one
 	if !handler.mutableState.IsWorkflowExecutionRunning() {
 		handler.metricsClient.IncCounter(
@@ -851,6 +870,15 @@ func (handler *taskHandlerImpl) handleDecisionStartChildWorkflow(
 		return err
 	}
 
+	if err := handler.validateTaskListPayloadSize(
+		attr.GetDomain(),
+		attr.TaskList,
+		persistence.TaskListTypeDecision,
+		attr.Input,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
 	enabled := handler.config.EnableParentClosePolicy(handler.domainEntry.GetInfo().Name)
 	if attr.ParentClosePolicy == nil {
 		// for old clients, this field is empty. If they enable the feature, make default as terminate
@@ -1044,6 +1072,32 @@ func (handler *taskHandlerImpl) validateDecisionAttr(
 	return nil
 }
 
+// validateTaskListPayloadSize fails the decision with a dedicated cause when the payload of the task it
+// schedules exceeds the limit of the target tasklist, an empty domain name means the current domain
+func (handler *taskHandlerImpl) validateTaskListPayloadSize(
+	domainName string,
+	taskList *types.TaskList,
+	taskType int,
+	payload []byte,
+) error {
+
+	if domainName == "" {
+		domainName = handler.domainEntry.GetInfo().Name
+	}
+	return handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateTaskListPayloadSize(
+				domainName,
+				taskList.GetName(),
+				taskType,
+				payload,
+				metrics.HistoryRespondDecisionTaskCompletedScope,
+			)
+		},
+		types.DecisionTaskFailedCauseBadTaskListPayloadSize,
+	)
+}
+
 func (handler *taskHandlerImpl) handlerFailDecision(
 	failedCause types.DecisionTaskFailedCause,
 	failMessage string,
@@ -52,6 +52,7 @@ type (
 		RecordActivityTaskHeartbeat(ctx context.Context, request *types.HistoryRecordActivityTaskHeartbeatRequest) (*types.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(ctx context.Context, request *types.HistoryRequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(ctx context.Context, request *types.HistorySignalWorkflowExecutionRequest) error
+		SignalBatchWorkflowExecution(ctx context.Context, request *types.HistorySignalBatchWorkflowExecutionRequest) error
 		SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *types.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *types.HistoryTerminateWorkflowExecutionRequest) error
@@ -63,6 +64,7 @@ type (
 		SyncActivity(ctx context.Context, request *types.SyncActivityRequest) error
 		GetReplicationMessages(ctx context.Context, pollingCluster string, lastReadMessageID int64) (*types.ReplicationMessages, error)
 		GetDLQReplicationMessages(ctx context.Context, taskInfos []*types.ReplicationTaskInfo) ([]*types.ReplicationTask, error)
+		GetDomainReplicationWatermark(ctx context.Context, domainID string) (*int64, error)
 		GetCrossClusterTasks(ctx context.Context, targetCluster string) ([]*types.CrossClusterTaskRequest, error)
 		RespondCrossClusterTasksCompleted(ctx context.Context, targetCluster string, responses []*types.CrossClusterTaskResponse) error
 		QueryWorkflow(ctx context.Context, request *types.HistoryQueryWorkflowRequest) (*types.HistoryQueryWorkflowResponse, error)
@@ -71,12 +73,15 @@ type (
 		PurgeDLQMessages(ctx context.Context, messagesRequest *types.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error
+		ReconcileWorkflowRetention(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error
 		ResetTransferQueue(ctx context.Context, clusterName string) error
 		ResetTimerQueue(ctx context.Context, clusterName string) error
 		ResetCrossClusterQueue(ctx context.Context, clusterName string) error
 		DescribeTransferQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
 		DescribeTimerQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
 		DescribeCrossClusterQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
+		DescribeReplicationQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error)
+		GetReplicationAckStates(ctx context.Context) (*types.GetReplicationAckStatesResponse, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTransferTasks(executionInfo *persistence.WorkflowExecutionInfo, tasks []persistence.Task)
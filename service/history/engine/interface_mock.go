@@ -318,6 +318,20 @@ func (mr *MockEngineMockRecorder) SignalWorkflowExecution(ctx, request interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).SignalWorkflowExecution), ctx, request)
 }
 
+// SignalBatchWorkflowExecution mocks base method
+func (m *MockEngine) SignalBatchWorkflowExecution(ctx context.Context, request *types.HistorySignalBatchWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalBatchWorkflowExecution", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalBatchWorkflowExecution indicates an expected call of SignalBatchWorkflowExecution
+func (mr *MockEngineMockRecorder) SignalBatchWorkflowExecution(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalBatchWorkflowExecution", reflect.TypeOf((*MockEngine)(nil).SignalBatchWorkflowExecution), ctx, request)
+}
+
 // SignalWithStartWorkflowExecution mocks base method
 func (m *MockEngine) SignalWithStartWorkflowExecution(ctx context.Context, request *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -476,6 +490,21 @@ func (mr *MockEngineMockRecorder) GetDLQReplicationMessages(ctx, taskInfos inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQReplicationMessages", reflect.TypeOf((*MockEngine)(nil).GetDLQReplicationMessages), ctx, taskInfos)
 }
 
+// GetDomainReplicationWatermark mocks base method
+func (m *MockEngine) GetDomainReplicationWatermark(ctx context.Context, domainID string) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainReplicationWatermark", ctx, domainID)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainReplicationWatermark indicates an expected call of GetDomainReplicationWatermark
+func (mr *MockEngineMockRecorder) GetDomainReplicationWatermark(ctx, domainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationWatermark", reflect.TypeOf((*MockEngine)(nil).GetDomainReplicationWatermark), ctx, domainID)
+}
+
 // GetCrossClusterTasks mocks base method
 func (m *MockEngine) GetCrossClusterTasks(ctx context.Context, targetCluster string) ([]*types.CrossClusterTaskRequest, error) {
 	m.ctrl.T.Helper()
@@ -592,6 +621,20 @@ func (mr *MockEngineMockRecorder) RefreshWorkflowTasks(ctx, domainUUID, executio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockEngine)(nil).RefreshWorkflowTasks), ctx, domainUUID, execution)
 }
 
+// ReconcileWorkflowRetention mocks base method
+func (m *MockEngine) ReconcileWorkflowRetention(ctx context.Context, domainUUID string, execution types.WorkflowExecution) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileWorkflowRetention", ctx, domainUUID, execution)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileWorkflowRetention indicates an expected call of ReconcileWorkflowRetention
+func (mr *MockEngineMockRecorder) ReconcileWorkflowRetention(ctx, domainUUID, execution interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileWorkflowRetention", reflect.TypeOf((*MockEngine)(nil).ReconcileWorkflowRetention), ctx, domainUUID, execution)
+}
+
 // ResetTransferQueue mocks base method
 func (m *MockEngine) ResetTransferQueue(ctx context.Context, clusterName string) error {
 	m.ctrl.T.Helper()
@@ -679,6 +722,36 @@ func (mr *MockEngineMockRecorder) DescribeCrossClusterQueue(ctx, clusterName int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeCrossClusterQueue", reflect.TypeOf((*MockEngine)(nil).DescribeCrossClusterQueue), ctx, clusterName)
 }
 
+// DescribeReplicationQueue mocks base method
+func (m *MockEngine) DescribeReplicationQueue(ctx context.Context, clusterName string) (*types.DescribeQueueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeReplicationQueue", ctx, clusterName)
+	ret0, _ := ret[0].(*types.DescribeQueueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeReplicationQueue indicates an expected call of DescribeReplicationQueue
+func (mr *MockEngineMockRecorder) DescribeReplicationQueue(ctx, clusterName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeReplicationQueue", reflect.TypeOf((*MockEngine)(nil).DescribeReplicationQueue), ctx, clusterName)
+}
+
+// GetReplicationAckStates mocks base method
+func (m *MockEngine) GetReplicationAckStates(ctx context.Context) (*types.GetReplicationAckStatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationAckStates", ctx)
+	ret0, _ := ret[0].(*types.GetReplicationAckStatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationAckStates indicates an expected call of GetReplicationAckStates
+func (mr *MockEngineMockRecorder) GetReplicationAckStates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationAckStates", reflect.TypeOf((*MockEngine)(nil).GetReplicationAckStates), ctx)
+}
+
 // NotifyNewHistoryEvent mocks base method
 func (m *MockEngine) NotifyNewHistoryEvent(event *events.Notification) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,99 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// HistoryLimits are the per workflow execution history limits used to detect executions at risk
+	HistoryLimits struct {
+		CountLimitWarn  int
+		CountLimitError int
+		SizeLimitWarn   int
+		SizeLimitError  int
+		// AtRiskWindow is how far ahead the current growth rate is projected
+		AtRiskWindow time.Duration
+	}
+)
+
+// CheckHistoryLimit returns a warning if the workflow history has crossed the warn limits,
+// or if at its average growth rate since start it will reach the error limits within the at risk window.
+// It returns nil if the execution is not at risk.
+func CheckHistoryLimit(
+	limits HistoryLimits,
+	startTime time.Time,
+	now time.Time,
+	historyCount int64,
+	historySize int64,
+) *types.HistoryLimitWarning {
+
+	var countRate, sizeRate float64
+	if elapsed := now.Sub(startTime); elapsed > 0 {
+		countRate = float64(historyCount) / elapsed.Seconds()
+		sizeRate = float64(historySize) / elapsed.Seconds()
+	}
+
+	timeToLimit, projected := timeUntilLimit(historyCount, limits.CountLimitError, countRate)
+	if sizeTimeToLimit, ok := timeUntilLimit(historySize, limits.SizeLimitError, sizeRate); ok {
+		if !projected || sizeTimeToLimit < timeToLimit {
+			timeToLimit = sizeTimeToLimit
+		}
+		projected = true
+	}
+
+	exceedsWarn := historyCount > int64(limits.CountLimitWarn) || historySize > int64(limits.SizeLimitWarn)
+	if !exceedsWarn && (!projected || timeToLimit > limits.AtRiskWindow) {
+		return nil
+	}
+
+	warning := &types.HistoryLimitWarning{
+		HistoryCount:      historyCount,
+		HistorySize:       historySize,
+		HistoryCountLimit: int64(limits.CountLimitError),
+		HistorySizeLimit:  int64(limits.SizeLimitError),
+		EventsPerHour:     countRate * time.Hour.Seconds(),
+	}
+	if projected {
+		warning.EstimatedSecondsToLimit = common.Int64Ptr(int64(timeToLimit / time.Second))
+	}
+	return warning
+}
+
+func timeUntilLimit(
+	current int64,
+	limit int,
+	ratePerSecond float64,
+) (time.Duration, bool) {
+
+	if ratePerSecond <= 0 {
+		return 0, false
+	}
+	remaining := int64(limit) - current
+	if remaining <= 0 {
+		return 0, true
+	}
+	return time.Duration(float64(remaining) / ratePerSecond * float64(time.Second)), true
+}
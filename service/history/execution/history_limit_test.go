@@ -0,0 +1,67 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package execution
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckHistoryLimit(t *testing.T) {
+	limits := HistoryLimits{
+		CountLimitWarn:  500,
+		CountLimitError: 1000,
+		SizeLimitWarn:   5000,
+		SizeLimitError:  10000,
+		AtRiskWindow:    time.Hour,
+	}
+	now := time.Unix(0, 0).Add(100 * time.Hour)
+
+	// slow growth below warn limits
+	assert.Nil(t, CheckHistoryLimit(limits, now.Add(-10*time.Hour), now, 100, 1000))
+
+	// no elapsed time, no projection
+	assert.Nil(t, CheckHistoryLimit(limits, now, now, 100, 1000))
+
+	// count over warn limit
+	warning := CheckHistoryLimit(limits, now.Add(-100*time.Hour), now, 600, 1000)
+	assert.NotNil(t, warning)
+	assert.Equal(t, int64(600), warning.GetHistoryCount())
+	assert.Equal(t, int64(1000), warning.GetHistoryCountLimit())
+	assert.Equal(t, int64(10000), warning.GetHistorySizeLimit())
+	assert.InDelta(t, 6, warning.GetEventsPerHour(), 0.001)
+	assert.InDelta(t, (400 * time.Hour / 6).Seconds(), warning.GetEstimatedSecondsToLimit(), 1)
+
+	// fast growth below warn limits, count limit reached in 30 minutes
+	warning = CheckHistoryLimit(limits, now.Add(-30*time.Minute), now, 400, 1000)
+	assert.NotNil(t, warning)
+	assert.InDelta(t, (45 * time.Minute).Seconds(), warning.GetEstimatedSecondsToLimit(), 1)
+
+	// size limit is reached before count limit
+	warning = CheckHistoryLimit(limits, now.Add(-30*time.Minute), now, 400, 4000)
+	assert.NotNil(t, warning)
+	assert.InDelta(t, (45 * time.Minute).Seconds(), warning.GetEstimatedSecondsToLimit(), 1)
+	warning = CheckHistoryLimit(limits, now.Add(-30*time.Minute), now, 100, 4000)
+	assert.NotNil(t, warning)
+	assert.InDelta(t, (45 * time.Minute).Seconds(), warning.GetEstimatedSecondsToLimit(), 1)
+}
@@ -219,6 +219,7 @@ type (
 		UpdateUserTimer(*persistence.TimerInfo) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
 		UpdateWorkflowStateCloseStatus(state int, closeStatus int) error
+		UpdateHistoryLimitReachTime(reachTime time.Time) error
 
 		AddTransferTasks(transferTasks ...persistence.Task)
 		AddCrossClusterTasks(crossClusterTasks ...persistence.Task)
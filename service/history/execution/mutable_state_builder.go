@@ -54,6 +54,8 @@ const (
 	mutableStateInvalidHistoryActionMsgTemplate = mutableStateInvalidHistoryActionMsg + ": %v"
 
 	timerCancellationMsgTimerIDUnknown = "TIMER_ID_UNKNOWN"
+
+	historyLimitReachTimeUpdateThreshold = time.Hour
 )
 
 var (
@@ -3938,6 +3940,38 @@ func (e *mutableStateBuilder) UpdateWorkflowStateCloseStatus(
 	return e.executionInfo.UpdateWorkflowStateCloseStatus(state, closeStatus)
 }
 
+// UpdateHistoryLimitReachTime records the estimated time the history reaches the size or count limit
+// as a search attribute, so that executions at risk can be listed with a single visibility query.
+// Every change is a visibility write, so estimates close to the recorded one are not written.
+func (e *mutableStateBuilder) UpdateHistoryLimitReachTime(
+	reachTime time.Time,
+) error {
+
+	exeInfo := e.executionInfo
+	if bytes, ok := exeInfo.SearchAttributes[definition.HistoryLimitReachTime]; ok {
+		var recorded time.Time
+		if err := json.Unmarshal(bytes, &recorded); err == nil {
+			diff := reachTime.Sub(recorded)
+			if diff < historyLimitReachTimeUpdateThreshold && diff > -historyLimitReachTimeUpdateThreshold {
+				return nil
+			}
+		}
+	}
+
+	bytes, err := json.Marshal(reachTime)
+	if err != nil {
+		return err
+	}
+	if exeInfo.SearchAttributes == nil {
+		exeInfo.SearchAttributes = make(map[string][]byte)
+	}
+	exeInfo.SearchAttributes[definition.HistoryLimitReachTime] = bytes
+	if e.shard.GetConfig().AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
+		return e.taskGenerator.GenerateWorkflowSearchAttrTasks()
+	}
+	return nil
+}
+
 func (e *mutableStateBuilder) StartTransaction(
 	domainEntry *cache.DomainCacheEntry,
 	incomingTaskVersion int64,
@@ -21,6 +21,7 @@
 package execution
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -458,6 +459,27 @@ func (s *mutableStateSuite) TestChecksumShouldInvalidate() {
 	s.False(s.msBuilder.shouldInvalidateChecksum())
 }
 
+func (s *mutableStateSuite) TestUpdateHistoryLimitReachTime() {
+	s.mockShard.GetConfig().AdvancedVisibilityWritingMode = dynamicconfig.GetStringPropertyFn(common.AdvancedVisibilityWritingModeOff)
+	s.msBuilder.executionInfo = &persistence.WorkflowExecutionInfo{}
+	getReachTime := func() time.Time {
+		var reachTime time.Time
+		s.NoError(json.Unmarshal(s.msBuilder.executionInfo.SearchAttributes[definition.HistoryLimitReachTime], &reachTime))
+		return reachTime
+	}
+
+	reachTime := time.Unix(0, 0).Add(100 * time.Hour).UTC()
+	s.NoError(s.msBuilder.UpdateHistoryLimitReachTime(reachTime))
+	s.True(reachTime.Equal(getReachTime()))
+
+	// estimates close to the recorded one are not written
+	s.NoError(s.msBuilder.UpdateHistoryLimitReachTime(reachTime.Add(-time.Minute)))
+	s.True(reachTime.Equal(getReachTime()))
+
+	s.NoError(s.msBuilder.UpdateHistoryLimitReachTime(reachTime.Add(-2 * time.Hour)))
+	s.True(reachTime.Add(-2 * time.Hour).Equal(getReachTime()))
+}
+
 func (s *mutableStateSuite) TestTrimEvents() {
 	var input []*types.HistoryEvent
 	output := s.msBuilder.trimEventsAfterWorkflowClose(input)
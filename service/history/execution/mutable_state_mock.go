@@ -2391,6 +2391,20 @@ func (mr *MockMutableStateMockRecorder) UpdateWorkflowStateCloseStatus(state, cl
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowStateCloseStatus", reflect.TypeOf((*MockMutableState)(nil).UpdateWorkflowStateCloseStatus), state, closeStatus)
 }
 
+// UpdateHistoryLimitReachTime mocks base method
+func (m *MockMutableState) UpdateHistoryLimitReachTime(reachTime time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHistoryLimitReachTime", reachTime)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateHistoryLimitReachTime indicates an expected call of UpdateHistoryLimitReachTime
+func (mr *MockMutableStateMockRecorder) UpdateHistoryLimitReachTime(reachTime interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHistoryLimitReachTime", reflect.TypeOf((*MockMutableState)(nil).UpdateHistoryLimitReachTime), reachTime)
+}
+
 // AddTransferTasks mocks base method
 func (m *MockMutableState) AddTransferTasks(transferTasks ...persistence.Task) {
 	m.ctrl.T.Helper()
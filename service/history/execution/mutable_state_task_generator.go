@@ -50,6 +50,11 @@ type (
 		GenerateWorkflowCloseTasks(
 			closeEvent *types.HistoryEvent,
 		) error
+		// GenerateWorkflowDeletionTask schedules the history deletion timer of a closed
+		// workflow according to the current retention of its domain
+		GenerateWorkflowDeletionTask(
+			closeEvent *types.HistoryEvent,
+		) error
 		GenerateRecordWorkflowStartedTasks(
 			startEvent *types.HistoryEvent,
 		) error
@@ -235,6 +240,14 @@ func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowCloseTasks(
 	r.mutableState.AddTransferTasks(transferTasks...)
 	r.mutableState.AddCrossClusterTasks(crossClusterTasks...)
 
+	return r.GenerateWorkflowDeletionTask(closeEvent)
+}
+
+func (r *mutableStateTaskGeneratorImpl) GenerateWorkflowDeletionTask(
+	closeEvent *types.HistoryEvent,
+) error {
+
+	executionInfo := r.mutableState.GetExecutionInfo()
 	retentionInDays := defaultWorkflowRetentionInDays
 	domainEntry, err := r.domainCache.GetDomainByID(executionInfo.DomainID)
 	switch err.(type) {
@@ -87,6 +87,20 @@ func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowCloseTasks(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowCloseTasks", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowCloseTasks), closeEvent)
 }
 
+// GenerateWorkflowDeletionTask mocks base method
+func (m *MockMutableStateTaskGenerator) GenerateWorkflowDeletionTask(closeEvent *types.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateWorkflowDeletionTask", closeEvent)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GenerateWorkflowDeletionTask indicates an expected call of GenerateWorkflowDeletionTask
+func (mr *MockMutableStateTaskGeneratorMockRecorder) GenerateWorkflowDeletionTask(closeEvent interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateWorkflowDeletionTask", reflect.TypeOf((*MockMutableStateTaskGenerator)(nil).GenerateWorkflowDeletionTask), closeEvent)
+}
+
 // GenerateRecordWorkflowStartedTasks mocks base method
 func (m *MockMutableStateTaskGenerator) GenerateRecordWorkflowStartedTasks(startEvent *types.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -68,6 +68,11 @@ func (g grpcHandler) DescribeQueue(ctx context.Context, request *historyv1.Descr
 	return proto.FromHistoryDescribeQueueResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) GetReplicationAckStates(ctx context.Context, request *historyv1.GetReplicationAckStatesRequest) (*historyv1.GetReplicationAckStatesResponse, error) {
+	response, err := g.h.GetReplicationAckStates(ctx, proto.ToHistoryGetReplicationAckStatesRequest(request))
+	return proto.FromHistoryGetReplicationAckStatesResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) DescribeWorkflowExecution(ctx context.Context, request *historyv1.DescribeWorkflowExecutionRequest) (*historyv1.DescribeWorkflowExecutionResponse, error) {
 	response, err := g.h.DescribeWorkflowExecution(ctx, proto.ToHistoryDescribeWorkflowExecutionRequest(request))
 	return proto.FromHistoryDescribeWorkflowExecutionResponse(response), proto.FromError(err)
@@ -88,6 +93,11 @@ func (g grpcHandler) GetFailoverInfo(ctx context.Context, request *historyv1.Get
 	return proto.FromHistoryGetFailoverInfoResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) GetDomainReplicationWatermark(ctx context.Context, request *historyv1.GetDomainReplicationWatermarkRequest) (*historyv1.GetDomainReplicationWatermarkResponse, error) {
+	response, err := g.h.GetDomainReplicationWatermark(ctx, proto.ToHistoryGetDomainReplicationWatermarkRequest(request))
+	return proto.FromHistoryGetDomainReplicationWatermarkResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) GetMutableState(ctx context.Context, request *historyv1.GetMutableStateRequest) (*historyv1.GetMutableStateResponse, error) {
 	response, err := g.h.GetMutableState(ctx, proto.ToHistoryGetMutableStateRequest(request))
 	return proto.FromHistoryGetMutableStateResponse(response), proto.FromError(err)
@@ -158,6 +168,11 @@ func (g grpcHandler) RefreshWorkflowTasks(ctx context.Context, request *historyv
 	return &historyv1.RefreshWorkflowTasksResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) ReconcileWorkflowRetention(ctx context.Context, request *historyv1.ReconcileWorkflowRetentionRequest) (*historyv1.ReconcileWorkflowRetentionResponse, error) {
+	err := g.h.ReconcileWorkflowRetention(ctx, proto.ToHistoryReconcileWorkflowRetentionRequest(request))
+	return &historyv1.ReconcileWorkflowRetentionResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) RemoveSignalMutableState(ctx context.Context, request *historyv1.RemoveSignalMutableStateRequest) (*historyv1.RemoveSignalMutableStateResponse, error) {
 	err := g.h.RemoveSignalMutableState(ctx, proto.ToHistoryRemoveSignalMutableStateRequest(request))
 	return &historyv1.RemoveSignalMutableStateResponse{}, proto.FromError(err)
@@ -238,6 +253,11 @@ func (g grpcHandler) SignalWorkflowExecution(ctx context.Context, request *histo
 	return &historyv1.SignalWorkflowExecutionResponse{}, proto.FromError(err)
 }
 
+func (g grpcHandler) SignalBatchWorkflowExecution(ctx context.Context, request *historyv1.SignalBatchWorkflowExecutionRequest) (*historyv1.SignalBatchWorkflowExecutionResponse, error) {
+	err := g.h.SignalBatchWorkflowExecution(ctx, proto.ToHistorySignalBatchWorkflowExecutionRequest(request))
+	return &historyv1.SignalBatchWorkflowExecutionResponse{}, proto.FromError(err)
+}
+
 func (g grpcHandler) StartWorkflowExecution(ctx context.Context, request *historyv1.StartWorkflowExecutionRequest) (*historyv1.StartWorkflowExecutionResponse, error) {
 	response, err := g.h.StartWorkflowExecution(ctx, proto.ToHistoryStartWorkflowExecutionRequest(request))
 	return proto.FromHistoryStartWorkflowExecutionResponse(response), proto.FromError(err)
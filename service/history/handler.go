@@ -37,6 +37,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/future"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -53,6 +54,7 @@ import (
 	"github.com/uber/cadence/service/history/resource"
 	"github.com/uber/cadence/service/history/shard"
 	"github.com/uber/cadence/service/history/task"
+	"github.com/uber/cadence/service/history/workflow"
 )
 
 const shardOwnershipTransferDelay = 5 * time.Second
@@ -72,6 +74,7 @@ type (
 		GetCrossClusterTasks(context.Context, *types.GetCrossClusterTasksRequest) (*types.GetCrossClusterTasksResponse, error)
 		GetDLQReplicationMessages(context.Context, *types.GetDLQReplicationMessagesRequest) (*types.GetDLQReplicationMessagesResponse, error)
 		GetMutableState(context.Context, *types.GetMutableStateRequest) (*types.GetMutableStateResponse, error)
+		GetReplicationAckStates(context.Context, *types.GetReplicationAckStatesRequest) (*types.GetReplicationAckStatesResponse, error)
 		GetReplicationMessages(context.Context, *types.GetReplicationMessagesRequest) (*types.GetReplicationMessagesResponse, error)
 		MergeDLQMessages(context.Context, *types.MergeDLQMessagesRequest) (*types.MergeDLQMessagesResponse, error)
 		NotifyFailoverMarkers(context.Context, *types.NotifyFailoverMarkersRequest) error
@@ -85,6 +88,7 @@ type (
 		RecordChildExecutionCompleted(context.Context, *types.RecordChildExecutionCompletedRequest) error
 		RecordDecisionTaskStarted(context.Context, *types.RecordDecisionTaskStartedRequest) (*types.RecordDecisionTaskStartedResponse, error)
 		RefreshWorkflowTasks(context.Context, *types.HistoryRefreshWorkflowTasksRequest) error
+		ReconcileWorkflowRetention(context.Context, *types.ReconcileWorkflowRetentionRequest) error
 		RemoveSignalMutableState(context.Context, *types.RemoveSignalMutableStateRequest) error
 		RemoveTask(context.Context, *types.RemoveTaskRequest) error
 		ReplicateEventsV2(context.Context, *types.ReplicateEventsV2Request) error
@@ -101,11 +105,13 @@ type (
 		ScheduleDecisionTask(context.Context, *types.ScheduleDecisionTaskRequest) error
 		SignalWithStartWorkflowExecution(context.Context, *types.HistorySignalWithStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		SignalWorkflowExecution(context.Context, *types.HistorySignalWorkflowExecutionRequest) error
+		SignalBatchWorkflowExecution(context.Context, *types.HistorySignalBatchWorkflowExecutionRequest) error
 		StartWorkflowExecution(context.Context, *types.HistoryStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error)
 		SyncActivity(context.Context, *types.SyncActivityRequest) error
 		SyncShardStatus(context.Context, *types.SyncShardStatusRequest) error
 		TerminateWorkflowExecution(context.Context, *types.HistoryTerminateWorkflowExecutionRequest) error
 		GetFailoverInfo(context.Context, *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error)
+		GetDomainReplicationWatermark(context.Context, *types.HistoryGetDomainReplicationWatermarkRequest) (*types.HistoryGetDomainReplicationWatermarkResponse, error)
 	}
 
 	// handlerImpl is an implementation for history service independent of wire protocol
@@ -119,6 +125,8 @@ type (
 		config                   *config.Config
 		historyEventNotifier     events.Notifier
 		rateLimiter              quotas.Limiter
+		workflowIDRateLimiter    *workflow.IDRateLimiter
+		payloadSizeStats         *workflow.PayloadSizeStats
 		crossClusterTaskFetchers task.Fetchers
 		replicationTaskFetchers  replication.TaskFetchers
 		queueTaskProcessor       task.Processor
@@ -152,6 +160,14 @@ func NewHandler(
 		config:          config,
 		tokenSerializer: common.NewJSONTaskTokenSerializer(),
 		rateLimiter:     quotas.NewDynamicRateLimiter(config.RPS.AsFloat64()),
+		workflowIDRateLimiter: workflow.NewIDRateLimiter(
+			config.WorkflowIDRateLimiterCacheSize(),
+			map[workflow.IDRequestType]dynamicconfig.IntPropertyFnWithDomainFilter{
+				workflow.IDRequestTypeSignal: config.WorkflowIDSignalRPS,
+				workflow.IDRequestTypeQuery:  config.WorkflowIDQueryRPS,
+			},
+		),
+		payloadSizeStats: workflow.NewPayloadSizeStats(config.PayloadSizeStatsWindow, resource.GetTimeSource()),
 	}
 
 	// prevent us from trying to serve requests before shard controller is started and ready
@@ -471,6 +487,7 @@ func (h *handlerImpl) RespondActivityTaskCompleted(
 		return h.error(err0, scope, domainID, "")
 	}
 	workflowID := token.WorkflowID
+	h.recordPayloadSize(scope, domainID, workflow.PayloadTypeActivityResult, completeRequest.Result)
 
 	engine, err1 := h.controller.GetEngine(workflowID)
 	if err1 != nil {
@@ -621,6 +638,7 @@ func (h *handlerImpl) RespondDecisionTaskCompleted(
 		return nil, h.error(err0, scope, domainID, "")
 	}
 	workflowID := token.WorkflowID
+	h.recordDecisionPayloadSizes(scope, domainID, completeRequest.Decisions)
 
 	engine, err1 := h.controller.GetEngine(workflowID)
 	if err1 != nil {
@@ -766,6 +784,7 @@ func (h *handlerImpl) DescribeHistoryHost(
 		},
 		ShardControllerStatus: status,
 		Address:               h.GetHostInfo().GetAddress(),
+		PayloadSizeStats:      h.payloadSizeStats.Describe(),
 	}
 	return resp, nil
 }
@@ -871,6 +890,8 @@ func (h *handlerImpl) DescribeQueue(
 		resp, err = engine.DescribeTimerQueue(ctx, request.GetClusterName())
 	case common.TaskTypeCrossCluster:
 		resp, err = engine.DescribeCrossClusterQueue(ctx, request.GetClusterName())
+	case common.TaskTypeReplication:
+		resp, err = engine.DescribeReplicationQueue(ctx, request.GetClusterName())
 	default:
 		err = errInvalidTaskType
 	}
@@ -881,6 +902,30 @@ func (h *handlerImpl) DescribeQueue(
 	return resp, nil
 }
 
+// GetReplicationAckStates returns the replication ack state of every remote cluster on the shard
+func (h *handlerImpl) GetReplicationAckStates(
+	ctx context.Context,
+	request *types.GetReplicationAckStatesRequest,
+) (resp *types.GetReplicationAckStatesResponse, retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetReplicationAckStatesScope)
+	defer sw.Stop()
+
+	engine, err := h.controller.GetEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.GetReplicationAckStates(ctx)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
 // DescribeMutableState - returns the internal analysis of workflow execution state
 func (h *handlerImpl) DescribeMutableState(
 	ctx context.Context,
@@ -1091,6 +1136,10 @@ func (h *handlerImpl) SignalWorkflowExecution(
 
 	workflowExecution := wrappedRequest.SignalRequest.WorkflowExecution
 	workflowID := workflowExecution.GetWorkflowID()
+	if err := h.allowWorkflowIDRequest(scope, domainID, workflowID, workflow.IDRequestTypeSignal); err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
 	engine, err1 := h.controller.GetEngine(workflowID)
 	if err1 != nil {
 		return h.error(err1, scope, domainID, workflowID)
@@ -1104,6 +1153,51 @@ func (h *handlerImpl) SignalWorkflowExecution(
 	return nil
 }
 
+// SignalBatchWorkflowExecution is used to send several signal events to a running workflow execution in one
+// history transaction. Only one decision task is created for them.
+func (h *handlerImpl) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	wrappedRequest *types.HistorySignalBatchWorkflowExecutionRequest,
+) (retError error) {
+
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistorySignalBatchWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.GetSignalRequest().GetWorkflowExecution()
+	workflowID := workflowExecution.GetWorkflowID()
+	if err := h.allowWorkflowIDRequest(scope, domainID, workflowID, workflow.IDRequestTypeSignal); err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.SignalBatchWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -1135,6 +1229,10 @@ func (h *handlerImpl) SignalWithStartWorkflowExecution(
 
 	signalWithStartRequest := wrappedRequest.SignalWithStartRequest
 	workflowID := signalWithStartRequest.GetWorkflowID()
+	if err := h.allowWorkflowIDRequest(scope, domainID, workflowID, workflow.IDRequestTypeSignal); err != nil {
+		return nil, h.error(err, scope, domainID, workflowID)
+	}
+
 	engine, err1 := h.controller.GetEngine(workflowID)
 	if err1 != nil {
 		return nil, h.error(err1, scope, domainID, workflowID)
@@ -1296,6 +1394,10 @@ func (h *handlerImpl) QueryWorkflow(
 	}
 
 	workflowID := request.GetRequest().GetExecution().GetWorkflowID()
+	if err := h.allowWorkflowIDRequest(scope, domainID, workflowID, workflow.IDRequestTypeQuery); err != nil {
+		return nil, h.error(err, scope, domainID, workflowID)
+	}
+
 	engine, err1 := h.controller.GetEngine(workflowID)
 	if err1 != nil {
 		return nil, h.error(err1, scope, domainID, workflowID)
@@ -1592,6 +1694,7 @@ func (h *handlerImpl) GetReplicationMessages(
 		return nil, errShuttingDown
 	}
 
+	compression := h.replicationTaskCompression(request.GetSupportedCompressions())
 	var wg sync.WaitGroup
 	wg.Add(len(request.Tokens))
 	result := new(sync.Map)
@@ -1614,6 +1717,11 @@ func (h *handlerImpl) GetReplicationMessages(
 				h.GetLogger().Warn("Failed to get replication tasks for shard", tag.Error(err))
 				return
 			}
+			if compression != nil && tasks != nil {
+				compressedTasks := *tasks
+				compressedTasks.ReplicationTasks = h.compressReplicationTasks(tasks.ReplicationTasks, *compression)
+				tasks = &compressedTasks
+			}
 
 			result.Store(token.GetShardID(), tasks)
 		}(token)
@@ -1704,11 +1812,38 @@ func (h *handlerImpl) GetDLQReplicationMessages(
 	for task := range tasksChan {
 		replicationTasks = append(replicationTasks, task)
 	}
+	if compression := h.replicationTaskCompression(request.GetSupportedCompressions()); compression != nil {
+		replicationTasks = h.compressReplicationTasks(replicationTasks, *compression)
+	}
 	return &types.GetDLQReplicationMessagesResponse{
 		ReplicationTasks: replicationTasks,
 	}, nil
 }
 
+// replicationTaskCompression returns the compression to send replication task events to the requesting
+// cluster with, nil to send them uncompressed
+func (h *handlerImpl) replicationTaskCompression(supported []types.CompressionType) *types.CompressionType {
+	compression, err := replication.NegotiateCompression(h.config.ReplicationTaskCompression(), supported)
+	if err != nil {
+		h.GetLogger().Warn("Invalid replication task compression, sending replication tasks uncompressed.", tag.Error(err))
+		return nil
+	}
+	return compression
+}
+
+// compressReplicationTasks falls back to the uncompressed tasks if they fail to compress
+func (h *handlerImpl) compressReplicationTasks(
+	tasks []*types.ReplicationTask,
+	compression types.CompressionType,
+) []*types.ReplicationTask {
+	compressedTasks, err := replication.CompressTasks(tasks, compression)
+	if err != nil {
+		h.GetLogger().Warn("Failed to compress replication tasks, sending them uncompressed.", tag.Error(err))
+		return tasks
+	}
+	return compressedTasks
+}
+
 // ReapplyEvents applies stale events to the current workflow and the current run
 func (h *handlerImpl) ReapplyEvents(
 	ctx context.Context,
@@ -1861,6 +1996,51 @@ func (h *handlerImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// ReconcileWorkflowRetention reschedules the history deletion timer of a closed workflow
+// according to the current retention of its domain
+func (h *handlerImpl) ReconcileWorkflowRetention(
+	ctx context.Context,
+	request *types.ReconcileWorkflowRetentionRequest,
+) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryReconcileWorkflowRetentionScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return errShuttingDown
+	}
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+	execution := request.GetWorkflowExecution()
+	workflowID := execution.GetWorkflowID()
+	if workflowID == "" {
+		return h.error(errWorkflowIDNotSet, scope, domainID, workflowID)
+	}
+	engine, err := h.controller.GetEngine(workflowID)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
+	err = engine.ReconcileWorkflowRetention(
+		ctx,
+		domainID,
+		types.WorkflowExecution{
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+		},
+	)
+	if err != nil {
+		return h.error(err, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // NotifyFailoverMarkers sends the failover markers to failover coordinator.
 // The coordinator decides when the failover finishes based on received failover marker.
 func (h *handlerImpl) NotifyFailoverMarkers(
@@ -1999,6 +2179,44 @@ func (h *handlerImpl) GetFailoverInfo(
 	return resp, nil
 }
 
+// GetDomainReplicationWatermark returns the event time of the latest history event of the domain
+// replicated to the current cluster, across the requested shards owned by this host
+func (h *handlerImpl) GetDomainReplicationWatermark(
+	ctx context.Context,
+	request *types.HistoryGetDomainReplicationWatermarkRequest,
+) (resp *types.HistoryGetDomainReplicationWatermarkResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope, sw := h.startRequestProfile(ctx, metrics.HistoryGetDomainReplicationWatermarkScope)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	domainID := request.GetDomainID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	resp = &types.HistoryGetDomainReplicationWatermarkResponse{}
+	for _, shardID := range request.GetShardIDs() {
+		engine, err := h.controller.GetEngineForShard(int(shardID))
+		if err != nil {
+			return nil, h.error(err, scope, domainID, "")
+		}
+		watermark, err := engine.GetDomainReplicationWatermark(ctx, domainID)
+		if err != nil {
+			return nil, h.error(err, scope, domainID, "")
+		}
+		if watermark != nil && *watermark > resp.GetLastReplicatedEventTime() {
+			resp.LastReplicatedEventTime = watermark
+		}
+	}
+	return resp, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -2087,6 +2305,26 @@ func (h *handlerImpl) error(
 	return err
 }
 
+func (h *handlerImpl) allowWorkflowIDRequest(
+	scope metrics.Scope,
+	domainID string,
+	workflowID string,
+	requestType workflow.IDRequestType,
+) error {
+
+	domainName, err := h.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		// throttling is best effort, do not fail the request when the domain cannot be resolved
+		return nil
+	}
+
+	if err := h.workflowIDRateLimiter.Allow(domainID, domainName, workflowID, requestType); err != nil {
+		scope.IncCounter(metrics.WorkflowIDRateLimitedCounter)
+		return err
+	}
+	return nil
+}
+
 func (h *handlerImpl) getLoggerWithTags(
 	domainID string,
 	workflowID string,
@@ -2123,6 +2361,59 @@ func (h *handlerImpl) startRequestProfile(ctx context.Context, scope int) (metri
 	return metricsScope, sw
 }
 
+// recordDecisionPayloadSizes records the size of the activity inputs, workflow inputs and workflow results
+// carried by the decisions of a completed decision task
+func (h *handlerImpl) recordDecisionPayloadSizes(
+	scope metrics.Scope,
+	domainID string,
+	decisions []*types.Decision,
+) {
+	for _, decision := range decisions {
+		if decision.DecisionType == nil {
+			continue
+		}
+		switch *decision.DecisionType {
+		case types.DecisionTypeScheduleActivityTask:
+			h.recordPayloadSize(scope, domainID, workflow.PayloadTypeActivityInput, decision.ScheduleActivityTaskDecisionAttributes.GetInput())
+		case types.DecisionTypeStartChildWorkflowExecution:
+			h.recordPayloadSize(scope, domainID, workflow.PayloadTypeDecisionInput, decision.StartChildWorkflowExecutionDecisionAttributes.GetInput())
+		case types.DecisionTypeContinueAsNewWorkflowExecution:
+			h.recordPayloadSize(scope, domainID, workflow.PayloadTypeDecisionInput, decision.ContinueAsNewWorkflowExecutionDecisionAttributes.GetInput())
+		case types.DecisionTypeCompleteWorkflowExecution:
+			h.recordPayloadSize(scope, domainID, workflow.PayloadTypeDecisionResult, decision.CompleteWorkflowExecutionDecisionAttributes.GetResult())
+		}
+	}
+}
+
+// recordPayloadSize adds a payload to the size distribution of its domain, and warns once per stats window
+// when the domain has payloads trending toward the blob size limit
+func (h *handlerImpl) recordPayloadSize(
+	scope metrics.Scope,
+	domainID string,
+	payloadType workflow.PayloadType,
+	payload []byte,
+) {
+	domainName, err := h.GetDomainCache().GetDomainName(domainID)
+	if err != nil {
+		return
+	}
+
+	size := len(payload)
+	payloadScope := scope.Tagged(metrics.DomainTag(domainName), metrics.PayloadTypeTag(payloadType.String()))
+	payloadScope.RecordHistogramValue(metrics.PayloadSize, float64(size))
+
+	nearLimit := h.config.BlobSizeLimitError(domainName) * h.config.PayloadSizeNearLimitPercentage(domainName) / 100
+	if size > nearLimit {
+		payloadScope.IncCounter(metrics.PayloadSizeNearLimitCount)
+	}
+	if h.payloadSizeStats.Record(domainName, payloadType, size, nearLimit) {
+		h.GetLogger().Warn("Domain payloads are trending toward blob size limit.",
+			tag.WorkflowDomainName(domainName),
+			tag.PayloadType(payloadType.String()),
+			tag.WorkflowSize(int64(size)))
+	}
+}
+
 func validateTaskToken(token *common.TaskToken) error {
 	if token.WorkflowID == "" {
 		return errWorkflowIDNotSet
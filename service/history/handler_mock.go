@@ -171,6 +171,21 @@ func (mr *MockHandlerMockRecorder) DescribeQueue(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeQueue", reflect.TypeOf((*MockHandler)(nil).DescribeQueue), arg0, arg1)
 }
 
+// GetReplicationAckStates mocks base method
+func (m *MockHandler) GetReplicationAckStates(arg0 context.Context, arg1 *types.GetReplicationAckStatesRequest) (*types.GetReplicationAckStatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplicationAckStates", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetReplicationAckStatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplicationAckStates indicates an expected call of GetReplicationAckStates
+func (mr *MockHandlerMockRecorder) GetReplicationAckStates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplicationAckStates", reflect.TypeOf((*MockHandler)(nil).GetReplicationAckStates), arg0, arg1)
+}
+
 // DescribeWorkflowExecution mocks base method
 func (m *MockHandler) DescribeWorkflowExecution(arg0 context.Context, arg1 *types.HistoryDescribeWorkflowExecutionRequest) (*types.DescribeWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -421,6 +436,20 @@ func (mr *MockHandlerMockRecorder) RefreshWorkflowTasks(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshWorkflowTasks", reflect.TypeOf((*MockHandler)(nil).RefreshWorkflowTasks), arg0, arg1)
 }
 
+// ReconcileWorkflowRetention mocks base method
+func (m *MockHandler) ReconcileWorkflowRetention(arg0 context.Context, arg1 *types.ReconcileWorkflowRetentionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileWorkflowRetention", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileWorkflowRetention indicates an expected call of ReconcileWorkflowRetention
+func (mr *MockHandlerMockRecorder) ReconcileWorkflowRetention(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileWorkflowRetention", reflect.TypeOf((*MockHandler)(nil).ReconcileWorkflowRetention), arg0, arg1)
+}
+
 // RemoveSignalMutableState mocks base method
 func (m *MockHandler) RemoveSignalMutableState(arg0 context.Context, arg1 *types.RemoveSignalMutableStateRequest) error {
 	m.ctrl.T.Helper()
@@ -650,6 +679,20 @@ func (mr *MockHandlerMockRecorder) SignalWorkflowExecution(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).SignalWorkflowExecution), arg0, arg1)
 }
 
+// SignalBatchWorkflowExecution mocks base method
+func (m *MockHandler) SignalBatchWorkflowExecution(arg0 context.Context, arg1 *types.HistorySignalBatchWorkflowExecutionRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignalBatchWorkflowExecution", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SignalBatchWorkflowExecution indicates an expected call of SignalBatchWorkflowExecution
+func (mr *MockHandlerMockRecorder) SignalBatchWorkflowExecution(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignalBatchWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).SignalBatchWorkflowExecution), arg0, arg1)
+}
+
 // StartWorkflowExecution mocks base method
 func (m *MockHandler) StartWorkflowExecution(arg0 context.Context, arg1 *types.HistoryStartWorkflowExecutionRequest) (*types.StartWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -707,6 +750,21 @@ func (mr *MockHandlerMockRecorder) TerminateWorkflowExecution(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TerminateWorkflowExecution", reflect.TypeOf((*MockHandler)(nil).TerminateWorkflowExecution), arg0, arg1)
 }
 
+// GetDomainReplicationWatermark mocks base method
+func (m *MockHandler) GetDomainReplicationWatermark(arg0 context.Context, arg1 *types.HistoryGetDomainReplicationWatermarkRequest) (*types.HistoryGetDomainReplicationWatermarkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDomainReplicationWatermark", arg0, arg1)
+	ret0, _ := ret[0].(*types.HistoryGetDomainReplicationWatermarkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDomainReplicationWatermark indicates an expected call of GetDomainReplicationWatermark
+func (mr *MockHandlerMockRecorder) GetDomainReplicationWatermark(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainReplicationWatermark", reflect.TypeOf((*MockHandler)(nil).GetDomainReplicationWatermark), arg0, arg1)
+}
+
 // GetFailoverInfo mocks base method
 func (m *MockHandler) GetFailoverInfo(arg0 context.Context, arg1 *types.GetFailoverInfoRequest) (*types.GetFailoverInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -32,6 +32,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/types"
@@ -148,3 +149,36 @@ func (s *handlerSuite) testRespondCrossClusterTaskCompleted(
 		s.Len(response.Tasks, numTasks)
 	}
 }
+
+func (s *handlerSuite) TestRecordDecisionPayloadSizes() {
+	domainID := "some random domain ID"
+	domainName := "some random domain name"
+	s.mockResource.DomainCache.EXPECT().GetDomainName(domainID).Return(domainName, nil).Times(3)
+	s.handler.config.BlobSizeLimitError = dynamicconfig.GetIntPropertyFilteredByDomain(100)
+
+	decisions := []*types.Decision{
+		{
+			DecisionType:                           types.DecisionTypeScheduleActivityTask.Ptr(),
+			ScheduleActivityTaskDecisionAttributes: &types.ScheduleActivityTaskDecisionAttributes{Input: make([]byte, 10)},
+		},
+		{
+			DecisionType:                           types.DecisionTypeScheduleActivityTask.Ptr(),
+			ScheduleActivityTaskDecisionAttributes: &types.ScheduleActivityTaskDecisionAttributes{Input: make([]byte, 60)},
+		},
+		{
+			DecisionType:                   types.DecisionTypeRecordMarker.Ptr(),
+			RecordMarkerDecisionAttributes: &types.RecordMarkerDecisionAttributes{Details: make([]byte, 90)},
+		},
+		{
+			DecisionType: types.DecisionTypeCompleteWorkflowExecution.Ptr(),
+			CompleteWorkflowExecutionDecisionAttributes: &types.CompleteWorkflowExecutionDecisionAttributes{Result: make([]byte, 20)},
+		},
+	}
+	s.handler.recordDecisionPayloadSizes(metrics.NoopScope(metrics.History), domainID, decisions)
+
+	// only payloads above half of the blob size limit count as near the limit by default
+	s.Equal([]*types.DomainPayloadSizeStats{
+		{DomainName: domainName, PayloadType: "activity_input", Count: 2, AverageSize: 35, MaxSize: 60, NearLimitCount: 1},
+		{DomainName: domainName, PayloadType: "decision_result", Count: 1, AverageSize: 20, MaxSize: 20},
+	}, s.handler.payloadSizeStats.Describe())
+}
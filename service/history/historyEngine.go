@@ -41,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/domain"
 	ce "github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -81,7 +82,8 @@ const (
 )
 
 var (
-	errDomainDeprecated = &types.BadRequestError{Message: "Domain is deprecated."}
+	errDomainDeprecated  = &types.BadRequestError{Message: "Domain is deprecated."}
+	errDomainQuarantined = &types.BadRequestError{Message: "Domain is quarantined, new workflows and signals are rejected."}
 )
 
 type (
@@ -591,6 +593,9 @@ func (e *historyEngineImpl) startWorkflowHelper(
 	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
 		return nil, errDomainDeprecated
 	}
+	if domain.IsQuarantined(domainEntry.GetInfo().Data) {
+		return nil, errDomainQuarantined
+	}
 
 	request := startRequest.StartRequest
 	err := e.validateStartWorkflowExecutionRequest(request, metricsScope)
@@ -1666,6 +1671,23 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		result.PendingDecision = pendingDecision
 	}
 
+	if mutableState.IsWorkflowExecutionRunning() {
+		domainName := mutableState.GetDomainEntry().GetInfo().Name
+		result.HistoryLimitWarning = execution.CheckHistoryLimit(
+			execution.HistoryLimits{
+				CountLimitWarn:  e.config.HistoryCountLimitWarn(domainName),
+				CountLimitError: e.config.HistoryCountLimitError(domainName),
+				SizeLimitWarn:   e.config.HistorySizeLimitWarn(domainName),
+				SizeLimitError:  e.config.HistorySizeLimitError(domainName),
+				AtRiskWindow:    e.config.HistoryLimitAtRiskWindow(domainName),
+			},
+			executionInfo.StartTimestamp,
+			e.timeSource.Now(),
+			result.WorkflowExecutionInfo.HistoryLength,
+			wfContext.GetHistorySize(),
+		)
+	}
+
 	return result, nil
 }
 
@@ -2204,21 +2226,71 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	signalRequest *types.HistorySignalWorkflowExecutionRequest,
 ) error {
 
-	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(signalRequest.DomainUUID)
+	request := signalRequest.SignalRequest
+	return e.signalWorkflowExecution(
+		ctx,
+		signalRequest.DomainUUID,
+		request.GetWorkflowExecution(),
+		[]*types.SignalBatchItem{{
+			SignalName: request.GetSignalName(),
+			Input:      request.GetInput(),
+		}},
+		request.GetIdentity(),
+		request.GetRequestID(),
+		signalRequest.ExternalWorkflowExecution,
+		signalRequest.GetChildWorkflowOnly(),
+	)
+}
+
+// SignalBatchWorkflowExecution applies all signals of the batch in a single transaction,
+// so the workflow sees them in one decision
+func (e *historyEngineImpl) SignalBatchWorkflowExecution(
+	ctx context.Context,
+	signalRequest *types.HistorySignalBatchWorkflowExecutionRequest,
+) error {
+
+	request := signalRequest.SignalRequest
+	if len(request.GetSignals()) == 0 {
+		return &types.BadRequestError{Message: "Signals are not set on request."}
+	}
+	return e.signalWorkflowExecution(
+		ctx,
+		signalRequest.DomainUUID,
+		request.WorkflowExecution,
+		request.GetSignals(),
+		request.GetIdentity(),
+		request.GetRequestID(),
+		nil,
+		false,
+	)
+}
+
+func (e *historyEngineImpl) signalWorkflowExecution(
+	ctx context.Context,
+	domainUUID string,
+	signalExecution *types.WorkflowExecution,
+	signals []*types.SignalBatchItem,
+	identity string,
+	requestID string,
+	parentExecution *types.WorkflowExecution,
+	childWorkflowOnly bool,
+) error {
+
+	domainEntry, err := e.shard.GetDomainCache().GetActiveDomainByID(domainUUID)
 	if err != nil {
 		return err
 	}
 	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
 		return errDomainDeprecated
 	}
+	if domain.IsQuarantined(domainEntry.GetInfo().Data) {
+		return errDomainQuarantined
+	}
 	domainID := domainEntry.GetInfo().ID
 
-	request := signalRequest.SignalRequest
-	parentExecution := signalRequest.ExternalWorkflowExecution
-	childWorkflowOnly := signalRequest.GetChildWorkflowOnly()
 	workflowExecution := types.WorkflowExecution{
-		WorkflowID: request.WorkflowExecution.WorkflowID,
-		RunID:      request.WorkflowExecution.RunID,
+		WorkflowID: signalExecution.GetWorkflowID(),
+		RunID:      signalExecution.GetRunID(),
 	}
 
 	return workflow.UpdateCurrentWithActionFunc(
@@ -2232,7 +2304,7 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			// first deduplicate by request id for signal decision
 			// this is done before workflow running check so that already completed error
 			// won't be returned for duplicated signals even if the workflow is closed.
-			if requestID := request.GetRequestID(); requestID != "" {
+			if requestID != "" {
 				if mutableState.IsSignalRequested(requestID) {
 					return &workflow.UpdateAction{
 						Noop:           true,
@@ -2258,7 +2330,7 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			}
 
 			maxAllowedSignals := e.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
-			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
+			if maxAllowedSignals > 0 && int(executionInfo.SignalCount)+len(signals) > maxAllowedSignals {
 				e.logger.Info("Execution limit reached for maximum signals", tag.WorkflowSignalCount(executionInfo.SignalCount),
 					tag.WorkflowID(workflowExecution.GetWorkflowID()),
 					tag.WorkflowRunID(workflowExecution.GetRunID()),
@@ -2275,15 +2347,17 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 				}
 			}
 
-			if requestID := request.GetRequestID(); requestID != "" {
+			if requestID != "" {
 				mutableState.AddSignalRequested(requestID)
 			}
 
-			if _, err := mutableState.AddWorkflowExecutionSignaled(
-				request.GetSignalName(),
-				request.GetInput(),
-				request.GetIdentity()); err != nil {
-				return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
+			for _, signal := range signals {
+				if _, err := mutableState.AddWorkflowExecutionSignaled(
+					signal.GetSignalName(),
+					signal.GetInput(),
+					identity); err != nil {
+					return nil, &types.InternalServiceError{Message: "Unable to signal workflow execution."}
+				}
 			}
 
 			return &workflow.UpdateAction{
@@ -2305,6 +2379,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	if domainEntry.GetInfo().Status != persistence.DomainStatusRegistered {
 		return nil, errDomainDeprecated
 	}
+	if domain.IsQuarantined(domainEntry.GetInfo().Data) {
+		return nil, errDomainQuarantined
+	}
 	domainID := domainEntry.GetInfo().ID
 
 	sRequest := signalWithStartRequest.SignalWithStartRequest
@@ -2871,6 +2948,53 @@ func (e *historyEngineImpl) DescribeCrossClusterQueue(
 	return e.describeQueue(ctx, e.crossClusterProcessor, clusterName)
 }
 
+// DescribeReplicationQueue returns the in-memory replication queue depth samples of the shard
+// for the polling cluster, each one serialized as JSON
+func (e *historyEngineImpl) DescribeReplicationQueue(
+	ctx context.Context,
+	clusterName string,
+) (*types.DescribeQueueResponse, error) {
+	samples := e.replicationAckManager.GetQueueStats(clusterName)
+	serializedSamples := make([]string, 0, len(samples))
+	for _, sample := range samples {
+		data, err := json.Marshal(sample)
+		if err != nil {
+			return nil, err
+		}
+		serializedSamples = append(serializedSamples, string(data))
+	}
+	return &types.DescribeQueueResponse{
+		ProcessingQueueStates: serializedSamples,
+	}, nil
+}
+
+// GetReplicationAckStates returns the replication ack state of the shard for every remote cluster
+func (e *historyEngineImpl) GetReplicationAckStates(
+	ctx context.Context,
+) (*types.GetReplicationAckStatesResponse, error) {
+	states, err := e.replicationAckManager.GetAckStates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ackStates := make([]*types.ReplicationAckState, 0, len(states))
+	for _, state := range states {
+		ackState := &types.ReplicationAckState{
+			ClusterName:        state.Cluster,
+			AckLevel:           state.AckLevel,
+			PendingTasks:       int64(state.PendingTasks),
+			PendingTasksCapped: state.PendingTasksCapped,
+		}
+		if !state.LastAckTime.IsZero() {
+			ackState.LastAckTime = common.Int64Ptr(state.LastAckTime.UnixNano())
+		}
+		ackStates = append(ackStates, ackState)
+	}
+	return &types.GetReplicationAckStatesResponse{
+		AckStates: ackStates,
+	}, nil
+}
+
 func (e *historyEngineImpl) describeQueue(
 	ctx context.Context,
 	queueProcessor queue.Processor,
@@ -3158,6 +3282,18 @@ func (e *historyEngineImpl) GetDLQReplicationMessages(
 	return tasks, nil
 }
 
+func (e *historyEngineImpl) GetDomainReplicationWatermark(
+	ctx context.Context,
+	domainID string,
+) (*int64, error) {
+
+	watermark, ok := e.shard.GetDomainReplicationWatermark(domainID)
+	if !ok {
+		return nil, nil
+	}
+	return common.Int64Ptr(watermark.UnixNano()), nil
+}
+
 func (e *historyEngineImpl) ReapplyEvents(
 	ctx context.Context,
 	domainUUID string,
@@ -3400,6 +3536,52 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+// ReconcileWorkflowRetention reschedules the history deletion timer of a closed workflow
+// using the current retention of its domain. The timer scheduled when the workflow closed
+// is left in place, it finds nothing to delete if the new timer fires first.
+func (e *historyEngineImpl) ReconcileWorkflowRetention(
+	ctx context.Context,
+	domainUUID string,
+	workflowExecution types.WorkflowExecution,
+) (retError error) {
+	domainEntry, err := e.shard.GetDomainCache().GetDomainByID(domainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	wfContext, release, err := e.executionCache.GetOrCreateWorkflowExecution(ctx, domainID, workflowExecution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := wfContext.LoadWorkflowExecution(ctx)
+	if err != nil {
+		return err
+	}
+	if mutableState.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	closeEvent, err := mutableState.GetCompletionEvent(ctx)
+	if err != nil {
+		return err
+	}
+
+	taskGenerator := execution.NewMutableStateTaskGenerator(
+		e.shard.GetClusterMetadata(),
+		e.shard.GetDomainCache(),
+		e.logger,
+		mutableState,
+	)
+	if err := taskGenerator.GenerateWorkflowDeletionTask(closeEvent); err != nil {
+		return err
+	}
+
+	return wfContext.UpdateWorkflowExecutionTasks(ctx, e.shard.GetTimeSource().Now())
+}
+
 func (e *historyEngineImpl) GetCrossClusterTasks(
 	ctx context.Context,
 	targetCluster string,
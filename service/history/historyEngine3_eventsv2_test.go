@@ -293,6 +293,34 @@ func (s *engine3Suite) TestStartWorkflowExecution_DeprecatedDomain() {
 	})
 	s.IsType(&types.BadRequestError{}, err)
 }
+
+func (s *engine3Suite) TestStartWorkflowExecution_QuarantinedDomain() {
+	testDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&p.DomainInfo{
+			ID:   constants.TestDomainID,
+			Name: constants.TestDomainName,
+			Data: map[string]string{common.DomainDataKeyForQuarantine: "true"},
+		}, &p.DomainConfig{Retention: 1}, "", nil,
+	)
+
+	s.mockDomainCache.EXPECT().GetActiveDomainByID(gomock.Any()).Return(testDomainEntry, nil)
+
+	_, err := s.historyEngine.StartWorkflowExecution(context.Background(), &types.HistoryStartWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		StartRequest: &types.StartWorkflowExecutionRequest{
+			Domain:                              constants.TestDomainID,
+			WorkflowID:                          "workflowID",
+			WorkflowType:                        &types.WorkflowType{Name: "workflowType"},
+			TaskList:                            &types.TaskList{Name: "testTaskList"},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(2),
+			Identity:                            "testIdentity",
+			RequestID:                           uuid.New(),
+		},
+	})
+	s.Equal(errDomainQuarantined, err)
+}
+
 func (s *engine3Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	testDomainEntry := cache.NewLocalDomainCacheEntryForTest(
 		&p.DomainInfo{ID: constants.TestDomainID, Name: constants.TestDomainName}, &p.DomainConfig{Retention: 1}, "", nil,
@@ -465,3 +493,32 @@ func (s *engine3Suite) TestSignalWorkflowExecution_DeprecatedDomain() {
 	err := s.historyEngine.SignalWorkflowExecution(context.Background(), signalRequest)
 	s.IsType(&types.BadRequestError{}, err)
 }
+
+func (s *engine3Suite) TestSignalWorkflowExecution_QuarantinedDomain() {
+	signalRequest := &types.HistorySignalWorkflowExecutionRequest{
+		DomainUUID: constants.TestDomainID,
+		SignalRequest: &types.SignalWorkflowExecutionRequest{
+			Domain: constants.TestDomainID,
+			WorkflowExecution: &types.WorkflowExecution{
+				WorkflowID: "wId",
+				RunID:      constants.TestRunID,
+			},
+			Identity:   "testIdentity",
+			SignalName: "my signal name",
+			Input:      []byte("test input"),
+		},
+	}
+
+	testDomainEntry := cache.NewLocalDomainCacheEntryForTest(
+		&p.DomainInfo{
+			ID:   constants.TestDomainID,
+			Name: constants.TestDomainName,
+			Data: map[string]string{common.DomainDataKeyForQuarantine: "true"},
+		}, &p.DomainConfig{Retention: 1}, "", nil,
+	)
+
+	s.mockDomainCache.EXPECT().GetActiveDomainByID(gomock.Any()).Return(testDomainEntry, nil)
+
+	err := s.historyEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Equal(errDomainQuarantined, err)
+}
@@ -2224,6 +2224,77 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedStartChildWorkflowWithTerm
 	s.Equal(types.ParentClosePolicyTerminate, executionBuilder.GetPendingChildExecutionInfos()[childID].ParentClosePolicy)
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedStartChildWorkflowTaskListPayloadSizeExceedsLimit() {
+
+	we := types.WorkflowExecution{
+		WorkflowID: "wId",
+		RunID:      constants.TestRunID,
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.WorkflowID,
+		RunID:      we.RunID,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	limitError := s.config.TaskListPayloadSizeLimitError
+	defer func() { s.config.TaskListPayloadSizeLimitError = limitError }()
+	s.config.TaskListPayloadSizeLimitError = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(10)
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	decisions := []*types.Decision{{
+		DecisionType: types.DecisionTypeStartChildWorkflowExecution.Ptr(),
+		StartChildWorkflowExecutionDecisionAttributes: &types.StartChildWorkflowExecutionDecisionAttributes{
+			Domain:     constants.TestDomainName,
+			WorkflowID: "child-workflow-id",
+			WorkflowType: &types.WorkflowType{
+				Name: "child-workflow-type",
+			},
+			Input: []byte("input exceeding the tasklist limit"),
+		},
+	}}
+
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: execution.CreatePersistenceMutableState(msBuilder)}
+	// the workflow is loaded again to fail the decision
+	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: execution.CreatePersistenceMutableState(msBuilder)}
+
+	var failedCause *types.DecisionTaskFailedCause
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse2, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		for _, event := range args.Get(1).(*persistence.AppendHistoryNodesRequest).Events {
+			if attr := event.DecisionTaskFailedEventAttributes; attr != nil {
+				failedCause = attr.Cause
+			}
+		}
+	}).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	_, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &types.HistoryRespondDecisionTaskCompletedRequest{
+		DomainUUID: constants.TestDomainID,
+		CompleteRequest: &types.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Decisions: decisions,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	s.Equal(types.DecisionTaskFailedCauseBadTaskListPayloadSize.Ptr(), failedCause)
+	executionBuilder := s.getBuilder(constants.TestDomainID, we)
+	s.Empty(executionBuilder.GetPendingChildExecutionInfos())
+	s.True(executionBuilder.HasPendingDecision())
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedSignalExternalWorkflowFailed() {
 
 	we := types.WorkflowExecution{
@@ -4955,6 +5026,54 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestSignalBatchWorkflowExecution() {
+	we := types.WorkflowExecution{
+		WorkflowID: constants.TestWorkflowID,
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalRequest := &types.SignalBatchWorkflowExecutionRequest{
+		Domain:            constants.TestDomainID,
+		WorkflowExecution: &we,
+		Identity:          identity,
+		Signals: []*types.SignalBatchItem{
+			{SignalName: "first signal", Input: []byte("first input")},
+			{SignalName: "second signal", Input: []byte("second input")},
+		},
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		we.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	test.AddDecisionTaskScheduledEvent(msBuilder)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = constants.TestDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	var signalNames []string
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything, mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Run(func(args mock.Arguments) {
+		for _, event := range args.Get(1).(*persistence.AppendHistoryNodesRequest).Events {
+			if event.GetEventType() == types.EventTypeWorkflowExecutionSignaled {
+				signalNames = append(signalNames, event.WorkflowExecutionSignaledEventAttributes.GetSignalName())
+			}
+		}
+	}).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.SignalBatchWorkflowExecution(context.Background(), &types.HistorySignalBatchWorkflowExecutionRequest{
+		DomainUUID:    constants.TestDomainID,
+		SignalRequest: signalRequest,
+	})
+	s.Nil(err)
+	s.Equal([]string{"first signal", "second signal"}, signalNames)
+}
+
 // Test signal decision by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest_WorkflowOpen() {
 	we := types.WorkflowExecution{
@@ -5234,6 +5353,67 @@ func (s *engineSuite) TestReapplyEvents_ResetWorkflow() {
 	s.NoError(err)
 }
 
+func (s *engineSuite) TestReconcileWorkflowRetention() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "TestReconcileWorkflowRetention",
+		RunID:      constants.TestRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		workflowExecution.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := test.AddDecisionTaskScheduledEvent(msBuilder)
+	event := test.AddDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	di.StartedID = event.ID
+	event = test.AddDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, nil, "some random identity")
+	closeEvent := test.AddCompleteWorkflowEvent(msBuilder, event.ID, nil)
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	ms.ExecutionInfo.CompletionEvent = closeEvent
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gweResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything, mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		timerTasks := request.UpdateWorkflowMutation.TimerTasks
+		if len(timerTasks) != 1 || len(request.UpdateWorkflowMutation.TransferTasks) != 0 {
+			return false
+		}
+		deleteTask, ok := timerTasks[0].(*persistence.DeleteHistoryEventTask)
+		// the retention of the test domain is one day
+		return ok && deleteTask.VisibilityTimestamp.Equal(time.Unix(0, closeEvent.GetTimestamp()).Add(24*time.Hour))
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.ReconcileWorkflowRetention(context.Background(), constants.TestDomainID, workflowExecution)
+	s.NoError(err)
+}
+
+func (s *engineSuite) TestReconcileWorkflowRetention_WorkflowRunning() {
+	workflowExecution := types.WorkflowExecution{
+		WorkflowID: "TestReconcileWorkflowRetention_WorkflowRunning",
+		RunID:      constants.TestRunID,
+	}
+
+	msBuilder := execution.NewMutableStateBuilderWithEventV2(
+		s.mockHistoryEngine.shard,
+		loggerimpl.NewLoggerForTest(s.Suite),
+		workflowExecution.GetRunID(),
+		constants.TestLocalDomainEntry,
+	)
+	test.AddWorkflowExecutionStartedEvent(msBuilder, workflowExecution, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
+	ms := execution.CreatePersistenceMutableState(msBuilder)
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything, mock.Anything).Return(gweResponse, nil).Once()
+
+	// the deletion timer of a running workflow is scheduled when it closes
+	err := s.mockHistoryEngine.ReconcileWorkflowRetention(context.Background(), constants.TestDomainID, workflowExecution)
+	s.NoError(err)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything, mock.Anything)
+}
+
 func (s *engineSuite) getBuilder(testDomainID string, we types.WorkflowExecution) execution.MutableState {
 	context, release, err := s.mockHistoryEngine.executionCache.GetOrCreateWorkflowExecutionForBackground(testDomainID, we)
 	if err != nil {
@@ -0,0 +1,158 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"fmt"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// SupportedCompressions are the compressions of replication task events this cluster can decode,
+// the source cluster is told about them when replication tasks are fetched
+var SupportedCompressions = []types.CompressionType{
+	types.CompressionTypeSnappy,
+	types.CompressionTypeZstd,
+}
+
+var (
+	// the encoder and decoder are safe for concurrent use of EncodeAll and DecodeAll
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// NegotiateCompression returns the compression to send replication task events with: the configured
+// compression if the requesting cluster supports it, nil if it is not configured or not supported
+func NegotiateCompression(configured string, supported []types.CompressionType) (*types.CompressionType, error) {
+	if configured == "" {
+		return nil, nil
+	}
+	var compression types.CompressionType
+	if err := compression.UnmarshalText([]byte(configured)); err != nil {
+		return nil, err
+	}
+	for _, s := range supported {
+		if s == compression {
+			return &compression, nil
+		}
+	}
+	return nil, nil
+}
+
+// CompressTasks returns the tasks with the events of history tasks compressed. Compressed tasks are
+// copies, the tasks passed in may be cached and are not modified
+func CompressTasks(
+	tasks []*types.ReplicationTask,
+	compression types.CompressionType,
+) ([]*types.ReplicationTask, error) {
+	result := make([]*types.ReplicationTask, 0, len(tasks))
+	for _, task := range tasks {
+		attr := task.GetHistoryTaskV2Attributes()
+		if attr == nil || attr.Compression != nil {
+			result = append(result, task)
+			continue
+		}
+
+		events, err := compressDataBlob(attr.Events, compression)
+		if err != nil {
+			return nil, err
+		}
+		newRunEvents, err := compressDataBlob(attr.NewRunEvents, compression)
+		if err != nil {
+			return nil, err
+		}
+		compressedAttr := *attr
+		compressedAttr.Events = events
+		compressedAttr.NewRunEvents = newRunEvents
+		compressedAttr.Compression = compression.Ptr()
+		compressedTask := *task
+		compressedTask.HistoryTaskV2Attributes = &compressedAttr
+		result = append(result, &compressedTask)
+	}
+	return result, nil
+}
+
+// DecompressTask decompresses the events of a history task in place, it is a no-op for other tasks
+// and for history tasks that are not compressed
+func DecompressTask(task *types.ReplicationTask) error {
+	attr := task.GetHistoryTaskV2Attributes()
+	if attr == nil || attr.Compression == nil {
+		return nil
+	}
+
+	events, err := decompressDataBlob(attr.Events, *attr.Compression)
+	if err != nil {
+		return err
+	}
+	newRunEvents, err := decompressDataBlob(attr.NewRunEvents, *attr.Compression)
+	if err != nil {
+		return err
+	}
+	attr.Events = events
+	attr.NewRunEvents = newRunEvents
+	attr.Compression = nil
+	return nil
+}
+
+func compressDataBlob(blob *types.DataBlob, compression types.CompressionType) (*types.DataBlob, error) {
+	if blob == nil {
+		return nil, nil
+	}
+	var data []byte
+	switch compression {
+	case types.CompressionTypeSnappy:
+		data = snappy.Encode(nil, blob.Data)
+	case types.CompressionTypeZstd:
+		data = zstdEncoder.EncodeAll(blob.Data, nil)
+	default:
+		return nil, fmt.Errorf("unknown compression type: %v", compression)
+	}
+	return &types.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+func decompressDataBlob(blob *types.DataBlob, compression types.CompressionType) (*types.DataBlob, error) {
+	if blob == nil {
+		return nil, nil
+	}
+	var data []byte
+	var err error
+	switch compression {
+	case types.CompressionTypeSnappy:
+		data, err = snappy.Decode(nil, blob.Data)
+	case types.CompressionTypeZstd:
+		data, err = zstdDecoder.DecodeAll(blob.Data, nil)
+	default:
+		return nil, fmt.Errorf("unknown compression type: %v", compression)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %v replication task events: %v", compression, err)
+	}
+	return &types.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestNegotiateCompression(t *testing.T) {
+	compression, err := NegotiateCompression("", SupportedCompressions)
+	assert.NoError(t, err)
+	assert.Nil(t, compression)
+
+	compression, err = NegotiateCompression("zstd", SupportedCompressions)
+	assert.NoError(t, err)
+	assert.Equal(t, types.CompressionTypeZstd.Ptr(), compression)
+
+	// clusters not sending their supported compressions get uncompressed tasks
+	compression, err = NegotiateCompression("snappy", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, compression)
+
+	_, err = NegotiateCompression("lz4", SupportedCompressions)
+	assert.Error(t, err)
+}
+
+func TestCompressTasks(t *testing.T) {
+	events := &types.DataBlob{
+		EncodingType: types.EncodingTypeThriftRW.Ptr(),
+		Data:         bytes.Repeat([]byte("history events "), 100),
+	}
+	domainTask := &types.ReplicationTask{
+		TaskType:             types.ReplicationTaskTypeDomain.Ptr(),
+		DomainTaskAttributes: &types.DomainTaskAttributes{ID: "domain-id"},
+	}
+	historyTask := &types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(),
+		HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+			TaskID: 1,
+			Events: events,
+		},
+	}
+
+	for _, compression := range SupportedCompressions {
+		tasks, err := CompressTasks([]*types.ReplicationTask{domainTask, historyTask}, compression)
+		require.NoError(t, err)
+		require.Len(t, tasks, 2)
+		assert.Same(t, domainTask, tasks[0])
+
+		compressed := tasks[1].GetHistoryTaskV2Attributes()
+		assert.Equal(t, compression.Ptr(), compressed.Compression)
+		assert.Equal(t, types.EncodingTypeThriftRW.Ptr(), compressed.Events.EncodingType)
+		assert.Less(t, len(compressed.Events.Data), len(events.Data))
+		assert.Nil(t, compressed.NewRunEvents)
+		// the original task is not modified
+		assert.Nil(t, historyTask.HistoryTaskV2Attributes.Compression)
+		assert.Equal(t, events, historyTask.HistoryTaskV2Attributes.Events)
+
+		require.NoError(t, DecompressTask(tasks[1]))
+		assert.Equal(t, historyTask, tasks[1])
+	}
+}
+
+func TestDecompressTask_Corrupted(t *testing.T) {
+	task := &types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(),
+		HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+			Events:      &types.DataBlob{Data: []byte("not compressed")},
+			Compression: types.CompressionTypeZstd.Ptr(),
+		},
+	}
+	assert.Error(t, DecompressTask(task))
+
+	// uncompressed tasks are left as they are
+	assert.NoError(t, DecompressTask(&types.ReplicationTask{}))
+}
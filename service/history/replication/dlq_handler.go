@@ -24,8 +24,14 @@ package replication
 
 import (
 	"context"
+	"math"
+	"sort"
+	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/shard"
@@ -33,10 +39,14 @@ import (
 
 const (
 	defaultBeginningMessageID = -1
+	purgeByDomainPageSize     = 100
 )
 
 var (
 	errInvalidCluster = &types.BadRequestError{Message: "Invalid target cluster name."}
+
+	// DLQ messages written before their creation time was recorded report a creation time around the Unix epoch
+	minDLQMessageCreationTime = time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
 )
 
 type (
@@ -54,6 +64,13 @@ type (
 			sourceCluster string,
 			lastMessageID int64,
 		) error
+		// PurgeMessagesByDomain removes only the DLQ messages of the given domain and
+		// returns the number of messages purged, messages of other domains are preserved
+		PurgeMessagesByDomain(
+			ctx context.Context,
+			sourceCluster string,
+			domainID string,
+		) (int, error)
 		MergeMessages(
 			ctx context.Context,
 			sourceCluster string,
@@ -63,6 +80,13 @@ type (
 		) ([]byte, error)
 	}
 
+	// dlqWorkflowKey identifies the workflow run of a DLQ message
+	dlqWorkflowKey struct {
+		domainID   string
+		workflowID string
+		runID      string
+	}
+
 	dlqHandlerImpl struct {
 		taskExecutors map[string]TaskExecutor
 		shard         shard.Context
@@ -135,9 +159,10 @@ func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 		return nil, nil, nil, errInvalidCluster
 	}
 
+	maxAge := r.shard.GetConfig().ReplicationDLQMessageMaxAge()
 	taskInfo := make([]*types.ReplicationTaskInfo, 0, len(resp.Tasks))
 	for _, task := range resp.Tasks {
-		taskInfo = append(taskInfo, &types.ReplicationTaskInfo{
+		info := &types.ReplicationTaskInfo{
 			DomainID:     task.GetDomainID(),
 			WorkflowID:   task.GetWorkflowID(),
 			RunID:        task.GetRunID(),
@@ -147,19 +172,29 @@ func (r *dlqHandlerImpl) readMessagesWithAckLevel(
 			FirstEventID: task.FirstEventID,
 			NextEventID:  task.NextEventID,
 			ScheduledID:  task.ScheduledID,
-		})
+		}
+		if expirationTime, ok := dlqMessageExpirationTime(task.CreationTime, maxAge); ok {
+			info.ExpirationTime = common.Int64Ptr(expirationTime.UnixNano())
+		}
+		taskInfo = append(taskInfo, info)
 	}
 	response := &types.GetDLQReplicationMessagesResponse{}
 	if len(taskInfo) > 0 {
 		response, err = remoteAdminClient.GetDLQReplicationMessages(
 			ctx,
 			&types.GetDLQReplicationMessagesRequest{
-				TaskInfos: taskInfo,
+				TaskInfos:             taskInfo,
+				SupportedCompressions: SupportedCompressions,
 			},
 		)
 		if err != nil {
 			return nil, nil, nil, err
 		}
+		for _, task := range response.ReplicationTasks {
+			if err := DecompressTask(task); err != nil {
+				return nil, nil, nil, err
+			}
+		}
 	}
 
 	return response.ReplicationTasks, taskInfo, resp.NextPageToken, nil
@@ -185,6 +220,63 @@ func (r *dlqHandlerImpl) PurgeMessages(
 	return nil
 }
 
+func (r *dlqHandlerImpl) PurgeMessagesByDomain(
+	ctx context.Context,
+	sourceCluster string,
+	domainID string,
+) (int, error) {
+
+	scanned := 0
+	purged := 0
+	var pageToken []byte
+	for {
+		resp, err := r.shard.GetExecutionManager().GetReplicationTasksFromDLQ(
+			ctx,
+			&persistence.GetReplicationTasksFromDLQRequest{
+				SourceClusterName: sourceCluster,
+				GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+					ReadLevel:     defaultBeginningMessageID,
+					MaxReadLevel:  common.EndMessageID,
+					BatchSize:     purgeByDomainPageSize,
+					NextPageToken: pageToken,
+				},
+			},
+		)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, task := range resp.Tasks {
+			scanned++
+			if task.GetDomainID() != domainID {
+				continue
+			}
+			if err := r.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(
+				ctx,
+				&persistence.DeleteReplicationTaskFromDLQRequest{
+					SourceClusterName: sourceCluster,
+					TaskID:            task.GetTaskID(),
+				},
+			); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+
+		r.logger.Info("Purging replication DLQ messages by domain.",
+			tag.SourceCluster(sourceCluster),
+			tag.WorkflowDomainID(domainID),
+			tag.NumberProcessed(scanned),
+			tag.NumberDeleted(purged),
+		)
+
+		if len(resp.NextPageToken) == 0 {
+			return purged, nil
+		}
+		pageToken = resp.NextPageToken
+	}
+}
+
 func (r *dlqHandlerImpl) MergeMessages(
 	ctx context.Context,
 	sourceCluster string,
@@ -213,22 +305,89 @@ func (r *dlqHandlerImpl) MergeMessages(
 		replicationTasks[task.SourceTaskID] = task
 	}
 
-	lastMessageID = defaultBeginningMessageID
-	for _, raw := range rawTasks {
-		if task, ok := replicationTasks[raw.TaskID]; ok {
-			if _, err := r.taskExecutors[sourceCluster].execute(task, true); err != nil {
-				return nil, err
+	// The messages are applied workflow by workflow, so that a workflow failing to merge does not block
+	// the other workflows of the page. The messages of a workflow after the one failing are kept in the DLQ
+	// with it, applying them without it would apply the history of the workflow out of order.
+	mergedTaskIDs := make([]int64, 0, len(rawTasks))
+	firstFailedTaskID := int64(math.MaxInt64)
+	for _, workflowTasks := range groupDLQMessagesByWorkflow(rawTasks) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for i, raw := range workflowTasks {
+			// If hydrated replication task does not exists in remote cluster - continue merging
+			// Record the raw task id, so that they can be purged after.
+			if task, ok := replicationTasks[raw.TaskID]; ok {
+				if err := r.executeTask(ctx, sourceCluster, task, raw); err != nil {
+					r.logger.Warn("Failed to merge replication DLQ messages of workflow, the messages are kept in DLQ.",
+						tag.WorkflowDomainID(raw.GetDomainID()),
+						tag.WorkflowID(raw.GetWorkflowID()),
+						tag.WorkflowRunID(raw.GetRunID()),
+						tag.TaskID(raw.GetTaskID()),
+						tag.Counter(len(workflowTasks)-i),
+						tag.Error(err),
+					)
+					r.shard.GetMetricsClient().IncCounter(metrics.ReplicationDLQStatsScope, metrics.ReplicationDLQMergeFailed)
+					if raw.TaskID < firstFailedTaskID {
+						firstFailedTaskID = raw.TaskID
+					}
+					break
+				}
 			}
+			mergedTaskIDs = append(mergedTaskIDs, raw.TaskID)
 		}
+	}
 
-		// If hydrated replication task does not exists in remote cluster - continue merging
-		// Record lastMessageID with raw task id, so that they can be purged after.
-		if lastMessageID < raw.TaskID {
-			lastMessageID = raw.TaskID
+	if err := r.deleteMergedMessages(ctx, sourceCluster, mergedTaskIDs, firstFailedTaskID); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// groupDLQMessagesByWorkflow groups the messages by workflow run, keeping the task ID order of the messages
+// of each workflow. The workflows are ordered by their first message.
+func groupDLQMessagesByWorkflow(rawTasks []*types.ReplicationTaskInfo) [][]*types.ReplicationTaskInfo {
+	var groups [][]*types.ReplicationTaskInfo
+	index := make(map[dlqWorkflowKey]int)
+	for _, raw := range rawTasks {
+		key := dlqWorkflowKey{
+			domainID:   raw.GetDomainID(),
+			workflowID: raw.GetWorkflowID(),
+			runID:      raw.GetRunID(),
+		}
+		i, ok := index[key]
+		if !ok {
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, nil)
 		}
+		groups[i] = append(groups[i], raw)
 	}
+	for _, group := range groups {
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].TaskID < group[j].TaskID
+		})
+	}
+	return groups
+}
+
+// deleteMergedMessages removes the merged messages from DLQ. The ones before the first message failing
+// to merge are removed with a single range delete, the ones after it are removed one by one
+// to keep the messages which failed.
+func (r *dlqHandlerImpl) deleteMergedMessages(
+	ctx context.Context,
+	sourceCluster string,
+	taskIDs []int64,
+	firstFailedTaskID int64,
+) error {
 
-	_, err = r.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
+	lastMessageID := int64(defaultBeginningMessageID)
+	for _, taskID := range taskIDs {
+		if taskID < firstFailedTaskID && lastMessageID < taskID {
+			lastMessageID = taskID
+		}
+	}
+	_, err := r.shard.GetExecutionManager().RangeDeleteReplicationTaskFromDLQ(
 		ctx,
 		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
 			SourceClusterName:    sourceCluster,
@@ -237,7 +396,85 @@ func (r *dlqHandlerImpl) MergeMessages(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return token, nil
+
+	for _, taskID := range taskIDs {
+		if taskID < firstFailedTaskID {
+			continue
+		}
+		if err := r.shard.GetExecutionManager().DeleteReplicationTaskFromDLQ(
+			ctx,
+			&persistence.DeleteReplicationTaskFromDLQRequest{
+				SourceClusterName: sourceCluster,
+				TaskID:            taskID,
+			},
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// executeTask applies the DLQ task. After the number of shards changed, the workflow of the task
+// may be owned by a different shard than the one the task was stored in, so the target shard is
+// recomputed from the workflow ID and the task is re-routed to the engine of that shard.
+func (r *dlqHandlerImpl) executeTask(
+	ctx context.Context,
+	sourceCluster string,
+	task *types.ReplicationTask,
+	raw *types.ReplicationTaskInfo,
+) error {
+
+	if task.GetTaskType() != types.ReplicationTaskTypeFailoverMarker {
+		targetShardID := common.WorkflowIDToHistoryShard(raw.GetWorkflowID(), r.shard.GetConfig().NumberOfShards)
+		if targetShardID != r.shard.GetShardID() {
+			r.logger.Info("Re-routing replication DLQ message to target shard.",
+				tag.ShardID(targetShardID),
+				tag.WorkflowDomainID(raw.GetDomainID()),
+				tag.WorkflowID(raw.GetWorkflowID()),
+				tag.WorkflowRunID(raw.GetRunID()),
+				tag.TaskID(raw.GetTaskID()),
+			)
+			r.shard.GetMetricsClient().IncCounter(metrics.ReplicationDLQStatsScope, metrics.ReplicationDLQRerouted)
+			err := r.rerouteTask(ctx, task)
+			if _, ok := err.(*types.RetryTaskV2Error); !ok {
+				return err
+			}
+			// the target shard is missing history of the workflow,
+			// fall back to the task executor which resends it before applying the task
+		}
+	}
+	_, err := r.taskExecutors[sourceCluster].execute(task, true)
+	return err
+}
+
+// rerouteTask sends the task to the history engine of the shard owning the workflow
+func (r *dlqHandlerImpl) rerouteTask(
+	ctx context.Context,
+	task *types.ReplicationTask,
+) error {
+
+	ctx, cancel := context.WithTimeout(ctx, replicationTimeout)
+	defer cancel()
+
+	historyClient := r.shard.GetService().GetClientBean().GetHistoryClient()
+	switch task.GetTaskType() {
+	case types.ReplicationTaskTypeSyncActivity:
+		return historyClient.SyncActivity(ctx, newSyncActivityRequest(task.SyncActivityTaskAttributes))
+	case types.ReplicationTaskTypeHistoryV2:
+		return historyClient.ReplicateEventsV2(ctx, newReplicateEventsV2Request(task.HistoryTaskV2Attributes))
+	default:
+		return ErrUnknownReplicationTask
+	}
+}
+
+// dlqMessageExpirationTime returns when a DLQ message reaches the max age and is expired by the store,
+// false when the max age is not configured or the creation time of the message is unknown
+func dlqMessageExpirationTime(creationTime int64, maxAge time.Duration) (time.Time, bool) {
+	created := time.Unix(0, creationTime)
+	if maxAge <= 0 || created.Before(minDLQMessageCreationTime) {
+		return time.Time{}, false
+	}
+	return created.Add(maxAge), true
 }
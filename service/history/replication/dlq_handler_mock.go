@@ -89,6 +89,21 @@ func (mr *MockDLQHandlerMockRecorder) PurgeMessages(ctx, sourceCluster, lastMess
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeMessages", reflect.TypeOf((*MockDLQHandler)(nil).PurgeMessages), ctx, sourceCluster, lastMessageID)
 }
 
+// PurgeMessagesByDomain mocks base method
+func (m *MockDLQHandler) PurgeMessagesByDomain(ctx context.Context, sourceCluster string, domainID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeMessagesByDomain", ctx, sourceCluster, domainID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeMessagesByDomain indicates an expected call of PurgeMessagesByDomain
+func (mr *MockDLQHandlerMockRecorder) PurgeMessagesByDomain(ctx, sourceCluster, domainID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeMessagesByDomain", reflect.TypeOf((*MockDLQHandler)(nil).PurgeMessagesByDomain), ctx, sourceCluster, domainID)
+}
+
 // MergeMessages mocks base method
 func (m *MockDLQHandler) MergeMessages(ctx context.Context, sourceCluster string, lastMessageID int64, pageSize int, pageToken []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -23,6 +23,7 @@ package replication
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
@@ -32,7 +33,9 @@ import (
 
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
@@ -128,8 +131,17 @@ func (s *dlqHandlerSuite) TestReadMessages_OK() {
 				TaskType:   0,
 				TaskID:     1,
 			},
+			{
+				DomainID:     uuid.New(),
+				WorkflowID:   uuid.New(),
+				RunID:        uuid.New(),
+				TaskType:     0,
+				TaskID:       2,
+				CreationTime: time.Date(2022, time.March, 1, 0, 0, 0, 0, time.UTC).UnixNano(),
+			},
 		},
 	}
+	s.config.ReplicationDLQMessageMaxAge = dynamicconfig.GetDurationPropertyFn(time.Hour)
 	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
 		SourceClusterName: s.sourceCluster,
 		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
@@ -150,6 +162,9 @@ func (s *dlqHandlerSuite) TestReadMessages_OK() {
 	s.Equal(resp.Tasks[0].GetDomainID(), info[0].GetDomainID())
 	s.Equal(resp.Tasks[0].GetWorkflowID(), info[0].GetWorkflowID())
 	s.Equal(resp.Tasks[0].GetRunID(), info[0].GetRunID())
+	// the creation time of the first message is unknown
+	s.Nil(info[0].ExpirationTime)
+	s.Equal(time.Date(2022, time.March, 1, 1, 0, 0, 0, time.UTC).UnixNano(), info[1].GetExpirationTime())
 	s.Nil(tasks)
 }
 
@@ -168,6 +183,54 @@ func (s *dlqHandlerSuite) TestPurgeMessages_OK() {
 	s.NoError(err)
 }
 
+func (s *dlqHandlerSuite) TestPurgeMessagesByDomain_OK() {
+	domainID := uuid.New()
+	otherDomainID := uuid.New()
+	pageToken := []byte("token")
+
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:    -1,
+			MaxReadLevel: common.EndMessageID,
+			BatchSize:    purgeByDomainPageSize,
+		},
+	}).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: domainID, TaskID: 1},
+			{DomainID: otherDomainID, TaskID: 2},
+		},
+		NextPageToken: pageToken,
+	}, nil).Times(1)
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+			ReadLevel:     -1,
+			MaxReadLevel:  common.EndMessageID,
+			BatchSize:     purgeByDomainPageSize,
+			NextPageToken: pageToken,
+		},
+	}).Return(&persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{DomainID: domainID, TaskID: 3},
+		},
+	}, nil).Times(1)
+	for _, taskID := range []int64{1, 3} {
+		s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+			SourceClusterName: s.sourceCluster,
+			TaskID:            taskID,
+		}).Return(nil).Times(1)
+	}
+
+	purged, err := s.messageHandler.PurgeMessagesByDomain(context.Background(), s.sourceCluster, domainID)
+	s.NoError(err)
+	s.Equal(2, purged)
+	s.executionManager.AssertNotCalled(s.T(), "DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+		SourceClusterName: s.sourceCluster,
+		TaskID:            2,
+	})
+}
+
 func (s *dlqHandlerSuite) TestMergeMessages_OK() {
 	ctx := context.Background()
 	lastMessageID := int64(2)
@@ -212,6 +275,7 @@ func (s *dlqHandlerSuite) TestMergeMessages_OK() {
 		Return(&types.GetDLQReplicationMessagesResponse{
 			ReplicationTasks: []*types.ReplicationTask{replicationTask},
 		}, nil)
+	// with a single shard the workflow is owned by the shard of the DLQ, the task is applied locally
 	s.taskExecutor.EXPECT().execute(replicationTask, true).Return(0, nil).Times(1)
 	s.executionManager.On("RangeDeleteReplicationTaskFromDLQ", mock.Anything,
 		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
@@ -224,3 +288,128 @@ func (s *dlqHandlerSuite) TestMergeMessages_OK() {
 	s.NoError(err)
 	s.Nil(token)
 }
+
+func (s *dlqHandlerSuite) TestMergeMessages_IsolatesFailedWorkflow() {
+	ctx := context.Background()
+	lastMessageID := int64(5)
+	domainID := uuid.New()
+	workflowA := &persistence.ReplicationTaskInfo{DomainID: domainID, WorkflowID: "wid-a", RunID: uuid.New()}
+	workflowB := &persistence.ReplicationTaskInfo{DomainID: domainID, WorkflowID: "wid-b", RunID: uuid.New()}
+	workflowC := &persistence.ReplicationTaskInfo{DomainID: domainID, WorkflowID: "wid-c", RunID: uuid.New()}
+	message := func(workflow *persistence.ReplicationTaskInfo, taskID int64) *persistence.ReplicationTaskInfo {
+		return &persistence.ReplicationTaskInfo{
+			DomainID:   workflow.DomainID,
+			WorkflowID: workflow.WorkflowID,
+			RunID:      workflow.RunID,
+			TaskID:     taskID,
+		}
+	}
+	resp := &persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			message(workflowA, 1),
+			message(workflowB, 2),
+			message(workflowA, 3),
+			message(workflowB, 4),
+			message(workflowC, 5),
+		},
+	}
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).Return(resp, nil).Times(1)
+
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	replicationTasks := make(map[int64]*types.ReplicationTask)
+	for taskID := int64(1); taskID <= lastMessageID; taskID++ {
+		replicationTasks[taskID] = &types.ReplicationTask{
+			TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+			SourceTaskID: taskID,
+		}
+	}
+	s.adminClient.EXPECT().
+		GetDLQReplicationMessages(ctx, gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{
+			ReplicationTasks: []*types.ReplicationTask{
+				replicationTasks[1], replicationTasks[2], replicationTasks[3], replicationTasks[4], replicationTasks[5],
+			},
+		}, nil)
+	// the messages of a workflow are applied in order, workflow B fails on its first message
+	// and its second message is not applied, the other workflows are merged
+	gomock.InOrder(
+		s.taskExecutor.EXPECT().execute(replicationTasks[1], true).Return(0, nil),
+		s.taskExecutor.EXPECT().execute(replicationTasks[3], true).Return(0, nil),
+		s.taskExecutor.EXPECT().execute(replicationTasks[2], true).Return(0, &types.InternalServiceError{Message: "poisoned"}),
+		s.taskExecutor.EXPECT().execute(replicationTasks[5], true).Return(0, nil),
+	)
+	// the messages of workflow B are kept in DLQ
+	s.executionManager.On("RangeDeleteReplicationTaskFromDLQ", mock.Anything,
+		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
+			SourceClusterName:    s.sourceCluster,
+			ExclusiveBeginTaskID: -1,
+			InclusiveEndTaskID:   1,
+		}).Return(&persistence.RangeDeleteReplicationTaskFromDLQResponse{TasksCompleted: persistence.UnknownNumRowsAffected}, nil).Times(1)
+	for _, taskID := range []int64{3, 5} {
+		s.executionManager.On("DeleteReplicationTaskFromDLQ", mock.Anything, &persistence.DeleteReplicationTaskFromDLQRequest{
+			SourceClusterName: s.sourceCluster,
+			TaskID:            taskID,
+		}).Return(nil).Times(1)
+	}
+
+	token, err := s.messageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, 5, nil)
+	s.NoError(err)
+	s.Nil(token)
+	s.executionManager.AssertNumberOfCalls(s.T(), "DeleteReplicationTaskFromDLQ", 2)
+}
+
+func (s *dlqHandlerSuite) TestMergeMessages_RerouteToTargetShard() {
+	ctx := context.Background()
+	lastMessageID := int64(1)
+	pageSize := 1
+	pageToken := []byte{}
+
+	// simulate the number of shards changed after the message was put in DLQ of shard 0
+	s.config.NumberOfShards = 16
+	workflowID := uuid.New()
+	for common.WorkflowIDToHistoryShard(workflowID, s.config.NumberOfShards) == s.mockShard.GetShardID() {
+		workflowID = uuid.New()
+	}
+
+	resp := &persistence.GetReplicationTasksFromDLQResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{
+				DomainID:   uuid.New(),
+				WorkflowID: workflowID,
+				RunID:      uuid.New(),
+				TaskType:   0,
+				TaskID:     1,
+			},
+		},
+	}
+	s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, mock.Anything).Return(resp, nil).Times(1)
+
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(s.sourceCluster).Return(s.adminClient).AnyTimes()
+	replicationTask := &types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskID: 1,
+		HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+			WorkflowID: workflowID,
+		},
+	}
+	s.adminClient.EXPECT().
+		GetDLQReplicationMessages(ctx, gomock.Any()).
+		Return(&types.GetDLQReplicationMessagesResponse{
+			ReplicationTasks: []*types.ReplicationTask{replicationTask},
+		}, nil)
+	// the task is applied by the engine of the owning shard instead of the local task executor
+	s.mockShard.Resource.HistoryClient.EXPECT().
+		ReplicateEventsV2(gomock.Any(), newReplicateEventsV2Request(replicationTask.HistoryTaskV2Attributes)).
+		Return(nil).Times(1)
+	s.taskExecutor.EXPECT().execute(gomock.Any(), gomock.Any()).Times(0)
+	s.executionManager.On("RangeDeleteReplicationTaskFromDLQ", mock.Anything,
+		&persistence.RangeDeleteReplicationTaskFromDLQRequest{
+			SourceClusterName:    s.sourceCluster,
+			ExclusiveBeginTaskID: -1,
+			InclusiveEndTaskID:   lastMessageID,
+		}).Return(&persistence.RangeDeleteReplicationTaskFromDLQResponse{TasksCompleted: persistence.UnknownNumRowsAffected}, nil).Times(1)
+
+	token, err := s.messageHandler.MergeMessages(ctx, s.sourceCluster, lastMessageID, pageSize, pageToken)
+	s.NoError(err)
+	s.Nil(token)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+)
+
+const (
+	// queueStatsSampleInterval is the resolution of the queue depth time series,
+	// the latest sample recorded within an interval replaces the earlier ones
+	queueStatsSampleInterval = 10 * time.Second
+)
+
+type (
+	// QueueStatsSample is a point of the replication queue depth time series of a shard
+	QueueStatsSample struct {
+		Timestamp time.Time `json:"timestamp"`
+		Depth     int64     `json:"depth"`
+		AckLevel  int64     `json:"ackLevel"`
+	}
+
+	// queueStats keeps the replication queue depth samples of the last retention period
+	// in memory, keyed by polling cluster
+	queueStats struct {
+		sync.Mutex
+		retention dynamicconfig.DurationPropertyFn
+		samples   map[string][]*QueueStatsSample
+	}
+)
+
+func newQueueStats(
+	retention dynamicconfig.DurationPropertyFn,
+) *queueStats {
+	return &queueStats{
+		retention: retention,
+		samples:   make(map[string][]*QueueStatsSample),
+	}
+}
+
+func (s *queueStats) record(
+	pollingCluster string,
+	now time.Time,
+	depth int64,
+	ackLevel int64,
+) {
+	s.Lock()
+	defer s.Unlock()
+
+	sample := &QueueStatsSample{
+		Timestamp: now,
+		Depth:     depth,
+		AckLevel:  ackLevel,
+	}
+	samples := s.samples[pollingCluster]
+	if len(samples) > 0 &&
+		samples[len(samples)-1].Timestamp.Truncate(queueStatsSampleInterval).Equal(now.Truncate(queueStatsSampleInterval)) {
+		samples[len(samples)-1] = sample
+	} else {
+		samples = append(samples, sample)
+	}
+
+	cutoff := now.Add(-s.retention())
+	expired := 0
+	for expired < len(samples) && samples[expired].Timestamp.Before(cutoff) {
+		expired++
+	}
+	s.samples[pollingCluster] = samples[expired:]
+}
+
+func (s *queueStats) get(
+	pollingCluster string,
+) []*QueueStatsSample {
+	s.Lock()
+	defer s.Unlock()
+
+	result := make([]*QueueStatsSample, len(s.samples[pollingCluster]))
+	copy(result, s.samples[pollingCluster])
+	return result
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replication
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+)
+
+func TestQueueStats(t *testing.T) {
+	stats := newQueueStats(dynamicconfig.GetDurationPropertyFn(time.Minute))
+	assert.Empty(t, stats.get("cluster-a"))
+
+	start := time.Now().Truncate(queueStatsSampleInterval)
+	stats.record("cluster-a", start, 10, 1)
+	// replaces the previous sample of the same interval
+	stats.record("cluster-a", start.Add(time.Second), 20, 2)
+	stats.record("cluster-a", start.Add(queueStatsSampleInterval), 30, 3)
+	stats.record("cluster-b", start, 5, 4)
+
+	assert.Equal(t, []*QueueStatsSample{
+		{Timestamp: start.Add(time.Second), Depth: 20, AckLevel: 2},
+		{Timestamp: start.Add(queueStatsSampleInterval), Depth: 30, AckLevel: 3},
+	}, stats.get("cluster-a"))
+	assert.Equal(t, []*QueueStatsSample{
+		{Timestamp: start, Depth: 5, AckLevel: 4},
+	}, stats.get("cluster-b"))
+
+	// samples older than the retention are dropped
+	later := start.Add(time.Minute + queueStatsSampleInterval/2)
+	stats.record("cluster-a", later, 40, 5)
+	assert.Equal(t, []*QueueStatsSample{
+		{Timestamp: start.Add(queueStatsSampleInterval), Depth: 30, AckLevel: 3},
+		{Timestamp: later, Depth: 40, AckLevel: 5},
+	}, stats.get("cluster-a"))
+}
@@ -27,7 +27,10 @@ package replication
 import (
 	"context"
 	"errors"
+	"math"
+	"sort"
 	"strconv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -53,6 +56,9 @@ var (
 	errUnknownReplicationTask = errors.New("unknown replication task")
 	defaultHistoryPageSize    = 1000
 	minReadTaskSize           = 20
+	// maxAckStatePendingTasks caps the number of pending tasks counted for a cluster in the ack states,
+	// the queue is read until every cluster reached it or to its end
+	maxAckStatePendingTasks = 10000
 )
 
 type (
@@ -68,6 +74,30 @@ type (
 			pollingCluster string,
 			lastReadTaskID int64,
 		) (*types.ReplicationMessages, error)
+
+		// GetQueueStats returns the replication queue depth samples of the last
+		// retention period for the polling cluster, oldest first
+		GetQueueStats(
+			pollingCluster string,
+		) []*QueueStatsSample
+
+		// GetAckStates returns the replication ack state of the shard for every remote cluster
+		GetAckStates(
+			ctx context.Context,
+		) ([]*ClusterAckState, error)
+	}
+
+	// ClusterAckState is the replication progress of a remote cluster on a shard
+	ClusterAckState struct {
+		Cluster  string `json:"cluster"`
+		AckLevel int64  `json:"ackLevel"`
+		// LastAckTime is the last time the cluster polled tasks from this host,
+		// it is zero when the cluster did not poll since the shard was loaded
+		LastAckTime time.Time `json:"lastAckTime"`
+		// PendingTasks is the number of tasks after the ack level to be replicated to the cluster,
+		// counted up to maxAckStatePendingTasks, PendingTasksCapped tells the cluster reached it
+		PendingTasks       int  `json:"pendingTasks"`
+		PendingTasksCapped bool `json:"pendingTasksCapped"`
 	}
 
 	taskAckManagerImpl struct {
@@ -81,6 +111,8 @@ type (
 
 		lastTaskCreationTime atomic.Value
 		maxAllowedLatencyFn  dynamicconfig.DurationPropertyFn
+		queueStats           *queueStats
+		lastAckTimes         sync.Map // polling cluster -> time.Time
 
 		metricsClient metrics.Client
 		logger        log.Logger
@@ -118,6 +150,7 @@ func NewTaskAckManager(
 		),
 		lastTaskCreationTime: atomic.Value{},
 		maxAllowedLatencyFn:  config.ReplicatorUpperLatency,
+		queueStats:           newQueueStats(config.ReplicatorQueueStatsRetention),
 		metricsClient:        shard.GetMetricsClient(),
 		logger:               shard.GetLogger().WithTags(tag.ComponentReplicationAckManager),
 		fetchTasksBatchSize:  config.ReplicatorProcessorFetchTasksBatchSize,
@@ -142,6 +175,95 @@ func (t *taskAckManagerImpl) GetTask(
 	return t.toReplicationTask(ctx, task)
 }
 
+func (t *taskAckManagerImpl) GetQueueStats(
+	pollingCluster string,
+) []*QueueStatsSample {
+	return t.queueStats.get(pollingCluster)
+}
+
+func (t *taskAckManagerImpl) GetAckStates(
+	ctx context.Context,
+) ([]*ClusterAckState, error) {
+
+	clusterMetadata := t.shard.GetClusterMetadata()
+	currentCluster := clusterMetadata.GetCurrentClusterName()
+	var states []*ClusterAckState
+	minAckLevel := int64(math.MaxInt64)
+	for clusterName, info := range clusterMetadata.GetAllClusterInfo() {
+		if !info.Enabled || clusterName == currentCluster {
+			continue
+		}
+		state := &ClusterAckState{
+			Cluster:  clusterName,
+			AckLevel: t.shard.GetClusterReplicationLevel(clusterName),
+		}
+		if lastAckTime, ok := t.lastAckTimes.Load(clusterName); ok {
+			state.LastAckTime = lastAckTime.(time.Time)
+		}
+		if state.AckLevel < minAckLevel {
+			minAckLevel = state.AckLevel
+		}
+		states = append(states, state)
+	}
+	if len(states) == 0 {
+		return nil, nil
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Cluster < states[j].Cluster
+	})
+
+	// the clusters share the same queue, read it once from the lowest ack level
+	// and count for every cluster the tasks after its own ack level
+	request := &persistence.GetReplicationTasksRequest{
+		ReadLevel:    minAckLevel,
+		MaxReadLevel: t.shard.GetTransferMaxReadLevel(),
+		BatchSize:    defaultHistoryPageSize,
+	}
+	unknownDomains := make(map[string]struct{})
+	for {
+		response, err := t.executionManager.GetReplicationTasks(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, taskInfo := range response.Tasks {
+			domainEntity, err := t.shard.GetDomainCache().GetDomainByID(taskInfo.GetDomainID())
+			if err != nil {
+				// tasks of a domain that cannot be loaded, e.g. a deleted one,
+				// are not counted instead of failing the ack states of every cluster
+				if _, ok := unknownDomains[taskInfo.GetDomainID()]; !ok {
+					unknownDomains[taskInfo.GetDomainID()] = struct{}{}
+					t.logger.Warn("Failed to get domain of replication task, not counting its tasks in ack states.",
+						tag.WorkflowDomainID(taskInfo.GetDomainID()),
+						tag.TaskID(taskInfo.GetTaskID()),
+						tag.Error(err),
+					)
+				}
+				continue
+			}
+			for _, state := range states {
+				if state.PendingTasksCapped || taskInfo.GetTaskID() <= state.AckLevel || skipTask(state.Cluster, domainEntity) {
+					continue
+				}
+				state.PendingTasks++
+				state.PendingTasksCapped = state.PendingTasks >= maxAckStatePendingTasks
+			}
+		}
+		if len(response.NextPageToken) == 0 || allAckStatesCapped(states) {
+			return states, nil
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+func allAckStatesCapped(states []*ClusterAckState) bool {
+	for _, state := range states {
+		if !state.PendingTasksCapped {
+			return false
+		}
+	}
+	return true
+}
+
 func (t *taskAckManagerImpl) GetTasks(
 	ctx context.Context,
 	pollingCluster string,
@@ -208,10 +330,14 @@ TaskInfoLoop:
 	}
 	taskGeneratedTimer.Stop()
 
+	queueDepth := t.shard.GetTransferMaxReadLevel() - readLevel
 	replicationScope.RecordTimer(
 		metrics.ReplicationTasksLag,
-		time.Duration(t.shard.GetTransferMaxReadLevel()-readLevel),
+		time.Duration(queueDepth),
 	)
+	now := t.shard.GetTimeSource().Now()
+	t.queueStats.record(pollingCluster, now, queueDepth, lastReadTaskID)
+	t.lastAckTimes.Store(pollingCluster, now)
 	replicationScope.RecordTimer(
 		metrics.ReplicationTasksFetched,
 		time.Duration(len(taskInfoList)),
@@ -87,3 +87,32 @@ func (mr *MockTaskAckManagerMockRecorder) GetTasks(ctx, pollingCluster, lastRead
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTasks", reflect.TypeOf((*MockTaskAckManager)(nil).GetTasks), ctx, pollingCluster, lastReadTaskID)
 }
+
+// GetQueueStats mocks base method
+func (m *MockTaskAckManager) GetQueueStats(pollingCluster string) []*QueueStatsSample {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueStats", pollingCluster)
+	ret0, _ := ret[0].([]*QueueStatsSample)
+	return ret0
+}
+
+// GetQueueStats indicates an expected call of GetQueueStats
+func (mr *MockTaskAckManagerMockRecorder) GetQueueStats(pollingCluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueStats", reflect.TypeOf((*MockTaskAckManager)(nil).GetQueueStats), pollingCluster)
+}
+
+// GetAckStates mocks base method
+func (m *MockTaskAckManager) GetAckStates(ctx context.Context) ([]*ClusterAckState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAckStates", ctx)
+	ret0, _ := ret[0].([]*ClusterAckState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAckStates indicates an expected call of GetAckStates
+func (mr *MockTaskAckManagerMockRecorder) GetAckStates(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAckStates", reflect.TypeOf((*MockTaskAckManager)(nil).GetAckStates), ctx)
+}
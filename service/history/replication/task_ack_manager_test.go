@@ -1040,6 +1040,97 @@ func (s *taskAckManagerSuite) TestGetTasks_ReturnDataErrors() {
 	s.Equal(taskID+1, msg.GetLastRetrievedMessageID())
 }
 
+func (s *taskAckManagerSuite) TestGetAckStates() {
+	replicatedDomainID := uuid.New()
+	localDomainID := uuid.New()
+	deletedDomainID := uuid.New()
+	s.mockDomainCache.EXPECT().GetDomainByID(replicatedDomainID).Return(cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: replicatedDomainID, Name: "replicatedDomain"},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1,
+		nil,
+	), nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(localDomainID).Return(cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: localDomainID, Name: "localDomain"},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+			},
+		},
+		1,
+		nil,
+	), nil).AnyTimes()
+	s.mockDomainCache.EXPECT().GetDomainByID(deletedDomainID).Return(nil, &types.EntityNotExistsError{}).AnyTimes()
+	s.mockShard.Resource.ShardMgr.On("UpdateShard", mock.Anything, mock.Anything).Return(nil)
+	s.NoError(s.mockShard.UpdateClusterReplicationLevel(cluster.TestAlternativeClusterName, 10))
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything, mock.MatchedBy(func(request *persistence.GetReplicationTasksRequest) bool {
+		return request.ReadLevel == 10
+	})).Return(&persistence.GetReplicationTasksResponse{
+		Tasks: []*persistence.ReplicationTaskInfo{
+			{TaskID: 11, DomainID: replicatedDomainID},
+			{TaskID: 12, DomainID: localDomainID},
+			{TaskID: 13, DomainID: deletedDomainID},
+			{TaskID: 14, DomainID: replicatedDomainID},
+		},
+	}, nil).Once()
+
+	states, err := s.ackManager.GetAckStates(context.Background())
+	s.NoError(err)
+	s.Equal([]*ClusterAckState{
+		{Cluster: cluster.TestAlternativeClusterName, AckLevel: 10, PendingTasks: 2},
+	}, states)
+
+	lastAckTime := time.Now()
+	s.ackManager.lastAckTimes.Store(cluster.TestAlternativeClusterName, lastAckTime)
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksResponse{}, nil).Once()
+	states, err = s.ackManager.GetAckStates(context.Background())
+	s.NoError(err)
+	s.Len(states, 1)
+	s.Equal(lastAckTime, states[0].LastAckTime)
+	s.Zero(states[0].PendingTasks)
+}
+
+func (s *taskAckManagerSuite) TestGetAckStates_PendingTasksCapped() {
+	domainID := uuid.New()
+	s.mockDomainCache.EXPECT().GetDomainByID(domainID).Return(cache.NewGlobalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: "domainName"},
+		&persistence.DomainConfig{Retention: 1},
+		&persistence.DomainReplicationConfig{
+			ActiveClusterName: cluster.TestCurrentClusterName,
+			Clusters: []*persistence.ClusterReplicationConfig{
+				{ClusterName: cluster.TestCurrentClusterName},
+				{ClusterName: cluster.TestAlternativeClusterName},
+			},
+		},
+		1,
+		nil,
+	), nil).AnyTimes()
+	tasks := make([]*persistence.ReplicationTaskInfo, maxAckStatePendingTasks)
+	for i := range tasks {
+		tasks[i] = &persistence.ReplicationTaskInfo{TaskID: int64(i + 1), DomainID: domainID}
+	}
+	// the queue is not read further once the cluster reached the cap
+	s.mockExecutionMgr.On("GetReplicationTasks", mock.Anything, mock.Anything).Return(&persistence.GetReplicationTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: []byte{1},
+	}, nil).Once()
+
+	states, err := s.ackManager.GetAckStates(context.Background())
+	s.NoError(err)
+	s.Len(states, 1)
+	s.Equal(maxAckStatePendingTasks, states[0].PendingTasks)
+	s.True(states[0].PendingTasksCapped)
+}
+
 func (s *taskAckManagerSuite) TestSkipTask_ReturnTrue() {
 	domainID := uuid.New()
 	domainEntity := cache.NewGlobalDomainCacheEntryForTest(
@@ -24,6 +24,7 @@ package replication
 
 import (
 	"context"
+	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/ndc"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/history/engine"
 	"github.com/uber/cadence/service/history/shard"
@@ -116,23 +118,7 @@ func (e *taskExecutorImpl) handleActivityTask(
 
 	replicationStopWatch := e.metricsClient.StartTimer(metrics.SyncActivityTaskScope, metrics.CadenceLatency)
 	defer replicationStopWatch.Stop()
-	request := &types.SyncActivityRequest{
-		DomainID:           attr.DomainID,
-		WorkflowID:         attr.WorkflowID,
-		RunID:              attr.RunID,
-		Version:            attr.Version,
-		ScheduledID:        attr.ScheduledID,
-		ScheduledTime:      attr.ScheduledTime,
-		StartedID:          attr.StartedID,
-		StartedTime:        attr.StartedTime,
-		LastHeartbeatTime:  attr.LastHeartbeatTime,
-		Details:            attr.Details,
-		Attempt:            attr.Attempt,
-		LastFailureReason:  attr.LastFailureReason,
-		LastFailureDetails: attr.LastFailureDetails,
-		LastWorkerIdentity: attr.LastWorkerIdentity,
-		VersionHistory:     attr.GetVersionHistory(),
-	}
+	request := newSyncActivityRequest(attr)
 	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
 	defer cancel()
 
@@ -201,20 +187,13 @@ func (e *taskExecutorImpl) handleHistoryReplicationTaskV2(
 	if err != nil || !doContinue {
 		return err
 	}
+	if err := DecompressTask(task); err != nil {
+		return err
+	}
 
 	replicationStopWatch := e.metricsClient.StartTimer(metrics.HistoryReplicationV2TaskScope, metrics.CadenceLatency)
 	defer replicationStopWatch.Stop()
-	request := &types.ReplicateEventsV2Request{
-		DomainUUID: attr.DomainID,
-		WorkflowExecution: &types.WorkflowExecution{
-			WorkflowID: attr.WorkflowID,
-			RunID:      attr.RunID,
-		},
-		VersionHistoryItems: attr.VersionHistoryItems,
-		Events:              attr.Events,
-		// new run events does not need version history since there is no prior events
-		NewRunEvents: attr.NewRunEvents,
-	}
+	request := newReplicateEventsV2Request(attr)
 	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
 	defer cancel()
 
@@ -233,6 +212,9 @@ func (e *taskExecutorImpl) handleHistoryReplicationTaskV2(
 	err = historyReplicationAction()
 	retryErr, ok := e.convertRetryTaskV2Error(err)
 	if !ok {
+		if err == nil {
+			e.updateDomainReplicationWatermark(attr)
+		}
 		return err
 	}
 	e.metricsClient.IncCounter(metrics.HistoryRereplicationByHistoryReplicationScope, metrics.CadenceClientRequests)
@@ -271,7 +253,76 @@ func (e *taskExecutorImpl) handleHistoryReplicationTaskV2(
 		return err
 	}
 
-	return historyReplicationAction()
+	if err := historyReplicationAction(); err != nil {
+		return err
+	}
+	e.updateDomainReplicationWatermark(attr)
+	return nil
+}
+
+func newSyncActivityRequest(
+	attr *types.SyncActivityTaskAttributes,
+) *types.SyncActivityRequest {
+
+	return &types.SyncActivityRequest{
+		DomainID:           attr.DomainID,
+		WorkflowID:         attr.WorkflowID,
+		RunID:              attr.RunID,
+		Version:            attr.Version,
+		ScheduledID:        attr.ScheduledID,
+		ScheduledTime:      attr.ScheduledTime,
+		StartedID:          attr.StartedID,
+		StartedTime:        attr.StartedTime,
+		LastHeartbeatTime:  attr.LastHeartbeatTime,
+		Details:            attr.Details,
+		Attempt:            attr.Attempt,
+		LastFailureReason:  attr.LastFailureReason,
+		LastFailureDetails: attr.LastFailureDetails,
+		LastWorkerIdentity: attr.LastWorkerIdentity,
+		VersionHistory:     attr.GetVersionHistory(),
+	}
+}
+
+func newReplicateEventsV2Request(
+	attr *types.HistoryTaskV2Attributes,
+) *types.ReplicateEventsV2Request {
+
+	return &types.ReplicateEventsV2Request{
+		DomainUUID: attr.DomainID,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: attr.WorkflowID,
+			RunID:      attr.RunID,
+		},
+		VersionHistoryItems: attr.VersionHistoryItems,
+		Events:              attr.Events,
+		// new run events does not need version history since there is no prior events
+		NewRunEvents: attr.NewRunEvents,
+	}
+}
+
+// updateDomainReplicationWatermark records the event time of the last event applied by the task
+// in shard info, so that it survives shard movement and restarts
+func (e *taskExecutorImpl) updateDomainReplicationWatermark(
+	attr *types.HistoryTaskV2Attributes,
+) {
+
+	if attr.Events == nil {
+		return
+	}
+	events, err := e.shard.GetService().GetPayloadSerializer().DeserializeBatchEvents(
+		persistence.NewDataBlobFromInternal(attr.Events),
+	)
+	if err != nil || len(events) == 0 || events[len(events)-1].Timestamp == nil {
+		return
+	}
+	eventTime := time.Unix(0, events[len(events)-1].GetTimestamp())
+	if err := e.shard.UpdateDomainReplicationWatermark(attr.GetDomainID(), eventTime); err != nil {
+		e.logger.Warn(
+			"failed to update domain replication watermark",
+			tag.WorkflowDomainID(attr.GetDomainID()),
+			tag.Error(err),
+		)
+	}
 }
 
 func (e *taskExecutorImpl) handleFailoverReplicationTask(
@@ -23,9 +23,11 @@ package replication
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
@@ -33,6 +35,7 @@ import (
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/client/admin"
 	historyClient "github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/metrics"
@@ -218,6 +221,47 @@ func (s *taskExecutorSuite) TestProcessTask_HistoryV2ReplicationTask_SameShardID
 	s.NoError(err)
 }
 
+func (s *taskExecutorSuite) TestProcessTask_HistoryV2ReplicationTask_UpdateDomainReplicationWatermark() {
+	domainID := uuid.New()
+	workflowID := "6d89f939-e6a4-4c26-a0ed-626ce27bcc9c" // belong to shard 0
+	runID := uuid.New()
+	eventTime := time.Now().Add(-time.Minute)
+	events, err := s.mockShard.GetPayloadSerializer().SerializeBatchEvents(
+		[]*types.HistoryEvent{
+			{ID: 1, Timestamp: common.Int64Ptr(eventTime.Add(-time.Second).UnixNano())},
+			{ID: 2, Timestamp: common.Int64Ptr(eventTime.UnixNano())},
+		},
+		common.EncodingTypeThriftRW,
+	)
+	s.NoError(err)
+	task := &types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(),
+		HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+			DomainID:   domainID,
+			WorkflowID: workflowID,
+			RunID:      runID,
+			Events:     events.ToInternal(),
+		},
+		// creation time of the task must not be used as the watermark
+		CreationTime: common.Int64Ptr(time.Now().UnixNano()),
+	}
+	s.mockShard.Resource.ShardMgr.On("UpdateShard", mock.Anything, mock.Anything).Return(nil)
+	s.clusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+
+	s.mockEngine.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).Return(&types.InternalServiceError{}).Times(1)
+	_, err = s.taskHandler.execute(task, true)
+	s.Error(err)
+	_, ok := s.mockShard.GetDomainReplicationWatermark(domainID)
+	s.False(ok)
+
+	s.mockEngine.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	_, err = s.taskHandler.execute(task, true)
+	s.NoError(err)
+	watermark, ok := s.mockShard.GetDomainReplicationWatermark(domainID)
+	s.True(ok)
+	s.Equal(eventTime.UnixNano(), watermark.UnixNano())
+}
+
 func (s *taskExecutorSuite) TestProcess_HistoryV2ReplicationTask_DifferentShardID() {
 	domainID := uuid.New()
 	workflowID := "abc" // belong to shard 1
@@ -283,8 +283,9 @@ func (f *taskFetcherImpl) getMessages(
 	defer cancel()
 
 	request := &types.GetReplicationMessagesRequest{
-		Tokens:      tokens,
-		ClusterName: f.currentCluster,
+		Tokens:                tokens,
+		ClusterName:           f.currentCluster,
+		SupportedCompressions: SupportedCompressions,
 	}
 	response, err := f.remotePeer.GetReplicationMessages(ctx, request)
 	if err != nil {
@@ -98,7 +98,8 @@ func (s *taskFetcherSuite) TestGetMessages() {
 		Tokens: []*types.ReplicationToken{
 			token,
 		},
-		ClusterName: "active",
+		ClusterName:           "active",
+		SupportedCompressions: SupportedCompressions,
 	}
 	messageByShared := make(map[int32]*types.ReplicationMessages)
 	messageByShared[0] = &types.ReplicationMessages{}
@@ -127,7 +128,8 @@ func (s *taskFetcherSuite) TestFetchAndDistributeTasks() {
 		Tokens: []*types.ReplicationToken{
 			token,
 		},
-		ClusterName: "active",
+		ClusterName:           "active",
+		SupportedCompressions: SupportedCompressions,
 	}
 	messageByShared := make(map[int32]*types.ReplicationMessages)
 	messageByShared[0] = &types.ReplicationMessages{}
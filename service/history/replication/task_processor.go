@@ -509,12 +509,13 @@ func (p *taskProcessorImpl) generateDLQRequest(
 		return &persistence.PutReplicationTaskToDLQRequest{
 			SourceClusterName: p.sourceCluster,
 			TaskInfo: &persistence.ReplicationTaskInfo{
-				DomainID:    taskAttributes.GetDomainID(),
-				WorkflowID:  taskAttributes.GetWorkflowID(),
-				RunID:       taskAttributes.GetRunID(),
-				TaskID:      replicationTask.GetSourceTaskID(),
-				TaskType:    persistence.ReplicationTaskTypeSyncActivity,
-				ScheduledID: taskAttributes.GetScheduledID(),
+				DomainID:     taskAttributes.GetDomainID(),
+				WorkflowID:   taskAttributes.GetWorkflowID(),
+				RunID:        taskAttributes.GetRunID(),
+				TaskID:       replicationTask.GetSourceTaskID(),
+				TaskType:     persistence.ReplicationTaskTypeSyncActivity,
+				ScheduledID:  taskAttributes.GetScheduledID(),
+				CreationTime: p.shard.GetTimeSource().Now().UnixNano(),
 			},
 		}, nil
 
@@ -542,6 +543,7 @@ func (p *taskProcessorImpl) generateDLQRequest(
 				FirstEventID: events[0].ID,
 				NextEventID:  events[len(events)-1].ID + 1,
 				Version:      events[0].Version,
+				CreationTime: p.shard.GetTimeSource().Now().UnixNano(),
 			},
 		}, nil
 	default:
@@ -631,6 +633,9 @@ func (p *taskProcessorImpl) emitDLQSizeMetricsLoop() {
 					metrics.ReplicationDLQStatsScope,
 					metrics.InstanceTag(strconv.Itoa(p.shard.GetShardID())),
 				).UpdateGauge(metrics.ReplicationDLQSize, float64(resp.Size))
+				if resp.Size > 0 {
+					p.emitDLQMessageAgeMetrics()
+				}
 			}
 		case <-p.done:
 			return
@@ -638,6 +643,57 @@ func (p *taskProcessorImpl) emitDLQSizeMetricsLoop() {
 	}
 }
 
+// emitDLQMessageAgeMetrics reports the age of the oldest DLQ message and warns when it is about to reach
+// the max age, after which the store expires it and it can no longer be merged
+func (p *taskProcessorImpl) emitDLQMessageAgeMetrics() {
+	maxAge := p.config.ReplicationDLQMessageMaxAge()
+	if maxAge <= 0 {
+		return
+	}
+
+	resp, err := p.shard.GetExecutionManager().GetReplicationTasksFromDLQ(
+		context.Background(),
+		&persistence.GetReplicationTasksFromDLQRequest{
+			SourceClusterName: p.sourceCluster,
+			GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+				ReadLevel:    defaultBeginningMessageID,
+				MaxReadLevel: common.EndMessageID,
+				BatchSize:    1,
+			},
+		},
+	)
+	if err != nil {
+		p.logger.Error("failed to read the oldest replication DLQ message", tag.Error(err))
+		p.metricsClient.Scope(metrics.ReplicationDLQStatsScope).IncCounter(metrics.ReplicationDLQProbeFailed)
+		return
+	}
+	if len(resp.Tasks) == 0 {
+		return
+	}
+
+	// DLQ messages are ordered by task ID, so the first one is the oldest
+	oldest := resp.Tasks[0]
+	expirationTime, ok := dlqMessageExpirationTime(oldest.CreationTime, maxAge)
+	if !ok {
+		return
+	}
+	now := p.shard.GetTimeSource().Now()
+	scope := p.metricsClient.Scope(
+		metrics.ReplicationDLQStatsScope,
+		metrics.InstanceTag(strconv.Itoa(p.shard.GetShardID())),
+	)
+	scope.RecordTimer(metrics.ReplicationDLQOldestMessageAge, now.Sub(time.Unix(0, oldest.CreationTime)))
+	if expirationTime.Sub(now) > p.config.ReplicationDLQMessageExpiryWarning() {
+		return
+	}
+
+	scope.IncCounter(metrics.ReplicationDLQMessageNearExpiry)
+	p.logger.Warn("Replication DLQ messages are about to expire, merge or purge them before they are lost.",
+		tag.TaskID(oldest.TaskID),
+		tag.Timestamp(expirationTime),
+	)
+}
+
 func isTransientRetryableError(err error) bool {
 	switch err.(type) {
 	case *types.BadRequestError:
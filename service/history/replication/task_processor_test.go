@@ -40,6 +40,7 @@ import (
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/metrics"
@@ -268,6 +269,60 @@ func (s *taskProcessorSuite) TestGenerateDLQRequest_ReplicationTaskTypeSyncActiv
 	s.Equal(persistence.ReplicationTaskTypeSyncActivity, request.TaskInfo.GetTaskType())
 }
 
+func (s *taskProcessorSuite) TestGenerateDLQRequest_CreationTime() {
+	task := &types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeSyncActivity.Ptr(),
+		SyncActivityTaskAttributes: &types.SyncActivityTaskAttributes{
+			DomainID:    uuid.New(),
+			WorkflowID:  uuid.New(),
+			RunID:       uuid.New(),
+			ScheduledID: 1,
+		},
+	}
+	now := time.Now()
+	s.mockShard.Resource.TimeSource = clock.NewEventTimeSource().Update(now)
+	request, err := s.taskProcessor.generateDLQRequest(task)
+	s.NoError(err)
+	s.Equal(now.UnixNano(), request.TaskInfo.CreationTime)
+}
+
+func (s *taskProcessorSuite) TestEmitDLQMessageAgeMetrics() {
+	testScope := tally.NewTestScope("", nil)
+	s.taskProcessor.metricsClient = metrics.NewClient(testScope, metrics.History)
+	s.config.ReplicationDLQMessageMaxAge = dynamicconfig.GetDurationPropertyFn(7 * 24 * time.Hour)
+	s.config.ReplicationDLQMessageExpiryWarning = dynamicconfig.GetDurationPropertyFn(24 * time.Hour)
+	nearExpiry := func() int64 {
+		for _, counter := range testScope.Snapshot().Counters() {
+			if counter.Name() == "replication_dlq_message_near_expiry" {
+				return counter.Value()
+			}
+		}
+		return 0
+	}
+
+	now := s.mockShard.GetTimeSource().Now()
+	for _, tc := range []struct {
+		age        time.Duration
+		nearExpiry int64
+	}{
+		{age: 2 * 24 * time.Hour, nearExpiry: 0},
+		{age: 6*24*time.Hour + time.Hour, nearExpiry: 1},
+	} {
+		s.executionManager.On("GetReplicationTasksFromDLQ", mock.Anything, &persistence.GetReplicationTasksFromDLQRequest{
+			SourceClusterName: "standby",
+			GetReplicationTasksRequest: persistence.GetReplicationTasksRequest{
+				ReadLevel:    defaultBeginningMessageID,
+				MaxReadLevel: common.EndMessageID,
+				BatchSize:    1,
+			},
+		}).Return(&persistence.GetReplicationTasksFromDLQResponse{
+			Tasks: []*persistence.ReplicationTaskInfo{{TaskID: 1, CreationTime: now.Add(-tc.age).UnixNano()}},
+		}, nil).Once()
+		s.taskProcessor.emitDLQMessageAgeMetrics()
+		s.Equal(tc.nearExpiry, nearExpiry())
+	}
+}
+
 func (s *taskProcessorSuite) TestTriggerDataInconsistencyScan_Success() {
 	domainID := uuid.New()
 	workflowID := uuid.New()
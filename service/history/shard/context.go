@@ -89,6 +89,9 @@ type (
 		GetClusterReplicationLevel(cluster string) int64
 		UpdateClusterReplicationLevel(cluster string, lastTaskID int64) error
 
+		GetDomainReplicationWatermark(domainID string) (time.Time, bool)
+		UpdateDomainReplicationWatermark(domainID string, eventTime time.Time) error
+
 		GetTimerAckLevel() time.Time
 		UpdateTimerAckLevel(ackLevel time.Time) error
 		GetTimerClusterAckLevel(cluster string) time.Time
@@ -394,6 +397,29 @@ func (s *contextImpl) UpdateClusterReplicationLevel(cluster string, lastTaskID i
 	return s.updateShardInfoLocked()
 }
 
+func (s *contextImpl) GetDomainReplicationWatermark(domainID string) (time.Time, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	watermark, ok := s.shardInfo.DomainReplicationWatermarks[domainID]
+	return watermark, ok
+}
+
+func (s *contextImpl) UpdateDomainReplicationWatermark(domainID string, eventTime time.Time) error {
+	s.Lock()
+	defer s.Unlock()
+
+	// watermark only moves forward, tasks may be applied out of order across workflows
+	if watermark, ok := s.shardInfo.DomainReplicationWatermarks[domainID]; ok && !eventTime.After(watermark) {
+		return nil
+	}
+	if s.shardInfo.DomainReplicationWatermarks == nil {
+		s.shardInfo.DomainReplicationWatermarks = make(map[string]time.Time)
+	}
+	s.shardInfo.DomainReplicationWatermarks[domainID] = eventTime
+	return s.updateShardInfoLocked()
+}
+
 func (s *contextImpl) GetTimerAckLevel() time.Time {
 	s.RLock()
 	defer s.RUnlock()
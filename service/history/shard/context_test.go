@@ -235,3 +235,25 @@ func (s *contextTestSuite) TestGetAndUpdateProcessingQueueStates() {
 	s.Equal(updatedTransferQueueStates[0].GetAckLevel(), s.context.GetTransferClusterAckLevel(clusterName))
 	s.Equal(time.Unix(0, updatedTimerQueueStates[0].GetAckLevel()), s.context.GetTimerClusterAckLevel(clusterName))
 }
+
+func (s *contextTestSuite) TestGetAndUpdateDomainReplicationWatermark() {
+	domainID := "some random domain ID"
+	s.mockShardManager.On("UpdateShard", mock.Anything, mock.Anything).Return(nil)
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+
+	_, ok := s.context.GetDomainReplicationWatermark(domainID)
+	s.False(ok)
+
+	eventTime := time.Now()
+	s.NoError(s.context.UpdateDomainReplicationWatermark(domainID, eventTime))
+	watermark, ok := s.context.GetDomainReplicationWatermark(domainID)
+	s.True(ok)
+	s.Equal(eventTime, watermark)
+
+	// watermark never moves backward
+	s.NoError(s.context.UpdateDomainReplicationWatermark(domainID, eventTime.Add(-time.Minute)))
+	watermark, ok = s.context.GetDomainReplicationWatermark(domainID)
+	s.True(ok)
+	s.Equal(eventTime, watermark)
+}
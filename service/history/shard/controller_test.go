@@ -163,8 +163,9 @@ func (s *controllerSuite) TestAcquireShardSuccess() {
 					TimerProcessingQueueStates: &types.ProcessingQueueStates{
 						StatesByCluster: make(map[string][]*types.ProcessingQueueState),
 					},
-					ClusterReplicationLevel: map[string]int64{},
-					ReplicationDLQAckLevel:  map[string]int64{},
+					ClusterReplicationLevel:     map[string]int64{},
+					ReplicationDLQAckLevel:      map[string]int64{},
+					DomainReplicationWatermarks: map[string]time.Time{},
 				},
 				PreviousRangeID: 5,
 			}).Return(nil).Once()
@@ -253,8 +254,9 @@ func (s *controllerSuite) TestAcquireShardsConcurrently() {
 					TimerProcessingQueueStates: &types.ProcessingQueueStates{
 						StatesByCluster: make(map[string][]*types.ProcessingQueueState),
 					},
-					ClusterReplicationLevel: map[string]int64{},
-					ReplicationDLQAckLevel:  map[string]int64{},
+					ClusterReplicationLevel:     map[string]int64{},
+					ReplicationDLQAckLevel:      map[string]int64{},
+					DomainReplicationWatermarks: map[string]time.Time{},
 				},
 				PreviousRangeID: 5,
 			}).Return(nil).Once()
@@ -350,8 +352,9 @@ func (s *controllerSuite) TestAcquireShardRenewSuccess() {
 				TimerProcessingQueueStates: &types.ProcessingQueueStates{
 					StatesByCluster: make(map[string][]*types.ProcessingQueueState),
 				},
-				ClusterReplicationLevel: map[string]int64{},
-				ReplicationDLQAckLevel:  map[string]int64{},
+				ClusterReplicationLevel:     map[string]int64{},
+				ReplicationDLQAckLevel:      map[string]int64{},
+				DomainReplicationWatermarks: map[string]time.Time{},
 			},
 			PreviousRangeID: 5,
 		}).Return(nil).Once()
@@ -432,8 +435,9 @@ func (s *controllerSuite) TestAcquireShardRenewLookupFailed() {
 				TimerProcessingQueueStates: &types.ProcessingQueueStates{
 					StatesByCluster: make(map[string][]*types.ProcessingQueueState),
 				},
-				ClusterReplicationLevel: map[string]int64{},
-				ReplicationDLQAckLevel:  map[string]int64{},
+				ClusterReplicationLevel:     map[string]int64{},
+				ReplicationDLQAckLevel:      map[string]int64{},
+				DomainReplicationWatermarks: map[string]time.Time{},
 			},
 			PreviousRangeID: 5,
 		}).Return(nil).Once()
@@ -662,8 +666,9 @@ func (s *controllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *eng
 			TimerProcessingQueueStates: &types.ProcessingQueueStates{
 				StatesByCluster: make(map[string][]*types.ProcessingQueueState),
 			},
-			ClusterReplicationLevel: map[string]int64{},
-			ReplicationDLQAckLevel:  map[string]int64{},
+			ClusterReplicationLevel:     map[string]int64{},
+			ReplicationDLQAckLevel:      map[string]int64{},
+			DomainReplicationWatermarks: map[string]time.Time{},
 		},
 		PreviousRangeID: currentRangeID,
 	}).Return(nil).Once()
@@ -112,11 +112,13 @@ type (
 
 	pushActivityToMatchingInfo struct {
 		activityScheduleToStartTimeout int32
+		attempt                        int64
 	}
 
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		tasklist                       types.TaskList
+		attempt                        int64
 	}
 )
 
@@ -132,21 +134,25 @@ func newHistoryResendInfo(
 
 func newPushActivityToMatchingInfo(
 	activityScheduleToStartTimeout int32,
+	attempt int64,
 ) *pushActivityToMatchingInfo {
 
 	return &pushActivityToMatchingInfo{
 		activityScheduleToStartTimeout: activityScheduleToStartTimeout,
+		attempt:                        attempt,
 	}
 }
 
 func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist types.TaskList,
+	attempt int64,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
+		attempt:                        attempt,
 	}
 }
 
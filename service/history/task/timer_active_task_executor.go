@@ -590,6 +590,7 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		Name: activityInfo.TaskList,
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	attempt := int64(activityInfo.Attempt)
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -600,6 +601,7 @@ func (t *timerActiveTaskExecutor) executeActivityRetryTimerTask(
 		TaskList:                      taskList,
 		ScheduleID:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(scheduleToStartTimeout),
+		Attempt:                       attempt,
 	})
 }
 
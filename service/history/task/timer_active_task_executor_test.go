@@ -1070,6 +1070,7 @@ func (s *timerActiveTaskExecutorSuite) TestActivityRetryTimer_Fire() {
 			},
 			ScheduleID:                    activityInfo.ScheduleID,
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityInfo.ScheduleToStartTimeout),
+			Attempt:                       int64(activityInfo.Attempt),
 		},
 	).Return(nil).Times(1)
 
@@ -178,6 +178,7 @@ func (t *timerTaskExecutorBase) archiveWorkflow(
 			ShardID:              t.shard.GetShardID(),
 			Targets:              []archiver.ArchivalTarget{archiver.ArchiveTargetHistory},
 			URI:                  domainCacheEntry.GetConfig().HistoryArchivalURI,
+			HistoryProvider:      domainCacheEntry.GetInfo().Data[common.DomainDataKeyForHistoryArchivalProvider],
 			NextEventID:          msBuilder.GetNextEventID(),
 			BranchToken:          branchToken,
 			CloseFailoverVersion: closeFailoverVersion,
@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -43,6 +44,7 @@ import (
 	"github.com/uber/cadence/service/history/execution"
 	"github.com/uber/cadence/service/history/reset"
 	"github.com/uber/cadence/service/history/shard"
+	"github.com/uber/cadence/service/history/workflow"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
 )
@@ -71,6 +73,9 @@ type (
 		historyClient           history.Client
 		parentClosePolicyClient parentclosepolicy.Client
 		workflowResetter        reset.WorkflowResetter
+		// childStartRateLimiter throttles child workflow starts per parent workflow ID,
+		// it is enforced here since the parent workflow is owned by this shard
+		childStartRateLimiter *workflow.IDRateLimiter
 	}
 
 	generatorF = func(taskGenerator execution.MutableStateTaskGenerator) error
@@ -102,6 +107,12 @@ func NewTransferActiveTaskExecutor(
 			config.NumParentClosePolicySystemWorkflows(),
 		),
 		workflowResetter: workflowResetter,
+		childStartRateLimiter: workflow.NewIDRateLimiter(
+			config.WorkflowIDRateLimiterCacheSize(),
+			map[workflow.IDRequestType]dynamicconfig.IntPropertyFnWithDomainFilter{
+				workflow.IDRequestTypeChildStart: config.WorkflowIDChildStartRPS,
+			},
+		),
 	}
 }
 
@@ -189,10 +200,11 @@ func (t *transferActiveTaskExecutor) processActivityTask(
 	}
 
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	attempt := int64(ai.Attempt)
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(ctx, task, timeout)
+	return t.pushActivity(ctx, task, timeout, attempt)
 }
 
 func (t *transferActiveTaskExecutor) processDecisionTask(
@@ -261,7 +273,7 @@ func (t *transferActiveTaskExecutor) processDecisionTask(
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushDecision(ctx, task, taskList, decisionTimeout)
+	return t.pushDecision(ctx, task, taskList, decisionTimeout, decision.Attempt)
 }
 
 func (t *transferActiveTaskExecutor) processCloseExecution(
@@ -828,6 +840,10 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 	// remaining 2 cases:
 	// workflow running, child not started, close policy is or is not abandon
 
+	if err := t.allowChildStart(task); err != nil {
+		return err
+	}
+
 	initiatedEvent, err := mutableState.GetChildExecutionInitiatedEvent(ctx, initiatedEventID)
 	if err != nil {
 		return err
@@ -884,6 +900,24 @@ func (t *transferActiveTaskExecutor) processStartChildExecution(
 		})
 }
 
+// allowChildStart returns an error if the parent workflow exceeded its child workflow start quota,
+// the transfer task is then retried with backoff
+func (t *transferActiveTaskExecutor) allowChildStart(
+	task *persistence.TransferTaskInfo,
+) error {
+
+	domainName, err := t.shard.GetDomainCache().GetDomainName(task.DomainID)
+	if err != nil {
+		// throttling is best effort, do not block the child start when the domain cannot be resolved
+		return nil
+	}
+	if err := t.childStartRateLimiter.Allow(task.DomainID, domainName, task.WorkflowID, workflow.IDRequestTypeChildStart); err != nil {
+		t.metricsClient.IncCounter(metrics.TransferActiveTaskStartChildExecutionScope, metrics.WorkflowIDRateLimitedCounter)
+		return err
+	}
+	return nil
+}
+
 func (t *transferActiveTaskExecutor) processRecordWorkflowStarted(
 	ctx context.Context,
 	task *persistence.TransferTaskInfo,
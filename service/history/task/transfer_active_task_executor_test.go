@@ -1666,6 +1666,7 @@ func createAddActivityTaskRequest(
 		TaskList:                      taskList,
 		ScheduleID:                    taskInfo.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(ai.ScheduleToStartTimeout),
+		Attempt:                       int64(ai.Attempt),
 	}
 }
 
@@ -1687,6 +1688,7 @@ func createAddDecisionTaskRequest(
 		taskList.Kind = &taskListStickyKind
 		timeout = executionInfo.StickyScheduleToStartTimeout
 	}
+	decision, _ := mutableState.GetDecisionInfo(taskInfo.ScheduleID)
 
 	return &types.AddDecisionTaskRequest{
 		DomainUUID:                    taskInfo.DomainID,
@@ -1694,6 +1696,7 @@ func createAddDecisionTaskRequest(
 		TaskList:                      taskList,
 		ScheduleID:                    taskInfo.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(timeout),
+		Attempt:                       decision.Attempt,
 	}
 }
 
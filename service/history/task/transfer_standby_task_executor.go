@@ -139,6 +139,7 @@ func (t *transferStandbyTaskExecutor) processActivityTask(
 		if activityInfo.StartedID == common.EmptyEventID {
 			return newPushActivityToMatchingInfo(
 				activityInfo.ScheduleToStartTimeout,
+				int64(activityInfo.Attempt),
 			), nil
 		}
 
@@ -187,6 +188,7 @@ func (t *transferStandbyTaskExecutor) processDecisionTask(
 			return newPushDecisionToMatchingInfo(
 				decisionTimeout,
 				types.TaskList{Name: transferTask.TaskList},
+				decisionInfo.Attempt,
 			), nil
 		}
 
@@ -578,6 +580,7 @@ func (t *transferStandbyTaskExecutor) pushActivity(
 		ctx,
 		task.(*persistence.TransferTaskInfo),
 		timeout,
+		pushActivityInfo.attempt,
 	)
 }
 
@@ -599,6 +602,7 @@ func (t *transferStandbyTaskExecutor) pushDecision(
 		task.(*persistence.TransferTaskInfo),
 		&pushDecisionInfo.tasklist,
 		timeout,
+		pushDecisionInfo.attempt,
 	)
 }
 
@@ -89,6 +89,7 @@ func (t *transferTaskExecutorBase) pushActivity(
 	ctx context.Context,
 	task *persistence.TransferTaskInfo,
 	activityScheduleToStartTimeout int32,
+	attempt int64,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -108,6 +109,7 @@ func (t *transferTaskExecutorBase) pushActivity(
 		TaskList:                      &types.TaskList{Name: task.TaskList},
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(activityScheduleToStartTimeout),
+		Attempt:                       attempt,
 	})
 }
 
@@ -116,6 +118,7 @@ func (t *transferTaskExecutorBase) pushDecision(
 	task *persistence.TransferTaskInfo,
 	tasklist *types.TaskList,
 	decisionScheduleToStartTimeout int32,
+	attempt int64,
 ) error {
 
 	ctx, cancel := context.WithTimeout(ctx, taskRPCCallTimeout)
@@ -134,6 +137,7 @@ func (t *transferTaskExecutorBase) pushDecision(
 		TaskList:                      tasklist,
 		ScheduleID:                    task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(decisionScheduleToStartTimeout),
+		Attempt:                       attempt,
 	})
 }
 
@@ -327,6 +331,7 @@ func (t *transferTaskExecutorBase) recordWorkflowClosed(
 				Memo:               visibilityMemo,
 				SearchAttributes:   searchAttributes,
 				VisibilityURI:      domainEntry.GetConfig().VisibilityArchivalURI,
+				VisibilityProvider: domainEntry.GetInfo().Data[common.DomainDataKeyForVisibilityArchivalProvider],
 				URI:                domainEntry.GetConfig().HistoryArchivalURI,
 				Targets:            []archiver.ArchivalTarget{archiver.ArchiveTargetVisibility},
 			},
@@ -79,6 +79,12 @@ func (t ThriftHandler) DescribeQueue(ctx context.Context, request *shared.Descri
 	return thrift.FromDescribeQueueResponse(response), thrift.FromError(err)
 }
 
+// GetReplicationAckStates forwards request to the underlying handler
+func (t ThriftHandler) GetReplicationAckStates(ctx context.Context, request *shared.GetReplicationAckStatesRequest) (*shared.GetReplicationAckStatesResponse, error) {
+	response, err := t.h.GetReplicationAckStates(ctx, thrift.ToGetReplicationAckStatesRequest(request))
+	return thrift.FromGetReplicationAckStatesResponse(response), thrift.FromError(err)
+}
+
 // DescribeWorkflowExecution forwards request to the underlying handler
 func (t ThriftHandler) DescribeWorkflowExecution(ctx context.Context, request *h.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
 	response, err := t.h.DescribeWorkflowExecution(ctx, thrift.ToHistoryDescribeWorkflowExecutionRequest(request))
@@ -181,6 +187,12 @@ func (t ThriftHandler) RefreshWorkflowTasks(ctx context.Context, request *h.Refr
 	return thrift.FromError(err)
 }
 
+// ReconcileWorkflowRetention forwards request to the underlying handler
+func (t ThriftHandler) ReconcileWorkflowRetention(ctx context.Context, request *h.ReconcileWorkflowRetentionRequest) error {
+	err := t.h.ReconcileWorkflowRetention(ctx, thrift.ToReconcileWorkflowRetentionRequest(request))
+	return thrift.FromError(err)
+}
+
 // RemoveSignalMutableState forwards request to the underlying handler
 func (t ThriftHandler) RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error {
 	err := t.h.RemoveSignalMutableState(ctx, thrift.ToRemoveSignalMutableStateRequest(request))
@@ -277,6 +289,12 @@ func (t ThriftHandler) SignalWorkflowExecution(ctx context.Context, request *h.S
 	return thrift.FromError(err)
 }
 
+// SignalBatchWorkflowExecution forwards request to the underlying handler
+func (t ThriftHandler) SignalBatchWorkflowExecution(ctx context.Context, request *h.SignalBatchWorkflowExecutionRequest) error {
+	err := t.h.SignalBatchWorkflowExecution(ctx, thrift.ToHistorySignalBatchWorkflowExecutionRequest(request))
+	return thrift.FromError(err)
+}
+
 // StartWorkflowExecution forwards request to the underlying handler
 func (t ThriftHandler) StartWorkflowExecution(ctx context.Context, request *h.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
 	response, err := t.h.StartWorkflowExecution(ctx, thrift.ToHistoryStartWorkflowExecutionRequest(request))
@@ -306,3 +324,9 @@ func (t ThriftHandler) GetFailoverInfo(ctx context.Context, request *h.GetFailov
 	response, err := t.h.GetFailoverInfo(ctx, thrift.ToGetFailoverInfoRequest(request))
 	return thrift.FromGetFailoverInfoResponse(response), thrift.FromError(err)
 }
+
+// GetDomainReplicationWatermark forwards request to the underlying handler
+func (t ThriftHandler) GetDomainReplicationWatermark(ctx context.Context, request *h.GetDomainReplicationWatermarkRequest) (*h.GetDomainReplicationWatermarkResponse, error) {
+	response, err := t.h.GetDomainReplicationWatermark(ctx, thrift.ToHistoryGetDomainReplicationWatermarkRequest(request))
+	return thrift.FromHistoryGetDomainReplicationWatermarkResponse(response), thrift.FromError(err)
+}
@@ -74,6 +74,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, shared.DescribeQueueResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("GetReplicationAckStates", func(t *testing.T) {
+		h.EXPECT().GetReplicationAckStates(ctx, &types.GetReplicationAckStatesRequest{}).Return(&types.GetReplicationAckStatesResponse{}, internalErr).Times(1)
+		resp, err := th.GetReplicationAckStates(ctx, &shared.GetReplicationAckStatesRequest{})
+		assert.Equal(t, shared.GetReplicationAckStatesResponse{}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("DescribeWorkflowExecution", func(t *testing.T) {
 		h.EXPECT().DescribeWorkflowExecution(ctx, &types.HistoryDescribeWorkflowExecutionRequest{}).Return(&types.DescribeWorkflowExecutionResponse{}, internalErr).Times(1)
 		resp, err := th.DescribeWorkflowExecution(ctx, &hist.DescribeWorkflowExecutionRequest{})
@@ -193,6 +199,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.RefreshWorkflowTasks(ctx, &hist.RefreshWorkflowTasksRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ReconcileWorkflowRetention", func(t *testing.T) {
+		h.EXPECT().ReconcileWorkflowRetention(ctx, &types.ReconcileWorkflowRetentionRequest{}).Return(internalErr).Times(1)
+		err := th.ReconcileWorkflowRetention(ctx, &hist.ReconcileWorkflowRetentionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("RemoveSignalMutableState", func(t *testing.T) {
 		h.EXPECT().RemoveSignalMutableState(ctx, &types.RemoveSignalMutableStateRequest{}).Return(internalErr).Times(1)
 		err := th.RemoveSignalMutableState(ctx, &hist.RemoveSignalMutableStateRequest{})
@@ -278,6 +289,11 @@ func TestThriftHandler(t *testing.T) {
 		err := th.SignalWorkflowExecution(ctx, &hist.SignalWorkflowExecutionRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("SignalBatchWorkflowExecution", func(t *testing.T) {
+		h.EXPECT().SignalBatchWorkflowExecution(ctx, &types.HistorySignalBatchWorkflowExecutionRequest{}).Return(internalErr).Times(1)
+		err := th.SignalBatchWorkflowExecution(ctx, &hist.SignalBatchWorkflowExecutionRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("StartWorkflowExecution", func(t *testing.T) {
 		h.EXPECT().StartWorkflowExecution(ctx, &types.HistoryStartWorkflowExecutionRequest{}).Return(&types.StartWorkflowExecutionResponse{}, internalErr).Times(1)
 		resp, err := th.StartWorkflowExecution(ctx, &hist.StartWorkflowExecutionRequest{})
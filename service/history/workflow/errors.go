@@ -59,4 +59,10 @@ var (
 	ErrConsistentQueryBufferExceeded = &types.InternalServiceError{Message: "consistent query buffer is full, cannot accept new consistent queries"}
 	// ErrConcurrentStartRequest is error indicating there is an outstanding start workflow request. The incoming request fails to acquires the lock before the outstanding request finishes.
 	ErrConcurrentStartRequest = &types.ServiceBusyError{Message: "an outstanding start workflow request is in-progress. Failed to acquire the resource."}
+	// ErrSignalRateLimitExceeded is error indicating the workflow ID received more signals than its quota allows
+	ErrSignalRateLimitExceeded = &types.LimitExceededError{Message: "workflow ID signal rate limit exceeded"}
+	// ErrQueryRateLimitExceeded is error indicating the workflow ID received more queries than its quota allows
+	ErrQueryRateLimitExceeded = &types.LimitExceededError{Message: "workflow ID query rate limit exceeded"}
+	// ErrChildStartRateLimitExceeded is error indicating the workflow ID started more child workflows than its quota allows
+	ErrChildStartRateLimitExceeded = &types.LimitExceededError{Message: "workflow ID child workflow start rate limit exceeded"}
 )
@@ -0,0 +1,162 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	// PayloadType is the kind of payload tracked by PayloadSizeStats
+	PayloadType int
+
+	// PayloadSizeStats keeps the payload size distribution of each domain seen by a history host
+	// over a sliding window, so that domains trending toward the blob size limit can be found
+	// before their workflows start failing on it
+	PayloadSizeStats struct {
+		sync.Mutex
+
+		window     dynamicconfig.DurationPropertyFn
+		timeSource clock.TimeSource
+		stats      map[payloadSizeStatsKey]*payloadSizeStatsEntry
+	}
+
+	payloadSizeStatsKey struct {
+		domainName  string
+		payloadType PayloadType
+	}
+
+	payloadSizeStatsEntry struct {
+		windowStart    time.Time
+		count          int64
+		totalSize      int64
+		maxSize        int64
+		nearLimitCount int64
+	}
+)
+
+const (
+	// PayloadTypeActivityInput is the input of an activity scheduled by a decision
+	PayloadTypeActivityInput PayloadType = iota
+	// PayloadTypeActivityResult is the result of a completed activity
+	PayloadTypeActivityResult
+	// PayloadTypeDecisionInput is the input of a workflow started by a decision,
+	// either a child workflow or a continued as new run
+	PayloadTypeDecisionInput
+	// PayloadTypeDecisionResult is the result of a workflow completed by a decision
+	PayloadTypeDecisionResult
+)
+
+func (t PayloadType) String() string {
+	switch t {
+	case PayloadTypeActivityInput:
+		return "activity_input"
+	case PayloadTypeActivityResult:
+		return "activity_result"
+	case PayloadTypeDecisionInput:
+		return "decision_input"
+	case PayloadTypeDecisionResult:
+		return "decision_result"
+	default:
+		return "unknown"
+	}
+}
+
+// NewPayloadSizeStats creates a new PayloadSizeStats
+func NewPayloadSizeStats(
+	window dynamicconfig.DurationPropertyFn,
+	timeSource clock.TimeSource,
+) *PayloadSizeStats {
+	return &PayloadSizeStats{
+		window:     window,
+		timeSource: timeSource,
+		stats:      make(map[payloadSizeStatsKey]*payloadSizeStatsEntry),
+	}
+}
+
+// Record adds a payload size to the distribution of the domain. It returns true when the payload is
+// the first one of its domain and type above nearLimit in the current window, so callers can warn
+// about the domain once per window instead of once per payload.
+func (s *PayloadSizeStats) Record(
+	domainName string,
+	payloadType PayloadType,
+	size int,
+	nearLimit int,
+) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	now := s.timeSource.Now()
+	key := payloadSizeStatsKey{domainName: domainName, payloadType: payloadType}
+	entry, ok := s.stats[key]
+	if !ok || now.Sub(entry.windowStart) >= s.window() {
+		entry = &payloadSizeStatsEntry{windowStart: now}
+		s.stats[key] = entry
+	}
+
+	entry.count++
+	entry.totalSize += int64(size)
+	if int64(size) > entry.maxSize {
+		entry.maxSize = int64(size)
+	}
+	if size <= nearLimit {
+		return false
+	}
+	entry.nearLimitCount++
+	return entry.nearLimitCount == 1
+}
+
+// Describe returns the payload size distribution of the current window, ordered by domain and payload type
+func (s *PayloadSizeStats) Describe() []*types.DomainPayloadSizeStats {
+	s.Lock()
+	defer s.Unlock()
+
+	now := s.timeSource.Now()
+	window := s.window()
+	var result []*types.DomainPayloadSizeStats
+	for key, entry := range s.stats {
+		if now.Sub(entry.windowStart) >= window {
+			delete(s.stats, key)
+			continue
+		}
+		result = append(result, &types.DomainPayloadSizeStats{
+			DomainName:     key.domainName,
+			PayloadType:    key.payloadType.String(),
+			Count:          entry.count,
+			AverageSize:    entry.totalSize / entry.count,
+			MaxSize:        entry.maxSize,
+			NearLimitCount: entry.nearLimitCount,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].DomainName != result[j].DomainName {
+			return result[i].DomainName < result[j].DomainName
+		}
+		return result[i].PayloadType < result[j].PayloadType
+	})
+	return result
+}
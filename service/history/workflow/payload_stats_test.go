@@ -0,0 +1,57 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestPayloadSizeStats(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	stats := NewPayloadSizeStats(dynamicconfig.GetDurationPropertyFn(time.Hour), timeSource)
+
+	// only the first near limit payload of a domain and type in the window asks for a warning
+	assert.False(t, stats.Record("domain-b", PayloadTypeActivityResult, 100, 500))
+	assert.True(t, stats.Record("domain-b", PayloadTypeActivityResult, 900, 500))
+	assert.False(t, stats.Record("domain-b", PayloadTypeActivityResult, 800, 500))
+	assert.True(t, stats.Record("domain-b", PayloadTypeActivityInput, 600, 500))
+	assert.False(t, stats.Record("domain-a", PayloadTypeDecisionResult, 50, 500))
+
+	assert.Equal(t, []*types.DomainPayloadSizeStats{
+		{DomainName: "domain-a", PayloadType: "decision_result", Count: 1, AverageSize: 50, MaxSize: 50},
+		{DomainName: "domain-b", PayloadType: "activity_input", Count: 1, AverageSize: 600, MaxSize: 600, NearLimitCount: 1},
+		{DomainName: "domain-b", PayloadType: "activity_result", Count: 3, AverageSize: 600, MaxSize: 900, NearLimitCount: 2},
+	}, stats.Describe())
+
+	// a new window starts over, and stale windows are not described
+	timeSource.Update(timeSource.Now().Add(time.Hour))
+	assert.True(t, stats.Record("domain-b", PayloadTypeActivityResult, 700, 500))
+	assert.Equal(t, []*types.DomainPayloadSizeStats{
+		{DomainName: "domain-b", PayloadType: "activity_result", Count: 1, AverageSize: 700, MaxSize: 700, NearLimitCount: 1},
+	}, stats.Describe())
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/quotas"
+)
+
+type (
+	// IDRequestType is the type of external event throttled by IDRateLimiter
+	IDRequestType int
+
+	// IDRateLimiter throttles external events targeting a single workflow ID,
+	// so that one hot workflow cannot overwhelm its shard
+	IDRateLimiter struct {
+		limiters cache.Cache
+		rpsFns   map[IDRequestType]dynamicconfig.IntPropertyFnWithDomainFilter
+	}
+
+	idRateLimiterKey struct {
+		domainID    string
+		workflowID  string
+		requestType IDRequestType
+	}
+)
+
+const (
+	// IDRequestTypeSignal is a signal sent to the workflow
+	IDRequestTypeSignal IDRequestType = iota
+	// IDRequestTypeQuery is a query of the workflow
+	IDRequestTypeQuery
+	// IDRequestTypeChildStart is a child workflow started by the workflow
+	IDRequestTypeChildStart
+)
+
+const idRateLimiterTTL = time.Minute
+
+// NewIDRateLimiter creates a workflow ID rate limiter, request types without a rps function are not throttled
+func NewIDRateLimiter(
+	cacheSize int,
+	rpsFns map[IDRequestType]dynamicconfig.IntPropertyFnWithDomainFilter,
+) *IDRateLimiter {
+	return &IDRateLimiter{
+		limiters: cache.New(&cache.Options{
+			TTL:      idRateLimiterTTL,
+			MaxCount: cacheSize,
+		}),
+		rpsFns: rpsFns,
+	}
+}
+
+// Allow returns an error if the given workflow ID exceeded its quota for the request type,
+// a non-positive quota means the workflow ID is not throttled
+func (r *IDRateLimiter) Allow(
+	domainID string,
+	domainName string,
+	workflowID string,
+	requestType IDRequestType,
+) error {
+	rpsFn, ok := r.rpsFns[requestType]
+	if !ok || rpsFn(domainName) <= 0 {
+		return nil
+	}
+
+	key := idRateLimiterKey{
+		domainID:    domainID,
+		workflowID:  workflowID,
+		requestType: requestType,
+	}
+	limiter, ok := r.limiters.Get(key).(quotas.Limiter)
+	if !ok {
+		newLimiter := quotas.NewDynamicRateLimiter(func() float64 {
+			return float64(rpsFn(domainName))
+		})
+		existing, err := r.limiters.PutIfNotExist(key, newLimiter)
+		if err != nil {
+			return err
+		}
+		limiter = existing.(quotas.Limiter)
+	}
+
+	if !limiter.Allow() {
+		switch requestType {
+		case IDRequestTypeQuery:
+			return ErrQueryRateLimitExceeded
+		case IDRequestTypeChildStart:
+			return ErrChildStartRateLimitExceeded
+		default:
+			return ErrSignalRateLimitExceeded
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+)
+
+func TestIDRateLimiter(t *testing.T) {
+	signalRPS := func(domain string) int {
+		if domain == "throttled-domain" {
+			return 1
+		}
+		return 0
+	}
+	queryRPS := func(domain string) int { return 0 }
+	childStartRPS := func(domain string) int { return 1 }
+	limiter := NewIDRateLimiter(10, map[IDRequestType]dynamicconfig.IntPropertyFnWithDomainFilter{
+		IDRequestTypeSignal:     signalRPS,
+		IDRequestTypeQuery:      queryRPS,
+		IDRequestTypeChildStart: childStartRPS,
+	})
+
+	// burst is allowed first, then the workflow ID gets throttled
+	var err error
+	for i := 0; i < 100 && err == nil; i++ {
+		err = limiter.Allow("domain-id", "throttled-domain", "hot-workflow", IDRequestTypeSignal)
+	}
+	assert.Equal(t, ErrSignalRateLimitExceeded, err)
+
+	// other workflow IDs and request types are not affected
+	assert.NoError(t, limiter.Allow("domain-id", "throttled-domain", "other-workflow", IDRequestTypeSignal))
+	assert.NoError(t, limiter.Allow("domain-id", "throttled-domain", "hot-workflow", IDRequestTypeQuery))
+	assert.NoError(t, limiter.Allow("domain-id", "throttled-domain", "hot-workflow", IDRequestTypeChildStart))
+
+	// request types without a rps function are not throttled
+	noChildLimiter := NewIDRateLimiter(10, map[IDRequestType]dynamicconfig.IntPropertyFnWithDomainFilter{
+		IDRequestTypeSignal: signalRPS,
+	})
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, noChildLimiter.Allow("domain-id", "throttled-domain", "hot-workflow", IDRequestTypeChildStart))
+	}
+
+	// zero rps disables throttling
+	for i := 0; i < 100; i++ {
+		assert.NoError(t, limiter.Allow("other-domain-id", "other-domain", "hot-workflow", IDRequestTypeSignal))
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+type (
+	// taskAffinity routes tasks of a workflow to the poller identity that handled the previous
+	// task of the same workflow, for as long as that identity keeps polling the tasklist.
+	// Affinity is best effort: tasks fall back to regular matching when the owner is not waiting
+	// for a task, or the affinity has expired
+	taskAffinity struct {
+		ttl        func() time.Duration
+		timeSource clock.TimeSource
+
+		sync.Mutex
+		owners    map[string]*affinityOwner  // affinity key -> identity owning it
+		pollers   map[string]*affinityPoller // poller identity -> channel for affine tasks
+		lastSweep time.Time
+	}
+
+	affinityOwner struct {
+		identity   string
+		expiryTime time.Time
+	}
+
+	affinityPoller struct {
+		taskC        chan *InternalTask
+		outstanding  int
+		lastPollTime time.Time
+	}
+)
+
+func newTaskAffinity(config *taskListConfig, timeSource clock.TimeSource) *taskAffinity {
+	return &taskAffinity{
+		ttl:        config.TaskAffinityTTL,
+		timeSource: timeSource,
+		owners:     make(map[string]*affinityOwner),
+		pollers:    make(map[string]*affinityPoller),
+	}
+}
+
+// acquirePoller registers an outstanding poll of the identity on the context and returns the
+// channel on which tasks affine to that identity are delivered. The channel is nil when affinity
+// routing is disabled or the poll carries no identity. The returned func must be called once
+// the poll completes
+func (a *taskAffinity) acquirePoller(ctx context.Context) (<-chan *InternalTask, func()) {
+	identity, _ := ctx.Value(identityKey).(string)
+	if identity == "" || a.ttl() <= 0 {
+		return nil, func() {}
+	}
+
+	a.Lock()
+	defer a.Unlock()
+	poller, ok := a.pollers[identity]
+	if !ok {
+		poller = &affinityPoller{taskC: make(chan *InternalTask)}
+		a.pollers[identity] = poller
+	}
+	poller.outstanding++
+	poller.lastPollTime = a.timeSource.Now()
+	return poller.taskC, func() {
+		a.Lock()
+		defer a.Unlock()
+		poller.outstanding--
+		poller.lastPollTime = a.timeSource.Now()
+	}
+}
+
+// recordMatch makes the identity on the context the owner of the affinity key of the task
+func (a *taskAffinity) recordMatch(ctx context.Context, task *InternalTask) {
+	identity, _ := ctx.Value(identityKey).(string)
+	key := task.affinityKey()
+	ttl := a.ttl()
+	if identity == "" || key == "" || ttl <= 0 {
+		return
+	}
+
+	now := a.timeSource.Now()
+	a.Lock()
+	defer a.Unlock()
+	a.owners[key] = &affinityOwner{identity: identity, expiryTime: now.Add(ttl)}
+	a.sweepLocked(now, ttl)
+}
+
+// offer hands the task to a poller of the identity owning its affinity key if one is waiting
+// right now. It never blocks, so that dispatching a batch of backlog tasks is not slowed down
+// by owners that are busy. Returns false when the task must be matched regularly
+func (a *taskAffinity) offer(task *InternalTask) bool {
+	taskC := a.ownerTaskC(task)
+	if taskC == nil {
+		return false
+	}
+
+	select {
+	case taskC <- task:
+		return true
+	default:
+		return false
+	}
+}
+
+// ownerTaskC returns the affine task channel of the identity owning the affinity key of the task,
+// or nil when there is no owner or the owner stopped polling
+func (a *taskAffinity) ownerTaskC(task *InternalTask) chan *InternalTask {
+	key := task.affinityKey()
+	ttl := a.ttl()
+	if key == "" || ttl <= 0 {
+		return nil
+	}
+
+	now := a.timeSource.Now()
+	a.Lock()
+	defer a.Unlock()
+	owner, ok := a.owners[key]
+	if !ok {
+		return nil
+	}
+	if now.After(owner.expiryTime) {
+		delete(a.owners, key)
+		return nil
+	}
+	poller, ok := a.pollers[owner.identity]
+	if !ok || !poller.isPolling(now, ttl) {
+		return nil
+	}
+	return poller.taskC
+}
+
+// sweepLocked drops expired affinities and identities that stopped polling, at most once per ttl
+func (a *taskAffinity) sweepLocked(now time.Time, ttl time.Duration) {
+	if now.Sub(a.lastSweep) < ttl {
+		return
+	}
+	a.lastSweep = now
+	for key, owner := range a.owners {
+		if now.After(owner.expiryTime) {
+			delete(a.owners, key)
+		}
+	}
+	for identity, poller := range a.pollers {
+		if !poller.isPolling(now, ttl) {
+			delete(a.pollers, identity)
+		}
+	}
+}
+
+func (p *affinityPoller) isPolling(now time.Time, ttl time.Duration) bool {
+	return p.outstanding > 0 || now.Sub(p.lastPollTime) <= ttl
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func newAffinityTestTask(affinityKey string) *InternalTask {
+	return newInternalTask(&persistence.TaskInfo{AffinityKey: affinityKey}, nil, types.TaskSourceDbBacklog, "", false)
+}
+
+func TestTaskAffinity_Disabled(t *testing.T) {
+	affinity := newTaskAffinity(&taskListConfig{
+		TaskAffinityTTL: func() time.Duration { return 0 },
+	}, clock.NewRealTimeSource())
+	ctx := context.WithValue(context.Background(), identityKey, "worker-a")
+
+	taskC, release := affinity.acquirePoller(ctx)
+	defer release()
+	assert.Nil(t, taskC)
+
+	task := newAffinityTestTask("wf")
+	affinity.recordMatch(ctx, task)
+	assert.Nil(t, affinity.ownerTaskC(task))
+}
+
+func TestTaskAffinity_Expiry(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	affinity := newTaskAffinity(&taskListConfig{
+		TaskAffinityTTL: func() time.Duration { return time.Minute },
+	}, timeSource)
+	ctx := context.WithValue(context.Background(), identityKey, "worker-a")
+	task := newAffinityTestTask("wf")
+
+	taskC, release := affinity.acquirePoller(ctx)
+	affinity.recordMatch(ctx, task)
+	assert.Equal(t, taskC, (<-chan *InternalTask)(affinity.ownerTaskC(task)))
+	assert.Nil(t, affinity.ownerTaskC(newAffinityTestTask("other-wf")))
+	release()
+
+	// the identity keeps its affinity for ttl after its last poll completed
+	timeSource.Update(timeSource.Now().Add(30 * time.Second))
+	assert.NotNil(t, affinity.ownerTaskC(task))
+
+	timeSource.Update(timeSource.Now().Add(time.Minute))
+	assert.Nil(t, affinity.ownerTaskC(task))
+	affinity.recordMatch(context.WithValue(context.Background(), identityKey, "worker-b"), newAffinityTestTask("wf2"))
+	assert.Empty(t, affinity.pollers)
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -39,11 +40,46 @@ type (
 		DomainUserRPS           dynamicconfig.IntPropertyFnWithDomainFilter
 		DomainWorkerRPS         dynamicconfig.IntPropertyFnWithDomainFilter
 		ShutdownDrainDuration   dynamicconfig.DurationPropertyFn
+		DomainDispatchWeight    dynamicconfig.IntPropertyFnWithDomainFilter
+		MaxTaskListsPerDomain   dynamicconfig.IntPropertyFnWithDomainFilter
+
+		// persistence error rate above which the host reports itself not ok, 0 disables the check
+		HealthMaxPersistenceErrorRate dynamicconfig.FloatPropertyFn
+
+		// ephemeral tasklist configuration
+		EnableEphemeralTaskList   dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		EphemeralSyncMatchTimeout dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// task affinity configuration
+		TaskAffinityTTL dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// task dedupe configuration
+		TaskDedupeWindow  dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		TaskDedupeMaxSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+
+		// dispatch tracing configuration
+		EnableDispatchTracing dynamicconfig.BoolPropertyFnWithDomainIDFilter
+
+		// dispatch buffered tasks by earliest deadline instead of FIFO
+		EnableDeadlineOrderedDispatch dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+
+		// draining of the partitions left over after the number of partitions is reduced
+		EnablePartitionDrain        dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		PartitionDrainCheckInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+
+		// mirroring of tasks to a shadow tasklist to validate a new worker fleet before cutting traffic over
+		ShadowTaskList            dynamicconfig.StringPropertyFnWithTaskListInfoFilters
+		ShadowTaskListSampleRatio dynamicconfig.FloatPropertyFnWithTaskListInfoFilters
+		ShadowTaskListMode        dynamicconfig.StringPropertyFnWithTaskListInfoFilters
+
+		// describing a task list that is not loaded reads its persisted metadata instead of loading it
+		EnableReadOnlyDescribeTaskList dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
 
 		// taskListManager configuration
 		RangeSize                    int64
 		GetTasksBatchSize            dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		UpdateAckInterval            dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		MaxIdleTaskReadInterval      dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		IdleTasklistCheckInterval    dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		MaxTasklistIdleTime          dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		NumTasklistWritePartitions   dynamicconfig.IntPropertyFnWithTaskListInfoFilters
@@ -52,6 +88,11 @@ type (
 		ForwarderMaxOutstandingTasks dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		ForwarderMaxRatePerSecond    dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		ForwarderMaxChildrenPerNode  dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		// interval at which partitions refresh the poller hints of their ancestors, 0 disables the hints
+		ForwarderPartitionHintInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		// isolation zone of the pollers inferred from their identity, and whether tasks may be forwarded across zones
+		PollerZonePattern          dynamicconfig.StringPropertyFnWithTaskListInfoFilters
+		DisableCrossZoneForwarding dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
@@ -60,7 +101,9 @@ type (
 
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		OverloadShedPolicy              dynamicconfig.StringPropertyFnWithTaskListInfoFilters
 		MaxTaskBatchSize                dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		TaskBatchFlushInterval          dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
 
@@ -74,25 +117,52 @@ type (
 		ForwarderMaxOutstandingTasks func() int
 		ForwarderMaxRatePerSecond    func() int
 		ForwarderMaxChildrenPerNode  func() int
+		// Ancestor partitions known to have no waiting pollers are skipped when forwarding tasks
+		ForwarderPartitionHintInterval func() time.Duration
+		// Tasks are not forwarded to ancestor partitions whose pollers are all in other isolation zones
+		ForwarderDisableCrossZone func() bool
+		PollerZonePattern         func() string
 	}
 
 	taskListConfig struct {
 		forwarderConfig
 		EnableSyncMatch func() bool
+		// Ephemeral tasklists only sync match tasks and never persist them
+		EnableEphemeralTaskList   func() bool
+		EphemeralSyncMatchTimeout func() time.Duration
+		// Tasks with an affinity key are routed to the poller identity that handled the previous task with that key
+		TaskAffinityTTL func() time.Duration
+		// Duplicate adds of a task within the window are dropped
+		TaskDedupeWindow  func() time.Duration
+		TaskDedupeMaxSize func() int
+		// Buffered tasks are dispatched by earliest schedule to start deadline instead of FIFO
+		EnableDeadlineOrderedDispatch func() bool
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval func() time.Duration
 		RangeSize                  int64
 		GetTasksBatchSize          func() int
 		UpdateAckInterval          func() time.Duration
+		// Periodic task reads of an idle tasklist back off up to this interval
+		MaxIdleTaskReadInterval    func() time.Duration
 		IdleTasklistCheckInterval  func() time.Duration
 		MaxTasklistIdleTime        func() time.Duration
 		MinTaskThrottlingBurstSize func() int
 		MaxTaskDeleteBatchSize     func() int
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
-		MaxTaskBatchSize                func() int
-		NumWritePartitions              func() int
-		NumReadPartitions               func() int
+		// What to do with a task add once OutstandingTaskAppendsThreshold is reached
+		OverloadShedPolicy     func() string
+		MaxTaskBatchSize       func() int
+		TaskBatchFlushInterval func() time.Duration
+		NumWritePartitions     func() int
+		NumReadPartitions      func() int
+		// Backlog of partitions at or above the number of partitions is moved to the active partitions
+		EnablePartitionDrain        func() bool
+		PartitionDrainCheckInterval func() time.Duration
+		// A sample of the tasks added is mirrored to the shadow tasklist
+		ShadowTaskList            func() string
+		ShadowTaskListSampleRatio func() float64
+		ShadowTaskListMode        func() string
 	}
 )
 
@@ -109,13 +179,16 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		RangeSize:                       100000,
 		GetTasksBatchSize:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingGetTasksBatchSize, 1000),
 		UpdateAckInterval:               dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingUpdateAckInterval, 1*time.Minute),
+		MaxIdleTaskReadInterval:         dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxIdleTaskReadInterval, 0),
 		IdleTasklistCheckInterval:       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval, 5*time.Minute),
 		MaxTasklistIdleTime:             dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MaxTasklistIdleTime, 5*time.Minute),
 		LongPollExpirationInterval:      dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingLongPollExpirationInterval, time.Minute),
 		MinTaskThrottlingBurstSize:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMinTaskThrottlingBurstSize, 1),
 		MaxTaskDeleteBatchSize:          dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskDeleteBatchSize, 100),
 		OutstandingTaskAppendsThreshold: dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOutstandingTaskAppendsThreshold, 250),
+		OverloadShedPolicy:              dc.GetStringPropertyFilteredByTaskListInfo(dynamicconfig.MatchingOverloadShedPolicy, overloadShedNewest),
 		MaxTaskBatchSize:                dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingMaxTaskBatchSize, 100),
+		TaskBatchFlushInterval:          dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskBatchFlushInterval, 5*time.Millisecond),
 		ThrottledLogRPS:                 dc.GetIntProperty(dynamicconfig.MatchingThrottledLogRPS, 20),
 		NumTasklistWritePartitions:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistWritePartitions, 1),
 		NumTasklistReadPartitions:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingNumTasklistReadPartitions, 1),
@@ -123,9 +196,28 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
 		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
+		ForwarderPartitionHintInterval:  dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderPartitionHintInterval, 0),
+		PollerZonePattern:               dc.GetStringPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPollerZonePattern, ""),
+		DisableCrossZoneForwarding:      dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingDisableCrossZoneForwarding, false),
 		ShutdownDrainDuration:           dc.GetDurationProperty(dynamicconfig.MatchingShutdownDrainDuration, 0),
+		HealthMaxPersistenceErrorRate:   dc.GetFloat64Property(dynamicconfig.MatchingHealthMaxPersistenceErrorRate, 0.5),
 		EnableDebugMode:                 dc.GetBoolProperty(dynamicconfig.EnableDebugMode, false)(),
 		EnableTaskInfoLogByDomainID:     dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableTaskInfoLogByDomainID, false),
+		DomainDispatchWeight:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingDomainDispatchWeight, 0),
+		MaxTaskListsPerDomain:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingMaxTaskListsPerDomain, 0),
+		EnableEphemeralTaskList:         dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableEphemeralTaskList, false),
+		EphemeralSyncMatchTimeout:       dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEphemeralSyncMatchTimeout, 200*time.Millisecond),
+		TaskAffinityTTL:                 dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskAffinityTTL, 0),
+		TaskDedupeWindow:                dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskDedupeWindow, 0),
+		TaskDedupeMaxSize:               dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingTaskDedupeMaxSize, 10000),
+		EnableDispatchTracing:           dc.GetBoolPropertyFilteredByDomainID(dynamicconfig.MatchingEnableDispatchTracing, false),
+		EnableDeadlineOrderedDispatch:   dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableDeadlineOrderedDispatch, false),
+		EnablePartitionDrain:            dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnablePartitionDrain, true),
+		PartitionDrainCheckInterval:     dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPartitionDrainCheckInterval, 5*time.Minute),
+		ShadowTaskList:                  dc.GetStringPropertyFilteredByTaskListInfo(dynamicconfig.MatchingShadowTaskList, ""),
+		ShadowTaskListSampleRatio:       dc.GetFloat64PropertyFilteredByTaskListInfo(dynamicconfig.MatchingShadowTaskListSampleRatio, 0),
+		ShadowTaskListMode:              dc.GetStringPropertyFilteredByTaskListInfo(dynamicconfig.MatchingShadowTaskListMode, shadowModeMetadata),
+		EnableReadOnlyDescribeTaskList:  dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableReadOnlyDescribeTaskList, false),
 	}
 }
 
@@ -134,9 +226,40 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 	if err != nil {
 		return nil, err
 	}
+	return newTaskListConfigByName(domainName, id.name, id.taskType, config), nil
+}
+
+// describeTaskListConfig returns the effective configuration of a tasklist after dynamic config filters are applied
+func describeTaskListConfig(
+	config *Config,
+	domainName string,
+	taskListName string,
+	taskType int,
+) *types.DescribeTaskListConfigResponse {
+	tlConfig := newTaskListConfigByName(domainName, taskListName, taskType, config)
+	return &types.DescribeTaskListConfigResponse{
+		NumReadPartitions:                   int32(tlConfig.NumReadPartitions()),
+		NumWritePartitions:                  int32(tlConfig.NumWritePartitions()),
+		ForwarderMaxOutstandingPolls:        int32(tlConfig.ForwarderMaxOutstandingPolls()),
+		ForwarderMaxOutstandingTasks:        int32(tlConfig.ForwarderMaxOutstandingTasks()),
+		ForwarderMaxRatePerSecond:           int32(tlConfig.ForwarderMaxRatePerSecond()),
+		ForwarderMaxChildrenPerNode:         int32(tlConfig.ForwarderMaxChildrenPerNode()),
+		EnableSyncMatch:                     tlConfig.EnableSyncMatch(),
+		EnableEphemeralTaskList:             tlConfig.EnableEphemeralTaskList(),
+		LongPollExpirationIntervalInSeconds: int64(tlConfig.LongPollExpirationInterval().Seconds()),
+		RangeSize:                           tlConfig.RangeSize,
+		GetTasksBatchSize:                   int32(tlConfig.GetTasksBatchSize()),
+		UpdateAckIntervalInSeconds:          int64(tlConfig.UpdateAckInterval().Seconds()),
+		IdleTaskListCheckIntervalInSeconds:  int64(tlConfig.IdleTasklistCheckInterval().Seconds()),
+		MaxTaskListIdleTimeInSeconds:        int64(tlConfig.MaxTasklistIdleTime().Seconds()),
+		MinTaskThrottlingBurstSize:          int32(tlConfig.MinTaskThrottlingBurstSize()),
+		MaxTaskDeleteBatchSize:              int32(tlConfig.MaxTaskDeleteBatchSize()),
+		OutstandingTaskAppendsThreshold:     int32(tlConfig.OutstandingTaskAppendsThreshold()),
+		MaxTaskBatchSize:                    int32(tlConfig.MaxTaskBatchSize()),
+	}
+}
 
-	taskListName := id.name
-	taskType := id.taskType
+func newTaskListConfigByName(domainName string, taskListName string, taskType int, config *Config) *taskListConfig {
 	return &taskListConfig{
 		RangeSize: config.RangeSize,
 		GetTasksBatchSize: func() int {
@@ -145,6 +268,9 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		UpdateAckInterval: func() time.Duration {
 			return config.UpdateAckInterval(domainName, taskListName, taskType)
 		},
+		MaxIdleTaskReadInterval: func() time.Duration {
+			return config.MaxIdleTaskReadInterval(domainName, taskListName, taskType)
+		},
 		IdleTasklistCheckInterval: func() time.Duration {
 			return config.IdleTasklistCheckInterval(domainName, taskListName, taskType)
 		},
@@ -157,6 +283,24 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		EnableSyncMatch: func() bool {
 			return config.EnableSyncMatch(domainName, taskListName, taskType)
 		},
+		EnableEphemeralTaskList: func() bool {
+			return config.EnableEphemeralTaskList(domainName, taskListName, taskType)
+		},
+		EphemeralSyncMatchTimeout: func() time.Duration {
+			return config.EphemeralSyncMatchTimeout(domainName, taskListName, taskType)
+		},
+		TaskAffinityTTL: func() time.Duration {
+			return config.TaskAffinityTTL(domainName, taskListName, taskType)
+		},
+		TaskDedupeWindow: func() time.Duration {
+			return config.TaskDedupeWindow(domainName, taskListName, taskType)
+		},
+		TaskDedupeMaxSize: func() int {
+			return config.TaskDedupeMaxSize(domainName, taskListName, taskType)
+		},
+		EnableDeadlineOrderedDispatch: func() bool {
+			return config.EnableDeadlineOrderedDispatch(domainName, taskListName, taskType)
+		},
 		LongPollExpirationInterval: func() time.Duration {
 			return config.LongPollExpirationInterval(domainName, taskListName, taskType)
 		},
@@ -166,15 +310,36 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		OutstandingTaskAppendsThreshold: func() int {
 			return config.OutstandingTaskAppendsThreshold(domainName, taskListName, taskType)
 		},
+		OverloadShedPolicy: func() string {
+			return config.OverloadShedPolicy(domainName, taskListName, taskType)
+		},
 		MaxTaskBatchSize: func() int {
 			return config.MaxTaskBatchSize(domainName, taskListName, taskType)
 		},
+		TaskBatchFlushInterval: func() time.Duration {
+			return config.TaskBatchFlushInterval(domainName, taskListName, taskType)
+		},
 		NumWritePartitions: func() int {
 			return common.MaxInt(1, config.NumTasklistWritePartitions(domainName, taskListName, taskType))
 		},
 		NumReadPartitions: func() int {
 			return common.MaxInt(1, config.NumTasklistReadPartitions(domainName, taskListName, taskType))
 		},
+		EnablePartitionDrain: func() bool {
+			return config.EnablePartitionDrain(domainName, taskListName, taskType)
+		},
+		PartitionDrainCheckInterval: func() time.Duration {
+			return config.PartitionDrainCheckInterval(domainName, taskListName, taskType)
+		},
+		ShadowTaskList: func() string {
+			return config.ShadowTaskList(domainName, taskListName, taskType)
+		},
+		ShadowTaskListSampleRatio: func() float64 {
+			return config.ShadowTaskListSampleRatio(domainName, taskListName, taskType)
+		},
+		ShadowTaskListMode: func() string {
+			return config.ShadowTaskListMode(domainName, taskListName, taskType)
+		},
 		forwarderConfig: forwarderConfig{
 			ForwarderMaxOutstandingPolls: func() int {
 				return config.ForwarderMaxOutstandingPolls(domainName, taskListName, taskType)
@@ -188,6 +353,15 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 			ForwarderMaxChildrenPerNode: func() int {
 				return common.MaxInt(1, config.ForwarderMaxChildrenPerNode(domainName, taskListName, taskType))
 			},
+			ForwarderPartitionHintInterval: func() time.Duration {
+				return config.ForwarderPartitionHintInterval(domainName, taskListName, taskType)
+			},
+			ForwarderDisableCrossZone: func() bool {
+				return config.DisableCrossZoneForwarding(domainName, taskListName, taskType)
+			},
+			PollerZonePattern: func() string {
+				return config.PollerZonePattern(domainName, taskListName, taskType)
+			},
 		},
-	}, nil
+	}
 }
@@ -41,11 +41,21 @@ type (
 		ackLevel     int64
 		store        persistence.TaskManager
 		logger       log.Logger
+		health       *hostHealth
+		lastErr      atomic.Value // string, empty when the last operation succeeded
+		// lease counters since the task list was loaded, a conflict means another host holds the lease
+		leaseRenewals  int64
+		leaseConflicts int64
 	}
 	taskListState struct {
 		rangeID  int64
 		ackLevel int64
 	}
+	taskListLeaseStats struct {
+		rangeID        int64
+		leaseRenewals  int64
+		leaseConflicts int64
+	}
 )
 
 // newTaskListDB returns an instance of an object that represents
@@ -58,15 +68,18 @@ type (
 // - To provide the guarantee that there is only writer who updates taskList in persistence at any given point in time
 //   This guarantee makes some of the other code simpler and there is no impact to perf because updates to tasklist are
 //   spread out and happen in background routines
-func newTaskListDB(store persistence.TaskManager, domainID string, name string, taskType int, kind int, logger log.Logger) *taskListDB {
-	return &taskListDB{
+func newTaskListDB(store persistence.TaskManager, domainID string, name string, taskType int, kind int, logger log.Logger, health *hostHealth) *taskListDB {
+	db := &taskListDB{
 		domainID:     domainID,
 		taskListName: name,
 		taskListKind: kind,
 		taskType:     taskType,
 		store:        store,
 		logger:       logger,
+		health:       health,
 	}
+	db.lastErr.Store("")
+	return db
 }
 
 // RangeID returns the current persistence view of rangeID
@@ -88,9 +101,12 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 		TaskListKind: db.taskListKind,
 		RangeID:      atomic.LoadInt64(&db.rangeID),
 	})
+	db.health.recordLease(err)
+	db.recordResult(err)
 	if err != nil {
 		return taskListState{}, err
 	}
+	atomic.AddInt64(&db.leaseRenewals, 1)
 	db.ackLevel = resp.TaskListInfo.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
 	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
@@ -110,6 +126,7 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 			Kind:     db.taskListKind,
 		},
 	})
+	db.recordPersistence(err)
 	if err == nil {
 		db.ackLevel = ackLevel
 	}
@@ -120,7 +137,7 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
 	defer db.Unlock()
-	return db.store.CreateTasks(context.Background(), &persistence.CreateTasksRequest{
+	resp, err := db.store.CreateTasks(context.Background(), &persistence.CreateTasksRequest{
 		TaskListInfo: &persistence.TaskListInfo{
 			DomainID: db.domainID,
 			Name:     db.taskListName,
@@ -131,11 +148,13 @@ func (db *taskListDB) CreateTasks(tasks []*persistence.CreateTaskInfo) (*persist
 		},
 		Tasks: tasks,
 	})
+	db.recordPersistence(err)
+	return resp, err
 }
 
 // GetTasks returns a batch of tasks between the given range
 func (db *taskListDB) GetTasks(minTaskID int64, maxTaskID int64, batchSize int) (*persistence.GetTasksResponse, error) {
-	return db.store.GetTasks(context.Background(), &persistence.GetTasksRequest{
+	resp, err := db.store.GetTasks(context.Background(), &persistence.GetTasksRequest{
 		DomainID:     db.domainID,
 		TaskList:     db.taskListName,
 		TaskType:     db.taskType,
@@ -143,6 +162,8 @@ func (db *taskListDB) GetTasks(minTaskID int64, maxTaskID int64, batchSize int)
 		ReadLevel:    minTaskID,  // exclusive
 		MaxReadLevel: &maxTaskID, // inclusive
 	})
+	db.recordPersistence(err)
+	return resp, err
 }
 
 // CompleteTask deletes a single task from this task list
@@ -155,6 +176,7 @@ func (db *taskListDB) CompleteTask(taskID int64) error {
 		},
 		TaskID: taskID,
 	})
+	db.recordPersistence(err)
 	if err != nil {
 		db.logger.Error("Persistent store operation failure",
 			tag.StoreOperationCompleteTask,
@@ -177,6 +199,7 @@ func (db *taskListDB) CompleteTasksLessThan(taskID int64, limit int) (int, error
 		TaskID:       taskID,
 		Limit:        limit,
 	})
+	db.recordPersistence(err)
 	if err != nil {
 		db.logger.Error("Persistent store operation failure",
 			tag.StoreOperationCompleteTasksLessThan,
@@ -188,3 +211,34 @@ func (db *taskListDB) CompleteTasksLessThan(taskID int64, limit int) (int, error
 	}
 	return resp.TasksCompleted, nil
 }
+
+// LeaseStats returns the current rangeID and the lease counters of this task list
+func (db *taskListDB) LeaseStats() taskListLeaseStats {
+	return taskListLeaseStats{
+		rangeID:        db.RangeID(),
+		leaseRenewals:  atomic.LoadInt64(&db.leaseRenewals),
+		leaseConflicts: atomic.LoadInt64(&db.leaseConflicts),
+	}
+}
+
+// LastError returns the error of the last persistence operation of this task list,
+// or an empty string when it succeeded
+func (db *taskListDB) LastError() string {
+	return db.lastErr.Load().(string)
+}
+
+func (db *taskListDB) recordPersistence(err error) {
+	db.health.recordPersistence(err)
+	db.recordResult(err)
+}
+
+func (db *taskListDB) recordResult(err error) {
+	if _, ok := err.(*persistence.ConditionFailedError); ok {
+		atomic.AddInt64(&db.leaseConflicts, 1)
+	}
+	if err != nil {
+		db.lastErr.Store(err.Error())
+	} else {
+		db.lastErr.Store("")
+	}
+}
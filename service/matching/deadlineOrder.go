@@ -0,0 +1,76 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/heap"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	dispatchOrderFIFO     = "fifo"
+	dispatchOrderDeadline = "deadline"
+)
+
+type (
+	// deadlineTaskHeap orders buffered tasks by earliest schedule to start deadline.
+	// Tasks without a deadline go after all tasks with one, ties are broken by task ID to keep FIFO order
+	deadlineTaskHeap []*persistence.TaskInfo
+)
+
+var _ heap.Interface = (*deadlineTaskHeap)(nil)
+
+func (h deadlineTaskHeap) Len() int {
+	return len(h)
+}
+
+func (h deadlineTaskHeap) Less(i, j int) bool {
+	iHasDeadline, jHasDeadline := hasDeadline(h[i]), hasDeadline(h[j])
+	if iHasDeadline != jHasDeadline {
+		return iHasDeadline
+	}
+	if iHasDeadline && !h[i].Expiry.Equal(h[j].Expiry) {
+		return h[i].Expiry.Before(h[j].Expiry)
+	}
+	return h[i].TaskID < h[j].TaskID
+}
+
+func (h deadlineTaskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *deadlineTaskHeap) Push(x interface{}) {
+	*h = append(*h, x.(*persistence.TaskInfo))
+}
+
+func (h *deadlineTaskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return task
+}
+
+func hasDeadline(task *persistence.TaskInfo) bool {
+	return task.Expiry.After(epochStartTime)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/heap"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestDeadlineTaskHeap(t *testing.T) {
+	now := time.Now()
+	h := &deadlineTaskHeap{}
+	for _, task := range []*persistence.TaskInfo{
+		{TaskID: 1},
+		{TaskID: 2, Expiry: now.Add(time.Hour)},
+		{TaskID: 3, Expiry: now.Add(-time.Minute)},
+		{TaskID: 4},
+		{TaskID: 5, Expiry: now.Add(time.Minute)},
+		{TaskID: 6, Expiry: now.Add(time.Minute)},
+		{TaskID: 7, Expiry: epochStartTime},
+	} {
+		heap.Push(h, task)
+	}
+
+	var order []int64
+	for h.Len() > 0 {
+		order = append(order, heap.Pop(h).(*persistence.TaskInfo).TaskID)
+	}
+	assert.Equal(t, []int64{3, 5, 6, 2, 1, 4, 7}, order)
+}
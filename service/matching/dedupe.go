@@ -0,0 +1,125 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+)
+
+type (
+	// taskDedupe remembers the task attempts recently added to a tasklist so that duplicate adds of
+	// the same attempt, e.g. history retrying an add whose response was lost, are dropped instead of
+	// being dispatched twice. Dedupe is best effort: it is per host and in memory only, bounded in size
+	// and time, and concurrent adds of the same task may both go through
+	taskDedupe struct {
+		window     func() time.Duration
+		maxSize    func() int
+		timeSource clock.TimeSource
+
+		sync.Mutex
+		entries map[taskDedupeKey]*list.Element
+		order   *list.List // of *taskDedupeEntry, least recently added first
+	}
+
+	taskDedupeKey struct {
+		runID      string
+		scheduleID int64
+		attempt    int64
+	}
+
+	taskDedupeEntry struct {
+		key        taskDedupeKey
+		expiryTime time.Time
+	}
+)
+
+func newTaskDedupe(config *taskListConfig, timeSource clock.TimeSource) *taskDedupe {
+	return &taskDedupe{
+		window:     config.TaskDedupeWindow,
+		maxSize:    config.TaskDedupeMaxSize,
+		timeSource: timeSource,
+		entries:    make(map[taskDedupeKey]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// isDuplicate returns true when the same attempt of a task was added within the dedupe window
+func (d *taskDedupe) isDuplicate(params addTaskParams) bool {
+	if d.window() <= 0 {
+		return false
+	}
+
+	now := d.timeSource.Now()
+	d.Lock()
+	defer d.Unlock()
+	elem, ok := d.entries[dedupeKey(params)]
+	if !ok {
+		return false
+	}
+	if now.After(elem.Value.(*taskDedupeEntry).expiryTime) {
+		d.removeLocked(elem)
+		return false
+	}
+	return true
+}
+
+// recordAdded remembers a task once it was successfully added to the tasklist
+func (d *taskDedupe) recordAdded(params addTaskParams) {
+	window := d.window()
+	if window <= 0 {
+		return
+	}
+
+	now := d.timeSource.Now()
+	key := dedupeKey(params)
+	d.Lock()
+	defer d.Unlock()
+	if elem, ok := d.entries[key]; ok {
+		d.removeLocked(elem)
+	}
+	d.entries[key] = d.order.PushBack(&taskDedupeEntry{key: key, expiryTime: now.Add(window)})
+
+	// entries share the same window so the oldest entries expire first
+	maxSize := d.maxSize()
+	for elem := d.order.Front(); elem != nil; elem = d.order.Front() {
+		if d.order.Len() <= maxSize && !now.After(elem.Value.(*taskDedupeEntry).expiryTime) {
+			break
+		}
+		d.removeLocked(elem)
+	}
+}
+
+func (d *taskDedupe) removeLocked(elem *list.Element) {
+	delete(d.entries, elem.Value.(*taskDedupeEntry).key)
+	d.order.Remove(elem)
+}
+
+func dedupeKey(params addTaskParams) taskDedupeKey {
+	return taskDedupeKey{
+		runID:      params.taskInfo.RunID,
+		scheduleID: params.taskInfo.ScheduleID,
+		attempt:    params.attempt,
+	}
+}
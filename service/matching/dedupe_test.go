@@ -0,0 +1,97 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestTaskDedupe_Disabled(t *testing.T) {
+	dedupe := newTaskDedupe(&taskListConfig{
+		TaskDedupeWindow:  func() time.Duration { return 0 },
+		TaskDedupeMaxSize: func() int { return 10 },
+	}, clock.NewRealTimeSource())
+	task := newDedupeTestParams("run", 5, 0)
+
+	dedupe.recordAdded(task)
+	assert.False(t, dedupe.isDuplicate(task))
+	assert.Empty(t, dedupe.entries)
+}
+
+func TestTaskDedupe_Expiry(t *testing.T) {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	dedupe := newTaskDedupe(&taskListConfig{
+		TaskDedupeWindow:  func() time.Duration { return time.Minute },
+		TaskDedupeMaxSize: func() int { return 10 },
+	}, timeSource)
+	task := newDedupeTestParams("run", 5, 0)
+
+	assert.False(t, dedupe.isDuplicate(task))
+	dedupe.recordAdded(task)
+	duplicate := newDedupeTestParams("run", 5, 0)
+	duplicate.taskInfo.TaskID = 100
+	assert.True(t, dedupe.isDuplicate(duplicate))
+	assert.False(t, dedupe.isDuplicate(newDedupeTestParams("run", 6, 0)))
+	assert.False(t, dedupe.isDuplicate(newDedupeTestParams("other-run", 5, 0)))
+
+	timeSource.Update(timeSource.Now().Add(2 * time.Minute))
+	assert.False(t, dedupe.isDuplicate(task))
+	assert.Empty(t, dedupe.entries)
+}
+
+func TestTaskDedupe_Attempt(t *testing.T) {
+	dedupe := newTaskDedupe(&taskListConfig{
+		TaskDedupeWindow:  func() time.Duration { return time.Minute },
+		TaskDedupeMaxSize: func() int { return 10 },
+	}, clock.NewRealTimeSource())
+
+	dedupe.recordAdded(newDedupeTestParams("run", 5, 0))
+	assert.True(t, dedupe.isDuplicate(newDedupeTestParams("run", 5, 0)))
+	assert.False(t, dedupe.isDuplicate(newDedupeTestParams("run", 5, 1)))
+}
+
+func TestTaskDedupe_MaxSize(t *testing.T) {
+	dedupe := newTaskDedupe(&taskListConfig{
+		TaskDedupeWindow:  func() time.Duration { return time.Minute },
+		TaskDedupeMaxSize: func() int { return 2 },
+	}, clock.NewRealTimeSource())
+
+	for scheduleID := int64(1); scheduleID <= 3; scheduleID++ {
+		dedupe.recordAdded(newDedupeTestParams("run", scheduleID, 0))
+	}
+	assert.Len(t, dedupe.entries, 2)
+	assert.False(t, dedupe.isDuplicate(newDedupeTestParams("run", 1, 0)))
+	assert.True(t, dedupe.isDuplicate(newDedupeTestParams("run", 2, 0)))
+	assert.True(t, dedupe.isDuplicate(newDedupeTestParams("run", 3, 0)))
+}
+
+func newDedupeTestParams(runID string, scheduleID int64, attempt int64) addTaskParams {
+	return addTaskParams{
+		taskInfo: &persistence.TaskInfo{RunID: runID, ScheduleID: scheduleID},
+		attempt:  attempt,
+	}
+}
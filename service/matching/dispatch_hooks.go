@@ -0,0 +1,248 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// DispatchHooks is invoked at every stage an activity or decision task goes through
+	// in the matching engine. Implementations must be safe for concurrent use and must not block.
+	DispatchHooks interface {
+		// TaskAdded is called when history, or a child partition, adds the task to the task list.
+		// The context is the one of the inbound AddTask call.
+		TaskAdded(ctx context.Context, taskList string, task *persistence.TaskInfo)
+		// SyncMatched is called after the task was offered to pollers without being persisted
+		SyncMatched(taskList string, task *persistence.TaskInfo, matched bool)
+		// BufferEntered is called when a persisted task is loaded into the in-memory dispatch buffer
+		BufferEntered(taskList string, task *persistence.TaskInfo)
+		// BufferExited is called when the task is taken out of the buffer to be dispatched
+		BufferExited(taskList string, task *persistence.TaskInfo)
+		// ForwardOptions returns the call options carrying the task's trace context to the parent partition
+		ForwardOptions(task *persistence.TaskInfo) []yarpc.CallOption
+		// Forwarded is called after the task was forwarded to the parent partition
+		Forwarded(taskList string, task *persistence.TaskInfo, err error)
+		// DispatchCompleted is called after the task was recorded as started in history
+		DispatchCompleted(taskList string, task *persistence.TaskInfo, err error)
+	}
+
+	noopDispatchHooks struct{}
+
+	// tracingDispatchHooks records one span per task, from the time it is added
+	// until it is dispatched, with an event for every intermediate stage
+	tracingDispatchHooks struct {
+		tracer     trace.Tracer
+		propagator propagation.TextMapPropagator
+		enabled    dynamicconfig.BoolPropertyFnWithDomainIDFilter
+		spans      cache.Cache
+	}
+
+	// yarpcCallCarrier reads the trace context from the headers of an inbound call
+	yarpcCallCarrier struct {
+		call *yarpc.Call
+	}
+)
+
+const (
+	dispatchTracerName        = "github.com/uber/cadence/service/matching"
+	dispatchSpanOperationName = "matching.dispatch"
+	dispatchSpanCacheSize     = 100000
+	// tasks sitting in the backlog for longer are no longer traced,
+	// their span is closed when evicted
+	dispatchSpanTTL = time.Hour
+)
+
+var _ DispatchHooks = (*noopDispatchHooks)(nil)
+var _ DispatchHooks = (*tracingDispatchHooks)(nil)
+var _ propagation.TextMapCarrier = (*yarpcCallCarrier)(nil)
+
+// NewNoopDispatchHooks returns hooks that do nothing
+func NewNoopDispatchHooks() DispatchHooks {
+	return &noopDispatchHooks{}
+}
+
+func (h *noopDispatchHooks) TaskAdded(context.Context, string, *persistence.TaskInfo) {}
+func (h *noopDispatchHooks) SyncMatched(string, *persistence.TaskInfo, bool)          {}
+func (h *noopDispatchHooks) BufferEntered(string, *persistence.TaskInfo)              {}
+func (h *noopDispatchHooks) BufferExited(string, *persistence.TaskInfo)               {}
+func (h *noopDispatchHooks) ForwardOptions(*persistence.TaskInfo) []yarpc.CallOption  { return nil }
+func (h *noopDispatchHooks) Forwarded(string, *persistence.TaskInfo, error)           {}
+func (h *noopDispatchHooks) DispatchCompleted(string, *persistence.TaskInfo, error)   {}
+
+// NewTracingDispatchHooks returns hooks creating a span per task with a tracer of the given provider,
+// for the domains tracing is enabled for. Spans of the same task are found by its task token fields:
+// domain, workflow, run and schedule ID. The trace context is propagated to parent partitions
+// in W3C trace context headers.
+func NewTracingDispatchHooks(
+	tracerProvider trace.TracerProvider,
+	enabled dynamicconfig.BoolPropertyFnWithDomainIDFilter,
+) DispatchHooks {
+	return &tracingDispatchHooks{
+		tracer:     tracerProvider.Tracer(dispatchTracerName),
+		propagator: propagation.TraceContext{},
+		enabled:    enabled,
+		spans: cache.New(&cache.Options{
+			TTL:      dispatchSpanTTL,
+			MaxCount: dispatchSpanCacheSize,
+			RemovedFunc: func(value interface{}) {
+				value.(trace.Span).End()
+			},
+		}),
+	}
+}
+
+func (h *tracingDispatchHooks) TaskAdded(ctx context.Context, taskList string, task *persistence.TaskInfo) {
+	if !h.enabled(task.DomainID) {
+		return
+	}
+	// a task forwarded by a child partition continues the trace started there
+	parent := context.Background()
+	if call := yarpc.CallFromContext(ctx); call != nil {
+		parent = h.propagator.Extract(parent, &yarpcCallCarrier{call: call})
+	}
+	h.getOrStartSpan(parent, taskList, task).AddEvent("task_added")
+}
+
+func (h *tracingDispatchHooks) SyncMatched(taskList string, task *persistence.TaskInfo, matched bool) {
+	if !h.enabled(task.DomainID) {
+		return
+	}
+	h.getOrStartSpan(context.Background(), taskList, task).
+		AddEvent("sync_match", trace.WithAttributes(attribute.Bool("matched", matched)))
+}
+
+func (h *tracingDispatchHooks) BufferEntered(taskList string, task *persistence.TaskInfo) {
+	if !h.enabled(task.DomainID) {
+		return
+	}
+	h.getOrStartSpan(context.Background(), taskList, task).AddEvent("buffer_entered")
+}
+
+func (h *tracingDispatchHooks) BufferExited(taskList string, task *persistence.TaskInfo) {
+	if !h.enabled(task.DomainID) {
+		return
+	}
+	h.getOrStartSpan(context.Background(), taskList, task).AddEvent("buffer_exited")
+}
+
+func (h *tracingDispatchHooks) ForwardOptions(task *persistence.TaskInfo) []yarpc.CallOption {
+	if !h.enabled(task.DomainID) {
+		return nil
+	}
+	span, ok := h.spans.Get(dispatchSpanKey(task)).(trace.Span)
+	if !ok {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	h.propagator.Inject(trace.ContextWithSpan(context.Background(), span), carrier)
+	opts := make([]yarpc.CallOption, 0, len(carrier))
+	for key, value := range carrier {
+		opts = append(opts, yarpc.WithHeader(key, value))
+	}
+	return opts
+}
+
+func (h *tracingDispatchHooks) Forwarded(taskList string, task *persistence.TaskInfo, err error) {
+	if !h.enabled(task.DomainID) {
+		return
+	}
+	span := h.getOrStartSpan(context.Background(), taskList, task)
+	span.AddEvent("forwarded")
+	if err != nil {
+		span.RecordError(err)
+	}
+}
+
+func (h *tracingDispatchHooks) DispatchCompleted(taskList string, task *persistence.TaskInfo, err error) {
+	key := dispatchSpanKey(task)
+	span, ok := h.spans.Get(key).(trace.Span)
+	if !ok {
+		if !h.enabled(task.DomainID) {
+			return
+		}
+		span = h.getOrStartSpan(context.Background(), taskList, task)
+	}
+	// spans started before tracing was disabled are still completed
+	span.AddEvent("dispatch_completed")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	h.spans.Delete(key)
+	span.End()
+}
+
+func (h *tracingDispatchHooks) getOrStartSpan(
+	parent context.Context,
+	taskList string,
+	task *persistence.TaskInfo,
+) trace.Span {
+	key := dispatchSpanKey(task)
+	if span, ok := h.spans.Get(key).(trace.Span); ok {
+		return span
+	}
+	_, span := h.tracer.Start(
+		parent,
+		dispatchSpanOperationName,
+		trace.WithTimestamp(task.CreatedTime),
+		trace.WithAttributes(
+			attribute.String("domainID", task.DomainID),
+			attribute.String("workflowID", task.WorkflowID),
+			attribute.String("runID", task.RunID),
+			attribute.Int64("scheduleID", task.ScheduleID),
+			attribute.String("taskList", taskList),
+		),
+	)
+	existing, err := h.spans.PutIfNotExist(key, span)
+	if err != nil {
+		return span
+	}
+	// another stage may have raced with this one, the extra span is never ended so it is not exported
+	return existing.(trace.Span)
+}
+
+func (c *yarpcCallCarrier) Get(key string) string {
+	return c.call.Header(key)
+}
+
+// Set is a no-op, headers of an inbound call are read only
+func (c *yarpcCallCarrier) Set(string, string) {}
+
+func (c *yarpcCallCarrier) Keys() []string {
+	return c.call.HeaderNames()
+}
+
+func dispatchSpanKey(task *persistence.TaskInfo) string {
+	return fmt.Sprintf("%v/%v/%v/%v", task.DomainID, task.WorkflowID, task.RunID, task.ScheduleID)
+}
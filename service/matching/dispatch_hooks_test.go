@@ -0,0 +1,119 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/yarpc/api/encoding"
+	"go.uber.org/yarpc/api/transport"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestTracingDispatchHooks(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	hooks := NewTracingDispatchHooks(
+		sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		func(domainID string) bool { return domainID == "domain" },
+	)
+
+	task := &persistence.TaskInfo{
+		DomainID:    "domain",
+		WorkflowID:  "wid",
+		RunID:       "rid",
+		ScheduleID:  5,
+		CreatedTime: time.Now(),
+	}
+	hooks.TaskAdded(context.Background(), "tl", task)
+	hooks.SyncMatched("tl", task, false)
+	hooks.BufferEntered("tl", task)
+	hooks.BufferExited("tl", task)
+	assert.Empty(t, recorder.Ended())
+
+	hooks.DispatchCompleted("tl", task, nil)
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, dispatchSpanOperationName, spans[0].Name())
+	assert.Contains(t, spans[0].Attributes(), attribute.String("workflowID", "wid"))
+	assert.Contains(t, spans[0].Attributes(), attribute.String("taskList", "tl"))
+	assert.Len(t, spans[0].Events(), 5)
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+	// a task dispatched without being seen before, e.g. loaded from the backlog after a restart, gets its own span
+	otherTask := &persistence.TaskInfo{DomainID: "domain", WorkflowID: "wid", RunID: "rid", ScheduleID: 6}
+	hooks.BufferEntered("tl", otherTask)
+	hooks.DispatchCompleted("tl", otherTask, errors.New("history unavailable"))
+	spans = recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+
+	// domains tracing is not enabled for are not traced
+	disabledTask := &persistence.TaskInfo{DomainID: "other-domain", WorkflowID: "wid", RunID: "rid", ScheduleID: 5}
+	hooks.TaskAdded(context.Background(), "tl", disabledTask)
+	assert.Empty(t, hooks.ForwardOptions(disabledTask))
+	hooks.DispatchCompleted("tl", disabledTask, nil)
+	assert.Len(t, recorder.Ended(), 2)
+}
+
+func TestTracingDispatchHooksForwardedTask(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	enabled := func(string) bool { return true }
+	childHooks := NewTracingDispatchHooks(tracerProvider, enabled)
+	parentHooks := NewTracingDispatchHooks(tracerProvider, enabled)
+
+	task := &persistence.TaskInfo{DomainID: "domain", WorkflowID: "wid", RunID: "rid", ScheduleID: 5}
+	childHooks.TaskAdded(context.Background(), "tl", task)
+	assert.NotEmpty(t, childHooks.ForwardOptions(task))
+
+	// the parent partition receives the trace context of the child in the headers of the AddTask call
+	childSpan, ok := childHooks.(*tracingDispatchHooks).spans.Get(dispatchSpanKey(task)).(trace.Span)
+	require.True(t, ok)
+	carrier := propagation.MapCarrier{}
+	propagation.TraceContext{}.Inject(trace.ContextWithSpan(context.Background(), childSpan), carrier)
+	headers := transport.NewHeaders()
+	for key, value := range carrier {
+		headers = headers.With(key, value)
+	}
+	ctx, call := encoding.NewInboundCall(context.Background())
+	require.NoError(t, call.ReadFromRequest(&transport.Request{Headers: headers}))
+
+	parentHooks.TaskAdded(ctx, "/__cadence_sys/tl/1", task)
+	parentHooks.DispatchCompleted("/__cadence_sys/tl/1", task, nil)
+	childHooks.Forwarded("tl", task, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, childSpan.SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+	assert.Equal(t, childSpan.SpanContext().SpanID(), spans[0].Parent().SpanID())
+}
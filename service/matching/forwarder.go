@@ -23,7 +23,9 @@ package matching
 import (
 	"context"
 	"errors"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/persistence"
@@ -57,6 +59,25 @@ type (
 		// todo: implement a rate limiter that automatically
 		// adjusts rate based on ServiceBusy errors from API calls
 		limiter *quotas.DynamicRateLimiter
+
+		dispatchHooks DispatchHooks
+
+		// partitionHints caches the number of pollers waiting on each
+		// ancestor partition, as learned from refreshPartitionHints.
+		// Tasks skip ancestors that are known to be idle
+		partitionHintsLock sync.RWMutex
+		partitionHints     map[string]partitionHint
+		// partitionZones are the last known isolation zones of the pollers
+		// of each ancestor partition, including the root. They are kept when
+		// the ancestor cannot be reached, which is when they matter the most
+		partitionZones map[string]map[string]struct{}
+		// localPollers returns the pollers of this partition
+		localPollers func() []*types.PollerInfo
+	}
+	// partitionHint is the metadata last reported by an ancestor partition
+	partitionHint struct {
+		pollers int64
+		expiry  time.Time
 	}
 	// ForwarderReqToken is the token that must be acquired before
 	// making forwarder API calls. This type contains the state
@@ -71,6 +92,7 @@ var (
 	errTaskListKind        = errors.New("forwarding is not supported on sticky task list")
 	errInvalidTaskListType = errors.New("unrecognized task list type")
 	errForwarderSlowDown   = errors.New("limit exceeded")
+	errCrossZoneForwarding = errors.New("forwarding to a partition in another isolation zone is disabled")
 )
 
 // noopForwarderTokenC refers to a token channel that blocks forever
@@ -86,11 +108,14 @@ var noopForwarderTokenC <-chan *ForwarderReqToken = make(chan *ForwarderReqToken
 //  - errTaskListKind: If the task list is a sticky task list. Sticky task lists are never partitioned
 //  - errForwarderSlowDown: When the rate limit is exceeded
 //  - errInvalidTaskType: If the task list type is invalid
+//  - errCrossZoneForwarding: If the task would be forwarded to a partition in another isolation zone
 func newForwarder(
 	cfg *forwarderConfig,
 	taskListID *taskListID,
 	kind types.TaskListKind,
 	client matching.Client,
+	dispatchHooks DispatchHooks,
+	localPollers func() []*types.PollerInfo,
 ) *Forwarder {
 	rpsFunc := func() float64 { return float64(cfg.ForwarderMaxRatePerSecond()) }
 	fwdr := &Forwarder{
@@ -101,6 +126,10 @@ func newForwarder(
 		outstandingTasksLimit: int32(cfg.ForwarderMaxOutstandingTasks()),
 		outstandingPollsLimit: int32(cfg.ForwarderMaxOutstandingPolls()),
 		limiter:               quotas.NewDynamicRateLimiter(rpsFunc),
+		dispatchHooks:         dispatchHooks,
+		partitionHints:        make(map[string]partitionHint),
+		partitionZones:        make(map[string]map[string]struct{}),
+		localPollers:          localPollers,
 	}
 	fwdr.addReqToken.Store(newForwarderReqToken(cfg.ForwarderMaxOutstandingTasks()))
 	fwdr.pollReqToken.Store(newForwarderReqToken(cfg.ForwarderMaxOutstandingPolls()))
@@ -113,16 +142,22 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 		return errTaskListKind
 	}
 
-	name := fwdr.taskListID.Parent(fwdr.cfg.ForwarderMaxChildrenPerNode())
+	name := fwdr.forwardTarget()
 	if name == "" {
 		return errNoParent
 	}
 
+	if fwdr.crossesZones(name) {
+		return errCrossZoneForwarding
+	}
+
 	if !fwdr.limiter.Allow() {
 		return errForwarderSlowDown
 	}
 
 	var err error
+	// carries the trace context of the task to the parent partition
+	opts := fwdr.dispatchHooks.ForwardOptions(task.event.TaskInfo)
 
 	switch fwdr.taskListID.taskType {
 	case persistence.TaskListTypeDecision:
@@ -137,7 +172,10 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
-		})
+			AffinityKey:                   task.event.AffinityKey,
+			ShadowSourceTaskList:          task.shadow.getSourceTaskList(),
+			ShadowMetadataOnly:            task.isShadowMetadataOnly(),
+		}, opts...)
 	case persistence.TaskListTypeActivity:
 		err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
 			DomainUUID:       fwdr.taskListID.domainID,
@@ -151,11 +189,15 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
-		})
+			AffinityKey:                   task.event.AffinityKey,
+			ShadowSourceTaskList:          task.shadow.getSourceTaskList(),
+			ShadowMetadataOnly:            task.isShadowMetadataOnly(),
+		}, opts...)
 	default:
 		return errInvalidTaskListType
 	}
 
+	fwdr.dispatchHooks.Forwarded(fwdr.taskListID.name, task.event.TaskInfo, err)
 	return fwdr.handleErr(err)
 }
 
@@ -169,7 +211,7 @@ func (fwdr *Forwarder) ForwardQueryTask(
 		return nil, errTaskListKind
 	}
 
-	name := fwdr.taskListID.Parent(fwdr.cfg.ForwarderMaxChildrenPerNode())
+	name := fwdr.forwardTarget()
 	if name == "" {
 		return nil, errNoParent
 	}
@@ -257,6 +299,124 @@ func (fwdr *Forwarder) PollReqTokenC() <-chan *ForwarderReqToken {
 	return fwdr.pollReqToken.Load().(*ForwarderReqToken).ch
 }
 
+// refreshPartitionHints asks every ancestor partition, except the root, for
+// the number of pollers waiting on it. The answers are kept for twice the
+// refresh interval, after which forwarding falls back to the parent.
+// When cross zone forwarding is disabled, the root is asked as well and the
+// isolation zones of the pollers of every ancestor are recorded
+func (fwdr *Forwarder) refreshPartitionHints(ctx context.Context, interval time.Duration) {
+	ancestors := fwdr.taskListID.Ancestors(fwdr.cfg.ForwarderMaxChildrenPerNode())
+	checkZones := fwdr.cfg.ForwarderDisableCrossZone()
+	// the poller counts are only used when the hints are enabled, they may be refreshed for the zones only
+	countPollers := fwdr.cfg.ForwarderPartitionHintInterval() > 0
+	targets := ancestors
+	if !checkZones && len(ancestors) > 0 {
+		targets = ancestors[:len(ancestors)-1]
+	}
+	if len(targets) == 0 {
+		return
+	}
+
+	taskListType := types.TaskListTypeDecision
+	if fwdr.taskListID.taskType == persistence.TaskListTypeActivity {
+		taskListType = types.TaskListTypeActivity
+	}
+	expiry := time.Now().Add(2 * interval)
+	pattern := fwdr.cfg.PollerZonePattern()
+
+	hints := make(map[string]partitionHint, len(targets))
+	zones := make(map[string]map[string]struct{}, len(targets))
+	for _, name := range targets {
+		resp, err := fwdr.client.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+			DomainUUID: fwdr.taskListID.domainID,
+			DescRequest: &types.DescribeTaskListRequest{
+				TaskList: &types.TaskList{
+					Name: name,
+					Kind: &fwdr.taskListKind,
+				},
+				TaskListType:          &taskListType,
+				IncludeTaskListStatus: true,
+			},
+		})
+		if err != nil {
+			continue
+		}
+		if countPollers && name != ancestors[len(ancestors)-1] {
+			hints[name] = partitionHint{
+				pollers: resp.GetTaskListStatus().GetOutstandingPollCount(),
+				expiry:  expiry,
+			}
+		}
+		if checkZones {
+			if found := pollerZones(resp.GetPollers(), pattern); len(found) > 0 {
+				zones[name] = found
+			}
+		}
+	}
+
+	fwdr.partitionHintsLock.Lock()
+	fwdr.partitionHints = hints
+	if checkZones {
+		for name, found := range zones {
+			fwdr.partitionZones[name] = found
+		}
+	} else {
+		fwdr.partitionZones = make(map[string]map[string]struct{})
+	}
+	fwdr.partitionHintsLock.Unlock()
+}
+
+// clearPartitionHints drops all known ancestor hints and zones, so that
+// forwarding goes to the parent partition again
+func (fwdr *Forwarder) clearPartitionHints() {
+	fwdr.partitionHintsLock.Lock()
+	fwdr.partitionHints = make(map[string]partitionHint)
+	fwdr.partitionZones = make(map[string]map[string]struct{})
+	fwdr.partitionHintsLock.Unlock()
+}
+
+// crossesZones returns true when cross zone forwarding is disabled and none of the
+// pollers of the partition are in the isolation zones of the pollers of this partition.
+// Forwarding is never blocked while the zones of either side are unknown
+func (fwdr *Forwarder) crossesZones(name string) bool {
+	if fwdr.localPollers == nil || !fwdr.cfg.ForwarderDisableCrossZone() {
+		return false
+	}
+
+	fwdr.partitionHintsLock.RLock()
+	remote := fwdr.partitionZones[name]
+	fwdr.partitionHintsLock.RUnlock()
+	if len(remote) == 0 {
+		return false
+	}
+
+	local := pollerZones(fwdr.localPollers(), fwdr.cfg.PollerZonePattern())
+	return len(local) > 0 && !sharesZone(local, remote)
+}
+
+// forwardTarget returns the partition that tasks should be forwarded to.
+// This is the parent, unless the parent is known to have no waiting pollers,
+// in which case it is the closest ancestor that may have some. An idle parent
+// would only forward the task further up, so skipping it saves a hop.
+// Returns empty string if this task list is the root
+func (fwdr *Forwarder) forwardTarget() string {
+	ancestors := fwdr.taskListID.Ancestors(fwdr.cfg.ForwarderMaxChildrenPerNode())
+	if len(ancestors) == 0 {
+		return ""
+	}
+
+	now := time.Now()
+	fwdr.partitionHintsLock.RLock()
+	defer fwdr.partitionHintsLock.RUnlock()
+	for _, name := range ancestors[:len(ancestors)-1] {
+		hint, ok := fwdr.partitionHints[name]
+		if !ok || now.After(hint.expiry) || hint.pollers > 0 {
+			return name
+		}
+	}
+	return ancestors[len(ancestors)-1]
+}
+
 func (fwdr *Forwarder) refreshTokenC(value *atomic.Value, curr *int32, maxLimit int32) {
 	currLimit := atomic.LoadInt32(curr)
 	if currLimit != maxLimit {
@@ -47,6 +47,7 @@ type ForwarderTestSuite struct {
 	fwdr       *Forwarder
 	cfg        *forwarderConfig
 	taskList   *taskListID
+	pollers    []*types.PollerInfo
 }
 
 func TestForwarderSuite(t *testing.T) {
@@ -57,13 +58,18 @@ func (t *ForwarderTestSuite) SetupTest() {
 	t.controller = gomock.NewController(t.T())
 	t.client = matching.NewMockClient(t.controller)
 	t.cfg = &forwarderConfig{
-		ForwarderMaxOutstandingPolls: func() int { return 1 },
-		ForwarderMaxRatePerSecond:    func() int { return 2 },
-		ForwarderMaxChildrenPerNode:  func() int { return 20 },
-		ForwarderMaxOutstandingTasks: func() int { return 1 },
+		ForwarderMaxOutstandingPolls:   func() int { return 1 },
+		ForwarderMaxRatePerSecond:      func() int { return 2 },
+		ForwarderMaxChildrenPerNode:    func() int { return 20 },
+		ForwarderMaxOutstandingTasks:   func() int { return 1 },
+		ForwarderPartitionHintInterval: func() time.Duration { return time.Minute },
+		ForwarderDisableCrossZone:      func() bool { return false },
+		PollerZonePattern:              func() string { return "" },
 	}
 	t.taskList = newTestTaskListID("fwdr", "tl0", persistence.TaskListTypeDecision)
-	t.fwdr = newForwarder(t.cfg, t.taskList, types.TaskListKindNormal, t.client)
+	t.fwdr = newForwarder(t.cfg, t.taskList, types.TaskListKindNormal, t.client, NewNoopDispatchHooks(), func() []*types.PollerInfo {
+		return t.pollers
+	})
 }
 
 func (t *ForwarderTestSuite) TearDownTest() {
@@ -332,6 +338,155 @@ func (t *ForwarderTestSuite) TestMaxOutstandingConfigUpdate() {
 	t.Equal(10, cap(t.fwdr.pollReqToken.Load().(*ForwarderReqToken).ch))
 }
 
+func (t *ForwarderTestSuite) TestForwardTaskSkipsIdleParent() {
+	t.cfg.ForwarderMaxChildrenPerNode = func() int { return 2 }
+	t.taskList = newTestTaskListID("fwdr", common.ReservedTaskListPrefix+"tl0/7", persistence.TaskListTypeActivity)
+	t.fwdr.taskListID = t.taskList
+	ancestors := t.taskList.Ancestors(2)
+	t.Equal([]string{
+		common.ReservedTaskListPrefix + "tl0/3",
+		common.ReservedTaskListPrefix + "tl0/1",
+		"tl0",
+	}, ancestors)
+
+	pollers := map[string]int64{ancestors[0]: 0, ancestors[1]: 3}
+	t.client.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.MatchingDescribeTaskListRequest, _ ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
+			t.Equal(t.taskList.domainID, req.GetDomainUUID())
+			t.Equal(types.TaskListTypeActivity, req.GetDescRequest().GetTaskListType())
+			t.True(req.GetDescRequest().GetIncludeTaskListStatus())
+			count, ok := pollers[req.GetDescRequest().GetTaskList().GetName()]
+			t.True(ok)
+			return &types.DescribeTaskListResponse{
+				TaskListStatus: &types.TaskListStatus{OutstandingPollCount: count},
+			}, nil
+		},
+	).Times(2)
+	t.fwdr.refreshPartitionHints(context.Background(), time.Minute)
+
+	var request *types.AddActivityTaskRequest
+	t.client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Do(
+		func(arg0 context.Context, arg1 *types.AddActivityTaskRequest, option ...yarpc.CallOption) {
+			request = arg1
+		},
+	).Return(nil).Times(1)
+
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false)
+	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
+	t.NotNil(request)
+	t.Equal(ancestors[1], request.TaskList.GetName())
+	t.Equal(t.taskList.name, request.GetForwardedFrom())
+}
+
+func (t *ForwarderTestSuite) TestForwardTargetWithPartitionHints() {
+	t.cfg.ForwarderMaxChildrenPerNode = func() int { return 2 }
+	t.taskList = newTestTaskListID("fwdr", common.ReservedTaskListPrefix+"tl0/7", persistence.TaskListTypeDecision)
+	t.fwdr.taskListID = t.taskList
+	ancestors := t.taskList.Ancestors(2)
+
+	// without hints tasks go to the parent
+	t.Equal(ancestors[0], t.fwdr.forwardTarget())
+
+	// expired hints are ignored
+	expired := time.Now().Add(-time.Second)
+	t.fwdr.partitionHints = map[string]partitionHint{
+		ancestors[0]: {pollers: 0, expiry: expired},
+	}
+	t.Equal(ancestors[0], t.fwdr.forwardTarget())
+
+	// an ancestor without a hint is not skipped
+	valid := time.Now().Add(time.Minute)
+	t.fwdr.partitionHints = map[string]partitionHint{
+		ancestors[0]: {pollers: 0, expiry: valid},
+	}
+	t.Equal(ancestors[1], t.fwdr.forwardTarget())
+
+	// when all intermediate partitions are idle tasks go to the root
+	t.fwdr.partitionHints = map[string]partitionHint{
+		ancestors[0]: {pollers: 0, expiry: valid},
+		ancestors[1]: {pollers: 0, expiry: valid},
+	}
+	t.Equal(ancestors[2], t.fwdr.forwardTarget())
+
+	// a parent with waiting pollers is always used
+	t.fwdr.partitionHints = map[string]partitionHint{
+		ancestors[0]: {pollers: 1, expiry: valid},
+		ancestors[1]: {pollers: 0, expiry: valid},
+	}
+	t.Equal(ancestors[0], t.fwdr.forwardTarget())
+
+	t.fwdr.clearPartitionHints()
+	t.Equal(ancestors[0], t.fwdr.forwardTarget())
+}
+
+func (t *ForwarderTestSuite) TestRefreshPartitionHintsError() {
+	t.cfg.ForwarderMaxChildrenPerNode = func() int { return 2 }
+	t.taskList = newTestTaskListID("fwdr", common.ReservedTaskListPrefix+"tl0/3", persistence.TaskListTypeDecision)
+	t.fwdr.taskListID = t.taskList
+	ancestors := t.taskList.Ancestors(2)
+
+	t.fwdr.partitionHints = map[string]partitionHint{
+		ancestors[0]: {pollers: 0, expiry: time.Now().Add(time.Minute)},
+	}
+	t.client.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).Return(nil, &types.InternalServiceError{}).Times(1)
+	t.fwdr.refreshPartitionHints(context.Background(), time.Minute)
+	t.Equal(ancestors[0], t.fwdr.forwardTarget())
+}
+
+func (t *ForwarderTestSuite) TestForwardTaskCrossZone() {
+	t.cfg.ForwarderDisableCrossZone = func() bool { return true }
+	t.cfg.PollerZonePattern = func() string { return `@(zone-[a-z])\.` }
+	t.cfg.ForwarderPartitionHintInterval = func() time.Duration { return 0 }
+	t.usingTasklistPartition(persistence.TaskListTypeActivity)
+	parent := t.taskList.Parent(20)
+	t.pollers = []*types.PollerInfo{{Identity: "1@zone-a.host1"}}
+
+	// the root is asked for its pollers, the poller counts are not kept as the hints are disabled
+	parentPollers := []*types.PollerInfo{{Identity: "2@zone-b.host2"}, {Identity: "3@unknown"}}
+	var describeErr error
+	t.client.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *types.MatchingDescribeTaskListRequest, _ ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
+			t.Equal(parent, req.GetDescRequest().GetTaskList().GetName())
+			if describeErr != nil {
+				return nil, describeErr
+			}
+			return &types.DescribeTaskListResponse{
+				Pollers:        parentPollers,
+				TaskListStatus: &types.TaskListStatus{OutstandingPollCount: 0},
+			}, nil
+		},
+	).AnyTimes()
+	t.fwdr.refreshPartitionHints(context.Background(), time.Minute)
+	t.Empty(t.fwdr.partitionHints)
+
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", false)
+	t.Equal(errCrossZoneForwarding, t.fwdr.ForwardTask(context.Background(), task))
+
+	// the zones are kept when the parent cannot be reached
+	describeErr = &types.InternalServiceError{}
+	t.fwdr.refreshPartitionHints(context.Background(), time.Minute)
+	t.Equal(errCrossZoneForwarding, t.fwdr.ForwardTask(context.Background(), task))
+
+	// tasks are forwarded when the zones overlap
+	describeErr = nil
+	parentPollers = append(parentPollers, &types.PollerInfo{Identity: "4@zone-a.host4"})
+	t.fwdr.refreshPartitionHints(context.Background(), time.Minute)
+	t.client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
+
+	// or when the zone of the local pollers is unknown
+	parentPollers = parentPollers[:1]
+	t.fwdr.refreshPartitionHints(context.Background(), time.Minute)
+	t.True(t.fwdr.crossesZones(parent))
+	t.pollers = []*types.PollerInfo{{Identity: "1@unknown"}}
+	t.False(t.fwdr.crossesZones(parent))
+
+	// or when the kill switch is off
+	t.pollers = []*types.PollerInfo{{Identity: "1@zone-a.host1"}}
+	t.cfg.ForwarderDisableCrossZone = func() bool { return false }
+	t.False(t.fwdr.crossesZones(parent))
+}
+
 func (t *ForwarderTestSuite) usingTasklistPartition(taskType int) {
 	t.taskList = newTestTaskListID("fwdr", common.ReservedTaskListPrefix+"tl0/1", taskType)
 	t.fwdr.taskListID = t.taskList
@@ -78,6 +78,11 @@ func (g grpcHandler) GetTaskListsByDomain(ctx context.Context, request *matching
 	return proto.FromMatchingGetTaskListsByDomainResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) DescribeTaskListConfig(ctx context.Context, request *matchingv1.DescribeTaskListConfigRequest) (*matchingv1.DescribeTaskListConfigResponse, error) {
+	response, err := g.h.DescribeTaskListConfig(ctx, proto.ToMatchingDescribeTaskListConfigRequest(request))
+	return proto.FromMatchingDescribeTaskListConfigResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) PollForActivityTask(ctx context.Context, request *matchingv1.PollForActivityTaskRequest) (*matchingv1.PollForActivityTaskResponse, error) {
 	response, err := g.h.PollForActivityTask(ctx, proto.ToMatchingPollForActivityTaskRequest(request))
 	return proto.FromMatchingPollForActivityTaskResponse(response), proto.FromError(err)
@@ -24,9 +24,12 @@ package matching
 
 import (
 	"context"
+	"encoding/json"
 	"sync"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
@@ -42,6 +45,7 @@ type (
 	Handler interface {
 		common.Daemon
 
+		DrainPollers()
 		Health(context.Context) (*types.HealthStatus, error)
 		AddActivityTask(context.Context, *types.AddActivityTaskRequest) error
 		AddDecisionTask(context.Context, *types.AddDecisionTaskRequest) error
@@ -49,6 +53,7 @@ type (
 		DescribeTaskList(context.Context, *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(context.Context, *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		DescribeTaskListConfig(context.Context, *types.MatchingDescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error)
 		PollForActivityTask(context.Context, *types.MatchingPollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error)
 		PollForDecisionTask(context.Context, *types.MatchingPollForDecisionTaskRequest) (*types.MatchingPollForDecisionTaskResponse, error)
 		QueryWorkflow(context.Context, *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
@@ -76,6 +81,7 @@ var (
 func NewHandler(
 	resource resource.Resource,
 	config *Config,
+	tracerProvider trace.TracerProvider,
 ) Handler {
 	handler := &handlerImpl{
 		Resource:      resource,
@@ -114,6 +120,7 @@ func NewHandler(
 			resource.GetMetricsClient(),
 			resource.GetDomainCache(),
 			resource.GetMembershipResolver(),
+			NewTracingDispatchHooks(tracerProvider, config.EnableDispatchTracing),
 		),
 	}
 	// prevent us from trying to serve requests before matching engine is started and ready
@@ -131,11 +138,24 @@ func (h *handlerImpl) Stop() {
 	h.engine.Stop()
 }
 
-// Health is for health check
+// DrainPollers completes outstanding polls so that pollers get routed to other hosts
+func (h *handlerImpl) DrainPollers() {
+	h.engine.DrainPollers()
+}
+
+// Health is for health check. The host is reported as not ok while it is draining or when its
+// persistence error rate is too high, so that load balancers stop routing to it, and the message
+// carries the health report as JSON.
 func (h *handlerImpl) Health(ctx context.Context) (*types.HealthStatus, error) {
 	h.startWG.Wait()
 	h.GetLogger().Debug("Matching service health check endpoint reached.")
-	hs := &types.HealthStatus{Ok: true, Msg: "matching good"}
+	health := h.engine.HostHealth()
+	health.Ready = isHostReady(health, h.config.HealthMaxPersistenceErrorRate())
+	msg, err := json.Marshal(health)
+	if err != nil {
+		return nil, err
+	}
+	hs := &types.HealthStatus{Ok: health.Ready, Msg: string(msg)}
 	return hs, nil
 }
 
@@ -179,7 +199,7 @@ func (h *handlerImpl) AddActivityTask(
 	}
 
 	if ok := h.workerRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
-		return hCtx.handleErr(errMatchingHostThrottle)
+		return hCtx.handleErr(newMatchingHostThrottleError(h.workerRateLimiter, domainName))
 	}
 
 	syncMatch, err := h.engine.AddActivityTask(hCtx, request)
@@ -214,7 +234,7 @@ func (h *handlerImpl) AddDecisionTask(
 	}
 
 	if ok := h.workerRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
-		return hCtx.handleErr(errMatchingHostThrottle)
+		return hCtx.handleErr(newMatchingHostThrottleError(h.workerRateLimiter, domainName))
 	}
 
 	syncMatch, err := h.engine.AddDecisionTask(hCtx, request)
@@ -247,7 +267,7 @@ func (h *handlerImpl) PollForActivityTask(
 	}
 
 	if ok := h.workerRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
-		return nil, hCtx.handleErr(errMatchingHostThrottle)
+		return nil, hCtx.handleErr(newMatchingHostThrottleError(h.workerRateLimiter, domainName))
 	}
 
 	if _, err := common.ValidateLongPollContextTimeoutIsSet(
@@ -285,7 +305,7 @@ func (h *handlerImpl) PollForDecisionTask(
 	}
 
 	if ok := h.workerRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
-		return nil, hCtx.handleErr(errMatchingHostThrottle)
+		return nil, hCtx.handleErr(newMatchingHostThrottleError(h.workerRateLimiter, domainName))
 	}
 
 	if _, err := common.ValidateLongPollContextTimeoutIsSet(
@@ -323,7 +343,7 @@ func (h *handlerImpl) QueryWorkflow(
 	}
 
 	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
-		return nil, hCtx.handleErr(errMatchingHostThrottle)
+		return nil, hCtx.handleErr(newMatchingHostThrottleError(h.userRateLimiter, domainName))
 	}
 
 	response, err := h.engine.QueryWorkflow(hCtx, request)
@@ -399,7 +419,7 @@ func (h *handlerImpl) DescribeTaskList(
 	defer sw.Stop()
 
 	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
-		return nil, hCtx.handleErr(errMatchingHostThrottle)
+		return nil, hCtx.handleErr(newMatchingHostThrottleError(h.userRateLimiter, domainName))
 	}
 
 	response, err := h.engine.DescribeTaskList(hCtx, request)
@@ -426,7 +446,7 @@ func (h *handlerImpl) ListTaskListPartitions(
 	defer sw.Stop()
 
 	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: request.GetDomain()}); !ok {
-		return nil, hCtx.handleErr(errMatchingHostThrottle)
+		return nil, hCtx.handleErr(newMatchingHostThrottleError(h.userRateLimiter, request.GetDomain()))
 	}
 
 	response, err := h.engine.ListTaskListPartitions(hCtx, request)
@@ -453,13 +473,40 @@ func (h *handlerImpl) GetTaskListsByDomain(
 	defer sw.Stop()
 
 	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: request.GetDomain()}); !ok {
-		return nil, hCtx.handleErr(errMatchingHostThrottle)
+		return nil, hCtx.handleErr(newMatchingHostThrottleError(h.userRateLimiter, request.GetDomain()))
 	}
 
 	response, err := h.engine.GetTaskListsByDomain(hCtx, request)
 	return response, hCtx.handleErr(err)
 }
 
+// DescribeTaskListConfig returns the configuration this host applies to the target tasklist,
+// after dynamic config filters are applied
+func (h *handlerImpl) DescribeTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListConfigRequest,
+) (resp *types.DescribeTaskListConfigResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetTaskList(),
+		metrics.MatchingDescribeTaskListConfigScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(newMatchingHostThrottleError(h.userRateLimiter, domainName))
+	}
+
+	response, err := h.engine.DescribeTaskListConfig(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
 func (h *handlerImpl) domainName(id string) string {
 	domainName, err := h.GetDomainCache().GetDomainName(id)
 	if err != nil {
@@ -467,3 +514,12 @@ func (h *handlerImpl) domainName(id string) string {
 	}
 	return domainName
 }
+
+// newMatchingHostThrottleError returns the error of a request rejected by the rate limiter,
+// with a hint on when the request can be retried
+func newMatchingHostThrottleError(limiter quotas.Policy, domainName string) error {
+	return common.NewServiceBusyError(
+		errMatchingHostThrottle.Message,
+		limiter.RetryAfter(quotas.Info{Domain: domainName}),
+	)
+}
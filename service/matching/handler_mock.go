@@ -169,6 +169,18 @@ func (mr *MockHandlerMockRecorder) ListTaskListPartitions(arg0, arg1 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTaskListPartitions", reflect.TypeOf((*MockHandler)(nil).ListTaskListPartitions), arg0, arg1)
 }
 
+// DrainPollers mocks base method
+func (m *MockHandler) DrainPollers() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DrainPollers")
+}
+
+// DrainPollers indicates an expected call of DrainPollers
+func (mr *MockHandlerMockRecorder) DrainPollers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DrainPollers", reflect.TypeOf((*MockHandler)(nil).DrainPollers))
+}
+
 // GetTaskListsByDomain mocks base method
 func (m *MockHandler) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +196,21 @@ func (mr *MockHandlerMockRecorder) GetTaskListsByDomain(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListsByDomain", reflect.TypeOf((*MockHandler)(nil).GetTaskListsByDomain), arg0, arg1)
 }
 
+// DescribeTaskListConfig mocks base method
+func (m *MockHandler) DescribeTaskListConfig(arg0 context.Context, arg1 *types.MatchingDescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeTaskListConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.DescribeTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeTaskListConfig indicates an expected call of DescribeTaskListConfig
+func (mr *MockHandlerMockRecorder) DescribeTaskListConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskListConfig", reflect.TypeOf((*MockHandler)(nil).DescribeTaskListConfig), arg0, arg1)
+}
+
 // PollForActivityTask mocks base method
 func (m *MockHandler) PollForActivityTask(arg0 context.Context, arg1 *types.MatchingPollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,148 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// healthWindow is the length of the window over which the persistence error rate is computed
+	healthWindow = time.Minute
+	// healthMinPersistenceRequests is the number of requests below which the error rate is considered noise
+	healthMinPersistenceRequests = 10
+)
+
+type (
+	// hostHealth keeps the counters reported by the matching health endpoint. A nil
+	// hostHealth is valid and records nothing.
+	hostHealth struct {
+		timeSource    clock.TimeSource
+		leaseFailures int64
+
+		sync.Mutex
+		windowStart time.Time
+		current     persistenceStats
+		previous    persistenceStats
+	}
+
+	persistenceStats struct {
+		requests int64
+		errors   int64
+	}
+)
+
+func newHostHealth(timeSource clock.TimeSource) *hostHealth {
+	return &hostHealth{
+		timeSource:  timeSource,
+		windowStart: timeSource.Now(),
+	}
+}
+
+// recordLease records the outcome of a tasklist lease acquisition or renewal
+func (h *hostHealth) recordLease(err error) {
+	if h == nil {
+		return
+	}
+	if err != nil {
+		atomic.AddInt64(&h.leaseFailures, 1)
+	}
+	h.recordPersistence(err)
+}
+
+// recordPersistence records the outcome of a persistence operation. Condition failures
+// mean the tasklist moved to another host and say nothing about the health of persistence.
+func (h *hostHealth) recordPersistence(err error) {
+	if h == nil {
+		return
+	}
+	h.Lock()
+	defer h.Unlock()
+	h.rotateLocked()
+	h.current.requests++
+	if _, ok := err.(*persistence.ConditionFailedError); err != nil && !ok {
+		h.current.errors++
+	}
+}
+
+// leaseFailureCount returns the number of failed lease attempts since the host started
+func (h *hostHealth) leaseFailureCount() int64 {
+	if h == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&h.leaseFailures)
+}
+
+// persistenceWindow returns the requests and errors of the previous and the current window
+func (h *hostHealth) persistenceWindow() persistenceStats {
+	if h == nil {
+		return persistenceStats{}
+	}
+	h.Lock()
+	defer h.Unlock()
+	h.rotateLocked()
+	return persistenceStats{
+		requests: h.previous.requests + h.current.requests,
+		errors:   h.previous.errors + h.current.errors,
+	}
+}
+
+func (h *hostHealth) rotateLocked() {
+	now := h.timeSource.Now()
+	elapsed := now.Sub(h.windowStart)
+	if elapsed < healthWindow {
+		return
+	}
+	if elapsed < 2*healthWindow {
+		h.previous = h.current
+	} else {
+		h.previous = persistenceStats{}
+	}
+	h.current = persistenceStats{}
+	h.windowStart = now
+}
+
+func (s persistenceStats) errorRate() float64 {
+	if s.requests == 0 {
+		return 0
+	}
+	return float64(s.errors) / float64(s.requests)
+}
+
+// isHostReady decides whether the host is ready to serve traffic, a draining host or one failing
+// most of its persistence operations is not
+func isHostReady(health *types.MatchingHostHealth, maxPersistenceErrorRate float64) bool {
+	if health.GetDraining() {
+		return false
+	}
+	if maxPersistenceErrorRate > 0 &&
+		health.PersistenceRequests >= healthMinPersistenceRequests &&
+		health.GetPersistenceErrorRate() > maxPersistenceErrorRate {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestHostHealth_PersistenceWindow(t *testing.T) {
+	now := time.Now()
+	timeSource := clock.NewEventTimeSource().Update(now)
+	health := newHostHealth(timeSource)
+
+	health.recordPersistence(nil)
+	health.recordPersistence(errors.New("timeout"))
+	health.recordPersistence(&persistence.ConditionFailedError{Msg: "range id changed"})
+	stats := health.persistenceWindow()
+	assert.Equal(t, int64(3), stats.requests)
+	assert.Equal(t, int64(1), stats.errors)
+
+	// the previous window is still part of the report
+	timeSource.Update(now.Add(healthWindow))
+	health.recordPersistence(nil)
+	stats = health.persistenceWindow()
+	assert.Equal(t, int64(4), stats.requests)
+	assert.Equal(t, int64(1), stats.errors)
+	assert.Equal(t, 0.25, stats.errorRate())
+
+	// windows older than that are dropped
+	timeSource.Update(now.Add(3 * healthWindow))
+	stats = health.persistenceWindow()
+	assert.Equal(t, persistenceStats{}, stats)
+	assert.Equal(t, float64(0), stats.errorRate())
+}
+
+func TestHostHealth_LeaseFailures(t *testing.T) {
+	health := newHostHealth(clock.NewRealTimeSource())
+	health.recordLease(nil)
+	health.recordLease(&persistence.ConditionFailedError{Msg: "range id changed"})
+	health.recordLease(errors.New("timeout"))
+	assert.Equal(t, int64(2), health.leaseFailureCount())
+	assert.Equal(t, int64(1), health.persistenceWindow().errors)
+
+	var nilHealth *hostHealth
+	nilHealth.recordLease(errors.New("timeout"))
+	assert.Equal(t, int64(0), nilHealth.leaseFailureCount())
+}
+
+func TestIsHostReady(t *testing.T) {
+	assert.True(t, isHostReady(&types.MatchingHostHealth{}, 0.5))
+	assert.False(t, isHostReady(&types.MatchingHostHealth{Draining: true}, 0.5))
+
+	failing := &types.MatchingHostHealth{
+		PersistenceRequests:  healthMinPersistenceRequests,
+		PersistenceErrors:    healthMinPersistenceRequests,
+		PersistenceErrorRate: 1,
+	}
+	assert.False(t, isHostReady(failing, 0.5))
+	assert.True(t, isHostReady(failing, 0), "0 disables the error rate check")
+
+	failing.PersistenceRequests = healthMinPersistenceRequests - 1
+	assert.True(t, isHostReady(failing, 0.5), "too few requests to judge")
+}
+
+func TestHealth_NotOkWhileDraining(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	mockResource := resource.NewTest(controller, metrics.Matching)
+	defer mockResource.Finish(t)
+
+	config := defaultTestConfig()
+	engine := newMatchingEngine(config, nil, nil, mockResource.GetLogger(), nil)
+	handler := &handlerImpl{
+		Resource: mockResource,
+		engine:   engine,
+		config:   config,
+	}
+
+	status, err := handler.Health(context.Background())
+	require.NoError(t, err)
+	assert.True(t, status.GetOk())
+
+	engine.DrainPollers()
+	status, err = handler.Health(context.Background())
+	require.NoError(t, err)
+	assert.False(t, status.GetOk())
+	var health types.MatchingHostHealth
+	require.NoError(t, json.Unmarshal([]byte(status.GetMsg()), &health))
+	assert.True(t, health.Draining)
+	assert.False(t, health.Ready)
+}
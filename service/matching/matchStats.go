@@ -0,0 +1,80 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync/atomic"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	matchTypeSync      = "sync"
+	matchTypeBuffered  = "buffered"
+	matchTypeForwarded = "forwarded"
+)
+
+// matchStats counts how tasks of a tasklist partition were handed to pollers, so that
+// the effect of sync match tuning can be observed. Counters are reset on tasklist reload.
+type matchStats struct {
+	syncMatched     int64 // matched with a local poller before being persisted
+	bufferedMatched int64 // persisted first and later matched with a local poller
+	forwarded       int64 // matched with a poller on the parent partition
+	expired         int64 // expired before they could be matched
+	scope           func() metrics.Scope
+}
+
+func newMatchStats(scopeFunc func() metrics.Scope) *matchStats {
+	return &matchStats{scope: scopeFunc}
+}
+
+func (s *matchStats) recordSyncMatch() {
+	atomic.AddInt64(&s.syncMatched, 1)
+	s.emit(matchTypeSync)
+}
+
+func (s *matchStats) recordBufferedMatch() {
+	atomic.AddInt64(&s.bufferedMatched, 1)
+	s.emit(matchTypeBuffered)
+}
+
+func (s *matchStats) recordForwarded() {
+	atomic.AddInt64(&s.forwarded, 1)
+	s.emit(matchTypeForwarded)
+}
+
+func (s *matchStats) recordExpired() {
+	atomic.AddInt64(&s.expired, 1)
+}
+
+func (s *matchStats) emit(matchType string) {
+	s.scope().Tagged(metrics.MatchTypeTag(matchType)).IncCounter(metrics.TaskMatchedPerTaskListCounter)
+}
+
+func (s *matchStats) toTaskListMatchStats() *types.TaskListMatchStats {
+	return &types.TaskListMatchStats{
+		SyncMatched:     atomic.LoadInt64(&s.syncMatched),
+		BufferedMatched: atomic.LoadInt64(&s.bufferedMatched),
+		Forwarded:       atomic.LoadInt64(&s.forwarded),
+		Expired:         atomic.LoadInt64(&s.expired),
+	}
+}
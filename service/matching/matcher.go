@@ -23,10 +23,12 @@ package matching
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
 
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
@@ -36,6 +38,8 @@ import (
 // Producers are usually rpc calls from history or taskReader
 // that drains backlog from db. Consumers are the task list pollers
 type TaskMatcher struct {
+	// number of pollers currently blocked waiting for a task, kept first for 64-bit atomic alignment
+	numOutstandingPolls int64
 	// synchronous task channel to match producer/consumer
 	taskC chan *InternalTask
 	// synchronous task channel to match query task - the reason to have
@@ -49,6 +53,8 @@ type TaskMatcher struct {
 	fwdr          *Forwarder
 	scope         func() metrics.Scope // domain metric scope
 	numPartitions func() int           // number of task list partitions
+	stats         *matchStats          // counts how tasks were matched with pollers
+	affinity      *taskAffinity        // routes tasks of a workflow to the same poller identity
 }
 
 const (
@@ -71,6 +77,8 @@ func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scopeFunc func() me
 		taskC:         make(chan *InternalTask),
 		queryTaskC:    make(chan *InternalTask),
 		numPartitions: config.NumReadPartitions,
+		stats:         newMatchStats(scopeFunc),
+		affinity:      newTaskAffinity(config, clock.NewRealTimeSource()),
 	}
 }
 
@@ -114,15 +122,13 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 		}
 	}
 
+	if tm.offerAffine(task) {
+		return tm.awaitLocalMatch(task)
+	}
+
 	select {
 	case tm.taskC <- task: // poller picked up the task
-		if task.responseC != nil {
-			// if there is a response channel, block until resp is received
-			// and return error if the response contains error
-			err = <-task.responseC
-			return true, err
-		}
-		return false, nil
+		return tm.awaitLocalMatch(task)
 	default:
 		// no poller waiting for tasks, try forwarding this task to the
 		// root partition if possible
@@ -131,6 +137,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 			if err := tm.fwdr.ForwardTask(ctx, task); err == nil {
 				// task was remotely sync matched on the parent partition
 				token.release()
+				tm.stats.recordForwarded()
 				return true, nil
 			}
 			token.release()
@@ -153,12 +160,38 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *InternalTask) (bool, err
 	}
 }
 
+// awaitLocalMatch waits for the local poller that picked up the task to respond. If there is
+// a response channel, block until resp is received and return error if the response contains error
+func (tm *TaskMatcher) awaitLocalMatch(task *InternalTask) (bool, error) {
+	if task.responseC != nil {
+		err := <-task.responseC
+		if err == nil {
+			tm.recordLocalMatch(task)
+		}
+		return true, err
+	}
+	return false, nil
+}
+
+// offerAffine hands the task to a poller of the identity that handled the previous task
+// of the same workflow, when task affinity is enabled and that identity is still polling
+func (tm *TaskMatcher) offerAffine(task *InternalTask) bool {
+	if !tm.affinity.offer(task) {
+		return false
+	}
+	tm.scope().IncCounter(metrics.TaskAffinityMatchedPerTaskListCounter)
+	return true
+}
+
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *InternalTask) (bool, error) {
 	select {
 	case tm.taskC <- task: // poller picked up the task
 		if task.responseC != nil {
 			select {
 			case err := <-task.responseC:
+				if err == nil {
+					tm.recordLocalMatch(task)
+				}
 				return true, err
 			case <-ctx.Done():
 				return false, nil
@@ -215,10 +248,16 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *InternalTask) error
 		return err
 	}
 
+	if tm.offerAffine(task) {
+		tm.recordLocalMatch(task)
+		return nil
+	}
+
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	select {
 	case tm.taskC <- task:
+		tm.recordLocalMatch(task)
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
@@ -229,6 +268,7 @@ forLoop:
 	for {
 		select {
 		case tm.taskC <- task:
+			tm.recordLocalMatch(task)
 			return nil
 		case token := <-tm.fwdrAddReqTokenC():
 			childCtx, cancel := context.WithDeadline(ctx, time.Now().Add(time.Second*2))
@@ -242,6 +282,7 @@ forLoop:
 				select {
 				case tm.taskC <- task:
 					cancel()
+					tm.recordLocalMatch(task)
 					return nil
 				case <-childCtx.Done():
 				case <-ctx.Done():
@@ -255,7 +296,8 @@ forLoop:
 			// at this point, we forwarded the task to a parent partition which
 			// in turn dispatched the task to a poller. Make sure we delete the
 			// task from the database
-			task.finish(nil)
+			task.finish(ctx, nil)
+			tm.stats.recordForwarded()
 			return nil
 		case <-ctx.Done():
 			return ctx.Err()
@@ -263,31 +305,49 @@ forLoop:
 	}
 }
 
+// recordLocalMatch records a task matched with a local poller, depending on
+// whether the task was read from the db backlog or offered directly
+func (tm *TaskMatcher) recordLocalMatch(task *InternalTask) {
+	if task.source == types.TaskSourceDbBacklog {
+		tm.stats.recordBufferedMatch()
+		return
+	}
+	tm.stats.recordSyncMatch()
+}
+
 // Poll blocks until a task is found or context deadline is exceeded
 // On success, the returned task could be a query task or a regular task
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) Poll(ctx context.Context) (*InternalTask, error) {
+	// tasks affine to the identity of this poller are delivered on a dedicated channel
+	affineTaskC, release := tm.affinity.acquirePoller(ctx)
+	defer release()
+
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, tm.taskC, tm.queryTaskC); err == nil {
-		return task, nil
+	task, err := tm.pollNonBlocking(ctx, tm.taskC, tm.queryTaskC, affineTaskC)
+	if err != nil {
+		// there is no local poller available to pickup this task. Now block waiting
+		// either for a local poller or a forwarding token to be available. When a
+		// forwarding token becomes available, send this poll to a parent partition
+		task, err = tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC, affineTaskC)
 	}
-	// there is no local poller available to pickup this task. Now block waiting
-	// either for a local poller or a forwarding token to be available. When a
-	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC)
+	if err == nil {
+		tm.affinity.recordMatch(ctx, task)
+	}
+	return task, err
 }
 
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) PollForQuery(ctx context.Context) (*InternalTask, error) {
 	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, tm.queryTaskC); err == nil {
+	if task, err := tm.pollNonBlocking(ctx, nil, tm.queryTaskC, nil); err == nil {
 		return task, nil
 	}
 	// there is no local poller available to pickup this task. Now block waiting
 	// either for a local poller or a forwarding token to be available. When a
 	// forwarding token becomes available, send this poll to a parent partition
-	return tm.pollOrForward(ctx, nil, tm.queryTaskC)
+	return tm.pollOrForward(ctx, nil, tm.queryTaskC, nil)
 }
 
 // UpdateRatelimit updates the task dispatch rate
@@ -309,11 +369,20 @@ func (tm *TaskMatcher) Rate() float64 {
 	return tm.limiter.Limit()
 }
 
+// OutstandingPollCount returns the number of long-polls currently blocked on this task list partition
+func (tm *TaskMatcher) OutstandingPollCount() int64 {
+	return atomic.LoadInt64(&tm.numOutstandingPolls)
+}
+
 func (tm *TaskMatcher) pollOrForward(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
+	affineTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
+	atomic.AddInt64(&tm.numOutstandingPolls, 1)
+	defer atomic.AddInt64(&tm.numOutstandingPolls, -1)
+
 	select {
 	case task := <-taskC:
 		if task.responseC != nil {
@@ -321,6 +390,12 @@ func (tm *TaskMatcher) pollOrForward(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-affineTaskC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -334,7 +409,7 @@ func (tm *TaskMatcher) pollOrForward(
 			return task, nil
 		}
 		token.release()
-		return tm.poll(ctx, taskC, queryTaskC)
+		return tm.poll(ctx, taskC, queryTaskC, affineTaskC)
 	}
 }
 
@@ -342,6 +417,7 @@ func (tm *TaskMatcher) poll(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
+	affineTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
 	case task := <-taskC:
@@ -350,6 +426,12 @@ func (tm *TaskMatcher) poll(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-affineTaskC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -364,6 +446,7 @@ func (tm *TaskMatcher) pollNonBlocking(
 	ctx context.Context,
 	taskC <-chan *InternalTask,
 	queryTaskC <-chan *InternalTask,
+	affineTaskC <-chan *InternalTask,
 ) (*InternalTask, error) {
 	select {
 	case task := <-taskC:
@@ -372,6 +455,12 @@ func (tm *TaskMatcher) pollNonBlocking(
 		}
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
 		return task, nil
+	case task := <-affineTaskC:
+		if task.responseC != nil {
+			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+		}
+		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+		return task, nil
 	case task := <-queryTaskC:
 		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
 		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
@@ -69,7 +69,7 @@ func (t *MatcherTestSuite) SetupTest() {
 		ForwarderMaxChildrenPerNode:  func() int { return 20 },
 	}
 	t.cfg = tlCfg
-	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskList, types.TaskListKindNormal, t.client)
+	t.fwdr = newForwarder(&t.cfg.forwarderConfig, t.taskList, types.TaskListKindNormal, t.client, NewNoopDispatchHooks(), nil)
 	t.matcher = newTaskMatcher(tlCfg, t.fwdr, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
 
 	rootTaskList := newTestTaskListID(t.taskList.domainID, t.taskList.Parent(20), persistence.TaskListTypeDecision)
@@ -90,7 +90,7 @@ func (t *MatcherTestSuite) TestLocalSyncMatch() {
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
 		task, err := t.matcher.Poll(ctx)
 		if err == nil {
-			task.finish(nil)
+			task.finish(context.Background(), nil)
 		}
 	})
 
@@ -101,6 +101,34 @@ func (t *MatcherTestSuite) TestLocalSyncMatch() {
 	wait()
 	t.NoError(err)
 	t.True(syncMatch)
+	t.Equal(&types.TaskListMatchStats{SyncMatched: 1}, t.matcher.stats.toTaskListMatchStats())
+}
+
+func (t *MatcherTestSuite) TestOutstandingPollCount() {
+	t.Zero(t.rootMatcher.OutstandingPollCount())
+
+	pollDone := make(chan struct{})
+	go func() {
+		defer close(pollDone)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		task, err := t.rootMatcher.Poll(ctx)
+		if err == nil {
+			task.finish(context.Background(), nil)
+		}
+	}()
+	t.Eventually(func() bool {
+		return t.rootMatcher.OutstandingPollCount() == 1
+	}, time.Second, time.Millisecond)
+
+	task := newInternalTask(t.newTaskInfo(), nil, types.TaskSourceHistory, "", true)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	syncMatch, err := t.rootMatcher.Offer(ctx, task)
+	cancel()
+	<-pollDone
+	t.NoError(err)
+	t.True(syncMatch)
+	t.Zero(t.rootMatcher.OutstandingPollCount())
 }
 
 func (t *MatcherTestSuite) TestRemoteSyncMatch() {
@@ -125,7 +153,7 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource types.TaskSource) {
 		task, err := t.matcher.Poll(bgctx)
 		bgcancel()
 		if err == nil && !task.isStarted() {
-			task.finish(nil)
+			task.finish(context.Background(), nil)
 		}
 	}()
 
@@ -136,7 +164,7 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource types.TaskSource) {
 				return nil, err
 			}
 
-			task.finish(nil)
+			task.finish(context.Background(), nil)
 			return &types.MatchingPollForDecisionTaskResponse{
 				WorkflowExecution: task.workflowExecution(),
 			}, nil
@@ -173,6 +201,7 @@ func (t *MatcherTestSuite) testRemoteSyncMatch(taskSource types.TaskSource) {
 	t.True(remoteSyncMatch)
 	t.Equal(t.taskList.name, req.GetForwardedFrom())
 	t.Equal(t.taskList.Parent(20), req.GetTaskList().GetName())
+	t.Equal(int64(1), t.matcher.stats.toTaskListMatchStats().GetForwarded())
 }
 
 func (t *MatcherTestSuite) TestSyncMatchFailure() {
@@ -191,6 +220,7 @@ func (t *MatcherTestSuite) TestSyncMatchFailure() {
 	t.NotNil(req)
 	t.NoError(err)
 	t.False(syncMatch)
+	t.Equal(&types.TaskListMatchStats{}, t.matcher.stats.toTaskListMatchStats())
 }
 
 func (t *MatcherTestSuite) TestQueryLocalSyncMatch() {
@@ -201,7 +231,7 @@ func (t *MatcherTestSuite) TestQueryLocalSyncMatch() {
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
 		task, err := t.matcher.PollForQuery(ctx)
 		if err == nil && task.isQuery() {
-			task.finish(nil)
+			task.finish(context.Background(), nil)
 		}
 	})
 
@@ -218,7 +248,7 @@ func (t *MatcherTestSuite) TestQueryRemoteSyncMatch() {
 	ready, wait := ensureAsyncAfterReady(time.Second, func(ctx context.Context) {
 		task, err := t.matcher.PollForQuery(ctx)
 		if err == nil && task.isQuery() {
-			task.finish(nil)
+			task.finish(context.Background(), nil)
 		}
 	})
 
@@ -229,7 +259,7 @@ func (t *MatcherTestSuite) TestQueryRemoteSyncMatch() {
 			if err != nil {
 				return nil, err
 			} else if task.isQuery() {
-				task.finish(nil)
+				task.finish(context.Background(), nil)
 				res := &types.MatchingPollForDecisionTaskResponse{
 					Query: &types.WorkflowQuery{},
 				}
@@ -273,7 +303,7 @@ func (t *MatcherTestSuite) TestQueryRemoteSyncMatchError() {
 		task, err := t.matcher.PollForQuery(ctx)
 		if err == nil && task.isQuery() {
 			matched = true
-			task.finish(nil)
+			task.finish(context.Background(), nil)
 		}
 	})
 
@@ -305,7 +335,7 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	wait := ensureAsyncReady(time.Second, func(ctx context.Context) {
 		task, err := t.matcher.Poll(ctx)
 		if err == nil {
-			task.finish(nil)
+			task.finish(context.Background(), nil)
 		}
 	})
 
@@ -329,7 +359,7 @@ func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 				return nil, err
 			}
 
-			task.finish(nil)
+			task.finish(context.Background(), nil)
 			return &types.MatchingPollForDecisionTaskResponse{
 				WorkflowExecution: task.workflowExecution(),
 			}, nil
@@ -337,7 +367,7 @@ func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	).AnyTimes()
 
 	taskCompleted := false
-	completionFunc := func(*persistence.TaskInfo, error) {
+	completionFunc := func(context.Context, *persistence.TaskInfo, error) {
 		taskCompleted = true
 	}
 
@@ -434,6 +464,47 @@ func (t *MatcherTestSuite) newDomainCache() cache.DomainCache {
 	return dc
 }
 
+func (t *MatcherTestSuite) TestAffinityMatch() {
+	t.cfg.TaskAffinityTTL = func() time.Duration { return time.Minute }
+	matcher := newTaskMatcher(t.cfg, nil, func() metrics.Scope { return metrics.NoopScope(metrics.Matching) })
+	ctxA := context.WithValue(context.Background(), identityKey, "worker-a")
+	ctxB := context.WithValue(context.Background(), identityKey, "worker-b")
+
+	poll := func(ctx context.Context, timeout time.Duration) <-chan *InternalTask {
+		resultC := make(chan *InternalTask, 1)
+		go func() {
+			pollCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			task, _ := matcher.Poll(pollCtx)
+			resultC <- task
+		}()
+		return resultC
+	}
+	newTask := func() *InternalTask {
+		info := t.newTaskInfo()
+		info.AffinityKey = "affinity-key"
+		return newInternalTask(info, nil, types.TaskSourceHistory, "", false)
+	}
+
+	// worker-a handles the first task of the workflow and becomes its owner
+	pollA := poll(ctxA, time.Second)
+	t.NoError(matcher.MustOffer(context.Background(), newTask()))
+	t.NotNil(<-pollA)
+
+	// worker-b is already waiting, but the next task of the workflow goes to worker-a
+	pollB := poll(ctxB, 500*time.Millisecond)
+	pollA = poll(ctxA, time.Second)
+	time.Sleep(50 * time.Millisecond)
+	t.NoError(matcher.MustOffer(context.Background(), newTask()))
+	t.NotNil(<-pollA)
+	t.Nil(<-pollB)
+
+	// worker-a is not waiting for a task, the affine offer does not block and worker-b gets the task
+	pollB = poll(ctxB, time.Second)
+	t.NoError(matcher.MustOffer(context.Background(), newTask()))
+	t.NotNil(<-pollB)
+}
+
 func (t *MatcherTestSuite) newTaskInfo() *persistence.TaskInfo {
 	return &persistence.TaskInfo{
 		DomainID:               uuid.New(),
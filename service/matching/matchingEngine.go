@@ -27,11 +27,13 @@ import (
 	"fmt"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/service"
 
 	"github.com/pborman/uuid"
+	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
@@ -39,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
@@ -68,6 +71,13 @@ type (
 		queryTaskMap map[string]chan *queryResult
 	}
 
+	// dispatchGroupKey identifies the task lists with the same name and type across domains,
+	// which are usually polled by the same workers
+	dispatchGroupKey struct {
+		name     string
+		taskType int
+	}
+
 	matchingEngineImpl struct {
 		taskManager          persistence.TaskManager
 		historyService       history.Client
@@ -75,16 +85,31 @@ type (
 		tokenSerializer      common.TaskTokenSerializer
 		logger               log.Logger
 		metricsClient        metrics.Client
-		taskListsLock        sync.RWMutex                   // locks mutation of taskLists
-		taskLists            map[taskListID]taskListManager // Convert to LRU cache
+		taskListsLock        sync.RWMutex                                        // locks mutation of taskLists
+		taskLists            map[taskListID]taskListManager                      // Convert to LRU cache
+		dispatchGroups       map[dispatchGroupKey]map[taskListID]taskListManager // loaded task lists sharing a name across domains, guarded by taskListsLock
+		domainTaskLists      map[string]map[string]int                           // base names of the loaded non-sticky task lists per domain ID, guarded by taskListsLock
 		config               *Config
 		lockableQueryTaskMap lockableQueryTaskMap
 		domainCache          cache.DomainCache
 		versionChecker       client.VersionChecker
 		membershipResolver   membership.Resolver
+		dispatchHooks        DispatchHooks
+		draining             int32
+		health               *hostHealth
+		unloadedTaskLists    cache.Cache // last DescribeTaskList response of the task lists unloaded by this host
 	}
 )
 
+const (
+	// loadTaskListHeaderName marks a DescribeTaskList call which loads the task list even when describing
+	// task lists read only is enabled, e.g. to make the owner of a leftover partition drain its backlog
+	loadTaskListHeaderName = "cadence-matching-load-tasklist"
+
+	unloadedTaskListCacheSize = 10000
+	unloadedTaskListCacheTTL  = time.Hour
+)
+
 var (
 	// EmptyPollForDecisionTaskResponse is the response when there are no decision tasks to hand out
 	emptyPollForDecisionTaskResponse = &types.MatchingPollForDecisionTaskResponse{}
@@ -112,6 +137,7 @@ func NewEngine(taskManager persistence.TaskManager,
 	metricsClient metrics.Client,
 	domainCache cache.DomainCache,
 	resolver membership.Resolver,
+	dispatchHooks DispatchHooks,
 ) Engine {
 
 	return &matchingEngineImpl{
@@ -119,6 +145,8 @@ func NewEngine(taskManager persistence.TaskManager,
 		historyService:       historyService,
 		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
 		taskLists:            make(map[taskListID]taskListManager),
+		dispatchGroups:       make(map[dispatchGroupKey]map[taskListID]taskListManager),
+		domainTaskLists:      make(map[string]map[string]int),
 		logger:               logger.WithTags(tag.ComponentMatchingEngine),
 		metricsClient:        metricsClient,
 		matchingClient:       matchingClient,
@@ -127,13 +155,75 @@ func NewEngine(taskManager persistence.TaskManager,
 		domainCache:          domainCache,
 		versionChecker:       client.NewVersionChecker(),
 		membershipResolver:   resolver,
+		dispatchHooks:        dispatchHooks,
+		health:               newHostHealth(clock.NewRealTimeSource()),
+		unloadedTaskLists:    newUnloadedTaskListCache(),
 	}
 }
 
+func newUnloadedTaskListCache() cache.Cache {
+	return cache.New(&cache.Options{
+		TTL:      unloadedTaskListCacheTTL,
+		MaxCount: unloadedTaskListCacheSize,
+	})
+}
+
 func (e *matchingEngineImpl) Start() {
 	// As task lists are initialized lazily nothing is done on startup at this point.
 }
 
+func (e *matchingEngineImpl) DrainPollers() {
+	atomic.StoreInt32(&e.draining, 1)
+	taskLists := e.getTaskLists(math.MaxInt32)
+	e.logger.Info("Draining outstanding polls.", tag.Counter(len(taskLists)))
+	for _, l := range taskLists {
+		l.DrainPollers()
+	}
+}
+
+func (e *matchingEngineImpl) isDraining() bool {
+	return atomic.LoadInt32(&e.draining) == 1
+}
+
+func (e *matchingEngineImpl) HostHealth() *types.MatchingHostHealth {
+	stats := e.health.persistenceWindow()
+	health := &types.MatchingHostHealth{
+		LeaseFailures:        e.health.leaseFailureCount(),
+		PersistenceRequests:  stats.requests,
+		PersistenceErrors:    stats.errors,
+		PersistenceErrorRate: stats.errorRate(),
+		Draining:             e.isDraining(),
+	}
+
+	e.taskListsLock.RLock()
+	defer e.taskListsLock.RUnlock()
+	health.LoadedTaskLists = len(e.taskLists)
+	for id, tlMgr := range e.taskLists {
+		lastErr := tlMgr.LastPersistenceError()
+		lease := tlMgr.LeaseStats()
+		if lastErr == "" && lease.leaseConflicts == 0 {
+			continue
+		}
+		tlHealth := &types.MatchingTaskListHealth{
+			DomainID:       id.domainID,
+			Name:           id.name,
+			TaskListType:   types.TaskListType(id.taskType),
+			LastError:      lastErr,
+			RangeID:        lease.rangeID,
+			LeaseRenewals:  lease.leaseRenewals,
+			LeaseConflicts: lease.leaseConflicts,
+		}
+		if lastErr != "" {
+			health.NotReadyTaskLists = append(health.NotReadyTaskLists, tlHealth)
+		}
+		// another host took over the lease at some point, which can point to a host that still holds it without serving
+		if lease.leaseConflicts > 0 {
+			health.ContestedTaskLists = append(health.ContestedTaskLists, tlHealth)
+		}
+	}
+	return health
+}
+
 func (e *matchingEngineImpl) Stop() {
 	// Executes Stop() on each task list outside of lock
 	for _, l := range e.getTaskLists(math.MaxInt32) {
@@ -194,6 +284,11 @@ func (e *matchingEngineImpl) getTaskListManager(
 		tag.WorkflowDomainID(taskList.domainID),
 	)
 
+	if err := e.checkTaskListQuotaLocked(taskList, taskListKind); err != nil {
+		e.taskListsLock.Unlock()
+		return nil, err
+	}
+
 	logger.Info("Task list manager state changed", tag.LifeCycleStarting)
 	mgr, err := newTaskListManager(e, taskList, taskListKind, e.config)
 	if err != nil {
@@ -202,7 +297,7 @@ func (e *matchingEngineImpl) getTaskListManager(
 		return nil, err
 	}
 
-	e.taskLists[*taskList] = mgr
+	e.addTaskListLocked(taskList, mgr)
 	e.metricsClient.Scope(metrics.MatchingTaskListMgrScope).UpdateGauge(
 		metrics.TaskListManagersGauge,
 		float64(len(e.taskLists)),
@@ -237,18 +332,153 @@ func (e *matchingEngineImpl) getTaskListByDomainLocked(
 	}
 }
 
+// getDomainDispatchShare returns the share of the poller provided dispatch rate that the given
+// task list is allowed to use. The weighting assumes that task lists with the same name in
+// different domains are polled by the same workers. When domains are given dispatch weights,
+// the rate is split proportionally to the weights of the domains whose task list of that name
+// has demand, so a domain gets the whole rate while the others are idle. Domains with a weight
+// of 0 are not weighted, negative weights are invalid and treated the same way.
+func (e *matchingEngineImpl) getDomainDispatchShare(taskList *taskListID) float64 {
+	domainName, err := e.domainCache.GetDomainName(taskList.domainID)
+	if err != nil {
+		return 1
+	}
+	weight := e.getDomainDispatchWeight(domainName)
+	if weight == 0 {
+		return 1
+	}
+
+	totalWeight := weight
+	for _, domainID := range e.getDispatchGroupDomains(taskList) {
+		name, err := e.domainCache.GetDomainName(domainID)
+		if err != nil {
+			continue
+		}
+		totalWeight += e.getDomainDispatchWeight(name)
+	}
+
+	if totalWeight <= weight {
+		return 1
+	}
+	return float64(weight) / float64(totalWeight)
+}
+
+// getDomainDispatchWeight returns the dispatch weight of the domain, or 0 when it is not weighted
+func (e *matchingEngineImpl) getDomainDispatchWeight(domainName string) int {
+	weight := e.config.DomainDispatchWeight(domainName)
+	if weight < 0 {
+		e.logger.Warn("Ignoring negative domain dispatch weight",
+			tag.WorkflowDomainName(domainName), tag.Number(int64(weight)))
+		return 0
+	}
+	return weight
+}
+
+// getDispatchGroupDomains returns the IDs of the other domains with a loaded task list of the same
+// name and type that has tasks to dispatch or was added tasks within the dispatch demand window
+func (e *matchingEngineImpl) getDispatchGroupDomains(taskList *taskListID) []string {
+	since := time.Now().Add(-dispatchDemandWindow)
+	e.taskListsLock.RLock()
+	defer e.taskListsLock.RUnlock()
+	group := e.dispatchGroups[dispatchGroupKey{name: taskList.name, taskType: taskList.taskType}]
+	domainIDs := make([]string, 0, len(group))
+	for id, mgr := range group {
+		if id.domainID != taskList.domainID && mgr != nil && mgr.HasDispatchDemand(since) {
+			domainIDs = append(domainIDs, id.domainID)
+		}
+	}
+	return domainIDs
+}
+
+// checkTaskListQuotaLocked returns a LimitExceededError if loading the task list would take
+// its domain over the number of distinct task lists a matching host loads for it.
+// Sticky task lists and further partitions or task types of a loaded task list are not limited.
+func (e *matchingEngineImpl) checkTaskListQuotaLocked(
+	taskList *taskListID,
+	taskListKind *types.TaskListKind,
+) error {
+	if taskListKind != nil && *taskListKind == types.TaskListKindSticky {
+		return nil
+	}
+	names := e.domainTaskLists[taskList.domainID]
+	if _, ok := names[taskList.baseName]; ok {
+		return nil
+	}
+	domainName, err := e.domainCache.GetDomainName(taskList.domainID)
+	if err != nil {
+		return nil
+	}
+	limit := e.config.MaxTaskListsPerDomain(domainName)
+	if limit <= 0 || len(names) < limit {
+		return nil
+	}
+	e.metricsClient.Scope(metrics.MatchingTaskListMgrScope, metrics.DomainTag(domainName)).
+		IncCounter(metrics.TaskListsPerDomainLimitExceededCounter)
+	return &types.LimitExceededError{
+		Message: fmt.Sprintf("domain %v exceeded the limit of %v task lists", domainName, limit),
+	}
+}
+
+func isStickyTaskList(mgr taskListManager) bool {
+	return mgr != nil && mgr.GetTaskListKind() == types.TaskListKindSticky
+}
+
+func (e *matchingEngineImpl) addTaskListLocked(taskList *taskListID, mgr taskListManager) {
+	key := dispatchGroupKey{name: taskList.name, taskType: taskList.taskType}
+	if e.dispatchGroups[key] == nil {
+		e.dispatchGroups[key] = make(map[taskListID]taskListManager)
+	}
+	e.dispatchGroups[key][*taskList] = mgr
+	if _, ok := e.taskLists[*taskList]; !ok {
+		if !isStickyTaskList(mgr) {
+			if e.domainTaskLists[taskList.domainID] == nil {
+				e.domainTaskLists[taskList.domainID] = make(map[string]int)
+			}
+			e.domainTaskLists[taskList.domainID][taskList.baseName]++
+		}
+	}
+	e.taskLists[*taskList] = mgr
+}
+
+func (e *matchingEngineImpl) deleteTaskListLocked(taskList *taskListID) {
+	mgr, ok := e.taskLists[*taskList]
+	if !ok {
+		return
+	}
+	delete(e.taskLists, *taskList)
+	if names, ok := e.domainTaskLists[taskList.domainID]; ok && !isStickyTaskList(mgr) {
+		if names[taskList.baseName]--; names[taskList.baseName] <= 0 {
+			delete(names, taskList.baseName)
+		}
+		if len(names) == 0 {
+			delete(e.domainTaskLists, taskList.domainID)
+		}
+	}
+	key := dispatchGroupKey{name: taskList.name, taskType: taskList.taskType}
+	delete(e.dispatchGroups[key], *taskList)
+	if len(e.dispatchGroups[key]) == 0 {
+		delete(e.dispatchGroups, key)
+	}
+}
+
 // For use in tests
 func (e *matchingEngineImpl) updateTaskList(taskList *taskListID, mgr taskListManager) {
 	e.taskListsLock.Lock()
 	defer e.taskListsLock.Unlock()
-	e.taskLists[*taskList] = mgr
+	e.addTaskListLocked(taskList, mgr)
+}
+
+// cacheUnloadedTaskList keeps the stats of a task list being unloaded, so describing it read only can
+// still report its pollers and match stats
+func (e *matchingEngineImpl) cacheUnloadedTaskList(id *taskListID, description *types.DescribeTaskListResponse) {
+	e.unloadedTaskLists.Put(*id, description)
 }
 
 func (e *matchingEngineImpl) removeTaskListManager(id *taskListID) {
 	e.taskListsLock.Lock()
 	defer e.taskListsLock.Unlock()
 
-	delete(e.taskLists, *id)
+	e.deleteTaskListLocked(id)
 	e.metricsClient.Scope(metrics.MatchingTaskListMgrScope).UpdateGauge(
 		metrics.TaskListManagersGauge,
 		float64(len(e.taskLists)),
@@ -294,12 +524,16 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleID:             request.GetScheduleID(),
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		AffinityKey:            request.GetAffinityKey(),
 	}
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
 		execution:     request.Execution,
 		taskInfo:      taskInfo,
 		source:        request.GetSource(),
 		forwardedFrom: request.GetForwardedFrom(),
+		ephemeral:     request.GetEphemeral(),
+		attempt:       request.GetAttempt(),
+		shadow:        newShadowTaskInfo(request.GetShadowSourceTaskList(), request.GetShadowMetadataOnly(), request.GetAttempt()),
 	})
 }
 
@@ -342,12 +576,16 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleID:             request.GetScheduleID(),
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		AffinityKey:            request.GetAffinityKey(),
 	}
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
 		execution:     request.Execution,
 		taskInfo:      taskInfo,
 		source:        request.GetSource(),
 		forwardedFrom: request.GetForwardedFrom(),
+		ephemeral:     request.GetEphemeral(),
+		attempt:       request.GetAttempt(),
+		shadow:        newShadowTaskInfo(request.GetShadowSourceTaskList(), request.GetShadowMetadataOnly(), request.GetAttempt()),
 	})
 }
 
@@ -396,8 +634,14 @@ pollLoop:
 			return task.pollForDecisionResponse(), nil
 		}
 
+		if task.isShadowMetadataOnly() {
+			// mirrored copies in metadata mode are never started from the shadow tasklist
+			task.finish(hCtx.Context, nil)
+			return task.shadowPollForDecisionResponse(), nil
+		}
+
 		if task.isQuery() {
-			task.finish(nil) // this only means query task sync match succeed.
+			task.finish(hCtx.Context, nil) // this only means query task sync match succeed.
 
 			// for query task, we don't need to update history to record decision task started. but we need to know
 			// the NextEventID so front end knows what are the history events to load for this decision task.
@@ -428,6 +672,7 @@ pollLoop:
 		}
 
 		resp, err := e.recordDecisionTaskStarted(hCtx.Context, request, task)
+		e.dispatchHooks.DispatchCompleted(taskListName, task.event.TaskInfo, err)
 		if err != nil {
 			switch err.(type) {
 			case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError, *types.EventAlreadyStartedError:
@@ -441,14 +686,14 @@ pollLoop:
 					tag.WorkflowScheduleID(task.event.ScheduleID),
 					tag.TaskID(task.event.TaskID),
 				)
-				task.finish(nil)
+				task.finish(hCtx.Context, nil)
 			default:
-				task.finish(err)
+				task.finish(hCtx.Context, err)
 			}
 
 			continue pollLoop
 		}
-		task.finish(nil)
+		task.finish(hCtx.Context, nil)
 		return e.createPollForDecisionTaskResponse(task, resp, hCtx.scope), nil
 	}
 }
@@ -506,7 +751,14 @@ pollLoop:
 			return task.pollForActivityResponse(), nil
 		}
 
+		if task.isShadowMetadataOnly() {
+			// mirrored copies in metadata mode are never started from the shadow tasklist
+			task.finish(hCtx.Context, nil)
+			return task.shadowPollForActivityResponse(), nil
+		}
+
 		resp, err := e.recordActivityTaskStarted(hCtx.Context, request, task)
+		e.dispatchHooks.DispatchCompleted(taskListName, task.event.TaskInfo, err)
 		if err != nil {
 			switch err.(type) {
 			case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError, *types.EventAlreadyStartedError:
@@ -520,14 +772,14 @@ pollLoop:
 					tag.WorkflowScheduleID(task.event.ScheduleID),
 					tag.TaskID(task.event.TaskID),
 				)
-				task.finish(nil)
+				task.finish(hCtx.Context, nil)
 			default:
-				task.finish(err)
+				task.finish(hCtx.Context, err)
 			}
 
 			continue pollLoop
 		}
-		task.finish(nil)
+		task.finish(hCtx.Context, nil)
 		return e.createPollForActivityTaskResponse(task, resp, hCtx.scope), nil
 	}
 }
@@ -652,12 +904,98 @@ func (e *matchingEngineImpl) DescribeTaskList(
 		return nil, err
 	}
 
+	includeTaskListStatus := request.DescRequest.GetIncludeTaskListStatus()
+	if e.isReadOnlyDescribeTaskList(hCtx, taskList) {
+		e.taskListsLock.RLock()
+		tlMgr, ok := e.taskLists[*taskList]
+		e.taskListsLock.RUnlock()
+		if !ok {
+			return e.describeUnloadedTaskList(hCtx, taskList, includeTaskListStatus)
+		}
+		return tlMgr.DescribeTaskList(includeTaskListStatus), nil
+	}
+
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
+	return tlMgr.DescribeTaskList(includeTaskListStatus), nil
+}
+
+// DescribeTaskListConfig returns the configuration this host applies to the task list. It is
+// resolved from the config of the host, so it includes the host overrides, and does not load the task list.
+func (e *matchingEngineImpl) DescribeTaskListConfig(
+	hCtx *handlerContext,
+	request *types.MatchingDescribeTaskListConfigRequest,
+) (*types.DescribeTaskListConfigResponse, error) {
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskList, err := newTaskListID(request.GetDomainUUID(), request.GetTaskList().GetName(), taskListType)
+	if err != nil {
+		return nil, err
+	}
+	domainName, err := e.domainCache.GetDomainName(taskList.domainID)
+	if err != nil {
+		return nil, err
+	}
+	return describeTaskListConfig(e.config, domainName, taskList.name, taskList.taskType), nil
+}
+
+// isReadOnlyDescribeTaskList returns true if describing the task list must not load it
+func (e *matchingEngineImpl) isReadOnlyDescribeTaskList(ctx context.Context, taskList *taskListID) bool {
+	if call := yarpc.CallFromContext(ctx); call != nil && call.Header(loadTaskListHeaderName) == "true" {
+		return false
+	}
+	domainName, _ := e.domainCache.GetDomainName(taskList.domainID)
+	return e.config.EnableReadOnlyDescribeTaskList(domainName, taskList.name, taskList.taskType)
+}
+
+// describeUnloadedTaskList describes a task list this host has not loaded from its persisted metadata, and the
+// pollers and stats cached when it was last unloaded, without leasing it. A task list which does not exist has
+// no pollers and no tasks
+func (e *matchingEngineImpl) describeUnloadedTaskList(
+	ctx context.Context,
+	taskList *taskListID,
+	includeTaskListStatus bool,
+) (*types.DescribeTaskListResponse, error) {
+	response := &types.DescribeTaskListResponse{}
+	cached, _ := e.unloadedTaskLists.Get(*taskList).(*types.DescribeTaskListResponse)
+	if cached != nil {
+		response.Pollers = cached.Pollers
+	}
+	if !includeTaskListStatus {
+		return response, nil
+	}
+
+	response.TaskListStatus = &types.TaskListStatus{TaskIDBlock: &types.TaskIDBlock{}}
+	resp, err := e.taskManager.GetTaskList(ctx, &persistence.GetTaskListRequest{
+		DomainID: taskList.domainID,
+		TaskList: taskList.name,
+		TaskType: taskList.taskType,
+	})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			return response, nil
+		}
+		return nil, err
+	}
+	info := resp.TaskListInfo
+	// the tasks above the ack level are only read once the task list is loaded
+	response.TaskListStatus.ReadLevel = info.AckLevel
+	response.TaskListStatus.AckLevel = info.AckLevel
+	response.TaskListStatus.TaskIDBlock = &types.TaskIDBlock{
+		StartID: (info.RangeID-1)*e.config.RangeSize + 1,
+		EndID:   info.RangeID * e.config.RangeSize,
+	}
+	if status := cached.GetTaskListStatus(); status != nil {
+		response.TaskListStatus.BacklogCountHint = status.BacklogCountHint
+		response.TaskListStatus.RatePerSecond = status.RatePerSecond
+		response.TaskListStatus.MatchStats = status.MatchStats
+	}
+	return response, nil
 }
 
 func (e *matchingEngineImpl) ListTaskListPartitions(
@@ -772,7 +1110,7 @@ func (e *matchingEngineImpl) unloadTaskList(id *taskListID) {
 	e.taskListsLock.Lock()
 	tlMgr, ok := e.taskLists[*id]
 	if ok {
-		delete(e.taskLists, *id)
+		e.deleteTaskListLocked(id)
 	}
 	e.taskListsLock.Unlock()
 	if ok {
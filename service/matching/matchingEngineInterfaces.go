@@ -26,6 +26,11 @@ type (
 	// Engine exposes interfaces for clients to poll for activity and decision tasks.
 	Engine interface {
 		Stop()
+		// DrainPollers completes outstanding polls and makes new polls return immediately,
+		// it is called on shutdown once the host is evicted from the membership ring
+		DrainPollers()
+		// HostHealth reports the health of the host, both for load balancers and operators
+		HostHealth() *types.MatchingHostHealth
 		AddDecisionTask(hCtx *handlerContext, request *types.AddDecisionTaskRequest) (syncMatch bool, err error)
 		AddActivityTask(hCtx *handlerContext, request *types.AddActivityTaskRequest) (syncMatch bool, err error)
 		PollForDecisionTask(hCtx *handlerContext, request *types.MatchingPollForDecisionTaskRequest) (*types.MatchingPollForDecisionTaskResponse, error)
@@ -36,5 +41,6 @@ type (
 		DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(hCtx *handlerContext, request *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		DescribeTaskListConfig(hCtx *handlerContext, request *types.MatchingDescribeTaskListConfigRequest) (*types.DescribeTaskListConfigResponse, error)
 	}
 )
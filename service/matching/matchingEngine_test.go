@@ -140,14 +140,18 @@ func newMatchingEngine(
 	logger log.Logger, mockDomainCache cache.DomainCache,
 ) *matchingEngineImpl {
 	return &matchingEngineImpl{
-		taskManager:     taskMgr,
-		historyService:  mockHistoryClient,
-		taskLists:       make(map[taskListID]taskListManager),
-		logger:          logger,
-		metricsClient:   metrics.NewClient(tally.NoopScope, metrics.Matching),
-		tokenSerializer: common.NewJSONTaskTokenSerializer(),
-		config:          config,
-		domainCache:     mockDomainCache,
+		taskManager:       taskMgr,
+		historyService:    mockHistoryClient,
+		taskLists:         make(map[taskListID]taskListManager),
+		dispatchGroups:    make(map[dispatchGroupKey]map[taskListID]taskListManager),
+		domainTaskLists:   make(map[string]map[string]int),
+		logger:            logger,
+		metricsClient:     metrics.NewClient(tally.NoopScope, metrics.Matching),
+		tokenSerializer:   common.NewJSONTaskTokenSerializer(),
+		config:            config,
+		domainCache:       mockDomainCache,
+		dispatchHooks:     NewNoopDispatchHooks(),
+		unloadedTaskLists: newUnloadedTaskListCache(),
 	}
 }
 
@@ -173,6 +177,162 @@ func (s *matchingEngineSuite) TestPollForDecisionTasksEmptyResultWithShortContex
 	s.PollForTasksEmptyResultTest(callContext, persistence.TaskListTypeDecision)
 }
 
+func (s *matchingEngineSuite) TestDrainPollers() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute)
+
+	domainID := "domainId"
+	tl := "makeToast"
+	taskList := &types.TaskList{Name: tl}
+	tlID := newTestTaskListID(domainID, tl, persistence.TaskListTypeDecision)
+	tlMgr, err := s.matchingEngine.getTaskListManager(tlID, nil)
+	s.NoError(err)
+	tlMgrImpl := tlMgr.(*taskListManagerImpl)
+
+	poll := func() (*types.MatchingPollForDecisionTaskResponse, error) {
+		return s.matchingEngine.PollForDecisionTask(s.handlerContext, &types.MatchingPollForDecisionTaskRequest{
+			DomainUUID: domainID,
+			PollerID:   uuid.New(),
+			PollRequest: &types.PollForDecisionTaskRequest{
+				TaskList: taskList,
+				Identity: "selfDrivingToaster",
+			},
+		})
+	}
+
+	respC := make(chan *types.MatchingPollForDecisionTaskResponse, 1)
+	go func() {
+		resp, err := poll()
+		s.NoError(err)
+		respC <- resp
+	}()
+	s.Eventually(func() bool {
+		tlMgrImpl.outstandingPollsLock.Lock()
+		defer tlMgrImpl.outstandingPollsLock.Unlock()
+		return len(tlMgrImpl.outstandingPollsMap) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	s.matchingEngine.DrainPollers()
+	select {
+	case resp := <-respC:
+		s.Equal(emptyPollForDecisionTaskResponse, resp)
+	case <-time.After(time.Second):
+		s.FailNow("outstanding poll was not drained")
+	}
+
+	// new polls fail right away with a retryable error once draining started,
+	// so that pollers back off instead of hot looping on this host
+	start := time.Now()
+	_, err = poll()
+	s.Equal(errHostDraining, err)
+	s.True(common.IsServiceTransientError(err))
+	s.True(time.Since(start) < time.Second)
+}
+
+func (s *matchingEngineSuite) TestDescribeTaskListOutstandingPollCount() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(500 * time.Millisecond)
+
+	domainID := "domainId"
+	taskList := &types.TaskList{Name: "makeToast"}
+	taskListType := types.TaskListTypeActivity
+	describe := func() *types.TaskListStatus {
+		resp, err := s.matchingEngine.DescribeTaskList(s.handlerContext, &types.MatchingDescribeTaskListRequest{
+			DomainUUID: domainID,
+			DescRequest: &types.DescribeTaskListRequest{
+				TaskList:              taskList,
+				TaskListType:          &taskListType,
+				IncludeTaskListStatus: true,
+			},
+		})
+		s.NoError(err)
+		return resp.GetTaskListStatus()
+	}
+
+	pollDone := make(chan struct{})
+	go func() {
+		defer close(pollDone)
+		_, err := s.matchingEngine.PollForActivityTask(s.handlerContext, &types.MatchingPollForActivityTaskRequest{
+			DomainUUID: domainID,
+			PollRequest: &types.PollForActivityTaskRequest{
+				TaskList: taskList,
+				Identity: "selfDrivingToaster",
+			},
+		})
+		s.NoError(err)
+	}()
+	s.Eventually(func() bool {
+		return describe().GetOutstandingPollCount() == 1
+	}, time.Second, 10*time.Millisecond)
+
+	<-pollDone
+	s.Zero(describe().GetOutstandingPollCount())
+}
+
+func (s *matchingEngineSuite) TestDescribeTaskListReadOnly() {
+	s.matchingEngine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	s.matchingEngine.config.RangeSize = 10
+
+	domainID := "domainId"
+	taskList := &types.TaskList{Name: "makeToast"}
+	taskListType := types.TaskListTypeActivity
+	tlID := newTestTaskListID(domainID, taskList.GetName(), persistence.TaskListTypeActivity)
+	describe := func() *types.DescribeTaskListResponse {
+		resp, err := s.matchingEngine.DescribeTaskList(s.handlerContext, &types.MatchingDescribeTaskListRequest{
+			DomainUUID: domainID,
+			DescRequest: &types.DescribeTaskListRequest{
+				TaskList:              taskList,
+				TaskListType:          &taskListType,
+				IncludeTaskListStatus: true,
+			},
+		})
+		s.NoError(err)
+		return resp
+	}
+
+	_, err := s.matchingEngine.PollForActivityTask(s.handlerContext, &types.MatchingPollForActivityTaskRequest{
+		DomainUUID: domainID,
+		PollRequest: &types.PollForActivityTaskRequest{
+			TaskList: taskList,
+			Identity: "selfDrivingToaster",
+		},
+	})
+	s.NoError(err)
+	s.matchingEngine.unloadTaskList(tlID)
+
+	s.matchingEngine.config.EnableReadOnlyDescribeTaskList = func(domain string, taskList string, taskType int) bool { return true }
+	resp := describe()
+	s.Empty(s.matchingEngine.getTaskLists(100), "describing must not load the task list")
+	s.Len(resp.GetPollers(), 1)
+	s.Equal("selfDrivingToaster", resp.GetPollers()[0].GetIdentity())
+	s.Equal(&types.TaskIDBlock{StartID: 1, EndID: 10}, resp.GetTaskListStatus().GetTaskIDBlock())
+	s.Zero(resp.GetTaskListStatus().GetOutstandingPollCount())
+
+	// a task list which is loaded is described by its manager
+	s.matchingEngine.config.EnableReadOnlyDescribeTaskList = func(domain string, taskList string, taskType int) bool { return false }
+	describe()
+	s.Len(s.matchingEngine.getTaskLists(100), 1)
+	s.matchingEngine.config.EnableReadOnlyDescribeTaskList = func(domain string, taskList string, taskType int) bool { return true }
+	s.Equal(int64(11), describe().GetTaskListStatus().GetTaskIDBlock().GetStartID())
+}
+
+func (s *matchingEngineSuite) TestDescribeTaskListConfig() {
+	s.matchingEngine.config.NumTasklistReadPartitions = func(domain string, taskList string, taskType int) int {
+		if domain == matchingTestDomainName && taskList == "makeToast" && taskType == persistence.TaskListTypeActivity {
+			return 3
+		}
+		return 1
+	}
+
+	resp, err := s.matchingEngine.DescribeTaskListConfig(s.handlerContext, &types.MatchingDescribeTaskListConfigRequest{
+		DomainUUID:   "domainId",
+		TaskList:     &types.TaskList{Name: "makeToast"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.NoError(err)
+	s.Equal(int32(3), resp.NumReadPartitions)
+	s.Equal(s.matchingEngine.config.RangeSize, resp.RangeSize)
+	s.Empty(s.matchingEngine.getTaskLists(100), "describing the config must not load the task list")
+}
+
 func (s *matchingEngineSuite) TestPollForDecisionTasks() {
 	s.PollForDecisionTasksResultTest()
 }
@@ -1371,7 +1531,7 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	ctx, err := s.matchingEngine.getTask(context.Background(), tlID, nil, &tlKind)
 	s.NoError(err)
 
-	ctx.finish(errors.New("test error"))
+	ctx.finish(context.Background(), errors.New("test error"))
 	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
 	ctx2, err := s.matchingEngine.getTask(context.Background(), tlID, nil, &tlKind)
 	s.NoError(err)
@@ -1381,10 +1541,85 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.Equal(ctx.event.RunID, ctx2.event.RunID)
 	s.Equal(ctx.event.ScheduleID, ctx2.event.ScheduleID)
 
-	ctx2.finish(nil)
+	ctx2.finish(context.Background(), nil)
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestGetDomainDispatchShare() {
+	tl := "makeToast"
+	tlID := newTestTaskListID("domainId", tl, persistence.TaskListTypeActivity)
+	otherTlID := newTestTaskListID("otherDomainId", tl, persistence.TaskListTypeActivity)
+	s.matchingEngine.updateTaskList(tlID, &dispatchDemandTaskListManager{demand: true})
+	s.matchingEngine.updateTaskList(otherTlID, &dispatchDemandTaskListManager{demand: true})
+	defer func() {
+		s.matchingEngine.removeTaskListManager(tlID)
+		s.matchingEngine.removeTaskListManager(otherTlID)
+	}()
+
+	// weighted dispatch is disabled by default
+	s.Equal(float64(1), s.matchingEngine.getDomainDispatchShare(tlID))
+
+	s.matchingEngine.config.DomainDispatchWeight = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+	s.Equal(0.5, s.matchingEngine.getDomainDispatchShare(tlID))
+
+	decisionTlID := newTestTaskListID("domainId", tl, persistence.TaskListTypeDecision)
+	s.Equal(float64(1), s.matchingEngine.getDomainDispatchShare(decisionTlID))
+
+	// negative weights are ignored
+	s.matchingEngine.config.DomainDispatchWeight = dynamicconfig.GetIntPropertyFilteredByDomain(-1)
+	s.Equal(float64(1), s.matchingEngine.getDomainDispatchShare(tlID))
+	s.matchingEngine.config.DomainDispatchWeight = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+
+	s.matchingEngine.removeTaskListManager(otherTlID)
+	s.Equal(float64(1), s.matchingEngine.getDomainDispatchShare(tlID))
+	s.matchingEngine.removeTaskListManager(tlID)
+	s.Empty(s.matchingEngine.dispatchGroups)
+}
+
+func (s *matchingEngineSuite) TestGetDomainDispatchShare_OnlyActiveDomainGetsFullRate() {
+	tl := "makeToast"
+	tlID := newTestTaskListID("domainId", tl, persistence.TaskListTypeActivity)
+	otherTlID := newTestTaskListID("otherDomainId", tl, persistence.TaskListTypeActivity)
+	otherMgr := &dispatchDemandTaskListManager{}
+	s.matchingEngine.updateTaskList(tlID, &dispatchDemandTaskListManager{demand: true})
+	s.matchingEngine.updateTaskList(otherTlID, otherMgr)
+	defer func() {
+		s.matchingEngine.removeTaskListManager(tlID)
+		s.matchingEngine.removeTaskListManager(otherTlID)
+	}()
+	s.matchingEngine.config.DomainDispatchWeight = dynamicconfig.GetIntPropertyFilteredByDomain(2)
+
+	// the idle task list of the other domain does not take a share of the rate
+	s.Equal(float64(1), s.matchingEngine.getDomainDispatchShare(tlID))
+
+	otherMgr.demand = true
+	s.Equal(0.5, s.matchingEngine.getDomainDispatchShare(tlID))
+}
+
+func (s *matchingEngineSuite) TestMaxTaskListsPerDomain() {
+	tlID := newTestTaskListID("domainId", "makeToast", persistence.TaskListTypeActivity)
+	s.matchingEngine.updateTaskList(tlID, nil)
+	defer s.matchingEngine.removeTaskListManager(tlID)
+
+	otherTlID := newTestTaskListID("domainId", "makeCoffee", persistence.TaskListTypeActivity)
+	s.NoError(s.matchingEngine.checkTaskListQuotaLocked(otherTlID, nil))
+
+	s.matchingEngine.config.MaxTaskListsPerDomain = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+	_, err := s.matchingEngine.getTaskListManager(otherTlID, nil)
+	s.IsType(&types.LimitExceededError{}, err)
+
+	// other task types and partitions of a loaded task list, sticky task lists and other domains are not limited
+	s.NoError(s.matchingEngine.checkTaskListQuotaLocked(newTestTaskListID("domainId", "makeToast", persistence.TaskListTypeDecision), nil))
+	s.NoError(s.matchingEngine.checkTaskListQuotaLocked(newTestTaskListID("domainId", "/__cadence_sys/makeToast/1", persistence.TaskListTypeActivity), nil))
+	stickyTlKind := types.TaskListKindSticky
+	s.NoError(s.matchingEngine.checkTaskListQuotaLocked(otherTlID, &stickyTlKind))
+	s.NoError(s.matchingEngine.checkTaskListQuotaLocked(newTestTaskListID("otherDomainId", "makeCoffee", persistence.TaskListTypeActivity), nil))
+
+	s.matchingEngine.removeTaskListManager(tlID)
+	s.NoError(s.matchingEngine.checkTaskListQuotaLocked(otherTlID, nil))
+	s.Empty(s.matchingEngine.domainTaskLists)
+}
+
 func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 	runID := "run1"
 	workflowID := "workflow1"
@@ -1662,6 +1897,20 @@ func newHistoryEvent(eventID int64, eventType types.EventType) *types.HistoryEve
 	return historyEvent
 }
 
+// dispatchDemandTaskListManager is a task list manager stub that only reports its dispatch demand
+type dispatchDemandTaskListManager struct {
+	taskListManager
+	demand bool
+}
+
+func (m *dispatchDemandTaskListManager) GetTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (m *dispatchDemandTaskListManager) HasDispatchDemand(since time.Time) bool {
+	return m.demand
+}
+
 var _ persistence.TaskManager = (*testTaskManager)(nil) // Asserts that interface is indeed implemented
 
 type testTaskManager struct {
@@ -1699,6 +1948,7 @@ type testTaskListManager struct {
 	ackLevel        int64
 	createTaskCount int
 	tasks           *treemap.Map
+	tags            map[string]string
 }
 
 func Int64Comparator(a, b interface{}) int {
@@ -1918,6 +2168,35 @@ func (m *testTaskManager) GetOrphanTasks(_ context.Context, request *persistence
 	return &persistence.GetOrphanTasksResponse{}, nil
 }
 
+func (m *testTaskManager) GetTaskListUsage(_ context.Context, request *persistence.GetTaskListUsageRequest) (*persistence.GetTaskListUsageResponse, error) {
+	count := m.getTaskCount(newTestTaskListID(request.DomainID, request.TaskListName, request.TaskListType))
+	return &persistence.GetTaskListUsageResponse{TaskCount: int64(count)}, nil
+}
+
+func (m *testTaskManager) GetTaskList(_ context.Context, request *persistence.GetTaskListRequest) (*persistence.GetTaskListResponse, error) {
+	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm.Lock()
+	defer tlm.Unlock()
+	return &persistence.GetTaskListResponse{
+		TaskListInfo: &persistence.TaskListInfo{
+			AckLevel: tlm.ackLevel,
+			DomainID: request.DomainID,
+			Name:     request.TaskList,
+			TaskType: request.TaskType,
+			RangeID:  tlm.rangeID,
+			Tags:     tlm.tags,
+		},
+	}, nil
+}
+
+func (m *testTaskManager) UpdateTaskListTags(_ context.Context, request *persistence.UpdateTaskListTagsRequest) error {
+	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm.Lock()
+	defer tlm.Unlock()
+	tlm.tags = request.Tags
+	return nil
+}
+
 // getTaskCount returns number of tasks in a task list
 func (m *testTaskManager) getTaskCount(taskList *taskListID) int {
 	tlm := m.getTaskListManager(taskList)
@@ -0,0 +1,252 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// When the number of partitions of a task list is reduced, the partitions at or above the new count, called
+// leftover partitions, no longer receive tasks nor polls and their backlog would be stranded. The root partition
+// periodically looks for leftover partitions with backlog and loads them on their owner host. A loaded leftover
+// partition adds every task of its backlog again to the task list, which places it on an active partition, and
+// unloads itself once its backlog is gone.
+
+const (
+	// partitionDrainRetryInterval is how long to wait before moving again a task that failed to be moved
+	partitionDrainRetryInterval = time.Second
+	// partitionDrainCallTimeout bounds the persistence and matching calls made to check a leftover partition
+	partitionDrainCallTimeout = 10 * time.Second
+	// maxMissingLeftoverPartitions is the number of consecutive leftover partitions that may not exist,
+	// e.g. as they were scavenged, before the root partition stops looking for more leftover partitions
+	maxMissingLeftoverPartitions = 3
+)
+
+// isDecommissioned returns true if this partition is a leftover partition whose backlog is moved to the
+// active partitions of the task list
+func (c *taskListManagerImpl) isDecommissioned() bool {
+	if c.taskListID.IsRoot() || c.taskListKind == types.TaskListKindSticky || !c.config.EnablePartitionDrain() {
+		return false
+	}
+	return c.taskListID.partition >= numActivePartitions(c.config)
+}
+
+// numActivePartitions returns the number of partitions which receive either tasks or polls
+func numActivePartitions(config *taskListConfig) int {
+	return common.MaxInt(config.NumReadPartitions(), config.NumWritePartitions())
+}
+
+// drainTask moves a backlog task of a decommissioned partition to an active partition. It retries until the
+// task is moved or ctx is done, and dispatches the task as usual if the partition becomes active again
+func (c *taskListManagerImpl) drainTask(ctx context.Context, task *InternalTask) error {
+	for {
+		err := c.addTaskToActivePartition(ctx, task)
+		if err == nil {
+			c.metricScope().IncCounter(metrics.DrainedTasksPerTaskListCounter)
+			task.finish(ctx, nil)
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		c.metricScope().IncCounter(metrics.DrainTaskErrorsPerTaskListCounter)
+		c.logger.Warn("Failed to move task of decommissioned task list partition", tag.TaskID(task.event.TaskID), tag.Error(err))
+
+		timer := time.NewTimer(partitionDrainRetryInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		if !c.isDecommissioned() {
+			return c.matcher.MustOffer(ctx, task)
+		}
+	}
+}
+
+// addTaskToActivePartition adds the task again to the task list through the root partition name so that the
+// matching client picks one of the active write partitions. The task keeps its schedule to start deadline
+func (c *taskListManagerImpl) addTaskToActivePartition(ctx context.Context, task *InternalTask) error {
+	info := task.event.TaskInfo
+	kind := c.taskListKind
+	taskList := &types.TaskList{
+		Name: c.taskListID.GetRoot(),
+		Kind: &kind,
+	}
+	timeout := remainingScheduleToStartTimeout(info, time.Now())
+	opts := c.engine.dispatchHooks.ForwardOptions(info)
+
+	switch c.taskListID.taskType {
+	case persistence.TaskListTypeDecision:
+		return c.engine.matchingClient.AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
+			DomainUUID:                    info.DomainID,
+			Execution:                     task.workflowExecution(),
+			TaskList:                      taskList,
+			ScheduleID:                    info.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &timeout,
+			Source:                        &task.source,
+			AffinityKey:                   info.AffinityKey,
+		}, opts...)
+	case persistence.TaskListTypeActivity:
+		return c.engine.matchingClient.AddActivityTask(ctx, &types.AddActivityTaskRequest{
+			DomainUUID:                    c.taskListID.domainID,
+			SourceDomainUUID:              info.DomainID,
+			Execution:                     task.workflowExecution(),
+			TaskList:                      taskList,
+			ScheduleID:                    info.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &timeout,
+			Source:                        &task.source,
+			AffinityKey:                   info.AffinityKey,
+		}, opts...)
+	default:
+		return errInvalidTaskListType
+	}
+}
+
+// remainingScheduleToStartTimeout returns the schedule to start timeout of a task that is moved at now,
+// so that it expires at the same time on the partition it is moved to
+func remainingScheduleToStartTimeout(info *persistence.TaskInfo, now time.Time) int32 {
+	if !hasDeadline(info) {
+		return info.ScheduleToStartTimeout
+	}
+	remaining := int64(info.Expiry.Sub(now) / time.Second)
+	return int32(common.MaxInt64(1, remaining))
+}
+
+// partitionDrainPump periodically loads the leftover partitions of the task list which still have backlog
+func (c *taskListManagerImpl) partitionDrainPump() {
+	// leftover partitions with backlog, tracked to report when their backlog is gone
+	draining := make(map[int]struct{})
+	for {
+		timer := time.NewTimer(c.config.PartitionDrainCheckInterval())
+		select {
+		case <-c.shutdownCh:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		if c.config.EnablePartitionDrain() {
+			c.drainLeftoverPartitions(draining)
+		}
+	}
+}
+
+// drainLeftoverPartitions loads the leftover partitions with backlog and reports the partitions tracked in
+// draining which have no backlog anymore
+func (c *taskListManagerImpl) drainLeftoverPartitions(draining map[int]struct{}) {
+	active := numActivePartitions(c.config)
+	for partition := range draining {
+		if partition < active {
+			// the number of partitions was raised again, the partition is not a leftover anymore
+			delete(draining, partition)
+		}
+	}
+
+	missing := 0
+	for partition := active; missing < maxMissingLeftoverPartitions; partition++ {
+		name := c.taskListID.mkName(partition)
+		hasBacklog, err := c.hasPartitionBacklog(name)
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok {
+				missing++
+				delete(draining, partition)
+				continue
+			}
+			c.logger.Warn("Failed to check backlog of leftover task list partition", tag.WorkflowTaskListName(name), tag.Error(err))
+			return
+		}
+		missing = 0
+
+		if !hasBacklog {
+			if _, ok := draining[partition]; ok {
+				delete(draining, partition)
+				c.metricScope().IncCounter(metrics.PartitionsDrainedPerTaskListCounter)
+				c.logger.Info("Leftover task list partition drained", tag.WorkflowTaskListName(name))
+			}
+			continue
+		}
+		if _, ok := draining[partition]; !ok {
+			draining[partition] = struct{}{}
+			c.logger.Info("Draining leftover task list partition", tag.WorkflowTaskListName(name))
+		}
+		if err := c.loadPartition(name); err != nil {
+			c.logger.Warn("Failed to load leftover task list partition", tag.WorkflowTaskListName(name), tag.Error(err))
+		}
+	}
+}
+
+// hasPartitionBacklog returns true if the partition has tasks above its ack level
+func (c *taskListManagerImpl) hasPartitionBacklog(name string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), partitionDrainCallTimeout)
+	defer cancel()
+
+	resp, err := c.engine.taskManager.GetTaskList(ctx, &persistence.GetTaskListRequest{
+		DomainID: c.taskListID.domainID,
+		TaskList: name,
+		TaskType: c.taskListID.taskType,
+	})
+	if err != nil {
+		return false, err
+	}
+	info := resp.TaskListInfo
+	maxReadLevel := info.RangeID * c.config.RangeSize
+	tasks, err := c.engine.taskManager.GetTasks(ctx, &persistence.GetTasksRequest{
+		DomainID:     c.taskListID.domainID,
+		TaskList:     name,
+		TaskType:     c.taskListID.taskType,
+		ReadLevel:    info.AckLevel,
+		MaxReadLevel: &maxReadLevel,
+		BatchSize:    1,
+	})
+	if err != nil {
+		return false, err
+	}
+	return len(tasks.Tasks) > 0, nil
+}
+
+// loadPartition makes the owner host of the partition load it, which starts moving its backlog
+func (c *taskListManagerImpl) loadPartition(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), partitionDrainCallTimeout)
+	defer cancel()
+
+	kind := c.taskListKind
+	_, err := c.engine.matchingClient.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: c.taskListID.domainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList: &types.TaskList{
+				Name: name,
+				Kind: &kind,
+			},
+			TaskListType: types.TaskListType(c.taskListID.taskType).Ptr(),
+		},
+	}, yarpc.WithHeader(loadTaskListHeaderName, "true"))
+	return err
+}
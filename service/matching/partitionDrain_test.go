@@ -0,0 +1,210 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/yarpc"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestIsDecommissioned(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := newPartitionDrainTestConfig(2, 3)
+	client := matching.NewMockClient(controller)
+	for partition, expected := range map[int]bool{0: false, 2: false, 3: true, 5: true} {
+		tlm := createTestPartitionManager(controller, cfg, partition, client)
+		assert.Equal(t, expected, tlm.isDecommissioned(), "partition %v", partition)
+	}
+
+	cfg.EnablePartitionDrain = func(domain string, taskList string, taskType int) bool { return false }
+	tlm := createTestPartitionManager(controller, cfg, 5, client)
+	assert.False(t, tlm.isDecommissioned())
+}
+
+func TestDrainTask(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	client := matching.NewMockClient(controller)
+	tlm := createTestPartitionManager(controller, newPartitionDrainTestConfig(2, 2), 3, client)
+	info := &persistence.TaskInfo{
+		DomainID:               "domain",
+		WorkflowID:             "workflow",
+		RunID:                  "run",
+		TaskID:                 7,
+		ScheduleID:             5,
+		ScheduleToStartTimeout: 600,
+		Expiry:                 time.Now().Add(100 * time.Second),
+	}
+	var completed []error
+	task := newInternalTask(info, func(_ context.Context, _ *persistence.TaskInfo, err error) {
+		completed = append(completed, err)
+	}, types.TaskSourceDbBacklog, "", false)
+
+	client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.AddActivityTaskRequest, _ ...yarpc.CallOption) error {
+			assert.Equal(t, "tl", request.TaskList.GetName())
+			assert.Empty(t, request.GetForwardedFrom())
+			assert.Equal(t, "domain", request.GetSourceDomainUUID())
+			assert.Equal(t, int64(5), request.GetScheduleID())
+			assert.InDelta(t, 100, request.GetScheduleToStartTimeoutSeconds(), 2)
+			assert.Equal(t, types.TaskSourceDbBacklog, request.GetSource())
+			return nil
+		})
+	require.NoError(t, tlm.DispatchTask(context.Background(), task))
+	assert.Equal(t, []error{nil}, completed)
+}
+
+func TestDrainTask_ContextDone(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	client := matching.NewMockClient(controller)
+	tlm := createTestPartitionManager(controller, newPartitionDrainTestConfig(1, 1), 1, client)
+	task := newInternalTask(&persistence.TaskInfo{DomainID: "domain"}, func(context.Context, *persistence.TaskInfo, error) {
+		t.Fatal("task is not moved and must not be completed")
+	}, types.TaskSourceDbBacklog, "", false)
+
+	client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).Return(errors.New("some error")).AnyTimes()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, tlm.DispatchTask(ctx, task))
+}
+
+func TestRemainingScheduleToStartTimeout(t *testing.T) {
+	now := time.Now()
+	assert.Equal(t, int32(10), remainingScheduleToStartTimeout(&persistence.TaskInfo{ScheduleToStartTimeout: 10}, now))
+	assert.Equal(t, int32(4), remainingScheduleToStartTimeout(&persistence.TaskInfo{
+		ScheduleToStartTimeout: 10,
+		Expiry:                 now.Add(4 * time.Second),
+	}, now))
+	assert.Equal(t, int32(1), remainingScheduleToStartTimeout(&persistence.TaskInfo{
+		ScheduleToStartTimeout: 10,
+		Expiry:                 now.Add(-time.Second),
+	}, now))
+}
+
+func TestDrainLeftoverPartitions(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	client := matching.NewMockClient(controller)
+	taskManager := persistence.NewMockTaskManager(controller)
+	tlm := createTestPartitionManager(controller, newPartitionDrainTestConfig(2, 1), 0, client)
+	tlm.engine.taskManager = taskManager
+
+	backlog := map[string][]*persistence.TaskInfo{
+		tlm.taskListID.mkName(2): {{TaskID: 3}},
+		tlm.taskListID.mkName(3): nil,
+	}
+	taskManager.EXPECT().GetTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetTaskListRequest) (*persistence.GetTaskListResponse, error) {
+			if _, ok := backlog[request.TaskList]; !ok {
+				return nil, &types.EntityNotExistsError{}
+			}
+			return &persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{RangeID: 1, AckLevel: 2}}, nil
+		}).AnyTimes()
+	taskManager.EXPECT().GetTasks(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
+			assert.Equal(t, int64(2), request.ReadLevel)
+			assert.Equal(t, tlm.config.RangeSize, *request.MaxReadLevel)
+			return &persistence.GetTasksResponse{Tasks: backlog[request.TaskList]}, nil
+		}).AnyTimes()
+
+	// only the leftover partition with backlog is loaded, even when describing task lists is read only
+	client.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.MatchingDescribeTaskListRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
+			assert.Equal(t, tlm.taskListID.mkName(2), request.DescRequest.TaskList.GetName())
+			assert.Equal(t, types.TaskListTypeActivity, request.DescRequest.GetTaskListType())
+			assert.Equal(t, []yarpc.CallOption{yarpc.WithHeader(loadTaskListHeaderName, "true")}, opts)
+			return &types.DescribeTaskListResponse{}, nil
+		}).Times(1)
+	draining := make(map[int]struct{})
+	tlm.drainLeftoverPartitions(draining)
+	assert.Equal(t, map[int]struct{}{2: {}}, draining)
+
+	// the backlog is gone
+	backlog[tlm.taskListID.mkName(2)] = nil
+	tlm.drainLeftoverPartitions(draining)
+	assert.Empty(t, draining)
+}
+
+func TestGetTasksPump_UnloadsDrainedPartition(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestPartitionManager(controller, newPartitionDrainTestConfig(1, 1), 1, matching.NewMockClient(controller))
+	require.NoError(t, tlm.Start())
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt32(&tlm.stopped) == 1
+	}, time.Second, 10*time.Millisecond)
+}
+
+func newPartitionDrainTestConfig(numReadPartitions, numWritePartitions int) *Config {
+	cfg := defaultTestConfig()
+	cfg.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(numReadPartitions)
+	cfg.NumTasklistWritePartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(numWritePartitions)
+	return cfg
+}
+
+func createTestPartitionManager(
+	controller *gomock.Controller,
+	cfg *Config,
+	partition int,
+	client matching.Client,
+) *taskListManagerImpl {
+	logger, err := loggerimpl.NewDevelopment()
+	if err != nil {
+		panic(err)
+	}
+	mockDomainCache := cache.NewMockDomainCache(controller)
+	mockDomainCache.EXPECT().GetDomainByID(gomock.Any()).Return(cache.CreateDomainCacheEntry("domainName"), nil).AnyTimes()
+	mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("domainName", nil).AnyTimes()
+	me := newMatchingEngine(cfg, newTestTaskManager(logger), nil, logger, mockDomainCache)
+	me.matchingClient = client
+
+	root := newTestTaskListID("domain", "tl", persistence.TaskListTypeActivity)
+	tlID := newTestTaskListID("domain", root.mkName(partition), persistence.TaskListTypeActivity)
+	tlKind := types.TaskListKindNormal
+	tlMgr, err := newTaskListManager(me, tlID, &tlKind, cfg)
+	if err != nil {
+		panic(err)
+	}
+	return tlMgr.(*taskListManagerImpl)
+}
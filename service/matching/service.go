@@ -24,6 +24,8 @@ import (
 	"sync/atomic"
 	"time"
 
+	"go.opentelemetry.io/otel/trace"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/resource"
@@ -34,10 +36,11 @@ import (
 type Service struct {
 	resource.Resource
 
-	status  int32
-	handler Handler
-	stopC   chan struct{}
-	config  *Config
+	status         int32
+	handler        Handler
+	stopC          chan struct{}
+	config         *Config
+	tracerProvider trace.TracerProvider
 }
 
 // NewService builds a new cadence-matching service
@@ -67,11 +70,17 @@ func NewService(
 		return nil, err
 	}
 
+	tracerProvider := params.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = trace.NewNoopTracerProvider()
+	}
+
 	return &Service{
-		Resource: serviceResource,
-		status:   common.DaemonStatusInitialized,
-		config:   serviceConfig,
-		stopC:    make(chan struct{}),
+		Resource:       serviceResource,
+		status:         common.DaemonStatusInitialized,
+		config:         serviceConfig,
+		stopC:          make(chan struct{}),
+		tracerProvider: tracerProvider,
 	}, nil
 }
 
@@ -84,7 +93,7 @@ func (s *Service) Start() {
 	logger := s.GetLogger()
 	logger.Info("matching starting")
 
-	s.handler = NewHandler(s, s.config)
+	s.handler = NewHandler(s, s.config, s.tracerProvider)
 
 	thriftHandler := NewThriftHandler(s.handler)
 	thriftHandler.register(s.GetDispatcher())
@@ -110,6 +119,8 @@ func (s *Service) Stop() {
 	// remove self from membership ring and wait for traffic to drain
 	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
 	s.GetMembershipResolver().EvictSelf()
+	s.GetLogger().Info("ShutdownHandler: Completing outstanding polls")
+	s.handler.DrainPollers()
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
 	time.Sleep(s.config.ShutdownDrainDuration())
 
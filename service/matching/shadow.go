@@ -0,0 +1,204 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+// A tasklist configured with a shadow tasklist mirrors a sample of the tasks added to it to the shadow tasklist,
+// so that a new worker fleet polling the shadow tasklist can be validated before traffic is cut over to it. The
+// mirrored copy is only ever sync matched and never persisted. In metadata mode pollers of the shadow tasklist get
+// the workflow execution of the task without a task token, which workers treat as an empty poll, so the task is
+// never started from there. In full mode they get a startable task and whichever tasklist starts the task first
+// processes it, the other copy is dropped as already started. Comparing the dispatch latencies of both tasklists
+// tells whether the shadow fleet keeps up.
+
+const (
+	// shadowModeMetadata hands pollers of the shadow tasklist the workflow execution of the task only
+	shadowModeMetadata = "metadata"
+	// shadowModeFull hands pollers of the shadow tasklist a task they can start
+	shadowModeFull = "full"
+
+	// shadowTaskAddTimeout bounds the call adding the mirrored copy of a task to the shadow tasklist
+	shadowTaskAddTimeout = 10 * time.Second
+)
+
+// shadowTaskInfo marks a task as the mirrored copy of a task added to another tasklist
+type shadowTaskInfo struct {
+	sourceTaskList string // name of the tasklist the task was mirrored from
+	metadataOnly   bool
+	attempt        int64
+}
+
+// newShadowTaskInfo returns the shadow info carried by an add task request, nil if the task is not a mirrored copy
+func newShadowTaskInfo(sourceTaskList string, metadataOnly bool, attempt int64) *shadowTaskInfo {
+	if sourceTaskList == "" {
+		return nil
+	}
+	return &shadowTaskInfo{
+		sourceTaskList: sourceTaskList,
+		metadataOnly:   metadataOnly,
+		attempt:        attempt,
+	}
+}
+
+// getSourceTaskList returns the tasklist the task was mirrored from, empty if the task is not a mirrored copy
+func (s *shadowTaskInfo) getSourceTaskList() string {
+	if s == nil {
+		return ""
+	}
+	return s.sourceTaskList
+}
+
+// mirrorTask adds a copy of a task added to this tasklist to the shadow tasklist for the configured sample of
+// tasks. The copy is added asynchronously through the matching client as the shadow tasklist may be owned by
+// another host, and adding it never fails nor delays the add of the task itself
+func (c *taskListManagerImpl) mirrorTask(params addTaskParams) {
+	if params.shadow != nil || c.taskListKind == types.TaskListKindSticky {
+		return
+	}
+	shadowTaskList := c.config.ShadowTaskList()
+	if shadowTaskList == "" || shadowTaskList == c.taskListID.GetRoot() {
+		return
+	}
+	if rand.Float64() >= c.config.ShadowTaskListSampleRatio() {
+		return
+	}
+	// tasks of a standby domain are not dispatched, the shadow tasklist would drop their copy
+	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
+	if err != nil || domainEntry.GetDomainNotActiveErr() != nil {
+		return
+	}
+
+	shadow := &shadowTaskInfo{
+		sourceTaskList: c.taskListID.GetRoot(),
+		metadataOnly:   c.config.ShadowTaskListMode() != shadowModeFull,
+		attempt:        params.attempt,
+	}
+	c.metricScope().IncCounter(metrics.ShadowTaskMirroredPerTaskListCounter)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shadowTaskAddTimeout)
+		defer cancel()
+		err := c.addShadowTask(ctx, shadowTaskList, params, shadow)
+		switch err.(type) {
+		case nil:
+		case *types.LimitExceededError:
+			// no poller of the shadow tasklist picked up the copy within the sync match timeout
+			c.metricScope().IncCounter(metrics.ShadowTaskNotMatchedPerTaskListCounter)
+		default:
+			c.metricScope().IncCounter(metrics.ShadowTaskErrorsPerTaskListCounter)
+		}
+	}()
+}
+
+// addShadowTask adds the copy of a task to the shadow tasklist of the same domain and type. The copy is ephemeral,
+// it is dropped if no poller of the shadow tasklist is waiting for it
+func (c *taskListManagerImpl) addShadowTask(
+	ctx context.Context,
+	shadowTaskList string,
+	params addTaskParams,
+	shadow *shadowTaskInfo,
+) error {
+	info := params.taskInfo
+	kind := types.TaskListKindNormal
+	taskList := &types.TaskList{
+		Name: shadowTaskList,
+		Kind: &kind,
+	}
+	timeout := remainingScheduleToStartTimeout(info, time.Now())
+
+	switch c.taskListID.taskType {
+	case persistence.TaskListTypeDecision:
+		return c.engine.matchingClient.AddDecisionTask(ctx, &types.AddDecisionTaskRequest{
+			DomainUUID:                    info.DomainID,
+			Execution:                     params.execution,
+			TaskList:                      taskList,
+			ScheduleID:                    info.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &timeout,
+			Source:                        &params.source,
+			Ephemeral:                     true,
+			Attempt:                       shadow.attempt,
+			ShadowSourceTaskList:          shadow.sourceTaskList,
+			ShadowMetadataOnly:            shadow.metadataOnly,
+		})
+	case persistence.TaskListTypeActivity:
+		return c.engine.matchingClient.AddActivityTask(ctx, &types.AddActivityTaskRequest{
+			DomainUUID:                    c.taskListID.domainID,
+			SourceDomainUUID:              info.DomainID,
+			Execution:                     params.execution,
+			TaskList:                      taskList,
+			ScheduleID:                    info.ScheduleID,
+			ScheduleToStartTimeoutSeconds: &timeout,
+			Source:                        &params.source,
+			Ephemeral:                     true,
+			Attempt:                       shadow.attempt,
+			ShadowSourceTaskList:          shadow.sourceTaskList,
+			ShadowMetadataOnly:            shadow.metadataOnly,
+		})
+	default:
+		return errInvalidTaskListType
+	}
+}
+
+// emitShadowDispatchLatency records the time from add to dispatch of the mirrored copies dispatched by the shadow
+// tasklist, and of the tasks dispatched by a tasklist that is being mirrored, so the two can be compared
+func (c *taskListManagerImpl) emitShadowDispatchLatency(task *InternalTask) {
+	if task.event == nil {
+		return
+	}
+	switch {
+	case task.shadow != nil:
+		c.metricScope().RecordTimer(metrics.ShadowTaskDispatchLatencyPerTaskList, time.Since(task.event.CreatedTime))
+	case c.config.ShadowTaskList() != "":
+		c.metricScope().RecordTimer(metrics.ShadowSourceDispatchLatencyPerTaskList, time.Since(task.event.CreatedTime))
+	}
+}
+
+// isShadowMetadataOnly returns true if the task is a mirrored copy that must not be started
+func (task *InternalTask) isShadowMetadataOnly() bool {
+	return task.shadow != nil && task.shadow.metadataOnly
+}
+
+// shadowPollForDecisionResponse returns the poll response for a metadata only mirrored copy of a decision task.
+// It has no task token, so pollers treat it as an empty poll
+func (task *InternalTask) shadowPollForDecisionResponse() *types.MatchingPollForDecisionTaskResponse {
+	return &types.MatchingPollForDecisionTaskResponse{
+		WorkflowExecution: task.workflowExecution(),
+		Attempt:           task.shadow.attempt,
+		BacklogCountHint:  task.backlogCountHint,
+	}
+}
+
+// shadowPollForActivityResponse returns the poll response for a metadata only mirrored copy of an activity task.
+// It has no task token, so pollers treat it as an empty poll
+func (task *InternalTask) shadowPollForActivityResponse() *types.PollForActivityTaskResponse {
+	return &types.PollForActivityTaskResponse{
+		WorkflowExecution: task.workflowExecution(),
+		Attempt:           int32(task.shadow.attempt),
+	}
+}
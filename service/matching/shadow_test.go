@@ -0,0 +1,184 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func newShadowTestConfig(shadowTaskList string, ratio float64, mode string) *Config {
+	cfg := defaultTestConfig()
+	cfg.ShadowTaskList = func(domain string, taskList string, taskType int) string { return shadowTaskList }
+	cfg.ShadowTaskListSampleRatio = dynamicconfig.GetFloatPropertyFnFilteredByTaskListInfo(ratio)
+	cfg.ShadowTaskListMode = func(domain string, taskList string, taskType int) string { return mode }
+	return cfg
+}
+
+func newShadowTestAddTaskParams() addTaskParams {
+	return addTaskParams{
+		execution: &types.WorkflowExecution{WorkflowID: "workflow", RunID: "run"},
+		taskInfo: &persistence.TaskInfo{
+			DomainID:               "domain",
+			WorkflowID:             "workflow",
+			RunID:                  "run",
+			ScheduleID:             5,
+			ScheduleToStartTimeout: 600,
+			CreatedTime:            time.Now(),
+		},
+		source:  types.TaskSourceHistory,
+		attempt: 2,
+	}
+}
+
+func TestMirrorTask(t *testing.T) {
+	for _, mode := range []string{shadowModeMetadata, shadowModeFull} {
+		t.Run(mode, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			client := matching.NewMockClient(controller)
+			tlm := createTestTaskListManagerWithConfig(controller, newShadowTestConfig("shadow", 1, mode))
+			tlm.engine.matchingClient = client
+			require.NoError(t, tlm.Start())
+			defer tlm.Stop()
+
+			mirrored := make(chan *types.AddActivityTaskRequest, 1)
+			client.EXPECT().AddActivityTask(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, request *types.AddActivityTaskRequest, _ ...yarpc.CallOption) error {
+					mirrored <- request
+					return nil
+				})
+			_, err := tlm.AddTask(context.Background(), newShadowTestAddTaskParams())
+			require.NoError(t, err)
+
+			select {
+			case request := <-mirrored:
+				assert.Equal(t, "shadow", request.TaskList.GetName())
+				assert.Equal(t, types.TaskListKindNormal, request.TaskList.GetKind())
+				assert.Equal(t, "domain", request.GetSourceDomainUUID())
+				assert.Equal(t, int64(5), request.GetScheduleID())
+				assert.Equal(t, int64(2), request.GetAttempt())
+				assert.True(t, request.GetEphemeral())
+				assert.Empty(t, request.GetForwardedFrom())
+				assert.Equal(t, "tl", request.GetShadowSourceTaskList())
+				assert.Equal(t, mode == shadowModeMetadata, request.GetShadowMetadataOnly())
+			case <-time.After(time.Second):
+				t.Fatal("task was not mirrored to the shadow tasklist")
+			}
+		})
+	}
+}
+
+func TestMirrorTask_Skipped(t *testing.T) {
+	tests := map[string]struct {
+		cfg    *Config
+		params func(addTaskParams) addTaskParams
+	}{
+		"not configured": {
+			cfg: newShadowTestConfig("", 1, shadowModeMetadata),
+		},
+		"mirrored to itself": {
+			cfg: newShadowTestConfig("tl", 1, shadowModeMetadata),
+		},
+		"not sampled": {
+			cfg: newShadowTestConfig("shadow", 0, shadowModeMetadata),
+		},
+		"shadow copy": {
+			cfg: newShadowTestConfig("shadow", 1, shadowModeMetadata),
+			params: func(params addTaskParams) addTaskParams {
+				params.shadow = newShadowTaskInfo("source", true, params.attempt)
+				return params
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			// the mock client fails the test on any call
+			tlm := createTestTaskListManagerWithConfig(controller, tc.cfg)
+			tlm.engine.matchingClient = matching.NewMockClient(controller)
+			params := newShadowTestAddTaskParams()
+			if tc.params != nil {
+				params = tc.params(params)
+			}
+			tlm.mirrorTask(params)
+		})
+	}
+}
+
+func TestAddShadowTask(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.EphemeralSyncMatchTimeout = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(100 * time.Millisecond)
+	cfg.TaskDedupeWindow = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(time.Minute)
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	tlMgrStartWithoutNotifyEvent(tlm)
+	defer tlm.Stop()
+
+	params := newShadowTestAddTaskParams()
+	params.ephemeral = true
+	params.shadow = newShadowTaskInfo("source", true, params.attempt)
+
+	// no poller of the shadow tasklist, the copy is dropped
+	syncMatch, err := tlm.AddTask(context.Background(), params)
+	assert.Equal(t, errEphemeralTaskNotMatched, err)
+	assert.False(t, syncMatch)
+
+	// copies are not deduped, a poller gets the copy of the same task
+	polled := make(chan *InternalTask, 1)
+	go func() {
+		task, err := tlm.GetTask(context.Background(), nil)
+		if assert.NoError(t, err) {
+			task.finish(context.Background(), nil)
+			polled <- task
+		}
+	}()
+	require.Eventually(t, func() bool {
+		syncMatch, err = tlm.AddTask(context.Background(), params)
+		return err == nil && syncMatch
+	}, time.Second, time.Millisecond)
+
+	task := <-polled
+	require.True(t, task.isShadowMetadataOnly())
+	decisionResp := task.shadowPollForDecisionResponse()
+	assert.Empty(t, decisionResp.TaskToken)
+	assert.Equal(t, params.execution, decisionResp.WorkflowExecution)
+	assert.Equal(t, int64(2), decisionResp.Attempt)
+	activityResp := task.shadowPollForActivityResponse()
+	assert.Empty(t, activityResp.TaskToken)
+	assert.Equal(t, params.execution, activityResp.WorkflowExecution)
+}
@@ -21,6 +21,8 @@
 package matching
 
 import (
+	"context"
+
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -29,7 +31,7 @@ type (
 	// genericTaskInfo contains the info for an activity or decision task
 	genericTaskInfo struct {
 		*persistence.TaskInfo
-		completionFunc func(*persistence.TaskInfo, error)
+		completionFunc func(context.Context, *persistence.TaskInfo, error)
 	}
 	// queryTaskInfo contains the info for a query task
 	queryTaskInfo struct {
@@ -51,15 +53,16 @@ type (
 		started          *startedTaskInfo // non-nil for a task received from a parent partition which is already started
 		domainName       string
 		source           types.TaskSource
-		forwardedFrom    string     // name of the child partition this task is forwarded from (empty if not forwarded)
-		responseC        chan error // non-nil only where there is a caller waiting for response (sync-match)
+		forwardedFrom    string          // name of the child partition this task is forwarded from (empty if not forwarded)
+		responseC        chan error      // non-nil only where there is a caller waiting for response (sync-match)
+		shadow           *shadowTaskInfo // non-nil for the mirrored copy of a task added to another tasklist
 		backlogCountHint int64
 	}
 )
 
 func newInternalTask(
 	info *persistence.TaskInfo,
-	completionFunc func(*persistence.TaskInfo, error),
+	completionFunc func(context.Context, *persistence.TaskInfo, error),
 	source types.TaskSource,
 	forwardedFrom string,
 	forSyncMatch bool,
@@ -126,6 +129,15 @@ func (task *InternalTask) workflowExecution() *types.WorkflowExecution {
 	return &types.WorkflowExecution{}
 }
 
+// affinityKey returns the key the task was added with, used to route the task to the poller
+// that handled previous tasks with the same key. Tasks without a key are matched regularly
+func (task *InternalTask) affinityKey() string {
+	if task.event != nil {
+		return task.event.AffinityKey
+	}
+	return ""
+}
+
 // pollForDecisionResponse returns the poll response for a decision task that is
 // already marked as started. This method should only be called when isStarted() is true
 func (task *InternalTask) pollForDecisionResponse() *types.MatchingPollForDecisionTaskResponse {
@@ -146,12 +158,13 @@ func (task *InternalTask) pollForActivityResponse() *types.PollForActivityTaskRe
 
 // finish marks a task as finished. Should be called after a poller picks up a task
 // and marks it as started. If the task is unable to marked as started, then this
-// method should be called with a non-nil error argument.
-func (task *InternalTask) finish(err error) {
+// method should be called with a non-nil error argument. ctx is the context of the
+// caller and bounds writing the task back to the database on error.
+func (task *InternalTask) finish(ctx context.Context, err error) {
 	switch {
 	case task.responseC != nil:
 		task.responseC <- err
 	case task.event.completionFunc != nil:
-		task.event.completionFunc(task.event.TaskInfo, err)
+		task.event.completionFunc(ctx, task.event.TaskInfo, err)
 	}
 }
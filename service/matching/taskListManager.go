@@ -31,6 +31,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -56,6 +57,12 @@ type (
 		taskInfo      *persistence.TaskInfo
 		source        types.TaskSource
 		forwardedFrom string
+		// ephemeral tasks are only sync matched and never persisted
+		ephemeral bool
+		// attempt tells retries of a task apart, they share its schedule ID
+		attempt int64
+		// shadow is non-nil for the mirrored copy of a task added to another tasklist
+		shadow *shadowTaskInfo
 	}
 
 	taskListManager interface {
@@ -76,11 +83,20 @@ type (
 		// if dispatched to local poller then nil and nil is returned.
 		DispatchQueryTask(ctx context.Context, taskID string, request *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
 		CancelPoller(pollerID string)
+		// DrainPollers completes all outstanding polls of the task list with an empty response
+		DrainPollers()
 		GetAllPollerInfo() []*types.PollerInfo
 		// DescribeTaskList returns information about the target tasklist
 		DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse
 		String() string
 		GetTaskListKind() types.TaskListKind
+		// LastPersistenceError returns the error of the last persistence operation of the task list,
+		// or an empty string when it succeeded. A task list with an error is reported as not ready.
+		LastPersistenceError() string
+		// LeaseStats returns the current rangeID and the lease counters of the task list
+		LeaseStats() taskListLeaseStats
+		// HasDispatchDemand returns true when the task list has a backlog or was added tasks after the given time
+		HasDispatchDemand(since time.Time) bool
 	}
 
 	// Single task list in memory state
@@ -95,11 +111,14 @@ type (
 		taskGC           *taskGC
 		taskAckManager   messaging.AckManager // tracks ackLevel for delivered messages
 		matcher          *TaskMatcher         // for matching a task producer with a poller
+		dedupe           *taskDedupe          // drops duplicate adds of recently added tasks
 		domainCache      cache.DomainCache
 		logger           log.Logger
 		metricsClient    metrics.Client
 		domainNameValue  atomic.Value
 		metricScopeValue atomic.Value // domain/tasklist tagged metric scope
+		dispatchShare    atomic.Value // cachedDispatchShare
+		lastTaskAddTime  int64        // unix nanos of the last added task, accessed atomically
 		// pollerHistory stores poller which poll from this tasklist in last few minutes
 		pollerHistory *pollerHistory
 		// outstandingPollsMap is needed to keep track of all outstanding pollers for a
@@ -114,17 +133,38 @@ type (
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
 		stopped    int32
 	}
+
+	cachedDispatchShare struct {
+		share      float64
+		expiryTime time.Time
+	}
 )
 
 const (
 	// maxSyncMatchWaitTime is the max amount of time that we are willing to wait for a sync match to happen
 	maxSyncMatchWaitTime = 200 * time.Millisecond
+	// partitionHintDisabledCheckInterval is how often partition hints are checked for being re-enabled
+	partitionHintDisabledCheckInterval = time.Minute
+	// partitionZoneRefreshInterval is how often the zones of the ancestor partitions are refreshed
+	// when cross zone forwarding is disabled but the partition hints are not enabled
+	partitionZoneRefreshInterval = 30 * time.Second
+	// dispatchShareRefreshInterval is how often the domain share of the poller dispatch rate is recomputed
+	dispatchShareRefreshInterval = 10 * time.Second
+	// dispatchDemandWindow is how long a task list without backlog counts towards the dispatch share
+	// of the other domains after it was last added a task
+	dispatchDemandWindow = time.Minute
 )
 
 var _ taskListManager = (*taskListManagerImpl)(nil)
 
 var errRemoteSyncMatchFailed = &types.RemoteSyncMatchedError{Message: "remote sync match failed"}
 
+// errHostDraining is a ServiceBusyError so that pollers back off and retry, by then the task list is owned by another host
+var errHostDraining = &types.ServiceBusyError{Message: "matching host is shutting down, poll again"}
+
+// errEphemeralTaskNotMatched is a LimitExceededError so that callers fail fast instead of retrying
+var errEphemeralTaskNotMatched = &types.LimitExceededError{Message: "no poller available to sync match task on ephemeral tasklist"}
+
 func newTaskListManager(
 	e *matchingEngineImpl,
 	taskList *taskListID,
@@ -142,7 +182,7 @@ func newTaskListManager(
 		taskListKind = &normalTaskListKind
 	}
 
-	db := newTaskListDB(e.taskManager, taskList.domainID, taskList.name, taskList.taskType, int(*taskListKind), e.logger)
+	db := newTaskListDB(e.taskManager, taskList.domainID, taskList.name, taskList.taskType, int(*taskListKind), e.logger, e.health)
 
 	tlMgr := &taskListManagerImpl{
 		domainCache:   e.domainCache,
@@ -156,6 +196,7 @@ func newTaskListManager(
 		db:                  db,
 		taskAckManager:      messaging.NewAckManager(e.logger),
 		taskGC:              newTaskGC(db, taskListConfig),
+		dedupe:              newTaskDedupe(taskListConfig, clock.NewRealTimeSource()),
 		config:              taskListConfig,
 		outstandingPollsMap: make(map[string]context.CancelFunc),
 	}
@@ -182,7 +223,7 @@ func newTaskListManager(
 	tlMgr.taskReader = newTaskReader(tlMgr)
 	var fwdr *Forwarder
 	if tlMgr.isFowardingAllowed(taskList, *taskListKind) {
-		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient)
+		fwdr = newForwarder(&taskListConfig.forwarderConfig, taskList, *taskListKind, e.matchingClient, e.dispatchHooks, tlMgr.GetAllPollerInfo)
 	}
 	tlMgr.matcher = newTaskMatcher(taskListConfig, fwdr, tlMgr.metricScope)
 	tlMgr.startWG.Add(1)
@@ -204,6 +245,12 @@ func (c *taskListManagerImpl) Start() error {
 	c.taskAckManager.SetAckLevel(state.ackLevel)
 	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
 	c.taskReader.Start()
+	if c.matcher.fwdr != nil {
+		go c.partitionHintsPump(c.matcher.fwdr)
+	}
+	if c.taskListID.IsRoot() && c.taskListKind != types.TaskListKindSticky {
+		go c.partitionDrainPump()
+	}
 
 	return nil
 }
@@ -216,6 +263,7 @@ func (c *taskListManagerImpl) Stop() {
 	close(c.shutdownCh)
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
+	c.engine.cacheUnloadedTaskList(c.taskListID, c.DescribeTaskList(true))
 	c.engine.removeTaskListManager(c.taskListID)
 	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
 }
@@ -225,7 +273,17 @@ func (c *taskListManagerImpl) Stop() {
 // be written to database and later asynchronously matched with a poller
 func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams) (bool, error) {
 	c.startWG.Wait()
+	isForwarded := params.forwardedFrom != ""
+	isShadow := params.shadow != nil
+	if !isForwarded && !isShadow && c.dedupe.isDuplicate(params) {
+		c.metricScope().IncCounter(metrics.TaskDedupedPerTaskListCounter)
+		return false, nil
+	}
+	atomic.StoreInt64(&c.lastTaskAddTime, time.Now().UnixNano())
+
 	var syncMatch bool
+	var ephemeralNotMatched bool
+	ephemeral := c.isEphemeral(params)
 	_, err := c.executeWithRetry(func() (interface{}, error) {
 		if err := ctx.Err(); err != nil {
 			return nil, err
@@ -236,7 +294,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return nil, err
 		}
 
-		isForwarded := params.forwardedFrom != ""
+		c.engine.dispatchHooks.TaskAdded(ctx, c.taskListID.name, params.taskInfo)
 
 		if domainEntry.GetDomainNotActiveErr() != nil {
 			// standby task, only persist when task is not forwarded from child partition
@@ -244,13 +302,18 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			if isForwarded {
 				return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 			}
+			if ephemeral {
+				ephemeralNotMatched = true
+				return &persistence.CreateTasksResponse{}, nil
+			}
 
-			r, err := c.taskWriter.appendTask(params.execution, params.taskInfo)
+			r, err := c.taskWriter.appendTask(ctx, params.execution, params.taskInfo)
 			return r, err
 		}
 
 		// active task, try sync match first
 		syncMatch, err = c.trySyncMatch(ctx, params)
+		c.engine.dispatchHooks.SyncMatched(c.taskListID.name, params.taskInfo, syncMatch)
 		if syncMatch {
 			return &persistence.CreateTasksResponse{}, err
 		}
@@ -261,9 +324,20 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return &persistence.CreateTasksResponse{}, errRemoteSyncMatchFailed
 		}
 
-		return c.taskWriter.appendTask(params.execution, params.taskInfo)
+		if ephemeral {
+			// ephemeral tasks are never persisted, fail fast instead of queueing
+			ephemeralNotMatched = true
+			return &persistence.CreateTasksResponse{}, nil
+		}
+
+		return c.taskWriter.appendTask(ctx, params.execution, params.taskInfo)
 	})
 
+	if ephemeralNotMatched {
+		c.metricScope().IncCounter(metrics.EphemeralNotMatchedPerTaskListCounter)
+		return false, errEphemeralTaskNotMatched
+	}
+
 	if err != nil {
 		c.logger.Error("Failed to add task",
 			tag.Error(err),
@@ -271,7 +345,11 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			tag.WorkflowTaskListType(c.taskListID.taskType),
 		)
 	} else {
-		c.taskReader.Signal()
+		c.taskReader.NotifyTaskAdded()
+		if !isForwarded && !isShadow {
+			c.dedupe.recordAdded(params)
+			c.mirrorTask(params)
+		}
 	}
 
 	return syncMatch, err
@@ -279,8 +357,11 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 
 // DispatchTask dispatches a task to a poller. When there are no pollers to pick
 // up the task or if rate limit is exceeded, this method will return error. Task
-// *will not* be persisted to db
+// *will not* be persisted to db. Tasks of a decommissioned partition are moved to an active partition instead
 func (c *taskListManagerImpl) DispatchTask(ctx context.Context, task *InternalTask) error {
+	if task.event != nil && !task.isForwarded() && c.isDecommissioned() {
+		return c.drainTask(ctx, task)
+	}
 	return c.matcher.MustOffer(ctx, task)
 }
 
@@ -310,6 +391,7 @@ func (c *taskListManagerImpl) GetTask(
 	}
 	task.domainName = c.domainName()
 	task.backlogCountHint = c.taskAckManager.GetBacklogCount()
+	c.emitShadowDispatchLatency(task)
 	return task, nil
 }
 
@@ -335,6 +417,13 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		}()
 	}
 
+	// checked after the poller is registered above, so that a poll either sees
+	// the drain or is canceled by it
+	if c.engine.isDraining() {
+		c.metricScope().IncCounter(metrics.PollDrainedPerTaskListCounter)
+		return nil, errHostDraining
+	}
+
 	identity, ok := ctx.Value(identityKey).(string)
 	if ok && identity != "" {
 		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
@@ -350,6 +439,12 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// one rateLimiter for this entire task list and as we get polls,
 	// we update the ratelimiter rps if it has changed from the last
 	// value. Last poller wins if different pollers provide different values
+	if maxDispatchPerSecond != nil {
+		// pollers shared across domains provide the rate for all of them,
+		// so only apply this domain's share of it
+		rps := *maxDispatchPerSecond * c.domainDispatchShare()
+		maxDispatchPerSecond = &rps
+	}
 	c.matcher.UpdateRatelimit(maxDispatchPerSecond)
 
 	if domainEntry.GetDomainNotActiveErr() != nil {
@@ -375,6 +470,22 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 	}
 }
 
+// DrainPollers cancels all outstanding polls, which then return an empty response
+// to the pollers so that they poll again and get routed to another host
+func (c *taskListManagerImpl) DrainPollers() {
+	c.outstandingPollsLock.Lock()
+	cancels := make([]context.CancelFunc, 0, len(c.outstandingPollsMap))
+	for _, cancel := range c.outstandingPollsMap {
+		cancels = append(cancels, cancel)
+	}
+	c.outstandingPollsLock.Unlock()
+
+	for _, cancel := range cancels {
+		cancel()
+	}
+	c.metricScope().AddCounter(metrics.PollDrainedPerTaskListCounter, int64(len(cancels)))
+}
+
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
 // (readLevel, ackLevel, backlogCountHint and taskIDBlock).
@@ -394,6 +505,8 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 			StartID: taskIDBlock.start,
 			EndID:   taskIDBlock.end,
 		},
+		MatchStats:           c.matcher.stats.toTaskListMatchStats(),
+		OutstandingPollCount: c.matcher.OutstandingPollCount(),
 	}
 
 	return response
@@ -420,11 +533,22 @@ func (c *taskListManagerImpl) GetTaskListKind() types.TaskListKind {
 	return c.taskListKind
 }
 
+func (c *taskListManagerImpl) LastPersistenceError() string {
+	return c.db.LastError()
+}
+
+func (c *taskListManagerImpl) LeaseStats() taskListLeaseStats {
+	return c.db.LeaseStats()
+}
+
 // completeTask marks a task as processed. Only tasks created by taskReader (i.e. backlog from db) reach
 // here. As part of completion:
 //   - task is deleted from the database when err is nil
 //   - new task is created and current task is deleted when err is not nil
-func (c *taskListManagerImpl) completeTask(task *persistence.TaskInfo, err error) {
+//
+// Writing the task back is bounded by ctx, the context of the poll that failed to start the task,
+// as the append may wait for room in the writer under the block-until-deadline overload policy.
+func (c *taskListManagerImpl) completeTask(ctx context.Context, task *persistence.TaskInfo, err error) {
 	if err != nil {
 		// failed to start the task.
 		// We cannot just remove it from persistence because then it will be lost.
@@ -435,7 +559,7 @@ func (c *taskListManagerImpl) completeTask(task *persistence.TaskInfo, err error
 		// re-written to persistence frequently.
 		_, err = c.executeWithRetry(func() (interface{}, error) {
 			wf := &types.WorkflowExecution{WorkflowID: task.WorkflowID, RunID: task.RunID}
-			return c.taskWriter.appendTask(wf, task)
+			return c.taskWriter.appendTask(ctx, wf, task)
 		})
 
 		if err != nil {
@@ -449,7 +573,7 @@ func (c *taskListManagerImpl) completeTask(task *persistence.TaskInfo, err error
 			c.Stop()
 			return
 		}
-		c.taskReader.Signal()
+		c.taskReader.NotifyTaskAdded()
 	}
 	ackLevel := c.taskAckManager.AckItem(task.TaskID)
 	c.taskGC.Run(ackLevel)
@@ -467,6 +591,9 @@ func (c *taskListManagerImpl) renewLeaseWithRetry() (taskListState, error) {
 		backoff.WithRetryableError(persistence.IsTransientError),
 	)
 	err := throttleRetry.Do(context.Background(), op)
+	if _, ok := err.(*persistence.ConditionFailedError); ok {
+		c.metricScope().IncCounter(metrics.LeaseConflictPerTaskListCounter)
+	}
 	if err != nil {
 		c.metricScope().IncCounter(metrics.LeaseFailurePerTaskListCounter)
 		c.engine.unloadTaskList(c.taskListID)
@@ -529,18 +656,33 @@ func (c *taskListManagerImpl) executeWithRetry(
 
 func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params addTaskParams) (bool, error) {
 	task := newInternalTask(params.taskInfo, c.completeTask, params.source, params.forwardedFrom, true)
+	task.shadow = params.shadow
 	childCtx := ctx
 	cancel := func() {}
+	waitTime := maxSyncMatchWaitTime
+	ephemeral := !task.isForwarded() && c.isEphemeral(params)
+	if ephemeral {
+		waitTime = c.config.EphemeralSyncMatchTimeout()
+	}
 	if !task.isForwarded() {
 		// when task is forwarded from another matching host, we trust the context as is
 		// otherwise, we override to limit the amount of time we can block on sync match
-		childCtx, cancel = c.newChildContext(ctx, maxSyncMatchWaitTime, time.Second)
+		childCtx, cancel = c.newChildContext(ctx, waitTime, time.Second)
 	}
 	matched, err := c.matcher.Offer(childCtx, task)
+	if !matched && err == nil && ephemeral {
+		// there is no backlog to fall back to, wait for a poller until the sync match deadline
+		matched, err = c.matcher.offerOrTimeout(childCtx, task)
+	}
 	cancel()
 	return matched, err
 }
 
+// isEphemeral returns true if the task was declared ephemeral when added or the tasklist is configured as ephemeral
+func (c *taskListManagerImpl) isEphemeral(params addTaskParams) bool {
+	return params.ephemeral || c.config.EnableEphemeralTaskList()
+}
+
 // newChildContext creates a child context with desired timeout.
 // if tailroom is non-zero, then child context timeout will be
 // the minOf(parentCtx.Deadline()-tailroom, timeout). Use this
@@ -568,6 +710,34 @@ func (c *taskListManagerImpl) newChildContext(
 	return context.WithTimeout(parent, timeout)
 }
 
+// partitionHintsPump periodically exchanges partition metadata with the
+// ancestors of this partition, so the forwarder can skip idle parents and
+// avoid forwarding tasks to other isolation zones
+func (c *taskListManagerImpl) partitionHintsPump(fwdr *Forwarder) {
+	for {
+		interval := fwdr.cfg.ForwarderPartitionHintInterval()
+		if interval <= 0 && fwdr.cfg.ForwarderDisableCrossZone() {
+			interval = partitionZoneRefreshInterval
+		}
+		if interval > 0 {
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			fwdr.refreshPartitionHints(ctx, interval)
+			cancel()
+		} else {
+			fwdr.clearPartitionHints()
+			interval = partitionHintDisabledCheckInterval
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-c.shutdownCh:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
 func (c *taskListManagerImpl) isFowardingAllowed(taskList *taskListID, kind types.TaskListKind) bool {
 	return !taskList.IsRoot() && kind != types.TaskListKindSticky
 }
@@ -577,6 +747,23 @@ func (c *taskListManagerImpl) metricScope() metrics.Scope {
 	return c.metricScopeValue.Load().(metrics.Scope)
 }
 
+// HasDispatchDemand returns true when the task list has a backlog or was added tasks after the given time
+func (c *taskListManagerImpl) HasDispatchDemand(since time.Time) bool {
+	return c.taskAckManager.GetBacklogCount() > 0 || atomic.LoadInt64(&c.lastTaskAddTime) > since.UnixNano()
+}
+
+// domainDispatchShare returns the share of the poller dispatch rate of this task list, it is cached as
+// computing it looks up the weights of every domain polled by the same workers
+func (c *taskListManagerImpl) domainDispatchShare() float64 {
+	now := time.Now()
+	if cached, ok := c.dispatchShare.Load().(cachedDispatchShare); ok && now.Before(cached.expiryTime) {
+		return cached.share
+	}
+	share := c.engine.getDomainDispatchShare(c.taskListID)
+	c.dispatchShare.Store(cachedDispatchShare{share: share, expiryTime: now.Add(dispatchShareRefreshInterval)})
+	return share
+}
+
 func (c *taskListManagerImpl) domainName() string {
 	name := c.domainNameValue.Load().(string)
 	if len(name) > 0 {
@@ -22,6 +22,7 @@ package matching
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -32,6 +33,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
@@ -88,6 +90,36 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	wg.Wait()
 }
 
+func TestDeliverBufferTasks_DeadlineOrdered(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := defaultTestConfig()
+	cfg.EnableDeadlineOrderedDispatch = func(domain string, taskList string, taskType int) bool { return true }
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	now := time.Now()
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{TaskID: 1}
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{TaskID: 2, Expiry: now.Add(time.Hour)}
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{TaskID: 3, Expiry: now.Add(time.Minute)}
+	tlm.taskReader.taskBuffer <- &persistence.TaskInfo{TaskID: 4, Expiry: now.Add(time.Minute)}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		tlm.taskReader.dispatchBufferedTasks()
+	}()
+	// earliest deadline first, tasks without a deadline last and FIFO among equal deadlines
+	for _, taskID := range []int64{3, 4, 2, 1} {
+		task, err := tlm.matcher.Poll(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, taskID, task.event.TaskID)
+	}
+	// the dispatcher is idle waiting for tasks, only the shutdown of the reader stops it
+	tlm.taskReader.Stop()
+	wg.Wait()
+}
+
 func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -173,6 +205,19 @@ func TestIsTaskAddedRecently(t *testing.T) {
 	require.False(t, tlm.taskReader.isTaskAddedRecently(time.Time{}))
 }
 
+func TestHasDispatchDemand(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.False(t, tlm.HasDispatchDemand(time.Now().Add(-dispatchDemandWindow)))
+
+	addTime := time.Now()
+	atomic.StoreInt64(&tlm.lastTaskAddTime, addTime.UnixNano())
+	require.True(t, tlm.HasDispatchDemand(addTime.Add(-dispatchDemandWindow)))
+	require.False(t, tlm.HasDispatchDemand(addTime))
+}
+
 func TestDescribeTaskList(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -205,6 +250,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Equal(t, taskCount, taskListStatus.GetBacklogCountHint())
 	require.True(t, taskListStatus.GetRatePerSecond() > (_defaultTaskDispatchRPS-1))
 	require.True(t, taskListStatus.GetRatePerSecond() < (_defaultTaskDispatchRPS+1))
+	require.Zero(t, taskListStatus.GetOutstandingPollCount())
 	taskIDBlock := taskListStatus.GetTaskIDBlock()
 	require.Equal(t, int64(1), taskIDBlock.GetStartID())
 	require.Equal(t, tlm.config.RangeSize, taskIDBlock.GetEndID())
@@ -275,6 +321,108 @@ func TestCheckIdleTaskList(t *testing.T) {
 	require.Equal(t, int32(1), tlm.stopped)
 }
 
+func TestDescribeTaskListConfig(t *testing.T) {
+	cfg := NewConfig(dynamicconfig.NewNopCollection())
+	cfg.NumTasklistReadPartitions = func(domain string, taskList string, taskType int) int {
+		if domain == "test-domain" && taskList == "test-tl" && taskType == persistence.TaskListTypeActivity {
+			return 4
+		}
+		return 1
+	}
+	cfg.EnableSyncMatch = func(domain string, taskList string, taskType int) bool { return false }
+	cfg.IdleTasklistCheckInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(30 * time.Second)
+
+	resp := describeTaskListConfig(cfg, "test-domain", "test-tl", persistence.TaskListTypeActivity)
+	require.Equal(t, int32(4), resp.NumReadPartitions)
+	require.Equal(t, int32(1), resp.NumWritePartitions)
+	require.False(t, resp.EnableSyncMatch)
+	require.Equal(t, int64(30), resp.IdleTaskListCheckIntervalInSeconds)
+	require.Equal(t, cfg.RangeSize, resp.RangeSize)
+
+	resp = describeTaskListConfig(cfg, "test-domain", "test-tl", persistence.TaskListTypeDecision)
+	require.Equal(t, int32(1), resp.NumReadPartitions)
+}
+
+func TestAddTaskEphemeral(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := NewConfig(dynamicconfig.NewNopCollection())
+	cfg.EnableEphemeralTaskList = func(domain string, taskList string, taskType int) bool { return true }
+	cfg.EphemeralSyncMatchTimeout = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(100 * time.Millisecond)
+
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	tlMgrStartWithoutNotifyEvent(tlm)
+	// stop taskWriter so that any attempt to persist the task fails with errShutdown
+	tlm.taskWriter.Stop()
+
+	addTaskParam := addTaskParams{
+		execution: &types.WorkflowExecution{
+			WorkflowID: "some random workflowID",
+			RunID:      "some random runID",
+		},
+		taskInfo: &persistence.TaskInfo{
+			DomainID:               "domain",
+			WorkflowID:             "some random workflowID",
+			RunID:                  "some random runID",
+			ScheduleID:             2,
+			ScheduleToStartTimeout: 5,
+			CreatedTime:            time.Now(),
+		},
+	}
+
+	// no poller, the task fails fast without being persisted
+	syncMatch, err := tlm.AddTask(context.Background(), addTaskParam)
+	require.Equal(t, errEphemeralTaskNotMatched, err)
+	require.False(t, syncMatch)
+
+	// a poller waiting within the sync match timeout gets the task
+	go func() {
+		task := <-tlm.matcher.taskC
+		task.finish(context.Background(), nil)
+	}()
+	syncMatch, err = tlm.AddTask(context.Background(), addTaskParam)
+	require.NoError(t, err)
+	require.True(t, syncMatch)
+	tlm.Stop()
+}
+
+func TestAddTaskEphemeralDeclaredAtAddTask(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	cfg := NewConfig(dynamicconfig.NewNopCollection())
+	cfg.EphemeralSyncMatchTimeout = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(100 * time.Millisecond)
+
+	tlm := createTestTaskListManagerWithConfig(controller, cfg)
+	tlMgrStartWithoutNotifyEvent(tlm)
+	// stop taskWriter so that any attempt to persist the task fails with errShutdown
+	tlm.taskWriter.Stop()
+
+	addTaskParam := addTaskParams{
+		execution: &types.WorkflowExecution{
+			WorkflowID: "some random workflowID",
+			RunID:      "some random runID",
+		},
+		taskInfo: &persistence.TaskInfo{
+			DomainID:               "domain",
+			WorkflowID:             "some random workflowID",
+			RunID:                  "some random runID",
+			ScheduleID:             2,
+			ScheduleToStartTimeout: 5,
+			CreatedTime:            time.Now(),
+		},
+		ephemeral: true,
+	}
+
+	// the tasklist is not configured as ephemeral but the task is, it is never persisted
+	syncMatch, err := tlm.AddTask(context.Background(), addTaskParam)
+	require.Equal(t, errEphemeralTaskNotMatched, err)
+	require.False(t, common.IsServiceTransientError(err))
+	require.False(t, syncMatch)
+	tlm.Stop()
+}
+
 func TestAddTaskStandby(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -332,3 +480,127 @@ func TestAddTaskStandby(t *testing.T) {
 	require.Error(t, err) // should not persist the task
 	require.False(t, syncMatch)
 }
+
+func TestTaskWriterGetWriteBatch(t *testing.T) {
+	w := &taskWriter{
+		config: &taskListConfig{
+			MaxTaskBatchSize:       func() int { return 3 },
+			TaskBatchFlushInterval: func() time.Duration { return time.Second },
+		},
+		appendCh: make(chan *writeTaskRequest, 10),
+		stopCh:   make(chan struct{}),
+	}
+
+	// idle tasklist: only drain what is already pending, never wait
+	w.appendCh <- &writeTaskRequest{}
+	start := time.Now()
+	reqs := w.getWriteBatch([]*writeTaskRequest{{}})
+	require.Len(t, reqs, 2)
+	require.True(t, time.Since(start) < 500*time.Millisecond)
+
+	// loaded tasklist: hold the batch open until it is full
+	w.lastBatchSize = 2
+	go func() {
+		for i := 0; i < 3; i++ {
+			time.Sleep(10 * time.Millisecond)
+			w.appendCh <- &writeTaskRequest{}
+		}
+	}()
+	start = time.Now()
+	reqs = w.getWriteBatch([]*writeTaskRequest{{}})
+	require.Len(t, reqs, 4)
+	require.True(t, time.Since(start) < 500*time.Millisecond)
+
+	// loaded tasklist: flush when the interval expires
+	w.config.TaskBatchFlushInterval = func() time.Duration { return 50 * time.Millisecond }
+	start = time.Now()
+	reqs = w.getWriteBatch([]*writeTaskRequest{{}})
+	require.Len(t, reqs, 1)
+	require.True(t, time.Since(start) >= 50*time.Millisecond)
+}
+
+func TestTaskWriterOverloadShedPolicy(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	tlm := createTestTaskListManager(controller)
+	w := tlm.taskWriter
+	w.appendCh = make(chan *writeTaskRequest, 1)
+	policy := overloadShedNewest
+	w.config.OverloadShedPolicy = func() string { return policy }
+
+	oldestCh := make(chan *writeTaskResponse, 1)
+	oldest := &writeTaskRequest{responseCh: oldestCh}
+	newest := &writeTaskRequest{responseCh: make(chan *writeTaskResponse, 1)}
+	w.appendCh <- oldest
+
+	require.Equal(t, errTooManyOutstandingAppends, w.enqueue(context.Background(), newest))
+	require.Equal(t, oldest, <-w.appendCh)
+
+	policy = overloadShedOldestBuffered
+	w.appendCh <- oldest
+	require.NoError(t, w.enqueue(context.Background(), newest))
+	require.Equal(t, errTooManyOutstandingAppends, (<-oldestCh).err)
+	require.Equal(t, newest, <-w.appendCh)
+
+	policy = overloadBlockUntilDeadline
+	w.appendCh <- oldest
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, w.enqueue(ctx, newest))
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		<-w.appendCh
+	}()
+	require.NoError(t, w.enqueue(context.Background(), newest))
+	require.Equal(t, newest, <-w.appendCh)
+	require.Empty(t, oldestCh)
+}
+
+func TestTaskListDBLeaseStats(t *testing.T) {
+	logger := loggerimpl.NewNopLogger()
+	db := newTaskListDB(newTestTaskManager(logger), "domain", "tl", persistence.TaskListTypeDecision, persistence.TaskListKindNormal, logger, nil)
+
+	_, err := db.RenewLease()
+	require.NoError(t, err)
+	_, err = db.RenewLease()
+	require.NoError(t, err)
+	db.recordPersistence(&persistence.ConditionFailedError{Msg: "range ID changed"})
+	require.Equal(t, taskListLeaseStats{rangeID: 2, leaseRenewals: 2, leaseConflicts: 1}, db.LeaseStats())
+}
+
+func TestCompleteTaskRewriteBoundedByContext(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+	tlm := createTestTaskListManager(controller)
+	tlm.taskWriter.appendCh = make(chan *writeTaskRequest, 1)
+	tlm.taskWriter.appendCh <- &writeTaskRequest{responseCh: make(chan *writeTaskResponse, 1)}
+	tlm.taskWriter.config.OverloadShedPolicy = func() string { return overloadBlockUntilDeadline }
+
+	// the writer never drains the channel, the rewrite gives up with the poll context
+	// and unloads the tasklist instead of blocking forever
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		tlm.completeTask(ctx, &persistence.TaskInfo{TaskID: 1}, errors.New("failed to start task"))
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "completeTask did not return")
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&tlm.stopped))
+}
+
+func TestNextPeriodicReadInterval(t *testing.T) {
+	base := time.Minute
+	max := 10 * time.Minute
+
+	require.Equal(t, base, nextPeriodicReadInterval(base, base, 0, true))
+	require.Equal(t, 2*time.Minute, nextPeriodicReadInterval(base, base, max, true))
+	require.Equal(t, 8*time.Minute, nextPeriodicReadInterval(4*time.Minute, base, max, true))
+	require.Equal(t, max, nextPeriodicReadInterval(8*time.Minute, base, max, true))
+	require.Equal(t, max, nextPeriodicReadInterval(max, base, max, true))
+	require.Equal(t, base, nextPeriodicReadInterval(max, base, max, false))
+}
@@ -21,8 +21,10 @@
 package matching
 
 import (
+	"container/heap"
 	"context"
 	"runtime"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/log"
@@ -49,6 +51,8 @@ type (
 		// separate shutdownC needed for dispatchTasks go routine to allow
 		// getTasksPump to be stopped without stopping dispatchTasks in unit tests
 		dispatcherShutdownC chan struct{}
+		// set when a task was added since the last periodic read, resets the idle read backoff
+		taskAdded int32
 	}
 )
 
@@ -85,40 +89,106 @@ func (tr *taskReader) Signal() {
 	}
 }
 
+// NotifyTaskAdded signals the pump to read the newly added task and resets the idle read backoff
+func (tr *taskReader) NotifyTaskAdded() {
+	atomic.StoreInt32(&tr.taskAdded, 1)
+	tr.Signal()
+}
+
 func (tr *taskReader) dispatchBufferedTasks() {
+	// tasks taken out of the buffer to be dispatched by earliest deadline, only filled when deadline ordering is enabled
+	pending := &deadlineTaskHeap{}
 dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task list getTasks pump is shutdown
+		var taskInfo *persistence.TaskInfo
+		if pending.Len() == 0 {
+			select {
+			case t, ok := <-tr.taskBuffer:
+				if !ok { // Task list getTasks pump is shutdown
+					break dispatchLoop
+				}
+				taskInfo = t
+			case <-tr.dispatcherShutdownC:
 				break dispatchLoop
 			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, types.TaskSourceDbBacklog, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
-				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+			if !tr.tlMgr.config.EnableDeadlineOrderedDispatch() {
+				if !tr.dispatchBufferedTask(taskInfo, dispatchOrderFIFO) {
 					break dispatchLoop
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
+				continue dispatchLoop
+			}
+			heap.Push(pending, taskInfo)
+		} else {
+			select {
+			case <-tr.dispatcherShutdownC:
+				break dispatchLoop
+			default:
 			}
-		case <-tr.dispatcherShutdownC:
+		}
+		// pick the earliest deadline among all buffered tasks, including those loaded while the previous one was dispatched
+		if !tr.drainBuffer(pending) {
+			break dispatchLoop
+		}
+		taskInfo = heap.Pop(pending).(*persistence.TaskInfo)
+		if !tr.dispatchBufferedTask(taskInfo, dispatchOrderDeadline) {
 			break dispatchLoop
 		}
 	}
 }
 
+// drainBuffer moves the tasks waiting in the buffer to pending, keeping at most a buffer worth of tasks pending.
+// It returns false if the buffer was closed
+func (tr *taskReader) drainBuffer(pending *deadlineTaskHeap) bool {
+	for pending.Len() <= cap(tr.taskBuffer) {
+		select {
+		case taskInfo, ok := <-tr.taskBuffer:
+			if !ok {
+				return false
+			}
+			heap.Push(pending, taskInfo)
+		default:
+			return true
+		}
+	}
+	return true
+}
+
+// dispatchBufferedTask blocks until the task is matched with a poller, it returns false if the task reader is stopped
+func (tr *taskReader) dispatchBufferedTask(taskInfo *persistence.TaskInfo, dispatchOrder string) bool {
+	tr.tlMgr.engine.dispatchHooks.BufferExited(tr.tlMgr.taskListID.name, taskInfo)
+	task := newInternalTask(taskInfo, tr.tlMgr.completeTask, types.TaskSourceDbBacklog, "", false)
+	for {
+		err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+		if err == nil {
+			break
+		}
+		if err == context.Canceled {
+			tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+			return false
+		}
+		// this should never happen unless there is a bug - don't drop the task
+		tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
+		tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+		runtime.Gosched()
+	}
+	if hasDeadline(taskInfo) {
+		// the deadline miss rate of the two orders is compared with these counters
+		scope := tr.scope().Tagged(metrics.DispatchOrderTag(dispatchOrder))
+		scope.IncCounter(metrics.BufferDispatchedPerTaskListCounter)
+		if time.Now().After(taskInfo.Expiry) {
+			scope.IncCounter(metrics.BufferDeadlineMissedPerTaskListCounter)
+		}
+	}
+	return true
+}
+
 func (tr *taskReader) getTasksPump() {
 	tr.tlMgr.startWG.Wait()
 	defer close(tr.taskBuffer)
 
 	updateAckTimer := time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
+	readInterval := tr.tlMgr.config.UpdateAckInterval()
+	periodicReadTimer := time.NewTimer(readInterval)
 	checkIdleTaskListTimer := time.NewTimer(tr.tlMgr.config.IdleTasklistCheckInterval())
 	lastTimeWriteTask := time.Time{}
 getTasksPumpLoop:
@@ -129,6 +199,12 @@ getTasksPumpLoop:
 		case <-tr.notifyC:
 			{
 				lastTimeWriteTask = time.Now()
+				if baseInterval := tr.tlMgr.config.UpdateAckInterval(); readInterval > baseInterval && atomic.LoadInt32(&tr.taskAdded) == 1 {
+					// the tasklist is busy again, go back to reading periodically at the base interval
+					readInterval = baseInterval
+					periodicReadTimer.Stop()
+					periodicReadTimer = time.NewTimer(readInterval)
+				}
 
 				tasks, readLevel, isReadBatchDone, err := tr.getTaskBatch()
 				if err != nil {
@@ -141,6 +217,9 @@ getTasksPumpLoop:
 					tr.tlMgr.taskAckManager.SetReadLevel(readLevel)
 					if !isReadBatchDone {
 						tr.Signal()
+					} else if tr.isDrained() {
+						tr.handleDrained()
+						break getTasksPumpLoop
 					}
 					continue getTasksPumpLoop
 				}
@@ -165,9 +244,20 @@ getTasksPumpLoop:
 					}
 					// keep going as saving ack is not critical
 				}
-				tr.Signal() // periodically signal pump to check persistence for tasks
 				updateAckTimer = time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 			}
+		case <-periodicReadTimer.C:
+			{
+				tr.Signal() // periodically signal pump to check persistence for tasks
+				isIdle := atomic.SwapInt32(&tr.taskAdded, 0) == 0 && tr.tlMgr.taskAckManager.GetBacklogCount() == 0
+				readInterval = nextPeriodicReadInterval(
+					readInterval,
+					tr.tlMgr.config.UpdateAckInterval(),
+					tr.tlMgr.config.MaxIdleTaskReadInterval(),
+					isIdle,
+				)
+				periodicReadTimer = time.NewTimer(readInterval)
+			}
 		case <-checkIdleTaskListTimer.C:
 			{
 				if tr.isIdle(lastTimeWriteTask) {
@@ -182,9 +272,26 @@ getTasksPumpLoop:
 	}
 
 	updateAckTimer.Stop()
+	periodicReadTimer.Stop()
 	checkIdleTaskListTimer.Stop()
 }
 
+// nextPeriodicReadInterval doubles the interval between periodic task reads while the tasklist
+// stays idle, up to maxInterval, and falls back to baseInterval as soon as it is not idle
+func nextPeriodicReadInterval(current, baseInterval, maxInterval time.Duration, isIdle bool) time.Duration {
+	if !isIdle || maxInterval <= baseInterval {
+		return baseInterval
+	}
+	next := current * 2
+	if next < baseInterval {
+		next = baseInterval
+	}
+	if next > maxInterval {
+		next = maxInterval
+	}
+	return next
+}
+
 func (tr *taskReader) getTaskBatchWithRange(readLevel int64, maxReadLevel int64) ([]*persistence.TaskInfo, error) {
 	response, err := tr.tlMgr.executeWithRetry(func() (interface{}, error) {
 		return tr.tlMgr.db.GetTasks(readLevel, maxReadLevel, tr.tlMgr.config.GetTasksBatchSize())
@@ -241,12 +348,24 @@ func (tr *taskReader) handleIdleTimeout() {
 	tr.tlMgr.Stop()
 }
 
+// isDrained returns true if the task list is a decommissioned partition whose whole backlog was moved
+func (tr *taskReader) isDrained() bool {
+	return tr.tlMgr.isDecommissioned() && tr.tlMgr.taskAckManager.GetBacklogCount() == 0
+}
+
+func (tr *taskReader) handleDrained() {
+	tr.scope().IncCounter(metrics.PartitionsDrainedPerTaskListCounter)
+	tr.logger().Info("Decommissioned task list partition drained, unloading it")
+	tr.handleIdleTimeout()
+}
+
 func (tr *taskReader) addTasksToBuffer(
 	tasks []*persistence.TaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
 	now := time.Now()
 	for _, t := range tasks {
 		if tr.isTaskExpired(t, now) {
 			tr.scope().IncCounter(metrics.ExpiredTasksPerTaskListCounter)
+			tr.tlMgr.matcher.stats.recordExpired()
 			// Also increment readLevel for expired tasks otherwise it could result in
 			// looping over the same tasks if all tasks read in the batch are expired
 			tr.tlMgr.taskAckManager.SetReadLevel(t.TaskID)
@@ -268,6 +387,7 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	for {
 		select {
 		case tr.taskBuffer <- task:
+			tr.tlMgr.engine.dispatchHooks.BufferEntered(tr.tlMgr.taskListID.name, task)
 			return true
 		case <-idleTimer.C:
 			if tr.isIdle(lastWriteTime) {
@@ -22,11 +22,14 @@
 package matching
 
 import (
+	"context"
 	"errors"
 	"sync/atomic"
+	"time"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
@@ -59,11 +62,28 @@ type (
 		stopped      int64 // set to 1 if the writer is stopped or is shutting down
 		logger       log.Logger
 		stopCh       chan struct{} // shutdown signal for all routines in this class
+		// size of the last batch written, only accessed by taskWriterLoop
+		lastBatchSize int
 	}
 )
 
-// errShutdown indicates that the task list is shutting down
-var errShutdown = errors.New("task list shutting down")
+// Policies applied by a tasklist once it has OutstandingTaskAppendsThreshold appends waiting to be written
+const (
+	// overloadShedNewest rejects the incoming add
+	overloadShedNewest = "reject-newest"
+	// overloadShedOldestBuffered rejects the add that has waited the longest to be written, so fresh tasks
+	// get in while the callers of stale adds retry
+	overloadShedOldestBuffered = "reject-oldest-buffered"
+	// overloadBlockUntilDeadline never sheds, the add waits for room in the writer until its deadline
+	overloadBlockUntilDeadline = "block-until-deadline"
+)
+
+var (
+	// errShutdown indicates that the task list is shutting down
+	errShutdown = errors.New("task list shutting down")
+
+	errTooManyOutstandingAppends = createServiceBusyError("Too many outstanding appends to the TaskList")
+)
 
 func newTaskWriter(tlMgr *taskListManagerImpl) *taskWriter {
 	return &taskWriter{
@@ -93,7 +113,7 @@ func (w *taskWriter) isStopped() bool {
 	return atomic.LoadInt64(&w.stopped) == 1
 }
 
-func (w *taskWriter) appendTask(execution *types.WorkflowExecution,
+func (w *taskWriter) appendTask(ctx context.Context, execution *types.WorkflowExecution,
 	taskInfo *persistence.TaskInfo) (*persistence.CreateTasksResponse, error) {
 
 	if w.isStopped() {
@@ -107,19 +127,62 @@ func (w *taskWriter) appendTask(execution *types.WorkflowExecution,
 		responseCh: ch,
 	}
 
+	startTime := time.Now()
+	if err := w.enqueue(ctx, req); err != nil {
+		return nil, err
+	}
+	select {
+	case r := <-ch:
+		w.tlMgr.metricScope().RecordTimer(metrics.TaskAppendLatencyPerTaskList, time.Since(startTime))
+		return r.persistenceResponse, r.err
+	case <-w.stopCh:
+		// if we are shutting down, this request will never make
+		// it to cassandra, just bail out and fail this request
+		return nil, errShutdown
+	}
+}
+
+// enqueue hands the request over to the writer loop, applying the overload shed policy
+// of the tasklist when too many appends are already outstanding
+func (w *taskWriter) enqueue(ctx context.Context, req *writeTaskRequest) error {
 	select {
 	case w.appendCh <- req:
+		return nil
+	default: // channel is full, shed load
+	}
+
+	scope := w.tlMgr.metricScope()
+	switch w.config.OverloadShedPolicy() {
+	case overloadShedOldestBuffered:
+		select {
+		case oldest := <-w.appendCh:
+			scope.IncCounter(metrics.OverloadShedOldestPerTaskListCounter)
+			select {
+			case oldest.responseCh <- &writeTaskResponse{err: errTooManyOutstandingAppends}:
+			case <-w.stopCh:
+				return errShutdown
+			}
+		default: // the writer loop drained the channel meanwhile
+		}
+		select {
+		case w.appendCh <- req:
+			return nil
+		default: // lost the freed slot to a concurrent add
+		}
+	case overloadBlockUntilDeadline:
+		sw := scope.StartTimer(metrics.OverloadBlockWaitPerTaskList)
+		defer sw.Stop()
 		select {
-		case r := <-ch:
-			return r.persistenceResponse, r.err
+		case w.appendCh <- req:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-w.stopCh:
-			// if we are shutting down, this request will never make
-			// it to cassandra, just bail out and fail this request
-			return nil, errShutdown
+			return errShutdown
 		}
-	default: // channel is full, throttle
-		return nil, createServiceBusyError("Too many outstanding appends to the TaskList")
 	}
+	scope.IncCounter(metrics.OverloadShedNewestPerTaskListCounter)
+	return errTooManyOutstandingAppends
 }
 
 func (w *taskWriter) GetMaxReadLevel() int64 {
@@ -153,6 +216,8 @@ writerLoop:
 				reqs := []*writeTaskRequest{request}
 				reqs = w.getWriteBatch(reqs)
 				batchSize := len(reqs)
+				w.lastBatchSize = batchSize
+				w.tlMgr.metricScope().RecordTimer(metrics.TaskAppendBatchSizePerTaskList, time.Duration(batchSize))
 
 				maxReadLevel := int64(0)
 
@@ -206,9 +271,13 @@ writerLoop:
 	}
 }
 
+// getWriteBatch groups pending appends into a single write, the batch is flushed
+// when it reaches MaxTaskBatchSize or when the flush interval expires, whichever comes first
 func (w *taskWriter) getWriteBatch(reqs []*writeTaskRequest) []*writeTaskRequest {
+	maxBatchSize := w.config.MaxTaskBatchSize()
+	count := 0
 readLoop:
-	for i := 0; i < w.config.MaxTaskBatchSize(); i++ {
+	for ; count < maxBatchSize; count++ {
 		select {
 		case req := <-w.appendCh:
 			reqs = append(reqs, req)
@@ -216,6 +285,26 @@ readLoop:
 			break readLoop
 		}
 	}
+
+	// only hold the batch open when the tasklist is under load, i.e. the previous
+	// batch grouped concurrent appends, so that low traffic tasklists are not delayed
+	flushInterval := w.config.TaskBatchFlushInterval()
+	if count >= maxBatchSize || flushInterval <= 0 || w.lastBatchSize <= 1 {
+		return reqs
+	}
+
+	timer := time.NewTimer(flushInterval)
+	defer timer.Stop()
+	for ; count < maxBatchSize; count++ {
+		select {
+		case req := <-w.appendCh:
+			reqs = append(reqs, req)
+		case <-timer.C:
+			return reqs
+		case <-w.stopCh:
+			return reqs
+		}
+	}
 	return reqs
 }
 
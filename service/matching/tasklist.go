@@ -95,6 +95,21 @@ func (tn *qualifiedTaskListName) Parent(degree int) string {
 	return tn.mkName(pid)
 }
 
+// Ancestors returns the names of all task lists on the path from
+// this task list to the root, starting with the parent and ending
+// with the root. Returns nil if this task list is the root
+func (tn *qualifiedTaskListName) Ancestors(degree int) []string {
+	if tn.IsRoot() || degree == 0 {
+		return nil
+	}
+	var names []string
+	for pid := tn.partition; pid != 0; {
+		pid = (pid+degree-1)/degree - 1
+		names = append(names, tn.mkName(pid))
+	}
+	return names
+}
+
 func (tn *qualifiedTaskListName) mkName(partition int) string {
 	if partition == 0 {
 		return tn.baseName
@@ -98,6 +98,29 @@ func TestTaskListParentName(t *testing.T) {
 	}
 }
 
+func TestTaskListAncestors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		degree int
+		output []string
+	}{
+		{"list0", 0, nil},
+		{"list0", 2, nil},
+		{"/__cadence_sys/list0/1", 0, nil},
+		{"/__cadence_sys/list0/1", 2, []string{"list0"}},
+		{"/__cadence_sys/list0/3", 1, []string{"/__cadence_sys/list0/2", "/__cadence_sys/list0/1", "list0"}},
+		{"/__cadence_sys/list0/6", 2, []string{"/__cadence_sys/list0/2", "list0"}},
+		{"/__cadence_sys/list0/13", 3, []string{"/__cadence_sys/list0/4", "/__cadence_sys/list0/1", "list0"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name+"#"+strconv.Itoa(tc.degree), func(t *testing.T) {
+			tn, err := newTaskListName(tc.name)
+			require.NoError(t, err)
+			require.Equal(t, tc.output, tn.Ancestors(tc.degree))
+		})
+	}
+}
+
 func TestInvalidTasklistNames(t *testing.T) {
 	inputs := []string{
 		"/__cadence_sys/",
@@ -90,6 +90,12 @@ func (t ThriftHandler) GetTaskListsByDomain(ctx context.Context, request *s.GetT
 	return thrift.FromGetTaskListsByDomainResponse(response), thrift.FromError(err)
 }
 
+// DescribeTaskListConfig forwards request to the underlying handler
+func (t ThriftHandler) DescribeTaskListConfig(ctx context.Context, request *m.DescribeTaskListConfigRequest) (*m.DescribeTaskListConfigResponse, error) {
+	response, err := t.h.DescribeTaskListConfig(ctx, thrift.ToMatchingDescribeTaskListConfigRequest(request))
+	return thrift.FromMatchingDescribeTaskListConfigResponse(response), thrift.FromError(err)
+}
+
 // PollForActivityTask forwards request to the underlying handler
 func (t ThriftHandler) PollForActivityTask(ctx context.Context, request *m.PollForActivityTaskRequest) (*s.PollForActivityTaskResponse, error) {
 	response, err := t.h.PollForActivityTask(ctx, thrift.ToMatchingPollForActivityTaskRequest(request))
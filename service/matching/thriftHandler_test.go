@@ -71,6 +71,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, s.DescribeTaskListResponse{}, *resp)
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("DescribeTaskListConfig", func(t *testing.T) {
+		h.EXPECT().DescribeTaskListConfig(ctx, &types.MatchingDescribeTaskListConfigRequest{}).Return(&types.DescribeTaskListConfigResponse{NumReadPartitions: 2}, internalErr).Times(1)
+		resp, err := th.DescribeTaskListConfig(ctx, &m.DescribeTaskListConfigRequest{})
+		assert.Equal(t, common.Int32Ptr(2), resp.NumReadPartitions)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ListTaskListPartitions", func(t *testing.T) {
 		h.EXPECT().ListTaskListPartitions(ctx, &types.MatchingListTaskListPartitionsRequest{}).Return(&types.ListTaskListPartitionsResponse{}, internalErr).Times(1)
 		resp, err := th.ListTaskListPartitions(ctx, &m.ListTaskListPartitionsRequest{})
@@ -0,0 +1,88 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"regexp"
+	"sync"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// zonePatterns caches the compiled poller zone patterns by their source, invalid patterns are cached as nil
+var zonePatterns sync.Map
+
+// pollerZone infers the isolation zone of a poller from its identity with the pattern. The zone is the first
+// capture group of the pattern, or the whole match when the pattern has none. Returns empty when unknown
+func pollerZone(identity string, pattern string) string {
+	re := compileZonePattern(pattern)
+	if re == nil {
+		return ""
+	}
+	match := re.FindStringSubmatch(identity)
+	switch len(match) {
+	case 0:
+		return ""
+	case 1:
+		return match[0]
+	default:
+		return match[1]
+	}
+}
+
+// pollerZones returns the isolation zones of the pollers, pollers in an unknown zone are left out
+func pollerZones(pollers []*types.PollerInfo, pattern string) map[string]struct{} {
+	if pattern == "" {
+		return nil
+	}
+	zones := make(map[string]struct{})
+	for _, poller := range pollers {
+		if zone := pollerZone(poller.GetIdentity(), pattern); zone != "" {
+			zones[zone] = struct{}{}
+		}
+	}
+	return zones
+}
+
+// sharesZone returns true when both sets have a zone in common
+func sharesZone(a, b map[string]struct{}) bool {
+	for zone := range a {
+		if _, ok := b[zone]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func compileZonePattern(pattern string) *regexp.Regexp {
+	if pattern == "" {
+		return nil
+	}
+	if re, ok := zonePatterns.Load(pattern); ok {
+		return re.(*regexp.Regexp)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		re = nil
+	}
+	zonePatterns.Store(pattern, re)
+	return re
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestPollerZone(t *testing.T) {
+	assert.Equal(t, "", pollerZone("1@host.zone-a", ""))
+	assert.Equal(t, "", pollerZone("1@host.zone-a", "zone-["))
+	assert.Equal(t, "zone-a", pollerZone("1@host.zone-a", `zone-[a-z]`))
+	assert.Equal(t, "a", pollerZone("1@host.zone-a", `zone-([a-z])`))
+	assert.Equal(t, "", pollerZone("1@host", `zone-([a-z])`))
+}
+
+func TestPollerZones(t *testing.T) {
+	pollers := []*types.PollerInfo{
+		{Identity: "1@host.zone-a"},
+		{Identity: "2@host.zone-b"},
+		{Identity: "3@host.zone-a"},
+		{Identity: "4@host"},
+	}
+	assert.Nil(t, pollerZones(pollers, ""))
+	zones := pollerZones(pollers, `zone-[a-z]`)
+	assert.Equal(t, map[string]struct{}{"zone-a": {}, "zone-b": {}}, zones)
+	assert.True(t, sharesZone(zones, map[string]struct{}{"zone-b": {}}))
+	assert.False(t, sharesZone(zones, map[string]struct{}{"zone-c": {}}))
+}
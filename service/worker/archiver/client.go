@@ -67,6 +67,7 @@ type (
 		NextEventID          int64
 		CloseFailoverVersion int64
 		URI                  string // should be historyURI, but keep the existing name for backward compatibility
+		HistoryProvider      string // name of the custom history archiver provider, empty for the default one
 
 		// visibility archival
 		WorkflowTypeName   string
@@ -78,6 +79,7 @@ type (
 		Memo               *types.Memo
 		SearchAttributes   map[string][]byte
 		VisibilityURI      string
+		VisibilityProvider string // name of the custom visibility archiver provider, empty for the default one
 
 		// archival targets: history and/or visibility
 		Targets []ArchivalTarget
@@ -220,7 +222,7 @@ func (c *client) archiveHistoryInline(ctx context.Context, request *ClientReques
 		return
 	}
 
-	historyArchiver, err := c.archiverProvider.GetHistoryArchiver(URI.Scheme(), request.CallerService)
+	historyArchiver, err := c.archiverProvider.GetHistoryArchiver(URI.Scheme(), request.ArchiveRequest.HistoryProvider, request.CallerService)
 	if err != nil {
 		return
 	}
@@ -262,7 +264,7 @@ func (c *client) archiveVisibilityInline(ctx context.Context, request *ClientReq
 		return
 	}
 
-	visibilityArchiver, err := c.archiverProvider.GetVisibilityArchiver(URI.Scheme(), request.CallerService)
+	visibilityArchiver, err := c.archiverProvider.GetVisibilityArchiver(URI.Scheme(), request.ArchiveRequest.VisibilityProvider, request.CallerService)
 	if err != nil {
 		return
 	}
@@ -88,7 +88,7 @@ func (s *clientSuite) TearDownTest() {
 }
 
 func (s *clientSuite) TestArchiveVisibilityInlineSuccess() {
-	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
 	s.visibilityArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientVisibilityRequestCount).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientVisibilityInlineArchiveAttemptCount).Once()
@@ -106,7 +106,7 @@ func (s *clientSuite) TestArchiveVisibilityInlineSuccess() {
 }
 
 func (s *clientSuite) TestArchiveVisibilityInlineThrottled() {
-	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
 	s.visibilityArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientVisibilityRequestCount).Times(2)
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientVisibilityInlineArchiveAttemptCount).Once()
@@ -130,7 +130,7 @@ func (s *clientSuite) TestArchiveVisibilityInlineThrottled() {
 }
 
 func (s *clientSuite) TestArchiveVisibilityInlineFail_SendSignalSuccess() {
-	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
 	s.visibilityArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientVisibilityRequestCount).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientVisibilityInlineArchiveAttemptCount).Once()
@@ -153,7 +153,7 @@ func (s *clientSuite) TestArchiveVisibilityInlineFail_SendSignalSuccess() {
 }
 
 func (s *clientSuite) TestArchiveVisibilityInlineFail_SendSignalFail() {
-	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
 	s.visibilityArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientVisibilityRequestCount).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientVisibilityInlineArchiveAttemptCount).Once()
@@ -176,7 +176,7 @@ func (s *clientSuite) TestArchiveVisibilityInlineFail_SendSignalFail() {
 }
 
 func (s *clientSuite) TestArchiveHistoryInlineSuccess() {
-	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
 	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryRequestCount).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryInlineArchiveAttemptCount).Once()
@@ -194,7 +194,7 @@ func (s *clientSuite) TestArchiveHistoryInlineSuccess() {
 }
 
 func (s *clientSuite) TestArchiveHistoryInlineThrottled() {
-	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
 	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryRequestCount).Times(2)
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryInlineArchiveAttemptCount).Once()
@@ -218,7 +218,7 @@ func (s *clientSuite) TestArchiveHistoryInlineThrottled() {
 }
 
 func (s *clientSuite) TestArchiveHistoryInlineFail_SendSignalSuccess() {
-	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
 	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryRequestCount).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryInlineArchiveAttemptCount).Once()
@@ -241,7 +241,7 @@ func (s *clientSuite) TestArchiveHistoryInlineFail_SendSignalSuccess() {
 }
 
 func (s *clientSuite) TestArchiveHistoryInlineFail_SendSignalFail() {
-	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
 	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryRequestCount).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryInlineArchiveAttemptCount).Once()
@@ -264,8 +264,8 @@ func (s *clientSuite) TestArchiveHistoryInlineFail_SendSignalFail() {
 }
 
 func (s *clientSuite) TestArchiveInline_HistoryFail_VisibilitySuccess() {
-	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
-	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
 	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
 	s.visibilityArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryRequestCount).Once()
@@ -292,8 +292,8 @@ func (s *clientSuite) TestArchiveInline_HistoryFail_VisibilitySuccess() {
 }
 
 func (s *clientSuite) TestArchiveInline_VisibilityFail_HistorySuccess() {
-	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
-	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
 	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	s.visibilityArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryRequestCount).Once()
@@ -320,8 +320,8 @@ func (s *clientSuite) TestArchiveInline_VisibilityFail_HistorySuccess() {
 }
 
 func (s *clientSuite) TestArchiveInline_VisibilityFail_HistoryFail() {
-	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
-	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
 	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
 	s.visibilityArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some random error")).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryRequestCount).Once()
@@ -349,8 +349,8 @@ func (s *clientSuite) TestArchiveInline_VisibilityFail_HistoryFail() {
 }
 
 func (s *clientSuite) TestArchiveInline_VisibilitySuccess_HistorySuccess() {
-	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
-	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
+	s.archiverProvider.On("GetHistoryArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.historyArchiver, nil).Once()
+	s.archiverProvider.On("GetVisibilityArchiver", mock.Anything, mock.Anything, mock.Anything).Return(s.visibilityArchiver, nil).Once()
 	s.historyArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	s.visibilityArchiver.On("Archive", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	s.metricsScope.On("IncCounter", metrics.ArchiverClientHistoryRequestCount).Once()
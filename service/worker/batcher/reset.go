@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"errors"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// ResetTypeFirstDecisionCompleted resets to the first completed decision
+	ResetTypeFirstDecisionCompleted = "FirstDecisionCompleted"
+	// ResetTypeLastDecisionCompleted resets to the last completed decision
+	ResetTypeLastDecisionCompleted = "LastDecisionCompleted"
+	// ResetTypeFirstDecisionScheduled resets to the first scheduled decision
+	ResetTypeFirstDecisionScheduled = "FirstDecisionScheduled"
+	// ResetTypeLastDecisionScheduled resets to the last scheduled decision
+	ResetTypeLastDecisionScheduled = "LastDecisionScheduled"
+)
+
+// AllResetTypes is the reset types supported by BatchTypeReset
+var AllResetTypes = []string{
+	ResetTypeFirstDecisionCompleted,
+	ResetTypeLastDecisionCompleted,
+	ResetTypeFirstDecisionScheduled,
+	ResetTypeLastDecisionScheduled,
+}
+
+// errNoResetPoint is returned when the history has no decision to reset to, retrying would not help
+var errNoResetPoint = errors.New("no reset point found in workflow history")
+
+func isValidResetType(resetType string) bool {
+	for _, t := range AllResetTypes {
+		if t == resetType {
+			return true
+		}
+	}
+	return false
+}
+
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	requestID string,
+) error {
+	decisionFinishID, err := getResetEventID(ctx, client, batchParams.DomainName, workflowID, runID, batchParams.ResetParams.ResetType)
+	if err != nil {
+		return err
+	}
+	_, err = client.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+		Domain: batchParams.DomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		Reason:                batchParams.Reason,
+		DecisionFinishEventID: decisionFinishID,
+		RequestID:             requestID,
+		SkipSignalReapply:     batchParams.ResetParams.SkipSignalReapply,
+	})
+	return err
+}
+
+// getResetEventID returns the DecisionFinishEventID to reset the run to for the reset type
+func getResetEventID(
+	ctx context.Context,
+	client frontend.Client,
+	domain string,
+	workflowID string,
+	runID string,
+	resetType string,
+) (int64, error) {
+	eventType := types.EventTypeDecisionTaskCompleted
+	if resetType == ResetTypeFirstDecisionScheduled || resetType == ResetTypeLastDecisionScheduled {
+		eventType = types.EventTypeDecisionTaskScheduled
+	}
+	first := resetType == ResetTypeFirstDecisionCompleted || resetType == ResetTypeFirstDecisionScheduled
+
+	request := &types.GetWorkflowExecutionHistoryRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: workflowID,
+			RunID:      runID,
+		},
+		MaximumPageSize: DefaultPageSize,
+	}
+	var eventID int64
+HistoryLoop:
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, request)
+		if err != nil {
+			return 0, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if e.GetEventType() == eventType {
+				eventID = e.ID
+				if first {
+					break HistoryLoop
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+
+	if eventID == 0 {
+		return 0, errNoResetPoint
+	}
+	if eventType == types.EventTypeDecisionTaskScheduled {
+		// DecisionFinishEventID is exclusive
+		eventID++
+	}
+	return eventID, nil
+}
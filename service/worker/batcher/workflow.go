@@ -77,10 +77,12 @@ const (
 	BatchTypeSignal = "signal"
 	// BatchTypeReplicate is batch type for replicating workflows
 	BatchTypeReplicate = "replicate"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypeReplicate, BatchTypeReset}
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -111,6 +113,14 @@ type (
 		TargetCluster string
 	}
 
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// ResetType is where to reset, one of AllResetTypes
+		ResetType string
+		// this indicates whether to skip reapplying the signals after the reset point
+		SkipSignalReapply bool
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target domain to execute batch operation
@@ -131,6 +141,8 @@ type (
 		SignalParams SignalParams
 		// ReplicateParams is params only for BatchTypeReplicate
 		ReplicateParams ReplicateParams
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
 		// RPS of processing. Default to DefaultRPS
 		// TODO we will implement smarter way than this static rate limiter: https://github.com/uber/cadence/issues/2138
 		RPS int
@@ -224,6 +236,11 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide target cluster")
 		}
 		return nil
+	case BatchTypeReset:
+		if !isValidResetType(params.ResetParams.ResetType) {
+			return fmt.Errorf("not supported reset type: %v", params.ResetParams.ResetType)
+		}
+		return nil
 	case BatchTypeCancel:
 		fallthrough
 	case BatchTypeTerminate:
@@ -447,13 +464,18 @@ func startTaskProcessor(
 							RemoteCluster: batchParams.ReplicateParams.SourceCluster,
 						})
 					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, common.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID, requestID)
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
-				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
+				if ok || err == errNoResetPoint || task.attempts >= batchParams.AttemptsOnRetryableError {
 					respCh <- err
 				} else {
 					// put back to the channel if less than attemptsOnError
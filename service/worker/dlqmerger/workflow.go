@@ -0,0 +1,376 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dlqmerger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	dlqMergerContextKey contextKey = "dlqMergerContext"
+	// TaskListName tasklist
+	TaskListName = "cadence-sys-dlqMerger-tasklist"
+	// WorkflowTypeName workflow type name
+	WorkflowTypeName = "cadence-sys-dlqMerger-workflow"
+	// WorkflowIDPrefix is the prefix of merge workflow IDs, see GetWorkflowID
+	WorkflowIDPrefix  = "cadence-dlq-merger"
+	mergeActivityName = "cadence-sys-dlqMerger-merge-activity"
+
+	// QueryType for merge workflow
+	QueryType = "state"
+
+	// workflow states for query
+
+	// WorkflowRunning state
+	WorkflowRunning = "running"
+	// WorkflowWaiting state, merging is paused until the window opens
+	WorkflowWaiting = "waiting for window"
+	// WorkflowCompleted state
+	WorkflowCompleted = "complete"
+
+	defaultPageSize = 1000
+	// maxActivityDuration bounds a single merge activity so the window is re-checked regularly
+	maxActivityDuration = 5 * time.Minute
+	// maxActivitiesPerRun bounds the history size of a run before it continues as new
+	maxActivitiesPerRun = 500
+
+	errMsgParamsIsNil          = "params is nil"
+	errMsgSourceClusterIsEmpty = "sourceCluster is empty"
+	errMsgRPSIsNegative        = "rps is negative"
+)
+
+type (
+	// MergeWindow is a daily UTC time range [StartMinute, EndMinute) in which merges are allowed to run.
+	// The range wraps around midnight when StartMinute is larger than EndMinute.
+	MergeWindow struct {
+		StartMinute int
+		EndMinute   int
+	}
+
+	// MergeParams is the arg for MergeWorkflow
+	MergeParams struct {
+		Type          types.DLQType
+		SourceCluster string
+		// Shards are the shards left to merge, the first one is in progress
+		Shards []int
+		// NextPageToken is the merge progress of the first shard
+		NextPageToken         []byte
+		InclusiveEndMessageID *int64
+		// Window limits merges to off-peak hours, merges run at any time when it is nil
+		Window *MergeWindow
+		// RPS caps the number of merged messages per second, zero means no limit
+		RPS int
+		// MergedShards and FailedShards carry the progress across continue-as-new
+		MergedShards []int
+		FailedShards []int
+	}
+
+	// MergeResult is workflow result
+	MergeResult struct {
+		MergedShards []int
+		FailedShards []int
+	}
+
+	// MergeActivityParams params for merge activity
+	MergeActivityParams struct {
+		Type                  types.DLQType
+		SourceCluster         string
+		ShardID               int
+		NextPageToken         []byte
+		InclusiveEndMessageID *int64
+		RPS                   int
+		// Deadline is when the activity stops merging and hands the progress back to the workflow
+		Deadline time.Time
+	}
+
+	// MergeActivityResult result for merge activity
+	MergeActivityResult struct {
+		// Completed is true when all messages of the shard are merged
+		Completed     bool
+		NextPageToken []byte
+	}
+
+	// QueryResult for merge progress
+	QueryResult struct {
+		State         string
+		Type          types.DLQType
+		SourceCluster string
+		Window        string
+		RPS           int
+		PendingShards []int
+		MergedShards  []int
+		FailedShards  []int
+	}
+)
+
+// GetWorkflowID returns the merge workflow ID, only one merge runs per DLQ type and source cluster
+func GetWorkflowID(dlqType types.DLQType, sourceCluster string) string {
+	return fmt.Sprintf("%s-%s-%s", WorkflowIDPrefix, strings.ToLower(dlqType.String()), sourceCluster)
+}
+
+// ParseMergeWindow parses a window in HH:MM-HH:MM format, times are in UTC
+func ParseMergeWindow(window string) (*MergeWindow, error) {
+	parts := strings.Split(window, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid window %q, expected format HH:MM-HH:MM", window)
+	}
+	start, err := parseMinuteOfDay(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid window start %q: %v", parts[0], err)
+	}
+	end, err := parseMinuteOfDay(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid window end %q: %v", parts[1], err)
+	}
+	if start == end {
+		return nil, fmt.Errorf("invalid window %q, start and end are the same", window)
+	}
+	return &MergeWindow{
+		StartMinute: start,
+		EndMinute:   end,
+	}, nil
+}
+
+func parseMinuteOfDay(value string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func (w *MergeWindow) String() string {
+	if w == nil {
+		return "always"
+	}
+	return fmt.Sprintf("%02d:%02d-%02d:%02d UTC", w.StartMinute/60, w.StartMinute%60, w.EndMinute/60, w.EndMinute%60)
+}
+
+func (w *MergeWindow) contains(now time.Time) bool {
+	if w == nil {
+		return true
+	}
+	now = now.UTC()
+	minute := now.Hour()*60 + now.Minute()
+	if w.StartMinute < w.EndMinute {
+		return minute >= w.StartMinute && minute < w.EndMinute
+	}
+	return minute >= w.StartMinute || minute < w.EndMinute
+}
+
+// untilOpen returns how long to wait for the window to open, zero if it is open
+func (w *MergeWindow) untilOpen(now time.Time) time.Duration {
+	if w.contains(now) {
+		return 0
+	}
+	return untilMinuteOfDay(now, w.StartMinute)
+}
+
+// untilClose returns how long the window stays open, it is only meaningful while the window is open
+func (w *MergeWindow) untilClose(now time.Time) time.Duration {
+	return untilMinuteOfDay(now, w.EndMinute)
+}
+
+func untilMinuteOfDay(now time.Time, minuteOfDay int) time.Duration {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(time.Duration(minuteOfDay) * time.Minute)
+	if !next.After(now) {
+		next = next.Add(24 * time.Hour)
+	}
+	return next.Sub(now)
+}
+
+// MergeWorkflow merges DLQ messages shard by shard, throttled to params.RPS and paused outside of params.Window
+func MergeWorkflow(ctx workflow.Context, params *MergeParams) (*MergeResult, error) {
+	err := validateParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	wfState := WorkflowRunning
+	err = workflow.SetQueryHandler(ctx, QueryType, func(input []byte) (*QueryResult, error) {
+		return &QueryResult{
+			State:         wfState,
+			Type:          params.Type,
+			SourceCluster: params.SourceCluster,
+			Window:        params.Window.String(),
+			RPS:           params.RPS,
+			PendingShards: params.Shards,
+			MergedShards:  params.MergedShards,
+			FailedShards:  params.FailedShards,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger := workflow.GetLogger(ctx)
+	ao := workflow.WithActivityOptions(ctx, getMergeActivityOptions())
+	for iteration := 0; len(params.Shards) > 0; iteration++ {
+		if iteration >= maxActivitiesPerRun {
+			return nil, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, params)
+		}
+
+		if wait := params.Window.untilOpen(workflow.Now(ctx)); wait > 0 {
+			wfState = WorkflowWaiting
+			if err := workflow.Sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+		wfState = WorkflowRunning
+
+		now := workflow.Now(ctx)
+		activityDuration := maxActivityDuration
+		if params.Window != nil {
+			activityDuration = common.MinDuration(activityDuration, params.Window.untilClose(now))
+		}
+		shardID := params.Shards[0]
+		activityParams := &MergeActivityParams{
+			Type:                  params.Type,
+			SourceCluster:         params.SourceCluster,
+			ShardID:               shardID,
+			NextPageToken:         params.NextPageToken,
+			InclusiveEndMessageID: params.InclusiveEndMessageID,
+			RPS:                   params.RPS,
+			Deadline:              now.Add(activityDuration),
+		}
+		var activityResult MergeActivityResult
+		err := workflow.ExecuteActivity(ao, MergeActivity, activityParams).Get(ctx, &activityResult)
+		switch {
+		case err != nil:
+			logger.Error("Failed to merge DLQ messages", zap.Int("shardID", shardID), zap.Error(err))
+			params.FailedShards = append(params.FailedShards, shardID)
+		case !activityResult.Completed:
+			params.NextPageToken = activityResult.NextPageToken
+			continue
+		default:
+			params.MergedShards = append(params.MergedShards, shardID)
+		}
+		params.Shards = params.Shards[1:]
+		params.NextPageToken = nil
+	}
+
+	wfState = WorkflowCompleted
+	return &MergeResult{
+		MergedShards: params.MergedShards,
+		FailedShards: params.FailedShards,
+	}, nil
+}
+
+func validateParams(params *MergeParams) error {
+	if params == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if len(params.SourceCluster) == 0 {
+		return errors.New(errMsgSourceClusterIsEmpty)
+	}
+	if params.RPS < 0 {
+		return errors.New(errMsgRPSIsNegative)
+	}
+	return nil
+}
+
+func getMergeActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    maxActivityDuration + time.Minute,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: 10 * time.Minute,
+		},
+	}
+}
+
+// MergeActivity merges pages of DLQ messages of one shard until the shard is drained or the deadline is reached
+func MergeActivity(ctx context.Context, params *MergeActivityParams) (*MergeActivityResult, error) {
+	adminClient := getAdminClient(ctx)
+
+	pageSize := defaultPageSize
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if params.RPS > 0 {
+		pageSize = common.MinInt(params.RPS, defaultPageSize)
+		limiter = rate.NewLimiter(rate.Limit(params.RPS), pageSize)
+	}
+
+	// resume from the last merged page when the activity is retried
+	token := params.NextPageToken
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &token); err != nil {
+			return nil, err
+		}
+	}
+
+	deadlineCtx, cancel := context.WithDeadline(ctx, params.Deadline)
+	defer cancel()
+	request := &types.MergeDLQMessagesRequest{
+		Type:                  params.Type.Ptr(),
+		SourceCluster:         params.SourceCluster,
+		ShardID:               int32(params.ShardID),
+		InclusiveEndMessageID: params.InclusiveEndMessageID,
+		MaximumPageSize:       int32(pageSize),
+	}
+	for {
+		if err := limiter.WaitN(deadlineCtx, pageSize); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// the deadline is reached, hand the progress back to the workflow
+			return &MergeActivityResult{NextPageToken: token}, nil
+		}
+
+		request.NextPageToken = token
+		response, err := adminClient.MergeDLQMessages(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+		token = response.GetNextPageToken()
+		if len(token) == 0 {
+			return &MergeActivityResult{Completed: true}, nil
+		}
+		activity.RecordHeartbeat(ctx, token)
+	}
+}
+
+func getAdminClient(ctx context.Context) admin.Client {
+	merger := ctx.Value(dlqMergerContextKey).(*DLQMerger)
+	return merger.clientBean.GetRemoteAdminClient(merger.cfg.ClusterMetadata.GetCurrentClusterName())
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package dlqmerger
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/types"
+)
+
+type dlqMergerWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	controller      *gomock.Controller
+	mockAdminClient *admin.MockClient
+	activityEnv     *testsuite.TestActivityEnvironment
+	workflowEnv     *testsuite.TestWorkflowEnvironment
+}
+
+func TestDLQMergerWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(dlqMergerWorkflowTestSuite))
+}
+
+func (s *dlqMergerWorkflowTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockAdminClient = admin.NewMockClient(s.controller)
+	clientBean := client.NewMockBean(s.controller)
+	clientBean.EXPECT().GetRemoteAdminClient(cluster.TestCurrentClusterName).Return(s.mockAdminClient).AnyTimes()
+	merger := &DLQMerger{
+		cfg:        Config{ClusterMetadata: cluster.GetTestClusterMetadata(true, true)},
+		clientBean: clientBean,
+	}
+
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), dlqMergerContextKey, merger),
+	})
+	s.activityEnv.RegisterActivityWithOptions(MergeActivity, activity.RegisterOptions{Name: mergeActivityName})
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(MergeWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(MergeActivity, activity.RegisterOptions{Name: mergeActivityName})
+}
+
+func (s *dlqMergerWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *dlqMergerWorkflowTestSuite) TestParseMergeWindow() {
+	window, err := ParseMergeWindow("02:00-05:30")
+	s.NoError(err)
+	s.Equal(&MergeWindow{StartMinute: 120, EndMinute: 330}, window)
+	s.Equal("02:00-05:30 UTC", window.String())
+
+	window, err = ParseMergeWindow(" 22:00 - 02:00 ")
+	s.NoError(err)
+	s.Equal(&MergeWindow{StartMinute: 1320, EndMinute: 120}, window)
+
+	for _, invalid := range []string{"", "02:00", "02:00-05:00-06:00", "25:00-05:00", "02:00-02:00"} {
+		_, err := ParseMergeWindow(invalid)
+		s.Error(err, invalid)
+	}
+}
+
+func (s *dlqMergerWorkflowTestSuite) TestMergeWindow() {
+	day := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	window := &MergeWindow{StartMinute: 120, EndMinute: 300}
+	s.Equal(30*time.Minute, window.untilOpen(day.Add(90*time.Minute)))
+	s.Equal(time.Duration(0), window.untilOpen(day.Add(3*time.Hour)))
+	s.Equal(2*time.Hour, window.untilClose(day.Add(3*time.Hour)))
+	s.Equal(20*time.Hour, window.untilOpen(day.Add(6*time.Hour)))
+
+	wrapped := &MergeWindow{StartMinute: 1320, EndMinute: 120}
+	s.Equal(time.Duration(0), wrapped.untilOpen(day.Add(23*time.Hour)))
+	s.Equal(time.Duration(0), wrapped.untilOpen(day.Add(time.Hour)))
+	s.Equal(time.Hour, wrapped.untilClose(day.Add(time.Hour)))
+	s.Equal(3*time.Hour, wrapped.untilOpen(day.Add(19*time.Hour)))
+
+	var always *MergeWindow
+	s.Equal(time.Duration(0), always.untilOpen(day))
+	s.Equal("always", always.String())
+}
+
+func (s *dlqMergerWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MergeParams{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *dlqMergerWorkflowTestSuite) TestWorkflow_WaitsForWindow() {
+	startTime := time.Date(2021, 6, 1, 1, 0, 0, 0, time.UTC)
+	s.workflowEnv.SetStartTime(startTime)
+	s.workflowEnv.OnActivity(mergeActivityName, mock.Anything, mock.Anything).Return(
+		func(ctx context.Context, params *MergeActivityParams) (*MergeActivityResult, error) {
+			// the window opens at 02:00 and each activity runs for at most maxActivityDuration
+			s.Equal(startTime.Add(time.Hour+maxActivityDuration), params.Deadline.UTC())
+			s.Equal(10, params.RPS)
+			return &MergeActivityResult{Completed: true}, nil
+		}).Times(2)
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MergeParams{
+		Type:          types.DLQTypeReplication,
+		SourceCluster: "standby",
+		Shards:        []int{1, 2},
+		Window:        &MergeWindow{StartMinute: 120, EndMinute: 180},
+		RPS:           10,
+	})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var result MergeResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal([]int{1, 2}, result.MergedShards)
+	s.Empty(result.FailedShards)
+}
+
+func (s *dlqMergerWorkflowTestSuite) TestWorkflow_ResumesPageTokenAndSkipsFailedShard() {
+	token := []byte("token")
+	s.workflowEnv.OnActivity(mergeActivityName, mock.Anything, mock.MatchedBy(func(params *MergeActivityParams) bool {
+		return params.ShardID == 1 && params.NextPageToken == nil
+	})).Return(&MergeActivityResult{NextPageToken: token}, nil).Once()
+	s.workflowEnv.OnActivity(mergeActivityName, mock.Anything, mock.MatchedBy(func(params *MergeActivityParams) bool {
+		return params.ShardID == 1 && string(params.NextPageToken) == string(token)
+	})).Return(&MergeActivityResult{Completed: true}, nil).Once()
+	s.workflowEnv.OnActivity(mergeActivityName, mock.Anything, mock.MatchedBy(func(params *MergeActivityParams) bool {
+		return params.ShardID == 2
+	})).Return(nil, errors.New("mockErr"))
+
+	s.workflowEnv.ExecuteWorkflow(WorkflowTypeName, &MergeParams{
+		Type:          types.DLQTypeDomain,
+		SourceCluster: "standby",
+		Shards:        []int{1, 2},
+	})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var result MergeResult
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal([]int{1}, result.MergedShards)
+	s.Equal([]int{2}, result.FailedShards)
+}
+
+func (s *dlqMergerWorkflowTestSuite) TestMergeActivity() {
+	lastMessageID := common.Int64Ptr(100)
+	s.mockAdminClient.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.MergeDLQMessagesRequest, opts ...yarpc.CallOption) (*types.MergeDLQMessagesResponse, error) {
+			s.Equal(types.DLQTypeReplication, request.GetType())
+			s.Equal(int32(3), request.ShardID)
+			s.Equal(lastMessageID, request.InclusiveEndMessageID)
+			s.Equal(int32(50), request.MaximumPageSize)
+			s.Nil(request.NextPageToken)
+			return &types.MergeDLQMessagesResponse{NextPageToken: []byte("token")}, nil
+		})
+	s.mockAdminClient.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.MergeDLQMessagesRequest, opts ...yarpc.CallOption) (*types.MergeDLQMessagesResponse, error) {
+			s.Equal([]byte("token"), request.NextPageToken)
+			return &types.MergeDLQMessagesResponse{}, nil
+		})
+
+	value, err := s.activityEnv.ExecuteActivity(mergeActivityName, &MergeActivityParams{
+		Type:                  types.DLQTypeReplication,
+		SourceCluster:         "standby",
+		ShardID:               3,
+		InclusiveEndMessageID: lastMessageID,
+		RPS:                   50,
+		Deadline:              time.Now().Add(time.Minute),
+	})
+	s.NoError(err)
+	var result MergeActivityResult
+	s.NoError(value.Get(&result))
+	s.True(result.Completed)
+}
+
+func (s *dlqMergerWorkflowTestSuite) TestMergeActivity_DeadlineReached() {
+	value, err := s.activityEnv.ExecuteActivity(mergeActivityName, &MergeActivityParams{
+		Type:          types.DLQTypeDomain,
+		SourceCluster: "standby",
+		NextPageToken: []byte("token"),
+		Deadline:      time.Now().Add(-time.Minute),
+	})
+	s.NoError(err)
+	var result MergeActivityResult
+	s.NoError(value.Get(&result))
+	s.False(result.Completed)
+	s.Equal([]byte("token"), result.NextPageToken)
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainprovisioning
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/domain"
+	"github.com/uber/cadence/common/domain/provisioning"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the domain provisioner
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// DomainManager is used to load and update the provisioned domain
+		DomainManager persistence.DomainManager
+		// Steps are the cluster specific provisioning steps, run in order
+		Steps  []domain.ProvisioningStep
+		Logger log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+	}
+
+	// Provisioner is the background sub-system that completes asynchronous domain registrations
+	Provisioner struct {
+		svcClient     workflowserviceclient.Interface
+		domainManager persistence.DomainManager
+		steps         []domain.ProvisioningStep
+		timeSource    clock.TimeSource
+		tallyScope    tally.Scope
+		logger        log.Logger
+		worker        worker.Worker
+	}
+)
+
+// New returns a new instance of Provisioner
+func New(params *BootstrapParams) *Provisioner {
+	return &Provisioner{
+		svcClient:     params.ServiceClient,
+		domainManager: params.DomainManager,
+		steps:         params.Steps,
+		timeSource:    clock.NewRealTimeSource(),
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentDomainProvisioner),
+	}
+}
+
+// Start starts the worker
+func (s *Provisioner) Start() error {
+	ctx := context.WithValue(context.Background(), provisionerContextKey, s)
+	workerOpts := worker.Options{
+		MetricsScope:              s.tallyScope,
+		BackgroundActivityContext: ctx,
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	provisionerWorker := worker.New(s.svcClient, common.SystemLocalDomainName, provisioning.TaskListName, workerOpts)
+	provisionerWorker.RegisterWorkflowWithOptions(ProvisioningWorkflow, workflow.RegisterOptions{Name: provisioning.WorkflowTypeName})
+	provisionerWorker.RegisterActivityWithOptions(ProvisionActivity, activity.RegisterOptions{Name: provisionActivityName})
+	provisionerWorker.RegisterActivityWithOptions(MarkRegisteredActivity, activity.RegisterOptions{Name: markRegisteredActivityName})
+	s.worker = provisionerWorker
+	return provisionerWorker.Start()
+}
+
+// Stop stops the worker
+func (s *Provisioner) Stop() {
+	s.worker.Stop()
+}
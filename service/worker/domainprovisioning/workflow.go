@@ -0,0 +1,147 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package domainprovisioning
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+
+	"github.com/uber/cadence/common/domain/provisioning"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	contextKey string
+)
+
+const (
+	provisionerContextKey contextKey = "domainProvisionerContext"
+
+	provisionActivityName      = "cadence-sys-domain-provisioning-provision-activity"
+	markRegisteredActivityName = "cadence-sys-domain-provisioning-mark-registered-activity"
+)
+
+var (
+	retryPolicy = cadence.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: provisioning.WorkflowTimeout,
+	}
+
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    5 * time.Minute,
+		RetryPolicy:            &retryPolicy,
+	}
+)
+
+// ProvisioningWorkflow runs all provisioning steps for a PROVISIONING domain
+// and then marks the domain as REGISTERED
+func ProvisioningWorkflow(ctx workflow.Context, request provisioning.Request) error {
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+	if err := workflow.ExecuteActivity(ctx, provisionActivityName, request).Get(ctx, nil); err != nil {
+		return err
+	}
+	return workflow.ExecuteActivity(ctx, markRegisteredActivityName, request).Get(ctx, nil)
+}
+
+// ProvisionActivity runs the configured provisioning steps for the domain,
+// all steps must be idempotent as the activity is retried as a whole
+func ProvisionActivity(ctx context.Context, request provisioning.Request) error {
+	provisioner := ctx.Value(provisionerContextKey).(*Provisioner)
+	logger := getActivityLogger(ctx, request)
+
+	resp, err := provisioner.domainManager.GetDomain(ctx, &persistence.GetDomainRequest{ID: request.DomainID})
+	if err != nil {
+		logger.Error("failed to load domain for provisioning", tag.Error(err))
+		return err
+	}
+	if resp.Info.Status != persistence.DomainStatusProvisioning {
+		logger.Info("domain is no longer provisioning, skip provisioning steps")
+		return nil
+	}
+
+	for _, step := range provisioner.steps {
+		if err := step.Provision(ctx, resp); err != nil {
+			logger.Error("domain provisioning step failed", tag.Value(step.Name()), tag.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
+// MarkRegisteredActivity flips the domain status from PROVISIONING to REGISTERED
+func MarkRegisteredActivity(ctx context.Context, request provisioning.Request) error {
+	provisioner := ctx.Value(provisionerContextKey).(*Provisioner)
+	domainManager := provisioner.domainManager
+	logger := getActivityLogger(ctx, request)
+
+	// must get the metadata (notificationVersion) first, see domain handler
+	metadata, err := domainManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := domainManager.GetDomain(ctx, &persistence.GetDomainRequest{ID: request.DomainID})
+	if err != nil {
+		return err
+	}
+	if resp.Info.Status != persistence.DomainStatusProvisioning {
+		return nil
+	}
+
+	resp.Info.Status = persistence.DomainStatusRegistered
+	err = domainManager.UpdateDomain(ctx, &persistence.UpdateDomainRequest{
+		Info:                        resp.Info,
+		Config:                      resp.Config,
+		ReplicationConfig:           resp.ReplicationConfig,
+		ConfigVersion:               resp.ConfigVersion + 1,
+		FailoverVersion:             resp.FailoverVersion,
+		FailoverNotificationVersion: resp.FailoverNotificationVersion,
+		FailoverEndTime:             resp.FailoverEndTime,
+		PreviousFailoverVersion:     resp.PreviousFailoverVersion,
+		LastUpdatedTime:             provisioner.timeSource.Now().UnixNano(),
+		NotificationVersion:         metadata.NotificationVersion,
+	})
+	if err != nil {
+		logger.Error("failed to mark domain as registered", tag.Error(err))
+		return err
+	}
+	logger.Info("domain provisioning completed")
+	return nil
+}
+
+func getActivityLogger(ctx context.Context, request provisioning.Request) log.Logger {
+	provisioner := ctx.Value(provisionerContextKey).(*Provisioner)
+	wfInfo := activity.GetInfo(ctx)
+	return provisioner.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+		tag.WorkflowDomainID(request.DomainID),
+		tag.WorkflowDomainName(request.DomainName),
+	)
+}
@@ -57,6 +57,10 @@ const (
 	failoverActivityName            = "cadence-sys-failover-activity"
 	getDomainsActivityName          = "cadence-sys-getDomains-activity"
 	getRebalanceDomainsActivityName = "cadence-sys-getRebalanceDomains-activity"
+	verifyFailoverActivityName      = "cadence-sys-verifyFailover-activity"
+
+	// verifyFailoverChangeID gates the drill verification step for workflows started before it existed
+	verifyFailoverChangeID = "failover-drill-verify"
 
 	defaultBatchFailoverSize              = 20
 	defaultBatchFailoverWaitTimeInSeconds = 30
@@ -106,6 +110,8 @@ type (
 		DrillWaitTime time.Duration
 		// GracefulFailoverTimeoutInSeconds
 		GracefulFailoverTimeoutInSeconds *int32
+		// DrillDomainsOnly limits the failover to domains with failover drill enabled in domain data
+		DrillDomainsOnly bool
 	}
 
 	// FailoverResult is workflow result
@@ -114,13 +120,16 @@ type (
 		FailedDomains       []string
 		SuccessResetDomains []string
 		FailedResetDomains  []string
+		VerifiedDomains     []string
+		UnverifiedDomains   []string
 	}
 
 	// GetDomainsActivityParams params for activity
 	GetDomainsActivityParams struct {
-		TargetCluster string
-		SourceCluster string
-		Domains       []string
+		TargetCluster    string
+		SourceCluster    string
+		Domains          []string
+		DrillDomainsOnly bool
 	}
 
 	// FailoverActivityParams params for activity
@@ -136,6 +145,21 @@ type (
 		FailedDomains  []string
 	}
 
+	// VerifyFailoverActivityParams params for verify failover activity
+	VerifyFailoverActivityParams struct {
+		Domains       []string
+		TargetCluster string
+		// FailoverTime is when the domains were failed over; a domain is only verified
+		// if some workflow started in the target cluster after this time
+		FailoverTime time.Time
+	}
+
+	// VerifyFailoverActivityResult result for verify failover activity
+	VerifyFailoverActivityResult struct {
+		VerifiedDomains   []string
+		UnverifiedDomains []string
+	}
+
 	// QueryResult for failover progress
 	QueryResult struct {
 		TotalDomains        int
@@ -148,6 +172,8 @@ type (
 		FailedDomains       []string // FailedDomains contains false positive
 		SuccessResetDomains []string // SuccessResetDomains are domains successfully reset in drill mode
 		FailedResetDomains  []string // FailedResetDomains contains false positive in drill mode
+		VerifiedDomains     []string // VerifiedDomains are domains confirmed active in target cluster in drill mode
+		UnverifiedDomains   []string // UnverifiedDomains are domains not confirmed active in target cluster in drill mode
 		Operator            string
 	}
 )
@@ -164,6 +190,8 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 	var successDomains []string
 	var successResetDomains []string
 	var failedResetDomains []string
+	var verifiedDomains []string
+	var unverifiedDomains []string
 	var totalNumOfDomains int
 	wfState := WorkflowInitialized
 	operator := getOperator(ctx)
@@ -179,6 +207,8 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 			FailedDomains:       failedDomains,
 			SuccessResetDomains: successResetDomains,
 			FailedResetDomains:  failedResetDomains,
+			VerifiedDomains:     verifiedDomains,
+			UnverifiedDomains:   unverifiedDomains,
 			Operator:            operator,
 		}, nil
 	})
@@ -189,9 +219,10 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 	// get target domains
 	ao := workflow.WithActivityOptions(ctx, getGetDomainsActivityOptions())
 	getDomainsParams := &GetDomainsActivityParams{
-		TargetCluster: params.TargetCluster,
-		SourceCluster: params.SourceCluster,
-		Domains:       params.Domains,
+		TargetCluster:    params.TargetCluster,
+		SourceCluster:    params.SourceCluster,
+		Domains:          params.Domains,
+		DrillDomainsOnly: params.DrillDomainsOnly,
 	}
 	var domains []string
 	err = workflow.ExecuteActivity(ao, GetDomainsActivity, getDomainsParams).Get(ctx, &domains)
@@ -226,8 +257,12 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 		}, nil
 	}
 
+	failoverTime := workflow.Now(ctx)
 	workflow.Sleep(ctx, params.DrillWaitTime)
-	// Reset domains to original cluster
+	// Verify the drill made progress before reset domains to original cluster
+	if workflow.GetVersion(ctx, verifyFailoverChangeID, workflow.DefaultVersion, 1) == 1 {
+		verifiedDomains, unverifiedDomains = verifyFailover(ctx, successDomains, params.TargetCluster, failoverTime)
+	}
 	successResetDomains, failedResetDomains = failoverDomainsByBatch(ctx, domains, params, checkPauseSignal, true)
 	wfState = WorkflowCompleted
 
@@ -236,9 +271,35 @@ func FailoverWorkflow(ctx workflow.Context, params *FailoverParams) (*FailoverRe
 		FailedDomains:       failedDomains,
 		SuccessResetDomains: successResetDomains,
 		FailedResetDomains:  failedResetDomains,
+		VerifiedDomains:     verifiedDomains,
+		UnverifiedDomains:   unverifiedDomains,
 	}, nil
 }
 
+func verifyFailover(
+	ctx workflow.Context,
+	domains []string,
+	targetCluster string,
+	failoverTime time.Time,
+) (verifiedDomains []string, unverifiedDomains []string) {
+
+	if len(domains) == 0 {
+		return
+	}
+	ao := workflow.WithActivityOptions(ctx, getVerifyFailoverActivityOptions())
+	verifyParams := &VerifyFailoverActivityParams{
+		Domains:       domains,
+		TargetCluster: targetCluster,
+		FailoverTime:  failoverTime,
+	}
+	var actResult VerifyFailoverActivityResult
+	err := workflow.ExecuteActivity(ao, VerifyFailoverActivity, verifyParams).Get(ctx, &actResult)
+	if err != nil {
+		return nil, domains
+	}
+	return actResult.VerifiedDomains, actResult.UnverifiedDomains
+}
+
 func failoverDomainsByBatch(
 	ctx workflow.Context,
 	domains []string,
@@ -323,6 +384,19 @@ func getFailoverActivityOptions() workflow.ActivityOptions {
 	}
 }
 
+func getVerifyFailoverActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: 10 * time.Second,
+		StartToCloseTimeout:    20 * time.Second,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    2 * time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    1 * time.Minute,
+			ExpirationInterval: 5 * time.Minute,
+		},
+	}
+}
+
 func validateParams(params *FailoverParams) error {
 	if params == nil {
 		return errors.New(errMsgParamsIsNil)
@@ -348,7 +422,8 @@ func GetDomainsActivity(ctx context.Context, params *GetDomainsActivityParams) (
 	}
 	var res []string
 	for _, domain := range domains {
-		if shouldFailover(domain, params.SourceCluster) {
+		if params.DrillDomainsOnly && shouldFailoverDrill(domain, params.SourceCluster) ||
+			!params.DrillDomainsOnly && shouldFailover(domain, params.SourceCluster) {
 			domainName := domain.GetDomainInfo().GetName()
 			res = append(res, domainName)
 		}
@@ -385,6 +460,20 @@ func shouldFailover(domain *types.DescribeDomainResponse, sourceCluster string)
 	return isDomainTarget && isDomainFailoverManagedByCadence(domain)
 }
 
+func shouldFailoverDrill(domain *types.DescribeDomainResponse, sourceCluster string) bool {
+	if !domain.GetIsGlobalDomain() {
+		return false
+	}
+	currentActiveCluster := domain.ReplicationConfiguration.GetActiveClusterName()
+	isDomainTarget := currentActiveCluster == sourceCluster
+	return isDomainTarget && isDomainFailoverDrillEnabled(domain)
+}
+
+func isDomainFailoverDrillEnabled(domain *types.DescribeDomainResponse) bool {
+	domainData := domain.DomainInfo.GetData()
+	return strings.ToLower(strings.TrimSpace(domainData[common.DomainDataKeyForFailoverDrill])) == "true"
+}
+
 func isDomainFailoverManagedByCadence(domain *types.DescribeDomainResponse) bool {
 	domainData := domain.DomainInfo.GetData()
 	return strings.ToLower(strings.TrimSpace(domainData[common.DomainDataKeyForManagedFailover])) == "true"
@@ -478,6 +567,65 @@ func FailoverActivity(ctx context.Context, params *FailoverActivityParams) (*Fai
 	}, nil
 }
 
+// VerifyFailoverActivity checks the domains are active in target cluster from the view of target cluster
+// and that workflows kept making progress there after the failover
+func VerifyFailoverActivity(ctx context.Context, params *VerifyFailoverActivityParams) (*VerifyFailoverActivityResult, error) {
+	logger := activity.GetLogger(ctx)
+	remoteFrontendClient := getRemoteClient(ctx, params.TargetCluster)
+	var verifiedDomains []string
+	var unverifiedDomains []string
+	for _, domain := range params.Domains {
+		resp, err := remoteFrontendClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(domain)})
+		if err != nil {
+			logger.Error("Failed to describe domain in target cluster", zap.String("domain", domain), zap.Error(err))
+			unverifiedDomains = append(unverifiedDomains, domain)
+			continue
+		}
+		if resp.ReplicationConfiguration.GetActiveClusterName() == params.TargetCluster &&
+			hasProgressSince(ctx, remoteFrontendClient, domain, params.FailoverTime) {
+			verifiedDomains = append(verifiedDomains, domain)
+		} else {
+			unverifiedDomains = append(unverifiedDomains, domain)
+		}
+		activity.RecordHeartbeat(ctx, len(verifiedDomains)+len(unverifiedDomains))
+	}
+	return &VerifyFailoverActivityResult{
+		VerifiedDomains:   verifiedDomains,
+		UnverifiedDomains: unverifiedDomains,
+	}, nil
+}
+
+// hasProgressSince returns true if any workflow of the domain was started in the cluster after the given time
+func hasProgressSince(ctx context.Context, client frontend.Client, domain string, since time.Time) bool {
+	logger := activity.GetLogger(ctx)
+	timeFilter := &types.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(since.UnixNano()),
+		LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+	}
+	openResp, err := client.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+		Domain:          domain,
+		MaximumPageSize: 1,
+		StartTimeFilter: timeFilter,
+	})
+	if err != nil {
+		logger.Error("Failed to list open workflows in target cluster", zap.String("domain", domain), zap.Error(err))
+		return false
+	}
+	if len(openResp.GetExecutions()) > 0 {
+		return true
+	}
+	closedResp, err := client.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+		Domain:          domain,
+		MaximumPageSize: 1,
+		StartTimeFilter: timeFilter,
+	})
+	if err != nil {
+		logger.Error("Failed to list closed workflows in target cluster", zap.String("domain", domain), zap.Error(err))
+		return false
+	}
+	return len(closedResp.GetExecutions()) > 0
+}
+
 func cleanupChannel(channel workflow.Channel) {
 	for {
 		if hasValue := channel.ReceiveAsync(nil); !hasValue {
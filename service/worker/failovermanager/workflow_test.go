@@ -67,8 +67,10 @@ func (s *failoverWorkflowTestSuite) SetupTest() {
 	s.workflowEnv.RegisterWorkflowWithOptions(FailoverWorkflow, workflow.RegisterOptions{Name: FailoverWorkflowTypeName})
 	s.workflowEnv.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	s.workflowEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.workflowEnv.RegisterActivityWithOptions(VerifyFailoverActivity, activity.RegisterOptions{Name: verifyFailoverActivityName})
 	s.activityEnv.RegisterActivityWithOptions(FailoverActivity, activity.RegisterOptions{Name: failoverActivityName})
 	s.activityEnv.RegisterActivityWithOptions(GetDomainsActivity, activity.RegisterOptions{Name: getDomainsActivityName})
+	s.activityEnv.RegisterActivityWithOptions(VerifyFailoverActivity, activity.RegisterOptions{Name: verifyFailoverActivityName})
 }
 
 func (s *failoverWorkflowTestSuite) TearDownTest() {
@@ -229,6 +231,10 @@ func (s *failoverWorkflowTestSuite) TestWorkflow_WithDrillWaitTime_Success() {
 	}
 	s.workflowEnv.OnActivity(getDomainsActivityName, mock.Anything, mock.Anything).Return(domains, nil)
 	s.workflowEnv.OnActivity(failoverActivityName, mock.Anything, mock.Anything).Return(mockFailoverActivityResult, nil)
+	mockVerifyFailoverActivityResult := &VerifyFailoverActivityResult{
+		VerifiedDomains: []string{"d1"},
+	}
+	s.workflowEnv.OnActivity(verifyFailoverActivityName, mock.Anything, mock.Anything).Return(mockVerifyFailoverActivityResult, nil).Once()
 	params := &FailoverParams{
 		TargetCluster: "t",
 		SourceCluster: "s",
@@ -266,6 +272,8 @@ func (s *failoverWorkflowTestSuite) TestWorkflow_WithDrillWaitTime_Success() {
 	s.Equal(unknownOperator, res.Operator)
 	s.Equal(domains, res.SuccessResetDomains)
 	s.Equal(0, len(res.FailedResetDomains))
+	s.Equal(domains, res.VerifiedDomains)
+	s.Equal(0, len(res.UnverifiedDomains))
 }
 
 func (s *failoverWorkflowTestSuite) TestShouldFailover() {
@@ -414,6 +422,103 @@ func (s *failoverWorkflowTestSuite) TestGetDomainsActivity_WithTargetDomains() {
 	s.Equal([]string{"d1"}, result) // d3 filtered out because not managed
 }
 
+func (s *failoverWorkflowTestSuite) TestGetDomainsActivity_DrillDomainsOnly() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	domains := &types.ListDomainsResponse{
+		Domains: []*types.DescribeDomainResponse{
+			{
+				DomainInfo: &types.DomainInfo{
+					Name: "d1",
+					Data: map[string]string{common.DomainDataKeyForManagedFailover: "true"},
+				},
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: "c1",
+					Clusters:          clusters,
+				},
+				IsGlobalDomain: true,
+			},
+			{
+				DomainInfo: &types.DomainInfo{
+					Name: "d2",
+					Data: map[string]string{common.DomainDataKeyForFailoverDrill: "true"},
+				},
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: "c1",
+					Clusters:          clusters,
+				},
+				IsGlobalDomain: true,
+			},
+			{
+				DomainInfo: &types.DomainInfo{
+					Name: "d3",
+					Data: map[string]string{common.DomainDataKeyForFailoverDrill: "true"},
+				},
+				ReplicationConfiguration: &types.DomainReplicationConfiguration{
+					ActiveClusterName: "c2",
+					Clusters:          clusters,
+				},
+				IsGlobalDomain: true,
+			},
+		},
+	}
+	mockResource.FrontendClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(domains, nil)
+
+	params := &GetDomainsActivityParams{
+		TargetCluster:    "c2",
+		SourceCluster:    "c1",
+		DrillDomainsOnly: true,
+	}
+	actResult, err := env.ExecuteActivity(getDomainsActivityName, params)
+	s.NoError(err)
+	var result []string
+	s.NoError(actResult.Get(&result))
+	s.Equal([]string{"d2"}, result) // d1 is not a drill domain and d3 is not active in source cluster
+}
+
+func (s *failoverWorkflowTestSuite) TestVerifyFailoverActivity() {
+	env, mockResource, controller := s.prepareTestActivityEnv()
+	defer controller.Finish()
+	defer mockResource.Finish(s.T())
+
+	mockResource.RemoteFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("d1")}).
+		Return(&types.DescribeDomainResponse{
+			ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "c2"},
+		}, nil)
+	mockResource.RemoteFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.ListOpenWorkflowExecutionsResponse{
+			Executions: []*types.WorkflowExecutionInfo{{}},
+		}, nil)
+	mockResource.RemoteFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("d4")}).
+		Return(&types.DescribeDomainResponse{
+			ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "c2"},
+		}, nil)
+	mockResource.RemoteFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.ListOpenWorkflowExecutionsResponse{}, nil)
+	mockResource.RemoteFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).
+		Return(&types.ListClosedWorkflowExecutionsResponse{}, nil)
+	mockResource.RemoteFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("d2")}).
+		Return(&types.DescribeDomainResponse{
+			ReplicationConfiguration: &types.DomainReplicationConfiguration{ActiveClusterName: "c1"},
+		}, nil)
+	mockResource.RemoteFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{Name: common.StringPtr("d3")}).
+		Return(nil, errors.New("mockErr"))
+
+	params := &VerifyFailoverActivityParams{
+		Domains:       []string{"d1", "d4", "d2", "d3"},
+		TargetCluster: "c2",
+		FailoverTime:  time.Now().Add(-time.Minute),
+	}
+	actResult, err := env.ExecuteActivity(verifyFailoverActivityName, params)
+	s.NoError(err)
+	var result VerifyFailoverActivityResult
+	s.NoError(actResult.Get(&result))
+	s.Equal([]string{"d1"}, result.VerifiedDomains)
+	s.Equal([]string{"d4", "d2", "d3"}, result.UnverifiedDomains) // d4 has no workflow started since failover
+}
+
 func (s *failoverWorkflowTestSuite) TestFailoverActivity_ForceFailover_Success() {
 	env, mockResource, controller := s.prepareTestActivityEnv()
 	defer controller.Finish()
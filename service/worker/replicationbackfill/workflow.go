@@ -0,0 +1,256 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationbackfill
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain/replicationbackfill"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	contextKey string
+
+	// BackfillActivityParams params for backfill activity
+	BackfillActivityParams struct {
+		Request replicationbackfill.Request
+		// Deadline is when the activity stops replicating and hands the progress back to the workflow
+		Deadline time.Time
+	}
+)
+
+const (
+	backfillerContextKey contextKey = "replicationBackfillContext"
+	backfillActivityName            = "cadence-sys-replication-backfill-activity"
+
+	defaultPageSize = 100
+	// maxActivityDuration bounds a single backfill activity so that its progress is checkpointed in the workflow
+	maxActivityDuration = 5 * time.Minute
+	// maxActivitiesPerRun bounds the history size of a run before it continues as new
+	maxActivitiesPerRun = 500
+
+	errMsgParamsIsNil          = "params is nil"
+	errMsgDomainIsEmpty        = "domain is empty"
+	errMsgClusterIsEmpty       = "source or target cluster is empty"
+	errMsgRPSIsNegative        = "rps is negative"
+	errMsgInvalidBackfillPhase = "invalid backfill phase"
+)
+
+// BackfillWorkflow replicates the open and then the recently closed workflows of a domain to the target cluster,
+// throttled to request.RPS
+func BackfillWorkflow(ctx workflow.Context, request *replicationbackfill.Request) (*replicationbackfill.Progress, error) {
+	err := validateRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = workflow.SetQueryHandler(ctx, replicationbackfill.QueryType, func(input []byte) (*replicationbackfill.Request, error) {
+		return request, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ao := workflow.WithActivityOptions(ctx, getBackfillActivityOptions())
+	for iteration := 0; request.Progress.Phase != replicationbackfill.PhaseCompleted; iteration++ {
+		if iteration >= maxActivitiesPerRun {
+			return nil, workflow.NewContinueAsNewError(ctx, replicationbackfill.WorkflowTypeName, request)
+		}
+
+		activityParams := &BackfillActivityParams{
+			Request:  *request,
+			Deadline: workflow.Now(ctx).Add(maxActivityDuration),
+		}
+		var progress replicationbackfill.Progress
+		if err := workflow.ExecuteActivity(ao, BackfillActivity, activityParams).Get(ctx, &progress); err != nil {
+			return nil, err
+		}
+		request.Progress = progress
+	}
+	return &request.Progress, nil
+}
+
+func validateRequest(request *replicationbackfill.Request) error {
+	if request == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if len(request.DomainName) == 0 {
+		return errors.New(errMsgDomainIsEmpty)
+	}
+	if len(request.SourceCluster) == 0 || len(request.TargetCluster) == 0 {
+		return errors.New(errMsgClusterIsEmpty)
+	}
+	if request.RPS < 0 {
+		return errors.New(errMsgRPSIsNegative)
+	}
+	switch request.Progress.Phase {
+	case "":
+		request.Progress.Phase = replicationbackfill.PhaseOpen
+	case replicationbackfill.PhaseOpen, replicationbackfill.PhaseClosed, replicationbackfill.PhaseCompleted:
+	default:
+		return errors.New(errMsgInvalidBackfillPhase)
+	}
+	return nil
+}
+
+func getBackfillActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    maxActivityDuration + time.Minute,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: time.Hour,
+		},
+	}
+}
+
+// BackfillActivity lists pages of workflows of the current phase and has the target cluster resend their
+// replication tasks from the source cluster, until the backfill completes or the deadline is reached
+func BackfillActivity(ctx context.Context, params *BackfillActivityParams) (*replicationbackfill.Progress, error) {
+	backfiller := ctx.Value(backfillerContextKey).(*Backfiller)
+	request := params.Request
+	logger := backfiller.logger.WithTags(tag.WorkflowDomainName(request.DomainName), tag.ClusterName(request.TargetCluster))
+
+	pageSize := defaultPageSize
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if request.RPS > 0 {
+		pageSize = common.MinInt(request.RPS, defaultPageSize)
+		limiter = rate.NewLimiter(rate.Limit(request.RPS), pageSize)
+	}
+
+	// resume from the last replicated page when the activity is retried
+	progress := request.Progress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			return nil, err
+		}
+	}
+
+	deadlineCtx, cancel := context.WithDeadline(ctx, params.Deadline)
+	defer cancel()
+	for progress.Phase != replicationbackfill.PhaseCompleted {
+		if err := limiter.WaitN(deadlineCtx, pageSize); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// the deadline is reached, hand the progress back to the workflow
+			return &progress, nil
+		}
+
+		executions, nextPageToken, err := listExecutions(ctx, backfiller, &request, &progress, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		adminClient := backfiller.clientBean.GetRemoteAdminClient(request.TargetCluster)
+		for _, execution := range executions {
+			err := adminClient.ResendReplicationTasks(ctx, &types.ResendReplicationTasksRequest{
+				DomainID:      request.DomainID,
+				WorkflowID:    execution.GetWorkflowID(),
+				RunID:         execution.GetRunID(),
+				RemoteCluster: request.SourceCluster,
+			})
+			switch err.(type) {
+			case nil:
+				progress.Replicated++
+			case *types.EntityNotExistsError:
+				// the workflow is deleted since it was listed, there is nothing to replicate
+			default:
+				logger.Warn("Failed to backfill workflow replication",
+					tag.WorkflowID(execution.GetWorkflowID()), tag.WorkflowRunID(execution.GetRunID()), tag.Error(err))
+				progress.Failed++
+			}
+		}
+
+		progress.NextPageToken = nextPageToken
+		if len(nextPageToken) == 0 {
+			progress.Phase = nextPhase(progress.Phase)
+		}
+		activity.RecordHeartbeat(ctx, progress)
+	}
+	return &progress, nil
+}
+
+func listExecutions(
+	ctx context.Context,
+	backfiller *Backfiller,
+	request *replicationbackfill.Request,
+	progress *replicationbackfill.Progress,
+	pageSize int,
+) ([]*types.WorkflowExecution, []byte, error) {
+	frontendClient := backfiller.clientBean.GetFrontendClient()
+	var executions []*types.WorkflowExecution
+	if progress.Phase == replicationbackfill.PhaseOpen {
+		response, err := frontendClient.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+			Domain:          request.DomainName,
+			MaximumPageSize: int32(pageSize),
+			NextPageToken:   progress.NextPageToken,
+			StartTimeFilter: &types.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(request.LatestTime),
+			},
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, info := range response.GetExecutions() {
+			executions = append(executions, info.GetExecution())
+		}
+		return executions, response.GetNextPageToken(), nil
+	}
+
+	// the start time filter of closed workflows applies to their close time
+	response, err := frontendClient.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+		Domain:          request.DomainName,
+		MaximumPageSize: int32(pageSize),
+		NextPageToken:   progress.NextPageToken,
+		StartTimeFilter: &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(request.EarliestCloseTime),
+			LatestTime:   common.Int64Ptr(request.LatestTime),
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, info := range response.GetExecutions() {
+		executions = append(executions, info.GetExecution())
+	}
+	return executions, response.GetNextPageToken(), nil
+}
+
+func nextPhase(phase string) string {
+	if phase == replicationbackfill.PhaseOpen {
+		return replicationbackfill.PhaseClosed
+	}
+	return replicationbackfill.PhaseCompleted
+}
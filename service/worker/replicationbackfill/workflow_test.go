@@ -0,0 +1,176 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package replicationbackfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/domain/replicationbackfill"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/types"
+)
+
+type backfillWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	controller         *gomock.Controller
+	mockAdminClient    *admin.MockClient
+	mockFrontendClient *frontend.MockClient
+	activityEnv        *testsuite.TestActivityEnvironment
+	workflowEnv        *testsuite.TestWorkflowEnvironment
+}
+
+func TestBackfillWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(backfillWorkflowTestSuite))
+}
+
+func (s *backfillWorkflowTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockAdminClient = admin.NewMockClient(s.controller)
+	s.mockFrontendClient = frontend.NewMockClient(s.controller)
+	clientBean := client.NewMockBean(s.controller)
+	clientBean.EXPECT().GetRemoteAdminClient("target").Return(s.mockAdminClient).AnyTimes()
+	clientBean.EXPECT().GetFrontendClient().Return(s.mockFrontendClient).AnyTimes()
+	backfiller := &Backfiller{
+		clientBean: clientBean,
+		logger:     loggerimpl.NewNopLogger(),
+	}
+
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), backfillerContextKey, backfiller),
+	})
+	s.activityEnv.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(BackfillWorkflow, workflow.RegisterOptions{Name: replicationbackfill.WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(BackfillActivity, activity.RegisterOptions{Name: backfillActivityName})
+}
+
+func (s *backfillWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *backfillWorkflowTestSuite) newRequest() *replicationbackfill.Request {
+	return &replicationbackfill.Request{
+		DomainID:          "domainID",
+		DomainName:        "domain",
+		SourceCluster:     "source",
+		TargetCluster:     "target",
+		EarliestCloseTime: 100,
+		LatestTime:        200,
+	}
+}
+
+func (s *backfillWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(replicationbackfill.WorkflowTypeName, &replicationbackfill.Request{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *backfillWorkflowTestSuite) TestWorkflow_CarriesProgressAcrossActivities() {
+	token := []byte("token")
+	s.workflowEnv.OnActivity(backfillActivityName, mock.Anything, mock.MatchedBy(func(params *BackfillActivityParams) bool {
+		return params.Request.Progress.Phase == replicationbackfill.PhaseOpen
+	})).Return(&replicationbackfill.Progress{Phase: replicationbackfill.PhaseClosed, NextPageToken: token, Replicated: 10}, nil).Once()
+	s.workflowEnv.OnActivity(backfillActivityName, mock.Anything, mock.MatchedBy(func(params *BackfillActivityParams) bool {
+		return params.Request.Progress.Phase == replicationbackfill.PhaseClosed && string(params.Request.Progress.NextPageToken) == string(token)
+	})).Return(&replicationbackfill.Progress{Phase: replicationbackfill.PhaseCompleted, Replicated: 15, Failed: 1}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(replicationbackfill.WorkflowTypeName, s.newRequest())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var result replicationbackfill.Progress
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(replicationbackfill.Progress{Phase: replicationbackfill.PhaseCompleted, Replicated: 15, Failed: 1}, result)
+}
+
+func (s *backfillWorkflowTestSuite) TestBackfillActivity() {
+	s.mockFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.ListOpenWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*types.ListOpenWorkflowExecutionsResponse, error) {
+			s.Equal("domain", request.Domain)
+			s.Equal(int32(20), request.MaximumPageSize)
+			s.Equal(int64(200), request.StartTimeFilter.GetLatestTime())
+			return &types.ListOpenWorkflowExecutionsResponse{
+				Executions: []*types.WorkflowExecutionInfo{
+					{Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}},
+					{Execution: &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}},
+				},
+			}, nil
+		})
+	s.mockFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.ListClosedWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*types.ListClosedWorkflowExecutionsResponse, error) {
+			s.Equal(int64(100), request.StartTimeFilter.GetEarliestTime())
+			s.Equal(int64(200), request.StartTimeFilter.GetLatestTime())
+			return &types.ListClosedWorkflowExecutionsResponse{
+				Executions: []*types.WorkflowExecutionInfo{
+					{Execution: &types.WorkflowExecution{WorkflowID: "wid3", RunID: "rid3"}},
+				},
+			}, nil
+		})
+	s.mockAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), &types.ResendReplicationTasksRequest{
+		DomainID:      "domainID",
+		WorkflowID:    "wid1",
+		RunID:         "rid1",
+		RemoteCluster: "source",
+	}).Return(nil)
+	s.mockAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
+	s.mockAdminClient.EXPECT().ResendReplicationTasks(gomock.Any(), gomock.Any()).Return(&types.InternalServiceError{})
+
+	request := s.newRequest()
+	request.RPS = 20
+	request.Progress.Phase = replicationbackfill.PhaseOpen
+	value, err := s.activityEnv.ExecuteActivity(backfillActivityName, &BackfillActivityParams{
+		Request:  *request,
+		Deadline: time.Now().Add(time.Minute),
+	})
+	s.NoError(err)
+	var result replicationbackfill.Progress
+	s.NoError(value.Get(&result))
+	s.Equal(replicationbackfill.Progress{Phase: replicationbackfill.PhaseCompleted, Replicated: 1, Failed: 1}, result)
+}
+
+func (s *backfillWorkflowTestSuite) TestBackfillActivity_DeadlineReached() {
+	request := s.newRequest()
+	request.Progress = replicationbackfill.Progress{Phase: replicationbackfill.PhaseClosed, NextPageToken: []byte("token"), Replicated: 5}
+	value, err := s.activityEnv.ExecuteActivity(backfillActivityName, &BackfillActivityParams{
+		Request:  *request,
+		Deadline: time.Now().Add(-time.Minute),
+	})
+	s.NoError(err)
+	var result replicationbackfill.Progress
+	s.NoError(value.Get(&result))
+	s.Equal(request.Progress, result)
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package retentionreconciler
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain/retentionreconciler"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	contextKey string
+
+	// ReconcileActivityParams params for reconcile activity
+	ReconcileActivityParams struct {
+		Request retentionreconciler.Request
+		// Deadline is when the activity stops reconciling and hands the progress back to the workflow
+		Deadline time.Time
+	}
+)
+
+const (
+	reconcilerContextKey  contextKey = "retentionReconcilerContext"
+	reconcileActivityName            = "cadence-sys-retention-reconcile-activity"
+
+	defaultPageSize = 100
+	// maxActivityDuration bounds a single reconcile activity so that its progress is checkpointed in the workflow
+	maxActivityDuration = 5 * time.Minute
+	// maxActivitiesPerRun bounds the history size of a run before it continues as new
+	maxActivitiesPerRun = 500
+
+	errMsgParamsIsNil   = "params is nil"
+	errMsgDomainIsEmpty = "domain is empty"
+	errMsgRPSIsNegative = "rps is negative"
+)
+
+// ReconcileWorkflow reschedules the history deletion timers of the closed workflows of a domain according
+// to the current retention of the domain, throttled to request.RPS
+func ReconcileWorkflow(ctx workflow.Context, request *retentionreconciler.Request) (*retentionreconciler.Progress, error) {
+	err := validateRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = workflow.SetQueryHandler(ctx, retentionreconciler.QueryType, func(input []byte) (*retentionreconciler.Request, error) {
+		return request, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ao := workflow.WithActivityOptions(ctx, getReconcileActivityOptions())
+	for iteration := 0; !request.Progress.Completed; iteration++ {
+		if iteration >= maxActivitiesPerRun {
+			return nil, workflow.NewContinueAsNewError(ctx, retentionreconciler.WorkflowTypeName, request)
+		}
+
+		activityParams := &ReconcileActivityParams{
+			Request:  *request,
+			Deadline: workflow.Now(ctx).Add(maxActivityDuration),
+		}
+		var progress retentionreconciler.Progress
+		if err := workflow.ExecuteActivity(ao, ReconcileActivity, activityParams).Get(ctx, &progress); err != nil {
+			return nil, err
+		}
+		request.Progress = progress
+	}
+	return &request.Progress, nil
+}
+
+func validateRequest(request *retentionreconciler.Request) error {
+	if request == nil {
+		return errors.New(errMsgParamsIsNil)
+	}
+	if len(request.DomainID) == 0 || len(request.DomainName) == 0 {
+		return errors.New(errMsgDomainIsEmpty)
+	}
+	if request.RPS < 0 {
+		return errors.New(errMsgRPSIsNegative)
+	}
+	return nil
+}
+
+func getReconcileActivityOptions() workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    maxActivityDuration + time.Minute,
+		HeartbeatTimeout:       time.Minute,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: time.Hour,
+		},
+	}
+}
+
+// ReconcileActivity lists pages of closed workflows of the domain and has history reschedule their deletion
+// timers, until all workflows are reconciled or the deadline is reached
+func ReconcileActivity(ctx context.Context, params *ReconcileActivityParams) (*retentionreconciler.Progress, error) {
+	reconciler := ctx.Value(reconcilerContextKey).(*Reconciler)
+	request := params.Request
+	logger := reconciler.logger.WithTags(tag.WorkflowDomainName(request.DomainName))
+
+	pageSize := defaultPageSize
+	limiter := rate.NewLimiter(rate.Inf, 0)
+	if request.RPS > 0 {
+		pageSize = common.MinInt(request.RPS, defaultPageSize)
+		limiter = rate.NewLimiter(rate.Limit(request.RPS), pageSize)
+	}
+
+	// resume from the last reconciled page when the activity is retried
+	progress := request.Progress
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			return nil, err
+		}
+	}
+
+	deadlineCtx, cancel := context.WithDeadline(ctx, params.Deadline)
+	defer cancel()
+	frontendClient := reconciler.clientBean.GetFrontendClient()
+	historyClient := reconciler.clientBean.GetHistoryClient()
+	for !progress.Completed {
+		if err := limiter.WaitN(deadlineCtx, pageSize); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			// the deadline is reached, hand the progress back to the workflow
+			return &progress, nil
+		}
+
+		// the start time filter of closed workflows applies to their close time
+		response, err := frontendClient.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+			Domain:          request.DomainName,
+			MaximumPageSize: int32(pageSize),
+			NextPageToken:   progress.NextPageToken,
+			StartTimeFilter: &types.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(request.LatestTime),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range response.GetExecutions() {
+			execution := info.GetExecution()
+			err := historyClient.ReconcileWorkflowRetention(ctx, &types.ReconcileWorkflowRetentionRequest{
+				DomainUUID:        request.DomainID,
+				WorkflowExecution: execution,
+			})
+			switch err.(type) {
+			case nil:
+				progress.Reconciled++
+			case *types.EntityNotExistsError:
+				// the workflow is deleted since it was listed, there is nothing to reconcile
+			default:
+				logger.Warn("Failed to reconcile workflow retention",
+					tag.WorkflowID(execution.GetWorkflowID()), tag.WorkflowRunID(execution.GetRunID()), tag.Error(err))
+				progress.Failed++
+			}
+		}
+
+		progress.NextPageToken = response.GetNextPageToken()
+		progress.Completed = len(progress.NextPageToken) == 0
+		activity.RecordHeartbeat(ctx, progress)
+	}
+	return &progress, nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package retentionreconciler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common/domain/retentionreconciler"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/types"
+)
+
+type reconcileWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+	controller         *gomock.Controller
+	mockHistoryClient  *history.MockClient
+	mockFrontendClient *frontend.MockClient
+	activityEnv        *testsuite.TestActivityEnvironment
+	workflowEnv        *testsuite.TestWorkflowEnvironment
+}
+
+func TestReconcileWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(reconcileWorkflowTestSuite))
+}
+
+func (s *reconcileWorkflowTestSuite) SetupTest() {
+	s.controller = gomock.NewController(s.T())
+	s.mockHistoryClient = history.NewMockClient(s.controller)
+	s.mockFrontendClient = frontend.NewMockClient(s.controller)
+	clientBean := client.NewMockBean(s.controller)
+	clientBean.EXPECT().GetHistoryClient().Return(s.mockHistoryClient).AnyTimes()
+	clientBean.EXPECT().GetFrontendClient().Return(s.mockFrontendClient).AnyTimes()
+	reconciler := &Reconciler{
+		clientBean: clientBean,
+		logger:     loggerimpl.NewNopLogger(),
+	}
+
+	s.activityEnv = s.NewTestActivityEnvironment()
+	s.activityEnv.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), reconcilerContextKey, reconciler),
+	})
+	s.activityEnv.RegisterActivityWithOptions(ReconcileActivity, activity.RegisterOptions{Name: reconcileActivityName})
+	s.workflowEnv = s.NewTestWorkflowEnvironment()
+	s.workflowEnv.RegisterWorkflowWithOptions(ReconcileWorkflow, workflow.RegisterOptions{Name: retentionreconciler.WorkflowTypeName})
+	s.workflowEnv.RegisterActivityWithOptions(ReconcileActivity, activity.RegisterOptions{Name: reconcileActivityName})
+}
+
+func (s *reconcileWorkflowTestSuite) TearDownTest() {
+	s.workflowEnv.AssertExpectations(s.T())
+	s.controller.Finish()
+}
+
+func (s *reconcileWorkflowTestSuite) newRequest() *retentionreconciler.Request {
+	return &retentionreconciler.Request{
+		DomainID:   "domainID",
+		DomainName: "domain",
+		LatestTime: 200,
+	}
+}
+
+func (s *reconcileWorkflowTestSuite) TestWorkflow_InvalidParams() {
+	s.workflowEnv.ExecuteWorkflow(retentionreconciler.WorkflowTypeName, &retentionreconciler.Request{})
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.Error(s.workflowEnv.GetWorkflowError())
+}
+
+func (s *reconcileWorkflowTestSuite) TestWorkflow_CarriesProgressAcrossActivities() {
+	token := []byte("token")
+	s.workflowEnv.OnActivity(reconcileActivityName, mock.Anything, mock.MatchedBy(func(params *ReconcileActivityParams) bool {
+		return len(params.Request.Progress.NextPageToken) == 0
+	})).Return(&retentionreconciler.Progress{NextPageToken: token, Reconciled: 10}, nil).Once()
+	s.workflowEnv.OnActivity(reconcileActivityName, mock.Anything, mock.MatchedBy(func(params *ReconcileActivityParams) bool {
+		return string(params.Request.Progress.NextPageToken) == string(token)
+	})).Return(&retentionreconciler.Progress{Reconciled: 15, Failed: 1, Completed: true}, nil).Once()
+
+	s.workflowEnv.ExecuteWorkflow(retentionreconciler.WorkflowTypeName, s.newRequest())
+	s.True(s.workflowEnv.IsWorkflowCompleted())
+	s.NoError(s.workflowEnv.GetWorkflowError())
+	var result retentionreconciler.Progress
+	s.NoError(s.workflowEnv.GetWorkflowResult(&result))
+	s.Equal(retentionreconciler.Progress{Reconciled: 15, Failed: 1, Completed: true}, result)
+}
+
+func (s *reconcileWorkflowTestSuite) TestReconcileActivity() {
+	s.mockFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.ListClosedWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*types.ListClosedWorkflowExecutionsResponse, error) {
+			s.Equal("domain", request.Domain)
+			s.Equal(int32(20), request.MaximumPageSize)
+			s.Equal(int64(0), request.StartTimeFilter.GetEarliestTime())
+			s.Equal(int64(200), request.StartTimeFilter.GetLatestTime())
+			return &types.ListClosedWorkflowExecutionsResponse{
+				Executions: []*types.WorkflowExecutionInfo{
+					{Execution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"}},
+					{Execution: &types.WorkflowExecution{WorkflowID: "wid2", RunID: "rid2"}},
+					{Execution: &types.WorkflowExecution{WorkflowID: "wid3", RunID: "rid3"}},
+				},
+			}, nil
+		})
+	s.mockHistoryClient.EXPECT().ReconcileWorkflowRetention(gomock.Any(), &types.ReconcileWorkflowRetentionRequest{
+		DomainUUID:        "domainID",
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid1", RunID: "rid1"},
+	}).Return(nil)
+	s.mockHistoryClient.EXPECT().ReconcileWorkflowRetention(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
+	s.mockHistoryClient.EXPECT().ReconcileWorkflowRetention(gomock.Any(), gomock.Any()).Return(&types.InternalServiceError{})
+
+	request := s.newRequest()
+	request.RPS = 20
+	value, err := s.activityEnv.ExecuteActivity(reconcileActivityName, &ReconcileActivityParams{
+		Request:  *request,
+		Deadline: time.Now().Add(time.Minute),
+	})
+	s.NoError(err)
+	var result retentionreconciler.Progress
+	s.NoError(value.Get(&result))
+	s.Equal(retentionreconciler.Progress{Reconciled: 1, Failed: 1, Completed: true}, result)
+}
+
+func (s *reconcileWorkflowTestSuite) TestReconcileActivity_DeadlineReached() {
+	request := s.newRequest()
+	request.Progress = retentionreconciler.Progress{NextPageToken: []byte("token"), Reconciled: 5}
+	value, err := s.activityEnv.ExecuteActivity(reconcileActivityName, &ReconcileActivityParams{
+		Request:  *request,
+		Deadline: time.Now().Add(-time.Minute),
+	})
+	s.NoError(err)
+	var result retentionreconciler.Progress
+	s.NoError(value.Get(&result))
+	s.Equal(request.Progress, result)
+}
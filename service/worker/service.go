@@ -40,11 +40,15 @@ import (
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/dlqmerger"
+	"github.com/uber/cadence/service/worker/domainprovisioning"
 	"github.com/uber/cadence/service/worker/esanalyzer"
 	"github.com/uber/cadence/service/worker/failovermanager"
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/parentclosepolicy"
+	"github.com/uber/cadence/service/worker/replicationbackfill"
 	"github.com/uber/cadence/service/worker/replicator"
+	"github.com/uber/cadence/service/worker/retentionreconciler"
 	"github.com/uber/cadence/service/worker/scanner"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/shardscanner"
@@ -77,13 +81,18 @@ type (
 		ESAnalyzerCfg                       *esanalyzer.Config
 		WatchdogConfig                      *watchdog.Config
 		failoverManagerCfg                  *failovermanager.Config
+		dlqMergerCfg                        *dlqmerger.Config
 		ThrottledLogRPS                     dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS             dynamicconfig.IntPropertyFn
 		PersistenceMaxQPS                   dynamicconfig.IntPropertyFn
 		EnableBatcher                       dynamicconfig.BoolPropertyFn
 		EnableParentClosePolicyWorker       dynamicconfig.BoolPropertyFn
 		NumParentClosePolicySystemWorkflows dynamicconfig.IntPropertyFn
+		EnableDomainProvisioningWorker      dynamicconfig.BoolPropertyFn
 		EnableFailoverManager               dynamicconfig.BoolPropertyFn
+		EnableDLQMerger                     dynamicconfig.BoolPropertyFn
+		EnableReplicationBackfill           dynamicconfig.BoolPropertyFn
+		EnableRetentionReconciler           dynamicconfig.BoolPropertyFn
 		EnableWorkflowShadower              dynamicconfig.BoolPropertyFn
 		DomainReplicationMaxRetryDuration   dynamicconfig.DurationPropertyFn
 		EnableESAnalyzer                    dynamicconfig.BoolPropertyFn
@@ -162,6 +171,9 @@ func NewConfig(params *resource.Params) *Config {
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
+		dlqMergerCfg: &dlqmerger.Config{
+			ClusterMetadata: params.ClusterMetadata,
+		},
 		ESAnalyzerCfg: &esanalyzer.Config{
 			ESAnalyzerPause:                          dc.GetBoolProperty(dynamicconfig.ESAnalyzerPause, common.DefaultESAnalyzerPause),
 			ESAnalyzerTimeWindow:                     dc.GetDurationProperty(dynamicconfig.ESAnalyzerTimeWindow, common.DefaultESAnalyzerTimeWindow),
@@ -180,9 +192,13 @@ func NewConfig(params *resource.Params) *Config {
 		EnableBatcher:                       dc.GetBoolProperty(dynamicconfig.EnableBatcher, true),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
+		EnableDomainProvisioningWorker:      dc.GetBoolProperty(dynamicconfig.EnableDomainProvisioningWorker, true),
 		EnableESAnalyzer:                    dc.GetBoolProperty(dynamicconfig.EnableESAnalyzer, false),
 		EnableWatchDog:                      dc.GetBoolProperty(dynamicconfig.EnableWatchDog, false),
 		EnableFailoverManager:               dc.GetBoolProperty(dynamicconfig.EnableFailoverManager, true),
+		EnableDLQMerger:                     dc.GetBoolProperty(dynamicconfig.EnableDLQMerger, true),
+		EnableReplicationBackfill:           dc.GetBoolProperty(dynamicconfig.EnableReplicationBackfill, true),
+		EnableRetentionReconciler:           dc.GetBoolProperty(dynamicconfig.EnableRetentionReconciler, true),
 		EnableWorkflowShadower:              dc.GetBoolProperty(dynamicconfig.EnableWorkflowShadower, true),
 		ThrottledLogRPS:                     dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 		PersistenceGlobalMaxQPS:             dc.GetIntProperty(dynamicconfig.WorkerPersistenceGlobalMaxQPS, 0),
@@ -237,6 +253,9 @@ func (s *Service) Start() {
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
+	if s.config.EnableDomainProvisioningWorker() {
+		s.startDomainProvisioner()
+	}
 	if s.config.EnableESAnalyzer() {
 		s.startESAnalyzer()
 	}
@@ -246,6 +265,15 @@ func (s *Service) Start() {
 	if s.config.EnableFailoverManager() {
 		s.startFailoverManager()
 	}
+	if s.config.EnableDLQMerger() {
+		s.startDLQMerger()
+	}
+	if s.config.EnableReplicationBackfill() {
+		s.startReplicationBackfill()
+	}
+	if s.config.EnableRetentionReconciler() {
+		s.startRetentionReconciler()
+	}
 	if s.config.EnableWorkflowShadower() {
 		s.ensureDomainExists(common.ShadowerLocalDomainName)
 		s.startWorkflowShadower()
@@ -285,6 +313,20 @@ func (s *Service) startParentClosePolicyProcessor() {
 	}
 }
 
+func (s *Service) startDomainProvisioner() {
+	params := &domainprovisioning.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		DomainManager: s.GetDomainManager(),
+		Steps:         s.params.DomainProvisioningSteps,
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+	}
+	provisioner := domainprovisioning.New(params)
+	if err := provisioner.Start(); err != nil {
+		s.GetLogger().Fatal("error starting domain provisioner", tag.Error(err))
+	}
+}
+
 func (s *Service) startESAnalyzer() {
 	analyzer := esanalyzer.New(
 		s.params.PublicClient,
@@ -427,6 +469,49 @@ func (s *Service) startFailoverManager() {
 	}
 }
 
+func (s *Service) startDLQMerger() {
+	params := &dlqmerger.BootstrapParams{
+		Config:        *s.config.dlqMergerCfg,
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := dlqmerger.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting dlq merger", tag.Error(err))
+	}
+}
+
+func (s *Service) startReplicationBackfill() {
+	params := &replicationbackfill.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := replicationbackfill.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting replication backfill", tag.Error(err))
+	}
+}
+
+func (s *Service) startRetentionReconciler() {
+	params := &retentionreconciler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.GetMetricsClient(),
+		Logger:        s.GetLogger(),
+		TallyScope:    s.params.MetricScope,
+		ClientBean:    s.GetClientBean(),
+	}
+	if err := retentionreconciler.New(params).Start(); err != nil {
+		s.Stop()
+		s.GetLogger().Fatal("error starting retention reconciler", tag.Error(err))
+	}
+}
+
 func (s *Service) startWorkflowShadower() {
 	params := &shadower.BootstrapParams{
 		ServiceClient: s.params.PublicClient,
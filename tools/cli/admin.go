@@ -158,6 +158,10 @@ func newAdminShardManagementCommands() []cli.Command {
 					Name:  FlagShardID,
 					Usage: "The Id of the shard to describe",
 				},
+				cli.StringFlag{
+					Name:  FlagCluster,
+					Usage: "Optional name of a remote cluster, also shows the recent replication queue depth of the shard for it",
+				},
 			),
 			Action: func(c *cli.Context) {
 				AdminDescribeShard(c)
@@ -364,6 +368,55 @@ func newAdminHistoryHostCommands() []cli.Command {
 	}
 }
 
+func newAdminMatchingCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "describe-host",
+			Aliases: []string{"dh"},
+			Usage:   "Describe the health of a matching host",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagMatchingAddressWithAlias,
+					Usage: "Matching Host address(IP:PORT)",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeMatchingHost(c)
+			},
+		},
+	}
+}
+
+func newAdminHistoryCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "convert",
+			Usage: "Convert a history blob between thrift, proto and JSON encodings",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagIn,
+					Usage: "Input file. Binary blobs may also be given as a 0x prefixed hex string",
+				},
+				cli.StringFlag{
+					Name:  FlagOut,
+					Usage: "Output file, history is written to stdout if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagInEncoding,
+					Usage: "Encoding of the input: thrift, proto or json. Derived from the input file extension if not set",
+				},
+				cli.StringFlag{
+					Name:  FlagOutEncoding,
+					Usage: "Encoding of the output: thrift, proto or json. Derived from the output file extension if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminConvertHistory(c)
+			},
+		},
+	}
+}
+
 func newAdminDomainCommands() []cli.Command {
 	return []cli.Command{
 		{
@@ -393,6 +446,22 @@ func newAdminDomainCommands() []cli.Command {
 				newDomainCLI(c, true).DeprecateDomain(c)
 			},
 		},
+		{
+			Name:  "quarantine",
+			Usage: "Quarantine existing workflow domain, new workflows and signals are rejected while running workflows keep going",
+			Flags: adminQuarantineDomainFlags,
+			Action: func(c *cli.Context) {
+				newDomainCLI(c, true).QuarantineDomain(c)
+			},
+		},
+		{
+			Name:  "unquarantine",
+			Usage: "Lift the quarantine of a workflow domain",
+			Flags: adminQuarantineDomainFlags,
+			Action: func(c *cli.Context) {
+				newDomainCLI(c, true).UnquarantineDomain(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
@@ -402,6 +471,186 @@ func newAdminDomainCommands() []cli.Command {
 				newDomainCLI(c, true).DescribeDomain(c)
 			},
 		},
+		{
+			Name:    "failover-simulate",
+			Aliases: []string{"fos"},
+			Usage:   "Run pre-failover checks against the target cluster of a domain without failing over",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "Target cluster the domain would fail over to",
+				},
+				cli.StringFlag{
+					Name:  FlagTargetAddress,
+					Usage: "host:port of the frontend of the target cluster",
+				},
+				cli.IntFlag{
+					Name:  FlagNumberOfShards,
+					Usage: "NumberOfShards for the cadence cluster (see config for numHistoryShards). Replication DLQ of every shard is checked if provided",
+				},
+				cli.IntFlag{
+					Name:  FlagSampleSize,
+					Value: defaultFailoverSimulationSampleSize,
+					Usage: "Number of open workflows sampled for replication lag and shadow decision checks",
+				},
+				cli.BoolFlag{
+					Name:  FlagPrintJSONWithAlias,
+					Usage: "Print in raw JSON format",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminSimulateDomainFailover(c)
+			},
+		},
+		{
+			Name:    "failover",
+			Aliases: []string{"fo"},
+			Usage:   "Make the cluster owning a failover version active for a global domain, setting exactly that failover version. Only the current cluster is updated, run it in every cluster of the domain",
+			Flags: append(getDBFlags(),
+				cli.Int64Flag{
+					Name:  FlagFailoverVersionWithAlias,
+					Usage: "Failover version to set, the cluster whose initial failover version equals it modulo the failover version increment becomes active",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason for the failover, recorded in the domain change history",
+				},
+				cli.BoolFlag{
+					Name:  FlagForce,
+					Usage: "Allow setting a failover version lower than the current one of the domain",
+				}),
+			Action: func(c *cli.Context) {
+				AdminFailoverDomainToVersion(c)
+			},
+		},
+		{
+			Name:  "replicate-backfill",
+			Usage: "Replicate the open and recently closed workflows of a global domain to a cluster newly added to the domain, run it in a cluster which has the workflows",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "Cluster newly added to the domain which receives the workflows",
+				},
+				cli.DurationFlag{
+					Name:  FlagClosedWithin,
+					Usage: "Optional, workflows closed within this time are replicated, e.g. 72h. Defaults to the retention of the domain",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 10,
+					Usage: "Optional, number of workflows replicated per second, 0 means no limit",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminBackfillDomainReplication(c)
+			},
+		},
+		{
+			Name:  "reconcile-retention",
+			Usage: "Reschedule the history deletion of the closed workflows of a domain according to its current retention, run it after changing the retention",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 10,
+					Usage: "Optional, number of workflows reconciled per second, 0 means no limit",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReconcileDomainRetention(c)
+			},
+		},
+		{
+			Name:  "update-async-workflow-config",
+			Usage: "Set the queue that async start and signal requests of the domain are sent to, replacing the current async workflow config of the domain",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagAsyncWorkflowEnabled,
+					Usage: "Accept async start and signal requests, they are rejected when this flag is not set",
+				},
+				cli.StringFlag{
+					Name:  FlagAsyncWorkflowQueueProvider,
+					Usage: "Provider of the queue, e.g. kafka. Required with --" + FlagAsyncWorkflowEnabled,
+				},
+				cli.StringFlag{
+					Name:  FlagAsyncWorkflowQueueTopic,
+					Usage: "Topic of the queue. Required with --" + FlagAsyncWorkflowEnabled,
+				},
+				cli.IntFlag{
+					Name:  FlagAsyncWorkflowRPS,
+					Usage: "Optional, number of requests consumed from the queue per second, 0 means no limit",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminUpdateDomainAsyncWorkflowConfig(c)
+			},
+		},
+		{
+			Name:  "describe-async-workflow-config",
+			Usage: "Describe the async workflow config of the domain",
+			Action: func(c *cli.Context) {
+				AdminDescribeDomainAsyncWorkflowConfig(c)
+			},
+		},
+		{
+			Name:  "export",
+			Usage: "Export the metadata, visibility records and optionally histories of a domain's workflows started within a time range to a directory",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagOut,
+					Usage: "Output directory, receives manifest.json, domain.json, open_workflows.jsonl, closed_workflows.jsonl and with include_history a histories/ directory",
+				},
+				cli.StringFlag{
+					Name: FlagEarliestTimeWithAlias,
+					Usage: "EarliestTime of start time of exported workflows, supported formats are '2006-01-02T15:04:05+07:00', raw UnixNano and " +
+						"time range (N<duration>), where 0 < N < 1000000 and duration (full-notation/short-notation) can be second/s, " +
+						"minute/m, hour/h, day/d, week/w, month/M or year/y. Defaults to the beginning of time",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "LatestTime of start time of exported workflows, same formats as earliest_time. Defaults to now",
+				},
+				cli.BoolFlag{
+					Name:  FlagIncludeHistory,
+					Usage: "Also export the history of every workflow",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Page size of visibility requests",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminExportDomain(c)
+			},
+		},
+		{
+			Name:  "token",
+			Usage: "Manage domain scoped API tokens",
+			Subcommands: []cli.Command{
+				{
+					Name:  "create",
+					Usage: "Create a token that only grants access to some APIs of the domain until it expires",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  FlagAPIs,
+							Usage: "Comma separated names of the APIs the token allows, e.g. StartWorkflowExecution,SignalWorkflowExecution",
+						},
+						cli.DurationFlag{
+							Name:  FlagTTL,
+							Value: 24 * time.Hour,
+							Usage: "Time until the token expires, it can't exceed the max JWT TTL of the cluster",
+						},
+						cli.StringFlag{
+							Name:  FlagSubject,
+							Usage: "Owner of the token, e.g. the team or automation using it",
+						},
+					},
+					Action: func(c *cli.Context) {
+						AdminCreateDomainToken(c)
+					},
+				},
+			},
+		},
 		{
 			Name:    "getdomainidorname",
 			Aliases: []string{"getdn"},
@@ -442,6 +691,14 @@ func newAdminDomainCommands() []cli.Command {
 					Usage: "List domains that are matching to the given prefix",
 					Value: "",
 				},
+				cli.StringSliceFlag{
+					Name:  FlagDataFilter,
+					Usage: "List domains whose domain data has the given key=value, can be passed multiple times to require all of them",
+				},
+				cli.StringFlag{
+					Name:  FlagDomainID,
+					Usage: "List only the domain with the given UUID, regardless of its status",
+				},
 				cli.BoolFlag{
 					Name:  FlagPrintFullyDetailWithAlias,
 					Usage: "Print full domain detail",
@@ -450,6 +707,10 @@ func newAdminDomainCommands() []cli.Command {
 					Name:  FlagPrintJSONWithAlias,
 					Usage: "Print in raw json format",
 				},
+				cli.StringFlag{
+					Name:  FlagFormat,
+					Usage: "Output format [table, json, jsonl]. jsonl prints one JSON object per line as pages arrive",
+				},
 			},
 			Action: func(c *cli.Context) {
 				newDomainCLI(c, false).ListDomains(c)
@@ -681,6 +942,128 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminListTaskList(c)
 			},
 		},
+		{
+			Name:    "config",
+			Aliases: []string{"cfg"},
+			Usage:   "Show the effective matching configuration of a tasklist",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeTaskListConfig(c)
+			},
+		},
+		{
+			Name:  "loadtest",
+			Usage: "Generate synthetic activity tasks on a test tasklist and report matching latency and sync match rate",
+			Description: "Starts generator workflows on the tasklist, acts as their decider and activity worker, " +
+				"and terminates them when done. Use a dedicated tasklist in a test domain",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Name of the test tasklist, used for both generator decisions and activities",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Value: 100,
+					Usage: "Activity tasks to generate per second",
+				},
+				cli.DurationFlag{
+					Name:  FlagDuration,
+					Value: time.Minute,
+					Usage: "How long to generate tasks, e.g. 2m",
+				},
+				cli.IntFlag{
+					Name:  FlagWorkflowCount,
+					Value: 10,
+					Usage: "Number of generator workflows",
+				},
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Value: 20,
+					Usage: "Number of activity pollers",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminTaskListLoadTest(c)
+			},
+		},
+		{
+			Name:  "usage",
+			Usage: "Show the tasks and bytes stored per tasklist",
+			Description: "With --tasklist shows the usage of one tasklist of the domain. Without it, lists every tasklist " +
+				"from the database given by the DB flags (SQL stores only) and shows the largest ones, optionally only of the domain. " +
+				"Counts are estimated on NoSQL stores",
+			Flags: append(getDBFlags(),
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Optional TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Usage: "Optional TaskList type [decision|activity], both types if not set",
+				},
+				cli.IntFlag{
+					Name:  FlagTop,
+					Value: 20,
+					Usage: "Number of largest tasklists to show when listing all tasklists",
+				},
+			),
+			Action: func(c *cli.Context) {
+				AdminTaskListUsage(c)
+			},
+		},
+		{
+			Name:  "steal-lease",
+			Usage: "Take over the lease of an existing tasklist, so that a matching host holding it without serving traffic gives it up",
+			Description: "Bumps the range ID of the tasklist. The matching host holding the lease fails its next write and unloads the " +
+				"tasklist, which is then loaded by the host owning it. Use admin matching describe-host to find contested tasklists",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminStealTaskListLease(c)
+			},
+		},
+		{
+			Name:  "tag",
+			Usage: "Set the tags of an existing tasklist, e.g. its team, service or tier",
+			Description: "Tags are merged into the current tags of the tasklist, a tag with an empty value (--tag tier=) is removed. " +
+				"Without --tasklisttype both the decision and the activity tasklist are tagged. Use tasklist list --tag to find tasklists by tag",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList name",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Usage: "Optional TaskList type [decision|activity], both types if not set",
+				},
+				cli.StringSliceFlag{
+					Name:  FlagTaskListTag,
+					Usage: "Tag in the format key=value. Can be passed multiple times",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminTagTaskList(c)
+			},
+		},
 	}
 }
 
@@ -702,7 +1085,7 @@ func newAdminClusterCommands() []cli.Command {
 				},
 				cli.StringFlag{
 					Name:  FlagSecurityTokenWithAlias,
-					Usage: "Optional token for security check",
+					Usage: "Optional token for security check, defaults to the security token of the environment or stored for the profile",
 				},
 			},
 			Action: func(c *cli.Context) {
@@ -717,12 +1100,38 @@ func newAdminClusterCommands() []cli.Command {
 				AdminDescribeCluster(c)
 			},
 		},
+		{
+			Name:  "smoketest",
+			Usage: "Run a canary workflow against the cluster and print which of its steps passed",
+			Description: "Starts a workflow exercising an activity, a timer, a query, a signal and a child workflow " +
+				"on a new tasklist, acting as its worker, and verifies every step. Exits non-zero if any step failed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagDomainWithAlias,
+					Usage: "Domain to run the canary workflow in",
+				},
+				cli.DurationFlag{
+					Name:  FlagStepTimeout,
+					Value: 30 * time.Second,
+					Usage: "How long to wait for each step, e.g. 1m",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminClusterSmokeTest(c)
+			},
+		},
 		{
 			Name:        "failover",
 			Aliases:     []string{"fo"},
 			Usage:       "Failover domains with domain data IsManagedByCadence=true to target cluster",
 			Subcommands: newAdminFailoverCommands(),
 		},
+		{
+			Name:        "failover-drill",
+			Aliases:     []string{"fod"},
+			Usage:       "Scheduled failover drill on domains with domain data IsFailoverDrillEnabled=true",
+			Subcommands: newAdminFailoverDrillCommands(),
+		},
 		{
 			Name:    "failover_fast",
 			Aliases: []string{"fof"},
@@ -732,6 +1141,10 @@ func newAdminClusterCommands() []cli.Command {
 					Name:  FlagTargetClusterWithAlias,
 					Usage: "Target active cluster name",
 				},
+				cli.StringFlag{
+					Name:  FlagResultsFile,
+					Usage: "Optional file the per-item results are written to as JSON",
+				},
 			},
 			Action: func(c *cli.Context) {
 				newDomainCLI(c, false).FailoverDomains(c)
@@ -765,6 +1178,10 @@ func newAdminDLQCommands() []cli.Command {
 					Name:  FlagShardIDWithAlias,
 					Usage: "ShardID",
 				},
+				cli.StringFlag{
+					Name:  FlagShardRange,
+					Usage: "Inclusive range of shards to read in format of <start>-<end>, instead of a single shard",
+				},
 				cli.IntFlag{
 					Name:  FlagMaxMessageCountWithAlias,
 					Usage: "Max message size to fetch",
@@ -781,6 +1198,10 @@ func newAdminDLQCommands() []cli.Command {
 					Name:  FlagDLQRawTask,
 					Usage: "Show DLQ raw task information",
 				},
+				cli.BoolFlag{
+					Name:  FlagRaw,
+					Usage: "Print every DLQ message as JSON instead of a summary table",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminGetDLQMessages(c)
@@ -807,10 +1228,22 @@ func newAdminDLQCommands() []cli.Command {
 					Name:  FlagUpperShardBound,
 					Usage: "upper bound of shard to merge (inclusive)",
 				},
+				cli.StringFlag{
+					Name:  FlagShardRange,
+					Usage: "Inclusive range of shards in format of <start>-<end>, takes precedence over the shard bounds",
+				},
 				cli.IntFlag{
 					Name:  FlagLastMessageIDWithAlias,
 					Usage: "The upper boundary of the read message",
 				},
+				cli.StringFlag{
+					Name:  FlagResultsFile,
+					Usage: "Optional file the per-shard results are written to as JSON",
+				},
+				cli.BoolFlag{
+					Name:  FlagYes,
+					Usage: "Optional flag to purge without retyping the source cluster name to confirm",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminPurgeDLQMessages(c)
@@ -837,10 +1270,30 @@ func newAdminDLQCommands() []cli.Command {
 					Name:  FlagUpperShardBound,
 					Usage: "upper bound of shard to merge (inclusive)",
 				},
+				cli.StringFlag{
+					Name:  FlagShardRange,
+					Usage: "Inclusive range of shards in format of <start>-<end>, takes precedence over the shard bounds",
+				},
 				cli.IntFlag{
 					Name:  FlagLastMessageIDWithAlias,
 					Usage: "The upper boundary of the read message",
 				},
+				cli.StringFlag{
+					Name:  FlagSchedule,
+					Usage: "Optional daily UTC window (HH:MM-HH:MM) to merge in. Starts a server side merge workflow that pauses outside of the window",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Optional cap of merged messages per second. Starts a server side merge workflow",
+				},
+				cli.BoolFlag{
+					Name:  FlagAsync,
+					Usage: "Start a server side merge workflow without a window or RPS cap. Track it with `cadence admin job describe`",
+				},
+				cli.StringFlag{
+					Name:  FlagResultsFile,
+					Usage: "Optional file the per-shard results are written to as JSON",
+				},
 			},
 			Action: func(c *cli.Context) {
 				AdminMergeDLQMessages(c)
@@ -871,13 +1324,51 @@ func newAdminQueueCommands() []cli.Command {
 	}
 }
 
+func newAdminReplicationCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "acks",
+			Usage: "Show the replication ack level, last ack time and pending tasks of every remote cluster on a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardID + ", shard",
+					Usage: "ID of the shard to show",
+				},
+				cli.StringFlag{
+					Name:  FlagFormat,
+					Usage: "Output format [table, json, jsonl]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReplicationAcks(c)
+			},
+		},
+	}
+}
+
 func newDBCommands() []cli.Command {
 	var collections cli.StringSlice = invariant.CollectionStrings()
 
 	scanFlag := cli.StringFlag{
-		Name:     FlagScanType,
-		Usage:    "Scan type to use: " + strings.Join(executions.ScanTypeStrings(), ", "),
-		Required: true,
+		Name:  FlagScanType,
+		Usage: "Scan type to use: " + strings.Join(executions.ScanTypeStrings(), ", ") + ". Required if collection is not provided",
+	}
+
+	dbCollectionFlag := cli.StringFlag{
+		Name:  FlagDBCollection,
+		Usage: "Collection to scan or clean: " + strings.Join(dbCollections, ", ") + ". Overrides scan type and invariant collection",
+	}
+
+	timerStartDateFlag := cli.StringFlag{
+		Name:  FlagStartDate,
+		Usage: "Start date of timers to scan or clean in tasks collection",
+		Value: time.Now().UTC().Format(time.RFC3339),
+	}
+
+	timerEndDateFlag := cli.StringFlag{
+		Name:  FlagEndDate,
+		Usage: "End date of timers to scan or clean in tasks collection",
+		Value: time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339),
 	}
 
 	collectionsFlag := cli.StringSliceFlag{
@@ -892,9 +1383,8 @@ func newDBCommands() []cli.Command {
 			Usage: "scan executions in database and detect corruptions",
 			Flags: append(getDBFlags(),
 				cli.IntFlag{
-					Name:     FlagNumberOfShards,
-					Usage:    "NumberOfShards for the cadence cluster (see config for numHistoryShards)",
-					Required: true,
+					Name:  FlagNumberOfShards,
+					Usage: "NumberOfShards for the cadence cluster (see config for numHistoryShards). Required if collection is not provided",
 				},
 				scanFlag,
 				collectionsFlag,
@@ -902,6 +1392,18 @@ func newDBCommands() []cli.Command {
 					Name:  FlagInputFileWithAlias,
 					Usage: "Input file of executions to scan in JSON format {\"DomainID\":\"x\",\"WorkflowID\":\"x\",\"RunID\":\"x\"} separated by a newline",
 				},
+				dbCollectionFlag,
+				cli.StringFlag{
+					Name:  FlagShardRange,
+					Usage: "Inclusive range of shards to scan when collection is provided, eg 0-127",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSize,
+					Usage: "Page size used to list entities from database when collection is provided",
+					Value: 500,
+				},
+				timerStartDateFlag,
+				timerEndDateFlag,
 			),
 
 			Action: func(c *cli.Context) {
@@ -949,6 +1451,9 @@ func newDBCommands() []cli.Command {
 					Name:  FlagInputFileWithAlias,
 					Usage: "Input file of execution to clean in JSON format. Use `scan` command to generate list of executions.",
 				},
+				dbCollectionFlag,
+				timerStartDateFlag,
+				timerEndDateFlag,
 			),
 			Action: func(c *cli.Context) {
 				AdminDBClean(c)
@@ -983,7 +1488,7 @@ func getQueueCommandFlags() []cli.Flag {
 		},
 		cli.IntFlag{
 			Name:  FlagQueueType,
-			Usage: "queue type: 2 (transfer queue), 3 (timer queue) or 6 (cross-cluster queue)",
+			Usage: "queue type: 2 (transfer queue), 3 (timer queue), 4 (replication queue depth samples) or 6 (cross-cluster queue)",
 		},
 	}
 }
@@ -1188,6 +1693,122 @@ func newAdminFailoverCommands() []cli.Command {
 	}
 }
 
+func newAdminFailoverDrillCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "start failover drill workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTargetClusterWithAlias,
+					Usage: "Target cluster name",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceClusterWithAlias,
+					Usage: "Source cluster name",
+				},
+				cli.IntFlag{
+					Name: FlagFailoverDrillWaitTimeWithAlias,
+					Usage: "Failover drill wait time in seconds. " +
+						"After the wait time, the domains will be verified and reset to original regions.",
+				},
+				cli.StringFlag{
+					Name:  FlagCronSchedule,
+					Usage: "Optional cron schedule on failover drill",
+				},
+				cli.IntFlag{
+					Name:  FlagFailoverTimeoutWithAlias,
+					Usage: "Optional graceful failover timeout in seconds. If this field is define, the failover will use graceful failover.",
+				},
+				cli.IntFlag{
+					Name:  FlagExecutionTimeoutWithAlias,
+					Usage: "Optional Failover workflow timeout in seconds",
+					Value: defaultFailoverWorkflowTimeoutInSeconds,
+				},
+				cli.IntFlag{
+					Name:  FlagFailoverWaitTimeWithAlias,
+					Usage: "Optional Failover wait time after each batch in seconds",
+					Value: defaultBatchFailoverWaitTimeInSeconds,
+				},
+				cli.IntFlag{
+					Name:  FlagFailoverBatchSizeWithAlias,
+					Usage: "Optional number of domains to failover in one batch",
+					Value: defaultBatchFailoverSize,
+				},
+				cli.StringSliceFlag{
+					Name: FlagFailoverDomains,
+					Usage: "Optional domains to failover, eg d1,d2..,dn. " +
+						"Only provided domains with failover drill enabled in source cluster will be failover.",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverDrillStart(c)
+			},
+		},
+		{
+			Name:    "pause",
+			Aliases: []string{"p"},
+			Usage:   "pause failover drill workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional Failover drill workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverDrillPause(c)
+			},
+		},
+		{
+			Name:    "resume",
+			Aliases: []string{"re"},
+			Usage:   "resume paused failover drill workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional Failover drill workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverDrillResume(c)
+			},
+		},
+		{
+			Name:    "query",
+			Aliases: []string{"q"},
+			Usage:   "query failover drill workflow state and verification result",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional Failover drill workflow runID, default is latest runID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverDrillQuery(c)
+			},
+		},
+		{
+			Name:    "abort",
+			Aliases: []string{"a"},
+			Usage:   "abort failover drill workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional Failover drill workflow runID, default is latest runID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Optional reason why abort",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminFailoverDrillAbort(c)
+			},
+		},
+	}
+}
+
 func newAdminRebalanceCommands() []cli.Command {
 	return []cli.Command{
 		{
@@ -1288,3 +1909,91 @@ func newAdminConfigStoreCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminJobCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List running jobs",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  FlagAllWithAlias,
+					Usage: "Also list closed jobs",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Max number of jobs of each kind to list",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListJobs(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe the status and progress of a job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "ID of the job, as printed when the job was started",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeJob(c)
+			},
+		},
+		{
+			Name:  "cancel",
+			Usage: "Cancel a running job",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagJobIDWithAlias,
+					Usage: "ID of the job, as printed when the job was started",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason to cancel the job",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCancelJob(c)
+			},
+		},
+	}
+}
+
+func newAdminDecodeCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "task-token",
+			Aliases: []string{"tt"},
+			Usage:   "Decode a decision or activity task token and print the workflow it belongs to",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskToken,
+					Usage: "Base64 encoded task token, as logged by the client libraries",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDecodeTaskToken(c)
+			},
+		},
+		{
+			Name:    "activity-task-token",
+			Aliases: []string{"att"},
+			Usage:   "Decode an activity task token and print the workflow and activity it belongs to",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskToken,
+					Usage: "Base64 encoded activity task token, as logged by the client libraries",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDecodeActivityTaskToken(c)
+			},
+		},
+	}
+}
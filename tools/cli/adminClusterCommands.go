@@ -57,7 +57,7 @@ func AdminAddSearchAttribute(c *cli.Context) {
 		SearchAttribute: map[string]types.IndexedValueType{
 			key: types.IndexedValueType(valType),
 		},
-		SecurityToken: c.String(FlagSecurityToken),
+		SecurityToken: getSecurityToken(c),
 	}
 
 	err := adminClient.AddSearchAttribute(ctx, request)
@@ -108,16 +108,16 @@ func TestAdminFailover(t *testing.T) {
 	set.String(FlagActiveClusterName, "standby", "test flag")
 
 	cliContext := cli.NewContext(nil, set, nil)
-	succeed, failed := domainCLI.failoverDomains(cliContext)
-	assert.Equal(t, []string{"test-domain"}, succeed)
-	assert.Equal(t, 0, len(failed))
+	results := domainCLI.failoverDomains(cliContext)
+	assert.Equal(t, []string{"test-domain"}, results.items(batchResultSucceeded))
+	assert.Equal(t, 0, len(results.items(batchResultFailed)))
 
 	serverFrontendClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(listDomainsResponse, nil).Times(1)
 	set = flag.NewFlagSet("test", 0)
 	set.String(FlagActiveClusterName, "active", "test flag")
 
 	cliContext = cli.NewContext(nil, set, nil)
-	succeed, failed = domainCLI.failoverDomains(cliContext)
-	assert.Equal(t, 0, len(succeed))
-	assert.Equal(t, 0, len(failed))
+	results = domainCLI.failoverDomains(cliContext)
+	assert.Equal(t, 0, len(results.items(batchResultSucceeded)))
+	assert.Equal(t, 0, len(results.items(batchResultFailed)))
 }
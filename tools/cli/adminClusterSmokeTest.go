@@ -0,0 +1,474 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	smokeTestWorkflowType      = "cadence-smoketest"
+	smokeTestChildWorkflowType = "cadence-smoketest-child"
+	smokeTestActivityType      = "cadence-smoketest-echo"
+	smokeTestWorkflowIDPrefix  = "cadence-smoketest"
+	smokeTestSignalName        = "cadence-smoketest-signal"
+	smokeTestQueryType         = "cadence-smoketest-query"
+	smokeTestActivityID        = "echo"
+	smokeTestTimerID           = "timer"
+
+	smokeTestTimerSeconds             = 1
+	smokeTestTimeoutInSeconds         = 60
+	smokeTestWorkflowTimeoutInSeconds = 600
+	smokeTestPollTimeout              = time.Minute
+
+	smokeTestResultPassed  = "PASSED"
+	smokeTestResultFailed  = "FAILED"
+	smokeTestResultSkipped = "SKIPPED"
+)
+
+// steps of the canary workflow, verified in this order
+const (
+	smokeTestStepStart    = "start workflow"
+	smokeTestStepActivity = "echo activity"
+	smokeTestStepTimer    = "timer"
+	smokeTestStepQuery    = "query"
+	smokeTestStepSignal   = "signal"
+	smokeTestStepChild    = "child workflow"
+	smokeTestStepComplete = "complete workflow"
+)
+
+var smokeTestSteps = []string{
+	smokeTestStepStart,
+	smokeTestStepActivity,
+	smokeTestStepTimer,
+	smokeTestStepQuery,
+	smokeTestStepSignal,
+	smokeTestStepChild,
+	smokeTestStepComplete,
+}
+
+type (
+	// clusterSmokeTest runs a canary workflow on a fresh tasklist, acting as its decider and activity
+	// worker, and verifies every feature the workflow exercises in turn
+	clusterSmokeTest struct {
+		client      frontend.Client
+		domain      string
+		taskList    *types.TaskList
+		identity    string
+		workflowID  string
+		input       []byte
+		stepTimeout time.Duration
+
+		// events carries the steps verified by the decider to the step runner
+		events chan smokeTestEvent
+	}
+
+	smokeTestEvent struct {
+		step string
+		err  error
+	}
+
+	// SmokeTestStepRow is a presentation layer entity use to render the result of a smoke test step
+	SmokeTestStepRow struct {
+		Step    string        `header:"Step"`
+		Result  string        `header:"Result"`
+		Latency time.Duration `header:"Latency"`
+		Details string        `header:"Details"`
+	}
+)
+
+// AdminClusterSmokeTest starts a canary workflow exercising an activity, a timer, a query, a signal and a
+// child workflow, verifies each step within the step timeout and renders a pass/fail matrix
+func AdminClusterSmokeTest(c *cli.Context) {
+	domain := getRequiredOption(c, FlagDomain)
+	stepTimeout := c.Duration(FlagStepTimeout)
+	if stepTimeout <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s must be positive.", FlagStepTimeout), nil)
+		return
+	}
+
+	runID := uuid.New()
+	st := &clusterSmokeTest{
+		client:      cFactory.ServerFrontendClient(c),
+		domain:      domain,
+		taskList:    &types.TaskList{Name: fmt.Sprintf("%s-%s", smokeTestWorkflowIDPrefix, runID)},
+		identity:    getCliIdentity(),
+		workflowID:  fmt.Sprintf("%s-%s", smokeTestWorkflowIDPrefix, runID),
+		input:       []byte(runID),
+		stepTimeout: stepTimeout,
+		events:      make(chan smokeTestEvent, len(smokeTestSteps)),
+	}
+	fmt.Printf("Running smoke test workflow %s on tasklist %s in domain %s.\n", st.workflowID, st.taskList.GetName(), domain)
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		st.runDecider(runCtx)
+	}()
+	go func() {
+		defer wg.Done()
+		st.runActivityWorker(runCtx)
+	}()
+
+	rows := st.run(c)
+	cancel()
+	wg.Wait()
+
+	RenderTable(getOutput(), rows, TableOptions{Color: true, Border: true})
+	for _, row := range rows {
+		if row.Result != smokeTestResultPassed {
+			st.terminateWorkflow(c)
+			ErrorAndExit("Smoke test failed.", nil)
+			return
+		}
+	}
+	fmt.Println("Smoke test passed.")
+}
+
+// run verifies the steps in order, the steps after the first failure are skipped
+func (st *clusterSmokeTest) run(c *cli.Context) []SmokeTestStepRow {
+	rows := make([]SmokeTestStepRow, 0, len(smokeTestSteps))
+	failed := false
+	for _, step := range smokeTestSteps {
+		if failed {
+			rows = append(rows, SmokeTestStepRow{Step: step, Result: smokeTestResultSkipped})
+			continue
+		}
+
+		startTime := time.Now()
+		err := st.runStep(c, step)
+		row := SmokeTestStepRow{
+			Step:    step,
+			Result:  smokeTestResultPassed,
+			Latency: time.Since(startTime).Round(time.Millisecond),
+		}
+		if err != nil {
+			row.Result = smokeTestResultFailed
+			row.Details = err.Error()
+			failed = true
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func (st *clusterSmokeTest) runStep(c *cli.Context, step string) error {
+	switch step {
+	case smokeTestStepStart:
+		return st.startWorkflow(c)
+	case smokeTestStepQuery:
+		return st.queryWorkflow()
+	case smokeTestStepSignal:
+		if err := st.signalWorkflow(c); err != nil {
+			return err
+		}
+		return st.waitForStep(step)
+	case smokeTestStepComplete:
+		return st.waitForCompletion()
+	default:
+		return st.waitForStep(step)
+	}
+}
+
+func (st *clusterSmokeTest) startWorkflow(c *cli.Context) error {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := st.client.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+		Domain:                              st.domain,
+		WorkflowID:                          st.workflowID,
+		WorkflowType:                        &types.WorkflowType{Name: smokeTestWorkflowType},
+		TaskList:                            st.taskList,
+		Input:                               st.input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(smokeTestWorkflowTimeoutInSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Identity:                            st.identity,
+		RequestID:                           uuid.New(),
+	})
+	return err
+}
+
+// queryWorkflow sends a query the decider answers with its arguments
+func (st *clusterSmokeTest) queryWorkflow() error {
+	ctx, cancel := context.WithTimeout(context.Background(), st.stepTimeout)
+	defer cancel()
+	resp, err := st.client.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain:    st.domain,
+		Execution: &types.WorkflowExecution{WorkflowID: st.workflowID},
+		Query: &types.WorkflowQuery{
+			QueryType: smokeTestQueryType,
+			QueryArgs: st.input,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if resp.GetQueryRejected() != nil {
+		return fmt.Errorf("query rejected, workflow status %v", resp.GetQueryRejected().GetCloseStatus())
+	}
+	if !bytes.Equal(resp.GetQueryResult(), st.input) {
+		return fmt.Errorf("query returned %q, expected %q", resp.GetQueryResult(), st.input)
+	}
+	return nil
+}
+
+func (st *clusterSmokeTest) signalWorkflow(c *cli.Context) error {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	return st.client.SignalWorkflowExecution(ctx, &types.SignalWorkflowExecutionRequest{
+		Domain:            st.domain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: st.workflowID},
+		SignalName:        smokeTestSignalName,
+		Input:             st.input,
+		Identity:          st.identity,
+		RequestID:         uuid.New(),
+	})
+}
+
+// waitForStep waits for the decider to verify the step
+func (st *clusterSmokeTest) waitForStep(step string) error {
+	timer := time.NewTimer(st.stepTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case event := <-st.events:
+			if event.step == step {
+				return event.err
+			}
+		case <-timer.C:
+			return fmt.Errorf("timed out after %v", st.stepTimeout)
+		}
+	}
+}
+
+// waitForCompletion long polls the close event of the workflow
+func (st *clusterSmokeTest) waitForCompletion() error {
+	ctx, cancel := context.WithTimeout(context.Background(), st.stepTimeout)
+	defer cancel()
+	resp, err := st.client.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 st.domain,
+		Execution:              &types.WorkflowExecution{WorkflowID: st.workflowID},
+		WaitForNewEvent:        true,
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+	})
+	if err != nil {
+		return err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return fmt.Errorf("timed out after %v", st.stepTimeout)
+	}
+	if eventType := events[len(events)-1].GetEventType(); eventType != types.EventTypeWorkflowExecutionCompleted {
+		return fmt.Errorf("workflow closed with %v", eventType)
+	}
+	return nil
+}
+
+// terminateWorkflow stops the canary workflow after a failed step, its child is terminated by the parent close policy
+func (st *clusterSmokeTest) terminateWorkflow(c *cli.Context) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := st.client.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+		Domain:            st.domain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: st.workflowID},
+		Reason:            "smoke test failed",
+		Identity:          st.identity,
+	})
+	if err != nil {
+		switch err.(type) {
+		case *types.EntityNotExistsError, *types.WorkflowExecutionAlreadyCompletedError:
+		default:
+			fmt.Fprintf(os.Stderr, "Failed to terminate smoke test workflow %s: %v\n", st.workflowID, err)
+		}
+	}
+}
+
+func (st *clusterSmokeTest) runDecider(ctx context.Context) {
+	for ctx.Err() == nil {
+		pollCtx, cancel := context.WithTimeout(ctx, smokeTestPollTimeout)
+		task, err := st.client.PollForDecisionTask(pollCtx, &types.PollForDecisionTaskRequest{
+			Domain:   st.domain,
+			TaskList: st.taskList,
+			Identity: st.identity,
+		})
+		cancel()
+		if err != nil || len(task.GetTaskToken()) == 0 {
+			continue
+		}
+
+		if task.Query != nil {
+			if err := st.client.RespondQueryTaskCompleted(ctx, &types.RespondQueryTaskCompletedRequest{
+				TaskToken:     task.TaskToken,
+				CompletedType: types.QueryTaskCompletedTypeCompleted.Ptr(),
+				QueryResult:   task.Query.GetQueryArgs(),
+			}); err != nil && ctx.Err() == nil {
+				fmt.Fprintf(os.Stderr, "Failed to respond smoke test query task: %v\n", err)
+			}
+			continue
+		}
+		if _, err := st.client.RespondDecisionTaskCompleted(ctx, &types.RespondDecisionTaskCompletedRequest{
+			TaskToken: task.TaskToken,
+			Decisions: st.decide(task),
+			Identity:  st.identity,
+		}); err != nil && ctx.Err() == nil {
+			fmt.Fprintf(os.Stderr, "Failed to respond smoke test decision task: %v\n", err)
+		}
+	}
+}
+
+// decide advances the canary workflow on the events since its previous decision task and reports the
+// steps they verify. The child workflow completes right away
+func (st *clusterSmokeTest) decide(task *types.PollForDecisionTaskResponse) []*types.Decision {
+	if task.WorkflowType.GetName() == smokeTestChildWorkflowType {
+		return []*types.Decision{smokeTestCompleteDecision()}
+	}
+
+	var decisions []*types.Decision
+	for _, event := range task.History.GetEvents() {
+		if event.ID <= task.GetPreviousStartedEventID() {
+			continue
+		}
+		switch event.GetEventType() {
+		case types.EventTypeWorkflowExecutionStarted:
+			decisions = append(decisions, &types.Decision{
+				DecisionType: types.DecisionTypeScheduleActivityTask.Ptr(),
+				ScheduleActivityTaskDecisionAttributes: &types.ScheduleActivityTaskDecisionAttributes{
+					ActivityID:                    smokeTestActivityID,
+					ActivityType:                  &types.ActivityType{Name: smokeTestActivityType},
+					TaskList:                      st.taskList,
+					Input:                         st.input,
+					ScheduleToCloseTimeoutSeconds: common.Int32Ptr(smokeTestTimeoutInSeconds),
+					ScheduleToStartTimeoutSeconds: common.Int32Ptr(smokeTestTimeoutInSeconds),
+					StartToCloseTimeoutSeconds:    common.Int32Ptr(smokeTestTimeoutInSeconds),
+					HeartbeatTimeoutSeconds:       common.Int32Ptr(0),
+				},
+			})
+		case types.EventTypeActivityTaskCompleted:
+			result := event.ActivityTaskCompletedEventAttributes.GetResult()
+			if !bytes.Equal(result, st.input) {
+				return st.fail(smokeTestStepActivity, fmt.Errorf("activity returned %q, expected %q", result, st.input))
+			}
+			st.report(smokeTestStepActivity, nil)
+			decisions = append(decisions, &types.Decision{
+				DecisionType: types.DecisionTypeStartTimer.Ptr(),
+				StartTimerDecisionAttributes: &types.StartTimerDecisionAttributes{
+					TimerID:                   smokeTestTimerID,
+					StartToFireTimeoutSeconds: common.Int64Ptr(smokeTestTimerSeconds),
+				},
+			})
+		case types.EventTypeActivityTaskFailed, types.EventTypeActivityTaskTimedOut:
+			return st.fail(smokeTestStepActivity, fmt.Errorf("activity closed with %v", event.GetEventType()))
+		case types.EventTypeTimerFired:
+			st.report(smokeTestStepTimer, nil)
+		case types.EventTypeWorkflowExecutionSignaled:
+			input := event.WorkflowExecutionSignaledEventAttributes.GetInput()
+			if !bytes.Equal(input, st.input) {
+				return st.fail(smokeTestStepSignal, fmt.Errorf("signal carried %q, expected %q", input, st.input))
+			}
+			st.report(smokeTestStepSignal, nil)
+			decisions = append(decisions, &types.Decision{
+				DecisionType: types.DecisionTypeStartChildWorkflowExecution.Ptr(),
+				StartChildWorkflowExecutionDecisionAttributes: &types.StartChildWorkflowExecutionDecisionAttributes{
+					Domain:                              st.domain,
+					WorkflowID:                          st.workflowID + "-child",
+					WorkflowType:                        &types.WorkflowType{Name: smokeTestChildWorkflowType},
+					TaskList:                            st.taskList,
+					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(smokeTestTimeoutInSeconds),
+					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+				},
+			})
+		case types.EventTypeChildWorkflowExecutionCompleted:
+			st.report(smokeTestStepChild, nil)
+			decisions = append(decisions, smokeTestCompleteDecision())
+		case types.EventTypeStartChildWorkflowExecutionFailed,
+			types.EventTypeChildWorkflowExecutionFailed,
+			types.EventTypeChildWorkflowExecutionTimedOut,
+			types.EventTypeChildWorkflowExecutionTerminated,
+			types.EventTypeChildWorkflowExecutionCanceled:
+			return st.fail(smokeTestStepChild, fmt.Errorf("child workflow closed with %v", event.GetEventType()))
+		}
+	}
+	return decisions
+}
+
+// fail reports the failed step and fails the canary workflow
+func (st *clusterSmokeTest) fail(step string, err error) []*types.Decision {
+	st.report(step, err)
+	return []*types.Decision{{
+		DecisionType: types.DecisionTypeFailWorkflowExecution.Ptr(),
+		FailWorkflowExecutionDecisionAttributes: &types.FailWorkflowExecutionDecisionAttributes{
+			Reason:  common.StringPtr(fmt.Sprintf("%v failed", step)),
+			Details: []byte(err.Error()),
+		},
+	}}
+}
+
+// report never blocks, a decision task retried after a failed response may report a step twice
+func (st *clusterSmokeTest) report(step string, err error) {
+	select {
+	case st.events <- smokeTestEvent{step: step, err: err}:
+	default:
+	}
+}
+
+func smokeTestCompleteDecision() *types.Decision {
+	return &types.Decision{
+		DecisionType: types.DecisionTypeCompleteWorkflowExecution.Ptr(),
+		CompleteWorkflowExecutionDecisionAttributes: &types.CompleteWorkflowExecutionDecisionAttributes{},
+	}
+}
+
+// runActivityWorker completes the echo activity with its input
+func (st *clusterSmokeTest) runActivityWorker(ctx context.Context) {
+	for ctx.Err() == nil {
+		pollCtx, cancel := context.WithTimeout(ctx, smokeTestPollTimeout)
+		task, err := st.client.PollForActivityTask(pollCtx, &types.PollForActivityTaskRequest{
+			Domain:   st.domain,
+			TaskList: st.taskList,
+			Identity: st.identity,
+		})
+		cancel()
+		if err != nil || len(task.GetTaskToken()) == 0 {
+			continue
+		}
+
+		if err := st.client.RespondActivityTaskCompleted(ctx, &types.RespondActivityTaskCompletedRequest{
+			TaskToken: task.TaskToken,
+			Result:    task.GetInput(),
+			Identity:  st.identity,
+		}); err != nil && ctx.Err() == nil {
+			fmt.Fprintf(os.Stderr, "Failed to respond smoke test activity task: %v\n", err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestClusterSmokeTest_Decide(t *testing.T) {
+	st := &clusterSmokeTest{
+		domain:      "test-domain",
+		taskList:    &types.TaskList{Name: "test-tasklist"},
+		workflowID:  "wid",
+		input:       []byte("input"),
+		stepTimeout: time.Second,
+		events:      make(chan smokeTestEvent, len(smokeTestSteps)),
+	}
+	task := func(previousStartedEventID int64, events ...*types.HistoryEvent) *types.PollForDecisionTaskResponse {
+		for i, event := range events {
+			event.ID = int64(i + 1)
+		}
+		return &types.PollForDecisionTaskResponse{
+			WorkflowType:           &types.WorkflowType{Name: smokeTestWorkflowType},
+			PreviousStartedEventID: common.Int64Ptr(previousStartedEventID),
+			History:                &types.History{Events: events},
+		}
+	}
+	started := &types.HistoryEvent{EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}
+	activityCompleted := &types.HistoryEvent{
+		EventType:                            types.EventTypeActivityTaskCompleted.Ptr(),
+		ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{Result: []byte("input")},
+	}
+	timerFired := &types.HistoryEvent{EventType: types.EventTypeTimerFired.Ptr()}
+	signaled := &types.HistoryEvent{
+		EventType:                                types.EventTypeWorkflowExecutionSignaled.Ptr(),
+		WorkflowExecutionSignaledEventAttributes: &types.WorkflowExecutionSignaledEventAttributes{Input: []byte("input")},
+	}
+	childCompleted := &types.HistoryEvent{EventType: types.EventTypeChildWorkflowExecutionCompleted.Ptr()}
+
+	decisions := st.decide(task(0, started))
+	require.Len(t, decisions, 1)
+	assert.Equal(t, types.DecisionTypeScheduleActivityTask, decisions[0].GetDecisionType())
+	assert.Equal(t, []byte("input"), decisions[0].ScheduleActivityTaskDecisionAttributes.Input)
+
+	decisions = st.decide(task(1, started, activityCompleted))
+	require.Len(t, decisions, 1)
+	assert.Equal(t, types.DecisionTypeStartTimer, decisions[0].GetDecisionType())
+	assert.NoError(t, st.waitForStep(smokeTestStepActivity))
+
+	decisions = st.decide(task(2, started, activityCompleted, timerFired))
+	assert.Empty(t, decisions)
+	assert.NoError(t, st.waitForStep(smokeTestStepTimer))
+
+	decisions = st.decide(task(3, started, activityCompleted, timerFired, signaled))
+	require.Len(t, decisions, 1)
+	assert.Equal(t, types.DecisionTypeStartChildWorkflowExecution, decisions[0].GetDecisionType())
+	assert.Equal(t, "wid-child", decisions[0].StartChildWorkflowExecutionDecisionAttributes.GetWorkflowID())
+	assert.NoError(t, st.waitForStep(smokeTestStepSignal))
+
+	decisions = st.decide(task(4, started, activityCompleted, timerFired, signaled, childCompleted))
+	require.Len(t, decisions, 1)
+	assert.Equal(t, types.DecisionTypeCompleteWorkflowExecution, decisions[0].GetDecisionType())
+	assert.NoError(t, st.waitForStep(smokeTestStepChild))
+
+	// the child workflow completes right away
+	decisions = st.decide(&types.PollForDecisionTaskResponse{WorkflowType: &types.WorkflowType{Name: smokeTestChildWorkflowType}})
+	require.Len(t, decisions, 1)
+	assert.Equal(t, types.DecisionTypeCompleteWorkflowExecution, decisions[0].GetDecisionType())
+}
+
+func TestClusterSmokeTest_DecideFailure(t *testing.T) {
+	st := &clusterSmokeTest{
+		input:       []byte("input"),
+		stepTimeout: time.Second,
+		events:      make(chan smokeTestEvent, len(smokeTestSteps)),
+	}
+	decisions := st.decide(&types.PollForDecisionTaskResponse{
+		WorkflowType: &types.WorkflowType{Name: smokeTestWorkflowType},
+		History: &types.History{Events: []*types.HistoryEvent{{
+			ID:        1,
+			EventType: types.EventTypeActivityTaskTimedOut.Ptr(),
+		}}},
+	})
+	require.Len(t, decisions, 1)
+	assert.Equal(t, types.DecisionTypeFailWorkflowExecution, decisions[0].GetDecisionType())
+	assert.EqualError(t, st.waitForStep(smokeTestStepActivity), "activity closed with ActivityTaskTimedOut")
+}
+
+func TestClusterSmokeTest_WaitForStepTimeout(t *testing.T) {
+	st := &clusterSmokeTest{
+		stepTimeout: 10 * time.Millisecond,
+		events:      make(chan smokeTestEvent, len(smokeTestSteps)),
+	}
+	// events of other steps are ignored
+	st.report(smokeTestStepActivity, nil)
+	assert.EqualError(t, st.waitForStep(smokeTestStepTimer), "timed out after 10ms")
+}
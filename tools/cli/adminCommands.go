@@ -25,8 +25,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/urfave/cli"
@@ -100,7 +100,7 @@ func AdminShowWorkflow(c *cli.Context) {
 			if err != nil {
 				ErrorAndExit("json.Marshal err", err)
 			}
-			fmt.Println(string(jsonstr))
+			Render(string(jsonstr))
 		}
 	}
 	fmt.Printf("======== total batches %v, total blob len: %v ======\n", len(history), totalSize)
@@ -412,6 +412,56 @@ func AdminDescribeShard(c *cli.Context) {
 	}
 
 	prettyPrintJSONObject(shard)
+
+	if cluster := c.String(FlagCluster); cluster != "" {
+		printReplicationQueueTrend(c, sid, cluster)
+	}
+}
+
+type replicationQueueSampleRow struct {
+	Timestamp time.Time `json:"timestamp" header:"Time"`
+	Depth     int64     `json:"depth" header:"Depth"`
+	AckLevel  int64     `json:"ackLevel" header:"Ack Level"`
+	Trend     string    `json:"-" header:"Trend"`
+}
+
+// printReplicationQueueTrend shows the replication queue depth samples the history host
+// keeps in memory for the shard, with a bar proportional to the depth as trendline
+func printReplicationQueueTrend(c *cli.Context, shardID int, cluster string) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeQueue(ctx, &types.DescribeQueueRequest{
+		ShardID:     int32(shardID),
+		ClusterName: cluster,
+		Type:        common.Int32Ptr(int32(common.TaskTypeReplication)),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe replication queue.", err)
+	}
+
+	var rows []replicationQueueSampleRow
+	var maxDepth int64
+	for _, state := range resp.GetProcessingQueueStates() {
+		var row replicationQueueSampleRow
+		if err := json.Unmarshal([]byte(state), &row); err != nil {
+			ErrorAndExit("Failed to decode replication queue sample.", err)
+		}
+		if row.Depth > maxDepth {
+			maxDepth = row.Depth
+		}
+		rows = append(rows, row)
+	}
+	for i := range rows {
+		if maxDepth > 0 {
+			rows[i].Trend = strings.Repeat(bar, int(rows[i].Depth*defaultWidth/2/maxDepth))
+		}
+	}
+
+	fmt.Printf("Replication queue depth for cluster %s:\n", cluster)
+	RenderTable(getOutput(), rows, TableOptions{Color: true, PrintDateTime: true})
 }
 
 // AdminSetShardRangeID set shard rangeID by shard id
@@ -495,8 +545,11 @@ func AdminDescribeShardDistribution(c *cli.Context) {
 	opts := TableOptions{Color: true}
 	outputPageSize := tableRenderSize
 	for shardID, identity := range resp.Shards {
+		if cliPaging.limit(1) == 0 {
+			break
+		}
 		if outputPageSize == 0 {
-			RenderTable(os.Stdout, table, opts)
+			RenderTable(getOutput(), table, opts)
 			table = []ShardRow{}
 			if !showNextPage() {
 				break
@@ -507,7 +560,7 @@ func AdminDescribeShardDistribution(c *cli.Context) {
 		outputPageSize--
 	}
 	// output the remaining rows
-	RenderTable(os.Stdout, table, opts)
+	RenderTable(getOutput(), table, opts)
 }
 
 // AdminDescribeHistoryHost describes history host
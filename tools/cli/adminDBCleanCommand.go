@@ -83,39 +83,19 @@ func AdminDBDataDecodeThrift(c *cli.Context) {
 // AdminDBClean is the command to clean up unhealthy executions.
 // Input is a JSON stream provided via STDIN or a file.
 func AdminDBClean(c *cli.Context) {
-	scanType, err := executions.ScanTypeString(getRequiredOption(c, FlagScanType))
-
-	if err != nil {
-		ErrorAndExit("unknown scan type", err)
-	}
-	collectionSlice := c.StringSlice(FlagInvariantCollection)
-	blob := scanType.ToBlobstoreEntity()
-
-	var collections []invariant.Collection
-	for _, v := range collectionSlice {
-		collection, err := invariant.CollectionString(v)
-		if err != nil {
-			ErrorAndExit("unknown invariant collection", err)
-		}
-		collections = append(collections, collection)
-	}
-
-	invariants := scanType.ToInvariants(collections)
-	if len(invariants) < 1 {
-		ErrorAndExit(
-			fmt.Sprintf("no invariants for scantype %q and collections %q",
-				scanType.String(),
-				collectionSlice),
-			nil,
-		)
+	var blob entity.Entity
+	var invariants []executions.InvariantFactory
+	if c.IsSet(FlagDBCollection) {
+		dbCollection := getDBScanCollection(c)
+		blob = dbCollection.blob
+		invariants = dbCollection.invariants
+	} else {
+		blob, invariants = getScanTypeInvariants(c)
 	}
 
 	input := getInputFile(c.String(FlagInputFile))
 
 	dec := json.NewDecoder(input)
-	if err != nil {
-		ErrorAndExit("", err)
-	}
 	var data []*store.ScanOutputEntity
 
 	for {
@@ -144,8 +124,37 @@ func AdminDBClean(c *cli.Context) {
 			continue
 		}
 
-		fmt.Println(string(data))
+		Render(string(data))
+	}
+}
+
+func getScanTypeInvariants(c *cli.Context) (entity.Entity, []executions.InvariantFactory) {
+	scanType, err := executions.ScanTypeString(getRequiredOption(c, FlagScanType))
+
+	if err != nil {
+		ErrorAndExit("unknown scan type", err)
+	}
+	collectionSlice := c.StringSlice(FlagInvariantCollection)
+
+	var collections []invariant.Collection
+	for _, v := range collectionSlice {
+		collection, err := invariant.CollectionString(v)
+		if err != nil {
+			ErrorAndExit("unknown invariant collection", err)
+		}
+		collections = append(collections, collection)
+	}
+
+	invariants := scanType.ToInvariants(collections)
+	if len(invariants) < 1 {
+		ErrorAndExit(
+			fmt.Sprintf("no invariants for scantype %q and collections %q",
+				scanType.String(),
+				collectionSlice),
+			nil,
+		)
 	}
+	return scanType.ToBlobstoreEntity(), invariants
 }
 
 func fixExecution(
@@ -28,13 +28,17 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/collection"
+	"github.com/uber/cadence/common/pagination"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/reconciliation/entity"
 	"github.com/uber/cadence/common/reconciliation/fetcher"
 	"github.com/uber/cadence/common/reconciliation/invariant"
 	"github.com/uber/cadence/common/reconciliation/store"
@@ -43,11 +47,29 @@ import (
 
 const (
 	listContextTimeout = time.Minute
+
+	dbCollectionExecutions = "executions"
+	dbCollectionTasks      = "tasks"
+	dbCollectionHistory    = "history"
 )
 
+var dbCollections = []string{dbCollectionExecutions, dbCollectionTasks, dbCollectionHistory}
+
+// dbScanCollection defines how entities of a collection are listed from a shard and which invariants are checked
+type dbScanCollection struct {
+	iterator   func(ctx context.Context, retryer persistence.Retryer, pageSize int) pagination.Iterator
+	invariants []executions.InvariantFactory
+	blob       entity.Entity
+}
+
 // AdminDBScan is used to scan over executions in database and detect corruptions.
 func AdminDBScan(c *cli.Context) {
-	scanType, err := executions.ScanTypeString(c.String(FlagScanType))
+	if c.IsSet(FlagDBCollection) {
+		adminDBScanShards(c)
+		return
+	}
+
+	scanType, err := executions.ScanTypeString(getRequiredOption(c, FlagScanType))
 
 	if err != nil {
 		ErrorAndExit("unknown scan type", err)
@@ -107,7 +129,7 @@ func AdminDBScan(c *cli.Context) {
 			continue
 		}
 
-		fmt.Println(string(data))
+		Render(string(data))
 	}
 }
 
@@ -148,13 +170,145 @@ func checkExecution(
 	return execution, invariant.NewInvariantManager(ivs).RunChecks(ctx, execution)
 }
 
+// adminDBScanShards scans all entities of a collection in the given shard range over the persistence interfaces,
+// so it works the same for all persistence backends. Corrupted and failed entities are written to stdout
+// in the format accepted by `admin db clean`, and a summary of each shard is written to stderr.
+func adminDBScanShards(c *cli.Context) {
+	dbCollection := getDBScanCollection(c)
+	startShardID, endShardID := parseShardRange(getRequiredOption(c, FlagShardRange))
+	pageSize := c.Int(FlagPageSize)
+
+	for shardID := startShardID; shardID <= endShardID; shardID++ {
+		scanned, corrupted, failed := scanShard(c, shardID, dbCollection, pageSize)
+		fmt.Fprintf(os.Stderr, "Shard %v scan is completed. Scanned: %v, corrupted: %v, failed: %v.\n", shardID, scanned, corrupted, failed)
+	}
+}
+
+func scanShard(
+	c *cli.Context,
+	shardID int,
+	dbCollection *dbScanCollection,
+	pageSize int,
+) (scanned int, corrupted int, failed int) {
+
+	execManager := initializeExecutionStore(c, shardID)
+	defer execManager.Close()
+
+	historyV2Mgr := initializeHistoryManager(c)
+	defer historyV2Mgr.Close()
+
+	pr := persistence.NewPersistenceRetryer(
+		execManager,
+		historyV2Mgr,
+		common.CreatePersistenceRetryPolicy(),
+	)
+
+	var ivs []invariant.Invariant
+	for _, fn := range dbCollection.invariants {
+		ivs = append(ivs, fn(pr))
+	}
+	manager := invariant.NewInvariantManager(ivs)
+
+	ctx := context.Background()
+	iterator := dbCollection.iterator(ctx, pr, pageSize)
+	for iterator.HasNext() {
+		e, err := iterator.Next()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to scan shard ID: %v. Please retry.", shardID), err)
+		}
+		scanned++
+
+		checkCtx, cancel := newContext(c)
+		result := manager.RunChecks(checkCtx, e)
+		cancel()
+		switch result.CheckResultType {
+		case invariant.CheckResultTypeHealthy:
+			continue
+		case invariant.CheckResultTypeCorrupted:
+			corrupted++
+		default:
+			failed++
+		}
+
+		data, err := json.Marshal(store.ScanOutputEntity{
+			Execution: e,
+			Result:    result,
+		})
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+			continue
+		}
+		Render(string(data))
+	}
+	return scanned, corrupted, failed
+}
+
+func getDBScanCollection(c *cli.Context) *dbScanCollection {
+	switch name := c.String(FlagDBCollection); name {
+	case dbCollectionExecutions:
+		return &dbScanCollection{
+			iterator:   fetcher.ConcreteExecutionIterator,
+			invariants: []executions.InvariantFactory{invariant.NewOpenCurrentExecution},
+			blob:       &entity.ConcreteExecution{},
+		}
+	case dbCollectionHistory:
+		return &dbScanCollection{
+			iterator:   fetcher.ConcreteExecutionIterator,
+			invariants: []executions.InvariantFactory{invariant.NewHistoryExists},
+			blob:       &entity.ConcreteExecution{},
+		}
+	case dbCollectionTasks:
+		startTime, err := parseSingleTs(c.String(FlagStartDate))
+		if err != nil {
+			ErrorAndExit("wrong date format for "+FlagStartDate, err)
+		}
+		endTime, err := parseSingleTs(c.String(FlagEndDate))
+		if err != nil {
+			ErrorAndExit("wrong date format for "+FlagEndDate, err)
+		}
+		return &dbScanCollection{
+			iterator: func(ctx context.Context, retryer persistence.Retryer, pageSize int) pagination.Iterator {
+				return fetcher.TimerIterator(ctx, retryer, startTime, endTime, pageSize)
+			},
+			invariants: []executions.InvariantFactory{invariant.NewTimerInvalid},
+			blob:       &entity.Timer{},
+		}
+	default:
+		ErrorAndExit(fmt.Sprintf("unknown collection %q, supported collections: %v", name, strings.Join(dbCollections, ", ")), nil)
+	}
+	return nil
+}
+
+// parseShardRange parses inclusive shard range in format of <start>-<end>, or a single shard ID
+func parseShardRange(shardRange string) (int, int) {
+	parts := strings.Split(shardRange, "-")
+	if len(parts) > 2 {
+		ErrorAndExit(fmt.Sprintf("invalid shard range %q, expected format: <start>-<end>", shardRange), nil)
+	}
+	start, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("invalid shard range %q, expected format: <start>-<end>", shardRange), err)
+	}
+	end := start
+	if len(parts) == 2 {
+		end, err = strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("invalid shard range %q, expected format: <start>-<end>", shardRange), err)
+		}
+	}
+	if start < 0 || start > end {
+		ErrorAndExit(fmt.Sprintf("invalid shard range %q, start must be non-negative and not greater than end", shardRange), nil)
+	}
+	return start, end
+}
+
 // AdminDBScanUnsupportedWorkflow is to scan DB for unsupported workflow for a new release
 func AdminDBScanUnsupportedWorkflow(c *cli.Context) {
 	outputFile := getOutputFile(c.String(FlagOutputFilename))
 	startShardID := c.Int(FlagLowerShardBound)
 	endShardID := c.Int(FlagUpperShardBound)
 
-	defer outputFile.Close()
+	defer releaseOutputFile(outputFile)
 	for i := startShardID; i <= endShardID; i++ {
 		listExecutionsByShardID(c, i, outputFile)
 		fmt.Printf("Shard %v scan operation is completed.\n", i)
@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -30,18 +31,34 @@ import (
 	"strings"
 	"time"
 
+	"github.com/pborman/uuid"
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/collection"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/dlqmerger"
 )
 
 const (
 	defaultPageSize = 1000
 )
 
+// DLQMessageRow is a presentation layer entity use to render a summary of a replication DLQ message
+type DLQMessageRow struct {
+	ShardID        int    `header:"Shard"`
+	MessageID      int64  `header:"Message ID"`
+	TaskType       string `header:"Task Type"`
+	Domain         string `header:"Domain"`
+	WorkflowID     string `header:"Workflow ID"`
+	RunID          string `header:"Run ID"`
+	EventIDs       string `header:"Event IDs"`
+	Version        int64  `header:"Version"`
+	CreationTime   string `header:"Created"`
+	ExpirationTime string `header:"Expires"`
+}
+
 // AdminGetDLQMessages gets DLQ metadata
 func AdminGetDLQMessages(c *cli.Context) {
 	ctx, cancel := newContext(c)
@@ -50,12 +67,21 @@ func AdminGetDLQMessages(c *cli.Context) {
 	adminClient := cFactory.ServerAdminClient(c)
 	dlqType := getRequiredOption(c, FlagDLQType)
 	sourceCluster := getRequiredOption(c, FlagSourceCluster)
-	shardID := getRequiredIntOption(c, FlagShardID)
+	var startShardID, endShardID int
+	if c.IsSet(FlagShardRange) {
+		startShardID, endShardID = parseShardRange(c.String(FlagShardRange))
+	} else {
+		startShardID = getRequiredIntOption(c, FlagShardID)
+		endShardID = startShardID
+	}
 	serializer := persistence.NewPayloadSerializer()
 	outputFile := getOutputFile(c.String(FlagOutputFilename))
-	defer outputFile.Close()
+	defer releaseOutputFile(outputFile)
 
 	showRawTask := c.Bool(FlagDLQRawTask)
+	showRawJSON := c.Bool(FlagRaw)
+	var rows []DLQMessageRow
+	domainName := newDomainNameResolver(ctx, c)
 	var rawTasksInfo []*types.ReplicationTaskInfo
 	remainingMessageCount := common.EndMessageID
 	if c.IsSet(FlagMaxMessageCount) {
@@ -66,6 +92,14 @@ func AdminGetDLQMessages(c *cli.Context) {
 		lastMessageID = c.Int64(FlagLastMessageID)
 	}
 
+	// raw messages are streamed to the output file, only show progress when rendering a table
+	progress := newPageProgress(c, "DLQ messages")
+	if showRawJSON {
+		progress = &pageProgress{}
+	}
+	var shardID int
+	// expiration times of the messages of the current shard by message ID, only set when the DLQ max age is configured
+	var expirationTimes map[int64]int64
 	paginationFunc := func(paginationToken []byte) ([]interface{}, []byte, error) {
 		resp, err := adminClient.ReadDLQMessages(ctx, &types.ReadDLQMessagesRequest{
 			Type:                  toQueueType(dlqType),
@@ -82,35 +116,66 @@ func AdminGetDLQMessages(c *cli.Context) {
 		for _, item := range resp.GetReplicationTasks() {
 			paginateItems = append(paginateItems, item)
 		}
+		for _, info := range resp.GetReplicationTasksInfo() {
+			if info.ExpirationTime != nil {
+				expirationTimes[info.GetTaskID()] = info.GetExpirationTime()
+			}
+		}
 		if showRawTask {
 			rawTasksInfo = append(rawTasksInfo, resp.GetReplicationTasksInfo()...)
 		}
+		progress.addPage(len(paginateItems))
 
 		return paginateItems, resp.GetNextPageToken(), err
 	}
 
-	iterator := collection.NewPagingIterator(paginationFunc)
 	var lastReadMessageID int
-	for iterator.HasNext() && remainingMessageCount > 0 {
-		item, err := iterator.Next()
-		if err != nil {
-			ErrorAndExit(fmt.Sprintf("fail to read dlq message. Last read message id: %v", lastReadMessageID), err)
-		}
+	// shards are read one after the other, the max message count applies to the whole range
+	for shardID = startShardID; shardID <= endShardID && remainingMessageCount > 0; shardID++ {
+		expirationTimes = make(map[int64]int64)
+		iterator := collection.NewPagingIterator(paginationFunc)
+		for iterator.HasNext() && remainingMessageCount > 0 {
+			item, err := iterator.Next()
+			if err != nil {
+				progress.clear()
+				ErrorAndExit(fmt.Sprintf("fail to read dlq message in shard %v. Last read message id: %v", shardID, lastReadMessageID), err)
+			}
 
-		task := item.(*types.ReplicationTask)
-		taskStr, err := decodeReplicationTask(task, serializer)
-		if err != nil {
-			ErrorAndExit(fmt.Sprintf("fail to encode dlq message. Last read message id: %v", lastReadMessageID), err)
-		}
+			task := item.(*types.ReplicationTask)
+			if !showRawJSON {
+				row, err := newDLQMessageRow(task, serializer, domainName)
+				if err != nil {
+					ErrorAndExit(fmt.Sprintf("fail to decode dlq message in shard %v. Last read message id: %v", shardID, lastReadMessageID), err)
+				}
+				row.ShardID = shardID
+				if expirationTime, ok := expirationTimes[task.GetSourceTaskID()]; ok {
+					row.ExpirationTime = convertTime(expirationTime, false)
+				}
+				rows = append(rows, row)
+				lastReadMessageID = int(task.SourceTaskID)
+				remainingMessageCount--
+				continue
+			}
 
-		lastReadMessageID = int(task.SourceTaskID)
-		remainingMessageCount--
-		_, err = outputFile.WriteString(fmt.Sprintf("%v\n", string(taskStr)))
-		if err != nil {
-			ErrorAndExit("fail to print dlq messages.", err)
+			taskStr, err := decodeReplicationTask(task, serializer)
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("fail to encode dlq message in shard %v. Last read message id: %v", shardID, lastReadMessageID), err)
+			}
+
+			lastReadMessageID = int(task.SourceTaskID)
+			remainingMessageCount--
+			_, err = outputFile.WriteString(fmt.Sprintf("%v\n", string(taskStr)))
+			if err != nil {
+				ErrorAndExit("fail to print dlq messages.", err)
+			}
 		}
 	}
 
+	progress.clear()
+	if !showRawJSON {
+		RenderTable(outputFile, rows, TableOptions{Color: outputFile == os.Stdout, Border: true, PrintDateTime: true})
+	}
+
 	if showRawTask {
 		_, err := outputFile.WriteString("#### REPLICATION DLQ RAW TASKS INFO ####\n")
 		if err != nil {
@@ -137,6 +202,83 @@ func AdminGetDLQMessages(c *cli.Context) {
 	}
 }
 
+// newDLQMessageRow summarizes a replication task read from the DLQ, history events are decoded
+// only to report the range of event IDs the task carries
+func newDLQMessageRow(
+	task *types.ReplicationTask,
+	serializer persistence.PayloadSerializer,
+	domainName func(domainID string) string,
+) (DLQMessageRow, error) {
+
+	row := DLQMessageRow{
+		MessageID: task.GetSourceTaskID(),
+		TaskType:  task.GetTaskType().String(),
+	}
+	creationTime := task.GetCreationTime()
+
+	switch task.GetTaskType() {
+	case types.ReplicationTaskTypeHistoryV2:
+		attributes := task.GetHistoryTaskV2Attributes()
+		row.Domain = domainName(attributes.GetDomainID())
+		row.WorkflowID = attributes.GetWorkflowID()
+		row.RunID = attributes.GetRunID()
+		if attributes.GetEvents() != nil {
+			events, err := serializer.DeserializeBatchEvents(persistence.NewDataBlobFromInternal(attributes.GetEvents()))
+			if err != nil {
+				return row, err
+			}
+			if len(events) > 0 {
+				lastEvent := events[len(events)-1]
+				row.EventIDs = fmt.Sprintf("%v→%v", events[0].ID, lastEvent.ID+1)
+				row.Version = lastEvent.Version
+			}
+		}
+	case types.ReplicationTaskTypeSyncActivity:
+		attributes := task.GetSyncActivityTaskAttributes()
+		row.Domain = domainName(attributes.GetDomainID())
+		row.WorkflowID = attributes.GetWorkflowID()
+		row.RunID = attributes.GetRunID()
+		row.Version = attributes.GetVersion()
+	case types.ReplicationTaskTypeDomain:
+		attributes := task.GetDomainTaskAttributes()
+		row.Domain = attributes.GetInfo().GetName()
+		row.Version = attributes.GetFailoverVersion()
+	case types.ReplicationTaskTypeFailoverMarker:
+		attributes := task.GetFailoverMarkerAttributes()
+		row.Domain = domainName(attributes.GetDomainID())
+		row.Version = attributes.GetFailoverVersion()
+		if creationTime == 0 {
+			creationTime = attributes.GetCreationTime()
+		}
+	}
+
+	if creationTime != 0 {
+		row.CreationTime = convertTime(creationTime, false)
+	}
+	return row, nil
+}
+
+// newDomainNameResolver returns a function mapping domain IDs to domain names, falling back to
+// the domain ID when the domain cannot be described. Names are cached for the lifetime of the function.
+func newDomainNameResolver(ctx context.Context, c *cli.Context) func(domainID string) string {
+	names := make(map[string]string)
+	return func(domainID string) string {
+		if domainID == "" {
+			return ""
+		}
+		if name, ok := names[domainID]; ok {
+			return name
+		}
+		name := domainID
+		resp, err := cFactory.ServerFrontendClient(c).DescribeDomain(ctx, &types.DescribeDomainRequest{UUID: common.StringPtr(domainID)})
+		if err == nil && resp.GetDomainInfo().GetName() != "" {
+			name = resp.GetDomainInfo().GetName()
+		}
+		names[domainID] = name
+		return name
+	}
+}
+
 // AdminPurgeDLQMessages deletes messages from DLQ
 func AdminPurgeDLQMessages(c *cli.Context) {
 	dlqType := getRequiredOption(c, FlagDLQType)
@@ -146,8 +288,36 @@ func AdminPurgeDLQMessages(c *cli.Context) {
 		lastMessageID = common.Int64Ptr(c.Int64(FlagLastMessageID))
 	}
 
+	confirmTypedName(c, fmt.Sprintf("purge %s DLQ messages of", dlqType), "source cluster", sourceCluster)
+
 	adminClient := cFactory.ServerAdminClient(c)
+	results := newBatchResults("Purge DLQ messages")
+	if c.IsSet(FlagShardRange) {
+		// the whole range is purged by the server in a single request
+		startShardID, endShardID := parseShardRange(c.String(FlagShardRange))
+		shards := fmt.Sprintf("shards %v-%v", startShardID, endShardID)
+		results.trackProgress(c, 1)
+		results.start(shards)
+		ctx, cancel := newContext(c)
+		err := adminClient.PurgeDLQMessages(ctx, &types.PurgeDLQMessagesRequest{
+			Type:                  toQueueType(dlqType),
+			SourceCluster:         sourceCluster,
+			ShardID:               int32(startShardID),
+			EndShardID:            int32(endShardID),
+			InclusiveEndMessageID: lastMessageID,
+		})
+		cancel()
+		if err != nil {
+			results.fail(shards, err)
+		} else {
+			results.succeed(shards)
+		}
+		results.finish(c)
+		return
+	}
+	results.trackProgress(c, getShardCount(c))
 	for shardID := range getShards(c) {
+		results.start(fmt.Sprintf("shard %v", shardID))
 		ctx, cancel := newContext(c)
 		err := adminClient.PurgeDLQMessages(ctx, &types.PurgeDLQMessagesRequest{
 			Type:                  toQueueType(dlqType),
@@ -157,12 +327,15 @@ func AdminPurgeDLQMessages(c *cli.Context) {
 		})
 		cancel()
 		if err != nil {
+			results.fail(fmt.Sprintf("shard %v", shardID), err)
 			fmt.Printf("Failed to purge DLQ message in shard %v with error: %v.\n", shardID, err)
 			continue
 		}
 		time.Sleep(10 * time.Millisecond)
+		results.succeed(fmt.Sprintf("shard %v", shardID))
 		fmt.Printf("Successfully purge DLQ Messages in shard %v.\n", shardID)
 	}
+	results.finish(c)
 }
 
 // AdminMergeDLQMessages merges message from DLQ
@@ -173,10 +346,48 @@ func AdminMergeDLQMessages(c *cli.Context) {
 	if c.IsSet(FlagLastMessageID) {
 		lastMessageID = common.Int64Ptr(c.Int64(FlagLastMessageID))
 	}
+	if c.IsSet(FlagSchedule) || c.IsSet(FlagRPS) || c.Bool(FlagAsync) {
+		startScheduledDLQMerge(c, *toQueueType(dlqType), sourceCluster, lastMessageID)
+		return
+	}
 
 	adminClient := cFactory.ServerAdminClient(c)
+	results := newBatchResults("Merge DLQ messages")
+	if c.IsSet(FlagShardRange) {
+		// the server walks the range, the page token tracks the shard being merged
+		startShardID, endShardID := parseShardRange(c.String(FlagShardRange))
+		shards := fmt.Sprintf("shards %v-%v", startShardID, endShardID)
+		results.trackProgress(c, 1)
+		results.start(shards)
+		request := &types.MergeDLQMessagesRequest{
+			Type:                  toQueueType(dlqType),
+			SourceCluster:         sourceCluster,
+			ShardID:               int32(startShardID),
+			EndShardID:            int32(endShardID),
+			InclusiveEndMessageID: lastMessageID,
+			MaximumPageSize:       defaultPageSize,
+		}
+		for {
+			ctx, cancel := newContext(c)
+			response, err := adminClient.MergeDLQMessages(ctx, request)
+			cancel()
+			if err != nil {
+				results.fail(shards, err)
+				break
+			}
+			if len(response.NextPageToken) == 0 {
+				results.succeed(shards)
+				break
+			}
+			request.NextPageToken = response.NextPageToken
+		}
+		results.finish(c)
+		return
+	}
+	results.trackProgress(c, getShardCount(c))
 ShardIDLoop:
 	for shardID := range getShards(c) {
+		results.start(fmt.Sprintf("shard %v", shardID))
 		request := &types.MergeDLQMessagesRequest{
 			Type:                  toQueueType(dlqType),
 			SourceCluster:         sourceCluster,
@@ -190,6 +401,7 @@ ShardIDLoop:
 			response, err := adminClient.MergeDLQMessages(ctx, request)
 			cancel()
 			if err != nil {
+				results.fail(fmt.Sprintf("shard %v", shardID), err)
 				fmt.Printf("Failed to merge DLQ message in shard %v with error: %v.\n", shardID, err)
 				continue ShardIDLoop
 			}
@@ -200,25 +412,112 @@ ShardIDLoop:
 
 			request.NextPageToken = response.NextPageToken
 		}
+		results.succeed(fmt.Sprintf("shard %v", shardID))
 		fmt.Printf("Successfully merged all messages in shard %v.\n", shardID)
 	}
+	results.finish(c)
+}
+
+// startScheduledDLQMerge hands the merge over to a system workflow in the worker service,
+// which throttles it and only runs within the given window
+func startScheduledDLQMerge(c *cli.Context, dlqType types.DLQType, sourceCluster string, lastMessageID *int64) {
+	var window *dlqmerger.MergeWindow
+	if c.IsSet(FlagSchedule) {
+		var err error
+		window, err = dlqmerger.ParseMergeWindow(c.String(FlagSchedule))
+		if err != nil {
+			ErrorAndExit("Invalid schedule.", err)
+		}
+	}
+	rps := c.Int(FlagRPS)
+	if rps < 0 {
+		ErrorAndExit("RPS must not be negative.", nil)
+	}
+	var shards []int
+	for shardID := range getShards(c) {
+		shards = append(shards, shardID)
+	}
+
+	input, err := json.Marshal(&dlqmerger.MergeParams{
+		Type:                  dlqType,
+		SourceCluster:         sourceCluster,
+		Shards:                shards,
+		InclusiveEndMessageID: lastMessageID,
+		Window:                window,
+		RPS:                   rps,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize merge params", err)
+	}
+	memo, err := getWorkflowMemo(map[string]interface{}{
+		common.MemoKeyForOperator: getOperator(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to serialize memo", err)
+	}
+	request := &types.StartWorkflowExecutionRequest{
+		Domain:                              common.SystemLocalDomainName,
+		RequestID:                           uuid.New(),
+		WorkflowID:                          dlqmerger.GetWorkflowID(dlqType, sourceCluster),
+		WorkflowIDReusePolicy:               types.WorkflowIDReusePolicyAllowDuplicate.Ptr(),
+		TaskList:                            &types.TaskList{Name: dlqmerger.TaskListName},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(defaultDLQMergeWorkflowTimeoutInSeconds),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+		Memo:                                memo,
+		WorkflowType:                        &types.WorkflowType{Name: dlqmerger.WorkflowTypeName},
+		Input:                               input,
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := getCadenceClient(c).StartWorkflowExecution(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to start DLQ merge workflow", err)
+	}
+	fmt.Printf("DLQ merge workflow started for %v shard(s), window: %v, rps: %v\n", len(shards), window, rps)
+	fmt.Println("wid: " + request.WorkflowID)
+	fmt.Println("rid: " + resp.GetRunID())
+	fmt.Println("Track it with: cadence admin job describe --job_id " + request.WorkflowID)
 }
 
 func getShards(c *cli.Context) chan int {
-	// Check if we have stdin available
-	stat, err := os.Stdin.Stat()
-	if err == nil && (stat.Mode()&os.ModeCharDevice) == 0 {
+	if isShardsFromStdin() {
 		return readShardsFromStdin()
 	}
 
 	return generateShardRangeFromFlags(c)
 }
 
+// getShardCount returns the number of shards returned by getShards, or 0 if they are read from stdin
+func getShardCount(c *cli.Context) int {
+	if isShardsFromStdin() {
+		return 0
+	}
+	lower := c.Int(FlagLowerShardBound)
+	upper := c.Int(FlagUpperShardBound)
+	if c.IsSet(FlagShardRange) {
+		lower, upper = parseShardRange(c.String(FlagShardRange))
+	}
+	if upper < lower {
+		return 0
+	}
+	return upper - lower + 1
+}
+
+// isShardsFromStdin returns true if stdin is piped, the shards are read from it then
+func isShardsFromStdin() bool {
+	stat, err := os.Stdin.Stat()
+	return err == nil && (stat.Mode()&os.ModeCharDevice) == 0
+}
+
 func generateShardRangeFromFlags(c *cli.Context) chan int {
+	lower := c.Int(FlagLowerShardBound)
+	upper := c.Int(FlagUpperShardBound)
+	if c.IsSet(FlagShardRange) {
+		lower, upper = parseShardRange(c.String(FlagShardRange))
+	}
 	shards := make(chan int)
 	go func() {
-		lower := c.Int(FlagLowerShardBound)
-		upper := c.Int(FlagUpperShardBound)
 		for shard := lower; shard <= upper; shard++ {
 			shards <- shard
 		}
@@ -253,13 +552,8 @@ func readShardsFromStdin() chan int {
 }
 
 func toQueueType(dlqType string) *types.DLQType {
-	switch dlqType {
-	case "domain":
+	if mustParseEnumValue(FlagDLQType, dlqType, []string{"domain", "history"}) == "domain" {
 		return types.DLQTypeDomain.Ptr()
-	case "history":
-		return types.DLQTypeReplication.Ptr()
-	default:
-		ErrorAndExit("The queue type is not supported.", fmt.Errorf("the queue type is not supported. Type: %v", dlqType))
 	}
-	return nil
+	return types.DLQTypeReplication.Ptr()
 }
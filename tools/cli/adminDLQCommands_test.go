@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestNewDLQMessageRow(t *testing.T) {
+	serializer := persistence.NewPayloadSerializer()
+	domainName := func(domainID string) string { return "name-of-" + domainID }
+
+	blob, err := serializer.SerializeBatchEvents([]*types.HistoryEvent{
+		{ID: 5, Version: 10},
+		{ID: 6, Version: 10},
+		{ID: 7, Version: 12},
+	}, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	row, err := newDLQMessageRow(&types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeHistoryV2.Ptr(),
+		SourceTaskID: 100,
+		HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+			DomainID:   "domain-id",
+			WorkflowID: "wid",
+			RunID:      "rid",
+			Events:     blob.ToInternal(),
+		},
+		CreationTime: common.Int64Ptr(1),
+	}, serializer, domainName)
+	require.NoError(t, err)
+	assert.Equal(t, DLQMessageRow{
+		MessageID:    100,
+		TaskType:     "HistoryV2",
+		Domain:       "name-of-domain-id",
+		WorkflowID:   "wid",
+		RunID:        "rid",
+		EventIDs:     "5→8",
+		Version:      12,
+		CreationTime: convertTime(1, false),
+	}, row)
+
+	row, err = newDLQMessageRow(&types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeSyncActivity.Ptr(),
+		SourceTaskID: 101,
+		SyncActivityTaskAttributes: &types.SyncActivityTaskAttributes{
+			DomainID:   "domain-id",
+			WorkflowID: "wid",
+			RunID:      "rid",
+			Version:    3,
+		},
+	}, serializer, domainName)
+	require.NoError(t, err)
+	assert.Equal(t, DLQMessageRow{
+		MessageID:  101,
+		TaskType:   "SyncActivity",
+		Domain:     "name-of-domain-id",
+		WorkflowID: "wid",
+		RunID:      "rid",
+		Version:    3,
+	}, row)
+
+	row, err = newDLQMessageRow(&types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeDomain.Ptr(),
+		SourceTaskID: 102,
+		DomainTaskAttributes: &types.DomainTaskAttributes{
+			Info:            &types.DomainInfo{Name: "domain"},
+			FailoverVersion: 20,
+		},
+	}, serializer, domainName)
+	require.NoError(t, err)
+	assert.Equal(t, DLQMessageRow{MessageID: 102, TaskType: "Domain", Domain: "domain", Version: 20}, row)
+
+	row, err = newDLQMessageRow(&types.ReplicationTask{
+		TaskType:     types.ReplicationTaskTypeFailoverMarker.Ptr(),
+		SourceTaskID: 103,
+		FailoverMarkerAttributes: &types.FailoverMarkerAttributes{
+			DomainID:        "domain-id",
+			FailoverVersion: 21,
+			CreationTime:    common.Int64Ptr(2),
+		},
+	}, serializer, domainName)
+	require.NoError(t, err)
+	assert.Equal(t, DLQMessageRow{
+		MessageID:    103,
+		TaskType:     "FailoverMarker",
+		Domain:       "name-of-domain-id",
+		Version:      21,
+		CreationTime: convertTime(2, false),
+	}, row)
+
+	_, err = newDLQMessageRow(&types.ReplicationTask{
+		TaskType: types.ReplicationTaskTypeHistoryV2.Ptr(),
+		HistoryTaskV2Attributes: &types.HistoryTaskV2Attributes{
+			Events: &types.DataBlob{EncodingType: types.EncodingTypeThriftRW.Ptr(), Data: []byte("corrupted")},
+		},
+	}, serializer, domainName)
+	assert.Error(t, err)
+}
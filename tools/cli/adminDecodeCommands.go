@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+)
+
+type (
+	// taskTokenView is the decoded content of a task token, with the domain ID resolved to the domain name
+	taskTokenView struct {
+		Domain          string `json:"domain"`
+		DomainID        string `json:"domainId"`
+		WorkflowID      string `json:"workflowId"`
+		RunID           string `json:"runId"`
+		WorkflowType    string `json:"workflowType,omitempty"`
+		ScheduleID      int64  `json:"scheduleId"`
+		ScheduleAttempt int64  `json:"scheduleAttempt"`
+		ActivityID      string `json:"activityId,omitempty"`
+		ActivityType    string `json:"activityType,omitempty"`
+	}
+)
+
+// AdminDecodeTaskToken prints the content of a decision or activity task token
+func AdminDecodeTaskToken(c *cli.Context) {
+	token, err := decodeTaskToken(getRequiredOption(c, FlagTaskToken))
+	if err != nil {
+		ErrorAndExit("Failed to decode task token.", err)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	prettyPrintJSONObject(newTaskTokenView(token, newDomainNameResolver(ctx, c)))
+}
+
+// AdminDecodeActivityTaskToken prints the content of an activity task token
+func AdminDecodeActivityTaskToken(c *cli.Context) {
+	token, err := decodeTaskToken(getRequiredOption(c, FlagTaskToken))
+	if err != nil {
+		ErrorAndExit("Failed to decode activity task token.", err)
+	}
+	if token.ActivityID == "" {
+		ErrorAndExit("Token is not an activity task token, it has no activity ID.", nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	prettyPrintJSONObject(newTaskTokenView(token, newDomainNameResolver(ctx, c)))
+	if token.ScheduleID == common.EmptyEventID {
+		fmt.Println("The token was built from the activity ID, look the activity up by its ID instead of the schedule ID.")
+	}
+}
+
+// decodeTaskToken decodes a base64 task token, as logged by the client libraries
+func decodeTaskToken(encoded string) (*common.TaskToken, error) {
+	encoded = strings.TrimSpace(encoded)
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		// some client libraries log tokens with the URL safe alphabet
+		if data, err = base64.URLEncoding.DecodeString(encoded); err != nil {
+			return nil, fmt.Errorf("token is not base64 encoded: %v", err)
+		}
+	}
+
+	token, err := common.NewJSONTaskTokenSerializer().Deserialize(data)
+	if err != nil {
+		return nil, err
+	}
+	if token.DomainID == "" || token.WorkflowID == "" {
+		return nil, fmt.Errorf("token has no domain or workflow ID")
+	}
+	return token, nil
+}
+
+func newTaskTokenView(token *common.TaskToken, domainName func(domainID string) string) taskTokenView {
+	return taskTokenView{
+		Domain:          domainName(token.DomainID),
+		DomainID:        token.DomainID,
+		WorkflowID:      token.WorkflowID,
+		RunID:           token.RunID,
+		WorkflowType:    token.WorkflowType,
+		ScheduleID:      token.ScheduleID,
+		ScheduleAttempt: token.ScheduleAttempt,
+		ActivityID:      token.ActivityID,
+		ActivityType:    token.ActivityType,
+	}
+}
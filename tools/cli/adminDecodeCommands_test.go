@@ -0,0 +1,77 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+)
+
+func TestDecodeTaskToken(t *testing.T) {
+	token := &common.TaskToken{
+		DomainID:        "domain-id",
+		WorkflowID:      "workflow-id",
+		RunID:           "run-id",
+		ScheduleID:      5,
+		ScheduleAttempt: 1,
+		ActivityID:      "activity-id",
+		ActivityType:    "activity-type",
+	}
+	data, err := common.NewJSONTaskTokenSerializer().Serialize(token)
+	require.NoError(t, err)
+
+	decoded, err := decodeTaskToken(base64.StdEncoding.EncodeToString(data) + "\n")
+	assert.NoError(t, err)
+	assert.Equal(t, token, decoded)
+
+	decoded, err = decodeTaskToken(base64.URLEncoding.EncodeToString(data))
+	assert.NoError(t, err)
+	assert.Equal(t, token, decoded)
+
+	_, err = decodeTaskToken("not a token")
+	assert.Error(t, err)
+
+	_, err = decodeTaskToken(base64.StdEncoding.EncodeToString([]byte(`{"taskList":"tl"}`)))
+	assert.Error(t, err)
+}
+
+func TestNewTaskTokenView(t *testing.T) {
+	view := newTaskTokenView(&common.TaskToken{
+		DomainID:   "domain-id",
+		WorkflowID: "workflow-id",
+		RunID:      "run-id",
+		ScheduleID: 5,
+	}, func(domainID string) string {
+		return "domain"
+	})
+	assert.Equal(t, taskTokenView{
+		Domain:     "domain",
+		DomainID:   "domain-id",
+		WorkflowID: "workflow-id",
+		RunID:      "run-id",
+		ScheduleID: 5,
+	}, view)
+}
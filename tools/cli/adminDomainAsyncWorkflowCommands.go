@@ -0,0 +1,74 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// AdminUpdateDomainAsyncWorkflowConfig replaces the async workflow config of a domain
+func AdminUpdateDomainAsyncWorkflowConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	enabled := c.Bool(FlagAsyncWorkflowEnabled)
+	queueProvider := c.String(FlagAsyncWorkflowQueueProvider)
+	queueTopic := c.String(FlagAsyncWorkflowQueueTopic)
+	if enabled && (queueProvider == "" || queueTopic == "") {
+		ErrorAndExit("Queue provider and topic are required to enable async workflows.", nil)
+	}
+	rps := c.Int(FlagAsyncWorkflowRPS)
+	if rps < 0 {
+		ErrorAndExit("RPS must not be negative.", nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UpdateDomainAsyncWorkflowConfig(ctx, &types.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain: domain,
+		Configuration: &types.AsyncWorkflowConfiguration{
+			Enabled:       enabled,
+			QueueProvider: queueProvider,
+			QueueTopic:    queueTopic,
+			RPS:           int32(rps),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to update async workflow config.", err)
+	}
+}
+
+// AdminDescribeDomainAsyncWorkflowConfig prints the async workflow config of a domain
+func AdminDescribeDomainAsyncWorkflowConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.GetDomainAsyncWorkflowConfig(ctx, &types.GetDomainAsyncWorkflowConfigRequest{
+		Domain: domain,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get async workflow config.", err)
+	}
+	prettyPrintJSONObject(resp.GetConfiguration())
+}
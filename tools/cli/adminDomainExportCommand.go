@@ -0,0 +1,203 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+	jsonmapper "github.com/uber/cadence/common/types/mapper/json"
+)
+
+// Files written by admin domain export. Records use the JSON mapping of common/types/mapper/json:
+//
+//	manifest.json           exportManifest describing the export
+//	domain.json             domain metadata and configuration
+//	open_workflows.jsonl    one open workflow execution per line
+//	closed_workflows.jsonl  one closed workflow execution per line
+//	histories/              with --include_history, one <workflowID>_<runID>.json file per execution
+//	                        holding its history events as an array, both IDs are path escaped
+const (
+	domainExportManifestFile        = "manifest.json"
+	domainExportDomainFile          = "domain.json"
+	domainExportOpenWorkflowsFile   = "open_workflows.jsonl"
+	domainExportClosedWorkflowsFile = "closed_workflows.jsonl"
+	domainExportHistoriesDir        = "histories"
+
+	domainExportFormatVersion = 1
+)
+
+type exportManifest struct {
+	FormatVersion       int    `json:"formatVersion"`
+	Domain              string `json:"domain"`
+	DomainID            string `json:"domainID"`
+	ExportTime          string `json:"exportTime"`
+	EarliestStartTime   string `json:"earliestStartTime"`
+	LatestStartTime     string `json:"latestStartTime"`
+	OpenWorkflowCount   int    `json:"openWorkflowCount"`
+	ClosedWorkflowCount int    `json:"closedWorkflowCount"`
+	IncludesHistory     bool   `json:"includesHistory"`
+}
+
+// AdminExportDomain exports the metadata, visibility records and optionally the histories of
+// all workflows of a domain started within a time range to files in a directory
+func AdminExportDomain(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	outDir := getRequiredOption(c, FlagOut)
+	earliestTime := parseTime(c.String(FlagEarliestTime), 0)
+	latestTime := parseTime(c.String(FlagLatestTime), time.Now().UnixNano())
+	includeHistory := c.Bool(FlagIncludeHistory)
+	client := cFactory.ServerFrontendClient(c)
+
+	if err := os.MkdirAll(outDir, 0700); err != nil {
+		ErrorAndExit("Failed to create output directory.", err)
+	}
+	if includeHistory {
+		if err := os.MkdirAll(filepath.Join(outDir, domainExportHistoriesDir), 0700); err != nil {
+			ErrorAndExit("Failed to create history directory.", err)
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	domain, err := client.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	cancel()
+	if err != nil {
+		ErrorAndExit("Operation DescribeDomain failed.", err)
+	}
+	writeExportJSON(filepath.Join(outDir, domainExportDomainFile), jsonmapper.FromDescribeDomainResponse(domain))
+
+	pageSize := c.Int(FlagPageSize)
+	openCount := exportWorkflows(
+		c,
+		client,
+		filepath.Join(outDir, domainExportOpenWorkflowsFile),
+		domainName,
+		listOpenWorkflow(client, pageSize, earliestTime, latestTime, domainName, "", "", c),
+		includeHistory,
+	)
+	closedCount := exportWorkflows(
+		c,
+		client,
+		filepath.Join(outDir, domainExportClosedWorkflowsFile),
+		domainName,
+		listClosedWorkflow(client, pageSize, earliestTime, latestTime, domainName, "", "", workflowStatusNotSet, c),
+		includeHistory,
+	)
+
+	writeExportJSON(filepath.Join(outDir, domainExportManifestFile), &exportManifest{
+		FormatVersion:       domainExportFormatVersion,
+		Domain:              domainName,
+		DomainID:            domain.GetDomainInfo().GetUUID(),
+		ExportTime:          time.Now().UTC().Format(time.RFC3339),
+		EarliestStartTime:   time.Unix(0, earliestTime).UTC().Format(time.RFC3339),
+		LatestStartTime:     time.Unix(0, latestTime).UTC().Format(time.RFC3339),
+		OpenWorkflowCount:   openCount,
+		ClosedWorkflowCount: closedCount,
+		IncludesHistory:     includeHistory,
+	})
+	fmt.Printf("Exported domain %s with %d open and %d closed workflows to %s\n", domainName, openCount, closedCount, outDir)
+}
+
+// exportWorkflows writes every workflow returned by getWorkflowPage as a line of the file and
+// returns how many were written
+func exportWorkflows(
+	c *cli.Context,
+	client frontend.Client,
+	fileName string,
+	domainName string,
+	getWorkflowPage getWorkflowPageFn,
+	includeHistory bool,
+) int {
+	file, err := os.OpenFile(fileName, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to create %s.", fileName), err)
+	}
+	defer file.Close()
+	writer := bufio.NewWriter(file)
+
+	progress := newPageProgress(c, "workflows")
+	defer progress.clear()
+
+	count := 0
+	var page []*types.WorkflowExecutionInfo
+	var nextPageToken []byte
+	for more := true; more; more = len(nextPageToken) > 0 {
+		page, nextPageToken = getWorkflowPage(nextPageToken)
+		for _, workflow := range page {
+			line, err := json.Marshal(jsonmapper.FromWorkflowExecutionInfo(workflow))
+			if err != nil {
+				ErrorAndExit("Failed to encode workflow into JSON.", err)
+			}
+			if _, err := writer.Write(append(line, '\n')); err != nil {
+				ErrorAndExit(fmt.Sprintf("Failed to write %s.", fileName), err)
+			}
+			if includeHistory {
+				exportHistory(c, client, filepath.Dir(fileName), domainName, workflow.GetExecution())
+			}
+			count++
+		}
+		progress.addPage(len(page))
+	}
+
+	if err := writer.Flush(); err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to write %s.", fileName), err)
+	}
+	return count
+}
+
+func exportHistory(
+	c *cli.Context,
+	client frontend.Client,
+	outDir string,
+	domainName string,
+	execution *types.WorkflowExecution,
+) {
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+	history, err := GetHistory(ctx, client, domainName, execution.GetWorkflowID(), execution.GetRunID())
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to get history of workflow %s, run %s.", execution.GetWorkflowID(), execution.GetRunID()), err)
+	}
+
+	fileName := url.PathEscape(execution.GetWorkflowID()) + "_" + url.PathEscape(execution.GetRunID()) + ".json"
+	writeExportJSON(filepath.Join(outDir, domainExportHistoriesDir, fileName), jsonmapper.FromHistoryEventArray(history.GetEvents()))
+}
+
+func writeExportJSON(fileName string, o interface{}) {
+	data, err := json.MarshalIndent(o, "", "  ")
+	if err != nil {
+		ErrorAndExit("Failed to encode export into JSON.", err)
+	}
+	if err := ioutil.WriteFile(fileName, append(data, '\n'), 0600); err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to write %s.", fileName), err)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// AdminFailoverDomainToVersion makes the cluster owning the given failover version the active cluster
+// of a global domain and sets the failover version of the domain to exactly that value.
+// The domain is updated in the database of the current cluster only, without replication, as this is
+// meant to recover from split-brain incidents where the failover versions of the clusters have diverged.
+// Run it against every cluster of the domain with the same failover version.
+func AdminFailoverDomainToVersion(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	failoverVersion := getRequiredInt64Option(c, FlagFailoverVersion)
+	reason := getRequiredOption(c, FlagReason)
+
+	serverConfig, err := cFactory.ServerConfig(c)
+	if err != nil {
+		ErrorAndExit("Unable to load config.", err)
+	}
+	clusterGroupMetadata := serverConfig.ClusterGroupMetadata
+	if clusterGroupMetadata == nil {
+		clusterGroupMetadata = serverConfig.ClusterMetadata
+	}
+	if clusterGroupMetadata == nil {
+		ErrorAndExit("Cluster group metadata is missing from config.", nil)
+	}
+	clusterGroupMetadata.FillDefaults()
+
+	activeCluster, err := clusterForFailoverVersion(clusterGroupMetadata, failoverVersion)
+	if err != nil {
+		ErrorAndExit("Invalid failover version.", err)
+	}
+
+	domainManager := initializeDomainManager(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	// must get the metadata (notificationVersion) before the domain, same as the domain handler
+	metadata, err := domainManager.GetMetadata(ctx)
+	if err != nil {
+		ErrorAndExit("Operation GetMetadata failed.", err)
+	}
+	domain, err := domainManager.GetDomain(ctx, &persistence.GetDomainRequest{Name: domainName})
+	if err != nil {
+		ErrorAndExit("Operation GetDomain failed.", err)
+	}
+
+	request, err := newFailoverToVersionRequest(domain, activeCluster, failoverVersion, metadata.NotificationVersion, c.Bool(FlagForce), reason)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Cannot fail over domain %s.", domainName), err)
+	}
+	if err := domainManager.UpdateDomain(ctx, request); err != nil {
+		ErrorAndExit("Operation UpdateDomain failed.", err)
+	}
+
+	fmt.Printf("Domain %s failed over from %s (failover version %d) to %s (failover version %d).\n",
+		domainName, domain.ReplicationConfig.ActiveClusterName, domain.FailoverVersion, activeCluster, failoverVersion)
+}
+
+// clusterForFailoverVersion returns the cluster owning a failover version, that is the cluster whose
+// initial failover version equals the failover version modulo the failover version increment
+func clusterForFailoverVersion(metadata *config.ClusterGroupMetadata, failoverVersion int64) (string, error) {
+	if failoverVersion < 0 {
+		return "", fmt.Errorf("failover version %d is negative", failoverVersion)
+	}
+	if metadata.FailoverVersionIncrement <= 0 {
+		return "", fmt.Errorf("failover version increment %d is invalid", metadata.FailoverVersionIncrement)
+	}
+
+	initialFailoverVersion := failoverVersion % metadata.FailoverVersionIncrement
+	for clusterName, info := range metadata.ClusterGroup {
+		if info.InitialFailoverVersion == initialFailoverVersion {
+			return clusterName, nil
+		}
+	}
+	return "", fmt.Errorf("no cluster has initial failover version %d (failover version %d, increment %d)",
+		initialFailoverVersion, failoverVersion, metadata.FailoverVersionIncrement)
+}
+
+// newFailoverToVersionRequest builds the update that makes the given cluster active with the given
+// failover version. Lowering the failover version of the domain is only allowed with force.
+func newFailoverToVersionRequest(
+	domain *persistence.GetDomainResponse,
+	activeCluster string,
+	failoverVersion int64,
+	notificationVersion int64,
+	force bool,
+	reason string,
+) (*persistence.UpdateDomainRequest, error) {
+
+	if !domain.IsGlobalDomain {
+		return nil, fmt.Errorf("domain %s is not a global domain", domain.Info.Name)
+	}
+
+	isDomainCluster := false
+	for _, cluster := range domain.ReplicationConfig.Clusters {
+		if cluster.ClusterName == activeCluster {
+			isDomainCluster = true
+		}
+	}
+	if !isDomainCluster {
+		return nil, fmt.Errorf("cluster %s owning failover version %d is not a cluster of the domain", activeCluster, failoverVersion)
+	}
+
+	if failoverVersion == domain.FailoverVersion && activeCluster == domain.ReplicationConfig.ActiveClusterName {
+		return nil, fmt.Errorf("domain is already active in %s with failover version %d", activeCluster, failoverVersion)
+	}
+	if failoverVersion < domain.FailoverVersion && !force {
+		return nil, fmt.Errorf("failover version %d is lower than the current failover version %d of the domain, use --%s to set it anyway",
+			failoverVersion, domain.FailoverVersion, FlagForce)
+	}
+
+	info := *domain.Info
+	info.Data = make(map[string]string, len(domain.Info.Data)+1)
+	for k, v := range domain.Info.Data {
+		info.Data[k] = v
+	}
+	info.Data[common.DomainDataKeyForChangeHistory] = newDomainChangeHistory(domain.Info.Data, domainChangeOperationFailover, reason)
+
+	replicationConfig := *domain.ReplicationConfig
+	replicationConfig.ActiveClusterName = activeCluster
+
+	// same as a force failover, any ongoing graceful failover is cleaned up
+	return &persistence.UpdateDomainRequest{
+		Info:                        &info,
+		Config:                      domain.Config,
+		ReplicationConfig:           &replicationConfig,
+		ConfigVersion:               domain.ConfigVersion,
+		FailoverVersion:             failoverVersion,
+		FailoverNotificationVersion: notificationVersion,
+		PreviousFailoverVersion:     common.InitialPreviousFailoverVersion,
+		FailoverEndTime:             nil,
+		LastUpdatedTime:             time.Now().UnixNano(),
+		NotificationVersion:         notificationVersion,
+	}, nil
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestClusterForFailoverVersion(t *testing.T) {
+	metadata := &config.ClusterGroupMetadata{
+		FailoverVersionIncrement: 10,
+		ClusterGroup: map[string]config.ClusterInformation{
+			"cluster0": {InitialFailoverVersion: 0},
+			"cluster1": {InitialFailoverVersion: 1},
+		},
+	}
+
+	cluster, err := clusterForFailoverVersion(metadata, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster0", cluster)
+
+	cluster, err = clusterForFailoverVersion(metadata, 41)
+	assert.NoError(t, err)
+	assert.Equal(t, "cluster1", cluster)
+
+	_, err = clusterForFailoverVersion(metadata, 42)
+	assert.Error(t, err)
+
+	_, err = clusterForFailoverVersion(metadata, -1)
+	assert.Error(t, err)
+
+	_, err = clusterForFailoverVersion(&config.ClusterGroupMetadata{}, 1)
+	assert.Error(t, err)
+}
+
+func TestNewFailoverToVersionRequest(t *testing.T) {
+	newDomain := func() *persistence.GetDomainResponse {
+		endTime := int64(123)
+		return &persistence.GetDomainResponse{
+			Info: &persistence.DomainInfo{
+				ID:   "domain-id",
+				Name: "domain",
+				Data: map[string]string{"k": "v"},
+			},
+			Config: &persistence.DomainConfig{Retention: 3},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: "cluster0",
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: "cluster0"},
+					{ClusterName: "cluster1"},
+				},
+			},
+			IsGlobalDomain:          true,
+			ConfigVersion:           5,
+			FailoverVersion:         30,
+			PreviousFailoverVersion: 20,
+			FailoverEndTime:         &endTime,
+		}
+	}
+
+	domain := newDomain()
+	request, err := newFailoverToVersionRequest(domain, "cluster1", 41, 7, false, "split brain")
+	require.NoError(t, err)
+	assert.Equal(t, "cluster1", request.ReplicationConfig.ActiveClusterName)
+	assert.Equal(t, int64(41), request.FailoverVersion)
+	assert.Equal(t, int64(7), request.FailoverNotificationVersion)
+	assert.Equal(t, int64(7), request.NotificationVersion)
+	assert.Equal(t, int64(5), request.ConfigVersion)
+	assert.Equal(t, common.InitialPreviousFailoverVersion, request.PreviousFailoverVersion)
+	assert.Nil(t, request.FailoverEndTime)
+	assert.Equal(t, "v", request.Info.Data["k"])
+	changes := getDomainChangeHistory(request.Info.Data)
+	require.Len(t, changes, 1)
+	assert.Equal(t, domainChangeOperationFailover, changes[0].Operation)
+	assert.Equal(t, "split brain", changes[0].Reason)
+	// the domain read from the database is left untouched
+	assert.Equal(t, "cluster0", domain.ReplicationConfig.ActiveClusterName)
+	assert.NotContains(t, domain.Info.Data, common.DomainDataKeyForChangeHistory)
+
+	_, err = newFailoverToVersionRequest(newDomain(), "cluster1", 21, 7, false, "split brain")
+	assert.Error(t, err)
+	request, err = newFailoverToVersionRequest(newDomain(), "cluster1", 21, 7, true, "split brain")
+	require.NoError(t, err)
+	assert.Equal(t, int64(21), request.FailoverVersion)
+
+	_, err = newFailoverToVersionRequest(newDomain(), "cluster0", 30, 7, true, "split brain")
+	assert.Error(t, err)
+
+	_, err = newFailoverToVersionRequest(newDomain(), "cluster2", 42, 7, false, "split brain")
+	assert.Error(t, err)
+
+	localDomain := newDomain()
+	localDomain.IsGlobalDomain = false
+	_, err = newFailoverToVersionRequest(localDomain, "cluster1", 41, 7, false, "split brain")
+	assert.Error(t, err)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// AdminBackfillDomainReplication starts the replication backfill of a domain to a newly added cluster,
+// it runs as a job which can be tracked with the admin job commands
+func AdminBackfillDomainReplication(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	targetCluster := getRequiredOption(c, FlagTargetCluster)
+	closedWithin := c.Duration(FlagClosedWithin)
+	if closedWithin < 0 {
+		ErrorAndExit("Closed within must not be negative.", nil)
+	}
+	rps := c.Int(FlagRPS)
+	if rps < 0 {
+		ErrorAndExit("RPS must not be negative.", nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.BackfillDomainReplication(ctx, &types.BackfillDomainReplicationRequest{
+		Domain:              domain,
+		TargetCluster:       targetCluster,
+		ClosedWithinSeconds: int64(closedWithin.Seconds()),
+		RPS:                 int32(rps),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to start replication backfill.", err)
+	}
+	fmt.Printf("Replication backfill of domain %s to cluster %s started.\n", domain, targetCluster)
+	fmt.Println("Track it with: cadence admin job describe --job_id " + resp.GetJobID())
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// AdminReconcileDomainRetention reschedules the history deletion of the closed workflows of a domain after
+// its retention changed, it runs as a job which can be tracked with the admin job commands
+func AdminReconcileDomainRetention(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	rps := c.Int(FlagRPS)
+	if rps < 0 {
+		ErrorAndExit("RPS must not be negative.", nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ReconcileDomainRetention(ctx, &types.ReconcileDomainRetentionRequest{
+		Domain: domain,
+		RPS:    int32(rps),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to start retention reconciliation.", err)
+	}
+	fmt.Printf("Retention reconciliation of domain %s started.\n", domain)
+	fmt.Println("Track it with: cadence admin job describe --job_id " + resp.GetJobID())
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// AdminCreateDomainToken creates a token that only grants access to some APIs of a domain.
+// The token is signed by the frontend with the private key of its OAuth authorizer
+func AdminCreateDomainToken(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	var apis []string
+	for _, api := range strings.Split(getRequiredOption(c, FlagAPIs), ",") {
+		if api = strings.TrimSpace(api); api != "" {
+			apis = append(apis, api)
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.IssueDomainToken(ctx, &types.IssueDomainTokenRequest{
+		Domain:     domain,
+		APIs:       apis,
+		TTLSeconds: int64(c.Duration(FlagTTL).Seconds()),
+		Subject:    c.String(FlagSubject),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to create token.", err)
+	}
+	fmt.Println(resp.GetToken())
+}
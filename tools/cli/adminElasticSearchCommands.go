@@ -107,7 +107,7 @@ func AdminCatIndices(c *cli.Context) {
 			PrimaryStorageSize: row.PriStoreSize,
 		})
 	}
-	RenderTable(os.Stdout, table, TableOptions{Color: true, Border: true})
+	RenderTable(getOutput(), table, TableOptions{Color: true, Border: true})
 }
 
 // AdminIndex used to bulk insert message from kafka parse
@@ -331,7 +331,7 @@ func GenerateReport(c *cli.Context) {
 	}
 
 	// Show result to terminal
-	table := tablewriter.NewWriter(os.Stdout)
+	table := tablewriter.NewWriter(getOutput())
 	var headers []string
 	var groupby, bucket map[string]interface{}
 	var buckets []interface{}
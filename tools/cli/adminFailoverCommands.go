@@ -53,6 +53,7 @@ type startParams struct {
 	domains                        []string
 	drillWaitTime                  int
 	cron                           string
+	drillDomainsOnly               bool
 }
 
 // AdminFailoverStart start failover workflow
@@ -71,30 +72,86 @@ func AdminFailoverStart(c *cli.Context) {
 	failoverStart(c, params)
 }
 
+// AdminFailoverDrillStart start failover drill workflow on domains with failover drill enabled
+func AdminFailoverDrillStart(c *cli.Context) {
+	params := &startParams{
+		targetCluster:                  getRequiredOption(c, FlagTargetCluster),
+		sourceCluster:                  getRequiredOption(c, FlagSourceCluster),
+		batchFailoverSize:              c.Int(FlagFailoverBatchSize),
+		batchFailoverWaitTimeInSeconds: c.Int(FlagFailoverWaitTime),
+		failoverTimeout:                c.Int(FlagFailoverTimeout),
+		failoverWorkflowTimeout:        c.Int(FlagExecutionTimeout),
+		domains:                        c.StringSlice(FlagFailoverDomains),
+		drillWaitTime:                  getRequiredIntOption(c, FlagFailoverDrillWaitTime),
+		cron:                           c.String(FlagCronSchedule),
+		drillDomainsOnly:               true,
+	}
+	if params.drillWaitTime <= 0 {
+		ErrorAndExit("Failover drill wait time must be positive", nil)
+	}
+	failoverStart(c, params)
+}
+
 // AdminFailoverPause pause failover workflow
 func AdminFailoverPause(c *cli.Context) {
-	err := executePauseOrResume(c, getFailoverWorkflowID(c), true)
+	failoverPause(c, getFailoverWorkflowID(c))
+}
+
+// AdminFailoverDrillPause pause failover drill workflow
+func AdminFailoverDrillPause(c *cli.Context) {
+	failoverPause(c, failovermanager.DrillWorkflowID)
+}
+
+// AdminFailoverResume resume a paused failover workflow
+func AdminFailoverResume(c *cli.Context) {
+	failoverResume(c, getFailoverWorkflowID(c))
+}
+
+// AdminFailoverDrillResume resume a paused failover drill workflow
+func AdminFailoverDrillResume(c *cli.Context) {
+	failoverResume(c, failovermanager.DrillWorkflowID)
+}
+
+// AdminFailoverQuery query a failover workflow
+func AdminFailoverQuery(c *cli.Context) {
+	failoverQuery(c, getFailoverWorkflowID(c))
+}
+
+// AdminFailoverDrillQuery query a failover drill workflow
+func AdminFailoverDrillQuery(c *cli.Context) {
+	failoverQuery(c, failovermanager.DrillWorkflowID)
+}
+
+// AdminFailoverAbort abort a failover workflow
+func AdminFailoverAbort(c *cli.Context) {
+	failoverAbort(c, getFailoverWorkflowID(c))
+}
+
+// AdminFailoverDrillAbort abort a failover drill workflow
+func AdminFailoverDrillAbort(c *cli.Context) {
+	failoverAbort(c, failovermanager.DrillWorkflowID)
+}
+
+func failoverPause(c *cli.Context, workflowID string) {
+	err := executePauseOrResume(c, workflowID, true)
 	if err != nil {
 		ErrorAndExit("Failed to pause failover workflow", err)
 	}
-	fmt.Println("Failover paused on " + getFailoverWorkflowID(c))
+	fmt.Println("Failover paused on " + workflowID)
 }
 
-// AdminFailoverResume resume a paused failover workflow
-func AdminFailoverResume(c *cli.Context) {
-	err := executePauseOrResume(c, getFailoverWorkflowID(c), false)
+func failoverResume(c *cli.Context, workflowID string) {
+	err := executePauseOrResume(c, workflowID, false)
 	if err != nil {
 		ErrorAndExit("Failed to resume failover workflow", err)
 	}
-	fmt.Println("Failover resumed on " + getFailoverWorkflowID(c))
+	fmt.Println("Failover resumed on " + workflowID)
 }
 
-// AdminFailoverQuery query a failover workflow
-func AdminFailoverQuery(c *cli.Context) {
+func failoverQuery(c *cli.Context, workflowID string) {
 	client := getCadenceClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	workflowID := getFailoverWorkflowID(c)
 	runID := getRunID(c)
 	result := query(tcCtx, client, workflowID, runID)
 	request := &types.DescribeWorkflowExecutionRequest{
@@ -115,8 +172,7 @@ func AdminFailoverQuery(c *cli.Context) {
 	prettyPrintJSONObject(result)
 }
 
-// AdminFailoverAbort abort a failover workflow
-func AdminFailoverAbort(c *cli.Context) {
+func failoverAbort(c *cli.Context, workflowID string) {
 	client := getCadenceClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
@@ -125,7 +181,6 @@ func AdminFailoverAbort(c *cli.Context) {
 	if len(reason) == 0 {
 		reason = defaultAbortReason
 	}
-	workflowID := getFailoverWorkflowID(c)
 	runID := getRunID(c)
 	request := &types.TerminateWorkflowExecutionRequest{
 		Domain: common.SystemLocalDomainName,
@@ -311,6 +366,7 @@ func failoverStart(c *cli.Context, params *startParams) {
 		Domains:                          domains,
 		DrillWaitTime:                    drillWaitTime,
 		GracefulFailoverTimeoutInSeconds: gracefulFailoverTimeoutInSeconds,
+		DrillDomainsOnly:                 params.drillDomainsOnly,
 	}
 	input, err := json.Marshal(foParams)
 	if err != nil {
@@ -322,7 +378,7 @@ func failoverStart(c *cli.Context, params *startParams) {
 		ErrorAndExit("Failed to start failover workflow", err)
 	}
 	fmt.Println("Failover workflow started")
-	fmt.Println("wid: " + workflowID)
+	fmt.Println("wid: " + request.WorkflowID)
 	fmt.Println("rid: " + wf.GetRunID())
 }
 
@@ -0,0 +1,394 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	failoverCheckPass = "PASS"
+	failoverCheckWarn = "WARN"
+	failoverCheckFail = "FAIL"
+)
+
+type (
+	failoverCheckRow struct {
+		Check   string `header:"Check" json:"check"`
+		Result  string `header:"Result" json:"result"`
+		Details string `header:"Details" json:"details"`
+	}
+
+	failoverSimulationReport struct {
+		Domain        string             `json:"domain"`
+		SourceCluster string             `json:"sourceCluster"`
+		TargetCluster string             `json:"targetCluster"`
+		Ready         bool               `json:"ready"`
+		Checks        []failoverCheckRow `json:"checks"`
+	}
+)
+
+// AdminSimulateDomainFailover runs the pre-failover checks of a domain against the target cluster
+// and executes a shadow decision task there, without changing the active cluster of the domain
+func AdminSimulateDomainFailover(c *cli.Context) {
+	domainName := getRequiredGlobalOption(c, FlagDomain)
+	targetCluster := getRequiredOption(c, FlagTargetCluster)
+	targetAddress := getRequiredOption(c, FlagTargetAddress)
+	sampleSize := c.Int(FlagSampleSize)
+
+	sourceClient := cFactory.ServerFrontendClient(c)
+	targetClient := cFactory.ServerFrontendClientForAddress(c, targetAddress)
+	targetAdminClient := cFactory.ServerAdminClientForAddress(c, targetAddress)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	sourceDomain, err := sourceClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	if err != nil {
+		ErrorAndExit("Operation DescribeDomain failed.", err)
+	}
+	sourceCluster := sourceDomain.GetReplicationConfiguration().GetActiveClusterName()
+
+	report := &failoverSimulationReport{
+		Domain:        domainName,
+		SourceCluster: sourceCluster,
+		TargetCluster: targetCluster,
+	}
+	report.Checks = append(report.Checks, checkFailoverDomainConfig(sourceDomain, targetCluster))
+
+	targetDomain, err := targetClient.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	if err != nil {
+		report.Checks = append(report.Checks, failoverCheckRow{
+			Check:   "Domain Replication",
+			Result:  failoverCheckFail,
+			Details: fmt.Sprintf("failed to describe domain in %s: %v", targetCluster, err),
+		})
+	} else {
+		report.Checks = append(report.Checks,
+			checkFailoverDomainReplication(sourceDomain, targetDomain),
+			checkFailoverBadBinaries(sourceDomain, targetDomain),
+		)
+	}
+
+	report.Checks = append(report.Checks,
+		checkFailoverSearchAttributes(ctx, sourceClient, targetClient),
+		checkFailoverReplicationDLQ(ctx, targetAdminClient, sourceCluster, c.Int(FlagNumberOfShards)),
+	)
+
+	executions, err := sampleOpenWorkflows(ctx, sourceClient, domainName, sampleSize)
+	if err != nil {
+		ErrorAndExit("Failed to list open workflows.", err)
+	}
+	report.Checks = append(report.Checks,
+		checkFailoverReplicationLag(ctx, sourceClient, targetClient, domainName, executions),
+		checkFailoverShadowDecision(ctx, targetClient, domainName, targetCluster, executions),
+	)
+
+	report.Ready = true
+	for _, check := range report.Checks {
+		if check.Result == failoverCheckFail {
+			report.Ready = false
+		}
+	}
+
+	if c.Bool(FlagPrintJSON) {
+		output, err := json.Marshal(report)
+		if err != nil {
+			ErrorAndExit("Failed to encode failover simulation report into JSON.", err)
+		}
+		Render(string(output))
+		return
+	}
+
+	RenderTable(getOutput(), report.Checks, TableOptions{Color: true, Border: true})
+	if report.Ready {
+		fmt.Printf("Domain %s is ready to fail over from %s to %s.\n", domainName, sourceCluster, targetCluster)
+	} else {
+		fmt.Printf("Domain %s is NOT ready to fail over from %s to %s.\n", domainName, sourceCluster, targetCluster)
+	}
+}
+
+func checkFailoverDomainConfig(domain *types.DescribeDomainResponse, targetCluster string) failoverCheckRow {
+	check := failoverCheckRow{Check: "Domain Config", Result: failoverCheckFail}
+	replicationConfig := domain.GetReplicationConfiguration()
+	if !domain.GetIsGlobalDomain() {
+		check.Details = "domain is not a global domain"
+		return check
+	}
+	if replicationConfig.GetActiveClusterName() == targetCluster {
+		check.Details = fmt.Sprintf("domain is already active in %s", targetCluster)
+		return check
+	}
+	if domain.FailoverInfo != nil {
+		check.Details = "a graceful failover of the domain is in progress"
+		return check
+	}
+	for _, cluster := range replicationConfig.GetClusters() {
+		if cluster.GetClusterName() == targetCluster {
+			check.Result = failoverCheckPass
+			check.Details = fmt.Sprintf("%s is a replication cluster of the domain", targetCluster)
+			return check
+		}
+	}
+	check.Details = fmt.Sprintf("%s is not a replication cluster of the domain", targetCluster)
+	return check
+}
+
+func checkFailoverDomainReplication(sourceDomain, targetDomain *types.DescribeDomainResponse) failoverCheckRow {
+	check := failoverCheckRow{Check: "Domain Replication", Result: failoverCheckPass}
+	sourceActive := sourceDomain.GetReplicationConfiguration().GetActiveClusterName()
+	targetActive := targetDomain.GetReplicationConfiguration().GetActiveClusterName()
+	switch {
+	case sourceDomain.GetFailoverVersion() != targetDomain.GetFailoverVersion():
+		check.Result = failoverCheckFail
+		check.Details = fmt.Sprintf("failover version %v in target does not match %v in source",
+			targetDomain.GetFailoverVersion(), sourceDomain.GetFailoverVersion())
+	case sourceActive != targetActive:
+		check.Result = failoverCheckFail
+		check.Details = fmt.Sprintf("active cluster %s in target does not match %s in source", targetActive, sourceActive)
+	default:
+		check.Details = fmt.Sprintf("domain metadata is in sync at failover version %v", sourceDomain.GetFailoverVersion())
+	}
+	return check
+}
+
+func checkFailoverBadBinaries(sourceDomain, targetDomain *types.DescribeDomainResponse) failoverCheckRow {
+	sourceBinaries := sourceDomain.GetConfiguration().GetBadBinaries().GetBinaries()
+	targetBinaries := targetDomain.GetConfiguration().GetBadBinaries().GetBinaries()
+	var missing []string
+	for checksum := range sourceBinaries {
+		if _, ok := targetBinaries[checksum]; !ok {
+			missing = append(missing, checksum)
+		}
+	}
+	if len(missing) > 0 || len(sourceBinaries) != len(targetBinaries) {
+		sort.Strings(missing)
+		return failoverCheckRow{
+			Check:   "Bad Binaries",
+			Result:  failoverCheckFail,
+			Details: fmt.Sprintf("%d bad binaries in source, %d in target, missing in target: [%s]", len(sourceBinaries), len(targetBinaries), strings.Join(missing, ", ")),
+		}
+	}
+	return failoverCheckRow{
+		Check:   "Bad Binaries",
+		Result:  failoverCheckPass,
+		Details: fmt.Sprintf("%d bad binaries in sync", len(sourceBinaries)),
+	}
+}
+
+func checkFailoverSearchAttributes(ctx context.Context, sourceClient, targetClient frontend.Client) failoverCheckRow {
+	check := failoverCheckRow{Check: "Search Attributes", Result: failoverCheckFail}
+	sourceAttributes, err := sourceClient.GetSearchAttributes(ctx)
+	if err != nil {
+		check.Details = fmt.Sprintf("failed to get search attributes in source: %v", err)
+		return check
+	}
+	targetAttributes, err := targetClient.GetSearchAttributes(ctx)
+	if err != nil {
+		check.Details = fmt.Sprintf("failed to get search attributes in target: %v", err)
+		return check
+	}
+
+	var mismatched []string
+	for key, valueType := range sourceAttributes.GetKeys() {
+		if targetType, ok := targetAttributes.GetKeys()[key]; !ok || targetType != valueType {
+			mismatched = append(mismatched, key)
+		}
+	}
+	if len(mismatched) > 0 {
+		sort.Strings(mismatched)
+		check.Details = fmt.Sprintf("missing or different in target: [%s]", strings.Join(mismatched, ", "))
+		return check
+	}
+	check.Result = failoverCheckPass
+	check.Details = fmt.Sprintf("%d search attributes in sync", len(sourceAttributes.GetKeys()))
+	return check
+}
+
+func checkFailoverReplicationDLQ(ctx context.Context, adminClient admin.Client, sourceCluster string, numberOfShards int) failoverCheckRow {
+	check := failoverCheckRow{Check: "Replication DLQ", Result: failoverCheckFail}
+	hasMessages := func(dlqType *types.DLQType, shardID int) (bool, error) {
+		resp, err := adminClient.ReadDLQMessages(ctx, &types.ReadDLQMessagesRequest{
+			Type:                  dlqType,
+			SourceCluster:         sourceCluster,
+			ShardID:               int32(shardID),
+			InclusiveEndMessageID: common.Int64Ptr(common.EndMessageID),
+			MaximumPageSize:       1,
+		})
+		if err != nil {
+			return false, err
+		}
+		return len(resp.GetReplicationTasks()) > 0 || len(resp.GetReplicationTasksInfo()) > 0, nil
+	}
+
+	domainDLQ, err := hasMessages(types.DLQTypeDomain.Ptr(), 0)
+	if err != nil {
+		check.Details = fmt.Sprintf("failed to read domain DLQ: %v", err)
+		return check
+	}
+	if domainDLQ {
+		check.Details = "domain replication DLQ is not empty"
+		return check
+	}
+
+	if numberOfShards <= 0 {
+		check.Result = failoverCheckWarn
+		check.Details = fmt.Sprintf("domain DLQ is empty, history DLQ not checked as %s is not provided", FlagNumberOfShards)
+		return check
+	}
+	var shardsWithMessages []string
+	for shardID := 0; shardID < numberOfShards; shardID++ {
+		historyDLQ, err := hasMessages(types.DLQTypeReplication.Ptr(), shardID)
+		if err != nil {
+			check.Details = fmt.Sprintf("failed to read history DLQ of shard %d: %v", shardID, err)
+			return check
+		}
+		if historyDLQ {
+			shardsWithMessages = append(shardsWithMessages, fmt.Sprintf("%d", shardID))
+		}
+	}
+	if len(shardsWithMessages) > 0 {
+		check.Details = fmt.Sprintf("history DLQ is not empty in shards: [%s]", strings.Join(shardsWithMessages, ", "))
+		return check
+	}
+	check.Result = failoverCheckPass
+	check.Details = fmt.Sprintf("domain DLQ and history DLQ of %d shards are empty", numberOfShards)
+	return check
+}
+
+func sampleOpenWorkflows(
+	ctx context.Context,
+	client frontend.Client,
+	domainName string,
+	sampleSize int,
+) ([]*types.WorkflowExecutionInfo, error) {
+	if sampleSize <= 0 {
+		return nil, nil
+	}
+	resp, err := client.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+		Domain:          domainName,
+		MaximumPageSize: int32(sampleSize),
+		StartTimeFilter: &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetExecutions(), nil
+}
+
+func checkFailoverReplicationLag(
+	ctx context.Context,
+	sourceClient frontend.Client,
+	targetClient frontend.Client,
+	domainName string,
+	executions []*types.WorkflowExecutionInfo,
+) failoverCheckRow {
+	check := failoverCheckRow{Check: "Replication Lag", Result: failoverCheckPass}
+	if len(executions) == 0 {
+		check.Details = "no open workflows to compare"
+		return check
+	}
+
+	var missing, behind int
+	var maxEventLag int64
+	for _, execution := range executions {
+		request := &types.DescribeWorkflowExecutionRequest{
+			Domain:    domainName,
+			Execution: execution.GetExecution(),
+		}
+		sourceResp, err := sourceClient.DescribeWorkflowExecution(ctx, request)
+		if err != nil {
+			// the workflow may have closed and been deleted since it was listed
+			continue
+		}
+		targetResp, err := targetClient.DescribeWorkflowExecution(ctx, request)
+		if err != nil {
+			missing++
+			continue
+		}
+		eventLag := sourceResp.GetWorkflowExecutionInfo().GetHistoryLength() - targetResp.GetWorkflowExecutionInfo().GetHistoryLength()
+		if eventLag > 0 {
+			behind++
+			if eventLag > maxEventLag {
+				maxEventLag = eventLag
+			}
+		}
+	}
+
+	switch {
+	case missing > 0:
+		check.Result = failoverCheckFail
+		check.Details = fmt.Sprintf("%d of %d sampled workflows are not replicated to target", missing, len(executions))
+	case behind > 0:
+		check.Result = failoverCheckWarn
+		check.Details = fmt.Sprintf("%d of %d sampled workflows are behind by up to %d events", behind, len(executions), maxEventLag)
+	default:
+		check.Details = fmt.Sprintf("%d sampled workflows are up to date", len(executions))
+	}
+	return check
+}
+
+func checkFailoverShadowDecision(
+	ctx context.Context,
+	targetClient frontend.Client,
+	domainName string,
+	targetCluster string,
+	executions []*types.WorkflowExecutionInfo,
+) failoverCheckRow {
+	check := failoverCheckRow{Check: "Shadow Decision", Result: failoverCheckWarn}
+	if len(executions) == 0 {
+		check.Details = "no open workflows to run a shadow decision task"
+		return check
+	}
+
+	// queries with eventual consistency are not forwarded to the active cluster, so the
+	// decision task is dispatched to and completed by workers polling the target cluster
+	execution := executions[0].GetExecution()
+	_, err := targetClient.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+		Domain:                domainName,
+		Execution:             execution,
+		Query:                 &types.WorkflowQuery{QueryType: queryTypeStackTrace},
+		QueryConsistencyLevel: types.QueryConsistencyLevelEventual.Ptr(),
+	})
+	if err != nil {
+		check.Result = failoverCheckFail
+		check.Details = fmt.Sprintf("decision task for workflow %s failed in %s: %v", execution.GetWorkflowID(), targetCluster, err)
+		return check
+	}
+	check.Result = failoverCheckPass
+	check.Details = fmt.Sprintf("decision task for workflow %s completed by workers in %s", execution.GetWorkflowID(), targetCluster)
+	return check
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
+)
+
+const (
+	historyEncodingThrift = "thrift"
+	historyEncodingProto  = "proto"
+	historyEncodingJSON   = "json"
+)
+
+// AdminConvertHistory converts a history blob between thrift, proto and JSON encodings
+func AdminConvertHistory(c *cli.Context) {
+	inFile := getRequiredOption(c, FlagIn)
+	outFile := c.String(FlagOut)
+
+	inEncoding := c.String(FlagInEncoding)
+	if inEncoding == "" {
+		inEncoding = historyEncodingFromFileName(inFile)
+	}
+	outEncoding := c.String(FlagOutEncoding)
+	if outEncoding == "" {
+		outEncoding = historyEncodingFromFileName(outFile)
+	}
+	if inEncoding == "" || outEncoding == "" {
+		ErrorAndExit(fmt.Sprintf("Unable to tell history encodings from file names, please set %s and %s.", FlagInEncoding, FlagOutEncoding), nil)
+	}
+
+	// This method is purely used to parse input from the CLI. The input comes from a trusted user
+	// #nosec
+	data, err := ioutil.ReadFile(inFile)
+	if err != nil {
+		ErrorAndExit("Failed to read input file.", err)
+	}
+	history, err := decodeHistory(data, inEncoding)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to decode %s history.", inEncoding), err)
+	}
+	data, err = encodeHistory(history, outEncoding)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to encode %s history.", outEncoding), err)
+	}
+
+	if outFile == "" {
+		if _, err := getOutput().Write(data); err != nil {
+			ErrorAndExit("Failed to write history.", err)
+		}
+		return
+	}
+	if err := ioutil.WriteFile(outFile, data, 0666); err != nil {
+		ErrorAndExit("Failed to write output file.", err)
+	}
+	fmt.Printf("Converted %d events from %s to %s.\n", len(history.Events), inEncoding, outEncoding)
+}
+
+func historyEncodingFromFileName(fileName string) string {
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".thrift", ".thriftrw":
+		return historyEncodingThrift
+	case ".proto", ".pb":
+		return historyEncodingProto
+	case ".json":
+		return historyEncodingJSON
+	default:
+		return ""
+	}
+}
+
+// decodeHistory decodes a history blob, binary blobs may also be given as hex strings as printed by database shells
+func decodeHistory(data []byte, encoding string) (*types.History, error) {
+	if encoding != historyEncodingJSON {
+		trimmed := bytes.TrimSpace(data)
+		if bytes.HasPrefix(trimmed, []byte("0x")) {
+			decoded, err := hex.DecodeString(string(trimmed[2:]))
+			if err != nil {
+				return nil, err
+			}
+			data = decoded
+		}
+	}
+
+	switch encoding {
+	case historyEncodingThrift:
+		events, err := persistence.NewPayloadSerializer().DeserializeBatchEvents(
+			persistence.NewDataBlob(data, common.EncodingTypeThriftRW),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &types.History{Events: events}, nil
+	case historyEncodingProto:
+		var history apiv1.History
+		if err := history.Unmarshal(data); err != nil {
+			return nil, err
+		}
+		return proto.ToHistory(&history), nil
+	case historyEncodingJSON:
+		serializer := &JSONHistorySerializer{}
+		return serializer.Deserialize(data)
+	default:
+		return nil, fmt.Errorf("unknown history encoding %q, supported encodings are %s, %s and %s",
+			encoding, historyEncodingThrift, historyEncodingProto, historyEncodingJSON)
+	}
+}
+
+func encodeHistory(history *types.History, encoding string) ([]byte, error) {
+	switch encoding {
+	case historyEncodingThrift:
+		blob, err := persistence.NewPayloadSerializer().SerializeBatchEvents(history.Events, common.EncodingTypeThriftRW)
+		if err != nil {
+			return nil, err
+		}
+		return blob.Data, nil
+	case historyEncodingProto:
+		return proto.FromHistory(history).Marshal()
+	case historyEncodingJSON:
+		serializer := &JSONHistorySerializer{}
+		return serializer.Serialize(history)
+	default:
+		return nil, fmt.Errorf("unknown history encoding %q, supported encodings are %s, %s and %s",
+			encoding, historyEncodingThrift, historyEncodingProto, historyEncodingJSON)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestConvertHistory(t *testing.T) {
+	encodings := []string{historyEncodingThrift, historyEncodingProto, historyEncodingJSON}
+	for _, from := range encodings {
+		for _, to := range encodings {
+			data, err := encodeHistory(&testdata.History, from)
+			require.NoError(t, err)
+			history, err := decodeHistory(data, from)
+			require.NoError(t, err)
+
+			data, err = encodeHistory(history, to)
+			require.NoError(t, err)
+			history, err = decodeHistory(data, to)
+			require.NoError(t, err)
+			expected, actual := testdata.History, *history
+			if from == historyEncodingThrift || to == historyEncodingThrift {
+				expected, actual = historyWithoutParentDomainID(expected), historyWithoutParentDomainID(actual)
+			}
+			assert.Equal(t, expected, actual, "%s to %s", from, to)
+		}
+	}
+}
+
+func TestConvertHistory_HexInput(t *testing.T) {
+	data, err := encodeHistory(&testdata.History, historyEncodingThrift)
+	require.NoError(t, err)
+
+	history, err := decodeHistory([]byte("0x"+hex.EncodeToString(data)+"\n"), historyEncodingThrift)
+	require.NoError(t, err)
+	assert.Equal(t, historyWithoutParentDomainID(testdata.History), *history)
+}
+
+// historyWithoutParentDomainID returns a copy of the history as read from thrift, which has no parent domain ID
+func historyWithoutParentDomainID(history types.History) types.History {
+	events := make([]*types.HistoryEvent, 0, len(history.Events))
+	for _, event := range history.Events {
+		e := *event
+		if attributes := e.WorkflowExecutionStartedEventAttributes; attributes != nil {
+			a := *attributes
+			a.ParentWorkflowDomainID = nil
+			e.WorkflowExecutionStartedEventAttributes = &a
+		}
+		events = append(events, &e)
+	}
+	return types.History{Events: events}
+}
+
+func TestHistoryEncodingFromFileName(t *testing.T) {
+	assert.Equal(t, historyEncodingThrift, historyEncodingFromFileName("events.thrift"))
+	assert.Equal(t, historyEncodingProto, historyEncodingFromFileName("events.pb"))
+	assert.Equal(t, historyEncodingJSON, historyEncodingFromFileName("/tmp/EVENTS.JSON"))
+	assert.Equal(t, "", historyEncodingFromFileName("events"))
+}
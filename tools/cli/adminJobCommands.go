@@ -0,0 +1,281 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain/replicationbackfill"
+	"github.com/uber/cadence/common/domain/retentionreconciler"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/batcher"
+	"github.com/uber/cadence/service/worker/dlqmerger"
+)
+
+const (
+	jobStatusRunning = "RUNNING"
+)
+
+type (
+	// jobKind is a kind of long running admin operation run by a system workflow in the worker service,
+	// the job ID is the workflow ID
+	jobKind struct {
+		name         string
+		domain       string
+		workflowType string
+	}
+
+	// JobRow is a row of the admin job list table
+	JobRow struct {
+		Kind      string `header:"Kind"`
+		JobID     string `header:"Job ID"`
+		Status    string `header:"Status"`
+		StartTime string `header:"Start Time"`
+		CloseTime string `header:"Close Time"`
+	}
+)
+
+var (
+	batchJobKind = jobKind{
+		name:         "batch",
+		domain:       common.BatcherLocalDomainName,
+		workflowType: batcher.BatchWFTypeName,
+	}
+	dlqMergeJobKind = jobKind{
+		name:         "dlq-merge",
+		domain:       common.SystemLocalDomainName,
+		workflowType: dlqmerger.WorkflowTypeName,
+	}
+	replicationBackfillJobKind = jobKind{
+		name:         "replication-backfill",
+		domain:       common.SystemLocalDomainName,
+		workflowType: replicationbackfill.WorkflowTypeName,
+	}
+	retentionReconcileJobKind = jobKind{
+		name:         "retention-reconcile",
+		domain:       common.SystemLocalDomainName,
+		workflowType: retentionreconciler.WorkflowTypeName,
+	}
+	allJobKinds = []jobKind{batchJobKind, dlqMergeJobKind, replicationBackfillJobKind, retentionReconcileJobKind}
+)
+
+// getJobKind returns the kind of the job, DLQ merge, replication backfill and retention reconcile workflow IDs
+// have a fixed prefix and batch jobs, which include batch resets, have random ones
+func getJobKind(jobID string) jobKind {
+	if strings.HasPrefix(jobID, dlqmerger.WorkflowIDPrefix) {
+		return dlqMergeJobKind
+	}
+	if strings.HasPrefix(jobID, replicationbackfill.WorkflowIDPrefix) {
+		return replicationBackfillJobKind
+	}
+	if strings.HasPrefix(jobID, retentionreconciler.WorkflowIDPrefix) {
+		return retentionReconcileJobKind
+	}
+	return batchJobKind
+}
+
+// AdminListJobs lists the running jobs of all kinds, and the closed ones when asked to
+func AdminListJobs(c *cli.Context) {
+	pageSize := c.Int(FlagPageSize)
+	listClosed := c.Bool(FlagAll)
+	client := getCadenceClient(c)
+
+	var rows []JobRow
+	for _, kind := range allJobKinds {
+		startTimeFilter := &types.StartTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+		}
+		typeFilter := &types.WorkflowTypeFilter{Name: kind.workflowType}
+
+		ctx, cancel := newContext(c)
+		openResp, err := client.ListOpenWorkflowExecutions(ctx, &types.ListOpenWorkflowExecutionsRequest{
+			Domain:          kind.domain,
+			MaximumPageSize: int32(pageSize),
+			StartTimeFilter: startTimeFilter,
+			TypeFilter:      typeFilter,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to list %s jobs.", kind.name), err)
+		}
+		executions := openResp.GetExecutions()
+
+		if listClosed {
+			ctx, cancel := newContext(c)
+			closedResp, err := client.ListClosedWorkflowExecutions(ctx, &types.ListClosedWorkflowExecutionsRequest{
+				Domain:          kind.domain,
+				MaximumPageSize: int32(pageSize),
+				StartTimeFilter: startTimeFilter,
+				TypeFilter:      typeFilter,
+			})
+			cancel()
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Failed to list closed %s jobs.", kind.name), err)
+			}
+			executions = append(executions, closedResp.GetExecutions()...)
+		}
+
+		for _, execution := range executions {
+			rows = append(rows, newJobRow(kind, execution))
+		}
+	}
+
+	if len(rows) == 0 {
+		fmt.Println("No jobs found.")
+		return
+	}
+	RenderTable(getOutput(), rows, TableOptions{Color: true})
+}
+
+func newJobRow(kind jobKind, execution *types.WorkflowExecutionInfo) JobRow {
+	row := JobRow{
+		Kind:      kind.name,
+		JobID:     execution.GetExecution().GetWorkflowID(),
+		Status:    jobStatusRunning,
+		StartTime: convertTime(execution.GetStartTime(), false),
+	}
+	if execution.CloseStatus != nil {
+		row.Status = execution.CloseStatus.String()
+		row.CloseTime = convertTime(execution.GetCloseTime(), false)
+	}
+	return row
+}
+
+// AdminDescribeJob describes the status and progress of a job
+func AdminDescribeJob(c *cli.Context) {
+	jobID := getRequiredOption(c, FlagJobID)
+	kind := getJobKind(jobID)
+	client := getCadenceClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := client.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain:    kind.domain,
+		Execution: &types.WorkflowExecution{WorkflowID: jobID},
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe job", err)
+	}
+
+	row := newJobRow(kind, resp.WorkflowExecutionInfo)
+	output := map[string]interface{}{
+		"kind":      row.Kind,
+		"jobID":     row.JobID,
+		"status":    row.Status,
+		"startTime": row.StartTime,
+	}
+	if row.Status != jobStatusRunning {
+		output["closeTime"] = row.CloseTime
+		prettyPrintJSONObject(output)
+		return
+	}
+
+	switch kind {
+	case batchJobKind:
+		if len(resp.PendingActivities) > 0 {
+			var progress batcher.HeartBeatDetails
+			if err := json.Unmarshal(resp.PendingActivities[0].HeartbeatDetails, &progress); err == nil {
+				output["progress"] = progress
+			}
+		}
+	case dlqMergeJobKind:
+		queryResp, err := client.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+			Domain:    kind.domain,
+			Execution: &types.WorkflowExecution{WorkflowID: jobID},
+			Query:     &types.WorkflowQuery{QueryType: dlqmerger.QueryType},
+		})
+		if err != nil {
+			ErrorAndExit("Failed to query DLQ merge job", err)
+		}
+		var progress dlqmerger.QueryResult
+		if err := json.Unmarshal(queryResp.GetQueryResult(), &progress); err != nil {
+			ErrorAndExit("Failed to deserialize DLQ merge job progress", err)
+		}
+		output["progress"] = progress
+	case replicationBackfillJobKind:
+		queryResp, err := client.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+			Domain:    kind.domain,
+			Execution: &types.WorkflowExecution{WorkflowID: jobID},
+			Query:     &types.WorkflowQuery{QueryType: replicationbackfill.QueryType},
+		})
+		if err != nil {
+			ErrorAndExit("Failed to query replication backfill job", err)
+		}
+		var request replicationbackfill.Request
+		if err := json.Unmarshal(queryResp.GetQueryResult(), &request); err != nil {
+			ErrorAndExit("Failed to deserialize replication backfill job progress", err)
+		}
+		output["domain"] = request.DomainName
+		output["targetCluster"] = request.TargetCluster
+		output["progress"] = map[string]interface{}{
+			"phase":      request.Progress.Phase,
+			"replicated": request.Progress.Replicated,
+			"failed":     request.Progress.Failed,
+		}
+	case retentionReconcileJobKind:
+		queryResp, err := client.QueryWorkflow(ctx, &types.QueryWorkflowRequest{
+			Domain:    kind.domain,
+			Execution: &types.WorkflowExecution{WorkflowID: jobID},
+			Query:     &types.WorkflowQuery{QueryType: retentionreconciler.QueryType},
+		})
+		if err != nil {
+			ErrorAndExit("Failed to query retention reconcile job", err)
+		}
+		var request retentionreconciler.Request
+		if err := json.Unmarshal(queryResp.GetQueryResult(), &request); err != nil {
+			ErrorAndExit("Failed to deserialize retention reconcile job progress", err)
+		}
+		output["domain"] = request.DomainName
+		output["progress"] = map[string]interface{}{
+			"completed":  request.Progress.Completed,
+			"reconciled": request.Progress.Reconciled,
+			"failed":     request.Progress.Failed,
+		}
+	}
+	prettyPrintJSONObject(output)
+}
+
+// AdminCancelJob stops a running job
+func AdminCancelJob(c *cli.Context) {
+	jobID := getRequiredOption(c, FlagJobID)
+	reason := getRequiredOption(c, FlagReason)
+	kind := getJobKind(jobID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := getCadenceClient(c).TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+		Domain:            kind.domain,
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: jobID},
+		Reason:            reason,
+		Identity:          getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to cancel job", err)
+	}
+	fmt.Printf("%s job %s is canceled.\n", kind.name, jobID)
+}
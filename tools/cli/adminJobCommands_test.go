@@ -0,0 +1,56 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/domain/retentionreconciler"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/dlqmerger"
+)
+
+func TestGetJobKind(t *testing.T) {
+	assert.Equal(t, dlqMergeJobKind, getJobKind(dlqmerger.GetWorkflowID(types.DLQTypeReplication, "cluster0")))
+	assert.Equal(t, retentionReconcileJobKind, getJobKind(retentionreconciler.GetWorkflowID("test-domain")))
+	assert.Equal(t, batchJobKind, getJobKind("3d4c5b2a-1f0e-4d3c-9b8a-7f6e5d4c3b2a"))
+}
+
+func TestNewJobRow(t *testing.T) {
+	execution := &types.WorkflowExecutionInfo{
+		Execution: &types.WorkflowExecution{WorkflowID: "job-id"},
+		StartTime: common.Int64Ptr(1),
+	}
+	row := newJobRow(batchJobKind, execution)
+	assert.Equal(t, "batch", row.Kind)
+	assert.Equal(t, "job-id", row.JobID)
+	assert.Equal(t, jobStatusRunning, row.Status)
+	assert.Empty(t, row.CloseTime)
+
+	execution.CloseStatus = types.WorkflowExecutionCloseStatusTerminated.Ptr()
+	execution.CloseTime = common.Int64Ptr(2)
+	row = newJobRow(batchJobKind, execution)
+	assert.Equal(t, "TERMINATED", row.Status)
+	assert.NotEmpty(t, row.CloseTime)
+}
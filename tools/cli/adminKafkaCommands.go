@@ -112,7 +112,7 @@ func AdminKafkaParse(c *cli.Context) {
 	outputFile := getOutputFile(c.String(FlagOutputFilename))
 
 	defer inputFile.Close()
-	defer outputFile.Close()
+	defer releaseOutputFile(outputFile)
 
 	readerCh := make(chan []byte, chanBufferSize)
 	writerCh := newWriterChannel(kafkaMessageType(c.Int(FlagMessageType)))
@@ -164,7 +164,7 @@ func buildFilterFnForVisibility(workflowID, runID string) filterFnForVisibility
 
 func getOutputFile(outputFile string) *os.File {
 	if len(outputFile) == 0 {
-		return os.Stdout
+		return getOutput()
 	}
 	f, err := os.Create(outputFile)
 	if err != nil {
@@ -174,6 +174,14 @@ func getOutputFile(outputFile string) *os.File {
 	return f
 }
 
+// releaseOutputFile closes a file returned by getOutputFile unless it is the shared output,
+// which is stdout or the file set with --output-file and is closed when the CLI exits.
+func releaseOutputFile(f *os.File) {
+	if f != getOutput() {
+		f.Close()
+	}
+}
+
 func startReader(file *os.File, readerCh chan<- []byte) {
 	defer close(readerCh)
 	reader := bufio.NewReader(file)
@@ -0,0 +1,114 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type (
+	MatchingHostHealthRow struct {
+		Ready                bool   `header:"Ready"`
+		Draining             bool   `header:"Draining"`
+		LoadedTaskLists      int    `header:"Loaded Task Lists"`
+		LeaseFailures        int64  `header:"Lease Failures"`
+		PersistenceErrorRate string `header:"Persistence Error Rate (last minute)"`
+	}
+	NotReadyTaskListRow struct {
+		DomainID  string `header:"Domain ID"`
+		Name      string `header:"Task List Name"`
+		Type      string `header:"Type"`
+		LastError string `header:"Last Error"`
+	}
+	ContestedTaskListRow struct {
+		DomainID       string `header:"Domain ID"`
+		Name           string `header:"Task List Name"`
+		Type           string `header:"Type"`
+		RangeID        int64  `header:"Range ID"`
+		LeaseRenewals  int64  `header:"Lease Renewals"`
+		LeaseConflicts int64  `header:"Lease Conflicts"`
+	}
+)
+
+// AdminDescribeMatchingHost displays the health report of a single matching host
+func AdminDescribeMatchingHost(c *cli.Context) {
+	address := getRequiredOption(c, FlagMatchingAddress)
+	healthClient := cFactory.ServerHealthClientForAddress(c, cadenceMatchingService, address)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	status, err := healthClient.Health(ctx)
+	if err != nil {
+		ErrorAndExit("Operation Health failed.", err)
+	}
+
+	var health types.MatchingHostHealth
+	if err := json.Unmarshal([]byte(status.GetMsg()), &health); err != nil {
+		ErrorAndExit(fmt.Sprintf("Matching host did not return a health report: %q", status.GetMsg()), err)
+	}
+
+	table := []MatchingHostHealthRow{{
+		Ready:           health.Ready,
+		Draining:        health.Draining,
+		LoadedTaskLists: health.LoadedTaskLists,
+		LeaseFailures:   health.LeaseFailures,
+		PersistenceErrorRate: fmt.Sprintf("%.2f%% (%d/%d)",
+			health.PersistenceErrorRate*100, health.PersistenceErrors, health.PersistenceRequests),
+	}}
+	RenderTable(getOutput(), table, TableOptions{Color: true})
+
+	if len(health.NotReadyTaskLists) > 0 {
+		fmt.Println()
+		fmt.Println("Task lists whose last persistence operation failed:")
+		notReady := make([]NotReadyTaskListRow, 0, len(health.NotReadyTaskLists))
+		for _, tl := range health.NotReadyTaskLists {
+			notReady = append(notReady, NotReadyTaskListRow{
+				DomainID:  tl.DomainID,
+				Name:      tl.Name,
+				Type:      tl.TaskListType.String(),
+				LastError: tl.LastError,
+			})
+		}
+		RenderTable(getOutput(), notReady, TableOptions{Color: true, Border: true})
+	}
+
+	if len(health.ContestedTaskLists) > 0 {
+		fmt.Println()
+		fmt.Println("Task lists whose lease was taken by another host:")
+		contested := make([]ContestedTaskListRow, 0, len(health.ContestedTaskLists))
+		for _, tl := range health.ContestedTaskLists {
+			contested = append(contested, ContestedTaskListRow{
+				DomainID:       tl.DomainID,
+				Name:           tl.Name,
+				Type:           tl.TaskListType.String(),
+				RangeID:        tl.RangeID,
+				LeaseRenewals:  tl.LeaseRenewals,
+				LeaseConflicts: tl.LeaseConflicts,
+			})
+		}
+		RenderTable(getOutput(), contested, TableOptions{Color: true, Border: true})
+	}
+}
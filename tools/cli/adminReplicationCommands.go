@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type replicationAckRow struct {
+	Cluster      string `header:"Cluster"`
+	AckLevel     int64  `header:"Ack Level"`
+	LastAckTime  string `header:"Last Ack Time"`
+	PendingTasks string `header:"Pending Tasks"`
+}
+
+// AdminReplicationAcks shows for every remote cluster how far it has consumed the
+// replication queue of a shard
+func AdminReplicationAcks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	shardID := getRequiredIntOption(c, FlagShardID)
+	format := getOutputFormat(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.GetReplicationAckStates(ctx, &types.GetReplicationAckStatesRequest{
+		ShardID: int32(shardID),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to get replication ack states.", err)
+	}
+	states := resp.GetAckStates()
+
+	switch format {
+	case outputFormatJSON:
+		prettyPrintJSONObject(states)
+		return
+	case outputFormatJSONL:
+		for _, state := range states {
+			printJSONLine(state)
+		}
+		return
+	}
+
+	if len(states) == 0 {
+		fmt.Println("No remote cluster is replicating from this shard.")
+		return
+	}
+	rows := make([]replicationAckRow, 0, len(states))
+	for _, state := range states {
+		row := replicationAckRow{
+			Cluster:      state.GetClusterName(),
+			AckLevel:     state.GetAckLevel(),
+			LastAckTime:  "unknown since shard was loaded",
+			PendingTasks: strconv.FormatInt(state.GetPendingTasks(), 10),
+		}
+		if state.LastAckTime != nil {
+			lastAckTime := time.Unix(0, state.GetLastAckTime())
+			row.LastAckTime = fmt.Sprintf("%v (%v ago)", convertTime(state.GetLastAckTime(), false),
+				time.Since(lastAckTime).Round(time.Second))
+		}
+		if state.GetPendingTasksCapped() {
+			row.PendingTasks += "+"
+		}
+		rows = append(rows, row)
+	}
+	RenderTable(getOutput(), rows, TableOptions{Color: true})
+}
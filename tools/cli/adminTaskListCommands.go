@@ -22,11 +22,12 @@ package cli
 
 import (
 	"fmt"
-	"os"
-	"strings"
+	"sort"
 
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -43,17 +44,24 @@ type (
 		StartID   int64 `header:"Lease Start TaskID"`
 		EndID     int64 `header:"Lease End TaskID"`
 	}
+	TaskListUsageRow struct {
+		Domain    string `header:"Domain"`
+		Name      string `header:"Task List Name"`
+		Type      string `header:"Type"`
+		TaskCount int64  `header:"Tasks"`
+		SizeBytes int64  `header:"Size (bytes)"`
+		Estimated bool   `header:"Estimated"`
+	}
 )
 
+const taskListUsagePageSize = 100
+
 // AdminDescribeTaskList displays poller and status information of task list.
 func AdminDescribeTaskList(c *cli.Context) {
 	frontendClient := cFactory.ServerFrontendClient(c)
 	domain := getRequiredGlobalOption(c, FlagDomain)
 	taskList := getRequiredOption(c, FlagTaskList)
-	taskListType := types.TaskListTypeDecision
-	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
-		taskListType = types.TaskListTypeActivity
-	}
+	taskListType := strToTaskListType(c.String(FlagTaskListType))
 
 	ctx, cancel := newContext(c)
 	defer cancel()
@@ -107,7 +115,7 @@ func AdminListTaskList(c *cli.Context) {
 	for name, taskList := range response.GetActivityTaskListMap() {
 		table = append(table, TaskListRow{name, "Activity", len(taskList.GetPollers())})
 	}
-	RenderTable(os.Stdout, table, TableOptions{Color: true, Border: true})
+	RenderTable(getOutput(), table, TableOptions{Color: true, Border: true})
 }
 
 func printTaskListStatus(taskListStatus *types.TaskListStatus) {
@@ -118,5 +126,243 @@ func printTaskListStatus(taskListStatus *types.TaskListStatus) {
 		StartID:   taskListStatus.GetTaskIDBlock().GetStartID(),
 		EndID:     taskListStatus.GetTaskIDBlock().GetEndID(),
 	}}
-	RenderTable(os.Stdout, table, TableOptions{Color: true})
+	RenderTable(getOutput(), table, TableOptions{Color: true})
+}
+
+// AdminDescribeTaskListConfig displays the effective matching configuration of a tasklist
+func AdminDescribeTaskListConfig(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := strToTaskListType(c.String(FlagTaskListType))
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.DescribeTaskListConfig(ctx, &types.DescribeTaskListConfigRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: taskList},
+		TaskListType: &taskListType,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe tasklist config.", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// AdminTaskListUsage displays the number of tasks and bytes stored per task list
+func AdminTaskListUsage(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := c.GlobalString(FlagDomain)
+	taskListTypes := []int{persistence.TaskListTypeDecision, persistence.TaskListTypeActivity}
+	if c.IsSet(FlagTaskListType) {
+		taskListTypes = []int{persistence.TaskListTypeDecision}
+		if strToTaskListType(c.String(FlagTaskListType)) == types.TaskListTypeActivity {
+			taskListTypes = []int{persistence.TaskListTypeActivity}
+		}
+	}
+
+	var rows []TaskListUsageRow
+	var err error
+	if c.IsSet(FlagTaskList) {
+		if domain == "" {
+			ErrorAndExit("Domain is required to get the usage of a tasklist.", nil)
+		}
+		rows, err = getTaskListUsage(c, adminClient, domain, c.String(FlagTaskList), taskListTypes)
+	} else {
+		// no API lists the tasklists of every domain, so they are read from the database
+		taskManager := initializeTaskManager(c)
+		defer taskManager.Close()
+		domainManager := initializeDomainManager(c)
+		defer domainManager.Close()
+		rows, err = listTaskListUsage(c, taskManager, domainManager, adminClient, domain, taskListTypes, c.Int(FlagTop))
+	}
+	if err != nil {
+		ErrorAndExit("Failed to get tasklist usage.", err)
+	}
+	RenderTable(getOutput(), rows, TableOptions{Color: true, Border: true})
+}
+
+// AdminStealTaskListLease takes over the lease of an existing task list. The matching host holding the
+// lease fails its next write and unloads the task list, which is then loaded by the host owning it
+func AdminStealTaskListLease(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := strToTaskListType(c.String(FlagTaskListType))
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.StealTaskListLease(ctx, &types.StealTaskListLeaseRequest{
+		Domain:       domain,
+		TaskList:     &types.TaskList{Name: taskList},
+		TaskListType: &taskListType,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to steal tasklist lease.", err)
+	}
+	fmt.Printf("Stole lease of %v tasklist %s, range ID is now %d.\n", taskListType, taskList, resp.GetRangeID())
+}
+
+// AdminTagTaskList sets the tags of an existing task list. Without a task list type both types are tagged,
+// skipping the type the task list does not exist for
+func AdminTagTaskList(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	tags := parseTaskListTags(c)
+	if len(tags) == 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagTaskListTag), nil)
+	}
+	taskListTypes := []types.TaskListType{types.TaskListTypeDecision, types.TaskListTypeActivity}
+	if c.IsSet(FlagTaskListType) {
+		taskListTypes = []types.TaskListType{strToTaskListType(c.String(FlagTaskListType))}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	tagged := 0
+	for _, taskListType := range taskListTypes {
+		resp, err := adminClient.UpdateTaskListTags(ctx, &types.UpdateTaskListTagsRequest{
+			Domain:       domain,
+			TaskList:     &types.TaskList{Name: taskList},
+			TaskListType: taskListType.Ptr(),
+			Tags:         tags,
+		})
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); ok && len(taskListTypes) > 1 {
+				continue
+			}
+			ErrorAndExit(fmt.Sprintf("Failed to tag %v tasklist %s.", taskListType, taskList), err)
+		}
+		tagged++
+		fmt.Printf("Tags of %v tasklist %s: %s\n", taskListType, taskList, formatTaskListTags(resp.GetTags()))
+	}
+	if tagged == 0 {
+		ErrorAndExit(fmt.Sprintf("Tasklist %s does not exist.", taskList), nil)
+	}
+}
+
+// getTaskListUsage gets the usage of the given types of a task list through the admin API
+func getTaskListUsage(
+	c *cli.Context,
+	adminClient admin.Client,
+	domain string,
+	taskList string,
+	taskListTypes []int,
+) ([]TaskListUsageRow, error) {
+	rows := make([]TaskListUsageRow, 0, len(taskListTypes))
+	for _, taskListType := range taskListTypes {
+		ctx, cancel := newContext(c)
+		usage, err := adminClient.GetTaskListUsage(ctx, &types.GetTaskListUsageRequest{
+			Domain:       domain,
+			TaskList:     &types.TaskList{Name: taskList},
+			TaskListType: types.TaskListType(taskListType).Ptr(),
+		})
+		cancel()
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, TaskListUsageRow{
+			Domain:    domain,
+			Name:      taskList,
+			Type:      taskListTypeName(taskListType),
+			TaskCount: usage.GetTaskCount(),
+			SizeBytes: usage.GetSizeBytes(),
+			Estimated: usage.GetEstimated(),
+		})
+	}
+	return rows, nil
+}
+
+// listTaskListUsage gets the usage of every task list in the store, optionally only of one domain,
+// and returns the top largest ones. Task lists of deleted domains are skipped
+func listTaskListUsage(
+	c *cli.Context,
+	taskManager persistence.TaskManager,
+	domainManager persistence.DomainManager,
+	adminClient admin.Client,
+	domain string,
+	taskListTypes []int,
+	top int,
+) ([]TaskListUsageRow, error) {
+	ctx, cancel := newIndefiniteContext(c)
+	defer cancel()
+
+	// the store keys task lists by domain ID while the admin API takes domain names
+	domainNames := make(map[string]string)
+	getDomainName := func(domainID string) (string, error) {
+		if name, ok := domainNames[domainID]; ok {
+			return name, nil
+		}
+		resp, err := domainManager.GetDomain(ctx, &persistence.GetDomainRequest{ID: domainID})
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			domainNames[domainID] = ""
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		domainNames[domainID] = resp.Info.Name
+		return resp.Info.Name, nil
+	}
+
+	var rows []TaskListUsageRow
+	var pageToken []byte
+	for {
+		resp, err := taskManager.ListTaskList(ctx, &persistence.ListTaskListRequest{
+			PageSize:  taskListUsagePageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("listing tasklists failed, NoSQL stores only support --tasklist: %v", err)
+		}
+		for _, item := range resp.Items {
+			if !containsTaskListType(taskListTypes, item.TaskType) {
+				continue
+			}
+			domainName, err := getDomainName(item.DomainID)
+			if err != nil {
+				return nil, err
+			}
+			if domainName == "" || (domain != "" && domainName != domain) {
+				continue
+			}
+			usage, err := getTaskListUsage(c, adminClient, domainName, item.Name, []int{item.TaskType})
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, usage...)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return rows[i].SizeBytes > rows[j].SizeBytes
+	})
+	if top > 0 && len(rows) > top {
+		rows = rows[:top]
+	}
+	return rows, nil
+}
+
+func containsTaskListType(taskListTypes []int, taskListType int) bool {
+	for _, t := range taskListTypes {
+		if t == taskListType {
+			return true
+		}
+	}
+	return false
+}
+
+func taskListTypeName(taskListType int) string {
+	if taskListType == persistence.TaskListTypeActivity {
+		return "Activity"
+	}
+	return "Decision"
 }
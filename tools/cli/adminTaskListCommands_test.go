@@ -0,0 +1,101 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/client/admin"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestListTaskListUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	taskManager := persistence.NewMockTaskManager(ctrl)
+	domainManager := persistence.NewMockDomainManager(ctrl)
+	adminClient := admin.NewMockClient(ctrl)
+	c := cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)
+
+	taskManager.EXPECT().ListTaskList(gomock.Any(), &persistence.ListTaskListRequest{PageSize: taskListUsagePageSize}).
+		Return(&persistence.ListTaskListResponse{
+			Items: []persistence.TaskListInfo{
+				{DomainID: "d1", Name: "small", TaskType: persistence.TaskListTypeDecision},
+				{DomainID: "d2", Name: "other-domain", TaskType: persistence.TaskListTypeDecision},
+				{DomainID: "d3", Name: "deleted-domain", TaskType: persistence.TaskListTypeDecision},
+			},
+			NextPageToken: []byte("next"),
+		}, nil)
+	taskManager.EXPECT().ListTaskList(gomock.Any(), &persistence.ListTaskListRequest{PageSize: taskListUsagePageSize, PageToken: []byte("next")}).
+		Return(&persistence.ListTaskListResponse{
+			Items: []persistence.TaskListInfo{
+				{DomainID: "d1", Name: "large", TaskType: persistence.TaskListTypeActivity},
+				{DomainID: "d1", Name: "medium", TaskType: persistence.TaskListTypeDecision},
+			},
+		}, nil)
+	domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "d1"}).
+		Return(&persistence.GetDomainResponse{Info: &persistence.DomainInfo{ID: "d1", Name: "domain1"}}, nil).Times(1)
+	domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "d2"}).
+		Return(&persistence.GetDomainResponse{Info: &persistence.DomainInfo{ID: "d2", Name: "domain2"}}, nil).Times(1)
+	domainManager.EXPECT().GetDomain(gomock.Any(), &persistence.GetDomainRequest{ID: "d3"}).
+		Return(nil, &types.EntityNotExistsError{}).Times(1)
+	for name, size := range map[string]int64{"small": 10, "medium": 100, "large": 1000} {
+		taskListType := types.TaskListTypeDecision
+		if name == "large" {
+			taskListType = types.TaskListTypeActivity
+		}
+		adminClient.EXPECT().GetTaskListUsage(gomock.Any(), &types.GetTaskListUsageRequest{
+			Domain:       "domain1",
+			TaskList:     &types.TaskList{Name: name},
+			TaskListType: taskListType.Ptr(),
+		}).Return(&types.GetTaskListUsageResponse{TaskCount: size / 10, SizeBytes: size}, nil)
+	}
+
+	rows, err := listTaskListUsage(c, taskManager, domainManager, adminClient, "domain1", []int{persistence.TaskListTypeDecision, persistence.TaskListTypeActivity}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []TaskListUsageRow{
+		{Domain: "domain1", Name: "large", Type: "Activity", TaskCount: 100, SizeBytes: 1000},
+		{Domain: "domain1", Name: "medium", Type: "Decision", TaskCount: 10, SizeBytes: 100},
+	}, rows)
+}
+
+func TestGetTaskListUsage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	adminClient := admin.NewMockClient(ctrl)
+	c := cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)
+
+	adminClient.EXPECT().GetTaskListUsage(gomock.Any(), gomock.Any()).
+		Return(&types.GetTaskListUsageResponse{TaskCount: 5, SizeBytes: 500, Estimated: true}, nil).Times(2)
+
+	rows, err := getTaskListUsage(c, adminClient, "domain1", "tl", []int{persistence.TaskListTypeDecision, persistence.TaskListTypeActivity})
+	assert.NoError(t, err)
+	assert.Equal(t, []TaskListUsageRow{
+		{Domain: "domain1", Name: "tl", Type: "Decision", TaskCount: 5, SizeBytes: 500, Estimated: true},
+		{Domain: "domain1", Name: "tl", Type: "Activity", TaskCount: 5, SizeBytes: 500, Estimated: true},
+	}, rows)
+}
@@ -0,0 +1,400 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	loadTestWorkflowType     = "cadence-tasklist-loadtest"
+	loadTestActivityType     = "cadence-tasklist-loadtest-activity"
+	loadTestWorkflowIDPrefix = "cadence-tasklist-loadtest"
+
+	// loadTestActivitiesPerRun bounds the history of a generator workflow, it continues as new after that
+	loadTestActivitiesPerRun = 2000
+	// loadTestDecisionsPerSecond is the target rate of decisions per generator workflow, it sets the batch size
+	loadTestDecisionsPerSecond = 10
+	loadTestMaxBatchSize       = 100
+	loadTestDrainTimeout       = 10 * time.Second
+	loadTestTimeoutInSeconds   = 60
+	loadTestPollTimeout        = time.Minute
+)
+
+type (
+	// taskListLoadTest generates activity tasks on a test tasklist through generator workflows, acting as
+	// their decider, and drains the tasks with activity pollers to measure the dispatch path
+	taskListLoadTest struct {
+		client    frontend.Client
+		domain    string
+		taskList  *types.TaskList
+		identity  string
+		limiter   *rate.Limiter
+		batchSize int
+		deadline  time.Time
+
+		sync.Mutex
+		scheduledByRun map[string]int
+
+		scheduled       int64
+		dispatched      int64
+		errors          int64
+		scheduleLatency latencyRecorder
+		dispatchLatency latencyRecorder
+	}
+
+	// latencyRecorder keeps every sample of a load test, runs are short enough to fit in memory
+	latencyRecorder struct {
+		sync.Mutex
+		samples []time.Duration
+	}
+
+	// LoadTestLatencyRow is a presentation layer entity use to render the latency summary of a load test
+	LoadTestLatencyRow struct {
+		Name  string        `header:"Latency"`
+		Count int           `header:"Count"`
+		P50   time.Duration `header:"P50"`
+		P90   time.Duration `header:"P90"`
+		P99   time.Duration `header:"P99"`
+		Max   time.Duration `header:"Max"`
+	}
+)
+
+// AdminTaskListLoadTest generates synthetic activity tasks on a test tasklist at the given rate and reports
+// the latency of scheduling and dispatching them, and the sync match rate of the tasklist
+func AdminTaskListLoadTest(c *cli.Context) {
+	client := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	taskList := getRequiredOption(c, FlagTaskList)
+	rps := c.Int(FlagRPS)
+	duration := c.Duration(FlagDuration)
+	workflows := c.Int(FlagWorkflowCount)
+	pollers := c.Int(FlagConcurrency)
+	if rps <= 0 || duration <= 0 || workflows <= 0 || pollers <= 0 {
+		ErrorAndExit(fmt.Sprintf("Options %s, %s, %s and %s must be positive.", FlagRPS, FlagDuration, FlagWorkflowCount, FlagConcurrency), nil)
+		return
+	}
+
+	batchSize := rps / workflows / loadTestDecisionsPerSecond
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	if batchSize > loadTestMaxBatchSize {
+		batchSize = loadTestMaxBatchSize
+	}
+	lt := &taskListLoadTest{
+		client:         client,
+		domain:         domain,
+		taskList:       &types.TaskList{Name: taskList},
+		identity:       getCliIdentity(),
+		limiter:        rate.NewLimiter(rate.Limit(rps), batchSize),
+		batchSize:      batchSize,
+		deadline:       time.Now().Add(duration),
+		scheduledByRun: make(map[string]int),
+	}
+
+	statsBefore := lt.describeMatchStats(c)
+
+	workflowIDs := lt.startWorkflows(c, workflows)
+	fmt.Printf("Started %d generator workflows on tasklist %s, generating %d activity tasks per second for %v.\n",
+		len(workflowIDs), taskList, rps, duration)
+
+	// pollers and deciders keep running past the deadline to drain the tasks already scheduled
+	runCtx, cancel := context.WithDeadline(context.Background(), lt.deadline.Add(loadTestDrainTimeout))
+	defer cancel()
+	var wg sync.WaitGroup
+	for i := 0; i < workflows; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lt.runDecider(runCtx)
+		}()
+	}
+	for i := 0; i < pollers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lt.runPoller(runCtx)
+		}()
+	}
+	wg.Wait()
+
+	lt.terminateWorkflows(c, workflowIDs)
+	statsAfter := lt.describeMatchStats(c)
+	lt.printReport(duration, statsBefore, statsAfter)
+}
+
+func (lt *taskListLoadTest) startWorkflows(c *cli.Context, count int) []string {
+	runID := uuid.New()
+	workflowIDs := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		workflowID := fmt.Sprintf("%s-%s-%d", loadTestWorkflowIDPrefix, runID, i)
+		ctx, cancel := newContext(c)
+		_, err := lt.client.StartWorkflowExecution(ctx, &types.StartWorkflowExecutionRequest{
+			Domain:                              lt.domain,
+			WorkflowID:                          workflowID,
+			WorkflowType:                        &types.WorkflowType{Name: loadTestWorkflowType},
+			TaskList:                            lt.taskList,
+			ExecutionStartToCloseTimeoutSeconds: lt.workflowTimeoutInSeconds(),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+			Identity:                            lt.identity,
+			RequestID:                           uuid.New(),
+		})
+		cancel()
+		if err != nil {
+			lt.terminateWorkflows(c, workflowIDs)
+			ErrorAndExit("Failed to start load test workflow.", err)
+			return nil
+		}
+		workflowIDs = append(workflowIDs, workflowID)
+	}
+	return workflowIDs
+}
+
+// terminateWorkflows stops the generator workflows, their abandoned activity tasks are dropped by matching
+func (lt *taskListLoadTest) terminateWorkflows(c *cli.Context, workflowIDs []string) {
+	for _, workflowID := range workflowIDs {
+		ctx, cancel := newContext(c)
+		err := lt.client.TerminateWorkflowExecution(ctx, &types.TerminateWorkflowExecutionRequest{
+			Domain:            lt.domain,
+			WorkflowExecution: &types.WorkflowExecution{WorkflowID: workflowID},
+			Reason:            "tasklist load test finished",
+			Identity:          lt.identity,
+		})
+		cancel()
+		if err != nil {
+			if _, ok := err.(*types.EntityNotExistsError); !ok {
+				fmt.Fprintf(os.Stderr, "Failed to terminate load test workflow %s: %v\n", workflowID, err)
+			}
+		}
+	}
+}
+
+func (lt *taskListLoadTest) runDecider(ctx context.Context) {
+	for ctx.Err() == nil {
+		pollCtx, cancel := context.WithTimeout(ctx, loadTestPollTimeout)
+		task, err := lt.client.PollForDecisionTask(pollCtx, &types.PollForDecisionTaskRequest{
+			Domain:   lt.domain,
+			TaskList: lt.taskList,
+			Identity: lt.identity,
+		})
+		cancel()
+		if err != nil {
+			if ctx.Err() == nil {
+				atomic.AddInt64(&lt.errors, 1)
+			}
+			continue
+		}
+		if len(task.GetTaskToken()) == 0 {
+			continue
+		}
+
+		decisions, scheduled := lt.decide(ctx, task)
+		startTime := time.Now()
+		_, err = lt.client.RespondDecisionTaskCompleted(ctx, &types.RespondDecisionTaskCompletedRequest{
+			TaskToken: task.TaskToken,
+			Decisions: decisions,
+			Identity:  lt.identity,
+		})
+		if err != nil {
+			atomic.AddInt64(&lt.errors, 1)
+			continue
+		}
+		if scheduled > 0 {
+			lt.scheduleLatency.add(time.Since(startTime))
+			atomic.AddInt64(&lt.scheduled, int64(scheduled))
+		}
+	}
+}
+
+// decide schedules the next batch of activity tasks once the rate limiter allows it. Every completed
+// activity brings a new decision, so the generator workflows never have more than a few batches pending.
+func (lt *taskListLoadTest) decide(ctx context.Context, task *types.PollForDecisionTaskResponse) ([]*types.Decision, int) {
+	if time.Now().After(lt.deadline) {
+		return nil, 0
+	}
+
+	runID := task.WorkflowExecution.GetRunID()
+	lt.Lock()
+	scheduledInRun := lt.scheduledByRun[runID]
+	lt.Unlock()
+	if scheduledInRun >= loadTestActivitiesPerRun {
+		lt.Lock()
+		delete(lt.scheduledByRun, runID)
+		lt.Unlock()
+		return []*types.Decision{{
+			DecisionType: types.DecisionTypeContinueAsNewWorkflowExecution.Ptr(),
+			ContinueAsNewWorkflowExecutionDecisionAttributes: &types.ContinueAsNewWorkflowExecutionDecisionAttributes{
+				WorkflowType:                        &types.WorkflowType{Name: loadTestWorkflowType},
+				TaskList:                            lt.taskList,
+				ExecutionStartToCloseTimeoutSeconds: lt.workflowTimeoutInSeconds(),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(defaultDecisionTimeoutInSeconds),
+			},
+		}}, 0
+	}
+
+	waitCtx, cancel := context.WithDeadline(ctx, lt.deadline)
+	defer cancel()
+	if err := lt.limiter.WaitN(waitCtx, lt.batchSize); err != nil {
+		return nil, 0
+	}
+
+	decisions := make([]*types.Decision, 0, lt.batchSize)
+	for i := 0; i < lt.batchSize; i++ {
+		decisions = append(decisions, &types.Decision{
+			DecisionType: types.DecisionTypeScheduleActivityTask.Ptr(),
+			ScheduleActivityTaskDecisionAttributes: &types.ScheduleActivityTaskDecisionAttributes{
+				ActivityID:                    strconv.Itoa(scheduledInRun + i),
+				ActivityType:                  &types.ActivityType{Name: loadTestActivityType},
+				TaskList:                      lt.taskList,
+				ScheduleToCloseTimeoutSeconds: common.Int32Ptr(loadTestTimeoutInSeconds),
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(loadTestTimeoutInSeconds),
+				StartToCloseTimeoutSeconds:    common.Int32Ptr(loadTestTimeoutInSeconds),
+				HeartbeatTimeoutSeconds:       common.Int32Ptr(0),
+			},
+		})
+	}
+	lt.Lock()
+	lt.scheduledByRun[runID] = scheduledInRun + len(decisions)
+	lt.Unlock()
+	return decisions, len(decisions)
+}
+
+func (lt *taskListLoadTest) runPoller(ctx context.Context) {
+	for ctx.Err() == nil {
+		pollCtx, cancel := context.WithTimeout(ctx, loadTestPollTimeout)
+		task, err := lt.client.PollForActivityTask(pollCtx, &types.PollForActivityTaskRequest{
+			Domain:   lt.domain,
+			TaskList: lt.taskList,
+			Identity: lt.identity,
+		})
+		cancel()
+		if err != nil {
+			if ctx.Err() == nil {
+				atomic.AddInt64(&lt.errors, 1)
+			}
+			continue
+		}
+		if len(task.GetTaskToken()) == 0 {
+			continue
+		}
+
+		atomic.AddInt64(&lt.dispatched, 1)
+		if task.GetScheduledTimestampOfThisAttempt() > 0 {
+			lt.dispatchLatency.add(time.Duration(task.GetStartedTimestamp() - task.GetScheduledTimestampOfThisAttempt()))
+		}
+		if err := lt.client.RespondActivityTaskCompleted(ctx, &types.RespondActivityTaskCompletedRequest{
+			TaskToken: task.TaskToken,
+			Identity:  lt.identity,
+		}); err != nil {
+			atomic.AddInt64(&lt.errors, 1)
+		}
+	}
+}
+
+// describeMatchStats returns the match counters of the root activity tasklist partition, if the server reports them
+func (lt *taskListLoadTest) describeMatchStats(c *cli.Context) *types.TaskListMatchStats {
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := lt.client.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
+		Domain:                lt.domain,
+		TaskList:              lt.taskList,
+		TaskListType:          types.TaskListTypeActivity.Ptr(),
+		IncludeTaskListStatus: true,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to describe tasklist %s: %v\n", lt.taskList.GetName(), err)
+		return nil
+	}
+	return resp.GetTaskListStatus().GetMatchStats()
+}
+
+func (lt *taskListLoadTest) workflowTimeoutInSeconds() *int32 {
+	return common.Int32Ptr(int32(time.Until(lt.deadline.Add(loadTestDrainTimeout)).Seconds()) + loadTestTimeoutInSeconds)
+}
+
+func (lt *taskListLoadTest) printReport(duration time.Duration, before, after *types.TaskListMatchStats) {
+	scheduled := atomic.LoadInt64(&lt.scheduled)
+	dispatched := atomic.LoadInt64(&lt.dispatched)
+	fmt.Printf("Scheduled activity tasks: %d (%.1f per second)\n", scheduled, float64(scheduled)/duration.Seconds())
+	fmt.Printf("Dispatched activity tasks: %d (%.1f per second)\n", dispatched, float64(dispatched)/duration.Seconds())
+	fmt.Printf("Errors: %d\n", atomic.LoadInt64(&lt.errors))
+	if matchRate, ok := syncMatchRate(before, after); ok {
+		fmt.Printf("Sync match rate (root partition): %.2f%%\n", matchRate*100)
+	} else {
+		fmt.Println("Sync match rate (root partition): N/A, match stats are not reported by the server")
+	}
+	RenderTable(getOutput(), []LoadTestLatencyRow{
+		lt.scheduleLatency.summary("Schedule (RespondDecisionTaskCompleted)"),
+		lt.dispatchLatency.summary("Dispatch (scheduled to started)"),
+	}, TableOptions{Color: true, Border: true})
+}
+
+func syncMatchRate(before, after *types.TaskListMatchStats) (float64, bool) {
+	if after == nil {
+		return 0, false
+	}
+	syncMatched := after.GetSyncMatched() - before.GetSyncMatched()
+	matched := syncMatched + after.GetBufferedMatched() - before.GetBufferedMatched()
+	if matched <= 0 {
+		return 0, false
+	}
+	return float64(syncMatched) / float64(matched), true
+}
+
+func (r *latencyRecorder) add(latency time.Duration) {
+	r.Lock()
+	defer r.Unlock()
+	r.samples = append(r.samples, latency)
+}
+
+func (r *latencyRecorder) summary(name string) LoadTestLatencyRow {
+	r.Lock()
+	defer r.Unlock()
+	row := LoadTestLatencyRow{Name: name, Count: len(r.samples)}
+	if len(r.samples) == 0 {
+		return row
+	}
+	sort.Slice(r.samples, func(i, j int) bool { return r.samples[i] < r.samples[j] })
+	percentile := func(p float64) time.Duration {
+		return r.samples[int(float64(len(r.samples)-1)*p)]
+	}
+	row.P50 = percentile(0.5)
+	row.P90 = percentile(0.9)
+	row.P99 = percentile(0.99)
+	row.Max = r.samples[len(r.samples)-1]
+	return row
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestLatencyRecorder_Summary(t *testing.T) {
+	var r latencyRecorder
+	assert.Equal(t, LoadTestLatencyRow{Name: "empty"}, r.summary("empty"))
+
+	for i := 100; i > 0; i-- {
+		r.add(time.Duration(i) * time.Millisecond)
+	}
+	row := r.summary("latency")
+	assert.Equal(t, 100, row.Count)
+	assert.Equal(t, 50*time.Millisecond, row.P50)
+	assert.Equal(t, 90*time.Millisecond, row.P90)
+	assert.Equal(t, 99*time.Millisecond, row.P99)
+	assert.Equal(t, 100*time.Millisecond, row.Max)
+}
+
+func TestSyncMatchRate(t *testing.T) {
+	_, ok := syncMatchRate(nil, nil)
+	assert.False(t, ok)
+
+	before := &types.TaskListMatchStats{SyncMatched: 10, BufferedMatched: 10}
+	_, ok = syncMatchRate(before, before)
+	assert.False(t, ok)
+
+	matchRate, ok := syncMatchRate(before, &types.TaskListMatchStats{SyncMatched: 40, BufferedMatched: 20})
+	assert.True(t, ok)
+	assert.InDelta(t, 0.75, matchRate, 0.0001)
+
+	matchRate, ok = syncMatchRate(nil, &types.TaskListMatchStats{SyncMatched: 5, BufferedMatched: 5})
+	assert.True(t, ok)
+	assert.InDelta(t, 0.5, matchRate, 0.0001)
+}
+
+func TestTaskListLoadTest_Decide(t *testing.T) {
+	lt := &taskListLoadTest{
+		taskList:       &types.TaskList{Name: "test-tasklist"},
+		limiter:        rate.NewLimiter(rate.Inf, 3),
+		batchSize:      3,
+		deadline:       time.Now().Add(time.Minute),
+		scheduledByRun: make(map[string]int),
+	}
+	task := &types.PollForDecisionTaskResponse{
+		WorkflowExecution: &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"},
+	}
+
+	decisions, scheduled := lt.decide(context.Background(), task)
+	assert.Equal(t, 3, scheduled)
+	assert.Len(t, decisions, 3)
+	for i, decision := range decisions {
+		assert.Equal(t, types.DecisionTypeScheduleActivityTask, decision.GetDecisionType())
+		assert.Equal(t, strconv.Itoa(i), decision.ScheduleActivityTaskDecisionAttributes.GetActivityID())
+		assert.Equal(t, "test-tasklist", decision.ScheduleActivityTaskDecisionAttributes.TaskList.GetName())
+	}
+
+	// activity IDs continue within the run
+	decisions, _ = lt.decide(context.Background(), task)
+	assert.Equal(t, "3", decisions[0].ScheduleActivityTaskDecisionAttributes.GetActivityID())
+
+	// long runs continue as new to bound their history
+	lt.scheduledByRun["rid"] = loadTestActivitiesPerRun
+	decisions, scheduled = lt.decide(context.Background(), task)
+	assert.Equal(t, 0, scheduled)
+	assert.Len(t, decisions, 1)
+	assert.Equal(t, types.DecisionTypeContinueAsNewWorkflowExecution, decisions[0].GetDecisionType())
+	assert.NotContains(t, lt.scheduledByRun, "rid")
+
+	// nothing is scheduled after the deadline
+	lt.deadline = time.Now().Add(-time.Second)
+	decisions, scheduled = lt.decide(context.Background(), task)
+	assert.Equal(t, 0, scheduled)
+	assert.Empty(t, decisions)
+}
@@ -173,7 +173,7 @@ func AdminTimers(c *cli.Context) {
 		if c.IsSet(FlagDateFormat) {
 			timerFormat = c.String(FlagDateFormat)
 		} else {
-			switch c.String(FlagBucketSize) {
+			switch getRequiredEnumOption(c, FlagBucketSize, []string{"day", "hour", "minute", "second"}) {
 			case "day":
 				timerFormat = "2006-01-02"
 			case "hour":
@@ -182,8 +182,6 @@ func AdminTimers(c *cli.Context) {
 				timerFormat = "2006-01-02T15:04"
 			case "second":
 				timerFormat = "2006-01-02T15:04:05"
-			default:
-				ErrorAndExit("unknown bucket size: "+c.String(FlagBucketSize), nil)
 			}
 		}
 		printer = NewHistogramPrinter(c, timerFormat)
@@ -209,7 +207,7 @@ func (jp *jsonPrinter) Print(timers []*persistence.TimerTaskInfo) error {
 			}
 			fmt.Println(err.Error())
 		} else {
-			fmt.Println(string(data))
+			Render(string(data))
 		}
 	}
 	return nil
@@ -27,6 +27,7 @@ import (
 
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/metrics"
+	cliconfig "github.com/uber/cadence/tools/cli/config"
 )
 
 // SetFactory is used to set the ClientFactory global
@@ -46,6 +47,7 @@ func NewCliApp() *cli.App {
 	app.Name = "cadence"
 	app.Usage = "A command-line tool for cadence users"
 	app.Version = version
+	app.Action = runDefaultAction
 	app.Flags = []cli.Flag{
 		cli.StringFlag{
 			Name:   FlagAddressWithAlias,
@@ -79,6 +81,123 @@ func NewCliApp() *cli.App {
 			Usage:  "optional argument for transport protocol format, either 'grpc' or 'tchannel'. Defaults to tchannel if not provided",
 			EnvVar: "CADENCE_CLI_TRANSPORT_PROTOCOL",
 		},
+		cli.IntFlag{
+			Name:   FlagMaxRetries,
+			Value:  0,
+			Usage:  "optional max number of retries of RPC calls failed with service busy or transient transport errors",
+			EnvVar: "CADENCE_CLI_MAX_RETRIES",
+		},
+		cli.IntFlag{
+			Name:   FlagRetryBackoffInMs,
+			Value:  defaultRetryBackoffInMs,
+			Usage:  "optional initial backoff in milliseconds between retries of RPC calls, doubled on each retry. Calls throttled by the server wait for the retry after hint of the server instead",
+			EnvVar: "CADENCE_CLI_RETRY_BACKOFF_MS",
+		},
+		cli.StringFlag{
+			Name:  FlagSortByWithAlias,
+			Usage: "optional column header name to sort every table of the command by, in ascending order. A table without the column is an error",
+		},
+		cli.StringFlag{
+			Name:  FlagColumns,
+			Usage: "optional comma separated column header names to display in every table of the command, in the given order. A table without one of the columns is an error",
+		},
+		cli.StringFlag{
+			Name:   FlagAuditLog,
+			Value:  getDefaultAuditLogPath(),
+			Usage:  "local append-only file recording mutating commands, set to empty to disable",
+			EnvVar: "CADENCE_CLI_AUDIT_LOG",
+		},
+		cli.StringFlag{
+			Name:   FlagAuditURL,
+			Usage:  "optional HTTP endpoint receiving a JSON audit record for every mutating command",
+			EnvVar: "CADENCE_CLI_AUDIT_URL",
+		},
+		cli.BoolFlag{
+			Name:   FlagQuiet,
+			Usage:  "optional flag to hide progress indicators written to stderr by long running commands",
+			EnvVar: "CADENCE_CLI_QUIET",
+		},
+		cli.StringFlag{
+			Name:   FlagTimezone,
+			Usage:  "optional IANA time zone to render times in, e.g. America/New_York or UTC. Defaults to the local time zone",
+			EnvVar: "CADENCE_CLI_TIMEZONE",
+		},
+		cli.StringFlag{
+			Name:   FlagProfile,
+			Usage:  "optional profile whose stored credentials are used when --jwt, --jwt-private-key, --security_token or --tls_key_path are not set",
+			EnvVar: "CADENCE_CLI_PROFILE",
+		},
+		cli.StringFlag{
+			Name:   FlagCredentialsStore,
+			Value:  credentialsStoreKeychain,
+			Usage:  "where profile credentials are stored, either 'keychain' for the OS keychain or 'file' for a passphrase encrypted file",
+			EnvVar: "CADENCE_CLI_CREDENTIALS_STORE",
+		},
+		cli.StringFlag{
+			Name:   FlagCredentialsFile,
+			Value:  getDefaultCredentialsFilePath(),
+			Usage:  "path of the encrypted credentials file used with --credentials_store file",
+			EnvVar: "CADENCE_CLI_CREDENTIALS_FILE",
+		},
+		cli.StringFlag{
+			Name:   FlagEnv,
+			Usage:  "optional environment of the configuration file whose address, transport, domain, TLS settings and security token are used when not set otherwise",
+			EnvVar: "CADENCE_CLI_ENV",
+		},
+		cli.StringFlag{
+			Name:   FlagConfigFile,
+			Value:  cliconfig.DefaultPath(),
+			Usage:  "path of the configuration file defining the environments selected with --env",
+			EnvVar: "CADENCE_CLI_CONFIG_FILE",
+		},
+		cli.StringFlag{
+			Name:   FlagOutputFile,
+			Usage:  "optional file to write the output of the command to, replaced once the command succeeds. Prompts are still shown on the terminal",
+			EnvVar: "CADENCE_CLI_OUTPUT_FILE",
+		},
+		cli.StringFlag{
+			Name:   FlagErrorFormat,
+			Value:  errorFormatText,
+			Usage:  "format of the error reported when a command fails, either 'text' or 'json' written to stderr. The exit code tells the failure cause apart: 1 for other errors, 3 not found, 4 already exists, 5 timeout, 6 access denied",
+			EnvVar: "CADENCE_CLI_ERROR_FORMAT",
+		},
+		cli.BoolFlag{
+			Name:   FlagAllPages,
+			Usage:  "optional flag to walk all the pages of listing commands without prompting for the next page",
+			EnvVar: "CADENCE_CLI_ALL_PAGES",
+		},
+		cli.IntFlag{
+			Name:   FlagMaxItems,
+			Usage:  "optional maximum number of items listing commands show, the pages are walked without prompting until it is reached",
+			EnvVar: "CADENCE_CLI_MAX_ITEMS",
+		},
+		cli.BoolFlag{
+			Name:   FlagGlobalDryRun,
+			Usage:  "optional flag to print the requests of mutating commands, e.g. domain register, update, deprecate and failover, workflow reset and terminate and batch jobs, as JSON instead of sending them",
+			EnvVar: "CADENCE_CLI_DRY_RUN",
+		},
+		cli.BoolFlag{
+			Name:  FlagVerbose,
+			Usage: "optional flag to write to stderr where the value of each set flag comes from: the command line, an environment variable or the config file. Every flag can be set with the CADENCE_FLAG_NAME environment variable, e.g. CADENCE_WORKFLOW_ID for --workflow_id",
+		},
+	}
+	app.Before = func(c *cli.Context) error {
+		if err := setErrorFormat(c); err != nil {
+			return err
+		}
+		if err := loadEnvironment(c); err != nil {
+			return err
+		}
+		if err := setPaging(c); err != nil {
+			return err
+		}
+		setTableFlags(c)
+		setDisplayLocation(c)
+		return openOutputFile(c)
+	}
+	app.After = func(c *cli.Context) error {
+		removeProfileCredentialFiles()
+		return closeOutputFile(true)
 	}
 	app.Commands = []cli.Command{
 		{
@@ -122,6 +241,17 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on history host",
 					Subcommands: newAdminHistoryHostCommands(),
 				},
+				{
+					Name:        "history",
+					Usage:       "Run admin operation on raw workflow history",
+					Subcommands: newAdminHistoryCommands(),
+				},
+				{
+					Name:        "matching",
+					Aliases:     []string{"mat"},
+					Usage:       "Run admin operation on matching host",
+					Subcommands: newAdminMatchingCommands(),
+				},
 				{
 					Name:        "kafka",
 					Aliases:     []string{"ka"},
@@ -170,12 +300,29 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operations on queue",
 					Subcommands: newAdminQueueCommands(),
 				},
+				{
+					Name:        "replication",
+					Aliases:     []string{"rep"},
+					Usage:       "Run admin operation on history replication",
+					Subcommands: newAdminReplicationCommands(),
+				},
 				{
 					Name:        "config",
 					Aliases:     []string{"c"},
 					Usage:       "Run admin operation on config store",
 					Subcommands: newAdminConfigStoreCommands(),
 				},
+				{
+					Name:        "job",
+					Aliases:     []string{"j"},
+					Usage:       "Run admin operation on server side jobs such as batch operations and DLQ merges",
+					Subcommands: newAdminJobCommands(),
+				},
+				{
+					Name:        "decode",
+					Usage:       "Decode opaque values such as task tokens",
+					Subcommands: newAdminDecodeCommands(),
+				},
 			},
 		},
 		{
@@ -184,7 +331,31 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence cluster",
 			Subcommands: newClusterCommands(),
 		},
+		{
+			Name:            "get",
+			Usage:           "Describe a resource addressed as " + getResourceUsage,
+			ArgsUsage:       "resource [describe flags]",
+			SkipFlagParsing: true,
+			Action: func(c *cli.Context) {
+				GetResource(c)
+			},
+		},
+		{
+			Name:        "script",
+			Usage:       "Record and replay sequences of CLI commands",
+			Subcommands: newScriptCommands(),
+		},
+		{
+			Name:        "credentials",
+			Aliases:     []string{"cred"},
+			Usage:       "Manage the credentials stored for profiles in the OS keychain or an encrypted file",
+			Subcommands: newCredentialsCommands(),
+		},
+		newCompletionCommand(),
 	}
+	enableFlagEnvVars(app)
+	enableAudit(app.Commands, "")
+	enableCompletion(app)
 
 	// set builder if not customized
 	if cFactory == nil {
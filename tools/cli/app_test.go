@@ -21,6 +21,11 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -31,12 +36,16 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/urfave/cli"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/service/worker/batcher"
 )
 
 type cliAppSuite struct {
@@ -50,6 +59,18 @@ type cliAppSuite struct {
 type clientFactoryMock struct {
 	serverFrontendClient frontend.Client
 	serverAdminClient    admin.Client
+	healthClient         HealthClient
+}
+
+// cliTestExit is raised from osExit in RunUntilErrorExit, so that a command stops where it would have exited
+type cliTestExit struct{}
+
+type healthClientStub struct {
+	status *types.HealthStatus
+}
+
+func (h *healthClientStub) Health(ctx context.Context) (*types.HealthStatus, error) {
+	return h.status, nil
 }
 
 func (m *clientFactoryMock) ServerFrontendClient(c *cli.Context) frontend.Client {
@@ -60,6 +81,18 @@ func (m *clientFactoryMock) ServerAdminClient(c *cli.Context) admin.Client {
 	return m.serverAdminClient
 }
 
+func (m *clientFactoryMock) ServerFrontendClientForAddress(c *cli.Context, hostPort string) frontend.Client {
+	return m.serverFrontendClient
+}
+
+func (m *clientFactoryMock) ServerAdminClientForAddress(c *cli.Context, hostPort string) admin.Client {
+	return m.serverAdminClient
+}
+
+func (m *clientFactoryMock) ServerHealthClientForAddress(c *cli.Context, serviceName, hostPort string) HealthClient {
+	return m.healthClient
+}
+
 func (m *clientFactoryMock) ElasticSearchClient(c *cli.Context) *elastic.Client {
 	panic("not implemented")
 }
@@ -82,9 +115,15 @@ func TestCLIAppSuite(t *testing.T) {
 }
 
 func (s *cliAppSuite) SetupSuite() {
+	// keep tests from writing to the audit log in the home directory
+	os.Setenv("CADENCE_CLI_AUDIT_LOG", "")
 	s.app = NewCliApp()
 }
 
+func (s *cliAppSuite) TearDownSuite() {
+	os.Unsetenv("CADENCE_CLI_AUDIT_LOG")
+}
+
 func (s *cliAppSuite) SetupTest() {
 	s.mockCtrl = gomock.NewController(s.T())
 
@@ -111,6 +150,25 @@ func (s *cliAppSuite) RunErrorExitCode(arguments []string) int {
 	return errorCode
 }
 
+// RunUntilErrorExit is like RunErrorExitCode, but the command stops at the first exit as the process would
+func (s *cliAppSuite) RunUntilErrorExit(arguments []string) (errorCode int) {
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	osExit = func(code int) {
+		errorCode = code
+		panic(cliTestExit{})
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(cliTestExit); !ok {
+				panic(r)
+			}
+		}
+	}()
+	s.NoError(s.app.Run(arguments))
+	return errorCode
+}
+
 func (s *cliAppSuite) TestAppCommands() {
 	for _, test := range commands {
 		cmd := s.app.Command(test)
@@ -118,6 +176,28 @@ func (s *cliAppSuite) TestAppCommands() {
 	}
 }
 
+func (s *cliAppSuite) TestPlugin() {
+	dir, err := ioutil.TempDir("", "cadence-cli-plugin")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	output := filepath.Join(dir, "output")
+	script := "#!/bin/sh\necho \"$CADENCE_CLI_ADDRESS $CADENCE_CLI_DOMAIN $*\" > " + output + "\nexit 3\n"
+	s.NoError(ioutil.WriteFile(filepath.Join(dir, pluginPrefix+"foo"), []byte(script), 0755))
+
+	oldPath := os.Getenv("PATH")
+	defer os.Setenv("PATH", oldPath)
+	s.NoError(os.Setenv("PATH", dir+string(os.PathListSeparator)+oldPath))
+
+	errorCode := s.RunErrorExitCode([]string{"", "--ad", "localhost:7933", "--do", domainName, "foo", "bar", "--baz"})
+	s.Equal(3, errorCode)
+	content, err := ioutil.ReadFile(output)
+	s.NoError(err)
+	s.Equal("localhost:7933 "+domainName+" bar --baz\n", string(content))
+
+	errorCode = s.RunErrorExitCode([]string{"", "not-a-plugin"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestDomainRegister_LocalDomain() {
 	s.serverFrontendClient.EXPECT().RegisterDomain(gomock.Any(), gomock.Any()).Return(nil)
 	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "register", "--global_domain", "false"})
@@ -130,10 +210,57 @@ func (s *cliAppSuite) TestDomainRegister_GlobalDomain() {
 	s.Equal(0, errorCode)
 }
 
+func (s *cliAppSuite) TestDomainRegister_Environment() {
+	dir, err := ioutil.TempDir("", "cadence-cli-config")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "config.yaml")
+	s.NoError(ioutil.WriteFile(path, []byte("environments:\n  staging:\n    domain: staging-domain\n    securityToken: staging-token\n"), 0600))
+
+	s.serverFrontendClient.EXPECT().RegisterDomain(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.RegisterDomainRequest, opts ...yarpc.CallOption) error {
+			s.Equal("staging-domain", request.GetName())
+			s.Equal("staging-token", request.GetSecurityToken())
+			return nil
+		})
+	err = s.app.Run([]string{"", "--env", "staging", "--config_file", path, "domain", "register", "--global_domain", "false"})
+	s.Nil(err)
+
+	// options passed on the command line take precedence over the environment
+	s.serverFrontendClient.EXPECT().RegisterDomain(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.RegisterDomainRequest, opts ...yarpc.CallOption) error {
+			s.Equal(domainName, request.GetName())
+			s.Equal("token", request.GetSecurityToken())
+			return nil
+		})
+	err = s.app.Run([]string{"", "--env", "staging", "--config_file", path, "--do", domainName, "domain", "register", "--global_domain", "false", "--st", "token"})
+	s.Nil(err)
+
+	err = s.app.Run([]string{"", "--env", "prod", "--config_file", path, "domain", "register"})
+	s.Error(err)
+}
+
+func (s *cliAppSuite) TestDomainRegister_DryRun() {
+	dir, err := ioutil.TempDir("", "cadence-cli-dry-run")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "request.json")
+
+	// the request is printed, not sent
+	err = s.app.Run([]string{"", "--dry-run", "--output-file", path, "--do", domainName, "domain", "register", "--global_domain", "false", "--st", "token"})
+	s.Nil(err)
+	content, err := ioutil.ReadFile(path)
+	s.NoError(err)
+	var request map[string]interface{}
+	s.NoError(json.Unmarshal(content, &request))
+	s.Equal(domainName, request["name"])
+	s.Equal(auditRedactedValue, request["securityToken"])
+}
+
 func (s *cliAppSuite) TestDomainRegister_DomainExist() {
 	s.serverFrontendClient.EXPECT().RegisterDomain(gomock.Any(), gomock.Any()).Return(&types.DomainAlreadyExistsError{})
 	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "register", "--global_domain", "true"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeAlreadyExists, errorCode)
 }
 
 func (s *cliAppSuite) TestDomainRegister_Failed() {
@@ -169,9 +296,74 @@ func (s *cliAppSuite) TestDomainUpdate() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
 	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, nil).Times(2)
-	err := s.app.Run([]string{"", "--do", domainName, "domain", "update"})
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "update", "--reason", "test"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--do", domainName, "domain", "update", "--reason", "test", "--desc", "another desc", "--oe", "another@uber.com", "--rd", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainUpdate_DryRun() {
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponseServer, nil)
+	err := s.app.Run([]string{"", "--dry-run", "--do", domainName, "domain", "update", "--reason", "test", "--desc", "another desc"})
 	s.Nil(err)
-	err = s.app.Run([]string{"", "--do", domainName, "domain", "update", "--desc", "another desc", "--oe", "another@uber.com", "--rd", "1"})
+}
+
+func (s *cliAppSuite) TestDomainUpdate_RequiresReason() {
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	// stop at the first failure, the domain is never updated
+	osExit = func(code int) {
+		panic(code)
+	}
+	s.PanicsWithValue(1, func() {
+		s.app.Run([]string{"", "--do", domainName, "domain", "update", "--desc", "another desc"})
+	})
+}
+
+func (s *cliAppSuite) TestDomainUpdate_RecordsChangeHistory() {
+	resp := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{
+			Name: "test-domain",
+			Data: map[string]string{
+				common.DomainDataKeyForChangeHistory: `[{"timestamp":"2021-01-01T00:00:00Z","operator":"alice","operation":"update","reason":"first"}]`,
+			},
+		},
+		Configuration:            describeDomainResponseServer.Configuration,
+		ReplicationConfiguration: describeDomainResponseServer.ReplicationConfiguration,
+	}
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.UpdateDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
+			changes := getDomainChangeHistory(request.Data)
+			s.Len(changes, 2)
+			s.Equal("first", changes[0].Reason)
+			s.Equal(domainChangeOperationUpdate, changes[1].Operation)
+			s.Equal("second", changes[1].Reason)
+			s.Equal("v", request.Data["k"])
+			return nil, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "update", "--reason", "second", "--domain_data", "k=v"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainUpdate_FailoverRecordsChangeHistory() {
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.UpdateDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
+			s.Equal("standby", *request.ActiveClusterName)
+			s.Nil(request.Data)
+			return nil, nil
+		})
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponseServer, nil)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.UpdateDomainRequest, opts ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
+			s.Nil(request.ActiveClusterName)
+			changes := getDomainChangeHistory(request.Data)
+			s.Len(changes, 1)
+			s.Equal(domainChangeOperationFailover, changes[0].Operation)
+			s.Equal("drill", changes[0].Reason)
+			return nil, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "update", "--reason", "drill", "--active_cluster", "standby"})
 	s.Nil(err)
 }
 
@@ -179,21 +371,21 @@ func (s *cliAppSuite) TestDomainUpdate_DomainNotExist() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
 	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
-	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "update"})
-	s.Equal(1, errorCode)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "update", "--reason", "test"})
+	s.Equal(ExitCodeNotFound, errorCode)
 }
 
 func (s *cliAppSuite) TestDomainUpdate_ActiveClusterFlagNotSet_DomainNotExist() {
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(nil, &types.EntityNotExistsError{})
-	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "update"})
-	s.Equal(1, errorCode)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "update", "--reason", "test"})
+	s.Equal(ExitCodeNotFound, errorCode)
 }
 
 func (s *cliAppSuite) TestDomainUpdate_Failed() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
 	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, &types.BadRequestError{"faked error"})
-	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "update"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "update", "--reason", "test"})
 	s.Equal(1, errorCode)
 }
 
@@ -201,54 +393,117 @@ func (s *cliAppSuite) TestDomainDeprecate() {
 	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListClosedWorkflowExecutionsResponse{}, nil)
 	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{}, nil)
 	s.serverFrontendClient.EXPECT().DeprecateDomain(gomock.Any(), gomock.Any()).Return(nil)
-	err := s.app.Run([]string{"", "--do", domainName, "domain", "deprecate"})
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponseServer, nil)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test", "--yes"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainDeprecate_TypedConfirmation() {
+	oldConfirmationInput := confirmationInput
+	defer func() { confirmationInput = oldConfirmationInput }()
+
+	s.serverFrontendClient.EXPECT().DeprecateDomain(gomock.Any(), gomock.Any()).Return(nil)
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponseServer, nil)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, nil)
+	confirmationInput = strings.NewReader(domainName + "\n")
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test", "--force"})
 	s.Nil(err)
+
+	// a plain yes no longer confirms, and nothing is deprecated
+	confirmationInput = strings.NewReader("y\n")
+	errorCode := s.RunUntilErrorExit([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test", "--force"})
+	s.Equal(1, errorCode)
 }
 
 func (s *cliAppSuite) TestDomainDeprecate_DomainNotExist() {
 	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListClosedWorkflowExecutionsResponse{}, nil)
 	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{}, nil)
 	s.serverFrontendClient.EXPECT().DeprecateDomain(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
-	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate"})
-	s.Equal(1, errorCode)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test", "--yes"})
+	s.Equal(ExitCodeNotFound, errorCode)
 }
 
 func (s *cliAppSuite) TestDomainDeprecate_Failed() {
 	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListClosedWorkflowExecutionsResponse{}, nil)
 	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListOpenWorkflowExecutionsResponse{}, nil)
 	s.serverFrontendClient.EXPECT().DeprecateDomain(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
-	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test", "--yes"})
 	s.Equal(1, errorCode)
 }
 
 func (s *cliAppSuite) TestDomainDeprecate_ClosedWorkflowsExist() {
 	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(listClosedWorkflowExecutionsResponse, nil)
-	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test"})
 	s.Equal(1, errorCode)
 }
 
 func (s *cliAppSuite) TestDomainDeprecate_OpenWorkflowsExist() {
 	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListClosedWorkflowExecutionsResponse{}, nil)
 	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(listOpenWorkflowExecutionsResponse, nil)
-	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test"})
 	s.Equal(1, errorCode)
 }
 
 func (s *cliAppSuite) TestDomainDeprecate_Force() {
 	s.serverFrontendClient.EXPECT().DeprecateDomain(gomock.Any(), gomock.Any()).Return(nil)
-	err := s.app.Run([]string{"", "--do", domainName, "domain", "deprecate", "--force"})
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponseServer, nil)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).Return(nil, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test", "--yes", "--force"})
 	s.Nil(err)
 }
 
 func (s *cliAppSuite) TestDomainDeprecate_DomainNotExist_Force() {
 	s.serverFrontendClient.EXPECT().DeprecateDomain(gomock.Any(), gomock.Any()).Return(&types.EntityNotExistsError{})
-	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate", "--force"})
-	s.Equal(1, errorCode)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test", "--yes", "--force"})
+	s.Equal(ExitCodeNotFound, errorCode)
 }
 
 func (s *cliAppSuite) TestDomainDeprecate_Failed_Force() {
 	s.serverFrontendClient.EXPECT().DeprecateDomain(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
-	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate", "--force"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "deprecate", "--reason", "test", "--yes", "--force"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestDomainQuarantine() {
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponseServer, nil)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.UpdateDomainRequest, _ ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
+			s.Equal("true", request.Data[common.DomainDataKeyForQuarantine])
+			s.Contains(request.Data[common.DomainDataKeyForChangeHistory], domainChangeOperationQuarantine)
+			return nil, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "quarantine", "--reason", "test"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainUnquarantine() {
+	resp := *describeDomainResponseServer
+	info := *resp.DomainInfo
+	info.Data = map[string]string{common.DomainDataKeyForQuarantine: "true"}
+	resp.DomainInfo = &info
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&resp, nil)
+	s.serverFrontendClient.EXPECT().UpdateDomain(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.UpdateDomainRequest, _ ...yarpc.CallOption) (*types.UpdateDomainResponse, error) {
+			s.Equal("false", request.Data[common.DomainDataKeyForQuarantine])
+			return nil, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "unquarantine", "--reason", "test"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainQuarantine_AlreadyQuarantined() {
+	resp := *describeDomainResponseServer
+	info := *resp.DomainInfo
+	info.Data = map[string]string{common.DomainDataKeyForQuarantine: "true"}
+	resp.DomainInfo = &info
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(&resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "quarantine", "--reason", "test"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainQuarantine_ReasonRequired() {
+	errorCode := s.RunUntilErrorExit([]string{"", "--do", domainName, "domain", "quarantine"})
 	s.Equal(1, errorCode)
 }
 
@@ -259,11 +514,84 @@ func (s *cliAppSuite) TestDomainDescribe() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDomainDescribe_History() {
+	resp := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{
+			Name: "test-domain",
+			Data: map[string]string{
+				common.DomainDataKeyForChangeHistory: newDomainChangeHistory(nil, domainChangeOperationUpdate, "test"),
+			},
+		},
+		Configuration:            describeDomainResponseServer.Configuration,
+		ReplicationConfiguration: describeDomainResponseServer.ReplicationConfiguration,
+	}
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "describe", "--history"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainDescribe_Replication() {
+	resp := describeDomainResponseServer
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
+	s.serverAdminClient.EXPECT().GetDomainReplicationWatermark(gomock.Any(), &types.GetDomainReplicationWatermarkRequest{
+		Domain: resp.DomainInfo.GetName(),
+	}).Return(&types.GetDomainReplicationWatermarkResponse{
+		Cluster:                 "standby",
+		LastReplicatedEventTime: common.Int64Ptr(time.Now().UnixNano()),
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "describe", "--replication"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainDescribe_JSONFormat() {
+	resp := describeDomainResponseServer
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "describe", "--format", "json"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--do", domainName, "domain", "describe", "--format", "jsonl"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDomainDescribe_ArchivalProvider() {
+	resp := &types.DescribeDomainResponse{
+		DomainInfo: &types.DomainInfo{
+			Name: "test-domain",
+			Data: map[string]string{
+				common.DomainDataKeyForHistoryArchivalProvider: "team-bucket",
+			},
+		},
+		Configuration: &types.DomainConfiguration{
+			HistoryArchivalStatus:    types.ArchivalStatusEnabled.Ptr(),
+			HistoryArchivalURI:       "s3://team-bucket/history",
+			VisibilityArchivalStatus: types.ArchivalStatusEnabled.Ptr(),
+			VisibilityArchivalURI:    "file:///tmp/visibility",
+		},
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{},
+	}
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "domain", "describe"})
+	s.Nil(err)
+
+	s.Equal("team-bucket (scheme: s3, location: team-bucket/history)", describeArchivalProvider("team-bucket", "s3://team-bucket/history"))
+	s.Equal("default (scheme: file, location: /tmp/visibility)", describeArchivalProvider("", "file:///tmp/visibility"))
+}
+
 func (s *cliAppSuite) TestDomainDescribe_DomainNotExist() {
 	resp := describeDomainResponseServer
 	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, &types.EntityNotExistsError{})
 	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "domain", "describe"})
-	s.Equal(1, errorCode)
+	s.Equal(ExitCodeNotFound, errorCode)
+}
+
+func (s *cliAppSuite) TestDomainDescribe_DomainNotExist_JSONErrorFormat() {
+	resp := describeDomainResponseServer
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(resp, &types.EntityNotExistsError{})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "--error-format", "json", "domain", "describe"})
+	s.Equal(ExitCodeNotFound, errorCode)
+	s.Equal(errorFormatJSON, errorFormat)
+
+	err := s.app.Run([]string{"", "--do", domainName, "--error-format", "yaml", "domain", "describe"})
+	s.Error(err)
 }
 
 func (s *cliAppSuite) TestDomainDescribe_Failed() {
@@ -306,6 +634,69 @@ func (s *cliAppSuite) TestShowHistory() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDescribeBatch() {
+	dir, err := ioutil.TempDir("", "cli-describe-batch")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "ids.txt")
+	s.NoError(ioutil.WriteFile(path, []byte("wid-open\nwid-closed,rid-closed\nwid-missing\n"), 0600))
+
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
+			s.Equal(domainName, request.GetDomain())
+			switch request.Execution.GetWorkflowID() {
+			case "wid-open":
+				return &types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+						Execution: &types.WorkflowExecution{WorkflowID: "wid-open", RunID: "rid-open"},
+						StartTime: common.Int64Ptr(time.Now().UnixNano()),
+						TaskList:  "tl",
+					},
+				}, nil
+			case "wid-closed":
+				s.Equal("rid-closed", request.Execution.GetRunID())
+				return &types.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+						Execution:     request.Execution,
+						StartTime:     common.Int64Ptr(time.Now().UnixNano()),
+						CloseTime:     common.Int64Ptr(time.Now().UnixNano()),
+						CloseStatus:   types.WorkflowExecutionCloseStatusFailed.Ptr(),
+						HistoryLength: 12,
+					},
+				}, nil
+			default:
+				return nil, &types.EntityNotExistsError{Message: "workflow not found"}
+			}
+		}).Times(6)
+
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "describe-batch", "--file", path, "--concurrency", "2"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "describe-batch", "--file", path, "--format", "json"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestShowHistory_JSONFormat() {
+	resp := getWorkflowExecutionHistoryResponse
+	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
+	// json output only contains the events, pending activities are not described
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "show", "-w", "wid", "--format", "json"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "show", "-w", "wid", "--format", "jsonl"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestShowHistory_ArchivedRequiresRunID() {
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	// stop at the first failure, archived history is never read from the frontend
+	osExit = func(code int) {
+		panic(code)
+	}
+	s.PanicsWithValue(1, func() {
+		s.app.Run([]string{"", "--do", domainName, "workflow", "show", "-w", "wid", "--archived"})
+	})
+}
+
 func (s *cliAppSuite) TestShowHistoryWithID() {
 	resp := getWorkflowExecutionHistoryResponse
 	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -339,6 +730,54 @@ func (s *cliAppSuite) TestShowHistory_PrintDateTime() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestDescribeWorkflow_SearchAttributes() {
+	describeResp := &types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{
+			SearchAttributes: &types.SearchAttributes{
+				IndexedFields: map[string][]byte{
+					"CustomKeywordField": []byte(`"keyword"`),
+					"RemovedField":       []byte(`123`),
+				},
+			},
+		},
+	}
+	searchAttrResp := &types.GetSearchAttributesResponse{
+		Keys: map[string]types.IndexedValueType{
+			"CustomKeywordField": types.IndexedValueTypeKeyword,
+		},
+	}
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(describeResp, nil)
+	s.serverFrontendClient.EXPECT().GetSearchAttributes(gomock.Any()).Return(searchAttrResp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "describe", "-w", "wid", "-psa"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDescribeWorkflow_FlagFromEnvVar() {
+	os.Setenv("CADENCE_WORKFLOW_ID", "env-wid")
+	defer os.Unsetenv("CADENCE_WORKFLOW_ID")
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.DescribeWorkflowExecutionRequest, _ ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
+			s.Equal("env-wid", request.Execution.GetWorkflowID())
+			return &types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{}}, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "--verbose", "workflow", "describe"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestStartWorkflow_CronSchedule() {
+	resp := &types.StartWorkflowExecutionResponse{RunID: uuid.New()}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(1)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "--cron", "*/5 * * * *", "--yes"})
+	s.Equal(0, errorCode)
+}
+
+func (s *cliAppSuite) TestStartWorkflow_InvalidCronSchedule() {
+	// osExit is mocked and doesn't stop the command
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{}, nil).MaxTimes(1)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "--cron", "* * *", "--confirm"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestStartWorkflow() {
 	resp := &types.StartWorkflowExecutionResponse{RunID: uuid.New()}
 	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
@@ -387,6 +826,11 @@ func (s *cliAppSuite) TestTerminateWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestTerminateWorkflow_DryRun() {
+	err := s.app.Run([]string{"", "--dry-run", "--do", domainName, "workflow", "terminate", "-w", "wid"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.serverFrontendClient.EXPECT().TerminateWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{"faked error"})
 	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "terminate", "-w", "wid"})
@@ -417,6 +861,69 @@ func (s *cliAppSuite) TestSignalWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestSignalWorkflow_BatchFile() {
+	dir, err := ioutil.TempDir("", "cli-signal-batch")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	batchFile := filepath.Join(dir, "signals.json")
+	s.NoError(ioutil.WriteFile(batchFile, []byte(`[{"name": "first", "input": {"key": "value"}}, {"name": "second"}]`), 0644))
+
+	s.serverFrontendClient.EXPECT().SignalBatchWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *types.SignalBatchWorkflowExecutionRequest, _ ...interface{}) error {
+			s.Equal("wid", request.GetWorkflowExecution().GetWorkflowID())
+			s.Equal([]*types.SignalBatchItem{
+				{SignalName: "first", Input: []byte(`{"key": "value"}`)},
+				{SignalName: "second"},
+			}, request.Signals)
+			return nil
+		})
+	err = s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "--batch_file", batchFile})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestSignalWorkflow_BatchFileWithName() {
+	errorCode := s.RunUntilErrorExit([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name", "--batch_file", "signals.json"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAuditLog() {
+	dir, err := ioutil.TempDir("", "cli-audit")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	auditLog := filepath.Join(dir, "audit.log")
+
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err = s.app.Run([]string{"", "--do", domainName, "--audit_log", auditLog, "workflow", "signal", "-w", "wid", "-n", "signal-name", "-i", `"secret"`})
+	s.Nil(err)
+
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "--audit_log", auditLog, "workflow", "signal", "-w", "wid", "-n", "signal-name"})
+	s.Equal(1, errorCode)
+
+	// read only commands are not recorded
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResponseServer, nil)
+	err = s.app.Run([]string{"", "--do", domainName, "--audit_log", auditLog, "domain", "describe"})
+	s.Nil(err)
+
+	data, err := ioutil.ReadFile(auditLog)
+	s.NoError(err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	s.Len(lines, 2)
+
+	var record auditRecord
+	s.NoError(json.Unmarshal([]byte(lines[0]), &record))
+	s.Equal("workflow signal", record.Command)
+	s.Equal(domainName, record.Domain)
+	s.Equal(auditOutcomeSuccess, record.Outcome)
+	s.Equal("wid", record.Flags[FlagWorkflowID])
+	s.Equal(auditRedactedValue, record.Flags[FlagInput])
+	s.NotContains(lines[0], "secret")
+
+	s.NoError(json.Unmarshal([]byte(lines[1]), &record))
+	s.Equal(auditOutcomeFailure, record.Outcome)
+	s.Equal(1, record.ExitCode)
+}
+
 func (s *cliAppSuite) TestQueryWorkflow() {
 	resp := &types.QueryWorkflowResponse{
 		QueryResult: []byte("query-result"),
@@ -539,6 +1046,106 @@ func (s *cliAppSuite) TestListWorkflow_Open_WithWorkflowType() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestListAllWorkflow_JSONLines() {
+	firstPage := &types.ListClosedWorkflowExecutionsResponse{
+		Executions:    listClosedWorkflowExecutionsResponse.Executions,
+		NextPageToken: []byte("next-page"),
+	}
+	gomock.InOrder(
+		s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(firstPage, nil),
+		s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(listClosedWorkflowExecutionsResponse, nil),
+	)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "listall", "--format", "jsonl"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListWorkflow_AllPages() {
+	firstPage := &types.ListClosedWorkflowExecutionsResponse{
+		Executions:    listClosedWorkflowExecutionsResponse.Executions,
+		NextPageToken: []byte("next-page"),
+	}
+	gomock.InOrder(
+		s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(firstPage, nil),
+		s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(listClosedWorkflowExecutionsResponse, nil),
+	)
+	err := s.app.Run([]string{"", "--do", domainName, "--all-pages", "workflow", "list"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListWorkflow_MaxItems() {
+	firstPage := &types.ListClosedWorkflowExecutionsResponse{
+		Executions:    listClosedWorkflowExecutionsResponse.Executions,
+		NextPageToken: []byte("next-page"),
+	}
+	// the next page is not fetched once max-items workflows are shown
+	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(firstPage, nil).Times(1)
+	err := s.app.Run([]string{"", "--do", domainName, "--max-items", "1", "workflow", "list", "--more"})
+	s.Nil(err)
+
+	err = s.app.Run([]string{"", "--do", domainName, "--max-items", "-1", "workflow", "list"})
+	s.Error(err)
+}
+
+func (s *cliAppSuite) TestStartBatchJob_Wait() {
+	result, err := json.Marshal(batcher.HeartBeatDetails{SuccessCount: 2, ErrorCount: 1})
+	s.NoError(err)
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.CountWorkflowExecutionsResponse{Count: 3}, nil)
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.StartWorkflowExecutionResponse{}, nil)
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(&types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{CloseStatus: types.WorkflowExecutionCloseStatusCompleted.Ptr()},
+	}, nil).Times(2)
+	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(&types.GetWorkflowExecutionHistoryResponse{
+		History: &types.History{Events: []*types.HistoryEvent{{
+			WorkflowExecutionCompletedEventAttributes: &types.WorkflowExecutionCompletedEventAttributes{Result: result},
+		}}},
+	}, nil)
+	// the job failed on one of the workflows
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "batch", "start", "--query", "WorkflowType='test'",
+		"--reason", "test", "--batch_type", "signal", "--signal_name", "test", "--input", "{}", "--yes", "--wait"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestListWorkflow_UnsupportedFormat() {
+	// the mocked os.Exit does not stop the command
+	s.serverFrontendClient.EXPECT().ListClosedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&types.ListClosedWorkflowExecutionsResponse{}, nil).MaxTimes(1)
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "list", "--format", "yaml"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestListAtRiskWorkflow() {
+	reachTime, _ := json.Marshal(time.Now().Add(time.Hour))
+	resp := &types.ListWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{
+			{
+				Execution: &types.WorkflowExecution{
+					WorkflowID: "test-list-at-risk-workflow-id",
+					RunID:      uuid.New(),
+				},
+				Type:          &types.WorkflowType{Name: "test-list-at-risk-workflow-type"},
+				HistoryLength: 60000,
+				SearchAttributes: &types.SearchAttributes{
+					IndexedFields: map[string][]byte{definition.HistoryLimitReachTime: reachTime},
+				},
+			},
+		},
+	}
+	s.serverFrontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil).Times(3)
+	for _, format := range []string{"table", "json", "jsonl"} {
+		err := s.app.Run([]string{"", "--do", domainName, "workflow", "list-at-risk", "--format", format})
+		s.Nil(err)
+	}
+}
+
+func (s *cliAppSuite) TestListAtRiskWorkflow_NoneAtRisk() {
+	s.serverFrontendClient.EXPECT().ListWorkflowExecutions(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, request *types.ListWorkflowExecutionsRequest, _ ...yarpc.CallOption) (*types.ListWorkflowExecutionsResponse, error) {
+			s.Equal(`CloseTime = missing AND HistoryLimitReachTime > "1970-01-01T00:00:00Z" AND WorkflowType = "test-list-at-risk-workflow-type" ORDER BY HistoryLimitReachTime`, request.GetQuery())
+			return &types.ListWorkflowExecutionsResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "list-at-risk", "-wt", "test-list-at-risk-workflow-type"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestListArchivedWorkflow() {
 	resp := &types.ListArchivedWorkflowExecutionsResponse{}
 	s.serverFrontendClient.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -557,6 +1164,25 @@ func (s *cliAppSuite) TestCountWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestCountWorkflow_GroupBy() {
+	resp := &types.CountWorkflowExecutionsResponse{
+		Count: 5,
+		Groups: []*types.CountWorkflowExecutionsGroup{
+			{Value: types.WorkflowExecutionCloseStatusCompleted.String(), Count: 3},
+			{Value: "", Count: 2},
+		},
+	}
+	s.serverFrontendClient.EXPECT().CountWorkflowExecutions(gomock.Any(), &types.CountWorkflowExecutionsRequest{
+		Domain:  domainName,
+		GroupBy: "CloseStatus",
+	}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "count", "--group_by", "CloseStatus"})
+	s.Nil(err)
+
+	errorCode := s.RunUntilErrorExit([]string{"", "--do", domainName, "workflow", "count", "--group_by", "RunID"})
+	s.Equal(1, errorCode)
+}
+
 var describeTaskListResponse = &types.DescribeTaskListResponse{
 	Pollers: []*types.PollerInfo{
 		{
@@ -584,6 +1210,24 @@ func (s *cliAppSuite) TestAdminDescribeWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestDescribeWorkflow_PrintPendingRequests() {
+	describeResp := &types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{},
+		PendingChildren: []*types.PendingChildExecutionInfo{
+			{Domain: "child-domain", WorkflowID: "child-wid", InitiatedID: 5},
+		},
+	}
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(describeResp, nil)
+	msResp := &types.AdminDescribeWorkflowExecutionResponse{
+		MutableStateInDatabase: `{"ChildExecutionInfos":{"5":{"InitiatedID":5,"StartedID":-23}},` +
+			`"RequestCancelInfos":{"7":{"InitiatedID":7,"CancelRequestID":"cancel-id"}},` +
+			`"SignalInfos":{"9":{"InitiatedID":9,"SignalRequestID":"signal-id","SignalName":"signal"}}}`,
+	}
+	s.serverAdminClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(msResp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "describe", "-w", "wid", "--praw", "--print_pending_requests"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	var promptMsg string
 	promptFn = func(msg string) {
@@ -601,6 +1245,330 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminReplicationAcks() {
+	s.serverAdminClient.EXPECT().GetReplicationAckStates(gomock.Any(), &types.GetReplicationAckStatesRequest{
+		ShardID: 1,
+	}).Return(&types.GetReplicationAckStatesResponse{
+		AckStates: []*types.ReplicationAckState{
+			{ClusterName: "standby", AckLevel: 10, LastAckTime: common.Int64Ptr(time.Now().UnixNano()), PendingTasks: 2},
+			{ClusterName: "other", AckLevel: 5, PendingTasks: 10000, PendingTasksCapped: true},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "replication", "acks", "--shard_id", "1"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDomainTokenCreate() {
+	s.serverAdminClient.EXPECT().IssueDomainToken(gomock.Any(), &types.IssueDomainTokenRequest{
+		Domain:     domainName,
+		APIs:       []string{"StartWorkflowExecution", "SignalWorkflowExecution"},
+		TTLSeconds: 3600,
+		Subject:    "team",
+	}).Return(&types.IssueDomainTokenResponse{Token: "token"}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "token", "create",
+		"--apis", "StartWorkflowExecution, SignalWorkflowExecution", "--ttl", "1h", "--subject", "team"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDomainReplicateBackfill() {
+	s.serverAdminClient.EXPECT().BackfillDomainReplication(gomock.Any(), &types.BackfillDomainReplicationRequest{
+		Domain:              domainName,
+		TargetCluster:       "standby",
+		ClosedWithinSeconds: 72 * 3600,
+		RPS:                 50,
+	}).Return(&types.BackfillDomainReplicationResponse{JobID: "cadence-sys-replication-backfill-test-domain-standby"}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "replicate-backfill",
+		"--tc", "standby", "--closed_within", "72h", "--rps", "50"})
+	s.Nil(err)
+
+	errorCode := s.RunUntilErrorExit([]string{"", "--do", domainName, "admin", "domain", "replicate-backfill", "--tc", "standby", "--rps", "-1"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminDomainReconcileRetention() {
+	s.serverAdminClient.EXPECT().ReconcileDomainRetention(gomock.Any(), &types.ReconcileDomainRetentionRequest{
+		Domain: domainName,
+		RPS:    50,
+	}).Return(&types.ReconcileDomainRetentionResponse{JobID: "cadence-sys-retention-reconcile-test-domain"}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "reconcile-retention", "--rps", "50"})
+	s.Nil(err)
+
+	errorCode := s.RunUntilErrorExit([]string{"", "--do", domainName, "admin", "domain", "reconcile-retention", "--rps", "-1"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminDomainUpdateAsyncWorkflowConfig() {
+	s.serverAdminClient.EXPECT().UpdateDomainAsyncWorkflowConfig(gomock.Any(), &types.UpdateDomainAsyncWorkflowConfigRequest{
+		Domain: domainName,
+		Configuration: &types.AsyncWorkflowConfiguration{
+			Enabled:       true,
+			QueueProvider: "kafka",
+			QueueTopic:    "async-requests",
+			RPS:           100,
+		},
+	}).Return(&types.UpdateDomainAsyncWorkflowConfigResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "update-async-workflow-config",
+		"--async_workflow_enabled", "--async_workflow_queue_provider", "kafka", "--async_workflow_queue_topic", "async-requests", "--async_workflow_rps", "100"})
+	s.Nil(err)
+
+	errorCode := s.RunUntilErrorExit([]string{"", "--do", domainName, "admin", "domain", "update-async-workflow-config", "--async_workflow_enabled"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminDomainDescribeAsyncWorkflowConfig() {
+	s.serverAdminClient.EXPECT().GetDomainAsyncWorkflowConfig(gomock.Any(), &types.GetDomainAsyncWorkflowConfigRequest{
+		Domain: domainName,
+	}).Return(&types.GetDomainAsyncWorkflowConfigResponse{
+		Configuration: &types.AsyncWorkflowConfiguration{Enabled: true, QueueProvider: "kafka", QueueTopic: "async-requests"},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "domain", "describe-async-workflow-config"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminTaskListConfig() {
+	s.serverAdminClient.EXPECT().DescribeTaskListConfig(gomock.Any(), &types.DescribeTaskListConfigRequest{
+		Domain:       domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+	}).Return(&types.DescribeTaskListConfigResponse{NumReadPartitions: 1, NumWritePartitions: 1}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "config", "--tl", "tl"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminTaskListUsage() {
+	s.serverAdminClient.EXPECT().GetTaskListUsage(gomock.Any(), &types.GetTaskListUsageRequest{
+		Domain:       domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}).Return(&types.GetTaskListUsageResponse{TaskCount: 2, SizeBytes: 200}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "usage", "--tl", "tl", "--tlt", "activity"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminTaskListStealLease() {
+	s.serverAdminClient.EXPECT().StealTaskListLease(gomock.Any(), &types.StealTaskListLeaseRequest{
+		Domain:       domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}).Return(&types.StealTaskListLeaseResponse{RangeID: 4}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "steal-lease", "--tl", "tl", "--tlt", "activity"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminTaskListTag() {
+	tags := map[string]string{"team": "payments", "tier": ""}
+	s.serverAdminClient.EXPECT().UpdateTaskListTags(gomock.Any(), &types.UpdateTaskListTagsRequest{
+		Domain:       domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+		Tags:         tags,
+	}).Return(&types.UpdateTaskListTagsResponse{Tags: map[string]string{"team": "payments"}}, nil)
+	s.serverAdminClient.EXPECT().UpdateTaskListTags(gomock.Any(), &types.UpdateTaskListTagsRequest{
+		Domain:       domainName,
+		TaskList:     &types.TaskList{Name: "tl"},
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		Tags:         tags,
+	}).Return(nil, &types.EntityNotExistsError{})
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "tasklist", "tag", "--tl", "tl", "--tag", "team=payments", "--tag", "tier="})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminTaskListTag_InvalidTag() {
+	errorCode := s.RunUntilErrorExit([]string{"", "--do", domainName, "admin", "tasklist", "tag", "--tl", "tl", "--tag", "team"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestListTaskLists() {
+	s.serverAdminClient.EXPECT().ListTaskListTags(gomock.Any(), &types.ListTaskListTagsRequest{
+		Domain: domainName,
+		Tags:   map[string]string{"team": "payments"},
+	}).Return(&types.ListTaskListTagsResponse{
+		TaskLists: []*types.TaskListTags{
+			{Name: "tl", TaskListType: types.TaskListTypeActivity.Ptr(), Tags: map[string]string{"team": "payments", "tier": "1"}},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "list", "--tag", "team=payments"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDomainList_DataFilter() {
+	s.serverFrontendClient.EXPECT().ListDomains(gomock.Any(), gomock.Any()).Return(&types.ListDomainsResponse{
+		Domains: []*types.DescribeDomainResponse{
+			{DomainInfo: &types.DomainInfo{Name: "managed", Status: types.DomainStatusRegistered.Ptr(), Data: map[string]string{"IsManagedByCadence": "true"}}},
+			{DomainInfo: &types.DomainInfo{Name: "unmanaged", Status: types.DomainStatusRegistered.Ptr()}},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "domain", "list", "--data_filter", "IsManagedByCadence=true"})
+	s.Nil(err)
+
+	errorCode := s.RunUntilErrorExit([]string{"", "admin", "domain", "list", "--data_filter", "IsManagedByCadence"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestListTaskLists_SortAndColumns() {
+	s.serverAdminClient.EXPECT().ListTaskListTags(gomock.Any(), gomock.Any()).Return(&types.ListTaskListTagsResponse{
+		TaskLists: []*types.TaskListTags{
+			{Name: "tl-b", TaskListType: types.TaskListTypeActivity.Ptr()},
+			{Name: "tl-a", TaskListType: types.TaskListTypeDecision.Ptr()},
+		},
+	}, nil).Times(2)
+	err := s.app.Run([]string{"", "--do", domainName, "--sort-by", "task list name", "--columns", "Type, Task List Name", "tasklist", "list"})
+	s.Nil(err)
+
+	errorCode := s.RunUntilErrorExit([]string{"", "--do", domainName, "--columns", "Owner", "tasklist", "list"})
+	s.Equal(1, errorCode)
+
+	// unknown columns are errors even when there is nothing to display
+	s.serverAdminClient.EXPECT().ListTaskListTags(gomock.Any(), gomock.Any()).Return(&types.ListTaskListTagsResponse{}, nil)
+	errorCode = s.RunUntilErrorExit([]string{"", "--do", domainName, "--sort-by", "Owner", "tasklist", "list"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminSimulateDomainFailover() {
+	describeDomainResp := &types.DescribeDomainResponse{
+		DomainInfo:     &types.DomainInfo{Name: domainName},
+		Configuration:  &types.DomainConfiguration{},
+		IsGlobalDomain: true,
+		ReplicationConfiguration: &types.DomainReplicationConfiguration{
+			ActiveClusterName: "active",
+			Clusters: []*types.ClusterReplicationConfiguration{
+				{ClusterName: "active"},
+				{ClusterName: "standby"},
+			},
+		},
+	}
+	execution := &types.WorkflowExecution{WorkflowID: "wid", RunID: "rid"}
+	listResp := &types.ListOpenWorkflowExecutionsResponse{
+		Executions: []*types.WorkflowExecutionInfo{{Execution: execution}},
+	}
+	describeWorkflowResp := &types.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &types.WorkflowExecutionInfo{Execution: execution, HistoryLength: 10},
+	}
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).Return(describeDomainResp, nil).Times(2)
+	s.serverFrontendClient.EXPECT().GetSearchAttributes(gomock.Any()).Return(&types.GetSearchAttributesResponse{}, nil).Times(2)
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Return(&types.ReadDLQMessagesResponse{}, nil).Times(3)
+	s.serverFrontendClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any()).Return(listResp, nil)
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(describeWorkflowResp, nil).Times(2)
+	s.serverFrontendClient.EXPECT().QueryWorkflow(gomock.Any(), &types.QueryWorkflowRequest{
+		Domain:                domainName,
+		Execution:             execution,
+		Query:                 &types.WorkflowQuery{QueryType: queryTypeStackTrace},
+		QueryConsistencyLevel: types.QueryConsistencyLevelEventual.Ptr(),
+	}).Return(&types.QueryWorkflowResponse{}, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "admin", "d", "fos", "--tc", "standby", "--target_address", "standby:7933", "--number_of_shards", "2"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDLQRead() {
+	readResp := &types.ReadDLQMessagesResponse{
+		ReplicationTasks: []*types.ReplicationTask{
+			{
+				TaskType:                 types.ReplicationTaskTypeFailoverMarker.Ptr(),
+				SourceTaskID:             1,
+				FailoverMarkerAttributes: &types.FailoverMarkerAttributes{DomainID: "domain-id", FailoverVersion: 10},
+			},
+			{
+				TaskType:                   types.ReplicationTaskTypeSyncActivity.Ptr(),
+				SourceTaskID:               2,
+				SyncActivityTaskAttributes: &types.SyncActivityTaskAttributes{DomainID: "domain-id", WorkflowID: "wid", RunID: "rid"},
+			},
+		},
+	}
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).Return(readResp, nil).Times(2)
+	// domain names are resolved once per domain and only for the summary table
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), &types.DescribeDomainRequest{UUID: common.StringPtr("domain-id")}).
+		Return(describeDomainResponseServer, nil).Times(1)
+
+	err := s.app.Run([]string{"", "admin", "dlq", "read", "--dt", "history", "--source_cluster", "active", "--sid", "1"})
+	s.Nil(err)
+	err = s.app.Run([]string{"", "admin", "dlq", "read", "--dt", "history", "--source_cluster", "active", "--sid", "1", "--raw"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDLQRead_ShardRange() {
+	var shards []int32
+	s.serverAdminClient.EXPECT().ReadDLQMessages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.ReadDLQMessagesRequest, opts ...yarpc.CallOption) (*types.ReadDLQMessagesResponse, error) {
+			shards = append(shards, request.GetShardID())
+			return &types.ReadDLQMessagesResponse{}, nil
+		}).Times(3)
+
+	err := s.app.Run([]string{"", "admin", "dlq", "read", "--dt", "history", "--source_cluster", "active", "--shard_range", "3-5"})
+	s.Nil(err)
+	s.Equal([]int32{3, 4, 5}, shards)
+}
+
+func (s *cliAppSuite) TestAdminDLQPurge_ShardRange() {
+	s.serverAdminClient.EXPECT().PurgeDLQMessages(gomock.Any(), &types.PurgeDLQMessagesRequest{
+		Type:          types.DLQTypeReplication.Ptr(),
+		SourceCluster: "active",
+		ShardID:       3,
+		EndShardID:    5,
+	}).Return(nil).Times(1)
+
+	err := s.app.Run([]string{"", "admin", "dlq", "purge", "--dt", "history", "--source_cluster", "active", "--shard_range", "3-5", "--yes"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDLQMerge_ShardRange() {
+	s.serverAdminClient.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.MergeDLQMessagesRequest, opts ...yarpc.CallOption) (*types.MergeDLQMessagesResponse, error) {
+			s.Equal(int32(3), request.GetShardID())
+			s.Equal(int32(5), request.GetEndShardID())
+			if request.NextPageToken == nil {
+				return &types.MergeDLQMessagesResponse{NextPageToken: []byte("next")}, nil
+			}
+			return &types.MergeDLQMessagesResponse{}, nil
+		}).Times(2)
+
+	err := s.app.Run([]string{"", "admin", "dlq", "merge", "--dt", "history", "--source_cluster", "active", "--shard_range", "3-5"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminDLQMerge_ShardRange_Failed() {
+	s.serverAdminClient.EXPECT().MergeDLQMessages(gomock.Any(), gomock.Any()).
+		Return(nil, &types.InternalServiceError{Message: "faked error"}).Times(1)
+
+	dir, err := ioutil.TempDir("", "cadence-cli-results")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	resultsFile := filepath.Join(dir, "results.json")
+	errorCode := s.RunErrorExitCode([]string{"", "admin", "dlq", "merge", "--dt", "history", "--source_cluster", "active",
+		"--shard_range", "3-5", "--results_file", resultsFile})
+	s.Equal(1, errorCode)
+
+	data, err := ioutil.ReadFile(resultsFile)
+	s.NoError(err)
+	var results batchResults
+	s.NoError(json.Unmarshal(data, &results))
+	s.Equal(0, results.Succeeded)
+	s.Equal(1, results.Failed)
+	s.Equal([]string{"shards 3-5"}, results.items(batchResultFailed))
+}
+
+func (s *cliAppSuite) TestAdminDescribeMatchingHost() {
+	health, err := json.Marshal(&types.MatchingHostHealth{
+		Ready:                true,
+		LoadedTaskLists:      3,
+		LeaseFailures:        1,
+		PersistenceRequests:  20,
+		PersistenceErrors:    1,
+		PersistenceErrorRate: 0.05,
+		NotReadyTaskLists: []*types.MatchingTaskListHealth{
+			{DomainID: "domain-id", Name: "tl", TaskListType: types.TaskListTypeActivity, LastError: "timeout"},
+		},
+	})
+	s.NoError(err)
+	SetFactory(&clientFactoryMock{
+		serverFrontendClient: s.serverFrontendClient,
+		serverAdminClient:    s.serverAdminClient,
+		healthClient:         &healthClientStub{status: &types.HealthStatus{Ok: true, Msg: string(health)}},
+	})
+
+	err = s.app.Run([]string{"", "admin", "matching", "describe-host", "--matching_address", "127.0.0.1:7935"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestAdminFailover() {
 	resp := &types.StartWorkflowExecutionResponse{RunID: uuid.New()}
 	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).Return(resp, nil)
@@ -623,6 +1591,56 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestGetResource() {
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.DescribeDomainRequest, opts ...yarpc.CallOption) (*types.DescribeDomainResponse, error) {
+			s.Equal("other-domain", request.GetName())
+			return describeDomainResponseServer, nil
+		})
+	s.Nil(s.app.Run([]string{"", "--do", domainName, "get", "domain/other-domain", "--format", "json"}))
+
+	runID := uuid.New()
+	s.serverFrontendClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*types.DescribeWorkflowExecutionResponse, error) {
+			s.Equal(domainName, request.GetDomain())
+			s.Equal("wid/with/slashes", request.Execution.GetWorkflowID())
+			s.Equal(runID, request.Execution.GetRunID())
+			return &types.DescribeWorkflowExecutionResponse{WorkflowExecutionInfo: &types.WorkflowExecutionInfo{}}, nil
+		})
+	s.Nil(s.app.Run([]string{"", "get", "workflow/" + domainName + "/wid/with/slashes/" + runID}))
+
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.DescribeTaskListRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
+			s.Equal("test-taskList", request.TaskList.GetName())
+			s.Equal(types.TaskListTypeActivity, request.GetTaskListType())
+			return describeTaskListResponse, nil
+		})
+	s.Nil(s.app.Run([]string{"", "get", "tl/" + domainName + "/test-taskList", "-tlt", "activity"}))
+
+	s.Equal(1, s.RunErrorExitCode([]string{"", "get", "workflow/" + domainName}))
+	s.Equal(1, s.RunErrorExitCode([]string{"", "get", "pod/" + domainName}))
+}
+
+func (s *cliAppSuite) TestListTaskListPartitions_Status() {
+	partitionsResp := &types.ListTaskListPartitionsResponse{
+		DecisionTaskListPartitions: []*types.TaskListPartitionMetadata{
+			{Key: "test-taskList", OwnerHostName: "host1"},
+			{Key: "/__cadence_sys/test-taskList/1", OwnerHostName: "host2"},
+		},
+	}
+	s.serverFrontendClient.EXPECT().ListTaskListPartitions(gomock.Any(), gomock.Any()).Return(partitionsResp, nil)
+	s.serverFrontendClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, request *types.DescribeTaskListRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
+			s.True(request.IncludeTaskListStatus)
+			s.Equal(types.TaskListTypeDecision, request.GetTaskListType())
+			return &types.DescribeTaskListResponse{
+				TaskListStatus: &types.TaskListStatus{BacklogCountHint: 10, OutstandingPollCount: 2},
+			}, nil
+		}).Times(2)
+	err := s.app.Run([]string{"", "--do", domainName, "tasklist", "list-partition", "-tl", "test-taskList", "--status"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
 	history := getWorkflowExecutionHistoryResponse
 	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(2)
@@ -632,6 +1650,55 @@ func (s *cliAppSuite) TestObserveWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestObserveWorkflow_EventTypeFilter() {
+	history := getWorkflowExecutionHistoryResponse
+	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.GetWorkflowExecutionHistoryRequest, opts ...yarpc.CallOption) (*types.GetWorkflowExecutionHistoryResponse, error) {
+			s.Contains(request.EventTypes, types.EventTypeActivityTaskCompleted)
+			s.Contains(request.EventTypes, types.EventTypeWorkflowExecutionCompleted)
+			return history, nil
+		})
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "observe", "-w", "wid", "--event_type", "ActivityTaskCompleted"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestScript_RecordAndRun() {
+	oldScriptInput := scriptInput
+	defer func() { scriptInput = oldScriptInput }()
+	dir, err := ioutil.TempDir("", "cli-script")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "runbook.yaml")
+
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.DescribeDomainRequest, opts ...yarpc.CallOption) (*types.DescribeDomainResponse, error) {
+			s.Equal(domainName, request.GetName())
+			return describeDomainResponseServer, nil
+		})
+	scriptInput = strings.NewReader("# check the domain\n--do " + domainName + " domain describe\nunknown-command\nexit\n")
+	err = s.app.Run([]string{"", "script", "record", "--var", "domain=" + domainName, path})
+	s.Nil(err)
+
+	script, err := readScript(path)
+	s.NoError(err)
+	s.Equal(map[string]string{"domain": domainName}, script.Vars)
+	s.Equal([]cliScriptStep{{Description: "check the domain", Command: "--do ${domain} domain describe"}}, script.Steps)
+
+	s.serverFrontendClient.EXPECT().DescribeDomain(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, request *types.DescribeDomainRequest, opts ...yarpc.CallOption) (*types.DescribeDomainResponse, error) {
+			s.Equal("other-domain", request.GetName())
+			return describeDomainResponseServer, nil
+		})
+	scriptInput = strings.NewReader("y\n")
+	err = s.app.Run([]string{"", "script", "run", "--var", "domain=other-domain", path})
+	s.Nil(err)
+
+	// aborting at the confirmation prompt runs nothing
+	scriptInput = strings.NewReader("a\n")
+	err = s.app.Run([]string{"", "script", "run", path})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflowWithID() {
 	history := getWorkflowExecutionHistoryResponse
 	s.serverFrontendClient.EXPECT().GetWorkflowExecutionHistory(gomock.Any(), gomock.Any()).Return(history, nil).Times(2)
@@ -826,6 +1893,21 @@ func (s *cliAppSuite) TestGetSearchAttributes() {
 	s.Nil(err)
 }
 
+// TestParseShardRange tests the parsing of inclusive shard range in <start>-<end> format
+func (s *cliAppSuite) TestParseShardRange() {
+	start, end := parseShardRange("0-127")
+	s.Equal(0, start)
+	s.Equal(127, end)
+
+	start, end = parseShardRange("5")
+	s.Equal(5, start)
+	s.Equal(5, end)
+
+	start, end = parseShardRange(" 3 - 4 ")
+	s.Equal(3, start)
+	s.Equal(4, end)
+}
+
 func (s *cliAppSuite) TestParseBool() {
 	res, err := parseBool("true")
 	s.NoError(err)
@@ -936,3 +2018,11 @@ func (s *cliAppSuite) TestConvertArray() {
 		s.Nil(res)
 	}
 }
+
+func (s *cliAppSuite) TestIsRetryableCLIError() {
+	s.True(isRetryableCLIError(&types.ServiceBusyError{}))
+	s.True(isRetryableCLIError(yarpcerrors.UnavailableErrorf("unavailable")))
+	s.False(isRetryableCLIError(yarpcerrors.DeadlineExceededErrorf("timeout")))
+	s.False(isRetryableCLIError(&types.BadRequestError{}))
+	s.False(isRetryableCLIError(&types.InternalServiceError{}))
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+)
+
+const (
+	auditOutcomeSuccess = "success"
+	auditOutcomeFailure = "failure"
+
+	auditRedactedValue = "<redacted>"
+	auditHTTPTimeout   = 5 * time.Second
+)
+
+// auditedCommands are the mutating commands recorded in the audit log, keyed by their full command path
+var auditedCommands = map[string]struct{}{
+	"domain update":              {},
+	"domain deprecate":           {},
+	"workflow cancel":            {},
+	"workflow signal":            {},
+	"workflow signalwithstart":   {},
+	"workflow terminate":         {},
+	"workflow reset":             {},
+	"workflow reset-batch":       {},
+	"workflow batch start":       {},
+	"workflow batch terminate":   {},
+	"admin workflow delete":      {},
+	"admin domain update":        {},
+	"admin dlq purge":            {},
+	"admin dlq merge":            {},
+	"admin queue reset":          {},
+	"admin tasklist steal-lease": {},
+	"admin job cancel":           {},
+}
+
+// auditRedactedFlags carry workflow payloads or credentials, only the fact that they are set is recorded
+var auditRedactedFlags = map[string]struct{}{
+	FlagInput:               {},
+	FlagSignalInput:         {},
+	FlagMemo:                {},
+	FlagSearchAttributesVal: {},
+	FlagResult:              {},
+	FlagJWT:                 {},
+}
+
+type auditRecord struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Operator  string            `json:"operator"`
+	Command   string            `json:"command"`
+	Domain    string            `json:"domain,omitempty"`
+	Args      []string          `json:"args,omitempty"`
+	Flags     map[string]string `json:"flags,omitempty"`
+	Outcome   string            `json:"outcome"`
+	ExitCode  int               `json:"exitCode"`
+}
+
+// enableAudit wraps the actions of the audited commands so that every invocation is recorded
+// with its outcome to the local audit file and, if configured, to the audit endpoint
+func enableAudit(commands []cli.Command, parentPath string) {
+	for i := range commands {
+		command := &commands[i]
+		path := strings.TrimSpace(parentPath + " " + command.Name)
+		if len(command.Subcommands) > 0 {
+			enableAudit(command.Subcommands, path)
+			continue
+		}
+		if _, ok := auditedCommands[path]; !ok {
+			continue
+		}
+		if action, ok := command.Action.(func(*cli.Context)); ok {
+			command.Action = auditAction(path, action)
+		}
+	}
+}
+
+func auditAction(path string, action func(*cli.Context)) func(*cli.Context) {
+	return func(c *cli.Context) {
+		record := newAuditRecord(c, path)
+
+		// commands report failures through osExit, which does not return in production,
+		// so the record has to be written before the process exits
+		oldOsExit := osExit
+		defer func() { osExit = oldOsExit }()
+		exited := false
+		osExit = func(code int) {
+			if !exited {
+				exited = true
+				record.Outcome = auditOutcomeFailure
+				record.ExitCode = code
+				writeAuditRecord(c, record)
+			}
+			oldOsExit(code)
+		}
+
+		action(c)
+
+		if !exited {
+			record.Outcome = auditOutcomeSuccess
+			writeAuditRecord(c, record)
+		}
+	}
+}
+
+func newAuditRecord(c *cli.Context, path string) *auditRecord {
+	record := &auditRecord{
+		Timestamp: time.Now().UTC(),
+		Operator:  getCurrentUserFromEnv(),
+		Command:   path,
+		Domain:    c.GlobalString(FlagDomain),
+		Args:      c.Args(),
+		Flags:     make(map[string]string),
+	}
+	for _, flag := range c.Command.Flags {
+		name := getFlagName(flag.GetName())
+		if !c.IsSet(name) {
+			continue
+		}
+		if _, ok := auditRedactedFlags[name]; ok {
+			record.Flags[name] = auditRedactedValue
+			continue
+		}
+		record.Flags[name] = fmt.Sprint(c.Generic(name))
+	}
+	return record
+}
+
+// writeAuditRecord never fails the command, problems with the audit sinks are only reported on stderr
+func writeAuditRecord(c *cli.Context, record *auditRecord) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to encode audit record: %v\n", err)
+		return
+	}
+
+	if path := c.GlobalString(FlagAuditLog); path != "" {
+		if err := appendAuditFile(path, data); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write audit log %s: %v\n", path, err)
+		}
+	}
+
+	if url := c.GlobalString(FlagAuditURL); url != "" {
+		if err := postAuditRecord(url, data); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to send audit record to %s: %v\n", url, err)
+		}
+	}
+}
+
+func appendAuditFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+func postAuditRecord(url string, data []byte) error {
+	client := &http.Client{Timeout: auditHTTPTimeout}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status %v", resp.Status)
+	}
+	return nil
+}
+
+// getDefaultAuditLogPath returns the audit file under the home directory of the operator
+func getDefaultAuditLogPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".cadence", "cli_audit.log")
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/urfave/cli"
+)
+
+const (
+	batchResultSucceeded = "SUCCEEDED"
+	batchResultFailed    = "FAILED"
+)
+
+type (
+	// BatchResultRow is the result of one item of a command operating on many entities
+	BatchResultRow struct {
+		Item   string `header:"Item" json:"item"`
+		Status string `header:"Status" json:"status"`
+		Error  string `header:"Error" json:"error,omitempty"`
+	}
+
+	// batchResults collects the per-item results of a command operating on many entities,
+	// it is also the content of the results file
+	batchResults struct {
+		Operation string           `json:"operation"`
+		Succeeded int              `json:"succeeded"`
+		Failed    int              `json:"failed"`
+		Results   []BatchResultRow `json:"results,omitempty"`
+
+		progress *itemProgress
+	}
+)
+
+func newBatchResults(operation string) *batchResults {
+	return &batchResults{Operation: operation}
+}
+
+// trackProgress shows the progress of the items on stderr until the results are finished, the total is 0
+// if it is not known up front
+func (r *batchResults) trackProgress(c *cli.Context, total int) {
+	r.progress = newItemProgress(c, r.Operation, total)
+}
+
+// start shows the item being processed on the progress line, the item is done once it succeeds or fails
+func (r *batchResults) start(item string) {
+	r.progress.start(item)
+}
+
+func (r *batchResults) succeed(item string) {
+	r.progress.finishItem()
+	r.Succeeded++
+	r.Results = append(r.Results, BatchResultRow{Item: item, Status: batchResultSucceeded})
+}
+
+func (r *batchResults) fail(item string, err error) {
+	r.progress.finishItem()
+	r.Failed++
+	r.Results = append(r.Results, BatchResultRow{Item: item, Status: batchResultFailed, Error: err.Error()})
+}
+
+// items returns the items with the given status in the order they were recorded
+func (r *batchResults) items(status string) []string {
+	var items []string
+	for _, result := range r.Results {
+		if result.Status == status {
+			items = append(items, result.Item)
+		}
+	}
+	return items
+}
+
+// finish renders the summary, writes the results file if one is given and exits
+// with a non-zero code if any item failed, so wrappers can detect partial failures
+func (r *batchResults) finish(c *cli.Context) {
+	r.progress.clear()
+	if len(r.Results) > 0 {
+		RenderTable(getOutput(), r.Results, TableOptions{Color: true, Border: true})
+	}
+	fmt.Printf("%s: %d succeeded, %d failed.\n", r.Operation, r.Succeeded, r.Failed)
+
+	if fileName := c.String(FlagResultsFile); fileName != "" {
+		data, err := json.MarshalIndent(r, "", "  ")
+		if err != nil {
+			ErrorAndExit("Failed to encode results.", err)
+		}
+		if err := ioutil.WriteFile(fileName, data, 0666); err != nil {
+			ErrorAndExit("Failed to write results file "+fileName, err)
+		}
+	}
+
+	if r.Failed > 0 {
+		ErrorAndExit(fmt.Sprintf("%s failed for %d of %d items.", r.Operation, r.Failed, r.Succeeded+r.Failed), nil)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestBatchResults(t *testing.T) {
+	results := newBatchResults("test")
+	results.succeed("a")
+	results.fail("b", errors.New("failed"))
+	results.succeed("c")
+
+	assert.Equal(t, 2, results.Succeeded)
+	assert.Equal(t, 1, results.Failed)
+	assert.Equal(t, []string{"a", "c"}, results.items(batchResultSucceeded))
+	assert.Equal(t, []string{"b"}, results.items(batchResultFailed))
+	assert.Equal(t, "failed", results.Results[1].Error)
+}
+
+func TestBatchResults_Progress(t *testing.T) {
+	out := &bytes.Buffer{}
+	results := newBatchResults("test")
+	results.progress = &itemProgress{writer: out, label: results.Operation, total: 2, started: time.Now()}
+
+	results.start("a")
+	assert.Contains(t, out.String(), "0/2 test: a")
+	results.succeed("a")
+	results.start("b")
+	assert.Contains(t, out.String(), "1/2 test: b")
+	results.fail("b", errors.New("failed"))
+	assert.Equal(t, 2, results.progress.done)
+	assert.Zero(t, results.progress.lineLen)
+}
+
+func TestBatchResults_FinishExitCode(t *testing.T) {
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	exitCode := 0
+	osExit = func(code int) {
+		exitCode = code
+	}
+	c := cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)
+
+	results := newBatchResults("test")
+	results.succeed("a")
+	results.finish(c)
+	assert.Equal(t, 0, exitCode)
+
+	results.fail("b", errors.New("failed"))
+	results.finish(c)
+	assert.Equal(t, 1, exitCode)
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+)
+
+const completionPageSize = 100
+
+const bashCompletionScript = `# bash completion for cadence, load it with: source <(cadence completion bash)
+_cadence_bash_autocomplete() {
+  local cur opts
+  COMPREPLY=()
+  cur="${COMP_WORDS[COMP_CWORD]}"
+  if [[ "$cur" == "-"* ]]; then
+    opts=$( ${COMP_WORDS[@]:0:$COMP_CWORD} ${cur} --generate-bash-completion 2>/dev/null )
+  else
+    opts=$( ${COMP_WORDS[@]:0:$COMP_CWORD} --generate-bash-completion 2>/dev/null )
+  fi
+  COMPREPLY=( $(compgen -W "${opts}" -- ${cur}) )
+  return 0
+}
+
+complete -o bashdefault -o default -F _cadence_bash_autocomplete cadence
+`
+
+const zshCompletionScript = `#compdef cadence
+# zsh completion for cadence, load it with: source <(cadence completion zsh)
+_cadence_zsh_autocomplete() {
+  local -a opts
+  local cur
+  cur=${words[-1]}
+  if [[ "$cur" == "-"* ]]; then
+    opts=("${(@f)$(_CLI_ZSH_AUTOCOMPLETE_HACK=1 ${words[@]:0:#words[@]-1} ${cur} --generate-bash-completion 2>/dev/null)}")
+  else
+    opts=("${(@f)$(_CLI_ZSH_AUTOCOMPLETE_HACK=1 ${words[@]:0:#words[@]-1} --generate-bash-completion 2>/dev/null)}")
+  fi
+
+  if [[ "${opts[1]}" != "" ]]; then
+    _describe 'values' opts
+  else
+    _files
+  fi
+}
+
+compdef _cadence_zsh_autocomplete cadence
+`
+
+const fishCompletionScript = `# fish completion for cadence, load it with: cadence completion fish | source
+function __fish_cadence_complete
+    set -l args (commandline -opc)
+    set -l cur (commandline -ct)
+    if string match -q -- '-*' $cur
+        $args $cur --generate-bash-completion 2>/dev/null
+    else
+        $args --generate-bash-completion 2>/dev/null
+    end
+end
+
+complete -c cadence -f -a '(__fish_cadence_complete)'
+`
+
+var completionScripts = map[string]string{
+	"bash": bashCompletionScript,
+	"zsh":  zshCompletionScript,
+	"fish": fishCompletionScript,
+}
+
+func newCompletionCommand() cli.Command {
+	return cli.Command{
+		Name:      "completion",
+		Usage:     "Generate the shell completion script, e.g. source <(cadence completion bash)",
+		ArgsUsage: "bash|zsh|fish",
+		BashComplete: func(c *cli.Context) {
+			for _, shell := range []string{"bash", "zsh", "fish"} {
+				fmt.Fprintln(getOutput(), shell)
+			}
+		},
+		Action: func(c *cli.Context) {
+			GenerateCompletion(c)
+		},
+	}
+}
+
+// GenerateCompletion prints the completion script of the given shell
+func GenerateCompletion(c *cli.Context) {
+	shell := c.Args().First()
+	script, ok := completionScripts[shell]
+	if !ok {
+		ErrorAndExit(fmt.Sprintf("Unsupported shell %q, expected one of bash, zsh or fish.", shell), nil)
+	}
+	fmt.Fprint(getOutput(), script)
+}
+
+// enableCompletion sets the completion of every command, on top of the default completion
+// of commands and flags the values of the domain and tasklist flags are completed from the server
+func enableCompletion(app *cli.App) {
+	app.EnableBashCompletion = true
+	app.BashComplete = completeWithFlagValues(cli.DefaultAppComplete)
+	setCommandCompletion(app.Commands)
+}
+
+func setCommandCompletion(commands []cli.Command) {
+	for i := range commands {
+		command := &commands[i]
+		if len(command.Subcommands) > 0 {
+			// the subcommands are run by a nested app, which lists them with the app completion
+			command.BashComplete = completeWithFlagValues(cli.DefaultAppComplete)
+			setCommandCompletion(command.Subcommands)
+			continue
+		}
+		if command.BashComplete != nil {
+			continue
+		}
+		command.BashComplete = completeWithFlagValues(cli.DefaultCompleteWithFlags(command))
+	}
+}
+
+func completeWithFlagValues(complete cli.BashCompleteFunc) cli.BashCompleteFunc {
+	return func(c *cli.Context) {
+		// the shell scripts append the completion flag, so the flag being completed is the one before it
+		if len(os.Args) > 2 {
+			switch completedFlag(os.Args[len(os.Args)-2]) {
+			case FlagDomain:
+				printDomainCompletions(c, getOutput())
+				return
+			case FlagTaskList:
+				printTaskListCompletions(c, getOutput())
+				return
+			}
+		}
+		complete(c)
+	}
+}
+
+// completedFlag returns the flag whose value is completed after the given argument, if its values can be completed
+func completedFlag(arg string) string {
+	if !strings.HasPrefix(arg, "-") || strings.Contains(arg, "=") {
+		return ""
+	}
+	for _, names := range []string{FlagDomainWithAlias, FlagTaskListWithAlias} {
+		for _, name := range strings.Split(names, ",") {
+			name = strings.TrimSpace(name)
+			if arg == "-"+name || arg == "--"+name {
+				return getFlagName(names)
+			}
+		}
+	}
+	return ""
+}
+
+// printDomainCompletions prints the names of the domains, errors are not reported as they would end up in the completions
+func printDomainCompletions(c *cli.Context, w io.Writer) {
+	_ = loadEnvironment(c)
+	frontendClient := cFactory.ServerFrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	request := &types.ListDomainsRequest{PageSize: completionPageSize}
+	for {
+		response, err := frontendClient.ListDomains(ctx, request)
+		if err != nil {
+			return
+		}
+		for _, domain := range response.GetDomains() {
+			fmt.Fprintln(w, domain.GetDomainInfo().GetName())
+		}
+		if len(response.GetNextPageToken()) == 0 {
+			return
+		}
+		request.NextPageToken = response.GetNextPageToken()
+	}
+}
+
+// printTaskListCompletions prints the names of the decision and activity task lists of the domain
+func printTaskListCompletions(c *cli.Context, w io.Writer) {
+	_ = loadEnvironment(c)
+	domain := c.GlobalString(FlagDomain)
+	if domain == "" {
+		return
+	}
+	frontendClient := cFactory.ServerFrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	response, err := frontendClient.GetTaskListsByDomain(ctx, &types.GetTaskListsByDomainRequest{Domain: domain})
+	if err != nil {
+		return
+	}
+	names := make(map[string]struct{})
+	for name := range response.GetDecisionTaskListMap() {
+		names[name] = struct{}{}
+	}
+	for name := range response.GetActivityTaskListMap() {
+		names[name] = struct{}{}
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		fmt.Fprintln(w, name)
+	}
+}
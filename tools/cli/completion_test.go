@@ -0,0 +1,115 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestCompletedFlag(t *testing.T) {
+	tests := map[string]string{
+		"--domain":   FlagDomain,
+		"-do":        FlagDomain,
+		"--do":       FlagDomain,
+		"--tasklist": FlagTaskList,
+		"--tl":       FlagTaskList,
+		"--do=test":  "",
+		"--wid":      "",
+		"domain":     "",
+	}
+	for arg, expected := range tests {
+		assert.Equal(t, expected, completedFlag(arg), arg)
+	}
+}
+
+func TestGenerateCompletion(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		assert.Contains(t, completionScripts[shell], "--generate-bash-completion", shell)
+	}
+	app := NewCliApp()
+	assert.True(t, app.EnableBashCompletion)
+	assert.NotNil(t, app.Command("completion"))
+}
+
+func TestPrintDomainCompletions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serverFrontendClient := frontend.NewMockClient(mockCtrl)
+	SetFactory(&clientFactoryMock{serverFrontendClient: serverFrontendClient})
+
+	gomock.InOrder(
+		serverFrontendClient.EXPECT().ListDomains(gomock.Any(), &types.ListDomainsRequest{PageSize: completionPageSize}).
+			Return(&types.ListDomainsResponse{
+				Domains:       []*types.DescribeDomainResponse{{DomainInfo: &types.DomainInfo{Name: "domain-1"}}},
+				NextPageToken: []byte("token"),
+			}, nil),
+		serverFrontendClient.EXPECT().ListDomains(gomock.Any(), &types.ListDomainsRequest{PageSize: completionPageSize, NextPageToken: []byte("token")}).
+			Return(&types.ListDomainsResponse{
+				Domains: []*types.DescribeDomainResponse{{DomainInfo: &types.DomainInfo{Name: "domain-2"}}},
+			}, nil),
+	)
+
+	var out bytes.Buffer
+	printDomainCompletions(newCompletionTestContext(t, ""), &out)
+	assert.Equal(t, "domain-1\ndomain-2\n", out.String())
+}
+
+func TestPrintTaskListCompletions(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serverFrontendClient := frontend.NewMockClient(mockCtrl)
+	SetFactory(&clientFactoryMock{serverFrontendClient: serverFrontendClient})
+
+	serverFrontendClient.EXPECT().GetTaskListsByDomain(gomock.Any(), &types.GetTaskListsByDomainRequest{Domain: domainName}).
+		Return(&types.GetTaskListsByDomainResponse{
+			DecisionTaskListMap: map[string]*types.DescribeTaskListResponse{"tl-b": {}, "tl-a": {}},
+			ActivityTaskListMap: map[string]*types.DescribeTaskListResponse{"tl-a": {}, "tl-c": {}},
+		}, nil)
+
+	var out bytes.Buffer
+	printTaskListCompletions(newCompletionTestContext(t, domainName), &out)
+	assert.Equal(t, "tl-a\ntl-b\ntl-c\n", out.String())
+
+	// nothing can be completed without a domain
+	out.Reset()
+	printTaskListCompletions(newCompletionTestContext(t, ""), &out)
+	assert.Empty(t, out.String())
+}
+
+func newCompletionTestContext(t *testing.T, domain string) *cli.Context {
+	globalSet := flag.NewFlagSet("global", 0)
+	globalSet.String(FlagDomain, "", "")
+	globalSet.String(FlagEnv, "", "")
+	if domain != "" {
+		assert.NoError(t, globalSet.Set(FlagDomain, domain))
+	}
+	globalContext := cli.NewContext(nil, globalSet, nil)
+	return cli.NewContext(nil, flag.NewFlagSet("command", 0), globalContext)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package config loads the named environments of the CLI from ~/.cadence/config.yaml, so that the
+// frontend address, domain, TLS settings and security token of an environment can be selected
+// with --env instead of being passed to every command.
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	// Config is the content of the CLI configuration file
+	Config struct {
+		Environments map[string]*Environment `yaml:"environments"`
+	}
+
+	// Environment is a named set of defaults for the global options of the CLI,
+	// options passed on the command line or with environment variables take precedence
+	Environment struct {
+		Address       string     `yaml:"address"`
+		Transport     string     `yaml:"transport"`
+		Domain        string     `yaml:"domain"`
+		SecurityToken string     `yaml:"securityToken"`
+		TLS           config.TLS `yaml:"tls"`
+	}
+)
+
+// DefaultPath returns the path of the configuration file in the home directory of the user
+func DefaultPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".cadence", "config.yaml")
+}
+
+// Load reads the configuration file at path, a missing file is an empty configuration
+func Load(path string) (*Config, error) {
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg Config
+	if err := yaml.UnmarshalStrict(content, &cfg); err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %v", path, err)
+	}
+	return &cfg, nil
+}
+
+// Environment returns the environment with the given name
+func (c *Config) Environment(name string) (*Environment, error) {
+	env, ok := c.Environments[name]
+	if !ok || env == nil {
+		if len(c.Environments) == 0 {
+			return nil, fmt.Errorf("environment %s is not defined, no environments are configured", name)
+		}
+		return nil, fmt.Errorf("environment %s is not defined, configured environments are %v", name, c.Names())
+	}
+	return env, nil
+}
+
+// Names returns the names of the configured environments in sorted order
+func (c *Config) Names() []string {
+	names := make([]string, 0, len(c.Environments))
+	for name := range c.Environments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
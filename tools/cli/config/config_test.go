@@ -0,0 +1,96 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "cadence-cli-config")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	path := filepath.Join(dir, "config.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeConfig(t, `
+environments:
+  staging:
+    address: staging-frontend:7833
+    transport: grpc
+    domain: orders
+    securityToken: secret
+    tls:
+      enabled: true
+      caFile: /etc/cadence/ca.pem
+      serverName: cadence.staging
+  local:
+    domain: samples
+`)
+	cfg, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"local", "staging"}, cfg.Names())
+
+	env, err := cfg.Environment("staging")
+	require.NoError(t, err)
+	assert.Equal(t, "staging-frontend:7833", env.Address)
+	assert.Equal(t, "grpc", env.Transport)
+	assert.Equal(t, "orders", env.Domain)
+	assert.Equal(t, "secret", env.SecurityToken)
+	assert.True(t, env.TLS.Enabled)
+	assert.Equal(t, "/etc/cadence/ca.pem", env.TLS.CaFile)
+	assert.Equal(t, "cadence.staging", env.TLS.ServerName)
+
+	env, err = cfg.Environment("local")
+	require.NoError(t, err)
+	assert.Equal(t, "samples", env.Domain)
+	assert.False(t, env.TLS.Enabled)
+
+	_, err = cfg.Environment("prod")
+	assert.EqualError(t, err, "environment prod is not defined, configured environments are [local staging]")
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	cfg, err := Load(filepath.Join(os.TempDir(), "cadence-cli-missing", "config.yaml"))
+	require.NoError(t, err)
+	assert.Empty(t, cfg.Names())
+	_, err = cfg.Environment("staging")
+	assert.EqualError(t, err, "environment staging is not defined, no environments are configured")
+}
+
+func TestLoad_UnknownField(t *testing.T) {
+	path := writeConfig(t, `
+environments:
+  staging:
+    adress: staging-frontend:7833
+`)
+	_, err := Load(path)
+	assert.Error(t, err)
+}
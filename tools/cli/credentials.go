@@ -0,0 +1,68 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newCredentialsCommands() []cli.Command {
+	kindUsage := "Kind of the credential, one of " + joinCredentialKinds()
+	return []cli.Command{
+		{
+			Name:  "set",
+			Usage: "Store a credential for the profile selected with --profile, the value is read from --value_file or stdin",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagCredentialKind,
+					Usage: kindUsage,
+				},
+				cli.StringFlag{
+					Name:  FlagCredentialValueFile,
+					Usage: "Optional file to read the credential from, e.g. a PEM encoded key. Prompted for without echo if not set",
+				},
+			},
+			Action: func(c *cli.Context) {
+				SetProfileCredential(c)
+			},
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete a credential of the profile selected with --profile",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagCredentialKind,
+					Usage: kindUsage,
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteProfileCredential(c)
+			},
+		},
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List the kinds of credentials stored for the profile selected with --profile, without their values",
+			Action: func(c *cli.Context) {
+				ListProfileCredentials(c)
+			},
+		},
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type profileCredentialRow struct {
+	Kind   string `header:"Kind"`
+	Stored bool   `header:"Stored"`
+}
+
+// credentialInput is where credential values are read from when --value_file is not set
+var credentialInput io.Reader = os.Stdin
+
+// SetProfileCredential stores a credential for the selected profile
+func SetProfileCredential(c *cli.Context) {
+	profile := getRequiredProfile(c)
+	kind := getRequiredOption(c, FlagCredentialKind)
+	if err := validateProfileCredential(profile, kind); err != nil {
+		ErrorAndExit("Invalid credential", err)
+	}
+
+	secret := readCredentialValue(c, kind)
+	if secret == "" {
+		ErrorAndExit("Credential value is empty", nil)
+	}
+	if err := newCredentialsStore(c).Set(profile, kind, secret); err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to store %s of profile %s", kind, profile), err)
+	}
+	fmt.Printf("Stored %s of profile %s\n", kind, profile)
+}
+
+// DeleteProfileCredential deletes a credential of the selected profile
+func DeleteProfileCredential(c *cli.Context) {
+	profile := getRequiredProfile(c)
+	kind := getRequiredOption(c, FlagCredentialKind)
+	if err := validateProfileCredential(profile, kind); err != nil {
+		ErrorAndExit("Invalid credential", err)
+	}
+
+	if err := newCredentialsStore(c).Delete(profile, kind); err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to delete %s of profile %s", kind, profile), err)
+	}
+	fmt.Printf("Deleted %s of profile %s\n", kind, profile)
+}
+
+// ListProfileCredentials lists the kinds of credentials stored for the selected profile
+func ListProfileCredentials(c *cli.Context) {
+	profile := getRequiredProfile(c)
+	store := newCredentialsStore(c)
+	var rows []profileCredentialRow
+	for _, kind := range credentialKinds {
+		secret, err := store.Get(profile, kind)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to read %s of profile %s", kind, profile), err)
+		}
+		rows = append(rows, profileCredentialRow{Kind: kind, Stored: secret != ""})
+	}
+	RenderTable(getOutput(), rows, TableOptions{Color: true})
+}
+
+func getRequiredProfile(c *cli.Context) string {
+	profile := c.GlobalString(FlagProfile)
+	if profile == "" {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagProfile), nil)
+	}
+	return profile
+}
+
+// readCredentialValue reads the credential from --value_file, or from stdin, without echo for a terminal
+func readCredentialValue(c *cli.Context, kind string) string {
+	if path := c.String(FlagCredentialValueFile); path != "" {
+		// This code is executed from the CLI. All user input is from a CLI user.
+		// #nosec
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to read %s", path), err)
+		}
+		return string(content)
+	}
+
+	if f, ok := credentialInput.(*os.File); ok && terminal.IsTerminal(int(f.Fd())) {
+		fmt.Fprintf(os.Stderr, "Enter %s: ", kind)
+		secret, err := terminal.ReadPassword(int(f.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			ErrorAndExit("Failed to read credential", err)
+		}
+		return strings.TrimSpace(string(secret))
+	}
+	secret, err := ioutil.ReadAll(bufio.NewReader(credentialInput))
+	if err != nil {
+		ErrorAndExit("Failed to read credential", err)
+	}
+	return strings.TrimSpace(string(secret))
+}
+
+func joinCredentialKinds() string {
+	return strings.Join(credentialKinds, ", ")
+}
@@ -0,0 +1,479 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/urfave/cli"
+	"golang.org/x/crypto/scrypt"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Credentials of a profile are kept out of flags and environment variables, which leak into the shell history
+// and process listings. They are stored either in the OS keychain or in a file encrypted with a passphrase,
+// and are looked up by the name of the profile selected with --profile.
+
+const (
+	credentialsStoreKeychain = "keychain"
+	credentialsStoreFile     = "file"
+
+	credentialJWT           = "jwt"
+	credentialJWTPrivateKey = "jwt_private_key"
+	credentialSecurityToken = "security_token"
+	credentialTLSKey        = "tls_key"
+
+	credentialsKeychainService = "cadence-cli"
+	credentialsPassphraseEnv   = "CADENCE_CLI_CREDENTIALS_PASSPHRASE"
+	credentialsFileVersion     = 1
+)
+
+var (
+	credentialKinds = []string{credentialJWT, credentialJWTPrivateKey, credentialSecurityToken, credentialTLSKey}
+
+	profileNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+	errCredentialsPassphraseMismatch = errors.New("passphrases do not match")
+	errCredentialsWrongPassphrase    = errors.New("wrong passphrase or corrupted credentials file")
+
+	// credentialsPassphrase reads the passphrase of the credentials file, confirm is set when the file is created
+	credentialsPassphrase = readCredentialsPassphrase
+	// keychainCommand runs a command of the OS keychain tool with the given stdin and returns its stdout
+	keychainCommand = runKeychainCommand
+)
+
+type (
+	// credentialsStore stores the credentials of profiles, Get returns an empty string for missing credentials
+	credentialsStore interface {
+		Get(profile string, kind string) (string, error)
+		Set(profile string, kind string, secret string) error
+		Delete(profile string, kind string) error
+	}
+
+	keychainCredentialsStore struct {
+		goos string
+	}
+
+	fileCredentialsStore struct {
+		path string
+		// decrypted content of the file by profile and kind, loaded on first use
+		credentials map[string]map[string]string
+		passphrase  string
+	}
+
+	encryptedCredentialsFile struct {
+		Version int    `json:"version"`
+		Salt    []byte `json:"salt"`
+		Nonce   []byte `json:"nonce"`
+		Data    []byte `json:"data"`
+	}
+)
+
+var (
+	profileCredentialsLock  sync.Mutex
+	profileCredentialsStore credentialsStore
+	// credentials already looked up, so that the passphrase is asked at most once per command
+	profileCredentials     = make(map[string]string)
+	profileCredentialFiles = make(map[string]string)
+)
+
+// getProfileCredential returns the credential of the given kind stored for the profile selected with --profile,
+// or an empty string when no profile is selected or the profile has no such credential
+func getProfileCredential(c *cli.Context, kind string) string {
+	profile := c.GlobalString(FlagProfile)
+	if profile == "" {
+		return ""
+	}
+
+	profileCredentialsLock.Lock()
+	defer profileCredentialsLock.Unlock()
+	key := profile + "/" + kind
+	if secret, ok := profileCredentials[key]; ok {
+		return secret
+	}
+	if profileCredentialsStore == nil {
+		profileCredentialsStore = newCredentialsStore(c)
+	}
+	secret, err := profileCredentialsStore.Get(profile, kind)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to read %s of profile %s", kind, profile), err)
+	}
+	profileCredentials[key] = secret
+	return secret
+}
+
+// getProfileCredentialFile writes the credential of the given kind stored for the selected profile to a
+// file readable only by the current user, for the options which take a file path. The file is removed when
+// the command exits. Returns an empty string when there is no such credential
+func getProfileCredentialFile(c *cli.Context, kind string) string {
+	secret := getProfileCredential(c, kind)
+	if secret == "" {
+		return ""
+	}
+
+	profileCredentialsLock.Lock()
+	defer profileCredentialsLock.Unlock()
+	if path, ok := profileCredentialFiles[kind]; ok {
+		return path
+	}
+	dir, err := ioutil.TempDir("", "cadence-cli-")
+	if err != nil {
+		ErrorAndExit("Failed to create directory for profile credentials", err)
+	}
+	path := filepath.Join(dir, kind)
+	if err := ioutil.WriteFile(path, []byte(secret), 0600); err != nil {
+		os.RemoveAll(dir)
+		ErrorAndExit("Failed to write profile credentials", err)
+	}
+	if len(profileCredentialFiles) == 0 {
+		// commands report failures through osExit, the files have to be removed before the process exits
+		oldOsExit := osExit
+		osExit = func(code int) {
+			removeProfileCredentialFiles()
+			oldOsExit(code)
+		}
+	}
+	profileCredentialFiles[kind] = path
+	return path
+}
+
+// removeProfileCredentialFiles removes the files written by getProfileCredentialFile
+func removeProfileCredentialFiles() {
+	profileCredentialsLock.Lock()
+	defer profileCredentialsLock.Unlock()
+	for kind, path := range profileCredentialFiles {
+		os.RemoveAll(filepath.Dir(path))
+		delete(profileCredentialFiles, kind)
+	}
+}
+
+// getSecurityToken returns the security token passed with --security_token, of the selected environment,
+// or stored for the selected profile
+func getSecurityToken(c *cli.Context) string {
+	if token := c.String(FlagSecurityToken); token != "" {
+		return token
+	}
+	if token := getEnvironmentSecurityToken(); token != "" {
+		return token
+	}
+	return getProfileCredential(c, credentialSecurityToken)
+}
+
+func newCredentialsStore(c *cli.Context) credentialsStore {
+	switch store := c.GlobalString(FlagCredentialsStore); store {
+	case credentialsStoreKeychain:
+		return &keychainCredentialsStore{goos: runtime.GOOS}
+	case credentialsStoreFile:
+		return &fileCredentialsStore{path: c.GlobalString(FlagCredentialsFile)}
+	default:
+		ErrorAndExit(fmt.Sprintf("Invalid credentials store %s, valid values are %s and %s",
+			store, credentialsStoreKeychain, credentialsStoreFile), nil)
+		return nil
+	}
+}
+
+func validateProfileCredential(profile string, kind string) error {
+	if !profileNameRegex.MatchString(profile) {
+		return fmt.Errorf("invalid profile name %q, only letters, digits, '_', '.' and '-' are allowed", profile)
+	}
+	for _, k := range credentialKinds {
+		if k == kind {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid credential kind %q, valid kinds are %s", kind, joinCredentialKinds())
+}
+
+// The keychain is accessed through the security tool on macOS and secret-tool, of libsecret, on Linux.
+// Secrets are base64 encoded so that they can be passed through the interactive mode of the security tool
+// which keeps them out of the process arguments.
+
+func (s *keychainCredentialsStore) Get(profile string, kind string) (string, error) {
+	account := keychainAccount(profile, kind)
+	var out string
+	var err error
+	switch s.goos {
+	case "darwin":
+		out, err = keychainCommand("security", "", "find-generic-password", "-s", credentialsKeychainService, "-a", account, "-w")
+	case "linux":
+		out, err = keychainCommand("secret-tool", "", "lookup", "service", credentialsKeychainService, "account", account)
+	default:
+		return "", s.unsupportedError()
+	}
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); ok && strings.TrimSpace(out) == "" {
+			// the tools exit with an error when the item is not found
+			return "", nil
+		}
+		return "", err
+	}
+	secret, err := base64.StdEncoding.DecodeString(strings.TrimSpace(out))
+	if err != nil {
+		return "", fmt.Errorf("invalid keychain item %s: %v", account, err)
+	}
+	return string(secret), nil
+}
+
+func (s *keychainCredentialsStore) Set(profile string, kind string, secret string) error {
+	if err := validateProfileCredential(profile, kind); err != nil {
+		return err
+	}
+	account := keychainAccount(profile, kind)
+	encoded := base64.StdEncoding.EncodeToString([]byte(secret))
+	switch s.goos {
+	case "darwin":
+		input := fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n", credentialsKeychainService, account, encoded)
+		out, err := keychainCommand("security", input, "-i")
+		if err == nil && strings.Contains(out, "error") {
+			err = errors.New(strings.TrimSpace(out))
+		}
+		return err
+	case "linux":
+		_, err := keychainCommand("secret-tool", encoded, "store", "--label", credentialsKeychainService+" "+account,
+			"service", credentialsKeychainService, "account", account)
+		return err
+	default:
+		return s.unsupportedError()
+	}
+}
+
+func (s *keychainCredentialsStore) Delete(profile string, kind string) error {
+	account := keychainAccount(profile, kind)
+	switch s.goos {
+	case "darwin":
+		_, err := keychainCommand("security", "", "delete-generic-password", "-s", credentialsKeychainService, "-a", account)
+		return err
+	case "linux":
+		_, err := keychainCommand("secret-tool", "", "clear", "service", credentialsKeychainService, "account", account)
+		return err
+	default:
+		return s.unsupportedError()
+	}
+}
+
+func (s *keychainCredentialsStore) unsupportedError() error {
+	return fmt.Errorf("keychain is not supported on %s, use --%s %s", s.goos, FlagCredentialsStore, credentialsStoreFile)
+}
+
+func keychainAccount(profile string, kind string) string {
+	return profile + "/" + kind
+}
+
+func runKeychainCommand(name string, stdin string, args ...string) (string, error) {
+	var stdout bytes.Buffer
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	err := cmd.Run()
+	return stdout.String(), err
+}
+
+func (s *fileCredentialsStore) Get(profile string, kind string) (string, error) {
+	if err := s.load(false); err != nil {
+		return "", err
+	}
+	return s.credentials[profile][kind], nil
+}
+
+func (s *fileCredentialsStore) Set(profile string, kind string, secret string) error {
+	if err := validateProfileCredential(profile, kind); err != nil {
+		return err
+	}
+	if err := s.load(true); err != nil {
+		return err
+	}
+	if s.credentials[profile] == nil {
+		s.credentials[profile] = make(map[string]string)
+	}
+	s.credentials[profile][kind] = secret
+	return s.save()
+}
+
+func (s *fileCredentialsStore) Delete(profile string, kind string) error {
+	if err := s.load(false); err != nil {
+		return err
+	}
+	if _, ok := s.credentials[profile][kind]; !ok {
+		return nil
+	}
+	delete(s.credentials[profile], kind)
+	if len(s.credentials[profile]) == 0 {
+		delete(s.credentials, profile)
+	}
+	return s.save()
+}
+
+// load decrypts the credentials file, a missing file has no credentials and is only created by save
+// if create is set, in which case the new passphrase is confirmed
+func (s *fileCredentialsStore) load(create bool) error {
+	if s.credentials != nil {
+		return nil
+	}
+	content, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		s.credentials = make(map[string]map[string]string)
+		if !create {
+			return nil
+		}
+		s.passphrase, err = credentialsPassphrase(true)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	var file encryptedCredentialsFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return fmt.Errorf("invalid credentials file %s: %v", s.path, err)
+	}
+	if file.Version != credentialsFileVersion {
+		return fmt.Errorf("unsupported credentials file version %d", file.Version)
+	}
+	passphrase, err := credentialsPassphrase(false)
+	if err != nil {
+		return err
+	}
+	gcm, err := newCredentialsCipher(passphrase, file.Salt)
+	if err != nil {
+		return err
+	}
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Data, nil)
+	if err != nil {
+		return errCredentialsWrongPassphrase
+	}
+	credentials := make(map[string]map[string]string)
+	if err := json.Unmarshal(plaintext, &credentials); err != nil {
+		return fmt.Errorf("invalid credentials file %s: %v", s.path, err)
+	}
+	s.credentials = credentials
+	s.passphrase = passphrase
+	return nil
+}
+
+// save encrypts the credentials with a new salt and nonce and atomically replaces the credentials file
+func (s *fileCredentialsStore) save() error {
+	plaintext, err := json.Marshal(s.credentials)
+	if err != nil {
+		return err
+	}
+	file := encryptedCredentialsFile{
+		Version: credentialsFileVersion,
+		Salt:    make([]byte, 16),
+	}
+	if _, err := rand.Read(file.Salt); err != nil {
+		return err
+	}
+	gcm, err := newCredentialsCipher(s.passphrase, file.Salt)
+	if err != nil {
+		return err
+	}
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Data = gcm.Seal(nil, file.Nonce, plaintext, nil)
+	content, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// newCredentialsCipher derives the AES-256 key of the credentials file from the passphrase with scrypt
+func newCredentialsCipher(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// readCredentialsPassphrase returns the passphrase set in the environment or prompts for it without echo
+func readCredentialsPassphrase(confirm bool) (string, error) {
+	if passphrase := os.Getenv(credentialsPassphraseEnv); passphrase != "" {
+		return passphrase, nil
+	}
+	fd := int(os.Stdin.Fd())
+	if !terminal.IsTerminal(fd) {
+		return "", fmt.Errorf("stdin is not a terminal, set the passphrase of the credentials file with %s", credentialsPassphraseEnv)
+	}
+	fmt.Fprint(os.Stderr, "Credentials file passphrase: ")
+	passphrase, err := terminal.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	if confirm {
+		fmt.Fprint(os.Stderr, "Confirm passphrase: ")
+		confirmation, err := terminal.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", err
+		}
+		if string(confirmation) != string(passphrase) {
+			return "", errCredentialsPassphraseMismatch
+		}
+	}
+	return string(passphrase), nil
+}
+
+func getDefaultCredentialsFilePath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".cadence", "credentials.enc")
+}
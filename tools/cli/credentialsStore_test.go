@@ -0,0 +1,151 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileCredentialsStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cadence-cli-credentials")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "credentials.enc")
+
+	oldPassphrase := credentialsPassphrase
+	defer func() { credentialsPassphrase = oldPassphrase }()
+	passphrase := "correct horse"
+	credentialsPassphrase = func(bool) (string, error) { return passphrase, nil }
+
+	store := &fileCredentialsStore{path: path}
+	require.NoError(t, store.Set("prod", credentialJWT, "secret-jwt"))
+	require.NoError(t, store.Set("prod", credentialSecurityToken, "secret-token"))
+	assert.Error(t, store.Set("prod cluster", credentialJWT, "secret-jwt"))
+	assert.Error(t, store.Set("prod", "password", "secret"))
+
+	// the file does not contain the credentials in plaintext and is only readable by the user
+	content, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "secret-jwt")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	store = &fileCredentialsStore{path: path}
+	secret, err := store.Get("prod", credentialJWT)
+	require.NoError(t, err)
+	assert.Equal(t, "secret-jwt", secret)
+	secret, err = store.Get("staging", credentialJWT)
+	require.NoError(t, err)
+	assert.Empty(t, secret)
+
+	require.NoError(t, store.Delete("prod", credentialJWT))
+	store = &fileCredentialsStore{path: path}
+	secret, err = store.Get("prod", credentialJWT)
+	require.NoError(t, err)
+	assert.Empty(t, secret)
+	secret, err = store.Get("prod", credentialSecurityToken)
+	require.NoError(t, err)
+	assert.Equal(t, "secret-token", secret)
+
+	passphrase = "wrong"
+	store = &fileCredentialsStore{path: path}
+	_, err = store.Get("prod", credentialSecurityToken)
+	assert.Equal(t, errCredentialsWrongPassphrase, err)
+}
+
+func TestFileCredentialsStore_MissingFile(t *testing.T) {
+	oldPassphrase := credentialsPassphrase
+	defer func() { credentialsPassphrase = oldPassphrase }()
+	credentialsPassphrase = func(bool) (string, error) {
+		t.Fatal("passphrase must not be asked without a credentials file")
+		return "", nil
+	}
+
+	store := &fileCredentialsStore{path: filepath.Join(os.TempDir(), "cadence-cli-missing", "credentials.enc")}
+	secret, err := store.Get("prod", credentialJWT)
+	require.NoError(t, err)
+	assert.Empty(t, secret)
+}
+
+func TestKeychainCredentialsStore(t *testing.T) {
+	oldCommand := keychainCommand
+	defer func() { keychainCommand = oldCommand }()
+
+	type call struct {
+		name  string
+		stdin string
+		args  []string
+	}
+	var calls []call
+	items := map[string]string{}
+	keychainCommand = func(name string, stdin string, args ...string) (string, error) {
+		calls = append(calls, call{name: name, stdin: stdin, args: args})
+		if args[0] == "lookup" {
+			if item, ok := items[args[len(args)-1]]; ok {
+				return item + "\n", nil
+			}
+			return "", &exec.ExitError{}
+		}
+		if args[0] == "store" {
+			items[args[len(args)-1]] = stdin
+		}
+		return "", nil
+	}
+
+	store := &keychainCredentialsStore{goos: "linux"}
+	require.NoError(t, store.Set("prod", credentialJWT, "secret-jwt"))
+	encoded := base64.StdEncoding.EncodeToString([]byte("secret-jwt"))
+	assert.Equal(t, call{
+		name:  "secret-tool",
+		stdin: encoded,
+		args:  []string{"store", "--label", "cadence-cli prod/jwt", "service", "cadence-cli", "account", "prod/jwt"},
+	}, calls[0])
+	for _, c := range calls {
+		assert.NotContains(t, c.args, "secret-jwt")
+	}
+
+	secret, err := store.Get("prod", credentialJWT)
+	require.NoError(t, err)
+	assert.Equal(t, "secret-jwt", secret)
+	secret, err = store.Get("prod", credentialTLSKey)
+	require.NoError(t, err)
+	assert.Empty(t, secret)
+
+	// the secret is passed to the security tool through stdin, not as an argument
+	calls = nil
+	store = &keychainCredentialsStore{goos: "darwin"}
+	require.NoError(t, store.Set("prod", credentialJWT, "secret-jwt"))
+	assert.Equal(t, []string{"-i"}, calls[0].args)
+	assert.Equal(t, "add-generic-password -U -s cadence-cli -a prod/jwt -w "+encoded+"\n", calls[0].stdin)
+
+	store = &keychainCredentialsStore{goos: "windows"}
+	_, err = store.Get("prod", credentialJWT)
+	assert.Error(t, err)
+}
@@ -40,7 +40,7 @@ import (
 
 var supportedDBs = append(sql.GetRegisteredPluginNames(), "cassandra")
 
-func getDBFlags() []cli.Flag {
+func getServiceConfigFlags() []cli.Flag {
 	return []cli.Flag{
 		cli.StringFlag{
 			Name:   FlagServiceConfigDirWithAlias,
@@ -58,6 +58,11 @@ func getDBFlags() []cli.Flag {
 			Usage:  "service zone for loading service configuration",
 			EnvVar: config.EnvKeyAvailabilityZone,
 		},
+	}
+}
+
+func getDBFlags() []cli.Flag {
+	return append(getServiceConfigFlags(),
 		cli.StringFlag{
 			Name:  FlagDBType,
 			Value: "cassandra",
@@ -122,7 +127,7 @@ func getDBFlags() []cli.Flag {
 		},
 		cli.StringFlag{
 			Name:  FlagTLSKeyPath,
-			Usage: "cassandra tls client key path (tls must be enabled)",
+			Usage: "cassandra tls client key path (tls must be enabled), defaults to the TLS key stored for the profile",
 		},
 		cli.StringFlag{
 			Name:  FlagTLSCaPath,
@@ -142,7 +147,7 @@ func getDBFlags() []cli.Flag {
 			Usage: "target rps of database queries",
 			Value: 100,
 		},
-	}
+	)
 }
 
 func initializeExecutionStore(c *cli.Context, shardID int) persistence.ExecutionManager {
@@ -172,6 +177,15 @@ func initializeShardManager(c *cli.Context) persistence.ShardManager {
 	return shardManager
 }
 
+func initializeTaskManager(c *cli.Context) persistence.TaskManager {
+	factory := getPersistenceFactory(c)
+	taskManager, err := factory.NewTaskManager()
+	if err != nil {
+		ErrorAndExit("Failed to initialize task manager", err)
+	}
+	return taskManager
+}
+
 func initializeDomainManager(c *cli.Context) persistence.DomainManager {
 	factory := getPersistenceFactory(c)
 	domainManager, err := factory.NewDomainManager()
@@ -331,6 +345,8 @@ func overrideTLS(c *cli.Context, tls *config.TLS) {
 	}
 	if c.IsSet(FlagTLSKeyPath) {
 		tls.KeyFile = c.String(FlagTLSKeyPath)
+	} else if tls.Enabled && tls.KeyFile == "" {
+		tls.KeyFile = getProfileCredentialFile(c, credentialTLSKey)
 	}
 	if c.IsSet(FlagTLSCaPath) {
 		tls.CaFile = c.String(FlagTLSCaPath)
@@ -71,7 +71,25 @@ const (
 
 	searchAttrInputSeparator = "|"
 
+	queryTypeStackTrace = "__stack_trace"
+
 	defaultGracefulFailoverTimeoutInSeconds = 60
+
+	defaultDomainProvisioningWaitTimeoutInSeconds = 600
+	domainProvisioningPollInterval                = 2 * time.Second
+	batchJobPollInterval                          = 2 * time.Second
+
+	defaultFailoverSimulationSampleSize = 20
+
+	defaultDLQMergeWorkflowTimeoutInSeconds = 30 * 24 * 60 * 60
+
+	outputFormatTable = "table"
+	outputFormatJSON  = "json"
+	outputFormatJSONL = "jsonl"
+
+	defaultRetryBackoffInMs = 500
+
+	cronPreviewCount = 5
 )
 
 var envKeysForUserName = []string{
@@ -98,6 +116,11 @@ var resetTypesMap = map[string]string{
 	resetTypeLastDecisionScheduled:  "",
 }
 
+const taskListTypeDecision = "decision"
+const taskListTypeActivity = "activity"
+
+var taskListTypeNames = []string{taskListTypeDecision, taskListTypeActivity}
+
 type jsonType int
 
 const (
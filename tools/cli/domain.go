@@ -74,6 +74,22 @@ func newDomainCommands() []cli.Command {
 				newDomainCLI(c, false).DeprecateDomain(c)
 			},
 		},
+		{
+			Name:  "quarantine",
+			Usage: "Quarantine existing workflow domain, new workflows and signals are rejected while running workflows keep going",
+			Flags: quarantineDomainFlags,
+			Action: func(c *cli.Context) {
+				newDomainCLI(c, false).QuarantineDomain(c)
+			},
+		},
+		{
+			Name:  "unquarantine",
+			Usage: "Lift the quarantine of a workflow domain",
+			Flags: quarantineDomainFlags,
+			Action: func(c *cli.Context) {
+				newDomainCLI(c, false).UnquarantineDomain(c)
+			},
+		},
 		{
 			Name:    "describe",
 			Aliases: []string{"desc"},
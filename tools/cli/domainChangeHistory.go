@@ -0,0 +1,145 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	domainChangeOperationUpdate       = "update"
+	domainChangeOperationFailover     = "failover"
+	domainChangeOperationDeprecate    = "deprecate"
+	domainChangeOperationQuarantine   = "quarantine"
+	domainChangeOperationUnquarantine = "unquarantine"
+
+	// maxDomainChangeHistory bounds the change trail kept in domain data, older entries are dropped
+	maxDomainChangeHistory = 20
+)
+
+type (
+	// domainChange is an entry of the change trail kept in domain data
+	domainChange struct {
+		Timestamp time.Time `json:"timestamp"`
+		Operator  string    `json:"operator"`
+		Operation string    `json:"operation"`
+		Reason    string    `json:"reason"`
+	}
+
+	// DomainChangeRow is a presentation layer entity use to render the domain change trail
+	DomainChangeRow struct {
+		Time      time.Time `header:"Time"`
+		Operator  string    `header:"Operator"`
+		Operation string    `header:"Operation"`
+		Reason    string    `header:"Reason"`
+	}
+)
+
+// getDomainChangeHistory decodes the change trail from domain data, a malformed trail is treated as empty
+func getDomainChangeHistory(data map[string]string) []domainChange {
+	value, ok := data[common.DomainDataKeyForChangeHistory]
+	if !ok {
+		return nil
+	}
+	var changes []domainChange
+	if err := json.Unmarshal([]byte(value), &changes); err != nil {
+		return nil
+	}
+	return changes
+}
+
+// newDomainChangeHistory appends a change made by the current operator to the trail in data
+// and returns the encoded trail to be written back to domain data
+func newDomainChangeHistory(data map[string]string, operation string, reason string) string {
+	changes := append(getDomainChangeHistory(data), domainChange{
+		Timestamp: time.Now().UTC(),
+		Operator:  getCurrentUserFromEnv(),
+		Operation: operation,
+		Reason:    reason,
+	})
+	if len(changes) > maxDomainChangeHistory {
+		changes = changes[len(changes)-maxDomainChangeHistory:]
+	}
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		ErrorAndExit("Failed to encode domain change history.", err)
+	}
+	return string(encoded)
+}
+
+// recordDomainChange writes a change to the trail of an existing domain. It is used after failover and
+// deprecation, which cannot carry domain data in the same request, so a failure is only reported on stderr
+func (d *domainCLIImpl) recordDomainChange(
+	c *cli.Context,
+	domainName string,
+	domainID string,
+	operation string,
+	reason string,
+) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := func() error {
+		resp, err := d.describeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+		if err != nil {
+			return err
+		}
+		_, err = d.updateDomain(ctx, &types.UpdateDomainRequest{
+			Name:          domainName,
+			UUID:          domainID,
+			SecurityToken: getSecurityToken(c),
+			Data: map[string]string{
+				common.DomainDataKeyForChangeHistory: newDomainChangeHistory(resp.DomainInfo.GetData(), operation, reason),
+			},
+		})
+		return err
+	}()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to record %s of domain %s in its change history: %v\n", operation, domainName, err)
+	}
+}
+
+func printDomainChangeHistory(data map[string]string) {
+	changes := getDomainChangeHistory(data)
+	if len(changes) == 0 {
+		fmt.Println("No recorded changes.")
+		return
+	}
+	fmt.Println("Change history:")
+	table := make([]DomainChangeRow, 0, len(changes))
+	for i := len(changes) - 1; i >= 0; i-- {
+		table = append(table, DomainChangeRow{
+			Time:      changes[i].Timestamp,
+			Operator:  changes[i].Operator,
+			Operation: changes[i].Operation,
+			Reason:    changes[i].Reason,
+		})
+	}
+	RenderTable(getOutput(), table, TableOptions{Color: true, Border: true, PrintDateTime: true})
+}
@@ -25,7 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -36,12 +36,20 @@ import (
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/types"
+	jsonmapper "github.com/uber/cadence/common/types/mapper/json"
 )
 
 var (
+	forceFailoverType    = "force"
 	gracefulFailoverType = "grace"
+	failoverTypes        = []string{forceFailoverType, gracefulFailoverType}
+
+	archivalStatusDisabled = "disabled"
+	archivalStatusEnabled  = "enabled"
+	archivalStatuses       = []string{archivalStatusDisabled, archivalStatusEnabled}
 )
 
 type (
@@ -84,7 +92,7 @@ func (d *domainCLIImpl) RegisterDomain(c *cli.Context) {
 	if c.IsSet(FlagRetentionDays) {
 		retentionDays = c.Int(FlagRetentionDays)
 	}
-	securityToken := c.String(FlagSecurityToken)
+	securityToken := getSecurityToken(c)
 	var err error
 
 	isGlobalDomain := true
@@ -124,11 +132,19 @@ func (d *domainCLIImpl) RegisterDomain(c *cli.Context) {
 		}
 	}
 
+	data := domainData.Value()
+	if c.IsSet(FlagWorkflowIDPattern) {
+		if data == nil {
+			data = map[string]string{}
+		}
+		data[common.DomainDataKeyForWorkflowIDPattern] = c.String(FlagWorkflowIDPattern)
+	}
+
 	request := &types.RegisterDomainRequest{
 		Name:                                   domainName,
 		Description:                            description,
 		OwnerEmail:                             ownerEmail,
-		Data:                                   domainData.Value(),
+		Data:                                   data,
 		WorkflowExecutionRetentionPeriodInDays: int32(retentionDays),
 		Clusters:                               clusters,
 		ActiveClusterName:                      activeClusterName,
@@ -139,6 +155,9 @@ func (d *domainCLIImpl) RegisterDomain(c *cli.Context) {
 		VisibilityArchivalURI:                  c.String(FlagVisibilityArchivalURI),
 		IsGlobalDomain:                         isGlobalDomain,
 	}
+	if printDryRunRequest(c, "RegisterDomain", request) {
+		return
+	}
 
 	ctx, cancel := newContext(c)
 	defer cancel()
@@ -150,24 +169,51 @@ func (d *domainCLIImpl) RegisterDomain(c *cli.Context) {
 			ErrorAndExit(fmt.Sprintf("Domain %s already registered.", domainName), err)
 		}
 	} else {
+		if c.Bool(FlagWait) {
+			d.waitForDomainProvisioning(c, domainName)
+		}
 		fmt.Printf("Domain %s successfully registered.\n", domainName)
 	}
 }
 
+// waitForDomainProvisioning polls the domain until it is no longer in PROVISIONING status
+func (d *domainCLIImpl) waitForDomainProvisioning(c *cli.Context, domainName string) {
+	deadline := time.Now().Add(time.Duration(c.Int(FlagWaitTimeout)) * time.Second)
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := d.describeDomain(ctx, &types.DescribeDomainRequest{
+			Name: common.StringPtr(domainName),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to describe domain while waiting for provisioning.", err)
+		}
+		if resp.DomainInfo.GetStatus() != types.DomainStatusProvisioning {
+			return
+		}
+		if time.Now().After(deadline) {
+			ErrorAndExit(fmt.Sprintf("Timed out waiting for domain %s to finish provisioning.", domainName), nil)
+		}
+		fmt.Printf("Domain %s is provisioning, waiting...\n", domainName)
+		time.Sleep(domainProvisioningPollInterval)
+	}
+}
+
 // UpdateDomain updates a domain
 func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
-	domainName := getRequiredGlobalOption(c, FlagDomain)
-
 	var updateRequest *types.UpdateDomainRequest
 	ctx, cancel := newContext(c)
 	defer cancel()
 
+	domainName, domainID := d.getDomainNameAndID(ctx, c)
+	reason := getRequiredOption(c, FlagReason)
+
 	if c.IsSet(FlagActiveClusterName) {
 		activeCluster := c.String(FlagActiveClusterName)
 		fmt.Printf("Will set active cluster name to: %s, other flag will be omitted.\n", activeCluster)
 
 		var failoverTimeout *int32
-		if c.String(FlagFailoverType) == gracefulFailoverType {
+		if getEnumOption(c, FlagFailoverType, failoverTypes) == gracefulFailoverType {
 			timeout := int32(c.Int(FlagFailoverTimeout))
 			failoverTimeout = &timeout
 		}
@@ -178,9 +224,11 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			FailoverTimeoutInSeconds: failoverTimeout,
 		}
 	} else {
-		resp, err := d.describeDomain(ctx, &types.DescribeDomainRequest{
-			Name: common.StringPtr(domainName),
-		})
+		describeRequest := &types.DescribeDomainRequest{Name: common.StringPtr(domainName)}
+		if domainID != "" {
+			describeRequest = &types.DescribeDomainRequest{UUID: common.StringPtr(domainID)}
+		}
+		resp, err := d.describeDomain(ctx, describeRequest)
 		if err != nil {
 			if _, ok := err.(*types.EntityNotExistsError); !ok {
 				ErrorAndExit("Operation UpdateDomain failed.", err)
@@ -202,10 +250,17 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 		if c.IsSet(FlagOwnerEmail) {
 			ownerEmail = c.String(FlagOwnerEmail)
 		}
-		var domainData *flag.StringMap
+		domainData := map[string]string{}
 		if c.IsSet(FlagDomainData) {
-			domainData = c.Generic(FlagDomainData).(*flag.StringMap)
+			for k, v := range c.Generic(FlagDomainData).(*flag.StringMap).Value() {
+				domainData[k] = v
+			}
+		}
+		if c.IsSet(FlagWorkflowIDPattern) {
+			// an empty pattern lifts the restriction
+			domainData[common.DomainDataKeyForWorkflowIDPattern] = c.String(FlagWorkflowIDPattern)
 		}
+		domainData[common.DomainDataKeyForChangeHistory] = newDomainChangeHistory(resp.DomainInfo.GetData(), domainChangeOperationUpdate, reason)
 		if c.IsSet(FlagRetentionDays) {
 			retentionDays = int32(c.Int(FlagRetentionDays))
 		}
@@ -223,11 +278,7 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 
 		var binBinaries *types.BadBinaries
 		if c.IsSet(FlagAddBadBinary) {
-			if !c.IsSet(FlagReason) {
-				ErrorAndExit("Must provide a reason.", nil)
-			}
 			binChecksum := c.String(FlagAddBadBinary)
-			reason := c.String(FlagReason)
 			operator := getCurrentUserFromEnv()
 			binBinaries = &types.BadBinaries{
 				Binaries: map[string]*types.BadBinaryInfo{
@@ -248,7 +299,7 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			Name:                                   domainName,
 			Description:                            common.StringPtr(description),
 			OwnerEmail:                             common.StringPtr(ownerEmail),
-			Data:                                   domainData.Value(),
+			Data:                                   domainData,
 			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(retentionDays),
 			EmitMetric:                             common.BoolPtr(emitMetric),
 			HistoryArchivalStatus:                  archivalStatus(c, FlagHistoryArchivalStatus),
@@ -258,11 +309,16 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 			BadBinaries:                            binBinaries,
 			Clusters:                               clusters,
 			DeleteBadBinary:                        badBinaryToDelete,
+			Metadata:                               parseDomainMetadata(c),
 		}
 	}
 
-	securityToken := c.String(FlagSecurityToken)
+	securityToken := getSecurityToken(c)
 	updateRequest.SecurityToken = securityToken
+	updateRequest.UUID = domainID
+	if printDryRunRequest(c, "UpdateDomain", updateRequest) {
+		return
+	}
 	_, err := d.updateDomain(ctx, updateRequest)
 	if err != nil {
 		if _, ok := err.(*types.EntityNotExistsError); !ok {
@@ -272,17 +328,22 @@ func (d *domainCLIImpl) UpdateDomain(c *cli.Context) {
 		}
 	} else {
 		fmt.Printf("Domain %s successfully updated.\n", domainName)
+		if updateRequest.ActiveClusterName != nil {
+			d.recordDomainChange(c, domainName, domainID, domainChangeOperationFailover, reason)
+		}
 	}
 }
 
 func (d *domainCLIImpl) DeprecateDomain(c *cli.Context) {
-	domainName := getRequiredGlobalOption(c, FlagDomain)
-	securityToken := c.String(FlagSecurityToken)
+	securityToken := getSecurityToken(c)
 	force := c.Bool(FlagForce)
 
 	ctx, cancel := newContext(c)
 	defer cancel()
 
+	domainName, domainID := d.getDomainNameAndID(ctx, c)
+	reason := getRequiredOption(c, FlagReason)
+
 	if !force {
 		// check if there is any workflow in this domain, if exists, do not deprecate
 		wfs, _ := listClosedWorkflow(getWorkflowClient(c), 1, 0, time.Now().UnixNano(), domainName, "", "", workflowStatusNotSet, c)(nil)
@@ -296,10 +357,16 @@ func (d *domainCLIImpl) DeprecateDomain(c *cli.Context) {
 			return
 		}
 	}
-	err := d.deprecateDomain(ctx, &types.DeprecateDomainRequest{
+	request := &types.DeprecateDomainRequest{
 		Name:          domainName,
 		SecurityToken: securityToken,
-	})
+		UUID:          domainID,
+	}
+	if printDryRunRequest(c, "DeprecateDomain", request) {
+		return
+	}
+	confirmTypedName(c, "deprecate", "domain", domainName)
+	err := d.deprecateDomain(ctx, request)
 	if err != nil {
 		if _, ok := err.(*types.EntityNotExistsError); !ok {
 			ErrorAndExit("Operation DeprecateDomain failed.", err)
@@ -308,63 +375,142 @@ func (d *domainCLIImpl) DeprecateDomain(c *cli.Context) {
 		}
 	} else {
 		fmt.Printf("Domain %s successfully deprecated.\n", domainName)
+		d.recordDomainChange(c, domainName, domainID, domainChangeOperationDeprecate, reason)
+	}
+}
+
+// QuarantineDomain puts a domain in quarantine, it rejects new workflows and signals until the quarantine is lifted
+func (d *domainCLIImpl) QuarantineDomain(c *cli.Context) {
+	d.setDomainQuarantine(c, true)
+}
+
+// UnquarantineDomain lifts the quarantine of a domain
+func (d *domainCLIImpl) UnquarantineDomain(c *cli.Context) {
+	d.setDomainQuarantine(c, false)
+}
+
+func (d *domainCLIImpl) setDomainQuarantine(c *cli.Context, quarantined bool) {
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	domainName, domainID := d.getDomainNameAndID(ctx, c)
+	reason := getRequiredOption(c, FlagReason)
+
+	resp, err := d.describeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr(domainName)})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); !ok {
+			ErrorAndExit("Operation DescribeDomain failed.", err)
+		}
+		ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domainName), err)
+	}
+
+	operation := domainChangeOperationQuarantine
+	if !quarantined {
+		operation = domainChangeOperationUnquarantine
+	}
+	if domain.IsQuarantined(resp.DomainInfo.GetData()) == quarantined {
+		fmt.Printf("Domain %s is already %sd.\n", domainName, operation)
+		return
+	}
+
+	request := &types.UpdateDomainRequest{
+		Name:          domainName,
+		UUID:          domainID,
+		SecurityToken: getSecurityToken(c),
+		Data: map[string]string{
+			common.DomainDataKeyForQuarantine:    strconv.FormatBool(quarantined),
+			common.DomainDataKeyForChangeHistory: newDomainChangeHistory(resp.DomainInfo.GetData(), operation, reason),
+		},
+	}
+	if printDryRunRequest(c, "UpdateDomain", request) {
+		return
 	}
+	_, err = d.updateDomain(ctx, request)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Operation %s failed.", operation), err)
+	}
+	fmt.Printf("Domain %s successfully %sd.\n", domainName, operation)
 }
 
 // FailoverDomains is used for managed failover all domains with domain data IsManagedByCadence=true
 func (d *domainCLIImpl) FailoverDomains(c *cli.Context) {
-	// ask user for confirmation
-	prompt("You are trying to failover all managed domains, continue? Y/N")
-	d.failoverDomains(c)
+	// ask user for confirmation, nothing is changed in a dry run
+	if !isDryRun(c) {
+		prompt("You are trying to failover all managed domains, continue? Y/N")
+	}
+	d.failoverDomains(c).finish(c)
 }
 
-// return succeed and failed domains for testing purpose
-func (d *domainCLIImpl) failoverDomains(c *cli.Context) ([]string, []string) {
+func (d *domainCLIImpl) failoverDomains(c *cli.Context) *batchResults {
 	targetCluster := getRequiredOption(c, FlagActiveClusterName)
 	domains := d.getAllDomains(c)
 	shouldFailover := func(domain *types.DescribeDomainResponse) bool {
 		isDomainNotActiveInTargetCluster := domain.ReplicationConfiguration.GetActiveClusterName() != targetCluster
 		return isDomainNotActiveInTargetCluster && isDomainFailoverManagedByCadence(domain)
 	}
-	var succeedDomains []string
-	var failedDomains []string
+	var domainNames []string
 	for _, domain := range domains {
 		if shouldFailover(domain) {
-			domainName := domain.GetDomainInfo().GetName()
-			err := d.failover(c, domainName, targetCluster)
-			if err != nil {
-				printError(fmt.Sprintf("Failed failover domain: %s\n", domainName), err)
-				failedDomains = append(failedDomains, domainName)
-			} else {
-				fmt.Printf("Success failover domain: %s\n", domainName)
-				succeedDomains = append(succeedDomains, domainName)
-			}
+			domainNames = append(domainNames, domain.GetDomainInfo().GetName())
+		}
+	}
+	results := newBatchResults("Failover domains")
+	results.trackProgress(c, len(domainNames))
+	for _, domainName := range domainNames {
+		if printDryRunRequest(c, "UpdateDomain", newFailoverRequest(domainName, targetCluster)) {
+			continue
+		}
+		results.start(domainName)
+		err := d.failover(c, domainName, targetCluster)
+		if err != nil {
+			results.fail(domainName, err)
+			printError(fmt.Sprintf("Failed failover domain: %s\n", domainName), err)
+		} else {
+			results.succeed(domainName)
+			fmt.Printf("Success failover domain: %s\n", domainName)
 		}
 	}
-	fmt.Printf("Succeed %d: %v\n", len(succeedDomains), succeedDomains)
-	fmt.Printf("Failed  %d: %v\n", len(failedDomains), failedDomains)
-	return succeedDomains, failedDomains
+	return results
 }
 
 func (d *domainCLIImpl) getAllDomains(c *cli.Context) []*types.DescribeDomainResponse {
 	var res []*types.DescribeDomainResponse
-	pagesize := int32(200)
+	d.listAllDomains(c, 200, func(domains []*types.DescribeDomainResponse) bool {
+		res = append(res, domains...)
+		return true
+	})
+	return res
+}
+
+// listAllDomains calls handlePage with each page of domains as soon as it is returned,
+// listing stops early when handlePage returns false
+func (d *domainCLIImpl) listAllDomains(
+	c *cli.Context,
+	pageSize int32,
+	handlePage func([]*types.DescribeDomainResponse) bool,
+) {
+	progress := newPageProgress(c, "domains")
+	defer progress.clear()
+
 	var token []byte
-	ctx, cancel := newContext(c)
-	defer cancel()
 	for more := true; more; more = len(token) > 0 {
 		listRequest := &types.ListDomainsRequest{
-			PageSize:      pagesize,
+			PageSize:      pageSize,
 			NextPageToken: token,
 		}
+		ctx, cancel := newContext(c)
 		listResp, err := d.listDomains(ctx, listRequest)
+		cancel()
+		progress.clear()
 		if err != nil {
 			ErrorAndExit("Error when list domains info", err)
 		}
 		token = listResp.GetNextPageToken()
-		res = append(res, listResp.GetDomains()...)
+		if !handlePage(listResp.GetDomains()) {
+			return
+		}
+		progress.addPage(len(listResp.GetDomains()))
 	}
-	return res
 }
 
 func isDomainFailoverManagedByCadence(domain *types.DescribeDomainResponse) bool {
@@ -372,22 +518,50 @@ func isDomainFailoverManagedByCadence(domain *types.DescribeDomainResponse) bool
 	return strings.ToLower(strings.TrimSpace(domainData[common.DomainDataKeyForManagedFailover])) == "true"
 }
 
-func (d *domainCLIImpl) failover(c *cli.Context, domainName string, targetCluster string) error {
-	updateRequest := &types.UpdateDomainRequest{
-		Name:              domainName,
-		ActiveClusterName: common.StringPtr(targetCluster),
+// getDomainNameAndID returns the domain name and UUID targeted by the command.
+// When a UUID is given the name is resolved from it, so either flag is enough.
+func (d *domainCLIImpl) getDomainNameAndID(ctx context.Context, c *cli.Context) (string, string) {
+	domainName := c.GlobalString(FlagDomain)
+	domainID := c.String(FlagDomainID)
+	if domainID == "" {
+		if domainName == "" {
+			ErrorAndExit(fmt.Sprintf("Option %s or %s is required", FlagDomain, FlagDomainID), nil)
+		}
+		return domainName, ""
 	}
+
+	resp, err := d.describeDomain(ctx, &types.DescribeDomainRequest{UUID: common.StringPtr(domainID)})
+	if err != nil {
+		if _, ok := err.(*types.EntityNotExistsError); !ok {
+			ErrorAndExit("Operation DescribeDomain failed.", err)
+		}
+		ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domainID), err)
+	}
+	if domainName != "" && domainName != resp.DomainInfo.GetName() {
+		ErrorAndExit(fmt.Sprintf("Domain %s does not match domain ID %s.", domainName, domainID), nil)
+	}
+	return resp.DomainInfo.GetName(), domainID
+}
+
+func (d *domainCLIImpl) failover(c *cli.Context, domainName string, targetCluster string) error {
 	ctx, cancel := newContext(c)
 	defer cancel()
-	_, err := d.updateDomain(ctx, updateRequest)
+	_, err := d.updateDomain(ctx, newFailoverRequest(domainName, targetCluster))
 	return err
 }
 
+func newFailoverRequest(domainName string, targetCluster string) *types.UpdateDomainRequest {
+	return &types.UpdateDomainRequest{
+		Name:              domainName,
+		ActiveClusterName: common.StringPtr(targetCluster),
+	}
+}
+
 // DescribeDomain updates a domain
 func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 	domainName := c.GlobalString(FlagDomain)
 	domainID := c.String(FlagDomainID)
-	printJSON := c.Bool(FlagPrintJSON)
+	format := getOutputFormat(c)
 
 	request := types.DescribeDomainRequest{}
 	if domainID != "" {
@@ -410,12 +584,12 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 		ErrorAndExit(fmt.Sprintf("Domain %s does not exist.", domainName), err)
 	}
 
-	if printJSON {
-		output, err := json.Marshal(resp)
-		if err != nil {
-			ErrorAndExit("Failed to encode domain response into JSON.", err)
-		}
-		fmt.Println(string(output))
+	switch format {
+	case outputFormatJSON:
+		prettyPrintJSONObject(jsonmapper.FromDescribeDomainResponse(resp))
+		return
+	case outputFormatJSONL:
+		printJSONLine(jsonmapper.FromDescribeDomainResponse(resp))
 		return
 	}
 
@@ -423,6 +597,13 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 	if resp.IsGlobalDomain {
 		clusters = clustersToString(resp.ReplicationConfiguration.Clusters)
 	}
+	// the change history is shown separately with --history
+	domainData := make(map[string]string, len(resp.DomainInfo.GetData()))
+	for k, v := range resp.DomainInfo.GetData() {
+		if k != common.DomainDataKeyForChangeHistory {
+			domainData[k] = v
+		}
+	}
 	var formatStr = "Name: %v\nUUID: %v\nDescription: %v\nOwnerEmail: %v\nDomainData: %v\nStatus: %v\nRetentionInDays: %v\n" +
 		"EmitMetrics: %v\nIsGlobal(XDC)Domain: %v\nActiveClusterName: %v\nClusters: %v\nHistoryArchivalStatus: %v\n"
 	descValues := []interface{}{
@@ -430,7 +611,7 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 		resp.DomainInfo.GetUUID(),
 		resp.DomainInfo.GetDescription(),
 		resp.DomainInfo.GetOwnerEmail(),
-		resp.DomainInfo.Data,
+		domainData,
 		resp.DomainInfo.GetStatus(),
 		resp.Configuration.GetWorkflowExecutionRetentionPeriodInDays(),
 		resp.Configuration.GetEmitMetric(),
@@ -440,16 +621,50 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 		resp.Configuration.GetHistoryArchivalStatus().String(),
 	}
 	if resp.Configuration.GetHistoryArchivalURI() != "" {
-		formatStr = formatStr + "HistoryArchivalURI: %v\n"
-		descValues = append(descValues, resp.Configuration.GetHistoryArchivalURI())
+		formatStr = formatStr + "HistoryArchivalURI: %v\nHistoryArchivalProvider: %v\n"
+		descValues = append(
+			descValues,
+			resp.Configuration.GetHistoryArchivalURI(),
+			describeArchivalProvider(
+				resp.DomainInfo.GetData()[common.DomainDataKeyForHistoryArchivalProvider],
+				resp.Configuration.GetHistoryArchivalURI(),
+			),
+		)
 	}
 	formatStr = formatStr + "VisibilityArchivalStatus: %v\n"
 	descValues = append(descValues, resp.Configuration.GetVisibilityArchivalStatus().String())
 	if resp.Configuration.GetVisibilityArchivalURI() != "" {
-		formatStr = formatStr + "VisibilityArchivalURI: %v\n"
-		descValues = append(descValues, resp.Configuration.GetVisibilityArchivalURI())
+		formatStr = formatStr + "VisibilityArchivalURI: %v\nVisibilityArchivalProvider: %v\n"
+		descValues = append(
+			descValues,
+			resp.Configuration.GetVisibilityArchivalURI(),
+			describeArchivalProvider(
+				resp.DomainInfo.GetData()[common.DomainDataKeyForVisibilityArchivalProvider],
+				resp.Configuration.GetVisibilityArchivalURI(),
+			),
+		)
+	}
+	if asyncWorkflowConfig := resp.Configuration.GetAsyncWorkflowConfig(); asyncWorkflowConfig.GetEnabled() {
+		formatStr = formatStr + "AsyncWorkflowQueueProvider: %v\nAsyncWorkflowQueueTopic: %v\nAsyncWorkflowRPS: %v\n"
+		descValues = append(
+			descValues,
+			asyncWorkflowConfig.GetQueueProvider(),
+			asyncWorkflowConfig.GetQueueTopic(),
+			asyncWorkflowConfig.GetRPS(),
+		)
 	}
 	fmt.Printf(formatStr, descValues...)
+	if metadata := resp.DomainInfo.GetMetadata(); len(metadata) > 0 {
+		fmt.Println("DomainMetadata:")
+		keys := make([]string, 0, len(metadata))
+		for key := range metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			fmt.Printf("  %v: %s\n", key, metadata[key])
+		}
+	}
 	if resp.Configuration.BadBinaries != nil {
 		fmt.Println("Bad binaries to reset:")
 		table := []BadBinaryRow{}
@@ -461,7 +676,7 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 				Reason:    bin.GetReason(),
 			})
 		}
-		RenderTable(os.Stdout, table, TableOptions{Color: true, Border: true, PrintDateTime: true})
+		RenderTable(getOutput(), table, TableOptions{Color: true, Border: true, PrintDateTime: true})
 	}
 	if resp.GetFailoverInfo() != nil {
 		info := resp.GetFailoverInfo()
@@ -473,8 +688,34 @@ func (d *domainCLIImpl) DescribeDomain(c *cli.Context) {
 			CompletedShardCount: info.GetCompletedShardCount(),
 			PendingShard:        info.GetPendingShards(),
 		}}
-		RenderTable(os.Stdout, table, TableOptions{Color: true, Border: true, PrintDateTime: true})
+		RenderTable(getOutput(), table, TableOptions{Color: true, Border: true, PrintDateTime: true})
+	}
+	if c.Bool(FlagHistory) {
+		printDomainChangeHistory(resp.DomainInfo.GetData())
+	}
+	if c.Bool(FlagReplication) {
+		printDomainReplicationWatermark(c, resp.DomainInfo.GetName())
+	}
+}
+
+func printDomainReplicationWatermark(c *cli.Context, domainName string) {
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.GetDomainReplicationWatermark(ctx, &types.GetDomainReplicationWatermarkRequest{
+		Domain: domainName,
+	})
+	if err != nil {
+		ErrorAndExit("Operation GetDomainReplicationWatermark failed.", err)
+	}
+
+	fmt.Printf("Replication watermark on cluster %v: ", resp.GetCluster())
+	if resp.LastReplicatedEventTime == nil {
+		fmt.Println("no events replicated yet")
+		return
 	}
+	eventTime := time.Unix(0, resp.GetLastReplicatedEventTime())
+	fmt.Printf("%v (lag %v)\n", eventTime.Format(time.RFC3339), time.Since(eventTime).Round(time.Second))
 }
 
 type BadBinaryRow struct {
@@ -547,69 +788,110 @@ func (d *domainCLIImpl) ListDomains(c *cli.Context) {
 	prefix := c.String(FlagPrefix)
 	printAll := c.Bool(FlagAll)
 	printDeprecated := c.Bool(FlagDeprecated)
-	printJSON := c.Bool(FlagPrintJSON)
+	format := getOutputFormat(c)
+	domainID := c.String(FlagDomainID)
+	dataFilters := parseDomainDataFilters(c)
 
 	if printAll && printDeprecated {
 		ErrorAndExit(fmt.Sprintf("Cannot specify %s and %s flags at the same time.", FlagAll, FlagDeprecated), nil)
 	}
+	if pageSize <= 0 {
+		ErrorAndExit(fmt.Sprintf("Flag %s must be a positive number.", FlagPageSize), nil)
+	}
 
-	domains := d.getAllDomains(c)
-	var filteredDomains []*types.DescribeDomainResponse
-
-	// Only list domains that are matching to the prefix if prefix is provided
-	if len(prefix) > 0 {
-		var prefixDomains []*types.DescribeDomainResponse
+	filterDomains := func(domains []*types.DescribeDomainResponse) []*types.DescribeDomainResponse {
+		filteredDomains := make([]*types.DescribeDomainResponse, 0, len(domains))
 		for _, domain := range domains {
-			if strings.Index(domain.DomainInfo.Name, prefix) == 0 {
-				prefixDomains = append(prefixDomains, domain)
+			// Only list the domain with the given ID regardless of its status
+			if len(domainID) > 0 {
+				if domain.DomainInfo.GetUUID() == domainID {
+					filteredDomains = append(filteredDomains, domain)
+				}
+				continue
+			}
+			// Only list domains that are matching to the prefix if prefix is provided
+			if len(prefix) > 0 && strings.Index(domain.DomainInfo.Name, prefix) != 0 {
+				continue
+			}
+			// Only list domains that have all the filtered domain data, the server does not support filtering on it
+			if !domainDataMatches(domain.DomainInfo.GetData(), dataFilters) {
+				continue
+			}
+			if printAll ||
+				(printDeprecated && *domain.DomainInfo.Status == types.DomainStatusDeprecated) ||
+				(!printDeprecated && *domain.DomainInfo.Status == types.DomainStatusRegistered) {
+				filteredDomains = append(filteredDomains, domain)
 			}
 		}
-		domains = prefixDomains
+		return filteredDomains[:cliPaging.limit(len(filteredDomains))]
 	}
 
-	if printAll {
-		filteredDomains = domains
-	} else {
-		filteredDomains = make([]*types.DescribeDomainResponse, 0, len(domains))
-		for _, domain := range domains {
-			if printDeprecated && *domain.DomainInfo.Status == types.DomainStatusDeprecated {
-				filteredDomains = append(filteredDomains, domain)
-			} else if !printDeprecated && *domain.DomainInfo.Status == types.DomainStatusRegistered {
-				filteredDomains = append(filteredDomains, domain)
+	if format == outputFormatJSONL {
+		d.listAllDomains(c, int32(pageSize), func(domains []*types.DescribeDomainResponse) bool {
+			for _, domain := range filterDomains(domains) {
+				printJSONLine(jsonmapper.FromDescribeDomainResponse(domain))
 			}
-		}
+			return !cliPaging.done()
+		})
+		return
 	}
 
-	if printJSON {
-		output, err := json.Marshal(filteredDomains)
-		if err != nil {
-			ErrorAndExit("Failed to encode domain results into JSON.", err)
-		}
-		fmt.Println(string(output))
+	if format == outputFormatJSON {
+		filteredDomains := []*types.DescribeDomainResponse{}
+		d.listAllDomains(c, int32(pageSize), func(domains []*types.DescribeDomainResponse) bool {
+			filteredDomains = append(filteredDomains, filterDomains(domains)...)
+			return !cliPaging.done()
+		})
+		prettyPrintJSONObject(jsonmapper.FromDescribeDomainResponseArray(filteredDomains))
 		return
 	}
 
+	// render each page as soon as it is full, so output shows up without waiting for all domains
 	table := make([]DomainRow, 0, pageSize)
+	rendered := false
+	aborted := false
+	d.listAllDomains(c, int32(pageSize), func(domains []*types.DescribeDomainResponse) bool {
+		for _, domain := range filterDomains(domains) {
+			if len(table) == pageSize {
+				RenderTable(getOutput(), table, domainTableOptions(c))
+				rendered = true
+				table = make([]DomainRow, 0, pageSize)
+				if !showNextPage() {
+					aborted = true
+					return false
+				}
+			}
+			table = append(table, newDomainRow(domain))
+		}
+		return !cliPaging.done()
+	})
 
-	currentPageSize := 0
-	for i, domain := range filteredDomains {
-		table = append(table, newDomainRow(domain))
-		currentPageSize++
+	if aborted || (rendered && len(table) == 0) {
+		return
+	}
+	RenderTable(getOutput(), table, domainTableOptions(c))
+}
 
-		if currentPageSize != pageSize {
-			continue
+// parseDomainDataFilters parses the key=value domain data filters of the command, the value may be empty
+func parseDomainDataFilters(c *cli.Context) map[string]string {
+	filters := make(map[string]string)
+	for _, filter := range c.StringSlice(FlagDataFilter) {
+		parts := strings.SplitN(filter, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			ErrorAndExit(fmt.Sprintf("Invalid %s %q, expected format key=value.", FlagDataFilter, filter), nil)
 		}
+		filters[parts[0]] = parts[1]
+	}
+	return filters
+}
 
-		// page is full
-		RenderTable(os.Stdout, table, domainTableOptions(c))
-		if i == len(domains)-1 || !showNextPage() {
-			return
+func domainDataMatches(data map[string]string, filters map[string]string) bool {
+	for key, value := range filters {
+		if actual, ok := data[key]; !ok || actual != value {
+			return false
 		}
-		table = make([]DomainRow, 0, pageSize)
-		currentPageSize = 0
 	}
-
-	RenderTable(os.Stdout, table, domainTableOptions(c))
+	return true
 }
 
 func (d *domainCLIImpl) listDomains(
@@ -672,16 +954,29 @@ func (d *domainCLIImpl) describeDomain(
 	return d.domainHandler.DescribeDomain(ctx, request)
 }
 
+// describeArchivalProvider renders the archiver provider selected by the domain
+// together with the provider specific location parsed from the archival URI
+func describeArchivalProvider(providerName string, URIString string) string {
+	if providerName == "" {
+		providerName = "default"
+	}
+	URI, err := archiver.NewURI(URIString)
+	if err != nil {
+		return providerName
+	}
+	location := URI.Hostname() + URI.Path()
+	if location == "" {
+		location = URI.Opaque()
+	}
+	return fmt.Sprintf("%v (scheme: %v, location: %v)", providerName, URI.Scheme(), location)
+}
+
 func archivalStatus(c *cli.Context, statusFlagName string) *types.ArchivalStatus {
-	if c.IsSet(statusFlagName) {
-		switch c.String(statusFlagName) {
-		case "disabled":
-			return types.ArchivalStatusDisabled.Ptr()
-		case "enabled":
-			return types.ArchivalStatusEnabled.Ptr()
-		default:
-			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", statusFlagName), errors.New("invalid status, valid values are \"disabled\" and \"enabled\""))
-		}
+	switch getEnumOption(c, statusFlagName, archivalStatuses) {
+	case archivalStatusDisabled:
+		return types.ArchivalStatusDisabled.Ptr()
+	case archivalStatusEnabled:
+		return types.ArchivalStatusEnabled.Ptr()
 	}
 	return nil
 }
@@ -697,3 +992,19 @@ func clustersToString(clusters []*types.ClusterReplicationConfiguration) string
 	}
 	return res
 }
+
+// parseDomainMetadata reads the metadata to upsert from the domain metadata flag, the frontend validates the values
+func parseDomainMetadata(c *cli.Context) map[string][]byte {
+	if !c.IsSet(FlagDomainMetadata) {
+		return nil
+	}
+	var documents map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(c.String(FlagDomainMetadata)), &documents); err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %v must be a JSON object.", FlagDomainMetadata), err)
+	}
+	metadata := make(map[string][]byte, len(documents))
+	for key, value := range documents {
+		metadata[key] = value
+	}
+	return metadata
+}
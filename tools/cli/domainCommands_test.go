@@ -0,0 +1,81 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package cli
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestListAllDomains_StreamsPages(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	serverFrontendClient := frontend.NewMockClient(mockCtrl)
+	domainCLI := &domainCLIImpl{
+		frontendClient: serverFrontendClient,
+	}
+
+	newPage := func(name string, token []byte) *types.ListDomainsResponse {
+		return &types.ListDomainsResponse{
+			Domains: []*types.DescribeDomainResponse{
+				{DomainInfo: &types.DomainInfo{Name: name}},
+			},
+			NextPageToken: token,
+		}
+	}
+	gomock.InOrder(
+		serverFrontendClient.EXPECT().ListDomains(gomock.Any(), &types.ListDomainsRequest{PageSize: 1}).
+			Return(newPage("domain-1", []byte("token-1")), nil),
+		serverFrontendClient.EXPECT().ListDomains(gomock.Any(), &types.ListDomainsRequest{PageSize: 1, NextPageToken: []byte("token-1")}).
+			Return(newPage("domain-2", []byte("token-2")), nil),
+	)
+
+	cliContext := cli.NewContext(nil, flag.NewFlagSet("test", 0), nil)
+	var names []string
+	domainCLI.listAllDomains(cliContext, 1, func(domains []*types.DescribeDomainResponse) bool {
+		for _, domain := range domains {
+			names = append(names, domain.GetDomainInfo().GetName())
+		}
+		// stop after the second page, the third page must not be requested
+		return len(names) < 2
+	})
+	assert.Equal(t, []string{"domain-1", "domain-2"}, names)
+}
+
+func TestDomainDataMatches(t *testing.T) {
+	data := map[string]string{"IsManagedByCadence": "true", "team": "payments"}
+
+	assert.True(t, domainDataMatches(data, nil))
+	assert.True(t, domainDataMatches(data, map[string]string{"IsManagedByCadence": "true"}))
+	assert.True(t, domainDataMatches(data, map[string]string{"IsManagedByCadence": "true", "team": "payments"}))
+	assert.False(t, domainDataMatches(data, map[string]string{"IsManagedByCadence": "true", "team": "search"}))
+	assert.False(t, domainDataMatches(data, map[string]string{"tier": ""}))
+	assert.False(t, domainDataMatches(nil, map[string]string{"IsManagedByCadence": "true"}))
+}
@@ -84,9 +84,13 @@ var (
 			Usage: "Domain data of key value pairs (must be in key1=value1,key2=value2,...,keyN=valueN format, e.g. cluster=dca or cluster=dca,instance=cadence)",
 			Value: &flag.StringMap{},
 		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDPattern,
+			Usage: "Optional regular expression workflow IDs started in the domain must match from their start, e.g. payments- to reserve a prefix",
+		},
 		cli.StringFlag{
 			Name:  FlagSecurityTokenWithAlias,
-			Usage: "Optional token for security check",
+			Usage: "Optional token for security check, defaults to the security token of the environment or stored for the profile",
 		},
 		cli.StringFlag{
 			Name:  FlagHistoryArchivalStatusWithAlias,
@@ -104,9 +108,22 @@ var (
 			Name:  FlagVisibilityArchivalURIWithAlias,
 			Usage: "Optionally specify visibility archival URI (cannot be changed after first time archival is enabled)",
 		},
+		cli.BoolFlag{
+			Name:  FlagWait,
+			Usage: "Wait until the domain leaves PROVISIONING status when the cluster registers domains asynchronously",
+		},
+		cli.IntFlag{
+			Name:  FlagWaitTimeout,
+			Usage: "Optional timeout in seconds when waiting for domain provisioning",
+			Value: defaultDomainProvisioningWaitTimeoutInSeconds,
+		},
 	}
 
 	updateDomainFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagDomainID,
+			Usage: "Domain UUID (required if not specify domainName)",
+		},
 		cli.StringFlag{
 			Name:  FlagDescriptionWithAlias,
 			Usage: "Domain description",
@@ -135,9 +152,18 @@ var (
 			Usage: "Domain data of key value pairs (must be in key1=value1,key2=value2,...,keyN=valueN format, e.g. cluster=dca or cluster=dca,instance=cadence)",
 			Value: &flag.StringMap{},
 		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDPattern,
+			Usage: "Optional regular expression workflow IDs started in the domain must match from their start, e.g. payments- to reserve a prefix",
+		},
+		cli.StringFlag{
+			Name: FlagDomainMetadata,
+			Usage: "Domain metadata to upsert as a JSON object of registered metadata keys, a null value removes the key " +
+				`(e.g. '{"tier":"gold","owner":{"team":"platform"},"runbook":null}')`,
+		},
 		cli.StringFlag{
 			Name:  FlagSecurityTokenWithAlias,
-			Usage: "Optional token for security check",
+			Usage: "Optional token for security check, defaults to the security token of the environment or stored for the profile",
 		},
 		cli.StringFlag{
 			Name:  FlagHistoryArchivalStatusWithAlias,
@@ -165,7 +191,7 @@ var (
 		},
 		cli.StringFlag{
 			Name:  FlagReason,
-			Usage: "Reason for the operation",
+			Usage: "Required reason for the update or failover, recorded in the domain change history",
 		},
 		cli.StringFlag{
 			Name:  FlagFailoverTypeWithAlias,
@@ -179,14 +205,41 @@ var (
 	}
 
 	deprecateDomainFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagDomainID,
+			Usage: "Domain UUID (required if not specify domainName)",
+		},
 		cli.StringFlag{
 			Name:  FlagSecurityTokenWithAlias,
-			Usage: "Optional token for security check",
+			Usage: "Optional token for security check, defaults to the security token of the environment or stored for the profile",
 		},
 		cli.BoolFlag{
 			Name:  FlagForce,
 			Usage: "Deprecate domain regardless of domain history.",
 		},
+		cli.StringFlag{
+			Name:  FlagReason,
+			Usage: "Required reason for the deprecation, recorded in the domain change history",
+		},
+		cli.BoolFlag{
+			Name:  FlagYes,
+			Usage: "Optional flag to deprecate without retyping the domain name to confirm",
+		},
+	}
+
+	quarantineDomainFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagDomainID,
+			Usage: "Domain UUID (required if not specify domainName)",
+		},
+		cli.StringFlag{
+			Name:  FlagSecurityTokenWithAlias,
+			Usage: "Optional token for security check, defaults to the security token of the environment or stored for the profile",
+		},
+		cli.StringFlag{
+			Name:  FlagReason,
+			Usage: "Required reason for the change, recorded in the domain change history",
+		},
 	}
 
 	describeDomainFlags = []cli.Flag{
@@ -198,6 +251,18 @@ var (
 			Name:  FlagPrintJSONWithAlias,
 			Usage: "Print in raw JSON format",
 		},
+		cli.StringFlag{
+			Name:  FlagFormat,
+			Usage: "Output format [table, json, jsonl]. json output is stable across runs, so snapshots can be diffed",
+		},
+		cli.BoolFlag{
+			Name:  FlagHistory,
+			Usage: "Also show the change history recorded for the domain",
+		},
+		cli.BoolFlag{
+			Name:  FlagReplication,
+			Usage: "Also show the event time of the latest history event replicated to the current cluster",
+		},
 	}
 
 	adminDomainCommonFlags = getDBFlags()
@@ -217,6 +282,11 @@ var (
 		adminDomainCommonFlags...,
 	)
 
+	adminQuarantineDomainFlags = append(
+		quarantineDomainFlags,
+		adminDomainCommonFlags...,
+	)
+
 	adminDescribeDomainFlags = append(
 		updateDomainFlags,
 		adminDomainCommonFlags...,
@@ -290,6 +360,7 @@ func initializeDomainHandler(
 		initializeDomainReplicator(logger),
 		archivalMetadata,
 		archiverProvider,
+		nil,
 		clock.NewRealTimeSource(),
 	)
 }
@@ -0,0 +1,74 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/urfave/cli"
+)
+
+// dryRunPayloadFields are the request fields carrying JSON payloads, they are printed decoded instead of base64 encoded
+var dryRunPayloadFields = []string{"input", "details"}
+
+// isDryRun returns true if --dry-run is set, mutating commands then print their requests instead of sending them
+func isDryRun(c *cli.Context) bool {
+	return c.GlobalBool(FlagGlobalDryRun)
+}
+
+// printDryRunRequest prints the request a mutating command is about to send and returns true if --dry-run is set,
+// the command must then return without sending it
+func printDryRunRequest(c *cli.Context, operation string, request interface{}) bool {
+	if !isDryRun(c) {
+		return false
+	}
+	fmt.Printf("Dry run, the %s request is not sent:\n", operation)
+	RenderJSON(getOutput(), getDryRunRequestView(request))
+	return true
+}
+
+// getDryRunRequestView returns the JSON fields of the request with the security token redacted
+// and the JSON payloads decoded
+func getDryRunRequestView(request interface{}) interface{} {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return request
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return request
+	}
+	if _, ok := fields["securityToken"]; ok {
+		fields["securityToken"] = auditRedactedValue
+	}
+	for _, name := range dryRunPayloadFields {
+		encoded, ok := fields[name].(string)
+		if !ok {
+			continue
+		}
+		if payload, err := base64.StdEncoding.DecodeString(encoded); err == nil && json.Valid(payload) {
+			fields[name] = json.RawMessage(payload)
+		}
+	}
+	return fields
+}
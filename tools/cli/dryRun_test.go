@@ -0,0 +1,54 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestGetDryRunRequestView(t *testing.T) {
+	view := getDryRunRequestView(&types.DeprecateDomainRequest{
+		Name:          "test-domain",
+		SecurityToken: "token",
+	})
+	assert.Equal(t, map[string]interface{}{
+		"name":          "test-domain",
+		"securityToken": auditRedactedValue,
+	}, view)
+
+	view = getDryRunRequestView(&types.StartWorkflowExecutionRequest{
+		WorkflowID: "wid",
+		Input:      []byte(`{"query":"WorkflowType='test'"}`),
+	})
+	var buf bytes.Buffer
+	RenderJSON(&buf, view)
+	assert.Contains(t, buf.String(), `"input": {`)
+	assert.Contains(t, buf.String(), `"query": "WorkflowType='test'"`)
+
+	// payloads which are not JSON stay encoded
+	view = getDryRunRequestView(&types.StartWorkflowExecutionRequest{Input: []byte("not json")})
+	assert.Equal(t, map[string]interface{}{"input": "bm90IGpzb24="}, view)
+}
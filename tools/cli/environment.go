@@ -0,0 +1,96 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"crypto/tls"
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	cliconfig "github.com/uber/cadence/tools/cli/config"
+)
+
+var (
+	// cliEnvironment is the environment selected with --env, nil if none is selected
+	cliEnvironment *cliconfig.Environment
+	// cliEnvironmentTLS is the TLS configuration of the frontend connection of the selected environment,
+	// nil if TLS is not enabled
+	cliEnvironmentTLS *tls.Config
+)
+
+// loadEnvironment selects the environment passed with --env and uses its settings for the global
+// options which are not set on the command line or with environment variables
+func loadEnvironment(c *cli.Context) error {
+	cliEnvironment = nil
+	cliEnvironmentTLS = nil
+	name := c.GlobalString(FlagEnv)
+	if name == "" {
+		return nil
+	}
+
+	path := c.GlobalString(FlagConfigFile)
+	cfg, err := cliconfig.Load(path)
+	if err != nil {
+		return err
+	}
+	env, err := cfg.Environment(name)
+	if err != nil {
+		return fmt.Errorf("%v, see %s", err, path)
+	}
+
+	defaults := []struct {
+		flag  string
+		value string
+	}{
+		{FlagAddress, env.Address},
+		{FlagTransport, env.Transport},
+		{FlagDomain, env.Domain},
+	}
+	for _, d := range defaults {
+		if d.value == "" || c.GlobalIsSet(d.flag) {
+			continue
+		}
+		if err := c.GlobalSet(d.flag, d.value); err != nil {
+			return fmt.Errorf("invalid %s of environment %s: %v", d.flag, name, err)
+		}
+	}
+
+	tlsConfig, err := env.TLS.ToTLSConfig()
+	if err != nil {
+		return fmt.Errorf("invalid TLS settings of environment %s: %v", name, err)
+	}
+	if tlsConfig != nil && c.GlobalString(FlagTransport) != grpcTransport {
+		return fmt.Errorf("TLS of environment %s requires the %s transport", name, grpcTransport)
+	}
+
+	cliEnvironment = env
+	cliEnvironmentTLS = tlsConfig
+	return nil
+}
+
+// getEnvironmentSecurityToken returns the security token of the selected environment, empty if there is none
+func getEnvironmentSecurityToken() string {
+	if cliEnvironment == nil {
+		return ""
+	}
+	return cliEnvironment.SecurityToken
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/urfave/cli"
+	"go.uber.org/yarpc/yarpcerrors"
+
+	"github.com/uber/cadence/common/types"
+)
+
+// Exit codes of the CLI, scripts wrapping the CLI can branch on them to tell failure causes apart
+const (
+	// ExitCodeError is the exit code of failures without a more specific code, e.g. invalid options
+	ExitCodeError = 1
+	// ExitCodeNotFound is the exit code when the domain, workflow or other entity of the command does not exist
+	ExitCodeNotFound = 3
+	// ExitCodeAlreadyExists is the exit code when the domain or workflow to create already exists
+	ExitCodeAlreadyExists = 4
+	// ExitCodeTimeout is the exit code when the request timed out
+	ExitCodeTimeout = 5
+	// ExitCodeAccessDenied is the exit code when the request is not authorized
+	ExitCodeAccessDenied = 6
+)
+
+const (
+	errorFormatText = "text"
+	errorFormatJSON = "json"
+)
+
+// errorFormat is how ErrorAndExit reports errors, set from the global error-format flag
+var errorFormat = errorFormatText
+
+// errorOutput is the error written by ErrorAndExit with --error-format json
+type errorOutput struct {
+	Message  string `json:"message"`
+	Details  string `json:"details,omitempty"`
+	Type     string `json:"type,omitempty"`
+	ExitCode int    `json:"exitCode"`
+}
+
+func setErrorFormat(c *cli.Context) error {
+	switch format := c.GlobalString(FlagErrorFormat); format {
+	case "", errorFormatText:
+		errorFormat = errorFormatText
+	case errorFormatJSON:
+		errorFormat = errorFormatJSON
+	default:
+		return fmt.Errorf("invalid %s %q, valid values are %s and %s", FlagErrorFormat, format, errorFormatText, errorFormatJSON)
+	}
+	return nil
+}
+
+// getExitCode maps the error a command failed with to its exit code
+func getExitCode(err error) int {
+	var (
+		entityNotExists       *types.EntityNotExistsError
+		domainAlreadyExists   *types.DomainAlreadyExistsError
+		workflowAlreadyExists *types.WorkflowExecutionAlreadyStartedError
+		accessDenied          *types.AccessDeniedError
+	)
+	switch {
+	case err == nil:
+		return ExitCodeError
+	case errors.As(err, &entityNotExists):
+		return ExitCodeNotFound
+	case errors.As(err, &domainAlreadyExists), errors.As(err, &workflowAlreadyExists):
+		return ExitCodeAlreadyExists
+	case errors.Is(err, context.DeadlineExceeded), yarpcerrors.IsDeadlineExceeded(err):
+		return ExitCodeTimeout
+	case errors.As(err, &accessDenied), yarpcerrors.IsPermissionDenied(err), yarpcerrors.IsUnauthenticated(err):
+		return ExitCodeAccessDenied
+	default:
+		return ExitCodeError
+	}
+}
+
+func printJSONError(w io.Writer, msg string, err error, exitCode int) {
+	output := errorOutput{
+		Message:  msg,
+		ExitCode: exitCode,
+	}
+	if err != nil {
+		output.Details = err.Error()
+		output.Type = reflect.Indirect(reflect.ValueOf(err)).Type().Name()
+	}
+	RenderJSON(w, output)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/yarpc/yarpcerrors"
+
+	"github.com/uber/cadence/common/types"
+)
+
+func TestGetExitCode(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err      error
+		exitCode int
+	}{
+		"no error":              {err: nil, exitCode: ExitCodeError},
+		"bad request":           {err: &types.BadRequestError{}, exitCode: ExitCodeError},
+		"entity not exists":     {err: &types.EntityNotExistsError{}, exitCode: ExitCodeNotFound},
+		"wrapped not exists":    {err: fmt.Errorf("describe: %w", &types.EntityNotExistsError{}), exitCode: ExitCodeNotFound},
+		"domain exists":         {err: &types.DomainAlreadyExistsError{}, exitCode: ExitCodeAlreadyExists},
+		"workflow started":      {err: &types.WorkflowExecutionAlreadyStartedError{}, exitCode: ExitCodeAlreadyExists},
+		"context deadline":      {err: context.DeadlineExceeded, exitCode: ExitCodeTimeout},
+		"yarpc deadline":        {err: yarpcerrors.DeadlineExceededErrorf("timeout"), exitCode: ExitCodeTimeout},
+		"access denied":         {err: &types.AccessDeniedError{}, exitCode: ExitCodeAccessDenied},
+		"yarpc unauthenticated": {err: yarpcerrors.UnauthenticatedErrorf("no token"), exitCode: ExitCodeAccessDenied},
+	} {
+		assert.Equal(t, tc.exitCode, getExitCode(tc.err), name)
+	}
+}
+
+func TestPrintJSONError(t *testing.T) {
+	var buf bytes.Buffer
+	printJSONError(&buf, "Failed to describe domain.", &types.EntityNotExistsError{Message: "domain not found"}, ExitCodeNotFound)
+	var output errorOutput
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+	assert.Equal(t, errorOutput{
+		Message:  "Failed to describe domain.",
+		Details:  "EntityNotExistsError{Message: domain not found}",
+		Type:     "EntityNotExistsError",
+		ExitCode: ExitCodeNotFound,
+	}, output)
+
+	buf.Reset()
+	printJSONError(&buf, "Option domain is required", nil, ExitCodeError)
+	assert.JSONEq(t, `{"message": "Option domain is required", "exitCode": 1}`, buf.String())
+}
@@ -31,23 +31,33 @@ import (
 	"github.com/urfave/cli"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/peer"
+	"go.uber.org/yarpc/peer/hostport"
+	"go.uber.org/yarpc/yarpcerrors"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
 
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	serverAdmin "github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	serverFrontend "github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/.gen/go/health/metaclient"
 	adminv1 "github.com/uber/cadence/.gen/proto/admin/v1"
 
 	"github.com/uber/cadence/client/admin"
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	cc "github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/proto"
+	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
 const (
 	cadenceClientName      = "cadence-client"
 	cadenceFrontendService = "cadence-frontend"
+	cadenceMatchingService = "cadence-matching"
 )
 
 // ContextKey is an alias for string, used as context key
@@ -63,15 +73,25 @@ type ClientFactory interface {
 	ServerFrontendClient(c *cli.Context) frontend.Client
 	ServerAdminClient(c *cli.Context) admin.Client
 
+	ServerFrontendClientForAddress(c *cli.Context, hostPort string) frontend.Client
+	ServerAdminClientForAddress(c *cli.Context, hostPort string) admin.Client
+	ServerHealthClientForAddress(c *cli.Context, serviceName, hostPort string) HealthClient
+
 	ElasticSearchClient(c *cli.Context) *elastic.Client
 
 	ServerConfig(c *cli.Context) (*config.Config, error)
 }
 
+// HealthClient checks the health of a single cadence service host
+type HealthClient interface {
+	Health(ctx context.Context) (*types.HealthStatus, error)
+}
+
 type clientFactory struct {
-	hostPort   string
-	dispatcher *yarpc.Dispatcher
-	logger     *zap.Logger
+	hostPort          string
+	dispatcher        *yarpc.Dispatcher
+	remoteDispatchers map[string]*yarpc.Dispatcher
+	logger            *zap.Logger
 }
 
 // NewClientFactory creates a new ClientFactory
@@ -82,7 +102,8 @@ func NewClientFactory() ClientFactory {
 	}
 
 	return &clientFactory{
-		logger: logger,
+		remoteDispatchers: make(map[string]*yarpc.Dispatcher),
+		logger:            logger,
 	}
 }
 
@@ -101,26 +122,107 @@ func (b *clientFactory) ServerConfig(c *cli.Context) (*config.Config, error) {
 // ServerFrontendClient builds a frontend client (based on server side thrift interface)
 func (b *clientFactory) ServerFrontendClient(c *cli.Context) frontend.Client {
 	b.ensureDispatcher(c)
-	clientConfig := b.dispatcher.ClientConfig(cadenceFrontendService)
+	return newServerFrontendClient(c, b.dispatcher)
+}
+
+// ServerAdminClient builds an admin client (based on server side thrift interface)
+func (b *clientFactory) ServerAdminClient(c *cli.Context) admin.Client {
+	b.ensureDispatcher(c)
+	return newServerAdminClient(c, b.dispatcher)
+}
+
+// ServerFrontendClientForAddress builds a frontend client for the cluster serving at the given address
+func (b *clientFactory) ServerFrontendClientForAddress(c *cli.Context, hostPort string) frontend.Client {
+	return newServerFrontendClient(c, b.ensureRemoteDispatcher(c, hostPort))
+}
+
+// ServerAdminClientForAddress builds an admin client for the cluster serving at the given address
+func (b *clientFactory) ServerAdminClientForAddress(c *cli.Context, hostPort string) admin.Client {
+	return newServerAdminClient(c, b.ensureRemoteDispatcher(c, hostPort))
+}
+
+// ServerHealthClientForAddress builds a health check client for the given service host
+func (b *clientFactory) ServerHealthClientForAddress(c *cli.Context, serviceName, hostPort string) HealthClient {
+	clientConfig := b.newDispatcherForService(c, serviceName, hostPort).ClientConfig(serviceName)
 	if c.GlobalString(FlagTransport) == grpcTransport {
-		return frontend.NewGRPCClient(
+		return &grpcHealthClient{client: apiv1.NewMetaAPIYARPCClient(clientConfig)}
+	}
+	return &thriftHealthClient{client: metaclient.New(clientConfig)}
+}
+
+type thriftHealthClient struct {
+	client metaclient.Interface
+}
+
+func (h *thriftHealthClient) Health(ctx context.Context) (*types.HealthStatus, error) {
+	response, err := h.client.Health(ctx)
+	return thrift.ToHealthStatus(response), thrift.ToError(err)
+}
+
+type grpcHealthClient struct {
+	client apiv1.MetaAPIYARPCClient
+}
+
+func (h *grpcHealthClient) Health(ctx context.Context) (*types.HealthStatus, error) {
+	response, err := h.client.Health(ctx, &apiv1.HealthRequest{})
+	return proto.ToHealthResponse(response), proto.ToError(err)
+}
+
+func newServerFrontendClient(c *cli.Context, dispatcher *yarpc.Dispatcher) frontend.Client {
+	clientConfig := dispatcher.ClientConfig(cadenceFrontendService)
+	var client frontend.Client
+	if c.GlobalString(FlagTransport) == grpcTransport {
+		client = frontend.NewGRPCClient(
 			apiv1.NewDomainAPIYARPCClient(clientConfig),
 			apiv1.NewWorkflowAPIYARPCClient(clientConfig),
 			apiv1.NewWorkerAPIYARPCClient(clientConfig),
 			apiv1.NewVisibilityAPIYARPCClient(clientConfig),
 		)
+	} else {
+		client = frontend.NewThriftClient(serverFrontend.New(clientConfig))
+	}
+	if policy := getRetryPolicy(c); policy != nil {
+		client = frontend.NewRetryableClient(client, policy, isRetryableCLIError)
 	}
-	return frontend.NewThriftClient(serverFrontend.New(clientConfig))
+	return client
 }
 
-// ServerAdminClient builds an admin client (based on server side thrift interface)
-func (b *clientFactory) ServerAdminClient(c *cli.Context) admin.Client {
-	b.ensureDispatcher(c)
-	clientConfig := b.dispatcher.ClientConfig(cadenceFrontendService)
+func newServerAdminClient(c *cli.Context, dispatcher *yarpc.Dispatcher) admin.Client {
+	clientConfig := dispatcher.ClientConfig(cadenceFrontendService)
+	var client admin.Client
 	if c.GlobalString(FlagTransport) == grpcTransport {
-		return admin.NewGRPCClient(adminv1.NewAdminAPIYARPCClient(clientConfig))
+		client = admin.NewGRPCClient(adminv1.NewAdminAPIYARPCClient(clientConfig))
+	} else {
+		client = admin.NewThriftClient(serverAdmin.New(clientConfig))
+	}
+	if policy := getRetryPolicy(c); policy != nil {
+		client = admin.NewRetryableClient(client, policy, isRetryableCLIError)
+	}
+	return client
+}
+
+// getRetryPolicy returns the retry policy for RPC calls, or nil when retries are disabled
+func getRetryPolicy(c *cli.Context) backoff.RetryPolicy {
+	maxRetries := c.GlobalInt(FlagMaxRetries)
+	if maxRetries <= 0 {
+		return nil
+	}
+
+	backoffInterval := time.Duration(c.GlobalInt(FlagRetryBackoffInMs)) * time.Millisecond
+	policy := backoff.NewExponentialRetryPolicy(backoffInterval)
+	policy.SetMaximumAttempts(maxRetries)
+	return policy
+}
+
+// isRetryableCLIError only retries errors which indicate the request was not processed by the server
+func isRetryableCLIError(err error) bool {
+	if common.IsServiceBusyError(err) {
+		return true
 	}
-	return admin.NewThriftClient(serverAdmin.New(clientConfig))
+	if _, ok := err.(*yarpcerrors.Status); ok {
+		return yarpcerrors.IsUnavailable(err)
+	}
+	return false
 }
 
 // ElasticSearchClient builds an ElasticSearch client
@@ -143,37 +245,60 @@ func (b *clientFactory) ensureDispatcher(c *cli.Context) {
 	if b.dispatcher != nil {
 		return
 	}
-	shouldUseGrpc := c.GlobalString(FlagTransport) == grpcTransport
 
 	b.hostPort = tchannelPort
-	if shouldUseGrpc {
+	if c.GlobalString(FlagTransport) == grpcTransport {
 		b.hostPort = grpcPort
 	}
 	if addr := c.GlobalString(FlagAddress); addr != "" {
 		b.hostPort = addr
 	}
+	b.dispatcher = b.newDispatcher(c, b.hostPort)
+}
+
+func (b *clientFactory) ensureRemoteDispatcher(c *cli.Context, hostPort string) *yarpc.Dispatcher {
+	if dispatcher, ok := b.remoteDispatchers[hostPort]; ok {
+		return dispatcher
+	}
+	dispatcher := b.newDispatcher(c, hostPort)
+	b.remoteDispatchers[hostPort] = dispatcher
+	return dispatcher
+}
 
-	outbounds := transport.Outbounds{Unary: grpc.NewTransport().NewSingleOutbound(b.hostPort)}
+func (b *clientFactory) newDispatcher(c *cli.Context, hostPort string) *yarpc.Dispatcher {
+	return b.newDispatcherForService(c, cadenceFrontendService, hostPort)
+}
+
+func (b *clientFactory) newDispatcherForService(c *cli.Context, serviceName, hostPort string) *yarpc.Dispatcher {
+	shouldUseGrpc := c.GlobalString(FlagTransport) == grpcTransport
+
+	outbounds := transport.Outbounds{Unary: grpc.NewTransport().NewSingleOutbound(hostPort)}
+	if cliEnvironmentTLS != nil {
+		grpcTransport := grpc.NewTransport()
+		dialer := grpcTransport.NewDialer(grpc.DialerCredentials(credentials.NewTLS(cliEnvironmentTLS)))
+		outbounds = transport.Outbounds{Unary: grpcTransport.NewOutbound(peer.NewSingle(hostport.Identify(hostPort), dialer))}
+	}
 	if !shouldUseGrpc {
 		ch, err := tchannel.NewChannelTransport(tchannel.ServiceName(cadenceClientName), tchannel.ListenAddr("127.0.0.1:0"))
 		if err != nil {
 			b.logger.Fatal("Failed to create transport channel", zap.Error(err))
 		}
-		outbounds = transport.Outbounds{Unary: ch.NewSingleOutbound(b.hostPort)}
+		outbounds = transport.Outbounds{Unary: ch.NewSingleOutbound(hostPort)}
 	}
 
-	b.dispatcher = yarpc.NewDispatcher(yarpc.Config{
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
 		Name:      cadenceClientName,
-		Outbounds: yarpc.Outbounds{cadenceFrontendService: outbounds},
+		Outbounds: yarpc.Outbounds{serviceName: outbounds},
 		OutboundMiddleware: yarpc.OutboundMiddleware{
 			Unary: &versionMiddleware{},
 		},
 	})
 
-	if err := b.dispatcher.Start(); err != nil {
-		b.dispatcher.Stop()
+	if err := dispatcher.Start(); err != nil {
+		dispatcher.Stop()
 		b.logger.Fatal("Failed to create outbound transport channel: %v", zap.Error(err))
 	}
+	return dispatcher
 }
 
 type versionMiddleware struct {
@@ -191,7 +316,10 @@ func (vm *versionMiddleware) Call(ctx context.Context, request *transport.Reques
 }
 
 func getJWT(c *cli.Context) string {
-	return c.GlobalString(FlagJWT)
+	if jwt := c.GlobalString(FlagJWT); jwt != "" {
+		return jwt
+	}
+	return getProfileCredential(c, credentialJWT)
 }
 
 func getJWTPrivateKey(c *cli.Context) string {
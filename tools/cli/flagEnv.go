@@ -0,0 +1,168 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/urfave/cli"
+)
+
+// flagEnvVarPrefix is the prefix of the environment variables of the flags, e.g. CADENCE_WORKFLOW_ID sets --workflow_id
+const flagEnvVarPrefix = "CADENCE_"
+
+// getFlagEnvVar returns the environment variable of a flag by the CADENCE_FLAG_NAME convention
+func getFlagEnvVar(flagName string) string {
+	return flagEnvVarPrefix + strings.ToUpper(strings.Replace(getFlagName(flagName), "-", "_", -1))
+}
+
+// enableFlagEnvVars lets every flag of the app be set from its environment variable. Flags which already
+// have environment variables keep them, the conventional one is looked up after them.
+func enableFlagEnvVars(app *cli.App) {
+	app.Flags = withFlagEnvVars(app.Flags)
+	setCommandFlagEnvVars(app.Commands)
+}
+
+func setCommandFlagEnvVars(commands []cli.Command) {
+	for i := range commands {
+		command := &commands[i]
+		command.Flags = withFlagEnvVars(command.Flags)
+		if len(command.Subcommands) > 0 {
+			setCommandFlagEnvVars(command.Subcommands)
+			continue
+		}
+		command.Before = printFlagSourcesBefore(command.Before)
+	}
+}
+
+// withFlagEnvVars returns a copy of the flags with their environment variables set, the flag slices are
+// shared by several commands so they are not modified in place
+func withFlagEnvVars(flags []cli.Flag) []cli.Flag {
+	result := make([]cli.Flag, 0, len(flags))
+	for _, flag := range flags {
+		result = append(result, withFlagEnvVar(flag))
+	}
+	return result
+}
+
+func withFlagEnvVar(flag cli.Flag) cli.Flag {
+	value := reflect.ValueOf(flag)
+	if value.Kind() != reflect.Struct {
+		return flag
+	}
+	flagCopy := reflect.New(value.Type()).Elem()
+	flagCopy.Set(value)
+	field := flagCopy.FieldByName("EnvVar")
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return flag
+	}
+
+	envVar := getFlagEnvVar(flag.GetName())
+	envVars := getFlagEnvVars(flag)
+	for _, existing := range envVars {
+		if existing == envVar {
+			return flag
+		}
+	}
+	field.SetString(strings.Join(append(envVars, envVar), ","))
+	return flagCopy.Interface().(cli.Flag)
+}
+
+// getFlagEnvVars returns the environment variables a flag is read from, in the order they are looked up
+func getFlagEnvVars(flag cli.Flag) []string {
+	value := reflect.ValueOf(flag)
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	field := value.FieldByName("EnvVar")
+	if !field.IsValid() || field.Kind() != reflect.String {
+		return nil
+	}
+	var envVars []string
+	for _, envVar := range strings.Split(field.String(), ",") {
+		if envVar = strings.TrimSpace(envVar); envVar != "" {
+			envVars = append(envVars, envVar)
+		}
+	}
+	return envVars
+}
+
+func printFlagSourcesBefore(before cli.BeforeFunc) cli.BeforeFunc {
+	return func(c *cli.Context) error {
+		if c.GlobalBool(FlagVerbose) {
+			printFlagSources(os.Stderr, c, os.Args)
+		}
+		if before != nil {
+			return before(c)
+		}
+		return nil
+	}
+}
+
+// printFlagSources writes where the value of each flag set for the command comes from, the values themselves
+// are not written as they may be credentials
+func printFlagSources(w io.Writer, c *cli.Context, args []string) {
+	root := c
+	for root.Parent() != nil {
+		root = root.Parent()
+	}
+	for _, flag := range root.App.Flags {
+		if name := getFlagName(flag.GetName()); root.IsSet(name) {
+			fmt.Fprintf(w, "--%s: %s\n", name, getFlagSource(flag, args))
+		}
+	}
+	for _, flag := range c.Command.Flags {
+		if name := getFlagName(flag.GetName()); c.IsSet(name) {
+			fmt.Fprintf(w, "--%s: %s\n", name, getFlagSource(flag, args))
+		}
+	}
+}
+
+// getFlagSource returns where the value of a set flag comes from: the command line arguments, which take
+// precedence, one of its environment variables, or else the environment selected from the config file
+func getFlagSource(flag cli.Flag, args []string) string {
+	names := make(map[string]struct{})
+	for _, name := range strings.Split(flag.GetName(), ",") {
+		names[strings.TrimSpace(name)] = struct{}{}
+	}
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+		name := strings.SplitN(strings.TrimLeft(arg, "-"), "=", 2)[0]
+		if _, ok := names[name]; ok {
+			return "command line"
+		}
+	}
+	for _, envVar := range getFlagEnvVars(flag) {
+		if _, ok := os.LookupEnv(envVar); ok {
+			return "environment variable " + envVar
+		}
+	}
+	return "config file"
+}
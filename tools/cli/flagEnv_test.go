@@ -0,0 +1,83 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli"
+)
+
+func TestGetFlagEnvVar(t *testing.T) {
+	assert.Equal(t, "CADENCE_WORKFLOW_ID", getFlagEnvVar(FlagWorkflowIDWithAlias))
+	assert.Equal(t, "CADENCE_ERROR_FORMAT", getFlagEnvVar(FlagErrorFormat))
+}
+
+func TestWithFlagEnvVar(t *testing.T) {
+	f := withFlagEnvVar(cli.StringFlag{Name: FlagWorkflowIDWithAlias})
+	assert.Equal(t, []string{"CADENCE_WORKFLOW_ID"}, getFlagEnvVars(f))
+
+	f = withFlagEnvVar(cli.StringFlag{Name: FlagDomainWithAlias, EnvVar: "CADENCE_CLI_DOMAIN"})
+	assert.Equal(t, []string{"CADENCE_CLI_DOMAIN", "CADENCE_DOMAIN"}, getFlagEnvVars(f))
+
+	f = withFlagEnvVar(cli.IntFlag{Name: FlagRPS, EnvVar: "CADENCE_RPS"})
+	assert.Equal(t, []string{"CADENCE_RPS"}, getFlagEnvVars(f))
+
+	original := []cli.Flag{cli.BoolFlag{Name: FlagVerbose}}
+	withFlagEnvVars(original)
+	assert.Empty(t, getFlagEnvVars(original[0]))
+}
+
+func TestGetFlagSource(t *testing.T) {
+	f := withFlagEnvVar(cli.StringFlag{Name: FlagWorkflowIDWithAlias})
+	assert.Equal(t, "command line", getFlagSource(f, []string{"cadence", "wf", "describe", "-w", "wid"}))
+	assert.Equal(t, "command line", getFlagSource(f, []string{"cadence", "wf", "describe", "--workflow_id=wid"}))
+	assert.Equal(t, "config file", getFlagSource(f, []string{"cadence", "wf", "describe", "--", "-w"}))
+
+	os.Setenv("CADENCE_WORKFLOW_ID", "wid")
+	defer os.Unsetenv("CADENCE_WORKFLOW_ID")
+	assert.Equal(t, "environment variable CADENCE_WORKFLOW_ID", getFlagSource(f, []string{"cadence", "wf", "describe"}))
+	assert.Equal(t, "command line", getFlagSource(f, []string{"cadence", "wf", "describe", "--wid", "wid"}))
+}
+
+func TestPrintFlagSources(t *testing.T) {
+	app := cli.NewApp()
+	app.Flags = []cli.Flag{cli.StringFlag{Name: FlagDomainWithAlias}}
+	command := cli.Command{Name: "describe", Flags: []cli.Flag{cli.StringFlag{Name: FlagWorkflowIDWithAlias}, cli.StringFlag{Name: FlagRunID}}}
+
+	globalSet := flag.NewFlagSet("global", flag.ContinueOnError)
+	globalSet.String(FlagDomain, "", "")
+	assert.NoError(t, globalSet.Parse([]string{"--domain", "test-domain"}))
+	commandSet := flag.NewFlagSet("describe", flag.ContinueOnError)
+	commandSet.String(FlagWorkflowID, "", "")
+	commandSet.String(FlagRunID, "", "")
+	assert.NoError(t, commandSet.Parse([]string{"--workflow_id", "wid"}))
+	c := cli.NewContext(app, commandSet, cli.NewContext(app, globalSet, nil))
+	c.Command = command
+
+	var buf bytes.Buffer
+	printFlagSources(&buf, c, []string{"cadence", "--domain", "test-domain", "describe", "--workflow_id", "wid"})
+	assert.Equal(t, "--domain: command line\n--workflow_id: command line\n", buf.String())
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+)
+
+// getEnumOption returns the value of an option that only accepts a fixed set of values, matched
+// case-insensitively and normalized to the spelling in validValues. An empty string is returned when
+// the option is empty, so callers keep their own defaults. Any other value exits with the list of
+// valid values and, when the value looks like a typo, the closest valid value
+func getEnumOption(c *cli.Context, optionName string, validValues []string) string {
+	value := c.String(optionName)
+	if value == "" {
+		return ""
+	}
+	return mustParseEnumValue(optionName, value, validValues)
+}
+
+// getRequiredEnumOption is getEnumOption for required options
+func getRequiredEnumOption(c *cli.Context, optionName string, validValues []string) string {
+	return mustParseEnumValue(optionName, getRequiredOption(c, optionName), validValues)
+}
+
+func mustParseEnumValue(optionName, value string, validValues []string) string {
+	parsed, err := parseEnumValue(optionName, value, validValues)
+	if err != nil {
+		ErrorAndExit(optionErr, err)
+	}
+	return parsed
+}
+
+func parseEnumValue(optionName, value string, validValues []string) (string, error) {
+	for _, valid := range validValues {
+		if strings.EqualFold(value, valid) {
+			return valid, nil
+		}
+	}
+
+	sorted := append([]string(nil), validValues...)
+	sort.Strings(sorted)
+	msg := fmt.Sprintf("invalid value %q for option %s, valid values are [%s]",
+		value, optionName, strings.Join(sorted, ", "))
+	if suggestion := suggestEnumValue(value, sorted); suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	return "", errors.New(msg)
+}
+
+// suggestEnumValue returns the valid value closest to a mistyped one, or an empty string when none of
+// them is close enough to be what the user meant
+func suggestEnumValue(value string, validValues []string) string {
+	value = strings.ToLower(value)
+	suggestion := ""
+	bestDistance := len(value)/3 + 1
+	for _, valid := range validValues {
+		lowerValid := strings.ToLower(valid)
+		if len(value) > 1 && (strings.HasPrefix(lowerValid, value) || strings.HasPrefix(value, lowerValid)) {
+			return valid
+		}
+		if distance := editDistance(value, lowerValid); distance <= bestDistance {
+			suggestion, bestDistance = valid, distance
+		}
+	}
+	return suggestion
+}
+
+// editDistance is the Damerau-Levenshtein distance, so swapped letters count as a single edit
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			d[i][j] = common.MinInt(d[i-1][j]+1, common.MinInt(d[i][j-1]+1, d[i-1][j-1]+cost))
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = common.MinInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ra)][len(rb)]
+}
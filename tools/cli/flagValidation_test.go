@@ -0,0 +1,53 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnumValue(t *testing.T) {
+	value, err := parseEnumValue(FlagTaskListType, "Activity", taskListTypeNames)
+	require.NoError(t, err)
+	assert.Equal(t, taskListTypeActivity, value)
+
+	value, err = parseEnumValue(FlagResetType, "lastdecisioncompleted", mapKeysToArray(resetTypesMap))
+	require.NoError(t, err)
+	assert.Equal(t, resetTypeLastDecisionCompleted, value)
+
+	_, err = parseEnumValue(FlagHistoryArchivalStatus, "enabeld", archivalStatuses)
+	assert.EqualError(t, err, `invalid value "enabeld" for option history_archival_status, valid values are [disabled, enabled], did you mean "enabled"?`)
+
+	_, err = parseEnumValue(FlagTaskListType, "workflow", taskListTypeNames)
+	assert.EqualError(t, err, `invalid value "workflow" for option tasklisttype, valid values are [activity, decision]`)
+}
+
+func TestSuggestEnumValue(t *testing.T) {
+	assert.Equal(t, "activity", suggestEnumValue("activty", taskListTypeNames))
+	assert.Equal(t, "grace", suggestEnumValue("graceful", failoverTypes))
+	assert.Equal(t, "force", suggestEnumValue("forc", failoverTypes))
+	assert.Equal(t, "not_open", suggestEnumValue("not-open", []string{"not_open", "not_completed_cleanly"}))
+	assert.Equal(t, "", suggestEnumValue("x", failoverTypes))
+	assert.Equal(t, "", suggestEnumValue("enabled", taskListTypeNames))
+}
@@ -38,6 +38,8 @@ const (
 	FlagDBPort                            = "db_port"
 	FlagDBRegion                          = "db_region"
 	FlagHistoryAddressWithAlias           = FlagHistoryAddress + ", had"
+	FlagMatchingAddress                   = "matching_address"
+	FlagMatchingAddressWithAlias          = FlagMatchingAddress + ", mad"
 	FlagProtoVersion                      = "protocol_version"
 	FlagDomainID                          = "domain_id"
 	FlagDomain                            = "domain"
@@ -64,6 +66,8 @@ const (
 	FlagTaskListWithAlias                 = FlagTaskList + ", tl"
 	FlagTaskListType                      = "tasklisttype"
 	FlagTaskListTypeWithAlias             = FlagTaskListType + ", tlt"
+	FlagTaskListStatus                    = "status"
+	FlagTaskListTag                       = "tag"
 	FlagWorkflowIDReusePolicy             = "workflowidreusepolicy"
 	FlagWorkflowIDReusePolicyAlias        = FlagWorkflowIDReusePolicy + ", wrp"
 	FlagCronSchedule                      = "cron"
@@ -107,6 +111,8 @@ const (
 	FlagIdentity                          = "identity"
 	FlagDetail                            = "detail"
 	FlagReason                            = "reason"
+	FlagHistory                           = "history"
+	FlagReplication                       = "replication"
 	FlagReasonWithAlias                   = FlagReason + ", re"
 	FlagOpen                              = "open"
 	FlagOpenWithAlias                     = FlagOpen + ", op"
@@ -114,6 +120,7 @@ const (
 	FlagMoreWithAlias                     = FlagMore + ", m"
 	FlagAll                               = "all"
 	FlagPrefix                            = "prefix"
+	FlagDataFilter                        = "data_filter"
 	FlagAllWithAlias                      = FlagAll + ", a"
 	FlagDeprecated                        = "deprecated"
 	FlagDeprecatedWithAlias               = FlagDeprecated + ", dep"
@@ -174,10 +181,13 @@ const (
 	FlagClustersWithAlias                 = FlagClusters + ", cl"
 	FlagIsGlobalDomain                    = "global_domain"
 	FlagIsGlobalDomainWithAlias           = FlagIsGlobalDomain + ", gd"
+	FlagWait                              = "wait"
+	FlagWaitTimeout                       = "wait_timeout"
 	FlagDomainData                        = "domain_data"
 	FlagDomainDataWithAlias               = FlagDomainData + ", dmd"
 	FlagEventID                           = "event_id"
 	FlagEventIDWithAlias                  = FlagEventID + ", eid"
+	FlagEventType                         = "event_type"
 	FlagActivityID                        = "activity_id"
 	FlagActivityIDWithAlias               = FlagActivityID + ", aid"
 	FlagMaxFieldLength                    = "max_field_length"
@@ -207,11 +217,13 @@ const (
 	FlagResetType                         = "reset_type"
 	FlagDecisionOffset                    = "decision_offset"
 	FlagResetPointsOnly                   = "reset_points_only"
+	FlagPrintPendingRequests              = "print_pending_requests"
 	FlagResetBadBinaryChecksum            = "reset_bad_binary_checksum"
 	FlagSkipSignalReapply                 = "skip_signal_reapply"
 	FlagListQuery                         = "query"
 	FlagListQueryWithAlias                = FlagListQuery + ", q"
 	FlagExcludeWorkflowIDByQuery          = "exclude_query"
+	FlagGroupBy                           = "group_by"
 	FlagBatchType                         = "batch_type"
 	FlagBatchTypeWithAlias                = FlagBatchType + ", bt"
 	FlagSignalName                        = "signal_name"
@@ -225,7 +237,11 @@ const (
 	FlagRPSScaleUpSeconds                 = "rps_scale_up_seconds"
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
+	FlagTaskToken                         = "token"
 	FlagYes                               = "yes"
+	FlagYesWithConfirmAlias               = FlagYes + ", confirm"
+	FlagVar                               = "var"
+	FlagTop                               = "top"
 	FlagServiceConfigDir                  = "service_config_dir"
 	FlagServiceConfigDirWithAlias         = FlagServiceConfigDir + ", scd"
 	FlagServiceEnv                        = "service_env"
@@ -240,11 +256,16 @@ const (
 	FlagDLQType                           = "dlq_type"
 	FlagDLQTypeWithAlias                  = FlagDLQType + ", dt"
 	FlagDLQRawTask                        = "dlq_raw_task"
+	FlagRaw                               = "raw"
 	FlagMaxMessageCount                   = "max_message_count"
 	FlagMaxMessageCountWithAlias          = FlagMaxMessageCount + ", mmc"
 	FlagLastMessageID                     = "last_message_id"
 	FlagLastMessageIDWithAlias            = FlagLastMessageID + ", lm"
 	FlagConcurrency                       = "concurrency"
+	FlagFile                              = "file"
+	FlagDuration                          = "duration"
+	FlagWorkflowCount                     = "workflows"
+	FlagStepTimeout                       = "step_timeout"
 	FlagReportRate                        = "report_rate"
 	FlagLowerShardBound                   = "lower_shard_bound"
 	FlagUpperShardBound                   = "upper_shard_bound"
@@ -265,6 +286,8 @@ const (
 	FlagFailoverDrillWaitTimeWithAlias    = FlagFailoverDrillWaitTime + ", fdws"
 	FlagFailoverDrill                     = "failover_drill"
 	FlagFailoverDrillWithAlias            = FlagFailoverDrill + ", fd"
+	FlagFailoverVersion                   = "failover_version"
+	FlagFailoverVersionWithAlias          = FlagFailoverVersion + ", fv"
 	FlagRetryInterval                     = "retry_interval"
 	FlagRetryAttempts                     = "retry_attempts"
 	FlagRetryExpiration                   = "retry_expiration"
@@ -288,6 +311,54 @@ const (
 	FlagDynamicConfigValue                = "dynamic_config_value"
 	FlagTransport                         = "transport"
 	FlagTransportWithAlias                = FlagTransport + ", t"
+	FlagMaxRetries                        = "max_retries"
+	FlagRetryBackoffInMs                  = "retry_backoff_ms"
+	FlagSortBy                            = "sort_by"
+	FlagSortByWithAlias                   = FlagSortBy + ", sort-by"
+	FlagColumns                           = "columns"
+	FlagAuditLog                          = "audit_log"
+	FlagAuditURL                          = "audit_url"
+	FlagQuiet                             = "quiet"
+	FlagTimezone                          = "timezone"
+	FlagIncludeHistory                    = "include_history"
+	FlagArchived                          = "archived"
+	FlagSchedule                          = "schedule"
+	FlagDBCollection                      = "collection"
+	FlagShardRange                        = "shard_range"
+	FlagTargetAddress                     = "target_address"
+	FlagSampleSize                        = "sample_size"
+	FlagFormat                            = "format"
+	FlagBatchFile                         = "batch_file"
+	FlagIn                                = "in"
+	FlagOut                               = "out"
+	FlagInEncoding                        = "in_encoding"
+	FlagOutEncoding                       = "out_encoding"
+	FlagAPIs                              = "apis"
+	FlagTTL                               = "ttl"
+	FlagSubject                           = "subject"
+	FlagWorkflowIDPattern                 = "workflow_id_pattern"
+	FlagDomainMetadata                    = "domain_metadata"
+	FlagAsync                             = "async"
+	FlagResultsFile                       = "results_file"
+	FlagClosedWithin                      = "closed_within"
+	FlagProfile                           = "profile"
+	FlagCredentialsStore                  = "credentials_store"
+	FlagCredentialsFile                   = "credentials_file"
+	FlagCredentialKind                    = "kind"
+	FlagCredentialValueFile               = "value_file"
+	FlagOutputFile                        = "output-file"
+	FlagInteractive                       = "interactive"
+	FlagEnv                               = "env"
+	FlagConfigFile                        = "config_file"
+	FlagAsyncWorkflowEnabled              = "async_workflow_enabled"
+	FlagAsyncWorkflowQueueProvider        = "async_workflow_queue_provider"
+	FlagAsyncWorkflowQueueTopic           = "async_workflow_queue_topic"
+	FlagAsyncWorkflowRPS                  = "async_workflow_rps"
+	FlagErrorFormat                       = "error-format"
+	FlagVerbose                           = "verbose"
+	FlagAllPages                          = "all-pages"
+	FlagMaxItems                          = "max-items"
+	FlagGlobalDryRun                      = "dry-run"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -317,7 +388,7 @@ func getFlagsForShow() []cli.Flag {
 }
 
 func getFlagsForShowID() []cli.Flag {
-	return []cli.Flag{
+	return append([]cli.Flag{
 		cli.BoolFlag{
 			Name:  FlagPrintDateTimeWithAlias,
 			Usage: "Print timestamp",
@@ -351,7 +422,19 @@ func getFlagsForShowID() []cli.Flag {
 			Name:  FlagResetPointsOnly,
 			Usage: "Only show events that are eligible for reset",
 		},
-	}
+		cli.StringFlag{
+			Name:  FlagFormat,
+			Usage: "Output format [table, json, jsonl]. json and jsonl print events with enum names and RFC3339 timestamps",
+		},
+		cli.BoolFlag{
+			Name:  FlagArchived,
+			Usage: "Read history directly from the domain's history archival URI, using the archiver configured in the service config (run_id is required)",
+		},
+		cli.BoolFlag{
+			Name:  FlagInteractive,
+			Usage: "Browse the history events in a terminal UI, with search (/), filter (f) and the details of an event (enter)",
+		},
+	}, getServiceConfigFlags()...)
 }
 
 func getFlagsForStart() []cli.Flag {
@@ -388,6 +471,10 @@ func getFlagsForStart() []cli.Flag {
 				"\t│ │ │ │ │ \n" +
 				"\t* * * * *",
 		},
+		cli.BoolFlag{
+			Name:  FlagYesWithConfirmAlias,
+			Usage: "Optional flag to start a cron workflow without confirming its next run times",
+		},
 		cli.IntFlag{
 			Name: FlagWorkflowIDReusePolicyAlias,
 			Usage: "Optional input to configure if the same workflow ID is allow to use for new workflow execution. " +
@@ -507,6 +594,12 @@ func getFlagsForSignal() []cli.Flag {
 			Name:  FlagInputFileWithAlias,
 			Usage: "Input for the signal from JSON file.",
 		},
+		cli.StringFlag{
+			Name: FlagBatchFile,
+			Usage: "JSON file with a list of signals to deliver together in one history transaction, " +
+				`e.g. [{"name": "signal1", "input": {"key": "value"}}, {"name": "signal2"}]. ` +
+				"Cannot be combined with --name and --input",
+		},
 	}
 }
 
@@ -559,6 +652,14 @@ func getCommonFlagsForVisibility() []cli.Flag {
 			Name:  FlagPrintJSONWithAlias,
 			Usage: "Print in raw json format",
 		},
+		cli.StringFlag{
+			Name:  FlagFormat,
+			Usage: "Output format [table, json, jsonl]. jsonl prints one JSON object per line as pages arrive",
+		},
+		cli.BoolFlag{
+			Name:  FlagInteractive,
+			Usage: "Browse the workflows in a terminal UI, with search (/), filter (f) and drill down into the history of a workflow (enter)",
+		},
 	}
 }
 
@@ -578,6 +679,42 @@ func getFlagsForList() []cli.Flag {
 	return flagsForList
 }
 
+func getFlagsForListAtRisk() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName",
+		},
+		cli.IntFlag{
+			Name:  FlagPageSizeWithAlias,
+			Value: 100,
+			Usage: "Page size for listing at risk workflow executions",
+		},
+		cli.StringFlag{
+			Name:  FlagFormat,
+			Usage: "Output format [table, json, jsonl]",
+		},
+	}
+}
+
+func getFlagsForDescribeBatch() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagFile,
+			Usage: "File with one workflowID[,runID] per line, empty lines and lines starting with # are skipped",
+		},
+		cli.IntFlag{
+			Name:  FlagConcurrency,
+			Value: 10,
+			Usage: "Number of workflow executions described in parallel",
+		},
+		cli.StringFlag{
+			Name:  FlagFormat,
+			Usage: "Output format [table, json, jsonl]",
+		},
+	}
+}
+
 func getFlagsForListAll() []cli.Flag {
 	flagsForListAll := []cli.Flag{
 		cli.BoolFlag{
@@ -665,6 +802,10 @@ func getFlagsForCount() []cli.Flag {
 			Name:  FlagListQueryWithAlias,
 			Usage: "Optional SQL like query. e.g count all open workflows 'CloseTime = missing'; 'WorkflowType=\"wtype\" and CloseTime > 0'",
 		},
+		cli.StringFlag{
+			Name:  FlagGroupBy,
+			Usage: "Optional field to break the count down by, one of CloseStatus, WorkflowType or TaskList",
+		},
 	}
 }
 
@@ -727,6 +868,14 @@ func getFlagsForDescribeID() []cli.Flag {
 			Name:  FlagResetPointsOnly,
 			Usage: "Only show auto-reset points",
 		},
+		cli.BoolFlag{
+			Name:  FlagPrintPendingRequests,
+			Usage: "Also show pending child executions and external cancel/signal requests from mutable state",
+		},
+		cli.BoolFlag{
+			Name:  FlagPrintSearchAttrWithAlias,
+			Usage: "Also show search attributes in a table, decoded the same way as list",
+		},
 	}
 }
 
@@ -744,5 +893,9 @@ func getFlagsForObserveID() []cli.Flag {
 			Name:  FlagMaxFieldLengthWithAlias,
 			Usage: "Optional maximum length for each attribute field when show details",
 		},
+		cli.StringSliceFlag{
+			Name:  FlagEventType,
+			Usage: "Optional event type to show, e.g. ActivityTaskCompleted. Can be passed multiple times. Workflow close events are always shown",
+		},
 	}
 }
@@ -0,0 +1,79 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pborman/uuid"
+	"github.com/urfave/cli"
+)
+
+const getResourceUsage = "domain/<name>, workflow/<domain>/<workflow_id>[/<run_id>] or tasklist/<domain>/<name>"
+
+// GetResource describes a resource addressed as kind/path by running the describe command of
+// that resource. Arguments after the resource are passed on to the describe command
+func GetResource(c *cli.Context) {
+	if !c.Args().Present() {
+		ErrorAndExit("Argument resource is required, expected "+getResourceUsage+".", nil)
+	}
+	args, err := resolveResourceArgs(c.Args().First())
+	if err != nil {
+		ErrorAndExit("Invalid resource.", err)
+	}
+	args = append(args, c.Args().Tail()...)
+
+	// the domain of the resource comes after the global flags the command was invoked with, so it takes precedence
+	runArgs := append([]string{c.App.Name}, getScriptGlobalArgs(c)...)
+	if err := c.App.Run(append(runArgs, args...)); err != nil {
+		ErrorAndExit("Failed to describe resource.", err)
+	}
+}
+
+// resolveResourceArgs translates a resource address into the command line of its describe command
+func resolveResourceArgs(resource string) ([]string, error) {
+	parts := strings.SplitN(resource, "/", 3)
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("resource %q has an empty segment, expected %v", resource, getResourceUsage)
+		}
+	}
+
+	switch kind := strings.ToLower(parts[0]); {
+	case (kind == "domain" || kind == "d") && len(parts) == 2:
+		return []string{"--" + FlagDomain, parts[1], "domain", "describe"}, nil
+	case (kind == "workflow" || kind == "wf") && len(parts) == 3:
+		// workflow IDs may contain slashes, the last segment is a run ID only when it is a UUID
+		workflowID, runID := parts[2], ""
+		if i := strings.LastIndex(workflowID, "/"); i > 0 && uuid.Parse(workflowID[i+1:]) != nil {
+			workflowID, runID = workflowID[:i], workflowID[i+1:]
+		}
+		args := []string{"--" + FlagDomain, parts[1], "workflow", "describe", "--" + FlagWorkflowID, workflowID}
+		if runID != "" {
+			args = append(args, "--"+FlagRunID, runID)
+		}
+		return args, nil
+	case (kind == "tasklist" || kind == "tl") && len(parts) == 3:
+		return []string{"--" + FlagDomain, parts[1], "tasklist", "describe", "--" + FlagTaskList, parts[2]}, nil
+	}
+	return nil, fmt.Errorf("unknown resource %q, expected %v", resource, getResourceUsage)
+}
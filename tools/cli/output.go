@@ -0,0 +1,121 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/urfave/cli"
+)
+
+// outputFile receives the rendered output of a command when --output-file is set. The output is written to a
+// temporary file next to the target, which atomically replaces the target once the command succeeds, so a
+// failed or aborted command never leaves a partial file behind. Prompts, e.g. for the next page, stay on the
+// terminal.
+type outputFile struct {
+	path   string
+	tmp    *os.File
+	osExit func(int)
+}
+
+var cliOutputFile *outputFile
+
+// getOutput returns where the rendered output of the command is written, os.Stdout unless --output-file is set
+func getOutput() *os.File {
+	if cliOutputFile != nil {
+		return cliOutputFile.tmp
+	}
+	return os.Stdout
+}
+
+// isOutputFile returns true if w is the file set with --output-file
+func isOutputFile(w io.Writer) bool {
+	return cliOutputFile != nil && w == io.Writer(cliOutputFile.tmp)
+}
+
+// Render writes the operands to the command output, like fmt.Println
+func Render(a ...interface{}) {
+	if _, err := fmt.Fprintln(getOutput(), a...); err != nil {
+		ErrorAndExit("Failed to write output.", err)
+	}
+}
+
+// RenderJSON writes o as indented JSON to w
+func RenderJSON(w io.Writer, o interface{}) {
+	b, err := json.MarshalIndent(o, "", "  ")
+	if err != nil {
+		fmt.Printf("Error when try to print pretty: %v\n", err)
+		fmt.Println(o)
+	}
+	w.Write(b)
+	fmt.Fprintln(w)
+}
+
+func openOutputFile(c *cli.Context) error {
+	path := c.GlobalString(FlagOutputFile)
+	if path == "" || cliOutputFile != nil {
+		return nil
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %v", err)
+	}
+	cliOutputFile = &outputFile{path: path, tmp: tmp, osExit: osExit}
+
+	// commands report failures through osExit, the partial output has to be discarded before the process exits
+	oldOsExit := osExit
+	osExit = func(code int) {
+		if code == 0 {
+			if err := closeOutputFile(true); err != nil {
+				printError("Failed to write output file", err)
+				code = 1
+			}
+		} else {
+			closeOutputFile(false) //nolint:errcheck
+		}
+		oldOsExit(code)
+	}
+	return nil
+}
+
+// closeOutputFile replaces the output file with the output of the command if commit is set, or discards it
+func closeOutputFile(commit bool) error {
+	if cliOutputFile == nil {
+		return nil
+	}
+	out := cliOutputFile
+	cliOutputFile = nil
+	osExit = out.osExit
+	defer os.Remove(out.tmp.Name())
+
+	if err := out.tmp.Close(); err != nil || !commit {
+		return err
+	}
+	if err := os.Chmod(out.tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(out.tmp.Name(), out.path)
+}
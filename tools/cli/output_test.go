@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli"
+)
+
+func newOutputFileContext(t *testing.T, path string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	set.String(FlagOutputFile, "", "")
+	require.NoError(t, set.Parse([]string{"--" + FlagOutputFile, path}))
+	global := cli.NewContext(nil, set, nil)
+	return cli.NewContext(nil, flag.NewFlagSet("command", 0), global)
+}
+
+func TestOutputFile_ReplacedOnSuccess(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cadence-cli-output")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "workflows.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte("previous\n"), 0644))
+
+	require.NoError(t, openOutputFile(newOutputFileContext(t, path)))
+	assert.NotEqual(t, os.Stdout, getOutput())
+	Render("[")
+	RenderJSON(getOutput(), map[string]string{"workflowId": "wid"})
+	RenderTable(getOutput(), []testRow{{StringField: "text"}}, TableOptions{Color: true})
+	Render("]")
+
+	// the target is untouched until the command completes
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "previous\n", string(data))
+
+	require.NoError(t, closeOutputFile(true))
+	assert.Equal(t, os.Stdout, getOutput())
+	data, err = ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "[\n{\n  \"workflowId\": \"wid\"\n}\n")
+	assert.Contains(t, string(data), "text")
+	assert.NotContains(t, string(data), "\x1b[")
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
+
+func TestOutputFile_DiscardedOnFailure(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cadence-cli-output")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "workflows.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte("previous\n"), 0644))
+
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	var exitCode int
+	osExit = func(code int) {
+		exitCode = code
+	}
+
+	require.NoError(t, openOutputFile(newOutputFileContext(t, path)))
+	Render("partial")
+	osExit(1)
+	assert.Equal(t, 1, exitCode)
+	assert.Equal(t, os.Stdout, getOutput())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "previous\n", string(data))
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+}
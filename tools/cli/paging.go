@@ -0,0 +1,68 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+)
+
+// paging controls how listing commands walk the pages of their results. By default they prompt before
+// showing the next page, with --all-pages or --max-items the pages are walked without prompting, which is
+// meant for non-interactive automation.
+type paging struct {
+	allPages bool
+	maxItems int // 0 means no limit
+	items    int // items shown so far
+}
+
+var cliPaging paging
+
+// setPaging resets the paging of the command from --all-pages and --max-items
+func setPaging(c *cli.Context) error {
+	cliPaging = paging{}
+	maxItems := c.GlobalInt(FlagMaxItems)
+	if maxItems < 0 {
+		return fmt.Errorf("invalid %s %d, it must not be negative", FlagMaxItems, maxItems)
+	}
+	cliPaging = paging{allPages: c.GlobalBool(FlagAllPages), maxItems: maxItems}
+	return nil
+}
+
+// autoPaging returns true if the pages are walked without prompting
+func (p *paging) autoPaging() bool {
+	return p.allPages || p.maxItems > 0
+}
+
+// limit records that up to n more items are shown and returns how many of them are within --max-items
+func (p *paging) limit(n int) int {
+	if p.maxItems > 0 && p.items+n > p.maxItems {
+		n = p.maxItems - p.items
+	}
+	p.items += n
+	return n
+}
+
+// done returns true once --max-items items are shown, so no further page has to be fetched
+func (p *paging) done() bool {
+	return p.maxItems > 0 && p.items >= p.maxItems
+}
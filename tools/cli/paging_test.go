@@ -0,0 +1,47 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaging(t *testing.T) {
+	p := paging{}
+	assert.False(t, p.autoPaging())
+	assert.Equal(t, 10, p.limit(10))
+	assert.False(t, p.done())
+
+	p = paging{allPages: true}
+	assert.True(t, p.autoPaging())
+	assert.Equal(t, 10, p.limit(10))
+	assert.False(t, p.done())
+
+	p = paging{maxItems: 5}
+	assert.True(t, p.autoPaging())
+	assert.Equal(t, 3, p.limit(3))
+	assert.False(t, p.done())
+	assert.Equal(t, 2, p.limit(3))
+	assert.True(t, p.done())
+	assert.Equal(t, 0, p.limit(3))
+}
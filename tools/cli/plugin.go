@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+)
+
+const (
+	// pluginPrefix is the executable name prefix of CLI plugins,
+	// an executable named cadence-foo on PATH is run as `cadence foo`
+	pluginPrefix = "cadence-"
+)
+
+// runDefaultAction runs the plugin named by the first argument, or shows help if there is none
+func runDefaultAction(c *cli.Context) error {
+	if !c.Args().Present() {
+		return cli.ShowAppHelp(c)
+	}
+	runPlugin(c, c.Args().First(), c.Args().Tail())
+	return nil
+}
+
+// runPlugin executes the plugin binary for the given command. Global flags of the parent CLI
+// are passed to the plugin through the same environment variables the CLI reads them from,
+// so a plugin built on this package picks up the connection settings without re-parsing them.
+func runPlugin(c *cli.Context, command string, args []string) {
+	path, err := exec.LookPath(pluginPrefix + command)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Unknown command %q and no %s%s plugin found on PATH", command, pluginPrefix, command), nil)
+		return
+	}
+
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), getPluginEnv(c)...)
+	if err := cmd.Run(); err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			osExit(exitErr.ExitCode())
+			return
+		}
+		ErrorAndExit(fmt.Sprintf("Failed to run plugin %s", path), err)
+	}
+}
+
+// getPluginEnv returns KEY=value pairs for the global flags explicitly set on the command line
+func getPluginEnv(c *cli.Context) []string {
+	var env []string
+	for _, flag := range c.App.Flags {
+		var value string
+		switch f := flag.(type) {
+		case cli.StringFlag:
+			value = c.GlobalString(getFlagName(f.Name))
+		case cli.IntFlag:
+			value = strconv.Itoa(c.GlobalInt(getFlagName(f.Name)))
+		default:
+			continue
+		}
+		if !c.GlobalIsSet(getFlagName(flag.GetName())) {
+			continue
+		}
+		for _, envVar := range getFlagEnvVars(flag) {
+			env = append(env, fmt.Sprintf("%s=%s", envVar, value))
+		}
+	}
+	return env
+}
+
+// getFlagName strips the aliases from a flag name like "address, ad"
+func getFlagName(name string) string {
+	return strings.TrimSpace(strings.Split(name, ",")[0])
+}
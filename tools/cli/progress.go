@@ -0,0 +1,197 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+)
+
+var spinnerFrames = []string{"|", "/", "-", "\\"}
+
+const progressBarWidth = 30
+
+// pageProgress shows on stderr how many pages and items a pagination loop has fetched so far.
+// The total is unknown up front, so a spinner and the running counts are shown instead of a bar.
+// It stays silent with --quiet or when stderr is not a terminal, so it never ends up in
+// redirected output. The zero value never prints anything
+type pageProgress struct {
+	writer  io.Writer
+	label   string
+	pages   int
+	items   int
+	lineLen int
+}
+
+func newPageProgress(c *cli.Context, label string) *pageProgress {
+	p := &pageProgress{label: label}
+	if !c.GlobalBool(FlagQuiet) && isTerminal(os.Stderr) {
+		p.writer = os.Stderr
+	}
+	return p
+}
+
+// addPage records a fetched page and redraws the progress line
+func (p *pageProgress) addPage(items int) {
+	p.pages++
+	p.items += items
+	if p.writer == nil {
+		return
+	}
+
+	line := fmt.Sprintf("%v fetching %v: %d fetched, %d pages",
+		spinnerFrames[p.pages%len(spinnerFrames)], p.label, p.items, p.pages)
+	p.lineLen = redrawProgressLine(p.writer, line, p.lineLen)
+}
+
+// clear erases the progress line, it must be called before anything else is printed to the
+// terminal and once the loop is done. The line is redrawn on the next page
+func (p *pageProgress) clear() {
+	if p.writer == nil || p.lineLen == 0 {
+		return
+	}
+	clearProgressLine(p.writer, p.lineLen)
+	p.lineLen = 0
+}
+
+// itemProgress shows on stderr how many items of a bulk operation are done. When the total is known a bar
+// and the estimated time left are shown, otherwise a spinner and the running count. Like pageProgress it
+// stays silent with --quiet or when stderr is not a terminal. A nil or zero value never prints anything
+type itemProgress struct {
+	writer  io.Writer
+	label   string
+	total   int
+	done    int
+	base    int // items done before the first update, they do not count towards the rate
+	updated bool
+	started time.Time
+	lineLen int
+}
+
+func newItemProgress(c *cli.Context, label string, total int) *itemProgress {
+	p := &itemProgress{label: label, total: total, started: time.Now()}
+	if !c.GlobalBool(FlagQuiet) && isTerminal(os.Stderr) {
+		p.writer = os.Stderr
+	}
+	return p
+}
+
+// start redraws the progress line with the item being processed
+func (p *itemProgress) start(item string) {
+	if p == nil {
+		return
+	}
+	p.draw(item)
+}
+
+// finishItem records a processed item and erases the progress line, so the outcome of the item can be
+// printed. The line is redrawn when the next item starts
+func (p *itemProgress) finishItem() {
+	if p == nil {
+		return
+	}
+	p.done++
+	p.clear()
+}
+
+// update sets the counts of an operation which runs elsewhere, e.g. in a system workflow, and redraws
+// the progress line. The total may be an estimate
+func (p *itemProgress) update(done int, total int) {
+	if p == nil {
+		return
+	}
+	if !p.updated {
+		p.base, p.started, p.updated = done, time.Now(), true
+	}
+	p.done, p.total = done, total
+	p.draw("")
+}
+
+func (p *itemProgress) draw(item string) {
+	if p.writer == nil {
+		return
+	}
+	line := formatItemProgress(p.label, p.done, p.total, p.eta(), item)
+	p.lineLen = redrawProgressLine(p.writer, line, p.lineLen)
+}
+
+// eta estimates the time left from the rate items were done at so far, it is 0 when unknown
+func (p *itemProgress) eta() time.Duration {
+	processed := p.done - p.base
+	if processed <= 0 || p.total <= p.done {
+		return 0
+	}
+	return time.Since(p.started) / time.Duration(processed) * time.Duration(p.total-p.done)
+}
+
+// clear erases the progress line, it must be called before anything else is printed to the terminal
+func (p *itemProgress) clear() {
+	if p == nil || p.writer == nil || p.lineLen == 0 {
+		return
+	}
+	clearProgressLine(p.writer, p.lineLen)
+	p.lineLen = 0
+}
+
+func formatItemProgress(label string, done int, total int, eta time.Duration, item string) string {
+	var line string
+	if total > 0 {
+		if done > total {
+			total = done
+		}
+		filled := progressBarWidth * done / total
+		line = fmt.Sprintf("[%s%s] %d/%d %v",
+			strings.Repeat("=", filled), strings.Repeat(" ", progressBarWidth-filled), done, total, label)
+		if eta = eta.Round(time.Second); eta > 0 {
+			line += fmt.Sprintf(", ETA %v", eta)
+		}
+	} else {
+		line = fmt.Sprintf("%v %v: %d done", spinnerFrames[done%len(spinnerFrames)], label, done)
+	}
+	if item != "" {
+		line += ": " + item
+	}
+	return line
+}
+
+// redrawProgressLine overwrites the progress line of the given length and returns the length of the new one
+func redrawProgressLine(w io.Writer, line string, lineLen int) int {
+	padding := ""
+	if lineLen > len(line) {
+		padding = strings.Repeat(" ", lineLen-len(line))
+	}
+	fmt.Fprint(w, "\r"+line+padding)
+	return len(line)
+}
+
+func clearProgressLine(w io.Writer, lineLen int) {
+	fmt.Fprint(w, "\r"+strings.Repeat(" ", lineLen)+"\r")
+}
+
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageProgress(t *testing.T) {
+	out := &bytes.Buffer{}
+	progress := &pageProgress{writer: out, label: "domains"}
+
+	progress.addPage(10)
+	assert.Equal(t, "\r/ fetching domains: 10 fetched, 1 pages", out.String())
+
+	out.Reset()
+	progress.addPage(5)
+	assert.Equal(t, "\r- fetching domains: 15 fetched, 2 pages", out.String())
+
+	out.Reset()
+	progress.clear()
+	assert.Equal(t, "\r"+strings.Repeat(" ", 39)+"\r", out.String())
+
+	out.Reset()
+	progress.clear()
+	assert.Empty(t, out.String())
+
+	silent := &pageProgress{}
+	silent.addPage(10)
+	silent.clear()
+	assert.Equal(t, 10, silent.items)
+}
+
+func TestItemProgress(t *testing.T) {
+	out := &bytes.Buffer{}
+	progress := &itemProgress{writer: out, label: "items", total: 2, started: time.Now()}
+
+	progress.start("a")
+	assert.Equal(t, "\r["+strings.Repeat(" ", progressBarWidth)+"] 0/2 items: a", out.String())
+
+	out.Reset()
+	progress.finishItem()
+	assert.Equal(t, "\r"+strings.Repeat(" ", progressBarWidth+15)+"\r", out.String())
+	assert.Equal(t, 1, progress.done)
+
+	out.Reset()
+	progress.clear()
+	assert.Empty(t, out.String())
+
+	var silent *itemProgress
+	silent.start("a")
+	silent.finishItem()
+	silent.update(1, 2)
+	silent.clear()
+}
+
+func TestItemProgress_Update(t *testing.T) {
+	out := &bytes.Buffer{}
+	progress := &itemProgress{writer: out, label: "workflows"}
+
+	progress.update(10, 0)
+	assert.Equal(t, "\r- workflows: 10 done", out.String())
+	assert.Equal(t, 10, progress.base)
+
+	progress.update(20, 40)
+	assert.Equal(t, 10, progress.base)
+	assert.True(t, progress.eta() > 0)
+}
+
+func TestFormatItemProgress(t *testing.T) {
+	bar := func(filled int) string {
+		return "[" + strings.Repeat("=", filled) + strings.Repeat(" ", progressBarWidth-filled) + "]"
+	}
+	assert.Equal(t, bar(15)+" 5/10 shards, ETA 3s: shard 5", formatItemProgress("shards", 5, 10, 2600*time.Millisecond, "shard 5"))
+	assert.Equal(t, bar(progressBarWidth)+" 10/10 shards", formatItemProgress("shards", 10, 10, 0, ""))
+	assert.Equal(t, bar(3)+" 1/10 shards", formatItemProgress("shards", 1, 10, 400*time.Millisecond, ""))
+	assert.Equal(t, bar(progressBarWidth)+" 12/12 workflows", formatItemProgress("workflows", 12, 10, 0, ""))
+	assert.Equal(t, "- workflows: 2 done", formatItemProgress("workflows", 2, 0, 0, ""))
+}
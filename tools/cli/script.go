@@ -0,0 +1,65 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newScriptCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:      "record",
+			Aliases:   []string{"rec"},
+			Usage:     "Run CLI commands read from stdin and record the successful ones into a script file",
+			ArgsUsage: "script_file",
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  FlagVar,
+					Usage: "Replace a value with a variable in recorded commands, in format name=value. Can be passed multiple times",
+				},
+				cli.StringFlag{
+					Name:  FlagDescriptionWithAlias,
+					Usage: "Optional description of the script",
+				},
+			},
+			Action: func(c *cli.Context) {
+				RecordScript(c)
+			},
+		},
+		{
+			Name:      "run",
+			Usage:     "Replay the commands of a recorded script, confirming each step",
+			ArgsUsage: "script_file",
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  FlagVar,
+					Usage: "Set a script variable, in format name=value. Overrides the value recorded in the script",
+				},
+				cli.BoolFlag{
+					Name:  FlagYes,
+					Usage: "Optional flag to run all steps without confirmation",
+				},
+			},
+			Action: func(c *cli.Context) {
+				RunScript(c)
+			},
+		},
+	}
+}
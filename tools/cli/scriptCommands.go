@@ -0,0 +1,365 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// cliScript is a recorded sequence of CLI commands that can be replayed
+	cliScript struct {
+		Description string            `yaml:"description,omitempty"`
+		Vars        map[string]string `yaml:"vars,omitempty"`
+		Steps       []cliScriptStep   `yaml:"steps"`
+	}
+
+	cliScriptStep struct {
+		Description string `yaml:"description,omitempty"`
+		Command     string `yaml:"command"`
+	}
+
+	// scriptStepExit is raised from osExit while a step runs, so that a failing
+	// command ends the step instead of the whole process
+	scriptStepExit struct {
+		code int
+	}
+)
+
+var (
+	scriptInput io.Reader = os.Stdin
+
+	scriptVarPattern = regexp.MustCompile(`\$\{(\w+)\}`)
+)
+
+// RecordScript runs commands read from stdin and records the successful ones into a script file
+func RecordScript(c *cli.Context) {
+	if !c.Args().Present() {
+		ErrorAndExit("Argument script_file is required.", nil)
+	}
+	path := c.Args().First()
+	vars := parseScriptVars(c)
+
+	script := &cliScript{
+		Description: c.String(FlagDescription),
+		Vars:        vars,
+	}
+	app := getRootApp(c)
+	globalArgs := getScriptGlobalArgs(c)
+	reader := bufio.NewReader(scriptInput)
+	stepDescription := ""
+
+	fmt.Printf("Recording to %s. Enter commands without the leading 'cadence', "+
+		"'# text' to describe the next step and 'exit' to finish.\n", path)
+	for {
+		fmt.Print("script> ")
+		line, err := reader.ReadString('\n')
+		line = strings.TrimSpace(line)
+		if line == "exit" || (line == "" && err != nil) {
+			break
+		}
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			stepDescription = strings.TrimSpace(strings.TrimPrefix(line, "#"))
+			continue
+		}
+
+		args, splitErr := splitCommandLine(strings.TrimPrefix(line, app.Name+" "))
+		if splitErr != nil {
+			printError("Invalid command.", splitErr)
+			continue
+		}
+		if len(args) > 0 && args[0] == "script" {
+			printError("Script commands cannot be recorded.", nil)
+			continue
+		}
+		if err := runScriptStep(app, globalArgs, args); err != nil {
+			printError("Command failed and is not recorded.", err)
+			continue
+		}
+
+		script.Steps = append(script.Steps, cliScriptStep{
+			Description: stepDescription,
+			Command:     joinCommandLine(parameterizeScriptArgs(args, vars)),
+		})
+		stepDescription = ""
+		if err := writeScript(path, script); err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to write script %s.", path), err)
+		}
+	}
+
+	fmt.Printf("Recorded %d steps to %s.\n", len(script.Steps), path)
+}
+
+// RunScript replays the commands of a recorded script, confirming each step unless --yes is set
+func RunScript(c *cli.Context) {
+	if !c.Args().Present() {
+		ErrorAndExit("Argument script_file is required.", nil)
+	}
+	path := c.Args().First()
+	script, err := readScript(path)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to read script %s.", path), err)
+	}
+
+	vars := make(map[string]string)
+	for name, value := range script.Vars {
+		vars[name] = value
+	}
+	for name, value := range parseScriptVars(c) {
+		vars[name] = value
+	}
+
+	// resolve every step before running any, so a missing variable cannot stop a runbook halfway
+	steps := make([][]string, len(script.Steps))
+	for i, step := range script.Steps {
+		args, err := splitCommandLine(step.Command)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Invalid command in step %d.", i+1), err)
+		}
+		steps[i], err = substituteScriptVars(args, vars)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to resolve step %d.", i+1), err)
+		}
+	}
+
+	if script.Description != "" {
+		fmt.Println(script.Description)
+	}
+	app := getRootApp(c)
+	globalArgs := getScriptGlobalArgs(c)
+	reader := bufio.NewReader(scriptInput)
+	for i, args := range steps {
+		fmt.Printf("%s %d/%d", colorMagenta("Step"), i+1, len(steps))
+		if script.Steps[i].Description != "" {
+			fmt.Printf(": %s", script.Steps[i].Description)
+		}
+		fmt.Printf("\n  %s %s\n", app.Name, joinCommandLine(args))
+
+		if !c.Bool(FlagYes) {
+			fmt.Print("Run this step? [y]es/[s]kip/[a]bort: ")
+			answer, _ := reader.ReadString('\n')
+			switch strings.ToLower(strings.TrimSpace(answer)) {
+			case "y", "yes":
+			case "s", "skip":
+				continue
+			default:
+				fmt.Printf("Script aborted at step %d.\n", i+1)
+				return
+			}
+		}
+
+		if err := runScriptStep(app, globalArgs, args); err != nil {
+			ErrorAndExit(fmt.Sprintf("Step %d failed, remaining steps are not run.", i+1), err)
+		}
+	}
+	fmt.Printf("Script completed, %d steps.\n", len(steps))
+}
+
+// runScriptStep runs one command with the same global flags the script command was invoked with.
+// Global flags of the step itself come later on the command line and take precedence.
+func runScriptStep(app *cli.App, globalArgs []string, args []string) (retError error) {
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	osExit = func(code int) {
+		panic(scriptStepExit{code: code})
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			exit, ok := r.(scriptStepExit)
+			if !ok {
+				panic(r)
+			}
+			retError = fmt.Errorf("command exited with code %d", exit.code)
+		}
+	}()
+
+	runArgs := append([]string{app.Name}, globalArgs...)
+	return app.Run(append(runArgs, args...))
+}
+
+// getRootApp returns the top level app, c.App of a subcommand only knows the flags of that subcommand
+func getRootApp(c *cli.Context) *cli.App {
+	for c.Parent() != nil {
+		c = c.Parent()
+	}
+	return c.App
+}
+
+func getScriptGlobalArgs(c *cli.Context) []string {
+	var args []string
+	for _, flag := range getRootApp(c).Flags {
+		name := getFlagName(flag.GetName())
+		if c.GlobalIsSet(name) {
+			args = append(args, fmt.Sprintf("--%s=%v", name, c.GlobalGeneric(name)))
+		}
+	}
+	return args
+}
+
+func parseScriptVars(c *cli.Context) map[string]string {
+	vars := make(map[string]string)
+	for _, v := range c.StringSlice(FlagVar) {
+		parts := strings.SplitN(v, "=", 2)
+		if len(parts) != 2 || !scriptVarPattern.MatchString("${"+parts[0]+"}") {
+			ErrorAndExit(fmt.Sprintf("Invalid variable %q, expected format name=value.", v), nil)
+		}
+		vars[parts[0]] = parts[1]
+	}
+	return vars
+}
+
+// parameterizeScriptArgs replaces the recorded values of variables with their references,
+// longest values first so that a value containing another one is replaced as a whole
+func parameterizeScriptArgs(args []string, vars map[string]string) []string {
+	names := make([]string, 0, len(vars))
+	for name, value := range vars {
+		if value != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return len(vars[names[i]]) > len(vars[names[j]])
+	})
+
+	result := make([]string, len(args))
+	for i, arg := range args {
+		for _, name := range names {
+			arg = strings.ReplaceAll(arg, vars[name], "${"+name+"}")
+		}
+		result[i] = arg
+	}
+	return result
+}
+
+func substituteScriptVars(args []string, vars map[string]string) ([]string, error) {
+	result := make([]string, len(args))
+	for i, arg := range args {
+		var missing string
+		result[i] = scriptVarPattern.ReplaceAllStringFunc(arg, func(ref string) string {
+			name := scriptVarPattern.FindStringSubmatch(ref)[1]
+			value, ok := vars[name]
+			if !ok {
+				missing = name
+			}
+			return value
+		})
+		if missing != "" {
+			return nil, fmt.Errorf("variable %s is not set, pass it with --%s %s=<value>", missing, FlagVar, missing)
+		}
+	}
+	return result, nil
+}
+
+// splitCommandLine splits a command line into arguments, honoring single and double quotes
+func splitCommandLine(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+		switch {
+		case ch == ' ' || ch == '\t':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		case ch == '\'':
+			end := strings.IndexByte(line[i+1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated single quote")
+			}
+			current.WriteString(line[i+1 : i+1+end])
+			i += end + 1
+			inArg = true
+		case ch == '"':
+			end := i + 1
+			for ; end < len(line) && line[end] != '"'; end++ {
+				if line[end] == '\\' {
+					end++
+				}
+			}
+			if end >= len(line) {
+				return nil, fmt.Errorf("unterminated double quote")
+			}
+			value, err := strconv.Unquote(line[i : end+1])
+			if err != nil {
+				return nil, err
+			}
+			current.WriteString(value)
+			i = end
+			inArg = true
+		default:
+			current.WriteByte(ch)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+func joinCommandLine(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t'\"\\") {
+			quoted[i] = strconv.Quote(arg)
+		} else {
+			quoted[i] = arg
+		}
+	}
+	return strings.Join(quoted, " ")
+}
+
+func readScript(path string) (*cliScript, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	script := &cliScript{}
+	if err := yaml.Unmarshal(data, script); err != nil {
+		return nil, err
+	}
+	return script, nil
+}
+
+func writeScript(path string, script *cliScript) error {
+	data, err := yaml.Marshal(script)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
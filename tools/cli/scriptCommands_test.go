@@ -0,0 +1,55 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitCommandLine(t *testing.T) {
+	args, err := splitCommandLine(`domain update --reason "planned failover" --desc 'a "quoted" text'  --ac c2`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"domain", "update", "--reason", "planned failover", "--desc", `a "quoted" text`, "--ac", "c2"}, args)
+
+	args, err = splitCommandLine(joinCommandLine(args))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"domain", "update", "--reason", "planned failover", "--desc", `a "quoted" text`, "--ac", "c2"}, args)
+
+	_, err = splitCommandLine(`domain update --reason "planned`)
+	assert.Error(t, err)
+	_, err = splitCommandLine(`domain update --reason 'planned`)
+	assert.Error(t, err)
+}
+
+func TestScriptVars(t *testing.T) {
+	vars := map[string]string{"domain": "samples", "cluster": "samples-dca"}
+	args := parameterizeScriptArgs([]string{"--do", "samples", "domain", "update", "--ac", "samples-dca"}, vars)
+	assert.Equal(t, []string{"--do", "${domain}", "domain", "update", "--ac", "${cluster}"}, args)
+
+	resolved, err := substituteScriptVars(args, map[string]string{"domain": "orders", "cluster": "dca"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"--do", "orders", "domain", "update", "--ac", "dca"}, resolved)
+
+	_, err = substituteScriptVars(args, map[string]string{"domain": "orders"})
+	assert.Error(t, err)
+}
@@ -26,11 +26,13 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/common/types"
 )
@@ -52,61 +54,171 @@ type TableOptions struct {
 	PrintRawTime bool
 	// PrintDateTime will print both date & time
 	PrintDateTime bool
+
+	// SortBy may contain a column header name to sort rows by in ascending order
+	SortBy string
+
+	// Columns may contain the column header names to display, in the given order.
+	// Optional columns are displayed when selected explicitly.
+	Columns []string
+}
+
+// tableFlags are the --sort_by and --columns selections of the command, they apply to every table it renders
+type tableFlags struct {
+	sortBy  string
+	columns []string
+}
+
+var cliTableFlags tableFlags
+
+// setTableFlags resets the table selections of the command from --sort_by and --columns
+func setTableFlags(c *cli.Context) {
+	cliTableFlags = tableFlags{sortBy: strings.TrimSpace(c.GlobalString(FlagSortBy))}
+	for _, column := range strings.Split(c.GlobalString(FlagColumns), ",") {
+		if column = strings.TrimSpace(column); column != "" {
+			cliTableFlags.columns = append(cliTableFlags.columns, column)
+		}
+	}
 }
 
-// RenderTable is generic function for rendering a slice of structs as a table
+// apply returns the options with the selections of the flags taking precedence over the ones of the command
+func (f tableFlags) apply(opts TableOptions) TableOptions {
+	if f.sortBy != "" {
+		opts.SortBy = f.sortBy
+	}
+	if len(f.columns) > 0 {
+		opts.Columns = f.columns
+	}
+	return opts
+}
+
+// RenderTable is generic function for rendering a slice of structs as a table.
+// The --sort_by and --columns flags apply to every table, a column they name which the table
+// does not have is an error, even if the table has no rows.
 func RenderTable(w io.Writer, slice interface{}, opts TableOptions) {
 	sliceValue := reflect.ValueOf(slice)
 	if sliceValue.Kind() != reflect.Slice {
 		panic(fmt.Errorf("table must be a slice, provided: %s", sliceValue.Kind()))
 	}
 
+	rowType := sliceValue.Type().Elem()
+	if rowType.Kind() != reflect.Struct {
+		panic(fmt.Errorf("table slice element must be a struct, provided: %s", rowType.Kind()))
+	}
+
+	opts = cliTableFlags.apply(opts)
+	fields, headers := tableColumns(rowType, opts)
+	sortField := -1
+	if opts.SortBy != "" {
+		sortField = columnField(rowType, opts.SortBy)
+		if sortField < 0 {
+			ErrorAndExit(fmt.Sprintf("Unable to sort by %q, no such column.", opts.SortBy), nil)
+		}
+	}
+
 	// No elements - nothing to render
 	if sliceValue.Len() == 0 {
 		return
 	}
 
-	firstElem := sliceValue.Index(0)
-	if firstElem.Kind() != reflect.Struct {
-		panic(fmt.Errorf("table slice element must be a struct, provided: %s", firstElem.Kind()))
+	if sortField >= 0 {
+		sliceValue = sortRows(sliceValue, sortField)
 	}
 
 	table := tablewriter.NewWriter(w)
 	table.SetBorder(opts.Border)
 	table.SetColumnSeparator("|")
 	table.SetHeaderLine(opts.Border)
+	table.SetHeader(headers)
+	if opts.Color && !isOutputFile(w) {
+		colors := make([]tablewriter.Colors, len(headers))
+		for i := range colors {
+			colors[i] = tableHeaderBlue
+		}
+		table.SetHeaderColor(colors...)
+	}
 
 	for r := 0; r < sliceValue.Len(); r++ {
-		var row []string
-		var headers []string
-		var colors []tablewriter.Colors
-
 		elem := sliceValue.Index(r)
-		for f := 0; f < elem.NumField(); f++ {
-			tag := elem.Type().Field(f).Tag
+		row := make([]string, 0, len(fields))
+		for _, f := range fields {
+			row = append(row, formatValue(elem.Field(f).Interface(), opts, elem.Type().Field(f).Tag))
+		}
+		table.Append(row)
+	}
 
-			header := columnHeader(tag, opts)
-			if header == "" {
-				continue
-			}
-			if r == 0 {
+	table.Render()
+}
+
+// tableColumns returns the indexes of the displayed fields of the row type with their column headers
+func tableColumns(rowType reflect.Type, opts TableOptions) ([]int, []string) {
+	var fields []int
+	var headers []string
+	if len(opts.Columns) == 0 {
+		for f := 0; f < rowType.NumField(); f++ {
+			if header := columnHeader(rowType.Field(f).Tag, opts); header != "" {
+				fields = append(fields, f)
 				headers = append(headers, header)
-				colors = append(colors, tableHeaderBlue)
 			}
-
-			row = append(row, formatValue(elem.Field(f).Interface(), opts, tag))
 		}
-		if r == 0 {
-			table.SetHeader(headers)
-			if opts.Color {
-				table.SetHeaderColor(colors...)
-			}
+		return fields, headers
+	}
+
+	for _, column := range opts.Columns {
+		f := columnField(rowType, column)
+		if f < 0 {
+			ErrorAndExit(fmt.Sprintf("Unable to display column %q, no such column.", column), nil)
 		}
+		fields = append(fields, f)
+		headers = append(headers, rowType.Field(f).Tag.Get("header"))
+	}
+	return fields, headers
+}
 
-		table.Append(row)
+// columnField returns the index of the field of the row type with the given column header, -1 if there is none
+func columnField(rowType reflect.Type, column string) int {
+	for f := 0; f < rowType.NumField(); f++ {
+		if header, ok := rowType.Field(f).Tag.Lookup("header"); ok && strings.EqualFold(header, column) {
+			return f
+		}
 	}
+	return -1
+}
 
-	table.Render()
+// sortRows returns a sorted copy of the slice, ordered by the given field
+func sortRows(sliceValue reflect.Value, fieldIndex int) reflect.Value {
+	sorted := reflect.MakeSlice(sliceValue.Type(), sliceValue.Len(), sliceValue.Len())
+	reflect.Copy(sorted, sliceValue)
+	sort.SliceStable(sorted.Interface(), func(i, j int) bool {
+		return lessValue(sorted.Index(i).Field(fieldIndex), sorted.Index(j).Field(fieldIndex))
+	})
+	return sorted
+}
+
+func lessValue(a, b reflect.Value) bool {
+	if t, ok := a.Interface().(time.Time); ok {
+		return t.Before(b.Interface().(time.Time))
+	}
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return a.Int() < b.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return a.Uint() < b.Uint()
+	case reflect.Float32, reflect.Float64:
+		return a.Float() < b.Float()
+	case reflect.String:
+		return a.String() < b.String()
+	case reflect.Bool:
+		return !a.Bool() && b.Bool()
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			// nil values go first
+			return a.IsNil() && !b.IsNil()
+		}
+		return lessValue(a.Elem(), b.Elem())
+	default:
+		return fmt.Sprintf("%v", a.Interface()) < fmt.Sprintf("%v", b.Interface())
+	}
 }
 
 func columnHeader(tag reflect.StructTag, opts TableOptions) string {
@@ -155,6 +267,7 @@ func formatTime(t time.Time, opts TableOptions) string {
 	if opts.PrintRawTime {
 		return strconv.FormatInt(t.Unix(), 10)
 	}
+	t = inDisplayLocation(t)
 	if opts.PrintDateTime {
 		return t.Format(defaultDateTimeFormat)
 	}
@@ -178,13 +291,18 @@ func formatSearchAttributes(searchAttr *types.SearchAttributes) string {
 	}
 	buf := new(bytes.Buffer)
 	for k, v := range searchAttr.IndexedFields {
-		var decodedVal interface{}
-		json.Unmarshal(v, &decodedVal)
-		fmt.Fprintf(buf, "%s=%v\n", k, decodedVal)
+		fmt.Fprintf(buf, "%s=%v\n", k, decodeSearchAttributeValue(v))
 	}
 	return strings.TrimRight(buf.String(), "\n")
 }
 
+// decodeSearchAttributeValue decodes a search attribute value without knowing its type
+func decodeSearchAttributeValue(value []byte) interface{} {
+	var decodedVal interface{}
+	json.Unmarshal(value, &decodedVal)
+	return decodedVal
+}
+
 func formatString(str string, tag reflect.StructTag) string {
 	if maxLengthStr, ok := tag.Lookup("maxLength"); ok {
 		maxLength, _ := strconv.ParseInt(maxLengthStr, 10, 64)
@@ -66,6 +66,23 @@ func Test_RenderTable(t *testing.T) {
 		"  ...g long long long |     456 | false | 2000-11-12T13:14:15Z |       \n",
 		builder.String())
 
+	builder = &strings.Builder{}
+	RenderTable(builder, table, TableOptions{OptionalColumns: map[string]bool{"memo": false, "search attributes": false}, SortBy: "BOOL"})
+	assert.Equal(t, ""+
+		"        STRING        | INTEGER | BOOL  |   TIME    \n"+
+		"  ...g long long long |     456 | false | 13:14:15  \n"+
+		"  text                |     123 | true  | 03:04:05  \n",
+		builder.String())
+	assert.Equal(t, "text", table[0].StringField, "sorting should not modify the original slice")
+
+	builder = &strings.Builder{}
+	RenderTable(builder, table, TableOptions{OptionalColumns: map[string]bool{"memo": false}, Columns: []string{"MEMO", "integer"}, SortBy: "integer"})
+	assert.Equal(t, ""+
+		"  MEMO | INTEGER  \n"+
+		"  A=AA |     123  \n"+
+		"       |     456  \n",
+		builder.String())
+
 	assert.PanicsWithError(t, "table must be a slice, provided: int", func() { RenderTable(nil, 123, TableOptions{}) })
 	assert.PanicsWithError(t, "table slice element must be a struct, provided: ptr", func() { RenderTable(nil, []*testRow{{}}, TableOptions{}) })
 }
@@ -79,3 +96,44 @@ type testRow struct {
 	SAField      *types.SearchAttributes `header:"search attributes"`
 	IgnoredField int
 }
+
+func Test_RenderTable_Flags(t *testing.T) {
+	defer func() { cliTableFlags = tableFlags{} }()
+	table := []testRow{{StringField: "b", IntField: 1}, {StringField: "a", IntField: 2}}
+
+	// the flags apply to tables of commands which pass no options of their own and take precedence over them
+	cliTableFlags = tableFlags{sortBy: "string", columns: []string{"integer", "STRING"}}
+	builder := &strings.Builder{}
+	RenderTable(builder, table, TableOptions{SortBy: "bool"})
+	assert.Equal(t, ""+
+		"  INTEGER | STRING  \n"+
+		"        2 | a       \n"+
+		"        1 | b       \n",
+		builder.String())
+
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	osExit = func(code int) {
+		panic(code)
+	}
+	// unknown columns are errors even when there are no rows
+	cliTableFlags = tableFlags{columns: []string{"owner"}}
+	assert.PanicsWithValue(t, 1, func() { RenderTable(&strings.Builder{}, []testRow{}, TableOptions{}) })
+	cliTableFlags = tableFlags{sortBy: "owner"}
+	assert.PanicsWithValue(t, 1, func() { RenderTable(&strings.Builder{}, []testRow{}, TableOptions{}) })
+}
+
+func Test_FormatTime_DisplayLocation(t *testing.T) {
+	defer func() { displayLocation = nil }()
+	timestamp := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	assert.Equal(t, "2000-01-02T03:04:05Z", formatTime(timestamp, TableOptions{PrintDateTime: true}))
+
+	location, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	displayLocation = location
+	assert.Equal(t, "2000-01-01T22:04:05-05:00", formatTime(timestamp, TableOptions{PrintDateTime: true}))
+	assert.Equal(t, "22:04:05", formatTime(timestamp, TableOptions{}))
+	assert.Equal(t, "2000-01-01T22:04:05-05:00", convertTime(timestamp.UnixNano(), false))
+	assert.Equal(t, "946782245", formatTime(timestamp, TableOptions{PrintRawTime: true}))
+}
@@ -52,10 +52,29 @@ func newTaskListCommands() []cli.Command {
 					Name:  FlagTaskListWithAlias,
 					Usage: "TaskList description",
 				},
+				cli.BoolFlag{
+					Name:  FlagTaskListStatus,
+					Usage: "Describe every partition and show its backlog and number of outstanding polls",
+				},
 			},
 			Action: func(c *cli.Context) {
 				ListTaskListPartitions(c)
 			},
 		},
+		{
+			Name:        "list",
+			Aliases:     []string{"l"},
+			Usage:       "List the tasklists of a domain with their tags",
+			Description: "Lists the tasklists currently loaded by matching hosts. Tags are set with admin tasklist tag",
+			Flags: []cli.Flag{
+				cli.StringSliceFlag{
+					Name:  FlagTaskListTag,
+					Usage: "Optional tag in the format key=value, only tasklists having all the given tags are listed. Can be passed multiple times",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ListTaskLists(c)
+			},
+		},
 	}
 }
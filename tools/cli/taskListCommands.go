@@ -21,7 +21,9 @@
 package cli
 
 import (
-	"os"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/uber/cadence/common/types"
@@ -39,6 +41,13 @@ type (
 		ActivityPartition string `header:"Activity Task List Partition"`
 		DecisionPartition string `header:"Decision Task List Partition"`
 		Host              string `header:"Host"`
+		Backlog           int64  `header:"Backlog"`
+		OutstandingPolls  int64  `header:"Outstanding Polls"`
+	}
+	TaskListTagsRow struct {
+		Name string `header:"Task List Name"`
+		Type string `header:"Type"`
+		Tags string `header:"Tags"`
 	}
 )
 
@@ -89,12 +98,97 @@ func ListTaskListPartitions(c *cli.Context) {
 	if err != nil {
 		ErrorAndExit("Operation ListTaskListPartitions failed.", err)
 	}
+	showStatus := c.Bool(FlagTaskListStatus)
 	if len(response.DecisionTaskListPartitions) > 0 {
-		printTaskListPartitions("Decision", response.DecisionTaskListPartitions)
+		var statuses map[string]*types.TaskListStatus
+		if showStatus {
+			statuses = describeTaskListPartitions(c, domain, types.TaskListTypeDecision, response.DecisionTaskListPartitions)
+		}
+		printTaskListPartitions("Decision", response.DecisionTaskListPartitions, statuses)
 	}
 	if len(response.ActivityTaskListPartitions) > 0 {
-		printTaskListPartitions("Activity", response.ActivityTaskListPartitions)
+		var statuses map[string]*types.TaskListStatus
+		if showStatus {
+			statuses = describeTaskListPartitions(c, domain, types.TaskListTypeActivity, response.ActivityTaskListPartitions)
+		}
+		printTaskListPartitions("Activity", response.ActivityTaskListPartitions, statuses)
+	}
+}
+
+// ListTaskLists lists the tasklists of a domain loaded by matching hosts with their tags
+func ListTaskLists(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	tags := parseTaskListTags(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.ListTaskListTags(ctx, &types.ListTaskListTagsRequest{
+		Domain: domain,
+		Tags:   tags,
+	})
+	if err != nil {
+		ErrorAndExit("Operation ListTaskListTags failed.", err)
+	}
+
+	table := make([]TaskListTagsRow, 0, len(response.GetTaskLists()))
+	for _, taskList := range response.GetTaskLists() {
+		table = append(table, TaskListTagsRow{
+			Name: taskList.GetName(),
+			Type: taskList.GetTaskListType().String(),
+			Tags: formatTaskListTags(taskList.GetTags()),
+		})
+	}
+	RenderTable(getOutput(), table, TableOptions{Color: true, Border: true})
+}
+
+// parseTaskListTags parses the key=value tags of the command, the value may be empty
+func parseTaskListTags(c *cli.Context) map[string]string {
+	tags := make(map[string]string)
+	for _, tag := range c.StringSlice(FlagTaskListTag) {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			ErrorAndExit(fmt.Sprintf("Invalid tag %q, expected format key=value.", tag), nil)
+		}
+		tags[parts[0]] = parts[1]
+	}
+	return tags
+}
+
+func formatTaskListTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for key, value := range tags {
+		pairs = append(pairs, key+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ", ")
+}
+
+// describeTaskListPartitions returns the status of every partition keyed by partition name,
+// the outstanding poll count together with the backlog tells whether pollers or dispatch is the bottleneck
+func describeTaskListPartitions(
+	c *cli.Context,
+	domain string,
+	taskListType types.TaskListType,
+	partitions []*types.TaskListPartitionMetadata,
+) map[string]*types.TaskListStatus {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	statuses := make(map[string]*types.TaskListStatus, len(partitions))
+	for _, partition := range partitions {
+		ctx, cancel := newContext(c)
+		response, err := frontendClient.DescribeTaskList(ctx, &types.DescribeTaskListRequest{
+			Domain:                domain,
+			TaskList:              &types.TaskList{Name: partition.GetKey()},
+			TaskListType:          taskListType.Ptr(),
+			IncludeTaskListStatus: true,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Operation DescribeTaskList failed for partition %v.", partition.GetKey()), err)
+		}
+		statuses[partition.GetKey()] = response.GetTaskListStatus()
 	}
+	return statuses
 }
 
 func printTaskListPollers(pollers []*types.PollerInfo, taskListType types.TaskListType) {
@@ -105,23 +199,32 @@ func printTaskListPollers(pollers []*types.PollerInfo, taskListType types.TaskLi
 			DecisionIdentity: poller.GetIdentity(),
 			LastAccessTime:   time.Unix(0, poller.GetLastAccessTime())})
 	}
-	RenderTable(os.Stdout, table, TableOptions{Color: true, PrintDateTime: true, OptionalColumns: map[string]bool{
+	RenderTable(getOutput(), table, TableOptions{Color: true, PrintDateTime: true, OptionalColumns: map[string]bool{
 		"Activity Poller Identity": taskListType == types.TaskListTypeActivity,
 		"Decision Poller Identity": taskListType == types.TaskListTypeDecision,
 	}})
 }
 
-func printTaskListPartitions(taskListType string, partitions []*types.TaskListPartitionMetadata) {
+func printTaskListPartitions(
+	taskListType string,
+	partitions []*types.TaskListPartitionMetadata,
+	statuses map[string]*types.TaskListStatus,
+) {
 	table := []TaskListPartitionRow{}
 	for _, partition := range partitions {
+		status := statuses[partition.GetKey()]
 		table = append(table, TaskListPartitionRow{
 			ActivityPartition: partition.GetKey(),
 			DecisionPartition: partition.GetKey(),
 			Host:              partition.GetOwnerHostName(),
+			Backlog:           status.GetBacklogCountHint(),
+			OutstandingPolls:  status.GetOutstandingPollCount(),
 		})
 	}
-	RenderTable(os.Stdout, table, TableOptions{Color: true, OptionalColumns: map[string]bool{
+	RenderTable(getOutput(), table, TableOptions{Color: true, OptionalColumns: map[string]bool{
 		"Activity Task List Partition": taskListType == "Activity",
 		"Decision Task List Partition": taskListType == "Decision",
+		"Backlog":                      statuses != nil,
+		"Outstanding Polls":            statuses != nil,
 	}})
 }
@@ -0,0 +1,204 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tui
+
+import (
+	"fmt"
+	"strings"
+)
+
+type (
+	// Browser shows a stack of lists, the list of a row is pushed when the row is opened
+	// and popped when going back
+	Browser struct {
+		stack   []*List
+		prompt  *prompt
+		message string
+		width   int
+		height  int
+	}
+
+	prompt struct {
+		label string
+		text  []rune
+		done  func(text string) error
+	}
+)
+
+const helpMessage = "↑/↓ PgUp/PgDn Home/End move  enter open  / search  n/N next/previous  f filter  esc back  q quit"
+
+// NewBrowser returns a browser showing the root list
+func NewBrowser(root *List) *Browser {
+	return &Browser{
+		stack:   []*List{root},
+		message: helpMessage,
+		width:   80,
+		height:  24,
+	}
+}
+
+// Done returns true once the user has quit the browser
+func (b *Browser) Done() bool {
+	return len(b.stack) == 0
+}
+
+// Resize sets the size of the screen
+func (b *Browser) Resize(width, height int) {
+	b.width = width
+	b.height = height
+}
+
+// Start loads the first page of the root list
+func (b *Browser) Start() {
+	b.report(b.current().fill(1))
+}
+
+func (b *Browser) current() *List {
+	return b.stack[len(b.stack)-1]
+}
+
+// pageSize returns the number of rows on the screen
+func (b *Browser) pageSize() int {
+	// the title and the status lines, and the header of the list
+	size := b.height - 3
+	if size < 1 {
+		size = 1
+	}
+	return size
+}
+
+// HandleKey updates the browser on a key press
+func (b *Browser) HandleKey(k Key) {
+	if b.Done() {
+		return
+	}
+	if b.prompt != nil {
+		b.handlePromptKey(k)
+		return
+	}
+
+	b.message = ""
+	list := b.current()
+	switch {
+	case k.Code == KeyCtrlC || k.Is('q'):
+		b.stack = nil
+	case k.Code == KeyEsc || k.Code == KeyBackspace || k.Code == KeyLeft:
+		b.stack = b.stack[:len(b.stack)-1]
+	case k.Code == KeyUp || k.Is('k'):
+		b.report(list.Move(-1))
+	case k.Code == KeyDown || k.Is('j'):
+		b.report(list.Move(1))
+	case k.Code == KeyPgUp || k.Is('b'):
+		b.report(list.Move(-b.pageSize()))
+	case k.Code == KeyPgDn || k.Is(' '):
+		b.report(list.Move(b.pageSize()))
+	case k.Code == KeyHome || k.Is('g'):
+		b.report(list.Move(-list.cursor))
+	case k.Code == KeyEnd || k.Is('G'):
+		b.report(list.End())
+	case k.Code == KeyEnter || k.Code == KeyRight:
+		b.open()
+	case k.Is('/'):
+		b.prompt = &prompt{label: "search: ", done: func(text string) error {
+			found, err := list.Search(text)
+			if err == nil && !found {
+				b.message = fmt.Sprintf("%q not found", text)
+			}
+			return err
+		}}
+	case k.Is('n') || k.Is('N'):
+		found, err := list.SearchNext(k.Is('N'))
+		if err == nil && !found && list.search != "" {
+			b.message = fmt.Sprintf("no more rows with %q", list.search)
+		}
+		b.report(err)
+	case k.Is('f'):
+		b.prompt = &prompt{label: "filter: ", text: []rune(list.filter), done: list.Filter}
+	}
+}
+
+func (b *Browser) handlePromptKey(k Key) {
+	p := b.prompt
+	switch k.Code {
+	case KeyRune:
+		p.text = append(p.text, k.Rune)
+	case KeyBackspace:
+		if len(p.text) > 0 {
+			p.text = p.text[:len(p.text)-1]
+		}
+	case KeyEnter:
+		b.prompt = nil
+		b.message = ""
+		b.report(p.done(string(p.text)))
+	case KeyEsc, KeyCtrlC:
+		b.prompt = nil
+	}
+}
+
+func (b *Browser) open() {
+	row := b.current().Selected()
+	if row == nil || row.Open == nil {
+		return
+	}
+	list, err := row.Open()
+	if err != nil {
+		b.report(err)
+		return
+	}
+	b.stack = append(b.stack, list)
+	b.report(list.fill(1))
+}
+
+// report shows the error of an action in the status line, the browser stays open so that the user can go on
+func (b *Browser) report(err error) {
+	if err != nil {
+		b.message = "error: " + err.Error()
+	}
+}
+
+// Render returns the lines of the screen: the path of the open lists, the current list and the status line
+func (b *Browser) Render() []string {
+	if b.Done() {
+		return nil
+	}
+	list := b.current()
+
+	var titles []string
+	for _, l := range b.stack {
+		titles = append(titles, l.Title())
+	}
+	lines := []string{"\x1b[1m" + truncate(strings.Join(titles, " > "), b.width) + "\x1b[0m"}
+
+	body := list.Render(b.width, b.height-2)
+	lines = append(lines, body...)
+	for len(lines) < b.height-1 {
+		lines = append(lines, "")
+	}
+
+	status := list.status()
+	if b.message != "" {
+		status += "  " + b.message
+	}
+	if b.prompt != nil {
+		status = b.prompt.label + string(b.prompt.text)
+	}
+	return append(lines, truncate(status, b.width))
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestList returns a list of pages of rows "row <n>", counting the loaded pages
+func newTestList(pages, pageSize int, loaded *int) *List {
+	return NewList("test", []string{"NAME", "INDEX"}, func() ([]Row, bool, error) {
+		var rows []Row
+		for i := 0; i < pageSize; i++ {
+			n := *loaded*pageSize + i
+			rows = append(rows, Row{
+				Columns: []string{fmt.Sprintf("row %d", n), fmt.Sprint(n)},
+				Open: func() (*List, error) {
+					return NewText(fmt.Sprintf("row %d", n), fmt.Sprintf("details of %d\nsecond line", n)), nil
+				},
+			})
+		}
+		*loaded++
+		return rows, *loaded < pages, nil
+	})
+}
+
+func TestList_LoadsPagesWhileMoving(t *testing.T) {
+	loaded := 0
+	list := newTestList(3, 10, &loaded)
+	require.NoError(t, list.fill(1))
+	assert.Equal(t, 1, loaded)
+	assert.Equal(t, "1/10+", list.status())
+
+	require.NoError(t, list.Move(9))
+	assert.Equal(t, 1, loaded)
+	require.NoError(t, list.Move(1))
+	assert.Equal(t, 2, loaded)
+	assert.Equal(t, "row 10", list.Selected().Columns[0])
+
+	require.NoError(t, list.End())
+	assert.Equal(t, 3, loaded)
+	assert.Equal(t, "30/30", list.status())
+
+	require.NoError(t, list.Move(100))
+	assert.Equal(t, "row 29", list.Selected().Columns[0])
+	require.NoError(t, list.Move(-100))
+	assert.Equal(t, "row 0", list.Selected().Columns[0])
+}
+
+func TestList_SearchAndFilter(t *testing.T) {
+	loaded := 0
+	list := newTestList(3, 10, &loaded)
+	require.NoError(t, list.fill(1))
+
+	// search loads pages until a row matches
+	found, err := list.Search("ROW 25")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 3, loaded)
+	assert.Equal(t, "row 25", list.Selected().Columns[0])
+
+	found, err = list.Search("row 2")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "row 25", list.Selected().Columns[0])
+	found, err = list.SearchNext(false)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "row 26", list.Selected().Columns[0])
+	found, err = list.SearchNext(true)
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "row 25", list.Selected().Columns[0])
+
+	found, err = list.Search("missing")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, list.Filter("row 1"))
+	assert.Equal(t, "row 1", list.Selected().Columns[0])
+	assert.Contains(t, list.status(), "1/11")
+	require.NoError(t, list.Filter(""))
+	assert.Contains(t, list.status(), "1/30")
+}
+
+func TestList_Render(t *testing.T) {
+	loaded := 0
+	list := newTestList(1, 20, &loaded)
+	require.NoError(t, list.fill(1))
+	require.NoError(t, list.Move(12))
+
+	lines := list.Render(12, 6)
+	require.Len(t, lines, 6)
+	assert.Equal(t, "\x1b[1mNAME    I...\x1b[0m", lines[0])
+	// scrolled so that the selected row is the last one on the screen
+	assert.Equal(t, "row 8   8", lines[1])
+	assert.Equal(t, "\x1b[7mrow 12  12  \x1b[0m", lines[5])
+}
+
+func TestBrowser_HandleKey(t *testing.T) {
+	loaded := 0
+	b := NewBrowser(newTestList(2, 10, &loaded))
+	b.Resize(40, 10)
+	b.Start()
+	assert.Equal(t, 1, loaded)
+
+	for _, k := range DecodeKeys([]byte("jj\x1b[B")) {
+		b.HandleKey(k)
+	}
+	assert.Equal(t, "row 3", b.current().Selected().Columns[0])
+
+	// drill down into the selected row and back
+	b.HandleKey(Key{Code: KeyEnter})
+	require.Len(t, b.stack, 2)
+	lines := b.Render()
+	require.Len(t, lines, 10)
+	assert.Equal(t, "\x1b[1mtest > row 3\x1b[0m", lines[0])
+	assert.Contains(t, lines[1], "details of 3")
+	assert.Equal(t, "second line", lines[2])
+	b.HandleKey(Key{Code: KeyEsc})
+	require.Len(t, b.stack, 1)
+
+	// search prompt
+	for _, k := range DecodeKeys([]byte("/row 15\r")) {
+		b.HandleKey(k)
+	}
+	assert.Equal(t, 2, loaded)
+	assert.Equal(t, "row 15", b.current().Selected().Columns[0])
+
+	// filter prompt, cancelled with escape
+	for _, k := range DecodeKeys([]byte("fxyz")) {
+		b.HandleKey(k)
+	}
+	assert.True(t, strings.HasSuffix(b.Render()[9], "filter: xyz"))
+	b.HandleKey(Key{Code: KeyEsc})
+	assert.Equal(t, "row 15", b.current().Selected().Columns[0])
+
+	b.HandleKey(Key{Code: KeyRune, Rune: 'q'})
+	assert.True(t, b.Done())
+}
+
+func TestBrowser_ReportsErrors(t *testing.T) {
+	b := NewBrowser(NewList("failing", nil, func() ([]Row, bool, error) {
+		return nil, false, errors.New("service busy")
+	}))
+	b.Resize(80, 5)
+	b.Start()
+	assert.False(t, b.Done())
+	assert.Contains(t, b.Render()[4], "error: service busy")
+}
+
+func TestDecodeKeys(t *testing.T) {
+	assert.Equal(t, []Key{{Code: KeyEsc}}, DecodeKeys([]byte("\x1b")))
+	assert.Equal(t, []Key{
+		{Code: KeyUp},
+		{Code: KeyPgDn},
+		{Code: KeyHome},
+		{Code: KeyRune, Rune: 'é'},
+		{Code: KeyEnter},
+		{Code: KeyBackspace},
+		{Code: KeyCtrlC},
+		{Code: KeyUnknown},
+	}, DecodeKeys([]byte("\x1b[A\x1b[6~\x1bOHé\r\x7f\x03\x1b[1;5A")))
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package tui implements the interactive terminal browser of the CLI. It shows lists of rows,
+// e.g. workflows or history events, which are loaded page by page while scrolling and can be
+// searched, filtered and opened to drill down into the details of a row.
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type (
+	// Row is a row of a list
+	Row struct {
+		Columns []string
+		// Open returns the list shown when the row is opened, nil if the row has no details
+		Open func() (*List, error)
+	}
+
+	// PageFunc loads the next page of rows, more is false once all the rows are loaded
+	PageFunc func() (rows []Row, more bool, err error)
+
+	// List is a scrollable list of rows, loaded page by page as the cursor reaches its end
+	List struct {
+		title    string
+		header   []string
+		loadPage PageFunc
+		more     bool
+
+		rows    []Row
+		visible []int // indexes of the rows matching the filter
+		filter  string
+		search  string
+		cursor  int // index of the selected row in visible
+		offset  int // index of the first row on the screen in visible
+	}
+)
+
+const columnSeparator = "  "
+
+// NewList returns a list with the given column headers, which loads its rows with loadPage
+func NewList(title string, header []string, loadPage PageFunc) *List {
+	return &List{
+		title:    title,
+		header:   header,
+		loadPage: loadPage,
+		more:     true,
+	}
+}
+
+// NewText returns a list of the lines of text, e.g. to show the details of a row
+func NewText(title, text string) *List {
+	var rows []Row
+	for _, line := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		rows = append(rows, Row{Columns: []string{strings.ReplaceAll(line, "\t", "    ")}})
+	}
+	return NewList(title, nil, func() ([]Row, bool, error) {
+		return rows, false, nil
+	})
+}
+
+// Title returns the title of the list
+func (l *List) Title() string {
+	return l.title
+}
+
+// Selected returns the selected row, nil if the list is empty
+func (l *List) Selected() *Row {
+	if l.cursor >= len(l.visible) {
+		return nil
+	}
+	return &l.rows[l.visible[l.cursor]]
+}
+
+// status returns the position of the cursor and the active filter and search
+func (l *List) status() string {
+	total := fmt.Sprintf("%d", len(l.visible))
+	if l.more {
+		total += "+"
+	}
+	position := 0
+	if len(l.visible) > 0 {
+		position = l.cursor + 1
+	}
+	status := fmt.Sprintf("%d/%s", position, total)
+	if l.filter != "" {
+		status += fmt.Sprintf("  filter: %q", l.filter)
+	}
+	if l.search != "" {
+		status += fmt.Sprintf("  search: %q", l.search)
+	}
+	return status
+}
+
+// load loads the next page of rows, if any
+func (l *List) load() error {
+	if !l.more {
+		return nil
+	}
+	rows, more, err := l.loadPage()
+	if err != nil {
+		return err
+	}
+	l.more = more
+	for _, row := range rows {
+		l.rows = append(l.rows, row)
+		if matches(row, l.filter) {
+			l.visible = append(l.visible, len(l.rows)-1)
+		}
+	}
+	return nil
+}
+
+// fill loads pages until the list has at least n visible rows or all rows are loaded
+func (l *List) fill(n int) error {
+	for len(l.visible) < n && l.more {
+		if err := l.load(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Move moves the cursor by delta rows, loading more rows when it moves past the loaded ones
+func (l *List) Move(delta int) error {
+	cursor := l.cursor + delta
+	err := l.fill(cursor + 1)
+	if cursor >= len(l.visible) {
+		cursor = len(l.visible) - 1
+	}
+	if cursor < 0 {
+		cursor = 0
+	}
+	l.cursor = cursor
+	return err
+}
+
+// End moves the cursor to the last row, which loads all the rows
+func (l *List) End() error {
+	err := l.fill(int(^uint(0) >> 1))
+	l.cursor = len(l.visible) - 1
+	if l.cursor < 0 {
+		l.cursor = 0
+	}
+	return err
+}
+
+// Filter only shows the rows containing the text, case insensitive. An empty text shows all the rows.
+func (l *List) Filter(text string) error {
+	l.filter = text
+	l.visible = l.visible[:0]
+	for i, row := range l.rows {
+		if matches(row, text) {
+			l.visible = append(l.visible, i)
+		}
+	}
+	l.cursor = 0
+	l.offset = 0
+	return l.fill(1)
+}
+
+// Search moves the cursor to the next row containing the text, case insensitive, loading more rows if needed.
+// It returns false if no row after the cursor contains the text.
+func (l *List) Search(text string) (bool, error) {
+	l.search = text
+	return l.searchFrom(l.cursor, 1)
+}
+
+// SearchNext moves the cursor to the next row matching the last search, or the previous one if backward is set
+func (l *List) SearchNext(backward bool) (bool, error) {
+	if l.search == "" {
+		return false, nil
+	}
+	if backward {
+		return l.searchFrom(l.cursor-1, -1)
+	}
+	return l.searchFrom(l.cursor+1, 1)
+}
+
+func (l *List) searchFrom(start, step int) (bool, error) {
+	for i := start; i >= 0; i += step {
+		if i >= len(l.visible) {
+			if step < 0 || !l.more {
+				return false, nil
+			}
+			if err := l.load(); err != nil {
+				return false, err
+			}
+			if i >= len(l.visible) {
+				continue
+			}
+		}
+		if matches(l.rows[l.visible[i]], l.search) {
+			l.cursor = i
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Render returns the lines showing the list in a screen of the given size,
+// scrolled so that the cursor is visible. The selected row is shown in reverse video.
+func (l *List) Render(width, height int) []string {
+	if len(l.header) > 0 {
+		height--
+	}
+	if height < 1 || width < 1 {
+		return nil
+	}
+	if l.cursor < l.offset {
+		l.offset = l.cursor
+	}
+	if l.cursor >= l.offset+height {
+		l.offset = l.cursor - height + 1
+	}
+	end := l.offset + height
+	if end > len(l.visible) {
+		end = len(l.visible)
+	}
+
+	// size the columns on the rows of the screen, so that they don't jump while scrolling
+	widths := columnWidths(l.header, nil)
+	for _, i := range l.visible[l.offset:end] {
+		widths = columnWidths(l.rows[i].Columns, widths)
+	}
+
+	var lines []string
+	if len(l.header) > 0 {
+		lines = append(lines, "\x1b[1m"+formatColumns(l.header, widths, width)+"\x1b[0m")
+	}
+	for i := l.offset; i < end; i++ {
+		line := formatColumns(l.rows[l.visible[i]].Columns, widths, width)
+		if i == l.cursor {
+			line = "\x1b[7m" + line + strings.Repeat(" ", width-utf8.RuneCountInString(line)) + "\x1b[0m"
+		}
+		lines = append(lines, line)
+	}
+	if len(l.visible) == 0 {
+		lines = append(lines, "(no rows)")
+	}
+	return lines
+}
+
+func matches(row Row, text string) bool {
+	if text == "" {
+		return true
+	}
+	text = strings.ToLower(text)
+	for _, column := range row.Columns {
+		if strings.Contains(strings.ToLower(column), text) {
+			return true
+		}
+	}
+	return false
+}
+
+func columnWidths(columns []string, widths []int) []int {
+	for i, column := range columns {
+		if i >= len(widths) {
+			widths = append(widths, 0)
+		}
+		if w := utf8.RuneCountInString(column); w > widths[i] {
+			widths[i] = w
+		}
+	}
+	return widths
+}
+
+// formatColumns pads the columns to their widths and truncates the line to the width of the screen
+func formatColumns(columns []string, widths []int, width int) string {
+	sb := &strings.Builder{}
+	for i, column := range columns {
+		if i > 0 {
+			sb.WriteString(columnSeparator)
+		}
+		sb.WriteString(column)
+		if i < len(columns)-1 {
+			sb.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(column)))
+		}
+	}
+	return truncate(sb.String(), width)
+}
+
+func truncate(s string, width int) string {
+	if utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	if width <= 3 {
+		return string([]rune(s)[:width])
+	}
+	return string([]rune(s)[:width-3]) + "..."
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+type (
+	// KeyCode identifies a key press, KeyRune for printable characters
+	KeyCode int
+
+	// Key is a key press read from the terminal
+	Key struct {
+		Code KeyCode
+		Rune rune
+	}
+
+	// Terminal shows a browser on a terminal in raw mode
+	Terminal struct {
+		in    *os.File
+		out   io.Writer
+		state *terminal.State
+	}
+)
+
+// Keys read from the terminal
+const (
+	KeyRune KeyCode = iota
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyPgUp
+	KeyPgDn
+	KeyHome
+	KeyEnd
+	KeyEnter
+	KeyEsc
+	KeyBackspace
+	KeyCtrlC
+	KeyUnknown
+)
+
+const (
+	enterAltScreen = "\x1b[?1049h\x1b[?25l"
+	exitAltScreen  = "\x1b[?25h\x1b[?1049l"
+	clearScreen    = "\x1b[H\x1b[2J"
+)
+
+var escapeSequences = map[string]KeyCode{
+	"[A": KeyUp, "OA": KeyUp,
+	"[B": KeyDown, "OB": KeyDown,
+	"[C": KeyRight, "OC": KeyRight,
+	"[D": KeyLeft, "OD": KeyLeft,
+	"[H": KeyHome, "OH": KeyHome, "[1~": KeyHome, "[7~": KeyHome,
+	"[F": KeyEnd, "OF": KeyEnd, "[4~": KeyEnd, "[8~": KeyEnd,
+	"[5~": KeyPgUp,
+	"[6~": KeyPgDn,
+}
+
+// Is returns true if the key is the printable character r
+func (k Key) Is(r rune) bool {
+	return k.Code == KeyRune && k.Rune == r
+}
+
+// DecodeKeys decodes the key presses of a read from a terminal in raw mode.
+// An escape alone in a read is the escape key, otherwise it starts an escape sequence.
+func DecodeKeys(b []byte) []Key {
+	var keys []Key
+	for len(b) > 0 {
+		switch b[0] {
+		case 0x1b:
+			if len(b) == 1 {
+				return append(keys, Key{Code: KeyEsc})
+			}
+			n := escapeSequenceLength(b[1:])
+			code, ok := escapeSequences[string(b[1:1+n])]
+			if !ok {
+				code = KeyUnknown
+			}
+			keys = append(keys, Key{Code: code})
+			b = b[1+n:]
+			continue
+		case '\r', '\n':
+			keys = append(keys, Key{Code: KeyEnter})
+		case 0x7f, 0x08:
+			keys = append(keys, Key{Code: KeyBackspace})
+		case 0x03:
+			keys = append(keys, Key{Code: KeyCtrlC})
+		default:
+			r, size := utf8.DecodeRune(b)
+			if r >= ' ' {
+				keys = append(keys, Key{Code: KeyRune, Rune: r})
+			}
+			b = b[size:]
+			continue
+		}
+		b = b[1:]
+	}
+	return keys
+}
+
+// escapeSequenceLength returns the length of the escape sequence following an escape:
+// a CSI sequence ending with a byte in @-~, or a single character, e.g. O followed by a letter
+func escapeSequenceLength(b []byte) int {
+	switch b[0] {
+	case '[':
+		for i := 1; i < len(b); i++ {
+			if b[i] >= 0x40 && b[i] <= 0x7e {
+				return i + 1
+			}
+		}
+		return len(b)
+	case 'O':
+		if len(b) > 1 {
+			return 2
+		}
+	}
+	return 1
+}
+
+// OpenTerminal switches the terminal to raw mode and the alternate screen, so that the screen of the shell
+// is restored once the browser is closed
+func OpenTerminal(in *os.File, out io.Writer) (*Terminal, error) {
+	fd := int(in.Fd())
+	if !terminal.IsTerminal(fd) {
+		return nil, errors.New("interactive mode requires a terminal")
+	}
+	state, err := terminal.MakeRaw(fd)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprint(out, enterAltScreen)
+	return &Terminal{in: in, out: out, state: state}, nil
+}
+
+// Close restores the terminal, it can be called more than once
+func (t *Terminal) Close() error {
+	if t.state == nil {
+		return nil
+	}
+	fmt.Fprint(t.out, exitAltScreen)
+	err := terminal.Restore(int(t.in.Fd()), t.state)
+	t.state = nil
+	return err
+}
+
+// Run shows the browser until the user quits it
+func (t *Terminal) Run(b *Browser) error {
+	b.Start()
+	buf := make([]byte, 256)
+	for !b.Done() {
+		if width, height, err := terminal.GetSize(int(t.in.Fd())); err == nil {
+			b.Resize(width, height)
+		}
+		t.draw(b.Render())
+
+		n, err := t.in.Read(buf)
+		if err != nil {
+			return err
+		}
+		for _, k := range DecodeKeys(buf[:n]) {
+			b.HandleKey(k)
+		}
+	}
+	return nil
+}
+
+func (t *Terminal) draw(lines []string) {
+	sb := &strings.Builder{}
+	sb.WriteString(clearScreen)
+	// raw mode doesn't translate line feeds, so each line has to return the carriage too
+	sb.WriteString(strings.Join(lines, "\r\n"))
+	fmt.Fprint(t.out, sb.String())
+}
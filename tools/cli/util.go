@@ -25,6 +25,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/rsa"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -50,6 +51,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/authorization"
+	jsonmapper "github.com/uber/cadence/common/types/mapper/json"
 )
 
 // JSONHistorySerializer is used to encode history event in JSON
@@ -73,7 +75,7 @@ func (j *JSONHistorySerializer) Deserialize(data []byte) (*types.History, error)
 // GetHistory helper method to iterate over all pages and return complete list of history events
 func GetHistory(ctx context.Context, workflowClient frontend.Client, domain, workflowID, runID string) (*types.History, error) {
 	events := []*types.HistoryEvent{}
-	iterator, err := GetWorkflowHistoryIterator(ctx, workflowClient, domain, workflowID, runID, false, types.HistoryEventFilterTypeAllEvent.Ptr())
+	iterator, err := GetWorkflowHistoryIterator(ctx, workflowClient, domain, workflowID, runID, false, types.HistoryEventFilterTypeAllEvent.Ptr(), nil)
 	for iterator.HasNext() {
 		entity, err := iterator.Next()
 		if err != nil {
@@ -95,6 +97,7 @@ func GetWorkflowHistoryIterator(
 	runID string,
 	isLongPoll bool,
 	filterType *types.HistoryEventFilterType,
+	eventTypes []types.EventType,
 ) (pagination.Iterator, error) {
 	paginate := func(ctx context.Context, pageToken pagination.PageToken) (pagination.Page, error) {
 		tcCtx, cancel := context.WithTimeout(ctx, 25*time.Second)
@@ -114,6 +117,7 @@ func GetWorkflowHistoryIterator(
 			HistoryEventFilterType: filterType,
 			NextPageToken:          nextPageToken,
 			SkipArchival:           isLongPoll,
+			EventTypes:             eventTypes,
 		}
 
 		var resp *types.GetWorkflowExecutionHistoryResponse
@@ -274,12 +278,13 @@ func breakLongWords(input string, maxWordLength int) string {
 
 // ColorEvent takes an event and return string with color
 // Event with color mapping rules:
-//   Failed - red
-//   Timeout - yellow
-//   Canceled - magenta
-//   Completed - green
-//   Started - blue
-//   Others - default (white/black)
+//
+//	Failed - red
+//	Timeout - yellow
+//	Canceled - magenta
+//	Completed - green
+//	Started - blue
+//	Others - default (white/black)
 func ColorEvent(e *types.HistoryEvent) string {
 	var data string
 	switch e.GetEventType() {
@@ -413,132 +418,10 @@ func ColorEvent(e *types.HistoryEvent) string {
 }
 
 func getEventAttributes(e *types.HistoryEvent) interface{} {
-	var data interface{}
-	switch e.GetEventType() {
-	case types.EventTypeWorkflowExecutionStarted:
-		data = e.WorkflowExecutionStartedEventAttributes
-
-	case types.EventTypeWorkflowExecutionCompleted:
-		data = e.WorkflowExecutionCompletedEventAttributes
-
-	case types.EventTypeWorkflowExecutionFailed:
-		data = e.WorkflowExecutionFailedEventAttributes
-
-	case types.EventTypeWorkflowExecutionTimedOut:
-		data = e.WorkflowExecutionTimedOutEventAttributes
-
-	case types.EventTypeDecisionTaskScheduled:
-		data = e.DecisionTaskScheduledEventAttributes
-
-	case types.EventTypeDecisionTaskStarted:
-		data = e.DecisionTaskStartedEventAttributes
-
-	case types.EventTypeDecisionTaskCompleted:
-		data = e.DecisionTaskCompletedEventAttributes
-
-	case types.EventTypeDecisionTaskTimedOut:
-		data = e.DecisionTaskTimedOutEventAttributes
-
-	case types.EventTypeActivityTaskScheduled:
-		data = e.ActivityTaskScheduledEventAttributes
-
-	case types.EventTypeActivityTaskStarted:
-		data = e.ActivityTaskStartedEventAttributes
-
-	case types.EventTypeActivityTaskCompleted:
-		data = e.ActivityTaskCompletedEventAttributes
-
-	case types.EventTypeActivityTaskFailed:
-		data = e.ActivityTaskFailedEventAttributes
-
-	case types.EventTypeActivityTaskTimedOut:
-		data = e.ActivityTaskTimedOutEventAttributes
-
-	case types.EventTypeActivityTaskCancelRequested:
-		data = e.ActivityTaskCancelRequestedEventAttributes
-
-	case types.EventTypeRequestCancelActivityTaskFailed:
-		data = e.RequestCancelActivityTaskFailedEventAttributes
-
-	case types.EventTypeActivityTaskCanceled:
-		data = e.ActivityTaskCanceledEventAttributes
-
-	case types.EventTypeTimerStarted:
-		data = e.TimerStartedEventAttributes
-
-	case types.EventTypeTimerFired:
-		data = e.TimerFiredEventAttributes
-
-	case types.EventTypeCancelTimerFailed:
-		data = e.CancelTimerFailedEventAttributes
-
-	case types.EventTypeTimerCanceled:
-		data = e.TimerCanceledEventAttributes
-
-	case types.EventTypeWorkflowExecutionCancelRequested:
-		data = e.WorkflowExecutionCancelRequestedEventAttributes
-
-	case types.EventTypeWorkflowExecutionCanceled:
-		data = e.WorkflowExecutionCanceledEventAttributes
-
-	case types.EventTypeRequestCancelExternalWorkflowExecutionInitiated:
-		data = e.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
-
-	case types.EventTypeRequestCancelExternalWorkflowExecutionFailed:
-		data = e.RequestCancelExternalWorkflowExecutionFailedEventAttributes
-
-	case types.EventTypeExternalWorkflowExecutionCancelRequested:
-		data = e.ExternalWorkflowExecutionCancelRequestedEventAttributes
-
-	case types.EventTypeMarkerRecorded:
-		data = e.MarkerRecordedEventAttributes
-
-	case types.EventTypeWorkflowExecutionSignaled:
-		data = e.WorkflowExecutionSignaledEventAttributes
-
-	case types.EventTypeWorkflowExecutionTerminated:
-		data = e.WorkflowExecutionTerminatedEventAttributes
-
-	case types.EventTypeWorkflowExecutionContinuedAsNew:
-		data = e.WorkflowExecutionContinuedAsNewEventAttributes
-
-	case types.EventTypeStartChildWorkflowExecutionInitiated:
-		data = e.StartChildWorkflowExecutionInitiatedEventAttributes
-
-	case types.EventTypeStartChildWorkflowExecutionFailed:
-		data = e.StartChildWorkflowExecutionFailedEventAttributes
-
-	case types.EventTypeChildWorkflowExecutionStarted:
-		data = e.ChildWorkflowExecutionStartedEventAttributes
-
-	case types.EventTypeChildWorkflowExecutionCompleted:
-		data = e.ChildWorkflowExecutionCompletedEventAttributes
-
-	case types.EventTypeChildWorkflowExecutionFailed:
-		data = e.ChildWorkflowExecutionFailedEventAttributes
-
-	case types.EventTypeChildWorkflowExecutionCanceled:
-		data = e.ChildWorkflowExecutionCanceledEventAttributes
-
-	case types.EventTypeChildWorkflowExecutionTimedOut:
-		data = e.ChildWorkflowExecutionTimedOutEventAttributes
-
-	case types.EventTypeChildWorkflowExecutionTerminated:
-		data = e.ChildWorkflowExecutionTerminatedEventAttributes
-
-	case types.EventTypeSignalExternalWorkflowExecutionInitiated:
-		data = e.SignalExternalWorkflowExecutionInitiatedEventAttributes
-
-	case types.EventTypeSignalExternalWorkflowExecutionFailed:
-		data = e.SignalExternalWorkflowExecutionFailedEventAttributes
-
-	case types.EventTypeExternalWorkflowExecutionSignaled:
-		data = e.ExternalWorkflowExecutionSignaledEventAttributes
-
-	default:
-		data = e
+	if data := jsonmapper.EventAttributes(e); data != nil {
+		return data
 	}
-	return data
+	return e
 }
 
 func isAttributeName(name string) bool {
@@ -560,13 +443,7 @@ func getCurrentUserFromEnv() string {
 }
 
 func prettyPrintJSONObject(o interface{}) {
-	b, err := json.MarshalIndent(o, "", "  ")
-	if err != nil {
-		fmt.Printf("Error when try to print pretty: %v\n", err)
-		fmt.Println(o)
-	}
-	os.Stdout.Write(b)
-	fmt.Println()
+	RenderJSON(getOutput(), o)
 }
 
 func mapKeysToArray(m map[string]string) []string {
@@ -608,10 +485,17 @@ func printError(msg string, err error) {
 	}
 }
 
-// ErrorAndExit print easy to understand error msg first then error detail in a new line
+// ErrorAndExit print easy to understand error msg first then error detail in a new line, and exits with
+// the exit code of the error
 func ErrorAndExit(msg string, err error) {
-	printError(msg, err)
-	osExit(1)
+	exitCode := getExitCode(err)
+	if errorFormat == errorFormatJSON {
+		// errors go to stderr so that they are not mixed with the output of the command
+		printJSONError(os.Stderr, msg, err, exitCode)
+	} else {
+		printError(msg, err)
+	}
+	osExit(exitCode)
 }
 
 func getWorkflowClient(c *cli.Context) frontend.Client {
@@ -655,8 +539,32 @@ func timestampPtrToStringPtr(unixNanoPtr *int64, onlyTime bool) *string {
 	return common.StringPtr(convertTime(*unixNanoPtr, onlyTime))
 }
 
+// displayLocation is the time zone times are rendered in, set from the global timezone flag.
+// Times keep their own location, the local time zone for server timestamps, if it is nil
+var displayLocation *time.Location
+
+func setDisplayLocation(c *cli.Context) {
+	displayLocation = nil
+	name := c.GlobalString(FlagTimezone)
+	if name == "" {
+		return
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Invalid %s.", FlagTimezone), err)
+	}
+	displayLocation = location
+}
+
+func inDisplayLocation(t time.Time) time.Time {
+	if displayLocation == nil {
+		return t
+	}
+	return t.In(displayLocation)
+}
+
 func convertTime(unixNano int64, onlyTime bool) string {
-	t := time.Unix(0, unixNano)
+	t := inDisplayLocation(time.Unix(0, unixNano))
 	var result string
 	if onlyTime {
 		result = t.Format(defaultTimeFormat)
@@ -792,8 +700,9 @@ func parseTimeDuration(duration string) (dur time.Duration, err error) {
 	return
 }
 
+// strToTaskListType parses the tasklist type option, an empty value defaults to decision
 func strToTaskListType(str string) types.TaskListType {
-	if strings.ToLower(str) == "activity" {
+	if str != "" && mustParseEnumValue(FlagTaskListType, str, taskListTypeNames) == taskListTypeActivity {
 		return types.TaskListTypeActivity
 	}
 	return types.TaskListTypeDecision
@@ -824,6 +733,16 @@ func processJWTFlags(ctx context.Context, cliCtx *cli.Context) context.Context {
 			ErrorAndExit("Error creating JWT token", err)
 		}
 		token = *createdToken
+	} else if key := getProfileCredential(cliCtx, credentialJWTPrivateKey); key != "" {
+		privateKey, err := common.ParseRSAPrivateKey([]byte(key))
+		if err != nil {
+			ErrorAndExit("Error parsing JWT private key of profile", err)
+		}
+		createdToken, err := createJWTWithKey(privateKey)
+		if err != nil {
+			ErrorAndExit("Error creating JWT token", err)
+		}
+		token = *createdToken
 	}
 
 	ctx = context.WithValue(ctx, CtxKeyJWT, token)
@@ -1036,7 +955,35 @@ func removePrevious2LinesFromTerminal() {
 	fmt.Printf("\033[2K")
 }
 
+// getOutputFormat returns the output format of list commands, falling back to print_json when format is not set
+func getOutputFormat(c *cli.Context) string {
+	format := c.String(FlagFormat)
+	switch format {
+	case "":
+		if c.Bool(FlagPrintJSON) {
+			return outputFormatJSON
+		}
+		return outputFormatTable
+	default:
+		return mustParseEnumValue(FlagFormat, format, []string{outputFormatTable, outputFormatJSON, outputFormatJSONL})
+	}
+}
+
+// printJSONLine prints the item as a single line of JSON, so results can be streamed into line based processors
+func printJSONLine(item interface{}) {
+	output, err := json.Marshal(item)
+	if err != nil {
+		ErrorAndExit("Failed to encode result into JSON.", err)
+	}
+	Render(string(output))
+}
+
+// showNextPage asks whether to show the next page, unless the pages are walked automatically with --all-pages
+// or --max-items
 func showNextPage() bool {
+	if cliPaging.autoPaging() {
+		return !cliPaging.done()
+	}
 	fmt.Printf("Press %s to show next page, press %s to quit: ",
 		color.GreenString("Enter"), color.RedString("any other key then Enter"))
 	var input string
@@ -1054,6 +1001,24 @@ func prompt(msg string) {
 		os.Exit(0)
 	}
 }
+
+// confirmationInput is where typed confirmations of destructive commands are read from
+var confirmationInput io.Reader = os.Stdin
+
+// confirmTypedName protects a destructive command by asking the operator to retype the name of its target,
+// unless --yes is set. Any other input aborts the command.
+func confirmTypedName(c *cli.Context, operation string, kind string, name string) {
+	if c.Bool(FlagYes) {
+		return
+	}
+	fmt.Printf("You are about to %s %s %s.\n", operation, kind, color.RedString(name))
+	fmt.Printf("Type the %s name to confirm: ", kind)
+	text, _ := bufio.NewReader(confirmationInput).ReadString('\n')
+	if strings.TrimSpace(text) != name {
+		ErrorAndExit(fmt.Sprintf("Typed %s name does not match %s, aborted.", kind, name), nil)
+	}
+}
+
 func getInputFile(inputFile string) *os.File {
 	if len(inputFile) == 0 {
 		info, err := os.Stdin.Stat()
@@ -1077,17 +1042,20 @@ func getInputFile(inputFile string) *os.File {
 
 // createJWT defines the logic to create a JWT
 func createJWT(keyPath string) (*string, error) {
+	privateKey, err := common.LoadRSAPrivateKey(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	return createJWTWithKey(privateKey)
+}
+
+func createJWTWithKey(privateKey *rsa.PrivateKey) (*string, error) {
 	claims := authorization.JWTClaims{
 		Admin: true,
 		Iat:   time.Now().Unix(),
 		TTL:   60 * 10,
 	}
 
-	privateKey, err := common.LoadRSAPrivateKey(keyPath)
-	if err != nil {
-		return nil, err
-	}
-
 	signer, err := jwt.NewSignerRS(jwt.RS256, privateKey)
 	if err != nil {
 		return nil, err
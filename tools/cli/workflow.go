@@ -124,6 +124,17 @@ func newWorkflowCommands() []cli.Command {
 				ListAllWorkflow(c)
 			},
 		},
+		{
+			Name:  "list-at-risk",
+			Usage: "list open workflow executions whose history is approaching the size or count limit",
+			Description: "queries advanced visibility for open workflow executions the server flags as at risk: " +
+				"history over the warn limit, or growing fast enough to reach the error limit and be terminated soon. " +
+				"Executions are ordered by the estimated time the limit is reached",
+			Flags: getFlagsForListAtRisk(),
+			Action: func(c *cli.Context) {
+				ListAtRiskWorkflow(c)
+			},
+		},
 		{
 			Name:  "listarchived",
 			Usage: "list archived workflow executions",
@@ -177,6 +188,16 @@ func newWorkflowCommands() []cli.Command {
 				DescribeWorkflow(c)
 			},
 		},
+		{
+			Name:  "describe-batch",
+			Usage: "show a summary of the workflow executions listed in a file",
+			Description: "cadence workflow describe-batch --file ids.txt. Each line of the file is workflowID[,runID], " +
+				"a missing runID describes the current run",
+			Flags: getFlagsForDescribeBatch(),
+			Action: func(c *cli.Context) {
+				DescribeBatchWorkflow(c)
+			},
+		},
 		{
 			Name:        "describeid",
 			Aliases:     []string{"descid"},
@@ -341,6 +362,12 @@ func newWorkflowCommands() []cli.Command {
 						"minute/m, hour/h, day/d, week/w, month/M or year/y. For example, '15minute' or '15m' implies last 15 minutes, " +
 						"meaning that workflow will be reset to the first decision that completed in last 15 minutes.",
 				},
+				cli.BoolFlag{
+					Name: FlagAsync,
+					Usage: "Submit the reset as a server side batch job instead of resetting from this process. " +
+						"Requires a query and supports the reset types " + strings.Join(batcher.AllResetTypes, ",") + ". " +
+						"Track the printed job ID with `cadence admin job describe`.",
+				},
 			},
 			Action: func(c *cli.Context) {
 				ResetInBatch(c)
@@ -433,6 +460,10 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagJobIDWithAlias,
 					Usage: "Batch Job ID",
 				},
+				cli.StringFlag{
+					Name:  FlagResultsFile,
+					Usage: "Optional file the success and failure counts of a finished job are written to as JSON",
+				},
 			},
 			Action: func(c *cli.Context) {
 				DescribeBatchJob(c)
@@ -503,6 +534,14 @@ func newBatchCommands() []cli.Command {
 					Name:  FlagTargetClusterWithAlias,
 					Usage: "Required for batch replicate",
 				},
+				cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, where to reset. Support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				cli.BoolFlag{
+					Name:  FlagSkipSignalReapply,
+					Usage: "Optional for batch reset, whether or not skipping signals reapply after the reset point",
+				},
 				cli.IntFlag{
 					Name:  FlagRPS,
 					Value: batcher.DefaultRPS,
@@ -510,7 +549,7 @@ func newBatchCommands() []cli.Command {
 				},
 				cli.BoolFlag{
 					Name:  FlagYes,
-					Usage: "Optional flag to disable confirmation prompt",
+					Usage: "Optional flag to disable confirmation prompt, including retyping the domain name of terminate jobs",
 				},
 				cli.IntFlag{
 					Name:  FlagPageSize,
@@ -532,6 +571,14 @@ func newBatchCommands() []cli.Command {
 					Value: batcher.DefaultConcurrency,
 					Usage: "Concurrency of batch activity",
 				},
+				cli.BoolFlag{
+					Name:  FlagWait,
+					Usage: "Optional flag to wait for the job to finish, showing its progress, and print its results",
+				},
+				cli.StringFlag{
+					Name:  FlagResultsFile,
+					Usage: "Optional file the success and failure counts of the job are written to as JSON, requires --wait",
+				},
 			},
 			Action: func(c *cli.Context) {
 				StartBatchJob(c)
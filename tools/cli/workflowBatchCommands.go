@@ -22,6 +22,7 @@ package cli
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -31,6 +32,7 @@ import (
 	"github.com/pborman/uuid"
 	"github.com/urfave/cli"
 
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/service/worker/batcher"
 
@@ -42,21 +44,22 @@ func TerminateBatchJob(c *cli.Context) {
 	jobID := getRequiredOption(c, FlagJobID)
 	reason := getRequiredOption(c, FlagReason)
 	svcClient := cFactory.ServerFrontendClient(c)
+	request := &types.TerminateWorkflowExecutionRequest{
+		Domain: common.BatcherLocalDomainName,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: jobID,
+			RunID:      "",
+		},
+		Reason:   reason,
+		Identity: getCliIdentity(),
+	}
+	if printDryRunRequest(c, "TerminateWorkflowExecution", request) {
+		return
+	}
 	tcCtx, cancel := newContext(c)
 	defer cancel()
 
-	err := svcClient.TerminateWorkflowExecution(
-		tcCtx,
-		&types.TerminateWorkflowExecutionRequest{
-			Domain: common.BatcherLocalDomainName,
-			WorkflowExecution: &types.WorkflowExecution{
-				WorkflowID: jobID,
-				RunID:      "",
-			},
-			Reason:   reason,
-			Identity: getCliIdentity(),
-		},
-	)
+	err := svcClient.TerminateWorkflowExecution(tcCtx, request)
 	if err != nil {
 		ErrorAndExit("Failed to terminate batch job", err)
 	}
@@ -68,8 +71,10 @@ func TerminateBatchJob(c *cli.Context) {
 
 // DescribeBatchJob describe the status of the batch job
 func DescribeBatchJob(c *cli.Context) {
-	jobID := getRequiredOption(c, FlagJobID)
+	describeBatchJob(c, getRequiredOption(c, FlagJobID))
+}
 
+func describeBatchJob(c *cli.Context, jobID string) {
 	svcClient := cFactory.ServerFrontendClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
@@ -89,11 +94,23 @@ func DescribeBatchJob(c *cli.Context) {
 	}
 
 	output := map[string]interface{}{}
+	var results *batchResults
 	if wf.WorkflowExecutionInfo.CloseStatus != nil {
 		if wf.WorkflowExecutionInfo.GetCloseStatus() != types.WorkflowExecutionCloseStatusCompleted {
 			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetCloseStatus().String()
 		} else {
 			output["msg"] = "batch job is finished successfully"
+			hbd, err := getBatchJobResult(tcCtx, svcClient, jobID)
+			if err != nil {
+				ErrorAndExit("Failed to get batch job result", err)
+			}
+			output["result"] = hbd
+			// the batcher only counts the workflows it failed on, so there are no per-workflow results
+			results = &batchResults{
+				Operation: "Batch job " + jobID,
+				Succeeded: hbd.SuccessCount,
+				Failed:    hbd.ErrorCount,
+			}
 		}
 	} else {
 		output["msg"] = "batch job is running"
@@ -108,6 +125,30 @@ func DescribeBatchJob(c *cli.Context) {
 		}
 	}
 	prettyPrintJSONObject(output)
+	if results != nil {
+		results.finish(c)
+	}
+}
+
+// getBatchJobResult reads the counts a completed batch job returned from its close event
+func getBatchJobResult(ctx context.Context, svcClient frontend.Client, jobID string) (*batcher.HeartBeatDetails, error) {
+	resp, err := svcClient.GetWorkflowExecutionHistory(ctx, &types.GetWorkflowExecutionHistoryRequest{
+		Domain:                 common.BatcherLocalDomainName,
+		Execution:              &types.WorkflowExecution{WorkflowID: jobID},
+		HistoryEventFilterType: types.HistoryEventFilterTypeCloseEvent.Ptr(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 || events[len(events)-1].WorkflowExecutionCompletedEventAttributes == nil {
+		return nil, fmt.Errorf("batch job %v has no completed event", jobID)
+	}
+	hbd := &batcher.HeartBeatDetails{}
+	if err := json.Unmarshal(events[len(events)-1].WorkflowExecutionCompletedEventAttributes.Result, hbd); err != nil {
+		return nil, err
+	}
+	return hbd, nil
 }
 
 // ListBatchJobs list the started batch jobs
@@ -172,6 +213,10 @@ func StartBatchJob(c *cli.Context) {
 		sourceCluster = getRequiredOption(c, FlagSourceCluster)
 		targetCluster = getRequiredOption(c, FlagTargetCluster)
 	}
+	var resetType string
+	if batchType == batcher.BatchTypeReset {
+		resetType = getRequiredEnumOption(c, FlagResetType, batcher.AllResetTypes)
+	}
 	rps := c.Int(FlagRPS)
 	pageSize := c.Int(FlagPageSize)
 	concurrency := c.Int(FlagConcurrency)
@@ -193,7 +238,12 @@ func StartBatchJob(c *cli.Context) {
 		ErrorAndExit("Failed to count impacting workflows for starting a batch job", err)
 	}
 	fmt.Printf("This batch job will be operating on %v workflows.\n", resp.GetCount())
-	if !c.Bool(FlagYes) {
+	if isDryRun(c) {
+		// nothing is started, so there is nothing to confirm
+	} else if batchType == batcher.BatchTypeTerminate {
+		// terminating is not reversible, so a Yes is not enough
+		confirmTypedName(c, fmt.Sprintf("terminate %v workflows of", resp.GetCount()), "domain", domain)
+	} else if !c.Bool(FlagYes) {
 		reader := bufio.NewReader(os.Stdin)
 		for {
 			fmt.Print("Please confirm[Yes/No]:")
@@ -210,9 +260,6 @@ func StartBatchJob(c *cli.Context) {
 		}
 
 	}
-	tcCtx, cancel = newContext(c)
-	defer cancel()
-
 	params := batcher.BatchParams{
 		DomainName: domain,
 		Query:      query,
@@ -226,34 +273,88 @@ func StartBatchJob(c *cli.Context) {
 			SourceCluster: sourceCluster,
 			TargetCluster: targetCluster,
 		},
+		ResetParams: batcher.ResetParams{
+			ResetType:         resetType,
+			SkipSignalReapply: c.Bool(FlagSkipSignalReapply),
+		},
 		RPS:                      rps,
 		Concurrency:              concurrency,
 		PageSize:                 pageSize,
 		AttemptsOnRetryableError: retryAttempt,
 		ActivityHeartBeatTimeout: heartBeatTimeout,
 	}
+	request := newBatchWorkflowRequest(params, operator)
+	if printDryRunRequest(c, "StartWorkflowExecution", request) {
+		return
+	}
+	workflowID := startBatchWorkflow(c, request)
+	output := map[string]interface{}{
+		"msg":   "batch job is started",
+		"jobID": workflowID,
+	}
+	prettyPrintJSONObject(output)
+	if c.Bool(FlagWait) {
+		waitForBatchJob(c, workflowID)
+		describeBatchJob(c, workflowID)
+	}
+}
+
+// waitForBatchJob polls the batch job until it is closed, showing the progress the batcher heartbeats
+func waitForBatchJob(c *cli.Context, jobID string) {
+	svcClient := cFactory.ServerFrontendClient(c)
+	progress := newItemProgress(c, "workflows of batch job "+jobID, 0)
+	defer progress.clear()
+	for {
+		ctx, cancel := newContext(c)
+		wf, err := svcClient.DescribeWorkflowExecution(
+			ctx,
+			&types.DescribeWorkflowExecutionRequest{
+				Domain:    common.BatcherLocalDomainName,
+				Execution: &types.WorkflowExecution{WorkflowID: jobID},
+			},
+		)
+		cancel()
+		if err != nil {
+			progress.clear()
+			ErrorAndExit("Failed to describe batch job", err)
+		}
+		if wf.WorkflowExecutionInfo.CloseStatus != nil {
+			return
+		}
+		// there are no heartbeat details until the first page is processed
+		if len(wf.PendingActivities) > 0 {
+			hbd := batcher.HeartBeatDetails{}
+			if err := json.Unmarshal(wf.PendingActivities[0].HeartbeatDetails, &hbd); err == nil {
+				progress.update(hbd.SuccessCount+hbd.ErrorCount, int(hbd.TotalEstimate))
+			}
+		}
+		time.Sleep(batchJobPollInterval)
+	}
+}
+
+// newBatchWorkflowRequest builds the request starting the batcher workflow for the params, its workflow ID is the job ID
+func newBatchWorkflowRequest(params batcher.BatchParams, operator string) *types.StartWorkflowExecutionRequest {
 	input, err := json.Marshal(params)
 	if err != nil {
 		ErrorAndExit("Failed to encode batch job parameters", err)
 	}
 	memo, err := getWorkflowMemo(map[string]interface{}{
-		"Reason": reason,
+		"Reason": params.Reason,
 	})
 	if err != nil {
 		ErrorAndExit("Failed to encode batch job memo", err)
 	}
 	searchAttributes, err := serializeSearchAttributes(map[string]interface{}{
-		"CustomDomain": domain,
+		"CustomDomain": params.DomainName,
 		"Operator":     operator,
 	})
 	if err != nil {
 		ErrorAndExit("Failed to encode batch job search attributes", err)
 	}
-	workflowID := uuid.NewRandom().String()
-	request := &types.StartWorkflowExecutionRequest{
+	return &types.StartWorkflowExecutionRequest{
 		Domain:                              common.BatcherLocalDomainName,
 		RequestID:                           uuid.New(),
-		WorkflowID:                          workflowID,
+		WorkflowID:                          uuid.NewRandom().String(),
 		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(batcher.InfiniteDuration.Seconds())),
 		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(defaultDecisionTimeoutInSeconds)),
 		TaskList:                            &types.TaskList{Name: batcher.BatcherTaskListName},
@@ -262,15 +363,17 @@ func StartBatchJob(c *cli.Context) {
 		WorkflowType:                        &types.WorkflowType{Name: batcher.BatchWFTypeName},
 		Input:                               input,
 	}
-	_, err = svcClient.StartWorkflowExecution(tcCtx, request)
+}
+
+// startBatchWorkflow starts the batcher workflow and returns its workflow ID, which is the job ID
+func startBatchWorkflow(c *cli.Context, request *types.StartWorkflowExecutionRequest) string {
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	_, err := cFactory.ServerFrontendClient(c).StartWorkflowExecution(tcCtx, request)
 	if err != nil {
 		ErrorAndExit("Failed to start batch job", err)
 	}
-	output := map[string]interface{}{
-		"msg":   "batch job is started",
-		"jobID": workflowID,
-	}
-	prettyPrintJSONObject(output)
+	return request.WorkflowID
 }
 
 func validateBatchType(bt string) bool {
@@ -0,0 +1,242 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/types"
+	jsonmapper "github.com/uber/cadence/common/types/mapper/json"
+	"github.com/uber/cadence/tools/cli/tui"
+)
+
+const historyEventsPerBrowserPage = 100
+
+var workflowBrowserHeader = []string{"WORKFLOW TYPE", "WORKFLOW ID", "RUN ID", "TASK LIST", "START TIME", "END TIME"}
+
+// browseWorkflows shows the workflows in the interactive browser, pages are loaded while scrolling
+// and opening a workflow shows its details and history
+func browseWorkflows(c *cli.Context, getWorkflowPage getWorkflowPageFn) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	var nextPageToken []byte
+	root := tui.NewList("workflows of "+domain, workflowBrowserHeader, func() ([]tui.Row, bool, error) {
+		var page []*types.WorkflowExecutionInfo
+		page, nextPageToken = getWorkflowPage(nextPageToken)
+		rows := make([]tui.Row, 0, len(page))
+		for _, workflow := range page {
+			rows = append(rows, newWorkflowBrowserRow(c, domain, workflow))
+		}
+		return rows, len(nextPageToken) > 0, nil
+	})
+	runBrowser(root)
+}
+
+func newWorkflowBrowserRow(c *cli.Context, domain string, workflow *types.WorkflowExecutionInfo) tui.Row {
+	endTime := ""
+	if workflow.GetCloseTime() > 0 {
+		endTime = convertTime(workflow.GetCloseTime(), false)
+	}
+	wid := workflow.GetExecution().GetWorkflowID()
+	rid := workflow.GetExecution().GetRunID()
+	return tui.Row{
+		Columns: []string{
+			workflow.GetType().GetName(),
+			wid,
+			rid,
+			workflow.GetTaskList(),
+			convertTime(workflow.GetStartTime(), false),
+			endTime,
+		},
+		Open: func() (*tui.List, error) {
+			return newWorkflowBrowserList(c, domain, wid, rid), nil
+		},
+	}
+}
+
+// newWorkflowBrowserList returns the list of the views of a workflow
+func newWorkflowBrowserList(c *cli.Context, domain, wid, rid string) *tui.List {
+	rows := []tui.Row{
+		{
+			Columns: []string{"history", "events of the workflow"},
+			Open: func() (*tui.List, error) {
+				return newHistoryBrowserList(c, domain, wid, rid)
+			},
+		},
+		{
+			Columns: []string{"describe", "execution details and pending activities, children and decision"},
+			Open: func() (*tui.List, error) {
+				return newDescribeBrowserText(c, domain, wid, rid)
+			},
+		},
+	}
+	return tui.NewList(wid, nil, func() ([]tui.Row, bool, error) {
+		return rows, false, nil
+	})
+}
+
+// newHistoryBrowserList returns the history events of a workflow, loaded page by page while scrolling
+func newHistoryBrowserList(c *cli.Context, domain, wid, rid string) (*tui.List, error) {
+	iterator, err := GetWorkflowHistoryIterator(context.Background(), getWorkflowClient(c), domain, wid, rid, false, types.HistoryEventFilterTypeAllEvent.Ptr(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return newHistoryEventsBrowserList(c, "history", func() ([]*types.HistoryEvent, bool, error) {
+		var events []*types.HistoryEvent
+		for len(events) < historyEventsPerBrowserPage && iterator.HasNext() {
+			entity, err := iterator.Next()
+			if err != nil {
+				return nil, false, err
+			}
+			events = append(events, entity.(*types.HistoryEvent))
+		}
+		return events, iterator.HasNext(), nil
+	}), nil
+}
+
+func newHistoryEventsBrowserList(c *cli.Context, title string, getEventsPage func() ([]*types.HistoryEvent, bool, error)) *tui.List {
+	var maxFieldLength int
+	if c.IsSet(FlagMaxFieldLength) {
+		maxFieldLength = c.Int(FlagMaxFieldLength)
+	}
+	return tui.NewList(title, []string{"ID", "TIME", "TYPE", "ATTRIBUTES"}, func() ([]tui.Row, bool, error) {
+		events, more, err := getEventsPage()
+		if err != nil {
+			return nil, false, err
+		}
+		rows := make([]tui.Row, 0, len(events))
+		for _, event := range events {
+			event := event
+			rows = append(rows, tui.Row{
+				Columns: []string{
+					strconv.FormatInt(event.ID, 10),
+					convertTime(event.GetTimestamp(), false),
+					event.GetEventType().String(),
+					HistoryEventToString(event, false, maxFieldLength),
+				},
+				Open: func() (*tui.List, error) {
+					return newJSONBrowserText(fmt.Sprintf("event %d", event.ID), jsonmapper.FromHistoryEvent(event))
+				},
+			})
+		}
+		return rows, more, nil
+	})
+}
+
+// browseHistory shows the history of a workflow in the interactive browser
+func browseHistory(c *cli.Context, domain, wid, rid string, archived bool) {
+	if !archived {
+		root, err := newHistoryBrowserList(c, domain, wid, rid)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
+		}
+		runBrowser(root)
+		return
+	}
+
+	// archived histories are read as a whole
+	ctx, cancel := newContext(c)
+	defer cancel()
+	history, err := getArchivedHistory(ctx, c, domain, wid, rid)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
+	}
+	runBrowser(newHistoryEventsBrowserList(c, "archived history", func() ([]*types.HistoryEvent, bool, error) {
+		return history.Events, false, nil
+	}))
+}
+
+func newDescribeBrowserText(c *cli.Context, domain, wid, rid string) (*tui.List, error) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newJSONBrowserText("describe", convertDescribeWorkflowExecutionResponse(resp, frontendClient, c))
+}
+
+func newJSONBrowserText(title string, o interface{}) (*tui.List, error) {
+	data, err := json.MarshalIndent(o, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return tui.NewText(title, string(data)), nil
+}
+
+// runBrowser shows the root list in the interactive browser until the user quits it.
+// Commands print their errors to stdout before exiting through osExit, which would be lost on the
+// alternate screen of the browser, so stdout is captured while the browser is shown and replayed
+// once the terminal is restored.
+func runBrowser(root *tui.List) {
+	stdout := os.Stdout
+	terminal, err := tui.OpenTerminal(os.Stdin, stdout)
+	if err != nil {
+		ErrorAndExit("Failed to start the interactive mode.", err)
+	}
+	captured, err := ioutil.TempFile("", "cadence-cli-browser")
+	if err != nil {
+		terminal.Close() //nolint:errcheck
+		ErrorAndExit("Failed to start the interactive mode.", err)
+	}
+	os.Stdout = captured
+
+	closed := false
+	closeBrowser := func() {
+		if closed {
+			return
+		}
+		closed = true
+		terminal.Close() //nolint:errcheck
+		os.Stdout = stdout
+		if _, err := captured.Seek(0, io.SeekStart); err == nil {
+			io.Copy(stdout, captured) //nolint:errcheck
+		}
+		captured.Close()
+		os.Remove(captured.Name())
+	}
+	oldOsExit := osExit
+	defer func() { osExit = oldOsExit }()
+	osExit = func(code int) {
+		closeBrowser()
+		oldOsExit(code)
+	}
+
+	err = terminal.Run(tui.NewBrowser(root))
+	closeBrowser()
+	if err != nil {
+		ErrorAndExit("Interactive mode failed.", err)
+	}
+}
@@ -39,14 +39,21 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/pborman/uuid"
+	"github.com/robfig/cron"
 	"github.com/urfave/cli"
 
 	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
+	jsonmapper "github.com/uber/cadence/common/types/mapper/json"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 	"github.com/uber/cadence/service/history/execution"
+	"github.com/uber/cadence/service/worker/batcher"
 )
 
 // ShowHistory shows the history of given workflow execution based on workflowID and runID.
@@ -83,16 +90,31 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 		maxFieldLength = c.Int(FlagMaxFieldLength)
 	}
 	resetPointsOnly := c.Bool(FlagResetPointsOnly)
+	format := getOutputFormat(c)
+
+	archived := c.Bool(FlagArchived)
+	if c.Bool(FlagInteractive) {
+		browseHistory(c, domain, wid, rid, archived)
+		return
+	}
 
 	ctx, cancel := newContext(c)
 	defer cancel()
-	history, err := GetHistory(ctx, wfClient, domain, wid, rid)
+	var history *types.History
+	var err error
+	if archived {
+		history, err = getArchivedHistory(ctx, c, domain, wid, rid)
+	} else {
+		history, err = GetHistory(ctx, wfClient, domain, wid, rid)
+	}
 	if err != nil {
 		ErrorAndExit(fmt.Sprintf("Failed to get history on workflow id: %s, run id: %s.", wid, rid), err)
 	}
 
 	prevEvent := types.HistoryEvent{}
-	if printFully { // dump everything
+	if format != outputFormatTable {
+		printHistoryEvents(history.Events, format)
+	} else if printFully { // dump everything
 		for _, e := range history.Events {
 			if resetPointsOnly {
 				if prevEvent.GetEventType() != types.EventTypeDecisionTaskStarted {
@@ -101,7 +123,7 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 				}
 				prevEvent = *e
 			}
-			fmt.Println(anyToString(e, true, maxFieldLength))
+			Render(anyToString(e, true, maxFieldLength))
 		}
 	} else if c.IsSet(FlagEventID) { // only dump that event
 		eventID := c.Int(FlagEventID)
@@ -109,9 +131,9 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 			ErrorAndExit("EventId out of range.", fmt.Errorf("number should be 1 - %d inclusive", len(history.Events)))
 		}
 		e := history.Events[eventID-1]
-		fmt.Println(anyToString(e, true, 0))
+		Render(anyToString(e, true, 0))
 	} else { // use table to pretty output, will trim long text
-		table := tablewriter.NewWriter(os.Stdout)
+		table := tablewriter.NewWriter(getOutput())
 		table.SetBorder(false)
 		table.SetColumnSeparator("")
 		for _, e := range history.Events {
@@ -151,6 +173,15 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 			ErrorAndExit("Failed to export history data file.", err)
 		}
 	}
+	// machine readable output only contains the events
+	if format != outputFormatTable {
+		return
+	}
+	// archived workflows are closed, so there are no pending activities to describe
+	if archived {
+		fmt.Println("History Source: History Archival")
+		return
+	}
 
 	// finally append activities with retry
 	frontendClient := cFactory.ServerFrontendClient(c)
@@ -179,6 +210,68 @@ func showHistoryHelper(c *cli.Context, wid, rid string) {
 
 }
 
+// getArchivedHistory reads the full history of a closed workflow run from the domain's
+// history archival URI, bypassing the frontend read path
+func getArchivedHistory(ctx context.Context, c *cli.Context, domain, wid, rid string) (*types.History, error) {
+	if rid == "" {
+		ErrorAndExit("RunID is required for archived history.", nil)
+	}
+
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domainResp, err := frontendClient.DescribeDomain(ctx, &types.DescribeDomainRequest{
+		Name: common.StringPtr(domain),
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe domain.", err)
+	}
+	uriString := domainResp.GetConfiguration().GetHistoryArchivalURI()
+	if uriString == "" {
+		ErrorAndExit(fmt.Sprintf("Domain %s has no history archival URI configured.", domain), nil)
+	}
+	uri, err := archiver.NewURI(uriString)
+	if err != nil {
+		ErrorAndExit("Invalid history archival URI.", err)
+	}
+
+	serviceConfig, err := cFactory.ServerConfig(c)
+	if err != nil {
+		ErrorAndExit("Unable to load config.", err)
+	}
+	logger := initializeLogger(serviceConfig)
+	archiverProvider := initializeArchivalProvider(
+		serviceConfig,
+		initializeClusterMetadata(serviceConfig, logger),
+		initializeMetricsClient(),
+		logger,
+	)
+	providerName := domainResp.GetDomainInfo().GetData()[common.DomainDataKeyForHistoryArchivalProvider]
+	historyArchiver, err := archiverProvider.GetHistoryArchiver(uri.Scheme(), providerName, service.Frontend)
+	if err != nil {
+		ErrorAndExit("Failed to initialize history archiver.", err)
+	}
+
+	history := &types.History{}
+	request := &archiver.GetHistoryRequest{
+		DomainID:   domainResp.GetDomainInfo().GetUUID(),
+		WorkflowID: wid,
+		RunID:      rid,
+		PageSize:   defaultPageSizeForList,
+	}
+	for {
+		resp, err := historyArchiver.Get(ctx, uri, request)
+		if err != nil {
+			return nil, err
+		}
+		for _, batch := range resp.HistoryBatches {
+			history.Events = append(history.Events, batch.Events...)
+		}
+		if len(resp.NextPageToken) == 0 {
+			return history, nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
 // StartWorkflow starts a new workflow execution
 func StartWorkflow(c *cli.Context) {
 	startWorkflowHelper(c, false)
@@ -222,7 +315,7 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 
 		// print execution summary
 		fmt.Println(colorMagenta("Running execution:"))
-		table := tablewriter.NewWriter(os.Stdout)
+		table := tablewriter.NewWriter(getOutput())
 		executionData := [][]string{
 			{"Workflow Id", wid},
 			{"Run Id", resp.GetRunID()},
@@ -246,6 +339,37 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	}
 }
 
+type cronRunRow struct {
+	UTC   string `header:"UTC"`
+	Local string `header:"Local"`
+}
+
+// confirmCronSchedule validates the cron schedule locally and shows its next run times,
+// which are computed in UTC by the server, then asks for confirmation unless --yes is set
+func confirmCronSchedule(c *cli.Context, cronSchedule string) {
+	schedule, err := cron.ParseStandard(cronSchedule)
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Invalid cron schedule %q.", cronSchedule), err)
+		return
+	}
+
+	rows := make([]cronRunRow, 0, cronPreviewCount)
+	next := time.Now().UTC()
+	for i := 0; i < cronPreviewCount; i++ {
+		next = schedule.Next(next)
+		rows = append(rows, cronRunRow{
+			UTC:   next.Format(defaultDateTimeFormat),
+			Local: inDisplayLocation(next.Local()).Format(defaultDateTimeFormat),
+		})
+	}
+	fmt.Printf("Next %d runs of cron schedule %q:\n", cronPreviewCount, cronSchedule)
+	RenderTable(getOutput(), rows, TableOptions{Color: true})
+
+	if !c.Bool(FlagYes) {
+		prompt("Start the cron workflow? [Yes/No]")
+	}
+}
+
 func constructStartWorkflowRequest(c *cli.Context) *types.StartWorkflowExecutionRequest {
 	domain := getRequiredGlobalOption(c, FlagDomain)
 	taskList := getRequiredOption(c, FlagTaskList)
@@ -283,6 +407,7 @@ func constructStartWorkflowRequest(c *cli.Context) *types.StartWorkflowExecution
 	}
 	if c.IsSet(FlagCronSchedule) {
 		startRequest.CronSchedule = c.String(FlagCronSchedule)
+		confirmCronSchedule(c, startRequest.CronSchedule)
 	}
 
 	if c.IsSet(FlagRetryAttempts) || c.IsSet(FlagRetryExpiration) {
@@ -398,9 +523,10 @@ func printWorkflowProgress(c *cli.Context, domain, wid, rid string) {
 	if c.IsSet(FlagMaxFieldLength) {
 		maxFieldLength = c.Int(FlagMaxFieldLength)
 	}
+	eventTypes := getObservedEventTypes(c)
 
 	go func() {
-		iterator, err := GetWorkflowHistoryIterator(tcCtx, wfClient, domain, wid, rid, true, types.HistoryEventFilterTypeAllEvent.Ptr())
+		iterator, err := GetWorkflowHistoryIterator(tcCtx, wfClient, domain, wid, rid, true, types.HistoryEventFilterTypeAllEvent.Ptr(), eventTypes)
 		if err != nil {
 			ErrorAndExit("Unable to get history events.", err)
 		}
@@ -442,6 +568,31 @@ func printWorkflowProgress(c *cli.Context, domain, wid, rid string) {
 	}
 }
 
+// getObservedEventTypes parses the event types to observe. Close events are always
+// added so that the observer can tell when the workflow finished.
+func getObservedEventTypes(c *cli.Context) []types.EventType {
+	names := c.StringSlice(FlagEventType)
+	if len(names) == 0 {
+		return nil
+	}
+	eventTypes := []types.EventType{
+		types.EventTypeWorkflowExecutionCompleted,
+		types.EventTypeWorkflowExecutionFailed,
+		types.EventTypeWorkflowExecutionTimedOut,
+		types.EventTypeWorkflowExecutionCanceled,
+		types.EventTypeWorkflowExecutionTerminated,
+		types.EventTypeWorkflowExecutionContinuedAsNew,
+	}
+	for _, name := range names {
+		var eventType types.EventType
+		if err := eventType.UnmarshalText([]byte(name)); err != nil {
+			ErrorAndExit(fmt.Sprintf("Invalid event type: %s", name), err)
+		}
+		eventTypes = append(eventTypes, eventType)
+	}
+	return eventTypes
+}
+
 // TerminateWorkflow terminates a workflow execution
 func TerminateWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -451,19 +602,21 @@ func TerminateWorkflow(c *cli.Context) {
 	rid := c.String(FlagRunID)
 	reason := c.String(FlagReason)
 
+	request := &types.TerminateWorkflowExecutionRequest{
+		Domain: domain,
+		Reason: reason,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		}, Identity: getCliIdentity(),
+	}
+	if printDryRunRequest(c, "TerminateWorkflowExecution", request) {
+		return
+	}
+
 	ctx, cancel := newContext(c)
 	defer cancel()
-	err := wfClient.TerminateWorkflowExecution(
-		ctx,
-		&types.TerminateWorkflowExecutionRequest{
-			Domain: domain,
-			Reason: reason,
-			WorkflowExecution: &types.WorkflowExecution{
-				WorkflowID: wid,
-				RunID:      rid,
-			}, Identity: getCliIdentity(),
-		},
-	)
+	err := wfClient.TerminateWorkflowExecution(ctx, request)
 
 	if err != nil {
 		ErrorAndExit("Terminate workflow failed.", err)
@@ -508,6 +661,10 @@ func SignalWorkflow(c *cli.Context) {
 	domain := getRequiredGlobalOption(c, FlagDomain)
 	wid := getRequiredOption(c, FlagWorkflowID)
 	rid := c.String(FlagRunID)
+	if c.IsSet(FlagBatchFile) {
+		signalWorkflowBatch(c, serviceClient, domain, wid, rid)
+		return
+	}
 	name := getRequiredOption(c, FlagName)
 	input := processJSONInput(c)
 
@@ -535,6 +692,71 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// signalBatchFileEntry is one signal of the file passed with --batch_file
+type signalBatchFileEntry struct {
+	Name  string          `json:"name"`
+	Input json.RawMessage `json:"input,omitempty"`
+}
+
+func signalWorkflowBatch(c *cli.Context, serviceClient frontend.Client, domain, wid, rid string) {
+	if c.IsSet(FlagName) || c.IsSet(FlagInput) || c.IsSet(FlagInputFile) {
+		ErrorAndExit(fmt.Sprintf("Option %s cannot be combined with %s, %s or %s.", FlagBatchFile, FlagName, FlagInput, FlagInputFile), nil)
+	}
+	signals, err := readSignalBatchFile(c.String(FlagBatchFile))
+	if err != nil {
+		ErrorAndExit("Failed to read signal batch file.", err)
+	}
+
+	tcCtx, cancel := newContext(c)
+	defer cancel()
+	err = serviceClient.SignalBatchWorkflowExecution(tcCtx, &types.SignalBatchWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+		Signals:   signals,
+		Identity:  getCliIdentity(),
+		RequestID: uuid.New(),
+	})
+	if err != nil {
+		ErrorAndExit("Signal workflow failed.", err)
+	}
+	fmt.Printf("Signal workflow succeeded. Sent %d signals in one batch.\n", len(signals))
+}
+
+func readSignalBatchFile(path string) ([]*types.SignalBatchItem, error) {
+	// This method is purely used to parse input from the CLI. The input comes from a trusted user
+	// #nosec
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []signalBatchFileEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("file must contain a JSON list of signals: %v", err)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("file does not contain any signal")
+	}
+
+	signals := make([]*types.SignalBatchItem, 0, len(entries))
+	for i, entry := range entries {
+		if entry.Name == "" {
+			return nil, fmt.Errorf("signal %d does not have a name", i)
+		}
+		var input []byte
+		if len(entry.Input) > 0 {
+			input = []byte(entry.Input)
+		}
+		signals = append(signals, &types.SignalBatchItem{
+			SignalName: entry.Name,
+			Input:      input,
+		})
+	}
+	return signals, nil
+}
+
 // SignalWithStartWorkflowExecution starts a workflow execution if not already exists and signals it
 func SignalWithStartWorkflowExecution(c *cli.Context) {
 	serviceClient := cFactory.ServerFrontendClient(c)
@@ -592,7 +814,7 @@ func QueryWorkflow(c *cli.Context) {
 
 // QueryWorkflowUsingStackTrace query workflow execution using __stack_trace as query type
 func QueryWorkflowUsingStackTrace(c *cli.Context) {
-	queryWorkflowHelper(c, "__stack_trace")
+	queryWorkflowHelper(c, queryTypeStackTrace)
 }
 
 func queryWorkflowHelper(c *cli.Context, queryType string) {
@@ -620,25 +842,21 @@ func queryWorkflowHelper(c *cli.Context, queryType string) {
 	}
 	if c.IsSet(FlagQueryRejectCondition) {
 		var rejectCondition types.QueryRejectCondition
-		switch c.String(FlagQueryRejectCondition) {
+		switch getRequiredEnumOption(c, FlagQueryRejectCondition, []string{"not_open", "not_completed_cleanly"}) {
 		case "not_open":
 			rejectCondition = types.QueryRejectConditionNotOpen
 		case "not_completed_cleanly":
 			rejectCondition = types.QueryRejectConditionNotCompletedCleanly
-		default:
-			ErrorAndExit(fmt.Sprintf("invalid reject condition %v, valid values are \"not_open\" and \"not_completed_cleanly\"", c.String(FlagQueryRejectCondition)), nil)
 		}
 		queryRequest.QueryRejectCondition = &rejectCondition
 	}
 	if c.IsSet(FlagQueryConsistencyLevel) {
 		var consistencyLevel types.QueryConsistencyLevel
-		switch c.String(FlagQueryConsistencyLevel) {
+		switch getRequiredEnumOption(c, FlagQueryConsistencyLevel, []string{"eventual", "strong"}) {
 		case "eventual":
 			consistencyLevel = types.QueryConsistencyLevelEventual
 		case "strong":
 			consistencyLevel = types.QueryConsistencyLevelStrong
-		default:
-			ErrorAndExit(fmt.Sprintf("invalid query consistency level %v, valid values are \"eventual\" and \"strong\"", c.String(FlagQueryConsistencyLevel)), nil)
 		}
 		queryRequest.QueryConsistencyLevel = &consistencyLevel
 	}
@@ -652,23 +870,35 @@ func queryWorkflowHelper(c *cli.Context, queryType string) {
 		fmt.Printf("Query was rejected, workflow is in state: %v\n", *queryResponse.QueryRejected.CloseStatus)
 	} else {
 		// assume it is json encoded
-		fmt.Print(string(queryResponse.QueryResult))
+		fmt.Fprint(getOutput(), string(queryResponse.QueryResult))
 	}
 }
 
 // ListWorkflow list workflow executions based on filters
 func ListWorkflow(c *cli.Context) {
+	if c.Bool(FlagInteractive) {
+		browseWorkflows(c, listWorkflows(c))
+		return
+	}
 	displayPagedWorkflows(c, listWorkflows(c), !c.Bool(FlagMore))
 }
 
 // ListAllWorkflow list all workflow executions based on filters
 func ListAllWorkflow(c *cli.Context) {
+	if c.Bool(FlagInteractive) {
+		browseWorkflows(c, filterExcludedWorkflows(c, listWorkflows(c)))
+		return
+	}
 	displayAllWorkflows(c, filterExcludedWorkflows(c, listWorkflows(c)))
 }
 
 // ScanAllWorkflow list all workflow executions using Scan API.
 // It should be faster than ListAllWorkflow, but result are not sorted.
 func ScanAllWorkflow(c *cli.Context) {
+	if c.Bool(FlagInteractive) {
+		browseWorkflows(c, scanWorkflows(c))
+		return
+	}
 	displayAllWorkflows(c, scanWorkflows(c))
 }
 
@@ -683,9 +913,14 @@ func CountWorkflow(c *cli.Context) {
 
 	domain := getRequiredGlobalOption(c, FlagDomain)
 	query := c.String(FlagListQuery)
+	groupBy := c.String(FlagGroupBy)
+	if groupBy != "" && !countGroupByFields[groupBy] {
+		ErrorAndExit(fmt.Sprintf("Invalid %s %q, must be one of CloseStatus, WorkflowType or TaskList.", FlagGroupBy, groupBy), nil)
+	}
 	request := &types.CountWorkflowExecutionsRequest{
-		Domain: domain,
-		Query:  query,
+		Domain:  domain,
+		Query:   query,
+		GroupBy: groupBy,
 	}
 
 	ctx, cancel := newContextForLongPoll(c)
@@ -695,11 +930,40 @@ func CountWorkflow(c *cli.Context) {
 		ErrorAndExit("Failed to count workflow.", err)
 	}
 
-	fmt.Println(response.GetCount())
+	if groupBy == "" {
+		fmt.Println(response.GetCount())
+		return
+	}
+
+	rows := make([]countGroupRow, 0, len(response.GetGroups()))
+	for _, group := range response.GetGroups() {
+		value := group.GetValue()
+		if value == "" && groupBy == "CloseStatus" {
+			value = "Open"
+		}
+		rows = append(rows, countGroupRow{Value: value, Count: group.GetCount()})
+	}
+	RenderTable(getOutput(), rows, TableOptions{Color: true})
+	fmt.Printf("Total: %d\n", response.GetCount())
+}
+
+type countGroupRow struct {
+	Value string `header:"Group"`
+	Count int64  `header:"Count"`
+}
+
+var countGroupByFields = map[string]bool{
+	"CloseStatus":  true,
+	"WorkflowType": true,
+	"TaskList":     true,
 }
 
 // ListArchivedWorkflow lists archived workflow executions based on filters
 func ListArchivedWorkflow(c *cli.Context) {
+	if c.Bool(FlagInteractive) {
+		browseWorkflows(c, listArchivedWorkflows(c))
+		return
+	}
 	printAll := c.Bool(FlagAll)
 	if printAll {
 		displayAllWorkflows(c, listArchivedWorkflows(c))
@@ -708,6 +972,96 @@ func ListArchivedWorkflow(c *cli.Context) {
 	}
 }
 
+type (
+	// atRiskWorkflow is an open workflow execution whose history is approaching the limit
+	atRiskWorkflow struct {
+		Execution             *types.WorkflowExecution `json:"execution"`
+		WorkflowType          string                   `json:"workflowType"`
+		HistoryLength         int64                    `json:"historyLength"`
+		HistoryLimitReachTime time.Time                `json:"historyLimitReachTime"`
+	}
+
+	atRiskWorkflowRow struct {
+		WorkflowType  string        `header:"Workflow Type" maxLength:"32"`
+		WorkflowID    string        `header:"Workflow ID"`
+		RunID         string        `header:"Run ID"`
+		HistoryLength int64         `header:"History Length"`
+		ReachTime     time.Time     `header:"Estimated Limit Time"`
+		TimeToLimit   time.Duration `header:"Time To Limit"`
+	}
+)
+
+// ListAtRiskWorkflow lists open workflow executions whose history is approaching the size or count limit.
+// History records the estimated time the limit is reached in the HistoryLimitReachTime search attribute,
+// so this is a single visibility query and needs advanced visibility.
+func ListAtRiskWorkflow(c *cli.Context) {
+	wfClient := getWorkflowClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	format := getOutputFormat(c)
+	getWorkflowPage := listWorkflowExecutions(wfClient, c.Int(FlagPageSize), domain, getAtRiskWorkflowQuery(c.String(FlagWorkflowType)), c)
+
+	now := time.Now()
+	atRisk := []atRiskWorkflow{}
+	var page []*types.WorkflowExecutionInfo
+	var nextPageToken []byte
+	for {
+		page, nextPageToken = getWorkflowPage(nextPageToken)
+		for _, info := range page {
+			var reachTime time.Time
+			if err := json.Unmarshal(info.GetSearchAttributes().GetIndexedFields()[definition.HistoryLimitReachTime], &reachTime); err != nil {
+				ErrorAndExit("Failed to decode history limit reach time", err)
+			}
+			workflow := atRiskWorkflow{
+				Execution:             info.Execution,
+				WorkflowType:          info.Type.GetName(),
+				HistoryLength:         info.HistoryLength,
+				HistoryLimitReachTime: reachTime,
+			}
+			if format == outputFormatJSONL {
+				printJSONLine(workflow)
+			}
+			atRisk = append(atRisk, workflow)
+		}
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	switch format {
+	case outputFormatJSON:
+		prettyPrintJSONObject(atRisk)
+	case outputFormatTable:
+		if len(atRisk) == 0 {
+			fmt.Println("No workflow executions at risk of reaching the history limit.")
+			return
+		}
+		rows := make([]atRiskWorkflowRow, 0, len(atRisk))
+		for _, workflow := range atRisk {
+			timeToLimit := workflow.HistoryLimitReachTime.Sub(now).Round(time.Second)
+			if timeToLimit < 0 {
+				timeToLimit = 0
+			}
+			rows = append(rows, atRiskWorkflowRow{
+				WorkflowType:  workflow.WorkflowType,
+				WorkflowID:    workflow.Execution.GetWorkflowID(),
+				RunID:         workflow.Execution.GetRunID(),
+				HistoryLength: workflow.HistoryLength,
+				ReachTime:     workflow.HistoryLimitReachTime,
+				TimeToLimit:   timeToLimit,
+			})
+		}
+		RenderTable(getOutput(), rows, TableOptions{Color: true})
+	}
+}
+
+func getAtRiskWorkflowQuery(workflowType string) string {
+	query := fmt.Sprintf(`CloseTime = missing AND %v > "%v"`, definition.HistoryLimitReachTime, time.Unix(0, 0).UTC().Format(time.RFC3339))
+	if len(workflowType) > 0 {
+		query += fmt.Sprintf(` AND %v = "%v"`, definition.WorkflowType, workflowType)
+	}
+	return query + " ORDER BY " + definition.HistoryLimitReachTime
+}
+
 // DescribeWorkflow show information about the specified workflow execution
 func DescribeWorkflow(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
@@ -764,6 +1118,131 @@ func describeWorkflowHelper(c *cli.Context, wid, rid string) {
 	}
 
 	prettyPrintJSONObject(o)
+
+	if c.Bool(FlagPrintSearchAttr) {
+		printSearchAttributes(resp.GetWorkflowExecutionInfo().GetSearchAttributes())
+	}
+
+	if c.Bool(FlagPrintPendingRequests) {
+		printPendingRequests(c, domain, wid, rid, resp)
+	}
+}
+
+type pendingChildExecutionRow struct {
+	InitiatedID       int64  `header:"Initiated ID"`
+	Domain            string `header:"Domain"`
+	WorkflowID        string `header:"Workflow ID"`
+	RunID             string `header:"Run ID"`
+	WorkflowType      string `header:"Workflow Type"`
+	Started           bool   `header:"Started"`
+	ParentClosePolicy string `header:"Parent Close Policy"`
+}
+
+type pendingCancelRequestRow struct {
+	InitiatedID int64  `header:"Initiated ID"`
+	RequestID   string `header:"Request ID"`
+}
+
+type pendingSignalRequestRow struct {
+	InitiatedID int64  `header:"Initiated ID"`
+	SignalName  string `header:"Signal Name"`
+	RequestID   string `header:"Request ID"`
+}
+
+// printPendingRequests renders pending child executions and pending external
+// cancel/signal requests of the workflow, as recorded in its mutable state
+func printPendingRequests(c *cli.Context, domain, wid, rid string, resp *types.DescribeWorkflowExecutionResponse) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	msResp, err := adminClient.DescribeWorkflowExecution(ctx, &types.AdminDescribeWorkflowExecutionRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      rid,
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Get workflow mutableState failed", err)
+	}
+	ms := persistence.WorkflowMutableState{}
+	if err := json.Unmarshal([]byte(msResp.GetMutableStateInDatabase()), &ms); err != nil {
+		ErrorAndExit("json.Unmarshal err", err)
+	}
+
+	pendingChildren := make(map[int64]*types.PendingChildExecutionInfo, len(resp.PendingChildren))
+	for _, child := range resp.PendingChildren {
+		pendingChildren[child.GetInitiatedID()] = child
+	}
+
+	children := []pendingChildExecutionRow{}
+	for _, ci := range ms.ChildExecutionInfos {
+		initiatedAttr := ci.InitiatedEvent.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+		row := pendingChildExecutionRow{
+			InitiatedID:       ci.InitiatedID,
+			Domain:            initiatedAttr.GetDomain(),
+			WorkflowID:        ci.StartedWorkflowID,
+			RunID:             ci.StartedRunID,
+			WorkflowType:      ci.WorkflowTypeName,
+			Started:           ci.StartedID != common.EmptyEventID,
+			ParentClosePolicy: ci.ParentClosePolicy.String(),
+		}
+		if child, ok := pendingChildren[ci.InitiatedID]; ok && row.Domain == "" {
+			row.Domain = child.GetDomain()
+		}
+		if row.Domain == "" {
+			row.Domain = ci.DomainID
+		}
+		if row.WorkflowID == "" {
+			row.WorkflowID = initiatedAttr.GetWorkflowID()
+		}
+		children = append(children, row)
+	}
+
+	cancels := []pendingCancelRequestRow{}
+	for _, rc := range ms.RequestCancelInfos {
+		cancels = append(cancels, pendingCancelRequestRow{
+			InitiatedID: rc.InitiatedID,
+			RequestID:   rc.CancelRequestID,
+		})
+	}
+
+	signals := []pendingSignalRequestRow{}
+	for _, si := range ms.SignalInfos {
+		signals = append(signals, pendingSignalRequestRow{
+			InitiatedID: si.InitiatedID,
+			SignalName:  si.SignalName,
+			RequestID:   si.SignalRequestID,
+		})
+	}
+
+	opts := TableOptions{Color: true, Border: true, SortBy: "Initiated ID"}
+	fmt.Println("Pending Child Executions:")
+	RenderTable(getOutput(), children, opts)
+	fmt.Println("Pending External Cancel Requests:")
+	RenderTable(getOutput(), cancels, opts)
+	fmt.Println("Pending External Signals:")
+	RenderTable(getOutput(), signals, opts)
+}
+
+type searchAttributeRow struct {
+	Key   string `header:"Key"`
+	Value string `header:"Value"`
+}
+
+// printSearchAttributes renders search attributes with the same decoding used by list
+func printSearchAttributes(searchAttr *types.SearchAttributes) {
+	rows := []searchAttributeRow{}
+	for k, v := range searchAttr.GetIndexedFields() {
+		rows = append(rows, searchAttributeRow{
+			Key:   k,
+			Value: fmt.Sprintf("%v", decodeSearchAttributeValue(v)),
+		})
+	}
+	fmt.Println("Search Attributes:")
+	RenderTable(getOutput(), rows, TableOptions{Color: true, Border: true, SortBy: "Key"})
 }
 
 type AutoResetPointRow struct {
@@ -787,7 +1266,7 @@ func printAutoResetPoints(resp *types.DescribeWorkflowExecutionResponse) {
 			EventID:        pt.GetFirstDecisionCompletedID(),
 		})
 	}
-	RenderTable(os.Stdout, table, TableOptions{Color: true, Border: true, PrintDateTime: true})
+	RenderTable(getOutput(), table, TableOptions{Color: true, Border: true, PrintDateTime: true})
 }
 
 // describeWorkflowExecutionResponse is used to print datetime instead of print raw time
@@ -797,6 +1276,7 @@ type describeWorkflowExecutionResponse struct {
 	PendingActivities      []*pendingActivityInfo
 	PendingChildren        []*types.PendingChildExecutionInfo
 	PendingDecision        *pendingDecisionInfo
+	HistoryLimitWarning    *types.HistoryLimitWarning `json:",omitempty"`
 }
 
 // workflowExecutionInfo has same fields as types.WorkflowExecutionInfo, but has datetime instead of raw time
@@ -908,6 +1388,7 @@ func convertDescribeWorkflowExecutionResponse(resp *types.DescribeWorkflowExecut
 		PendingActivities:      pendingActs,
 		PendingChildren:        resp.PendingChildren,
 		PendingDecision:        pendingDecision,
+		HistoryLimitWarning:    resp.HistoryLimitWarning,
 	}
 }
 
@@ -929,7 +1410,12 @@ func convertSearchAttributesToMapOfInterface(searchAttributes *types.SearchAttri
 
 	indexedFields := searchAttributes.GetIndexedFields()
 	for k, v := range indexedFields {
-		valueType := validKeys[k]
+		valueType, ok := validKeys[k]
+		if !ok {
+			// key is no longer in the whitelist, decode it the same way list does instead of failing
+			result[k] = decodeSearchAttributeValue(v)
+			continue
+		}
 		deserializedValue, err := common.DeserializeSearchAttributeValue(v, thrift.FromIndexedValueType(valueType))
 		if err != nil {
 			ErrorAndExit("Error deserializing search attribute value", err)
@@ -1058,7 +1544,22 @@ func filterExcludedWorkflows(c *cli.Context, getWorkflowPage getWorkflowPageFn)
 	}
 }
 
+// limitWorkflowPages stops listing workflows once --max-items workflows are shown
+func limitWorkflowPages(getWorkflowPage getWorkflowPageFn) getWorkflowPageFn {
+	return func(nextPageToken []byte) ([]*types.WorkflowExecutionInfo, []byte) {
+		page, nextPageToken := getWorkflowPage(nextPageToken)
+		page = page[:cliPaging.limit(len(page))]
+		if cliPaging.done() {
+			return page, nil
+		}
+		return page, nextPageToken
+	}
+}
+
 func displayPagedWorkflows(c *cli.Context, getWorkflowPage getWorkflowPageFn, firstPageOnly bool) {
+	// json lines are meant to be piped, so pages are streamed without prompting
+	streaming := getOutputFormat(c) == outputFormatJSONL
+	getWorkflowPage = limitWorkflowPages(getWorkflowPage)
 	var page []*types.WorkflowExecutionInfo
 	var nextPageToken []byte
 	for {
@@ -1066,36 +1567,56 @@ func displayPagedWorkflows(c *cli.Context, getWorkflowPage getWorkflowPageFn, fi
 
 		displayWorkflows(c, page)
 
-		if firstPageOnly {
+		if firstPageOnly && !cliPaging.autoPaging() {
 			break
 		}
 		if len(nextPageToken) == 0 {
 			break
 		}
-		if !showNextPage() {
+		if !streaming && !showNextPage() {
 			break
 		}
 	}
 }
 
 func displayAllWorkflows(c *cli.Context, getWorkflowsPage getWorkflowPageFn) {
-	displayWorkflows(c, getAllWorkflows(getWorkflowsPage))
+	if getOutputFormat(c) == outputFormatJSONL {
+		displayPagedWorkflows(c, getWorkflowsPage, false)
+		return
+	}
+	progress := newPageProgress(c, "workflows")
+	getWorkflowsPage = limitWorkflowPages(getWorkflowsPage)
+	workflows := getAllWorkflows(func(nextPageToken []byte) ([]*types.WorkflowExecutionInfo, []byte) {
+		page, nextPageToken := getWorkflowsPage(nextPageToken)
+		progress.addPage(len(page))
+		return page, nextPageToken
+	})
+	progress.clear()
+	displayWorkflows(c, workflows)
 }
 
 func displayWorkflows(c *cli.Context, workflows []*types.WorkflowExecutionInfo) {
-	printJSON := c.Bool(FlagPrintJSON)
+	format := getOutputFormat(c)
+	if format == outputFormatJSONL {
+		for _, workflow := range workflows {
+			printJSONLine(jsonmapper.FromWorkflowExecutionInfo(workflow))
+		}
+		return
+	}
+
+	printJSON := format == outputFormatJSON
 	printDecodedRaw := c.Bool(FlagPrintFullyDetail)
 	if printJSON || printDecodedRaw {
-		fmt.Println("[")
+		Render("[")
 		printListResults(workflows, printJSON, false)
-		fmt.Println("]")
+		Render("]")
 	} else {
 		tableOptions := workflowTableOptions(c)
 		var table []WorkflowRow
 		for _, workflow := range workflows {
 			table = append(table, newWorkflowRow(workflow))
 		}
-		RenderTable(os.Stdout, table, tableOptions)
+		RenderTable(getOutput(), table, tableOptions)
 	}
 }
 
@@ -1285,8 +1806,9 @@ func getWorkflowStatus(statusStr string) types.WorkflowExecutionCloseStatus {
 	if status, ok := workflowClosedStatusMap[strings.ToLower(statusStr)]; ok {
 		return status
 	}
-	ErrorAndExit(optionErr, errors.New("option status is not one of allowed values "+
-		"[completed, failed, canceled, terminated, continued_as_new, timed_out]"))
+	// aliases are accepted above but only the full names are listed and suggested
+	mustParseEnumValue(FlagWorkflowStatus, statusStr, []string{
+		"completed", "failed", "canceled", "terminated", "continued_as_new", "timed_out"})
 	return 0
 }
 
@@ -1300,20 +1822,31 @@ func getWorkflowIDReusePolicy(value int) *types.WorkflowIDReusePolicy {
 }
 
 // default will print decoded raw
+// printHistoryEvents prints events in the stable JSON representation of the json mapper
+func printHistoryEvents(events []*types.HistoryEvent, format string) {
+	if format == outputFormatJSONL {
+		for _, e := range events {
+			printJSONLine(jsonmapper.FromHistoryEvent(e))
+		}
+		return
+	}
+	prettyPrintJSONObject(jsonmapper.FromHistoryEventArray(events))
+}
+
 func printListResults(executions []*types.WorkflowExecutionInfo, inJSON bool, more bool) {
 	for i, execution := range executions {
 		if inJSON {
-			j, _ := json.Marshal(execution)
+			j, _ := json.Marshal(jsonmapper.FromWorkflowExecutionInfo(execution))
 			if more || i < len(executions)-1 {
-				fmt.Println(string(j) + ",")
+				Render(string(j) + ",")
 			} else {
-				fmt.Println(string(j))
+				Render(string(j))
 			}
 		} else {
 			if more || i < len(executions)-1 {
-				fmt.Println(anyToString(execution, true, 0) + ",")
+				Render(anyToString(execution, true, 0) + ",")
 			} else {
-				fmt.Println(anyToString(execution, true, 0))
+				Render(anyToString(execution, true, 0))
 			}
 		}
 	}
@@ -1337,7 +1870,7 @@ func ResetWorkflow(c *cli.Context) {
 		ErrorAndExit("wrong reason", fmt.Errorf("reason cannot be empty"))
 	}
 	eventID := c.Int64(FlagEventID)
-	resetType := c.String(FlagResetType)
+	resetType := getEnumOption(c, FlagResetType, mapKeysToArray(resetTypesMap))
 	decisionOffset := c.Int(FlagDecisionOffset)
 	if decisionOffset > 0 {
 		ErrorAndExit("Only decision offset <=0 is supported", nil)
@@ -1372,7 +1905,7 @@ func ResetWorkflow(c *cli.Context) {
 			ErrorAndExit("getResetEventIDByType failed", err)
 		}
 	}
-	resp, err := frontendClient.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
+	request := &types.ResetWorkflowExecutionRequest{
 		Domain: domain,
 		WorkflowExecution: &types.WorkflowExecution{
 			WorkflowID: wid,
@@ -1382,7 +1915,11 @@ func ResetWorkflow(c *cli.Context) {
 		DecisionFinishEventID: decisionFinishID,
 		RequestID:             uuid.New(),
 		SkipSignalReapply:     c.Bool(FlagSkipSignalReapply),
-	})
+	}
+	if printDryRunRequest(c, "ResetWorkflowExecution", request) {
+		return
+	}
+	resp, err := frontendClient.ResetWorkflowExecution(ctx, request)
 	if err != nil {
 		ErrorAndExit("reset failed", err)
 	}
@@ -1434,7 +1971,7 @@ type batchResetParamsType struct {
 // ResetInBatch resets workflow in batch
 func ResetInBatch(c *cli.Context) {
 	domain := getRequiredGlobalOption(c, FlagDomain)
-	resetType := getRequiredOption(c, FlagResetType)
+	resetType := getRequiredEnumOption(c, FlagResetType, mapKeysToArray(resetTypesMap))
 	decisionOffset := c.Int(FlagDecisionOffset)
 	if decisionOffset > 0 {
 		ErrorAndExit("Only decision offset <=0 is supported", nil)
@@ -1454,6 +1991,11 @@ func ResetInBatch(c *cli.Context) {
 		getRequiredOption(c, extraForResetType)
 	}
 
+	if c.Bool(FlagAsync) {
+		startResetBatchJob(c, domain, resetType)
+		return
+	}
+
 	if excludeFileName != "" && excludeQuery != "" {
 		ErrorAndExit("Only one of the excluding option is allowed", nil)
 	}
@@ -1562,6 +2104,48 @@ func ResetInBatch(c *cli.Context) {
 	wg.Wait()
 }
 
+// startResetBatchJob submits the reset as a batch job to the worker service, so it keeps running after the CLI exits
+func startResetBatchJob(c *cli.Context, domain, resetType string) {
+	resetType = mustParseEnumValue(FlagResetType, resetType, batcher.AllResetTypes)
+	for _, flag := range []string{
+		FlagInputFile,
+		FlagExcludeFile,
+		FlagExcludeWorkflowIDByQuery,
+		FlagSkipCurrentOpen,
+		FlagSkipCurrentCompleted,
+		FlagSkipBaseIsNotCurrent,
+		FlagNonDeterministicOnly,
+		FlagDryRun,
+		FlagDecisionOffset,
+	} {
+		if c.IsSet(flag) {
+			ErrorAndExit(fmt.Sprintf("Flag %s is not supported with %s.", flag, FlagAsync), nil)
+		}
+	}
+
+	params := batcher.BatchParams{
+		DomainName: domain,
+		Query:      getRequiredOption(c, FlagListQuery),
+		Reason:     getRequiredOption(c, FlagReason),
+		BatchType:  batcher.BatchTypeReset,
+		ResetParams: batcher.ResetParams{
+			ResetType:         resetType,
+			SkipSignalReapply: c.Bool(FlagSkipSignalReapply),
+		},
+		Concurrency: c.Int(FlagParallism),
+	}
+	request := newBatchWorkflowRequest(params, getCurrentUserFromEnv())
+	if printDryRunRequest(c, "StartWorkflowExecution", request) {
+		return
+	}
+	jobID := startBatchWorkflow(c, request)
+	output := map[string]interface{}{
+		"msg":   "reset batch job is started",
+		"jobID": jobID,
+	}
+	prettyPrintJSONObject(output)
+}
+
 func loadWorkflowIDsFromFile(excludeFileName, separator string) map[string]bool {
 	excludeWIDs := map[string]bool{}
 	if len(excludeFileName) > 0 {
@@ -1652,20 +2236,24 @@ func doReset(c *cli.Context, domain, wid, rid string, params batchResetParamsTyp
 	}
 	fmt.Println("DecisionFinishEventId for reset:", wid, rid, resetBaseRunID, decisionFinishID)
 
+	request := &types.ResetWorkflowExecutionRequest{
+		Domain: domain,
+		WorkflowExecution: &types.WorkflowExecution{
+			WorkflowID: wid,
+			RunID:      resetBaseRunID,
+		},
+		DecisionFinishEventID: decisionFinishID,
+		RequestID:             uuid.New(),
+		Reason:                fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), params.reason),
+		SkipSignalReapply:     params.skipSignalReapply,
+	}
+	if printDryRunRequest(c, "ResetWorkflowExecution", request) {
+		return nil
+	}
 	if params.dryRun {
 		fmt.Printf("dry run to reset wid: %v, rid:%v to baseRunID:%v, eventID:%v \n", wid, rid, resetBaseRunID, decisionFinishID)
 	} else {
-		resp2, err := frontendClient.ResetWorkflowExecution(ctx, &types.ResetWorkflowExecutionRequest{
-			Domain: domain,
-			WorkflowExecution: &types.WorkflowExecution{
-				WorkflowID: wid,
-				RunID:      resetBaseRunID,
-			},
-			DecisionFinishEventID: decisionFinishID,
-			RequestID:             uuid.New(),
-			Reason:                fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), params.reason),
-			SkipSignalReapply:     params.skipSignalReapply,
-		})
+		resp2, err := frontendClient.ResetWorkflowExecution(ctx, request)
 
 		if err != nil {
 			return printErrorAndReturn("ResetWorkflowExecution failed", err)
@@ -0,0 +1,204 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	batchDescribeStatusOpen     = "OPEN"
+	batchDescribeStatusClosed   = "CLOSED"
+	batchDescribeStatusNotFound = "NOT FOUND"
+	batchDescribeStatusError    = "ERROR"
+)
+
+type (
+	batchDescribeTarget struct {
+		WorkflowID string
+		RunID      string
+	}
+
+	// BatchDescribeRow is one workflow execution in the output of describe-batch
+	BatchDescribeRow struct {
+		WorkflowID    string `header:"Workflow ID" json:"workflowID"`
+		RunID         string `header:"Run ID" json:"runID"`
+		Status        string `header:"Status" json:"status"`
+		StartTime     string `header:"Start Time" json:"startTime,omitempty"`
+		CloseTime     string `header:"Close Time" json:"closeTime,omitempty"`
+		CloseStatus   string `header:"Close Status" json:"closeStatus,omitempty"`
+		TaskList      string `header:"Task List" json:"taskList,omitempty"`
+		HistoryLength int64  `header:"History Length" json:"historyLength"`
+		Error         string `header:"Error" json:"error,omitempty"`
+	}
+)
+
+// DescribeBatchWorkflow describes the workflow executions listed in a file and renders them in one table
+func DescribeBatchWorkflow(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	fileName := getRequiredOption(c, FlagFile)
+	format := getOutputFormat(c)
+	concurrency := c.Int(FlagConcurrency)
+	if concurrency <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s must be positive.", FlagConcurrency), nil)
+	}
+
+	// This code is only used in the CLI. The input provided is from a trusted user.
+	// #nosec
+	file, err := os.Open(fileName)
+	if err != nil {
+		ErrorAndExit("Failed to open file "+fileName, err)
+	}
+	targets, err := readBatchDescribeTargets(file)
+	file.Close()
+	if err != nil {
+		ErrorAndExit("Failed to read file "+fileName, err)
+	}
+	if len(targets) == 0 {
+		ErrorAndExit("No workflow IDs found in file "+fileName, nil)
+	}
+
+	rows := describeBatchWorkflows(c, cFactory.ServerFrontendClient(c), domain, targets, concurrency)
+	switch format {
+	case outputFormatJSON:
+		prettyPrintJSONObject(rows)
+	case outputFormatJSONL:
+		for _, row := range rows {
+			printJSONLine(row)
+		}
+	case outputFormatTable:
+		RenderTable(getOutput(), rows, TableOptions{Color: true, Border: true})
+	}
+}
+
+// readBatchDescribeTargets parses workflowID[,runID] lines, skipping empty lines and comments
+func readBatchDescribeTargets(reader io.Reader) ([]batchDescribeTarget, error) {
+	var targets []batchDescribeTarget
+	scanner := bufio.NewScanner(reader)
+	idx := 0
+	for scanner.Scan() {
+		idx++
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		cols := strings.Split(line, ",")
+		if len(cols) > 2 {
+			return nil, fmt.Errorf("line %v has %v columns, expected workflowID[,runID]", idx, len(cols))
+		}
+		target := batchDescribeTarget{WorkflowID: strings.TrimSpace(cols[0])}
+		if len(cols) == 2 {
+			target.RunID = strings.TrimSpace(cols[1])
+		}
+		if target.WorkflowID == "" {
+			return nil, fmt.Errorf("line %v has an empty workflowID", idx)
+		}
+		targets = append(targets, target)
+	}
+	return targets, scanner.Err()
+}
+
+// describeBatchWorkflows describes the targets with at most concurrency requests in flight
+// and returns the rows in the order of the targets
+func describeBatchWorkflows(
+	c *cli.Context,
+	frontendClient frontend.Client,
+	domain string,
+	targets []batchDescribeTarget,
+	concurrency int,
+) []BatchDescribeRow {
+	rows := make([]BatchDescribeRow, len(targets))
+	indexes := make(chan int)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < concurrency && i < len(targets); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				rows[idx] = describeBatchWorkflow(c, frontendClient, domain, targets[idx])
+			}
+		}()
+	}
+	for idx := range targets {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+	return rows
+}
+
+func describeBatchWorkflow(
+	c *cli.Context,
+	frontendClient frontend.Client,
+	domain string,
+	target batchDescribeTarget,
+) BatchDescribeRow {
+	row := BatchDescribeRow{
+		WorkflowID: target.WorkflowID,
+		RunID:      target.RunID,
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := frontendClient.DescribeWorkflowExecution(ctx, &types.DescribeWorkflowExecutionRequest{
+		Domain: domain,
+		Execution: &types.WorkflowExecution{
+			WorkflowID: target.WorkflowID,
+			RunID:      target.RunID,
+		},
+	})
+	if err != nil {
+		row.Status = batchDescribeStatusError
+		if _, ok := err.(*types.EntityNotExistsError); ok {
+			row.Status = batchDescribeStatusNotFound
+		}
+		row.Error = err.Error()
+		return row
+	}
+
+	info := resp.GetWorkflowExecutionInfo()
+	if info == nil {
+		row.Status = batchDescribeStatusError
+		row.Error = "response has no workflow execution info"
+		return row
+	}
+	row.RunID = info.GetExecution().GetRunID()
+	row.Status = batchDescribeStatusOpen
+	row.StartTime = convertTime(info.GetStartTime(), false)
+	if info.CloseStatus != nil {
+		row.Status = batchDescribeStatusClosed
+		row.CloseTime = convertTime(info.GetCloseTime(), false)
+		row.CloseStatus = info.GetCloseStatus().String()
+	}
+	row.TaskList = info.GetTaskList()
+	row.HistoryLength = info.GetHistoryLength()
+	return row
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2021 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBatchDescribeTargets(t *testing.T) {
+	input := `
+# incident 1234
+wid-1
+ wid-2 , rid-2
+
+wid-3,
+`
+	targets, err := readBatchDescribeTargets(strings.NewReader(input))
+	require.NoError(t, err)
+	assert.Equal(t, []batchDescribeTarget{
+		{WorkflowID: "wid-1"},
+		{WorkflowID: "wid-2", RunID: "rid-2"},
+		{WorkflowID: "wid-3"},
+	}, targets)
+
+	_, err = readBatchDescribeTargets(strings.NewReader("wid,rid,extra"))
+	assert.Error(t, err)
+
+	_, err = readBatchDescribeTargets(strings.NewReader(",rid"))
+	assert.Error(t, err)
+}